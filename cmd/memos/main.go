@@ -25,15 +25,24 @@ var (
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
 			instanceProfile := &profile.Profile{
-				Mode:        viper.GetString("mode"),
-				Addr:        viper.GetString("addr"),
-				Port:        viper.GetInt("port"),
-				UNIXSock:    viper.GetString("unix-sock"),
-				Data:        viper.GetString("data"),
-				Driver:      viper.GetString("driver"),
-				DSN:         viper.GetString("dsn"),
-				InstanceURL: viper.GetString("instance-url"),
-				Version:     version.GetCurrentVersion(viper.GetString("mode")),
+				Mode:            viper.GetString("mode"),
+				Addr:            viper.GetString("addr"),
+				Port:            viper.GetInt("port"),
+				UNIXSock:        viper.GetString("unix-sock"),
+				Data:            viper.GetString("data"),
+				Driver:          viper.GetString("driver"),
+				DSN:             viper.GetString("dsn"),
+				MaxOpenConns:    viper.GetInt("max-open-conns"),
+				MaxIdleConns:    viper.GetInt("max-idle-conns"),
+				ConnMaxLifetime: viper.GetDuration("conn-max-lifetime"),
+				Migrate:         viper.GetString("migrate"),
+				InstanceURL:     viper.GetString("instance-url"),
+				OutboundProxy:   viper.GetString("outbound-proxy"),
+				FeedItemLength:  viper.GetInt("feed-item-length"),
+				Metrics:         viper.GetBool("metrics"),
+				MetricsAddr:     viper.GetString("metrics-addr"),
+				MetricsToken:    viper.GetString("metrics-token"),
+				Version:         version.GetCurrentVersion(viper.GetString("mode")),
 			}
 			if err := instanceProfile.Validate(); err != nil {
 				panic(err)
@@ -48,6 +57,13 @@ var (
 			}
 
 			storeInstance := store.New(dbDriver, instanceProfile)
+			if instanceProfile.Migrate == profile.MigrateDryRun {
+				defer cancel()
+				if err := printMigrationStatus(ctx, storeInstance); err != nil {
+					slog.Error("failed to get migration status", "error", err)
+				}
+				return
+			}
 			if err := storeInstance.Migrate(ctx); err != nil {
 				cancel()
 				slog.Error("failed to migrate", "error", err)
@@ -100,7 +116,16 @@ func init() {
 	rootCmd.PersistentFlags().String("data", "", "data directory")
 	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
+	rootCmd.PersistentFlags().Int("max-open-conns", 0, "maximum number of open database connections, 0 means unlimited")
+	rootCmd.PersistentFlags().Int("max-idle-conns", 0, "maximum number of idle database connections")
+	rootCmd.PersistentFlags().Duration("conn-max-lifetime", 0, "maximum time a database connection may be reused, e.g. 30m")
+	rootCmd.PersistentFlags().String("migrate", "auto", `what to do with pending database migrations on startup, can be "auto" to apply them, "dry-run" to print them and exit, or "manual" to refuse to start`)
 	rootCmd.PersistentFlags().String("instance-url", "", "the url of your memos instance")
+	rootCmd.PersistentFlags().String("outbound-proxy", "", "proxy url for outbound requests, overrides HTTP_PROXY and HTTPS_PROXY")
+	rootCmd.PersistentFlags().Int("feed-item-length", 0, "number of characters of memo content shown in feed items, 0 means the whole content")
+	rootCmd.PersistentFlags().Bool("metrics", false, "serve Prometheus metrics at /metrics, needs --metrics-addr or --metrics-token")
+	rootCmd.PersistentFlags().String("metrics-addr", "", "address of a separate listener serving the metrics, e.g. 127.0.0.1:9090")
+	rootCmd.PersistentFlags().String("metrics-token", "", "bearer token required to scrape the metrics")
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -123,15 +148,96 @@ func init() {
 	if err := viper.BindPFlag("dsn", rootCmd.PersistentFlags().Lookup("dsn")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("max-open-conns", rootCmd.PersistentFlags().Lookup("max-open-conns")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("max-idle-conns", rootCmd.PersistentFlags().Lookup("max-idle-conns")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("conn-max-lifetime", rootCmd.PersistentFlags().Lookup("conn-max-lifetime")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("migrate", rootCmd.PersistentFlags().Lookup("migrate")); err != nil {
+		panic(err)
+	}
 	if err := viper.BindPFlag("instance-url", rootCmd.PersistentFlags().Lookup("instance-url")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("outbound-proxy", rootCmd.PersistentFlags().Lookup("outbound-proxy")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("feed-item-length", rootCmd.PersistentFlags().Lookup("feed-item-length")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("metrics", rootCmd.PersistentFlags().Lookup("metrics")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("metrics-addr", rootCmd.PersistentFlags().Lookup("metrics-addr")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("metrics-token", rootCmd.PersistentFlags().Lookup("metrics-token")); err != nil {
+		panic(err)
+	}
 
 	viper.SetEnvPrefix("memos")
 	viper.AutomaticEnv()
+	if err := viper.BindEnv("max-open-conns", "MEMOS_MAX_OPEN_CONNS"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("max-idle-conns", "MEMOS_MAX_IDLE_CONNS"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("conn-max-lifetime", "MEMOS_CONN_MAX_LIFETIME"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("migrate", "MEMOS_MIGRATE"); err != nil {
+		panic(err)
+	}
 	if err := viper.BindEnv("instance-url", "MEMOS_INSTANCE_URL"); err != nil {
 		panic(err)
 	}
+	if err := viper.BindEnv("outbound-proxy", "MEMOS_OUTBOUND_PROXY"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("feed-item-length", "MEMOS_FEED_ITEM_LENGTH"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("metrics", "MEMOS_METRICS"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("metrics-addr", "MEMOS_METRICS_ADDR"); err != nil {
+		panic(err)
+	}
+	if err := viper.BindEnv("metrics-token", "MEMOS_METRICS_TOKEN"); err != nil {
+		panic(err)
+	}
+}
+
+// printMigrationStatus prints the schema version of the database and the pending migration files.
+func printMigrationStatus(ctx context.Context, storeInstance *store.Store) error {
+	status, err := storeInstance.GetMigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	schemaVersion := status.SchemaVersion
+	if schemaVersion == "" {
+		schemaVersion = "not initialized"
+	}
+	fmt.Printf("Database schema version: %s\n", schemaVersion)
+	fmt.Printf("Target schema version: %s\n", status.TargetSchemaVersion)
+	for _, file := range status.Modified {
+		fmt.Printf("Modified since applied: %s\n", file)
+	}
+	if len(status.Pending) == 0 {
+		fmt.Println("Database is up to date")
+		return nil
+	}
+	fmt.Printf("Pending migrations (%d):\n", len(status.Pending))
+	for _, file := range status.Pending {
+		fmt.Printf("  %s\n", file)
+	}
+	return nil
 }
 
 func printGreetings(profile *profile.Profile) {
@@ -0,0 +1,248 @@
+// Package metrics holds the Prometheus metrics of the server, served by the /metrics endpoint
+// when it's enabled.
+//
+// Metrics are named memos_<subsystem>_<name>_<unit>, e.g. memos_http_request_duration_seconds,
+// and their labels are bounded: routes are route templates rather than request paths, RPCs are
+// service and method names, and no label holds user data.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "memos"
+
+// Runner run results.
+const (
+	RunnerSuccess = "success"
+	RunnerFailure = "failure"
+)
+
+// Webhook delivery outcomes.
+const (
+	// WebhookSucceeded is a delivery whose attempt succeeded.
+	WebhookSucceeded = "succeeded"
+	// WebhookRetrying is a delivery whose attempt failed, and that will be retried.
+	WebhookRetrying = "retrying"
+	// WebhookFailed is a delivery whose last attempt failed.
+	WebhookFailed = "failed"
+	// WebhookDropped is a delivery to a webhook that was deleted or disabled, which isn't sent.
+	WebhookDropped = "dropped"
+)
+
+// registry is the registry of the metrics of the server, rather than the default registry, so
+// that the metrics of dependencies aren't served.
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by method, route template and status code.",
+	}, []string{"method", "route", "code"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by method and route template.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	rpcRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC and Connect requests by service, method and status code.",
+	}, []string{"service", "method", "code"})
+	rpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC and Connect requests by service and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	storeOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of database operations by store driver method.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation"})
+
+	runnerRunsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "runner",
+		Name:      "runs_total",
+		Help:      "Number of runs of the background runners by runner and result.",
+	}, []string{"runner", "result"})
+	runnerRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "runner",
+		Name:      "run_duration_seconds",
+		Help:      "Duration of the runs of the background runners by runner.",
+		Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300},
+	}, []string{"runner"})
+
+	webhookDeliveriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "deliveries_total",
+		Help:      "Number of webhook delivery attempts by outcome.",
+	}, []string{"outcome"})
+	webhookDeliveryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "delivery_duration_seconds",
+		Help:      "Duration of the webhook requests sent by delivery attempts.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	})
+
+	buildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "Build information of the server, always 1.",
+	}, []string{"version"})
+	activeSessions = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_sessions",
+		Help:      "Number of sign-in sessions that haven't expired.",
+	}, countActiveSessions)
+)
+
+var (
+	activeSessionsMutex sync.Mutex
+	activeSessionsFunc  func() float64
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		rpcRequestsTotal,
+		rpcRequestDuration,
+		storeOperationDuration,
+		runnerRunsTotal,
+		runnerRunDuration,
+		webhookDeliveriesTotal,
+		webhookDeliveryDuration,
+		buildInfo,
+		activeSessions,
+	)
+}
+
+// Handler returns the handler serving the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// SetBuildInfo sets memos_build_info, whose version label is the version of the server.
+func SetBuildInfo(version string) {
+	buildInfo.Reset()
+	buildInfo.WithLabelValues(version).Set(1)
+}
+
+// SetActiveSessionsFunc sets the func counting the sessions of memos_active_sessions, called when
+// the metrics are scraped.
+func SetActiveSessionsFunc(count func() float64) {
+	activeSessionsMutex.Lock()
+	defer activeSessionsMutex.Unlock()
+	activeSessionsFunc = count
+}
+
+func countActiveSessions() float64 {
+	activeSessionsMutex.Lock()
+	defer activeSessionsMutex.Unlock()
+	if activeSessionsFunc == nil {
+		return 0
+	}
+	return activeSessionsFunc()
+}
+
+// ObserveHTTPRequest records an HTTP request to the route template, e.g. "/api/v1/*", started at
+// start.
+func ObserveHTTPRequest(method, route string, code int, start time.Time) {
+	httpRequestsTotal.WithLabelValues(method, route, statusCodeLabel(code)).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+}
+
+// ObserveRPC records an RPC to the procedure, e.g. "/memos.api.v1.MemoService/ListMemos", started
+// at start.
+func ObserveRPC(procedure, code string, start time.Time) {
+	service, method := splitProcedure(procedure)
+	rpcRequestsTotal.WithLabelValues(service, method, code).Inc()
+	rpcRequestDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// ObserveStoreOperation records a store driver operation started at start.
+func ObserveStoreOperation(operation string, start time.Time) {
+	storeOperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// ObserveWebhookDelivery records the outcome of a webhook delivery attempt, and the duration of
+// its request if it was sent.
+func ObserveWebhookDelivery(outcome string, duration time.Duration) {
+	webhookDeliveriesTotal.WithLabelValues(outcome).Inc()
+	if outcome != WebhookDropped {
+		webhookDeliveryDuration.Observe(duration.Seconds())
+	}
+}
+
+// RunnerRun is a run of a background runner, recorded when it ends.
+type RunnerRun struct {
+	runner string
+	start  time.Time
+	failed bool
+}
+
+// StartRunnerRun starts a run of the runner, e.g. "trash".
+func StartRunnerRun(runner string) *RunnerRun {
+	return &RunnerRun{runner: runner, start: time.Now()}
+}
+
+// Fail marks the run as failed, e.g. when one of its operations failed.
+func (r *RunnerRun) Fail() {
+	r.failed = true
+}
+
+// End records the run.
+func (r *RunnerRun) End() {
+	result := RunnerSuccess
+	if r.failed {
+		result = RunnerFailure
+	}
+	runnerRunsTotal.WithLabelValues(r.runner, result).Inc()
+	runnerRunDuration.WithLabelValues(r.runner).Observe(time.Since(r.start).Seconds())
+}
+
+// splitProcedure splits a procedure into its service and method names, e.g. "MemoService" and
+// "ListMemos".
+func splitProcedure(procedure string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	if !ok {
+		return "unknown", "unknown"
+	}
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	return service, method
+}
+
+// statusCodeLabel returns the label of an HTTP status code, which is "unknown" for invalid codes.
+func statusCodeLabel(code int) string {
+	if code < 100 || code > 999 {
+		return "unknown"
+	}
+	return strconv.Itoa(code)
+}
@@ -7,10 +7,21 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// Migrate modes, deciding what happens to pending database migrations on startup.
+const (
+	// MigrateAuto applies pending migrations.
+	MigrateAuto = "auto"
+	// MigrateDryRun prints the pending migrations and exits without starting the server.
+	MigrateDryRun = "dry-run"
+	// MigrateManual refuses to start while migrations are pending.
+	MigrateManual = "manual"
+)
+
 // Profile is the configuration to start main server.
 type Profile struct {
 	// Mode can be "prod" or "dev" or "demo"
@@ -28,10 +39,31 @@ type Profile struct {
 	// Driver is the database driver
 	// sqlite, mysql
 	Driver string
+	// MaxOpenConns is the maximum number of open database connections, 0 means unlimited
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of idle database connections, 0 keeps the default
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum time a database connection may be reused, 0 means forever
+	ConnMaxLifetime time.Duration
+	// Migrate is the migrate mode, can be "auto" or "dry-run" or "manual"
+	Migrate string
 	// Version is the current version of server
 	Version string
 	// InstanceURL is the url of your memos instance.
 	InstanceURL string
+	// OutboundProxy is the proxy URL for outbound requests such as link previews and webhooks.
+	// Overrides HTTP_PROXY and HTTPS_PROXY; NO_PROXY is still honored.
+	OutboundProxy string
+	// FeedItemLength is the number of characters of the content of a memo shown in feed items,
+	// 0 shows the whole content. Longer memos link to the memo to read the rest.
+	FeedItemLength int
+	// Metrics enables the Prometheus metrics endpoint, /metrics.
+	Metrics bool
+	// MetricsAddr is the binding address of a separate listener serving the metrics, e.g.
+	// "127.0.0.1:9090". The metrics are served by the server itself if it's empty.
+	MetricsAddr string
+	// MetricsToken is the bearer token required to scrape the metrics, if any.
+	MetricsToken string
 }
 
 func (p *Profile) IsDev() bool {
@@ -76,6 +108,25 @@ func (p *Profile) Validate() error {
 		}
 	}
 
+	if p.MaxOpenConns < 0 || p.MaxIdleConns < 0 || p.ConnMaxLifetime < 0 {
+		return errors.New("database connection pool options cannot be negative")
+	}
+
+	if p.FeedItemLength < 0 {
+		return errors.New("feed item length cannot be negative")
+	}
+
+	if p.Metrics && p.MetricsAddr == "" && p.MetricsToken == "" {
+		return errors.New("the metrics endpoint needs a metrics address or a metrics token, so that it isn't public")
+	}
+
+	if p.Migrate == "" {
+		p.Migrate = MigrateAuto
+	}
+	if p.Migrate != MigrateAuto && p.Migrate != MigrateDryRun && p.Migrate != MigrateManual {
+		return errors.Errorf("invalid migrate mode %q, must be one of %q, %q and %q", p.Migrate, MigrateAuto, MigrateDryRun, MigrateManual)
+	}
+
 	dataDir, err := checkDataDir(p.Data)
 	if err != nil {
 		slog.Error("failed to check dsn", slog.String("data", dataDir), slog.String("error", err.Error()))
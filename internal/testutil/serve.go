@@ -0,0 +1,34 @@
+// Package testutil provides helpers shared by the tests of several packages.
+package testutil
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ServeAt starts a test server for handler, closed when the test ends, and returns a dial
+// function that connects to it whatever address is dialed, so that requests to public
+// addresses reach handler.
+func ServeAt(t testing.TB, handler http.HandlerFunc) func(ctx context.Context, network, address string) (net.Conn, error) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+}
+
+// ServeTransportAt makes newTransport return transports connected to a test server for handler
+// until the test ends. See ServeAt.
+func ServeTransportAt(t testing.TB, newTransport *func() *http.Transport, handler http.HandlerFunc) {
+	t.Helper()
+	dial := ServeAt(t, handler)
+	previous := *newTransport
+	*newTransport = func() *http.Transport {
+		return &http.Transport{DialContext: dial}
+	}
+	t.Cleanup(func() { *newTransport = previous })
+}
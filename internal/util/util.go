@@ -6,8 +6,12 @@ import (
 	"net/mail"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/google/uuid"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 // ConvertStringToInt32 converts a string to int32.
@@ -71,3 +75,13 @@ func ReplaceString(slice []string, old, new string) []string {
 	}
 	return slice
 }
+
+// NormalizeTag returns the form of a tag used to compare tags: NFC-normalized, lowercased and
+// without diacritics, e.g. "Café" becomes "cafe".
+func NormalizeTag(tag string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), tag)
+	if err != nil {
+		folded = norm.NFC.String(tag)
+	}
+	return strings.ToLower(folded)
+}
@@ -29,3 +29,23 @@ func TestValidateEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{tag: "todo", want: "todo"},
+		{tag: "Todo", want: "todo"},
+		{tag: "Work/Project", want: "work/project"},
+		{tag: "caf\u00e9", want: "cafe"},
+		{tag: "Cafe\u0301", want: "cafe"},
+		{tag: "\u00c5ngstr\u00f6m", want: "angstrom"},
+		{tag: "\u6807\u7b7e", want: "\u6807\u7b7e"},
+	}
+	for _, test := range tests {
+		if result := NormalizeTag(test.tag); result != test.want {
+			t.Errorf("NormalizeTag %q: got %q, want %q.", test.tag, result, test.want)
+		}
+	}
+}
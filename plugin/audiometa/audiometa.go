@@ -0,0 +1,57 @@
+// Package audiometa reads the duration of uploaded audio files from their MP3, MP4, Ogg or WebM
+// container, without decoding the audio.
+package audiometa
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedFormat is returned for audio files whose container can't be parsed.
+var ErrUnsupportedFormat = errors.New("unsupported audio format")
+
+// IsSupported reports whether the duration of files of the type is read.
+func IsSupported(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "audio/")
+}
+
+// Duration returns the duration of an audio file. The container is detected from the content
+// rather than the type of the upload, as browsers and recorders don't agree on the types of
+// M4A and WebM recordings.
+func Duration(blob []byte) (time.Duration, error) {
+	var duration time.Duration
+	var err error
+	switch {
+	case len(blob) >= 8 && string(blob[4:8]) == "ftyp":
+		duration, err = readMP4Duration(blob)
+	case bytes.HasPrefix(blob, oggCapturePattern):
+		duration, err = readOggDuration(blob)
+	case bytes.HasPrefix(blob, ebmlMagic):
+		duration, err = readWebMDuration(blob)
+	case bytes.HasPrefix(blob, id3Magic) || isMP3FrameHeader(blob):
+		duration, err = readMP3Duration(blob)
+	default:
+		return 0, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read the audio duration")
+	}
+	if duration <= 0 {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "the audio has no duration")
+	}
+	return duration, nil
+}
+
+// samplesToDuration converts a number of samples at a sample rate to a duration, or of bits at a
+// bitrate.
+func samplesToDuration(samples uint64, sampleRate uint32) time.Duration {
+	if sampleRate == 0 {
+		return 0
+	}
+	seconds := samples / uint64(sampleRate)
+	remainder := samples % uint64(sampleRate)
+	return time.Duration(seconds)*time.Second + time.Duration(remainder*uint64(time.Second)/uint64(sampleRate))
+}
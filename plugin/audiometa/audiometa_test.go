@@ -0,0 +1,194 @@
+package audiometa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mp3FrameHeader is the header of an MPEG-1 layer 3 frame at 128 kbit/s and 44.1 kHz, stereo,
+// whose frames are 417 bytes.
+var mp3FrameHeader = []byte{0xff, 0xfb, 0x90, 0x00}
+
+// testMP3 returns a constant bitrate MP3 file of frames, with an ID3v2 tag and optionally a Xing
+// header in the first frame.
+func testMP3(frames int, xingFrames uint32) []byte {
+	frame := make([]byte, 417)
+	copy(frame, mp3FrameHeader)
+	blob := []byte("ID3\x04\x00\x00\x00\x00\x00\x05hello")
+	if xingFrames > 0 {
+		first := bytes.Clone(frame)
+		xing := 4 + 32
+		copy(first[xing:], "Xing")
+		binary.BigEndian.PutUint32(first[xing+4:], xingFramesFlag)
+		binary.BigEndian.PutUint32(first[xing+8:], xingFrames)
+		blob = append(blob, first...)
+	}
+	for i := 0; i < frames; i++ {
+		blob = append(blob, frame...)
+	}
+	return blob
+}
+
+// mp4Box returns an MP4 box of content.
+func mp4Box(boxType string, content ...[]byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, 0)
+	b = append(b, boxType...)
+	for _, c := range content {
+		b = append(b, c...)
+	}
+	binary.BigEndian.PutUint32(b, uint32(len(b)))
+	return b
+}
+
+// testM4A returns an M4A file whose movie header has the timescale and duration.
+func testM4A(timescale, duration uint32) []byte {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], timescale)
+	binary.BigEndian.PutUint32(mvhd[16:], duration)
+	return bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("M4A \x00\x00\x00\x00")),
+		mp4Box("mdat", make([]byte, 64)),
+		mp4Box("moov", mp4Box("mvhd", mvhd), mp4Box("trak")),
+	}, nil)
+}
+
+// oggPage returns an Ogg page of a packet.
+func oggPage(serial uint32, granule int64, packet []byte) []byte {
+	b := bytes.Clone(oggCapturePattern)
+	b = append(b, 0, 0)
+	b = binary.LittleEndian.AppendUint64(b, uint64(granule))
+	b = binary.LittleEndian.AppendUint32(b, serial)
+	b = append(b, make([]byte, 8)...)
+	b = append(b, 1, byte(len(packet)))
+	return append(b, packet...)
+}
+
+// ebmlElement returns an EBML element of content, whose size is written as unknown if unknownSize.
+func ebmlElement(id []byte, unknownSize bool, content ...[]byte) []byte {
+	data := bytes.Join(content, nil)
+	b := bytes.Clone(id)
+	if unknownSize {
+		b = append(b, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff)
+	} else {
+		// The size is written on 8 bytes, the marker taking the first one.
+		size := binary.BigEndian.AppendUint64(nil, uint64(len(data)))
+		b = append(append(b, 0x01), size[1:]...)
+	}
+	return append(b, data...)
+}
+
+// simpleBlock returns a SimpleBlock of track 1 with the relative timecode.
+func simpleBlock(timecode int16) []byte {
+	data := []byte{0x81}
+	data = binary.BigEndian.AppendUint16(data, uint16(timecode))
+	return ebmlElement([]byte{ebmlSimpleBlockID}, false, append(data, 0x80, 1, 2, 3))
+}
+
+func TestDuration(t *testing.T) {
+	segment := []byte{0x18, 0x53, 0x80, 0x67}
+	info := []byte{0x15, 0x49, 0xA9, 0x66}
+	cluster := []byte{0x1F, 0x43, 0xB6, 0x75}
+	ebmlHeader := ebmlElement(ebmlMagic, false, ebmlElement([]byte{0x42, 0x82}, false, []byte("webm")))
+
+	tests := []struct {
+		name     string
+		blob     []byte
+		duration time.Duration
+	}{
+		{
+			name: "mp3 with a Xing header",
+			blob: testMP3(10, 1000),
+			// A frame of MPEG-1 layer 3 holds 1152 samples.
+			duration: samplesToDuration(1000*1152, 44100),
+		},
+		{
+			name: "mp3 with an ID3v1 tag and no Xing header",
+			blob: append(testMP3(100, 0), append([]byte("TAG"), make([]byte, 125)...)...),
+			// The duration is estimated from the bitrate.
+			duration: samplesToDuration(100*417*8, 128000),
+		},
+		{
+			name:     "m4a",
+			blob:     testM4A(44100, 44100*3+22050),
+			duration: 3500 * time.Millisecond,
+		},
+		{
+			name: "ogg opus",
+			blob: bytes.Join([][]byte{
+				oggPage(7, 0, append([]byte("OpusHead\x01\x01"), binary.LittleEndian.AppendUint16(nil, 312)...)),
+				oggPage(7, 0, []byte("OpusTags")),
+				oggPage(7, 48000*2+312, make([]byte, 32)),
+				oggPage(7, 48000*4+312, make([]byte, 32)),
+				// A page without a packet ending in it has no granule position.
+				oggPage(7, -1, make([]byte, 32)),
+			}, nil),
+			duration: 4 * time.Second,
+		},
+		{
+			name: "ogg vorbis",
+			blob: bytes.Join([][]byte{
+				oggPage(3, 0, append([]byte("\x01vorbis\x00\x00\x00\x00\x02"), binary.LittleEndian.AppendUint32(nil, 44100)...)),
+				oggPage(3, 44100*90, make([]byte, 32)),
+				// The pages of other streams are skipped.
+				oggPage(4, 44100*200, make([]byte, 32)),
+			}, nil),
+			duration: 90 * time.Second,
+		},
+		{
+			name: "webm with a segment duration",
+			blob: bytes.Join([][]byte{
+				ebmlHeader,
+				ebmlElement(segment, false, ebmlElement(info, false,
+					ebmlElement([]byte{0x2A, 0xD7, 0xB1}, false, []byte{0x0f, 0x42, 0x40}),
+					ebmlElement([]byte{0x44, 0x89}, false, binary.BigEndian.AppendUint64(nil, math.Float64bits(12345))),
+				)),
+			}, nil),
+			duration: 12345 * time.Millisecond,
+		},
+		{
+			name: "webm recorded without a segment duration",
+			blob: bytes.Join([][]byte{
+				ebmlHeader,
+				ebmlElement(segment, true,
+					ebmlElement(info, false, ebmlElement([]byte{0x2A, 0xD7, 0xB1}, false, []byte{0x0f, 0x42, 0x40})),
+					ebmlElement(cluster, true, ebmlElement([]byte{ebmlTimecodeID}, false, []byte{0}), simpleBlock(0), simpleBlock(980)),
+					ebmlElement(cluster, true, ebmlElement([]byte{ebmlTimecodeID}, false, []byte{0x07, 0xd0}), simpleBlock(0), simpleBlock(1500)),
+				),
+			}, nil),
+			duration: 3500 * time.Millisecond,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duration, err := Duration(test.blob)
+			require.NoError(t, err)
+			require.Equal(t, test.duration, duration)
+		})
+	}
+}
+
+func TestDurationUnparseable(t *testing.T) {
+	m4a := testM4A(44100, 44100)
+	tests := []struct {
+		name string
+		blob []byte
+	}{
+		{name: "empty", blob: nil},
+		{name: "unknown format", blob: []byte("RIFF\x00\x00\x00\x00WAVE")},
+		{name: "truncated m4a", blob: m4a[:len(m4a)-40]},
+		{name: "ogg flac", blob: oggPage(1, 0, []byte("\x7fFLAC\x01\x00"))},
+		{name: "mp3 without frames", blob: []byte("ID3\x04\x00\x00\x00\x00\x00\x05hello")},
+		{name: "webm without blocks", blob: ebmlElement(ebmlMagic, false)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Duration(test.blob)
+			require.ErrorIs(t, err, ErrUnsupportedFormat)
+		})
+	}
+}
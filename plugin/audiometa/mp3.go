@@ -0,0 +1,188 @@
+package audiometa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	mpegVersion25 = 0
+	mpegVersion2  = 2
+	mpegVersion1  = 3
+
+	mpegLayer3 = 1
+	mpegLayer1 = 3
+
+	// id3v1TagSize is the size of the ID3v1 tag at the end of some MP3 files.
+	id3v1TagSize = 128
+	// xingFramesFlag is the flag of the Xing header telling the frame count is set.
+	xingFramesFlag = 0x1
+)
+
+// id3Magic starts the ID3v2 tag before the frames of most MP3 files.
+var id3Magic = []byte("ID3")
+
+// mpegBitrates are the bitrates in kbit/s by bitrate index, of the MPEG-1 layers 1, 2 and 3 then
+// of the MPEG-2 and 2.5 layers 1, and 2 and 3.
+var mpegBitrates = [5][15]uint32{
+	{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+	{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+	{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+	{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+}
+
+// mpegSampleRates are the sample rates by sample rate index of MPEG-1.
+var mpegSampleRates = [3]uint32{44100, 48000, 32000}
+
+// mpegFrame is the header of an MPEG audio frame.
+type mpegFrame struct {
+	version, layer  byte
+	bitrate         uint32
+	sampleRate      uint32
+	padding         bool
+	mono            bool
+	samplesPerFrame uint32
+}
+
+// size returns the size in bytes of the frame, header included.
+func (f mpegFrame) size() int {
+	if f.layer == mpegLayer1 {
+		size := 12 * f.bitrate / f.sampleRate
+		if f.padding {
+			size++
+		}
+		return int(size * 4)
+	}
+	size := f.samplesPerFrame / 8 * f.bitrate / f.sampleRate
+	if f.padding {
+		size++
+	}
+	return int(size)
+}
+
+// sideInfoSize returns the size of the side information following the header of a layer 3 frame,
+// after which the Xing header is.
+func (f mpegFrame) sideInfoSize() int {
+	switch {
+	case f.version == mpegVersion1 && !f.mono:
+		return 32
+	case f.version == mpegVersion1 || !f.mono:
+		return 17
+	default:
+		return 9
+	}
+}
+
+// parseMPEGFrame parses the MPEG audio frame header at the start of b.
+func parseMPEGFrame(b []byte) (mpegFrame, bool) {
+	if len(b) < 4 || b[0] != 0xff || b[1]&0xe0 != 0xe0 {
+		return mpegFrame{}, false
+	}
+	version, layer := (b[1]>>3)&0x3, (b[1]>>1)&0x3
+	bitrateIndex, sampleRateIndex := b[2]>>4, (b[2]>>2)&0x3
+	if version == 1 || layer == 0 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return mpegFrame{}, false
+	}
+	frame := mpegFrame{
+		version:    version,
+		layer:      layer,
+		sampleRate: mpegSampleRates[sampleRateIndex],
+		padding:    b[2]&0x2 != 0,
+		mono:       b[3]>>6 == 3,
+	}
+	var table int
+	switch {
+	case version == mpegVersion1:
+		table = int(mpegLayer1 - layer)
+	case layer == mpegLayer1:
+		table = 3
+	default:
+		table = 4
+	}
+	frame.bitrate = mpegBitrates[table][bitrateIndex] * 1000
+	switch version {
+	case mpegVersion2:
+		frame.sampleRate /= 2
+	case mpegVersion25:
+		frame.sampleRate /= 4
+	default:
+	}
+	switch {
+	case layer == mpegLayer1:
+		frame.samplesPerFrame = 384
+	case layer == mpegLayer3 && version != mpegVersion1:
+		frame.samplesPerFrame = 576
+	default:
+		frame.samplesPerFrame = 1152
+	}
+	return frame, true
+}
+
+// isMP3FrameHeader reports whether b starts with an MPEG audio frame header.
+func isMP3FrameHeader(b []byte) bool {
+	_, ok := parseMPEGFrame(b)
+	return ok
+}
+
+// readMP3Duration returns the duration of an MP3 file from the frame count of its Xing or VBRI
+// header, or estimated from its bitrate for constant bitrate files without one.
+func readMP3Duration(blob []byte) (time.Duration, error) {
+	offset := 0
+	if bytes.HasPrefix(blob, id3Magic) {
+		if len(blob) < 10 {
+			return 0, errors.Wrap(ErrUnsupportedFormat, "malformed ID3 tag")
+		}
+		// The tag size is a synchsafe integer, of 7 bits per byte.
+		size := int(blob[6]&0x7f)<<21 | int(blob[7]&0x7f)<<14 | int(blob[8]&0x7f)<<7 | int(blob[9]&0x7f)
+		offset = 10 + size
+		if blob[5]&0x10 != 0 {
+			// The tag has a footer.
+			offset += 10
+		}
+	}
+	end := len(blob)
+	if end-id3v1TagSize >= offset && string(blob[end-id3v1TagSize:end-id3v1TagSize+3]) == "TAG" {
+		end -= id3v1TagSize
+	}
+
+	// The first frame is the first frame header followed by another one, as the audio data may
+	// contain the frame sync by chance.
+	var frame mpegFrame
+	for ; offset+4 <= end; offset++ {
+		candidate, ok := parseMPEGFrame(blob[offset:end])
+		if !ok {
+			continue
+		}
+		next := offset + candidate.size()
+		if next+4 > end || isMP3FrameHeader(blob[next:end]) {
+			frame = candidate
+			break
+		}
+	}
+	if frame.sampleRate == 0 {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "no MPEG audio frame")
+	}
+
+	if frame.layer == mpegLayer3 {
+		xing := offset + 4 + frame.sideInfoSize()
+		if xing+12 <= end {
+			tag := string(blob[xing : xing+4])
+			if (tag == "Xing" || tag == "Info") && binary.BigEndian.Uint32(blob[xing+4:])&xingFramesFlag != 0 {
+				frames := binary.BigEndian.Uint32(blob[xing+8:])
+				return samplesToDuration(uint64(frames)*uint64(frame.samplesPerFrame), frame.sampleRate), nil
+			}
+		}
+		// The VBRI header is always 32 bytes after the frame header.
+		vbri := offset + 4 + 32
+		if vbri+18 <= end && string(blob[vbri:vbri+4]) == "VBRI" {
+			frames := binary.BigEndian.Uint32(blob[vbri+14:])
+			return samplesToDuration(uint64(frames)*uint64(frame.samplesPerFrame), frame.sampleRate), nil
+		}
+	}
+	// Without a frame count, the bitrate is assumed constant.
+	return samplesToDuration(uint64(end-offset)*8, frame.bitrate), nil
+}
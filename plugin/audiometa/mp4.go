@@ -0,0 +1,84 @@
+package audiometa
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// readMP4Duration returns the duration of an MP4 file, as M4A recordings are, from the timescale
+// and the duration of its movie header box.
+func readMP4Duration(blob []byte) (time.Duration, error) {
+	moov, ok, err := findMP4Box(blob, "moov")
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "no MP4 movie box")
+	}
+	mvhd, ok, err := findMP4Box(moov, "mvhd")
+	if err != nil {
+		return 0, err
+	}
+	// The movie header is a full box, with its version and flags first.
+	if !ok || len(mvhd) < 4 {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "no MP4 movie header box")
+	}
+	var timescale uint32
+	var duration uint64
+	switch mvhd[0] {
+	case 0:
+		if len(mvhd) < 20 {
+			return 0, errors.Wrap(ErrUnsupportedFormat, "malformed MP4 movie header box")
+		}
+		timescale = binary.BigEndian.Uint32(mvhd[12:])
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:]))
+		if duration == math.MaxUint32 {
+			duration = math.MaxUint64
+		}
+	case 1:
+		if len(mvhd) < 32 {
+			return 0, errors.Wrap(ErrUnsupportedFormat, "malformed MP4 movie header box")
+		}
+		timescale = binary.BigEndian.Uint32(mvhd[20:])
+		duration = binary.BigEndian.Uint64(mvhd[24:])
+	default:
+		return 0, errors.Wrap(ErrUnsupportedFormat, "unknown MP4 movie header box version")
+	}
+	// A duration of all ones is unknown.
+	if duration == math.MaxUint64 {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "unknown MP4 duration")
+	}
+	return samplesToDuration(duration, timescale), nil
+}
+
+// findMP4Box returns the content of the first box of the type among the boxes of b.
+func findMP4Box(b []byte, boxType string) ([]byte, bool, error) {
+	for offset := 0; offset < len(b); {
+		if offset+8 > len(b) {
+			return nil, false, errors.Wrap(ErrUnsupportedFormat, "malformed MP4 box")
+		}
+		size, headerSize := uint64(binary.BigEndian.Uint32(b[offset:])), uint64(8)
+		switch size {
+		case 0:
+			// The last box extends to the end of the file.
+			size = uint64(len(b) - offset)
+		case 1:
+			if offset+16 > len(b) {
+				return nil, false, errors.Wrap(ErrUnsupportedFormat, "malformed MP4 box")
+			}
+			size, headerSize = binary.BigEndian.Uint64(b[offset+8:]), 16
+		default:
+		}
+		if size < headerSize || size > uint64(len(b)-offset) {
+			return nil, false, errors.Wrap(ErrUnsupportedFormat, "malformed MP4 box size")
+		}
+		if string(b[offset+4:offset+8]) == boxType {
+			return b[offset+int(headerSize) : offset+int(size)], true, nil
+		}
+		offset += int(size)
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,67 @@
+package audiometa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// oggPageHeaderSize is the size of the header of an Ogg page, before its segment table.
+	oggPageHeaderSize = 27
+	// opusSampleRate is the sample rate of the granule positions of Opus streams, whatever the
+	// sample rate of the recording.
+	opusSampleRate = 48000
+)
+
+var (
+	// oggCapturePattern starts the pages of Ogg files.
+	oggCapturePattern = []byte("OggS")
+	vorbisHeader      = []byte("\x01vorbis")
+	opusHeader        = []byte("OpusHead")
+)
+
+// readOggDuration returns the duration of an Ogg Vorbis or Opus file from the granule position of
+// its last page, which is the number of samples of the stream up to the end of the page.
+func readOggDuration(blob []byte) (time.Duration, error) {
+	if len(blob) < oggPageHeaderSize {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "malformed Ogg page")
+	}
+	serial := binary.LittleEndian.Uint32(blob[14:])
+	segmentCount := int(blob[26])
+	packet := oggPageHeaderSize + segmentCount
+	if packet > len(blob) {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "malformed Ogg page")
+	}
+	// The first page holds the identification header of the stream.
+	var sampleRate uint32
+	var preSkip uint64
+	switch header := blob[packet:]; {
+	case bytes.HasPrefix(header, vorbisHeader) && len(header) >= 16:
+		sampleRate = binary.LittleEndian.Uint32(header[12:])
+	case bytes.HasPrefix(header, opusHeader) && len(header) >= 12:
+		sampleRate = opusSampleRate
+		preSkip = uint64(binary.LittleEndian.Uint16(header[10:]))
+	default:
+		return 0, errors.Wrap(ErrUnsupportedFormat, "unsupported Ogg codec")
+	}
+
+	// The last page of the stream is searched backwards, skipping the pages without a granule
+	// position, as the pages with no packet ending in them have none.
+	for offset := bytes.LastIndex(blob, oggCapturePattern); offset > 0; offset = bytes.LastIndex(blob[:offset], oggCapturePattern) {
+		if offset+oggPageHeaderSize > len(blob) || binary.LittleEndian.Uint32(blob[offset+14:]) != serial {
+			continue
+		}
+		granule := binary.LittleEndian.Uint64(blob[offset+6:])
+		if int64(granule) < 0 {
+			continue
+		}
+		if granule <= preSkip {
+			break
+		}
+		return samplesToDuration(granule-preSkip, sampleRate), nil
+	}
+	return 0, errors.Wrap(ErrUnsupportedFormat, "no Ogg page with a granule position")
+}
@@ -0,0 +1,150 @@
+package audiometa
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The IDs of the EBML elements used to read the duration of WebM files.
+const (
+	ebmlSegmentID       = 0x18538067
+	ebmlInfoID          = 0x1549A966
+	ebmlTimecodeScaleID = 0x2AD7B1
+	ebmlDurationID      = 0x4489
+	ebmlClusterID       = 0x1F43B675
+	ebmlTimecodeID      = 0xE7
+	ebmlBlockGroupID    = 0xA0
+	ebmlBlockID         = 0xA1
+	ebmlSimpleBlockID   = 0xA3
+
+	// defaultTimecodeScale is the duration in nanoseconds of the ticks of the timecodes.
+	defaultTimecodeScale = 1000000
+)
+
+// ebmlMagic is the ID of the EBML header starting WebM files.
+var ebmlMagic = []byte{0x1A, 0x45, 0xDF, 0xA3}
+
+// ebmlMasterIDs are the IDs of the EBML elements whose children are read.
+var ebmlMasterIDs = map[uint32]bool{
+	ebmlSegmentID:    true,
+	ebmlInfoID:       true,
+	ebmlClusterID:    true,
+	ebmlBlockGroupID: true,
+}
+
+// readWebMDuration returns the duration of a WebM file from the duration of its segment info, or
+// from the timecode of its last block. Browsers don't write the duration of MediaRecorder
+// recordings, as they're streamed, and their clusters have an unknown size.
+func readWebMDuration(blob []byte) (time.Duration, error) {
+	timecodeScale := uint64(defaultTimecodeScale)
+	var duration float64
+	var clusterTimecode, lastTimecode int64
+	// The elements are read in order, descending into the master elements without tracking their
+	// ends, so that the elements of unknown size are read too.
+	for offset := 0; offset < len(blob); {
+		id, idSize, ok := readEBMLID(blob[offset:])
+		if !ok {
+			break
+		}
+		size, sizeSize, ok := readEBMLSize(blob[offset+idSize:])
+		if !ok {
+			break
+		}
+		offset += idSize + sizeSize
+		if ebmlMasterIDs[id] {
+			continue
+		}
+		if size < 0 || size > int64(len(blob)-offset) {
+			// The last element of a truncated recording is dropped.
+			break
+		}
+		data := blob[offset : offset+int(size)]
+		offset += int(size)
+		switch id {
+		case ebmlTimecodeScaleID:
+			timecodeScale = readEBMLUint(data)
+		case ebmlDurationID:
+			switch len(data) {
+			case 4:
+				duration = float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+			case 8:
+				duration = math.Float64frombits(binary.BigEndian.Uint64(data))
+			default:
+			}
+		case ebmlTimecodeID:
+			clusterTimecode = int64(readEBMLUint(data))
+			lastTimecode = max(lastTimecode, clusterTimecode)
+		case ebmlSimpleBlockID, ebmlBlockID:
+			// The block timecode is relative to the cluster, after the track number.
+			_, trackSize, ok := readEBMLSize(data)
+			if ok && trackSize+2 <= len(data) {
+				lastTimecode = max(lastTimecode, clusterTimecode+int64(int16(binary.BigEndian.Uint16(data[trackSize:]))))
+			}
+		default:
+		}
+	}
+	if timecodeScale == 0 {
+		return 0, errors.Wrap(ErrUnsupportedFormat, "malformed WebM timecode scale")
+	}
+	if duration > 0 && !math.IsInf(duration, 0) {
+		return time.Duration(duration * float64(timecodeScale)), nil
+	}
+	return time.Duration(lastTimecode) * time.Duration(timecodeScale), nil
+}
+
+// readEBMLID reads the variable-size ID of an EBML element, length marker included.
+func readEBMLID(b []byte) (uint32, int, bool) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0, false
+	}
+	length := 1
+	for mask := byte(0x80); b[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > 4 || length > len(b) {
+		return 0, 0, false
+	}
+	var id uint32
+	for _, c := range b[:length] {
+		id = id<<8 | uint32(c)
+	}
+	return id, length, true
+}
+
+// readEBMLSize reads the variable-size integer of the size of an EBML element, length marker
+// excluded, which is -1 for elements of unknown size.
+func readEBMLSize(b []byte) (int64, int, bool) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0, false
+	}
+	length := 1
+	mask := byte(0x80)
+	for ; b[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > len(b) {
+		return 0, 0, false
+	}
+	value := uint64(b[0] & (mask - 1))
+	unknown := value == uint64(mask-1)
+	for _, c := range b[1:length] {
+		value = value<<8 | uint64(c)
+		unknown = unknown && c == 0xff
+	}
+	if unknown || value > math.MaxInt64 {
+		return -1, length, true
+	}
+	return int64(value), length, true
+}
+
+// readEBMLUint reads the big-endian unsigned integer of an EBML element.
+func readEBMLUint(b []byte) uint64 {
+	var value uint64
+	for _, c := range b {
+		value = value<<8 | uint64(c)
+	}
+	return value
+}
@@ -0,0 +1,205 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// timeout bounds connecting to the SMTP server and sending a message.
+const timeout = 30 * time.Second
+
+// ErrNotConfigured is returned when sending email without an SMTP server configured.
+var ErrNotConfigured = errors.New("email is not configured")
+
+// Message is an email with a plain text body, and optionally an HTML alternative of it.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+	// HTMLBody is the HTML version of Body. If set, the email has both parts and clients pick one.
+	HTMLBody string
+	// UnsubscribeURL is the URL of the List-Unsubscribe header of the email, which also accepts
+	// one-click unsubscribe POST requests (RFC 8058).
+	UnsubscribeURL string
+}
+
+// Sender sends emails with the instance email setting.
+type Sender interface {
+	Send(ctx context.Context, config *storepb.InstanceEmailSetting, message *Message) error
+}
+
+// IsConfigured reports whether the email setting has an SMTP server and a sender address.
+func IsConfigured(config *storepb.InstanceEmailSetting) bool {
+	return config.GetSmtpHost() != "" && config.GetFromEmail() != ""
+}
+
+// SMTPSender sends emails over SMTP.
+type SMTPSender struct{}
+
+// NewSMTPSender creates a new SMTP sender.
+func NewSMTPSender() *SMTPSender {
+	return &SMTPSender{}
+}
+
+// Send sends the message, securing the connection as the TLS mode of the setting says. Without a
+// TLS mode, implicit TLS is used if use_tls is set, and STARTTLS if the server offers it
+// otherwise. Credentials are only sent over TLS, or to localhost.
+func (*SMTPSender) Send(ctx context.Context, config *storepb.InstanceEmailSetting, message *Message) error {
+	if !IsConfigured(config) {
+		return ErrNotConfigured
+	}
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return errors.Wrapf(err, "invalid recipient %q", message.To)
+	}
+	data, err := buildMessage(config, to, message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	host := config.SmtpHost
+	addr := net.JoinHostPort(host, strconv.Itoa(int(config.SmtpPort)))
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", addr)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	tlsMode := config.TlsMode
+	if tlsMode == storepb.InstanceEmailSetting_TLS_MODE_UNSPECIFIED && config.UseTls {
+		tlsMode = storepb.InstanceEmailSetting_TLS
+	}
+	if tlsMode == storepb.InstanceEmailSetting_TLS {
+		conn = tls.Client(conn, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to start SMTP session")
+	}
+	defer client.Close()
+
+	if tlsMode == storepb.InstanceEmailSetting_STARTTLS || tlsMode == storepb.InstanceEmailSetting_TLS_MODE_UNSPECIFIED {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+				return errors.Wrap(err, "failed to start TLS")
+			}
+		} else if tlsMode == storepb.InstanceEmailSetting_STARTTLS {
+			return errors.New("the SMTP server doesn't support STARTTLS")
+		}
+	}
+	if config.SmtpUsername != "" {
+		// PlainAuth refuses to send credentials over unencrypted connections, except to localhost.
+		if err := client.Auth(smtp.PlainAuth("", config.SmtpUsername, config.SmtpPassword, host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+	if err := client.Mail(config.FromEmail); err != nil {
+		return errors.Wrap(err, "failed to set sender")
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return errors.Wrap(err, "failed to set recipient")
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start message")
+	}
+	if _, err := writer.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to send message")
+	}
+	return client.Quit()
+}
+
+// buildMessage formats the message with its headers. The bodies are quoted-printable encoded, in
+// a multipart/alternative body if the message has an HTML body.
+func buildMessage(config *storepb.InstanceEmailSetting, to *mail.Address, message *Message) ([]byte, error) {
+	if strings.ContainsAny(message.Subject, "\r\n") {
+		return nil, errors.New("subject must not contain line breaks")
+	}
+	if strings.ContainsAny(message.UnsubscribeURL, "\r\n<>") {
+		return nil, errors.New("invalid unsubscribe URL")
+	}
+	from := &mail.Address{Name: config.FromName, Address: config.FromEmail}
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+	}
+	if message.UnsubscribeURL != "" {
+		headers = append(headers, [2]string{"List-Unsubscribe", "<" + message.UnsubscribeURL + ">"}, [2]string{"List-Unsubscribe-Post", "List-Unsubscribe=One-Click"})
+	}
+	if message.HTMLBody == "" {
+		headers = append(headers, [2]string{"Content-Type", `text/plain; charset="utf-8"`}, [2]string{"Content-Transfer-Encoding", "quoted-printable"})
+		writeHeaders(&buf, headers)
+		if err := writeQuotedPrintable(&buf, message.Body); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	writer := multipart.NewWriter(&buf)
+	headers = append(headers, [2]string{"Content-Type", `multipart/alternative; boundary="` + writer.Boundary() + `"`})
+	writeHeaders(&buf, headers)
+	// Clients show the last part they support, so the HTML part comes last.
+	for _, part := range [][2]string{{"text/plain", message.Body}, {"text/html", message.HTMLBody}} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part[0] + `; charset="utf-8"`},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create message part")
+		}
+		if err := writeQuotedPrintable(partWriter, part[1]); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close message parts")
+	}
+	return buf.Bytes(), nil
+}
+
+func writeHeaders(buf *bytes.Buffer, headers [][2]string) {
+	for _, header := range headers {
+		buf.WriteString(header[0] + ": " + header[1] + "\r\n")
+	}
+	buf.WriteString("\r\n")
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	writer := quotedprintable.NewWriter(w)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		return errors.Wrap(err, "failed to encode message body")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to encode message body")
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// fakeSMTPServer accepts one SMTP session and records the envelope and message.
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	to       []string
+	data     string
+	done     chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeSMTPServer{listener: listener, done: make(chan struct{})}
+	go server.serve()
+	t.Cleanup(func() { listener.Close() })
+	return server
+}
+
+func (s *fakeSMTPServer) port(t *testing.T) int32 {
+	addr, ok := s.listener.Addr().(*net.TCPAddr)
+	require.True(t, ok)
+	return int32(addr.Port)
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = io.WriteString(conn, line+"\r\n")
+	}
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			s.from = strings.Trim(strings.TrimPrefix(command, "MAIL FROM:"), "<>")
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.to = append(s.to, strings.Trim(strings.TrimPrefix(command, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.data = data.String()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeSMTPServer(t)
+	config := &storepb.InstanceEmailSetting{
+		SmtpHost:  "127.0.0.1",
+		SmtpPort:  server.port(t),
+		FromEmail: "memos@example.com",
+		FromName:  "Memos",
+	}
+
+	err := NewSMTPSender().Send(ctx, config, &Message{
+		To:      "Jane Doe <jane@example.com>",
+		Subject: "Réinitialiser le mot de passe",
+		Body:    "Open https://memos.example.com/auth/reset-password?token=abc to reset your password.",
+	})
+	require.NoError(t, err)
+	<-server.done
+
+	require.Equal(t, "memos@example.com", server.from)
+	require.Equal(t, []string{"jane@example.com"}, server.to)
+	message, err := mail.ReadMessage(strings.NewReader(server.data))
+	require.NoError(t, err)
+	require.Equal(t, `"Memos" <memos@example.com>`, message.Header.Get("From"))
+	require.Equal(t, `"Jane Doe" <jane@example.com>`, message.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Réinitialiser le mot de passe", subject)
+	body, err := io.ReadAll(quotedprintable.NewReader(message.Body))
+	require.NoError(t, err)
+	require.Equal(t, "Open https://memos.example.com/auth/reset-password?token=abc to reset your password.", strings.TrimSpace(string(body)))
+}
+
+func TestSMTPSenderMultipart(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeSMTPServer(t)
+	config := &storepb.InstanceEmailSetting{
+		SmtpHost:  "127.0.0.1",
+		SmtpPort:  server.port(t),
+		FromEmail: "memos@example.com",
+	}
+
+	err := NewSMTPSender().Send(ctx, config, &Message{
+		To:             "jane@example.com",
+		Subject:        "New comment",
+		Body:           "John commented on your memo.",
+		HTMLBody:       "<p>John commented on your memo.</p>",
+		UnsubscribeURL: "https://memos.example.com/email/unsubscribe?token=abc",
+	})
+	require.NoError(t, err)
+	<-server.done
+
+	message, err := mail.ReadMessage(strings.NewReader(server.data))
+	require.NoError(t, err)
+	require.Equal(t, "<https://memos.example.com/email/unsubscribe?token=abc>", message.Header.Get("List-Unsubscribe"))
+	require.Equal(t, "List-Unsubscribe=One-Click", message.Header.Get("List-Unsubscribe-Post"))
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	parts := map[string]string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part)
+		require.NoError(t, err)
+		parts[part.Header.Get("Content-Type")] = strings.TrimSpace(string(body))
+	}
+	require.Equal(t, map[string]string{
+		`text/plain; charset="utf-8"`: "John commented on your memo.",
+		`text/html; charset="utf-8"`:  "<p>John commented on your memo.</p>",
+	}, parts)
+}
+
+func TestSMTPSenderRequiresSTARTTLS(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeSMTPServer(t)
+	config := &storepb.InstanceEmailSetting{
+		SmtpHost:  "127.0.0.1",
+		SmtpPort:  server.port(t),
+		FromEmail: "memos@example.com",
+		TlsMode:   storepb.InstanceEmailSetting_STARTTLS,
+	}
+
+	err := NewSMTPSender().Send(ctx, config, &Message{To: "jane@example.com", Subject: "Hello", Body: "Hello"})
+	require.ErrorContains(t, err, "doesn't support STARTTLS")
+	<-server.done
+	require.Empty(t, server.data)
+}
+
+func TestSMTPSenderRejectsInvalidMessages(t *testing.T) {
+	ctx := context.Background()
+	config := &storepb.InstanceEmailSetting{
+		SmtpHost:  "127.0.0.1",
+		SmtpPort:  1,
+		FromEmail: "memos@example.com",
+	}
+
+	err := NewSMTPSender().Send(ctx, &storepb.InstanceEmailSetting{}, &Message{To: "jane@example.com"})
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	err = NewSMTPSender().Send(ctx, config, &Message{To: "jane@example.com\r\nBcc: eve@example.com"})
+	require.ErrorContains(t, err, "invalid recipient")
+
+	err = NewSMTPSender().Send(ctx, config, &Message{To: "jane@example.com", Subject: "Hello\r\nBcc: eve@example.com"})
+	require.ErrorContains(t, err, "line breaks")
+}
+
+func TestIsConfigured(t *testing.T) {
+	require.False(t, IsConfigured(nil))
+	require.False(t, IsConfigured(&storepb.InstanceEmailSetting{SmtpHost: "smtp.example.com"}))
+	require.False(t, IsConfigured(&storepb.InstanceEmailSetting{FromEmail: "memos@example.com"}))
+	require.True(t, IsConfigured(&storepb.InstanceEmailSetting{SmtpHost: "smtp.example.com", SmtpPort: 587, FromEmail: "memos@example.com"}))
+}
@@ -81,6 +81,9 @@ type RenderOptions struct {
 	Dialect           DialectName
 	PlaceholderOffset int
 	DisableNullChecks bool
+	// ViewerID is the user the filter is evaluated for, or 0 if anonymous. Location conditions
+	// only match the locations of the viewer's memos and the locations shown publicly.
+	ViewerID int32
 }
 
 // Statement contains the rendered SQL fragment and its args.
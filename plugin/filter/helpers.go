@@ -6,12 +6,11 @@ import (
 )
 
 // AppendConditions compiles the provided filters and appends the resulting SQL fragments and args.
-func AppendConditions(ctx context.Context, engine *Engine, filters []string, dialect DialectName, where *[]string, args *[]any) error {
+// The placeholder offset of opts is set from args.
+func AppendConditions(ctx context.Context, engine *Engine, filters []string, opts RenderOptions, where *[]string, args *[]any) error {
 	for _, filterStr := range filters {
-		stmt, err := engine.CompileToStatement(ctx, filterStr, RenderOptions{
-			Dialect:           dialect,
-			PlaceholderOffset: len(*args),
-		})
+		opts.PlaceholderOffset = len(*args)
+		stmt, err := engine.CompileToStatement(ctx, filterStr, opts)
 		if err != nil {
 			return err
 		}
@@ -81,6 +81,27 @@ type ContainsCondition struct {
 
 func (*ContainsCondition) isCondition() {}
 
+// LocationBoxCondition models location_within_box(south, west, north, east), which matches the
+// memo locations within the box. The box crosses the antimeridian if West is greater than East.
+type LocationBoxCondition struct {
+	South float64
+	West  float64
+	North float64
+	East  float64
+}
+
+func (*LocationBoxCondition) isCondition() {}
+
+// LocationRadiusCondition models location_within_radius(latitude, longitude, radius_km), which
+// matches the memo locations within RadiusKm kilometers of the point.
+type LocationRadiusCondition struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+func (*LocationRadiusCondition) isCondition() {}
+
 // ConstantCondition captures a literal boolean outcome.
 type ConstantCondition struct {
 	Value bool
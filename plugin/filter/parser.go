@@ -92,6 +92,8 @@ func buildCallCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error
 		return buildInCondition(call, schema)
 	case "contains":
 		return buildContainsCondition(call, schema)
+	case "location_within_box", "location_within_radius":
+		return buildLocationCondition(call)
 	default:
 		val, ok, err := evaluateBool(call)
 		if err != nil {
@@ -230,6 +232,57 @@ func buildContainsCondition(call *exprv1.Expr_Call, schema Schema) (Condition, e
 	}, nil
 }
 
+func buildLocationCondition(call *exprv1.Expr_Call) (Condition, error) {
+	values := make([]float64, 0, len(call.Args))
+	for _, arg := range call.Args {
+		literal, err := getConstValue(arg)
+		if err != nil {
+			return nil, errors.Errorf("%s only supports numeric literal arguments", call.Function)
+		}
+		switch v := literal.(type) {
+		case int64:
+			values = append(values, float64(v))
+		case float64:
+			values = append(values, v)
+		default:
+			return nil, errors.Errorf("%s only supports numeric literal arguments", call.Function)
+		}
+	}
+
+	if call.Function == "location_within_box" {
+		if len(values) != 4 {
+			return nil, errors.New("location_within_box expects south, west, north and east")
+		}
+		south, west, north, east := values[0], values[1], values[2], values[3]
+		if !isValidLatitude(south) || !isValidLatitude(north) || !isValidLongitude(west) || !isValidLongitude(east) {
+			return nil, errors.New("location_within_box coordinates are out of range")
+		}
+		if south > north {
+			return nil, errors.New("location_within_box south must not be greater than north")
+		}
+		return &LocationBoxCondition{South: south, West: west, North: north, East: east}, nil
+	}
+	if len(values) != 3 {
+		return nil, errors.New("location_within_radius expects latitude, longitude and radius in kilometers")
+	}
+	latitude, longitude, radiusKm := values[0], values[1], values[2]
+	if !isValidLatitude(latitude) || !isValidLongitude(longitude) {
+		return nil, errors.New("location_within_radius coordinates are out of range")
+	}
+	if !(radiusKm > 0) {
+		return nil, errors.New("location_within_radius radius must be positive")
+	}
+	return &LocationRadiusCondition{Latitude: latitude, Longitude: longitude, RadiusKm: radiusKm}, nil
+}
+
+func isValidLatitude(value float64) bool {
+	return value >= -90 && value <= 90
+}
+
+func isValidLongitude(value float64) bool {
+	return value >= -180 && value <= 180
+}
+
 func buildValueExpr(expr *exprv1.Expr, schema Schema) (ValueExpr, error) {
 	if identName, err := getIdentName(expr); err == nil {
 		if _, ok := schema.Field(identName); !ok {
@@ -2,9 +2,12 @@ package filter
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/util"
 )
 
 type renderer struct {
@@ -12,6 +15,7 @@ type renderer struct {
 	dialect            DialectName
 	placeholderOffset  int
 	placeholderCounter int
+	viewerID           int32
 	args               []any
 }
 
@@ -26,6 +30,7 @@ func newRenderer(schema Schema, opts RenderOptions) *renderer {
 		schema:            schema,
 		dialect:           opts.Dialect,
 		placeholderOffset: opts.PlaceholderOffset,
+		viewerID:          opts.ViewerID,
 	}
 }
 
@@ -74,6 +79,10 @@ func (r *renderer) renderCondition(cond Condition) (renderResult, error) {
 		return r.renderElementInCondition(c)
 	case *ContainsCondition:
 		return r.renderContainsCondition(c)
+	case *LocationBoxCondition:
+		return r.renderLocationBoxCondition(c)
+	case *LocationRadiusCondition:
+		return r.renderLocationRadiusCondition(c)
 	case *ConstantCondition:
 		if c.Value {
 			return renderResult{trivial: true}, nil
@@ -139,6 +148,8 @@ func (r *renderer) renderFieldPredicate(cond *FieldPredicateCondition) (renderRe
 			return renderResult{}, err
 		}
 		return renderResult{sql: sql}, nil
+	case FieldKindSubquery:
+		return renderResult{sql: field.columnExpr(r.dialect)}, nil
 	default:
 		return renderResult{}, errors.Errorf("field %q cannot be used as a predicate", cond.Field)
 	}
@@ -156,6 +167,8 @@ func (r *renderer) renderComparison(cond *ComparisonCondition) (renderResult, er
 			return r.renderBoolColumnComparison(field, cond.Operator, cond.Right)
 		case FieldKindJSONBool:
 			return r.renderJSONBoolComparison(field, cond.Operator, cond.Right)
+		case FieldKindSubquery:
+			return r.renderSubqueryComparison(field, cond.Operator, cond.Right)
 		case FieldKindScalar:
 			return r.renderScalarComparison(field, cond.Operator, cond.Right)
 		default:
@@ -242,6 +255,23 @@ func (r *renderer) renderBoolColumnComparison(field Field, op ComparisonOperator
 	}, nil
 }
 
+func (r *renderer) renderSubqueryComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
+	value, err := expectBool(right)
+	if err != nil {
+		return renderResult{}, err
+	}
+	expr := field.columnExpr(r.dialect)
+	switch op {
+	case CompareEq, CompareNeq:
+		if value == (op == CompareEq) {
+			return renderResult{sql: expr}, nil
+		}
+		return renderResult{sql: fmt.Sprintf("NOT (%s)", expr)}, nil
+	default:
+		return renderResult{}, errors.Errorf("operator %s not supported for field %q", op, field.Name)
+	}
+}
+
 func (r *renderer) renderJSONBoolComparison(field Field, op ComparisonOperator, right ValueExpr) (renderResult, error) {
 	value, err := expectBool(right)
 	if err != nil {
@@ -335,6 +365,7 @@ func (r *renderer) renderTagInList(values []ValueExpr) (renderResult, error) {
 		if !ok {
 			return renderResult{}, errors.New("tags must be compared with string literals")
 		}
+		str = util.NormalizeTag(str)
 
 		switch r.dialect {
 		case DialectSQLite:
@@ -385,6 +416,7 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 	if !ok {
 		return renderResult{}, errors.New("tags membership requires string literal")
 	}
+	str = util.NormalizeTag(str)
 
 	switch r.dialect {
 	case DialectSQLite:
@@ -450,13 +482,80 @@ func (r *renderer) renderContainsCondition(cond *ContainsCondition) (renderResul
 	}
 }
 
+// earthRadiusKm is the mean radius of the Earth used by location radius conditions.
+const earthRadiusKm = 6371.0
+
+func (r *renderer) renderLocationBoxCondition(cond *LocationBoxCondition) (renderResult, error) {
+	latitude, longitude, guard, err := r.locationExprs()
+	if err != nil {
+		return renderResult{}, err
+	}
+	sql := fmt.Sprintf("%s AND %s >= %s AND %s <= %s", guard, latitude, r.addArg(cond.South), latitude, r.addArg(cond.North))
+	if cond.West > cond.East {
+		// The box crosses the antimeridian.
+		sql += fmt.Sprintf(" AND (%s >= %s OR %s <= %s)", longitude, r.addArg(cond.West), longitude, r.addArg(cond.East))
+	} else {
+		sql += fmt.Sprintf(" AND %s >= %s AND %s <= %s", longitude, r.addArg(cond.West), longitude, r.addArg(cond.East))
+	}
+	return renderResult{sql: fmt.Sprintf("(%s)", sql)}, nil
+}
+
+// renderLocationRadiusCondition matches the haversine term of the distance against its value for
+// the radius, computed here so that the dialects only need trigonometric functions. The latitude
+// range of the radius is matched first, which is cheaper.
+func (r *renderer) renderLocationRadiusCondition(cond *LocationRadiusCondition) (renderResult, error) {
+	latitude, longitude, guard, err := r.locationExprs()
+	if err != nil {
+		return renderResult{}, err
+	}
+	angle := cond.RadiusKm / earthRadiusKm
+	delta := angle * 180 / math.Pi
+	threshold := math.Pow(math.Sin(math.Min(angle/2, math.Pi/2)), 2)
+	sql := fmt.Sprintf("%s AND %s >= %s AND %s <= %s", guard,
+		latitude, r.addArg(math.Max(cond.Latitude-delta, -90)),
+		latitude, r.addArg(math.Min(cond.Latitude+delta, 90)),
+	)
+	sql += fmt.Sprintf(" AND POWER(SIN(RADIANS(%s - %s) / 2), 2) + COS(RADIANS(%s)) * COS(RADIANS(%s)) * POWER(SIN(RADIANS(%s - %s) / 2), 2) <= %s",
+		latitude, r.addArg(cond.Latitude),
+		r.addArg(cond.Latitude), latitude,
+		longitude, r.addArg(cond.Longitude),
+		r.addArg(threshold),
+	)
+	return renderResult{sql: fmt.Sprintf("(%s)", sql)}, nil
+}
+
+// locationExprs returns the latitude and longitude of the memo location, and the condition that
+// the memo has a location shown to the viewer. The zero coordinates are omitted from the payload.
+func (r *renderer) locationExprs() (string, string, string, error) {
+	latitudeField := Field{Column: Column{Table: "memo", Name: "payload"}, JSONPath: []string{"location", "latitude"}}
+	longitudeField := Field{Column: Column{Table: "memo", Name: "payload"}, JSONPath: []string{"location", "longitude"}}
+	latitude, longitude := jsonExtractExpr(r.dialect, latitudeField), jsonExtractExpr(r.dialect, longitudeField)
+	switch r.dialect {
+	case DialectSQLite:
+	case DialectMySQL:
+		latitude, longitude = fmt.Sprintf("CAST(%s AS DOUBLE)", latitude), fmt.Sprintf("CAST(%s AS DOUBLE)", longitude)
+	case DialectPostgres:
+		latitude, longitude = fmt.Sprintf("(%s)::double precision", latitude), fmt.Sprintf("(%s)::double precision", longitude)
+	default:
+		return "", "", "", errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+	showPublicly, err := r.jsonBoolPredicate(Field{Column: Column{Table: "memo", Name: "payload"}, JSONPath: []string{"location", "showPublicly"}})
+	if err != nil {
+		return "", "", "", err
+	}
+	creator := qualifyColumn(r.dialect, Column{Table: "memo", Name: "creator_id"})
+	guard := fmt.Sprintf("(%s IS NOT NULL OR %s IS NOT NULL) AND (%s OR %s = %s)", latitude, longitude, showPublicly, creator, r.addArg(r.viewerID))
+	return fmt.Sprintf("COALESCE(%s, 0)", latitude), fmt.Sprintf("COALESCE(%s, 0)", longitude), guard, nil
+}
+
 func (r *renderer) jsonBoolPredicate(field Field) (string, error) {
 	expr := jsonExtractExpr(r.dialect, field)
 	switch r.dialect {
 	case DialectSQLite:
 		return fmt.Sprintf("%s IS TRUE", expr), nil
 	case DialectMySQL:
-		return fmt.Sprintf("%s = CAST('true' AS JSON)", expr), nil
+		// The null-safe comparison is false for missing flags, so that negated predicates match them.
+		return fmt.Sprintf("%s <=> CAST('true' AS JSON)", expr), nil
 	case DialectPostgres:
 		return fmt.Sprintf("(%s)::boolean IS TRUE", expr), nil
 	default:
@@ -37,6 +37,9 @@ const (
 	FieldKindJSONBool     FieldKind = "json_bool"
 	FieldKindJSONList     FieldKind = "json_list"
 	FieldKindVirtualAlias FieldKind = "virtual_alias"
+	// FieldKindSubquery is a boolean computed by the dialect expression of the field, an EXISTS
+	// subquery on its column.
+	FieldKindSubquery FieldKind = "subquery"
 )
 
 // Column identifies the backing table column.
@@ -97,6 +100,22 @@ var nowFunction = cel.Function("now",
 	),
 )
 
+// The location functions are only rendered to SQL, so they have no bindings. Their arguments are
+// numeric literals, either integers or doubles.
+var locationWithinBoxFunction = cel.Function("location_within_box",
+	cel.Overload("location_within_box_dyn",
+		[]*cel.Type{cel.DynType, cel.DynType, cel.DynType, cel.DynType},
+		cel.BoolType,
+	),
+)
+
+var locationWithinRadiusFunction = cel.Function("location_within_radius",
+	cel.Overload("location_within_radius_dyn",
+		[]*cel.Type{cel.DynType, cel.DynType, cel.DynType},
+		cel.BoolType,
+	),
+)
+
 // NewSchema constructs the memo filter schema and CEL environment.
 func NewSchema() Schema {
 	fields := map[string]Field{
@@ -150,6 +169,26 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		// publish_ts is when a scheduled memo is published, 0 once it is published.
+		"publish_ts": {
+			Name:        "publish_ts",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeInt,
+			Column:      Column{Table: "memo", Name: "publish_ts"},
+			Expressions: map[DialectName]string{},
+		},
+		// row_status is the state of a memo: NORMAL, ARCHIVED or DRAFT.
+		"row_status": {
+			Name:        "row_status",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeString,
+			Column:      Column{Table: "memo", Name: "row_status"},
+			Expressions: map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 		"visibility": {
 			Name:        "visibility",
 			Kind:        FieldKindScalar,
@@ -161,12 +200,13 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		// Tags are matched by their normalized form, so tag filters are case- and accent-insensitive.
 		"tags": {
 			Name:     "tags",
 			Kind:     FieldKindJSONList,
 			Type:     FieldTypeString,
 			Column:   Column{Table: "memo", Name: "payload"},
-			JSONPath: []string{"tags"},
+			JSONPath: []string{"normalizedTags"},
 		},
 		"tag": {
 			Name:     "tag",
@@ -218,6 +258,22 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		// has_attachment is whether any attachment belongs to the memo.
+		"has_attachment": {
+			Name:   "has_attachment",
+			Kind:   FieldKindSubquery,
+			Type:   FieldTypeBool,
+			Column: Column{Table: "memo", Name: "id"},
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = %s)",
+				DialectMySQL:    "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = %s)",
+				DialectPostgres: "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = %s)",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 	}
 
 	envOptions := []cel.EnvOption{
@@ -226,6 +282,8 @@ func NewSchema() Schema {
 		cel.Variable("created_ts", cel.IntType),
 		cel.Variable("updated_ts", cel.IntType),
 		cel.Variable("pinned", cel.BoolType),
+		cel.Variable("publish_ts", cel.IntType),
+		cel.Variable("row_status", cel.StringType),
 		cel.Variable("tag", cel.StringType),
 		cel.Variable("tags", cel.ListType(cel.StringType)),
 		cel.Variable("visibility", cel.StringType),
@@ -233,7 +291,10 @@ func NewSchema() Schema {
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
 		cel.Variable("has_incomplete_tasks", cel.BoolType),
+		cel.Variable("has_attachment", cel.BoolType),
 		nowFunction,
+		locationWithinBoxFunction,
+		locationWithinRadiusFunction,
 	}
 
 	return Schema{
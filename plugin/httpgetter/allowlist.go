@@ -0,0 +1,108 @@
+package httpgetter
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// carrierGradeNATPrefix is shared address space, used by VPNs such as Tailscale for LAN hosts.
+	carrierGradeNATPrefix = netip.MustParsePrefix("100.64.0.0/10")
+	// metadataPrefixes are cloud metadata endpoints outside the link-local range, which
+	// can never be allowlisted.
+	metadataPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("100.100.100.200/32"),
+		netip.MustParsePrefix("fd00:ec2::254/128"),
+	}
+)
+
+// InternalAllowlist lists private hosts that fetches may reach despite the internal IP validation,
+// e.g. a self-hosted Gitea on the LAN. Only private (RFC 1918, IPv6 ULA) and carrier-grade NAT
+// addresses can be allowed; loopback, link-local and cloud metadata addresses are always rejected.
+type InternalAllowlist struct {
+	hosts    []string
+	prefixes []netip.Prefix
+}
+
+// ParseInternalAllowlist parses allowlist entries, each a hostname, an IP address or a CIDR range.
+// A hostname matches the addresses it resolves to, and a leading "*." matches any subdomain.
+func ParseInternalAllowlist(entries []string) (*InternalAllowlist, error) {
+	allowlist := &InternalAllowlist{}
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			allowlist.prefixes = append(allowlist.prefixes, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			addr = addr.Unmap()
+			allowlist.prefixes = append(allowlist.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		host := strings.TrimSuffix(entry, ".")
+		if strings.ContainsAny(host, "/:@ ") || strings.TrimPrefix(host, "*.") == "" || strings.Contains(strings.TrimPrefix(host, "*."), "*") {
+			return nil, errors.Errorf("invalid internal host %q", entry)
+		}
+		allowlist.hosts = append(allowlist.hosts, host)
+	}
+	return allowlist, nil
+}
+
+// allows reports whether host may be fetched at the internal address ip.
+func (a *InternalAllowlist) allows(host string, ip net.IP) bool {
+	if a == nil {
+		return false
+	}
+	addr, err := normalizeIP(ip)
+	if err != nil || !isAllowlistable(addr) {
+		return false
+	}
+	for _, prefix := range a.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, pattern := range a.hosts {
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// isAllowlistable reports whether addr is an internal address that an InternalAllowlist may permit.
+func isAllowlistable(addr netip.Addr) bool {
+	for _, prefix := range metadataPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return addr.IsPrivate() || carrierGradeNATPrefix.Contains(addr)
+}
+
+type internalAllowlistContextKey struct{}
+
+// WithInternalAllowlist returns a context whose fetches may reach the internal hosts on allowlist.
+// The allowlist is checked after DNS resolution and for every redirect hop.
+func WithInternalAllowlist(ctx context.Context, allowlist *InternalAllowlist) context.Context {
+	return context.WithValue(ctx, internalAllowlistContextKey{}, allowlist)
+}
+
+func internalAllowlistFromContext(ctx context.Context) *InternalAllowlist {
+	if allowlist, ok := ctx.Value(internalAllowlistContextKey{}).(*InternalAllowlist); ok {
+		return allowlist
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package httpgetter
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInternalAllowlist(t *testing.T) {
+	allowlist, err := ParseInternalAllowlist([]string{" gitea.lan ", "*.home.arpa", "192.168.1.0/24", "10.0.0.5", "fd12::/16", ""})
+	require.NoError(t, err)
+	require.Equal(t, []string{"gitea.lan", "*.home.arpa"}, allowlist.hosts)
+	require.Len(t, allowlist.prefixes, 3)
+
+	for _, entry := range []string{"http://gitea.lan", "gitea.lan:3000", "*.", "a.*.lan", "10.0.0.0/33"} {
+		_, err := ParseInternalAllowlist([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
+
+func TestInternalAllowlistAllows(t *testing.T) {
+	allowlist, err := ParseInternalAllowlist([]string{"gitea.lan", "*.home.arpa", "192.168.1.0/24", "0.0.0.0/0", "::/0"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		host  string
+		ip    string
+		allow bool
+	}{
+		{host: "gitea.lan", ip: "10.1.2.3", allow: true},
+		{host: "GITEA.LAN.", ip: "10.1.2.3", allow: true},
+		{host: "wiki.home.arpa", ip: "172.16.0.4", allow: true},
+		{host: "192.168.1.10", ip: "192.168.1.10", allow: true},
+		{host: "tailnet.example", ip: "100.101.102.103", allow: true},
+		{host: "ula.example", ip: "fd12::1", allow: true},
+		{host: "mapped.example", ip: "::ffff:192.168.1.10", allow: true},
+		// Loopback, link-local and metadata addresses can never be allowlisted.
+		{host: "gitea.lan", ip: "127.0.0.1", allow: false},
+		{host: "gitea.lan", ip: "::1", allow: false},
+		{host: "gitea.lan", ip: "169.254.169.254", allow: false},
+		{host: "gitea.lan", ip: "100.100.100.200", allow: false},
+		{host: "gitea.lan", ip: "fd00:ec2::254", allow: false},
+		{host: "gitea.lan", ip: "0.0.0.0", allow: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.allow, allowlist.allows(test.host, net.ParseIP(test.ip)), "%s %s", test.host, test.ip)
+	}
+
+	var empty *InternalAllowlist
+	require.False(t, empty.allows("gitea.lan", net.ParseIP("10.1.2.3")))
+}
+
+func TestDialerInternalAllowlist(t *testing.T) {
+	dialer := &Dialer{
+		Resolver: resolverFunc(func(_ context.Context, host string) ([]net.IPAddr, error) {
+			if host == "gitea.lan" {
+				return []net.IPAddr{{IP: net.ParseIP("192.168.1.10")}}, nil
+			}
+			return []net.IPAddr{{IP: net.ParseIP("192.168.2.10")}}, nil
+		}),
+		dial: func(context.Context, string, string) (net.Conn, error) {
+			client, server := net.Pipe()
+			server.Close()
+			return client, nil
+		},
+	}
+	allowlist, err := ParseInternalAllowlist([]string{"gitea.lan"})
+	require.NoError(t, err)
+	ctx := WithInternalAllowlist(context.Background(), allowlist)
+
+	// Without the allowlist the private address is rejected.
+	_, err = dialer.DialContext(context.Background(), "tcp", "gitea.lan:443")
+	require.ErrorIs(t, err, ErrInternalIP)
+
+	conn, err := dialer.DialContext(ctx, "tcp", "gitea.lan:443")
+	require.NoError(t, err)
+	conn.Close()
+
+	_, err = dialer.DialContext(ctx, "tcp", "other.lan:443")
+	require.ErrorIs(t, err, ErrInternalIP)
+
+	// A pooled connection to an allowlisted host is re-validated for every request.
+	useProxy(t, "")
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://gitea.lan/", nil)
+	require.NoError(t, err)
+	_, err = dialer.proxy(req)
+	require.ErrorIs(t, err, ErrInternalIP)
+	_, err = dialer.proxy(req.WithContext(ctx))
+	require.NoError(t, err)
+}
+
+func TestValidateURLInternalAllowlist(t *testing.T) {
+	allowlist, err := ParseInternalAllowlist([]string{"192.168.1.0/24"})
+	require.NoError(t, err)
+	ctx := WithInternalAllowlist(context.Background(), allowlist)
+
+	require.ErrorIs(t, validateURL(context.Background(), "http://192.168.1.10/"), ErrInternalIP)
+	require.NoError(t, validateURL(ctx, "http://192.168.1.10/"))
+	require.ErrorIs(t, validateURL(ctx, "http://127.0.0.1/"), ErrInternalIP)
+
+	// Redirect hops are held to the same allowlist.
+	via := newRedirectChain(t, "http://203.0.113.1/")
+	req := newRedirectChain(t, "http://192.168.1.10/")[0]
+	require.ErrorIs(t, checkRedirect(req, via), ErrInternalIP)
+	require.NoError(t, checkRedirect(req.WithContext(ctx), via))
+	req = newRedirectChain(t, "http://169.254.169.254/latest/meta-data/")[0].WithContext(ctx)
+	require.ErrorIs(t, checkRedirect(req, via), ErrInternalIP)
+}
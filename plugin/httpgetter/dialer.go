@@ -0,0 +1,177 @@
+package httpgetter
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// blockedPrefixes are ranges rejected in addition to loopback, private and link-local addresses.
+var blockedPrefixes = []netip.Prefix{
+	// "This network", only valid as a source address.
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// Carrier-grade NAT, also used for cloud metadata services (e.g. 100.100.100.200).
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// IETF protocol assignments.
+	netip.MustParsePrefix("192.0.0.0/24"),
+	// Benchmarking.
+	netip.MustParsePrefix("198.18.0.0/15"),
+	// Reserved and limited broadcast.
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// nat64Prefix is the well-known NAT64 prefix, which embeds an IPv4 address in its last 32 bits.
+var nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+// ValidateIP rejects addresses that outbound fetches must never connect to: loopback,
+// private (RFC 1918 and IPv6 ULA), link-local (including the 169.254.169.254 metadata endpoint),
+// multicast, unspecified and other reserved ranges. IPv4-mapped and NAT64 IPv6 addresses are
+// checked against the IPv4 address they embed.
+func ValidateIP(ip net.IP) error {
+	addr, err := normalizeIP(ip)
+	if err != nil {
+		return err
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return errors.Wrap(ErrInternalIP, addr.String())
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return errors.Wrap(ErrInternalIP, addr.String())
+		}
+	}
+	return nil
+}
+
+// normalizeIP converts ip to the address it designates, unwrapping IPv4-mapped and NAT64 IPv6 addresses.
+func normalizeIP(ip net.IP) (netip.Addr, error) {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return netip.Addr{}, errors.Errorf("invalid IP address %q", ip.String())
+	}
+	addr = addr.Unmap()
+	if addr.Is6() && nat64Prefix.Contains(addr) {
+		bytes := addr.As16()
+		addr = netip.AddrFrom4([4]byte(bytes[12:]))
+	}
+	return addr, nil
+}
+
+// Resolver looks up the IP addresses of a host. *net.Resolver satisfies it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// Dialer connects only to addresses accepted by ValidateIP.
+// The host is resolved once per connection and the validated IP is dialed directly,
+// so a DNS rebinding between validation and connect cannot redirect the connection.
+type Dialer struct {
+	// Resolver is used to look up hostnames. Defaults to net.DefaultResolver.
+	Resolver Resolver
+
+	// dial connects to a validated IP address. Defaults to a net.Dialer; overridden in tests.
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// allowlisted records the addresses connected to through an InternalAllowlist. Pooled
+	// connections to them are re-validated per request, as the allowlist may have changed.
+	allowlisted sync.Map
+}
+
+// DialContext resolves the host of address, rejects it if any of its addresses is internal,
+// and connects to the first address that accepts the connection.
+// Configured proxies are dialed without validation; see SetProxy.
+func (d *Dialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	dial := d.dial
+	if dial == nil {
+		dial = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext
+	}
+	if loadProxyConfig().addrs[address] {
+		return dial(ctx, network, address)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ips, allowlisted, err := d.resolve(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if allowlisted {
+		d.allowlisted.Store(address, true)
+	}
+
+	var lastErr error
+	for _, ip := range ips {
+		conn, err := dial(ctx, network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// resolve looks up the addresses of host and fails if any of them is rejected by ValidateIP,
+// unless the InternalAllowlist bound to ctx permits it. The returned bool reports whether
+// an internal address was allowed, which is logged for auditing.
+func (d *Dialer) resolve(ctx context.Context, host string) ([]net.IP, bool, error) {
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		resolver := d.Resolver
+		if resolver == nil {
+			resolver = net.DefaultResolver
+		}
+		addrs, err := resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to resolve hostname %s", host)
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, false, errors.Errorf("no addresses found for %s", host)
+	}
+
+	allowlist := internalAllowlistFromContext(ctx)
+	allowlisted := false
+	for _, ip := range ips {
+		err := ValidateIP(ip)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, ErrInternalIP) || !allowlist.allows(host, ip) {
+			return nil, false, errors.Wrapf(err, "host=%s", host)
+		}
+		slog.Warn("fetching allowlisted internal address", slog.String("host", host), slog.String("ip", ip.String()))
+		allowlisted = true
+	}
+	return ips, allowlisted, nil
+}
+
+// NewTransport returns an http.Transport whose connections are made through a Dialer,
+// for any outbound request to a user-supplied URL. Requests are sent through the proxy
+// configured with SetProxy.
+func NewTransport() *http.Transport {
+	dialer := &Dialer{}
+	transport := &http.Transport{
+		Proxy:                 dialer.proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	return transport
+}
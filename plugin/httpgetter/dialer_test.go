@@ -0,0 +1,97 @@
+package httpgetter
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIP(t *testing.T) {
+	blocked := []string{
+		"127.0.0.1",
+		"10.1.2.3",
+		"172.16.0.1",
+		"192.168.1.10",
+		"169.254.169.254",
+		"100.100.100.200",
+		"0.0.0.0",
+		"224.0.0.1",
+		"::1",
+		"::",
+		"fc00::1",
+		"fd00:ec2::254",
+		"fe80::1",
+		"::ffff:127.0.0.1",
+		"::ffff:169.254.169.254",
+		"64:ff9b::a00:1",
+	}
+	for _, ip := range blocked {
+		require.ErrorIs(t, ValidateIP(net.ParseIP(ip)), ErrInternalIP, ip)
+	}
+
+	allowed := []string{"203.0.113.1", "8.8.8.8", "2001:4860:4860::8888", "::ffff:8.8.8.8"}
+	for _, ip := range allowed {
+		require.NoError(t, ValidateIP(net.ParseIP(ip)), ip)
+	}
+}
+
+// rebindingResolver answers with a public address first and a private address afterwards.
+type rebindingResolver struct {
+	calls int
+}
+
+func (r *rebindingResolver) LookupIPAddr(_ context.Context, _ string) ([]net.IPAddr, error) {
+	r.calls++
+	if r.calls == 1 {
+		return []net.IPAddr{{IP: net.ParseIP("203.0.113.1")}}, nil
+	}
+	return []net.IPAddr{{IP: net.ParseIP("10.0.0.1")}}, nil
+}
+
+func TestDialerValidatesEveryConnection(t *testing.T) {
+	var dialed []string
+	dialer := &Dialer{
+		Resolver: &rebindingResolver{},
+		dial: func(_ context.Context, _, address string) (net.Conn, error) {
+			dialed = append(dialed, address)
+			client, server := net.Pipe()
+			server.Close()
+			return client, nil
+		},
+	}
+
+	conn, err := dialer.DialContext(context.Background(), "tcp", "rebind.example:80")
+	require.NoError(t, err)
+	conn.Close()
+	require.Equal(t, []string{"203.0.113.1:80"}, dialed)
+
+	// The second lookup rebinds to a private address, which must not be connected to.
+	_, err = dialer.DialContext(context.Background(), "tcp", "rebind.example:80")
+	require.ErrorIs(t, err, ErrInternalIP)
+	require.Equal(t, []string{"203.0.113.1:80"}, dialed)
+}
+
+func TestDialerRejectsMixedAnswers(t *testing.T) {
+	dialer := &Dialer{
+		Resolver: resolverFunc(func(context.Context, string) ([]net.IPAddr, error) {
+			return []net.IPAddr{{IP: net.ParseIP("203.0.113.1")}, {IP: net.ParseIP("::ffff:192.168.0.1")}}, nil
+		}),
+		dial: func(context.Context, string, string) (net.Conn, error) {
+			t.Fatal("must not dial")
+			return nil, nil
+		},
+	}
+	_, err := dialer.DialContext(context.Background(), "tcp", "mixed.example:443")
+	require.ErrorIs(t, err, ErrInternalIP)
+
+	_, err = dialer.DialContext(context.Background(), "tcp", "[::1]:443")
+	require.ErrorIs(t, err, ErrInternalIP)
+}
+
+type resolverFunc func(ctx context.Context, host string) ([]net.IPAddr, error)
+
+func (f resolverFunc) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return f(ctx, host)
+}
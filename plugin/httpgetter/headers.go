@@ -0,0 +1,96 @@
+package httpgetter
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http/httpguts"
+)
+
+const (
+	// DefaultUserAgent is sent until SetUserAgent is called.
+	DefaultUserAgent = "memos-linkpreview"
+	// MaxRequestHeaders is the maximum number of extra headers accepted by ValidateRequestHeaders.
+	MaxRequestHeaders = 10
+)
+
+// forbiddenRequestHeaders carry credentials or control the connection, so they are never forwarded.
+var forbiddenRequestHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Host":                true,
+	"Connection":          true,
+	"Content-Length":      true,
+	"Transfer-Encoding":   true,
+	"Te":                  true,
+	"Upgrade":             true,
+	"Keep-Alive":          true,
+	"Trailer":             true,
+}
+
+var userAgent atomic.Pointer[string]
+
+// SetUserAgent sets the User-Agent sent with every fetch, e.g. "memos-linkpreview/0.25 (+https://memos.example.com)".
+func SetUserAgent(ua string) {
+	userAgent.Store(&ua)
+}
+
+func currentUserAgent() string {
+	if ua := userAgent.Load(); ua != nil {
+		return *ua
+	}
+	return DefaultUserAgent
+}
+
+// ValidateRequestHeaders checks headers configured by an admin for outbound fetches.
+// Credentials and hop-by-hop headers are rejected, as is User-Agent, which has its own override.
+func ValidateRequestHeaders(headers map[string]string) error {
+	if len(headers) > MaxRequestHeaders {
+		return errors.Errorf("at most %d request headers are allowed", MaxRequestHeaders)
+	}
+	for name, value := range headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return errors.Errorf("invalid header name %q", name)
+		}
+		canonical := http.CanonicalHeaderKey(name)
+		if forbiddenRequestHeaders[canonical] {
+			return errors.Errorf("header %q is not allowed", canonical)
+		}
+		if canonical == "User-Agent" {
+			return errors.New("set the user agent instead of a User-Agent header")
+		}
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return errors.Errorf("invalid value for header %q", canonical)
+		}
+	}
+	return nil
+}
+
+// ValidateUserAgent checks a User-Agent override configured by an admin.
+func ValidateUserAgent(ua string) error {
+	if !httpguts.ValidHeaderFieldValue(ua) {
+		return errors.New("invalid user agent")
+	}
+	return nil
+}
+
+type requestHeadersContextKey struct{}
+
+// WithRequestHeaders returns a context whose fetches send headers, which override the default
+// User-Agent when they set one. The headers are assumed to be validated.
+func WithRequestHeaders(ctx context.Context, headers http.Header) context.Context {
+	return context.WithValue(ctx, requestHeadersContextKey{}, headers)
+}
+
+// setRequestHeaders applies the User-Agent and the headers bound to the request's context.
+func setRequestHeaders(request *http.Request) {
+	request.Header.Set("User-Agent", currentUserAgent())
+	if headers, ok := request.Context().Value(requestHeadersContextKey{}).(http.Header); ok {
+		for name, values := range headers {
+			request.Header[name] = values
+		}
+	}
+}
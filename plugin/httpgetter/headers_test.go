@@ -0,0 +1,64 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+)
+
+func TestValidateRequestHeaders(t *testing.T) {
+	require.NoError(t, ValidateRequestHeaders(nil))
+	require.NoError(t, ValidateRequestHeaders(map[string]string{"Accept-Language": "en-US,en;q=0.9", "DNT": "1"}))
+
+	for _, headers := range []map[string]string{
+		{"Authorization": "Bearer secret"},
+		{"cookie": "session=1"},
+		{"Proxy-Authorization": "Basic Zm9v"},
+		{"Host": "example.com"},
+		{"User-Agent": "curl/8.0"},
+		{"Bad Name": "1"},
+		{"X-Injected": "a\r\nCookie: session=1"},
+	} {
+		require.Error(t, ValidateRequestHeaders(headers), headers)
+	}
+
+	tooMany := map[string]string{}
+	for i := range MaxRequestHeaders + 1 {
+		tooMany["X-Header-"+string(rune('a'+i))] = "1"
+	}
+	require.Error(t, ValidateRequestHeaders(tooMany))
+
+	require.NoError(t, ValidateUserAgent("Mozilla/5.0 (compatible; memos)"))
+	require.Error(t, ValidateUserAgent("memos\nX-Injected: 1"))
+}
+
+func TestRequestHeaders(t *testing.T) {
+	var received http.Header
+	DialForTest(t, testutil.ServeAt(t, func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = io.WriteString(w, "ok")
+	}))
+	previous := userAgent.Load()
+	t.Cleanup(func() { userAgent.Store(previous) })
+	SetUserAgent("memos-linkpreview/1.2 (+https://memos.example.com)")
+
+	response, err := GetRaw(context.Background(), "http://203.0.113.1/", RawOptions{})
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	require.Equal(t, "memos-linkpreview/1.2 (+https://memos.example.com)", received.Get("User-Agent"))
+
+	headers := http.Header{}
+	headers.Set("User-Agent", "Mozilla/5.0 (compatible; memos)")
+	headers.Set("Accept-Language", "de-DE")
+	ctx := WithRequestHeaders(context.Background(), headers)
+	response, err = GetRaw(ctx, "http://203.0.113.1/", RawOptions{})
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	require.Equal(t, "Mozilla/5.0 (compatible; memos)", received.Get("User-Agent"))
+	require.Equal(t, "de-DE", received.Get("Accept-Language"))
+}
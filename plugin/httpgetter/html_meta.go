@@ -1,73 +1,192 @@
 package httpgetter
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"mime"
 	"net"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
 )
 
 var ErrInternalIP = errors.New("internal IP addresses are not allowed")
 
-var httpClient = &http.Client{
-	CheckRedirect: func(req *http.Request, via []*http.Request) error {
-		if err := validateURL(req.URL.String()); err != nil {
-			return errors.Wrap(err, "redirect to internal IP")
-		}
-		if len(via) >= 10 {
-			return errors.New("too many redirects")
-		}
-		return nil
-	},
-}
-
 type HTMLMeta struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Image       string `json:"image"`
+	// Favicon is the absolute URL of the site icon.
+	Favicon string `json:"favicon"`
+	// OEmbedURL is the oEmbed endpoint discovered via <link rel="alternate" type="application/json+oembed">.
+	OEmbedURL string `json:"oembedUrl"`
+	// FinalURL is the URL the page was served from after following redirects.
+	FinalURL string `json:"finalUrl"`
+	// ContentType is the media type of the resource, e.g. "text/html" or "application/pdf".
+	ContentType string `json:"contentType"`
+	// ContentLength and Filename are only set for non-HTML resources.
+	ContentLength int64  `json:"contentLength,omitempty"`
+	Filename      string `json:"filename,omitempty"`
+	// Attempts is the number of requests the fetch needed, including retries.
+	Attempts int `json:"-"`
 }
 
-func GetHTMLMeta(urlStr string) (*HTMLMeta, error) {
-	if err := validateURL(urlStr); err != nil {
+// GetHTMLMeta fetches the page at urlStr and extracts its metadata.
+// The fetch is bound to ctx, at most DefaultMaxHTMLSize bytes are read and parsing
+// stops at </head>. Deadline and size violations are reported as ErrTimeout and
+// ErrResponseTooLarge respectively.
+// Resources that are not HTML pages are described from their response headers instead,
+// see getFileMeta. Transient failures are retried with DefaultRetryPolicy.
+func GetHTMLMeta(ctx context.Context, urlStr string) (*HTMLMeta, error) {
+	response, err := GetRaw(ctx, urlStr, RawOptions{MaxBytes: DefaultMaxHTMLSize, Retry: DefaultRetryPolicy})
+	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
+
+	mediatype := response.Mediatype
+	if mediatype != "text/html" && mediatype != "application/xhtml+xml" {
+		fileMeta := getFileMeta(response.URL, response.Header, response.ContentLength, mediatype)
+		fileMeta.Attempts = response.Attempts
+		return fileMeta, nil
+	}
 
-	response, err := httpClient.Get(urlStr)
+	body, err := decodeHTML(response.Body, response.Header.Get("Content-Type"))
 	if err != nil {
-		return nil, err
+		return nil, &AttemptError{Attempts: response.Attempts, Err: err}
 	}
-	defer response.Body.Close()
+	htmlMeta, err := extractHTMLMeta(body)
+	if err != nil {
+		return nil, &AttemptError{Attempts: response.Attempts, Err: err}
+	}
+	htmlMeta.Attempts = response.Attempts
+	htmlMeta.ContentType = mediatype
+	htmlMeta.FinalURL = response.URL.String()
+	enrichSiteMeta(response.URL, htmlMeta)
+	resolveImageURL(response.URL, htmlMeta)
+	htmlMeta.OEmbedURL = resolveURL(response.URL, htmlMeta.OEmbedURL)
+	htmlMeta.Favicon = resolveFavicon(ctx, response.URL, htmlMeta.Favicon)
+	return htmlMeta, nil
+}
 
-	mediatype, err := getMediatype(response)
+// sniffMediatype detects the media type from the first bytes of the body.
+// The returned reader yields the whole body, including the sniffed bytes.
+func sniffMediatype(body io.Reader) (string, io.Reader, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", nil, wrapTimeout(err)
+	}
+	head = head[:n]
+	mediatype, _, err := mime.ParseMediaType(http.DetectContentType(head))
 	if err != nil {
-		return nil, err
+		return "", nil, err
+	}
+	return mediatype, io.MultiReader(bytes.NewReader(head), body), nil
+}
+
+// getFileMeta describes a non-HTML resource from its response headers.
+// The filename comes from Content-Disposition or the last path segment of the final URL,
+// and images use their own URL as the preview image.
+func getFileMeta(finalURL *url.URL, header http.Header, contentLength int64, mediatype string) *HTMLMeta {
+	meta := &HTMLMeta{
+		FinalURL:    finalURL.String(),
+		ContentType: mediatype,
+		Filename:    extractFilename(finalURL, header),
+	}
+	if contentLength > 0 {
+		meta.ContentLength = contentLength
 	}
-	if mediatype != "text/html" {
-		return nil, errors.New("not a HTML page")
+	meta.Title = meta.Filename
+	if strings.HasPrefix(mediatype, "image/") {
+		meta.Image = finalURL.String()
 	}
+	sanitizeHTMLMeta(meta)
+	return meta
+}
 
-	// TODO: limit the size of the response body
+func extractFilename(finalURL *url.URL, header http.Header) string {
+	if disposition := header.Get("Content-Disposition"); disposition != "" {
+		if _, params, err := mime.ParseMediaType(disposition); err == nil && params["filename"] != "" {
+			return path.Base(params["filename"])
+		}
+	}
+	filename := path.Base(finalURL.Path)
+	if filename == "/" || filename == "." {
+		return ""
+	}
+	return filename
+}
 
-	htmlMeta := extractHTMLMeta(response.Body)
-	enrichSiteMeta(response.Request.URL, htmlMeta)
-	resolveImageURL(response.Request.URL, htmlMeta)
-	return htmlMeta, nil
+// decodeHTML transcodes the page to UTF-8. The charset is taken from the Content-Type
+// header, a BOM, or the <meta charset> / <meta http-equiv="Content-Type"> tags.
+func decodeHTML(r io.Reader, contentType string) (io.Reader, error) {
+	reader, err := charset.NewReader(r, contentType)
+	if err != nil {
+		return nil, wrapTimeout(err)
+	}
+	return reader, nil
+}
+
+// metaCandidates holds the metadata found from a single source.
+type metaCandidates struct {
+	title       string
+	description string
+	image       string
 }
 
-func extractHTMLMeta(resp io.Reader) *HTMLMeta {
+// set stores the value for the field unless one was already found, so the first occurrence wins.
+func (c *metaCandidates) set(field, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	var target *string
+	switch field {
+	case "title":
+		target = &c.title
+	case "description":
+		target = &c.description
+	case "image":
+		target = &c.image
+	default:
+		return
+	}
+	if *target == "" {
+		*target = value
+	}
+}
+
+// extractHTMLMeta parses metadata from the document head.
+// Reading stops at </head> or <body>; read errors other than EOF are returned.
+// Fields are taken with the precedence Open Graph > Twitter Card > JSON-LD > <title>/<meta name="description">.
+func extractHTMLMeta(resp io.Reader) (*HTMLMeta, error) {
 	tokenizer := html.NewTokenizer(resp)
 	htmlMeta := new(HTMLMeta)
+	var og, twitter, jsonLD, basic metaCandidates
+	// Prefer the regular icon links over apple-touch-icon.
+	var icon, touchIcon string
 
 	for {
 		tokenType := tokenizer.Next()
 		if tokenType == html.ErrorToken {
+			if err := tokenizer.Err(); !errors.Is(err, io.EOF) {
+				return nil, err
+			}
 			break
+		} else if tokenType == html.EndTagToken {
+			// Metadata lives in the head, no need to read the rest of the document.
+			if tokenizer.Token().DataAtom == atom.Head {
+				break
+			}
 		} else if tokenType == html.StartTagToken || tokenType == html.SelfClosingTagToken {
 			token := tokenizer.Token()
 			if token.DataAtom == atom.Body {
@@ -75,50 +194,172 @@ func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 			}
 
 			if token.DataAtom == atom.Title {
-				tokenizer.Next()
-				token := tokenizer.Token()
-				htmlMeta.Title = token.Data
+				if tokenizer.Next() == html.TextToken {
+					basic.set("title", tokenizer.Token().Data)
+				}
 			} else if token.DataAtom == atom.Meta {
-				description, ok := extractMetaProperty(token, "description")
-				if ok {
-					htmlMeta.Description = description
+				key, content, ok := extractMetaTag(token)
+				if !ok {
+					continue
 				}
-
-				ogTitle, ok := extractMetaProperty(token, "og:title")
-				if ok {
-					htmlMeta.Title = ogTitle
+				switch {
+				case key == "description":
+					basic.set("description", content)
+				case strings.HasPrefix(key, "og:"):
+					og.set(strings.TrimPrefix(key, "og:"), content)
+				case strings.HasPrefix(key, "twitter:"):
+					twitter.set(strings.TrimPrefix(key, "twitter:"), content)
+				default:
 				}
-
-				ogDescription, ok := extractMetaProperty(token, "og:description")
-				if ok {
-					htmlMeta.Description = ogDescription
+			} else if token.DataAtom == atom.Script {
+				if !isJSONLDScript(token) || tokenizer.Next() != html.TextToken {
+					continue
 				}
-
-				ogImage, ok := extractMetaProperty(token, "og:image")
-				if ok {
-					htmlMeta.Image = ogImage
+				extractJSONLD(tokenizer.Token().Data, &jsonLD)
+			} else if token.DataAtom == atom.Link {
+				if href, ok := extractOEmbedLink(token); ok {
+					htmlMeta.OEmbedURL = href
+				}
+				if href, rel, ok := extractIconLink(token); ok {
+					if rel == "apple-touch-icon" {
+						if touchIcon == "" {
+							touchIcon = href
+						}
+					} else if icon == "" {
+						icon = href
+					}
 				}
 			}
 		}
 	}
 
-	return htmlMeta
+	htmlMeta.Title = firstNonEmpty(og.title, twitter.title, jsonLD.title, basic.title)
+	htmlMeta.Description = firstNonEmpty(og.description, twitter.description, jsonLD.description, basic.description)
+	htmlMeta.Image = firstNonEmpty(og.image, twitter.image, jsonLD.image)
+	htmlMeta.Favicon = firstNonEmpty(icon, touchIcon)
+	sanitizeHTMLMeta(htmlMeta)
+	return htmlMeta, nil
 }
 
-func extractMetaProperty(token html.Token, prop string) (content string, ok bool) {
-	content, ok = "", false
-	for _, attr := range token.Attr {
-		if attr.Key == "property" && attr.Val == prop {
-			ok = true
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
 		}
-		if attr.Key == "content" {
+	}
+	return ""
+}
+
+// sanitizeHTMLMeta replaces invalid UTF-8 sequences so the metadata always encodes to valid JSON.
+func sanitizeHTMLMeta(meta *HTMLMeta) {
+	for _, field := range []*string{&meta.Title, &meta.Description, &meta.Image, &meta.Favicon, &meta.OEmbedURL, &meta.Filename} {
+		*field = strings.ToValidUTF8(*field, "\uFFFD")
+	}
+}
+
+// extractMetaTag returns the lowercased property or name of a meta tag along with its content.
+// Both attributes are accepted since sites mix them up for Open Graph and Twitter Cards.
+func extractMetaTag(token html.Token) (key string, content string, ok bool) {
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(attr.Val))
+			}
+		case "content":
 			content = attr.Val
+		default:
+		}
+	}
+	if key == "" {
+		return "", "", false
+	}
+	// twitter:image:src is a legacy alias of twitter:image.
+	if key == "twitter:image:src" {
+		key = "twitter:image"
+	}
+	return key, content, true
+}
+
+// extractOEmbedLink returns the href of a JSON oEmbed discovery link.
+func extractOEmbedLink(token html.Token) (href string, ok bool) {
+	var rel, linkType string
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "rel":
+			rel = strings.ToLower(attr.Val)
+		case "type":
+			linkType = strings.ToLower(attr.Val)
+		case "href":
+			href = attr.Val
+		default:
+		}
+	}
+	if rel != "alternate" || linkType != "application/json+oembed" || href == "" {
+		return "", false
+	}
+	return href, true
+}
+
+// extractIconLink returns the href of an icon link along with its normalized rel
+// ("icon" or "apple-touch-icon").
+func extractIconLink(token html.Token) (href string, rel string, ok bool) {
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "rel":
+			rel = strings.ToLower(strings.TrimSpace(attr.Val))
+		case "href":
+			href = strings.TrimSpace(attr.Val)
+		default:
+		}
+	}
+	if href == "" {
+		return "", "", false
+	}
+	switch rel {
+	case "icon", "shortcut icon":
+		return href, "icon", true
+	case "apple-touch-icon", "apple-touch-icon-precomposed":
+		return href, "apple-touch-icon", true
+	default:
+		return "", "", false
+	}
+}
+
+// resolveFavicon returns the absolute favicon URL for the page.
+// Relative hrefs are resolved against the final page URL, data: URIs are rejected,
+// and when the page declares no usable icon we fall back to /favicon.ico if it exists.
+func resolveFavicon(ctx context.Context, pageURL *url.URL, href string) string {
+	if pageURL == nil {
+		return ""
+	}
+	if href != "" && !strings.HasPrefix(strings.ToLower(href), "data:") {
+		favicon := resolveURL(pageURL, href)
+		if validateURL(ctx, favicon) == nil {
+			return favicon
 		}
 	}
-	return content, ok
+
+	fallback := pageURL.ResolveReference(&url.URL{Path: "/favicon.ico"}).String()
+	if err := validateURL(ctx, fallback); err != nil {
+		return ""
+	}
+	response, err := doRequest(ctx, http.MethodHead, fallback)
+	if err != nil {
+		return ""
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return ""
+	}
+	return fallback
 }
 
-func validateURL(urlStr string) error {
+// validateURL checks the scheme and host of urlStr and rejects internal IP literals early,
+// unless they are on the InternalAllowlist bound to ctx.
+// Hostnames are checked by the Dialer when the connection is made, which is not subject to
+// DNS rebinding between the check and the connect.
+func validateURL(ctx context.Context, urlStr string) error {
 	u, err := url.Parse(urlStr)
 	if err != nil {
 		return errors.New("invalid URL format")
@@ -133,26 +374,13 @@ func validateURL(urlStr string) error {
 		return errors.New("empty hostname")
 	}
 
-	// check if the hostname is an IP
 	if ip := net.ParseIP(host); ip != nil {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
-			return errors.Wrap(ErrInternalIP, ip.String())
+		err := ValidateIP(ip)
+		if err != nil && errors.Is(err, ErrInternalIP) && internalAllowlistFromContext(ctx).allows(host, ip) {
+			return nil
 		}
-		return nil
+		return err
 	}
-
-	// check if it's a hostname, resolve it and check all returned IPs
-	ips, err := net.LookupIP(host)
-	if err != nil {
-		return errors.Errorf("failed to resolve hostname: %v", err)
-	}
-
-	for _, ip := range ips {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
-			return errors.Wrapf(ErrInternalIP, "host=%s, ip=%s", host, ip.String())
-		}
-	}
-
 	return nil
 }
 
@@ -167,6 +395,18 @@ func enrichSiteMeta(url *url.URL, meta *HTMLMeta) {
 	}
 }
 
+// resolveURL makes rawURL absolute using the page URL if it was relative.
+func resolveURL(pageURL *url.URL, rawURL string) string {
+	if pageURL == nil || rawURL == "" {
+		return rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return pageURL.ResolveReference(u).String()
+}
+
 // resolveImageURL makes meta.Image absolute using the page URL if it was relative.
 func resolveImageURL(pageURL *url.URL, meta *HTMLMeta) {
 	if pageURL == nil || meta.Image == "" {
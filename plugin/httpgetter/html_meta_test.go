@@ -1,8 +1,17 @@
 package httpgetter
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/require"
 )
@@ -13,7 +22,7 @@ func TestGetHTMLMeta(t *testing.T) {
 		htmlMeta HTMLMeta
 	}{}
 	for _, test := range tests {
-		metadata, err := GetHTMLMeta(test.urlStr)
+		metadata, err := GetHTMLMeta(context.Background(), test.urlStr)
 		require.NoError(t, err)
 		require.Equal(t, test.htmlMeta, *metadata)
 	}
@@ -21,12 +30,141 @@ func TestGetHTMLMeta(t *testing.T) {
 
 func TestGetHTMLMetaForInternal(t *testing.T) {
 	// test for internal IP
-	if _, err := GetHTMLMeta("http://192.168.0.1"); !errors.Is(err, ErrInternalIP) {
+	if _, err := GetHTMLMeta(context.Background(), "http://192.168.0.1"); !errors.Is(err, ErrInternalIP) {
 		t.Errorf("Expected error for internal IP, got %v", err)
 	}
 
 	// test for resolved internal IP
-	if _, err := GetHTMLMeta("http://localhost"); !errors.Is(err, ErrInternalIP) {
+	if _, err := GetHTMLMeta(context.Background(), "http://localhost"); !errors.Is(err, ErrInternalIP) {
 		t.Errorf("Expected error for resolved internal IP, got %v", err)
 	}
 }
+
+func TestExtractHTMLMetaFavicon(t *testing.T) {
+	tests := []struct {
+		page    string
+		favicon string
+	}{
+		{
+			page:    `<head><link rel="apple-touch-icon" href="/touch.png"><link rel="icon" href="/icon.png"></head>`,
+			favicon: "/icon.png",
+		},
+		{
+			page:    `<head><link rel="Shortcut Icon" href="favicon.png"></head>`,
+			favicon: "favicon.png",
+		},
+		{
+			page:    `<head><link rel="apple-touch-icon" href="/touch.png"></head>`,
+			favicon: "/touch.png",
+		},
+		{
+			page:    `<head><link rel="stylesheet" href="/style.css"></head>`,
+			favicon: "",
+		},
+	}
+	for _, test := range tests {
+		meta, err := extractHTMLMeta(strings.NewReader(test.page))
+		require.NoError(t, err)
+		require.Equal(t, test.favicon, meta.Favicon)
+	}
+}
+
+func TestResolveFaviconRejectsUnsafeURLs(t *testing.T) {
+	pageURL, err := url.Parse("http://127.0.0.1/page")
+	require.NoError(t, err)
+	// Internal hosts are rejected for both the declared icon and the /favicon.ico fallback.
+	require.Empty(t, resolveFavicon(context.Background(), pageURL, "/icon.png"))
+	require.Empty(t, resolveFavicon(context.Background(), pageURL, "data:image/png;base64,AAAA"))
+}
+
+func TestExtractHTMLMetaStopsAtHead(t *testing.T) {
+	// Anything after </head> must not be read, even if it is unreadable.
+	page := io.MultiReader(
+		strings.NewReader(`<html><head><meta property="og:title" content="Title"></head>`),
+		iotest.ErrReader(errors.New("should not be read")),
+	)
+	meta, err := extractHTMLMeta(page)
+	require.NoError(t, err)
+	require.Equal(t, "Title", meta.Title)
+}
+
+func TestExtractHTMLMetaTooLarge(t *testing.T) {
+	page := "<html><head><title>T</title>" + strings.Repeat("<!-- padding -->", 1024)
+	_, err := extractHTMLMeta(newLimitedReader(strings.NewReader(page), 512))
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+}
+
+func TestExtractHTMLMetaCharset(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		contentType string
+		title       string
+		description string
+	}{
+		{fixture: "gbk.html", contentType: "text/html", title: "中文标题", description: "这是一个描述"},
+		{fixture: "shift_jis.html", contentType: "text/html", title: "日本語のタイトル", description: "説明文です"},
+		// The charset is only declared in the Content-Type header.
+		{fixture: "iso-8859-1.html", contentType: "text/html; charset=ISO-8859-1", title: "Café crème"},
+	}
+	for _, test := range tests {
+		file, err := os.Open(filepath.Join("testdata", test.fixture))
+		require.NoError(t, err)
+		body, err := decodeHTML(file, test.contentType)
+		require.NoError(t, err)
+		meta, err := extractHTMLMeta(body)
+		file.Close()
+		require.NoError(t, err)
+		require.Equal(t, test.title, meta.Title, test.fixture)
+		require.Equal(t, test.description, meta.Description, test.fixture)
+	}
+}
+
+func TestExtractHTMLMetaInvalidUTF8(t *testing.T) {
+	meta, err := extractHTMLMeta(strings.NewReader("<head><title>bad \xff\xfe title</title></head>"))
+	require.NoError(t, err)
+	require.True(t, utf8.ValidString(meta.Title))
+	require.Equal(t, "bad � title", meta.Title)
+}
+
+func TestGetFileMeta(t *testing.T) {
+	mustParse := func(rawURL string) *url.URL {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		return u
+	}
+
+	meta := getFileMeta(mustParse("https://example.com/docs/report%20v2.pdf"), http.Header{}, 1024, "application/pdf")
+	require.Equal(t, HTMLMeta{
+		Title:         "report v2.pdf",
+		FinalURL:      "https://example.com/docs/report%20v2.pdf",
+		ContentType:   "application/pdf",
+		ContentLength: 1024,
+		Filename:      "report v2.pdf",
+	}, *meta)
+
+	header := http.Header{}
+	header.Set("Content-Disposition", `attachment; filename="../archive.zip"`)
+	meta = getFileMeta(mustParse("https://example.com/download?id=1"), header, -1, "application/zip")
+	require.Equal(t, "archive.zip", meta.Filename)
+	require.Zero(t, meta.ContentLength)
+	require.Empty(t, meta.Image)
+
+	meta = getFileMeta(mustParse("https://example.com/"), http.Header{}, 0, "image/png")
+	require.Empty(t, meta.Filename)
+	require.Equal(t, "https://example.com/", meta.Image)
+}
+
+func TestSniffMediatype(t *testing.T) {
+	body := "%PDF-1.7\n" + strings.Repeat("x", 1024)
+	mediatype, reader, err := sniffMediatype(strings.NewReader(body))
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", mediatype)
+	// The sniffed bytes must still be readable.
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, body, string(data))
+
+	mediatype, _, err = sniffMediatype(strings.NewReader("<!DOCTYPE html><title>x</title>"))
+	require.NoError(t, err)
+	require.Equal(t, "text/html", mediatype)
+}
@@ -1 +1,134 @@
 package httpgetter
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxHTMLSize is the maximum number of bytes read from an HTML page.
+	DefaultMaxHTMLSize = 1 << 20
+	// MaxRedirects is the maximum number of redirects followed by a single fetch.
+	MaxRedirects = 5
+)
+
+var (
+	// ErrResponseTooLarge is returned when a response body exceeds the read limit.
+	ErrResponseTooLarge = errors.New("response is too large")
+	// ErrTimeout is returned when a fetch exceeds the caller's deadline.
+	ErrTimeout = errors.New("fetch timed out")
+	// ErrTooManyRedirects is returned when a fetch is redirected more than MaxRedirects times.
+	ErrTooManyRedirects = errors.New("too many redirects")
+	// ErrRedirectLoop is returned when a redirect points back to a URL already visited.
+	ErrRedirectLoop = errors.New("redirect loop")
+)
+
+var httpClient = &http.Client{
+	Transport:     NewTransport(),
+	CheckRedirect: checkRedirect,
+}
+
+// checkRedirect re-validates every redirect hop, since a public host may redirect
+// to an internal address after the initial validation.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > MaxRedirects {
+		return ErrTooManyRedirects
+	}
+	for _, previous := range via {
+		if previous.URL.String() == req.URL.String() {
+			return errors.Wrap(ErrRedirectLoop, req.URL.String())
+		}
+	}
+	if err := validateURL(req.Context(), req.URL.String()); err != nil {
+		return errors.Wrap(err, "invalid redirect")
+	}
+	if check := urlCheckFromContext(req.Context()); check != nil {
+		if err := check(req.URL); err != nil {
+			return errors.Wrap(err, "invalid redirect")
+		}
+	}
+	return nil
+}
+
+// URLCheck is an additional check a fetched URL must pass.
+type URLCheck func(u *url.URL) error
+
+type urlCheckContextKey struct{}
+
+// WithURLCheck returns a context whose fetches must also pass check.
+// The check applies to the requested URL as well as every redirect hop.
+func WithURLCheck(ctx context.Context, check URLCheck) context.Context {
+	return context.WithValue(ctx, urlCheckContextKey{}, check)
+}
+
+func urlCheckFromContext(ctx context.Context) URLCheck {
+	if check, ok := ctx.Value(urlCheckContextKey{}).(URLCheck); ok {
+		return check
+	}
+	return nil
+}
+
+// doRequest sends a request bound to ctx with the configured headers and maps deadline errors to ErrTimeout.
+func doRequest(ctx context.Context, method, urlStr string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	setRequestHeaders(request)
+	if check := urlCheckFromContext(ctx); check != nil {
+		if err := check(request.URL); err != nil {
+			return nil, err
+		}
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, wrapTimeout(err)
+	}
+	return response, nil
+}
+
+// wrapTimeout wraps deadline and network timeout errors so that errors.Is(err, ErrTimeout) holds.
+func wrapTimeout(err error) error {
+	if err == nil {
+		return nil
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return errors.Wrap(ErrTimeout, err.Error())
+	}
+	return err
+}
+
+// limitedReader reads at most limit bytes and fails with ErrResponseTooLarge
+// if the underlying reader has more data, unlike io.LimitReader which silently truncates.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func newLimitedReader(r io.Reader, limit int64) *limitedReader {
+	return &limitedReader{r: r, remaining: limit}
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for one more byte to tell a body of exactly limit bytes from an oversized one.
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, wrapTimeout(err)
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, wrapTimeout(err)
+}
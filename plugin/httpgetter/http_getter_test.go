@@ -0,0 +1,91 @@
+package httpgetter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitedReader(t *testing.T) {
+	// A body of exactly the limit is fine.
+	data, err := io.ReadAll(newLimitedReader(strings.NewReader("12345"), 5))
+	require.NoError(t, err)
+	require.Equal(t, "12345", string(data))
+
+	// One byte more is rejected instead of being silently truncated.
+	_, err = io.ReadAll(newLimitedReader(strings.NewReader("123456"), 5))
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+}
+
+func TestWrapTimeout(t *testing.T) {
+	require.ErrorIs(t, wrapTimeout(context.DeadlineExceeded), ErrTimeout)
+	require.ErrorIs(t, wrapTimeout(errors.Wrap(context.DeadlineExceeded, "read body")), ErrTimeout)
+	require.NotErrorIs(t, wrapTimeout(errors.New("connection refused")), ErrTimeout)
+	require.NoError(t, wrapTimeout(nil))
+}
+
+func newRedirectChain(t *testing.T, urls ...string) []*http.Request {
+	chain := make([]*http.Request, 0, len(urls))
+	for _, rawURL := range urls {
+		request, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		require.NoError(t, err)
+		chain = append(chain, request)
+	}
+	return chain
+}
+
+func TestCheckRedirectToInternalIP(t *testing.T) {
+	via := newRedirectChain(t, "http://203.0.113.1/")
+	for _, target := range []string{"http://127.0.0.1/admin", "http://10.0.0.1/", "http://169.254.169.254/latest/meta-data"} {
+		req := newRedirectChain(t, target)[0]
+		require.ErrorIs(t, checkRedirect(req, via), ErrInternalIP, target)
+	}
+}
+
+func TestCheckRedirectLoop(t *testing.T) {
+	via := newRedirectChain(t, "http://203.0.113.1/a", "http://203.0.113.1/b")
+	req := newRedirectChain(t, "http://203.0.113.1/a")[0]
+	require.ErrorIs(t, checkRedirect(req, via), ErrRedirectLoop)
+}
+
+func TestCheckRedirectLimit(t *testing.T) {
+	urls := make([]string, 0, MaxRedirects+1)
+	for i := range MaxRedirects + 1 {
+		urls = append(urls, fmt.Sprintf("http://203.0.113.1/%d", i))
+	}
+	req := newRedirectChain(t, "http://203.0.113.1/final")[0]
+
+	// The MaxRedirects-th redirect is still followed.
+	require.NoError(t, checkRedirect(req, newRedirectChain(t, urls[:MaxRedirects]...)))
+	// One more is rejected.
+	require.ErrorIs(t, checkRedirect(req, newRedirectChain(t, urls...)), ErrTooManyRedirects)
+}
+
+func TestCheckRedirectURLCheck(t *testing.T) {
+	errBlocked := errors.New("blocked")
+	ctx := WithURLCheck(context.Background(), func(u *url.URL) error {
+		if u.Hostname() != "203.0.113.1" {
+			return errBlocked
+		}
+		return nil
+	})
+	via := newRedirectChain(t, "http://203.0.113.1/")
+
+	req := newRedirectChain(t, "http://203.0.113.1/next")[0].WithContext(ctx)
+	require.NoError(t, checkRedirect(req, via))
+	req = newRedirectChain(t, "http://203.0.113.2/")[0].WithContext(ctx)
+	require.ErrorIs(t, checkRedirect(req, via), errBlocked)
+
+	response, err := doRequest(ctx, http.MethodGet, "http://203.0.113.2/")
+	if response != nil {
+		response.Body.Close()
+	}
+	require.ErrorIs(t, err, errBlocked)
+}
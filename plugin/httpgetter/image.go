@@ -1,45 +1,53 @@
 package httpgetter
 
 import (
-	"errors"
+	"context"
 	"io"
 	"net/http"
-	"net/url"
-	"strings"
+
+	"github.com/pkg/errors"
 )
 
 type Image struct {
 	Blob      []byte
 	Mediatype string
+	// ETag, CacheControl and LastModified are the upstream caching headers, if any.
+	ETag         string
+	CacheControl string
+	LastModified string
+	// Attempts is the number of requests the fetch needed, including retries.
+	Attempts int
 }
 
-func GetImage(urlStr string) (*Image, error) {
-	if _, err := url.Parse(urlStr); err != nil {
-		return nil, err
-	}
-
-	response, err := http.Get(urlStr)
+// GetImage fetches the image at urlStr. The URL and every redirect hop must pass
+// the internal IP validation, images larger than maxSize bytes are rejected
+// with ErrResponseTooLarge and other media types with ErrUnsupportedContentType.
+func GetImage(ctx context.Context, urlStr string, maxSize int64) (*Image, error) {
+	response, err := GetRaw(ctx, urlStr, RawOptions{MaxBytes: maxSize, ContentTypes: []string{"image/*"}, Retry: DefaultRetryPolicy})
 	if err != nil {
 		return nil, err
 	}
 	defer response.Body.Close()
 
-	mediatype, err := getMediatype(response)
-	if err != nil {
-		return nil, err
+	if response.StatusCode != http.StatusOK {
+		return nil, &AttemptError{Attempts: response.Attempts, Err: errors.Errorf("unexpected status code: %d", response.StatusCode)}
 	}
-	if !strings.HasPrefix(mediatype, "image/") {
-		return nil, errors.New("wrong image mediatype")
+	if response.ContentLength > maxSize {
+		return nil, &AttemptError{Attempts: response.Attempts, Err: ErrResponseTooLarge}
 	}
 
 	bodyBytes, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, &AttemptError{Attempts: response.Attempts, Err: err}
 	}
 
 	image := &Image{
-		Blob:      bodyBytes,
-		Mediatype: mediatype,
+		Blob:         bodyBytes,
+		Mediatype:    response.Mediatype,
+		ETag:         response.Header.Get("ETag"),
+		CacheControl: response.Header.Get("Cache-Control"),
+		LastModified: response.Header.Get("Last-Modified"),
+		Attempts:     response.Attempts,
 	}
 	return image, nil
 }
@@ -0,0 +1,106 @@
+package httpgetter
+
+import (
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// isJSONLDScript reports whether the script tag holds schema.org JSON-LD.
+func isJSONLDScript(token html.Token) bool {
+	for _, attr := range token.Attr {
+		if attr.Key == "type" {
+			return strings.EqualFold(strings.TrimSpace(attr.Val), "application/ld+json")
+		}
+	}
+	return false
+}
+
+// extractJSONLD fills the candidates from the first Article or WebPage-like node in a JSON-LD block.
+// Top-level arrays and @graph wrappers are supported; malformed JSON is ignored.
+func extractJSONLD(data string, candidates *metaCandidates) {
+	var document any
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return
+	}
+
+	var nodes []map[string]any
+	var collect func(value any, depth int)
+	collect = func(value any, depth int) {
+		// Nodes only appear at the top level, in arrays or in a @graph.
+		if depth > 2 {
+			return
+		}
+		switch v := value.(type) {
+		case []any:
+			for _, item := range v {
+				collect(item, depth+1)
+			}
+		case map[string]any:
+			nodes = append(nodes, v)
+			if graph, ok := v["@graph"]; ok {
+				collect(graph, depth+1)
+			}
+		default:
+		}
+	}
+	collect(document, 0)
+
+	for _, node := range nodes {
+		if !isJSONLDContentType(node["@type"]) {
+			continue
+		}
+		candidates.set("title", firstNonEmpty(jsonLDString(node["headline"]), jsonLDString(node["name"])))
+		candidates.set("description", jsonLDString(node["description"]))
+		candidates.set("image", jsonLDImage(node["image"]))
+		return
+	}
+}
+
+// isJSONLDContentType matches Article, WebPage and posting types such as NewsArticle or BlogPosting.
+func isJSONLDContentType(value any) bool {
+	var types []string
+	switch v := value.(type) {
+	case string:
+		types = []string{v}
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+	default:
+	}
+	for _, t := range types {
+		if strings.HasSuffix(t, "Article") || strings.HasSuffix(t, "Page") || strings.HasSuffix(t, "Posting") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonLDString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// jsonLDImage returns the image URL from a string, an ImageObject or an array of either.
+func jsonLDImage(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]any:
+		return jsonLDString(v["url"])
+	case []any:
+		for _, item := range v {
+			if image := jsonLDImage(item); image != "" {
+				return image
+			}
+		}
+	default:
+	}
+	return ""
+}
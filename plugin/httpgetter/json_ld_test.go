@@ -0,0 +1,84 @@
+package httpgetter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractJSONLD(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		candidate metaCandidates
+	}{
+		{
+			name:      "article",
+			data:      `{"@context":"https://schema.org","@type":"NewsArticle","headline":"Headline","description":"Desc","image":["https://example.com/a.jpg"]}`,
+			candidate: metaCandidates{title: "Headline", description: "Desc", image: "https://example.com/a.jpg"},
+		},
+		{
+			name:      "graph",
+			data:      `{"@context":"https://schema.org","@graph":[{"@type":"Organization","name":"Org"},{"@type":["WebPage"],"name":"Page","image":{"@type":"ImageObject","url":"https://example.com/b.png"}}]}`,
+			candidate: metaCandidates{title: "Page", image: "https://example.com/b.png"},
+		},
+		{
+			name:      "array",
+			data:      `[{"@type":"BreadcrumbList"},{"@type":"BlogPosting","name":"Post"}]`,
+			candidate: metaCandidates{title: "Post"},
+		},
+		{
+			name: "malformed",
+			data: `{"@type":"Article","headline":`,
+		},
+		{
+			name: "unexpected types",
+			data: `{"@type":42,"headline":["not","a","string"],"@graph":"nope"}`,
+		},
+	}
+	for _, test := range tests {
+		var candidate metaCandidates
+		extractJSONLD(test.data, &candidate)
+		require.Equal(t, test.candidate, candidate, test.name)
+	}
+}
+
+func TestExtractHTMLMetaPrecedence(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		meta HTMLMeta
+	}{
+		{
+			name: "open graph wins",
+			page: `<head><title>Title</title><meta name="twitter:title" content="Twitter"><meta property="og:title" content="OG"></head>`,
+			meta: HTMLMeta{Title: "OG"},
+		},
+		{
+			name: "twitter card fallback",
+			page: `<head><title>Title</title>
+<meta name="description" content="Plain">
+<meta name="twitter:title" content="Twitter">
+<meta name="twitter:description" content="Tweet desc">
+<meta name="twitter:image:src" content="https://example.com/t.png"></head>`,
+			meta: HTMLMeta{Title: "Twitter", Description: "Tweet desc", Image: "https://example.com/t.png"},
+		},
+		{
+			name: "json-ld fallback",
+			page: `<head><title>Title</title><meta name="description" content="Plain">
+<script type="application/ld+json">{"@type":"Article","headline":"LD","image":"https://example.com/ld.png"}</script></head>`,
+			meta: HTMLMeta{Title: "LD", Description: "Plain", Image: "https://example.com/ld.png"},
+		},
+		{
+			name: "basic fallback",
+			page: `<head><title> Title </title><meta name="description" content="First"><meta name="description" content="Second"></head>`,
+			meta: HTMLMeta{Title: "Title", Description: "First"},
+		},
+	}
+	for _, test := range tests {
+		meta, err := extractHTMLMeta(strings.NewReader(test.page))
+		require.NoError(t, err)
+		require.Equal(t, test.meta, *meta, test.name)
+	}
+}
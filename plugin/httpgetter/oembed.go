@@ -0,0 +1,105 @@
+package httpgetter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+)
+
+const (
+	// maxOEmbedResponseSize is the maximum size of an oEmbed JSON response.
+	maxOEmbedResponseSize = 256 * 1024
+	// maxOEmbedHTMLSize is the maximum size of the embed markup we pass through.
+	maxOEmbedHTMLSize = 16 * 1024
+)
+
+// OEmbed is the subset of an oEmbed response used for link previews.
+// See https://oembed.com/#section2.3
+type OEmbed struct {
+	Type         string          `json:"type"`
+	Title        string          `json:"title"`
+	AuthorName   string          `json:"author_name"`
+	ProviderName string          `json:"provider_name"`
+	ThumbnailURL string          `json:"thumbnail_url"`
+	HTML         string          `json:"html"`
+	Width        oEmbedDimension `json:"width"`
+	Height       oEmbedDimension `json:"height"`
+}
+
+// oEmbedDimension tolerates providers that send dimensions as numbers, strings or null.
+type oEmbedDimension int
+
+func (d *oEmbedDimension) UnmarshalJSON(data []byte) error {
+	*d = 0
+	// Null and non-numeric dimensions such as "100%" are ignored.
+	if n, err := strconv.ParseFloat(strings.Trim(string(data), `"`), 64); err == nil {
+		*d = oEmbedDimension(n)
+	}
+	return nil
+}
+
+// GetOEmbed fetches and decodes the oEmbed JSON document at endpointURL.
+// The endpoint is subject to the same internal IP validation as other fetches,
+// and embed markup referencing internal hosts or exceeding the size limit is dropped.
+func GetOEmbed(ctx context.Context, endpointURL string) (*OEmbed, error) {
+	response, err := GetRaw(ctx, endpointURL, RawOptions{MaxBytes: maxOEmbedResponseSize, Retry: DefaultRetryPolicy})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected oEmbed status code: %d", response.StatusCode)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	oembed := new(OEmbed)
+	if err := json.Unmarshal(body, oembed); err != nil {
+		return nil, errors.Wrap(err, "failed to decode oEmbed response")
+	}
+
+	if len(oembed.HTML) > maxOEmbedHTMLSize || !isSafeEmbedHTML(oembed.HTML) {
+		oembed.HTML = ""
+	}
+	if oembed.ThumbnailURL != "" && validateURL(ctx, oembed.ThumbnailURL) != nil {
+		oembed.ThumbnailURL = ""
+	}
+	return oembed, nil
+}
+
+// isSafeEmbedHTML reports whether every src attribute in the embed markup points to a public http(s) URL.
+func isSafeEmbedHTML(markup string) bool {
+	tokenizer := html.NewTokenizer(strings.NewReader(markup))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return errors.Is(tokenizer.Err(), io.EOF)
+		}
+		if tokenType != html.StartTagToken && tokenType != html.SelfClosingTagToken {
+			continue
+		}
+		for _, attr := range tokenizer.Token().Attr {
+			if attr.Key != "src" {
+				continue
+			}
+			src := attr.Val
+			// Protocol-relative URLs are common in embed snippets.
+			if strings.HasPrefix(src, "//") {
+				src = "https:" + src
+			}
+			if err := validateURL(context.Background(), src); err != nil {
+				return false
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package httpgetter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOEmbedDimensionUnmarshal(t *testing.T) {
+	tests := []struct {
+		input  string
+		width  int
+		height int
+	}{
+		{input: `{"width": 480, "height": 270}`, width: 480, height: 270},
+		{input: `{"width": "640", "height": null}`, width: 640, height: 0},
+		{input: `{"width": "100%"}`, width: 0, height: 0},
+	}
+	for _, test := range tests {
+		oembed := new(OEmbed)
+		require.NoError(t, json.Unmarshal([]byte(test.input), oembed))
+		require.Equal(t, test.width, int(oembed.Width))
+		require.Equal(t, test.height, int(oembed.Height))
+	}
+}
+
+func TestIsSafeEmbedHTML(t *testing.T) {
+	require.True(t, isSafeEmbedHTML(`<iframe width="480" src="https://93.184.216.34/embed/abc"></iframe>`))
+	require.True(t, isSafeEmbedHTML(`<blockquote class="twitter-tweet"><p>hello</p></blockquote>`))
+	require.False(t, isSafeEmbedHTML(`<iframe src="http://127.0.0.1/admin"></iframe>`))
+	require.False(t, isSafeEmbedHTML(`<script src="javascript:alert(1)"></script>`))
+}
+
+func TestExtractHTMLMetaOEmbedLink(t *testing.T) {
+	page := `<html><head>
+<title>Video</title>
+<link rel="alternate" type="application/json+oembed" href="/oembed?url=abc&amp;format=json">
+</head><body></body></html>`
+	meta, err := extractHTMLMeta(strings.NewReader(page))
+	require.NoError(t, err)
+	require.Equal(t, "Video", meta.Title)
+	require.Equal(t, "/oembed?url=abc&format=json", meta.OEmbedURL)
+}
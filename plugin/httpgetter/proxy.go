@@ -0,0 +1,147 @@
+package httpgetter
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// defaultProxyPorts are the ports http.Transport assumes when a proxy URL has none.
+var defaultProxyPorts = map[string]string{
+	"http":    "80",
+	"https":   "443",
+	"socks5":  "1080",
+	"socks5h": "1080",
+}
+
+// proxyConfig decides which outbound requests are sent through a proxy.
+type proxyConfig struct {
+	proxyFunc func(*url.URL) (*url.URL, error)
+	// addrs holds the host:port of every configured proxy. Proxies are chosen by the
+	// administrator, so the dialer connects to them even on internal addresses.
+	addrs map[string]bool
+}
+
+var currentProxy atomic.Pointer[proxyConfig]
+
+// SetProxy configures the proxy used by every transport returned by NewTransport.
+// An empty proxyURL honors the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables,
+// which is also the behavior when SetProxy is never called. An explicit proxyURL overrides
+// HTTP_PROXY and HTTPS_PROXY, while NO_PROXY still lists the hosts that are dialed directly.
+// Supported schemes are http, https, socks5 and socks5h.
+func SetProxy(proxyURL string) error {
+	config, err := newProxyConfig(proxyURL)
+	if err != nil {
+		return err
+	}
+	currentProxy.Store(config)
+	return nil
+}
+
+// ProxyURL returns the proxy that a request to target is sent through, or nil when it is dialed directly.
+func ProxyURL(target *url.URL) (*url.URL, error) {
+	return loadProxyConfig().proxyFunc(target)
+}
+
+func loadProxyConfig() *proxyConfig {
+	if config := currentProxy.Load(); config != nil {
+		return config
+	}
+	config, err := newProxyConfig("")
+	if err != nil {
+		// Fail closed rather than silently bypassing a misconfigured proxy.
+		config = &proxyConfig{proxyFunc: func(*url.URL) (*url.URL, error) { return nil, err }}
+	}
+	currentProxy.CompareAndSwap(nil, config)
+	return currentProxy.Load()
+}
+
+func newProxyConfig(proxyURL string) (*proxyConfig, error) {
+	environment := httpproxy.FromEnvironment()
+	if proxyURL != "" {
+		environment.HTTPProxy = proxyURL
+		environment.HTTPSProxy = proxyURL
+	}
+
+	addrs := map[string]bool{}
+	for _, raw := range []string{environment.HTTPProxy, environment.HTTPSProxy} {
+		if raw == "" {
+			continue
+		}
+		u, err := parseProxyURL(raw)
+		if err != nil {
+			return nil, err
+		}
+		addrs[proxyAddr(u)] = true
+	}
+	return &proxyConfig{
+		proxyFunc: environment.ProxyFunc(),
+		addrs:     addrs,
+	}, nil
+}
+
+// parseProxyURL parses a proxy URL the way httpproxy does, treating a bare host:port as an HTTP proxy.
+func parseProxyURL(raw string) (*url.URL, error) {
+	withScheme := raw
+	if !strings.Contains(raw, "://") {
+		withScheme = "http://" + raw
+	}
+	u, err := url.Parse(withScheme)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid proxy URL %q", raw)
+	}
+	if _, ok := defaultProxyPorts[u.Scheme]; !ok {
+		return nil, errors.Errorf("unsupported proxy scheme %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, errors.Errorf("invalid proxy URL %q: missing host", raw)
+	}
+	return u, nil
+}
+
+// proxyAddr returns the address http.Transport dials to reach the proxy.
+func proxyAddr(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = defaultProxyPorts[u.Scheme]
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// proxy is the http.Transport Proxy hook. A proxied request is resolved and connected to by
+// the proxy rather than by DialContext, so the target host is validated here instead.
+func (d *Dialer) proxy(req *http.Request) (*url.URL, error) {
+	config := loadProxyConfig()
+	proxyURL, err := config.proxyFunc(req.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine proxy")
+	}
+
+	host := req.URL.Hostname()
+	port := req.URL.Port()
+	if port == "" {
+		port = defaultProxyPorts[req.URL.Scheme]
+	}
+	// The dialer trusts proxy addresses, so they must not be reachable as a fetch target.
+	if config.addrs[net.JoinHostPort(host, port)] {
+		return nil, errors.Wrapf(ErrInternalIP, "host=%s is the outbound proxy", host)
+	}
+	if proxyURL == nil {
+		if _, ok := d.allowlisted.Load(net.JoinHostPort(host, port)); ok {
+			// A pooled connection may outlive the allowlist entry that permitted it.
+			if _, _, err := d.resolve(req.Context(), host); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	if _, _, err := d.resolve(req.Context(), host); err != nil {
+		return nil, err
+	}
+	return proxyURL, nil
+}
@@ -0,0 +1,178 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedContentType is returned when a response's media type is not one of the accepted types.
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+// RawOptions configures GetRaw.
+type RawOptions struct {
+	// MaxBytes is the maximum number of body bytes that may be read. Reading past it fails
+	// with ErrResponseTooLarge. Defaults to DefaultMaxHTMLSize.
+	MaxBytes int64
+	// ContentTypes lists the accepted media types, e.g. "text/html" or "image/*".
+	// Successful responses of any other type fail with ErrUnsupportedContentType.
+	// Empty accepts every type.
+	ContentTypes []string
+	// Timeout bounds the wait for the response headers and for each read of the body,
+	// so a stalled server fails with ErrTimeout even within the caller's deadline.
+	// Zero means only ctx bounds the fetch.
+	Timeout time.Duration
+	// Retry is the policy for retrying transient failures. The zero value makes a single attempt.
+	Retry RetryPolicy
+}
+
+// RawResponse is a response whose body is streamed rather than read into memory.
+// The caller must close Body.
+type RawResponse struct {
+	// Body yields at most MaxBytes bytes of the response body.
+	Body io.ReadCloser
+	// URL is the URL the response was served from after following redirects.
+	URL        *url.URL
+	StatusCode int
+	Header     http.Header
+	// ContentLength is the declared body length, or -1 when unknown.
+	ContentLength int64
+	// Mediatype is taken from the Content-Type header, or sniffed from the body when the header is missing or malformed.
+	Mediatype string
+	// Attempts is the number of requests made, including retries.
+	Attempts int
+}
+
+// GetRaw fetches urlStr and returns its body as a size-capped stream.
+// The URL and every redirect hop must pass the internal IP validation. The status code
+// is not checked, but the content type of a 2xx response must match opts.ContentTypes.
+// Errors after the URL validation are *AttemptError, which reports the number of attempts made.
+func GetRaw(ctx context.Context, urlStr string, opts RawOptions) (*RawResponse, error) {
+	if err := validateURL(ctx, urlStr); err != nil {
+		return nil, err
+	}
+	maxBytes := opts.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxHTMLSize
+	}
+
+	// Each attempt gets its own context, so that its read deadline cancels only that attempt.
+	var body *deadlineBody
+	send := func() (*http.Response, error) {
+		if body != nil {
+			body.stop()
+			body.cancel()
+		}
+		attemptCtx, cancel := context.WithCancel(ctx)
+		body = &deadlineBody{timeout: opts.Timeout, cancel: cancel}
+		if opts.Timeout > 0 {
+			body.timer = time.AfterFunc(opts.Timeout, body.expire)
+		}
+		response, err := doRequest(attemptCtx, http.MethodGet, urlStr)
+		body.stop()
+		if err != nil {
+			cancel()
+			if body.expired.Load() {
+				return nil, errors.Wrap(ErrTimeout, err.Error())
+			}
+			return nil, err
+		}
+		return response, nil
+	}
+	response, attempts, err := opts.Retry.retry(ctx, send) //nolint:bodyclose // closed through the returned RawResponse
+	if err != nil {
+		return nil, &AttemptError{Attempts: attempts, Err: err}
+	}
+	body.r = response.Body
+	body.closer = response.Body
+
+	var reader io.Reader = newLimitedReader(body, maxBytes)
+	mediatype, err := getMediatype(response)
+	if err != nil {
+		// Missing or malformed Content-Type, sniff the first bytes instead.
+		if mediatype, reader, err = sniffMediatype(reader); err != nil {
+			body.Close()
+			return nil, &AttemptError{Attempts: attempts, Err: err}
+		}
+	}
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 && !matchContentType(opts.ContentTypes, mediatype) {
+		body.Close()
+		return nil, &AttemptError{Attempts: attempts, Err: errors.Wrap(ErrUnsupportedContentType, mediatype)}
+	}
+	return &RawResponse{
+		Body:          readCloser{Reader: reader, Closer: body},
+		URL:           response.Request.URL,
+		StatusCode:    response.StatusCode,
+		Header:        response.Header,
+		ContentLength: response.ContentLength,
+		Mediatype:     mediatype,
+		Attempts:      attempts,
+	}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// matchContentType reports whether mediatype is one of accepted. A "type/*" entry matches any subtype.
+func matchContentType(accepted []string, mediatype string) bool {
+	if len(accepted) == 0 {
+		return true
+	}
+	for _, pattern := range accepted {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(mediatype, prefix) {
+			return true
+		}
+		if pattern == mediatype {
+			return true
+		}
+	}
+	return false
+}
+
+// deadlineBody wraps a response body and cancels the request when a single read takes longer than timeout.
+type deadlineBody struct {
+	r       io.Reader
+	closer  io.Closer
+	timeout time.Duration
+	timer   *time.Timer
+	cancel  context.CancelFunc
+	expired atomic.Bool
+}
+
+func (b *deadlineBody) expire() {
+	b.expired.Store(true)
+	b.cancel()
+}
+
+func (b *deadlineBody) stop() {
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+}
+
+func (b *deadlineBody) Read(p []byte) (int, error) {
+	if b.timer != nil {
+		b.timer.Reset(b.timeout)
+		defer b.timer.Stop()
+	}
+	n, err := b.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && b.expired.Load() {
+		return n, errors.Wrap(ErrTimeout, err.Error())
+	}
+	return n, err
+}
+
+func (b *deadlineBody) Close() error {
+	b.stop()
+	b.cancel()
+	return b.closer.Close()
+}
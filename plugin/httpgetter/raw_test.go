@@ -0,0 +1,75 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+)
+
+func TestGetRaw(t *testing.T) {
+	DialForTest(t, testutil.ServeAt(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			_, _ = io.WriteString(w, "%PDF-1.4")
+		case "/sniff":
+			_, _ = io.WriteString(w, "<!DOCTYPE html><html><head><title>Sniffed</title></head></html>")
+		case "/large":
+			w.Header().Set("Content-Type", "text/plain")
+			w.(http.Flusher).Flush()
+			_, _ = io.WriteString(w, strings.Repeat("a", 2048))
+		case "/stall":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = io.WriteString(w, "partial")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	ctx := context.Background()
+
+	response, err := GetRaw(ctx, "http://203.0.113.1/pdf", RawOptions{ContentTypes: []string{"image/*", "application/pdf"}})
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", response.Mediatype)
+	require.Equal(t, "http://203.0.113.1/pdf", response.URL.String())
+	require.NoError(t, response.Body.Close())
+
+	_, err = GetRaw(ctx, "http://203.0.113.1/pdf", RawOptions{ContentTypes: []string{"image/*"}})
+	require.ErrorIs(t, err, ErrUnsupportedContentType)
+
+	response, err = GetRaw(ctx, "http://203.0.113.1/sniff", RawOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "text/html", response.Mediatype)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(body), "<!DOCTYPE html>"))
+	require.NoError(t, response.Body.Close())
+
+	response, err = GetRaw(ctx, "http://203.0.113.1/large", RawOptions{MaxBytes: 1024})
+	require.NoError(t, err)
+	_, err = io.ReadAll(response.Body)
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+	require.NoError(t, response.Body.Close())
+
+	response, err = GetRaw(ctx, "http://203.0.113.1/stall", RawOptions{Timeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+	_, err = io.ReadAll(response.Body)
+	require.ErrorIs(t, err, ErrTimeout)
+	require.NoError(t, response.Body.Close())
+}
+
+func TestMatchContentType(t *testing.T) {
+	require.True(t, matchContentType(nil, "application/pdf"))
+	require.True(t, matchContentType([]string{"image/*"}, "image/png"))
+	require.True(t, matchContentType([]string{"text/html", "application/xhtml+xml"}, "application/xhtml+xml"))
+	require.False(t, matchContentType([]string{"image/*"}, "text/html"))
+	require.False(t, matchContentType([]string{"text/html"}, "text/plain"))
+}
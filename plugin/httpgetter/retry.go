@@ -0,0 +1,171 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy controls how transient fetch failures are retried.
+// Network errors, 429 and 5xx responses are retried; other responses and errors are returned as is.
+// The zero value makes a single attempt.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry, doubled for every further retry.
+	BaseDelay time.Duration
+	// MaxDelay caps a single backoff. A Retry-After asking for a longer wait is not retried.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy retries a failed fetch twice.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 2,
+	BaseDelay:  250 * time.Millisecond,
+	MaxDelay:   5 * time.Second,
+}
+
+// AttemptError is returned by a failed fetch and reports how many attempts it made.
+type AttemptError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *AttemptError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AttemptError) Unwrap() error {
+	return e.Err
+}
+
+// Attempts returns the number of attempts recorded in err, or 0 if err reports none.
+func Attempts(err error) int {
+	var attemptErr *AttemptError
+	if errors.As(err, &attemptErr) {
+		return attemptErr.Attempts
+	}
+	return 0
+}
+
+// Do sends the request returned by newRequest with client, retrying transient failures.
+// newRequest is called for every attempt so that request bodies can be replayed.
+// It returns the last response or error along with the number of attempts made.
+func (p RetryPolicy) Do(ctx context.Context, client *http.Client, newRequest func(ctx context.Context) (*http.Request, error)) (*http.Response, int, error) {
+	return p.retry(ctx, func() (*http.Response, error) {
+		request, err := newRequest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return client.Do(request)
+	})
+}
+
+// retry calls send until it succeeds, fails permanently or the policy is exhausted.
+// Retries never outlast ctx: a backoff that would end after its deadline is not attempted.
+func (p RetryPolicy) retry(ctx context.Context, send func() (*http.Response, error)) (*http.Response, int, error) {
+	for attempt := 1; ; attempt++ {
+		response, err := send()
+		var statusCode int
+		var retryAfter string
+		if response != nil {
+			statusCode = response.StatusCode
+			retryAfter = response.Header.Get("Retry-After")
+		}
+		delay, ok := p.next(attempt, statusCode, retryAfter, err)
+		if !ok || !sleep(ctx, delay) {
+			return response, attempt, err
+		}
+		if response != nil {
+			// Drain a little so that the connection can be reused.
+			_, _ = io.CopyN(io.Discard, response.Body, 4<<10)
+			response.Body.Close()
+		}
+	}
+}
+
+// next returns the backoff before the next attempt, or false if the result must not be retried.
+// statusCode and retryAfter describe the response when err is nil.
+func (p RetryPolicy) next(attempt, statusCode int, retryAfter string, err error) (time.Duration, bool) {
+	if attempt > p.MaxRetries {
+		return 0, false
+	}
+	if err != nil {
+		return p.backoff(attempt), isRetryableError(err)
+	}
+	if statusCode != http.StatusTooManyRequests && statusCode < 500 {
+		return 0, false
+	}
+	if delay, ok := parseRetryAfter(retryAfter, time.Now()); ok {
+		return delay, delay <= p.MaxDelay
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns the exponential backoff for the attempt with equal jitter,
+// i.e. a random delay between half and all of the exponential delay.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// isRetryableError reports whether err is a transient network failure.
+func isRetryableError(err error) bool {
+	if errors.Is(err, ErrInternalIP) || errors.Is(err, ErrTimeout) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return !dnsErr.IsNotFound
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// sleep waits for d, returning false without waiting if ctx would expire first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= d {
+		return false
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,131 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+)
+
+func TestRetryPolicyNext(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 2, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		name       string
+		attempt    int
+		statusCode int
+		retryAfter string
+		err        error
+		retry      bool
+	}{
+		{name: "ok", attempt: 1, statusCode: http.StatusOK, retry: false},
+		{name: "not found", attempt: 1, statusCode: http.StatusNotFound, retry: false},
+		{name: "unavailable", attempt: 1, statusCode: http.StatusServiceUnavailable, retry: true},
+		{name: "too many requests", attempt: 2, statusCode: http.StatusTooManyRequests, retryAfter: "1", retry: true},
+		{name: "retry after too long", attempt: 1, statusCode: http.StatusServiceUnavailable, retryAfter: "120", retry: false},
+		{name: "exhausted", attempt: 3, statusCode: http.StatusBadGateway, retry: false},
+		{name: "connection reset", attempt: 1, err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}, retry: true},
+		{name: "internal ip", attempt: 1, err: errors.Wrap(ErrInternalIP, "10.0.0.1"), retry: false},
+		{name: "timeout", attempt: 1, err: errors.Wrap(ErrTimeout, "deadline"), retry: false},
+		{name: "unknown host", attempt: 1, err: &net.DNSError{Err: "no such host", IsNotFound: true}, retry: false},
+	}
+	for _, test := range tests {
+		_, retry := policy.next(test.attempt, test.statusCode, test.retryAfter, test.err)
+		require.Equal(t, test.retry, retry, test.name)
+	}
+
+	delay, _ := policy.next(1, http.StatusTooManyRequests, "1", nil)
+	require.Equal(t, time.Second, delay)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	for range 100 {
+		delay := policy.backoff(1)
+		require.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		require.LessOrEqual(t, delay, 100*time.Millisecond)
+
+		delay = policy.backoff(4)
+		require.GreaterOrEqual(t, delay, 150*time.Millisecond)
+		require.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+	require.Zero(t, RetryPolicy{}.backoff(1))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	delay, ok := parseRetryAfter("3", now)
+	require.True(t, ok)
+	require.Equal(t, 3*time.Second, delay)
+
+	delay, ok = parseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, 10*time.Second, delay)
+
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+	_, ok = parseRetryAfter("-1", now)
+	require.False(t, ok)
+}
+
+func TestGetRawRetries(t *testing.T) {
+	var calls atomic.Int32
+	DialForTest(t, testutil.ServeAt(t, func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch r.URL.Path {
+		case "/flaky":
+			if n == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = io.WriteString(w, "ok")
+		case "/slow-down":
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	policy := RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Second}
+
+	response, err := GetRaw(context.Background(), "http://203.0.113.1/flaky", RawOptions{Retry: policy})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, 2, response.Attempts)
+	require.NoError(t, response.Body.Close())
+
+	// 4xx responses are returned without retrying.
+	calls.Store(0)
+	response, err = GetRaw(context.Background(), "http://203.0.113.1/missing", RawOptions{Retry: policy})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+	require.Equal(t, 1, response.Attempts)
+	require.NoError(t, response.Body.Close())
+
+	// A Retry-After that would outlast the caller's deadline is not waited for.
+	calls.Store(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	response, err = GetRaw(ctx, "http://203.0.113.1/slow-down", RawOptions{Retry: policy})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	require.Equal(t, 1, response.Attempts)
+	require.Less(t, time.Since(start), time.Second)
+	require.NoError(t, response.Body.Close())
+}
+
+func TestAttempts(t *testing.T) {
+	err := errors.Wrap(&AttemptError{Attempts: 3, Err: ErrTimeout}, "fetch")
+	require.Equal(t, 3, Attempts(err))
+	require.ErrorIs(t, err, ErrTimeout)
+	require.Zero(t, Attempts(errors.New("other")))
+}
@@ -0,0 +1,20 @@
+package httpgetter
+
+import (
+	"context"
+	"net"
+	"testing"
+)
+
+// DialForTest routes the connections of every fetch through dial until the test ends, so that
+// tests can serve fetches to public addresses from a local server. The fetched addresses are
+// still validated before they are dialed.
+func DialForTest(t testing.TB, dial func(ctx context.Context, network, address string) (net.Conn, error)) {
+	t.Helper()
+	transport := NewTransport()
+	transport.Proxy = nil
+	transport.DialContext = (&Dialer{dial: dial}).DialContext
+	previous := httpClient.Transport
+	httpClient.Transport = transport
+	t.Cleanup(func() { httpClient.Transport = previous })
+}
@@ -5,4 +5,6 @@ type IdentityProviderUserInfo struct {
 	DisplayName string
 	Email       string
 	AvatarURL   string
+	// Groups are the values of the configured role claim.
+	Groups []string
 }
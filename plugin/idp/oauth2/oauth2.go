@@ -128,6 +128,42 @@ func (p *IdentityProvider) UserInfo(token string) (*idp.IdentityProviderUserInfo
 			userInfo.AvatarURL = v
 		}
 	}
+	if p.config.RoleClaim != "" {
+		userInfo.Groups = claimValues(claims, p.config.RoleClaim)
+	}
 	slog.Info("user info", "userInfo", userInfo)
 	return userInfo, nil
 }
+
+// claimValues returns the string values of a claim, which is either a string or an array.
+// Nested claims are addressed with dots, e.g. "realm_access.roles". Keys containing dots,
+// e.g. namespaced claims like "https://example.com/groups", are matched as a whole first.
+func claimValues(claims map[string]any, path string) []string {
+	if v, ok := claims[path]; ok {
+		switch v := v.(type) {
+		case string:
+			return []string{v}
+		case []any:
+			values := []string{}
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					values = append(values, s)
+				}
+			}
+			return values
+		default:
+			return nil
+		}
+	}
+	for i := range len(path) {
+		if path[i] != '.' {
+			continue
+		}
+		if nested, ok := claims[path[:i]].(map[string]any); ok {
+			if values := claimValues(nested, path[i+1:]); values != nil {
+				return values
+			}
+		}
+	}
+	return nil
+}
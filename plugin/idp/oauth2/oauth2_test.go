@@ -125,6 +125,9 @@ func TestIdentityProvider(t *testing.T) {
 			"sub":   testSubject,
 			"name":  testName,
 			"email": testEmail,
+			"realm_access": map[string]any{
+				"roles": []string{"memos-admin", "offline_access"},
+			},
 		},
 	)
 	require.NoError(t, err)
@@ -142,6 +145,7 @@ func TestIdentityProvider(t *testing.T) {
 				DisplayName: "name",
 				Email:       "email",
 			},
+			RoleClaim: "realm_access.roles",
 		},
 	)
 	require.NoError(t, err)
@@ -159,6 +163,41 @@ func TestIdentityProvider(t *testing.T) {
 		Identifier:  testSubject,
 		DisplayName: testName,
 		Email:       testEmail,
+		Groups:      []string{"memos-admin", "offline_access"},
 	}
 	assert.Equal(t, wantUserInfo, userInfoResult)
 }
+
+func TestClaimValues(t *testing.T) {
+	claims := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"group": "admins",
+		"groups": ["admins", 42, "writers"],
+		"realm_access": {"roles": ["offline_access", "memos-host"]},
+		"resource_access": {"memos": {"roles": "memos-admin"}},
+		"https://example.com/groups": ["namespaced"],
+		"empty": [],
+		"count": 3
+	}`), &claims))
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "group", want: []string{"admins"}},
+		{path: "groups", want: []string{"admins", "writers"}},
+		{path: "realm_access.roles", want: []string{"offline_access", "memos-host"}},
+		{path: "resource_access.memos.roles", want: []string{"memos-admin"}},
+		{path: "https://example.com/groups", want: []string{"namespaced"}},
+		{path: "empty", want: []string{}},
+		{path: "count", want: nil},
+		{path: "realm_access", want: nil},
+		{path: "realm_access.missing", want: nil},
+		{path: "missing", want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.want, claimValues(claims, test.path))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Package imagecompress re-encodes the large JPEG and PNG uploads to WebP, or to a lower quality
+// JPEG when it's smaller, capping their dimensions.
+package imagecompress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+
+	"github.com/disintegration/imaging"
+	"github.com/gen2brain/webp"
+	"github.com/pkg/errors"
+)
+
+// DefaultQuality is the encoding quality used when none is set.
+const DefaultQuality = 80
+
+// maxTranslucentRatio is the ratio of translucent pixels above which a PNG is kept as uploaded,
+// as the lossy encoding of large transparent areas bloats the image.
+const maxTranslucentRatio = 0.25
+
+// Options are the encoding parameters of the compression.
+type Options struct {
+	// Quality is the encoding quality from 1 to 100, DefaultQuality if 0.
+	Quality int
+	// MaxDimension caps the long edge of the image in pixels, 0 meaning uncapped.
+	MaxDimension int
+}
+
+// IsSupported reports whether images of the type are compressed.
+func IsSupported(mimeType string) bool {
+	return mimeType == "image/jpeg" || mimeType == "image/png"
+}
+
+// Compress re-encodes an image of a supported type to the smallest of WebP and JPEG, JPEG being
+// tried for opaque images only. The image is rotated and flipped according to its EXIF
+// orientation, as the metadata isn't kept, and downscaled to the maximum dimension. ok is false
+// when the image is kept as uploaded: an animated or mostly transparent PNG, or an image that
+// doesn't get smaller.
+func Compress(blob []byte, mimeType string, options Options) (compressed []byte, compressedType string, ok bool, err error) {
+	if !IsSupported(mimeType) {
+		return nil, "", false, errors.Errorf("unsupported image type %q", mimeType)
+	}
+	if mimeType == "image/png" && isAnimatedPNG(blob) {
+		return nil, "", false, nil
+	}
+	decoded, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, "", false, errors.Wrap(err, "failed to decode image")
+	}
+	img := imaging.Clone(decoded)
+	translucentRatio := getTranslucentRatio(img)
+	if translucentRatio > maxTranslucentRatio {
+		return nil, "", false, nil
+	}
+	if options.MaxDimension > 0 && max(img.Bounds().Dx(), img.Bounds().Dy()) > options.MaxDimension {
+		img = imaging.Fit(img, options.MaxDimension, options.MaxDimension, imaging.Lanczos)
+	}
+	quality := options.Quality
+	if quality <= 0 || quality > 100 {
+		quality = DefaultQuality
+	}
+
+	var webpBuf bytes.Buffer
+	if err := webp.Encode(&webpBuf, img, webp.Options{Quality: quality, Method: webp.DefaultMethod}); err != nil {
+		return nil, "", false, errors.Wrap(err, "failed to encode webp")
+	}
+	compressed, compressedType = webpBuf.Bytes(), "image/webp"
+	// JPEG has no alpha channel, so it's only tried for opaque images.
+	if translucentRatio == 0 {
+		var jpegBuf bytes.Buffer
+		if err := jpeg.Encode(&jpegBuf, img, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, "", false, errors.Wrap(err, "failed to encode jpeg")
+		}
+		if jpegBuf.Len() < len(compressed) {
+			compressed, compressedType = jpegBuf.Bytes(), "image/jpeg"
+		}
+	}
+	if len(compressed) >= len(blob) {
+		return nil, "", false, nil
+	}
+	return compressed, compressedType, true, nil
+}
+
+// getTranslucentRatio returns the ratio of the pixels of an image that aren't opaque.
+func getTranslucentRatio(img *image.NRGBA) float64 {
+	translucent := 0
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] != 0xff {
+			translucent++
+		}
+	}
+	pixels := len(img.Pix) / 4
+	if pixels == 0 {
+		return 0
+	}
+	return float64(translucent) / float64(pixels)
+}
+
+// isAnimatedPNG reports whether a PNG is an APNG, whose animation control chunk comes before the
+// image data.
+func isAnimatedPNG(blob []byte) bool {
+	const signatureSize = 8
+	for offset := signatureSize; offset+8 <= len(blob); {
+		length := int(binary.BigEndian.Uint32(blob[offset:]))
+		switch string(blob[offset+4 : offset+8]) {
+		case "acTL":
+			return true
+		case "IDAT":
+			return false
+		default:
+		}
+		offset += 12 + length
+	}
+	return false
+}
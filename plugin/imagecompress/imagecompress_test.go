@@ -0,0 +1,88 @@
+package imagecompress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// encodePhoto encodes a PNG of noisy gradients, which compresses as badly as a photo.
+func encodePhoto(t *testing.T, width, height int, alpha uint8) []byte {
+	random := rand.New(rand.NewSource(1))
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			noise := uint8(random.Intn(16))
+			img.Set(x, y, color.NRGBA{R: uint8(x) + noise, G: uint8(y) + noise, B: 128 + noise, A: alpha})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestCompress(t *testing.T) {
+	photo := encodePhoto(t, 1200, 800, 0xff)
+	compressed, compressedType, ok, err := Compress(photo, "image/png", Options{MaxDimension: 600})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Less(t, len(compressed), len(photo))
+	config, format, err := image.DecodeConfig(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	require.Equal(t, compressedType, "image/"+format)
+	require.Equal(t, image.Point{600, 400}, image.Point{config.Width, config.Height})
+
+	// Without a maximum dimension, the image keeps its dimensions.
+	compressed, _, ok, err = Compress(photo, "image/png", Options{Quality: 50})
+	require.NoError(t, err)
+	require.True(t, ok)
+	config, _, err = image.DecodeConfig(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	require.Equal(t, 1200, config.Width)
+
+	_, _, _, err = Compress([]byte("not an image"), "image/jpeg", Options{})
+	require.Error(t, err)
+	_, _, _, err = Compress(photo, "image/gif", Options{})
+	require.Error(t, err)
+}
+
+func TestCompressKeepsImages(t *testing.T) {
+	t.Run("mostly transparent images", func(t *testing.T) {
+		_, _, ok, err := Compress(encodePhoto(t, 200, 200, 0x80), "image/png", Options{})
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("images that don't get smaller", func(t *testing.T) {
+		img := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+		_, _, ok, err := Compress(buf.Bytes(), "image/png", Options{})
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("animated images", func(t *testing.T) {
+		photo := encodePhoto(t, 200, 200, 0xff)
+		// The animation control chunk is inserted after the header chunk.
+		acTL := make([]byte, 8)
+		binary.BigEndian.PutUint32(acTL, 1)
+		chunk := binary.BigEndian.AppendUint32(nil, uint32(len(acTL)))
+		chunk = append(chunk, "acTL"...)
+		chunk = append(chunk, acTL...)
+		chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+		headerEnd := 8 + 12 + 13
+		animated := slices.Concat(photo[:headerEnd], chunk, photo[headerEnd:])
+		_, _, ok, err := Compress(animated, "image/png", Options{})
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,65 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+const (
+	// orientationTag is the EXIF tag of the orientation of the image.
+	orientationTag = 0x0112
+	// orientationNormal is the orientation of an image displayed as stored.
+	orientationNormal = 1
+)
+
+// exifHeader prefixes the EXIF data of JPEG APP1 segments, and of some WebP EXIF chunks.
+var exifHeader = []byte("Exif\x00\x00")
+
+// readOrientation returns the orientation of the TIFF-structured EXIF data, or the normal
+// orientation if it has none or is malformed.
+func readOrientation(tiff []byte) uint16 {
+	tiff = bytes.TrimPrefix(tiff, exifHeader)
+	if len(tiff) < 8 {
+		return orientationNormal
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+	ifdOffset := int(order.Uint32(tiff[4:8]))
+	if ifdOffset+2 > len(tiff) || ifdOffset < 8 {
+		return orientationNormal
+	}
+	count := int(order.Uint16(tiff[ifdOffset:]))
+	for i := 0; i < count; i++ {
+		entry := ifdOffset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		// The orientation is a single SHORT, stored in the value field of its entry.
+		if order.Uint16(tiff[entry:]) == orientationTag && order.Uint16(tiff[entry+2:]) == 3 {
+			if orientation := order.Uint16(tiff[entry+8:]); orientation >= 1 && orientation <= 8 {
+				return orientation
+			}
+		}
+	}
+	return orientationNormal
+}
+
+// orientationEXIF returns TIFF-structured EXIF data with the orientation as its only tag.
+func orientationEXIF(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0)
+	// There is no next IFD.
+	return binary.BigEndian.AppendUint32(tiff, 0)
+}
@@ -0,0 +1,290 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// heifBox is a box of an ISO base media file, as HEIF images are.
+type heifBox struct {
+	boxType string
+	// start and end are the offsets of the content of the box in the file.
+	start, end int
+}
+
+// heifExtent is the range of the file holding a part of the data of an item.
+type heifExtent struct {
+	start, end int
+}
+
+// stripHEIF blanks the EXIF and XMP items of a HEIF image in place: the items are referenced by
+// their offsets in the file, so they're overwritten rather than removed. The EXIF items keep the
+// EXIF orientation; HEIF viewers rotate the images with their irot and imir properties anyway.
+func stripHEIF(blob []byte) ([]byte, error) {
+	boxes, err := readHEIFBoxes(blob, 0, len(blob))
+	if err != nil {
+		return nil, err
+	}
+	if len(boxes) == 0 || boxes[0].boxType != "ftyp" {
+		return nil, ErrUnsupportedFormat
+	}
+	meta, ok := findHEIFBox(boxes, "meta")
+	if !ok {
+		return blob, nil
+	}
+	// The meta box is a full box, with its version and flags first.
+	metaBoxes, err := readHEIFBoxes(blob, meta.start+4, meta.end)
+	if err != nil {
+		return nil, err
+	}
+	iinf, ok := findHEIFBox(metaBoxes, "iinf")
+	if !ok {
+		return blob, nil
+	}
+	exifItems, xmpItems, err := readHEIFMetadataItems(blob, iinf)
+	if err != nil {
+		return nil, err
+	}
+	if len(exifItems) == 0 && len(xmpItems) == 0 {
+		return blob, nil
+	}
+	iloc, ok := findHEIFBox(metaBoxes, "iloc")
+	if !ok {
+		return nil, errors.Wrap(ErrUnsupportedFormat, "missing HEIF iloc box")
+	}
+	idatStart := -1
+	if idat, ok := findHEIFBox(metaBoxes, "idat"); ok {
+		idatStart = idat.start
+	}
+	locations, err := readHEIFItemLocations(blob, iloc, idatStart)
+	if err != nil {
+		return nil, err
+	}
+
+	stripped := bytes.Clone(blob)
+	for itemID := range exifItems {
+		extents := locations[itemID]
+		var item []byte
+		for _, extent := range extents {
+			item = append(item, blob[extent.start:extent.end]...)
+		}
+		// The EXIF data of an item follows the offset of its TIFF header.
+		payload := make([]byte, 4, 34)
+		if len(item) >= 4 {
+			if tiffOffset := 4 + int(binary.BigEndian.Uint32(item)); tiffOffset <= len(item) {
+				payload = append(payload, orientationEXIF(readOrientation(item[tiffOffset:]))...)
+			}
+		}
+		if len(payload) > len(item) {
+			payload = nil
+		}
+		writeHEIFItem(stripped, extents, payload, 0)
+	}
+	for itemID := range xmpItems {
+		// XMP packets may be padded with whitespace.
+		writeHEIFItem(stripped, locations[itemID], nil, ' ')
+	}
+	return stripped, nil
+}
+
+// readHEIFBoxes reads the boxes between two offsets of the file.
+func readHEIFBoxes(blob []byte, start, end int) ([]heifBox, error) {
+	boxes := []heifBox{}
+	for offset := start; offset < end; {
+		if offset+8 > end {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed HEIF box")
+		}
+		size, headerSize := int(binary.BigEndian.Uint32(blob[offset:])), 8
+		switch size {
+		case 0:
+			// The last box extends to the end of the file.
+			size = end - offset
+		case 1:
+			if offset+16 > end {
+				return nil, errors.Wrap(ErrUnsupportedFormat, "malformed HEIF box")
+			}
+			largeSize := binary.BigEndian.Uint64(blob[offset+8:])
+			if largeSize > uint64(end-offset) {
+				return nil, errors.Wrap(ErrUnsupportedFormat, "malformed HEIF box size")
+			}
+			size, headerSize = int(largeSize), 16
+		default:
+		}
+		if size < headerSize || offset+size > end {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed HEIF box size")
+		}
+		boxes = append(boxes, heifBox{boxType: string(blob[offset+4 : offset+8]), start: offset + headerSize, end: offset + size})
+		offset += size
+	}
+	return boxes, nil
+}
+
+func findHEIFBox(boxes []heifBox, boxType string) (heifBox, bool) {
+	for _, box := range boxes {
+		if box.boxType == boxType {
+			return box, true
+		}
+	}
+	return heifBox{}, false
+}
+
+// readHEIFMetadataItems returns the IDs of the EXIF and XMP items of the iinf box.
+func readHEIFMetadataItems(blob []byte, iinf heifBox) (map[uint32]bool, map[uint32]bool, error) {
+	reader := &heifReader{blob: blob[:iinf.end], offset: iinf.start}
+	version := reader.uint(1)
+	reader.skip(3)
+	if version == 0 {
+		reader.uint(2)
+	} else {
+		reader.uint(4)
+	}
+	if reader.err != nil {
+		return nil, nil, reader.err
+	}
+	infes, err := readHEIFBoxes(blob, reader.offset, iinf.end)
+	if err != nil {
+		return nil, nil, err
+	}
+	exifItems, xmpItems := map[uint32]bool{}, map[uint32]bool{}
+	for _, infe := range infes {
+		if infe.boxType != "infe" {
+			continue
+		}
+		reader := &heifReader{blob: blob[:infe.end], offset: infe.start}
+		version := reader.uint(1)
+		reader.skip(3)
+		// The item types were introduced by the version 2 of the box.
+		if version < 2 {
+			continue
+		}
+		// The item ID is 32 bits from version 3 on.
+		var itemID uint32
+		if version == 2 {
+			itemID = uint32(reader.uint(2))
+		} else {
+			itemID = uint32(reader.uint(4))
+		}
+		reader.skip(2)
+		itemType := string(reader.bytes(4))
+		if reader.err != nil {
+			return nil, nil, reader.err
+		}
+		switch itemType {
+		case "Exif":
+			exifItems[itemID] = true
+		case "mime":
+			// The item name precedes the content type.
+			rest := blob[reader.offset:infe.end]
+			parts := bytes.SplitN(rest, []byte{0}, 3)
+			if len(parts) >= 2 && string(parts[1]) == "application/rdf+xml" {
+				xmpItems[itemID] = true
+			}
+		default:
+		}
+	}
+	return exifItems, xmpItems, nil
+}
+
+// readHEIFItemLocations returns the extents of the items of the iloc box, as offsets in the file.
+func readHEIFItemLocations(blob []byte, iloc heifBox, idatStart int) (map[uint32][]heifExtent, error) {
+	reader := &heifReader{blob: blob[:iloc.end], offset: iloc.start}
+	version := reader.uint(1)
+	reader.skip(3)
+	sizes := reader.uint(1)
+	offsetSize, lengthSize := int(sizes>>4), int(sizes&0x0F)
+	sizes = reader.uint(1)
+	baseOffsetSize, indexSize := int(sizes>>4), 0
+	if version == 1 || version == 2 {
+		indexSize = int(sizes & 0x0F)
+	}
+	var itemCount uint64
+	if version < 2 {
+		itemCount = reader.uint(2)
+	} else {
+		itemCount = reader.uint(4)
+	}
+	locations := map[uint32][]heifExtent{}
+	for i := uint64(0); i < itemCount && reader.err == nil; i++ {
+		var itemID uint32
+		if version < 2 {
+			itemID = uint32(reader.uint(2))
+		} else {
+			itemID = uint32(reader.uint(4))
+		}
+		constructionMethod := uint64(0)
+		if version == 1 || version == 2 {
+			constructionMethod = reader.uint(2) & 0x0F
+		}
+		reader.skip(2)
+		baseOffset := reader.uint(baseOffsetSize)
+		extentCount := reader.uint(2)
+		for j := uint64(0); j < extentCount && reader.err == nil; j++ {
+			reader.uint(indexSize)
+			extentOffset := reader.uint(offsetSize)
+			extentLength := reader.uint(lengthSize)
+			start := baseOffset + extentOffset
+			switch constructionMethod {
+			case 0:
+			case 1:
+				if idatStart < 0 {
+					return nil, errors.Wrap(ErrUnsupportedFormat, "missing HEIF idat box")
+				}
+				start += uint64(idatStart)
+			default:
+				// Items constructed from other items are skipped, their data is in the other items.
+				continue
+			}
+			if extentLength == 0 || start+extentLength > uint64(len(blob)) {
+				return nil, errors.Wrap(ErrUnsupportedFormat, "malformed HEIF item extent")
+			}
+			locations[itemID] = append(locations[itemID], heifExtent{start: int(start), end: int(start + extentLength)})
+		}
+	}
+	if reader.err != nil {
+		return nil, reader.err
+	}
+	return locations, nil
+}
+
+// writeHEIFItem overwrites the extents of an item with the payload, then with the fill byte.
+func writeHEIFItem(blob []byte, extents []heifExtent, payload []byte, fill byte) {
+	for _, extent := range extents {
+		n := copy(blob[extent.start:extent.end], payload)
+		payload = payload[n:]
+		for i := extent.start + n; i < extent.end; i++ {
+			blob[i] = fill
+		}
+	}
+}
+
+// heifReader reads the big-endian fields of a box, recording the first read past its end.
+type heifReader struct {
+	blob   []byte
+	offset int
+	err    error
+}
+
+func (r *heifReader) bytes(n int) []byte {
+	if r.err != nil || r.offset+n > len(r.blob) {
+		r.err = errors.Wrap(ErrUnsupportedFormat, "truncated HEIF box")
+		return make([]byte, n)
+	}
+	b := r.blob[r.offset : r.offset+n]
+	r.offset += n
+	return b
+}
+
+func (r *heifReader) skip(n int) {
+	r.bytes(n)
+}
+
+// uint reads an unsigned integer of n bytes, 0 to 8.
+func (r *heifReader) uint(n int) uint64 {
+	var v uint64
+	for _, b := range r.bytes(n) {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
@@ -0,0 +1,40 @@
+// Package imagemeta strips the metadata of uploaded images, as the GPS coordinates and the device
+// serial numbers of the EXIF and XMP data of photos, without re-encoding their pixels.
+package imagemeta
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedFormat is returned for images whose container can't be parsed.
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// strippers are the metadata strippers of the supported image types.
+var strippers = map[string]func([]byte) ([]byte, error){
+	"image/jpeg": stripJPEG,
+	"image/png":  stripPNG,
+	"image/webp": stripWebP,
+	"image/heic": stripHEIF,
+	"image/heif": stripHEIF,
+}
+
+// IsSupported reports whether the metadata of the image type is stripped.
+func IsSupported(mimeType string) bool {
+	_, ok := strippers[mimeType]
+	return ok
+}
+
+// Strip removes the EXIF and XMP metadata of an image of a supported type. The EXIF orientation
+// is kept, rewritten as the only EXIF tag, so that the image is still displayed upright; the
+// pixels and the dimensions of the image are unchanged. Images of other types are returned as is.
+func Strip(blob []byte, mimeType string) ([]byte, error) {
+	strip, ok := strippers[mimeType]
+	if !ok {
+		return blob, nil
+	}
+	stripped, err := strip(blob)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to strip the metadata of %s image", mimeType)
+	}
+	return stripped, nil
+}
@@ -0,0 +1,287 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// secretCamera is the camera make of the test EXIF data.
+	secretCamera = "SecretCam SN-0042"
+	// secretXMP is the content of the test XMP packets.
+	secretXMP = "<x:xmpmeta><exif:GPSLatitude>48,51.4N</exif:GPSLatitude></x:xmpmeta>"
+)
+
+// testEXIF returns little-endian TIFF-structured EXIF data with a camera make, a GPS IFD and the
+// orientation, if not 0.
+func testEXIF(orientation uint16) []byte {
+	order := binary.LittleEndian
+	entries := [][]byte{}
+	entry := func(tag, valueType uint16, count, value uint32) []byte {
+		b := order.AppendUint16(nil, tag)
+		b = order.AppendUint16(b, valueType)
+		b = order.AppendUint32(b, count)
+		return order.AppendUint32(b, value)
+	}
+	entryCount := 2
+	if orientation != 0 {
+		entryCount++
+	}
+	ifdEnd := 8 + 2 + entryCount*12 + 4
+	makeOffset := ifdEnd
+	gpsOffset := makeOffset + len(secretCamera) + 1
+	entries = append(entries, entry(0x010F, 2, uint32(len(secretCamera)+1), uint32(makeOffset)))
+	if orientation != 0 {
+		entries = append(entries, entry(orientationTag, 3, 1, uint32(orientation)))
+	}
+	entries = append(entries, entry(0x8825, 4, 1, uint32(gpsOffset)))
+
+	tiff := []byte("II\x2a\x00")
+	tiff = order.AppendUint32(tiff, 8)
+	tiff = order.AppendUint16(tiff, uint16(entryCount))
+	for _, entry := range entries {
+		tiff = append(tiff, entry...)
+	}
+	tiff = order.AppendUint32(tiff, 0)
+	tiff = append(tiff, []byte(secretCamera+"\x00")...)
+	// The GPS IFD has the latitude reference, "N", as its only tag.
+	tiff = order.AppendUint16(tiff, 1)
+	tiff = append(tiff, entry(0x0001, 2, 2, uint32('N'))...)
+	return order.AppendUint32(tiff, 0)
+}
+
+func requireStripped(t *testing.T, stripped []byte) {
+	require.NotContains(t, string(stripped), secretCamera)
+	require.NotContains(t, string(stripped), "GPSLatitude")
+	require.False(t, bytes.Contains(stripped, []byte{0x25, 0x88}), "the GPS IFD is stripped")
+}
+
+func testImage() image.Image {
+	return image.NewRGBA(image.Rect(0, 0, 40, 30))
+}
+
+func requireDimensions(t *testing.T, blob []byte) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(blob))
+	require.NoError(t, err)
+	require.Equal(t, image.Point{40, 30}, image.Point{config.Width, config.Height})
+}
+
+func jpegSegment(marker byte, data []byte) []byte {
+	segment := []byte{0xFF, marker}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(data)+2))
+	return append(segment, data...)
+}
+
+func TestStripJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, testImage(), nil))
+	encoded := buf.Bytes()
+
+	for orientation := uint16(0); orientation <= 8; orientation++ {
+		blob := bytes.Clone(encoded[:2])
+		blob = append(blob, jpegSegment(jpegMarkerAPP1, append(bytes.Clone(exifHeader), testEXIF(orientation)...))...)
+		blob = append(blob, jpegSegment(jpegMarkerAPP1, append(bytes.Clone(xmpHeader), secretXMP...))...)
+		blob = append(blob, encoded[2:]...)
+
+		stripped, err := Strip(blob, "image/jpeg")
+		require.NoError(t, err)
+		requireStripped(t, stripped)
+		requireDimensions(t, stripped)
+		_, err = jpeg.Decode(bytes.NewReader(stripped))
+		require.NoError(t, err)
+
+		exif := bytes.Index(stripped, exifHeader)
+		if orientation <= orientationNormal {
+			require.Equal(t, -1, exif, "no EXIF data is kept for the normal orientation")
+			require.Len(t, stripped, len(encoded))
+			continue
+		}
+		require.NotEqual(t, -1, exif)
+		require.Equal(t, orientation, readOrientation(stripped[exif:]))
+	}
+
+	_, err := Strip([]byte("not a jpeg"), "image/jpeg")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func pngChunk(chunkType string, data []byte) []byte {
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, data...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+func TestStripPNG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, testImage()))
+	encoded := buf.Bytes()
+	// The IHDR chunk follows the 8-byte signature.
+	ihdrEnd := 8 + 12 + 13
+
+	for orientation := uint16(0); orientation <= 8; orientation++ {
+		blob := bytes.Clone(encoded[:ihdrEnd])
+		blob = append(blob, pngChunk("eXIf", testEXIF(orientation))...)
+		blob = append(blob, pngChunk("iTXt", append([]byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00"), secretXMP...))...)
+		blob = append(blob, pngChunk("tEXt", []byte("Comment\x00"+secretCamera))...)
+		blob = append(blob, encoded[ihdrEnd:]...)
+
+		stripped, err := Strip(blob, "image/png")
+		require.NoError(t, err)
+		requireStripped(t, stripped)
+		requireDimensions(t, stripped)
+		_, err = png.Decode(bytes.NewReader(stripped))
+		require.NoError(t, err)
+
+		exif := bytes.Index(stripped, []byte("eXIf"))
+		if orientation <= orientationNormal {
+			require.Equal(t, -1, exif)
+			require.Equal(t, encoded, stripped)
+			continue
+		}
+		require.NotEqual(t, -1, exif)
+		require.Equal(t, orientation, readOrientation(stripped[exif+4:]))
+	}
+
+	_, err := Strip([]byte("not a png"), "image/png")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func riffChunk(chunkType string, data []byte) []byte {
+	chunk := append([]byte(chunkType), binary.LittleEndian.AppendUint32(nil, uint32(len(data)))...)
+	chunk = append(chunk, data...)
+	if len(data)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+// testWebP returns an extended WebP file of 40x30 pixels, with the VP8X flags and the chunks.
+func testWebP(flags byte, chunks ...[]byte) []byte {
+	// The canvas width and height minus one are 24-bit little-endian integers.
+	vp8x := []byte{flags, 0, 0, 0, 39, 0, 0, 29, 0, 0}
+	body := append([]byte("WEBP"), riffChunk("VP8X", vp8x)...)
+	body = append(body, riffChunk("VP8L", []byte{0x2F, 0x27, 0xC0, 0x07, 0x00})...)
+	for _, chunk := range chunks {
+		body = append(body, chunk...)
+	}
+	return append(append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(len(body)))...), body...)
+}
+
+func TestStripWebP(t *testing.T) {
+	for orientation := uint16(0); orientation <= 8; orientation++ {
+		blob := testWebP(0x08|0x04, riffChunk("EXIF", testEXIF(orientation)), riffChunk("XMP ", []byte(secretXMP)))
+
+		stripped, err := Strip(blob, "image/webp")
+		require.NoError(t, err)
+		requireStripped(t, stripped)
+		require.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+		// The canvas size is unchanged.
+		require.Equal(t, blob[24:30], stripped[24:30])
+
+		flags := stripped[20]
+		require.Zero(t, flags&0x04, "the XMP flag is cleared")
+		exif := bytes.Index(stripped, []byte("EXIF"))
+		if orientation <= orientationNormal {
+			require.Equal(t, -1, exif)
+			require.Zero(t, flags&0x08)
+			require.Equal(t, testWebP(0), stripped)
+			continue
+		}
+		require.NotEqual(t, -1, exif)
+		require.NotZero(t, flags&0x08)
+		require.Equal(t, orientation, readOrientation(stripped[exif+8:]))
+	}
+
+	_, err := Strip([]byte("RIFF\x04\x00\x00\x00WEBX"), "image/webp")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func isoBox(boxType string, data []byte) []byte {
+	box := binary.BigEndian.AppendUint32(nil, uint32(len(data)+8))
+	return append(append(box, boxType...), data...)
+}
+
+func isoFullBox(boxType string, version byte, data []byte) []byte {
+	return isoBox(boxType, append([]byte{version, 0, 0, 0}, data...))
+}
+
+// testHEIF returns a HEIF file with an EXIF item and an XMP item stored in its mdat box.
+func testHEIF(exif []byte) []byte {
+	infe := func(itemID uint16, itemType string, extra string) []byte {
+		data := binary.BigEndian.AppendUint16(nil, itemID)
+		data = append(data, 0, 0)
+		data = append(data, itemType...)
+		return isoFullBox("infe", 2, append(data, extra...))
+	}
+	iinfData := binary.BigEndian.AppendUint16(nil, 3)
+	iinfData = append(iinfData, infe(1, "hvc1", "\x00")...)
+	iinfData = append(iinfData, infe(2, "Exif", "\x00")...)
+	iinfData = append(iinfData, infe(3, "mime", "XMP\x00application/rdf+xml\x00")...)
+	iinf := isoFullBox("iinf", 0, iinfData)
+
+	ftyp := isoBox("ftyp", []byte("heic\x00\x00\x00\x00mif1heic"))
+	build := func(mdatStart int) []byte {
+		// The iloc box has 4-byte offsets and lengths, and no base offsets.
+		iloc := []byte{0x44, 0x00}
+		iloc = binary.BigEndian.AppendUint16(iloc, 2)
+		for _, item := range []struct {
+			itemID uint16
+			offset int
+			length int
+		}{{2, mdatStart + 8, len(exif)}, {3, mdatStart + 8 + len(exif), len(secretXMP)}} {
+			iloc = binary.BigEndian.AppendUint16(iloc, item.itemID)
+			iloc = binary.BigEndian.AppendUint16(iloc, 0)
+			iloc = binary.BigEndian.AppendUint16(iloc, 1)
+			iloc = binary.BigEndian.AppendUint32(iloc, uint32(item.offset))
+			iloc = binary.BigEndian.AppendUint32(iloc, uint32(item.length))
+		}
+		meta := isoFullBox("meta", 0, append(bytes.Clone(iinf), isoFullBox("iloc", 0, iloc)...))
+		blob := append(bytes.Clone(ftyp), meta...)
+		return append(blob, isoBox("mdat", append(bytes.Clone(exif), secretXMP...))...)
+	}
+	blob := build(0)
+	return build(len(blob) - 8 - len(exif) - len(secretXMP))
+}
+
+func TestStripHEIF(t *testing.T) {
+	for orientation := uint16(0); orientation <= 8; orientation++ {
+		// The EXIF data of an item follows the offset of its TIFF header, here after the EXIF header.
+		exif := binary.BigEndian.AppendUint32(nil, uint32(len(exifHeader)))
+		exif = append(append(exif, exifHeader...), testEXIF(orientation)...)
+		blob := testHEIF(exif)
+
+		stripped, err := Strip(blob, "image/heic")
+		require.NoError(t, err)
+		requireStripped(t, stripped)
+		require.Len(t, stripped, len(blob))
+
+		mdat := bytes.Index(stripped, []byte("mdat")) + 4
+		item := stripped[mdat : mdat+len(exif)]
+		tiffOffset := 4 + int(binary.BigEndian.Uint32(item))
+		expected := orientation
+		if expected == 0 {
+			expected = orientationNormal
+		}
+		require.Equal(t, expected, readOrientation(item[tiffOffset:]))
+		require.Equal(t, bytes.Repeat([]byte(" "), len(secretXMP)), stripped[mdat+len(exif):])
+	}
+
+	_, err := Strip([]byte("not a heif image"), "image/heic")
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestStripUnsupportedType(t *testing.T) {
+	blob := []byte("GIF89a" + secretCamera)
+	stripped, err := Strip(blob, "image/gif")
+	require.NoError(t, err)
+	require.Equal(t, blob, stripped)
+	require.False(t, IsSupported("image/gif"))
+	require.True(t, IsSupported("image/heic"))
+}
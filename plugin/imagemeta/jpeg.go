@@ -0,0 +1,84 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	jpegMarkerSOS   = 0xDA
+	jpegMarkerEOI   = 0xD9
+	jpegMarkerAPP1  = 0xE1
+	jpegMarkerAPP13 = 0xED
+)
+
+var (
+	// xmpHeader prefixes the XMP packets of JPEG APP1 segments.
+	xmpHeader = []byte("http://ns.adobe.com/xap/1.0/\x00") //nolint:revive // The XMP namespace is an identifier, not a URL.
+	// xmpExtensionHeader prefixes the extended XMP packets of JPEG APP1 segments.
+	xmpExtensionHeader = []byte("http://ns.adobe.com/xmp/extension/\x00") //nolint:revive // The XMP namespace is an identifier, not a URL.
+)
+
+// stripJPEG removes the EXIF and XMP APP1 segments and the Photoshop APP13 segment, which holds
+// the IPTC data, of a JPEG image. The other segments, as the ICC profile, and the entropy-coded
+// data are copied as is.
+func stripJPEG(blob []byte) ([]byte, error) {
+	if len(blob) < 4 || blob[0] != 0xFF || blob[1] != 0xD8 {
+		return nil, ErrUnsupportedFormat
+	}
+	stripped := bytes.NewBuffer(make([]byte, 0, len(blob)))
+	stripped.Write(blob[:2])
+	orientation, exifPosition := uint16(orientationNormal), -1
+	offset := 2
+	for {
+		if offset+4 > len(blob) || blob[offset] != 0xFF {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed JPEG segment")
+		}
+		marker := blob[offset+1]
+		if marker == 0xFF {
+			// Markers may be preceded by fill bytes.
+			offset++
+			continue
+		}
+		if marker == jpegMarkerEOI {
+			stripped.Write(blob[offset:])
+			break
+		}
+		length := int(binary.BigEndian.Uint16(blob[offset+2:]))
+		end := offset + 2 + length
+		if length < 2 || end > len(blob) {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed JPEG segment length")
+		}
+		segment, data := blob[offset:end], blob[offset+4:end]
+		switch {
+		case marker == jpegMarkerAPP1 && bytes.HasPrefix(data, exifHeader):
+			orientation = readOrientation(data)
+			if exifPosition < 0 {
+				exifPosition = stripped.Len()
+			}
+		case marker == jpegMarkerAPP1 && (bytes.HasPrefix(data, xmpHeader) || bytes.HasPrefix(data, xmpExtensionHeader)):
+		case marker == jpegMarkerAPP13:
+		default:
+			stripped.Write(segment)
+		}
+		offset = end
+		if marker == jpegMarkerSOS {
+			// The entropy-coded data and the segments after it are copied as is.
+			stripped.Write(blob[offset:])
+			break
+		}
+	}
+
+	result := stripped.Bytes()
+	if orientation == orientationNormal {
+		return result, nil
+	}
+	data := append(append([]byte{}, exifHeader...), orientationEXIF(orientation)...)
+	segment := []byte{0xFF, jpegMarkerAPP1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(data)+2))
+	segment = append(segment, data...)
+	// The orientation is written where the EXIF data was.
+	return append(result[:exifPosition], append(segment, result[exifPosition:]...)...), nil
+}
@@ -0,0 +1,61 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/pkg/errors"
+)
+
+// pngSignature starts every PNG image.
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// stripPNG removes the eXIf chunk and the text chunks, which hold the XMP packet and the raw
+// EXIF profiles, of a PNG image. The other chunks are copied as is.
+func stripPNG(blob []byte) ([]byte, error) {
+	if !bytes.HasPrefix(blob, pngSignature) {
+		return nil, ErrUnsupportedFormat
+	}
+	stripped := bytes.NewBuffer(make([]byte, 0, len(blob)))
+	stripped.Write(pngSignature)
+	orientation, exifPosition := uint16(orientationNormal), -1
+	offset := len(pngSignature)
+	for offset < len(blob) {
+		if offset+12 > len(blob) {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed PNG chunk")
+		}
+		length := int(binary.BigEndian.Uint32(blob[offset:]))
+		end := offset + 12 + length
+		if length < 0 || end > len(blob) {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed PNG chunk length")
+		}
+		chunkType := string(blob[offset+4 : offset+8])
+		switch chunkType {
+		case "eXIf":
+			orientation = readOrientation(blob[offset+8 : offset+8+length])
+			if exifPosition < 0 {
+				exifPosition = stripped.Len()
+			}
+		case "tEXt", "zTXt", "iTXt":
+		default:
+			stripped.Write(blob[offset:end])
+		}
+		offset = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+
+	result := stripped.Bytes()
+	if orientation == orientationNormal {
+		return result, nil
+	}
+	data := orientationEXIF(orientation)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, "eXIf"...)
+	chunk = append(chunk, data...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	// The orientation is written where the EXIF data was.
+	return append(result[:exifPosition], append(chunk, result[exifPosition:]...)...), nil
+}
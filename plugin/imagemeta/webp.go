@@ -0,0 +1,74 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// webpFlagEXIF and webpFlagXMP are the flags of the VP8X chunk of a WebP image with EXIF and XMP data.
+	webpFlagEXIF = 0x08
+	webpFlagXMP  = 0x04
+)
+
+// stripWebP removes the EXIF and XMP chunks of a WebP image, and clears their flags in its VP8X
+// chunk. Simple WebP images, without a VP8X chunk, have no metadata.
+func stripWebP(blob []byte) ([]byte, error) {
+	if len(blob) < 12 || string(blob[:4]) != "RIFF" || string(blob[8:12]) != "WEBP" {
+		return nil, ErrUnsupportedFormat
+	}
+	stripped := bytes.NewBuffer(make([]byte, 0, len(blob)))
+	stripped.Write(blob[:12])
+	orientation, vp8xPosition := uint16(orientationNormal), -1
+	offset := 12
+	for offset < len(blob) {
+		if offset+8 > len(blob) {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed WebP chunk")
+		}
+		size := int(binary.LittleEndian.Uint32(blob[offset+4:]))
+		// Chunks are padded to an even size.
+		end := offset + 8 + size + size%2
+		if size < 0 || offset+8+size > len(blob) {
+			return nil, errors.Wrap(ErrUnsupportedFormat, "malformed WebP chunk size")
+		}
+		end = min(end, len(blob))
+		switch string(blob[offset : offset+4]) {
+		case "EXIF":
+			orientation = readOrientation(blob[offset+8 : offset+8+size])
+		case "XMP ":
+		case "VP8X":
+			if size < 10 {
+				return nil, errors.Wrap(ErrUnsupportedFormat, "malformed WebP VP8X chunk")
+			}
+			vp8xPosition = stripped.Len()
+			stripped.Write(blob[offset:end])
+		default:
+			stripped.Write(blob[offset:end])
+		}
+		offset = end
+	}
+	if vp8xPosition < 0 {
+		return blob, nil
+	}
+
+	if orientation != orientationNormal {
+		// The EXIF chunk follows the image data.
+		data := orientationEXIF(orientation)
+		stripped.WriteString("EXIF")
+		stripped.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(data))))
+		stripped.Write(data)
+		if len(data)%2 == 1 {
+			stripped.WriteByte(0)
+		}
+	}
+	result := stripped.Bytes()
+	flags := &result[vp8xPosition+8]
+	*flags &^= webpFlagEXIF | webpFlagXMP
+	if orientation != orientationNormal {
+		*flags |= webpFlagEXIF
+	}
+	binary.LittleEndian.PutUint32(result[4:], uint32(len(result)-8))
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package ast
+
+import (
+	"strconv"
+
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// FootnoteReferenceNode represents a [^label] footnote reference in the markdown AST.
+type FootnoteReferenceNode struct {
+	gast.BaseInline
+
+	// Label is the label between "[^" and "]".
+	Label []byte
+
+	// Index is the number of the footnote, in the order of the first references.
+	Index int
+
+	// RefIndex is the index of the reference among the references to the same footnote, from 0.
+	RefIndex int
+}
+
+// KindFootnoteReference is the NodeKind for FootnoteReferenceNode.
+var KindFootnoteReference = gast.NewNodeKind("FootnoteReference")
+
+// Kind returns KindFootnoteReference.
+func (*FootnoteReferenceNode) Kind() gast.NodeKind {
+	return KindFootnoteReference
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *FootnoteReferenceNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Label": string(n.Label),
+		"Index": strconv.Itoa(n.Index),
+	}, nil)
+}
+
+// FootnoteDefinitionNode represents a [^label]: footnote definition in the markdown AST. The
+// definition stays where it's written, its children are the content of the footnote.
+type FootnoteDefinitionNode struct {
+	gast.BaseBlock
+
+	// Label is the label between "[^" and "]:".
+	Label []byte
+
+	// Index is the number of the footnote, or 0 if the definition isn't shown: it isn't
+	// referenced, or another definition of the label comes first.
+	Index int
+
+	// RefCount is the number of references to the footnote.
+	RefCount int
+}
+
+// KindFootnoteDefinition is the NodeKind for FootnoteDefinitionNode.
+var KindFootnoteDefinition = gast.NewNodeKind("FootnoteDefinition")
+
+// Kind returns KindFootnoteDefinition.
+func (*FootnoteDefinitionNode) Kind() gast.NodeKind {
+	return KindFootnoteDefinition
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *FootnoteDefinitionNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Label": string(n.Label),
+		"Index": strconv.Itoa(n.Index),
+	}, nil)
+}
+
+// FootnoteListNode represents the footnotes section at the end of the document. It has no
+// children, it lists the definitions of the referenced footnotes in the order of their numbers.
+type FootnoteListNode struct {
+	gast.BaseBlock
+
+	// Definitions are the definitions of the footnotes, Definitions[i] is footnote i+1.
+	Definitions []*FootnoteDefinitionNode
+}
+
+// KindFootnoteList is the NodeKind for FootnoteListNode.
+var KindFootnoteList = gast.NewNodeKind("FootnoteList")
+
+// Kind returns KindFootnoteList.
+func (*FootnoteListNode) Kind() gast.NodeKind {
+	return KindFootnoteList
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *FootnoteListNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Count": strconv.Itoa(len(n.Definitions)),
+	}, nil)
+}
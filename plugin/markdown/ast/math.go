@@ -0,0 +1,62 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// InlineMathNode represents $inline$ math in the markdown AST.
+type InlineMathNode struct {
+	gast.BaseInline
+
+	// Value is the raw TeX between the $ delimiters.
+	Value []byte
+}
+
+// KindInlineMath is the NodeKind for InlineMathNode.
+var KindInlineMath = gast.NewNodeKind("InlineMath")
+
+// Kind returns KindInlineMath.
+func (*InlineMathNode) Kind() gast.NodeKind {
+	return KindInlineMath
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *InlineMathNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Value": string(n.Value),
+	}, nil)
+}
+
+// MathBlockNode represents $$block$$ or \[block\] math in the markdown AST.
+type MathBlockNode struct {
+	gast.BaseBlock
+
+	// Opener and Closer are the delimiters of the block, "$$" or "\[" and "\]".
+	Opener []byte
+	Closer []byte
+
+	// Value is the raw TeX between the delimiters, including the line breaks, so that the block
+	// is rendered back to markdown as it was written.
+	Value []byte
+}
+
+// KindMathBlock is the NodeKind for MathBlockNode.
+var KindMathBlock = gast.NewNodeKind("MathBlock")
+
+// Kind returns KindMathBlock.
+func (*MathBlockNode) Kind() gast.NodeKind {
+	return KindMathBlock
+}
+
+// IsRaw returns true, the content of math blocks isn't parsed as markdown.
+func (*MathBlockNode) IsRaw() bool {
+	return true
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *MathBlockNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Opener": string(n.Opener),
+		"Value":  string(n.Value),
+	}, nil)
+}
@@ -0,0 +1,38 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// MermaidBlockNode represents a fenced code block tagged `mermaid` in the markdown AST, which is
+// rendered as a diagram.
+type MermaidBlockNode struct {
+	gast.BaseBlock
+
+	// Info is the raw info string of the fence, e.g. "mermaid".
+	Info []byte
+
+	// Definition is the raw diagram definition between the fences, including the line breaks.
+	Definition []byte
+}
+
+// KindMermaidBlock is the NodeKind for MermaidBlockNode.
+var KindMermaidBlock = gast.NewNodeKind("MermaidBlock")
+
+// Kind returns KindMermaidBlock.
+func (*MermaidBlockNode) Kind() gast.NodeKind {
+	return KindMermaidBlock
+}
+
+// IsRaw returns true, the definition of diagrams isn't parsed as markdown.
+func (*MermaidBlockNode) IsRaw() bool {
+	return true
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *MermaidBlockNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Info":       string(n.Info),
+		"Definition": string(n.Definition),
+	}, nil)
+}
@@ -0,0 +1,44 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+)
+
+// WikiLinkNode represents a [[target]] or [[target|label]] wiki link in the markdown AST.
+type WikiLinkNode struct {
+	gast.BaseInline
+
+	// Target is the slug or title of the linked memo, without the surrounding spaces.
+	Target []byte
+
+	// Label is the text shown instead of the target, or nil if there's none.
+	Label []byte
+
+	// Href is the URL of the linked memo, set before rendering to HTML. A link without Href is
+	// missing.
+	Href string
+}
+
+// KindWikiLink is the NodeKind for WikiLinkNode.
+var KindWikiLink = gast.NewNodeKind("WikiLink")
+
+// Kind returns KindWikiLink.
+func (*WikiLinkNode) Kind() gast.NodeKind {
+	return KindWikiLink
+}
+
+// DisplayText returns the text of the link, its label or else its target.
+func (n *WikiLinkNode) DisplayText() []byte {
+	if n.Label != nil {
+		return n.Label
+	}
+	return n.Target
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *WikiLinkNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Target": string(n.Target),
+		"Label":  string(n.Label),
+	}, nil)
+}
@@ -0,0 +1,107 @@
+package extensions
+
+import (
+	"fmt"
+
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type footnoteExtension struct{}
+
+// FootnoteExtension is a goldmark extension for [^label] footnote references and [^label]:
+// footnote definitions.
+var FootnoteExtension = &footnoteExtension{}
+
+// Extend extends the goldmark parser with footnotes, and renders them to HTML as superscript
+// links to a footnotes section at the end.
+func (*footnoteExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			// Priority 999 - run before paragraphs (1000), which take link reference definitions
+			util.Prioritized(mparser.NewFootnoteDefinitionParser(), 999),
+		),
+		parser.WithInlineParsers(
+			// Priority 101 - run before links (200)
+			util.Prioritized(mparser.NewFootnoteReferenceParser(), 101),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(mparser.NewFootnoteTransformer(), 999),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&footnoteHTMLRenderer{markdown: m}, 500),
+		),
+	)
+}
+
+// footnoteHTMLRenderer renders the references as `<sup class="footnote-ref">` links, and the
+// footnote list as a `<section class="footnotes">` with the content of the definitions and links
+// back to the references. The definitions aren't rendered where they're written.
+type footnoteHTMLRenderer struct {
+	// markdown renders the content of the definitions in the footnotes section.
+	markdown goldmark.Markdown
+}
+
+// RegisterFuncs registers the renderers of footnote nodes.
+func (r *footnoteHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindFootnoteReference, r.renderFootnoteReference)
+	reg.Register(mast.KindFootnoteDefinition, r.renderFootnoteDefinition)
+	reg.Register(mast.KindFootnoteList, r.renderFootnoteList)
+}
+
+// footnoteReferenceID returns the id of a reference to a footnote.
+func footnoteReferenceID(index, refIndex int) string {
+	if refIndex == 0 {
+		return fmt.Sprintf("fnref-%d", index)
+	}
+	return fmt.Sprintf("fnref-%d-%d", index, refIndex+1)
+}
+
+func (*footnoteHTMLRenderer) renderFootnoteReference(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	reference, ok := node.(*mast.FootnoteReferenceNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	_, _ = fmt.Fprintf(w, `<sup class="footnote-ref"><a href="#fn-%d" id="%s">%d</a></sup>`, reference.Index, footnoteReferenceID(reference.Index, reference.RefIndex), reference.Index)
+	return gast.WalkContinue, nil
+}
+
+func (*footnoteHTMLRenderer) renderFootnoteDefinition(util.BufWriter, []byte, gast.Node, bool) (gast.WalkStatus, error) {
+	return gast.WalkSkipChildren, nil
+}
+
+func (r *footnoteHTMLRenderer) renderFootnoteList(w util.BufWriter, source []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	list, ok := node.(*mast.FootnoteListNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString("<section class=\"footnotes\">\n<ol>\n")
+	for _, definition := range list.Definitions {
+		_, _ = fmt.Fprintf(w, "<li id=\"fn-%d\">\n", definition.Index)
+		for child := definition.FirstChild(); child != nil; child = child.NextSibling() {
+			if err := r.markdown.Renderer().Render(w, source, child); err != nil {
+				return gast.WalkStop, err
+			}
+		}
+		for i := 0; i < definition.RefCount; i++ {
+			_, _ = fmt.Fprintf(w, `<a href="#%s" class="footnote-backref">&#x21a9;&#xfe0e;</a>`, footnoteReferenceID(definition.Index, i))
+		}
+		_, _ = w.WriteString("\n</li>\n")
+	}
+	_, _ = w.WriteString("</ol>\n</section>\n")
+	return gast.WalkContinue, nil
+}
@@ -0,0 +1,73 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type mathExtension struct{}
+
+// MathExtension is a goldmark extension for $inline$, $$block$$ and \[block\] math syntax.
+var MathExtension = &mathExtension{}
+
+// Extend extends the goldmark parser with math support, and renders math to HTML for KaTeX.
+func (*mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			// Priority 750 - run after fenced code blocks (700) and before blockquotes (800)
+			util.Prioritized(mparser.NewMathBlockParser(), 750),
+		),
+		parser.WithInlineParsers(
+			util.Prioritized(mparser.NewInlineMathParser(), 200),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&mathHTMLRenderer{}, 500),
+		),
+	)
+}
+
+// mathHTMLRenderer renders math as `<span class="math-inline">` and `<div class="math-display">`
+// with the escaped TeX, which the frontend renders with KaTeX.
+type mathHTMLRenderer struct{}
+
+// RegisterFuncs registers the renderers of math nodes.
+func (r *mathHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindInlineMath, r.renderInlineMath)
+	reg.Register(mast.KindMathBlock, r.renderMathBlock)
+}
+
+func (*mathHTMLRenderer) renderInlineMath(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	mathNode, ok := node.(*mast.InlineMathNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<span class="math-inline">`)
+	_, _ = w.Write(util.EscapeHTML(mathNode.Value))
+	_, _ = w.WriteString("</span>")
+	return gast.WalkContinue, nil
+}
+
+func (*mathHTMLRenderer) renderMathBlock(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	mathNode, ok := node.(*mast.MathBlockNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<div class="math-display">`)
+	_, _ = w.Write(util.EscapeHTML(mathNode.Value))
+	_, _ = w.WriteString("</div>\n")
+	return gast.WalkContinue, nil
+}
@@ -0,0 +1,54 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type mermaidExtension struct{}
+
+// MermaidExtension is a goldmark extension for mermaid diagrams in fenced code blocks.
+var MermaidExtension = &mermaidExtension{}
+
+// Extend extends the goldmark parser with mermaid diagrams, and renders them to HTML for mermaid.js.
+func (*mermaidExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(mparser.NewMermaidTransformer(), 100),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&mermaidHTMLRenderer{}, 500),
+		),
+	)
+}
+
+// mermaidHTMLRenderer renders diagrams as `<pre class="mermaid">` with the escaped definition,
+// which the frontend renders with mermaid.js.
+type mermaidHTMLRenderer struct{}
+
+// RegisterFuncs registers the renderer of mermaid nodes.
+func (r *mermaidHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindMermaidBlock, r.renderMermaidBlock)
+}
+
+func (*mermaidHTMLRenderer) renderMermaidBlock(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	mermaidNode, ok := node.(*mast.MermaidBlockNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<pre class="mermaid">`)
+	_, _ = w.Write(util.EscapeHTML(mermaidNode.Definition))
+	_, _ = w.WriteString("</pre>\n")
+	return gast.WalkSkipChildren, nil
+}
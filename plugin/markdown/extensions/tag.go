@@ -2,9 +2,12 @@ package extensions
 
 import (
 	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/util"
 
+	mast "github.com/usememos/memos/plugin/markdown/ast"
 	mparser "github.com/usememos/memos/plugin/markdown/parser"
 )
 
@@ -13,7 +16,7 @@ type tagExtension struct{}
 // TagExtension is a goldmark extension for #tag syntax.
 var TagExtension = &tagExtension{}
 
-// Extend extends the goldmark parser with tag support.
+// Extend extends the goldmark parser with tag support, and renders tags to HTML.
 func (*tagExtension) Extend(m goldmark.Markdown) {
 	m.Parser().AddOptions(
 		parser.WithInlineParsers(
@@ -21,4 +24,31 @@ func (*tagExtension) Extend(m goldmark.Markdown) {
 			util.Prioritized(mparser.NewTagParser(), 200),
 		),
 	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&tagHTMLRenderer{}, 500),
+		),
+	)
+}
+
+// tagHTMLRenderer renders tags as `<span class="tag">#tag</span>`.
+type tagHTMLRenderer struct{}
+
+// RegisterFuncs registers the renderer of tag nodes.
+func (r *tagHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindTag, r.renderTag)
+}
+
+func (*tagHTMLRenderer) renderTag(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	tagNode, ok := node.(*mast.TagNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<span class="tag">#`)
+	_, _ = w.Write(util.EscapeHTML(tagNode.Tag))
+	_, _ = w.WriteString("</span>")
+	return gast.WalkContinue, nil
 }
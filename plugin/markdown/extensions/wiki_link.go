@@ -0,0 +1,63 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type wikiLinkExtension struct{}
+
+// WikiLinkExtension is a goldmark extension for [[target]] and [[target|label]] wiki links.
+var WikiLinkExtension = &wikiLinkExtension{}
+
+// Extend extends the goldmark parser with wiki links, and renders them to HTML.
+func (*wikiLinkExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// Priority 102 - run before links (200)
+			util.Prioritized(mparser.NewWikiLinkParser(), 102),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&wikiLinkHTMLRenderer{}, 500),
+		),
+	)
+}
+
+// wikiLinkHTMLRenderer renders the wiki links with a URL as `<a class="wiki-link">`, and the
+// missing ones as `<span class="wiki-link wiki-link-missing">`.
+type wikiLinkHTMLRenderer struct{}
+
+// RegisterFuncs registers the renderer of wiki link nodes.
+func (r *wikiLinkHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindWikiLink, r.renderWikiLink)
+}
+
+func (*wikiLinkHTMLRenderer) renderWikiLink(w util.BufWriter, _ []byte, node gast.Node, entering bool) (gast.WalkStatus, error) {
+	if !entering {
+		return gast.WalkContinue, nil
+	}
+	wikiLink, ok := node.(*mast.WikiLinkNode)
+	if !ok {
+		return gast.WalkContinue, nil
+	}
+	if wikiLink.Href == "" {
+		_, _ = w.WriteString(`<span class="wiki-link wiki-link-missing">`)
+		_, _ = w.Write(util.EscapeHTML(wikiLink.DisplayText()))
+		_, _ = w.WriteString("</span>")
+		return gast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<a class="wiki-link" href="`)
+	_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(wikiLink.Href), false)))
+	_, _ = w.WriteString(`">`)
+	_, _ = w.Write(util.EscapeHTML(wikiLink.DisplayText()))
+	_, _ = w.WriteString("</a>")
+	return gast.WalkContinue, nil
+}
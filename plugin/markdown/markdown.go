@@ -2,8 +2,15 @@ package markdown
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
@@ -11,6 +18,7 @@ import (
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/text"
 
+	"github.com/usememos/memos/internal/util"
 	mast "github.com/usememos/memos/plugin/markdown/ast"
 	"github.com/usememos/memos/plugin/markdown/extensions"
 	"github.com/usememos/memos/plugin/markdown/renderer"
@@ -21,6 +29,85 @@ import (
 type ExtractedData struct {
 	Tags     []string
 	Property *storepb.MemoPayload_Property
+	// MemoLinks are the UIDs of the memos linked to, e.g. by [memo](/memos/{uid}).
+	MemoLinks []string
+	// Mentions are the usernames mentioned with @username, outside of code and links.
+	Mentions []string
+	// WikiLinks are the targets of the [[target]] wiki links.
+	WikiLinks []string
+}
+
+// Task is a task list item of markdown content, e.g. "- [ ] Buy milk".
+type Task struct {
+	// ID identifies the task by its text and the number of tasks with the same text before it, so
+	// that it doesn't change when the task is checked or when other tasks are added or removed.
+	ID string
+	// Text is the markdown of the task after its checkbox, on a single line.
+	Text    string
+	Checked bool
+
+	// offset is the position in the content of the character between the brackets of the checkbox.
+	offset int
+}
+
+// ErrTaskNotFound is returned when content has no task at an index.
+var ErrTaskNotFound = errors.New("task not found")
+
+// memoLinkPathMatcher matches the path of a link to a memo, capturing the memo UID.
+var memoLinkPathMatcher = regexp.MustCompile(`(?:^|/)memos/([a-zA-Z0-9](?:[a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?)/?$`)
+
+// mentionMatcher matches the @username mentions, with the rules of usernames.
+var mentionMatcher = regexp.MustCompile(`@([a-zA-Z0-9](?:[a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?)`)
+
+// extractMentions returns the usernames mentioned in a segment of text of content. A mention
+// isn't part of a word or an email address, so "jane@example.com" doesn't mention "example".
+func extractMentions(content []byte, segment text.Segment) []string {
+	isUsernameByte := func(b byte) bool {
+		return b == '_' || b == '-' || b == '.' || b == '@' || b == '+' ||
+			('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+	}
+	var mentions []string
+	for _, match := range mentionMatcher.FindAllSubmatchIndex(segment.Value(content), -1) {
+		start, end := segment.Start+match[0], segment.Start+match[1]
+		if start > 0 && isUsernameByte(content[start-1]) {
+			continue
+		}
+		if end < len(content) && isUsernameByte(content[end]) && content[end] != '.' {
+			continue
+		}
+		mentions = append(mentions, string(content[segment.Start+match[2]:segment.Start+match[3]]))
+	}
+	return mentions
+}
+
+// isInCodeOrLink reports whether a node is inside a code span or a link.
+func isInCodeOrLink(n gast.Node) bool {
+	for parent := n.Parent(); parent != nil; parent = parent.Parent() {
+		switch parent.Kind() {
+		case gast.KindCodeSpan, gast.KindLink, gast.KindAutoLink:
+			return true
+		default:
+			// Keep looking at the ancestors
+		}
+	}
+	return false
+}
+
+// extractMemoLink returns the UID of the memo a link destination points to, relative or
+// absolute, or "" if it doesn't point to a memo.
+func extractMemoLink(destination string) string {
+	u, err := url.Parse(destination)
+	if err != nil {
+		return ""
+	}
+	if u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	matches := memoLinkPathMatcher.FindStringSubmatch(u.Path)
+	if matches == nil {
+		return ""
+	}
+	return matches[1]
 }
 
 // Service handles markdown metadata extraction.
@@ -40,9 +127,13 @@ type Service interface {
 	// RenderMarkdown renders goldmark AST back to markdown text
 	RenderMarkdown(content []byte) (string, error)
 
-	// RenderHTML renders markdown content to HTML
+	// RenderHTML renders markdown content to HTML, with the wiki links missing
 	RenderHTML(content []byte) (string, error)
 
+	// RenderHTMLWithWikiLinks renders markdown content to HTML, with the wiki links to the URLs
+	// resolve returns for their targets, and missing where it returns ""
+	RenderHTMLWithWikiLinks(content []byte, resolve func(target string) string) (string, error)
+
 	// GenerateSnippet creates plain text summary
 	GenerateSnippet(content []byte, maxLength int) (string, error)
 
@@ -51,6 +142,15 @@ type Service interface {
 
 	// RenameTag renames all occurrences of oldTag to newTag in content
 	RenameTag(content []byte, oldTag, newTag string) (string, error)
+
+	// RemoveTag removes all occurrences of tag, but not its subtags, from content
+	RemoveTag(content []byte, tag string) (string, error)
+
+	// ListTasks returns the task list items of content, in document order
+	ListTasks(content []byte) ([]*Task, error)
+
+	// SetTaskChecked checks or unchecks the task list item at index of content
+	SetTaskChecked(content []byte, index int, checked bool) (string, error)
 }
 
 // service implements the Service interface.
@@ -62,7 +162,11 @@ type service struct {
 type Option func(*config)
 
 type config struct {
-	enableTags bool
+	enableTags      bool
+	enableMath      bool
+	enableMermaid   bool
+	enableFootnotes bool
+	enableWikiLinks bool
 }
 
 // WithTagExtension enables #tag parsing.
@@ -72,6 +176,34 @@ func WithTagExtension() Option {
 	}
 }
 
+// WithMathExtension enables $inline$, $$block$$ and \[block\] math parsing.
+func WithMathExtension() Option {
+	return func(c *config) {
+		c.enableMath = true
+	}
+}
+
+// WithMermaidExtension enables mermaid diagrams in fenced code blocks tagged `mermaid`.
+func WithMermaidExtension() Option {
+	return func(c *config) {
+		c.enableMermaid = true
+	}
+}
+
+// WithFootnoteExtension enables [^label] footnote references and [^label]: definitions.
+func WithFootnoteExtension() Option {
+	return func(c *config) {
+		c.enableFootnotes = true
+	}
+}
+
+// WithWikiLinkExtension enables [[target]] and [[target|label]] wiki link parsing.
+func WithWikiLinkExtension() Option {
+	return func(c *config) {
+		c.enableWikiLinks = true
+	}
+}
+
 // NewService creates a new markdown service with the given options.
 func NewService(opts ...Option) Service {
 	cfg := &config{}
@@ -87,6 +219,18 @@ func NewService(opts ...Option) Service {
 	if cfg.enableTags {
 		exts = append(exts, extensions.TagExtension)
 	}
+	if cfg.enableMath {
+		exts = append(exts, extensions.MathExtension)
+	}
+	if cfg.enableMermaid {
+		exts = append(exts, extensions.MermaidExtension)
+	}
+	if cfg.enableFootnotes {
+		exts = append(exts, extensions.FootnoteExtension)
+	}
+	if cfg.enableWikiLinks {
+		exts = append(exts, extensions.WikiLinkExtension)
+	}
 
 	md := goldmark.New(
 		goldmark.WithExtensions(exts...),
@@ -134,8 +278,8 @@ func (s *service) ExtractTags(content []byte) ([]string, error) {
 		return nil, err
 	}
 
-	// Deduplicate and normalize tags
-	return uniqueLowercase(tags), nil
+	// Deduplicate tags, keeping the first spelling of each
+	return uniqueTags(tags), nil
 }
 
 // ExtractProperties computes boolean properties about the content.
@@ -200,7 +344,35 @@ func (s *service) RenderHTML(content []byte) (string, error) {
 	return buf.String(), nil
 }
 
-// GenerateSnippet creates a plain text summary from markdown content.
+// RenderHTMLWithWikiLinks renders markdown content to HTML, after setting the URLs of the wiki
+// links.
+func (s *service) RenderHTMLWithWikiLinks(content []byte, resolve func(target string) string) (string, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return "", err
+	}
+	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if wikiLink, ok := n.(*mast.WikiLinkNode); ok && entering {
+			wikiLink.Href = resolve(string(wikiLink.Target))
+		}
+		return gast.WalkContinue, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := s.md.Renderer().Render(&buf, content, root); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// DiagramPlaceholder replaces the diagrams in plain text, where their definitions aren't readable.
+const DiagramPlaceholder = "[diagram]"
+
+// GenerateSnippet creates a plain text summary from markdown content. Diagrams are replaced
+// with DiagramPlaceholder, and footnotes are dropped.
 func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error) {
 	root, err := s.parse(content)
 	if err != nil {
@@ -212,9 +384,9 @@ func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error)
 
 	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
 		if entering {
-			// Skip code blocks and code spans entirely
+			// Skip code blocks, code spans and footnotes entirely
 			switch n.Kind() {
-			case gast.KindCodeBlock, gast.KindFencedCodeBlock, gast.KindCodeSpan:
+			case gast.KindCodeBlock, gast.KindFencedCodeBlock, gast.KindCodeSpan, mast.KindFootnoteReference, mast.KindFootnoteDefinition:
 				return gast.WalkSkipChildren, nil
 			default:
 				// Continue walking for other node types
@@ -222,19 +394,25 @@ func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error)
 
 			// Add space before block elements (except first)
 			switch n.Kind() {
-			case gast.KindParagraph, gast.KindHeading, gast.KindListItem:
+			case gast.KindParagraph, gast.KindHeading, gast.KindListItem, mast.KindMermaidBlock:
 				if buf.Len() > 0 && lastNodeWasBlock {
 					buf.WriteByte(' ')
 				}
 			default:
 				// No space needed for other node types
 			}
+
+			if n.Kind() == mast.KindMermaidBlock {
+				buf.WriteString(DiagramPlaceholder)
+				lastNodeWasBlock = false
+				return gast.WalkSkipChildren, nil
+			}
 		}
 
 		if !entering {
 			// Mark that we just exited a block element
 			switch n.Kind() {
-			case gast.KindParagraph, gast.KindHeading, gast.KindListItem:
+			case gast.KindParagraph, gast.KindHeading, gast.KindListItem, mast.KindMermaidBlock:
 				lastNodeWasBlock = true
 			default:
 				// Not a block element
@@ -244,6 +422,11 @@ func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error)
 
 		lastNodeWasBlock = false
 
+		// The wiki links show their label or target
+		if wikiLink, ok := n.(*mast.WikiLinkNode); ok {
+			buf.Write(wikiLink.DisplayText())
+		}
+
 		// Only extract plain text nodes
 		if textNode, ok := n.(*gast.Text); ok {
 			segment := textNode.Segment
@@ -293,8 +476,11 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	}
 
 	data := &ExtractedData{
-		Tags:     []string{},
-		Property: &storepb.MemoPayload_Property{},
+		Tags:      []string{},
+		Property:  &storepb.MemoPayload_Property{},
+		MemoLinks: []string{},
+		Mentions:  []string{},
+		WikiLinks: []string{},
 	}
 
 	// Single walk to collect all data
@@ -308,9 +494,37 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 			data.Tags = append(data.Tags, string(tagNode.Tag))
 		}
 
+		// Extract memo links
+		var destination string
+		switch link := n.(type) {
+		case *gast.Link:
+			destination = string(link.Destination)
+		case *gast.AutoLink:
+			destination = string(link.URL(content))
+		default:
+			// Not a link
+		}
+		if uid := extractMemoLink(destination); uid != "" && !slices.Contains(data.MemoLinks, uid) {
+			data.MemoLinks = append(data.MemoLinks, uid)
+		}
+
+		// Extract mentions
+		if textNode, ok := n.(*gast.Text); ok && !isInCodeOrLink(n) {
+			for _, username := range extractMentions(content, textNode.Segment) {
+				if !slices.Contains(data.Mentions, username) {
+					data.Mentions = append(data.Mentions, username)
+				}
+			}
+		}
+
+		// Extract wiki links
+		if wikiLink, ok := n.(*mast.WikiLinkNode); ok && !slices.Contains(data.WikiLinks, string(wikiLink.Target)) {
+			data.WikiLinks = append(data.WikiLinks, string(wikiLink.Target))
+		}
+
 		// Extract properties based on node kind
 		switch n.Kind() {
-		case gast.KindLink:
+		case gast.KindLink, mast.KindWikiLink:
 			data.Property.HasLink = true
 
 		case gast.KindCodeBlock, gast.KindFencedCodeBlock, gast.KindCodeSpan:
@@ -334,20 +548,26 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 		return nil, err
 	}
 
-	// Deduplicate and normalize tags
-	data.Tags = uniqueLowercase(data.Tags)
+	// Deduplicate tags, keeping the first spelling of each
+	data.Tags = uniqueTags(data.Tags)
 
 	return data, nil
 }
 
-// RenameTag renames all occurrences of oldTag to newTag in content.
+// RenameTag renames all occurrences of oldTag to newTag in content. Tags are matched by their
+// normalized form, and subtags are renamed too, e.g. #Work/project becomes #job/project.
+// Only the tags are rewritten, the rest of the content is kept byte for byte.
 func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, error) {
 	root, err := s.parse(content)
 	if err != nil {
 		return "", err
 	}
 
-	// Walk the AST to find and rename tag nodes
+	// Walk the AST to find the tag nodes to rename
+	normalizedOldTag := util.NormalizeTag(oldTag)
+	depth := strings.Count(oldTag, "/") + 1
+	segments := []text.Segment{}
+	replacements := []string{}
 	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
 		if !entering {
 			return gast.WalkContinue, nil
@@ -355,8 +575,14 @@ func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, erro
 
 		// Check for custom TagNode and rename if it matches
 		if tagNode, ok := n.(*mast.TagNode); ok {
-			if string(tagNode.Tag) == oldTag {
-				tagNode.Tag = []byte(newTag)
+			tag := string(tagNode.Tag)
+			parts := strings.SplitN(tag, "/", depth+1)
+			if util.NormalizeTag(tag) == normalizedOldTag {
+				segments = append(segments, tagNode.Segment)
+				replacements = append(replacements, "#"+newTag)
+			} else if len(parts) > depth && util.NormalizeTag(strings.Join(parts[:depth], "/")) == normalizedOldTag {
+				segments = append(segments, tagNode.Segment)
+				replacements = append(replacements, "#"+newTag+"/"+parts[depth])
 			}
 		}
 
@@ -367,21 +593,145 @@ func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, erro
 		return "", err
 	}
 
-	// Render back to markdown using the already-parsed AST
-	mdRenderer := renderer.NewMarkdownRenderer()
-	return mdRenderer.Render(root, content), nil
+	// Splice the renamed tags into the source, which is walked in document order
+	var b strings.Builder
+	pos := 0
+	for i, segment := range segments {
+		b.Write(content[pos:segment.Start])
+		b.WriteString(replacements[i])
+		pos = segment.Stop
+	}
+	b.Write(content[pos:])
+	return b.String(), nil
+}
+
+func (s *service) RemoveTag(content []byte, tag string) (string, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	// Walk the AST to find the tag nodes to remove
+	normalizedTag := util.NormalizeTag(tag)
+	segments := []text.Segment{}
+	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+
+		if tagNode, ok := n.(*mast.TagNode); ok && util.NormalizeTag(string(tagNode.Tag)) == normalizedTag {
+			segments = append(segments, tagNode.Segment)
+		}
+
+		return gast.WalkContinue, nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+	if len(segments) == 0 {
+		return string(content), nil
+	}
+
+	// Cut the tags from the source with one of the spaces around them, so that the words around
+	// a removed tag stay separated by a single space
+	var b strings.Builder
+	pos := 0
+	for _, segment := range segments {
+		start, stop := segment.Start, segment.Stop
+		if stop < len(content) && content[stop] == ' ' {
+			stop++
+		} else if start > pos && content[start-1] == ' ' {
+			start--
+		}
+		b.Write(content[pos:start])
+		pos = stop
+	}
+	b.Write(content[pos:])
+	return strings.TrimRight(b.String(), " \n"), nil
+}
+
+// ListTasks returns the task list items of content, in document order.
+func (s *service) ListTasks(content []byte) ([]*Task, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*Task{}
+	occurrences := map[string]int{}
+	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		checkBox, ok := n.(*east.TaskCheckBox)
+		if !ok || !entering {
+			return gast.WalkContinue, nil
+		}
+
+		// The checkbox starts the first line of the text of its list item
+		lines := checkBox.Parent().Lines()
+		if lines.Len() == 0 {
+			return gast.WalkContinue, nil
+		}
+		offset := lines.At(0).Start
+		if offset+2 >= len(content) || content[offset] != '[' || content[offset+2] != ']' {
+			return gast.WalkContinue, nil
+		}
+		parts := []string{strings.TrimSpace(string(content[offset+3 : lines.At(0).Stop]))}
+		for i := 1; i < lines.Len(); i++ {
+			line := lines.At(i)
+			parts = append(parts, strings.TrimSpace(string(line.Value(content))))
+		}
+		taskText := strings.TrimSpace(strings.Join(parts, " "))
+
+		occurrences[taskText]++
+		sum := sha256.Sum256([]byte(taskText))
+		id := hex.EncodeToString(sum[:6])
+		if occurrences[taskText] > 1 {
+			id += "-" + strconv.Itoa(occurrences[taskText])
+		}
+		tasks = append(tasks, &Task{ID: id, Text: taskText, Checked: checkBox.IsChecked, offset: offset + 1})
+		return gast.WalkContinue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// SetTaskChecked checks or unchecks the task list item at index of content. Only the checkbox is
+// rewritten, the rest of the content is kept byte for byte.
+func (s *service) SetTaskChecked(content []byte, index int, checked bool) (string, error) {
+	tasks, err := s.ListTasks(content)
+	if err != nil {
+		return "", err
+	}
+	if index < 0 || index >= len(tasks) {
+		return "", ErrTaskNotFound
+	}
+	task := tasks[index]
+	if task.Checked == checked {
+		return string(content), nil
+	}
+
+	updated := bytes.Clone(content)
+	if checked {
+		updated[task.offset] = 'x'
+	} else {
+		updated[task.offset] = ' '
+	}
+	return string(updated), nil
 }
 
-// uniqueLowercase returns unique lowercase strings from input.
-func uniqueLowercase(strs []string) []string {
+// uniqueTags returns the tags without duplicates, compared by their normalized form, e.g.
+// "Café" and "cafe" are the same tag. The first spelling of each tag is kept for display.
+func uniqueTags(tags []string) []string {
 	seen := make(map[string]bool)
 	var result []string
 
-	for _, s := range strs {
-		lower := strings.ToLower(s)
-		if !seen[lower] {
-			seen[lower] = true
-			result = append(result, lower)
+	for _, tag := range tags {
+		normalized := util.NormalizeTag(tag)
+		if !seen[normalized] {
+			seen[normalized] = true
+			result = append(result, tag)
 		}
 	}
 
@@ -12,6 +12,95 @@ func TestNewService(t *testing.T) {
 	assert.NotNil(t, svc)
 }
 
+func TestRenderHTMLTags(t *testing.T) {
+	svc := NewService(WithTagExtension())
+	html, err := svc.RenderHTML([]byte("Hello #work/<b> world"))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Hello <span class=\"tag\">#work/&lt;b&gt;</span> world</p>\n", html)
+}
+
+func TestRenderHTMLMath(t *testing.T) {
+	svc := NewService(WithTagExtension(), WithMathExtension())
+	html, err := svc.RenderHTML([]byte("Inline $a<b$ costs $5 and $10\n\n$$\n*x* #tag\n$$"))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Inline <span class=\"math-inline\">a&lt;b</span> costs $5 and $10</p>\n<div class=\"math-display\">\n*x* #tag\n</div>\n", html)
+
+	// The TeX isn't markdown, so math has no tags.
+	tags, err := svc.ExtractTags([]byte("$\\#x$\n\n$$\n#y\n$$"))
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestRenderHTMLMermaid(t *testing.T) {
+	svc := NewService(WithTagExtension(), WithMermaidExtension())
+	content := "Flow:\n\n```mermaid\ngraph TD\n  A[\"Say <hi>\"] -->|a & b| B\n  B --> C{'x > y'}\n```\n\n```go\nx := 1\n```"
+	html, err := svc.RenderHTML([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Flow:</p>\n<pre class=\"mermaid\">graph TD\n  A[&quot;Say &lt;hi&gt;&quot;] --&gt;|a &amp; b| B\n  B --&gt; C{'x &gt; y'}\n</pre>\n<pre><code class=\"language-go\">x := 1\n</code></pre>\n", html)
+
+	// Previews show a placeholder instead of the definition.
+	snippet, err := svc.GenerateSnippet([]byte("Before\n\n```mermaid\ngraph TD\n  A --> B\n```\n\nAfter"), 100)
+	require.NoError(t, err)
+	assert.Equal(t, "Before [diagram] After", snippet)
+
+	// The definition is rendered back to markdown as written.
+	rendered, err := svc.RenderMarkdown([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, content, rendered)
+}
+
+func TestRenderHTMLFootnotes(t *testing.T) {
+	svc := NewService(WithTagExtension(), WithFootnoteExtension())
+	content := "Text[^1], [^missing] and again[^1].\n\n> Quote[^q]\n>\n> [^q]: In a *quote*\n\n- Item[^2]\n\n  [^2]: In an item\n\n[^1]: First\n[^1]: Duplicate"
+	html, err := svc.RenderHTML([]byte(content))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Text<sup class=\"footnote-ref\"><a href=\"#fn-1\" id=\"fnref-1\">1</a></sup>, [^missing] and again<sup class=\"footnote-ref\"><a href=\"#fn-1\" id=\"fnref-1-2\">1</a></sup>.</p>\n"+
+		"<blockquote>\n<p>Quote<sup class=\"footnote-ref\"><a href=\"#fn-2\" id=\"fnref-2\">2</a></sup></p>\n</blockquote>\n"+
+		"<ul>\n<li>\n<p>Item<sup class=\"footnote-ref\"><a href=\"#fn-3\" id=\"fnref-3\">3</a></sup></p>\n</li>\n</ul>\n"+
+		"<section class=\"footnotes\">\n<ol>\n"+
+		"<li id=\"fn-1\">\n<p>First</p>\n<a href=\"#fnref-1\" class=\"footnote-backref\">&#x21a9;&#xfe0e;</a><a href=\"#fnref-1-2\" class=\"footnote-backref\">&#x21a9;&#xfe0e;</a>\n</li>\n"+
+		"<li id=\"fn-2\">\n<p>In a <em>quote</em></p>\n<a href=\"#fnref-2\" class=\"footnote-backref\">&#x21a9;&#xfe0e;</a>\n</li>\n"+
+		"<li id=\"fn-3\">\n<p>In an item</p>\n<a href=\"#fnref-3\" class=\"footnote-backref\">&#x21a9;&#xfe0e;</a>\n</li>\n"+
+		"</ol>\n</section>\n", html)
+
+	// Previews drop the footnotes.
+	snippet, err := svc.GenerateSnippet([]byte("Text[^1] and more.\n\n[^1]: The note"), 100)
+	require.NoError(t, err)
+	assert.Equal(t, "Text and more.", snippet)
+
+	// The footnotes are rendered back to markdown as written.
+	rendered, err := svc.RenderMarkdown([]byte("Text[^1] and [^missing].\n\n[^1]: First\n    line\n\n    Second\n\n[^1]: Duplicate\n\nEnd[^1]"))
+	require.NoError(t, err)
+	assert.Equal(t, "Text[^1] and [^missing].\n\n[^1]: First\n    line\n\n    Second\n\n[^1]: Duplicate\n\nEnd[^1]", rendered)
+}
+
+func TestRenderHTMLWikiLinks(t *testing.T) {
+	svc := NewService(WithTagExtension(), WithWikiLinkExtension())
+	content := "See [[Plan|the <plan>]] and [[Missing]]."
+	html, err := svc.RenderHTMLWithWikiLinks([]byte(content), func(target string) string {
+		if target == "Plan" {
+			return "/memos/abc?x=1&y=2"
+		}
+		return ""
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "<p>See <a class=\"wiki-link\" href=\"/memos/abc?x=1&amp;y=2\">the &lt;plan&gt;</a> and <span class=\"wiki-link wiki-link-missing\">Missing</span>.</p>\n", html)
+
+	// Without a resolver, the wiki links are missing.
+	html, err = svc.RenderHTML([]byte("[[Plan]]"))
+	require.NoError(t, err)
+	assert.Equal(t, "<p><span class=\"wiki-link wiki-link-missing\">Plan</span></p>\n", html)
+
+	// The wiki links are extracted once per target, and previews show their labels.
+	data, err := svc.ExtractAll([]byte("[[Plan]], [[Plan|again]] and [[Goals]]"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Plan", "Goals"}, data.WikiLinks)
+	assert.True(t, data.Property.HasLink)
+	snippet, err := svc.GenerateSnippet([]byte(content), 100)
+	require.NoError(t, err)
+	assert.Equal(t, "See the <plan> and Missing.", snippet)
+}
+
 func TestValidateContent(t *testing.T) {
 	svc := NewService()
 
@@ -223,7 +312,7 @@ func TestExtractTags(t *testing.T) {
 			name:     "duplicate tags",
 			content:  "#work is important. #Work #WORK",
 			withExt:  true,
-			expected: []string{"work"}, // Deduplicated and lowercased
+			expected: []string{"work"}, // Deduplicated, keeping the first spelling
 		},
 		{
 			name:     "tags with hyphens and underscores",
@@ -315,7 +404,289 @@ func TestExtractTags(t *testing.T) {
 	}
 }
 
-func TestUniqueLowercase(t *testing.T) {
+func TestRenameTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		oldTag   string
+		newTag   string
+		expected string
+	}{
+		{
+			name:     "single tag",
+			content:  "Text with #work",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "Text with #job",
+		},
+		{
+			name:     "word boundary",
+			content:  "#work and #workout",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#job and #workout",
+		},
+		{
+			name:     "case-insensitive with subtags",
+			content:  "#Work/project and #work/",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#job/project and #job/",
+		},
+		{
+			name:     "accent-insensitive with subtags",
+			content:  "#Café/paris and #cafe",
+			oldTag:   "café",
+			newTag:   "coffee",
+			expected: "#coffee/paris and #coffee",
+		},
+		{
+			name:     "code is kept",
+			content:  "`#work` and\n\n```\n#work\n```",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "`#work` and\n\n```\n#work\n```",
+		},
+		{
+			name:     "formatting is kept",
+			content:  "* item #work\n\n| a | b |\n|---|---|\n| #work | 1 |",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "* item #job\n\n| a | b |\n|---|---|\n| #job | 1 |",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			content, err := svc.RenameTag([]byte(tt.content), tt.oldTag, tt.newTag)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
+func TestRemoveTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		tag      string
+		expected string
+	}{
+		{
+			name:     "tag between words",
+			content:  "Text with #work tag",
+			tag:      "work",
+			expected: "Text with tag",
+		},
+		{
+			name:     "trailing tags",
+			content:  "Done\n\n#todo #work",
+			tag:      "work",
+			expected: "Done\n\n#todo",
+		},
+		{
+			name:     "only tag of a line",
+			content:  "Done\n\n#work",
+			tag:      "work",
+			expected: "Done",
+		},
+		{
+			name:     "case- and accent-insensitive",
+			content:  "#Café and #cafe and #cafe/paris",
+			tag:      "café",
+			expected: "and and #cafe/paris",
+		},
+		{
+			name:     "code is kept",
+			content:  "`#work` and #work",
+			tag:      "work",
+			expected: "`#work` and",
+		},
+		{
+			name:     "no such tag",
+			content:  "#workout",
+			tag:      "work",
+			expected: "#workout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			content, err := svc.RemoveTag([]byte(tt.content), tt.tag)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
+func TestExtractMemoLinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "no links",
+			content:  "Just plain text",
+			expected: []string{},
+		},
+		{
+			name:     "relative memo link",
+			content:  "See [this memo](/memos/abc123)",
+			expected: []string{"abc123"},
+		},
+		{
+			name:     "absolute memo link and autolink",
+			content:  "[a](https://memos.example.com/memos/abc123) and https://memos.example.com/memos/def-456",
+			expected: []string{"abc123", "def-456"},
+		},
+		{
+			name:     "duplicate links",
+			content:  "[a](/memos/abc123) [b](/memos/abc123/)",
+			expected: []string{"abc123"},
+		},
+		{
+			name:     "other links",
+			content:  "[a](https://example.com) [b](/memos/abc123/comments) [c](mailto:memos/abc123)",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.MemoLinks)
+		})
+	}
+}
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "no mentions",
+			content:  "Just plain text",
+			expected: []string{},
+		},
+		{
+			name:     "mentions",
+			content:  "@jane and @john-doe, see this. Thanks @jane!",
+			expected: []string{"jane", "john-doe"},
+		},
+		{
+			name:     "mentions in lists and emphasis",
+			content:  "- ask **@jane**\n- cc @john.",
+			expected: []string{"jane", "john"},
+		},
+		{
+			name:     "emails and words",
+			content:  "Write to jane@example.com or a@b, not@me",
+			expected: []string{},
+		},
+		{
+			name:     "code and links",
+			content:  "`@jane` and [@john](https://example.com)\n\n```\n@bob\n```",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithTagExtension())
+
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.Mentions)
+		})
+	}
+}
+
+func TestListTasks(t *testing.T) {
+	svc := NewService(WithTagExtension())
+	content := "- [ ] Buy *milk*\n  today\n- [X] Call #mom\n\n> 1. [ ] Buy *milk*\n\n- [] not a task\n\n```\n- [ ] code\n```\n\n- [x] [ ] Box"
+	tasks, err := svc.ListTasks([]byte(content))
+	require.NoError(t, err)
+	texts := []string{}
+	checked := []bool{}
+	for _, task := range tasks {
+		texts = append(texts, task.Text)
+		checked = append(checked, task.Checked)
+	}
+	assert.Equal(t, []string{"Buy *milk* today", "Call #mom", "Buy *milk*", "[ ] Box"}, texts)
+	assert.Equal(t, []bool{false, true, false, true}, checked)
+
+	// The IDs depend on the text of the tasks, not on their position or state.
+	assert.Len(t, tasks[0].ID, 12)
+	assert.NotEqual(t, tasks[0].ID, tasks[1].ID)
+	other, err := svc.ListTasks([]byte("- [x] Call #mom\n- [x] Buy *milk*"))
+	require.NoError(t, err)
+	assert.Equal(t, tasks[1].ID, other[0].ID)
+	duplicate, err := svc.ListTasks([]byte("- [ ] Buy *milk*\n- [ ] Buy *milk*"))
+	require.NoError(t, err)
+	assert.Equal(t, other[1].ID, duplicate[0].ID)
+	assert.Equal(t, duplicate[0].ID+"-2", duplicate[1].ID)
+}
+
+func TestSetTaskChecked(t *testing.T) {
+	svc := NewService(WithTagExtension())
+	content := "Intro [ ] text\n\n- [ ] One\n- [X] Two\n  > - [ ]   Three  "
+	tests := []struct {
+		name     string
+		index    int
+		checked  bool
+		expected string
+	}{
+		{
+			name:     "check",
+			index:    0,
+			checked:  true,
+			expected: "Intro [ ] text\n\n- [x] One\n- [X] Two\n  > - [ ]   Three  ",
+		},
+		{
+			name:     "uncheck",
+			index:    1,
+			checked:  false,
+			expected: "Intro [ ] text\n\n- [ ] One\n- [ ] Two\n  > - [ ]   Three  ",
+		},
+		{
+			name:     "already checked",
+			index:    1,
+			checked:  true,
+			expected: content,
+		},
+		{
+			name:     "nested in a blockquote",
+			index:    2,
+			checked:  true,
+			expected: "Intro [ ] text\n\n- [ ] One\n- [X] Two\n  > - [x]   Three  ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, err := svc.SetTaskChecked([]byte(content), tt.index, tt.checked)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, updated)
+		})
+	}
+
+	for _, index := range []int{-1, 3} {
+		_, err := svc.SetTaskChecked([]byte(content), index, true)
+		assert.ErrorIs(t, err, ErrTaskNotFound)
+	}
+}
+
+func TestUniqueTags(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    []string
@@ -336,16 +707,21 @@ func TestUniqueLowercase(t *testing.T) {
 			input:    []string{"tag", "TAG", "Tag"},
 			expected: []string{"tag"},
 		},
+		{
+			name:     "accents",
+			input:    []string{"Café", "cafe", "CAFÉ"},
+			expected: []string{"Café"},
+		},
 		{
 			name:     "mixed",
 			input:    []string{"Work", "work", "Important", "work"},
-			expected: []string{"work", "important"},
+			expected: []string{"Work", "Important"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := uniqueLowercase(tt.input)
+			result := uniqueTags(tt.input)
 			assert.ElementsMatch(t, tt.expected, result)
 		})
 	}
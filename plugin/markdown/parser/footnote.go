@@ -0,0 +1,188 @@
+package parser
+
+import (
+	"bytes"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+// footnoteData holds the footnote definitions of a document while it's parsed.
+type footnoteData struct {
+	// definitions are the first definitions of the labels.
+	definitions map[string]*mast.FootnoteDefinitionNode
+}
+
+var footnoteInfoKey = parser.NewContextKey()
+
+// getFootnoteData returns the footnote definitions of the parsed document.
+func getFootnoteData(pc parser.Context) *footnoteData {
+	data, ok := pc.Get(footnoteInfoKey).(*footnoteData)
+	if !ok {
+		data = &footnoteData{definitions: map[string]*mast.FootnoteDefinitionNode{}}
+		pc.Set(footnoteInfoKey, data)
+	}
+	return data
+}
+
+// parseFootnoteLabel parses the [^label] at the start of a line, and returns the label and the
+// position after the closing bracket, or nil if there's no label. A label has no spaces or
+// brackets.
+func parseFootnoteLabel(line []byte) ([]byte, int) {
+	if len(line) < 4 || line[0] != '[' || line[1] != '^' {
+		return nil, 0
+	}
+	for i := 2; i < len(line); i++ {
+		switch {
+		case line[i] == ']':
+			if i == 2 {
+				return nil, 0
+			}
+			return line[2:i], i + 1
+		case line[i] == '[' || util.IsSpace(line[i]):
+			return nil, 0
+		default:
+			// Part of the label
+		}
+	}
+	return nil, 0
+}
+
+type footnoteDefinitionParser struct{}
+
+// NewFootnoteDefinitionParser creates a new block parser for [^label]: footnote definitions.
+func NewFootnoteDefinitionParser() parser.BlockParser {
+	return &footnoteDefinitionParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*footnoteDefinitionParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Open opens a footnote definition, whose content follows the colon. The first definition of a
+// label is the one its references link to.
+func (*footnoteDefinitionParser) Open(_ gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	label, end := parseFootnoteLabel(line[pos:])
+	if label == nil || pos+end >= len(line) || line[pos+end] != ':' {
+		return nil, parser.NoChildren
+	}
+
+	node := &mast.FootnoteDefinitionNode{Label: bytes.Clone(label)}
+	data := getFootnoteData(pc)
+	if _, ok := data.definitions[string(label)]; !ok {
+		data.definitions[string(label)] = node
+	}
+
+	pos += end + 1 - segment.Padding
+	if pos >= len(line) {
+		reader.Advance(pos)
+		return node, parser.NoChildren
+	}
+	reader.AdvanceAndSetPadding(pos, segment.Padding)
+	return node, parser.HasChildren
+}
+
+// Continue continues a footnote definition with the blank lines and the lines indented by 4
+// spaces, as for list items.
+func (*footnoteDefinitionParser) Continue(_ gast.Node, reader text.Reader, _ parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if util.IsBlank(line) {
+		return parser.Continue | parser.HasChildren
+	}
+	childPos, padding := util.IndentPosition(line, reader.LineOffset(), 4)
+	if childPos < 0 {
+		return parser.Close
+	}
+	reader.AdvanceAndSetPadding(childPos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+// Close does nothing, the definition stays where it's written.
+func (*footnoteDefinitionParser) Close(gast.Node, text.Reader, parser.Context) {}
+
+// CanInterruptParagraph returns true, a definition may directly follow a paragraph.
+func (*footnoteDefinitionParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine returns false, an indented line is a code block.
+func (*footnoteDefinitionParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+type footnoteReferenceParser struct{}
+
+// NewFootnoteReferenceParser creates a new inline parser for [^label] footnote references.
+func NewFootnoteReferenceParser() parser.InlineParser {
+	return &footnoteReferenceParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*footnoteReferenceParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse parses a footnote reference. A reference to a label without definition is plain text.
+func (*footnoteReferenceParser) Parse(_ gast.Node, block text.Reader, pc parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	label, end := parseFootnoteLabel(line)
+	if label == nil {
+		return nil
+	}
+	if _, ok := getFootnoteData(pc).definitions[string(label)]; !ok {
+		return nil
+	}
+	block.Advance(end)
+	return &mast.FootnoteReferenceNode{Label: bytes.Clone(label)}
+}
+
+type footnoteTransformer struct{}
+
+// NewFootnoteTransformer creates a new AST transformer that numbers the footnotes, and appends
+// the footnotes section to the document.
+func NewFootnoteTransformer() parser.ASTTransformer {
+	return &footnoteTransformer{}
+}
+
+// Transform numbers the footnotes in the order of their first references, and appends a
+// footnote list with their definitions if any footnote is referenced.
+func (*footnoteTransformer) Transform(doc *gast.Document, _ text.Reader, pc parser.Context) {
+	data, ok := pc.Get(footnoteInfoKey).(*footnoteData)
+	pc.Set(footnoteInfoKey, nil)
+	if !ok {
+		return
+	}
+
+	list := &mast.FootnoteListNode{}
+	_ = gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		reference, ok := n.(*mast.FootnoteReferenceNode)
+		if !ok {
+			return gast.WalkContinue, nil
+		}
+		definition := data.definitions[string(reference.Label)]
+		if definition.Index == 0 {
+			list.Definitions = append(list.Definitions, definition)
+			definition.Index = len(list.Definitions)
+		}
+		reference.Index = definition.Index
+		reference.RefIndex = definition.RefCount
+		definition.RefCount++
+		return gast.WalkContinue, nil
+	})
+	if len(list.Definitions) > 0 {
+		doc.AppendChild(doc, list)
+	}
+}
@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestFootnoteParsers(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "reference and definition",
+			input:    "Text[^1].\n\n[^1]: The note.",
+			expected: []string{"Paragraph", "Text:Text", "Reference:1:1:0", "Text:.", "Definition:1:1:1", "Paragraph", "Text:The note.", "FootnoteList:1"},
+		},
+		{
+			name:     "reference before and after the definition",
+			input:    "[^a]: A\n\nOne[^a], two[^a]",
+			expected: []string{"Definition:a:1:2", "Paragraph", "Text:A", "Paragraph", "Text:One", "Reference:a:1:0", "Text:, two", "Reference:a:1:1", "FootnoteList:a"},
+		},
+		{
+			name:     "numbered in the order of the references",
+			input:    "[^b] [^a]\n\n[^a]: A\n[^b]: B",
+			expected: []string{"Paragraph", "Reference:b:1:0", "Text: ", "Reference:a:2:0", "Definition:a:2:1", "Paragraph", "Text:A", "Definition:b:1:1", "Paragraph", "Text:B", "FootnoteList:b,a"},
+		},
+		{
+			name:     "missing definition is plain text",
+			input:    "Text[^missing]",
+			expected: []string{"Paragraph", "Text:Text[", "Text:^missing", "Text:]"},
+		},
+		{
+			name:     "duplicate labels keep the first definition",
+			input:    "Text[^1]\n\n[^1]: First\n\n[^1]: Second",
+			expected: []string{"Paragraph", "Text:Text", "Reference:1:1:0", "Definition:1:1:1", "Paragraph", "Text:First", "Definition:1:0:0", "Paragraph", "Text:Second", "FootnoteList:1"},
+		},
+		{
+			name:     "unreferenced definition isn't listed",
+			input:    "Text\n\n[^1]: Note",
+			expected: []string{"Paragraph", "Text:Text", "Definition:1:0:0", "Paragraph", "Text:Note"},
+		},
+		{
+			name:     "definition with indented paragraphs",
+			input:    "Text[^1]\n\n[^1]: First\n    line\n\n    Second\nAfter",
+			expected: []string{"Paragraph", "Text:Text", "Reference:1:1:0", "Definition:1:1:1", "Paragraph", "Text:First", "Text:line", "Paragraph", "Text:Second", "Text:After", "FootnoteList:1"},
+		},
+		{
+			name:     "labels aren't empty and have no spaces",
+			input:    "Text[^a b] and [^]",
+			expected: []string{"Paragraph", "Text:Text[", "Text:^a b] and [", "Text:^", "Text:]"},
+		},
+		{
+			name:     "footnotes in a blockquote",
+			input:    "> Quote[^q]\n>\n> [^q]: Quoted note",
+			expected: []string{"Blockquote", "Paragraph", "Text:Quote", "Reference:q:1:0", "Definition:q:1:1", "Paragraph", "Text:Quoted note", "FootnoteList:q"},
+		},
+		{
+			name:     "footnotes in list items",
+			input:    "- One[^1]\n- Two[^2]\n\n  [^2]: In the item\n\n[^1]: After the list",
+			expected: []string{"List", "ListItem", "Paragraph", "Text:One", "Reference:1:1:0", "ListItem", "Paragraph", "Text:Two", "Reference:2:2:0", "Definition:2:2:1", "Paragraph", "Text:In the item", "Definition:1:1:1", "Paragraph", "Text:After the list", "FootnoteList:1,2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseFootnotes(t, tt.input))
+		})
+	}
+}
+
+func TestFootnoteNode_Kind(t *testing.T) {
+	assert.Equal(t, mast.KindFootnoteReference, (&mast.FootnoteReferenceNode{}).Kind())
+	assert.Equal(t, mast.KindFootnoteDefinition, (&mast.FootnoteDefinitionNode{}).Kind())
+	assert.Equal(t, mast.KindFootnoteList, (&mast.FootnoteListNode{}).Kind())
+}
+
+// parseFootnotes parses markdown with the footnote parsers, and returns the kinds of the nodes
+// of the document with the labels and numbers of the footnote nodes.
+func parseFootnotes(t *testing.T, input string) []string {
+	md := goldmark.New(goldmark.WithParserOptions(
+		parser.WithBlockParsers(util.Prioritized(NewFootnoteDefinitionParser(), 999)),
+		parser.WithInlineParsers(util.Prioritized(NewFootnoteReferenceParser(), 101)),
+		parser.WithASTTransformers(util.Prioritized(NewFootnoteTransformer(), 999)),
+	))
+	source := []byte(input)
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	nodes := []string{}
+	err := gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *mast.FootnoteReferenceNode:
+			nodes = append(nodes, fmt.Sprintf("Reference:%s:%d:%d", node.Label, node.Index, node.RefIndex))
+		case *mast.FootnoteDefinitionNode:
+			nodes = append(nodes, fmt.Sprintf("Definition:%s:%d:%d", node.Label, node.Index, node.RefCount))
+		case *mast.FootnoteListNode:
+			labels := ""
+			for i, definition := range node.Definitions {
+				if i > 0 {
+					labels += ","
+				}
+				labels += string(definition.Label)
+			}
+			nodes = append(nodes, "FootnoteList:"+labels)
+		case *gast.Text:
+			nodes = append(nodes, "Text:"+string(node.Segment.Value(source)))
+		case *gast.Document:
+		default:
+			nodes = append(nodes, n.Kind().String())
+		}
+		return gast.WalkContinue, nil
+	})
+	require.NoError(t, err)
+	return nodes
+}
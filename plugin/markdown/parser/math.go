@@ -0,0 +1,192 @@
+package parser
+
+import (
+	"bytes"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+type inlineMathParser struct{}
+
+// NewInlineMathParser creates a new inline parser for $inline$ math syntax.
+func NewInlineMathParser() parser.InlineParser {
+	return &inlineMathParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*inlineMathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Parse parses $inline$ math. To not mistake amounts of money for math, e.g. "$5 and $10":
+//   - The opening $ isn't followed by a space, and the closing $ isn't preceded by one
+//   - The closing $ isn't followed by a digit, and is on the same line
+//   - $$ isn't inline math
+//
+// A $ escaped with a backslash doesn't close the math.
+func (*inlineMathParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	if len(line) < 3 || line[0] != '$' || block.PrecendingCharacter() == '$' {
+		return nil
+	}
+	if line[1] == '$' || util.IsSpace(line[1]) {
+		return nil
+	}
+
+	for i := 1; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			// Skip the escaped character
+			i++
+		case '\n', '\r':
+			return nil
+		case '$':
+			if util.IsSpace(line[i-1]) {
+				continue
+			}
+			if i+1 < len(line) && (util.IsNumeric(line[i+1]) || line[i+1] == '$') {
+				continue
+			}
+			value := make([]byte, i-1)
+			copy(value, line[1:i])
+			block.Advance(i + 1)
+			return &mast.InlineMathNode{Value: value}
+		default:
+			// Part of the math
+		}
+	}
+	return nil
+}
+
+// mathBlockDelimiters are the opening and closing delimiters of math blocks.
+var mathBlockDelimiters = []struct {
+	opener []byte
+	closer []byte
+}{
+	{opener: []byte("$$"), closer: []byte("$$")},
+	{opener: []byte(`\[`), closer: []byte(`\]`)},
+}
+
+type mathBlockData struct {
+	node   *mast.MathBlockNode
+	value  []byte
+	closed bool
+}
+
+var mathBlockInfoKey = parser.NewContextKey()
+
+type mathBlockParser struct{}
+
+// NewMathBlockParser creates a new block parser for $$block$$ and \[block\] math syntax.
+func NewMathBlockParser() parser.BlockParser {
+	return &mathBlockParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*mathBlockParser) Trigger() []byte {
+	return []byte{'$', '\\'}
+}
+
+// Open opens a math block on a line with only its opening delimiter, or with the whole block
+// like "$$x^2$$".
+func (*mathBlockParser) Open(_ gast.Node, reader text.Reader, pc parser.Context) (gast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+
+	for _, delimiter := range mathBlockDelimiters {
+		if !bytes.HasPrefix(line[pos:], delimiter.opener) {
+			continue
+		}
+		rest := util.TrimRightSpace(line[pos+len(delimiter.opener):])
+		data := &mathBlockData{}
+		if len(rest) > 0 {
+			// A block on a single line
+			if !bytes.HasSuffix(rest, delimiter.closer) || bytes.Index(rest, delimiter.closer) != len(rest)-len(delimiter.closer) {
+				return nil, parser.NoChildren
+			}
+			data.value = append(data.value, rest[:len(rest)-len(delimiter.closer)]...)
+			data.closed = true
+		} else {
+			data.value = append(data.value, line[pos+len(delimiter.opener):]...)
+		}
+		data.node = &mast.MathBlockNode{Opener: delimiter.opener, Closer: delimiter.closer}
+		data.node.Lines().Append(text.NewSegment(segment.Start+pos, segment.Stop))
+		pc.Set(mathBlockInfoKey, data)
+		reader.Advance(segment.Len() - 1)
+		return data.node, parser.NoChildren
+	}
+	return nil, parser.NoChildren
+}
+
+// Continue adds a line to a math block, until its closing delimiter ends a line. A math block
+// doesn't span blank lines, so that a stray delimiter doesn't swallow the rest of the memo.
+func (*mathBlockParser) Continue(node gast.Node, reader text.Reader, pc parser.Context) parser.State {
+	data, ok := pc.Get(mathBlockInfoKey).(*mathBlockData)
+	if !ok || data.closed {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	if util.IsBlank(line) {
+		return parser.Close
+	}
+
+	node.Lines().Append(segment)
+	trimmed := util.TrimRightSpace(line)
+	if bytes.HasSuffix(trimmed, data.node.Closer) {
+		data.value = append(data.value, trimmed[:len(trimmed)-len(data.node.Closer)]...)
+		data.closed = true
+		newline := 1
+		if line[len(line)-1] != '\n' {
+			newline = 0
+		}
+		reader.Advance(segment.Len() - newline)
+		return parser.Close
+	}
+	data.value = append(data.value, line...)
+	reader.Advance(segment.Len() - 1)
+	return parser.Continue | parser.NoChildren
+}
+
+// Close sets the value of a math block. A math block without its closing delimiter is a
+// paragraph instead.
+func (*mathBlockParser) Close(node gast.Node, reader text.Reader, pc parser.Context) {
+	data, ok := pc.Get(mathBlockInfoKey).(*mathBlockData)
+	if !ok || data.node != node {
+		return
+	}
+	pc.Set(mathBlockInfoKey, nil)
+	if data.closed {
+		data.node.Value = data.value
+		return
+	}
+
+	paragraph := gast.NewParagraph()
+	paragraph.SetBlankPreviousLines(node.HasBlankPreviousLines())
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		lines.Set(i, line.TrimLeftSpace(reader.Source()))
+	}
+	lastLine := lines.At(lines.Len() - 1)
+	lines.Set(lines.Len()-1, lastLine.TrimRightSpace(reader.Source()))
+	paragraph.SetLines(lines)
+	node.Parent().ReplaceChild(node.Parent(), node, paragraph)
+}
+
+// CanInterruptParagraph returns true, a math block may directly follow a paragraph.
+func (*mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine returns false, an indented line is a code block.
+func (*mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
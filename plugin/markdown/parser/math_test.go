@@ -0,0 +1,224 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestInlineMathParser(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedValue string
+		shouldParse   bool
+	}{
+		{
+			name:          "basic math",
+			input:         "$x^2$",
+			expectedValue: "x^2",
+			shouldParse:   true,
+		},
+		{
+			name:          "math followed by text",
+			input:         "$a + b$ is the sum",
+			expectedValue: "a + b",
+			shouldParse:   true,
+		},
+		{
+			name:          "escaped dollar in math",
+			input:         `$\$5$`,
+			expectedValue: `\$5`,
+			shouldParse:   true,
+		},
+		{
+			name:        "amounts of money",
+			input:       "$5 and $10",
+			shouldParse: false,
+		},
+		{
+			name:        "space after the opening delimiter",
+			input:       "$ x$",
+			shouldParse: false,
+		},
+		{
+			name:        "space before the closing delimiter",
+			input:       "$x $",
+			shouldParse: false,
+		},
+		{
+			name:        "closing delimiter followed by a digit",
+			input:       "$x$5",
+			shouldParse: false,
+		},
+		{
+			name:        "unterminated",
+			input:       "$x",
+			shouldParse: false,
+		},
+		{
+			name:        "closing delimiter on the next line",
+			input:       "$x\ny$",
+			shouldParse: false,
+		},
+		{
+			name:        "double dollar",
+			input:       "$$x$$",
+			shouldParse: false,
+		},
+		{
+			name:        "empty",
+			input:       "$$",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewInlineMathParser()
+			reader := text.NewReader([]byte(tt.input))
+			ctx := parser.NewContext()
+
+			node := p.Parse(nil, reader, ctx)
+
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected math to be parsed")
+				mathNode, ok := node.(*mast.InlineMathNode)
+				require.True(t, ok, "Expected node to be *mast.InlineMathNode")
+				assert.Equal(t, tt.expectedValue, string(mathNode.Value))
+			} else {
+				assert.Nil(t, node, "Expected math NOT to be parsed")
+			}
+		})
+	}
+}
+
+func TestInlineMathParser_Trigger(t *testing.T) {
+	assert.Equal(t, []byte{'$'}, NewInlineMathParser().Trigger())
+}
+
+// parseMath parses markdown with the math parsers, and returns the kinds of the nodes of the
+// document with the values of the math nodes.
+func parseMath(t *testing.T, input string) []string {
+	md := goldmark.New(goldmark.WithParserOptions(
+		parser.WithBlockParsers(util.Prioritized(NewMathBlockParser(), 750)),
+		parser.WithInlineParsers(util.Prioritized(NewInlineMathParser(), 200)),
+	))
+	source := []byte(input)
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	nodes := []string{}
+	err := gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *mast.InlineMathNode:
+			nodes = append(nodes, "InlineMath:"+string(node.Value))
+		case *mast.MathBlockNode:
+			nodes = append(nodes, "MathBlock:"+string(node.Opener)+string(node.Value)+string(node.Closer))
+		case *gast.Text:
+			nodes = append(nodes, "Text:"+string(node.Segment.Value(source)))
+		case *gast.Document:
+		default:
+			nodes = append(nodes, n.Kind().String())
+		}
+		return gast.WalkContinue, nil
+	})
+	require.NoError(t, err)
+	return nodes
+}
+
+func TestMathBlockParser(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "dollar block",
+			input:    "$$\n\\sum_{i=1}^n i\n$$",
+			expected: []string{"MathBlock:$$\n\\sum_{i=1}^n i\n$$"},
+		},
+		{
+			name:     "bracket block",
+			input:    "\\[\nx *y* z\n\\]",
+			expected: []string{"MathBlock:\\[\nx *y* z\n\\]"},
+		},
+		{
+			name:     "block on a single line",
+			input:    "$$x^2$$",
+			expected: []string{"MathBlock:$$x^2$$"},
+		},
+		{
+			name:     "block after a paragraph",
+			input:    "The sum:\n$$\na + b\n$$\nEnd",
+			expected: []string{"Paragraph", "Text:The sum:", "MathBlock:$$\na + b\n$$", "Paragraph", "Text:End"},
+		},
+		{
+			name:     "text after a single line block",
+			input:    "$$x$$ y",
+			expected: []string{"Paragraph", "Text:$$x$", "Text:$ y"},
+		},
+		{
+			name:     "block in a blockquote",
+			input:    "> $$\n> a\n> $$",
+			expected: []string{"Blockquote", "MathBlock:$$\na\n$$"},
+		},
+		{
+			name:     "block in a list item",
+			input:    "- Item\n  \\[\n  a\n  \\]\n- Next $b$",
+			expected: []string{"List", "ListItem", "TextBlock", "Text:Item", "MathBlock:\\[\na\n\\]", "ListItem", "TextBlock", "Text:Next ", "InlineMath:b"},
+		},
+		{
+			name:     "inline math in a nested list",
+			input:    "- a\n  - $x$ and $y$",
+			expected: []string{"List", "ListItem", "TextBlock", "Text:a", "List", "ListItem", "TextBlock", "InlineMath:x", "Text: and ", "InlineMath:y"},
+		},
+		{
+			name:     "inline math in a blockquote",
+			input:    "> quoted $x$",
+			expected: []string{"Blockquote", "Paragraph", "Text:quoted ", "InlineMath:x"},
+		},
+		{
+			name:     "unterminated block is a paragraph",
+			input:    "$$\na $b$\n\nNext",
+			expected: []string{"Paragraph", "Text:$", "Text:$", "Text:a ", "InlineMath:b", "Paragraph", "Text:Next"},
+		},
+		{
+			name:     "unterminated block at the end",
+			input:    "$$\na",
+			expected: []string{"Paragraph", "Text:$", "Text:$", "Text:a"},
+		},
+		{
+			name:     "unterminated block in a blockquote",
+			input:    "> $$\n> a\n\nafter",
+			expected: []string{"Blockquote", "Paragraph", "Text:$", "Text:$", "Text:a", "Paragraph", "Text:after"},
+		},
+		{
+			name:     "mismatched delimiters",
+			input:    "\\[\na\n$$",
+			expected: []string{"Paragraph", "Text:\\[", "Text:a", "Text:$", "Text:$"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseMath(t, tt.input))
+		})
+	}
+}
+
+func TestMathBlockNode_Kind(t *testing.T) {
+	assert.Equal(t, mast.KindMathBlock, (&mast.MathBlockNode{}).Kind())
+	assert.Equal(t, mast.KindInlineMath, (&mast.InlineMathNode{}).Kind())
+	assert.True(t, (&mast.MathBlockNode{}).IsRaw())
+}
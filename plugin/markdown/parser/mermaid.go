@@ -0,0 +1,51 @@
+package parser
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+// mermaidLanguage is the language of the fenced code blocks that are diagrams, as in the frontend.
+const mermaidLanguage = "mermaid"
+
+type mermaidTransformer struct{}
+
+// NewMermaidTransformer creates a new AST transformer that replaces the fenced code blocks tagged
+// `mermaid` with mermaid blocks.
+func NewMermaidTransformer() parser.ASTTransformer {
+	return &mermaidTransformer{}
+}
+
+// Transform replaces the mermaid fenced code blocks of the document. The definition is kept as
+// written, so that the block is rendered back to markdown byte-for-byte.
+func (*mermaidTransformer) Transform(doc *gast.Document, reader text.Reader, _ parser.Context) {
+	source := reader.Source()
+	var codeBlocks []*gast.FencedCodeBlock
+	_ = gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if codeBlock, ok := n.(*gast.FencedCodeBlock); ok && string(codeBlock.Language(source)) == mermaidLanguage {
+			codeBlocks = append(codeBlocks, codeBlock)
+		}
+		return gast.WalkContinue, nil
+	})
+
+	for _, codeBlock := range codeBlocks {
+		node := &mast.MermaidBlockNode{}
+		if codeBlock.Info != nil {
+			node.Info = append(node.Info, codeBlock.Info.Segment.Value(source)...)
+		}
+		lines := codeBlock.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			line := lines.At(i)
+			node.Definition = append(node.Definition, line.Value(source)...)
+			node.Lines().Append(line)
+		}
+		node.SetBlankPreviousLines(codeBlock.HasBlankPreviousLines())
+		codeBlock.Parent().ReplaceChild(codeBlock.Parent(), codeBlock, node)
+	}
+}
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestMermaidTransformer(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "multi-line definition with quotes and angle brackets",
+			input:    "```mermaid\ngraph TD\n  A[\"Say <hi>\"] -->|'yes' & no| B<br/>\n  B --> C{\"a > b?\"}\n```",
+			expected: []string{"MermaidBlock:mermaid:graph TD\n  A[\"Say <hi>\"] -->|'yes' & no| B<br/>\n  B --> C{\"a > b?\"}\n"},
+		},
+		{
+			name:     "info string after the language",
+			input:    "~~~mermaid title=\"Flow\"\nsequenceDiagram\n    Alice->>Bob: Hi\n~~~",
+			expected: []string{"MermaidBlock:mermaid title=\"Flow\":sequenceDiagram\n    Alice->>Bob: Hi\n"},
+		},
+		{
+			name:     "markdown in the definition isn't parsed",
+			input:    "```mermaid\nA[*x* #tag] --> B\n```",
+			expected: []string{"MermaidBlock:mermaid:A[*x* #tag] --> B\n"},
+		},
+		{
+			name:     "other languages are code blocks",
+			input:    "```go\nx := 1\n```\n\n```\ngraph TD\n```\n\n```Mermaid\ngraph TD\n```",
+			expected: []string{"FencedCodeBlock", "FencedCodeBlock", "FencedCodeBlock"},
+		},
+		{
+			name:     "indented code blocks are code blocks",
+			input:    "    mermaid\n    graph TD",
+			expected: []string{"CodeBlock"},
+		},
+		{
+			name:     "diagram between paragraphs",
+			input:    "Before\n\n```mermaid\ngraph LR\n```\n\nAfter",
+			expected: []string{"Paragraph", "Text:Before", "MermaidBlock:mermaid:graph LR\n", "Paragraph", "Text:After"},
+		},
+		{
+			name:     "diagram in a list item",
+			input:    "- Item\n\n  ```mermaid\n  graph LR\n  ```",
+			expected: []string{"List", "ListItem", "Paragraph", "Text:Item", "MermaidBlock:mermaid:graph LR\n"},
+		},
+		{
+			name:     "diagram in a blockquote",
+			input:    "> ```mermaid\n> pie\n> ```",
+			expected: []string{"Blockquote", "MermaidBlock:mermaid:pie\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseMermaid(t, tt.input))
+		})
+	}
+}
+
+func TestMermaidBlockNode_Kind(t *testing.T) {
+	assert.Equal(t, mast.KindMermaidBlock, (&mast.MermaidBlockNode{}).Kind())
+	assert.True(t, (&mast.MermaidBlockNode{}).IsRaw())
+}
+
+// parseMermaid parses markdown with the mermaid transformer, and returns the kinds of the nodes
+// of the document with the info strings and definitions of the mermaid nodes.
+func parseMermaid(t *testing.T, input string) []string {
+	md := goldmark.New(goldmark.WithParserOptions(
+		parser.WithASTTransformers(util.Prioritized(NewMermaidTransformer(), 100)),
+	))
+	source := []byte(input)
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	nodes := []string{}
+	err := gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *mast.MermaidBlockNode:
+			nodes = append(nodes, "MermaidBlock:"+string(node.Info)+":"+string(node.Definition))
+		case *gast.Text:
+			nodes = append(nodes, "Text:"+string(node.Segment.Value(source)))
+		case *gast.Document:
+		default:
+			nodes = append(nodes, n.Kind().String())
+		}
+		return gast.WalkContinue, nil
+	})
+	require.NoError(t, err)
+	return nodes
+}
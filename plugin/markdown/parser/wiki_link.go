@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"bytes"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+type wikiLinkParser struct{}
+
+// NewWikiLinkParser creates a new inline parser for [[target]] and [[target|label]] wiki links.
+func NewWikiLinkParser() parser.InlineParser {
+	return &wikiLinkParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*wikiLinkParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse parses a wiki link. The link is on a single line, without brackets inside, and its
+// target isn't blank. The label follows the first "|".
+func (*wikiLinkParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+	if len(line) < 5 || line[0] != '[' || line[1] != '[' {
+		return nil
+	}
+	end := -1
+	for i := 2; i < len(line) && end < 0; i++ {
+		switch line[i] {
+		case ']':
+			if i+1 >= len(line) || line[i+1] != ']' {
+				return nil
+			}
+			end = i
+		case '[', '\n', '\r':
+			return nil
+		default:
+			// Part of the link
+		}
+	}
+	if end < 0 {
+		return nil
+	}
+
+	inner := line[2:end]
+	node := &mast.WikiLinkNode{}
+	if index := bytes.IndexByte(inner, '|'); index >= 0 {
+		node.Label = bytes.Clone(util.TrimRightSpace(util.TrimLeftSpace(inner[index+1:])))
+		inner = inner[:index]
+	}
+	node.Target = bytes.Clone(util.TrimRightSpace(util.TrimLeftSpace(inner)))
+	if len(node.Target) == 0 {
+		return nil
+	}
+	if len(node.Label) == 0 {
+		node.Label = nil
+	}
+	block.Advance(end + 2)
+	return node
+}
@@ -0,0 +1,110 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestWikiLinkParser(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "target",
+			input:    "See [[Project Plan]] now",
+			expected: []string{"Text:See ", "WikiLink:Project Plan:", "Text: now"},
+		},
+		{
+			name:     "target with a label",
+			input:    "[[ Project Plan | the plan ]]",
+			expected: []string{"WikiLink:Project Plan:the plan"},
+		},
+		{
+			name:     "empty label",
+			input:    "[[Plan|]]",
+			expected: []string{"WikiLink:Plan:"},
+		},
+		{
+			name:     "two links",
+			input:    "[[a]][[b]]",
+			expected: []string{"WikiLink:a:", "WikiLink:b:"},
+		},
+		{
+			name:     "empty target isn't a link",
+			input:    "[[ |label]]",
+			expected: []string{"Text:[", "Text:[", "Text: |label]", "Text:]"},
+		},
+		{
+			name:     "brackets inside aren't a link",
+			input:    "[[a]b]]",
+			expected: []string{"Text:[", "Text:[", "Text:a]b]", "Text:]"},
+		},
+		{
+			name:     "link across lines isn't a link",
+			input:    "[[a\nb]]",
+			expected: []string{"Text:[", "Text:[", "Text:a", "Text:b]", "Text:]"},
+		},
+		{
+			name:     "link in emphasis",
+			input:    "*[[Plan]]*",
+			expected: []string{"Emphasis", "WikiLink:Plan:"},
+		},
+		{
+			name:     "code isn't a link",
+			input:    "`[[Plan]]`",
+			expected: []string{"CodeSpan", "Text:[[Plan]]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseWikiLinks(t, tt.input))
+		})
+	}
+}
+
+func TestWikiLinkNode_DisplayText(t *testing.T) {
+	assert.Equal(t, mast.KindWikiLink, (&mast.WikiLinkNode{}).Kind())
+	assert.Equal(t, []byte("Plan"), (&mast.WikiLinkNode{Target: []byte("Plan")}).DisplayText())
+	assert.Equal(t, []byte("the plan"), (&mast.WikiLinkNode{Target: []byte("Plan"), Label: []byte("the plan")}).DisplayText())
+}
+
+// parseWikiLinks parses markdown with the wiki link parser, and returns the kinds of the inline
+// nodes of the document with the targets and labels of the wiki links.
+func parseWikiLinks(t *testing.T, input string) []string {
+	md := goldmark.New(goldmark.WithParserOptions(
+		parser.WithInlineParsers(util.Prioritized(NewWikiLinkParser(), 102)),
+	))
+	source := []byte(input)
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	nodes := []string{}
+	err := gast.Walk(doc, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *mast.WikiLinkNode:
+			nodes = append(nodes, "WikiLink:"+string(node.Target)+":"+string(node.Label))
+		case *gast.Text:
+			nodes = append(nodes, "Text:"+string(node.Segment.Value(source)))
+		case *gast.Document, *gast.Paragraph:
+		default:
+			nodes = append(nodes, n.Kind().String())
+		}
+		return gast.WalkContinue, nil
+	})
+	require.NoError(t, err)
+	return nodes
+}
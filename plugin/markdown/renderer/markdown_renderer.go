@@ -35,6 +35,10 @@ func (r *MarkdownRenderer) renderNode(node gast.Node, source []byte, depth int)
 	switch n := node.(type) {
 	case *gast.Document:
 		r.renderChildren(n, source, depth)
+		if _, ok := n.LastChild().(*mast.FootnoteListNode); ok {
+			// The footnote list isn't written, nor the separator before it
+			r.buf.Truncate(len(bytes.TrimSuffix(r.buf.Bytes(), []byte("\n\n"))))
+		}
 
 	case *gast.Paragraph:
 		r.renderChildren(n, source, depth)
@@ -156,6 +160,46 @@ func (r *MarkdownRenderer) renderNode(node gast.Node, source []byte, depth int)
 		r.buf.WriteByte('#')
 		r.buf.Write(n.Tag)
 
+	case *mast.InlineMathNode:
+		r.buf.WriteByte('$')
+		r.buf.Write(n.Value)
+		r.buf.WriteByte('$')
+
+	case *mast.MathBlockNode:
+		r.renderMathBlock(n)
+		if node.NextSibling() != nil {
+			r.buf.WriteString("\n\n")
+		}
+
+	case *mast.WikiLinkNode:
+		r.buf.WriteString("[[")
+		r.buf.Write(n.Target)
+		if n.Label != nil {
+			r.buf.WriteByte('|')
+			r.buf.Write(n.Label)
+		}
+		r.buf.WriteString("]]")
+
+	case *mast.FootnoteReferenceNode:
+		r.buf.WriteString("[^")
+		r.buf.Write(n.Label)
+		r.buf.WriteByte(']')
+
+	case *mast.FootnoteDefinitionNode:
+		r.renderFootnoteDefinition(n, source, depth)
+		if node.NextSibling() != nil {
+			r.buf.WriteString("\n\n")
+		}
+
+	case *mast.FootnoteListNode:
+		// The definitions are written where they're defined
+
+	case *mast.MermaidBlockNode:
+		r.renderMermaidBlock(n)
+		if node.NextSibling() != nil {
+			r.buf.WriteString("\n\n")
+		}
+
 	default:
 		// For unknown nodes, try to render children
 		r.renderChildren(n, source, depth)
@@ -206,6 +250,92 @@ func (r *MarkdownRenderer) renderCodeBlock(node gast.Node, source []byte) {
 	}
 }
 
+// renderMathBlock renders a math block with its delimiters. In a list item, the block starts on
+// its own line and its lines are indented to the content of the item, as rendered by
+// renderListItem.
+func (r *MarkdownRenderer) renderMathBlock(node *mast.MathBlockNode) {
+	var indent string
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		listItem, ok := parent.(*gast.ListItem)
+		if !ok {
+			continue
+		}
+		depth := 0
+		for ancestor := listItem.Parent(); ancestor != nil; ancestor = ancestor.Parent() {
+			depth++
+		}
+		marker := "- "
+		if list, ok := listItem.Parent().(*gast.List); ok && list.IsOrdered() {
+			// The number of the item was incremented after rendering its marker
+			marker = fmt.Sprintf("%d. ", list.Start-1)
+		}
+		indent = strings.Repeat("  ", max(depth-2, 0)) + strings.Repeat(" ", len(marker))
+		break
+	}
+	if node.PreviousSibling() != nil && r.buf.Len() > 0 && !bytes.HasSuffix(r.buf.Bytes(), []byte("\n")) {
+		r.buf.WriteByte('\n')
+		r.buf.WriteString(indent)
+	}
+
+	r.buf.Write(node.Opener)
+	value := node.Value
+	if indent != "" {
+		value = bytes.ReplaceAll(value, []byte("\n"), []byte("\n"+indent))
+	}
+	r.buf.Write(value)
+	r.buf.Write(node.Closer)
+}
+
+// renderMermaidBlock renders a diagram as a fenced code block with its info string and its
+// definition as written. The fence is longer than the runs of backticks in the definition.
+func (r *MarkdownRenderer) renderMermaidBlock(node *mast.MermaidBlockNode) {
+	fenceLength, run := 3, 0
+	for _, c := range node.Definition {
+		if c != '`' {
+			run = 0
+			continue
+		}
+		run++
+		fenceLength = max(fenceLength, run+1)
+	}
+	fence := strings.Repeat("`", fenceLength)
+
+	r.buf.WriteString(fence)
+	r.buf.Write(node.Info)
+	r.buf.WriteByte('\n')
+	r.buf.Write(node.Definition)
+	if len(node.Definition) > 0 && node.Definition[len(node.Definition)-1] != '\n' {
+		// The fence of an unclosed block at the end of the content
+		r.buf.WriteByte('\n')
+	}
+	r.buf.WriteString(fence)
+}
+
+// renderFootnoteDefinition renders a footnote definition with its label, and its content with
+// the lines after the first indented by 4 spaces.
+func (r *MarkdownRenderer) renderFootnoteDefinition(node *mast.FootnoteDefinitionNode, source []byte, depth int) {
+	tempBuf := &bytes.Buffer{}
+	tempRenderer := &MarkdownRenderer{buf: tempBuf}
+	tempRenderer.renderChildren(node, source, depth)
+
+	r.buf.WriteString("[^")
+	r.buf.Write(node.Label)
+	r.buf.WriteString("]:")
+	for i, line := range strings.Split(tempBuf.String(), "\n") {
+		switch {
+		case i > 0:
+			r.buf.WriteByte('\n')
+			if line != "" {
+				r.buf.WriteString("    ")
+			}
+		case line != "":
+			r.buf.WriteByte(' ')
+		default:
+		}
+		r.buf.WriteString(line)
+	}
+}
+
 // renderBlockquote renders a blockquote with "> " prefix.
 func (r *MarkdownRenderer) renderBlockquote(node *gast.Blockquote, source []byte, depth int) {
 	// Create a temporary buffer for the blockquote content
@@ -19,6 +19,10 @@ func TestMarkdownRenderer(t *testing.T) {
 		goldmark.WithExtensions(
 			extension.GFM,
 			extensions.TagExtension,
+			extensions.MathExtension,
+			extensions.MermaidExtension,
+			extensions.FootnoteExtension,
+			extensions.WikiLinkExtension,
 		),
 		goldmark.WithParserOptions(
 			parser.WithAutoHeadingID(),
@@ -110,6 +114,61 @@ func TestMarkdownRenderer(t *testing.T) {
 			input:    "#work #important meeting notes",
 			expected: "#work #important meeting notes",
 		},
+		{
+			name:     "inline math",
+			input:    "Euler: $e^{i\\pi} + 1 = 0$, not $5 and $10",
+			expected: "Euler: $e^{i\\pi} + 1 = 0$, not $5 and $10",
+		},
+		{
+			name:     "math block",
+			input:    "$$\n\\int_0^1 x\\,dx\n$$\n\nAfter",
+			expected: "$$\n\\int_0^1 x\\,dx\n$$\n\nAfter",
+		},
+		{
+			name:     "bracket math block on a single line",
+			input:    "\\[x^2\\]",
+			expected: "\\[x^2\\]",
+		},
+		{
+			name:     "math block in a blockquote",
+			input:    "> $$\n> a + b\n> $$",
+			expected: "> $$\n> a + b\n> $$",
+		},
+		{
+			name:     "math block in a list item",
+			input:    "- Item\n  $$\n  x\n  $$\n- Next",
+			expected: "- Item\n  $$\n  x\n  $$\n- Next",
+		},
+		{
+			name:     "mermaid diagram",
+			input:    "Flow\n\n~~~mermaid title=\"A <b>\"\ngraph TD\n  A[\"Say <hi>\"] --> B\n\n  B -.-> C\n~~~",
+			expected: "Flow\n\n```mermaid title=\"A <b>\"\ngraph TD\n  A[\"Say <hi>\"] --> B\n\n  B -.-> C\n```",
+		},
+		{
+			name:     "mermaid diagram with backticks",
+			input:    "````mermaid\nA[\"```\"] --> B\n````\n\nEnd",
+			expected: "````mermaid\nA[\"```\"] --> B\n````\n\nEnd",
+		},
+		{
+			name:     "unclosed mermaid diagram",
+			input:    "```mermaid\ngraph TD",
+			expected: "```mermaid\ngraph TD\n```",
+		},
+		{
+			name:     "footnotes",
+			input:    "Text[^1] and [^note].\n\n[^note]: A *note*\n[^1]: First",
+			expected: "Text[^1] and [^note].\n\n[^note]: A *note*\n\n[^1]: First",
+		},
+		{
+			name:     "footnote in a blockquote",
+			input:    "> Quote[^q]\n> [^q]: Quoted",
+			expected: "> Quote[^q]\n> \n> [^q]: Quoted",
+		},
+		{
+			name:     "wiki links",
+			input:    "See [[ Project Plan ]] and [[Plan| the plan]], not [[ ]]",
+			expected: "See [[Project Plan]] and [[Plan|the plan]], not [[ ]]",
+		},
 		{
 			name:     "complex mixed content",
 			input:    "# Meeting Notes\n\n**Date**: 2024-01-01\n\n## Attendees\n- Alice\n- Bob\n\n## Discussion\n\nWe discussed #project status.\n\n```python\nprint('hello')\n```",
@@ -0,0 +1,283 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// clientTimeout bounds the requests to the homeserver, long-polled syncs and media downloads
+// included.
+const clientTimeout = 2 * time.Minute
+
+// Client calls the client-server API of a Matrix homeserver with the access token of an account.
+//
+// Only the few endpoints of a bot are covered, and end-to-end encryption isn't supported: the
+// events of encrypted rooms can't be read.
+type Client struct {
+	homeserverURL *url.URL
+	accessToken   string
+	client        *http.Client
+
+	// transactionCount makes the transaction IDs of the sent messages unique.
+	transactionCount atomic.Int64
+}
+
+// NewClient returns a client of the homeserver at homeserverURL.
+func NewClient(homeserverURL, accessToken string) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(homeserverURL, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid homeserver URL")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.Errorf("homeserver URL %q isn't an HTTP URL", homeserverURL)
+	}
+	return &Client{
+		homeserverURL: u,
+		accessToken:   accessToken,
+		client:        &http.Client{Timeout: clientTimeout},
+	}, nil
+}
+
+// Error is an error response of the homeserver.
+type Error struct {
+	StatusCode int
+	Code       string `json:"errcode"`
+	Message    string `json:"error"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("matrix: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsUnknownToken reports whether err is the error of an invalid or revoked access token.
+func IsUnknownToken(err error) bool {
+	matrixErr := &Error{}
+	return errors.As(err, &matrixErr) && matrixErr.Code == "M_UNKNOWN_TOKEN"
+}
+
+// Event is an event of a room.
+type Event struct {
+	Type           string          `json:"type"`
+	EventID        string          `json:"event_id"`
+	Sender         string          `json:"sender"`
+	OriginServerTS int64           `json:"origin_server_ts"`
+	StateKey       *string         `json:"state_key,omitempty"`
+	Content        json.RawMessage `json:"content"`
+}
+
+// MessageContent is the content of an m.room.message event.
+type MessageContent struct {
+	MsgType       string    `json:"msgtype"`
+	Body          string    `json:"body"`
+	Format        string    `json:"format,omitempty"`
+	FormattedBody string    `json:"formatted_body,omitempty"`
+	URL           string    `json:"url,omitempty"`
+	Filename      string    `json:"filename,omitempty"`
+	Info          *FileInfo `json:"info,omitempty"`
+	RelatesTo     *struct {
+		RelType string `json:"rel_type,omitempty"`
+		// InReplyTo is set on replies, whose body starts with a quote of the replied message.
+		InReplyTo *struct {
+			EventID string `json:"event_id"`
+		} `json:"m.in_reply_to,omitempty"`
+	} `json:"m.relates_to,omitempty"`
+}
+
+// FileInfo is the metadata of the file of a message.
+type FileInfo struct {
+	MimeType string `json:"mimetype,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+}
+
+// The message types of m.room.message events.
+const (
+	MsgTypeText   = "m.text"
+	MsgTypeEmote  = "m.emote"
+	MsgTypeNotice = "m.notice"
+	MsgTypeImage  = "m.image"
+	MsgTypeFile   = "m.file"
+	MsgTypeAudio  = "m.audio"
+	MsgTypeVideo  = "m.video"
+)
+
+// SyncResponse is the response of a sync, with only the parts a bot handles.
+type SyncResponse struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join   map[string]*JoinedRoom `json:"join"`
+		Invite map[string]any         `json:"invite"`
+		Leave  map[string]any         `json:"leave"`
+	} `json:"rooms"`
+}
+
+// JoinedRoom is the update of a room the account is a member of.
+type JoinedRoom struct {
+	Timeline struct {
+		Events []*Event `json:"events"`
+	} `json:"timeline"`
+}
+
+// syncFilter leaves out the presence, account data and ephemeral events, and the timeline events
+// other than messages and membership changes.
+const syncFilter = `{"presence":{"not_types":["*"]},"account_data":{"not_types":["*"]},` +
+	`"room":{"account_data":{"not_types":["*"]},"ephemeral":{"not_types":["*"]},` +
+	`"timeline":{"types":["m.room.message","m.room.encrypted","m.room.member"],"limit":50}}}`
+
+// initialSyncFilter is the filter of the first sync, which only gets the next batch token and
+// the pending invites, not the history of the rooms.
+const initialSyncFilter = `{"presence":{"not_types":["*"]},"account_data":{"not_types":["*"]},` +
+	`"room":{"account_data":{"not_types":["*"]},"ephemeral":{"not_types":["*"]},` +
+	`"state":{"lazy_load_members":true},"timeline":{"limit":0}}}`
+
+// Whoami returns the user ID of the account of the access token.
+func (c *Client) Whoami(ctx context.Context) (string, error) {
+	response := &struct {
+		UserID string `json:"user_id"`
+	}{}
+	if err := c.call(ctx, http.MethodGet, "/_matrix/client/v3/account/whoami", nil, nil, response); err != nil {
+		return "", err
+	}
+	return response.UserID, nil
+}
+
+// Sync gets the events since the since batch token, waiting up to timeout for new events. The
+// first sync, without a since token, doesn't get the history of the rooms.
+func (c *Client) Sync(ctx context.Context, since string, timeout time.Duration) (*SyncResponse, error) {
+	query := url.Values{"timeout": {strconv.FormatInt(timeout.Milliseconds(), 10)}}
+	if since == "" {
+		query.Set("filter", initialSyncFilter)
+	} else {
+		query.Set("since", since)
+		query.Set("filter", syncFilter)
+	}
+	response := &SyncResponse{}
+	if err := c.call(ctx, http.MethodGet, "/_matrix/client/v3/sync", query, nil, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// JoinRoom joins a room, e.g. the room of an invite.
+func (c *Client) JoinRoom(ctx context.Context, roomID string) error {
+	return c.call(ctx, http.MethodPost, "/_matrix/client/v3/join/"+url.PathEscape(roomID), nil, []byte("{}"), nil)
+}
+
+// JoinedMemberCount returns the number of members of a room.
+func (c *Client) JoinedMemberCount(ctx context.Context, roomID string) (int, error) {
+	response := &struct {
+		Joined map[string]any `json:"joined"`
+	}{}
+	if err := c.call(ctx, http.MethodGet, "/_matrix/client/v3/rooms/"+url.PathEscape(roomID)+"/joined_members", nil, nil, response); err != nil {
+		return 0, err
+	}
+	return len(response.Joined), nil
+}
+
+// SendMessage sends a message to a room.
+func (c *Client) SendMessage(ctx context.Context, roomID string, content *MessageContent) error {
+	body, err := json.Marshal(content)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal message")
+	}
+	transactionID := fmt.Sprintf("memos.%d.%d", time.Now().UnixNano(), c.transactionCount.Add(1))
+	path := "/_matrix/client/v3/rooms/" + url.PathEscape(roomID) + "/send/m.room.message/" + url.PathEscape(transactionID)
+	return c.call(ctx, http.MethodPut, path, nil, body, nil)
+}
+
+// DownloadMedia downloads the media of an mxc:// URI. Media larger than maxSize are rejected.
+//
+// The authenticated media endpoint is tried first, then the legacy one of the homeservers that
+// don't support it yet.
+func (c *Client) DownloadMedia(ctx context.Context, mxcURI string, maxSize int64) ([]byte, error) {
+	serverName, mediaID, ok := strings.Cut(strings.TrimPrefix(mxcURI, "mxc://"), "/")
+	if !strings.HasPrefix(mxcURI, "mxc://") || !ok || serverName == "" || mediaID == "" || strings.Contains(mediaID, "/") {
+		return nil, errors.Errorf("invalid media URI %q", mxcURI)
+	}
+	mediaPath := url.PathEscape(serverName) + "/" + url.PathEscape(mediaID)
+	data, err := c.download(ctx, "/_matrix/client/v1/media/download/"+mediaPath, maxSize)
+	matrixErr := &Error{}
+	if errors.As(err, &matrixErr) && (matrixErr.StatusCode == http.StatusNotFound || matrixErr.Code == "M_UNRECOGNIZED") {
+		return c.download(ctx, "/_matrix/media/v3/download/"+mediaPath, maxSize)
+	}
+	return data, err
+}
+
+func (c *Client) download(ctx context.Context, path string, maxSize int64) ([]byte, error) {
+	response, err := c.do(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read media")
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.Errorf("media is larger than %d bytes", maxSize)
+	}
+	return data, nil
+}
+
+// call calls an endpoint of the client-server API, and decodes its JSON response into result,
+// unless it's nil.
+func (c *Client) call(ctx context.Context, method, path string, query url.Values, body []byte, result any) error {
+	response, err := c.do(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+	return nil
+}
+
+// do sends a request to the homeserver, and returns the response if it's successful.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body []byte) (*http.Response, error) {
+	// The path segments are escaped by the callers, so the URL is built as a string.
+	requestURL := strings.TrimSuffix(c.homeserverURL.String(), "/") + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, bodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call homeserver")
+	}
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		matrixErr := &Error{StatusCode: response.StatusCode}
+		data, _ := io.ReadAll(io.LimitReader(response.Body, 1<<16))
+		if err := json.Unmarshal(data, matrixErr); err != nil || matrixErr.Code == "" {
+			matrixErr.Code, matrixErr.Message = "M_UNKNOWN", response.Status
+		}
+		return nil, matrixErr
+	}
+	return response, nil
+}
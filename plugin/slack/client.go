@@ -0,0 +1,194 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// APIURL is the URL of the Slack Web API.
+const APIURL = "https://slack.com/api/"
+
+// clientTimeout bounds the requests to Slack, file downloads included.
+const clientTimeout = 30 * time.Second
+
+// Client calls the Slack Web API with the bot token of an app.
+type Client struct {
+	token  string
+	apiURL *url.URL
+	client *http.Client
+}
+
+// NewClient returns a client of the Slack Web API at apiURL, usually APIURL.
+func NewClient(token, apiURL string) (*Client, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Slack API URL")
+	}
+	return &Client{
+		token:  token,
+		apiURL: u,
+		client: &http.Client{Timeout: clientTimeout},
+	}, nil
+}
+
+// apiResponse is the envelope of the responses of the Web API.
+type apiResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// PostMessage sends a message to a channel, e.g. the direct message channel of a user.
+func (c *Client) PostMessage(ctx context.Context, channel, text string) error {
+	body, err := json.Marshal(map[string]string{"channel": channel, "text": text})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal message")
+	}
+	return c.call(ctx, http.MethodPost, "chat.postMessage", nil, body, &apiResponse{})
+}
+
+// GetPermalink returns the permalink of a message.
+func (c *Client) GetPermalink(ctx context.Context, channel, messageTS string) (string, error) {
+	response := &struct {
+		apiResponse
+		Permalink string `json:"permalink"`
+	}{}
+	query := url.Values{"channel": {channel}, "message_ts": {messageTS}}
+	if err := c.call(ctx, http.MethodGet, "chat.getPermalink", query, nil, response); err != nil {
+		return "", err
+	}
+	return response.Permalink, nil
+}
+
+// DownloadFile downloads a file shared in Slack from its private URL. Files larger than maxSize
+// are rejected.
+func (c *Client) DownloadFile(ctx context.Context, fileURL string, maxSize int64) ([]byte, error) {
+	// The bot token is only sent to Slack.
+	if !c.isSlackURL(fileURL) {
+		return nil, errors.Errorf("file URL %q isn't a Slack URL", fileURL)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Authorization", "Bearer "+c.token)
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to download file")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to download file: %s", response.Status)
+	}
+	// Slack serves an HTML sign-in page instead of the file to tokens without the files:read scope.
+	if strings.HasPrefix(response.Header.Get("Content-Type"), "text/html") {
+		return nil, errors.New("failed to download file: missing files:read scope")
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.Errorf("file is larger than %d bytes", maxSize)
+	}
+	return data, nil
+}
+
+// Respond sends a message to the response URL of a slash command or a message action, which
+// doesn't need the bot token. Ephemeral messages are only shown to the user who sent the command.
+func (c *Client) Respond(ctx context.Context, responseURL, text string, ephemeral bool) error {
+	if !c.isSlackURL(responseURL) {
+		return errors.Errorf("response URL %q isn't a Slack URL", responseURL)
+	}
+	body, err := json.Marshal(NewResponse(text, ephemeral))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal response")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := c.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to send response")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to send response: %s", response.Status)
+	}
+	return nil
+}
+
+// Response is a message in reply to a slash command or a message action.
+type Response struct {
+	ResponseType    string `json:"response_type"`
+	Text            string `json:"text"`
+	ReplaceOriginal bool   `json:"replace_original"`
+}
+
+// NewResponse returns a response message, shown to everyone in the channel unless ephemeral.
+func NewResponse(text string, ephemeral bool) *Response {
+	response := &Response{ResponseType: "in_channel", Text: text}
+	if ephemeral {
+		response.ResponseType = "ephemeral"
+	}
+	return response
+}
+
+func (c *Client) call(ctx context.Context, method, endpoint string, query url.Values, body []byte, result any) error {
+	u := c.apiURL.JoinPath(endpoint)
+	u.RawQuery = query.Encode()
+	request, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	response, err := c.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s", endpoint)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to call %s: %s", endpoint, response.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s response", endpoint)
+	}
+	envelope := &apiResponse{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return errors.Wrapf(err, "invalid %s response", endpoint)
+	}
+	if !envelope.OK {
+		return errors.Errorf("failed to call %s: %s", endpoint, envelope.Error)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return errors.Wrapf(err, "invalid %s response", endpoint)
+	}
+	return nil
+}
+
+// isSlackURL reports whether a URL is an HTTPS URL of Slack, e.g. a file or a response URL, or a
+// URL of the host of the API URL, for tests.
+func (c *Client) isSlackURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	if u.Host == c.apiURL.Host && u.Scheme == c.apiURL.Scheme {
+		return true
+	}
+	host := strings.ToLower(u.Hostname())
+	return u.Scheme == "https" && (host == "slack.com" || strings.HasSuffix(host, ".slack.com"))
+}
@@ -0,0 +1,48 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignatureHeader is the header Slack requests carry their signature in, e.g. "v0=a2114d...".
+	SignatureHeader = "X-Slack-Signature"
+	// TimestampHeader is the header Slack requests carry their Unix timestamp in.
+	TimestampHeader = "X-Slack-Request-Timestamp"
+	// signatureTolerance is the maximum age of the signed requests, so that captured requests
+	// can't be replayed.
+	signatureTolerance = 5 * time.Minute
+)
+
+// Sign returns the signature of a request body sent at the given Unix time. The signature is the
+// hex-encoded HMAC-SHA256 of "v0:<timestamp>:<body>", keyed with the signing secret of the app.
+func Sign(signingSecret string, timestampSec int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	fmt.Fprintf(mac, "v0:%d:", timestampSec)
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a request from Slack against the signing secret of the app.
+func Verify(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	timestampSec, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid request timestamp")
+	}
+	age := now.Sub(time.Unix(timestampSec, 0))
+	if age > signatureTolerance || age < -signatureTolerance {
+		return errors.New("request timestamp is too old")
+	}
+	if !hmac.Equal([]byte(header.Get(SignatureHeader)), []byte(Sign(signingSecret, timestampSec, body))) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
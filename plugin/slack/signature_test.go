@@ -0,0 +1,34 @@
+package slack
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte("token=x&team_id=T1&user_id=U1&command=%2Fmemo&text=hello")
+	now := time.Now()
+	signed := func(secret string, timestamp time.Time) http.Header {
+		header := http.Header{}
+		header.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+		header.Set(SignatureHeader, Sign(secret, timestamp.Unix(), body))
+		return header
+	}
+
+	require.NoError(t, Verify("secret", signed("secret", now), body, now))
+	require.Error(t, Verify("other", signed("secret", now), body, now))
+	require.Error(t, Verify("secret", signed("secret", now), []byte("text=bye"), now))
+	// Replays of an old request are rejected.
+	require.Error(t, Verify("secret", signed("secret", now.Add(-10*time.Minute)), body, now))
+	require.Error(t, Verify("secret", http.Header{}, body, now))
+}
+
+func TestSign(t *testing.T) {
+	// The example of the Slack documentation.
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+	require.Equal(t, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503", Sign("8f742231b10e8888abcd99yyyzzz85a5", 1531420618, body))
+}
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/pkg/errors"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -79,6 +81,36 @@ func (c *Client) PresignGetObject(ctx context.Context, key string) (string, erro
 	return presignResult.URL, nil
 }
 
+// PresignPutObject presigns an upload of an object to S3, valid for the duration. The content
+// type and length are signed, so the upload must send them unchanged.
+func (c *Client) PresignPutObject(ctx context.Context, key string, contentType string, contentLength int64, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.Client)
+	presignResult, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(*c.Bucket),
+		Key:           aws.String(key),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(contentLength),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expires
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to presign put object")
+	}
+	return presignResult.URL, nil
+}
+
+// HeadObject returns the size and the content type of an object in S3.
+func (c *Client) HeadObject(ctx context.Context, key string) (int64, string, error) {
+	result, err := c.Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: c.Bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed to head object")
+	}
+	return aws.ToInt64(result.ContentLength), aws.ToString(result.ContentType), nil
+}
+
 // GetObject retrieves an object from S3.
 func (c *Client) GetObject(ctx context.Context, key string) ([]byte, error) {
 	downloader := manager.NewDownloader(c.Client)
@@ -93,6 +125,121 @@ func (c *Client) GetObject(ctx context.Context, key string) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// GetObjectReader retrieves an object from S3 as a stream, which must be closed.
+func (c *Client) GetObjectReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	result, err := c.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: c.Bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+	return result.Body, nil
+}
+
+// Part is an uploaded part of a multipart upload.
+type Part struct {
+	// Number is the number of the part, from 1.
+	Number int32
+	ETag   string
+	Size   int64
+}
+
+// CreateMultipartUpload starts a multipart upload of an object to S3 and returns its ID.
+func (c *Client) CreateMultipartUpload(ctx context.Context, key string, contentType string) (string, error) {
+	result, err := c.Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      c.Bucket,
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create multipart upload")
+	}
+	return aws.ToString(result.UploadId), nil
+}
+
+// UploadPart uploads a part of a multipart upload, replacing a previous upload of the part.
+func (c *Client) UploadPart(ctx context.Context, key string, uploadID string, number int32, content []byte) error {
+	_, err := c.Client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        c.Bucket,
+		Key:           aws.String(key),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int32(number),
+		ContentLength: aws.Int64(int64(len(content))),
+		Body:          bytes.NewReader(content),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to upload part")
+	}
+	return nil
+}
+
+// ListParts lists the uploaded parts of a multipart upload, ordered by number.
+func (c *Client) ListParts(ctx context.Context, key string, uploadID string) ([]*Part, error) {
+	parts := []*Part{}
+	paginator := s3.NewListPartsPaginator(c.Client, &s3.ListPartsInput{
+		Bucket:   c.Bucket,
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list parts")
+		}
+		for _, part := range page.Parts {
+			parts = append(parts, &Part{
+				Number: aws.ToInt32(part.PartNumber),
+				ETag:   aws.ToString(part.ETag),
+				Size:   aws.ToInt64(part.Size),
+			})
+		}
+	}
+	return parts, nil
+}
+
+// CompleteMultipartUpload assembles the parts of a multipart upload into the object.
+func (c *Client) CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []*Part) error {
+	completedParts := make([]types.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completedParts = append(completedParts, types.CompletedPart{
+			PartNumber: aws.Int32(part.Number),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+	_, err := c.Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          c.Bucket,
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completedParts},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to complete multipart upload")
+	}
+	return nil
+}
+
+// AbortMultipartUpload abandons a multipart upload, deleting its uploaded parts.
+func (c *Client) AbortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	_, err := c.Client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   c.Bucket,
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to abort multipart upload")
+	}
+	return nil
+}
+
+// IsNotFound reports whether an error of GetObject or HeadObject is caused by a missing object.
+func IsNotFound(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	// HeadObject responses have no body, so a missing object is only reported by the status code.
+	var notFound *types.NotFound
+	return errors.As(err, &noSuchKey) || errors.As(err, &notFound)
+}
+
 // DeleteObject deletes an object in S3.
 func (c *Client) DeleteObject(ctx context.Context, key string) error {
 	_, err := c.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
@@ -104,3 +251,22 @@ func (c *Client) DeleteObject(ctx context.Context, key string) error {
 	}
 	return nil
 }
+
+// ListObjects lists the keys of the objects in S3 with the prefix.
+func (c *Client) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	keys := []string{}
+	paginator := s3.NewListObjectsV2Paginator(c.Client, &s3.ListObjectsV2Input{
+		Bucket: c.Bucket,
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list objects")
+		}
+		for _, object := range page.Contents {
+			keys = append(keys, aws.ToString(object.Key))
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,87 @@
+// Package thumbnail generates the thumbnails of image attachments at fixed widths.
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"path"
+	"slices"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+)
+
+// Size is the name of a thumbnail width.
+type Size string
+
+const (
+	// Small is the size of the thumbnails shown in lists and the editor.
+	Small Size = "small"
+	// Medium is the size of the thumbnails shown in the timeline.
+	Medium Size = "medium"
+)
+
+// Sizes are the sizes of the thumbnails generated for an image.
+var Sizes = []Size{Small, Medium}
+
+// widths are the maximum widths in pixels of the thumbnail sizes. Narrower images aren't enlarged.
+var widths = map[Size]int{
+	Small:  300,
+	Medium: 600,
+}
+
+// formats are the image types thumbnails are generated for, with their encoding format.
+// Other types, animated GIFs included, are served untouched.
+var formats = map[string]imaging.Format{
+	"image/png":  imaging.PNG,
+	"image/jpeg": imaging.JPEG,
+}
+
+// ParseSize returns the size with the given name. "true" is the medium size, as requested by
+// previous versions.
+func ParseSize(name string) (Size, bool) {
+	if name == "true" {
+		return Medium, true
+	}
+	size := Size(name)
+	return size, slices.Contains(Sizes, size)
+}
+
+// IsSupported reports whether thumbnails are generated for the image type.
+func IsSupported(mimeType string) bool {
+	_, ok := formats[mimeType]
+	return ok
+}
+
+// Key returns the deterministic key of the thumbnail of a stored file, in a .thumbnails folder
+// next to the file. The key of a local file is its path, the one of an S3 object its object key.
+func Key(reference string, size Size) string {
+	return path.Join(path.Dir(reference), ".thumbnails", string(size)+"_"+path.Base(reference))
+}
+
+// Generate generates the thumbnails of an image of a supported type at every size. The image is
+// rotated and flipped according to its EXIF orientation, and narrower images aren't enlarged.
+func Generate(blob []byte, mimeType string) (map[Size][]byte, error) {
+	format, ok := formats[mimeType]
+	if !ok {
+		return nil, errors.Errorf("unsupported image type %q", mimeType)
+	}
+	// Decoding is memory intensive, so the image is decoded once for all the sizes.
+	img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode image")
+	}
+	thumbnails := make(map[Size][]byte, len(Sizes))
+	for _, size := range Sizes {
+		thumbnailImage := image.Image(img)
+		if img.Bounds().Dx() > widths[size] {
+			thumbnailImage = imaging.Resize(img, widths[size], 0, imaging.Lanczos)
+		}
+		var buf bytes.Buffer
+		if err := imaging.Encode(&buf, thumbnailImage, format); err != nil {
+			return nil, errors.Wrap(err, "failed to encode thumbnail")
+		}
+		thumbnails[size] = buf.Bytes()
+	}
+	return thumbnails, nil
+}
@@ -0,0 +1,60 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestGenerate(t *testing.T) {
+	thumbnails, err := Generate(encodePNG(t, 1200, 800), "image/png")
+	require.NoError(t, err)
+	require.Len(t, thumbnails, len(Sizes))
+	for size, expected := range map[Size]image.Point{Small: {300, 200}, Medium: {600, 400}} {
+		config, format, err := image.DecodeConfig(bytes.NewReader(thumbnails[size]))
+		require.NoError(t, err)
+		require.Equal(t, "png", format)
+		require.Equal(t, expected, image.Point{config.Width, config.Height})
+	}
+
+	// Narrower images aren't enlarged.
+	thumbnails, err = Generate(encodePNG(t, 100, 50), "image/png")
+	require.NoError(t, err)
+	config, _, err := image.DecodeConfig(bytes.NewReader(thumbnails[Medium]))
+	require.NoError(t, err)
+	require.Equal(t, 100, config.Width)
+
+	_, err = Generate([]byte("not an image"), "image/png")
+	require.Error(t, err)
+	_, err = Generate(encodePNG(t, 100, 50), "image/gif")
+	require.Error(t, err)
+}
+
+func TestParseSize(t *testing.T) {
+	for name, expected := range map[string]Size{"small": Small, "medium": Medium, "true": Medium} {
+		size, ok := ParseSize(name)
+		require.True(t, ok)
+		require.Equal(t, expected, size)
+	}
+	_, ok := ParseSize("huge")
+	require.False(t, ok)
+}
+
+func TestKey(t *testing.T) {
+	require.Equal(t, "assets/.thumbnails/small_123_photo.jpg", Key("assets/123_photo.jpg", Small))
+	require.Equal(t, ".thumbnails/medium_photo.png", Key("photo.png", Medium))
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// Format is the format of the payloads sent to a webhook.
+type Format string
+
+const (
+	// FormatRaw sends the WebhookRequestPayload of events.
+	FormatRaw Format = "raw"
+	// FormatSlack sends Slack incoming webhook messages.
+	FormatSlack Format = "slack"
+	// FormatDiscord sends Discord webhook messages.
+	FormatDiscord Format = "discord"
+)
+
+// The limits of the message fields of Slack and Discord, which reject longer messages with a 400.
+// The fields of a Discord embed must also total at most 6000 characters.
+const (
+	slackHeaderMaxLength       = 150
+	slackSectionTextMaxLength  = 3000
+	slackFallbackTextMaxLength = 4000
+	discordTitleMaxLength      = 256
+	discordDescriptionMaxLen   = 4096
+	discordFooterMaxLength     = 256
+)
+
+// Message is an event formatted for chat services.
+type Message struct {
+	// Event is the event of the message, e.g. memos.memo.created.
+	Event string
+	// Title is the title of the memo, usually its first line.
+	Title string
+	// Text is the content of the memo, in Markdown.
+	Text string
+	// URL links back to the memo, if the instance URL is known.
+	URL string
+	// ImageURL is the URL of a thumbnail of the memo, if it has a public image.
+	ImageURL string
+	// Time is the time of the event.
+	Time time.Time
+}
+
+// eventDescriptions are the readable descriptions of the events in messages.
+var eventDescriptions = map[string]string{
+	EventMemoCreated:  "Memo created",
+	EventMemoUpdated:  "Memo updated",
+	EventMemoTrashed:  "Memo moved to the trash",
+	EventMemoRestored: "Memo restored",
+	EventMemoReminder: "Memo reminder",
+	EventMemoExpired:  "Memo expired",
+	EventMemoDeleted:  "Memo deleted",
+	EventTest:         "Test event",
+}
+
+// MarshalSlack renders a message as a Slack message with blocks.
+func MarshalSlack(message *Message) ([]byte, error) {
+	event := describeEvent(message.Event)
+	title := truncate(orDefault(message.Title, event), slackHeaderMaxLength)
+	section := map[string]any{
+		"type": "section",
+		"text": map[string]any{"type": "mrkdwn", "text": truncate(orDefault(message.Text, event), slackSectionTextMaxLength)},
+	}
+	if message.ImageURL != "" {
+		section["accessory"] = map[string]any{"type": "image", "image_url": message.ImageURL, "alt_text": title}
+	}
+	footer := event
+	if message.URL != "" {
+		footer = fmt.Sprintf("%s · <%s|View memo>", event, message.URL)
+	}
+	return json.Marshal(map[string]any{
+		// Notifications show the fallback text.
+		"text": truncate(fmt.Sprintf("%s: %s", event, title), slackFallbackTextMaxLength),
+		"blocks": []any{
+			map[string]any{"type": "header", "text": map[string]any{"type": "plain_text", "text": title}},
+			section,
+			map[string]any{"type": "context", "elements": []any{map[string]any{"type": "mrkdwn", "text": footer}}},
+		},
+	})
+}
+
+// MarshalDiscord renders a message as a Discord message with an embed.
+func MarshalDiscord(message *Message) ([]byte, error) {
+	event := describeEvent(message.Event)
+	embed := map[string]any{
+		"title":     truncate(orDefault(message.Title, event), discordTitleMaxLength),
+		"timestamp": message.Time.UTC().Format(time.RFC3339),
+		"footer":    map[string]any{"text": truncate(event, discordFooterMaxLength)},
+	}
+	if message.Text != "" {
+		embed["description"] = truncate(message.Text, discordDescriptionMaxLen)
+	}
+	if message.URL != "" {
+		embed["url"] = message.URL
+	}
+	if message.ImageURL != "" {
+		embed["thumbnail"] = map[string]any{"url": message.ImageURL}
+	}
+	return json.Marshal(map[string]any{
+		"embeds": []any{embed},
+	})
+}
+
+func describeEvent(event string) string {
+	if description, ok := eventDescriptions[event]; ok {
+		return description
+	}
+	return event
+}
+
+// truncate shortens s to at most n characters, ending it with an ellipsis if it's cut.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:n-1]) + "…"
+}
+
+func orDefault(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalSlack(t *testing.T) {
+	body, err := MarshalSlack(&Message{
+		Event:    EventMemoCreated,
+		Title:    strings.Repeat("t", 200),
+		Text:     strings.Repeat("é", 5000),
+		URL:      "https://memos.example.com/memos/abc",
+		ImageURL: "https://memos.example.com/file/attachments/abc/image.png",
+		Time:     time.Now(),
+	})
+	require.NoError(t, err)
+	message := struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type string `json:"type"`
+			Text struct {
+				Text string `json:"text"`
+			} `json:"text"`
+			Accessory struct {
+				ImageURL string `json:"image_url"`
+			} `json:"accessory"`
+			Elements []struct {
+				Text string `json:"text"`
+			} `json:"elements"`
+		} `json:"blocks"`
+	}{}
+	require.NoError(t, json.Unmarshal(body, &message))
+	require.True(t, strings.HasPrefix(message.Text, "Memo created: "))
+	require.Len(t, message.Blocks, 3)
+	require.Equal(t, 150, utf8.RuneCountInString(message.Blocks[0].Text.Text))
+	require.Equal(t, 3000, utf8.RuneCountInString(message.Blocks[1].Text.Text))
+	require.True(t, strings.HasSuffix(message.Blocks[1].Text.Text, "…"))
+	require.Equal(t, "https://memos.example.com/file/attachments/abc/image.png", message.Blocks[1].Accessory.ImageURL)
+	require.Equal(t, "Memo created · <https://memos.example.com/memos/abc|View memo>", message.Blocks[2].Elements[0].Text)
+}
+
+func TestMarshalDiscord(t *testing.T) {
+	body, err := MarshalDiscord(&Message{
+		Event: EventTest,
+		Text:  strings.Repeat("a", 5000),
+		Time:  time.Now(),
+	})
+	require.NoError(t, err)
+	message := struct {
+		Embeds []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			Thumbnail   *struct {
+				URL string `json:"url"`
+			} `json:"thumbnail"`
+			Footer struct {
+				Text string `json:"text"`
+			} `json:"footer"`
+		} `json:"embeds"`
+	}{}
+	require.NoError(t, json.Unmarshal(body, &message))
+	require.Len(t, message.Embeds, 1)
+	embed := message.Embeds[0]
+	// Memos without a first line are titled with their event.
+	require.Equal(t, "Test event", embed.Title)
+	require.Equal(t, 4096, utf8.RuneCountInString(embed.Description))
+	require.Empty(t, embed.URL)
+	require.Nil(t, embed.Thumbnail)
+	require.Equal(t, "Test event", embed.Footer.Text)
+}
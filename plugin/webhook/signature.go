@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SignatureHeader is the header signed webhook requests carry their signature in.
+// Its value has the form "t=<timestamp>,v1=<signature>".
+const SignatureHeader = "X-Memos-Signature"
+
+// Sign returns the signature header value of a request body sent at the given Unix time.
+// The signature is the hex-encoded HMAC-SHA256 of "<timestamp>.<body>", keyed with the secret.
+func Sign(secret string, timestampSec int64, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestampSec, computeSignature(secret, timestampSec, body))
+}
+
+// Verify checks the signature header value of a request body against the secret, as a receiver
+// would. Signatures older than tolerance are rejected, so that captured requests can't be replayed.
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var timestampSec int64
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return errors.Errorf("invalid signature header part %q", part)
+		}
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid signature timestamp")
+			}
+			timestampSec = parsed
+		case "v1":
+			signatures = append(signatures, value)
+		default:
+			// Ignore unknown schemes.
+		}
+	}
+	if timestampSec == 0 || len(signatures) == 0 {
+		return errors.New("signature header is missing the timestamp or the signature")
+	}
+	if now.Sub(time.Unix(timestampSec, 0)) > tolerance {
+		return errors.New("signature timestamp is too old")
+	}
+
+	expected := computeSignature(secret, timestampSec, body)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return errors.New("signature doesn't match")
+}
+
+func computeSignature(secret string, timestampSec int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestampSec)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -2,62 +2,165 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
-	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/plugin/httpgetter"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 )
 
 var (
 	// timeout is the timeout for webhook request. Default to 30 seconds.
 	timeout = 30 * time.Second
+	// testTimeout is the timeout for test webhook requests, which are answered synchronously.
+	testTimeout = 10 * time.Second
+	// newTransport returns the transport of webhook requests.
+	newTransport = httpgetter.NewTransport
 )
 
+const (
+	// maxResponseBodySize is the size of the response body read by test requests.
+	maxResponseBodySize = 1 << 20
+	// maxTestResponseBodySize is the size of the response body returned by test requests.
+	maxTestResponseBodySize = 1 << 10
+)
+
+// The events webhooks are sent for. The event of a webhook request is its activity type.
+const (
+	EventMemoCreated  = "memos.memo.created"
+	EventMemoUpdated  = "memos.memo.updated"
+	EventMemoTrashed  = "memos.memo.trashed"
+	EventMemoRestored = "memos.memo.restored"
+	EventMemoReminder = "memos.memo.reminder"
+	EventMemoExpired  = "memos.memo.expired"
+	EventMemoDeleted  = "memos.memo.deleted"
+	// EventTest is the event of test requests, which webhooks can't subscribe to.
+	EventTest = "test"
+)
+
+// Events lists the events webhooks can subscribe to.
+var Events = []string{
+	EventMemoCreated,
+	EventMemoUpdated,
+	EventMemoTrashed,
+	EventMemoRestored,
+	EventMemoReminder,
+	EventMemoExpired,
+	EventMemoDeleted,
+}
+
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
-	// The type of activity that triggered this webhook.
+	// The event that triggered this webhook, one of Events.
 	ActivityType string `json:"activityType"`
 	// The resource name of the creator. Format: users/{user}
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// TaskToggle is set for the memo updated events of checking or unchecking a task, whose memo
+	// only has the content and the fields that changed with it.
+	TaskToggle *TaskToggle `json:"taskToggle,omitempty"`
+}
+
+// TaskToggle is the task of a memo that was checked or unchecked.
+type TaskToggle struct {
+	// The position of the task among the tasks of the memo, from 0.
+	Index int32 `json:"index"`
+	// The markdown of the task after its checkbox.
+	Text    string `json:"text"`
+	Checked bool   `json:"checked"`
 }
 
-// Post posts the message to webhook endpoint.
-func Post(requestPayload *WebhookRequestPayload) error {
-	body, err := json.Marshal(requestPayload)
+// Response is the response of a webhook endpoint to a test request.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// Body is the start of the response body, up to maxTestResponseBodySize bytes.
+	Body []byte
+	// Latency is the round-trip time of the request.
+	Latency time.Duration
+}
+
+// Send posts a JSON body to a webhook endpoint once, returning the HTTP status of the response, or
+// 0 if there was none. The webhook must answer with a 2xx status, and raw webhooks with a JSON body
+// of code 0. Failures aren't retried, as deliveries are retried by their queue.
+// If secret isn't empty, the request is signed with it at the time it's sent.
+func Send(ctx context.Context, url, secret string, format Format, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := post(ctx, url, secret, body)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read webhook response from %s", url)
 	}
+	return resp.StatusCode, checkResponse(url, format, resp.StatusCode, b)
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// Test posts a JSON body to a webhook endpoint like Send, with a shorter timeout, and returns the
+// response of the endpoint when there is one. The returned error is the one Send would fail with.
+func Test(ctx context.Context, url, secret string, format Format, body []byte) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, testTimeout)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := post(ctx, url, secret, body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return nil, err
 	}
+	defer resp.Body.Close()
+
+	b, readErr := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       b[:min(len(b), maxTestResponseBodySize)],
+		Latency:    time.Since(startTime),
+	}
+	if readErr != nil {
+		return response, errors.Wrapf(readErr, "failed to read webhook response from %s", url)
+	}
+	return response, checkResponse(url, format, resp.StatusCode, b)
+}
 
+// post sends a webhook request, signed with secret unless it's empty.
+func post(ctx context.Context, url, secret string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to construct webhook request to %s", url)
+	}
 	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, time.Now().Unix(), body))
+	}
 	client := &http.Client{
-		Timeout: timeout,
+		// Webhook URLs are user-supplied, so connect only to public addresses.
+		Transport: newTransport(),
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to post webhook to %s", url)
 	}
+	return resp, nil
+}
 
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+// checkResponse checks that a webhook endpoint answered with a 2xx status, and raw webhooks with a
+// JSON body of code 0. Slack and Discord answer with a plain "ok" and an empty body.
+func checkResponse(url string, format Format, statusCode int, b []byte) error {
+	if statusCode < 200 || statusCode > 299 {
+		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", url, statusCode, b)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", requestPayload.URL, resp.StatusCode, b)
+	if format != FormatRaw {
+		return nil
 	}
 
 	response := &struct {
@@ -65,26 +168,11 @@ func Post(requestPayload *WebhookRequestPayload) error {
 		Message string `json:"message"`
 	}{}
 	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", url)
 	}
 
 	if response.Code != 0 {
 		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
 	}
-
 	return nil
 }
-
-// PostAsync posts the message to webhook endpoint asynchronously.
-// It spawns a new goroutine to handle the request and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
-	go func() {
-		if err := Post(requestPayload); err != nil {
-			// Since we're in a goroutine, we can only log the error
-			slog.Warn("Failed to dispatch webhook asynchronously",
-				slog.String("url", requestPayload.URL),
-				slog.String("activityType", requestPayload.ActivityType),
-				slog.Any("err", err))
-		}
-	}()
-}
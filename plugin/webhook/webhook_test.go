@@ -1 +1,96 @@
 package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	now := time.Now()
+	header := Sign("secret", now.Unix(), body)
+
+	require.NoError(t, Verify("secret", header, body, 5*time.Minute, now))
+	require.Error(t, Verify("other", header, body, 5*time.Minute, now))
+	require.Error(t, Verify("secret", header, []byte(`{}`), 5*time.Minute, now))
+	// Replays of an old request are rejected.
+	require.Error(t, Verify("secret", header, body, 5*time.Minute, now.Add(10*time.Minute)))
+	require.Error(t, Verify("secret", "v1=abc", body, 5*time.Minute, now))
+}
+
+func TestSendSignsEveryAttempt(t *testing.T) {
+	headers := make(chan string, 2)
+	testutil.ServeTransportAt(t, &newTransport, func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get(SignatureHeader)
+		w.Write([]byte(`{"code":0}`))
+	})
+
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	for range 2 {
+		before := time.Now()
+		statusCode, err := Send(context.Background(), "http://192.0.2.1/hook", "secret", FormatRaw, body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		// Each attempt is signed with the time it was sent.
+		header := <-headers
+		require.NoError(t, Verify("secret", header, body, time.Since(before)+time.Second, time.Now()))
+	}
+
+	_, err := Send(context.Background(), "http://192.0.2.1/hook", "", FormatRaw, body)
+	require.NoError(t, err)
+	require.Empty(t, <-headers)
+}
+
+func TestTest(t *testing.T) {
+	testutil.ServeTransportAt(t, &newTransport, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Receiver", "test")
+		switch r.URL.Path {
+		case "/large":
+			w.Write([]byte(strings.Repeat("a", 2048)))
+			return
+		case "/slack":
+			w.Write([]byte("ok"))
+			return
+		default:
+		}
+		w.Write([]byte(`{"code":0}`))
+	})
+
+	response, err := Test(context.Background(), "http://192.0.2.1/hook", "secret", FormatRaw, []byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "test", response.Header.Get("X-Receiver"))
+	require.Equal(t, `{"code":0}`, string(response.Body))
+
+	// Responses production deliveries would fail on are reported, with the start of their body.
+	response, err = Test(context.Background(), "http://192.0.2.1/large", "secret", FormatRaw, []byte(`{}`))
+	require.Error(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Len(t, response.Body, 1024)
+
+	// Slack and Discord don't answer with a JSON body.
+	response, err = Test(context.Background(), "http://192.0.2.1/slack", "secret", FormatSlack, []byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(response.Body))
+	_, err = Test(context.Background(), "http://192.0.2.1/slack", "secret", FormatRaw, []byte(`{}`))
+	require.Error(t, err)
+}
+
+func TestTestRejectsInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	response, err := Test(context.Background(), server.URL, "secret", FormatRaw, []byte(`{}`))
+	require.Error(t, err)
+	require.Nil(t, response)
+}
@@ -0,0 +1,92 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// recordSize is the record size of the encrypted messages, which are a single record.
+	recordSize = 4096
+	// headerSize is the size of the header of the encrypted messages: the salt, the record size,
+	// and the length and the public key of the sender.
+	headerSize = 16 + 4 + 1 + 65
+	// MaxPayloadSize is the maximum size of a payload, as push services accept messages of up to
+	// 4096 bytes, minus the header, the padding delimiter and the authentication tag.
+	MaxPayloadSize = recordSize - headerSize - 1 - 16
+)
+
+// encrypt encrypts a payload for a subscription, with the aes128gcm content encoding of Web Push
+// (RFC 8291 and RFC 8188).
+func encrypt(subscription *Subscription, payload []byte) ([]byte, error) {
+	senderKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+	return encryptWithKey(subscription, payload, senderKey, salt)
+}
+
+func encryptWithKey(subscription *Subscription, payload []byte, senderKey *ecdh.PrivateKey, salt []byte) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, errors.Errorf("payload is too large (max %d bytes)", MaxPayloadSize)
+	}
+	if len(subscription.Auth) != 16 {
+		return nil, errors.New("invalid subscription auth secret")
+	}
+	receiverKey, err := ecdh.P256().NewPublicKey(subscription.P256dh)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid subscription public key")
+	}
+	sharedSecret, err := senderKey.ECDH(receiverKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute shared secret")
+	}
+
+	receiverPublicKey := receiverKey.Bytes()
+	senderPublicKey := senderKey.PublicKey().Bytes()
+	keyInfo := "WebPush: info\x00" + string(receiverPublicKey) + string(senderPublicKey)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, subscription.Auth, keyInfo, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive key")
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive key")
+	}
+	contentKey, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive key")
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive nonce")
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+
+	body := make([]byte, 0, headerSize+len(payload)+1+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(senderPublicKey)))
+	body = append(body, senderPublicKey...)
+	// The payload is the last record, so it ends with the 0x02 padding delimiter.
+	return gcm.Seal(body, nonce, append(payload, 0x02), nil), nil
+}
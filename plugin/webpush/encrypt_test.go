@@ -0,0 +1,35 @@
+package webpush
+
+import (
+	"crypto/ecdh"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decodeBase64(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// TestEncrypt checks the encryption against the example of RFC 8291, section 5.
+func TestEncrypt(t *testing.T) {
+	senderKey, err := ecdh.P256().NewPrivateKey(decodeBase64(t, "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	require.NoError(t, err)
+	subscription := &Subscription{
+		P256dh: decodeBase64(t, "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+		Auth:   decodeBase64(t, "BTBZMqHH6r4Tts7J_aSIgg"),
+	}
+
+	body, err := encryptWithKey(subscription, []byte("When I grow up, I want to be a watermelon"), senderKey, decodeBase64(t, "DGv6ra1nlYgDCS1FRnbzlw"))
+	require.NoError(t, err)
+	require.Equal(t, "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN", base64.RawURLEncoding.EncodeToString(body))
+
+	_, err = encryptWithKey(subscription, make([]byte, MaxPayloadSize+1), senderKey, make([]byte, 16))
+	require.Error(t, err)
+	_, err = encryptWithKey(&Subscription{P256dh: subscription.P256dh, Auth: []byte("short")}, []byte("payload"), senderKey, make([]byte, 16))
+	require.Error(t, err)
+}
@@ -0,0 +1,182 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+var (
+	// timeout bounds sending a message to a push service.
+	timeout = 30 * time.Second
+	// newTransport returns the transport of the requests to push services.
+	newTransport = httpgetter.NewTransport
+)
+
+const (
+	// ttl is how long push services keep the messages for the devices that are offline.
+	ttl = 24 * time.Hour
+	// vapidTokenDuration is how long the VAPID tokens of the requests are valid, at most 24 hours.
+	vapidTokenDuration = 12 * time.Hour
+)
+
+// ErrSubscriptionGone is returned when the push service answers that a subscription expired or
+// was unsubscribed, so that it can be deleted.
+var ErrSubscriptionGone = errors.New("push subscription is gone")
+
+// Subscription is the PushSubscription of a browser.
+type Subscription struct {
+	// Endpoint is the URL of the push service the messages are posted to.
+	Endpoint string
+	// P256dh is the P-256 public key of the subscription, as an uncompressed point.
+	P256dh []byte
+	// Auth is the 16-byte authentication secret of the subscription.
+	Auth []byte
+}
+
+// Message is the payload of a notification, read by the service worker of the web app.
+type Message struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// URL is the page the notification opens.
+	URL string `json:"url"`
+	// Tag identifies the notification, so that the browser replaces it rather than showing it twice.
+	Tag string `json:"tag,omitempty"`
+}
+
+// VAPID identifies the application server to push services (RFC 8292).
+type VAPID struct {
+	// PublicKey is the base64url-encoded P-256 public key, as an uncompressed point. It's the
+	// applicationServerKey of the subscriptions.
+	PublicKey string
+	// PrivateKey is the base64url-encoded P-256 private key.
+	PrivateKey string
+	// Subject is a contact of the application server, a mailto: or an https: URL.
+	Subject string
+}
+
+// GenerateVAPIDKeys returns a new pair of base64url-encoded VAPID keys.
+func GenerateVAPIDKeys() (publicKey string, privateKey string, err error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to generate VAPID keys")
+	}
+	return base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()), base64.RawURLEncoding.EncodeToString(key.Bytes()), nil
+}
+
+// ValidateSubscription checks that a subscription has an https endpoint and valid keys.
+func ValidateSubscription(subscription *Subscription) error {
+	u, err := url.Parse(subscription.Endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return errors.New("endpoint must be an https URL")
+	}
+	if _, err := ecdh.P256().NewPublicKey(subscription.P256dh); err != nil {
+		return errors.New("invalid p256dh key")
+	}
+	if len(subscription.Auth) != 16 {
+		return errors.New("auth secret must be 16 bytes")
+	}
+	return nil
+}
+
+// Sender sends push messages.
+type Sender interface {
+	Send(ctx context.Context, vapid *VAPID, subscription *Subscription, message *Message) error
+}
+
+// HTTPSender sends push messages to push services over HTTP (RFC 8030).
+type HTTPSender struct{}
+
+// NewHTTPSender creates a new HTTP sender.
+func NewHTTPSender() *HTTPSender {
+	return &HTTPSender{}
+}
+
+// Send encrypts a message for a subscription and posts it to its push service. It returns
+// ErrSubscriptionGone if the subscription doesn't exist anymore.
+func (*HTTPSender) Send(ctx context.Context, vapid *VAPID, subscription *Subscription, message *Message) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal push message")
+	}
+	body, err := encrypt(subscription, payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt push message")
+	}
+	authorization, err := vapidAuthorization(vapid, subscription.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to construct push request")
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", strconv.Itoa(int(ttl.Seconds())))
+	req.Header.Set("Urgency", "normal")
+	req.Header.Set("Authorization", authorization)
+	client := &http.Client{
+		// Push endpoints are supplied by the browsers of the users, so connect only to public addresses.
+		Transport: newTransport(),
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post push message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return ErrSubscriptionGone
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return errors.Errorf("failed to post push message, status code: %d, response body: %s", resp.StatusCode, b)
+	}
+	return nil
+}
+
+// vapidAuthorization returns the Authorization header of a request to a push endpoint: a token
+// signed with the VAPID private key for the origin of the endpoint, and the public key.
+func vapidAuthorization(vapid *VAPID, endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid push endpoint")
+	}
+	privateKeyBytes, err := base64.RawURLEncoding.DecodeString(vapid.PrivateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid VAPID private key")
+	}
+	privateKey, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), privateKeyBytes)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid VAPID private key")
+	}
+	// The audience is a string rather than an array, which some push services reject.
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": time.Now().Add(vapidTokenDuration).Unix(),
+		"sub": vapid.Subject,
+	}).SignedString(privateKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign VAPID token")
+	}
+	return "vapid t=" + token + ", k=" + vapid.PublicKey, nil
+}
@@ -0,0 +1,61 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+)
+
+func TestHTTPSenderSend(t *testing.T) {
+	publicKey, privateKey, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	vapid := &VAPID{PublicKey: publicKey, PrivateKey: privateKey, Subject: "https://memos.example.com"}
+	receiverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+	subscription := &Subscription{Endpoint: "http://push.example.com/send/abc", P256dh: receiverKey.PublicKey().Bytes(), Auth: auth}
+
+	statusCode := http.StatusCreated
+	var request *http.Request
+	var body []byte
+	testutil.ServeTransportAt(t, &newTransport, func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(statusCode)
+	})
+
+	require.NoError(t, NewHTTPSender().Send(context.Background(), vapid, subscription, &Message{Title: "Hello", URL: "/inbox"}))
+	require.Equal(t, "/send/abc", request.URL.Path)
+	require.Equal(t, "aes128gcm", request.Header.Get("Content-Encoding"))
+	require.NotEmpty(t, request.Header.Get("TTL"))
+	require.Len(t, body, headerSize+len(`{"title":"Hello","body":"","url":"/inbox"}`)+1+16)
+
+	// The VAPID token is signed for the origin of the push service.
+	authorization := request.Header.Get("Authorization")
+	require.True(t, strings.HasPrefix(authorization, "vapid t="))
+	require.True(t, strings.HasSuffix(authorization, ", k="+publicKey))
+	token := strings.TrimSuffix(strings.TrimPrefix(authorization, "vapid t="), ", k="+publicKey)
+	claims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(token, claims)
+	require.NoError(t, err)
+	require.Equal(t, "http://push.example.com", claims["aud"])
+	require.Equal(t, "https://memos.example.com", claims["sub"])
+
+	statusCode = http.StatusGone
+	require.ErrorIs(t, NewHTTPSender().Send(context.Background(), vapid, subscription, &Message{Title: "Hello"}), ErrSubscriptionGone)
+	statusCode = http.StatusInternalServerError
+	err = NewHTTPSender().Send(context.Background(), vapid, subscription, &Message{Title: "Hello"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrSubscriptionGone)
+}
@@ -31,6 +31,20 @@ const (
 	Activity_TYPE_UNSPECIFIED Activity_Type = 0
 	// Memo comment activity.
 	Activity_MEMO_COMMENT Activity_Type = 1
+	// Memo reminder activity.
+	Activity_MEMO_REMINDER Activity_Type = 2
+	// Memo auto-archive activity.
+	Activity_MEMO_AUTO_ARCHIVE Activity_Type = 3
+	// Webhook disabled activity.
+	Activity_WEBHOOK_DISABLED Activity_Type = 4
+	// Backup failed activity.
+	Activity_BACKUP_FAILED Activity_Type = 5
+	// Memo reaction activity.
+	Activity_MEMO_REACTION Activity_Type = 6
+	// Memo mention activity.
+	Activity_MEMO_MENTION Activity_Type = 7
+	// Activity digest.
+	Activity_DIGEST Activity_Type = 8
 )
 
 // Enum value maps for Activity_Type.
@@ -38,10 +52,24 @@ var (
 	Activity_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "MEMO_REMINDER",
+		3: "MEMO_AUTO_ARCHIVE",
+		4: "WEBHOOK_DISABLED",
+		5: "BACKUP_FAILED",
+		6: "MEMO_REACTION",
+		7: "MEMO_MENTION",
+		8: "DIGEST",
 	}
 	Activity_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"MEMO_REMINDER":     2,
+		"MEMO_AUTO_ARCHIVE": 3,
+		"WEBHOOK_DISABLED":  4,
+		"BACKUP_FAILED":     5,
+		"MEMO_REACTION":     6,
+		"MEMO_MENTION":      7,
+		"DIGEST":            8,
 	}
 )
 
@@ -226,6 +254,13 @@ type ActivityPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*ActivityPayload_MemoComment
+	//	*ActivityPayload_MemoReminder
+	//	*ActivityPayload_MemoAutoArchive
+	//	*ActivityPayload_WebhookDisabled
+	//	*ActivityPayload_BackupFailed
+	//	*ActivityPayload_MemoReaction
+	//	*ActivityPayload_MemoMention
+	//	*ActivityPayload_Digest
 	Payload       isActivityPayload_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -277,6 +312,69 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoReminder); ok {
+			return x.MemoReminder
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoAutoArchive() *ActivityMemoAutoArchivePayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoAutoArchive); ok {
+			return x.MemoAutoArchive
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetWebhookDisabled() *ActivityWebhookDisabledPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_WebhookDisabled); ok {
+			return x.WebhookDisabled
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetBackupFailed() *ActivityBackupFailedPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_BackupFailed); ok {
+			return x.BackupFailed
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReaction() *ActivityMemoReactionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoReaction); ok {
+			return x.MemoReaction
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoMention); ok {
+			return x.MemoMention
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetDigest() *ActivityDigestPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_Digest); ok {
+			return x.Digest
+		}
+	}
+	return nil
+}
+
 type isActivityPayload_Payload interface {
 	isActivityPayload_Payload()
 }
@@ -286,8 +384,57 @@ type ActivityPayload_MemoComment struct {
 	MemoComment *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3,oneof"`
 }
 
+type ActivityPayload_MemoReminder struct {
+	// Memo reminder activity payload.
+	MemoReminder *ActivityMemoReminderPayload `protobuf:"bytes,2,opt,name=memo_reminder,json=memoReminder,proto3,oneof"`
+}
+
+type ActivityPayload_MemoAutoArchive struct {
+	// Memo auto-archive activity payload.
+	MemoAutoArchive *ActivityMemoAutoArchivePayload `protobuf:"bytes,3,opt,name=memo_auto_archive,json=memoAutoArchive,proto3,oneof"`
+}
+
+type ActivityPayload_WebhookDisabled struct {
+	// Webhook disabled activity payload.
+	WebhookDisabled *ActivityWebhookDisabledPayload `protobuf:"bytes,4,opt,name=webhook_disabled,json=webhookDisabled,proto3,oneof"`
+}
+
+type ActivityPayload_BackupFailed struct {
+	// Backup failed activity payload.
+	BackupFailed *ActivityBackupFailedPayload `protobuf:"bytes,5,opt,name=backup_failed,json=backupFailed,proto3,oneof"`
+}
+
+type ActivityPayload_MemoReaction struct {
+	// Memo reaction activity payload.
+	MemoReaction *ActivityMemoReactionPayload `protobuf:"bytes,6,opt,name=memo_reaction,json=memoReaction,proto3,oneof"`
+}
+
+type ActivityPayload_MemoMention struct {
+	// Memo mention activity payload.
+	MemoMention *ActivityMemoMentionPayload `protobuf:"bytes,7,opt,name=memo_mention,json=memoMention,proto3,oneof"`
+}
+
+type ActivityPayload_Digest struct {
+	// Activity digest payload.
+	Digest *ActivityDigestPayload `protobuf:"bytes,8,opt,name=digest,proto3,oneof"`
+}
+
 func (*ActivityPayload_MemoComment) isActivityPayload_Payload() {}
 
+func (*ActivityPayload_MemoReminder) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoAutoArchive) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_WebhookDisabled) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_BackupFailed) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoReaction) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoMention) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_Digest) isActivityPayload_Payload() {}
+
 // ActivityMemoCommentPayload represents the payload of a memo comment activity.
 type ActivityMemoCommentPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -345,6 +492,431 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemo() string {
 	return ""
 }
 
+// ActivityMemoReminderPayload represents the payload of a memo reminder activity.
+type ActivityMemoReminderPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The reminder time that was due.
+	RemindTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityMemoReminderPayload) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
+// ActivityMemoAutoArchivePayload represents the payload of a memo auto-archive activity, which
+// summarizes the memos archived by a run of the auto-archive policy of a user.
+type ActivityMemoAutoArchivePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos archived.
+	MemoCount int32 `protobuf:"varint,1,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The days without edits after which the memos were archived.
+	Days          int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoAutoArchivePayload) Reset() {
+	*x = ActivityMemoAutoArchivePayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoAutoArchivePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoAutoArchivePayload) ProtoMessage() {}
+
+func (x *ActivityMemoAutoArchivePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoAutoArchivePayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoAutoArchivePayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityMemoAutoArchivePayload) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *ActivityMemoAutoArchivePayload) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// ActivityWebhookDisabledPayload represents the payload of a webhook disabled activity, which
+// records a webhook disabled after too many consecutive failed deliveries.
+type ActivityWebhookDisabledPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the webhook.
+	// Format: users/{user}/webhooks/{webhook}
+	Webhook string `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The number of consecutive failed deliveries that disabled the webhook.
+	ConsecutiveFailures int32 `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ActivityWebhookDisabledPayload) Reset() {
+	*x = ActivityWebhookDisabledPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityWebhookDisabledPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityWebhookDisabledPayload) ProtoMessage() {}
+
+func (x *ActivityWebhookDisabledPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityWebhookDisabledPayload.ProtoReflect.Descriptor instead.
+func (*ActivityWebhookDisabledPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityWebhookDisabledPayload) GetWebhook() string {
+	if x != nil {
+		return x.Webhook
+	}
+	return ""
+}
+
+func (x *ActivityWebhookDisabledPayload) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+// ActivityBackupFailedPayload represents the payload of a backup failed activity, which records
+// a scheduled or manual backup of the instance that failed.
+type ActivityBackupFailedPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The error of the failed backup.
+	Error         string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityBackupFailedPayload) Reset() {
+	*x = ActivityBackupFailedPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityBackupFailedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityBackupFailedPayload) ProtoMessage() {}
+
+func (x *ActivityBackupFailedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityBackupFailedPayload.ProtoReflect.Descriptor instead.
+func (*ActivityBackupFailedPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityBackupFailedPayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ActivityMemoReactionPayload represents the payload of a memo reaction activity, which records
+// a reaction to a memo of another user.
+type ActivityMemoReactionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo reacted to.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The reaction type, e.g. an emoji.
+	ReactionType  string `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReactionPayload) Reset() {
+	*x = ActivityMemoReactionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReactionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActivityMemoReactionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityMemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+// ActivityMemoMentionPayload represents the payload of a memo mention activity, which records a
+// memo mentioning another user with @username.
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo mentioning the user.
+	// Format: memos/{memo}
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityDigestPayload represents the payload of an activity digest, which summarizes the
+// activity of a user over a day or a week.
+type ActivityDigestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start of the period summarized by the digest.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the period summarized by the digest.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The names of the memos the user created in the period.
+	// Format: memos/{memo}
+	Memos []string `protobuf:"bytes,3,rep,name=memos,proto3" json:"memos,omitempty"`
+	// The number of comments on the memos of the user in the period.
+	CommentCount int32 `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// The number of reactions to the memos of the user in the period.
+	ReactionCount int32 `protobuf:"varint,5,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`
+	// The names of the memos of the user with a reminder due in the next period.
+	// Format: memos/{memo}
+	ReminderMemos []string `protobuf:"bytes,6,rep,name=reminder_memos,json=reminderMemos,proto3" json:"reminder_memos,omitempty"`
+	// The name of a random older memo of the user, brought back by the digest.
+	// Format: memos/{memo}
+	ResurfacedMemo string `protobuf:"bytes,7,opt,name=resurfaced_memo,json=resurfacedMemo,proto3" json:"resurfaced_memo,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActivityDigestPayload) Reset() {
+	*x = ActivityDigestPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityDigestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDigestPayload) ProtoMessage() {}
+
+func (x *ActivityDigestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDigestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityDigestPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ActivityDigestPayload) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ActivityDigestPayload) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ActivityDigestPayload) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ActivityDigestPayload) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *ActivityDigestPayload) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+func (x *ActivityDigestPayload) GetReminderMemos() []string {
+	if x != nil {
+		return x.ReminderMemos
+	}
+	return nil
+}
+
+func (x *ActivityDigestPayload) GetResurfacedMemo() string {
+	if x != nil {
+		return x.ResurfacedMemo
+	}
+	return ""
+}
+
 type ListActivitiesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The maximum number of activities to return.
@@ -361,7 +933,7 @@ type ListActivitiesRequest struct {
 
 func (x *ListActivitiesRequest) Reset() {
 	*x = ListActivitiesRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -373,7 +945,7 @@ func (x *ListActivitiesRequest) String() string {
 func (*ListActivitiesRequest) ProtoMessage() {}
 
 func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +958,7 @@ func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListActivitiesRequest) GetPageSize() int32 {
@@ -417,7 +989,7 @@ type ListActivitiesResponse struct {
 
 func (x *ListActivitiesResponse) Reset() {
 	*x = ListActivitiesResponse{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +1001,7 @@ func (x *ListActivitiesResponse) String() string {
 func (*ListActivitiesResponse) ProtoMessage() {}
 
 func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +1014,7 @@ func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListActivitiesResponse) GetActivities() []*Activity {
@@ -470,7 +1042,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +1054,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +1067,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetActivityRequest) GetName() string {
@@ -509,7 +1081,7 @@ var File_api_v1_activity_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x03\n" +
+	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x04\n" +
 	"\bActivity\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x1d\n" +
 	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x03R\acreator\x124\n" +
@@ -517,23 +1089,65 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\x0e2\x1c.memos.api.v1.Activity.LevelB\x03\xe0A\x03R\x05level\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12<\n" +
-	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\".\n" +
+	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\"\xb2\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01\"=\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x02\x12\x15\n" +
+	"\x11MEMO_AUTO_ARCHIVE\x10\x03\x12\x14\n" +
+	"\x10WEBHOOK_DISABLED\x10\x04\x12\x11\n" +
+	"\rBACKUP_FAILED\x10\x05\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x06\x12\x10\n" +
+	"\fMEMO_MENTION\x10\a\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\b\"=\n" +
 	"\x05Level\x12\x15\n" +
 	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04INFO\x10\x01\x12\b\n" +
 	"\x04WARN\x10\x02\x12\t\n" +
 	"\x05ERROR\x10\x03:M\xeaAJ\n" +
 	"\x15memos.api.v1/Activity\x12\x15activities/{activity}\x1a\x04name*\n" +
-	"activities2\bactivity\"k\n" +
+	"activities2\bactivity\"\xa6\x05\n" +
 	"\x0fActivityPayload\x12M\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoCommentB\t\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoComment\x12P\n" +
+	"\rmemo_reminder\x18\x02 \x01(\v2).memos.api.v1.ActivityMemoReminderPayloadH\x00R\fmemoReminder\x12Z\n" +
+	"\x11memo_auto_archive\x18\x03 \x01(\v2,.memos.api.v1.ActivityMemoAutoArchivePayloadH\x00R\x0fmemoAutoArchive\x12Y\n" +
+	"\x10webhook_disabled\x18\x04 \x01(\v2,.memos.api.v1.ActivityWebhookDisabledPayloadH\x00R\x0fwebhookDisabled\x12P\n" +
+	"\rbackup_failed\x18\x05 \x01(\v2).memos.api.v1.ActivityBackupFailedPayloadH\x00R\fbackupFailed\x12P\n" +
+	"\rmemo_reaction\x18\x06 \x01(\v2).memos.api.v1.ActivityMemoReactionPayloadH\x00R\fmemoReaction\x12M\n" +
+	"\fmemo_mention\x18\a \x01(\v2(.memos.api.v1.ActivityMemoMentionPayloadH\x00R\vmemoMention\x12=\n" +
+	"\x06digest\x18\b \x01(\v2#.memos.api.v1.ActivityDigestPayloadH\x00R\x06digestB\t\n" +
 	"\apayload\"S\n" +
 	"\x1aActivityMemoCommentPayload\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
-	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"S\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"n\n" +
+	"\x1bActivityMemoReminderPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12;\n" +
+	"\vremind_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"remindTime\"S\n" +
+	"\x1eActivityMemoAutoArchivePayload\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x01 \x01(\x05R\tmemoCount\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"m\n" +
+	"\x1eActivityWebhookDisabledPayload\x12\x18\n" +
+	"\awebhook\x18\x01 \x01(\tR\awebhook\x121\n" +
+	"\x14consecutive_failures\x18\x02 \x01(\x05R\x13consecutiveFailures\"3\n" +
+	"\x1bActivityBackupFailedPayload\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"V\n" +
+	"\x1bActivityMemoReactionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\"0\n" +
+	"\x1aActivityMemoMentionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"\xbb\x02\n" +
+	"\x15ActivityDigestPayload\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05memos\x18\x03 \x03(\tR\x05memos\x12#\n" +
+	"\rcomment_count\x18\x04 \x01(\x05R\fcommentCount\x12%\n" +
+	"\x0ereaction_count\x18\x05 \x01(\x05R\rreactionCount\x12%\n" +
+	"\x0ereminder_memos\x18\x06 \x03(\tR\rreminderMemos\x12'\n" +
+	"\x0fresurfaced_memo\x18\a \x01(\tR\x0eresurfacedMemo\"S\n" +
 	"\x15ListActivitiesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -564,34 +1178,51 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_activity_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_v1_activity_service_proto_goTypes = []any{
-	(Activity_Type)(0),                 // 0: memos.api.v1.Activity.Type
-	(Activity_Level)(0),                // 1: memos.api.v1.Activity.Level
-	(*Activity)(nil),                   // 2: memos.api.v1.Activity
-	(*ActivityPayload)(nil),            // 3: memos.api.v1.ActivityPayload
-	(*ActivityMemoCommentPayload)(nil), // 4: memos.api.v1.ActivityMemoCommentPayload
-	(*ListActivitiesRequest)(nil),      // 5: memos.api.v1.ListActivitiesRequest
-	(*ListActivitiesResponse)(nil),     // 6: memos.api.v1.ListActivitiesResponse
-	(*GetActivityRequest)(nil),         // 7: memos.api.v1.GetActivityRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(Activity_Type)(0),                     // 0: memos.api.v1.Activity.Type
+	(Activity_Level)(0),                    // 1: memos.api.v1.Activity.Level
+	(*Activity)(nil),                       // 2: memos.api.v1.Activity
+	(*ActivityPayload)(nil),                // 3: memos.api.v1.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),     // 4: memos.api.v1.ActivityMemoCommentPayload
+	(*ActivityMemoReminderPayload)(nil),    // 5: memos.api.v1.ActivityMemoReminderPayload
+	(*ActivityMemoAutoArchivePayload)(nil), // 6: memos.api.v1.ActivityMemoAutoArchivePayload
+	(*ActivityWebhookDisabledPayload)(nil), // 7: memos.api.v1.ActivityWebhookDisabledPayload
+	(*ActivityBackupFailedPayload)(nil),    // 8: memos.api.v1.ActivityBackupFailedPayload
+	(*ActivityMemoReactionPayload)(nil),    // 9: memos.api.v1.ActivityMemoReactionPayload
+	(*ActivityMemoMentionPayload)(nil),     // 10: memos.api.v1.ActivityMemoMentionPayload
+	(*ActivityDigestPayload)(nil),          // 11: memos.api.v1.ActivityDigestPayload
+	(*ListActivitiesRequest)(nil),          // 12: memos.api.v1.ListActivitiesRequest
+	(*ListActivitiesResponse)(nil),         // 13: memos.api.v1.ListActivitiesResponse
+	(*GetActivityRequest)(nil),             // 14: memos.api.v1.GetActivityRequest
+	(*timestamppb.Timestamp)(nil),          // 15: google.protobuf.Timestamp
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
-	1, // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
-	8, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
-	3, // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
-	4, // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
-	2, // 5: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
-	5, // 6: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
-	7, // 7: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
-	6, // 8: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
-	2, // 9: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
+	1,  // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
+	15, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
+	4,  // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
+	5,  // 5: memos.api.v1.ActivityPayload.memo_reminder:type_name -> memos.api.v1.ActivityMemoReminderPayload
+	6,  // 6: memos.api.v1.ActivityPayload.memo_auto_archive:type_name -> memos.api.v1.ActivityMemoAutoArchivePayload
+	7,  // 7: memos.api.v1.ActivityPayload.webhook_disabled:type_name -> memos.api.v1.ActivityWebhookDisabledPayload
+	8,  // 8: memos.api.v1.ActivityPayload.backup_failed:type_name -> memos.api.v1.ActivityBackupFailedPayload
+	9,  // 9: memos.api.v1.ActivityPayload.memo_reaction:type_name -> memos.api.v1.ActivityMemoReactionPayload
+	10, // 10: memos.api.v1.ActivityPayload.memo_mention:type_name -> memos.api.v1.ActivityMemoMentionPayload
+	11, // 11: memos.api.v1.ActivityPayload.digest:type_name -> memos.api.v1.ActivityDigestPayload
+	15, // 12: memos.api.v1.ActivityMemoReminderPayload.remind_time:type_name -> google.protobuf.Timestamp
+	15, // 13: memos.api.v1.ActivityDigestPayload.start_time:type_name -> google.protobuf.Timestamp
+	15, // 14: memos.api.v1.ActivityDigestPayload.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 15: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
+	12, // 16: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
+	14, // 17: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
+	13, // 18: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
+	2,  // 19: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
+	18, // [18:20] is the sub-list for method output_type
+	16, // [16:18] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -601,6 +1232,13 @@ func file_api_v1_activity_service_proto_init() {
 	}
 	file_api_v1_activity_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*ActivityPayload_MemoComment)(nil),
+		(*ActivityPayload_MemoReminder)(nil),
+		(*ActivityPayload_MemoAutoArchive)(nil),
+		(*ActivityPayload_WebhookDisabled)(nil),
+		(*ActivityPayload_BackupFailed)(nil),
+		(*ActivityPayload_MemoReaction)(nil),
+		(*ActivityPayload_MemoMention)(nil),
+		(*ActivityPayload_Digest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -608,7 +1246,7 @@ func file_api_v1_activity_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
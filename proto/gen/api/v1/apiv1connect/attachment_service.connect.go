@@ -37,6 +37,24 @@ const (
 	// AttachmentServiceCreateAttachmentProcedure is the fully-qualified name of the AttachmentService's
 	// CreateAttachment RPC.
 	AttachmentServiceCreateAttachmentProcedure = "/memos.api.v1.AttachmentService/CreateAttachment"
+	// AttachmentServiceCreateAttachmentUploadProcedure is the fully-qualified name of the
+	// AttachmentService's CreateAttachmentUpload RPC.
+	AttachmentServiceCreateAttachmentUploadProcedure = "/memos.api.v1.AttachmentService/CreateAttachmentUpload"
+	// AttachmentServiceCompleteAttachmentUploadProcedure is the fully-qualified name of the
+	// AttachmentService's CompleteAttachmentUpload RPC.
+	AttachmentServiceCompleteAttachmentUploadProcedure = "/memos.api.v1.AttachmentService/CompleteAttachmentUpload"
+	// AttachmentServiceCreateChunkedUploadProcedure is the fully-qualified name of the
+	// AttachmentService's CreateChunkedUpload RPC.
+	AttachmentServiceCreateChunkedUploadProcedure = "/memos.api.v1.AttachmentService/CreateChunkedUpload"
+	// AttachmentServiceUploadAttachmentChunkProcedure is the fully-qualified name of the
+	// AttachmentService's UploadAttachmentChunk RPC.
+	AttachmentServiceUploadAttachmentChunkProcedure = "/memos.api.v1.AttachmentService/UploadAttachmentChunk"
+	// AttachmentServiceGetChunkedUploadProcedure is the fully-qualified name of the AttachmentService's
+	// GetChunkedUpload RPC.
+	AttachmentServiceGetChunkedUploadProcedure = "/memos.api.v1.AttachmentService/GetChunkedUpload"
+	// AttachmentServiceCompleteChunkedUploadProcedure is the fully-qualified name of the
+	// AttachmentService's CompleteChunkedUpload RPC.
+	AttachmentServiceCompleteChunkedUploadProcedure = "/memos.api.v1.AttachmentService/CompleteChunkedUpload"
 	// AttachmentServiceListAttachmentsProcedure is the fully-qualified name of the AttachmentService's
 	// ListAttachments RPC.
 	AttachmentServiceListAttachmentsProcedure = "/memos.api.v1.AttachmentService/ListAttachments"
@@ -49,12 +67,44 @@ const (
 	// AttachmentServiceDeleteAttachmentProcedure is the fully-qualified name of the AttachmentService's
 	// DeleteAttachment RPC.
 	AttachmentServiceDeleteAttachmentProcedure = "/memos.api.v1.AttachmentService/DeleteAttachment"
+	// AttachmentServicePurgeOrphanedAttachmentsProcedure is the fully-qualified name of the
+	// AttachmentService's PurgeOrphanedAttachments RPC.
+	AttachmentServicePurgeOrphanedAttachmentsProcedure = "/memos.api.v1.AttachmentService/PurgeOrphanedAttachments"
+	// AttachmentServiceDeduplicateAttachmentsProcedure is the fully-qualified name of the
+	// AttachmentService's DeduplicateAttachments RPC.
+	AttachmentServiceDeduplicateAttachmentsProcedure = "/memos.api.v1.AttachmentService/DeduplicateAttachments"
+	// AttachmentServiceRefreshExternalAttachmentProcedure is the fully-qualified name of the
+	// AttachmentService's RefreshExternalAttachment RPC.
+	AttachmentServiceRefreshExternalAttachmentProcedure = "/memos.api.v1.AttachmentService/RefreshExternalAttachment"
+	// AttachmentServiceRecalculateStorageUsageProcedure is the fully-qualified name of the
+	// AttachmentService's RecalculateStorageUsage RPC.
+	AttachmentServiceRecalculateStorageUsageProcedure = "/memos.api.v1.AttachmentService/RecalculateStorageUsage"
 )
 
 // AttachmentServiceClient is a client for the memos.api.v1.AttachmentService service.
 type AttachmentServiceClient interface {
 	// CreateAttachment creates a new attachment.
 	CreateAttachment(context.Context, *connect.Request[v1.CreateAttachmentRequest]) (*connect.Response[v1.Attachment], error)
+	// CreateAttachmentUpload starts a direct upload of an attachment to the S3 storage, returning
+	// a pending attachment and a presigned upload URL. When the content must go through the
+	// server, as with the local storage, no upload URL is returned and the content is uploaded
+	// with CreateAttachment instead.
+	CreateAttachmentUpload(context.Context, *connect.Request[v1.CreateAttachmentUploadRequest]) (*connect.Response[v1.CreateAttachmentUploadResponse], error)
+	// CompleteAttachmentUpload completes a direct upload once the content is uploaded to the
+	// upload URL, checking the uploaded object.
+	CompleteAttachmentUpload(context.Context, *connect.Request[v1.CompleteAttachmentUploadRequest]) (*connect.Response[v1.Attachment], error)
+	// CreateChunkedUpload starts a resumable upload of a large attachment in chunks, returning the
+	// chunk size. The pending attachment is completed by CompleteChunkedUpload once all the chunks
+	// are uploaded.
+	CreateChunkedUpload(context.Context, *connect.Request[v1.CreateChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error)
+	// UploadAttachmentChunk uploads a chunk of a chunked upload, replacing a previous upload of
+	// the chunk.
+	UploadAttachmentChunk(context.Context, *connect.Request[v1.UploadAttachmentChunkRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetChunkedUpload returns the status of a chunked upload, with the chunks left to upload.
+	GetChunkedUpload(context.Context, *connect.Request[v1.GetChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error)
+	// CompleteChunkedUpload assembles the chunks of a chunked upload into the attachment, checking
+	// the content hash.
+	CompleteChunkedUpload(context.Context, *connect.Request[v1.CompleteChunkedUploadRequest]) (*connect.Response[v1.Attachment], error)
 	// ListAttachments lists all attachments.
 	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
 	// GetAttachment returns a attachment by name.
@@ -63,6 +113,21 @@ type AttachmentServiceClient interface {
 	UpdateAttachment(context.Context, *connect.Request[v1.UpdateAttachmentRequest]) (*connect.Response[v1.Attachment], error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// PurgeOrphanedAttachments deletes the attachments without a memo older than the grace
+	// period of the storage setting, except library uploads.
+	// Only admins can purge orphaned attachments.
+	PurgeOrphanedAttachments(context.Context, *connect.Request[v1.PurgeOrphanedAttachmentsRequest]) (*connect.Response[v1.PurgeOrphanedAttachmentsResponse], error)
+	// DeduplicateAttachments hashes the local files of the attachments uploaded before the content
+	// hashes, and makes the attachments of a user with the same content share a single file.
+	// Only admins can deduplicate attachments.
+	DeduplicateAttachments(context.Context, *connect.Request[v1.DeduplicateAttachmentsRequest]) (*connect.Response[v1.DeduplicateAttachmentsResponse], error)
+	// RefreshExternalAttachment checks again that the external link of an attachment responds,
+	// marking the attachment as broken when its file is gone.
+	RefreshExternalAttachment(context.Context, *connect.Request[v1.RefreshExternalAttachmentRequest]) (*connect.Response[v1.Attachment], error)
+	// RecalculateStorageUsage recomputes the storage usages of all users from their attachments,
+	// fixing a drift of the usages counted on upload and delete.
+	// Only admins can recalculate the storage usages.
+	RecalculateStorageUsage(context.Context, *connect.Request[v1.RecalculateStorageUsageRequest]) (*connect.Response[v1.RecalculateStorageUsageResponse], error)
 }
 
 // NewAttachmentServiceClient constructs a client for the memos.api.v1.AttachmentService service. By
@@ -82,6 +147,42 @@ func NewAttachmentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(attachmentServiceMethods.ByName("CreateAttachment")),
 			connect.WithClientOptions(opts...),
 		),
+		createAttachmentUpload: connect.NewClient[v1.CreateAttachmentUploadRequest, v1.CreateAttachmentUploadResponse](
+			httpClient,
+			baseURL+AttachmentServiceCreateAttachmentUploadProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("CreateAttachmentUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		completeAttachmentUpload: connect.NewClient[v1.CompleteAttachmentUploadRequest, v1.Attachment](
+			httpClient,
+			baseURL+AttachmentServiceCompleteAttachmentUploadProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("CompleteAttachmentUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		createChunkedUpload: connect.NewClient[v1.CreateChunkedUploadRequest, v1.ChunkedUpload](
+			httpClient,
+			baseURL+AttachmentServiceCreateChunkedUploadProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("CreateChunkedUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAttachmentChunk: connect.NewClient[v1.UploadAttachmentChunkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AttachmentServiceUploadAttachmentChunkProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("UploadAttachmentChunk")),
+			connect.WithClientOptions(opts...),
+		),
+		getChunkedUpload: connect.NewClient[v1.GetChunkedUploadRequest, v1.ChunkedUpload](
+			httpClient,
+			baseURL+AttachmentServiceGetChunkedUploadProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("GetChunkedUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		completeChunkedUpload: connect.NewClient[v1.CompleteChunkedUploadRequest, v1.Attachment](
+			httpClient,
+			baseURL+AttachmentServiceCompleteChunkedUploadProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("CompleteChunkedUpload")),
+			connect.WithClientOptions(opts...),
+		),
 		listAttachments: connect.NewClient[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse](
 			httpClient,
 			baseURL+AttachmentServiceListAttachmentsProcedure,
@@ -106,16 +207,50 @@ func NewAttachmentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(attachmentServiceMethods.ByName("DeleteAttachment")),
 			connect.WithClientOptions(opts...),
 		),
+		purgeOrphanedAttachments: connect.NewClient[v1.PurgeOrphanedAttachmentsRequest, v1.PurgeOrphanedAttachmentsResponse](
+			httpClient,
+			baseURL+AttachmentServicePurgeOrphanedAttachmentsProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("PurgeOrphanedAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		deduplicateAttachments: connect.NewClient[v1.DeduplicateAttachmentsRequest, v1.DeduplicateAttachmentsResponse](
+			httpClient,
+			baseURL+AttachmentServiceDeduplicateAttachmentsProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("DeduplicateAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshExternalAttachment: connect.NewClient[v1.RefreshExternalAttachmentRequest, v1.Attachment](
+			httpClient,
+			baseURL+AttachmentServiceRefreshExternalAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("RefreshExternalAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		recalculateStorageUsage: connect.NewClient[v1.RecalculateStorageUsageRequest, v1.RecalculateStorageUsageResponse](
+			httpClient,
+			baseURL+AttachmentServiceRecalculateStorageUsageProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("RecalculateStorageUsage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // attachmentServiceClient implements AttachmentServiceClient.
 type attachmentServiceClient struct {
-	createAttachment *connect.Client[v1.CreateAttachmentRequest, v1.Attachment]
-	listAttachments  *connect.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
-	getAttachment    *connect.Client[v1.GetAttachmentRequest, v1.Attachment]
-	updateAttachment *connect.Client[v1.UpdateAttachmentRequest, v1.Attachment]
-	deleteAttachment *connect.Client[v1.DeleteAttachmentRequest, emptypb.Empty]
+	createAttachment          *connect.Client[v1.CreateAttachmentRequest, v1.Attachment]
+	createAttachmentUpload    *connect.Client[v1.CreateAttachmentUploadRequest, v1.CreateAttachmentUploadResponse]
+	completeAttachmentUpload  *connect.Client[v1.CompleteAttachmentUploadRequest, v1.Attachment]
+	createChunkedUpload       *connect.Client[v1.CreateChunkedUploadRequest, v1.ChunkedUpload]
+	uploadAttachmentChunk     *connect.Client[v1.UploadAttachmentChunkRequest, emptypb.Empty]
+	getChunkedUpload          *connect.Client[v1.GetChunkedUploadRequest, v1.ChunkedUpload]
+	completeChunkedUpload     *connect.Client[v1.CompleteChunkedUploadRequest, v1.Attachment]
+	listAttachments           *connect.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
+	getAttachment             *connect.Client[v1.GetAttachmentRequest, v1.Attachment]
+	updateAttachment          *connect.Client[v1.UpdateAttachmentRequest, v1.Attachment]
+	deleteAttachment          *connect.Client[v1.DeleteAttachmentRequest, emptypb.Empty]
+	purgeOrphanedAttachments  *connect.Client[v1.PurgeOrphanedAttachmentsRequest, v1.PurgeOrphanedAttachmentsResponse]
+	deduplicateAttachments    *connect.Client[v1.DeduplicateAttachmentsRequest, v1.DeduplicateAttachmentsResponse]
+	refreshExternalAttachment *connect.Client[v1.RefreshExternalAttachmentRequest, v1.Attachment]
+	recalculateStorageUsage   *connect.Client[v1.RecalculateStorageUsageRequest, v1.RecalculateStorageUsageResponse]
 }
 
 // CreateAttachment calls memos.api.v1.AttachmentService.CreateAttachment.
@@ -123,6 +258,36 @@ func (c *attachmentServiceClient) CreateAttachment(ctx context.Context, req *con
 	return c.createAttachment.CallUnary(ctx, req)
 }
 
+// CreateAttachmentUpload calls memos.api.v1.AttachmentService.CreateAttachmentUpload.
+func (c *attachmentServiceClient) CreateAttachmentUpload(ctx context.Context, req *connect.Request[v1.CreateAttachmentUploadRequest]) (*connect.Response[v1.CreateAttachmentUploadResponse], error) {
+	return c.createAttachmentUpload.CallUnary(ctx, req)
+}
+
+// CompleteAttachmentUpload calls memos.api.v1.AttachmentService.CompleteAttachmentUpload.
+func (c *attachmentServiceClient) CompleteAttachmentUpload(ctx context.Context, req *connect.Request[v1.CompleteAttachmentUploadRequest]) (*connect.Response[v1.Attachment], error) {
+	return c.completeAttachmentUpload.CallUnary(ctx, req)
+}
+
+// CreateChunkedUpload calls memos.api.v1.AttachmentService.CreateChunkedUpload.
+func (c *attachmentServiceClient) CreateChunkedUpload(ctx context.Context, req *connect.Request[v1.CreateChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error) {
+	return c.createChunkedUpload.CallUnary(ctx, req)
+}
+
+// UploadAttachmentChunk calls memos.api.v1.AttachmentService.UploadAttachmentChunk.
+func (c *attachmentServiceClient) UploadAttachmentChunk(ctx context.Context, req *connect.Request[v1.UploadAttachmentChunkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.uploadAttachmentChunk.CallUnary(ctx, req)
+}
+
+// GetChunkedUpload calls memos.api.v1.AttachmentService.GetChunkedUpload.
+func (c *attachmentServiceClient) GetChunkedUpload(ctx context.Context, req *connect.Request[v1.GetChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error) {
+	return c.getChunkedUpload.CallUnary(ctx, req)
+}
+
+// CompleteChunkedUpload calls memos.api.v1.AttachmentService.CompleteChunkedUpload.
+func (c *attachmentServiceClient) CompleteChunkedUpload(ctx context.Context, req *connect.Request[v1.CompleteChunkedUploadRequest]) (*connect.Response[v1.Attachment], error) {
+	return c.completeChunkedUpload.CallUnary(ctx, req)
+}
+
 // ListAttachments calls memos.api.v1.AttachmentService.ListAttachments.
 func (c *attachmentServiceClient) ListAttachments(ctx context.Context, req *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
 	return c.listAttachments.CallUnary(ctx, req)
@@ -143,10 +308,50 @@ func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, req *con
 	return c.deleteAttachment.CallUnary(ctx, req)
 }
 
+// PurgeOrphanedAttachments calls memos.api.v1.AttachmentService.PurgeOrphanedAttachments.
+func (c *attachmentServiceClient) PurgeOrphanedAttachments(ctx context.Context, req *connect.Request[v1.PurgeOrphanedAttachmentsRequest]) (*connect.Response[v1.PurgeOrphanedAttachmentsResponse], error) {
+	return c.purgeOrphanedAttachments.CallUnary(ctx, req)
+}
+
+// DeduplicateAttachments calls memos.api.v1.AttachmentService.DeduplicateAttachments.
+func (c *attachmentServiceClient) DeduplicateAttachments(ctx context.Context, req *connect.Request[v1.DeduplicateAttachmentsRequest]) (*connect.Response[v1.DeduplicateAttachmentsResponse], error) {
+	return c.deduplicateAttachments.CallUnary(ctx, req)
+}
+
+// RefreshExternalAttachment calls memos.api.v1.AttachmentService.RefreshExternalAttachment.
+func (c *attachmentServiceClient) RefreshExternalAttachment(ctx context.Context, req *connect.Request[v1.RefreshExternalAttachmentRequest]) (*connect.Response[v1.Attachment], error) {
+	return c.refreshExternalAttachment.CallUnary(ctx, req)
+}
+
+// RecalculateStorageUsage calls memos.api.v1.AttachmentService.RecalculateStorageUsage.
+func (c *attachmentServiceClient) RecalculateStorageUsage(ctx context.Context, req *connect.Request[v1.RecalculateStorageUsageRequest]) (*connect.Response[v1.RecalculateStorageUsageResponse], error) {
+	return c.recalculateStorageUsage.CallUnary(ctx, req)
+}
+
 // AttachmentServiceHandler is an implementation of the memos.api.v1.AttachmentService service.
 type AttachmentServiceHandler interface {
 	// CreateAttachment creates a new attachment.
 	CreateAttachment(context.Context, *connect.Request[v1.CreateAttachmentRequest]) (*connect.Response[v1.Attachment], error)
+	// CreateAttachmentUpload starts a direct upload of an attachment to the S3 storage, returning
+	// a pending attachment and a presigned upload URL. When the content must go through the
+	// server, as with the local storage, no upload URL is returned and the content is uploaded
+	// with CreateAttachment instead.
+	CreateAttachmentUpload(context.Context, *connect.Request[v1.CreateAttachmentUploadRequest]) (*connect.Response[v1.CreateAttachmentUploadResponse], error)
+	// CompleteAttachmentUpload completes a direct upload once the content is uploaded to the
+	// upload URL, checking the uploaded object.
+	CompleteAttachmentUpload(context.Context, *connect.Request[v1.CompleteAttachmentUploadRequest]) (*connect.Response[v1.Attachment], error)
+	// CreateChunkedUpload starts a resumable upload of a large attachment in chunks, returning the
+	// chunk size. The pending attachment is completed by CompleteChunkedUpload once all the chunks
+	// are uploaded.
+	CreateChunkedUpload(context.Context, *connect.Request[v1.CreateChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error)
+	// UploadAttachmentChunk uploads a chunk of a chunked upload, replacing a previous upload of
+	// the chunk.
+	UploadAttachmentChunk(context.Context, *connect.Request[v1.UploadAttachmentChunkRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetChunkedUpload returns the status of a chunked upload, with the chunks left to upload.
+	GetChunkedUpload(context.Context, *connect.Request[v1.GetChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error)
+	// CompleteChunkedUpload assembles the chunks of a chunked upload into the attachment, checking
+	// the content hash.
+	CompleteChunkedUpload(context.Context, *connect.Request[v1.CompleteChunkedUploadRequest]) (*connect.Response[v1.Attachment], error)
 	// ListAttachments lists all attachments.
 	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
 	// GetAttachment returns a attachment by name.
@@ -155,6 +360,21 @@ type AttachmentServiceHandler interface {
 	UpdateAttachment(context.Context, *connect.Request[v1.UpdateAttachmentRequest]) (*connect.Response[v1.Attachment], error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// PurgeOrphanedAttachments deletes the attachments without a memo older than the grace
+	// period of the storage setting, except library uploads.
+	// Only admins can purge orphaned attachments.
+	PurgeOrphanedAttachments(context.Context, *connect.Request[v1.PurgeOrphanedAttachmentsRequest]) (*connect.Response[v1.PurgeOrphanedAttachmentsResponse], error)
+	// DeduplicateAttachments hashes the local files of the attachments uploaded before the content
+	// hashes, and makes the attachments of a user with the same content share a single file.
+	// Only admins can deduplicate attachments.
+	DeduplicateAttachments(context.Context, *connect.Request[v1.DeduplicateAttachmentsRequest]) (*connect.Response[v1.DeduplicateAttachmentsResponse], error)
+	// RefreshExternalAttachment checks again that the external link of an attachment responds,
+	// marking the attachment as broken when its file is gone.
+	RefreshExternalAttachment(context.Context, *connect.Request[v1.RefreshExternalAttachmentRequest]) (*connect.Response[v1.Attachment], error)
+	// RecalculateStorageUsage recomputes the storage usages of all users from their attachments,
+	// fixing a drift of the usages counted on upload and delete.
+	// Only admins can recalculate the storage usages.
+	RecalculateStorageUsage(context.Context, *connect.Request[v1.RecalculateStorageUsageRequest]) (*connect.Response[v1.RecalculateStorageUsageResponse], error)
 }
 
 // NewAttachmentServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -170,6 +390,42 @@ func NewAttachmentServiceHandler(svc AttachmentServiceHandler, opts ...connect.H
 		connect.WithSchema(attachmentServiceMethods.ByName("CreateAttachment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	attachmentServiceCreateAttachmentUploadHandler := connect.NewUnaryHandler(
+		AttachmentServiceCreateAttachmentUploadProcedure,
+		svc.CreateAttachmentUpload,
+		connect.WithSchema(attachmentServiceMethods.ByName("CreateAttachmentUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceCompleteAttachmentUploadHandler := connect.NewUnaryHandler(
+		AttachmentServiceCompleteAttachmentUploadProcedure,
+		svc.CompleteAttachmentUpload,
+		connect.WithSchema(attachmentServiceMethods.ByName("CompleteAttachmentUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceCreateChunkedUploadHandler := connect.NewUnaryHandler(
+		AttachmentServiceCreateChunkedUploadProcedure,
+		svc.CreateChunkedUpload,
+		connect.WithSchema(attachmentServiceMethods.ByName("CreateChunkedUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceUploadAttachmentChunkHandler := connect.NewUnaryHandler(
+		AttachmentServiceUploadAttachmentChunkProcedure,
+		svc.UploadAttachmentChunk,
+		connect.WithSchema(attachmentServiceMethods.ByName("UploadAttachmentChunk")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceGetChunkedUploadHandler := connect.NewUnaryHandler(
+		AttachmentServiceGetChunkedUploadProcedure,
+		svc.GetChunkedUpload,
+		connect.WithSchema(attachmentServiceMethods.ByName("GetChunkedUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceCompleteChunkedUploadHandler := connect.NewUnaryHandler(
+		AttachmentServiceCompleteChunkedUploadProcedure,
+		svc.CompleteChunkedUpload,
+		connect.WithSchema(attachmentServiceMethods.ByName("CompleteChunkedUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
 	attachmentServiceListAttachmentsHandler := connect.NewUnaryHandler(
 		AttachmentServiceListAttachmentsProcedure,
 		svc.ListAttachments,
@@ -194,10 +450,46 @@ func NewAttachmentServiceHandler(svc AttachmentServiceHandler, opts ...connect.H
 		connect.WithSchema(attachmentServiceMethods.ByName("DeleteAttachment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	attachmentServicePurgeOrphanedAttachmentsHandler := connect.NewUnaryHandler(
+		AttachmentServicePurgeOrphanedAttachmentsProcedure,
+		svc.PurgeOrphanedAttachments,
+		connect.WithSchema(attachmentServiceMethods.ByName("PurgeOrphanedAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceDeduplicateAttachmentsHandler := connect.NewUnaryHandler(
+		AttachmentServiceDeduplicateAttachmentsProcedure,
+		svc.DeduplicateAttachments,
+		connect.WithSchema(attachmentServiceMethods.ByName("DeduplicateAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceRefreshExternalAttachmentHandler := connect.NewUnaryHandler(
+		AttachmentServiceRefreshExternalAttachmentProcedure,
+		svc.RefreshExternalAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("RefreshExternalAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceRecalculateStorageUsageHandler := connect.NewUnaryHandler(
+		AttachmentServiceRecalculateStorageUsageProcedure,
+		svc.RecalculateStorageUsage,
+		connect.WithSchema(attachmentServiceMethods.ByName("RecalculateStorageUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.AttachmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AttachmentServiceCreateAttachmentProcedure:
 			attachmentServiceCreateAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServiceCreateAttachmentUploadProcedure:
+			attachmentServiceCreateAttachmentUploadHandler.ServeHTTP(w, r)
+		case AttachmentServiceCompleteAttachmentUploadProcedure:
+			attachmentServiceCompleteAttachmentUploadHandler.ServeHTTP(w, r)
+		case AttachmentServiceCreateChunkedUploadProcedure:
+			attachmentServiceCreateChunkedUploadHandler.ServeHTTP(w, r)
+		case AttachmentServiceUploadAttachmentChunkProcedure:
+			attachmentServiceUploadAttachmentChunkHandler.ServeHTTP(w, r)
+		case AttachmentServiceGetChunkedUploadProcedure:
+			attachmentServiceGetChunkedUploadHandler.ServeHTTP(w, r)
+		case AttachmentServiceCompleteChunkedUploadProcedure:
+			attachmentServiceCompleteChunkedUploadHandler.ServeHTTP(w, r)
 		case AttachmentServiceListAttachmentsProcedure:
 			attachmentServiceListAttachmentsHandler.ServeHTTP(w, r)
 		case AttachmentServiceGetAttachmentProcedure:
@@ -206,6 +498,14 @@ func NewAttachmentServiceHandler(svc AttachmentServiceHandler, opts ...connect.H
 			attachmentServiceUpdateAttachmentHandler.ServeHTTP(w, r)
 		case AttachmentServiceDeleteAttachmentProcedure:
 			attachmentServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServicePurgeOrphanedAttachmentsProcedure:
+			attachmentServicePurgeOrphanedAttachmentsHandler.ServeHTTP(w, r)
+		case AttachmentServiceDeduplicateAttachmentsProcedure:
+			attachmentServiceDeduplicateAttachmentsHandler.ServeHTTP(w, r)
+		case AttachmentServiceRefreshExternalAttachmentProcedure:
+			attachmentServiceRefreshExternalAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServiceRecalculateStorageUsageProcedure:
+			attachmentServiceRecalculateStorageUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -219,6 +519,30 @@ func (UnimplementedAttachmentServiceHandler) CreateAttachment(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.CreateAttachment is not implemented"))
 }
 
+func (UnimplementedAttachmentServiceHandler) CreateAttachmentUpload(context.Context, *connect.Request[v1.CreateAttachmentUploadRequest]) (*connect.Response[v1.CreateAttachmentUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.CreateAttachmentUpload is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) CompleteAttachmentUpload(context.Context, *connect.Request[v1.CompleteAttachmentUploadRequest]) (*connect.Response[v1.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.CompleteAttachmentUpload is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) CreateChunkedUpload(context.Context, *connect.Request[v1.CreateChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.CreateChunkedUpload is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) UploadAttachmentChunk(context.Context, *connect.Request[v1.UploadAttachmentChunkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.UploadAttachmentChunk is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) GetChunkedUpload(context.Context, *connect.Request[v1.GetChunkedUploadRequest]) (*connect.Response[v1.ChunkedUpload], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.GetChunkedUpload is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) CompleteChunkedUpload(context.Context, *connect.Request[v1.CompleteChunkedUploadRequest]) (*connect.Response[v1.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.CompleteChunkedUpload is not implemented"))
+}
+
 func (UnimplementedAttachmentServiceHandler) ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.ListAttachments is not implemented"))
 }
@@ -234,3 +558,19 @@ func (UnimplementedAttachmentServiceHandler) UpdateAttachment(context.Context, *
 func (UnimplementedAttachmentServiceHandler) DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.DeleteAttachment is not implemented"))
 }
+
+func (UnimplementedAttachmentServiceHandler) PurgeOrphanedAttachments(context.Context, *connect.Request[v1.PurgeOrphanedAttachmentsRequest]) (*connect.Response[v1.PurgeOrphanedAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.PurgeOrphanedAttachments is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) DeduplicateAttachments(context.Context, *connect.Request[v1.DeduplicateAttachmentsRequest]) (*connect.Response[v1.DeduplicateAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.DeduplicateAttachments is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) RefreshExternalAttachment(context.Context, *connect.Request[v1.RefreshExternalAttachmentRequest]) (*connect.Response[v1.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.RefreshExternalAttachment is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) RecalculateStorageUsage(context.Context, *connect.Request[v1.RecalculateStorageUsageRequest]) (*connect.Response[v1.RecalculateStorageUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AttachmentService.RecalculateStorageUsage is not implemented"))
+}
@@ -40,9 +40,21 @@ const (
 	// AuthServiceCreateSessionProcedure is the fully-qualified name of the AuthService's CreateSession
 	// RPC.
 	AuthServiceCreateSessionProcedure = "/memos.api.v1.AuthService/CreateSession"
+	// AuthServiceCreatePasskeyChallengeProcedure is the fully-qualified name of the AuthService's
+	// CreatePasskeyChallenge RPC.
+	AuthServiceCreatePasskeyChallengeProcedure = "/memos.api.v1.AuthService/CreatePasskeyChallenge"
+	// AuthServiceRefreshSessionProcedure is the fully-qualified name of the AuthService's
+	// RefreshSession RPC.
+	AuthServiceRefreshSessionProcedure = "/memos.api.v1.AuthService/RefreshSession"
 	// AuthServiceDeleteSessionProcedure is the fully-qualified name of the AuthService's DeleteSession
 	// RPC.
 	AuthServiceDeleteSessionProcedure = "/memos.api.v1.AuthService/DeleteSession"
+	// AuthServiceRequestPasswordResetProcedure is the fully-qualified name of the AuthService's
+	// RequestPasswordReset RPC.
+	AuthServiceRequestPasswordResetProcedure = "/memos.api.v1.AuthService/RequestPasswordReset"
+	// AuthServiceResetPasswordProcedure is the fully-qualified name of the AuthService's ResetPassword
+	// RPC.
+	AuthServiceResetPasswordProcedure = "/memos.api.v1.AuthService/ResetPassword"
 )
 
 // AuthServiceClient is a client for the memos.api.v1.AuthService service.
@@ -53,9 +65,23 @@ type AuthServiceClient interface {
 	// CreateSession authenticates a user and creates a new session.
 	// Returns the authenticated user information upon successful authentication.
 	CreateSession(context.Context, *connect.Request[v1.CreateSessionRequest]) (*connect.Response[v1.CreateSessionResponse], error)
+	// CreatePasskeyChallenge starts a passkey sign-in.
+	// Returns the options for navigator.credentials.get(); the assertion is sent back in CreateSession.
+	CreatePasskeyChallenge(context.Context, *connect.Request[v1.CreatePasskeyChallengeRequest]) (*connect.Response[v1.PasskeyChallenge], error)
+	// RefreshSession renews the session access token of a browser session.
+	// The refresh token is read from the user_refresh_token cookie and rotated: both cookies are
+	// replaced with a new pair, and reusing the old refresh token revokes the session.
+	RefreshSession(context.Context, *connect.Request[v1.RefreshSessionRequest]) (*connect.Response[v1.RefreshSessionResponse], error)
 	// DeleteSession terminates the current user session.
 	// This is an idempotent operation that invalidates the user's authentication.
 	DeleteSession(context.Context, *connect.Request[v1.DeleteSessionRequest]) (*connect.Response[emptypb.Empty], error)
+	// RequestPasswordReset emails a single-use password reset link to a user.
+	// The response is the same whether or not the account exists.
+	// Fails with FAILED_PRECONDITION if email is not configured.
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[emptypb.Empty], error)
+	// ResetPassword sets a new password with a token from RequestPasswordReset.
+	// All sessions of the user are signed out.
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAuthServiceClient constructs a client for the memos.api.v1.AuthService service. By default, it
@@ -81,20 +107,48 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("CreateSession")),
 			connect.WithClientOptions(opts...),
 		),
+		createPasskeyChallenge: connect.NewClient[v1.CreatePasskeyChallengeRequest, v1.PasskeyChallenge](
+			httpClient,
+			baseURL+AuthServiceCreatePasskeyChallengeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CreatePasskeyChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshSession: connect.NewClient[v1.RefreshSessionRequest, v1.RefreshSessionResponse](
+			httpClient,
+			baseURL+AuthServiceRefreshSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RefreshSession")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteSession: connect.NewClient[v1.DeleteSessionRequest, emptypb.Empty](
 			httpClient,
 			baseURL+AuthServiceDeleteSessionProcedure,
 			connect.WithSchema(authServiceMethods.ByName("DeleteSession")),
 			connect.WithClientOptions(opts...),
 		),
+		requestPasswordReset: connect.NewClient[v1.RequestPasswordResetRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AuthServiceRequestPasswordResetProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestPasswordReset")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AuthServiceResetPasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	getCurrentSession *connect.Client[v1.GetCurrentSessionRequest, v1.GetCurrentSessionResponse]
-	createSession     *connect.Client[v1.CreateSessionRequest, v1.CreateSessionResponse]
-	deleteSession     *connect.Client[v1.DeleteSessionRequest, emptypb.Empty]
+	getCurrentSession      *connect.Client[v1.GetCurrentSessionRequest, v1.GetCurrentSessionResponse]
+	createSession          *connect.Client[v1.CreateSessionRequest, v1.CreateSessionResponse]
+	createPasskeyChallenge *connect.Client[v1.CreatePasskeyChallengeRequest, v1.PasskeyChallenge]
+	refreshSession         *connect.Client[v1.RefreshSessionRequest, v1.RefreshSessionResponse]
+	deleteSession          *connect.Client[v1.DeleteSessionRequest, emptypb.Empty]
+	requestPasswordReset   *connect.Client[v1.RequestPasswordResetRequest, emptypb.Empty]
+	resetPassword          *connect.Client[v1.ResetPasswordRequest, emptypb.Empty]
 }
 
 // GetCurrentSession calls memos.api.v1.AuthService.GetCurrentSession.
@@ -107,11 +161,31 @@ func (c *authServiceClient) CreateSession(ctx context.Context, req *connect.Requ
 	return c.createSession.CallUnary(ctx, req)
 }
 
+// CreatePasskeyChallenge calls memos.api.v1.AuthService.CreatePasskeyChallenge.
+func (c *authServiceClient) CreatePasskeyChallenge(ctx context.Context, req *connect.Request[v1.CreatePasskeyChallengeRequest]) (*connect.Response[v1.PasskeyChallenge], error) {
+	return c.createPasskeyChallenge.CallUnary(ctx, req)
+}
+
+// RefreshSession calls memos.api.v1.AuthService.RefreshSession.
+func (c *authServiceClient) RefreshSession(ctx context.Context, req *connect.Request[v1.RefreshSessionRequest]) (*connect.Response[v1.RefreshSessionResponse], error) {
+	return c.refreshSession.CallUnary(ctx, req)
+}
+
 // DeleteSession calls memos.api.v1.AuthService.DeleteSession.
 func (c *authServiceClient) DeleteSession(ctx context.Context, req *connect.Request[v1.DeleteSessionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.deleteSession.CallUnary(ctx, req)
 }
 
+// RequestPasswordReset calls memos.api.v1.AuthService.RequestPasswordReset.
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, req *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.requestPasswordReset.CallUnary(ctx, req)
+}
+
+// ResetPassword calls memos.api.v1.AuthService.ResetPassword.
+func (c *authServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the memos.api.v1.AuthService service.
 type AuthServiceHandler interface {
 	// GetCurrentSession returns the current active session information.
@@ -120,9 +194,23 @@ type AuthServiceHandler interface {
 	// CreateSession authenticates a user and creates a new session.
 	// Returns the authenticated user information upon successful authentication.
 	CreateSession(context.Context, *connect.Request[v1.CreateSessionRequest]) (*connect.Response[v1.CreateSessionResponse], error)
+	// CreatePasskeyChallenge starts a passkey sign-in.
+	// Returns the options for navigator.credentials.get(); the assertion is sent back in CreateSession.
+	CreatePasskeyChallenge(context.Context, *connect.Request[v1.CreatePasskeyChallengeRequest]) (*connect.Response[v1.PasskeyChallenge], error)
+	// RefreshSession renews the session access token of a browser session.
+	// The refresh token is read from the user_refresh_token cookie and rotated: both cookies are
+	// replaced with a new pair, and reusing the old refresh token revokes the session.
+	RefreshSession(context.Context, *connect.Request[v1.RefreshSessionRequest]) (*connect.Response[v1.RefreshSessionResponse], error)
 	// DeleteSession terminates the current user session.
 	// This is an idempotent operation that invalidates the user's authentication.
 	DeleteSession(context.Context, *connect.Request[v1.DeleteSessionRequest]) (*connect.Response[emptypb.Empty], error)
+	// RequestPasswordReset emails a single-use password reset link to a user.
+	// The response is the same whether or not the account exists.
+	// Fails with FAILED_PRECONDITION if email is not configured.
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[emptypb.Empty], error)
+	// ResetPassword sets a new password with a token from RequestPasswordReset.
+	// All sessions of the user are signed out.
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -144,20 +232,52 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("CreateSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceCreatePasskeyChallengeHandler := connect.NewUnaryHandler(
+		AuthServiceCreatePasskeyChallengeProcedure,
+		svc.CreatePasskeyChallenge,
+		connect.WithSchema(authServiceMethods.ByName("CreatePasskeyChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRefreshSessionHandler := connect.NewUnaryHandler(
+		AuthServiceRefreshSessionProcedure,
+		svc.RefreshSession,
+		connect.WithSchema(authServiceMethods.ByName("RefreshSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	authServiceDeleteSessionHandler := connect.NewUnaryHandler(
 		AuthServiceDeleteSessionProcedure,
 		svc.DeleteSession,
 		connect.WithSchema(authServiceMethods.ByName("DeleteSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceRequestPasswordResetHandler := connect.NewUnaryHandler(
+		AuthServiceRequestPasswordResetProcedure,
+		svc.RequestPasswordReset,
+		connect.WithSchema(authServiceMethods.ByName("RequestPasswordReset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceResetPasswordHandler := connect.NewUnaryHandler(
+		AuthServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceGetCurrentSessionProcedure:
 			authServiceGetCurrentSessionHandler.ServeHTTP(w, r)
 		case AuthServiceCreateSessionProcedure:
 			authServiceCreateSessionHandler.ServeHTTP(w, r)
+		case AuthServiceCreatePasskeyChallengeProcedure:
+			authServiceCreatePasskeyChallengeHandler.ServeHTTP(w, r)
+		case AuthServiceRefreshSessionProcedure:
+			authServiceRefreshSessionHandler.ServeHTTP(w, r)
 		case AuthServiceDeleteSessionProcedure:
 			authServiceDeleteSessionHandler.ServeHTTP(w, r)
+		case AuthServiceRequestPasswordResetProcedure:
+			authServiceRequestPasswordResetHandler.ServeHTTP(w, r)
+		case AuthServiceResetPasswordProcedure:
+			authServiceResetPasswordHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -175,6 +295,22 @@ func (UnimplementedAuthServiceHandler) CreateSession(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.CreateSession is not implemented"))
 }
 
+func (UnimplementedAuthServiceHandler) CreatePasskeyChallenge(context.Context, *connect.Request[v1.CreatePasskeyChallengeRequest]) (*connect.Response[v1.PasskeyChallenge], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.CreatePasskeyChallenge is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RefreshSession(context.Context, *connect.Request[v1.RefreshSessionRequest]) (*connect.Response[v1.RefreshSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.RefreshSession is not implemented"))
+}
+
 func (UnimplementedAuthServiceHandler) DeleteSession(context.Context, *connect.Request[v1.DeleteSessionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.DeleteSession is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.RequestPasswordReset is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.AuthService.ResetPassword is not implemented"))
+}
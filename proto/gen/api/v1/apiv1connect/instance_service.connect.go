@@ -9,6 +9,7 @@ import (
 	context "context"
 	errors "errors"
 	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -42,6 +43,39 @@ const (
 	// InstanceServiceUpdateInstanceSettingProcedure is the fully-qualified name of the
 	// InstanceService's UpdateInstanceSetting RPC.
 	InstanceServiceUpdateInstanceSettingProcedure = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	// InstanceServiceDismissInstanceAnnouncementProcedure is the fully-qualified name of the
+	// InstanceService's DismissInstanceAnnouncement RPC.
+	InstanceServiceDismissInstanceAnnouncementProcedure = "/memos.api.v1.InstanceService/DismissInstanceAnnouncement"
+	// InstanceServiceListAuditLogsProcedure is the fully-qualified name of the InstanceService's
+	// ListAuditLogs RPC.
+	InstanceServiceListAuditLogsProcedure = "/memos.api.v1.InstanceService/ListAuditLogs"
+	// InstanceServiceGetInstanceDiagnosticsProcedure is the fully-qualified name of the
+	// InstanceService's GetInstanceDiagnostics RPC.
+	InstanceServiceGetInstanceDiagnosticsProcedure = "/memos.api.v1.InstanceService/GetInstanceDiagnostics"
+	// InstanceServiceGetInstanceStatsProcedure is the fully-qualified name of the InstanceService's
+	// GetInstanceStats RPC.
+	InstanceServiceGetInstanceStatsProcedure = "/memos.api.v1.InstanceService/GetInstanceStats"
+	// InstanceServiceGetInstanceMigrationStatusProcedure is the fully-qualified name of the
+	// InstanceService's GetInstanceMigrationStatus RPC.
+	InstanceServiceGetInstanceMigrationStatusProcedure = "/memos.api.v1.InstanceService/GetInstanceMigrationStatus"
+	// InstanceServiceGetInstanceBackupStatusProcedure is the fully-qualified name of the
+	// InstanceService's GetInstanceBackupStatus RPC.
+	InstanceServiceGetInstanceBackupStatusProcedure = "/memos.api.v1.InstanceService/GetInstanceBackupStatus"
+	// InstanceServiceRunInstanceBackupProcedure is the fully-qualified name of the InstanceService's
+	// RunInstanceBackup RPC.
+	InstanceServiceRunInstanceBackupProcedure = "/memos.api.v1.InstanceService/RunInstanceBackup"
+	// InstanceServiceSendTestEmailProcedure is the fully-qualified name of the InstanceService's
+	// SendTestEmail RPC.
+	InstanceServiceSendTestEmailProcedure = "/memos.api.v1.InstanceService/SendTestEmail"
+	// InstanceServiceListSigningKeysProcedure is the fully-qualified name of the InstanceService's
+	// ListSigningKeys RPC.
+	InstanceServiceListSigningKeysProcedure = "/memos.api.v1.InstanceService/ListSigningKeys"
+	// InstanceServiceRotateSigningKeyProcedure is the fully-qualified name of the InstanceService's
+	// RotateSigningKey RPC.
+	InstanceServiceRotateSigningKeyProcedure = "/memos.api.v1.InstanceService/RotateSigningKey"
+	// InstanceServiceExpireSigningKeyProcedure is the fully-qualified name of the InstanceService's
+	// ExpireSigningKey RPC.
+	InstanceServiceExpireSigningKeyProcedure = "/memos.api.v1.InstanceService/ExpireSigningKey"
 )
 
 // InstanceServiceClient is a client for the memos.api.v1.InstanceService service.
@@ -52,6 +86,42 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Dismisses the announcement of the instance for the current user, until its content changes.
+	DismissInstanceAnnouncement(context.Context, *connect.Request[v1.DismissInstanceAnnouncementRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the audit log of authentication and admin events, newest first.
+	// Only the host can list audit logs.
+	ListAuditLogs(context.Context, *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error)
+	// Gets the diagnostics of the instance, such as the database connection pool statistics.
+	// Only admins can get the diagnostics.
+	GetInstanceDiagnostics(context.Context, *connect.Request[v1.GetInstanceDiagnosticsRequest]) (*connect.Response[v1.InstanceDiagnostics], error)
+	// Gets the statistics of the instance: its users, memos, attachments and webhooks, along with
+	// the version and uptime of the server. The statistics are cached for a few minutes.
+	// Only the host can get the statistics.
+	GetInstanceStats(context.Context, *connect.Request[v1.GetInstanceStatsRequest]) (*connect.Response[v1.InstanceStats], error)
+	// Gets the schema migration status of the database: the schema version, the history of the
+	// applied migrations and the pending migrations.
+	// Only admins can get the migration status.
+	GetInstanceMigrationStatus(context.Context, *connect.Request[v1.GetInstanceMigrationStatusRequest]) (*connect.Response[v1.InstanceMigrationStatus], error)
+	// Gets the status of the scheduled backups: the last run, the next run and whether a backup is
+	// running. Only the host can get the backup status.
+	GetInstanceBackupStatus(context.Context, *connect.Request[v1.GetInstanceBackupStatusRequest]) (*connect.Response[v1.InstanceBackupStatus], error)
+	// Starts a backup now, with the backup setting of the instance. The backup runs in the
+	// background, and its result is reported by GetInstanceBackupStatus.
+	// Only the host can run backups.
+	RunInstanceBackup(context.Context, *connect.Request[v1.RunInstanceBackupRequest]) (*connect.Response[v1.InstanceBackupStatus], error)
+	// Sends a test email with the email setting of the instance, to check the SMTP settings.
+	// Only the host can send test emails.
+	SendTestEmail(context.Context, *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the keys that sign and verify JWTs, newest first.
+	// Only the host can list signing keys.
+	ListSigningKeys(context.Context, *connect.Request[v1.ListSigningKeysRequest]) (*connect.Response[v1.ListSigningKeysResponse], error)
+	// Rotates the signing key. New tokens are signed with a new key, while tokens signed with
+	// the previous key stay valid for the grace period.
+	// Only the host can rotate the signing key.
+	RotateSigningKey(context.Context, *connect.Request[v1.RotateSigningKeyRequest]) (*connect.Response[v1.SigningKey], error)
+	// Expires a retired signing key, so tokens signed with it are rejected immediately.
+	// Only the host can expire signing keys.
+	ExpireSigningKey(context.Context, *connect.Request[v1.ExpireSigningKeyRequest]) (*connect.Response[v1.SigningKey], error)
 }
 
 // NewInstanceServiceClient constructs a client for the memos.api.v1.InstanceService service. By
@@ -83,14 +153,91 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 			connect.WithClientOptions(opts...),
 		),
+		dismissInstanceAnnouncement: connect.NewClient[v1.DismissInstanceAnnouncementRequest, emptypb.Empty](
+			httpClient,
+			baseURL+InstanceServiceDismissInstanceAnnouncementProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("DismissInstanceAnnouncement")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditLogs: connect.NewClient[v1.ListAuditLogsRequest, v1.ListAuditLogsResponse](
+			httpClient,
+			baseURL+InstanceServiceListAuditLogsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListAuditLogs")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstanceDiagnostics: connect.NewClient[v1.GetInstanceDiagnosticsRequest, v1.InstanceDiagnostics](
+			httpClient,
+			baseURL+InstanceServiceGetInstanceDiagnosticsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetInstanceDiagnostics")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstanceStats: connect.NewClient[v1.GetInstanceStatsRequest, v1.InstanceStats](
+			httpClient,
+			baseURL+InstanceServiceGetInstanceStatsProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetInstanceStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstanceMigrationStatus: connect.NewClient[v1.GetInstanceMigrationStatusRequest, v1.InstanceMigrationStatus](
+			httpClient,
+			baseURL+InstanceServiceGetInstanceMigrationStatusProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetInstanceMigrationStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		getInstanceBackupStatus: connect.NewClient[v1.GetInstanceBackupStatusRequest, v1.InstanceBackupStatus](
+			httpClient,
+			baseURL+InstanceServiceGetInstanceBackupStatusProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("GetInstanceBackupStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		runInstanceBackup: connect.NewClient[v1.RunInstanceBackupRequest, v1.InstanceBackupStatus](
+			httpClient,
+			baseURL+InstanceServiceRunInstanceBackupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("RunInstanceBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		sendTestEmail: connect.NewClient[v1.SendTestEmailRequest, emptypb.Empty](
+			httpClient,
+			baseURL+InstanceServiceSendTestEmailProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("SendTestEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		listSigningKeys: connect.NewClient[v1.ListSigningKeysRequest, v1.ListSigningKeysResponse](
+			httpClient,
+			baseURL+InstanceServiceListSigningKeysProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ListSigningKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateSigningKey: connect.NewClient[v1.RotateSigningKeyRequest, v1.SigningKey](
+			httpClient,
+			baseURL+InstanceServiceRotateSigningKeyProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("RotateSigningKey")),
+			connect.WithClientOptions(opts...),
+		),
+		expireSigningKey: connect.NewClient[v1.ExpireSigningKeyRequest, v1.SigningKey](
+			httpClient,
+			baseURL+InstanceServiceExpireSigningKeyProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("ExpireSigningKey")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // instanceServiceClient implements InstanceServiceClient.
 type instanceServiceClient struct {
-	getInstanceProfile    *connect.Client[v1.GetInstanceProfileRequest, v1.InstanceProfile]
-	getInstanceSetting    *connect.Client[v1.GetInstanceSettingRequest, v1.InstanceSetting]
-	updateInstanceSetting *connect.Client[v1.UpdateInstanceSettingRequest, v1.InstanceSetting]
+	getInstanceProfile          *connect.Client[v1.GetInstanceProfileRequest, v1.InstanceProfile]
+	getInstanceSetting          *connect.Client[v1.GetInstanceSettingRequest, v1.InstanceSetting]
+	updateInstanceSetting       *connect.Client[v1.UpdateInstanceSettingRequest, v1.InstanceSetting]
+	dismissInstanceAnnouncement *connect.Client[v1.DismissInstanceAnnouncementRequest, emptypb.Empty]
+	listAuditLogs               *connect.Client[v1.ListAuditLogsRequest, v1.ListAuditLogsResponse]
+	getInstanceDiagnostics      *connect.Client[v1.GetInstanceDiagnosticsRequest, v1.InstanceDiagnostics]
+	getInstanceStats            *connect.Client[v1.GetInstanceStatsRequest, v1.InstanceStats]
+	getInstanceMigrationStatus  *connect.Client[v1.GetInstanceMigrationStatusRequest, v1.InstanceMigrationStatus]
+	getInstanceBackupStatus     *connect.Client[v1.GetInstanceBackupStatusRequest, v1.InstanceBackupStatus]
+	runInstanceBackup           *connect.Client[v1.RunInstanceBackupRequest, v1.InstanceBackupStatus]
+	sendTestEmail               *connect.Client[v1.SendTestEmailRequest, emptypb.Empty]
+	listSigningKeys             *connect.Client[v1.ListSigningKeysRequest, v1.ListSigningKeysResponse]
+	rotateSigningKey            *connect.Client[v1.RotateSigningKeyRequest, v1.SigningKey]
+	expireSigningKey            *connect.Client[v1.ExpireSigningKeyRequest, v1.SigningKey]
 }
 
 // GetInstanceProfile calls memos.api.v1.InstanceService.GetInstanceProfile.
@@ -108,6 +255,61 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, req *
 	return c.updateInstanceSetting.CallUnary(ctx, req)
 }
 
+// DismissInstanceAnnouncement calls memos.api.v1.InstanceService.DismissInstanceAnnouncement.
+func (c *instanceServiceClient) DismissInstanceAnnouncement(ctx context.Context, req *connect.Request[v1.DismissInstanceAnnouncementRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.dismissInstanceAnnouncement.CallUnary(ctx, req)
+}
+
+// ListAuditLogs calls memos.api.v1.InstanceService.ListAuditLogs.
+func (c *instanceServiceClient) ListAuditLogs(ctx context.Context, req *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error) {
+	return c.listAuditLogs.CallUnary(ctx, req)
+}
+
+// GetInstanceDiagnostics calls memos.api.v1.InstanceService.GetInstanceDiagnostics.
+func (c *instanceServiceClient) GetInstanceDiagnostics(ctx context.Context, req *connect.Request[v1.GetInstanceDiagnosticsRequest]) (*connect.Response[v1.InstanceDiagnostics], error) {
+	return c.getInstanceDiagnostics.CallUnary(ctx, req)
+}
+
+// GetInstanceStats calls memos.api.v1.InstanceService.GetInstanceStats.
+func (c *instanceServiceClient) GetInstanceStats(ctx context.Context, req *connect.Request[v1.GetInstanceStatsRequest]) (*connect.Response[v1.InstanceStats], error) {
+	return c.getInstanceStats.CallUnary(ctx, req)
+}
+
+// GetInstanceMigrationStatus calls memos.api.v1.InstanceService.GetInstanceMigrationStatus.
+func (c *instanceServiceClient) GetInstanceMigrationStatus(ctx context.Context, req *connect.Request[v1.GetInstanceMigrationStatusRequest]) (*connect.Response[v1.InstanceMigrationStatus], error) {
+	return c.getInstanceMigrationStatus.CallUnary(ctx, req)
+}
+
+// GetInstanceBackupStatus calls memos.api.v1.InstanceService.GetInstanceBackupStatus.
+func (c *instanceServiceClient) GetInstanceBackupStatus(ctx context.Context, req *connect.Request[v1.GetInstanceBackupStatusRequest]) (*connect.Response[v1.InstanceBackupStatus], error) {
+	return c.getInstanceBackupStatus.CallUnary(ctx, req)
+}
+
+// RunInstanceBackup calls memos.api.v1.InstanceService.RunInstanceBackup.
+func (c *instanceServiceClient) RunInstanceBackup(ctx context.Context, req *connect.Request[v1.RunInstanceBackupRequest]) (*connect.Response[v1.InstanceBackupStatus], error) {
+	return c.runInstanceBackup.CallUnary(ctx, req)
+}
+
+// SendTestEmail calls memos.api.v1.InstanceService.SendTestEmail.
+func (c *instanceServiceClient) SendTestEmail(ctx context.Context, req *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.sendTestEmail.CallUnary(ctx, req)
+}
+
+// ListSigningKeys calls memos.api.v1.InstanceService.ListSigningKeys.
+func (c *instanceServiceClient) ListSigningKeys(ctx context.Context, req *connect.Request[v1.ListSigningKeysRequest]) (*connect.Response[v1.ListSigningKeysResponse], error) {
+	return c.listSigningKeys.CallUnary(ctx, req)
+}
+
+// RotateSigningKey calls memos.api.v1.InstanceService.RotateSigningKey.
+func (c *instanceServiceClient) RotateSigningKey(ctx context.Context, req *connect.Request[v1.RotateSigningKeyRequest]) (*connect.Response[v1.SigningKey], error) {
+	return c.rotateSigningKey.CallUnary(ctx, req)
+}
+
+// ExpireSigningKey calls memos.api.v1.InstanceService.ExpireSigningKey.
+func (c *instanceServiceClient) ExpireSigningKey(ctx context.Context, req *connect.Request[v1.ExpireSigningKeyRequest]) (*connect.Response[v1.SigningKey], error) {
+	return c.expireSigningKey.CallUnary(ctx, req)
+}
+
 // InstanceServiceHandler is an implementation of the memos.api.v1.InstanceService service.
 type InstanceServiceHandler interface {
 	// Gets the instance profile.
@@ -116,6 +318,42 @@ type InstanceServiceHandler interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Dismisses the announcement of the instance for the current user, until its content changes.
+	DismissInstanceAnnouncement(context.Context, *connect.Request[v1.DismissInstanceAnnouncementRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the audit log of authentication and admin events, newest first.
+	// Only the host can list audit logs.
+	ListAuditLogs(context.Context, *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error)
+	// Gets the diagnostics of the instance, such as the database connection pool statistics.
+	// Only admins can get the diagnostics.
+	GetInstanceDiagnostics(context.Context, *connect.Request[v1.GetInstanceDiagnosticsRequest]) (*connect.Response[v1.InstanceDiagnostics], error)
+	// Gets the statistics of the instance: its users, memos, attachments and webhooks, along with
+	// the version and uptime of the server. The statistics are cached for a few minutes.
+	// Only the host can get the statistics.
+	GetInstanceStats(context.Context, *connect.Request[v1.GetInstanceStatsRequest]) (*connect.Response[v1.InstanceStats], error)
+	// Gets the schema migration status of the database: the schema version, the history of the
+	// applied migrations and the pending migrations.
+	// Only admins can get the migration status.
+	GetInstanceMigrationStatus(context.Context, *connect.Request[v1.GetInstanceMigrationStatusRequest]) (*connect.Response[v1.InstanceMigrationStatus], error)
+	// Gets the status of the scheduled backups: the last run, the next run and whether a backup is
+	// running. Only the host can get the backup status.
+	GetInstanceBackupStatus(context.Context, *connect.Request[v1.GetInstanceBackupStatusRequest]) (*connect.Response[v1.InstanceBackupStatus], error)
+	// Starts a backup now, with the backup setting of the instance. The backup runs in the
+	// background, and its result is reported by GetInstanceBackupStatus.
+	// Only the host can run backups.
+	RunInstanceBackup(context.Context, *connect.Request[v1.RunInstanceBackupRequest]) (*connect.Response[v1.InstanceBackupStatus], error)
+	// Sends a test email with the email setting of the instance, to check the SMTP settings.
+	// Only the host can send test emails.
+	SendTestEmail(context.Context, *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the keys that sign and verify JWTs, newest first.
+	// Only the host can list signing keys.
+	ListSigningKeys(context.Context, *connect.Request[v1.ListSigningKeysRequest]) (*connect.Response[v1.ListSigningKeysResponse], error)
+	// Rotates the signing key. New tokens are signed with a new key, while tokens signed with
+	// the previous key stay valid for the grace period.
+	// Only the host can rotate the signing key.
+	RotateSigningKey(context.Context, *connect.Request[v1.RotateSigningKeyRequest]) (*connect.Response[v1.SigningKey], error)
+	// Expires a retired signing key, so tokens signed with it are rejected immediately.
+	// Only the host can expire signing keys.
+	ExpireSigningKey(context.Context, *connect.Request[v1.ExpireSigningKeyRequest]) (*connect.Response[v1.SigningKey], error)
 }
 
 // NewInstanceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +381,72 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceDismissInstanceAnnouncementHandler := connect.NewUnaryHandler(
+		InstanceServiceDismissInstanceAnnouncementProcedure,
+		svc.DismissInstanceAnnouncement,
+		connect.WithSchema(instanceServiceMethods.ByName("DismissInstanceAnnouncement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListAuditLogsHandler := connect.NewUnaryHandler(
+		InstanceServiceListAuditLogsProcedure,
+		svc.ListAuditLogs,
+		connect.WithSchema(instanceServiceMethods.ByName("ListAuditLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetInstanceDiagnosticsHandler := connect.NewUnaryHandler(
+		InstanceServiceGetInstanceDiagnosticsProcedure,
+		svc.GetInstanceDiagnostics,
+		connect.WithSchema(instanceServiceMethods.ByName("GetInstanceDiagnostics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetInstanceStatsHandler := connect.NewUnaryHandler(
+		InstanceServiceGetInstanceStatsProcedure,
+		svc.GetInstanceStats,
+		connect.WithSchema(instanceServiceMethods.ByName("GetInstanceStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetInstanceMigrationStatusHandler := connect.NewUnaryHandler(
+		InstanceServiceGetInstanceMigrationStatusProcedure,
+		svc.GetInstanceMigrationStatus,
+		connect.WithSchema(instanceServiceMethods.ByName("GetInstanceMigrationStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceGetInstanceBackupStatusHandler := connect.NewUnaryHandler(
+		InstanceServiceGetInstanceBackupStatusProcedure,
+		svc.GetInstanceBackupStatus,
+		connect.WithSchema(instanceServiceMethods.ByName("GetInstanceBackupStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceRunInstanceBackupHandler := connect.NewUnaryHandler(
+		InstanceServiceRunInstanceBackupProcedure,
+		svc.RunInstanceBackup,
+		connect.WithSchema(instanceServiceMethods.ByName("RunInstanceBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceSendTestEmailHandler := connect.NewUnaryHandler(
+		InstanceServiceSendTestEmailProcedure,
+		svc.SendTestEmail,
+		connect.WithSchema(instanceServiceMethods.ByName("SendTestEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceListSigningKeysHandler := connect.NewUnaryHandler(
+		InstanceServiceListSigningKeysProcedure,
+		svc.ListSigningKeys,
+		connect.WithSchema(instanceServiceMethods.ByName("ListSigningKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceRotateSigningKeyHandler := connect.NewUnaryHandler(
+		InstanceServiceRotateSigningKeyProcedure,
+		svc.RotateSigningKey,
+		connect.WithSchema(instanceServiceMethods.ByName("RotateSigningKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceExpireSigningKeyHandler := connect.NewUnaryHandler(
+		InstanceServiceExpireSigningKeyProcedure,
+		svc.ExpireSigningKey,
+		connect.WithSchema(instanceServiceMethods.ByName("ExpireSigningKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.InstanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InstanceServiceGetInstanceProfileProcedure:
@@ -151,6 +455,28 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 			instanceServiceGetInstanceSettingHandler.ServeHTTP(w, r)
 		case InstanceServiceUpdateInstanceSettingProcedure:
 			instanceServiceUpdateInstanceSettingHandler.ServeHTTP(w, r)
+		case InstanceServiceDismissInstanceAnnouncementProcedure:
+			instanceServiceDismissInstanceAnnouncementHandler.ServeHTTP(w, r)
+		case InstanceServiceListAuditLogsProcedure:
+			instanceServiceListAuditLogsHandler.ServeHTTP(w, r)
+		case InstanceServiceGetInstanceDiagnosticsProcedure:
+			instanceServiceGetInstanceDiagnosticsHandler.ServeHTTP(w, r)
+		case InstanceServiceGetInstanceStatsProcedure:
+			instanceServiceGetInstanceStatsHandler.ServeHTTP(w, r)
+		case InstanceServiceGetInstanceMigrationStatusProcedure:
+			instanceServiceGetInstanceMigrationStatusHandler.ServeHTTP(w, r)
+		case InstanceServiceGetInstanceBackupStatusProcedure:
+			instanceServiceGetInstanceBackupStatusHandler.ServeHTTP(w, r)
+		case InstanceServiceRunInstanceBackupProcedure:
+			instanceServiceRunInstanceBackupHandler.ServeHTTP(w, r)
+		case InstanceServiceSendTestEmailProcedure:
+			instanceServiceSendTestEmailHandler.ServeHTTP(w, r)
+		case InstanceServiceListSigningKeysProcedure:
+			instanceServiceListSigningKeysHandler.ServeHTTP(w, r)
+		case InstanceServiceRotateSigningKeyProcedure:
+			instanceServiceRotateSigningKeyHandler.ServeHTTP(w, r)
+		case InstanceServiceExpireSigningKeyProcedure:
+			instanceServiceExpireSigningKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +497,47 @@ func (UnimplementedInstanceServiceHandler) GetInstanceSetting(context.Context, *
 func (UnimplementedInstanceServiceHandler) UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.UpdateInstanceSetting is not implemented"))
 }
+
+func (UnimplementedInstanceServiceHandler) DismissInstanceAnnouncement(context.Context, *connect.Request[v1.DismissInstanceAnnouncementRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.DismissInstanceAnnouncement is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListAuditLogs(context.Context, *connect.Request[v1.ListAuditLogsRequest]) (*connect.Response[v1.ListAuditLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.ListAuditLogs is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetInstanceDiagnostics(context.Context, *connect.Request[v1.GetInstanceDiagnosticsRequest]) (*connect.Response[v1.InstanceDiagnostics], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.GetInstanceDiagnostics is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetInstanceStats(context.Context, *connect.Request[v1.GetInstanceStatsRequest]) (*connect.Response[v1.InstanceStats], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.GetInstanceStats is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetInstanceMigrationStatus(context.Context, *connect.Request[v1.GetInstanceMigrationStatusRequest]) (*connect.Response[v1.InstanceMigrationStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.GetInstanceMigrationStatus is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) GetInstanceBackupStatus(context.Context, *connect.Request[v1.GetInstanceBackupStatusRequest]) (*connect.Response[v1.InstanceBackupStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.GetInstanceBackupStatus is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) RunInstanceBackup(context.Context, *connect.Request[v1.RunInstanceBackupRequest]) (*connect.Response[v1.InstanceBackupStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.RunInstanceBackup is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) SendTestEmail(context.Context, *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.SendTestEmail is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ListSigningKeys(context.Context, *connect.Request[v1.ListSigningKeysRequest]) (*connect.Response[v1.ListSigningKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.ListSigningKeys is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) RotateSigningKey(context.Context, *connect.Request[v1.RotateSigningKeyRequest]) (*connect.Response[v1.SigningKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.RotateSigningKey is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) ExpireSigningKey(context.Context, *connect.Request[v1.ExpireSigningKeyRequest]) (*connect.Response[v1.SigningKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.ExpireSigningKey is not implemented"))
+}
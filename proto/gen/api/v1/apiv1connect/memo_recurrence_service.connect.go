@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/memo_recurrence_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MemoRecurrenceServiceName is the fully-qualified name of the MemoRecurrenceService service.
+	MemoRecurrenceServiceName = "memos.api.v1.MemoRecurrenceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MemoRecurrenceServiceListMemoRecurrencesProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's ListMemoRecurrences RPC.
+	MemoRecurrenceServiceListMemoRecurrencesProcedure = "/memos.api.v1.MemoRecurrenceService/ListMemoRecurrences"
+	// MemoRecurrenceServiceGetMemoRecurrenceProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's GetMemoRecurrence RPC.
+	MemoRecurrenceServiceGetMemoRecurrenceProcedure = "/memos.api.v1.MemoRecurrenceService/GetMemoRecurrence"
+	// MemoRecurrenceServiceCreateMemoRecurrenceProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's CreateMemoRecurrence RPC.
+	MemoRecurrenceServiceCreateMemoRecurrenceProcedure = "/memos.api.v1.MemoRecurrenceService/CreateMemoRecurrence"
+	// MemoRecurrenceServiceUpdateMemoRecurrenceProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's UpdateMemoRecurrence RPC.
+	MemoRecurrenceServiceUpdateMemoRecurrenceProcedure = "/memos.api.v1.MemoRecurrenceService/UpdateMemoRecurrence"
+	// MemoRecurrenceServiceDeleteMemoRecurrenceProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's DeleteMemoRecurrence RPC.
+	MemoRecurrenceServiceDeleteMemoRecurrenceProcedure = "/memos.api.v1.MemoRecurrenceService/DeleteMemoRecurrence"
+	// MemoRecurrenceServicePauseMemoRecurrenceProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's PauseMemoRecurrence RPC.
+	MemoRecurrenceServicePauseMemoRecurrenceProcedure = "/memos.api.v1.MemoRecurrenceService/PauseMemoRecurrence"
+	// MemoRecurrenceServiceResumeMemoRecurrenceProcedure is the fully-qualified name of the
+	// MemoRecurrenceService's ResumeMemoRecurrence RPC.
+	MemoRecurrenceServiceResumeMemoRecurrenceProcedure = "/memos.api.v1.MemoRecurrenceService/ResumeMemoRecurrence"
+)
+
+// MemoRecurrenceServiceClient is a client for the memos.api.v1.MemoRecurrenceService service.
+type MemoRecurrenceServiceClient interface {
+	// ListMemoRecurrences returns the memo recurrences of a user.
+	ListMemoRecurrences(context.Context, *connect.Request[v1.ListMemoRecurrencesRequest]) (*connect.Response[v1.ListMemoRecurrencesResponse], error)
+	// GetMemoRecurrence gets a memo recurrence by name.
+	GetMemoRecurrence(context.Context, *connect.Request[v1.GetMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// CreateMemoRecurrence creates a memo recurrence for a user. Its first occurrence is the first
+	// one after the creation.
+	CreateMemoRecurrence(context.Context, *connect.Request[v1.CreateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// UpdateMemoRecurrence updates a memo recurrence of a user. Changing its schedule moves its next
+	// occurrence to the first one of the new schedule after the update.
+	UpdateMemoRecurrence(context.Context, *connect.Request[v1.UpdateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// DeleteMemoRecurrence deletes a memo recurrence of a user. The memos it created are kept.
+	DeleteMemoRecurrence(context.Context, *connect.Request[v1.DeleteMemoRecurrenceRequest]) (*connect.Response[emptypb.Empty], error)
+	// PauseMemoRecurrence stops a memo recurrence from creating memos until it is resumed.
+	PauseMemoRecurrence(context.Context, *connect.Request[v1.PauseMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// ResumeMemoRecurrence resumes a paused memo recurrence from its first occurrence after now. The
+	// occurrences while it was paused are skipped.
+	ResumeMemoRecurrence(context.Context, *connect.Request[v1.ResumeMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+}
+
+// NewMemoRecurrenceServiceClient constructs a client for the memos.api.v1.MemoRecurrenceService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMemoRecurrenceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MemoRecurrenceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	memoRecurrenceServiceMethods := v1.File_api_v1_memo_recurrence_service_proto.Services().ByName("MemoRecurrenceService").Methods()
+	return &memoRecurrenceServiceClient{
+		listMemoRecurrences: connect.NewClient[v1.ListMemoRecurrencesRequest, v1.ListMemoRecurrencesResponse](
+			httpClient,
+			baseURL+MemoRecurrenceServiceListMemoRecurrencesProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("ListMemoRecurrences")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoRecurrence: connect.NewClient[v1.GetMemoRecurrenceRequest, v1.MemoRecurrence](
+			httpClient,
+			baseURL+MemoRecurrenceServiceGetMemoRecurrenceProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("GetMemoRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		createMemoRecurrence: connect.NewClient[v1.CreateMemoRecurrenceRequest, v1.MemoRecurrence](
+			httpClient,
+			baseURL+MemoRecurrenceServiceCreateMemoRecurrenceProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("CreateMemoRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemoRecurrence: connect.NewClient[v1.UpdateMemoRecurrenceRequest, v1.MemoRecurrence](
+			httpClient,
+			baseURL+MemoRecurrenceServiceUpdateMemoRecurrenceProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("UpdateMemoRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMemoRecurrence: connect.NewClient[v1.DeleteMemoRecurrenceRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoRecurrenceServiceDeleteMemoRecurrenceProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("DeleteMemoRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseMemoRecurrence: connect.NewClient[v1.PauseMemoRecurrenceRequest, v1.MemoRecurrence](
+			httpClient,
+			baseURL+MemoRecurrenceServicePauseMemoRecurrenceProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("PauseMemoRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeMemoRecurrence: connect.NewClient[v1.ResumeMemoRecurrenceRequest, v1.MemoRecurrence](
+			httpClient,
+			baseURL+MemoRecurrenceServiceResumeMemoRecurrenceProcedure,
+			connect.WithSchema(memoRecurrenceServiceMethods.ByName("ResumeMemoRecurrence")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// memoRecurrenceServiceClient implements MemoRecurrenceServiceClient.
+type memoRecurrenceServiceClient struct {
+	listMemoRecurrences  *connect.Client[v1.ListMemoRecurrencesRequest, v1.ListMemoRecurrencesResponse]
+	getMemoRecurrence    *connect.Client[v1.GetMemoRecurrenceRequest, v1.MemoRecurrence]
+	createMemoRecurrence *connect.Client[v1.CreateMemoRecurrenceRequest, v1.MemoRecurrence]
+	updateMemoRecurrence *connect.Client[v1.UpdateMemoRecurrenceRequest, v1.MemoRecurrence]
+	deleteMemoRecurrence *connect.Client[v1.DeleteMemoRecurrenceRequest, emptypb.Empty]
+	pauseMemoRecurrence  *connect.Client[v1.PauseMemoRecurrenceRequest, v1.MemoRecurrence]
+	resumeMemoRecurrence *connect.Client[v1.ResumeMemoRecurrenceRequest, v1.MemoRecurrence]
+}
+
+// ListMemoRecurrences calls memos.api.v1.MemoRecurrenceService.ListMemoRecurrences.
+func (c *memoRecurrenceServiceClient) ListMemoRecurrences(ctx context.Context, req *connect.Request[v1.ListMemoRecurrencesRequest]) (*connect.Response[v1.ListMemoRecurrencesResponse], error) {
+	return c.listMemoRecurrences.CallUnary(ctx, req)
+}
+
+// GetMemoRecurrence calls memos.api.v1.MemoRecurrenceService.GetMemoRecurrence.
+func (c *memoRecurrenceServiceClient) GetMemoRecurrence(ctx context.Context, req *connect.Request[v1.GetMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return c.getMemoRecurrence.CallUnary(ctx, req)
+}
+
+// CreateMemoRecurrence calls memos.api.v1.MemoRecurrenceService.CreateMemoRecurrence.
+func (c *memoRecurrenceServiceClient) CreateMemoRecurrence(ctx context.Context, req *connect.Request[v1.CreateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return c.createMemoRecurrence.CallUnary(ctx, req)
+}
+
+// UpdateMemoRecurrence calls memos.api.v1.MemoRecurrenceService.UpdateMemoRecurrence.
+func (c *memoRecurrenceServiceClient) UpdateMemoRecurrence(ctx context.Context, req *connect.Request[v1.UpdateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return c.updateMemoRecurrence.CallUnary(ctx, req)
+}
+
+// DeleteMemoRecurrence calls memos.api.v1.MemoRecurrenceService.DeleteMemoRecurrence.
+func (c *memoRecurrenceServiceClient) DeleteMemoRecurrence(ctx context.Context, req *connect.Request[v1.DeleteMemoRecurrenceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteMemoRecurrence.CallUnary(ctx, req)
+}
+
+// PauseMemoRecurrence calls memos.api.v1.MemoRecurrenceService.PauseMemoRecurrence.
+func (c *memoRecurrenceServiceClient) PauseMemoRecurrence(ctx context.Context, req *connect.Request[v1.PauseMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return c.pauseMemoRecurrence.CallUnary(ctx, req)
+}
+
+// ResumeMemoRecurrence calls memos.api.v1.MemoRecurrenceService.ResumeMemoRecurrence.
+func (c *memoRecurrenceServiceClient) ResumeMemoRecurrence(ctx context.Context, req *connect.Request[v1.ResumeMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return c.resumeMemoRecurrence.CallUnary(ctx, req)
+}
+
+// MemoRecurrenceServiceHandler is an implementation of the memos.api.v1.MemoRecurrenceService
+// service.
+type MemoRecurrenceServiceHandler interface {
+	// ListMemoRecurrences returns the memo recurrences of a user.
+	ListMemoRecurrences(context.Context, *connect.Request[v1.ListMemoRecurrencesRequest]) (*connect.Response[v1.ListMemoRecurrencesResponse], error)
+	// GetMemoRecurrence gets a memo recurrence by name.
+	GetMemoRecurrence(context.Context, *connect.Request[v1.GetMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// CreateMemoRecurrence creates a memo recurrence for a user. Its first occurrence is the first
+	// one after the creation.
+	CreateMemoRecurrence(context.Context, *connect.Request[v1.CreateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// UpdateMemoRecurrence updates a memo recurrence of a user. Changing its schedule moves its next
+	// occurrence to the first one of the new schedule after the update.
+	UpdateMemoRecurrence(context.Context, *connect.Request[v1.UpdateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// DeleteMemoRecurrence deletes a memo recurrence of a user. The memos it created are kept.
+	DeleteMemoRecurrence(context.Context, *connect.Request[v1.DeleteMemoRecurrenceRequest]) (*connect.Response[emptypb.Empty], error)
+	// PauseMemoRecurrence stops a memo recurrence from creating memos until it is resumed.
+	PauseMemoRecurrence(context.Context, *connect.Request[v1.PauseMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+	// ResumeMemoRecurrence resumes a paused memo recurrence from its first occurrence after now. The
+	// occurrences while it was paused are skipped.
+	ResumeMemoRecurrence(context.Context, *connect.Request[v1.ResumeMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error)
+}
+
+// NewMemoRecurrenceServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMemoRecurrenceServiceHandler(svc MemoRecurrenceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	memoRecurrenceServiceMethods := v1.File_api_v1_memo_recurrence_service_proto.Services().ByName("MemoRecurrenceService").Methods()
+	memoRecurrenceServiceListMemoRecurrencesHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServiceListMemoRecurrencesProcedure,
+		svc.ListMemoRecurrences,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("ListMemoRecurrences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoRecurrenceServiceGetMemoRecurrenceHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServiceGetMemoRecurrenceProcedure,
+		svc.GetMemoRecurrence,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("GetMemoRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoRecurrenceServiceCreateMemoRecurrenceHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServiceCreateMemoRecurrenceProcedure,
+		svc.CreateMemoRecurrence,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("CreateMemoRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoRecurrenceServiceUpdateMemoRecurrenceHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServiceUpdateMemoRecurrenceProcedure,
+		svc.UpdateMemoRecurrence,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("UpdateMemoRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoRecurrenceServiceDeleteMemoRecurrenceHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServiceDeleteMemoRecurrenceProcedure,
+		svc.DeleteMemoRecurrence,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("DeleteMemoRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoRecurrenceServicePauseMemoRecurrenceHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServicePauseMemoRecurrenceProcedure,
+		svc.PauseMemoRecurrence,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("PauseMemoRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoRecurrenceServiceResumeMemoRecurrenceHandler := connect.NewUnaryHandler(
+		MemoRecurrenceServiceResumeMemoRecurrenceProcedure,
+		svc.ResumeMemoRecurrence,
+		connect.WithSchema(memoRecurrenceServiceMethods.ByName("ResumeMemoRecurrence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.MemoRecurrenceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MemoRecurrenceServiceListMemoRecurrencesProcedure:
+			memoRecurrenceServiceListMemoRecurrencesHandler.ServeHTTP(w, r)
+		case MemoRecurrenceServiceGetMemoRecurrenceProcedure:
+			memoRecurrenceServiceGetMemoRecurrenceHandler.ServeHTTP(w, r)
+		case MemoRecurrenceServiceCreateMemoRecurrenceProcedure:
+			memoRecurrenceServiceCreateMemoRecurrenceHandler.ServeHTTP(w, r)
+		case MemoRecurrenceServiceUpdateMemoRecurrenceProcedure:
+			memoRecurrenceServiceUpdateMemoRecurrenceHandler.ServeHTTP(w, r)
+		case MemoRecurrenceServiceDeleteMemoRecurrenceProcedure:
+			memoRecurrenceServiceDeleteMemoRecurrenceHandler.ServeHTTP(w, r)
+		case MemoRecurrenceServicePauseMemoRecurrenceProcedure:
+			memoRecurrenceServicePauseMemoRecurrenceHandler.ServeHTTP(w, r)
+		case MemoRecurrenceServiceResumeMemoRecurrenceProcedure:
+			memoRecurrenceServiceResumeMemoRecurrenceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMemoRecurrenceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMemoRecurrenceServiceHandler struct{}
+
+func (UnimplementedMemoRecurrenceServiceHandler) ListMemoRecurrences(context.Context, *connect.Request[v1.ListMemoRecurrencesRequest]) (*connect.Response[v1.ListMemoRecurrencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.ListMemoRecurrences is not implemented"))
+}
+
+func (UnimplementedMemoRecurrenceServiceHandler) GetMemoRecurrence(context.Context, *connect.Request[v1.GetMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.GetMemoRecurrence is not implemented"))
+}
+
+func (UnimplementedMemoRecurrenceServiceHandler) CreateMemoRecurrence(context.Context, *connect.Request[v1.CreateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.CreateMemoRecurrence is not implemented"))
+}
+
+func (UnimplementedMemoRecurrenceServiceHandler) UpdateMemoRecurrence(context.Context, *connect.Request[v1.UpdateMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.UpdateMemoRecurrence is not implemented"))
+}
+
+func (UnimplementedMemoRecurrenceServiceHandler) DeleteMemoRecurrence(context.Context, *connect.Request[v1.DeleteMemoRecurrenceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.DeleteMemoRecurrence is not implemented"))
+}
+
+func (UnimplementedMemoRecurrenceServiceHandler) PauseMemoRecurrence(context.Context, *connect.Request[v1.PauseMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.PauseMemoRecurrence is not implemented"))
+}
+
+func (UnimplementedMemoRecurrenceServiceHandler) ResumeMemoRecurrence(context.Context, *connect.Request[v1.ResumeMemoRecurrenceRequest]) (*connect.Response[v1.MemoRecurrence], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoRecurrenceService.ResumeMemoRecurrence is not implemented"))
+}
@@ -38,12 +38,88 @@ const (
 	MemoServiceCreateMemoProcedure = "/memos.api.v1.MemoService/CreateMemo"
 	// MemoServiceListMemosProcedure is the fully-qualified name of the MemoService's ListMemos RPC.
 	MemoServiceListMemosProcedure = "/memos.api.v1.MemoService/ListMemos"
+	// MemoServiceGetMemoHighlightsProcedure is the fully-qualified name of the MemoService's
+	// GetMemoHighlights RPC.
+	MemoServiceGetMemoHighlightsProcedure = "/memos.api.v1.MemoService/GetMemoHighlights"
+	// MemoServiceGetMemoCountsProcedure is the fully-qualified name of the MemoService's GetMemoCounts
+	// RPC.
+	MemoServiceGetMemoCountsProcedure = "/memos.api.v1.MemoService/GetMemoCounts"
+	// MemoServicePreviewAutoArchiveMemosProcedure is the fully-qualified name of the MemoService's
+	// PreviewAutoArchiveMemos RPC.
+	MemoServicePreviewAutoArchiveMemosProcedure = "/memos.api.v1.MemoService/PreviewAutoArchiveMemos"
 	// MemoServiceGetMemoProcedure is the fully-qualified name of the MemoService's GetMemo RPC.
 	MemoServiceGetMemoProcedure = "/memos.api.v1.MemoService/GetMemo"
 	// MemoServiceUpdateMemoProcedure is the fully-qualified name of the MemoService's UpdateMemo RPC.
 	MemoServiceUpdateMemoProcedure = "/memos.api.v1.MemoService/UpdateMemo"
 	// MemoServiceDeleteMemoProcedure is the fully-qualified name of the MemoService's DeleteMemo RPC.
 	MemoServiceDeleteMemoProcedure = "/memos.api.v1.MemoService/DeleteMemo"
+	// MemoServiceRestoreMemoProcedure is the fully-qualified name of the MemoService's RestoreMemo RPC.
+	MemoServiceRestoreMemoProcedure = "/memos.api.v1.MemoService/RestoreMemo"
+	// MemoServicePurgeMemoProcedure is the fully-qualified name of the MemoService's PurgeMemo RPC.
+	MemoServicePurgeMemoProcedure = "/memos.api.v1.MemoService/PurgeMemo"
+	// MemoServiceListMemoRevisionsProcedure is the fully-qualified name of the MemoService's
+	// ListMemoRevisions RPC.
+	MemoServiceListMemoRevisionsProcedure = "/memos.api.v1.MemoService/ListMemoRevisions"
+	// MemoServiceGetMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// GetMemoRevision RPC.
+	MemoServiceGetMemoRevisionProcedure = "/memos.api.v1.MemoService/GetMemoRevision"
+	// MemoServiceRestoreMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// RestoreMemoRevision RPC.
+	MemoServiceRestoreMemoRevisionProcedure = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	// MemoServiceCreateMemoShareLinkProcedure is the fully-qualified name of the MemoService's
+	// CreateMemoShareLink RPC.
+	MemoServiceCreateMemoShareLinkProcedure = "/memos.api.v1.MemoService/CreateMemoShareLink"
+	// MemoServiceListMemoShareLinksProcedure is the fully-qualified name of the MemoService's
+	// ListMemoShareLinks RPC.
+	MemoServiceListMemoShareLinksProcedure = "/memos.api.v1.MemoService/ListMemoShareLinks"
+	// MemoServiceUpdateMemoShareLinkProcedure is the fully-qualified name of the MemoService's
+	// UpdateMemoShareLink RPC.
+	MemoServiceUpdateMemoShareLinkProcedure = "/memos.api.v1.MemoService/UpdateMemoShareLink"
+	// MemoServiceDeleteMemoShareLinkProcedure is the fully-qualified name of the MemoService's
+	// DeleteMemoShareLink RPC.
+	MemoServiceDeleteMemoShareLinkProcedure = "/memos.api.v1.MemoService/DeleteMemoShareLink"
+	// MemoServiceGetSharedMemoProcedure is the fully-qualified name of the MemoService's GetSharedMemo
+	// RPC.
+	MemoServiceGetSharedMemoProcedure = "/memos.api.v1.MemoService/GetSharedMemo"
+	// MemoServiceUnlockSharedMemoProcedure is the fully-qualified name of the MemoService's
+	// UnlockSharedMemo RPC.
+	MemoServiceUnlockSharedMemoProcedure = "/memos.api.v1.MemoService/UnlockSharedMemo"
+	// MemoServiceDuplicateMemoProcedure is the fully-qualified name of the MemoService's DuplicateMemo
+	// RPC.
+	MemoServiceDuplicateMemoProcedure = "/memos.api.v1.MemoService/DuplicateMemo"
+	// MemoServiceMergeMemosProcedure is the fully-qualified name of the MemoService's MergeMemos RPC.
+	MemoServiceMergeMemosProcedure = "/memos.api.v1.MemoService/MergeMemos"
+	// MemoServiceCreateMemoFromWikiLinkProcedure is the fully-qualified name of the MemoService's
+	// CreateMemoFromWikiLink RPC.
+	MemoServiceCreateMemoFromWikiLinkProcedure = "/memos.api.v1.MemoService/CreateMemoFromWikiLink"
+	// MemoServiceUpdateMemoTaskProcedure is the fully-qualified name of the MemoService's
+	// UpdateMemoTask RPC.
+	MemoServiceUpdateMemoTaskProcedure = "/memos.api.v1.MemoService/UpdateMemoTask"
+	// MemoServiceMovePinnedMemoProcedure is the fully-qualified name of the MemoService's
+	// MovePinnedMemo RPC.
+	MemoServiceMovePinnedMemoProcedure = "/memos.api.v1.MemoService/MovePinnedMemo"
+	// MemoServiceSnoozeMemoReminderProcedure is the fully-qualified name of the MemoService's
+	// SnoozeMemoReminder RPC.
+	MemoServiceSnoozeMemoReminderProcedure = "/memos.api.v1.MemoService/SnoozeMemoReminder"
+	// MemoServiceCompleteMemoReminderProcedure is the fully-qualified name of the MemoService's
+	// CompleteMemoReminder RPC.
+	MemoServiceCompleteMemoReminderProcedure = "/memos.api.v1.MemoService/CompleteMemoReminder"
+	// MemoServiceCreateBookmarkMemoProcedure is the fully-qualified name of the MemoService's
+	// CreateBookmarkMemo RPC.
+	MemoServiceCreateBookmarkMemoProcedure = "/memos.api.v1.MemoService/CreateBookmarkMemo"
+	// MemoServiceListTagTreeProcedure is the fully-qualified name of the MemoService's ListTagTree RPC.
+	MemoServiceListTagTreeProcedure = "/memos.api.v1.MemoService/ListTagTree"
+	// MemoServiceRenameMemoTagProcedure is the fully-qualified name of the MemoService's RenameMemoTag
+	// RPC.
+	MemoServiceRenameMemoTagProcedure = "/memos.api.v1.MemoService/RenameMemoTag"
+	// MemoServiceBatchUpdateMemosProcedure is the fully-qualified name of the MemoService's
+	// BatchUpdateMemos RPC.
+	MemoServiceBatchUpdateMemosProcedure = "/memos.api.v1.MemoService/BatchUpdateMemos"
+	// MemoServiceImportMemosProcedure is the fully-qualified name of the MemoService's ImportMemos RPC.
+	MemoServiceImportMemosProcedure = "/memos.api.v1.MemoService/ImportMemos"
+	// MemoServiceGetMemoImportProcedure is the fully-qualified name of the MemoService's GetMemoImport
+	// RPC.
+	MemoServiceGetMemoImportProcedure = "/memos.api.v1.MemoService/GetMemoImport"
 	// MemoServiceSetMemoAttachmentsProcedure is the fully-qualified name of the MemoService's
 	// SetMemoAttachments RPC.
 	MemoServiceSetMemoAttachmentsProcedure = "/memos.api.v1.MemoService/SetMemoAttachments"
@@ -56,6 +132,9 @@ const (
 	// MemoServiceListMemoRelationsProcedure is the fully-qualified name of the MemoService's
 	// ListMemoRelations RPC.
 	MemoServiceListMemoRelationsProcedure = "/memos.api.v1.MemoService/ListMemoRelations"
+	// MemoServiceListMemoBacklinksProcedure is the fully-qualified name of the MemoService's
+	// ListMemoBacklinks RPC.
+	MemoServiceListMemoBacklinksProcedure = "/memos.api.v1.MemoService/ListMemoBacklinks"
 	// MemoServiceCreateMemoCommentProcedure is the fully-qualified name of the MemoService's
 	// CreateMemoComment RPC.
 	MemoServiceCreateMemoCommentProcedure = "/memos.api.v1.MemoService/CreateMemoComment"
@@ -79,12 +158,93 @@ type MemoServiceClient interface {
 	CreateMemo(context.Context, *connect.Request[v1.CreateMemoRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *connect.Request[v1.ListMemosRequest]) (*connect.Response[v1.ListMemosResponse], error)
+	// GetMemoHighlights gets memos of the current user to rediscover: the memos created on the same
+	// day in previous years, and a random sample of memos older than 90 days.
+	GetMemoHighlights(context.Context, *connect.Request[v1.GetMemoHighlightsRequest]) (*connect.Response[v1.GetMemoHighlightsResponse], error)
+	// GetMemoCounts gets the number of memos of the current user in each state.
+	GetMemoCounts(context.Context, *connect.Request[v1.GetMemoCountsRequest]) (*connect.Response[v1.GetMemoCountsResponse], error)
+	// PreviewAutoArchiveMemos lists the memos of the current user that the auto-archive policy
+	// would archive, with the saved policy or the one of the request.
+	PreviewAutoArchiveMemos(context.Context, *connect.Request[v1.PreviewAutoArchiveMemosRequest]) (*connect.Response[v1.PreviewAutoArchiveMemosResponse], error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *connect.Request[v1.UpdateMemoRequest]) (*connect.Response[v1.Memo], error)
-	// DeleteMemo deletes a memo.
+	// DeleteMemo moves a memo to the trash.
+	// Memos in the trash are purged after the trash retention of the instance.
 	DeleteMemo(context.Context, *connect.Request[v1.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(context.Context, *connect.Request[v1.RestoreMemoRequest]) (*connect.Response[v1.Memo], error)
+	// PurgeMemo permanently deletes a memo with its attachments, relations, reactions, revisions
+	// and comments.
+	PurgeMemo(context.Context, *connect.Request[v1.PurgeMemoRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemoRevisions lists the revisions of a memo, newest first, with their diffs.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a revision of a memo with its content.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// RestoreMemoRevision restores the content of a memo to a revision, which creates a new revision.
+	// The attachments of the revision that still exist are attached to the memo again.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
+	// CreateMemoShareLink creates a link that shares a memo read-only with anyone who has it,
+	// whatever the visibility of the memo, until it expires, runs out of views or is revoked.
+	CreateMemoShareLink(context.Context, *connect.Request[v1.CreateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error)
+	// ListMemoShareLinks lists the share links of a memo, newest first.
+	ListMemoShareLinks(context.Context, *connect.Request[v1.ListMemoShareLinksRequest]) (*connect.Response[v1.ListMemoShareLinksResponse], error)
+	// UpdateMemoShareLink updates a share link. Only the passphrase can be changed, which signs out
+	// everyone who entered the previous one.
+	UpdateMemoShareLink(context.Context, *connect.Request[v1.UpdateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error)
+	// DeleteMemoShareLink revokes a share link.
+	DeleteMemoShareLink(context.Context, *connect.Request[v1.DeleteMemoShareLinkRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetSharedMemo gets the memo of a share link, which counts a view of the link. The links that
+	// expired, ran out of views or were revoked are not found. The links with a passphrase are
+	// unauthenticated without an access token from UnlockSharedMemo.
+	GetSharedMemo(context.Context, *connect.Request[v1.GetSharedMemoRequest]) (*connect.Response[v1.Memo], error)
+	// UnlockSharedMemo checks the passphrase of a share link and returns a short-lived access token
+	// to the memo of the link. Failed attempts are throttled per link.
+	UnlockSharedMemo(context.Context, *connect.Request[v1.UnlockSharedMemoRequest]) (*connect.Response[v1.UnlockSharedMemoResponse], error)
+	// DuplicateMemo creates a private copy of a memo for the current user, with the same content
+	// and attachments. The copied attachments share the files of the memo's attachments.
+	// Duplicating a memo of another user adds a reference to the memo.
+	DuplicateMemo(context.Context, *connect.Request[v1.DuplicateMemoRequest]) (*connect.Response[v1.Memo], error)
+	// MergeMemos merges a source memo into a target memo of the current user. The source content is
+	// appended to the target, its attachments, reactions and relations move to the target, and the
+	// source is moved to the trash with a merge relation to the target.
+	MergeMemos(context.Context, *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error)
+	// CreateMemoFromWikiLink creates the memo a missing [[wiki link]] of a memo of the current user
+	// links to, a private memo titled with the target, and resolves the link to it.
+	CreateMemoFromWikiLink(context.Context, *connect.Request[v1.CreateMemoFromWikiLinkRequest]) (*connect.Response[v1.Memo], error)
+	// UpdateMemoTask checks or unchecks a task list item of a memo, without rewriting the rest of
+	// its content.
+	UpdateMemoTask(context.Context, *connect.Request[v1.UpdateMemoTaskRequest]) (*connect.Response[v1.UpdateMemoTaskResponse], error)
+	// MovePinnedMemo moves a pinned memo up, down or to a position among the pinned memos of its
+	// creator, which are listed in that order before the other memos when ordered by pinned.
+	MovePinnedMemo(context.Context, *connect.Request[v1.MovePinnedMemoRequest]) (*connect.Response[v1.Memo], error)
+	// SnoozeMemoReminder moves the reminder of a memo to a later time.
+	SnoozeMemoReminder(context.Context, *connect.Request[v1.SnoozeMemoReminderRequest]) (*connect.Response[v1.Memo], error)
+	// CompleteMemoReminder clears the reminder of a memo, including its next occurrences.
+	CompleteMemoReminder(context.Context, *connect.Request[v1.CompleteMemoReminderRequest]) (*connect.Response[v1.Memo], error)
+	// CreateBookmarkMemo creates a memo bookmarking a link, with the title, description and image
+	// of the link fetched like link previews. The memo is still created, with the link alone, if
+	// the metadata can't be fetched. Bookmarking a link again shortly after returns the memo that
+	// was created instead of creating another one.
+	CreateBookmarkMemo(context.Context, *connect.Request[v1.CreateBookmarkMemoRequest]) (*connect.Response[v1.CreateBookmarkMemoResponse], error)
+	// ListTagTree lists the tags of the memos of a user as a tree, e.g. "project" with the
+	// subtags "project/alpha" and "project/beta", with the number of memos of each tag.
+	ListTagTree(context.Context, *connect.Request[v1.ListTagTreeRequest]) (*connect.Response[v1.ListTagTreeResponse], error)
+	// RenameMemoTag renames a tag in all memos of the current user.
+	// Renaming to an existing tag merges the two tags.
+	RenameMemoTag(context.Context, *connect.Request[v1.RenameMemoTagRequest]) (*connect.Response[v1.RenameMemoTagResponse], error)
+	// BatchUpdateMemos applies one operation to up to 100 memos in a single transaction. Each memo
+	// is checked as with the single-memo endpoints, and the memos that fail the checks are reported
+	// without failing the others. Each changed memo dispatches its own webhook event, as if it was
+	// changed by the single-memo endpoint.
+	BatchUpdateMemos(context.Context, *connect.Request[v1.BatchUpdateMemosRequest]) (*connect.Response[v1.BatchUpdateMemosResponse], error)
+	// ImportMemos starts importing the memos of a zip archive of markdown files, e.g. an Obsidian
+	// vault, of a Google Keep Takeout archive, or of a Day One export, as memos of the current
+	// user. The import runs in the background, and its progress is returned by GetMemoImport.
+	ImportMemos(context.Context, *connect.Request[v1.ImportMemosRequest]) (*connect.Response[v1.MemoImport], error)
+	// GetMemoImport gets the progress of an import of the current user.
+	GetMemoImport(context.Context, *connect.Request[v1.GetMemoImportRequest]) (*connect.Response[v1.MemoImport], error)
 	// SetMemoAttachments sets attachments for a memo.
 	SetMemoAttachments(context.Context, *connect.Request[v1.SetMemoAttachmentsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoAttachments lists attachments for a memo.
@@ -93,6 +253,8 @@ type MemoServiceClient interface {
 	SetMemoRelations(context.Context, *connect.Request[v1.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *connect.Request[v1.ListMemoRelationsRequest]) (*connect.Response[v1.ListMemoRelationsResponse], error)
+	// ListMemoBacklinks lists the memos referencing a memo.
+	ListMemoBacklinks(context.Context, *connect.Request[v1.ListMemoBacklinksRequest]) (*connect.Response[v1.ListMemoBacklinksResponse], error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemoComments lists comments for a memo.
@@ -128,6 +290,24 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("ListMemos")),
 			connect.WithClientOptions(opts...),
 		),
+		getMemoHighlights: connect.NewClient[v1.GetMemoHighlightsRequest, v1.GetMemoHighlightsResponse](
+			httpClient,
+			baseURL+MemoServiceGetMemoHighlightsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoHighlights")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoCounts: connect.NewClient[v1.GetMemoCountsRequest, v1.GetMemoCountsResponse](
+			httpClient,
+			baseURL+MemoServiceGetMemoCountsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoCounts")),
+			connect.WithClientOptions(opts...),
+		),
+		previewAutoArchiveMemos: connect.NewClient[v1.PreviewAutoArchiveMemosRequest, v1.PreviewAutoArchiveMemosResponse](
+			httpClient,
+			baseURL+MemoServicePreviewAutoArchiveMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("PreviewAutoArchiveMemos")),
+			connect.WithClientOptions(opts...),
+		),
 		getMemo: connect.NewClient[v1.GetMemoRequest, v1.Memo](
 			httpClient,
 			baseURL+MemoServiceGetMemoProcedure,
@@ -146,6 +326,150 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("DeleteMemo")),
 			connect.WithClientOptions(opts...),
 		),
+		restoreMemo: connect.NewClient[v1.RestoreMemoRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceRestoreMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RestoreMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeMemo: connect.NewClient[v1.PurgeMemoRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoServicePurgeMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("PurgeMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		listMemoRevisions: connect.NewClient[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoRevisionsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoRevision: connect.NewClient[v1.GetMemoRevisionRequest, v1.MemoRevision](
+			httpClient,
+			baseURL+MemoServiceGetMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreMemoRevision: connect.NewClient[v1.RestoreMemoRevisionRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceRestoreMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		createMemoShareLink: connect.NewClient[v1.CreateMemoShareLinkRequest, v1.MemoShareLink](
+			httpClient,
+			baseURL+MemoServiceCreateMemoShareLinkProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("CreateMemoShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listMemoShareLinks: connect.NewClient[v1.ListMemoShareLinksRequest, v1.ListMemoShareLinksResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoShareLinksProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoShareLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemoShareLink: connect.NewClient[v1.UpdateMemoShareLinkRequest, v1.MemoShareLink](
+			httpClient,
+			baseURL+MemoServiceUpdateMemoShareLinkProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("UpdateMemoShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMemoShareLink: connect.NewClient[v1.DeleteMemoShareLinkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoServiceDeleteMemoShareLinkProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("DeleteMemoShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getSharedMemo: connect.NewClient[v1.GetSharedMemoRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceGetSharedMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetSharedMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockSharedMemo: connect.NewClient[v1.UnlockSharedMemoRequest, v1.UnlockSharedMemoResponse](
+			httpClient,
+			baseURL+MemoServiceUnlockSharedMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("UnlockSharedMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		duplicateMemo: connect.NewClient[v1.DuplicateMemoRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceDuplicateMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("DuplicateMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeMemos: connect.NewClient[v1.MergeMemosRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceMergeMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("MergeMemos")),
+			connect.WithClientOptions(opts...),
+		),
+		createMemoFromWikiLink: connect.NewClient[v1.CreateMemoFromWikiLinkRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceCreateMemoFromWikiLinkProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("CreateMemoFromWikiLink")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemoTask: connect.NewClient[v1.UpdateMemoTaskRequest, v1.UpdateMemoTaskResponse](
+			httpClient,
+			baseURL+MemoServiceUpdateMemoTaskProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("UpdateMemoTask")),
+			connect.WithClientOptions(opts...),
+		),
+		movePinnedMemo: connect.NewClient[v1.MovePinnedMemoRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceMovePinnedMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("MovePinnedMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		snoozeMemoReminder: connect.NewClient[v1.SnoozeMemoReminderRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceSnoozeMemoReminderProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("SnoozeMemoReminder")),
+			connect.WithClientOptions(opts...),
+		),
+		completeMemoReminder: connect.NewClient[v1.CompleteMemoReminderRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceCompleteMemoReminderProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("CompleteMemoReminder")),
+			connect.WithClientOptions(opts...),
+		),
+		createBookmarkMemo: connect.NewClient[v1.CreateBookmarkMemoRequest, v1.CreateBookmarkMemoResponse](
+			httpClient,
+			baseURL+MemoServiceCreateBookmarkMemoProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("CreateBookmarkMemo")),
+			connect.WithClientOptions(opts...),
+		),
+		listTagTree: connect.NewClient[v1.ListTagTreeRequest, v1.ListTagTreeResponse](
+			httpClient,
+			baseURL+MemoServiceListTagTreeProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListTagTree")),
+			connect.WithClientOptions(opts...),
+		),
+		renameMemoTag: connect.NewClient[v1.RenameMemoTagRequest, v1.RenameMemoTagResponse](
+			httpClient,
+			baseURL+MemoServiceRenameMemoTagProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RenameMemoTag")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateMemos: connect.NewClient[v1.BatchUpdateMemosRequest, v1.BatchUpdateMemosResponse](
+			httpClient,
+			baseURL+MemoServiceBatchUpdateMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("BatchUpdateMemos")),
+			connect.WithClientOptions(opts...),
+		),
+		importMemos: connect.NewClient[v1.ImportMemosRequest, v1.MemoImport](
+			httpClient,
+			baseURL+MemoServiceImportMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ImportMemos")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoImport: connect.NewClient[v1.GetMemoImportRequest, v1.MemoImport](
+			httpClient,
+			baseURL+MemoServiceGetMemoImportProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoImport")),
+			connect.WithClientOptions(opts...),
+		),
 		setMemoAttachments: connect.NewClient[v1.SetMemoAttachmentsRequest, emptypb.Empty](
 			httpClient,
 			baseURL+MemoServiceSetMemoAttachmentsProcedure,
@@ -170,6 +494,12 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("ListMemoRelations")),
 			connect.WithClientOptions(opts...),
 		),
+		listMemoBacklinks: connect.NewClient[v1.ListMemoBacklinksRequest, v1.ListMemoBacklinksResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoBacklinksProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoBacklinks")),
+			connect.WithClientOptions(opts...),
+		),
 		createMemoComment: connect.NewClient[v1.CreateMemoCommentRequest, v1.Memo](
 			httpClient,
 			baseURL+MemoServiceCreateMemoCommentProcedure,
@@ -205,20 +535,48 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // memoServiceClient implements MemoServiceClient.
 type memoServiceClient struct {
-	createMemo          *connect.Client[v1.CreateMemoRequest, v1.Memo]
-	listMemos           *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
-	getMemo             *connect.Client[v1.GetMemoRequest, v1.Memo]
-	updateMemo          *connect.Client[v1.UpdateMemoRequest, v1.Memo]
-	deleteMemo          *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
-	setMemoAttachments  *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
-	listMemoAttachments *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
-	setMemoRelations    *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
-	listMemoRelations   *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
-	createMemoComment   *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
-	listMemoComments    *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
-	listMemoReactions   *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
-	upsertMemoReaction  *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
-	deleteMemoReaction  *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	createMemo              *connect.Client[v1.CreateMemoRequest, v1.Memo]
+	listMemos               *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
+	getMemoHighlights       *connect.Client[v1.GetMemoHighlightsRequest, v1.GetMemoHighlightsResponse]
+	getMemoCounts           *connect.Client[v1.GetMemoCountsRequest, v1.GetMemoCountsResponse]
+	previewAutoArchiveMemos *connect.Client[v1.PreviewAutoArchiveMemosRequest, v1.PreviewAutoArchiveMemosResponse]
+	getMemo                 *connect.Client[v1.GetMemoRequest, v1.Memo]
+	updateMemo              *connect.Client[v1.UpdateMemoRequest, v1.Memo]
+	deleteMemo              *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
+	restoreMemo             *connect.Client[v1.RestoreMemoRequest, v1.Memo]
+	purgeMemo               *connect.Client[v1.PurgeMemoRequest, emptypb.Empty]
+	listMemoRevisions       *connect.Client[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse]
+	getMemoRevision         *connect.Client[v1.GetMemoRevisionRequest, v1.MemoRevision]
+	restoreMemoRevision     *connect.Client[v1.RestoreMemoRevisionRequest, v1.Memo]
+	createMemoShareLink     *connect.Client[v1.CreateMemoShareLinkRequest, v1.MemoShareLink]
+	listMemoShareLinks      *connect.Client[v1.ListMemoShareLinksRequest, v1.ListMemoShareLinksResponse]
+	updateMemoShareLink     *connect.Client[v1.UpdateMemoShareLinkRequest, v1.MemoShareLink]
+	deleteMemoShareLink     *connect.Client[v1.DeleteMemoShareLinkRequest, emptypb.Empty]
+	getSharedMemo           *connect.Client[v1.GetSharedMemoRequest, v1.Memo]
+	unlockSharedMemo        *connect.Client[v1.UnlockSharedMemoRequest, v1.UnlockSharedMemoResponse]
+	duplicateMemo           *connect.Client[v1.DuplicateMemoRequest, v1.Memo]
+	mergeMemos              *connect.Client[v1.MergeMemosRequest, v1.Memo]
+	createMemoFromWikiLink  *connect.Client[v1.CreateMemoFromWikiLinkRequest, v1.Memo]
+	updateMemoTask          *connect.Client[v1.UpdateMemoTaskRequest, v1.UpdateMemoTaskResponse]
+	movePinnedMemo          *connect.Client[v1.MovePinnedMemoRequest, v1.Memo]
+	snoozeMemoReminder      *connect.Client[v1.SnoozeMemoReminderRequest, v1.Memo]
+	completeMemoReminder    *connect.Client[v1.CompleteMemoReminderRequest, v1.Memo]
+	createBookmarkMemo      *connect.Client[v1.CreateBookmarkMemoRequest, v1.CreateBookmarkMemoResponse]
+	listTagTree             *connect.Client[v1.ListTagTreeRequest, v1.ListTagTreeResponse]
+	renameMemoTag           *connect.Client[v1.RenameMemoTagRequest, v1.RenameMemoTagResponse]
+	batchUpdateMemos        *connect.Client[v1.BatchUpdateMemosRequest, v1.BatchUpdateMemosResponse]
+	importMemos             *connect.Client[v1.ImportMemosRequest, v1.MemoImport]
+	getMemoImport           *connect.Client[v1.GetMemoImportRequest, v1.MemoImport]
+	setMemoAttachments      *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
+	listMemoAttachments     *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
+	setMemoRelations        *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
+	listMemoRelations       *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
+	listMemoBacklinks       *connect.Client[v1.ListMemoBacklinksRequest, v1.ListMemoBacklinksResponse]
+	createMemoComment       *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
+	listMemoComments        *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
+	listMemoReactions       *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
+	upsertMemoReaction      *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
+	deleteMemoReaction      *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
 }
 
 // CreateMemo calls memos.api.v1.MemoService.CreateMemo.
@@ -231,6 +589,21 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, req *connect.Request[
 	return c.listMemos.CallUnary(ctx, req)
 }
 
+// GetMemoHighlights calls memos.api.v1.MemoService.GetMemoHighlights.
+func (c *memoServiceClient) GetMemoHighlights(ctx context.Context, req *connect.Request[v1.GetMemoHighlightsRequest]) (*connect.Response[v1.GetMemoHighlightsResponse], error) {
+	return c.getMemoHighlights.CallUnary(ctx, req)
+}
+
+// GetMemoCounts calls memos.api.v1.MemoService.GetMemoCounts.
+func (c *memoServiceClient) GetMemoCounts(ctx context.Context, req *connect.Request[v1.GetMemoCountsRequest]) (*connect.Response[v1.GetMemoCountsResponse], error) {
+	return c.getMemoCounts.CallUnary(ctx, req)
+}
+
+// PreviewAutoArchiveMemos calls memos.api.v1.MemoService.PreviewAutoArchiveMemos.
+func (c *memoServiceClient) PreviewAutoArchiveMemos(ctx context.Context, req *connect.Request[v1.PreviewAutoArchiveMemosRequest]) (*connect.Response[v1.PreviewAutoArchiveMemosResponse], error) {
+	return c.previewAutoArchiveMemos.CallUnary(ctx, req)
+}
+
 // GetMemo calls memos.api.v1.MemoService.GetMemo.
 func (c *memoServiceClient) GetMemo(ctx context.Context, req *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error) {
 	return c.getMemo.CallUnary(ctx, req)
@@ -246,6 +619,126 @@ func (c *memoServiceClient) DeleteMemo(ctx context.Context, req *connect.Request
 	return c.deleteMemo.CallUnary(ctx, req)
 }
 
+// RestoreMemo calls memos.api.v1.MemoService.RestoreMemo.
+func (c *memoServiceClient) RestoreMemo(ctx context.Context, req *connect.Request[v1.RestoreMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return c.restoreMemo.CallUnary(ctx, req)
+}
+
+// PurgeMemo calls memos.api.v1.MemoService.PurgeMemo.
+func (c *memoServiceClient) PurgeMemo(ctx context.Context, req *connect.Request[v1.PurgeMemoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.purgeMemo.CallUnary(ctx, req)
+}
+
+// ListMemoRevisions calls memos.api.v1.MemoService.ListMemoRevisions.
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, req *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return c.listMemoRevisions.CallUnary(ctx, req)
+}
+
+// GetMemoRevision calls memos.api.v1.MemoService.GetMemoRevision.
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, req *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return c.getMemoRevision.CallUnary(ctx, req)
+}
+
+// RestoreMemoRevision calls memos.api.v1.MemoService.RestoreMemoRevision.
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return c.restoreMemoRevision.CallUnary(ctx, req)
+}
+
+// CreateMemoShareLink calls memos.api.v1.MemoService.CreateMemoShareLink.
+func (c *memoServiceClient) CreateMemoShareLink(ctx context.Context, req *connect.Request[v1.CreateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error) {
+	return c.createMemoShareLink.CallUnary(ctx, req)
+}
+
+// ListMemoShareLinks calls memos.api.v1.MemoService.ListMemoShareLinks.
+func (c *memoServiceClient) ListMemoShareLinks(ctx context.Context, req *connect.Request[v1.ListMemoShareLinksRequest]) (*connect.Response[v1.ListMemoShareLinksResponse], error) {
+	return c.listMemoShareLinks.CallUnary(ctx, req)
+}
+
+// UpdateMemoShareLink calls memos.api.v1.MemoService.UpdateMemoShareLink.
+func (c *memoServiceClient) UpdateMemoShareLink(ctx context.Context, req *connect.Request[v1.UpdateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error) {
+	return c.updateMemoShareLink.CallUnary(ctx, req)
+}
+
+// DeleteMemoShareLink calls memos.api.v1.MemoService.DeleteMemoShareLink.
+func (c *memoServiceClient) DeleteMemoShareLink(ctx context.Context, req *connect.Request[v1.DeleteMemoShareLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteMemoShareLink.CallUnary(ctx, req)
+}
+
+// GetSharedMemo calls memos.api.v1.MemoService.GetSharedMemo.
+func (c *memoServiceClient) GetSharedMemo(ctx context.Context, req *connect.Request[v1.GetSharedMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return c.getSharedMemo.CallUnary(ctx, req)
+}
+
+// UnlockSharedMemo calls memos.api.v1.MemoService.UnlockSharedMemo.
+func (c *memoServiceClient) UnlockSharedMemo(ctx context.Context, req *connect.Request[v1.UnlockSharedMemoRequest]) (*connect.Response[v1.UnlockSharedMemoResponse], error) {
+	return c.unlockSharedMemo.CallUnary(ctx, req)
+}
+
+// DuplicateMemo calls memos.api.v1.MemoService.DuplicateMemo.
+func (c *memoServiceClient) DuplicateMemo(ctx context.Context, req *connect.Request[v1.DuplicateMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return c.duplicateMemo.CallUnary(ctx, req)
+}
+
+// MergeMemos calls memos.api.v1.MemoService.MergeMemos.
+func (c *memoServiceClient) MergeMemos(ctx context.Context, req *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error) {
+	return c.mergeMemos.CallUnary(ctx, req)
+}
+
+// CreateMemoFromWikiLink calls memos.api.v1.MemoService.CreateMemoFromWikiLink.
+func (c *memoServiceClient) CreateMemoFromWikiLink(ctx context.Context, req *connect.Request[v1.CreateMemoFromWikiLinkRequest]) (*connect.Response[v1.Memo], error) {
+	return c.createMemoFromWikiLink.CallUnary(ctx, req)
+}
+
+// UpdateMemoTask calls memos.api.v1.MemoService.UpdateMemoTask.
+func (c *memoServiceClient) UpdateMemoTask(ctx context.Context, req *connect.Request[v1.UpdateMemoTaskRequest]) (*connect.Response[v1.UpdateMemoTaskResponse], error) {
+	return c.updateMemoTask.CallUnary(ctx, req)
+}
+
+// MovePinnedMemo calls memos.api.v1.MemoService.MovePinnedMemo.
+func (c *memoServiceClient) MovePinnedMemo(ctx context.Context, req *connect.Request[v1.MovePinnedMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return c.movePinnedMemo.CallUnary(ctx, req)
+}
+
+// SnoozeMemoReminder calls memos.api.v1.MemoService.SnoozeMemoReminder.
+func (c *memoServiceClient) SnoozeMemoReminder(ctx context.Context, req *connect.Request[v1.SnoozeMemoReminderRequest]) (*connect.Response[v1.Memo], error) {
+	return c.snoozeMemoReminder.CallUnary(ctx, req)
+}
+
+// CompleteMemoReminder calls memos.api.v1.MemoService.CompleteMemoReminder.
+func (c *memoServiceClient) CompleteMemoReminder(ctx context.Context, req *connect.Request[v1.CompleteMemoReminderRequest]) (*connect.Response[v1.Memo], error) {
+	return c.completeMemoReminder.CallUnary(ctx, req)
+}
+
+// CreateBookmarkMemo calls memos.api.v1.MemoService.CreateBookmarkMemo.
+func (c *memoServiceClient) CreateBookmarkMemo(ctx context.Context, req *connect.Request[v1.CreateBookmarkMemoRequest]) (*connect.Response[v1.CreateBookmarkMemoResponse], error) {
+	return c.createBookmarkMemo.CallUnary(ctx, req)
+}
+
+// ListTagTree calls memos.api.v1.MemoService.ListTagTree.
+func (c *memoServiceClient) ListTagTree(ctx context.Context, req *connect.Request[v1.ListTagTreeRequest]) (*connect.Response[v1.ListTagTreeResponse], error) {
+	return c.listTagTree.CallUnary(ctx, req)
+}
+
+// RenameMemoTag calls memos.api.v1.MemoService.RenameMemoTag.
+func (c *memoServiceClient) RenameMemoTag(ctx context.Context, req *connect.Request[v1.RenameMemoTagRequest]) (*connect.Response[v1.RenameMemoTagResponse], error) {
+	return c.renameMemoTag.CallUnary(ctx, req)
+}
+
+// BatchUpdateMemos calls memos.api.v1.MemoService.BatchUpdateMemos.
+func (c *memoServiceClient) BatchUpdateMemos(ctx context.Context, req *connect.Request[v1.BatchUpdateMemosRequest]) (*connect.Response[v1.BatchUpdateMemosResponse], error) {
+	return c.batchUpdateMemos.CallUnary(ctx, req)
+}
+
+// ImportMemos calls memos.api.v1.MemoService.ImportMemos.
+func (c *memoServiceClient) ImportMemos(ctx context.Context, req *connect.Request[v1.ImportMemosRequest]) (*connect.Response[v1.MemoImport], error) {
+	return c.importMemos.CallUnary(ctx, req)
+}
+
+// GetMemoImport calls memos.api.v1.MemoService.GetMemoImport.
+func (c *memoServiceClient) GetMemoImport(ctx context.Context, req *connect.Request[v1.GetMemoImportRequest]) (*connect.Response[v1.MemoImport], error) {
+	return c.getMemoImport.CallUnary(ctx, req)
+}
+
 // SetMemoAttachments calls memos.api.v1.MemoService.SetMemoAttachments.
 func (c *memoServiceClient) SetMemoAttachments(ctx context.Context, req *connect.Request[v1.SetMemoAttachmentsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.setMemoAttachments.CallUnary(ctx, req)
@@ -266,6 +759,11 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, req *connect.
 	return c.listMemoRelations.CallUnary(ctx, req)
 }
 
+// ListMemoBacklinks calls memos.api.v1.MemoService.ListMemoBacklinks.
+func (c *memoServiceClient) ListMemoBacklinks(ctx context.Context, req *connect.Request[v1.ListMemoBacklinksRequest]) (*connect.Response[v1.ListMemoBacklinksResponse], error) {
+	return c.listMemoBacklinks.CallUnary(ctx, req)
+}
+
 // CreateMemoComment calls memos.api.v1.MemoService.CreateMemoComment.
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, req *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error) {
 	return c.createMemoComment.CallUnary(ctx, req)
@@ -297,12 +795,93 @@ type MemoServiceHandler interface {
 	CreateMemo(context.Context, *connect.Request[v1.CreateMemoRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *connect.Request[v1.ListMemosRequest]) (*connect.Response[v1.ListMemosResponse], error)
+	// GetMemoHighlights gets memos of the current user to rediscover: the memos created on the same
+	// day in previous years, and a random sample of memos older than 90 days.
+	GetMemoHighlights(context.Context, *connect.Request[v1.GetMemoHighlightsRequest]) (*connect.Response[v1.GetMemoHighlightsResponse], error)
+	// GetMemoCounts gets the number of memos of the current user in each state.
+	GetMemoCounts(context.Context, *connect.Request[v1.GetMemoCountsRequest]) (*connect.Response[v1.GetMemoCountsResponse], error)
+	// PreviewAutoArchiveMemos lists the memos of the current user that the auto-archive policy
+	// would archive, with the saved policy or the one of the request.
+	PreviewAutoArchiveMemos(context.Context, *connect.Request[v1.PreviewAutoArchiveMemosRequest]) (*connect.Response[v1.PreviewAutoArchiveMemosResponse], error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *connect.Request[v1.UpdateMemoRequest]) (*connect.Response[v1.Memo], error)
-	// DeleteMemo deletes a memo.
+	// DeleteMemo moves a memo to the trash.
+	// Memos in the trash are purged after the trash retention of the instance.
 	DeleteMemo(context.Context, *connect.Request[v1.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(context.Context, *connect.Request[v1.RestoreMemoRequest]) (*connect.Response[v1.Memo], error)
+	// PurgeMemo permanently deletes a memo with its attachments, relations, reactions, revisions
+	// and comments.
+	PurgeMemo(context.Context, *connect.Request[v1.PurgeMemoRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMemoRevisions lists the revisions of a memo, newest first, with their diffs.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a revision of a memo with its content.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// RestoreMemoRevision restores the content of a memo to a revision, which creates a new revision.
+	// The attachments of the revision that still exist are attached to the memo again.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
+	// CreateMemoShareLink creates a link that shares a memo read-only with anyone who has it,
+	// whatever the visibility of the memo, until it expires, runs out of views or is revoked.
+	CreateMemoShareLink(context.Context, *connect.Request[v1.CreateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error)
+	// ListMemoShareLinks lists the share links of a memo, newest first.
+	ListMemoShareLinks(context.Context, *connect.Request[v1.ListMemoShareLinksRequest]) (*connect.Response[v1.ListMemoShareLinksResponse], error)
+	// UpdateMemoShareLink updates a share link. Only the passphrase can be changed, which signs out
+	// everyone who entered the previous one.
+	UpdateMemoShareLink(context.Context, *connect.Request[v1.UpdateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error)
+	// DeleteMemoShareLink revokes a share link.
+	DeleteMemoShareLink(context.Context, *connect.Request[v1.DeleteMemoShareLinkRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetSharedMemo gets the memo of a share link, which counts a view of the link. The links that
+	// expired, ran out of views or were revoked are not found. The links with a passphrase are
+	// unauthenticated without an access token from UnlockSharedMemo.
+	GetSharedMemo(context.Context, *connect.Request[v1.GetSharedMemoRequest]) (*connect.Response[v1.Memo], error)
+	// UnlockSharedMemo checks the passphrase of a share link and returns a short-lived access token
+	// to the memo of the link. Failed attempts are throttled per link.
+	UnlockSharedMemo(context.Context, *connect.Request[v1.UnlockSharedMemoRequest]) (*connect.Response[v1.UnlockSharedMemoResponse], error)
+	// DuplicateMemo creates a private copy of a memo for the current user, with the same content
+	// and attachments. The copied attachments share the files of the memo's attachments.
+	// Duplicating a memo of another user adds a reference to the memo.
+	DuplicateMemo(context.Context, *connect.Request[v1.DuplicateMemoRequest]) (*connect.Response[v1.Memo], error)
+	// MergeMemos merges a source memo into a target memo of the current user. The source content is
+	// appended to the target, its attachments, reactions and relations move to the target, and the
+	// source is moved to the trash with a merge relation to the target.
+	MergeMemos(context.Context, *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error)
+	// CreateMemoFromWikiLink creates the memo a missing [[wiki link]] of a memo of the current user
+	// links to, a private memo titled with the target, and resolves the link to it.
+	CreateMemoFromWikiLink(context.Context, *connect.Request[v1.CreateMemoFromWikiLinkRequest]) (*connect.Response[v1.Memo], error)
+	// UpdateMemoTask checks or unchecks a task list item of a memo, without rewriting the rest of
+	// its content.
+	UpdateMemoTask(context.Context, *connect.Request[v1.UpdateMemoTaskRequest]) (*connect.Response[v1.UpdateMemoTaskResponse], error)
+	// MovePinnedMemo moves a pinned memo up, down or to a position among the pinned memos of its
+	// creator, which are listed in that order before the other memos when ordered by pinned.
+	MovePinnedMemo(context.Context, *connect.Request[v1.MovePinnedMemoRequest]) (*connect.Response[v1.Memo], error)
+	// SnoozeMemoReminder moves the reminder of a memo to a later time.
+	SnoozeMemoReminder(context.Context, *connect.Request[v1.SnoozeMemoReminderRequest]) (*connect.Response[v1.Memo], error)
+	// CompleteMemoReminder clears the reminder of a memo, including its next occurrences.
+	CompleteMemoReminder(context.Context, *connect.Request[v1.CompleteMemoReminderRequest]) (*connect.Response[v1.Memo], error)
+	// CreateBookmarkMemo creates a memo bookmarking a link, with the title, description and image
+	// of the link fetched like link previews. The memo is still created, with the link alone, if
+	// the metadata can't be fetched. Bookmarking a link again shortly after returns the memo that
+	// was created instead of creating another one.
+	CreateBookmarkMemo(context.Context, *connect.Request[v1.CreateBookmarkMemoRequest]) (*connect.Response[v1.CreateBookmarkMemoResponse], error)
+	// ListTagTree lists the tags of the memos of a user as a tree, e.g. "project" with the
+	// subtags "project/alpha" and "project/beta", with the number of memos of each tag.
+	ListTagTree(context.Context, *connect.Request[v1.ListTagTreeRequest]) (*connect.Response[v1.ListTagTreeResponse], error)
+	// RenameMemoTag renames a tag in all memos of the current user.
+	// Renaming to an existing tag merges the two tags.
+	RenameMemoTag(context.Context, *connect.Request[v1.RenameMemoTagRequest]) (*connect.Response[v1.RenameMemoTagResponse], error)
+	// BatchUpdateMemos applies one operation to up to 100 memos in a single transaction. Each memo
+	// is checked as with the single-memo endpoints, and the memos that fail the checks are reported
+	// without failing the others. Each changed memo dispatches its own webhook event, as if it was
+	// changed by the single-memo endpoint.
+	BatchUpdateMemos(context.Context, *connect.Request[v1.BatchUpdateMemosRequest]) (*connect.Response[v1.BatchUpdateMemosResponse], error)
+	// ImportMemos starts importing the memos of a zip archive of markdown files, e.g. an Obsidian
+	// vault, of a Google Keep Takeout archive, or of a Day One export, as memos of the current
+	// user. The import runs in the background, and its progress is returned by GetMemoImport.
+	ImportMemos(context.Context, *connect.Request[v1.ImportMemosRequest]) (*connect.Response[v1.MemoImport], error)
+	// GetMemoImport gets the progress of an import of the current user.
+	GetMemoImport(context.Context, *connect.Request[v1.GetMemoImportRequest]) (*connect.Response[v1.MemoImport], error)
 	// SetMemoAttachments sets attachments for a memo.
 	SetMemoAttachments(context.Context, *connect.Request[v1.SetMemoAttachmentsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoAttachments lists attachments for a memo.
@@ -311,6 +890,8 @@ type MemoServiceHandler interface {
 	SetMemoRelations(context.Context, *connect.Request[v1.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *connect.Request[v1.ListMemoRelationsRequest]) (*connect.Response[v1.ListMemoRelationsResponse], error)
+	// ListMemoBacklinks lists the memos referencing a memo.
+	ListMemoBacklinks(context.Context, *connect.Request[v1.ListMemoBacklinksRequest]) (*connect.Response[v1.ListMemoBacklinksResponse], error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemoComments lists comments for a memo.
@@ -342,6 +923,24 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("ListMemos")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceGetMemoHighlightsHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoHighlightsProcedure,
+		svc.GetMemoHighlights,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoHighlights")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoCountsHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoCountsProcedure,
+		svc.GetMemoCounts,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoCounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServicePreviewAutoArchiveMemosHandler := connect.NewUnaryHandler(
+		MemoServicePreviewAutoArchiveMemosProcedure,
+		svc.PreviewAutoArchiveMemos,
+		connect.WithSchema(memoServiceMethods.ByName("PreviewAutoArchiveMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceGetMemoHandler := connect.NewUnaryHandler(
 		MemoServiceGetMemoProcedure,
 		svc.GetMemo,
@@ -360,6 +959,150 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("DeleteMemo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceRestoreMemoHandler := connect.NewUnaryHandler(
+		MemoServiceRestoreMemoProcedure,
+		svc.RestoreMemo,
+		connect.WithSchema(memoServiceMethods.ByName("RestoreMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServicePurgeMemoHandler := connect.NewUnaryHandler(
+		MemoServicePurgeMemoProcedure,
+		svc.PurgeMemo,
+		connect.WithSchema(memoServiceMethods.ByName("PurgeMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListMemoRevisionsHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoRevisionsProcedure,
+		svc.ListMemoRevisions,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoRevisionProcedure,
+		svc.GetMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRestoreMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceRestoreMemoRevisionProcedure,
+		svc.RestoreMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceCreateMemoShareLinkHandler := connect.NewUnaryHandler(
+		MemoServiceCreateMemoShareLinkProcedure,
+		svc.CreateMemoShareLink,
+		connect.WithSchema(memoServiceMethods.ByName("CreateMemoShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListMemoShareLinksHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoShareLinksProcedure,
+		svc.ListMemoShareLinks,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoShareLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceUpdateMemoShareLinkHandler := connect.NewUnaryHandler(
+		MemoServiceUpdateMemoShareLinkProcedure,
+		svc.UpdateMemoShareLink,
+		connect.WithSchema(memoServiceMethods.ByName("UpdateMemoShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceDeleteMemoShareLinkHandler := connect.NewUnaryHandler(
+		MemoServiceDeleteMemoShareLinkProcedure,
+		svc.DeleteMemoShareLink,
+		connect.WithSchema(memoServiceMethods.ByName("DeleteMemoShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetSharedMemoHandler := connect.NewUnaryHandler(
+		MemoServiceGetSharedMemoProcedure,
+		svc.GetSharedMemo,
+		connect.WithSchema(memoServiceMethods.ByName("GetSharedMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceUnlockSharedMemoHandler := connect.NewUnaryHandler(
+		MemoServiceUnlockSharedMemoProcedure,
+		svc.UnlockSharedMemo,
+		connect.WithSchema(memoServiceMethods.ByName("UnlockSharedMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceDuplicateMemoHandler := connect.NewUnaryHandler(
+		MemoServiceDuplicateMemoProcedure,
+		svc.DuplicateMemo,
+		connect.WithSchema(memoServiceMethods.ByName("DuplicateMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceMergeMemosHandler := connect.NewUnaryHandler(
+		MemoServiceMergeMemosProcedure,
+		svc.MergeMemos,
+		connect.WithSchema(memoServiceMethods.ByName("MergeMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceCreateMemoFromWikiLinkHandler := connect.NewUnaryHandler(
+		MemoServiceCreateMemoFromWikiLinkProcedure,
+		svc.CreateMemoFromWikiLink,
+		connect.WithSchema(memoServiceMethods.ByName("CreateMemoFromWikiLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceUpdateMemoTaskHandler := connect.NewUnaryHandler(
+		MemoServiceUpdateMemoTaskProcedure,
+		svc.UpdateMemoTask,
+		connect.WithSchema(memoServiceMethods.ByName("UpdateMemoTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceMovePinnedMemoHandler := connect.NewUnaryHandler(
+		MemoServiceMovePinnedMemoProcedure,
+		svc.MovePinnedMemo,
+		connect.WithSchema(memoServiceMethods.ByName("MovePinnedMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceSnoozeMemoReminderHandler := connect.NewUnaryHandler(
+		MemoServiceSnoozeMemoReminderProcedure,
+		svc.SnoozeMemoReminder,
+		connect.WithSchema(memoServiceMethods.ByName("SnoozeMemoReminder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceCompleteMemoReminderHandler := connect.NewUnaryHandler(
+		MemoServiceCompleteMemoReminderProcedure,
+		svc.CompleteMemoReminder,
+		connect.WithSchema(memoServiceMethods.ByName("CompleteMemoReminder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceCreateBookmarkMemoHandler := connect.NewUnaryHandler(
+		MemoServiceCreateBookmarkMemoProcedure,
+		svc.CreateBookmarkMemo,
+		connect.WithSchema(memoServiceMethods.ByName("CreateBookmarkMemo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListTagTreeHandler := connect.NewUnaryHandler(
+		MemoServiceListTagTreeProcedure,
+		svc.ListTagTree,
+		connect.WithSchema(memoServiceMethods.ByName("ListTagTree")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRenameMemoTagHandler := connect.NewUnaryHandler(
+		MemoServiceRenameMemoTagProcedure,
+		svc.RenameMemoTag,
+		connect.WithSchema(memoServiceMethods.ByName("RenameMemoTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceBatchUpdateMemosHandler := connect.NewUnaryHandler(
+		MemoServiceBatchUpdateMemosProcedure,
+		svc.BatchUpdateMemos,
+		connect.WithSchema(memoServiceMethods.ByName("BatchUpdateMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceImportMemosHandler := connect.NewUnaryHandler(
+		MemoServiceImportMemosProcedure,
+		svc.ImportMemos,
+		connect.WithSchema(memoServiceMethods.ByName("ImportMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoImportHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoImportProcedure,
+		svc.GetMemoImport,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoImport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceSetMemoAttachmentsHandler := connect.NewUnaryHandler(
 		MemoServiceSetMemoAttachmentsProcedure,
 		svc.SetMemoAttachments,
@@ -384,6 +1127,12 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("ListMemoRelations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceListMemoBacklinksHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoBacklinksProcedure,
+		svc.ListMemoBacklinks,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoBacklinks")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceCreateMemoCommentHandler := connect.NewUnaryHandler(
 		MemoServiceCreateMemoCommentProcedure,
 		svc.CreateMemoComment,
@@ -420,12 +1169,66 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceCreateMemoHandler.ServeHTTP(w, r)
 		case MemoServiceListMemosProcedure:
 			memoServiceListMemosHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoHighlightsProcedure:
+			memoServiceGetMemoHighlightsHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoCountsProcedure:
+			memoServiceGetMemoCountsHandler.ServeHTTP(w, r)
+		case MemoServicePreviewAutoArchiveMemosProcedure:
+			memoServicePreviewAutoArchiveMemosHandler.ServeHTTP(w, r)
 		case MemoServiceGetMemoProcedure:
 			memoServiceGetMemoHandler.ServeHTTP(w, r)
 		case MemoServiceUpdateMemoProcedure:
 			memoServiceUpdateMemoHandler.ServeHTTP(w, r)
 		case MemoServiceDeleteMemoProcedure:
 			memoServiceDeleteMemoHandler.ServeHTTP(w, r)
+		case MemoServiceRestoreMemoProcedure:
+			memoServiceRestoreMemoHandler.ServeHTTP(w, r)
+		case MemoServicePurgeMemoProcedure:
+			memoServicePurgeMemoHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoRevisionsProcedure:
+			memoServiceListMemoRevisionsHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoRevisionProcedure:
+			memoServiceGetMemoRevisionHandler.ServeHTTP(w, r)
+		case MemoServiceRestoreMemoRevisionProcedure:
+			memoServiceRestoreMemoRevisionHandler.ServeHTTP(w, r)
+		case MemoServiceCreateMemoShareLinkProcedure:
+			memoServiceCreateMemoShareLinkHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoShareLinksProcedure:
+			memoServiceListMemoShareLinksHandler.ServeHTTP(w, r)
+		case MemoServiceUpdateMemoShareLinkProcedure:
+			memoServiceUpdateMemoShareLinkHandler.ServeHTTP(w, r)
+		case MemoServiceDeleteMemoShareLinkProcedure:
+			memoServiceDeleteMemoShareLinkHandler.ServeHTTP(w, r)
+		case MemoServiceGetSharedMemoProcedure:
+			memoServiceGetSharedMemoHandler.ServeHTTP(w, r)
+		case MemoServiceUnlockSharedMemoProcedure:
+			memoServiceUnlockSharedMemoHandler.ServeHTTP(w, r)
+		case MemoServiceDuplicateMemoProcedure:
+			memoServiceDuplicateMemoHandler.ServeHTTP(w, r)
+		case MemoServiceMergeMemosProcedure:
+			memoServiceMergeMemosHandler.ServeHTTP(w, r)
+		case MemoServiceCreateMemoFromWikiLinkProcedure:
+			memoServiceCreateMemoFromWikiLinkHandler.ServeHTTP(w, r)
+		case MemoServiceUpdateMemoTaskProcedure:
+			memoServiceUpdateMemoTaskHandler.ServeHTTP(w, r)
+		case MemoServiceMovePinnedMemoProcedure:
+			memoServiceMovePinnedMemoHandler.ServeHTTP(w, r)
+		case MemoServiceSnoozeMemoReminderProcedure:
+			memoServiceSnoozeMemoReminderHandler.ServeHTTP(w, r)
+		case MemoServiceCompleteMemoReminderProcedure:
+			memoServiceCompleteMemoReminderHandler.ServeHTTP(w, r)
+		case MemoServiceCreateBookmarkMemoProcedure:
+			memoServiceCreateBookmarkMemoHandler.ServeHTTP(w, r)
+		case MemoServiceListTagTreeProcedure:
+			memoServiceListTagTreeHandler.ServeHTTP(w, r)
+		case MemoServiceRenameMemoTagProcedure:
+			memoServiceRenameMemoTagHandler.ServeHTTP(w, r)
+		case MemoServiceBatchUpdateMemosProcedure:
+			memoServiceBatchUpdateMemosHandler.ServeHTTP(w, r)
+		case MemoServiceImportMemosProcedure:
+			memoServiceImportMemosHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoImportProcedure:
+			memoServiceGetMemoImportHandler.ServeHTTP(w, r)
 		case MemoServiceSetMemoAttachmentsProcedure:
 			memoServiceSetMemoAttachmentsHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoAttachmentsProcedure:
@@ -434,6 +1237,8 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceSetMemoRelationsHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoRelationsProcedure:
 			memoServiceListMemoRelationsHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoBacklinksProcedure:
+			memoServiceListMemoBacklinksHandler.ServeHTTP(w, r)
 		case MemoServiceCreateMemoCommentProcedure:
 			memoServiceCreateMemoCommentHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoCommentsProcedure:
@@ -461,6 +1266,18 @@ func (UnimplementedMemoServiceHandler) ListMemos(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemos is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) GetMemoHighlights(context.Context, *connect.Request[v1.GetMemoHighlightsRequest]) (*connect.Response[v1.GetMemoHighlightsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoHighlights is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoCounts(context.Context, *connect.Request[v1.GetMemoCountsRequest]) (*connect.Response[v1.GetMemoCountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoCounts is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) PreviewAutoArchiveMemos(context.Context, *connect.Request[v1.PreviewAutoArchiveMemosRequest]) (*connect.Response[v1.PreviewAutoArchiveMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.PreviewAutoArchiveMemos is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemo is not implemented"))
 }
@@ -473,6 +1290,102 @@ func (UnimplementedMemoServiceHandler) DeleteMemo(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemo is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) RestoreMemo(context.Context, *connect.Request[v1.RestoreMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RestoreMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) PurgeMemo(context.Context, *connect.Request[v1.PurgeMemoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.PurgeMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRevisions is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoRevision is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RestoreMemoRevision is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) CreateMemoShareLink(context.Context, *connect.Request[v1.CreateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoShareLink is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListMemoShareLinks(context.Context, *connect.Request[v1.ListMemoShareLinksRequest]) (*connect.Response[v1.ListMemoShareLinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoShareLinks is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) UpdateMemoShareLink(context.Context, *connect.Request[v1.UpdateMemoShareLinkRequest]) (*connect.Response[v1.MemoShareLink], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.UpdateMemoShareLink is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) DeleteMemoShareLink(context.Context, *connect.Request[v1.DeleteMemoShareLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemoShareLink is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetSharedMemo(context.Context, *connect.Request[v1.GetSharedMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetSharedMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) UnlockSharedMemo(context.Context, *connect.Request[v1.UnlockSharedMemoRequest]) (*connect.Response[v1.UnlockSharedMemoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.UnlockSharedMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) DuplicateMemo(context.Context, *connect.Request[v1.DuplicateMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DuplicateMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) MergeMemos(context.Context, *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.MergeMemos is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) CreateMemoFromWikiLink(context.Context, *connect.Request[v1.CreateMemoFromWikiLinkRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoFromWikiLink is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) UpdateMemoTask(context.Context, *connect.Request[v1.UpdateMemoTaskRequest]) (*connect.Response[v1.UpdateMemoTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.UpdateMemoTask is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) MovePinnedMemo(context.Context, *connect.Request[v1.MovePinnedMemoRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.MovePinnedMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) SnoozeMemoReminder(context.Context, *connect.Request[v1.SnoozeMemoReminderRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SnoozeMemoReminder is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) CompleteMemoReminder(context.Context, *connect.Request[v1.CompleteMemoReminderRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CompleteMemoReminder is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) CreateBookmarkMemo(context.Context, *connect.Request[v1.CreateBookmarkMemoRequest]) (*connect.Response[v1.CreateBookmarkMemoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateBookmarkMemo is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListTagTree(context.Context, *connect.Request[v1.ListTagTreeRequest]) (*connect.Response[v1.ListTagTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListTagTree is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RenameMemoTag(context.Context, *connect.Request[v1.RenameMemoTagRequest]) (*connect.Response[v1.RenameMemoTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RenameMemoTag is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) BatchUpdateMemos(context.Context, *connect.Request[v1.BatchUpdateMemosRequest]) (*connect.Response[v1.BatchUpdateMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.BatchUpdateMemos is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ImportMemos(context.Context, *connect.Request[v1.ImportMemosRequest]) (*connect.Response[v1.MemoImport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ImportMemos is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoImport(context.Context, *connect.Request[v1.GetMemoImportRequest]) (*connect.Response[v1.MemoImport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoImport is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) SetMemoAttachments(context.Context, *connect.Request[v1.SetMemoAttachmentsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SetMemoAttachments is not implemented"))
 }
@@ -489,6 +1402,10 @@ func (UnimplementedMemoServiceHandler) ListMemoRelations(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRelations is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) ListMemoBacklinks(context.Context, *connect.Request[v1.ListMemoBacklinksRequest]) (*connect.Response[v1.ListMemoBacklinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoBacklinks is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoComment is not implemented"))
 }
@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/memo_template_service.proto
+
+package apiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MemoTemplateServiceName is the fully-qualified name of the MemoTemplateService service.
+	MemoTemplateServiceName = "memos.api.v1.MemoTemplateService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MemoTemplateServiceListMemoTemplatesProcedure is the fully-qualified name of the
+	// MemoTemplateService's ListMemoTemplates RPC.
+	MemoTemplateServiceListMemoTemplatesProcedure = "/memos.api.v1.MemoTemplateService/ListMemoTemplates"
+	// MemoTemplateServiceGetMemoTemplateProcedure is the fully-qualified name of the
+	// MemoTemplateService's GetMemoTemplate RPC.
+	MemoTemplateServiceGetMemoTemplateProcedure = "/memos.api.v1.MemoTemplateService/GetMemoTemplate"
+	// MemoTemplateServiceCreateMemoTemplateProcedure is the fully-qualified name of the
+	// MemoTemplateService's CreateMemoTemplate RPC.
+	MemoTemplateServiceCreateMemoTemplateProcedure = "/memos.api.v1.MemoTemplateService/CreateMemoTemplate"
+	// MemoTemplateServiceUpdateMemoTemplateProcedure is the fully-qualified name of the
+	// MemoTemplateService's UpdateMemoTemplate RPC.
+	MemoTemplateServiceUpdateMemoTemplateProcedure = "/memos.api.v1.MemoTemplateService/UpdateMemoTemplate"
+	// MemoTemplateServiceDeleteMemoTemplateProcedure is the fully-qualified name of the
+	// MemoTemplateService's DeleteMemoTemplate RPC.
+	MemoTemplateServiceDeleteMemoTemplateProcedure = "/memos.api.v1.MemoTemplateService/DeleteMemoTemplate"
+	// MemoTemplateServiceReorderMemoTemplatesProcedure is the fully-qualified name of the
+	// MemoTemplateService's ReorderMemoTemplates RPC.
+	MemoTemplateServiceReorderMemoTemplatesProcedure = "/memos.api.v1.MemoTemplateService/ReorderMemoTemplates"
+	// MemoTemplateServiceCreateMemoFromTemplateProcedure is the fully-qualified name of the
+	// MemoTemplateService's CreateMemoFromTemplate RPC.
+	MemoTemplateServiceCreateMemoFromTemplateProcedure = "/memos.api.v1.MemoTemplateService/CreateMemoFromTemplate"
+)
+
+// MemoTemplateServiceClient is a client for the memos.api.v1.MemoTemplateService service.
+type MemoTemplateServiceClient interface {
+	// ListMemoTemplates returns the memo templates of a user, in the order of the user.
+	ListMemoTemplates(context.Context, *connect.Request[v1.ListMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error)
+	// GetMemoTemplate gets a memo template by name.
+	GetMemoTemplate(context.Context, *connect.Request[v1.GetMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error)
+	// CreateMemoTemplate creates a memo template for a user, after its other templates.
+	CreateMemoTemplate(context.Context, *connect.Request[v1.CreateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error)
+	// UpdateMemoTemplate updates a memo template of a user.
+	UpdateMemoTemplate(context.Context, *connect.Request[v1.UpdateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error)
+	// DeleteMemoTemplate deletes a memo template of a user.
+	DeleteMemoTemplate(context.Context, *connect.Request[v1.DeleteMemoTemplateRequest]) (*connect.Response[emptypb.Empty], error)
+	// ReorderMemoTemplates sets the order of the memo templates of a user.
+	ReorderMemoTemplates(context.Context, *connect.Request[v1.ReorderMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error)
+	// CreateMemoFromTemplate creates a memo from a template, with its placeholders expanded in the
+	// time zone of the user and its default tags.
+	CreateMemoFromTemplate(context.Context, *connect.Request[v1.CreateMemoFromTemplateRequest]) (*connect.Response[v1.Memo], error)
+}
+
+// NewMemoTemplateServiceClient constructs a client for the memos.api.v1.MemoTemplateService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMemoTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MemoTemplateServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	memoTemplateServiceMethods := v1.File_api_v1_memo_template_service_proto.Services().ByName("MemoTemplateService").Methods()
+	return &memoTemplateServiceClient{
+		listMemoTemplates: connect.NewClient[v1.ListMemoTemplatesRequest, v1.ListMemoTemplatesResponse](
+			httpClient,
+			baseURL+MemoTemplateServiceListMemoTemplatesProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("ListMemoTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoTemplate: connect.NewClient[v1.GetMemoTemplateRequest, v1.MemoTemplate](
+			httpClient,
+			baseURL+MemoTemplateServiceGetMemoTemplateProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("GetMemoTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		createMemoTemplate: connect.NewClient[v1.CreateMemoTemplateRequest, v1.MemoTemplate](
+			httpClient,
+			baseURL+MemoTemplateServiceCreateMemoTemplateProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("CreateMemoTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemoTemplate: connect.NewClient[v1.UpdateMemoTemplateRequest, v1.MemoTemplate](
+			httpClient,
+			baseURL+MemoTemplateServiceUpdateMemoTemplateProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("UpdateMemoTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMemoTemplate: connect.NewClient[v1.DeleteMemoTemplateRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MemoTemplateServiceDeleteMemoTemplateProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("DeleteMemoTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderMemoTemplates: connect.NewClient[v1.ReorderMemoTemplatesRequest, v1.ListMemoTemplatesResponse](
+			httpClient,
+			baseURL+MemoTemplateServiceReorderMemoTemplatesProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("ReorderMemoTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		createMemoFromTemplate: connect.NewClient[v1.CreateMemoFromTemplateRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoTemplateServiceCreateMemoFromTemplateProcedure,
+			connect.WithSchema(memoTemplateServiceMethods.ByName("CreateMemoFromTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// memoTemplateServiceClient implements MemoTemplateServiceClient.
+type memoTemplateServiceClient struct {
+	listMemoTemplates      *connect.Client[v1.ListMemoTemplatesRequest, v1.ListMemoTemplatesResponse]
+	getMemoTemplate        *connect.Client[v1.GetMemoTemplateRequest, v1.MemoTemplate]
+	createMemoTemplate     *connect.Client[v1.CreateMemoTemplateRequest, v1.MemoTemplate]
+	updateMemoTemplate     *connect.Client[v1.UpdateMemoTemplateRequest, v1.MemoTemplate]
+	deleteMemoTemplate     *connect.Client[v1.DeleteMemoTemplateRequest, emptypb.Empty]
+	reorderMemoTemplates   *connect.Client[v1.ReorderMemoTemplatesRequest, v1.ListMemoTemplatesResponse]
+	createMemoFromTemplate *connect.Client[v1.CreateMemoFromTemplateRequest, v1.Memo]
+}
+
+// ListMemoTemplates calls memos.api.v1.MemoTemplateService.ListMemoTemplates.
+func (c *memoTemplateServiceClient) ListMemoTemplates(ctx context.Context, req *connect.Request[v1.ListMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error) {
+	return c.listMemoTemplates.CallUnary(ctx, req)
+}
+
+// GetMemoTemplate calls memos.api.v1.MemoTemplateService.GetMemoTemplate.
+func (c *memoTemplateServiceClient) GetMemoTemplate(ctx context.Context, req *connect.Request[v1.GetMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error) {
+	return c.getMemoTemplate.CallUnary(ctx, req)
+}
+
+// CreateMemoTemplate calls memos.api.v1.MemoTemplateService.CreateMemoTemplate.
+func (c *memoTemplateServiceClient) CreateMemoTemplate(ctx context.Context, req *connect.Request[v1.CreateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error) {
+	return c.createMemoTemplate.CallUnary(ctx, req)
+}
+
+// UpdateMemoTemplate calls memos.api.v1.MemoTemplateService.UpdateMemoTemplate.
+func (c *memoTemplateServiceClient) UpdateMemoTemplate(ctx context.Context, req *connect.Request[v1.UpdateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error) {
+	return c.updateMemoTemplate.CallUnary(ctx, req)
+}
+
+// DeleteMemoTemplate calls memos.api.v1.MemoTemplateService.DeleteMemoTemplate.
+func (c *memoTemplateServiceClient) DeleteMemoTemplate(ctx context.Context, req *connect.Request[v1.DeleteMemoTemplateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteMemoTemplate.CallUnary(ctx, req)
+}
+
+// ReorderMemoTemplates calls memos.api.v1.MemoTemplateService.ReorderMemoTemplates.
+func (c *memoTemplateServiceClient) ReorderMemoTemplates(ctx context.Context, req *connect.Request[v1.ReorderMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error) {
+	return c.reorderMemoTemplates.CallUnary(ctx, req)
+}
+
+// CreateMemoFromTemplate calls memos.api.v1.MemoTemplateService.CreateMemoFromTemplate.
+func (c *memoTemplateServiceClient) CreateMemoFromTemplate(ctx context.Context, req *connect.Request[v1.CreateMemoFromTemplateRequest]) (*connect.Response[v1.Memo], error) {
+	return c.createMemoFromTemplate.CallUnary(ctx, req)
+}
+
+// MemoTemplateServiceHandler is an implementation of the memos.api.v1.MemoTemplateService service.
+type MemoTemplateServiceHandler interface {
+	// ListMemoTemplates returns the memo templates of a user, in the order of the user.
+	ListMemoTemplates(context.Context, *connect.Request[v1.ListMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error)
+	// GetMemoTemplate gets a memo template by name.
+	GetMemoTemplate(context.Context, *connect.Request[v1.GetMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error)
+	// CreateMemoTemplate creates a memo template for a user, after its other templates.
+	CreateMemoTemplate(context.Context, *connect.Request[v1.CreateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error)
+	// UpdateMemoTemplate updates a memo template of a user.
+	UpdateMemoTemplate(context.Context, *connect.Request[v1.UpdateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error)
+	// DeleteMemoTemplate deletes a memo template of a user.
+	DeleteMemoTemplate(context.Context, *connect.Request[v1.DeleteMemoTemplateRequest]) (*connect.Response[emptypb.Empty], error)
+	// ReorderMemoTemplates sets the order of the memo templates of a user.
+	ReorderMemoTemplates(context.Context, *connect.Request[v1.ReorderMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error)
+	// CreateMemoFromTemplate creates a memo from a template, with its placeholders expanded in the
+	// time zone of the user and its default tags.
+	CreateMemoFromTemplate(context.Context, *connect.Request[v1.CreateMemoFromTemplateRequest]) (*connect.Response[v1.Memo], error)
+}
+
+// NewMemoTemplateServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMemoTemplateServiceHandler(svc MemoTemplateServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	memoTemplateServiceMethods := v1.File_api_v1_memo_template_service_proto.Services().ByName("MemoTemplateService").Methods()
+	memoTemplateServiceListMemoTemplatesHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceListMemoTemplatesProcedure,
+		svc.ListMemoTemplates,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("ListMemoTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoTemplateServiceGetMemoTemplateHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceGetMemoTemplateProcedure,
+		svc.GetMemoTemplate,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("GetMemoTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoTemplateServiceCreateMemoTemplateHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceCreateMemoTemplateProcedure,
+		svc.CreateMemoTemplate,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("CreateMemoTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoTemplateServiceUpdateMemoTemplateHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceUpdateMemoTemplateProcedure,
+		svc.UpdateMemoTemplate,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("UpdateMemoTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoTemplateServiceDeleteMemoTemplateHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceDeleteMemoTemplateProcedure,
+		svc.DeleteMemoTemplate,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("DeleteMemoTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoTemplateServiceReorderMemoTemplatesHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceReorderMemoTemplatesProcedure,
+		svc.ReorderMemoTemplates,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("ReorderMemoTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoTemplateServiceCreateMemoFromTemplateHandler := connect.NewUnaryHandler(
+		MemoTemplateServiceCreateMemoFromTemplateProcedure,
+		svc.CreateMemoFromTemplate,
+		connect.WithSchema(memoTemplateServiceMethods.ByName("CreateMemoFromTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/memos.api.v1.MemoTemplateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MemoTemplateServiceListMemoTemplatesProcedure:
+			memoTemplateServiceListMemoTemplatesHandler.ServeHTTP(w, r)
+		case MemoTemplateServiceGetMemoTemplateProcedure:
+			memoTemplateServiceGetMemoTemplateHandler.ServeHTTP(w, r)
+		case MemoTemplateServiceCreateMemoTemplateProcedure:
+			memoTemplateServiceCreateMemoTemplateHandler.ServeHTTP(w, r)
+		case MemoTemplateServiceUpdateMemoTemplateProcedure:
+			memoTemplateServiceUpdateMemoTemplateHandler.ServeHTTP(w, r)
+		case MemoTemplateServiceDeleteMemoTemplateProcedure:
+			memoTemplateServiceDeleteMemoTemplateHandler.ServeHTTP(w, r)
+		case MemoTemplateServiceReorderMemoTemplatesProcedure:
+			memoTemplateServiceReorderMemoTemplatesHandler.ServeHTTP(w, r)
+		case MemoTemplateServiceCreateMemoFromTemplateProcedure:
+			memoTemplateServiceCreateMemoFromTemplateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMemoTemplateServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMemoTemplateServiceHandler struct{}
+
+func (UnimplementedMemoTemplateServiceHandler) ListMemoTemplates(context.Context, *connect.Request[v1.ListMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.ListMemoTemplates is not implemented"))
+}
+
+func (UnimplementedMemoTemplateServiceHandler) GetMemoTemplate(context.Context, *connect.Request[v1.GetMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.GetMemoTemplate is not implemented"))
+}
+
+func (UnimplementedMemoTemplateServiceHandler) CreateMemoTemplate(context.Context, *connect.Request[v1.CreateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.CreateMemoTemplate is not implemented"))
+}
+
+func (UnimplementedMemoTemplateServiceHandler) UpdateMemoTemplate(context.Context, *connect.Request[v1.UpdateMemoTemplateRequest]) (*connect.Response[v1.MemoTemplate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.UpdateMemoTemplate is not implemented"))
+}
+
+func (UnimplementedMemoTemplateServiceHandler) DeleteMemoTemplate(context.Context, *connect.Request[v1.DeleteMemoTemplateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.DeleteMemoTemplate is not implemented"))
+}
+
+func (UnimplementedMemoTemplateServiceHandler) ReorderMemoTemplates(context.Context, *connect.Request[v1.ReorderMemoTemplatesRequest]) (*connect.Response[v1.ListMemoTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.ReorderMemoTemplates is not implemented"))
+}
+
+func (UnimplementedMemoTemplateServiceHandler) CreateMemoFromTemplate(context.Context, *connect.Request[v1.CreateMemoFromTemplateRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoTemplateService.CreateMemoFromTemplate is not implemented"))
+}
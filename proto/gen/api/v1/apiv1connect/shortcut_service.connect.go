@@ -49,6 +49,12 @@ const (
 	// ShortcutServiceDeleteShortcutProcedure is the fully-qualified name of the ShortcutService's
 	// DeleteShortcut RPC.
 	ShortcutServiceDeleteShortcutProcedure = "/memos.api.v1.ShortcutService/DeleteShortcut"
+	// ShortcutServiceReorderShortcutsProcedure is the fully-qualified name of the ShortcutService's
+	// ReorderShortcuts RPC.
+	ShortcutServiceReorderShortcutsProcedure = "/memos.api.v1.ShortcutService/ReorderShortcuts"
+	// ShortcutServiceCountShortcutMemosProcedure is the fully-qualified name of the ShortcutService's
+	// CountShortcutMemos RPC.
+	ShortcutServiceCountShortcutMemosProcedure = "/memos.api.v1.ShortcutService/CountShortcutMemos"
 )
 
 // ShortcutServiceClient is a client for the memos.api.v1.ShortcutService service.
@@ -63,6 +69,10 @@ type ShortcutServiceClient interface {
 	UpdateShortcut(context.Context, *connect.Request[v1.UpdateShortcutRequest]) (*connect.Response[v1.Shortcut], error)
 	// DeleteShortcut deletes a shortcut for a user.
 	DeleteShortcut(context.Context, *connect.Request[v1.DeleteShortcutRequest]) (*connect.Response[emptypb.Empty], error)
+	// ReorderShortcuts sets the order of the shortcuts of a user.
+	ReorderShortcuts(context.Context, *connect.Request[v1.ReorderShortcutsRequest]) (*connect.Response[v1.ListShortcutsResponse], error)
+	// CountShortcutMemos counts the memos of each shortcut of a user, without listing them.
+	CountShortcutMemos(context.Context, *connect.Request[v1.CountShortcutMemosRequest]) (*connect.Response[v1.CountShortcutMemosResponse], error)
 }
 
 // NewShortcutServiceClient constructs a client for the memos.api.v1.ShortcutService service. By
@@ -106,16 +116,30 @@ func NewShortcutServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(shortcutServiceMethods.ByName("DeleteShortcut")),
 			connect.WithClientOptions(opts...),
 		),
+		reorderShortcuts: connect.NewClient[v1.ReorderShortcutsRequest, v1.ListShortcutsResponse](
+			httpClient,
+			baseURL+ShortcutServiceReorderShortcutsProcedure,
+			connect.WithSchema(shortcutServiceMethods.ByName("ReorderShortcuts")),
+			connect.WithClientOptions(opts...),
+		),
+		countShortcutMemos: connect.NewClient[v1.CountShortcutMemosRequest, v1.CountShortcutMemosResponse](
+			httpClient,
+			baseURL+ShortcutServiceCountShortcutMemosProcedure,
+			connect.WithSchema(shortcutServiceMethods.ByName("CountShortcutMemos")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // shortcutServiceClient implements ShortcutServiceClient.
 type shortcutServiceClient struct {
-	listShortcuts  *connect.Client[v1.ListShortcutsRequest, v1.ListShortcutsResponse]
-	getShortcut    *connect.Client[v1.GetShortcutRequest, v1.Shortcut]
-	createShortcut *connect.Client[v1.CreateShortcutRequest, v1.Shortcut]
-	updateShortcut *connect.Client[v1.UpdateShortcutRequest, v1.Shortcut]
-	deleteShortcut *connect.Client[v1.DeleteShortcutRequest, emptypb.Empty]
+	listShortcuts      *connect.Client[v1.ListShortcutsRequest, v1.ListShortcutsResponse]
+	getShortcut        *connect.Client[v1.GetShortcutRequest, v1.Shortcut]
+	createShortcut     *connect.Client[v1.CreateShortcutRequest, v1.Shortcut]
+	updateShortcut     *connect.Client[v1.UpdateShortcutRequest, v1.Shortcut]
+	deleteShortcut     *connect.Client[v1.DeleteShortcutRequest, emptypb.Empty]
+	reorderShortcuts   *connect.Client[v1.ReorderShortcutsRequest, v1.ListShortcutsResponse]
+	countShortcutMemos *connect.Client[v1.CountShortcutMemosRequest, v1.CountShortcutMemosResponse]
 }
 
 // ListShortcuts calls memos.api.v1.ShortcutService.ListShortcuts.
@@ -143,6 +167,16 @@ func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, req *connect
 	return c.deleteShortcut.CallUnary(ctx, req)
 }
 
+// ReorderShortcuts calls memos.api.v1.ShortcutService.ReorderShortcuts.
+func (c *shortcutServiceClient) ReorderShortcuts(ctx context.Context, req *connect.Request[v1.ReorderShortcutsRequest]) (*connect.Response[v1.ListShortcutsResponse], error) {
+	return c.reorderShortcuts.CallUnary(ctx, req)
+}
+
+// CountShortcutMemos calls memos.api.v1.ShortcutService.CountShortcutMemos.
+func (c *shortcutServiceClient) CountShortcutMemos(ctx context.Context, req *connect.Request[v1.CountShortcutMemosRequest]) (*connect.Response[v1.CountShortcutMemosResponse], error) {
+	return c.countShortcutMemos.CallUnary(ctx, req)
+}
+
 // ShortcutServiceHandler is an implementation of the memos.api.v1.ShortcutService service.
 type ShortcutServiceHandler interface {
 	// ListShortcuts returns a list of shortcuts for a user.
@@ -155,6 +189,10 @@ type ShortcutServiceHandler interface {
 	UpdateShortcut(context.Context, *connect.Request[v1.UpdateShortcutRequest]) (*connect.Response[v1.Shortcut], error)
 	// DeleteShortcut deletes a shortcut for a user.
 	DeleteShortcut(context.Context, *connect.Request[v1.DeleteShortcutRequest]) (*connect.Response[emptypb.Empty], error)
+	// ReorderShortcuts sets the order of the shortcuts of a user.
+	ReorderShortcuts(context.Context, *connect.Request[v1.ReorderShortcutsRequest]) (*connect.Response[v1.ListShortcutsResponse], error)
+	// CountShortcutMemos counts the memos of each shortcut of a user, without listing them.
+	CountShortcutMemos(context.Context, *connect.Request[v1.CountShortcutMemosRequest]) (*connect.Response[v1.CountShortcutMemosResponse], error)
 }
 
 // NewShortcutServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -194,6 +232,18 @@ func NewShortcutServiceHandler(svc ShortcutServiceHandler, opts ...connect.Handl
 		connect.WithSchema(shortcutServiceMethods.ByName("DeleteShortcut")),
 		connect.WithHandlerOptions(opts...),
 	)
+	shortcutServiceReorderShortcutsHandler := connect.NewUnaryHandler(
+		ShortcutServiceReorderShortcutsProcedure,
+		svc.ReorderShortcuts,
+		connect.WithSchema(shortcutServiceMethods.ByName("ReorderShortcuts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shortcutServiceCountShortcutMemosHandler := connect.NewUnaryHandler(
+		ShortcutServiceCountShortcutMemosProcedure,
+		svc.CountShortcutMemos,
+		connect.WithSchema(shortcutServiceMethods.ByName("CountShortcutMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.ShortcutService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ShortcutServiceListShortcutsProcedure:
@@ -206,6 +256,10 @@ func NewShortcutServiceHandler(svc ShortcutServiceHandler, opts ...connect.Handl
 			shortcutServiceUpdateShortcutHandler.ServeHTTP(w, r)
 		case ShortcutServiceDeleteShortcutProcedure:
 			shortcutServiceDeleteShortcutHandler.ServeHTTP(w, r)
+		case ShortcutServiceReorderShortcutsProcedure:
+			shortcutServiceReorderShortcutsHandler.ServeHTTP(w, r)
+		case ShortcutServiceCountShortcutMemosProcedure:
+			shortcutServiceCountShortcutMemosHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -234,3 +288,11 @@ func (UnimplementedShortcutServiceHandler) UpdateShortcut(context.Context, *conn
 func (UnimplementedShortcutServiceHandler) DeleteShortcut(context.Context, *connect.Request[v1.DeleteShortcutRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.ShortcutService.DeleteShortcut is not implemented"))
 }
+
+func (UnimplementedShortcutServiceHandler) ReorderShortcuts(context.Context, *connect.Request[v1.ReorderShortcutsRequest]) (*connect.Response[v1.ListShortcutsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.ShortcutService.ReorderShortcuts is not implemented"))
+}
+
+func (UnimplementedShortcutServiceHandler) CountShortcutMemos(context.Context, *connect.Request[v1.CountShortcutMemosRequest]) (*connect.Response[v1.CountShortcutMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.ShortcutService.CountShortcutMemos is not implemented"))
+}
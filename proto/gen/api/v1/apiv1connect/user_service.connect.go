@@ -50,6 +50,9 @@ const (
 	// UserServiceGetUserStatsProcedure is the fully-qualified name of the UserService's GetUserStats
 	// RPC.
 	UserServiceGetUserStatsProcedure = "/memos.api.v1.UserService/GetUserStats"
+	// UserServiceGetUserWritingStatsProcedure is the fully-qualified name of the UserService's
+	// GetUserWritingStats RPC.
+	UserServiceGetUserWritingStatsProcedure = "/memos.api.v1.UserService/GetUserWritingStats"
 	// UserServiceGetUserSettingProcedure is the fully-qualified name of the UserService's
 	// GetUserSetting RPC.
 	UserServiceGetUserSettingProcedure = "/memos.api.v1.UserService/GetUserSetting"
@@ -74,6 +77,85 @@ const (
 	// UserServiceRevokeUserSessionProcedure is the fully-qualified name of the UserService's
 	// RevokeUserSession RPC.
 	UserServiceRevokeUserSessionProcedure = "/memos.api.v1.UserService/RevokeUserSession"
+	// UserServiceRevokeOtherUserSessionsProcedure is the fully-qualified name of the UserService's
+	// RevokeOtherUserSessions RPC.
+	UserServiceRevokeOtherUserSessionsProcedure = "/memos.api.v1.UserService/RevokeOtherUserSessions"
+	// UserServiceGetUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// GetUserTwoFactor RPC.
+	UserServiceGetUserTwoFactorProcedure = "/memos.api.v1.UserService/GetUserTwoFactor"
+	// UserServiceEnrollUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// EnrollUserTwoFactor RPC.
+	UserServiceEnrollUserTwoFactorProcedure = "/memos.api.v1.UserService/EnrollUserTwoFactor"
+	// UserServiceConfirmUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// ConfirmUserTwoFactor RPC.
+	UserServiceConfirmUserTwoFactorProcedure = "/memos.api.v1.UserService/ConfirmUserTwoFactor"
+	// UserServiceDeleteUserTwoFactorProcedure is the fully-qualified name of the UserService's
+	// DeleteUserTwoFactor RPC.
+	UserServiceDeleteUserTwoFactorProcedure = "/memos.api.v1.UserService/DeleteUserTwoFactor"
+	// UserServiceListUserPasskeysProcedure is the fully-qualified name of the UserService's
+	// ListUserPasskeys RPC.
+	UserServiceListUserPasskeysProcedure = "/memos.api.v1.UserService/ListUserPasskeys"
+	// UserServiceCreateUserPasskeyOptionsProcedure is the fully-qualified name of the UserService's
+	// CreateUserPasskeyOptions RPC.
+	UserServiceCreateUserPasskeyOptionsProcedure = "/memos.api.v1.UserService/CreateUserPasskeyOptions"
+	// UserServiceCreateUserPasskeyProcedure is the fully-qualified name of the UserService's
+	// CreateUserPasskey RPC.
+	UserServiceCreateUserPasskeyProcedure = "/memos.api.v1.UserService/CreateUserPasskey"
+	// UserServiceDeleteUserPasskeyProcedure is the fully-qualified name of the UserService's
+	// DeleteUserPasskey RPC.
+	UserServiceDeleteUserPasskeyProcedure = "/memos.api.v1.UserService/DeleteUserPasskey"
+	// UserServiceListUserPushSubscriptionsProcedure is the fully-qualified name of the UserService's
+	// ListUserPushSubscriptions RPC.
+	UserServiceListUserPushSubscriptionsProcedure = "/memos.api.v1.UserService/ListUserPushSubscriptions"
+	// UserServiceCreateUserPushSubscriptionProcedure is the fully-qualified name of the UserService's
+	// CreateUserPushSubscription RPC.
+	UserServiceCreateUserPushSubscriptionProcedure = "/memos.api.v1.UserService/CreateUserPushSubscription"
+	// UserServiceDeleteUserPushSubscriptionProcedure is the fully-qualified name of the UserService's
+	// DeleteUserPushSubscription RPC.
+	UserServiceDeleteUserPushSubscriptionProcedure = "/memos.api.v1.UserService/DeleteUserPushSubscription"
+	// UserServiceGetUserFeedTokenProcedure is the fully-qualified name of the UserService's
+	// GetUserFeedToken RPC.
+	UserServiceGetUserFeedTokenProcedure = "/memos.api.v1.UserService/GetUserFeedToken"
+	// UserServiceRotateUserFeedTokenProcedure is the fully-qualified name of the UserService's
+	// RotateUserFeedToken RPC.
+	UserServiceRotateUserFeedTokenProcedure = "/memos.api.v1.UserService/RotateUserFeedToken"
+	// UserServiceDeleteUserFeedTokenProcedure is the fully-qualified name of the UserService's
+	// DeleteUserFeedToken RPC.
+	UserServiceDeleteUserFeedTokenProcedure = "/memos.api.v1.UserService/DeleteUserFeedToken"
+	// UserServiceGetUserInboundEmailProcedure is the fully-qualified name of the UserService's
+	// GetUserInboundEmail RPC.
+	UserServiceGetUserInboundEmailProcedure = "/memos.api.v1.UserService/GetUserInboundEmail"
+	// UserServiceRotateUserInboundEmailProcedure is the fully-qualified name of the UserService's
+	// RotateUserInboundEmail RPC.
+	UserServiceRotateUserInboundEmailProcedure = "/memos.api.v1.UserService/RotateUserInboundEmail"
+	// UserServiceDeleteUserInboundEmailProcedure is the fully-qualified name of the UserService's
+	// DeleteUserInboundEmail RPC.
+	UserServiceDeleteUserInboundEmailProcedure = "/memos.api.v1.UserService/DeleteUserInboundEmail"
+	// UserServiceGetUserSlackLinkProcedure is the fully-qualified name of the UserService's
+	// GetUserSlackLink RPC.
+	UserServiceGetUserSlackLinkProcedure = "/memos.api.v1.UserService/GetUserSlackLink"
+	// UserServiceGenerateUserSlackLinkCodeProcedure is the fully-qualified name of the UserService's
+	// GenerateUserSlackLinkCode RPC.
+	UserServiceGenerateUserSlackLinkCodeProcedure = "/memos.api.v1.UserService/GenerateUserSlackLinkCode"
+	// UserServiceDeleteUserSlackLinkProcedure is the fully-qualified name of the UserService's
+	// DeleteUserSlackLink RPC.
+	UserServiceDeleteUserSlackLinkProcedure = "/memos.api.v1.UserService/DeleteUserSlackLink"
+	// UserServiceGetUserMatrixLinkProcedure is the fully-qualified name of the UserService's
+	// GetUserMatrixLink RPC.
+	UserServiceGetUserMatrixLinkProcedure = "/memos.api.v1.UserService/GetUserMatrixLink"
+	// UserServiceGenerateUserMatrixLinkCodeProcedure is the fully-qualified name of the UserService's
+	// GenerateUserMatrixLinkCode RPC.
+	UserServiceGenerateUserMatrixLinkCodeProcedure = "/memos.api.v1.UserService/GenerateUserMatrixLinkCode"
+	// UserServiceDeleteUserMatrixLinkProcedure is the fully-qualified name of the UserService's
+	// DeleteUserMatrixLink RPC.
+	UserServiceDeleteUserMatrixLinkProcedure = "/memos.api.v1.UserService/DeleteUserMatrixLink"
+	// UserServiceUnlockUserProcedure is the fully-qualified name of the UserService's UnlockUser RPC.
+	UserServiceUnlockUserProcedure = "/memos.api.v1.UserService/UnlockUser"
+	// UserServiceSuspendUserProcedure is the fully-qualified name of the UserService's SuspendUser RPC.
+	UserServiceSuspendUserProcedure = "/memos.api.v1.UserService/SuspendUser"
+	// UserServiceUnsuspendUserProcedure is the fully-qualified name of the UserService's UnsuspendUser
+	// RPC.
+	UserServiceUnsuspendUserProcedure = "/memos.api.v1.UserService/UnsuspendUser"
 	// UserServiceListUserWebhooksProcedure is the fully-qualified name of the UserService's
 	// ListUserWebhooks RPC.
 	UserServiceListUserWebhooksProcedure = "/memos.api.v1.UserService/ListUserWebhooks"
@@ -86,6 +168,18 @@ const (
 	// UserServiceDeleteUserWebhookProcedure is the fully-qualified name of the UserService's
 	// DeleteUserWebhook RPC.
 	UserServiceDeleteUserWebhookProcedure = "/memos.api.v1.UserService/DeleteUserWebhook"
+	// UserServiceRotateUserWebhookSecretProcedure is the fully-qualified name of the UserService's
+	// RotateUserWebhookSecret RPC.
+	UserServiceRotateUserWebhookSecretProcedure = "/memos.api.v1.UserService/RotateUserWebhookSecret"
+	// UserServiceTestUserWebhookProcedure is the fully-qualified name of the UserService's
+	// TestUserWebhook RPC.
+	UserServiceTestUserWebhookProcedure = "/memos.api.v1.UserService/TestUserWebhook"
+	// UserServiceListUserWebhookDeliveriesProcedure is the fully-qualified name of the UserService's
+	// ListUserWebhookDeliveries RPC.
+	UserServiceListUserWebhookDeliveriesProcedure = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	// UserServiceRedeliverUserWebhookDeliveryProcedure is the fully-qualified name of the UserService's
+	// RedeliverUserWebhookDelivery RPC.
+	UserServiceRedeliverUserWebhookDeliveryProcedure = "/memos.api.v1.UserService/RedeliverUserWebhookDelivery"
 	// UserServiceListUserNotificationsProcedure is the fully-qualified name of the UserService's
 	// ListUserNotifications RPC.
 	UserServiceListUserNotificationsProcedure = "/memos.api.v1.UserService/ListUserNotifications"
@@ -116,6 +210,9 @@ type UserServiceClient interface {
 	ListAllUserStats(context.Context, *connect.Request[v1.ListAllUserStatsRequest]) (*connect.Response[v1.ListAllUserStatsResponse], error)
 	// GetUserStats returns statistics for a specific user.
 	GetUserStats(context.Context, *connect.Request[v1.GetUserStatsRequest]) (*connect.Response[v1.UserStats], error)
+	// GetUserWritingStats returns the writing statistics of a user.
+	// Users can only get their own, except for the host.
+	GetUserWritingStats(context.Context, *connect.Request[v1.GetUserWritingStatsRequest]) (*connect.Response[v1.UserWritingStats], error)
 	// GetUserSetting returns the user setting.
 	GetUserSetting(context.Context, *connect.Request[v1.GetUserSettingRequest]) (*connect.Response[v1.UserSetting], error)
 	// UpdateUserSetting updates the user setting.
@@ -132,7 +229,72 @@ type UserServiceClient interface {
 	ListUserSessions(context.Context, *connect.Request[v1.ListUserSessionsRequest]) (*connect.Response[v1.ListUserSessionsResponse], error)
 	// RevokeUserSession revokes a specific session for a user.
 	RevokeUserSession(context.Context, *connect.Request[v1.RevokeUserSessionRequest]) (*connect.Response[emptypb.Empty], error)
-	// ListUserWebhooks returns a list of webhooks for a user.
+	// RevokeOtherUserSessions revokes all sessions of a user except the current one.
+	RevokeOtherUserSessions(context.Context, *connect.Request[v1.RevokeOtherUserSessionsRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error)
+	// EnrollUserTwoFactor generates a new TOTP secret for a user.
+	// Two-factor authentication is enabled once ConfirmUserTwoFactor verifies a code.
+	EnrollUserTwoFactor(context.Context, *connect.Request[v1.EnrollUserTwoFactorRequest]) (*connect.Response[v1.EnrollUserTwoFactorResponse], error)
+	// ConfirmUserTwoFactor verifies a code for a pending enrollment and enables two-factor authentication.
+	ConfirmUserTwoFactor(context.Context, *connect.Request[v1.ConfirmUserTwoFactorRequest]) (*connect.Response[v1.ConfirmUserTwoFactorResponse], error)
+	// DeleteUserTwoFactor disables two-factor authentication for a user.
+	// Admins can use it to reset two-factor authentication for a locked-out user.
+	DeleteUserTwoFactor(context.Context, *connect.Request[v1.DeleteUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPasskeys returns the passkeys registered by a user.
+	ListUserPasskeys(context.Context, *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error)
+	// CreateUserPasskeyOptions starts a passkey registration.
+	// Returns the options for navigator.credentials.create(); the credential is sent back in CreateUserPasskey.
+	CreateUserPasskeyOptions(context.Context, *connect.Request[v1.CreateUserPasskeyOptionsRequest]) (*connect.Response[v1.UserPasskeyOptions], error)
+	// CreateUserPasskey registers a passkey for a user.
+	CreateUserPasskey(context.Context, *connect.Request[v1.CreateUserPasskeyRequest]) (*connect.Response[v1.UserPasskey], error)
+	// DeleteUserPasskey removes a passkey of a user.
+	DeleteUserPasskey(context.Context, *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPushSubscriptions returns the Web Push subscriptions of the devices of a user.
+	ListUserPushSubscriptions(context.Context, *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error)
+	// CreateUserPushSubscription registers the Web Push subscription of a device of a user.
+	// Registering the endpoint of an existing subscription again updates it.
+	CreateUserPushSubscription(context.Context, *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error)
+	// DeleteUserPushSubscription revokes the Web Push subscription of a device of a user.
+	DeleteUserPushSubscription(context.Context, *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserFeedToken returns whether a user has a feed token.
+	GetUserFeedToken(context.Context, *connect.Request[v1.GetUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error)
+	// RotateUserFeedToken generates a new feed token for a user, replacing the current one.
+	// The token is only returned in the response.
+	RotateUserFeedToken(context.Context, *connect.Request[v1.RotateUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error)
+	// DeleteUserFeedToken revokes the feed token of a user.
+	DeleteUserFeedToken(context.Context, *connect.Request[v1.DeleteUserFeedTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserInboundEmail gets the inbound email address of a user.
+	GetUserInboundEmail(context.Context, *connect.Request[v1.GetUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error)
+	// RotateUserInboundEmail generates a new inbound email address for a user, replacing the
+	// current one, whose emails are dropped.
+	RotateUserInboundEmail(context.Context, *connect.Request[v1.RotateUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error)
+	// DeleteUserInboundEmail removes the inbound email address of a user.
+	DeleteUserInboundEmail(context.Context, *connect.Request[v1.DeleteUserInboundEmailRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserSlackLink gets the Slack account linked to a user.
+	GetUserSlackLink(context.Context, *connect.Request[v1.GetUserSlackLinkRequest]) (*connect.Response[v1.UserSlackLink], error)
+	// GenerateUserSlackLinkCode generates a one-time code linking the Slack account it's sent
+	// from, in a direct message to the Slack app, to a user. It replaces the pending code, if any.
+	GenerateUserSlackLinkCode(context.Context, *connect.Request[v1.GenerateUserSlackLinkCodeRequest]) (*connect.Response[v1.UserSlackLink], error)
+	// DeleteUserSlackLink unlinks the Slack account of a user, and revokes the pending code.
+	DeleteUserSlackLink(context.Context, *connect.Request[v1.DeleteUserSlackLinkRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserMatrixLink gets the Matrix account linked to a user.
+	GetUserMatrixLink(context.Context, *connect.Request[v1.GetUserMatrixLinkRequest]) (*connect.Response[v1.UserMatrixLink], error)
+	// GenerateUserMatrixLinkCode generates a one-time code linking the Matrix account it's sent
+	// from, with "!memos link <code>" in a room of the bot, to a user. It replaces the pending code,
+	// if any.
+	GenerateUserMatrixLinkCode(context.Context, *connect.Request[v1.GenerateUserMatrixLinkCodeRequest]) (*connect.Response[v1.UserMatrixLink], error)
+	// DeleteUserMatrixLink unlinks the Matrix account of a user, and revokes the pending code.
+	DeleteUserMatrixLink(context.Context, *connect.Request[v1.DeleteUserMatrixLinkRequest]) (*connect.Response[emptypb.Empty], error)
+	// UnlockUser clears the failed sign-in attempts of a user, lifting a sign-in lock.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// SuspendUser suspends a user: the user can't sign in and the memos of the user are hidden from
+	// the others, but nothing is deleted. Only the host can suspend users.
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.User], error)
+	// UnsuspendUser lifts the suspension of a user. Only the host can unsuspend users.
+	UnsuspendUser(context.Context, *connect.Request[v1.UnsuspendUserRequest]) (*connect.Response[v1.User], error)
+	// ListUserWebhooks returns a list of webhooks for a user, or the workspace webhooks for the
+	// "instance" parent. Only hosts can manage the workspace webhooks.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
 	CreateUserWebhook(context.Context, *connect.Request[v1.CreateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
@@ -140,6 +302,18 @@ type UserServiceClient interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// RotateUserWebhookSecret generates a new signing secret for a webhook, replacing the current one.
+	// Webhooks without a secret start signing their deliveries.
+	RotateUserWebhookSecret(context.Context, *connect.Request[v1.RotateUserWebhookSecretRequest]) (*connect.Response[v1.UserWebhook], error)
+	// TestUserWebhook sends a test request to a webhook and returns the response of its endpoint.
+	// The request is validated and signed like deliveries, and carries the "test" event with a
+	// sample memo. Tests are rate-limited per user.
+	TestUserWebhook(context.Context, *connect.Request[v1.TestUserWebhookRequest]) (*connect.Response[v1.TestUserWebhookResponse], error)
+	// ListUserWebhookDeliveries lists the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error)
+	// RedeliverUserWebhookDelivery replays a delivery of a webhook, sending its payload again as a
+	// new delivery.
+	RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
@@ -201,6 +375,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetUserStats")),
 			connect.WithClientOptions(opts...),
 		),
+		getUserWritingStats: connect.NewClient[v1.GetUserWritingStatsRequest, v1.UserWritingStats](
+			httpClient,
+			baseURL+UserServiceGetUserWritingStatsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserWritingStats")),
+			connect.WithClientOptions(opts...),
+		),
 		getUserSetting: connect.NewClient[v1.GetUserSettingRequest, v1.UserSetting](
 			httpClient,
 			baseURL+UserServiceGetUserSettingProcedure,
@@ -249,6 +429,168 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("RevokeUserSession")),
 			connect.WithClientOptions(opts...),
 		),
+		revokeOtherUserSessions: connect.NewClient[v1.RevokeOtherUserSessionsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceRevokeOtherUserSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeOtherUserSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserTwoFactor: connect.NewClient[v1.GetUserTwoFactorRequest, v1.UserTwoFactor](
+			httpClient,
+			baseURL+UserServiceGetUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		enrollUserTwoFactor: connect.NewClient[v1.EnrollUserTwoFactorRequest, v1.EnrollUserTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceEnrollUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("EnrollUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmUserTwoFactor: connect.NewClient[v1.ConfirmUserTwoFactorRequest, v1.ConfirmUserTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceConfirmUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ConfirmUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserTwoFactor: connect.NewClient[v1.DeleteUserTwoFactorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserPasskeys: connect.NewClient[v1.ListUserPasskeysRequest, v1.ListUserPasskeysResponse](
+			httpClient,
+			baseURL+UserServiceListUserPasskeysProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserPasskeys")),
+			connect.WithClientOptions(opts...),
+		),
+		createUserPasskeyOptions: connect.NewClient[v1.CreateUserPasskeyOptionsRequest, v1.UserPasskeyOptions](
+			httpClient,
+			baseURL+UserServiceCreateUserPasskeyOptionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUserPasskeyOptions")),
+			connect.WithClientOptions(opts...),
+		),
+		createUserPasskey: connect.NewClient[v1.CreateUserPasskeyRequest, v1.UserPasskey](
+			httpClient,
+			baseURL+UserServiceCreateUserPasskeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUserPasskey")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserPasskey: connect.NewClient[v1.DeleteUserPasskeyRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserPasskeyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserPasskey")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserPushSubscriptions: connect.NewClient[v1.ListUserPushSubscriptionsRequest, v1.ListUserPushSubscriptionsResponse](
+			httpClient,
+			baseURL+UserServiceListUserPushSubscriptionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserPushSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		createUserPushSubscription: connect.NewClient[v1.CreateUserPushSubscriptionRequest, v1.UserPushSubscription](
+			httpClient,
+			baseURL+UserServiceCreateUserPushSubscriptionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateUserPushSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserPushSubscription: connect.NewClient[v1.DeleteUserPushSubscriptionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserPushSubscriptionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserPushSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserFeedToken: connect.NewClient[v1.GetUserFeedTokenRequest, v1.UserFeedToken](
+			httpClient,
+			baseURL+UserServiceGetUserFeedTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateUserFeedToken: connect.NewClient[v1.RotateUserFeedTokenRequest, v1.UserFeedToken](
+			httpClient,
+			baseURL+UserServiceRotateUserFeedTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RotateUserFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserFeedToken: connect.NewClient[v1.DeleteUserFeedTokenRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserFeedTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserFeedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserInboundEmail: connect.NewClient[v1.GetUserInboundEmailRequest, v1.UserInboundEmail](
+			httpClient,
+			baseURL+UserServiceGetUserInboundEmailProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserInboundEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateUserInboundEmail: connect.NewClient[v1.RotateUserInboundEmailRequest, v1.UserInboundEmail](
+			httpClient,
+			baseURL+UserServiceRotateUserInboundEmailProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RotateUserInboundEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserInboundEmail: connect.NewClient[v1.DeleteUserInboundEmailRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserInboundEmailProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserInboundEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserSlackLink: connect.NewClient[v1.GetUserSlackLinkRequest, v1.UserSlackLink](
+			httpClient,
+			baseURL+UserServiceGetUserSlackLinkProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserSlackLink")),
+			connect.WithClientOptions(opts...),
+		),
+		generateUserSlackLinkCode: connect.NewClient[v1.GenerateUserSlackLinkCodeRequest, v1.UserSlackLink](
+			httpClient,
+			baseURL+UserServiceGenerateUserSlackLinkCodeProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GenerateUserSlackLinkCode")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserSlackLink: connect.NewClient[v1.DeleteUserSlackLinkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserSlackLinkProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserSlackLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserMatrixLink: connect.NewClient[v1.GetUserMatrixLinkRequest, v1.UserMatrixLink](
+			httpClient,
+			baseURL+UserServiceGetUserMatrixLinkProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUserMatrixLink")),
+			connect.WithClientOptions(opts...),
+		),
+		generateUserMatrixLinkCode: connect.NewClient[v1.GenerateUserMatrixLinkCodeRequest, v1.UserMatrixLink](
+			httpClient,
+			baseURL+UserServiceGenerateUserMatrixLinkCodeProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GenerateUserMatrixLinkCode")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUserMatrixLink: connect.NewClient[v1.DeleteUserMatrixLinkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteUserMatrixLinkProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteUserMatrixLink")),
+			connect.WithClientOptions(opts...),
+		),
+		unlockUser: connect.NewClient[v1.UnlockUserRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceUnlockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UnlockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendUser: connect.NewClient[v1.SuspendUserRequest, v1.User](
+			httpClient,
+			baseURL+UserServiceSuspendUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SuspendUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unsuspendUser: connect.NewClient[v1.UnsuspendUserRequest, v1.User](
+			httpClient,
+			baseURL+UserServiceUnsuspendUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UnsuspendUser")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserWebhooks: connect.NewClient[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse](
 			httpClient,
 			baseURL+UserServiceListUserWebhooksProcedure,
@@ -273,6 +615,30 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateUserWebhookSecret: connect.NewClient[v1.RotateUserWebhookSecretRequest, v1.UserWebhook](
+			httpClient,
+			baseURL+UserServiceRotateUserWebhookSecretProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RotateUserWebhookSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		testUserWebhook: connect.NewClient[v1.TestUserWebhookRequest, v1.TestUserWebhookResponse](
+			httpClient,
+			baseURL+UserServiceTestUserWebhookProcedure,
+			connect.WithSchema(userServiceMethods.ByName("TestUserWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserWebhookDeliveries: connect.NewClient[v1.ListUserWebhookDeliveriesRequest, v1.ListUserWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+UserServiceListUserWebhookDeliveriesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverUserWebhookDelivery: connect.NewClient[v1.RedeliverUserWebhookDeliveryRequest, v1.UserWebhookDelivery](
+			httpClient,
+			baseURL+UserServiceRedeliverUserWebhookDeliveryProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RedeliverUserWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserNotifications: connect.NewClient[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse](
 			httpClient,
 			baseURL+UserServiceListUserNotificationsProcedure,
@@ -296,28 +662,60 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	listUsers              *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	getUser                *connect.Client[v1.GetUserRequest, v1.User]
-	createUser             *connect.Client[v1.CreateUserRequest, v1.User]
-	updateUser             *connect.Client[v1.UpdateUserRequest, v1.User]
-	deleteUser             *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
-	listAllUserStats       *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
-	getUserStats           *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
-	getUserSetting         *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
-	updateUserSetting      *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
-	listUserSettings       *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
-	listUserAccessTokens   *connect.Client[v1.ListUserAccessTokensRequest, v1.ListUserAccessTokensResponse]
-	createUserAccessToken  *connect.Client[v1.CreateUserAccessTokenRequest, v1.UserAccessToken]
-	deleteUserAccessToken  *connect.Client[v1.DeleteUserAccessTokenRequest, emptypb.Empty]
-	listUserSessions       *connect.Client[v1.ListUserSessionsRequest, v1.ListUserSessionsResponse]
-	revokeUserSession      *connect.Client[v1.RevokeUserSessionRequest, emptypb.Empty]
-	listUserWebhooks       *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
-	createUserWebhook      *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
-	updateUserWebhook      *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
-	deleteUserWebhook      *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
-	listUserNotifications  *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
-	updateUserNotification *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
-	deleteUserNotification *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
+	listUsers                    *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser                      *connect.Client[v1.GetUserRequest, v1.User]
+	createUser                   *connect.Client[v1.CreateUserRequest, v1.User]
+	updateUser                   *connect.Client[v1.UpdateUserRequest, v1.User]
+	deleteUser                   *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
+	listAllUserStats             *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
+	getUserStats                 *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
+	getUserWritingStats          *connect.Client[v1.GetUserWritingStatsRequest, v1.UserWritingStats]
+	getUserSetting               *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
+	updateUserSetting            *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
+	listUserSettings             *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
+	listUserAccessTokens         *connect.Client[v1.ListUserAccessTokensRequest, v1.ListUserAccessTokensResponse]
+	createUserAccessToken        *connect.Client[v1.CreateUserAccessTokenRequest, v1.UserAccessToken]
+	deleteUserAccessToken        *connect.Client[v1.DeleteUserAccessTokenRequest, emptypb.Empty]
+	listUserSessions             *connect.Client[v1.ListUserSessionsRequest, v1.ListUserSessionsResponse]
+	revokeUserSession            *connect.Client[v1.RevokeUserSessionRequest, emptypb.Empty]
+	revokeOtherUserSessions      *connect.Client[v1.RevokeOtherUserSessionsRequest, emptypb.Empty]
+	getUserTwoFactor             *connect.Client[v1.GetUserTwoFactorRequest, v1.UserTwoFactor]
+	enrollUserTwoFactor          *connect.Client[v1.EnrollUserTwoFactorRequest, v1.EnrollUserTwoFactorResponse]
+	confirmUserTwoFactor         *connect.Client[v1.ConfirmUserTwoFactorRequest, v1.ConfirmUserTwoFactorResponse]
+	deleteUserTwoFactor          *connect.Client[v1.DeleteUserTwoFactorRequest, emptypb.Empty]
+	listUserPasskeys             *connect.Client[v1.ListUserPasskeysRequest, v1.ListUserPasskeysResponse]
+	createUserPasskeyOptions     *connect.Client[v1.CreateUserPasskeyOptionsRequest, v1.UserPasskeyOptions]
+	createUserPasskey            *connect.Client[v1.CreateUserPasskeyRequest, v1.UserPasskey]
+	deleteUserPasskey            *connect.Client[v1.DeleteUserPasskeyRequest, emptypb.Empty]
+	listUserPushSubscriptions    *connect.Client[v1.ListUserPushSubscriptionsRequest, v1.ListUserPushSubscriptionsResponse]
+	createUserPushSubscription   *connect.Client[v1.CreateUserPushSubscriptionRequest, v1.UserPushSubscription]
+	deleteUserPushSubscription   *connect.Client[v1.DeleteUserPushSubscriptionRequest, emptypb.Empty]
+	getUserFeedToken             *connect.Client[v1.GetUserFeedTokenRequest, v1.UserFeedToken]
+	rotateUserFeedToken          *connect.Client[v1.RotateUserFeedTokenRequest, v1.UserFeedToken]
+	deleteUserFeedToken          *connect.Client[v1.DeleteUserFeedTokenRequest, emptypb.Empty]
+	getUserInboundEmail          *connect.Client[v1.GetUserInboundEmailRequest, v1.UserInboundEmail]
+	rotateUserInboundEmail       *connect.Client[v1.RotateUserInboundEmailRequest, v1.UserInboundEmail]
+	deleteUserInboundEmail       *connect.Client[v1.DeleteUserInboundEmailRequest, emptypb.Empty]
+	getUserSlackLink             *connect.Client[v1.GetUserSlackLinkRequest, v1.UserSlackLink]
+	generateUserSlackLinkCode    *connect.Client[v1.GenerateUserSlackLinkCodeRequest, v1.UserSlackLink]
+	deleteUserSlackLink          *connect.Client[v1.DeleteUserSlackLinkRequest, emptypb.Empty]
+	getUserMatrixLink            *connect.Client[v1.GetUserMatrixLinkRequest, v1.UserMatrixLink]
+	generateUserMatrixLinkCode   *connect.Client[v1.GenerateUserMatrixLinkCodeRequest, v1.UserMatrixLink]
+	deleteUserMatrixLink         *connect.Client[v1.DeleteUserMatrixLinkRequest, emptypb.Empty]
+	unlockUser                   *connect.Client[v1.UnlockUserRequest, emptypb.Empty]
+	suspendUser                  *connect.Client[v1.SuspendUserRequest, v1.User]
+	unsuspendUser                *connect.Client[v1.UnsuspendUserRequest, v1.User]
+	listUserWebhooks             *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
+	createUserWebhook            *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
+	updateUserWebhook            *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
+	deleteUserWebhook            *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
+	rotateUserWebhookSecret      *connect.Client[v1.RotateUserWebhookSecretRequest, v1.UserWebhook]
+	testUserWebhook              *connect.Client[v1.TestUserWebhookRequest, v1.TestUserWebhookResponse]
+	listUserWebhookDeliveries    *connect.Client[v1.ListUserWebhookDeliveriesRequest, v1.ListUserWebhookDeliveriesResponse]
+	redeliverUserWebhookDelivery *connect.Client[v1.RedeliverUserWebhookDeliveryRequest, v1.UserWebhookDelivery]
+	listUserNotifications        *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
+	updateUserNotification       *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
+	deleteUserNotification       *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
 }
 
 // ListUsers calls memos.api.v1.UserService.ListUsers.
@@ -355,6 +753,11 @@ func (c *userServiceClient) GetUserStats(ctx context.Context, req *connect.Reque
 	return c.getUserStats.CallUnary(ctx, req)
 }
 
+// GetUserWritingStats calls memos.api.v1.UserService.GetUserWritingStats.
+func (c *userServiceClient) GetUserWritingStats(ctx context.Context, req *connect.Request[v1.GetUserWritingStatsRequest]) (*connect.Response[v1.UserWritingStats], error) {
+	return c.getUserWritingStats.CallUnary(ctx, req)
+}
+
 // GetUserSetting calls memos.api.v1.UserService.GetUserSetting.
 func (c *userServiceClient) GetUserSetting(ctx context.Context, req *connect.Request[v1.GetUserSettingRequest]) (*connect.Response[v1.UserSetting], error) {
 	return c.getUserSetting.CallUnary(ctx, req)
@@ -395,6 +798,141 @@ func (c *userServiceClient) RevokeUserSession(ctx context.Context, req *connect.
 	return c.revokeUserSession.CallUnary(ctx, req)
 }
 
+// RevokeOtherUserSessions calls memos.api.v1.UserService.RevokeOtherUserSessions.
+func (c *userServiceClient) RevokeOtherUserSessions(ctx context.Context, req *connect.Request[v1.RevokeOtherUserSessionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeOtherUserSessions.CallUnary(ctx, req)
+}
+
+// GetUserTwoFactor calls memos.api.v1.UserService.GetUserTwoFactor.
+func (c *userServiceClient) GetUserTwoFactor(ctx context.Context, req *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error) {
+	return c.getUserTwoFactor.CallUnary(ctx, req)
+}
+
+// EnrollUserTwoFactor calls memos.api.v1.UserService.EnrollUserTwoFactor.
+func (c *userServiceClient) EnrollUserTwoFactor(ctx context.Context, req *connect.Request[v1.EnrollUserTwoFactorRequest]) (*connect.Response[v1.EnrollUserTwoFactorResponse], error) {
+	return c.enrollUserTwoFactor.CallUnary(ctx, req)
+}
+
+// ConfirmUserTwoFactor calls memos.api.v1.UserService.ConfirmUserTwoFactor.
+func (c *userServiceClient) ConfirmUserTwoFactor(ctx context.Context, req *connect.Request[v1.ConfirmUserTwoFactorRequest]) (*connect.Response[v1.ConfirmUserTwoFactorResponse], error) {
+	return c.confirmUserTwoFactor.CallUnary(ctx, req)
+}
+
+// DeleteUserTwoFactor calls memos.api.v1.UserService.DeleteUserTwoFactor.
+func (c *userServiceClient) DeleteUserTwoFactor(ctx context.Context, req *connect.Request[v1.DeleteUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserTwoFactor.CallUnary(ctx, req)
+}
+
+// ListUserPasskeys calls memos.api.v1.UserService.ListUserPasskeys.
+func (c *userServiceClient) ListUserPasskeys(ctx context.Context, req *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error) {
+	return c.listUserPasskeys.CallUnary(ctx, req)
+}
+
+// CreateUserPasskeyOptions calls memos.api.v1.UserService.CreateUserPasskeyOptions.
+func (c *userServiceClient) CreateUserPasskeyOptions(ctx context.Context, req *connect.Request[v1.CreateUserPasskeyOptionsRequest]) (*connect.Response[v1.UserPasskeyOptions], error) {
+	return c.createUserPasskeyOptions.CallUnary(ctx, req)
+}
+
+// CreateUserPasskey calls memos.api.v1.UserService.CreateUserPasskey.
+func (c *userServiceClient) CreateUserPasskey(ctx context.Context, req *connect.Request[v1.CreateUserPasskeyRequest]) (*connect.Response[v1.UserPasskey], error) {
+	return c.createUserPasskey.CallUnary(ctx, req)
+}
+
+// DeleteUserPasskey calls memos.api.v1.UserService.DeleteUserPasskey.
+func (c *userServiceClient) DeleteUserPasskey(ctx context.Context, req *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserPasskey.CallUnary(ctx, req)
+}
+
+// ListUserPushSubscriptions calls memos.api.v1.UserService.ListUserPushSubscriptions.
+func (c *userServiceClient) ListUserPushSubscriptions(ctx context.Context, req *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error) {
+	return c.listUserPushSubscriptions.CallUnary(ctx, req)
+}
+
+// CreateUserPushSubscription calls memos.api.v1.UserService.CreateUserPushSubscription.
+func (c *userServiceClient) CreateUserPushSubscription(ctx context.Context, req *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error) {
+	return c.createUserPushSubscription.CallUnary(ctx, req)
+}
+
+// DeleteUserPushSubscription calls memos.api.v1.UserService.DeleteUserPushSubscription.
+func (c *userServiceClient) DeleteUserPushSubscription(ctx context.Context, req *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserPushSubscription.CallUnary(ctx, req)
+}
+
+// GetUserFeedToken calls memos.api.v1.UserService.GetUserFeedToken.
+func (c *userServiceClient) GetUserFeedToken(ctx context.Context, req *connect.Request[v1.GetUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error) {
+	return c.getUserFeedToken.CallUnary(ctx, req)
+}
+
+// RotateUserFeedToken calls memos.api.v1.UserService.RotateUserFeedToken.
+func (c *userServiceClient) RotateUserFeedToken(ctx context.Context, req *connect.Request[v1.RotateUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error) {
+	return c.rotateUserFeedToken.CallUnary(ctx, req)
+}
+
+// DeleteUserFeedToken calls memos.api.v1.UserService.DeleteUserFeedToken.
+func (c *userServiceClient) DeleteUserFeedToken(ctx context.Context, req *connect.Request[v1.DeleteUserFeedTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserFeedToken.CallUnary(ctx, req)
+}
+
+// GetUserInboundEmail calls memos.api.v1.UserService.GetUserInboundEmail.
+func (c *userServiceClient) GetUserInboundEmail(ctx context.Context, req *connect.Request[v1.GetUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error) {
+	return c.getUserInboundEmail.CallUnary(ctx, req)
+}
+
+// RotateUserInboundEmail calls memos.api.v1.UserService.RotateUserInboundEmail.
+func (c *userServiceClient) RotateUserInboundEmail(ctx context.Context, req *connect.Request[v1.RotateUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error) {
+	return c.rotateUserInboundEmail.CallUnary(ctx, req)
+}
+
+// DeleteUserInboundEmail calls memos.api.v1.UserService.DeleteUserInboundEmail.
+func (c *userServiceClient) DeleteUserInboundEmail(ctx context.Context, req *connect.Request[v1.DeleteUserInboundEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserInboundEmail.CallUnary(ctx, req)
+}
+
+// GetUserSlackLink calls memos.api.v1.UserService.GetUserSlackLink.
+func (c *userServiceClient) GetUserSlackLink(ctx context.Context, req *connect.Request[v1.GetUserSlackLinkRequest]) (*connect.Response[v1.UserSlackLink], error) {
+	return c.getUserSlackLink.CallUnary(ctx, req)
+}
+
+// GenerateUserSlackLinkCode calls memos.api.v1.UserService.GenerateUserSlackLinkCode.
+func (c *userServiceClient) GenerateUserSlackLinkCode(ctx context.Context, req *connect.Request[v1.GenerateUserSlackLinkCodeRequest]) (*connect.Response[v1.UserSlackLink], error) {
+	return c.generateUserSlackLinkCode.CallUnary(ctx, req)
+}
+
+// DeleteUserSlackLink calls memos.api.v1.UserService.DeleteUserSlackLink.
+func (c *userServiceClient) DeleteUserSlackLink(ctx context.Context, req *connect.Request[v1.DeleteUserSlackLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserSlackLink.CallUnary(ctx, req)
+}
+
+// GetUserMatrixLink calls memos.api.v1.UserService.GetUserMatrixLink.
+func (c *userServiceClient) GetUserMatrixLink(ctx context.Context, req *connect.Request[v1.GetUserMatrixLinkRequest]) (*connect.Response[v1.UserMatrixLink], error) {
+	return c.getUserMatrixLink.CallUnary(ctx, req)
+}
+
+// GenerateUserMatrixLinkCode calls memos.api.v1.UserService.GenerateUserMatrixLinkCode.
+func (c *userServiceClient) GenerateUserMatrixLinkCode(ctx context.Context, req *connect.Request[v1.GenerateUserMatrixLinkCodeRequest]) (*connect.Response[v1.UserMatrixLink], error) {
+	return c.generateUserMatrixLinkCode.CallUnary(ctx, req)
+}
+
+// DeleteUserMatrixLink calls memos.api.v1.UserService.DeleteUserMatrixLink.
+func (c *userServiceClient) DeleteUserMatrixLink(ctx context.Context, req *connect.Request[v1.DeleteUserMatrixLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteUserMatrixLink.CallUnary(ctx, req)
+}
+
+// UnlockUser calls memos.api.v1.UserService.UnlockUser.
+func (c *userServiceClient) UnlockUser(ctx context.Context, req *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.unlockUser.CallUnary(ctx, req)
+}
+
+// SuspendUser calls memos.api.v1.UserService.SuspendUser.
+func (c *userServiceClient) SuspendUser(ctx context.Context, req *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.User], error) {
+	return c.suspendUser.CallUnary(ctx, req)
+}
+
+// UnsuspendUser calls memos.api.v1.UserService.UnsuspendUser.
+func (c *userServiceClient) UnsuspendUser(ctx context.Context, req *connect.Request[v1.UnsuspendUserRequest]) (*connect.Response[v1.User], error) {
+	return c.unsuspendUser.CallUnary(ctx, req)
+}
+
 // ListUserWebhooks calls memos.api.v1.UserService.ListUserWebhooks.
 func (c *userServiceClient) ListUserWebhooks(ctx context.Context, req *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return c.listUserWebhooks.CallUnary(ctx, req)
@@ -415,6 +953,26 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, req *connect.
 	return c.deleteUserWebhook.CallUnary(ctx, req)
 }
 
+// RotateUserWebhookSecret calls memos.api.v1.UserService.RotateUserWebhookSecret.
+func (c *userServiceClient) RotateUserWebhookSecret(ctx context.Context, req *connect.Request[v1.RotateUserWebhookSecretRequest]) (*connect.Response[v1.UserWebhook], error) {
+	return c.rotateUserWebhookSecret.CallUnary(ctx, req)
+}
+
+// TestUserWebhook calls memos.api.v1.UserService.TestUserWebhook.
+func (c *userServiceClient) TestUserWebhook(ctx context.Context, req *connect.Request[v1.TestUserWebhookRequest]) (*connect.Response[v1.TestUserWebhookResponse], error) {
+	return c.testUserWebhook.CallUnary(ctx, req)
+}
+
+// ListUserWebhookDeliveries calls memos.api.v1.UserService.ListUserWebhookDeliveries.
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error) {
+	return c.listUserWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RedeliverUserWebhookDelivery calls memos.api.v1.UserService.RedeliverUserWebhookDelivery.
+func (c *userServiceClient) RedeliverUserWebhookDelivery(ctx context.Context, req *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error) {
+	return c.redeliverUserWebhookDelivery.CallUnary(ctx, req)
+}
+
 // ListUserNotifications calls memos.api.v1.UserService.ListUserNotifications.
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, req *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return c.listUserNotifications.CallUnary(ctx, req)
@@ -449,6 +1007,9 @@ type UserServiceHandler interface {
 	ListAllUserStats(context.Context, *connect.Request[v1.ListAllUserStatsRequest]) (*connect.Response[v1.ListAllUserStatsResponse], error)
 	// GetUserStats returns statistics for a specific user.
 	GetUserStats(context.Context, *connect.Request[v1.GetUserStatsRequest]) (*connect.Response[v1.UserStats], error)
+	// GetUserWritingStats returns the writing statistics of a user.
+	// Users can only get their own, except for the host.
+	GetUserWritingStats(context.Context, *connect.Request[v1.GetUserWritingStatsRequest]) (*connect.Response[v1.UserWritingStats], error)
 	// GetUserSetting returns the user setting.
 	GetUserSetting(context.Context, *connect.Request[v1.GetUserSettingRequest]) (*connect.Response[v1.UserSetting], error)
 	// UpdateUserSetting updates the user setting.
@@ -465,7 +1026,72 @@ type UserServiceHandler interface {
 	ListUserSessions(context.Context, *connect.Request[v1.ListUserSessionsRequest]) (*connect.Response[v1.ListUserSessionsResponse], error)
 	// RevokeUserSession revokes a specific session for a user.
 	RevokeUserSession(context.Context, *connect.Request[v1.RevokeUserSessionRequest]) (*connect.Response[emptypb.Empty], error)
-	// ListUserWebhooks returns a list of webhooks for a user.
+	// RevokeOtherUserSessions revokes all sessions of a user except the current one.
+	RevokeOtherUserSessions(context.Context, *connect.Request[v1.RevokeOtherUserSessionsRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error)
+	// EnrollUserTwoFactor generates a new TOTP secret for a user.
+	// Two-factor authentication is enabled once ConfirmUserTwoFactor verifies a code.
+	EnrollUserTwoFactor(context.Context, *connect.Request[v1.EnrollUserTwoFactorRequest]) (*connect.Response[v1.EnrollUserTwoFactorResponse], error)
+	// ConfirmUserTwoFactor verifies a code for a pending enrollment and enables two-factor authentication.
+	ConfirmUserTwoFactor(context.Context, *connect.Request[v1.ConfirmUserTwoFactorRequest]) (*connect.Response[v1.ConfirmUserTwoFactorResponse], error)
+	// DeleteUserTwoFactor disables two-factor authentication for a user.
+	// Admins can use it to reset two-factor authentication for a locked-out user.
+	DeleteUserTwoFactor(context.Context, *connect.Request[v1.DeleteUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPasskeys returns the passkeys registered by a user.
+	ListUserPasskeys(context.Context, *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error)
+	// CreateUserPasskeyOptions starts a passkey registration.
+	// Returns the options for navigator.credentials.create(); the credential is sent back in CreateUserPasskey.
+	CreateUserPasskeyOptions(context.Context, *connect.Request[v1.CreateUserPasskeyOptionsRequest]) (*connect.Response[v1.UserPasskeyOptions], error)
+	// CreateUserPasskey registers a passkey for a user.
+	CreateUserPasskey(context.Context, *connect.Request[v1.CreateUserPasskeyRequest]) (*connect.Response[v1.UserPasskey], error)
+	// DeleteUserPasskey removes a passkey of a user.
+	DeleteUserPasskey(context.Context, *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserPushSubscriptions returns the Web Push subscriptions of the devices of a user.
+	ListUserPushSubscriptions(context.Context, *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error)
+	// CreateUserPushSubscription registers the Web Push subscription of a device of a user.
+	// Registering the endpoint of an existing subscription again updates it.
+	CreateUserPushSubscription(context.Context, *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error)
+	// DeleteUserPushSubscription revokes the Web Push subscription of a device of a user.
+	DeleteUserPushSubscription(context.Context, *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserFeedToken returns whether a user has a feed token.
+	GetUserFeedToken(context.Context, *connect.Request[v1.GetUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error)
+	// RotateUserFeedToken generates a new feed token for a user, replacing the current one.
+	// The token is only returned in the response.
+	RotateUserFeedToken(context.Context, *connect.Request[v1.RotateUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error)
+	// DeleteUserFeedToken revokes the feed token of a user.
+	DeleteUserFeedToken(context.Context, *connect.Request[v1.DeleteUserFeedTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserInboundEmail gets the inbound email address of a user.
+	GetUserInboundEmail(context.Context, *connect.Request[v1.GetUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error)
+	// RotateUserInboundEmail generates a new inbound email address for a user, replacing the
+	// current one, whose emails are dropped.
+	RotateUserInboundEmail(context.Context, *connect.Request[v1.RotateUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error)
+	// DeleteUserInboundEmail removes the inbound email address of a user.
+	DeleteUserInboundEmail(context.Context, *connect.Request[v1.DeleteUserInboundEmailRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserSlackLink gets the Slack account linked to a user.
+	GetUserSlackLink(context.Context, *connect.Request[v1.GetUserSlackLinkRequest]) (*connect.Response[v1.UserSlackLink], error)
+	// GenerateUserSlackLinkCode generates a one-time code linking the Slack account it's sent
+	// from, in a direct message to the Slack app, to a user. It replaces the pending code, if any.
+	GenerateUserSlackLinkCode(context.Context, *connect.Request[v1.GenerateUserSlackLinkCodeRequest]) (*connect.Response[v1.UserSlackLink], error)
+	// DeleteUserSlackLink unlinks the Slack account of a user, and revokes the pending code.
+	DeleteUserSlackLink(context.Context, *connect.Request[v1.DeleteUserSlackLinkRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetUserMatrixLink gets the Matrix account linked to a user.
+	GetUserMatrixLink(context.Context, *connect.Request[v1.GetUserMatrixLinkRequest]) (*connect.Response[v1.UserMatrixLink], error)
+	// GenerateUserMatrixLinkCode generates a one-time code linking the Matrix account it's sent
+	// from, with "!memos link <code>" in a room of the bot, to a user. It replaces the pending code,
+	// if any.
+	GenerateUserMatrixLinkCode(context.Context, *connect.Request[v1.GenerateUserMatrixLinkCodeRequest]) (*connect.Response[v1.UserMatrixLink], error)
+	// DeleteUserMatrixLink unlinks the Matrix account of a user, and revokes the pending code.
+	DeleteUserMatrixLink(context.Context, *connect.Request[v1.DeleteUserMatrixLinkRequest]) (*connect.Response[emptypb.Empty], error)
+	// UnlockUser clears the failed sign-in attempts of a user, lifting a sign-in lock.
+	UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// SuspendUser suspends a user: the user can't sign in and the memos of the user are hidden from
+	// the others, but nothing is deleted. Only the host can suspend users.
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.User], error)
+	// UnsuspendUser lifts the suspension of a user. Only the host can unsuspend users.
+	UnsuspendUser(context.Context, *connect.Request[v1.UnsuspendUserRequest]) (*connect.Response[v1.User], error)
+	// ListUserWebhooks returns a list of webhooks for a user, or the workspace webhooks for the
+	// "instance" parent. Only hosts can manage the workspace webhooks.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
 	CreateUserWebhook(context.Context, *connect.Request[v1.CreateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
@@ -473,6 +1099,18 @@ type UserServiceHandler interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// RotateUserWebhookSecret generates a new signing secret for a webhook, replacing the current one.
+	// Webhooks without a secret start signing their deliveries.
+	RotateUserWebhookSecret(context.Context, *connect.Request[v1.RotateUserWebhookSecretRequest]) (*connect.Response[v1.UserWebhook], error)
+	// TestUserWebhook sends a test request to a webhook and returns the response of its endpoint.
+	// The request is validated and signed like deliveries, and carries the "test" event with a
+	// sample memo. Tests are rate-limited per user.
+	TestUserWebhook(context.Context, *connect.Request[v1.TestUserWebhookRequest]) (*connect.Response[v1.TestUserWebhookResponse], error)
+	// ListUserWebhookDeliveries lists the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error)
+	// RedeliverUserWebhookDelivery replays a delivery of a webhook, sending its payload again as a
+	// new delivery.
+	RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
@@ -530,6 +1168,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetUserStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetUserWritingStatsHandler := connect.NewUnaryHandler(
+		UserServiceGetUserWritingStatsProcedure,
+		svc.GetUserWritingStats,
+		connect.WithSchema(userServiceMethods.ByName("GetUserWritingStats")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceGetUserSettingHandler := connect.NewUnaryHandler(
 		UserServiceGetUserSettingProcedure,
 		svc.GetUserSetting,
@@ -578,6 +1222,168 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("RevokeUserSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceRevokeOtherUserSessionsHandler := connect.NewUnaryHandler(
+		UserServiceRevokeOtherUserSessionsProcedure,
+		svc.RevokeOtherUserSessions,
+		connect.WithSchema(userServiceMethods.ByName("RevokeOtherUserSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceGetUserTwoFactorProcedure,
+		svc.GetUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("GetUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceEnrollUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceEnrollUserTwoFactorProcedure,
+		svc.EnrollUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("EnrollUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceConfirmUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceConfirmUserTwoFactorProcedure,
+		svc.ConfirmUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("ConfirmUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserTwoFactorProcedure,
+		svc.DeleteUserTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserPasskeysHandler := connect.NewUnaryHandler(
+		UserServiceListUserPasskeysProcedure,
+		svc.ListUserPasskeys,
+		connect.WithSchema(userServiceMethods.ByName("ListUserPasskeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserPasskeyOptionsHandler := connect.NewUnaryHandler(
+		UserServiceCreateUserPasskeyOptionsProcedure,
+		svc.CreateUserPasskeyOptions,
+		connect.WithSchema(userServiceMethods.ByName("CreateUserPasskeyOptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserPasskeyHandler := connect.NewUnaryHandler(
+		UserServiceCreateUserPasskeyProcedure,
+		svc.CreateUserPasskey,
+		connect.WithSchema(userServiceMethods.ByName("CreateUserPasskey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserPasskeyHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserPasskeyProcedure,
+		svc.DeleteUserPasskey,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserPasskey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserPushSubscriptionsHandler := connect.NewUnaryHandler(
+		UserServiceListUserPushSubscriptionsProcedure,
+		svc.ListUserPushSubscriptions,
+		connect.WithSchema(userServiceMethods.ByName("ListUserPushSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateUserPushSubscriptionHandler := connect.NewUnaryHandler(
+		UserServiceCreateUserPushSubscriptionProcedure,
+		svc.CreateUserPushSubscription,
+		connect.WithSchema(userServiceMethods.ByName("CreateUserPushSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserPushSubscriptionHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserPushSubscriptionProcedure,
+		svc.DeleteUserPushSubscription,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserPushSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserFeedTokenHandler := connect.NewUnaryHandler(
+		UserServiceGetUserFeedTokenProcedure,
+		svc.GetUserFeedToken,
+		connect.WithSchema(userServiceMethods.ByName("GetUserFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRotateUserFeedTokenHandler := connect.NewUnaryHandler(
+		UserServiceRotateUserFeedTokenProcedure,
+		svc.RotateUserFeedToken,
+		connect.WithSchema(userServiceMethods.ByName("RotateUserFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserFeedTokenHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserFeedTokenProcedure,
+		svc.DeleteUserFeedToken,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserFeedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserInboundEmailHandler := connect.NewUnaryHandler(
+		UserServiceGetUserInboundEmailProcedure,
+		svc.GetUserInboundEmail,
+		connect.WithSchema(userServiceMethods.ByName("GetUserInboundEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRotateUserInboundEmailHandler := connect.NewUnaryHandler(
+		UserServiceRotateUserInboundEmailProcedure,
+		svc.RotateUserInboundEmail,
+		connect.WithSchema(userServiceMethods.ByName("RotateUserInboundEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserInboundEmailHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserInboundEmailProcedure,
+		svc.DeleteUserInboundEmail,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserInboundEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserSlackLinkHandler := connect.NewUnaryHandler(
+		UserServiceGetUserSlackLinkProcedure,
+		svc.GetUserSlackLink,
+		connect.WithSchema(userServiceMethods.ByName("GetUserSlackLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGenerateUserSlackLinkCodeHandler := connect.NewUnaryHandler(
+		UserServiceGenerateUserSlackLinkCodeProcedure,
+		svc.GenerateUserSlackLinkCode,
+		connect.WithSchema(userServiceMethods.ByName("GenerateUserSlackLinkCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserSlackLinkHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserSlackLinkProcedure,
+		svc.DeleteUserSlackLink,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserSlackLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserMatrixLinkHandler := connect.NewUnaryHandler(
+		UserServiceGetUserMatrixLinkProcedure,
+		svc.GetUserMatrixLink,
+		connect.WithSchema(userServiceMethods.ByName("GetUserMatrixLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGenerateUserMatrixLinkCodeHandler := connect.NewUnaryHandler(
+		UserServiceGenerateUserMatrixLinkCodeProcedure,
+		svc.GenerateUserMatrixLinkCode,
+		connect.WithSchema(userServiceMethods.ByName("GenerateUserMatrixLinkCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteUserMatrixLinkHandler := connect.NewUnaryHandler(
+		UserServiceDeleteUserMatrixLinkProcedure,
+		svc.DeleteUserMatrixLink,
+		connect.WithSchema(userServiceMethods.ByName("DeleteUserMatrixLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUnlockUserHandler := connect.NewUnaryHandler(
+		UserServiceUnlockUserProcedure,
+		svc.UnlockUser,
+		connect.WithSchema(userServiceMethods.ByName("UnlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSuspendUserHandler := connect.NewUnaryHandler(
+		UserServiceSuspendUserProcedure,
+		svc.SuspendUser,
+		connect.WithSchema(userServiceMethods.ByName("SuspendUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUnsuspendUserHandler := connect.NewUnaryHandler(
+		UserServiceUnsuspendUserProcedure,
+		svc.UnsuspendUser,
+		connect.WithSchema(userServiceMethods.ByName("UnsuspendUser")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserWebhooksHandler := connect.NewUnaryHandler(
 		UserServiceListUserWebhooksProcedure,
 		svc.ListUserWebhooks,
@@ -602,6 +1408,30 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceRotateUserWebhookSecretHandler := connect.NewUnaryHandler(
+		UserServiceRotateUserWebhookSecretProcedure,
+		svc.RotateUserWebhookSecret,
+		connect.WithSchema(userServiceMethods.ByName("RotateUserWebhookSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceTestUserWebhookHandler := connect.NewUnaryHandler(
+		UserServiceTestUserWebhookProcedure,
+		svc.TestUserWebhook,
+		connect.WithSchema(userServiceMethods.ByName("TestUserWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		UserServiceListUserWebhookDeliveriesProcedure,
+		svc.ListUserWebhookDeliveries,
+		connect.WithSchema(userServiceMethods.ByName("ListUserWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRedeliverUserWebhookDeliveryHandler := connect.NewUnaryHandler(
+		UserServiceRedeliverUserWebhookDeliveryProcedure,
+		svc.RedeliverUserWebhookDelivery,
+		connect.WithSchema(userServiceMethods.ByName("RedeliverUserWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserNotificationsHandler := connect.NewUnaryHandler(
 		UserServiceListUserNotificationsProcedure,
 		svc.ListUserNotifications,
@@ -636,6 +1466,8 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceListAllUserStatsHandler.ServeHTTP(w, r)
 		case UserServiceGetUserStatsProcedure:
 			userServiceGetUserStatsHandler.ServeHTTP(w, r)
+		case UserServiceGetUserWritingStatsProcedure:
+			userServiceGetUserWritingStatsHandler.ServeHTTP(w, r)
 		case UserServiceGetUserSettingProcedure:
 			userServiceGetUserSettingHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserSettingProcedure:
@@ -652,6 +1484,60 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceListUserSessionsHandler.ServeHTTP(w, r)
 		case UserServiceRevokeUserSessionProcedure:
 			userServiceRevokeUserSessionHandler.ServeHTTP(w, r)
+		case UserServiceRevokeOtherUserSessionsProcedure:
+			userServiceRevokeOtherUserSessionsHandler.ServeHTTP(w, r)
+		case UserServiceGetUserTwoFactorProcedure:
+			userServiceGetUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceEnrollUserTwoFactorProcedure:
+			userServiceEnrollUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceConfirmUserTwoFactorProcedure:
+			userServiceConfirmUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserTwoFactorProcedure:
+			userServiceDeleteUserTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceListUserPasskeysProcedure:
+			userServiceListUserPasskeysHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserPasskeyOptionsProcedure:
+			userServiceCreateUserPasskeyOptionsHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserPasskeyProcedure:
+			userServiceCreateUserPasskeyHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserPasskeyProcedure:
+			userServiceDeleteUserPasskeyHandler.ServeHTTP(w, r)
+		case UserServiceListUserPushSubscriptionsProcedure:
+			userServiceListUserPushSubscriptionsHandler.ServeHTTP(w, r)
+		case UserServiceCreateUserPushSubscriptionProcedure:
+			userServiceCreateUserPushSubscriptionHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserPushSubscriptionProcedure:
+			userServiceDeleteUserPushSubscriptionHandler.ServeHTTP(w, r)
+		case UserServiceGetUserFeedTokenProcedure:
+			userServiceGetUserFeedTokenHandler.ServeHTTP(w, r)
+		case UserServiceRotateUserFeedTokenProcedure:
+			userServiceRotateUserFeedTokenHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserFeedTokenProcedure:
+			userServiceDeleteUserFeedTokenHandler.ServeHTTP(w, r)
+		case UserServiceGetUserInboundEmailProcedure:
+			userServiceGetUserInboundEmailHandler.ServeHTTP(w, r)
+		case UserServiceRotateUserInboundEmailProcedure:
+			userServiceRotateUserInboundEmailHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserInboundEmailProcedure:
+			userServiceDeleteUserInboundEmailHandler.ServeHTTP(w, r)
+		case UserServiceGetUserSlackLinkProcedure:
+			userServiceGetUserSlackLinkHandler.ServeHTTP(w, r)
+		case UserServiceGenerateUserSlackLinkCodeProcedure:
+			userServiceGenerateUserSlackLinkCodeHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserSlackLinkProcedure:
+			userServiceDeleteUserSlackLinkHandler.ServeHTTP(w, r)
+		case UserServiceGetUserMatrixLinkProcedure:
+			userServiceGetUserMatrixLinkHandler.ServeHTTP(w, r)
+		case UserServiceGenerateUserMatrixLinkCodeProcedure:
+			userServiceGenerateUserMatrixLinkCodeHandler.ServeHTTP(w, r)
+		case UserServiceDeleteUserMatrixLinkProcedure:
+			userServiceDeleteUserMatrixLinkHandler.ServeHTTP(w, r)
+		case UserServiceUnlockUserProcedure:
+			userServiceUnlockUserHandler.ServeHTTP(w, r)
+		case UserServiceSuspendUserProcedure:
+			userServiceSuspendUserHandler.ServeHTTP(w, r)
+		case UserServiceUnsuspendUserProcedure:
+			userServiceUnsuspendUserHandler.ServeHTTP(w, r)
 		case UserServiceListUserWebhooksProcedure:
 			userServiceListUserWebhooksHandler.ServeHTTP(w, r)
 		case UserServiceCreateUserWebhookProcedure:
@@ -660,6 +1546,14 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserWebhookHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserWebhookProcedure:
 			userServiceDeleteUserWebhookHandler.ServeHTTP(w, r)
+		case UserServiceRotateUserWebhookSecretProcedure:
+			userServiceRotateUserWebhookSecretHandler.ServeHTTP(w, r)
+		case UserServiceTestUserWebhookProcedure:
+			userServiceTestUserWebhookHandler.ServeHTTP(w, r)
+		case UserServiceListUserWebhookDeliveriesProcedure:
+			userServiceListUserWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case UserServiceRedeliverUserWebhookDeliveryProcedure:
+			userServiceRedeliverUserWebhookDeliveryHandler.ServeHTTP(w, r)
 		case UserServiceListUserNotificationsProcedure:
 			userServiceListUserNotificationsHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserNotificationProcedure:
@@ -703,6 +1597,10 @@ func (UnimplementedUserServiceHandler) GetUserStats(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserStats is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) GetUserWritingStats(context.Context, *connect.Request[v1.GetUserWritingStatsRequest]) (*connect.Response[v1.UserWritingStats], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserWritingStats is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) GetUserSetting(context.Context, *connect.Request[v1.GetUserSettingRequest]) (*connect.Response[v1.UserSetting], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserSetting is not implemented"))
 }
@@ -735,6 +1633,114 @@ func (UnimplementedUserServiceHandler) RevokeUserSession(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RevokeUserSession is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) RevokeOtherUserSessions(context.Context, *connect.Request[v1.RevokeOtherUserSessionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RevokeOtherUserSessions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserTwoFactor(context.Context, *connect.Request[v1.GetUserTwoFactorRequest]) (*connect.Response[v1.UserTwoFactor], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) EnrollUserTwoFactor(context.Context, *connect.Request[v1.EnrollUserTwoFactorRequest]) (*connect.Response[v1.EnrollUserTwoFactorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.EnrollUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ConfirmUserTwoFactor(context.Context, *connect.Request[v1.ConfirmUserTwoFactorRequest]) (*connect.Response[v1.ConfirmUserTwoFactorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ConfirmUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserTwoFactor(context.Context, *connect.Request[v1.DeleteUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserPasskeys(context.Context, *connect.Request[v1.ListUserPasskeysRequest]) (*connect.Response[v1.ListUserPasskeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserPasskeys is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUserPasskeyOptions(context.Context, *connect.Request[v1.CreateUserPasskeyOptionsRequest]) (*connect.Response[v1.UserPasskeyOptions], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.CreateUserPasskeyOptions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUserPasskey(context.Context, *connect.Request[v1.CreateUserPasskeyRequest]) (*connect.Response[v1.UserPasskey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.CreateUserPasskey is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserPasskey(context.Context, *connect.Request[v1.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserPasskey is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserPushSubscriptions(context.Context, *connect.Request[v1.ListUserPushSubscriptionsRequest]) (*connect.Response[v1.ListUserPushSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserPushSubscriptions is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateUserPushSubscription(context.Context, *connect.Request[v1.CreateUserPushSubscriptionRequest]) (*connect.Response[v1.UserPushSubscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.CreateUserPushSubscription is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserPushSubscription(context.Context, *connect.Request[v1.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserPushSubscription is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserFeedToken(context.Context, *connect.Request[v1.GetUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserFeedToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RotateUserFeedToken(context.Context, *connect.Request[v1.RotateUserFeedTokenRequest]) (*connect.Response[v1.UserFeedToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RotateUserFeedToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserFeedToken(context.Context, *connect.Request[v1.DeleteUserFeedTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserFeedToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserInboundEmail(context.Context, *connect.Request[v1.GetUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserInboundEmail is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RotateUserInboundEmail(context.Context, *connect.Request[v1.RotateUserInboundEmailRequest]) (*connect.Response[v1.UserInboundEmail], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RotateUserInboundEmail is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserInboundEmail(context.Context, *connect.Request[v1.DeleteUserInboundEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserInboundEmail is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserSlackLink(context.Context, *connect.Request[v1.GetUserSlackLinkRequest]) (*connect.Response[v1.UserSlackLink], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserSlackLink is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GenerateUserSlackLinkCode(context.Context, *connect.Request[v1.GenerateUserSlackLinkCodeRequest]) (*connect.Response[v1.UserSlackLink], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GenerateUserSlackLinkCode is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserSlackLink(context.Context, *connect.Request[v1.DeleteUserSlackLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserSlackLink is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUserMatrixLink(context.Context, *connect.Request[v1.GetUserMatrixLinkRequest]) (*connect.Response[v1.UserMatrixLink], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetUserMatrixLink is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GenerateUserMatrixLinkCode(context.Context, *connect.Request[v1.GenerateUserMatrixLinkCodeRequest]) (*connect.Response[v1.UserMatrixLink], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GenerateUserMatrixLinkCode is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteUserMatrixLink(context.Context, *connect.Request[v1.DeleteUserMatrixLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserMatrixLink is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnlockUser(context.Context, *connect.Request[v1.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.UnlockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.SuspendUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnsuspendUser(context.Context, *connect.Request[v1.UnsuspendUserRequest]) (*connect.Response[v1.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.UnsuspendUser is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhooks is not implemented"))
 }
@@ -751,6 +1757,22 @@ func (UnimplementedUserServiceHandler) DeleteUserWebhook(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserWebhook is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) RotateUserWebhookSecret(context.Context, *connect.Request[v1.RotateUserWebhookSecretRequest]) (*connect.Response[v1.UserWebhook], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RotateUserWebhookSecret is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) TestUserWebhook(context.Context, *connect.Request[v1.TestUserWebhookRequest]) (*connect.Response[v1.TestUserWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.TestUserWebhook is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RedeliverUserWebhookDelivery is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserNotifications is not implemented"))
 }
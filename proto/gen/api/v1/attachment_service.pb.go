@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -36,7 +37,9 @@ type Attachment struct {
 	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
 	// Input only. The content of the attachment.
 	Content []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	// Optional. The external link of the attachment.
+	// Optional. The external link of the attachment, created instead of uploading the content.
+	// The link must respond when the attachment is created, and its type and size are taken from
+	// the response when it declares them. Links to internal addresses are rejected.
 	ExternalLink string `protobuf:"bytes,5,opt,name=external_link,json=externalLink,proto3" json:"external_link,omitempty"`
 	// The MIME type of the attachment.
 	Type string `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
@@ -44,7 +47,21 @@ type Attachment struct {
 	Size int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
 	// Optional. The related memo. Refer to `Memo.name`.
 	// Format: memos/{memo}
-	Memo          *string `protobuf:"bytes,8,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Memo *string `protobuf:"bytes,8,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// Optional. Whether the attachment is a library upload, kept without a memo. Attachments
+	// without a memo that aren't library uploads are purged after a grace period.
+	Library bool `protobuf:"varint,9,opt,name=library,proto3" json:"library,omitempty"`
+	// Output only. The size in bytes of the uploaded image before it was compressed, or 0 if the
+	// attachment is stored as uploaded.
+	OriginalSize int64 `protobuf:"varint,10,opt,name=original_size,json=originalSize,proto3" json:"original_size,omitempty"`
+	// Output only. The duration of an audio attachment, unset if it couldn't be read from the
+	// audio container or the audio was uploaded directly to S3.
+	Duration *durationpb.Duration `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Output only. Whether the external link of the attachment answered 404 or 410 when it was
+	// last checked.
+	Broken bool `protobuf:"varint,12,opt,name=broken,proto3" json:"broken,omitempty"`
+	// Output only. The last time the external link of the attachment was checked.
+	LinkCheckTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=link_check_time,json=linkCheckTime,proto3" json:"link_check_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -135,6 +152,41 @@ func (x *Attachment) GetMemo() string {
 	return ""
 }
 
+func (x *Attachment) GetLibrary() bool {
+	if x != nil {
+		return x.Library
+	}
+	return false
+}
+
+func (x *Attachment) GetOriginalSize() int64 {
+	if x != nil {
+		return x.OriginalSize
+	}
+	return 0
+}
+
+func (x *Attachment) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Attachment) GetBroken() bool {
+	if x != nil {
+		return x.Broken
+	}
+	return false
+}
+
+func (x *Attachment) GetLinkCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCheckTime
+	}
+	return nil
+}
+
 type CreateAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment to create.
@@ -176,16 +228,532 @@ func (*CreateAttachmentRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateAttachmentRequest) GetAttachment() *Attachment {
-	if x != nil {
-		return x.Attachment
-	}
-	return nil
+func (x *CreateAttachmentRequest) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CreateAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type CreateAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The attachment to upload, without its content.
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// Required. The size of the content in bytes, which the upload must match.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Optional. The attachment ID to use for this attachment.
+	// If empty, a unique ID will be generated.
+	AttachmentId string `protobuf:"bytes,3,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	// Optional. How long the upload URL is valid in seconds, at most an hour.
+	// If unspecified, the upload URL is valid for 15 minutes.
+	ExpireSeconds int32 `protobuf:"varint,4,opt,name=expire_seconds,json=expireSeconds,proto3" json:"expire_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentUploadRequest) Reset() {
+	*x = CreateAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAttachmentUploadRequest) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CreateAttachmentUploadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateAttachmentUploadRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadRequest) GetExpireSeconds() int32 {
+	if x != nil {
+		return x.ExpireSeconds
+	}
+	return 0
+}
+
+type CreateAttachmentUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pending attachment, which is completed by CompleteAttachmentUpload. Pending attachments
+	// that aren't completed within two days are deleted.
+	// Unset if the content must be uploaded with CreateAttachment.
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// The presigned URL to upload the content to, with a PUT request sending the type and the
+	// size of the attachment as the Content-Type and Content-Length headers.
+	UploadUrl string `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	// The expiration time of the upload URL.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentUploadResponse) Reset() {
+	*x = CreateAttachmentUploadResponse{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentUploadResponse) ProtoMessage() {}
+
+func (x *CreateAttachmentUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentUploadResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAttachmentUploadResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CreateAttachmentUploadResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type CompleteAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the pending attachment.
+	// Format: attachments/{attachment}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteAttachmentUploadRequest) Reset() {
+	*x = CompleteAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *CompleteAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompleteAttachmentUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateChunkedUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The attachment to upload, without its content.
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// Required. The size of the content in bytes.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Required. The hex-encoded SHA-256 of the content, which the assembled chunks must match.
+	ContentHash string `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Optional. The attachment ID to use for this attachment.
+	// If empty, a unique ID will be generated.
+	AttachmentId  string `protobuf:"bytes,4,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChunkedUploadRequest) Reset() {
+	*x = CreateChunkedUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChunkedUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChunkedUploadRequest) ProtoMessage() {}
+
+func (x *CreateChunkedUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChunkedUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateChunkedUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateChunkedUploadRequest) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CreateChunkedUploadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateChunkedUploadRequest) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *CreateChunkedUploadRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// ChunkedUpload is the status of a chunked upload.
+type ChunkedUpload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the pending attachment.
+	// Format: attachments/{attachment}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The size of the content in bytes.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// The size of the chunks in bytes. Only the last chunk may be smaller.
+	ChunkSize int64 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// The number of chunks, indexed from 0.
+	ChunkCount int32 `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// The indexes of the chunks left to upload.
+	MissingChunks []int32 `protobuf:"varint,5,rep,packed,name=missing_chunks,json=missingChunks,proto3" json:"missing_chunks,omitempty"`
+	// The time after which the upload can't be resumed, and is deleted.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkedUpload) Reset() {
+	*x = ChunkedUpload{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkedUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkedUpload) ProtoMessage() {}
+
+func (x *ChunkedUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkedUpload.ProtoReflect.Descriptor instead.
+func (*ChunkedUpload) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChunkedUpload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChunkedUpload) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ChunkedUpload) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *ChunkedUpload) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *ChunkedUpload) GetMissingChunks() []int32 {
+	if x != nil {
+		return x.MissingChunks
+	}
+	return nil
+}
+
+func (x *ChunkedUpload) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type UploadAttachmentChunkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the pending attachment.
+	// Format: attachments/{attachment}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The index of the chunk, from 0.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Required. The content of the chunk.
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Required. The hex-encoded SHA-256 of the content of the chunk.
+	Checksum      string `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentChunkRequest) Reset() {
+	*x = UploadAttachmentChunkRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentChunkRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentChunkRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentChunkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadAttachmentChunkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadAttachmentChunkRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UploadAttachmentChunkRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UploadAttachmentChunkRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type GetChunkedUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the pending attachment.
+	// Format: attachments/{attachment}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunkedUploadRequest) Reset() {
+	*x = GetChunkedUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkedUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkedUploadRequest) ProtoMessage() {}
+
+func (x *GetChunkedUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkedUploadRequest.ProtoReflect.Descriptor instead.
+func (*GetChunkedUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetChunkedUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CompleteChunkedUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the pending attachment.
+	// Format: attachments/{attachment}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteChunkedUploadRequest) Reset() {
+	*x = CompleteChunkedUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteChunkedUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteChunkedUploadRequest) ProtoMessage() {}
+
+func (x *CompleteChunkedUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteChunkedUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteChunkedUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateAttachmentRequest) GetAttachmentId() string {
+func (x *CompleteChunkedUploadRequest) GetName() string {
 	if x != nil {
-		return x.AttachmentId
+		return x.Name
 	}
 	return ""
 }
@@ -200,21 +768,26 @@ type ListAttachmentsRequest struct {
 	// Optional. A page token, received from a previous `ListAttachments` call.
 	// Provide this to retrieve the subsequent page.
 	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. Filter to apply to the list results.
-	// Example: "type=image/png" or "filename:*.jpg"
-	// Supported operators: =, !=, <, <=, >, >=, :
-	// Supported fields: filename, type, size, create_time, memo
+	// Optional. A CEL expression to filter the attachments by, as a conjunction with `&&` of:
+	// - `filename.contains("report")` for a filename substring;
+	// - `mime_type.startsWith("image/")` for a MIME type prefix;
+	// - `size` compared with `<`, `<=`, `>`, `>=` or `==` to a number of bytes;
+	// - `create_time` compared to a timestamp, as `create_time >= timestamp("2025-03-01T00:00:00Z")`;
+	// - `has_memo` or `!has_memo` for whether the attachment is attached to a memo.
 	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	// Optional. The order to sort results by.
 	// Example: "create_time desc" or "filename asc"
-	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. Whether to list the attachments of all users instead of the caller's. Only the host
+	// can list the attachments of all users.
+	AllUsers      bool `protobuf:"varint,5,opt,name=all_users,json=allUsers,proto3" json:"all_users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAttachmentsRequest) Reset() {
 	*x = ListAttachmentsRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[2]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +799,7 @@ func (x *ListAttachmentsRequest) String() string {
 func (*ListAttachmentsRequest) ProtoMessage() {}
 
 func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[2]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +812,7 @@ func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListAttachmentsRequest) GetPageSize() int32 {
@@ -270,6 +843,13 @@ func (x *ListAttachmentsRequest) GetOrderBy() string {
 	return ""
 }
 
+func (x *ListAttachmentsRequest) GetAllUsers() bool {
+	if x != nil {
+		return x.AllUsers
+	}
+	return false
+}
+
 type ListAttachmentsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The list of attachments.
@@ -277,7 +857,7 @@ type ListAttachmentsResponse struct {
 	// A token that can be sent as `page_token` to retrieve the next page.
 	// If this field is omitted, there are no subsequent pages.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of attachments (may be approximate).
+	// The total count of the attachments matching the filter.
 	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -285,7 +865,7 @@ type ListAttachmentsResponse struct {
 
 func (x *ListAttachmentsResponse) Reset() {
 	*x = ListAttachmentsResponse{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[3]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +877,7 @@ func (x *ListAttachmentsResponse) String() string {
 func (*ListAttachmentsResponse) ProtoMessage() {}
 
 func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[3]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +890,7 @@ func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
@@ -334,6 +914,52 @@ func (x *ListAttachmentsResponse) GetTotalSize() int32 {
 	return 0
 }
 
+type RefreshExternalAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the external attachment.
+	// Format: attachments/{attachment}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshExternalAttachmentRequest) Reset() {
+	*x = RefreshExternalAttachmentRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshExternalAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshExternalAttachmentRequest) ProtoMessage() {}
+
+func (x *RefreshExternalAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshExternalAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*RefreshExternalAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefreshExternalAttachmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type GetAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment name of the attachment to retrieve.
@@ -345,7 +971,7 @@ type GetAttachmentRequest struct {
 
 func (x *GetAttachmentRequest) Reset() {
 	*x = GetAttachmentRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +983,7 @@ func (x *GetAttachmentRequest) String() string {
 func (*GetAttachmentRequest) ProtoMessage() {}
 
 func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[4]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +996,7 @@ func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAttachmentRequest) GetName() string {
@@ -392,7 +1018,7 @@ type UpdateAttachmentRequest struct {
 
 func (x *UpdateAttachmentRequest) Reset() {
 	*x = UpdateAttachmentRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +1030,7 @@ func (x *UpdateAttachmentRequest) String() string {
 func (*UpdateAttachmentRequest) ProtoMessage() {}
 
 func (x *UpdateAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[5]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +1043,7 @@ func (x *UpdateAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateAttachmentRequest) GetAttachment() *Attachment {
@@ -445,7 +1071,7 @@ type DeleteAttachmentRequest struct {
 
 func (x *DeleteAttachmentRequest) Reset() {
 	*x = DeleteAttachmentRequest{}
-	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +1083,7 @@ func (x *DeleteAttachmentRequest) String() string {
 func (*DeleteAttachmentRequest) ProtoMessage() {}
 
 func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_attachment_service_proto_msgTypes[6]
+	mi := &file_api_v1_attachment_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +1096,7 @@ func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteAttachmentRequest) GetName() string {
@@ -480,11 +1106,290 @@ func (x *DeleteAttachmentRequest) GetName() string {
 	return ""
 }
 
+type PurgeOrphanedAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeOrphanedAttachmentsRequest) Reset() {
+	*x = PurgeOrphanedAttachmentsRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeOrphanedAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeOrphanedAttachmentsRequest) ProtoMessage() {}
+
+func (x *PurgeOrphanedAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeOrphanedAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeOrphanedAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{16}
+}
+
+type PurgeOrphanedAttachmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of purged attachments.
+	PurgedCount int32 `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+	// The total size of the purged attachments in bytes.
+	ReclaimedBytes int64 `protobuf:"varint,2,opt,name=reclaimed_bytes,json=reclaimedBytes,proto3" json:"reclaimed_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurgeOrphanedAttachmentsResponse) Reset() {
+	*x = PurgeOrphanedAttachmentsResponse{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeOrphanedAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeOrphanedAttachmentsResponse) ProtoMessage() {}
+
+func (x *PurgeOrphanedAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeOrphanedAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeOrphanedAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PurgeOrphanedAttachmentsResponse) GetPurgedCount() int32 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+func (x *PurgeOrphanedAttachmentsResponse) GetReclaimedBytes() int64 {
+	if x != nil {
+		return x.ReclaimedBytes
+	}
+	return 0
+}
+
+type DeduplicateAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeduplicateAttachmentsRequest) Reset() {
+	*x = DeduplicateAttachmentsRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeduplicateAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeduplicateAttachmentsRequest) ProtoMessage() {}
+
+func (x *DeduplicateAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeduplicateAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeduplicateAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{18}
+}
+
+type DeduplicateAttachmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of attachments hashed.
+	HashedCount int32 `protobuf:"varint,1,opt,name=hashed_count,json=hashedCount,proto3" json:"hashed_count,omitempty"`
+	// The number of attachments now sharing the file of another attachment.
+	DeduplicatedCount int32 `protobuf:"varint,2,opt,name=deduplicated_count,json=deduplicatedCount,proto3" json:"deduplicated_count,omitempty"`
+	// The total size of the deleted duplicate files in bytes.
+	SavedBytes    int64 `protobuf:"varint,3,opt,name=saved_bytes,json=savedBytes,proto3" json:"saved_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeduplicateAttachmentsResponse) Reset() {
+	*x = DeduplicateAttachmentsResponse{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeduplicateAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeduplicateAttachmentsResponse) ProtoMessage() {}
+
+func (x *DeduplicateAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeduplicateAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*DeduplicateAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeduplicateAttachmentsResponse) GetHashedCount() int32 {
+	if x != nil {
+		return x.HashedCount
+	}
+	return 0
+}
+
+func (x *DeduplicateAttachmentsResponse) GetDeduplicatedCount() int32 {
+	if x != nil {
+		return x.DeduplicatedCount
+	}
+	return 0
+}
+
+func (x *DeduplicateAttachmentsResponse) GetSavedBytes() int64 {
+	if x != nil {
+		return x.SavedBytes
+	}
+	return 0
+}
+
+type RecalculateStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateStorageUsageRequest) Reset() {
+	*x = RecalculateStorageUsageRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateStorageUsageRequest) ProtoMessage() {}
+
+func (x *RecalculateStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecalculateStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{20}
+}
+
+type RecalculateStorageUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of users whose storage usage was recalculated.
+	UserCount int32 `protobuf:"varint,1,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// The total storage usage of the users in bytes.
+	TotalBytes    int64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateStorageUsageResponse) Reset() {
+	*x = RecalculateStorageUsageResponse{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateStorageUsageResponse) ProtoMessage() {}
+
+func (x *RecalculateStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecalculateStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecalculateStorageUsageResponse) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *RecalculateStorageUsageResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
 var File_api_v1_attachment_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fapi/v1/attachment_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x02\n" +
+	"\x1fapi/v1/attachment_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x04\n" +
 	"\n" +
 	"Attachment\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
@@ -495,7 +1400,13 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\rexternal_link\x18\x05 \x01(\tB\x03\xe0A\x01R\fexternalLink\x12\x17\n" +
 	"\x04type\x18\x06 \x01(\tB\x03\xe0A\x02R\x04type\x12\x17\n" +
 	"\x04size\x18\a \x01(\x03B\x03\xe0A\x03R\x04size\x12\x1c\n" +
-	"\x04memo\x18\b \x01(\tB\x03\xe0A\x01H\x00R\x04memo\x88\x01\x01:O\xeaAL\n" +
+	"\x04memo\x18\b \x01(\tB\x03\xe0A\x01H\x00R\x04memo\x88\x01\x01\x12\x1d\n" +
+	"\alibrary\x18\t \x01(\bB\x03\xe0A\x01R\alibrary\x12(\n" +
+	"\roriginal_size\x18\n" +
+	" \x01(\x03B\x03\xe0A\x03R\foriginalSize\x12:\n" +
+	"\bduration\x18\v \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x03R\bduration\x12\x1b\n" +
+	"\x06broken\x18\f \x01(\bB\x03\xe0A\x03R\x06broken\x12G\n" +
+	"\x0flink_check_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\rlinkCheckTime:O\xeaAL\n" +
 	"\x17memos.api.v1/Attachment\x12\x18attachments/{attachment}*\vattachments2\n" +
 	"attachmentB\a\n" +
 	"\x05_memo\"\x82\x01\n" +
@@ -503,18 +1414,69 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\n" +
 	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
 	"attachment\x12(\n" +
-	"\rattachment_id\x18\x02 \x01(\tB\x03\xe0A\x01R\fattachmentId\"\x9b\x01\n" +
+	"\rattachment_id\x18\x02 \x01(\tB\x03\xe0A\x01R\fattachmentId\"\xcd\x01\n" +
+	"\x1dCreateAttachmentUploadRequest\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
+	"attachment\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x03B\x03\xe0A\x02R\x04size\x12(\n" +
+	"\rattachment_id\x18\x03 \x01(\tB\x03\xe0A\x01R\fattachmentId\x12*\n" +
+	"\x0eexpire_seconds\x18\x04 \x01(\x05B\x03\xe0A\x01R\rexpireSeconds\"\xb6\x01\n" +
+	"\x1eCreateAttachmentUploadResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentR\n" +
+	"attachment\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"V\n" +
+	"\x1fCompleteAttachmentUploadRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"\xc6\x01\n" +
+	"\x1aCreateChunkedUploadRequest\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
+	"attachment\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x03B\x03\xe0A\x02R\x04size\x12&\n" +
+	"\fcontent_hash\x18\x03 \x01(\tB\x03\xe0A\x02R\vcontentHash\x12(\n" +
+	"\rattachment_id\x18\x04 \x01(\tB\x03\xe0A\x01R\fattachmentId\"\xdb\x01\n" +
+	"\rChunkedUpload\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\x03R\tchunkSize\x12\x1f\n" +
+	"\vchunk_count\x18\x04 \x01(\x05R\n" +
+	"chunkCount\x12%\n" +
+	"\x0emissing_chunks\x18\x05 \x03(\x05R\rmissingChunks\x12;\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xae\x01\n" +
+	"\x1cUploadAttachmentChunkRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\x12\x19\n" +
+	"\x05index\x18\x02 \x01(\x05B\x03\xe0A\x02R\x05index\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\fB\x03\xe0A\x02R\acontent\x12\x1f\n" +
+	"\bchecksum\x18\x04 \x01(\tB\x03\xe0A\x02R\bchecksum\"N\n" +
+	"\x17GetChunkedUploadRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"S\n" +
+	"\x1cCompleteChunkedUploadRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"\xbd\x01\n" +
 	"\x16ListAttachmentsRequest\x12 \n" +
 	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
 	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x1e\n" +
-	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\"\x9c\x01\n" +
+	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\x12 \n" +
+	"\tall_users\x18\x05 \x01(\bB\x03\xe0A\x01R\ballUsers\"\x9c\x01\n" +
 	"\x17ListAttachmentsResponse\x12:\n" +
 	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"K\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"W\n" +
+	" RefreshExternalAttachmentRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"K\n" +
 	"\x14GetAttachmentRequest\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
 	"\x17memos.api.v1/AttachmentR\x04name\"\x9a\x01\n" +
@@ -526,16 +1488,42 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"updateMask\"N\n" +
 	"\x17DeleteAttachmentRequest\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
-	"\x17memos.api.v1/AttachmentR\x04name2\xc4\x05\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"!\n" +
+	"\x1fPurgeOrphanedAttachmentsRequest\"n\n" +
+	" PurgeOrphanedAttachmentsResponse\x12!\n" +
+	"\fpurged_count\x18\x01 \x01(\x05R\vpurgedCount\x12'\n" +
+	"\x0freclaimed_bytes\x18\x02 \x01(\x03R\x0ereclaimedBytes\"\x1f\n" +
+	"\x1dDeduplicateAttachmentsRequest\"\x93\x01\n" +
+	"\x1eDeduplicateAttachmentsResponse\x12!\n" +
+	"\fhashed_count\x18\x01 \x01(\x05R\vhashedCount\x12-\n" +
+	"\x12deduplicated_count\x18\x02 \x01(\x05R\x11deduplicatedCount\x12\x1f\n" +
+	"\vsaved_bytes\x18\x03 \x01(\x03R\n" +
+	"savedBytes\" \n" +
+	"\x1eRecalculateStorageUsageRequest\"a\n" +
+	"\x1fRecalculateStorageUsageResponse\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x01 \x01(\x05R\tuserCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x03R\n" +
+	"totalBytes2\x91\x12\n" +
 	"\x11AttachmentService\x12\x89\x01\n" +
 	"\x10CreateAttachment\x12%.memos.api.v1.CreateAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"4\xdaA\n" +
 	"attachment\x82\xd3\xe4\x93\x02!:\n" +
-	"attachment\"\x13/api/v1/attachments\x12{\n" +
+	"attachment\"\x13/api/v1/attachments\x12\xa0\x01\n" +
+	"\x16CreateAttachmentUpload\x12+.memos.api.v1.CreateAttachmentUploadRequest\x1a,.memos.api.v1.CreateAttachmentUploadResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/attachments:createUpload\x12\xa2\x01\n" +
+	"\x18CompleteAttachmentUpload\x12-.memos.api.v1.CompleteAttachmentUploadRequest\x1a\x18.memos.api.v1.Attachment\"=\xdaA\x04name\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/{name=attachments/*}:completeUpload\x12\x90\x01\n" +
+	"\x13CreateChunkedUpload\x12(.memos.api.v1.CreateChunkedUploadRequest\x1a\x1b.memos.api.v1.ChunkedUpload\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/attachments:createChunkedUpload\x12\x90\x01\n" +
+	"\x15UploadAttachmentChunk\x12*.memos.api.v1.UploadAttachmentChunkRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=attachments/*}:uploadChunk\x12\x91\x01\n" +
+	"\x10GetChunkedUpload\x12%.memos.api.v1.GetChunkedUploadRequest\x1a\x1b.memos.api.v1.ChunkedUpload\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,\x12*/api/v1/{name=attachments/*}:chunkedUpload\x12\xa3\x01\n" +
+	"\x15CompleteChunkedUpload\x12*.memos.api.v1.CompleteChunkedUploadRequest\x1a\x18.memos.api.v1.Attachment\"D\xdaA\x04name\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/{name=attachments/*}:completeChunkedUpload\x12{\n" +
 	"\x0fListAttachments\x12$.memos.api.v1.ListAttachmentsRequest\x1a%.memos.api.v1.ListAttachmentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/attachments\x12z\n" +
 	"\rGetAttachment\x12\".memos.api.v1.GetAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/{name=attachments/*}\x12\xa9\x01\n" +
 	"\x10UpdateAttachment\x12%.memos.api.v1.UpdateAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"T\xdaA\x16attachment,update_mask\x82\xd3\xe4\x93\x025:\n" +
 	"attachment2'/api/v1/{attachment.name=attachments/*}\x12~\n" +
-	"\x10DeleteAttachment\x12%.memos.api.v1.DeleteAttachmentRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=attachments/*}B\xae\x01\n" +
+	"\x10DeleteAttachment\x12%.memos.api.v1.DeleteAttachmentRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=attachments/*}\x12\xa7\x01\n" +
+	"\x18PurgeOrphanedAttachments\x12-.memos.api.v1.PurgeOrphanedAttachmentsRequest\x1a..memos.api.v1.PurgeOrphanedAttachmentsResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/attachments:purgeOrphaned\x12\x9f\x01\n" +
+	"\x16DeduplicateAttachments\x12+.memos.api.v1.DeduplicateAttachmentsRequest\x1a,.memos.api.v1.DeduplicateAttachmentsResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/attachments:deduplicate\x12\xa5\x01\n" +
+	"\x19RefreshExternalAttachment\x12..memos.api.v1.RefreshExternalAttachmentRequest\x1a\x18.memos.api.v1.Attachment\">\xdaA\x04name\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/{name=attachments/*}:refreshExternal\x12\xae\x01\n" +
+	"\x17RecalculateStorageUsage\x12,.memos.api.v1.RecalculateStorageUsageRequest\x1a-.memos.api.v1.RecalculateStorageUsageResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/attachments:recalculateStorageUsageB\xae\x01\n" +
 	"\x10com.memos.api.v1B\x16AttachmentServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -550,40 +1538,83 @@ func file_api_v1_attachment_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_attachment_service_proto_rawDescData
 }
 
-var file_api_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_api_v1_attachment_service_proto_goTypes = []any{
-	(*Attachment)(nil),              // 0: memos.api.v1.Attachment
-	(*CreateAttachmentRequest)(nil), // 1: memos.api.v1.CreateAttachmentRequest
-	(*ListAttachmentsRequest)(nil),  // 2: memos.api.v1.ListAttachmentsRequest
-	(*ListAttachmentsResponse)(nil), // 3: memos.api.v1.ListAttachmentsResponse
-	(*GetAttachmentRequest)(nil),    // 4: memos.api.v1.GetAttachmentRequest
-	(*UpdateAttachmentRequest)(nil), // 5: memos.api.v1.UpdateAttachmentRequest
-	(*DeleteAttachmentRequest)(nil), // 6: memos.api.v1.DeleteAttachmentRequest
-	(*timestamppb.Timestamp)(nil),   // 7: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),   // 8: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),           // 9: google.protobuf.Empty
+	(*Attachment)(nil),                       // 0: memos.api.v1.Attachment
+	(*CreateAttachmentRequest)(nil),          // 1: memos.api.v1.CreateAttachmentRequest
+	(*CreateAttachmentUploadRequest)(nil),    // 2: memos.api.v1.CreateAttachmentUploadRequest
+	(*CreateAttachmentUploadResponse)(nil),   // 3: memos.api.v1.CreateAttachmentUploadResponse
+	(*CompleteAttachmentUploadRequest)(nil),  // 4: memos.api.v1.CompleteAttachmentUploadRequest
+	(*CreateChunkedUploadRequest)(nil),       // 5: memos.api.v1.CreateChunkedUploadRequest
+	(*ChunkedUpload)(nil),                    // 6: memos.api.v1.ChunkedUpload
+	(*UploadAttachmentChunkRequest)(nil),     // 7: memos.api.v1.UploadAttachmentChunkRequest
+	(*GetChunkedUploadRequest)(nil),          // 8: memos.api.v1.GetChunkedUploadRequest
+	(*CompleteChunkedUploadRequest)(nil),     // 9: memos.api.v1.CompleteChunkedUploadRequest
+	(*ListAttachmentsRequest)(nil),           // 10: memos.api.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),          // 11: memos.api.v1.ListAttachmentsResponse
+	(*RefreshExternalAttachmentRequest)(nil), // 12: memos.api.v1.RefreshExternalAttachmentRequest
+	(*GetAttachmentRequest)(nil),             // 13: memos.api.v1.GetAttachmentRequest
+	(*UpdateAttachmentRequest)(nil),          // 14: memos.api.v1.UpdateAttachmentRequest
+	(*DeleteAttachmentRequest)(nil),          // 15: memos.api.v1.DeleteAttachmentRequest
+	(*PurgeOrphanedAttachmentsRequest)(nil),  // 16: memos.api.v1.PurgeOrphanedAttachmentsRequest
+	(*PurgeOrphanedAttachmentsResponse)(nil), // 17: memos.api.v1.PurgeOrphanedAttachmentsResponse
+	(*DeduplicateAttachmentsRequest)(nil),    // 18: memos.api.v1.DeduplicateAttachmentsRequest
+	(*DeduplicateAttachmentsResponse)(nil),   // 19: memos.api.v1.DeduplicateAttachmentsResponse
+	(*RecalculateStorageUsageRequest)(nil),   // 20: memos.api.v1.RecalculateStorageUsageRequest
+	(*RecalculateStorageUsageResponse)(nil),  // 21: memos.api.v1.RecalculateStorageUsageResponse
+	(*timestamppb.Timestamp)(nil),            // 22: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 23: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),            // 24: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                    // 25: google.protobuf.Empty
 }
 var file_api_v1_attachment_service_proto_depIdxs = []int32{
-	7,  // 0: memos.api.v1.Attachment.create_time:type_name -> google.protobuf.Timestamp
-	0,  // 1: memos.api.v1.CreateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
-	0,  // 2: memos.api.v1.ListAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	0,  // 3: memos.api.v1.UpdateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
-	8,  // 4: memos.api.v1.UpdateAttachmentRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 5: memos.api.v1.AttachmentService.CreateAttachment:input_type -> memos.api.v1.CreateAttachmentRequest
-	2,  // 6: memos.api.v1.AttachmentService.ListAttachments:input_type -> memos.api.v1.ListAttachmentsRequest
-	4,  // 7: memos.api.v1.AttachmentService.GetAttachment:input_type -> memos.api.v1.GetAttachmentRequest
-	5,  // 8: memos.api.v1.AttachmentService.UpdateAttachment:input_type -> memos.api.v1.UpdateAttachmentRequest
-	6,  // 9: memos.api.v1.AttachmentService.DeleteAttachment:input_type -> memos.api.v1.DeleteAttachmentRequest
-	0,  // 10: memos.api.v1.AttachmentService.CreateAttachment:output_type -> memos.api.v1.Attachment
-	3,  // 11: memos.api.v1.AttachmentService.ListAttachments:output_type -> memos.api.v1.ListAttachmentsResponse
-	0,  // 12: memos.api.v1.AttachmentService.GetAttachment:output_type -> memos.api.v1.Attachment
-	0,  // 13: memos.api.v1.AttachmentService.UpdateAttachment:output_type -> memos.api.v1.Attachment
-	9,  // 14: memos.api.v1.AttachmentService.DeleteAttachment:output_type -> google.protobuf.Empty
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	22, // 0: memos.api.v1.Attachment.create_time:type_name -> google.protobuf.Timestamp
+	23, // 1: memos.api.v1.Attachment.duration:type_name -> google.protobuf.Duration
+	22, // 2: memos.api.v1.Attachment.link_check_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: memos.api.v1.CreateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
+	0,  // 4: memos.api.v1.CreateAttachmentUploadRequest.attachment:type_name -> memos.api.v1.Attachment
+	0,  // 5: memos.api.v1.CreateAttachmentUploadResponse.attachment:type_name -> memos.api.v1.Attachment
+	22, // 6: memos.api.v1.CreateAttachmentUploadResponse.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: memos.api.v1.CreateChunkedUploadRequest.attachment:type_name -> memos.api.v1.Attachment
+	22, // 8: memos.api.v1.ChunkedUpload.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 9: memos.api.v1.ListAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	0,  // 10: memos.api.v1.UpdateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
+	24, // 11: memos.api.v1.UpdateAttachmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 12: memos.api.v1.AttachmentService.CreateAttachment:input_type -> memos.api.v1.CreateAttachmentRequest
+	2,  // 13: memos.api.v1.AttachmentService.CreateAttachmentUpload:input_type -> memos.api.v1.CreateAttachmentUploadRequest
+	4,  // 14: memos.api.v1.AttachmentService.CompleteAttachmentUpload:input_type -> memos.api.v1.CompleteAttachmentUploadRequest
+	5,  // 15: memos.api.v1.AttachmentService.CreateChunkedUpload:input_type -> memos.api.v1.CreateChunkedUploadRequest
+	7,  // 16: memos.api.v1.AttachmentService.UploadAttachmentChunk:input_type -> memos.api.v1.UploadAttachmentChunkRequest
+	8,  // 17: memos.api.v1.AttachmentService.GetChunkedUpload:input_type -> memos.api.v1.GetChunkedUploadRequest
+	9,  // 18: memos.api.v1.AttachmentService.CompleteChunkedUpload:input_type -> memos.api.v1.CompleteChunkedUploadRequest
+	10, // 19: memos.api.v1.AttachmentService.ListAttachments:input_type -> memos.api.v1.ListAttachmentsRequest
+	13, // 20: memos.api.v1.AttachmentService.GetAttachment:input_type -> memos.api.v1.GetAttachmentRequest
+	14, // 21: memos.api.v1.AttachmentService.UpdateAttachment:input_type -> memos.api.v1.UpdateAttachmentRequest
+	15, // 22: memos.api.v1.AttachmentService.DeleteAttachment:input_type -> memos.api.v1.DeleteAttachmentRequest
+	16, // 23: memos.api.v1.AttachmentService.PurgeOrphanedAttachments:input_type -> memos.api.v1.PurgeOrphanedAttachmentsRequest
+	18, // 24: memos.api.v1.AttachmentService.DeduplicateAttachments:input_type -> memos.api.v1.DeduplicateAttachmentsRequest
+	12, // 25: memos.api.v1.AttachmentService.RefreshExternalAttachment:input_type -> memos.api.v1.RefreshExternalAttachmentRequest
+	20, // 26: memos.api.v1.AttachmentService.RecalculateStorageUsage:input_type -> memos.api.v1.RecalculateStorageUsageRequest
+	0,  // 27: memos.api.v1.AttachmentService.CreateAttachment:output_type -> memos.api.v1.Attachment
+	3,  // 28: memos.api.v1.AttachmentService.CreateAttachmentUpload:output_type -> memos.api.v1.CreateAttachmentUploadResponse
+	0,  // 29: memos.api.v1.AttachmentService.CompleteAttachmentUpload:output_type -> memos.api.v1.Attachment
+	6,  // 30: memos.api.v1.AttachmentService.CreateChunkedUpload:output_type -> memos.api.v1.ChunkedUpload
+	25, // 31: memos.api.v1.AttachmentService.UploadAttachmentChunk:output_type -> google.protobuf.Empty
+	6,  // 32: memos.api.v1.AttachmentService.GetChunkedUpload:output_type -> memos.api.v1.ChunkedUpload
+	0,  // 33: memos.api.v1.AttachmentService.CompleteChunkedUpload:output_type -> memos.api.v1.Attachment
+	11, // 34: memos.api.v1.AttachmentService.ListAttachments:output_type -> memos.api.v1.ListAttachmentsResponse
+	0,  // 35: memos.api.v1.AttachmentService.GetAttachment:output_type -> memos.api.v1.Attachment
+	0,  // 36: memos.api.v1.AttachmentService.UpdateAttachment:output_type -> memos.api.v1.Attachment
+	25, // 37: memos.api.v1.AttachmentService.DeleteAttachment:output_type -> google.protobuf.Empty
+	17, // 38: memos.api.v1.AttachmentService.PurgeOrphanedAttachments:output_type -> memos.api.v1.PurgeOrphanedAttachmentsResponse
+	19, // 39: memos.api.v1.AttachmentService.DeduplicateAttachments:output_type -> memos.api.v1.DeduplicateAttachmentsResponse
+	0,  // 40: memos.api.v1.AttachmentService.RefreshExternalAttachment:output_type -> memos.api.v1.Attachment
+	21, // 41: memos.api.v1.AttachmentService.RecalculateStorageUsage:output_type -> memos.api.v1.RecalculateStorageUsageResponse
+	27, // [27:42] is the sub-list for method output_type
+	12, // [12:27] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_attachment_service_proto_init() }
@@ -598,7 +1629,7 @@ func file_api_v1_attachment_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_attachment_service_proto_rawDesc), len(file_api_v1_attachment_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
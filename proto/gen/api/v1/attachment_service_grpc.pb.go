@@ -20,11 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AttachmentService_CreateAttachment_FullMethodName = "/memos.api.v1.AttachmentService/CreateAttachment"
-	AttachmentService_ListAttachments_FullMethodName  = "/memos.api.v1.AttachmentService/ListAttachments"
-	AttachmentService_GetAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/GetAttachment"
-	AttachmentService_UpdateAttachment_FullMethodName = "/memos.api.v1.AttachmentService/UpdateAttachment"
-	AttachmentService_DeleteAttachment_FullMethodName = "/memos.api.v1.AttachmentService/DeleteAttachment"
+	AttachmentService_CreateAttachment_FullMethodName          = "/memos.api.v1.AttachmentService/CreateAttachment"
+	AttachmentService_CreateAttachmentUpload_FullMethodName    = "/memos.api.v1.AttachmentService/CreateAttachmentUpload"
+	AttachmentService_CompleteAttachmentUpload_FullMethodName  = "/memos.api.v1.AttachmentService/CompleteAttachmentUpload"
+	AttachmentService_CreateChunkedUpload_FullMethodName       = "/memos.api.v1.AttachmentService/CreateChunkedUpload"
+	AttachmentService_UploadAttachmentChunk_FullMethodName     = "/memos.api.v1.AttachmentService/UploadAttachmentChunk"
+	AttachmentService_GetChunkedUpload_FullMethodName          = "/memos.api.v1.AttachmentService/GetChunkedUpload"
+	AttachmentService_CompleteChunkedUpload_FullMethodName     = "/memos.api.v1.AttachmentService/CompleteChunkedUpload"
+	AttachmentService_ListAttachments_FullMethodName           = "/memos.api.v1.AttachmentService/ListAttachments"
+	AttachmentService_GetAttachment_FullMethodName             = "/memos.api.v1.AttachmentService/GetAttachment"
+	AttachmentService_UpdateAttachment_FullMethodName          = "/memos.api.v1.AttachmentService/UpdateAttachment"
+	AttachmentService_DeleteAttachment_FullMethodName          = "/memos.api.v1.AttachmentService/DeleteAttachment"
+	AttachmentService_PurgeOrphanedAttachments_FullMethodName  = "/memos.api.v1.AttachmentService/PurgeOrphanedAttachments"
+	AttachmentService_DeduplicateAttachments_FullMethodName    = "/memos.api.v1.AttachmentService/DeduplicateAttachments"
+	AttachmentService_RefreshExternalAttachment_FullMethodName = "/memos.api.v1.AttachmentService/RefreshExternalAttachment"
+	AttachmentService_RecalculateStorageUsage_FullMethodName   = "/memos.api.v1.AttachmentService/RecalculateStorageUsage"
 )
 
 // AttachmentServiceClient is the client API for AttachmentService service.
@@ -33,6 +43,26 @@ const (
 type AttachmentServiceClient interface {
 	// CreateAttachment creates a new attachment.
 	CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// CreateAttachmentUpload starts a direct upload of an attachment to the S3 storage, returning
+	// a pending attachment and a presigned upload URL. When the content must go through the
+	// server, as with the local storage, no upload URL is returned and the content is uploaded
+	// with CreateAttachment instead.
+	CreateAttachmentUpload(ctx context.Context, in *CreateAttachmentUploadRequest, opts ...grpc.CallOption) (*CreateAttachmentUploadResponse, error)
+	// CompleteAttachmentUpload completes a direct upload once the content is uploaded to the
+	// upload URL, checking the uploaded object.
+	CompleteAttachmentUpload(ctx context.Context, in *CompleteAttachmentUploadRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// CreateChunkedUpload starts a resumable upload of a large attachment in chunks, returning the
+	// chunk size. The pending attachment is completed by CompleteChunkedUpload once all the chunks
+	// are uploaded.
+	CreateChunkedUpload(ctx context.Context, in *CreateChunkedUploadRequest, opts ...grpc.CallOption) (*ChunkedUpload, error)
+	// UploadAttachmentChunk uploads a chunk of a chunked upload, replacing a previous upload of
+	// the chunk.
+	UploadAttachmentChunk(ctx context.Context, in *UploadAttachmentChunkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetChunkedUpload returns the status of a chunked upload, with the chunks left to upload.
+	GetChunkedUpload(ctx context.Context, in *GetChunkedUploadRequest, opts ...grpc.CallOption) (*ChunkedUpload, error)
+	// CompleteChunkedUpload assembles the chunks of a chunked upload into the attachment, checking
+	// the content hash.
+	CompleteChunkedUpload(ctx context.Context, in *CompleteChunkedUploadRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// ListAttachments lists all attachments.
 	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
 	// GetAttachment returns a attachment by name.
@@ -41,6 +71,21 @@ type AttachmentServiceClient interface {
 	UpdateAttachment(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PurgeOrphanedAttachments deletes the attachments without a memo older than the grace
+	// period of the storage setting, except library uploads.
+	// Only admins can purge orphaned attachments.
+	PurgeOrphanedAttachments(ctx context.Context, in *PurgeOrphanedAttachmentsRequest, opts ...grpc.CallOption) (*PurgeOrphanedAttachmentsResponse, error)
+	// DeduplicateAttachments hashes the local files of the attachments uploaded before the content
+	// hashes, and makes the attachments of a user with the same content share a single file.
+	// Only admins can deduplicate attachments.
+	DeduplicateAttachments(ctx context.Context, in *DeduplicateAttachmentsRequest, opts ...grpc.CallOption) (*DeduplicateAttachmentsResponse, error)
+	// RefreshExternalAttachment checks again that the external link of an attachment responds,
+	// marking the attachment as broken when its file is gone.
+	RefreshExternalAttachment(ctx context.Context, in *RefreshExternalAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// RecalculateStorageUsage recomputes the storage usages of all users from their attachments,
+	// fixing a drift of the usages counted on upload and delete.
+	// Only admins can recalculate the storage usages.
+	RecalculateStorageUsage(ctx context.Context, in *RecalculateStorageUsageRequest, opts ...grpc.CallOption) (*RecalculateStorageUsageResponse, error)
 }
 
 type attachmentServiceClient struct {
@@ -61,6 +106,66 @@ func (c *attachmentServiceClient) CreateAttachment(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *attachmentServiceClient) CreateAttachmentUpload(ctx context.Context, in *CreateAttachmentUploadRequest, opts ...grpc.CallOption) (*CreateAttachmentUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAttachmentUploadResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_CreateAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) CompleteAttachmentUpload(ctx context.Context, in *CompleteAttachmentUploadRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_CompleteAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) CreateChunkedUpload(ctx context.Context, in *CreateChunkedUploadRequest, opts ...grpc.CallOption) (*ChunkedUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChunkedUpload)
+	err := c.cc.Invoke(ctx, AttachmentService_CreateChunkedUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) UploadAttachmentChunk(ctx context.Context, in *UploadAttachmentChunkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttachmentService_UploadAttachmentChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetChunkedUpload(ctx context.Context, in *GetChunkedUploadRequest, opts ...grpc.CallOption) (*ChunkedUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChunkedUpload)
+	err := c.cc.Invoke(ctx, AttachmentService_GetChunkedUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) CompleteChunkedUpload(ctx context.Context, in *CompleteChunkedUploadRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_CompleteChunkedUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *attachmentServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAttachmentsResponse)
@@ -101,12 +206,72 @@ func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *attachmentServiceClient) PurgeOrphanedAttachments(ctx context.Context, in *PurgeOrphanedAttachmentsRequest, opts ...grpc.CallOption) (*PurgeOrphanedAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeOrphanedAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_PurgeOrphanedAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) DeduplicateAttachments(ctx context.Context, in *DeduplicateAttachmentsRequest, opts ...grpc.CallOption) (*DeduplicateAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeduplicateAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_DeduplicateAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) RefreshExternalAttachment(ctx context.Context, in *RefreshExternalAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_RefreshExternalAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) RecalculateStorageUsage(ctx context.Context, in *RecalculateStorageUsageRequest, opts ...grpc.CallOption) (*RecalculateStorageUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecalculateStorageUsageResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_RecalculateStorageUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttachmentServiceServer is the server API for AttachmentService service.
 // All implementations must embed UnimplementedAttachmentServiceServer
 // for forward compatibility.
 type AttachmentServiceServer interface {
 	// CreateAttachment creates a new attachment.
 	CreateAttachment(context.Context, *CreateAttachmentRequest) (*Attachment, error)
+	// CreateAttachmentUpload starts a direct upload of an attachment to the S3 storage, returning
+	// a pending attachment and a presigned upload URL. When the content must go through the
+	// server, as with the local storage, no upload URL is returned and the content is uploaded
+	// with CreateAttachment instead.
+	CreateAttachmentUpload(context.Context, *CreateAttachmentUploadRequest) (*CreateAttachmentUploadResponse, error)
+	// CompleteAttachmentUpload completes a direct upload once the content is uploaded to the
+	// upload URL, checking the uploaded object.
+	CompleteAttachmentUpload(context.Context, *CompleteAttachmentUploadRequest) (*Attachment, error)
+	// CreateChunkedUpload starts a resumable upload of a large attachment in chunks, returning the
+	// chunk size. The pending attachment is completed by CompleteChunkedUpload once all the chunks
+	// are uploaded.
+	CreateChunkedUpload(context.Context, *CreateChunkedUploadRequest) (*ChunkedUpload, error)
+	// UploadAttachmentChunk uploads a chunk of a chunked upload, replacing a previous upload of
+	// the chunk.
+	UploadAttachmentChunk(context.Context, *UploadAttachmentChunkRequest) (*emptypb.Empty, error)
+	// GetChunkedUpload returns the status of a chunked upload, with the chunks left to upload.
+	GetChunkedUpload(context.Context, *GetChunkedUploadRequest) (*ChunkedUpload, error)
+	// CompleteChunkedUpload assembles the chunks of a chunked upload into the attachment, checking
+	// the content hash.
+	CompleteChunkedUpload(context.Context, *CompleteChunkedUploadRequest) (*Attachment, error)
 	// ListAttachments lists all attachments.
 	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
 	// GetAttachment returns a attachment by name.
@@ -115,6 +280,21 @@ type AttachmentServiceServer interface {
 	UpdateAttachment(context.Context, *UpdateAttachmentRequest) (*Attachment, error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error)
+	// PurgeOrphanedAttachments deletes the attachments without a memo older than the grace
+	// period of the storage setting, except library uploads.
+	// Only admins can purge orphaned attachments.
+	PurgeOrphanedAttachments(context.Context, *PurgeOrphanedAttachmentsRequest) (*PurgeOrphanedAttachmentsResponse, error)
+	// DeduplicateAttachments hashes the local files of the attachments uploaded before the content
+	// hashes, and makes the attachments of a user with the same content share a single file.
+	// Only admins can deduplicate attachments.
+	DeduplicateAttachments(context.Context, *DeduplicateAttachmentsRequest) (*DeduplicateAttachmentsResponse, error)
+	// RefreshExternalAttachment checks again that the external link of an attachment responds,
+	// marking the attachment as broken when its file is gone.
+	RefreshExternalAttachment(context.Context, *RefreshExternalAttachmentRequest) (*Attachment, error)
+	// RecalculateStorageUsage recomputes the storage usages of all users from their attachments,
+	// fixing a drift of the usages counted on upload and delete.
+	// Only admins can recalculate the storage usages.
+	RecalculateStorageUsage(context.Context, *RecalculateStorageUsageRequest) (*RecalculateStorageUsageResponse, error)
 	mustEmbedUnimplementedAttachmentServiceServer()
 }
 
@@ -128,6 +308,24 @@ type UnimplementedAttachmentServiceServer struct{}
 func (UnimplementedAttachmentServiceServer) CreateAttachment(context.Context, *CreateAttachmentRequest) (*Attachment, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateAttachment not implemented")
 }
+func (UnimplementedAttachmentServiceServer) CreateAttachmentUpload(context.Context, *CreateAttachmentUploadRequest) (*CreateAttachmentUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) CompleteAttachmentUpload(context.Context, *CompleteAttachmentUploadRequest) (*Attachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) CreateChunkedUpload(context.Context, *CreateChunkedUploadRequest) (*ChunkedUpload, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateChunkedUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) UploadAttachmentChunk(context.Context, *UploadAttachmentChunkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadAttachmentChunk not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetChunkedUpload(context.Context, *GetChunkedUploadRequest) (*ChunkedUpload, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChunkedUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) CompleteChunkedUpload(context.Context, *CompleteChunkedUploadRequest) (*Attachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteChunkedUpload not implemented")
+}
 func (UnimplementedAttachmentServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAttachments not implemented")
 }
@@ -140,6 +338,18 @@ func (UnimplementedAttachmentServiceServer) UpdateAttachment(context.Context, *U
 func (UnimplementedAttachmentServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteAttachment not implemented")
 }
+func (UnimplementedAttachmentServiceServer) PurgeOrphanedAttachments(context.Context, *PurgeOrphanedAttachmentsRequest) (*PurgeOrphanedAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeOrphanedAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DeduplicateAttachments(context.Context, *DeduplicateAttachmentsRequest) (*DeduplicateAttachmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeduplicateAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) RefreshExternalAttachment(context.Context, *RefreshExternalAttachmentRequest) (*Attachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshExternalAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) RecalculateStorageUsage(context.Context, *RecalculateStorageUsageRequest) (*RecalculateStorageUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecalculateStorageUsage not implemented")
+}
 func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
 func (UnimplementedAttachmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -179,6 +389,114 @@ func _AttachmentService_CreateAttachment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_CreateAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CreateAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CreateAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CreateAttachmentUpload(ctx, req.(*CreateAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_CompleteAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CompleteAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CompleteAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CompleteAttachmentUpload(ctx, req.(*CompleteAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_CreateChunkedUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChunkedUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CreateChunkedUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CreateChunkedUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CreateChunkedUpload(ctx, req.(*CreateChunkedUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_UploadAttachmentChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).UploadAttachmentChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_UploadAttachmentChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).UploadAttachmentChunk(ctx, req.(*UploadAttachmentChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetChunkedUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkedUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetChunkedUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetChunkedUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetChunkedUpload(ctx, req.(*GetChunkedUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_CompleteChunkedUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteChunkedUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CompleteChunkedUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CompleteChunkedUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CompleteChunkedUpload(ctx, req.(*CompleteChunkedUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AttachmentService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAttachmentsRequest)
 	if err := dec(in); err != nil {
@@ -251,6 +569,78 @@ func _AttachmentService_DeleteAttachment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_PurgeOrphanedAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeOrphanedAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).PurgeOrphanedAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_PurgeOrphanedAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).PurgeOrphanedAttachments(ctx, req.(*PurgeOrphanedAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_DeduplicateAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeduplicateAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).DeduplicateAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_DeduplicateAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).DeduplicateAttachments(ctx, req.(*DeduplicateAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_RefreshExternalAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshExternalAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).RefreshExternalAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_RefreshExternalAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).RefreshExternalAttachment(ctx, req.(*RefreshExternalAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_RecalculateStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).RecalculateStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_RecalculateStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).RecalculateStorageUsage(ctx, req.(*RecalculateStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -262,6 +652,30 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAttachment",
 			Handler:    _AttachmentService_CreateAttachment_Handler,
 		},
+		{
+			MethodName: "CreateAttachmentUpload",
+			Handler:    _AttachmentService_CreateAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "CompleteAttachmentUpload",
+			Handler:    _AttachmentService_CompleteAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "CreateChunkedUpload",
+			Handler:    _AttachmentService_CreateChunkedUpload_Handler,
+		},
+		{
+			MethodName: "UploadAttachmentChunk",
+			Handler:    _AttachmentService_UploadAttachmentChunk_Handler,
+		},
+		{
+			MethodName: "GetChunkedUpload",
+			Handler:    _AttachmentService_GetChunkedUpload_Handler,
+		},
+		{
+			MethodName: "CompleteChunkedUpload",
+			Handler:    _AttachmentService_CompleteChunkedUpload_Handler,
+		},
 		{
 			MethodName: "ListAttachments",
 			Handler:    _AttachmentService_ListAttachments_Handler,
@@ -278,6 +692,22 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAttachment",
 			Handler:    _AttachmentService_DeleteAttachment_Handler,
 		},
+		{
+			MethodName: "PurgeOrphanedAttachments",
+			Handler:    _AttachmentService_PurgeOrphanedAttachments_Handler,
+		},
+		{
+			MethodName: "DeduplicateAttachments",
+			Handler:    _AttachmentService_DeduplicateAttachments_Handler,
+		},
+		{
+			MethodName: "RefreshExternalAttachment",
+			Handler:    _AttachmentService_RefreshExternalAttachment_Handler,
+		},
+		{
+			MethodName: "RecalculateStorageUsage",
+			Handler:    _AttachmentService_RecalculateStorageUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/attachment_service.proto",
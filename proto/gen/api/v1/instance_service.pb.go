@@ -10,7 +10,11 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -34,6 +38,16 @@ const (
 	InstanceSetting_STORAGE InstanceSetting_Key = 2
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSetting_MEMO_RELATED InstanceSetting_Key = 3
+	// LINK_PREVIEW is the key for link preview settings.
+	InstanceSetting_LINK_PREVIEW InstanceSetting_Key = 4
+	// EMAIL is the key for email settings.
+	InstanceSetting_EMAIL InstanceSetting_Key = 5
+	// BACKUP is the key for scheduled backup settings.
+	InstanceSetting_BACKUP InstanceSetting_Key = 6
+	// SLACK is the key for Slack app settings.
+	InstanceSetting_SLACK InstanceSetting_Key = 7
+	// MATRIX is the key for Matrix bot settings.
+	InstanceSetting_MATRIX InstanceSetting_Key = 8
 )
 
 // Enum value maps for InstanceSetting_Key.
@@ -43,12 +57,22 @@ var (
 		1: "GENERAL",
 		2: "STORAGE",
 		3: "MEMO_RELATED",
+		4: "LINK_PREVIEW",
+		5: "EMAIL",
+		6: "BACKUP",
+		7: "SLACK",
+		8: "MATRIX",
 	}
 	InstanceSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"STORAGE":         2,
 		"MEMO_RELATED":    3,
+		"LINK_PREVIEW":    4,
+		"EMAIL":           5,
+		"BACKUP":          6,
+		"SLACK":           7,
+		"MATRIX":          8,
 	}
 )
 
@@ -135,6 +159,375 @@ func (InstanceSetting_StorageSetting_StorageType) EnumDescriptor() ([]byte, []in
 	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 0}
 }
 
+// Mode controls which URLs may be previewed.
+type InstanceSetting_LinkPreviewSetting_Mode int32
+
+const (
+	InstanceSetting_LinkPreviewSetting_MODE_UNSPECIFIED InstanceSetting_LinkPreviewSetting_Mode = 0
+	// OPEN allows previews of any public URL.
+	InstanceSetting_LinkPreviewSetting_OPEN InstanceSetting_LinkPreviewSetting_Mode = 1
+	// ALLOWLIST only allows previews of URLs whose host matches allowed_domains.
+	InstanceSetting_LinkPreviewSetting_ALLOWLIST InstanceSetting_LinkPreviewSetting_Mode = 2
+	// DISABLED turns off link previews entirely.
+	InstanceSetting_LinkPreviewSetting_DISABLED InstanceSetting_LinkPreviewSetting_Mode = 3
+)
+
+// Enum value maps for InstanceSetting_LinkPreviewSetting_Mode.
+var (
+	InstanceSetting_LinkPreviewSetting_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "OPEN",
+		2: "ALLOWLIST",
+		3: "DISABLED",
+	}
+	InstanceSetting_LinkPreviewSetting_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"OPEN":             1,
+		"ALLOWLIST":        2,
+		"DISABLED":         3,
+	}
+)
+
+func (x InstanceSetting_LinkPreviewSetting_Mode) Enum() *InstanceSetting_LinkPreviewSetting_Mode {
+	p := new(InstanceSetting_LinkPreviewSetting_Mode)
+	*p = x
+	return p
+}
+
+func (x InstanceSetting_LinkPreviewSetting_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceSetting_LinkPreviewSetting_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceSetting_LinkPreviewSetting_Mode) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[2]
+}
+
+func (x InstanceSetting_LinkPreviewSetting_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceSetting_LinkPreviewSetting_Mode.Descriptor instead.
+func (InstanceSetting_LinkPreviewSetting_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
+// How the connection to the SMTP server is secured.
+type InstanceSetting_EmailSetting_TlsMode int32
+
+const (
+	InstanceSetting_EmailSetting_TLS_MODE_UNSPECIFIED InstanceSetting_EmailSetting_TlsMode = 0
+	// STARTTLS upgrades the connection with STARTTLS, usually on port 587, and fails if the
+	// server doesn't support it.
+	InstanceSetting_EmailSetting_STARTTLS InstanceSetting_EmailSetting_TlsMode = 1
+	// TLS connects with implicit TLS, usually on port 465.
+	InstanceSetting_EmailSetting_TLS InstanceSetting_EmailSetting_TlsMode = 2
+	// NONE never uses TLS, for relays on a trusted network. Credentials are only sent to
+	// localhost.
+	InstanceSetting_EmailSetting_NONE InstanceSetting_EmailSetting_TlsMode = 3
+)
+
+// Enum value maps for InstanceSetting_EmailSetting_TlsMode.
+var (
+	InstanceSetting_EmailSetting_TlsMode_name = map[int32]string{
+		0: "TLS_MODE_UNSPECIFIED",
+		1: "STARTTLS",
+		2: "TLS",
+		3: "NONE",
+	}
+	InstanceSetting_EmailSetting_TlsMode_value = map[string]int32{
+		"TLS_MODE_UNSPECIFIED": 0,
+		"STARTTLS":             1,
+		"TLS":                  2,
+		"NONE":                 3,
+	}
+)
+
+func (x InstanceSetting_EmailSetting_TlsMode) Enum() *InstanceSetting_EmailSetting_TlsMode {
+	p := new(InstanceSetting_EmailSetting_TlsMode)
+	*p = x
+	return p
+}
+
+func (x InstanceSetting_EmailSetting_TlsMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceSetting_EmailSetting_TlsMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[3].Descriptor()
+}
+
+func (InstanceSetting_EmailSetting_TlsMode) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[3]
+}
+
+func (x InstanceSetting_EmailSetting_TlsMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceSetting_EmailSetting_TlsMode.Descriptor instead.
+func (InstanceSetting_EmailSetting_TlsMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 4, 0}
+}
+
+// Destination of the backups.
+type InstanceSetting_BackupSetting_Destination int32
+
+const (
+	InstanceSetting_BackupSetting_DESTINATION_UNSPECIFIED InstanceSetting_BackupSetting_Destination = 0
+	// LOCAL writes the backups to a directory of the server.
+	InstanceSetting_BackupSetting_LOCAL InstanceSetting_BackupSetting_Destination = 1
+	// S3 uploads the backups to the bucket of the S3 storage setting.
+	InstanceSetting_BackupSetting_S3 InstanceSetting_BackupSetting_Destination = 2
+)
+
+// Enum value maps for InstanceSetting_BackupSetting_Destination.
+var (
+	InstanceSetting_BackupSetting_Destination_name = map[int32]string{
+		0: "DESTINATION_UNSPECIFIED",
+		1: "LOCAL",
+		2: "S3",
+	}
+	InstanceSetting_BackupSetting_Destination_value = map[string]int32{
+		"DESTINATION_UNSPECIFIED": 0,
+		"LOCAL":                   1,
+		"S3":                      2,
+	}
+)
+
+func (x InstanceSetting_BackupSetting_Destination) Enum() *InstanceSetting_BackupSetting_Destination {
+	p := new(InstanceSetting_BackupSetting_Destination)
+	*p = x
+	return p
+}
+
+func (x InstanceSetting_BackupSetting_Destination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceSetting_BackupSetting_Destination) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[4].Descriptor()
+}
+
+func (InstanceSetting_BackupSetting_Destination) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[4]
+}
+
+func (x InstanceSetting_BackupSetting_Destination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceSetting_BackupSetting_Destination.Descriptor instead.
+func (InstanceSetting_BackupSetting_Destination) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 5, 0}
+}
+
+type InstanceSetting_AnnouncementSetting_Severity int32
+
+const (
+	InstanceSetting_AnnouncementSetting_SEVERITY_UNSPECIFIED InstanceSetting_AnnouncementSetting_Severity = 0
+	InstanceSetting_AnnouncementSetting_INFO                 InstanceSetting_AnnouncementSetting_Severity = 1
+	InstanceSetting_AnnouncementSetting_WARNING              InstanceSetting_AnnouncementSetting_Severity = 2
+	InstanceSetting_AnnouncementSetting_CRITICAL             InstanceSetting_AnnouncementSetting_Severity = 3
+)
+
+// Enum value maps for InstanceSetting_AnnouncementSetting_Severity.
+var (
+	InstanceSetting_AnnouncementSetting_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "INFO",
+		2: "WARNING",
+		3: "CRITICAL",
+	}
+	InstanceSetting_AnnouncementSetting_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"INFO":                 1,
+		"WARNING":              2,
+		"CRITICAL":             3,
+	}
+)
+
+func (x InstanceSetting_AnnouncementSetting_Severity) Enum() *InstanceSetting_AnnouncementSetting_Severity {
+	p := new(InstanceSetting_AnnouncementSetting_Severity)
+	*p = x
+	return p
+}
+
+func (x InstanceSetting_AnnouncementSetting_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceSetting_AnnouncementSetting_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[5].Descriptor()
+}
+
+func (InstanceSetting_AnnouncementSetting_Severity) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[5]
+}
+
+func (x InstanceSetting_AnnouncementSetting_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceSetting_AnnouncementSetting_Severity.Descriptor instead.
+func (InstanceSetting_AnnouncementSetting_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 8, 0}
+}
+
+// Audited event types.
+type AuditLog_EventType int32
+
+const (
+	AuditLog_EVENT_TYPE_UNSPECIFIED AuditLog_EventType = 0
+	// A user signed in.
+	AuditLog_SIGN_IN AuditLog_EventType = 1
+	// A sign-in attempt failed.
+	AuditLog_SIGN_IN_FAILED AuditLog_EventType = 2
+	// A user created an access token.
+	AuditLog_ACCESS_TOKEN_CREATED AuditLog_EventType = 3
+	// A user revoked an access token.
+	AuditLog_ACCESS_TOKEN_REVOKED AuditLog_EventType = 4
+	// A session was signed out or revoked.
+	AuditLog_SESSION_REVOKED AuditLog_EventType = 5
+	// A user's role was changed.
+	AuditLog_USER_ROLE_CHANGED AuditLog_EventType = 6
+	// A user was deleted.
+	AuditLog_USER_DELETED AuditLog_EventType = 7
+	// An instance setting was changed.
+	AuditLog_INSTANCE_SETTING_CHANGED AuditLog_EventType = 8
+	// A user reset their password with an emailed token.
+	AuditLog_PASSWORD_RESET AuditLog_EventType = 9
+	// The signing key of JWTs was rotated.
+	AuditLog_SIGNING_KEY_ROTATED AuditLog_EventType = 10
+	// A retired signing key was expired.
+	AuditLog_SIGNING_KEY_EXPIRED AuditLog_EventType = 11
+	// A user was suspended by the host.
+	AuditLog_USER_SUSPENDED AuditLog_EventType = 12
+	// The suspension of a user was lifted.
+	AuditLog_USER_UNSUSPENDED AuditLog_EventType = 13
+)
+
+// Enum value maps for AuditLog_EventType.
+var (
+	AuditLog_EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "SIGN_IN",
+		2:  "SIGN_IN_FAILED",
+		3:  "ACCESS_TOKEN_CREATED",
+		4:  "ACCESS_TOKEN_REVOKED",
+		5:  "SESSION_REVOKED",
+		6:  "USER_ROLE_CHANGED",
+		7:  "USER_DELETED",
+		8:  "INSTANCE_SETTING_CHANGED",
+		9:  "PASSWORD_RESET",
+		10: "SIGNING_KEY_ROTATED",
+		11: "SIGNING_KEY_EXPIRED",
+		12: "USER_SUSPENDED",
+		13: "USER_UNSUSPENDED",
+	}
+	AuditLog_EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":   0,
+		"SIGN_IN":                  1,
+		"SIGN_IN_FAILED":           2,
+		"ACCESS_TOKEN_CREATED":     3,
+		"ACCESS_TOKEN_REVOKED":     4,
+		"SESSION_REVOKED":          5,
+		"USER_ROLE_CHANGED":        6,
+		"USER_DELETED":             7,
+		"INSTANCE_SETTING_CHANGED": 8,
+		"PASSWORD_RESET":           9,
+		"SIGNING_KEY_ROTATED":      10,
+		"SIGNING_KEY_EXPIRED":      11,
+		"USER_SUSPENDED":           12,
+		"USER_UNSUSPENDED":         13,
+	}
+)
+
+func (x AuditLog_EventType) Enum() *AuditLog_EventType {
+	p := new(AuditLog_EventType)
+	*p = x
+	return p
+}
+
+func (x AuditLog_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLog_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[6].Descriptor()
+}
+
+func (AuditLog_EventType) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[6]
+}
+
+func (x AuditLog_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLog_EventType.Descriptor instead.
+func (AuditLog_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type SigningKey_State int32
+
+const (
+	SigningKey_STATE_UNSPECIFIED SigningKey_State = 0
+	// The key signs new tokens.
+	SigningKey_CURRENT SigningKey_State = 1
+	// The key no longer signs tokens, but still verifies the tokens it signed.
+	SigningKey_RETIRED SigningKey_State = 2
+	// Tokens signed with the key are rejected.
+	SigningKey_EXPIRED SigningKey_State = 3
+)
+
+// Enum value maps for SigningKey_State.
+var (
+	SigningKey_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "CURRENT",
+		2: "RETIRED",
+		3: "EXPIRED",
+	}
+	SigningKey_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"CURRENT":           1,
+		"RETIRED":           2,
+		"EXPIRED":           3,
+	}
+)
+
+func (x SigningKey_State) Enum() *SigningKey_State {
+	p := new(SigningKey_State)
+	*p = x
+	return p
+}
+
+func (x SigningKey_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningKey_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[7].Descriptor()
+}
+
+func (SigningKey_State) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[7]
+}
+
+func (x SigningKey_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningKey_State.Descriptor instead.
+func (SigningKey_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
 // Instance profile message containing basic instance information.
 type InstanceProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -146,9 +539,15 @@ type InstanceProfile struct {
 	// Mode is the instance mode (e.g. "prod", "dev" or "demo").
 	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	// Instance URL is the URL of the instance.
-	InstanceUrl   string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	InstanceUrl string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// The announcement shown to the users, if one is active and the current user didn't
+	// dismiss it.
+	Announcement *InstanceSetting_AnnouncementSetting `protobuf:"bytes,7,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// The VAPID public key of Web Push, the applicationServerKey of the push subscriptions. Empty
+	// when push notifications are unavailable, as the instance URL isn't set.
+	WebPushPublicKey string `protobuf:"bytes,8,opt,name=web_push_public_key,json=webPushPublicKey,proto3" json:"web_push_public_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *InstanceProfile) Reset() {
@@ -209,6 +608,20 @@ func (x *InstanceProfile) GetInstanceUrl() string {
 	return ""
 }
 
+func (x *InstanceProfile) GetAnnouncement() *InstanceSetting_AnnouncementSetting {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *InstanceProfile) GetWebPushPublicKey() string {
+	if x != nil {
+		return x.WebPushPublicKey
+	}
+	return ""
+}
+
 // Request for instance profile.
 type GetInstanceProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -257,6 +670,13 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting_
 	//	*InstanceSetting_StorageSetting_
 	//	*InstanceSetting_MemoRelatedSetting_
+	//	*InstanceSetting_LinkPreviewSetting_
+	//	*InstanceSetting_EmailSetting_
+	//	*InstanceSetting_BackupSetting_
+	//	*InstanceSetting_SlackSetting_
+	//	*InstanceSetting_MatrixSetting_
+	//	*InstanceSetting_AnnouncementSetting_
+	//	*InstanceSetting_RateLimitSetting_
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -333,6 +753,69 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceSetting_MemoRelatedSe
 	return nil
 }
 
+func (x *InstanceSetting) GetLinkPreviewSetting() *InstanceSetting_LinkPreviewSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_LinkPreviewSetting_); ok {
+			return x.LinkPreviewSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetEmailSetting() *InstanceSetting_EmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_EmailSetting_); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetBackupSetting() *InstanceSetting_BackupSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_BackupSetting_); ok {
+			return x.BackupSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetSlackSetting() *InstanceSetting_SlackSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_SlackSetting_); ok {
+			return x.SlackSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetMatrixSetting() *InstanceSetting_MatrixSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_MatrixSetting_); ok {
+			return x.MatrixSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetAnnouncementSetting() *InstanceSetting_AnnouncementSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_AnnouncementSetting_); ok {
+			return x.AnnouncementSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetRateLimitSetting() *InstanceSetting_RateLimitSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_RateLimitSetting_); ok {
+			return x.RateLimitSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -349,12 +832,54 @@ type InstanceSetting_MemoRelatedSetting_ struct {
 	MemoRelatedSetting *InstanceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_LinkPreviewSetting_ struct {
+	LinkPreviewSetting *InstanceSetting_LinkPreviewSetting `protobuf:"bytes,5,opt,name=link_preview_setting,json=linkPreviewSetting,proto3,oneof"`
+}
+
+type InstanceSetting_EmailSetting_ struct {
+	EmailSetting *InstanceSetting_EmailSetting `protobuf:"bytes,6,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type InstanceSetting_BackupSetting_ struct {
+	BackupSetting *InstanceSetting_BackupSetting `protobuf:"bytes,7,opt,name=backup_setting,json=backupSetting,proto3,oneof"`
+}
+
+type InstanceSetting_SlackSetting_ struct {
+	SlackSetting *InstanceSetting_SlackSetting `protobuf:"bytes,8,opt,name=slack_setting,json=slackSetting,proto3,oneof"`
+}
+
+type InstanceSetting_MatrixSetting_ struct {
+	MatrixSetting *InstanceSetting_MatrixSetting `protobuf:"bytes,9,opt,name=matrix_setting,json=matrixSetting,proto3,oneof"`
+}
+
+type InstanceSetting_AnnouncementSetting_ struct {
+	AnnouncementSetting *InstanceSetting_AnnouncementSetting `protobuf:"bytes,10,opt,name=announcement_setting,json=announcementSetting,proto3,oneof"`
+}
+
+type InstanceSetting_RateLimitSetting_ struct {
+	RateLimitSetting *InstanceSetting_RateLimitSetting `protobuf:"bytes,11,opt,name=rate_limit_setting,json=rateLimitSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_GeneralSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_StorageSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting_) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_LinkPreviewSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_EmailSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_BackupSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_SlackSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_MatrixSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_AnnouncementSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_RateLimitSetting_) isInstanceSetting_Value() {}
+
 // Request message for GetInstanceSetting method.
 type GetInstanceSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -402,31 +927,29 @@ func (x *GetInstanceSettingRequest) GetName() string {
 	return ""
 }
 
-// Request message for UpdateInstanceSetting method.
-type UpdateInstanceSettingRequest struct {
+// Request message for DismissInstanceAnnouncement method.
+type DismissInstanceAnnouncementRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The instance setting resource which replaces the resource on the server.
-	Setting *InstanceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The id of the announcement to dismiss.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInstanceSettingRequest) Reset() {
-	*x = UpdateInstanceSettingRequest{}
+func (x *DismissInstanceAnnouncementRequest) Reset() {
+	*x = DismissInstanceAnnouncementRequest{}
 	mi := &file_api_v1_instance_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInstanceSettingRequest) String() string {
+func (x *DismissInstanceAnnouncementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstanceSettingRequest) ProtoMessage() {}
+func (*DismissInstanceAnnouncementRequest) ProtoMessage() {}
 
-func (x *UpdateInstanceSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *DismissInstanceAnnouncementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_instance_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,65 +961,2608 @@ func (x *UpdateInstanceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstanceSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInstanceSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DismissInstanceAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DismissInstanceAnnouncementRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateInstanceSettingRequest) GetSetting() *InstanceSetting {
+func (x *DismissInstanceAnnouncementRequest) GetId() string {
 	if x != nil {
-		return x.Setting
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
-	if x != nil {
-		return x.UpdateMask
-	}
-	return nil
+// Request message for UpdateInstanceSetting method.
+type UpdateInstanceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The instance setting resource which replaces the resource on the server.
+	Setting *InstanceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInstanceSettingRequest) Reset() {
+	*x = UpdateInstanceSettingRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstanceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceSettingRequest) ProtoMessage() {}
+
+func (x *UpdateInstanceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateInstanceSettingRequest) GetSetting() *InstanceSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// An audited authentication or admin event.
+type AuditLog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the audit log entry.
+	// Format: auditLogs/{id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The user who caused the event, empty for unauthenticated requests.
+	// Format: users/{user}
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The type of the event.
+	EventType AuditLog_EventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=memos.api.v1.AuditLog_EventType" json:"event_type,omitempty"`
+	// The IP address of the client.
+	IpAddress string `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The user agent of the client.
+	UserAgent string `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Event specific details.
+	Payload *structpb.Struct `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The time of the event.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuditLog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditLog) GetEventType() AuditLog_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AuditLog_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *AuditLog) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *AuditLog) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// Request message for ListAuditLogs method.
+type ListAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of entries to return.
+	// If unspecified, at most 50 entries will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListAuditLogs` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return entries caused by this user.
+	// Format: users/{user}
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Only return entries of this event type.
+	EventType AuditLog_EventType `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=memos.api.v1.AuditLog_EventType" json:"event_type,omitempty"`
+	// Only return entries created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Only return entries created at or before this time.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetEventType() AuditLog_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AuditLog_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// Response message for ListAuditLogs method.
+type ListAuditLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audit log entries, newest first.
+	AuditLogs []*AuditLog `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAuditLogsResponse) GetAuditLogs() []*AuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Diagnostics of the instance.
+type InstanceDiagnostics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database driver, e.g. "sqlite", "mysql" or "postgres".
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// The schema version of the database.
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// The statistics of the database connection pool.
+	DatabaseStats *InstanceDiagnostics_DatabaseStats `protobuf:"bytes,3,opt,name=database_stats,json=databaseStats,proto3" json:"database_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceDiagnostics) Reset() {
+	*x = InstanceDiagnostics{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDiagnostics) ProtoMessage() {}
+
+func (x *InstanceDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDiagnostics.ProtoReflect.Descriptor instead.
+func (*InstanceDiagnostics) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstanceDiagnostics) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *InstanceDiagnostics) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *InstanceDiagnostics) GetDatabaseStats() *InstanceDiagnostics_DatabaseStats {
+	if x != nil {
+		return x.DatabaseStats
+	}
+	return nil
+}
+
+// Request for instance diagnostics.
+type GetInstanceDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceDiagnosticsRequest) Reset() {
+	*x = GetInstanceDiagnosticsRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetInstanceDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{10}
+}
+
+// Statistics of the instance.
+type InstanceStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The running version of the server.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// How long the server has been running.
+	Uptime *durationpb.Duration `protobuf:"bytes,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// The time the statistics were computed, as they're cached for a few minutes.
+	ComputeTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=compute_time,json=computeTime,proto3" json:"compute_time,omitempty"`
+	// The number of users.
+	UserCount int32 `protobuf:"varint,4,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// The number of users who signed in or used a browser session within the last 30 days.
+	// Users who only use access tokens aren't counted.
+	ActiveUserCount int32 `protobuf:"varint,5,opt,name=active_user_count,json=activeUserCount,proto3" json:"active_user_count,omitempty"`
+	// The numbers of memos by visibility and state, comments included.
+	MemoCounts []*InstanceStats_MemoCount `protobuf:"bytes,6,rep,name=memo_counts,json=memoCounts,proto3" json:"memo_counts,omitempty"`
+	// The numbers of memos created by day over the last 90 days, in UTC, ordered by date.
+	// Days without memos are omitted.
+	DailyMemoCounts []*InstanceStats_DailyMemoCount `protobuf:"bytes,7,rep,name=daily_memo_counts,json=dailyMemoCounts,proto3" json:"daily_memo_counts,omitempty"`
+	// The attachments by storage backend.
+	StorageUsages []*InstanceStats_StorageUsage `protobuf:"bytes,8,rep,name=storage_usages,json=storageUsages,proto3" json:"storage_usages,omitempty"`
+	// The number of webhooks of the users.
+	UserWebhookCount int32 `protobuf:"varint,9,opt,name=user_webhook_count,json=userWebhookCount,proto3" json:"user_webhook_count,omitempty"`
+	// The number of webhooks of the instance.
+	InstanceWebhookCount int32 `protobuf:"varint,10,opt,name=instance_webhook_count,json=instanceWebhookCount,proto3" json:"instance_webhook_count,omitempty"`
+	// The size of the database, if the driver can report it.
+	DatabaseSizeBytes *int64 `protobuf:"varint,11,opt,name=database_size_bytes,json=databaseSizeBytes,proto3,oneof" json:"database_size_bytes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InstanceStats) Reset() {
+	*x = InstanceStats{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStats) ProtoMessage() {}
+
+func (x *InstanceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStats.ProtoReflect.Descriptor instead.
+func (*InstanceStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InstanceStats) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InstanceStats) GetUptime() *durationpb.Duration {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetComputeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputeTime
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetActiveUserCount() int32 {
+	if x != nil {
+		return x.ActiveUserCount
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetMemoCounts() []*InstanceStats_MemoCount {
+	if x != nil {
+		return x.MemoCounts
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetDailyMemoCounts() []*InstanceStats_DailyMemoCount {
+	if x != nil {
+		return x.DailyMemoCounts
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetStorageUsages() []*InstanceStats_StorageUsage {
+	if x != nil {
+		return x.StorageUsages
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetUserWebhookCount() int32 {
+	if x != nil {
+		return x.UserWebhookCount
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetInstanceWebhookCount() int32 {
+	if x != nil {
+		return x.InstanceWebhookCount
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetDatabaseSizeBytes() int64 {
+	if x != nil && x.DatabaseSizeBytes != nil {
+		return *x.DatabaseSizeBytes
+	}
+	return 0
+}
+
+// Request for instance statistics.
+type GetInstanceStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceStatsRequest) Reset() {
+	*x = GetInstanceStatsRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceStatsRequest) ProtoMessage() {}
+
+func (x *GetInstanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{12}
+}
+
+// The schema migration status of the instance database.
+type InstanceMigrationStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The schema version of the database, empty if it isn't initialized.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// The schema version of the running release.
+	TargetSchemaVersion string `protobuf:"bytes,2,opt,name=target_schema_version,json=targetSchemaVersion,proto3" json:"target_schema_version,omitempty"`
+	// The applied migrations, oldest first.
+	AppliedMigrations []*InstanceMigrationStatus_AppliedMigration `protobuf:"bytes,3,rep,name=applied_migrations,json=appliedMigrations,proto3" json:"applied_migrations,omitempty"`
+	// The number of migrations pending to reach the target schema version.
+	PendingCount int32 `protobuf:"varint,4,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	// The pending migration files, in the order they are applied.
+	PendingMigrations []string `protobuf:"bytes,5,rep,name=pending_migrations,json=pendingMigrations,proto3" json:"pending_migrations,omitempty"`
+	// Whether the database is up to date, i.e. no migration is pending.
+	UpToDate      bool `protobuf:"varint,6,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceMigrationStatus) Reset() {
+	*x = InstanceMigrationStatus{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceMigrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMigrationStatus) ProtoMessage() {}
+
+func (x *InstanceMigrationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMigrationStatus.ProtoReflect.Descriptor instead.
+func (*InstanceMigrationStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InstanceMigrationStatus) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *InstanceMigrationStatus) GetTargetSchemaVersion() string {
+	if x != nil {
+		return x.TargetSchemaVersion
+	}
+	return ""
+}
+
+func (x *InstanceMigrationStatus) GetAppliedMigrations() []*InstanceMigrationStatus_AppliedMigration {
+	if x != nil {
+		return x.AppliedMigrations
+	}
+	return nil
+}
+
+func (x *InstanceMigrationStatus) GetPendingCount() int32 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *InstanceMigrationStatus) GetPendingMigrations() []string {
+	if x != nil {
+		return x.PendingMigrations
+	}
+	return nil
+}
+
+func (x *InstanceMigrationStatus) GetUpToDate() bool {
+	if x != nil {
+		return x.UpToDate
+	}
+	return false
+}
+
+// Request for the instance migration status.
+type GetInstanceMigrationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceMigrationStatusRequest) Reset() {
+	*x = GetInstanceMigrationStatusRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceMigrationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceMigrationStatusRequest) ProtoMessage() {}
+
+func (x *GetInstanceMigrationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceMigrationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceMigrationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{14}
+}
+
+// The status of the backups of the instance. The status of the last run is kept in memory, so
+// it's reset when the server restarts.
+type InstanceBackupStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether a backup is running.
+	Running bool `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	// The time the last backup started, unset if no backup ran since the server started.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// The time the last backup finished.
+	LastFinishTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_finish_time,json=lastFinishTime,proto3" json:"last_finish_time,omitempty"`
+	// The error of the last backup, empty if it succeeded.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The file name of the archive of the last successful backup.
+	LastBackup string `protobuf:"bytes,5,opt,name=last_backup,json=lastBackup,proto3" json:"last_backup,omitempty"`
+	// The size of the archive of the last successful backup.
+	LastBackupSizeBytes int64 `protobuf:"varint,6,opt,name=last_backup_size_bytes,json=lastBackupSizeBytes,proto3" json:"last_backup_size_bytes,omitempty"`
+	// The time of the next scheduled backup, unset if the scheduled backups are disabled.
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceBackupStatus) Reset() {
+	*x = InstanceBackupStatus{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceBackupStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceBackupStatus) ProtoMessage() {}
+
+func (x *InstanceBackupStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceBackupStatus.ProtoReflect.Descriptor instead.
+func (*InstanceBackupStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InstanceBackupStatus) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *InstanceBackupStatus) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *InstanceBackupStatus) GetLastFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFinishTime
+	}
+	return nil
+}
+
+func (x *InstanceBackupStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *InstanceBackupStatus) GetLastBackup() string {
+	if x != nil {
+		return x.LastBackup
+	}
+	return ""
+}
+
+func (x *InstanceBackupStatus) GetLastBackupSizeBytes() int64 {
+	if x != nil {
+		return x.LastBackupSizeBytes
+	}
+	return 0
+}
+
+func (x *InstanceBackupStatus) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+type GetInstanceBackupStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceBackupStatusRequest) Reset() {
+	*x = GetInstanceBackupStatusRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceBackupStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceBackupStatusRequest) ProtoMessage() {}
+
+func (x *GetInstanceBackupStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceBackupStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceBackupStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{16}
+}
+
+type RunInstanceBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInstanceBackupRequest) Reset() {
+	*x = RunInstanceBackupRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInstanceBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInstanceBackupRequest) ProtoMessage() {}
+
+func (x *RunInstanceBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInstanceBackupRequest.ProtoReflect.Descriptor instead.
+func (*RunInstanceBackupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{17}
+}
+
+type SendTestEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address to send the test email to. Defaults to the email of the host.
+	Recipient     string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestEmailRequest) Reset() {
+	*x = SendTestEmailRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestEmailRequest) ProtoMessage() {}
+
+func (x *SendTestEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendTestEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SendTestEmailRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// A key that signs and verifies JWTs. The secret of the key is never returned.
+type SigningKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the signing key. The last segment is the kid header of tokens
+	// signed with the key.
+	// Format: signingKeys/{key_id}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The state of the key.
+	State SigningKey_State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.SigningKey_State" json:"state,omitempty"`
+	// The time the key was created, unset for the legacy key derived from the server secret.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the key was replaced as signing key, unset for the current key.
+	RetireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=retire_time,json=retireTime,proto3" json:"retire_time,omitempty"`
+	// The time tokens signed with the key stop being accepted, unset if they are accepted
+	// until the key is expired explicitly.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SigningKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SigningKey) GetState() SigningKey_State {
+	if x != nil {
+		return x.State
+	}
+	return SigningKey_STATE_UNSPECIFIED
+}
+
+func (x *SigningKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SigningKey) GetRetireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetireTime
+	}
+	return nil
+}
+
+func (x *SigningKey) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// Request message for ListSigningKeys method.
+type ListSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSigningKeysRequest) Reset() {
+	*x = ListSigningKeysRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSigningKeysRequest) ProtoMessage() {}
+
+func (x *ListSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{20}
+}
+
+// Response message for ListSigningKeys method.
+type ListSigningKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signing keys, newest first.
+	SigningKeys   []*SigningKey `protobuf:"bytes,1,rep,name=signing_keys,json=signingKeys,proto3" json:"signing_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSigningKeysResponse) Reset() {
+	*x = ListSigningKeysResponse{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSigningKeysResponse) ProtoMessage() {}
+
+func (x *ListSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSigningKeysResponse) GetSigningKeys() []*SigningKey {
+	if x != nil {
+		return x.SigningKeys
+	}
+	return nil
+}
+
+// Request message for RotateSigningKey method.
+type RotateSigningKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long tokens signed with the previous key stay valid.
+	// If unset, they stay valid until the key is expired with ExpireSigningKey.
+	GracePeriod   *durationpb.Duration `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSigningKeyRequest) Reset() {
+	*x = RotateSigningKeyRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSigningKeyRequest) ProtoMessage() {}
+
+func (x *RotateSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RotateSigningKeyRequest) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+// Request message for ExpireSigningKey method.
+type ExpireSigningKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the signing key to expire.
+	// Format: signingKeys/{key_id}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireSigningKeyRequest) Reset() {
+	*x = ExpireSigningKeyRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSigningKeyRequest) ProtoMessage() {}
+
+func (x *ExpireSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*ExpireSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExpireSigningKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
 // General instance settings configuration.
 type InstanceSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// disallow_user_registration disallows user registration.
-	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
-	// disallow_password_auth disallows password authentication.
-	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	// additional_script is the additional script.
-	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
-	// additional_style is the additional style.
-	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
-	// custom_profile is the custom profile.
-	CustomProfile *InstanceSetting_GeneralSetting_CustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
-	// week_start_day_offset is the week start day offset from Sunday.
-	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
-	// Default is Sunday.
-	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
-	// disallow_change_username disallows changing username.
-	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
-	// disallow_change_nickname disallows changing nickname.
-	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// disallow_user_registration disallows user registration.
+	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
+	// disallow_password_auth disallows password authentication.
+	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
+	// additional_script is the additional script.
+	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
+	// additional_style is the additional style.
+	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
+	// custom_profile is the custom profile.
+	CustomProfile *InstanceSetting_GeneralSetting_CustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
+	// week_start_day_offset is the week start day offset from Sunday.
+	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
+	// Default is Sunday.
+	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
+	// disallow_change_username disallows changing username.
+	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
+	// disallow_change_nickname disallows changing nickname.
+	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
+	// audit_log_retention_days is how many days audit log entries are kept.
+	// Default is 90 days.
+	AuditLogRetentionDays int32 `protobuf:"varint,10,opt,name=audit_log_retention_days,json=auditLogRetentionDays,proto3" json:"audit_log_retention_days,omitempty"`
+	// session_idle_timeout_hours is how long a session stays valid without being used.
+	// Default is 336 hours (14 days).
+	SessionIdleTimeoutHours int32 `protobuf:"varint,11,opt,name=session_idle_timeout_hours,json=sessionIdleTimeoutHours,proto3" json:"session_idle_timeout_hours,omitempty"`
+	// session_absolute_lifetime_hours caps the lifetime of a session from sign-in,
+	// however often it is used. 0 means no cap.
+	SessionAbsoluteLifetimeHours int32 `protobuf:"varint,12,opt,name=session_absolute_lifetime_hours,json=sessionAbsoluteLifetimeHours,proto3" json:"session_absolute_lifetime_hours,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_GeneralSetting) Reset() {
+	*x = InstanceSetting_GeneralSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_GeneralSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_GeneralSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_GeneralSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_GeneralSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *InstanceSetting_GeneralSetting) GetDisallowUserRegistration() bool {
+	if x != nil {
+		return x.DisallowUserRegistration
+	}
+	return false
+}
+
+func (x *InstanceSetting_GeneralSetting) GetDisallowPasswordAuth() bool {
+	if x != nil {
+		return x.DisallowPasswordAuth
+	}
+	return false
+}
+
+func (x *InstanceSetting_GeneralSetting) GetAdditionalScript() string {
+	if x != nil {
+		return x.AdditionalScript
+	}
+	return ""
+}
+
+func (x *InstanceSetting_GeneralSetting) GetAdditionalStyle() string {
+	if x != nil {
+		return x.AdditionalStyle
+	}
+	return ""
+}
+
+func (x *InstanceSetting_GeneralSetting) GetCustomProfile() *InstanceSetting_GeneralSetting_CustomProfile {
+	if x != nil {
+		return x.CustomProfile
+	}
+	return nil
+}
+
+func (x *InstanceSetting_GeneralSetting) GetWeekStartDayOffset() int32 {
+	if x != nil {
+		return x.WeekStartDayOffset
+	}
+	return 0
+}
+
+func (x *InstanceSetting_GeneralSetting) GetDisallowChangeUsername() bool {
+	if x != nil {
+		return x.DisallowChangeUsername
+	}
+	return false
+}
+
+func (x *InstanceSetting_GeneralSetting) GetDisallowChangeNickname() bool {
+	if x != nil {
+		return x.DisallowChangeNickname
+	}
+	return false
+}
+
+func (x *InstanceSetting_GeneralSetting) GetAuditLogRetentionDays() int32 {
+	if x != nil {
+		return x.AuditLogRetentionDays
+	}
+	return 0
+}
+
+func (x *InstanceSetting_GeneralSetting) GetSessionIdleTimeoutHours() int32 {
+	if x != nil {
+		return x.SessionIdleTimeoutHours
+	}
+	return 0
+}
+
+func (x *InstanceSetting_GeneralSetting) GetSessionAbsoluteLifetimeHours() int32 {
+	if x != nil {
+		return x.SessionAbsoluteLifetimeHours
+	}
+	return 0
+}
+
+// Storage configuration settings for instance attachments.
+type InstanceSetting_StorageSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// storage_type is the storage type.
+	StorageType InstanceSetting_StorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.api.v1.InstanceSetting_StorageSetting_StorageType" json:"storage_type,omitempty"`
+	// The template of file path.
+	// e.g. assets/{timestamp}_{filename}
+	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
+	// The max upload size in megabytes.
+	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
+	// The S3 config.
+	S3Config *InstanceSetting_StorageSetting_S3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	// orphaned_attachment_grace_days is how many days attachments without a memo are kept
+	// before they are purged. Library uploads are never purged.
+	OrphanedAttachmentGraceDays int32 `protobuf:"varint,5,opt,name=orphaned_attachment_grace_days,json=orphanedAttachmentGraceDays,proto3" json:"orphaned_attachment_grace_days,omitempty"`
+	// strip_image_metadata strips the EXIF and XMP metadata of uploaded images, keeping
+	// their orientation. Users may override it in their general settings.
+	StripImageMetadata bool `protobuf:"varint,6,opt,name=strip_image_metadata,json=stripImageMetadata,proto3" json:"strip_image_metadata,omitempty"`
+	// default_user_quota_mb is the storage quota of each user in MiB, 0 means unlimited.
+	// The host may set another quota on a user.
+	DefaultUserQuotaMb int64 `protobuf:"varint,7,opt,name=default_user_quota_mb,json=defaultUserQuotaMb,proto3" json:"default_user_quota_mb,omitempty"`
+	// image_compression re-encodes the large JPEG and PNG uploads to save storage.
+	ImageCompression *InstanceSetting_StorageSetting_ImageCompressionConfig `protobuf:"bytes,8,opt,name=image_compression,json=imageCompression,proto3" json:"image_compression,omitempty"`
+	// check_external_links re-checks the external links of the attachments every week, marking
+	// the links whose files are gone as broken.
+	CheckExternalLinks bool `protobuf:"varint,9,opt,name=check_external_links,json=checkExternalLinks,proto3" json:"check_external_links,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_StorageSetting) Reset() {
+	*x = InstanceSetting_StorageSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_StorageSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_StorageSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_StorageSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_StorageSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *InstanceSetting_StorageSetting) GetStorageType() InstanceSetting_StorageSetting_StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return InstanceSetting_StorageSetting_STORAGE_TYPE_UNSPECIFIED
+}
+
+func (x *InstanceSetting_StorageSetting) GetFilepathTemplate() string {
+	if x != nil {
+		return x.FilepathTemplate
+	}
+	return ""
+}
+
+func (x *InstanceSetting_StorageSetting) GetUploadSizeLimitMb() int64 {
+	if x != nil {
+		return x.UploadSizeLimitMb
+	}
+	return 0
+}
+
+func (x *InstanceSetting_StorageSetting) GetS3Config() *InstanceSetting_StorageSetting_S3Config {
+	if x != nil {
+		return x.S3Config
+	}
+	return nil
+}
+
+func (x *InstanceSetting_StorageSetting) GetOrphanedAttachmentGraceDays() int32 {
+	if x != nil {
+		return x.OrphanedAttachmentGraceDays
+	}
+	return 0
+}
+
+func (x *InstanceSetting_StorageSetting) GetStripImageMetadata() bool {
+	if x != nil {
+		return x.StripImageMetadata
+	}
+	return false
+}
+
+func (x *InstanceSetting_StorageSetting) GetDefaultUserQuotaMb() int64 {
+	if x != nil {
+		return x.DefaultUserQuotaMb
+	}
+	return 0
+}
+
+func (x *InstanceSetting_StorageSetting) GetImageCompression() *InstanceSetting_StorageSetting_ImageCompressionConfig {
+	if x != nil {
+		return x.ImageCompression
+	}
+	return nil
+}
+
+func (x *InstanceSetting_StorageSetting) GetCheckExternalLinks() bool {
+	if x != nil {
+		return x.CheckExternalLinks
+	}
+	return false
+}
+
+// Memo-related instance settings and policies.
+type InstanceSetting_MemoRelatedSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disallow_public_visibility disallows set memo as public visibility.
+	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
+	// display_with_update_time orders and displays memo with update time.
+	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
+	// content_length_limit is the limit of content length. Unit is byte.
+	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
+	// enable_double_click_edit enables editing on double click.
+	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
+	// reactions is the list of reactions.
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
+	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
+	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
+	NsfwTags []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// trash_retention_days is how many days deleted memos stay in the trash before they are purged.
+	// Default is 30 days.
+	TrashRetentionDays int32 `protobuf:"varint,11,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	// memo_revision_limit is how many revisions are kept per memo, the oldest being pruned first.
+	// Default is 50 revisions.
+	MemoRevisionLimit int32 `protobuf:"varint,12,opt,name=memo_revision_limit,json=memoRevisionLimit,proto3" json:"memo_revision_limit,omitempty"`
+	// bookmark_duplicate_window_minutes is how long a bookmark memo is returned again instead of
+	// creating another memo when the same link is bookmarked.
+	// Default is 10 minutes.
+	BookmarkDuplicateWindowMinutes int32 `protobuf:"varint,13,opt,name=bookmark_duplicate_window_minutes,json=bookmarkDuplicateWindowMinutes,proto3" json:"bookmark_duplicate_window_minutes,omitempty"`
+	// max_memos_per_user is the number of memos, not counting the trash, a user may have.
+	// 0 means unlimited. The host is exempt and may override the limit per user.
+	MaxMemosPerUser int32 `protobuf:"varint,14,opt,name=max_memos_per_user,json=maxMemosPerUser,proto3" json:"max_memos_per_user,omitempty"`
+	// max_memos_per_day is the number of memos a user may create per day, in the time zone
+	// of the user. 0 means unlimited. The host is exempt and may override the limit per user.
+	MaxMemosPerDay int32 `protobuf:"varint,15,opt,name=max_memos_per_day,json=maxMemosPerDay,proto3" json:"max_memos_per_day,omitempty"`
+	// new_account_max_public_memos_per_day is the number of memos an account younger than
+	// new_account_days may create as public or make public per day. 0 means unlimited.
+	NewAccountMaxPublicMemosPerDay int32 `protobuf:"varint,16,opt,name=new_account_max_public_memos_per_day,json=newAccountMaxPublicMemosPerDay,proto3" json:"new_account_max_public_memos_per_day,omitempty"`
+	// new_account_days is how many days after its creation an account is new.
+	// Default is 7 days.
+	NewAccountDays int32 `protobuf:"varint,17,opt,name=new_account_days,json=newAccountDays,proto3" json:"new_account_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) Reset() {
+	*x = InstanceSetting_MemoRelatedSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_MemoRelatedSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_MemoRelatedSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_MemoRelatedSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetDisallowPublicVisibility() bool {
+	if x != nil {
+		return x.DisallowPublicVisibility
+	}
+	return false
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+	if x != nil {
+		return x.DisplayWithUpdateTime
+	}
+	return false
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetContentLengthLimit() int32 {
+	if x != nil {
+		return x.ContentLengthLimit
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+	if x != nil {
+		return x.EnableDoubleClickEdit
+	}
+	return false
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+	if x != nil {
+		return x.EnableBlurNsfwContent
+	}
+	return false
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetNsfwTags() []string {
+	if x != nil {
+		return x.NsfwTags
+	}
+	return nil
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetMemoRevisionLimit() int32 {
+	if x != nil {
+		return x.MemoRevisionLimit
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetBookmarkDuplicateWindowMinutes() int32 {
+	if x != nil {
+		return x.BookmarkDuplicateWindowMinutes
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetMaxMemosPerUser() int32 {
+	if x != nil {
+		return x.MaxMemosPerUser
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetMaxMemosPerDay() int32 {
+	if x != nil {
+		return x.MaxMemosPerDay
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetNewAccountMaxPublicMemosPerDay() int32 {
+	if x != nil {
+		return x.NewAccountMaxPublicMemosPerDay
+	}
+	return 0
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetNewAccountDays() int32 {
+	if x != nil {
+		return x.NewAccountDays
+	}
+	return 0
+}
+
+// Link preview settings controlling outbound metadata fetches.
+type InstanceSetting_LinkPreviewSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rate_limit_per_minute is the number of link preview requests a user may make per minute.
+	RateLimitPerMinute int32 `protobuf:"varint,1,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	// rate_limit_burst is the number of requests a user may make in a burst.
+	RateLimitBurst int32 `protobuf:"varint,2,opt,name=rate_limit_burst,json=rateLimitBurst,proto3" json:"rate_limit_burst,omitempty"`
+	// admin_rate_limit_per_minute is the per-minute limit applied to admin users.
+	AdminRateLimitPerMinute int32 `protobuf:"varint,3,opt,name=admin_rate_limit_per_minute,json=adminRateLimitPerMinute,proto3" json:"admin_rate_limit_per_minute,omitempty"`
+	// mode controls which URLs may be previewed.
+	Mode InstanceSetting_LinkPreviewSetting_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=memos.api.v1.InstanceSetting_LinkPreviewSetting_Mode" json:"mode,omitempty"`
+	// allowed_domains is the list of domain patterns allowed in ALLOWLIST mode.
+	// A leading "*." matches any subdomain, e.g. "*.wikipedia.org".
+	AllowedDomains []string `protobuf:"bytes,5,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	// allowed_internal_hosts lists private hosts that may be fetched despite the internal IP protection.
+	// Entries are hostnames (a leading "*." matches any subdomain), IP addresses or CIDR ranges, e.g. "192.168.1.0/24".
+	// Loopback and link-local (cloud metadata) addresses are never allowed.
+	AllowedInternalHosts []string `protobuf:"bytes,6,rep,name=allowed_internal_hosts,json=allowedInternalHosts,proto3" json:"allowed_internal_hosts,omitempty"`
+	// user_agent overrides the User-Agent sent with outbound fetches.
+	// Defaults to "memos-linkpreview/<version> (+<instance url>)".
+	UserAgent string `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// request_headers are extra headers sent with outbound fetches, e.g. Accept-Language.
+	// Credentials such as Authorization and Cookie are not allowed.
+	RequestHeaders map[string]string `protobuf:"bytes,8,rep,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) Reset() {
+	*x = InstanceSetting_LinkPreviewSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_LinkPreviewSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_LinkPreviewSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_LinkPreviewSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_LinkPreviewSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
+	}
+	return 0
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetRateLimitBurst() int32 {
+	if x != nil {
+		return x.RateLimitBurst
+	}
+	return 0
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetAdminRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.AdminRateLimitPerMinute
+	}
+	return 0
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetMode() InstanceSetting_LinkPreviewSetting_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return InstanceSetting_LinkPreviewSetting_MODE_UNSPECIFIED
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetAllowedInternalHosts() []string {
+	if x != nil {
+		return x.AllowedInternalHosts
+	}
+	return nil
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *InstanceSetting_LinkPreviewSetting) GetRequestHeaders() map[string]string {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+// Email settings for sending notifications and password reset links over SMTP.
+type InstanceSetting_EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_host is the host of the SMTP server. Email is disabled if empty.
+	SmtpHost string `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	// smtp_port is the port of the SMTP server. Defaults to 587.
+	SmtpPort int32 `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username is the username for SMTP authentication, if required.
+	SmtpUsername string `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	// smtp_password is the password for SMTP authentication.
+	SmtpPassword string `protobuf:"bytes,4,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// use_tls connects with implicit TLS, usually on port 465.
+	// Otherwise STARTTLS is used if the server supports it.
+	UseTls bool `protobuf:"varint,5,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// from_email is the sender address of emails.
+	FromEmail string `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the sender name of emails.
+	FromName string `protobuf:"bytes,7,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// inbound_domain is the domain of the inbound email addresses of the users, whose emails
+	// become memos, e.g. "memos.example.com". The emails are received by an inbound email
+	// service, e.g. Mailgun, which posts them to /email/inbound. Inbound email is disabled if
+	// empty.
+	InboundDomain string `protobuf:"bytes,8,opt,name=inbound_domain,json=inboundDomain,proto3" json:"inbound_domain,omitempty"`
+	// tls_mode is how the connection to the SMTP server is secured.
+	// If unspecified, use_tls is used.
+	TlsMode       InstanceSetting_EmailSetting_TlsMode `protobuf:"varint,9,opt,name=tls_mode,json=tlsMode,proto3,enum=memos.api.v1.InstanceSetting_EmailSetting_TlsMode" json:"tls_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_EmailSetting) Reset() {
+	*x = InstanceSetting_EmailSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_EmailSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_EmailSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_EmailSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceSetting_EmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetInboundDomain() string {
+	if x != nil {
+		return x.InboundDomain
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetTlsMode() InstanceSetting_EmailSetting_TlsMode {
+	if x != nil {
+		return x.TlsMode
+	}
+	return InstanceSetting_EmailSetting_TLS_MODE_UNSPECIFIED
+}
+
+// Backup settings for the scheduled backups of the database and the local attachments.
+type InstanceSetting_BackupSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled turns on the scheduled backups.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// schedule is when backups run, as a standard cron expression in UTC such as "0 3 * * *",
+	// or a descriptor such as "@daily" or "@every 12h". Defaults to "@daily".
+	Schedule string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// destination is where backups are written. Defaults to LOCAL.
+	Destination InstanceSetting_BackupSetting_Destination `protobuf:"varint,3,opt,name=destination,proto3,enum=memos.api.v1.InstanceSetting_BackupSetting_Destination" json:"destination,omitempty"`
+	// local_path is the directory of the LOCAL backups, relative to the data directory unless
+	// absolute. Defaults to "backups".
+	LocalPath string `protobuf:"bytes,4,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
+	// s3_prefix is the key prefix of the S3 backups. Defaults to "backups/".
+	S3Prefix string `protobuf:"bytes,5,opt,name=s3_prefix,json=s3Prefix,proto3" json:"s3_prefix,omitempty"`
+	// retention_count is how many backups are kept, the oldest being pruned first.
+	// Defaults to 7.
+	RetentionCount int32 `protobuf:"varint,6,opt,name=retention_count,json=retentionCount,proto3" json:"retention_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_BackupSetting) Reset() {
+	*x = InstanceSetting_BackupSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_BackupSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_BackupSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_BackupSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_BackupSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_BackupSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *InstanceSetting_BackupSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InstanceSetting_BackupSetting) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *InstanceSetting_BackupSetting) GetDestination() InstanceSetting_BackupSetting_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return InstanceSetting_BackupSetting_DESTINATION_UNSPECIFIED
+}
+
+func (x *InstanceSetting_BackupSetting) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *InstanceSetting_BackupSetting) GetS3Prefix() string {
+	if x != nil {
+		return x.S3Prefix
+	}
+	return ""
+}
+
+func (x *InstanceSetting_BackupSetting) GetRetentionCount() int32 {
+	if x != nil {
+		return x.RetentionCount
+	}
+	return 0
+}
+
+// Slack app settings for the /memo slash command and the "Save to memos" message action.
+type InstanceSetting_SlackSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// signing_secret verifies the requests of the Slack app. The integration is disabled while
+	// it's empty.
+	SigningSecret string `protobuf:"bytes,1,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// bot_token is the bot token of the Slack app, used to reply to messages, get the permalinks
+	// of messages and download their files.
+	BotToken      string `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_SlackSetting) Reset() {
+	*x = InstanceSetting_SlackSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_SlackSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_SlackSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_SlackSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_SlackSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_SlackSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *InstanceSetting_SlackSetting) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *InstanceSetting_SlackSetting) GetBotToken() string {
+	if x != nil {
+		return x.BotToken
+	}
+	return ""
+}
+
+// Matrix bot settings for creating memos from Matrix messages, and posting public memos.
+type InstanceSetting_MatrixSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// homeserver_url is the URL of the homeserver of the bot account. The bot is disabled while
+	// it or the access token is empty.
+	HomeserverUrl string `protobuf:"bytes,1,opt,name=homeserver_url,json=homeserverUrl,proto3" json:"homeserver_url,omitempty"`
+	// access_token is the access token of the bot account.
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// rooms are the rooms the bot joined, added when the bot is invited.
+	Rooms         []*InstanceSetting_MatrixSetting_Room `protobuf:"bytes,3,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_MatrixSetting) Reset() {
+	*x = InstanceSetting_MatrixSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_MatrixSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_MatrixSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_MatrixSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_MatrixSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_MatrixSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *InstanceSetting_MatrixSetting) GetHomeserverUrl() string {
+	if x != nil {
+		return x.HomeserverUrl
+	}
+	return ""
+}
+
+func (x *InstanceSetting_MatrixSetting) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *InstanceSetting_MatrixSetting) GetRooms() []*InstanceSetting_MatrixSetting_Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// Announcement banner shown to all users, e.g. for a planned maintenance.
+type InstanceSetting_AnnouncementSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the content of the announcement, which the dismissals are for.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// content is the markdown text of the announcement. No announcement is shown while it's
+	// empty.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// severity is how the announcement is highlighted. Defaults to INFO.
+	Severity InstanceSetting_AnnouncementSetting_Severity `protobuf:"varint,3,opt,name=severity,proto3,enum=memos.api.v1.InstanceSetting_AnnouncementSetting_Severity" json:"severity,omitempty"`
+	// start_time is when the announcement starts being shown. If not set, it's shown right away.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is when the announcement stops being shown. If not set, it's shown until it's
+	// removed.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// dismissible lets the users hide the announcement.
+	Dismissible   bool `protobuf:"varint,6,opt,name=dismissible,proto3" json:"dismissible,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_AnnouncementSetting) Reset() {
+	*x = InstanceSetting_AnnouncementSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_AnnouncementSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_AnnouncementSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_AnnouncementSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_AnnouncementSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_AnnouncementSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *InstanceSetting_AnnouncementSetting) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstanceSetting_AnnouncementSetting) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InstanceSetting_AnnouncementSetting) GetSeverity() InstanceSetting_AnnouncementSetting_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return InstanceSetting_AnnouncementSetting_SEVERITY_UNSPECIFIED
+}
+
+func (x *InstanceSetting_AnnouncementSetting) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AnnouncementSetting) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *InstanceSetting_AnnouncementSetting) GetDismissible() bool {
+	if x != nil {
+		return x.Dismissible
+	}
+	return false
+}
+
+// API rate limits, applied to each user or, for anonymous requests, to each IP. Reads and
+// writes are limited separately.
+type InstanceSetting_RateLimitSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disabled turns the API rate limits off.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// read_requests_per_minute is the number of read requests that may be made per minute.
+	// Defaults to 1200.
+	ReadRequestsPerMinute int32 `protobuf:"varint,2,opt,name=read_requests_per_minute,json=readRequestsPerMinute,proto3" json:"read_requests_per_minute,omitempty"`
+	// read_burst is the number of read requests that may be made in a burst. Defaults to 300.
+	ReadBurst int32 `protobuf:"varint,3,opt,name=read_burst,json=readBurst,proto3" json:"read_burst,omitempty"`
+	// write_requests_per_minute is the number of write requests that may be made per minute.
+	// Defaults to 120.
+	WriteRequestsPerMinute int32 `protobuf:"varint,4,opt,name=write_requests_per_minute,json=writeRequestsPerMinute,proto3" json:"write_requests_per_minute,omitempty"`
+	// write_burst is the number of write requests that may be made in a burst. Defaults to 30.
+	WriteBurst int32 `protobuf:"varint,5,opt,name=write_burst,json=writeBurst,proto3" json:"write_burst,omitempty"`
+	// host_multiplier multiplies the limits of the host. Defaults to 10.
+	HostMultiplier int32 `protobuf:"varint,6,opt,name=host_multiplier,json=hostMultiplier,proto3" json:"host_multiplier,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_RateLimitSetting) Reset() {
+	*x = InstanceSetting_RateLimitSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_RateLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_RateLimitSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_RateLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_RateLimitSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_RateLimitSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 9}
+}
+
+func (x *InstanceSetting_RateLimitSetting) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *InstanceSetting_RateLimitSetting) GetReadRequestsPerMinute() int32 {
+	if x != nil {
+		return x.ReadRequestsPerMinute
+	}
+	return 0
+}
+
+func (x *InstanceSetting_RateLimitSetting) GetReadBurst() int32 {
+	if x != nil {
+		return x.ReadBurst
+	}
+	return 0
+}
+
+func (x *InstanceSetting_RateLimitSetting) GetWriteRequestsPerMinute() int32 {
+	if x != nil {
+		return x.WriteRequestsPerMinute
+	}
+	return 0
+}
+
+func (x *InstanceSetting_RateLimitSetting) GetWriteBurst() int32 {
+	if x != nil {
+		return x.WriteBurst
+	}
+	return 0
+}
+
+func (x *InstanceSetting_RateLimitSetting) GetHostMultiplier() int32 {
+	if x != nil {
+		return x.HostMultiplier
+	}
+	return 0
+}
+
+// Custom profile configuration for instance branding.
+type InstanceSetting_GeneralSetting_CustomProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_GeneralSetting_CustomProfile) Reset() {
+	*x = InstanceSetting_GeneralSetting_CustomProfile{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_GeneralSetting_CustomProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
+
+func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_GeneralSetting_CustomProfile.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_GeneralSetting_CustomProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
+func (x *InstanceSetting_GeneralSetting_CustomProfile) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InstanceSetting_GeneralSetting_CustomProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InstanceSetting_GeneralSetting_CustomProfile) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+// S3 configuration for cloud storage backend.
+// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
+type InstanceSetting_StorageSetting_S3Config struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) Reset() {
+	*x = InstanceSetting_StorageSetting_S3Config{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_StorageSetting_S3Config) ProtoMessage() {}
+
+func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_StorageSetting_S3Config.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_StorageSetting_S3Config) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 0}
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) GetAccessKeySecret() string {
+	if x != nil {
+		return x.AccessKeySecret
+	}
+	return ""
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *InstanceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
+	if x != nil {
+		return x.UsePathStyle
+	}
+	return false
+}
+
+// Image compression configuration for the uploads.
+type InstanceSetting_StorageSetting_ImageCompressionConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled re-encodes the JPEG and PNG uploads larger than the threshold to WebP, or to a
+	// lower quality JPEG when smaller.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// quality is the encoding quality from 1 to 100, 0 meaning the default quality of 80.
+	Quality int32 `protobuf:"varint,2,opt,name=quality,proto3" json:"quality,omitempty"`
+	// max_dimension caps the long edge of the compressed images in pixels, 0 meaning uncapped.
+	MaxDimension int32 `protobuf:"varint,3,opt,name=max_dimension,json=maxDimension,proto3" json:"max_dimension,omitempty"`
+	// threshold_kb is the size in KiB up to which images are kept as uploaded.
+	ThresholdKb   int64 `protobuf:"varint,4,opt,name=threshold_kb,json=thresholdKb,proto3" json:"threshold_kb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) Reset() {
+	*x = InstanceSetting_StorageSetting_ImageCompressionConfig{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_StorageSetting_ImageCompressionConfig) ProtoMessage() {}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_StorageSetting_ImageCompressionConfig.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_StorageSetting_ImageCompressionConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 1}
+}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) GetMaxDimension() int32 {
+	if x != nil {
+		return x.MaxDimension
+	}
+	return 0
+}
+
+func (x *InstanceSetting_StorageSetting_ImageCompressionConfig) GetThresholdKb() int64 {
+	if x != nil {
+		return x.ThresholdKb
+	}
+	return 0
+}
+
+type InstanceSetting_MatrixSetting_Room struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// room_id is the ID of the room, e.g. "!abc:example.com".
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// enabled turns the messages of the room into memos.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// post_public_memos posts the new public memos of the users subscribed to the room.
+	PostPublicMemos bool `protobuf:"varint,3,opt,name=post_public_memos,json=postPublicMemos,proto3" json:"post_public_memos,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_MatrixSetting_Room) Reset() {
+	*x = InstanceSetting_MatrixSetting_Room{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_MatrixSetting_Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_MatrixSetting_Room) ProtoMessage() {}
+
+func (x *InstanceSetting_MatrixSetting_Room) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_MatrixSetting_Room.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_MatrixSetting_Room) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 7, 0}
+}
+
+func (x *InstanceSetting_MatrixSetting_Room) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *InstanceSetting_MatrixSetting_Room) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InstanceSetting_MatrixSetting_Room) GetPostPublicMemos() bool {
+	if x != nil {
+		return x.PostPublicMemos
+	}
+	return false
+}
+
+// Statistics of the database connection pool.
+type InstanceDiagnostics_DatabaseStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of open connections, 0 means unlimited.
+	MaxOpenConnections int32 `protobuf:"varint,1,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	// The number of established connections, both in use and idle.
+	OpenConnections int32 `protobuf:"varint,2,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	// The number of connections in use.
+	InUse int32 `protobuf:"varint,3,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	// The number of idle connections.
+	Idle int32 `protobuf:"varint,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	// The total number of connections waited for.
+	WaitCount int64 `protobuf:"varint,5,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	// The total time blocked waiting for a new connection.
+	WaitDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=wait_duration,json=waitDuration,proto3" json:"wait_duration,omitempty"`
+	// The total number of connections closed due to the maximum idle connections.
+	MaxIdleClosed int64 `protobuf:"varint,7,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	// The total number of connections closed due to the maximum idle time.
+	MaxIdleTimeClosed int64 `protobuf:"varint,8,opt,name=max_idle_time_closed,json=maxIdleTimeClosed,proto3" json:"max_idle_time_closed,omitempty"`
+	// The total number of connections closed due to the maximum connection lifetime.
+	MaxLifetimeClosed int64 `protobuf:"varint,9,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *InstanceSetting_GeneralSetting) Reset() {
-	*x = InstanceSetting_GeneralSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+func (x *InstanceDiagnostics_DatabaseStats) Reset() {
+	*x = InstanceDiagnostics_DatabaseStats{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstanceSetting_GeneralSetting) String() string {
+func (x *InstanceDiagnostics_DatabaseStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceSetting_GeneralSetting) ProtoMessage() {}
+func (*InstanceDiagnostics_DatabaseStats) ProtoMessage() {}
 
-func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+func (x *InstanceDiagnostics_DatabaseStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,98 +3573,101 @@ func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceSetting_GeneralSetting.ProtoReflect.Descriptor instead.
-func (*InstanceSetting_GeneralSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use InstanceDiagnostics_DatabaseStats.ProtoReflect.Descriptor instead.
+func (*InstanceDiagnostics_DatabaseStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *InstanceSetting_GeneralSetting) GetDisallowUserRegistration() bool {
+func (x *InstanceDiagnostics_DatabaseStats) GetMaxOpenConnections() int32 {
 	if x != nil {
-		return x.DisallowUserRegistration
+		return x.MaxOpenConnections
 	}
-	return false
+	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting) GetDisallowPasswordAuth() bool {
+func (x *InstanceDiagnostics_DatabaseStats) GetOpenConnections() int32 {
 	if x != nil {
-		return x.DisallowPasswordAuth
+		return x.OpenConnections
 	}
-	return false
+	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting) GetAdditionalScript() string {
+func (x *InstanceDiagnostics_DatabaseStats) GetInUse() int32 {
 	if x != nil {
-		return x.AdditionalScript
+		return x.InUse
 	}
-	return ""
+	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting) GetAdditionalStyle() string {
+func (x *InstanceDiagnostics_DatabaseStats) GetIdle() int32 {
 	if x != nil {
-		return x.AdditionalStyle
+		return x.Idle
 	}
-	return ""
+	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting) GetCustomProfile() *InstanceSetting_GeneralSetting_CustomProfile {
+func (x *InstanceDiagnostics_DatabaseStats) GetWaitCount() int64 {
 	if x != nil {
-		return x.CustomProfile
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *InstanceDiagnostics_DatabaseStats) GetWaitDuration() *durationpb.Duration {
+	if x != nil {
+		return x.WaitDuration
 	}
 	return nil
 }
 
-func (x *InstanceSetting_GeneralSetting) GetWeekStartDayOffset() int32 {
+func (x *InstanceDiagnostics_DatabaseStats) GetMaxIdleClosed() int64 {
 	if x != nil {
-		return x.WeekStartDayOffset
+		return x.MaxIdleClosed
 	}
 	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting) GetDisallowChangeUsername() bool {
+func (x *InstanceDiagnostics_DatabaseStats) GetMaxIdleTimeClosed() int64 {
 	if x != nil {
-		return x.DisallowChangeUsername
+		return x.MaxIdleTimeClosed
 	}
-	return false
+	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting) GetDisallowChangeNickname() bool {
+func (x *InstanceDiagnostics_DatabaseStats) GetMaxLifetimeClosed() int64 {
 	if x != nil {
-		return x.DisallowChangeNickname
+		return x.MaxLifetimeClosed
 	}
-	return false
+	return 0
 }
 
-// Storage configuration settings for instance attachments.
-type InstanceSetting_StorageSetting struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// storage_type is the storage type.
-	StorageType InstanceSetting_StorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.api.v1.InstanceSetting_StorageSetting_StorageType" json:"storage_type,omitempty"`
-	// The template of file path.
-	// e.g. assets/{timestamp}_{filename}
-	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
-	// The max upload size in megabytes.
-	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
-	// The S3 config.
-	S3Config      *InstanceSetting_StorageSetting_S3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+// The number of memos with a visibility and state.
+type InstanceStats_MemoCount struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Visibility Visibility             `protobuf:"varint,1,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	State      State                  `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// Whether the memos are in the trash.
+	Trashed       bool  `protobuf:"varint,3,opt,name=trashed,proto3" json:"trashed,omitempty"`
+	Count         int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstanceSetting_StorageSetting) Reset() {
-	*x = InstanceSetting_StorageSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+func (x *InstanceStats_MemoCount) Reset() {
+	*x = InstanceStats_MemoCount{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstanceSetting_StorageSetting) String() string {
+func (x *InstanceStats_MemoCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceSetting_StorageSetting) ProtoMessage() {}
+func (*InstanceStats_MemoCount) ProtoMessage() {}
 
-func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+func (x *InstanceStats_MemoCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,75 +3678,64 @@ func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceSetting_StorageSetting.ProtoReflect.Descriptor instead.
-func (*InstanceSetting_StorageSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use InstanceStats_MemoCount.ProtoReflect.Descriptor instead.
+func (*InstanceStats_MemoCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{11, 0}
 }
 
-func (x *InstanceSetting_StorageSetting) GetStorageType() InstanceSetting_StorageSetting_StorageType {
+func (x *InstanceStats_MemoCount) GetVisibility() Visibility {
 	if x != nil {
-		return x.StorageType
+		return x.Visibility
 	}
-	return InstanceSetting_StorageSetting_STORAGE_TYPE_UNSPECIFIED
+	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
-func (x *InstanceSetting_StorageSetting) GetFilepathTemplate() string {
+func (x *InstanceStats_MemoCount) GetState() State {
 	if x != nil {
-		return x.FilepathTemplate
+		return x.State
 	}
-	return ""
+	return State_STATE_UNSPECIFIED
 }
 
-func (x *InstanceSetting_StorageSetting) GetUploadSizeLimitMb() int64 {
+func (x *InstanceStats_MemoCount) GetTrashed() bool {
 	if x != nil {
-		return x.UploadSizeLimitMb
+		return x.Trashed
 	}
-	return 0
+	return false
 }
 
-func (x *InstanceSetting_StorageSetting) GetS3Config() *InstanceSetting_StorageSetting_S3Config {
+func (x *InstanceStats_MemoCount) GetCount() int32 {
 	if x != nil {
-		return x.S3Config
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-// Memo-related instance settings and policies.
-type InstanceSetting_MemoRelatedSetting struct {
+// The number of memos created on a day.
+type InstanceStats_DailyMemoCount struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// disallow_public_visibility disallows set memo as public visibility.
-	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
-	// display_with_update_time orders and displays memo with update time.
-	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
-	// content_length_limit is the limit of content length. Unit is byte.
-	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
-	// enable_double_click_edit enables editing on double click.
-	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
-	// reactions is the list of reactions.
-	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
-	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
-	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// The date, in YYYY-MM-DD format.
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstanceSetting_MemoRelatedSetting) Reset() {
-	*x = InstanceSetting_MemoRelatedSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+func (x *InstanceStats_DailyMemoCount) Reset() {
+	*x = InstanceStats_DailyMemoCount{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstanceSetting_MemoRelatedSetting) String() string {
+func (x *InstanceStats_DailyMemoCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceSetting_MemoRelatedSetting) ProtoMessage() {}
+func (*InstanceStats_DailyMemoCount) ProtoMessage() {}
 
-func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+func (x *InstanceStats_DailyMemoCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,85 +3746,53 @@ func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceSetting_MemoRelatedSetting.ProtoReflect.Descriptor instead.
-func (*InstanceSetting_MemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 2}
-}
-
-func (x *InstanceSetting_MemoRelatedSetting) GetDisallowPublicVisibility() bool {
-	if x != nil {
-		return x.DisallowPublicVisibility
-	}
-	return false
+// Deprecated: Use InstanceStats_DailyMemoCount.ProtoReflect.Descriptor instead.
+func (*InstanceStats_DailyMemoCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{11, 1}
 }
 
-func (x *InstanceSetting_MemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+func (x *InstanceStats_DailyMemoCount) GetDate() string {
 	if x != nil {
-		return x.DisplayWithUpdateTime
+		return x.Date
 	}
-	return false
+	return ""
 }
 
-func (x *InstanceSetting_MemoRelatedSetting) GetContentLengthLimit() int32 {
+func (x *InstanceStats_DailyMemoCount) GetCount() int32 {
 	if x != nil {
-		return x.ContentLengthLimit
+		return x.Count
 	}
 	return 0
 }
 
-func (x *InstanceSetting_MemoRelatedSetting) GetEnableDoubleClickEdit() bool {
-	if x != nil {
-		return x.EnableDoubleClickEdit
-	}
-	return false
-}
-
-func (x *InstanceSetting_MemoRelatedSetting) GetReactions() []string {
-	if x != nil {
-		return x.Reactions
-	}
-	return nil
-}
-
-func (x *InstanceSetting_MemoRelatedSetting) GetEnableBlurNsfwContent() bool {
-	if x != nil {
-		return x.EnableBlurNsfwContent
-	}
-	return false
-}
-
-func (x *InstanceSetting_MemoRelatedSetting) GetNsfwTags() []string {
-	if x != nil {
-		return x.NsfwTags
-	}
-	return nil
-}
-
-// Custom profile configuration for instance branding.
-type InstanceSetting_GeneralSetting_CustomProfile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+// The attachments of a storage backend.
+type InstanceStats_StorageUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The storage backend, "DATABASE", "LOCAL", "S3" or "EXTERNAL".
+	StorageType     string `protobuf:"bytes,1,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	AttachmentCount int32  `protobuf:"varint,2,opt,name=attachment_count,json=attachmentCount,proto3" json:"attachment_count,omitempty"`
+	// The total size of the attachments. External attachments only count the size they were
+	// created with, if any.
+	SizeBytes     int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstanceSetting_GeneralSetting_CustomProfile) Reset() {
-	*x = InstanceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+func (x *InstanceStats_StorageUsage) Reset() {
+	*x = InstanceStats_StorageUsage{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstanceSetting_GeneralSetting_CustomProfile) String() string {
+func (x *InstanceStats_StorageUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
+func (*InstanceStats_StorageUsage) ProtoMessage() {}
 
-func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+func (x *InstanceStats_StorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,61 +3803,67 @@ func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceSetting_GeneralSetting_CustomProfile.ProtoReflect.Descriptor instead.
-func (*InstanceSetting_GeneralSetting_CustomProfile) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 0, 0}
+// Deprecated: Use InstanceStats_StorageUsage.ProtoReflect.Descriptor instead.
+func (*InstanceStats_StorageUsage) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{11, 2}
 }
 
-func (x *InstanceSetting_GeneralSetting_CustomProfile) GetTitle() string {
+func (x *InstanceStats_StorageUsage) GetStorageType() string {
 	if x != nil {
-		return x.Title
+		return x.StorageType
 	}
 	return ""
 }
 
-func (x *InstanceSetting_GeneralSetting_CustomProfile) GetDescription() string {
+func (x *InstanceStats_StorageUsage) GetAttachmentCount() int32 {
 	if x != nil {
-		return x.Description
+		return x.AttachmentCount
 	}
-	return ""
+	return 0
 }
 
-func (x *InstanceSetting_GeneralSetting_CustomProfile) GetLogoUrl() string {
+func (x *InstanceStats_StorageUsage) GetSizeBytes() int64 {
 	if x != nil {
-		return x.LogoUrl
+		return x.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-// S3 configuration for cloud storage backend.
-// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
-type InstanceSetting_StorageSetting_S3Config struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
-	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
-	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// A migration file applied to the database.
+type InstanceMigrationStatus_AppliedMigration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the migration file, e.g. "0.25/09__schema_migration.sql", or "LATEST.sql"
+	// for the initial schema.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// The schema version of the migration.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The hex-encoded SHA-256 of the file when it was applied.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// The execution time of the migration.
+	Duration *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The time the migration was applied.
+	ApplyTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=apply_time,json=applyTime,proto3" json:"apply_time,omitempty"`
+	// Whether the file was modified after it was applied.
+	Modified      bool `protobuf:"varint,6,opt,name=modified,proto3" json:"modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) Reset() {
-	*x = InstanceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+func (x *InstanceMigrationStatus_AppliedMigration) Reset() {
+	*x = InstanceMigrationStatus_AppliedMigration{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) String() string {
+func (x *InstanceMigrationStatus_AppliedMigration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceSetting_StorageSetting_S3Config) ProtoMessage() {}
+func (*InstanceMigrationStatus_AppliedMigration) ProtoMessage() {}
 
-func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+func (x *InstanceMigrationStatus_AppliedMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,49 +3874,49 @@ func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceSetting_StorageSetting_S3Config.ProtoReflect.Descriptor instead.
-func (*InstanceSetting_StorageSetting_S3Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 0}
+// Deprecated: Use InstanceMigrationStatus_AppliedMigration.ProtoReflect.Descriptor instead.
+func (*InstanceMigrationStatus_AppliedMigration) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) GetAccessKeyId() string {
+func (x *InstanceMigrationStatus_AppliedMigration) GetFile() string {
 	if x != nil {
-		return x.AccessKeyId
+		return x.File
 	}
 	return ""
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) GetAccessKeySecret() string {
+func (x *InstanceMigrationStatus_AppliedMigration) GetVersion() string {
 	if x != nil {
-		return x.AccessKeySecret
+		return x.Version
 	}
 	return ""
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) GetEndpoint() string {
+func (x *InstanceMigrationStatus_AppliedMigration) GetChecksum() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Checksum
 	}
 	return ""
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) GetRegion() string {
+func (x *InstanceMigrationStatus_AppliedMigration) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Region
+		return x.Duration
 	}
-	return ""
+	return nil
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) GetBucket() string {
+func (x *InstanceMigrationStatus_AppliedMigration) GetApplyTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Bucket
+		return x.ApplyTime
 	}
-	return ""
+	return nil
 }
 
-func (x *InstanceSetting_StorageSetting_S3Config) GetUsePathStyle() bool {
+func (x *InstanceMigrationStatus_AppliedMigration) GetModified() bool {
 	if x != nil {
-		return x.UsePathStyle
+		return x.Modified
 	}
 	return false
 }
@@ -893,18 +3925,28 @@ var File_api_v1_instance_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"x\n" +
+	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x01\n" +
 	"\x0fInstanceProfile\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12!\n" +
-	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\"\x1b\n" +
-	"\x19GetInstanceProfileRequest\"\xef\x0f\n" +
+	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12U\n" +
+	"\fannouncement\x18\a \x01(\v21.memos.api.v1.InstanceSetting.AnnouncementSettingR\fannouncement\x12-\n" +
+	"\x13web_push_public_key\x18\b \x01(\tR\x10webPushPublicKey\"\x1b\n" +
+	"\x19GetInstanceProfileRequest\"\xa30\n" +
 	"\x0fInstanceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12W\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2,.memos.api.v1.InstanceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12W\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2,.memos.api.v1.InstanceSetting.StorageSettingH\x00R\x0estorageSetting\x12d\n" +
-	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x1a\xca\x04\n" +
+	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12d\n" +
+	"\x14link_preview_setting\x18\x05 \x01(\v20.memos.api.v1.InstanceSetting.LinkPreviewSettingH\x00R\x12linkPreviewSetting\x12Q\n" +
+	"\remail_setting\x18\x06 \x01(\v2*.memos.api.v1.InstanceSetting.EmailSettingH\x00R\femailSetting\x12T\n" +
+	"\x0ebackup_setting\x18\a \x01(\v2+.memos.api.v1.InstanceSetting.BackupSettingH\x00R\rbackupSetting\x12Q\n" +
+	"\rslack_setting\x18\b \x01(\v2*.memos.api.v1.InstanceSetting.SlackSettingH\x00R\fslackSetting\x12T\n" +
+	"\x0ematrix_setting\x18\t \x01(\v2+.memos.api.v1.InstanceSetting.MatrixSettingH\x00R\rmatrixSetting\x12f\n" +
+	"\x14announcement_setting\x18\n" +
+	" \x01(\v21.memos.api.v1.InstanceSetting.AnnouncementSettingH\x00R\x13announcementSetting\x12^\n" +
+	"\x12rate_limit_setting\x18\v \x01(\v2..memos.api.v1.InstanceSetting.RateLimitSettingH\x00R\x10rateLimitSetting\x1a\x87\x06\n" +
 	"\x0eGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -913,28 +3955,42 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2:.memos.api.v1.InstanceSetting.GeneralSetting.CustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x1ab\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x127\n" +
+	"\x18audit_log_retention_days\x18\n" +
+	" \x01(\x05R\x15auditLogRetentionDays\x12;\n" +
+	"\x1asession_idle_timeout_hours\x18\v \x01(\x05R\x17sessionIdleTimeoutHours\x12E\n" +
+	"\x1fsession_absolute_lifetime_hours\x18\f \x01(\x05R\x1csessionAbsoluteLifetimeHours\x1ab\n" +
 	"\rCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x1a\xbc\x04\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x1a\xa1\b\n" +
 	"\x0eStorageSetting\x12[\n" +
 	"\fstorage_type\x18\x01 \x01(\x0e28.memos.api.v1.InstanceSetting.StorageSetting.StorageTypeR\vstorageType\x12+\n" +
 	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
 	"\x14upload_size_limit_mb\x18\x03 \x01(\x03R\x11uploadSizeLimitMb\x12R\n" +
-	"\ts3_config\x18\x04 \x01(\v25.memos.api.v1.InstanceSetting.StorageSetting.S3ConfigR\bs3Config\x1a\xcc\x01\n" +
+	"\ts3_config\x18\x04 \x01(\v25.memos.api.v1.InstanceSetting.StorageSetting.S3ConfigR\bs3Config\x12C\n" +
+	"\x1eorphaned_attachment_grace_days\x18\x05 \x01(\x05R\x1borphanedAttachmentGraceDays\x120\n" +
+	"\x14strip_image_metadata\x18\x06 \x01(\bR\x12stripImageMetadata\x121\n" +
+	"\x15default_user_quota_mb\x18\a \x01(\x03R\x12defaultUserQuotaMb\x12p\n" +
+	"\x11image_compression\x18\b \x01(\v2C.memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfigR\x10imageCompression\x120\n" +
+	"\x14check_external_links\x18\t \x01(\bR\x12checkExternalLinks\x1a\xcc\x01\n" +
 	"\bS3Config\x12\"\n" +
 	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
 	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"L\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\x1a\x94\x01\n" +
+	"\x16ImageCompressionConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aquality\x18\x02 \x01(\x05R\aquality\x12#\n" +
+	"\rmax_dimension\x18\x03 \x01(\x05R\fmaxDimension\x12!\n" +
+	"\fthreshold_kb\x18\x04 \x01(\x03R\vthresholdKb\"L\n" +
 	"\vStorageType\x12\x1c\n" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\x1a\xea\x02\n" +
+	"\x02S3\x10\x03\x1a\xe7\x05\n" +
 	"\x12MemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -943,25 +3999,278 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\treactions\x18\a \x03(\tR\treactions\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags\"F\n" +
+	" \x03(\tR\bnsfwTags\x120\n" +
+	"\x14trash_retention_days\x18\v \x01(\x05R\x12trashRetentionDays\x12.\n" +
+	"\x13memo_revision_limit\x18\f \x01(\x05R\x11memoRevisionLimit\x12I\n" +
+	"!bookmark_duplicate_window_minutes\x18\r \x01(\x05R\x1ebookmarkDuplicateWindowMinutes\x12+\n" +
+	"\x12max_memos_per_user\x18\x0e \x01(\x05R\x0fmaxMemosPerUser\x12)\n" +
+	"\x11max_memos_per_day\x18\x0f \x01(\x05R\x0emaxMemosPerDay\x12L\n" +
+	"$new_account_max_public_memos_per_day\x18\x10 \x01(\x05R\x1enewAccountMaxPublicMemosPerDay\x12(\n" +
+	"\x10new_account_days\x18\x11 \x01(\x05R\x0enewAccountDays\x1a\xef\x04\n" +
+	"\x12LinkPreviewSetting\x121\n" +
+	"\x15rate_limit_per_minute\x18\x01 \x01(\x05R\x12rateLimitPerMinute\x12(\n" +
+	"\x10rate_limit_burst\x18\x02 \x01(\x05R\x0erateLimitBurst\x12<\n" +
+	"\x1badmin_rate_limit_per_minute\x18\x03 \x01(\x05R\x17adminRateLimitPerMinute\x12I\n" +
+	"\x04mode\x18\x04 \x01(\x0e25.memos.api.v1.InstanceSetting.LinkPreviewSetting.ModeR\x04mode\x12'\n" +
+	"\x0fallowed_domains\x18\x05 \x03(\tR\x0eallowedDomains\x124\n" +
+	"\x16allowed_internal_hosts\x18\x06 \x03(\tR\x14allowedInternalHosts\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12m\n" +
+	"\x0frequest_headers\x18\b \x03(\v2D.memos.api.v1.InstanceSetting.LinkPreviewSetting.RequestHeadersEntryR\x0erequestHeaders\x1aA\n" +
+	"\x13RequestHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04OPEN\x10\x01\x12\r\n" +
+	"\tALLOWLIST\x10\x02\x12\f\n" +
+	"\bDISABLED\x10\x03\x1a\xa3\x03\n" +
+	"\fEmailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x04 \x01(\tR\fsmtpPassword\x12\x17\n" +
+	"\ause_tls\x18\x05 \x01(\bR\x06useTls\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\a \x01(\tR\bfromName\x12%\n" +
+	"\x0einbound_domain\x18\b \x01(\tR\rinboundDomain\x12M\n" +
+	"\btls_mode\x18\t \x01(\x0e22.memos.api.v1.InstanceSetting.EmailSetting.TlsModeR\atlsMode\"D\n" +
+	"\aTlsMode\x12\x18\n" +
+	"\x14TLS_MODE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSTARTTLS\x10\x01\x12\a\n" +
+	"\x03TLS\x10\x02\x12\b\n" +
+	"\x04NONE\x10\x03\x1a\xc4\x02\n" +
+	"\rBackupSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12Y\n" +
+	"\vdestination\x18\x03 \x01(\x0e27.memos.api.v1.InstanceSetting.BackupSetting.DestinationR\vdestination\x12\x1d\n" +
+	"\n" +
+	"local_path\x18\x04 \x01(\tR\tlocalPath\x12\x1b\n" +
+	"\ts3_prefix\x18\x05 \x01(\tR\bs3Prefix\x12'\n" +
+	"\x0fretention_count\x18\x06 \x01(\x05R\x0eretentionCount\"=\n" +
+	"\vDestination\x12\x1b\n" +
+	"\x17DESTINATION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05LOCAL\x10\x01\x12\x06\n" +
+	"\x02S3\x10\x02\x1aR\n" +
+	"\fSlackSetting\x12%\n" +
+	"\x0esigning_secret\x18\x01 \x01(\tR\rsigningSecret\x12\x1b\n" +
+	"\tbot_token\x18\x02 \x01(\tR\bbotToken\x1a\x88\x02\n" +
+	"\rMatrixSetting\x12%\n" +
+	"\x0ehomeserver_url\x18\x01 \x01(\tR\rhomeserverUrl\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12F\n" +
+	"\x05rooms\x18\x03 \x03(\v20.memos.api.v1.InstanceSetting.MatrixSetting.RoomR\x05rooms\x1ae\n" +
+	"\x04Room\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12*\n" +
+	"\x11post_public_memos\x18\x03 \x01(\bR\x0fpostPublicMemos\x1a\x85\x03\n" +
+	"\x13AnnouncementSetting\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12V\n" +
+	"\bseverity\x18\x03 \x01(\x0e2:.memos.api.v1.InstanceSetting.AnnouncementSetting.SeverityR\bseverity\x12>\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartTime\x12:\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendTime\x12 \n" +
+	"\vdismissible\x18\x06 \x01(\bR\vdismissible\"I\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04INFO\x10\x01\x12\v\n" +
+	"\aWARNING\x10\x02\x12\f\n" +
+	"\bCRITICAL\x10\x03\x1a\x8b\x02\n" +
+	"\x10RateLimitSetting\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x127\n" +
+	"\x18read_requests_per_minute\x18\x02 \x01(\x05R\x15readRequestsPerMinute\x12\x1d\n" +
+	"\n" +
+	"read_burst\x18\x03 \x01(\x05R\treadBurst\x129\n" +
+	"\x19write_requests_per_minute\x18\x04 \x01(\x05R\x16writeRequestsPerMinute\x12\x1f\n" +
+	"\vwrite_burst\x18\x05 \x01(\x05R\n" +
+	"writeBurst\x12'\n" +
+	"\x0fhost_multiplier\x18\x06 \x01(\x05R\x0ehostMultiplier\"\x86\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x03:a\xeaA^\n" +
+	"\fMEMO_RELATED\x10\x03\x12\x10\n" +
+	"\fLINK_PREVIEW\x10\x04\x12\t\n" +
+	"\x05EMAIL\x10\x05\x12\n" +
+	"\n" +
+	"\x06BACKUP\x10\x06\x12\t\n" +
+	"\x05SLACK\x10\a\x12\n" +
+	"\n" +
+	"\x06MATRIX\x10\b:a\xeaA^\n" +
 	"\x1cmemos.api.v1/InstanceSetting\x12\x1binstance/settings/{setting}*\x10instanceSettings2\x0finstanceSettingB\a\n" +
 	"\x05value\"U\n" +
 	"\x19GetInstanceSettingRequest\x128\n" +
 	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
-	"\x1cmemos.api.v1/InstanceSettingR\x04name\"\x9e\x01\n" +
+	"\x1cmemos.api.v1/InstanceSettingR\x04name\"9\n" +
+	"\"DismissInstanceAnnouncementRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x9e\x01\n" +
 	"\x1cUpdateInstanceSettingRequest\x12<\n" +
 	"\asetting\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xdb\x03\n" +
+	"updateMask\"\xe2\x05\n" +
+	"\bAuditLog\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05actor\x18\x02 \x01(\tB\x03\xe0A\x03R\x05actor\x12D\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\x0e2 .memos.api.v1.AuditLog.EventTypeB\x03\xe0A\x03R\teventType\x12\"\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tB\x03\xe0A\x03R\tipAddress\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tB\x03\xe0A\x03R\tuserAgent\x126\n" +
+	"\apayload\x18\x06 \x01(\v2\x17.google.protobuf.StructB\x03\xe0A\x03R\apayload\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"\xc8\x02\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aSIGN_IN\x10\x01\x12\x12\n" +
+	"\x0eSIGN_IN_FAILED\x10\x02\x12\x18\n" +
+	"\x14ACCESS_TOKEN_CREATED\x10\x03\x12\x18\n" +
+	"\x14ACCESS_TOKEN_REVOKED\x10\x04\x12\x13\n" +
+	"\x0fSESSION_REVOKED\x10\x05\x12\x15\n" +
+	"\x11USER_ROLE_CHANGED\x10\x06\x12\x10\n" +
+	"\fUSER_DELETED\x10\a\x12\x1c\n" +
+	"\x18INSTANCE_SETTING_CHANGED\x10\b\x12\x12\n" +
+	"\x0ePASSWORD_RESET\x10\t\x12\x17\n" +
+	"\x13SIGNING_KEY_ROTATED\x10\n" +
+	"\x12\x17\n" +
+	"\x13SIGNING_KEY_EXPIRED\x10\v\x12\x12\n" +
+	"\x0eUSER_SUSPENDED\x10\f\x12\x14\n" +
+	"\x10USER_UNSUSPENDED\x10\r:L\xeaAI\n" +
+	"\x15memos.api.v1/AuditLog\x12\x15auditLogs/{audit_log}\x1a\x04name*\tauditLogs2\bauditLog\"\xb9\x02\n" +
+	"\x14ListAuditLogsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x19\n" +
+	"\x05actor\x18\x03 \x01(\tB\x03\xe0A\x01R\x05actor\x12D\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2 .memos.api.v1.AuditLog.EventTypeB\x03\xe0A\x01R\teventType\x12>\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\tstartTime\x12:\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\aendTime\"v\n" +
+	"\x15ListAuditLogsResponse\x125\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x16.memos.api.v1.AuditLogR\tauditLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xae\x04\n" +
+	"\x13InstanceDiagnostics\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\x12V\n" +
+	"\x0edatabase_stats\x18\x03 \x01(\v2/.memos.api.v1.InstanceDiagnostics.DatabaseStatsR\rdatabaseStats\x1a\xff\x02\n" +
+	"\rDatabaseStats\x120\n" +
+	"\x14max_open_connections\x18\x01 \x01(\x05R\x12maxOpenConnections\x12)\n" +
+	"\x10open_connections\x18\x02 \x01(\x05R\x0fopenConnections\x12\x15\n" +
+	"\x06in_use\x18\x03 \x01(\x05R\x05inUse\x12\x12\n" +
+	"\x04idle\x18\x04 \x01(\x05R\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\x05 \x01(\x03R\twaitCount\x12>\n" +
+	"\rwait_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fwaitDuration\x12&\n" +
+	"\x0fmax_idle_closed\x18\a \x01(\x03R\rmaxIdleClosed\x12/\n" +
+	"\x14max_idle_time_closed\x18\b \x01(\x03R\x11maxIdleTimeClosed\x12.\n" +
+	"\x13max_lifetime_closed\x18\t \x01(\x03R\x11maxLifetimeClosed\"\x1f\n" +
+	"\x1dGetInstanceDiagnosticsRequest\"\xe4\a\n" +
+	"\rInstanceStats\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x121\n" +
+	"\x06uptime\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06uptime\x12=\n" +
+	"\fcompute_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcomputeTime\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x04 \x01(\x05R\tuserCount\x12*\n" +
+	"\x11active_user_count\x18\x05 \x01(\x05R\x0factiveUserCount\x12F\n" +
+	"\vmemo_counts\x18\x06 \x03(\v2%.memos.api.v1.InstanceStats.MemoCountR\n" +
+	"memoCounts\x12V\n" +
+	"\x11daily_memo_counts\x18\a \x03(\v2*.memos.api.v1.InstanceStats.DailyMemoCountR\x0fdailyMemoCounts\x12O\n" +
+	"\x0estorage_usages\x18\b \x03(\v2(.memos.api.v1.InstanceStats.StorageUsageR\rstorageUsages\x12,\n" +
+	"\x12user_webhook_count\x18\t \x01(\x05R\x10userWebhookCount\x124\n" +
+	"\x16instance_webhook_count\x18\n" +
+	" \x01(\x05R\x14instanceWebhookCount\x123\n" +
+	"\x13database_size_bytes\x18\v \x01(\x03H\x00R\x11databaseSizeBytes\x88\x01\x01\x1a\xa0\x01\n" +
+	"\tMemoCount\x128\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\x0e2\x18.memos.api.v1.VisibilityR\n" +
+	"visibility\x12)\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateR\x05state\x12\x18\n" +
+	"\atrashed\x18\x03 \x01(\bR\atrashed\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x1a:\n" +
+	"\x0eDailyMemoCount\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x1a{\n" +
+	"\fStorageUsage\x12!\n" +
+	"\fstorage_type\x18\x01 \x01(\tR\vstorageType\x12)\n" +
+	"\x10attachment_count\x18\x02 \x01(\x05R\x0fattachmentCount\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytesB\x16\n" +
+	"\x14_database_size_bytes\"\x19\n" +
+	"\x17GetInstanceStatsRequest\"\xba\x04\n" +
+	"\x17InstanceMigrationStatus\x12%\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\rschemaVersion\x122\n" +
+	"\x15target_schema_version\x18\x02 \x01(\tR\x13targetSchemaVersion\x12e\n" +
+	"\x12applied_migrations\x18\x03 \x03(\v26.memos.api.v1.InstanceMigrationStatus.AppliedMigrationR\x11appliedMigrations\x12#\n" +
+	"\rpending_count\x18\x04 \x01(\x05R\fpendingCount\x12-\n" +
+	"\x12pending_migrations\x18\x05 \x03(\tR\x11pendingMigrations\x12\x1c\n" +
+	"\n" +
+	"up_to_date\x18\x06 \x01(\bR\bupToDate\x1a\xea\x01\n" +
+	"\x10AppliedMigration\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
+	"\bchecksum\x18\x03 \x01(\tR\bchecksum\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x129\n" +
+	"\n" +
+	"apply_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tapplyTime\x12\x1a\n" +
+	"\bmodified\x18\x06 \x01(\bR\bmodified\"#\n" +
+	"!GetInstanceMigrationStatusRequest\"\xeb\x02\n" +
+	"\x14InstanceBackupStatus\x12\x18\n" +
+	"\arunning\x18\x01 \x01(\bR\arunning\x12>\n" +
+	"\rlast_run_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vlastRunTime\x12D\n" +
+	"\x10last_finish_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastFinishTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tR\tlastError\x12\x1f\n" +
+	"\vlast_backup\x18\x05 \x01(\tR\n" +
+	"lastBackup\x123\n" +
+	"\x16last_backup_size_bytes\x18\x06 \x01(\x03R\x13lastBackupSizeBytes\x12>\n" +
+	"\rnext_run_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vnextRunTime\" \n" +
+	"\x1eGetInstanceBackupStatusRequest\"\x1a\n" +
+	"\x18RunInstanceBackupRequest\"9\n" +
+	"\x14SendTestEmailRequest\x12!\n" +
+	"\trecipient\x18\x01 \x01(\tB\x03\xe0A\x01R\trecipient\"\xc8\x03\n" +
+	"\n" +
+	"SigningKey\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x129\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1e.memos.api.v1.SigningKey.StateB\x03\xe0A\x03R\x05state\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vretire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"retireTime\x12@\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expireTime\"E\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCURRENT\x10\x01\x12\v\n" +
+	"\aRETIRED\x10\x02\x12\v\n" +
+	"\aEXPIRED\x10\x03:V\xeaAS\n" +
+	"\x17memos.api.v1/SigningKey\x12\x19signingKeys/{signing_key}\x1a\x04name*\vsigningKeys2\n" +
+	"signingKey\"\x18\n" +
+	"\x16ListSigningKeysRequest\"V\n" +
+	"\x17ListSigningKeysResponse\x12;\n" +
+	"\fsigning_keys\x18\x01 \x03(\v2\x18.memos.api.v1.SigningKeyR\vsigningKeys\"\\\n" +
+	"\x17RotateSigningKeyRequest\x12A\n" +
+	"\fgrace_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x01R\vgracePeriod\"N\n" +
+	"\x17ExpireSigningKeyRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/SigningKeyR\x04name2\xa5\x0f\n" +
 	"\x0fInstanceService\x12~\n" +
 	"\x12GetInstanceProfile\x12'.memos.api.v1.GetInstanceProfileRequest\x1a\x1d.memos.api.v1.InstanceProfile\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/instance/profile\x12\x8f\x01\n" +
 	"\x12GetInstanceSetting\x12'.memos.api.v1.GetInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=instance/settings/*}\x12\xb5\x01\n" +
-	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}B\xac\x01\n" +
+	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}\x12\x99\x01\n" +
+	"\x1bDismissInstanceAnnouncement\x120.memos.api.v1.DismissInstanceAnnouncementRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/instance/announcement:dismiss\x12s\n" +
+	"\rListAuditLogs\x12\".memos.api.v1.ListAuditLogsRequest\x1a#.memos.api.v1.ListAuditLogsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/auditLogs\x12\x8e\x01\n" +
+	"\x16GetInstanceDiagnostics\x12+.memos.api.v1.GetInstanceDiagnosticsRequest\x1a!.memos.api.v1.InstanceDiagnostics\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/instance/diagnostics\x12v\n" +
+	"\x10GetInstanceStats\x12%.memos.api.v1.GetInstanceStatsRequest\x1a\x1b.memos.api.v1.InstanceStats\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/instance/stats\x12\x99\x01\n" +
+	"\x1aGetInstanceMigrationStatus\x12/.memos.api.v1.GetInstanceMigrationStatusRequest\x1a%.memos.api.v1.InstanceMigrationStatus\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/instance/migrations\x12\x8c\x01\n" +
+	"\x17GetInstanceBackupStatus\x12,.memos.api.v1.GetInstanceBackupStatusRequest\x1a\".memos.api.v1.InstanceBackupStatus\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/instance/backup\x12\x87\x01\n" +
+	"\x11RunInstanceBackup\x12&.memos.api.v1.RunInstanceBackupRequest\x1a\".memos.api.v1.InstanceBackupStatus\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/instance/backup:run\x12s\n" +
+	"\rSendTestEmail\x12\".memos.api.v1.SendTestEmailRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/instance/email:test\x12{\n" +
+	"\x0fListSigningKeys\x12$.memos.api.v1.ListSigningKeysRequest\x1a%.memos.api.v1.ListSigningKeysResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/signingKeys\x12z\n" +
+	"\x10RotateSigningKey\x12%.memos.api.v1.RotateSigningKeyRequest\x1a\x18.memos.api.v1.SigningKey\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/signingKeys:rotate\x12\x8a\x01\n" +
+	"\x10ExpireSigningKey\x12%.memos.api.v1.ExpireSigningKeyRequest\x1a\x18.memos.api.v1.SigningKey\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/{name=signingKeys/*}:expireB\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14InstanceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -976,43 +4285,156 @@ func file_api_v1_instance_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_instance_service_proto_rawDescData
 }
 
-var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_api_v1_instance_service_proto_goTypes = []any{
-	(InstanceSetting_Key)(0),                             // 0: memos.api.v1.InstanceSetting.Key
-	(InstanceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	(*InstanceProfile)(nil),                              // 2: memos.api.v1.InstanceProfile
-	(*GetInstanceProfileRequest)(nil),                    // 3: memos.api.v1.GetInstanceProfileRequest
-	(*InstanceSetting)(nil),                              // 4: memos.api.v1.InstanceSetting
-	(*GetInstanceSettingRequest)(nil),                    // 5: memos.api.v1.GetInstanceSettingRequest
-	(*UpdateInstanceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateInstanceSettingRequest
-	(*InstanceSetting_GeneralSetting)(nil),               // 7: memos.api.v1.InstanceSetting.GeneralSetting
-	(*InstanceSetting_StorageSetting)(nil),               // 8: memos.api.v1.InstanceSetting.StorageSetting
-	(*InstanceSetting_MemoRelatedSetting)(nil),           // 9: memos.api.v1.InstanceSetting.MemoRelatedSetting
-	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 10: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 11: memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	(*fieldmaskpb.FieldMask)(nil),                        // 12: google.protobuf.FieldMask
+	(InstanceSetting_Key)(0),                                      // 0: memos.api.v1.InstanceSetting.Key
+	(InstanceSetting_StorageSetting_StorageType)(0),               // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	(InstanceSetting_LinkPreviewSetting_Mode)(0),                  // 2: memos.api.v1.InstanceSetting.LinkPreviewSetting.Mode
+	(InstanceSetting_EmailSetting_TlsMode)(0),                     // 3: memos.api.v1.InstanceSetting.EmailSetting.TlsMode
+	(InstanceSetting_BackupSetting_Destination)(0),                // 4: memos.api.v1.InstanceSetting.BackupSetting.Destination
+	(InstanceSetting_AnnouncementSetting_Severity)(0),             // 5: memos.api.v1.InstanceSetting.AnnouncementSetting.Severity
+	(AuditLog_EventType)(0),                                       // 6: memos.api.v1.AuditLog.EventType
+	(SigningKey_State)(0),                                         // 7: memos.api.v1.SigningKey.State
+	(*InstanceProfile)(nil),                                       // 8: memos.api.v1.InstanceProfile
+	(*GetInstanceProfileRequest)(nil),                             // 9: memos.api.v1.GetInstanceProfileRequest
+	(*InstanceSetting)(nil),                                       // 10: memos.api.v1.InstanceSetting
+	(*GetInstanceSettingRequest)(nil),                             // 11: memos.api.v1.GetInstanceSettingRequest
+	(*DismissInstanceAnnouncementRequest)(nil),                    // 12: memos.api.v1.DismissInstanceAnnouncementRequest
+	(*UpdateInstanceSettingRequest)(nil),                          // 13: memos.api.v1.UpdateInstanceSettingRequest
+	(*AuditLog)(nil),                                              // 14: memos.api.v1.AuditLog
+	(*ListAuditLogsRequest)(nil),                                  // 15: memos.api.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),                                 // 16: memos.api.v1.ListAuditLogsResponse
+	(*InstanceDiagnostics)(nil),                                   // 17: memos.api.v1.InstanceDiagnostics
+	(*GetInstanceDiagnosticsRequest)(nil),                         // 18: memos.api.v1.GetInstanceDiagnosticsRequest
+	(*InstanceStats)(nil),                                         // 19: memos.api.v1.InstanceStats
+	(*GetInstanceStatsRequest)(nil),                               // 20: memos.api.v1.GetInstanceStatsRequest
+	(*InstanceMigrationStatus)(nil),                               // 21: memos.api.v1.InstanceMigrationStatus
+	(*GetInstanceMigrationStatusRequest)(nil),                     // 22: memos.api.v1.GetInstanceMigrationStatusRequest
+	(*InstanceBackupStatus)(nil),                                  // 23: memos.api.v1.InstanceBackupStatus
+	(*GetInstanceBackupStatusRequest)(nil),                        // 24: memos.api.v1.GetInstanceBackupStatusRequest
+	(*RunInstanceBackupRequest)(nil),                              // 25: memos.api.v1.RunInstanceBackupRequest
+	(*SendTestEmailRequest)(nil),                                  // 26: memos.api.v1.SendTestEmailRequest
+	(*SigningKey)(nil),                                            // 27: memos.api.v1.SigningKey
+	(*ListSigningKeysRequest)(nil),                                // 28: memos.api.v1.ListSigningKeysRequest
+	(*ListSigningKeysResponse)(nil),                               // 29: memos.api.v1.ListSigningKeysResponse
+	(*RotateSigningKeyRequest)(nil),                               // 30: memos.api.v1.RotateSigningKeyRequest
+	(*ExpireSigningKeyRequest)(nil),                               // 31: memos.api.v1.ExpireSigningKeyRequest
+	(*InstanceSetting_GeneralSetting)(nil),                        // 32: memos.api.v1.InstanceSetting.GeneralSetting
+	(*InstanceSetting_StorageSetting)(nil),                        // 33: memos.api.v1.InstanceSetting.StorageSetting
+	(*InstanceSetting_MemoRelatedSetting)(nil),                    // 34: memos.api.v1.InstanceSetting.MemoRelatedSetting
+	(*InstanceSetting_LinkPreviewSetting)(nil),                    // 35: memos.api.v1.InstanceSetting.LinkPreviewSetting
+	(*InstanceSetting_EmailSetting)(nil),                          // 36: memos.api.v1.InstanceSetting.EmailSetting
+	(*InstanceSetting_BackupSetting)(nil),                         // 37: memos.api.v1.InstanceSetting.BackupSetting
+	(*InstanceSetting_SlackSetting)(nil),                          // 38: memos.api.v1.InstanceSetting.SlackSetting
+	(*InstanceSetting_MatrixSetting)(nil),                         // 39: memos.api.v1.InstanceSetting.MatrixSetting
+	(*InstanceSetting_AnnouncementSetting)(nil),                   // 40: memos.api.v1.InstanceSetting.AnnouncementSetting
+	(*InstanceSetting_RateLimitSetting)(nil),                      // 41: memos.api.v1.InstanceSetting.RateLimitSetting
+	(*InstanceSetting_GeneralSetting_CustomProfile)(nil),          // 42: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	(*InstanceSetting_StorageSetting_S3Config)(nil),               // 43: memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	(*InstanceSetting_StorageSetting_ImageCompressionConfig)(nil), // 44: memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfig
+	nil, // 45: memos.api.v1.InstanceSetting.LinkPreviewSetting.RequestHeadersEntry
+	(*InstanceSetting_MatrixSetting_Room)(nil),       // 46: memos.api.v1.InstanceSetting.MatrixSetting.Room
+	(*InstanceDiagnostics_DatabaseStats)(nil),        // 47: memos.api.v1.InstanceDiagnostics.DatabaseStats
+	(*InstanceStats_MemoCount)(nil),                  // 48: memos.api.v1.InstanceStats.MemoCount
+	(*InstanceStats_DailyMemoCount)(nil),             // 49: memos.api.v1.InstanceStats.DailyMemoCount
+	(*InstanceStats_StorageUsage)(nil),               // 50: memos.api.v1.InstanceStats.StorageUsage
+	(*InstanceMigrationStatus_AppliedMigration)(nil), // 51: memos.api.v1.InstanceMigrationStatus.AppliedMigration
+	(*fieldmaskpb.FieldMask)(nil),                    // 52: google.protobuf.FieldMask
+	(*structpb.Struct)(nil),                          // 53: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                    // 54: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                      // 55: google.protobuf.Duration
+	(Visibility)(0),                                  // 56: memos.api.v1.Visibility
+	(State)(0),                                       // 57: memos.api.v1.State
+	(*emptypb.Empty)(nil),                            // 58: google.protobuf.Empty
 }
 var file_api_v1_instance_service_proto_depIdxs = []int32{
-	7,  // 0: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
-	8,  // 1: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
-	9,  // 2: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
-	4,  // 3: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
-	12, // 4: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10, // 5: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	1,  // 6: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	11, // 7: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	3,  // 8: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
-	5,  // 9: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
-	6,  // 10: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
-	2,  // 11: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
-	4,  // 12: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	4,  // 13: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	40, // 0: memos.api.v1.InstanceProfile.announcement:type_name -> memos.api.v1.InstanceSetting.AnnouncementSetting
+	32, // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
+	33, // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
+	34, // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
+	35, // 4: memos.api.v1.InstanceSetting.link_preview_setting:type_name -> memos.api.v1.InstanceSetting.LinkPreviewSetting
+	36, // 5: memos.api.v1.InstanceSetting.email_setting:type_name -> memos.api.v1.InstanceSetting.EmailSetting
+	37, // 6: memos.api.v1.InstanceSetting.backup_setting:type_name -> memos.api.v1.InstanceSetting.BackupSetting
+	38, // 7: memos.api.v1.InstanceSetting.slack_setting:type_name -> memos.api.v1.InstanceSetting.SlackSetting
+	39, // 8: memos.api.v1.InstanceSetting.matrix_setting:type_name -> memos.api.v1.InstanceSetting.MatrixSetting
+	40, // 9: memos.api.v1.InstanceSetting.announcement_setting:type_name -> memos.api.v1.InstanceSetting.AnnouncementSetting
+	41, // 10: memos.api.v1.InstanceSetting.rate_limit_setting:type_name -> memos.api.v1.InstanceSetting.RateLimitSetting
+	10, // 11: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
+	52, // 12: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 13: memos.api.v1.AuditLog.event_type:type_name -> memos.api.v1.AuditLog.EventType
+	53, // 14: memos.api.v1.AuditLog.payload:type_name -> google.protobuf.Struct
+	54, // 15: memos.api.v1.AuditLog.create_time:type_name -> google.protobuf.Timestamp
+	6,  // 16: memos.api.v1.ListAuditLogsRequest.event_type:type_name -> memos.api.v1.AuditLog.EventType
+	54, // 17: memos.api.v1.ListAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	54, // 18: memos.api.v1.ListAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	14, // 19: memos.api.v1.ListAuditLogsResponse.audit_logs:type_name -> memos.api.v1.AuditLog
+	47, // 20: memos.api.v1.InstanceDiagnostics.database_stats:type_name -> memos.api.v1.InstanceDiagnostics.DatabaseStats
+	55, // 21: memos.api.v1.InstanceStats.uptime:type_name -> google.protobuf.Duration
+	54, // 22: memos.api.v1.InstanceStats.compute_time:type_name -> google.protobuf.Timestamp
+	48, // 23: memos.api.v1.InstanceStats.memo_counts:type_name -> memos.api.v1.InstanceStats.MemoCount
+	49, // 24: memos.api.v1.InstanceStats.daily_memo_counts:type_name -> memos.api.v1.InstanceStats.DailyMemoCount
+	50, // 25: memos.api.v1.InstanceStats.storage_usages:type_name -> memos.api.v1.InstanceStats.StorageUsage
+	51, // 26: memos.api.v1.InstanceMigrationStatus.applied_migrations:type_name -> memos.api.v1.InstanceMigrationStatus.AppliedMigration
+	54, // 27: memos.api.v1.InstanceBackupStatus.last_run_time:type_name -> google.protobuf.Timestamp
+	54, // 28: memos.api.v1.InstanceBackupStatus.last_finish_time:type_name -> google.protobuf.Timestamp
+	54, // 29: memos.api.v1.InstanceBackupStatus.next_run_time:type_name -> google.protobuf.Timestamp
+	7,  // 30: memos.api.v1.SigningKey.state:type_name -> memos.api.v1.SigningKey.State
+	54, // 31: memos.api.v1.SigningKey.create_time:type_name -> google.protobuf.Timestamp
+	54, // 32: memos.api.v1.SigningKey.retire_time:type_name -> google.protobuf.Timestamp
+	54, // 33: memos.api.v1.SigningKey.expire_time:type_name -> google.protobuf.Timestamp
+	27, // 34: memos.api.v1.ListSigningKeysResponse.signing_keys:type_name -> memos.api.v1.SigningKey
+	55, // 35: memos.api.v1.RotateSigningKeyRequest.grace_period:type_name -> google.protobuf.Duration
+	42, // 36: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	1,  // 37: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	43, // 38: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	44, // 39: memos.api.v1.InstanceSetting.StorageSetting.image_compression:type_name -> memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfig
+	2,  // 40: memos.api.v1.InstanceSetting.LinkPreviewSetting.mode:type_name -> memos.api.v1.InstanceSetting.LinkPreviewSetting.Mode
+	45, // 41: memos.api.v1.InstanceSetting.LinkPreviewSetting.request_headers:type_name -> memos.api.v1.InstanceSetting.LinkPreviewSetting.RequestHeadersEntry
+	3,  // 42: memos.api.v1.InstanceSetting.EmailSetting.tls_mode:type_name -> memos.api.v1.InstanceSetting.EmailSetting.TlsMode
+	4,  // 43: memos.api.v1.InstanceSetting.BackupSetting.destination:type_name -> memos.api.v1.InstanceSetting.BackupSetting.Destination
+	46, // 44: memos.api.v1.InstanceSetting.MatrixSetting.rooms:type_name -> memos.api.v1.InstanceSetting.MatrixSetting.Room
+	5,  // 45: memos.api.v1.InstanceSetting.AnnouncementSetting.severity:type_name -> memos.api.v1.InstanceSetting.AnnouncementSetting.Severity
+	54, // 46: memos.api.v1.InstanceSetting.AnnouncementSetting.start_time:type_name -> google.protobuf.Timestamp
+	54, // 47: memos.api.v1.InstanceSetting.AnnouncementSetting.end_time:type_name -> google.protobuf.Timestamp
+	55, // 48: memos.api.v1.InstanceDiagnostics.DatabaseStats.wait_duration:type_name -> google.protobuf.Duration
+	56, // 49: memos.api.v1.InstanceStats.MemoCount.visibility:type_name -> memos.api.v1.Visibility
+	57, // 50: memos.api.v1.InstanceStats.MemoCount.state:type_name -> memos.api.v1.State
+	55, // 51: memos.api.v1.InstanceMigrationStatus.AppliedMigration.duration:type_name -> google.protobuf.Duration
+	54, // 52: memos.api.v1.InstanceMigrationStatus.AppliedMigration.apply_time:type_name -> google.protobuf.Timestamp
+	9,  // 53: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
+	11, // 54: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
+	13, // 55: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
+	12, // 56: memos.api.v1.InstanceService.DismissInstanceAnnouncement:input_type -> memos.api.v1.DismissInstanceAnnouncementRequest
+	15, // 57: memos.api.v1.InstanceService.ListAuditLogs:input_type -> memos.api.v1.ListAuditLogsRequest
+	18, // 58: memos.api.v1.InstanceService.GetInstanceDiagnostics:input_type -> memos.api.v1.GetInstanceDiagnosticsRequest
+	20, // 59: memos.api.v1.InstanceService.GetInstanceStats:input_type -> memos.api.v1.GetInstanceStatsRequest
+	22, // 60: memos.api.v1.InstanceService.GetInstanceMigrationStatus:input_type -> memos.api.v1.GetInstanceMigrationStatusRequest
+	24, // 61: memos.api.v1.InstanceService.GetInstanceBackupStatus:input_type -> memos.api.v1.GetInstanceBackupStatusRequest
+	25, // 62: memos.api.v1.InstanceService.RunInstanceBackup:input_type -> memos.api.v1.RunInstanceBackupRequest
+	26, // 63: memos.api.v1.InstanceService.SendTestEmail:input_type -> memos.api.v1.SendTestEmailRequest
+	28, // 64: memos.api.v1.InstanceService.ListSigningKeys:input_type -> memos.api.v1.ListSigningKeysRequest
+	30, // 65: memos.api.v1.InstanceService.RotateSigningKey:input_type -> memos.api.v1.RotateSigningKeyRequest
+	31, // 66: memos.api.v1.InstanceService.ExpireSigningKey:input_type -> memos.api.v1.ExpireSigningKeyRequest
+	8,  // 67: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
+	10, // 68: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	10, // 69: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	58, // 70: memos.api.v1.InstanceService.DismissInstanceAnnouncement:output_type -> google.protobuf.Empty
+	16, // 71: memos.api.v1.InstanceService.ListAuditLogs:output_type -> memos.api.v1.ListAuditLogsResponse
+	17, // 72: memos.api.v1.InstanceService.GetInstanceDiagnostics:output_type -> memos.api.v1.InstanceDiagnostics
+	19, // 73: memos.api.v1.InstanceService.GetInstanceStats:output_type -> memos.api.v1.InstanceStats
+	21, // 74: memos.api.v1.InstanceService.GetInstanceMigrationStatus:output_type -> memos.api.v1.InstanceMigrationStatus
+	23, // 75: memos.api.v1.InstanceService.GetInstanceBackupStatus:output_type -> memos.api.v1.InstanceBackupStatus
+	23, // 76: memos.api.v1.InstanceService.RunInstanceBackup:output_type -> memos.api.v1.InstanceBackupStatus
+	58, // 77: memos.api.v1.InstanceService.SendTestEmail:output_type -> google.protobuf.Empty
+	29, // 78: memos.api.v1.InstanceService.ListSigningKeys:output_type -> memos.api.v1.ListSigningKeysResponse
+	27, // 79: memos.api.v1.InstanceService.RotateSigningKey:output_type -> memos.api.v1.SigningKey
+	27, // 80: memos.api.v1.InstanceService.ExpireSigningKey:output_type -> memos.api.v1.SigningKey
+	67, // [67:81] is the sub-list for method output_type
+	53, // [53:67] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_instance_service_proto_init() }
@@ -1020,18 +4442,28 @@ func file_api_v1_instance_service_proto_init() {
 	if File_api_v1_instance_service_proto != nil {
 		return
 	}
+	file_api_v1_common_proto_init()
+	file_api_v1_memo_service_proto_init()
 	file_api_v1_instance_service_proto_msgTypes[2].OneofWrappers = []any{
 		(*InstanceSetting_GeneralSetting_)(nil),
 		(*InstanceSetting_StorageSetting_)(nil),
 		(*InstanceSetting_MemoRelatedSetting_)(nil),
+		(*InstanceSetting_LinkPreviewSetting_)(nil),
+		(*InstanceSetting_EmailSetting_)(nil),
+		(*InstanceSetting_BackupSetting_)(nil),
+		(*InstanceSetting_SlackSetting_)(nil),
+		(*InstanceSetting_MatrixSetting_)(nil),
+		(*InstanceSetting_AnnouncementSetting_)(nil),
+		(*InstanceSetting_RateLimitSetting_)(nil),
 	}
+	file_api_v1_instance_service_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_instance_service_proto_rawDesc), len(file_api_v1_instance_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      8,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
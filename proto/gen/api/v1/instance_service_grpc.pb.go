@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InstanceService_GetInstanceProfile_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceProfile"
-	InstanceService_GetInstanceSetting_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceSetting"
-	InstanceService_UpdateInstanceSetting_FullMethodName = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	InstanceService_GetInstanceProfile_FullMethodName          = "/memos.api.v1.InstanceService/GetInstanceProfile"
+	InstanceService_GetInstanceSetting_FullMethodName          = "/memos.api.v1.InstanceService/GetInstanceSetting"
+	InstanceService_UpdateInstanceSetting_FullMethodName       = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	InstanceService_DismissInstanceAnnouncement_FullMethodName = "/memos.api.v1.InstanceService/DismissInstanceAnnouncement"
+	InstanceService_ListAuditLogs_FullMethodName               = "/memos.api.v1.InstanceService/ListAuditLogs"
+	InstanceService_GetInstanceDiagnostics_FullMethodName      = "/memos.api.v1.InstanceService/GetInstanceDiagnostics"
+	InstanceService_GetInstanceStats_FullMethodName            = "/memos.api.v1.InstanceService/GetInstanceStats"
+	InstanceService_GetInstanceMigrationStatus_FullMethodName  = "/memos.api.v1.InstanceService/GetInstanceMigrationStatus"
+	InstanceService_GetInstanceBackupStatus_FullMethodName     = "/memos.api.v1.InstanceService/GetInstanceBackupStatus"
+	InstanceService_RunInstanceBackup_FullMethodName           = "/memos.api.v1.InstanceService/RunInstanceBackup"
+	InstanceService_SendTestEmail_FullMethodName               = "/memos.api.v1.InstanceService/SendTestEmail"
+	InstanceService_ListSigningKeys_FullMethodName             = "/memos.api.v1.InstanceService/ListSigningKeys"
+	InstanceService_RotateSigningKey_FullMethodName            = "/memos.api.v1.InstanceService/RotateSigningKey"
+	InstanceService_ExpireSigningKey_FullMethodName            = "/memos.api.v1.InstanceService/ExpireSigningKey"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -34,6 +46,42 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(ctx context.Context, in *GetInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(ctx context.Context, in *UpdateInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
+	// Dismisses the announcement of the instance for the current user, until its content changes.
+	DismissInstanceAnnouncement(ctx context.Context, in *DismissInstanceAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the audit log of authentication and admin events, newest first.
+	// Only the host can list audit logs.
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	// Gets the diagnostics of the instance, such as the database connection pool statistics.
+	// Only admins can get the diagnostics.
+	GetInstanceDiagnostics(ctx context.Context, in *GetInstanceDiagnosticsRequest, opts ...grpc.CallOption) (*InstanceDiagnostics, error)
+	// Gets the statistics of the instance: its users, memos, attachments and webhooks, along with
+	// the version and uptime of the server. The statistics are cached for a few minutes.
+	// Only the host can get the statistics.
+	GetInstanceStats(ctx context.Context, in *GetInstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStats, error)
+	// Gets the schema migration status of the database: the schema version, the history of the
+	// applied migrations and the pending migrations.
+	// Only admins can get the migration status.
+	GetInstanceMigrationStatus(ctx context.Context, in *GetInstanceMigrationStatusRequest, opts ...grpc.CallOption) (*InstanceMigrationStatus, error)
+	// Gets the status of the scheduled backups: the last run, the next run and whether a backup is
+	// running. Only the host can get the backup status.
+	GetInstanceBackupStatus(ctx context.Context, in *GetInstanceBackupStatusRequest, opts ...grpc.CallOption) (*InstanceBackupStatus, error)
+	// Starts a backup now, with the backup setting of the instance. The backup runs in the
+	// background, and its result is reported by GetInstanceBackupStatus.
+	// Only the host can run backups.
+	RunInstanceBackup(ctx context.Context, in *RunInstanceBackupRequest, opts ...grpc.CallOption) (*InstanceBackupStatus, error)
+	// Sends a test email with the email setting of the instance, to check the SMTP settings.
+	// Only the host can send test emails.
+	SendTestEmail(ctx context.Context, in *SendTestEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the keys that sign and verify JWTs, newest first.
+	// Only the host can list signing keys.
+	ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*ListSigningKeysResponse, error)
+	// Rotates the signing key. New tokens are signed with a new key, while tokens signed with
+	// the previous key stay valid for the grace period.
+	// Only the host can rotate the signing key.
+	RotateSigningKey(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*SigningKey, error)
+	// Expires a retired signing key, so tokens signed with it are rejected immediately.
+	// Only the host can expire signing keys.
+	ExpireSigningKey(ctx context.Context, in *ExpireSigningKeyRequest, opts ...grpc.CallOption) (*SigningKey, error)
 }
 
 type instanceServiceClient struct {
@@ -74,6 +122,116 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *instanceServiceClient) DismissInstanceAnnouncement(ctx context.Context, in *DismissInstanceAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstanceService_DismissInstanceAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceDiagnostics(ctx context.Context, in *GetInstanceDiagnosticsRequest, opts ...grpc.CallOption) (*InstanceDiagnostics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceDiagnostics)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceStats(ctx context.Context, in *GetInstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceStats)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceMigrationStatus(ctx context.Context, in *GetInstanceMigrationStatusRequest, opts ...grpc.CallOption) (*InstanceMigrationStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceMigrationStatus)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceMigrationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) GetInstanceBackupStatus(ctx context.Context, in *GetInstanceBackupStatusRequest, opts ...grpc.CallOption) (*InstanceBackupStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceBackupStatus)
+	err := c.cc.Invoke(ctx, InstanceService_GetInstanceBackupStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) RunInstanceBackup(ctx context.Context, in *RunInstanceBackupRequest, opts ...grpc.CallOption) (*InstanceBackupStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceBackupStatus)
+	err := c.cc.Invoke(ctx, InstanceService_RunInstanceBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) SendTestEmail(ctx context.Context, in *SendTestEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstanceService_SendTestEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*ListSigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSigningKeysResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) RotateSigningKey(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*SigningKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningKey)
+	err := c.cc.Invoke(ctx, InstanceService_RotateSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) ExpireSigningKey(ctx context.Context, in *ExpireSigningKeyRequest, opts ...grpc.CallOption) (*SigningKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningKey)
+	err := c.cc.Invoke(ctx, InstanceService_ExpireSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility.
@@ -84,6 +242,42 @@ type InstanceServiceServer interface {
 	GetInstanceSetting(context.Context, *GetInstanceSettingRequest) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error)
+	// Dismisses the announcement of the instance for the current user, until its content changes.
+	DismissInstanceAnnouncement(context.Context, *DismissInstanceAnnouncementRequest) (*emptypb.Empty, error)
+	// Lists the audit log of authentication and admin events, newest first.
+	// Only the host can list audit logs.
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// Gets the diagnostics of the instance, such as the database connection pool statistics.
+	// Only admins can get the diagnostics.
+	GetInstanceDiagnostics(context.Context, *GetInstanceDiagnosticsRequest) (*InstanceDiagnostics, error)
+	// Gets the statistics of the instance: its users, memos, attachments and webhooks, along with
+	// the version and uptime of the server. The statistics are cached for a few minutes.
+	// Only the host can get the statistics.
+	GetInstanceStats(context.Context, *GetInstanceStatsRequest) (*InstanceStats, error)
+	// Gets the schema migration status of the database: the schema version, the history of the
+	// applied migrations and the pending migrations.
+	// Only admins can get the migration status.
+	GetInstanceMigrationStatus(context.Context, *GetInstanceMigrationStatusRequest) (*InstanceMigrationStatus, error)
+	// Gets the status of the scheduled backups: the last run, the next run and whether a backup is
+	// running. Only the host can get the backup status.
+	GetInstanceBackupStatus(context.Context, *GetInstanceBackupStatusRequest) (*InstanceBackupStatus, error)
+	// Starts a backup now, with the backup setting of the instance. The backup runs in the
+	// background, and its result is reported by GetInstanceBackupStatus.
+	// Only the host can run backups.
+	RunInstanceBackup(context.Context, *RunInstanceBackupRequest) (*InstanceBackupStatus, error)
+	// Sends a test email with the email setting of the instance, to check the SMTP settings.
+	// Only the host can send test emails.
+	SendTestEmail(context.Context, *SendTestEmailRequest) (*emptypb.Empty, error)
+	// Lists the keys that sign and verify JWTs, newest first.
+	// Only the host can list signing keys.
+	ListSigningKeys(context.Context, *ListSigningKeysRequest) (*ListSigningKeysResponse, error)
+	// Rotates the signing key. New tokens are signed with a new key, while tokens signed with
+	// the previous key stay valid for the grace period.
+	// Only the host can rotate the signing key.
+	RotateSigningKey(context.Context, *RotateSigningKeyRequest) (*SigningKey, error)
+	// Expires a retired signing key, so tokens signed with it are rejected immediately.
+	// Only the host can expire signing keys.
+	ExpireSigningKey(context.Context, *ExpireSigningKeyRequest) (*SigningKey, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -103,6 +297,39 @@ func (UnimplementedInstanceServiceServer) GetInstanceSetting(context.Context, *G
 func (UnimplementedInstanceServiceServer) UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateInstanceSetting not implemented")
 }
+func (UnimplementedInstanceServiceServer) DismissInstanceAnnouncement(context.Context, *DismissInstanceAnnouncementRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DismissInstanceAnnouncement not implemented")
+}
+func (UnimplementedInstanceServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceDiagnostics(context.Context, *GetInstanceDiagnosticsRequest) (*InstanceDiagnostics, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstanceDiagnostics not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceStats(context.Context, *GetInstanceStatsRequest) (*InstanceStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstanceStats not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceMigrationStatus(context.Context, *GetInstanceMigrationStatusRequest) (*InstanceMigrationStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstanceMigrationStatus not implemented")
+}
+func (UnimplementedInstanceServiceServer) GetInstanceBackupStatus(context.Context, *GetInstanceBackupStatusRequest) (*InstanceBackupStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstanceBackupStatus not implemented")
+}
+func (UnimplementedInstanceServiceServer) RunInstanceBackup(context.Context, *RunInstanceBackupRequest) (*InstanceBackupStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunInstanceBackup not implemented")
+}
+func (UnimplementedInstanceServiceServer) SendTestEmail(context.Context, *SendTestEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendTestEmail not implemented")
+}
+func (UnimplementedInstanceServiceServer) ListSigningKeys(context.Context, *ListSigningKeysRequest) (*ListSigningKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSigningKeys not implemented")
+}
+func (UnimplementedInstanceServiceServer) RotateSigningKey(context.Context, *RotateSigningKeyRequest) (*SigningKey, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateSigningKey not implemented")
+}
+func (UnimplementedInstanceServiceServer) ExpireSigningKey(context.Context, *ExpireSigningKeyRequest) (*SigningKey, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExpireSigningKey not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
 
@@ -178,6 +405,204 @@ func _InstanceService_UpdateInstanceSetting_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_DismissInstanceAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissInstanceAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).DismissInstanceAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_DismissInstanceAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).DismissInstanceAnnouncement(ctx, req.(*DismissInstanceAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceDiagnostics(ctx, req.(*GetInstanceDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceStats(ctx, req.(*GetInstanceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceMigrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceMigrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceMigrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceMigrationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceMigrationStatus(ctx, req.(*GetInstanceMigrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_GetInstanceBackupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceBackupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).GetInstanceBackupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_GetInstanceBackupStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).GetInstanceBackupStatus(ctx, req.(*GetInstanceBackupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_RunInstanceBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInstanceBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).RunInstanceBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_RunInstanceBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).RunInstanceBackup(ctx, req.(*RunInstanceBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_SendTestEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).SendTestEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_SendTestEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).SendTestEmail(ctx, req.(*SendTestEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ListSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListSigningKeys(ctx, req.(*ListSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_RotateSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).RotateSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_RotateSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).RotateSigningKey(ctx, req.(*RotateSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_ExpireSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ExpireSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ExpireSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ExpireSigningKey(ctx, req.(*ExpireSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +622,50 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstanceSetting",
 			Handler:    _InstanceService_UpdateInstanceSetting_Handler,
 		},
+		{
+			MethodName: "DismissInstanceAnnouncement",
+			Handler:    _InstanceService_DismissInstanceAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _InstanceService_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "GetInstanceDiagnostics",
+			Handler:    _InstanceService_GetInstanceDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetInstanceStats",
+			Handler:    _InstanceService_GetInstanceStats_Handler,
+		},
+		{
+			MethodName: "GetInstanceMigrationStatus",
+			Handler:    _InstanceService_GetInstanceMigrationStatus_Handler,
+		},
+		{
+			MethodName: "GetInstanceBackupStatus",
+			Handler:    _InstanceService_GetInstanceBackupStatus_Handler,
+		},
+		{
+			MethodName: "RunInstanceBackup",
+			Handler:    _InstanceService_RunInstanceBackup_Handler,
+		},
+		{
+			MethodName: "SendTestEmail",
+			Handler:    _InstanceService_SendTestEmail_Handler,
+		},
+		{
+			MethodName: "ListSigningKeys",
+			Handler:    _InstanceService_ListSigningKeys_Handler,
+		},
+		{
+			MethodName: "RotateSigningKey",
+			Handler:    _InstanceService_RotateSigningKey_Handler,
+		},
+		{
+			MethodName: "ExpireSigningKey",
+			Handler:    _InstanceService_ExpireSigningKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/instance_service.proto",
@@ -0,0 +1,731 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/v1/memo_recurrence_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MemoRecurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo recurrence.
+	// Format: users/{user}/memoRecurrences/{memo_recurrence}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The recurrence rule, a subset of an RFC 5545 RRULE: FREQ (DAILY or WEEKLY), INTERVAL and
+	// BYDAY (MO to SU), e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH". A weekly rule without BYDAY
+	// occurs on the weekday of the creation of the recurrence.
+	Rrule string `protobuf:"bytes,2,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// The local time of the occurrences, formatted as HH:MM, e.g. "08:00".
+	TimeOfDay string `protobuf:"bytes,3,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"`
+	// The IANA time zone of the occurrences, e.g. "Europe/Paris". If empty, the time zone of the
+	// user is used.
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The source of the memos created at each occurrence.
+	//
+	// Types that are valid to be assigned to Source:
+	//
+	//	*MemoRecurrence_MemoTemplate
+	//	*MemoRecurrence_Memo
+	Source isMemoRecurrence_Source `protobuf_oneof:"source"`
+	// The tags, without the # prefix, added to the created memos if their content doesn't have them.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Whether the recurrence is paused.
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The next three occurrences of the recurrence. Empty if the recurrence is paused.
+	NextOccurrenceTimes []*timestamppb.Timestamp `protobuf:"bytes,9,rep,name=next_occurrence_times,json=nextOccurrenceTimes,proto3" json:"next_occurrence_times,omitempty"`
+	// The occurrence that created the last memo, if any.
+	LastOccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_occurrence_time,json=lastOccurrenceTime,proto3" json:"last_occurrence_time,omitempty"`
+	CreateTime         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MemoRecurrence) Reset() {
+	*x = MemoRecurrence{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRecurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRecurrence) ProtoMessage() {}
+
+func (x *MemoRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRecurrence.ProtoReflect.Descriptor instead.
+func (*MemoRecurrence) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoRecurrence) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoRecurrence) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *MemoRecurrence) GetTimeOfDay() string {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return ""
+}
+
+func (x *MemoRecurrence) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MemoRecurrence) GetSource() isMemoRecurrence_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *MemoRecurrence) GetMemoTemplate() string {
+	if x != nil {
+		if x, ok := x.Source.(*MemoRecurrence_MemoTemplate); ok {
+			return x.MemoTemplate
+		}
+	}
+	return ""
+}
+
+func (x *MemoRecurrence) GetMemo() string {
+	if x != nil {
+		if x, ok := x.Source.(*MemoRecurrence_Memo); ok {
+			return x.Memo
+		}
+	}
+	return ""
+}
+
+func (x *MemoRecurrence) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MemoRecurrence) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *MemoRecurrence) GetNextOccurrenceTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOccurrenceTimes
+	}
+	return nil
+}
+
+func (x *MemoRecurrence) GetLastOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastOccurrenceTime
+	}
+	return nil
+}
+
+func (x *MemoRecurrence) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoRecurrence) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type isMemoRecurrence_Source interface {
+	isMemoRecurrence_Source()
+}
+
+type MemoRecurrence_MemoTemplate struct {
+	// A memo template of the user, whose placeholders are expanded at the occurrence.
+	// Format: users/{user}/memoTemplates/{memo_template}
+	MemoTemplate string `protobuf:"bytes,5,opt,name=memo_template,json=memoTemplate,proto3,oneof"`
+}
+
+type MemoRecurrence_Memo struct {
+	// A memo of the user, whose content and visibility are copied.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3,oneof"`
+}
+
+func (*MemoRecurrence_MemoTemplate) isMemoRecurrence_Source() {}
+
+func (*MemoRecurrence_Memo) isMemoRecurrence_Source() {}
+
+type ListMemoRecurrencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where memo recurrences are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRecurrencesRequest) Reset() {
+	*x = ListMemoRecurrencesRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRecurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRecurrencesRequest) ProtoMessage() {}
+
+func (x *ListMemoRecurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRecurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRecurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMemoRecurrencesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoRecurrencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memo recurrences, from the oldest to the newest.
+	MemoRecurrences []*MemoRecurrence `protobuf:"bytes,1,rep,name=memo_recurrences,json=memoRecurrences,proto3" json:"memo_recurrences,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMemoRecurrencesResponse) Reset() {
+	*x = ListMemoRecurrencesResponse{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRecurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRecurrencesResponse) ProtoMessage() {}
+
+func (x *ListMemoRecurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRecurrencesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRecurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMemoRecurrencesResponse) GetMemoRecurrences() []*MemoRecurrence {
+	if x != nil {
+		return x.MemoRecurrences
+	}
+	return nil
+}
+
+type GetMemoRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo recurrence to retrieve.
+	// Format: users/{user}/memoRecurrences/{memo_recurrence}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRecurrenceRequest) Reset() {
+	*x = GetMemoRecurrenceRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRecurrenceRequest) ProtoMessage() {}
+
+func (x *GetMemoRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMemoRecurrenceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateMemoRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this memo recurrence will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The memo recurrence to create.
+	MemoRecurrence *MemoRecurrence `protobuf:"bytes,2,opt,name=memo_recurrence,json=memoRecurrence,proto3" json:"memo_recurrence,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateMemoRecurrenceRequest) Reset() {
+	*x = CreateMemoRecurrenceRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoRecurrenceRequest) ProtoMessage() {}
+
+func (x *CreateMemoRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateMemoRecurrenceRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoRecurrenceRequest) GetMemoRecurrence() *MemoRecurrence {
+	if x != nil {
+		return x.MemoRecurrence
+	}
+	return nil
+}
+
+type UpdateMemoRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo recurrence resource which replaces the resource on the server.
+	MemoRecurrence *MemoRecurrence `protobuf:"bytes,1,opt,name=memo_recurrence,json=memoRecurrence,proto3" json:"memo_recurrence,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoRecurrenceRequest) Reset() {
+	*x = UpdateMemoRecurrenceRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoRecurrenceRequest) ProtoMessage() {}
+
+func (x *UpdateMemoRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMemoRecurrenceRequest) GetMemoRecurrence() *MemoRecurrence {
+	if x != nil {
+		return x.MemoRecurrence
+	}
+	return nil
+}
+
+func (x *UpdateMemoRecurrenceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteMemoRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo recurrence to delete.
+	// Format: users/{user}/memoRecurrences/{memo_recurrence}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoRecurrenceRequest) Reset() {
+	*x = DeleteMemoRecurrenceRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoRecurrenceRequest) ProtoMessage() {}
+
+func (x *DeleteMemoRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteMemoRecurrenceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PauseMemoRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo recurrence to pause.
+	// Format: users/{user}/memoRecurrences/{memo_recurrence}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseMemoRecurrenceRequest) Reset() {
+	*x = PauseMemoRecurrenceRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseMemoRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMemoRecurrenceRequest) ProtoMessage() {}
+
+func (x *PauseMemoRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMemoRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*PauseMemoRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PauseMemoRecurrenceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResumeMemoRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo recurrence to resume.
+	// Format: users/{user}/memoRecurrences/{memo_recurrence}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeMemoRecurrenceRequest) Reset() {
+	*x = ResumeMemoRecurrenceRequest{}
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeMemoRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeMemoRecurrenceRequest) ProtoMessage() {}
+
+func (x *ResumeMemoRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_recurrence_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeMemoRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*ResumeMemoRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_recurrence_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResumeMemoRecurrenceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_memo_recurrence_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_memo_recurrence_service_proto_rawDesc = "" +
+	"\n" +
+	"$api/v1/memo_recurrence_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x05\n" +
+	"\x0eMemoRecurrence\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05rrule\x18\x02 \x01(\tB\x03\xe0A\x02R\x05rrule\x12#\n" +
+	"\vtime_of_day\x18\x03 \x01(\tB\x03\xe0A\x02R\ttimeOfDay\x12\x1f\n" +
+	"\btimezone\x18\x04 \x01(\tB\x03\xe0A\x01R\btimezone\x12%\n" +
+	"\rmemo_template\x18\x05 \x01(\tH\x00R\fmemoTemplate\x12\x14\n" +
+	"\x04memo\x18\x06 \x01(\tH\x00R\x04memo\x12\x17\n" +
+	"\x04tags\x18\a \x03(\tB\x03\xe0A\x01R\x04tags\x12\x1b\n" +
+	"\x06paused\x18\b \x01(\bB\x03\xe0A\x03R\x06paused\x12S\n" +
+	"\x15next_occurrence_times\x18\t \x03(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x13nextOccurrenceTimes\x12Q\n" +
+	"\x14last_occurrence_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x12lastOccurrenceTime\x12@\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime:q\xeaAn\n" +
+	"\x1bmemos.api.v1/MemoRecurrence\x12.users/{user}/memoRecurrences/{memo_recurrence}*\x0fmemoRecurrences2\x0ememoRecurrenceB\b\n" +
+	"\x06source\"Y\n" +
+	"\x1aListMemoRecurrencesRequest\x12;\n" +
+	"\x06parent\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\x12\x1bmemos.api.v1/MemoRecurrenceR\x06parent\"f\n" +
+	"\x1bListMemoRecurrencesResponse\x12G\n" +
+	"\x10memo_recurrences\x18\x01 \x03(\v2\x1c.memos.api.v1.MemoRecurrenceR\x0fmemoRecurrences\"S\n" +
+	"\x18GetMemoRecurrenceRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/MemoRecurrenceR\x04name\"\xa6\x01\n" +
+	"\x1bCreateMemoRecurrenceRequest\x12;\n" +
+	"\x06parent\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\x12\x1bmemos.api.v1/MemoRecurrenceR\x06parent\x12J\n" +
+	"\x0fmemo_recurrence\x18\x02 \x01(\v2\x1c.memos.api.v1.MemoRecurrenceB\x03\xe0A\x02R\x0ememoRecurrence\"\xab\x01\n" +
+	"\x1bUpdateMemoRecurrenceRequest\x12J\n" +
+	"\x0fmemo_recurrence\x18\x01 \x01(\v2\x1c.memos.api.v1.MemoRecurrenceB\x03\xe0A\x02R\x0ememoRecurrence\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"V\n" +
+	"\x1bDeleteMemoRecurrenceRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/MemoRecurrenceR\x04name\"U\n" +
+	"\x1aPauseMemoRecurrenceRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/MemoRecurrenceR\x04name\"V\n" +
+	"\x1bResumeMemoRecurrenceRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/MemoRecurrenceR\x04name2\xc1\t\n" +
+	"\x15MemoRecurrenceService\x12\xa5\x01\n" +
+	"\x13ListMemoRecurrences\x12(.memos.api.v1.ListMemoRecurrencesRequest\x1a).memos.api.v1.ListMemoRecurrencesResponse\"9\xdaA\x06parent\x82\xd3\xe4\x93\x02*\x12(/api/v1/{parent=users/*}/memoRecurrences\x12\x92\x01\n" +
+	"\x11GetMemoRecurrence\x12&.memos.api.v1.GetMemoRecurrenceRequest\x1a\x1c.memos.api.v1.MemoRecurrence\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*\x12(/api/v1/{name=users/*/memoRecurrences/*}\x12\xbb\x01\n" +
+	"\x14CreateMemoRecurrence\x12).memos.api.v1.CreateMemoRecurrenceRequest\x1a\x1c.memos.api.v1.MemoRecurrence\"Z\xdaA\x16parent,memo_recurrence\x82\xd3\xe4\x93\x02;:\x0fmemo_recurrence\"(/api/v1/{parent=users/*}/memoRecurrences\x12\xd0\x01\n" +
+	"\x14UpdateMemoRecurrence\x12).memos.api.v1.UpdateMemoRecurrenceRequest\x1a\x1c.memos.api.v1.MemoRecurrence\"o\xdaA\x1bmemo_recurrence,update_mask\x82\xd3\xe4\x93\x02K:\x0fmemo_recurrence28/api/v1/{memo_recurrence.name=users/*/memoRecurrences/*}\x12\x92\x01\n" +
+	"\x14DeleteMemoRecurrence\x12).memos.api.v1.DeleteMemoRecurrenceRequest\x1a\x16.google.protobuf.Empty\"7\xdaA\x04name\x82\xd3\xe4\x93\x02**(/api/v1/{name=users/*/memoRecurrences/*}\x12\x9f\x01\n" +
+	"\x13PauseMemoRecurrence\x12(.memos.api.v1.PauseMemoRecurrenceRequest\x1a\x1c.memos.api.v1.MemoRecurrence\"@\xdaA\x04name\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/{name=users/*/memoRecurrences/*}:pause\x12\xa2\x01\n" +
+	"\x14ResumeMemoRecurrence\x12).memos.api.v1.ResumeMemoRecurrenceRequest\x1a\x1c.memos.api.v1.MemoRecurrence\"A\xdaA\x04name\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/{name=users/*/memoRecurrences/*}:resumeB\xb2\x01\n" +
+	"\x10com.memos.api.v1B\x1aMemoRecurrenceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_memo_recurrence_service_proto_rawDescOnce sync.Once
+	file_api_v1_memo_recurrence_service_proto_rawDescData []byte
+)
+
+func file_api_v1_memo_recurrence_service_proto_rawDescGZIP() []byte {
+	file_api_v1_memo_recurrence_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_memo_recurrence_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_memo_recurrence_service_proto_rawDesc), len(file_api_v1_memo_recurrence_service_proto_rawDesc)))
+	})
+	return file_api_v1_memo_recurrence_service_proto_rawDescData
+}
+
+var file_api_v1_memo_recurrence_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_memo_recurrence_service_proto_goTypes = []any{
+	(*MemoRecurrence)(nil),              // 0: memos.api.v1.MemoRecurrence
+	(*ListMemoRecurrencesRequest)(nil),  // 1: memos.api.v1.ListMemoRecurrencesRequest
+	(*ListMemoRecurrencesResponse)(nil), // 2: memos.api.v1.ListMemoRecurrencesResponse
+	(*GetMemoRecurrenceRequest)(nil),    // 3: memos.api.v1.GetMemoRecurrenceRequest
+	(*CreateMemoRecurrenceRequest)(nil), // 4: memos.api.v1.CreateMemoRecurrenceRequest
+	(*UpdateMemoRecurrenceRequest)(nil), // 5: memos.api.v1.UpdateMemoRecurrenceRequest
+	(*DeleteMemoRecurrenceRequest)(nil), // 6: memos.api.v1.DeleteMemoRecurrenceRequest
+	(*PauseMemoRecurrenceRequest)(nil),  // 7: memos.api.v1.PauseMemoRecurrenceRequest
+	(*ResumeMemoRecurrenceRequest)(nil), // 8: memos.api.v1.ResumeMemoRecurrenceRequest
+	(*timestamppb.Timestamp)(nil),       // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),               // 11: google.protobuf.Empty
+}
+var file_api_v1_memo_recurrence_service_proto_depIdxs = []int32{
+	9,  // 0: memos.api.v1.MemoRecurrence.next_occurrence_times:type_name -> google.protobuf.Timestamp
+	9,  // 1: memos.api.v1.MemoRecurrence.last_occurrence_time:type_name -> google.protobuf.Timestamp
+	9,  // 2: memos.api.v1.MemoRecurrence.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 3: memos.api.v1.MemoRecurrence.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: memos.api.v1.ListMemoRecurrencesResponse.memo_recurrences:type_name -> memos.api.v1.MemoRecurrence
+	0,  // 5: memos.api.v1.CreateMemoRecurrenceRequest.memo_recurrence:type_name -> memos.api.v1.MemoRecurrence
+	0,  // 6: memos.api.v1.UpdateMemoRecurrenceRequest.memo_recurrence:type_name -> memos.api.v1.MemoRecurrence
+	10, // 7: memos.api.v1.UpdateMemoRecurrenceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: memos.api.v1.MemoRecurrenceService.ListMemoRecurrences:input_type -> memos.api.v1.ListMemoRecurrencesRequest
+	3,  // 9: memos.api.v1.MemoRecurrenceService.GetMemoRecurrence:input_type -> memos.api.v1.GetMemoRecurrenceRequest
+	4,  // 10: memos.api.v1.MemoRecurrenceService.CreateMemoRecurrence:input_type -> memos.api.v1.CreateMemoRecurrenceRequest
+	5,  // 11: memos.api.v1.MemoRecurrenceService.UpdateMemoRecurrence:input_type -> memos.api.v1.UpdateMemoRecurrenceRequest
+	6,  // 12: memos.api.v1.MemoRecurrenceService.DeleteMemoRecurrence:input_type -> memos.api.v1.DeleteMemoRecurrenceRequest
+	7,  // 13: memos.api.v1.MemoRecurrenceService.PauseMemoRecurrence:input_type -> memos.api.v1.PauseMemoRecurrenceRequest
+	8,  // 14: memos.api.v1.MemoRecurrenceService.ResumeMemoRecurrence:input_type -> memos.api.v1.ResumeMemoRecurrenceRequest
+	2,  // 15: memos.api.v1.MemoRecurrenceService.ListMemoRecurrences:output_type -> memos.api.v1.ListMemoRecurrencesResponse
+	0,  // 16: memos.api.v1.MemoRecurrenceService.GetMemoRecurrence:output_type -> memos.api.v1.MemoRecurrence
+	0,  // 17: memos.api.v1.MemoRecurrenceService.CreateMemoRecurrence:output_type -> memos.api.v1.MemoRecurrence
+	0,  // 18: memos.api.v1.MemoRecurrenceService.UpdateMemoRecurrence:output_type -> memos.api.v1.MemoRecurrence
+	11, // 19: memos.api.v1.MemoRecurrenceService.DeleteMemoRecurrence:output_type -> google.protobuf.Empty
+	0,  // 20: memos.api.v1.MemoRecurrenceService.PauseMemoRecurrence:output_type -> memos.api.v1.MemoRecurrence
+	0,  // 21: memos.api.v1.MemoRecurrenceService.ResumeMemoRecurrence:output_type -> memos.api.v1.MemoRecurrence
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_memo_recurrence_service_proto_init() }
+func file_api_v1_memo_recurrence_service_proto_init() {
+	if File_api_v1_memo_recurrence_service_proto != nil {
+		return
+	}
+	file_api_v1_memo_recurrence_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*MemoRecurrence_MemoTemplate)(nil),
+		(*MemoRecurrence_Memo)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_recurrence_service_proto_rawDesc), len(file_api_v1_memo_recurrence_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_memo_recurrence_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_memo_recurrence_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_memo_recurrence_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_memo_recurrence_service_proto = out.File
+	file_api_v1_memo_recurrence_service_proto_goTypes = nil
+	file_api_v1_memo_recurrence_service_proto_depIdxs = nil
+}
@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: api/v1/memo_recurrence_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MemoRecurrenceService_ListMemoRecurrences_FullMethodName  = "/memos.api.v1.MemoRecurrenceService/ListMemoRecurrences"
+	MemoRecurrenceService_GetMemoRecurrence_FullMethodName    = "/memos.api.v1.MemoRecurrenceService/GetMemoRecurrence"
+	MemoRecurrenceService_CreateMemoRecurrence_FullMethodName = "/memos.api.v1.MemoRecurrenceService/CreateMemoRecurrence"
+	MemoRecurrenceService_UpdateMemoRecurrence_FullMethodName = "/memos.api.v1.MemoRecurrenceService/UpdateMemoRecurrence"
+	MemoRecurrenceService_DeleteMemoRecurrence_FullMethodName = "/memos.api.v1.MemoRecurrenceService/DeleteMemoRecurrence"
+	MemoRecurrenceService_PauseMemoRecurrence_FullMethodName  = "/memos.api.v1.MemoRecurrenceService/PauseMemoRecurrence"
+	MemoRecurrenceService_ResumeMemoRecurrence_FullMethodName = "/memos.api.v1.MemoRecurrenceService/ResumeMemoRecurrence"
+)
+
+// MemoRecurrenceServiceClient is the client API for MemoRecurrenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemoRecurrenceServiceClient interface {
+	// ListMemoRecurrences returns the memo recurrences of a user.
+	ListMemoRecurrences(ctx context.Context, in *ListMemoRecurrencesRequest, opts ...grpc.CallOption) (*ListMemoRecurrencesResponse, error)
+	// GetMemoRecurrence gets a memo recurrence by name.
+	GetMemoRecurrence(ctx context.Context, in *GetMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error)
+	// CreateMemoRecurrence creates a memo recurrence for a user. Its first occurrence is the first
+	// one after the creation.
+	CreateMemoRecurrence(ctx context.Context, in *CreateMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error)
+	// UpdateMemoRecurrence updates a memo recurrence of a user. Changing its schedule moves its next
+	// occurrence to the first one of the new schedule after the update.
+	UpdateMemoRecurrence(ctx context.Context, in *UpdateMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error)
+	// DeleteMemoRecurrence deletes a memo recurrence of a user. The memos it created are kept.
+	DeleteMemoRecurrence(ctx context.Context, in *DeleteMemoRecurrenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PauseMemoRecurrence stops a memo recurrence from creating memos until it is resumed.
+	PauseMemoRecurrence(ctx context.Context, in *PauseMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error)
+	// ResumeMemoRecurrence resumes a paused memo recurrence from its first occurrence after now. The
+	// occurrences while it was paused are skipped.
+	ResumeMemoRecurrence(ctx context.Context, in *ResumeMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error)
+}
+
+type memoRecurrenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemoRecurrenceServiceClient(cc grpc.ClientConnInterface) MemoRecurrenceServiceClient {
+	return &memoRecurrenceServiceClient{cc}
+}
+
+func (c *memoRecurrenceServiceClient) ListMemoRecurrences(ctx context.Context, in *ListMemoRecurrencesRequest, opts ...grpc.CallOption) (*ListMemoRecurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRecurrencesResponse)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_ListMemoRecurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRecurrenceServiceClient) GetMemoRecurrence(ctx context.Context, in *GetMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRecurrence)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_GetMemoRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRecurrenceServiceClient) CreateMemoRecurrence(ctx context.Context, in *CreateMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRecurrence)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_CreateMemoRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRecurrenceServiceClient) UpdateMemoRecurrence(ctx context.Context, in *UpdateMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRecurrence)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_UpdateMemoRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRecurrenceServiceClient) DeleteMemoRecurrence(ctx context.Context, in *DeleteMemoRecurrenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_DeleteMemoRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRecurrenceServiceClient) PauseMemoRecurrence(ctx context.Context, in *PauseMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRecurrence)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_PauseMemoRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoRecurrenceServiceClient) ResumeMemoRecurrence(ctx context.Context, in *ResumeMemoRecurrenceRequest, opts ...grpc.CallOption) (*MemoRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRecurrence)
+	err := c.cc.Invoke(ctx, MemoRecurrenceService_ResumeMemoRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoRecurrenceServiceServer is the server API for MemoRecurrenceService service.
+// All implementations must embed UnimplementedMemoRecurrenceServiceServer
+// for forward compatibility.
+type MemoRecurrenceServiceServer interface {
+	// ListMemoRecurrences returns the memo recurrences of a user.
+	ListMemoRecurrences(context.Context, *ListMemoRecurrencesRequest) (*ListMemoRecurrencesResponse, error)
+	// GetMemoRecurrence gets a memo recurrence by name.
+	GetMemoRecurrence(context.Context, *GetMemoRecurrenceRequest) (*MemoRecurrence, error)
+	// CreateMemoRecurrence creates a memo recurrence for a user. Its first occurrence is the first
+	// one after the creation.
+	CreateMemoRecurrence(context.Context, *CreateMemoRecurrenceRequest) (*MemoRecurrence, error)
+	// UpdateMemoRecurrence updates a memo recurrence of a user. Changing its schedule moves its next
+	// occurrence to the first one of the new schedule after the update.
+	UpdateMemoRecurrence(context.Context, *UpdateMemoRecurrenceRequest) (*MemoRecurrence, error)
+	// DeleteMemoRecurrence deletes a memo recurrence of a user. The memos it created are kept.
+	DeleteMemoRecurrence(context.Context, *DeleteMemoRecurrenceRequest) (*emptypb.Empty, error)
+	// PauseMemoRecurrence stops a memo recurrence from creating memos until it is resumed.
+	PauseMemoRecurrence(context.Context, *PauseMemoRecurrenceRequest) (*MemoRecurrence, error)
+	// ResumeMemoRecurrence resumes a paused memo recurrence from its first occurrence after now. The
+	// occurrences while it was paused are skipped.
+	ResumeMemoRecurrence(context.Context, *ResumeMemoRecurrenceRequest) (*MemoRecurrence, error)
+	mustEmbedUnimplementedMemoRecurrenceServiceServer()
+}
+
+// UnimplementedMemoRecurrenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemoRecurrenceServiceServer struct{}
+
+func (UnimplementedMemoRecurrenceServiceServer) ListMemoRecurrences(context.Context, *ListMemoRecurrencesRequest) (*ListMemoRecurrencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoRecurrences not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) GetMemoRecurrence(context.Context, *GetMemoRecurrenceRequest) (*MemoRecurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoRecurrence not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) CreateMemoRecurrence(context.Context, *CreateMemoRecurrenceRequest) (*MemoRecurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemoRecurrence not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) UpdateMemoRecurrence(context.Context, *UpdateMemoRecurrenceRequest) (*MemoRecurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemoRecurrence not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) DeleteMemoRecurrence(context.Context, *DeleteMemoRecurrenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemoRecurrence not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) PauseMemoRecurrence(context.Context, *PauseMemoRecurrenceRequest) (*MemoRecurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseMemoRecurrence not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) ResumeMemoRecurrence(context.Context, *ResumeMemoRecurrenceRequest) (*MemoRecurrence, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeMemoRecurrence not implemented")
+}
+func (UnimplementedMemoRecurrenceServiceServer) mustEmbedUnimplementedMemoRecurrenceServiceServer() {}
+func (UnimplementedMemoRecurrenceServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeMemoRecurrenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoRecurrenceServiceServer will
+// result in compilation errors.
+type UnsafeMemoRecurrenceServiceServer interface {
+	mustEmbedUnimplementedMemoRecurrenceServiceServer()
+}
+
+func RegisterMemoRecurrenceServiceServer(s grpc.ServiceRegistrar, srv MemoRecurrenceServiceServer) {
+	// If the following call panics, it indicates UnimplementedMemoRecurrenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemoRecurrenceService_ServiceDesc, srv)
+}
+
+func _MemoRecurrenceService_ListMemoRecurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRecurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).ListMemoRecurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_ListMemoRecurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).ListMemoRecurrences(ctx, req.(*ListMemoRecurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRecurrenceService_GetMemoRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).GetMemoRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_GetMemoRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).GetMemoRecurrence(ctx, req.(*GetMemoRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRecurrenceService_CreateMemoRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).CreateMemoRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_CreateMemoRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).CreateMemoRecurrence(ctx, req.(*CreateMemoRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRecurrenceService_UpdateMemoRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).UpdateMemoRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_UpdateMemoRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).UpdateMemoRecurrence(ctx, req.(*UpdateMemoRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRecurrenceService_DeleteMemoRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).DeleteMemoRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_DeleteMemoRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).DeleteMemoRecurrence(ctx, req.(*DeleteMemoRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRecurrenceService_PauseMemoRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseMemoRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).PauseMemoRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_PauseMemoRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).PauseMemoRecurrence(ctx, req.(*PauseMemoRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoRecurrenceService_ResumeMemoRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeMemoRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoRecurrenceServiceServer).ResumeMemoRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoRecurrenceService_ResumeMemoRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoRecurrenceServiceServer).ResumeMemoRecurrence(ctx, req.(*ResumeMemoRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoRecurrenceService_ServiceDesc is the grpc.ServiceDesc for MemoRecurrenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoRecurrenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.MemoRecurrenceService",
+	HandlerType: (*MemoRecurrenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMemoRecurrences",
+			Handler:    _MemoRecurrenceService_ListMemoRecurrences_Handler,
+		},
+		{
+			MethodName: "GetMemoRecurrence",
+			Handler:    _MemoRecurrenceService_GetMemoRecurrence_Handler,
+		},
+		{
+			MethodName: "CreateMemoRecurrence",
+			Handler:    _MemoRecurrenceService_CreateMemoRecurrence_Handler,
+		},
+		{
+			MethodName: "UpdateMemoRecurrence",
+			Handler:    _MemoRecurrenceService_UpdateMemoRecurrence_Handler,
+		},
+		{
+			MethodName: "DeleteMemoRecurrence",
+			Handler:    _MemoRecurrenceService_DeleteMemoRecurrence_Handler,
+		},
+		{
+			MethodName: "PauseMemoRecurrence",
+			Handler:    _MemoRecurrenceService_PauseMemoRecurrence_Handler,
+		},
+		{
+			MethodName: "ResumeMemoRecurrence",
+			Handler:    _MemoRecurrenceService_ResumeMemoRecurrence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/memo_recurrence_service.proto",
+}
@@ -77,6 +77,172 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
 }
 
+type MemoReminder_Repeat int32
+
+const (
+	// The reminder is delivered once.
+	MemoReminder_REPEAT_UNSPECIFIED MemoReminder_Repeat = 0
+	// The reminder is delivered every day.
+	MemoReminder_DAILY MemoReminder_Repeat = 1
+	// The reminder is delivered every week.
+	MemoReminder_WEEKLY MemoReminder_Repeat = 2
+)
+
+// Enum value maps for MemoReminder_Repeat.
+var (
+	MemoReminder_Repeat_name = map[int32]string{
+		0: "REPEAT_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	MemoReminder_Repeat_value = map[string]int32{
+		"REPEAT_UNSPECIFIED": 0,
+		"DAILY":              1,
+		"WEEKLY":             2,
+	}
+)
+
+func (x MemoReminder_Repeat) Enum() *MemoReminder_Repeat {
+	p := new(MemoReminder_Repeat)
+	*p = x
+	return p
+}
+
+func (x MemoReminder_Repeat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoReminder_Repeat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MemoReminder_Repeat) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[1]
+}
+
+func (x MemoReminder_Repeat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoReminder_Repeat.Descriptor instead.
+func (MemoReminder_Repeat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type ImportMemosRequest_Format int32
+
+const (
+	ImportMemosRequest_FORMAT_UNSPECIFIED ImportMemosRequest_Format = 0
+	// A zip archive of markdown files.
+	ImportMemosRequest_MARKDOWN ImportMemosRequest_Format = 1
+	// A Google Keep Takeout archive, with a JSON file per note and the files attached to the
+	// notes. The titles of the notes are prepended to their content as headings, their labels
+	// are appended as tags, and their checklists are converted to task lists.
+	ImportMemosRequest_GOOGLE_KEEP ImportMemosRequest_Format = 2
+	// A Day One JSON export, with a JSON file per journal and the photos, videos, audio
+	// recordings and PDFs of the entries named after their MD5 hashes. The entries keep their
+	// creation date, tags and location, and their embedded media become attachments.
+	ImportMemosRequest_DAY_ONE ImportMemosRequest_Format = 3
+)
+
+// Enum value maps for ImportMemosRequest_Format.
+var (
+	ImportMemosRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "MARKDOWN",
+		2: "GOOGLE_KEEP",
+		3: "DAY_ONE",
+	}
+	ImportMemosRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"MARKDOWN":           1,
+		"GOOGLE_KEEP":        2,
+		"DAY_ONE":            3,
+	}
+)
+
+func (x ImportMemosRequest_Format) Enum() *ImportMemosRequest_Format {
+	p := new(ImportMemosRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ImportMemosRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMemosRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportMemosRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[2]
+}
+
+func (x ImportMemosRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMemosRequest_Format.Descriptor instead.
+func (ImportMemosRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type MemoImport_State int32
+
+const (
+	MemoImport_STATE_UNSPECIFIED MemoImport_State = 0
+	// The import is running.
+	MemoImport_RUNNING MemoImport_State = 1
+	// The import finished, possibly with some files failing.
+	MemoImport_SUCCEEDED MemoImport_State = 2
+	// The import was aborted.
+	MemoImport_FAILED MemoImport_State = 3
+)
+
+// Enum value maps for MemoImport_State.
+var (
+	MemoImport_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	MemoImport_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"RUNNING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x MemoImport_State) Enum() *MemoImport_State {
+	p := new(MemoImport_State)
+	*p = x
+	return p
+}
+
+func (x MemoImport_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoImport_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[3].Descriptor()
+}
+
+func (MemoImport_State) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[3]
+}
+
+func (x MemoImport_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoImport_State.Descriptor instead.
+func (MemoImport_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53, 0}
+}
+
 // The type of the relation.
 type MemoRelation_Type int32
 
@@ -84,6 +250,8 @@ const (
 	MemoRelation_TYPE_UNSPECIFIED MemoRelation_Type = 0
 	MemoRelation_REFERENCE        MemoRelation_Type = 1
 	MemoRelation_COMMENT          MemoRelation_Type = 2
+	// The memo was merged into the related memo.
+	MemoRelation_MERGE MemoRelation_Type = 3
 )
 
 // Enum value maps for MemoRelation_Type.
@@ -92,11 +260,13 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "REFERENCE",
 		2: "COMMENT",
+		3: "MERGE",
 	}
 	MemoRelation_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"REFERENCE":        1,
 		"COMMENT":          2,
+		"MERGE":            3,
 	}
 )
 
@@ -111,11 +281,11 @@ func (x MemoRelation_Type) String() string {
 }
 
 func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_memo_service_proto_enumTypes[4].Descriptor()
 }
 
 func (MemoRelation_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_memo_service_proto_enumTypes[1]
+	return &file_api_v1_memo_service_proto_enumTypes[4]
 }
 
 func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
@@ -124,7 +294,7 @@ func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemoRelation_Type.Descriptor instead.
 func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57, 0}
 }
 
 type Reaction struct {
@@ -234,7 +404,7 @@ type Memo struct {
 	Visibility Visibility `protobuf:"varint,9,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
 	// Output only. The tags extracted from the content.
 	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
-	// Whether the memo is pinned.
+	// Whether the memo is pinned. A memo pinned by an update is placed at the top of the pinned memos.
 	Pinned bool `protobuf:"varint,11,opt,name=pinned,proto3" json:"pinned,omitempty"`
 	// Optional. The attachments of the memo.
 	Attachments []*Attachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
@@ -250,7 +420,42 @@ type Memo struct {
 	// Output only. The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// Optional. The location of the memo.
-	Location      *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Location *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Output only. When the memo was moved to the trash. Unset if it isn't in the trash.
+	DeleteTime *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	// Output only. Only set in search results: the content around the search matches, HTML-escaped,
+	// with each match wrapped in <mark> tags.
+	SearchSnippet string `protobuf:"bytes,20,opt,name=search_snippet,json=searchSnippet,proto3" json:"search_snippet,omitempty"`
+	// Optional. When the memo is scheduled to be published. Until then, the memo is only visible to
+	// its creator, whatever its visibility. Once published, its display time is set to the publish
+	// time and this field is unset. Clear it in an update to publish the memo now.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// Optional. The reminder of the memo. Its creator gets an inbox notification and their webhooks
+	// get a memos.memo.reminder event when it is due. Clear it in an update to remove the reminder.
+	Reminder *MemoReminder `protobuf:"bytes,22,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	// Optional. Whether new comments on the memo are rejected. Comments created before are still
+	// listed. Only the creator of the memo can change it.
+	DisableComments bool `protobuf:"varint,23,opt,name=disable_comments,json=disableComments,proto3" json:"disable_comments,omitempty"`
+	// Optional. When the memo expires. Once it has passed, the memo is excluded from all reads, then
+	// moved to the trash with its expiry cleared, and webhooks get a memos.memo.expired event. It
+	// must be in the future, and for a comment not later than the expiry of its memo, which a
+	// comment without an expiry inherits. Clear it in an update to remove the expiry.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Output only. The link the memo bookmarks, if it was created by CreateBookmarkMemo.
+	Bookmark *MemoBookmark `protobuf:"bytes,25,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	// Optional. The short name of the memo in its URLs, such as /memos/weekly-review. It is unique
+	// per instance and made of lowercase letters, digits and hyphens. It can be used instead of the
+	// UID in the name of GetMemo, and after a change the previous slug keeps resolving to the memo
+	// for a grace period. Only the creator of the memo can set it, in UpdateMemo. Clear it in an
+	// update to remove the slug.
+	Slug string `protobuf:"bytes,26,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Output only. The [[wiki links]] of the content. A link resolves, when the memo is saved, to
+	// the memo of the creator with the target as slug, or else as title. The memo of a link is
+	// empty if it didn't resolve or the linked memo isn't visible.
+	WikiLinks []*Memo_WikiLink `protobuf:"bytes,27,rep,name=wiki_links,json=wikiLinks,proto3" json:"wiki_links,omitempty"`
+	// Output only. A checksum of the content, which changes whenever the content does. Requests that
+	// edit part of the content take it to fail if the memo was edited since the client read it.
+	Etag          string `protobuf:"bytes,28,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -404,95 +609,104 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
-type Location struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// A placeholder text for the location.
-	Placeholder string `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	// The latitude of the location.
-	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	// The longitude of the location.
-	Longitude     float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Memo) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Memo) GetSearchSnippet() string {
+	if x != nil {
+		return x.SearchSnippet
+	}
+	return ""
 }
 
-func (x *Location) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Memo) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
 }
 
-func (*Location) ProtoMessage() {}
+func (x *Memo) GetReminder() *MemoReminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+func (x *Memo) GetDisableComments() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DisableComments
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+func (x *Memo) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
 }
 
-func (x *Location) GetPlaceholder() string {
+func (x *Memo) GetBookmark() *MemoBookmark {
 	if x != nil {
-		return x.Placeholder
+		return x.Bookmark
+	}
+	return nil
+}
+
+func (x *Memo) GetSlug() string {
+	if x != nil {
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *Location) GetLatitude() float64 {
+func (x *Memo) GetWikiLinks() []*Memo_WikiLink {
 	if x != nil {
-		return x.Latitude
+		return x.WikiLinks
 	}
-	return 0
+	return nil
 }
 
-func (x *Location) GetLongitude() float64 {
+func (x *Memo) GetEtag() string {
 	if x != nil {
-		return x.Longitude
+		return x.Etag
 	}
-	return 0
+	return ""
 }
 
-type CreateMemoRequest struct {
+type MemoReminder struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The memo to create.
-	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// Optional. The memo ID to use for this memo.
-	// If empty, a unique ID will be generated.
-	MemoId        string `protobuf:"bytes,2,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// Required. When the reminder is due. A repeating reminder moves to its next occurrence in the
+	// time zone of the memo creator once delivered.
+	RemindTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
+	// How the reminder repeats once delivered.
+	Repeat MemoReminder_Repeat `protobuf:"varint,2,opt,name=repeat,proto3,enum=memos.api.v1.MemoReminder_Repeat" json:"repeat,omitempty"`
+	// Output only. When the reminder was last delivered. Unset if it hasn't been delivered.
+	DeliverTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deliver_time,json=deliverTime,proto3" json:"deliver_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoRequest) Reset() {
-	*x = CreateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+func (x *MemoReminder) Reset() {
+	*x = MemoReminder{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoRequest) String() string {
+func (x *MemoReminder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoRequest) ProtoMessage() {}
+func (*MemoReminder) ProtoMessage() {}
 
-func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+func (x *MemoReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,69 +717,61 @@ func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MemoReminder.ProtoReflect.Descriptor instead.
+func (*MemoReminder) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateMemoRequest) GetMemo() *Memo {
+func (x *MemoReminder) GetRemindTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Memo
+		return x.RemindTime
 	}
 	return nil
 }
 
-func (x *CreateMemoRequest) GetMemoId() string {
+func (x *MemoReminder) GetRepeat() MemoReminder_Repeat {
 	if x != nil {
-		return x.MemoId
+		return x.Repeat
 	}
-	return ""
+	return MemoReminder_REPEAT_UNSPECIFIED
 }
 
-type ListMemosRequest struct {
+func (x *MemoReminder) GetDeliverTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliverTime
+	}
+	return nil
+}
+
+type MemoBookmark struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. The maximum number of memos to return.
-	// The service may return fewer than this value.
-	// If unspecified, at most 50 memos will be returned.
-	// The maximum value is 1000; values above 1000 will be coerced to 1000.
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token, received from a previous `ListMemos` call.
-	// Provide this to retrieve the subsequent page.
-	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. The state of the memos to list.
-	// Default to `NORMAL`. Set to `ARCHIVED` to list archived memos.
-	State State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
-	// Optional. The order to sort results by.
-	// Default to "display_time desc".
-	// Supports comma-separated list of fields following AIP-132.
-	// Example: "pinned desc, display_time desc" or "create_time asc"
-	// Supported fields: pinned, display_time, create_time, update_time, name
-	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	// Optional. Filter to apply to the list results.
-	// Filter is a CEL expression to filter memos.
-	// Refer to `Shortcut.filter`.
-	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
-	// Optional. If true, show deleted memos in the response.
-	ShowDeleted   bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// The bookmarked link.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The title of the link, empty if its metadata couldn't be fetched.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The description of the link.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The URL of the image of the link, e.g. its Open Graph image.
+	Image         string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemosRequest) Reset() {
-	*x = ListMemosRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+func (x *MemoBookmark) Reset() {
+	*x = MemoBookmark{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemosRequest) String() string {
+func (x *MemoBookmark) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemosRequest) ProtoMessage() {}
+func (*MemoBookmark) ProtoMessage() {}
 
-func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+func (x *MemoBookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,79 +782,69 @@ func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
-func (*ListMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ListMemosRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use MemoBookmark.ProtoReflect.Descriptor instead.
+func (*MemoBookmark) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListMemosRequest) GetPageToken() string {
+func (x *MemoBookmark) GetUrl() string {
 	if x != nil {
-		return x.PageToken
+		return x.Url
 	}
 	return ""
 }
 
-func (x *ListMemosRequest) GetState() State {
-	if x != nil {
-		return x.State
-	}
-	return State_STATE_UNSPECIFIED
-}
-
-func (x *ListMemosRequest) GetOrderBy() string {
+func (x *MemoBookmark) GetTitle() string {
 	if x != nil {
-		return x.OrderBy
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ListMemosRequest) GetFilter() string {
+func (x *MemoBookmark) GetDescription() string {
 	if x != nil {
-		return x.Filter
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListMemosRequest) GetShowDeleted() bool {
+func (x *MemoBookmark) GetImage() string {
 	if x != nil {
-		return x.ShowDeleted
+		return x.Image
 	}
-	return false
+	return ""
 }
 
-type ListMemosResponse struct {
+type Location struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of memos.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token that can be sent as `page_token` to retrieve the next page.
-	// If this field is omitted, there are no subsequent pages.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// A placeholder text for the location, at most 256 characters.
+	Placeholder string `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	// The latitude of the location, from -90 to 90.
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// The longitude of the location, from -180 to 180.
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Optional. Whether the location is shown to other users who can view the memo, and matched by
+	// their location filters. The location is only shown to the creator otherwise.
+	ShowPublicly  bool `protobuf:"varint,4,opt,name=show_publicly,json=showPublicly,proto3" json:"show_publicly,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemosResponse) Reset() {
-	*x = ListMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemosResponse) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemosResponse) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,49 +855,3011 @@ func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
-func (*ListMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListMemosResponse) GetMemos() []*Memo {
+func (x *Location) GetPlaceholder() string {
 	if x != nil {
-		return x.Memos
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetShowPublicly() bool {
+	if x != nil {
+		return x.ShowPublicly
+	}
+	return false
+}
+
+type CreateMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo to create.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Optional. The memo ID to use for this memo.
+	// If empty, a unique ID will be generated.
+	MemoId        string `protobuf:"bytes,2,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoRequest) Reset() {
+	*x = CreateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoRequest) ProtoMessage() {}
+
+func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMemoRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *CreateMemoRequest) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+type CreateBookmarkMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The link to bookmark, an http or https URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. A comment added to the memo below the link.
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Optional. The tags, without the # prefix, added to the memo.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. The visibility of the memo.
+	// Defaults to the default memo visibility of the user.
+	Visibility    Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookmarkMemoRequest) Reset() {
+	*x = CreateBookmarkMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookmarkMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkMemoRequest) ProtoMessage() {}
+
+func (x *CreateBookmarkMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkMemoRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateBookmarkMemoRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateBookmarkMemoRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateBookmarkMemoRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateBookmarkMemoRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type CreateBookmarkMemoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo bookmarking the link.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Why the metadata of the link couldn't be fetched, empty if it was.
+	Warning string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	// Whether the memo was created before, as the link was bookmarked within the duplicate window
+	// of the instance.
+	Duplicate     bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookmarkMemoResponse) Reset() {
+	*x = CreateBookmarkMemoResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookmarkMemoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookmarkMemoResponse) ProtoMessage() {}
+
+func (x *CreateBookmarkMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookmarkMemoResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookmarkMemoResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateBookmarkMemoResponse) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *CreateBookmarkMemoResponse) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+func (x *CreateBookmarkMemoResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type ListMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of memos to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 50 memos will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListMemos` call.
+	// Provide this to retrieve the subsequent page.
+	// The order_by must match the call that provided the page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The state of the memos to list.
+	// Default to `NORMAL`. Set to `ARCHIVED` to list archived memos, or to `DRAFT` to list the
+	// drafts of the current user.
+	State State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// Optional. The order to sort results by.
+	// Default to "display_time desc".
+	// Supports comma-separated list of fields following AIP-132.
+	// Example: "pinned desc, display_time desc" or "create_time asc"
+	// Supported fields: pinned, display_time, create_time, update_time, name
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. Filter to apply to the list results.
+	// Filter is a CEL expression to filter memos.
+	// Refer to `Shortcut.filter`.
+	// Memo locations are matched by `location_within_box(south, west, north, east)` and
+	// `location_within_radius(latitude, longitude, radius_km)`.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. If true, list the memos in the trash instead.
+	// Only the current user's memos are listed, in any state.
+	ShowDeleted bool `protobuf:"varint,6,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	// Optional. A full-text search query. Only the memos matching all of its words are listed,
+	// most relevant first, with their search_snippet set. Words match the content of a memo,
+	// code blocks included, or the filenames of its attachments.
+	// Words may also be operators: has:attachment, has:link, has:code, has:task,
+	// has:incomplete-task, is:pinned, is:archived, and before:YYYY-MM-DD and after:YYYY-MM-DD
+	// for the memos created before the date, or on or after it, in the time zone of the user,
+	// and tag:name for the memos tagged with the tag or one of its subtags, e.g. tag:project
+	// matches #project/alpha but not #projects.
+	// A leading minus negates an operator or a word.
+	// Example: "meeting notes has:attachment after:2024-03-01 -is:pinned"
+	Search string `protobuf:"bytes,7,opt,name=search,proto3" json:"search,omitempty"`
+	// Optional. If true, list the memos scheduled to be published instead.
+	// Only the current user's memos are listed.
+	ShowScheduled bool `protobuf:"varint,8,opt,name=show_scheduled,json=showScheduled,proto3" json:"show_scheduled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemosRequest) Reset() {
+	*x = ListMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosRequest) ProtoMessage() {}
+
+func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *ListMemosRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *ListMemosRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetShowScheduled() bool {
+	if x != nil {
+		return x.ShowScheduled
+	}
+	return false
+}
+
+type ListMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The names of the memos of the page matching the search through the filenames of their
+	// attachments, whose content doesn't match every search term.
+	// Format: memos/{memo}
+	AttachmentMatchedMemos []string `protobuf:"bytes,3,rep,name=attachment_matched_memos,json=attachmentMatchedMemos,proto3" json:"attachment_matched_memos,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListMemosResponse) Reset() {
+	*x = ListMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemosResponse) ProtoMessage() {}
+
+func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemosResponse) GetAttachmentMatchedMemos() []string {
+	if x != nil {
+		return x.AttachmentMatchedMemos
+	}
+	return nil
+}
+
+type GetMemoHighlightsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The month of the day to get the memos of, from 1 to 12, together with day.
+	// Defaults to today in the time zone of the user. The previous years are the years before the
+	// next occurrence of the day, so that clients can prefetch the highlights of tomorrow.
+	Month int32 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// Optional. The day of the month to get the memos of, from 1 to 31, together with month.
+	Day int32 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// Optional. The number of random memos older than 90 days, at most 20. Defaults to none.
+	RandomCount   int32 `protobuf:"varint,3,opt,name=random_count,json=randomCount,proto3" json:"random_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoHighlightsRequest) Reset() {
+	*x = GetMemoHighlightsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoHighlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoHighlightsRequest) ProtoMessage() {}
+
+func (x *GetMemoHighlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoHighlightsRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoHighlightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMemoHighlightsRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetMemoHighlightsRequest) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *GetMemoHighlightsRequest) GetRandomCount() int32 {
+	if x != nil {
+		return x.RandomCount
+	}
+	return 0
+}
+
+type GetMemoHighlightsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos created on the day in previous years, newest first.
+	// Archived memos, memos in the trash and comments are excluded.
+	OnThisDayMemos []*Memo `protobuf:"bytes,1,rep,name=on_this_day_memos,json=onThisDayMemos,proto3" json:"on_this_day_memos,omitempty"`
+	// The random memos older than 90 days, with the same exclusions.
+	RandomMemos   []*Memo `protobuf:"bytes,2,rep,name=random_memos,json=randomMemos,proto3" json:"random_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoHighlightsResponse) Reset() {
+	*x = GetMemoHighlightsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoHighlightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoHighlightsResponse) ProtoMessage() {}
+
+func (x *GetMemoHighlightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoHighlightsResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoHighlightsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMemoHighlightsResponse) GetOnThisDayMemos() []*Memo {
+	if x != nil {
+		return x.OnThisDayMemos
+	}
+	return nil
+}
+
+func (x *GetMemoHighlightsResponse) GetRandomMemos() []*Memo {
+	if x != nil {
+		return x.RandomMemos
+	}
+	return nil
+}
+
+type GetMemoCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoCountsRequest) Reset() {
+	*x = GetMemoCountsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoCountsRequest) ProtoMessage() {}
+
+func (x *GetMemoCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoCountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+}
+
+type GetMemoCountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that are published and not archived.
+	NormalCount int32 `protobuf:"varint,1,opt,name=normal_count,json=normalCount,proto3" json:"normal_count,omitempty"`
+	// The number of archived memos.
+	ArchivedCount int32 `protobuf:"varint,2,opt,name=archived_count,json=archivedCount,proto3" json:"archived_count,omitempty"`
+	// The number of drafts.
+	DraftCount    int32 `protobuf:"varint,3,opt,name=draft_count,json=draftCount,proto3" json:"draft_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoCountsResponse) Reset() {
+	*x = GetMemoCountsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoCountsResponse) ProtoMessage() {}
+
+func (x *GetMemoCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoCountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMemoCountsResponse) GetNormalCount() int32 {
+	if x != nil {
+		return x.NormalCount
+	}
+	return 0
+}
+
+func (x *GetMemoCountsResponse) GetArchivedCount() int32 {
+	if x != nil {
+		return x.ArchivedCount
+	}
+	return 0
+}
+
+func (x *GetMemoCountsResponse) GetDraftCount() int32 {
+	if x != nil {
+		return x.DraftCount
+	}
+	return 0
+}
+
+type PreviewAutoArchiveMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The number of days without edits of the previewed policy. If not set, the saved
+	// auto-archive setting of the user is previewed.
+	Days *int32 `protobuf:"varint,1,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	// Optional. The protected tags of the previewed policy, only used if days is set.
+	ProtectedTags []string `protobuf:"bytes,2,rep,name=protected_tags,json=protectedTags,proto3" json:"protected_tags,omitempty"`
+	// Optional. The maximum number of memos to return, least recently edited first.
+	// Defaults to 50, at most 1000.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewAutoArchiveMemosRequest) Reset() {
+	*x = PreviewAutoArchiveMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewAutoArchiveMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAutoArchiveMemosRequest) ProtoMessage() {}
+
+func (x *PreviewAutoArchiveMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAutoArchiveMemosRequest.ProtoReflect.Descriptor instead.
+func (*PreviewAutoArchiveMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PreviewAutoArchiveMemosRequest) GetDays() int32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+func (x *PreviewAutoArchiveMemosRequest) GetProtectedTags() []string {
+	if x != nil {
+		return x.ProtectedTags
+	}
+	return nil
+}
+
+func (x *PreviewAutoArchiveMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type PreviewAutoArchiveMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos that would be archived, least recently edited first.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// The number of memos that would be archived, including the ones not returned.
+	TotalSize     int32 `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewAutoArchiveMemosResponse) Reset() {
+	*x = PreviewAutoArchiveMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewAutoArchiveMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAutoArchiveMemosResponse) ProtoMessage() {}
+
+func (x *PreviewAutoArchiveMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAutoArchiveMemosResponse.ProtoReflect.Descriptor instead.
+func (*PreviewAutoArchiveMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PreviewAutoArchiveMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *PreviewAutoArchiveMemosResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo. The memo can also be named by its slug, or by a
+	// previous slug within its grace period, and the name of the memo returned is its canonical name.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRequest) Reset() {
+	*x = GetMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRequest) ProtoMessage() {}
+
+func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo to update.
+	// The `name` field is required.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoRequest) Reset() {
+	*x = UpdateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoRequest) ProtoMessage() {}
+
+func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateMemoRequest) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo to delete.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. If set to true, the memo will be deleted even if it has associated data.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoRequest) Reset() {
+	*x = DeleteMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoRequest) ProtoMessage() {}
+
+func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteMemoRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RestoreMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo to restore.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMemoRequest) Reset() {
+	*x = RestoreMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMemoRequest) ProtoMessage() {}
+
+func (x *RestoreMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMemoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestoreMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DuplicateMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo to duplicate.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Whether to keep the tags in the content. The tags are removed otherwise.
+	CopyTags bool `protobuf:"varint,2,opt,name=copy_tags,json=copyTags,proto3" json:"copy_tags,omitempty"`
+	// Optional. Whether to copy the references of the memo to other memos.
+	// The memo links in the content are always kept as references.
+	CopyRelations bool `protobuf:"varint,3,opt,name=copy_relations,json=copyRelations,proto3" json:"copy_relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateMemoRequest) Reset() {
+	*x = DuplicateMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMemoRequest) ProtoMessage() {}
+
+func (x *DuplicateMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMemoRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DuplicateMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DuplicateMemoRequest) GetCopyTags() bool {
+	if x != nil {
+		return x.CopyTags
+	}
+	return false
+}
+
+func (x *DuplicateMemoRequest) GetCopyRelations() bool {
+	if x != nil {
+		return x.CopyRelations
+	}
+	return false
+}
+
+type MergeMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the target memo, which the source memo is merged into.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The resource name of the source memo, which is moved to the trash.
+	// Format: memos/{memo}
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// Optional. The separator between the target content and the source content.
+	// Defaults to a blank line.
+	Separator     *string `protobuf:"bytes,3,opt,name=separator,proto3,oneof" json:"separator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeMemosRequest) Reset() {
+	*x = MergeMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMemosRequest) ProtoMessage() {}
+
+func (x *MergeMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMemosRequest.ProtoReflect.Descriptor instead.
+func (*MergeMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MergeMemosRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MergeMemosRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MergeMemosRequest) GetSeparator() string {
+	if x != nil && x.Separator != nil {
+		return *x.Separator
+	}
+	return ""
+}
+
+type CreateMemoFromWikiLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo with the wiki link.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The target of the missing wiki link, which is the title of the created memo.
+	Target        string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoFromWikiLinkRequest) Reset() {
+	*x = CreateMemoFromWikiLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoFromWikiLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoFromWikiLinkRequest) ProtoMessage() {}
+
+func (x *CreateMemoFromWikiLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoFromWikiLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoFromWikiLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateMemoFromWikiLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoFromWikiLinkRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type UpdateMemoTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo with the task.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The task to check or uncheck.
+	//
+	// Types that are valid to be assigned to Task:
+	//
+	//	*UpdateMemoTaskRequest_Index
+	//	*UpdateMemoTaskRequest_TaskId
+	Task isUpdateMemoTaskRequest_Task `protobuf_oneof:"task"`
+	// Whether the task is checked.
+	Checked bool `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	// Required. The etag of the memo the task was read from. If the content changed since, the
+	// request fails with FAILED_PRECONDITION.
+	Etag          string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoTaskRequest) Reset() {
+	*x = UpdateMemoTaskRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoTaskRequest) ProtoMessage() {}
+
+func (x *UpdateMemoTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateMemoTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMemoTaskRequest) GetTask() isUpdateMemoTaskRequest_Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateMemoTaskRequest) GetIndex() int32 {
+	if x != nil {
+		if x, ok := x.Task.(*UpdateMemoTaskRequest_Index); ok {
+			return x.Index
+		}
+	}
+	return 0
+}
+
+func (x *UpdateMemoTaskRequest) GetTaskId() string {
+	if x != nil {
+		if x, ok := x.Task.(*UpdateMemoTaskRequest_TaskId); ok {
+			return x.TaskId
+		}
+	}
+	return ""
+}
+
+func (x *UpdateMemoTaskRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *UpdateMemoTaskRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type isUpdateMemoTaskRequest_Task interface {
+	isUpdateMemoTaskRequest_Task()
+}
+
+type UpdateMemoTaskRequest_Index struct {
+	// The position of the task among the tasks of the memo, from 0.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3,oneof"`
+}
+
+type UpdateMemoTaskRequest_TaskId struct {
+	// The id of the task, which doesn't change when other tasks are added or removed.
+	TaskId string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3,oneof"`
+}
+
+func (*UpdateMemoTaskRequest_Index) isUpdateMemoTaskRequest_Task() {}
+
+func (*UpdateMemoTaskRequest_TaskId) isUpdateMemoTaskRequest_Task() {}
+
+type UpdateMemoTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated memo.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The tasks of the updated memo, in the order of the content.
+	Tasks         []*MemoTask `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoTaskResponse) Reset() {
+	*x = UpdateMemoTaskResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoTaskResponse) ProtoMessage() {}
+
+func (x *UpdateMemoTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemoTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateMemoTaskResponse) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *UpdateMemoTaskResponse) GetTasks() []*MemoTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// MemoTask is a task list item of the content of a memo, e.g. "- [ ] Buy milk".
+type MemoTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the task, computed from its text and the number of tasks with the same text before
+	// it, so that it doesn't change when the task is checked or when other tasks change.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The position of the task among the tasks of the memo, from 0.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// The markdown of the task after its checkbox, on a single line.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// Whether the task is checked.
+	Checked       bool `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoTask) Reset() {
+	*x = MemoTask{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoTask) ProtoMessage() {}
+
+func (x *MemoTask) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoTask.ProtoReflect.Descriptor instead.
+func (*MemoTask) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MemoTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoTask) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *MemoTask) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MemoTask) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+type MovePinnedMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the pinned memo to move.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. Where the memo is moved to.
+	//
+	// Types that are valid to be assigned to Destination:
+	//
+	//	*MovePinnedMemoRequest_MoveUp
+	//	*MovePinnedMemoRequest_MoveDown
+	//	*MovePinnedMemoRequest_Position
+	Destination   isMovePinnedMemoRequest_Destination `protobuf_oneof:"destination"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovePinnedMemoRequest) Reset() {
+	*x = MovePinnedMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovePinnedMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePinnedMemoRequest) ProtoMessage() {}
+
+func (x *MovePinnedMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePinnedMemoRequest.ProtoReflect.Descriptor instead.
+func (*MovePinnedMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MovePinnedMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MovePinnedMemoRequest) GetDestination() isMovePinnedMemoRequest_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *MovePinnedMemoRequest) GetMoveUp() bool {
+	if x != nil {
+		if x, ok := x.Destination.(*MovePinnedMemoRequest_MoveUp); ok {
+			return x.MoveUp
+		}
+	}
+	return false
+}
+
+func (x *MovePinnedMemoRequest) GetMoveDown() bool {
+	if x != nil {
+		if x, ok := x.Destination.(*MovePinnedMemoRequest_MoveDown); ok {
+			return x.MoveDown
+		}
+	}
+	return false
+}
+
+func (x *MovePinnedMemoRequest) GetPosition() int32 {
+	if x != nil {
+		if x, ok := x.Destination.(*MovePinnedMemoRequest_Position); ok {
+			return x.Position
+		}
+	}
+	return 0
+}
+
+type isMovePinnedMemoRequest_Destination interface {
+	isMovePinnedMemoRequest_Destination()
+}
+
+type MovePinnedMemoRequest_MoveUp struct {
+	// Moves the memo up by one position, towards the top.
+	MoveUp bool `protobuf:"varint,2,opt,name=move_up,json=moveUp,proto3,oneof"`
+}
+
+type MovePinnedMemoRequest_MoveDown struct {
+	// Moves the memo down by one position.
+	MoveDown bool `protobuf:"varint,3,opt,name=move_down,json=moveDown,proto3,oneof"`
+}
+
+type MovePinnedMemoRequest_Position struct {
+	// Moves the memo to a 1-based position, from 1 at the top. Positions after the last pinned
+	// memo move it to the bottom.
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3,oneof"`
+}
+
+func (*MovePinnedMemoRequest_MoveUp) isMovePinnedMemoRequest_Destination() {}
+
+func (*MovePinnedMemoRequest_MoveDown) isMovePinnedMemoRequest_Destination() {}
+
+func (*MovePinnedMemoRequest_Position) isMovePinnedMemoRequest_Destination() {}
+
+type PurgeMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo to purge.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeMemoRequest) Reset() {
+	*x = PurgeMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeMemoRequest) ProtoMessage() {}
+
+func (x *PurgeMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeMemoRequest.ProtoReflect.Descriptor instead.
+func (*PurgeMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PurgeMemoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MemoRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the user who made the revision.
+	// Format: users/{user}
+	Editor string `protobuf:"bytes,2,opt,name=editor,proto3" json:"editor,omitempty"`
+	// When the revision was made.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The content of the memo at the revision. Only set in GetMemoRevision.
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// The attachments of the memo at the revision, with their name, filename and type only.
+	// They may since have been deleted.
+	Attachments []*Attachment `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// The unified diff of the content against the previous revision, without file headers.
+	// Only set in ListMemoRevisions.
+	Diff          string `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevision) Reset() {
+	*x = MemoRevision{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevision) ProtoMessage() {}
+
+func (x *MemoRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevision.ProtoReflect.Descriptor instead.
+func (*MemoRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MemoRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *MemoRevision) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type ListMemoRevisionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of revisions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsRequest) Reset() {
+	*x = ListMemoRevisionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsRequest) ProtoMessage() {}
+
+func (x *ListMemoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMemoRevisionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListMemoRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The revisions of the memo, newest first.
+	Revisions []*MemoRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsResponse) Reset() {
+	*x = ListMemoRevisionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsResponse) ProtoMessage() {}
+
+func (x *ListMemoRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMemoRevisionsResponse) GetRevisions() []*MemoRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListMemoRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRevisionRequest) Reset() {
+	*x = GetMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRevisionRequest) ProtoMessage() {}
+
+func (x *GetMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMemoRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the revision to restore.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMemoRevisionRequest) Reset() {
+	*x = RestoreMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMemoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMemoRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestoreMemoRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MemoShareLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the share link.
+	// Format: memos/{memo}/shareLinks/{share_link}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The random slug of the link, which GetSharedMemo gets the memo with.
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	// When the link was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Optional. When the link stops working. If not set, the link doesn't expire.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Optional. The number of views after which the link stops working. 0 for no limit.
+	MaxViews int32 `protobuf:"varint,5,opt,name=max_views,json=maxViews,proto3" json:"max_views,omitempty"`
+	// The number of times the memo was viewed through the link.
+	ViewCount int32 `protobuf:"varint,6,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// Optional. The passphrase to enter to view the memo. Empty for no passphrase.
+	Passphrase string `protobuf:"bytes,7,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Whether the link has a passphrase.
+	HasPassphrase bool `protobuf:"varint,8,opt,name=has_passphrase,json=hasPassphrase,proto3" json:"has_passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoShareLink) Reset() {
+	*x = MemoShareLink{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoShareLink) ProtoMessage() {}
+
+func (x *MemoShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoShareLink.ProtoReflect.Descriptor instead.
+func (*MemoShareLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MemoShareLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoShareLink) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *MemoShareLink) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoShareLink) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *MemoShareLink) GetMaxViews() int32 {
+	if x != nil {
+		return x.MaxViews
+	}
+	return 0
+}
+
+func (x *MemoShareLink) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *MemoShareLink) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *MemoShareLink) GetHasPassphrase() bool {
+	if x != nil {
+		return x.HasPassphrase
+	}
+	return false
+}
+
+type CreateMemoShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo to share.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The expiry and the view limit of the link.
+	ShareLink     *MemoShareLink `protobuf:"bytes,2,opt,name=share_link,json=shareLink,proto3" json:"share_link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoShareLinkRequest) Reset() {
+	*x = CreateMemoShareLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateMemoShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateMemoShareLinkRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoShareLinkRequest) GetShareLink() *MemoShareLink {
+	if x != nil {
+		return x.ShareLink
+	}
+	return nil
+}
+
+type ListMemoShareLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoShareLinksRequest) Reset() {
+	*x = ListMemoShareLinksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoShareLinksRequest) ProtoMessage() {}
+
+func (x *ListMemoShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMemoShareLinksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoShareLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share links of the memo, including those that expired or ran out of views.
+	ShareLinks    []*MemoShareLink `protobuf:"bytes,1,rep,name=share_links,json=shareLinks,proto3" json:"share_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoShareLinksResponse) Reset() {
+	*x = ListMemoShareLinksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoShareLinksResponse) ProtoMessage() {}
+
+func (x *ListMemoShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListMemoShareLinksResponse) GetShareLinks() []*MemoShareLink {
+	if x != nil {
+		return x.ShareLinks
+	}
+	return nil
+}
+
+type UpdateMemoShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The share link to update.
+	// The `name` field is required.
+	ShareLink *MemoShareLink `protobuf:"bytes,1,opt,name=share_link,json=shareLink,proto3" json:"share_link,omitempty"`
+	// Required. The list of fields to update. Only `passphrase` is supported.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemoShareLinkRequest) Reset() {
+	*x = UpdateMemoShareLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemoShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemoShareLinkRequest) ProtoMessage() {}
+
+func (x *UpdateMemoShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemoShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateMemoShareLinkRequest) GetShareLink() *MemoShareLink {
+	if x != nil {
+		return x.ShareLink
+	}
+	return nil
+}
+
+func (x *UpdateMemoShareLinkRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteMemoShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the share link to revoke.
+	// Format: memos/{memo}/shareLinks/{share_link}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoShareLinkRequest) Reset() {
+	*x = DeleteMemoShareLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoShareLinkRequest) ProtoMessage() {}
+
+func (x *DeleteMemoShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteMemoShareLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSharedMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The slug of the share link.
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Optional. The access token from UnlockSharedMemo, for links with a passphrase.
+	AccessToken   string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedMemoRequest) Reset() {
+	*x = GetSharedMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedMemoRequest) ProtoMessage() {}
+
+func (x *GetSharedMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSharedMemoRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetSharedMemoRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type UnlockSharedMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The slug of the share link.
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Required. The passphrase of the share link.
+	Passphrase    string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockSharedMemoRequest) Reset() {
+	*x = UnlockSharedMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockSharedMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockSharedMemoRequest) ProtoMessage() {}
+
+func (x *UnlockSharedMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockSharedMemoRequest.ProtoReflect.Descriptor instead.
+func (*UnlockSharedMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnlockSharedMemoRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UnlockSharedMemoRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type UnlockSharedMemoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token to pass to GetSharedMemo, and as the `token` query parameter of the attachment
+	// files of the shared memo.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// When the access token expires.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockSharedMemoResponse) Reset() {
+	*x = UnlockSharedMemoResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockSharedMemoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockSharedMemoResponse) ProtoMessage() {}
+
+func (x *UnlockSharedMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockSharedMemoResponse.ProtoReflect.Descriptor instead.
+func (*UnlockSharedMemoResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnlockSharedMemoResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *UnlockSharedMemoResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type SnoozeMemoReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The new reminder time, in the future. A repeating reminder repeats from it.
+	RemindTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_time,json=remindTime,proto3" json:"remind_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeMemoReminderRequest) Reset() {
+	*x = SnoozeMemoReminderRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeMemoReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeMemoReminderRequest) ProtoMessage() {}
+
+func (x *SnoozeMemoReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeMemoReminderRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeMemoReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SnoozeMemoReminderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnoozeMemoReminderRequest) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
+type CompleteMemoReminderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMemoReminderRequest) Reset() {
+	*x = CompleteMemoReminderRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMemoReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMemoReminderRequest) ProtoMessage() {}
+
+func (x *CompleteMemoReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMemoReminderRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMemoReminderRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CompleteMemoReminderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTagTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The creator of the memos, e.g. "users/1". Defaults to the current user.
+	// Only the memos of the creator visible to the current user are counted.
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagTreeRequest) Reset() {
+	*x = ListTagTreeRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagTreeRequest) ProtoMessage() {}
+
+func (x *ListTagTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagTreeRequest.ProtoReflect.Descriptor instead.
+func (*ListTagTreeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListTagTreeRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+type ListTagTreeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The top-level tags, sorted by tag.
+	Tags          []*TagTreeNode `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagTreeResponse) Reset() {
+	*x = ListTagTreeResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagTreeResponse) ProtoMessage() {}
+
+func (x *ListTagTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagTreeResponse.ProtoReflect.Descriptor instead.
+func (*ListTagTreeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListTagTreeResponse) GetTags() []*TagTreeNode {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// TagTreeNode is a tag of a tag tree, e.g. "project/alpha", with its subtags.
+type TagTreeNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag, without the # prefix, e.g. "project/alpha".
+	// Tags differing only by case or accents are the same tag, written as in the first memo found.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The last segment of the tag, e.g. "alpha".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of memos tagged with the tag or one of its subtags, as listed by the filter
+	// `tag in ["project/alpha"]`. Archived memos and comments aren't counted.
+	MemoCount int32 `protobuf:"varint,3,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The subtags one level below the tag, sorted by tag.
+	Children      []*TagTreeNode `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagTreeNode) Reset() {
+	*x = TagTreeNode{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTreeNode) ProtoMessage() {}
+
+func (x *TagTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTreeNode.ProtoReflect.Descriptor instead.
+func (*TagTreeNode) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TagTreeNode) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagTreeNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagTreeNode) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *TagTreeNode) GetChildren() []*TagTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type RenameMemoTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tag to rename, without the # prefix. Its subtags are renamed too,
+	// e.g. renaming "work" to "job" renames "work/project" to "job/project".
+	OldTag string `protobuf:"bytes,1,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	// Required. The new name of the tag, without the # prefix.
+	NewTag string `protobuf:"bytes,2,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// Optional. If set, only returns the memos that would be changed, without changing them.
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameMemoTagRequest) Reset() {
+	*x = RenameMemoTagRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameMemoTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameMemoTagRequest) ProtoMessage() {}
+
+func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RenameMemoTagRequest) GetOldTag() string {
+	if x != nil {
+		return x.OldTag
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+func (x *RenameMemoTagRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RenameMemoTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource names of the changed memos, or the memos that would be changed
+	// if validate_only is set.
+	// Format: memos/{memo}
+	Memos []string `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// The number of changed memos.
+	MemoCount     int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameMemoTagResponse) Reset() {
+	*x = RenameMemoTagResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameMemoTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameMemoTagResponse) ProtoMessage() {}
+
+func (x *RenameMemoTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameMemoTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RenameMemoTagResponse) GetMemos() []string {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *RenameMemoTagResponse) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+type BatchUpdateMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource names of the memos to update, at most 100.
+	// Format: memos/{memo}
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// Required. The operation applied to each memo.
+	//
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*BatchUpdateMemosRequest_SetVisibility
+	//	*BatchUpdateMemosRequest_SetState
+	//	*BatchUpdateMemosRequest_AddTag
+	//	*BatchUpdateMemosRequest_RemoveTag
+	//	*BatchUpdateMemosRequest_MoveToTrash
+	Operation     isBatchUpdateMemosRequest_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosRequest) Reset() {
+	*x = BatchUpdateMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosRequest) ProtoMessage() {}
+
+func (x *BatchUpdateMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchUpdateMemosRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchUpdateMemosRequest) GetOperation() isBatchUpdateMemosRequest_Operation {
+	if x != nil {
+		return x.Operation
 	}
 	return nil
 }
 
-func (x *ListMemosResponse) GetNextPageToken() string {
+func (x *BatchUpdateMemosRequest) GetSetVisibility() Visibility {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchUpdateMemosRequest_SetVisibility); ok {
+			return x.SetVisibility
+		}
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *BatchUpdateMemosRequest) GetSetState() State {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchUpdateMemosRequest_SetState); ok {
+			return x.SetState
+		}
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *BatchUpdateMemosRequest) GetAddTag() string {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchUpdateMemosRequest_AddTag); ok {
+			return x.AddTag
+		}
+	}
+	return ""
+}
+
+func (x *BatchUpdateMemosRequest) GetRemoveTag() string {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchUpdateMemosRequest_RemoveTag); ok {
+			return x.RemoveTag
+		}
+	}
+	return ""
+}
+
+func (x *BatchUpdateMemosRequest) GetMoveToTrash() bool {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchUpdateMemosRequest_MoveToTrash); ok {
+			return x.MoveToTrash
+		}
+	}
+	return false
+}
+
+type isBatchUpdateMemosRequest_Operation interface {
+	isBatchUpdateMemosRequest_Operation()
+}
+
+type BatchUpdateMemosRequest_SetVisibility struct {
+	// Sets the visibility of the memos.
+	SetVisibility Visibility `protobuf:"varint,2,opt,name=set_visibility,json=setVisibility,proto3,enum=memos.api.v1.Visibility,oneof"`
+}
+
+type BatchUpdateMemosRequest_SetState struct {
+	// Archives the memos with ARCHIVED, or unarchives them with NORMAL.
+	SetState State `protobuf:"varint,3,opt,name=set_state,json=setState,proto3,enum=memos.api.v1.State,oneof"`
+}
+
+type BatchUpdateMemosRequest_AddTag struct {
+	// Adds a tag, without the # prefix, at the end of the memos that don't have it.
+	AddTag string `protobuf:"bytes,4,opt,name=add_tag,json=addTag,proto3,oneof"`
+}
+
+type BatchUpdateMemosRequest_RemoveTag struct {
+	// Removes a tag, without the # prefix, from the memos. Its subtags are kept.
+	RemoveTag string `protobuf:"bytes,5,opt,name=remove_tag,json=removeTag,proto3,oneof"`
+}
+
+type BatchUpdateMemosRequest_MoveToTrash struct {
+	// Moves the memos to the trash, which webhooks receive as trashed events.
+	MoveToTrash bool `protobuf:"varint,6,opt,name=move_to_trash,json=moveToTrash,proto3,oneof"`
+}
+
+func (*BatchUpdateMemosRequest_SetVisibility) isBatchUpdateMemosRequest_Operation() {}
+
+func (*BatchUpdateMemosRequest_SetState) isBatchUpdateMemosRequest_Operation() {}
+
+func (*BatchUpdateMemosRequest_AddTag) isBatchUpdateMemosRequest_Operation() {}
+
+func (*BatchUpdateMemosRequest_RemoveTag) isBatchUpdateMemosRequest_Operation() {}
+
+func (*BatchUpdateMemosRequest_MoveToTrash) isBatchUpdateMemosRequest_Operation() {}
+
+type BatchUpdateMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos the operation was applied to, including the memos it didn't change.
+	SucceededCount int32 `protobuf:"varint,1,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	// The number of memos the operation wasn't applied to.
+	FailedCount int32 `protobuf:"varint,2,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// The memos the operation wasn't applied to, in the order of the request.
+	Failures      []*BatchUpdateMemosResponse_Failure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosResponse) Reset() {
+	*x = BatchUpdateMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosResponse) ProtoMessage() {}
+
+func (x *BatchUpdateMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchUpdateMemosResponse) GetSucceededCount() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.SucceededCount
 	}
-	return ""
+	return 0
 }
 
-type GetMemoRequest struct {
+func (x *BatchUpdateMemosResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BatchUpdateMemosResponse) GetFailures() []*BatchUpdateMemosResponse_Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type ImportMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The zip archive of markdown files. The front matter of the files may set the
+	// created, updated, tags, visibility and pinned fields of their memos, as in exported memos.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. The visibility of the memos without one in their front matter.
+	// Defaults to PRIVATE.
+	Visibility Visibility `protobuf:"varint,2,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// Optional. The format of the archive.
+	// Defaults to MARKDOWN.
+	Format        ImportMemosRequest_Format `protobuf:"varint,3,opt,name=format,proto3,enum=memos.api.v1.ImportMemosRequest_Format" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoRequest) Reset() {
-	*x = GetMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *ImportMemosRequest) Reset() {
+	*x = ImportMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoRequest) String() string {
+func (x *ImportMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoRequest) ProtoMessage() {}
+func (*ImportMemosRequest) ProtoMessage() {}
 
-func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *ImportMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,44 +3870,56 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ImportMemosRequest.ProtoReflect.Descriptor instead.
+func (*ImportMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetMemoRequest) GetName() string {
+func (x *ImportMemosRequest) GetContent() []byte {
 	if x != nil {
-		return x.Name
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-type UpdateMemoRequest struct {
+func (x *ImportMemosRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ImportMemosRequest) GetFormat() ImportMemosRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ImportMemosRequest_FORMAT_UNSPECIFIED
+}
+
+type GetMemoImportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The memo to update.
-	// The `name` field is required.
-	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// Required. The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Required. The resource name of the import.
+	// Format: memoImports/{memo_import}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemoRequest) Reset() {
-	*x = UpdateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *GetMemoImportRequest) Reset() {
+	*x = GetMemoImportRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemoRequest) String() string {
+func (x *GetMemoImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemoRequest) ProtoMessage() {}
+func (*GetMemoImportRequest) ProtoMessage() {}
 
-func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *GetMemoImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,51 +3930,60 @@ func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpdateMemoRequest) GetMemo() *Memo {
-	if x != nil {
-		return x.Memo
-	}
-	return nil
+// Deprecated: Use GetMemoImportRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoImportRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetMemoImportRequest) GetName() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DeleteMemoRequest struct {
+type MemoImport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo to delete.
-	// Format: memos/{memo}
+	// The resource name of the import.
+	// Format: memoImports/{memo_import}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. If set to true, the memo will be deleted even if it has associated data.
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// The state of the import.
+	State MemoImport_State `protobuf:"varint,2,opt,name=state,proto3,enum=memos.api.v1.MemoImport_State" json:"state,omitempty"`
+	// The number of markdown files, or Google Keep notes, in the archive. For Day One exports,
+	// the number of journals until they are read, and then the number of their entries.
+	TotalFiles int32 `protobuf:"varint,3,opt,name=total_files,json=totalFiles,proto3" json:"total_files,omitempty"`
+	// The number of files imported, skipped or failed so far.
+	ProcessedFiles int32 `protobuf:"varint,4,opt,name=processed_files,json=processedFiles,proto3" json:"processed_files,omitempty"`
+	// The number of memos created.
+	CreatedMemos int32 `protobuf:"varint,5,opt,name=created_memos,json=createdMemos,proto3" json:"created_memos,omitempty"`
+	// The number of files skipped because their content was already imported.
+	SkippedFiles int32 `protobuf:"varint,6,opt,name=skipped_files,json=skippedFiles,proto3" json:"skipped_files,omitempty"`
+	// The files that failed to import. They don't abort the import of the other files. The
+	// entries of Day One journals are reported as {journal file}#{entry uuid}.
+	Errors []*MemoImport_FileError `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	// When the import was started.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// When the import finished, if it did.
+	FinishTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoRequest) Reset() {
-	*x = DeleteMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+func (x *MemoImport) Reset() {
+	*x = MemoImport{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoRequest) String() string {
+func (x *MemoImport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoRequest) ProtoMessage() {}
+func (*MemoImport) ProtoMessage() {}
 
-func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+func (x *MemoImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,23 +3994,72 @@ func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MemoImport.ProtoReflect.Descriptor instead.
+func (*MemoImport) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DeleteMemoRequest) GetName() string {
+func (x *MemoImport) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeleteMemoRequest) GetForce() bool {
+func (x *MemoImport) GetState() MemoImport_State {
 	if x != nil {
-		return x.Force
+		return x.State
 	}
-	return false
+	return MemoImport_STATE_UNSPECIFIED
+}
+
+func (x *MemoImport) GetTotalFiles() int32 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *MemoImport) GetProcessedFiles() int32 {
+	if x != nil {
+		return x.ProcessedFiles
+	}
+	return 0
+}
+
+func (x *MemoImport) GetCreatedMemos() int32 {
+	if x != nil {
+		return x.CreatedMemos
+	}
+	return 0
+}
+
+func (x *MemoImport) GetSkippedFiles() int32 {
+	if x != nil {
+		return x.SkippedFiles
+	}
+	return 0
+}
+
+func (x *MemoImport) GetErrors() []*MemoImport_FileError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *MemoImport) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoImport) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
 }
 
 type SetMemoAttachmentsRequest struct {
@@ -847,7 +4075,7 @@ type SetMemoAttachmentsRequest struct {
 
 func (x *SetMemoAttachmentsRequest) Reset() {
 	*x = SetMemoAttachmentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +4087,7 @@ func (x *SetMemoAttachmentsRequest) String() string {
 func (*SetMemoAttachmentsRequest) ProtoMessage() {}
 
 func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +4100,7 @@ func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
 func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *SetMemoAttachmentsRequest) GetName() string {
@@ -904,7 +4132,7 @@ type ListMemoAttachmentsRequest struct {
 
 func (x *ListMemoAttachmentsRequest) Reset() {
 	*x = ListMemoAttachmentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -916,7 +4144,7 @@ func (x *ListMemoAttachmentsRequest) String() string {
 func (*ListMemoAttachmentsRequest) ProtoMessage() {}
 
 func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +4157,7 @@ func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
 func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ListMemoAttachmentsRequest) GetName() string {
@@ -965,7 +4193,7 @@ type ListMemoAttachmentsResponse struct {
 
 func (x *ListMemoAttachmentsResponse) Reset() {
 	*x = ListMemoAttachmentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -977,7 +4205,7 @@ func (x *ListMemoAttachmentsResponse) String() string {
 func (*ListMemoAttachmentsResponse) ProtoMessage() {}
 
 func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +4218,7 @@ func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
 func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
@@ -1020,7 +4248,7 @@ type MemoRelation struct {
 
 func (x *MemoRelation) Reset() {
 	*x = MemoRelation{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1032,7 +4260,7 @@ func (x *MemoRelation) String() string {
 func (*MemoRelation) ProtoMessage() {}
 
 func (x *MemoRelation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,7 +4273,7 @@ func (x *MemoRelation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
 func (*MemoRelation) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
@@ -1062,39 +4290,157 @@ func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
 	return nil
 }
 
-func (x *MemoRelation) GetType() MemoRelation_Type {
+func (x *MemoRelation) GetType() MemoRelation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemoRelation_TYPE_UNSPECIFIED
+}
+
+type SetMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The relations to set for the memo.
+	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemoRelationsRequest) Reset() {
+	*x = SetMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoRelationsRequest) ProtoMessage() {}
+
+func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type ListMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of relations to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsRequest) Reset() {
+	*x = ListMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsRequest) ProtoMessage() {}
+
+func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRelationsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Type
+		return x.PageToken
 	}
-	return MemoRelation_TYPE_UNSPECIFIED
+	return ""
 }
 
-type SetMemoRelationsRequest struct {
+type ListMemoRelationsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The relations to set for the memo.
-	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// The list of relations.
+	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoRelationsRequest) Reset() {
-	*x = SetMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *ListMemoRelationsResponse) Reset() {
+	*x = ListMemoRelationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoRelationsRequest) String() string {
+func (x *ListMemoRelationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoRelationsResponse) ProtoMessage() {}
 
-func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,31 +4451,31 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SetMemoRelationsRequest) GetName() string {
+func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
 	if x != nil {
-		return x.Name
+		return x.Relations
 	}
-	return ""
+	return nil
 }
 
-func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+func (x *ListMemoRelationsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Relations
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type ListMemoRelationsRequest struct {
+type ListMemoBacklinksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the memo.
 	// Format: memos/{memo}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of relations to return.
+	// Optional. The maximum number of memos to return.
 	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// Optional. A page token for pagination.
 	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
@@ -1137,21 +4483,21 @@ type ListMemoRelationsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsRequest) Reset() {
-	*x = ListMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *ListMemoBacklinksRequest) Reset() {
+	*x = ListMemoBacklinksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsRequest) String() string {
+func (x *ListMemoBacklinksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoBacklinksRequest) ProtoMessage() {}
 
-func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+func (x *ListMemoBacklinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,57 +4508,58 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListMemoBacklinksRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListMemoRelationsRequest) GetName() string {
+func (x *ListMemoBacklinksRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+func (x *ListMemoBacklinksRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListMemoRelationsRequest) GetPageToken() string {
+func (x *ListMemoBacklinksRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListMemoRelationsResponse struct {
+type ListMemoBacklinksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of relations.
-	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	// The memos referencing the memo that are visible to the caller, newest first,
+	// with their snippets.
+	Memos []*MemoRelation_Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
 	// A token for the next page of results.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsResponse) Reset() {
-	*x = ListMemoRelationsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *ListMemoBacklinksResponse) Reset() {
+	*x = ListMemoBacklinksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsResponse) String() string {
+func (x *ListMemoBacklinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsResponse) ProtoMessage() {}
+func (*ListMemoBacklinksResponse) ProtoMessage() {}
 
-func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+func (x *ListMemoBacklinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,19 +4570,19 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListMemoBacklinksResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoBacklinksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+func (x *ListMemoBacklinksResponse) GetMemos() []*MemoRelation_Memo {
 	if x != nil {
-		return x.Relations
+		return x.Memos
 	}
 	return nil
 }
 
-func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+func (x *ListMemoBacklinksResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
@@ -1257,7 +4604,7 @@ type CreateMemoCommentRequest struct {
 
 func (x *CreateMemoCommentRequest) Reset() {
 	*x = CreateMemoCommentRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1269,7 +4616,7 @@ func (x *CreateMemoCommentRequest) String() string {
 func (*CreateMemoCommentRequest) ProtoMessage() {}
 
 func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	mi := &file_api_v1_memo_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,7 +4629,7 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
 func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateMemoCommentRequest) GetName() string {
@@ -1323,7 +4670,7 @@ type ListMemoCommentsRequest struct {
 
 func (x *ListMemoCommentsRequest) Reset() {
 	*x = ListMemoCommentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1335,7 +4682,7 @@ func (x *ListMemoCommentsRequest) String() string {
 func (*ListMemoCommentsRequest) ProtoMessage() {}
 
 func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+	mi := &file_api_v1_memo_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +4695,7 @@ func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ListMemoCommentsRequest) GetName() string {
@@ -1393,7 +4740,7 @@ type ListMemoCommentsResponse struct {
 
 func (x *ListMemoCommentsResponse) Reset() {
 	*x = ListMemoCommentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +4752,7 @@ func (x *ListMemoCommentsResponse) String() string {
 func (*ListMemoCommentsResponse) ProtoMessage() {}
 
 func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+	mi := &file_api_v1_memo_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +4765,7 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
@@ -1457,7 +4804,7 @@ type ListMemoReactionsRequest struct {
 
 func (x *ListMemoReactionsRequest) Reset() {
 	*x = ListMemoReactionsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1469,7 +4816,7 @@ func (x *ListMemoReactionsRequest) String() string {
 func (*ListMemoReactionsRequest) ProtoMessage() {}
 
 func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+	mi := &file_api_v1_memo_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,7 +4829,7 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
 func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ListMemoReactionsRequest) GetName() string {
@@ -1520,7 +4867,7 @@ type ListMemoReactionsResponse struct {
 
 func (x *ListMemoReactionsResponse) Reset() {
 	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1532,7 +4879,7 @@ func (x *ListMemoReactionsResponse) String() string {
 func (*ListMemoReactionsResponse) ProtoMessage() {}
 
 func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	mi := &file_api_v1_memo_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +4892,7 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
 func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
@@ -1582,7 +4929,7 @@ type UpsertMemoReactionRequest struct {
 
 func (x *UpsertMemoReactionRequest) Reset() {
 	*x = UpsertMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	mi := &file_api_v1_memo_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1594,7 +4941,7 @@ func (x *UpsertMemoReactionRequest) String() string {
 func (*UpsertMemoReactionRequest) ProtoMessage() {}
 
 func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+	mi := &file_api_v1_memo_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +4954,7 @@ func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
 func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpsertMemoReactionRequest) GetName() string {
@@ -1635,7 +4982,7 @@ type DeleteMemoReactionRequest struct {
 
 func (x *DeleteMemoReactionRequest) Reset() {
 	*x = DeleteMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	mi := &file_api_v1_memo_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1647,7 +4994,7 @@ func (x *DeleteMemoReactionRequest) String() string {
 func (*DeleteMemoReactionRequest) ProtoMessage() {}
 
 func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+	mi := &file_api_v1_memo_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +5007,7 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *DeleteMemoReactionRequest) GetName() string {
@@ -1683,7 +5030,7 @@ type Memo_Property struct {
 
 func (x *Memo_Property) Reset() {
 	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	mi := &file_api_v1_memo_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1695,7 +5042,7 @@ func (x *Memo_Property) String() string {
 func (*Memo_Property) ProtoMessage() {}
 
 func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+	mi := &file_api_v1_memo_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,6 +5086,169 @@ func (x *Memo_Property) GetHasIncompleteTasks() bool {
 	return false
 }
 
+type Memo_WikiLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target of the link, between the brackets and before the "|" of a label.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The resource name of the linked memo, empty if the link is missing.
+	// Format: memos/{memo}
+	Memo          string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Memo_WikiLink) Reset() {
+	*x = Memo_WikiLink{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memo_WikiLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo_WikiLink) ProtoMessage() {}
+
+func (x *Memo_WikiLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo_WikiLink.ProtoReflect.Descriptor instead.
+func (*Memo_WikiLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Memo_WikiLink) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Memo_WikiLink) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type BatchUpdateMemosResponse_Failure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo, as in the request.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The reason of the failure, e.g. "permission denied" or "memo not found".
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMemosResponse_Failure) Reset() {
+	*x = BatchUpdateMemosResponse_Failure{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMemosResponse_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMemosResponse_Failure) ProtoMessage() {}
+
+func (x *BatchUpdateMemosResponse_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMemosResponse_Failure.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMemosResponse_Failure) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *BatchUpdateMemosResponse_Failure) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchUpdateMemosResponse_Failure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MemoImport_FileError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the file in the archive.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// The reason of the failure, e.g. "content too long".
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoImport_FileError) Reset() {
+	*x = MemoImport_FileError{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoImport_FileError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoImport_FileError) ProtoMessage() {}
+
+func (x *MemoImport_FileError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoImport_FileError.ProtoReflect.Descriptor instead.
+func (*MemoImport_FileError) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *MemoImport_FileError) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MemoImport_FileError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // Memo reference in relations.
 type MemoRelation_Memo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1753,7 +5263,7 @@ type MemoRelation_Memo struct {
 
 func (x *MemoRelation_Memo) Reset() {
 	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	mi := &file_api_v1_memo_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1765,7 +5275,7 @@ func (x *MemoRelation_Memo) String() string {
 func (*MemoRelation_Memo) ProtoMessage() {}
 
 func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+	mi := &file_api_v1_memo_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +5288,7 @@ func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
 func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{57, 0}
 }
 
 func (x *MemoRelation_Memo) GetName() string {
@@ -1810,7 +5320,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rreaction_type\x18\x04 \x01(\tB\x03\xe0A\x02R\freactionType\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime:K\xeaAH\n" +
-	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xd8\b\n" +
+	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xb9\r\n" +
 	"\x04Memo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12.\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x02R\x05state\x123\n" +
@@ -1835,22 +5345,66 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x10 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoH\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x11 \x01(\tB\x03\xe0A\x03R\asnippet\x12<\n" +
-	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x1a\x96\x01\n" +
+	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x12@\n" +
+	"\vdelete_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"deleteTime\x12*\n" +
+	"\x0esearch_snippet\x18\x14 \x01(\tB\x03\xe0A\x03R\rsearchSnippet\x12B\n" +
+	"\fpublish_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\vpublishTime\x12;\n" +
+	"\breminder\x18\x16 \x01(\v2\x1a.memos.api.v1.MemoReminderB\x03\xe0A\x01R\breminder\x12.\n" +
+	"\x10disable_comments\x18\x17 \x01(\bB\x03\xe0A\x01R\x0fdisableComments\x12@\n" +
+	"\vexpire_time\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\x12;\n" +
+	"\bbookmark\x18\x19 \x01(\v2\x1a.memos.api.v1.MemoBookmarkB\x03\xe0A\x03R\bbookmark\x12\x17\n" +
+	"\x04slug\x18\x1a \x01(\tB\x03\xe0A\x01R\x04slug\x12?\n" +
+	"\n" +
+	"wiki_links\x18\x1b \x03(\v2\x1b.memos.api.v1.Memo.WikiLinkB\x03\xe0A\x03R\twikiLinks\x12\x17\n" +
+	"\x04etag\x18\x1c \x01(\tB\x03\xe0A\x03R\x04etag\x1a\x96\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks:7\xeaA4\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1aN\n" +
+	"\bWikiLink\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12*\n" +
+	"\x04memo\x18\x02 \x01(\tB\x16\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04memo:7\xeaA4\n" +
 	"\x11memos.api.v1/Memo\x12\fmemos/{memo}\x1a\x04name*\x05memos2\x04memoB\t\n" +
 	"\a_parentB\v\n" +
-	"\t_location\"u\n" +
+	"\t_location\"\x8d\x02\n" +
+	"\fMemoReminder\x12@\n" +
+	"\vremind_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\n" +
+	"remindTime\x12>\n" +
+	"\x06repeat\x18\x02 \x01(\x0e2!.memos.api.v1.MemoReminder.RepeatB\x03\xe0A\x01R\x06repeat\x12B\n" +
+	"\fdeliver_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vdeliverTime\"7\n" +
+	"\x06Repeat\x12\x16\n" +
+	"\x12REPEAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02\"n\n" +
+	"\fMemoBookmark\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\"\x9f\x01\n" +
 	"\bLocation\x12%\n" +
 	"\vplaceholder\x18\x01 \x01(\tB\x03\xe0A\x01R\vplaceholder\x12\x1f\n" +
 	"\blatitude\x18\x02 \x01(\x01B\x03\xe0A\x01R\blatitude\x12!\n" +
-	"\tlongitude\x18\x03 \x01(\x01B\x03\xe0A\x01R\tlongitude\"^\n" +
+	"\tlongitude\x18\x03 \x01(\x01B\x03\xe0A\x01R\tlongitude\x12(\n" +
+	"\rshow_publicly\x18\x04 \x01(\bB\x03\xe0A\x01R\fshowPublicly\"^\n" +
 	"\x11CreateMemoRequest\x12+\n" +
 	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoB\x03\xe0A\x02R\x04memo\x12\x1c\n" +
-	"\amemo_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06memoId\"\xed\x01\n" +
+	"\amemo_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06memoId\"\xa9\x01\n" +
+	"\x19CreateBookmarkMemoRequest\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12\x1d\n" +
+	"\acomment\x18\x02 \x01(\tB\x03\xe0A\x01R\acomment\x12\x17\n" +
+	"\x04tags\x18\x03 \x03(\tB\x03\xe0A\x01R\x04tags\x12=\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\"|\n" +
+	"\x1aCreateBookmarkMemoResponse\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12\x18\n" +
+	"\awarning\x18\x02 \x01(\tR\awarning\x12\x1c\n" +
+	"\tduplicate\x18\x03 \x01(\bR\tduplicate\"\xb6\x02\n" +
 	"\x10ListMemosRequest\x12 \n" +
 	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
@@ -1858,10 +5412,35 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x05state\x18\x03 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x01R\x05state\x12\x1e\n" +
 	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\x12\x1b\n" +
 	"\x06filter\x18\x05 \x01(\tB\x03\xe0A\x01R\x06filter\x12&\n" +
-	"\fshow_deleted\x18\x06 \x01(\bB\x03\xe0A\x01R\vshowDeleted\"e\n" +
+	"\fshow_deleted\x18\x06 \x01(\bB\x03\xe0A\x01R\vshowDeleted\x12\x1b\n" +
+	"\x06search\x18\a \x01(\tB\x03\xe0A\x01R\x06search\x12*\n" +
+	"\x0eshow_scheduled\x18\b \x01(\bB\x03\xe0A\x01R\rshowScheduled\"\x9f\x01\n" +
 	"\x11ListMemosResponse\x12(\n" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"?\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x128\n" +
+	"\x18attachment_matched_memos\x18\x03 \x03(\tR\x16attachmentMatchedMemos\"t\n" +
+	"\x18GetMemoHighlightsRequest\x12\x19\n" +
+	"\x05month\x18\x01 \x01(\x05B\x03\xe0A\x01R\x05month\x12\x15\n" +
+	"\x03day\x18\x02 \x01(\x05B\x03\xe0A\x01R\x03day\x12&\n" +
+	"\frandom_count\x18\x03 \x01(\x05B\x03\xe0A\x01R\vrandomCount\"\x91\x01\n" +
+	"\x19GetMemoHighlightsResponse\x12=\n" +
+	"\x11on_this_day_memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x0eonThisDayMemos\x125\n" +
+	"\frandom_memos\x18\x02 \x03(\v2\x12.memos.api.v1.MemoR\vrandomMemos\"\x16\n" +
+	"\x14GetMemoCountsRequest\"\x82\x01\n" +
+	"\x15GetMemoCountsResponse\x12!\n" +
+	"\fnormal_count\x18\x01 \x01(\x05R\vnormalCount\x12%\n" +
+	"\x0earchived_count\x18\x02 \x01(\x05R\rarchivedCount\x12\x1f\n" +
+	"\vdraft_count\x18\x03 \x01(\x05R\n" +
+	"draftCount\"\x95\x01\n" +
+	"\x1ePreviewAutoArchiveMemosRequest\x12\x1c\n" +
+	"\x04days\x18\x01 \x01(\x05B\x03\xe0A\x01H\x00R\x04days\x88\x01\x01\x12*\n" +
+	"\x0eprotected_tags\x18\x02 \x03(\tB\x03\xe0A\x01R\rprotectedTags\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSizeB\a\n" +
+	"\x05_days\"j\n" +
+	"\x1fPreviewAutoArchiveMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x02 \x01(\x05R\ttotalSize\"?\n" +
 	"\x0eGetMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\"\x82\x01\n" +
@@ -1872,7 +5451,206 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x11DeleteMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x19\n" +
-	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"\x8b\x01\n" +
+	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"C\n" +
+	"\x12RestoreMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"\x93\x01\n" +
+	"\x14DuplicateMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
+	"\tcopy_tags\x18\x02 \x01(\bB\x03\xe0A\x01R\bcopyTags\x12*\n" +
+	"\x0ecopy_relations\x18\x03 \x01(\bB\x03\xe0A\x01R\rcopyRelations\"\xab\x01\n" +
+	"\x11MergeMemosRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x121\n" +
+	"\x06source\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06source\x12&\n" +
+	"\tseparator\x18\x03 \x01(\tB\x03\xe0A\x01H\x00R\tseparator\x88\x01\x01B\f\n" +
+	"\n" +
+	"_separator\"k\n" +
+	"\x1dCreateMemoFromWikiLinkRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x1b\n" +
+	"\x06target\x18\x02 \x01(\tB\x03\xe0A\x02R\x06target\"\xb4\x01\n" +
+	"\x15UpdateMemoTaskRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x16\n" +
+	"\x05index\x18\x02 \x01(\x05H\x00R\x05index\x12\x19\n" +
+	"\atask_id\x18\x03 \x01(\tH\x00R\x06taskId\x12\x18\n" +
+	"\achecked\x18\x04 \x01(\bR\achecked\x12\x17\n" +
+	"\x04etag\x18\x05 \x01(\tB\x03\xe0A\x02R\x04etagB\x06\n" +
+	"\x04task\"n\n" +
+	"\x16UpdateMemoTaskResponse\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12,\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x16.memos.api.v1.MemoTaskR\x05tasks\"^\n" +
+	"\bMemoTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x18\n" +
+	"\achecked\x18\x04 \x01(\bR\achecked\"\xad\x01\n" +
+	"\x15MovePinnedMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x19\n" +
+	"\amove_up\x18\x02 \x01(\bH\x00R\x06moveUp\x12\x1d\n" +
+	"\tmove_down\x18\x03 \x01(\bH\x00R\bmoveDown\x12\x1c\n" +
+	"\bposition\x18\x04 \x01(\x05H\x00R\bpositionB\r\n" +
+	"\vdestination\"A\n" +
+	"\x10PurgeMemoRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"\xe5\x02\n" +
+	"\fMemoRevision\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
+	"\x06editor\x18\x02 \x01(\tB\x03\xe0A\x03R\x06editor\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\tB\x03\xe0A\x03R\acontent\x12?\n" +
+	"\vattachments\x18\x05 \x03(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x03R\vattachments\x12\x17\n" +
+	"\x04diff\x18\x06 \x01(\tB\x03\xe0A\x03R\x04diff:d\xeaAa\n" +
+	"\x19memos.api.v1/MemoRevision\x12!memos/{memo}/revisions/{revision}\x1a\x04name*\rmemoRevisions2\fmemoRevision\"\x93\x01\n" +
+	"\x18ListMemoRevisionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"}\n" +
+	"\x19ListMemoRevisionsResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"O\n" +
+	"\x16GetMemoRevisionRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoRevisionR\x04name\"S\n" +
+	"\x1aRestoreMemoRevisionRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoRevisionR\x04name\"\xc8\x03\n" +
+	"\rMemoShareLink\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04slug\x18\x02 \x01(\tB\x03\xe0A\x03R\x04slug\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\x12 \n" +
+	"\tmax_views\x18\x05 \x01(\x05B\x03\xe0A\x01R\bmaxViews\x12\"\n" +
+	"\n" +
+	"view_count\x18\x06 \x01(\x05B\x03\xe0A\x03R\tviewCount\x12#\n" +
+	"\n" +
+	"passphrase\x18\a \x01(\tB\x03\xe0A\x04R\n" +
+	"passphrase\x12*\n" +
+	"\x0ehas_passphrase\x18\b \x01(\bB\x03\xe0A\x03R\rhasPassphrase:j\xeaAg\n" +
+	"\x1amemos.api.v1/MemoShareLink\x12$memos/{memo}/shareLinks/{share_link}\x1a\x04name*\x0ememoShareLinks2\rmemoShareLink\"\x90\x01\n" +
+	"\x1aCreateMemoShareLinkRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\x12?\n" +
+	"\n" +
+	"share_link\x18\x02 \x01(\v2\x1b.memos.api.v1.MemoShareLinkB\x03\xe0A\x01R\tshareLink\"N\n" +
+	"\x19ListMemoShareLinksRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x06parent\"Z\n" +
+	"\x1aListMemoShareLinksResponse\x12<\n" +
+	"\vshare_links\x18\x01 \x03(\v2\x1b.memos.api.v1.MemoShareLinkR\n" +
+	"shareLinks\"\x9f\x01\n" +
+	"\x1aUpdateMemoShareLinkRequest\x12?\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2\x1b.memos.api.v1.MemoShareLinkB\x03\xe0A\x02R\tshareLink\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"T\n" +
+	"\x1aDeleteMemoShareLinkRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/MemoShareLinkR\x04name\"W\n" +
+	"\x14GetSharedMemoRequest\x12\x17\n" +
+	"\x04slug\x18\x01 \x01(\tB\x03\xe0A\x02R\x04slug\x12&\n" +
+	"\faccess_token\x18\x02 \x01(\tB\x03\xe0A\x01R\vaccessToken\"W\n" +
+	"\x17UnlockSharedMemoRequest\x12\x17\n" +
+	"\x04slug\x18\x01 \x01(\tB\x03\xe0A\x02R\x04slug\x12#\n" +
+	"\n" +
+	"passphrase\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"passphrase\"z\n" +
+	"\x18UnlockSharedMemoResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\x8c\x01\n" +
+	"\x19SnoozeMemoReminderRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12@\n" +
+	"\vremind_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\n" +
+	"remindTime\"L\n" +
+	"\x1bCompleteMemoReminderRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"I\n" +
+	"\x12ListTagTreeRequest\x123\n" +
+	"\acreator\x18\x01 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\acreator\"D\n" +
+	"\x13ListTagTreeResponse\x12-\n" +
+	"\x04tags\x18\x01 \x03(\v2\x19.memos.api.v1.TagTreeNodeR\x04tags\"\x89\x01\n" +
+	"\vTagTreeNode\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x03 \x01(\x05R\tmemoCount\x125\n" +
+	"\bchildren\x18\x04 \x03(\v2\x19.memos.api.v1.TagTreeNodeR\bchildren\"|\n" +
+	"\x14RenameMemoTagRequest\x12\x1c\n" +
+	"\aold_tag\x18\x01 \x01(\tB\x03\xe0A\x02R\x06oldTag\x12\x1c\n" +
+	"\anew_tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x06newTag\x12(\n" +
+	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"L\n" +
+	"\x15RenameMemoTagResponse\x12\x14\n" +
+	"\x05memos\x18\x01 \x03(\tR\x05memos\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\"\xb0\x02\n" +
+	"\x17BatchUpdateMemosRequest\x12/\n" +
+	"\x05names\x18\x01 \x03(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x05names\x12A\n" +
+	"\x0eset_visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityH\x00R\rsetVisibility\x122\n" +
+	"\tset_state\x18\x03 \x01(\x0e2\x13.memos.api.v1.StateH\x00R\bsetState\x12\x19\n" +
+	"\aadd_tag\x18\x04 \x01(\tH\x00R\x06addTag\x12\x1f\n" +
+	"\n" +
+	"remove_tag\x18\x05 \x01(\tH\x00R\tremoveTag\x12$\n" +
+	"\rmove_to_trash\x18\x06 \x01(\bH\x00R\vmoveToTrashB\v\n" +
+	"\toperation\"\xe9\x01\n" +
+	"\x18BatchUpdateMemosResponse\x12'\n" +
+	"\x0fsucceeded_count\x18\x01 \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x02 \x01(\x05R\vfailedCount\x12J\n" +
+	"\bfailures\x18\x03 \x03(\v2..memos.api.v1.BatchUpdateMemosResponse.FailureR\bfailures\x1a5\n" +
+	"\aFailure\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x86\x02\n" +
+	"\x12ImportMemosRequest\x12\x1d\n" +
+	"\acontent\x18\x01 \x01(\fB\x03\xe0A\x02R\acontent\x12=\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\x12D\n" +
+	"\x06format\x18\x03 \x01(\x0e2'.memos.api.v1.ImportMemosRequest.FormatB\x03\xe0A\x01R\x06format\"L\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bMARKDOWN\x10\x01\x12\x0f\n" +
+	"\vGOOGLE_KEEP\x10\x02\x12\v\n" +
+	"\aDAY_ONE\x10\x03\"K\n" +
+	"\x14GetMemoImportRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/MemoImportR\x04name\"\xae\x05\n" +
+	"\n" +
+	"MemoImport\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x129\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1e.memos.api.v1.MemoImport.StateB\x03\xe0A\x03R\x05state\x12$\n" +
+	"\vtotal_files\x18\x03 \x01(\x05B\x03\xe0A\x03R\n" +
+	"totalFiles\x12,\n" +
+	"\x0fprocessed_files\x18\x04 \x01(\x05B\x03\xe0A\x03R\x0eprocessedFiles\x12(\n" +
+	"\rcreated_memos\x18\x05 \x01(\x05B\x03\xe0A\x03R\fcreatedMemos\x12(\n" +
+	"\rskipped_files\x18\x06 \x01(\x05B\x03\xe0A\x03R\fskippedFiles\x12?\n" +
+	"\x06errors\x18\a \x03(\v2\".memos.api.v1.MemoImport.FileErrorB\x03\xe0A\x03R\x06errors\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vfinish_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"finishTime\x1a?\n" +
+	"\tFileError\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03:V\xeaAS\n" +
+	"\x17memos.api.v1/MemoImport\x12\x19memoImports/{memo_import}\x1a\x04name*\vmemoImports2\n" +
+	"memoImport\"\x8b\x01\n" +
 	"\x19SetMemoAttachmentsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12?\n" +
@@ -1885,7 +5663,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x81\x01\n" +
 	"\x1bListMemoAttachmentsResponse\x12:\n" +
 	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdb\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe6\x02\n" +
 	"\fMemoRelation\x128\n" +
 	"\x04memo\x18\x01 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\x04memo\x12G\n" +
 	"\frelated_memo\x18\x02 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\vrelatedMemo\x128\n" +
@@ -1893,11 +5671,12 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x04Memo\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12\x1d\n" +
-	"\asnippet\x18\x02 \x01(\tB\x03\xe0A\x03R\asnippet\"8\n" +
+	"\asnippet\x18\x02 \x01(\tB\x03\xe0A\x03R\asnippet\"C\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tREFERENCE\x10\x01\x12\v\n" +
-	"\aCOMMENT\x10\x02\"\x87\x01\n" +
+	"\aCOMMENT\x10\x02\x12\t\n" +
+	"\x05MERGE\x10\x03\"\x87\x01\n" +
 	"\x17SetMemoRelationsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12=\n" +
@@ -1910,6 +5689,15 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"}\n" +
 	"\x19ListMemoRelationsResponse\x128\n" +
 	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x01\n" +
+	"\x18ListMemoBacklinksRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"z\n" +
+	"\x19ListMemoBacklinksResponse\x125\n" +
+	"\x05memos\x18\x01 \x03(\v2\x1f.memos.api.v1.MemoRelation.MemoR\x05memos\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa0\x01\n" +
 	"\x18CreateMemoCommentRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
@@ -1953,20 +5741,51 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\xcb\x0e\n" +
+	"\x06PUBLIC\x10\x032\xcd-\n" +
 	"\vMemoService\x12e\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\"\xdaA\x04memo\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12f\n" +
-	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"\x18\xdaA\x00\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/memos\x12b\n" +
+	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"\x18\xdaA\x00\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/memos\x12\x89\x01\n" +
+	"\x11GetMemoHighlights\x12&.memos.api.v1.GetMemoHighlightsRequest\x1a'.memos.api.v1.GetMemoHighlightsResponse\"#\xdaA\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/memos:highlights\x12y\n" +
+	"\rGetMemoCounts\x12\".memos.api.v1.GetMemoCountsRequest\x1a#.memos.api.v1.GetMemoCountsResponse\"\x1f\xdaA\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/memos:counts\x12\xa3\x01\n" +
+	"\x17PreviewAutoArchiveMemos\x12,.memos.api.v1.PreviewAutoArchiveMemosRequest\x1a-.memos.api.v1.PreviewAutoArchiveMemosResponse\"+\xdaA\x00\x82\xd3\xe4\x93\x02\"\x12 /api/v1/memos:previewAutoArchive\x12b\n" +
 	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12\x7f\n" +
 	"\n" +
 	"UpdateMemo\x12\x1f.memos.api.v1.UpdateMemoRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x10memo,update_mask\x82\xd3\xe4\x93\x02#:\x04memo2\x1b/api/v1/{memo.name=memos/*}\x12l\n" +
 	"\n" +
-	"DeleteMemo\x12\x1f.memos.api.v1.DeleteMemoRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=memos/*}\x12\x8b\x01\n" +
+	"DeleteMemo\x12\x1f.memos.api.v1.DeleteMemoRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=memos/*}\x12u\n" +
+	"\vRestoreMemo\x12 .memos.api.v1.RestoreMemoRequest\x1a\x12.memos.api.v1.Memo\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=memos/*}:restore\x12s\n" +
+	"\tPurgeMemo\x12\x1e.memos.api.v1.PurgeMemoRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/{name=memos/*}:purge\x12\x99\x01\n" +
+	"\x11ListMemoRevisions\x12&.memos.api.v1.ListMemoRevisionsRequest\x1a'.memos.api.v1.ListMemoRevisionsResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=memos/*}/revisions\x12\x86\x01\n" +
+	"\x0fGetMemoRevision\x12$.memos.api.v1.GetMemoRevisionRequest\x1a\x1a.memos.api.v1.MemoRevision\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*/revisions/*}\x12\x91\x01\n" +
+	"\x13RestoreMemoRevision\x12(.memos.api.v1.RestoreMemoRevisionRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=memos/*/revisions/*}:restore\x12\xa9\x01\n" +
+	"\x13CreateMemoShareLink\x12(.memos.api.v1.CreateMemoShareLinkRequest\x1a\x1b.memos.api.v1.MemoShareLink\"K\xdaA\x11parent,share_link\x82\xd3\xe4\x93\x021:\n" +
+	"share_link\"#/api/v1/{parent=memos/*}/shareLinks\x12\x9d\x01\n" +
+	"\x12ListMemoShareLinks\x12'.memos.api.v1.ListMemoShareLinksRequest\x1a(.memos.api.v1.ListMemoShareLinksResponse\"4\xdaA\x06parent\x82\xd3\xe4\x93\x02%\x12#/api/v1/{parent=memos/*}/shareLinks\x12\xb9\x01\n" +
+	"\x13UpdateMemoShareLink\x12(.memos.api.v1.UpdateMemoShareLinkRequest\x1a\x1b.memos.api.v1.MemoShareLink\"[\xdaA\x16share_link,update_mask\x82\xd3\xe4\x93\x02<:\n" +
+	"share_link2./api/v1/{share_link.name=memos/*/shareLinks/*}\x12\x8b\x01\n" +
+	"\x13DeleteMemoShareLink\x12(.memos.api.v1.DeleteMemoShareLinkRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%*#/api/v1/{name=memos/*/shareLinks/*}\x12m\n" +
+	"\rGetSharedMemo\x12\".memos.api.v1.GetSharedMemoRequest\x1a\x12.memos.api.v1.Memo\"$\xdaA\x04slug\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/shares/{slug}\x12\x9c\x01\n" +
+	"\x10UnlockSharedMemo\x12%.memos.api.v1.UnlockSharedMemoRequest\x1a&.memos.api.v1.UnlockSharedMemoResponse\"9\xdaA\x0fslug,passphrase\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/shares/{slug}:unlock\x12{\n" +
+	"\rDuplicateMemo\x12\".memos.api.v1.DuplicateMemoRequest\x1a\x12.memos.api.v1.Memo\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}:duplicate\x12x\n" +
+	"\n" +
+	"MergeMemos\x12\x1f.memos.api.v1.MergeMemosRequest\x1a\x12.memos.api.v1.Memo\"5\xdaA\vname,source\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/{name=memos/*}:merge\x12\x9d\x01\n" +
+	"\x16CreateMemoFromWikiLink\x12+.memos.api.v1.CreateMemoFromWikiLinkRequest\x1a\x12.memos.api.v1.Memo\"B\xdaA\vname,target\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/{name=memos/*}:createFromWikiLink\x12\x98\x01\n" +
+	"\x0eUpdateMemoTask\x12#.memos.api.v1.UpdateMemoTaskRequest\x1a$.memos.api.v1.UpdateMemoTaskResponse\";\xdaA\fname,checked\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/{name=memos/*}:updateTask\x12{\n" +
+	"\x0eMovePinnedMemo\x12#.memos.api.v1.MovePinnedMemoRequest\x1a\x12.memos.api.v1.Memo\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=memos/*}:movePin\x12\x96\x01\n" +
+	"\x12SnoozeMemoReminder\x12'.memos.api.v1.SnoozeMemoReminderRequest\x1a\x12.memos.api.v1.Memo\"C\xdaA\x10name,remind_time\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=memos/*}:snoozeReminder\x12\x90\x01\n" +
+	"\x14CompleteMemoReminder\x12).memos.api.v1.CompleteMemoReminderRequest\x1a\x12.memos.api.v1.Memo\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=memos/*}:completeReminder\x12\x96\x01\n" +
+	"\x12CreateBookmarkMemo\x12'.memos.api.v1.CreateBookmarkMemoRequest\x1a(.memos.api.v1.CreateBookmarkMemoResponse\"-\xdaA\x03url\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/memos:createBookmark\x12q\n" +
+	"\vListTagTree\x12 .memos.api.v1.ListTagTreeRequest\x1a!.memos.api.v1.ListTagTreeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:tagTree\x12\x90\x01\n" +
+	"\rRenameMemoTag\x12\".memos.api.v1.RenameMemoTagRequest\x1a#.memos.api.v1.RenameMemoTagResponse\"6\xdaA\x0fold_tag,new_tag\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos/tags:rename\x12\x8f\x01\n" +
+	"\x10BatchUpdateMemos\x12%.memos.api.v1.BatchUpdateMemosRequest\x1a&.memos.api.v1.BatchUpdateMemosResponse\",\xdaA\x05names\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/memos:batchUpdate\x12j\n" +
+	"\vImportMemos\x12 .memos.api.v1.ImportMemosRequest\x1a\x18.memos.api.v1.MemoImport\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/memos:import\x12z\n" +
+	"\rGetMemoImport\x12\".memos.api.v1.GetMemoImportRequest\x1a\x18.memos.api.v1.MemoImport\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/{name=memoImports/*}\x12\x8b\x01\n" +
 	"\x12SetMemoAttachments\x12'.memos.api.v1.SetMemoAttachmentsRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02':\x01*2\"/api/v1/{name=memos/*}/attachments\x12\x9d\x01\n" +
 	"\x13ListMemoAttachments\x12(.memos.api.v1.ListMemoAttachmentsRequest\x1a).memos.api.v1.ListMemoAttachmentsResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/attachments\x12\x85\x01\n" +
 	"\x10SetMemoRelations\x12%.memos.api.v1.SetMemoRelationsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
-	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x90\x01\n" +
+	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
+	"\x11ListMemoBacklinks\x12&.memos.api.v1.ListMemoBacklinksRequest\x1a'.memos.api.v1.ListMemoBacklinksResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/backlinks\x12\x90\x01\n" +
 	"\x11CreateMemoComment\x12&.memos.api.v1.CreateMemoCommentRequest\x1a\x12.memos.api.v1.Memo\"?\xdaA\fname,comment\x82\xd3\xe4\x93\x02*:\acomment\"\x1f/api/v1/{name=memos/*}/comments\x12\x91\x01\n" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
@@ -1986,103 +5805,251 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                     // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),              // 1: memos.api.v1.MemoRelation.Type
-	(*Reaction)(nil),                    // 2: memos.api.v1.Reaction
-	(*Memo)(nil),                        // 3: memos.api.v1.Memo
-	(*Location)(nil),                    // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),           // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),            // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),           // 7: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),              // 8: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),           // 9: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),           // 10: memos.api.v1.DeleteMemoRequest
-	(*SetMemoAttachmentsRequest)(nil),   // 11: memos.api.v1.SetMemoAttachmentsRequest
-	(*ListMemoAttachmentsRequest)(nil),  // 12: memos.api.v1.ListMemoAttachmentsRequest
-	(*ListMemoAttachmentsResponse)(nil), // 13: memos.api.v1.ListMemoAttachmentsResponse
-	(*MemoRelation)(nil),                // 14: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),     // 15: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),    // 16: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil),   // 17: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),    // 18: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),     // 19: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),    // 20: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),    // 21: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil),   // 22: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil),   // 23: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil),   // 24: memos.api.v1.DeleteMemoReactionRequest
-	(*Memo_Property)(nil),               // 25: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),           // 26: memos.api.v1.MemoRelation.Memo
-	(*timestamppb.Timestamp)(nil),       // 27: google.protobuf.Timestamp
-	(State)(0),                          // 28: memos.api.v1.State
-	(*Attachment)(nil),                  // 29: memos.api.v1.Attachment
-	(*fieldmaskpb.FieldMask)(nil),       // 30: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),               // 31: google.protobuf.Empty
+	(Visibility)(0),                          // 0: memos.api.v1.Visibility
+	(MemoReminder_Repeat)(0),                 // 1: memos.api.v1.MemoReminder.Repeat
+	(ImportMemosRequest_Format)(0),           // 2: memos.api.v1.ImportMemosRequest.Format
+	(MemoImport_State)(0),                    // 3: memos.api.v1.MemoImport.State
+	(MemoRelation_Type)(0),                   // 4: memos.api.v1.MemoRelation.Type
+	(*Reaction)(nil),                         // 5: memos.api.v1.Reaction
+	(*Memo)(nil),                             // 6: memos.api.v1.Memo
+	(*MemoReminder)(nil),                     // 7: memos.api.v1.MemoReminder
+	(*MemoBookmark)(nil),                     // 8: memos.api.v1.MemoBookmark
+	(*Location)(nil),                         // 9: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),                // 10: memos.api.v1.CreateMemoRequest
+	(*CreateBookmarkMemoRequest)(nil),        // 11: memos.api.v1.CreateBookmarkMemoRequest
+	(*CreateBookmarkMemoResponse)(nil),       // 12: memos.api.v1.CreateBookmarkMemoResponse
+	(*ListMemosRequest)(nil),                 // 13: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),                // 14: memos.api.v1.ListMemosResponse
+	(*GetMemoHighlightsRequest)(nil),         // 15: memos.api.v1.GetMemoHighlightsRequest
+	(*GetMemoHighlightsResponse)(nil),        // 16: memos.api.v1.GetMemoHighlightsResponse
+	(*GetMemoCountsRequest)(nil),             // 17: memos.api.v1.GetMemoCountsRequest
+	(*GetMemoCountsResponse)(nil),            // 18: memos.api.v1.GetMemoCountsResponse
+	(*PreviewAutoArchiveMemosRequest)(nil),   // 19: memos.api.v1.PreviewAutoArchiveMemosRequest
+	(*PreviewAutoArchiveMemosResponse)(nil),  // 20: memos.api.v1.PreviewAutoArchiveMemosResponse
+	(*GetMemoRequest)(nil),                   // 21: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),                // 22: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),                // 23: memos.api.v1.DeleteMemoRequest
+	(*RestoreMemoRequest)(nil),               // 24: memos.api.v1.RestoreMemoRequest
+	(*DuplicateMemoRequest)(nil),             // 25: memos.api.v1.DuplicateMemoRequest
+	(*MergeMemosRequest)(nil),                // 26: memos.api.v1.MergeMemosRequest
+	(*CreateMemoFromWikiLinkRequest)(nil),    // 27: memos.api.v1.CreateMemoFromWikiLinkRequest
+	(*UpdateMemoTaskRequest)(nil),            // 28: memos.api.v1.UpdateMemoTaskRequest
+	(*UpdateMemoTaskResponse)(nil),           // 29: memos.api.v1.UpdateMemoTaskResponse
+	(*MemoTask)(nil),                         // 30: memos.api.v1.MemoTask
+	(*MovePinnedMemoRequest)(nil),            // 31: memos.api.v1.MovePinnedMemoRequest
+	(*PurgeMemoRequest)(nil),                 // 32: memos.api.v1.PurgeMemoRequest
+	(*MemoRevision)(nil),                     // 33: memos.api.v1.MemoRevision
+	(*ListMemoRevisionsRequest)(nil),         // 34: memos.api.v1.ListMemoRevisionsRequest
+	(*ListMemoRevisionsResponse)(nil),        // 35: memos.api.v1.ListMemoRevisionsResponse
+	(*GetMemoRevisionRequest)(nil),           // 36: memos.api.v1.GetMemoRevisionRequest
+	(*RestoreMemoRevisionRequest)(nil),       // 37: memos.api.v1.RestoreMemoRevisionRequest
+	(*MemoShareLink)(nil),                    // 38: memos.api.v1.MemoShareLink
+	(*CreateMemoShareLinkRequest)(nil),       // 39: memos.api.v1.CreateMemoShareLinkRequest
+	(*ListMemoShareLinksRequest)(nil),        // 40: memos.api.v1.ListMemoShareLinksRequest
+	(*ListMemoShareLinksResponse)(nil),       // 41: memos.api.v1.ListMemoShareLinksResponse
+	(*UpdateMemoShareLinkRequest)(nil),       // 42: memos.api.v1.UpdateMemoShareLinkRequest
+	(*DeleteMemoShareLinkRequest)(nil),       // 43: memos.api.v1.DeleteMemoShareLinkRequest
+	(*GetSharedMemoRequest)(nil),             // 44: memos.api.v1.GetSharedMemoRequest
+	(*UnlockSharedMemoRequest)(nil),          // 45: memos.api.v1.UnlockSharedMemoRequest
+	(*UnlockSharedMemoResponse)(nil),         // 46: memos.api.v1.UnlockSharedMemoResponse
+	(*SnoozeMemoReminderRequest)(nil),        // 47: memos.api.v1.SnoozeMemoReminderRequest
+	(*CompleteMemoReminderRequest)(nil),      // 48: memos.api.v1.CompleteMemoReminderRequest
+	(*ListTagTreeRequest)(nil),               // 49: memos.api.v1.ListTagTreeRequest
+	(*ListTagTreeResponse)(nil),              // 50: memos.api.v1.ListTagTreeResponse
+	(*TagTreeNode)(nil),                      // 51: memos.api.v1.TagTreeNode
+	(*RenameMemoTagRequest)(nil),             // 52: memos.api.v1.RenameMemoTagRequest
+	(*RenameMemoTagResponse)(nil),            // 53: memos.api.v1.RenameMemoTagResponse
+	(*BatchUpdateMemosRequest)(nil),          // 54: memos.api.v1.BatchUpdateMemosRequest
+	(*BatchUpdateMemosResponse)(nil),         // 55: memos.api.v1.BatchUpdateMemosResponse
+	(*ImportMemosRequest)(nil),               // 56: memos.api.v1.ImportMemosRequest
+	(*GetMemoImportRequest)(nil),             // 57: memos.api.v1.GetMemoImportRequest
+	(*MemoImport)(nil),                       // 58: memos.api.v1.MemoImport
+	(*SetMemoAttachmentsRequest)(nil),        // 59: memos.api.v1.SetMemoAttachmentsRequest
+	(*ListMemoAttachmentsRequest)(nil),       // 60: memos.api.v1.ListMemoAttachmentsRequest
+	(*ListMemoAttachmentsResponse)(nil),      // 61: memos.api.v1.ListMemoAttachmentsResponse
+	(*MemoRelation)(nil),                     // 62: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),          // 63: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),         // 64: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),        // 65: memos.api.v1.ListMemoRelationsResponse
+	(*ListMemoBacklinksRequest)(nil),         // 66: memos.api.v1.ListMemoBacklinksRequest
+	(*ListMemoBacklinksResponse)(nil),        // 67: memos.api.v1.ListMemoBacklinksResponse
+	(*CreateMemoCommentRequest)(nil),         // 68: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),          // 69: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),         // 70: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),         // 71: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),        // 72: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),        // 73: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),        // 74: memos.api.v1.DeleteMemoReactionRequest
+	(*Memo_Property)(nil),                    // 75: memos.api.v1.Memo.Property
+	(*Memo_WikiLink)(nil),                    // 76: memos.api.v1.Memo.WikiLink
+	(*BatchUpdateMemosResponse_Failure)(nil), // 77: memos.api.v1.BatchUpdateMemosResponse.Failure
+	(*MemoImport_FileError)(nil),             // 78: memos.api.v1.MemoImport.FileError
+	(*MemoRelation_Memo)(nil),                // 79: memos.api.v1.MemoRelation.Memo
+	(*timestamppb.Timestamp)(nil),            // 80: google.protobuf.Timestamp
+	(State)(0),                               // 81: memos.api.v1.State
+	(*Attachment)(nil),                       // 82: memos.api.v1.Attachment
+	(*fieldmaskpb.FieldMask)(nil),            // 83: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                    // 84: google.protobuf.Empty
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	27, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
-	28, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	27, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	27, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	27, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	29, // 6: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
-	14, // 7: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 8: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	25, // 9: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	4,  // 10: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	3,  // 11: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	28, // 12: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	3,  // 13: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	3,  // 14: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	30, // 15: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	29, // 16: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
-	29, // 17: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	26, // 18: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	26, // 19: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 20: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	14, // 21: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	14, // 22: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	3,  // 23: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	3,  // 24: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 25: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	2,  // 26: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	5,  // 27: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 28: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 29: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	9,  // 30: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	10, // 31: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	11, // 32: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
-	12, // 33: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
-	15, // 34: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	16, // 35: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	18, // 36: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	19, // 37: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	21, // 38: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	23, // 39: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	24, // 40: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	3,  // 41: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 42: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	3,  // 43: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	3,  // 44: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	31, // 45: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	31, // 46: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
-	13, // 47: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
-	31, // 48: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	17, // 49: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	3,  // 50: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	20, // 51: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	22, // 52: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	2,  // 53: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	31, // 54: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	41, // [41:55] is the sub-list for method output_type
-	27, // [27:41] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	80,  // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	81,  // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	80,  // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	80,  // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	80,  // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
+	0,   // 5: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
+	82,  // 6: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
+	62,  // 7: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	5,   // 8: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	75,  // 9: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	9,   // 10: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	80,  // 11: memos.api.v1.Memo.delete_time:type_name -> google.protobuf.Timestamp
+	80,  // 12: memos.api.v1.Memo.publish_time:type_name -> google.protobuf.Timestamp
+	7,   // 13: memos.api.v1.Memo.reminder:type_name -> memos.api.v1.MemoReminder
+	80,  // 14: memos.api.v1.Memo.expire_time:type_name -> google.protobuf.Timestamp
+	8,   // 15: memos.api.v1.Memo.bookmark:type_name -> memos.api.v1.MemoBookmark
+	76,  // 16: memos.api.v1.Memo.wiki_links:type_name -> memos.api.v1.Memo.WikiLink
+	80,  // 17: memos.api.v1.MemoReminder.remind_time:type_name -> google.protobuf.Timestamp
+	1,   // 18: memos.api.v1.MemoReminder.repeat:type_name -> memos.api.v1.MemoReminder.Repeat
+	80,  // 19: memos.api.v1.MemoReminder.deliver_time:type_name -> google.protobuf.Timestamp
+	6,   // 20: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	0,   // 21: memos.api.v1.CreateBookmarkMemoRequest.visibility:type_name -> memos.api.v1.Visibility
+	6,   // 22: memos.api.v1.CreateBookmarkMemoResponse.memo:type_name -> memos.api.v1.Memo
+	81,  // 23: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	6,   // 24: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	6,   // 25: memos.api.v1.GetMemoHighlightsResponse.on_this_day_memos:type_name -> memos.api.v1.Memo
+	6,   // 26: memos.api.v1.GetMemoHighlightsResponse.random_memos:type_name -> memos.api.v1.Memo
+	6,   // 27: memos.api.v1.PreviewAutoArchiveMemosResponse.memos:type_name -> memos.api.v1.Memo
+	6,   // 28: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	83,  // 29: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,   // 30: memos.api.v1.UpdateMemoTaskResponse.memo:type_name -> memos.api.v1.Memo
+	30,  // 31: memos.api.v1.UpdateMemoTaskResponse.tasks:type_name -> memos.api.v1.MemoTask
+	80,  // 32: memos.api.v1.MemoRevision.create_time:type_name -> google.protobuf.Timestamp
+	82,  // 33: memos.api.v1.MemoRevision.attachments:type_name -> memos.api.v1.Attachment
+	33,  // 34: memos.api.v1.ListMemoRevisionsResponse.revisions:type_name -> memos.api.v1.MemoRevision
+	80,  // 35: memos.api.v1.MemoShareLink.create_time:type_name -> google.protobuf.Timestamp
+	80,  // 36: memos.api.v1.MemoShareLink.expire_time:type_name -> google.protobuf.Timestamp
+	38,  // 37: memos.api.v1.CreateMemoShareLinkRequest.share_link:type_name -> memos.api.v1.MemoShareLink
+	38,  // 38: memos.api.v1.ListMemoShareLinksResponse.share_links:type_name -> memos.api.v1.MemoShareLink
+	38,  // 39: memos.api.v1.UpdateMemoShareLinkRequest.share_link:type_name -> memos.api.v1.MemoShareLink
+	83,  // 40: memos.api.v1.UpdateMemoShareLinkRequest.update_mask:type_name -> google.protobuf.FieldMask
+	80,  // 41: memos.api.v1.UnlockSharedMemoResponse.expire_time:type_name -> google.protobuf.Timestamp
+	80,  // 42: memos.api.v1.SnoozeMemoReminderRequest.remind_time:type_name -> google.protobuf.Timestamp
+	51,  // 43: memos.api.v1.ListTagTreeResponse.tags:type_name -> memos.api.v1.TagTreeNode
+	51,  // 44: memos.api.v1.TagTreeNode.children:type_name -> memos.api.v1.TagTreeNode
+	0,   // 45: memos.api.v1.BatchUpdateMemosRequest.set_visibility:type_name -> memos.api.v1.Visibility
+	81,  // 46: memos.api.v1.BatchUpdateMemosRequest.set_state:type_name -> memos.api.v1.State
+	77,  // 47: memos.api.v1.BatchUpdateMemosResponse.failures:type_name -> memos.api.v1.BatchUpdateMemosResponse.Failure
+	0,   // 48: memos.api.v1.ImportMemosRequest.visibility:type_name -> memos.api.v1.Visibility
+	2,   // 49: memos.api.v1.ImportMemosRequest.format:type_name -> memos.api.v1.ImportMemosRequest.Format
+	3,   // 50: memos.api.v1.MemoImport.state:type_name -> memos.api.v1.MemoImport.State
+	78,  // 51: memos.api.v1.MemoImport.errors:type_name -> memos.api.v1.MemoImport.FileError
+	80,  // 52: memos.api.v1.MemoImport.create_time:type_name -> google.protobuf.Timestamp
+	80,  // 53: memos.api.v1.MemoImport.finish_time:type_name -> google.protobuf.Timestamp
+	82,  // 54: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
+	82,  // 55: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	79,  // 56: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	79,  // 57: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	4,   // 58: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	62,  // 59: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	62,  // 60: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	79,  // 61: memos.api.v1.ListMemoBacklinksResponse.memos:type_name -> memos.api.v1.MemoRelation.Memo
+	6,   // 62: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	6,   // 63: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	5,   // 64: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	5,   // 65: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	10,  // 66: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	13,  // 67: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	15,  // 68: memos.api.v1.MemoService.GetMemoHighlights:input_type -> memos.api.v1.GetMemoHighlightsRequest
+	17,  // 69: memos.api.v1.MemoService.GetMemoCounts:input_type -> memos.api.v1.GetMemoCountsRequest
+	19,  // 70: memos.api.v1.MemoService.PreviewAutoArchiveMemos:input_type -> memos.api.v1.PreviewAutoArchiveMemosRequest
+	21,  // 71: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	22,  // 72: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	23,  // 73: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	24,  // 74: memos.api.v1.MemoService.RestoreMemo:input_type -> memos.api.v1.RestoreMemoRequest
+	32,  // 75: memos.api.v1.MemoService.PurgeMemo:input_type -> memos.api.v1.PurgeMemoRequest
+	34,  // 76: memos.api.v1.MemoService.ListMemoRevisions:input_type -> memos.api.v1.ListMemoRevisionsRequest
+	36,  // 77: memos.api.v1.MemoService.GetMemoRevision:input_type -> memos.api.v1.GetMemoRevisionRequest
+	37,  // 78: memos.api.v1.MemoService.RestoreMemoRevision:input_type -> memos.api.v1.RestoreMemoRevisionRequest
+	39,  // 79: memos.api.v1.MemoService.CreateMemoShareLink:input_type -> memos.api.v1.CreateMemoShareLinkRequest
+	40,  // 80: memos.api.v1.MemoService.ListMemoShareLinks:input_type -> memos.api.v1.ListMemoShareLinksRequest
+	42,  // 81: memos.api.v1.MemoService.UpdateMemoShareLink:input_type -> memos.api.v1.UpdateMemoShareLinkRequest
+	43,  // 82: memos.api.v1.MemoService.DeleteMemoShareLink:input_type -> memos.api.v1.DeleteMemoShareLinkRequest
+	44,  // 83: memos.api.v1.MemoService.GetSharedMemo:input_type -> memos.api.v1.GetSharedMemoRequest
+	45,  // 84: memos.api.v1.MemoService.UnlockSharedMemo:input_type -> memos.api.v1.UnlockSharedMemoRequest
+	25,  // 85: memos.api.v1.MemoService.DuplicateMemo:input_type -> memos.api.v1.DuplicateMemoRequest
+	26,  // 86: memos.api.v1.MemoService.MergeMemos:input_type -> memos.api.v1.MergeMemosRequest
+	27,  // 87: memos.api.v1.MemoService.CreateMemoFromWikiLink:input_type -> memos.api.v1.CreateMemoFromWikiLinkRequest
+	28,  // 88: memos.api.v1.MemoService.UpdateMemoTask:input_type -> memos.api.v1.UpdateMemoTaskRequest
+	31,  // 89: memos.api.v1.MemoService.MovePinnedMemo:input_type -> memos.api.v1.MovePinnedMemoRequest
+	47,  // 90: memos.api.v1.MemoService.SnoozeMemoReminder:input_type -> memos.api.v1.SnoozeMemoReminderRequest
+	48,  // 91: memos.api.v1.MemoService.CompleteMemoReminder:input_type -> memos.api.v1.CompleteMemoReminderRequest
+	11,  // 92: memos.api.v1.MemoService.CreateBookmarkMemo:input_type -> memos.api.v1.CreateBookmarkMemoRequest
+	49,  // 93: memos.api.v1.MemoService.ListTagTree:input_type -> memos.api.v1.ListTagTreeRequest
+	52,  // 94: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
+	54,  // 95: memos.api.v1.MemoService.BatchUpdateMemos:input_type -> memos.api.v1.BatchUpdateMemosRequest
+	56,  // 96: memos.api.v1.MemoService.ImportMemos:input_type -> memos.api.v1.ImportMemosRequest
+	57,  // 97: memos.api.v1.MemoService.GetMemoImport:input_type -> memos.api.v1.GetMemoImportRequest
+	59,  // 98: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
+	60,  // 99: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
+	63,  // 100: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	64,  // 101: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	66,  // 102: memos.api.v1.MemoService.ListMemoBacklinks:input_type -> memos.api.v1.ListMemoBacklinksRequest
+	68,  // 103: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	69,  // 104: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	71,  // 105: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	73,  // 106: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	74,  // 107: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	6,   // 108: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	14,  // 109: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	16,  // 110: memos.api.v1.MemoService.GetMemoHighlights:output_type -> memos.api.v1.GetMemoHighlightsResponse
+	18,  // 111: memos.api.v1.MemoService.GetMemoCounts:output_type -> memos.api.v1.GetMemoCountsResponse
+	20,  // 112: memos.api.v1.MemoService.PreviewAutoArchiveMemos:output_type -> memos.api.v1.PreviewAutoArchiveMemosResponse
+	6,   // 113: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	6,   // 114: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	84,  // 115: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	6,   // 116: memos.api.v1.MemoService.RestoreMemo:output_type -> memos.api.v1.Memo
+	84,  // 117: memos.api.v1.MemoService.PurgeMemo:output_type -> google.protobuf.Empty
+	35,  // 118: memos.api.v1.MemoService.ListMemoRevisions:output_type -> memos.api.v1.ListMemoRevisionsResponse
+	33,  // 119: memos.api.v1.MemoService.GetMemoRevision:output_type -> memos.api.v1.MemoRevision
+	6,   // 120: memos.api.v1.MemoService.RestoreMemoRevision:output_type -> memos.api.v1.Memo
+	38,  // 121: memos.api.v1.MemoService.CreateMemoShareLink:output_type -> memos.api.v1.MemoShareLink
+	41,  // 122: memos.api.v1.MemoService.ListMemoShareLinks:output_type -> memos.api.v1.ListMemoShareLinksResponse
+	38,  // 123: memos.api.v1.MemoService.UpdateMemoShareLink:output_type -> memos.api.v1.MemoShareLink
+	84,  // 124: memos.api.v1.MemoService.DeleteMemoShareLink:output_type -> google.protobuf.Empty
+	6,   // 125: memos.api.v1.MemoService.GetSharedMemo:output_type -> memos.api.v1.Memo
+	46,  // 126: memos.api.v1.MemoService.UnlockSharedMemo:output_type -> memos.api.v1.UnlockSharedMemoResponse
+	6,   // 127: memos.api.v1.MemoService.DuplicateMemo:output_type -> memos.api.v1.Memo
+	6,   // 128: memos.api.v1.MemoService.MergeMemos:output_type -> memos.api.v1.Memo
+	6,   // 129: memos.api.v1.MemoService.CreateMemoFromWikiLink:output_type -> memos.api.v1.Memo
+	29,  // 130: memos.api.v1.MemoService.UpdateMemoTask:output_type -> memos.api.v1.UpdateMemoTaskResponse
+	6,   // 131: memos.api.v1.MemoService.MovePinnedMemo:output_type -> memos.api.v1.Memo
+	6,   // 132: memos.api.v1.MemoService.SnoozeMemoReminder:output_type -> memos.api.v1.Memo
+	6,   // 133: memos.api.v1.MemoService.CompleteMemoReminder:output_type -> memos.api.v1.Memo
+	12,  // 134: memos.api.v1.MemoService.CreateBookmarkMemo:output_type -> memos.api.v1.CreateBookmarkMemoResponse
+	50,  // 135: memos.api.v1.MemoService.ListTagTree:output_type -> memos.api.v1.ListTagTreeResponse
+	53,  // 136: memos.api.v1.MemoService.RenameMemoTag:output_type -> memos.api.v1.RenameMemoTagResponse
+	55,  // 137: memos.api.v1.MemoService.BatchUpdateMemos:output_type -> memos.api.v1.BatchUpdateMemosResponse
+	58,  // 138: memos.api.v1.MemoService.ImportMemos:output_type -> memos.api.v1.MemoImport
+	58,  // 139: memos.api.v1.MemoService.GetMemoImport:output_type -> memos.api.v1.MemoImport
+	84,  // 140: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
+	61,  // 141: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
+	84,  // 142: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	65,  // 143: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	67,  // 144: memos.api.v1.MemoService.ListMemoBacklinks:output_type -> memos.api.v1.ListMemoBacklinksResponse
+	6,   // 145: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	70,  // 146: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	72,  // 147: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	5,   // 148: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	84,  // 149: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	108, // [108:150] is the sub-list for method output_type
+	66,  // [66:108] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -2093,13 +6060,31 @@ func file_api_v1_memo_service_proto_init() {
 	file_api_v1_attachment_service_proto_init()
 	file_api_v1_common_proto_init()
 	file_api_v1_memo_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[23].OneofWrappers = []any{
+		(*UpdateMemoTaskRequest_Index)(nil),
+		(*UpdateMemoTaskRequest_TaskId)(nil),
+	}
+	file_api_v1_memo_service_proto_msgTypes[26].OneofWrappers = []any{
+		(*MovePinnedMemoRequest_MoveUp)(nil),
+		(*MovePinnedMemoRequest_MoveDown)(nil),
+		(*MovePinnedMemoRequest_Position)(nil),
+	}
+	file_api_v1_memo_service_proto_msgTypes[49].OneofWrappers = []any{
+		(*BatchUpdateMemosRequest_SetVisibility)(nil),
+		(*BatchUpdateMemosRequest_SetState)(nil),
+		(*BatchUpdateMemosRequest_AddTag)(nil),
+		(*BatchUpdateMemosRequest_RemoveTag)(nil),
+		(*BatchUpdateMemosRequest_MoveToTrash)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      5,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,20 +20,48 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName          = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName           = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName             = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_SetMemoAttachments_FullMethodName  = "/memos.api.v1.MemoService/SetMemoAttachments"
-	MemoService_ListMemoAttachments_FullMethodName = "/memos.api.v1.MemoService/ListMemoAttachments"
-	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName              = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName               = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_GetMemoHighlights_FullMethodName       = "/memos.api.v1.MemoService/GetMemoHighlights"
+	MemoService_GetMemoCounts_FullMethodName           = "/memos.api.v1.MemoService/GetMemoCounts"
+	MemoService_PreviewAutoArchiveMemos_FullMethodName = "/memos.api.v1.MemoService/PreviewAutoArchiveMemos"
+	MemoService_GetMemo_FullMethodName                 = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName              = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName              = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_RestoreMemo_FullMethodName             = "/memos.api.v1.MemoService/RestoreMemo"
+	MemoService_PurgeMemo_FullMethodName               = "/memos.api.v1.MemoService/PurgeMemo"
+	MemoService_ListMemoRevisions_FullMethodName       = "/memos.api.v1.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevision_FullMethodName         = "/memos.api.v1.MemoService/GetMemoRevision"
+	MemoService_RestoreMemoRevision_FullMethodName     = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	MemoService_CreateMemoShareLink_FullMethodName     = "/memos.api.v1.MemoService/CreateMemoShareLink"
+	MemoService_ListMemoShareLinks_FullMethodName      = "/memos.api.v1.MemoService/ListMemoShareLinks"
+	MemoService_UpdateMemoShareLink_FullMethodName     = "/memos.api.v1.MemoService/UpdateMemoShareLink"
+	MemoService_DeleteMemoShareLink_FullMethodName     = "/memos.api.v1.MemoService/DeleteMemoShareLink"
+	MemoService_GetSharedMemo_FullMethodName           = "/memos.api.v1.MemoService/GetSharedMemo"
+	MemoService_UnlockSharedMemo_FullMethodName        = "/memos.api.v1.MemoService/UnlockSharedMemo"
+	MemoService_DuplicateMemo_FullMethodName           = "/memos.api.v1.MemoService/DuplicateMemo"
+	MemoService_MergeMemos_FullMethodName              = "/memos.api.v1.MemoService/MergeMemos"
+	MemoService_CreateMemoFromWikiLink_FullMethodName  = "/memos.api.v1.MemoService/CreateMemoFromWikiLink"
+	MemoService_UpdateMemoTask_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemoTask"
+	MemoService_MovePinnedMemo_FullMethodName          = "/memos.api.v1.MemoService/MovePinnedMemo"
+	MemoService_SnoozeMemoReminder_FullMethodName      = "/memos.api.v1.MemoService/SnoozeMemoReminder"
+	MemoService_CompleteMemoReminder_FullMethodName    = "/memos.api.v1.MemoService/CompleteMemoReminder"
+	MemoService_CreateBookmarkMemo_FullMethodName      = "/memos.api.v1.MemoService/CreateBookmarkMemo"
+	MemoService_ListTagTree_FullMethodName             = "/memos.api.v1.MemoService/ListTagTree"
+	MemoService_RenameMemoTag_FullMethodName           = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_BatchUpdateMemos_FullMethodName        = "/memos.api.v1.MemoService/BatchUpdateMemos"
+	MemoService_ImportMemos_FullMethodName             = "/memos.api.v1.MemoService/ImportMemos"
+	MemoService_GetMemoImport_FullMethodName           = "/memos.api.v1.MemoService/GetMemoImport"
+	MemoService_SetMemoAttachments_FullMethodName      = "/memos.api.v1.MemoService/SetMemoAttachments"
+	MemoService_ListMemoAttachments_FullMethodName     = "/memos.api.v1.MemoService/ListMemoAttachments"
+	MemoService_SetMemoRelations_FullMethodName        = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName       = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_ListMemoBacklinks_FullMethodName       = "/memos.api.v1.MemoService/ListMemoBacklinks"
+	MemoService_CreateMemoComment_FullMethodName       = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName        = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName       = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName      = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName      = "/memos.api.v1.MemoService/DeleteMemoReaction"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -44,12 +72,93 @@ type MemoServiceClient interface {
 	CreateMemo(ctx context.Context, in *CreateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
+	// GetMemoHighlights gets memos of the current user to rediscover: the memos created on the same
+	// day in previous years, and a random sample of memos older than 90 days.
+	GetMemoHighlights(ctx context.Context, in *GetMemoHighlightsRequest, opts ...grpc.CallOption) (*GetMemoHighlightsResponse, error)
+	// GetMemoCounts gets the number of memos of the current user in each state.
+	GetMemoCounts(ctx context.Context, in *GetMemoCountsRequest, opts ...grpc.CallOption) (*GetMemoCountsResponse, error)
+	// PreviewAutoArchiveMemos lists the memos of the current user that the auto-archive policy
+	// would archive, with the saved policy or the one of the request.
+	PreviewAutoArchiveMemos(ctx context.Context, in *PreviewAutoArchiveMemosRequest, opts ...grpc.CallOption) (*PreviewAutoArchiveMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
-	// DeleteMemo deletes a memo.
+	// DeleteMemo moves a memo to the trash.
+	// Memos in the trash are purged after the trash retention of the instance.
 	DeleteMemo(ctx context.Context, in *DeleteMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// PurgeMemo permanently deletes a memo with its attachments, relations, reactions, revisions
+	// and comments.
+	PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoRevisions lists the revisions of a memo, newest first, with their diffs.
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo with its content.
+	GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error)
+	// RestoreMemoRevision restores the content of a memo to a revision, which creates a new revision.
+	// The attachments of the revision that still exist are attached to the memo again.
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error)
+	// CreateMemoShareLink creates a link that shares a memo read-only with anyone who has it,
+	// whatever the visibility of the memo, until it expires, runs out of views or is revoked.
+	CreateMemoShareLink(ctx context.Context, in *CreateMemoShareLinkRequest, opts ...grpc.CallOption) (*MemoShareLink, error)
+	// ListMemoShareLinks lists the share links of a memo, newest first.
+	ListMemoShareLinks(ctx context.Context, in *ListMemoShareLinksRequest, opts ...grpc.CallOption) (*ListMemoShareLinksResponse, error)
+	// UpdateMemoShareLink updates a share link. Only the passphrase can be changed, which signs out
+	// everyone who entered the previous one.
+	UpdateMemoShareLink(ctx context.Context, in *UpdateMemoShareLinkRequest, opts ...grpc.CallOption) (*MemoShareLink, error)
+	// DeleteMemoShareLink revokes a share link.
+	DeleteMemoShareLink(ctx context.Context, in *DeleteMemoShareLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSharedMemo gets the memo of a share link, which counts a view of the link. The links that
+	// expired, ran out of views or were revoked are not found. The links with a passphrase are
+	// unauthenticated without an access token from UnlockSharedMemo.
+	GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// UnlockSharedMemo checks the passphrase of a share link and returns a short-lived access token
+	// to the memo of the link. Failed attempts are throttled per link.
+	UnlockSharedMemo(ctx context.Context, in *UnlockSharedMemoRequest, opts ...grpc.CallOption) (*UnlockSharedMemoResponse, error)
+	// DuplicateMemo creates a private copy of a memo for the current user, with the same content
+	// and attachments. The copied attachments share the files of the memo's attachments.
+	// Duplicating a memo of another user adds a reference to the memo.
+	DuplicateMemo(ctx context.Context, in *DuplicateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// MergeMemos merges a source memo into a target memo of the current user. The source content is
+	// appended to the target, its attachments, reactions and relations move to the target, and the
+	// source is moved to the trash with a merge relation to the target.
+	MergeMemos(ctx context.Context, in *MergeMemosRequest, opts ...grpc.CallOption) (*Memo, error)
+	// CreateMemoFromWikiLink creates the memo a missing [[wiki link]] of a memo of the current user
+	// links to, a private memo titled with the target, and resolves the link to it.
+	CreateMemoFromWikiLink(ctx context.Context, in *CreateMemoFromWikiLinkRequest, opts ...grpc.CallOption) (*Memo, error)
+	// UpdateMemoTask checks or unchecks a task list item of a memo, without rewriting the rest of
+	// its content.
+	UpdateMemoTask(ctx context.Context, in *UpdateMemoTaskRequest, opts ...grpc.CallOption) (*UpdateMemoTaskResponse, error)
+	// MovePinnedMemo moves a pinned memo up, down or to a position among the pinned memos of its
+	// creator, which are listed in that order before the other memos when ordered by pinned.
+	MovePinnedMemo(ctx context.Context, in *MovePinnedMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// SnoozeMemoReminder moves the reminder of a memo to a later time.
+	SnoozeMemoReminder(ctx context.Context, in *SnoozeMemoReminderRequest, opts ...grpc.CallOption) (*Memo, error)
+	// CompleteMemoReminder clears the reminder of a memo, including its next occurrences.
+	CompleteMemoReminder(ctx context.Context, in *CompleteMemoReminderRequest, opts ...grpc.CallOption) (*Memo, error)
+	// CreateBookmarkMemo creates a memo bookmarking a link, with the title, description and image
+	// of the link fetched like link previews. The memo is still created, with the link alone, if
+	// the metadata can't be fetched. Bookmarking a link again shortly after returns the memo that
+	// was created instead of creating another one.
+	CreateBookmarkMemo(ctx context.Context, in *CreateBookmarkMemoRequest, opts ...grpc.CallOption) (*CreateBookmarkMemoResponse, error)
+	// ListTagTree lists the tags of the memos of a user as a tree, e.g. "project" with the
+	// subtags "project/alpha" and "project/beta", with the number of memos of each tag.
+	ListTagTree(ctx context.Context, in *ListTagTreeRequest, opts ...grpc.CallOption) (*ListTagTreeResponse, error)
+	// RenameMemoTag renames a tag in all memos of the current user.
+	// Renaming to an existing tag merges the two tags.
+	RenameMemoTag(ctx context.Context, in *RenameMemoTagRequest, opts ...grpc.CallOption) (*RenameMemoTagResponse, error)
+	// BatchUpdateMemos applies one operation to up to 100 memos in a single transaction. Each memo
+	// is checked as with the single-memo endpoints, and the memos that fail the checks are reported
+	// without failing the others. Each changed memo dispatches its own webhook event, as if it was
+	// changed by the single-memo endpoint.
+	BatchUpdateMemos(ctx context.Context, in *BatchUpdateMemosRequest, opts ...grpc.CallOption) (*BatchUpdateMemosResponse, error)
+	// ImportMemos starts importing the memos of a zip archive of markdown files, e.g. an Obsidian
+	// vault, of a Google Keep Takeout archive, or of a Day One export, as memos of the current
+	// user. The import runs in the background, and its progress is returned by GetMemoImport.
+	ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*MemoImport, error)
+	// GetMemoImport gets the progress of an import of the current user.
+	GetMemoImport(ctx context.Context, in *GetMemoImportRequest, opts ...grpc.CallOption) (*MemoImport, error)
 	// SetMemoAttachments sets attachments for a memo.
 	SetMemoAttachments(ctx context.Context, in *SetMemoAttachmentsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoAttachments lists attachments for a memo.
@@ -58,6 +167,8 @@ type MemoServiceClient interface {
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(ctx context.Context, in *ListMemoRelationsRequest, opts ...grpc.CallOption) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos referencing a memo.
+	ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -98,6 +209,36 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest,
 	return out, nil
 }
 
+func (c *memoServiceClient) GetMemoHighlights(ctx context.Context, in *GetMemoHighlightsRequest, opts ...grpc.CallOption) (*GetMemoHighlightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoHighlightsResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoHighlights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoCounts(ctx context.Context, in *GetMemoCountsRequest, opts ...grpc.CallOption) (*GetMemoCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoCountsResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) PreviewAutoArchiveMemos(ctx context.Context, in *PreviewAutoArchiveMemosRequest, opts ...grpc.CallOption) (*PreviewAutoArchiveMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewAutoArchiveMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_PreviewAutoArchiveMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -128,6 +269,246 @@ func (c *memoServiceClient) DeleteMemo(ctx context.Context, in *DeleteMemoReques
 	return out, nil
 }
 
+func (c *memoServiceClient) RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_PurgeMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRevision)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShareLink(ctx context.Context, in *CreateMemoShareLinkRequest, opts ...grpc.CallOption) (*MemoShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShareLink)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoShareLinks(ctx context.Context, in *ListMemoShareLinksRequest, opts ...grpc.CallOption) (*ListMemoShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoShareLinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) UpdateMemoShareLink(ctx context.Context, in *UpdateMemoShareLinkRequest, opts ...grpc.CallOption) (*MemoShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShareLink)
+	err := c.cc.Invoke(ctx, MemoService_UpdateMemoShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoShareLink(ctx context.Context, in *DeleteMemoShareLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_GetSharedMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) UnlockSharedMemo(ctx context.Context, in *UnlockSharedMemoRequest, opts ...grpc.CallOption) (*UnlockSharedMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockSharedMemoResponse)
+	err := c.cc.Invoke(ctx, MemoService_UnlockSharedMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DuplicateMemo(ctx context.Context, in *DuplicateMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_DuplicateMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) MergeMemos(ctx context.Context, in *MergeMemosRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_MergeMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoFromWikiLink(ctx context.Context, in *CreateMemoFromWikiLinkRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoFromWikiLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) UpdateMemoTask(ctx context.Context, in *UpdateMemoTaskRequest, opts ...grpc.CallOption) (*UpdateMemoTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemoTaskResponse)
+	err := c.cc.Invoke(ctx, MemoService_UpdateMemoTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) MovePinnedMemo(ctx context.Context, in *MovePinnedMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_MovePinnedMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SnoozeMemoReminder(ctx context.Context, in *SnoozeMemoReminderRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_SnoozeMemoReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CompleteMemoReminder(ctx context.Context, in *CompleteMemoReminderRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_CompleteMemoReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateBookmarkMemo(ctx context.Context, in *CreateBookmarkMemoRequest, opts ...grpc.CallOption) (*CreateBookmarkMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookmarkMemoResponse)
+	err := c.cc.Invoke(ctx, MemoService_CreateBookmarkMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListTagTree(ctx context.Context, in *ListTagTreeRequest, opts ...grpc.CallOption) (*ListTagTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagTreeResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTagTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RenameMemoTag(ctx context.Context, in *RenameMemoTagRequest, opts ...grpc.CallOption) (*RenameMemoTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameMemoTagResponse)
+	err := c.cc.Invoke(ctx, MemoService_RenameMemoTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) BatchUpdateMemos(ctx context.Context, in *BatchUpdateMemosRequest, opts ...grpc.CallOption) (*BatchUpdateMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_BatchUpdateMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*MemoImport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoImport)
+	err := c.cc.Invoke(ctx, MemoService_ImportMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoImport(ctx context.Context, in *GetMemoImportRequest, opts ...grpc.CallOption) (*MemoImport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoImport)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) SetMemoAttachments(ctx context.Context, in *SetMemoAttachmentsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -168,6 +549,16 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, in *ListMemoR
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoBacklinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoBacklinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -226,12 +617,93 @@ type MemoServiceServer interface {
 	CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
+	// GetMemoHighlights gets memos of the current user to rediscover: the memos created on the same
+	// day in previous years, and a random sample of memos older than 90 days.
+	GetMemoHighlights(context.Context, *GetMemoHighlightsRequest) (*GetMemoHighlightsResponse, error)
+	// GetMemoCounts gets the number of memos of the current user in each state.
+	GetMemoCounts(context.Context, *GetMemoCountsRequest) (*GetMemoCountsResponse, error)
+	// PreviewAutoArchiveMemos lists the memos of the current user that the auto-archive policy
+	// would archive, with the saved policy or the one of the request.
+	PreviewAutoArchiveMemos(context.Context, *PreviewAutoArchiveMemosRequest) (*PreviewAutoArchiveMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error)
-	// DeleteMemo deletes a memo.
+	// DeleteMemo moves a memo to the trash.
+	// Memos in the trash are purged after the trash retention of the instance.
 	DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error)
+	// PurgeMemo permanently deletes a memo with its attachments, relations, reactions, revisions
+	// and comments.
+	PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error)
+	// ListMemoRevisions lists the revisions of a memo, newest first, with their diffs.
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo with its content.
+	GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error)
+	// RestoreMemoRevision restores the content of a memo to a revision, which creates a new revision.
+	// The attachments of the revision that still exist are attached to the memo again.
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error)
+	// CreateMemoShareLink creates a link that shares a memo read-only with anyone who has it,
+	// whatever the visibility of the memo, until it expires, runs out of views or is revoked.
+	CreateMemoShareLink(context.Context, *CreateMemoShareLinkRequest) (*MemoShareLink, error)
+	// ListMemoShareLinks lists the share links of a memo, newest first.
+	ListMemoShareLinks(context.Context, *ListMemoShareLinksRequest) (*ListMemoShareLinksResponse, error)
+	// UpdateMemoShareLink updates a share link. Only the passphrase can be changed, which signs out
+	// everyone who entered the previous one.
+	UpdateMemoShareLink(context.Context, *UpdateMemoShareLinkRequest) (*MemoShareLink, error)
+	// DeleteMemoShareLink revokes a share link.
+	DeleteMemoShareLink(context.Context, *DeleteMemoShareLinkRequest) (*emptypb.Empty, error)
+	// GetSharedMemo gets the memo of a share link, which counts a view of the link. The links that
+	// expired, ran out of views or were revoked are not found. The links with a passphrase are
+	// unauthenticated without an access token from UnlockSharedMemo.
+	GetSharedMemo(context.Context, *GetSharedMemoRequest) (*Memo, error)
+	// UnlockSharedMemo checks the passphrase of a share link and returns a short-lived access token
+	// to the memo of the link. Failed attempts are throttled per link.
+	UnlockSharedMemo(context.Context, *UnlockSharedMemoRequest) (*UnlockSharedMemoResponse, error)
+	// DuplicateMemo creates a private copy of a memo for the current user, with the same content
+	// and attachments. The copied attachments share the files of the memo's attachments.
+	// Duplicating a memo of another user adds a reference to the memo.
+	DuplicateMemo(context.Context, *DuplicateMemoRequest) (*Memo, error)
+	// MergeMemos merges a source memo into a target memo of the current user. The source content is
+	// appended to the target, its attachments, reactions and relations move to the target, and the
+	// source is moved to the trash with a merge relation to the target.
+	MergeMemos(context.Context, *MergeMemosRequest) (*Memo, error)
+	// CreateMemoFromWikiLink creates the memo a missing [[wiki link]] of a memo of the current user
+	// links to, a private memo titled with the target, and resolves the link to it.
+	CreateMemoFromWikiLink(context.Context, *CreateMemoFromWikiLinkRequest) (*Memo, error)
+	// UpdateMemoTask checks or unchecks a task list item of a memo, without rewriting the rest of
+	// its content.
+	UpdateMemoTask(context.Context, *UpdateMemoTaskRequest) (*UpdateMemoTaskResponse, error)
+	// MovePinnedMemo moves a pinned memo up, down or to a position among the pinned memos of its
+	// creator, which are listed in that order before the other memos when ordered by pinned.
+	MovePinnedMemo(context.Context, *MovePinnedMemoRequest) (*Memo, error)
+	// SnoozeMemoReminder moves the reminder of a memo to a later time.
+	SnoozeMemoReminder(context.Context, *SnoozeMemoReminderRequest) (*Memo, error)
+	// CompleteMemoReminder clears the reminder of a memo, including its next occurrences.
+	CompleteMemoReminder(context.Context, *CompleteMemoReminderRequest) (*Memo, error)
+	// CreateBookmarkMemo creates a memo bookmarking a link, with the title, description and image
+	// of the link fetched like link previews. The memo is still created, with the link alone, if
+	// the metadata can't be fetched. Bookmarking a link again shortly after returns the memo that
+	// was created instead of creating another one.
+	CreateBookmarkMemo(context.Context, *CreateBookmarkMemoRequest) (*CreateBookmarkMemoResponse, error)
+	// ListTagTree lists the tags of the memos of a user as a tree, e.g. "project" with the
+	// subtags "project/alpha" and "project/beta", with the number of memos of each tag.
+	ListTagTree(context.Context, *ListTagTreeRequest) (*ListTagTreeResponse, error)
+	// RenameMemoTag renames a tag in all memos of the current user.
+	// Renaming to an existing tag merges the two tags.
+	RenameMemoTag(context.Context, *RenameMemoTagRequest) (*RenameMemoTagResponse, error)
+	// BatchUpdateMemos applies one operation to up to 100 memos in a single transaction. Each memo
+	// is checked as with the single-memo endpoints, and the memos that fail the checks are reported
+	// without failing the others. Each changed memo dispatches its own webhook event, as if it was
+	// changed by the single-memo endpoint.
+	BatchUpdateMemos(context.Context, *BatchUpdateMemosRequest) (*BatchUpdateMemosResponse, error)
+	// ImportMemos starts importing the memos of a zip archive of markdown files, e.g. an Obsidian
+	// vault, of a Google Keep Takeout archive, or of a Day One export, as memos of the current
+	// user. The import runs in the background, and its progress is returned by GetMemoImport.
+	ImportMemos(context.Context, *ImportMemosRequest) (*MemoImport, error)
+	// GetMemoImport gets the progress of an import of the current user.
+	GetMemoImport(context.Context, *GetMemoImportRequest) (*MemoImport, error)
 	// SetMemoAttachments sets attachments for a memo.
 	SetMemoAttachments(context.Context, *SetMemoAttachmentsRequest) (*emptypb.Empty, error)
 	// ListMemoAttachments lists attachments for a memo.
@@ -240,6 +712,8 @@ type MemoServiceServer interface {
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos referencing a memo.
+	ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -266,6 +740,15 @@ func (UnimplementedMemoServiceServer) CreateMemo(context.Context, *CreateMemoReq
 func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) GetMemoHighlights(context.Context, *GetMemoHighlightsRequest) (*GetMemoHighlightsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoHighlights not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoCounts(context.Context, *GetMemoCountsRequest) (*GetMemoCountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoCounts not implemented")
+}
+func (UnimplementedMemoServiceServer) PreviewAutoArchiveMemos(context.Context, *PreviewAutoArchiveMemosRequest) (*PreviewAutoArchiveMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewAutoArchiveMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMemo not implemented")
 }
@@ -275,6 +758,78 @@ func (UnimplementedMemoServiceServer) UpdateMemo(context.Context, *UpdateMemoReq
 func (UnimplementedMemoServiceServer) DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShareLink(context.Context, *CreateMemoShareLinkRequest) (*MemoShareLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoShareLinks(context.Context, *ListMemoShareLinksRequest) (*ListMemoShareLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoShareLinks not implemented")
+}
+func (UnimplementedMemoServiceServer) UpdateMemoShareLink(context.Context, *UpdateMemoShareLinkRequest) (*MemoShareLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoShareLink(context.Context, *DeleteMemoShareLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) GetSharedMemo(context.Context, *GetSharedMemoRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) UnlockSharedMemo(context.Context, *UnlockSharedMemoRequest) (*UnlockSharedMemoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockSharedMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) DuplicateMemo(context.Context, *DuplicateMemoRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) MergeMemos(context.Context, *MergeMemosRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoFromWikiLink(context.Context, *CreateMemoFromWikiLinkRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMemoFromWikiLink not implemented")
+}
+func (UnimplementedMemoServiceServer) UpdateMemoTask(context.Context, *UpdateMemoTaskRequest) (*UpdateMemoTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemoTask not implemented")
+}
+func (UnimplementedMemoServiceServer) MovePinnedMemo(context.Context, *MovePinnedMemoRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method MovePinnedMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) SnoozeMemoReminder(context.Context, *SnoozeMemoReminderRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnoozeMemoReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) CompleteMemoReminder(context.Context, *CompleteMemoReminderRequest) (*Memo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteMemoReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateBookmarkMemo(context.Context, *CreateBookmarkMemoRequest) (*CreateBookmarkMemoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBookmarkMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) ListTagTree(context.Context, *ListTagTreeRequest) (*ListTagTreeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTagTree not implemented")
+}
+func (UnimplementedMemoServiceServer) RenameMemoTag(context.Context, *RenameMemoTagRequest) (*RenameMemoTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameMemoTag not implemented")
+}
+func (UnimplementedMemoServiceServer) BatchUpdateMemos(context.Context, *BatchUpdateMemosRequest) (*BatchUpdateMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ImportMemos(context.Context, *ImportMemosRequest) (*MemoImport, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoImport(context.Context, *GetMemoImportRequest) (*MemoImport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMemoImport not implemented")
+}
 func (UnimplementedMemoServiceServer) SetMemoAttachments(context.Context, *SetMemoAttachmentsRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetMemoAttachments not implemented")
 }
@@ -287,6 +842,9 @@ func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemo
 func (UnimplementedMemoServiceServer) ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMemoRelations not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMemoBacklinks not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateMemoComment not implemented")
 }
@@ -359,67 +917,553 @@ func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMemoRequest)
+func _MemoService_GetMemoHighlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoHighlightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).GetMemo(ctx, in)
+		return srv.(MemoServiceServer).GetMemoHighlights(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_GetMemo_FullMethodName,
+		FullMethod: MemoService_GetMemoHighlights_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).GetMemo(ctx, req.(*GetMemoRequest))
+		return srv.(MemoServiceServer).GetMemoHighlights(ctx, req.(*GetMemoHighlightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_UpdateMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMemoRequest)
+func _MemoService_GetMemoCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoCountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).UpdateMemo(ctx, in)
+		return srv.(MemoServiceServer).GetMemoCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_UpdateMemo_FullMethodName,
+		FullMethod: MemoService_GetMemoCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).UpdateMemo(ctx, req.(*UpdateMemoRequest))
+		return srv.(MemoServiceServer).GetMemoCounts(ctx, req.(*GetMemoCountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_DeleteMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMemoRequest)
+func _MemoService_PreviewAutoArchiveMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewAutoArchiveMemosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).DeleteMemo(ctx, in)
+		return srv.(MemoServiceServer).PreviewAutoArchiveMemos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MemoService_DeleteMemo_FullMethodName,
+		FullMethod: MemoService_PreviewAutoArchiveMemos_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MemoServiceServer).DeleteMemo(ctx, req.(*DeleteMemoRequest))
+		return srv.(MemoServiceServer).PreviewAutoArchiveMemos(ctx, req.(*PreviewAutoArchiveMemosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MemoService_SetMemoAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMemoAttachmentsRequest)
+func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MemoServiceServer).SetMemoAttachments(ctx, in)
+		return srv.(MemoServiceServer).GetMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemo(ctx, req.(*GetMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpdateMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpdateMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpdateMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpdateMemo(ctx, req.(*UpdateMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemo(ctx, req.(*DeleteMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, req.(*RestoreMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_PurgeMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_PurgeMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, req.(*PurgeMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, req.(*GetMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShareLink(ctx, req.(*CreateMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShareLinks(ctx, req.(*ListMemoShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpdateMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpdateMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpdateMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpdateMemoShareLink(ctx, req.(*UpdateMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoShareLink(ctx, req.(*DeleteMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetSharedMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetSharedMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, req.(*GetSharedMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UnlockSharedMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockSharedMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UnlockSharedMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UnlockSharedMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UnlockSharedMemo(ctx, req.(*UnlockSharedMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DuplicateMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DuplicateMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DuplicateMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DuplicateMemo(ctx, req.(*DuplicateMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_MergeMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).MergeMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_MergeMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).MergeMemos(ctx, req.(*MergeMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoFromWikiLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoFromWikiLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoFromWikiLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoFromWikiLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoFromWikiLink(ctx, req.(*CreateMemoFromWikiLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_UpdateMemoTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).UpdateMemoTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_UpdateMemoTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).UpdateMemoTask(ctx, req.(*UpdateMemoTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_MovePinnedMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePinnedMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).MovePinnedMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_MovePinnedMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).MovePinnedMemo(ctx, req.(*MovePinnedMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SnoozeMemoReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeMemoReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SnoozeMemoReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SnoozeMemoReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SnoozeMemoReminder(ctx, req.(*SnoozeMemoReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CompleteMemoReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMemoReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CompleteMemoReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CompleteMemoReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CompleteMemoReminder(ctx, req.(*CompleteMemoReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateBookmarkMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookmarkMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateBookmarkMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateBookmarkMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateBookmarkMemo(ctx, req.(*CreateBookmarkMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListTagTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTagTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTagTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTagTree(ctx, req.(*ListTagTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RenameMemoTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameMemoTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RenameMemoTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RenameMemoTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RenameMemoTag(ctx, req.(*RenameMemoTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_BatchUpdateMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).BatchUpdateMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_BatchUpdateMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).BatchUpdateMemos(ctx, req.(*BatchUpdateMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ImportMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ImportMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ImportMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ImportMemos(ctx, req.(*ImportMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoImport(ctx, req.(*GetMemoImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetMemoAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemoAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetMemoAttachments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -485,6 +1529,24 @@ func _MemoService_ListMemoRelations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoBacklinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, req.(*ListMemoBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemoCommentRequest)
 	if err := dec(in); err != nil {
@@ -590,6 +1652,18 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemos",
 			Handler:    _MemoService_ListMemos_Handler,
 		},
+		{
+			MethodName: "GetMemoHighlights",
+			Handler:    _MemoService_GetMemoHighlights_Handler,
+		},
+		{
+			MethodName: "GetMemoCounts",
+			Handler:    _MemoService_GetMemoCounts_Handler,
+		},
+		{
+			MethodName: "PreviewAutoArchiveMemos",
+			Handler:    _MemoService_PreviewAutoArchiveMemos_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _MemoService_GetMemo_Handler,
@@ -602,6 +1676,102 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemo",
 			Handler:    _MemoService_DeleteMemo_Handler,
 		},
+		{
+			MethodName: "RestoreMemo",
+			Handler:    _MemoService_RestoreMemo_Handler,
+		},
+		{
+			MethodName: "PurgeMemo",
+			Handler:    _MemoService_PurgeMemo_Handler,
+		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevision",
+			Handler:    _MemoService_GetMemoRevision_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
+		{
+			MethodName: "CreateMemoShareLink",
+			Handler:    _MemoService_CreateMemoShareLink_Handler,
+		},
+		{
+			MethodName: "ListMemoShareLinks",
+			Handler:    _MemoService_ListMemoShareLinks_Handler,
+		},
+		{
+			MethodName: "UpdateMemoShareLink",
+			Handler:    _MemoService_UpdateMemoShareLink_Handler,
+		},
+		{
+			MethodName: "DeleteMemoShareLink",
+			Handler:    _MemoService_DeleteMemoShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedMemo",
+			Handler:    _MemoService_GetSharedMemo_Handler,
+		},
+		{
+			MethodName: "UnlockSharedMemo",
+			Handler:    _MemoService_UnlockSharedMemo_Handler,
+		},
+		{
+			MethodName: "DuplicateMemo",
+			Handler:    _MemoService_DuplicateMemo_Handler,
+		},
+		{
+			MethodName: "MergeMemos",
+			Handler:    _MemoService_MergeMemos_Handler,
+		},
+		{
+			MethodName: "CreateMemoFromWikiLink",
+			Handler:    _MemoService_CreateMemoFromWikiLink_Handler,
+		},
+		{
+			MethodName: "UpdateMemoTask",
+			Handler:    _MemoService_UpdateMemoTask_Handler,
+		},
+		{
+			MethodName: "MovePinnedMemo",
+			Handler:    _MemoService_MovePinnedMemo_Handler,
+		},
+		{
+			MethodName: "SnoozeMemoReminder",
+			Handler:    _MemoService_SnoozeMemoReminder_Handler,
+		},
+		{
+			MethodName: "CompleteMemoReminder",
+			Handler:    _MemoService_CompleteMemoReminder_Handler,
+		},
+		{
+			MethodName: "CreateBookmarkMemo",
+			Handler:    _MemoService_CreateBookmarkMemo_Handler,
+		},
+		{
+			MethodName: "ListTagTree",
+			Handler:    _MemoService_ListTagTree_Handler,
+		},
+		{
+			MethodName: "RenameMemoTag",
+			Handler:    _MemoService_RenameMemoTag_Handler,
+		},
+		{
+			MethodName: "BatchUpdateMemos",
+			Handler:    _MemoService_BatchUpdateMemos_Handler,
+		},
+		{
+			MethodName: "ImportMemos",
+			Handler:    _MemoService_ImportMemos_Handler,
+		},
+		{
+			MethodName: "GetMemoImport",
+			Handler:    _MemoService_GetMemoImport_Handler,
+		},
 		{
 			MethodName: "SetMemoAttachments",
 			Handler:    _MemoService_SetMemoAttachments_Handler,
@@ -618,6 +1788,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoRelations",
 			Handler:    _MemoService_ListMemoRelations_Handler,
 		},
+		{
+			MethodName: "ListMemoBacklinks",
+			Handler:    _MemoService_ListMemoBacklinks_Handler,
+		},
 		{
 			MethodName: "CreateMemoComment",
 			Handler:    _MemoService_CreateMemoComment_Handler,
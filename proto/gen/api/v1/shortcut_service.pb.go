@@ -32,7 +32,10 @@ type Shortcut struct {
 	// The title of the shortcut.
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	// The filter expression for the shortcut.
-	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The order of the memos of the shortcut, in the syntax of ListMemosRequest.order_by.
+	// Empty for the default order of memo lists.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -88,6 +91,13 @@ func (x *Shortcut) GetFilter() string {
 	return ""
 }
 
+func (x *Shortcut) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListShortcutsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource where shortcuts are listed.
@@ -389,15 +399,163 @@ func (x *DeleteShortcutRequest) GetName() string {
 	return ""
 }
 
+type ReorderShortcutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user of the shortcuts.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The resource names of all the shortcuts of the user, in their new order.
+	Names         []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderShortcutsRequest) Reset() {
+	*x = ReorderShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderShortcutsRequest) ProtoMessage() {}
+
+func (x *ReorderShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReorderShortcutsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ReorderShortcutsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type CountShortcutMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user of the shortcuts.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountShortcutMemosRequest) Reset() {
+	*x = CountShortcutMemosRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountShortcutMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountShortcutMemosRequest) ProtoMessage() {}
+
+func (x *CountShortcutMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountShortcutMemosRequest.ProtoReflect.Descriptor instead.
+func (*CountShortcutMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CountShortcutMemosRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type CountShortcutMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The numbers of memos of the shortcuts, by the resource names of the shortcuts.
+	// The memos are those of the user listed on the home page. Counts are capped at 1000.
+	MemoCounts    map[string]int32 `protobuf:"bytes,1,rep,name=memo_counts,json=memoCounts,proto3" json:"memo_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountShortcutMemosResponse) Reset() {
+	*x = CountShortcutMemosResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountShortcutMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountShortcutMemosResponse) ProtoMessage() {}
+
+func (x *CountShortcutMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountShortcutMemosResponse.ProtoReflect.Descriptor instead.
+func (*CountShortcutMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CountShortcutMemosResponse) GetMemoCounts() map[string]int32 {
+	if x != nil {
+		return x.MemoCounts
+	}
+	return nil
+}
+
 var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/shortcut_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xaf\x01\n" +
+	"\x1dapi/v1/shortcut_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xcf\x01\n" +
 	"\bShortcut\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1b\n" +
-	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter:R\xeaAO\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x1e\n" +
+	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy:R\xeaAO\n" +
 	"\x15memos.api.v1/Shortcut\x12!users/{user}/shortcuts/{shortcut}*\tshortcuts2\bshortcut\"M\n" +
 	"\x14ListShortcutsRequest\x125\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/ShortcutR\x06parent\"M\n" +
@@ -416,13 +574,26 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"updateMask\"J\n" +
 	"\x15DeleteShortcutRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15memos.api.v1/ShortcutR\x04name2\xde\x05\n" +
+	"\x15memos.api.v1/ShortcutR\x04name\"k\n" +
+	"\x17ReorderShortcutsRequest\x125\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/ShortcutR\x06parent\x12\x19\n" +
+	"\x05names\x18\x02 \x03(\tB\x03\xe0A\x02R\x05names\"R\n" +
+	"\x19CountShortcutMemosRequest\x125\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/ShortcutR\x06parent\"\xb6\x01\n" +
+	"\x1aCountShortcutMemosResponse\x12Y\n" +
+	"\vmemo_counts\x18\x01 \x03(\v28.memos.api.v1.CountShortcutMemosResponse.MemoCountsEntryR\n" +
+	"memoCounts\x1a=\n" +
+	"\x0fMemoCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\xaf\b\n" +
 	"\x0fShortcutService\x12\x8d\x01\n" +
 	"\rListShortcuts\x12\".memos.api.v1.ListShortcutsRequest\x1a#.memos.api.v1.ListShortcutsResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=users/*}/shortcuts\x12z\n" +
 	"\vGetShortcut\x12 .memos.api.v1.GetShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=users/*/shortcuts/*}\x12\x95\x01\n" +
 	"\x0eCreateShortcut\x12#.memos.api.v1.CreateShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"F\xdaA\x0fparent,shortcut\x82\xd3\xe4\x93\x02.:\bshortcut\"\"/api/v1/{parent=users/*}/shortcuts\x12\xa3\x01\n" +
 	"\x0eUpdateShortcut\x12#.memos.api.v1.UpdateShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"T\xdaA\x14shortcut,update_mask\x82\xd3\xe4\x93\x027:\bshortcut2+/api/v1/{shortcut.name=users/*/shortcuts/*}\x12\x80\x01\n" +
-	"\x0eDeleteShortcut\x12#.memos.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=users/*/shortcuts/*}B\xac\x01\n" +
+	"\x0eDeleteShortcut\x12#.memos.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=users/*/shortcuts/*}\x12\xa4\x01\n" +
+	"\x10ReorderShortcuts\x12%.memos.api.v1.ReorderShortcutsRequest\x1a#.memos.api.v1.ListShortcutsResponse\"D\xdaA\fparent,names\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{parent=users/*}/shortcuts:reorder\x12\xa7\x01\n" +
+	"\x12CountShortcutMemos\x12'.memos.api.v1.CountShortcutMemosRequest\x1a(.memos.api.v1.CountShortcutMemosResponse\">\xdaA\x06parent\x82\xd3\xe4\x93\x02/\x12-/api/v1/{parent=users/*}/shortcuts:countMemosB\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14ShortcutServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -437,38 +608,47 @@ func file_api_v1_shortcut_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_shortcut_service_proto_rawDescData
 }
 
-var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_api_v1_shortcut_service_proto_goTypes = []any{
-	(*Shortcut)(nil),              // 0: memos.api.v1.Shortcut
-	(*ListShortcutsRequest)(nil),  // 1: memos.api.v1.ListShortcutsRequest
-	(*ListShortcutsResponse)(nil), // 2: memos.api.v1.ListShortcutsResponse
-	(*GetShortcutRequest)(nil),    // 3: memos.api.v1.GetShortcutRequest
-	(*CreateShortcutRequest)(nil), // 4: memos.api.v1.CreateShortcutRequest
-	(*UpdateShortcutRequest)(nil), // 5: memos.api.v1.UpdateShortcutRequest
-	(*DeleteShortcutRequest)(nil), // 6: memos.api.v1.DeleteShortcutRequest
-	(*fieldmaskpb.FieldMask)(nil), // 7: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(*Shortcut)(nil),                   // 0: memos.api.v1.Shortcut
+	(*ListShortcutsRequest)(nil),       // 1: memos.api.v1.ListShortcutsRequest
+	(*ListShortcutsResponse)(nil),      // 2: memos.api.v1.ListShortcutsResponse
+	(*GetShortcutRequest)(nil),         // 3: memos.api.v1.GetShortcutRequest
+	(*CreateShortcutRequest)(nil),      // 4: memos.api.v1.CreateShortcutRequest
+	(*UpdateShortcutRequest)(nil),      // 5: memos.api.v1.UpdateShortcutRequest
+	(*DeleteShortcutRequest)(nil),      // 6: memos.api.v1.DeleteShortcutRequest
+	(*ReorderShortcutsRequest)(nil),    // 7: memos.api.v1.ReorderShortcutsRequest
+	(*CountShortcutMemosRequest)(nil),  // 8: memos.api.v1.CountShortcutMemosRequest
+	(*CountShortcutMemosResponse)(nil), // 9: memos.api.v1.CountShortcutMemosResponse
+	nil,                                // 10: memos.api.v1.CountShortcutMemosResponse.MemoCountsEntry
+	(*fieldmaskpb.FieldMask)(nil),      // 11: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),              // 12: google.protobuf.Empty
 }
 var file_api_v1_shortcut_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.ListShortcutsResponse.shortcuts:type_name -> memos.api.v1.Shortcut
-	0, // 1: memos.api.v1.CreateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
-	0, // 2: memos.api.v1.UpdateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
-	7, // 3: memos.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1, // 4: memos.api.v1.ShortcutService.ListShortcuts:input_type -> memos.api.v1.ListShortcutsRequest
-	3, // 5: memos.api.v1.ShortcutService.GetShortcut:input_type -> memos.api.v1.GetShortcutRequest
-	4, // 6: memos.api.v1.ShortcutService.CreateShortcut:input_type -> memos.api.v1.CreateShortcutRequest
-	5, // 7: memos.api.v1.ShortcutService.UpdateShortcut:input_type -> memos.api.v1.UpdateShortcutRequest
-	6, // 8: memos.api.v1.ShortcutService.DeleteShortcut:input_type -> memos.api.v1.DeleteShortcutRequest
-	2, // 9: memos.api.v1.ShortcutService.ListShortcuts:output_type -> memos.api.v1.ListShortcutsResponse
-	0, // 10: memos.api.v1.ShortcutService.GetShortcut:output_type -> memos.api.v1.Shortcut
-	0, // 11: memos.api.v1.ShortcutService.CreateShortcut:output_type -> memos.api.v1.Shortcut
-	0, // 12: memos.api.v1.ShortcutService.UpdateShortcut:output_type -> memos.api.v1.Shortcut
-	8, // 13: memos.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.ListShortcutsResponse.shortcuts:type_name -> memos.api.v1.Shortcut
+	0,  // 1: memos.api.v1.CreateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
+	0,  // 2: memos.api.v1.UpdateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
+	11, // 3: memos.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 4: memos.api.v1.CountShortcutMemosResponse.memo_counts:type_name -> memos.api.v1.CountShortcutMemosResponse.MemoCountsEntry
+	1,  // 5: memos.api.v1.ShortcutService.ListShortcuts:input_type -> memos.api.v1.ListShortcutsRequest
+	3,  // 6: memos.api.v1.ShortcutService.GetShortcut:input_type -> memos.api.v1.GetShortcutRequest
+	4,  // 7: memos.api.v1.ShortcutService.CreateShortcut:input_type -> memos.api.v1.CreateShortcutRequest
+	5,  // 8: memos.api.v1.ShortcutService.UpdateShortcut:input_type -> memos.api.v1.UpdateShortcutRequest
+	6,  // 9: memos.api.v1.ShortcutService.DeleteShortcut:input_type -> memos.api.v1.DeleteShortcutRequest
+	7,  // 10: memos.api.v1.ShortcutService.ReorderShortcuts:input_type -> memos.api.v1.ReorderShortcutsRequest
+	8,  // 11: memos.api.v1.ShortcutService.CountShortcutMemos:input_type -> memos.api.v1.CountShortcutMemosRequest
+	2,  // 12: memos.api.v1.ShortcutService.ListShortcuts:output_type -> memos.api.v1.ListShortcutsResponse
+	0,  // 13: memos.api.v1.ShortcutService.GetShortcut:output_type -> memos.api.v1.Shortcut
+	0,  // 14: memos.api.v1.ShortcutService.CreateShortcut:output_type -> memos.api.v1.Shortcut
+	0,  // 15: memos.api.v1.ShortcutService.UpdateShortcut:output_type -> memos.api.v1.Shortcut
+	12, // 16: memos.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
+	2,  // 17: memos.api.v1.ShortcutService.ReorderShortcuts:output_type -> memos.api.v1.ListShortcutsResponse
+	9,  // 18: memos.api.v1.ShortcutService.CountShortcutMemos:output_type -> memos.api.v1.CountShortcutMemosResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_shortcut_service_proto_init() }
@@ -482,7 +662,7 @@ func file_api_v1_shortcut_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_shortcut_service_proto_rawDesc), len(file_api_v1_shortcut_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
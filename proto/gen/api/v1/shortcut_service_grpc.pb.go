@@ -20,11 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName  = "/memos.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName    = "/memos.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_CreateShortcut_FullMethodName = "/memos.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName = "/memos.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName = "/memos.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_ListShortcuts_FullMethodName      = "/memos.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_GetShortcut_FullMethodName        = "/memos.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_CreateShortcut_FullMethodName     = "/memos.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName     = "/memos.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_DeleteShortcut_FullMethodName     = "/memos.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_ReorderShortcuts_FullMethodName   = "/memos.api.v1.ShortcutService/ReorderShortcuts"
+	ShortcutService_CountShortcutMemos_FullMethodName = "/memos.api.v1.ShortcutService/CountShortcutMemos"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -41,6 +43,10 @@ type ShortcutServiceClient interface {
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// DeleteShortcut deletes a shortcut for a user.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReorderShortcuts sets the order of the shortcuts of a user.
+	ReorderShortcuts(ctx context.Context, in *ReorderShortcutsRequest, opts ...grpc.CallOption) (*ListShortcutsResponse, error)
+	// CountShortcutMemos counts the memos of each shortcut of a user, without listing them.
+	CountShortcutMemos(ctx context.Context, in *CountShortcutMemosRequest, opts ...grpc.CallOption) (*CountShortcutMemosResponse, error)
 }
 
 type shortcutServiceClient struct {
@@ -101,6 +107,26 @@ func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ReorderShortcuts(ctx context.Context, in *ReorderShortcutsRequest, opts ...grpc.CallOption) (*ListShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ReorderShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CountShortcutMemos(ctx context.Context, in *CountShortcutMemosRequest, opts ...grpc.CallOption) (*CountShortcutMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountShortcutMemosResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_CountShortcutMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShortcutServiceServer is the server API for ShortcutService service.
 // All implementations must embed UnimplementedShortcutServiceServer
 // for forward compatibility.
@@ -115,6 +141,10 @@ type ShortcutServiceServer interface {
 	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
 	// DeleteShortcut deletes a shortcut for a user.
 	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
+	// ReorderShortcuts sets the order of the shortcuts of a user.
+	ReorderShortcuts(context.Context, *ReorderShortcutsRequest) (*ListShortcutsResponse, error)
+	// CountShortcutMemos counts the memos of each shortcut of a user, without listing them.
+	CountShortcutMemos(context.Context, *CountShortcutMemosRequest) (*CountShortcutMemosResponse, error)
 	mustEmbedUnimplementedShortcutServiceServer()
 }
 
@@ -140,6 +170,12 @@ func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *Updat
 func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) ReorderShortcuts(context.Context, *ReorderShortcutsRequest) (*ListShortcutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) CountShortcutMemos(context.Context, *CountShortcutMemosRequest) (*CountShortcutMemosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CountShortcutMemos not implemented")
+}
 func (UnimplementedShortcutServiceServer) mustEmbedUnimplementedShortcutServiceServer() {}
 func (UnimplementedShortcutServiceServer) testEmbeddedByValue()                         {}
 
@@ -251,6 +287,42 @@ func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ReorderShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ReorderShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ReorderShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ReorderShortcuts(ctx, req.(*ReorderShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CountShortcutMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountShortcutMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CountShortcutMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CountShortcutMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CountShortcutMemos(ctx, req.(*CountShortcutMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShortcutService_ServiceDesc is the grpc.ServiceDesc for ShortcutService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -278,6 +350,14 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShortcut",
 			Handler:    _ShortcutService_DeleteShortcut_Handler,
 		},
+		{
+			MethodName: "ReorderShortcuts",
+			Handler:    _ShortcutService_ReorderShortcuts_Handler,
+		},
+		{
+			MethodName: "CountShortcutMemos",
+			Handler:    _ShortcutService_CountShortcutMemos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/shortcut_service.proto",
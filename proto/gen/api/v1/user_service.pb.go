@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -95,6 +96,14 @@ const (
 	UserSetting_ACCESS_TOKENS UserSetting_Key = 3
 	// WEBHOOKS is the key for user webhooks.
 	UserSetting_WEBHOOKS UserSetting_Key = 4
+	// AUTO_ARCHIVE is the key for the auto-archive policy of the user.
+	UserSetting_AUTO_ARCHIVE UserSetting_Key = 5
+	// STORAGE is the key for the storage usage and quota of the user.
+	UserSetting_STORAGE UserSetting_Key = 6
+	// MEMO_QUOTA is the key for the memo counts and quotas of the user.
+	UserSetting_MEMO_QUOTA UserSetting_Key = 7
+	// NOTIFICATION is the key for the notification preferences of the user.
+	UserSetting_NOTIFICATION UserSetting_Key = 8
 )
 
 // Enum value maps for UserSetting_Key.
@@ -105,6 +114,10 @@ var (
 		2: "SESSIONS",
 		3: "ACCESS_TOKENS",
 		4: "WEBHOOKS",
+		5: "AUTO_ARCHIVE",
+		6: "STORAGE",
+		7: "MEMO_QUOTA",
+		8: "NOTIFICATION",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
@@ -112,6 +125,10 @@ var (
 		"SESSIONS":        2,
 		"ACCESS_TOKENS":   3,
 		"WEBHOOKS":        4,
+		"AUTO_ARCHIVE":    5,
+		"STORAGE":         6,
+		"MEMO_QUOTA":      7,
+		"NOTIFICATION":    8,
 	}
 )
 
@@ -139,7 +156,220 @@ func (x UserSetting_Key) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserSetting_Key.Descriptor instead.
 func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type UserSetting_NotificationSetting_DigestFrequency int32
+
+const (
+	// No digest is delivered.
+	UserSetting_NotificationSetting_DIGEST_FREQUENCY_UNSPECIFIED UserSetting_NotificationSetting_DigestFrequency = 0
+	// A digest of the previous day is delivered every day.
+	UserSetting_NotificationSetting_DAILY UserSetting_NotificationSetting_DigestFrequency = 1
+	// A digest of the previous week is delivered every week.
+	UserSetting_NotificationSetting_WEEKLY UserSetting_NotificationSetting_DigestFrequency = 2
+)
+
+// Enum value maps for UserSetting_NotificationSetting_DigestFrequency.
+var (
+	UserSetting_NotificationSetting_DigestFrequency_name = map[int32]string{
+		0: "DIGEST_FREQUENCY_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	UserSetting_NotificationSetting_DigestFrequency_value = map[string]int32{
+		"DIGEST_FREQUENCY_UNSPECIFIED": 0,
+		"DAILY":                        1,
+		"WEEKLY":                       2,
+	}
+)
+
+func (x UserSetting_NotificationSetting_DigestFrequency) Enum() *UserSetting_NotificationSetting_DigestFrequency {
+	p := new(UserSetting_NotificationSetting_DigestFrequency)
+	*p = x
+	return p
+}
+
+func (x UserSetting_NotificationSetting_DigestFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSetting_NotificationSetting_DigestFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+}
+
+func (UserSetting_NotificationSetting_DigestFrequency) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[2]
+}
+
+func (x UserSetting_NotificationSetting_DigestFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSetting_NotificationSetting_DigestFrequency.Descriptor instead.
+func (UserSetting_NotificationSetting_DigestFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 5, 0}
+}
+
+type UserWebhook_Format int32
+
+const (
+	UserWebhook_FORMAT_UNSPECIFIED UserWebhook_Format = 0
+	// The memos event payload, with the event in its activityType field. It's the default.
+	UserWebhook_RAW UserWebhook_Format = 1
+	// A Slack incoming webhook message, for Slack webhook URLs.
+	UserWebhook_SLACK UserWebhook_Format = 2
+	// A Discord webhook message with an embed, for Discord webhook URLs.
+	UserWebhook_DISCORD UserWebhook_Format = 3
+)
+
+// Enum value maps for UserWebhook_Format.
+var (
+	UserWebhook_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "RAW",
+		2: "SLACK",
+		3: "DISCORD",
+	}
+	UserWebhook_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"RAW":                1,
+		"SLACK":              2,
+		"DISCORD":            3,
+	}
+)
+
+func (x UserWebhook_Format) Enum() *UserWebhook_Format {
+	p := new(UserWebhook_Format)
+	*p = x
+	return p
+}
+
+func (x UserWebhook_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhook_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+}
+
+func (UserWebhook_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[3]
+}
+
+func (x UserWebhook_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhook_Format.Descriptor instead.
+func (UserWebhook_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{66, 0}
+}
+
+type UserWebhook_Scope int32
+
+const (
+	UserWebhook_SCOPE_UNSPECIFIED UserWebhook_Scope = 0
+	// The webhook of a user, sent for the events of the memos of the user.
+	UserWebhook_USER UserWebhook_Scope = 1
+	// A workspace webhook, sent for the events of the memos of all users.
+	UserWebhook_WORKSPACE UserWebhook_Scope = 2
+)
+
+// Enum value maps for UserWebhook_Scope.
+var (
+	UserWebhook_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "USER",
+		2: "WORKSPACE",
+	}
+	UserWebhook_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"USER":              1,
+		"WORKSPACE":         2,
+	}
+)
+
+func (x UserWebhook_Scope) Enum() *UserWebhook_Scope {
+	p := new(UserWebhook_Scope)
+	*p = x
+	return p
+}
+
+func (x UserWebhook_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhook_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[4].Descriptor()
+}
+
+func (UserWebhook_Scope) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[4]
+}
+
+func (x UserWebhook_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhook_Scope.Descriptor instead.
+func (UserWebhook_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{66, 1}
+}
+
+type UserWebhookDelivery_State int32
+
+const (
+	UserWebhookDelivery_STATE_UNSPECIFIED UserWebhookDelivery_State = 0
+	// The delivery is waiting for its next attempt.
+	UserWebhookDelivery_PENDING UserWebhookDelivery_State = 1
+	// The webhook accepted the delivery.
+	UserWebhookDelivery_SUCCEEDED UserWebhookDelivery_State = 2
+	// All the attempts of the delivery failed.
+	UserWebhookDelivery_FAILED UserWebhookDelivery_State = 3
+)
+
+// Enum value maps for UserWebhookDelivery_State.
+var (
+	UserWebhookDelivery_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	UserWebhookDelivery_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x UserWebhookDelivery_State) Enum() *UserWebhookDelivery_State {
+	p := new(UserWebhookDelivery_State)
+	*p = x
+	return p
+}
+
+func (x UserWebhookDelivery_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhookDelivery_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[5].Descriptor()
+}
+
+func (UserWebhookDelivery_State) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[5]
+}
+
+func (x UserWebhookDelivery_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhookDelivery_State.Descriptor instead.
+func (UserWebhookDelivery_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{67, 0}
 }
 
 type UserNotification_Status int32
@@ -175,11 +405,11 @@ func (x UserNotification_Status) String() string {
 }
 
 func (UserNotification_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[6].Descriptor()
 }
 
 func (UserNotification_Status) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[2]
+	return &file_api_v1_user_service_proto_enumTypes[6]
 }
 
 func (x UserNotification_Status) Number() protoreflect.EnumNumber {
@@ -188,7 +418,7 @@ func (x UserNotification_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Status.Descriptor instead.
 func (UserNotification_Status) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{79, 0}
 }
 
 type UserNotification_Type int32
@@ -196,6 +426,19 @@ type UserNotification_Type int32
 const (
 	UserNotification_TYPE_UNSPECIFIED UserNotification_Type = 0
 	UserNotification_MEMO_COMMENT     UserNotification_Type = 1
+	UserNotification_MEMO_REMINDER    UserNotification_Type = 2
+	// Summary of the memos archived by the auto-archive policy.
+	UserNotification_MEMO_AUTO_ARCHIVE UserNotification_Type = 3
+	// A webhook was disabled after too many consecutive failed deliveries.
+	UserNotification_WEBHOOK_DISABLED UserNotification_Type = 4
+	// A backup of the instance failed.
+	UserNotification_BACKUP_FAILED UserNotification_Type = 5
+	// A reaction to a memo of the user.
+	UserNotification_MEMO_REACTION UserNotification_Type = 6
+	// A memo mentioning the user with @username.
+	UserNotification_MEMO_MENTION UserNotification_Type = 7
+	// A digest summarizing the activity of the user.
+	UserNotification_DIGEST UserNotification_Type = 8
 )
 
 // Enum value maps for UserNotification_Type.
@@ -203,10 +446,24 @@ var (
 	UserNotification_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "MEMO_REMINDER",
+		3: "MEMO_AUTO_ARCHIVE",
+		4: "WEBHOOK_DISABLED",
+		5: "BACKUP_FAILED",
+		6: "MEMO_REACTION",
+		7: "MEMO_MENTION",
+		8: "DIGEST",
 	}
 	UserNotification_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"MEMO_REMINDER":     2,
+		"MEMO_AUTO_ARCHIVE": 3,
+		"WEBHOOK_DISABLED":  4,
+		"BACKUP_FAILED":     5,
+		"MEMO_REACTION":     6,
+		"MEMO_MENTION":      7,
+		"DIGEST":            8,
 	}
 )
 
@@ -221,11 +478,11 @@ func (x UserNotification_Type) String() string {
 }
 
 func (UserNotification_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[7].Descriptor()
 }
 
 func (UserNotification_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[3]
+	return &file_api_v1_user_service_proto_enumTypes[7]
 }
 
 func (x UserNotification_Type) Number() protoreflect.EnumNumber {
@@ -234,7 +491,7 @@ func (x UserNotification_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Type.Descriptor instead.
 func (UserNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31, 1}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{79, 1}
 }
 
 type User struct {
@@ -261,7 +518,9 @@ type User struct {
 	// Output only. The creation timestamp.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. The last update timestamp.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. The time the user was suspended by the host, unset if the user isn't suspended.
+	SuspendTime   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=suspend_time,json=suspendTime,proto3" json:"suspend_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -373,6 +632,13 @@ func (x *User) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetSuspendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendTime
+	}
+	return nil
+}
+
 type ListUsersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional. The maximum number of users to return.
@@ -522,7 +788,6 @@ type GetUserRequest struct {
 	// Supports both numeric IDs and username strings:
 	//   - users/{id}       (e.g., users/101)
 	//   - users/{username} (e.g., users/steven)
-	//
 	// Format: users/{id_or_username}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Optional. The fields to return in the response.
@@ -907,6 +1172,174 @@ func (x *GetUserStatsRequest) GetName() string {
 	return ""
 }
 
+// The writing statistics of a user, computed from the memos that aren't comments, scheduled or in
+// the trash. Days and months are in the time zone of the user. The statistics are cached for a few
+// minutes, so they may not include the latest edits.
+type UserWritingStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the user whose stats these are.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of memos.
+	TotalMemoCount int32 `protobuf:"varint,2,opt,name=total_memo_count,json=totalMemoCount,proto3" json:"total_memo_count,omitempty"`
+	// The number of words of the memos. The words are separated by whitespace, and each CJK
+	// character counts as a word.
+	TotalWordCount int64 `protobuf:"varint,3,opt,name=total_word_count,json=totalWordCount,proto3" json:"total_word_count,omitempty"`
+	// The average number of characters of the memos.
+	AverageMemoLength float64 `protobuf:"fixed64,4,opt,name=average_memo_length,json=averageMemoLength,proto3" json:"average_memo_length,omitempty"`
+	// The number of memos by tag.
+	TagMemoCounts map[string]int32 `protobuf:"bytes,5,rep,name=tag_memo_counts,json=tagMemoCounts,proto3" json:"tag_memo_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The number of consecutive days with memos, up to today or yesterday.
+	CurrentStreakDays int32 `protobuf:"varint,6,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"`
+	// The largest number of consecutive days with memos.
+	LongestStreakDays int32 `protobuf:"varint,7,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	// The number of memos by month, ordered by month.
+	MonthCounts   []*UserWritingStats_MonthCount `protobuf:"bytes,8,rep,name=month_counts,json=monthCounts,proto3" json:"month_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWritingStats) Reset() {
+	*x = UserWritingStats{}
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWritingStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWritingStats) ProtoMessage() {}
+
+func (x *UserWritingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWritingStats.ProtoReflect.Descriptor instead.
+func (*UserWritingStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserWritingStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWritingStats) GetTotalMemoCount() int32 {
+	if x != nil {
+		return x.TotalMemoCount
+	}
+	return 0
+}
+
+func (x *UserWritingStats) GetTotalWordCount() int64 {
+	if x != nil {
+		return x.TotalWordCount
+	}
+	return 0
+}
+
+func (x *UserWritingStats) GetAverageMemoLength() float64 {
+	if x != nil {
+		return x.AverageMemoLength
+	}
+	return 0
+}
+
+func (x *UserWritingStats) GetTagMemoCounts() map[string]int32 {
+	if x != nil {
+		return x.TagMemoCounts
+	}
+	return nil
+}
+
+func (x *UserWritingStats) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *UserWritingStats) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *UserWritingStats) GetMonthCounts() []*UserWritingStats_MonthCount {
+	if x != nil {
+		return x.MonthCounts
+	}
+	return nil
+}
+
+type GetUserWritingStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Whether the archived memos are counted.
+	IncludeArchived bool `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserWritingStatsRequest) Reset() {
+	*x = GetUserWritingStatsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserWritingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserWritingStatsRequest) ProtoMessage() {}
+
+func (x *GetUserWritingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserWritingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserWritingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserWritingStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUserWritingStatsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type ListAllUserStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -915,7 +1348,7 @@ type ListAllUserStatsRequest struct {
 
 func (x *ListAllUserStatsRequest) Reset() {
 	*x = ListAllUserStatsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -927,7 +1360,7 @@ func (x *ListAllUserStatsRequest) String() string {
 func (*ListAllUserStatsRequest) ProtoMessage() {}
 
 func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,7 +1373,7 @@ func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllUserStatsRequest.ProtoReflect.Descriptor instead.
 func (*ListAllUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 type ListAllUserStatsResponse struct {
@@ -953,7 +1386,7 @@ type ListAllUserStatsResponse struct {
 
 func (x *ListAllUserStatsResponse) Reset() {
 	*x = ListAllUserStatsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1398,7 @@ func (x *ListAllUserStatsResponse) String() string {
 func (*ListAllUserStatsResponse) ProtoMessage() {}
 
 func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1411,7 @@ func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllUserStatsResponse.ProtoReflect.Descriptor instead.
 func (*ListAllUserStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListAllUserStatsResponse) GetStats() []*UserStats {
@@ -1001,6 +1434,10 @@ type UserSetting struct {
 	//	*UserSetting_SessionsSetting_
 	//	*UserSetting_AccessTokensSetting_
 	//	*UserSetting_WebhooksSetting_
+	//	*UserSetting_AutoArchiveSetting_
+	//	*UserSetting_StorageSetting_
+	//	*UserSetting_MemoQuotaSetting_
+	//	*UserSetting_NotificationSetting_
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1008,7 +1445,7 @@ type UserSetting struct {
 
 func (x *UserSetting) Reset() {
 	*x = UserSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1020,7 +1457,7 @@ func (x *UserSetting) String() string {
 func (*UserSetting) ProtoMessage() {}
 
 func (x *UserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1033,7 +1470,7 @@ func (x *UserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UserSetting) GetName() string {
@@ -1086,15 +1523,51 @@ func (x *UserSetting) GetWebhooksSetting() *UserSetting_WebhooksSetting {
 	return nil
 }
 
-type isUserSetting_Value interface {
-	isUserSetting_Value()
+func (x *UserSetting) GetAutoArchiveSetting() *UserSetting_AutoArchiveSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AutoArchiveSetting_); ok {
+			return x.AutoArchiveSetting
+		}
+	}
+	return nil
 }
 
-type UserSetting_GeneralSetting_ struct {
-	GeneralSetting *UserSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+func (x *UserSetting) GetStorageSetting() *UserSetting_StorageSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_StorageSetting_); ok {
+			return x.StorageSetting
+		}
+	}
+	return nil
 }
 
-type UserSetting_SessionsSetting_ struct {
+func (x *UserSetting) GetMemoQuotaSetting() *UserSetting_MemoQuotaSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MemoQuotaSetting_); ok {
+			return x.MemoQuotaSetting
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNotificationSetting() *UserSetting_NotificationSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_NotificationSetting_); ok {
+			return x.NotificationSetting
+		}
+	}
+	return nil
+}
+
+type isUserSetting_Value interface {
+	isUserSetting_Value()
+}
+
+type UserSetting_GeneralSetting_ struct {
+	GeneralSetting *UserSetting_GeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+}
+
+type UserSetting_SessionsSetting_ struct {
 	SessionsSetting *UserSetting_SessionsSetting `protobuf:"bytes,3,opt,name=sessions_setting,json=sessionsSetting,proto3,oneof"`
 }
 
@@ -1106,6 +1579,22 @@ type UserSetting_WebhooksSetting_ struct {
 	WebhooksSetting *UserSetting_WebhooksSetting `protobuf:"bytes,5,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
 }
 
+type UserSetting_AutoArchiveSetting_ struct {
+	AutoArchiveSetting *UserSetting_AutoArchiveSetting `protobuf:"bytes,6,opt,name=auto_archive_setting,json=autoArchiveSetting,proto3,oneof"`
+}
+
+type UserSetting_StorageSetting_ struct {
+	StorageSetting *UserSetting_StorageSetting `protobuf:"bytes,7,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+}
+
+type UserSetting_MemoQuotaSetting_ struct {
+	MemoQuotaSetting *UserSetting_MemoQuotaSetting `protobuf:"bytes,8,opt,name=memo_quota_setting,json=memoQuotaSetting,proto3,oneof"`
+}
+
+type UserSetting_NotificationSetting_ struct {
+	NotificationSetting *UserSetting_NotificationSetting `protobuf:"bytes,9,opt,name=notification_setting,json=notificationSetting,proto3,oneof"`
+}
+
 func (*UserSetting_GeneralSetting_) isUserSetting_Value() {}
 
 func (*UserSetting_SessionsSetting_) isUserSetting_Value() {}
@@ -1114,6 +1603,14 @@ func (*UserSetting_AccessTokensSetting_) isUserSetting_Value() {}
 
 func (*UserSetting_WebhooksSetting_) isUserSetting_Value() {}
 
+func (*UserSetting_AutoArchiveSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_StorageSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_MemoQuotaSetting_) isUserSetting_Value() {}
+
+func (*UserSetting_NotificationSetting_) isUserSetting_Value() {}
+
 type GetUserSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user setting.
@@ -1125,7 +1622,7 @@ type GetUserSettingRequest struct {
 
 func (x *GetUserSettingRequest) Reset() {
 	*x = GetUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1137,7 +1634,7 @@ func (x *GetUserSettingRequest) String() string {
 func (*GetUserSettingRequest) ProtoMessage() {}
 
 func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1647,7 @@ func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetUserSettingRequest) GetName() string {
@@ -1172,7 +1669,7 @@ type UpdateUserSettingRequest struct {
 
 func (x *UpdateUserSettingRequest) Reset() {
 	*x = UpdateUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1184,7 +1681,7 @@ func (x *UpdateUserSettingRequest) String() string {
 func (*UpdateUserSettingRequest) ProtoMessage() {}
 
 func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,7 +1694,7 @@ func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateUserSettingRequest) GetSetting() *UserSetting {
@@ -1234,7 +1731,7 @@ type ListUserSettingsRequest struct {
 
 func (x *ListUserSettingsRequest) Reset() {
 	*x = ListUserSettingsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1246,7 +1743,7 @@ func (x *ListUserSettingsRequest) String() string {
 func (*ListUserSettingsRequest) ProtoMessage() {}
 
 func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,7 +1756,7 @@ func (x *ListUserSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSettingsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListUserSettingsRequest) GetParent() string {
@@ -1299,7 +1796,7 @@ type ListUserSettingsResponse struct {
 
 func (x *ListUserSettingsResponse) Reset() {
 	*x = ListUserSettingsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1311,7 +1808,7 @@ func (x *ListUserSettingsResponse) String() string {
 func (*ListUserSettingsResponse) ProtoMessage() {}
 
 func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,7 +1821,7 @@ func (x *ListUserSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSettingsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListUserSettingsResponse) GetSettings() []*UserSetting {
@@ -1361,14 +1858,23 @@ type UserAccessToken struct {
 	// Output only. The issued timestamp.
 	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
 	// Optional. The expiration timestamp.
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Optional. The scopes granted to the access token: "read", "write" and "admin".
+	// Empty means full access.
+	Scopes []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Output only. The timestamp when the access token was last used.
+	// Updated at most once per hour; unset if never used.
+	LastUsedTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// Output only. A truncated form of the access token, e.g. "eyJhbGci...x1YzQ2Nw",
+	// for matching entries to tokens in use.
+	TokenPrefix   string `protobuf:"bytes,8,opt,name=token_prefix,json=tokenPrefix,proto3" json:"token_prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserAccessToken) Reset() {
 	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1380,7 +1886,7 @@ func (x *UserAccessToken) String() string {
 func (*UserAccessToken) ProtoMessage() {}
 
 func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,7 +1899,7 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
 func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UserAccessToken) GetName() string {
@@ -1431,6 +1937,27 @@ func (x *UserAccessToken) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserAccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *UserAccessToken) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *UserAccessToken) GetTokenPrefix() string {
+	if x != nil {
+		return x.TokenPrefix
+	}
+	return ""
+}
+
 type ListUserAccessTokensRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource whose access tokens will be listed.
@@ -1446,7 +1973,7 @@ type ListUserAccessTokensRequest struct {
 
 func (x *ListUserAccessTokensRequest) Reset() {
 	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1458,7 +1985,7 @@ func (x *ListUserAccessTokensRequest) String() string {
 func (*ListUserAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +1998,7 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListUserAccessTokensRequest) GetParent() string {
@@ -1509,7 +2036,7 @@ type ListUserAccessTokensResponse struct {
 
 func (x *ListUserAccessTokensResponse) Reset() {
 	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1521,7 +2048,7 @@ func (x *ListUserAccessTokensResponse) String() string {
 func (*ListUserAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +2061,7 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
@@ -1573,7 +2100,7 @@ type CreateUserAccessTokenRequest struct {
 
 func (x *CreateUserAccessTokenRequest) Reset() {
 	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1585,7 +2112,7 @@ func (x *CreateUserAccessTokenRequest) String() string {
 func (*CreateUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,7 +2125,7 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateUserAccessTokenRequest) GetParent() string {
@@ -1633,7 +2160,7 @@ type DeleteUserAccessTokenRequest struct {
 
 func (x *DeleteUserAccessTokenRequest) Reset() {
 	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1645,7 +2172,7 @@ func (x *DeleteUserAccessTokenRequest) String() string {
 func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,7 +2185,7 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteUserAccessTokenRequest) GetName() string {
@@ -1678,17 +2205,19 @@ type UserSession struct {
 	// The timestamp when the session was created.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// The timestamp when the session was last accessed.
-	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+	// Used for sliding expiration calculation (last_accessed_time + the session idle timeout).
 	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
 	// Client information associated with this session.
-	ClientInfo    *UserSession_ClientInfo `protobuf:"bytes,5,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	ClientInfo *UserSession_ClientInfo `protobuf:"bytes,5,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	// Whether this is the session making the request.
+	Current       bool `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserSession) Reset() {
 	*x = UserSession{}
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1700,7 +2229,7 @@ func (x *UserSession) String() string {
 func (*UserSession) ProtoMessage() {}
 
 func (x *UserSession) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,7 +2242,7 @@ func (x *UserSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
 func (*UserSession) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UserSession) GetName() string {
@@ -1751,6 +2280,13 @@ func (x *UserSession) GetClientInfo() *UserSession_ClientInfo {
 	return nil
 }
 
+func (x *UserSession) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
 type ListUserSessionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the parent.
@@ -1762,7 +2298,7 @@ type ListUserSessionsRequest struct {
 
 func (x *ListUserSessionsRequest) Reset() {
 	*x = ListUserSessionsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1774,7 +2310,7 @@ func (x *ListUserSessionsRequest) String() string {
 func (*ListUserSessionsRequest) ProtoMessage() {}
 
 func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,7 +2323,7 @@ func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListUserSessionsRequest) GetParent() string {
@@ -1807,7 +2343,7 @@ type ListUserSessionsResponse struct {
 
 func (x *ListUserSessionsResponse) Reset() {
 	*x = ListUserSessionsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1819,7 +2355,7 @@ func (x *ListUserSessionsResponse) String() string {
 func (*ListUserSessionsResponse) ProtoMessage() {}
 
 func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,7 +2368,7 @@ func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListUserSessionsResponse) GetSessions() []*UserSession {
@@ -1853,7 +2389,7 @@ type RevokeUserSessionRequest struct {
 
 func (x *RevokeUserSessionRequest) Reset() {
 	*x = RevokeUserSessionRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1865,7 +2401,7 @@ func (x *RevokeUserSessionRequest) String() string {
 func (*RevokeUserSessionRequest) ProtoMessage() {}
 
 func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,7 +2414,7 @@ func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeUserSessionRequest.ProtoReflect.Descriptor instead.
 func (*RevokeUserSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RevokeUserSessionRequest) GetName() string {
@@ -1888,39 +2424,30 @@ func (x *RevokeUserSessionRequest) GetName() string {
 	return ""
 }
 
-// UserWebhook represents a webhook owned by a user.
-type UserWebhook struct {
+type RevokeOtherUserSessionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook.
-	// Format: users/{user}/webhooks/{webhook}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The URL to send the webhook to.
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	// Optional. Human-readable name for the webhook.
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// The creation time of the webhook.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The last update time of the webhook.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Required. The resource name of the parent.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserWebhook) Reset() {
-	*x = UserWebhook{}
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+func (x *RevokeOtherUserSessionsRequest) Reset() {
+	*x = RevokeOtherUserSessionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserWebhook) String() string {
+func (x *RevokeOtherUserSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserWebhook) ProtoMessage() {}
+func (*RevokeOtherUserSessionsRequest) ProtoMessage() {}
 
-func (x *UserWebhook) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+func (x *RevokeOtherUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1931,70 +2458,116 @@ func (x *UserWebhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
-func (*UserWebhook) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RevokeOtherUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UserWebhook) GetName() string {
+func (x *RevokeOtherUserSessionsRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *UserWebhook) GetUrl() string {
+// UserTwoFactor is the TOTP two-factor authentication status of a user.
+type UserTwoFactor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether two-factor authentication is enabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The timestamp when two-factor authentication was enabled.
+	EnableTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enable_time,json=enableTime,proto3" json:"enable_time,omitempty"`
+	// The number of unused recovery codes.
+	RecoveryCodesRemaining int32 `protobuf:"varint,4,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserTwoFactor) Reset() {
+	*x = UserTwoFactor{}
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTwoFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTwoFactor) ProtoMessage() {}
+
+func (x *UserTwoFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	if x != nil {
-		return x.Url
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserWebhook) GetDisplayName() string {
+// Deprecated: Use UserTwoFactor.ProtoReflect.Descriptor instead.
+func (*UserTwoFactor) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UserTwoFactor) GetName() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+func (x *UserTwoFactor) GetEnabled() bool {
 	if x != nil {
-		return x.CreateTime
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+func (x *UserTwoFactor) GetEnableTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateTime
+		return x.EnableTime
 	}
 	return nil
 }
 
-type ListUserWebhooksRequest struct {
+func (x *UserTwoFactor) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+type GetUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksRequest) Reset() {
-	*x = ListUserWebhooksRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[26]
+func (x *GetUserTwoFactorRequest) Reset() {
+	*x = GetUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksRequest) String() string {
+func (x *GetUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksRequest) ProtoMessage() {}
+func (*GetUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[26]
+func (x *GetUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2005,41 +2578,42 @@ func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListUserWebhooksRequest) GetParent() string {
+func (x *GetUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-type ListUserWebhooksResponse struct {
+type EnrollUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of webhooks.
-	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserWebhooksResponse) Reset() {
-	*x = ListUserWebhooksResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+func (x *EnrollUserTwoFactorRequest) Reset() {
+	*x = EnrollUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserWebhooksResponse) String() string {
+func (x *EnrollUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserWebhooksResponse) ProtoMessage() {}
+func (*EnrollUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+func (x *EnrollUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,44 +2624,2654 @@ func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
-func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use EnrollUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnrollUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+func (x *EnrollUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.Webhooks
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateUserWebhookRequest struct {
+type EnrollUserTwoFactorResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The webhook to create.
-	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The base32-encoded TOTP secret, for entering into an authenticator app manually.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The otpauth:// URI of the secret, usually shown as a QR code.
+	OtpauthUri    string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserWebhookRequest) Reset() {
-	*x = CreateUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+func (x *EnrollUserTwoFactorResponse) Reset() {
+	*x = EnrollUserTwoFactorResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserWebhookRequest) String() string {
+func (x *EnrollUserTwoFactorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserWebhookRequest) ProtoMessage() {}
+func (*EnrollUserTwoFactorResponse) ProtoMessage() {}
 
-func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+func (x *EnrollUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnrollUserTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EnrollUserTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollUserTwoFactorResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmUserTwoFactorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. A 6-digit code from the authenticator app.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmUserTwoFactorRequest) Reset() {
+	*x = ConfirmUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmUserTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmUserTwoFactorRequest) ProtoMessage() {}
+
+func (x *ConfirmUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfirmUserTwoFactorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfirmUserTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmUserTwoFactorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One-time recovery codes for signing in without the authenticator app.
+	// They are only shown once.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmUserTwoFactorResponse) Reset() {
+	*x = ConfirmUserTwoFactorResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmUserTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmUserTwoFactorResponse) ProtoMessage() {}
+
+func (x *ConfirmUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmUserTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfirmUserTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DeleteUserTwoFactorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A 6-digit code or a recovery code.
+	// Required when disabling your own two-factor authentication; admins resetting another user's leave it empty.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserTwoFactorRequest) Reset() {
+	*x = DeleteUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserTwoFactorRequest) ProtoMessage() {}
+
+func (x *DeleteUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteUserTwoFactorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteUserTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// UserPasskey is a WebAuthn passkey registered by a user.
+type UserPasskey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the passkey.
+	// Format: users/{user}/passkeys/{passkey}, where passkey is the base64url-encoded credential ID.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The user-supplied label, e.g. "MacBook".
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Transport hints reported by the authenticator, e.g. "internal" or "usb".
+	Transports []string `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	// The timestamp when the passkey was registered.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The timestamp when the passkey was last used to sign in.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPasskey) Reset() {
+	*x = UserPasskey{}
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPasskey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPasskey) ProtoMessage() {}
+
+func (x *UserPasskey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPasskey.ProtoReflect.Descriptor instead.
+func (*UserPasskey) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UserPasskey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPasskey) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UserPasskey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *UserPasskey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserPasskey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type ListUserPasskeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose passkeys will be listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPasskeysRequest) Reset() {
+	*x = ListUserPasskeysRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPasskeysRequest) ProtoMessage() {}
+
+func (x *ListUserPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListUserPasskeysRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserPasskeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of passkeys.
+	Passkeys      []*UserPasskey `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPasskeysResponse) Reset() {
+	*x = ListUserPasskeysResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPasskeysResponse) ProtoMessage() {}
+
+func (x *ListUserPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListUserPasskeysResponse) GetPasskeys() []*UserPasskey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+type CreateUserPasskeyOptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource who will register the passkey.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserPasskeyOptionsRequest) Reset() {
+	*x = CreateUserPasskeyOptionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserPasskeyOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserPasskeyOptionsRequest) ProtoMessage() {}
+
+func (x *CreateUserPasskeyOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserPasskeyOptionsRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserPasskeyOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateUserPasskeyOptionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// UserPasskeyOptions holds the WebAuthn creation options for a passkey registration.
+type UserPasskeyOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The challenge to sign. It expires after 5 minutes.
+	Challenge []byte `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The relying party ID, derived from the instance URL.
+	RpId string `protobuf:"bytes,2,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// The relying party display name.
+	RpName string `protobuf:"bytes,3,opt,name=rp_name,json=rpName,proto3" json:"rp_name,omitempty"`
+	// The user handle stored in the passkey.
+	UserHandle []byte `protobuf:"bytes,4,opt,name=user_handle,json=userHandle,proto3" json:"user_handle,omitempty"`
+	// The username.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// The user's display name.
+	DisplayName string `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// IDs of the user's existing passkeys, so an authenticator isn't registered twice.
+	ExcludeCredentialIds [][]byte `protobuf:"bytes,7,rep,name=exclude_credential_ids,json=excludeCredentialIds,proto3" json:"exclude_credential_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UserPasskeyOptions) Reset() {
+	*x = UserPasskeyOptions{}
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPasskeyOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPasskeyOptions) ProtoMessage() {}
+
+func (x *UserPasskeyOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPasskeyOptions.ProtoReflect.Descriptor instead.
+func (*UserPasskeyOptions) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UserPasskeyOptions) GetChallenge() []byte {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *UserPasskeyOptions) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *UserPasskeyOptions) GetRpName() string {
+	if x != nil {
+		return x.RpName
+	}
+	return ""
+}
+
+func (x *UserPasskeyOptions) GetUserHandle() []byte {
+	if x != nil {
+		return x.UserHandle
+	}
+	return nil
+}
+
+func (x *UserPasskeyOptions) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserPasskeyOptions) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserPasskeyOptions) GetExcludeCredentialIds() [][]byte {
+	if x != nil {
+		return x.ExcludeCredentialIds
+	}
+	return nil
+}
+
+type CreateUserPasskeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource who will register the passkey.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The user-supplied label of the passkey.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Required. The credential ID (PublicKeyCredential.rawId).
+	CredentialId []byte `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// Required. The client data JSON (AuthenticatorAttestationResponse.clientDataJSON).
+	ClientDataJson []byte `protobuf:"bytes,4,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	// Required. The authenticator data (AuthenticatorAttestationResponse.getAuthenticatorData()).
+	AuthenticatorData []byte `protobuf:"bytes,5,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
+	// Required. The DER-encoded public key (AuthenticatorAttestationResponse.getPublicKey()).
+	PublicKey []byte `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Required. The COSE algorithm of the public key (AuthenticatorAttestationResponse.getPublicKeyAlgorithm()).
+	PublicKeyAlgorithm int64 `protobuf:"varint,7,opt,name=public_key_algorithm,json=publicKeyAlgorithm,proto3" json:"public_key_algorithm,omitempty"`
+	// Optional. The transports (AuthenticatorAttestationResponse.getTransports()).
+	Transports    []string `protobuf:"bytes,8,rep,name=transports,proto3" json:"transports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserPasskeyRequest) Reset() {
+	*x = CreateUserPasskeyRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserPasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserPasskeyRequest) ProtoMessage() {}
+
+func (x *CreateUserPasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserPasskeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserPasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateUserPasskeyRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserPasskeyRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateUserPasskeyRequest) GetCredentialId() []byte {
+	if x != nil {
+		return x.CredentialId
+	}
+	return nil
+}
+
+func (x *CreateUserPasskeyRequest) GetClientDataJson() []byte {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return nil
+}
+
+func (x *CreateUserPasskeyRequest) GetAuthenticatorData() []byte {
+	if x != nil {
+		return x.AuthenticatorData
+	}
+	return nil
+}
+
+func (x *CreateUserPasskeyRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *CreateUserPasskeyRequest) GetPublicKeyAlgorithm() int64 {
+	if x != nil {
+		return x.PublicKeyAlgorithm
+	}
+	return 0
+}
+
+func (x *CreateUserPasskeyRequest) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+type DeleteUserPasskeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the passkey to delete.
+	// Format: users/{user}/passkeys/{passkey}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserPasskeyRequest) Reset() {
+	*x = DeleteUserPasskeyRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPasskeyRequest) ProtoMessage() {}
+
+func (x *DeleteUserPasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserPasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteUserPasskeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UserPushSubscription is the Web Push subscription of a device of a user.
+type UserPushSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the push subscription.
+	// Format: users/{user}/pushSubscriptions/{push_subscription}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The user-supplied label of the device, e.g. "Phone".
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The host of the push service of the subscription, e.g. "fcm.googleapis.com".
+	PushService string `protobuf:"bytes,3,opt,name=push_service,json=pushService,proto3" json:"push_service,omitempty"`
+	// The timestamp when the subscription was registered.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The timestamp when the subscription expires, if its push service set one.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The timestamp when a notification was last pushed to the device.
+	LastPushTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_push_time,json=lastPushTime,proto3" json:"last_push_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPushSubscription) Reset() {
+	*x = UserPushSubscription{}
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPushSubscription) ProtoMessage() {}
+
+func (x *UserPushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPushSubscription.ProtoReflect.Descriptor instead.
+func (*UserPushSubscription) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UserPushSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetPushService() string {
+	if x != nil {
+		return x.PushService
+	}
+	return ""
+}
+
+func (x *UserPushSubscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserPushSubscription) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *UserPushSubscription) GetLastPushTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPushTime
+	}
+	return nil
+}
+
+type ListUserPushSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose push subscriptions will be listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPushSubscriptionsRequest) Reset() {
+	*x = ListUserPushSubscriptionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPushSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPushSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListUserPushSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPushSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPushSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListUserPushSubscriptionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserPushSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of push subscriptions.
+	PushSubscriptions []*UserPushSubscription `protobuf:"bytes,1,rep,name=push_subscriptions,json=pushSubscriptions,proto3" json:"push_subscriptions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListUserPushSubscriptionsResponse) Reset() {
+	*x = ListUserPushSubscriptionsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPushSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPushSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListUserPushSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPushSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPushSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListUserPushSubscriptionsResponse) GetPushSubscriptions() []*UserPushSubscription {
+	if x != nil {
+		return x.PushSubscriptions
+	}
+	return nil
+}
+
+type CreateUserPushSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource who will receive the notifications.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The user-supplied label of the device.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Required. The push endpoint (PushSubscription.endpoint).
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Required. The P-256 public key of the subscription (PushSubscription.getKey("p256dh")).
+	P256Dh []byte `protobuf:"bytes,4,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	// Required. The authentication secret of the subscription (PushSubscription.getKey("auth")).
+	Auth []byte `protobuf:"bytes,5,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Optional. When the subscription expires (PushSubscription.expirationTime).
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserPushSubscriptionRequest) Reset() {
+	*x = CreateUserPushSubscriptionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateUserPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetP256Dh() []byte {
+	if x != nil {
+		return x.P256Dh
+	}
+	return nil
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetAuth() []byte {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *CreateUserPushSubscriptionRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type DeleteUserPushSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the push subscription to delete.
+	// Format: users/{user}/pushSubscriptions/{push_subscription}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserPushSubscriptionRequest) Reset() {
+	*x = DeleteUserPushSubscriptionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteUserPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteUserPushSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The feed token of a user. Appended to the feed URLs of the user as the token query
+// parameter, it makes the feeds include the protected and private memos of the user.
+// Feed tokens are independent of access tokens, and only grant access to the feeds.
+type UserFeedToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the feed token.
+	// Format: users/{user}/feedToken
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the user has a feed token.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The timestamp when the feed token was generated.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The feed token. Only returned by RotateUserFeedToken, as it's stored hashed.
+	Token         string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFeedToken) Reset() {
+	*x = UserFeedToken{}
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFeedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFeedToken) ProtoMessage() {}
+
+func (x *UserFeedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFeedToken.ProtoReflect.Descriptor instead.
+func (*UserFeedToken) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UserFeedToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserFeedToken) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UserFeedToken) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserFeedToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetUserFeedTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the feed token.
+	// Format: users/{user}/feedToken
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserFeedTokenRequest) Reset() {
+	*x = GetUserFeedTokenRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFeedTokenRequest) ProtoMessage() {}
+
+func (x *GetUserFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUserFeedTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RotateUserFeedTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the feed token.
+	// Format: users/{user}/feedToken
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateUserFeedTokenRequest) Reset() {
+	*x = RotateUserFeedTokenRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateUserFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateUserFeedTokenRequest) ProtoMessage() {}
+
+func (x *RotateUserFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateUserFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateUserFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RotateUserFeedTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteUserFeedTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the feed token.
+	// Format: users/{user}/feedToken
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserFeedTokenRequest) Reset() {
+	*x = DeleteUserFeedTokenRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserFeedTokenRequest) ProtoMessage() {}
+
+func (x *DeleteUserFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteUserFeedTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The inbound email address of a user. The emails sent to the address become private memos of
+// the user: the tags of the subject are extracted, the body is converted to markdown, and the
+// attachments are uploaded.
+type UserInboundEmail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the inbound email address.
+	// Format: users/{user}/inboundEmail
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether inbound email is enabled on the instance, i.e. an inbound domain is set.
+	Available bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// The inbound email address, empty if the user has none.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// The timestamp when the address was generated.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserInboundEmail) Reset() {
+	*x = UserInboundEmail{}
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInboundEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInboundEmail) ProtoMessage() {}
+
+func (x *UserInboundEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInboundEmail.ProtoReflect.Descriptor instead.
+func (*UserInboundEmail) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UserInboundEmail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserInboundEmail) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *UserInboundEmail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserInboundEmail) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type GetUserInboundEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the inbound email address.
+	// Format: users/{user}/inboundEmail
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserInboundEmailRequest) Reset() {
+	*x = GetUserInboundEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserInboundEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInboundEmailRequest) ProtoMessage() {}
+
+func (x *GetUserInboundEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInboundEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserInboundEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUserInboundEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RotateUserInboundEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the inbound email address.
+	// Format: users/{user}/inboundEmail
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateUserInboundEmailRequest) Reset() {
+	*x = RotateUserInboundEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateUserInboundEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateUserInboundEmailRequest) ProtoMessage() {}
+
+func (x *RotateUserInboundEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateUserInboundEmailRequest.ProtoReflect.Descriptor instead.
+func (*RotateUserInboundEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RotateUserInboundEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteUserInboundEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the inbound email address.
+	// Format: users/{user}/inboundEmail
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserInboundEmailRequest) Reset() {
+	*x = DeleteUserInboundEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserInboundEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserInboundEmailRequest) ProtoMessage() {}
+
+func (x *DeleteUserInboundEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserInboundEmailRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserInboundEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteUserInboundEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The Slack account linked to a user. The linked account creates memos of the user with the
+// /memo slash command and the "Save to memos" message action.
+type UserSlackLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the Slack link.
+	// Format: users/{user}/slackLink
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the Slack integration is enabled on the instance, i.e. a signing secret is set.
+	Available bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// The ID of the Slack workspace of the linked account, empty if the user has none.
+	SlackTeamId string `protobuf:"bytes,3,opt,name=slack_team_id,json=slackTeamId,proto3" json:"slack_team_id,omitempty"`
+	// The ID of the linked Slack user.
+	SlackUserId string `protobuf:"bytes,4,opt,name=slack_user_id,json=slackUserId,proto3" json:"slack_user_id,omitempty"`
+	// The timestamp when the Slack account was linked.
+	LinkTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	// The one-time link code, only returned by GenerateUserSlackLinkCode.
+	LinkCode string `protobuf:"bytes,6,opt,name=link_code,json=linkCode,proto3" json:"link_code,omitempty"`
+	// The timestamp when the pending link code expires, unset if there's none.
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserSlackLink) Reset() {
+	*x = UserSlackLink{}
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSlackLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSlackLink) ProtoMessage() {}
+
+func (x *UserSlackLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSlackLink.ProtoReflect.Descriptor instead.
+func (*UserSlackLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UserSlackLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSlackLink) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *UserSlackLink) GetSlackTeamId() string {
+	if x != nil {
+		return x.SlackTeamId
+	}
+	return ""
+}
+
+func (x *UserSlackLink) GetSlackUserId() string {
+	if x != nil {
+		return x.SlackUserId
+	}
+	return ""
+}
+
+func (x *UserSlackLink) GetLinkTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkTime
+	}
+	return nil
+}
+
+func (x *UserSlackLink) GetLinkCode() string {
+	if x != nil {
+		return x.LinkCode
+	}
+	return ""
+}
+
+func (x *UserSlackLink) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type GetUserSlackLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Slack link.
+	// Format: users/{user}/slackLink
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSlackLinkRequest) Reset() {
+	*x = GetUserSlackLinkRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSlackLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSlackLinkRequest) ProtoMessage() {}
+
+func (x *GetUserSlackLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSlackLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSlackLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetUserSlackLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateUserSlackLinkCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Slack link.
+	// Format: users/{user}/slackLink
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUserSlackLinkCodeRequest) Reset() {
+	*x = GenerateUserSlackLinkCodeRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUserSlackLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUserSlackLinkCodeRequest) ProtoMessage() {}
+
+func (x *GenerateUserSlackLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateUserSlackLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*GenerateUserSlackLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GenerateUserSlackLinkCodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteUserSlackLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Slack link.
+	// Format: users/{user}/slackLink
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserSlackLinkRequest) Reset() {
+	*x = DeleteUserSlackLinkRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserSlackLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserSlackLinkRequest) ProtoMessage() {}
+
+func (x *DeleteUserSlackLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserSlackLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserSlackLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteUserSlackLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The Matrix account linked to a user. The linked account creates memos of the user with the
+// messages to the Matrix bot.
+type UserMatrixLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the Matrix link.
+	// Format: users/{user}/matrixLink
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the Matrix bot is enabled on the instance, i.e. a homeserver and access token are set.
+	Available bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// The ID of the linked Matrix user, e.g. "@alice:example.com", empty if the user has none.
+	MatrixUserId string `protobuf:"bytes,3,opt,name=matrix_user_id,json=matrixUserId,proto3" json:"matrix_user_id,omitempty"`
+	// The timestamp when the Matrix account was linked.
+	LinkTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	// The one-time link code, only returned by GenerateUserMatrixLinkCode.
+	LinkCode string `protobuf:"bytes,5,opt,name=link_code,json=linkCode,proto3" json:"link_code,omitempty"`
+	// The timestamp when the pending link code expires, unset if there's none.
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserMatrixLink) Reset() {
+	*x = UserMatrixLink{}
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMatrixLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMatrixLink) ProtoMessage() {}
+
+func (x *UserMatrixLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMatrixLink.ProtoReflect.Descriptor instead.
+func (*UserMatrixLink) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UserMatrixLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserMatrixLink) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *UserMatrixLink) GetMatrixUserId() string {
+	if x != nil {
+		return x.MatrixUserId
+	}
+	return ""
+}
+
+func (x *UserMatrixLink) GetLinkTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkTime
+	}
+	return nil
+}
+
+func (x *UserMatrixLink) GetLinkCode() string {
+	if x != nil {
+		return x.LinkCode
+	}
+	return ""
+}
+
+func (x *UserMatrixLink) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type GetUserMatrixLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Matrix link.
+	// Format: users/{user}/matrixLink
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserMatrixLinkRequest) Reset() {
+	*x = GetUserMatrixLinkRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMatrixLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMatrixLinkRequest) ProtoMessage() {}
+
+func (x *GetUserMatrixLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMatrixLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetUserMatrixLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetUserMatrixLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateUserMatrixLinkCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Matrix link.
+	// Format: users/{user}/matrixLink
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUserMatrixLinkCodeRequest) Reset() {
+	*x = GenerateUserMatrixLinkCodeRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUserMatrixLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUserMatrixLinkCodeRequest) ProtoMessage() {}
+
+func (x *GenerateUserMatrixLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateUserMatrixLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*GenerateUserMatrixLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GenerateUserMatrixLinkCodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteUserMatrixLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Matrix link.
+	// Format: users/{user}/matrixLink
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserMatrixLinkRequest) Reset() {
+	*x = DeleteUserMatrixLinkRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserMatrixLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMatrixLinkRequest) ProtoMessage() {}
+
+func (x *DeleteUserMatrixLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMatrixLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserMatrixLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteUserMatrixLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnlockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user to unlock.
+	// Format: users/{user}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UnlockUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SuspendUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user to suspend.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The reason of the suspension, recorded in the audit log.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SuspendUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnsuspendUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user to unsuspend.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The reason of lifting the suspension, recorded in the audit log.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendUserRequest) Reset() {
+	*x = UnsuspendUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendUserRequest) ProtoMessage() {}
+
+func (x *UnsuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UnsuspendUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnsuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UserWebhook represents a webhook owned by a user.
+type UserWebhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook.
+	// Format: users/{user}/webhooks/{webhook}, or instance/webhooks/{webhook} for workspace webhooks.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL to send the webhook to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. Human-readable name for the webhook.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The creation time of the webhook.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The last update time of the webhook.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Whether deliveries to the webhook are stopped. A webhook is disabled automatically after
+	// 50 consecutive failed deliveries, and enabling it again resets the count.
+	Disabled bool `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The number of failed deliveries since the last successful one.
+	ConsecutiveFailures int32 `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// The secret deliveries are signed with. It's only returned when the webhook is created and when
+	// its secret is rotated.
+	//
+	// Each delivery carries an X-Memos-Signature header of the form "t=<timestamp>,v1=<signature>",
+	// where the timestamp is the Unix time of the attempt and the signature is the hex-encoded
+	// HMAC-SHA256, keyed with the secret, of the timestamp, a ".", and the request body. Receivers
+	// should compute the signature and compare it in constant time, and reject timestamps that are
+	// too old to prevent replays. Every attempt is signed with a fresh timestamp.
+	Secret string `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether the deliveries of the webhook are signed.
+	HasSecret bool `protobuf:"varint,9,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"`
+	// The events the webhook is sent for. An empty list subscribes to all events.
+	// Valid events are memos.memo.created, memos.memo.updated, memos.memo.trashed,
+	// memos.memo.restored, memos.memo.reminder, memos.memo.expired and memos.memo.deleted.
+	// The event of a delivery is sent in the activityType field of its payload.
+	Events []string `protobuf:"bytes,10,rep,name=events,proto3" json:"events,omitempty"`
+	// The format of the payloads sent to the webhook.
+	Format UserWebhook_Format `protobuf:"varint,11,opt,name=format,proto3,enum=memos.api.v1.UserWebhook_Format" json:"format,omitempty"`
+	// Whether the webhook belongs to a user or to the workspace.
+	Scope UserWebhook_Scope `protobuf:"varint,12,opt,name=scope,proto3,enum=memos.api.v1.UserWebhook_Scope" json:"scope,omitempty"`
+	// Whether the events of private memos carry their content. Only used by workspace webhooks,
+	// which otherwise send only the memo name, its creator and the event of private memos.
+	IncludePrivateContent bool `protobuf:"varint,13,opt,name=include_private_content,json=includePrivateContent,proto3" json:"include_private_content,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UserWebhook) Reset() {
+	*x = UserWebhook{}
+	mi := &file_api_v1_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebhook) ProtoMessage() {}
+
+func (x *UserWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebhook.ProtoReflect.Descriptor instead.
+func (*UserWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UserWebhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *UserWebhook) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *UserWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+func (x *UserWebhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetFormat() UserWebhook_Format {
+	if x != nil {
+		return x.Format
+	}
+	return UserWebhook_FORMAT_UNSPECIFIED
+}
+
+func (x *UserWebhook) GetScope() UserWebhook_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return UserWebhook_SCOPE_UNSPECIFIED
+}
+
+func (x *UserWebhook) GetIncludePrivateContent() bool {
+	if x != nil {
+		return x.IncludePrivateContent
+	}
+	return false
+}
+
+// UserWebhookDelivery is the delivery of an event to a webhook. Failed attempts are retried with
+// exponential backoff, up to 5 attempts over about 30 minutes.
+type UserWebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the delivery.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the delivered event, e.g. memos.memo.created.
+	ActivityType string `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// The hex encoded SHA-256 hash of the delivered JSON body.
+	PayloadHash string `protobuf:"bytes,3,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	// The state of the delivery.
+	State UserWebhookDelivery_State `protobuf:"varint,4,opt,name=state,proto3,enum=memos.api.v1.UserWebhookDelivery_State" json:"state,omitempty"`
+	// The number of attempts made so far.
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The HTTP status of the response to the last attempt, 0 if there was no response.
+	ResponseStatus int32 `protobuf:"varint,6,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	// The time the last attempt took.
+	Latency *durationpb.Duration `protobuf:"bytes,7,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The error of the last attempt, empty if it succeeded.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// The creation time of the delivery.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the last attempt.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The time of the next attempt of a pending delivery.
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserWebhookDelivery) Reset() {
+	*x = UserWebhookDelivery{}
+	mi := &file_api_v1_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebhookDelivery) ProtoMessage() {}
+
+func (x *UserWebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*UserWebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UserWebhookDelivery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetPayloadHash() string {
+	if x != nil {
+		return x.PayloadHash
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetState() UserWebhookDelivery_State {
+	if x != nil {
+		return x.State
+	}
+	return UserWebhookDelivery_STATE_UNSPECIFIED
+}
+
+func (x *UserWebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *UserWebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *UserWebhookDelivery) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *UserWebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhookDelivery) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *UserWebhookDelivery) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
+type ListUserWebhooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}, or instance for the workspace webhooks.
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksRequest) Reset() {
+	*x = ListUserWebhooksRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksRequest) ProtoMessage() {}
+
+func (x *ListUserWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListUserWebhooksRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserWebhooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of webhooks.
+	Webhooks      []*UserWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhooksResponse) Reset() {
+	*x = ListUserWebhooksResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhooksResponse) ProtoMessage() {}
+
+func (x *ListUserWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListUserWebhooksResponse) GetWebhooks() []*UserWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type CreateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}, or instance for a workspace webhook.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The webhook to create.
+	Webhook       *UserWebhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserWebhookRequest) Reset() {
+	*x = CreateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserWebhookRequest) ProtoMessage() {}
+
+func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateUserWebhookRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type UpdateUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The webhook to update.
+	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserWebhookRequest) Reset() {
+	*x = UpdateUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook to delete.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserWebhookRequest) Reset() {
+	*x = DeleteUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RotateUserWebhookSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook to rotate the secret of.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateUserWebhookSecretRequest) Reset() {
+	*x = RotateUserWebhookSecretRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateUserWebhookSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateUserWebhookSecretRequest) ProtoMessage() {}
+
+func (x *RotateUserWebhookSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,50 +5282,96 @@ func (x *CreateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RotateUserWebhookSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateUserWebhookSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CreateUserWebhookRequest) GetParent() string {
+func (x *RotateUserWebhookSecretRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateUserWebhookRequest) GetWebhook() *UserWebhook {
+type TestUserWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the webhook to test.
+	// Format: users/{user}/webhooks/{webhook}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestUserWebhookRequest) Reset() {
+	*x = TestUserWebhookRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestUserWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestUserWebhookRequest) ProtoMessage() {}
+
+func (x *TestUserWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[74]
 	if x != nil {
-		return x.Webhook
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type UpdateUserWebhookRequest struct {
+// Deprecated: Use TestUserWebhookRequest.ProtoReflect.Descriptor instead.
+func (*TestUserWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TestUserWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TestUserWebhookResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The webhook to update.
-	Webhook *UserWebhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The HTTP status of the response, or 0 if the endpoint couldn't be reached.
+	StatusCode int32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The headers of the response.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The first 1 KB of the response body.
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// The round-trip time of the request.
+	Latency *durationpb.Duration `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Why deliveries to the webhook would fail, e.g. an unreachable endpoint or an unexpected
+	// response. Empty if the test succeeded.
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserWebhookRequest) Reset() {
-	*x = UpdateUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+func (x *TestUserWebhookResponse) Reset() {
+	*x = TestUserWebhookResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserWebhookRequest) String() string {
+func (x *TestUserWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserWebhookRequest) ProtoMessage() {}
+func (*TestUserWebhookResponse) ProtoMessage() {}
 
-func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[29]
+func (x *TestUserWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,49 +5382,191 @@ func (x *UpdateUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TestUserWebhookResponse.ProtoReflect.Descriptor instead.
+func (*TestUserWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UpdateUserWebhookRequest) GetWebhook() *UserWebhook {
+func (x *TestUserWebhookResponse) GetStatusCode() int32 {
 	if x != nil {
-		return x.Webhook
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TestUserWebhookResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
 	}
 	return nil
 }
 
-func (x *UpdateUserWebhookRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *TestUserWebhookResponse) GetBody() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TestUserWebhookResponse) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
 	}
 	return nil
 }
 
-type DeleteUserWebhookRequest struct {
+func (x *TestUserWebhookResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListUserWebhookDeliveriesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the webhook to delete.
+	// The parent webhook.
 	// Format: users/{user}/webhooks/{webhook}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of deliveries to return.
+	// If unspecified, at most 50 deliveries will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListUserWebhookDeliveries` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesRequest) Reset() {
+	*x = ListUserWebhookDeliveriesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deliveries, newest first.
+	Deliveries []*UserWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesResponse) Reset() {
+	*x = ListUserWebhookDeliveriesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetDeliveries() []*UserWebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RedeliverUserWebhookDeliveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery to replay.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserWebhookRequest) Reset() {
-	*x = DeleteUserWebhookRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *RedeliverUserWebhookDeliveryRequest) Reset() {
+	*x = RedeliverUserWebhookDeliveryRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserWebhookRequest) String() string {
+func (x *RedeliverUserWebhookDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserWebhookRequest) ProtoMessage() {}
+func (*RedeliverUserWebhookDeliveryRequest) ProtoMessage() {}
 
-func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *RedeliverUserWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,12 +5577,12 @@ func (x *DeleteUserWebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserWebhookRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RedeliverUserWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverUserWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteUserWebhookRequest) GetName() string {
+func (x *RedeliverUserWebhookDeliveryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
@@ -2239,7 +5611,7 @@ type UserNotification struct {
 
 func (x *UserNotification) Reset() {
 	*x = UserNotification{}
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	mi := &file_api_v1_user_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2251,7 +5623,7 @@ func (x *UserNotification) String() string {
 func (*UserNotification) ProtoMessage() {}
 
 func (x *UserNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	mi := &file_api_v1_user_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2264,7 +5636,7 @@ func (x *UserNotification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
 func (*UserNotification) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *UserNotification) GetName() string {
@@ -2323,7 +5695,7 @@ type ListUserNotificationsRequest struct {
 
 func (x *ListUserNotificationsRequest) Reset() {
 	*x = ListUserNotificationsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	mi := &file_api_v1_user_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2335,7 +5707,7 @@ func (x *ListUserNotificationsRequest) String() string {
 func (*ListUserNotificationsRequest) ProtoMessage() {}
 
 func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	mi := &file_api_v1_user_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2348,7 +5720,7 @@ func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ListUserNotificationsRequest) GetParent() string {
@@ -2389,7 +5761,7 @@ type ListUserNotificationsResponse struct {
 
 func (x *ListUserNotificationsResponse) Reset() {
 	*x = ListUserNotificationsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	mi := &file_api_v1_user_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2401,7 +5773,7 @@ func (x *ListUserNotificationsResponse) String() string {
 func (*ListUserNotificationsResponse) ProtoMessage() {}
 
 func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	mi := &file_api_v1_user_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +5786,7 @@ func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ListUserNotificationsResponse) GetNotifications() []*UserNotification {
@@ -2441,7 +5813,7 @@ type UpdateUserNotificationRequest struct {
 
 func (x *UpdateUserNotificationRequest) Reset() {
 	*x = UpdateUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	mi := &file_api_v1_user_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2453,7 +5825,7 @@ func (x *UpdateUserNotificationRequest) String() string {
 func (*UpdateUserNotificationRequest) ProtoMessage() {}
 
 func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	mi := &file_api_v1_user_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,7 +5838,7 @@ func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserNotificationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *UpdateUserNotificationRequest) GetNotification() *UserNotification {
@@ -2493,7 +5865,7 @@ type DeleteUserNotificationRequest struct {
 
 func (x *DeleteUserNotificationRequest) Reset() {
 	*x = DeleteUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	mi := &file_api_v1_user_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2505,7 +5877,7 @@ func (x *DeleteUserNotificationRequest) String() string {
 func (*DeleteUserNotificationRequest) ProtoMessage() {}
 
 func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	mi := &file_api_v1_user_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2518,7 +5890,7 @@ func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserNotificationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *DeleteUserNotificationRequest) GetName() string {
@@ -2541,7 +5913,7 @@ type UserStats_MemoTypeStats struct {
 
 func (x *UserStats_MemoTypeStats) Reset() {
 	*x = UserStats_MemoTypeStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	mi := &file_api_v1_user_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2553,7 +5925,7 @@ func (x *UserStats_MemoTypeStats) String() string {
 func (*UserStats_MemoTypeStats) ProtoMessage() {}
 
 func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	mi := &file_api_v1_user_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,6 +5969,59 @@ func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
 	return 0
 }
 
+type UserWritingStats_MonthCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The month, in the format YYYY-MM.
+	Month         string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWritingStats_MonthCount) Reset() {
+	*x = UserWritingStats_MonthCount{}
+	mi := &file_api_v1_user_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWritingStats_MonthCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWritingStats_MonthCount) ProtoMessage() {}
+
+func (x *UserWritingStats_MonthCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWritingStats_MonthCount.ProtoReflect.Descriptor instead.
+func (*UserWritingStats_MonthCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *UserWritingStats_MonthCount) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *UserWritingStats_MonthCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 // General user settings configuration.
 type UserSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2607,14 +6032,20 @@ type UserSetting_GeneralSetting struct {
 	// The preferred theme of the user.
 	// This references a CSS file in the web/public/themes/ directory.
 	// If not set, the default theme will be used.
-	Theme         string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Theme string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The IANA time zone of the user, e.g. "Europe/Paris", used to expand the placeholders
+	// of memo templates. If not set, UTC is used.
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Whether the EXIF and XMP metadata of the images uploaded by the user is stripped.
+	// If not set, the instance storage setting is used.
+	StripImageMetadata *bool `protobuf:"varint,6,opt,name=strip_image_metadata,json=stripImageMetadata,proto3,oneof" json:"strip_image_metadata,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UserSetting_GeneralSetting) Reset() {
 	*x = UserSetting_GeneralSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	mi := &file_api_v1_user_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2626,7 +6057,7 @@ func (x *UserSetting_GeneralSetting) String() string {
 func (*UserSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	mi := &file_api_v1_user_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2639,7 +6070,7 @@ func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting_GeneralSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting_GeneralSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *UserSetting_GeneralSetting) GetLocale() string {
@@ -2663,6 +6094,20 @@ func (x *UserSetting_GeneralSetting) GetTheme() string {
 	return ""
 }
 
+func (x *UserSetting_GeneralSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserSetting_GeneralSetting) GetStripImageMetadata() bool {
+	if x != nil && x.StripImageMetadata != nil {
+		return *x.StripImageMetadata
+	}
+	return false
+}
+
 // User authentication sessions configuration.
 type UserSetting_SessionsSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2674,7 +6119,7 @@ type UserSetting_SessionsSetting struct {
 
 func (x *UserSetting_SessionsSetting) Reset() {
 	*x = UserSetting_SessionsSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	mi := &file_api_v1_user_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2686,7 +6131,7 @@ func (x *UserSetting_SessionsSetting) String() string {
 func (*UserSetting_SessionsSetting) ProtoMessage() {}
 
 func (x *UserSetting_SessionsSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	mi := &file_api_v1_user_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,7 +6144,7 @@ func (x *UserSetting_SessionsSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting_SessionsSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting_SessionsSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 1}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *UserSetting_SessionsSetting) GetSessions() []*UserSession {
@@ -2720,7 +6165,7 @@ type UserSetting_AccessTokensSetting struct {
 
 func (x *UserSetting_AccessTokensSetting) Reset() {
 	*x = UserSetting_AccessTokensSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	mi := &file_api_v1_user_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2732,7 +6177,7 @@ func (x *UserSetting_AccessTokensSetting) String() string {
 func (*UserSetting_AccessTokensSetting) ProtoMessage() {}
 
 func (x *UserSetting_AccessTokensSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	mi := &file_api_v1_user_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,7 +6190,7 @@ func (x *UserSetting_AccessTokensSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting_AccessTokensSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting_AccessTokensSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 2}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 2}
 }
 
 func (x *UserSetting_AccessTokensSetting) GetAccessTokens() []*UserAccessToken {
@@ -2766,7 +6211,7 @@ type UserSetting_WebhooksSetting struct {
 
 func (x *UserSetting_WebhooksSetting) Reset() {
 	*x = UserSetting_WebhooksSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	mi := &file_api_v1_user_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2778,7 +6223,7 @@ func (x *UserSetting_WebhooksSetting) String() string {
 func (*UserSetting_WebhooksSetting) ProtoMessage() {}
 
 func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	mi := &file_api_v1_user_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +6236,7 @@ func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting_WebhooksSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting_WebhooksSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 3}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 3}
 }
 
 func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
@@ -2801,6 +6246,363 @@ func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
 	return nil
 }
 
+// Auto-archive policy configuration. A daily job archives the memos of the user not edited
+// in the given number of days, except pinned memos, memos with a reminder and memos with a
+// protected tag.
+type UserSetting_AutoArchiveSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of days without edits after which memos are archived.
+	// 0 disables the policy, which is the default.
+	Days int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	// The tags, without the # prefix, of the memos that are never archived. Their subtags
+	// are protected too.
+	ProtectedTags []string `protobuf:"bytes,2,rep,name=protected_tags,json=protectedTags,proto3" json:"protected_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_AutoArchiveSetting) Reset() {
+	*x = UserSetting_AutoArchiveSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_AutoArchiveSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_AutoArchiveSetting) ProtoMessage() {}
+
+func (x *UserSetting_AutoArchiveSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_AutoArchiveSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_AutoArchiveSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 4}
+}
+
+func (x *UserSetting_AutoArchiveSetting) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *UserSetting_AutoArchiveSetting) GetProtectedTags() []string {
+	if x != nil {
+		return x.ProtectedTags
+	}
+	return nil
+}
+
+// Notification preferences of the user. The notifications of the enabled types are also sent to
+// the email of the user, when email is configured, batched in digests of the notifications
+// received within a few minutes. Unread notifications only are sent.
+type UserSetting_NotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the comments on the memos of the user are sent by email.
+	EmailMemoComment bool `protobuf:"varint,1,opt,name=email_memo_comment,json=emailMemoComment,proto3" json:"email_memo_comment,omitempty"`
+	// Whether the reactions to the memos of the user are sent by email.
+	EmailMemoReaction bool `protobuf:"varint,2,opt,name=email_memo_reaction,json=emailMemoReaction,proto3" json:"email_memo_reaction,omitempty"`
+	// Whether the memos mentioning the user are sent by email.
+	EmailMemoMention bool `protobuf:"varint,3,opt,name=email_memo_mention,json=emailMemoMention,proto3" json:"email_memo_mention,omitempty"`
+	// Whether the due reminders of the memos of the user are sent by email.
+	EmailMemoReminder bool `protobuf:"varint,4,opt,name=email_memo_reminder,json=emailMemoReminder,proto3" json:"email_memo_reminder,omitempty"`
+	// The time of day the quiet hours of the push notifications start, as "HH:MM" in the time
+	// zone of the user. No notification is pushed during the quiet hours, which are off unless
+	// both their start and end are set.
+	QuietHoursStart string `protobuf:"bytes,5,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	// The time of day the quiet hours of the push notifications end, as "HH:MM".
+	QuietHoursEnd string `protobuf:"bytes,6,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	// How often a digest summarizing the activity of the user is delivered to the inbox: the
+	// memos created by the user, the comments and reactions received and the reminders due. No
+	// digest is delivered for a period without any.
+	DigestFrequency UserSetting_NotificationSetting_DigestFrequency `protobuf:"varint,7,opt,name=digest_frequency,json=digestFrequency,proto3,enum=memos.api.v1.UserSetting_NotificationSetting_DigestFrequency" json:"digest_frequency,omitempty"`
+	// The time of day the digest is delivered, as "HH:MM" in the time zone of the user.
+	// Defaults to "08:00".
+	DigestTime string `protobuf:"bytes,8,opt,name=digest_time,json=digestTime,proto3" json:"digest_time,omitempty"`
+	// The day of the week a weekly digest is delivered, from 0 for Sunday to 6 for Saturday.
+	DigestWeekday int32 `protobuf:"varint,9,opt,name=digest_weekday,json=digestWeekday,proto3" json:"digest_weekday,omitempty"`
+	// Whether the digest is also sent to the email of the user, when email is configured.
+	EmailDigest   bool `protobuf:"varint,10,opt,name=email_digest,json=emailDigest,proto3" json:"email_digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_NotificationSetting) Reset() {
+	*x = UserSetting_NotificationSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_NotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_NotificationSetting) ProtoMessage() {}
+
+func (x *UserSetting_NotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_NotificationSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_NotificationSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 5}
+}
+
+func (x *UserSetting_NotificationSetting) GetEmailMemoComment() bool {
+	if x != nil {
+		return x.EmailMemoComment
+	}
+	return false
+}
+
+func (x *UserSetting_NotificationSetting) GetEmailMemoReaction() bool {
+	if x != nil {
+		return x.EmailMemoReaction
+	}
+	return false
+}
+
+func (x *UserSetting_NotificationSetting) GetEmailMemoMention() bool {
+	if x != nil {
+		return x.EmailMemoMention
+	}
+	return false
+}
+
+func (x *UserSetting_NotificationSetting) GetEmailMemoReminder() bool {
+	if x != nil {
+		return x.EmailMemoReminder
+	}
+	return false
+}
+
+func (x *UserSetting_NotificationSetting) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *UserSetting_NotificationSetting) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *UserSetting_NotificationSetting) GetDigestFrequency() UserSetting_NotificationSetting_DigestFrequency {
+	if x != nil {
+		return x.DigestFrequency
+	}
+	return UserSetting_NotificationSetting_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *UserSetting_NotificationSetting) GetDigestTime() string {
+	if x != nil {
+		return x.DigestTime
+	}
+	return ""
+}
+
+func (x *UserSetting_NotificationSetting) GetDigestWeekday() int32 {
+	if x != nil {
+		return x.DigestWeekday
+	}
+	return 0
+}
+
+func (x *UserSetting_NotificationSetting) GetEmailDigest() bool {
+	if x != nil {
+		return x.EmailDigest
+	}
+	return false
+}
+
+// Storage usage and quota of the user. Uploads that would exceed the quota are rejected.
+type UserSetting_StorageSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of bytes used by the attachments of the user. A file shared by several
+	// attachments of the user is counted once.
+	UsedBytes int64 `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// The storage quota of the user in bytes, 0 means unlimited.
+	QuotaBytes int64 `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	// The storage quota set on the user in MiB, 0 means unlimited. If not set, the default
+	// quota of the instance storage setting is used. Only the host can update it.
+	QuotaMb       *int64 `protobuf:"varint,3,opt,name=quota_mb,json=quotaMb,proto3,oneof" json:"quota_mb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_StorageSetting) Reset() {
+	*x = UserSetting_StorageSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_StorageSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_StorageSetting) ProtoMessage() {}
+
+func (x *UserSetting_StorageSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_StorageSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_StorageSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 6}
+}
+
+func (x *UserSetting_StorageSetting) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *UserSetting_StorageSetting) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *UserSetting_StorageSetting) GetQuotaMb() int64 {
+	if x != nil && x.QuotaMb != nil {
+		return *x.QuotaMb
+	}
+	return 0
+}
+
+// Memo counts and quotas of the user. Memo creations that would exceed a quota are
+// rejected. The days are counted in the time zone of the user.
+type UserSetting_MemoQuotaSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos of the user, not counting the trash.
+	MemoCount int32 `protobuf:"varint,1,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The number of memos created by the user today, whatever their creation time was set to
+	// afterwards.
+	TodayMemoCount int32 `protobuf:"varint,2,opt,name=today_memo_count,json=todayMemoCount,proto3" json:"today_memo_count,omitempty"`
+	// The number of memos the user created as public or made public today.
+	TodayPublicMemoCount int32 `protobuf:"varint,3,opt,name=today_public_memo_count,json=todayPublicMemoCount,proto3" json:"today_public_memo_count,omitempty"`
+	// The number of memos the user may have, 0 means unlimited. If not set, the limit of the
+	// instance memo related setting is used. Only the host can update it.
+	MaxMemos *int32 `protobuf:"varint,4,opt,name=max_memos,json=maxMemos,proto3,oneof" json:"max_memos,omitempty"`
+	// The number of memos the user may create per day, 0 means unlimited. If not set, the
+	// limit of the instance memo related setting is used. Only the host can update it.
+	MaxMemosPerDay *int32 `protobuf:"varint,5,opt,name=max_memos_per_day,json=maxMemosPerDay,proto3,oneof" json:"max_memos_per_day,omitempty"`
+	// The number of memos the user may create as public or make public per day, 0 means
+	// unlimited. Public memos imported by a user with this limit become private. If set, it
+	// applies whatever the age of the account, else the new account limit of the instance
+	// memo related setting applies. Only the host can update it.
+	MaxPublicMemosPerDay *int32 `protobuf:"varint,6,opt,name=max_public_memos_per_day,json=maxPublicMemosPerDay,proto3,oneof" json:"max_public_memos_per_day,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UserSetting_MemoQuotaSetting) Reset() {
+	*x = UserSetting_MemoQuotaSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_MemoQuotaSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_MemoQuotaSetting) ProtoMessage() {}
+
+func (x *UserSetting_MemoQuotaSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_MemoQuotaSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_MemoQuotaSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13, 7}
+}
+
+func (x *UserSetting_MemoQuotaSetting) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *UserSetting_MemoQuotaSetting) GetTodayMemoCount() int32 {
+	if x != nil {
+		return x.TodayMemoCount
+	}
+	return 0
+}
+
+func (x *UserSetting_MemoQuotaSetting) GetTodayPublicMemoCount() int32 {
+	if x != nil {
+		return x.TodayPublicMemoCount
+	}
+	return 0
+}
+
+func (x *UserSetting_MemoQuotaSetting) GetMaxMemos() int32 {
+	if x != nil && x.MaxMemos != nil {
+		return *x.MaxMemos
+	}
+	return 0
+}
+
+func (x *UserSetting_MemoQuotaSetting) GetMaxMemosPerDay() int32 {
+	if x != nil && x.MaxMemosPerDay != nil {
+		return *x.MaxMemosPerDay
+	}
+	return 0
+}
+
+func (x *UserSetting_MemoQuotaSetting) GetMaxPublicMemosPerDay() int32 {
+	if x != nil && x.MaxPublicMemosPerDay != nil {
+		return *x.MaxPublicMemosPerDay
+	}
+	return 0
+}
+
 type UserSession_ClientInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// User agent string of the client.
@@ -2819,7 +6621,7 @@ type UserSession_ClientInfo struct {
 
 func (x *UserSession_ClientInfo) Reset() {
 	*x = UserSession_ClientInfo{}
-	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	mi := &file_api_v1_user_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2831,7 +6633,7 @@ func (x *UserSession_ClientInfo) String() string {
 func (*UserSession_ClientInfo) ProtoMessage() {}
 
 func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	mi := &file_api_v1_user_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2844,7 +6646,7 @@ func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSession_ClientInfo.ProtoReflect.Descriptor instead.
 func (*UserSession_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *UserSession_ClientInfo) GetUserAgent() string {
@@ -2886,7 +6688,7 @@ var File_api_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/user_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x04\n" +
+	"\x19api/v1/user_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x05\n" +
 	"\x04User\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x120\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x17.memos.api.v1.User.RoleB\x03\xe0A\x02R\x04role\x12\x1f\n" +
@@ -2902,7 +6704,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\";\n" +
+	"updateTime\x12B\n" +
+	"\fsuspend_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vsuspendTime\";\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04HOST\x10\x01\x12\t\n" +
@@ -2961,32 +6764,104 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x16memos.api.v1/UserStats\x12\fusers/{user}*\tuserStats2\tuserStats\"D\n" +
 	"\x13GetUserStatsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
-	"\x11memos.api.v1/UserR\x04name\"\x19\n" +
+	"\x11memos.api.v1/UserR\x04name\"\xb4\x04\n" +
+	"\x10UserWritingStats\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12(\n" +
+	"\x10total_memo_count\x18\x02 \x01(\x05R\x0etotalMemoCount\x12(\n" +
+	"\x10total_word_count\x18\x03 \x01(\x03R\x0etotalWordCount\x12.\n" +
+	"\x13average_memo_length\x18\x04 \x01(\x01R\x11averageMemoLength\x12Y\n" +
+	"\x0ftag_memo_counts\x18\x05 \x03(\v21.memos.api.v1.UserWritingStats.TagMemoCountsEntryR\rtagMemoCounts\x12.\n" +
+	"\x13current_streak_days\x18\x06 \x01(\x05R\x11currentStreakDays\x12.\n" +
+	"\x13longest_streak_days\x18\a \x01(\x05R\x11longestStreakDays\x12L\n" +
+	"\fmonth_counts\x18\b \x03(\v2).memos.api.v1.UserWritingStats.MonthCountR\vmonthCounts\x1a@\n" +
+	"\x12TagMemoCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"MonthCount\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"{\n" +
+	"\x1aGetUserWritingStatsRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12.\n" +
+	"\x10include_archived\x18\x02 \x01(\bB\x03\xe0A\x01R\x0fincludeArchived\"\x19\n" +
 	"\x17ListAllUserStatsRequest\"I\n" +
 	"\x18ListAllUserStatsResponse\x12-\n" +
-	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xb3\a\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xcb\x15\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12S\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2(.memos.api.v1.UserSetting.GeneralSettingH\x00R\x0egeneralSetting\x12V\n" +
 	"\x10sessions_setting\x18\x03 \x01(\v2).memos.api.v1.UserSetting.SessionsSettingH\x00R\x0fsessionsSetting\x12c\n" +
 	"\x15access_tokens_setting\x18\x04 \x01(\v2-.memos.api.v1.UserSetting.AccessTokensSettingH\x00R\x13accessTokensSetting\x12V\n" +
-	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x1av\n" +
+	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x12`\n" +
+	"\x14auto_archive_setting\x18\x06 \x01(\v2,.memos.api.v1.UserSetting.AutoArchiveSettingH\x00R\x12autoArchiveSetting\x12S\n" +
+	"\x0fstorage_setting\x18\a \x01(\v2(.memos.api.v1.UserSetting.StorageSettingH\x00R\x0estorageSetting\x12Z\n" +
+	"\x12memo_quota_setting\x18\b \x01(\v2*.memos.api.v1.UserSetting.MemoQuotaSettingH\x00R\x10memoQuotaSetting\x12b\n" +
+	"\x14notification_setting\x18\t \x01(\v2-.memos.api.v1.UserSetting.NotificationSettingH\x00R\x13notificationSetting\x1a\xec\x01\n" +
 	"\x0eGeneralSetting\x12\x1b\n" +
 	"\x06locale\x18\x01 \x01(\tB\x03\xe0A\x01R\x06locale\x12,\n" +
 	"\x0fmemo_visibility\x18\x03 \x01(\tB\x03\xe0A\x01R\x0ememoVisibility\x12\x19\n" +
-	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x1aH\n" +
+	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x12\x1f\n" +
+	"\btimezone\x18\x05 \x01(\tB\x03\xe0A\x01R\btimezone\x12:\n" +
+	"\x14strip_image_metadata\x18\x06 \x01(\bB\x03\xe0A\x01H\x00R\x12stripImageMetadata\x88\x01\x01B\x17\n" +
+	"\x15_strip_image_metadata\x1aH\n" +
 	"\x0fSessionsSetting\x125\n" +
 	"\bsessions\x18\x01 \x03(\v2\x19.memos.api.v1.UserSessionR\bsessions\x1aY\n" +
 	"\x13AccessTokensSetting\x12B\n" +
 	"\raccess_tokens\x18\x01 \x03(\v2\x1d.memos.api.v1.UserAccessTokenR\faccessTokens\x1aH\n" +
 	"\x0fWebhooksSetting\x125\n" +
-	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\"V\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\x1aY\n" +
+	"\x12AutoArchiveSetting\x12\x17\n" +
+	"\x04days\x18\x01 \x01(\x05B\x03\xe0A\x01R\x04days\x12*\n" +
+	"\x0eprotected_tags\x18\x02 \x03(\tB\x03\xe0A\x01R\rprotectedTags\x1a\xf8\x04\n" +
+	"\x13NotificationSetting\x121\n" +
+	"\x12email_memo_comment\x18\x01 \x01(\bB\x03\xe0A\x01R\x10emailMemoComment\x123\n" +
+	"\x13email_memo_reaction\x18\x02 \x01(\bB\x03\xe0A\x01R\x11emailMemoReaction\x121\n" +
+	"\x12email_memo_mention\x18\x03 \x01(\bB\x03\xe0A\x01R\x10emailMemoMention\x123\n" +
+	"\x13email_memo_reminder\x18\x04 \x01(\bB\x03\xe0A\x01R\x11emailMemoReminder\x12/\n" +
+	"\x11quiet_hours_start\x18\x05 \x01(\tB\x03\xe0A\x01R\x0fquietHoursStart\x12+\n" +
+	"\x0fquiet_hours_end\x18\x06 \x01(\tB\x03\xe0A\x01R\rquietHoursEnd\x12m\n" +
+	"\x10digest_frequency\x18\a \x01(\x0e2=.memos.api.v1.UserSetting.NotificationSetting.DigestFrequencyB\x03\xe0A\x01R\x0fdigestFrequency\x12$\n" +
+	"\vdigest_time\x18\b \x01(\tB\x03\xe0A\x01R\n" +
+	"digestTime\x12*\n" +
+	"\x0edigest_weekday\x18\t \x01(\x05B\x03\xe0A\x01R\rdigestWeekday\x12&\n" +
+	"\femail_digest\x18\n" +
+	" \x01(\bB\x03\xe0A\x01R\vemailDigest\"J\n" +
+	"\x0fDigestFrequency\x12 \n" +
+	"\x1cDIGEST_FREQUENCY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02\x1a\x8c\x01\n" +
+	"\x0eStorageSetting\x12\"\n" +
+	"\n" +
+	"used_bytes\x18\x01 \x01(\x03B\x03\xe0A\x03R\tusedBytes\x12$\n" +
+	"\vquota_bytes\x18\x02 \x01(\x03B\x03\xe0A\x03R\n" +
+	"quotaBytes\x12#\n" +
+	"\bquota_mb\x18\x03 \x01(\x03B\x03\xe0A\x01H\x00R\aquotaMb\x88\x01\x01B\v\n" +
+	"\t_quota_mb\x1a\x80\x03\n" +
+	"\x10MemoQuotaSetting\x12\"\n" +
+	"\n" +
+	"memo_count\x18\x01 \x01(\x05B\x03\xe0A\x03R\tmemoCount\x12-\n" +
+	"\x10today_memo_count\x18\x02 \x01(\x05B\x03\xe0A\x03R\x0etodayMemoCount\x12:\n" +
+	"\x17today_public_memo_count\x18\x03 \x01(\x05B\x03\xe0A\x03R\x14todayPublicMemoCount\x12%\n" +
+	"\tmax_memos\x18\x04 \x01(\x05B\x03\xe0A\x01H\x00R\bmaxMemos\x88\x01\x01\x123\n" +
+	"\x11max_memos_per_day\x18\x05 \x01(\x05B\x03\xe0A\x01H\x01R\x0emaxMemosPerDay\x88\x01\x01\x12@\n" +
+	"\x18max_public_memos_per_day\x18\x06 \x01(\x05B\x03\xe0A\x01H\x02R\x14maxPublicMemosPerDay\x88\x01\x01B\f\n" +
+	"\n" +
+	"_max_memosB\x14\n" +
+	"\x12_max_memos_per_dayB\x1b\n" +
+	"\x19_max_public_memos_per_day\"\x97\x01\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
 	"\bSESSIONS\x10\x02\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x03\x12\f\n" +
-	"\bWEBHOOKS\x10\x04:Y\xeaAV\n" +
+	"\bWEBHOOKS\x10\x04\x12\x10\n" +
+	"\fAUTO_ARCHIVE\x10\x05\x12\v\n" +
+	"\aSTORAGE\x10\x06\x12\x0e\n" +
+	"\n" +
+	"MEMO_QUOTA\x10\a\x12\x10\n" +
+	"\fNOTIFICATION\x10\b:Y\xeaAV\n" +
 	"\x18memos.api.v1/UserSetting\x12\x1fusers/{user}/settings/{setting}*\fuserSettings2\vuserSettingB\a\n" +
 	"\x05value\"M\n" +
 	"\x15GetUserSettingRequest\x124\n" +
@@ -3006,14 +6881,17 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\bsettings\x18\x01 \x03(\v2\x19.memos.api.v1.UserSettingR\bsettings\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xe7\x02\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xf3\x03\n" +
 	"\x0fUserAccessToken\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12&\n" +
 	"\faccess_token\x18\x02 \x01(\tB\x03\xe0A\x03R\vaccessToken\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x01R\vdescription\x12<\n" +
 	"\tissued_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\bissuedAt\x12>\n" +
 	"\n" +
-	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\texpiresAt:n\xeaAk\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\texpiresAt\x12\x1b\n" +
+	"\x06scopes\x18\x06 \x03(\tB\x03\xe0A\x01R\x06scopes\x12E\n" +
+	"\x0elast_used_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastUsedTime\x12&\n" +
+	"\ftoken_prefix\x18\b \x01(\tB\x03\xe0A\x03R\vtokenPrefix:n\xeaAk\n" +
 	"\x1cmemos.api.v1/UserAccessToken\x12(users/{user}/accessTokens/{access_token}*\x10userAccessTokens2\x0fuserAccessToken\"\x96\x01\n" +
 	"\x1bListUserAccessTokensRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
@@ -3033,7 +6911,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x0faccess_token_id\x18\x03 \x01(\tB\x03\xe0A\x01R\raccessTokenId\"X\n" +
 	"\x1cDeleteUserAccessTokenRequest\x128\n" +
 	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
-	"\x1cmemos.api.v1/UserAccessTokenR\x04name\"\x94\x04\n" +
+	"\x1cmemos.api.v1/UserAccessTokenR\x04name\"\xb3\x04\n" +
 	"\vUserSession\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\"\n" +
 	"\n" +
@@ -3042,7 +6920,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"createTime\x12M\n" +
 	"\x12last_accessed_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x10lastAccessedTime\x12J\n" +
 	"\vclient_info\x18\x05 \x01(\v2$.memos.api.v1.UserSession.ClientInfoB\x03\xe0A\x03R\n" +
-	"clientInfo\x1a\xa4\x01\n" +
+	"clientInfo\x12\x1d\n" +
+	"\acurrent\x18\x06 \x01(\bB\x03\xe0A\x03R\acurrent\x1a\xa4\x01\n" +
 	"\n" +
 	"ClientInfo\x12\x1d\n" +
 	"\n" +
@@ -3060,7 +6939,185 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x18ListUserSessionsResponse\x125\n" +
 	"\bsessions\x18\x01 \x03(\v2\x19.memos.api.v1.UserSessionR\bsessions\"3\n" +
 	"\x18RevokeUserSessionRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xda\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"S\n" +
+	"\x1eRevokeOtherUserSessionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"\x90\x02\n" +
+	"\rUserTwoFactor\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x03R\aenabled\x12@\n" +
+	"\venable_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"enableTime\x12=\n" +
+	"\x18recovery_codes_remaining\x18\x04 \x01(\x05B\x03\xe0A\x03R\x16recoveryCodesRemaining:F\xeaAC\n" +
+	"\x1amemos.api.v1/UserTwoFactor\x12\x16users/{user}/twoFactor2\ruserTwoFactor\"Q\n" +
+	"\x17GetUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\"T\n" +
+	"\x1aEnrollUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\"V\n" +
+	"\x1bEnrollUserTwoFactorResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"n\n" +
+	"\x1bConfirmUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\"E\n" +
+	"\x1cConfirmUserTwoFactorResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"m\n" +
+	"\x1aDeleteUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x01R\x04code\"\xcb\x02\n" +
+	"\vUserPasskey\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tB\x03\xe0A\x03R\n" +
+	"transports\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12E\n" +
+	"\x0elast_used_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastUsedTime:_\xeaA\\\n" +
+	"\x18memos.api.v1/UserPasskey\x12\x1fusers/{user}/passkeys/{passkey}\x1a\x04name*\fuserPasskeys2\vuserPasskey\"L\n" +
+	"\x17ListUserPasskeysRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"Q\n" +
+	"\x18ListUserPasskeysResponse\x125\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\x19.memos.api.v1.UserPasskeyR\bpasskeys\"T\n" +
+	"\x1fCreateUserPasskeyOptionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"\xf6\x01\n" +
+	"\x12UserPasskeyOptions\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\fR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x02 \x01(\tR\x04rpId\x12\x17\n" +
+	"\arp_name\x18\x03 \x01(\tR\x06rpName\x12\x1f\n" +
+	"\vuser_handle\x18\x04 \x01(\fR\n" +
+	"userHandle\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x124\n" +
+	"\x16exclude_credential_ids\x18\a \x03(\fR\x14excludeCredentialIds\"\xf5\x02\n" +
+	"\x18CreateUserPasskeyRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x19\n" +
+	"\x05label\x18\x02 \x01(\tB\x03\xe0A\x02R\x05label\x12(\n" +
+	"\rcredential_id\x18\x03 \x01(\fB\x03\xe0A\x02R\fcredentialId\x12-\n" +
+	"\x10client_data_json\x18\x04 \x01(\fB\x03\xe0A\x02R\x0eclientDataJson\x122\n" +
+	"\x12authenticator_data\x18\x05 \x01(\fB\x03\xe0A\x02R\x11authenticatorData\x12\"\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\fB\x03\xe0A\x02R\tpublicKey\x125\n" +
+	"\x14public_key_algorithm\x18\a \x01(\x03B\x03\xe0A\x02R\x12publicKeyAlgorithm\x12#\n" +
+	"\n" +
+	"transports\x18\b \x03(\tB\x03\xe0A\x01R\n" +
+	"transports\"P\n" +
+	"\x18DeleteUserPasskeyRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/UserPasskeyR\x04name\"\xc9\x03\n" +
+	"\x14UserPushSubscription\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12&\n" +
+	"\fpush_service\x18\x03 \x01(\tB\x03\xe0A\x03R\vpushService\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expireTime\x12E\n" +
+	"\x0elast_push_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastPushTime:\x8e\x01\xeaA\x8a\x01\n" +
+	"!memos.api.v1/UserPushSubscription\x122users/{user}/pushSubscriptions/{push_subscription}\x1a\x04name*\x15userPushSubscriptions2\x14userPushSubscription\"U\n" +
+	" ListUserPushSubscriptionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"v\n" +
+	"!ListUserPushSubscriptionsResponse\x12Q\n" +
+	"\x12push_subscriptions\x18\x01 \x03(\v2\".memos.api.v1.UserPushSubscriptionR\x11pushSubscriptions\"\x8a\x02\n" +
+	"!CreateUserPushSubscriptionRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x19\n" +
+	"\x05label\x18\x02 \x01(\tB\x03\xe0A\x01R\x05label\x12\x1f\n" +
+	"\bendpoint\x18\x03 \x01(\tB\x03\xe0A\x02R\bendpoint\x12\x1b\n" +
+	"\x06p256dh\x18\x04 \x01(\fB\x03\xe0A\x02R\x06p256dh\x12\x17\n" +
+	"\x04auth\x18\x05 \x01(\fB\x03\xe0A\x02R\x04auth\x12@\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\"b\n" +
+	"!DeleteUserPushSubscriptionRequest\x12=\n" +
+	"\x04name\x18\x01 \x01(\tB)\xe0A\x02\xfaA#\n" +
+	"!memos.api.v1/UserPushSubscriptionR\x04name\"\xec\x01\n" +
+	"\rUserFeedToken\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x03R\aenabled\x12@\n" +
+	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x19\n" +
+	"\x05token\x18\x04 \x01(\tB\x03\xe0A\x03R\x05token:F\xeaAC\n" +
+	"\x1amemos.api.v1/UserFeedToken\x12\x16users/{user}/feedToken2\ruserFeedToken\"Q\n" +
+	"\x17GetUserFeedTokenRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserFeedTokenR\x04name\"T\n" +
+	"\x1aRotateUserFeedTokenRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserFeedTokenR\x04name\"T\n" +
+	"\x1aDeleteUserFeedTokenRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserFeedTokenR\x04name\"\x80\x02\n" +
+	"\x10UserInboundEmail\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\tavailable\x18\x02 \x01(\bB\x03\xe0A\x03R\tavailable\x12\x1d\n" +
+	"\aaddress\x18\x03 \x01(\tB\x03\xe0A\x03R\aaddress\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:O\xeaAL\n" +
+	"\x1dmemos.api.v1/UserInboundEmail\x12\x19users/{user}/inboundEmail2\x10userInboundEmail\"W\n" +
+	"\x1aGetUserInboundEmailRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/UserInboundEmailR\x04name\"Z\n" +
+	"\x1dRotateUserInboundEmailRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/UserInboundEmailR\x04name\"Z\n" +
+	"\x1dDeleteUserInboundEmailRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/UserInboundEmailR\x04name\"\x99\x03\n" +
+	"\rUserSlackLink\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\tavailable\x18\x02 \x01(\bB\x03\xe0A\x03R\tavailable\x12'\n" +
+	"\rslack_team_id\x18\x03 \x01(\tB\x03\xe0A\x03R\vslackTeamId\x12'\n" +
+	"\rslack_user_id\x18\x04 \x01(\tB\x03\xe0A\x03R\vslackUserId\x12<\n" +
+	"\tlink_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\blinkTime\x12 \n" +
+	"\tlink_code\x18\x06 \x01(\tB\x03\xe0A\x03R\blinkCode\x12R\n" +
+	"\x15link_code_expire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x12linkCodeExpireTime:F\xeaAC\n" +
+	"\x1amemos.api.v1/UserSlackLink\x12\x16users/{user}/slackLink2\ruserSlackLink\"Q\n" +
+	"\x17GetUserSlackLinkRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserSlackLinkR\x04name\"Z\n" +
+	" GenerateUserSlackLinkCodeRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserSlackLinkR\x04name\"T\n" +
+	"\x1aDeleteUserSlackLinkRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserSlackLinkR\x04name\"\xf6\x02\n" +
+	"\x0eUserMatrixLink\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\tavailable\x18\x02 \x01(\bB\x03\xe0A\x03R\tavailable\x12)\n" +
+	"\x0ematrix_user_id\x18\x03 \x01(\tB\x03\xe0A\x03R\fmatrixUserId\x12<\n" +
+	"\tlink_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\blinkTime\x12 \n" +
+	"\tlink_code\x18\x05 \x01(\tB\x03\xe0A\x03R\blinkCode\x12R\n" +
+	"\x15link_code_expire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x12linkCodeExpireTime:I\xeaAF\n" +
+	"\x1bmemos.api.v1/UserMatrixLink\x12\x17users/{user}/matrixLink2\x0euserMatrixLink\"S\n" +
+	"\x18GetUserMatrixLinkRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/UserMatrixLinkR\x04name\"\\\n" +
+	"!GenerateUserMatrixLinkCodeRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/UserMatrixLinkR\x04name\"V\n" +
+	"\x1bDeleteUserMatrixLinkRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/UserMatrixLinkR\x04name\"B\n" +
+	"\x11UnlockUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\"`\n" +
+	"\x12SuspendUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x01R\x06reason\"b\n" +
+	"\x14UnsuspendUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x01R\x06reason\"\xc5\x05\n" +
 	"\vUserWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
@@ -3068,7 +7125,48 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"6\n" +
+	"updateTime\x12\x1f\n" +
+	"\bdisabled\x18\x06 \x01(\bB\x03\xe0A\x01R\bdisabled\x126\n" +
+	"\x14consecutive_failures\x18\a \x01(\x05B\x03\xe0A\x03R\x13consecutiveFailures\x12\x1b\n" +
+	"\x06secret\x18\b \x01(\tB\x03\xe0A\x03R\x06secret\x12\"\n" +
+	"\n" +
+	"has_secret\x18\t \x01(\bB\x03\xe0A\x03R\thasSecret\x12\x1b\n" +
+	"\x06events\x18\n" +
+	" \x03(\tB\x03\xe0A\x01R\x06events\x12=\n" +
+	"\x06format\x18\v \x01(\x0e2 .memos.api.v1.UserWebhook.FormatB\x03\xe0A\x01R\x06format\x12:\n" +
+	"\x05scope\x18\f \x01(\x0e2\x1f.memos.api.v1.UserWebhook.ScopeB\x03\xe0A\x03R\x05scope\x12;\n" +
+	"\x17include_private_content\x18\r \x01(\bB\x03\xe0A\x01R\x15includePrivateContent\"A\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03RAW\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\v\n" +
+	"\aDISCORD\x10\x03\"7\n" +
+	"\x05Scope\x12\x15\n" +
+	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04USER\x10\x01\x12\r\n" +
+	"\tWORKSPACE\x10\x02\"\x96\x06\n" +
+	"\x13UserWebhookDelivery\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12(\n" +
+	"\ractivity_type\x18\x02 \x01(\tB\x03\xe0A\x03R\factivityType\x12&\n" +
+	"\fpayload_hash\x18\x03 \x01(\tB\x03\xe0A\x03R\vpayloadHash\x12B\n" +
+	"\x05state\x18\x04 \x01(\x0e2'.memos.api.v1.UserWebhookDelivery.StateB\x03\xe0A\x03R\x05state\x12\x1f\n" +
+	"\battempts\x18\x05 \x01(\x05B\x03\xe0A\x03R\battempts\x12,\n" +
+	"\x0fresponse_status\x18\x06 \x01(\x05B\x03\xe0A\x03R\x0eresponseStatus\x128\n" +
+	"\alatency\x18\a \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x03R\alatency\x12\x19\n" +
+	"\x05error\x18\b \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12K\n" +
+	"\x11next_attempt_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0fnextAttemptTime\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03:\x8f\x01\xeaA\x8b\x01\n" +
+	" memos.api.v1/UserWebhookDelivery\x125users/{user}/webhooks/{webhook}/deliveries/{delivery}\x1a\x04name*\x15userWebhookDeliveries2\x13userWebhookDelivery\"6\n" +
 	"\x17ListUserWebhooksRequest\x12\x1b\n" +
 	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\"Q\n" +
 	"\x18ListUserWebhooksResponse\x125\n" +
@@ -3081,7 +7179,34 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"3\n" +
 	"\x18DeleteUserWebhookRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xbe\x04\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"9\n" +
+	"\x1eRotateUserWebhookSecretRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"1\n" +
+	"\x16TestUserWebhookRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xa3\x02\n" +
+	"\x17TestUserWebhookResponse\x12\x1f\n" +
+	"\vstatus_code\x18\x01 \x01(\x05R\n" +
+	"statusCode\x12L\n" +
+	"\aheaders\x18\x02 \x03(\v22.memos.api.v1.TestUserWebhookResponse.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x123\n" +
+	"\alatency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
+	" ListUserWebhookDeliveriesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8e\x01\n" +
+	"!ListUserWebhookDeliveriesResponse\x12A\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2!.memos.api.v1.UserWebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"c\n" +
+	"#RedeliverUserWebhookDeliveryRequest\x12<\n" +
+	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
+	" memos.api.v1/UserWebhookDeliveryR\x04name\"\xc3\x05\n" +
 	"\x10UserNotification\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x121\n" +
 	"\x06sender\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -3096,10 +7221,18 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\".\n" +
+	"\bARCHIVED\x10\x02\"\xb2\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01:p\xeaAm\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x02\x12\x15\n" +
+	"\x11MEMO_AUTO_ARCHIVE\x10\x03\x12\x14\n" +
+	"\x10WEBHOOK_DISABLED\x10\x04\x12\x11\n" +
+	"\rBACKUP_FAILED\x10\x05\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x06\x12\x10\n" +
+	"\fMEMO_MENTION\x10\a\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\b:p\xeaAm\n" +
 	"\x1dmemos.api.v1/UserNotification\x12)users/{user}/notifications/{notification}\x1a\x04name*\rnotifications2\fnotificationB\x0e\n" +
 	"\f_activity_id\"\xb4\x01\n" +
 	"\x1cListUserNotificationsRequest\x121\n" +
@@ -3118,7 +7251,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"updateMask\"Z\n" +
 	"\x1dDeleteUserNotificationRequest\x129\n" +
 	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
-	"\x1dmemos.api.v1/UserNotificationR\x04name2\xfe\x18\n" +
+	"\x1dmemos.api.v1/UserNotificationR\x04name2\xd3B\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12b\n" +
 	"\aGetUser\x12\x1c.memos.api.v1.GetUserRequest\x1a\x12.memos.api.v1.User\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=users/*}\x12e\n" +
@@ -3129,7 +7262,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12~\n" +
 	"\x10ListAllUserStats\x12%.memos.api.v1.ListAllUserStatsRequest\x1a&.memos.api.v1.ListAllUserStatsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/users:stats\x12z\n" +
-	"\fGetUserStats\x12!.memos.api.v1.GetUserStatsRequest\x1a\x17.memos.api.v1.UserStats\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*}:getStats\x12\x82\x01\n" +
+	"\fGetUserStats\x12!.memos.api.v1.GetUserStatsRequest\x1a\x17.memos.api.v1.UserStats\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*}:getStats\x12\x96\x01\n" +
+	"\x13GetUserWritingStats\x12(.memos.api.v1.GetUserWritingStatsRequest\x1a\x1e.memos.api.v1.UserWritingStats\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(\x12&/api/v1/{name=users/*}:getWritingStats\x12\x82\x01\n" +
 	"\x0eGetUserSetting\x12#.memos.api.v1.GetUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/settings/*}\x12\xa8\x01\n" +
 	"\x11UpdateUserSetting\x12&.memos.api.v1.UpdateUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"P\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x024:\asetting2)/api/v1/{setting.name=users/*/settings/*}\x12\x95\x01\n" +
 	"\x10ListUserSettings\x12%.memos.api.v1.ListUserSettingsRequest\x1a&.memos.api.v1.ListUserSettingsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/settings\x12\xa5\x01\n" +
@@ -3137,11 +7271,43 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x15CreateUserAccessToken\x12*.memos.api.v1.CreateUserAccessTokenRequest\x1a\x1d.memos.api.v1.UserAccessToken\"Q\xdaA\x13parent,access_token\x82\xd3\xe4\x93\x025:\faccess_token\"%/api/v1/{parent=users/*}/accessTokens\x12\x91\x01\n" +
 	"\x15DeleteUserAccessToken\x12*.memos.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02'*%/api/v1/{name=users/*/accessTokens/*}\x12\x95\x01\n" +
 	"\x10ListUserSessions\x12%.memos.api.v1.ListUserSessionsRequest\x1a&.memos.api.v1.ListUserSessionsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/sessions\x12\x85\x01\n" +
-	"\x11RevokeUserSession\x12&.memos.api.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/sessions/*}\x12\x95\x01\n" +
-	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/webhooks\x12\x9b\x01\n" +
-	"\x11CreateUserWebhook\x12&.memos.api.v1.CreateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"C\xdaA\x0eparent,webhook\x82\xd3\xe4\x93\x02,:\awebhook\"!/api/v1/{parent=users/*}/webhooks\x12\xa8\x01\n" +
-	"\x11UpdateUserWebhook\x12&.memos.api.v1.UpdateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"P\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x024:\awebhook2)/api/v1/{webhook.name=users/*/webhooks/*}\x12\x85\x01\n" +
-	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xa9\x01\n" +
+	"\x11RevokeUserSession\x12&.memos.api.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/sessions/*}\x12\x93\x01\n" +
+	"\x17RevokeOtherUserSessions\x12,.memos.api.v1.RevokeOtherUserSessionsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#*!/api/v1/{parent=users/*}/sessions\x12\x87\x01\n" +
+	"\x10GetUserTwoFactor\x12%.memos.api.v1.GetUserTwoFactorRequest\x1a\x1b.memos.api.v1.UserTwoFactor\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/twoFactor}\x12\xa5\x01\n" +
+	"\x13EnrollUserTwoFactor\x12(.memos.api.v1.EnrollUserTwoFactorRequest\x1a).memos.api.v1.EnrollUserTwoFactorResponse\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=users/*/twoFactor}/enroll\x12\xae\x01\n" +
+	"\x14ConfirmUserTwoFactor\x12).memos.api.v1.ConfirmUserTwoFactorRequest\x1a*.memos.api.v1.ConfirmUserTwoFactorResponse\"?\xdaA\tname,code\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=users/*/twoFactor}/confirm\x12\x88\x01\n" +
+	"\x13DeleteUserTwoFactor\x12(.memos.api.v1.DeleteUserTwoFactorRequest\x1a\x16.google.protobuf.Empty\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"* /api/v1/{name=users/*/twoFactor}\x12\x95\x01\n" +
+	"\x10ListUserPasskeys\x12%.memos.api.v1.ListUserPasskeysRequest\x1a&.memos.api.v1.ListUserPasskeysResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/passkeys\x12\xaa\x01\n" +
+	"\x18CreateUserPasskeyOptions\x12-.memos.api.v1.CreateUserPasskeyOptionsRequest\x1a .memos.api.v1.UserPasskeyOptions\"=\xdaA\x06parent\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/{parent=users/*}/passkeys:options\x12\x8d\x01\n" +
+	"\x11CreateUserPasskey\x12&.memos.api.v1.CreateUserPasskeyRequest\x1a\x19.memos.api.v1.UserPasskey\"5\xdaA\x06parent\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/{parent=users/*}/passkeys\x12\x85\x01\n" +
+	"\x11DeleteUserPasskey\x12&.memos.api.v1.DeleteUserPasskeyRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/passkeys/*}\x12\xb9\x01\n" +
+	"\x19ListUserPushSubscriptions\x12..memos.api.v1.ListUserPushSubscriptionsRequest\x1a/.memos.api.v1.ListUserPushSubscriptionsResponse\";\xdaA\x06parent\x82\xd3\xe4\x93\x02,\x12*/api/v1/{parent=users/*}/pushSubscriptions\x12\xb1\x01\n" +
+	"\x1aCreateUserPushSubscription\x12/.memos.api.v1.CreateUserPushSubscriptionRequest\x1a\".memos.api.v1.UserPushSubscription\">\xdaA\x06parent\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{parent=users/*}/pushSubscriptions\x12\xa0\x01\n" +
+	"\x1aDeleteUserPushSubscription\x12/.memos.api.v1.DeleteUserPushSubscriptionRequest\x1a\x16.google.protobuf.Empty\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,**/api/v1/{name=users/*/pushSubscriptions/*}\x12\x87\x01\n" +
+	"\x10GetUserFeedToken\x12%.memos.api.v1.GetUserFeedTokenRequest\x1a\x1b.memos.api.v1.UserFeedToken\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/feedToken}\x12\x97\x01\n" +
+	"\x13RotateUserFeedToken\x12(.memos.api.v1.RotateUserFeedTokenRequest\x1a\x1b.memos.api.v1.UserFeedToken\"9\xdaA\x04name\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=users/*/feedToken}:rotate\x12\x88\x01\n" +
+	"\x13DeleteUserFeedToken\x12(.memos.api.v1.DeleteUserFeedTokenRequest\x1a\x16.google.protobuf.Empty\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"* /api/v1/{name=users/*/feedToken}\x12\x93\x01\n" +
+	"\x13GetUserInboundEmail\x12(.memos.api.v1.GetUserInboundEmailRequest\x1a\x1e.memos.api.v1.UserInboundEmail\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%\x12#/api/v1/{name=users/*/inboundEmail}\x12\xa3\x01\n" +
+	"\x16RotateUserInboundEmail\x12+.memos.api.v1.RotateUserInboundEmailRequest\x1a\x1e.memos.api.v1.UserInboundEmail\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=users/*/inboundEmail}:rotate\x12\x91\x01\n" +
+	"\x16DeleteUserInboundEmail\x12+.memos.api.v1.DeleteUserInboundEmailRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%*#/api/v1/{name=users/*/inboundEmail}\x12\x87\x01\n" +
+	"\x10GetUserSlackLink\x12%.memos.api.v1.GetUserSlackLinkRequest\x1a\x1b.memos.api.v1.UserSlackLink\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/slackLink}\x12\xa9\x01\n" +
+	"\x19GenerateUserSlackLinkCode\x12..memos.api.v1.GenerateUserSlackLinkCodeRequest\x1a\x1b.memos.api.v1.UserSlackLink\"?\xdaA\x04name\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/{name=users/*/slackLink}:generateCode\x12\x88\x01\n" +
+	"\x13DeleteUserSlackLink\x12(.memos.api.v1.DeleteUserSlackLinkRequest\x1a\x16.google.protobuf.Empty\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"* /api/v1/{name=users/*/slackLink}\x12\x8b\x01\n" +
+	"\x11GetUserMatrixLink\x12&.memos.api.v1.GetUserMatrixLinkRequest\x1a\x1c.memos.api.v1.UserMatrixLink\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/matrixLink}\x12\xad\x01\n" +
+	"\x1aGenerateUserMatrixLinkCode\x12/.memos.api.v1.GenerateUserMatrixLinkCodeRequest\x1a\x1c.memos.api.v1.UserMatrixLink\"@\xdaA\x04name\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/{name=users/*/matrixLink}:generateCode\x12\x8b\x01\n" +
+	"\x14DeleteUserMatrixLink\x12).memos.api.v1.DeleteUserMatrixLinkRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/matrixLink}\x12v\n" +
+	"\n" +
+	"UnlockUser\x12\x1f.memos.api.v1.UnlockUserRequest\x1a\x16.google.protobuf.Empty\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/{name=users/*}:unlock\x12u\n" +
+	"\vSuspendUser\x12 .memos.api.v1.SuspendUserRequest\x1a\x12.memos.api.v1.User\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=users/*}:suspend\x12{\n" +
+	"\rUnsuspendUser\x12\".memos.api.v1.UnsuspendUserRequest\x1a\x12.memos.api.v1.User\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=users/*}:unsuspend\x12\xbb\x01\n" +
+	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"X\xdaA\x06parent\x82\xd3\xe4\x93\x02IZ$\x12\"/api/v1/{parent=instance}/webhooks\x12!/api/v1/{parent=users/*}/webhooks\x12\xca\x01\n" +
+	"\x11CreateUserWebhook\x12&.memos.api.v1.CreateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"r\xdaA\x0eparent,webhook\x82\xd3\xe4\x93\x02[:\awebhookZ-:\awebhook\"\"/api/v1/{parent=instance}/webhooks\"!/api/v1/{parent=users/*}/webhooks\x12\xe0\x01\n" +
+	"\x11UpdateUserWebhook\x12&.memos.api.v1.UpdateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"\x87\x01\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x02k:\awebhookZ5:\awebhook2*/api/v1/{webhook.name=instance/webhooks/*}2)/api/v1/{webhook.name=users/*/webhooks/*}\x12\xab\x01\n" +
+	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"V\xdaA\x04name\x82\xd3\xe4\x93\x02IZ$*\"/api/v1/{name=instance/webhooks/*}*!/api/v1/{name=users/*/webhooks/*}\x12\xda\x01\n" +
+	"\x17RotateUserWebhookSecret\x12,.memos.api.v1.RotateUserWebhookSecretRequest\x1a\x19.memos.api.v1.UserWebhook\"v\xdaA\x04name\x82\xd3\xe4\x93\x02i:\x01*Z4:\x01*\"//api/v1/{name=instance/webhooks/*}:rotateSecret\"./api/v1/{name=users/*/webhooks/*}:rotateSecret\x12\xc6\x01\n" +
+	"\x0fTestUserWebhook\x12$.memos.api.v1.TestUserWebhookRequest\x1a%.memos.api.v1.TestUserWebhookResponse\"f\xdaA\x04name\x82\xd3\xe4\x93\x02Y:\x01*Z,:\x01*\"'/api/v1/{name=instance/webhooks/*}:test\"&/api/v1/{name=users/*/webhooks/*}:test\x12\xf0\x01\n" +
+	"\x19ListUserWebhookDeliveries\x12..memos.api.v1.ListUserWebhookDeliveriesRequest\x1a/.memos.api.v1.ListUserWebhookDeliveriesResponse\"r\xdaA\x06parent\x82\xd3\xe4\x93\x02cZ1\x12//api/v1/{parent=instance/webhooks/*}/deliveries\x12./api/v1/{parent=users/*/webhooks/*}/deliveries\x12\x81\x02\n" +
+	"\x1cRedeliverUserWebhookDelivery\x121.memos.api.v1.RedeliverUserWebhookDeliveryRequest\x1a!.memos.api.v1.UserWebhookDelivery\"\x8a\x01\xdaA\x04name\x82\xd3\xe4\x93\x02}:\x01*Z>:\x01*\"9/api/v1/{name=instance/webhooks/*/deliveries/*}:redeliver\"8/api/v1/{name=users/*/webhooks/*/deliveries/*}:redeliver\x12\xa9\x01\n" +
 	"\x15ListUserNotifications\x12*.memos.api.v1.ListUserNotificationsRequest\x1a+.memos.api.v1.ListUserNotificationsResponse\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=users/*}/notifications\x12\xcb\x01\n" +
 	"\x16UpdateUserNotification\x12+.memos.api.v1.UpdateUserNotificationRequest\x1a\x1e.memos.api.v1.UserNotification\"d\xdaA\x18notification,update_mask\x82\xd3\xe4\x93\x02C:\fnotification23/api/v1/{notification.name=users/*/notifications/*}\x12\x94\x01\n" +
 	"\x16DeleteUserNotification\x12+.memos.api.v1.DeleteUserNotificationRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/notifications/*}B\xa8\x01\n" +
@@ -3159,154 +7325,312 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(User_Role)(0),                          // 0: memos.api.v1.User.Role
-	(UserSetting_Key)(0),                    // 1: memos.api.v1.UserSetting.Key
-	(UserNotification_Status)(0),            // 2: memos.api.v1.UserNotification.Status
-	(UserNotification_Type)(0),              // 3: memos.api.v1.UserNotification.Type
-	(*User)(nil),                            // 4: memos.api.v1.User
-	(*ListUsersRequest)(nil),                // 5: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),               // 6: memos.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),                  // 7: memos.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),               // 8: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),               // 9: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),               // 10: memos.api.v1.DeleteUserRequest
-	(*UserStats)(nil),                       // 11: memos.api.v1.UserStats
-	(*GetUserStatsRequest)(nil),             // 12: memos.api.v1.GetUserStatsRequest
-	(*ListAllUserStatsRequest)(nil),         // 13: memos.api.v1.ListAllUserStatsRequest
-	(*ListAllUserStatsResponse)(nil),        // 14: memos.api.v1.ListAllUserStatsResponse
-	(*UserSetting)(nil),                     // 15: memos.api.v1.UserSetting
-	(*GetUserSettingRequest)(nil),           // 16: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),        // 17: memos.api.v1.UpdateUserSettingRequest
-	(*ListUserSettingsRequest)(nil),         // 18: memos.api.v1.ListUserSettingsRequest
-	(*ListUserSettingsResponse)(nil),        // 19: memos.api.v1.ListUserSettingsResponse
-	(*UserAccessToken)(nil),                 // 20: memos.api.v1.UserAccessToken
-	(*ListUserAccessTokensRequest)(nil),     // 21: memos.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil),    // 22: memos.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil),    // 23: memos.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil),    // 24: memos.api.v1.DeleteUserAccessTokenRequest
-	(*UserSession)(nil),                     // 25: memos.api.v1.UserSession
-	(*ListUserSessionsRequest)(nil),         // 26: memos.api.v1.ListUserSessionsRequest
-	(*ListUserSessionsResponse)(nil),        // 27: memos.api.v1.ListUserSessionsResponse
-	(*RevokeUserSessionRequest)(nil),        // 28: memos.api.v1.RevokeUserSessionRequest
-	(*UserWebhook)(nil),                     // 29: memos.api.v1.UserWebhook
-	(*ListUserWebhooksRequest)(nil),         // 30: memos.api.v1.ListUserWebhooksRequest
-	(*ListUserWebhooksResponse)(nil),        // 31: memos.api.v1.ListUserWebhooksResponse
-	(*CreateUserWebhookRequest)(nil),        // 32: memos.api.v1.CreateUserWebhookRequest
-	(*UpdateUserWebhookRequest)(nil),        // 33: memos.api.v1.UpdateUserWebhookRequest
-	(*DeleteUserWebhookRequest)(nil),        // 34: memos.api.v1.DeleteUserWebhookRequest
-	(*UserNotification)(nil),                // 35: memos.api.v1.UserNotification
-	(*ListUserNotificationsRequest)(nil),    // 36: memos.api.v1.ListUserNotificationsRequest
-	(*ListUserNotificationsResponse)(nil),   // 37: memos.api.v1.ListUserNotificationsResponse
-	(*UpdateUserNotificationRequest)(nil),   // 38: memos.api.v1.UpdateUserNotificationRequest
-	(*DeleteUserNotificationRequest)(nil),   // 39: memos.api.v1.DeleteUserNotificationRequest
-	nil,                                     // 40: memos.api.v1.UserStats.TagCountEntry
-	(*UserStats_MemoTypeStats)(nil),         // 41: memos.api.v1.UserStats.MemoTypeStats
-	(*UserSetting_GeneralSetting)(nil),      // 42: memos.api.v1.UserSetting.GeneralSetting
-	(*UserSetting_SessionsSetting)(nil),     // 43: memos.api.v1.UserSetting.SessionsSetting
-	(*UserSetting_AccessTokensSetting)(nil), // 44: memos.api.v1.UserSetting.AccessTokensSetting
-	(*UserSetting_WebhooksSetting)(nil),     // 45: memos.api.v1.UserSetting.WebhooksSetting
-	(*UserSession_ClientInfo)(nil),          // 46: memos.api.v1.UserSession.ClientInfo
-	(State)(0),                              // 47: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil),           // 48: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),           // 49: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                   // 50: google.protobuf.Empty
+	(User_Role)(0),       // 0: memos.api.v1.User.Role
+	(UserSetting_Key)(0), // 1: memos.api.v1.UserSetting.Key
+	(UserSetting_NotificationSetting_DigestFrequency)(0), // 2: memos.api.v1.UserSetting.NotificationSetting.DigestFrequency
+	(UserWebhook_Format)(0),                              // 3: memos.api.v1.UserWebhook.Format
+	(UserWebhook_Scope)(0),                               // 4: memos.api.v1.UserWebhook.Scope
+	(UserWebhookDelivery_State)(0),                       // 5: memos.api.v1.UserWebhookDelivery.State
+	(UserNotification_Status)(0),                         // 6: memos.api.v1.UserNotification.Status
+	(UserNotification_Type)(0),                           // 7: memos.api.v1.UserNotification.Type
+	(*User)(nil),                                         // 8: memos.api.v1.User
+	(*ListUsersRequest)(nil),                             // 9: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                            // 10: memos.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                               // 11: memos.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                            // 12: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                            // 13: memos.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                            // 14: memos.api.v1.DeleteUserRequest
+	(*UserStats)(nil),                                    // 15: memos.api.v1.UserStats
+	(*GetUserStatsRequest)(nil),                          // 16: memos.api.v1.GetUserStatsRequest
+	(*UserWritingStats)(nil),                             // 17: memos.api.v1.UserWritingStats
+	(*GetUserWritingStatsRequest)(nil),                   // 18: memos.api.v1.GetUserWritingStatsRequest
+	(*ListAllUserStatsRequest)(nil),                      // 19: memos.api.v1.ListAllUserStatsRequest
+	(*ListAllUserStatsResponse)(nil),                     // 20: memos.api.v1.ListAllUserStatsResponse
+	(*UserSetting)(nil),                                  // 21: memos.api.v1.UserSetting
+	(*GetUserSettingRequest)(nil),                        // 22: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),                     // 23: memos.api.v1.UpdateUserSettingRequest
+	(*ListUserSettingsRequest)(nil),                      // 24: memos.api.v1.ListUserSettingsRequest
+	(*ListUserSettingsResponse)(nil),                     // 25: memos.api.v1.ListUserSettingsResponse
+	(*UserAccessToken)(nil),                              // 26: memos.api.v1.UserAccessToken
+	(*ListUserAccessTokensRequest)(nil),                  // 27: memos.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil),                 // 28: memos.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil),                 // 29: memos.api.v1.CreateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil),                 // 30: memos.api.v1.DeleteUserAccessTokenRequest
+	(*UserSession)(nil),                                  // 31: memos.api.v1.UserSession
+	(*ListUserSessionsRequest)(nil),                      // 32: memos.api.v1.ListUserSessionsRequest
+	(*ListUserSessionsResponse)(nil),                     // 33: memos.api.v1.ListUserSessionsResponse
+	(*RevokeUserSessionRequest)(nil),                     // 34: memos.api.v1.RevokeUserSessionRequest
+	(*RevokeOtherUserSessionsRequest)(nil),               // 35: memos.api.v1.RevokeOtherUserSessionsRequest
+	(*UserTwoFactor)(nil),                                // 36: memos.api.v1.UserTwoFactor
+	(*GetUserTwoFactorRequest)(nil),                      // 37: memos.api.v1.GetUserTwoFactorRequest
+	(*EnrollUserTwoFactorRequest)(nil),                   // 38: memos.api.v1.EnrollUserTwoFactorRequest
+	(*EnrollUserTwoFactorResponse)(nil),                  // 39: memos.api.v1.EnrollUserTwoFactorResponse
+	(*ConfirmUserTwoFactorRequest)(nil),                  // 40: memos.api.v1.ConfirmUserTwoFactorRequest
+	(*ConfirmUserTwoFactorResponse)(nil),                 // 41: memos.api.v1.ConfirmUserTwoFactorResponse
+	(*DeleteUserTwoFactorRequest)(nil),                   // 42: memos.api.v1.DeleteUserTwoFactorRequest
+	(*UserPasskey)(nil),                                  // 43: memos.api.v1.UserPasskey
+	(*ListUserPasskeysRequest)(nil),                      // 44: memos.api.v1.ListUserPasskeysRequest
+	(*ListUserPasskeysResponse)(nil),                     // 45: memos.api.v1.ListUserPasskeysResponse
+	(*CreateUserPasskeyOptionsRequest)(nil),              // 46: memos.api.v1.CreateUserPasskeyOptionsRequest
+	(*UserPasskeyOptions)(nil),                           // 47: memos.api.v1.UserPasskeyOptions
+	(*CreateUserPasskeyRequest)(nil),                     // 48: memos.api.v1.CreateUserPasskeyRequest
+	(*DeleteUserPasskeyRequest)(nil),                     // 49: memos.api.v1.DeleteUserPasskeyRequest
+	(*UserPushSubscription)(nil),                         // 50: memos.api.v1.UserPushSubscription
+	(*ListUserPushSubscriptionsRequest)(nil),             // 51: memos.api.v1.ListUserPushSubscriptionsRequest
+	(*ListUserPushSubscriptionsResponse)(nil),            // 52: memos.api.v1.ListUserPushSubscriptionsResponse
+	(*CreateUserPushSubscriptionRequest)(nil),            // 53: memos.api.v1.CreateUserPushSubscriptionRequest
+	(*DeleteUserPushSubscriptionRequest)(nil),            // 54: memos.api.v1.DeleteUserPushSubscriptionRequest
+	(*UserFeedToken)(nil),                                // 55: memos.api.v1.UserFeedToken
+	(*GetUserFeedTokenRequest)(nil),                      // 56: memos.api.v1.GetUserFeedTokenRequest
+	(*RotateUserFeedTokenRequest)(nil),                   // 57: memos.api.v1.RotateUserFeedTokenRequest
+	(*DeleteUserFeedTokenRequest)(nil),                   // 58: memos.api.v1.DeleteUserFeedTokenRequest
+	(*UserInboundEmail)(nil),                             // 59: memos.api.v1.UserInboundEmail
+	(*GetUserInboundEmailRequest)(nil),                   // 60: memos.api.v1.GetUserInboundEmailRequest
+	(*RotateUserInboundEmailRequest)(nil),                // 61: memos.api.v1.RotateUserInboundEmailRequest
+	(*DeleteUserInboundEmailRequest)(nil),                // 62: memos.api.v1.DeleteUserInboundEmailRequest
+	(*UserSlackLink)(nil),                                // 63: memos.api.v1.UserSlackLink
+	(*GetUserSlackLinkRequest)(nil),                      // 64: memos.api.v1.GetUserSlackLinkRequest
+	(*GenerateUserSlackLinkCodeRequest)(nil),             // 65: memos.api.v1.GenerateUserSlackLinkCodeRequest
+	(*DeleteUserSlackLinkRequest)(nil),                   // 66: memos.api.v1.DeleteUserSlackLinkRequest
+	(*UserMatrixLink)(nil),                               // 67: memos.api.v1.UserMatrixLink
+	(*GetUserMatrixLinkRequest)(nil),                     // 68: memos.api.v1.GetUserMatrixLinkRequest
+	(*GenerateUserMatrixLinkCodeRequest)(nil),            // 69: memos.api.v1.GenerateUserMatrixLinkCodeRequest
+	(*DeleteUserMatrixLinkRequest)(nil),                  // 70: memos.api.v1.DeleteUserMatrixLinkRequest
+	(*UnlockUserRequest)(nil),                            // 71: memos.api.v1.UnlockUserRequest
+	(*SuspendUserRequest)(nil),                           // 72: memos.api.v1.SuspendUserRequest
+	(*UnsuspendUserRequest)(nil),                         // 73: memos.api.v1.UnsuspendUserRequest
+	(*UserWebhook)(nil),                                  // 74: memos.api.v1.UserWebhook
+	(*UserWebhookDelivery)(nil),                          // 75: memos.api.v1.UserWebhookDelivery
+	(*ListUserWebhooksRequest)(nil),                      // 76: memos.api.v1.ListUserWebhooksRequest
+	(*ListUserWebhooksResponse)(nil),                     // 77: memos.api.v1.ListUserWebhooksResponse
+	(*CreateUserWebhookRequest)(nil),                     // 78: memos.api.v1.CreateUserWebhookRequest
+	(*UpdateUserWebhookRequest)(nil),                     // 79: memos.api.v1.UpdateUserWebhookRequest
+	(*DeleteUserWebhookRequest)(nil),                     // 80: memos.api.v1.DeleteUserWebhookRequest
+	(*RotateUserWebhookSecretRequest)(nil),               // 81: memos.api.v1.RotateUserWebhookSecretRequest
+	(*TestUserWebhookRequest)(nil),                       // 82: memos.api.v1.TestUserWebhookRequest
+	(*TestUserWebhookResponse)(nil),                      // 83: memos.api.v1.TestUserWebhookResponse
+	(*ListUserWebhookDeliveriesRequest)(nil),             // 84: memos.api.v1.ListUserWebhookDeliveriesRequest
+	(*ListUserWebhookDeliveriesResponse)(nil),            // 85: memos.api.v1.ListUserWebhookDeliveriesResponse
+	(*RedeliverUserWebhookDeliveryRequest)(nil),          // 86: memos.api.v1.RedeliverUserWebhookDeliveryRequest
+	(*UserNotification)(nil),                             // 87: memos.api.v1.UserNotification
+	(*ListUserNotificationsRequest)(nil),                 // 88: memos.api.v1.ListUserNotificationsRequest
+	(*ListUserNotificationsResponse)(nil),                // 89: memos.api.v1.ListUserNotificationsResponse
+	(*UpdateUserNotificationRequest)(nil),                // 90: memos.api.v1.UpdateUserNotificationRequest
+	(*DeleteUserNotificationRequest)(nil),                // 91: memos.api.v1.DeleteUserNotificationRequest
+	nil,                                                  // 92: memos.api.v1.UserStats.TagCountEntry
+	(*UserStats_MemoTypeStats)(nil),                      // 93: memos.api.v1.UserStats.MemoTypeStats
+	nil,                                                  // 94: memos.api.v1.UserWritingStats.TagMemoCountsEntry
+	(*UserWritingStats_MonthCount)(nil),                  // 95: memos.api.v1.UserWritingStats.MonthCount
+	(*UserSetting_GeneralSetting)(nil),                   // 96: memos.api.v1.UserSetting.GeneralSetting
+	(*UserSetting_SessionsSetting)(nil),                  // 97: memos.api.v1.UserSetting.SessionsSetting
+	(*UserSetting_AccessTokensSetting)(nil),              // 98: memos.api.v1.UserSetting.AccessTokensSetting
+	(*UserSetting_WebhooksSetting)(nil),                  // 99: memos.api.v1.UserSetting.WebhooksSetting
+	(*UserSetting_AutoArchiveSetting)(nil),               // 100: memos.api.v1.UserSetting.AutoArchiveSetting
+	(*UserSetting_NotificationSetting)(nil),              // 101: memos.api.v1.UserSetting.NotificationSetting
+	(*UserSetting_StorageSetting)(nil),                   // 102: memos.api.v1.UserSetting.StorageSetting
+	(*UserSetting_MemoQuotaSetting)(nil),                 // 103: memos.api.v1.UserSetting.MemoQuotaSetting
+	(*UserSession_ClientInfo)(nil),                       // 104: memos.api.v1.UserSession.ClientInfo
+	nil,                                                  // 105: memos.api.v1.TestUserWebhookResponse.HeadersEntry
+	(State)(0),                                           // 106: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil),                        // 107: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                        // 108: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                          // 109: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                                // 110: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
-	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	47, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
-	48, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	48, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	4,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	49, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
-	4,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	4,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	49, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	48, // 9: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
-	41, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
-	40, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
-	11, // 12: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
-	42, // 13: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
-	43, // 14: memos.api.v1.UserSetting.sessions_setting:type_name -> memos.api.v1.UserSetting.SessionsSetting
-	44, // 15: memos.api.v1.UserSetting.access_tokens_setting:type_name -> memos.api.v1.UserSetting.AccessTokensSetting
-	45, // 16: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
-	15, // 17: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	49, // 18: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	15, // 19: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
-	48, // 20: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	48, // 21: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	20, // 22: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
-	20, // 23: memos.api.v1.CreateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
-	48, // 24: memos.api.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
-	48, // 25: memos.api.v1.UserSession.last_accessed_time:type_name -> google.protobuf.Timestamp
-	46, // 26: memos.api.v1.UserSession.client_info:type_name -> memos.api.v1.UserSession.ClientInfo
-	25, // 27: memos.api.v1.ListUserSessionsResponse.sessions:type_name -> memos.api.v1.UserSession
-	48, // 28: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
-	48, // 29: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
-	29, // 30: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
-	29, // 31: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	29, // 32: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	49, // 33: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 34: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
-	48, // 35: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
-	3,  // 36: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
-	35, // 37: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
-	35, // 38: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
-	49, // 39: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	25, // 40: memos.api.v1.UserSetting.SessionsSetting.sessions:type_name -> memos.api.v1.UserSession
-	20, // 41: memos.api.v1.UserSetting.AccessTokensSetting.access_tokens:type_name -> memos.api.v1.UserAccessToken
-	29, // 42: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
-	5,  // 43: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	7,  // 44: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	8,  // 45: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	9,  // 46: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	10, // 47: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	13, // 48: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
-	12, // 49: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
-	16, // 50: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	17, // 51: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	18, // 52: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
-	21, // 53: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
-	23, // 54: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
-	24, // 55: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
-	26, // 56: memos.api.v1.UserService.ListUserSessions:input_type -> memos.api.v1.ListUserSessionsRequest
-	28, // 57: memos.api.v1.UserService.RevokeUserSession:input_type -> memos.api.v1.RevokeUserSessionRequest
-	30, // 58: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
-	32, // 59: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
-	33, // 60: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
-	34, // 61: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
-	36, // 62: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
-	38, // 63: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
-	39, // 64: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
-	6,  // 65: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	4,  // 66: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	4,  // 67: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	4,  // 68: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	50, // 69: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	14, // 70: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
-	11, // 71: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
-	15, // 72: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	15, // 73: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	19, // 74: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
-	22, // 75: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
-	20, // 76: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
-	50, // 77: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	27, // 78: memos.api.v1.UserService.ListUserSessions:output_type -> memos.api.v1.ListUserSessionsResponse
-	50, // 79: memos.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
-	31, // 80: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
-	29, // 81: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	29, // 82: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	50, // 83: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
-	37, // 84: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
-	35, // 85: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
-	50, // 86: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
-	65, // [65:87] is the sub-list for method output_type
-	43, // [43:65] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	0,   // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
+	106, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
+	107, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	107, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	107, // 4: memos.api.v1.User.suspend_time:type_name -> google.protobuf.Timestamp
+	8,   // 5: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	108, // 6: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	8,   // 7: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	8,   // 8: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	108, // 9: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	107, // 10: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
+	93,  // 11: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
+	92,  // 12: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
+	94,  // 13: memos.api.v1.UserWritingStats.tag_memo_counts:type_name -> memos.api.v1.UserWritingStats.TagMemoCountsEntry
+	95,  // 14: memos.api.v1.UserWritingStats.month_counts:type_name -> memos.api.v1.UserWritingStats.MonthCount
+	15,  // 15: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
+	96,  // 16: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
+	97,  // 17: memos.api.v1.UserSetting.sessions_setting:type_name -> memos.api.v1.UserSetting.SessionsSetting
+	98,  // 18: memos.api.v1.UserSetting.access_tokens_setting:type_name -> memos.api.v1.UserSetting.AccessTokensSetting
+	99,  // 19: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
+	100, // 20: memos.api.v1.UserSetting.auto_archive_setting:type_name -> memos.api.v1.UserSetting.AutoArchiveSetting
+	102, // 21: memos.api.v1.UserSetting.storage_setting:type_name -> memos.api.v1.UserSetting.StorageSetting
+	103, // 22: memos.api.v1.UserSetting.memo_quota_setting:type_name -> memos.api.v1.UserSetting.MemoQuotaSetting
+	101, // 23: memos.api.v1.UserSetting.notification_setting:type_name -> memos.api.v1.UserSetting.NotificationSetting
+	21,  // 24: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	108, // 25: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	21,  // 26: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
+	107, // 27: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	107, // 28: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	107, // 29: memos.api.v1.UserAccessToken.last_used_time:type_name -> google.protobuf.Timestamp
+	26,  // 30: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
+	26,  // 31: memos.api.v1.CreateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
+	107, // 32: memos.api.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
+	107, // 33: memos.api.v1.UserSession.last_accessed_time:type_name -> google.protobuf.Timestamp
+	104, // 34: memos.api.v1.UserSession.client_info:type_name -> memos.api.v1.UserSession.ClientInfo
+	31,  // 35: memos.api.v1.ListUserSessionsResponse.sessions:type_name -> memos.api.v1.UserSession
+	107, // 36: memos.api.v1.UserTwoFactor.enable_time:type_name -> google.protobuf.Timestamp
+	107, // 37: memos.api.v1.UserPasskey.create_time:type_name -> google.protobuf.Timestamp
+	107, // 38: memos.api.v1.UserPasskey.last_used_time:type_name -> google.protobuf.Timestamp
+	43,  // 39: memos.api.v1.ListUserPasskeysResponse.passkeys:type_name -> memos.api.v1.UserPasskey
+	107, // 40: memos.api.v1.UserPushSubscription.create_time:type_name -> google.protobuf.Timestamp
+	107, // 41: memos.api.v1.UserPushSubscription.expire_time:type_name -> google.protobuf.Timestamp
+	107, // 42: memos.api.v1.UserPushSubscription.last_push_time:type_name -> google.protobuf.Timestamp
+	50,  // 43: memos.api.v1.ListUserPushSubscriptionsResponse.push_subscriptions:type_name -> memos.api.v1.UserPushSubscription
+	107, // 44: memos.api.v1.CreateUserPushSubscriptionRequest.expire_time:type_name -> google.protobuf.Timestamp
+	107, // 45: memos.api.v1.UserFeedToken.create_time:type_name -> google.protobuf.Timestamp
+	107, // 46: memos.api.v1.UserInboundEmail.create_time:type_name -> google.protobuf.Timestamp
+	107, // 47: memos.api.v1.UserSlackLink.link_time:type_name -> google.protobuf.Timestamp
+	107, // 48: memos.api.v1.UserSlackLink.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	107, // 49: memos.api.v1.UserMatrixLink.link_time:type_name -> google.protobuf.Timestamp
+	107, // 50: memos.api.v1.UserMatrixLink.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	107, // 51: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
+	107, // 52: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
+	3,   // 53: memos.api.v1.UserWebhook.format:type_name -> memos.api.v1.UserWebhook.Format
+	4,   // 54: memos.api.v1.UserWebhook.scope:type_name -> memos.api.v1.UserWebhook.Scope
+	5,   // 55: memos.api.v1.UserWebhookDelivery.state:type_name -> memos.api.v1.UserWebhookDelivery.State
+	109, // 56: memos.api.v1.UserWebhookDelivery.latency:type_name -> google.protobuf.Duration
+	107, // 57: memos.api.v1.UserWebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	107, // 58: memos.api.v1.UserWebhookDelivery.update_time:type_name -> google.protobuf.Timestamp
+	107, // 59: memos.api.v1.UserWebhookDelivery.next_attempt_time:type_name -> google.protobuf.Timestamp
+	74,  // 60: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
+	74,  // 61: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	74,  // 62: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	108, // 63: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	105, // 64: memos.api.v1.TestUserWebhookResponse.headers:type_name -> memos.api.v1.TestUserWebhookResponse.HeadersEntry
+	109, // 65: memos.api.v1.TestUserWebhookResponse.latency:type_name -> google.protobuf.Duration
+	75,  // 66: memos.api.v1.ListUserWebhookDeliveriesResponse.deliveries:type_name -> memos.api.v1.UserWebhookDelivery
+	6,   // 67: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
+	107, // 68: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
+	7,   // 69: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
+	87,  // 70: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
+	87,  // 71: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
+	108, // 72: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	31,  // 73: memos.api.v1.UserSetting.SessionsSetting.sessions:type_name -> memos.api.v1.UserSession
+	26,  // 74: memos.api.v1.UserSetting.AccessTokensSetting.access_tokens:type_name -> memos.api.v1.UserAccessToken
+	74,  // 75: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
+	2,   // 76: memos.api.v1.UserSetting.NotificationSetting.digest_frequency:type_name -> memos.api.v1.UserSetting.NotificationSetting.DigestFrequency
+	9,   // 77: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	11,  // 78: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	12,  // 79: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	13,  // 80: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	14,  // 81: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	19,  // 82: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
+	16,  // 83: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
+	18,  // 84: memos.api.v1.UserService.GetUserWritingStats:input_type -> memos.api.v1.GetUserWritingStatsRequest
+	22,  // 85: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	23,  // 86: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	24,  // 87: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
+	27,  // 88: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
+	29,  // 89: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
+	30,  // 90: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
+	32,  // 91: memos.api.v1.UserService.ListUserSessions:input_type -> memos.api.v1.ListUserSessionsRequest
+	34,  // 92: memos.api.v1.UserService.RevokeUserSession:input_type -> memos.api.v1.RevokeUserSessionRequest
+	35,  // 93: memos.api.v1.UserService.RevokeOtherUserSessions:input_type -> memos.api.v1.RevokeOtherUserSessionsRequest
+	37,  // 94: memos.api.v1.UserService.GetUserTwoFactor:input_type -> memos.api.v1.GetUserTwoFactorRequest
+	38,  // 95: memos.api.v1.UserService.EnrollUserTwoFactor:input_type -> memos.api.v1.EnrollUserTwoFactorRequest
+	40,  // 96: memos.api.v1.UserService.ConfirmUserTwoFactor:input_type -> memos.api.v1.ConfirmUserTwoFactorRequest
+	42,  // 97: memos.api.v1.UserService.DeleteUserTwoFactor:input_type -> memos.api.v1.DeleteUserTwoFactorRequest
+	44,  // 98: memos.api.v1.UserService.ListUserPasskeys:input_type -> memos.api.v1.ListUserPasskeysRequest
+	46,  // 99: memos.api.v1.UserService.CreateUserPasskeyOptions:input_type -> memos.api.v1.CreateUserPasskeyOptionsRequest
+	48,  // 100: memos.api.v1.UserService.CreateUserPasskey:input_type -> memos.api.v1.CreateUserPasskeyRequest
+	49,  // 101: memos.api.v1.UserService.DeleteUserPasskey:input_type -> memos.api.v1.DeleteUserPasskeyRequest
+	51,  // 102: memos.api.v1.UserService.ListUserPushSubscriptions:input_type -> memos.api.v1.ListUserPushSubscriptionsRequest
+	53,  // 103: memos.api.v1.UserService.CreateUserPushSubscription:input_type -> memos.api.v1.CreateUserPushSubscriptionRequest
+	54,  // 104: memos.api.v1.UserService.DeleteUserPushSubscription:input_type -> memos.api.v1.DeleteUserPushSubscriptionRequest
+	56,  // 105: memos.api.v1.UserService.GetUserFeedToken:input_type -> memos.api.v1.GetUserFeedTokenRequest
+	57,  // 106: memos.api.v1.UserService.RotateUserFeedToken:input_type -> memos.api.v1.RotateUserFeedTokenRequest
+	58,  // 107: memos.api.v1.UserService.DeleteUserFeedToken:input_type -> memos.api.v1.DeleteUserFeedTokenRequest
+	60,  // 108: memos.api.v1.UserService.GetUserInboundEmail:input_type -> memos.api.v1.GetUserInboundEmailRequest
+	61,  // 109: memos.api.v1.UserService.RotateUserInboundEmail:input_type -> memos.api.v1.RotateUserInboundEmailRequest
+	62,  // 110: memos.api.v1.UserService.DeleteUserInboundEmail:input_type -> memos.api.v1.DeleteUserInboundEmailRequest
+	64,  // 111: memos.api.v1.UserService.GetUserSlackLink:input_type -> memos.api.v1.GetUserSlackLinkRequest
+	65,  // 112: memos.api.v1.UserService.GenerateUserSlackLinkCode:input_type -> memos.api.v1.GenerateUserSlackLinkCodeRequest
+	66,  // 113: memos.api.v1.UserService.DeleteUserSlackLink:input_type -> memos.api.v1.DeleteUserSlackLinkRequest
+	68,  // 114: memos.api.v1.UserService.GetUserMatrixLink:input_type -> memos.api.v1.GetUserMatrixLinkRequest
+	69,  // 115: memos.api.v1.UserService.GenerateUserMatrixLinkCode:input_type -> memos.api.v1.GenerateUserMatrixLinkCodeRequest
+	70,  // 116: memos.api.v1.UserService.DeleteUserMatrixLink:input_type -> memos.api.v1.DeleteUserMatrixLinkRequest
+	71,  // 117: memos.api.v1.UserService.UnlockUser:input_type -> memos.api.v1.UnlockUserRequest
+	72,  // 118: memos.api.v1.UserService.SuspendUser:input_type -> memos.api.v1.SuspendUserRequest
+	73,  // 119: memos.api.v1.UserService.UnsuspendUser:input_type -> memos.api.v1.UnsuspendUserRequest
+	76,  // 120: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
+	78,  // 121: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
+	79,  // 122: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
+	80,  // 123: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
+	81,  // 124: memos.api.v1.UserService.RotateUserWebhookSecret:input_type -> memos.api.v1.RotateUserWebhookSecretRequest
+	82,  // 125: memos.api.v1.UserService.TestUserWebhook:input_type -> memos.api.v1.TestUserWebhookRequest
+	84,  // 126: memos.api.v1.UserService.ListUserWebhookDeliveries:input_type -> memos.api.v1.ListUserWebhookDeliveriesRequest
+	86,  // 127: memos.api.v1.UserService.RedeliverUserWebhookDelivery:input_type -> memos.api.v1.RedeliverUserWebhookDeliveryRequest
+	88,  // 128: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
+	90,  // 129: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
+	91,  // 130: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
+	10,  // 131: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	8,   // 132: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	8,   // 133: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	8,   // 134: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	110, // 135: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	20,  // 136: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
+	15,  // 137: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
+	17,  // 138: memos.api.v1.UserService.GetUserWritingStats:output_type -> memos.api.v1.UserWritingStats
+	21,  // 139: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	21,  // 140: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	25,  // 141: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
+	28,  // 142: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
+	26,  // 143: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
+	110, // 144: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	33,  // 145: memos.api.v1.UserService.ListUserSessions:output_type -> memos.api.v1.ListUserSessionsResponse
+	110, // 146: memos.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
+	110, // 147: memos.api.v1.UserService.RevokeOtherUserSessions:output_type -> google.protobuf.Empty
+	36,  // 148: memos.api.v1.UserService.GetUserTwoFactor:output_type -> memos.api.v1.UserTwoFactor
+	39,  // 149: memos.api.v1.UserService.EnrollUserTwoFactor:output_type -> memos.api.v1.EnrollUserTwoFactorResponse
+	41,  // 150: memos.api.v1.UserService.ConfirmUserTwoFactor:output_type -> memos.api.v1.ConfirmUserTwoFactorResponse
+	110, // 151: memos.api.v1.UserService.DeleteUserTwoFactor:output_type -> google.protobuf.Empty
+	45,  // 152: memos.api.v1.UserService.ListUserPasskeys:output_type -> memos.api.v1.ListUserPasskeysResponse
+	47,  // 153: memos.api.v1.UserService.CreateUserPasskeyOptions:output_type -> memos.api.v1.UserPasskeyOptions
+	43,  // 154: memos.api.v1.UserService.CreateUserPasskey:output_type -> memos.api.v1.UserPasskey
+	110, // 155: memos.api.v1.UserService.DeleteUserPasskey:output_type -> google.protobuf.Empty
+	52,  // 156: memos.api.v1.UserService.ListUserPushSubscriptions:output_type -> memos.api.v1.ListUserPushSubscriptionsResponse
+	50,  // 157: memos.api.v1.UserService.CreateUserPushSubscription:output_type -> memos.api.v1.UserPushSubscription
+	110, // 158: memos.api.v1.UserService.DeleteUserPushSubscription:output_type -> google.protobuf.Empty
+	55,  // 159: memos.api.v1.UserService.GetUserFeedToken:output_type -> memos.api.v1.UserFeedToken
+	55,  // 160: memos.api.v1.UserService.RotateUserFeedToken:output_type -> memos.api.v1.UserFeedToken
+	110, // 161: memos.api.v1.UserService.DeleteUserFeedToken:output_type -> google.protobuf.Empty
+	59,  // 162: memos.api.v1.UserService.GetUserInboundEmail:output_type -> memos.api.v1.UserInboundEmail
+	59,  // 163: memos.api.v1.UserService.RotateUserInboundEmail:output_type -> memos.api.v1.UserInboundEmail
+	110, // 164: memos.api.v1.UserService.DeleteUserInboundEmail:output_type -> google.protobuf.Empty
+	63,  // 165: memos.api.v1.UserService.GetUserSlackLink:output_type -> memos.api.v1.UserSlackLink
+	63,  // 166: memos.api.v1.UserService.GenerateUserSlackLinkCode:output_type -> memos.api.v1.UserSlackLink
+	110, // 167: memos.api.v1.UserService.DeleteUserSlackLink:output_type -> google.protobuf.Empty
+	67,  // 168: memos.api.v1.UserService.GetUserMatrixLink:output_type -> memos.api.v1.UserMatrixLink
+	67,  // 169: memos.api.v1.UserService.GenerateUserMatrixLinkCode:output_type -> memos.api.v1.UserMatrixLink
+	110, // 170: memos.api.v1.UserService.DeleteUserMatrixLink:output_type -> google.protobuf.Empty
+	110, // 171: memos.api.v1.UserService.UnlockUser:output_type -> google.protobuf.Empty
+	8,   // 172: memos.api.v1.UserService.SuspendUser:output_type -> memos.api.v1.User
+	8,   // 173: memos.api.v1.UserService.UnsuspendUser:output_type -> memos.api.v1.User
+	77,  // 174: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
+	74,  // 175: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	74,  // 176: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	110, // 177: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
+	74,  // 178: memos.api.v1.UserService.RotateUserWebhookSecret:output_type -> memos.api.v1.UserWebhook
+	83,  // 179: memos.api.v1.UserService.TestUserWebhook:output_type -> memos.api.v1.TestUserWebhookResponse
+	85,  // 180: memos.api.v1.UserService.ListUserWebhookDeliveries:output_type -> memos.api.v1.ListUserWebhookDeliveriesResponse
+	75,  // 181: memos.api.v1.UserService.RedeliverUserWebhookDelivery:output_type -> memos.api.v1.UserWebhookDelivery
+	89,  // 182: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
+	87,  // 183: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
+	110, // 184: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
+	131, // [131:185] is the sub-list for method output_type
+	77,  // [77:131] is the sub-list for method input_type
+	77,  // [77:77] is the sub-list for extension type_name
+	77,  // [77:77] is the sub-list for extension extendee
+	0,   // [0:77] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -3315,20 +7639,27 @@ func file_api_v1_user_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[11].OneofWrappers = []any{
+	file_api_v1_user_service_proto_msgTypes[13].OneofWrappers = []any{
 		(*UserSetting_GeneralSetting_)(nil),
 		(*UserSetting_SessionsSetting_)(nil),
 		(*UserSetting_AccessTokensSetting_)(nil),
 		(*UserSetting_WebhooksSetting_)(nil),
-	}
-	file_api_v1_user_service_proto_msgTypes[31].OneofWrappers = []any{}
+		(*UserSetting_AutoArchiveSetting_)(nil),
+		(*UserSetting_StorageSetting_)(nil),
+		(*UserSetting_MemoQuotaSetting_)(nil),
+		(*UserSetting_NotificationSetting_)(nil),
+	}
+	file_api_v1_user_service_proto_msgTypes[79].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[88].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[94].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[95].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   43,
+			NumEnums:      8,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,28 +20,60 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_ListUsers_FullMethodName              = "/memos.api.v1.UserService/ListUsers"
-	UserService_GetUser_FullMethodName                = "/memos.api.v1.UserService/GetUser"
-	UserService_CreateUser_FullMethodName             = "/memos.api.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName             = "/memos.api.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName             = "/memos.api.v1.UserService/DeleteUser"
-	UserService_ListAllUserStats_FullMethodName       = "/memos.api.v1.UserService/ListAllUserStats"
-	UserService_GetUserStats_FullMethodName           = "/memos.api.v1.UserService/GetUserStats"
-	UserService_GetUserSetting_FullMethodName         = "/memos.api.v1.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName      = "/memos.api.v1.UserService/UpdateUserSetting"
-	UserService_ListUserSettings_FullMethodName       = "/memos.api.v1.UserService/ListUserSettings"
-	UserService_ListUserAccessTokens_FullMethodName   = "/memos.api.v1.UserService/ListUserAccessTokens"
-	UserService_CreateUserAccessToken_FullMethodName  = "/memos.api.v1.UserService/CreateUserAccessToken"
-	UserService_DeleteUserAccessToken_FullMethodName  = "/memos.api.v1.UserService/DeleteUserAccessToken"
-	UserService_ListUserSessions_FullMethodName       = "/memos.api.v1.UserService/ListUserSessions"
-	UserService_RevokeUserSession_FullMethodName      = "/memos.api.v1.UserService/RevokeUserSession"
-	UserService_ListUserWebhooks_FullMethodName       = "/memos.api.v1.UserService/ListUserWebhooks"
-	UserService_CreateUserWebhook_FullMethodName      = "/memos.api.v1.UserService/CreateUserWebhook"
-	UserService_UpdateUserWebhook_FullMethodName      = "/memos.api.v1.UserService/UpdateUserWebhook"
-	UserService_DeleteUserWebhook_FullMethodName      = "/memos.api.v1.UserService/DeleteUserWebhook"
-	UserService_ListUserNotifications_FullMethodName  = "/memos.api.v1.UserService/ListUserNotifications"
-	UserService_UpdateUserNotification_FullMethodName = "/memos.api.v1.UserService/UpdateUserNotification"
-	UserService_DeleteUserNotification_FullMethodName = "/memos.api.v1.UserService/DeleteUserNotification"
+	UserService_ListUsers_FullMethodName                    = "/memos.api.v1.UserService/ListUsers"
+	UserService_GetUser_FullMethodName                      = "/memos.api.v1.UserService/GetUser"
+	UserService_CreateUser_FullMethodName                   = "/memos.api.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                   = "/memos.api.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                   = "/memos.api.v1.UserService/DeleteUser"
+	UserService_ListAllUserStats_FullMethodName             = "/memos.api.v1.UserService/ListAllUserStats"
+	UserService_GetUserStats_FullMethodName                 = "/memos.api.v1.UserService/GetUserStats"
+	UserService_GetUserWritingStats_FullMethodName          = "/memos.api.v1.UserService/GetUserWritingStats"
+	UserService_GetUserSetting_FullMethodName               = "/memos.api.v1.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName            = "/memos.api.v1.UserService/UpdateUserSetting"
+	UserService_ListUserSettings_FullMethodName             = "/memos.api.v1.UserService/ListUserSettings"
+	UserService_ListUserAccessTokens_FullMethodName         = "/memos.api.v1.UserService/ListUserAccessTokens"
+	UserService_CreateUserAccessToken_FullMethodName        = "/memos.api.v1.UserService/CreateUserAccessToken"
+	UserService_DeleteUserAccessToken_FullMethodName        = "/memos.api.v1.UserService/DeleteUserAccessToken"
+	UserService_ListUserSessions_FullMethodName             = "/memos.api.v1.UserService/ListUserSessions"
+	UserService_RevokeUserSession_FullMethodName            = "/memos.api.v1.UserService/RevokeUserSession"
+	UserService_RevokeOtherUserSessions_FullMethodName      = "/memos.api.v1.UserService/RevokeOtherUserSessions"
+	UserService_GetUserTwoFactor_FullMethodName             = "/memos.api.v1.UserService/GetUserTwoFactor"
+	UserService_EnrollUserTwoFactor_FullMethodName          = "/memos.api.v1.UserService/EnrollUserTwoFactor"
+	UserService_ConfirmUserTwoFactor_FullMethodName         = "/memos.api.v1.UserService/ConfirmUserTwoFactor"
+	UserService_DeleteUserTwoFactor_FullMethodName          = "/memos.api.v1.UserService/DeleteUserTwoFactor"
+	UserService_ListUserPasskeys_FullMethodName             = "/memos.api.v1.UserService/ListUserPasskeys"
+	UserService_CreateUserPasskeyOptions_FullMethodName     = "/memos.api.v1.UserService/CreateUserPasskeyOptions"
+	UserService_CreateUserPasskey_FullMethodName            = "/memos.api.v1.UserService/CreateUserPasskey"
+	UserService_DeleteUserPasskey_FullMethodName            = "/memos.api.v1.UserService/DeleteUserPasskey"
+	UserService_ListUserPushSubscriptions_FullMethodName    = "/memos.api.v1.UserService/ListUserPushSubscriptions"
+	UserService_CreateUserPushSubscription_FullMethodName   = "/memos.api.v1.UserService/CreateUserPushSubscription"
+	UserService_DeleteUserPushSubscription_FullMethodName   = "/memos.api.v1.UserService/DeleteUserPushSubscription"
+	UserService_GetUserFeedToken_FullMethodName             = "/memos.api.v1.UserService/GetUserFeedToken"
+	UserService_RotateUserFeedToken_FullMethodName          = "/memos.api.v1.UserService/RotateUserFeedToken"
+	UserService_DeleteUserFeedToken_FullMethodName          = "/memos.api.v1.UserService/DeleteUserFeedToken"
+	UserService_GetUserInboundEmail_FullMethodName          = "/memos.api.v1.UserService/GetUserInboundEmail"
+	UserService_RotateUserInboundEmail_FullMethodName       = "/memos.api.v1.UserService/RotateUserInboundEmail"
+	UserService_DeleteUserInboundEmail_FullMethodName       = "/memos.api.v1.UserService/DeleteUserInboundEmail"
+	UserService_GetUserSlackLink_FullMethodName             = "/memos.api.v1.UserService/GetUserSlackLink"
+	UserService_GenerateUserSlackLinkCode_FullMethodName    = "/memos.api.v1.UserService/GenerateUserSlackLinkCode"
+	UserService_DeleteUserSlackLink_FullMethodName          = "/memos.api.v1.UserService/DeleteUserSlackLink"
+	UserService_GetUserMatrixLink_FullMethodName            = "/memos.api.v1.UserService/GetUserMatrixLink"
+	UserService_GenerateUserMatrixLinkCode_FullMethodName   = "/memos.api.v1.UserService/GenerateUserMatrixLinkCode"
+	UserService_DeleteUserMatrixLink_FullMethodName         = "/memos.api.v1.UserService/DeleteUserMatrixLink"
+	UserService_UnlockUser_FullMethodName                   = "/memos.api.v1.UserService/UnlockUser"
+	UserService_SuspendUser_FullMethodName                  = "/memos.api.v1.UserService/SuspendUser"
+	UserService_UnsuspendUser_FullMethodName                = "/memos.api.v1.UserService/UnsuspendUser"
+	UserService_ListUserWebhooks_FullMethodName             = "/memos.api.v1.UserService/ListUserWebhooks"
+	UserService_CreateUserWebhook_FullMethodName            = "/memos.api.v1.UserService/CreateUserWebhook"
+	UserService_UpdateUserWebhook_FullMethodName            = "/memos.api.v1.UserService/UpdateUserWebhook"
+	UserService_DeleteUserWebhook_FullMethodName            = "/memos.api.v1.UserService/DeleteUserWebhook"
+	UserService_RotateUserWebhookSecret_FullMethodName      = "/memos.api.v1.UserService/RotateUserWebhookSecret"
+	UserService_TestUserWebhook_FullMethodName              = "/memos.api.v1.UserService/TestUserWebhook"
+	UserService_ListUserWebhookDeliveries_FullMethodName    = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	UserService_RedeliverUserWebhookDelivery_FullMethodName = "/memos.api.v1.UserService/RedeliverUserWebhookDelivery"
+	UserService_ListUserNotifications_FullMethodName        = "/memos.api.v1.UserService/ListUserNotifications"
+	UserService_UpdateUserNotification_FullMethodName       = "/memos.api.v1.UserService/UpdateUserNotification"
+	UserService_DeleteUserNotification_FullMethodName       = "/memos.api.v1.UserService/DeleteUserNotification"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -65,6 +97,9 @@ type UserServiceClient interface {
 	ListAllUserStats(ctx context.Context, in *ListAllUserStatsRequest, opts ...grpc.CallOption) (*ListAllUserStatsResponse, error)
 	// GetUserStats returns statistics for a specific user.
 	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*UserStats, error)
+	// GetUserWritingStats returns the writing statistics of a user.
+	// Users can only get their own, except for the host.
+	GetUserWritingStats(ctx context.Context, in *GetUserWritingStatsRequest, opts ...grpc.CallOption) (*UserWritingStats, error)
 	// GetUserSetting returns the user setting.
 	GetUserSetting(ctx context.Context, in *GetUserSettingRequest, opts ...grpc.CallOption) (*UserSetting, error)
 	// UpdateUserSetting updates the user setting.
@@ -81,7 +116,72 @@ type UserServiceClient interface {
 	ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
 	// RevokeUserSession revokes a specific session for a user.
 	RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// ListUserWebhooks returns a list of webhooks for a user.
+	// RevokeOtherUserSessions revokes all sessions of a user except the current one.
+	RevokeOtherUserSessions(ctx context.Context, in *RevokeOtherUserSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(ctx context.Context, in *GetUserTwoFactorRequest, opts ...grpc.CallOption) (*UserTwoFactor, error)
+	// EnrollUserTwoFactor generates a new TOTP secret for a user.
+	// Two-factor authentication is enabled once ConfirmUserTwoFactor verifies a code.
+	EnrollUserTwoFactor(ctx context.Context, in *EnrollUserTwoFactorRequest, opts ...grpc.CallOption) (*EnrollUserTwoFactorResponse, error)
+	// ConfirmUserTwoFactor verifies a code for a pending enrollment and enables two-factor authentication.
+	ConfirmUserTwoFactor(ctx context.Context, in *ConfirmUserTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmUserTwoFactorResponse, error)
+	// DeleteUserTwoFactor disables two-factor authentication for a user.
+	// Admins can use it to reset two-factor authentication for a locked-out user.
+	DeleteUserTwoFactor(ctx context.Context, in *DeleteUserTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserPasskeys returns the passkeys registered by a user.
+	ListUserPasskeys(ctx context.Context, in *ListUserPasskeysRequest, opts ...grpc.CallOption) (*ListUserPasskeysResponse, error)
+	// CreateUserPasskeyOptions starts a passkey registration.
+	// Returns the options for navigator.credentials.create(); the credential is sent back in CreateUserPasskey.
+	CreateUserPasskeyOptions(ctx context.Context, in *CreateUserPasskeyOptionsRequest, opts ...grpc.CallOption) (*UserPasskeyOptions, error)
+	// CreateUserPasskey registers a passkey for a user.
+	CreateUserPasskey(ctx context.Context, in *CreateUserPasskeyRequest, opts ...grpc.CallOption) (*UserPasskey, error)
+	// DeleteUserPasskey removes a passkey of a user.
+	DeleteUserPasskey(ctx context.Context, in *DeleteUserPasskeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserPushSubscriptions returns the Web Push subscriptions of the devices of a user.
+	ListUserPushSubscriptions(ctx context.Context, in *ListUserPushSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserPushSubscriptionsResponse, error)
+	// CreateUserPushSubscription registers the Web Push subscription of a device of a user.
+	// Registering the endpoint of an existing subscription again updates it.
+	CreateUserPushSubscription(ctx context.Context, in *CreateUserPushSubscriptionRequest, opts ...grpc.CallOption) (*UserPushSubscription, error)
+	// DeleteUserPushSubscription revokes the Web Push subscription of a device of a user.
+	DeleteUserPushSubscription(ctx context.Context, in *DeleteUserPushSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserFeedToken returns whether a user has a feed token.
+	GetUserFeedToken(ctx context.Context, in *GetUserFeedTokenRequest, opts ...grpc.CallOption) (*UserFeedToken, error)
+	// RotateUserFeedToken generates a new feed token for a user, replacing the current one.
+	// The token is only returned in the response.
+	RotateUserFeedToken(ctx context.Context, in *RotateUserFeedTokenRequest, opts ...grpc.CallOption) (*UserFeedToken, error)
+	// DeleteUserFeedToken revokes the feed token of a user.
+	DeleteUserFeedToken(ctx context.Context, in *DeleteUserFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserInboundEmail gets the inbound email address of a user.
+	GetUserInboundEmail(ctx context.Context, in *GetUserInboundEmailRequest, opts ...grpc.CallOption) (*UserInboundEmail, error)
+	// RotateUserInboundEmail generates a new inbound email address for a user, replacing the
+	// current one, whose emails are dropped.
+	RotateUserInboundEmail(ctx context.Context, in *RotateUserInboundEmailRequest, opts ...grpc.CallOption) (*UserInboundEmail, error)
+	// DeleteUserInboundEmail removes the inbound email address of a user.
+	DeleteUserInboundEmail(ctx context.Context, in *DeleteUserInboundEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserSlackLink gets the Slack account linked to a user.
+	GetUserSlackLink(ctx context.Context, in *GetUserSlackLinkRequest, opts ...grpc.CallOption) (*UserSlackLink, error)
+	// GenerateUserSlackLinkCode generates a one-time code linking the Slack account it's sent
+	// from, in a direct message to the Slack app, to a user. It replaces the pending code, if any.
+	GenerateUserSlackLinkCode(ctx context.Context, in *GenerateUserSlackLinkCodeRequest, opts ...grpc.CallOption) (*UserSlackLink, error)
+	// DeleteUserSlackLink unlinks the Slack account of a user, and revokes the pending code.
+	DeleteUserSlackLink(ctx context.Context, in *DeleteUserSlackLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserMatrixLink gets the Matrix account linked to a user.
+	GetUserMatrixLink(ctx context.Context, in *GetUserMatrixLinkRequest, opts ...grpc.CallOption) (*UserMatrixLink, error)
+	// GenerateUserMatrixLinkCode generates a one-time code linking the Matrix account it's sent
+	// from, with "!memos link <code>" in a room of the bot, to a user. It replaces the pending code,
+	// if any.
+	GenerateUserMatrixLinkCode(ctx context.Context, in *GenerateUserMatrixLinkCodeRequest, opts ...grpc.CallOption) (*UserMatrixLink, error)
+	// DeleteUserMatrixLink unlinks the Matrix account of a user, and revokes the pending code.
+	DeleteUserMatrixLink(ctx context.Context, in *DeleteUserMatrixLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UnlockUser clears the failed sign-in attempts of a user, lifting a sign-in lock.
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SuspendUser suspends a user: the user can't sign in and the memos of the user are hidden from
+	// the others, but nothing is deleted. Only the host can suspend users.
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*User, error)
+	// UnsuspendUser lifts the suspension of a user. Only the host can unsuspend users.
+	UnsuspendUser(ctx context.Context, in *UnsuspendUserRequest, opts ...grpc.CallOption) (*User, error)
+	// ListUserWebhooks returns a list of webhooks for a user, or the workspace webhooks for the
+	// "instance" parent. Only hosts can manage the workspace webhooks.
 	ListUserWebhooks(ctx context.Context, in *ListUserWebhooksRequest, opts ...grpc.CallOption) (*ListUserWebhooksResponse, error)
 	// CreateUserWebhook creates a new webhook for a user.
 	CreateUserWebhook(ctx context.Context, in *CreateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
@@ -89,6 +189,18 @@ type UserServiceClient interface {
 	UpdateUserWebhook(ctx context.Context, in *UpdateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(ctx context.Context, in *DeleteUserWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RotateUserWebhookSecret generates a new signing secret for a webhook, replacing the current one.
+	// Webhooks without a secret start signing their deliveries.
+	RotateUserWebhookSecret(ctx context.Context, in *RotateUserWebhookSecretRequest, opts ...grpc.CallOption) (*UserWebhook, error)
+	// TestUserWebhook sends a test request to a webhook and returns the response of its endpoint.
+	// The request is validated and signed like deliveries, and carries the "test" event with a
+	// sample memo. Tests are rate-limited per user.
+	TestUserWebhook(ctx context.Context, in *TestUserWebhookRequest, opts ...grpc.CallOption) (*TestUserWebhookResponse, error)
+	// ListUserWebhookDeliveries lists the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error)
+	// RedeliverUserWebhookDelivery replays a delivery of a webhook, sending its payload again as a
+	// new delivery.
+	RedeliverUserWebhookDelivery(ctx context.Context, in *RedeliverUserWebhookDeliveryRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(ctx context.Context, in *ListUserNotificationsRequest, opts ...grpc.CallOption) (*ListUserNotificationsResponse, error)
 	// UpdateUserNotification updates a notification.
@@ -175,6 +287,16 @@ func (c *userServiceClient) GetUserStats(ctx context.Context, in *GetUserStatsRe
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserWritingStats(ctx context.Context, in *GetUserWritingStatsRequest, opts ...grpc.CallOption) (*UserWritingStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWritingStats)
+	err := c.cc.Invoke(ctx, UserService_GetUserWritingStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserSetting(ctx context.Context, in *GetUserSettingRequest, opts ...grpc.CallOption) (*UserSetting, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserSetting)
@@ -255,6 +377,276 @@ func (c *userServiceClient) RevokeUserSession(ctx context.Context, in *RevokeUse
 	return out, nil
 }
 
+func (c *userServiceClient) RevokeOtherUserSessions(ctx context.Context, in *RevokeOtherUserSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeOtherUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserTwoFactor(ctx context.Context, in *GetUserTwoFactorRequest, opts ...grpc.CallOption) (*UserTwoFactor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserTwoFactor)
+	err := c.cc.Invoke(ctx, UserService_GetUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EnrollUserTwoFactor(ctx context.Context, in *EnrollUserTwoFactorRequest, opts ...grpc.CallOption) (*EnrollUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_EnrollUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmUserTwoFactor(ctx context.Context, in *ConfirmUserTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserTwoFactor(ctx context.Context, in *DeleteUserTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserPasskeys(ctx context.Context, in *ListUserPasskeysRequest, opts ...grpc.CallOption) (*ListUserPasskeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserPasskeysResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserPasskeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserPasskeyOptions(ctx context.Context, in *CreateUserPasskeyOptionsRequest, opts ...grpc.CallOption) (*UserPasskeyOptions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPasskeyOptions)
+	err := c.cc.Invoke(ctx, UserService_CreateUserPasskeyOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserPasskey(ctx context.Context, in *CreateUserPasskeyRequest, opts ...grpc.CallOption) (*UserPasskey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPasskey)
+	err := c.cc.Invoke(ctx, UserService_CreateUserPasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserPasskey(ctx context.Context, in *DeleteUserPasskeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserPasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserPushSubscriptions(ctx context.Context, in *ListUserPushSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserPushSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserPushSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserPushSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserPushSubscription(ctx context.Context, in *CreateUserPushSubscriptionRequest, opts ...grpc.CallOption) (*UserPushSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPushSubscription)
+	err := c.cc.Invoke(ctx, UserService_CreateUserPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserPushSubscription(ctx context.Context, in *DeleteUserPushSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserFeedToken(ctx context.Context, in *GetUserFeedTokenRequest, opts ...grpc.CallOption) (*UserFeedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFeedToken)
+	err := c.cc.Invoke(ctx, UserService_GetUserFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RotateUserFeedToken(ctx context.Context, in *RotateUserFeedTokenRequest, opts ...grpc.CallOption) (*UserFeedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFeedToken)
+	err := c.cc.Invoke(ctx, UserService_RotateUserFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserFeedToken(ctx context.Context, in *DeleteUserFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserInboundEmail(ctx context.Context, in *GetUserInboundEmailRequest, opts ...grpc.CallOption) (*UserInboundEmail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInboundEmail)
+	err := c.cc.Invoke(ctx, UserService_GetUserInboundEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RotateUserInboundEmail(ctx context.Context, in *RotateUserInboundEmailRequest, opts ...grpc.CallOption) (*UserInboundEmail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInboundEmail)
+	err := c.cc.Invoke(ctx, UserService_RotateUserInboundEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserInboundEmail(ctx context.Context, in *DeleteUserInboundEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserInboundEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserSlackLink(ctx context.Context, in *GetUserSlackLinkRequest, opts ...grpc.CallOption) (*UserSlackLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSlackLink)
+	err := c.cc.Invoke(ctx, UserService_GetUserSlackLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GenerateUserSlackLinkCode(ctx context.Context, in *GenerateUserSlackLinkCodeRequest, opts ...grpc.CallOption) (*UserSlackLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSlackLink)
+	err := c.cc.Invoke(ctx, UserService_GenerateUserSlackLinkCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserSlackLink(ctx context.Context, in *DeleteUserSlackLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserSlackLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserMatrixLink(ctx context.Context, in *GetUserMatrixLinkRequest, opts ...grpc.CallOption) (*UserMatrixLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMatrixLink)
+	err := c.cc.Invoke(ctx, UserService_GetUserMatrixLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GenerateUserMatrixLinkCode(ctx context.Context, in *GenerateUserMatrixLinkCodeRequest, opts ...grpc.CallOption) (*UserMatrixLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMatrixLink)
+	err := c.cc.Invoke(ctx, UserService_GenerateUserMatrixLinkCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserMatrixLink(ctx context.Context, in *DeleteUserMatrixLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserMatrixLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnsuspendUser(ctx context.Context, in *UnsuspendUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_UnsuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserWebhooks(ctx context.Context, in *ListUserWebhooksRequest, opts ...grpc.CallOption) (*ListUserWebhooksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserWebhooksResponse)
@@ -295,6 +687,46 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, in *DeleteUse
 	return out, nil
 }
 
+func (c *userServiceClient) RotateUserWebhookSecret(ctx context.Context, in *RotateUserWebhookSecretRequest, opts ...grpc.CallOption) (*UserWebhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhook)
+	err := c.cc.Invoke(ctx, UserService_RotateUserWebhookSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) TestUserWebhook(ctx context.Context, in *TestUserWebhookRequest, opts ...grpc.CallOption) (*TestUserWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestUserWebhookResponse)
+	err := c.cc.Invoke(ctx, UserService_TestUserWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RedeliverUserWebhookDelivery(ctx context.Context, in *RedeliverUserWebhookDeliveryRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhookDelivery)
+	err := c.cc.Invoke(ctx, UserService_RedeliverUserWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, in *ListUserNotificationsRequest, opts ...grpc.CallOption) (*ListUserNotificationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserNotificationsResponse)
@@ -346,6 +778,9 @@ type UserServiceServer interface {
 	ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error)
 	// GetUserStats returns statistics for a specific user.
 	GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error)
+	// GetUserWritingStats returns the writing statistics of a user.
+	// Users can only get their own, except for the host.
+	GetUserWritingStats(context.Context, *GetUserWritingStatsRequest) (*UserWritingStats, error)
 	// GetUserSetting returns the user setting.
 	GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error)
 	// UpdateUserSetting updates the user setting.
@@ -362,7 +797,72 @@ type UserServiceServer interface {
 	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
 	// RevokeUserSession revokes a specific session for a user.
 	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error)
-	// ListUserWebhooks returns a list of webhooks for a user.
+	// RevokeOtherUserSessions revokes all sessions of a user except the current one.
+	RevokeOtherUserSessions(context.Context, *RevokeOtherUserSessionsRequest) (*emptypb.Empty, error)
+	// GetUserTwoFactor returns the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *GetUserTwoFactorRequest) (*UserTwoFactor, error)
+	// EnrollUserTwoFactor generates a new TOTP secret for a user.
+	// Two-factor authentication is enabled once ConfirmUserTwoFactor verifies a code.
+	EnrollUserTwoFactor(context.Context, *EnrollUserTwoFactorRequest) (*EnrollUserTwoFactorResponse, error)
+	// ConfirmUserTwoFactor verifies a code for a pending enrollment and enables two-factor authentication.
+	ConfirmUserTwoFactor(context.Context, *ConfirmUserTwoFactorRequest) (*ConfirmUserTwoFactorResponse, error)
+	// DeleteUserTwoFactor disables two-factor authentication for a user.
+	// Admins can use it to reset two-factor authentication for a locked-out user.
+	DeleteUserTwoFactor(context.Context, *DeleteUserTwoFactorRequest) (*emptypb.Empty, error)
+	// ListUserPasskeys returns the passkeys registered by a user.
+	ListUserPasskeys(context.Context, *ListUserPasskeysRequest) (*ListUserPasskeysResponse, error)
+	// CreateUserPasskeyOptions starts a passkey registration.
+	// Returns the options for navigator.credentials.create(); the credential is sent back in CreateUserPasskey.
+	CreateUserPasskeyOptions(context.Context, *CreateUserPasskeyOptionsRequest) (*UserPasskeyOptions, error)
+	// CreateUserPasskey registers a passkey for a user.
+	CreateUserPasskey(context.Context, *CreateUserPasskeyRequest) (*UserPasskey, error)
+	// DeleteUserPasskey removes a passkey of a user.
+	DeleteUserPasskey(context.Context, *DeleteUserPasskeyRequest) (*emptypb.Empty, error)
+	// ListUserPushSubscriptions returns the Web Push subscriptions of the devices of a user.
+	ListUserPushSubscriptions(context.Context, *ListUserPushSubscriptionsRequest) (*ListUserPushSubscriptionsResponse, error)
+	// CreateUserPushSubscription registers the Web Push subscription of a device of a user.
+	// Registering the endpoint of an existing subscription again updates it.
+	CreateUserPushSubscription(context.Context, *CreateUserPushSubscriptionRequest) (*UserPushSubscription, error)
+	// DeleteUserPushSubscription revokes the Web Push subscription of a device of a user.
+	DeleteUserPushSubscription(context.Context, *DeleteUserPushSubscriptionRequest) (*emptypb.Empty, error)
+	// GetUserFeedToken returns whether a user has a feed token.
+	GetUserFeedToken(context.Context, *GetUserFeedTokenRequest) (*UserFeedToken, error)
+	// RotateUserFeedToken generates a new feed token for a user, replacing the current one.
+	// The token is only returned in the response.
+	RotateUserFeedToken(context.Context, *RotateUserFeedTokenRequest) (*UserFeedToken, error)
+	// DeleteUserFeedToken revokes the feed token of a user.
+	DeleteUserFeedToken(context.Context, *DeleteUserFeedTokenRequest) (*emptypb.Empty, error)
+	// GetUserInboundEmail gets the inbound email address of a user.
+	GetUserInboundEmail(context.Context, *GetUserInboundEmailRequest) (*UserInboundEmail, error)
+	// RotateUserInboundEmail generates a new inbound email address for a user, replacing the
+	// current one, whose emails are dropped.
+	RotateUserInboundEmail(context.Context, *RotateUserInboundEmailRequest) (*UserInboundEmail, error)
+	// DeleteUserInboundEmail removes the inbound email address of a user.
+	DeleteUserInboundEmail(context.Context, *DeleteUserInboundEmailRequest) (*emptypb.Empty, error)
+	// GetUserSlackLink gets the Slack account linked to a user.
+	GetUserSlackLink(context.Context, *GetUserSlackLinkRequest) (*UserSlackLink, error)
+	// GenerateUserSlackLinkCode generates a one-time code linking the Slack account it's sent
+	// from, in a direct message to the Slack app, to a user. It replaces the pending code, if any.
+	GenerateUserSlackLinkCode(context.Context, *GenerateUserSlackLinkCodeRequest) (*UserSlackLink, error)
+	// DeleteUserSlackLink unlinks the Slack account of a user, and revokes the pending code.
+	DeleteUserSlackLink(context.Context, *DeleteUserSlackLinkRequest) (*emptypb.Empty, error)
+	// GetUserMatrixLink gets the Matrix account linked to a user.
+	GetUserMatrixLink(context.Context, *GetUserMatrixLinkRequest) (*UserMatrixLink, error)
+	// GenerateUserMatrixLinkCode generates a one-time code linking the Matrix account it's sent
+	// from, with "!memos link <code>" in a room of the bot, to a user. It replaces the pending code,
+	// if any.
+	GenerateUserMatrixLinkCode(context.Context, *GenerateUserMatrixLinkCodeRequest) (*UserMatrixLink, error)
+	// DeleteUserMatrixLink unlinks the Matrix account of a user, and revokes the pending code.
+	DeleteUserMatrixLink(context.Context, *DeleteUserMatrixLinkRequest) (*emptypb.Empty, error)
+	// UnlockUser clears the failed sign-in attempts of a user, lifting a sign-in lock.
+	UnlockUser(context.Context, *UnlockUserRequest) (*emptypb.Empty, error)
+	// SuspendUser suspends a user: the user can't sign in and the memos of the user are hidden from
+	// the others, but nothing is deleted. Only the host can suspend users.
+	SuspendUser(context.Context, *SuspendUserRequest) (*User, error)
+	// UnsuspendUser lifts the suspension of a user. Only the host can unsuspend users.
+	UnsuspendUser(context.Context, *UnsuspendUserRequest) (*User, error)
+	// ListUserWebhooks returns a list of webhooks for a user, or the workspace webhooks for the
+	// "instance" parent. Only hosts can manage the workspace webhooks.
 	ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error)
 	// CreateUserWebhook creates a new webhook for a user.
 	CreateUserWebhook(context.Context, *CreateUserWebhookRequest) (*UserWebhook, error)
@@ -370,6 +870,18 @@ type UserServiceServer interface {
 	UpdateUserWebhook(context.Context, *UpdateUserWebhookRequest) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error)
+	// RotateUserWebhookSecret generates a new signing secret for a webhook, replacing the current one.
+	// Webhooks without a secret start signing their deliveries.
+	RotateUserWebhookSecret(context.Context, *RotateUserWebhookSecretRequest) (*UserWebhook, error)
+	// TestUserWebhook sends a test request to a webhook and returns the response of its endpoint.
+	// The request is validated and signed like deliveries, and carries the "test" event with a
+	// sample memo. Tests are rate-limited per user.
+	TestUserWebhook(context.Context, *TestUserWebhookRequest) (*TestUserWebhookResponse, error)
+	// ListUserWebhookDeliveries lists the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error)
+	// RedeliverUserWebhookDelivery replays a delivery of a webhook, sending its payload again as a
+	// new delivery.
+	RedeliverUserWebhookDelivery(context.Context, *RedeliverUserWebhookDeliveryRequest) (*UserWebhookDelivery, error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error)
 	// UpdateUserNotification updates a notification.
@@ -407,6 +919,9 @@ func (UnimplementedUserServiceServer) ListAllUserStats(context.Context, *ListAll
 func (UnimplementedUserServiceServer) GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserStats not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserWritingStats(context.Context, *GetUserWritingStatsRequest) (*UserWritingStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserWritingStats not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserSetting not implemented")
 }
@@ -431,314 +946,911 @@ func (UnimplementedUserServiceServer) ListUserSessions(context.Context, *ListUse
 func (UnimplementedUserServiceServer) RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeUserSession not implemented")
 }
-func (UnimplementedUserServiceServer) ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListUserWebhooks not implemented")
+func (UnimplementedUserServiceServer) RevokeOtherUserSessions(context.Context, *RevokeOtherUserSessionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeOtherUserSessions not implemented")
 }
-func (UnimplementedUserServiceServer) CreateUserWebhook(context.Context, *CreateUserWebhookRequest) (*UserWebhook, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateUserWebhook not implemented")
+func (UnimplementedUserServiceServer) GetUserTwoFactor(context.Context, *GetUserTwoFactorRequest) (*UserTwoFactor, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserTwoFactor not implemented")
 }
-func (UnimplementedUserServiceServer) UpdateUserWebhook(context.Context, *UpdateUserWebhookRequest) (*UserWebhook, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateUserWebhook not implemented")
+func (UnimplementedUserServiceServer) EnrollUserTwoFactor(context.Context, *EnrollUserTwoFactorRequest) (*EnrollUserTwoFactorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollUserTwoFactor not implemented")
 }
-func (UnimplementedUserServiceServer) DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteUserWebhook not implemented")
+func (UnimplementedUserServiceServer) ConfirmUserTwoFactor(context.Context, *ConfirmUserTwoFactorRequest) (*ConfirmUserTwoFactorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmUserTwoFactor not implemented")
 }
-func (UnimplementedUserServiceServer) ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListUserNotifications not implemented")
+func (UnimplementedUserServiceServer) DeleteUserTwoFactor(context.Context, *DeleteUserTwoFactorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserPasskeys(context.Context, *ListUserPasskeysRequest) (*ListUserPasskeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserPasskeys not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserPasskeyOptions(context.Context, *CreateUserPasskeyOptionsRequest) (*UserPasskeyOptions, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserPasskeyOptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserPasskey(context.Context, *CreateUserPasskeyRequest) (*UserPasskey, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserPasskey not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserPasskey(context.Context, *DeleteUserPasskeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserPasskey not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserPushSubscriptions(context.Context, *ListUserPushSubscriptionsRequest) (*ListUserPushSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserPushSubscriptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserPushSubscription(context.Context, *CreateUserPushSubscriptionRequest) (*UserPushSubscription, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserPushSubscription not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserPushSubscription(context.Context, *DeleteUserPushSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserPushSubscription not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserFeedToken(context.Context, *GetUserFeedTokenRequest) (*UserFeedToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserFeedToken not implemented")
+}
+func (UnimplementedUserServiceServer) RotateUserFeedToken(context.Context, *RotateUserFeedTokenRequest) (*UserFeedToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateUserFeedToken not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserFeedToken(context.Context, *DeleteUserFeedTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserFeedToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserInboundEmail(context.Context, *GetUserInboundEmailRequest) (*UserInboundEmail, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserInboundEmail not implemented")
+}
+func (UnimplementedUserServiceServer) RotateUserInboundEmail(context.Context, *RotateUserInboundEmailRequest) (*UserInboundEmail, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateUserInboundEmail not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserInboundEmail(context.Context, *DeleteUserInboundEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserInboundEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSlackLink(context.Context, *GetUserSlackLinkRequest) (*UserSlackLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserSlackLink not implemented")
+}
+func (UnimplementedUserServiceServer) GenerateUserSlackLinkCode(context.Context, *GenerateUserSlackLinkCodeRequest) (*UserSlackLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateUserSlackLinkCode not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserSlackLink(context.Context, *DeleteUserSlackLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserSlackLink not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserMatrixLink(context.Context, *GetUserMatrixLinkRequest) (*UserMatrixLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserMatrixLink not implemented")
+}
+func (UnimplementedUserServiceServer) GenerateUserMatrixLinkCode(context.Context, *GenerateUserMatrixLinkCodeRequest) (*UserMatrixLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateUserMatrixLinkCode not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserMatrixLink(context.Context, *DeleteUserMatrixLinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserMatrixLink not implemented")
+}
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnsuspendUser(context.Context, *UnsuspendUserRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnsuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserWebhooks(context.Context, *ListUserWebhooksRequest) (*ListUserWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserWebhooks not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserWebhook(context.Context, *CreateUserWebhookRequest) (*UserWebhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserWebhook(context.Context, *UpdateUserWebhookRequest) (*UserWebhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) RotateUserWebhookSecret(context.Context, *RotateUserWebhookSecretRequest) (*UserWebhook, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateUserWebhookSecret not implemented")
+}
+func (UnimplementedUserServiceServer) TestUserWebhook(context.Context, *TestUserWebhookRequest) (*TestUserWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TestUserWebhook not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserWebhookDeliveries not implemented")
+}
+func (UnimplementedUserServiceServer) RedeliverUserWebhookDelivery(context.Context, *RedeliverUserWebhookDeliveryRequest) (*UserWebhookDelivery, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeliverUserWebhookDelivery not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserNotifications not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserNotification(context.Context, *UpdateUserNotificationRequest) (*UserNotification, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserNotification not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserNotification(context.Context, *DeleteUserNotificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserNotification not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call panics, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAllUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAllUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAllUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAllUserStats(ctx, req.(*ListAllUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserStats(ctx, req.(*GetUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserWritingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserWritingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserWritingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserWritingStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserWritingStats(ctx, req.(*GetUserWritingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSetting(ctx, req.(*GetUserSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserSetting(ctx, req.(*UpdateUserSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserSettings(ctx, req.(*ListUserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserAccessTokens(ctx, req.(*ListUserAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserAccessToken(ctx, req.(*CreateUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, req.(*DeleteUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeOtherUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeOtherUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeOtherUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeOtherUserSessions(ctx, req.(*RevokeOtherUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserTwoFactor(ctx, req.(*GetUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EnrollUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EnrollUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EnrollUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EnrollUserTwoFactor(ctx, req.(*EnrollUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmUserTwoFactor(ctx, req.(*ConfirmUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserTwoFactor(ctx, req.(*DeleteUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserPasskeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPasskeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserPasskeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserPasskeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserPasskeys(ctx, req.(*ListUserPasskeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserPasskeyOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserPasskeyOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserPasskeyOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserPasskeyOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserPasskeyOptions(ctx, req.(*CreateUserPasskeyOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserPasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserPasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserPasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserPasskey(ctx, req.(*CreateUserPasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) UpdateUserNotification(context.Context, *UpdateUserNotificationRequest) (*UserNotification, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateUserNotification not implemented")
+
+func _UserService_DeleteUserPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPasskeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserPasskey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserPasskey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserPasskey(ctx, req.(*DeleteUserPasskeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) DeleteUserNotification(context.Context, *DeleteUserNotificationRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteUserNotification not implemented")
+
+func _UserService_ListUserPushSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPushSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserPushSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserPushSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserPushSubscriptions(ctx, req.(*ListUserPushSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_CreateUserPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserPushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserPushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserPushSubscription(ctx, req.(*CreateUserPushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call panics, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_DeleteUserPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPushSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserPushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserPushSubscription(ctx, req.(*DeleteUserPushSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _UserService_GetUserFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFeedTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUsers(ctx, in)
+		return srv.(UserServiceServer).GetUserFeedToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUsers_FullMethodName,
+		FullMethod: UserService_GetUserFeedToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(UserServiceServer).GetUserFeedToken(ctx, req.(*GetUserFeedTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _UserService_RotateUserFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateUserFeedTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).RotateUserFeedToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_RotateUserFeedToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServiceServer).RotateUserFeedToken(ctx, req.(*RotateUserFeedTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _UserService_DeleteUserFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserFeedTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUser(ctx, in)
+		return srv.(UserServiceServer).DeleteUserFeedToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateUser_FullMethodName,
+		FullMethod: UserService_DeleteUserFeedToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(UserServiceServer).DeleteUserFeedToken(ctx, req.(*DeleteUserFeedTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _UserService_GetUserInboundEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInboundEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
+		return srv.(UserServiceServer).GetUserInboundEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUser_FullMethodName,
+		FullMethod: UserService_GetUserInboundEmail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServiceServer).GetUserInboundEmail(ctx, req.(*GetUserInboundEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _UserService_RotateUserInboundEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateUserInboundEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUser(ctx, in)
+		return srv.(UserServiceServer).RotateUserInboundEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteUser_FullMethodName,
+		FullMethod: UserService_RotateUserInboundEmail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(UserServiceServer).RotateUserInboundEmail(ctx, req.(*RotateUserInboundEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListAllUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllUserStatsRequest)
+func _UserService_DeleteUserInboundEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserInboundEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListAllUserStats(ctx, in)
+		return srv.(UserServiceServer).DeleteUserInboundEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListAllUserStats_FullMethodName,
+		FullMethod: UserService_DeleteUserInboundEmail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListAllUserStats(ctx, req.(*ListAllUserStatsRequest))
+		return srv.(UserServiceServer).DeleteUserInboundEmail(ctx, req.(*DeleteUserInboundEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserStatsRequest)
+func _UserService_GetUserSlackLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSlackLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserStats(ctx, in)
+		return srv.(UserServiceServer).GetUserSlackLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserStats_FullMethodName,
+		FullMethod: UserService_GetUserSlackLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserStats(ctx, req.(*GetUserStatsRequest))
+		return srv.(UserServiceServer).GetUserSlackLink(ctx, req.(*GetUserSlackLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserSettingRequest)
+func _UserService_GenerateUserSlackLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUserSlackLinkCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserSetting(ctx, in)
+		return srv.(UserServiceServer).GenerateUserSlackLinkCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserSetting_FullMethodName,
+		FullMethod: UserService_GenerateUserSlackLinkCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserSetting(ctx, req.(*GetUserSettingRequest))
+		return srv.(UserServiceServer).GenerateUserSlackLinkCode(ctx, req.(*GenerateUserSlackLinkCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserSettingRequest)
+func _UserService_DeleteUserSlackLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSlackLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUserSetting(ctx, in)
+		return srv.(UserServiceServer).DeleteUserSlackLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUserSetting_FullMethodName,
+		FullMethod: UserService_DeleteUserSlackLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUserSetting(ctx, req.(*UpdateUserSettingRequest))
+		return srv.(UserServiceServer).DeleteUserSlackLink(ctx, req.(*DeleteUserSlackLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserSettingsRequest)
+func _UserService_GetUserMatrixLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMatrixLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUserSettings(ctx, in)
+		return srv.(UserServiceServer).GetUserMatrixLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUserSettings_FullMethodName,
+		FullMethod: UserService_GetUserMatrixLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUserSettings(ctx, req.(*ListUserSettingsRequest))
+		return srv.(UserServiceServer).GetUserMatrixLink(ctx, req.(*GetUserMatrixLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUserAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserAccessTokensRequest)
+func _UserService_GenerateUserMatrixLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUserMatrixLinkCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUserAccessTokens(ctx, in)
+		return srv.(UserServiceServer).GenerateUserMatrixLinkCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUserAccessTokens_FullMethodName,
+		FullMethod: UserService_GenerateUserMatrixLinkCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUserAccessTokens(ctx, req.(*ListUserAccessTokensRequest))
+		return srv.(UserServiceServer).GenerateUserMatrixLinkCode(ctx, req.(*GenerateUserMatrixLinkCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserAccessTokenRequest)
+func _UserService_DeleteUserMatrixLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserMatrixLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUserAccessToken(ctx, in)
+		return srv.(UserServiceServer).DeleteUserMatrixLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateUserAccessToken_FullMethodName,
+		FullMethod: UserService_DeleteUserMatrixLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUserAccessToken(ctx, req.(*CreateUserAccessTokenRequest))
+		return srv.(UserServiceServer).DeleteUserMatrixLink(ctx, req.(*DeleteUserMatrixLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserAccessTokenRequest)
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, in)
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteUserAccessToken_FullMethodName,
+		FullMethod: UserService_UnlockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, req.(*DeleteUserAccessTokenRequest))
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserSessionsRequest)
+func _UserService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUserSessions(ctx, in)
+		return srv.(UserServiceServer).SuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUserSessions_FullMethodName,
+		FullMethod: UserService_SuspendUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+		return srv.(UserServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeUserSessionRequest)
+func _UserService_UnsuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RevokeUserSession(ctx, in)
+		return srv.(UserServiceServer).UnsuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RevokeUserSession_FullMethodName,
+		FullMethod: UserService_UnsuspendUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+		return srv.(UserServiceServer).UnsuspendUser(ctx, req.(*UnsuspendUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -815,6 +1927,78 @@ func _UserService_DeleteUserWebhook_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RotateUserWebhookSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateUserWebhookSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RotateUserWebhookSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RotateUserWebhookSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RotateUserWebhookSecret(ctx, req.(*RotateUserWebhookSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_TestUserWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestUserWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).TestUserWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_TestUserWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).TestUserWebhook(ctx, req.(*TestUserWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, req.(*ListUserWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RedeliverUserWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverUserWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RedeliverUserWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RedeliverUserWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RedeliverUserWebhookDelivery(ctx, req.(*RedeliverUserWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUserNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -904,6 +2088,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserStats",
 			Handler:    _UserService_GetUserStats_Handler,
 		},
+		{
+			MethodName: "GetUserWritingStats",
+			Handler:    _UserService_GetUserWritingStats_Handler,
+		},
 		{
 			MethodName: "GetUserSetting",
 			Handler:    _UserService_GetUserSetting_Handler,
@@ -936,6 +2124,114 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeUserSession",
 			Handler:    _UserService_RevokeUserSession_Handler,
 		},
+		{
+			MethodName: "RevokeOtherUserSessions",
+			Handler:    _UserService_RevokeOtherUserSessions_Handler,
+		},
+		{
+			MethodName: "GetUserTwoFactor",
+			Handler:    _UserService_GetUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "EnrollUserTwoFactor",
+			Handler:    _UserService_EnrollUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmUserTwoFactor",
+			Handler:    _UserService_ConfirmUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "DeleteUserTwoFactor",
+			Handler:    _UserService_DeleteUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "ListUserPasskeys",
+			Handler:    _UserService_ListUserPasskeys_Handler,
+		},
+		{
+			MethodName: "CreateUserPasskeyOptions",
+			Handler:    _UserService_CreateUserPasskeyOptions_Handler,
+		},
+		{
+			MethodName: "CreateUserPasskey",
+			Handler:    _UserService_CreateUserPasskey_Handler,
+		},
+		{
+			MethodName: "DeleteUserPasskey",
+			Handler:    _UserService_DeleteUserPasskey_Handler,
+		},
+		{
+			MethodName: "ListUserPushSubscriptions",
+			Handler:    _UserService_ListUserPushSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateUserPushSubscription",
+			Handler:    _UserService_CreateUserPushSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteUserPushSubscription",
+			Handler:    _UserService_DeleteUserPushSubscription_Handler,
+		},
+		{
+			MethodName: "GetUserFeedToken",
+			Handler:    _UserService_GetUserFeedToken_Handler,
+		},
+		{
+			MethodName: "RotateUserFeedToken",
+			Handler:    _UserService_RotateUserFeedToken_Handler,
+		},
+		{
+			MethodName: "DeleteUserFeedToken",
+			Handler:    _UserService_DeleteUserFeedToken_Handler,
+		},
+		{
+			MethodName: "GetUserInboundEmail",
+			Handler:    _UserService_GetUserInboundEmail_Handler,
+		},
+		{
+			MethodName: "RotateUserInboundEmail",
+			Handler:    _UserService_RotateUserInboundEmail_Handler,
+		},
+		{
+			MethodName: "DeleteUserInboundEmail",
+			Handler:    _UserService_DeleteUserInboundEmail_Handler,
+		},
+		{
+			MethodName: "GetUserSlackLink",
+			Handler:    _UserService_GetUserSlackLink_Handler,
+		},
+		{
+			MethodName: "GenerateUserSlackLinkCode",
+			Handler:    _UserService_GenerateUserSlackLinkCode_Handler,
+		},
+		{
+			MethodName: "DeleteUserSlackLink",
+			Handler:    _UserService_DeleteUserSlackLink_Handler,
+		},
+		{
+			MethodName: "GetUserMatrixLink",
+			Handler:    _UserService_GetUserMatrixLink_Handler,
+		},
+		{
+			MethodName: "GenerateUserMatrixLinkCode",
+			Handler:    _UserService_GenerateUserMatrixLinkCode_Handler,
+		},
+		{
+			MethodName: "DeleteUserMatrixLink",
+			Handler:    _UserService_DeleteUserMatrixLink_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "UnsuspendUser",
+			Handler:    _UserService_UnsuspendUser_Handler,
+		},
 		{
 			MethodName: "ListUserWebhooks",
 			Handler:    _UserService_ListUserWebhooks_Handler,
@@ -952,6 +2248,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserWebhook",
 			Handler:    _UserService_DeleteUserWebhook_Handler,
 		},
+		{
+			MethodName: "RotateUserWebhookSecret",
+			Handler:    _UserService_RotateUserWebhookSecret_Handler,
+		},
+		{
+			MethodName: "TestUserWebhook",
+			Handler:    _UserService_TestUserWebhook_Handler,
+		},
+		{
+			MethodName: "ListUserWebhookDeliveries",
+			Handler:    _UserService_ListUserWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverUserWebhookDelivery",
+			Handler:    _UserService_RedeliverUserWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "ListUserNotifications",
 			Handler:    _UserService_ListUserNotifications_Handler,
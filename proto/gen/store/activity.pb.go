@@ -73,16 +73,423 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemoId() int32 {
 	return 0
 }
 
-type ActivityPayload struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+type ActivityMemoReminderPayload struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	MemoId int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The reminder time that was due.
+	ReminderTs    int64 `protobuf:"varint,2,opt,name=reminder_ts,json=reminderTs,proto3" json:"reminder_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReminderPayload) Reset() {
+	*x = ActivityMemoReminderPayload{}
+	mi := &file_store_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReminderPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReminderPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReminderPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReminderPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReminderPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityMemoReminderPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoReminderPayload) GetReminderTs() int64 {
+	if x != nil {
+		return x.ReminderTs
+	}
+	return 0
+}
+
+type ActivityMemoAutoArchivePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos archived.
+	MemoCount int32 `protobuf:"varint,1,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The days of the auto-archive policy the memos were archived with.
+	Days          int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoAutoArchivePayload) Reset() {
+	*x = ActivityMemoAutoArchivePayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoAutoArchivePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoAutoArchivePayload) ProtoMessage() {}
+
+func (x *ActivityMemoAutoArchivePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoAutoArchivePayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoAutoArchivePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityMemoAutoArchivePayload) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *ActivityMemoAutoArchivePayload) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type ActivityWebhookDisabledPayload struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// The number of consecutive failed deliveries that disabled the webhook.
+	ConsecutiveFailures int32 `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ActivityWebhookDisabledPayload) Reset() {
+	*x = ActivityWebhookDisabledPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityWebhookDisabledPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityWebhookDisabledPayload) ProtoMessage() {}
+
+func (x *ActivityWebhookDisabledPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityWebhookDisabledPayload.ProtoReflect.Descriptor instead.
+func (*ActivityWebhookDisabledPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityWebhookDisabledPayload) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ActivityWebhookDisabledPayload) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+type ActivityBackupFailedPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The error of the failed backup.
+	Error         string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityBackupFailedPayload) Reset() {
+	*x = ActivityBackupFailedPayload{}
+	mi := &file_store_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityBackupFailedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityBackupFailedPayload) ProtoMessage() {}
+
+func (x *ActivityBackupFailedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityBackupFailedPayload.ProtoReflect.Descriptor instead.
+func (*ActivityBackupFailedPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityBackupFailedPayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ActivityMemoReactionPayload struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	MemoId int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The reaction type, e.g. an emoji.
+	ReactionType  string `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReactionPayload) Reset() {
+	*x = ActivityMemoReactionPayload{}
+	mi := &file_store_activity_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReactionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoReactionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo mentioning the receiver.
+	MemoId        int32 `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_store_activity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityDigestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start and end of the period summarized by the digest.
+	StartTs int64 `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	EndTs   int64 `protobuf:"varint,2,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	// The memos the receiver created in the period.
+	MemoIds []int32 `protobuf:"varint,3,rep,packed,name=memo_ids,json=memoIds,proto3" json:"memo_ids,omitempty"`
+	// The number of comments on and reactions to the memos of the receiver in the period.
+	CommentCount  int32 `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	ReactionCount int32 `protobuf:"varint,5,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`
+	// The memos of the receiver with a reminder due in the next period.
+	ReminderMemoIds []int32 `protobuf:"varint,6,rep,packed,name=reminder_memo_ids,json=reminderMemoIds,proto3" json:"reminder_memo_ids,omitempty"`
+	// A random older memo of the receiver, or 0 if there's none.
+	ResurfacedMemoId int32 `protobuf:"varint,7,opt,name=resurfaced_memo_id,json=resurfacedMemoId,proto3" json:"resurfaced_memo_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActivityDigestPayload) Reset() {
+	*x = ActivityDigestPayload{}
+	mi := &file_store_activity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityDigestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDigestPayload) ProtoMessage() {}
+
+func (x *ActivityDigestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDigestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityDigestPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActivityDigestPayload) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *ActivityDigestPayload) GetEndTs() int64 {
+	if x != nil {
+		return x.EndTs
+	}
+	return 0
+}
+
+func (x *ActivityDigestPayload) GetMemoIds() []int32 {
+	if x != nil {
+		return x.MemoIds
+	}
+	return nil
+}
+
+func (x *ActivityDigestPayload) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *ActivityDigestPayload) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+func (x *ActivityDigestPayload) GetReminderMemoIds() []int32 {
+	if x != nil {
+		return x.ReminderMemoIds
+	}
+	return nil
+}
+
+func (x *ActivityDigestPayload) GetResurfacedMemoId() int32 {
+	if x != nil {
+		return x.ResurfacedMemoId
+	}
+	return 0
+}
+
+type ActivityPayload struct {
+	state           protoimpl.MessageState          `protogen:"open.v1"`
+	MemoComment     *ActivityMemoCommentPayload     `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	MemoReminder    *ActivityMemoReminderPayload    `protobuf:"bytes,2,opt,name=memo_reminder,json=memoReminder,proto3" json:"memo_reminder,omitempty"`
+	MemoAutoArchive *ActivityMemoAutoArchivePayload `protobuf:"bytes,3,opt,name=memo_auto_archive,json=memoAutoArchive,proto3" json:"memo_auto_archive,omitempty"`
+	WebhookDisabled *ActivityWebhookDisabledPayload `protobuf:"bytes,4,opt,name=webhook_disabled,json=webhookDisabled,proto3" json:"webhook_disabled,omitempty"`
+	BackupFailed    *ActivityBackupFailedPayload    `protobuf:"bytes,5,opt,name=backup_failed,json=backupFailed,proto3" json:"backup_failed,omitempty"`
+	MemoReaction    *ActivityMemoReactionPayload    `protobuf:"bytes,6,opt,name=memo_reaction,json=memoReaction,proto3" json:"memo_reaction,omitempty"`
+	MemoMention     *ActivityMemoMentionPayload     `protobuf:"bytes,7,opt,name=memo_mention,json=memoMention,proto3" json:"memo_mention,omitempty"`
+	Digest          *ActivityDigestPayload          `protobuf:"bytes,8,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *ActivityPayload) Reset() {
 	*x = ActivityPayload{}
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +501,7 @@ func (x *ActivityPayload) String() string {
 func (*ActivityPayload) ProtoMessage() {}
 
 func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +514,7 @@ func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityPayload.ProtoReflect.Descriptor instead.
 func (*ActivityPayload) Descriptor() ([]byte, []int) {
-	return file_store_activity_proto_rawDescGZIP(), []int{1}
+	return file_store_activity_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
@@ -117,6 +524,55 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetMemoReminder() *ActivityMemoReminderPayload {
+	if x != nil {
+		return x.MemoReminder
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoAutoArchive() *ActivityMemoAutoArchivePayload {
+	if x != nil {
+		return x.MemoAutoArchive
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetWebhookDisabled() *ActivityWebhookDisabledPayload {
+	if x != nil {
+		return x.WebhookDisabled
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetBackupFailed() *ActivityBackupFailedPayload {
+	if x != nil {
+		return x.BackupFailed
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReaction() *ActivityMemoReactionPayload {
+	if x != nil {
+		return x.MemoReaction
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		return x.MemoMention
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetDigest() *ActivityDigestPayload {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
 var File_store_activity_proto protoreflect.FileDescriptor
 
 const file_store_activity_proto_rawDesc = "" +
@@ -124,9 +580,43 @@ const file_store_activity_proto_rawDesc = "" +
 	"\x14store/activity.proto\x12\vmemos.store\"]\n" +
 	"\x1aActivityMemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
-	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"]\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"W\n" +
+	"\x1bActivityMemoReminderPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x1f\n" +
+	"\vreminder_ts\x18\x02 \x01(\x03R\n" +
+	"reminderTs\"S\n" +
+	"\x1eActivityMemoAutoArchivePayload\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x01 \x01(\x05R\tmemoCount\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"r\n" +
+	"\x1eActivityWebhookDisabledPayload\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x121\n" +
+	"\x14consecutive_failures\x18\x02 \x01(\x05R\x13consecutiveFailures\"3\n" +
+	"\x1bActivityBackupFailedPayload\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"[\n" +
+	"\x1bActivityMemoReactionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\"5\n" +
+	"\x1aActivityMemoMentionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"\x8a\x02\n" +
+	"\x15ActivityDigestPayload\x12\x19\n" +
+	"\bstart_ts\x18\x01 \x01(\x03R\astartTs\x12\x15\n" +
+	"\x06end_ts\x18\x02 \x01(\x03R\x05endTs\x12\x19\n" +
+	"\bmemo_ids\x18\x03 \x03(\x05R\amemoIds\x12#\n" +
+	"\rcomment_count\x18\x04 \x01(\x05R\fcommentCount\x12%\n" +
+	"\x0ereaction_count\x18\x05 \x01(\x05R\rreactionCount\x12*\n" +
+	"\x11reminder_memo_ids\x18\x06 \x03(\x05R\x0freminderMemoIds\x12,\n" +
+	"\x12resurfaced_memo_id\x18\a \x01(\x05R\x10resurfacedMemoId\"\x83\x05\n" +
 	"\x0fActivityPayload\x12J\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoCommentB\x98\x01\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoComment\x12M\n" +
+	"\rmemo_reminder\x18\x02 \x01(\v2(.memos.store.ActivityMemoReminderPayloadR\fmemoReminder\x12W\n" +
+	"\x11memo_auto_archive\x18\x03 \x01(\v2+.memos.store.ActivityMemoAutoArchivePayloadR\x0fmemoAutoArchive\x12V\n" +
+	"\x10webhook_disabled\x18\x04 \x01(\v2+.memos.store.ActivityWebhookDisabledPayloadR\x0fwebhookDisabled\x12M\n" +
+	"\rbackup_failed\x18\x05 \x01(\v2(.memos.store.ActivityBackupFailedPayloadR\fbackupFailed\x12M\n" +
+	"\rmemo_reaction\x18\x06 \x01(\v2(.memos.store.ActivityMemoReactionPayloadR\fmemoReaction\x12J\n" +
+	"\fmemo_mention\x18\a \x01(\v2'.memos.store.ActivityMemoMentionPayloadR\vmemoMention\x12:\n" +
+	"\x06digest\x18\b \x01(\v2\".memos.store.ActivityDigestPayloadR\x06digestB\x98\x01\n" +
 	"\x0fcom.memos.storeB\rActivityProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -141,18 +631,32 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityMemoCommentPayload)(nil), // 0: memos.store.ActivityMemoCommentPayload
-	(*ActivityPayload)(nil),            // 1: memos.store.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),     // 0: memos.store.ActivityMemoCommentPayload
+	(*ActivityMemoReminderPayload)(nil),    // 1: memos.store.ActivityMemoReminderPayload
+	(*ActivityMemoAutoArchivePayload)(nil), // 2: memos.store.ActivityMemoAutoArchivePayload
+	(*ActivityWebhookDisabledPayload)(nil), // 3: memos.store.ActivityWebhookDisabledPayload
+	(*ActivityBackupFailedPayload)(nil),    // 4: memos.store.ActivityBackupFailedPayload
+	(*ActivityMemoReactionPayload)(nil),    // 5: memos.store.ActivityMemoReactionPayload
+	(*ActivityMemoMentionPayload)(nil),     // 6: memos.store.ActivityMemoMentionPayload
+	(*ActivityDigestPayload)(nil),          // 7: memos.store.ActivityDigestPayload
+	(*ActivityPayload)(nil),                // 8: memos.store.ActivityPayload
 }
 var file_store_activity_proto_depIdxs = []int32{
 	0, // 0: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: memos.store.ActivityPayload.memo_reminder:type_name -> memos.store.ActivityMemoReminderPayload
+	2, // 2: memos.store.ActivityPayload.memo_auto_archive:type_name -> memos.store.ActivityMemoAutoArchivePayload
+	3, // 3: memos.store.ActivityPayload.webhook_disabled:type_name -> memos.store.ActivityWebhookDisabledPayload
+	4, // 4: memos.store.ActivityPayload.backup_failed:type_name -> memos.store.ActivityBackupFailedPayload
+	5, // 5: memos.store.ActivityPayload.memo_reaction:type_name -> memos.store.ActivityMemoReactionPayload
+	6, // 6: memos.store.ActivityPayload.memo_mention:type_name -> memos.store.ActivityMemoMentionPayload
+	7, // 7: memos.store.ActivityPayload.digest:type_name -> memos.store.ActivityDigestPayload
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -166,7 +670,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -82,7 +83,15 @@ type AttachmentPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*AttachmentPayload_S3Object_
-	Payload       isAttachmentPayload_Payload `protobuf_oneof:"payload"`
+	Payload isAttachmentPayload_Payload `protobuf_oneof:"payload"`
+	// chunked_upload is set on the pending attachments of chunked uploads.
+	ChunkedUpload *AttachmentPayload_ChunkedUpload `protobuf:"bytes,2,opt,name=chunked_upload,json=chunkedUpload,proto3" json:"chunked_upload,omitempty"`
+	// original_size is the size in bytes of the uploaded image before it was compressed.
+	OriginalSize int64 `protobuf:"varint,3,opt,name=original_size,json=originalSize,proto3" json:"original_size,omitempty"`
+	// duration is the duration of an audio attachment, read from its container on upload.
+	Duration *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// external_link is the state of the link of an external attachment when it was last checked.
+	ExternalLink  *AttachmentPayload_ExternalLink `protobuf:"bytes,5,opt,name=external_link,json=externalLink,proto3" json:"external_link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +142,34 @@ func (x *AttachmentPayload) GetS3Object() *AttachmentPayload_S3Object {
 	return nil
 }
 
+func (x *AttachmentPayload) GetChunkedUpload() *AttachmentPayload_ChunkedUpload {
+	if x != nil {
+		return x.ChunkedUpload
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetOriginalSize() int64 {
+	if x != nil {
+		return x.OriginalSize
+	}
+	return 0
+}
+
+func (x *AttachmentPayload) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetExternalLink() *AttachmentPayload_ExternalLink {
+	if x != nil {
+		return x.ExternalLink
+	}
+	return nil
+}
+
 type isAttachmentPayload_Payload interface {
 	isAttachmentPayload_Payload()
 }
@@ -206,17 +243,149 @@ func (x *AttachmentPayload_S3Object) GetLastPresignedTime() *timestamppb.Timesta
 	return nil
 }
 
+type AttachmentPayload_ChunkedUpload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chunk_size is the size of the chunks in bytes, only the last chunk may be smaller.
+	ChunkSize int64 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// s3_upload_id is the ID of the S3 multipart upload of the chunks to the S3 object. The
+	// chunks uploaded to the other storages are kept in the data directory.
+	S3UploadId    string `protobuf:"bytes,2,opt,name=s3_upload_id,json=s3UploadId,proto3" json:"s3_upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_ChunkedUpload) Reset() {
+	*x = AttachmentPayload_ChunkedUpload{}
+	mi := &file_store_attachment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_ChunkedUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_ChunkedUpload) ProtoMessage() {}
+
+func (x *AttachmentPayload_ChunkedUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_ChunkedUpload.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_ChunkedUpload) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AttachmentPayload_ChunkedUpload) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *AttachmentPayload_ChunkedUpload) GetS3UploadId() string {
+	if x != nil {
+		return x.S3UploadId
+	}
+	return ""
+}
+
+type AttachmentPayload_ExternalLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// last_check_time is the last time the link was checked.
+	LastCheckTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_check_time,json=lastCheckTime,proto3" json:"last_check_time,omitempty"`
+	// status_code is the HTTP status code of the response to the last check.
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// broken is set when the link answered 404 or 410 to the last check.
+	Broken        bool `protobuf:"varint,3,opt,name=broken,proto3" json:"broken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_ExternalLink) Reset() {
+	*x = AttachmentPayload_ExternalLink{}
+	mi := &file_store_attachment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_ExternalLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_ExternalLink) ProtoMessage() {}
+
+func (x *AttachmentPayload_ExternalLink) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_ExternalLink.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_ExternalLink) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *AttachmentPayload_ExternalLink) GetLastCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckTime
+	}
+	return nil
+}
+
+func (x *AttachmentPayload_ExternalLink) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *AttachmentPayload_ExternalLink) GetBroken() bool {
+	if x != nil {
+		return x.Broken
+	}
+	return false
+}
+
 var File_store_attachment_proto protoreflect.FileDescriptor
 
 const file_store_attachment_proto_rawDesc = "" +
 	"\n" +
-	"\x16store/attachment.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cstore/instance_setting.proto\"\x8c\x02\n" +
+	"\x16store/attachment.proto\x12\vmemos.store\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cstore/instance_setting.proto\"\xef\x05\n" +
 	"\x11AttachmentPayload\x12F\n" +
-	"\ts3_object\x18\x01 \x01(\v2'.memos.store.AttachmentPayload.S3ObjectH\x00R\bs3Object\x1a\xa3\x01\n" +
+	"\ts3_object\x18\x01 \x01(\v2'.memos.store.AttachmentPayload.S3ObjectH\x00R\bs3Object\x12S\n" +
+	"\x0echunked_upload\x18\x02 \x01(\v2,.memos.store.AttachmentPayload.ChunkedUploadR\rchunkedUpload\x12#\n" +
+	"\roriginal_size\x18\x03 \x01(\x03R\foriginalSize\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12P\n" +
+	"\rexternal_link\x18\x05 \x01(\v2+.memos.store.AttachmentPayload.ExternalLinkR\fexternalLink\x1a\xa3\x01\n" +
 	"\bS3Object\x129\n" +
 	"\ts3_config\x18\x01 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12J\n" +
-	"\x13last_presigned_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastPresignedTimeB\t\n" +
+	"\x13last_presigned_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastPresignedTime\x1aP\n" +
+	"\rChunkedUpload\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x01 \x01(\x03R\tchunkSize\x12 \n" +
+	"\fs3_upload_id\x18\x02 \x01(\tR\n" +
+	"s3UploadId\x1a\x8b\x01\n" +
+	"\fExternalLink\x12B\n" +
+	"\x0flast_check_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCheckTime\x12\x1f\n" +
+	"\vstatus_code\x18\x02 \x01(\x05R\n" +
+	"statusCode\x12\x16\n" +
+	"\x06broken\x18\x03 \x01(\bR\x06brokenB\t\n" +
 	"\apayload*a\n" +
 	"\x15AttachmentStorageType\x12'\n" +
 	"#ATTACHMENT_STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
@@ -238,23 +407,30 @@ func file_store_attachment_proto_rawDescGZIP() []byte {
 }
 
 var file_store_attachment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_store_attachment_proto_goTypes = []any{
-	(AttachmentStorageType)(0),         // 0: memos.store.AttachmentStorageType
-	(*AttachmentPayload)(nil),          // 1: memos.store.AttachmentPayload
-	(*AttachmentPayload_S3Object)(nil), // 2: memos.store.AttachmentPayload.S3Object
-	(*StorageS3Config)(nil),            // 3: memos.store.StorageS3Config
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	(AttachmentStorageType)(0),              // 0: memos.store.AttachmentStorageType
+	(*AttachmentPayload)(nil),               // 1: memos.store.AttachmentPayload
+	(*AttachmentPayload_S3Object)(nil),      // 2: memos.store.AttachmentPayload.S3Object
+	(*AttachmentPayload_ChunkedUpload)(nil), // 3: memos.store.AttachmentPayload.ChunkedUpload
+	(*AttachmentPayload_ExternalLink)(nil),  // 4: memos.store.AttachmentPayload.ExternalLink
+	(*durationpb.Duration)(nil),             // 5: google.protobuf.Duration
+	(*StorageS3Config)(nil),                 // 6: memos.store.StorageS3Config
+	(*timestamppb.Timestamp)(nil),           // 7: google.protobuf.Timestamp
 }
 var file_store_attachment_proto_depIdxs = []int32{
 	2, // 0: memos.store.AttachmentPayload.s3_object:type_name -> memos.store.AttachmentPayload.S3Object
-	3, // 1: memos.store.AttachmentPayload.S3Object.s3_config:type_name -> memos.store.StorageS3Config
-	4, // 2: memos.store.AttachmentPayload.S3Object.last_presigned_time:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: memos.store.AttachmentPayload.chunked_upload:type_name -> memos.store.AttachmentPayload.ChunkedUpload
+	5, // 2: memos.store.AttachmentPayload.duration:type_name -> google.protobuf.Duration
+	4, // 3: memos.store.AttachmentPayload.external_link:type_name -> memos.store.AttachmentPayload.ExternalLink
+	6, // 4: memos.store.AttachmentPayload.S3Object.s3_config:type_name -> memos.store.StorageS3Config
+	7, // 5: memos.store.AttachmentPayload.S3Object.last_presigned_time:type_name -> google.protobuf.Timestamp
+	7, // 6: memos.store.AttachmentPayload.ExternalLink.last_check_time:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_store_attachment_proto_init() }
@@ -272,7 +448,7 @@ func file_store_attachment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_attachment_proto_rawDesc), len(file_store_attachment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
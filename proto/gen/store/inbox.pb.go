@@ -27,6 +27,20 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	// Memo comment notification.
 	InboxMessage_MEMO_COMMENT InboxMessage_Type = 1
+	// Memo reminder notification.
+	InboxMessage_MEMO_REMINDER InboxMessage_Type = 3
+	// Summary notification of the memos archived by the auto-archive policy.
+	InboxMessage_MEMO_AUTO_ARCHIVE InboxMessage_Type = 4
+	// Notification of a webhook disabled after too many failed deliveries.
+	InboxMessage_WEBHOOK_DISABLED InboxMessage_Type = 5
+	// Notification of a failed scheduled backup, sent to the host.
+	InboxMessage_BACKUP_FAILED InboxMessage_Type = 6
+	// Notification of a reaction to a memo of the receiver.
+	InboxMessage_MEMO_REACTION InboxMessage_Type = 7
+	// Notification of a memo mentioning the receiver.
+	InboxMessage_MEMO_MENTION InboxMessage_Type = 8
+	// Digest summarizing the activity of the receiver.
+	InboxMessage_DIGEST InboxMessage_Type = 9
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -34,10 +48,24 @@ var (
 	InboxMessage_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		3: "MEMO_REMINDER",
+		4: "MEMO_AUTO_ARCHIVE",
+		5: "WEBHOOK_DISABLED",
+		6: "BACKUP_FAILED",
+		7: "MEMO_REACTION",
+		8: "MEMO_MENTION",
+		9: "DIGEST",
 	}
 	InboxMessage_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
+		"TYPE_UNSPECIFIED":  0,
+		"MEMO_COMMENT":      1,
+		"MEMO_REMINDER":     3,
+		"MEMO_AUTO_ARCHIVE": 4,
+		"WEBHOOK_DISABLED":  5,
+		"BACKUP_FAILED":     6,
+		"MEMO_REACTION":     7,
+		"MEMO_MENTION":      8,
+		"DIGEST":            9,
 	}
 )
 
@@ -126,14 +154,22 @@ var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xa8\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xb3\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\".\n" +
+	"activityId\x88\x01\x01\"\xb8\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01B\x0e\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x03\x12\x15\n" +
+	"\x11MEMO_AUTO_ARCHIVE\x10\x04\x12\x14\n" +
+	"\x10WEBHOOK_DISABLED\x10\x05\x12\x11\n" +
+	"\rBACKUP_FAILED\x10\x06\x12\x11\n" +
+	"\rMEMO_REACTION\x10\a\x12\x10\n" +
+	"\fMEMO_MENTION\x10\b\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\t\"\x04\b\x02\x10\x02B\x0e\n" +
 	"\f_activity_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
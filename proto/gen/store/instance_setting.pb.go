@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -33,16 +34,46 @@ const (
 	InstanceSettingKey_STORAGE InstanceSettingKey = 3
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSettingKey_MEMO_RELATED InstanceSettingKey = 4
+	// LINK_PREVIEW is the key for link preview settings.
+	InstanceSettingKey_LINK_PREVIEW InstanceSettingKey = 5
+	// EMAIL is the key for email settings.
+	InstanceSettingKey_EMAIL InstanceSettingKey = 6
+	// WEBHOOKS is the key for the workspace webhooks.
+	InstanceSettingKey_WEBHOOKS InstanceSettingKey = 7
+	// BACKUP is the key for the scheduled backup settings.
+	InstanceSettingKey_BACKUP InstanceSettingKey = 8
+	// SLACK is the key for the Slack app settings.
+	InstanceSettingKey_SLACK InstanceSettingKey = 9
+	// MATRIX is the key for the Matrix bot settings.
+	InstanceSettingKey_MATRIX InstanceSettingKey = 10
+	// MATRIX_SYNC is the key for the sync state of the Matrix bot. It's written by the bot only.
+	InstanceSettingKey_MATRIX_SYNC InstanceSettingKey = 11
+	// ANNOUNCEMENT is the key for the announcement banner.
+	InstanceSettingKey_ANNOUNCEMENT InstanceSettingKey = 12
+	// RATE_LIMIT is the key for the API rate limits.
+	InstanceSettingKey_RATE_LIMIT InstanceSettingKey = 13
+	// WEB_PUSH is the key for the VAPID keys of Web Push. They're generated by the server only.
+	InstanceSettingKey_WEB_PUSH InstanceSettingKey = 14
 )
 
 // Enum value maps for InstanceSettingKey.
 var (
 	InstanceSettingKey_name = map[int32]string{
-		0: "INSTANCE_SETTING_KEY_UNSPECIFIED",
-		1: "BASIC",
-		2: "GENERAL",
-		3: "STORAGE",
-		4: "MEMO_RELATED",
+		0:  "INSTANCE_SETTING_KEY_UNSPECIFIED",
+		1:  "BASIC",
+		2:  "GENERAL",
+		3:  "STORAGE",
+		4:  "MEMO_RELATED",
+		5:  "LINK_PREVIEW",
+		6:  "EMAIL",
+		7:  "WEBHOOKS",
+		8:  "BACKUP",
+		9:  "SLACK",
+		10: "MATRIX",
+		11: "MATRIX_SYNC",
+		12: "ANNOUNCEMENT",
+		13: "RATE_LIMIT",
+		14: "WEB_PUSH",
 	}
 	InstanceSettingKey_value = map[string]int32{
 		"INSTANCE_SETTING_KEY_UNSPECIFIED": 0,
@@ -50,6 +81,16 @@ var (
 		"GENERAL":                          2,
 		"STORAGE":                          3,
 		"MEMO_RELATED":                     4,
+		"LINK_PREVIEW":                     5,
+		"EMAIL":                            6,
+		"WEBHOOKS":                         7,
+		"BACKUP":                           8,
+		"SLACK":                            9,
+		"MATRIX":                           10,
+		"MATRIX_SYNC":                      11,
+		"ANNOUNCEMENT":                     12,
+		"RATE_LIMIT":                       13,
+		"WEB_PUSH":                         14,
 	}
 )
 
@@ -135,6 +176,220 @@ func (InstanceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_store_instance_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type InstanceLinkPreviewSetting_Mode int32
+
+const (
+	InstanceLinkPreviewSetting_MODE_UNSPECIFIED InstanceLinkPreviewSetting_Mode = 0
+	// OPEN allows previews of any public URL.
+	InstanceLinkPreviewSetting_OPEN InstanceLinkPreviewSetting_Mode = 1
+	// ALLOWLIST only allows previews of URLs whose host matches allowed_domains.
+	InstanceLinkPreviewSetting_ALLOWLIST InstanceLinkPreviewSetting_Mode = 2
+	// DISABLED turns off link previews entirely.
+	InstanceLinkPreviewSetting_DISABLED InstanceLinkPreviewSetting_Mode = 3
+)
+
+// Enum value maps for InstanceLinkPreviewSetting_Mode.
+var (
+	InstanceLinkPreviewSetting_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "OPEN",
+		2: "ALLOWLIST",
+		3: "DISABLED",
+	}
+	InstanceLinkPreviewSetting_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"OPEN":             1,
+		"ALLOWLIST":        2,
+		"DISABLED":         3,
+	}
+)
+
+func (x InstanceLinkPreviewSetting_Mode) Enum() *InstanceLinkPreviewSetting_Mode {
+	p := new(InstanceLinkPreviewSetting_Mode)
+	*p = x
+	return p
+}
+
+func (x InstanceLinkPreviewSetting_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceLinkPreviewSetting_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceLinkPreviewSetting_Mode) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[2]
+}
+
+func (x InstanceLinkPreviewSetting_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceLinkPreviewSetting_Mode.Descriptor instead.
+func (InstanceLinkPreviewSetting_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type InstanceEmailSetting_TlsMode int32
+
+const (
+	InstanceEmailSetting_TLS_MODE_UNSPECIFIED InstanceEmailSetting_TlsMode = 0
+	// STARTTLS upgrades the connection with STARTTLS, usually on port 587, and fails if the
+	// server doesn't support it.
+	InstanceEmailSetting_STARTTLS InstanceEmailSetting_TlsMode = 1
+	// TLS connects with implicit TLS, usually on port 465.
+	InstanceEmailSetting_TLS InstanceEmailSetting_TlsMode = 2
+	// NONE never uses TLS, for relays on a trusted network. Credentials are only sent to localhost.
+	InstanceEmailSetting_NONE InstanceEmailSetting_TlsMode = 3
+)
+
+// Enum value maps for InstanceEmailSetting_TlsMode.
+var (
+	InstanceEmailSetting_TlsMode_name = map[int32]string{
+		0: "TLS_MODE_UNSPECIFIED",
+		1: "STARTTLS",
+		2: "TLS",
+		3: "NONE",
+	}
+	InstanceEmailSetting_TlsMode_value = map[string]int32{
+		"TLS_MODE_UNSPECIFIED": 0,
+		"STARTTLS":             1,
+		"TLS":                  2,
+		"NONE":                 3,
+	}
+)
+
+func (x InstanceEmailSetting_TlsMode) Enum() *InstanceEmailSetting_TlsMode {
+	p := new(InstanceEmailSetting_TlsMode)
+	*p = x
+	return p
+}
+
+func (x InstanceEmailSetting_TlsMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceEmailSetting_TlsMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[3].Descriptor()
+}
+
+func (InstanceEmailSetting_TlsMode) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[3]
+}
+
+func (x InstanceEmailSetting_TlsMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceEmailSetting_TlsMode.Descriptor instead.
+func (InstanceEmailSetting_TlsMode) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type InstanceBackupSetting_Destination int32
+
+const (
+	InstanceBackupSetting_DESTINATION_UNSPECIFIED InstanceBackupSetting_Destination = 0
+	// LOCAL writes the backups to a directory of the server.
+	InstanceBackupSetting_LOCAL InstanceBackupSetting_Destination = 1
+	// S3 uploads the backups to the bucket of the S3 storage setting.
+	InstanceBackupSetting_S3 InstanceBackupSetting_Destination = 2
+)
+
+// Enum value maps for InstanceBackupSetting_Destination.
+var (
+	InstanceBackupSetting_Destination_name = map[int32]string{
+		0: "DESTINATION_UNSPECIFIED",
+		1: "LOCAL",
+		2: "S3",
+	}
+	InstanceBackupSetting_Destination_value = map[string]int32{
+		"DESTINATION_UNSPECIFIED": 0,
+		"LOCAL":                   1,
+		"S3":                      2,
+	}
+)
+
+func (x InstanceBackupSetting_Destination) Enum() *InstanceBackupSetting_Destination {
+	p := new(InstanceBackupSetting_Destination)
+	*p = x
+	return p
+}
+
+func (x InstanceBackupSetting_Destination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceBackupSetting_Destination) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (InstanceBackupSetting_Destination) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[4]
+}
+
+func (x InstanceBackupSetting_Destination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceBackupSetting_Destination.Descriptor instead.
+func (InstanceBackupSetting_Destination) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type InstanceAnnouncementSetting_Severity int32
+
+const (
+	InstanceAnnouncementSetting_SEVERITY_UNSPECIFIED InstanceAnnouncementSetting_Severity = 0
+	InstanceAnnouncementSetting_INFO                 InstanceAnnouncementSetting_Severity = 1
+	InstanceAnnouncementSetting_WARNING              InstanceAnnouncementSetting_Severity = 2
+	InstanceAnnouncementSetting_CRITICAL             InstanceAnnouncementSetting_Severity = 3
+)
+
+// Enum value maps for InstanceAnnouncementSetting_Severity.
+var (
+	InstanceAnnouncementSetting_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "INFO",
+		2: "WARNING",
+		3: "CRITICAL",
+	}
+	InstanceAnnouncementSetting_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"INFO":                 1,
+		"WARNING":              2,
+		"CRITICAL":             3,
+	}
+)
+
+func (x InstanceAnnouncementSetting_Severity) Enum() *InstanceAnnouncementSetting_Severity {
+	p := new(InstanceAnnouncementSetting_Severity)
+	*p = x
+	return p
+}
+
+func (x InstanceAnnouncementSetting_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceAnnouncementSetting_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[5].Descriptor()
+}
+
+func (InstanceAnnouncementSetting_Severity) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[5]
+}
+
+func (x InstanceAnnouncementSetting_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceAnnouncementSetting_Severity.Descriptor instead.
+func (InstanceAnnouncementSetting_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{15, 0}
+}
+
 type InstanceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   InstanceSettingKey     `protobuf:"varint,1,opt,name=key,proto3,enum=memos.store.InstanceSettingKey" json:"key,omitempty"`
@@ -144,6 +399,16 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting
 	//	*InstanceSetting_StorageSetting
 	//	*InstanceSetting_MemoRelatedSetting
+	//	*InstanceSetting_LinkPreviewSetting
+	//	*InstanceSetting_EmailSetting
+	//	*InstanceSetting_WebhooksSetting
+	//	*InstanceSetting_BackupSetting
+	//	*InstanceSetting_SlackSetting
+	//	*InstanceSetting_MatrixSetting
+	//	*InstanceSetting_MatrixSyncState
+	//	*InstanceSetting_AnnouncementSetting
+	//	*InstanceSetting_RateLimitSetting
+	//	*InstanceSetting_WebPushSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -229,6 +494,96 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceMemoRelatedSetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetLinkPreviewSetting() *InstanceLinkPreviewSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_LinkPreviewSetting); ok {
+			return x.LinkPreviewSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetEmailSetting() *InstanceEmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_EmailSetting); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetWebhooksSetting() *InstanceWebhooksSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_WebhooksSetting); ok {
+			return x.WebhooksSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetBackupSetting() *InstanceBackupSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_BackupSetting); ok {
+			return x.BackupSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetSlackSetting() *InstanceSlackSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_SlackSetting); ok {
+			return x.SlackSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetMatrixSetting() *InstanceMatrixSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_MatrixSetting); ok {
+			return x.MatrixSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetMatrixSyncState() *InstanceMatrixSyncState {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_MatrixSyncState); ok {
+			return x.MatrixSyncState
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetAnnouncementSetting() *InstanceAnnouncementSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_AnnouncementSetting); ok {
+			return x.AnnouncementSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetRateLimitSetting() *InstanceRateLimitSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_RateLimitSetting); ok {
+			return x.RateLimitSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetWebPushSetting() *InstanceWebPushSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_WebPushSetting); ok {
+			return x.WebPushSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -249,6 +604,46 @@ type InstanceSetting_MemoRelatedSetting struct {
 	MemoRelatedSetting *InstanceMemoRelatedSetting `protobuf:"bytes,5,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_LinkPreviewSetting struct {
+	LinkPreviewSetting *InstanceLinkPreviewSetting `protobuf:"bytes,6,opt,name=link_preview_setting,json=linkPreviewSetting,proto3,oneof"`
+}
+
+type InstanceSetting_EmailSetting struct {
+	EmailSetting *InstanceEmailSetting `protobuf:"bytes,7,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type InstanceSetting_WebhooksSetting struct {
+	WebhooksSetting *InstanceWebhooksSetting `protobuf:"bytes,8,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
+}
+
+type InstanceSetting_BackupSetting struct {
+	BackupSetting *InstanceBackupSetting `protobuf:"bytes,9,opt,name=backup_setting,json=backupSetting,proto3,oneof"`
+}
+
+type InstanceSetting_SlackSetting struct {
+	SlackSetting *InstanceSlackSetting `protobuf:"bytes,10,opt,name=slack_setting,json=slackSetting,proto3,oneof"`
+}
+
+type InstanceSetting_MatrixSetting struct {
+	MatrixSetting *InstanceMatrixSetting `protobuf:"bytes,11,opt,name=matrix_setting,json=matrixSetting,proto3,oneof"`
+}
+
+type InstanceSetting_MatrixSyncState struct {
+	MatrixSyncState *InstanceMatrixSyncState `protobuf:"bytes,12,opt,name=matrix_sync_state,json=matrixSyncState,proto3,oneof"`
+}
+
+type InstanceSetting_AnnouncementSetting struct {
+	AnnouncementSetting *InstanceAnnouncementSetting `protobuf:"bytes,13,opt,name=announcement_setting,json=announcementSetting,proto3,oneof"`
+}
+
+type InstanceSetting_RateLimitSetting struct {
+	RateLimitSetting *InstanceRateLimitSetting `protobuf:"bytes,14,opt,name=rate_limit_setting,json=rateLimitSetting,proto3,oneof"`
+}
+
+type InstanceSetting_WebPushSetting struct {
+	WebPushSetting *InstanceWebPushSetting `protobuf:"bytes,15,opt,name=web_push_setting,json=webPushSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_BasicSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_GeneralSetting) isInstanceSetting_Value() {}
@@ -257,6 +652,26 @@ func (*InstanceSetting_StorageSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_LinkPreviewSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_EmailSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_WebhooksSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_BackupSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_SlackSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_MatrixSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_MatrixSyncState) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_AnnouncementSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_RateLimitSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_WebPushSetting) isInstanceSetting_Value() {}
+
 type InstanceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for instance. Mainly used for session management.
@@ -331,8 +746,15 @@ type InstanceGeneralSetting struct {
 	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
 	// disallow_change_nickname disallows changing nickname.
 	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// audit_log_retention_days is how many days audit log entries are kept.
+	AuditLogRetentionDays int32 `protobuf:"varint,10,opt,name=audit_log_retention_days,json=auditLogRetentionDays,proto3" json:"audit_log_retention_days,omitempty"`
+	// session_idle_timeout_hours is how long a session stays valid without being used.
+	SessionIdleTimeoutHours int32 `protobuf:"varint,11,opt,name=session_idle_timeout_hours,json=sessionIdleTimeoutHours,proto3" json:"session_idle_timeout_hours,omitempty"`
+	// session_absolute_lifetime_hours caps the lifetime of a session from sign-in,
+	// however often it is used. 0 means no cap.
+	SessionAbsoluteLifetimeHours int32 `protobuf:"varint,12,opt,name=session_absolute_lifetime_hours,json=sessionAbsoluteLifetimeHours,proto3" json:"session_absolute_lifetime_hours,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *InstanceGeneralSetting) Reset() {
@@ -421,6 +843,27 @@ func (x *InstanceGeneralSetting) GetDisallowChangeNickname() bool {
 	return false
 }
 
+func (x *InstanceGeneralSetting) GetAuditLogRetentionDays() int32 {
+	if x != nil {
+		return x.AuditLogRetentionDays
+	}
+	return 0
+}
+
+func (x *InstanceGeneralSetting) GetSessionIdleTimeoutHours() int32 {
+	if x != nil {
+		return x.SessionIdleTimeoutHours
+	}
+	return 0
+}
+
+func (x *InstanceGeneralSetting) GetSessionAbsoluteLifetimeHours() int32 {
+	if x != nil {
+		return x.SessionAbsoluteLifetimeHours
+	}
+	return 0
+}
+
 type InstanceCustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -491,9 +934,23 @@ type InstanceStorageSetting struct {
 	// The max upload size in megabytes.
 	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
 	// The S3 config.
-	S3Config      *StorageS3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	S3Config *StorageS3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	// orphaned_attachment_grace_days is how many days attachments without a memo are kept
+	// before they are purged. Library uploads are never purged.
+	OrphanedAttachmentGraceDays int32 `protobuf:"varint,5,opt,name=orphaned_attachment_grace_days,json=orphanedAttachmentGraceDays,proto3" json:"orphaned_attachment_grace_days,omitempty"`
+	// strip_image_metadata strips the EXIF and XMP metadata of uploaded images, keeping
+	// their orientation. Users may override it in their general settings.
+	StripImageMetadata bool `protobuf:"varint,6,opt,name=strip_image_metadata,json=stripImageMetadata,proto3" json:"strip_image_metadata,omitempty"`
+	// default_user_quota_mb is the storage quota of each user in MiB, 0 means unlimited. The host
+	// may set another quota on a user.
+	DefaultUserQuotaMb int64 `protobuf:"varint,7,opt,name=default_user_quota_mb,json=defaultUserQuotaMb,proto3" json:"default_user_quota_mb,omitempty"`
+	// image_compression re-encodes the large JPEG and PNG uploads to save storage.
+	ImageCompression *StorageImageCompressionConfig `protobuf:"bytes,8,opt,name=image_compression,json=imageCompression,proto3" json:"image_compression,omitempty"`
+	// check_external_links re-checks the external links of the attachments every week, marking
+	// the links whose files are gone as broken.
+	CheckExternalLinks bool `protobuf:"varint,9,opt,name=check_external_links,json=checkExternalLinks,proto3" json:"check_external_links,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *InstanceStorageSetting) Reset() {
@@ -554,33 +1011,70 @@ func (x *InstanceStorageSetting) GetS3Config() *StorageS3Config {
 	return nil
 }
 
-// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
-type StorageS3Config struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
-	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
-	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *InstanceStorageSetting) GetOrphanedAttachmentGraceDays() int32 {
+	if x != nil {
+		return x.OrphanedAttachmentGraceDays
+	}
+	return 0
 }
 
-func (x *StorageS3Config) Reset() {
-	*x = StorageS3Config{}
+func (x *InstanceStorageSetting) GetStripImageMetadata() bool {
+	if x != nil {
+		return x.StripImageMetadata
+	}
+	return false
+}
+
+func (x *InstanceStorageSetting) GetDefaultUserQuotaMb() int64 {
+	if x != nil {
+		return x.DefaultUserQuotaMb
+	}
+	return 0
+}
+
+func (x *InstanceStorageSetting) GetImageCompression() *StorageImageCompressionConfig {
+	if x != nil {
+		return x.ImageCompression
+	}
+	return nil
+}
+
+func (x *InstanceStorageSetting) GetCheckExternalLinks() bool {
+	if x != nil {
+		return x.CheckExternalLinks
+	}
+	return false
+}
+
+type StorageImageCompressionConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled re-encodes the JPEG and PNG uploads larger than the threshold to WebP, or to a lower
+	// quality JPEG when smaller.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// quality is the encoding quality from 1 to 100, 0 meaning the default quality of 80.
+	Quality int32 `protobuf:"varint,2,opt,name=quality,proto3" json:"quality,omitempty"`
+	// max_dimension caps the long edge of the compressed images in pixels, 0 meaning uncapped.
+	MaxDimension int32 `protobuf:"varint,3,opt,name=max_dimension,json=maxDimension,proto3" json:"max_dimension,omitempty"`
+	// threshold_kb is the size in KiB up to which images are kept as uploaded.
+	ThresholdKb   int64 `protobuf:"varint,4,opt,name=threshold_kb,json=thresholdKb,proto3" json:"threshold_kb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageImageCompressionConfig) Reset() {
+	*x = StorageImageCompressionConfig{}
 	mi := &file_store_instance_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StorageS3Config) String() string {
+func (x *StorageImageCompressionConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageS3Config) ProtoMessage() {}
+func (*StorageImageCompressionConfig) ProtoMessage() {}
 
-func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
+func (x *StorageImageCompressionConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_store_instance_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,88 +1086,806 @@ func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
-func (*StorageS3Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use StorageImageCompressionConfig.ProtoReflect.Descriptor instead.
+func (*StorageImageCompressionConfig) Descriptor() ([]byte, []int) {
 	return file_store_instance_setting_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StorageS3Config) GetAccessKeyId() string {
+func (x *StorageImageCompressionConfig) GetEnabled() bool {
 	if x != nil {
-		return x.AccessKeyId
+		return x.Enabled
 	}
-	return ""
+	return false
+}
+
+func (x *StorageImageCompressionConfig) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *StorageImageCompressionConfig) GetMaxDimension() int32 {
+	if x != nil {
+		return x.MaxDimension
+	}
+	return 0
+}
+
+func (x *StorageImageCompressionConfig) GetThresholdKb() int64 {
+	if x != nil {
+		return x.ThresholdKb
+	}
+	return 0
+}
+
+// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
+type StorageS3Config struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StorageS3Config) Reset() {
+	*x = StorageS3Config{}
+	mi := &file_store_instance_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageS3Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageS3Config) ProtoMessage() {}
+
+func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
+func (*StorageS3Config) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StorageS3Config) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetAccessKeySecret() string {
+	if x != nil {
+		return x.AccessKeySecret
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetUsePathStyle() bool {
+	if x != nil {
+		return x.UsePathStyle
+	}
+	return false
+}
+
+type InstanceMemoRelatedSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disallow_public_visibility disallows set memo as public visibility.
+	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
+	// display_with_update_time orders and displays memo with update time.
+	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
+	// content_length_limit is the limit of content length. Unit is byte.
+	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
+	// enable_double_click_edit enables editing on double click.
+	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
+	// reactions is the list of reactions.
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
+	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
+	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
+	NsfwTags []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// trash_retention_days is how many days deleted memos stay in the trash before they are purged.
+	TrashRetentionDays int32 `protobuf:"varint,11,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	// memo_revision_limit is how many revisions are kept per memo, the oldest being pruned first.
+	MemoRevisionLimit int32 `protobuf:"varint,12,opt,name=memo_revision_limit,json=memoRevisionLimit,proto3" json:"memo_revision_limit,omitempty"`
+	// bookmark_duplicate_window_minutes is how long a bookmark memo is returned again instead of
+	// creating another memo when the same link is bookmarked.
+	BookmarkDuplicateWindowMinutes int32 `protobuf:"varint,13,opt,name=bookmark_duplicate_window_minutes,json=bookmarkDuplicateWindowMinutes,proto3" json:"bookmark_duplicate_window_minutes,omitempty"`
+	// max_memos_per_user is the number of memos a user may have, 0 means unlimited.
+	MaxMemosPerUser int32 `protobuf:"varint,14,opt,name=max_memos_per_user,json=maxMemosPerUser,proto3" json:"max_memos_per_user,omitempty"`
+	// max_memos_per_day is the number of memos a user may create per day, 0 means unlimited.
+	MaxMemosPerDay int32 `protobuf:"varint,15,opt,name=max_memos_per_day,json=maxMemosPerDay,proto3" json:"max_memos_per_day,omitempty"`
+	// new_account_max_public_memos_per_day is the number of public memos an account younger than
+	// new_account_days may create per day, 0 means unlimited.
+	NewAccountMaxPublicMemosPerDay int32 `protobuf:"varint,16,opt,name=new_account_max_public_memos_per_day,json=newAccountMaxPublicMemosPerDay,proto3" json:"new_account_max_public_memos_per_day,omitempty"`
+	// new_account_days is how many days an account is new for.
+	NewAccountDays int32 `protobuf:"varint,17,opt,name=new_account_days,json=newAccountDays,proto3" json:"new_account_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceMemoRelatedSetting) Reset() {
+	*x = InstanceMemoRelatedSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceMemoRelatedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMemoRelatedSetting) ProtoMessage() {}
+
+func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMemoRelatedSetting.ProtoReflect.Descriptor instead.
+func (*InstanceMemoRelatedSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InstanceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
+	if x != nil {
+		return x.DisallowPublicVisibility
+	}
+	return false
+}
+
+func (x *InstanceMemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+	if x != nil {
+		return x.DisplayWithUpdateTime
+	}
+	return false
+}
+
+func (x *InstanceMemoRelatedSetting) GetContentLengthLimit() int32 {
+	if x != nil {
+		return x.ContentLengthLimit
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+	if x != nil {
+		return x.EnableDoubleClickEdit
+	}
+	return false
+}
+
+func (x *InstanceMemoRelatedSetting) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *InstanceMemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+	if x != nil {
+		return x.EnableBlurNsfwContent
+	}
+	return false
+}
+
+func (x *InstanceMemoRelatedSetting) GetNsfwTags() []string {
+	if x != nil {
+		return x.NsfwTags
+	}
+	return nil
+}
+
+func (x *InstanceMemoRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetMemoRevisionLimit() int32 {
+	if x != nil {
+		return x.MemoRevisionLimit
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetBookmarkDuplicateWindowMinutes() int32 {
+	if x != nil {
+		return x.BookmarkDuplicateWindowMinutes
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetMaxMemosPerUser() int32 {
+	if x != nil {
+		return x.MaxMemosPerUser
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetMaxMemosPerDay() int32 {
+	if x != nil {
+		return x.MaxMemosPerDay
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetNewAccountMaxPublicMemosPerDay() int32 {
+	if x != nil {
+		return x.NewAccountMaxPublicMemosPerDay
+	}
+	return 0
+}
+
+func (x *InstanceMemoRelatedSetting) GetNewAccountDays() int32 {
+	if x != nil {
+		return x.NewAccountDays
+	}
+	return 0
+}
+
+type InstanceLinkPreviewSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rate_limit_per_minute is the number of link preview requests a user may make per minute.
+	RateLimitPerMinute int32 `protobuf:"varint,1,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	// rate_limit_burst is the number of requests a user may make in a burst.
+	RateLimitBurst int32 `protobuf:"varint,2,opt,name=rate_limit_burst,json=rateLimitBurst,proto3" json:"rate_limit_burst,omitempty"`
+	// admin_rate_limit_per_minute is the per-minute limit applied to admin users.
+	AdminRateLimitPerMinute int32 `protobuf:"varint,3,opt,name=admin_rate_limit_per_minute,json=adminRateLimitPerMinute,proto3" json:"admin_rate_limit_per_minute,omitempty"`
+	// mode controls which URLs may be previewed.
+	Mode InstanceLinkPreviewSetting_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=memos.store.InstanceLinkPreviewSetting_Mode" json:"mode,omitempty"`
+	// allowed_domains is the list of domain patterns allowed in ALLOWLIST mode.
+	// A leading "*." matches any subdomain, e.g. "*.wikipedia.org".
+	AllowedDomains []string `protobuf:"bytes,5,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	// allowed_internal_hosts lists private hosts that may be fetched despite the internal IP protection.
+	// Entries are hostnames (a leading "*." matches any subdomain), IP addresses or CIDR ranges, e.g. "192.168.1.0/24".
+	// Loopback and link-local (cloud metadata) addresses are never allowed.
+	AllowedInternalHosts []string `protobuf:"bytes,6,rep,name=allowed_internal_hosts,json=allowedInternalHosts,proto3" json:"allowed_internal_hosts,omitempty"`
+	// user_agent overrides the User-Agent sent with outbound fetches.
+	// Defaults to "memos-linkpreview/<version> (+<instance url>)".
+	UserAgent string `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// request_headers are extra headers sent with outbound fetches, e.g. Accept-Language.
+	// Credentials such as Authorization and Cookie are not allowed.
+	RequestHeaders map[string]string `protobuf:"bytes,8,rep,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceLinkPreviewSetting) Reset() {
+	*x = InstanceLinkPreviewSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceLinkPreviewSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceLinkPreviewSetting) ProtoMessage() {}
+
+func (x *InstanceLinkPreviewSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceLinkPreviewSetting.ProtoReflect.Descriptor instead.
+func (*InstanceLinkPreviewSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InstanceLinkPreviewSetting) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
+	}
+	return 0
+}
+
+func (x *InstanceLinkPreviewSetting) GetRateLimitBurst() int32 {
+	if x != nil {
+		return x.RateLimitBurst
+	}
+	return 0
+}
+
+func (x *InstanceLinkPreviewSetting) GetAdminRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.AdminRateLimitPerMinute
+	}
+	return 0
+}
+
+func (x *InstanceLinkPreviewSetting) GetMode() InstanceLinkPreviewSetting_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return InstanceLinkPreviewSetting_MODE_UNSPECIFIED
+}
+
+func (x *InstanceLinkPreviewSetting) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *InstanceLinkPreviewSetting) GetAllowedInternalHosts() []string {
+	if x != nil {
+		return x.AllowedInternalHosts
+	}
+	return nil
+}
+
+func (x *InstanceLinkPreviewSetting) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *InstanceLinkPreviewSetting) GetRequestHeaders() map[string]string {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+type InstanceEmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_host is the host of the SMTP server. Email is disabled if empty.
+	SmtpHost string `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	// smtp_port is the port of the SMTP server.
+	SmtpPort int32 `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username is the username for SMTP authentication, if required.
+	SmtpUsername string `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	// smtp_password is the password for SMTP authentication.
+	SmtpPassword string `protobuf:"bytes,4,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// use_tls connects with implicit TLS, usually on port 465. Otherwise STARTTLS is used if the server supports it.
+	UseTls bool `protobuf:"varint,5,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// from_email is the sender address of emails.
+	FromEmail string `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the sender name of emails.
+	FromName string `protobuf:"bytes,7,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// inbound_domain is the domain of the inbound email addresses of the users, whose emails
+	// become memos, e.g. "memos.example.com". Inbound email is disabled if empty.
+	InboundDomain string `protobuf:"bytes,8,opt,name=inbound_domain,json=inboundDomain,proto3" json:"inbound_domain,omitempty"`
+	// tls_mode is how the connection to the SMTP server is secured. If unspecified, use_tls is used.
+	TlsMode       InstanceEmailSetting_TlsMode `protobuf:"varint,9,opt,name=tls_mode,json=tlsMode,proto3,enum=memos.store.InstanceEmailSetting_TlsMode" json:"tls_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceEmailSetting) Reset() {
+	*x = InstanceEmailSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceEmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceEmailSetting) ProtoMessage() {}
+
+func (x *InstanceEmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceEmailSetting.ProtoReflect.Descriptor instead.
+func (*InstanceEmailSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstanceEmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *InstanceEmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceEmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetInboundDomain() string {
+	if x != nil {
+		return x.InboundDomain
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetTlsMode() InstanceEmailSetting_TlsMode {
+	if x != nil {
+		return x.TlsMode
+	}
+	return InstanceEmailSetting_TLS_MODE_UNSPECIFIED
+}
+
+type InstanceWebhooksSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace webhooks, which are sent for the events of all users.
+	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceWebhooksSetting) Reset() {
+	*x = InstanceWebhooksSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceWebhooksSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWebhooksSetting) ProtoMessage() {}
+
+func (x *InstanceWebhooksSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWebhooksSetting.ProtoReflect.Descriptor instead.
+func (*InstanceWebhooksSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InstanceWebhooksSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type InstanceBackupSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled turns on the scheduled backups.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// schedule is when backups run, as a standard cron expression in UTC such as "0 3 * * *", or a
+	// descriptor such as "@daily" or "@every 12h". Defaults to "@daily".
+	Schedule string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// destination is where backups are written. Defaults to LOCAL.
+	Destination InstanceBackupSetting_Destination `protobuf:"varint,3,opt,name=destination,proto3,enum=memos.store.InstanceBackupSetting_Destination" json:"destination,omitempty"`
+	// local_path is the directory of the LOCAL backups, relative to the data directory unless
+	// absolute. Defaults to "backups".
+	LocalPath string `protobuf:"bytes,4,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
+	// s3_prefix is the key prefix of the S3 backups. Defaults to "backups/".
+	S3Prefix string `protobuf:"bytes,5,opt,name=s3_prefix,json=s3Prefix,proto3" json:"s3_prefix,omitempty"`
+	// retention_count is how many backups are kept, the oldest being pruned first. Defaults to 7.
+	RetentionCount int32 `protobuf:"varint,6,opt,name=retention_count,json=retentionCount,proto3" json:"retention_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceBackupSetting) Reset() {
+	*x = InstanceBackupSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceBackupSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceBackupSetting) ProtoMessage() {}
+
+func (x *InstanceBackupSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceBackupSetting.ProtoReflect.Descriptor instead.
+func (*InstanceBackupSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InstanceBackupSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *InstanceBackupSetting) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *InstanceBackupSetting) GetDestination() InstanceBackupSetting_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return InstanceBackupSetting_DESTINATION_UNSPECIFIED
+}
+
+func (x *InstanceBackupSetting) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *InstanceBackupSetting) GetS3Prefix() string {
+	if x != nil {
+		return x.S3Prefix
+	}
+	return ""
+}
+
+func (x *InstanceBackupSetting) GetRetentionCount() int32 {
+	if x != nil {
+		return x.RetentionCount
+	}
+	return 0
+}
+
+type InstanceSlackSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// signing_secret verifies the requests of the Slack app. The integration is disabled while it's
+	// empty.
+	SigningSecret string `protobuf:"bytes,1,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// bot_token is the bot token of the Slack app, used to reply to messages, get the permalinks of
+	// messages and download their files.
+	BotToken      string `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSlackSetting) Reset() {
+	*x = InstanceSlackSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSlackSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSlackSetting) ProtoMessage() {}
+
+func (x *InstanceSlackSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *StorageS3Config) GetAccessKeySecret() string {
+// Deprecated: Use InstanceSlackSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSlackSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InstanceSlackSetting) GetSigningSecret() string {
 	if x != nil {
-		return x.AccessKeySecret
+		return x.SigningSecret
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetEndpoint() string {
+func (x *InstanceSlackSetting) GetBotToken() string {
 	if x != nil {
-		return x.Endpoint
+		return x.BotToken
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetRegion() string {
+type InstanceMatrixSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// homeserver_url is the URL of the homeserver of the bot account, e.g. "https://matrix.org".
+	// The bot is disabled while it or the access token is empty.
+	HomeserverUrl string `protobuf:"bytes,1,opt,name=homeserver_url,json=homeserverUrl,proto3" json:"homeserver_url,omitempty"`
+	// access_token is the access token of the bot account.
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// rooms are the rooms the bot joined. The rooms joined on invite are added enabled, and the
+	// rooms the bot isn't listed in are handled as enabled rooms without posting.
+	Rooms         []*InstanceMatrixSetting_Room `protobuf:"bytes,3,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceMatrixSetting) Reset() {
+	*x = InstanceMatrixSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceMatrixSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMatrixSetting) ProtoMessage() {}
+
+func (x *InstanceMatrixSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[13]
 	if x != nil {
-		return x.Region
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMatrixSetting.ProtoReflect.Descriptor instead.
+func (*InstanceMatrixSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InstanceMatrixSetting) GetHomeserverUrl() string {
+	if x != nil {
+		return x.HomeserverUrl
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetBucket() string {
+func (x *InstanceMatrixSetting) GetAccessToken() string {
 	if x != nil {
-		return x.Bucket
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetUsePathStyle() bool {
+func (x *InstanceMatrixSetting) GetRooms() []*InstanceMatrixSetting_Room {
 	if x != nil {
-		return x.UsePathStyle
+		return x.Rooms
 	}
-	return false
+	return nil
 }
 
-type InstanceMemoRelatedSetting struct {
+type InstanceMatrixSyncState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// disallow_public_visibility disallows set memo as public visibility.
-	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
-	// display_with_update_time orders and displays memo with update time.
-	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
-	// content_length_limit is the limit of content length. Unit is byte.
-	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
-	// enable_double_click_edit enables editing on double click.
-	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
-	// reactions is the list of reactions.
-	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
-	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
-	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// homeserver_url and user_id are the bot account the sync state is for, so that the state is
+	// reset when the account changes.
+	HomeserverUrl string `protobuf:"bytes,1,opt,name=homeserver_url,json=homeserverUrl,proto3" json:"homeserver_url,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// next_batch is the token to resume the sync from.
+	NextBatch string `protobuf:"bytes,3,opt,name=next_batch,json=nextBatch,proto3" json:"next_batch,omitempty"`
+	// post_time is the creation time of the last public memo posted to the rooms.
+	PostTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=post_time,json=postTime,proto3" json:"post_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstanceMemoRelatedSetting) Reset() {
-	*x = InstanceMemoRelatedSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[6]
+func (x *InstanceMatrixSyncState) Reset() {
+	*x = InstanceMatrixSyncState{}
+	mi := &file_store_instance_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstanceMemoRelatedSetting) String() string {
+func (x *InstanceMatrixSyncState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstanceMemoRelatedSetting) ProtoMessage() {}
+func (*InstanceMatrixSyncState) ProtoMessage() {}
 
-func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[6]
+func (x *InstanceMatrixSyncState) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,76 +1896,356 @@ func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstanceMemoRelatedSetting.ProtoReflect.Descriptor instead.
-func (*InstanceMemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use InstanceMatrixSyncState.ProtoReflect.Descriptor instead.
+func (*InstanceMatrixSyncState) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *InstanceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
+func (x *InstanceMatrixSyncState) GetHomeserverUrl() string {
 	if x != nil {
-		return x.DisallowPublicVisibility
+		return x.HomeserverUrl
+	}
+	return ""
+}
+
+func (x *InstanceMatrixSyncState) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InstanceMatrixSyncState) GetNextBatch() string {
+	if x != nil {
+		return x.NextBatch
+	}
+	return ""
+}
+
+func (x *InstanceMatrixSyncState) GetPostTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostTime
+	}
+	return nil
+}
+
+type InstanceAnnouncementSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// content is the markdown text of the announcement, empty for no announcement.
+	Content  string                               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Severity InstanceAnnouncementSetting_Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=memos.store.InstanceAnnouncementSetting_Severity" json:"severity,omitempty"`
+	// start_time and end_time bound when the announcement is shown, unbounded if not set.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// dismissible lets the users hide the announcement.
+	Dismissible   bool `protobuf:"varint,5,opt,name=dismissible,proto3" json:"dismissible,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceAnnouncementSetting) Reset() {
+	*x = InstanceAnnouncementSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceAnnouncementSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceAnnouncementSetting) ProtoMessage() {}
+
+func (x *InstanceAnnouncementSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceAnnouncementSetting.ProtoReflect.Descriptor instead.
+func (*InstanceAnnouncementSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InstanceAnnouncementSetting) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InstanceAnnouncementSetting) GetSeverity() InstanceAnnouncementSetting_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return InstanceAnnouncementSetting_SEVERITY_UNSPECIFIED
+}
+
+func (x *InstanceAnnouncementSetting) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *InstanceAnnouncementSetting) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *InstanceAnnouncementSetting) GetDismissible() bool {
+	if x != nil {
+		return x.Dismissible
 	}
 	return false
 }
 
-func (x *InstanceMemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+type InstanceRateLimitSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disabled turns the API rate limits off.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// read_requests_per_minute is the number of read requests a user, or an anonymous client by
+	// IP, may make per minute.
+	ReadRequestsPerMinute int32 `protobuf:"varint,2,opt,name=read_requests_per_minute,json=readRequestsPerMinute,proto3" json:"read_requests_per_minute,omitempty"`
+	// read_burst is the number of read requests that may be made in a burst.
+	ReadBurst int32 `protobuf:"varint,3,opt,name=read_burst,json=readBurst,proto3" json:"read_burst,omitempty"`
+	// write_requests_per_minute is the number of write requests a user, or an anonymous client by
+	// IP, may make per minute.
+	WriteRequestsPerMinute int32 `protobuf:"varint,4,opt,name=write_requests_per_minute,json=writeRequestsPerMinute,proto3" json:"write_requests_per_minute,omitempty"`
+	// write_burst is the number of write requests that may be made in a burst.
+	WriteBurst int32 `protobuf:"varint,5,opt,name=write_burst,json=writeBurst,proto3" json:"write_burst,omitempty"`
+	// host_multiplier multiplies the limits of the host.
+	HostMultiplier int32 `protobuf:"varint,6,opt,name=host_multiplier,json=hostMultiplier,proto3" json:"host_multiplier,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceRateLimitSetting) Reset() {
+	*x = InstanceRateLimitSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceRateLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceRateLimitSetting) ProtoMessage() {}
+
+func (x *InstanceRateLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[16]
 	if x != nil {
-		return x.DisplayWithUpdateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceRateLimitSetting.ProtoReflect.Descriptor instead.
+func (*InstanceRateLimitSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InstanceRateLimitSetting) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
 	}
 	return false
 }
 
-func (x *InstanceMemoRelatedSetting) GetContentLengthLimit() int32 {
+func (x *InstanceRateLimitSetting) GetReadRequestsPerMinute() int32 {
 	if x != nil {
-		return x.ContentLengthLimit
+		return x.ReadRequestsPerMinute
 	}
 	return 0
 }
 
-func (x *InstanceMemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+func (x *InstanceRateLimitSetting) GetReadBurst() int32 {
 	if x != nil {
-		return x.EnableDoubleClickEdit
+		return x.ReadBurst
 	}
-	return false
+	return 0
 }
 
-func (x *InstanceMemoRelatedSetting) GetReactions() []string {
+func (x *InstanceRateLimitSetting) GetWriteRequestsPerMinute() int32 {
 	if x != nil {
-		return x.Reactions
+		return x.WriteRequestsPerMinute
 	}
-	return nil
+	return 0
 }
 
-func (x *InstanceMemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+func (x *InstanceRateLimitSetting) GetWriteBurst() int32 {
 	if x != nil {
-		return x.EnableBlurNsfwContent
+		return x.WriteBurst
+	}
+	return 0
+}
+
+func (x *InstanceRateLimitSetting) GetHostMultiplier() int32 {
+	if x != nil {
+		return x.HostMultiplier
+	}
+	return 0
+}
+
+type InstanceWebPushSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// vapid_public_key and vapid_private_key are the base64url-encoded P-256 keys identifying the
+	// instance to push services. The public key is the applicationServerKey of the subscriptions,
+	// so they can't change without the subscriptions being lost.
+	VapidPublicKey  string `protobuf:"bytes,1,opt,name=vapid_public_key,json=vapidPublicKey,proto3" json:"vapid_public_key,omitempty"`
+	VapidPrivateKey string `protobuf:"bytes,2,opt,name=vapid_private_key,json=vapidPrivateKey,proto3" json:"vapid_private_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceWebPushSetting) Reset() {
+	*x = InstanceWebPushSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceWebPushSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceWebPushSetting) ProtoMessage() {}
+
+func (x *InstanceWebPushSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceWebPushSetting.ProtoReflect.Descriptor instead.
+func (*InstanceWebPushSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InstanceWebPushSetting) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
+func (x *InstanceWebPushSetting) GetVapidPrivateKey() string {
+	if x != nil {
+		return x.VapidPrivateKey
+	}
+	return ""
+}
+
+type InstanceMatrixSetting_Room struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// room_id is the ID of the room, e.g. "!abc:example.com".
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// enabled turns the messages of the room into memos. The bot ignores the rooms that aren't
+	// enabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// post_public_memos posts the new public memos of the users subscribed to the room.
+	PostPublicMemos bool `protobuf:"varint,3,opt,name=post_public_memos,json=postPublicMemos,proto3" json:"post_public_memos,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InstanceMatrixSetting_Room) Reset() {
+	*x = InstanceMatrixSetting_Room{}
+	mi := &file_store_instance_setting_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceMatrixSetting_Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMatrixSetting_Room) ProtoMessage() {}
+
+func (x *InstanceMatrixSetting_Room) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMatrixSetting_Room.ProtoReflect.Descriptor instead.
+func (*InstanceMatrixSetting_Room) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *InstanceMatrixSetting_Room) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *InstanceMatrixSetting_Room) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
 	return false
 }
 
-func (x *InstanceMemoRelatedSetting) GetNsfwTags() []string {
+func (x *InstanceMatrixSetting_Room) GetPostPublicMemos() bool {
 	if x != nil {
-		return x.NsfwTags
+		return x.PostPublicMemos
 	}
-	return nil
+	return false
 }
 
 var File_store_instance_setting_proto protoreflect.FileDescriptor
 
 const file_store_instance_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\x94\x03\n" +
+	"\x1cstore/instance_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18store/user_setting.proto\"\xcd\t\n" +
 	"\x0fInstanceSetting\x121\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1f.memos.store.InstanceSettingKeyR\x03key\x12H\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2!.memos.store.InstanceBasicSettingH\x00R\fbasicSetting\x12N\n" +
 	"\x0fgeneral_setting\x18\x03 \x01(\v2#.memos.store.InstanceGeneralSettingH\x00R\x0egeneralSetting\x12N\n" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2#.memos.store.InstanceStorageSettingH\x00R\x0estorageSetting\x12[\n" +
-	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSettingB\a\n" +
+	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12[\n" +
+	"\x14link_preview_setting\x18\x06 \x01(\v2'.memos.store.InstanceLinkPreviewSettingH\x00R\x12linkPreviewSetting\x12H\n" +
+	"\remail_setting\x18\a \x01(\v2!.memos.store.InstanceEmailSettingH\x00R\femailSetting\x12Q\n" +
+	"\x10webhooks_setting\x18\b \x01(\v2$.memos.store.InstanceWebhooksSettingH\x00R\x0fwebhooksSetting\x12K\n" +
+	"\x0ebackup_setting\x18\t \x01(\v2\".memos.store.InstanceBackupSettingH\x00R\rbackupSetting\x12H\n" +
+	"\rslack_setting\x18\n" +
+	" \x01(\v2!.memos.store.InstanceSlackSettingH\x00R\fslackSetting\x12K\n" +
+	"\x0ematrix_setting\x18\v \x01(\v2\".memos.store.InstanceMatrixSettingH\x00R\rmatrixSetting\x12R\n" +
+	"\x11matrix_sync_state\x18\f \x01(\v2$.memos.store.InstanceMatrixSyncStateH\x00R\x0fmatrixSyncState\x12]\n" +
+	"\x14announcement_setting\x18\r \x01(\v2(.memos.store.InstanceAnnouncementSettingH\x00R\x13announcementSetting\x12U\n" +
+	"\x12rate_limit_setting\x18\x0e \x01(\v2%.memos.store.InstanceRateLimitSettingH\x00R\x10rateLimitSetting\x12O\n" +
+	"\x10web_push_setting\x18\x0f \x01(\v2#.memos.store.InstanceWebPushSettingH\x00R\x0ewebPushSettingB\a\n" +
 	"\x05value\"\\\n" +
 	"\x14InstanceBasicSetting\x12\x1d\n" +
 	"\n" +
 	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
-	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xd6\x03\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\x93\x05\n" +
 	"\x16InstanceGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -762,28 +2254,42 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2\".memos.store.InstanceCustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"j\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x127\n" +
+	"\x18audit_log_retention_days\x18\n" +
+	" \x01(\x05R\x15auditLogRetentionDays\x12;\n" +
+	"\x1asession_idle_timeout_hours\x18\v \x01(\x05R\x17sessionIdleTimeoutHours\x12E\n" +
+	"\x1fsession_absolute_lifetime_hours\x18\f \x01(\x05R\x1csessionAbsoluteLifetimeHours\"j\n" +
 	"\x15InstanceCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\"\xd3\x02\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\"\x88\x05\n" +
 	"\x16InstanceStorageSetting\x12R\n" +
 	"\fstorage_type\x18\x01 \x01(\x0e2/.memos.store.InstanceStorageSetting.StorageTypeR\vstorageType\x12+\n" +
 	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
 	"\x14upload_size_limit_mb\x18\x03 \x01(\x03R\x11uploadSizeLimitMb\x129\n" +
-	"\ts3_config\x18\x04 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\"L\n" +
+	"\ts3_config\x18\x04 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\x12C\n" +
+	"\x1eorphaned_attachment_grace_days\x18\x05 \x01(\x05R\x1borphanedAttachmentGraceDays\x120\n" +
+	"\x14strip_image_metadata\x18\x06 \x01(\bR\x12stripImageMetadata\x121\n" +
+	"\x15default_user_quota_mb\x18\a \x01(\x03R\x12defaultUserQuotaMb\x12W\n" +
+	"\x11image_compression\x18\b \x01(\v2*.memos.store.StorageImageCompressionConfigR\x10imageCompression\x120\n" +
+	"\x14check_external_links\x18\t \x01(\bR\x12checkExternalLinks\"L\n" +
 	"\vStorageType\x12\x1c\n" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\"\xd3\x01\n" +
+	"\x02S3\x10\x03\"\x9b\x01\n" +
+	"\x1dStorageImageCompressionConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aquality\x18\x02 \x01(\x05R\aquality\x12#\n" +
+	"\rmax_dimension\x18\x03 \x01(\x05R\fmaxDimension\x12!\n" +
+	"\fthreshold_kb\x18\x04 \x01(\x03R\vthresholdKb\"\xd3\x01\n" +
 	"\x0fStorageS3Config\x12\"\n" +
 	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
 	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xf2\x02\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\xef\x05\n" +
 	"\x1aInstanceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -792,13 +2298,123 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\treactions\x18\a \x03(\tR\treactions\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags*q\n" +
+	" \x03(\tR\bnsfwTags\x120\n" +
+	"\x14trash_retention_days\x18\v \x01(\x05R\x12trashRetentionDays\x12.\n" +
+	"\x13memo_revision_limit\x18\f \x01(\x05R\x11memoRevisionLimit\x12I\n" +
+	"!bookmark_duplicate_window_minutes\x18\r \x01(\x05R\x1ebookmarkDuplicateWindowMinutes\x12+\n" +
+	"\x12max_memos_per_user\x18\x0e \x01(\x05R\x0fmaxMemosPerUser\x12)\n" +
+	"\x11max_memos_per_day\x18\x0f \x01(\x05R\x0emaxMemosPerDay\x12L\n" +
+	"$new_account_max_public_memos_per_day\x18\x10 \x01(\x05R\x1enewAccountMaxPublicMemosPerDay\x12(\n" +
+	"\x10new_account_days\x18\x11 \x01(\x05R\x0enewAccountDays\"\xe5\x04\n" +
+	"\x1aInstanceLinkPreviewSetting\x121\n" +
+	"\x15rate_limit_per_minute\x18\x01 \x01(\x05R\x12rateLimitPerMinute\x12(\n" +
+	"\x10rate_limit_burst\x18\x02 \x01(\x05R\x0erateLimitBurst\x12<\n" +
+	"\x1badmin_rate_limit_per_minute\x18\x03 \x01(\x05R\x17adminRateLimitPerMinute\x12@\n" +
+	"\x04mode\x18\x04 \x01(\x0e2,.memos.store.InstanceLinkPreviewSetting.ModeR\x04mode\x12'\n" +
+	"\x0fallowed_domains\x18\x05 \x03(\tR\x0eallowedDomains\x124\n" +
+	"\x16allowed_internal_hosts\x18\x06 \x03(\tR\x14allowedInternalHosts\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12d\n" +
+	"\x0frequest_headers\x18\b \x03(\v2;.memos.store.InstanceLinkPreviewSetting.RequestHeadersEntryR\x0erequestHeaders\x1aA\n" +
+	"\x13RequestHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04OPEN\x10\x01\x12\r\n" +
+	"\tALLOWLIST\x10\x02\x12\f\n" +
+	"\bDISABLED\x10\x03\"\xa2\x03\n" +
+	"\x14InstanceEmailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x04 \x01(\tR\fsmtpPassword\x12\x17\n" +
+	"\ause_tls\x18\x05 \x01(\bR\x06useTls\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\a \x01(\tR\bfromName\x12%\n" +
+	"\x0einbound_domain\x18\b \x01(\tR\rinboundDomain\x12D\n" +
+	"\btls_mode\x18\t \x01(\x0e2).memos.store.InstanceEmailSetting.TlsModeR\atlsMode\"D\n" +
+	"\aTlsMode\x12\x18\n" +
+	"\x14TLS_MODE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSTARTTLS\x10\x01\x12\a\n" +
+	"\x03TLS\x10\x02\x12\b\n" +
+	"\x04NONE\x10\x03\"_\n" +
+	"\x17InstanceWebhooksSetting\x12D\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\"\xc3\x02\n" +
+	"\x15InstanceBackupSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12P\n" +
+	"\vdestination\x18\x03 \x01(\x0e2..memos.store.InstanceBackupSetting.DestinationR\vdestination\x12\x1d\n" +
+	"\n" +
+	"local_path\x18\x04 \x01(\tR\tlocalPath\x12\x1b\n" +
+	"\ts3_prefix\x18\x05 \x01(\tR\bs3Prefix\x12'\n" +
+	"\x0fretention_count\x18\x06 \x01(\x05R\x0eretentionCount\"=\n" +
+	"\vDestination\x12\x1b\n" +
+	"\x17DESTINATION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05LOCAL\x10\x01\x12\x06\n" +
+	"\x02S3\x10\x02\"Z\n" +
+	"\x14InstanceSlackSetting\x12%\n" +
+	"\x0esigning_secret\x18\x01 \x01(\tR\rsigningSecret\x12\x1b\n" +
+	"\tbot_token\x18\x02 \x01(\tR\bbotToken\"\x87\x02\n" +
+	"\x15InstanceMatrixSetting\x12%\n" +
+	"\x0ehomeserver_url\x18\x01 \x01(\tR\rhomeserverUrl\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12=\n" +
+	"\x05rooms\x18\x03 \x03(\v2'.memos.store.InstanceMatrixSetting.RoomR\x05rooms\x1ae\n" +
+	"\x04Room\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12*\n" +
+	"\x11post_public_memos\x18\x03 \x01(\bR\x0fpostPublicMemos\"\xb1\x01\n" +
+	"\x17InstanceMatrixSyncState\x12%\n" +
+	"\x0ehomeserver_url\x18\x01 \x01(\tR\rhomeserverUrl\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"next_batch\x18\x03 \x01(\tR\tnextBatch\x127\n" +
+	"\tpost_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bpostTime\"\xe5\x02\n" +
+	"\x1bInstanceAnnouncementSetting\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12M\n" +
+	"\bseverity\x18\x02 \x01(\x0e21.memos.store.InstanceAnnouncementSetting.SeverityR\bseverity\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\vdismissible\x18\x05 \x01(\bR\vdismissible\"I\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04INFO\x10\x01\x12\v\n" +
+	"\aWARNING\x10\x02\x12\f\n" +
+	"\bCRITICAL\x10\x03\"\x93\x02\n" +
+	"\x18InstanceRateLimitSetting\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x127\n" +
+	"\x18read_requests_per_minute\x18\x02 \x01(\x05R\x15readRequestsPerMinute\x12\x1d\n" +
+	"\n" +
+	"read_burst\x18\x03 \x01(\x05R\treadBurst\x129\n" +
+	"\x19write_requests_per_minute\x18\x04 \x01(\x05R\x16writeRequestsPerMinute\x12\x1f\n" +
+	"\vwrite_burst\x18\x05 \x01(\x05R\n" +
+	"writeBurst\x12'\n" +
+	"\x0fhost_multiplier\x18\x06 \x01(\x05R\x0ehostMultiplier\"n\n" +
+	"\x16InstanceWebPushSetting\x12(\n" +
+	"\x10vapid_public_key\x18\x01 \x01(\tR\x0evapidPublicKey\x12*\n" +
+	"\x11vapid_private_key\x18\x02 \x01(\tR\x0fvapidPrivateKey*\x80\x02\n" +
 	"\x12InstanceSettingKey\x12$\n" +
 	" INSTANCE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x04B\x9f\x01\n" +
+	"\fMEMO_RELATED\x10\x04\x12\x10\n" +
+	"\fLINK_PREVIEW\x10\x05\x12\t\n" +
+	"\x05EMAIL\x10\x06\x12\f\n" +
+	"\bWEBHOOKS\x10\a\x12\n" +
+	"\n" +
+	"\x06BACKUP\x10\b\x12\t\n" +
+	"\x05SLACK\x10\t\x12\n" +
+	"\n" +
+	"\x06MATRIX\x10\n" +
+	"\x12\x0f\n" +
+	"\vMATRIX_SYNC\x10\v\x12\x10\n" +
+	"\fANNOUNCEMENT\x10\f\x12\x0e\n" +
+	"\n" +
+	"RATE_LIMIT\x10\r\x12\f\n" +
+	"\bWEB_PUSH\x10\x0eB\x9f\x01\n" +
 	"\x0fcom.memos.storeB\x14InstanceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -813,33 +2429,73 @@ func file_store_instance_setting_proto_rawDescGZIP() []byte {
 	return file_store_instance_setting_proto_rawDescData
 }
 
-var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_store_instance_setting_proto_goTypes = []any{
-	(InstanceSettingKey)(0),                 // 0: memos.store.InstanceSettingKey
-	(InstanceStorageSetting_StorageType)(0), // 1: memos.store.InstanceStorageSetting.StorageType
-	(*InstanceSetting)(nil),                 // 2: memos.store.InstanceSetting
-	(*InstanceBasicSetting)(nil),            // 3: memos.store.InstanceBasicSetting
-	(*InstanceGeneralSetting)(nil),          // 4: memos.store.InstanceGeneralSetting
-	(*InstanceCustomProfile)(nil),           // 5: memos.store.InstanceCustomProfile
-	(*InstanceStorageSetting)(nil),          // 6: memos.store.InstanceStorageSetting
-	(*StorageS3Config)(nil),                 // 7: memos.store.StorageS3Config
-	(*InstanceMemoRelatedSetting)(nil),      // 8: memos.store.InstanceMemoRelatedSetting
+	(InstanceSettingKey)(0),                   // 0: memos.store.InstanceSettingKey
+	(InstanceStorageSetting_StorageType)(0),   // 1: memos.store.InstanceStorageSetting.StorageType
+	(InstanceLinkPreviewSetting_Mode)(0),      // 2: memos.store.InstanceLinkPreviewSetting.Mode
+	(InstanceEmailSetting_TlsMode)(0),         // 3: memos.store.InstanceEmailSetting.TlsMode
+	(InstanceBackupSetting_Destination)(0),    // 4: memos.store.InstanceBackupSetting.Destination
+	(InstanceAnnouncementSetting_Severity)(0), // 5: memos.store.InstanceAnnouncementSetting.Severity
+	(*InstanceSetting)(nil),                   // 6: memos.store.InstanceSetting
+	(*InstanceBasicSetting)(nil),              // 7: memos.store.InstanceBasicSetting
+	(*InstanceGeneralSetting)(nil),            // 8: memos.store.InstanceGeneralSetting
+	(*InstanceCustomProfile)(nil),             // 9: memos.store.InstanceCustomProfile
+	(*InstanceStorageSetting)(nil),            // 10: memos.store.InstanceStorageSetting
+	(*StorageImageCompressionConfig)(nil),     // 11: memos.store.StorageImageCompressionConfig
+	(*StorageS3Config)(nil),                   // 12: memos.store.StorageS3Config
+	(*InstanceMemoRelatedSetting)(nil),        // 13: memos.store.InstanceMemoRelatedSetting
+	(*InstanceLinkPreviewSetting)(nil),        // 14: memos.store.InstanceLinkPreviewSetting
+	(*InstanceEmailSetting)(nil),              // 15: memos.store.InstanceEmailSetting
+	(*InstanceWebhooksSetting)(nil),           // 16: memos.store.InstanceWebhooksSetting
+	(*InstanceBackupSetting)(nil),             // 17: memos.store.InstanceBackupSetting
+	(*InstanceSlackSetting)(nil),              // 18: memos.store.InstanceSlackSetting
+	(*InstanceMatrixSetting)(nil),             // 19: memos.store.InstanceMatrixSetting
+	(*InstanceMatrixSyncState)(nil),           // 20: memos.store.InstanceMatrixSyncState
+	(*InstanceAnnouncementSetting)(nil),       // 21: memos.store.InstanceAnnouncementSetting
+	(*InstanceRateLimitSetting)(nil),          // 22: memos.store.InstanceRateLimitSetting
+	(*InstanceWebPushSetting)(nil),            // 23: memos.store.InstanceWebPushSetting
+	nil,                                       // 24: memos.store.InstanceLinkPreviewSetting.RequestHeadersEntry
+	(*InstanceMatrixSetting_Room)(nil),        // 25: memos.store.InstanceMatrixSetting.Room
+	(*WebhooksUserSetting_Webhook)(nil),       // 26: memos.store.WebhooksUserSetting.Webhook
+	(*timestamppb.Timestamp)(nil),             // 27: google.protobuf.Timestamp
 }
 var file_store_instance_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
-	3, // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
-	4, // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
-	6, // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
-	8, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
-	5, // 5: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
-	1, // 6: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
-	7, // 7: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
+	7,  // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
+	8,  // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
+	10, // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
+	13, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
+	14, // 5: memos.store.InstanceSetting.link_preview_setting:type_name -> memos.store.InstanceLinkPreviewSetting
+	15, // 6: memos.store.InstanceSetting.email_setting:type_name -> memos.store.InstanceEmailSetting
+	16, // 7: memos.store.InstanceSetting.webhooks_setting:type_name -> memos.store.InstanceWebhooksSetting
+	17, // 8: memos.store.InstanceSetting.backup_setting:type_name -> memos.store.InstanceBackupSetting
+	18, // 9: memos.store.InstanceSetting.slack_setting:type_name -> memos.store.InstanceSlackSetting
+	19, // 10: memos.store.InstanceSetting.matrix_setting:type_name -> memos.store.InstanceMatrixSetting
+	20, // 11: memos.store.InstanceSetting.matrix_sync_state:type_name -> memos.store.InstanceMatrixSyncState
+	21, // 12: memos.store.InstanceSetting.announcement_setting:type_name -> memos.store.InstanceAnnouncementSetting
+	22, // 13: memos.store.InstanceSetting.rate_limit_setting:type_name -> memos.store.InstanceRateLimitSetting
+	23, // 14: memos.store.InstanceSetting.web_push_setting:type_name -> memos.store.InstanceWebPushSetting
+	9,  // 15: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
+	1,  // 16: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
+	12, // 17: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	11, // 18: memos.store.InstanceStorageSetting.image_compression:type_name -> memos.store.StorageImageCompressionConfig
+	2,  // 19: memos.store.InstanceLinkPreviewSetting.mode:type_name -> memos.store.InstanceLinkPreviewSetting.Mode
+	24, // 20: memos.store.InstanceLinkPreviewSetting.request_headers:type_name -> memos.store.InstanceLinkPreviewSetting.RequestHeadersEntry
+	3,  // 21: memos.store.InstanceEmailSetting.tls_mode:type_name -> memos.store.InstanceEmailSetting.TlsMode
+	26, // 22: memos.store.InstanceWebhooksSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	4,  // 23: memos.store.InstanceBackupSetting.destination:type_name -> memos.store.InstanceBackupSetting.Destination
+	25, // 24: memos.store.InstanceMatrixSetting.rooms:type_name -> memos.store.InstanceMatrixSetting.Room
+	27, // 25: memos.store.InstanceMatrixSyncState.post_time:type_name -> google.protobuf.Timestamp
+	5,  // 26: memos.store.InstanceAnnouncementSetting.severity:type_name -> memos.store.InstanceAnnouncementSetting.Severity
+	27, // 27: memos.store.InstanceAnnouncementSetting.start_time:type_name -> google.protobuf.Timestamp
+	27, // 28: memos.store.InstanceAnnouncementSetting.end_time:type_name -> google.protobuf.Timestamp
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_setting_proto_init() }
@@ -847,19 +2503,30 @@ func file_store_instance_setting_proto_init() {
 	if File_store_instance_setting_proto != nil {
 		return
 	}
+	file_store_user_setting_proto_init()
 	file_store_instance_setting_proto_msgTypes[0].OneofWrappers = []any{
 		(*InstanceSetting_BasicSetting)(nil),
 		(*InstanceSetting_GeneralSetting)(nil),
 		(*InstanceSetting_StorageSetting)(nil),
 		(*InstanceSetting_MemoRelatedSetting)(nil),
+		(*InstanceSetting_LinkPreviewSetting)(nil),
+		(*InstanceSetting_EmailSetting)(nil),
+		(*InstanceSetting_WebhooksSetting)(nil),
+		(*InstanceSetting_BackupSetting)(nil),
+		(*InstanceSetting_SlackSetting)(nil),
+		(*InstanceSetting_MatrixSetting)(nil),
+		(*InstanceSetting_MatrixSyncState)(nil),
+		(*InstanceSetting_AnnouncementSetting)(nil),
+		(*InstanceSetting_RateLimitSetting)(nil),
+		(*InstanceSetting_WebPushSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_instance_setting_proto_rawDesc), len(file_store_instance_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      6,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
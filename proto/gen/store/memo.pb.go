@@ -22,10 +22,21 @@ const (
 )
 
 type MemoPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
-	Location      *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Property *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Location *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The tags lowercased and without diacritics, e.g. "Café" becomes "cafe", used by tag filters.
+	NormalizedTags []string `protobuf:"bytes,4,rep,name=normalized_tags,json=normalizedTags,proto3" json:"normalized_tags,omitempty"`
+	// Whether new comments on the memo are rejected. Existing comments are kept.
+	DisableComments bool `protobuf:"varint,5,opt,name=disable_comments,json=disableComments,proto3" json:"disable_comments,omitempty"`
+	// The hash of the imported file or account dump record the memo was created from, used to
+	// skip the memos already imported when an import is run again.
+	ImportHash string `protobuf:"bytes,6,opt,name=import_hash,json=importHash,proto3" json:"import_hash,omitempty"`
+	// The link the memo bookmarks, with the metadata fetched when it was created.
+	Bookmark *MemoPayload_Bookmark `protobuf:"bytes,7,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	// The [[wiki links]] of the content, resolved when the memo is saved.
+	WikiLinks     []*MemoPayload_WikiLink `protobuf:"bytes,8,rep,name=wiki_links,json=wikiLinks,proto3" json:"wiki_links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +92,207 @@ func (x *MemoPayload) GetTags() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetNormalizedTags() []string {
+	if x != nil {
+		return x.NormalizedTags
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetDisableComments() bool {
+	if x != nil {
+		return x.DisableComments
+	}
+	return false
+}
+
+func (x *MemoPayload) GetImportHash() string {
+	if x != nil {
+		return x.ImportHash
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetBookmark() *MemoPayload_Bookmark {
+	if x != nil {
+		return x.Bookmark
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetWikiLinks() []*MemoPayload_WikiLink {
+	if x != nil {
+		return x.WikiLinks
+	}
+	return nil
+}
+
+type MemoRevisionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The attachments of the memo at the revision, which may since have been deleted.
+	Attachments   []*MemoRevisionPayload_Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevisionPayload) Reset() {
+	*x = MemoRevisionPayload{}
+	mi := &file_store_memo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRevisionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevisionPayload) ProtoMessage() {}
+
+func (x *MemoRevisionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevisionPayload.ProtoReflect.Descriptor instead.
+func (*MemoRevisionPayload) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MemoRevisionPayload) GetAttachments() []*MemoRevisionPayload_Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type MemoRecurrencePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recurrence rule, a subset of RFC 5545 RRULE with FREQ, INTERVAL and BYDAY.
+	Rrule string `protobuf:"bytes,1,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// The local time of the occurrences, formatted as 15:04.
+	TimeOfDay string `protobuf:"bytes,2,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"`
+	// The IANA time zone of the occurrences.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// The memo created at each occurrence is either from a memo template of the creator or a copy
+	// of the content of a source memo.
+	//
+	// Types that are valid to be assigned to Source:
+	//
+	//	*MemoRecurrencePayload_MemoTemplateId
+	//	*MemoRecurrencePayload_MemoId
+	Source isMemoRecurrencePayload_Source `protobuf_oneof:"source"`
+	// The tags, without the # prefix, added to the created memos.
+	Tags          []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRecurrencePayload) Reset() {
+	*x = MemoRecurrencePayload{}
+	mi := &file_store_memo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRecurrencePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRecurrencePayload) ProtoMessage() {}
+
+func (x *MemoRecurrencePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRecurrencePayload.ProtoReflect.Descriptor instead.
+func (*MemoRecurrencePayload) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MemoRecurrencePayload) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *MemoRecurrencePayload) GetTimeOfDay() string {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return ""
+}
+
+func (x *MemoRecurrencePayload) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MemoRecurrencePayload) GetSource() isMemoRecurrencePayload_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *MemoRecurrencePayload) GetMemoTemplateId() string {
+	if x != nil {
+		if x, ok := x.Source.(*MemoRecurrencePayload_MemoTemplateId); ok {
+			return x.MemoTemplateId
+		}
+	}
+	return ""
+}
+
+func (x *MemoRecurrencePayload) GetMemoId() int32 {
+	if x != nil {
+		if x, ok := x.Source.(*MemoRecurrencePayload_MemoId); ok {
+			return x.MemoId
+		}
+	}
+	return 0
+}
+
+func (x *MemoRecurrencePayload) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type isMemoRecurrencePayload_Source interface {
+	isMemoRecurrencePayload_Source()
+}
+
+type MemoRecurrencePayload_MemoTemplateId struct {
+	MemoTemplateId string `protobuf:"bytes,4,opt,name=memo_template_id,json=memoTemplateId,proto3,oneof"`
+}
+
+type MemoRecurrencePayload_MemoId struct {
+	MemoId int32 `protobuf:"varint,5,opt,name=memo_id,json=memoId,proto3,oneof"`
+}
+
+func (*MemoRecurrencePayload_MemoTemplateId) isMemoRecurrencePayload_Source() {}
+
+func (*MemoRecurrencePayload_MemoId) isMemoRecurrencePayload_Source() {}
+
 // The calculated properties from the memo content.
 type MemoPayload_Property struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -88,13 +300,15 @@ type MemoPayload_Property struct {
 	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
 	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
 	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The number of words of the content, where each CJK character counts as a word.
+	WordCount     int32 `protobuf:"varint,5,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MemoPayload_Property) Reset() {
 	*x = MemoPayload_Property{}
-	mi := &file_store_memo_proto_msgTypes[1]
+	mi := &file_store_memo_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +320,7 @@ func (x *MemoPayload_Property) String() string {
 func (*MemoPayload_Property) ProtoMessage() {}
 
 func (x *MemoPayload_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_store_memo_proto_msgTypes[1]
+	mi := &file_store_memo_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,18 +364,27 @@ func (x *MemoPayload_Property) GetHasIncompleteTasks() bool {
 	return false
 }
 
+func (x *MemoPayload_Property) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
 type MemoPayload_Location struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Placeholder string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	Latitude    float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Whether the location is shown to other users than the creator.
+	ShowPublicly  bool `protobuf:"varint,4,opt,name=show_publicly,json=showPublicly,proto3" json:"show_publicly,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MemoPayload_Location) Reset() {
 	*x = MemoPayload_Location{}
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +396,7 @@ func (x *MemoPayload_Location) String() string {
 func (*MemoPayload_Location) ProtoMessage() {}
 
 func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,24 +433,248 @@ func (x *MemoPayload_Location) GetLongitude() float64 {
 	return 0
 }
 
+func (x *MemoPayload_Location) GetShowPublicly() bool {
+	if x != nil {
+		return x.ShowPublicly
+	}
+	return false
+}
+
+type MemoPayload_Bookmark struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Url         string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The URL of the image of the link, e.g. its Open Graph image.
+	Image         string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Bookmark) Reset() {
+	*x = MemoPayload_Bookmark{}
+	mi := &file_store_memo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Bookmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Bookmark) ProtoMessage() {}
+
+func (x *MemoPayload_Bookmark) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Bookmark.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Bookmark) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MemoPayload_Bookmark) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MemoPayload_Bookmark) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MemoPayload_Bookmark) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MemoPayload_Bookmark) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type MemoPayload_WikiLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target of the link, between the brackets and before the "|" of a label.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The ID of the memo the target resolved to, or 0 if it didn't resolve. A resolved link keeps
+	// linking to the memo when its slug or title changes.
+	MemoId        int32 `protobuf:"varint,2,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_WikiLink) Reset() {
+	*x = MemoPayload_WikiLink{}
+	mi := &file_store_memo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_WikiLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_WikiLink) ProtoMessage() {}
+
+func (x *MemoPayload_WikiLink) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_WikiLink.ProtoReflect.Descriptor instead.
+func (*MemoPayload_WikiLink) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *MemoPayload_WikiLink) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *MemoPayload_WikiLink) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type MemoRevisionPayload_Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevisionPayload_Attachment) Reset() {
+	*x = MemoRevisionPayload_Attachment{}
+	mi := &file_store_memo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRevisionPayload_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevisionPayload_Attachment) ProtoMessage() {}
+
+func (x *MemoRevisionPayload_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevisionPayload_Attachment.ProtoReflect.Descriptor instead.
+func (*MemoRevisionPayload_Attachment) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *MemoRevisionPayload_Attachment) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *MemoRevisionPayload_Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *MemoRevisionPayload_Attachment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
 var File_store_memo_proto protoreflect.FileDescriptor
 
 const file_store_memo_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xa0\x03\n" +
+	"\x10store/memo.proto\x12\vmemos.store\"\x84\a\n" +
 	"\vMemoPayload\x12=\n" +
 	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
 	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a\x96\x01\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12'\n" +
+	"\x0fnormalized_tags\x18\x04 \x03(\tR\x0enormalizedTags\x12)\n" +
+	"\x10disable_comments\x18\x05 \x01(\bR\x0fdisableComments\x12\x1f\n" +
+	"\vimport_hash\x18\x06 \x01(\tR\n" +
+	"importHash\x12=\n" +
+	"\bbookmark\x18\a \x01(\v2!.memos.store.MemoPayload.BookmarkR\bbookmark\x12@\n" +
+	"\n" +
+	"wiki_links\x18\b \x03(\v2!.memos.store.MemoPayload.WikiLinkR\twikiLinks\x1a\xb5\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1af\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x05 \x01(\x05R\twordCount\x1a\x8b\x01\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x03 \x01(\x01R\tlongitudeB\x94\x01\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12#\n" +
+	"\rshow_publicly\x18\x04 \x01(\bR\fshowPublicly\x1aj\n" +
+	"\bBookmark\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x1a;\n" +
+	"\bWikiLink\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x17\n" +
+	"\amemo_id\x18\x02 \x01(\x05R\x06memoId\"\xb4\x01\n" +
+	"\x13MemoRevisionPayload\x12M\n" +
+	"\vattachments\x18\x01 \x03(\v2+.memos.store.MemoRevisionPayload.AttachmentR\vattachments\x1aN\n" +
+	"\n" +
+	"Attachment\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\xce\x01\n" +
+	"\x15MemoRecurrencePayload\x12\x14\n" +
+	"\x05rrule\x18\x01 \x01(\tR\x05rrule\x12\x1e\n" +
+	"\vtime_of_day\x18\x02 \x01(\tR\ttimeOfDay\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12*\n" +
+	"\x10memo_template_id\x18\x04 \x01(\tH\x00R\x0ememoTemplateId\x12\x19\n" +
+	"\amemo_id\x18\x05 \x01(\x05H\x00R\x06memoId\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tagsB\b\n" +
+	"\x06sourceB\x94\x01\n" +
 	"\x0fcom.memos.storeB\tMemoProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -242,20 +689,28 @@ func file_store_memo_proto_rawDescGZIP() []byte {
 	return file_store_memo_proto_rawDescData
 }
 
-var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_memo_proto_goTypes = []any{
-	(*MemoPayload)(nil),          // 0: memos.store.MemoPayload
-	(*MemoPayload_Property)(nil), // 1: memos.store.MemoPayload.Property
-	(*MemoPayload_Location)(nil), // 2: memos.store.MemoPayload.Location
+	(*MemoPayload)(nil),                    // 0: memos.store.MemoPayload
+	(*MemoRevisionPayload)(nil),            // 1: memos.store.MemoRevisionPayload
+	(*MemoRecurrencePayload)(nil),          // 2: memos.store.MemoRecurrencePayload
+	(*MemoPayload_Property)(nil),           // 3: memos.store.MemoPayload.Property
+	(*MemoPayload_Location)(nil),           // 4: memos.store.MemoPayload.Location
+	(*MemoPayload_Bookmark)(nil),           // 5: memos.store.MemoPayload.Bookmark
+	(*MemoPayload_WikiLink)(nil),           // 6: memos.store.MemoPayload.WikiLink
+	(*MemoRevisionPayload_Attachment)(nil), // 7: memos.store.MemoRevisionPayload.Attachment
 }
 var file_store_memo_proto_depIdxs = []int32{
-	1, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
-	2, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
+	4, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
+	5, // 2: memos.store.MemoPayload.bookmark:type_name -> memos.store.MemoPayload.Bookmark
+	6, // 3: memos.store.MemoPayload.wiki_links:type_name -> memos.store.MemoPayload.WikiLink
+	7, // 4: memos.store.MemoRevisionPayload.attachments:type_name -> memos.store.MemoRevisionPayload.Attachment
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_store_memo_proto_init() }
@@ -263,13 +718,17 @@ func file_store_memo_proto_init() {
 	if File_store_memo_proto != nil {
 		return
 	}
+	file_store_memo_proto_msgTypes[2].OneofWrappers = []any{
+		(*MemoRecurrencePayload_MemoTemplateId)(nil),
+		(*MemoRecurrencePayload_MemoId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_memo_proto_rawDesc), len(file_store_memo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
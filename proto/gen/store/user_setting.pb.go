@@ -36,25 +36,77 @@ const (
 	UserSetting_SHORTCUTS UserSetting_Key = 4
 	// The webhooks of the user.
 	UserSetting_WEBHOOKS UserSetting_Key = 5
+	// TOTP two-factor authentication of the user.
+	UserSetting_TWO_FACTOR UserSetting_Key = 6
+	// WebAuthn passkeys of the user.
+	UserSetting_PASSKEYS UserSetting_Key = 7
+	// The memo templates of the user.
+	UserSetting_MEMO_TEMPLATES UserSetting_Key = 8
+	// The auto-archive policy of the user.
+	UserSetting_AUTO_ARCHIVE UserSetting_Key = 9
+	// The storage quota of the user, set by the host.
+	UserSetting_STORAGE_QUOTA UserSetting_Key = 10
+	// The feed token of the user.
+	UserSetting_FEED_TOKEN UserSetting_Key = 11
+	// The inbound email address of the user.
+	UserSetting_INBOUND_EMAIL UserSetting_Key = 12
+	// The Slack account linked to the user.
+	UserSetting_SLACK UserSetting_Key = 13
+	// The Matrix account linked to the user.
+	UserSetting_MATRIX UserSetting_Key = 14
+	// The memo quotas of the user, set by the host.
+	UserSetting_MEMO_QUOTA UserSetting_Key = 15
+	// The announcement dismissed by the user.
+	UserSetting_ANNOUNCEMENT_DISMISSAL UserSetting_Key = 16
+	// The notification preferences of the user.
+	UserSetting_NOTIFICATION UserSetting_Key = 17
+	// The Web Push subscriptions of the devices of the user.
+	UserSetting_PUSH_SUBSCRIPTIONS UserSetting_Key = 18
 )
 
 // Enum value maps for UserSetting_Key.
 var (
 	UserSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		2: "SESSIONS",
-		3: "ACCESS_TOKENS",
-		4: "SHORTCUTS",
-		5: "WEBHOOKS",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		2:  "SESSIONS",
+		3:  "ACCESS_TOKENS",
+		4:  "SHORTCUTS",
+		5:  "WEBHOOKS",
+		6:  "TWO_FACTOR",
+		7:  "PASSKEYS",
+		8:  "MEMO_TEMPLATES",
+		9:  "AUTO_ARCHIVE",
+		10: "STORAGE_QUOTA",
+		11: "FEED_TOKEN",
+		12: "INBOUND_EMAIL",
+		13: "SLACK",
+		14: "MATRIX",
+		15: "MEMO_QUOTA",
+		16: "ANNOUNCEMENT_DISMISSAL",
+		17: "NOTIFICATION",
+		18: "PUSH_SUBSCRIPTIONS",
 	}
 	UserSetting_Key_value = map[string]int32{
-		"KEY_UNSPECIFIED": 0,
-		"GENERAL":         1,
-		"SESSIONS":        2,
-		"ACCESS_TOKENS":   3,
-		"SHORTCUTS":       4,
-		"WEBHOOKS":        5,
+		"KEY_UNSPECIFIED":        0,
+		"GENERAL":                1,
+		"SESSIONS":               2,
+		"ACCESS_TOKENS":          3,
+		"SHORTCUTS":              4,
+		"WEBHOOKS":               5,
+		"TWO_FACTOR":             6,
+		"PASSKEYS":               7,
+		"MEMO_TEMPLATES":         8,
+		"AUTO_ARCHIVE":           9,
+		"STORAGE_QUOTA":          10,
+		"FEED_TOKEN":             11,
+		"INBOUND_EMAIL":          12,
+		"SLACK":                  13,
+		"MATRIX":                 14,
+		"MEMO_QUOTA":             15,
+		"ANNOUNCEMENT_DISMISSAL": 16,
+		"NOTIFICATION":           17,
+		"PUSH_SUBSCRIPTIONS":     18,
 	}
 )
 
@@ -85,6 +137,111 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type NotificationUserSetting_DigestFrequency int32
+
+const (
+	NotificationUserSetting_DIGEST_FREQUENCY_UNSPECIFIED NotificationUserSetting_DigestFrequency = 0
+	NotificationUserSetting_DAILY                        NotificationUserSetting_DigestFrequency = 1
+	NotificationUserSetting_WEEKLY                       NotificationUserSetting_DigestFrequency = 2
+)
+
+// Enum value maps for NotificationUserSetting_DigestFrequency.
+var (
+	NotificationUserSetting_DigestFrequency_name = map[int32]string{
+		0: "DIGEST_FREQUENCY_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	NotificationUserSetting_DigestFrequency_value = map[string]int32{
+		"DIGEST_FREQUENCY_UNSPECIFIED": 0,
+		"DAILY":                        1,
+		"WEEKLY":                       2,
+	}
+)
+
+func (x NotificationUserSetting_DigestFrequency) Enum() *NotificationUserSetting_DigestFrequency {
+	p := new(NotificationUserSetting_DigestFrequency)
+	*p = x
+	return p
+}
+
+func (x NotificationUserSetting_DigestFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationUserSetting_DigestFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationUserSetting_DigestFrequency) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x NotificationUserSetting_DigestFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationUserSetting_DigestFrequency.Descriptor instead.
+func (NotificationUserSetting_DigestFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type WebhooksUserSetting_Webhook_Format int32
+
+const (
+	// Unspecified formats are raw, for webhooks created before formats.
+	WebhooksUserSetting_Webhook_FORMAT_UNSPECIFIED WebhooksUserSetting_Webhook_Format = 0
+	// The memos event payload.
+	WebhooksUserSetting_Webhook_RAW WebhooksUserSetting_Webhook_Format = 1
+	// A Slack incoming webhook message.
+	WebhooksUserSetting_Webhook_SLACK WebhooksUserSetting_Webhook_Format = 2
+	// A Discord webhook message.
+	WebhooksUserSetting_Webhook_DISCORD WebhooksUserSetting_Webhook_Format = 3
+)
+
+// Enum value maps for WebhooksUserSetting_Webhook_Format.
+var (
+	WebhooksUserSetting_Webhook_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "RAW",
+		2: "SLACK",
+		3: "DISCORD",
+	}
+	WebhooksUserSetting_Webhook_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"RAW":                1,
+		"SLACK":              2,
+		"DISCORD":            3,
+	}
+)
+
+func (x WebhooksUserSetting_Webhook_Format) Enum() *WebhooksUserSetting_Webhook_Format {
+	p := new(WebhooksUserSetting_Webhook_Format)
+	*p = x
+	return p
+}
+
+func (x WebhooksUserSetting_Webhook_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhooksUserSetting_Webhook_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (WebhooksUserSetting_Webhook_Format) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[2]
+}
+
+func (x WebhooksUserSetting_Webhook_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhooksUserSetting_Webhook_Format.Descriptor instead.
+func (WebhooksUserSetting_Webhook_Format) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{16, 0, 0}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -96,6 +253,19 @@ type UserSetting struct {
 	//	*UserSetting_AccessTokens
 	//	*UserSetting_Shortcuts
 	//	*UserSetting_Webhooks
+	//	*UserSetting_TwoFactor
+	//	*UserSetting_Passkeys
+	//	*UserSetting_MemoTemplates
+	//	*UserSetting_AutoArchive
+	//	*UserSetting_StorageQuota
+	//	*UserSetting_FeedToken
+	//	*UserSetting_InboundEmail
+	//	*UserSetting_Slack
+	//	*UserSetting_Matrix
+	//	*UserSetting_MemoQuota
+	//	*UserSetting_AnnouncementDismissal
+	//	*UserSetting_Notification
+	//	*UserSetting_PushSubscriptions
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +367,123 @@ func (x *UserSetting) GetWebhooks() *WebhooksUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetTwoFactor() *TwoFactorUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_TwoFactor); ok {
+			return x.TwoFactor
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetPasskeys() *PasskeysUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Passkeys); ok {
+			return x.Passkeys
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMemoTemplates() *MemoTemplatesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MemoTemplates); ok {
+			return x.MemoTemplates
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAutoArchive() *AutoArchiveUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AutoArchive); ok {
+			return x.AutoArchive
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetStorageQuota() *StorageQuotaUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_StorageQuota); ok {
+			return x.StorageQuota
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetFeedToken() *FeedTokenUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_FeedToken); ok {
+			return x.FeedToken
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetInboundEmail() *InboundEmailUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_InboundEmail); ok {
+			return x.InboundEmail
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetSlack() *SlackUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Slack); ok {
+			return x.Slack
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMatrix() *MatrixUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Matrix); ok {
+			return x.Matrix
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMemoQuota() *MemoQuotaUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MemoQuota); ok {
+			return x.MemoQuota
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAnnouncementDismissal() *AnnouncementDismissalUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AnnouncementDismissal); ok {
+			return x.AnnouncementDismissal
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNotification() *NotificationUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Notification); ok {
+			return x.Notification
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetPushSubscriptions() *PushSubscriptionsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_PushSubscriptions); ok {
+			return x.PushSubscriptions
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -221,6 +508,58 @@ type UserSetting_Webhooks struct {
 	Webhooks *WebhooksUserSetting `protobuf:"bytes,7,opt,name=webhooks,proto3,oneof"`
 }
 
+type UserSetting_TwoFactor struct {
+	TwoFactor *TwoFactorUserSetting `protobuf:"bytes,8,opt,name=two_factor,json=twoFactor,proto3,oneof"`
+}
+
+type UserSetting_Passkeys struct {
+	Passkeys *PasskeysUserSetting `protobuf:"bytes,9,opt,name=passkeys,proto3,oneof"`
+}
+
+type UserSetting_MemoTemplates struct {
+	MemoTemplates *MemoTemplatesUserSetting `protobuf:"bytes,10,opt,name=memo_templates,json=memoTemplates,proto3,oneof"`
+}
+
+type UserSetting_AutoArchive struct {
+	AutoArchive *AutoArchiveUserSetting `protobuf:"bytes,11,opt,name=auto_archive,json=autoArchive,proto3,oneof"`
+}
+
+type UserSetting_StorageQuota struct {
+	StorageQuota *StorageQuotaUserSetting `protobuf:"bytes,12,opt,name=storage_quota,json=storageQuota,proto3,oneof"`
+}
+
+type UserSetting_FeedToken struct {
+	FeedToken *FeedTokenUserSetting `protobuf:"bytes,13,opt,name=feed_token,json=feedToken,proto3,oneof"`
+}
+
+type UserSetting_InboundEmail struct {
+	InboundEmail *InboundEmailUserSetting `protobuf:"bytes,14,opt,name=inbound_email,json=inboundEmail,proto3,oneof"`
+}
+
+type UserSetting_Slack struct {
+	Slack *SlackUserSetting `protobuf:"bytes,15,opt,name=slack,proto3,oneof"`
+}
+
+type UserSetting_Matrix struct {
+	Matrix *MatrixUserSetting `protobuf:"bytes,16,opt,name=matrix,proto3,oneof"`
+}
+
+type UserSetting_MemoQuota struct {
+	MemoQuota *MemoQuotaUserSetting `protobuf:"bytes,17,opt,name=memo_quota,json=memoQuota,proto3,oneof"`
+}
+
+type UserSetting_AnnouncementDismissal struct {
+	AnnouncementDismissal *AnnouncementDismissalUserSetting `protobuf:"bytes,18,opt,name=announcement_dismissal,json=announcementDismissal,proto3,oneof"`
+}
+
+type UserSetting_Notification struct {
+	Notification *NotificationUserSetting `protobuf:"bytes,19,opt,name=notification,proto3,oneof"`
+}
+
+type UserSetting_PushSubscriptions struct {
+	PushSubscriptions *PushSubscriptionsUserSetting `protobuf:"bytes,20,opt,name=push_subscriptions,json=pushSubscriptions,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Sessions) isUserSetting_Value() {}
@@ -231,6 +570,32 @@ func (*UserSetting_Shortcuts) isUserSetting_Value() {}
 
 func (*UserSetting_Webhooks) isUserSetting_Value() {}
 
+func (*UserSetting_TwoFactor) isUserSetting_Value() {}
+
+func (*UserSetting_Passkeys) isUserSetting_Value() {}
+
+func (*UserSetting_MemoTemplates) isUserSetting_Value() {}
+
+func (*UserSetting_AutoArchive) isUserSetting_Value() {}
+
+func (*UserSetting_StorageQuota) isUserSetting_Value() {}
+
+func (*UserSetting_FeedToken) isUserSetting_Value() {}
+
+func (*UserSetting_InboundEmail) isUserSetting_Value() {}
+
+func (*UserSetting_Slack) isUserSetting_Value() {}
+
+func (*UserSetting_Matrix) isUserSetting_Value() {}
+
+func (*UserSetting_MemoQuota) isUserSetting_Value() {}
+
+func (*UserSetting_AnnouncementDismissal) isUserSetting_Value() {}
+
+func (*UserSetting_Notification) isUserSetting_Value() {}
+
+func (*UserSetting_PushSubscriptions) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -239,9 +604,15 @@ type GeneralUserSetting struct {
 	MemoVisibility string `protobuf:"bytes,2,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
 	// The user's theme preference.
 	// This references a CSS file in the web/public/themes/ directory.
-	Theme         string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Theme string `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The user's IANA time zone, e.g. "Europe/Paris", used to expand the placeholders of memo
+	// templates. Empty means UTC.
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Whether the metadata of the images uploaded by the user is stripped, overriding the
+	// instance storage setting when set.
+	StripImageMetadata *bool `protobuf:"varint,5,opt,name=strip_image_metadata,json=stripImageMetadata,proto3,oneof" json:"strip_image_metadata,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GeneralUserSetting) Reset() {
@@ -295,6 +666,20 @@ func (x *GeneralUserSetting) GetTheme() string {
 	return ""
 }
 
+func (x *GeneralUserSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GeneralUserSetting) GetStripImageMetadata() bool {
+	if x != nil && x.StripImageMetadata != nil {
+		return *x.StripImageMetadata
+	}
+	return false
+}
+
 type SessionsUserSetting struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Sessions      []*SessionsUserSetting_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
@@ -427,27 +812,28 @@ func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
 	return nil
 }
 
-type WebhooksUserSetting struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+type MemoTemplatesUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The templates in the order of the user.
+	Templates     []*MemoTemplatesUserSetting_MemoTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebhooksUserSetting) Reset() {
-	*x = WebhooksUserSetting{}
+func (x *MemoTemplatesUserSetting) Reset() {
+	*x = MemoTemplatesUserSetting{}
 	mi := &file_store_user_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhooksUserSetting) String() string {
+func (x *MemoTemplatesUserSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhooksUserSetting) ProtoMessage() {}
+func (*MemoTemplatesUserSetting) ProtoMessage() {}
 
-func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
+func (x *MemoTemplatesUserSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_user_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,47 +845,42 @@ func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhooksUserSetting.ProtoReflect.Descriptor instead.
-func (*WebhooksUserSetting) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemoTemplatesUserSetting.ProtoReflect.Descriptor instead.
+func (*MemoTemplatesUserSetting) Descriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+func (x *MemoTemplatesUserSetting) GetTemplates() []*MemoTemplatesUserSetting_MemoTemplate {
 	if x != nil {
-		return x.Webhooks
+		return x.Templates
 	}
 	return nil
 }
 
-type SessionsUserSetting_Session struct {
+type AutoArchiveUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique session identifier.
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// Timestamp when the session was created.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Timestamp when the session was last accessed.
-	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
-	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
-	// Client information associated with this session.
-	ClientInfo    *SessionsUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	// The memos not edited in this many days are archived daily, 0 disables the policy.
+	Days int32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	// The memos with these tags, or their subtags, are never archived.
+	ProtectedTags []string `protobuf:"bytes,2,rep,name=protected_tags,json=protectedTags,proto3" json:"protected_tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_Session) Reset() {
-	*x = SessionsUserSetting_Session{}
+func (x *AutoArchiveUserSetting) Reset() {
+	*x = AutoArchiveUserSetting{}
 	mi := &file_store_user_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionsUserSetting_Session) String() string {
+func (x *AutoArchiveUserSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionsUserSetting_Session) ProtoMessage() {}
+func (*AutoArchiveUserSetting) ProtoMessage() {}
 
-func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
+func (x *AutoArchiveUserSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_user_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,69 +892,48 @@ func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionsUserSetting_Session.ProtoReflect.Descriptor instead.
-func (*SessionsUserSetting_Session) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *SessionsUserSetting_Session) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *SessionsUserSetting_Session) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
+// Deprecated: Use AutoArchiveUserSetting.ProtoReflect.Descriptor instead.
+func (*AutoArchiveUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SessionsUserSetting_Session) GetLastAccessedTime() *timestamppb.Timestamp {
+func (x *AutoArchiveUserSetting) GetDays() int32 {
 	if x != nil {
-		return x.LastAccessedTime
+		return x.Days
 	}
-	return nil
+	return 0
 }
 
-func (x *SessionsUserSetting_Session) GetClientInfo() *SessionsUserSetting_ClientInfo {
+func (x *AutoArchiveUserSetting) GetProtectedTags() []string {
 	if x != nil {
-		return x.ClientInfo
+		return x.ProtectedTags
 	}
 	return nil
 }
 
-type SessionsUserSetting_ClientInfo struct {
+type StorageQuotaUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User agent string of the client.
-	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// IP address of the client.
-	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
-	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
-	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
-	// Optional. Browser name and version (e.g., "Chrome 119.0").
-	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	// The storage quota of the user in MiB, 0 means unlimited. If not set, the default quota of
+	// the instance storage setting is used.
+	QuotaMb       *int64 `protobuf:"varint,1,opt,name=quota_mb,json=quotaMb,proto3,oneof" json:"quota_mb,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_ClientInfo) Reset() {
-	*x = SessionsUserSetting_ClientInfo{}
+func (x *StorageQuotaUserSetting) Reset() {
+	*x = StorageQuotaUserSetting{}
 	mi := &file_store_user_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionsUserSetting_ClientInfo) String() string {
+func (x *StorageQuotaUserSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
+func (*StorageQuotaUserSetting) ProtoMessage() {}
 
-func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
+func (x *StorageQuotaUserSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_user_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,22 +945,893 @@ func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionsUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
-func (*SessionsUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use StorageQuotaUserSetting.ProtoReflect.Descriptor instead.
+func (*StorageQuotaUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetUserAgent() string {
-	if x != nil {
-		return x.UserAgent
+func (x *StorageQuotaUserSetting) GetQuotaMb() int64 {
+	if x != nil && x.QuotaMb != nil {
+		return *x.QuotaMb
 	}
-	return ""
+	return 0
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
+type MemoQuotaUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos the user may have, 0 means unlimited. If not set, the limit of the
+	// instance memo related setting is used.
+	MaxMemos *int32 `protobuf:"varint,1,opt,name=max_memos,json=maxMemos,proto3,oneof" json:"max_memos,omitempty"`
+	// The number of memos the user may create per day, 0 means unlimited. If not set, the limit
+	// of the instance memo related setting is used.
+	MaxMemosPerDay *int32 `protobuf:"varint,2,opt,name=max_memos_per_day,json=maxMemosPerDay,proto3,oneof" json:"max_memos_per_day,omitempty"`
+	// The number of public memos the user may create per day, 0 means unlimited. If set, it
+	// applies whatever the age of the account, else the limit of the instance memo related
+	// setting applies to new accounts.
+	MaxPublicMemosPerDay *int32 `protobuf:"varint,3,opt,name=max_public_memos_per_day,json=maxPublicMemosPerDay,proto3,oneof" json:"max_public_memos_per_day,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MemoQuotaUserSetting) Reset() {
+	*x = MemoQuotaUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoQuotaUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoQuotaUserSetting) ProtoMessage() {}
+
+func (x *MemoQuotaUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoQuotaUserSetting.ProtoReflect.Descriptor instead.
+func (*MemoQuotaUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MemoQuotaUserSetting) GetMaxMemos() int32 {
+	if x != nil && x.MaxMemos != nil {
+		return *x.MaxMemos
+	}
+	return 0
+}
+
+func (x *MemoQuotaUserSetting) GetMaxMemosPerDay() int32 {
+	if x != nil && x.MaxMemosPerDay != nil {
+		return *x.MaxMemosPerDay
+	}
+	return 0
+}
+
+func (x *MemoQuotaUserSetting) GetMaxPublicMemosPerDay() int32 {
+	if x != nil && x.MaxPublicMemosPerDay != nil {
+		return *x.MaxPublicMemosPerDay
+	}
+	return 0
+}
+
+type AnnouncementDismissalUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the last announcement dismissed by the user, which changes with its content.
+	AnnouncementId string `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnnouncementDismissalUserSetting) Reset() {
+	*x = AnnouncementDismissalUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementDismissalUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementDismissalUserSetting) ProtoMessage() {}
+
+func (x *AnnouncementDismissalUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementDismissalUserSetting.ProtoReflect.Descriptor instead.
+func (*AnnouncementDismissalUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AnnouncementDismissalUserSetting) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+type NotificationUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the comments on the memos of the user are also sent by email.
+	EmailMemoComment bool `protobuf:"varint,1,opt,name=email_memo_comment,json=emailMemoComment,proto3" json:"email_memo_comment,omitempty"`
+	// Whether the reactions to the memos of the user are also sent by email.
+	EmailMemoReaction bool `protobuf:"varint,2,opt,name=email_memo_reaction,json=emailMemoReaction,proto3" json:"email_memo_reaction,omitempty"`
+	// Whether the memos mentioning the user are also sent by email.
+	EmailMemoMention bool `protobuf:"varint,3,opt,name=email_memo_mention,json=emailMemoMention,proto3" json:"email_memo_mention,omitempty"`
+	// Whether the due reminders of the memos of the user are also sent by email.
+	EmailMemoReminder bool `protobuf:"varint,4,opt,name=email_memo_reminder,json=emailMemoReminder,proto3" json:"email_memo_reminder,omitempty"`
+	// The ID of the last inbox message considered for the notification emails. Only the newer
+	// messages are sent.
+	EmailCursorInboxId int32 `protobuf:"varint,5,opt,name=email_cursor_inbox_id,json=emailCursorInboxId,proto3" json:"email_cursor_inbox_id,omitempty"`
+	// The time of day the quiet hours of the push notifications start and end, as "HH:MM" in the
+	// time zone of the user. The quiet hours are off unless both are set.
+	QuietHoursStart string `protobuf:"bytes,6,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string `protobuf:"bytes,7,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`
+	// How often a digest summarizing the activity of the user is delivered to the inbox.
+	DigestFrequency NotificationUserSetting_DigestFrequency `protobuf:"varint,8,opt,name=digest_frequency,json=digestFrequency,proto3,enum=memos.store.NotificationUserSetting_DigestFrequency" json:"digest_frequency,omitempty"`
+	// The time of day the digest is delivered, as "HH:MM" in the time zone of the user.
+	DigestTime string `protobuf:"bytes,9,opt,name=digest_time,json=digestTime,proto3" json:"digest_time,omitempty"`
+	// The day of the week a weekly digest is delivered, from 0 for Sunday to 6 for Saturday.
+	DigestWeekday int32 `protobuf:"varint,10,opt,name=digest_weekday,json=digestWeekday,proto3" json:"digest_weekday,omitempty"`
+	// Whether the digest is also sent by email.
+	EmailDigest bool `protobuf:"varint,11,opt,name=email_digest,json=emailDigest,proto3" json:"email_digest,omitempty"`
+	// The scheduled time of the last digest, so that a digest is never delivered twice.
+	LastDigestTs  int64 `protobuf:"varint,12,opt,name=last_digest_ts,json=lastDigestTs,proto3" json:"last_digest_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationUserSetting) Reset() {
+	*x = NotificationUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationUserSetting) ProtoMessage() {}
+
+func (x *NotificationUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NotificationUserSetting) GetEmailMemoComment() bool {
+	if x != nil {
+		return x.EmailMemoComment
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetEmailMemoReaction() bool {
+	if x != nil {
+		return x.EmailMemoReaction
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetEmailMemoMention() bool {
+	if x != nil {
+		return x.EmailMemoMention
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetEmailMemoReminder() bool {
+	if x != nil {
+		return x.EmailMemoReminder
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetEmailCursorInboxId() int32 {
+	if x != nil {
+		return x.EmailCursorInboxId
+	}
+	return 0
+}
+
+func (x *NotificationUserSetting) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *NotificationUserSetting) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *NotificationUserSetting) GetDigestFrequency() NotificationUserSetting_DigestFrequency {
+	if x != nil {
+		return x.DigestFrequency
+	}
+	return NotificationUserSetting_DIGEST_FREQUENCY_UNSPECIFIED
+}
+
+func (x *NotificationUserSetting) GetDigestTime() string {
+	if x != nil {
+		return x.DigestTime
+	}
+	return ""
+}
+
+func (x *NotificationUserSetting) GetDigestWeekday() int32 {
+	if x != nil {
+		return x.DigestWeekday
+	}
+	return 0
+}
+
+func (x *NotificationUserSetting) GetEmailDigest() bool {
+	if x != nil {
+		return x.EmailDigest
+	}
+	return false
+}
+
+func (x *NotificationUserSetting) GetLastDigestTs() int64 {
+	if x != nil {
+		return x.LastDigestTs
+	}
+	return 0
+}
+
+type PushSubscriptionsUserSetting struct {
+	state         protoimpl.MessageState                           `protogen:"open.v1"`
+	Subscriptions []*PushSubscriptionsUserSetting_PushSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushSubscriptionsUserSetting) Reset() {
+	*x = PushSubscriptionsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushSubscriptionsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSubscriptionsUserSetting) ProtoMessage() {}
+
+func (x *PushSubscriptionsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSubscriptionsUserSetting.ProtoReflect.Descriptor instead.
+func (*PushSubscriptionsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PushSubscriptionsUserSetting) GetSubscriptions() []*PushSubscriptionsUserSetting_PushSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type FeedTokenUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SHA-256 hash of the feed token, empty if the user has no feed token.
+	TokenHash string `protobuf:"bytes,1,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	// Timestamp when the feed token was generated.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedTokenUserSetting) Reset() {
+	*x = FeedTokenUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedTokenUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedTokenUserSetting) ProtoMessage() {}
+
+func (x *FeedTokenUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedTokenUserSetting.ProtoReflect.Descriptor instead.
+func (*FeedTokenUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FeedTokenUserSetting) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *FeedTokenUserSetting) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type InboundEmailUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the inbound email address of the user, its local part. Empty if the user has no
+	// inbound email address. It's stored as is, unlike feed tokens, so that the address can be shown
+	// again: it only allows creating memos.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Timestamp when the token was generated.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboundEmailUserSetting) Reset() {
+	*x = InboundEmailUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboundEmailUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboundEmailUserSetting) ProtoMessage() {}
+
+func (x *InboundEmailUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboundEmailUserSetting.ProtoReflect.Descriptor instead.
+func (*InboundEmailUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InboundEmailUserSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InboundEmailUserSetting) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type SlackUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the Slack workspace of the linked account, empty if the user has no linked account.
+	TeamId string `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// The ID of the linked Slack user.
+	SlackUserId string `protobuf:"bytes,2,opt,name=slack_user_id,json=slackUserId,proto3" json:"slack_user_id,omitempty"`
+	// Timestamp when the Slack account was linked.
+	LinkTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	// SHA-256 hash of the pending one-time link code, empty if there's none.
+	LinkCodeHash string `protobuf:"bytes,4,opt,name=link_code_hash,json=linkCodeHash,proto3" json:"link_code_hash,omitempty"`
+	// Timestamp when the pending link code expires.
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SlackUserSetting) Reset() {
+	*x = SlackUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlackUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackUserSetting) ProtoMessage() {}
+
+func (x *SlackUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackUserSetting.ProtoReflect.Descriptor instead.
+func (*SlackUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SlackUserSetting) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *SlackUserSetting) GetSlackUserId() string {
+	if x != nil {
+		return x.SlackUserId
+	}
+	return ""
+}
+
+func (x *SlackUserSetting) GetLinkTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkTime
+	}
+	return nil
+}
+
+func (x *SlackUserSetting) GetLinkCodeHash() string {
+	if x != nil {
+		return x.LinkCodeHash
+	}
+	return ""
+}
+
+func (x *SlackUserSetting) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type MatrixUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the linked Matrix user, e.g. "@alice:example.com", empty if the user has no linked
+	// account.
+	MatrixUserId string `protobuf:"bytes,1,opt,name=matrix_user_id,json=matrixUserId,proto3" json:"matrix_user_id,omitempty"`
+	// Timestamp when the Matrix account was linked.
+	LinkTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	// SHA-256 hash of the pending one-time link code, empty if there's none.
+	LinkCodeHash string `protobuf:"bytes,3,opt,name=link_code_hash,json=linkCodeHash,proto3" json:"link_code_hash,omitempty"`
+	// Timestamp when the pending link code expires.
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	// The IDs of the rooms the user subscribed to with "!memos subscribe", where their new public
+	// memos are posted.
+	SubscribedRoomIds []string `protobuf:"bytes,5,rep,name=subscribed_room_ids,json=subscribedRoomIds,proto3" json:"subscribed_room_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MatrixUserSetting) Reset() {
+	*x = MatrixUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixUserSetting) ProtoMessage() {}
+
+func (x *MatrixUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixUserSetting.ProtoReflect.Descriptor instead.
+func (*MatrixUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MatrixUserSetting) GetMatrixUserId() string {
+	if x != nil {
+		return x.MatrixUserId
+	}
+	return ""
+}
+
+func (x *MatrixUserSetting) GetLinkTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkTime
+	}
+	return nil
+}
+
+func (x *MatrixUserSetting) GetLinkCodeHash() string {
+	if x != nil {
+		return x.LinkCodeHash
+	}
+	return ""
+}
+
+func (x *MatrixUserSetting) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+func (x *MatrixUserSetting) GetSubscribedRoomIds() []string {
+	if x != nil {
+		return x.SubscribedRoomIds
+	}
+	return nil
+}
+
+type WebhooksUserSetting struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksUserSetting) Reset() {
+	*x = WebhooksUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksUserSetting) ProtoMessage() {}
+
+func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksUserSetting.ProtoReflect.Descriptor instead.
+func (*WebhooksUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type TwoFactorUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32-encoded TOTP secret.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether two-factor authentication is enabled.
+	// False while an enrollment awaits confirmation.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The time step counter of the last accepted code, used to reject replays.
+	LastCounter int64 `protobuf:"varint,3,opt,name=last_counter,json=lastCounter,proto3" json:"last_counter,omitempty"`
+	// SHA-256 hashes of the unused recovery codes.
+	RecoveryCodeHashes []string `protobuf:"bytes,4,rep,name=recovery_code_hashes,json=recoveryCodeHashes,proto3" json:"recovery_code_hashes,omitempty"`
+	// Timestamp when two-factor authentication was enabled.
+	EnableTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=enable_time,json=enableTime,proto3" json:"enable_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactorUserSetting) Reset() {
+	*x = TwoFactorUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorUserSetting) ProtoMessage() {}
+
+func (x *TwoFactorUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorUserSetting.ProtoReflect.Descriptor instead.
+func (*TwoFactorUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TwoFactorUserSetting) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TwoFactorUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TwoFactorUserSetting) GetLastCounter() int64 {
+	if x != nil {
+		return x.LastCounter
+	}
+	return 0
+}
+
+func (x *TwoFactorUserSetting) GetRecoveryCodeHashes() []string {
+	if x != nil {
+		return x.RecoveryCodeHashes
+	}
+	return nil
+}
+
+func (x *TwoFactorUserSetting) GetEnableTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnableTime
+	}
+	return nil
+}
+
+type PasskeysUserSetting struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Passkeys      []*PasskeysUserSetting_Passkey `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeysUserSetting) Reset() {
+	*x = PasskeysUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeysUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeysUserSetting) ProtoMessage() {}
+
+func (x *PasskeysUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeysUserSetting.ProtoReflect.Descriptor instead.
+func (*PasskeysUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PasskeysUserSetting) GetPasskeys() []*PasskeysUserSetting_Passkey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+type SessionsUserSetting_Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique session identifier.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Timestamp when the session was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Timestamp when the session was last accessed.
+	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
+	// Client information associated with this session.
+	ClientInfo    *SessionsUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsUserSetting_Session) Reset() {
+	*x = SessionsUserSetting_Session{}
+	mi := &file_store_user_setting_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsUserSetting_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsUserSetting_Session) ProtoMessage() {}
+
+func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsUserSetting_Session.ProtoReflect.Descriptor instead.
+func (*SessionsUserSetting_Session) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SessionsUserSetting_Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_Session) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetClientInfo() *SessionsUserSetting_ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+type SessionsUserSetting_ClientInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User agent string of the client.
+	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
+	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
+	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// Optional. Browser name and version (e.g., "Chrome 119.0").
+	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsUserSetting_ClientInfo) Reset() {
+	*x = SessionsUserSetting_ClientInfo{}
+	mi := &file_store_user_setting_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsUserSetting_ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
+
+func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
+func (*SessionsUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
 	return ""
 }
 
@@ -631,14 +1862,20 @@ type AccessTokensUserSetting_AccessToken struct {
 	// Including expiration time, issuer, etc.
 	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	// A description for the access token.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The scopes granted to the access token, e.g. "read".
+	// Empty means full access.
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Timestamp when the access token was last used.
+	// Updated at most once per hour.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AccessTokensUserSetting_AccessToken) Reset() {
 	*x = AccessTokensUserSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +1887,7 @@ func (x *AccessTokensUserSetting_AccessToken) String() string {
 func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
 
 func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+	mi := &file_store_user_setting_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,18 +1917,34 @@ func (x *AccessTokensUserSetting_AccessToken) GetDescription() string {
 	return ""
 }
 
+func (x *AccessTokensUserSetting_AccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AccessTokensUserSetting_AccessToken) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
 type ShortcutsUserSetting_Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Filter string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by is the order of the memos of the shortcut, as ListMemosRequest.order_by.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ShortcutsUserSetting_Shortcut) Reset() {
 	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +1956,7 @@ func (x *ShortcutsUserSetting_Shortcut) String() string {
 func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+	mi := &file_store_user_setting_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,6 +1993,198 @@ func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
 	return ""
 }
 
+func (x *ShortcutsUserSetting_Shortcut) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type MemoTemplatesUserSetting_MemoTemplate struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Tags    []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The visibility of the memos created from the template, or empty for the user's default.
+	Visibility    string `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) Reset() {
+	*x = MemoTemplatesUserSetting_MemoTemplate{}
+	mi := &file_store_user_setting_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoTemplatesUserSetting_MemoTemplate) ProtoMessage() {}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoTemplatesUserSetting_MemoTemplate.ProtoReflect.Descriptor instead.
+func (*MemoTemplatesUserSetting_MemoTemplate) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MemoTemplatesUserSetting_MemoTemplate) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type PushSubscriptionsUserSetting_PushSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Random identifier of the subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The URL of the push service the notifications are posted to.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The P-256 public key of the subscription, as an uncompressed point.
+	P256Dh []byte `protobuf:"bytes,3,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	// The authentication secret of the subscription.
+	Auth []byte `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	// User-supplied label of the device, e.g. "Phone".
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	// Timestamp when the subscription was registered.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Timestamp when the subscription expires, if the push service set one.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Timestamp when a notification was last pushed to the subscription.
+	LastPushTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_push_time,json=lastPushTime,proto3" json:"last_push_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) Reset() {
+	*x = PushSubscriptionsUserSetting_PushSubscription{}
+	mi := &file_store_user_setting_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSubscriptionsUserSetting_PushSubscription) ProtoMessage() {}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSubscriptionsUserSetting_PushSubscription.ProtoReflect.Descriptor instead.
+func (*PushSubscriptionsUserSetting_PushSubscription) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetP256Dh() []byte {
+	if x != nil {
+		return x.P256Dh
+	}
+	return nil
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetAuth() []byte {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *PushSubscriptionsUserSetting_PushSubscription) GetLastPushTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPushTime
+	}
+	return nil
+}
+
 type WebhooksUserSetting_Webhook struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier for the webhook
@@ -747,14 +2192,30 @@ type WebhooksUserSetting_Webhook struct {
 	// Descriptive title for the webhook
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	// The webhook URL endpoint
-	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Whether deliveries to the webhook are stopped. Webhooks are disabled automatically after
+	// too many consecutive failed deliveries.
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The number of failed deliveries since the last successful one.
+	ConsecutiveFailures int32 `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// The secret deliveries are signed with, encrypted with the instance secret key.
+	// Empty for webhooks created before signing, whose deliveries are sent unsigned.
+	EncryptedSecret string `protobuf:"bytes,6,opt,name=encrypted_secret,json=encryptedSecret,proto3" json:"encrypted_secret,omitempty"`
+	// The events the webhook is subscribed to, e.g. memos.memo.created.
+	// Empty subscribes to all events, which is the case for webhooks created before subscriptions.
+	Events []string `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// The format of the payloads sent to the webhook.
+	Format WebhooksUserSetting_Webhook_Format `protobuf:"varint,8,opt,name=format,proto3,enum=memos.store.WebhooksUserSetting_Webhook_Format" json:"format,omitempty"`
+	// Whether the events of private memos carry their content. Only used by workspace webhooks,
+	// which otherwise send only the memo name and the event of private memos.
+	IncludePrivateContent bool `protobuf:"varint,9,opt,name=include_private_content,json=includePrivateContent,proto3" json:"include_private_content,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *WebhooksUserSetting_Webhook) Reset() {
 	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +2227,7 @@ func (x *WebhooksUserSetting_Webhook) String() string {
 func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
 
 func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+	mi := &file_store_user_setting_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +2240,7 @@ func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
 func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+	return file_store_user_setting_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *WebhooksUserSetting_Webhook) GetId() string {
@@ -803,11 +2264,161 @@ func (x *WebhooksUserSetting_Webhook) GetUrl() string {
 	return ""
 }
 
+func (x *WebhooksUserSetting_Webhook) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *WebhooksUserSetting_Webhook) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *WebhooksUserSetting_Webhook) GetEncryptedSecret() string {
+	if x != nil {
+		return x.EncryptedSecret
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhooksUserSetting_Webhook) GetFormat() WebhooksUserSetting_Webhook_Format {
+	if x != nil {
+		return x.Format
+	}
+	return WebhooksUserSetting_Webhook_FORMAT_UNSPECIFIED
+}
+
+func (x *WebhooksUserSetting_Webhook) GetIncludePrivateContent() bool {
+	if x != nil {
+		return x.IncludePrivateContent
+	}
+	return false
+}
+
+type PasskeysUserSetting_Passkey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64url-encoded WebAuthn credential ID.
+	CredentialId string `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	// DER-encoded SubjectPublicKeyInfo of the credential.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// COSE algorithm identifier of the public key, e.g. -7 for ES256.
+	Algorithm int64 `protobuf:"varint,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// The signature counter of the last accepted assertion.
+	SignCount uint32 `protobuf:"varint,4,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	// Transport hints reported by the authenticator, e.g. "internal" or "usb".
+	Transports []string `protobuf:"bytes,5,rep,name=transports,proto3" json:"transports,omitempty"`
+	// User-supplied label.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// Timestamp when the passkey was registered.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Timestamp when the passkey was last used to sign in.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeysUserSetting_Passkey) Reset() {
+	*x = PasskeysUserSetting_Passkey{}
+	mi := &file_store_user_setting_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeysUserSetting_Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeysUserSetting_Passkey) ProtoMessage() {}
+
+func (x *PasskeysUserSetting_Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeysUserSetting_Passkey.ProtoReflect.Descriptor instead.
+func (*PasskeysUserSetting_Passkey) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *PasskeysUserSetting_Passkey) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *PasskeysUserSetting_Passkey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetAlgorithm() int64 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *PasskeysUserSetting_Passkey) GetSignCount() uint32 {
+	if x != nil {
+		return x.SignCount
+	}
+	return 0
+}
+
+func (x *PasskeysUserSetting_Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PasskeysUserSetting_Passkey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PasskeysUserSetting_Passkey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x04\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\r\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -815,19 +2426,57 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bsessions\x18\x04 \x01(\v2 .memos.store.SessionsUserSettingH\x00R\bsessions\x12K\n" +
 	"\raccess_tokens\x18\x05 \x01(\v2$.memos.store.AccessTokensUserSettingH\x00R\faccessTokens\x12A\n" +
 	"\tshortcuts\x18\x06 \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12>\n" +
-	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\"e\n" +
+	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\x12B\n" +
+	"\n" +
+	"two_factor\x18\b \x01(\v2!.memos.store.TwoFactorUserSettingH\x00R\ttwoFactor\x12>\n" +
+	"\bpasskeys\x18\t \x01(\v2 .memos.store.PasskeysUserSettingH\x00R\bpasskeys\x12N\n" +
+	"\x0ememo_templates\x18\n" +
+	" \x01(\v2%.memos.store.MemoTemplatesUserSettingH\x00R\rmemoTemplates\x12H\n" +
+	"\fauto_archive\x18\v \x01(\v2#.memos.store.AutoArchiveUserSettingH\x00R\vautoArchive\x12K\n" +
+	"\rstorage_quota\x18\f \x01(\v2$.memos.store.StorageQuotaUserSettingH\x00R\fstorageQuota\x12B\n" +
+	"\n" +
+	"feed_token\x18\r \x01(\v2!.memos.store.FeedTokenUserSettingH\x00R\tfeedToken\x12K\n" +
+	"\rinbound_email\x18\x0e \x01(\v2$.memos.store.InboundEmailUserSettingH\x00R\finboundEmail\x125\n" +
+	"\x05slack\x18\x0f \x01(\v2\x1d.memos.store.SlackUserSettingH\x00R\x05slack\x128\n" +
+	"\x06matrix\x18\x10 \x01(\v2\x1e.memos.store.MatrixUserSettingH\x00R\x06matrix\x12B\n" +
+	"\n" +
+	"memo_quota\x18\x11 \x01(\v2!.memos.store.MemoQuotaUserSettingH\x00R\tmemoQuota\x12f\n" +
+	"\x16announcement_dismissal\x18\x12 \x01(\v2-.memos.store.AnnouncementDismissalUserSettingH\x00R\x15announcementDismissal\x12J\n" +
+	"\fnotification\x18\x13 \x01(\v2$.memos.store.NotificationUserSettingH\x00R\fnotification\x12Z\n" +
+	"\x12push_subscriptions\x18\x14 \x01(\v2).memos.store.PushSubscriptionsUserSettingH\x00R\x11pushSubscriptions\"\xcc\x02\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
 	"\bSESSIONS\x10\x02\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x03\x12\r\n" +
 	"\tSHORTCUTS\x10\x04\x12\f\n" +
-	"\bWEBHOOKS\x10\x05B\a\n" +
-	"\x05value\"k\n" +
+	"\bWEBHOOKS\x10\x05\x12\x0e\n" +
+	"\n" +
+	"TWO_FACTOR\x10\x06\x12\f\n" +
+	"\bPASSKEYS\x10\a\x12\x12\n" +
+	"\x0eMEMO_TEMPLATES\x10\b\x12\x10\n" +
+	"\fAUTO_ARCHIVE\x10\t\x12\x11\n" +
+	"\rSTORAGE_QUOTA\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"FEED_TOKEN\x10\v\x12\x11\n" +
+	"\rINBOUND_EMAIL\x10\f\x12\t\n" +
+	"\x05SLACK\x10\r\x12\n" +
+	"\n" +
+	"\x06MATRIX\x10\x0e\x12\x0e\n" +
+	"\n" +
+	"MEMO_QUOTA\x10\x0f\x12\x1a\n" +
+	"\x16ANNOUNCEMENT_DISMISSAL\x10\x10\x12\x10\n" +
+	"\fNOTIFICATION\x10\x11\x12\x16\n" +
+	"\x12PUSH_SUBSCRIPTIONS\x10\x12B\a\n" +
+	"\x05value\"\xd7\x01\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12'\n" +
 	"\x0fmemo_visibility\x18\x02 \x01(\tR\x0ememoVisibility\x12\x14\n" +
-	"\x05theme\x18\x03 \x01(\tR\x05theme\"\xf3\x03\n" +
+	"\x05theme\x18\x03 \x01(\tR\x05theme\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x125\n" +
+	"\x14strip_image_metadata\x18\x05 \x01(\bH\x00R\x12stripImageMetadata\x88\x01\x01B\x17\n" +
+	"\x15_strip_image_metadata\"\xf3\x03\n" +
 	"\x13SessionsUserSetting\x12D\n" +
 	"\bsessions\x18\x01 \x03(\v2(.memos.store.SessionsUserSetting.SessionR\bsessions\x1a\xfd\x01\n" +
 	"\aSession\x12\x1d\n" +
@@ -847,24 +2496,141 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\vdevice_type\x18\x03 \x01(\tR\n" +
 	"deviceType\x12\x0e\n" +
 	"\x02os\x18\x04 \x01(\tR\x02os\x12\x18\n" +
-	"\abrowser\x18\x05 \x01(\tR\abrowser\"\xc4\x01\n" +
+	"\abrowser\x18\x05 \x01(\tR\abrowser\"\x9f\x02\n" +
 	"\x17AccessTokensUserSetting\x12U\n" +
-	"\raccess_tokens\x18\x01 \x03(\v20.memos.store.AccessTokensUserSetting.AccessTokenR\faccessTokens\x1aR\n" +
+	"\raccess_tokens\x18\x01 \x03(\v20.memos.store.AccessTokensUserSetting.AccessTokenR\faccessTokens\x1a\xac\x01\n" +
 	"\vAccessToken\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xaa\x01\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12@\n" +
+	"\x0elast_used_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\"\xc5\x01\n" +
 	"\x14ShortcutsUserSetting\x12H\n" +
-	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1aH\n" +
+	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1ac\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9e\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"\xf1\x01\n" +
+	"\x18MemoTemplatesUserSetting\x12P\n" +
+	"\ttemplates\x18\x01 \x03(\v22.memos.store.MemoTemplatesUserSetting.MemoTemplateR\ttemplates\x1a\x82\x01\n" +
+	"\fMemoTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\"S\n" +
+	"\x16AutoArchiveUserSetting\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12%\n" +
+	"\x0eprotected_tags\x18\x02 \x03(\tR\rprotectedTags\"F\n" +
+	"\x17StorageQuotaUserSetting\x12\x1e\n" +
+	"\bquota_mb\x18\x01 \x01(\x03H\x00R\aquotaMb\x88\x01\x01B\v\n" +
+	"\t_quota_mb\"\xe6\x01\n" +
+	"\x14MemoQuotaUserSetting\x12 \n" +
+	"\tmax_memos\x18\x01 \x01(\x05H\x00R\bmaxMemos\x88\x01\x01\x12.\n" +
+	"\x11max_memos_per_day\x18\x02 \x01(\x05H\x01R\x0emaxMemosPerDay\x88\x01\x01\x12;\n" +
+	"\x18max_public_memos_per_day\x18\x03 \x01(\x05H\x02R\x14maxPublicMemosPerDay\x88\x01\x01B\f\n" +
+	"\n" +
+	"_max_memosB\x14\n" +
+	"\x12_max_memos_per_dayB\x1b\n" +
+	"\x19_max_public_memos_per_day\"K\n" +
+	" AnnouncementDismissalUserSetting\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId\"\x9a\x05\n" +
+	"\x17NotificationUserSetting\x12,\n" +
+	"\x12email_memo_comment\x18\x01 \x01(\bR\x10emailMemoComment\x12.\n" +
+	"\x13email_memo_reaction\x18\x02 \x01(\bR\x11emailMemoReaction\x12,\n" +
+	"\x12email_memo_mention\x18\x03 \x01(\bR\x10emailMemoMention\x12.\n" +
+	"\x13email_memo_reminder\x18\x04 \x01(\bR\x11emailMemoReminder\x121\n" +
+	"\x15email_cursor_inbox_id\x18\x05 \x01(\x05R\x12emailCursorInboxId\x12*\n" +
+	"\x11quiet_hours_start\x18\x06 \x01(\tR\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\a \x01(\tR\rquietHoursEnd\x12_\n" +
+	"\x10digest_frequency\x18\b \x01(\x0e24.memos.store.NotificationUserSetting.DigestFrequencyR\x0fdigestFrequency\x12\x1f\n" +
+	"\vdigest_time\x18\t \x01(\tR\n" +
+	"digestTime\x12%\n" +
+	"\x0edigest_weekday\x18\n" +
+	" \x01(\x05R\rdigestWeekday\x12!\n" +
+	"\femail_digest\x18\v \x01(\bR\vemailDigest\x12$\n" +
+	"\x0elast_digest_ts\x18\f \x01(\x03R\flastDigestTs\"J\n" +
+	"\x0fDigestFrequency\x12 \n" +
+	"\x1cDIGEST_FREQUENCY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02\"\xbf\x03\n" +
+	"\x1cPushSubscriptionsUserSetting\x12`\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2:.memos.store.PushSubscriptionsUserSetting.PushSubscriptionR\rsubscriptions\x1a\xbc\x02\n" +
+	"\x10PushSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06p256dh\x18\x03 \x01(\fR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x04 \x01(\fR\x04auth\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12@\n" +
+	"\x0elast_push_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\flastPushTime\"r\n" +
+	"\x14FeedTokenUserSetting\x12\x1d\n" +
+	"\n" +
+	"token_hash\x18\x01 \x01(\tR\ttokenHash\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"l\n" +
+	"\x17InboundEmailUserSetting\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xfd\x01\n" +
+	"\x10SlackUserSetting\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\"\n" +
+	"\rslack_user_id\x18\x02 \x01(\tR\vslackUserId\x127\n" +
+	"\tlink_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blinkTime\x12$\n" +
+	"\x0elink_code_hash\x18\x04 \x01(\tR\flinkCodeHash\x12M\n" +
+	"\x15link_code_expire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x12linkCodeExpireTime\"\x97\x02\n" +
+	"\x11MatrixUserSetting\x12$\n" +
+	"\x0ematrix_user_id\x18\x01 \x01(\tR\fmatrixUserId\x127\n" +
+	"\tlink_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\blinkTime\x12$\n" +
+	"\x0elink_code_hash\x18\x03 \x01(\tR\flinkCodeHash\x12M\n" +
+	"\x15link_code_expire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12linkCodeExpireTime\x12.\n" +
+	"\x13subscribed_room_ids\x18\x05 \x03(\tR\x11subscribedRoomIds\"\xf5\x03\n" +
 	"\x13WebhooksUserSetting\x12D\n" +
-	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1aA\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1a\x97\x03\n" +
 	"\aWebhook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03urlB\x9b\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\x121\n" +
+	"\x14consecutive_failures\x18\x05 \x01(\x05R\x13consecutiveFailures\x12)\n" +
+	"\x10encrypted_secret\x18\x06 \x01(\tR\x0fencryptedSecret\x12\x16\n" +
+	"\x06events\x18\a \x03(\tR\x06events\x12G\n" +
+	"\x06format\x18\b \x01(\x0e2/.memos.store.WebhooksUserSetting.Webhook.FormatR\x06format\x126\n" +
+	"\x17include_private_content\x18\t \x01(\bR\x15includePrivateContent\"A\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03RAW\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\v\n" +
+	"\aDISCORD\x10\x03\"\xda\x01\n" +
+	"\x14TwoFactorUserSetting\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12!\n" +
+	"\flast_counter\x18\x03 \x01(\x03R\vlastCounter\x120\n" +
+	"\x14recovery_code_hashes\x18\x04 \x03(\tR\x12recoveryCodeHashes\x12;\n" +
+	"\venable_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"enableTime\"\x9d\x03\n" +
+	"\x13PasskeysUserSetting\x12D\n" +
+	"\bpasskeys\x18\x01 \x03(\v2(.memos.store.PasskeysUserSetting.PasskeyR\bpasskeys\x1a\xbf\x02\n" +
+	"\aPasskey\x12#\n" +
+	"\rcredential_id\x18\x01 \x01(\tR\fcredentialId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\talgorithm\x18\x03 \x01(\x03R\talgorithm\x12\x1d\n" +
+	"\n" +
+	"sign_count\x18\x04 \x01(\rR\tsignCount\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x05 \x03(\tR\n" +
+	"transports\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12@\n" +
+	"\x0elast_used_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTimeB\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -879,42 +2645,91 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_store_user_setting_proto_goTypes = []any{
-	(UserSetting_Key)(0),                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                  // 2: memos.store.GeneralUserSetting
-	(*SessionsUserSetting)(nil),                 // 3: memos.store.SessionsUserSetting
-	(*AccessTokensUserSetting)(nil),             // 4: memos.store.AccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                 // 6: memos.store.WebhooksUserSetting
-	(*SessionsUserSetting_Session)(nil),         // 7: memos.store.SessionsUserSetting.Session
-	(*SessionsUserSetting_ClientInfo)(nil),      // 8: memos.store.SessionsUserSetting.ClientInfo
-	(*AccessTokensUserSetting_AccessToken)(nil), // 9: memos.store.AccessTokensUserSetting.AccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),       // 10: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),         // 11: memos.store.WebhooksUserSetting.Webhook
-	(*timestamppb.Timestamp)(nil),               // 12: google.protobuf.Timestamp
+	(UserSetting_Key)(0),                                  // 0: memos.store.UserSetting.Key
+	(NotificationUserSetting_DigestFrequency)(0),          // 1: memos.store.NotificationUserSetting.DigestFrequency
+	(WebhooksUserSetting_Webhook_Format)(0),               // 2: memos.store.WebhooksUserSetting.Webhook.Format
+	(*UserSetting)(nil),                                   // 3: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                            // 4: memos.store.GeneralUserSetting
+	(*SessionsUserSetting)(nil),                           // 5: memos.store.SessionsUserSetting
+	(*AccessTokensUserSetting)(nil),                       // 6: memos.store.AccessTokensUserSetting
+	(*ShortcutsUserSetting)(nil),                          // 7: memos.store.ShortcutsUserSetting
+	(*MemoTemplatesUserSetting)(nil),                      // 8: memos.store.MemoTemplatesUserSetting
+	(*AutoArchiveUserSetting)(nil),                        // 9: memos.store.AutoArchiveUserSetting
+	(*StorageQuotaUserSetting)(nil),                       // 10: memos.store.StorageQuotaUserSetting
+	(*MemoQuotaUserSetting)(nil),                          // 11: memos.store.MemoQuotaUserSetting
+	(*AnnouncementDismissalUserSetting)(nil),              // 12: memos.store.AnnouncementDismissalUserSetting
+	(*NotificationUserSetting)(nil),                       // 13: memos.store.NotificationUserSetting
+	(*PushSubscriptionsUserSetting)(nil),                  // 14: memos.store.PushSubscriptionsUserSetting
+	(*FeedTokenUserSetting)(nil),                          // 15: memos.store.FeedTokenUserSetting
+	(*InboundEmailUserSetting)(nil),                       // 16: memos.store.InboundEmailUserSetting
+	(*SlackUserSetting)(nil),                              // 17: memos.store.SlackUserSetting
+	(*MatrixUserSetting)(nil),                             // 18: memos.store.MatrixUserSetting
+	(*WebhooksUserSetting)(nil),                           // 19: memos.store.WebhooksUserSetting
+	(*TwoFactorUserSetting)(nil),                          // 20: memos.store.TwoFactorUserSetting
+	(*PasskeysUserSetting)(nil),                           // 21: memos.store.PasskeysUserSetting
+	(*SessionsUserSetting_Session)(nil),                   // 22: memos.store.SessionsUserSetting.Session
+	(*SessionsUserSetting_ClientInfo)(nil),                // 23: memos.store.SessionsUserSetting.ClientInfo
+	(*AccessTokensUserSetting_AccessToken)(nil),           // 24: memos.store.AccessTokensUserSetting.AccessToken
+	(*ShortcutsUserSetting_Shortcut)(nil),                 // 25: memos.store.ShortcutsUserSetting.Shortcut
+	(*MemoTemplatesUserSetting_MemoTemplate)(nil),         // 26: memos.store.MemoTemplatesUserSetting.MemoTemplate
+	(*PushSubscriptionsUserSetting_PushSubscription)(nil), // 27: memos.store.PushSubscriptionsUserSetting.PushSubscription
+	(*WebhooksUserSetting_Webhook)(nil),                   // 28: memos.store.WebhooksUserSetting.Webhook
+	(*PasskeysUserSetting_Passkey)(nil),                   // 29: memos.store.PasskeysUserSetting.Passkey
+	(*timestamppb.Timestamp)(nil),                         // 30: google.protobuf.Timestamp
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	3,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
-	4,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
-	5,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	7,  // 6: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
-	9,  // 7: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
-	10, // 8: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	11, // 9: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	12, // 10: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
-	12, // 11: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
-	8,  // 12: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	4,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	5,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
+	6,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
+	7,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	19, // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	20, // 6: memos.store.UserSetting.two_factor:type_name -> memos.store.TwoFactorUserSetting
+	21, // 7: memos.store.UserSetting.passkeys:type_name -> memos.store.PasskeysUserSetting
+	8,  // 8: memos.store.UserSetting.memo_templates:type_name -> memos.store.MemoTemplatesUserSetting
+	9,  // 9: memos.store.UserSetting.auto_archive:type_name -> memos.store.AutoArchiveUserSetting
+	10, // 10: memos.store.UserSetting.storage_quota:type_name -> memos.store.StorageQuotaUserSetting
+	15, // 11: memos.store.UserSetting.feed_token:type_name -> memos.store.FeedTokenUserSetting
+	16, // 12: memos.store.UserSetting.inbound_email:type_name -> memos.store.InboundEmailUserSetting
+	17, // 13: memos.store.UserSetting.slack:type_name -> memos.store.SlackUserSetting
+	18, // 14: memos.store.UserSetting.matrix:type_name -> memos.store.MatrixUserSetting
+	11, // 15: memos.store.UserSetting.memo_quota:type_name -> memos.store.MemoQuotaUserSetting
+	12, // 16: memos.store.UserSetting.announcement_dismissal:type_name -> memos.store.AnnouncementDismissalUserSetting
+	13, // 17: memos.store.UserSetting.notification:type_name -> memos.store.NotificationUserSetting
+	14, // 18: memos.store.UserSetting.push_subscriptions:type_name -> memos.store.PushSubscriptionsUserSetting
+	22, // 19: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
+	24, // 20: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
+	25, // 21: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	26, // 22: memos.store.MemoTemplatesUserSetting.templates:type_name -> memos.store.MemoTemplatesUserSetting.MemoTemplate
+	1,  // 23: memos.store.NotificationUserSetting.digest_frequency:type_name -> memos.store.NotificationUserSetting.DigestFrequency
+	27, // 24: memos.store.PushSubscriptionsUserSetting.subscriptions:type_name -> memos.store.PushSubscriptionsUserSetting.PushSubscription
+	30, // 25: memos.store.FeedTokenUserSetting.create_time:type_name -> google.protobuf.Timestamp
+	30, // 26: memos.store.InboundEmailUserSetting.create_time:type_name -> google.protobuf.Timestamp
+	30, // 27: memos.store.SlackUserSetting.link_time:type_name -> google.protobuf.Timestamp
+	30, // 28: memos.store.SlackUserSetting.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	30, // 29: memos.store.MatrixUserSetting.link_time:type_name -> google.protobuf.Timestamp
+	30, // 30: memos.store.MatrixUserSetting.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	28, // 31: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	30, // 32: memos.store.TwoFactorUserSetting.enable_time:type_name -> google.protobuf.Timestamp
+	29, // 33: memos.store.PasskeysUserSetting.passkeys:type_name -> memos.store.PasskeysUserSetting.Passkey
+	30, // 34: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
+	30, // 35: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
+	23, // 36: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
+	30, // 37: memos.store.AccessTokensUserSetting.AccessToken.last_used_time:type_name -> google.protobuf.Timestamp
+	30, // 38: memos.store.PushSubscriptionsUserSetting.PushSubscription.create_time:type_name -> google.protobuf.Timestamp
+	30, // 39: memos.store.PushSubscriptionsUserSetting.PushSubscription.expire_time:type_name -> google.protobuf.Timestamp
+	30, // 40: memos.store.PushSubscriptionsUserSetting.PushSubscription.last_push_time:type_name -> google.protobuf.Timestamp
+	2,  // 41: memos.store.WebhooksUserSetting.Webhook.format:type_name -> memos.store.WebhooksUserSetting.Webhook.Format
+	30, // 42: memos.store.PasskeysUserSetting.Passkey.create_time:type_name -> google.protobuf.Timestamp
+	30, // 43: memos.store.PasskeysUserSetting.Passkey.last_used_time:type_name -> google.protobuf.Timestamp
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -928,14 +2743,30 @@ func file_store_user_setting_proto_init() {
 		(*UserSetting_AccessTokens)(nil),
 		(*UserSetting_Shortcuts)(nil),
 		(*UserSetting_Webhooks)(nil),
+		(*UserSetting_TwoFactor)(nil),
+		(*UserSetting_Passkeys)(nil),
+		(*UserSetting_MemoTemplates)(nil),
+		(*UserSetting_AutoArchive)(nil),
+		(*UserSetting_StorageQuota)(nil),
+		(*UserSetting_FeedToken)(nil),
+		(*UserSetting_InboundEmail)(nil),
+		(*UserSetting_Slack)(nil),
+		(*UserSetting_Matrix)(nil),
+		(*UserSetting_MemoQuota)(nil),
+		(*UserSetting_AnnouncementDismissal)(nil),
+		(*UserSetting_Notification)(nil),
+		(*UserSetting_PushSubscriptions)(nil),
 	}
+	file_store_user_setting_proto_msgTypes[1].OneofWrappers = []any{}
+	file_store_user_setting_proto_msgTypes[7].OneofWrappers = []any{}
+	file_store_user_setting_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+// RecordAuditLog writes an audit log entry for an event caused by actorID, with the client
+// IP address and user agent of the current request. The payload holds event specific
+// details and must not contain secrets such as tokens or passwords.
+//
+// Failing to write the entry is logged but doesn't fail the audited action.
+func (a *Authenticator) RecordAuditLog(ctx context.Context, eventType store.AuditEventType, actorID int32, payload map[string]any) {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil || payload == nil {
+		payloadBytes = []byte("{}")
+	}
+	ipAddress, userAgent := ExtractClientFromContext(ctx)
+	create := &store.AuditLog{
+		ActorID:   actorID,
+		EventType: eventType,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Payload:   string(payloadBytes),
+		CreatedTs: time.Now().Unix(),
+	}
+	if _, err := a.store.CreateAuditLog(ctx, create); err != nil {
+		slog.Error("failed to create audit log", "event_type", eventType, "error", err)
+	}
+}
@@ -17,8 +17,12 @@ import (
 // Used by gRPC interceptor, Connect interceptor, and file server to ensure
 // consistent authentication behavior across all API endpoints.
 //
+// The authentication methods remember their results on contexts made by WithRequestAuthentications,
+// so a request authenticated more than once validates its credentials only once.
+//
 // Authentication methods:
 // - Session cookie: Browser-based authentication with sliding expiration
+// - Session access token: Short-lived browser token renewed with a rotating refresh token
 // - JWT token: API token authentication for programmatic access
 //
 // This struct is safe for concurrent use.
@@ -39,12 +43,21 @@ func NewAuthenticator(store *store.Store, secret string) *Authenticator {
 //
 // Validation steps:
 // 1. Parse cookie value to extract userID and sessionID
-// 2. Verify user exists and is not archived
+// 2. Verify user exists and is neither archived nor suspended
 // 3. Verify session exists in user's sessions list
-// 4. Check session hasn't expired (sliding expiration: 14 days from last access)
+// 4. Check session hasn't expired under the session policy (sliding idle timeout from the last
+// access, capped by the absolute lifetime from sign-in)
 //
 // Returns the user if authentication succeeds, or an error describing the failure.
 func (a *Authenticator) AuthenticateBySession(ctx context.Context, sessionCookieValue string) (*store.User, error) {
+	result := authenticateOnce(ctx, "session", sessionCookieValue, func() *requestAuthentication {
+		user, err := a.validateSessionCookie(ctx, sessionCookieValue)
+		return &requestAuthentication{user: user, err: err}
+	})
+	return result.user, result.err
+}
+
+func (a *Authenticator) validateSessionCookie(ctx context.Context, sessionCookieValue string) (*store.User, error) {
 	if sessionCookieValue == "" {
 		return nil, errors.New("session cookie value not found")
 	}
@@ -64,13 +77,20 @@ func (a *Authenticator) AuthenticateBySession(ctx context.Context, sessionCookie
 	if user.RowStatus == store.Archived {
 		return nil, errors.New("user is archived")
 	}
+	if user.SuspendedTs != 0 {
+		return nil, errors.New("user is suspended")
+	}
 
 	sessions, err := a.store.GetUserSessions(ctx, user.ID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get user sessions")
 	}
 
-	if !validateSession(sessionID, sessions) {
+	policy, err := a.SessionPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !validateSession(sessionID, sessions, policy) {
 		return nil, errors.New("invalid or expired session")
 	}
 
@@ -80,76 +100,103 @@ func (a *Authenticator) AuthenticateBySession(ctx context.Context, sessionCookie
 // AuthenticateByJWT validates a JWT access token and returns the authenticated user.
 //
 // Validation steps:
-// 1. Parse and verify JWT signature using server secret
-// 2. Verify key ID matches expected version
-// 3. Extract user ID from JWT claims (subject field)
-// 4. Verify user exists and is not archived
-// 5. Verify token exists in user's access_tokens list (for revocation support)
+// 1. Parse and verify JWT signature using the signing key of its key ID
+// 2. Verify the key is still accepted, i.e. it wasn't expired after a rotation
+// 3. Reject tokens past their expiration time
+// 4. Extract user ID from JWT claims (subject field)
+// 5. Verify user exists and is neither archived nor suspended
+// 6. Verify token exists in user's access_tokens list (for revocation support)
 //
-// Returns the user if authentication succeeds, or an error describing the failure.
-func (a *Authenticator) AuthenticateByJWT(ctx context.Context, accessToken string) (*store.User, error) {
+// Also updates the token's last used time, at most once per AccessTokenLastUsedUpdateInterval.
+//
+// Returns the user and the scopes granted to the token if authentication succeeds,
+// or an error describing the failure. Empty scopes grant full access.
+func (a *Authenticator) AuthenticateByJWT(ctx context.Context, accessToken string) (*store.User, []string, error) {
+	result := authenticateOnce(ctx, "jwt", accessToken, func() *requestAuthentication {
+		user, scopes, err := a.validateJWT(ctx, accessToken)
+		return &requestAuthentication{user: user, scopes: scopes, err: err}
+	})
+	return result.user, result.scopes, result.err
+}
+
+func (a *Authenticator) validateJWT(ctx context.Context, accessToken string) (*store.User, []string, error) {
 	if accessToken == "" {
-		return nil, errors.New("access token not found")
+		return nil, nil, errors.New("access token not found")
 	}
 
 	claims := &ClaimsMessage{}
-	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, errors.Errorf("unexpected signing method: %v", t.Header["alg"])
-		}
-		kid, ok := t.Header["kid"].(string)
-		if !ok || kid != KeyID {
-			return nil, errors.Errorf("unexpected kid: %v", t.Header["kid"])
-		}
-		return []byte(a.secret), nil
-	})
+	_, err := jwt.ParseWithClaims(accessToken, claims, a.verificationKey(ctx))
 	if err != nil {
-		return nil, errors.New("invalid or expired access token")
+		return nil, nil, errors.New("invalid or expired access token")
+	}
+	// The parser validates exp too, but expiry must not depend on parser options.
+	if claims.ExpiresAt != nil && !claims.ExpiresAt.After(time.Now()) {
+		return nil, nil, errors.New("invalid or expired access token")
 	}
 
 	userID, err := util.ConvertStringToInt32(claims.Subject)
 	if err != nil {
-		return nil, errors.Wrap(err, "malformed ID in token")
+		return nil, nil, errors.Wrap(err, "malformed ID in token")
 	}
 
 	user, err := a.store.GetUser(ctx, &store.FindUser{ID: &userID})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user")
+		return nil, nil, errors.Wrap(err, "failed to get user")
 	}
 	if user == nil {
-		return nil, errors.Errorf("user %d not found", userID)
+		return nil, nil, errors.Errorf("user %d not found", userID)
 	}
 	if user.RowStatus == store.Archived {
-		return nil, errors.Errorf("user %d is archived", userID)
+		return nil, nil, errors.Errorf("user %d is archived", userID)
+	}
+	if user.SuspendedTs != 0 {
+		return nil, nil, errors.Errorf("user %d is suspended", userID)
 	}
 
 	accessTokens, err := a.store.GetUserAccessTokens(ctx, user.ID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user access tokens")
+		return nil, nil, errors.Wrap(err, "failed to get user access tokens")
+	}
+	storedToken := findAccessToken(accessToken, accessTokens)
+	if storedToken == nil {
+		return nil, nil, errors.New("invalid access token")
 	}
-	if !validateAccessToken(accessToken, accessTokens) {
-		return nil, errors.New("invalid access token")
+	if storedToken.LastUsedTime == nil || time.Since(storedToken.LastUsedTime.AsTime()) >= AccessTokenLastUsedUpdateInterval {
+		_ = a.store.UpdateUserAccessTokenLastUsed(ctx, user.ID, accessToken, timestamppb.Now())
 	}
 
-	return user, nil
+	return user, claims.Scopes, nil
 }
 
 // AuthorizeAndSetContext checks user authorization for the given procedure and sets context values.
 //
 // Authorization checks:
+// - Scoped access tokens need the scope returned by RequiredScope, plus the admin scope for admin-only methods
 // - Admin-only methods require Host or Admin role (checked via isAdminOnly function)
 //
 // Context values set:
 // - UserIDContextKey: Always set with the user's ID
 // - SessionIDContextKey: Set if authenticated via session cookie
 // - AccessTokenContextKey: Set if authenticated via JWT token
+// - ScopesContextKey: Set if authenticated via JWT token with scopes
 //
-// Also updates session last accessed time for session-based auth (sliding expiration).
+// Also updates session last accessed time for session-based auth (sliding expiration),
+// at most once per SessionLastAccessedUpdateInterval.
 //
 // Returns the updated context or an error if authorization fails.
-func (a *Authenticator) AuthorizeAndSetContext(ctx context.Context, procedure string, user *store.User, sessionID, accessToken string, isAdminOnly func(string) bool) (context.Context, error) {
+func (a *Authenticator) AuthorizeAndSetContext(ctx context.Context, procedure string, user *store.User, sessionID, accessToken string, scopes []string, isAdminOnly func(string) bool) (context.Context, error) {
+	adminOnly := isAdminOnly != nil && isAdminOnly(procedure)
+
+	// Check access token scopes
+	if scope := RequiredScope(procedure); !HasScope(scopes, scope) {
+		return nil, errors.Errorf("access token is missing the %q scope", scope)
+	}
+	if adminOnly && !HasScope(scopes, ScopeAdmin) {
+		return nil, errors.Errorf("access token is missing the %q scope", ScopeAdmin)
+	}
+
 	// Check admin-only method authorization
-	if isAdminOnly != nil && isAdminOnly(procedure) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+	if adminOnly && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
 		return nil, errors.Errorf("user %q is not authorized for this operation", user.Username)
 	}
 
@@ -160,38 +207,54 @@ func (a *Authenticator) AuthorizeAndSetContext(ctx context.Context, procedure st
 	if sessionID != "" {
 		ctx = context.WithValue(ctx, SessionIDContextKey, sessionID)
 		// Update session last accessed time for sliding expiration
-		_ = a.store.UpdateUserSessionLastAccessed(ctx, user.ID, sessionID, timestamppb.Now())
+		a.touchSession(ctx, user.ID, sessionID)
 	} else if accessToken != "" {
 		ctx = context.WithValue(ctx, AccessTokenContextKey, accessToken)
+		if len(scopes) > 0 {
+			ctx = context.WithValue(ctx, ScopesContextKey, scopes)
+		}
 	}
 
 	return ctx, nil
 }
 
-// validateSession checks if a session exists and is still valid.
-// Uses sliding expiration: session is valid if last accessed within SessionSlidingDuration.
-func validateSession(sessionID string, sessions []*storepb.SessionsUserSetting_Session) bool {
+// touchSession records that the session was accessed, skipping the write if the
+// stored last accessed time is more recent than SessionLastAccessedUpdateInterval.
+func (a *Authenticator) touchSession(ctx context.Context, userID int32, sessionID string) {
+	sessions, err := a.store.GetUserSessions(ctx, userID)
+	if err != nil {
+		return
+	}
 	for _, session := range sessions {
-		if sessionID == session.SessionId {
-			if session.LastAccessedTime != nil {
-				expiration := session.LastAccessedTime.AsTime().Add(SessionSlidingDuration)
-				if expiration.Before(time.Now()) {
-					return false // Session expired
-				}
-			}
-			return true
+		if session.SessionId != sessionID {
+			continue
 		}
+		if session.LastAccessedTime != nil && time.Since(session.LastAccessedTime.AsTime()) < SessionLastAccessedUpdateInterval {
+			return
+		}
+		_ = a.store.UpdateUserSessionLastAccessed(ctx, userID, sessionID, timestamppb.Now())
+		return
 	}
-	return false // Session not found
 }
 
-// validateAccessToken checks if the token exists in the user's access tokens list.
+// findAccessToken returns the token's entry in the user's access tokens list, or nil if absent.
 // This enables token revocation: deleted tokens are removed from the list.
-func validateAccessToken(token string, tokens []*storepb.AccessTokensUserSetting_AccessToken) bool {
+func findAccessToken(token string, tokens []*storepb.AccessTokensUserSetting_AccessToken) *storepb.AccessTokensUserSetting_AccessToken {
 	for _, t := range tokens {
 		if token == t.AccessToken {
-			return true
+			return t
 		}
 	}
-	return false
+	return nil
+}
+
+// parseShortLivedToken verifies a token issued for audience that must have an expiration
+// time, such as a two-factor challenge or a session access token, and returns its claims.
+func (a *Authenticator) parseShortLivedToken(ctx context.Context, token, audience string) (*ClaimsMessage, error) {
+	claims := &ClaimsMessage{}
+	_, err := jwt.ParseWithClaims(token, claims, a.verificationKey(ctx), jwt.WithAudience(audience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
 }
@@ -19,6 +19,11 @@ const (
 	// AccessTokenContextKey stores the JWT token for token-based auth.
 	// Only set when authenticated via Bearer token.
 	AccessTokenContextKey
+
+	// ScopesContextKey stores the scopes granted to the access token.
+	// Only set when authenticated via Bearer token.
+	// Use GetScopes(ctx) to retrieve this value.
+	ScopesContextKey
 )
 
 // GetUserID retrieves the authenticated user's ID from the context.
@@ -47,3 +52,12 @@ func GetAccessToken(ctx context.Context) string {
 	}
 	return ""
 }
+
+// GetScopes retrieves the scopes granted to the access token from the context.
+// Returns nil, meaning full access, if not authenticated via a scoped bearer token.
+func GetScopes(ctx context.Context) []string {
+	if v, ok := ctx.Value(ScopesContextKey).([]string); ok {
+		return v
+	}
+	return nil
+}
@@ -1,19 +1,28 @@
 package auth
 
 import (
+	"context"
 	"net/http"
 	"strings"
+
+	"google.golang.org/grpc/metadata"
 )
 
 // ExtractSessionCookieFromHeader extracts the session cookie value from an HTTP Cookie header.
 // Returns empty string if the session cookie is not found.
 func ExtractSessionCookieFromHeader(cookieHeader string) string {
+	return ExtractCookieFromHeader(cookieHeader, SessionCookieName)
+}
+
+// ExtractCookieFromHeader extracts the value of the named cookie from an HTTP Cookie header.
+// Returns empty string if the cookie is not found.
+func ExtractCookieFromHeader(cookieHeader, name string) string {
 	if cookieHeader == "" {
 		return ""
 	}
 	// Use http.Request to parse cookies properly
 	req := &http.Request{Header: http.Header{"Cookie": []string{cookieHeader}}}
-	cookie, err := req.Cookie(SessionCookieName)
+	cookie, err := req.Cookie(name)
 	if err != nil {
 		return ""
 	}
@@ -33,3 +42,23 @@ func ExtractBearerToken(authHeader string) string {
 	}
 	return parts[1]
 }
+
+// ExtractClientFromContext extracts the client IP address and user agent from the incoming
+// request metadata. The IP address is the first address in X-Forwarded-For, or X-Real-IP.
+// Returns empty strings for values that are not available.
+func ExtractClientFromContext(ctx context.Context) (ipAddress, userAgent string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	if userAgents := md.Get("user-agent"); len(userAgents) > 0 {
+		userAgent = userAgents[0]
+	}
+	if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
+		// Get the first IP in case of multiple
+		ipAddress = strings.TrimSpace(strings.Split(forwardedFor[0], ",")[0])
+	} else if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
+		ipAddress = realIP[0]
+	}
+	return ipAddress, userAgent
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// feedTokenPrefix marks feed tokens, so that they're recognizable when leaked.
+const feedTokenPrefix = "feed_"
+
+// GenerateFeedToken generates a feed token and the hash to store.
+//
+// Feed tokens only grant access to the feeds of their user. They're independent of access
+// tokens, so that a leaked feed URL can be rotated without breaking API clients.
+func GenerateFeedToken() (string, string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", errors.Wrap(err, "failed to generate feed token")
+	}
+	token := feedTokenPrefix + hex.EncodeToString(raw)
+	return token, hashFeedToken(token), nil
+}
+
+// VerifyFeedToken checks a feed token against the feed token setting of a user.
+// Revoked feed tokens, whose hash is cleared, never match.
+func VerifyFeedToken(setting *storepb.FeedTokenUserSetting, token string) bool {
+	if setting.GetTokenHash() == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(setting.GetTokenHash()), []byte(hashFeedToken(token))) == 1
+}
+
+// hashFeedToken hashes a feed token for storage. Feed tokens are random, so a fast hash is enough.
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// linkCodeAlphabet leaves out the characters that are easily confused, e.g. 0 and O.
+	linkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	linkCodeLength   = 8
+	// LinkCodeDuration is how long a link code can be used.
+	LinkCodeDuration = 10 * time.Minute
+)
+
+// GenerateLinkCode generates a one-time code linking a chat account, e.g. a Slack or Matrix
+// account, to a user, and the hash to store.
+//
+// The codes are short, so that they're easy to paste in a chat, and expire quickly instead.
+func GenerateLinkCode() (string, string, error) {
+	raw := make([]byte, linkCodeLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", errors.Wrap(err, "failed to generate link code")
+	}
+	code := make([]byte, linkCodeLength)
+	for i, b := range raw {
+		// The alphabet has 32 characters, so the modulo isn't biased.
+		code[i] = linkCodeAlphabet[int(b)%len(linkCodeAlphabet)]
+	}
+	return string(code), hashLinkCode(string(code)), nil
+}
+
+// VerifyLinkCode checks a link code against the hash and expiry time of the pending link code of
+// a user. Used and expired codes never match.
+func VerifyLinkCode(codeHash string, expireTime *timestamppb.Timestamp, code string, now time.Time) bool {
+	if codeHash == "" || code == "" {
+		return false
+	}
+	if expireTime == nil || now.After(expireTime.AsTime()) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(codeHash), []byte(hashLinkCode(code))) == 1
+}
+
+// hashLinkCode hashes a link code for storage. The codes are normalized first, as they're typed
+// by hand.
+func hashLinkCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToUpper(strings.Join(strings.Fields(code), ""))))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,382 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Passkeys implement the WebAuthn registration and assertion ceremonies.
+//
+// Browsers expose the credential public key in DER form (getPublicKey()), so the attestation
+// object doesn't need to be decoded. Attestation statements are not verified ("none" attestation),
+// which is what passkey providers send by default.
+const (
+	// PasskeyRegistrationAudienceName is the audience claim for passkey registration challenges.
+	PasskeyRegistrationAudienceName = "user.passkey-registration"
+
+	// PasskeySignInAudienceName is the audience claim for passkey sign-in challenges.
+	PasskeySignInAudienceName = "user.passkey-sign-in"
+
+	// PasskeyChallengeDuration is how long a passkey challenge can be signed.
+	PasskeyChallengeDuration = 5 * time.Minute
+)
+
+// COSE algorithm identifiers of the supported passkey public keys.
+const (
+	PasskeyAlgorithmES256 int64 = -7
+	PasskeyAlgorithmEdDSA int64 = -8
+	PasskeyAlgorithmRS256 int64 = -257
+)
+
+// SupportedPasskeyAlgorithms lists the supported algorithms in order of preference.
+var SupportedPasskeyAlgorithms = []int64{PasskeyAlgorithmES256, PasskeyAlgorithmEdDSA, PasskeyAlgorithmRS256}
+
+// Authenticator data flags.
+const (
+	authenticatorDataFlagUserPresent            = 0x01
+	authenticatorDataFlagAttestedCredentialData = 0x40
+)
+
+// passkeyMu serializes passkey sign-ins, so concurrent assertions can't both pass the sign counter check.
+var passkeyMu sync.Mutex
+
+// RelyingParty identifies this instance to passkey authenticators.
+type RelyingParty struct {
+	// ID is the relying party ID, the host name of the instance.
+	ID string
+	// Origin is the origin browsers report in client data, e.g. "https://memos.example.com".
+	Origin string
+}
+
+// NewRelyingParty returns the relying party of the instance served at instanceURL.
+func NewRelyingParty(instanceURL string) (*RelyingParty, error) {
+	if instanceURL == "" {
+		return nil, errors.New("instance URL is not set")
+	}
+	u, err := url.Parse(instanceURL)
+	if err != nil || u.Scheme == "" || u.Hostname() == "" {
+		return nil, errors.Errorf("invalid instance URL %q", instanceURL)
+	}
+	return &RelyingParty{
+		ID:     u.Hostname(),
+		Origin: u.Scheme + "://" + u.Host,
+	}, nil
+}
+
+// GeneratePasskeyChallenge generates a challenge for a passkey ceremony.
+// Registration challenges are bound to the user; sign-in challenges use a zero user ID.
+//
+// The challenge is stored hashed until its ceremony is verified, so each challenge is only
+// accepted once. Expired challenges are deleted along the way.
+func (a *Authenticator) GeneratePasskeyChallenge(ctx context.Context, audience, username string, userID int32) ([]byte, error) {
+	now := time.Now()
+	nowSec := now.Unix()
+	if _, err := a.store.DeletePasskeyChallenges(ctx, &store.DeletePasskeyChallenge{ExpiresBefore: &nowSec}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete expired passkey challenges")
+	}
+	// The random ID keeps the challenges of concurrent ceremonies apart.
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate passkey challenge")
+	}
+	expiresAt := now.Add(PasskeyChallengeDuration)
+	token, err := a.signClaims(ctx, &ClaimsMessage{
+		Name: username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        base64.RawURLEncoding.EncodeToString(nonce),
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			Subject:   fmt.Sprint(userID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	challenge := &store.PasskeyChallenge{
+		ChallengeHash: hashToken(token),
+		ExpiresTs:     expiresAt.Unix(),
+	}
+	if _, err := a.store.CreatePasskeyChallenge(ctx, challenge); err != nil {
+		return nil, errors.Wrap(err, "failed to create passkey challenge")
+	}
+	return []byte(token), nil
+}
+
+// PasskeyUserHandle returns the user handle stored in passkeys of the user.
+// Discoverable credentials return it on sign-in, identifying the user without a username.
+func PasskeyUserHandle(userID int32) []byte {
+	return []byte(fmt.Sprint(userID))
+}
+
+// PasskeyRegistration is a credential returned by navigator.credentials.create().
+type PasskeyRegistration struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	// PublicKey is the DER-encoded SubjectPublicKeyInfo of the credential.
+	PublicKey  []byte
+	Algorithm  int64
+	Transports []string
+	Label      string
+}
+
+// PasskeyAssertion is a credential returned by navigator.credentials.get().
+type PasskeyAssertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	// credentialID is only set on registration, when attested credential data is included.
+	credentialID []byte
+}
+
+// VerifyPasskeyRegistration verifies a passkey created for a registration challenge of userID
+// and returns the passkey to store.
+func (a *Authenticator) VerifyPasskeyRegistration(ctx context.Context, rp *RelyingParty, userID int32, registration *PasskeyRegistration) (*storepb.PasskeysUserSetting_Passkey, error) {
+	claims, authData, err := a.verifyPasskeyCeremony(ctx, rp, "webauthn.create", PasskeyRegistrationAudienceName, registration.ClientDataJSON, registration.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Subject != fmt.Sprint(userID) {
+		return nil, errors.New("passkey challenge was issued for another user")
+	}
+	if len(registration.CredentialID) == 0 || !bytes.Equal(authData.credentialID, registration.CredentialID) {
+		return nil, errors.New("passkey credential ID mismatch")
+	}
+	if _, err := parsePasskeyPublicKey(registration.PublicKey, registration.Algorithm); err != nil {
+		return nil, err
+	}
+
+	return &storepb.PasskeysUserSetting_Passkey{
+		CredentialId: base64.RawURLEncoding.EncodeToString(registration.CredentialID),
+		PublicKey:    registration.PublicKey,
+		Algorithm:    registration.Algorithm,
+		SignCount:    authData.signCount,
+		Transports:   registration.Transports,
+		Label:        registration.Label,
+		CreateTime:   timestamppb.Now(),
+	}, nil
+}
+
+// AuthenticateByPasskey verifies a passkey assertion and returns the authenticated user.
+//
+// Validation steps:
+// 1. Verify client data: ceremony type, origin and an unused challenge from GeneratePasskeyChallenge
+// 2. Verify authenticator data: relying party ID hash and user presence
+// 3. Find the passkey by user handle and credential ID
+// 4. Verify the signature over the authenticator data and client data hash
+// 5. Reject sign counters that didn't increase, which indicate a cloned authenticator
+// 6. Verify user exists and is neither archived nor suspended.
+func (a *Authenticator) AuthenticateByPasskey(ctx context.Context, rp *RelyingParty, assertion *PasskeyAssertion) (*store.User, error) {
+	_, authData, err := a.verifyPasskeyCeremony(ctx, rp, "webauthn.get", PasskeySignInAudienceName, assertion.ClientDataJSON, assertion.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := util.ConvertStringToInt32(string(assertion.UserHandle))
+	if err != nil {
+		return nil, errors.New("malformed passkey user handle")
+	}
+
+	passkeyMu.Lock()
+	defer passkeyMu.Unlock()
+
+	passkeys, err := a.store.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get passkeys")
+	}
+	credentialID := base64.RawURLEncoding.EncodeToString(assertion.CredentialID)
+	var passkey *storepb.PasskeysUserSetting_Passkey
+	for _, p := range passkeys {
+		if p.CredentialId == credentialID {
+			passkey = p
+			break
+		}
+	}
+	if passkey == nil {
+		return nil, errors.New("passkey not found")
+	}
+
+	publicKey, err := parsePasskeyPublicKey(passkey.PublicKey, passkey.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	message := append(slices.Clone(assertion.AuthenticatorData), clientDataHash[:]...)
+	if !verifyPasskeySignature(publicKey, message, assertion.Signature) {
+		return nil, errors.New("invalid passkey signature")
+	}
+	// Authenticators without a signature counter always report zero, so they are only protected
+	// against replays by the single-use challenge.
+	if (authData.signCount != 0 || passkey.SignCount != 0) && authData.signCount <= passkey.SignCount {
+		return nil, errors.New("passkey sign counter did not increase")
+	}
+
+	user, err := a.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, errors.Errorf("user %d not found", userID)
+	}
+	if user.RowStatus == store.Archived {
+		return nil, errors.Errorf("user %d is archived", userID)
+	}
+	if user.SuspendedTs != 0 {
+		return nil, errors.Errorf("user %d is suspended", userID)
+	}
+
+	if err := a.store.UpdateUserPasskeySignCount(ctx, userID, credentialID, authData.signCount, timestamppb.Now()); err != nil {
+		return nil, errors.Wrap(err, "failed to update passkey")
+	}
+	return user, nil
+}
+
+// verifyPasskeyCeremony verifies the client data and authenticator data of a passkey ceremony
+// and returns the claims of its challenge. The challenge is consumed, so the ceremony can't be
+// verified again, even if a later check fails.
+func (a *Authenticator) verifyPasskeyCeremony(ctx context.Context, rp *RelyingParty, ceremonyType, audience string, clientDataJSON, rawAuthenticatorData []byte) (*ClaimsMessage, *authenticatorData, error) {
+	data := &clientData{}
+	if err := json.Unmarshal(clientDataJSON, data); err != nil {
+		return nil, nil, errors.Wrap(err, "malformed passkey client data")
+	}
+	if data.Type != ceremonyType {
+		return nil, nil, errors.Errorf("unexpected passkey ceremony %q", data.Type)
+	}
+	if data.Origin != rp.Origin {
+		return nil, nil, errors.Errorf("unexpected passkey origin %q", data.Origin)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(data.Challenge)
+	if err != nil {
+		return nil, nil, errors.New("malformed passkey challenge")
+	}
+	claims, err := a.parseShortLivedToken(ctx, string(challenge), audience)
+	if err != nil {
+		return nil, nil, errors.New("invalid or expired passkey challenge")
+	}
+	challengeHash := hashToken(string(challenge))
+	consumed, err := a.store.DeletePasskeyChallenges(ctx, &store.DeletePasskeyChallenge{ChallengeHash: &challengeHash})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to consume passkey challenge")
+	}
+	if !consumed {
+		return nil, nil, errors.New("passkey challenge was already used")
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthenticatorData)
+	if err != nil {
+		return nil, nil, err
+	}
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return nil, nil, errors.New("passkey relying party ID mismatch")
+	}
+	if authData.flags&authenticatorDataFlagUserPresent == 0 {
+		return nil, nil, errors.New("passkey user presence not verified")
+	}
+	return claims, authData, nil
+}
+
+// parseAuthenticatorData parses WebAuthn authenticator data.
+//
+// Layout: rpIdHash (32) | flags (1) | signCount (4) | [aaguid (16) | credentialIdLength (2) | credentialId | credentialPublicKey].
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("malformed passkey authenticator data")
+	}
+	authData := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	if authData.flags&authenticatorDataFlagAttestedCredentialData != 0 {
+		attested := data[37:]
+		if len(attested) < 18 {
+			return nil, errors.New("malformed passkey attested credential data")
+		}
+		length := int(binary.BigEndian.Uint16(attested[16:18]))
+		if len(attested) < 18+length {
+			return nil, errors.New("malformed passkey attested credential data")
+		}
+		authData.credentialID = attested[18 : 18+length]
+	}
+	return authData, nil
+}
+
+// parsePasskeyPublicKey parses a DER-encoded public key and checks it matches algorithm.
+func parsePasskeyPublicKey(der []byte, algorithm int64) (crypto.PublicKey, error) {
+	publicKey, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed passkey public key")
+	}
+	valid := false
+	switch algorithm {
+	case PasskeyAlgorithmES256:
+		key, ok := publicKey.(*ecdsa.PublicKey)
+		valid = ok && key.Curve == elliptic.P256()
+	case PasskeyAlgorithmEdDSA:
+		_, valid = publicKey.(ed25519.PublicKey)
+	case PasskeyAlgorithmRS256:
+		_, valid = publicKey.(*rsa.PublicKey)
+	default:
+		return nil, errors.Errorf("unsupported passkey algorithm %d", algorithm)
+	}
+	if !valid {
+		return nil, errors.Errorf("passkey public key doesn't match algorithm %d", algorithm)
+	}
+	return publicKey, nil
+}
+
+// verifyPasskeySignature verifies an assertion signature over message.
+func verifyPasskeySignature(publicKey crypto.PublicKey, message, signature []byte) bool {
+	digest := sha256.Sum256(message)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return false
+	}
+}
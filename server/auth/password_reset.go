@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// PasswordResetTokenDuration is how long a password reset token can be used.
+	PasswordResetTokenDuration = 30 * time.Minute
+
+	// PasswordResetRequestInterval is the minimum time between two reset emails to the same
+	// user, so the request endpoint can't be used to flood a mailbox.
+	PasswordResetRequestInterval = time.Minute
+)
+
+// ErrPasswordResetTooFrequent is returned by CreatePasswordResetToken if a token was issued
+// to the user within PasswordResetRequestInterval.
+var ErrPasswordResetTooFrequent = errors.New("a password reset was requested recently")
+
+// passwordResetMu serializes password resets, so a token can only be used once.
+var passwordResetMu sync.Mutex
+
+// CreatePasswordResetToken issues a single-use password reset token for a user.
+//
+// The token is stored hashed and replaces any previous token of the user. Expired tokens of
+// all users are deleted along the way.
+func (a *Authenticator) CreatePasswordResetToken(ctx context.Context, userID int32) (string, error) {
+	now := time.Now()
+	nowSec := now.Unix()
+	if err := a.store.DeletePasswordResetTokens(ctx, &store.DeletePasswordResetToken{ExpiresBefore: &nowSec}); err != nil {
+		return "", errors.Wrap(err, "failed to delete expired password reset tokens")
+	}
+	existing, err := a.store.GetPasswordResetToken(ctx, &store.FindPasswordResetToken{UserID: &userID})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get password reset token")
+	}
+	if existing != nil && now.Sub(time.Unix(existing.CreatedTs, 0)) < PasswordResetRequestInterval {
+		return "", ErrPasswordResetTooFrequent
+	}
+	if err := a.store.DeletePasswordResetTokens(ctx, &store.DeletePasswordResetToken{UserID: &userID}); err != nil {
+		return "", errors.Wrap(err, "failed to delete password reset tokens")
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.Wrap(err, "failed to generate password reset token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	create := &store.PasswordResetToken{
+		TokenHash: hashToken(token),
+		UserID:    userID,
+		CreatedTs: nowSec,
+		ExpiresTs: now.Add(PasswordResetTokenDuration).Unix(),
+	}
+	if _, err := a.store.CreatePasswordResetToken(ctx, create); err != nil {
+		return "", errors.Wrap(err, "failed to create password reset token")
+	}
+	return token, nil
+}
+
+// ResetPassword sets a new password with a token from CreatePasswordResetToken and returns
+// the user.
+//
+// The token is consumed, and all sessions of the user are revoked, so anyone signed in with
+// the old password is signed out. The reset is recorded in the audit log.
+func (a *Authenticator) ResetPassword(ctx context.Context, token, newPassword string) (*store.User, error) {
+	if token == "" {
+		return nil, errors.New("password reset token not found")
+	}
+
+	passwordResetMu.Lock()
+	defer passwordResetMu.Unlock()
+
+	tokenHash := hashToken(token)
+	stored, err := a.store.GetPasswordResetToken(ctx, &store.FindPasswordResetToken{TokenHash: &tokenHash})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get password reset token")
+	}
+	if stored == nil || stored.ExpiresTs <= time.Now().Unix() {
+		return nil, errors.New("invalid or expired password reset token")
+	}
+	if err := a.store.DeletePasswordResetTokens(ctx, &store.DeletePasswordResetToken{UserID: &stored.UserID}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete password reset tokens")
+	}
+
+	user, err := a.store.GetUser(ctx, &store.FindUser{ID: &stored.UserID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, errors.Errorf("user %d not found", stored.UserID)
+	}
+	if user.RowStatus == store.Archived {
+		return nil, errors.Errorf("user %d is archived", stored.UserID)
+	}
+	if user.SuspendedTs != 0 {
+		return nil, errors.Errorf("user %d is suspended", stored.UserID)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate password hash")
+	}
+	passwordHashStr := string(passwordHash)
+	user, err = a.store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update password")
+	}
+
+	if err := a.store.DeleteRefreshTokens(ctx, &store.DeleteRefreshToken{UserID: &user.ID}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete refresh tokens")
+	}
+	if err := a.store.RemoveOtherUserSessions(ctx, user.ID, ""); err != nil {
+		return nil, errors.Wrap(err, "failed to remove user sessions")
+	}
+	a.RecordAuditLog(ctx, store.AuditEventPasswordReset, user.ID, map[string]any{"user_id": user.ID})
+	return user, nil
+}
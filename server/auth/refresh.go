@@ -0,0 +1,273 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// SessionAccessTokenAudienceName is the audience claim for the short-lived access tokens
+	// of browser sessions. Unlike user access tokens, they are not stored server-side.
+	SessionAccessTokenAudienceName = "user.session-access-token"
+
+	// SessionAccessTokenDuration is how long a session access token is valid.
+	// A stolen access token is usable for at most this long.
+	SessionAccessTokenDuration = 15 * time.Minute
+
+	// RefreshTokenReuseGracePeriod is how long a rotated refresh token may be presented again
+	// without being treated as stolen, e.g. by another browser tab refreshing concurrently.
+	// Such requests are rejected, but the session is kept.
+	RefreshTokenReuseGracePeriod = 30 * time.Second
+
+	// AccessTokenCookieName is the HTTP cookie name used to store the session access token.
+	AccessTokenCookieName = "user_access_token"
+
+	// RefreshTokenCookieName is the HTTP cookie name used to store the refresh token.
+	RefreshTokenCookieName = "user_refresh_token"
+)
+
+var (
+	// ErrRefreshTokenRotated is returned for a refresh token that was rotated within
+	// RefreshTokenReuseGracePeriod. The session is kept, and its current tokens stay valid.
+	ErrRefreshTokenRotated = errors.New("refresh token was already rotated")
+
+	// ErrRefreshTokenReused is returned for a refresh token that was rotated before
+	// RefreshTokenReuseGracePeriod. The token may have been stolen, so the session is revoked.
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected, session revoked")
+)
+
+// refreshTokenMu serializes refresh token rotations, so a token can only be rotated once.
+var refreshTokenMu sync.Mutex
+
+// TokenPair is a session access token with the refresh token to renew it.
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// hashToken hashes a refresh or password reset token for storage. The tokens are random, so
+// a fast hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IssueTokenPair issues a session access token and a refresh token for a session.
+//
+// The refresh token is stored hashed; rotating it with RotateRefreshToken issues the next
+// pair of the same session. It can be exchanged for the idle timeout of the session policy, so
+// sessions expire after that much inactivity, and never past the session's absolute lifetime.
+func (a *Authenticator) IssueTokenPair(ctx context.Context, user *store.User, sessionID string) (*TokenPair, error) {
+	policy, err := a.SessionPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := a.store.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user sessions")
+	}
+
+	now := time.Now()
+	accessTokenExpiresAt := now.Add(SessionAccessTokenDuration)
+	accessToken, err := a.signClaims(ctx, &ClaimsMessage{
+		Name:      user.Username,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{SessionAccessTokenAudienceName},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(accessTokenExpiresAt),
+			Subject:   fmt.Sprint(user.ID),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate access token")
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to generate refresh token")
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(raw)
+	refreshTokenExpiresAt := now.Add(policy.IdleTimeout)
+	for _, session := range sessions {
+		absoluteExpireTime := policy.AbsoluteExpireTime(session)
+		if session.SessionId == sessionID && !absoluteExpireTime.IsZero() && absoluteExpireTime.Before(refreshTokenExpiresAt) {
+			refreshTokenExpiresAt = absoluteExpireTime
+		}
+	}
+	create := &store.RefreshToken{
+		TokenHash: hashToken(refreshToken),
+		UserID:    user.ID,
+		SessionID: sessionID,
+		CreatedTs: now.Unix(),
+		ExpiresTs: refreshTokenExpiresAt.Unix(),
+	}
+	if _, err := a.store.CreateRefreshToken(ctx, create); err != nil {
+		return nil, errors.Wrap(err, "failed to create refresh token")
+	}
+
+	return &TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+	}, nil
+}
+
+// RotateRefreshToken exchanges a refresh token for a new token pair of the same session and
+// returns it with the session's user.
+//
+// Validation steps:
+// 1. Verify the refresh token exists and hasn't expired
+// 2. Detect reuse of a rotated token: within RefreshTokenReuseGracePeriod the request is
+// rejected with ErrRefreshTokenRotated, afterwards the session is revoked (ErrRefreshTokenReused)
+// 3. Verify user exists and is neither archived nor suspended
+// 4. Verify the session still exists, i.e. it wasn't signed out or revoked
+//
+// On success the presented token is marked as rotated and the session's last accessed time
+// is updated.
+func (a *Authenticator) RotateRefreshToken(ctx context.Context, refreshToken string) (*TokenPair, *store.User, error) {
+	if refreshToken == "" {
+		return nil, nil, errors.New("refresh token not found")
+	}
+
+	refreshTokenMu.Lock()
+	defer refreshTokenMu.Unlock()
+
+	tokenHash := hashToken(refreshToken)
+	stored, err := a.store.GetRefreshToken(ctx, &store.FindRefreshToken{TokenHash: &tokenHash})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get refresh token")
+	}
+	now := time.Now()
+	if stored == nil || stored.ExpiresTs <= now.Unix() {
+		return nil, nil, errors.New("invalid or expired refresh token")
+	}
+	if stored.RotatedTs != 0 {
+		if now.Sub(time.Unix(stored.RotatedTs, 0)) <= RefreshTokenReuseGracePeriod {
+			return nil, nil, ErrRefreshTokenRotated
+		}
+		if err := a.RevokeSession(ctx, stored.UserID, stored.SessionID, "refresh_token_reuse"); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, ErrRefreshTokenReused
+	}
+
+	user, err := a.store.GetUser(ctx, &store.FindUser{ID: &stored.UserID})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, nil, errors.Errorf("user %d not found", stored.UserID)
+	}
+	if user.RowStatus == store.Archived {
+		return nil, nil, errors.Errorf("user %d is archived", stored.UserID)
+	}
+	if user.SuspendedTs != 0 {
+		return nil, nil, errors.Errorf("user %d is suspended", stored.UserID)
+	}
+	sessions, err := a.store.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get user sessions")
+	}
+	policy, err := a.SessionPolicy(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !validateSession(stored.SessionID, sessions, policy) {
+		return nil, nil, errors.New("invalid or expired session")
+	}
+
+	rotatedSec := now.Unix()
+	if err := a.store.UpdateRefreshToken(ctx, &store.UpdateRefreshToken{TokenHash: tokenHash, RotatedTs: &rotatedSec}); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to update refresh token")
+	}
+	tokenPair, err := a.IssueTokenPair(ctx, user, stored.SessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	_ = a.store.UpdateUserSessionLastAccessed(ctx, user.ID, stored.SessionID, timestamppb.Now())
+	return tokenPair, user, nil
+}
+
+// RevokeSession removes a session and deletes all refresh tokens issued for it, which also
+// invalidates the session's access tokens. The reason is recorded in the audit log.
+func (a *Authenticator) RevokeSession(ctx context.Context, userID int32, sessionID, reason string) error {
+	if err := a.store.DeleteRefreshTokens(ctx, &store.DeleteRefreshToken{SessionID: &sessionID}); err != nil {
+		return errors.Wrap(err, "failed to delete refresh tokens")
+	}
+	if err := a.store.RemoveUserSession(ctx, userID, sessionID); err != nil {
+		return errors.Wrap(err, "failed to remove user session")
+	}
+	a.RecordAuditLog(ctx, store.AuditEventSessionRevoked, GetUserID(ctx), map[string]any{"user_id": userID, "reason": reason})
+	return nil
+}
+
+// AuthenticateBySessionAccessToken validates a session access token issued by IssueTokenPair
+// and returns the authenticated user and the session ID.
+//
+// The session must still exist, so signing out or revoking a session takes effect
+// immediately rather than when the access token expires.
+func (a *Authenticator) AuthenticateBySessionAccessToken(ctx context.Context, accessToken string) (*store.User, string, error) {
+	result := authenticateOnce(ctx, "session_access_token", accessToken, func() *requestAuthentication {
+		user, sessionID, err := a.validateSessionAccessToken(ctx, accessToken)
+		return &requestAuthentication{user: user, sessionID: sessionID, err: err}
+	})
+	return result.user, result.sessionID, result.err
+}
+
+func (a *Authenticator) validateSessionAccessToken(ctx context.Context, accessToken string) (*store.User, string, error) {
+	if accessToken == "" {
+		return nil, "", errors.New("access token not found")
+	}
+	claims, err := a.parseShortLivedToken(ctx, accessToken, SessionAccessTokenAudienceName)
+	if err != nil || claims.SessionID == "" {
+		return nil, "", errors.New("invalid or expired access token")
+	}
+
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "malformed ID in token")
+	}
+	user, err := a.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, "", errors.Errorf("user %d not found", userID)
+	}
+	if user.RowStatus == store.Archived {
+		return nil, "", errors.Errorf("user %d is archived", userID)
+	}
+	if user.SuspendedTs != 0 {
+		return nil, "", errors.Errorf("user %d is suspended", userID)
+	}
+	sessions, err := a.store.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get user sessions")
+	}
+	policy, err := a.SessionPolicy(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if !validateSession(claims.SessionID, sessions, policy) {
+		return nil, "", errors.New("invalid or expired session")
+	}
+	return user, claims.SessionID, nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"sync"
+
+	"github.com/usememos/memos/store"
+)
+
+// requestAuthenticationsKey is the context key of the authentications of a request.
+type requestAuthenticationsKey struct{}
+
+// requestAuthentications holds the results of the authentications made for a request, so a
+// request authenticated by a middleware and then by its handler validates its credentials, with
+// the lookups and side effects of that, only once.
+type requestAuthentications struct {
+	mu      sync.Mutex
+	results map[string]*requestAuthentication
+}
+
+type requestAuthentication struct {
+	user      *store.User
+	sessionID string
+	scopes    []string
+	err       error
+}
+
+// WithRequestAuthentications returns a context that remembers the results of the session, session
+// access token and JWT authentications made with it, to be used as the context of a request.
+func WithRequestAuthentications(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(requestAuthenticationsKey{}).(*requestAuthentications); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, requestAuthenticationsKey{}, &requestAuthentications{
+		results: map[string]*requestAuthentication{},
+	})
+}
+
+// authenticateOnce returns the result of the earlier authentication of the credential with the
+// method if the context remembers it, or else runs authenticate and remembers its result.
+func authenticateOnce(ctx context.Context, method, credential string, authenticate func() *requestAuthentication) *requestAuthentication {
+	authentications, ok := ctx.Value(requestAuthenticationsKey{}).(*requestAuthentications)
+	if !ok {
+		return authenticate()
+	}
+	key := method + ":" + credential
+	authentications.mu.Lock()
+	defer authentications.mu.Unlock()
+	if result, ok := authentications.results[key]; ok {
+		return result
+	}
+	result := authenticate()
+	authentications.results[key] = result
+	return result
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// Access token scopes.
+//
+// Scopes limit what an access token may do on behalf of its user:
+// - read: Call read-only methods (Get*, List*, Search*)
+// - write: Call any method; implies read
+// - admin: Use the user's Host or Admin privileges; without it the token acts as a regular user
+//
+// Tokens without scopes, including those issued before scopes existed, have full access.
+// Session cookies always have full access.
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+)
+
+// AllScopes lists every scope in the order they are displayed.
+var AllScopes = []string{ScopeRead, ScopeWrite, ScopeAdmin}
+
+// readOnlyMethodPrefixes are method name prefixes of methods that don't mutate state.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Search", "Count"}
+
+// ValidateScopes checks scopes requested for a new access token by a user with the given role.
+// Users can't mint the admin scope unless they are a Host or Admin.
+func ValidateScopes(scopes []string, role store.Role) error {
+	for _, scope := range scopes {
+		if !slices.Contains(AllScopes, scope) {
+			return errors.Errorf("unknown scope %q", scope)
+		}
+		if scope == ScopeAdmin && role != store.RoleHost && role != store.RoleAdmin {
+			return errors.Errorf("scope %q requires an admin user", scope)
+		}
+	}
+	return nil
+}
+
+// HasScope reports whether scopes grant scope. Empty scopes grant full access, and write implies read.
+func HasScope(scopes []string, scope string) bool {
+	if len(scopes) == 0 || slices.Contains(scopes, scope) {
+		return true
+	}
+	return scope == ScopeRead && slices.Contains(scopes, ScopeWrite)
+}
+
+// RequiredScope returns the scope needed to call procedure: read for read-only methods, write otherwise.
+// Procedure format: "/{package}.{service}/{method}".
+func RequiredScope(procedure string) string {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return ScopeRead
+		}
+	}
+	return ScopeWrite
+}
+
+// ApplyScopes returns the user as seen through scopes.
+// Without the admin scope, a Host or Admin user is treated as a regular user.
+func ApplyScopes(user *store.User, scopes []string) *store.User {
+	if user == nil || HasScope(scopes, ScopeAdmin) || (user.Role != store.RoleHost && user.Role != store.RoleAdmin) {
+		return user
+	}
+	// Copy the user, which may be shared through the store cache.
+	scoped := *user
+	scoped.Role = store.RoleUser
+	return &scoped
+}
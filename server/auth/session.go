@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// SessionPolicy is the lifetime of browser sessions, configured in the instance general setting.
+type SessionPolicy struct {
+	// IdleTimeout is how long a session stays valid without being used.
+	IdleTimeout time.Duration
+	// AbsoluteLifetime caps the lifetime of a session from sign-in, however often it is used.
+	// Zero means no cap.
+	AbsoluteLifetime time.Duration
+}
+
+// GetSessionPolicy returns the session policy of the instance general setting.
+func GetSessionPolicy(ctx context.Context, st *store.Store) (*SessionPolicy, error) {
+	instanceGeneralSetting, err := st.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance general setting")
+	}
+	return &SessionPolicy{
+		IdleTimeout:      time.Duration(instanceGeneralSetting.SessionIdleTimeoutHours) * time.Hour,
+		AbsoluteLifetime: time.Duration(instanceGeneralSetting.SessionAbsoluteLifetimeHours) * time.Hour,
+	}, nil
+}
+
+// AbsoluteExpireTime returns when the session expires however often it is used, or the zero
+// time if the lifetime isn't capped.
+func (p *SessionPolicy) AbsoluteExpireTime(session *storepb.SessionsUserSetting_Session) time.Time {
+	if p.AbsoluteLifetime <= 0 || session.CreateTime == nil {
+		return time.Time{}
+	}
+	return session.CreateTime.AsTime().Add(p.AbsoluteLifetime)
+}
+
+// ExpireTime returns when the session expires if it isn't used again: the idle timeout after
+// it was last accessed, capped by the absolute lifetime. Returns the zero time for a session
+// without timestamps, which never expires.
+func (p *SessionPolicy) ExpireTime(session *storepb.SessionsUserSetting_Session) time.Time {
+	lastAccessedTime := session.LastAccessedTime
+	if lastAccessedTime == nil {
+		lastAccessedTime = session.CreateTime
+	}
+	var expireTime time.Time
+	if lastAccessedTime != nil {
+		expireTime = lastAccessedTime.AsTime().Add(p.IdleTimeout)
+	}
+	if absolute := p.AbsoluteExpireTime(session); !absolute.IsZero() && (expireTime.IsZero() || absolute.Before(expireTime)) {
+		expireTime = absolute
+	}
+	return expireTime
+}
+
+// IsExpired reports whether the session is expired at now.
+func (p *SessionPolicy) IsExpired(session *storepb.SessionsUserSetting_Session, now time.Time) bool {
+	expireTime := p.ExpireTime(session)
+	return !expireTime.IsZero() && !expireTime.After(now)
+}
+
+// SessionPolicy returns the session policy of the instance.
+func (a *Authenticator) SessionPolicy(ctx context.Context) (*SessionPolicy, error) {
+	return GetSessionPolicy(ctx, a.store)
+}
+
+// validateSession checks if a session exists and hasn't expired under the session policy.
+// Sliding expiration: the idle timeout counts from the last access, see touchSession.
+func validateSession(sessionID string, sessions []*storepb.SessionsUserSetting_Session, policy *SessionPolicy) bool {
+	for _, session := range sessions {
+		if sessionID == session.SessionId {
+			return !policy.IsExpired(session, time.Now())
+		}
+	}
+	return false // Session not found
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// ShareAccessTokenAudienceName is the audience claim of the tokens granting access to the memo
+	// of a share link with a passphrase.
+	ShareAccessTokenAudienceName = "memo-share.access-token"
+
+	// ShareAccessTokenDuration is how long a share access token can be used.
+	ShareAccessTokenDuration = time.Hour
+)
+
+// ShareLoginIdentifier returns the throttling identifier of the passphrase attempts of a share
+// link. Attempts are counted per link, whoever makes them.
+func ShareLoginIdentifier(uid string) string {
+	return "share:" + uid
+}
+
+// GenerateShareAccessToken issues a token granting access to the memo of a share link, once its
+// passphrase has been entered, and returns it with its expiry.
+//
+// The token is bound to the passphrase hash of the link, so changing the passphrase invalidates
+// the tokens issued for the previous one.
+func (a *Authenticator) GenerateShareAccessToken(ctx context.Context, share *store.MemoShare) (string, time.Time, error) {
+	now := time.Now()
+	expireTime := now.Add(ShareAccessTokenDuration)
+	token, err := a.signClaims(ctx, &ClaimsMessage{
+		ShareVersion: sharePassphraseVersion(share.PassphraseHash),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{ShareAccessTokenAudienceName},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expireTime),
+			Subject:   share.UID,
+		},
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expireTime, nil
+}
+
+// VerifyShareAccessToken checks a token from GenerateShareAccessToken against a share link.
+func (a *Authenticator) VerifyShareAccessToken(ctx context.Context, share *store.MemoShare, token string) bool {
+	if token == "" {
+		return false
+	}
+	claims, err := a.parseShortLivedToken(ctx, token, ShareAccessTokenAudienceName)
+	if err != nil {
+		return false
+	}
+	return claims.Subject == share.UID &&
+		subtle.ConstantTimeCompare([]byte(claims.ShareVersion), []byte(sharePassphraseVersion(share.PassphraseHash))) == 1
+}
+
+// sharePassphraseVersion identifies the passphrase of a share link in its access tokens without
+// revealing the hash. bcrypt salts each hash, so setting the same passphrase again changes it.
+func sharePassphraseVersion(passphraseHash string) string {
+	sum := sha256.Sum256([]byte(passphraseHash))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+var (
+	// ErrSigningKeyNotFound is returned by ExpireSigningKey for an unknown key ID.
+	ErrSigningKeyNotFound = errors.New("signing key not found")
+
+	// ErrSigningKeyCurrent is returned by ExpireSigningKey for the current signing key, which
+	// has to be rotated before it can be expired.
+	ErrSigningKeyCurrent = errors.New("the current signing key can't be expired, rotate it first")
+)
+
+// signingKeyMu serializes signing key changes, so there is always exactly one current key.
+var signingKeyMu sync.Mutex
+
+// signingKeySet is the key that signs new tokens and the keys that verify tokens.
+type signingKeySet struct {
+	currentID string
+	// accepted maps the key ID to the secret of every key that still verifies tokens,
+	// including the current key.
+	accepted map[string][]byte
+}
+
+// signingKeys returns the signing key set.
+//
+// Until the first rotation there are no stored keys, and the legacy key KeyID signs and
+// verifies all tokens with the server secret.
+func (a *Authenticator) signingKeys(ctx context.Context) (*signingKeySet, error) {
+	keys, err := a.store.ListSigningKeys(ctx, &store.FindSigningKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list signing keys")
+	}
+	if len(keys) == 0 {
+		return &signingKeySet{currentID: KeyID, accepted: map[string][]byte{KeyID: []byte(a.secret)}}, nil
+	}
+
+	set := &signingKeySet{accepted: map[string][]byte{}}
+	nowSec := time.Now().Unix()
+	for _, key := range keys {
+		if key.RetiredTs == 0 {
+			set.currentID = key.ID
+		} else if key.ExpiresTs != 0 && key.ExpiresTs <= nowSec {
+			continue
+		}
+		set.accepted[key.ID] = a.signingKeySecret(key)
+	}
+	if set.currentID == "" {
+		return nil, errors.New("no current signing key")
+	}
+	return set, nil
+}
+
+// signingKeySecret returns the HMAC secret of a key. The legacy key uses the server secret.
+func (a *Authenticator) signingKeySecret(key *store.SigningKey) []byte {
+	if key.Secret == "" {
+		return []byte(a.secret)
+	}
+	return []byte(key.Secret)
+}
+
+// verificationKey returns a jwt.Keyfunc that resolves the key of a token from its kid header.
+// Tokens signed with an unknown or expired key are rejected.
+func (a *Authenticator) verificationKey(ctx context.Context) jwt.Keyfunc {
+	return func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		kid, ok := t.Header["kid"].(string)
+		if !ok {
+			return nil, errors.Errorf("unexpected kid: %v", t.Header["kid"])
+		}
+		keys, err := a.signingKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		secret, ok := keys.accepted[kid]
+		if !ok {
+			return nil, errors.Errorf("unknown or expired kid: %s", kid)
+		}
+		return secret, nil
+	}
+}
+
+// ParseTokenClaims verifies the signature of a token and returns its claims, without
+// validating them. Tokens signed with an unknown or expired key are rejected.
+func (a *Authenticator) ParseTokenClaims(ctx context.Context, token string) (*ClaimsMessage, error) {
+	claims := &ClaimsMessage{}
+	if _, err := jwt.ParseWithClaims(token, claims, a.verificationKey(ctx), jwt.WithoutClaimsValidation()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// ListSigningKeys returns the signing keys, newest first.
+//
+// Before the first rotation, only the legacy key KeyID is returned. It is not stored, so its
+// created time is zero.
+func (a *Authenticator) ListSigningKeys(ctx context.Context) ([]*store.SigningKey, error) {
+	keys, err := a.store.ListSigningKeys(ctx, &store.FindSigningKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list signing keys")
+	}
+	if len(keys) == 0 {
+		return []*store.SigningKey{{ID: KeyID}}, nil
+	}
+	return keys, nil
+}
+
+// RotateSigningKey creates a new current signing key and returns it.
+//
+// The previous key is retired: it no longer signs tokens, but keeps verifying the tokens it
+// signed for gracePeriod, or until it is expired with ExpireSigningKey if gracePeriod is zero.
+// Existing sessions and access tokens therefore keep working across a rotation.
+func (a *Authenticator) RotateSigningKey(ctx context.Context, gracePeriod time.Duration) (*store.SigningKey, error) {
+	signingKeyMu.Lock()
+	defer signingKeyMu.Unlock()
+
+	keys, err := a.store.ListSigningKeys(ctx, &store.FindSigningKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list signing keys")
+	}
+	now := time.Now()
+	nowSec := now.Unix()
+	var expiresSec int64
+	if gracePeriod > 0 {
+		expiresSec = now.Add(gracePeriod).Unix()
+	}
+
+	if len(keys) == 0 {
+		// Store the legacy key, so tokens it signed stay valid after the rotation.
+		legacy := &store.SigningKey{ID: KeyID, RetiredTs: nowSec, ExpiresTs: expiresSec}
+		if _, err := a.store.CreateSigningKey(ctx, legacy); err != nil {
+			return nil, errors.Wrap(err, "failed to create legacy signing key")
+		}
+		keys = append(keys, legacy)
+	}
+	for _, key := range keys {
+		if key.RetiredTs != 0 {
+			continue
+		}
+		update := &store.UpdateSigningKey{ID: key.ID, RetiredTs: &nowSec, ExpiresTs: &expiresSec}
+		if err := a.store.UpdateSigningKey(ctx, update); err != nil {
+			return nil, errors.Wrap(err, "failed to retire signing key")
+		}
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to generate signing key")
+	}
+	key, err := a.store.CreateSigningKey(ctx, &store.SigningKey{
+		ID:        nextSigningKeyID(keys),
+		Secret:    base64.RawURLEncoding.EncodeToString(raw),
+		CreatedTs: nowSec,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create signing key")
+	}
+	a.RecordAuditLog(ctx, store.AuditEventSigningKeyRotated, GetUserID(ctx), map[string]any{
+		"key_id":       key.ID,
+		"grace_period": gracePeriod.String(),
+	})
+	return key, nil
+}
+
+// ExpireSigningKey expires a retired signing key immediately, so tokens signed with it are
+// rejected from now on. Expiring an already expired key does nothing.
+func (a *Authenticator) ExpireSigningKey(ctx context.Context, id string) error {
+	signingKeyMu.Lock()
+	defer signingKeyMu.Unlock()
+
+	key, err := a.store.GetSigningKey(ctx, &store.FindSigningKey{ID: &id})
+	if err != nil {
+		return errors.Wrap(err, "failed to get signing key")
+	}
+	if key == nil {
+		if id == KeyID {
+			// The legacy key is current until the first rotation.
+			return ErrSigningKeyCurrent
+		}
+		return ErrSigningKeyNotFound
+	}
+	if key.RetiredTs == 0 {
+		return ErrSigningKeyCurrent
+	}
+	nowSec := time.Now().Unix()
+	if key.ExpiresTs != 0 && key.ExpiresTs <= nowSec {
+		return nil
+	}
+	if err := a.store.UpdateSigningKey(ctx, &store.UpdateSigningKey{ID: key.ID, ExpiresTs: &nowSec}); err != nil {
+		return errors.Wrap(err, "failed to expire signing key")
+	}
+	a.RecordAuditLog(ctx, store.AuditEventSigningKeyExpired, GetUserID(ctx), map[string]any{"key_id": key.ID})
+	return nil
+}
+
+// nextSigningKeyID returns the key ID following the legacy key "v1": "v2", "v3" and so on.
+func nextSigningKeyID(keys []*store.SigningKey) string {
+	version := 1
+	for _, key := range keys {
+		if n, err := strconv.Atoi(strings.TrimPrefix(key.ID, "v")); err == nil && n > version {
+			version = n
+		}
+	}
+	return fmt.Sprintf("v%d", version+1)
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// LoginFailuresBeforeDelay is the number of failed sign-ins allowed before each further
+	// attempt has to wait, starting at one second and doubling with each failure.
+	LoginFailuresBeforeDelay = 5
+
+	// LoginFailuresBeforeLock is the number of failed sign-ins within LoginFailureWindow
+	// after which sign-in is locked for LoginLockDuration.
+	LoginFailuresBeforeLock = 15
+
+	// LoginFailureWindow is how long failed sign-ins are counted. The count starts over
+	// once the first counted failure is older than the window.
+	LoginFailureWindow = time.Hour
+
+	// LoginLockDuration is how long sign-in stays locked after too many failures.
+	LoginLockDuration = 30 * time.Minute
+
+	// maxLoginDelay caps the delay between attempts before the lock kicks in.
+	maxLoginDelay = 15 * time.Minute
+)
+
+// loginAttemptMu serializes updates of the failure counters within this process.
+var loginAttemptMu sync.Mutex
+
+// LoginThrottledError is returned when sign-in is refused because of previous failures.
+type LoginThrottledError struct {
+	// RetryAfter is how long to wait before trying again.
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	if minutes := e.RetryAfterMinutes(); minutes > 1 {
+		return fmt.Sprintf("too many failed sign-in attempts, try again in %d minutes", minutes)
+	}
+	return "too many failed sign-in attempts, try again in 1 minute"
+}
+
+// RetryAfterMinutes returns RetryAfter rounded up to whole minutes.
+func (e *LoginThrottledError) RetryAfterMinutes() int {
+	return int((e.RetryAfter + time.Minute - 1) / time.Minute)
+}
+
+// AccountLoginIdentifier returns the throttling identifier of a username.
+// Usernames that don't exist are throttled the same way, so the response doesn't reveal
+// whether an account exists.
+func AccountLoginIdentifier(username string) string {
+	return "user:" + username
+}
+
+// IPLoginIdentifier returns the throttling identifier of a client IP address, or an empty
+// string if the address is unknown.
+func IPLoginIdentifier(ip string) string {
+	if ip == "" {
+		return ""
+	}
+	return "ip:" + ip
+}
+
+// loginDelay returns how long an identifier has to wait before its next attempt.
+func loginDelay(attempt *store.LoginAttempt, now time.Time) time.Duration {
+	if attempt.LockedUntilTs > now.Unix() {
+		return time.Unix(attempt.LockedUntilTs, 0).Sub(now)
+	}
+	if time.Unix(attempt.FirstFailedTs, 0).Add(LoginFailureWindow).Before(now) {
+		return 0
+	}
+	if attempt.FailureCount < LoginFailuresBeforeDelay {
+		return 0
+	}
+	delay := maxLoginDelay
+	if shift := attempt.FailureCount - LoginFailuresBeforeDelay; shift < 10 {
+		delay = min(time.Second<<shift, maxLoginDelay)
+	}
+	return time.Unix(attempt.LastFailedTs, 0).Add(delay).Sub(now)
+}
+
+// CheckLoginThrottle returns a *LoginThrottledError if any of the identifiers has to wait
+// before its next sign-in attempt. Empty identifiers are ignored.
+func (a *Authenticator) CheckLoginThrottle(ctx context.Context, identifiers ...string) error {
+	now := time.Now()
+	var retryAfter time.Duration
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			continue
+		}
+		attempt, err := a.store.GetLoginAttempt(ctx, &store.FindLoginAttempt{Identifier: &identifier})
+		if err != nil {
+			return errors.Wrap(err, "failed to get login attempt")
+		}
+		if attempt == nil {
+			continue
+		}
+		retryAfter = max(retryAfter, loginDelay(attempt, now))
+	}
+	if retryAfter > 0 {
+		return &LoginThrottledError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RecordLoginFailure counts a failed sign-in attempt for each of the identifiers and locks
+// the ones that reached LoginFailuresBeforeLock. Empty identifiers are ignored.
+func (a *Authenticator) RecordLoginFailure(ctx context.Context, identifiers ...string) error {
+	loginAttemptMu.Lock()
+	defer loginAttemptMu.Unlock()
+
+	now := time.Now()
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			continue
+		}
+		attempt, err := a.store.GetLoginAttempt(ctx, &store.FindLoginAttempt{Identifier: &identifier})
+		if err != nil {
+			return errors.Wrap(err, "failed to get login attempt")
+		}
+		if attempt == nil || time.Unix(attempt.FirstFailedTs, 0).Add(LoginFailureWindow).Before(now) {
+			attempt = &store.LoginAttempt{
+				Identifier:    identifier,
+				FirstFailedTs: now.Unix(),
+			}
+		}
+		attempt.FailureCount++
+		attempt.LastFailedTs = now.Unix()
+		if attempt.FailureCount >= LoginFailuresBeforeLock {
+			attempt.LockedUntilTs = now.Add(LoginLockDuration).Unix()
+		}
+		if _, err := a.store.UpsertLoginAttempt(ctx, attempt); err != nil {
+			return errors.Wrap(err, "failed to update login attempt")
+		}
+	}
+	return nil
+}
+
+// ResetLoginThrottle clears the failed sign-in attempts and any lock of the identifiers.
+func (a *Authenticator) ResetLoginThrottle(ctx context.Context, identifiers ...string) error {
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			continue
+		}
+		if err := a.store.DeleteLoginAttempts(ctx, &store.DeleteLoginAttempt{Identifier: &identifier}); err != nil {
+			return errors.Wrap(err, "failed to delete login attempt")
+		}
+	}
+	return nil
+}
@@ -6,10 +6,12 @@
 //
 // Authentication methods supported:
 // - Session cookie: Browser-based authentication with sliding expiration
+// - Session access token: Short-lived browser token renewed with a rotating refresh token
 // - JWT token: API token authentication for programmatic access
 package auth
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -25,19 +27,20 @@ const (
 	// This identifies tokens as issued by Memos.
 	Issuer = "memos"
 
-	// KeyID is the key identifier used in JWT header.
-	// Version "v1" allows for future key rotation while maintaining backward compatibility.
-	// If signing mechanism changes, add "v2", "v3", etc. and verify both versions.
+	// KeyID is the key identifier of the legacy signing key, which signs with the server secret.
+	// It signs tokens until the key is first rotated, see RotateSigningKey.
 	KeyID = "v1"
 
 	// AccessTokenAudienceName is the audience claim for JWT access tokens.
 	// This ensures tokens are only used for API access, not other purposes.
 	AccessTokenAudienceName = "user.access-token"
 
-	// SessionSlidingDuration is the sliding expiration duration for user sessions.
-	// Sessions remain valid if accessed within the last 14 days.
-	// Each API call extends the session by updating last_accessed_time.
-	SessionSlidingDuration = 14 * 24 * time.Hour
+	// SessionLastAccessedUpdateInterval is the minimum interval between last_accessed_time writes.
+	// Requests within the interval reuse the stored time, avoiding a write on every API call.
+	SessionLastAccessedUpdateInterval = 5 * time.Minute
+
+	// AccessTokenLastUsedUpdateInterval is the minimum interval between last_used_time writes.
+	AccessTokenLastUsedUpdateInterval = time.Hour
 
 	// SessionCookieName is the HTTP cookie name used to store session information.
 	// Cookie value format: {userID}-{sessionID}.
@@ -52,32 +55,41 @@ const (
 // - aud: Audience = "user.access-token"
 // - sub: Subject = user ID
 // - iat: Issued at time
-// - exp: Expiration time (optional, may be empty for never-expiring tokens).
+// - exp: Expiration time (optional, may be empty for never-expiring tokens)
+// - scopes: Granted scopes (custom claim, omitted for full access)
+// - sid: Session ID (custom claim, only in session access tokens)
+// - shv: Passphrase version (custom claim, only in share access tokens, whose subject is the share link).
+// - ntf: Notification types (custom claim, only in email unsubscribe tokens).
 type ClaimsMessage struct {
-	Name string `json:"name"` // Username
+	Name              string   `json:"name"`             // Username
+	Scopes            []string `json:"scopes,omitempty"` // Granted scopes, see ScopeRead etc.
+	SessionID         string   `json:"sid,omitempty"`    // Session of a session access token
+	ShareVersion      string   `json:"shv,omitempty"`    // Passphrase of a share access token
+	NotificationTypes []string `json:"ntf,omitempty"`    // Notification types of an email unsubscribe token
 	jwt.RegisteredClaims
 }
 
-// GenerateAccessToken generates a JWT access token for a user.
+// GenerateAccessToken generates a JWT access token for a user, signed with the current
+// signing key.
 //
 // Parameters:
 // - username: The user's username (stored in "name" claim)
 // - userID: The user's ID (stored in "sub" claim)
+// - scopes: The granted scopes (stored in "scopes" claim, empty for full access)
 // - expirationTime: When the token expires (pass zero time for no expiration)
-// - secret: Server secret used to sign the token
 //
 // Returns a signed JWT string or an error.
-func GenerateAccessToken(username string, userID int32, expirationTime time.Time, secret []byte) (string, error) {
-	return generateToken(username, userID, AccessTokenAudienceName, expirationTime, secret)
+func (a *Authenticator) GenerateAccessToken(ctx context.Context, username string, userID int32, scopes []string, expirationTime time.Time) (string, error) {
+	return a.generateToken(ctx, username, userID, scopes, AccessTokenAudienceName, expirationTime)
 }
 
 // generateToken generates a JWT token with the given claims.
 //
 // Token structure:
-// Header: {"alg": "HS256", "kid": "v1", "typ": "JWT"}
-// Claims: {"name": username, "iss": "memos", "aud": [audience], "sub": userID, "iat": now, "exp": expiry}
-// Signature: HMACSHA256(base64UrlEncode(header) + "." + base64UrlEncode(payload), secret).
-func generateToken(username string, userID int32, audience string, expirationTime time.Time, secret []byte) (string, error) {
+// Header: {"alg": "HS256", "kid": current key ID, "typ": "JWT"}
+// Claims: {"name": username, "scopes": [scopes], "iss": "memos", "aud": [audience], "sub": userID, "iat": now, "exp": expiry}
+// Signature: HMACSHA256(base64UrlEncode(header) + "." + base64UrlEncode(payload), key secret).
+func (a *Authenticator) generateToken(ctx context.Context, username string, userID int32, scopes []string, audience string, expirationTime time.Time) (string, error) {
 	registeredClaims := jwt.RegisteredClaims{
 		Issuer:   Issuer,
 		Audience: jwt.ClaimStrings{audience},
@@ -88,15 +100,26 @@ func generateToken(username string, userID int32, audience string, expirationTim
 		registeredClaims.ExpiresAt = jwt.NewNumericDate(expirationTime)
 	}
 
-	// Declare the token with the HS256 algorithm used for signing, and the claims.
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ClaimsMessage{
+	return a.signClaims(ctx, &ClaimsMessage{
 		Name:             username,
+		Scopes:           scopes,
 		RegisteredClaims: registeredClaims,
 	})
-	token.Header["kid"] = KeyID
+}
+
+// signClaims signs claims with the HS256 algorithm and the current signing key.
+func (a *Authenticator) signClaims(ctx context.Context, claims *ClaimsMessage) (string, error) {
+	keys, err := a.signingKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Declare the token with the HS256 algorithm used for signing, and the claims.
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = keys.currentID
 
 	// Create the JWT string.
-	tokenString, err := token.SignedString(secret)
+	tokenString, err := token.SignedString(keys.accepted[keys.currentID])
 	if err != nil {
 		return "", err
 	}
@@ -104,6 +127,21 @@ func generateToken(username string, userID int32, audience string, expirationTim
 	return tokenString, nil
 }
 
+// GetAccessTokenExpiration returns the expiration time of an access token issued by this server,
+// or zero time if it never expires.
+//
+// The signature is not verified, so only use this for tokens read from the store.
+func GetAccessTokenExpiration(accessToken string) (time.Time, error) {
+	claims := &ClaimsMessage{}
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, claims); err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse access token")
+	}
+	if claims.ExpiresAt == nil {
+		return time.Time{}, nil
+	}
+	return claims.ExpiresAt.Time, nil
+}
+
 // GenerateSessionID generates a unique session ID.
 //
 // Uses UUID v4 (random) for high entropy and uniqueness.
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// TwoFactorChallengeAudienceName is the audience claim for two-factor sign-in challenges.
+	// A challenge proves the first sign-in step succeeded; it is not an access token.
+	TwoFactorChallengeAudienceName = "user.two-factor-challenge"
+
+	// TwoFactorChallengeDuration is how long a sign-in challenge accepts a code.
+	TwoFactorChallengeDuration = 5 * time.Minute
+
+	// RecoveryCodeCount is the number of recovery codes generated when two-factor authentication is enabled.
+	RecoveryCodeCount = 10
+)
+
+// twoFactorMu serializes code checks, so concurrent requests can't both accept the same code.
+var twoFactorMu sync.Mutex
+
+// GenerateTwoFactorChallenge generates the challenge returned after the first sign-in step
+// of a user with two-factor authentication enabled.
+func (a *Authenticator) GenerateTwoFactorChallenge(ctx context.Context, username string, userID int32) (string, error) {
+	return a.generateToken(ctx, username, userID, nil, TwoFactorChallengeAudienceName, time.Now().Add(TwoFactorChallengeDuration))
+}
+
+// GenerateRecoveryCodes generates one-time recovery codes, formatted as "xxxxx-xxxxx",
+// and the hashes to store.
+func GenerateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		raw := make([]byte, 6)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate recovery code")
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(raw))
+		code = code[:5] + "-" + code[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a recovery code, ignoring case and separators.
+// Recovery codes are random, so a fast hash is enough.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyTwoFactorCode checks a 6-digit TOTP code or a recovery code against setting.
+// On success it advances the replay counter or consumes the recovery code, and the caller
+// must persist setting.
+func verifyTwoFactorCode(setting *storepb.TwoFactorUserSetting, code string, now time.Time) bool {
+	code = strings.TrimSpace(code)
+	if counter, ok := ValidateTOTP(setting.Secret, code, now, setting.LastCounter); ok {
+		setting.LastCounter = counter
+		return true
+	}
+	hash := hashRecoveryCode(code)
+	for i, recoveryCodeHash := range setting.RecoveryCodeHashes {
+		if recoveryCodeHash == hash {
+			setting.RecoveryCodeHashes = append(setting.RecoveryCodeHashes[:i], setting.RecoveryCodeHashes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyTwoFactorCode checks a code for a user with two-factor authentication enabled.
+// The code may be from the authenticator app or a recovery code, and is only accepted once.
+func (a *Authenticator) VerifyTwoFactorCode(ctx context.Context, userID int32, code string) error {
+	twoFactorMu.Lock()
+	defer twoFactorMu.Unlock()
+
+	stored, err := a.store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get two-factor setting")
+	}
+	if !stored.Enabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+	// Copy the setting, which may be shared through the store cache.
+	setting, ok := proto.Clone(stored).(*storepb.TwoFactorUserSetting)
+	if !ok {
+		return errors.New("failed to copy two-factor setting")
+	}
+	if !verifyTwoFactorCode(setting, code, time.Now()) {
+		return errors.New("invalid two-factor code")
+	}
+	if err := a.store.UpsertUserTwoFactor(ctx, userID, setting); err != nil {
+		return errors.Wrap(err, "failed to update two-factor setting")
+	}
+	return nil
+}
+
+// AuthenticateByTwoFactor completes a two-factor sign-in and returns the authenticated user.
+//
+// Validation steps:
+// 1. Parse and verify the challenge issued by GenerateTwoFactorChallenge
+// 2. Verify user exists and is neither archived nor suspended
+// 3. Check the sign-in throttle of the user and client IP
+// 4. Verify the code with VerifyTwoFactorCode, counting failures against the throttle
+//
+// Access token authentication is unaffected by two-factor authentication.
+func (a *Authenticator) AuthenticateByTwoFactor(ctx context.Context, challenge, code, clientIP string) (*store.User, error) {
+	claims, err := a.parseShortLivedToken(ctx, challenge, TwoFactorChallengeAudienceName)
+	if err != nil {
+		return nil, errors.New("invalid or expired two-factor challenge")
+	}
+
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed ID in challenge")
+	}
+	user, err := a.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, errors.Errorf("user %d not found", userID)
+	}
+	if user.RowStatus == store.Archived {
+		return nil, errors.Errorf("user %d is archived", userID)
+	}
+	if user.SuspendedTs != 0 {
+		return nil, errors.Errorf("user %d is suspended", userID)
+	}
+
+	identifiers := []string{AccountLoginIdentifier(user.Username), IPLoginIdentifier(clientIP)}
+	if err := a.CheckLoginThrottle(ctx, identifiers...); err != nil {
+		return nil, err
+	}
+	if verifyErr := a.VerifyTwoFactorCode(ctx, user.ID, code); verifyErr != nil {
+		if err := a.RecordLoginFailure(ctx, identifiers...); err != nil {
+			return nil, err
+		}
+		a.RecordAuditLog(ctx, store.AuditEventSignInFailed, user.ID, map[string]any{"method": "two_factor", "username": user.Username})
+		return nil, verifyErr
+	}
+	if err := a.ResetLoginThrottle(ctx, AccountLoginIdentifier(user.Username)); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
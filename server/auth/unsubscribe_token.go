@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// UnsubscribeTokenAudienceName is the audience claim of the tokens of the unsubscribe links of
+	// notification emails.
+	UnsubscribeTokenAudienceName = "user.email-unsubscribe"
+
+	// UnsubscribeTokenDuration is how long the unsubscribe link of a notification email works.
+	UnsubscribeTokenDuration = 90 * 24 * time.Hour
+)
+
+// GenerateUnsubscribeToken issues the token of an unsubscribe link, which turns off the emails of
+// some notification types for a user without signing in.
+func (a *Authenticator) GenerateUnsubscribeToken(ctx context.Context, userID int32, notificationTypes []string) (string, error) {
+	now := time.Now()
+	return a.signClaims(ctx, &ClaimsMessage{
+		NotificationTypes: notificationTypes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{UnsubscribeTokenAudienceName},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(UnsubscribeTokenDuration)),
+			Subject:   strconv.Itoa(int(userID)),
+		},
+	})
+}
+
+// ParseUnsubscribeToken returns the user and the notification types of a token from
+// GenerateUnsubscribeToken.
+func (a *Authenticator) ParseUnsubscribeToken(ctx context.Context, token string) (int32, []string, error) {
+	claims, err := a.parseShortLivedToken(ctx, token, UnsubscribeTokenAudienceName)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid unsubscribe token")
+	}
+	userID, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil || len(claims.NotificationTypes) == 0 {
+		return 0, nil, errors.New("invalid unsubscribe token")
+	}
+	return int32(userID), claims.NotificationTypes, nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// metricsPath is the path of the Prometheus metrics endpoint.
+const metricsPath = "/metrics"
+
+// newMetricsMiddleware records the requests in the HTTP metrics by their route templates, e.g.
+// "/api/v1/*", which keeps the cardinality of the metrics bounded.
+func newMetricsMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			code := c.Response().Status
+			if err != nil {
+				code = http.StatusInternalServerError
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					code = httpError.Code
+				}
+			}
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+			metrics.ObserveHTTPRequest(c.Request().Method, route, code, start)
+			return err
+		}
+	}
+}
+
+// registerMetrics registers the metrics endpoint, if it's enabled, on the server itself, or on the
+// listener of the metrics address, started by Start.
+func (s *Server) registerMetrics() {
+	if !s.Profile.Metrics {
+		return
+	}
+	metrics.SetBuildInfo(s.Profile.Version)
+	metrics.SetActiveSessionsFunc(func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		count, err := countActiveSessions(ctx, s.Store)
+		if err != nil {
+			slog.Error("failed to count active sessions", "error", err)
+		}
+		return float64(count)
+	})
+
+	handler := newMetricsHandler(s.Profile.MetricsToken)
+	if s.Profile.MetricsAddr == "" {
+		s.echoServer.GET(metricsPath, echo.WrapHandler(handler))
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, handler)
+	s.metricsServer = &http.Server{
+		Addr:              s.Profile.MetricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+// newMetricsHandler returns the handler of the metrics endpoint, which requires the bearer token if
+// it's set.
+func newMetricsHandler(token string) http.Handler {
+	handler := metrics.Handler()
+	if token == "" {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearerToken := auth.ExtractBearerToken(r.Header.Get(echo.HeaderAuthorization))
+		if subtle.ConstantTimeCompare([]byte(bearerToken), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "invalid metrics token", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// countActiveSessions returns the number of sessions of all users that haven't expired under the
+// session policy.
+func countActiveSessions(ctx context.Context, stores *store.Store) (int, error) {
+	policy, err := auth.GetSessionPolicy(ctx, stores)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get session policy")
+	}
+	userSettings, err := stores.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_SESSIONS,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list sessions")
+	}
+	now := time.Now()
+	count := 0
+	for _, userSetting := range userSettings {
+		for _, session := range userSetting.GetSessions().GetSessions() {
+			if !policy.IsExpired(session, now) {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
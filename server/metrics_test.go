@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/server/runner/loginattempt"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() { stores.Close() })
+
+	testProfile := &profile.Profile{
+		Mode:         "dev",
+		Version:      "test-1.0.0",
+		Driver:       "sqlite",
+		Port:         8081,
+		Metrics:      true,
+		MetricsToken: "metrics-token",
+	}
+	s, err := NewServer(ctx, testProfile, stores)
+	require.NoError(t, err)
+	loginattempt.NewRunner(stores).RunOnce(ctx)
+
+	serve := func(method, target, body, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if token != "" {
+			request.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		s.echoServer.ServeHTTP(recorder, request)
+		return recorder
+	}
+	require.Equal(t, http.StatusOK, serve(http.MethodGet, "/healthz", "", "").Code)
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, "/memos.api.v1.InstanceService/GetInstanceProfile", "{}", "").Code)
+
+	t.Run("scrapes need the token", func(t *testing.T) {
+		require.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, metricsPath, "", "").Code)
+		require.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, metricsPath, "", "wrong-token").Code)
+	})
+
+	t.Run("key series are served", func(t *testing.T) {
+		recorder := serve(http.MethodGet, metricsPath, "", "metrics-token")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		body := recorder.Body.String()
+		for _, series := range []string{
+			`memos_http_requests_total{code="200",method="GET",route="/healthz"}`,
+			`memos_http_request_duration_seconds_count{method="GET",route="/healthz"}`,
+			`memos_rpc_requests_total{code="OK",method="GetInstanceProfile",service="InstanceService"}`,
+			`memos_store_operation_duration_seconds_count{operation="ListInstanceSettings"}`,
+			`memos_runner_runs_total{result="success",runner="loginattempt"}`,
+			`memos_build_info{version="test-1.0.0"} 1`,
+			`memos_active_sessions 0`,
+			`go_goroutines`,
+		} {
+			require.Contains(t, body, series)
+		}
+		// Label values are route templates, not request paths.
+		require.NotContains(t, body, `route="/memos.api.v1.InstanceService/GetInstanceProfile"`)
+	})
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/ratelimit"
+)
+
+// rateLimitedPathPrefixes are the HTTP routes limited by the API rate limits. The gRPC gateway and
+// the Connect handlers are limited by their interceptors, and the frontend isn't limited. Neither
+// are the signed webhooks of Slack and the mail provider, whose requests of all the users come
+// from the same IPs.
+var rateLimitedPathPrefixes = []string{
+	"/file/",
+	"/api/link/",
+	"/api/capture",
+	"/api/oembed",
+	"/embed/",
+	"/explore/",
+	"/u/",
+}
+
+// newRateLimitMiddleware limits the HTTP routes by the API rate limits, charging the requests to
+// the user of their session or access token, or else to the IP of the client. The request context
+// remembers the authentication, so the handler doesn't authenticate the request again.
+func newRateLimitMiddleware(rateLimitService *ratelimit.Service, authenticator *auth.Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isRateLimitedPath(c.Request().URL.Path) {
+				return next(c)
+			}
+			c.SetRequest(c.Request().WithContext(auth.WithRequestAuthentications(c.Request().Context())))
+			write := c.Request().Method != http.MethodGet && c.Request().Method != http.MethodHead && c.Request().Method != http.MethodOptions
+			userID := getRequestUserID(c, authenticator)
+			if exceeded := rateLimitService.Check(c.Request().Context(), userID, c.RealIP(), write); exceeded != nil {
+				c.Response().Header().Set("Retry-After", exceeded.RetryAfterHeader())
+				return echo.NewHTTPError(http.StatusTooManyRequests, exceeded.Error())
+			}
+			return next(c)
+		}
+	}
+}
+
+func isRateLimitedPath(path string) bool {
+	for _, prefix := range rateLimitedPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRequestUserID returns the ID of the user authenticated by the session cookie, the access
+// token cookie or the bearer token of a request, or 0 for anonymous requests.
+func getRequestUserID(c echo.Context, authenticator *auth.Authenticator) int32 {
+	ctx := c.Request().Context()
+	if cookie, err := c.Cookie(auth.SessionCookieName); err == nil && cookie.Value != "" {
+		if user, err := authenticator.AuthenticateBySession(ctx, cookie.Value); err == nil && user != nil {
+			return user.ID
+		}
+	}
+	if cookie, err := c.Cookie(auth.AccessTokenCookieName); err == nil && cookie.Value != "" {
+		if user, _, err := authenticator.AuthenticateBySessionAccessToken(ctx, cookie.Value); err == nil && user != nil {
+			return user.ID
+		}
+	}
+	if token := auth.ExtractBearerToken(c.Request().Header.Get("Authorization")); token != "" {
+		if user, _, err := authenticator.AuthenticateByJWT(ctx, token); err == nil && user != nil {
+			return user.ID
+		}
+	}
+	return 0
+}
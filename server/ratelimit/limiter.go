@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Limit is the rate of a token bucket.
+type Limit struct {
+	// PerMinute is the number of tokens refilled per minute.
+	PerMinute int
+	// Burst is the size of the bucket.
+	Burst int
+}
+
+// Limiter takes tokens from the buckets of keys.
+//
+// MemoryLimiter keeps the buckets in the process, which limits each replica of a deployment on its
+// own. Replicas that share the limits need a limiter backed by a shared store, e.g. Redis.
+type Limiter interface {
+	// Allow takes a token from the bucket of key. When the bucket is empty nothing is taken and
+	// the time to wait before retrying is returned.
+	Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// MemoryLimiter is an in-memory token bucket Limiter.
+// Buckets that have not been used for idleTTL are dropped during periodic cleanup.
+type MemoryLimiter struct {
+	mu          sync.Mutex
+	buckets     map[string]*bucket
+	idleTTL     time.Duration
+	lastCleanup time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewMemoryLimiter creates an in-memory limiter dropping the buckets idle for idleTTL.
+func NewMemoryLimiter(idleTTL time.Duration) *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets:     make(map[string]*bucket),
+		idleTTL:     idleTTL,
+		lastCleanup: time.Now(),
+	}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	ok, retryAfter := l.allowAt(key, limit, time.Now())
+	return ok, retryAfter, nil
+}
+
+func (l *MemoryLimiter) allowAt(key string, limit Limit, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastCleanup) >= l.idleTTL {
+		l.cleanup(now)
+	}
+
+	perSecond := rate.Limit(float64(limit.PerMinute) / 60)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(perSecond, limit.Burst)}
+		l.buckets[key] = b
+	} else if b.limiter.Limit() != perSecond || b.limiter.Burst() != limit.Burst {
+		// The instance setting changed since the bucket was created.
+		b.limiter.SetLimitAt(now, perSecond)
+		b.limiter.SetBurstAt(now, limit.Burst)
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return false, time.Minute
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// cleanup drops the buckets that have been idle for longer than idleTTL.
+func (l *MemoryLimiter) cleanup(now time.Time) {
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.idleTTL {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastCleanup = now
+}
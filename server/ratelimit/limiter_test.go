@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiterBurstAndRefill(t *testing.T) {
+	limiter := NewMemoryLimiter(time.Minute)
+	limit := Limit{PerMinute: 60, Burst: 3}
+	now := time.Now()
+
+	for range 3 {
+		ok, _ := limiter.allowAt("user:1:read", limit, now)
+		require.True(t, ok)
+	}
+	ok, retryAfter := limiter.allowAt("user:1:read", limit, now)
+	require.False(t, ok)
+	require.Equal(t, time.Second, retryAfter)
+
+	// Other keys have their own bucket.
+	ok, _ = limiter.allowAt("user:1:write", limit, now)
+	require.True(t, ok)
+
+	// One token is refilled per second at 60 per minute.
+	ok, _ = limiter.allowAt("user:1:read", limit, now.Add(time.Second))
+	require.True(t, ok)
+}
+
+func TestMemoryLimiterLimitChange(t *testing.T) {
+	limiter := NewMemoryLimiter(time.Minute)
+	now := time.Now()
+
+	ok, _ := limiter.allowAt("ip:1.2.3.4:read", Limit{PerMinute: 60, Burst: 3}, now)
+	require.True(t, ok)
+	// A lower limit of the instance setting applies to the existing buckets.
+	ok, _ = limiter.allowAt("ip:1.2.3.4:read", Limit{PerMinute: 60, Burst: 1}, now)
+	require.True(t, ok)
+	ok, _ = limiter.allowAt("ip:1.2.3.4:read", Limit{PerMinute: 60, Burst: 1}, now)
+	require.False(t, ok)
+}
+
+func TestMemoryLimiterCleanup(t *testing.T) {
+	limiter := NewMemoryLimiter(time.Minute)
+	limit := Limit{PerMinute: 60, Burst: 3}
+	now := time.Now()
+
+	limiter.allowAt("user:1:read", limit, now)
+	limiter.allowAt("user:2:read", limit, now.Add(30*time.Second))
+	limiter.allowAt("user:3:read", limit, now.Add(time.Minute+time.Second))
+	require.Len(t, limiter.buckets, 2)
+	require.NotContains(t, limiter.buckets, "user:1:read")
+}
@@ -0,0 +1,119 @@
+// Package ratelimit limits the API requests of each user, or of each IP for the anonymous
+// requests, by the rate limit setting of the instance.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// DefaultIdleTTL is how long the buckets of the users and IPs without requests are kept.
+const DefaultIdleTTL = 10 * time.Minute
+
+// ExceededError is returned when a request is over the rate limit.
+type ExceededError struct {
+	// RetryAfter is how long to wait before retrying.
+	RetryAfter time.Duration
+}
+
+func (*ExceededError) Error() string {
+	return "rate limit exceeded"
+}
+
+// RetryAfterHeader returns RetryAfter in whole seconds, rounded up, for the Retry-After header.
+func (e *ExceededError) RetryAfterHeader() string {
+	return strconv.Itoa(max(1, int(math.Ceil(e.RetryAfter.Seconds()))))
+}
+
+// Service charges the API requests to the buckets of their clients.
+type Service struct {
+	store   *store.Store
+	limiter Limiter
+}
+
+// NewService creates a rate limit service taking the tokens from limiter.
+func NewService(store *store.Store, limiter Limiter) *Service {
+	return &Service{
+		store:   store,
+		limiter: limiter,
+	}
+}
+
+// Check charges a request to the bucket of the user, or of the IP if the request is anonymous,
+// with reads and writes charged to separate buckets. It returns the error to respond with once
+// the limit is exceeded, nil otherwise. The requests are let through when the limit can't be
+// checked, so that the API doesn't go down with the limiter.
+func (s *Service) Check(ctx context.Context, userID int32, ip string, write bool) *ExceededError {
+	retryAfter, err := s.getRetryAfter(ctx, userID, ip, write)
+	if err != nil {
+		slog.Warn("failed to check the API rate limit", "error", err)
+		return nil
+	}
+	if retryAfter > 0 {
+		return &ExceededError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// getRetryAfter returns the time to wait before retrying if the request is over the limit, 0 otherwise.
+// The requests without user nor IP, e.g. in-process calls, aren't limited.
+func (s *Service) getRetryAfter(ctx context.Context, userID int32, ip string, write bool) (time.Duration, error) {
+	if userID == 0 && ip == "" {
+		return 0, nil
+	}
+	setting, err := s.store.GetInstanceRateLimitSetting(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get instance rate limit setting")
+	}
+	if setting.Disabled {
+		return 0, nil
+	}
+
+	key := "ip:" + ip
+	multiplier := 1
+	if userID != 0 {
+		key = fmt.Sprintf("user:%d", userID)
+		user, err := s.store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get user")
+		}
+		if user != nil && user.Role == store.RoleHost {
+			multiplier = int(setting.HostMultiplier)
+		}
+	}
+	limit := getLimit(setting, write)
+	limit.PerMinute *= multiplier
+	limit.Burst *= multiplier
+	if write {
+		key += ":write"
+	} else {
+		key += ":read"
+	}
+
+	ok, retryAfter, err := s.limiter.Allow(ctx, key, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to take a token")
+	}
+	if !ok {
+		// A rejected request must wait, even if the limiter rounded the wait down to nothing.
+		return max(retryAfter, time.Millisecond), nil
+	}
+	return 0, nil
+}
+
+// getLimit returns the limit of the reads or the writes of a client.
+func getLimit(setting *storepb.InstanceRateLimitSetting, write bool) Limit {
+	if write {
+		return Limit{PerMinute: int(setting.WriteRequestsPerMinute), Burst: int(setting.WriteBurst)}
+	}
+	return Limit{PerMinute: int(setting.ReadRequestsPerMinute), Burst: int(setting.ReadBurst)}
+}
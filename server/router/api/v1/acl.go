@@ -42,9 +42,10 @@ func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthIntercep
 //
 // Authentication strategy (in priority order):
 // 1. Session Cookie: "user_session" cookie with format "{userID}-{sessionID}"
-// 2. Bearer Token: "Authorization: Bearer {jwt_token}" header
-// 3. Public Methods: Allow without auth if method is in public allowlist
-// 4. Reject: Return Unauthenticated error
+// 2. Session Access Token: "user_access_token" cookie, renewed with RefreshSession
+// 3. Bearer Token: "Authorization: Bearer {jwt_token}" header
+// 4. Public Methods: Allow without auth if method is in public allowlist
+// 5. Reject: Return Unauthenticated error
 //
 // On successful authentication, context values are set:
 // - auth.UserIDContextKey: The authenticated user's ID
@@ -70,7 +71,19 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 				// but handle it gracefully anyway
 				sessionID = ""
 			}
-			ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, serverInfo.FullMethod, user, sessionID, "", IsAdminOnlyMethod)
+			ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, serverInfo.FullMethod, user, sessionID, "", nil, IsAdminOnlyMethod)
+			if err != nil {
+				return nil, toGRPCError(err, codes.PermissionDenied)
+			}
+			return handler(ctx, request)
+		}
+	}
+
+	// Try session access token cookie authentication
+	if accessToken := extractCookieFromMetadata(md, auth.AccessTokenCookieName); accessToken != "" {
+		user, sessionID, err := in.authenticator.AuthenticateBySessionAccessToken(ctx, accessToken)
+		if err == nil && user != nil {
+			ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, serverInfo.FullMethod, user, sessionID, "", nil, IsAdminOnlyMethod)
 			if err != nil {
 				return nil, toGRPCError(err, codes.PermissionDenied)
 			}
@@ -80,9 +93,9 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 
 	// Try bearer token authentication
 	if token := extractBearerTokenFromMetadata(md); token != "" {
-		user, err := in.authenticator.AuthenticateByJWT(ctx, token)
+		user, scopes, err := in.authenticator.AuthenticateByJWT(ctx, token)
 		if err == nil && user != nil {
-			ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, serverInfo.FullMethod, user, "", token, IsAdminOnlyMethod)
+			ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, serverInfo.FullMethod, user, "", token, scopes, IsAdminOnlyMethod)
 			if err != nil {
 				return nil, toGRPCError(err, codes.PermissionDenied)
 			}
@@ -114,9 +127,15 @@ func toGRPCError(err error, code codes.Code) error {
 // Checks both "grpcgateway-cookie" (from gRPC-Gateway) and "cookie" (native gRPC).
 // Returns empty string if no session cookie is found.
 func extractSessionCookieFromMetadata(md metadata.MD) string {
+	return extractCookieFromMetadata(md, auth.SessionCookieName)
+}
+
+// extractCookieFromMetadata extracts the value of the named cookie from gRPC metadata.
+// Returns empty string if the cookie is not found.
+func extractCookieFromMetadata(md metadata.MD, name string) string {
 	// gRPC-Gateway puts cookies in "grpcgateway-cookie", native gRPC uses "cookie"
 	for _, cookieHeader := range append(md.Get("grpcgateway-cookie"), md.Get("cookie")...) {
-		if cookie := auth.ExtractSessionCookieFromHeader(cookieHeader); cookie != "" {
+		if cookie := auth.ExtractCookieFromHeader(cookieHeader, name); cookie != "" {
 			return cookie
 		}
 	}
@@ -16,8 +16,12 @@ var publicMethods = map[string]bool{
 	"/memos.api.v1.InstanceService/GetInstanceSetting": true,
 
 	// Auth - login/session endpoints
-	"/memos.api.v1.AuthService/CreateSession":     true,
-	"/memos.api.v1.AuthService/GetCurrentSession": true,
+	"/memos.api.v1.AuthService/CreateSession":          true,
+	"/memos.api.v1.AuthService/CreatePasskeyChallenge": true,
+	"/memos.api.v1.AuthService/GetCurrentSession":      true,
+	"/memos.api.v1.AuthService/RefreshSession":         true,
+	"/memos.api.v1.AuthService/RequestPasswordReset":   true,
+	"/memos.api.v1.AuthService/ResetPassword":          true,
 
 	// User - public user info and registration
 	"/memos.api.v1.UserService/CreateUser":       true, // Registration (also admin-only when not first user)
@@ -31,8 +35,11 @@ var publicMethods = map[string]bool{
 	"/memos.api.v1.IdentityProviderService/ListIdentityProviders": true,
 
 	// Memo - public memo access
-	"/memos.api.v1.MemoService/GetMemo":   true,
-	"/memos.api.v1.MemoService/ListMemos": true,
+	"/memos.api.v1.MemoService/GetMemo":          true,
+	"/memos.api.v1.MemoService/ListMemos":        true,
+	"/memos.api.v1.MemoService/ListTagTree":      true,
+	"/memos.api.v1.MemoService/GetSharedMemo":    true, // Anyone with the share link
+	"/memos.api.v1.MemoService/UnlockSharedMemo": true, // Anyone with the share link and its passphrase
 
 	// Attachment - public attachment access
 	"/memos.api.v1.AttachmentService/GetAttachmentBinary": true,
@@ -41,8 +48,21 @@ var publicMethods = map[string]bool{
 // adminOnlyMethods lists methods that require admin (Host or Admin role) privileges.
 // Regular users cannot call these methods even if authenticated.
 var adminOnlyMethods = map[string]bool{
-	"/memos.api.v1.UserService/CreateUser":                true, // Admin creates users (except first user registration)
-	"/memos.api.v1.InstanceService/UpdateInstanceSetting": true,
+	"/memos.api.v1.UserService/CreateUser":                     true, // Admin creates users (except first user registration)
+	"/memos.api.v1.InstanceService/UpdateInstanceSetting":      true,
+	"/memos.api.v1.UserService/UnlockUser":                     true,
+	"/memos.api.v1.UserService/SuspendUser":                    true, // Host only, checked by the method
+	"/memos.api.v1.UserService/UnsuspendUser":                  true, // Host only, checked by the method
+	"/memos.api.v1.InstanceService/ListAuditLogs":              true, // Host only, checked by the method
+	"/memos.api.v1.InstanceService/GetInstanceDiagnostics":     true,
+	"/memos.api.v1.InstanceService/GetInstanceStats":           true, // Host only, checked by the method
+	"/memos.api.v1.InstanceService/GetInstanceMigrationStatus": true,
+	"/memos.api.v1.InstanceService/ListSigningKeys":            true, // Host only, checked by the method
+	"/memos.api.v1.InstanceService/RotateSigningKey":           true, // Host only, checked by the method
+	"/memos.api.v1.InstanceService/ExpireSigningKey":           true, // Host only, checked by the method
+	"/memos.api.v1.AttachmentService/PurgeOrphanedAttachments": true,
+	"/memos.api.v1.AttachmentService/DeduplicateAttachments":   true,
+	"/memos.api.v1.AttachmentService/RecalculateStorageUsage":  true,
 }
 
 // IsPublicMethod returns true if the method can be called without authentication.
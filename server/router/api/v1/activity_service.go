@@ -68,7 +68,7 @@ func (s *APIV1Service) GetActivity(ctx context.Context, request *v1pb.GetActivit
 // This handles the mapping between internal activity representation and the public API,
 // including proper type and level conversions.
 func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *store.Activity) (*v1pb.Activity, error) {
-	payload, err := s.convertActivityPayloadFromStore(ctx, activity.Payload)
+	payload, err := s.convertActivityPayloadFromStore(ctx, activity.CreatorID, activity.Payload)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert activity payload from store: %v", err)
 	}
@@ -78,6 +78,20 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 	switch activity.Type {
 	case store.ActivityTypeMemoComment:
 		activityType = v1pb.Activity_MEMO_COMMENT
+	case store.ActivityTypeMemoReminder:
+		activityType = v1pb.Activity_MEMO_REMINDER
+	case store.ActivityTypeMemoAutoArchive:
+		activityType = v1pb.Activity_MEMO_AUTO_ARCHIVE
+	case store.ActivityTypeWebhookDisabled:
+		activityType = v1pb.Activity_WEBHOOK_DISABLED
+	case store.ActivityTypeBackupFailed:
+		activityType = v1pb.Activity_BACKUP_FAILED
+	case store.ActivityTypeMemoReaction:
+		activityType = v1pb.Activity_MEMO_REACTION
+	case store.ActivityTypeMemoMention:
+		activityType = v1pb.Activity_MEMO_MENTION
+	case store.ActivityTypeDigest:
+		activityType = v1pb.Activity_DIGEST
 	default:
 		activityType = v1pb.Activity_TYPE_UNSPECIFIED
 	}
@@ -87,6 +101,8 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 	switch activity.Level {
 	case store.ActivityLevelInfo:
 		activityLevel = v1pb.Activity_INFO
+	case store.ActivityLevelError:
+		activityLevel = v1pb.Activity_ERROR
 	default:
 		activityLevel = v1pb.Activity_LEVEL_UNSPECIFIED
 	}
@@ -102,14 +118,16 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 }
 
 // convertActivityPayloadFromStore converts a storage-layer activity payload to an API payload.
-// This resolves references (e.g., memo IDs) to resource names for the API.
-func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payload *storepb.ActivityPayload) (*v1pb.ActivityPayload, error) {
+// This resolves references (e.g., memo IDs) to resource names for the API, the webhooks being
+// resolved in the webhooks of the creator.
+func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, creatorID int32, payload *storepb.ActivityPayload) (*v1pb.ActivityPayload, error) {
 	v2Payload := &v1pb.ActivityPayload{}
 	if payload.MemoComment != nil {
 		// Fetch the comment memo
 		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
 			ID:             &payload.MemoComment.MemoId,
 			ExcludeContent: true,
+			IncludeTrashed: true,
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
@@ -122,10 +140,14 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
 			ID:             &payload.MemoComment.RelatedMemoId,
 			ExcludeContent: true,
+			IncludeTrashed: true,
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
 		}
+		if relatedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "related memo does not exist")
+		}
 
 		v2Payload.Payload = &v1pb.ActivityPayload_MemoComment{
 			MemoComment: &v1pb.ActivityMemoCommentPayload{
@@ -134,5 +156,149 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			},
 		}
 	}
+	if payload.MemoReminder != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:               &payload.MemoReminder.MemoId,
+			ExcludeContent:   true,
+			IncludeTrashed:   true,
+			IncludeScheduled: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReminder{
+			MemoReminder: &v1pb.ActivityMemoReminderPayload{
+				Memo:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				RemindTime: timestamppb.New(time.Unix(payload.MemoReminder.ReminderTs, 0)),
+			},
+		}
+	}
+	if payload.MemoAutoArchive != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoAutoArchive{
+			MemoAutoArchive: &v1pb.ActivityMemoAutoArchivePayload{
+				MemoCount: payload.MemoAutoArchive.MemoCount,
+				Days:      payload.MemoAutoArchive.Days,
+			},
+		}
+	}
+	if payload.WebhookDisabled != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_WebhookDisabled{
+			WebhookDisabled: &v1pb.ActivityWebhookDisabledPayload{
+				Webhook:             fmt.Sprintf("%s%d/webhooks/%s", UserNamePrefix, creatorID, payload.WebhookDisabled.WebhookId),
+				ConsecutiveFailures: payload.WebhookDisabled.ConsecutiveFailures,
+			},
+		}
+	}
+	if payload.BackupFailed != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_BackupFailed{
+			BackupFailed: &v1pb.ActivityBackupFailedPayload{
+				Error: payload.BackupFailed.Error,
+			},
+		}
+	}
+	if payload.MemoReaction != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReaction.MemoId,
+			ExcludeContent: true,
+			IncludeTrashed: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReaction{
+			MemoReaction: &v1pb.ActivityMemoReactionPayload{
+				Memo:         fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				ReactionType: payload.MemoReaction.ReactionType,
+			},
+		}
+	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+			IncludeTrashed: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo does not exist")
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoMention{
+			MemoMention: &v1pb.ActivityMemoMentionPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			},
+		}
+	}
+	if payload.Digest != nil {
+		// The memos deleted since the digest are left out.
+		memoNames, err := s.listMemoNames(ctx, payload.Digest.MemoIds)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		reminderMemoNames, err := s.listMemoNames(ctx, payload.Digest.ReminderMemoIds)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		var resurfacedMemoName string
+		if payload.Digest.ResurfacedMemoId != 0 {
+			names, err := s.listMemoNames(ctx, []int32{payload.Digest.ResurfacedMemoId})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+			}
+			if len(names) > 0 {
+				resurfacedMemoName = names[0]
+			}
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_Digest{
+			Digest: &v1pb.ActivityDigestPayload{
+				StartTime:      timestamppb.New(time.Unix(payload.Digest.StartTs, 0)),
+				EndTime:        timestamppb.New(time.Unix(payload.Digest.EndTs, 0)),
+				Memos:          memoNames,
+				CommentCount:   payload.Digest.CommentCount,
+				ReactionCount:  payload.Digest.ReactionCount,
+				ReminderMemos:  reminderMemoNames,
+				ResurfacedMemo: resurfacedMemoName,
+			},
+		}
+	}
 	return v2Payload, nil
 }
+
+// listMemoNames returns the names of the memos with the given IDs that still exist, in the order
+// of the IDs.
+func (s *APIV1Service) listMemoNames(ctx context.Context, memoIDs []int32) ([]string, error) {
+	names := []string{}
+	if len(memoIDs) == 0 {
+		return names, nil
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:           memoIDs,
+		ExcludeContent:   true,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uids := make(map[int32]string, len(memos))
+	for _, memo := range memos {
+		uids[memo.ID] = memo.UID
+	}
+	for _, memoID := range memoIDs {
+		if uid, ok := uids[memoID]; ok {
+			names = append(names, fmt.Sprintf("%s%s", MemoNamePrefix, uid))
+		}
+	}
+	return names, nil
+}
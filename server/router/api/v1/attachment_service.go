@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -14,14 +15,21 @@ import (
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/audiometa"
+	"github.com/usememos/memos/plugin/imagecompress"
+	"github.com/usememos/memos/plugin/imagemeta"
 	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/thumbnail"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -33,14 +41,13 @@ const (
 	// This is unrelated to maximum upload size limit, which is now set through system setting.
 	MaxUploadBufferSizeBytes = 32 << 20
 	MebiByte                 = 1024 * 1024
-	// ThumbnailCacheFolder is the folder name where the thumbnail images are stored.
-	ThumbnailCacheFolder = ".thumbnail_cache"
 )
 
-var SupportedThumbnailMimeTypes = []string{
-	"image/png",
-	"image/jpeg",
-}
+// thumbnailSemaphore limits concurrent thumbnail generation on upload to prevent memory exhaustion.
+var thumbnailSemaphore = semaphore.NewWeighted(3)
+
+// imageCompressionSemaphore limits concurrent image compression on upload to prevent memory exhaustion.
+var imageCompressionSemaphore = semaphore.NewWeighted(3)
 
 func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.CreateAttachmentRequest) (*v1pb.Attachment, error) {
 	user, err := s.GetCurrentUser(ctx)
@@ -51,18 +58,11 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Validate required fields
-	if request.Attachment == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "attachment is required")
-	}
-	if request.Attachment.Filename == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "filename is required")
+	if request.Attachment.GetExternalLink() != "" {
+		return s.createExternalAttachment(ctx, user, request)
 	}
-	if !validateFilename(request.Attachment.Filename) {
-		return nil, status.Errorf(codes.InvalidArgument, "filename contains invalid characters or format")
-	}
-	if request.Attachment.Type == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "type is required")
+	if err := validateAttachmentCreate(request.Attachment); err != nil {
+		return nil, err
 	}
 
 	// Use provided attachment_id or generate a new one
@@ -76,6 +76,7 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		CreatorID: user.ID,
 		Filename:  request.Attachment.Filename,
 		Type:      request.Attachment.Type,
+		Library:   request.Attachment.Library,
 	}
 
 	instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
@@ -83,42 +84,256 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		return nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
 	}
 	size := binary.Size(request.Attachment.Content)
-	uploadSizeLimit := int(instanceStorageSetting.UploadSizeLimitMb) * MebiByte
-	if uploadSizeLimit == 0 {
-		uploadSizeLimit = MaxUploadBufferSizeBytes
-	}
-	if size > uploadSizeLimit {
+	if int64(size) > getUploadSizeLimit(instanceStorageSetting) {
 		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
 	}
-	create.Size = int64(size)
-	create.Blob = request.Attachment.Content
-
-	if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
+	content := request.Attachment.Content
+	if imagemeta.IsSupported(create.Type) {
+		strip, err := s.shouldStripImageMetadata(ctx, user.ID, instanceStorageSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+		}
+		if strip {
+			content, err = imagemeta.Strip(content, create.Type)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to strip image metadata: %v", err)
+			}
+		}
 	}
+	compressed := false
+	if shouldCompressImage(instanceStorageSetting, create.Type, int64(len(content))) {
+		if content, compressed, err = compressAttachmentImage(ctx, create, content, instanceStorageSetting.ImageCompression); err != nil {
+			return nil, err
+		}
+	}
+	create.Size = int64(len(content))
+	create.Blob = content
+	create.ContentHash = store.HashAttachmentBlob(content)
 
-	if request.Attachment.Memo != nil {
-		memoUID, err := ExtractMemoUIDFromName(*request.Attachment.Memo)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	duplicate, err := s.findDuplicateAttachmentBlob(ctx, user.ID, create.ContentHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find duplicate attachment: %v", err)
+	}
+	if duplicate != nil {
+		// The upload shares the local file or S3 object, and the thumbnails, of an identical upload.
+		create.Blob = nil
+		create.StorageType = duplicate.StorageType
+		create.Reference = duplicate.Reference
+		create.Payload = duplicate.Payload
+	} else {
+		if err := s.checkStorageQuota(ctx, user.ID, create.Size); err != nil {
+			return nil, err
 		}
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
+		if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
+		}
+	}
+
+	duration := readAudioDuration(create, content)
+	if compressed || duration != nil {
+		payload := &storepb.AttachmentPayload{}
+		if create.Payload != nil {
+			payload = proto.CloneOf(create.Payload)
 		}
-		if memo == nil {
-			return nil, status.Errorf(codes.NotFound, "memo not found: %s", *request.Attachment.Memo)
+		if compressed {
+			payload.OriginalSize = int64(size)
 		}
-		create.MemoID = &memo.ID
+		payload.Duration = duration
+		create.Payload = payload
+	}
+
+	if create.MemoID, err = s.getAttachmentMemoID(ctx, request.Attachment.Memo); err != nil {
+		return nil, err
 	}
 	attachment, err := s.Store.CreateAttachment(ctx, create)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
+	if duplicate == nil {
+		s.generateAttachmentThumbnails(ctx, attachment, content)
+	}
 
 	return convertAttachmentFromStore(attachment), nil
 }
 
+// validateAttachmentCreate checks the required fields of an attachment to create.
+func validateAttachmentCreate(attachment *v1pb.Attachment) error {
+	if attachment == nil {
+		return status.Errorf(codes.InvalidArgument, "attachment is required")
+	}
+	if attachment.Filename == "" {
+		return status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+	if !validateFilename(attachment.Filename) {
+		return status.Errorf(codes.InvalidArgument, "filename contains invalid characters or format")
+	}
+	if attachment.Type == "" {
+		return status.Errorf(codes.InvalidArgument, "type is required")
+	}
+	return nil
+}
+
+// getUploadSizeLimit returns the maximum size of an upload in bytes.
+func getUploadSizeLimit(instanceStorageSetting *storepb.InstanceStorageSetting) int64 {
+	if instanceStorageSetting.UploadSizeLimitMb == 0 {
+		return MaxUploadBufferSizeBytes
+	}
+	return instanceStorageSetting.UploadSizeLimitMb * MebiByte
+}
+
+// getAttachmentMemoID returns the ID of the memo of an attachment to create, if any.
+func (s *APIV1Service) getAttachmentMemoID(ctx context.Context, memoName *string) (*int32, error) {
+	if memoName == nil {
+		return nil, nil
+	}
+	memoUID, err := ExtractMemoUIDFromName(*memoName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found: %s", *memoName)
+	}
+	return &memo.ID, nil
+}
+
+// findDuplicateAttachmentBlob returns an attachment of a user with the content hash whose local
+// file or S3 object can be shared with a new upload, or nil. The blobs of attachments stored in
+// the database aren't shared.
+func (s *APIV1Service) findDuplicateAttachmentBlob(ctx context.Context, creatorID int32, contentHash string) (*store.Attachment, error) {
+	limit := 10
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{CreatorID: &creatorID, ContentHash: &contentHash, Limit: &limit})
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		switch attachment.StorageType {
+		case storepb.AttachmentStorageType_LOCAL:
+			attachmentPath := filepath.FromSlash(attachment.Reference)
+			if !filepath.IsAbs(attachmentPath) {
+				attachmentPath = filepath.Join(s.Profile.Data, attachmentPath)
+			}
+			// The file may have been removed from the disk.
+			if _, err := os.Stat(attachmentPath); err == nil {
+				return attachment, nil
+			}
+		case storepb.AttachmentStorageType_S3:
+			if attachment.Payload.GetS3Object() != nil {
+				return attachment, nil
+			}
+		default:
+		}
+	}
+	return nil, nil
+}
+
+// shouldProcessImageUpload reports whether an uploaded image is stripped of its metadata or
+// compressed, which requires its content to go through CreateAttachment.
+func (s *APIV1Service) shouldProcessImageUpload(ctx context.Context, userID int32, instanceStorageSetting *storepb.InstanceStorageSetting, mimeType string, size int64) (bool, error) {
+	if shouldCompressImage(instanceStorageSetting, mimeType, size) {
+		return true, nil
+	}
+	if !imagemeta.IsSupported(mimeType) {
+		return false, nil
+	}
+	return s.shouldStripImageMetadata(ctx, userID, instanceStorageSetting)
+}
+
+// shouldCompressImage reports whether an uploaded image of the type and size is compressed: the
+// image compression is enabled and the image is larger than its threshold.
+func shouldCompressImage(instanceStorageSetting *storepb.InstanceStorageSetting, mimeType string, size int64) bool {
+	imageCompression := instanceStorageSetting.GetImageCompression()
+	if !imageCompression.GetEnabled() || !imagecompress.IsSupported(mimeType) {
+		return false
+	}
+	return size > imageCompression.GetThresholdKb()*1024
+}
+
+// compressAttachmentImage re-encodes an uploaded image, updating the type of the attachment and
+// the extension of its filename when the type changes. An image that can't be compressed, as a
+// corrupt file, is stored as uploaded.
+func compressAttachmentImage(ctx context.Context, create *store.Attachment, content []byte, imageCompression *storepb.StorageImageCompressionConfig) ([]byte, bool, error) {
+	if err := imageCompressionSemaphore.Acquire(ctx, 1); err != nil {
+		return nil, false, status.Errorf(codes.Canceled, "upload canceled: %v", err)
+	}
+	defer imageCompressionSemaphore.Release(1)
+
+	compressed, compressedType, ok, err := imagecompress.Compress(content, create.Type, imagecompress.Options{
+		Quality:      int(imageCompression.Quality),
+		MaxDimension: int(imageCompression.MaxDimension),
+	})
+	if err != nil {
+		slog.Warn("failed to compress attachment image", slog.String("filename", create.Filename), slog.String("error", err.Error()))
+		return content, false, nil
+	}
+	if !ok {
+		return content, false, nil
+	}
+	if compressedType != create.Type {
+		extension := ".webp"
+		if compressedType == "image/jpeg" {
+			extension = ".jpg"
+		}
+		create.Filename = strings.TrimSuffix(create.Filename, filepath.Ext(create.Filename)) + extension
+		create.Type = compressedType
+	}
+	return compressed, true, nil
+}
+
+// readAudioDuration returns the duration of an uploaded audio file, or nil if the attachment isn't
+// audio or its container can't be parsed, as a corrupt file is stored as uploaded.
+func readAudioDuration(attachment *store.Attachment, content []byte) *durationpb.Duration {
+	if !audiometa.IsSupported(attachment.Type) {
+		return nil
+	}
+	duration, err := audiometa.Duration(content)
+	if err != nil {
+		slog.Debug("failed to read audio duration", slog.String("filename", attachment.Filename), slog.String("error", err.Error()))
+		return nil
+	}
+	return durationpb.New(duration)
+}
+
+// shouldStripImageMetadata reports whether the metadata of the images uploaded by a user is
+// stripped: the general setting of the user overrides the instance storage setting.
+func (s *APIV1Service) shouldStripImageMetadata(ctx context.Context, userID int32, instanceStorageSetting *storepb.InstanceStorageSetting) (bool, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return false, err
+	}
+	if general := userSetting.GetGeneral(); general != nil && general.StripImageMetadata != nil {
+		return general.GetStripImageMetadata(), nil
+	}
+	return instanceStorageSetting.GetStripImageMetadata(), nil
+}
+
+// generateAttachmentThumbnails generates and stores the thumbnails of an uploaded image. An image
+// that can't be thumbnailed, as a corrupt file, doesn't fail the upload: the original is served
+// instead of its thumbnails.
+func (s *APIV1Service) generateAttachmentThumbnails(ctx context.Context, attachment *store.Attachment, blob []byte) {
+	if !thumbnail.IsSupported(attachment.Type) {
+		return
+	}
+	if err := thumbnailSemaphore.Acquire(ctx, 1); err != nil {
+		return
+	}
+	defer thumbnailSemaphore.Release(1)
+
+	thumbnails, err := thumbnail.Generate(blob, attachment.Type)
+	if err != nil {
+		slog.Warn("failed to generate attachment thumbnails", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		return
+	}
+	for size, thumbnailBlob := range thumbnails {
+		if err := s.Store.SaveAttachmentThumbnail(ctx, attachment, size, thumbnailBlob); err != nil {
+			slog.Warn("failed to save attachment thumbnail", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		}
+	}
+}
+
 func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAttachmentsRequest) (*v1pb.ListAttachmentsResponse, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -148,28 +363,41 @@ func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAt
 	}
 
 	findAttachment := &store.FindAttachment{
-		CreatorID: &user.ID,
-		Limit:     &pageSize,
-		Offset:    &offset,
+		Limit:  &pageSize,
+		Offset: &offset,
+	}
+	// Only the host can list the attachments of all users, on request.
+	if request.AllUsers {
+		if user.Role != store.RoleHost {
+			return nil, status.Errorf(codes.PermissionDenied, "only the host can list the attachments of all users")
+		}
+	} else {
+		findAttachment.CreatorID = &user.ID
+	}
+	if request.Filter != "" {
+		if err := applyAttachmentFilter(request.Filter, findAttachment); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
 	}
 
 	attachments, err := s.Store.ListAttachments(ctx, findAttachment)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
 	}
+	totalSize, err := s.Store.CountAttachments(ctx, findAttachment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count attachments: %v", err)
+	}
 
-	response := &v1pb.ListAttachmentsResponse{}
-
+	response := &v1pb.ListAttachmentsResponse{
+		TotalSize: int32(totalSize),
+	}
 	for _, attachment := range attachments {
 		response.Attachments = append(response.Attachments, convertAttachmentFromStore(attachment))
 	}
 
-	// For simplicity, set total size to the number of returned attachments.
-	// In a full implementation, you'd want a separate count query
-	response.TotalSize = int32(len(response.Attachments))
-
-	// Set next page token if we got the full page size (indicating there might be more)
-	if len(attachments) == pageSize {
+	// Set next page token if there are attachments after the page
+	if offset+len(attachments) < totalSize {
 		response.NextPageToken = fmt.Sprintf("%d", offset+pageSize)
 	}
 
@@ -215,6 +443,8 @@ func (s *APIV1Service) UpdateAttachment(ctx context.Context, request *v1pb.Updat
 				return nil, status.Errorf(codes.InvalidArgument, "filename contains invalid characters or format")
 			}
 			update.Filename = &request.Attachment.Filename
+		} else if field == "library" {
+			update.Library = &request.Attachment.Library
 		}
 	}
 
@@ -257,13 +487,105 @@ func (s *APIV1Service) DeleteAttachment(ctx context.Context, request *v1pb.Delet
 	return &emptypb.Empty{}, nil
 }
 
+// PurgeOrphanedAttachments deletes the attachments without a memo older than the grace period
+// of the storage setting, with their files, except library uploads.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Admin only.
+func (s *APIV1Service) PurgeOrphanedAttachments(ctx context.Context, _ *v1pb.PurgeOrphanedAttachmentsRequest) (*v1pb.PurgeOrphanedAttachmentsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+	}
+	cutoffSec := time.Now().AddDate(0, 0, -int(instanceStorageSetting.OrphanedAttachmentGraceDays)).Unix()
+	result, err := s.Store.PurgeOrphanedAttachments(ctx, cutoffSec)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge orphaned attachments: %v", err)
+	}
+	return &v1pb.PurgeOrphanedAttachmentsResponse{
+		PurgedCount:    int32(result.PurgedCount),
+		ReclaimedBytes: result.ReclaimedBytes,
+	}, nil
+}
+
+// DeduplicateAttachments hashes the local files of the attachments uploaded before the content
+// hashes, and makes the attachments of a user with the same content share a single file.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Admin only.
+func (s *APIV1Service) DeduplicateAttachments(ctx context.Context, _ *v1pb.DeduplicateAttachmentsRequest) (*v1pb.DeduplicateAttachmentsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	result, err := s.Store.DeduplicateLocalAttachments(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to deduplicate attachments: %v", err)
+	}
+	return &v1pb.DeduplicateAttachmentsResponse{
+		HashedCount:       int32(result.HashedCount),
+		DeduplicatedCount: int32(result.DeduplicatedCount),
+		SavedBytes:        result.SavedBytes,
+	}, nil
+}
+
+// RecalculateStorageUsage recomputes the storage usages of all users from their attachments.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Admin only.
+func (s *APIV1Service) RecalculateStorageUsage(ctx context.Context, _ *v1pb.RecalculateStorageUsageRequest) (*v1pb.RecalculateStorageUsageResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	usages, err := s.Store.RecalculateUserStorageUsages(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to recalculate storage usages: %v", err)
+	}
+	response := &v1pb.RecalculateStorageUsageResponse{UserCount: int32(len(usages))}
+	for _, usage := range usages {
+		response.TotalBytes += usage.UsedBytes
+	}
+	return response, nil
+}
+
 func convertAttachmentFromStore(attachment *store.Attachment) *v1pb.Attachment {
 	attachmentMessage := &v1pb.Attachment{
-		Name:       fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID),
-		CreateTime: timestamppb.New(time.Unix(attachment.CreatedTs, 0)),
-		Filename:   attachment.Filename,
-		Type:       attachment.Type,
-		Size:       attachment.Size,
+		Name:          fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID),
+		CreateTime:    timestamppb.New(time.Unix(attachment.CreatedTs, 0)),
+		Filename:      attachment.Filename,
+		Type:          attachment.Type,
+		Size:          attachment.Size,
+		Library:       attachment.Library,
+		OriginalSize:  attachment.Payload.GetOriginalSize(),
+		Duration:      attachment.Payload.GetDuration(),
+		Broken:        attachment.Payload.GetExternalLink().GetBroken(),
+		LinkCheckTime: attachment.Payload.GetExternalLink().GetLastCheckTime(),
 	}
 	if attachment.MemoUID != nil && *attachment.MemoUID != "" {
 		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, *attachment.MemoUID)
@@ -284,23 +606,9 @@ func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *s
 	}
 
 	if instanceStorageSetting.StorageType == storepb.InstanceStorageSetting_LOCAL {
-		filepathTemplate := "assets/{timestamp}_{filename}"
-		if instanceStorageSetting.FilepathTemplate != "" {
-			filepathTemplate = instanceStorageSetting.FilepathTemplate
-		}
-
-		internalPath := filepathTemplate
-		if !strings.Contains(internalPath, "{filename}") {
-			internalPath = filepath.Join(internalPath, "{filename}")
-		}
-		internalPath = replaceFilenameWithPathTemplate(internalPath, create.Filename)
-		internalPath = filepath.ToSlash(internalPath)
+		internalPath, osPath := getLocalAttachmentFilepath(profile, instanceStorageSetting, create.Filename)
 
 		// Ensure the directory exists.
-		osPath := filepath.FromSlash(internalPath)
-		if !filepath.IsAbs(osPath) {
-			osPath = filepath.Join(profile.Data, osPath)
-		}
 		dir := filepath.Dir(osPath)
 		if err = os.MkdirAll(dir, os.ModePerm); err != nil {
 			return errors.Wrap(err, "Failed to create directory")
@@ -328,12 +636,7 @@ func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *s
 			return errors.Wrap(err, "Failed to create s3 client")
 		}
 
-		filepathTemplate := instanceStorageSetting.FilepathTemplate
-		if !strings.Contains(filepathTemplate, "{filename}") {
-			filepathTemplate = filepath.Join(filepathTemplate, "{filename}")
-		}
-		filepathTemplate = replaceFilenameWithPathTemplate(filepathTemplate, create.Filename)
-		key, err := s3Client.UploadObject(ctx, filepathTemplate, create.Type, bytes.NewReader(create.Blob))
+		key, err := s3Client.UploadObject(ctx, getS3ObjectKey(instanceStorageSetting, create.Filename), create.Type, bytes.NewReader(create.Blob))
 		if err != nil {
 			return errors.Wrap(err, "Failed to upload via s3 client")
 		}
@@ -412,6 +715,37 @@ func (s *APIV1Service) GetAttachmentBlob(attachment *store.Attachment) ([]byte,
 	return attachment.Blob, nil
 }
 
+// getLocalAttachmentFilepath returns the reference of the local file of a new attachment, from the
+// filepath template, and its path on the disk.
+func getLocalAttachmentFilepath(profile *profile.Profile, instanceStorageSetting *storepb.InstanceStorageSetting, filename string) (string, string) {
+	filepathTemplate := "assets/{timestamp}_{filename}"
+	if instanceStorageSetting.FilepathTemplate != "" {
+		filepathTemplate = instanceStorageSetting.FilepathTemplate
+	}
+
+	internalPath := filepathTemplate
+	if !strings.Contains(internalPath, "{filename}") {
+		internalPath = filepath.Join(internalPath, "{filename}")
+	}
+	internalPath = replaceFilenameWithPathTemplate(internalPath, filename)
+	internalPath = filepath.ToSlash(internalPath)
+
+	osPath := filepath.FromSlash(internalPath)
+	if !filepath.IsAbs(osPath) {
+		osPath = filepath.Join(profile.Data, osPath)
+	}
+	return internalPath, osPath
+}
+
+// getS3ObjectKey returns the key of the S3 object of a new attachment, from the filepath template.
+func getS3ObjectKey(instanceStorageSetting *storepb.InstanceStorageSetting, filename string) string {
+	filepathTemplate := instanceStorageSetting.FilepathTemplate
+	if !strings.Contains(filepathTemplate, "{filename}") {
+		filepathTemplate = filepath.Join(filepathTemplate, "{filename}")
+	}
+	return replaceFilenameWithPathTemplate(filepathTemplate, filename)
+}
+
 var fileKeyPattern = regexp.MustCompile(`\{[a-z]{1,9}\}`)
 
 func replaceFilenameWithPathTemplate(path, filename string) string {
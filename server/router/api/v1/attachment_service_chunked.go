@@ -0,0 +1,488 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/audiometa"
+	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/thumbnail"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// attachmentChunkSize is the size of the chunks of a chunked upload, at least the minimum size
+	// of the parts of an S3 multipart upload.
+	attachmentChunkSize = 8 * MebiByte
+	// maxAttachmentChunkCount is the maximum number of parts of an S3 multipart upload.
+	maxAttachmentChunkCount = 10000
+	// chunkedUploadExpiry is how long a chunked upload can be resumed. The runner purging the
+	// abandoned uploads keeps the pending attachments for as long.
+	chunkedUploadExpiry = 48 * time.Hour
+)
+
+// CreateChunkedUpload starts a chunked upload: the pending attachment is created with the content
+// hash, and the chunks are kept in the data directory, or uploaded as the parts of an S3 multipart
+// upload, until the upload is completed. The size limit and the storage quota are checked upfront.
+//
+// Authentication: Required.
+func (s *APIV1Service) CreateChunkedUpload(ctx context.Context, request *v1pb.CreateChunkedUploadRequest) (*v1pb.ChunkedUpload, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := validateAttachmentCreate(request.Attachment); err != nil {
+		return nil, err
+	}
+	contentHash := strings.ToLower(request.ContentHash)
+	if hash, err := hex.DecodeString(contentHash); err != nil || len(hash) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "content hash must be a hex-encoded SHA-256")
+	}
+
+	instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+	}
+	if request.Size <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be positive")
+	}
+	if request.Size > getUploadSizeLimit(instanceStorageSetting) {
+		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
+	}
+	if getAttachmentChunkCount(request.Size, attachmentChunkSize) > maxAttachmentChunkCount {
+		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit of chunked uploads")
+	}
+	process, err := s.shouldProcessImageUpload(ctx, user.ID, instanceStorageSetting, request.Attachment.Type, request.Size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	if process {
+		return nil, status.Errorf(codes.FailedPrecondition, "the image is processed on upload, upload the image with CreateAttachment")
+	}
+	if err := s.checkStorageQuota(ctx, user.ID, request.Size); err != nil {
+		return nil, err
+	}
+
+	attachmentUID := request.AttachmentId
+	if attachmentUID == "" {
+		attachmentUID = shortuuid.New()
+	}
+	create := &store.Attachment{
+		UID:         attachmentUID,
+		CreatorID:   user.ID,
+		Filename:    request.Attachment.Filename,
+		Type:        request.Attachment.Type,
+		Size:        request.Size,
+		Library:     request.Attachment.Library,
+		ContentHash: contentHash,
+		Pending:     true,
+		Payload: &storepb.AttachmentPayload{
+			ChunkedUpload: &storepb.AttachmentPayload_ChunkedUpload{ChunkSize: attachmentChunkSize},
+		},
+	}
+	if create.MemoID, err = s.getAttachmentMemoID(ctx, request.Attachment.Memo); err != nil {
+		return nil, err
+	}
+	switch instanceStorageSetting.StorageType {
+	case storepb.InstanceStorageSetting_LOCAL:
+		create.StorageType = storepb.AttachmentStorageType_LOCAL
+	case storepb.InstanceStorageSetting_S3:
+		if instanceStorageSetting.S3Config == nil {
+			return nil, status.Errorf(codes.Internal, "no activated external storage found")
+		}
+		s3Client, err := s3.NewClient(ctx, instanceStorageSetting.S3Config)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+		}
+		key := getS3ObjectKey(instanceStorageSetting, create.Filename)
+		uploadID, err := s3Client.CreateMultipartUpload(ctx, key, create.Type)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create multipart upload: %v", err)
+		}
+		create.StorageType = storepb.AttachmentStorageType_S3
+		create.Payload.Payload = &storepb.AttachmentPayload_S3Object_{
+			S3Object: &storepb.AttachmentPayload_S3Object{
+				S3Config: instanceStorageSetting.S3Config,
+				Key:      key,
+			},
+		}
+		create.Payload.ChunkedUpload.S3UploadId = uploadID
+	default:
+		// The chunks are assembled into the blob of the attachment.
+	}
+	attachment, err := s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	return convertChunkedUploadFromStore(attachment, map[int]int64{}), nil
+}
+
+// UploadAttachmentChunk uploads a chunk of a chunked upload. All chunks but the last one have the
+// chunk size, and the checksum of each chunk is checked, so that a corrupted chunk is uploaded
+// again rather than failing the whole upload.
+//
+// Authentication: Required.
+func (s *APIV1Service) UploadAttachmentChunk(ctx context.Context, request *v1pb.UploadAttachmentChunkRequest) (*emptypb.Empty, error) {
+	attachment, err := s.getChunkedUploadAttachment(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	chunkedUpload := attachment.Payload.GetChunkedUpload()
+	chunkCount := getAttachmentChunkCount(attachment.Size, chunkedUpload.ChunkSize)
+	if request.Index < 0 || int(request.Index) >= chunkCount {
+		return nil, status.Errorf(codes.InvalidArgument, "chunk index must be between 0 and %d", chunkCount-1)
+	}
+	chunkSize := getAttachmentChunkSize(attachment.Size, chunkedUpload.ChunkSize, int(request.Index))
+	if int64(len(request.Content)) != chunkSize {
+		return nil, status.Errorf(codes.InvalidArgument, "chunk %d must be %d bytes, got %d bytes", request.Index, chunkSize, len(request.Content))
+	}
+	if !strings.EqualFold(request.Checksum, store.HashAttachmentBlob(request.Content)) {
+		return nil, status.Errorf(codes.InvalidArgument, "checksum of chunk %d doesn't match its content", request.Index)
+	}
+
+	if chunkedUpload.S3UploadId == "" {
+		if err := s.Store.SaveAttachmentChunk(attachment, int(request.Index), request.Content); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save chunk: %v", err)
+		}
+		return &emptypb.Empty{}, nil
+	}
+	s3Object := attachment.Payload.GetS3Object()
+	s3Client, err := s3.NewClient(ctx, s3Object.GetS3Config())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+	}
+	if err := s3Client.UploadPart(ctx, s3Object.Key, chunkedUpload.S3UploadId, request.Index+1, request.Content); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upload chunk: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetChunkedUpload returns the status of a chunked upload, so that an interrupted upload can be
+// resumed with the missing chunks.
+//
+// Authentication: Required.
+func (s *APIV1Service) GetChunkedUpload(ctx context.Context, request *v1pb.GetChunkedUploadRequest) (*v1pb.ChunkedUpload, error) {
+	attachment, err := s.getChunkedUploadAttachment(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	chunkSizes, err := s.listAttachmentChunkSizes(ctx, attachment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list chunks: %v", err)
+	}
+	return convertChunkedUploadFromStore(attachment, chunkSizes), nil
+}
+
+// CompleteChunkedUpload assembles the chunks of a chunked upload into the local file, the blob or
+// the S3 object of the attachment, which must match the content hash given when the upload was
+// started. An upload whose content doesn't match is discarded. The attachment then shares the
+// blob of an identical upload, if any, and is no longer pending.
+//
+// Authentication: Required.
+func (s *APIV1Service) CompleteChunkedUpload(ctx context.Context, request *v1pb.CompleteChunkedUploadRequest) (*v1pb.Attachment, error) {
+	attachment, err := s.getChunkedUploadAttachment(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	chunkSizes, err := s.listAttachmentChunkSizes(ctx, attachment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list chunks: %v", err)
+	}
+	if missingChunks := getMissingAttachmentChunks(attachment, chunkSizes); len(missingChunks) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%d chunks have not been uploaded", len(missingChunks))
+	}
+
+	// The content is kept in memory for the blob of a database attachment, the thumbnails of an
+	// image and the duration of an audio file only.
+	hasher := sha256.New()
+	var content *bytes.Buffer
+	if attachment.StorageType == storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED || thumbnail.IsSupported(attachment.Type) || audiometa.IsSupported(attachment.Type) {
+		content = &bytes.Buffer{}
+	}
+	update := &store.UpdateAttachment{ID: attachment.ID}
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+		}
+		reference, err := s.assembleLocalAttachmentChunks(attachment, instanceStorageSetting, newAttachmentContentWriter(hasher, content))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to assemble chunks: %v", err)
+		}
+		update.Reference = &reference
+		update.Payload = &storepb.AttachmentPayload{}
+	case storepb.AttachmentStorageType_S3:
+		reference, err := assembleS3AttachmentChunks(ctx, attachment, newAttachmentContentWriter(hasher, content))
+		if err != nil {
+			// The upload can't be resumed once the multipart upload is completed.
+			if attachment.Payload.GetChunkedUpload() == nil {
+				if err := s.Store.DeletePendingAttachment(ctx, attachment); err != nil {
+					slog.Warn("failed to delete pending attachment", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+				}
+			}
+			return nil, status.Errorf(codes.Internal, "failed to assemble chunks: %v", err)
+		}
+		update.Reference = &reference
+		update.Payload = &storepb.AttachmentPayload{Payload: attachment.Payload.Payload}
+	default:
+		if err := s.Store.CopyAttachmentChunks(attachment, len(chunkSizes), newAttachmentContentWriter(hasher, content)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to assemble chunks: %v", err)
+		}
+		update.Blob = content.Bytes()
+		update.Payload = &storepb.AttachmentPayload{}
+	}
+	if err := s.Store.DeleteAttachmentChunks(ctx, attachment); err != nil {
+		slog.Warn("failed to delete attachment chunks", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+	}
+	if contentHash := hex.EncodeToString(hasher.Sum(nil)); contentHash != attachment.ContentHash {
+		// The S3 object is deleted with the pending attachment.
+		if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
+			s.deleteAssembledAttachmentBlob(ctx, attachment, update)
+		}
+		if err := s.Store.DeletePendingAttachment(ctx, attachment); err != nil {
+			slog.Warn("failed to delete pending attachment", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "content hash %s doesn't match the content hash %s of the upload, the upload is discarded", contentHash, attachment.ContentHash)
+	}
+
+	if content != nil {
+		update.Payload.Duration = readAudioDuration(attachment, content.Bytes())
+	}
+
+	duplicate, err := s.findDuplicateAttachmentBlob(ctx, attachment.CreatorID, attachment.ContentHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find duplicate attachment: %v", err)
+	}
+	if duplicate != nil {
+		// The upload shares the local file or S3 object, and the thumbnails, of an identical upload.
+		s.deleteAssembledAttachmentBlob(ctx, attachment, update)
+		update.StorageType = &duplicate.StorageType
+		update.Reference = &duplicate.Reference
+		update.Payload = duplicate.Payload
+		update.Blob = nil
+	}
+	pending := false
+	update.Pending = &pending
+	if err := s.Store.UpdateAttachment(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update attachment: %v", err)
+	}
+	completed, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if duplicate == nil {
+		if err := s.Store.AddUserStorageUsage(ctx, completed.CreatorID, completed.Size); err != nil {
+			slog.Warn("failed to update storage usage", slog.Int("user", int(completed.CreatorID)), slog.String("error", err.Error()))
+		}
+		if content != nil {
+			s.generateAttachmentThumbnails(ctx, completed, content.Bytes())
+		}
+	}
+	return convertAttachmentFromStore(completed), nil
+}
+
+// getChunkedUploadAttachment returns the pending attachment of a chunked upload of the current
+// user that hasn't expired.
+func (s *APIV1Service) getChunkedUploadAttachment(ctx context.Context, name string) (*store.Attachment, error) {
+	attachmentUID, err := ExtractAttachmentUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID, CreatorID: &user.ID, Pending: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find attachment: %v", err)
+	}
+	if attachment == nil || attachment.Payload.GetChunkedUpload() == nil {
+		return nil, status.Errorf(codes.NotFound, "chunked upload not found")
+	}
+	if time.Now().After(getChunkedUploadExpireTime(attachment)) {
+		return nil, status.Errorf(codes.FailedPrecondition, "the chunked upload has expired")
+	}
+	return attachment, nil
+}
+
+// listAttachmentChunkSizes returns the sizes of the uploaded chunks of a chunked upload by index.
+func (s *APIV1Service) listAttachmentChunkSizes(ctx context.Context, attachment *store.Attachment) (map[int]int64, error) {
+	chunkedUpload := attachment.Payload.GetChunkedUpload()
+	if chunkedUpload.S3UploadId == "" {
+		return s.Store.ListAttachmentChunks(attachment)
+	}
+	s3Object := attachment.Payload.GetS3Object()
+	s3Client, err := s3.NewClient(ctx, s3Object.GetS3Config())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create s3 client")
+	}
+	parts, err := s3Client.ListParts(ctx, s3Object.Key, chunkedUpload.S3UploadId)
+	if err != nil {
+		return nil, err
+	}
+	chunkSizes := map[int]int64{}
+	for _, part := range parts {
+		chunkSizes[int(part.Number)-1] = part.Size
+	}
+	return chunkSizes, nil
+}
+
+// assembleLocalAttachmentChunks writes the chunks of a chunked upload to the local file of the
+// attachment and to w, returning the reference of the file.
+func (s *APIV1Service) assembleLocalAttachmentChunks(attachment *store.Attachment, instanceStorageSetting *storepb.InstanceStorageSetting, w io.Writer) (string, error) {
+	reference, osPath := getLocalAttachmentFilepath(s.Profile, instanceStorageSetting, attachment.Filename)
+	if err := os.MkdirAll(filepath.Dir(osPath), os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed to create directory")
+	}
+	// The file is assembled in a temporary file first, so that it's never read partially written.
+	tmpFile, err := os.CreateTemp(filepath.Dir(osPath), filepath.Base(osPath)+".*.tmp")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create file")
+	}
+	defer os.Remove(tmpFile.Name())
+	chunkCount := getAttachmentChunkCount(attachment.Size, attachment.Payload.GetChunkedUpload().ChunkSize)
+	if err := s.Store.CopyAttachmentChunks(attachment, chunkCount, io.MultiWriter(tmpFile, w)); err != nil {
+		tmpFile.Close()
+		return "", err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to write file")
+	}
+	if err := os.Rename(tmpFile.Name(), osPath); err != nil {
+		return "", errors.Wrap(err, "failed to save file")
+	}
+	return reference, nil
+}
+
+// assembleS3AttachmentChunks completes the S3 multipart upload of a chunked upload, then reads the
+// object back into w, as S3 doesn't compute the SHA-256 of the whole object. It returns the
+// presigned URL of the object.
+func assembleS3AttachmentChunks(ctx context.Context, attachment *store.Attachment, w io.Writer) (string, error) {
+	chunkedUpload := attachment.Payload.GetChunkedUpload()
+	s3Object := attachment.Payload.GetS3Object()
+	s3Client, err := s3.NewClient(ctx, s3Object.GetS3Config())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create s3 client")
+	}
+	parts, err := s3Client.ListParts(ctx, s3Object.Key, chunkedUpload.S3UploadId)
+	if err != nil {
+		return "", err
+	}
+	if err := s3Client.CompleteMultipartUpload(ctx, s3Object.Key, chunkedUpload.S3UploadId, parts); err != nil {
+		return "", err
+	}
+	// The multipart upload is gone once completed, so the object is deleted with the attachment.
+	attachment.Payload.ChunkedUpload = nil
+
+	reader, err := s3Client.GetObjectReader(ctx, s3Object.Key)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	if _, err := io.Copy(w, reader); err != nil {
+		return "", errors.Wrap(err, "failed to read object")
+	}
+	presignURL, err := s3Client.PresignGetObject(ctx, s3Object.Key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to presign object")
+	}
+	s3Object.LastPresignedTime = timestamppb.Now()
+	return presignURL, nil
+}
+
+// deleteAssembledAttachmentBlob deletes the local file or S3 object assembled from the chunks of
+// an upload that isn't kept.
+func (s *APIV1Service) deleteAssembledAttachmentBlob(ctx context.Context, attachment *store.Attachment, update *store.UpdateAttachment) {
+	var err error
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		attachmentPath := filepath.FromSlash(*update.Reference)
+		if !filepath.IsAbs(attachmentPath) {
+			attachmentPath = filepath.Join(s.Profile.Data, attachmentPath)
+		}
+		err = os.Remove(attachmentPath)
+	case storepb.AttachmentStorageType_S3:
+		var s3Client *s3.Client
+		s3Client, err = s3.NewClient(ctx, attachment.Payload.GetS3Object().GetS3Config())
+		if err == nil {
+			err = s3Client.DeleteObject(ctx, attachment.Payload.GetS3Object().Key)
+		}
+	default:
+	}
+	if err != nil {
+		slog.Warn("failed to delete assembled attachment blob", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+	}
+}
+
+// newAttachmentContentWriter returns a writer hashing the assembled content of an upload, and
+// keeping it in content if set.
+func newAttachmentContentWriter(hasher hash.Hash, content *bytes.Buffer) io.Writer {
+	if content == nil {
+		return hasher
+	}
+	return io.MultiWriter(hasher, content)
+}
+
+func convertChunkedUploadFromStore(attachment *store.Attachment, chunkSizes map[int]int64) *v1pb.ChunkedUpload {
+	chunkedUpload := attachment.Payload.GetChunkedUpload()
+	return &v1pb.ChunkedUpload{
+		Name:          fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID),
+		Size:          attachment.Size,
+		ChunkSize:     chunkedUpload.ChunkSize,
+		ChunkCount:    int32(getAttachmentChunkCount(attachment.Size, chunkedUpload.ChunkSize)),
+		MissingChunks: getMissingAttachmentChunks(attachment, chunkSizes),
+		ExpireTime:    timestamppb.New(getChunkedUploadExpireTime(attachment)),
+	}
+}
+
+// getMissingAttachmentChunks returns the indexes of the chunks of a chunked upload that haven't
+// been uploaded with their size.
+func getMissingAttachmentChunks(attachment *store.Attachment, chunkSizes map[int]int64) []int32 {
+	chunkSize := attachment.Payload.GetChunkedUpload().ChunkSize
+	missingChunks := []int32{}
+	for index := 0; index < getAttachmentChunkCount(attachment.Size, chunkSize); index++ {
+		if size, ok := chunkSizes[index]; !ok || size != getAttachmentChunkSize(attachment.Size, chunkSize, index) {
+			missingChunks = append(missingChunks, int32(index))
+		}
+	}
+	return missingChunks
+}
+
+func getAttachmentChunkCount(size, chunkSize int64) int {
+	return int((size + chunkSize - 1) / chunkSize)
+}
+
+// getAttachmentChunkSize returns the size of a chunk: the chunk size, except for the last chunk.
+func getAttachmentChunkSize(size, chunkSize int64, index int) int64 {
+	return min(chunkSize, size-int64(index)*chunkSize)
+}
+
+func getChunkedUploadExpireTime(attachment *store.Attachment) time.Time {
+	return time.Unix(attachment.CreatedTs, 0).Add(chunkedUploadExpiry)
+}
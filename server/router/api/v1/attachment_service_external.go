@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/router/linkpreview"
+	"github.com/usememos/memos/store"
+)
+
+// createExternalAttachment creates an attachment linking to an external file instead of storing
+// its content, once the link responds. The type and size of the attachment are taken from the
+// response when it declares them, as they're more accurate than the guesses of the client.
+func (s *APIV1Service) createExternalAttachment(ctx context.Context, user *store.User, request *v1pb.CreateAttachmentRequest) (*v1pb.Attachment, error) {
+	externalLink := request.Attachment.ExternalLink
+	if len(request.Attachment.Content) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "an attachment can't have both content and an external link")
+	}
+	u, err := url.Parse(externalLink)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "external link must be an http or https URL")
+	}
+	filename := request.Attachment.Filename
+	if filename == "" {
+		filename = path.Base(u.Path)
+	}
+	if !validateFilename(filename) {
+		return nil, status.Errorf(codes.InvalidArgument, "filename contains invalid characters or format")
+	}
+
+	link, err := s.LinkPreviewService.CheckLink(ctx, user, externalLink)
+	if err != nil {
+		return nil, convertExternalLinkError(err)
+	}
+	if link.StatusCode < 200 || link.StatusCode >= 300 {
+		return nil, status.Errorf(codes.InvalidArgument, "external link responded with status %d", link.StatusCode)
+	}
+
+	attachmentUID := request.AttachmentId
+	if attachmentUID == "" {
+		attachmentUID = shortuuid.New()
+	}
+	create := &store.Attachment{
+		UID:         attachmentUID,
+		CreatorID:   user.ID,
+		Filename:    filename,
+		Type:        request.Attachment.Type,
+		Library:     request.Attachment.Library,
+		StorageType: storepb.AttachmentStorageType_EXTERNAL,
+		Reference:   externalLink,
+		Payload: &storepb.AttachmentPayload{
+			ExternalLink: newExternalLinkPayload(link.StatusCode),
+		},
+	}
+	// Servers not knowing the type of a file answer with the generic binary type.
+	if create.Type == "" || link.Mediatype != "application/octet-stream" {
+		create.Type = link.Mediatype
+	}
+	if link.ContentLength > 0 {
+		create.Size = link.ContentLength
+	}
+	if create.MemoID, err = s.getAttachmentMemoID(ctx, request.Attachment.Memo); err != nil {
+		return nil, err
+	}
+	attachment, err := s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	return convertAttachmentFromStore(attachment), nil
+}
+
+// RefreshExternalAttachment checks again that the external link of an attachment responds,
+// marking the attachment as broken when the link answers 404 or 410.
+//
+// Authentication: Required. Only the creator of the attachment can refresh it.
+func (s *APIV1Service) RefreshExternalAttachment(ctx context.Context, request *v1pb.RefreshExternalAttachmentRequest) (*v1pb.Attachment, error) {
+	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{
+		UID:       &attachmentUID,
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find attachment: %v", err)
+	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if attachment.StorageType != storepb.AttachmentStorageType_EXTERNAL {
+		return nil, status.Errorf(codes.FailedPrecondition, "attachment doesn't have an external link")
+	}
+	attachment, err = s.CheckExternalAttachment(ctx, attachment)
+	if err != nil {
+		return nil, convertExternalLinkError(err)
+	}
+	return convertAttachmentFromStore(attachment), nil
+}
+
+// CheckExternalAttachment checks the external link of an attachment on behalf of its creator, and
+// records the response. Nothing is recorded when the link can't be fetched, so that the links of a
+// host that is down for a while aren't marked as broken.
+func (s *APIV1Service) CheckExternalAttachment(ctx context.Context, attachment *store.Attachment) (*store.Attachment, error) {
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &attachment.CreatorID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attachment creator")
+	}
+	if creator == nil {
+		return nil, errors.Errorf("creator of attachment %s not found", attachment.UID)
+	}
+	link, err := s.LinkPreviewService.CheckLink(ctx, creator, attachment.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &storepb.AttachmentPayload{}
+	if attachment.Payload != nil {
+		payload = proto.CloneOf(attachment.Payload)
+	}
+	payload.ExternalLink = newExternalLinkPayload(link.StatusCode)
+	if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{ID: attachment.ID, Payload: payload}); err != nil {
+		return nil, errors.Wrap(err, "failed to update attachment")
+	}
+	attachment.Payload = payload
+	return attachment, nil
+}
+
+// newExternalLinkPayload returns the state of an external link answering statusCode. Only the
+// links whose files are gone are broken, other errors may be temporary.
+func newExternalLinkPayload(statusCode int) *storepb.AttachmentPayload_ExternalLink {
+	return &storepb.AttachmentPayload_ExternalLink{
+		LastCheckTime: timestamppb.New(time.Now()),
+		StatusCode:    int32(statusCode),
+		Broken:        statusCode == http.StatusNotFound || statusCode == http.StatusGone,
+	}
+}
+
+// convertExternalLinkError maps the errors of the checks of external links to status errors.
+func convertExternalLinkError(err error) error {
+	var rateLimitErr *linkpreview.RateLimitError
+	switch {
+	case errors.As(err, &rateLimitErr):
+		return status.Errorf(codes.ResourceExhausted, "%v", rateLimitErr)
+	case errors.Is(err, linkpreview.ErrDomainNotAllowed):
+		return status.Errorf(codes.PermissionDenied, "external link is not allowed: %v", err)
+	case errors.Is(err, httpgetter.ErrTimeout):
+		return status.Errorf(codes.DeadlineExceeded, "timed out checking external link")
+	default:
+		return status.Errorf(codes.InvalidArgument, "failed to check external link: %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// applyAttachmentFilter parses a CEL attachment filter into the conditions of find. The filter is
+// a conjunction of conditions on the filename, the type, the size and the creation time of the
+// attachments, and on whether they're attached to a memo. The type is named mime_type, as type is
+// reserved in CEL.
+func applyAttachmentFilter(filter string, find *store.FindAttachment) error {
+	env, err := cel.NewEnv(
+		cel.Variable("filename", cel.StringType),
+		cel.Variable("mime_type", cel.StringType),
+		cel.Variable("size", cel.IntType),
+		cel.Variable("create_time", cel.TimestampType),
+		cel.Variable("has_memo", cel.BoolType),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create CEL environment")
+	}
+	celAST, issues := env.Compile(filter)
+	if issues != nil && issues.Err() != nil {
+		return errors.Wrapf(issues.Err(), "invalid filter expression: %s", filter)
+	}
+	return applyAttachmentFilterExpr(celAST.NativeRep().Expr(), find)
+}
+
+func applyAttachmentFilterExpr(expr ast.Expr, find *store.FindAttachment) error {
+	switch expr.Kind() {
+	case ast.IdentKind:
+		if expr.AsIdent() == "has_memo" {
+			setAttachmentFilterHasMemo(find, true)
+			return nil
+		}
+	case ast.CallKind:
+		call := expr.AsCall()
+		args := call.Args()
+		switch call.FunctionName() {
+		case operators.LogicalAnd:
+			for _, arg := range args {
+				if err := applyAttachmentFilterExpr(arg, find); err != nil {
+					return err
+				}
+			}
+			return nil
+		case operators.LogicalNot:
+			if args[0].Kind() == ast.IdentKind && args[0].AsIdent() == "has_memo" {
+				setAttachmentFilterHasMemo(find, false)
+				return nil
+			}
+		case "contains", "startsWith":
+			return applyAttachmentFilterStringCall(call, find)
+		case operators.Equals, operators.Less, operators.LessEquals, operators.Greater, operators.GreaterEquals:
+			return applyAttachmentFilterComparison(call.FunctionName(), args[0], args[1], find)
+		default:
+		}
+	default:
+	}
+	return errors.New("filter conditions must be joined with && and be one of filename.contains(), mime_type.startsWith(), size or create_time comparisons, and has_memo")
+}
+
+// applyAttachmentFilterStringCall applies the filename.contains() and mime_type.startsWith() conditions.
+func applyAttachmentFilterStringCall(call ast.CallExpr, find *store.FindAttachment) error {
+	if !call.IsMemberFunction() || call.Target().Kind() != ast.IdentKind || len(call.Args()) != 1 {
+		return errors.Errorf("unsupported %s() call", call.FunctionName())
+	}
+	value, ok := getAttachmentFilterLiteral(call.Args()[0]).(string)
+	if !ok {
+		return errors.Errorf("%s() only supports string literals", call.FunctionName())
+	}
+	switch field := call.Target().AsIdent(); {
+	case field == "filename" && call.FunctionName() == "contains":
+		find.FilenameSearch = &value
+	case field == "mime_type" && call.FunctionName() == "startsWith":
+		find.TypePrefix = &value
+	default:
+		return errors.Errorf("%s.%s() isn't supported", field, call.FunctionName())
+	}
+	return nil
+}
+
+// applyAttachmentFilterComparison applies the comparisons of the size, the creation time and
+// has_memo to a literal. The bounds are merged with the bounds of the previous conditions.
+func applyAttachmentFilterComparison(operator string, left, right ast.Expr, find *store.FindAttachment) error {
+	if left.Kind() != ast.IdentKind {
+		return errors.New("comparisons must have the field on the left")
+	}
+	field := left.AsIdent()
+	switch field {
+	case "size":
+		size, ok := getAttachmentFilterLiteral(right).(int64)
+		if !ok {
+			return errors.New("size must be compared to an integer")
+		}
+		switch operator {
+		case operators.Equals:
+			find.SizeMin, find.SizeMax = maxBound(find.SizeMin, size), minBound(find.SizeMax, size)
+		case operators.Less:
+			find.SizeMax = minBound(find.SizeMax, size-1)
+		case operators.LessEquals:
+			find.SizeMax = minBound(find.SizeMax, size)
+		case operators.Greater:
+			find.SizeMin = maxBound(find.SizeMin, size+1)
+		default:
+			find.SizeMin = maxBound(find.SizeMin, size)
+		}
+	case "create_time":
+		createTime, err := getAttachmentFilterTimestamp(right)
+		if err != nil {
+			return err
+		}
+		// The end of the time range is excluded.
+		createdTsSec := createTime.Unix()
+		switch operator {
+		case operators.Equals:
+			find.CreatedTsAfter, find.CreatedTsBefore = maxBound(find.CreatedTsAfter, createdTsSec), minBound(find.CreatedTsBefore, createdTsSec+1)
+		case operators.Less:
+			find.CreatedTsBefore = minBound(find.CreatedTsBefore, createdTsSec)
+		case operators.LessEquals:
+			find.CreatedTsBefore = minBound(find.CreatedTsBefore, createdTsSec+1)
+		case operators.Greater:
+			find.CreatedTsAfter = maxBound(find.CreatedTsAfter, createdTsSec+1)
+		default:
+			find.CreatedTsAfter = maxBound(find.CreatedTsAfter, createdTsSec)
+		}
+	case "has_memo":
+		hasMemo, ok := getAttachmentFilterLiteral(right).(bool)
+		if !ok || operator != operators.Equals {
+			return errors.New("has_memo must be compared with == to a boolean")
+		}
+		setAttachmentFilterHasMemo(find, hasMemo)
+	default:
+		return errors.Errorf("%s can't be compared", field)
+	}
+	return nil
+}
+
+// getAttachmentFilterTimestamp returns the time of a timestamp("...") call of the filter.
+func getAttachmentFilterTimestamp(expr ast.Expr) (time.Time, error) {
+	if expr.Kind() != ast.CallKind || expr.AsCall().FunctionName() != "timestamp" || len(expr.AsCall().Args()) != 1 {
+		return time.Time{}, errors.New(`create_time must be compared to a timestamp("...") literal`)
+	}
+	value, ok := getAttachmentFilterLiteral(expr.AsCall().Args()[0]).(string)
+	if !ok {
+		return time.Time{}, errors.New("timestamp() only supports string literals")
+	}
+	createTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "invalid timestamp")
+	}
+	return createTime, nil
+}
+
+// getAttachmentFilterLiteral returns the Go value of a literal of the filter, or nil.
+func getAttachmentFilterLiteral(expr ast.Expr) any {
+	if expr.Kind() != ast.LiteralKind {
+		return nil
+	}
+	return expr.AsLiteral().Value()
+}
+
+func setAttachmentFilterHasMemo(find *store.FindAttachment, hasMemo bool) {
+	if hasMemo {
+		find.HasRelatedMemo = true
+	} else {
+		find.WithoutRelatedMemo = true
+	}
+}
+
+// minBound returns the lowest of an optional upper bound and a value.
+func minBound(bound *int64, value int64) *int64 {
+	if bound != nil && *bound < value {
+		return bound
+	}
+	return &value
+}
+
+// maxBound returns the highest of an optional lower bound and a value.
+func maxBound(bound *int64, value int64) *int64 {
+	if bound != nil && *bound > value {
+		return bound
+	}
+	return &value
+}
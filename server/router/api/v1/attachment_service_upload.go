@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/storage/s3"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultAttachmentUploadExpiry is how long the upload URL of a direct upload is valid by default.
+	defaultAttachmentUploadExpiry = 15 * time.Minute
+	// maxAttachmentUploadExpiry is how long the upload URL of a direct upload may be valid.
+	maxAttachmentUploadExpiry = time.Hour
+)
+
+// CreateAttachmentUpload starts a direct upload of an attachment to the S3 storage: the pending
+// attachment is created, and the content is uploaded to a presigned URL without going through
+// the server. The content still goes through the server, with CreateAttachment, when the storage
+// isn't S3 or the image is stripped of its metadata or compressed on upload.
+//
+// Authentication: Required.
+func (s *APIV1Service) CreateAttachmentUpload(ctx context.Context, request *v1pb.CreateAttachmentUploadRequest) (*v1pb.CreateAttachmentUploadResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := validateAttachmentCreate(request.Attachment); err != nil {
+		return nil, err
+	}
+
+	instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+	}
+	if request.Size <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be positive")
+	}
+	if request.Size > getUploadSizeLimit(instanceStorageSetting) {
+		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
+	}
+	expiry := defaultAttachmentUploadExpiry
+	if request.ExpireSeconds != 0 {
+		expiry = time.Duration(request.ExpireSeconds) * time.Second
+		if expiry < 0 || expiry > maxAttachmentUploadExpiry {
+			return nil, status.Errorf(codes.InvalidArgument, "expire seconds must be between 1 and %d", int(maxAttachmentUploadExpiry.Seconds()))
+		}
+	}
+
+	if instanceStorageSetting.StorageType != storepb.InstanceStorageSetting_S3 || instanceStorageSetting.S3Config == nil {
+		return &v1pb.CreateAttachmentUploadResponse{}, nil
+	}
+	process, err := s.shouldProcessImageUpload(ctx, user.ID, instanceStorageSetting, request.Attachment.Type, request.Size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	if process {
+		return &v1pb.CreateAttachmentUploadResponse{}, nil
+	}
+
+	if err := s.checkStorageQuota(ctx, user.ID, request.Size); err != nil {
+		return nil, err
+	}
+
+	attachmentUID := request.AttachmentId
+	if attachmentUID == "" {
+		attachmentUID = shortuuid.New()
+	}
+	create := &store.Attachment{
+		UID:         attachmentUID,
+		CreatorID:   user.ID,
+		Filename:    request.Attachment.Filename,
+		Type:        request.Attachment.Type,
+		Size:        request.Size,
+		Library:     request.Attachment.Library,
+		StorageType: storepb.AttachmentStorageType_S3,
+		Pending:     true,
+	}
+	if create.MemoID, err = s.getAttachmentMemoID(ctx, request.Attachment.Memo); err != nil {
+		return nil, err
+	}
+
+	s3Client, err := s3.NewClient(ctx, instanceStorageSetting.S3Config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+	}
+	key := getS3ObjectKey(instanceStorageSetting, create.Filename)
+	uploadURL, err := s3Client.PresignPutObject(ctx, key, create.Type, create.Size, expiry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to presign upload: %v", err)
+	}
+	create.Payload = &storepb.AttachmentPayload{
+		Payload: &storepb.AttachmentPayload_S3Object_{
+			S3Object: &storepb.AttachmentPayload_S3Object{
+				S3Config: instanceStorageSetting.S3Config,
+				Key:      key,
+			},
+		},
+	}
+	attachment, err := s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	return &v1pb.CreateAttachmentUploadResponse{
+		Attachment: convertAttachmentFromStore(attachment),
+		UploadUrl:  uploadURL,
+		ExpireTime: timestamppb.New(time.Now().Add(expiry)),
+	}, nil
+}
+
+// CompleteAttachmentUpload completes a direct upload: the uploaded object must have the size of
+// the pending attachment, then the attachment records its content type and is no longer pending.
+//
+// Authentication: Required.
+func (s *APIV1Service) CompleteAttachmentUpload(ctx context.Context, request *v1pb.CompleteAttachmentUploadRequest) (*v1pb.Attachment, error) {
+	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID, CreatorID: &user.ID, Pending: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find attachment: %v", err)
+	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "pending attachment not found")
+	}
+	s3Object := attachment.Payload.GetS3Object()
+	if s3Object == nil {
+		return nil, status.Errorf(codes.Internal, "pending attachment has no s3 object")
+	}
+
+	s3Client, err := s3.NewClient(ctx, s3Object.S3Config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+	}
+	size, contentType, err := s3Client.HeadObject(ctx, s3Object.Key)
+	if err != nil {
+		if s3.IsNotFound(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "the content has not been uploaded")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get uploaded object: %v", err)
+	}
+	if size != attachment.Size {
+		// The upload may be retried while the attachment is pending.
+		if err := s3Client.DeleteObject(ctx, s3Object.Key); err != nil {
+			slog.Warn("failed to delete mismatched upload", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "uploaded size %d doesn't match the size %d of the attachment", size, attachment.Size)
+	}
+	if contentType == "" {
+		contentType = attachment.Type
+	}
+
+	presignURL, err := s3Client.PresignGetObject(ctx, s3Object.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to presign attachment: %v", err)
+	}
+	s3Object.LastPresignedTime = timestamppb.Now()
+	pending := false
+	update := &store.UpdateAttachment{
+		ID:        attachment.ID,
+		Type:      &contentType,
+		Size:      &size,
+		Reference: &presignURL,
+		Payload:   attachment.Payload,
+		Pending:   &pending,
+	}
+	if err := s.Store.UpdateAttachment(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update attachment: %v", err)
+	}
+	// The object of a direct upload is never shared, so it's always counted.
+	if err := s.Store.AddUserStorageUsage(ctx, user.ID, size); err != nil {
+		slog.Warn("failed to update storage usage", slog.Int("user", int(user.ID)), slog.String("error", err.Error()))
+	}
+	completed, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	return convertAttachmentFromStore(completed), nil
+}
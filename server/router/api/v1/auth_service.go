@@ -10,9 +10,11 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -37,7 +39,7 @@ const (
 // - Retrieve the last accessed time of the session
 //
 // Authentication: Required (session cookie or access token)
-// Returns: User information and last accessed timestamp.
+// Returns: User information, last accessed timestamp and the session lifetimes.
 func (s *APIV1Service) GetCurrentSession(ctx context.Context, _ *v1pb.GetCurrentSessionRequest) (*v1pb.GetCurrentSessionResponse, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -51,52 +53,101 @@ func (s *APIV1Service) GetCurrentSession(ctx context.Context, _ *v1pb.GetCurrent
 		return nil, status.Errorf(codes.Unauthenticated, "user not found")
 	}
 
-	var lastAccessedAt *timestamppb.Timestamp
-	// Update session last accessed time if we have a session ID and get the current session info
+	policy, err := auth.GetSessionPolicy(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session policy: %v", err)
+	}
+	response := &v1pb.GetCurrentSessionResponse{
+		User:        convertUserFromStore(user),
+		IdleTimeout: durationpb.New(policy.IdleTimeout),
+	}
+	// The authenticator already refreshed the last accessed time, so report the stored value
 	if sessionID := auth.GetSessionID(ctx); sessionID != "" {
-		now := timestamppb.Now()
-		if err := s.Store.UpdateUserSessionLastAccessed(ctx, user.ID, sessionID, now); err != nil {
+		sessions, err := s.Store.GetUserSessions(ctx, user.ID)
+		if err != nil {
 			// Log error but don't fail the request
-			slog.Error("failed to update session last accessed time", "error", err)
+			slog.Error("failed to get user sessions", "error", err)
+		}
+		for _, session := range sessions {
+			if session.SessionId == sessionID {
+				response.LastAccessedAt = session.LastAccessedTime
+				response.AbsoluteExpireTime = convertAbsoluteExpireTime(policy, session)
+				break
+			}
 		}
-		lastAccessedAt = now
 	}
 
-	return &v1pb.GetCurrentSessionResponse{
-		User:           convertUserFromStore(user),
-		LastAccessedAt: lastAccessedAt,
-	}, nil
+	return response, nil
+}
+
+// convertAbsoluteExpireTime returns the absolute expiry of a session, or nil if the session
+// policy doesn't cap the session lifetime.
+func convertAbsoluteExpireTime(policy *auth.SessionPolicy, session *storepb.SessionsUserSetting_Session) *timestamppb.Timestamp {
+	absoluteExpireTime := policy.AbsoluteExpireTime(session)
+	if absoluteExpireTime.IsZero() {
+		return nil
+	}
+	return timestamppb.New(absoluteExpireTime)
 }
 
 // CreateSession authenticates a user and establishes a new session.
 //
-// This endpoint supports two authentication methods:
+// This endpoint supports three authentication methods:
 // 1. Password-based authentication (username + password)
 // 2. SSO authentication (OAuth2 authorization code)
+// 3. Passkey authentication (WebAuthn assertion for a challenge from CreatePasskeyChallenge)
+//
+// Password and two-factor sign-ins are throttled per username and per client IP: after
+// auth.LoginFailuresBeforeDelay failures each attempt has to wait exponentially longer, and
+// after auth.LoginFailuresBeforeLock failures within an hour sign-in is locked for a while.
+// Throttled attempts return ResourceExhausted. Admins can lift a lock with UnlockUser.
+//
+// For users with two-factor authentication enabled, password and SSO sign-ins only return a
+// two-factor challenge. The session is created once the challenge is sent back with
+// a code from the authenticator app or a recovery code (two-factor credentials).
 //
 // On successful authentication:
-// - A session cookie is set for web browsers (cookie: user_session={userID}-{sessionID})
+// - A short-lived access token and a refresh token are set as cookies for web browsers
+// (user_access_token and user_refresh_token); see RefreshSession
 // - Session information is stored including client details (IP, user agent, device type)
-// - Sessions use sliding expiration: 14 days from last access
+// - Sessions use sliding expiration: they expire after the idle timeout of the instance
+// general setting without use, and after the absolute lifetime from sign-in if it is set
 //
 // Authentication: Not required (public endpoint)
-// Returns: Authenticated user information and last accessed timestamp.
+// Returns: Authenticated user information, last accessed timestamp and the session lifetimes,
+// so clients can warn before the session expires.
 func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSessionRequest) (*v1pb.CreateSessionResponse, error) {
+	authenticator := auth.NewAuthenticator(s.Store, s.Secret)
 	var existingUser *store.User
+	var signInMethod string
+	twoFactorVerified := false
 
 	// Authentication Method 1: Password-based authentication
 	if passwordCredentials := request.GetPasswordCredentials(); passwordCredentials != nil {
+		// Failures are counted per username, whether or not the user exists, and per client IP.
+		throttleIdentifiers := []string{
+			auth.AccountLoginIdentifier(passwordCredentials.Username),
+			auth.IPLoginIdentifier(s.extractClientInfo(ctx).IpAddress),
+		}
+		if err := authenticator.CheckLoginThrottle(ctx, throttleIdentifiers...); err != nil {
+			return nil, convertLoginThrottleError(err)
+		}
 		user, err := s.Store.GetUser(ctx, &store.FindUser{
 			Username: &passwordCredentials.Username,
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
 		}
-		if user == nil {
-			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
-		}
 		// Compare the stored hashed password, with the hashed version of the password that was received.
-		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(passwordCredentials.Password)); err != nil {
+		if user == nil || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(passwordCredentials.Password)) != nil {
+			if err := authenticator.RecordLoginFailure(ctx, throttleIdentifiers...); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to record sign-in failure, error: %v", err)
+			}
+			var actorID int32
+			if user != nil {
+				actorID = user.ID
+			}
+			authenticator.RecordAuditLog(ctx, store.AuditEventSignInFailed, actorID, map[string]any{"method": "password", "username": passwordCredentials.Username})
 			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
 		}
 		instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
@@ -108,6 +159,7 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 			return nil, status.Errorf(codes.PermissionDenied, "password signin is not allowed")
 		}
 		existingUser = user
+		signInMethod = "password"
 	} else if ssoCredentials := request.GetSsoCredentials(); ssoCredentials != nil {
 		// Authentication Method 2: SSO (OAuth2) authentication
 		identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
@@ -173,6 +225,9 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 				Email:     userInfo.Email,
 				AvatarURL: userInfo.AvatarURL,
 			}
+			if role, ok := mapIdentityProviderRole(identityProvider.Config.GetOauth2Config(), userInfo.Groups); ok {
+				userCreate.Role = role
+			}
 			password, err := util.RandomString(20)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to generate random password, error: %v", err)
@@ -186,8 +241,48 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
 			}
+		} else {
+			user, err = s.syncSSOUser(ctx, identityProvider.Config.GetOauth2Config(), user, userInfo)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update user, error: %v", err)
+			}
+		}
+		existingUser = user
+		signInMethod = "sso"
+	} else if twoFactorCredentials := request.GetTwoFactorCredentials(); twoFactorCredentials != nil {
+		// Second step for users with two-factor authentication enabled
+		user, err := authenticator.AuthenticateByTwoFactor(ctx, twoFactorCredentials.Challenge, twoFactorCredentials.Code, s.extractClientInfo(ctx).IpAddress)
+		if err != nil {
+			var throttledErr *auth.LoginThrottledError
+			if errors.As(err, &throttledErr) {
+				return nil, convertLoginThrottleError(err)
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "two-factor authentication failed: %v", err)
+		}
+		existingUser = user
+		signInMethod = "two_factor"
+		twoFactorVerified = true
+	} else if passkeyCredentials := request.GetPasskeyCredentials(); passkeyCredentials != nil {
+		// Authentication Method 3: Passkey (WebAuthn) authentication
+		rp, err := auth.NewRelyingParty(s.Profile.InstanceURL)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "passkeys are unavailable: %v", err)
+		}
+		user, err := authenticator.AuthenticateByPasskey(ctx, rp, &auth.PasskeyAssertion{
+			CredentialID:      passkeyCredentials.CredentialId,
+			ClientDataJSON:    passkeyCredentials.ClientDataJson,
+			AuthenticatorData: passkeyCredentials.AuthenticatorData,
+			Signature:         passkeyCredentials.Signature,
+			UserHandle:        passkeyCredentials.UserHandle,
+		})
+		if err != nil {
+			authenticator.RecordAuditLog(ctx, store.AuditEventSignInFailed, 0, map[string]any{"method": "passkey"})
+			return nil, status.Errorf(codes.Unauthenticated, "passkey authentication failed: %v", err)
 		}
 		existingUser = user
+		signInMethod = "passkey"
+		// A passkey is already a possession factor, so it doesn't require a two-factor code.
+		twoFactorVerified = true
 	}
 
 	if existingUser == nil {
@@ -196,58 +291,238 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 	if existingUser.RowStatus == store.Archived {
 		return nil, status.Errorf(codes.PermissionDenied, "user has been archived with username %s", existingUser.Username)
 	}
+	if existingUser.SuspendedTs != 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "your account has been suspended, contact the administrator of this instance")
+	}
+
+	if !twoFactorVerified {
+		twoFactor, err := s.Store.GetUserTwoFactor(ctx, existingUser.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get two-factor setting, error: %v", err)
+		}
+		if twoFactor.Enabled {
+			challenge, err := auth.NewAuthenticator(s.Store, s.Secret).GenerateTwoFactorChallenge(ctx, existingUser.Username, existingUser.ID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to generate two-factor challenge, error: %v", err)
+			}
+			return &v1pb.CreateSessionResponse{
+				TwoFactorChallenge: challenge,
+			}, nil
+		}
+	}
+
+	// A completed sign-in clears the failures counted against the account, but not those
+	// counted against the client IP, so one valid account can't reset an IP's count.
+	if err := authenticator.ResetLoginThrottle(ctx, auth.AccountLoginIdentifier(existingUser.Username)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset sign-in failures, error: %v", err)
+	}
 
-	// Default session expiration time is 100 year
-	expireTime := time.Now().Add(100 * 365 * 24 * time.Hour)
-	if err := s.doSignIn(ctx, existingUser, expireTime); err != nil {
+	if err := s.doSignIn(ctx, existingUser); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in, error: %v", err)
 	}
+	authenticator.RecordAuditLog(ctx, store.AuditEventSignIn, existingUser.ID, map[string]any{"method": signInMethod})
 
+	policy, err := authenticator.SessionPolicy(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session policy, error: %v", err)
+	}
+	now := timestamppb.Now()
 	return &v1pb.CreateSessionResponse{
-		User:           convertUserFromStore(existingUser),
-		LastAccessedAt: timestamppb.Now(),
+		User:               convertUserFromStore(existingUser),
+		LastAccessedAt:     now,
+		IdleTimeout:        durationpb.New(policy.IdleTimeout),
+		AbsoluteExpireTime: convertAbsoluteExpireTime(policy, &storepb.SessionsUserSetting_Session{CreateTime: now}),
 	}, nil
 }
 
-// doSignIn performs the actual sign-in operation by creating a session and setting the cookie.
+// convertLoginThrottleError converts an error from the sign-in throttle to a gRPC status.
+//
+// Throttled sign-ins return ResourceExhausted, with the delay in the message and as
+// RetryInfo details. The response is the same whether or not the username exists.
+func convertLoginThrottleError(err error) error {
+	var throttledErr *auth.LoginThrottledError
+	if !errors.As(err, &throttledErr) {
+		return status.Errorf(codes.Internal, "failed to check sign-in attempts, error: %v", err)
+	}
+	st := status.New(codes.ResourceExhausted, throttledErr.Error())
+	if detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(throttledErr.RetryAfter)}); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// CreatePasskeyChallenge starts a passkey sign-in.
+//
+// Returns a challenge for navigator.credentials.get(). The signed assertion is sent to
+// CreateSession as passkey credentials within 5 minutes. The relying party is derived from
+// the instance URL, which must be set for passkeys to work.
+//
+// Authentication: Not required (public endpoint).
+func (s *APIV1Service) CreatePasskeyChallenge(ctx context.Context, _ *v1pb.CreatePasskeyChallengeRequest) (*v1pb.PasskeyChallenge, error) {
+	rp, err := auth.NewRelyingParty(s.Profile.InstanceURL)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are unavailable: %v", err)
+	}
+	challenge, err := auth.NewAuthenticator(s.Store, s.Secret).GeneratePasskeyChallenge(ctx, auth.PasskeySignInAudienceName, "", 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate passkey challenge: %v", err)
+	}
+	return &v1pb.PasskeyChallenge{
+		Challenge: challenge,
+		RpId:      rp.ID,
+	}, nil
+}
+
+// syncSSOUser updates an existing user signing in with an OAuth2 identity provider.
+//
+// The role mappings of the identity provider are applied on every sign-in, so users removed
+// from a group lose its role. The last remaining host is never demoted, as nobody would be
+// left to administer the instance. If enabled, the display name and avatar are synced too.
+func (s *APIV1Service) syncSSOUser(ctx context.Context, config *storepb.OAuth2Config, user *store.User, userInfo *idp.IdentityProviderUserInfo) (*store.User, error) {
+	update := &store.UpdateUser{ID: user.ID}
+	changed := false
+	if role, ok := mapIdentityProviderRole(config, userInfo.Groups); ok && role != user.Role {
+		if user.Role == store.RoleHost {
+			hostRole, normal := store.RoleHost, store.Normal
+			hosts, err := s.Store.ListUsers(ctx, &store.FindUser{Role: &hostRole, RowStatus: &normal})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to list hosts")
+			}
+			if len(hosts) <= 1 {
+				slog.Warn("not demoting the last host on SSO sign-in", "user", user.Username, "role", role)
+				role = store.RoleHost
+			}
+		}
+		if role != user.Role {
+			update.Role = &role
+			changed = true
+		}
+	}
+	if config.GetSyncUserInfo() {
+		if config.FieldMapping.GetDisplayName() != "" && userInfo.DisplayName != user.Nickname {
+			update.Nickname = &userInfo.DisplayName
+			changed = true
+		}
+		if config.FieldMapping.GetAvatarUrl() != "" && userInfo.AvatarURL != user.AvatarURL {
+			update.AvatarURL = &userInfo.AvatarURL
+			changed = true
+		}
+	}
+	if !changed {
+		return user, nil
+	}
+
+	updatedUser, err := s.Store.UpdateUser(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	if update.Role != nil {
+		auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventUserRoleChanged, user.ID, map[string]any{
+			"user_id":  user.ID,
+			"old_role": user.Role.String(),
+			"new_role": update.Role.String(),
+			"source":   "sso",
+		})
+	}
+	return updatedUser, nil
+}
+
+// doSignIn performs the actual sign-in operation by creating a session and setting the cookies.
 //
 // This function:
 // 1. Generates a unique session ID (UUID)
 // 2. Tracks the session in user settings with client information
-// 3. Sets a session cookie in the format: {userID}-{sessionID}
-// 4. Configures cookie security settings (HttpOnly, Secure, SameSite)
+// 3. Issues an access token and a refresh token for the session
+// 4. Sets both as cookies with security settings (HttpOnly, Secure, SameSite)
 //
-// Cookie lifetime is 100 years, but actual session validity is controlled by
-// sliding expiration (14 days from last access) checked during authentication.
-func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTime time.Time) error {
+// The access token expires after 15 minutes and is renewed with RefreshSession. The refresh
+// token is valid for the session idle timeout, which gives the session its sliding expiration.
+func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User) error {
 	// Generate unique session ID for web use
 	sessionID := auth.GenerateSessionID()
 
-	// Track session in user settings
+	// Track session in user settings, which tokens are checked against
 	if err := s.trackUserSession(ctx, user.ID, sessionID); err != nil {
-		// Log the error but don't fail the login if session tracking fails
-		// This ensures backward compatibility
-		slog.Error("failed to track user session", "error", err)
+		return errors.Wrap(err, "failed to track user session")
 	}
 
-	// Set session cookie for web use (format: userID-sessionID)
-	sessionCookieValue := auth.BuildSessionCookieValue(user.ID, sessionID)
-	sessionCookie, err := s.buildSessionCookie(ctx, sessionCookieValue, expireTime)
+	tokenPair, err := auth.NewAuthenticator(s.Store, s.Secret).IssueTokenPair(ctx, user, sessionID)
 	if err != nil {
-		return status.Errorf(codes.Internal, "failed to build session cookie, error: %v", err)
+		return errors.Wrap(err, "failed to issue session tokens")
+	}
+	return s.setTokenPairCookies(ctx, tokenPair)
+}
+
+// RefreshSession renews the session access token of a browser session.
+//
+// This endpoint:
+// 1. Reads the refresh token from the user_refresh_token cookie
+// 2. Rotates it with the Authenticator, which invalidates the presented token
+// 3. Sets the new access token and refresh token cookies
+//
+// Presenting a refresh token that was already rotated is treated as token theft and revokes
+// the whole session, unless it was rotated moments ago, e.g. by another browser tab.
+// Sessions signed in before refresh tokens existed use the user_session cookie; they stay
+// valid until they expire and don't need refreshing.
+//
+// Authentication: Not required (the refresh token cookie is the credential).
+func (s *APIV1Service) RefreshSession(ctx context.Context, _ *v1pb.RefreshSessionRequest) (*v1pb.RefreshSessionResponse, error) {
+	refreshToken := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		refreshToken = extractCookieFromMetadata(md, auth.RefreshTokenCookieName)
 	}
-	if err := SetResponseHeader(ctx, "Set-Cookie", sessionCookie); err != nil {
-		return status.Errorf(codes.Internal, "failed to set response header, error: %v", err)
+	if refreshToken == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token not found")
 	}
 
+	tokenPair, user, err := auth.NewAuthenticator(s.Store, s.Secret).RotateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrRefreshTokenRotated) {
+			// Another request already rotated the token and set the new cookies, so keep them.
+			return nil, status.Errorf(codes.Aborted, "%v", err)
+		}
+		if clearErr := s.clearAllAuthCookies(ctx); clearErr != nil {
+			slog.Error("failed to clear auth cookies", "error", clearErr)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "failed to refresh session: %v", err)
+	}
+	if err := s.setTokenPairCookies(ctx, tokenPair); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set session cookies, error: %v", err)
+	}
+
+	return &v1pb.RefreshSessionResponse{
+		User:                  convertUserFromStore(user),
+		AccessTokenExpireTime: timestamppb.New(tokenPair.AccessTokenExpiresAt),
+	}, nil
+}
+
+// setTokenPairCookies sets the access token and refresh token cookies of a session.
+func (s *APIV1Service) setTokenPairCookies(ctx context.Context, tokenPair *auth.TokenPair) error {
+	cookies := []struct {
+		name       string
+		value      string
+		expireTime time.Time
+	}{
+		{auth.AccessTokenCookieName, tokenPair.AccessToken, tokenPair.AccessTokenExpiresAt},
+		{auth.RefreshTokenCookieName, tokenPair.RefreshToken, tokenPair.RefreshTokenExpiresAt},
+	}
+	for _, cookie := range cookies {
+		value, err := s.buildCookie(ctx, cookie.name, cookie.value, cookie.expireTime)
+		if err != nil {
+			return errors.Wrap(err, "failed to build cookie")
+		}
+		if err := AddResponseHeader(ctx, "Set-Cookie", value); err != nil {
+			return errors.Wrap(err, "failed to set response header")
+		}
+	}
 	return nil
 }
 
 // DeleteSession terminates the current user session (logout).
 //
 // This endpoint:
-// 1. Removes the session from the user's sessions list in the database
-// 2. Clears the session cookie by setting it to expire immediately
+// 1. Removes the session and its refresh tokens from the database
+// 2. Clears the session cookies by setting them to expire immediately
 //
 // Authentication: Required (session cookie or access token)
 // Returns: Empty response on success.
@@ -262,35 +537,48 @@ func (s *APIV1Service) DeleteSession(ctx context.Context, _ *v1pb.DeleteSessionR
 
 	// Check if we have a session ID (from cookie-based auth)
 	if sessionID := auth.GetSessionID(ctx); sessionID != "" {
-		// Remove session from user settings
-		if err := s.Store.RemoveUserSession(ctx, user.ID, sessionID); err != nil {
+		// Remove session and its refresh tokens
+		if err := auth.NewAuthenticator(s.Store, s.Secret).RevokeSession(ctx, user.ID, sessionID, "sign_out"); err != nil {
 			slog.Error("failed to remove user session", "error", err)
 		}
 	}
 
-	if err := s.clearAuthCookies(ctx); err != nil {
+	if err := s.clearAllAuthCookies(ctx); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to clear auth cookies, error: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// clearAuthCookies clears the cookies that authenticate requests: the session cookie of
+// sessions signed in before refresh tokens existed, and the access token cookie.
+// The refresh token cookie is kept, so an expired access token can still be refreshed.
 func (s *APIV1Service) clearAuthCookies(ctx context.Context) error {
-	// Clear session cookie
-	sessionCookie, err := s.buildSessionCookie(ctx, "", time.Time{})
-	if err != nil {
-		return errors.Wrap(err, "failed to build session cookie")
-	}
+	return s.clearCookies(ctx, auth.SessionCookieName, auth.AccessTokenCookieName)
+}
+
+// clearAllAuthCookies clears the authentication cookies including the refresh token cookie.
+func (s *APIV1Service) clearAllAuthCookies(ctx context.Context) error {
+	return s.clearCookies(ctx, auth.SessionCookieName, auth.AccessTokenCookieName, auth.RefreshTokenCookieName)
+}
+
+func (s *APIV1Service) clearCookies(ctx context.Context, names ...string) error {
+	for _, name := range names {
+		cookie, err := s.buildCookie(ctx, name, "", time.Time{})
+		if err != nil {
+			return errors.Wrap(err, "failed to build cookie")
+		}
 
-	// Set cookie in the response
-	if err := SetResponseHeader(ctx, "Set-Cookie", sessionCookie); err != nil {
-		return errors.Wrap(err, "failed to set response header")
+		// Set cookie in the response
+		if err := AddResponseHeader(ctx, "Set-Cookie", cookie); err != nil {
+			return errors.Wrap(err, "failed to set response header")
+		}
 	}
 	return nil
 }
 
-func (*APIV1Service) buildSessionCookie(ctx context.Context, sessionCookieValue string, expireTime time.Time) (string, error) {
+func (*APIV1Service) buildCookie(ctx context.Context, name, value string, expireTime time.Time) (string, error) {
 	attrs := []string{
-		fmt.Sprintf("%s=%s", auth.SessionCookieName, sessionCookieValue),
+		fmt.Sprintf("%s=%s", name, value),
 		"Path=/",
 		"HttpOnly",
 	}
@@ -335,7 +623,8 @@ func (s *APIV1Service) GetCurrentUser(ctx context.Context) (*store.User, error)
 	if user == nil {
 		return nil, errors.Errorf("user %d not found", userID)
 	}
-	return user, nil
+	// Without the admin scope, a scoped access token acts as a regular user.
+	return auth.ApplyScopes(user, auth.GetScopes(ctx)), nil
 }
 
 // trackUserSession creates a new session record in the user's settings.
@@ -375,23 +664,9 @@ func (s *APIV1Service) trackUserSession(ctx context.Context, userID int32, sessi
 func (s *APIV1Service) extractClientInfo(ctx context.Context) *storepb.SessionsUserSetting_ClientInfo {
 	clientInfo := &storepb.SessionsUserSetting_ClientInfo{}
 
-	// Extract user agent from metadata if available
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if userAgents := md.Get("user-agent"); len(userAgents) > 0 {
-			userAgent := userAgents[0]
-			clientInfo.UserAgent = userAgent
-
-			// Parse user agent to extract device type, OS, browser info
-			s.parseUserAgent(userAgent, clientInfo)
-		}
-		if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
-			ipAddress := strings.Split(forwardedFor[0], ",")[0] // Get the first IP in case of multiple
-			ipAddress = strings.TrimSpace(ipAddress)
-			clientInfo.IpAddress = ipAddress
-		} else if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
-			clientInfo.IpAddress = realIP[0]
-		}
-	}
+	clientInfo.IpAddress, clientInfo.UserAgent = auth.ExtractClientFromContext(ctx)
+	// Parse user agent to extract device type, OS, browser info
+	s.parseUserAgent(clientInfo.UserAgent, clientInfo)
 
 	return clientInfo
 }
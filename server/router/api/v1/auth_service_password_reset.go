@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// passwordResetEmailTimeout bounds sending a password reset email in the background.
+const passwordResetEmailTimeout = time.Minute
+
+// RequestPasswordReset emails a password reset link to the user with the given username or
+// email address.
+//
+// To not reveal which accounts exist, the response is the same for unknown users, users
+// without an email address and archived users, and the email is sent in the background.
+// The link contains a single-use token that expires after 30 minutes.
+//
+// Authentication: Not required (public endpoint).
+// Returns: FAILED_PRECONDITION if email or the instance URL is not configured.
+func (s *APIV1Service) RequestPasswordReset(ctx context.Context, request *v1pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	identifier := strings.TrimSpace(request.Identifier)
+	if identifier == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username or email is required")
+	}
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance email setting: %v", err)
+	}
+	if !email.IsConfigured(emailSetting) {
+		return nil, status.Errorf(codes.FailedPrecondition, "password reset is unavailable: email is not configured")
+	}
+	if s.Profile.InstanceURL == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "password reset is unavailable: instance URL is not configured")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &identifier})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil && strings.Contains(identifier, "@") {
+		user, err = s.Store.GetUser(ctx, &store.FindUser{Email: &identifier})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+	}
+	if user == nil || user.Email == "" || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	token, err := auth.NewAuthenticator(s.Store, s.Secret).CreatePasswordResetToken(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, auth.ErrPasswordResetTooFrequent) {
+			return &emptypb.Empty{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create password reset token: %v", err)
+	}
+
+	title := s.getInstanceTitle(ctx)
+	message := &email.Message{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Reset your %s password", title),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nSomeone requested a password reset for your %s account. Open the link below to choose a new password:\n\n%s\n\nThe link expires in %d minutes and can only be used once. If you didn't request a password reset, you can ignore this email.\n",
+			user.Username, title, s.passwordResetLink(token), int(auth.PasswordResetTokenDuration.Minutes()),
+		),
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), passwordResetEmailTimeout)
+		defer cancel()
+		if err := s.EmailSender.Send(ctx, emailSetting, message); err != nil {
+			slog.Error("failed to send password reset email", slog.Int("user", int(user.ID)), slog.Any("err", err))
+		}
+	}()
+	return &emptypb.Empty{}, nil
+}
+
+// ResetPassword sets a new password with a token from a password reset email.
+//
+// The token can only be used once, and all sessions of the user are signed out.
+//
+// Authentication: Not required (public endpoint).
+func (s *APIV1Service) ResetPassword(ctx context.Context, request *v1pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if request.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new password is required")
+	}
+
+	authenticator := auth.NewAuthenticator(s.Store, s.Secret)
+	user, err := authenticator.ResetPassword(ctx, request.Token, request.NewPassword)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to reset password: %v", err)
+	}
+	// Password sign-in is throttled per account; a reset proves ownership, so lift any lock.
+	if err := authenticator.ResetLoginThrottle(ctx, auth.AccountLoginIdentifier(user.Username)); err != nil {
+		slog.Warn("failed to reset login throttle", slog.Int("user", int(user.ID)), slog.Any("err", err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// passwordResetLink returns the link to the password reset page for a token.
+func (s *APIV1Service) passwordResetLink(token string) string {
+	return strings.TrimSuffix(s.Profile.InstanceURL, "/") + "/auth/reset-password?token=" + url.QueryEscape(token)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"runtime/debug"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
@@ -125,6 +127,44 @@ func (in *RecoveryInterceptor) logPanic(procedure string, panicValue any) {
 	slog.LogAttrs(context.Background(), slog.LevelError, "panic recovered in Connect handler", attrs...)
 }
 
+// MetadataInterceptor exposes Connect request headers as incoming gRPC metadata.
+//
+// Service methods read client information (user agent, origin, client IP) from gRPC
+// metadata, which grpc-gateway fills from the HTTP request. This interceptor does the same
+// for Connect requests, using the peer address as X-Real-IP if no proxy header is set.
+type MetadataInterceptor struct{}
+
+// NewMetadataInterceptor creates a new metadata interceptor.
+func NewMetadataInterceptor() *MetadataInterceptor {
+	return &MetadataInterceptor{}
+}
+
+func (*MetadataInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		md := metadata.MD{}
+		for key, values := range req.Header() {
+			md.Append(key, values...)
+		}
+		if len(md.Get("x-forwarded-for")) == 0 && len(md.Get("x-real-ip")) == 0 {
+			if host, _, err := net.SplitHostPort(req.Peer().Addr); err == nil {
+				md.Set("x-real-ip", host)
+			}
+		}
+		if incoming, ok := metadata.FromIncomingContext(ctx); ok {
+			md = metadata.Join(incoming, md)
+		}
+		return next(metadata.NewIncomingContext(ctx, md), req)
+	}
+}
+
+func (*MetadataInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*MetadataInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
 // AuthInterceptor handles authentication for Connect handlers.
 //
 // It reuses the same authentication logic as GRPCAuthInterceptor by delegating
@@ -156,7 +196,19 @@ func (in *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 					// but handle it gracefully anyway
 					sessionID = ""
 				}
-				ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, procedure, user, sessionID, "", IsAdminOnlyMethod)
+				ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, procedure, user, sessionID, "", nil, IsAdminOnlyMethod)
+				if err != nil {
+					return nil, convertAuthError(err)
+				}
+				return next(ctx, req)
+			}
+		}
+
+		// Try session access token cookie authentication
+		if accessToken := auth.ExtractCookieFromHeader(header.Get("Cookie"), auth.AccessTokenCookieName); accessToken != "" {
+			user, sessionID, err := in.authenticator.AuthenticateBySessionAccessToken(ctx, accessToken)
+			if err == nil && user != nil {
+				ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, procedure, user, sessionID, "", nil, IsAdminOnlyMethod)
 				if err != nil {
 					return nil, convertAuthError(err)
 				}
@@ -166,9 +218,9 @@ func (in *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 
 		// Try JWT token authentication
 		if accessToken := auth.ExtractBearerToken(header.Get("Authorization")); accessToken != "" {
-			user, err := in.authenticator.AuthenticateByJWT(ctx, accessToken)
+			user, scopes, err := in.authenticator.AuthenticateByJWT(ctx, accessToken)
 			if err == nil && user != nil {
-				ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, procedure, user, "", accessToken, IsAdminOnlyMethod)
+				ctx, err = in.authenticator.AuthorizeAndSetContext(ctx, procedure, user, "", accessToken, scopes, IsAdminOnlyMethod)
 				if err != nil {
 					return nil, convertAuthError(err)
 				}
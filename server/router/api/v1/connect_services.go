@@ -39,6 +39,94 @@ func (s *ConnectServiceHandler) UpdateInstanceSetting(ctx context.Context, req *
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) DismissInstanceAnnouncement(ctx context.Context, req *connect.Request[v1pb.DismissInstanceAnnouncementRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DismissInstanceAnnouncement(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListAuditLogs(ctx context.Context, req *connect.Request[v1pb.ListAuditLogsRequest]) (*connect.Response[v1pb.ListAuditLogsResponse], error) {
+	resp, err := s.APIV1Service.ListAuditLogs(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetInstanceDiagnostics(ctx context.Context, req *connect.Request[v1pb.GetInstanceDiagnosticsRequest]) (*connect.Response[v1pb.InstanceDiagnostics], error) {
+	resp, err := s.APIV1Service.GetInstanceDiagnostics(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetInstanceStats(ctx context.Context, req *connect.Request[v1pb.GetInstanceStatsRequest]) (*connect.Response[v1pb.InstanceStats], error) {
+	resp, err := s.APIV1Service.GetInstanceStats(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetInstanceMigrationStatus(ctx context.Context, req *connect.Request[v1pb.GetInstanceMigrationStatusRequest]) (*connect.Response[v1pb.InstanceMigrationStatus], error) {
+	resp, err := s.APIV1Service.GetInstanceMigrationStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetInstanceBackupStatus(ctx context.Context, req *connect.Request[v1pb.GetInstanceBackupStatusRequest]) (*connect.Response[v1pb.InstanceBackupStatus], error) {
+	resp, err := s.APIV1Service.GetInstanceBackupStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RunInstanceBackup(ctx context.Context, req *connect.Request[v1pb.RunInstanceBackupRequest]) (*connect.Response[v1pb.InstanceBackupStatus], error) {
+	resp, err := s.APIV1Service.RunInstanceBackup(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SendTestEmail(ctx context.Context, req *connect.Request[v1pb.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.SendTestEmail(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListSigningKeys(ctx context.Context, req *connect.Request[v1pb.ListSigningKeysRequest]) (*connect.Response[v1pb.ListSigningKeysResponse], error) {
+	resp, err := s.APIV1Service.ListSigningKeys(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RotateSigningKey(ctx context.Context, req *connect.Request[v1pb.RotateSigningKeyRequest]) (*connect.Response[v1pb.SigningKey], error) {
+	resp, err := s.APIV1Service.RotateSigningKey(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ExpireSigningKey(ctx context.Context, req *connect.Request[v1pb.ExpireSigningKeyRequest]) (*connect.Response[v1pb.SigningKey], error) {
+	resp, err := s.APIV1Service.ExpireSigningKey(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AuthService
 //
 // Auth service methods need special handling for response headers (cookies).
@@ -57,12 +145,42 @@ func (s *ConnectServiceHandler) CreateSession(ctx context.Context, req *connect.
 	})
 }
 
+func (s *ConnectServiceHandler) CreatePasskeyChallenge(ctx context.Context, req *connect.Request[v1pb.CreatePasskeyChallengeRequest]) (*connect.Response[v1pb.PasskeyChallenge], error) {
+	resp, err := s.APIV1Service.CreatePasskeyChallenge(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RefreshSession(ctx context.Context, req *connect.Request[v1pb.RefreshSessionRequest]) (*connect.Response[v1pb.RefreshSessionResponse], error) {
+	return connectWithHeaderCarrier(ctx, func(ctx context.Context) (*v1pb.RefreshSessionResponse, error) {
+		return s.APIV1Service.RefreshSession(ctx, req.Msg)
+	})
+}
+
 func (s *ConnectServiceHandler) DeleteSession(ctx context.Context, req *connect.Request[v1pb.DeleteSessionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return connectWithHeaderCarrier(ctx, func(ctx context.Context) (*emptypb.Empty, error) {
 		return s.APIV1Service.DeleteSession(ctx, req.Msg)
 	})
 }
 
+func (s *ConnectServiceHandler) RequestPasswordReset(ctx context.Context, req *connect.Request[v1pb.RequestPasswordResetRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RequestPasswordReset(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ResetPassword(ctx context.Context, req *connect.Request[v1pb.ResetPasswordRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.ResetPassword(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // UserService
 
 func (s *ConnectServiceHandler) ListUsers(ctx context.Context, req *connect.Request[v1pb.ListUsersRequest]) (*connect.Response[v1pb.ListUsersResponse], error) {
@@ -121,6 +239,14 @@ func (s *ConnectServiceHandler) GetUserStats(ctx context.Context, req *connect.R
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) GetUserWritingStats(ctx context.Context, req *connect.Request[v1pb.GetUserWritingStatsRequest]) (*connect.Response[v1pb.UserWritingStats], error) {
+	resp, err := s.APIV1Service.GetUserWritingStats(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) GetUserSetting(ctx context.Context, req *connect.Request[v1pb.GetUserSettingRequest]) (*connect.Response[v1pb.UserSetting], error) {
 	resp, err := s.APIV1Service.GetUserSetting(ctx, req.Msg)
 	if err != nil {
@@ -185,254 +311,938 @@ func (s *ConnectServiceHandler) RevokeUserSession(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListUserWebhooks(ctx context.Context, req *connect.Request[v1pb.ListUserWebhooksRequest]) (*connect.Response[v1pb.ListUserWebhooksResponse], error) {
-	resp, err := s.APIV1Service.ListUserWebhooks(ctx, req.Msg)
+func (s *ConnectServiceHandler) RevokeOtherUserSessions(ctx context.Context, req *connect.Request[v1pb.RevokeOtherUserSessionsRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.RevokeOtherUserSessions(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) CreateUserWebhook(ctx context.Context, req *connect.Request[v1pb.CreateUserWebhookRequest]) (*connect.Response[v1pb.UserWebhook], error) {
-	resp, err := s.APIV1Service.CreateUserWebhook(ctx, req.Msg)
+func (s *ConnectServiceHandler) GetUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.GetUserTwoFactorRequest]) (*connect.Response[v1pb.UserTwoFactor], error) {
+	resp, err := s.APIV1Service.GetUserTwoFactor(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) UpdateUserWebhook(ctx context.Context, req *connect.Request[v1pb.UpdateUserWebhookRequest]) (*connect.Response[v1pb.UserWebhook], error) {
-	resp, err := s.APIV1Service.UpdateUserWebhook(ctx, req.Msg)
+func (s *ConnectServiceHandler) EnrollUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.EnrollUserTwoFactorRequest]) (*connect.Response[v1pb.EnrollUserTwoFactorResponse], error) {
+	resp, err := s.APIV1Service.EnrollUserTwoFactor(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) DeleteUserWebhook(ctx context.Context, req *connect.Request[v1pb.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.DeleteUserWebhook(ctx, req.Msg)
+func (s *ConnectServiceHandler) ConfirmUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.ConfirmUserTwoFactorRequest]) (*connect.Response[v1pb.ConfirmUserTwoFactorResponse], error) {
+	resp, err := s.APIV1Service.ConfirmUserTwoFactor(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListUserNotifications(ctx context.Context, req *connect.Request[v1pb.ListUserNotificationsRequest]) (*connect.Response[v1pb.ListUserNotificationsResponse], error) {
-	resp, err := s.APIV1Service.ListUserNotifications(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserTwoFactor(ctx context.Context, req *connect.Request[v1pb.DeleteUserTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserTwoFactor(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) UpdateUserNotification(ctx context.Context, req *connect.Request[v1pb.UpdateUserNotificationRequest]) (*connect.Response[v1pb.UserNotification], error) {
-	resp, err := s.APIV1Service.UpdateUserNotification(ctx, req.Msg)
+func (s *ConnectServiceHandler) ListUserPasskeys(ctx context.Context, req *connect.Request[v1pb.ListUserPasskeysRequest]) (*connect.Response[v1pb.ListUserPasskeysResponse], error) {
+	resp, err := s.APIV1Service.ListUserPasskeys(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) DeleteUserNotification(ctx context.Context, req *connect.Request[v1pb.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.DeleteUserNotification(ctx, req.Msg)
+func (s *ConnectServiceHandler) CreateUserPasskeyOptions(ctx context.Context, req *connect.Request[v1pb.CreateUserPasskeyOptionsRequest]) (*connect.Response[v1pb.UserPasskeyOptions], error) {
+	resp, err := s.APIV1Service.CreateUserPasskeyOptions(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-// MemoService
+func (s *ConnectServiceHandler) CreateUserPasskey(ctx context.Context, req *connect.Request[v1pb.CreateUserPasskeyRequest]) (*connect.Response[v1pb.UserPasskey], error) {
+	resp, err := s.APIV1Service.CreateUserPasskey(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
 
-func (s *ConnectServiceHandler) CreateMemo(ctx context.Context, req *connect.Request[v1pb.CreateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
-	resp, err := s.APIV1Service.CreateMemo(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserPasskey(ctx context.Context, req *connect.Request[v1pb.DeleteUserPasskeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserPasskey(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListMemos(ctx context.Context, req *connect.Request[v1pb.ListMemosRequest]) (*connect.Response[v1pb.ListMemosResponse], error) {
-	resp, err := s.APIV1Service.ListMemos(ctx, req.Msg)
+func (s *ConnectServiceHandler) ListUserPushSubscriptions(ctx context.Context, req *connect.Request[v1pb.ListUserPushSubscriptionsRequest]) (*connect.Response[v1pb.ListUserPushSubscriptionsResponse], error) {
+	resp, err := s.APIV1Service.ListUserPushSubscriptions(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) GetMemo(ctx context.Context, req *connect.Request[v1pb.GetMemoRequest]) (*connect.Response[v1pb.Memo], error) {
-	resp, err := s.APIV1Service.GetMemo(ctx, req.Msg)
+func (s *ConnectServiceHandler) CreateUserPushSubscription(ctx context.Context, req *connect.Request[v1pb.CreateUserPushSubscriptionRequest]) (*connect.Response[v1pb.UserPushSubscription], error) {
+	resp, err := s.APIV1Service.CreateUserPushSubscription(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) UpdateMemo(ctx context.Context, req *connect.Request[v1pb.UpdateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
-	resp, err := s.APIV1Service.UpdateMemo(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserPushSubscription(ctx context.Context, req *connect.Request[v1pb.DeleteUserPushSubscriptionRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserPushSubscription(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) DeleteMemo(ctx context.Context, req *connect.Request[v1pb.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.DeleteMemo(ctx, req.Msg)
+func (s *ConnectServiceHandler) GetUserFeedToken(ctx context.Context, req *connect.Request[v1pb.GetUserFeedTokenRequest]) (*connect.Response[v1pb.UserFeedToken], error) {
+	resp, err := s.APIV1Service.GetUserFeedToken(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) SetMemoAttachments(ctx context.Context, req *connect.Request[v1pb.SetMemoAttachmentsRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.SetMemoAttachments(ctx, req.Msg)
+func (s *ConnectServiceHandler) RotateUserFeedToken(ctx context.Context, req *connect.Request[v1pb.RotateUserFeedTokenRequest]) (*connect.Response[v1pb.UserFeedToken], error) {
+	resp, err := s.APIV1Service.RotateUserFeedToken(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListMemoAttachments(ctx context.Context, req *connect.Request[v1pb.ListMemoAttachmentsRequest]) (*connect.Response[v1pb.ListMemoAttachmentsResponse], error) {
-	resp, err := s.APIV1Service.ListMemoAttachments(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserFeedToken(ctx context.Context, req *connect.Request[v1pb.DeleteUserFeedTokenRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserFeedToken(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) SetMemoRelations(ctx context.Context, req *connect.Request[v1pb.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.SetMemoRelations(ctx, req.Msg)
+func (s *ConnectServiceHandler) GetUserInboundEmail(ctx context.Context, req *connect.Request[v1pb.GetUserInboundEmailRequest]) (*connect.Response[v1pb.UserInboundEmail], error) {
+	resp, err := s.APIV1Service.GetUserInboundEmail(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListMemoRelations(ctx context.Context, req *connect.Request[v1pb.ListMemoRelationsRequest]) (*connect.Response[v1pb.ListMemoRelationsResponse], error) {
-	resp, err := s.APIV1Service.ListMemoRelations(ctx, req.Msg)
+func (s *ConnectServiceHandler) RotateUserInboundEmail(ctx context.Context, req *connect.Request[v1pb.RotateUserInboundEmailRequest]) (*connect.Response[v1pb.UserInboundEmail], error) {
+	resp, err := s.APIV1Service.RotateUserInboundEmail(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) CreateMemoComment(ctx context.Context, req *connect.Request[v1pb.CreateMemoCommentRequest]) (*connect.Response[v1pb.Memo], error) {
-	resp, err := s.APIV1Service.CreateMemoComment(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserInboundEmail(ctx context.Context, req *connect.Request[v1pb.DeleteUserInboundEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserInboundEmail(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListMemoComments(ctx context.Context, req *connect.Request[v1pb.ListMemoCommentsRequest]) (*connect.Response[v1pb.ListMemoCommentsResponse], error) {
-	resp, err := s.APIV1Service.ListMemoComments(ctx, req.Msg)
+func (s *ConnectServiceHandler) GetUserSlackLink(ctx context.Context, req *connect.Request[v1pb.GetUserSlackLinkRequest]) (*connect.Response[v1pb.UserSlackLink], error) {
+	resp, err := s.APIV1Service.GetUserSlackLink(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListMemoReactions(ctx context.Context, req *connect.Request[v1pb.ListMemoReactionsRequest]) (*connect.Response[v1pb.ListMemoReactionsResponse], error) {
-	resp, err := s.APIV1Service.ListMemoReactions(ctx, req.Msg)
+func (s *ConnectServiceHandler) GenerateUserSlackLinkCode(ctx context.Context, req *connect.Request[v1pb.GenerateUserSlackLinkCodeRequest]) (*connect.Response[v1pb.UserSlackLink], error) {
+	resp, err := s.APIV1Service.GenerateUserSlackLinkCode(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) UpsertMemoReaction(ctx context.Context, req *connect.Request[v1pb.UpsertMemoReactionRequest]) (*connect.Response[v1pb.Reaction], error) {
-	resp, err := s.APIV1Service.UpsertMemoReaction(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserSlackLink(ctx context.Context, req *connect.Request[v1pb.DeleteUserSlackLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserSlackLink(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) DeleteMemoReaction(ctx context.Context, req *connect.Request[v1pb.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.DeleteMemoReaction(ctx, req.Msg)
+func (s *ConnectServiceHandler) GetUserMatrixLink(ctx context.Context, req *connect.Request[v1pb.GetUserMatrixLinkRequest]) (*connect.Response[v1pb.UserMatrixLink], error) {
+	resp, err := s.APIV1Service.GetUserMatrixLink(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-// AttachmentService
+func (s *ConnectServiceHandler) GenerateUserMatrixLinkCode(ctx context.Context, req *connect.Request[v1pb.GenerateUserMatrixLinkCodeRequest]) (*connect.Response[v1pb.UserMatrixLink], error) {
+	resp, err := s.APIV1Service.GenerateUserMatrixLinkCode(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
 
-func (s *ConnectServiceHandler) CreateAttachment(ctx context.Context, req *connect.Request[v1pb.CreateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
-	resp, err := s.APIV1Service.CreateAttachment(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserMatrixLink(ctx context.Context, req *connect.Request[v1pb.DeleteUserMatrixLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserMatrixLink(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) ListAttachments(ctx context.Context, req *connect.Request[v1pb.ListAttachmentsRequest]) (*connect.Response[v1pb.ListAttachmentsResponse], error) {
-	resp, err := s.APIV1Service.ListAttachments(ctx, req.Msg)
+func (s *ConnectServiceHandler) UnlockUser(ctx context.Context, req *connect.Request[v1pb.UnlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.UnlockUser(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) GetAttachment(ctx context.Context, req *connect.Request[v1pb.GetAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
-	resp, err := s.APIV1Service.GetAttachment(ctx, req.Msg)
+func (s *ConnectServiceHandler) SuspendUser(ctx context.Context, req *connect.Request[v1pb.SuspendUserRequest]) (*connect.Response[v1pb.User], error) {
+	resp, err := s.APIV1Service.SuspendUser(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) UpdateAttachment(ctx context.Context, req *connect.Request[v1pb.UpdateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
-	resp, err := s.APIV1Service.UpdateAttachment(ctx, req.Msg)
+func (s *ConnectServiceHandler) UnsuspendUser(ctx context.Context, req *connect.Request[v1pb.UnsuspendUserRequest]) (*connect.Response[v1pb.User], error) {
+	resp, err := s.APIV1Service.UnsuspendUser(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) DeleteAttachment(ctx context.Context, req *connect.Request[v1pb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.DeleteAttachment(ctx, req.Msg)
+func (s *ConnectServiceHandler) ListUserWebhooks(ctx context.Context, req *connect.Request[v1pb.ListUserWebhooksRequest]) (*connect.Response[v1pb.ListUserWebhooksResponse], error) {
+	resp, err := s.APIV1Service.ListUserWebhooks(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-// ShortcutService
+func (s *ConnectServiceHandler) CreateUserWebhook(ctx context.Context, req *connect.Request[v1pb.CreateUserWebhookRequest]) (*connect.Response[v1pb.UserWebhook], error) {
+	resp, err := s.APIV1Service.CreateUserWebhook(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
 
-func (s *ConnectServiceHandler) ListShortcuts(ctx context.Context, req *connect.Request[v1pb.ListShortcutsRequest]) (*connect.Response[v1pb.ListShortcutsResponse], error) {
-	resp, err := s.APIV1Service.ListShortcuts(ctx, req.Msg)
+func (s *ConnectServiceHandler) UpdateUserWebhook(ctx context.Context, req *connect.Request[v1pb.UpdateUserWebhookRequest]) (*connect.Response[v1pb.UserWebhook], error) {
+	resp, err := s.APIV1Service.UpdateUserWebhook(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) GetShortcut(ctx context.Context, req *connect.Request[v1pb.GetShortcutRequest]) (*connect.Response[v1pb.Shortcut], error) {
-	resp, err := s.APIV1Service.GetShortcut(ctx, req.Msg)
+func (s *ConnectServiceHandler) DeleteUserWebhook(ctx context.Context, req *connect.Request[v1pb.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserWebhook(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) CreateShortcut(ctx context.Context, req *connect.Request[v1pb.CreateShortcutRequest]) (*connect.Response[v1pb.Shortcut], error) {
-	resp, err := s.APIV1Service.CreateShortcut(ctx, req.Msg)
+func (s *ConnectServiceHandler) RotateUserWebhookSecret(ctx context.Context, req *connect.Request[v1pb.RotateUserWebhookSecretRequest]) (*connect.Response[v1pb.UserWebhook], error) {
+	resp, err := s.APIV1Service.RotateUserWebhookSecret(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) UpdateShortcut(ctx context.Context, req *connect.Request[v1pb.UpdateShortcutRequest]) (*connect.Response[v1pb.Shortcut], error) {
-	resp, err := s.APIV1Service.UpdateShortcut(ctx, req.Msg)
+func (s *ConnectServiceHandler) TestUserWebhook(ctx context.Context, req *connect.Request[v1pb.TestUserWebhookRequest]) (*connect.Response[v1pb.TestUserWebhookResponse], error) {
+	resp, err := s.APIV1Service.TestUserWebhook(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
 	return connect.NewResponse(resp), nil
 }
 
-func (s *ConnectServiceHandler) DeleteShortcut(ctx context.Context, req *connect.Request[v1pb.DeleteShortcutRequest]) (*connect.Response[emptypb.Empty], error) {
-	resp, err := s.APIV1Service.DeleteShortcut(ctx, req.Msg)
+func (s *ConnectServiceHandler) ListUserWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListUserWebhookDeliveriesResponse], error) {
+	resp, err := s.APIV1Service.ListUserWebhookDeliveries(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RedeliverUserWebhookDelivery(ctx context.Context, req *connect.Request[v1pb.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1pb.UserWebhookDelivery], error) {
+	resp, err := s.APIV1Service.RedeliverUserWebhookDelivery(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListUserNotifications(ctx context.Context, req *connect.Request[v1pb.ListUserNotificationsRequest]) (*connect.Response[v1pb.ListUserNotificationsResponse], error) {
+	resp, err := s.APIV1Service.ListUserNotifications(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateUserNotification(ctx context.Context, req *connect.Request[v1pb.UpdateUserNotificationRequest]) (*connect.Response[v1pb.UserNotification], error) {
+	resp, err := s.APIV1Service.UpdateUserNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteUserNotification(ctx context.Context, req *connect.Request[v1pb.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteUserNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MemoService
+
+func (s *ConnectServiceHandler) CreateMemo(ctx context.Context, req *connect.Request[v1pb.CreateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.CreateMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemos(ctx context.Context, req *connect.Request[v1pb.ListMemosRequest]) (*connect.Response[v1pb.ListMemosResponse], error) {
+	resp, err := s.APIV1Service.ListMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoHighlights(ctx context.Context, req *connect.Request[v1pb.GetMemoHighlightsRequest]) (*connect.Response[v1pb.GetMemoHighlightsResponse], error) {
+	resp, err := s.APIV1Service.GetMemoHighlights(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoCounts(ctx context.Context, req *connect.Request[v1pb.GetMemoCountsRequest]) (*connect.Response[v1pb.GetMemoCountsResponse], error) {
+	resp, err := s.APIV1Service.GetMemoCounts(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) PreviewAutoArchiveMemos(ctx context.Context, req *connect.Request[v1pb.PreviewAutoArchiveMemosRequest]) (*connect.Response[v1pb.PreviewAutoArchiveMemosResponse], error) {
+	resp, err := s.APIV1Service.PreviewAutoArchiveMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemo(ctx context.Context, req *connect.Request[v1pb.GetMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.GetMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemo(ctx context.Context, req *connect.Request[v1pb.UpdateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.UpdateMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemo(ctx context.Context, req *connect.Request[v1pb.DeleteMemoRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RestoreMemo(ctx context.Context, req *connect.Request[v1pb.RestoreMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.RestoreMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) PurgeMemo(ctx context.Context, req *connect.Request[v1pb.PurgeMemoRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.PurgeMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoRevisions(ctx context.Context, req *connect.Request[v1pb.ListMemoRevisionsRequest]) (*connect.Response[v1pb.ListMemoRevisionsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoRevisions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoRevision(ctx context.Context, req *connect.Request[v1pb.GetMemoRevisionRequest]) (*connect.Response[v1pb.MemoRevision], error) {
+	resp, err := s.APIV1Service.GetMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1pb.RestoreMemoRevisionRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.RestoreMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoShareLink(ctx context.Context, req *connect.Request[v1pb.CreateMemoShareLinkRequest]) (*connect.Response[v1pb.MemoShareLink], error) {
+	resp, err := s.APIV1Service.CreateMemoShareLink(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoShareLinks(ctx context.Context, req *connect.Request[v1pb.ListMemoShareLinksRequest]) (*connect.Response[v1pb.ListMemoShareLinksResponse], error) {
+	resp, err := s.APIV1Service.ListMemoShareLinks(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemoShareLink(ctx context.Context, req *connect.Request[v1pb.UpdateMemoShareLinkRequest]) (*connect.Response[v1pb.MemoShareLink], error) {
+	resp, err := s.APIV1Service.UpdateMemoShareLink(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemoShareLink(ctx context.Context, req *connect.Request[v1pb.DeleteMemoShareLinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemoShareLink(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetSharedMemo(ctx context.Context, req *connect.Request[v1pb.GetSharedMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.GetSharedMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UnlockSharedMemo(ctx context.Context, req *connect.Request[v1pb.UnlockSharedMemoRequest]) (*connect.Response[v1pb.UnlockSharedMemoResponse], error) {
+	resp, err := s.APIV1Service.UnlockSharedMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DuplicateMemo(ctx context.Context, req *connect.Request[v1pb.DuplicateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.DuplicateMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) MergeMemos(ctx context.Context, req *connect.Request[v1pb.MergeMemosRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.MergeMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoFromWikiLink(ctx context.Context, req *connect.Request[v1pb.CreateMemoFromWikiLinkRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.CreateMemoFromWikiLink(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemoTask(ctx context.Context, req *connect.Request[v1pb.UpdateMemoTaskRequest]) (*connect.Response[v1pb.UpdateMemoTaskResponse], error) {
+	resp, err := s.APIV1Service.UpdateMemoTask(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) MovePinnedMemo(ctx context.Context, req *connect.Request[v1pb.MovePinnedMemoRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.MovePinnedMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SnoozeMemoReminder(ctx context.Context, req *connect.Request[v1pb.SnoozeMemoReminderRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.SnoozeMemoReminder(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CompleteMemoReminder(ctx context.Context, req *connect.Request[v1pb.CompleteMemoReminderRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.CompleteMemoReminder(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListTagTree(ctx context.Context, req *connect.Request[v1pb.ListTagTreeRequest]) (*connect.Response[v1pb.ListTagTreeResponse], error) {
+	resp, err := s.APIV1Service.ListTagTree(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RenameMemoTag(ctx context.Context, req *connect.Request[v1pb.RenameMemoTagRequest]) (*connect.Response[v1pb.RenameMemoTagResponse], error) {
+	resp, err := s.APIV1Service.RenameMemoTag(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateBookmarkMemo(ctx context.Context, req *connect.Request[v1pb.CreateBookmarkMemoRequest]) (*connect.Response[v1pb.CreateBookmarkMemoResponse], error) {
+	resp, err := s.APIV1Service.CreateBookmarkMemo(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) BatchUpdateMemos(ctx context.Context, req *connect.Request[v1pb.BatchUpdateMemosRequest]) (*connect.Response[v1pb.BatchUpdateMemosResponse], error) {
+	resp, err := s.APIV1Service.BatchUpdateMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ImportMemos(ctx context.Context, req *connect.Request[v1pb.ImportMemosRequest]) (*connect.Response[v1pb.MemoImport], error) {
+	resp, err := s.APIV1Service.ImportMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoImport(ctx context.Context, req *connect.Request[v1pb.GetMemoImportRequest]) (*connect.Response[v1pb.MemoImport], error) {
+	resp, err := s.APIV1Service.GetMemoImport(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SetMemoAttachments(ctx context.Context, req *connect.Request[v1pb.SetMemoAttachmentsRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.SetMemoAttachments(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoAttachments(ctx context.Context, req *connect.Request[v1pb.ListMemoAttachmentsRequest]) (*connect.Response[v1pb.ListMemoAttachmentsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoAttachments(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SetMemoRelations(ctx context.Context, req *connect.Request[v1pb.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.SetMemoRelations(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoRelations(ctx context.Context, req *connect.Request[v1pb.ListMemoRelationsRequest]) (*connect.Response[v1pb.ListMemoRelationsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoRelations(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoBacklinks(ctx context.Context, req *connect.Request[v1pb.ListMemoBacklinksRequest]) (*connect.Response[v1pb.ListMemoBacklinksResponse], error) {
+	resp, err := s.APIV1Service.ListMemoBacklinks(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoComment(ctx context.Context, req *connect.Request[v1pb.CreateMemoCommentRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.CreateMemoComment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoComments(ctx context.Context, req *connect.Request[v1pb.ListMemoCommentsRequest]) (*connect.Response[v1pb.ListMemoCommentsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoComments(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoReactions(ctx context.Context, req *connect.Request[v1pb.ListMemoReactionsRequest]) (*connect.Response[v1pb.ListMemoReactionsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoReactions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpsertMemoReaction(ctx context.Context, req *connect.Request[v1pb.UpsertMemoReactionRequest]) (*connect.Response[v1pb.Reaction], error) {
+	resp, err := s.APIV1Service.UpsertMemoReaction(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemoReaction(ctx context.Context, req *connect.Request[v1pb.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemoReaction(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// AttachmentService
+
+func (s *ConnectServiceHandler) CreateAttachment(ctx context.Context, req *connect.Request[v1pb.CreateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.CreateAttachment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateAttachmentUpload(ctx context.Context, req *connect.Request[v1pb.CreateAttachmentUploadRequest]) (*connect.Response[v1pb.CreateAttachmentUploadResponse], error) {
+	resp, err := s.APIV1Service.CreateAttachmentUpload(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CompleteAttachmentUpload(ctx context.Context, req *connect.Request[v1pb.CompleteAttachmentUploadRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.CompleteAttachmentUpload(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateChunkedUpload(ctx context.Context, req *connect.Request[v1pb.CreateChunkedUploadRequest]) (*connect.Response[v1pb.ChunkedUpload], error) {
+	resp, err := s.APIV1Service.CreateChunkedUpload(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UploadAttachmentChunk(ctx context.Context, req *connect.Request[v1pb.UploadAttachmentChunkRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.UploadAttachmentChunk(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetChunkedUpload(ctx context.Context, req *connect.Request[v1pb.GetChunkedUploadRequest]) (*connect.Response[v1pb.ChunkedUpload], error) {
+	resp, err := s.APIV1Service.GetChunkedUpload(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CompleteChunkedUpload(ctx context.Context, req *connect.Request[v1pb.CompleteChunkedUploadRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.CompleteChunkedUpload(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListAttachments(ctx context.Context, req *connect.Request[v1pb.ListAttachmentsRequest]) (*connect.Response[v1pb.ListAttachmentsResponse], error) {
+	resp, err := s.APIV1Service.ListAttachments(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetAttachment(ctx context.Context, req *connect.Request[v1pb.GetAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.GetAttachment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateAttachment(ctx context.Context, req *connect.Request[v1pb.UpdateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.UpdateAttachment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteAttachment(ctx context.Context, req *connect.Request[v1pb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteAttachment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) PurgeOrphanedAttachments(ctx context.Context, req *connect.Request[v1pb.PurgeOrphanedAttachmentsRequest]) (*connect.Response[v1pb.PurgeOrphanedAttachmentsResponse], error) {
+	resp, err := s.APIV1Service.PurgeOrphanedAttachments(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeduplicateAttachments(ctx context.Context, req *connect.Request[v1pb.DeduplicateAttachmentsRequest]) (*connect.Response[v1pb.DeduplicateAttachmentsResponse], error) {
+	resp, err := s.APIV1Service.DeduplicateAttachments(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RefreshExternalAttachment(ctx context.Context, req *connect.Request[v1pb.RefreshExternalAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
+	resp, err := s.APIV1Service.RefreshExternalAttachment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RecalculateStorageUsage(ctx context.Context, req *connect.Request[v1pb.RecalculateStorageUsageRequest]) (*connect.Response[v1pb.RecalculateStorageUsageResponse], error) {
+	resp, err := s.APIV1Service.RecalculateStorageUsage(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ShortcutService
+
+func (s *ConnectServiceHandler) ListShortcuts(ctx context.Context, req *connect.Request[v1pb.ListShortcutsRequest]) (*connect.Response[v1pb.ListShortcutsResponse], error) {
+	resp, err := s.APIV1Service.ListShortcuts(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetShortcut(ctx context.Context, req *connect.Request[v1pb.GetShortcutRequest]) (*connect.Response[v1pb.Shortcut], error) {
+	resp, err := s.APIV1Service.GetShortcut(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateShortcut(ctx context.Context, req *connect.Request[v1pb.CreateShortcutRequest]) (*connect.Response[v1pb.Shortcut], error) {
+	resp, err := s.APIV1Service.CreateShortcut(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateShortcut(ctx context.Context, req *connect.Request[v1pb.UpdateShortcutRequest]) (*connect.Response[v1pb.Shortcut], error) {
+	resp, err := s.APIV1Service.UpdateShortcut(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteShortcut(ctx context.Context, req *connect.Request[v1pb.DeleteShortcutRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteShortcut(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ReorderShortcuts(ctx context.Context, req *connect.Request[v1pb.ReorderShortcutsRequest]) (*connect.Response[v1pb.ListShortcutsResponse], error) {
+	resp, err := s.APIV1Service.ReorderShortcuts(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CountShortcutMemos(ctx context.Context, req *connect.Request[v1pb.CountShortcutMemosRequest]) (*connect.Response[v1pb.CountShortcutMemosResponse], error) {
+	resp, err := s.APIV1Service.CountShortcutMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MemoTemplateService
+
+func (s *ConnectServiceHandler) ListMemoTemplates(ctx context.Context, req *connect.Request[v1pb.ListMemoTemplatesRequest]) (*connect.Response[v1pb.ListMemoTemplatesResponse], error) {
+	resp, err := s.APIV1Service.ListMemoTemplates(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoTemplate(ctx context.Context, req *connect.Request[v1pb.GetMemoTemplateRequest]) (*connect.Response[v1pb.MemoTemplate], error) {
+	resp, err := s.APIV1Service.GetMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoTemplate(ctx context.Context, req *connect.Request[v1pb.CreateMemoTemplateRequest]) (*connect.Response[v1pb.MemoTemplate], error) {
+	resp, err := s.APIV1Service.CreateMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemoTemplate(ctx context.Context, req *connect.Request[v1pb.UpdateMemoTemplateRequest]) (*connect.Response[v1pb.MemoTemplate], error) {
+	resp, err := s.APIV1Service.UpdateMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemoTemplate(ctx context.Context, req *connect.Request[v1pb.DeleteMemoTemplateRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemoTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ReorderMemoTemplates(ctx context.Context, req *connect.Request[v1pb.ReorderMemoTemplatesRequest]) (*connect.Response[v1pb.ListMemoTemplatesResponse], error) {
+	resp, err := s.APIV1Service.ReorderMemoTemplates(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoFromTemplate(ctx context.Context, req *connect.Request[v1pb.CreateMemoFromTemplateRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.CreateMemoFromTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MemoRecurrenceService
+
+func (s *ConnectServiceHandler) ListMemoRecurrences(ctx context.Context, req *connect.Request[v1pb.ListMemoRecurrencesRequest]) (*connect.Response[v1pb.ListMemoRecurrencesResponse], error) {
+	resp, err := s.APIV1Service.ListMemoRecurrences(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoRecurrence(ctx context.Context, req *connect.Request[v1pb.GetMemoRecurrenceRequest]) (*connect.Response[v1pb.MemoRecurrence], error) {
+	resp, err := s.APIV1Service.GetMemoRecurrence(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateMemoRecurrence(ctx context.Context, req *connect.Request[v1pb.CreateMemoRecurrenceRequest]) (*connect.Response[v1pb.MemoRecurrence], error) {
+	resp, err := s.APIV1Service.CreateMemoRecurrence(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateMemoRecurrence(ctx context.Context, req *connect.Request[v1pb.UpdateMemoRecurrenceRequest]) (*connect.Response[v1pb.MemoRecurrence], error) {
+	resp, err := s.APIV1Service.UpdateMemoRecurrence(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteMemoRecurrence(ctx context.Context, req *connect.Request[v1pb.DeleteMemoRecurrenceRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DeleteMemoRecurrence(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) PauseMemoRecurrence(ctx context.Context, req *connect.Request[v1pb.PauseMemoRecurrenceRequest]) (*connect.Response[v1pb.MemoRecurrence], error) {
+	resp, err := s.APIV1Service.PauseMemoRecurrence(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ResumeMemoRecurrence(ctx context.Context, req *connect.Request[v1pb.ResumeMemoRecurrenceRequest]) (*connect.Response[v1pb.MemoRecurrence], error) {
+	resp, err := s.APIV1Service.ResumeMemoRecurrence(ctx, req.Msg)
 	if err != nil {
 		return nil, convertGRPCError(err)
 	}
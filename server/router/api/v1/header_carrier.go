@@ -25,28 +25,41 @@ type headerCarrierKey struct{}
 //
 // This allows service methods to work with both protocols without knowing which one is being used.
 type HeaderCarrier struct {
-	headers map[string]string
+	headers map[string][]string
 }
 
 // newHeaderCarrier creates a new header carrier.
 func newHeaderCarrier() *HeaderCarrier {
 	return &HeaderCarrier{
-		headers: make(map[string]string),
+		headers: make(map[string][]string),
 	}
 }
 
-// Set adds a header to the carrier.
+// Set sets a header in the carrier, replacing any existing values.
 func (h *HeaderCarrier) Set(key, value string) {
-	h.headers[key] = value
+	h.headers[key] = []string{value}
 }
 
-// Get retrieves a header from the carrier.
+// Add appends a header value to the carrier, e.g. for multiple Set-Cookie headers.
+func (h *HeaderCarrier) Add(key, value string) {
+	h.headers[key] = append(h.headers[key], value)
+}
+
+// Get retrieves the first value of a header from the carrier.
 func (h *HeaderCarrier) Get(key string) string {
+	if values := h.headers[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Values retrieves all values of a header from the carrier.
+func (h *HeaderCarrier) Values(key string) []string {
 	return h.headers[key]
 }
 
 // All returns all headers.
-func (h *HeaderCarrier) All() map[string]string {
+func (h *HeaderCarrier) All() map[string][]string {
 	return h.headers
 }
 
@@ -85,6 +98,18 @@ func SetResponseHeader(ctx context.Context, key, value string) error {
 	}))
 }
 
+// AddResponseHeader adds a header value to the response without replacing earlier values
+// of the same header, e.g. to set several cookies.
+func AddResponseHeader(ctx context.Context, key, value string) error {
+	if carrier := GetHeaderCarrier(ctx); carrier != nil {
+		carrier.Add(key, value)
+		return nil
+	}
+
+	// gRPC appends header metadata set by repeated calls
+	return grpc.SetHeader(ctx, metadata.Pairs(key, value))
+}
+
 // connectWithHeaderCarrier is a helper for Connect service wrappers that need to set response headers.
 //
 // It injects a HeaderCarrier into the context, calls the service method,
@@ -115,8 +140,10 @@ func connectWithHeaderCarrier[T any](ctx context.Context, fn func(context.Contex
 
 	// Apply any headers set via the header carrier
 	if carrier := GetHeaderCarrier(ctx); carrier != nil {
-		for key, value := range carrier.All() {
-			connectResp.Header().Set(key, value)
+		for key, values := range carrier.All() {
+			for _, value := range values {
+				connectResp.Header().Add(key, value)
+			}
 		}
 	}
 
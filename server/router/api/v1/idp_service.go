@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -173,6 +174,9 @@ func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider
 						Email:       oauth2Config.FieldMapping.Email,
 						AvatarUrl:   oauth2Config.FieldMapping.AvatarUrl,
 					},
+					RoleClaim:    oauth2Config.RoleClaim,
+					RoleMappings: convertRoleMappingsFromStore(oauth2Config.RoleMappings),
+					SyncUserInfo: oauth2Config.SyncUserInfo,
 				},
 			},
 		}
@@ -211,6 +215,9 @@ func convertIdentityProviderConfigToStore(identityProviderType v1pb.IdentityProv
 						Email:       oauth2Config.FieldMapping.Email,
 						AvatarUrl:   oauth2Config.FieldMapping.AvatarUrl,
 					},
+					RoleClaim:    oauth2Config.RoleClaim,
+					RoleMappings: convertRoleMappingsToStore(oauth2Config.RoleMappings),
+					SyncUserInfo: oauth2Config.SyncUserInfo,
 				},
 			},
 		}
@@ -218,6 +225,54 @@ func convertIdentityProviderConfigToStore(identityProviderType v1pb.IdentityProv
 	return nil
 }
 
+func convertRoleMappingsFromStore(roleMappings []*storepb.RoleMapping) []*v1pb.RoleMapping {
+	list := make([]*v1pb.RoleMapping, 0, len(roleMappings))
+	for _, roleMapping := range roleMappings {
+		list = append(list, &v1pb.RoleMapping{
+			ClaimValue: roleMapping.ClaimValue,
+			Role:       convertUserRoleFromStore(store.Role(roleMapping.Role)),
+		})
+	}
+	return list
+}
+
+func convertRoleMappingsToStore(roleMappings []*v1pb.RoleMapping) []*storepb.RoleMapping {
+	list := make([]*storepb.RoleMapping, 0, len(roleMappings))
+	for _, roleMapping := range roleMappings {
+		list = append(list, &storepb.RoleMapping{
+			ClaimValue: roleMapping.ClaimValue,
+			Role:       convertUserRoleToStore(roleMapping.Role).String(),
+		})
+	}
+	return list
+}
+
+// mapIdentityProviderRole returns the role granted by the role mappings of an OAuth2 identity
+// provider to a user with the given groups. Users matching several mappings get the most
+// privileged role, users matching none get RoleUser. ok is false if no role mapping is
+// configured, in which case roles are managed in memos.
+func mapIdentityProviderRole(config *storepb.OAuth2Config, groups []string) (role store.Role, ok bool) {
+	if config.GetRoleClaim() == "" || len(config.GetRoleMappings()) == 0 {
+		return "", false
+	}
+	role = store.RoleUser
+	for _, roleMapping := range config.RoleMappings {
+		if !slices.Contains(groups, roleMapping.ClaimValue) {
+			continue
+		}
+		switch store.Role(roleMapping.Role) {
+		case store.RoleHost:
+			role = store.RoleHost
+		case store.RoleAdmin:
+			if role != store.RoleHost {
+				role = store.RoleAdmin
+			}
+		default:
+		}
+	}
+	return role, true
+}
+
 func redactIdentityProviderResponse(identityProvider *v1pb.IdentityProvider, userRole store.Role) *v1pb.IdentityProvider {
 	if userRole != store.RoleHost {
 		if identityProvider.Type == v1pb.IdentityProvider_OAUTH2 {
@@ -3,13 +3,17 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/plugin/matrix"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -27,6 +31,19 @@ func (s *APIV1Service) GetInstanceProfile(ctx context.Context, _ *v1pb.GetInstan
 	if owner != nil {
 		instanceProfile.Owner = owner.Name
 	}
+	announcement, err := s.getActiveAnnouncement(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance announcement: %v", err)
+	}
+	instanceProfile.Announcement = announcement
+	// Push notifications link to the memos, so they need the instance URL.
+	if s.Profile.InstanceURL != "" {
+		vapid, err := s.getWebPushVAPID(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get web push keys: %v", err)
+		}
+		instanceProfile.WebPushPublicKey = vapid.PublicKey
+	}
 	return instanceProfile, nil
 }
 
@@ -47,6 +64,20 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		_, err = s.Store.GetInstanceMemoRelatedSetting(ctx)
 	case storepb.InstanceSettingKey_STORAGE:
 		_, err = s.Store.GetInstanceStorageSetting(ctx)
+	case storepb.InstanceSettingKey_LINK_PREVIEW:
+		_, err = s.Store.GetInstanceLinkPreviewSetting(ctx)
+	case storepb.InstanceSettingKey_EMAIL:
+		_, err = s.Store.GetInstanceEmailSetting(ctx)
+	case storepb.InstanceSettingKey_BACKUP:
+		_, err = s.Store.GetInstanceBackupSetting(ctx)
+	case storepb.InstanceSettingKey_SLACK:
+		_, err = s.Store.GetInstanceSlackSetting(ctx)
+	case storepb.InstanceSettingKey_MATRIX:
+		_, err = s.Store.GetInstanceMatrixSetting(ctx)
+	case storepb.InstanceSettingKey_ANNOUNCEMENT:
+		_, err = s.Store.GetInstanceAnnouncementSetting(ctx)
+	case storepb.InstanceSettingKey_RATE_LIMIT:
+		_, err = s.Store.GetInstanceRateLimitSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -64,8 +95,9 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		return nil, status.Errorf(codes.NotFound, "instance setting not found")
 	}
 
-	// For storage setting, only host can get it.
-	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE {
+	// For storage, email, backup, Slack and Matrix settings, only host can get them.
+	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE || instanceSetting.Key == storepb.InstanceSettingKey_EMAIL || instanceSetting.Key == storepb.InstanceSettingKey_BACKUP ||
+		instanceSetting.Key == storepb.InstanceSettingKey_SLACK || instanceSetting.Key == storepb.InstanceSettingKey_MATRIX {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -75,7 +107,18 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		}
 	}
 
-	return convertInstanceSettingFromStore(instanceSetting), nil
+	setting := convertInstanceSettingFromStore(instanceSetting)
+	// The internal host allowlist reveals the local network, so only admins can see it.
+	if linkPreviewSetting := setting.GetLinkPreviewSetting(); linkPreviewSetting != nil && len(linkPreviewSetting.AllowedInternalHosts) > 0 {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if user == nil || (user.Role != store.RoleHost && user.Role != store.RoleAdmin) {
+			linkPreviewSetting.AllowedInternalHosts = nil
+		}
+	}
+	return setting, nil
 }
 
 func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.UpdateInstanceSettingRequest) (*v1pb.InstanceSetting, error) {
@@ -94,10 +137,65 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	_ = request.UpdateMask
 
 	updateSetting := convertInstanceSettingToStore(request.Setting)
+	// The sync state of the Matrix bot is written by the bot only, and the VAPID keys by the server.
+	if updateSetting.Key == storepb.InstanceSettingKey_MATRIX_SYNC || updateSetting.Key == storepb.InstanceSettingKey_WEB_PUSH {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", updateSetting.Key)
+	}
+	if linkPreviewSetting := updateSetting.GetLinkPreviewSetting(); linkPreviewSetting != nil {
+		if _, err := httpgetter.ParseInternalAllowlist(linkPreviewSetting.AllowedInternalHosts); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid allowed internal hosts: %v", err)
+		}
+		if err := httpgetter.ValidateUserAgent(linkPreviewSetting.UserAgent); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user agent: %v", err)
+		}
+		if err := httpgetter.ValidateRequestHeaders(linkPreviewSetting.RequestHeaders); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request headers: %v", err)
+		}
+	}
+	if imageCompression := updateSetting.GetStorageSetting().GetImageCompression(); imageCompression != nil {
+		if imageCompression.Quality < 0 || imageCompression.Quality > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "image compression quality must be between 1 and 100")
+		}
+		if imageCompression.MaxDimension < 0 || imageCompression.ThresholdKb < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "image compression max dimension and threshold must not be negative")
+		}
+	}
+	if memoRelatedSetting := updateSetting.GetMemoRelatedSetting(); memoRelatedSetting != nil {
+		if memoRelatedSetting.MaxMemosPerUser < 0 || memoRelatedSetting.MaxMemosPerDay < 0 || memoRelatedSetting.NewAccountMaxPublicMemosPerDay < 0 || memoRelatedSetting.NewAccountDays < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "memo quotas must not be negative")
+		}
+	}
+	if rateLimitSetting := updateSetting.GetRateLimitSetting(); rateLimitSetting != nil {
+		if rateLimitSetting.ReadRequestsPerMinute < 0 || rateLimitSetting.ReadBurst < 0 || rateLimitSetting.WriteRequestsPerMinute < 0 || rateLimitSetting.WriteBurst < 0 || rateLimitSetting.HostMultiplier < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "rate limits must not be negative")
+		}
+	}
+	if announcementSetting := updateSetting.GetAnnouncementSetting(); announcementSetting != nil {
+		if err := validateAnnouncementSetting(announcementSetting); err != nil {
+			return nil, err
+		}
+	}
+	if emailSetting := updateSetting.GetEmailSetting(); emailSetting != nil {
+		emailSetting.InboundDomain = strings.ToLower(strings.TrimSpace(emailSetting.InboundDomain))
+		if strings.ContainsAny(emailSetting.InboundDomain, "@ /") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid inbound email domain %q", emailSetting.InboundDomain)
+		}
+	}
+	if backupSetting := updateSetting.GetBackupSetting(); backupSetting != nil {
+		if err := s.validateBackupSetting(ctx, backupSetting); err != nil {
+			return nil, err
+		}
+	}
+	if matrixSetting := updateSetting.GetMatrixSetting(); matrixSetting != nil && matrixSetting.HomeserverUrl != "" {
+		if _, err := matrix.NewClient(matrixSetting.HomeserverUrl, matrixSetting.AccessToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid Matrix homeserver URL: %v", err)
+		}
+	}
 	instanceSetting, err := s.Store.UpsertInstanceSetting(ctx, updateSetting)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert instance setting: %v", err)
 	}
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventInstanceSettingChanged, user.ID, map[string]any{"key": instanceSetting.Key.String()})
 
 	return convertInstanceSettingFromStore(instanceSetting), nil
 }
@@ -119,6 +217,34 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_MemoRelatedSetting_{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingFromStore(setting.GetMemoRelatedSetting()),
 		}
+	case *storepb.InstanceSetting_LinkPreviewSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_LinkPreviewSetting_{
+			LinkPreviewSetting: convertInstanceLinkPreviewSettingFromStore(setting.GetLinkPreviewSetting()),
+		}
+	case *storepb.InstanceSetting_EmailSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_EmailSetting_{
+			EmailSetting: convertInstanceEmailSettingFromStore(setting.GetEmailSetting()),
+		}
+	case *storepb.InstanceSetting_BackupSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_BackupSetting_{
+			BackupSetting: convertInstanceBackupSettingFromStore(setting.GetBackupSetting()),
+		}
+	case *storepb.InstanceSetting_SlackSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_SlackSetting_{
+			SlackSetting: convertInstanceSlackSettingFromStore(setting.GetSlackSetting()),
+		}
+	case *storepb.InstanceSetting_MatrixSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_MatrixSetting_{
+			MatrixSetting: convertInstanceMatrixSettingFromStore(setting.GetMatrixSetting()),
+		}
+	case *storepb.InstanceSetting_AnnouncementSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_AnnouncementSetting_{
+			AnnouncementSetting: convertInstanceAnnouncementSettingFromStore(setting.GetAnnouncementSetting()),
+		}
+	case *storepb.InstanceSetting_RateLimitSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_RateLimitSetting_{
+			RateLimitSetting: convertInstanceRateLimitSettingFromStore(setting.GetRateLimitSetting()),
+		}
 	}
 	return instanceSetting
 }
@@ -144,6 +270,34 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingToStore(setting.GetMemoRelatedSetting()),
 		}
+	case storepb.InstanceSettingKey_LINK_PREVIEW:
+		instanceSetting.Value = &storepb.InstanceSetting_LinkPreviewSetting{
+			LinkPreviewSetting: convertInstanceLinkPreviewSettingToStore(setting.GetLinkPreviewSetting()),
+		}
+	case storepb.InstanceSettingKey_EMAIL:
+		instanceSetting.Value = &storepb.InstanceSetting_EmailSetting{
+			EmailSetting: convertInstanceEmailSettingToStore(setting.GetEmailSetting()),
+		}
+	case storepb.InstanceSettingKey_BACKUP:
+		instanceSetting.Value = &storepb.InstanceSetting_BackupSetting{
+			BackupSetting: convertInstanceBackupSettingToStore(setting.GetBackupSetting()),
+		}
+	case storepb.InstanceSettingKey_SLACK:
+		instanceSetting.Value = &storepb.InstanceSetting_SlackSetting{
+			SlackSetting: convertInstanceSlackSettingToStore(setting.GetSlackSetting()),
+		}
+	case storepb.InstanceSettingKey_MATRIX:
+		instanceSetting.Value = &storepb.InstanceSetting_MatrixSetting{
+			MatrixSetting: convertInstanceMatrixSettingToStore(setting.GetMatrixSetting()),
+		}
+	case storepb.InstanceSettingKey_ANNOUNCEMENT:
+		instanceSetting.Value = &storepb.InstanceSetting_AnnouncementSetting{
+			AnnouncementSetting: convertInstanceAnnouncementSettingToStore(setting.GetAnnouncementSetting()),
+		}
+	case storepb.InstanceSettingKey_RATE_LIMIT:
+		instanceSetting.Value = &storepb.InstanceSetting_RateLimitSetting{
+			RateLimitSetting: convertInstanceRateLimitSettingToStore(setting.GetRateLimitSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -156,13 +310,16 @@ func convertInstanceGeneralSettingFromStore(setting *storepb.InstanceGeneralSett
 	}
 
 	generalSetting := &v1pb.InstanceSetting_GeneralSetting{
-		DisallowUserRegistration: setting.DisallowUserRegistration,
-		DisallowPasswordAuth:     setting.DisallowPasswordAuth,
-		AdditionalScript:         setting.AdditionalScript,
-		AdditionalStyle:          setting.AdditionalStyle,
-		WeekStartDayOffset:       setting.WeekStartDayOffset,
-		DisallowChangeUsername:   setting.DisallowChangeUsername,
-		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		DisallowUserRegistration:     setting.DisallowUserRegistration,
+		DisallowPasswordAuth:         setting.DisallowPasswordAuth,
+		AdditionalScript:             setting.AdditionalScript,
+		AdditionalStyle:              setting.AdditionalStyle,
+		WeekStartDayOffset:           setting.WeekStartDayOffset,
+		DisallowChangeUsername:       setting.DisallowChangeUsername,
+		DisallowChangeNickname:       setting.DisallowChangeNickname,
+		AuditLogRetentionDays:        setting.AuditLogRetentionDays,
+		SessionIdleTimeoutHours:      setting.SessionIdleTimeoutHours,
+		SessionAbsoluteLifetimeHours: setting.SessionAbsoluteLifetimeHours,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &v1pb.InstanceSetting_GeneralSetting_CustomProfile{
@@ -179,13 +336,16 @@ func convertInstanceGeneralSettingToStore(setting *v1pb.InstanceSetting_GeneralS
 		return nil
 	}
 	generalSetting := &storepb.InstanceGeneralSetting{
-		DisallowUserRegistration: setting.DisallowUserRegistration,
-		DisallowPasswordAuth:     setting.DisallowPasswordAuth,
-		AdditionalScript:         setting.AdditionalScript,
-		AdditionalStyle:          setting.AdditionalStyle,
-		WeekStartDayOffset:       setting.WeekStartDayOffset,
-		DisallowChangeUsername:   setting.DisallowChangeUsername,
-		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		DisallowUserRegistration:     setting.DisallowUserRegistration,
+		DisallowPasswordAuth:         setting.DisallowPasswordAuth,
+		AdditionalScript:             setting.AdditionalScript,
+		AdditionalStyle:              setting.AdditionalStyle,
+		WeekStartDayOffset:           setting.WeekStartDayOffset,
+		DisallowChangeUsername:       setting.DisallowChangeUsername,
+		DisallowChangeNickname:       setting.DisallowChangeNickname,
+		AuditLogRetentionDays:        setting.AuditLogRetentionDays,
+		SessionIdleTimeoutHours:      setting.SessionIdleTimeoutHours,
+		SessionAbsoluteLifetimeHours: setting.SessionAbsoluteLifetimeHours,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &storepb.InstanceCustomProfile{
@@ -202,9 +362,13 @@ func convertInstanceStorageSettingFromStore(settingpb *storepb.InstanceStorageSe
 		return nil
 	}
 	setting := &v1pb.InstanceSetting_StorageSetting{
-		StorageType:       v1pb.InstanceSetting_StorageSetting_StorageType(settingpb.StorageType),
-		FilepathTemplate:  settingpb.FilepathTemplate,
-		UploadSizeLimitMb: settingpb.UploadSizeLimitMb,
+		StorageType:                 v1pb.InstanceSetting_StorageSetting_StorageType(settingpb.StorageType),
+		FilepathTemplate:            settingpb.FilepathTemplate,
+		UploadSizeLimitMb:           settingpb.UploadSizeLimitMb,
+		OrphanedAttachmentGraceDays: settingpb.OrphanedAttachmentGraceDays,
+		StripImageMetadata:          settingpb.StripImageMetadata,
+		DefaultUserQuotaMb:          settingpb.DefaultUserQuotaMb,
+		CheckExternalLinks:          settingpb.CheckExternalLinks,
 	}
 	if settingpb.S3Config != nil {
 		setting.S3Config = &v1pb.InstanceSetting_StorageSetting_S3Config{
@@ -216,6 +380,14 @@ func convertInstanceStorageSettingFromStore(settingpb *storepb.InstanceStorageSe
 			UsePathStyle:    settingpb.S3Config.UsePathStyle,
 		}
 	}
+	if settingpb.ImageCompression != nil {
+		setting.ImageCompression = &v1pb.InstanceSetting_StorageSetting_ImageCompressionConfig{
+			Enabled:      settingpb.ImageCompression.Enabled,
+			Quality:      settingpb.ImageCompression.Quality,
+			MaxDimension: settingpb.ImageCompression.MaxDimension,
+			ThresholdKb:  settingpb.ImageCompression.ThresholdKb,
+		}
+	}
 	return setting
 }
 
@@ -224,9 +396,13 @@ func convertInstanceStorageSettingToStore(setting *v1pb.InstanceSetting_StorageS
 		return nil
 	}
 	settingpb := &storepb.InstanceStorageSetting{
-		StorageType:       storepb.InstanceStorageSetting_StorageType(setting.StorageType),
-		FilepathTemplate:  setting.FilepathTemplate,
-		UploadSizeLimitMb: setting.UploadSizeLimitMb,
+		StorageType:                 storepb.InstanceStorageSetting_StorageType(setting.StorageType),
+		FilepathTemplate:            setting.FilepathTemplate,
+		UploadSizeLimitMb:           setting.UploadSizeLimitMb,
+		OrphanedAttachmentGraceDays: setting.OrphanedAttachmentGraceDays,
+		StripImageMetadata:          setting.StripImageMetadata,
+		DefaultUserQuotaMb:          setting.DefaultUserQuotaMb,
+		CheckExternalLinks:          setting.CheckExternalLinks,
 	}
 	if setting.S3Config != nil {
 		settingpb.S3Config = &storepb.StorageS3Config{
@@ -238,6 +414,14 @@ func convertInstanceStorageSettingToStore(setting *v1pb.InstanceSetting_StorageS
 			UsePathStyle:    setting.S3Config.UsePathStyle,
 		}
 	}
+	if setting.ImageCompression != nil {
+		settingpb.ImageCompression = &storepb.StorageImageCompressionConfig{
+			Enabled:      setting.ImageCompression.Enabled,
+			Quality:      setting.ImageCompression.Quality,
+			MaxDimension: setting.ImageCompression.MaxDimension,
+			ThresholdKb:  setting.ImageCompression.ThresholdKb,
+		}
+	}
 	return settingpb
 }
 
@@ -253,6 +437,14 @@ func convertInstanceMemoRelatedSettingFromStore(setting *storepb.InstanceMemoRel
 		Reactions:                setting.Reactions,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		TrashRetentionDays:       setting.TrashRetentionDays,
+		MemoRevisionLimit:        setting.MemoRevisionLimit,
+
+		BookmarkDuplicateWindowMinutes: setting.BookmarkDuplicateWindowMinutes,
+		MaxMemosPerUser:                setting.MaxMemosPerUser,
+		MaxMemosPerDay:                 setting.MaxMemosPerDay,
+		NewAccountMaxPublicMemosPerDay: setting.NewAccountMaxPublicMemosPerDay,
+		NewAccountDays:                 setting.NewAccountDays,
 	}
 }
 
@@ -268,9 +460,199 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 		Reactions:                setting.Reactions,
 		EnableBlurNsfwContent:    setting.EnableBlurNsfwContent,
 		NsfwTags:                 setting.NsfwTags,
+		TrashRetentionDays:       setting.TrashRetentionDays,
+		MemoRevisionLimit:        setting.MemoRevisionLimit,
+
+		BookmarkDuplicateWindowMinutes: setting.BookmarkDuplicateWindowMinutes,
+		MaxMemosPerUser:                setting.MaxMemosPerUser,
+		MaxMemosPerDay:                 setting.MaxMemosPerDay,
+		NewAccountMaxPublicMemosPerDay: setting.NewAccountMaxPublicMemosPerDay,
+		NewAccountDays:                 setting.NewAccountDays,
+	}
+}
+
+func convertInstanceLinkPreviewSettingFromStore(setting *storepb.InstanceLinkPreviewSetting) *v1pb.InstanceSetting_LinkPreviewSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_LinkPreviewSetting{
+		RateLimitPerMinute:      setting.RateLimitPerMinute,
+		RateLimitBurst:          setting.RateLimitBurst,
+		AdminRateLimitPerMinute: setting.AdminRateLimitPerMinute,
+		Mode:                    v1pb.InstanceSetting_LinkPreviewSetting_Mode(setting.Mode),
+		AllowedDomains:          setting.AllowedDomains,
+		AllowedInternalHosts:    setting.AllowedInternalHosts,
+		UserAgent:               setting.UserAgent,
+		RequestHeaders:          setting.RequestHeaders,
+	}
+}
+
+func convertInstanceLinkPreviewSettingToStore(setting *v1pb.InstanceSetting_LinkPreviewSetting) *storepb.InstanceLinkPreviewSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceLinkPreviewSetting{
+		RateLimitPerMinute:      setting.RateLimitPerMinute,
+		RateLimitBurst:          setting.RateLimitBurst,
+		AdminRateLimitPerMinute: setting.AdminRateLimitPerMinute,
+		Mode:                    storepb.InstanceLinkPreviewSetting_Mode(setting.Mode),
+		AllowedDomains:          setting.AllowedDomains,
+		AllowedInternalHosts:    setting.AllowedInternalHosts,
+		UserAgent:               setting.UserAgent,
+		RequestHeaders:          setting.RequestHeaders,
 	}
 }
 
+func convertInstanceRateLimitSettingFromStore(setting *storepb.InstanceRateLimitSetting) *v1pb.InstanceSetting_RateLimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_RateLimitSetting{
+		Disabled:               setting.Disabled,
+		ReadRequestsPerMinute:  setting.ReadRequestsPerMinute,
+		ReadBurst:              setting.ReadBurst,
+		WriteRequestsPerMinute: setting.WriteRequestsPerMinute,
+		WriteBurst:             setting.WriteBurst,
+		HostMultiplier:         setting.HostMultiplier,
+	}
+}
+
+func convertInstanceRateLimitSettingToStore(setting *v1pb.InstanceSetting_RateLimitSetting) *storepb.InstanceRateLimitSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceRateLimitSetting{
+		Disabled:               setting.Disabled,
+		ReadRequestsPerMinute:  setting.ReadRequestsPerMinute,
+		ReadBurst:              setting.ReadBurst,
+		WriteRequestsPerMinute: setting.WriteRequestsPerMinute,
+		WriteBurst:             setting.WriteBurst,
+		HostMultiplier:         setting.HostMultiplier,
+	}
+}
+
+func convertInstanceEmailSettingFromStore(setting *storepb.InstanceEmailSetting) *v1pb.InstanceSetting_EmailSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_EmailSetting{
+		SmtpHost:      setting.SmtpHost,
+		SmtpPort:      setting.SmtpPort,
+		SmtpUsername:  setting.SmtpUsername,
+		SmtpPassword:  setting.SmtpPassword,
+		UseTls:        setting.UseTls,
+		FromEmail:     setting.FromEmail,
+		FromName:      setting.FromName,
+		InboundDomain: setting.InboundDomain,
+		TlsMode:       v1pb.InstanceSetting_EmailSetting_TlsMode(setting.TlsMode),
+	}
+}
+
+func convertInstanceEmailSettingToStore(setting *v1pb.InstanceSetting_EmailSetting) *storepb.InstanceEmailSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceEmailSetting{
+		SmtpHost:      setting.SmtpHost,
+		SmtpPort:      setting.SmtpPort,
+		SmtpUsername:  setting.SmtpUsername,
+		SmtpPassword:  setting.SmtpPassword,
+		UseTls:        setting.UseTls,
+		FromEmail:     setting.FromEmail,
+		FromName:      setting.FromName,
+		InboundDomain: setting.InboundDomain,
+		TlsMode:       storepb.InstanceEmailSetting_TlsMode(setting.TlsMode),
+	}
+}
+
+func convertInstanceBackupSettingFromStore(setting *storepb.InstanceBackupSetting) *v1pb.InstanceSetting_BackupSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_BackupSetting{
+		Enabled:        setting.Enabled,
+		Schedule:       setting.Schedule,
+		Destination:    v1pb.InstanceSetting_BackupSetting_Destination(setting.Destination),
+		LocalPath:      setting.LocalPath,
+		S3Prefix:       setting.S3Prefix,
+		RetentionCount: setting.RetentionCount,
+	}
+}
+
+func convertInstanceBackupSettingToStore(setting *v1pb.InstanceSetting_BackupSetting) *storepb.InstanceBackupSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceBackupSetting{
+		Enabled:        setting.Enabled,
+		Schedule:       setting.Schedule,
+		Destination:    storepb.InstanceBackupSetting_Destination(setting.Destination),
+		LocalPath:      setting.LocalPath,
+		S3Prefix:       setting.S3Prefix,
+		RetentionCount: setting.RetentionCount,
+	}
+}
+
+func convertInstanceSlackSettingFromStore(setting *storepb.InstanceSlackSetting) *v1pb.InstanceSetting_SlackSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_SlackSetting{
+		SigningSecret: setting.SigningSecret,
+		BotToken:      setting.BotToken,
+	}
+}
+
+func convertInstanceSlackSettingToStore(setting *v1pb.InstanceSetting_SlackSetting) *storepb.InstanceSlackSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceSlackSetting{
+		SigningSecret: strings.TrimSpace(setting.SigningSecret),
+		BotToken:      strings.TrimSpace(setting.BotToken),
+	}
+}
+
+func convertInstanceMatrixSettingFromStore(setting *storepb.InstanceMatrixSetting) *v1pb.InstanceSetting_MatrixSetting {
+	if setting == nil {
+		return nil
+	}
+	matrixSetting := &v1pb.InstanceSetting_MatrixSetting{
+		HomeserverUrl: setting.HomeserverUrl,
+		AccessToken:   setting.AccessToken,
+	}
+	for _, room := range setting.Rooms {
+		matrixSetting.Rooms = append(matrixSetting.Rooms, &v1pb.InstanceSetting_MatrixSetting_Room{
+			RoomId:          room.RoomId,
+			Enabled:         room.Enabled,
+			PostPublicMemos: room.PostPublicMemos,
+		})
+	}
+	return matrixSetting
+}
+
+func convertInstanceMatrixSettingToStore(setting *v1pb.InstanceSetting_MatrixSetting) *storepb.InstanceMatrixSetting {
+	if setting == nil {
+		return nil
+	}
+	matrixSetting := &storepb.InstanceMatrixSetting{
+		HomeserverUrl: strings.TrimSuffix(strings.TrimSpace(setting.HomeserverUrl), "/"),
+		AccessToken:   strings.TrimSpace(setting.AccessToken),
+	}
+	for _, room := range setting.Rooms {
+		roomID := strings.TrimSpace(room.RoomId)
+		if roomID == "" {
+			continue
+		}
+		matrixSetting.Rooms = append(matrixSetting.Rooms, &storepb.InstanceMatrixSetting_Room{
+			RoomId:          roomID,
+			Enabled:         room.Enabled,
+			PostPublicMemos: room.PostPublicMemos,
+		})
+	}
+	return matrixSetting
+}
+
 var ownerCache *v1pb.User
 
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
@@ -292,3 +674,13 @@ func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error)
 	ownerCache = convertUserFromStore(user)
 	return ownerCache, nil
 }
+
+// getInstanceTitle returns the instance title shown to users outside the app,
+// e.g. in authenticator apps and passkey prompts.
+func (s *APIV1Service) getInstanceTitle(ctx context.Context) string {
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil || instanceGeneralSetting.GetCustomProfile().GetTitle() == "" {
+		return "Memos"
+	}
+	return instanceGeneralSetting.GetCustomProfile().GetTitle()
+}
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxAnnouncementContentLength is the maximum length of the content of an announcement in bytes.
+const maxAnnouncementContentLength = 4096
+
+// DismissInstanceAnnouncement hides the announcement of the instance for the current user. The
+// dismissal holds until the content of the announcement changes, which changes its id.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Any user, if the announcement is dismissible.
+func (s *APIV1Service) DismissInstanceAnnouncement(ctx context.Context, request *v1pb.DismissInstanceAnnouncementRequest) (*emptypb.Empty, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	announcementSetting, err := s.Store.GetInstanceAnnouncementSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance announcement setting: %v", err)
+	}
+	if announcementSetting.Content == "" || request.Id != getAnnouncementID(announcementSetting) {
+		return nil, status.Errorf(codes.NotFound, "announcement not found")
+	}
+	if !announcementSetting.Dismissible {
+		return nil, status.Errorf(codes.FailedPrecondition, "the announcement can't be dismissed")
+	}
+
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_ANNOUNCEMENT_DISMISSAL,
+		Value: &storepb.UserSetting_AnnouncementDismissal{
+			AnnouncementDismissal: &storepb.AnnouncementDismissalUserSetting{AnnouncementId: request.Id},
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getActiveAnnouncement returns the announcement of the instance to show to the current user,
+// nil if there is none at the moment or the user dismissed it.
+func (s *APIV1Service) getActiveAnnouncement(ctx context.Context) (*v1pb.InstanceSetting_AnnouncementSetting, error) {
+	announcementSetting, err := s.Store.GetInstanceAnnouncementSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAnnouncementActive(announcementSetting, time.Now()) {
+		return nil, nil
+	}
+	announcement := convertInstanceAnnouncementSettingFromStore(announcementSetting)
+	if !announcementSetting.Dismissible {
+		return announcement, nil
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSetting_ANNOUNCEMENT_DISMISSAL})
+		if err != nil {
+			return nil, err
+		}
+		if userSetting.GetAnnouncementDismissal().GetAnnouncementId() == announcement.Id {
+			return nil, nil
+		}
+	}
+	return announcement, nil
+}
+
+// isAnnouncementActive returns whether an announcement is shown at now.
+func isAnnouncementActive(setting *storepb.InstanceAnnouncementSetting, now time.Time) bool {
+	if setting.Content == "" {
+		return false
+	}
+	if setting.StartTime != nil && now.Before(setting.StartTime.AsTime()) {
+		return false
+	}
+	if setting.EndTime != nil && !now.Before(setting.EndTime.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// getAnnouncementID returns the id of an announcement, which is derived from its content so that
+// the dismissals of an announcement don't hide the next one.
+func getAnnouncementID(setting *storepb.InstanceAnnouncementSetting) string {
+	sum := sha256.Sum256([]byte(setting.Content))
+	return hex.EncodeToString(sum[:8])
+}
+
+// validateAnnouncementSetting checks an announcement before it's saved.
+func validateAnnouncementSetting(setting *storepb.InstanceAnnouncementSetting) error {
+	if len(setting.Content) > maxAnnouncementContentLength {
+		return status.Errorf(codes.InvalidArgument, "announcement too long (max %d characters)", maxAnnouncementContentLength)
+	}
+	if setting.StartTime != nil && setting.EndTime != nil && !setting.EndTime.AsTime().After(setting.StartTime.AsTime()) {
+		return status.Errorf(codes.InvalidArgument, "the end time of the announcement must be after its start time")
+	}
+	return nil
+}
+
+func convertInstanceAnnouncementSettingFromStore(setting *storepb.InstanceAnnouncementSetting) *v1pb.InstanceSetting_AnnouncementSetting {
+	if setting == nil {
+		return nil
+	}
+	announcementSetting := &v1pb.InstanceSetting_AnnouncementSetting{
+		Content:     setting.Content,
+		Severity:    v1pb.InstanceSetting_AnnouncementSetting_Severity(setting.Severity),
+		StartTime:   setting.StartTime,
+		EndTime:     setting.EndTime,
+		Dismissible: setting.Dismissible,
+	}
+	if setting.Content != "" {
+		announcementSetting.Id = getAnnouncementID(setting)
+	}
+	return announcementSetting
+}
+
+func convertInstanceAnnouncementSettingToStore(setting *v1pb.InstanceSetting_AnnouncementSetting) *storepb.InstanceAnnouncementSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceAnnouncementSetting{
+		Content:     setting.Content,
+		Severity:    storepb.InstanceAnnouncementSetting_Severity(setting.Severity),
+		StartTime:   setting.StartTime,
+		EndTime:     setting.EndTime,
+		Dismissible: setting.Dismissible,
+	}
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultAuditLogPageSize is the page size of ListAuditLogs if none is requested.
+	defaultAuditLogPageSize = 50
+	// maxAuditLogPageSize caps the page size of ListAuditLogs.
+	maxAuditLogPageSize = 1000
+)
+
+// ListAuditLogs lists audit log entries of authentication and admin events, newest first.
+//
+// Entries can be filtered by actor, event type and time range. Entries older than the
+// audit log retention of the general instance setting are pruned in the background.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) ListAuditLogs(ctx context.Context, request *v1pb.ListAuditLogsRequest) (*v1pb.ListAuditLogsResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	find := &store.FindAuditLog{}
+	if request.Actor != "" {
+		actorID, err := ExtractUserIDFromName(request.Actor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid actor: %v", err)
+		}
+		find.ActorID = &actorID
+	}
+	if request.EventType != v1pb.AuditLog_EVENT_TYPE_UNSPECIFIED {
+		eventType := store.AuditEventType(request.EventType.String())
+		find.EventType = &eventType
+	}
+	if request.StartTime != nil {
+		startSec := request.StartTime.AsTime().Unix()
+		find.CreatedTsAfter = &startSec
+	}
+	if request.EndTime != nil {
+		endSec := request.EndTime.AsTime().Unix()
+		find.CreatedTsBefore = &endSec
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = defaultAuditLogPageSize
+	}
+	limit = min(limit, maxAuditLogPageSize)
+	limitPlusOne := limit + 1
+	find.Limit = &limitPlusOne
+	find.Offset = &offset
+	auditLogs, err := s.Store.ListAuditLogs(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit logs: %v", err)
+	}
+
+	response := &v1pb.ListAuditLogsResponse{
+		AuditLogs: []*v1pb.AuditLog{},
+	}
+	if len(auditLogs) == limitPlusOne {
+		auditLogs = auditLogs[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, auditLog := range auditLogs {
+		response.AuditLogs = append(response.AuditLogs, convertAuditLogFromStore(auditLog))
+	}
+	return response, nil
+}
+
+func convertAuditLogFromStore(auditLog *store.AuditLog) *v1pb.AuditLog {
+	message := &v1pb.AuditLog{
+		Name:       fmt.Sprintf("%s%d", AuditLogNamePrefix, auditLog.ID),
+		EventType:  v1pb.AuditLog_EventType(v1pb.AuditLog_EventType_value[auditLog.EventType.String()]),
+		IpAddress:  auditLog.IPAddress,
+		UserAgent:  auditLog.UserAgent,
+		CreateTime: timestamppb.New(time.Unix(auditLog.CreatedTs, 0)),
+	}
+	if auditLog.ActorID != 0 {
+		message.Actor = fmt.Sprintf("%s%d", UserNamePrefix, auditLog.ActorID)
+	}
+	payload := map[string]any{}
+	if err := json.Unmarshal([]byte(auditLog.Payload), &payload); err == nil {
+		if payloadStruct, err := structpb.NewStruct(payload); err == nil {
+			message.Payload = payloadStruct
+		}
+	}
+	return message
+}
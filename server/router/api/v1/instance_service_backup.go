@@ -0,0 +1,475 @@
+package v1
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/plugin/storage/s3"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// backupFilePrefix is the prefix of the file names of the backup archives, which are followed
+	// by the UTC time of the backup so that they sort by time.
+	backupFilePrefix = "memos-backup-"
+	// backupFileSuffix is the suffix of the file names of the backup archives.
+	backupFileSuffix = ".zip"
+	// backupAttachmentBatchSize is the number of local attachments listed at once by a backup.
+	backupAttachmentBatchSize = 500
+)
+
+// backupStatus is the status of the backups since the server started.
+type backupStatus struct {
+	mutex               sync.Mutex
+	running             bool
+	lastRunTime         time.Time
+	lastFinishTime      time.Time
+	lastError           string
+	lastBackup          string
+	lastBackupSizeBytes int64
+}
+
+// backupManifest is the manifest.json file of the backup archives.
+type backupManifest struct {
+	Version    string    `json:"version"`
+	Driver     string    `json:"driver"`
+	CreateTime time.Time `json:"createTime"`
+	Database   string    `json:"database"`
+	// Attachments is the number of local attachment files in the archive. The attachments stored
+	// in the database are in the database snapshot, and those stored in S3 aren't copied.
+	Attachments int `json:"attachments"`
+}
+
+func (s *APIV1Service) GetInstanceBackupStatus(ctx context.Context, _ *v1pb.GetInstanceBackupStatusRequest) (*v1pb.InstanceBackupStatus, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+	return s.getBackupStatus(ctx)
+}
+
+func (s *APIV1Service) RunInstanceBackup(ctx context.Context, _ *v1pb.RunInstanceBackupRequest) (*v1pb.InstanceBackupStatus, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+	if !s.startBackup() {
+		return nil, status.Errorf(codes.FailedPrecondition, "a backup is already running")
+	}
+	// The backup outlives the request.
+	go s.runBackup(context.WithoutCancel(ctx))
+	return s.getBackupStatus(ctx)
+}
+
+// RunScheduledBackup runs a backup of the instance, unless one is already running. A failed
+// backup is reported to the host in the inbox.
+func (s *APIV1Service) RunScheduledBackup(ctx context.Context) {
+	if !s.startBackup() {
+		slog.Warn("skipped scheduled backup, as a backup is already running")
+		return
+	}
+	s.runBackup(ctx)
+}
+
+func (s *APIV1Service) getBackupStatus(ctx context.Context) (*v1pb.InstanceBackupStatus, error) {
+	backupSetting, err := s.Store.GetInstanceBackupSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance backup setting: %v", err)
+	}
+
+	s.backupStatus.mutex.Lock()
+	defer s.backupStatus.mutex.Unlock()
+	response := &v1pb.InstanceBackupStatus{
+		Running:             s.backupStatus.running,
+		LastError:           s.backupStatus.lastError,
+		LastBackup:          s.backupStatus.lastBackup,
+		LastBackupSizeBytes: s.backupStatus.lastBackupSizeBytes,
+	}
+	if !s.backupStatus.lastRunTime.IsZero() {
+		response.LastRunTime = timestamppb.New(s.backupStatus.lastRunTime)
+	}
+	if !s.backupStatus.lastFinishTime.IsZero() {
+		response.LastFinishTime = timestamppb.New(s.backupStatus.lastFinishTime)
+	}
+	if backupSetting.Enabled {
+		if schedule, err := cron.ParseStandard(backupSetting.Schedule); err == nil {
+			response.NextRunTime = timestamppb.New(schedule.Next(time.Now().UTC()))
+		}
+	}
+	return response, nil
+}
+
+// startBackup marks a backup as running, and returns false if one already is.
+func (s *APIV1Service) startBackup() bool {
+	s.backupStatus.mutex.Lock()
+	defer s.backupStatus.mutex.Unlock()
+	if s.backupStatus.running {
+		return false
+	}
+	s.backupStatus.running = true
+	s.backupStatus.lastRunTime = time.Now()
+	return true
+}
+
+// runBackup runs a backup started by startBackup, and records its result.
+func (s *APIV1Service) runBackup(ctx context.Context) {
+	run := metrics.StartRunnerRun("backup")
+	defer run.End()
+
+	filename, sizeBytes, err := s.backupInstance(ctx)
+
+	s.backupStatus.mutex.Lock()
+	s.backupStatus.running = false
+	s.backupStatus.lastFinishTime = time.Now()
+	s.backupStatus.lastError = ""
+	if err != nil {
+		s.backupStatus.lastError = err.Error()
+	} else {
+		s.backupStatus.lastBackup = filename
+		s.backupStatus.lastBackupSizeBytes = sizeBytes
+	}
+	s.backupStatus.mutex.Unlock()
+
+	if err != nil {
+		run.Fail()
+		slog.Error("failed to back up instance", "error", err)
+		if err := s.notifyBackupFailure(ctx, err); err != nil {
+			slog.Error("failed to notify backup failure", "error", err)
+		}
+		return
+	}
+	slog.Info("backed up instance", "backup", filename, "size", sizeBytes)
+}
+
+// backupInstance writes a backup archive to the destination of the backup setting, and prunes
+// the backups beyond the retention count. It returns the file name and the size of the archive.
+func (s *APIV1Service) backupInstance(ctx context.Context) (string, int64, error) {
+	backupSetting, err := s.Store.GetInstanceBackupSetting(ctx)
+	if err != nil {
+		return "", 0, errors.Wrap(err, "failed to get instance backup setting")
+	}
+	filename := backupFilePrefix + time.Now().UTC().Format("20060102T150405Z") + backupFileSuffix
+
+	switch backupSetting.Destination {
+	case storepb.InstanceBackupSetting_S3:
+		s3Client, err := s.newBackupS3Client(ctx)
+		if err != nil {
+			return "", 0, err
+		}
+		archive, err := os.CreateTemp("", filename)
+		if err != nil {
+			return "", 0, errors.Wrap(err, "failed to create temporary file")
+		}
+		defer os.Remove(archive.Name())
+		defer archive.Close()
+		sizeBytes, err := s.writeBackupArchive(ctx, archive)
+		if err != nil {
+			return "", 0, err
+		}
+		if _, err := archive.Seek(0, io.SeekStart); err != nil {
+			return "", 0, errors.Wrap(err, "failed to rewind backup archive")
+		}
+		if _, err := s3Client.UploadObject(ctx, backupSetting.S3Prefix+filename, "application/zip", archive); err != nil {
+			return "", 0, errors.Wrap(err, "failed to upload backup archive")
+		}
+		if err := pruneS3Backups(ctx, s3Client, backupSetting.S3Prefix, int(backupSetting.RetentionCount)); err != nil {
+			return "", 0, err
+		}
+		return filename, sizeBytes, nil
+	default:
+		dir := s.backupLocalDir(backupSetting)
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return "", 0, errors.Wrap(err, "failed to create backup directory")
+		}
+		// The archive is written under a temporary name, so that incomplete archives are never
+		// mistaken for backups.
+		partialPath := filepath.Join(dir, filename+".partial")
+		archive, err := os.OpenFile(partialPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return "", 0, errors.Wrap(err, "failed to create backup archive")
+		}
+		sizeBytes, err := s.writeBackupArchive(ctx, archive)
+		if closeErr := archive.Close(); err == nil && closeErr != nil {
+			err = errors.Wrap(closeErr, "failed to close backup archive")
+		}
+		if err != nil {
+			os.Remove(partialPath)
+			return "", 0, err
+		}
+		if err := os.Rename(partialPath, filepath.Join(dir, filename)); err != nil {
+			os.Remove(partialPath)
+			return "", 0, errors.Wrap(err, "failed to rename backup archive")
+		}
+		if err := pruneLocalBackups(dir, int(backupSetting.RetentionCount)); err != nil {
+			return "", 0, err
+		}
+		return filename, sizeBytes, nil
+	}
+}
+
+// backupLocalDir returns the directory of the local backups.
+func (s *APIV1Service) backupLocalDir(backupSetting *storepb.InstanceBackupSetting) string {
+	dir := filepath.FromSlash(backupSetting.LocalPath)
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(s.Profile.Data, dir)
+	}
+	return dir
+}
+
+func (s *APIV1Service) newBackupS3Client(ctx context.Context) (*s3.Client, error) {
+	storageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance storage setting")
+	}
+	if storageSetting.S3Config == nil || storageSetting.S3Config.Bucket == "" {
+		return nil, errors.New("no S3 bucket is configured in the storage setting")
+	}
+	s3Client, err := s3.NewClient(ctx, storageSetting.S3Config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create s3 client")
+	}
+	return s3Client, nil
+}
+
+// writeBackupArchive writes a zip archive of a snapshot of the database, the files of the local
+// attachments and a manifest. It returns the size of the archive.
+func (s *APIV1Service) writeBackupArchive(ctx context.Context, w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	archive := zip.NewWriter(counter)
+	manifest := &backupManifest{
+		Version:    s.Profile.Version,
+		Driver:     s.Profile.Driver,
+		CreateTime: time.Now().UTC(),
+		Database:   s.Store.DatabaseBackupFilename(),
+	}
+
+	// The database snapshot is already compact, and large, so it's stored without compression.
+	database, err := archive.CreateHeader(&zip.FileHeader{Name: manifest.Database, Method: zip.Store, Modified: manifest.CreateTime})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create database entry")
+	}
+	if err := s.Store.BackupDatabase(ctx, database); err != nil {
+		return 0, errors.Wrap(err, "failed to back up database")
+	}
+
+	attachments, err := s.writeBackupAttachments(ctx, archive)
+	if err != nil {
+		return 0, err
+	}
+	manifest.Attachments = attachments
+
+	manifestFile, err := archive.CreateHeader(&zip.FileHeader{Name: "manifest.json", Method: zip.Deflate, Modified: manifest.CreateTime})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create manifest entry")
+	}
+	if err := json.NewEncoder(manifestFile).Encode(manifest); err != nil {
+		return 0, errors.Wrap(err, "failed to write manifest")
+	}
+	if err := archive.Close(); err != nil {
+		return 0, errors.Wrap(err, "failed to close backup archive")
+	}
+	return counter.n, nil
+}
+
+// writeBackupAttachments writes the files of the local attachments under attachments/, at their
+// path in the data directory. The files shared by deduplicated attachments are written once, and
+// the files missing from the disk are skipped. It returns the number of files written.
+func (s *APIV1Service) writeBackupAttachments(ctx context.Context, archive *zip.Writer) (int, error) {
+	storageType := storepb.AttachmentStorageType_LOCAL
+	limit := backupAttachmentBatchSize
+	find := &store.FindAttachment{StorageType: &storageType, Limit: &limit}
+	written := map[string]bool{}
+	for offset := 0; ; offset += limit {
+		find.Offset = &offset
+		attachments, err := s.Store.ListAttachments(ctx, find)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to list attachments")
+		}
+		for _, attachment := range attachments {
+			if written[attachment.Reference] {
+				continue
+			}
+			written[attachment.Reference] = true
+			if err := s.writeBackupAttachment(archive, attachment); err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					slog.Warn("skipped backup of missing attachment file", "attachment", attachment.UID, "reference", attachment.Reference)
+					delete(written, attachment.Reference)
+					continue
+				}
+				return 0, err
+			}
+		}
+		if len(attachments) < limit {
+			return len(written), nil
+		}
+	}
+}
+
+func (s *APIV1Service) writeBackupAttachment(archive *zip.Writer, attachment *store.Attachment) error {
+	attachmentPath := filepath.FromSlash(attachment.Reference)
+	if !filepath.IsAbs(attachmentPath) {
+		attachmentPath = filepath.Join(s.Profile.Data, attachmentPath)
+	}
+	file, err := os.Open(attachmentPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat attachment %s", attachment.UID)
+	}
+
+	name := path.Join("attachments", strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(attachment.Reference)), "/"))
+	entry, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: info.ModTime()})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create attachment entry %s", name)
+	}
+	if _, err := io.Copy(entry, file); err != nil {
+		return errors.Wrapf(err, "failed to write attachment %s", attachment.UID)
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written to a writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// expiredBackups returns the backups beyond the retention count, from the names of the files, the
+// oldest first.
+func expiredBackups(names []string, retentionCount int) []string {
+	backups := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(name, backupFilePrefix) && strings.HasSuffix(name, backupFileSuffix) {
+			backups = append(backups, name)
+		}
+	}
+	slices.Sort(backups)
+	if len(backups) <= retentionCount {
+		return nil
+	}
+	return backups[:len(backups)-retentionCount]
+}
+
+func pruneLocalBackups(dir string, retentionCount int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to list backups")
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			names = append(names, entry.Name())
+		}
+	}
+	for _, name := range expiredBackups(names, retentionCount) {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return errors.Wrapf(err, "failed to prune backup %s", name)
+		}
+		slog.Info("pruned backup", "backup", name)
+	}
+	return nil
+}
+
+func pruneS3Backups(ctx context.Context, s3Client *s3.Client, prefix string, retentionCount int) error {
+	keys, err := s3Client.ListObjects(ctx, prefix+backupFilePrefix)
+	if err != nil {
+		return errors.Wrap(err, "failed to list backups")
+	}
+	names := []string{}
+	for _, key := range keys {
+		// The backups in the "folders" below the prefix aren't pruned.
+		if name := strings.TrimPrefix(key, prefix); !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	for _, name := range expiredBackups(names, retentionCount) {
+		if err := s3Client.DeleteObject(ctx, prefix+name); err != nil {
+			return errors.Wrapf(err, "failed to prune backup %s", name)
+		}
+		slog.Info("pruned backup", "backup", name)
+	}
+	return nil
+}
+
+// notifyBackupFailure notifies the hosts of a failed backup in their inbox.
+func (s *APIV1Service) notifyBackupFailure(ctx context.Context, backupErr error) error {
+	hostRole := store.RoleHost
+	hosts, err := s.Store.ListUsers(ctx, &store.FindUser{Role: &hostRole})
+	if err != nil {
+		return errors.Wrap(err, "failed to list hosts")
+	}
+	for _, host := range hosts {
+		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: host.ID,
+			Type:      store.ActivityTypeBackupFailed,
+			Level:     store.ActivityLevelError,
+			Payload: &storepb.ActivityPayload{
+				BackupFailed: &storepb.ActivityBackupFailedPayload{Error: backupErr.Error()},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		inbox := &store.Inbox{
+			SenderID:   host.ID,
+			ReceiverID: host.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_BACKUP_FAILED,
+				ActivityId: &activity.ID,
+			},
+		}
+		if _, err := s.Store.CreateInbox(ctx, inbox); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+	}
+	return nil
+}
+
+// validateBackupSetting returns an error if the backup setting can't be run.
+func (s *APIV1Service) validateBackupSetting(ctx context.Context, backupSetting *storepb.InstanceBackupSetting) error {
+	if backupSetting.Schedule != "" {
+		if _, err := cron.ParseStandard(backupSetting.Schedule); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid backup schedule: %v", err)
+		}
+	}
+	if backupSetting.RetentionCount < 0 {
+		return status.Errorf(codes.InvalidArgument, "backup retention count must not be negative")
+	}
+	if backupSetting.Destination == storepb.InstanceBackupSetting_S3 {
+		storageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+		}
+		if storageSetting.S3Config == nil || storageSetting.S3Config.Bucket == "" {
+			return status.Errorf(codes.InvalidArgument, "S3 backups require the S3 config of the storage setting")
+		}
+	}
+	return nil
+}
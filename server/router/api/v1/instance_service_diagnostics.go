@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// GetInstanceDiagnostics returns the database driver, schema version and connection pool
+// statistics of the instance.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Admin only.
+func (s *APIV1Service) GetInstanceDiagnostics(ctx context.Context, _ *v1pb.GetInstanceDiagnosticsRequest) (*v1pb.InstanceDiagnostics, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	instanceBasicSetting, err := s.Store.GetInstanceBasicSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance basic setting: %v", err)
+	}
+	stats := s.Store.GetDBStats()
+	return &v1pb.InstanceDiagnostics{
+		Driver:        s.Profile.Driver,
+		SchemaVersion: instanceBasicSetting.SchemaVersion,
+		DatabaseStats: &v1pb.InstanceDiagnostics_DatabaseStats{
+			MaxOpenConnections: int32(stats.MaxOpenConnections),
+			OpenConnections:    int32(stats.OpenConnections),
+			InUse:              int32(stats.InUse),
+			Idle:               int32(stats.Idle),
+			WaitCount:          stats.WaitCount,
+			WaitDuration:       durationpb.New(stats.WaitDuration),
+			MaxIdleClosed:      stats.MaxIdleClosed,
+			MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+			MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+		},
+	}, nil
+}
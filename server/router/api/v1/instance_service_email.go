@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/mail"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// SendTestEmail sends an email with the email setting of the instance to the recipient, or to the
+// host, so that the host can check the SMTP settings. Unlike the other emails, it's sent before
+// responding, and the SMTP errors are returned.
+func (s *APIV1Service) SendTestEmail(ctx context.Context, request *v1pb.SendTestEmailRequest) (*emptypb.Empty, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	recipient := request.Recipient
+	if recipient == "" {
+		recipient = currentUser.Email
+	}
+	if recipient == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "recipient is required, as the host has no email")
+	}
+	if _, err := mail.ParseAddress(recipient); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient %q", recipient)
+	}
+
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance email setting: %v", err)
+	}
+	if !email.IsConfigured(emailSetting) {
+		return nil, status.Errorf(codes.FailedPrecondition, "email is not configured")
+	}
+
+	title := s.getInstanceTitle(ctx)
+	body := fmt.Sprintf("This is a test email from %s. The email settings work.", title)
+	message := &email.Message{
+		To:       recipient,
+		Subject:  fmt.Sprintf("Test email from %s", title),
+		Body:     body + "\n",
+		HTMLBody: "<p>" + html.EscapeString(body) + "</p>",
+	}
+	if err := s.EmailSender.Send(ctx, emailSetting, message); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to send test email: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// GetInstanceMigrationStatus returns the schema version of the database, the history of the
+// applied migrations and the pending migrations.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Admin only.
+func (s *APIV1Service) GetInstanceMigrationStatus(ctx context.Context, _ *v1pb.GetInstanceMigrationStatusRequest) (*v1pb.InstanceMigrationStatus, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	migrationStatus, err := s.Store.GetMigrationStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get migration status: %v", err)
+	}
+	response := &v1pb.InstanceMigrationStatus{
+		SchemaVersion:       migrationStatus.SchemaVersion,
+		TargetSchemaVersion: migrationStatus.TargetSchemaVersion,
+		AppliedMigrations:   []*v1pb.InstanceMigrationStatus_AppliedMigration{},
+		PendingCount:        int32(len(migrationStatus.Pending)),
+		PendingMigrations:   migrationStatus.Pending,
+		UpToDate:            len(migrationStatus.Pending) == 0,
+	}
+	for _, schemaMigration := range migrationStatus.Applied {
+		response.AppliedMigrations = append(response.AppliedMigrations, &v1pb.InstanceMigrationStatus_AppliedMigration{
+			File:      schemaMigration.File,
+			Version:   schemaMigration.Version,
+			Checksum:  schemaMigration.Checksum,
+			Duration:  durationpb.New(time.Duration(schemaMigration.DurationMs) * time.Millisecond),
+			ApplyTime: timestamppb.New(time.Unix(schemaMigration.AppliedTs, 0)),
+			Modified:  slices.Contains(migrationStatus.Modified, schemaMigration.File),
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// ListSigningKeys lists the keys that sign and verify JWTs, newest first.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) ListSigningKeys(ctx context.Context, _ *v1pb.ListSigningKeysRequest) (*v1pb.ListSigningKeysResponse, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+
+	signingKeys, err := auth.NewAuthenticator(s.Store, s.Secret).ListSigningKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list signing keys: %v", err)
+	}
+	response := &v1pb.ListSigningKeysResponse{
+		SigningKeys: []*v1pb.SigningKey{},
+	}
+	for _, signingKey := range signingKeys {
+		response.SigningKeys = append(response.SigningKeys, convertSigningKeyFromStore(signingKey))
+	}
+	return response, nil
+}
+
+// RotateSigningKey replaces the key that signs new tokens.
+//
+// Tokens signed with the previous key, including sessions and access tokens, stay valid for
+// the requested grace period, or until the key is expired with ExpireSigningKey.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) RotateSigningKey(ctx context.Context, request *v1pb.RotateSigningKeyRequest) (*v1pb.SigningKey, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+
+	var gracePeriod time.Duration
+	if request.GracePeriod != nil {
+		if err := request.GracePeriod.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid grace period: %v", err)
+		}
+		gracePeriod = request.GracePeriod.AsDuration()
+		if gracePeriod < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "grace period must not be negative")
+		}
+	}
+
+	signingKey, err := auth.NewAuthenticator(s.Store, s.Secret).RotateSigningKey(ctx, gracePeriod)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate signing key: %v", err)
+	}
+	return convertSigningKeyFromStore(signingKey), nil
+}
+
+// ExpireSigningKey expires a retired signing key, so tokens signed with it are rejected
+// immediately. The current key has to be rotated first.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) ExpireSigningKey(ctx context.Context, request *v1pb.ExpireSigningKeyRequest) (*v1pb.SigningKey, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := ExtractSigningKeyIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid signing key name: %v", err)
+	}
+	if err := auth.NewAuthenticator(s.Store, s.Secret).ExpireSigningKey(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrSigningKeyNotFound):
+			return nil, status.Errorf(codes.NotFound, "signing key not found")
+		case errors.Is(err, auth.ErrSigningKeyCurrent):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to expire signing key: %v", err)
+		}
+	}
+
+	signingKey, err := s.Store.GetSigningKey(ctx, &store.FindSigningKey{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get signing key: %v", err)
+	}
+	return convertSigningKeyFromStore(signingKey), nil
+}
+
+// checkHost returns an error unless the current user is the host.
+func (s *APIV1Service) checkHost(ctx context.Context) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.Role != store.RoleHost {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func convertSigningKeyFromStore(signingKey *store.SigningKey) *v1pb.SigningKey {
+	message := &v1pb.SigningKey{
+		Name:  SigningKeyNamePrefix + signingKey.ID,
+		State: v1pb.SigningKey_CURRENT,
+	}
+	if signingKey.CreatedTs != 0 {
+		message.CreateTime = timestamppb.New(time.Unix(signingKey.CreatedTs, 0))
+	}
+	if signingKey.RetiredTs != 0 {
+		message.State = v1pb.SigningKey_RETIRED
+		message.RetireTime = timestamppb.New(time.Unix(signingKey.RetiredTs, 0))
+	}
+	if signingKey.ExpiresTs != 0 {
+		message.ExpireTime = timestamppb.New(time.Unix(signingKey.ExpiresTs, 0))
+		if signingKey.ExpiresTs <= time.Now().Unix() {
+			message.State = v1pb.SigningKey_EXPIRED
+		}
+	}
+	return message
+}
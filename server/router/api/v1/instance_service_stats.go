@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// instanceStartTime is the time the server started, which the uptime of the stats is counted from.
+var instanceStartTime = time.Now()
+
+// GetInstanceStats returns the stats of the instance, computed by aggregate queries and cached by
+// the store for a few minutes, along with the version and the uptime of the server.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) GetInstanceStats(ctx context.Context, _ *v1pb.GetInstanceStatsRequest) (*v1pb.InstanceStats, error) {
+	if err := s.checkHost(ctx); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.Store.GetInstanceStats(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance stats: %v", err)
+	}
+	instanceWebhooks, err := s.Store.GetInstanceWebhooks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance webhooks: %v", err)
+	}
+
+	response := &v1pb.InstanceStats{
+		Version:              s.Profile.Version,
+		Uptime:               durationpb.New(time.Since(instanceStartTime).Truncate(time.Second)),
+		ComputeTime:          timestamppb.New(time.Unix(stats.ComputedTs, 0)),
+		UserCount:            stats.UserCount,
+		ActiveUserCount:      stats.ActiveUserCount,
+		UserWebhookCount:     stats.UserWebhookCount,
+		InstanceWebhookCount: int32(len(instanceWebhooks)),
+	}
+	for _, memoCount := range stats.MemoCounts {
+		response.MemoCounts = append(response.MemoCounts, &v1pb.InstanceStats_MemoCount{
+			Visibility: convertVisibilityFromStore(memoCount.Visibility),
+			State:      convertStateFromStore(memoCount.RowStatus),
+			Trashed:    memoCount.Trashed,
+			Count:      memoCount.Count,
+		})
+	}
+	for _, dayCount := range stats.DayCounts {
+		response.DailyMemoCounts = append(response.DailyMemoCounts, &v1pb.InstanceStats_DailyMemoCount{
+			Date:  time.Unix(dayCount.Day*86400, 0).UTC().Format(time.DateOnly),
+			Count: dayCount.Count,
+		})
+	}
+	for _, usage := range stats.StorageUsages {
+		storageType := usage.StorageType
+		if storageType == "" {
+			storageType = "DATABASE"
+		}
+		response.StorageUsages = append(response.StorageUsages, &v1pb.InstanceStats_StorageUsage{
+			StorageType:     storageType,
+			AttachmentCount: usage.AttachmentCount,
+			SizeBytes:       usage.SizeBytes,
+		})
+	}
+	if databaseSizeBytes := stats.DatabaseSizeBytes; databaseSizeBytes >= 0 {
+		response.DatabaseSizeBytes = &databaseSizeBytes
+	}
+	return response, nil
+}
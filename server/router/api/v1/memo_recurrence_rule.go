@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	memoRecurrenceFrequencyDaily  = "DAILY"
+	memoRecurrenceFrequencyWeekly = "WEEKLY"
+
+	maxMemoRecurrenceInterval = 365
+)
+
+var memoRecurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// memoRecurrenceRule is a recurrence rule with its time of day and time zone. It occurs on the days
+// matching FREQ, INTERVAL and BYDAY, counted from the day of its start.
+type memoRecurrenceRule struct {
+	frequency string
+	interval  int
+	// byDay is the set of weekdays of the occurrences, or empty for every day of a daily rule.
+	byDay  []time.Weekday
+	hour   int
+	minute int
+	// start is the midnight of the first day of the recurrence in the time zone of the rule.
+	start time.Time
+}
+
+// parseMemoRecurrenceRule parses an RRULE with FREQ, INTERVAL and BYDAY and a time of day formatted
+// as 15:04, in the given time zone, starting on the day of startSec.
+func parseMemoRecurrenceRule(rrule, timeOfDay string, location *time.Location, startSec int64) (*memoRecurrenceRule, error) {
+	rule := &memoRecurrenceRule{interval: 1}
+	startTime := time.Unix(startSec, 0).In(location)
+	rule.start = time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, location)
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, errors.Errorf("invalid rule part %q", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.frequency = strings.ToUpper(value)
+			if rule.frequency != memoRecurrenceFrequencyDaily && rule.frequency != memoRecurrenceFrequencyWeekly {
+				return nil, errors.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 || interval > maxMemoRecurrenceInterval {
+				return nil, errors.Errorf("interval must be between 1 and %d", maxMemoRecurrenceInterval)
+			}
+			rule.interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := memoRecurrenceWeekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, errors.Errorf("invalid weekday %q", day)
+				}
+				if !slices.Contains(rule.byDay, weekday) {
+					rule.byDay = append(rule.byDay, weekday)
+				}
+			}
+		default:
+			return nil, errors.Errorf("unsupported rule part %q", key)
+		}
+	}
+	if rule.frequency == "" {
+		return nil, errors.New("frequency is required")
+	}
+	if rule.frequency == memoRecurrenceFrequencyWeekly && len(rule.byDay) == 0 {
+		rule.byDay = []time.Weekday{rule.start.Weekday()}
+	}
+	slices.SortFunc(rule.byDay, func(a, b time.Weekday) int {
+		return mondayFirstWeekday(a) - mondayFirstWeekday(b)
+	})
+
+	clock, err := time.Parse("15:04", timeOfDay)
+	if err != nil {
+		return nil, errors.Errorf("invalid time of day %q", timeOfDay)
+	}
+	rule.hour, rule.minute = clock.Hour(), clock.Minute()
+	return rule, nil
+}
+
+// String returns the canonical RRULE of the rule.
+func (r *memoRecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.frequency}
+	if r.interval != 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.interval))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, 0, len(r.byDay))
+		for _, weekday := range r.byDay {
+			for day, dayWeekday := range memoRecurrenceWeekdays {
+				if dayWeekday == weekday {
+					days = append(days, day)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// next returns the first occurrence strictly after the given time. Occurrences keep their time of
+// day across DST changes; one in a skipped hour is moved forward by the length of the gap.
+func (r *memoRecurrenceRule) next(after time.Time) time.Time {
+	after = after.In(r.start.Location())
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, r.start.Location())
+	if day.Before(r.start) {
+		day = r.start
+	}
+	// Every rule occurs at least once in any span of interval weeks.
+	for i := 0; i <= 7*r.interval+1; i++ {
+		if r.occursOn(day) {
+			occurrence := time.Date(day.Year(), day.Month(), day.Day(), r.hour, r.minute, 0, 0, day.Location())
+			if occurrence.After(after) {
+				return occurrence
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// nextN returns the first n occurrences strictly after the given time.
+func (r *memoRecurrenceRule) nextN(after time.Time, n int) []time.Time {
+	occurrences := make([]time.Time, 0, n)
+	for len(occurrences) < n {
+		after = r.next(after)
+		if after.IsZero() {
+			break
+		}
+		occurrences = append(occurrences, after)
+	}
+	return occurrences
+}
+
+// occursOn reports whether the rule occurs on the day starting at the given midnight.
+func (r *memoRecurrenceRule) occursOn(day time.Time) bool {
+	if len(r.byDay) > 0 && !slices.Contains(r.byDay, day.Weekday()) {
+		return false
+	}
+	days := calendarDaysBetween(r.start, day)
+	if r.frequency == memoRecurrenceFrequencyDaily {
+		return days%r.interval == 0
+	}
+	// Weeks start on Monday, as with the default WKST of RRULE.
+	weeks := (days + mondayFirstWeekday(r.start.Weekday())) / 7
+	return weeks%r.interval == 0
+}
+
+// calendarDaysBetween returns the number of calendar days from one date to a later one, ignoring
+// the length of the days in their time zone.
+func calendarDaysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// mondayFirstWeekday returns the index of a weekday in a week starting on Monday.
+func mondayFirstWeekday(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemoRecurrenceRule(t *testing.T) {
+	// Wednesday, 2024-01-10.
+	startSec := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC).Unix()
+
+	tests := []struct {
+		rrule     string
+		timeOfDay string
+		expected  string
+	}{
+		{rrule: "FREQ=DAILY", timeOfDay: "08:00", expected: "FREQ=DAILY"},
+		{rrule: "RRULE:freq=weekly;interval=2;byday=th,mo,mo", timeOfDay: "23:59", expected: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"},
+		{rrule: "FREQ=WEEKLY;INTERVAL=1", timeOfDay: "08:00", expected: "FREQ=WEEKLY;BYDAY=WE"},
+		{rrule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", timeOfDay: "00:00", expected: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR"},
+	}
+	for _, test := range tests {
+		rule, err := parseMemoRecurrenceRule(test.rrule, test.timeOfDay, time.UTC, startSec)
+		require.NoError(t, err, test.rrule)
+		require.Equal(t, test.expected, rule.String())
+	}
+
+	for _, invalid := range []struct {
+		rrule     string
+		timeOfDay string
+	}{
+		{rrule: "", timeOfDay: "08:00"},
+		{rrule: "FREQ=MONTHLY", timeOfDay: "08:00"},
+		{rrule: "FREQ=DAILY;INTERVAL=0", timeOfDay: "08:00"},
+		{rrule: "FREQ=DAILY;INTERVAL=366", timeOfDay: "08:00"},
+		{rrule: "FREQ=WEEKLY;BYDAY=XX", timeOfDay: "08:00"},
+		{rrule: "FREQ=WEEKLY;COUNT=3", timeOfDay: "08:00"},
+		{rrule: "FREQ", timeOfDay: "08:00"},
+		{rrule: "FREQ=DAILY", timeOfDay: "8am"},
+		{rrule: "FREQ=DAILY", timeOfDay: "24:00"},
+	} {
+		_, err := parseMemoRecurrenceRule(invalid.rrule, invalid.timeOfDay, time.UTC, startSec)
+		require.Error(t, err, invalid.rrule)
+	}
+}
+
+func TestMemoRecurrenceRuleNext(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	// Wednesday, 2024-01-10 in Paris.
+	startSec := time.Date(2024, 1, 10, 12, 0, 0, 0, paris).Unix()
+
+	tests := []struct {
+		name      string
+		rrule     string
+		timeOfDay string
+		after     time.Time
+		expected  []time.Time
+	}{
+		{
+			name:      "every Monday",
+			rrule:     "FREQ=WEEKLY;BYDAY=MO",
+			timeOfDay: "08:00",
+			after:     time.Unix(startSec, 0),
+			expected: []time.Time{
+				time.Date(2024, 1, 15, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 22, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 29, 8, 0, 0, 0, paris),
+			},
+		},
+		{
+			name:      "every other week from the week of the start",
+			rrule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			timeOfDay: "08:00",
+			after:     time.Unix(startSec, 0),
+			expected: []time.Time{
+				time.Date(2024, 1, 11, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 22, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 25, 8, 0, 0, 0, paris),
+			},
+		},
+		{
+			name:      "later the same day",
+			rrule:     "FREQ=DAILY",
+			timeOfDay: "18:30",
+			after:     time.Unix(startSec, 0),
+			expected: []time.Time{
+				time.Date(2024, 1, 10, 18, 30, 0, 0, paris),
+				time.Date(2024, 1, 11, 18, 30, 0, 0, paris),
+				time.Date(2024, 1, 12, 18, 30, 0, 0, paris),
+			},
+		},
+		{
+			name:      "every third day",
+			rrule:     "FREQ=DAILY;INTERVAL=3",
+			timeOfDay: "08:00",
+			after:     time.Date(2024, 1, 12, 0, 0, 0, 0, paris),
+			expected: []time.Time{
+				time.Date(2024, 1, 13, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 16, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 19, 8, 0, 0, 0, paris),
+			},
+		},
+		{
+			name:      "weekdays",
+			rrule:     "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			timeOfDay: "08:00",
+			after:     time.Date(2024, 1, 12, 9, 0, 0, 0, paris),
+			expected: []time.Time{
+				time.Date(2024, 1, 15, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 16, 8, 0, 0, 0, paris),
+				time.Date(2024, 1, 17, 8, 0, 0, 0, paris),
+			},
+		},
+		{
+			name:      "the wall clock time is kept across DST",
+			rrule:     "FREQ=DAILY",
+			timeOfDay: "08:00",
+			after:     time.Date(2024, 3, 30, 9, 0, 0, 0, paris),
+			expected: []time.Time{
+				time.Date(2024, 3, 31, 8, 0, 0, 0, paris),
+				time.Date(2024, 4, 1, 8, 0, 0, 0, paris),
+				time.Date(2024, 4, 2, 8, 0, 0, 0, paris),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := parseMemoRecurrenceRule(test.rrule, test.timeOfDay, paris, startSec)
+			require.NoError(t, err)
+			occurrences := rule.nextN(test.after, len(test.expected))
+			require.Len(t, occurrences, len(test.expected))
+			for i, expected := range test.expected {
+				require.True(t, expected.Equal(occurrences[i]), "expected %s, got %s", expected, occurrences[i])
+			}
+		})
+	}
+
+	t.Run("a rule that never occurs", func(t *testing.T) {
+		// Every seventh day from a Wednesday is always a Wednesday.
+		rule, err := parseMemoRecurrenceRule("FREQ=DAILY;INTERVAL=7;BYDAY=MO", "08:00", paris, startSec)
+		require.NoError(t, err)
+		require.True(t, rule.next(time.Unix(startSec, 0)).IsZero())
+	})
+}
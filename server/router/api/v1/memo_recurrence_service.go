@@ -0,0 +1,473 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// maxMemoRecurrencesPerUser is the maximum number of memo recurrences of a user.
+const maxMemoRecurrencesPerUser = 50
+
+// memoRecurrencePreviewCount is the number of next occurrences returned with a memo recurrence.
+const memoRecurrencePreviewCount = 3
+
+// Helper function to extract user ID and memo recurrence ID from memo recurrence resource name.
+// Format: users/{user}/memoRecurrences/{memo_recurrence}.
+func extractUserAndMemoRecurrenceIDFromName(name string) (int32, int32, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "memoRecurrences" {
+		return 0, 0, errors.Errorf("invalid memo recurrence name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", parts[1])
+	}
+	memoRecurrenceID, err := util.ConvertStringToInt32(parts[3])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo recurrence ID %q", parts[3])
+	}
+
+	return userID, memoRecurrenceID, nil
+}
+
+// Helper function to construct memo recurrence resource name.
+func constructMemoRecurrenceName(userID, memoRecurrenceID int32) string {
+	return fmt.Sprintf("users/%d/memoRecurrences/%d", userID, memoRecurrenceID)
+}
+
+func (s *APIV1Service) ListMemoRecurrences(ctx context.Context, request *v1pb.ListMemoRecurrencesRequest) (*v1pb.ListMemoRecurrencesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	memoRecurrences, err := s.Store.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo recurrences: %v", err)
+	}
+	response := &v1pb.ListMemoRecurrencesResponse{
+		MemoRecurrences: make([]*v1pb.MemoRecurrence, 0, len(memoRecurrences)),
+	}
+	for _, memoRecurrence := range memoRecurrences {
+		memoRecurrenceMessage, err := s.convertMemoRecurrenceFromStore(ctx, memoRecurrence)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert memo recurrence: %v", err)
+		}
+		response.MemoRecurrences = append(response.MemoRecurrences, memoRecurrenceMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRecurrence(ctx context.Context, request *v1pb.GetMemoRecurrenceRequest) (*v1pb.MemoRecurrence, error) {
+	memoRecurrence, err := s.getMemoRecurrenceByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	memoRecurrenceMessage, err := s.convertMemoRecurrenceFromStore(ctx, memoRecurrence)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memo recurrence: %v", err)
+	}
+	return memoRecurrenceMessage, nil
+}
+
+func (s *APIV1Service) CreateMemoRecurrence(ctx context.Context, request *v1pb.CreateMemoRecurrenceRequest) (*v1pb.MemoRecurrence, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.MemoRecurrence == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo recurrence is required")
+	}
+
+	memoRecurrences, err := s.Store.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo recurrences: %v", err)
+	}
+	if len(memoRecurrences) >= maxMemoRecurrencesPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "a user can have at most %d memo recurrences", maxMemoRecurrencesPerUser)
+	}
+
+	timezone, err := s.getMemoRecurrenceTimezone(ctx, userID, request.MemoRecurrence.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	payload := &storepb.MemoRecurrencePayload{
+		Rrule:     request.MemoRecurrence.Rrule,
+		TimeOfDay: request.MemoRecurrence.TimeOfDay,
+		Timezone:  timezone,
+		Tags:      normalizeMemoTemplateTags(request.MemoRecurrence.Tags),
+	}
+	if err := s.setMemoRecurrenceSource(ctx, payload, userID, request.MemoRecurrence); err != nil {
+		return nil, err
+	}
+	nowSec := time.Now().Unix()
+	memoRecurrence := &store.MemoRecurrence{
+		CreatorID: userID,
+		CreatedTs: nowSec,
+		UpdatedTs: nowSec,
+		Payload:   payload,
+	}
+	if err := scheduleMemoRecurrence(memoRecurrence, nowSec); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recurrence rule: %v", err)
+	}
+
+	memoRecurrence, err = s.Store.CreateMemoRecurrence(ctx, memoRecurrence)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo recurrence: %v", err)
+	}
+	return s.GetMemoRecurrence(ctx, &v1pb.GetMemoRecurrenceRequest{Name: constructMemoRecurrenceName(userID, memoRecurrence.ID)})
+}
+
+func (s *APIV1Service) UpdateMemoRecurrence(ctx context.Context, request *v1pb.UpdateMemoRecurrenceRequest) (*v1pb.MemoRecurrence, error) {
+	if request.MemoRecurrence == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo recurrence is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	memoRecurrence, err := s.getMemoRecurrenceByName(ctx, request.MemoRecurrence.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := memoRecurrence.Payload
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "rrule":
+			payload.Rrule = request.MemoRecurrence.Rrule
+		case "time_of_day":
+			payload.TimeOfDay = request.MemoRecurrence.TimeOfDay
+		case "timezone":
+			payload.Timezone, err = s.getMemoRecurrenceTimezone(ctx, memoRecurrence.CreatorID, request.MemoRecurrence.Timezone)
+			if err != nil {
+				return nil, err
+			}
+		case "memo_template", "memo":
+			if err := s.setMemoRecurrenceSource(ctx, payload, memoRecurrence.CreatorID, request.MemoRecurrence); err != nil {
+				return nil, err
+			}
+		case "tags":
+			payload.Tags = normalizeMemoTemplateTags(request.MemoRecurrence.Tags)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	nowSec := time.Now().Unix()
+	if err := scheduleMemoRecurrence(memoRecurrence, nowSec); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recurrence rule: %v", err)
+	}
+	err = s.Store.UpdateMemoRecurrence(ctx, &store.UpdateMemoRecurrence{
+		ID:        memoRecurrence.ID,
+		UpdatedTs: &nowSec,
+		NextTs:    &memoRecurrence.NextTs,
+		Payload:   payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo recurrence: %v", err)
+	}
+	return s.GetMemoRecurrence(ctx, &v1pb.GetMemoRecurrenceRequest{Name: request.MemoRecurrence.Name})
+}
+
+func (s *APIV1Service) DeleteMemoRecurrence(ctx context.Context, request *v1pb.DeleteMemoRecurrenceRequest) (*emptypb.Empty, error) {
+	memoRecurrence, err := s.getMemoRecurrenceByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteMemoRecurrence(ctx, &store.DeleteMemoRecurrence{ID: memoRecurrence.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo recurrence: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) PauseMemoRecurrence(ctx context.Context, request *v1pb.PauseMemoRecurrenceRequest) (*v1pb.MemoRecurrence, error) {
+	memoRecurrence, err := s.getMemoRecurrenceByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	paused, nowSec := true, time.Now().Unix()
+	err = s.Store.UpdateMemoRecurrence(ctx, &store.UpdateMemoRecurrence{
+		ID:        memoRecurrence.ID,
+		UpdatedTs: &nowSec,
+		Paused:    &paused,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo recurrence: %v", err)
+	}
+	return s.GetMemoRecurrence(ctx, &v1pb.GetMemoRecurrenceRequest{Name: request.Name})
+}
+
+func (s *APIV1Service) ResumeMemoRecurrence(ctx context.Context, request *v1pb.ResumeMemoRecurrenceRequest) (*v1pb.MemoRecurrence, error) {
+	memoRecurrence, err := s.getMemoRecurrenceByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	paused, nowSec := false, time.Now().Unix()
+	if err := scheduleMemoRecurrence(memoRecurrence, nowSec); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid recurrence rule: %v", err)
+	}
+	err = s.Store.UpdateMemoRecurrence(ctx, &store.UpdateMemoRecurrence{
+		ID:        memoRecurrence.ID,
+		UpdatedTs: &nowSec,
+		Paused:    &paused,
+		NextTs:    &memoRecurrence.NextTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo recurrence: %v", err)
+	}
+	return s.GetMemoRecurrence(ctx, &v1pb.GetMemoRecurrenceRequest{Name: request.Name})
+}
+
+// CreateRecurringMemo creates the memo of the due occurrence of a memo recurrence, as its creator.
+// The occurrences missed while the server was down create a single memo, for the latest of them.
+// The recurrence is moved to its next occurrence first, so that an occurrence never creates two
+// memos even if the server stops in between. It does nothing if the recurrence has been paused,
+// rescheduled or advanced since it was listed.
+func (s *APIV1Service) CreateRecurringMemo(ctx context.Context, memoRecurrence *store.MemoRecurrence) error {
+	rule, err := parseMemoRecurrencePayload(memoRecurrence)
+	if err != nil {
+		return errors.Wrap(err, "invalid recurrence rule")
+	}
+	now := time.Now()
+	occurrence := time.Unix(memoRecurrence.NextTs, 0)
+	for next := rule.next(occurrence); !next.IsZero() && !next.After(now); next = rule.next(occurrence) {
+		occurrence = next
+	}
+	advanced, err := s.Store.AdvanceMemoRecurrence(ctx, &store.AdvanceMemoRecurrence{
+		ID:               memoRecurrence.ID,
+		NextTs:           memoRecurrence.NextTs,
+		NewNextTs:        rule.next(now).Unix(),
+		LastOccurrenceTs: occurrence.Unix(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to advance memo recurrence")
+	}
+	if !advanced {
+		return nil
+	}
+
+	// The memo is created as the creator of the recurrence, with the same permissions.
+	ctx = context.WithValue(ctx, auth.UserIDContextKey, memoRecurrence.CreatorID)
+	memo, err := s.newRecurringMemo(ctx, memoRecurrence, occurrence.In(rule.start.Location()))
+	if err != nil {
+		return err
+	}
+	if _, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: memo}); err != nil {
+		return errors.Wrap(err, "failed to create memo")
+	}
+	return nil
+}
+
+// newRecurringMemo returns the memo to create for an occurrence of a memo recurrence.
+func (s *APIV1Service) newRecurringMemo(ctx context.Context, memoRecurrence *store.MemoRecurrence, occurrence time.Time) (*v1pb.Memo, error) {
+	payload := memoRecurrence.Payload
+	if memoTemplateID := payload.GetMemoTemplateId(); memoTemplateID != "" {
+		memoTemplate, err := s.GetMemoTemplate(ctx, &v1pb.GetMemoTemplateRequest{
+			Name: constructMemoTemplateName(memoRecurrence.CreatorID, memoTemplateID),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo template")
+		}
+		generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+			UserID: &memoRecurrence.CreatorID,
+			Key:    storepb.UserSetting_GENERAL,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user general setting")
+		}
+		memoTemplate.Tags = append(slices.Clone(memoTemplate.Tags), payload.Tags...)
+		memo, err := s.newMemoFromTemplate(memoTemplate, generalSetting.GetGeneral(), occurrence)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to extract tags")
+		}
+		return memo, nil
+	}
+
+	memoID := payload.GetMemoId()
+	sourceMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID, IncludeScheduled: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source memo")
+	}
+	if sourceMemo == nil || sourceMemo.CreatorID != memoRecurrence.CreatorID {
+		return nil, errors.Errorf("source memo %d not found", memoID)
+	}
+	content, err := s.appendMissingMemoTags(sourceMemo.Content, payload.Tags)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract tags")
+	}
+	return &v1pb.Memo{
+		Content:    content,
+		Visibility: convertVisibilityFromStore(sourceMemo.Visibility),
+	}, nil
+}
+
+// getMemoRecurrenceByName returns the memo recurrence with the given name if the current user is
+// its creator.
+func (s *APIV1Service) getMemoRecurrenceByName(ctx context.Context, name string) (*store.MemoRecurrence, error) {
+	userID, memoRecurrenceID, err := extractUserAndMemoRecurrenceIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo recurrence name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	memoRecurrence, err := s.Store.GetMemoRecurrence(ctx, &store.FindMemoRecurrence{
+		ID:        &memoRecurrenceID,
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo recurrence: %v", err)
+	}
+	if memoRecurrence == nil {
+		return nil, status.Errorf(codes.NotFound, "memo recurrence not found")
+	}
+	return memoRecurrence, nil
+}
+
+// setMemoRecurrenceSource sets the source of a memo recurrence to a memo template or a memo of the
+// user.
+func (s *APIV1Service) setMemoRecurrenceSource(ctx context.Context, payload *storepb.MemoRecurrencePayload, userID int32, memoRecurrence *v1pb.MemoRecurrence) error {
+	switch source := memoRecurrence.Source.(type) {
+	case *v1pb.MemoRecurrence_MemoTemplate:
+		memoTemplate, err := s.GetMemoTemplate(ctx, &v1pb.GetMemoTemplateRequest{Name: source.MemoTemplate})
+		if err != nil {
+			return err
+		}
+		_, memoTemplateID, err := extractUserAndMemoTemplateIDFromName(memoTemplate.Name)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+		}
+		payload.Source = &storepb.MemoRecurrencePayload_MemoTemplateId{MemoTemplateId: memoTemplateID}
+	case *v1pb.MemoRecurrence_Memo:
+		memoUID, err := ExtractMemoUIDFromName(source.Memo)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return status.Errorf(codes.NotFound, "memo not found")
+		}
+		if memo.CreatorID != userID {
+			return status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		payload.Source = &storepb.MemoRecurrencePayload_MemoId{MemoId: memo.ID}
+	default:
+		return status.Errorf(codes.InvalidArgument, "a memo template or a memo is required")
+	}
+	return nil
+}
+
+// getMemoRecurrenceTimezone returns the time zone of a memo recurrence, which defaults to the time
+// zone of the user.
+func (s *APIV1Service) getMemoRecurrenceTimezone(ctx context.Context, userID int32, timezone string) (string, error) {
+	if timezone != "" {
+		return timezone, nil
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	return getUserGeneralSettingLocation(generalSetting.GetGeneral()).String(), nil
+}
+
+// scheduleMemoRecurrence validates the rule of a memo recurrence, normalizes it, and moves the
+// recurrence to its first occurrence after nowSec.
+func scheduleMemoRecurrence(memoRecurrence *store.MemoRecurrence, nowSec int64) error {
+	rule, err := parseMemoRecurrencePayload(memoRecurrence)
+	if err != nil {
+		return err
+	}
+	next := rule.next(time.Unix(nowSec, 0))
+	if next.IsZero() {
+		return errors.New("the rule has no occurrence")
+	}
+	memoRecurrence.Payload.Rrule = rule.String()
+	memoRecurrence.NextTs = next.Unix()
+	return nil
+}
+
+func parseMemoRecurrencePayload(memoRecurrence *store.MemoRecurrence) (*memoRecurrenceRule, error) {
+	location, err := time.LoadLocation(memoRecurrence.Payload.Timezone)
+	if err != nil {
+		return nil, errors.Errorf("invalid time zone %q", memoRecurrence.Payload.Timezone)
+	}
+	return parseMemoRecurrenceRule(memoRecurrence.Payload.Rrule, memoRecurrence.Payload.TimeOfDay, location, memoRecurrence.CreatedTs)
+}
+
+func (s *APIV1Service) convertMemoRecurrenceFromStore(ctx context.Context, memoRecurrence *store.MemoRecurrence) (*v1pb.MemoRecurrence, error) {
+	payload := memoRecurrence.Payload
+	memoRecurrenceMessage := &v1pb.MemoRecurrence{
+		Name:       constructMemoRecurrenceName(memoRecurrence.CreatorID, memoRecurrence.ID),
+		Rrule:      payload.Rrule,
+		TimeOfDay:  payload.TimeOfDay,
+		Timezone:   payload.Timezone,
+		Tags:       payload.Tags,
+		Paused:     memoRecurrence.Paused,
+		CreateTime: timestamppb.New(time.Unix(memoRecurrence.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(memoRecurrence.UpdatedTs, 0)),
+	}
+	if memoRecurrence.LastOccurrenceTs != 0 {
+		memoRecurrenceMessage.LastOccurrenceTime = timestamppb.New(time.Unix(memoRecurrence.LastOccurrenceTs, 0))
+	}
+
+	switch source := payload.Source.(type) {
+	case *storepb.MemoRecurrencePayload_MemoTemplateId:
+		memoRecurrenceMessage.Source = &v1pb.MemoRecurrence_MemoTemplate{
+			MemoTemplate: constructMemoTemplateName(memoRecurrence.CreatorID, source.MemoTemplateId),
+		}
+	case *storepb.MemoRecurrencePayload_MemoId:
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &source.MemoId, IncludeScheduled: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get source memo")
+		}
+		// The source memo may have been deleted since.
+		if memo != nil {
+			memoRecurrenceMessage.Source = &v1pb.MemoRecurrence_Memo{Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)}
+		}
+	default:
+	}
+
+	if !memoRecurrence.Paused {
+		rule, err := parseMemoRecurrencePayload(memoRecurrence)
+		if err != nil {
+			return nil, err
+		}
+		// The next occurrence may be overdue until the scheduler creates its memo.
+		after := time.Unix(memoRecurrence.NextTs-1, 0)
+		for _, occurrence := range rule.nextN(after, memoRecurrencePreviewCount) {
+			memoRecurrenceMessage.NextOccurrenceTimes = append(memoRecurrenceMessage.NextOccurrenceTimes, timestamppb.New(occurrence))
+		}
+	}
+	return memoRecurrenceMessage, nil
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -25,7 +27,7 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
@@ -70,11 +72,16 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
 	}
+	// Keep the references from memo links in the content.
+	if err := s.syncMemoLinkRelations(ctx, memo, ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo link relations: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.ListMemoRelationsRequest) (*v1pb.ListMemoRelationsResponse, error) {
+// ListMemoBacklinks lists the memos referencing a memo that are visible to the current user.
+func (s *APIV1Service) ListMemoBacklinks(ctx context.Context, request *v1pb.ListMemoBacklinksRequest) (*v1pb.ListMemoBacklinksResponse, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
@@ -83,6 +90,210 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if memo.Visibility != store.Public && currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if memo.Visibility == store.Private && memo.CreatorID != currentUser.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	var memoFilter string
+	if currentUser == nil {
+		memoFilter = `visibility == "PUBLIC" && publish_ts == 0 && row_status != "DRAFT"`
+	} else {
+		memoFilter = fmt.Sprintf(`creator_id == %d || (visibility in ["PUBLIC", "PROTECTED"] && publish_ts == 0 && row_status != "DRAFT")`, currentUser.ID)
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+	limitPlusOne := limit + 1
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelationsByRelatedMemo(ctx, memo.ID, &store.FindMemoRelation{
+		Type:       &referenceType,
+		MemoFilter: &memoFilter,
+		Limit:      &limitPlusOne,
+		Offset:     &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo backlinks: %v", err)
+	}
+
+	response := &v1pb.ListMemoBacklinksResponse{
+		Memos: []*v1pb.MemoRelation_Memo{},
+	}
+	if len(relations) == limitPlusOne {
+		relations = relations[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, relation := range relations {
+		backlink, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo")
+		}
+		if backlink == nil {
+			continue
+		}
+		snippet, err := s.getMemoContentSnippet(backlink.Content)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo content snippet")
+		}
+		response.Memos = append(response.Memos, &v1pb.MemoRelation_Memo{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, backlink.UID),
+			Snippet: snippet,
+		})
+	}
+	return response, nil
+}
+
+// syncMemoLinkRelations keeps the reference relations of a memo in line with the memo links and
+// wiki links in its content: links to other memos add references, and links removed since
+// oldContent remove theirs. References set explicitly without a link are kept. The wiki links are
+// resolved and saved in the payload of the memo.
+func (s *APIV1Service) syncMemoLinkRelations(ctx context.Context, memo *store.Memo, oldContent string) error {
+	data, err := s.MarkdownService.ExtractAll([]byte(memo.Content))
+	if err != nil {
+		return errors.Wrap(err, "failed to extract memo links")
+	}
+	removedLinks := []string{}
+	if oldContent != "" {
+		oldData, err := s.MarkdownService.ExtractAll([]byte(oldContent))
+		if err != nil {
+			return errors.Wrap(err, "failed to extract memo links")
+		}
+		for _, uid := range oldData.MemoLinks {
+			if !slices.Contains(data.MemoLinks, uid) {
+				removedLinks = append(removedLinks, uid)
+			}
+		}
+	}
+	oldWikiLinks := memo.Payload.GetWikiLinks()
+	if len(data.MemoLinks) == 0 && len(removedLinks) == 0 && len(data.WikiLinks) == 0 && len(oldWikiLinks) == 0 {
+		return nil
+	}
+
+	wikiLinks, err := s.resolveMemoWikiLinks(ctx, memo, data.WikiLinks)
+	if err != nil {
+		return err
+	}
+	if !slices.EqualFunc(wikiLinks, oldWikiLinks, func(a, b *storepb.MemoPayload_WikiLink) bool {
+		return a.Target == b.Target && a.MemoId == b.MemoId
+	}) {
+		if memo.Payload == nil {
+			memo.Payload = &storepb.MemoPayload{}
+		}
+		memo.Payload.WikiLinks = wikiLinks
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Payload: memo.Payload}); err != nil {
+			return errors.Wrap(err, "failed to update memo payload")
+		}
+	}
+
+	linkedMemoIDs := []int32{}
+	for _, uid := range data.MemoLinks {
+		if uid == memo.UID {
+			continue
+		}
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		if err != nil {
+			return errors.Wrap(err, "failed to get linked memo")
+		}
+		if relatedMemo != nil && !slices.Contains(linkedMemoIDs, relatedMemo.ID) {
+			linkedMemoIDs = append(linkedMemoIDs, relatedMemo.ID)
+		}
+	}
+	for _, wikiLink := range wikiLinks {
+		if wikiLink.MemoId != 0 && wikiLink.MemoId != memo.ID && !slices.Contains(linkedMemoIDs, wikiLink.MemoId) {
+			linkedMemoIDs = append(linkedMemoIDs, wikiLink.MemoId)
+		}
+	}
+	unlinkedMemoIDs := []int32{}
+	for _, uid := range removedLinks {
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, IncludeTrashed: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get linked memo")
+		}
+		if relatedMemo != nil {
+			unlinkedMemoIDs = append(unlinkedMemoIDs, relatedMemo.ID)
+		}
+	}
+	for _, wikiLink := range oldWikiLinks {
+		if wikiLink.MemoId != 0 {
+			unlinkedMemoIDs = append(unlinkedMemoIDs, wikiLink.MemoId)
+		}
+	}
+
+	referenceType := store.MemoRelationReference
+	for _, relatedMemoID := range unlinkedMemoIDs {
+		if slices.Contains(linkedMemoIDs, relatedMemoID) {
+			continue
+		}
+		err = s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &relatedMemoID,
+			Type:          &referenceType,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID, Type: &referenceType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, relatedMemoID := range linkedMemoIDs {
+		if slices.ContainsFunc(relations, func(relation *store.MemoRelation) bool {
+			return relation.RelatedMemoID == relatedMemoID
+		}) {
+			continue
+		}
+		_, err = s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemoID,
+			Type:          store.MemoRelationReference,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert memo relation")
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.ListMemoRelationsRequest) (*v1pb.ListMemoRelationsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	// Memos in the trash are converted with their relations too, which are always empty.
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeTrashed: true, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
 
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -90,14 +301,15 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	}
 	var memoFilter string
 	if currentUser == nil {
-		memoFilter = `visibility == "PUBLIC"`
+		memoFilter = `visibility == "PUBLIC" && publish_ts == 0 && row_status != "DRAFT"`
 	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+		memoFilter = fmt.Sprintf(`creator_id == %d || (visibility in ["PUBLIC", "PROTECTED"] && publish_ts == 0 && row_status != "DRAFT")`, currentUser.ID)
 	}
 	relationList := []*v1pb.MemoRelation{}
 	tempList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		MemoID:     &memo.ID,
-		MemoFilter: &memoFilter,
+		MemoID:                   &memo.ID,
+		MemoFilter:               &memoFilter,
+		ExcludeSuspendedCreators: excludeSuspendedCreators(currentUser),
 	})
 	if err != nil {
 		return nil, err
@@ -110,8 +322,9 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 		relationList = append(relationList, relation)
 	}
 	tempList, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &memo.ID,
-		MemoFilter:    &memoFilter,
+		RelatedMemoID:            &memo.ID,
+		MemoFilter:               &memoFilter,
+		ExcludeSuspendedCreators: excludeSuspendedCreators(currentUser),
 	})
 	if err != nil {
 		return nil, err
@@ -131,7 +344,7 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 }
 
 func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRelation *store.MemoRelation) (*v1pb.MemoRelation, error) {
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.MemoID})
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.MemoID, IncludeScheduled: true})
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +352,7 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo content snippet")
 	}
-	relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.RelatedMemoID})
+	relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.RelatedMemoID, IncludeScheduled: true})
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +379,8 @@ func convertMemoRelationTypeFromStore(relationType store.MemoRelationType) v1pb.
 		return v1pb.MemoRelation_REFERENCE
 	case store.MemoRelationComment:
 		return v1pb.MemoRelation_COMMENT
+	case store.MemoRelationMerge:
+		return v1pb.MemoRelation_MERGE
 	default:
 		return v1pb.MemoRelation_TYPE_UNSPECIFIED
 	}
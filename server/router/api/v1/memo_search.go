@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"html"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// searchSnippetContextLength is the number of characters of context around the first match
+// in a search snippet.
+const searchSnippetContextLength = 48
+
+// parseMemoSearchQuery parses the search query of ListMemos, whose dates are in the time zone of
+// the current user, or UTC for visitors.
+func (s *APIV1Service) parseMemoSearchQuery(ctx context.Context, query string, currentUser *store.User) (*store.MemoSearchQuery, error) {
+	location := time.UTC
+	if currentUser != nil && strings.TrimSpace(query) != "" {
+		generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+			UserID: &currentUser.ID,
+			Key:    storepb.UserSetting_GENERAL,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+		}
+		location = getUserGeneralSettingLocation(generalSetting.GetGeneral())
+	}
+	searchQuery, err := store.ParseMemoSearchQuery(query, location)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid search: %v", err)
+	}
+	return searchQuery, nil
+}
+
+// buildSearchSnippet returns the part of the content around the first match of the search
+// terms, HTML-escaped, with each match wrapped in <mark> tags. Matching is case-insensitive.
+func buildSearchSnippet(content string, terms []string) string {
+	text := []rune(strings.Join(strings.Fields(content), " "))
+	lower := make([]rune, len(text))
+	for i, r := range text {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// Find the matches of every term, as [start, end) rune ranges.
+	matches := [][2]int{}
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(lower); i++ {
+			if slices.Equal(lower[i:i+len(needle)], needle) {
+				matches = append(matches, [2]int{i, i + len(needle)})
+				i += len(needle) - 1
+			}
+		}
+	}
+	slices.SortFunc(matches, func(a, b [2]int) int { return a[0] - b[0] })
+
+	start := 0
+	if len(matches) > 0 {
+		start = max(0, matches[0][0]-searchSnippetContextLength)
+	}
+	end := min(len(text), start+searchSnippetContextLength*3)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("...")
+	}
+	pos := start
+	for _, match := range matches {
+		// Skip matches overlapping the previous one or outside the snippet.
+		if match[0] < pos || match[1] > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(text[pos:match[0]])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(text[match[0]:match[1]])))
+		b.WriteString("</mark>")
+		pos = match[1]
+	}
+	b.WriteString(html.EscapeString(string(text[pos:end])))
+	if end < len(text) {
+		b.WriteString("...")
+	}
+	return b.String()
+}
@@ -2,8 +2,11 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,6 +15,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/plugin/webhook"
@@ -22,6 +26,11 @@ import (
 )
 
 func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	return s.createMemoWithBookmark(ctx, request, nil)
+}
+
+// createMemoWithBookmark creates a memo, with the bookmark of the link it saves if it's a bookmark memo.
+func (s *APIV1Service) createMemoWithBookmark(ctx context.Context, request *v1pb.CreateMemoRequest, bookmark *storepb.MemoPayload_Bookmark) (*v1pb.Memo, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -45,6 +54,10 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		Content:    request.Memo.Content,
 		Visibility: convertVisibilityToStore(request.Memo.Visibility),
 	}
+	// Memos are created as drafts on request, any other state is ignored.
+	if request.Memo.State == v1pb.State_DRAFT {
+		create.RowStatus = store.Draft
+	}
 	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
@@ -52,6 +65,9 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if instanceMemoRelatedSetting.DisallowPublicVisibility && create.Visibility == store.Public {
 		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
+	if err := s.checkMemoQuota(ctx, user, create.Visibility); err != nil {
+		return nil, err
+	}
 	contentLengthLimit, err := s.getContentLengthLimit(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
@@ -63,7 +79,31 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 	}
 	if request.Memo.Location != nil {
-		create.Payload.Location = convertLocationToStore(request.Memo.Location)
+		location, err := convertLocationToStore(request.Memo.Location)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid location: %v", err)
+		}
+		create.Payload.Location = location
+	}
+	create.Payload.DisableComments = request.Memo.DisableComments
+	create.Payload.Bookmark = bookmark
+	if request.Memo.ExpireTime != nil {
+		expireTs, err := convertMemoExpireTimeToStore(request.Memo.ExpireTime, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+		create.ExpireTs = expireTs
+	}
+	// A publish time that has already passed publishes the memo right away.
+	if publishTime := request.Memo.PublishTime; publishTime != nil && publishTime.AsTime().After(time.Now()) {
+		create.PublishTs = publishTime.AsTime().Unix()
+	}
+	if request.Memo.Reminder != nil {
+		reminderTs, reminderRepeat, err := convertMemoReminderToStore(request.Memo.Reminder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reminder: %v", err)
+		}
+		create.ReminderTs, create.ReminderRepeat = reminderTs, reminderRepeat
 	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
@@ -77,6 +117,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		}
 		return nil, err
 	}
+	RecordMemoQuotaUsage(ctx, s.Store, user.ID, memo.Visibility)
 
 	attachments := []*store.Attachment{}
 
@@ -105,6 +146,11 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to set memo relations")
 		}
+	} else if err := s.syncMemoLinkRelations(ctx, memo, ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo link relations: %v", err)
+	}
+	if err := s.notifyMemoMentions(ctx, memo, ""); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
 	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachments)
@@ -124,13 +170,20 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		// Exclude comments by default.
 		ExcludeComments: true,
 	}
-	if request.State == v1pb.State_ARCHIVED {
-		state := store.Archived
+	if request.ShowDeleted {
+		// The trash lists memos in any state, scheduled or not.
+		memoFind.Trashed = true
+		memoFind.IncludeScheduled = true
+	} else if request.State == v1pb.State_ARCHIVED || request.State == v1pb.State_DRAFT {
+		state := convertStateToStore(request.State)
 		memoFind.RowStatus = &state
 	} else {
 		state := store.Normal
 		memoFind.RowStatus = &state
 	}
+	if request.ShowScheduled {
+		memoFind.Scheduled = true
+	}
 
 	// Parse order_by field (replaces the old sort and direction fields)
 	if request.OrderBy != "" {
@@ -153,7 +206,31 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	if currentUser == nil {
+	searchQuery, err := s.parseMemoSearchQuery(ctx, request.Search, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	searchTerms := searchQuery.Terms
+	if len(searchTerms) > 0 {
+		query := strings.Join(searchTerms, " ")
+		memoFind.SearchQuery = &query
+	}
+	memoFind.Filters = append(memoFind.Filters, searchQuery.Filters...)
+	if searchQuery.Archived && !request.ShowDeleted {
+		state := store.Archived
+		memoFind.RowStatus = &state
+	}
+	if currentUser != nil {
+		memoFind.FilterViewerID = currentUser.ID
+	}
+	memoFind.ExcludeSuspendedCreators = excludeSuspendedCreators(currentUser)
+	if request.ShowDeleted || request.ShowScheduled || request.State == v1pb.State_DRAFT {
+		// Only the creator sees their memos in the trash, their scheduled memos and their drafts.
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		memoFind.CreatorID = &currentUser.ID
+	} else if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else {
 		if memoFind.CreatorID == nil {
@@ -180,6 +257,12 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		if cursor := pageToken.MemoCursor; cursor != nil {
+			if cursor.Order != memoOrderKey(memoFind) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid page token: the ordering of the memos changed")
+			}
+			memoFind.Cursor = &store.MemoCursor{Pinned: cursor.Pinned, PinOrder: cursor.PinOrder, Ts: cursor.Ts, ID: cursor.Id}
+		}
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -188,7 +271,10 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	}
 	limitPlusOne := limit + 1
 	memoFind.Limit = &limitPlusOne
-	memoFind.Offset = &offset
+	if memoFind.Cursor == nil {
+		// Page tokens without a cursor are offsets, as issued by previous versions and for search results.
+		memoFind.Offset = &offset
+	}
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
@@ -198,7 +284,12 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		if memoFind.SearchQuery != nil {
+			// Search results are ranked by relevance, which has no stable cursor.
+			nextPageToken, err = getPageToken(limit, offset+limit)
+		} else {
+			nextPageToken, err = getMemoCursorPageToken(limit, memos[limit-1], memoFind)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -241,22 +332,30 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
 	}
 
+	attachmentMatchedMemos := []string{}
 	for _, memo := range memos {
 		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
 		reactions := reactionMap[memoName]
 		attachments := attachmentMap[memo.ID]
+		if memo.SearchMatchedAttachment {
+			attachmentMatchedMemos = append(attachmentMatchedMemos, memoName)
+		}
 
 		memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to convert memo")
 		}
+		if len(searchTerms) > 0 {
+			memoMessage.SearchSnippet = buildSearchSnippet(memo.Content, searchTerms)
+		}
 
 		memoMessages = append(memoMessages, memoMessage)
 	}
 
 	response := &v1pb.ListMemosResponse{
-		Memos:         memoMessages,
-		NextPageToken: nextPageToken,
+		Memos:                  memoMessages,
+		NextPageToken:          nextPageToken,
+		AttachmentMatchedMemos: attachmentMatchedMemos,
 	}
 	return response, nil
 }
@@ -266,30 +365,54 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-		UID: &memoUID,
-	})
+	find := &store.FindMemo{
+		UID:              &memoUID,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
+	}
+	memo, err := s.Store.GetMemo(ctx, find)
 	if err != nil {
 		return nil, err
 	}
+	if memo == nil {
+		// The memo may be named by its slug instead.
+		find.UID, find.Slug = nil, &memoUID
+		memo, err = s.Store.GetMemo(ctx, find)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if memo.Visibility != store.Public {
-		user, err := s.GetCurrentUser(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user")
+	viewer, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	hidden, err := s.isMemoCreatorHidden(ctx, memo.CreatorID, viewer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo creator")
+	}
+	if hidden {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.DeletedTs != 0 || memo.PublishTs != 0 || memo.RowStatus == store.Draft {
+		// Memos in the trash, scheduled memos and drafts are only visible to their creator.
+		if viewer == nil || memo.CreatorID != viewer.ID {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
 		}
-		if user == nil {
+	} else if memo.Visibility != store.Public {
+		if viewer == nil {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
+		if memo.Visibility == store.Private && memo.CreatorID != viewer.ID {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
 
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
-		ContentID: &request.Name,
+		ContentID: &memoName,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list reactions")
@@ -318,7 +441,7 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
 	}
 
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
 	if err != nil {
 		return nil, err
 	}
@@ -333,11 +456,27 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	// Only the creator or admin can update the memo.
-	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := checkMemoUpdatePermission(memo, user); err != nil {
+		return nil, err
 	}
 
+	// Edits of the content or the attachments are recorded as revisions.
+	recordRevision := slices.Contains(request.UpdateMask.Paths, "content") || slices.Contains(request.UpdateMask.Paths, "attachments")
+	if recordRevision {
+		if err := s.recordMemoRevisionBaseline(ctx, memo); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+		}
+	}
+
+	oldContent := memo.Content
+	// The users mentioned by a memo are notified once others can see it.
+	wasVisible := memo.Visibility != store.Private && memo.RowStatus != store.Draft && memo.PublishTs == 0
+	scheduledPublishTs := memo.PublishTs
+	publishNow := false
+	publishDraft := false
+	var pinned *bool
+	madePublic := false
+	var slug *string
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
@@ -365,11 +504,27 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
 				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 			}
+			// Making a memo public counts against the public memos per day of its creator.
+			if visibility == store.Public && memo.Visibility != store.Public && memo.CreatorID == user.ID {
+				if err := s.checkPublicMemoQuota(ctx, user); err != nil {
+					return nil, err
+				}
+				madePublic = true
+			}
 			update.Visibility = &visibility
 		} else if path == "pinned" {
-			update.Pinned = &request.Memo.Pinned
+			pinned = &request.Memo.Pinned
 		} else if path == "state" {
 			rowStatus := convertStateToStore(request.Memo.State)
+			if memo.RowStatus == store.Draft {
+				// A draft is published by setting its state to NORMAL.
+				if rowStatus != store.Normal {
+					return nil, status.Errorf(codes.FailedPrecondition, "a draft must be published before it is archived")
+				}
+				publishDraft = true
+			} else if rowStatus == store.Draft {
+				return nil, status.Errorf(codes.InvalidArgument, "a published memo can't become a draft")
+			}
 			update.RowStatus = &rowStatus
 		} else if path == "create_time" {
 			createdTs := request.Memo.CreateTime.AsTime().Unix()
@@ -391,10 +546,62 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			} else {
 				update.CreatedTs = &displayTs
 			}
+		} else if path == "publish_time" {
+			if scheduledPublishTs == 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "memo is already published")
+			}
+			// Clearing the publish time, or setting one that has already passed, publishes the memo now.
+			if publishTime := request.Memo.PublishTime; publishTime != nil && publishTime.AsTime().After(time.Now()) {
+				publishTsSec := publishTime.AsTime().Unix()
+				update.PublishTs = &publishTsSec
+			} else {
+				publishNow = true
+			}
+		} else if path == "reminder" {
+			// Reminders notify the creator of the memo, so only they can set them.
+			if memo.CreatorID != user.ID {
+				return nil, status.Errorf(codes.PermissionDenied, "only the creator can set the reminder of a memo")
+			}
+			reminderTs, reminderRepeat, err := convertMemoReminderToStore(request.Memo.Reminder)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid reminder: %v", err)
+			}
+			var reminderDeliveredTs int64
+			update.ReminderTs, update.ReminderRepeat, update.ReminderDeliveredTs = &reminderTs, &reminderRepeat, &reminderDeliveredTs
 		} else if path == "location" {
+			location, err := convertLocationToStore(request.Memo.Location)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid location: %v", err)
+			}
+			payload := memo.Payload
+			payload.Location = location
+			update.Payload = payload
+		} else if path == "disable_comments" {
+			if memo.CreatorID != user.ID {
+				return nil, status.Errorf(codes.PermissionDenied, "only the creator can disable the comments of a memo")
+			}
 			payload := memo.Payload
-			payload.Location = convertLocationToStore(request.Memo.Location)
+			payload.DisableComments = request.Memo.DisableComments
 			update.Payload = payload
+		} else if path == "expire_time" {
+			var parent *store.Memo
+			if memo.ParentUID != nil {
+				parent, err = s.Store.GetMemo(ctx, &store.FindMemo{UID: memo.ParentUID, IncludeScheduled: true})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get parent memo")
+				}
+			}
+			expireTs, err := convertMemoExpireTimeToStore(request.Memo.ExpireTime, parent)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+			}
+			update.ExpireTs = &expireTs
+		} else if path == "slug" {
+			// Slugs are the short URLs of the creator of the memo, so only they can set them.
+			if memo.CreatorID != user.ID {
+				return nil, status.Errorf(codes.PermissionDenied, "only the creator can set the slug of a memo")
+			}
+			slug = &request.Memo.Slug
 		} else if path == "attachments" {
 			_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 				Name:        request.Memo.Name,
@@ -414,16 +621,61 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		}
 	}
 
+	if slug != nil {
+		if err := s.setMemoSlug(ctx, memo, *slug); err != nil {
+			return nil, err
+		}
+	}
+	if publishDraft {
+		// A published draft is created and displayed at the time it is published.
+		nowSec := time.Now().Unix()
+		update.CreatedTs, update.UpdatedTs = &nowSec, &nowSec
+	}
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
+	if madePublic {
+		s.recordPublicMemoQuotaUsage(ctx, memo.CreatorID)
+	}
+	if pinned != nil {
+		// A memo that is already pinned keeps its position.
+		if err := s.Store.PinMemo(ctx, &store.PinMemo{ID: memo.ID, CreatorID: memo.CreatorID, Pinned: *pinned}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to pin memo")
+		}
+	}
+	published := false
+	if publishNow {
+		published, err = s.Store.PublishMemo(ctx, &store.PublishMemo{
+			ID:        memo.ID,
+			PublishTs: scheduledPublishTs,
+			DisplayTs: time.Now().Unix(),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to publish memo")
+		}
+	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
-		ID: &memo.ID,
+		ID:               &memo.ID,
+		IncludeScheduled: true,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	if update.Content != nil {
+		if err := s.syncMemoLinkRelations(ctx, memo, oldContent); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sync memo link relations: %v", err)
+		}
+	}
+	if update.Content != nil || !wasVisible {
+		previousContent := oldContent
+		if !wasVisible {
+			previousContent = ""
+		}
+		if err := s.notifyMemoMentions(ctx, memo, previousContent); err != nil {
+			slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+		}
+	}
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Memo.Name,
 	})
@@ -436,13 +688,23 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list attachments")
 	}
+	if recordRevision {
+		if err := s.recordMemoRevision(ctx, memo, attachments, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+		}
+	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
-	// Try to dispatch webhook when memo is updated.
-	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+	if published || publishDraft {
+		// Try to dispatch webhook when memo is published.
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
+	} else if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		// Try to dispatch webhook when memo is updated.
 		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
 
@@ -455,7 +717,8 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
 	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-		UID: &memoUID,
+		UID:              &memoUID,
+		IncludeScheduled: true,
 	})
 	if err != nil {
 		return nil, err
@@ -490,47 +753,19 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.Internal, "failed to list attachments")
 	}
 
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
-		// Try to dispatch webhook when memo is deleted.
-		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
-			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
-		}
-	}
-
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
-	}
-
-	// Delete memo relation
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
+	deletedTsSec := time.Now().Unix()
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, DeletedTs: &deletedTsSec}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move memo to the trash")
 	}
+	memo.DeletedTs = deletedTsSec
 
-	// Delete related attachments.
-	for _, attachment := range attachments {
-		if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete attachment")
-		}
-	}
-
-	// Delete memo comments
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
-	}
-	for _, relation := range relations {
-		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
+	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
+		// Try to dispatch webhook when memo is moved to the trash.
+		if err := s.DispatchMemoTrashedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo trashed webhook", slog.Any("err", err))
 		}
 	}
 
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
-	}
-
 	return &emptypb.Empty{}, nil
 }
 
@@ -543,6 +778,35 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if relatedMemo.RowStatus == store.Draft {
+		// Drafts are only visible to their creator, and are commented on once they are published.
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if user == nil || relatedMemo.CreatorID != user.ID {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "drafts can't be commented on")
+	}
+	if relatedMemo.Payload.GetDisableComments() {
+		return nil, status.Errorf(codes.FailedPrecondition, "comments are disabled for this memo")
+	}
+	// Comments are published right away, and expire at the latest with the memo they comment on.
+	if request.Comment != nil {
+		request.Comment.PublishTime = nil
+		request.Comment.State = v1pb.State_NORMAL
+		expireTs, err := convertMemoExpireTimeToStore(request.Comment.ExpireTime, relatedMemo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+		if expireTs != 0 {
+			request.Comment.ExpireTime = timestamppb.New(time.Unix(expireTs, 0))
+		}
+	}
 
 	// Create the memo comment first.
 	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
@@ -589,7 +853,7 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create activity")
 		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+		_, err = s.createNotificationInbox(ctx, &store.Inbox{
 			SenderID:   creatorID,
 			ReceiverID: relatedMemo.CreatorID,
 			Status:     store.UNREAD,
@@ -597,7 +861,8 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 				Type:       storepb.InboxMessage_MEMO_COMMENT,
 				ActivityId: &activity.ID,
 			},
-		}); err != nil {
+		})
+		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create inbox")
 		}
 	}
@@ -646,7 +911,7 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	for _, m := range memoRelations {
 		memoRelationIDs = append(memoRelationIDs, m.MemoID)
 	}
-	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoRelationIDs})
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoRelationIDs, ExcludeSuspendedCreators: excludeSuspendedCreators(currentUser)})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
@@ -693,8 +958,10 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 		memosResponse = append(memosResponse, memoMessage)
 	}
 
+	// The comments created before the comments of the memo were disabled are still counted.
 	response := &v1pb.ListMemoCommentsResponse{
-		Memos: memosResponse,
+		Memos:     memosResponse,
+		TotalSize: int32(len(memosResponse)),
 	}
 	return response, nil
 }
@@ -707,22 +974,65 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	return int(instanceMemoRelatedSetting.ContentLengthLimit), nil
 }
 
+// checkMemoUpdatePermission returns an error unless the user may update the memo: only its creator
+// and admins can, and scheduled memos are only visible to their creator.
+func checkMemoUpdatePermission(memo *store.Memo, user *store.User) error {
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if (memo.PublishTs != 0 || memo.RowStatus == store.Draft) && memo.CreatorID != user.ID {
+		return status.Errorf(codes.NotFound, "memo not found")
+	}
+	return nil
+}
+
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoCreated)
 }
 
 // DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
 func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoUpdated)
+}
+
+// DispatchMemoTrashedWebhook dispatches webhook when memo is moved to the trash.
+func (s *APIV1Service) DispatchMemoTrashedWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoTrashed)
+}
+
+// DispatchMemoRestoredWebhook dispatches webhook when memo is restored from the trash.
+func (s *APIV1Service) DispatchMemoRestoredWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoRestored)
+}
+
+// DispatchMemoReminderWebhook dispatches webhook when the reminder of a memo is due.
+func (s *APIV1Service) DispatchMemoReminderWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoReminder)
 }
 
-// DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
+// DispatchMemoExpiredWebhook dispatches webhook when memo has expired and is moved to the trash.
+func (s *APIV1Service) DispatchMemoExpiredWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoExpired)
+}
+
+// DispatchMemoDeletedWebhook dispatches webhook when memo is permanently deleted.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.EventMemoDeleted)
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
+	return s.dispatchMemoWebhook(ctx, memo, activityType, nil)
+}
+
+// dispatchMemoWebhook enqueues the deliveries of a memo event to the webhooks subscribed to it.
+// toggle is the task checked or unchecked by a memo updated event, if that's all it changed.
+func (s *APIV1Service) dispatchMemoWebhook(ctx context.Context, memo *v1pb.Memo, activityType string, toggle *webhook.TaskToggle) error {
+	// Scheduled memos and drafts are only visible to their creator, the memo created webhook fires
+	// once they are published.
+	if memo.PublishTime != nil || memo.State == v1pb.State_DRAFT {
+		return nil
+	}
 	creatorID, err := ExtractUserIDFromName(memo.Creator)
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
@@ -732,17 +1042,107 @@ func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1p
 		return err
 	}
 	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(memo)
+		if hook.Disabled || !isUserWebhookSubscribed(hook, activityType) {
+			continue
+		}
+		body, err := s.buildWebhookBody(hook, memo, activityType, toggle)
+		if err != nil {
+			return err
+		}
+
+		// The delivery is attempted asynchronously, and retried by the webhook delivery runner.
+		if _, err := s.enqueueWebhookDelivery(ctx, creatorID, hook.Id, activityType, body); err != nil {
+			return err
+		}
+	}
+
+	workspaceWebhooks, err := s.Store.GetInstanceWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, hook := range workspaceWebhooks {
+		if hook.Disabled || !isUserWebhookSubscribed(hook, activityType) {
+			continue
+		}
+		redacted := redactWebhookMemo(hook, memo)
+		hookToggle := toggle
+		if toggle != nil && redacted != memo {
+			hookToggle = &webhook.TaskToggle{Index: toggle.Index, Checked: toggle.Checked}
+		}
+		body, err := s.buildWebhookBody(hook, redacted, activityType, hookToggle)
 		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
+			return err
+		}
+		if _, err := s.enqueueWebhookDelivery(ctx, 0, hook.Id, activityType, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildWebhookBody returns the body of the request sent to a webhook for a memo event, in the
+// format of the webhook. The chat messages of task toggles only show the task.
+func (s *APIV1Service) buildWebhookBody(hook *storepb.WebhooksUserSetting_Webhook, memo *v1pb.Memo, activityType string, toggle *webhook.TaskToggle) ([]byte, error) {
+	var body []byte
+	var err error
+	format := convertWebhookFormatFromStore(hook.Format)
+	switch format {
+	case webhook.FormatSlack, webhook.FormatDiscord:
+		message := s.convertMemoToWebhookMessage(memo, activityType)
+		if toggle != nil && toggle.Text != "" {
+			message.Text = convertTaskToggleToWebhookText(toggle)
+		}
+		if format == webhook.FormatSlack {
+			body, err = webhook.MarshalSlack(message)
+		} else {
+			body, err = webhook.MarshalDiscord(message)
+		}
+	default:
+		payload, convertErr := convertMemoToWebhookPayload(memo)
+		if convertErr != nil {
+			return nil, errors.Wrap(convertErr, "failed to convert memo to webhook payload")
 		}
 		payload.ActivityType = activityType
 		payload.URL = hook.Url
+		payload.TaskToggle = toggle
+		body, err = json.Marshal(payload)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal webhook payload")
+	}
+	return body, nil
+}
 
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
+// convertMemoToWebhookMessage converts a memo event to a message for chat services. The message
+// is titled with the first line of the memo, and links back to it if the instance URL is known.
+// Only public memos have a thumbnail, as chat services fetch it without credentials.
+func (s *APIV1Service) convertMemoToWebhookMessage(memo *v1pb.Memo, activityType string) *webhook.Message {
+	title, text, _ := strings.Cut(strings.TrimSpace(memo.Content), "\n")
+	message := &webhook.Message{
+		Event: activityType,
+		Title: strings.TrimSpace(strings.TrimLeft(title, "#")),
+		Text:  strings.TrimSpace(text),
+		Time:  time.Now(),
+	}
+	instanceURL := strings.TrimSuffix(s.Profile.InstanceURL, "/")
+	if instanceURL != "" {
+		message.URL = fmt.Sprintf("%s/%s", instanceURL, memo.Name)
+	}
+	if memo.Visibility != v1pb.Visibility_PUBLIC {
+		return message
+	}
+	for _, attachment := range memo.Attachments {
+		if !strings.HasPrefix(attachment.Type, "image/") {
+			continue
+		}
+		if attachment.ExternalLink != "" {
+			message.ImageURL = attachment.ExternalLink
+		} else if instanceURL != "" {
+			message.ImageURL = fmt.Sprintf("%s/file/%s/%s", instanceURL, attachment.Name, url.PathEscape(attachment.Filename))
+		}
+		break
 	}
-	return nil
+	return message
 }
 
 func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
@@ -765,6 +1165,31 @@ func (s *APIV1Service) getMemoContentSnippet(content string) (string, error) {
 	return snippet, nil
 }
 
+// memoOrderKey identifies the ordering of memoFind, so that memo cursors are only used with
+// the ordering they were made in.
+func memoOrderKey(memoFind *store.FindMemo) string {
+	if memoFind.SearchQuery != nil {
+		return "relevance"
+	}
+	// The pinned memos are ordered by their pin order, which the cursors of previous versions lack.
+	return fmt.Sprintf("pin_order=%t,updated_ts=%t,asc=%t", memoFind.OrderByPinned, memoFind.OrderByUpdatedTs, memoFind.OrderByTimeAsc)
+}
+
+// getMemoCursorPageToken returns the page token of the memos after the last memo of a page.
+func getMemoCursorPageToken(limit int, last *store.Memo, memoFind *store.FindMemo) (string, error) {
+	cursor := store.NewMemoCursor(last, memoFind)
+	return marshalPageToken(&v1pb.PageToken{
+		Limit: int32(limit),
+		MemoCursor: &v1pb.PageToken_MemoCursor{
+			Pinned:   cursor.Pinned,
+			PinOrder: cursor.PinOrder,
+			Ts:       cursor.Ts,
+			Id:       cursor.ID,
+			Order:    memoOrderKey(memoFind),
+		},
+	})
+}
+
 // parseMemoOrderBy parses the order_by field and sets the appropriate ordering in memoFind.
 // Follows AIP-132: supports comma-separated list of fields with optional "desc" suffix.
 // Example: "pinned desc, display_time desc" or "create_time asc".
@@ -0,0 +1,241 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxAutoArchiveDays is the maximum number of days of an auto-archive policy.
+	maxAutoArchiveDays = 100 * 365
+	// maxAutoArchiveProtectedTags is the maximum number of protected tags of an auto-archive policy.
+	maxAutoArchiveProtectedTags = 100
+	// defaultAutoArchivePreviewPageSize is the default number of memos of PreviewAutoArchiveMemos.
+	defaultAutoArchivePreviewPageSize = 50
+)
+
+// PreviewAutoArchiveMemos lists the memos of the current user that the auto-archive policy would
+// archive now, so that a policy can be checked before it is enabled.
+//
+// Authentication: Required.
+func (s *APIV1Service) PreviewAutoArchiveMemos(ctx context.Context, request *v1pb.PreviewAutoArchiveMemosRequest) (*v1pb.PreviewAutoArchiveMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultAutoArchivePreviewPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	var setting *storepb.AutoArchiveUserSetting
+	if request.Days != nil {
+		setting = &storepb.AutoArchiveUserSetting{Days: *request.Days, ProtectedTags: request.ProtectedTags}
+		if err := s.validateAutoArchiveUserSetting(setting); err != nil {
+			return nil, err
+		}
+	} else {
+		userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSetting_AUTO_ARCHIVE})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+		}
+		setting = userSetting.GetAutoArchive()
+	}
+	if setting.GetDays() == 0 {
+		return &v1pb.PreviewAutoArchiveMemosResponse{Memos: []*v1pb.Memo{}}, nil
+	}
+
+	memos, err := s.listAutoArchiveMemos(ctx, user.ID, setting)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	page := memos[:min(pageSize, len(memos))]
+	// The memos are listed without their content, then the memos of the page are listed in their order.
+	memoMessages := []*v1pb.Memo{}
+	if len(page) > 0 {
+		memoIDs := make([]int32, 0, len(page))
+		for _, memo := range page {
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		withContent, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDs})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		slices.SortFunc(withContent, func(a, b *store.Memo) int {
+			return slices.Index(memoIDs, a.ID) - slices.Index(memoIDs, b.ID)
+		})
+		memoMessages, err = s.convertMemosFromStore(ctx, withContent)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+		}
+	}
+	return &v1pb.PreviewAutoArchiveMemosResponse{
+		Memos:     memoMessages,
+		TotalSize: int32(len(memos)),
+	}, nil
+}
+
+// AutoArchiveMemos archives the memos of a user matching the auto-archive policy of the user, and
+// notifies the user of the number of memos archived with a single inbox message. Webhooks aren't
+// dispatched for the archived memos, as a policy may archive thousands of memos at once.
+func (s *APIV1Service) AutoArchiveMemos(ctx context.Context, userID int32, setting *storepb.AutoArchiveUserSetting) (int, error) {
+	if setting.GetDays() == 0 {
+		return 0, nil
+	}
+	memos, err := s.listAutoArchiveMemos(ctx, userID, setting)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list memos")
+	}
+	if len(memos) == 0 {
+		return 0, nil
+	}
+
+	archived := store.Archived
+	updates := make([]*store.UpdateMemo, 0, len(memos))
+	for _, memo := range memos {
+		updates = append(updates, &store.UpdateMemo{ID: memo.ID, RowStatus: &archived})
+	}
+	if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+		return 0, errors.Wrap(err, "failed to archive memos")
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: userID,
+		Type:      store.ActivityTypeMemoAutoArchive,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoAutoArchive: &storepb.ActivityMemoAutoArchivePayload{
+				MemoCount: int32(len(memos)),
+				Days:      setting.Days,
+			},
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create activity")
+	}
+	_, err = s.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   userID,
+		ReceiverID: userID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_AUTO_ARCHIVE,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create inbox")
+	}
+	return len(memos), nil
+}
+
+// listAutoArchiveMemos lists the memos of a user that the auto-archive policy archives, without
+// their content, least recently updated first: the memos not updated in setting.Days days that
+// aren't pinned, have no reminder and have no protected tag. Comments are left to their memo.
+func (s *APIV1Service) listAutoArchiveMemos(ctx context.Context, userID int32, setting *storepb.AutoArchiveUserSetting) ([]*store.Memo, error) {
+	rowStatus := store.Normal
+	updatedTsBeforeSec := time.Now().AddDate(0, 0, -int(setting.Days)).Unix()
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &userID,
+		RowStatus:        &rowStatus,
+		ExcludeComments:  true,
+		ExcludeContent:   true,
+		UpdatedTsBefore:  &updatedTsBeforeSec,
+		OrderByUpdatedTs: true,
+		OrderByTimeAsc:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	protectedTags := make([]string, 0, len(setting.ProtectedTags))
+	for _, tag := range setting.ProtectedTags {
+		protectedTags = append(protectedTags, util.NormalizeTag(tag))
+	}
+	return slices.DeleteFunc(memos, func(memo *store.Memo) bool {
+		return memo.Pinned || memo.ReminderTs != 0 || hasProtectedTag(memo, protectedTags)
+	}), nil
+}
+
+// hasProtectedTag reports whether a memo has one of the normalized protected tags or a subtag of one.
+func hasProtectedTag(memo *store.Memo, protectedTags []string) bool {
+	for _, tag := range memo.Payload.GetTags() {
+		tag = util.NormalizeTag(tag)
+		for _, protectedTag := range protectedTags {
+			if tag == protectedTag || strings.HasPrefix(tag, protectedTag+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// updateAutoArchiveUserSetting updates the auto-archive policy of a user with the fields of the
+// update mask of an UpdateUserSetting request.
+func (s *APIV1Service) updateAutoArchiveUserSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_AUTO_ARCHIVE})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	setting := &storepb.AutoArchiveUserSetting{
+		Days:          userSetting.GetAutoArchive().GetDays(),
+		ProtectedTags: userSetting.GetAutoArchive().GetProtectedTags(),
+	}
+
+	incoming := request.Setting.GetAutoArchiveSetting()
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "days":
+			setting.Days = incoming.GetDays()
+		case "protectedTags":
+			setting.ProtectedTags = incoming.GetProtectedTags()
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	if err := s.validateAutoArchiveUserSetting(setting); err != nil {
+		return nil, err
+	}
+
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_AUTO_ARCHIVE,
+		Value:  &storepb.UserSetting_AutoArchive{AutoArchive: setting},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// validateAutoArchiveUserSetting checks the days and the protected tags of an auto-archive policy.
+func (s *APIV1Service) validateAutoArchiveUserSetting(setting *storepb.AutoArchiveUserSetting) error {
+	if setting.Days < 0 || setting.Days > maxAutoArchiveDays {
+		return status.Errorf(codes.InvalidArgument, "days must be between 0 and %d", maxAutoArchiveDays)
+	}
+	if len(setting.ProtectedTags) > maxAutoArchiveProtectedTags {
+		return status.Errorf(codes.InvalidArgument, "at most %d protected tags are allowed", maxAutoArchiveProtectedTags)
+	}
+	for _, tag := range setting.ProtectedTags {
+		if !s.isValidTag(tag) {
+			return status.Errorf(codes.InvalidArgument, "invalid protected tag %q", tag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// maxBatchUpdateMemos is the maximum number of memos updated by a BatchUpdateMemos request.
+const maxBatchUpdateMemos = 100
+
+// BatchUpdateMemos applies one operation to a list of memos in a single transaction. The memos
+// that the current user can't update, as with UpdateMemo and DeleteMemo, are reported as failures
+// while the operation is applied to the others. Each changed memo dispatches its own memo updated
+// webhook, or memo trashed webhook when moved to the trash.
+//
+// Authentication: Required.
+func (s *APIV1Service) BatchUpdateMemos(ctx context.Context, request *v1pb.BatchUpdateMemosRequest) (*v1pb.BatchUpdateMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if len(request.Names) == 0 || len(request.Names) > maxBatchUpdateMemos {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d memos are required", maxBatchUpdateMemos)
+	}
+	seen := map[string]bool{}
+	for _, name := range request.Names {
+		if seen[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate memo %s", name)
+		}
+		seen[name] = true
+	}
+	if err := s.validateBatchMemoOperation(ctx, request); err != nil {
+		return nil, err
+	}
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.BatchUpdateMemosResponse{
+		Failures: []*v1pb.BatchUpdateMemosResponse_Failure{},
+	}
+	updates, changed := []*store.UpdateMemo{}, []*store.Memo{}
+	for _, name := range request.Names {
+		update, err := s.getBatchMemoUpdate(ctx, request, name, user, contentLengthLimit)
+		if err != nil {
+			response.FailedCount++
+			response.Failures = append(response.Failures, &v1pb.BatchUpdateMemosResponse_Failure{
+				Name:   name,
+				Reason: status.Convert(err).Message(),
+			})
+			continue
+		}
+		response.SucceededCount++
+		if update != nil {
+			updates = append(updates, update.update)
+			changed = append(changed, update.memo)
+		}
+	}
+
+	if len(updates) > 0 {
+		if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+		}
+	}
+	activityType := webhook.EventMemoUpdated
+	if request.GetMoveToTrash() {
+		activityType = webhook.EventMemoTrashed
+	}
+	for _, memo := range changed {
+		s.dispatchStoreMemoWebhook(ctx, memo, activityType)
+	}
+	return response, nil
+}
+
+// validateBatchMemoOperation checks the operation of a BatchUpdateMemos request, which fails the
+// whole request if invalid.
+func (s *APIV1Service) validateBatchMemoOperation(ctx context.Context, request *v1pb.BatchUpdateMemosRequest) error {
+	switch operation := request.Operation.(type) {
+	case *v1pb.BatchUpdateMemosRequest_SetVisibility:
+		if operation.SetVisibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			return status.Errorf(codes.InvalidArgument, "visibility is required")
+		}
+		instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get instance memo related setting")
+		}
+		if instanceMemoRelatedSetting.DisallowPublicVisibility && operation.SetVisibility == v1pb.Visibility_PUBLIC {
+			return status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+		}
+	case *v1pb.BatchUpdateMemosRequest_SetState:
+		if operation.SetState != v1pb.State_NORMAL && operation.SetState != v1pb.State_ARCHIVED {
+			return status.Errorf(codes.InvalidArgument, "state must be NORMAL or ARCHIVED")
+		}
+	case *v1pb.BatchUpdateMemosRequest_AddTag:
+		if !s.isValidTag(operation.AddTag) {
+			return status.Errorf(codes.InvalidArgument, "invalid tag: tags cannot be empty or contain spaces")
+		}
+	case *v1pb.BatchUpdateMemosRequest_RemoveTag:
+		if !s.isValidTag(operation.RemoveTag) {
+			return status.Errorf(codes.InvalidArgument, "invalid tag: tags cannot be empty or contain spaces")
+		}
+	case *v1pb.BatchUpdateMemosRequest_MoveToTrash:
+		if !operation.MoveToTrash {
+			return status.Errorf(codes.InvalidArgument, "move to trash must be true")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "operation is required")
+	}
+	return nil
+}
+
+// batchMemoUpdate is the update of a memo by BatchUpdateMemos, with the memo as updated.
+type batchMemoUpdate struct {
+	memo   *store.Memo
+	update *store.UpdateMemo
+}
+
+// getBatchMemoUpdate returns the update of a memo by the operation of a BatchUpdateMemos request,
+// or nil if the operation doesn't change the memo. The returned error is the reason of the failure.
+func (s *APIV1Service) getBatchMemoUpdate(ctx context.Context, request *v1pb.BatchUpdateMemosRequest, name string, user *store.User, contentLengthLimit int) (*batchMemoUpdate, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if request.GetMoveToTrash() {
+		// As with DeleteMemo, only the creator or admin can move the memo to the trash.
+		if memo.CreatorID != user.ID && !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	} else if err := checkMemoUpdatePermission(memo, user); err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateMemo{ID: memo.ID}
+	switch operation := request.Operation.(type) {
+	case *v1pb.BatchUpdateMemosRequest_SetVisibility:
+		visibility := convertVisibilityToStore(operation.SetVisibility)
+		if memo.Visibility == visibility {
+			return nil, nil
+		}
+		memo.Visibility = visibility
+		update.Visibility = &visibility
+	case *v1pb.BatchUpdateMemosRequest_SetState:
+		// Drafts are published one by one with UpdateMemo, which sets their creation time.
+		if memo.RowStatus == store.Draft {
+			return nil, status.Errorf(codes.FailedPrecondition, "memo is a draft")
+		}
+		rowStatus := convertStateToStore(operation.SetState)
+		if memo.RowStatus == rowStatus {
+			return nil, nil
+		}
+		memo.RowStatus = rowStatus
+		update.RowStatus = &rowStatus
+	case *v1pb.BatchUpdateMemosRequest_AddTag, *v1pb.BatchUpdateMemosRequest_RemoveTag:
+		var content string
+		if request.GetAddTag() != "" {
+			content, err = s.appendMissingMemoTags(memo.Content, []string{request.GetAddTag()})
+		} else {
+			content, err = s.MarkdownService.RemoveTag([]byte(memo.Content), request.GetRemoveTag())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update tags: %v", err)
+		}
+		if content == memo.Content {
+			return nil, nil
+		}
+		if len(content) > contentLengthLimit {
+			return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+		}
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		}
+		update.Content, update.Payload = &memo.Content, memo.Payload
+	case *v1pb.BatchUpdateMemosRequest_MoveToTrash:
+		deletedTsSec := time.Now().Unix()
+		memo.DeletedTs = deletedTsSec
+		update.DeletedTs = &deletedTsSec
+	default:
+	}
+	return &batchMemoUpdate{memo: memo, update: update}, nil
+}
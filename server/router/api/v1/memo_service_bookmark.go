@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// CreateBookmarkMemo creates a memo bookmarking a link, whose title, description and image are
+// fetched server-side like link previews. The memo is created with the bare link, and a warning,
+// if the metadata can't be fetched. A link the user bookmarked within the duplicate window of the
+// instance returns the memo bookmarking it instead, as browser extensions may save it twice.
+//
+// Authentication: Required.
+func (s *APIV1Service) CreateBookmarkMemo(ctx context.Context, request *v1pb.CreateBookmarkMemoRequest) (*v1pb.CreateBookmarkMemoResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	link, err := parseBookmarkURL(request.Url)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid url: %v", err)
+	}
+
+	duplicate, err := s.findBookmarkMemo(ctx, user, link)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate != nil {
+		memoMessage, err := s.convertMemoFromStore(ctx, duplicate, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		return &v1pb.CreateBookmarkMemoResponse{Memo: memoMessage, Duplicate: true}, nil
+	}
+
+	response := &v1pb.CreateBookmarkMemoResponse{}
+	bookmark := &storepb.MemoPayload_Bookmark{Url: link}
+	if s.LinkPreviewService == nil {
+		response.Warning = "link previews are not available"
+	} else if metadata, err := s.LinkPreviewService.GetLinkMetadata(ctx, user, link); err != nil {
+		slog.Debug("failed to fetch bookmark metadata", slog.String("url", link), slog.Any("err", err))
+		response.Warning = fmt.Sprintf("failed to fetch link metadata: %v", err)
+	} else {
+		bookmark.Title = strings.TrimSpace(metadata.Title)
+		bookmark.Description = strings.Join(strings.Fields(metadata.Description), " ")
+		bookmark.Image = metadata.Image
+	}
+
+	content, err := s.AppendMemoTags(formatBookmarkContent(bookmark, request.Comment), request.Tags)
+	if err != nil {
+		return nil, err
+	}
+	visibility := request.Visibility
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility, err = s.getDefaultMemoVisibility(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response.Memo, err = s.createMemoWithBookmark(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	}, bookmark)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// parseBookmarkURL returns the normalized form of an http or https URL to bookmark.
+func parseBookmarkURL(rawURL string) (string, error) {
+	link, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", err
+	}
+	link.Scheme = strings.ToLower(link.Scheme)
+	if link.Scheme != "http" && link.Scheme != "https" {
+		return "", errors.New("only http and https URLs can be bookmarked")
+	}
+	if link.Host == "" {
+		return "", errors.New("the URL has no host")
+	}
+	link.Host = strings.ToLower(link.Host)
+	link.Fragment = ""
+	return link.String(), nil
+}
+
+// findBookmarkMemo returns the memo of the user bookmarking the link within the duplicate window
+// of the instance, or nil if there's none.
+func (s *APIV1Service) findBookmarkMemo(ctx context.Context, user *store.User, link string) (*store.Memo, error) {
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	now := time.Now()
+	window := time.Duration(instanceMemoRelatedSetting.BookmarkDuplicateWindowMinutes) * time.Minute
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		ExcludeComments: true,
+		CreatedTsRanges: []*store.MemoTsRange{{StartTs: now.Add(-window).Unix(), EndTs: now.Unix() + 1}},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	for _, memo := range memos {
+		if memo.Payload.GetBookmark().GetUrl() == link {
+			return memo, nil
+		}
+	}
+	return nil, nil
+}
+
+// formatBookmarkContent returns the markdown content of a bookmark memo: the link, titled if the
+// title is known, followed by the description as a quote and the comment.
+func formatBookmarkContent(bookmark *storepb.MemoPayload_Bookmark, comment string) string {
+	parts := []string{bookmark.Url}
+	if bookmark.Title != "" {
+		title := strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`).Replace(bookmark.Title)
+		parts[0] = fmt.Sprintf("[%s](%s)", title, bookmark.Url)
+	}
+	if bookmark.Description != "" {
+		parts = append(parts, "> "+bookmark.Description)
+	}
+	if comment = strings.TrimSpace(comment); comment != "" {
+		parts = append(parts, comment)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// getDefaultMemoVisibility returns the default memo visibility of the user.
+func (s *APIV1Service) getDefaultMemoVisibility(ctx context.Context, userID int32) (v1pb.Visibility, error) {
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	if memoVisibility := generalSetting.GetGeneral().GetMemoVisibility(); memoVisibility != "" {
+		return convertVisibilityFromStore(store.Visibility(memoVisibility)), nil
+	}
+	return v1pb.Visibility_PRIVATE, nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -34,11 +36,32 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		Content:     memo.Content,
 		Visibility:  convertVisibilityFromStore(memo.Visibility),
 		Pinned:      memo.Pinned,
+		Slug:        memo.Slug,
+		Etag:        getMemoEtag(memo.Content),
 	}
 	if memo.Payload != nil {
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
-		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		memoMessage.DisableComments = memo.Payload.DisableComments
+		// The location is only shown to others if the creator chose to show it publicly.
+		if location := memo.Payload.Location; location != nil && (location.ShowPublicly || auth.GetUserID(ctx) == memo.CreatorID) {
+			memoMessage.Location = convertLocationFromStore(location)
+		}
+		memoMessage.Bookmark = convertMemoBookmarkFromStore(memo.Payload.Bookmark)
+	}
+
+	if memo.DeletedTs != 0 {
+		memoMessage.DeleteTime = timestamppb.New(time.Unix(memo.DeletedTs, 0))
+	}
+	if memo.PublishTs != 0 {
+		memoMessage.PublishTime = timestamppb.New(time.Unix(memo.PublishTs, 0))
+	}
+	if memo.ExpireTs != 0 {
+		memoMessage.ExpireTime = timestamppb.New(time.Unix(memo.ExpireTs, 0))
+	}
+	// Reminders are personal to the creator of the memo.
+	if memo.ReminderTs != 0 && auth.GetUserID(ctx) == memo.CreatorID {
+		memoMessage.Reminder = convertMemoReminderFromStore(memo)
 	}
 
 	if memo.ParentUID != nil {
@@ -59,6 +82,12 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	}
 	memoMessage.Relations = listMemoRelationsResponse.Relations
 
+	wikiLinks, err := s.convertMemoWikiLinksFromStore(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert wiki links")
+	}
+	memoMessage.WikiLinks = wikiLinks
+
 	memoMessage.Attachments = []*v1pb.Attachment{}
 
 	for _, attachment := range attachments {
@@ -75,6 +104,44 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	return memoMessage, nil
 }
 
+// convertMemosFromStore converts memos with their reactions and attachments, which are listed
+// for all the memos at once.
+func (s *APIV1Service) convertMemosFromStore(ctx context.Context, memos []*store.Memo) ([]*v1pb.Memo, error) {
+	memoMessages := []*v1pb.Memo{}
+	if len(memos) == 0 {
+		return memoMessages, nil
+	}
+	contentIDs, memoIDs := make([]string, 0, len(memos)), make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		contentIDs = append(contentIDs, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	reactionMap := make(map[string][]*store.Reaction)
+	for _, reaction := range reactions {
+		reactionMap[reaction.ContentID] = append(reactionMap[reaction.ContentID], reaction)
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	attachmentMap := make(map[int32][]*store.Attachment)
+	for _, attachment := range attachments {
+		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
+	}
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo, reactionMap[fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)], attachmentMap[memo.ID])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return memoMessages, nil
+}
+
 func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.Memo_Property {
 	if property == nil {
 		return nil
@@ -92,21 +159,48 @@ func convertLocationFromStore(location *storepb.MemoPayload_Location) *v1pb.Loca
 		return nil
 	}
 	return &v1pb.Location{
-		Placeholder: location.Placeholder,
-		Latitude:    location.Latitude,
-		Longitude:   location.Longitude,
+		Placeholder:  location.Placeholder,
+		Latitude:     location.Latitude,
+		Longitude:    location.Longitude,
+		ShowPublicly: location.ShowPublicly,
 	}
 }
 
-func convertLocationToStore(location *v1pb.Location) *storepb.MemoPayload_Location {
-	if location == nil {
+func convertMemoBookmarkFromStore(bookmark *storepb.MemoPayload_Bookmark) *v1pb.MemoBookmark {
+	if bookmark == nil {
 		return nil
 	}
-	return &storepb.MemoPayload_Location{
-		Placeholder: location.Placeholder,
-		Latitude:    location.Latitude,
-		Longitude:   location.Longitude,
+	return &v1pb.MemoBookmark{
+		Url:         bookmark.Url,
+		Title:       bookmark.Title,
+		Description: bookmark.Description,
+		Image:       bookmark.Image,
+	}
+}
+
+// maxLocationPlaceholderLength is the maximum number of characters of a location placeholder.
+const maxLocationPlaceholderLength = 256
+
+func convertLocationToStore(location *v1pb.Location) (*storepb.MemoPayload_Location, error) {
+	if location == nil {
+		return nil, nil
 	}
+	// The negated comparisons also reject NaN.
+	if !(location.Latitude >= -90 && location.Latitude <= 90) {
+		return nil, errors.New("latitude must be between -90 and 90")
+	}
+	if !(location.Longitude >= -180 && location.Longitude <= 180) {
+		return nil, errors.New("longitude must be between -180 and 180")
+	}
+	if utf8.RuneCountInString(location.Placeholder) > maxLocationPlaceholderLength {
+		return nil, errors.Errorf("placeholder too long (max %d characters)", maxLocationPlaceholderLength)
+	}
+	return &storepb.MemoPayload_Location{
+		Placeholder:  location.Placeholder,
+		Latitude:     location.Latitude,
+		Longitude:    location.Longitude,
+		ShowPublicly: location.ShowPublicly,
+	}, nil
 }
 
 func convertVisibilityFromStore(visibility store.Visibility) v1pb.Visibility {
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// GetMemoCounts gets the number of memos of the current user in each state, as listed by
+// ListMemos: comments, scheduled memos and memos in the trash aren't counted.
+//
+// Authentication: Required.
+func (s *APIV1Service) GetMemoCounts(ctx context.Context, _ *v1pb.GetMemoCountsRequest) (*v1pb.GetMemoCountsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	response := &v1pb.GetMemoCountsResponse{}
+	for _, memo := range memos {
+		switch memo.RowStatus {
+		case store.Normal:
+			response.NormalCount++
+		case store.Archived:
+			response.ArchivedCount++
+		case store.Draft:
+			response.DraftCount++
+		default:
+		}
+	}
+	return response, nil
+}
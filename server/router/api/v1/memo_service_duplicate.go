@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// DuplicateMemo creates a private, unpinned copy of a memo for the current user. The copied
+// attachments reference the local files and S3 objects of the memo's attachments, which are
+// kept until no attachment references them. Duplicating a memo of another user adds a reference
+// relation to the memo, to record the origin of the copy.
+//
+// Authentication: Required (any user who can view the memo).
+func (s *APIV1Service) DuplicateMemo(ctx context.Context, request *v1pb.DuplicateMemoRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	source, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if source == nil || ((source.PublishTs != 0 || source.RowStatus == store.Draft) && source.CreatorID != user.ID) {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if source.Visibility == store.Private && source.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	content := source.Content
+	if !request.CopyTags {
+		for _, tag := range source.Payload.GetTags() {
+			content, err = s.MarkdownService.RemoveTag([]byte(content), tag)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to remove tags: %v", err)
+			}
+		}
+	}
+	if err := s.checkMemoQuota(ctx, user, store.Private); err != nil {
+		return nil, err
+	}
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	}
+	create := &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: store.Private,
+	}
+	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	// The location of another user's memo is only copied if it is shown publicly.
+	if location := source.Payload.GetLocation(); location != nil && (location.ShowPublicly || source.CreatorID == user.ID) {
+		create.Payload.Location = location
+	}
+	memo, err := s.Store.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+	}
+	RecordMemoQuotaUsage(ctx, s.Store, user.ID, memo.Visibility)
+
+	attachments, err := s.duplicateMemoAttachments(ctx, source, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to duplicate attachments: %v", err)
+	}
+	if err := s.duplicateMemoRelations(ctx, source, memo, request.CopyRelations); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to duplicate relations: %v", err)
+	}
+	if err := s.syncMemoLinkRelations(ctx, memo, ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo link relations: %v", err)
+	}
+
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is created.
+	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	}
+	return memoMessage, nil
+}
+
+// duplicateMemoAttachments copies the attachments of source to memo. The copies of attachments
+// stored in the database copy their blobs, the others reference the same file or S3 object.
+func (s *APIV1Service) duplicateMemoAttachments(ctx context.Context, source, memo *store.Memo) ([]*store.Attachment, error) {
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &source.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list attachments")
+	}
+	// The attachments are listed by their update time, newest first, which orders the copies
+	// as in SetMemoAttachments.
+	copies := make([]*store.Attachment, len(attachments))
+	nowSec := time.Now().Unix()
+	for i := len(attachments) - 1; i >= 0; i-- {
+		attachment := attachments[i]
+		create := &store.Attachment{
+			UID:         shortuuid.New(),
+			CreatorID:   memo.CreatorID,
+			Filename:    attachment.Filename,
+			Type:        attachment.Type,
+			Size:        attachment.Size,
+			StorageType: attachment.StorageType,
+			Reference:   attachment.Reference,
+			Payload:     attachment.Payload,
+			ContentHash: attachment.ContentHash,
+			MemoID:      &memo.ID,
+		}
+		if attachment.StorageType == storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+			withBlob, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get attachment blob")
+			}
+			if withBlob != nil {
+				create.Blob = withBlob.Blob
+			}
+		}
+		copied, err := s.Store.CreateAttachment(ctx, create)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create attachment")
+		}
+		updatedTsSec := nowSec + int64(len(attachments)-1-i)
+		if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{ID: copied.ID, UpdatedTs: &updatedTsSec}); err != nil {
+			return nil, errors.Wrap(err, "failed to update attachment")
+		}
+		copied.UpdatedTs = updatedTsSec
+		copies[i] = copied
+	}
+	return copies, nil
+}
+
+// duplicateMemoRelations adds a reference from memo to source if source was created by another
+// user, and copies the references of source to other memos if copyReferences is set. Comment
+// relations are never copied, so duplicating a comment creates a memo.
+func (s *APIV1Service) duplicateMemoRelations(ctx context.Context, source, memo *store.Memo, copyReferences bool) error {
+	relatedMemoIDs := []int32{}
+	if source.CreatorID != memo.CreatorID {
+		relatedMemoIDs = append(relatedMemoIDs, source.ID)
+	}
+	if copyReferences {
+		referenceType := store.MemoRelationReference
+		relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &source.ID, Type: &referenceType})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo relations")
+		}
+		for _, relation := range relations {
+			relatedMemoIDs = append(relatedMemoIDs, relation.RelatedMemoID)
+		}
+	}
+	for _, relatedMemoID := range relatedMemoIDs {
+		_, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemoID,
+			Type:          store.MemoRelationReference,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create memo relation")
+		}
+	}
+	return nil
+}
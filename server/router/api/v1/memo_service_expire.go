@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/store"
+)
+
+// ExpireMemo moves a memo past its expiry to the trash and dispatches the memo expired webhook.
+// It does nothing if the expiry of the memo has changed since it was listed.
+func (s *APIV1Service) ExpireMemo(ctx context.Context, memo *store.Memo) error {
+	expired, err := s.Store.ExpireMemo(ctx, &store.ExpireMemo{
+		ID:        memo.ID,
+		ExpireTs:  memo.ExpireTs,
+		DeletedTs: time.Now().Unix(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to expire memo")
+	}
+	if !expired {
+		return nil
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID, IncludeTrashed: true, IncludeScheduled: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo has expired.
+	if err := s.DispatchMemoExpiredWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo expired webhook", slog.Any("err", err))
+	}
+	return nil
+}
+
+// convertMemoExpireTimeToStore converts the expire time of a memo to its expiry, which must be in
+// the future. The expiry of a comment can't be later than the expiry of the memo it comments on,
+// parent, which a comment without an expire time inherits.
+func convertMemoExpireTimeToStore(expireTime *timestamppb.Timestamp, parent *store.Memo) (int64, error) {
+	if expireTime == nil {
+		if parent != nil {
+			return parent.ExpireTs, nil
+		}
+		return 0, nil
+	}
+	expireTs := expireTime.AsTime().Unix()
+	if expireTs <= time.Now().Unix() {
+		return 0, errors.New("expire time must be in the future")
+	}
+	if parent != nil && parent.ExpireTs != 0 && expireTs > parent.ExpireTs {
+		return 0, errors.New("expire time of a comment must not be later than the expire time of its memo")
+	}
+	return expireTs, nil
+}
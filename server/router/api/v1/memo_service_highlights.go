@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxOnThisDayMemos is the maximum number of memos created on the day in previous years.
+	maxOnThisDayMemos = 100
+	// maxRandomMemoHighlights is the maximum number of random memos of GetMemoHighlights.
+	maxRandomMemoHighlights = 20
+	// randomMemoHighlightMinAge is the minimum age of the random memos of GetMemoHighlights.
+	randomMemoHighlightMinAge = 90 * 24 * time.Hour
+)
+
+// GetMemoHighlights gets the memos of the current user created on a day in previous years, in
+// the time zone of the user, and a random sample of older memos. The memos of the day are found
+// by a creation time range for each year, which uses the index on the creator and creation time.
+//
+// Authentication: Required.
+func (s *APIV1Service) GetMemoHighlights(ctx context.Context, request *v1pb.GetMemoHighlightsRequest) (*v1pb.GetMemoHighlightsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.RandomCount < 0 || request.RandomCount > maxRandomMemoHighlights {
+		return nil, status.Errorf(codes.InvalidArgument, "random count must be between 0 and %d", maxRandomMemoHighlights)
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	now := time.Now().In(getUserGeneralSettingLocation(generalSetting.GetGeneral()))
+	month, day := now.Month(), now.Day()
+	if request.Month != 0 || request.Day != 0 {
+		// The day is checked in a leap year, for February 29.
+		if request.Month < 1 || request.Month > 12 || request.Day < 1 || request.Day > 31 ||
+			time.Date(2024, time.Month(request.Month), int(request.Day), 0, 0, 0, 0, time.UTC).Month() != time.Month(request.Month) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid month and day")
+		}
+		month, day = time.Month(request.Month), int(request.Day)
+	}
+
+	onThisDayMemos, err := s.listOnThisDayMemos(ctx, user.ID, now, month, day)
+	if err != nil {
+		return nil, err
+	}
+	randomMemos := []*store.Memo{}
+	if request.RandomCount > 0 {
+		rowStatus := store.Normal
+		createdTsBeforeSec := now.Add(-randomMemoHighlightMinAge).Unix()
+		limit := int(request.RandomCount)
+		// The sample is taken without the content, then the sampled memos are listed.
+		sample, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			CreatorID:       &user.ID,
+			RowStatus:       &rowStatus,
+			ExcludeComments: true,
+			ExcludeContent:  true,
+			CreatedTsBefore: &createdTsBeforeSec,
+			OrderByRandom:   true,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sample memos: %v", err)
+		}
+		if len(sample) > 0 {
+			memoIDs := make([]int32, 0, len(sample))
+			for _, memo := range sample {
+				memoIDs = append(memoIDs, memo.ID)
+			}
+			randomMemos, err = s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDs})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+			}
+		}
+	}
+
+	onThisDayMemoMessages, err := s.convertMemosFromStore(ctx, onThisDayMemos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	randomMemoMessages, err := s.convertMemosFromStore(ctx, randomMemos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	return &v1pb.GetMemoHighlightsResponse{
+		OnThisDayMemos: onThisDayMemoMessages,
+		RandomMemos:    randomMemoMessages,
+	}, nil
+}
+
+// listOnThisDayMemos lists the memos of a user created on month and day in the years before the
+// next occurrence of the day from now, since the first memo of the user.
+func (s *APIV1Service) listOnThisDayMemos(ctx context.Context, userID int32, now time.Time, month time.Month, day int) ([]*store.Memo, error) {
+	rowStatus := store.Normal
+	limit := 1
+	first, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &rowStatus,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		OrderByTimeAsc:  true,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get first memo: %v", err)
+	}
+	if len(first) == 0 {
+		return []*store.Memo{}, nil
+	}
+
+	location := now.Location()
+	nextYear := now.Year()
+	if month < now.Month() || (month == now.Month() && day < now.Day()) {
+		nextYear++
+	}
+	ranges := []*store.MemoTsRange{}
+	for year := time.Unix(first[0].CreatedTs, 0).In(location).Year(); year < nextYear; year++ {
+		start := time.Date(year, month, day, 0, 0, 0, 0, location)
+		if start.Month() != month {
+			// February 29 of a year that isn't a leap year.
+			continue
+		}
+		ranges = append(ranges, &store.MemoTsRange{StartTs: start.Unix(), EndTs: start.AddDate(0, 0, 1).Unix()})
+	}
+	if len(ranges) == 0 {
+		return []*store.Memo{}, nil
+	}
+	limit = maxOnThisDayMemos
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &rowStatus,
+		ExcludeComments: true,
+		CreatedTsRanges: ranges,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	return memos, nil
+}
@@ -0,0 +1,684 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxMemoImportSize is the maximum size of an imported archive.
+	maxMemoImportSize = 512 * MebiByte
+	// memoImportRetention is how long the progress of a finished import is kept.
+	memoImportRetention = 24 * time.Hour
+)
+
+var (
+	// importedLinkRegexp matches the markdown links and images of imported files, with their
+	// destination optionally in angle brackets and an optional title.
+	importedLinkRegexp = regexp.MustCompile(`(!?)\[([^\]]*)\]\((?:<([^>]+)>|([^)\s]+))(\s+"[^"]*")?\)`)
+	// importedEmbedRegexp matches the embedded files of Obsidian notes, e.g. ![[image.png|300]].
+	importedEmbedRegexp = regexp.MustCompile(`!\[\[([^\]|#]+)(?:[|#][^\]]*)?\]\]`)
+)
+
+// memoImport is the progress of an import. Imports are kept in memory, so they are lost when
+// the server restarts.
+type memoImport struct {
+	mu sync.Mutex
+
+	id             string
+	creatorID      int32
+	state          v1pb.MemoImport_State
+	totalFiles     int32
+	processedFiles int32
+	createdMemos   int32
+	skippedFiles   int32
+	errors         []*v1pb.MemoImport_FileError
+	createTime     time.Time
+	finishTime     time.Time
+}
+
+func (i *memoImport) toProto() *v1pb.MemoImport {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	memoImport := &v1pb.MemoImport{
+		Name:           MemoImportNamePrefix + i.id,
+		State:          i.state,
+		TotalFiles:     i.totalFiles,
+		ProcessedFiles: i.processedFiles,
+		CreatedMemos:   i.createdMemos,
+		SkippedFiles:   i.skippedFiles,
+		Errors:         slices.Clone(i.errors),
+		CreateTime:     timestamppb.New(i.createTime),
+	}
+	if !i.finishTime.IsZero() {
+		memoImport.FinishTime = timestamppb.New(i.finishTime)
+	}
+	return memoImport
+}
+
+// expired reports whether the import finished more than memoImportRetention ago.
+func (i *memoImport) expired(now time.Time) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return !i.finishTime.IsZero() && now.Sub(i.finishTime) > memoImportRetention
+}
+
+func (i *memoImport) running() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.state == v1pb.MemoImport_RUNNING
+}
+
+// record records the result of the import of a file, or of an entry of a file.
+func (i *memoImport) record(filename string, created bool, err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.processedFiles++
+	switch {
+	case err != nil:
+		i.errors = append(i.errors, &v1pb.MemoImport_FileError{Filename: filename, Reason: status.Convert(err).Message()})
+	case created:
+		i.createdMemos++
+	default:
+		i.skippedFiles++
+	}
+}
+
+// ImportMemos starts importing the markdown files of a zip archive, the notes of a Google Keep
+// Takeout archive, or the entries of a Day One export, as memos of the current user. Each user
+// runs one import at a time.
+//
+// Authentication: Required.
+func (s *APIV1Service) ImportMemos(ctx context.Context, request *v1pb.ImportMemosRequest) (*v1pb.MemoImport, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if len(request.Content) > maxMemoImportSize {
+		return nil, status.Errorf(codes.InvalidArgument, "archive too large (max %d MiB)", maxMemoImportSize/MebiByte)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(request.Content), int64(len(request.Content)))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid zip archive: %v", err)
+	}
+	visibility := store.Private
+	if request.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility = convertVisibilityToStore(request.Visibility)
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+
+	now := time.Now()
+	running := false
+	s.memoImports.Range(func(key, value any) bool {
+		memoImport, ok := value.(*memoImport)
+		if !ok {
+			return true
+		}
+		if memoImport.expired(now) {
+			s.memoImports.Delete(key)
+		} else if memoImport.creatorID == user.ID && memoImport.running() {
+			running = true
+		}
+		return true
+	})
+	if running {
+		return nil, status.Errorf(codes.FailedPrecondition, "an import is already running")
+	}
+
+	isImportedFile := isImportedMarkdownFile
+	if request.Format == v1pb.ImportMemosRequest_GOOGLE_KEEP || request.Format == v1pb.ImportMemosRequest_DAY_ONE {
+		isImportedFile = isImportedJSONFile
+	}
+	files := []*zip.File{}
+	for _, file := range archive.File {
+		if isImportedFile(file) {
+			files = append(files, file)
+		}
+	}
+	memoImport := &memoImport{
+		id:         shortuuid.New(),
+		creatorID:  user.ID,
+		state:      v1pb.MemoImport_RUNNING,
+		totalFiles: int32(len(files)),
+		createTime: now,
+	}
+	s.memoImports.Store(memoImport.id, memoImport)
+
+	// The import outlives the request, as the user who started it.
+	importCtx := context.WithValue(context.Background(), auth.UserIDContextKey, user.ID)
+	go s.runMemoImport(importCtx, memoImport, user, archive, files, visibility, request.Format)
+	return memoImport.toProto(), nil
+}
+
+// GetMemoImport gets the progress of an import of the current user.
+//
+// Authentication: Required (the user who started the import).
+func (s *APIV1Service) GetMemoImport(ctx context.Context, request *v1pb.GetMemoImportRequest) (*v1pb.MemoImport, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	id, err := ExtractMemoImportIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo import name: %v", err)
+	}
+	value, _ := s.memoImports.Load(id)
+	memoImport, ok := value.(*memoImport)
+	if !ok || memoImport.creatorID != user.ID {
+		return nil, status.Errorf(codes.NotFound, "memo import not found")
+	}
+	return memoImport.toProto(), nil
+}
+
+// runMemoImport imports the files of an archive one by one. A file that fails is reported
+// without aborting the import of the others.
+func (s *APIV1Service) runMemoImport(ctx context.Context, memoImport *memoImport, creator *store.User, archive *zip.Reader, files []*zip.File, visibility store.Visibility, format v1pb.ImportMemosRequest_Format) {
+	state := v1pb.MemoImport_SUCCEEDED
+	defer func() {
+		memoImport.mu.Lock()
+		memoImport.state = state
+		memoImport.finishTime = time.Now()
+		memoImport.mu.Unlock()
+	}()
+
+	importHashes, err := s.listMemoImportHashes(ctx, memoImport.creatorID)
+	if err != nil {
+		slog.Error("failed to list imported memos", "import", memoImport.id, "error", err)
+		state = v1pb.MemoImport_FAILED
+		return
+	}
+	importer := &memoImporter{
+		service:      s,
+		creator:      creator,
+		visibility:   visibility,
+		importHashes: importHashes,
+		files:        map[string]*zip.File{},
+		filesByName:  map[string]*zip.File{},
+	}
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		importer.files[file.Name] = file
+		// Obsidian embeds refer to files by their name, wherever they are in the vault.
+		if _, ok := importer.filesByName[path.Base(file.Name)]; !ok {
+			importer.filesByName[path.Base(file.Name)] = file
+		}
+	}
+
+	if format == v1pb.ImportMemosRequest_DAY_ONE {
+		importer.importDayOneJournals(ctx, memoImport, files)
+		return
+	}
+	importFile := importer.importFile
+	if format == v1pb.ImportMemosRequest_GOOGLE_KEEP {
+		importFile = importer.importKeepNote
+	}
+	for _, file := range files {
+		created, err := importFile(ctx, file)
+		memoImport.record(file.Name, created, err)
+	}
+}
+
+// listMemoImportHashes returns the import hashes of the memos of a user, including the memos
+// in the trash.
+func (s *APIV1Service) listMemoImportHashes(ctx context.Context, creatorID int32) (map[string]bool, error) {
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &creatorID,
+		ExcludeContent:   true,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
+		IncludeExpired:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	importHashes := map[string]bool{}
+	for _, memo := range memos {
+		if importHash := memo.Payload.GetImportHash(); importHash != "" {
+			importHashes[importHash] = true
+		}
+	}
+	return importHashes, nil
+}
+
+// memoImporter imports the files of an archive as memos.
+type memoImporter struct {
+	service    *APIV1Service
+	creator    *store.User
+	visibility store.Visibility
+	// importHashes are the hashes of the files imported as memos of the user, so far.
+	importHashes map[string]bool
+	// files maps the paths of the files of the archive to the files, and filesByName their names.
+	files       map[string]*zip.File
+	filesByName map[string]*zip.File
+}
+
+// importFile imports a markdown file as a memo. It returns false without an error if the file
+// was already imported.
+func (i *memoImporter) importFile(ctx context.Context, file *zip.File) (bool, error) {
+	data, err := readImportedFile(file)
+	if err != nil {
+		return false, err
+	}
+	hash := sha256.Sum256(data)
+	importHash := hex.EncodeToString(hash[:])
+	if i.importHashes[importHash] {
+		return false, nil
+	}
+
+	frontMatter, content, err := parseImportedFrontMatter(string(data))
+	if err != nil {
+		return false, err
+	}
+	createdTime := file.Modified
+	if createdTime.IsZero() {
+		createdTime = time.Now()
+	}
+	if t, ok := frontMatter.createdTime(); ok {
+		createdTime = t
+	}
+	updatedTime := createdTime
+	if t, ok := frontMatter.updatedTime(); ok && t.After(createdTime) {
+		updatedTime = t
+	}
+
+	content, attachments, err := i.importLinkedFiles(ctx, file.Name, content)
+	if err != nil {
+		i.deleteAttachments(ctx, attachments)
+		return false, err
+	}
+	memo, err := i.createMemo(ctx, content, frontMatter, importHash)
+	if err != nil {
+		i.deleteAttachments(ctx, attachments)
+		return false, err
+	}
+	createdTsSec, updatedTsSec := createdTime.Unix(), updatedTime.Unix()
+	update := &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTsSec, UpdatedTs: &updatedTsSec}
+	if err := i.completeMemo(ctx, memo, update, frontMatter.Pinned, attachments); err != nil {
+		return false, err
+	}
+	i.importHashes[importHash] = true
+	return true, nil
+}
+
+// completeMemo sets the attachments of a memo created by an import, and updates it with the
+// fields CreateMemo doesn't set. The memo is updated last, as it may move it to the trash.
+func (i *memoImporter) completeMemo(ctx context.Context, memo *store.Memo, update *store.UpdateMemo, pinned bool, attachments []*v1pb.Attachment) error {
+	if pinned {
+		if err := i.service.Store.PinMemo(ctx, &store.PinMemo{ID: memo.ID, CreatorID: memo.CreatorID, Pinned: true}); err != nil {
+			return errors.Wrap(err, "failed to pin memo")
+		}
+	}
+	if len(attachments) > 0 {
+		request := &v1pb.SetMemoAttachmentsRequest{Name: MemoNamePrefix + memo.UID, Attachments: attachments}
+		if _, err := i.service.SetMemoAttachments(ctx, request); err != nil {
+			return errors.Wrap(err, "failed to set memo attachments")
+		}
+	}
+	if err := i.service.syncMemoLinkRelations(ctx, memo, ""); err != nil {
+		return errors.Wrap(err, "failed to sync memo link relations")
+	}
+	if err := i.service.Store.UpdateMemo(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
+
+// createMemo creates the memo of an imported file. The tags of the front matter missing from
+// the content are appended to it, as the tags of memos come from their content. The memo counts
+// against the memo quotas of the user.
+func (i *memoImporter) createMemo(ctx context.Context, content string, frontMatter *importedFrontMatter, importHash string) (*store.Memo, error) {
+	create := &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  i.creator.ID,
+		Content:    content,
+		Visibility: i.visibility,
+	}
+	if visibility, ok := frontMatter.visibility(); ok {
+		instanceMemoRelatedSetting, err := i.service.Store.GetInstanceMemoRelatedSetting(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get instance memo related setting")
+		}
+		// Public memos fall back to the default visibility when they are disabled.
+		if visibility != store.Public || !instanceMemoRelatedSetting.DisallowPublicVisibility {
+			create.Visibility = visibility
+		}
+	}
+	if err := memopayload.RebuildMemoPayload(create, i.service.MarkdownService); err != nil {
+		return nil, errors.Wrap(err, "failed to rebuild memo payload")
+	}
+	missingTags := []string{}
+	for _, tag := range frontMatter.tags() {
+		if !slices.ContainsFunc(create.Payload.Tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			missingTags = append(missingTags, "#"+tag)
+		}
+	}
+	if len(missingTags) > 0 {
+		create.Content = strings.TrimRight(create.Content, "\n") + "\n\n" + strings.Join(missingTags, " ")
+		if err := memopayload.RebuildMemoPayload(create, i.service.MarkdownService); err != nil {
+			return nil, errors.Wrap(err, "failed to rebuild memo payload")
+		}
+	}
+
+	contentLengthLimit, err := i.service.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(create.Content) > contentLengthLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	}
+	create.Visibility, err = GetImportedMemoVisibility(ctx, i.service.Store, i.creator, create.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.service.checkMemoQuota(ctx, i.creator, create.Visibility); err != nil {
+		return nil, err
+	}
+	create.Payload.ImportHash = importHash
+	memo, err := i.service.Store.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create memo")
+	}
+	RecordMemoQuotaUsage(ctx, i.service.Store, i.creator.ID, memo.Visibility)
+	return memo, nil
+}
+
+// importLinkedFiles uploads the files of the archive linked or embedded in the content of a
+// markdown file as attachments, and rewrites the links to the attachments. Links to other
+// markdown files and to missing files are kept.
+func (i *memoImporter) importLinkedFiles(ctx context.Context, filename, content string) (string, []*v1pb.Attachment, error) {
+	attachments := []*v1pb.Attachment{}
+	// uploaded maps the paths of the uploaded files to the URLs of their attachments, so that a
+	// file linked twice is uploaded once.
+	uploaded := map[string]string{}
+	upload := func(file *zip.File) (string, error) {
+		if attachmentURL, ok := uploaded[file.Name]; ok {
+			return attachmentURL, nil
+		}
+		attachment, err := i.uploadAttachment(ctx, file)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to import %q", file.Name)
+		}
+		attachments = append(attachments, attachment)
+		attachmentURL := importedAttachmentURL(attachment)
+		uploaded[file.Name] = attachmentURL
+		return attachmentURL, nil
+	}
+
+	var uploadErr error
+	content = importedLinkRegexp.ReplaceAllStringFunc(content, func(link string) string {
+		match := importedLinkRegexp.FindStringSubmatch(link)
+		destination := match[3] + match[4]
+		file := i.findLinkedFile(filename, destination, false)
+		if file == nil || uploadErr != nil {
+			return link
+		}
+		attachmentURL, err := upload(file)
+		if err != nil {
+			uploadErr = err
+			return link
+		}
+		return fmt.Sprintf("%s[%s](%s%s)", match[1], match[2], attachmentURL, match[5])
+	})
+	content = importedEmbedRegexp.ReplaceAllStringFunc(content, func(embed string) string {
+		match := importedEmbedRegexp.FindStringSubmatch(embed)
+		file := i.findLinkedFile(filename, strings.TrimSpace(match[1]), true)
+		if file == nil || uploadErr != nil {
+			return embed
+		}
+		attachmentURL, err := upload(file)
+		if err != nil {
+			uploadErr = err
+			return embed
+		}
+		return fmt.Sprintf("![%s](%s)", escapeImportedLinkText(path.Base(file.Name)), attachmentURL)
+	})
+	return content, attachments, uploadErr
+}
+
+// findLinkedFile returns the file of the archive a link of a markdown file points to, or nil if
+// it's an external link, a link to another markdown file, or a missing file. Embeds fall back
+// to the files of the same name anywhere in the archive.
+func (i *memoImporter) findLinkedFile(filename, destination string, isEmbed bool) *zip.File {
+	if !isEmbed {
+		u, err := url.Parse(destination)
+		if err != nil || u.Scheme != "" || u.Host != "" || u.Path == "" || strings.HasPrefix(u.Path, "/") {
+			return nil
+		}
+		destination = u.Path
+	}
+	file, ok := i.files[path.Join(path.Dir(filename), destination)]
+	if !ok && isEmbed {
+		file, ok = i.filesByName[path.Base(destination)]
+	}
+	if !ok || isImportedMarkdownFile(file) {
+		return nil
+	}
+	return file
+}
+
+// uploadAttachment uploads a file of the archive as an attachment of the current user, with the
+// checks of CreateAttachment.
+func (i *memoImporter) uploadAttachment(ctx context.Context, file *zip.File) (*v1pb.Attachment, error) {
+	data, err := readImportedFile(file)
+	if err != nil {
+		return nil, err
+	}
+	filename := path.Base(file.Name)
+	fileType := mime.TypeByExtension(path.Ext(filename))
+	if fileType == "" {
+		fileType = http.DetectContentType(data)
+	}
+	return i.service.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: filename,
+			Type:     fileType,
+			Content:  data,
+		},
+	})
+}
+
+// importedAttachmentURL returns the URL of an imported attachment, for the links of memos.
+func importedAttachmentURL(attachment *v1pb.Attachment) string {
+	uid := strings.TrimPrefix(attachment.Name, AttachmentNamePrefix)
+	return fmt.Sprintf("/file/attachments/%s/%s", uid, url.PathEscape(attachment.Filename))
+}
+
+// deleteAttachments deletes the attachments uploaded for a file that failed to import.
+func (i *memoImporter) deleteAttachments(ctx context.Context, attachments []*v1pb.Attachment) {
+	for _, attachment := range attachments {
+		if _, err := i.service.DeleteAttachment(ctx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name}); err != nil {
+			slog.Warn("failed to delete imported attachment", "attachment", attachment.Name, "error", err)
+		}
+	}
+}
+
+// isImportedMarkdownFile reports whether a file of an archive is imported as a memo. The files in
+// hidden folders, e.g. the .obsidian and .trash folders of Obsidian vaults, are ignored.
+func isImportedMarkdownFile(file *zip.File) bool {
+	if file.FileInfo().IsDir() || isHiddenImportedFile(file) {
+		return false
+	}
+	ext := strings.ToLower(path.Ext(file.Name))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// isHiddenImportedFile reports whether a file of an archive is in a hidden folder, or is hidden.
+func isHiddenImportedFile(file *zip.File) bool {
+	for _, segment := range strings.Split(file.Name, "/") {
+		if strings.HasPrefix(segment, ".") || segment == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
+
+// readImportedFile reads a file of an archive, up to the maximum size of an archive.
+func readImportedFile(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to open file: %v", err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(io.LimitReader(reader, maxMemoImportSize+1))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read file: %v", err)
+	}
+	if len(data) > maxMemoImportSize {
+		return nil, status.Errorf(codes.InvalidArgument, "file too large")
+	}
+	return data, nil
+}
+
+// escapeImportedLinkText escapes the brackets of the text of a markdown link.
+func escapeImportedLinkText(text string) string {
+	return strings.NewReplacer(`[`, `\[`, `]`, `\]`).Replace(text)
+}
+
+// importedFrontMatter is the YAML front matter of an imported markdown file. Its fields are
+// those of exported memos, and their common Obsidian equivalents.
+type importedFrontMatter struct {
+	Created    any    `yaml:"created"`
+	Date       any    `yaml:"date"`
+	Updated    any    `yaml:"updated"`
+	Modified   any    `yaml:"modified"`
+	Tags       any    `yaml:"tags"`
+	Visibility string `yaml:"visibility"`
+	Pinned     bool   `yaml:"pinned"`
+}
+
+// parseImportedFrontMatter splits the front matter from the content of a markdown file.
+func parseImportedFrontMatter(data string) (*importedFrontMatter, string, error) {
+	frontMatter := &importedFrontMatter{}
+	data = strings.TrimPrefix(data, "\ufeff")
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	rest, ok := strings.CutPrefix(data, "---\n")
+	if !ok {
+		return frontMatter, data, nil
+	}
+	end := strings.Index(rest, "\n---\n")
+	if end < 0 {
+		if !strings.HasSuffix(rest, "\n---") {
+			return frontMatter, data, nil
+		}
+		end = len(rest) - len("\n---")
+	}
+	if err := yaml.Unmarshal([]byte(rest[:end]), frontMatter); err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "invalid front matter: %v", err)
+	}
+	content := strings.TrimPrefix(rest[end:], "\n---")
+	return frontMatter, strings.TrimLeft(content, "\n"), nil
+}
+
+func (f *importedFrontMatter) createdTime() (time.Time, bool) {
+	if t, ok := parseImportedTime(f.Created); ok {
+		return t, true
+	}
+	return parseImportedTime(f.Date)
+}
+
+func (f *importedFrontMatter) updatedTime() (time.Time, bool) {
+	if t, ok := parseImportedTime(f.Updated); ok {
+		return t, true
+	}
+	return parseImportedTime(f.Modified)
+}
+
+// tags returns the tags of the front matter without their # prefix. The tags are a list, or a
+// string of tags separated by commas or spaces.
+func (f *importedFrontMatter) tags() []string {
+	values := []string{}
+	switch tags := f.Tags.(type) {
+	case string:
+		values = strings.FieldsFunc(tags, func(r rune) bool { return r == ',' || r == ' ' })
+	case []any:
+		for _, tag := range tags {
+			if tag, ok := tag.(string); ok {
+				values = append(values, tag)
+			}
+		}
+	default:
+	}
+	tags := []string{}
+	for _, value := range values {
+		// Tags can't contain spaces.
+		tag := strings.Join(strings.Fields(strings.TrimPrefix(strings.TrimSpace(value), "#")), "-")
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func (f *importedFrontMatter) visibility() (store.Visibility, bool) {
+	switch strings.ToUpper(f.Visibility) {
+	case "PRIVATE":
+		return store.Private, true
+	case "PROTECTED":
+		return store.Protected, true
+	case "PUBLIC":
+		return store.Public, true
+	default:
+		return "", false
+	}
+}
+
+// importedTimeLayouts are the layouts of the times of front matter written as strings. Times
+// without a timezone are in UTC.
+var importedTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", time.DateOnly}
+
+// parseImportedTime parses a time of front matter, which YAML decodes as a time if it's unquoted.
+func parseImportedTime(value any) (time.Time, bool) {
+	switch value := value.(type) {
+	case time.Time:
+		return value, true
+	case string:
+		for _, layout := range importedTimeLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+				return t, true
+			}
+		}
+	default:
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,240 @@
+package v1
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// dayOneMomentRegexp matches the media embedded in the text of Day One entries, e.g.
+// ![](dayone-moment://5A2D...) for photos and ![](dayone-moment:/video/5A2D...) for the others.
+var dayOneMomentRegexp = regexp.MustCompile(`!\[[^\]]*\]\(dayone-moment:/{1,2}(?:(?:video|audio|pdfAttachment)/)?([^)\s]+)\)`)
+
+// dayOneJournal is a journal of a Day One export.
+type dayOneJournal struct {
+	// Entries are decoded one by one, so that a malformed entry doesn't fail the others.
+	Entries []json.RawMessage `json:"entries"`
+}
+
+// dayOneEntry is an entry of a Day One journal. Only its markdown text is imported, not its
+// rich text, and the fields without an equivalent in memos, e.g. the weather, are ignored.
+type dayOneEntry struct {
+	UUID           string          `json:"uuid"`
+	CreationDate   time.Time       `json:"creationDate"`
+	ModifiedDate   time.Time       `json:"modifiedDate"`
+	Text           string          `json:"text"`
+	Tags           []string        `json:"tags"`
+	Starred        bool            `json:"starred"`
+	Location       *dayOneLocation `json:"location"`
+	Photos         []*dayOneMedia  `json:"photos"`
+	Videos         []*dayOneMedia  `json:"videos"`
+	Audios         []*dayOneMedia  `json:"audios"`
+	PDFAttachments []*dayOneMedia  `json:"pdfAttachments"`
+}
+
+type dayOneLocation struct {
+	PlaceName    string  `json:"placeName"`
+	LocalityName string  `json:"localityName"`
+	Country      string  `json:"country"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+}
+
+// dayOneMedia is a photo, video, audio recording or PDF of an entry. Its file is named after
+// its MD5 hash, and the text of the entry refers to it by its identifier.
+type dayOneMedia struct {
+	Identifier string `json:"identifier"`
+	MD5        string `json:"md5"`
+}
+
+// importDayOneJournals imports the entries of the journals of a Day One export. The journals
+// are read first, to count their entries. A journal or an entry that fails is reported without
+// aborting the import of the others.
+func (i *memoImporter) importDayOneJournals(ctx context.Context, memoImport *memoImport, files []*zip.File) {
+	journals := map[*zip.File]*dayOneJournal{}
+	totalFiles := 0
+	for _, file := range files {
+		journal, err := readDayOneJournal(file)
+		if err != nil {
+			memoImport.record(file.Name, false, err)
+			totalFiles++
+			continue
+		}
+		journals[file] = journal
+		totalFiles += len(journal.Entries)
+	}
+	memoImport.mu.Lock()
+	memoImport.totalFiles = int32(totalFiles)
+	memoImport.mu.Unlock()
+
+	// The media files are named after their MD5 hashes, whatever the folder they're in.
+	media := map[string]*zip.File{}
+	for name, file := range i.files {
+		if !isImportedJSONFile(file) {
+			media[strings.ToLower(strings.TrimSuffix(path.Base(name), path.Ext(name)))] = file
+		}
+	}
+	for _, file := range files {
+		journal, ok := journals[file]
+		if !ok {
+			continue
+		}
+		for index, data := range journal.Entries {
+			entry := &dayOneEntry{}
+			err := json.Unmarshal(data, entry)
+			if err != nil {
+				err = status.Errorf(codes.InvalidArgument, "invalid entry: %v", err)
+			}
+			name := fmt.Sprintf("%s#%d", file.Name, index)
+			if entry.UUID != "" {
+				name = file.Name + "#" + entry.UUID
+			}
+			created := false
+			if err == nil {
+				created, err = i.importDayOneEntry(ctx, entry, data, media)
+			}
+			memoImport.record(name, created, err)
+		}
+	}
+}
+
+// readDayOneJournal reads a journal of a Day One export.
+func readDayOneJournal(file *zip.File) (*dayOneJournal, error) {
+	data, err := readImportedFile(file)
+	if err != nil {
+		return nil, err
+	}
+	journal := &dayOneJournal{}
+	if err := json.Unmarshal(data, journal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid journal: %v", err)
+	}
+	if journal.Entries == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not a Day One journal")
+	}
+	return journal, nil
+}
+
+// importDayOneEntry imports an entry of a Day One journal as a memo. It returns false without an
+// error if the entry was already imported. The media of the entry missing from the export, e.g.
+// the photos that weren't downloaded from the Day One servers, are left out.
+func (i *memoImporter) importDayOneEntry(ctx context.Context, entry *dayOneEntry, data []byte, media map[string]*zip.File) (bool, error) {
+	// The entries are identified by their UUIDs, so that an entry edited since it was imported
+	// isn't imported again.
+	hash := sha256.Sum256(data)
+	if entry.UUID != "" {
+		hash = sha256.Sum256([]byte("dayone:" + entry.UUID))
+	}
+	importHash := hex.EncodeToString(hash[:])
+	if i.importHashes[importHash] {
+		return false, nil
+	}
+	if entry.CreationDate.IsZero() {
+		return false, status.Errorf(codes.InvalidArgument, "missing creation date")
+	}
+	var location *v1pb.Location
+	if entry.Location != nil {
+		location = &v1pb.Location{
+			Placeholder: entry.Location.placeholder(),
+			Latitude:    entry.Location.Latitude,
+			Longitude:   entry.Location.Longitude,
+		}
+	}
+	storeLocation, err := convertLocationToStore(location)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid location: %v", err)
+	}
+
+	attachments := []*v1pb.Attachment{}
+	// embeds maps the identifiers of the media to the markdown of their attachments.
+	embeds := map[string]string{}
+	for _, item := range entry.media() {
+		file, ok := media[strings.ToLower(item.MD5)]
+		if !ok {
+			slog.Warn("missing Day One media", "entry", entry.UUID, "md5", item.MD5)
+			continue
+		}
+		attachment, err := i.uploadAttachment(ctx, file)
+		if err != nil {
+			i.deleteAttachments(ctx, attachments)
+			return false, errors.Wrapf(err, "failed to import %q", file.Name)
+		}
+		attachments = append(attachments, attachment)
+		embed := fmt.Sprintf("[%s](%s)", escapeImportedLinkText(attachment.Filename), importedAttachmentURL(attachment))
+		if strings.HasPrefix(attachment.Type, "image/") {
+			embed = fmt.Sprintf("![](%s)", importedAttachmentURL(attachment))
+		}
+		embeds[item.Identifier] = embed
+	}
+	content := dayOneMomentRegexp.ReplaceAllStringFunc(entry.Text, func(moment string) string {
+		return embeds[dayOneMomentRegexp.FindStringSubmatch(moment)[1]]
+	})
+	content = strings.TrimSpace(strings.ReplaceAll(content, "\r\n", "\n"))
+
+	tags := []any{}
+	for _, tag := range entry.Tags {
+		tags = append(tags, tag)
+	}
+	memo, err := i.createMemo(ctx, content, &importedFrontMatter{Tags: tags}, importHash)
+	if err != nil {
+		i.deleteAttachments(ctx, attachments)
+		return false, err
+	}
+	createdTsSec, updatedTsSec := entry.CreationDate.Unix(), entry.CreationDate.Unix()
+	if entry.ModifiedDate.After(entry.CreationDate) {
+		updatedTsSec = entry.ModifiedDate.Unix()
+	}
+	update := &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTsSec, UpdatedTs: &updatedTsSec}
+	if storeLocation != nil {
+		memo.Payload.Location = storeLocation
+		update.Payload = memo.Payload
+	}
+	if err := i.completeMemo(ctx, memo, update, entry.Starred, attachments); err != nil {
+		return false, err
+	}
+	i.importHashes[importHash] = true
+	return true, nil
+}
+
+// media returns the photos, videos, audio recordings and PDFs of an entry.
+func (e *dayOneEntry) media() []*dayOneMedia {
+	media := []*dayOneMedia{}
+	for _, items := range [][]*dayOneMedia{e.Photos, e.Videos, e.Audios, e.PDFAttachments} {
+		for _, item := range items {
+			if item != nil && item.MD5 != "" {
+				media = append(media, item)
+			}
+		}
+	}
+	return media
+}
+
+// placeholder returns the name of a location, from the most to the least specific part of it.
+func (l *dayOneLocation) placeholder() string {
+	parts := []string{}
+	for _, part := range []string{l.PlaceName, l.LocalityName, l.Country} {
+		if part = strings.TrimSpace(part); part != "" && !slices.Contains(parts, part) {
+			parts = append(parts, part)
+		}
+	}
+	placeholder := []rune(strings.Join(parts, ", "))
+	if len(placeholder) > maxLocationPlaceholderLength {
+		placeholder = placeholder[:maxLocationPlaceholderLength]
+	}
+	return string(placeholder)
+}
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// keepNote is a note of a Google Keep Takeout archive. The fields without an equivalent in memos,
+// e.g. the color, the annotations of links and the sharees of the notes, are ignored.
+type keepNote struct {
+	Title                   string            `json:"title"`
+	TextContent             string            `json:"textContent"`
+	ListContent             []*keepListItem   `json:"listContent"`
+	Labels                  []*keepLabel      `json:"labels"`
+	Attachments             []*keepAttachment `json:"attachments"`
+	IsPinned                bool              `json:"isPinned"`
+	IsArchived              bool              `json:"isArchived"`
+	IsTrashed               bool              `json:"isTrashed"`
+	UserEditedTimestampUsec int64             `json:"userEditedTimestampUsec"`
+	CreatedTimestampUsec    int64             `json:"createdTimestampUsec"`
+}
+
+type keepListItem struct {
+	Text      string `json:"text"`
+	IsChecked bool   `json:"isChecked"`
+}
+
+type keepLabel struct {
+	Name string `json:"name"`
+}
+
+type keepAttachment struct {
+	FilePath string `json:"filePath"`
+}
+
+// importKeepNote imports a note of a Google Keep Takeout archive as a memo. It returns false
+// without an error if the note was already imported.
+func (i *memoImporter) importKeepNote(ctx context.Context, file *zip.File) (bool, error) {
+	data, err := readImportedFile(file)
+	if err != nil {
+		return false, err
+	}
+	hash := sha256.Sum256(data)
+	importHash := hex.EncodeToString(hash[:])
+	if i.importHashes[importHash] {
+		return false, nil
+	}
+
+	note := &keepNote{}
+	if err := json.Unmarshal(data, note); err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "invalid note: %v", err)
+	}
+	// The other JSON files of a Takeout archive aren't notes.
+	if note.UserEditedTimestampUsec == 0 && note.CreatedTimestampUsec == 0 {
+		return false, status.Errorf(codes.InvalidArgument, "not a Google Keep note")
+	}
+	editedTime := time.UnixMicro(note.UserEditedTimestampUsec)
+	if note.UserEditedTimestampUsec == 0 {
+		editedTime = time.UnixMicro(note.CreatedTimestampUsec)
+	}
+
+	attachments := []*v1pb.Attachment{}
+	for _, keepAttachment := range note.Attachments {
+		attachmentFile := i.findKeepAttachment(file.Name, keepAttachment.FilePath)
+		if attachmentFile == nil {
+			i.deleteAttachments(ctx, attachments)
+			return false, status.Errorf(codes.InvalidArgument, "attachment %q not found", keepAttachment.FilePath)
+		}
+		attachment, err := i.uploadAttachment(ctx, attachmentFile)
+		if err != nil {
+			i.deleteAttachments(ctx, attachments)
+			return false, errors.Wrapf(err, "failed to import %q", attachmentFile.Name)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	labels := []any{}
+	for _, label := range note.Labels {
+		labels = append(labels, label.Name)
+	}
+	frontMatter := &importedFrontMatter{Tags: labels}
+	memo, err := i.createMemo(ctx, note.content(), frontMatter, importHash)
+	if err != nil {
+		i.deleteAttachments(ctx, attachments)
+		return false, err
+	}
+	editedTsSec := editedTime.Unix()
+	update := &store.UpdateMemo{ID: memo.ID, CreatedTs: &editedTsSec, UpdatedTs: &editedTsSec}
+	if note.IsArchived {
+		archived := store.Archived
+		update.RowStatus = &archived
+	}
+	// Trashed notes are moved to the trash when they are imported, so that they are purged with
+	// the trash rather than right away.
+	if note.IsTrashed {
+		deletedTsSec := time.Now().Unix()
+		update.DeletedTs = &deletedTsSec
+	}
+	if err := i.completeMemo(ctx, memo, update, note.IsPinned, attachments); err != nil {
+		return false, err
+	}
+	i.importHashes[importHash] = true
+	return true, nil
+}
+
+// content returns the markdown content of a note: its title as a heading, followed by its text
+// and its checklist as a task list.
+func (n *keepNote) content() string {
+	parts := []string{}
+	if title := strings.TrimSpace(n.Title); title != "" {
+		parts = append(parts, "# "+title)
+	}
+	if text := strings.TrimSpace(strings.ReplaceAll(n.TextContent, "\r\n", "\n")); text != "" {
+		parts = append(parts, text)
+	}
+	if len(n.ListContent) > 0 {
+		items := []string{}
+		for _, item := range n.ListContent {
+			checkbox := "[ ]"
+			if item.IsChecked {
+				checkbox = "[x]"
+			}
+			// Items can't span lines.
+			items = append(items, "- "+checkbox+" "+strings.Join(strings.Fields(item.Text), " "))
+		}
+		parts = append(parts, strings.Join(items, "\n"))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// findKeepAttachment returns the file of the archive attached to a note, or nil if it's missing.
+// The attachments are next to the notes, but Takeout sometimes exports them with another
+// extension than the one of their path in the note, e.g. .jpg for .jpeg.
+func (i *memoImporter) findKeepAttachment(filename, filePath string) *zip.File {
+	if file, ok := i.files[path.Join(path.Dir(filename), filePath)]; ok {
+		return file
+	}
+	if file, ok := i.filesByName[path.Base(filePath)]; ok {
+		return file
+	}
+	stem := strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))
+	for name, file := range i.files {
+		// The notes are also exported as HTML files.
+		ext := strings.ToLower(path.Ext(name))
+		if path.Dir(name) == path.Dir(filename) && strings.TrimSuffix(path.Base(name), path.Ext(name)) == stem && ext != ".json" && ext != ".html" {
+			return file
+		}
+	}
+	return nil
+}
+
+// isImportedJSONFile reports whether a file of an archive is a JSON file outside of hidden
+// folders, i.e. a note of a Google Keep Takeout archive or a journal of a Day One export.
+func isImportedJSONFile(file *zip.File) bool {
+	if file.FileInfo().IsDir() || isHiddenImportedFile(file) {
+		return false
+	}
+	return strings.ToLower(path.Ext(file.Name)) == ".json"
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxMemoMentionNotifications is the maximum number of users notified of the mentions of a memo,
+// so that a memo can't spam the whole instance.
+const maxMemoMentionNotifications = 20
+
+// notifyMemoMentions notifies the users mentioned with @username in a memo that others can see.
+// The users already mentioned in previousContent were notified before, so editing a memo only
+// notifies the users it newly mentions.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, previousContent string) error {
+	if memo.Visibility == store.Private || memo.RowStatus == store.Draft || memo.PublishTs != 0 || memo.DeletedTs != 0 {
+		return nil
+	}
+	data, err := s.MarkdownService.ExtractAll([]byte(memo.Content))
+	if err != nil {
+		return errors.Wrap(err, "failed to extract mentions")
+	}
+	var previousMentions []string
+	if previousContent != "" {
+		previousData, err := s.MarkdownService.ExtractAll([]byte(previousContent))
+		if err != nil {
+			return errors.Wrap(err, "failed to extract previous mentions")
+		}
+		previousMentions = previousData.Mentions
+	}
+
+	notified := 0
+	for _, username := range data.Mentions {
+		if notified >= maxMemoMentionNotifications {
+			slog.Warn("skipped the notifications of the other mentions of a memo", slog.String("memo", memo.UID))
+			break
+		}
+		if slices.Contains(previousMentions, username) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+		if err != nil {
+			return errors.Wrap(err, "failed to get mentioned user")
+		}
+		if user == nil || user.ID == memo.CreatorID || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+			continue
+		}
+
+		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: memo.CreatorID,
+			Type:      store.ActivityTypeMemoMention,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				MemoMention: &storepb.ActivityMemoMentionPayload{
+					MemoId: memo.ID,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		_, err = s.createNotificationInbox(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_MEMO_MENTION,
+				ActivityId: &activity.ID,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		notified++
+	}
+	return nil
+}
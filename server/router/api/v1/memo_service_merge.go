@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// defaultMemoMergeSeparator separates the target content and the source content of merged memos.
+const defaultMemoMergeSeparator = "\n\n"
+
+// MergeMemos merges the source memo into the target memo, which keeps the earlier creation time
+// of the two and the tags of both in its content. The store moves the attachments, reactions and
+// relations of the source in one transaction, so a failure leaves both memos as they were.
+//
+// Authentication: Required (the creator of both memos).
+func (s *APIV1Service) MergeMemos(ctx context.Context, request *v1pb.MergeMemosRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	targetUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	sourceUID, err := ExtractMemoUIDFromName(request.Source)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source memo name: %v", err)
+	}
+	if targetUID == sourceUID {
+		return nil, status.Errorf(codes.InvalidArgument, "a memo can't be merged into itself")
+	}
+	target, err := s.getMemoToMerge(ctx, targetUID, user)
+	if err != nil {
+		return nil, err
+	}
+	source, err := s.getMemoToMerge(ctx, sourceUID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	separator := defaultMemoMergeSeparator
+	if request.Separator != nil {
+		separator = *request.Separator
+	}
+	content := target.Content + separator + source.Content
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	}
+	if err := s.recordMemoRevisionBaseline(ctx, target); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+	}
+
+	oldContent := target.Content
+	target.Content = content
+	if err := memopayload.RebuildMemoPayload(target, s.MarkdownService); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	if target.Payload.Location == nil {
+		target.Payload.Location = source.Payload.GetLocation()
+	}
+	nowSec := time.Now().Unix()
+	target.CreatedTs = min(target.CreatedTs, source.CreatedTs)
+	target.UpdatedTs = nowSec
+	source.DeletedTs = nowSec
+	targetName, sourceName := fmt.Sprintf("%s%s", MemoNamePrefix, target.UID), fmt.Sprintf("%s%s", MemoNamePrefix, source.UID)
+	err = s.Store.MergeMemo(ctx, &store.MergeMemo{
+		Target: &store.UpdateMemo{
+			ID:        target.ID,
+			CreatedTs: &target.CreatedTs,
+			UpdatedTs: &target.UpdatedTs,
+			Content:   &target.Content,
+			Payload:   target.Payload,
+		},
+		TargetName: targetName,
+		SourceID:   source.ID,
+		SourceName: sourceName,
+		DeletedTs:  source.DeletedTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to merge memos: %v", err)
+	}
+	if err := s.syncMemoLinkRelations(ctx, target, oldContent); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo link relations: %v", err)
+	}
+
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &targetName})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &target.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	if err := s.recordMemoRevision(ctx, target, attachments, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, target, reactions, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is updated.
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	}
+	s.dispatchStoreMemoWebhook(ctx, source, webhook.EventMemoTrashed)
+	return memoMessage, nil
+}
+
+// getMemoToMerge gets a memo of the user for MergeMemos. Comments can't be merged, as they belong
+// to the memo they comment on.
+func (s *APIV1Service) getMemoToMerge(ctx context.Context, memoUID string, user *store.User) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if memo.ParentUID != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "comments can't be merged")
+	}
+	return memo, nil
+}
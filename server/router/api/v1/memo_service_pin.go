@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// MovePinnedMemo moves a pinned memo up, down or to a position among the pinned memos of its
+// creator that are neither archived nor in the trash.
+func (s *APIV1Service) MovePinnedMemo(ctx context.Context, request *v1pb.MovePinnedMemoRequest) (*v1pb.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := checkMemoUpdatePermission(memo, user); err != nil {
+		return nil, err
+	}
+	if !memo.Pinned || memo.RowStatus != store.Normal {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is not pinned")
+	}
+
+	pin := &store.PinMemo{ID: memo.ID, CreatorID: memo.CreatorID, Pinned: true}
+	switch destination := request.Destination.(type) {
+	case *v1pb.MovePinnedMemoRequest_MoveUp:
+		pin.Offset = -1
+	case *v1pb.MovePinnedMemoRequest_MoveDown:
+		pin.Offset = 1
+	case *v1pb.MovePinnedMemoRequest_Position:
+		if destination.Position < 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "position must be at least 1")
+		}
+		pin.Position = destination.Position
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "destination is required")
+	}
+	if err := s.Store.PinMemo(ctx, pin); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move pinned memo: %v", err)
+	}
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: request.Name})
+}
@@ -0,0 +1,228 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// SnoozeMemoReminder moves the reminder of a memo to a later time. A repeating reminder repeats
+// from the new reminder time.
+func (s *APIV1Service) SnoozeMemoReminder(ctx context.Context, request *v1pb.SnoozeMemoReminderRequest) (*v1pb.Memo, error) {
+	memo, err := s.getMemoForReminder(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if request.RemindTime == nil || !request.RemindTime.AsTime().After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "remind time must be in the future")
+	}
+
+	reminderTs := request.RemindTime.AsTime().Unix()
+	var reminderDeliveredTs int64
+	err = s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                  memo.ID,
+		ReminderTs:          &reminderTs,
+		ReminderDeliveredTs: &reminderDeliveredTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	}
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: request.Name})
+}
+
+// CompleteMemoReminder clears the reminder of a memo, including the next occurrences of a
+// repeating reminder.
+func (s *APIV1Service) CompleteMemoReminder(ctx context.Context, request *v1pb.CompleteMemoReminderRequest) (*v1pb.Memo, error) {
+	memo, err := s.getMemoForReminder(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var reminderTs, reminderDeliveredTs int64
+	reminderRepeat := store.MemoReminderRepeatNone
+	err = s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:                  memo.ID,
+		ReminderTs:          &reminderTs,
+		ReminderRepeat:      &reminderRepeat,
+		ReminderDeliveredTs: &reminderDeliveredTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	}
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: request.Name})
+}
+
+// DeliverMemoReminder delivers the due reminder of a memo: it creates an inbox notification for
+// the creator of the memo and dispatches the memo reminder webhook. The reminder is marked as
+// delivered first, so that it is never delivered twice even if the server stops in between. It
+// does nothing if the reminder has been rescheduled or delivered since the memo was listed.
+func (s *APIV1Service) DeliverMemoReminder(ctx context.Context, memo *store.Memo) error {
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &memo.CreatorID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user general setting")
+	}
+	nowSec := time.Now().Unix()
+	location := getUserGeneralSettingLocation(generalSetting.GetGeneral())
+	delivered, err := s.Store.DeliverMemoReminder(ctx, &store.DeliverMemoReminder{
+		ID:             memo.ID,
+		ReminderTs:     memo.ReminderTs,
+		NextReminderTs: nextMemoReminderTs(memo.ReminderTs, memo.ReminderRepeat, nowSec, location),
+		DeliveredTs:    nowSec,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to deliver memo reminder")
+	}
+	if !delivered {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoReminder,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReminder: &storepb.ActivityMemoReminderPayload{
+				MemoId:     memo.ID,
+				ReminderTs: memo.ReminderTs,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	_, err = s.createNotificationInbox(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_REMINDER,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	// The webhooks of the creator get the reminder that was delivered.
+	memo.ReminderDeliveredTs = nowSec
+	memoMessage.Reminder = convertMemoReminderFromStore(memo)
+	if err := s.DispatchMemoReminderWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo reminder webhook", slog.Any("err", err))
+	}
+	return nil
+}
+
+// getMemoForReminder returns the memo with the given name if the current user is its creator.
+func (s *APIV1Service) getMemoForReminder(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if memo.ReminderTs == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo has no reminder")
+	}
+	return memo, nil
+}
+
+// nextMemoReminderTs returns the reminder time following a delivery at afterSec: the first
+// occurrence after afterSec of a repeating reminder, or reminderTs for a reminder delivered once.
+// Occurrences keep their wall clock time in the time zone of the creator across DST changes.
+func nextMemoReminderTs(reminderTs int64, repeat store.MemoReminderRepeat, afterSec int64, location *time.Location) int64 {
+	var days int
+	switch repeat {
+	case store.MemoReminderRepeatDaily:
+		days = 1
+	case store.MemoReminderRepeatWeekly:
+		days = 7
+	default:
+		return reminderTs
+	}
+	next := time.Unix(reminderTs, 0).In(location)
+	for next.Unix() <= afterSec {
+		next = next.AddDate(0, 0, days)
+	}
+	return next.Unix()
+}
+
+// convertMemoReminderToStore converts the reminder of a memo to its reminder time and repeat, which
+// are zero if the reminder is unset.
+func convertMemoReminderToStore(reminder *v1pb.MemoReminder) (int64, store.MemoReminderRepeat, error) {
+	if reminder == nil {
+		return 0, store.MemoReminderRepeatNone, nil
+	}
+	if reminder.RemindTime == nil || reminder.RemindTime.AsTime().Unix() <= 0 {
+		return 0, store.MemoReminderRepeatNone, errors.New("remind time is required")
+	}
+	var repeat store.MemoReminderRepeat
+	switch reminder.Repeat {
+	case v1pb.MemoReminder_DAILY:
+		repeat = store.MemoReminderRepeatDaily
+	case v1pb.MemoReminder_WEEKLY:
+		repeat = store.MemoReminderRepeatWeekly
+	default:
+		repeat = store.MemoReminderRepeatNone
+	}
+	return reminder.RemindTime.AsTime().Unix(), repeat, nil
+}
+
+func convertMemoReminderFromStore(memo *store.Memo) *v1pb.MemoReminder {
+	reminder := &v1pb.MemoReminder{
+		RemindTime: timestamppb.New(time.Unix(memo.ReminderTs, 0)),
+	}
+	switch memo.ReminderRepeat {
+	case store.MemoReminderRepeatDaily:
+		reminder.Repeat = v1pb.MemoReminder_DAILY
+	case store.MemoReminderRepeatWeekly:
+		reminder.Repeat = v1pb.MemoReminder_WEEKLY
+	default:
+		reminder.Repeat = v1pb.MemoReminder_REPEAT_UNSPECIFIED
+	}
+	if memo.ReminderDeliveredTs != 0 {
+		reminder.DeliverTime = timestamppb.New(time.Unix(memo.ReminderDeliveredTs, 0))
+	}
+	return reminder
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestNextMemoReminderTs(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	reminderSec := time.Date(2024, 3, 8, 9, 0, 0, 0, newYork).Unix()
+
+	tests := []struct {
+		name     string
+		repeat   store.MemoReminderRepeat
+		after    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "a reminder delivered once doesn't move",
+			repeat:   store.MemoReminderRepeatNone,
+			after:    time.Date(2024, 3, 20, 0, 0, 0, 0, newYork),
+			expected: time.Date(2024, 3, 8, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:     "the next day",
+			repeat:   store.MemoReminderRepeatDaily,
+			after:    time.Date(2024, 3, 8, 9, 0, 30, 0, newYork),
+			expected: time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:     "the wall clock time is kept across DST",
+			repeat:   store.MemoReminderRepeatDaily,
+			after:    time.Date(2024, 3, 9, 9, 0, 30, 0, newYork),
+			expected: time.Date(2024, 3, 10, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:     "the occurrences missed while the server was down are skipped",
+			repeat:   store.MemoReminderRepeatDaily,
+			after:    time.Date(2024, 3, 12, 12, 0, 0, 0, newYork),
+			expected: time.Date(2024, 3, 13, 9, 0, 0, 0, newYork),
+		},
+		{
+			name:     "the next week",
+			repeat:   store.MemoReminderRepeatWeekly,
+			after:    time.Date(2024, 3, 8, 9, 1, 0, 0, newYork),
+			expected: time.Date(2024, 3, 15, 9, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected.Unix(), nextMemoReminderTs(reminderSec, test.repeat, test.after.Unix(), newYork))
+		})
+	}
+}
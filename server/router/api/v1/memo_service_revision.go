@@ -0,0 +1,311 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoRevisionDiffContext is the number of unchanged lines around the changes in a revision diff.
+const memoRevisionDiffContext = 1
+
+// ListMemoRevisions lists the revisions of a memo, newest first, with the diff of their content
+// against the previous revision instead of the content.
+//
+// Authentication: Required (the memo creator or an admin).
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForRevisions(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+	// The extra revision is the previous one of the last revision of the page.
+	limitPlusOne := limit + 1
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions: []*v1pb.MemoRevision{},
+	}
+	for i, revision := range revisions[:min(len(revisions), limit)] {
+		previousContent := ""
+		if i+1 < len(revisions) {
+			previousContent = revisions[i+1].Content
+		}
+		revisionMessage := convertMemoRevisionFromStore(memo, revision)
+		revisionMessage.Diff, err = diffMemoRevisionContent(previousContent, revision.Content)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to diff memo revision: %v", err)
+		}
+		response.Revisions = append(response.Revisions, revisionMessage)
+	}
+	if len(revisions) == limitPlusOne {
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	return response, nil
+}
+
+// GetMemoRevision gets a revision of a memo with its content.
+//
+// Authentication: Required (the memo creator or an admin).
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	revisionMessage := convertMemoRevisionFromStore(memo, revision)
+	revisionMessage.Content = revision.Content
+	return revisionMessage, nil
+}
+
+// RestoreMemoRevision restores the content of a memo to a revision through UpdateMemo, which
+// records it as a new revision. The attachments of the revision that still exist and aren't
+// attached to another memo are attached to the memo again, the current ones are kept.
+//
+// Authentication: Required (the memo creator or an admin).
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memo, revision, err := s.getMemoRevisionByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	attachmentMessages := []*v1pb.Attachment{}
+	attached := map[string]bool{}
+	for _, attachment := range attachments {
+		attachmentMessages = append(attachmentMessages, &v1pb.Attachment{Name: fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID)})
+		attached[attachment.UID] = true
+	}
+	for _, revisionAttachment := range revision.Payload.GetAttachments() {
+		if attached[revisionAttachment.Uid] {
+			continue
+		}
+		attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &revisionAttachment.Uid})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get attachment")
+		}
+		if attachment == nil || (attachment.MemoID != nil && *attachment.MemoID != memo.ID) {
+			continue
+		}
+		attachmentMessages = append(attachmentMessages, &v1pb.Attachment{Name: fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID)})
+		attached[attachment.UID] = true
+	}
+
+	updateMask := []string{"content"}
+	if len(attachmentMessages) > len(attachments) {
+		updateMask = append(updateMask, "attachments")
+	}
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content:     revision.Content,
+			Attachments: attachmentMessages,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+	})
+}
+
+// getMemoForRevisions returns the memo with the given UID for the revision methods, which only
+// its creator and admins may call, as the revisions may have content that has since been removed.
+func (s *APIV1Service) getMemoForRevisions(ctx context.Context, memoUID string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Scheduled memos and drafts are only visible to their creator.
+	if (memo.PublishTs != 0 || memo.RowStatus == store.Draft) && memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	return memo, nil
+}
+
+// getMemoRevisionByName returns the revision with the given name and its memo.
+func (s *APIV1Service) getMemoRevisionByName(ctx context.Context, name string) (*store.Memo, *store.MemoRevision, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisions(ctx, memoUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &revisionID, MemoID: &memo.ID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo revision")
+	}
+	if revision == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	return memo, revision, nil
+}
+
+// recordMemoRevisionBaseline records the current content and attachments of a memo before its
+// first revision, e.g. for the memos written before revisions were recorded, so that the first
+// edit has a revision to be diffed against and restored to.
+func (s *APIV1Service) recordMemoRevisionBaseline(ctx context.Context, memo *store.Memo) error {
+	one := 1
+	latest, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{MemoID: &memo.ID, Limit: &one})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo revision")
+	}
+	if latest != nil {
+		return nil
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	_, err = s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:    memo.ID,
+		CreatorID: memo.CreatorID,
+		CreatedTs: memo.UpdatedTs,
+		Content:   memo.Content,
+		Payload:   buildMemoRevisionPayload(attachments),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+	return nil
+}
+
+// recordMemoRevision records the content and attachments of a memo after an edit as its newest
+// revision, unless they are the same as the newest revision's, and prunes the oldest revisions
+// beyond the limit of the instance.
+func (s *APIV1Service) recordMemoRevision(ctx context.Context, memo *store.Memo, attachments []*store.Attachment, editorID int32) error {
+	one := 1
+	latest, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{MemoID: &memo.ID, Limit: &one})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo revision")
+	}
+	payload := buildMemoRevisionPayload(attachments)
+	if latest != nil && latest.Content == memo.Content && proto.Equal(latest.Payload, payload) {
+		return nil
+	}
+	_, err = s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:    memo.ID,
+		CreatorID: editorID,
+		CreatedTs: time.Now().Unix(),
+		Content:   memo.Content,
+		Payload:   payload,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	if err := s.Store.PruneMemoRevisions(ctx, memo.ID, int(instanceMemoRelatedSetting.MemoRevisionLimit)); err != nil {
+		return errors.Wrap(err, "failed to prune memo revisions")
+	}
+	return nil
+}
+
+func buildMemoRevisionPayload(attachments []*store.Attachment) *storepb.MemoRevisionPayload {
+	payload := &storepb.MemoRevisionPayload{}
+	for _, attachment := range attachments {
+		payload.Attachments = append(payload.Attachments, &storepb.MemoRevisionPayload_Attachment{
+			Uid:      attachment.UID,
+			Filename: attachment.Filename,
+			Type:     attachment.Type,
+		})
+	}
+	return payload
+}
+
+func convertMemoRevisionFromStore(memo *store.Memo, revision *store.MemoRevision) *v1pb.MemoRevision {
+	revisionMessage := &v1pb.MemoRevision{
+		Name:        fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, revision.ID),
+		Editor:      fmt.Sprintf("%s%d", UserNamePrefix, revision.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Attachments: []*v1pb.Attachment{},
+	}
+	for _, attachment := range revision.Payload.GetAttachments() {
+		revisionMessage.Attachments = append(revisionMessage.Attachments, &v1pb.Attachment{
+			Name:     fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.Uid),
+			Filename: attachment.Filename,
+			Type:     attachment.Type,
+		})
+	}
+	return revisionMessage
+}
+
+// diffMemoRevisionContent returns the unified diff from the previous content to the content,
+// without file headers.
+func diffMemoRevisionContent(previousContent, content string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:       splitMemoRevisionLines(previousContent),
+		B:       splitMemoRevisionLines(content),
+		Context: memoRevisionDiffContext,
+	})
+}
+
+// splitMemoRevisionLines splits content into lines that all end with a newline, as expected by
+// difflib, without the empty line difflib.SplitLines adds after a trailing newline.
+func splitMemoRevisionLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
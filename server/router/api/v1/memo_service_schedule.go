@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// PublishScheduledMemo publishes a scheduled memo at its publish time, which becomes its display
+// time, and dispatches the memo created webhook. It does nothing if the memo has been rescheduled
+// or published since it was listed.
+func (s *APIV1Service) PublishScheduledMemo(ctx context.Context, memo *store.Memo) error {
+	published, err := s.Store.PublishMemo(ctx, &store.PublishMemo{
+		ID:        memo.ID,
+		PublishTs: memo.PublishTs,
+		DisplayTs: memo.PublishTs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to publish memo")
+	}
+	if !published {
+		return nil
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	if err := s.notifyMemoMentions(ctx, memo, ""); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is published.
+	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	}
+	return nil
+}
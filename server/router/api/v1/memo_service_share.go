@@ -0,0 +1,313 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareSlugLength is the length of the random slug of a share link, long enough not to be
+// guessed.
+const memoShareSlugLength = 24
+
+// CreateMemoShareLink creates a link that shares a memo read-only with anyone who has it.
+//
+// Authentication: Required (the memo creator).
+func (s *APIV1Service) CreateMemoShareLink(ctx context.Context, request *v1pb.CreateMemoShareLinkRequest) (*v1pb.MemoShareLink, error) {
+	memo, err := s.getMemoForShareLinks(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if memo.DeletedTs != 0 || memo.PublishTs != 0 || memo.RowStatus == store.Draft {
+		return nil, status.Errorf(codes.FailedPrecondition, "memos in the trash, scheduled memos and drafts cannot be shared")
+	}
+
+	create := &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: memo.CreatorID,
+		CreatedTs: time.Now().Unix(),
+	}
+	if shareLink := request.ShareLink; shareLink != nil {
+		if shareLink.ExpireTime != nil {
+			if !shareLink.ExpireTime.AsTime().After(time.Now()) {
+				return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+			}
+			create.ExpiresTs = shareLink.ExpireTime.AsTime().Unix()
+		}
+		if shareLink.MaxViews < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "max views must not be negative")
+		}
+		create.MaxViews = shareLink.MaxViews
+		if shareLink.Passphrase != "" {
+			if create.PassphraseHash, err = hashSharePassphrase(shareLink.Passphrase); err != nil {
+				return nil, err
+			}
+		}
+	}
+	create.UID, err = util.RandomString(memoShareSlugLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate share link slug")
+	}
+
+	share, err := s.Store.CreateMemoShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create share link: %v", err)
+	}
+	return convertMemoShareLinkFromStore(memo, share), nil
+}
+
+// ListMemoShareLinks lists the share links of a memo, newest first.
+//
+// Authentication: Required (the memo creator).
+func (s *APIV1Service) ListMemoShareLinks(ctx context.Context, request *v1pb.ListMemoShareLinksRequest) (*v1pb.ListMemoShareLinksResponse, error) {
+	memo, err := s.getMemoForShareLinks(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list share links: %v", err)
+	}
+
+	response := &v1pb.ListMemoShareLinksResponse{
+		ShareLinks: []*v1pb.MemoShareLink{},
+	}
+	for _, share := range shares {
+		response.ShareLinks = append(response.ShareLinks, convertMemoShareLinkFromStore(memo, share))
+	}
+	return response, nil
+}
+
+// UpdateMemoShareLink changes the passphrase of a share link. The access tokens issued for the
+// previous passphrase stop working, and so do the failed attempts of the link.
+//
+// Authentication: Required (the memo creator).
+func (s *APIV1Service) UpdateMemoShareLink(ctx context.Context, request *v1pb.UpdateMemoShareLinkRequest) (*v1pb.MemoShareLink, error) {
+	if request.ShareLink == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "share link is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	memo, share, err := s.getMemoShareByName(ctx, request.ShareLink.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateMemoShare{ID: share.ID}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "passphrase":
+			passphraseHash := ""
+			if request.ShareLink.Passphrase != "" {
+				if passphraseHash, err = hashSharePassphrase(request.ShareLink.Passphrase); err != nil {
+					return nil, err
+				}
+			}
+			update.PassphraseHash = &passphraseHash
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path: %s", path)
+		}
+	}
+	if err := s.Store.UpdateMemoShare(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update share link: %v", err)
+	}
+	if err := auth.NewAuthenticator(s.Store, s.Secret).ResetLoginThrottle(ctx, auth.ShareLoginIdentifier(share.UID)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset passphrase attempts: %v", err)
+	}
+	share, err = s.Store.GetMemoShare(ctx, &store.FindMemoShare{ID: &share.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get share link: %v", err)
+	}
+	return convertMemoShareLinkFromStore(memo, share), nil
+}
+
+// DeleteMemoShareLink revokes a share link.
+//
+// Authentication: Required (the memo creator).
+func (s *APIV1Service) DeleteMemoShareLink(ctx context.Context, request *v1pb.DeleteMemoShareLinkRequest) (*emptypb.Empty, error) {
+	_, share, err := s.getMemoShareByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteMemoShares(ctx, &store.DeleteMemoShare{ID: &share.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete share link: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSharedMemo gets the memo of a share link whatever its visibility, and counts a view of the
+// link. The links that expired, ran out of views or were revoked are not found, as are the links
+// of memos that can no longer be shared, so that a link doesn't reveal whether its memo exists.
+// The links with a passphrase need an access token from UnlockSharedMemo.
+//
+// Authentication: Not required.
+func (s *APIV1Service) GetSharedMemo(ctx context.Context, request *v1pb.GetSharedMemoRequest) (*v1pb.Memo, error) {
+	share, memo, err := s.Store.GetActiveMemoShare(ctx, request.Slug)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get share link: %v", err)
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+	if share.PassphraseHash != "" && !auth.NewAuthenticator(s.Store, s.Secret).VerifyShareAccessToken(ctx, share, request.AccessToken) {
+		return nil, status.Errorf(codes.Unauthenticated, "passphrase required")
+	}
+	viewed, err := s.Store.ViewMemoShare(ctx, &store.ViewMemoShare{ID: share.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to view share link: %v", err)
+	}
+	if !viewed {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ContentID: &memoName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	return memoMessage, nil
+}
+
+// UnlockSharedMemo checks the passphrase of a share link and returns an access token to its memo.
+//
+// Failed attempts are counted per link with the sign-in throttle, so that a passphrase can't be
+// guessed by spreading the attempts over many clients.
+//
+// Authentication: Not required.
+func (s *APIV1Service) UnlockSharedMemo(ctx context.Context, request *v1pb.UnlockSharedMemoRequest) (*v1pb.UnlockSharedMemoResponse, error) {
+	share, _, err := s.Store.GetActiveMemoShare(ctx, request.Slug)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get share link: %v", err)
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+	if share.PassphraseHash == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "share link has no passphrase")
+	}
+
+	authenticator := auth.NewAuthenticator(s.Store, s.Secret)
+	identifier := auth.ShareLoginIdentifier(share.UID)
+	if err := authenticator.CheckLoginThrottle(ctx, identifier); err != nil {
+		return nil, convertLoginThrottleError(err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(share.PassphraseHash), []byte(request.Passphrase)) != nil {
+		if err := authenticator.RecordLoginFailure(ctx, identifier); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record passphrase failure: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect passphrase")
+	}
+	if err := authenticator.ResetLoginThrottle(ctx, identifier); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reset passphrase attempts: %v", err)
+	}
+
+	accessToken, expireTime, err := authenticator.GenerateShareAccessToken(ctx, share)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+	}
+	return &v1pb.UnlockSharedMemoResponse{
+		AccessToken: accessToken,
+		ExpireTime:  timestamppb.New(expireTime),
+	}, nil
+}
+
+// getMemoShareByName returns the share link of the given name and its memo if the current user
+// can manage the share links of the memo.
+func (s *APIV1Service) getMemoShareByName(ctx context.Context, name string) (*store.Memo, *store.MemoShare, error) {
+	memoUID, slug, err := ExtractMemoShareLinkFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid share link name: %v", err)
+	}
+	memo, err := s.getMemoForShareLinks(ctx, fmt.Sprintf("%s%s", MemoNamePrefix, memoUID))
+	if err != nil {
+		return nil, nil, err
+	}
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{UID: &slug, MemoID: &memo.ID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get share link: %v", err)
+	}
+	if share == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+	return memo, share, nil
+}
+
+// getMemoForShareLinks returns the memo of the given name if the current user can manage its
+// share links, which only its creator can.
+func (s *APIV1Service) getMemoForShareLinks(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeTrashed: true, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// hashSharePassphrase hashes the passphrase of a share link for storage.
+func hashSharePassphrase(passphrase string) (string, error) {
+	passphraseHash, err := bcrypt.GenerateFromPassword([]byte(passphrase), bcrypt.DefaultCost)
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return "", status.Errorf(codes.InvalidArgument, "passphrase must not be longer than 72 bytes")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to hash passphrase: %v", err)
+	}
+	return string(passphraseHash), nil
+}
+
+func convertMemoShareLinkFromStore(memo *store.Memo, share *store.MemoShare) *v1pb.MemoShareLink {
+	shareLink := &v1pb.MemoShareLink{
+		Name:          fmt.Sprintf("%s%s/%s%s", MemoNamePrefix, memo.UID, MemoShareLinkNamePrefix, share.UID),
+		Slug:          share.UID,
+		CreateTime:    timestamppb.New(time.Unix(share.CreatedTs, 0)),
+		MaxViews:      share.MaxViews,
+		ViewCount:     share.ViewCount,
+		HasPassphrase: share.PassphraseHash != "",
+	}
+	if share.ExpiresTs != 0 {
+		shareLink.ExpireTime = timestamppb.New(time.Unix(share.ExpiresTs, 0))
+	}
+	return shareLink
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// memoSlugMaxLength is the maximum length of a memo slug.
+	memoSlugMaxLength = 64
+
+	// memoSlugRedirectDuration is how long the previous slug of a memo keeps resolving to it, so
+	// that the links shared with it don't break immediately.
+	memoSlugRedirectDuration = 30 * 24 * time.Hour
+
+	// memoSlugSuggestionAttempts is how many numbered variants of a taken slug are tried for a
+	// suggestion.
+	memoSlugSuggestionAttempts = 20
+)
+
+// memoSlugRegex matches slugs of lowercase letters and digits, in words separated by hyphens.
+var memoSlugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// memoSlugNumberSuffixRegex matches the numbered variants of slugs suggested for taken ones.
+var memoSlugNumberSuffixRegex = regexp.MustCompile(`^(.+)-[0-9]+$`)
+
+// reservedMemoSlugs are the words used by the routes of the server and the web app, which memos
+// can't take as slugs.
+var reservedMemoSlugs = []string{
+	"403", "404", "admin", "api", "archived", "assets", "attachments", "auth", "callback", "embed",
+	"explore", "file", "forgot-password", "healthz", "inbox", "m", "memos", "reset-password", "rss",
+	"setting", "shares", "signin", "signup", "trash", "u",
+}
+
+// validateMemoSlug checks the format of a memo slug.
+func validateMemoSlug(slug string) error {
+	if len(slug) > memoSlugMaxLength {
+		return errors.Errorf("slug is too long (max %d characters)", memoSlugMaxLength)
+	}
+	if !memoSlugRegex.MatchString(slug) {
+		return errors.New("slug must be lowercase letters, digits and hyphens between them")
+	}
+	if slices.Contains(reservedMemoSlugs, slug) {
+		return errors.Errorf("slug %q is reserved", slug)
+	}
+	return nil
+}
+
+// setMemoSlug changes the slug of a memo, or removes it if slug is empty. The previous slug keeps
+// resolving to the memo for memoSlugRedirectDuration.
+//
+// A slug taken by another memo, as its slug, a previous slug or its UID, returns AlreadyExists
+// with an available variant in the message and as ErrorInfo details.
+func (s *APIV1Service) setMemoSlug(ctx context.Context, memo *store.Memo, slug string) error {
+	if slug == memo.Slug {
+		return nil
+	}
+	if slug != "" {
+		if err := validateMemoSlug(slug); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid slug: %v", err)
+		}
+		available, err := s.isMemoSlugAvailable(ctx, memo.ID, slug)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check slug: %v", err)
+		}
+		if !available {
+			return s.newMemoSlugTakenError(ctx, memo.ID, slug)
+		}
+	}
+	err := s.Store.SetMemoSlug(ctx, &store.SetMemoSlug{
+		MemoID:            memo.ID,
+		Slug:              slug,
+		RedirectExpiresTs: time.Now().Add(memoSlugRedirectDuration).Unix(),
+	})
+	if errors.Is(err, store.ErrMemoSlugTaken) {
+		return s.newMemoSlugTakenError(ctx, memo.ID, slug)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to set slug: %v", err)
+	}
+	return nil
+}
+
+// isMemoSlugAvailable returns whether a memo can take a slug: no other memo has it as its slug,
+// a previous slug or its UID, which names of memos resolve first.
+func (s *APIV1Service) isMemoSlugAvailable(ctx context.Context, memoID int32, slug string) (bool, error) {
+	for _, find := range []*store.FindMemo{{UID: &slug}, {Slug: &slug}} {
+		find.IncludeTrashed, find.IncludeScheduled, find.IncludeExpired, find.ExcludeContent = true, true, true, true
+		memo, err := s.Store.GetMemo(ctx, find)
+		if err != nil {
+			return false, err
+		}
+		if memo != nil && memo.ID != memoID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// newMemoSlugTakenError returns the AlreadyExists error of a taken slug, suggesting the first
+// available numbered variant of it: weekly-2, weekly-3, and so on.
+func (s *APIV1Service) newMemoSlugTakenError(ctx context.Context, memoID int32, slug string) error {
+	base := slug
+	if matches := memoSlugNumberSuffixRegex.FindStringSubmatch(slug); matches != nil {
+		base = matches[1]
+	}
+	suggestion := ""
+	for i := 2; i < 2+memoSlugSuggestionAttempts && suggestion == ""; i++ {
+		suffix := "-" + strconv.Itoa(i)
+		candidate := strings.TrimSuffix(base[:min(len(base), memoSlugMaxLength-len(suffix))], "-") + suffix
+		available, err := s.isMemoSlugAvailable(ctx, memoID, candidate)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check slug: %v", err)
+		}
+		if available {
+			suggestion = candidate
+		}
+	}
+	if suggestion == "" {
+		return status.Errorf(codes.AlreadyExists, "slug %q is already taken", slug)
+	}
+	st := status.Newf(codes.AlreadyExists, "slug %q is already taken, try %q", slug, suggestion)
+	errorInfo := &errdetails.ErrorInfo{
+		Reason:   "MEMO_SLUG_TAKEN",
+		Domain:   "memos",
+		Metadata: map[string]string{"suggestion": suggestion},
+	}
+	if detailed, detailErr := st.WithDetails(errorInfo); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
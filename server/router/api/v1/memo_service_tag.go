@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// renameMemoTagBatchSize is the number of memos updated in each transaction by RenameMemoTag.
+const renameMemoTagBatchSize = 100
+
+// RenameMemoTag renames a tag, with its subtags, in all memos of the current user, including
+// the memos in the trash and the scheduled memos.
+//
+// Authentication: Required.
+func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMemoTagRequest) (*v1pb.RenameMemoTagResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !s.isValidTag(request.OldTag) || !s.isValidTag(request.NewTag) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: tags cannot be empty or contain spaces")
+	}
+	if util.NormalizeTag(request.OldTag) == util.NormalizeTag(request.NewTag) {
+		return nil, status.Errorf(codes.InvalidArgument, "the new tag must be different from the old tag")
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &user.ID,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
+		Filters:          []string{fmt.Sprintf("tag in [%s]", strconv.Quote(request.OldTag))},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	changed := []*store.Memo{}
+	for _, memo := range memos {
+		content, err := s.MarkdownService.RenameTag([]byte(memo.Content), request.OldTag, request.NewTag)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rename tag: %v", err)
+		}
+		if content == memo.Content {
+			continue
+		}
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		}
+		changed = append(changed, memo)
+	}
+
+	response := &v1pb.RenameMemoTagResponse{
+		Memos:     []string{},
+		MemoCount: int32(len(changed)),
+	}
+	for _, memo := range changed {
+		response.Memos = append(response.Memos, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+	}
+	if request.ValidateOnly {
+		return response, nil
+	}
+
+	for batch := range slices.Chunk(changed, renameMemoTagBatchSize) {
+		updates := []*store.UpdateMemo{}
+		for _, memo := range batch {
+			updates = append(updates, &store.UpdateMemo{ID: memo.ID, Content: &memo.Content, Payload: memo.Payload})
+		}
+		if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+		}
+		for _, memo := range batch {
+			s.dispatchMemoTagRenamedWebhook(ctx, memo)
+		}
+	}
+	return response, nil
+}
+
+// ListTagTree lists the tags of the memos of a user as a tree. Like the tag filters, a tag
+// counts the memos of its subtags, and tags match by their normalized form.
+//
+// Authentication: Optional. Anonymous users count the public memos of the creator.
+func (s *APIV1Service) ListTagTree(ctx context.Context, request *v1pb.ListTagTreeRequest) (*v1pb.ListTagTreeResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	var creatorID int32
+	if request.Creator != "" {
+		creatorID, err = ExtractUserIDFromName(request.Creator)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid creator: %v", err)
+		}
+	} else if currentUser != nil {
+		creatorID = currentUser.ID
+	} else {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &creatorID,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		RowStatus:       &normalStatus,
+	}
+	if currentUser == nil {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	} else if currentUser.ID != creatorID {
+		memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	return &v1pb.ListTagTreeResponse{Tags: buildTagTree(memos)}, nil
+}
+
+// buildTagTree returns the top-level nodes of the tag tree of the memos. A memo counts once for
+// each tag it has, or has a subtag of: #project/alpha counts for "project" and "project/alpha".
+func buildTagTree(memos []*store.Memo) []*v1pb.TagTreeNode {
+	root := &v1pb.TagTreeNode{}
+	nodes := map[string]*v1pb.TagTreeNode{}
+	for _, memo := range memos {
+		counted := map[string]bool{}
+		for _, tag := range memo.Payload.GetTags() {
+			parent, key := root, ""
+			for _, name := range strings.Split(tag, "/") {
+				if key != "" {
+					key += "/"
+				}
+				key += util.NormalizeTag(name)
+				node, ok := nodes[key]
+				if !ok {
+					tagPath := name
+					if parent != root {
+						tagPath = parent.Tag + "/" + name
+					}
+					node = &v1pb.TagTreeNode{Tag: tagPath, Name: name, Children: []*v1pb.TagTreeNode{}}
+					nodes[key] = node
+					parent.Children = append(parent.Children, node)
+				}
+				if !counted[key] {
+					counted[key] = true
+					node.MemoCount++
+				}
+				parent = node
+			}
+		}
+	}
+	sortTagTree(root.Children)
+	return root.Children
+}
+
+func sortTagTree(nodes []*v1pb.TagTreeNode) {
+	slices.SortFunc(nodes, func(a, b *v1pb.TagTreeNode) int {
+		return strings.Compare(util.NormalizeTag(a.Tag), util.NormalizeTag(b.Tag))
+	})
+	for _, node := range nodes {
+		sortTagTree(node.Children)
+	}
+}
+
+// isValidTag reports whether tag is a single valid #tag, without the # prefix.
+func (s *APIV1Service) isValidTag(tag string) bool {
+	tags, err := s.MarkdownService.ExtractTags([]byte("#" + tag))
+	return err == nil && len(tags) == 1 && tags[0] == tag
+}
+
+// AppendMemoTags appends the tags, without the # prefix, to the end of the content of a memo,
+// leaving out those already in the content. It fails with InvalidArgument if a tag isn't valid.
+func (s *APIV1Service) AppendMemoTags(content string, tags []string) (string, error) {
+	for _, tag := range tags {
+		if !s.isValidTag(tag) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid tag %q: tags cannot be empty or contain spaces", tag)
+		}
+	}
+	content, err := s.appendMissingMemoTags(content, tags)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to append tags: %v", err)
+	}
+	return content, nil
+}
+
+// dispatchMemoTagRenamedWebhook dispatches the memo updated webhook for a memo changed by
+// RenameMemoTag. Memos in the trash are skipped, as they don't dispatch webhooks until restored.
+func (s *APIV1Service) dispatchMemoTagRenamedWebhook(ctx context.Context, memo *store.Memo) {
+	if memo.DeletedTs != 0 {
+		return
+	}
+	s.dispatchStoreMemoWebhook(ctx, memo, webhook.EventMemoUpdated)
+}
+
+// dispatchStoreMemoWebhook dispatches a memo related webhook for a memo changed in the store
+// without its message at hand. Failures are logged, as they don't fail the change.
+func (s *APIV1Service) dispatchStoreMemoWebhook(ctx context.Context, memo *store.Memo, activityType string) {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		slog.Warn("Failed to list reactions", slog.Any("err", err))
+		return
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		slog.Warn("Failed to list attachments", slog.Any("err", err))
+		return
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		slog.Warn("Failed to convert memo", slog.Any("err", err))
+		return
+	}
+	if err := s.dispatchMemoRelatedWebhook(ctx, memoMessage, activityType); err != nil {
+		slog.Warn("Failed to dispatch memo webhook", slog.String("activityType", activityType), slog.Any("err", err))
+	}
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// getMemoEtag returns the etag of the content of a memo.
+func getMemoEtag(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:8])
+}
+
+// UpdateMemoTask checks or unchecks a task of a memo. Only the checkbox of the task is rewritten,
+// so the toggle doesn't record a revision, and the webhooks get a memo updated event with the task
+// rather than the whole memo.
+//
+// Authentication: Required (the creator of the memo, or an admin).
+func (s *APIV1Service) UpdateMemoTask(ctx context.Context, request *v1pb.UpdateMemoTaskRequest) (*v1pb.UpdateMemoTaskResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	if request.Etag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "etag is required")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := checkMemoUpdatePermission(memo, user); err != nil {
+		return nil, err
+	}
+	if request.Etag != getMemoEtag(memo.Content) {
+		return nil, status.Errorf(codes.FailedPrecondition, "the memo was edited since it was read")
+	}
+
+	tasks, err := s.MarkdownService.ListTasks([]byte(memo.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+	index := -1
+	switch task := request.Task.(type) {
+	case *v1pb.UpdateMemoTaskRequest_Index:
+		if task.Index >= 0 && int(task.Index) < len(tasks) {
+			index = int(task.Index)
+		}
+	case *v1pb.UpdateMemoTaskRequest_TaskId:
+		for i, t := range tasks {
+			if t.ID == task.TaskId {
+				index = i
+				break
+			}
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "the index or the id of the task is required")
+	}
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+
+	var toggle *webhook.TaskToggle
+	if tasks[index].Checked != request.Checked {
+		content, err := s.MarkdownService.SetTaskChecked([]byte(memo.Content), index, request.Checked)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update task: %v", err)
+		}
+		oldContent := memo.Content
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		}
+		// The content is only written if it's still the one the etag was checked against, so an
+		// edit saved in the meantime isn't overwritten.
+		updatedSec := time.Now().Unix()
+		updated, err := s.Store.UpdateMemoContent(ctx, &store.UpdateMemoContent{
+			ID:         memo.ID,
+			OldContent: oldContent,
+			Content:    memo.Content,
+			Payload:    memo.Payload,
+			UpdatedTs:  updatedSec,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memo")
+		}
+		if !updated {
+			return nil, status.Errorf(codes.FailedPrecondition, "the memo was edited since it was read")
+		}
+		memo.UpdatedTs = updatedSec
+		toggle = &webhook.TaskToggle{Index: int32(index), Text: tasks[index].Text, Checked: request.Checked}
+	}
+
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &request.Name})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	if toggle != nil {
+		if err := s.DispatchMemoTaskToggledWebhook(ctx, memoMessage, toggle); err != nil {
+			slog.Warn("Failed to dispatch memo task toggled webhook", slog.Any("err", err))
+		}
+	}
+
+	tasks, err = s.MarkdownService.ListTasks([]byte(memo.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+	return &v1pb.UpdateMemoTaskResponse{
+		Memo:  memoMessage,
+		Tasks: convertMemoTasksFromMarkdown(tasks),
+	}, nil
+}
+
+// DispatchMemoTaskToggledWebhook dispatches the memo updated webhook of checking or unchecking a
+// task, with only the fields of the memo that changed with it.
+func (s *APIV1Service) DispatchMemoTaskToggledWebhook(ctx context.Context, memo *v1pb.Memo, toggle *webhook.TaskToggle) error {
+	return s.dispatchMemoWebhook(ctx, &v1pb.Memo{
+		Name:        memo.Name,
+		State:       memo.State,
+		Creator:     memo.Creator,
+		UpdateTime:  memo.UpdateTime,
+		PublishTime: memo.PublishTime,
+		Content:     memo.Content,
+		Visibility:  memo.Visibility,
+		Property:    memo.Property,
+		Etag:        memo.Etag,
+	}, webhook.EventMemoUpdated, toggle)
+}
+
+// convertTaskToggleToWebhookText returns the text of the chat messages of task toggles.
+func convertTaskToggleToWebhookText(toggle *webhook.TaskToggle) string {
+	if toggle.Checked {
+		return "☑ " + toggle.Text
+	}
+	return "☐ " + toggle.Text
+}
+
+func convertMemoTasksFromMarkdown(tasks []*markdown.Task) []*v1pb.MemoTask {
+	memoTasks := []*v1pb.MemoTask{}
+	for i, task := range tasks {
+		memoTasks = append(memoTasks, &v1pb.MemoTask{
+			Id:      task.ID,
+			Index:   int32(i),
+			Text:    task.Text,
+			Checked: task.Checked,
+		})
+	}
+	return memoTasks
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// RestoreMemo restores a memo from the trash.
+//
+// Authentication: Required (the memo creator or an admin).
+func (s *APIV1Service) RestoreMemo(ctx context.Context, request *v1pb.RestoreMemoRequest) (*v1pb.Memo, error) {
+	memo, err := s.getMemoForTrash(ctx, request.Name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedTs := int64(0)
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, DeletedTs: &deletedTs}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore memo")
+	}
+	memo.DeletedTs = 0
+
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &request.Name})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is restored.
+	if err := s.DispatchMemoRestoredWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo restored webhook", slog.Any("err", err))
+	}
+	return memoMessage, nil
+}
+
+// PurgeMemo permanently deletes a memo, whether or not it is in the trash.
+//
+// Authentication: Required (the memo creator or an admin).
+func (s *APIV1Service) PurgeMemo(ctx context.Context, request *v1pb.PurgeMemoRequest) (*emptypb.Empty, error) {
+	memo, err := s.getMemoForTrash(ctx, request.Name, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DeleteMemoPermanently(ctx, memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge memo: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getMemoForTrash returns the memo with the given name for RestoreMemo and PurgeMemo, which
+// only its creator and admins may call. If trashedOnly is set, the memo must be in the trash.
+func (s *APIV1Service) getMemoForTrash(ctx context.Context, name string, trashedOnly bool) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeTrashed: true, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if trashedOnly && memo.DeletedTs == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is not in the trash")
+	}
+	return memo, nil
+}
+
+// DeleteMemoPermanently deletes a memo with its attachments, relations, reactions, revisions and
+// comments, and dispatches the memo deleted webhook. Used by PurgeMemo and the trash purge runner.
+func (s *APIV1Service) DeleteMemoPermanently(ctx context.Context, memo *store.Memo) error {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
+		// Try to dispatch webhook when memo is permanently deleted.
+		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
+		}
+	}
+
+	// Delete memo comments, whether or not they are in the trash.
+	commentType := store.MemoRelationComment
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo comments")
+	}
+	for _, relation := range relations {
+		comment, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID, IncludeTrashed: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo comment")
+		}
+		if comment == nil {
+			continue
+		}
+		if err := s.deleteMemoData(ctx, comment); err != nil {
+			return errors.Wrap(err, "failed to delete memo comment")
+		}
+	}
+	return s.deleteMemoData(ctx, memo)
+}
+
+// deleteMemoData deletes a memo with its attachments, relations, reactions, revisions and share
+// links.
+func (s *APIV1Service) deleteMemoData(ctx context.Context, memo *store.Memo) error {
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		if err := s.Store.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete reaction")
+		}
+	}
+
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	for _, attachment := range attachments {
+		if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete attachment")
+		}
+	}
+
+	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo relations")
+	}
+	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo references")
+	}
+	if err := s.Store.DeleteMemoRevisions(ctx, &store.DeleteMemoRevision{MemoID: memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo revisions")
+	}
+	if err := s.Store.DeleteMemoShares(ctx, &store.DeleteMemoShare{MemoID: &memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo share links")
+	}
+	if err := s.Store.DeleteMemoSlugs(ctx, &store.DeleteMemoSlug{MemoID: memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo slugs")
+	}
+	if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo")
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// wikiLinkSlugSeparatorRegex matches the runs of characters of wiki link targets that separate
+// the words of slugs.
+var wikiLinkSlugSeparatorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// memoTitleHeadingRegex matches the heading syntax at the start of the title of a memo.
+var memoTitleHeadingRegex = regexp.MustCompile(`^#{1,6}\s+`)
+
+// getWikiLinkSlug returns the slug a wiki link target names, e.g. "2024-goals" for "2024 Goals".
+func getWikiLinkSlug(target string) string {
+	return strings.Trim(wikiLinkSlugSeparatorRegex.ReplaceAllString(strings.ToLower(target), "-"), "-")
+}
+
+// getMemoTitle returns the title of a memo, the first line of its content without heading syntax.
+func getMemoTitle(content string) string {
+	line, _, _ := strings.Cut(content, "\n")
+	return strings.TrimSpace(memoTitleHeadingRegex.ReplaceAllString(strings.TrimSpace(line), ""))
+}
+
+// resolveMemoWikiLinks resolves the wiki link targets of a memo. A target resolved before keeps
+// its memo while the memo exists, so that the link doesn't break when the memo's slug or title
+// changes.
+func (s *APIV1Service) resolveMemoWikiLinks(ctx context.Context, memo *store.Memo, targets []string) ([]*storepb.MemoPayload_WikiLink, error) {
+	resolved := map[string]int32{}
+	for _, wikiLink := range memo.Payload.GetWikiLinks() {
+		resolved[wikiLink.Target] = wikiLink.MemoId
+	}
+
+	wikiLinks := []*storepb.MemoPayload_WikiLink{}
+	for _, target := range targets {
+		memoID := resolved[target]
+		if memoID != 0 {
+			linkedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID, IncludeScheduled: true, ExcludeContent: true})
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get linked memo")
+			}
+			if linkedMemo == nil {
+				memoID = 0
+			}
+		}
+		if memoID == 0 {
+			var err error
+			if memoID, err = s.resolveMemoWikiLink(ctx, memo, target); err != nil {
+				return nil, err
+			}
+		}
+		wikiLinks = append(wikiLinks, &storepb.MemoPayload_WikiLink{Target: target, MemoId: memoID})
+	}
+	return wikiLinks, nil
+}
+
+// resolveMemoWikiLink returns the ID of the memo of the creator of a memo that a wiki link target
+// names, by its slug first and then by its title, or 0 if there's none. The latest memo with the
+// title is the one linked to.
+func (s *APIV1Service) resolveMemoWikiLink(ctx context.Context, memo *store.Memo, target string) (int32, error) {
+	if slug := getWikiLinkSlug(target); slug != "" {
+		linkedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{Slug: &slug, CreatorID: &memo.CreatorID, IncludeScheduled: true, ExcludeContent: true})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get memo by slug")
+		}
+		if linkedMemo != nil && linkedMemo.ID != memo.ID {
+			return linkedMemo.ID, nil
+		}
+	}
+
+	// The content of the memos is searched for the target, then their titles are compared.
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &memo.CreatorID,
+		ExcludeComments:  true,
+		IncludeScheduled: true,
+		Filters:          []string{fmt.Sprintf("content.contains(%s)", strconv.Quote(target))},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list memos by title")
+	}
+	for _, linkedMemo := range memos {
+		if linkedMemo.ID != memo.ID && getMemoTitle(linkedMemo.Content) == target {
+			return linkedMemo.ID, nil
+		}
+	}
+	return 0, nil
+}
+
+// convertMemoWikiLinksFromStore converts the wiki links of a memo, with the linked memos that the
+// current user can see.
+func (s *APIV1Service) convertMemoWikiLinksFromStore(ctx context.Context, memo *store.Memo) ([]*v1pb.Memo_WikiLink, error) {
+	memoIDs := []int32{}
+	for _, wikiLink := range memo.Payload.GetWikiLinks() {
+		if wikiLink.MemoId != 0 {
+			memoIDs = append(memoIDs, wikiLink.MemoId)
+		}
+	}
+	names := map[int32]string{}
+	if len(memoIDs) > 0 {
+		currentUser, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		find := &store.FindMemo{IDList: memoIDs, ExcludeContent: true}
+		switch {
+		case currentUser == nil:
+			find.Filters = []string{`visibility == "PUBLIC" && row_status != "DRAFT"`}
+		case currentUser.ID != memo.CreatorID:
+			find.Filters = []string{`visibility in ["PUBLIC", "PROTECTED"] && row_status != "DRAFT"`}
+		default:
+			find.IncludeScheduled = true
+		}
+		linkedMemos, err := s.Store.ListMemos(ctx, find)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list linked memos")
+		}
+		for _, linkedMemo := range linkedMemos {
+			names[linkedMemo.ID] = fmt.Sprintf("%s%s", MemoNamePrefix, linkedMemo.UID)
+		}
+	}
+
+	wikiLinks := []*v1pb.Memo_WikiLink{}
+	for _, wikiLink := range memo.Payload.GetWikiLinks() {
+		wikiLinks = append(wikiLinks, &v1pb.Memo_WikiLink{Target: wikiLink.Target, Memo: names[wikiLink.MemoId]})
+	}
+	return wikiLinks, nil
+}
+
+// CreateMemoFromWikiLink creates a private memo titled with the target of a missing wiki link of a
+// memo of the current user, and resolves the link to it, with a reference relation.
+//
+// Authentication: Required (the creator of the memo).
+func (s *APIV1Service) CreateMemoFromWikiLink(ctx context.Context, request *v1pb.CreateMemoFromWikiLinkRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, IncludeScheduled: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	index := slices.IndexFunc(memo.Payload.GetWikiLinks(), func(wikiLink *storepb.MemoPayload_WikiLink) bool {
+		return wikiLink.Target == request.Target
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the memo has no wiki link to %q", request.Target)
+	}
+	if memoID := memo.Payload.WikiLinks[index].MemoId; memoID != 0 {
+		linkedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID, IncludeScheduled: true, ExcludeContent: true})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get linked memo")
+		}
+		if linkedMemo != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "the wiki link to %q isn't missing", request.Target)
+		}
+	}
+
+	created, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "# " + request.Target,
+		Visibility: v1pb.Visibility_PRIVATE,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	// The link resolves to the created memo by its title.
+	if err := s.syncMemoLinkRelations(ctx, memo, memo.Content); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync memo link relations: %v", err)
+	}
+	return created, nil
+}
@@ -0,0 +1,440 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxMemoTemplatesPerUser is the maximum number of memo templates of a user.
+const maxMemoTemplatesPerUser = 50
+
+// memoTemplatePlaceholderRegexp matches the placeholders of memo templates, e.g. {{date}}.
+var memoTemplatePlaceholderRegexp = regexp.MustCompile(`\{\{\s*([a-z]+)\s*\}\}`)
+
+// Helper function to extract user ID and memo template ID from memo template resource name.
+// Format: users/{user}/memoTemplates/{memo_template}.
+func extractUserAndMemoTemplateIDFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "memoTemplates" {
+		return 0, "", errors.Errorf("invalid memo template name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+
+	memoTemplateID := parts[3]
+	if memoTemplateID == "" {
+		return 0, "", errors.Errorf("empty memo template ID in name: %s", name)
+	}
+
+	return userID, memoTemplateID, nil
+}
+
+// Helper function to construct memo template resource name.
+func constructMemoTemplateName(userID int32, memoTemplateID string) string {
+	return fmt.Sprintf("users/%d/memoTemplates/%s", userID, memoTemplateID)
+}
+
+func (s *APIV1Service) ListMemoTemplates(ctx context.Context, request *v1pb.ListMemoTemplatesRequest) (*v1pb.ListMemoTemplatesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	userSetting, err := s.getMemoTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	return &v1pb.ListMemoTemplatesResponse{
+		MemoTemplates: convertMemoTemplatesFromStore(userID, userSetting.GetMemoTemplates().GetTemplates()),
+	}, nil
+}
+
+func (s *APIV1Service) GetMemoTemplate(ctx context.Context, request *v1pb.GetMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	userID, memoTemplateID, err := extractUserAndMemoTemplateIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	userSetting, err := s.getMemoTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	for _, memoTemplate := range userSetting.GetMemoTemplates().GetTemplates() {
+		if memoTemplate.GetId() == memoTemplateID {
+			return convertMemoTemplateFromStore(userID, memoTemplate), nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "memo template not found")
+}
+
+func (s *APIV1Service) CreateMemoTemplate(ctx context.Context, request *v1pb.CreateMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.MemoTemplate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo template is required")
+	}
+
+	newMemoTemplate := &storepb.MemoTemplatesUserSetting_MemoTemplate{
+		Id:      util.GenUUID(),
+		Title:   strings.TrimSpace(request.MemoTemplate.GetTitle()),
+		Content: request.MemoTemplate.GetContent(),
+		Tags:    normalizeMemoTemplateTags(request.MemoTemplate.GetTags()),
+	}
+	if request.MemoTemplate.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		newMemoTemplate.Visibility = convertVisibilityToStore(request.MemoTemplate.Visibility).String()
+	}
+	if newMemoTemplate.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title is required")
+	}
+
+	userSetting, err := s.getMemoTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	memoTemplatesUserSetting := userSetting.GetMemoTemplates()
+	if len(memoTemplatesUserSetting.GetTemplates()) >= maxMemoTemplatesPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "a user can have at most %d memo templates", maxMemoTemplatesPerUser)
+	}
+	memoTemplatesUserSetting.Templates = append(memoTemplatesUserSetting.Templates, newMemoTemplate)
+	_, err = s.Store.UpsertUserSetting(ctx, userSetting)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo templates: %v", err)
+	}
+
+	return convertMemoTemplateFromStore(userID, newMemoTemplate), nil
+}
+
+func (s *APIV1Service) UpdateMemoTemplate(ctx context.Context, request *v1pb.UpdateMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	if request.MemoTemplate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo template is required")
+	}
+	userID, memoTemplateID, err := extractUserAndMemoTemplateIDFromName(request.MemoTemplate.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	userSetting, err := s.getMemoTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	var foundMemoTemplate *storepb.MemoTemplatesUserSetting_MemoTemplate
+	for _, memoTemplate := range userSetting.GetMemoTemplates().GetTemplates() {
+		if memoTemplate.GetId() == memoTemplateID {
+			foundMemoTemplate = memoTemplate
+			break
+		}
+	}
+	if foundMemoTemplate == nil {
+		return nil, status.Errorf(codes.NotFound, "memo template not found")
+	}
+
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			title := strings.TrimSpace(request.MemoTemplate.GetTitle())
+			if title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "title is required")
+			}
+			foundMemoTemplate.Title = title
+		case "content":
+			foundMemoTemplate.Content = request.MemoTemplate.GetContent()
+		case "tags":
+			foundMemoTemplate.Tags = normalizeMemoTemplateTags(request.MemoTemplate.GetTags())
+		case "visibility":
+			foundMemoTemplate.Visibility = ""
+			if request.MemoTemplate.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+				foundMemoTemplate.Visibility = convertVisibilityToStore(request.MemoTemplate.Visibility).String()
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	_, err = s.Store.UpsertUserSetting(ctx, userSetting)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo templates: %v", err)
+	}
+
+	return convertMemoTemplateFromStore(userID, foundMemoTemplate), nil
+}
+
+func (s *APIV1Service) DeleteMemoTemplate(ctx context.Context, request *v1pb.DeleteMemoTemplateRequest) (*emptypb.Empty, error) {
+	userID, memoTemplateID, err := extractUserAndMemoTemplateIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	userSetting, err := s.getMemoTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	memoTemplatesUserSetting := userSetting.GetMemoTemplates()
+	memoTemplates := make([]*storepb.MemoTemplatesUserSetting_MemoTemplate, 0, len(memoTemplatesUserSetting.GetTemplates()))
+	for _, memoTemplate := range memoTemplatesUserSetting.GetTemplates() {
+		if memoTemplate.GetId() != memoTemplateID {
+			memoTemplates = append(memoTemplates, memoTemplate)
+		}
+	}
+	if len(memoTemplates) == len(memoTemplatesUserSetting.GetTemplates()) {
+		return nil, status.Errorf(codes.NotFound, "memo template not found")
+	}
+	memoTemplatesUserSetting.Templates = memoTemplates
+	_, err = s.Store.UpsertUserSetting(ctx, userSetting)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo templates: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ReorderMemoTemplates(ctx context.Context, request *v1pb.ReorderMemoTemplatesRequest) (*v1pb.ListMemoTemplatesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkMemoTemplateOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	userSetting, err := s.getMemoTemplatesUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo templates: %v", err)
+	}
+	memoTemplatesUserSetting := userSetting.GetMemoTemplates()
+	memoTemplateByID := map[string]*storepb.MemoTemplatesUserSetting_MemoTemplate{}
+	for _, memoTemplate := range memoTemplatesUserSetting.GetTemplates() {
+		memoTemplateByID[memoTemplate.GetId()] = memoTemplate
+	}
+	// The new order must list every memo template of the user exactly once.
+	if len(request.Names) != len(memoTemplateByID) {
+		return nil, status.Errorf(codes.InvalidArgument, "names must list all the %d memo templates of the user", len(memoTemplateByID))
+	}
+	memoTemplates := make([]*storepb.MemoTemplatesUserSetting_MemoTemplate, 0, len(request.Names))
+	for _, name := range request.Names {
+		nameUserID, memoTemplateID, err := extractUserAndMemoTemplateIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+		}
+		memoTemplate, ok := memoTemplateByID[memoTemplateID]
+		if nameUserID != userID || !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "memo template %s is unknown or listed more than once", name)
+		}
+		delete(memoTemplateByID, memoTemplateID)
+		memoTemplates = append(memoTemplates, memoTemplate)
+	}
+	memoTemplatesUserSetting.Templates = memoTemplates
+	_, err = s.Store.UpsertUserSetting(ctx, userSetting)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert memo templates: %v", err)
+	}
+
+	return &v1pb.ListMemoTemplatesResponse{
+		MemoTemplates: convertMemoTemplatesFromStore(userID, memoTemplates),
+	}, nil
+}
+
+func (s *APIV1Service) CreateMemoFromTemplate(ctx context.Context, request *v1pb.CreateMemoFromTemplateRequest) (*v1pb.Memo, error) {
+	memoTemplate, err := s.GetMemoTemplate(ctx, &v1pb.GetMemoTemplateRequest{Name: request.Name})
+	if err != nil {
+		return nil, err
+	}
+	userID, _, err := extractUserAndMemoTemplateIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+	}
+
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	location := getUserGeneralSettingLocation(generalSetting.GetGeneral())
+	memo, err := s.newMemoFromTemplate(memoTemplate, generalSetting.GetGeneral(), time.Now().In(location))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to extract tags: %v", err)
+	}
+	return s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: memo})
+}
+
+// newMemoFromTemplate returns the memo to create from a memo template at the given time, which is in
+// the time zone of the placeholders.
+func (s *APIV1Service) newMemoFromTemplate(memoTemplate *v1pb.MemoTemplate, generalSetting *storepb.GeneralUserSetting, now time.Time) (*v1pb.Memo, error) {
+	content, err := s.appendMissingMemoTags(expandMemoTemplatePlaceholders(memoTemplate.Content, now), memoTemplate.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := memoTemplate.Visibility
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility = v1pb.Visibility_PRIVATE
+		if memoVisibility := generalSetting.GetMemoVisibility(); memoVisibility != "" {
+			visibility = convertVisibilityFromStore(store.Visibility(memoVisibility))
+		}
+	}
+	return &v1pb.Memo{
+		Content:    content,
+		Visibility: visibility,
+	}, nil
+}
+
+// appendMissingMemoTags appends the tags not already in the content to its end.
+func (s *APIV1Service) appendMissingMemoTags(content string, tags []string) (string, error) {
+	missingTags, err := s.getMissingMemoTemplateTags(content, tags)
+	if err != nil {
+		return "", err
+	}
+	if len(missingTags) == 0 {
+		return content, nil
+	}
+	hashtags := make([]string, 0, len(missingTags))
+	for _, tag := range missingTags {
+		hashtags = append(hashtags, "#"+tag)
+	}
+	content = strings.TrimRight(content, "\n")
+	if content != "" {
+		content += "\n\n"
+	}
+	return content + strings.Join(hashtags, " "), nil
+}
+
+// expandMemoTemplatePlaceholders expands the placeholders of a memo template content at the given
+// time, which is in the time zone of the user. Unknown placeholders are kept as is.
+func expandMemoTemplatePlaceholders(content string, now time.Time) string {
+	return memoTemplatePlaceholderRegexp.ReplaceAllStringFunc(content, func(placeholder string) string {
+		switch memoTemplatePlaceholderRegexp.FindStringSubmatch(placeholder)[1] {
+		case "date":
+			return now.Format("2006-01-02")
+		case "time":
+			return now.Format("15:04")
+		case "weekday":
+			return now.Weekday().String()
+		default:
+			return placeholder
+		}
+	})
+}
+
+// getMissingMemoTemplateTags returns the tags of a memo template not already in the content.
+func (s *APIV1Service) getMissingMemoTemplateTags(content string, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	contentTags, err := s.MarkdownService.ExtractTags([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for _, tag := range contentTags {
+		existing[util.NormalizeTag(tag)] = true
+	}
+	missingTags := []string{}
+	for _, tag := range tags {
+		if !existing[util.NormalizeTag(tag)] {
+			missingTags = append(missingTags, tag)
+		}
+	}
+	return missingTags, nil
+}
+
+func (s *APIV1Service) checkMemoTemplateOwner(ctx context.Context, userID int32) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// getMemoTemplatesUserSetting returns the memo templates setting of a user, or an empty one.
+func (s *APIV1Service) getMemoTemplatesUserSetting(ctx context.Context, userID int32) (*storepb.UserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_MEMO_TEMPLATES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetMemoTemplates() == nil {
+		userSetting = &storepb.UserSetting{
+			UserId: userID,
+			Key:    storepb.UserSetting_MEMO_TEMPLATES,
+			Value: &storepb.UserSetting_MemoTemplates{
+				MemoTemplates: &storepb.MemoTemplatesUserSetting{},
+			},
+		}
+	}
+	return userSetting, nil
+}
+
+// normalizeMemoTemplateTags trims the # prefix and the spaces of the tags and drops the empty ones.
+func normalizeMemoTemplateTags(tags []string) []string {
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+func convertMemoTemplatesFromStore(userID int32, memoTemplates []*storepb.MemoTemplatesUserSetting_MemoTemplate) []*v1pb.MemoTemplate {
+	list := make([]*v1pb.MemoTemplate, 0, len(memoTemplates))
+	for _, memoTemplate := range memoTemplates {
+		list = append(list, convertMemoTemplateFromStore(userID, memoTemplate))
+	}
+	return list
+}
+
+func convertMemoTemplateFromStore(userID int32, memoTemplate *storepb.MemoTemplatesUserSetting_MemoTemplate) *v1pb.MemoTemplate {
+	visibility := v1pb.Visibility_VISIBILITY_UNSPECIFIED
+	if memoTemplate.GetVisibility() != "" {
+		visibility = convertVisibilityFromStore(store.Visibility(memoTemplate.GetVisibility()))
+	}
+	return &v1pb.MemoTemplate{
+		Name:       constructMemoTemplateName(userID, memoTemplate.GetId()),
+		Title:      memoTemplate.GetTitle(),
+		Content:    memoTemplate.GetContent(),
+		Tags:       memoTemplate.GetTags(),
+		Visibility: visibility,
+	}
+}
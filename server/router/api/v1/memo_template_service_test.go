@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandMemoTemplatePlaceholders(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 23:30 UTC on Sunday, 2024-03-31.
+	beforeMidnightUTC := time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		content  string
+		now      time.Time
+		expected string
+	}{
+		{
+			name:     "all placeholders",
+			content:  "# {{date}} {{time}} ({{weekday}})",
+			now:      time.Date(2024, 1, 15, 9, 5, 0, 0, time.UTC),
+			expected: "# 2024-01-15 09:05 (Monday)",
+		},
+		{
+			name:     "spaces inside the braces",
+			content:  "{{ date }}",
+			now:      time.Date(2024, 1, 15, 9, 5, 0, 0, time.UTC),
+			expected: "2024-01-15",
+		},
+		{
+			name:     "unknown placeholders are kept",
+			content:  "{{date}} {{author}} {{DATE}} {date}",
+			now:      time.Date(2024, 1, 15, 9, 5, 0, 0, time.UTC),
+			expected: "2024-01-15 {{author}} {{DATE}} {date}",
+		},
+		{
+			name:     "no placeholders",
+			content:  "plain content",
+			now:      time.Date(2024, 1, 15, 9, 5, 0, 0, time.UTC),
+			expected: "plain content",
+		},
+		{
+			name:     "before midnight in UTC",
+			content:  "{{date}} {{time}} {{weekday}}",
+			now:      beforeMidnightUTC,
+			expected: "2024-03-31 23:30 Sunday",
+		},
+		{
+			name:     "the next day east of UTC",
+			content:  "{{date}} {{time}} {{weekday}}",
+			now:      beforeMidnightUTC.In(tokyo),
+			expected: "2024-04-01 08:30 Monday",
+		},
+		{
+			name:     "the same day west of UTC",
+			content:  "{{date}} {{time}} {{weekday}}",
+			now:      beforeMidnightUTC.In(newYork),
+			expected: "2024-03-31 19:30 Sunday",
+		},
+		{
+			name:     "the previous day west of UTC just after midnight in UTC",
+			content:  "{{date}} {{time}} {{weekday}}",
+			now:      time.Date(2024, 4, 1, 0, 15, 0, 0, time.UTC).In(newYork),
+			expected: "2024-03-31 20:15 Sunday",
+		},
+		{
+			name:     "midnight in the user time zone",
+			content:  "{{date}} {{time}}",
+			now:      time.Date(2024, 3, 31, 15, 0, 0, 0, time.UTC).In(tokyo),
+			expected: "2024-04-01 00:00",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, expandMemoTemplatePlaceholders(test.content, test.now))
+		})
+	}
+}
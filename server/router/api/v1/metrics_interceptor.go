@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/metrics"
+)
+
+// MetricsInterceptor records the Connect requests in the RPC metrics, with the gRPC names of their
+// codes like the gRPC requests.
+type MetricsInterceptor struct{}
+
+// NewMetricsInterceptor creates a new metrics interceptor.
+func NewMetricsInterceptor() *MetricsInterceptor {
+	return &MetricsInterceptor{}
+}
+
+func (*MetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+		code := codes.OK
+		if err != nil {
+			code = codes.Code(connect.CodeOf(err))
+		}
+		metrics.ObserveRPC(req.Spec().Procedure, code.String(), start)
+		return resp, err
+	}
+}
+
+func (*MetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*MetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// MetricsUnaryInterceptor records the gRPC requests, including those of the gateway, in the RPC
+// metrics.
+func MetricsUnaryInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, request)
+	metrics.ObserveRPC(serverInfo.FullMethod, status.Code(err).String(), start)
+	return resp, err
+}
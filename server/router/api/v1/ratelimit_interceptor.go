@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"net"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/ratelimit"
+)
+
+// RateLimitInterceptor limits the Connect requests by the API rate limits. It must run after the
+// AuthInterceptor, to charge the requests to their users.
+type RateLimitInterceptor struct {
+	rateLimitService *ratelimit.Service
+}
+
+// NewRateLimitInterceptor creates a new rate limit interceptor.
+func NewRateLimitInterceptor(rateLimitService *ratelimit.Service) *RateLimitInterceptor {
+	return &RateLimitInterceptor{rateLimitService: rateLimitService}
+}
+
+func (in *RateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if exceeded := in.rateLimitService.Check(ctx, auth.GetUserID(ctx), getClientIP(ctx), isWriteMethod(procedure)); exceeded != nil {
+			connectErr := connect.NewError(connect.CodeResourceExhausted, exceeded)
+			connectErr.Meta().Set("Retry-After", exceeded.RetryAfterHeader())
+			return nil, connectErr
+		}
+		return next(ctx, req)
+	}
+}
+
+func (*RateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*RateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// NewRateLimitUnaryInterceptor limits the gRPC requests, including those of the gateway, by the
+// API rate limits. It must be chained after the authentication interceptor.
+func NewRateLimitUnaryInterceptor(rateLimitService *ratelimit.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if exceeded := rateLimitService.Check(ctx, auth.GetUserID(ctx), getClientIP(ctx), isWriteMethod(serverInfo.FullMethod)); exceeded != nil {
+			// The gateway forwards the header as Retry-After, see retryAfterHeaderMatcher.
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", exceeded.RetryAfterHeader()))
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", exceeded)
+		}
+		return handler(ctx, request)
+	}
+}
+
+// retryAfterHeaderMatcher forwards the retry-after header of the rate limited gRPC responses as
+// the Retry-After header of the gateway responses.
+func retryAfterHeaderMatcher(key string) (string, bool) {
+	if key == "retry-after" {
+		return "Retry-After", true
+	}
+	// The other headers keep the default prefix of the gateway.
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// isWriteMethod returns whether a method changes data, charged to the write bucket of the client.
+func isWriteMethod(procedure string) bool {
+	return auth.RequiredScope(procedure) == auth.ScopeWrite
+}
+
+// getClientIP returns the IP of the client of a request, from the proxy headers or else the peer
+// address of the native gRPC clients.
+func getClientIP(ctx context.Context) string {
+	if ip, _ := auth.ExtractClientFromContext(ctx); ip != "" {
+		return ip
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+	}
+	return ""
+}
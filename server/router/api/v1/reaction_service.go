@@ -3,14 +3,18 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -48,6 +52,9 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
+	if err := s.notifyMemoReaction(ctx, reaction); err != nil {
+		slog.Warn("Failed to notify memo reaction", slog.Any("err", err))
+	}
 
 	reactionMessage := convertReactionFromStore(reaction)
 
@@ -93,6 +100,53 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 	return &emptypb.Empty{}, nil
 }
 
+// notifyMemoReaction notifies the creator of a memo of a reaction of another user to it.
+func (s *APIV1Service) notifyMemoReaction(ctx context.Context, reaction *store.Reaction) error {
+	if !strings.HasPrefix(reaction.ContentID, MemoNamePrefix) {
+		// Not a reaction to a memo.
+		return nil
+	}
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentID)
+	if err != nil {
+		return errors.Wrap(err, "invalid memo name")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, ExcludeContent: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || memo.CreatorID == reaction.CreatorID {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: reaction.CreatorID,
+		Type:      store.ActivityTypeMemoReaction,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReaction: &storepb.ActivityMemoReactionPayload{
+				MemoId:       memo.ID,
+				ReactionType: reaction.ReactionType,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	_, err = s.createNotificationInbox(ctx, &store.Inbox{
+		SenderID:   reaction.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_REACTION,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
 func convertReactionFromStore(reaction *store.Reaction) *v1pb.Reaction {
 	reactionUID := fmt.Sprintf("%d", reaction.ID)
 	return &v1pb.Reaction{
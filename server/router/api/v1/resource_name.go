@@ -13,12 +13,17 @@ const (
 	InstanceSettingNamePrefix  = "instance/settings/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
+	MemoRevisionNamePrefix     = "revisions/"
+	MemoShareLinkNamePrefix    = "shareLinks/"
+	MemoImportNamePrefix       = "memoImports/"
 	AttachmentNamePrefix       = "attachments/"
 	ReactionNamePrefix         = "reactions/"
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identity-providers/"
 	ActivityNamePrefix         = "activities/"
 	WebhookNamePrefix          = "webhooks/"
+	AuditLogNamePrefix         = "auditLogs/"
+	SigningKeyNamePrefix       = "signingKeys/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -95,6 +100,49 @@ func ExtractMemoUIDFromName(name string) (string, error) {
 	return id, nil
 }
 
+// ExtractMemoImportIDFromName returns the ID from a memo import resource name.
+func ExtractMemoImportIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, MemoImportNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// ExtractMemoRevisionFromName returns the memo UID and the revision ID from a revision resource name.
+// e.g., "memos/uuid/revisions/123" -> "uuid", 123.
+func ExtractMemoRevisionFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo revision ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractMemoShareLinkFromName returns the memo UID and the slug from a share link resource name.
+// e.g., "memos/uuid/shareLinks/slug" -> "uuid", "slug".
+func ExtractMemoShareLinkFromName(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoShareLinkNamePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
+// ExtractSigningKeyIDFromName returns the key ID from a signing key resource name.
+// e.g., "signingKeys/v2" -> "v2".
+func ExtractSigningKeyIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, SigningKeyNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 // ExtractAttachmentUIDFromName returns the attachment UID from a resource name.
 func ExtractAttachmentUIDFromName(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, AttachmentNamePrefix)
@@ -155,3 +203,53 @@ func ExtractActivityIDFromName(name string) (int32, error) {
 	}
 	return id, nil
 }
+
+// ExtractUserIDFromTwoFactorName returns the user ID from a two-factor authentication resource name.
+// e.g., "users/101/twoFactor" -> 101.
+func ExtractUserIDFromTwoFactorName(name string) (int32, error) {
+	userName, found := strings.CutSuffix(name, "/twoFactor")
+	if !found {
+		return 0, errors.Errorf("invalid two-factor name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// ExtractUserIDFromFeedTokenName returns the user ID from a feed token resource name.
+// e.g., "users/101/feedToken" -> 101.
+func ExtractUserIDFromFeedTokenName(name string) (int32, error) {
+	userName, found := strings.CutSuffix(name, "/feedToken")
+	if !found {
+		return 0, errors.Errorf("invalid feed token name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// ExtractUserIDFromInboundEmailName returns the user ID from an inbound email resource name.
+// e.g., "users/101/inboundEmail" -> 101.
+func ExtractUserIDFromInboundEmailName(name string) (int32, error) {
+	userName, found := strings.CutSuffix(name, "/inboundEmail")
+	if !found {
+		return 0, errors.Errorf("invalid inbound email name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// ExtractUserIDFromSlackLinkName returns the user ID from a Slack link resource name.
+// e.g., "users/101/slackLink" -> 101.
+func ExtractUserIDFromSlackLinkName(name string) (int32, error) {
+	userName, found := strings.CutSuffix(name, "/slackLink")
+	if !found {
+		return 0, errors.Errorf("invalid Slack link name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// ExtractUserIDFromMatrixLinkName returns the user ID from a Matrix link resource name.
+// e.g., "users/101/matrixLink" -> 101.
+func ExtractUserIDFromMatrixLinkName(name string) (int32, error) {
+	userName, found := strings.CutSuffix(name, "/matrixLink")
+	if !found {
+		return 0, errors.Errorf("invalid Matrix link name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
@@ -17,6 +17,13 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+const (
+	// maxShortcutsPerUser is the maximum number of shortcuts of a user.
+	maxShortcutsPerUser = 50
+	// shortcutMemoCountLimit is the number of memos the memo counts of shortcuts are capped at.
+	shortcutMemoCountLimit = 1000
+)
+
 // Helper function to extract user ID and shortcut ID from shortcut resource name.
 // Format: users/{user}/shortcuts/{shortcut}.
 func extractUserAndShortcutIDFromName(name string) (int32, string, error) {
@@ -43,6 +50,15 @@ func constructShortcutName(userID int32, shortcutID string) string {
 	return fmt.Sprintf("users/%d/shortcuts/%s", userID, shortcutID)
 }
 
+func convertShortcutFromStore(userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) *v1pb.Shortcut {
+	return &v1pb.Shortcut{
+		Name:    constructShortcutName(userID, shortcut.GetId()),
+		Title:   shortcut.GetTitle(),
+		Filter:  shortcut.GetFilter(),
+		OrderBy: shortcut.GetOrderBy(),
+	}
+}
+
 func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -73,11 +89,7 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShor
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	shortcuts := []*v1pb.Shortcut{}
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
-		shortcuts = append(shortcuts, &v1pb.Shortcut{
-			Name:   constructShortcutName(userID, shortcut.GetId()),
-			Title:  shortcut.GetTitle(),
-			Filter: shortcut.GetFilter(),
-		})
+		shortcuts = append(shortcuts, convertShortcutFromStore(userID, shortcut))
 	}
 
 	return &v1pb.ListShortcutsResponse{
@@ -113,11 +125,7 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
 		if shortcut.GetId() == shortcutID {
-			return &v1pb.Shortcut{
-				Name:   constructShortcutName(userID, shortcut.GetId()),
-				Title:  shortcut.GetTitle(),
-				Filter: shortcut.GetFilter(),
-			}, nil
+			return convertShortcutFromStore(userID, shortcut), nil
 		}
 	}
 
@@ -139,9 +147,10 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	}
 
 	newShortcut := &storepb.ShortcutsUserSetting_Shortcut{
-		Id:     util.GenUUID(),
-		Title:  request.Shortcut.GetTitle(),
-		Filter: request.Shortcut.GetFilter(),
+		Id:      util.GenUUID(),
+		Title:   request.Shortcut.GetTitle(),
+		Filter:  request.Shortcut.GetFilter(),
+		OrderBy: strings.TrimSpace(request.Shortcut.GetOrderBy()),
 	}
 	if newShortcut.Title == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "title is required")
@@ -149,12 +158,11 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	if err := s.validateFilter(ctx, newShortcut.Filter); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	if err := s.validateShortcutOrderBy(newShortcut.OrderBy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
+	}
 	if request.ValidateOnly {
-		return &v1pb.Shortcut{
-			Name:   constructShortcutName(userID, newShortcut.GetId()),
-			Title:  newShortcut.GetTitle(),
-			Filter: newShortcut.GetFilter(),
-		}, nil
+		return convertShortcutFromStore(userID, newShortcut), nil
 	}
 
 	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
@@ -177,6 +185,9 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	}
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	shortcuts := shortcutsUserSetting.GetShortcuts()
+	if len(shortcuts) >= maxShortcutsPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "a user can have at most %d shortcuts", maxShortcutsPerUser)
+	}
 	shortcuts = append(shortcuts, newShortcut)
 	shortcutsUserSetting.Shortcuts = shortcuts
 
@@ -189,11 +200,7 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Name:   constructShortcutName(userID, newShortcut.GetId()),
-		Title:  newShortcut.GetTitle(),
-		Filter: newShortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(userID, newShortcut), nil
 }
 
 func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
@@ -242,6 +249,12 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 						return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 					}
 					shortcut.Filter = request.Shortcut.GetFilter()
+				} else if field == "order_by" {
+					orderBy := strings.TrimSpace(request.Shortcut.GetOrderBy())
+					if err := s.validateShortcutOrderBy(orderBy); err != nil {
+						return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
+					}
+					shortcut.OrderBy = orderBy
 				}
 			}
 		}
@@ -261,11 +274,7 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Name:   constructShortcutName(userID, foundShortcut.GetId()),
-		Title:  foundShortcut.GetTitle(),
-		Filter: foundShortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(userID, foundShortcut), nil
 }
 
 func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
@@ -319,6 +328,122 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) ReorderShortcuts(ctx context.Context, request *v1pb.ReorderShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shortcutByID := map[string]*storepb.ShortcutsUserSetting_Shortcut{}
+	for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+		shortcutByID[shortcut.GetId()] = shortcut
+	}
+	// The new order must list every shortcut of the user exactly once.
+	if len(request.Names) != len(shortcutByID) {
+		return nil, status.Errorf(codes.InvalidArgument, "names must list all the %d shortcuts of the user", len(shortcutByID))
+	}
+	if len(shortcutByID) == 0 {
+		return &v1pb.ListShortcutsResponse{Shortcuts: []*v1pb.Shortcut{}}, nil
+	}
+	shortcuts := make([]*storepb.ShortcutsUserSetting_Shortcut, 0, len(request.Names))
+	for _, name := range request.Names {
+		nameUserID, shortcutID, err := extractUserAndShortcutIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut name: %v", err)
+		}
+		shortcut, ok := shortcutByID[shortcutID]
+		if nameUserID != userID || !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "shortcut %s is unknown or listed more than once", name)
+		}
+		delete(shortcutByID, shortcutID)
+		shortcuts = append(shortcuts, shortcut)
+	}
+	userSetting.GetShortcuts().Shortcuts = shortcuts
+	_, err = s.Store.UpsertUserSetting(ctx, userSetting)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ListShortcutsResponse{Shortcuts: []*v1pb.Shortcut{}}
+	for _, shortcut := range shortcuts {
+		response.Shortcuts = append(response.Shortcuts, convertShortcutFromStore(userID, shortcut))
+	}
+	return response, nil
+}
+
+// CountShortcutMemos counts the memos of the user matching each shortcut, as listed on the home
+// page: the user's own memos, neither archived, scheduled nor comments. A shortcut whose filter
+// no longer compiles counts no memos, so that a broken shortcut doesn't fail the others.
+func (s *APIV1Service) CountShortcutMemos(ctx context.Context, request *v1pb.CountShortcutMemosRequest) (*v1pb.CountShortcutMemosResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.CountShortcutMemosResponse{MemoCounts: map[string]int32{}}
+	for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+		name := constructShortcutName(userID, shortcut.GetId())
+		if err := s.validateFilter(ctx, shortcut.GetFilter()); err != nil {
+			response.MemoCounts[name] = 0
+			continue
+		}
+		state := store.Normal
+		limit := shortcutMemoCountLimit
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			CreatorID:       &userID,
+			RowStatus:       &state,
+			ExcludeComments: true,
+			ExcludeContent:  true,
+			Filters:         []string{shortcut.GetFilter()},
+			FilterViewerID:  userID,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count the memos of shortcut %s: %v", name, err)
+		}
+		response.MemoCounts[name] = int32(len(memos))
+	}
+	return response, nil
+}
+
+// validateShortcutOrderBy checks the order_by of a shortcut, which may be empty.
+func (s *APIV1Service) validateShortcutOrderBy(orderBy string) error {
+	if orderBy == "" {
+		return nil
+	}
+	return s.parseMemoOrderBy(orderBy, &store.FindMemo{})
+}
+
 func (s *APIV1Service) validateFilter(ctx context.Context, filterStr string) error {
 	if filterStr == "" {
 		return errors.New("filter cannot be empty")
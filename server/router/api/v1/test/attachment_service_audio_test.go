@@ -0,0 +1,77 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// mp4Box returns an MP4 box of content.
+func mp4Box(boxType string, content ...[]byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, 0)
+	b = append(b, boxType...)
+	b = append(b, bytes.Join(content, nil)...)
+	binary.BigEndian.PutUint32(b, uint32(len(b)))
+	return b
+}
+
+// encodeM4A encodes an M4A file whose movie header has the duration, without audio.
+func encodeM4A(duration time.Duration) []byte {
+	const timescale = 1000
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], timescale)
+	binary.BigEndian.PutUint32(mvhd[16:], uint32(duration.Milliseconds()))
+	return bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("M4A \x00\x00\x00\x00")),
+		mp4Box("moov", mp4Box("mvhd", mvhd)),
+		mp4Box("mdat", make([]byte, 256)),
+	}, nil)
+}
+
+func TestCreateAttachmentReadsAudioDuration(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	t.Run("the duration of audio files is returned", func(t *testing.T) {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "voice.m4a", Type: "audio/x-m4a", Content: encodeM4A(42500 * time.Millisecond)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 42500*time.Millisecond, attachment.Duration.AsDuration())
+
+		// The duration is kept with the attachment.
+		attachment, err = ts.Service.GetAttachment(userCtx, &v1pb.GetAttachmentRequest{Name: attachment.Name})
+		require.NoError(t, err)
+		require.Equal(t, 42500*time.Millisecond, attachment.Duration.AsDuration())
+	})
+
+	t.Run("corrupt audio files are uploaded without a duration", func(t *testing.T) {
+		content := []byte("not really an mp3")
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "broken.mp3", Type: "audio/mpeg", Content: content},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(len(content)), attachment.Size)
+		require.Nil(t, attachment.Duration)
+	})
+
+	t.Run("other files have no duration", func(t *testing.T) {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "voice.mp4", Type: "video/mp4", Content: encodeM4A(time.Second)},
+		})
+		require.NoError(t, err)
+		require.Nil(t, attachment.Duration)
+	})
+}
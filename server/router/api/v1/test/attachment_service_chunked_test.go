@@ -0,0 +1,289 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// chunkedUploadContent is the content of the chunked uploads, of two full chunks and a last
+// smaller one.
+var chunkedUploadContent = bytes.Repeat([]byte("0123456789abcdef"), (17<<20)/16)
+
+func sha256Hex(blob []byte) string {
+	sum := sha256.Sum256(blob)
+	return hex.EncodeToString(sum[:])
+}
+
+// chunkedUploadClient runs the chunked uploads of a user.
+type chunkedUploadClient struct {
+	t   *testing.T
+	ts  *TestService
+	ctx context.Context
+}
+
+func (c *chunkedUploadClient) create(filename string, content []byte, contentHash string) (*v1pb.ChunkedUpload, error) {
+	return c.ts.Service.CreateChunkedUpload(c.ctx, &v1pb.CreateChunkedUploadRequest{
+		Attachment:  &v1pb.Attachment{Filename: filename, Type: "application/octet-stream"},
+		Size:        int64(len(content)),
+		ContentHash: contentHash,
+	})
+}
+
+func (c *chunkedUploadClient) uploadChunk(upload *v1pb.ChunkedUpload, content []byte, index int32) error {
+	start := int64(index) * upload.ChunkSize
+	chunk := content[start:min(start+upload.ChunkSize, int64(len(content)))]
+	_, err := c.ts.Service.UploadAttachmentChunk(c.ctx, &v1pb.UploadAttachmentChunkRequest{
+		Name:     upload.Name,
+		Index:    index,
+		Content:  chunk,
+		Checksum: sha256Hex(chunk),
+	})
+	return err
+}
+
+func (c *chunkedUploadClient) missingChunks(upload *v1pb.ChunkedUpload) []int32 {
+	status, err := c.ts.Service.GetChunkedUpload(c.ctx, &v1pb.GetChunkedUploadRequest{Name: upload.Name})
+	require.NoError(c.t, err)
+	return status.MissingChunks
+}
+
+func (c *chunkedUploadClient) complete(upload *v1pb.ChunkedUpload) (*v1pb.Attachment, error) {
+	return c.ts.Service.CompleteChunkedUpload(c.ctx, &v1pb.CompleteChunkedUploadRequest{Name: upload.Name})
+}
+
+func TestChunkedUploadLocal(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	c := &chunkedUploadClient{t: t, ts: ts, ctx: ts.CreateUserContext(ctx, user.ID)}
+	assetsDir := t.TempDir()
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:       storepb.InstanceStorageSetting_LOCAL,
+			FilepathTemplate:  filepath.ToSlash(filepath.Join(assetsDir, "{uuid}_{filename}")),
+			UploadSizeLimitMb: 32,
+		}},
+	})
+	require.NoError(t, err)
+	contentHash := sha256Hex(chunkedUploadContent)
+	pendingAttachment := func() *store.Attachment {
+		attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{CreatorID: &user.ID, Pending: true})
+		require.NoError(t, err)
+		require.NotNil(t, attachment)
+		return attachment
+	}
+
+	t.Run("invalid uploads are rejected", func(t *testing.T) {
+		_, err := c.create("large.bin", chunkedUploadContent, "hash")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = c.create("large.bin", bytes.Repeat([]byte("a"), 33<<20), contentHash)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("the chunks are assembled into the local file", func(t *testing.T) {
+		upload, err := c.create("large.bin", chunkedUploadContent, contentHash)
+		require.NoError(t, err)
+		require.Equal(t, int64(8<<20), upload.ChunkSize)
+		require.Equal(t, int32(3), upload.ChunkCount)
+		require.Equal(t, []int32{0, 1, 2}, upload.MissingChunks)
+		require.WithinDuration(t, time.Now().Add(48*time.Hour), upload.ExpireTime.AsTime(), 5*time.Second)
+
+		// The chunks may be uploaded in any order.
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 2))
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 0))
+		require.Equal(t, []int32{1}, c.missingChunks(upload))
+		_, err = c.complete(upload)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = ts.Service.UploadAttachmentChunk(c.ctx, &v1pb.UploadAttachmentChunkRequest{
+			Name:     upload.Name,
+			Index:    1,
+			Content:  chunkedUploadContent[8<<20 : 16<<20],
+			Checksum: sha256Hex([]byte("corrupted")),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, codes.InvalidArgument, status.Code(c.uploadChunk(upload, chunkedUploadContent[:len(chunkedUploadContent)-1], 2)))
+		_, err = ts.Service.UploadAttachmentChunk(c.ctx, &v1pb.UploadAttachmentChunkRequest{
+			Name:     upload.Name,
+			Index:    3,
+			Content:  []byte("extra"),
+			Checksum: sha256Hex([]byte("extra")),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 1))
+		require.Empty(t, c.missingChunks(upload))
+		pending := pendingAttachment()
+		attachment, err := c.complete(upload)
+		require.NoError(t, err)
+		require.Equal(t, upload.Name, attachment.Name)
+		require.Equal(t, int64(len(chunkedUploadContent)), attachment.Size)
+
+		list, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{CreatorID: &user.ID, ContentHash: &contentHash})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, storepb.AttachmentStorageType_LOCAL, list[0].StorageType)
+		require.Nil(t, list[0].Payload.GetChunkedUpload())
+		blob, err := os.ReadFile(list[0].Reference)
+		require.NoError(t, err)
+		require.Equal(t, chunkedUploadContent, blob)
+		// The chunks are deleted once assembled.
+		chunkSizes, err := ts.Store.ListAttachmentChunks(pending)
+		require.NoError(t, err)
+		require.Empty(t, chunkSizes)
+
+		usedBytes, err := ts.Store.GetUserStorageUsage(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(chunkedUploadContent)), usedBytes)
+	})
+
+	t.Run("an identical upload shares the local file", func(t *testing.T) {
+		upload, err := c.create("copy.bin", chunkedUploadContent, contentHash)
+		require.NoError(t, err)
+		for index := int32(0); index < upload.ChunkCount; index++ {
+			require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, index))
+		}
+		_, err = c.complete(upload)
+		require.NoError(t, err)
+		list, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{CreatorID: &user.ID, ContentHash: &contentHash})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, list[0].Reference, list[1].Reference)
+		entries, err := os.ReadDir(assetsDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+
+	t.Run("an upload with another content hash is discarded", func(t *testing.T) {
+		upload, err := c.create("other.bin", chunkedUploadContent, sha256Hex([]byte("other")))
+		require.NoError(t, err)
+		for index := int32(0); index < upload.ChunkCount; index++ {
+			require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, index))
+		}
+		_, err = c.complete(upload)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = c.complete(upload)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		entries, err := os.ReadDir(assetsDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+
+	t.Run("abandoned uploads are purged", func(t *testing.T) {
+		upload, err := c.create("abandoned.bin", chunkedUploadContent, contentHash)
+		require.NoError(t, err)
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 0))
+		pending := pendingAttachment()
+		chunkSizes, err := ts.Store.ListAttachmentChunks(pending)
+		require.NoError(t, err)
+		require.Len(t, chunkSizes, 1)
+
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		_, err = ts.Service.GetChunkedUpload(ts.CreateUserContext(ctx, other.ID), &v1pb.GetChunkedUploadRequest{Name: upload.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		purged, err := ts.Store.PurgePendingAttachments(ctx, time.Now().Add(time.Hour).Unix())
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+		chunkSizes, err = ts.Store.ListAttachmentChunks(pending)
+		require.NoError(t, err)
+		require.Empty(t, chunkSizes)
+		_, err = ts.Service.GetChunkedUpload(c.ctx, &v1pb.GetChunkedUploadRequest{Name: upload.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestChunkedUploadS3(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	c := &chunkedUploadClient{t: t, ts: ts, ctx: ts.CreateUserContext(ctx, user.ID)}
+	s3 := newFakeS3()
+	server := httptest.NewServer(s3)
+	defer server.Close()
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:       storepb.InstanceStorageSetting_S3,
+			FilepathTemplate:  "uploads/{uuid}_{filename}",
+			UploadSizeLimitMb: 32,
+			S3Config: &storepb.StorageS3Config{
+				AccessKeyId:     "key",
+				AccessKeySecret: "secret",
+				Endpoint:        server.URL,
+				Region:          "us-east-1",
+				Bucket:          "memos",
+				UsePathStyle:    true,
+			},
+		}},
+	})
+	require.NoError(t, err)
+	contentHash := sha256Hex(chunkedUploadContent)
+
+	t.Run("the chunks are uploaded as the parts of a multipart upload", func(t *testing.T) {
+		upload, err := c.create("large.bin", chunkedUploadContent, contentHash)
+		require.NoError(t, err)
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 1))
+		require.Equal(t, []int32{0, 2}, c.missingChunks(upload))
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 0))
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 2))
+		require.Empty(t, c.missingChunks(upload))
+
+		attachment, err := c.complete(upload)
+		require.NoError(t, err)
+		require.Contains(t, attachment.ExternalLink, server.URL+"/memos/uploads/")
+		require.Equal(t, 1, s3.count())
+		for _, object := range s3.objects {
+			require.Equal(t, chunkedUploadContent, object)
+		}
+	})
+
+	t.Run("an upload with another content hash is discarded", func(t *testing.T) {
+		upload, err := c.create("other.bin", chunkedUploadContent, sha256Hex([]byte("other")))
+		require.NoError(t, err)
+		for index := int32(0); index < upload.ChunkCount; index++ {
+			require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, index))
+		}
+		_, err = c.complete(upload)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, 1, s3.count())
+	})
+
+	t.Run("abandoned uploads are aborted", func(t *testing.T) {
+		upload, err := c.create("abandoned.bin", chunkedUploadContent, contentHash)
+		require.NoError(t, err)
+		require.NoError(t, c.uploadChunk(upload, chunkedUploadContent, 0))
+		require.Len(t, s3.uploads, 1)
+
+		purged, err := ts.Store.PurgePendingAttachments(ctx, time.Now().Add(time.Hour).Unix())
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+		require.Empty(t, s3.uploads)
+		require.Equal(t, 1, s3.count())
+	})
+}
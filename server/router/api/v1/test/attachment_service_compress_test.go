@@ -0,0 +1,102 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// encodeNoisyPNG encodes a PNG of noisy gradients, which compresses as badly as a photo.
+func encodeNoisyPNG(t *testing.T, width, height int, alpha uint8) []byte {
+	random := rand.New(rand.NewSource(1))
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			noise := uint8(random.Intn(16))
+			img.Set(x, y, color.NRGBA{R: uint8(x) + noise, G: uint8(y) + noise, B: 128 + noise, A: alpha})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestCreateAttachmentCompressesImages(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:      storepb.InstanceStorageSetting_LOCAL,
+			FilepathTemplate: filepath.ToSlash(filepath.Join(t.TempDir(), "{uuid}_{filename}")),
+			ImageCompression: &storepb.StorageImageCompressionConfig{
+				Enabled:      true,
+				MaxDimension: 600,
+				ThresholdKb:  64,
+			},
+		}},
+	})
+	require.NoError(t, err)
+	upload := func(filename string, content []byte) *v1pb.Attachment {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: filename, Type: "image/png", Content: content},
+		})
+		require.NoError(t, err)
+		return attachment
+	}
+
+	t.Run("large images are compressed", func(t *testing.T) {
+		photo := encodeNoisyPNG(t, 1200, 800, 0xff)
+		attachment := upload("photo.png", photo)
+		require.Contains(t, []string{"image/webp", "image/jpeg"}, attachment.Type)
+		require.True(t, strings.HasPrefix(attachment.Filename, "photo."))
+		require.NotEqual(t, "photo.png", attachment.Filename)
+		require.Equal(t, int64(len(photo)), attachment.OriginalSize)
+		require.Less(t, attachment.Size, attachment.OriginalSize)
+
+		uid := strings.TrimPrefix(attachment.Name, apiv1.AttachmentNamePrefix)
+		stored, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid})
+		require.NoError(t, err)
+		blob, err := ts.Service.GetAttachmentBlob(stored)
+		require.NoError(t, err)
+		config, _, err := image.DecodeConfig(bytes.NewReader(blob))
+		require.NoError(t, err)
+		require.Equal(t, image.Point{600, 400}, image.Point{config.Width, config.Height})
+	})
+
+	t.Run("small images are kept as uploaded", func(t *testing.T) {
+		icon := encodeNoisyPNG(t, 32, 32, 0xff)
+		attachment := upload("icon.png", icon)
+		require.Equal(t, "image/png", attachment.Type)
+		require.Equal(t, "icon.png", attachment.Filename)
+		require.Equal(t, int64(len(icon)), attachment.Size)
+		require.Zero(t, attachment.OriginalSize)
+	})
+
+	t.Run("transparent images are kept as uploaded", func(t *testing.T) {
+		overlay := encodeNoisyPNG(t, 400, 400, 0x80)
+		attachment := upload("overlay.png", overlay)
+		require.Equal(t, "image/png", attachment.Type)
+		require.Equal(t, int64(len(overlay)), attachment.Size)
+		require.Zero(t, attachment.OriginalSize)
+	})
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestCreateExternalAttachment(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for _, externalLink := range []string{
+		"file:///etc/passwd",
+		"ftp://example.com/report.pdf",
+		"http://127.0.0.1/report.pdf",
+		"http://10.0.0.1/report.pdf",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/report.pdf",
+	} {
+		t.Run(externalLink, func(t *testing.T) {
+			_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+				Attachment: &v1pb.Attachment{Filename: "report.pdf", Type: "application/pdf", ExternalLink: externalLink},
+			})
+			require.Error(t, err)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	t.Run("external attachments have no content", func(t *testing.T) {
+		_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "report.pdf", Type: "application/pdf", ExternalLink: "https://example.com/report.pdf", Content: []byte("%PDF")},
+		})
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestRefreshExternalAttachment(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("notes")},
+	})
+	require.NoError(t, err)
+
+	t.Run("uploaded attachments can't be refreshed", func(t *testing.T) {
+		_, err := ts.Service.RefreshExternalAttachment(userCtx, &v1pb.RefreshExternalAttachmentRequest{Name: attachment.Name})
+		require.Error(t, err)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("only the creator can refresh an attachment", func(t *testing.T) {
+		_, err := ts.Service.RefreshExternalAttachment(otherCtx, &v1pb.RefreshExternalAttachmentRequest{Name: attachment.Name})
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
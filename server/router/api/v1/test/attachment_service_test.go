@@ -0,0 +1,363 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/thumbnail"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestPurgeOrphanedAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	library, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "library.txt", Type: "text/plain", Content: []byte("library"), Library: true},
+	})
+	require.NoError(t, err)
+	require.True(t, library.Library)
+	orphan, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "orphan.txt", Type: "text/plain", Content: []byte("orphan")},
+	})
+	require.NoError(t, err)
+	require.False(t, orphan.Library)
+
+	orphan.Library = true
+	updated, err := ts.Service.UpdateAttachment(userCtx, &v1pb.UpdateAttachmentRequest{
+		Attachment: orphan,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"library"}},
+	})
+	require.NoError(t, err)
+	require.True(t, updated.Library)
+
+	// Attachments within the grace period are kept.
+	resp, err := ts.Service.PurgeOrphanedAttachments(hostCtx, &v1pb.PurgeOrphanedAttachmentsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, resp.PurgedCount)
+	require.Zero(t, resp.ReclaimedBytes)
+
+	_, err = ts.Service.PurgeOrphanedAttachments(userCtx, &v1pb.PurgeOrphanedAttachmentsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.PurgeOrphanedAttachments(ctx, &v1pb.PurgeOrphanedAttachmentsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestCreateAttachmentThumbnails(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	getStoreAttachment := func(name string) *store.Attachment {
+		uid := name[len("attachments/"):]
+		attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid})
+		require.NoError(t, err)
+		return attachment
+	}
+
+	t.Run("thumbnails are generated on upload", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1200, 600))))
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: buf.Bytes()},
+		})
+		require.NoError(t, err)
+		blob, err := ts.Store.GetAttachmentThumbnail(ctx, getStoreAttachment(attachment.Name), thumbnail.Small)
+		require.NoError(t, err)
+		config, err := png.DecodeConfig(bytes.NewReader(blob))
+		require.NoError(t, err)
+		require.Equal(t, 300, config.Width)
+	})
+
+	t.Run("a corrupt image is uploaded without thumbnails", func(t *testing.T) {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "corrupt.png", Type: "image/png", Content: []byte("not an image")},
+		})
+		require.NoError(t, err)
+		blob, err := ts.Store.GetAttachmentThumbnail(ctx, getStoreAttachment(attachment.Name), thumbnail.Medium)
+		require.NoError(t, err)
+		require.Nil(t, blob)
+	})
+}
+
+func TestCreateAttachmentStripImageMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The PNG image has a tEXt chunk, with the serial number of the camera, after its IHDR chunk.
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 40, 30))))
+	encoded := buf.Bytes()
+	text := []byte("\x00\x00\x00\x0etEXtSerial\x00SN-0042")
+	text = binary.BigEndian.AppendUint32(text, crc32.ChecksumIEEE(text[4:]))
+	content := append(append(bytes.Clone(encoded[:33]), text...), encoded[33:]...)
+
+	upload := func() *store.Attachment {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: content},
+		})
+		require.NoError(t, err)
+		uid := attachment.Name[len("attachments/"):]
+		stored, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid, GetBlob: true})
+		require.NoError(t, err)
+		require.Equal(t, attachment.Size, stored.Size)
+		return stored
+	}
+
+	t.Run("metadata is kept by default", func(t *testing.T) {
+		stored := upload()
+		require.Equal(t, content, stored.Blob)
+	})
+
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:        storepb.InstanceStorageSetting_DATABASE,
+			StripImageMetadata: true,
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Run("metadata is stripped before the blob is stored", func(t *testing.T) {
+		stored := upload()
+		require.Equal(t, encoded, stored.Blob)
+		require.Equal(t, int64(len(encoded)), stored.Size)
+	})
+
+	t.Run("the user setting overrides the instance setting", func(t *testing.T) {
+		stripImageMetadata := false
+		_, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+				Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+					StripImageMetadata: &stripImageMetadata,
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"stripImageMetadata"}},
+		})
+		require.NoError(t, err)
+		stored := upload()
+		require.Equal(t, content, stored.Blob)
+	})
+}
+
+func TestDeduplicateAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The files are stored in a temporary directory.
+	dir := t.TempDir()
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:      storepb.InstanceStorageSetting_LOCAL,
+			FilepathTemplate: filepath.ToSlash(filepath.Join(dir, "{uuid}_{filename}")),
+		}},
+	})
+	require.NoError(t, err)
+
+	upload := func(ctx context.Context, content string) *store.Attachment {
+		attachment, err := ts.Service.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "screenshot.txt", Type: "text/plain", Content: []byte(content)},
+		})
+		require.NoError(t, err)
+		uid := attachment.Name[len("attachments/"):]
+		stored, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid})
+		require.NoError(t, err)
+		return stored
+	}
+	fileExists := func(attachment *store.Attachment) bool {
+		_, err := os.Stat(filepath.FromSlash(attachment.Reference))
+		return err == nil
+	}
+	deleteAttachment := func(attachment *store.Attachment) {
+		_, err := ts.Service.DeleteAttachment(userCtx, &v1pb.DeleteAttachmentRequest{Name: "attachments/" + attachment.UID})
+		require.NoError(t, err)
+	}
+
+	t.Run("identical uploads of a user share their file", func(t *testing.T) {
+		first := upload(userCtx, "screenshot")
+		second := upload(userCtx, "screenshot")
+		require.Equal(t, store.HashAttachmentBlob([]byte("screenshot")), first.ContentHash)
+		require.Equal(t, first.Reference, second.Reference)
+		other := upload(userCtx, "another screenshot")
+		require.NotEqual(t, first.Reference, other.Reference)
+		// The uploads of other users aren't shared.
+		hostUpload := upload(hostCtx, "screenshot")
+		require.NotEqual(t, first.Reference, hostUpload.Reference)
+
+		// The file is deleted with the last attachment referencing it.
+		deleteAttachment(first)
+		require.True(t, fileExists(second))
+		deleteAttachment(second)
+		require.False(t, fileExists(second))
+	})
+
+	t.Run("existing files are deduplicated", func(t *testing.T) {
+		// The attachments were uploaded before the content hashes.
+		attachments := []*store.Attachment{}
+		for i := range 3 {
+			reference := filepath.Join(dir, fmt.Sprintf("old_%d.txt", i))
+			require.NoError(t, os.WriteFile(reference, []byte("old screenshot"), 0644))
+			attachment, err := ts.Store.CreateAttachment(ctx, &store.Attachment{
+				UID:         fmt.Sprintf("old-%d", i),
+				CreatorID:   user.ID,
+				Filename:    "old.txt",
+				Type:        "text/plain",
+				Size:        int64(len("old screenshot")),
+				StorageType: storepb.AttachmentStorageType_LOCAL,
+				Reference:   filepath.ToSlash(reference),
+			})
+			require.NoError(t, err)
+			attachments = append(attachments, attachment)
+		}
+
+		_, err := ts.Service.DeduplicateAttachments(userCtx, &v1pb.DeduplicateAttachmentsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		resp, err := ts.Service.DeduplicateAttachments(hostCtx, &v1pb.DeduplicateAttachmentsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int32(3), resp.HashedCount)
+		require.Equal(t, int32(2), resp.DeduplicatedCount)
+		require.Equal(t, int64(2*len("old screenshot")), resp.SavedBytes)
+
+		for _, attachment := range attachments {
+			stored, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+			require.NoError(t, err)
+			require.Equal(t, attachments[0].Reference, stored.Reference)
+			require.Equal(t, store.HashAttachmentBlob([]byte("old screenshot")), stored.ContentHash)
+		}
+		require.True(t, fileExists(attachments[0]))
+		require.False(t, fileExists(attachments[1]))
+		require.False(t, fileExists(attachments[2]))
+
+		// Deduplicating again has nothing to do.
+		resp, err = ts.Service.DeduplicateAttachments(hostCtx, &v1pb.DeduplicateAttachmentsRequest{})
+		require.NoError(t, err)
+		require.Zero(t, resp.HashedCount)
+		require.Zero(t, resp.DeduplicatedCount)
+	})
+}
+
+func TestListAttachmentsFilter(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	create := func(userCtx context.Context, filename, attachmentType string, size int, memo *string) {
+		_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: filename, Type: attachmentType, Content: bytes.Repeat([]byte{'x'}, size), Memo: memo},
+		})
+		require.NoError(t, err)
+	}
+	create(userCtx, "March report.pdf", "application/pdf", 3000, nil)
+	create(userCtx, "photo.png", "image/png", 2000, &memo.Name)
+	create(userCtx, "scan.jpg", "image/jpeg", 1000, nil)
+	create(hostCtx, "host.pdf", "application/pdf", 3000, nil)
+
+	list := func(t *testing.T, userCtx context.Context, request *v1pb.ListAttachmentsRequest) ([]string, *v1pb.ListAttachmentsResponse) {
+		resp, err := ts.Service.ListAttachments(userCtx, request)
+		require.NoError(t, err)
+		filenames := []string{}
+		for _, attachment := range resp.Attachments {
+			filenames = append(filenames, attachment.Filename)
+		}
+		return filenames, resp
+	}
+
+	t.Run("filters", func(t *testing.T) {
+		filenames, _ := list(t, userCtx, &v1pb.ListAttachmentsRequest{Filter: `filename.contains("report")`})
+		require.Equal(t, []string{"March report.pdf"}, filenames)
+		filenames, _ = list(t, userCtx, &v1pb.ListAttachmentsRequest{Filter: `mime_type.startsWith("image/") && size > 1000`})
+		require.Equal(t, []string{"photo.png"}, filenames)
+		filenames, _ = list(t, userCtx, &v1pb.ListAttachmentsRequest{Filter: `!has_memo && size <= 3000 && create_time >= timestamp("2020-01-01T00:00:00Z")`})
+		require.ElementsMatch(t, []string{"March report.pdf", "scan.jpg"}, filenames)
+		filenames, resp := list(t, userCtx, &v1pb.ListAttachmentsRequest{Filter: `has_memo`})
+		require.Equal(t, []string{"photo.png"}, filenames)
+		// The attachments carry their memo.
+		require.Equal(t, memo.Name, resp.Attachments[0].GetMemo())
+		filenames, _ = list(t, userCtx, &v1pb.ListAttachmentsRequest{Filter: `create_time < timestamp("2020-01-01T00:00:00Z")`})
+		require.Empty(t, filenames)
+
+		for _, filter := range []string{`filename == "a"`, `mime_type.contains("image")`, `size > "1"`, `has_memo || size > 1`, `create_time > timestamp("yesterday")`} {
+			_, err := ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{Filter: filter})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		first, resp := list(t, userCtx, &v1pb.ListAttachmentsRequest{PageSize: 2})
+		require.Len(t, first, 2)
+		require.Equal(t, int32(3), resp.TotalSize)
+		require.NotEmpty(t, resp.NextPageToken)
+		second, resp := list(t, userCtx, &v1pb.ListAttachmentsRequest{PageSize: 2, PageToken: resp.NextPageToken})
+		require.Len(t, second, 1)
+		require.Equal(t, int32(3), resp.TotalSize)
+		require.Empty(t, resp.NextPageToken)
+		require.ElementsMatch(t, []string{"March report.pdf", "photo.png", "scan.jpg"}, append(first, second...))
+	})
+
+	t.Run("all users", func(t *testing.T) {
+		filenames, _ := list(t, hostCtx, &v1pb.ListAttachmentsRequest{})
+		require.Equal(t, []string{"host.pdf"}, filenames)
+		filenames, resp := list(t, hostCtx, &v1pb.ListAttachmentsRequest{AllUsers: true, Filter: `mime_type.startsWith("application/pdf")`})
+		require.ElementsMatch(t, []string{"March report.pdf", "host.pdf"}, filenames)
+		require.Equal(t, int32(2), resp.TotalSize)
+
+		_, err := ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{AllUsers: true})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
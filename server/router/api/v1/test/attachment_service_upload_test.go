@@ -0,0 +1,249 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// fakeS3 is an S3 server storing the objects and the parts of the multipart uploads in memory,
+// without checking the signatures.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+	// uploads are the parts of the multipart uploads by upload ID, then part number.
+	uploads map[string]map[int][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	query := r.URL.Query()
+	if query.Has("uploads") || query.Has("uploadId") {
+		f.serveMultipartUpload(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodHead, http.MethodGet:
+		object, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+		w.Header().Set("Content-Type", f.types[r.URL.Path])
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object)
+		}
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) serveMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	if query.Has("uploads") {
+		uploadID = strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[uploadID] = map[int][]byte{}
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		_, _ = fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>memos</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>", r.URL.Path, uploadID)
+		return
+	}
+	parts, ok := f.uploads[uploadID]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, "<Error><Code>NoSuchUpload</Code></Error>")
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		body, _ := io.ReadAll(r.Body)
+		parts[number] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"etag%d"`, number))
+	case http.MethodGet:
+		numbers := slices.Sorted(maps.Keys(parts))
+		_, _ = fmt.Fprint(w, "<ListPartsResult><IsTruncated>false</IsTruncated>")
+		for _, number := range numbers {
+			_, _ = fmt.Fprintf(w, `<Part><PartNumber>%d</PartNumber><ETag>"etag%d"</ETag><Size>%d</Size></Part>`, number, number, len(parts[number]))
+		}
+		_, _ = fmt.Fprint(w, "</ListPartsResult>")
+	case http.MethodPost:
+		object := []byte{}
+		for _, number := range slices.Sorted(maps.Keys(parts)) {
+			object = append(object, parts[number]...)
+		}
+		f.objects[r.URL.Path] = object
+		delete(f.uploads, uploadID)
+		_, _ = fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>memos</Bucket><Key>%s</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`, r.URL.Path)
+	case http.MethodDelete:
+		delete(f.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, types: map[string]string{}, uploads: map[string]map[int][]byte{}}
+}
+
+func (f *fakeS3) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.objects)
+}
+
+func TestAttachmentUpload(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createUpload := func(size int64, expireSeconds int32) (*v1pb.CreateAttachmentUploadResponse, error) {
+		return ts.Service.CreateAttachmentUpload(userCtx, &v1pb.CreateAttachmentUploadRequest{
+			Attachment:    &v1pb.Attachment{Filename: "video.mp4", Type: "video/mp4"},
+			Size:          size,
+			ExpireSeconds: expireSeconds,
+		})
+	}
+
+	t.Run("the content goes through the server without S3", func(t *testing.T) {
+		resp, err := createUpload(100, 0)
+		require.NoError(t, err)
+		require.Nil(t, resp.Attachment)
+		require.Empty(t, resp.UploadUrl)
+	})
+
+	s3 := newFakeS3()
+	server := httptest.NewServer(s3)
+	defer server.Close()
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:       storepb.InstanceStorageSetting_S3,
+			FilepathTemplate:  "uploads/{uuid}_{filename}",
+			UploadSizeLimitMb: 1,
+			S3Config: &storepb.StorageS3Config{
+				AccessKeyId:     "key",
+				AccessKeySecret: "secret",
+				Endpoint:        server.URL,
+				Region:          "us-east-1",
+				Bucket:          "memos",
+				UsePathStyle:    true,
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	upload := func(uploadURL string, content []byte) {
+		req, err := http.NewRequest(http.MethodPut, uploadURL, bytes.NewReader(content))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "video/mp4")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	listNames := func() []string {
+		resp, err := ts.Service.ListAttachments(userCtx, &v1pb.ListAttachmentsRequest{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, attachment := range resp.Attachments {
+			names = append(names, attachment.Name)
+		}
+		return names
+	}
+
+	t.Run("invalid uploads are rejected", func(t *testing.T) {
+		_, err := createUpload(0, 0)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = createUpload(2<<20, 0)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = createUpload(100, 7200)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("the content is uploaded to S3", func(t *testing.T) {
+		content := []byte("video content")
+		resp, err := createUpload(int64(len(content)), 60)
+		require.NoError(t, err)
+		require.Contains(t, resp.UploadUrl, server.URL+"/memos/uploads/")
+		require.WithinDuration(t, time.Now().Add(time.Minute), resp.ExpireTime.AsTime(), 5*time.Second)
+		name := resp.Attachment.Name
+		// Pending attachments aren't listed.
+		require.NotContains(t, listNames(), name)
+
+		complete := func(ctx context.Context) (*v1pb.Attachment, error) {
+			return ts.Service.CompleteAttachmentUpload(ctx, &v1pb.CompleteAttachmentUploadRequest{Name: name})
+		}
+		_, err = complete(userCtx)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// An upload of another size is deleted.
+		upload(resp.UploadUrl, []byte("truncated"))
+		_, err = complete(userCtx)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Zero(t, s3.count())
+
+		upload(resp.UploadUrl, content)
+		_, err = complete(otherCtx)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		attachment, err := complete(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(content)), attachment.Size)
+		require.Equal(t, "video/mp4", attachment.Type)
+		require.Contains(t, attachment.ExternalLink, server.URL+"/memos/uploads/")
+		require.Contains(t, listNames(), name)
+
+		_, err = complete(userCtx)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("abandoned uploads are purged", func(t *testing.T) {
+		resp, err := createUpload(4, 0)
+		require.NoError(t, err)
+		upload(resp.UploadUrl, []byte("half"))
+		require.Equal(t, 2, s3.count())
+
+		purged, err := ts.Store.PurgePendingAttachments(ctx, time.Now().Add(time.Hour).Unix())
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+		require.Equal(t, 1, s3.count())
+		_, err = ts.Service.CompleteAttachmentUpload(userCtx, &v1pb.CompleteAttachmentUploadRequest{Name: resp.Attachment.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
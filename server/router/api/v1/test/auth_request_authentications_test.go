@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+)
+
+func TestRequestAuthentications(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+	token, err := ts.Service.CreateUserAccessToken(ts.CreateUserContext(ctx, user.ID), &v1pb.CreateUserAccessTokenRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		AccessToken: &v1pb.UserAccessToken{Description: "test", Scopes: []string{auth.ScopeRead}},
+	})
+	require.NoError(t, err)
+
+	requestCtx := auth.WithRequestAuthentications(ctx)
+	tokenUser, scopes, err := authenticator.AuthenticateByJWT(requestCtx, token.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, tokenUser.ID)
+
+	// The request remembers its authentication, so it isn't validated against the store again.
+	require.NoError(t, ts.Store.RemoveUserAccessToken(ctx, user.ID, token.AccessToken))
+	tokenUser, cachedScopes, err := authenticator.AuthenticateByJWT(requestCtx, token.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, tokenUser.ID)
+	require.Equal(t, scopes, cachedScopes)
+
+	// Other requests, and credentials the request hasn't authenticated, are validated as usual.
+	_, _, err = authenticator.AuthenticateByJWT(auth.WithRequestAuthentications(ctx), token.AccessToken)
+	require.Error(t, err)
+	_, _, err = authenticator.AuthenticateByJWT(ctx, token.AccessToken)
+	require.Error(t, err)
+	_, err = authenticator.AuthenticateBySession(requestCtx, token.AccessToken)
+	require.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// recordingEmailSender records the emails it is asked to send.
+type recordingEmailSender struct {
+	messages chan *email.Message
+}
+
+func (r *recordingEmailSender) Send(_ context.Context, _ *storepb.InstanceEmailSetting, message *email.Message) error {
+	r.messages <- message
+	return nil
+}
+
+func (r *recordingEmailSender) next(t *testing.T) *email.Message {
+	select {
+	case message := <-r.messages:
+		return message
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no email was sent")
+		return nil
+	}
+}
+
+func (r *recordingEmailSender) requireNone(t *testing.T) {
+	select {
+	case message := <-r.messages:
+		require.FailNow(t, "unexpected email", "to %s", message.To)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+var passwordResetLinkRegexp = regexp.MustCompile(`http://localhost:8080/auth/reset-password\?token=(\S+)`)
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	sender := &recordingEmailSender{messages: make(chan *email.Message, 10)}
+	ts.Service.EmailSender = sender
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "jane",
+		Role:         store.RoleUser,
+		Email:        "jane@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+
+	signIn := func(password string) error {
+		_, err := ts.Service.CreateSession(apiv1.WithHeaderCarrier(ctx), &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "jane", Password: password},
+			},
+		})
+		return err
+	}
+	requestReset := func(identifier string) error {
+		_, err := ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Identifier: identifier})
+		return err
+	}
+	tokenFromEmail := func(message *email.Message) string {
+		matches := passwordResetLinkRegexp.FindStringSubmatch(message.Body)
+		require.Len(t, matches, 2, message.Body)
+		token, err := url.QueryUnescape(matches[1])
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("unavailable without email settings", func(t *testing.T) {
+		err := requestReset("jane")
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Contains(t, err.Error(), "email is not configured")
+	})
+
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: &storepb.InstanceEmailSetting{
+			SmtpHost:  "smtp.example.com",
+			FromEmail: "memos@example.com",
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Run("unknown accounts get the same response", func(t *testing.T) {
+		require.NoError(t, requestReset("nobody"))
+		require.NoError(t, requestReset("nobody@example.com"))
+		sender.requireNone(t)
+	})
+
+	t.Run("reset with emailed token", func(t *testing.T) {
+		require.NoError(t, signIn("old-password"))
+		sessions, err := ts.Store.GetUserSessions(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+
+		require.NoError(t, requestReset("jane@example.com"))
+		message := sender.next(t)
+		require.Equal(t, "jane@example.com", message.To)
+		token := tokenFromEmail(message)
+
+		// Only a hash of the token is stored.
+		tokens, err := ts.Store.ListPasswordResetTokens(ctx, &store.FindPasswordResetToken{UserID: &user.ID})
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		sum := sha256.Sum256([]byte(token))
+		require.Equal(t, hex.EncodeToString(sum[:]), tokens[0].TokenHash)
+
+		_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "new-password"})
+		require.NoError(t, err)
+
+		sessions, err = ts.Store.GetUserSessions(ctx, user.ID)
+		require.NoError(t, err)
+		require.Empty(t, sessions)
+		require.Error(t, signIn("old-password"))
+		require.NoError(t, signIn("new-password"))
+
+		eventType := store.AuditEventPasswordReset
+		auditLogs, err := ts.Store.ListAuditLogs(ctx, &store.FindAuditLog{EventType: &eventType})
+		require.NoError(t, err)
+		require.Len(t, auditLogs, 1)
+		require.Equal(t, user.ID, auditLogs[0].ActorID)
+
+		// Tokens are single-use.
+		_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "another-password"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("requests are rate limited per user", func(t *testing.T) {
+		require.NoError(t, requestReset("jane"))
+		sender.next(t)
+		require.NoError(t, requestReset("jane"))
+		sender.requireNone(t)
+	})
+
+	t.Run("expired tokens are rejected", func(t *testing.T) {
+		const token = "expired-token"
+		sum := sha256.Sum256([]byte(token))
+		_, err := ts.Store.CreatePasswordResetToken(ctx, &store.PasswordResetToken{
+			TokenHash: hex.EncodeToString(sum[:]),
+			UserID:    user.ID,
+			CreatedTs: time.Now().Add(-time.Hour).Unix(),
+			ExpiresTs: time.Now().Add(-time.Minute).Unix(),
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: token, NewPassword: "another-password"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.NoError(t, signIn("new-password"))
+	})
+}
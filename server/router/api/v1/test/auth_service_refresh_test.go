@@ -0,0 +1,155 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// responseCookies returns the cookies set in the response headers of ctx.
+func responseCookies(ctx context.Context, t *testing.T) map[string]string {
+	cookies := map[string]string{}
+	for _, value := range apiv1.GetHeaderCarrier(ctx).Values("Set-Cookie") {
+		cookie, err := http.ParseSetCookie(value)
+		require.NoError(t, err)
+		cookies[cookie.Name] = cookie.Value
+	}
+	return cookies
+}
+
+func TestRefreshSession(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "user",
+		Role:         store.RoleUser,
+		Email:        "user@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+
+	signIn := func() map[string]string {
+		signInCtx := apiv1.WithHeaderCarrier(ctx)
+		_, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "user", Password: "password"},
+			},
+		})
+		require.NoError(t, err)
+		return responseCookies(signInCtx, t)
+	}
+	refresh := func(refreshToken string) (map[string]string, error) {
+		md := metadata.Pairs("cookie", auth.RefreshTokenCookieName+"="+refreshToken)
+		refreshCtx := apiv1.WithHeaderCarrier(metadata.NewIncomingContext(ctx, md))
+		resp, err := ts.Service.RefreshSession(refreshCtx, &v1pb.RefreshSessionRequest{})
+		if err != nil {
+			return nil, err
+		}
+		require.Equal(t, user.Username, resp.User.Username)
+		require.True(t, resp.AccessTokenExpireTime.AsTime().After(time.Now()))
+		return responseCookies(refreshCtx, t), nil
+	}
+
+	t.Run("sign in issues a token pair", func(t *testing.T) {
+		cookies := signIn()
+		require.NotContains(t, cookies, auth.SessionCookieName)
+		require.NotEmpty(t, cookies[auth.RefreshTokenCookieName])
+
+		authenticated, sessionID, err := authenticator.AuthenticateBySessionAccessToken(ctx, cookies[auth.AccessTokenCookieName])
+		require.NoError(t, err)
+		require.Equal(t, user.ID, authenticated.ID)
+		require.NotEmpty(t, sessionID)
+
+		// Session access tokens are not API access tokens.
+		_, _, err = authenticator.AuthenticateByJWT(ctx, cookies[auth.AccessTokenCookieName])
+		require.Error(t, err)
+	})
+
+	t.Run("refresh rotates the refresh token", func(t *testing.T) {
+		cookies := signIn()
+		refreshed, err := refresh(cookies[auth.RefreshTokenCookieName])
+		require.NoError(t, err)
+		require.NotEqual(t, cookies[auth.RefreshTokenCookieName], refreshed[auth.RefreshTokenCookieName])
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, refreshed[auth.AccessTokenCookieName])
+		require.NoError(t, err)
+
+		// Reusing the old token right away, e.g. from another tab, keeps the session.
+		_, err = refresh(cookies[auth.RefreshTokenCookieName])
+		require.Equal(t, codes.Aborted, status.Code(err))
+		refreshed, err = refresh(refreshed[auth.RefreshTokenCookieName])
+		require.NoError(t, err)
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, refreshed[auth.AccessTokenCookieName])
+		require.NoError(t, err)
+	})
+
+	t.Run("reusing a rotated token revokes the session", func(t *testing.T) {
+		cookies := signIn()
+		refreshed, err := refresh(cookies[auth.RefreshTokenCookieName])
+		require.NoError(t, err)
+
+		// Move the rotation out of the grace period.
+		tokens, err := ts.Store.ListRefreshTokens(ctx, &store.FindRefreshToken{UserID: &user.ID})
+		require.NoError(t, err)
+		rotatedSec := time.Now().Add(-time.Minute).Unix()
+		for _, token := range tokens {
+			if token.RotatedTs != 0 {
+				require.NoError(t, ts.Store.UpdateRefreshToken(ctx, &store.UpdateRefreshToken{TokenHash: token.TokenHash, RotatedTs: &rotatedSec}))
+			}
+		}
+
+		_, err = refresh(cookies[auth.RefreshTokenCookieName])
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = refresh(refreshed[auth.RefreshTokenCookieName])
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, refreshed[auth.AccessTokenCookieName])
+		require.Error(t, err)
+	})
+
+	t.Run("sign out revokes the session", func(t *testing.T) {
+		cookies := signIn()
+		_, sessionID, err := authenticator.AuthenticateBySessionAccessToken(ctx, cookies[auth.AccessTokenCookieName])
+		require.NoError(t, err)
+
+		sessionCtx := apiv1.WithHeaderCarrier(context.WithValue(context.WithValue(ctx, auth.UserIDContextKey, user.ID), auth.SessionIDContextKey, sessionID))
+		_, err = ts.Service.DeleteSession(sessionCtx, &v1pb.DeleteSessionRequest{})
+		require.NoError(t, err)
+		require.Empty(t, responseCookies(sessionCtx, t)[auth.RefreshTokenCookieName])
+
+		_, err = refresh(cookies[auth.RefreshTokenCookieName])
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, cookies[auth.AccessTokenCookieName])
+		require.Error(t, err)
+	})
+
+	t.Run("existing session cookies stay valid", func(t *testing.T) {
+		sessionID := auth.GenerateSessionID()
+		require.NoError(t, ts.Store.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{
+			SessionId:        sessionID,
+			CreateTime:       timestamppb.Now(),
+			LastAccessedTime: timestamppb.Now(),
+		}))
+		authenticated, err := authenticator.AuthenticateBySession(ctx, auth.BuildSessionCookieValue(user.ID, sessionID))
+		require.NoError(t, err)
+		require.Equal(t, user.ID, authenticated.ID)
+	})
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	sessionrunner "github.com/usememos/memos/server/runner/session"
+)
+
+func TestSessionLifetime(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_GENERAL,
+		Value: &storepb.InstanceSetting_GeneralSetting{GeneralSetting: &storepb.InstanceGeneralSetting{
+			SessionIdleTimeoutHours:      1,
+			SessionAbsoluteLifetimeHours: 8,
+		}},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+	now := time.Now()
+	addSession := func(sessionID string, createTime, lastAccessedTime time.Time) {
+		require.NoError(t, ts.Store.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{
+			SessionId:        sessionID,
+			CreateTime:       timestamppb.New(createTime),
+			LastAccessedTime: timestamppb.New(lastAccessedTime),
+		}))
+	}
+	addSession("active", now.Add(-7*time.Hour-30*time.Minute), now.Add(-10*time.Minute))
+	addSession("idle", now.Add(-3*time.Hour), now.Add(-2*time.Hour))
+	addSession("too-old", now.Add(-9*time.Hour), now.Add(-time.Minute))
+
+	t.Run("sessions expire after the idle timeout or the absolute lifetime", func(t *testing.T) {
+		_, err := authenticator.AuthenticateBySession(ctx, auth.BuildSessionCookieValue(user.ID, "active"))
+		require.NoError(t, err)
+		_, err = authenticator.AuthenticateBySession(ctx, auth.BuildSessionCookieValue(user.ID, "idle"))
+		require.Error(t, err)
+		_, err = authenticator.AuthenticateBySession(ctx, auth.BuildSessionCookieValue(user.ID, "too-old"))
+		require.Error(t, err)
+	})
+
+	t.Run("refresh tokens don't outlive the absolute lifetime", func(t *testing.T) {
+		tokenPair, err := authenticator.IssueTokenPair(ctx, user, "active")
+		require.NoError(t, err)
+		require.WithinDuration(t, now.Add(30*time.Minute), tokenPair.RefreshTokenExpiresAt, time.Minute)
+	})
+
+	t.Run("GetCurrentSession reports the session lifetimes", func(t *testing.T) {
+		userCtx := context.WithValue(ts.CreateUserContext(ctx, user.ID), auth.SessionIDContextKey, "active")
+		resp, err := ts.Service.GetCurrentSession(userCtx, &v1pb.GetCurrentSessionRequest{})
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, resp.IdleTimeout.AsDuration())
+		require.WithinDuration(t, now.Add(30*time.Minute), resp.AbsoluteExpireTime.AsTime(), time.Minute)
+	})
+
+	t.Run("the cleanup job removes expired sessions", func(t *testing.T) {
+		sessionrunner.NewRunner(ts.Store).RunOnce(ctx)
+
+		sessions, err := ts.Store.GetUserSessions(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		require.Equal(t, "active", sessions[0].SessionId)
+	})
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestSSORoleMapping(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The claims returned by the mock identity provider for the next sign-in.
+	var claims map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"access_token": "test-access-token", "token_type": "Bearer"}))
+	})
+	mux.HandleFunc("/oauth2/userinfo", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(claims))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	createIdentityProvider := func(roleClaim string, syncUserInfo bool) int32 {
+		identityProvider, err := ts.Store.CreateIdentityProvider(ctx, &storepb.IdentityProvider{
+			Name: "Test IdP",
+			Type: storepb.IdentityProvider_OAUTH2,
+			Config: &storepb.IdentityProviderConfig{
+				Config: &storepb.IdentityProviderConfig_Oauth2Config{
+					Oauth2Config: &storepb.OAuth2Config{
+						ClientId:     "test-client-id",
+						ClientSecret: "test-client-secret",
+						TokenUrl:     server.URL + "/oauth2/token",
+						UserInfoUrl:  server.URL + "/oauth2/userinfo",
+						FieldMapping: &storepb.FieldMapping{
+							Identifier:  "sub",
+							DisplayName: "name",
+							AvatarUrl:   "picture",
+						},
+						RoleClaim: roleClaim,
+						RoleMappings: []*storepb.RoleMapping{
+							{ClaimValue: "memos-hosts", Role: store.RoleHost.String()},
+							{ClaimValue: "memos-admins", Role: store.RoleAdmin.String()},
+						},
+						SyncUserInfo: syncUserInfo,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		return identityProvider.Id
+	}
+	signIn := func(idpID int32, userClaims map[string]any) *v1pb.User {
+		claims = userClaims
+		resp, err := ts.Service.CreateSession(apiv1.WithHeaderCarrier(ctx), &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_SsoCredentials{
+				SsoCredentials: &v1pb.CreateSessionRequest_SSOCredentials{
+					IdpId:       idpID,
+					Code:        "test-code",
+					RedirectUri: "https://example.com/auth/callback",
+				},
+			},
+		})
+		require.NoError(t, err)
+		return resp.User
+	}
+
+	flatIDP := createIdentityProvider("groups", false)
+	nestedIDP := createIdentityProvider("realm_access.roles", true)
+
+	t.Run("new users get the mapped role", func(t *testing.T) {
+		user := signIn(flatIDP, map[string]any{"sub": "alice", "groups": []string{"staff", "memos-admins"}})
+		require.Equal(t, v1pb.User_ADMIN, user.Role)
+
+		user = signIn(flatIDP, map[string]any{"sub": "bob", "groups": "staff"})
+		require.Equal(t, v1pb.User_USER, user.Role)
+	})
+
+	t.Run("roles follow the claim on every sign-in", func(t *testing.T) {
+		user := signIn(nestedIDP, map[string]any{"sub": "carol", "realm_access": map[string]any{"roles": []string{"memos-admins"}}})
+		require.Equal(t, v1pb.User_ADMIN, user.Role)
+
+		// The most privileged role wins.
+		user = signIn(nestedIDP, map[string]any{"sub": "carol", "realm_access": map[string]any{"roles": []string{"memos-admins", "memos-hosts"}}})
+		require.Equal(t, v1pb.User_HOST, user.Role)
+
+		// With another host, carol can be demoted.
+		user = signIn(nestedIDP, map[string]any{"sub": "alice", "realm_access": map[string]any{"roles": []string{"memos-hosts"}}})
+		require.Equal(t, v1pb.User_HOST, user.Role)
+		user = signIn(nestedIDP, map[string]any{"sub": "carol", "realm_access": map[string]any{"roles": []string{}}})
+		require.Equal(t, v1pb.User_USER, user.Role)
+	})
+
+	t.Run("the last host is never demoted", func(t *testing.T) {
+		user := signIn(nestedIDP, map[string]any{"sub": "alice"})
+		require.Equal(t, v1pb.User_HOST, user.Role)
+
+		stored, err := ts.Store.GetUser(ctx, &store.FindUser{Username: &user.Username})
+		require.NoError(t, err)
+		require.Equal(t, store.RoleHost, stored.Role)
+	})
+
+	t.Run("roles are kept without a role claim", func(t *testing.T) {
+		unmappedIDP := createIdentityProvider("", false)
+		user := signIn(unmappedIDP, map[string]any{"sub": "alice", "groups": []string{}})
+		require.Equal(t, v1pb.User_HOST, user.Role)
+	})
+
+	t.Run("user info is synced when enabled", func(t *testing.T) {
+		user := signIn(flatIDP, map[string]any{"sub": "dave", "name": "Dave", "picture": "https://example.com/dave.png"})
+		require.Equal(t, "Dave", user.DisplayName)
+
+		user = signIn(flatIDP, map[string]any{"sub": "dave", "name": "David", "picture": "https://example.com/david.png"})
+		require.Equal(t, "Dave", user.DisplayName)
+		require.Equal(t, "https://example.com/dave.png", user.AvatarUrl)
+
+		user = signIn(nestedIDP, map[string]any{"sub": "dave", "name": "David", "picture": "https://example.com/david.png"})
+		require.Equal(t, "David", user.DisplayName)
+		require.Equal(t, "https://example.com/david.png", user.AvatarUrl)
+	})
+}
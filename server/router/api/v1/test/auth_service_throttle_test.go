@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestSignInThrottle(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "user",
+		Role:         store.RoleUser,
+		Email:        "user@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+
+	clientCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-real-ip", "203.0.113.7"))
+	signIn := func(username, password string) (*v1pb.CreateSessionResponse, error) {
+		return ts.Service.CreateSession(apiv1.WithHeaderCarrier(clientCtx), &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: username, Password: password},
+			},
+		})
+	}
+	getAttempt := func(identifier string) *store.LoginAttempt {
+		attempt, err := ts.Store.GetLoginAttempt(ctx, &store.FindLoginAttempt{Identifier: &identifier})
+		require.NoError(t, err)
+		return attempt
+	}
+	setFailures := func(identifier string, failureCount int32, lastFailed time.Time) {
+		_, err := ts.Store.UpsertLoginAttempt(ctx, &store.LoginAttempt{
+			Identifier:    identifier,
+			FailureCount:  failureCount,
+			FirstFailedTs: lastFailed.Add(-time.Minute).Unix(),
+			LastFailedTs:  lastFailed.Unix(),
+		})
+		require.NoError(t, err)
+	}
+	resetFailures := func() {
+		require.NoError(t, ts.Store.DeleteLoginAttempts(ctx, &store.DeleteLoginAttempt{}))
+	}
+	accountIdentifier := auth.AccountLoginIdentifier(user.Username)
+	ipIdentifier := auth.IPLoginIdentifier("203.0.113.7")
+
+	t.Run("failures are counted per account and IP", func(t *testing.T) {
+		defer resetFailures()
+		// Stay below the delay threshold, so the next attempt isn't delayed.
+		for range auth.LoginFailuresBeforeDelay - 1 {
+			_, err := signIn("user", "wrong")
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		require.EqualValues(t, auth.LoginFailuresBeforeDelay-1, getAttempt(accountIdentifier).FailureCount)
+		require.EqualValues(t, auth.LoginFailuresBeforeDelay-1, getAttempt(ipIdentifier).FailureCount)
+
+		// Signing in clears the account's failures, but not the IP's.
+		resp, err := signIn("user", "password")
+		require.NoError(t, err)
+		require.NotNil(t, resp.User)
+		require.Nil(t, getAttempt(accountIdentifier))
+		require.NotNil(t, getAttempt(ipIdentifier))
+	})
+
+	t.Run("attempts are delayed after repeated failures", func(t *testing.T) {
+		defer resetFailures()
+		setFailures(accountIdentifier, auth.LoginFailuresBeforeDelay+5, time.Now())
+		_, err := signIn("user", "password")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// Unknown usernames get the same response.
+		setFailures(auth.AccountLoginIdentifier("nobody"), auth.LoginFailuresBeforeDelay+5, time.Now())
+		_, unknownErr := signIn("nobody", "password")
+		require.Equal(t, codes.ResourceExhausted, status.Code(unknownErr))
+		require.Equal(t, status.Convert(err).Message(), status.Convert(unknownErr).Message())
+	})
+
+	t.Run("accounts are locked after too many failures", func(t *testing.T) {
+		defer resetFailures()
+		// The delay after the previous failure has passed, but the failure window hasn't.
+		setFailures(accountIdentifier, auth.LoginFailuresBeforeLock-1, time.Now().Add(-20*time.Minute))
+		_, err := signIn("user", "wrong")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.NotZero(t, getAttempt(accountIdentifier).LockedUntilTs)
+
+		_, err = signIn("user", "password")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "try again in 30 minutes")
+
+		// Regular users can't unlock accounts.
+		_, err = ts.Service.UnlockUser(ts.CreateUserContext(ctx, user.ID), &v1pb.UnlockUserRequest{Name: fmt.Sprintf("users/%d", user.ID)})
+		require.Error(t, err)
+
+		_, err = ts.Service.UnlockUser(ts.CreateUserContext(ctx, host.ID), &v1pb.UnlockUserRequest{Name: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		resp, err := signIn("user", "password")
+		require.NoError(t, err)
+		require.NotNil(t, resp.User)
+	})
+
+	t.Run("failures expire after the window", func(t *testing.T) {
+		defer resetFailures()
+		setFailures(accountIdentifier, auth.LoginFailuresBeforeLock-1, time.Now().Add(-2*auth.LoginFailureWindow))
+		_, err := signIn("user", "wrong")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.EqualValues(t, 1, getAttempt(accountIdentifier).FailureCount)
+	})
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/auditlog"
+	"github.com/usememos/memos/store"
+)
+
+func TestListAuditLogs(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "user",
+		Role:         store.RoleUser,
+		Email:        "user@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	clientCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-real-ip", "203.0.113.7", "user-agent", "audit-test"))
+	signIn := func(username, password string) error {
+		_, err := ts.Service.CreateSession(apiv1.WithHeaderCarrier(clientCtx), &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: username, Password: password},
+			},
+		})
+		return err
+	}
+	listAuditLogs := func(request *v1pb.ListAuditLogsRequest) []*v1pb.AuditLog {
+		resp, err := ts.Service.ListAuditLogs(hostCtx, request)
+		require.NoError(t, err)
+		return resp.AuditLogs
+	}
+
+	t.Run("sign-ins are recorded", func(t *testing.T) {
+		require.Error(t, signIn("user", "wrong"))
+		require.Error(t, signIn("nobody", "wrong"))
+		require.NoError(t, signIn("user", "password"))
+
+		auditLogs := listAuditLogs(&v1pb.ListAuditLogsRequest{Actor: userName})
+		require.Len(t, auditLogs, 2)
+		require.Equal(t, v1pb.AuditLog_SIGN_IN, auditLogs[0].EventType)
+		require.Equal(t, "password", auditLogs[0].Payload.AsMap()["method"])
+		require.Equal(t, "203.0.113.7", auditLogs[0].IpAddress)
+		require.Equal(t, "audit-test", auditLogs[0].UserAgent)
+		require.Equal(t, v1pb.AuditLog_SIGN_IN_FAILED, auditLogs[1].EventType)
+
+		auditLogs = listAuditLogs(&v1pb.ListAuditLogsRequest{EventType: v1pb.AuditLog_SIGN_IN_FAILED})
+		require.Len(t, auditLogs, 2)
+		// Unknown usernames have no actor.
+		require.Empty(t, auditLogs[0].Actor)
+		require.Equal(t, "nobody", auditLogs[0].Payload.AsMap()["username"])
+	})
+
+	t.Run("admin mutations are recorded", func(t *testing.T) {
+		_, err := ts.Service.UpdateUser(hostCtx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: userName, Role: v1pb.User_ADMIN},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/GENERAL",
+				Value: &v1pb.InstanceSetting_GeneralSetting_{
+					GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{AuditLogRetentionDays: 30},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		auditLogs := listAuditLogs(&v1pb.ListAuditLogsRequest{Actor: fmt.Sprintf("users/%d", host.ID)})
+		require.Len(t, auditLogs, 2)
+		require.Equal(t, v1pb.AuditLog_INSTANCE_SETTING_CHANGED, auditLogs[0].EventType)
+		require.Equal(t, "GENERAL", auditLogs[0].Payload.AsMap()["key"])
+		require.Equal(t, v1pb.AuditLog_USER_ROLE_CHANGED, auditLogs[1].EventType)
+		require.Equal(t, map[string]any{"user_id": float64(user.ID), "old_role": "USER", "new_role": "ADMIN"}, auditLogs[1].Payload.AsMap())
+	})
+
+	t.Run("pagination and time range", func(t *testing.T) {
+		resp, err := ts.Service.ListAuditLogs(hostCtx, &v1pb.ListAuditLogsRequest{PageSize: 3})
+		require.NoError(t, err)
+		require.Len(t, resp.AuditLogs, 3)
+		require.NotEmpty(t, resp.NextPageToken)
+		resp, err = ts.Service.ListAuditLogs(hostCtx, &v1pb.ListAuditLogsRequest{PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, resp.AuditLogs, 2)
+		require.Empty(t, resp.NextPageToken)
+
+		require.Empty(t, listAuditLogs(&v1pb.ListAuditLogsRequest{EndTime: timestamppb.New(time.Now().Add(-time.Hour))}))
+		require.Len(t, listAuditLogs(&v1pb.ListAuditLogsRequest{StartTime: timestamppb.New(time.Now().Add(-time.Hour))}), 5)
+	})
+
+	t.Run("only the host can list audit logs", func(t *testing.T) {
+		// The user is an admin now, which is not enough.
+		_, err := ts.Service.ListAuditLogs(ts.CreateUserContext(ctx, user.ID), &v1pb.ListAuditLogsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("old entries are pruned", func(t *testing.T) {
+		_, err := ts.Store.CreateAuditLog(ctx, &store.AuditLog{
+			EventType: store.AuditEventSignIn,
+			Payload:   "{}",
+			CreatedTs: time.Now().AddDate(0, 0, -31).Unix(),
+		})
+		require.NoError(t, err)
+		require.Len(t, listAuditLogs(&v1pb.ListAuditLogsRequest{}), 6)
+
+		// The retention was set to 30 days above.
+		auditlog.NewRunner(ts.Store).RunOnce(ctx)
+		require.Len(t, listAuditLogs(&v1pb.ListAuditLogsRequest{}), 5)
+	})
+}
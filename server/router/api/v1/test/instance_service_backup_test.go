@@ -0,0 +1,148 @@
+package test
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestInstanceBackup(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	attachmentPath := filepath.Join(t.TempDir(), "photo.png")
+	require.NoError(t, os.WriteFile(attachmentPath, []byte("png"), 0o600))
+	_, err = ts.Store.CreateAttachment(ctx, &store.Attachment{
+		UID:         "photo",
+		CreatorID:   user.ID,
+		Filename:    "photo.png",
+		Type:        "image/png",
+		Size:        3,
+		StorageType: storepb.AttachmentStorageType_LOCAL,
+		Reference:   attachmentPath,
+	})
+	require.NoError(t, err)
+
+	backupDir := t.TempDir()
+	updateBackupSetting := func(ctx context.Context, setting *v1pb.InstanceSetting_BackupSetting) error {
+		_, err := ts.Service.UpdateInstanceSetting(ctx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name:  "instance/settings/BACKUP",
+				Value: &v1pb.InstanceSetting_BackupSetting_{BackupSetting: setting},
+			},
+		})
+		return err
+	}
+	listBackups := func() []string {
+		entries, err := os.ReadDir(backupDir)
+		require.NoError(t, err)
+		names := []string{}
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+
+	t.Run("backups are written and pruned", func(t *testing.T) {
+		require.NoError(t, updateBackupSetting(hostCtx, &v1pb.InstanceSetting_BackupSetting{
+			Enabled:        true,
+			Schedule:       "0 3 * * *",
+			Destination:    v1pb.InstanceSetting_BackupSetting_LOCAL,
+			LocalPath:      backupDir,
+			RetentionCount: 1,
+		}))
+		ts.Service.RunScheduledBackup(ctx)
+		first := listBackups()
+		require.Len(t, first, 1)
+		// The backups are named after the second they ran.
+		time.Sleep(time.Second)
+		ts.Service.RunScheduledBackup(ctx)
+		backups := listBackups()
+		require.Len(t, backups, 1)
+		require.NotEqual(t, first, backups)
+
+		backupStatus, err := ts.Service.GetInstanceBackupStatus(hostCtx, &v1pb.GetInstanceBackupStatusRequest{})
+		require.NoError(t, err)
+		require.False(t, backupStatus.Running)
+		require.Empty(t, backupStatus.LastError)
+		require.Equal(t, backups[0], backupStatus.LastBackup)
+		require.Positive(t, backupStatus.LastBackupSizeBytes)
+		require.NotNil(t, backupStatus.NextRunTime)
+		require.Equal(t, 3, backupStatus.NextRunTime.AsTime().Hour())
+
+		archive, err := zip.OpenReader(filepath.Join(backupDir, backups[0]))
+		require.NoError(t, err)
+		defer archive.Close()
+		names := []string{}
+		for _, file := range archive.File {
+			names = append(names, file.Name)
+		}
+		require.Contains(t, names, ts.Store.DatabaseBackupFilename())
+		require.Contains(t, names, "manifest.json")
+		require.Contains(t, names, "attachments"+filepath.ToSlash(attachmentPath))
+	})
+
+	t.Run("backups run now in the background", func(t *testing.T) {
+		_, err := ts.Service.RunInstanceBackup(hostCtx, &v1pb.RunInstanceBackupRequest{})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			backupStatus, err := ts.Service.GetInstanceBackupStatus(hostCtx, &v1pb.GetInstanceBackupStatusRequest{})
+			require.NoError(t, err)
+			return !backupStatus.Running
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Len(t, listBackups(), 1)
+	})
+
+	t.Run("failed backups notify the host", func(t *testing.T) {
+		_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+			Key: storepb.InstanceSettingKey_BACKUP,
+			Value: &storepb.InstanceSetting_BackupSetting{BackupSetting: &storepb.InstanceBackupSetting{
+				Enabled:     true,
+				Destination: storepb.InstanceBackupSetting_S3,
+			}},
+		})
+		require.NoError(t, err)
+		ts.Service.RunScheduledBackup(ctx)
+
+		backupStatus, err := ts.Service.GetInstanceBackupStatus(hostCtx, &v1pb.GetInstanceBackupStatusRequest{})
+		require.NoError(t, err)
+		require.Contains(t, backupStatus.LastError, "no S3 bucket")
+		notifications, err := ts.Service.ListUserNotifications(hostCtx, &v1pb.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", host.ID)})
+		require.NoError(t, err)
+		require.Len(t, notifications.Notifications, 1)
+		require.Equal(t, v1pb.UserNotification_BACKUP_FAILED, notifications.Notifications[0].Type)
+	})
+
+	t.Run("only the host manages backups", func(t *testing.T) {
+		_, err := ts.Service.GetInstanceBackupStatus(userCtx, &v1pb.GetInstanceBackupStatusRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.RunInstanceBackup(userCtx, &v1pb.RunInstanceBackupRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		err = updateBackupSetting(hostCtx, &v1pb.InstanceSetting_BackupSetting{Enabled: true, Schedule: "every day"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = updateBackupSetting(hostCtx, &v1pb.InstanceSetting_BackupSetting{Enabled: true, Destination: v1pb.InstanceSetting_BackupSetting_S3})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,36 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGetInstanceDiagnostics(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	diagnostics, err := ts.Service.GetInstanceDiagnostics(ts.CreateUserContext(ctx, host.ID), &v1pb.GetInstanceDiagnosticsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "sqlite", diagnostics.Driver)
+	require.NotEmpty(t, diagnostics.SchemaVersion)
+	require.NotNil(t, diagnostics.DatabaseStats)
+	require.Positive(t, diagnostics.DatabaseStats.OpenConnections)
+
+	_, err = ts.Service.GetInstanceDiagnostics(ts.CreateUserContext(ctx, user.ID), &v1pb.GetInstanceDiagnosticsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetInstanceDiagnostics(ctx, &v1pb.GetInstanceDiagnosticsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,38 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGetInstanceMigrationStatus(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	migrationStatus, err := ts.Service.GetInstanceMigrationStatus(ts.CreateUserContext(ctx, host.ID), &v1pb.GetInstanceMigrationStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, migrationStatus.UpToDate)
+	require.Zero(t, migrationStatus.PendingCount)
+	require.Equal(t, migrationStatus.TargetSchemaVersion, migrationStatus.SchemaVersion)
+	require.Len(t, migrationStatus.AppliedMigrations, 1)
+	require.Equal(t, "LATEST.sql", migrationStatus.AppliedMigrations[0].File)
+	require.False(t, migrationStatus.AppliedMigrations[0].Modified)
+
+	_, err = ts.Service.GetInstanceMigrationStatus(ts.CreateUserContext(ctx, user.ID), &v1pb.GetInstanceMigrationStatusRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetInstanceMigrationStatus(ctx, &v1pb.GetInstanceMigrationStatusRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
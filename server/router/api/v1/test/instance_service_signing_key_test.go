@@ -0,0 +1,164 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// tokenKeyID returns the kid header of a JWT.
+func tokenKeyID(t *testing.T, token string) string {
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &auth.ClaimsMessage{})
+	require.NoError(t, err)
+	kid, ok := parsed.Header["kid"].(string)
+	require.True(t, ok)
+	return kid
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+
+	createAccessToken := func() string {
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", user.ID),
+			AccessToken: &v1pb.UserAccessToken{Description: "test"},
+		})
+		require.NoError(t, err)
+		return accessToken.AccessToken
+	}
+	createSession := func() string {
+		sessionID := auth.GenerateSessionID()
+		require.NoError(t, ts.Store.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{
+			SessionId:        sessionID,
+			CreateTime:       timestamppb.Now(),
+			LastAccessedTime: timestamppb.Now(),
+		}))
+		tokenPair, err := authenticator.IssueTokenPair(ctx, user, sessionID)
+		require.NoError(t, err)
+		return tokenPair.AccessToken
+	}
+	listKeys := func() map[string]*v1pb.SigningKey {
+		resp, err := ts.Service.ListSigningKeys(hostCtx, &v1pb.ListSigningKeysRequest{})
+		require.NoError(t, err)
+		keys := map[string]*v1pb.SigningKey{}
+		for _, key := range resp.SigningKeys {
+			keys[key.Name] = key
+		}
+		return keys
+	}
+
+	legacyAccessToken := createAccessToken()
+	legacySessionToken := createSession()
+	require.Equal(t, auth.KeyID, tokenKeyID(t, legacyAccessToken))
+
+	t.Run("only the host manages signing keys", func(t *testing.T) {
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		_, err := ts.Service.ListSigningKeys(userCtx, &v1pb.ListSigningKeysRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.RotateSigningKey(userCtx, &v1pb.RotateSigningKeyRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("the legacy key is current before the first rotation", func(t *testing.T) {
+		keys := listKeys()
+		require.Len(t, keys, 1)
+		require.Equal(t, v1pb.SigningKey_CURRENT, keys["signingKeys/v1"].State)
+
+		_, err := ts.Service.ExpireSigningKey(hostCtx, &v1pb.ExpireSigningKeyRequest{Name: "signingKeys/v1"})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("tokens of the previous key keep working after a rotation", func(t *testing.T) {
+		key, err := ts.Service.RotateSigningKey(hostCtx, &v1pb.RotateSigningKeyRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "signingKeys/v2", key.Name)
+		require.Equal(t, v1pb.SigningKey_CURRENT, key.State)
+
+		keys := listKeys()
+		require.Len(t, keys, 2)
+		require.Equal(t, v1pb.SigningKey_RETIRED, keys["signingKeys/v1"].State)
+		require.Nil(t, keys["signingKeys/v1"].ExpireTime)
+
+		_, _, err = authenticator.AuthenticateByJWT(ctx, legacyAccessToken)
+		require.NoError(t, err)
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, legacySessionToken)
+		require.NoError(t, err)
+
+		accessToken := createAccessToken()
+		require.Equal(t, "v2", tokenKeyID(t, accessToken))
+		_, _, err = authenticator.AuthenticateByJWT(ctx, accessToken)
+		require.NoError(t, err)
+
+		// Tokens of both keys are listed.
+		resp, err := ts.Service.ListUserAccessTokens(ts.CreateUserContext(ctx, user.ID), &v1pb.ListUserAccessTokensRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		require.Len(t, resp.AccessTokens, 2)
+	})
+
+	t.Run("expired keys no longer verify tokens", func(t *testing.T) {
+		accessToken := createAccessToken()
+		sessionToken := createSession()
+
+		key, err := ts.Service.ExpireSigningKey(hostCtx, &v1pb.ExpireSigningKeyRequest{Name: "signingKeys/v1"})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.SigningKey_EXPIRED, key.State)
+
+		_, _, err = authenticator.AuthenticateByJWT(ctx, legacyAccessToken)
+		require.Error(t, err)
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, legacySessionToken)
+		require.Error(t, err)
+		_, _, err = authenticator.AuthenticateByJWT(ctx, accessToken)
+		require.NoError(t, err)
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, sessionToken)
+		require.NoError(t, err)
+
+		_, err = ts.Service.ExpireSigningKey(hostCtx, &v1pb.ExpireSigningKeyRequest{Name: "signingKeys/v2"})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = ts.Service.ExpireSigningKey(hostCtx, &v1pb.ExpireSigningKeyRequest{Name: "signingKeys/v9"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("retired keys expire after the grace period", func(t *testing.T) {
+		_, err := ts.Service.RotateSigningKey(hostCtx, &v1pb.RotateSigningKeyRequest{GracePeriod: durationpb.New(time.Hour)})
+		require.NoError(t, err)
+
+		retired := listKeys()["signingKeys/v2"]
+		require.Equal(t, v1pb.SigningKey_RETIRED, retired.State)
+		require.WithinDuration(t, time.Now().Add(time.Hour), retired.ExpireTime.AsTime(), time.Minute)
+
+		_, err = ts.Service.RotateSigningKey(hostCtx, &v1pb.RotateSigningKeyRequest{GracePeriod: durationpb.New(-time.Hour)})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("rotations are audited", func(t *testing.T) {
+		eventType := store.AuditEventSigningKeyRotated
+		auditLogs, err := ts.Store.ListAuditLogs(ctx, &store.FindAuditLog{EventType: &eventType})
+		require.NoError(t, err)
+		require.Len(t, auditLogs, 2)
+		require.Equal(t, host.ID, auditLogs[0].ActorID)
+	})
+}
@@ -0,0 +1,100 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetInstanceStats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for _, visibility := range []v1pb.Visibility{v1pb.Visibility_PUBLIC, v1pb.Visibility_PRIVATE, v1pb.Visibility_PRIVATE} {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: visibility}})
+		require.NoError(t, err)
+	}
+	trashed, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "trashed", CreatorID: user.ID, Content: "trashed", Visibility: store.Private})
+	require.NoError(t, err)
+	deletedSec := time.Now().Unix()
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: trashed.ID, DeletedTs: &deletedSec}))
+
+	_, err = ts.Store.CreateAttachment(ctx, &store.Attachment{UID: "blob", CreatorID: user.ID, Filename: "a.txt", Type: "text/plain", Blob: []byte("hello"), Size: 5})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateAttachment(ctx, &store.Attachment{UID: "s3", CreatorID: user.ID, Filename: "b.txt", Type: "text/plain", Size: 100, StorageType: storepb.AttachmentStorageType_S3, Reference: "b.txt"})
+	require.NoError(t, err)
+	require.NoError(t, ts.Store.AddUserWebhook(ctx, user.ID, &storepb.WebhooksUserSetting_Webhook{Id: "hook", Title: "hook", Url: "https://example.com/hook"}))
+
+	// Only the user has signed in recently.
+	now := time.Now()
+	_, err = ts.Store.CreateRefreshToken(ctx, &store.RefreshToken{TokenHash: "recent", UserID: user.ID, SessionID: "recent", CreatedTs: now.Unix(), ExpiresTs: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateRefreshToken(ctx, &store.RefreshToken{TokenHash: "old", UserID: host.ID, SessionID: "old", CreatedTs: now.AddDate(0, 0, -40).Unix(), ExpiresTs: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+
+	t.Run("only the host can get the stats", func(t *testing.T) {
+		_, err := ts.Service.GetInstanceStats(userCtx, &v1pb.GetInstanceStatsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.GetInstanceStats(ctx, &v1pb.GetInstanceStatsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("stats are aggregated", func(t *testing.T) {
+		stats, err := ts.Service.GetInstanceStats(hostCtx, &v1pb.GetInstanceStatsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, ts.Profile.Version, stats.Version)
+		require.NotNil(t, stats.Uptime)
+		require.Equal(t, int32(2), stats.UserCount)
+		require.Equal(t, int32(1), stats.ActiveUserCount)
+		require.Equal(t, int32(1), stats.UserWebhookCount)
+		require.Positive(t, stats.GetDatabaseSizeBytes())
+
+		memoCounts := map[v1pb.Visibility]int32{}
+		trashedCount := int32(0)
+		for _, memoCount := range stats.MemoCounts {
+			require.Equal(t, v1pb.State_NORMAL, memoCount.State)
+			if memoCount.Trashed {
+				trashedCount += memoCount.Count
+				continue
+			}
+			memoCounts[memoCount.Visibility] += memoCount.Count
+		}
+		require.Equal(t, map[v1pb.Visibility]int32{v1pb.Visibility_PUBLIC: 1, v1pb.Visibility_PRIVATE: 2}, memoCounts)
+		require.Equal(t, int32(1), trashedCount)
+
+		require.Len(t, stats.DailyMemoCounts, 1)
+		require.Equal(t, time.Now().UTC().Format(time.DateOnly), stats.DailyMemoCounts[0].Date)
+		require.Equal(t, int32(4), stats.DailyMemoCounts[0].Count)
+
+		storageUsages := map[string][2]int64{}
+		for _, usage := range stats.StorageUsages {
+			storageUsages[usage.StorageType] = [2]int64{int64(usage.AttachmentCount), usage.SizeBytes}
+		}
+		require.Equal(t, map[string][2]int64{"DATABASE": {1, 5}, "S3": {1, 100}}, storageUsages)
+	})
+
+	t.Run("stats are cached", func(t *testing.T) {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "later", Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+		stats, err := ts.Service.GetInstanceStats(hostCtx, &v1pb.GetInstanceStatsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int32(4), stats.DailyMemoCounts[0].Count)
+	})
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 )
@@ -188,6 +192,63 @@ func TestGetInstanceSetting(t *testing.T) {
 		require.NotNil(t, memoRelatedSetting)
 	})
 
+	t.Run("GetInstanceSetting - link preview setting validation and visibility", func(t *testing.T) {
+		// Create test service for this specific test
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		hostUser, err := ts.CreateHostUser(ctx, "testhost")
+		require.NoError(t, err)
+		regularUser, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+		// Invalid entries are rejected
+		_, err = ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/LINK_PREVIEW",
+				Value: &v1pb.InstanceSetting_LinkPreviewSetting_{
+					LinkPreviewSetting: &v1pb.InstanceSetting_LinkPreviewSetting{AllowedInternalHosts: []string{"http://gitea.lan/"}},
+				},
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid allowed internal hosts")
+
+		_, err = ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/LINK_PREVIEW",
+				Value: &v1pb.InstanceSetting_LinkPreviewSetting_{
+					LinkPreviewSetting: &v1pb.InstanceSetting_LinkPreviewSetting{RequestHeaders: map[string]string{"Cookie": "session=1"}},
+				},
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid request headers")
+
+		_, err = ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/LINK_PREVIEW",
+				Value: &v1pb.InstanceSetting_LinkPreviewSetting_{
+					LinkPreviewSetting: &v1pb.InstanceSetting_LinkPreviewSetting{AllowedInternalHosts: []string{"gitea.lan", "192.168.1.0/24"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		req := &v1pb.GetInstanceSettingRequest{
+			Name: "instance/settings/LINK_PREVIEW",
+		}
+		resp, err := ts.Service.GetInstanceSetting(hostCtx, req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"gitea.lan", "192.168.1.0/24"}, resp.GetLinkPreviewSetting().AllowedInternalHosts)
+
+		// Regular users don't see the allowlist
+		resp, err = ts.Service.GetInstanceSetting(ts.CreateUserContext(ctx, regularUser.ID), req)
+		require.NoError(t, err)
+		require.Empty(t, resp.GetLinkPreviewSetting().AllowedInternalHosts)
+	})
+
 	t.Run("GetInstanceSetting - invalid setting name", func(t *testing.T) {
 		// Create test service for this specific test
 		ts := NewTestService(t)
@@ -204,3 +265,111 @@ func TestGetInstanceSetting(t *testing.T) {
 		require.Contains(t, err.Error(), "invalid instance setting name")
 	})
 }
+
+func TestInstanceAnnouncement(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	setAnnouncement := func(ctx context.Context, announcement *v1pb.InstanceSetting_AnnouncementSetting) (*v1pb.InstanceSetting, error) {
+		return ts.Service.UpdateInstanceSetting(ctx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name:  "instance/settings/ANNOUNCEMENT",
+				Value: &v1pb.InstanceSetting_AnnouncementSetting_{AnnouncementSetting: announcement},
+			},
+		})
+	}
+	getAnnouncement := func(ctx context.Context) *v1pb.InstanceSetting_AnnouncementSetting {
+		profile, err := ts.Service.GetInstanceProfile(ctx, &v1pb.GetInstanceProfileRequest{})
+		require.NoError(t, err)
+		return profile.Announcement
+	}
+
+	t.Run("no announcement by default", func(t *testing.T) {
+		require.Nil(t, getAnnouncement(userCtx))
+		setting, err := ts.Service.GetInstanceSetting(userCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/ANNOUNCEMENT"})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.InstanceSetting_AnnouncementSetting_INFO, setting.GetAnnouncementSetting().Severity)
+	})
+
+	t.Run("only the host can set the announcement", func(t *testing.T) {
+		_, err := setAnnouncement(userCtx, &v1pb.InstanceSetting_AnnouncementSetting{Content: "hello"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		now := time.Now()
+		_, err = setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{
+			Content:   "hello",
+			StartTime: timestamppb.New(now),
+			EndTime:   timestamppb.New(now.Add(-time.Hour)),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("the announcement is shown within its time window", func(t *testing.T) {
+		now := time.Now()
+		_, err := setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{
+			Content:  "Maintenance on **Saturday**",
+			Severity: v1pb.InstanceSetting_AnnouncementSetting_WARNING,
+			EndTime:  timestamppb.New(now.Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		announcement := getAnnouncement(ctx)
+		require.NotNil(t, announcement)
+		require.Equal(t, "Maintenance on **Saturday**", announcement.Content)
+		require.Equal(t, v1pb.InstanceSetting_AnnouncementSetting_WARNING, announcement.Severity)
+		require.NotEmpty(t, announcement.Id)
+
+		_, err = setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{
+			Content: "Maintenance on Saturday",
+			EndTime: timestamppb.New(now.Add(-time.Second)),
+		})
+		require.NoError(t, err)
+		require.Nil(t, getAnnouncement(userCtx))
+
+		_, err = setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{
+			Content:   "Maintenance on Saturday",
+			StartTime: timestamppb.New(now.Add(time.Hour)),
+		})
+		require.NoError(t, err)
+		require.Nil(t, getAnnouncement(userCtx))
+	})
+
+	t.Run("dismissals hold until the content changes", func(t *testing.T) {
+		_, err := setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{Content: "Not dismissible"})
+		require.NoError(t, err)
+		announcement := getAnnouncement(userCtx)
+		_, err = ts.Service.DismissInstanceAnnouncement(userCtx, &v1pb.DismissInstanceAnnouncementRequest{Id: announcement.Id})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{Content: "Dismissible", Dismissible: true})
+		require.NoError(t, err)
+		announcement = getAnnouncement(userCtx)
+		require.NotNil(t, announcement)
+		_, err = ts.Service.DismissInstanceAnnouncement(ctx, &v1pb.DismissInstanceAnnouncementRequest{Id: announcement.Id})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = ts.Service.DismissInstanceAnnouncement(userCtx, &v1pb.DismissInstanceAnnouncementRequest{Id: "stale"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.DismissInstanceAnnouncement(userCtx, &v1pb.DismissInstanceAnnouncementRequest{Id: announcement.Id})
+		require.NoError(t, err)
+		require.Nil(t, getAnnouncement(userCtx))
+		// The other users and the visitors still see it.
+		require.NotNil(t, getAnnouncement(hostCtx))
+		require.NotNil(t, getAnnouncement(ctx))
+
+		// Editing the other fields keeps the dismissal, changing the content shows it again.
+		_, err = setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{Content: "Dismissible", Severity: v1pb.InstanceSetting_AnnouncementSetting_CRITICAL, Dismissible: true})
+		require.NoError(t, err)
+		require.Nil(t, getAnnouncement(userCtx))
+		_, err = setAnnouncement(hostCtx, &v1pb.InstanceSetting_AnnouncementSetting{Content: "Dismissible again", Dismissible: true})
+		require.NoError(t, err)
+		require.NotNil(t, getAnnouncement(userCtx))
+	})
+}
@@ -0,0 +1,248 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memorecurrence"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRecurrences(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	runner := memorecurrence.NewRunner(ts.Store, ts.Service.CreateRecurringMemo)
+	memoTemplate, err := ts.Service.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+		Parent:       parent,
+		MemoTemplate: &v1pb.MemoTemplate{Title: "Weekly review", Content: "# Review {{date}}", Tags: []string{"review"}},
+	})
+	require.NoError(t, err)
+
+	createMemoRecurrence := func(memoRecurrence *v1pb.MemoRecurrence) *v1pb.MemoRecurrence {
+		created, err := ts.Service.CreateMemoRecurrence(userCtx, &v1pb.CreateMemoRecurrenceRequest{
+			Parent:         parent,
+			MemoRecurrence: memoRecurrence,
+		})
+		require.NoError(t, err)
+		return created
+	}
+	// makeDue moves the next occurrence of a recurrence to the given number of days ago.
+	makeDue := func(name string, days int) {
+		memoRecurrences, err := ts.Store.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{CreatorID: &user.ID})
+		require.NoError(t, err)
+		for _, memoRecurrence := range memoRecurrences {
+			if fmt.Sprintf("%s/memoRecurrences/%d", parent, memoRecurrence.ID) != name {
+				continue
+			}
+			nextSec := memoRecurrence.NextTs - int64(days+1)*24*60*60
+			require.NoError(t, ts.Store.UpdateMemoRecurrence(ctx, &store.UpdateMemoRecurrence{ID: memoRecurrence.ID, NextTs: &nextSec}))
+		}
+	}
+	listMemoContents := func() []string {
+		resp, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		contents := []string{}
+		for _, memo := range resp.Memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	t.Run("memo recurrences preview their next occurrences", func(t *testing.T) {
+		created := createMemoRecurrence(&v1pb.MemoRecurrence{
+			Rrule:     "FREQ=WEEKLY;BYDAY=MO",
+			TimeOfDay: "08:00",
+			Timezone:  "Europe/Paris",
+			Source:    &v1pb.MemoRecurrence_MemoTemplate{MemoTemplate: memoTemplate.Name},
+		})
+		require.Equal(t, "Europe/Paris", created.Timezone)
+		require.Equal(t, memoTemplate.Name, created.GetMemoTemplate())
+		require.False(t, created.Paused)
+		require.Nil(t, created.LastOccurrenceTime)
+		require.Len(t, created.NextOccurrenceTimes, 3)
+		paris, err := time.LoadLocation("Europe/Paris")
+		require.NoError(t, err)
+		for i, occurrence := range created.NextOccurrenceTimes {
+			local := occurrence.AsTime().In(paris)
+			require.Equal(t, time.Monday, local.Weekday())
+			require.Equal(t, 8, local.Hour())
+			require.True(t, local.After(time.Now()))
+			if i > 0 {
+				require.Equal(t, 7, int(local.Sub(created.NextOccurrenceTimes[i-1].AsTime()).Round(24*time.Hour).Hours()/24))
+			}
+		}
+
+		resp, err := ts.Service.ListMemoRecurrences(userCtx, &v1pb.ListMemoRecurrencesRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.MemoRecurrences, 1)
+		require.Equal(t, created.Name, resp.MemoRecurrences[0].Name)
+
+		_, err = ts.Service.DeleteMemoRecurrence(userCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemoRecurrence(userCtx, &v1pb.GetMemoRecurrenceRequest{Name: created.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("missed occurrences create a single catch-up memo", func(t *testing.T) {
+		created := createMemoRecurrence(&v1pb.MemoRecurrence{
+			Rrule:     "FREQ=DAILY",
+			TimeOfDay: "08:00",
+			Source:    &v1pb.MemoRecurrence_MemoTemplate{MemoTemplate: memoTemplate.Name},
+			Tags:      []string{"weekly"},
+		})
+		defer ts.Service.DeleteMemoRecurrence(userCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+		memoCount := len(listMemoContents())
+
+		makeDue(created.Name, 5)
+		runner.RunOnce(ctx)
+		runner.RunOnce(ctx)
+		contents := listMemoContents()
+		require.Len(t, contents, memoCount+1)
+		require.Regexp(t, `^# Review \d{4}-\d{2}-\d{2}\n\n#review #weekly$`, contents[0])
+
+		got, err := ts.Service.GetMemoRecurrence(userCtx, &v1pb.GetMemoRecurrenceRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.NotNil(t, got.LastOccurrenceTime)
+		require.True(t, got.LastOccurrenceTime.AsTime().Before(time.Now()))
+		require.True(t, got.LastOccurrenceTime.AsTime().After(time.Now().Add(-25*time.Hour)))
+		require.True(t, got.NextOccurrenceTimes[0].AsTime().After(time.Now()))
+	})
+
+	t.Run("memo recurrences copy a source memo", func(t *testing.T) {
+		source, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Water the plants #home", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		created := createMemoRecurrence(&v1pb.MemoRecurrence{
+			Rrule:     "FREQ=WEEKLY;INTERVAL=2",
+			TimeOfDay: "19:00",
+			Source:    &v1pb.MemoRecurrence_Memo{Memo: source.Name},
+			Tags:      []string{"home", "chores"},
+		})
+		defer ts.Service.DeleteMemoRecurrence(userCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+		require.Equal(t, source.Name, created.GetMemo())
+
+		makeDue(created.Name, 0)
+		runner.RunOnce(ctx)
+		resp, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "Water the plants #home\n\n#chores", resp.Memos[0].Content)
+		require.Equal(t, v1pb.Visibility_PROTECTED, resp.Memos[0].Visibility)
+	})
+
+	t.Run("paused memo recurrences don't create memos", func(t *testing.T) {
+		created := createMemoRecurrence(&v1pb.MemoRecurrence{
+			Rrule:     "FREQ=DAILY",
+			TimeOfDay: "08:00",
+			Source:    &v1pb.MemoRecurrence_MemoTemplate{MemoTemplate: memoTemplate.Name},
+		})
+		defer ts.Service.DeleteMemoRecurrence(userCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+
+		paused, err := ts.Service.PauseMemoRecurrence(userCtx, &v1pb.PauseMemoRecurrenceRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.True(t, paused.Paused)
+		require.Empty(t, paused.NextOccurrenceTimes)
+
+		memoCount := len(listMemoContents())
+		makeDue(created.Name, 1)
+		runner.RunOnce(ctx)
+		require.Len(t, listMemoContents(), memoCount)
+
+		// Resuming skips the occurrences while the recurrence was paused.
+		resumed, err := ts.Service.ResumeMemoRecurrence(userCtx, &v1pb.ResumeMemoRecurrenceRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.False(t, resumed.Paused)
+		require.True(t, resumed.NextOccurrenceTimes[0].AsTime().After(time.Now()))
+		runner.RunOnce(ctx)
+		require.Len(t, listMemoContents(), memoCount)
+	})
+
+	t.Run("memo recurrences can be rescheduled", func(t *testing.T) {
+		created := createMemoRecurrence(&v1pb.MemoRecurrence{
+			Rrule:     "FREQ=DAILY",
+			TimeOfDay: "08:00",
+			Source:    &v1pb.MemoRecurrence_MemoTemplate{MemoTemplate: memoTemplate.Name},
+		})
+		defer ts.Service.DeleteMemoRecurrence(userCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+
+		updated, err := ts.Service.UpdateMemoRecurrence(userCtx, &v1pb.UpdateMemoRecurrenceRequest{
+			MemoRecurrence: &v1pb.MemoRecurrence{Name: created.Name, Rrule: "freq=weekly;byday=fr,sa", TimeOfDay: "21:15", Timezone: "Asia/Tokyo"},
+			UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"rrule", "time_of_day", "timezone"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "FREQ=WEEKLY;BYDAY=FR,SA", updated.Rrule)
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		for _, occurrence := range updated.NextOccurrenceTimes {
+			local := occurrence.AsTime().In(tokyo)
+			require.Contains(t, []time.Weekday{time.Friday, time.Saturday}, local.Weekday())
+			require.Equal(t, "21:15", local.Format("15:04"))
+		}
+
+		// Every seventh day from the creation is never the day after the creation.
+		tomorrow := []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}[(time.Unix(created.CreateTime.Seconds, 0).In(tokyo).Weekday()+1)%7]
+		for _, memoRecurrence := range []*v1pb.MemoRecurrence{
+			{Name: created.Name, Rrule: "FREQ=MONTHLY", TimeOfDay: "08:00"},
+			{Name: created.Name, Rrule: "FREQ=DAILY;INTERVAL=7;BYDAY=" + tomorrow, TimeOfDay: "08:00"},
+			{Name: created.Name, Rrule: "FREQ=DAILY", TimeOfDay: "noon"},
+			{Name: created.Name, Rrule: "FREQ=DAILY", TimeOfDay: "08:00", Timezone: "Mars/Olympus_Mons"},
+		} {
+			_, err := ts.Service.UpdateMemoRecurrence(userCtx, &v1pb.UpdateMemoRecurrenceRequest{
+				MemoRecurrence: memoRecurrence,
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"rrule", "time_of_day", "timezone"}},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), memoRecurrence.Rrule)
+		}
+	})
+
+	t.Run("memo recurrences are only available to their owner", func(t *testing.T) {
+		created := createMemoRecurrence(&v1pb.MemoRecurrence{
+			Rrule:     "FREQ=DAILY",
+			TimeOfDay: "08:00",
+			Source:    &v1pb.MemoRecurrence_MemoTemplate{MemoTemplate: memoTemplate.Name},
+		})
+		defer ts.Service.DeleteMemoRecurrence(userCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+
+		_, err := ts.Service.ListMemoRecurrences(otherCtx, &v1pb.ListMemoRecurrencesRequest{Parent: parent})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.PauseMemoRecurrence(otherCtx, &v1pb.PauseMemoRecurrenceRequest{Name: created.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.DeleteMemoRecurrence(otherCtx, &v1pb.DeleteMemoRecurrenceRequest{Name: created.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// The source of a recurrence must belong to its owner.
+		otherMemo, err := ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "not yours", Visibility: v1pb.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateMemoRecurrence(userCtx, &v1pb.CreateMemoRecurrenceRequest{
+			Parent:         parent,
+			MemoRecurrence: &v1pb.MemoRecurrence{Rrule: "FREQ=DAILY", TimeOfDay: "08:00", Source: &v1pb.MemoRecurrence_Memo{Memo: otherMemo.Name}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.CreateMemoRecurrence(userCtx, &v1pb.CreateMemoRecurrenceRequest{
+			Parent:         parent,
+			MemoRecurrence: &v1pb.MemoRecurrence{Rrule: "FREQ=DAILY", TimeOfDay: "08:00"},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
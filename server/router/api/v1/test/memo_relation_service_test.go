@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
 )
@@ -167,3 +168,76 @@ func TestSetMemoRelations(t *testing.T) {
 		require.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestListMemoBacklinks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	target, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Target memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	public, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "See [the target](/" + target.Name + ")", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	private, err := ts.Service.CreateMemo(otherCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Private note on https://memos.example.com/" + target.Name, Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	listBacklinks := func(ctx context.Context) []*apiv1.MemoRelation_Memo {
+		resp, err := ts.Service.ListMemoBacklinks(ctx, &apiv1.ListMemoBacklinksRequest{Name: target.Name})
+		require.NoError(t, err)
+		return resp.Memos
+	}
+
+	t.Run("memo links create references visible to the caller", func(t *testing.T) {
+		backlinks := listBacklinks(userCtx)
+		require.Len(t, backlinks, 1)
+		require.Equal(t, public.Name, backlinks[0].Name)
+		require.Equal(t, "See the target", backlinks[0].Snippet)
+
+		backlinks = listBacklinks(otherCtx)
+		require.Len(t, backlinks, 2)
+		require.Equal(t, private.Name, backlinks[0].Name)
+		require.Equal(t, public.Name, backlinks[1].Name)
+	})
+
+	t.Run("backlinks are paginated", func(t *testing.T) {
+		resp, err := ts.Service.ListMemoBacklinks(otherCtx, &apiv1.ListMemoBacklinksRequest{Name: target.Name, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.NotEmpty(t, resp.NextPageToken)
+
+		resp, err = ts.Service.ListMemoBacklinks(otherCtx, &apiv1.ListMemoBacklinksRequest{Name: target.Name, PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, public.Name, resp.Memos[0].Name)
+		require.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("removing a link removes the reference", func(t *testing.T) {
+		_, err := ts.Service.UpdateMemo(otherCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: private.Name, Content: "Private note"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, listBacklinks(otherCtx), 1)
+	})
+
+	t.Run("deleting a memo removes its backlinks", func(t *testing.T) {
+		_, err := ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: public.Name})
+		require.NoError(t, err)
+		require.Empty(t, listBacklinks(userCtx))
+	})
+}
@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAutoArchiveMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string, age time.Duration, update *store.UpdateMemo) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		memoUID := memo.Name[len("memos/"):]
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		updatedTsSec := time.Now().Add(-age).Unix()
+		update.ID = stored.ID
+		update.UpdatedTs = &updatedTsSec
+		require.NoError(t, ts.Store.UpdateMemo(ctx, update))
+		return memo
+	}
+	pinned := true
+	reminderTsSec := time.Now().Add(time.Hour).Unix()
+	day := 24 * time.Hour
+	oldest := createMemo(userCtx, "oldest", 100*day, &store.UpdateMemo{})
+	old := createMemo(userCtx, "old #work", 40*day, &store.UpdateMemo{})
+	createMemo(userCtx, "recent", 10*day, &store.UpdateMemo{})
+	createMemo(userCtx, "pinned", 100*day, &store.UpdateMemo{Pinned: &pinned})
+	createMemo(userCtx, "reminder", 100*day, &store.UpdateMemo{ReminderTs: &reminderTsSec})
+	createMemo(userCtx, "protected #keep/sub", 90*day, &store.UpdateMemo{})
+	createMemo(otherCtx, "other user", 100*day, &store.UpdateMemo{})
+
+	settingName := fmt.Sprintf("users/%d/settings/AUTO_ARCHIVE", user.ID)
+	updateSetting := func(setting *apiv1.UserSetting_AutoArchiveSetting, paths ...string) (*apiv1.UserSetting, error) {
+		return ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+			Setting: &apiv1.UserSetting{
+				Name:  settingName,
+				Value: &apiv1.UserSetting_AutoArchiveSetting_{AutoArchiveSetting: setting},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+	contents := func(memos []*apiv1.Memo) []string {
+		contents := []string{}
+		for _, memo := range memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	t.Run("the policy is off by default", func(t *testing.T) {
+		setting, err := ts.Service.GetUserSetting(userCtx, &apiv1.GetUserSettingRequest{Name: settingName})
+		require.NoError(t, err)
+		require.Zero(t, setting.GetAutoArchiveSetting().Days)
+		resp, err := ts.Service.PreviewAutoArchiveMemos(userCtx, &apiv1.PreviewAutoArchiveMemosRequest{})
+		require.NoError(t, err)
+		require.Empty(t, resp.Memos)
+		require.Zero(t, resp.TotalSize)
+	})
+
+	t.Run("invalid settings are rejected", func(t *testing.T) {
+		_, err := updateSetting(&apiv1.UserSetting_AutoArchiveSetting{Days: -1}, "days")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = updateSetting(&apiv1.UserSetting_AutoArchiveSetting{ProtectedTags: []string{"not a tag"}}, "protectedTags")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.PreviewAutoArchiveMemos(userCtx, &apiv1.PreviewAutoArchiveMemosRequest{PageSize: -1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.PreviewAutoArchiveMemos(ctx, &apiv1.PreviewAutoArchiveMemosRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("the preview lists the memos the policy would archive", func(t *testing.T) {
+		days := int32(30)
+		resp, err := ts.Service.PreviewAutoArchiveMemos(userCtx, &apiv1.PreviewAutoArchiveMemosRequest{Days: &days})
+		require.NoError(t, err)
+		require.Equal(t, []string{"oldest", "protected #keep/sub", "old #work"}, contents(resp.Memos))
+
+		resp, err = ts.Service.PreviewAutoArchiveMemos(userCtx, &apiv1.PreviewAutoArchiveMemosRequest{Days: &days, ProtectedTags: []string{"keep"}, PageSize: 1})
+		require.NoError(t, err)
+		require.Equal(t, []string{"oldest"}, contents(resp.Memos))
+		require.Equal(t, int32(2), resp.TotalSize)
+	})
+
+	t.Run("the saved policy archives the memos and notifies once", func(t *testing.T) {
+		setting, err := updateSetting(&apiv1.UserSetting_AutoArchiveSetting{Days: 30, ProtectedTags: []string{"keep"}}, "days", "protectedTags")
+		require.NoError(t, err)
+		require.Equal(t, int32(30), setting.GetAutoArchiveSetting().Days)
+		require.Equal(t, []string{"keep"}, setting.GetAutoArchiveSetting().ProtectedTags)
+
+		resp, err := ts.Service.PreviewAutoArchiveMemos(userCtx, &apiv1.PreviewAutoArchiveMemosRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"oldest", "old #work"}, contents(resp.Memos))
+
+		count, err := ts.Service.AutoArchiveMemos(ctx, user.ID, &storepb.AutoArchiveUserSetting{Days: 30, ProtectedTags: []string{"keep"}})
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		for _, name := range []string{oldest.Name, old.Name} {
+			memo, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: name})
+			require.NoError(t, err)
+			require.Equal(t, apiv1.State_ARCHIVED, memo.State)
+		}
+
+		// Running the policy again archives nothing and doesn't notify.
+		count, err = ts.Service.AutoArchiveMemos(ctx, user.ID, &storepb.AutoArchiveUserSetting{Days: 30, ProtectedTags: []string{"keep"}})
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		notifications, err := ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		autoArchive := []*apiv1.UserNotification{}
+		for _, notification := range notifications.Notifications {
+			if notification.Type == apiv1.UserNotification_MEMO_AUTO_ARCHIVE {
+				autoArchive = append(autoArchive, notification)
+			}
+		}
+		require.Len(t, autoArchive, 1)
+	})
+}
@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestBatchUpdateMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	getMemo := func(name string) *apiv1.Memo {
+		memo, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		return memo
+	}
+	batchUpdate := func(request *apiv1.BatchUpdateMemosRequest) *apiv1.BatchUpdateMemosResponse {
+		resp, err := ts.Service.BatchUpdateMemos(userCtx, request)
+		require.NoError(t, err)
+		return resp
+	}
+
+	first := createMemo(userCtx, "first #work")
+	second := createMemo(userCtx, "second")
+	otherMemo := createMemo(otherCtx, "not mine")
+
+	t.Run("memos the caller doesn't own are reported as failures", func(t *testing.T) {
+		resp := batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name, otherMemo.Name, "memos/unknown", second.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_SetVisibility{SetVisibility: apiv1.Visibility_PRIVATE},
+		})
+		require.Equal(t, int32(2), resp.SucceededCount)
+		require.Equal(t, int32(2), resp.FailedCount)
+		require.Len(t, resp.Failures, 2)
+		require.Equal(t, otherMemo.Name, resp.Failures[0].Name)
+		require.Equal(t, "permission denied", resp.Failures[0].Reason)
+		require.Equal(t, "memos/unknown", resp.Failures[1].Name)
+		require.Equal(t, "memo not found", resp.Failures[1].Reason)
+
+		require.Equal(t, apiv1.Visibility_PRIVATE, getMemo(first.Name).Visibility)
+		require.Equal(t, apiv1.Visibility_PRIVATE, getMemo(second.Name).Visibility)
+		otherGot, err := ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: otherMemo.Name})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.Visibility_PUBLIC, otherGot.Visibility)
+	})
+
+	t.Run("tags are added and removed", func(t *testing.T) {
+		resp := batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name, second.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_AddTag{AddTag: "work"},
+		})
+		require.Equal(t, int32(2), resp.SucceededCount)
+		require.Equal(t, "first #work", getMemo(first.Name).Content)
+		require.Equal(t, "second\n\n#work", getMemo(second.Name).Content)
+		require.Equal(t, []string{"work"}, getMemo(second.Name).Tags)
+
+		resp = batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name, second.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_RemoveTag{RemoveTag: "Work"},
+		})
+		require.Equal(t, int32(2), resp.SucceededCount)
+		require.Equal(t, "first", getMemo(first.Name).Content)
+		require.Equal(t, "second", getMemo(second.Name).Content)
+		require.Empty(t, getMemo(second.Name).Tags)
+	})
+
+	t.Run("memos are archived, unarchived and moved to the trash", func(t *testing.T) {
+		resp := batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name, second.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_SetState{SetState: apiv1.State_ARCHIVED},
+		})
+		require.Equal(t, int32(2), resp.SucceededCount)
+		require.Equal(t, apiv1.State_ARCHIVED, getMemo(first.Name).State)
+		resp = batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_SetState{SetState: apiv1.State_NORMAL},
+		})
+		require.Equal(t, int32(1), resp.SucceededCount)
+		require.Equal(t, apiv1.State_NORMAL, getMemo(first.Name).State)
+
+		resp = batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name, otherMemo.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_MoveToTrash{MoveToTrash: true},
+		})
+		require.Equal(t, int32(1), resp.SucceededCount)
+		require.Equal(t, int32(1), resp.FailedCount)
+		require.NotNil(t, getMemo(first.Name).DeleteTime)
+
+		// Memos in the trash are not found, as with the single-memo endpoints.
+		resp = batchUpdate(&apiv1.BatchUpdateMemosRequest{
+			Names:     []string{first.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_SetState{SetState: apiv1.State_ARCHIVED},
+		})
+		require.Equal(t, int32(1), resp.FailedCount)
+		require.Equal(t, "memo not found", resp.Failures[0].Reason)
+	})
+
+	t.Run("invalid requests fail as a whole", func(t *testing.T) {
+		tooMany := []string{}
+		for i := 0; i <= 100; i++ {
+			tooMany = append(tooMany, fmt.Sprintf("memos/memo-%d", i))
+		}
+		for _, request := range []*apiv1.BatchUpdateMemosRequest{
+			{Names: []string{second.Name}},
+			{Names: []string{}, Operation: &apiv1.BatchUpdateMemosRequest_MoveToTrash{MoveToTrash: true}},
+			{Names: tooMany, Operation: &apiv1.BatchUpdateMemosRequest_MoveToTrash{MoveToTrash: true}},
+			{Names: []string{second.Name, second.Name}, Operation: &apiv1.BatchUpdateMemosRequest_MoveToTrash{MoveToTrash: true}},
+			{Names: []string{second.Name}, Operation: &apiv1.BatchUpdateMemosRequest_AddTag{AddTag: "two words"}},
+			{Names: []string{second.Name}, Operation: &apiv1.BatchUpdateMemosRequest_SetState{SetState: apiv1.State_STATE_UNSPECIFIED}},
+		} {
+			_, err := ts.Service.BatchUpdateMemos(userCtx, request)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		require.Equal(t, "second", getMemo(second.Name).Content)
+
+		_, err := ts.Service.BatchUpdateMemos(ctx, &apiv1.BatchUpdateMemosRequest{
+			Names:     []string{second.Name},
+			Operation: &apiv1.BatchUpdateMemosRequest_MoveToTrash{MoveToTrash: true},
+		})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
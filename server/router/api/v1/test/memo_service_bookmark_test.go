@@ -0,0 +1,95 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestCreateBookmarkMemo(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		_, err := ts.Service.CreateBookmarkMemo(ctx, &apiv1.CreateBookmarkMemoRequest{Url: "https://example.com"})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		for _, url := range []string{"", "example.com", "ftp://example.com/file", "https://"} {
+			_, err := ts.Service.CreateBookmarkMemo(userCtx, &apiv1.CreateBookmarkMemoRequest{Url: url})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), url)
+		}
+		_, err = ts.Service.CreateBookmarkMemo(userCtx, &apiv1.CreateBookmarkMemoRequest{Url: "http://127.0.0.1/a", Tags: []string{"two words"}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	// Internal hosts can't be fetched, so the memo is created with the bare link.
+	var bookmarked *apiv1.Memo
+	t.Run("failed metadata fetches create the bare link", func(t *testing.T) {
+		resp, err := ts.Service.CreateBookmarkMemo(userCtx, &apiv1.CreateBookmarkMemoRequest{
+			Url:     "http://127.0.0.1/article#section",
+			Comment: " Read later ",
+			Tags:    []string{"reading", "links"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Warning)
+		require.False(t, resp.Duplicate)
+		require.Equal(t, "http://127.0.0.1/article\n\nRead later\n\n#reading #links", resp.Memo.Content)
+		require.ElementsMatch(t, []string{"reading", "links"}, resp.Memo.Tags)
+		require.Equal(t, apiv1.Visibility_PRIVATE, resp.Memo.Visibility)
+		require.Equal(t, "http://127.0.0.1/article", resp.Memo.Bookmark.GetUrl())
+		require.Empty(t, resp.Memo.Bookmark.GetTitle())
+		bookmarked = resp.Memo
+	})
+
+	t.Run("links bookmarked again return the memo", func(t *testing.T) {
+		resp, err := ts.Service.CreateBookmarkMemo(userCtx, &apiv1.CreateBookmarkMemoRequest{Url: "HTTP://127.0.0.1/article"})
+		require.NoError(t, err)
+		require.True(t, resp.Duplicate)
+		require.Equal(t, bookmarked.Name, resp.Memo.Name)
+
+		// The bookmark survives edits of the memo.
+		memo, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: bookmarked.Name, Content: "http://127.0.0.1/article\n\nEdited"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "http://127.0.0.1/article", memo.Bookmark.GetUrl())
+
+		// The links of other users are their own.
+		resp, err = ts.Service.CreateBookmarkMemo(otherCtx, &apiv1.CreateBookmarkMemoRequest{Url: "http://127.0.0.1/article"})
+		require.NoError(t, err)
+		require.False(t, resp.Duplicate)
+		require.NotEqual(t, bookmarked.Name, resp.Memo.Name)
+	})
+
+	t.Run("default memo visibility of the user applies", func(t *testing.T) {
+		_, err := ts.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: user.ID,
+			Key:    storepb.UserSetting_GENERAL,
+			Value:  &storepb.UserSetting_General{General: &storepb.GeneralUserSetting{MemoVisibility: "PROTECTED"}},
+		})
+		require.NoError(t, err)
+		resp, err := ts.Service.CreateBookmarkMemo(userCtx, &apiv1.CreateBookmarkMemoRequest{Url: "http://127.0.0.1/other"})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.Visibility_PROTECTED, resp.Memo.Visibility)
+
+		resp, err = ts.Service.CreateBookmarkMemo(userCtx, &apiv1.CreateBookmarkMemoRequest{Url: "http://127.0.0.1/public", Visibility: apiv1.Visibility_PUBLIC})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.Visibility_PUBLIC, resp.Memo.Visibility)
+	})
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestDisableMemoComments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "memo", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.False(t, memo.DisableComments)
+	comment := func() error {
+		_, err := ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: "comment", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		return err
+	}
+	setDisableComments := func(ctx context.Context, disableComments bool) (*apiv1.Memo, error) {
+		return ts.Service.UpdateMemo(ctx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, DisableComments: disableComments},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"disable_comments"}},
+		})
+	}
+	listComments := func() *apiv1.ListMemoCommentsResponse {
+		resp, err := ts.Service.ListMemoComments(otherCtx, &apiv1.ListMemoCommentsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		return resp
+	}
+	require.NoError(t, comment())
+
+	t.Run("only the creator disables the comments", func(t *testing.T) {
+		_, err := setDisableComments(hostCtx, true)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		updated, err := setDisableComments(userCtx, true)
+		require.NoError(t, err)
+		require.True(t, updated.DisableComments)
+		got, err := ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.True(t, got.DisableComments)
+	})
+
+	t.Run("new comments are rejected and existing ones are kept", func(t *testing.T) {
+		require.Equal(t, codes.FailedPrecondition, status.Code(comment()))
+		resp := listComments()
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, int32(1), resp.TotalSize)
+	})
+
+	t.Run("comments are accepted again once enabled", func(t *testing.T) {
+		_, err := setDisableComments(userCtx, false)
+		require.NoError(t, err)
+		require.NoError(t, comment())
+		require.Len(t, listComments().Memos, 2)
+	})
+
+	t.Run("memos are created with comments disabled", func(t *testing.T) {
+		created, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "no comments", Visibility: apiv1.Visibility_PUBLIC, DisableComments: true},
+		})
+		require.NoError(t, err)
+		require.True(t, created.DisableComments)
+	})
+}
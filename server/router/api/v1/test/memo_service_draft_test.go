@@ -0,0 +1,112 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoDraft(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	draft, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "unfinished thoughts", Visibility: apiv1.Visibility_PUBLIC, State: apiv1.State_DRAFT},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_DRAFT, draft.State)
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "finished thoughts", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	// The draft is created in the past, publishing it moves it to the publish time.
+	draftUID := draft.Name[len("memos/"):]
+	stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &draftUID})
+	require.NoError(t, err)
+	createdTsSec := time.Now().Add(-48 * time.Hour).Unix()
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdTsSec, UpdatedTs: &createdTsSec}))
+
+	listContents := func(ctx context.Context, request *apiv1.ListMemosRequest) []string {
+		resp, err := ts.Service.ListMemos(ctx, request)
+		require.NoError(t, err)
+		contents := []string{}
+		for _, memo := range resp.Memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	t.Run("drafts are only listed on request by their creator", func(t *testing.T) {
+		require.Equal(t, []string{"finished thoughts"}, listContents(userCtx, &apiv1.ListMemosRequest{}))
+		require.Equal(t, []string{"finished thoughts"}, listContents(otherCtx, &apiv1.ListMemosRequest{}))
+		require.Empty(t, listContents(userCtx, &apiv1.ListMemosRequest{Search: "unfinished"}))
+		require.Equal(t, []string{"unfinished thoughts"}, listContents(userCtx, &apiv1.ListMemosRequest{State: apiv1.State_DRAFT}))
+		require.Empty(t, listContents(otherCtx, &apiv1.ListMemosRequest{State: apiv1.State_DRAFT}))
+		_, err := ts.Service.ListMemos(ctx, &apiv1.ListMemosRequest{State: apiv1.State_DRAFT})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("drafts are only visible to their creator", func(t *testing.T) {
+		_, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: draft.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: draft.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: draft.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    draft.Name,
+			Comment: &apiv1.Memo{Content: "a comment", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("the memo counts include the drafts", func(t *testing.T) {
+		counts, err := ts.Service.GetMemoCounts(userCtx, &apiv1.GetMemoCountsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &apiv1.GetMemoCountsResponse{NormalCount: 1, DraftCount: 1}, counts)
+		_, err = ts.Service.GetMemoCounts(ctx, &apiv1.GetMemoCountsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("a draft is published at the publish time", func(t *testing.T) {
+		_, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: draft.Name, State: apiv1.State_ARCHIVED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		beforeSec := time.Now().Unix()
+		published, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: draft.Name, State: apiv1.State_NORMAL},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.State_NORMAL, published.State)
+		require.GreaterOrEqual(t, published.CreateTime.AsTime().Unix(), beforeSec)
+		require.GreaterOrEqual(t, published.DisplayTime.AsTime().Unix(), beforeSec)
+		require.ElementsMatch(t, []string{"unfinished thoughts", "finished thoughts"}, listContents(otherCtx, &apiv1.ListMemosRequest{}))
+
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: draft.Name, State: apiv1.State_DRAFT},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestDuplicateMemo(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createAttachment := func(filename string) *apiv1.Attachment {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &apiv1.CreateAttachmentRequest{
+			Attachment: &apiv1.Attachment{Filename: filename, Type: "text/plain", Content: []byte(filename)},
+		})
+		require.NoError(t, err)
+		return attachment
+	}
+	referenced, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "referenced", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:     "hello #work",
+			Visibility:  apiv1.Visibility_PUBLIC,
+			Attachments: []*apiv1.Attachment{createAttachment("one.txt"), createAttachment("two.txt")},
+			Relations: []*apiv1.MemoRelation{{
+				RelatedMemo: &apiv1.MemoRelation_Memo{Name: referenced.Name},
+				Type:        apiv1.MemoRelation_REFERENCE,
+			}},
+		},
+	})
+	require.NoError(t, err)
+	memo.Pinned = true
+	memo, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}}})
+	require.NoError(t, err)
+	require.True(t, memo.Pinned)
+
+	t.Run("the creator gets a private copy with the attachments", func(t *testing.T) {
+		duplicated, err := ts.Service.DuplicateMemo(userCtx, &apiv1.DuplicateMemoRequest{Name: memo.Name, CopyTags: true, CopyRelations: true})
+		require.NoError(t, err)
+		require.NotEqual(t, memo.Name, duplicated.Name)
+		require.Equal(t, "hello #work", duplicated.Content)
+		require.Equal(t, []string{"work"}, duplicated.Tags)
+		require.Equal(t, apiv1.Visibility_PRIVATE, duplicated.Visibility)
+		require.False(t, duplicated.Pinned)
+		require.Len(t, duplicated.Attachments, 2)
+		for i, attachment := range duplicated.Attachments {
+			require.NotEqual(t, memo.Attachments[i].Name, attachment.Name)
+			require.Equal(t, memo.Attachments[i].Filename, attachment.Filename)
+		}
+		require.Len(t, duplicated.Relations, 1)
+		require.Equal(t, referenced.Name, duplicated.Relations[0].RelatedMemo.Name)
+
+		// The attachments of the memo are kept.
+		attachments, err := ts.Service.ListMemoAttachments(userCtx, &apiv1.ListMemoAttachmentsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, attachments.Attachments, 2)
+	})
+
+	t.Run("tags and relations are dropped by default", func(t *testing.T) {
+		duplicated, err := ts.Service.DuplicateMemo(userCtx, &apiv1.DuplicateMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, "hello", duplicated.Content)
+		require.Empty(t, duplicated.Tags)
+		require.Empty(t, duplicated.Relations)
+	})
+
+	t.Run("a memo of another user references its origin", func(t *testing.T) {
+		duplicated, err := ts.Service.DuplicateMemo(otherCtx, &apiv1.DuplicateMemoRequest{Name: memo.Name, CopyTags: true})
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("users/%d", other.ID), duplicated.Creator)
+		require.Len(t, duplicated.Attachments, 2)
+		require.Len(t, duplicated.Relations, 1)
+		require.Equal(t, memo.Name, duplicated.Relations[0].RelatedMemo.Name)
+		require.Equal(t, apiv1.MemoRelation_REFERENCE, duplicated.Relations[0].Type)
+	})
+
+	t.Run("private memos of other users can't be duplicated", func(t *testing.T) {
+		private, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "private", Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.DuplicateMemo(otherCtx, &apiv1.DuplicateMemoRequest{Name: private.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.DuplicateMemo(ctx, &apiv1.DuplicateMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,122 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memoexpire"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoExpire(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	expireTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	createMemo := func(content string, expireTime *timestamppb.Timestamp) (*apiv1.Memo, error) {
+		return ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC, ExpireTime: expireTime},
+		})
+	}
+	getStoreMemo := func(name string) *store.Memo {
+		uid := name[len("memos/"):]
+		memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, IncludeTrashed: true, IncludeExpired: true})
+		require.NoError(t, err)
+		return memo
+	}
+	// expire moves the expiry of a memo to the past.
+	expire := func(name string) {
+		expireTsSec := time.Now().Add(-time.Minute).Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: getStoreMemo(name).ID, ExpireTs: &expireTsSec}))
+	}
+	listMemoNames := func(showDeleted bool) []string {
+		resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{ShowDeleted: showDeleted})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range resp.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+
+	t.Run("the expire time must be in the future", func(t *testing.T) {
+		_, err := createMemo("past", timestamppb.New(time.Now().Add(-time.Minute)))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		memo, err := createMemo("future", timestamppb.New(expireTime))
+		require.NoError(t, err)
+		require.Equal(t, expireTime.Unix(), memo.ExpireTime.AsTime().Unix())
+
+		updated, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, updated.ExpireTime)
+	})
+
+	t.Run("comments expire at the latest with their memo", func(t *testing.T) {
+		memo, err := createMemo("memo", timestamppb.New(expireTime))
+		require.NoError(t, err)
+		createComment := func(expireTime *timestamppb.Timestamp) (*apiv1.Memo, error) {
+			return ts.Service.CreateMemoComment(userCtx, &apiv1.CreateMemoCommentRequest{
+				Name:    memo.Name,
+				Comment: &apiv1.Memo{Content: "comment", Visibility: apiv1.Visibility_PUBLIC, ExpireTime: expireTime},
+			})
+		}
+		_, err = createComment(timestamppb.New(expireTime.Add(time.Minute)))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		comment, err := createComment(nil)
+		require.NoError(t, err)
+		require.Equal(t, expireTime.Unix(), comment.ExpireTime.AsTime().Unix())
+		comment, err = createComment(timestamppb.New(expireTime.Add(-time.Minute)))
+		require.NoError(t, err)
+		require.Equal(t, expireTime.Add(-time.Minute).Unix(), comment.ExpireTime.AsTime().Unix())
+
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: comment.Name, ExpireTime: timestamppb.New(expireTime.Add(time.Minute))},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("expired memos are excluded from reads then moved to the trash", func(t *testing.T) {
+		memo, err := createMemo("one-time code", timestamppb.New(expireTime))
+		require.NoError(t, err)
+		require.Contains(t, listMemoNames(false), memo.Name)
+
+		expire(memo.Name)
+		_, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.NotContains(t, listMemoNames(false), memo.Name)
+		require.NotContains(t, listMemoNames(true), memo.Name)
+
+		runner := memoexpire.NewRunner(ts.Store, ts.Service.ExpireMemo)
+		runner.RunOnce(ctx)
+		stored := getStoreMemo(memo.Name)
+		require.NotZero(t, stored.DeletedTs)
+		require.Zero(t, stored.ExpireTs)
+		require.Contains(t, listMemoNames(true), memo.Name)
+
+		// The memo restored from the trash doesn't expire anymore.
+		restored, err := ts.Service.RestoreMemo(userCtx, &apiv1.RestoreMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Nil(t, restored.ExpireTime)
+		require.Contains(t, listMemoNames(false), memo.Name)
+	})
+}
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetMemoHighlights(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string, createdTime time.Time) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		memoUID := memo.Name[len("memos/"):]
+		found, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		createdTsSec := createdTime.Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: found.ID, CreatedTs: &createdTsSec}))
+		return memo
+	}
+	contents := func(memos []*apiv1.Memo) []string {
+		list := []string{}
+		for _, memo := range memos {
+			list = append(list, memo.Content)
+		}
+		return list
+	}
+	getHighlights := func(request *apiv1.GetMemoHighlightsRequest) *apiv1.GetMemoHighlightsResponse {
+		resp, err := ts.Service.GetMemoHighlights(userCtx, request)
+		require.NoError(t, err)
+		return resp
+	}
+
+	createMemo(userCtx, "2020", time.Date(2020, 3, 15, 10, 0, 0, 0, time.UTC))
+	createMemo(userCtx, "2023 late", time.Date(2023, 3, 15, 23, 30, 0, 0, time.UTC))
+	createMemo(userCtx, "2023 next day", time.Date(2023, 3, 16, 0, 30, 0, 0, time.UTC))
+	createMemo(userCtx, "2024 leap day", time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC))
+	archived := createMemo(userCtx, "2021 archived", time.Date(2021, 3, 15, 12, 0, 0, 0, time.UTC))
+	archived.State = apiv1.State_ARCHIVED
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{Memo: archived, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}}})
+	require.NoError(t, err)
+	createMemo(userCtx, "recent", time.Now())
+	createMemo(otherCtx, "2022 other", time.Date(2022, 3, 15, 12, 0, 0, 0, time.UTC))
+
+	t.Run("memos created on the day in previous years", func(t *testing.T) {
+		resp := getHighlights(&apiv1.GetMemoHighlightsRequest{Month: 3, Day: 15})
+		require.Equal(t, []string{"2023 late", "2020"}, contents(resp.OnThisDayMemos))
+		require.Empty(t, resp.RandomMemos)
+
+		resp = getHighlights(&apiv1.GetMemoHighlightsRequest{Month: 2, Day: 29})
+		require.Equal(t, []string{"2024 leap day"}, contents(resp.OnThisDayMemos))
+	})
+
+	t.Run("the day is in the time zone of the user", func(t *testing.T) {
+		_, err := ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+			Setting: &apiv1.UserSetting{
+				Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+				Value: &apiv1.UserSetting_GeneralSetting_{GeneralSetting: &apiv1.UserSetting_GeneralSetting{
+					Timezone: "Asia/Tokyo",
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+		})
+		require.NoError(t, err)
+		defer func() {
+			_, err := ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+				Setting: &apiv1.UserSetting{
+					Name:  fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+					Value: &apiv1.UserSetting_GeneralSetting_{GeneralSetting: &apiv1.UserSetting_GeneralSetting{}},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+			})
+			require.NoError(t, err)
+		}()
+
+		resp := getHighlights(&apiv1.GetMemoHighlightsRequest{Month: 3, Day: 16})
+		require.Equal(t, []string{"2023 next day", "2023 late"}, contents(resp.OnThisDayMemos))
+	})
+
+	t.Run("random memos are older than 90 days", func(t *testing.T) {
+		resp := getHighlights(&apiv1.GetMemoHighlightsRequest{Month: 1, Day: 1, RandomCount: 10})
+		require.Empty(t, resp.OnThisDayMemos)
+		require.ElementsMatch(t, []string{"2020", "2023 late", "2023 next day", "2024 leap day"}, contents(resp.RandomMemos))
+
+		resp = getHighlights(&apiv1.GetMemoHighlightsRequest{Month: 1, Day: 1, RandomCount: 2})
+		require.Len(t, resp.RandomMemos, 2)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, request := range []*apiv1.GetMemoHighlightsRequest{
+			{Month: 2, Day: 30},
+			{Month: 13, Day: 1},
+			{Month: 3},
+			{RandomCount: 21},
+			{RandomCount: -1},
+		} {
+			_, err := ts.Service.GetMemoHighlights(userCtx, request)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		_, err := ts.Service.GetMemoHighlights(ctx, &apiv1.GetMemoHighlightsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
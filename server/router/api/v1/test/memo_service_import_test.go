@@ -0,0 +1,348 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestImportMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	modified := time.Date(2023, 5, 6, 7, 8, 9, 0, time.UTC)
+	archive := func(files map[string]string) []byte {
+		var buf bytes.Buffer
+		writer := zip.NewWriter(&buf)
+		for name, content := range files {
+			file, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+			require.NoError(t, err)
+			_, err = file.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.Close())
+		return buf.Bytes()
+	}
+	importMemos := func(ctx context.Context, content []byte) *apiv1.MemoImport {
+		memoImport, err := ts.Service.ImportMemos(ctx, &apiv1.ImportMemosRequest{Content: content})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			memoImport, err = ts.Service.GetMemoImport(ctx, &apiv1.GetMemoImportRequest{Name: memoImport.Name})
+			require.NoError(t, err)
+			return memoImport.State != apiv1.MemoImport_RUNNING
+		}, 10*time.Second, 10*time.Millisecond)
+		return memoImport
+	}
+	findMemo := func(content string) *store.Memo {
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+		require.NoError(t, err)
+		for _, memo := range memos {
+			if strings.HasPrefix(memo.Content, content) {
+				return memo
+			}
+		}
+		return nil
+	}
+
+	vault := archive(map[string]string{
+		"notes/trip.md":         "---\ncreated: 2024-01-02T03:04:05Z\ntags: [travel, \"#2024\"]\nvisibility: PUBLIC\npinned: true\n---\n\nTrip ![photo](../images/photo.png) ![[map.png|300]] [doc](<docs/my doc.txt>) [[other note]] [web](https://example.com/a.png)\n",
+		"notes/docs/my doc.txt": "doc",
+		"images/photo.png":      "png",
+		"attachments/map.png":   "map",
+		"plain.md":              "Plain #work",
+		"invalid.md":            "---\ntags: [unclosed\n---\nInvalid",
+		".obsidian/readme.md":   "ignored",
+		"notes/readme.txt":      "not a memo",
+	})
+
+	t.Run("markdown files are imported as memos", func(t *testing.T) {
+		memoImport := importMemos(userCtx, vault)
+		require.Equal(t, apiv1.MemoImport_SUCCEEDED, memoImport.State)
+		require.Equal(t, int32(3), memoImport.TotalFiles)
+		require.Equal(t, int32(3), memoImport.ProcessedFiles)
+		require.Equal(t, int32(2), memoImport.CreatedMemos)
+		require.Len(t, memoImport.Errors, 1)
+		require.Equal(t, "invalid.md", memoImport.Errors[0].Filename)
+		require.Contains(t, memoImport.Errors[0].Reason, "invalid front matter")
+		require.NotNil(t, memoImport.FinishTime)
+
+		trip := findMemo("Trip")
+		require.NotNil(t, trip)
+		require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Unix(), trip.CreatedTs)
+		require.Equal(t, store.Public, trip.Visibility)
+		require.True(t, trip.Pinned)
+		// The tags of the front matter are appended to the content.
+		require.Equal(t, []string{"travel", "2024"}, trip.Payload.Tags)
+		require.True(t, strings.HasSuffix(trip.Content, "\n\n#travel #2024"))
+
+		attachments, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &trip.ID})
+		require.NoError(t, err)
+		require.Len(t, attachments, 3)
+		for _, attachment := range attachments {
+			require.Contains(t, trip.Content, "/file/attachments/"+attachment.UID+"/")
+		}
+		require.NotContains(t, trip.Content, "../images/photo.png")
+		require.NotContains(t, trip.Content, "[[map.png")
+		require.Contains(t, trip.Content, "[[other note]]")
+		require.Contains(t, trip.Content, "[web](https://example.com/a.png)")
+
+		plain := findMemo("Plain")
+		require.NotNil(t, plain)
+		require.Equal(t, modified.Unix(), plain.CreatedTs)
+		require.Equal(t, store.Private, plain.Visibility)
+		require.Equal(t, []string{"work"}, plain.Payload.Tags)
+	})
+
+	t.Run("files already imported are skipped", func(t *testing.T) {
+		memoImport := importMemos(userCtx, vault)
+		require.Equal(t, int32(0), memoImport.CreatedMemos)
+		require.Equal(t, int32(2), memoImport.SkippedFiles)
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+		require.NoError(t, err)
+		require.Len(t, memos, 2)
+
+		// Other users import the files as their own memos.
+		memoImport = importMemos(otherCtx, vault)
+		require.Equal(t, int32(2), memoImport.CreatedMemos)
+	})
+
+	t.Run("imports are only visible to their creator", func(t *testing.T) {
+		memoImport := importMemos(userCtx, archive(map[string]string{"new.md": "New"}))
+		_, err := ts.Service.GetMemoImport(otherCtx, &apiv1.GetMemoImportRequest{Name: memoImport.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.GetMemoImport(ctx, &apiv1.GetMemoImportRequest{Name: memoImport.Name})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("imports count against the memo quotas", func(t *testing.T) {
+		host, err := ts.CreateHostUser(ctx, "host")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, host.ID)
+		setQuotas := func(maxMemos, maxPublicMemosPerDay int32) {
+			_, err := ts.Service.UpdateInstanceSetting(hostCtx, &apiv1.UpdateInstanceSettingRequest{
+				Setting: &apiv1.InstanceSetting{
+					Name: "instance/settings/MEMO_RELATED",
+					Value: &apiv1.InstanceSetting_MemoRelatedSetting_{MemoRelatedSetting: &apiv1.InstanceSetting_MemoRelatedSetting{
+						MaxMemosPerUser:                maxMemos,
+						NewAccountMaxPublicMemosPerDay: maxPublicMemosPerDay,
+					}},
+				},
+			})
+			require.NoError(t, err)
+		}
+		setQuotas(2, 1)
+		defer setQuotas(0, 0)
+
+		limited, err := ts.CreateRegularUser(ctx, "limited")
+		require.NoError(t, err)
+		public := "---\nvisibility: PUBLIC\n---\n"
+		memoImport := importMemos(ts.CreateUserContext(ctx, limited.ID), archive(map[string]string{
+			"a.md": public + "A",
+			"b.md": public + "B",
+			"c.md": public + "C",
+		}))
+		require.Equal(t, int32(2), memoImport.CreatedMemos)
+		require.Len(t, memoImport.Errors, 1)
+		require.Contains(t, memoImport.Errors[0].Reason, "at most 2 memos")
+
+		// New accounts import public memos as private.
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &limited.ID})
+		require.NoError(t, err)
+		require.Len(t, memos, 2)
+		for _, memo := range memos {
+			require.Equal(t, store.Private, memo.Visibility)
+		}
+	})
+
+	t.Run("invalid archives are rejected", func(t *testing.T) {
+		_, err := ts.Service.ImportMemos(userCtx, &apiv1.ImportMemosRequest{Content: []byte("not a zip")})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestImportGoogleKeepNotes(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"Takeout/Keep/Groceries.json": `{"color":"YELLOW","isTrashed":false,"isPinned":true,"isArchived":false,"title":"Groceries",` +
+			`"listContent":[{"text":"Milk","isChecked":true},{"text":"Eggs","isChecked":false}],"labels":[{"name":"Shopping list"}],` +
+			`"attachments":[{"filePath":"photo.jpeg","mimetype":"image/jpeg"}],"userEditedTimestampUsec":1700000000000000,"createdTimestampUsec":1600000000000000}`,
+		"Takeout/Keep/Groceries.html": "<html></html>",
+		"Takeout/Keep/photo.jpg":      "jpg",
+		"Takeout/Keep/Old.json": `{"color":"DEFAULT","isTrashed":false,"isPinned":false,"isArchived":true,"textContent":"Old idea",` +
+			`"annotations":[{"source":"WEBLINK","url":"https://example.com"}],"userEditedTimestampUsec":1500000000000000}`,
+		"Takeout/Keep/Deleted.json":    `{"isTrashed":true,"textContent":"Deleted idea","userEditedTimestampUsec":1500000000000000}`,
+		"Takeout/Keep/Missing.json":    `{"textContent":"Missing","attachments":[{"filePath":"missing.png"}],"userEditedTimestampUsec":1500000000000000}`,
+		"Takeout/archive_browser.json": `{"files":[]}`,
+	} {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	importNotes := func() *apiv1.MemoImport {
+		memoImport, err := ts.Service.ImportMemos(userCtx, &apiv1.ImportMemosRequest{Content: buf.Bytes(), Format: apiv1.ImportMemosRequest_GOOGLE_KEEP})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			memoImport, err = ts.Service.GetMemoImport(userCtx, &apiv1.GetMemoImportRequest{Name: memoImport.Name})
+			require.NoError(t, err)
+			return memoImport.State != apiv1.MemoImport_RUNNING
+		}, 10*time.Second, 10*time.Millisecond)
+		return memoImport
+	}
+
+	t.Run("notes are imported as memos", func(t *testing.T) {
+		memoImport := importNotes()
+		require.Equal(t, apiv1.MemoImport_SUCCEEDED, memoImport.State)
+		require.Equal(t, int32(5), memoImport.TotalFiles)
+		require.Equal(t, int32(3), memoImport.CreatedMemos)
+		require.Len(t, memoImport.Errors, 2)
+
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, IncludeTrashed: true})
+		require.NoError(t, err)
+		require.Len(t, memos, 3)
+		byContent := map[string]*store.Memo{}
+		for _, memo := range memos {
+			byContent[strings.SplitN(memo.Content, "\n", 2)[0]] = memo
+		}
+
+		groceries := byContent["# Groceries"]
+		require.NotNil(t, groceries)
+		require.Equal(t, "# Groceries\n\n- [x] Milk\n- [ ] Eggs\n\n#Shopping-list", groceries.Content)
+		require.Equal(t, []string{"Shopping-list"}, groceries.Payload.Tags)
+		require.Equal(t, int64(1700000000), groceries.CreatedTs)
+		require.True(t, groceries.Pinned)
+		attachments, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &groceries.ID})
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		require.Equal(t, "photo.jpg", attachments[0].Filename)
+
+		old := byContent["Old idea"]
+		require.NotNil(t, old)
+		require.Equal(t, store.Archived, old.RowStatus)
+		require.Equal(t, int64(1500000000), old.CreatedTs)
+		deleted := byContent["Deleted idea"]
+		require.NotNil(t, deleted)
+		require.NotZero(t, deleted.DeletedTs)
+	})
+
+	t.Run("notes already imported are skipped", func(t *testing.T) {
+		memoImport := importNotes()
+		require.Equal(t, int32(0), memoImport.CreatedMemos)
+		require.Equal(t, int32(3), memoImport.SkippedFiles)
+	})
+}
+
+func TestImportDayOneJournals(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"Journal.json": `{"metadata":{"version":"1.0"},"entries":[` +
+			`{"uuid":"TRIP","creationDate":"2019-06-07T08:09:10Z","modifiedDate":"2019-06-08T08:09:10Z","starred":true,"tags":["travel","day one"],` +
+			`"text":"Lisbon\n\n![](dayone-moment://PHOTO)\n\n![](dayone-moment:/pdfAttachment/TICKET)\n\n![](dayone-moment://MISSING)",` +
+			`"location":{"placeName":"Belém Tower","localityName":"Lisbon","country":"Portugal","latitude":38.69,"longitude":-9.21},` +
+			`"photos":[{"identifier":"PHOTO","md5":"aaaa","type":"jpeg"},{"identifier":"MISSING","md5":"ffff","type":"jpeg"}],` +
+			`"pdfAttachments":[{"identifier":"TICKET","md5":"bbbb","type":"pdf"}],"weather":{"temperatureCelsius":21}},` +
+			`{"uuid":"BROKEN","creationDate":"not a date","text":"Broken"},` +
+			`{"uuid":"UNDATED","text":"Undated"}` +
+			`]}`,
+		"photos/aaaa.jpeg": "jpeg",
+		"pdfs/bbbb.pdf":    "pdf",
+		"Other.json":       `{"files":[]}`,
+	} {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	importJournals := func() *apiv1.MemoImport {
+		memoImport, err := ts.Service.ImportMemos(userCtx, &apiv1.ImportMemosRequest{Content: buf.Bytes(), Format: apiv1.ImportMemosRequest_DAY_ONE})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			memoImport, err = ts.Service.GetMemoImport(userCtx, &apiv1.GetMemoImportRequest{Name: memoImport.Name})
+			require.NoError(t, err)
+			return memoImport.State != apiv1.MemoImport_RUNNING
+		}, 10*time.Second, 10*time.Millisecond)
+		return memoImport
+	}
+
+	t.Run("entries are imported as memos", func(t *testing.T) {
+		memoImport := importJournals()
+		require.Equal(t, apiv1.MemoImport_SUCCEEDED, memoImport.State)
+		require.Equal(t, int32(4), memoImport.TotalFiles)
+		require.Equal(t, int32(4), memoImport.ProcessedFiles)
+		require.Equal(t, int32(1), memoImport.CreatedMemos)
+		filenames := []string{}
+		for _, fileError := range memoImport.Errors {
+			filenames = append(filenames, fileError.Filename)
+		}
+		require.ElementsMatch(t, []string{"Other.json", "Journal.json#BROKEN", "Journal.json#UNDATED"}, filenames)
+
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+		require.NoError(t, err)
+		require.Len(t, memos, 1)
+		trip := memos[0]
+		require.Equal(t, time.Date(2019, 6, 7, 8, 9, 10, 0, time.UTC).Unix(), trip.CreatedTs)
+		require.Equal(t, time.Date(2019, 6, 8, 8, 9, 10, 0, time.UTC).Unix(), trip.UpdatedTs)
+		require.True(t, trip.Pinned)
+		require.Equal(t, []string{"travel", "day-one"}, trip.Payload.Tags)
+		require.NotNil(t, trip.Payload.Location)
+		require.Equal(t, "Belém Tower, Lisbon, Portugal", trip.Payload.Location.Placeholder)
+		require.Equal(t, 38.69, trip.Payload.Location.Latitude)
+		require.NotContains(t, trip.Content, "dayone-moment")
+
+		attachments, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &trip.ID})
+		require.NoError(t, err)
+		require.Len(t, attachments, 2)
+		for _, attachment := range attachments {
+			require.Contains(t, trip.Content, "/file/attachments/"+attachment.UID+"/")
+		}
+		require.Contains(t, trip.Content, "![](/file/attachments/")
+		require.Contains(t, trip.Content, "[bbbb.pdf](/file/attachments/")
+	})
+
+	t.Run("entries already imported are skipped", func(t *testing.T) {
+		memoImport := importJournals()
+		require.Equal(t, int32(0), memoImport.CreatedMemos)
+		require.Equal(t, int32(1), memoImport.SkippedFiles)
+	})
+}
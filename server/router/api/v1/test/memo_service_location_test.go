@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoLocation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string, location *apiv1.Location) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC, Location: location},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	listContents := func(ctx context.Context, filter string) []string {
+		resp, err := ts.Service.ListMemos(ctx, &apiv1.ListMemosRequest{Filter: filter})
+		require.NoError(t, err)
+		contents := []string{}
+		for _, memo := range resp.Memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	paris := createMemo(userCtx, "paris", &apiv1.Location{Placeholder: "Paris", Latitude: 48.8566, Longitude: 2.3522})
+	createMemo(userCtx, "versailles", &apiv1.Location{Placeholder: "Versailles", Latitude: 48.8049, Longitude: 2.1204})
+	createMemo(userCtx, "fiji", &apiv1.Location{Placeholder: "Fiji", Latitude: -17.7134, Longitude: 178.065, ShowPublicly: true})
+	createMemo(userCtx, "no location", nil)
+	createMemo(otherCtx, "other paris", &apiv1.Location{Placeholder: "Paris", Latitude: 48.8584, Longitude: 2.2945})
+
+	t.Run("invalid locations are rejected", func(t *testing.T) {
+		for _, location := range []*apiv1.Location{
+			{Latitude: 90.5},
+			{Latitude: math.NaN()},
+			{Longitude: -181},
+			{Placeholder: strings.Repeat("é", 257)},
+		} {
+			_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+				Memo: &apiv1.Memo{Content: "invalid", Visibility: apiv1.Visibility_PUBLIC, Location: location},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		_, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: paris.Name, Location: &apiv1.Location{Latitude: -91}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"location"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("the location is only shown to others if shown publicly", func(t *testing.T) {
+		got, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: paris.Name})
+		require.NoError(t, err)
+		require.Equal(t, "Paris", got.Location.GetPlaceholder())
+		got, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: paris.Name})
+		require.NoError(t, err)
+		require.Nil(t, got.Location)
+
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: paris.Name, Location: &apiv1.Location{Placeholder: "Paris", Latitude: 48.8566, Longitude: 2.3522, ShowPublicly: true}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"location"}},
+		})
+		require.NoError(t, err)
+		got, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: paris.Name})
+		require.NoError(t, err)
+		require.True(t, got.Location.GetShowPublicly())
+		require.Equal(t, 48.8566, got.Location.GetLatitude())
+	})
+
+	t.Run("memos are filtered by a box", func(t *testing.T) {
+		require.ElementsMatch(t, []string{"paris", "versailles"}, listContents(userCtx, `location_within_box(48, 2, 49, 3)`))
+		require.ElementsMatch(t, []string{"fiji"}, listContents(userCtx, `location_within_box(-20, 170, -10, -170)`))
+		// The location of versailles isn't shown to other users.
+		require.ElementsMatch(t, []string{"paris", "other paris"}, listContents(otherCtx, `location_within_box(48, 2, 49, 3)`))
+		require.ElementsMatch(t, []string{"paris"}, listContents(ctx, `location_within_box(48, 2, 49, 3)`))
+	})
+
+	t.Run("memos are filtered by a radius", func(t *testing.T) {
+		// Versailles is about 17 km from Paris.
+		require.ElementsMatch(t, []string{"paris"}, listContents(userCtx, `location_within_radius(48.8566, 2.3522, 10)`))
+		require.ElementsMatch(t, []string{"paris", "versailles"}, listContents(userCtx, `location_within_radius(48.8566, 2.3522, 20)`))
+		require.ElementsMatch(t, []string{"paris", "versailles"}, listContents(userCtx, fmt.Sprintf("location_within_radius(48.8566, 2.3522, 20) && creator_id == %d", user.ID)))
+	})
+
+	t.Run("invalid location filters are rejected", func(t *testing.T) {
+		for _, filter := range []string{
+			`location_within_box(49, 2, 48, 3)`,
+			`location_within_box(48, 2, 49, 181)`,
+			`location_within_radius(48.8566, 2.3522, 0)`,
+			`location_within_radius(48.8566, 2.3522)`,
+		} {
+			_, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: filter})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+		}
+	})
+
+	t.Run("only visible locations are duplicated", func(t *testing.T) {
+		hidden := createMemo(userCtx, "hidden", &apiv1.Location{Placeholder: "Home", Latitude: 1, Longitude: 1})
+		duplicate, err := ts.Service.DuplicateMemo(otherCtx, &apiv1.DuplicateMemoRequest{Name: hidden.Name})
+		require.NoError(t, err)
+		require.Nil(t, duplicate.Location)
+		duplicate, err = ts.Service.DuplicateMemo(userCtx, &apiv1.DuplicateMemoRequest{Name: hidden.Name})
+		require.NoError(t, err)
+		require.Equal(t, "Home", duplicate.Location.GetPlaceholder())
+	})
+}
@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMergeMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, memo *apiv1.Memo) *apiv1.Memo {
+		memo.Visibility = apiv1.Visibility_PUBLIC
+		created, err := ts.Service.CreateMemo(ctx, &apiv1.CreateMemoRequest{Memo: memo})
+		require.NoError(t, err)
+		return created
+	}
+	createAttachment := func(filename string) *apiv1.Attachment {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &apiv1.CreateAttachmentRequest{
+			Attachment: &apiv1.Attachment{Filename: filename, Type: "text/plain", Content: []byte(filename)},
+		})
+		require.NoError(t, err)
+		return attachment
+	}
+	react := func(ctx context.Context, name, reactionType string) {
+		_, err := ts.Service.UpsertMemoReaction(ctx, &apiv1.UpsertMemoReactionRequest{
+			Name:     name,
+			Reaction: &apiv1.Reaction{ContentId: name, ReactionType: reactionType},
+		})
+		require.NoError(t, err)
+	}
+	getStoreMemo := func(name string) *store.Memo {
+		uid := name[len("memos/"):]
+		memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, IncludeTrashed: true})
+		require.NoError(t, err)
+		return memo
+	}
+
+	referenced := createMemo(userCtx, &apiv1.Memo{Content: "referenced"})
+	target := createMemo(userCtx, &apiv1.Memo{Content: "target #a", Attachments: []*apiv1.Attachment{createAttachment("one.txt")}})
+	source := createMemo(userCtx, &apiv1.Memo{
+		Content:     "source #b",
+		Attachments: []*apiv1.Attachment{createAttachment("two.txt")},
+		Relations: []*apiv1.MemoRelation{{
+			RelatedMemo: &apiv1.MemoRelation_Memo{Name: referenced.Name},
+			Type:        apiv1.MemoRelation_REFERENCE,
+		}},
+	})
+	referrer := createMemo(otherCtx, &apiv1.Memo{
+		Content: "refers to the source",
+		Relations: []*apiv1.MemoRelation{{
+			RelatedMemo: &apiv1.MemoRelation_Memo{Name: source.Name},
+			Type:        apiv1.MemoRelation_REFERENCE,
+		}},
+	})
+	comment, err := ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    source.Name,
+		Comment: &apiv1.Memo{Content: "a comment", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	react(otherCtx, target.Name, "👍")
+	react(otherCtx, source.Name, "👍")
+	react(otherCtx, source.Name, "🎉")
+	// The source is the older memo.
+	createdTsSec := getStoreMemo(target.Name).CreatedTs - 1000
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: getStoreMemo(source.Name).ID, CreatedTs: &createdTsSec}))
+
+	t.Run("invalid merges are rejected", func(t *testing.T) {
+		_, err := ts.Service.MergeMemos(userCtx, &apiv1.MergeMemosRequest{Name: target.Name, Source: target.Name})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.MergeMemos(userCtx, &apiv1.MergeMemosRequest{Name: target.Name, Source: referrer.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.MergeMemos(otherCtx, &apiv1.MergeMemosRequest{Name: referrer.Name, Source: comment.Name})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.MergeMemos(userCtx, &apiv1.MergeMemosRequest{Name: target.Name, Source: "memos/unknown"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("the source is merged into the target", func(t *testing.T) {
+		merged, err := ts.Service.MergeMemos(userCtx, &apiv1.MergeMemosRequest{Name: target.Name, Source: source.Name})
+		require.NoError(t, err)
+		require.Equal(t, "target #a\n\nsource #b", merged.Content)
+		require.ElementsMatch(t, []string{"a", "b"}, merged.Tags)
+		require.Len(t, merged.Attachments, 2)
+		require.Len(t, merged.Reactions, 2)
+		require.Equal(t, createdTsSec, merged.CreateTime.AsTime().Unix())
+
+		relations, err := ts.Service.ListMemoRelations(userCtx, &apiv1.ListMemoRelationsRequest{Name: target.Name})
+		require.NoError(t, err)
+		related := map[string]string{}
+		for _, relation := range relations.Relations {
+			related[relation.Memo.Name+" "+relation.RelatedMemo.Name] = relation.Type.String()
+		}
+		require.Equal(t, "REFERENCE", related[target.Name+" "+referenced.Name])
+		require.Equal(t, "REFERENCE", related[referrer.Name+" "+target.Name])
+		require.Equal(t, "COMMENT", related[comment.Name+" "+target.Name])
+		require.Len(t, related, 3)
+
+		// The source in the trash keeps its content and records the merge.
+		trashed := getStoreMemo(source.Name)
+		require.NotZero(t, trashed.DeletedTs)
+		require.Equal(t, "source #b", trashed.Content)
+		sourceRelations, err := ts.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &trashed.ID})
+		require.NoError(t, err)
+		require.Equal(t, []*store.MemoRelation{{MemoID: trashed.ID, RelatedMemoID: getStoreMemo(target.Name).ID, Type: store.MemoRelationMerge}}, sourceRelations)
+	})
+}
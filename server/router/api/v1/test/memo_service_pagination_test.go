@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListMemosPagination(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	createMemo := func(content string) {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+	}
+	for i := 0; i < 5; i++ {
+		createMemo(fmt.Sprintf("memo %d", i))
+	}
+
+	t.Run("memos created while paging don't shift the pages", func(t *testing.T) {
+		first, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageSize: 2})
+		require.NoError(t, err)
+		require.Len(t, first.Memos, 2)
+		require.NotEmpty(t, first.NextPageToken)
+
+		createMemo("new memo")
+
+		names := map[string]bool{}
+		for _, memo := range first.Memos {
+			names[memo.Name] = true
+		}
+		pageToken := first.NextPageToken
+		for pageToken != "" {
+			page, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageToken: pageToken})
+			require.NoError(t, err)
+			for _, memo := range page.Memos {
+				require.False(t, names[memo.Name], "memo listed twice")
+				names[memo.Name] = true
+			}
+			pageToken = page.NextPageToken
+		}
+		require.Len(t, names, 5)
+	})
+
+	t.Run("page tokens are only valid for the same ordering", func(t *testing.T) {
+		first, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageSize: 2, OrderBy: "display_time desc"})
+		require.NoError(t, err)
+
+		_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageToken: first.NextPageToken})
+		require.NoError(t, err)
+		_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageToken: first.NextPageToken, OrderBy: "display_time asc"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("offset page tokens keep working", func(t *testing.T) {
+		b, err := proto.Marshal(&apiv1.PageToken{Limit: 2, Offset: 3})
+		require.NoError(t, err)
+		page, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageToken: base64.StdEncoding.EncodeToString(b)})
+		require.NoError(t, err)
+		require.Len(t, page.Memos, 2)
+		require.NotEmpty(t, page.NextPageToken)
+	})
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMovePinnedMemo(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	names := map[string]string{}
+	for _, content := range []string{"a", "b", "c", "unpinned"} {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		names[content] = memo.Name
+	}
+	setPinned := func(content string, pinned bool) {
+		_, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: names[content], Pinned: pinned},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		})
+		require.NoError(t, err)
+	}
+	move := func(ctx context.Context, content string, request *apiv1.MovePinnedMemoRequest) error {
+		request.Name = names[content]
+		_, err := ts.Service.MovePinnedMemo(ctx, request)
+		return err
+	}
+	listContents := func() []string {
+		resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{OrderBy: "pinned desc, display_time desc"})
+		require.NoError(t, err)
+		contents := []string{}
+		for _, memo := range resp.Memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	// Pinned memos are placed at the top, before the other memos.
+	setPinned("a", true)
+	setPinned("b", true)
+	setPinned("c", true)
+	require.Equal(t, []string{"c", "b", "a", "unpinned"}, listContents())
+
+	require.NoError(t, move(userCtx, "a", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_MoveUp{MoveUp: true}}))
+	require.Equal(t, []string{"c", "a", "b", "unpinned"}, listContents())
+	require.NoError(t, move(userCtx, "c", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_MoveDown{MoveDown: true}}))
+	require.Equal(t, []string{"a", "c", "b", "unpinned"}, listContents())
+	require.NoError(t, move(userCtx, "b", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_Position{Position: 1}}))
+	require.Equal(t, []string{"b", "a", "c", "unpinned"}, listContents())
+
+	// Editing a pinned memo keeps its position.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: names["c"], Content: "c", Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "pinned"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "a", "c", "unpinned"}, listContents())
+
+	setPinned("b", false)
+	require.Equal(t, []string{"a", "c", "unpinned", "b"}, listContents())
+	require.NoError(t, move(userCtx, "c", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_MoveUp{MoveUp: true}}))
+	require.Equal(t, []string{"c", "a", "unpinned", "b"}, listContents())
+
+	t.Run("invalid moves are rejected", func(t *testing.T) {
+		err := move(userCtx, "unpinned", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_MoveUp{MoveUp: true}})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		err = move(userCtx, "a", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_Position{Position: 0}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = move(userCtx, "a", &apiv1.MovePinnedMemoRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = move(otherCtx, "a", &apiv1.MovePinnedMemoRequest{Destination: &apiv1.MovePinnedMemoRequest_MoveUp{MoveUp: true}})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
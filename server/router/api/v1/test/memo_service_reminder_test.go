@@ -0,0 +1,132 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memoreminder"
+)
+
+func TestMemoReminders(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	runner := memoreminder.NewRunner(ts.Store, ts.Service.DeliverMemoReminder)
+	createMemo := func(reminder *apiv1.MemoReminder) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "call the dentist", Visibility: apiv1.Visibility_PUBLIC, Reminder: reminder},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	getMemo := func(ctx context.Context, name string) *apiv1.Memo {
+		memo, err := ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		return memo
+	}
+	listReminderNotifications := func() []*apiv1.UserNotification {
+		resp, err := ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		notifications := []*apiv1.UserNotification{}
+		for _, notification := range resp.Notifications {
+			if notification.Type == apiv1.UserNotification_MEMO_REMINDER {
+				notifications = append(notifications, notification)
+			}
+		}
+		return notifications
+	}
+
+	t.Run("due reminders are delivered once to the inbox of the creator", func(t *testing.T) {
+		remindTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+		memo := createMemo(&apiv1.MemoReminder{RemindTime: timestamppb.New(remindTime)})
+		require.Equal(t, remindTime.Unix(), memo.Reminder.RemindTime.AsTime().Unix())
+		require.Nil(t, memo.Reminder.DeliverTime)
+		// Reminders are personal to the creator.
+		require.Nil(t, getMemo(otherCtx, memo.Name).Reminder)
+
+		notificationCount := len(listReminderNotifications())
+		runner.RunOnce(ctx)
+		runner.RunOnce(ctx)
+		notifications := listReminderNotifications()
+		require.Len(t, notifications, notificationCount+1)
+		require.Equal(t, apiv1.UserNotification_UNREAD, notifications[0].Status)
+
+		activity, err := ts.Service.GetActivity(userCtx, &apiv1.GetActivityRequest{Name: fmt.Sprintf("activities/%d", notifications[0].GetActivityId())})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.Activity_MEMO_REMINDER, activity.Type)
+		require.Equal(t, memo.Name, activity.Payload.GetMemoReminder().Memo)
+
+		delivered := getMemo(userCtx, memo.Name)
+		require.Equal(t, remindTime.Unix(), delivered.Reminder.RemindTime.AsTime().Unix())
+		require.NotNil(t, delivered.Reminder.DeliverTime)
+	})
+
+	t.Run("repeating reminders move to their next occurrence", func(t *testing.T) {
+		remindTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+		memo := createMemo(&apiv1.MemoReminder{RemindTime: timestamppb.New(remindTime), Repeat: apiv1.MemoReminder_DAILY})
+
+		runner.RunOnce(ctx)
+		delivered := getMemo(userCtx, memo.Name)
+		require.Equal(t, apiv1.MemoReminder_DAILY, delivered.Reminder.Repeat)
+		require.Equal(t, remindTime.Add(24*time.Hour).Unix(), delivered.Reminder.RemindTime.AsTime().Unix())
+	})
+
+	t.Run("reminders can be snoozed, completed and cleared", func(t *testing.T) {
+		memo := createMemo(&apiv1.MemoReminder{RemindTime: timestamppb.New(time.Now().Add(-time.Minute))})
+		runner.RunOnce(ctx)
+
+		_, err := ts.Service.SnoozeMemoReminder(userCtx, &apiv1.SnoozeMemoReminderRequest{Name: memo.Name, RemindTime: timestamppb.New(time.Now().Add(-time.Minute))})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.SnoozeMemoReminder(otherCtx, &apiv1.SnoozeMemoReminderRequest{Name: memo.Name, RemindTime: timestamppb.New(time.Now().Add(time.Hour))})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		snoozeTime := time.Now().Add(time.Hour).Truncate(time.Second)
+		snoozed, err := ts.Service.SnoozeMemoReminder(userCtx, &apiv1.SnoozeMemoReminderRequest{Name: memo.Name, RemindTime: timestamppb.New(snoozeTime)})
+		require.NoError(t, err)
+		require.Equal(t, snoozeTime.Unix(), snoozed.Reminder.RemindTime.AsTime().Unix())
+		require.Nil(t, snoozed.Reminder.DeliverTime)
+
+		completed, err := ts.Service.CompleteMemoReminder(userCtx, &apiv1.CompleteMemoReminderRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Nil(t, completed.Reminder)
+		_, err = ts.Service.CompleteMemoReminder(userCtx, &apiv1.CompleteMemoReminderRequest{Name: memo.Name})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		updated, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Reminder: &apiv1.MemoReminder{RemindTime: timestamppb.New(snoozeTime), Repeat: apiv1.MemoReminder_WEEKLY}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"reminder"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, apiv1.MemoReminder_WEEKLY, updated.Reminder.Repeat)
+		updated, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"reminder"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, updated.Reminder)
+
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Reminder: &apiv1.MemoReminder{Repeat: apiv1.MemoReminder_DAILY}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"reminder"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMemoRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(content string) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	updateMemo := func(memo *apiv1.Memo, paths ...string) *apiv1.Memo {
+		updated, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       memo,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		require.NoError(t, err)
+		return updated
+	}
+	listRevisions := func(name string) []*apiv1.MemoRevision {
+		resp, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: name})
+		require.NoError(t, err)
+		return resp.Revisions
+	}
+
+	t.Run("edits are recorded as revisions with their diffs", func(t *testing.T) {
+		memo := createMemo("first\nsecond\nthird")
+		require.Empty(t, listRevisions(memo.Name))
+
+		updateMemo(&apiv1.Memo{Name: memo.Name, Content: "first\nsecond edited\nthird"}, "content")
+		// Identical consecutive contents are recorded once.
+		updateMemo(&apiv1.Memo{Name: memo.Name, Content: "first\nsecond edited\nthird"}, "content")
+		updateMemo(&apiv1.Memo{Name: memo.Name, Pinned: true}, "pinned")
+
+		revisions := listRevisions(memo.Name)
+		require.Len(t, revisions, 2)
+		require.Equal(t, "@@ -1,3 +1,3 @@\n first\n-second\n+second edited\n third\n", revisions[0].Diff)
+		require.Empty(t, revisions[0].Content)
+		require.Equal(t, "@@ -0,0 +1,3 @@\n+first\n+second\n+third\n", revisions[1].Diff)
+		require.Equal(t, memo.Creator, revisions[0].Editor)
+
+		revision, err := ts.Service.GetMemoRevision(userCtx, &apiv1.GetMemoRevisionRequest{Name: revisions[1].Name})
+		require.NoError(t, err)
+		require.Equal(t, "first\nsecond\nthird", revision.Content)
+
+		// Restoring a revision records a new one.
+		restored, err := ts.Service.RestoreMemoRevision(userCtx, &apiv1.RestoreMemoRevisionRequest{Name: revisions[1].Name})
+		require.NoError(t, err)
+		require.Equal(t, "first\nsecond\nthird", restored.Content)
+		revisions = listRevisions(memo.Name)
+		require.Len(t, revisions, 3)
+		require.Equal(t, "@@ -1,3 +1,3 @@\n first\n-second edited\n+second\n third\n", revisions[0].Diff)
+	})
+
+	t.Run("revisions are only available to the creator", func(t *testing.T) {
+		memo := createMemo("private history")
+		updateMemo(&apiv1.Memo{Name: memo.Name, Content: "public history"}, "content")
+		revisions := listRevisions(memo.Name)
+		require.Len(t, revisions, 2)
+
+		_, err := ts.Service.ListMemoRevisions(otherCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.GetMemoRevision(otherCtx, &apiv1.GetMemoRevisionRequest{Name: revisions[1].Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.RestoreMemoRevision(otherCtx, &apiv1.RestoreMemoRevisionRequest{Name: revisions[1].Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		other := createMemo("other")
+		_, err = ts.Service.GetMemoRevision(userCtx, &apiv1.GetMemoRevisionRequest{Name: other.Name + "/revisions/1"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("the oldest revisions are pruned beyond the limit", func(t *testing.T) {
+		_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+			Key: storepb.InstanceSettingKey_MEMO_RELATED,
+			Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: &storepb.InstanceMemoRelatedSetting{
+				MemoRevisionLimit: 3,
+			}},
+		})
+		require.NoError(t, err)
+
+		memo := createMemo("v0")
+		for _, content := range []string{"v1", "v2", "v3", "v4"} {
+			updateMemo(&apiv1.Memo{Name: memo.Name, Content: content}, "content")
+		}
+		resp, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name, PageSize: 2})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 2)
+		require.Equal(t, "@@ -1 +1 @@\n-v3\n+v4\n", resp.Revisions[0].Diff)
+		require.Equal(t, "@@ -1 +1 @@\n-v2\n+v3\n", resp.Revisions[1].Diff)
+		require.NotEmpty(t, resp.NextPageToken)
+
+		resp, err = ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Parent: memo.Name, PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 1)
+		require.Equal(t, "@@ -0,0 +1 @@\n+v2\n", resp.Revisions[0].Diff)
+		require.Empty(t, resp.NextPageToken)
+	})
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopublish"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	scheduleMemo := func(content string, publishTime time.Time) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC, PublishTime: timestamppb.New(publishTime)},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	listMemoNames := func(ctx context.Context, showScheduled bool) []string {
+		resp, err := ts.Service.ListMemos(ctx, &apiv1.ListMemosRequest{ShowScheduled: showScheduled})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range resp.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+
+	t.Run("scheduled memos are only visible to their creator", func(t *testing.T) {
+		memo := scheduleMemo("later", time.Now().Add(time.Hour))
+		require.NotNil(t, memo.PublishTime)
+
+		require.NotContains(t, listMemoNames(userCtx, false), memo.Name)
+		require.Contains(t, listMemoNames(userCtx, true), memo.Name)
+		require.NotContains(t, listMemoNames(otherCtx, true), memo.Name)
+		require.NotContains(t, listMemoNames(otherCtx, false), memo.Name)
+
+		_, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("a publish time in the past publishes the memo", func(t *testing.T) {
+		memo := scheduleMemo("now", time.Now().Add(-time.Hour))
+		require.Nil(t, memo.PublishTime)
+		require.Contains(t, listMemoNames(otherCtx, false), memo.Name)
+	})
+
+	t.Run("scheduled memos can be rescheduled and published now", func(t *testing.T) {
+		memo := scheduleMemo("reschedule me", time.Now().Add(time.Hour))
+		publishTime := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+		rescheduled, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, PublishTime: timestamppb.New(publishTime)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, publishTime.Unix(), rescheduled.PublishTime.AsTime().Unix())
+
+		published, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, published.PublishTime)
+		require.Contains(t, listMemoNames(otherCtx, false), memo.Name)
+
+		// A published memo can't be scheduled again.
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, PublishTime: timestamppb.New(publishTime)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("the runner publishes the due scheduled memos at their publish time", func(t *testing.T) {
+		memo := scheduleMemo("due", time.Now().Add(time.Hour))
+		uid := strings.TrimPrefix(memo.Name, "memos/")
+		storeMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, IncludeScheduled: true})
+		require.NoError(t, err)
+		dueSec := time.Now().Add(-time.Minute).Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, PublishTs: &dueSec}))
+
+		memopublish.NewRunner(ts.Store, ts.Service.PublishScheduledMemo).RunOnce(ctx)
+		published, err := ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Nil(t, published.PublishTime)
+		require.Equal(t, dueSec, published.DisplayTime.AsTime().Unix())
+	})
+}
@@ -0,0 +1,152 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestListMemosSearch(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for _, content := range []string{
+		"A memo about <b>Search</b> engines and search ranking",
+		"Nothing to see here",
+		"今天学习了全文检索的实现",
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: "search"})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, "A memo about &lt;b&gt;<mark>Search</mark>&lt;/b&gt; engines and <mark>search</mark> ranking", resp.Memos[0].SearchSnippet)
+
+	resp, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: "检索"})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, "今天学习了全文<mark>检索</mark>的实现", resp.Memos[0].SearchSnippet)
+
+	// Private memos aren't found by other users.
+	resp, err = ts.Service.ListMemos(ctx, &apiv1.ListMemosRequest{Search: "search"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Memos)
+
+	// Without a search query, no snippets are set.
+	resp, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 3)
+	require.Empty(t, resp.Memos[0].SearchSnippet)
+}
+
+func TestListMemosSearchOperators(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	createMemo := func(content string) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	createMemo("Release notes at [example](https://example.com)")
+	createMemo("Release checklist\n\n- [ ] tag the release\n- [x] write the notes")
+	pinned := createMemo("Release plan")
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: pinned.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	archived := createMemo("Old release")
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: archived.Name, State: apiv1.State_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+
+	search := func(query string) []string {
+		resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: query})
+		require.NoError(t, err)
+		contents := []string{}
+		for _, memo := range resp.Memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents
+	}
+
+	require.Equal(t, []string{"Release notes at [example](https://example.com)"}, search("release has:link"))
+	require.Len(t, search("has:incomplete-task"), 1)
+	require.Equal(t, []string{"Release plan"}, search("is:pinned"))
+	require.Len(t, search("release -is:pinned"), 2)
+	require.Equal(t, []string{"Old release"}, search("is:archived"))
+	require.Empty(t, search("release has:attachment"))
+	// Snippets only highlight the terms.
+	resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: "plan is:pinned"})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Equal(t, "Release <mark>plan</mark>", resp.Memos[0].SearchSnippet)
+
+	_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: "release has:video"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "has:video")
+}
+
+func TestListMemosSearchAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &apiv1.CreateAttachmentRequest{
+		Attachment: &apiv1.Attachment{Filename: "invoice-march.pdf", Type: "application/pdf", Content: []byte("%PDF-1.4")},
+	})
+	require.NoError(t, err)
+	attached, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Paid", Visibility: apiv1.Visibility_PRIVATE, Attachments: []*apiv1.Attachment{attachment}},
+	})
+	require.NoError(t, err)
+	mentioned, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Send the invoice", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: "invoice"})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 2)
+	require.Equal(t, mentioned.Name, resp.Memos[0].Name)
+	require.Equal(t, attached.Name, resp.Memos[1].Name)
+	require.Equal(t, []string{attached.Name}, resp.AttachmentMatchedMemos)
+
+	// Memos matched by their content aren't reported.
+	resp, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Search: "paid"})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 1)
+	require.Empty(t, resp.AttachmentMatchedMemos)
+}
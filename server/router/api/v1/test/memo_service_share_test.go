@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareLinks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "private memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	createShareLink := func(shareLink *v1pb.MemoShareLink) (*v1pb.MemoShareLink, error) {
+		return ts.Service.CreateMemoShareLink(userCtx, &v1pb.CreateMemoShareLinkRequest{Parent: memo.Name, ShareLink: shareLink})
+	}
+	getSharedMemo := func(slug string) (*v1pb.Memo, error) {
+		return ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Slug: slug})
+	}
+
+	t.Run("anyone with the link gets the memo", func(t *testing.T) {
+		shareLink, err := createShareLink(nil)
+		require.NoError(t, err)
+		require.Len(t, shareLink.Slug, 24)
+		require.Equal(t, memo.Name+"/shareLinks/"+shareLink.Slug, shareLink.Name)
+		require.Nil(t, shareLink.ExpireTime)
+
+		for range 2 {
+			shared, err := getSharedMemo(shareLink.Slug)
+			require.NoError(t, err)
+			require.Equal(t, memo.Name, shared.Name)
+			require.Equal(t, "private memo", shared.Content)
+		}
+		// The memo itself is still private.
+		_, err = ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		response, err := ts.Service.ListMemoShareLinks(userCtx, &v1pb.ListMemoShareLinksRequest{Parent: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, response.ShareLinks, 1)
+		require.Equal(t, int32(2), response.ShareLinks[0].ViewCount)
+	})
+
+	t.Run("links stop working once they run out of views", func(t *testing.T) {
+		shareLink, err := createShareLink(&v1pb.MemoShareLink{MaxViews: 1})
+		require.NoError(t, err)
+		_, err = getSharedMemo(shareLink.Slug)
+		require.NoError(t, err)
+		_, err = getSharedMemo(shareLink.Slug)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("expired links are not found", func(t *testing.T) {
+		shareLink, err := createShareLink(&v1pb.MemoShareLink{ExpireTime: timestamppb.New(time.Now().Add(time.Hour))})
+		require.NoError(t, err)
+		require.NotNil(t, shareLink.ExpireTime)
+		slug := shareLink.Slug
+		share, err := ts.Store.GetMemoShare(ctx, &store.FindMemoShare{UID: &slug})
+		require.NoError(t, err)
+		require.NoError(t, ts.Store.DeleteMemoShares(ctx, &store.DeleteMemoShare{ID: &share.ID}))
+		share.ExpiresTs = time.Now().Add(-time.Minute).Unix()
+		_, err = ts.Store.CreateMemoShare(ctx, share)
+		require.NoError(t, err)
+
+		_, err = getSharedMemo(slug)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("revoked links are not found", func(t *testing.T) {
+		shareLink, err := createShareLink(nil)
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemoShareLink(userCtx, &v1pb.DeleteMemoShareLinkRequest{Name: shareLink.Name})
+		require.NoError(t, err)
+		_, err = getSharedMemo(shareLink.Slug)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.DeleteMemoShareLink(userCtx, &v1pb.DeleteMemoShareLinkRequest{Name: shareLink.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("only the creator manages the links", func(t *testing.T) {
+		_, err := ts.Service.CreateMemoShareLink(otherCtx, &v1pb.CreateMemoShareLinkRequest{Parent: memo.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ListMemoShareLinks(otherCtx, &v1pb.ListMemoShareLinksRequest{Parent: memo.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ListMemoShareLinks(ctx, &v1pb.ListMemoShareLinksRequest{Parent: memo.Name})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("invalid links are rejected", func(t *testing.T) {
+		_, err := createShareLink(&v1pb.MemoShareLink{ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = createShareLink(&v1pb.MemoShareLink{MaxViews: -1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("links with a passphrase need to be unlocked", func(t *testing.T) {
+		shareLink, err := createShareLink(&v1pb.MemoShareLink{Passphrase: "open sesame"})
+		require.NoError(t, err)
+		require.True(t, shareLink.HasPassphrase)
+		unlock := func(passphrase string) (*v1pb.UnlockSharedMemoResponse, error) {
+			return ts.Service.UnlockSharedMemo(ctx, &v1pb.UnlockSharedMemoRequest{Slug: shareLink.Slug, Passphrase: passphrase})
+		}
+		getUnlockedMemo := func(accessToken string) error {
+			_, err := ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Slug: shareLink.Slug, AccessToken: accessToken})
+			return err
+		}
+
+		require.Equal(t, codes.Unauthenticated, status.Code(getUnlockedMemo("")))
+		_, err = unlock("wrong")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		unlocked, err := unlock("open sesame")
+		require.NoError(t, err)
+		require.NoError(t, getUnlockedMemo(unlocked.AccessToken))
+		// Only the views with the passphrase are counted.
+		response, err := ts.Service.ListMemoShareLinks(userCtx, &v1pb.ListMemoShareLinksRequest{Parent: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), response.ShareLinks[0].ViewCount)
+
+		// Changing the passphrase invalidates the access tokens of the previous one.
+		updated, err := ts.Service.UpdateMemoShareLink(userCtx, &v1pb.UpdateMemoShareLinkRequest{
+			ShareLink:  &v1pb.MemoShareLink{Name: shareLink.Name, Passphrase: "new passphrase"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"passphrase"}},
+		})
+		require.NoError(t, err)
+		require.True(t, updated.HasPassphrase)
+		require.Equal(t, codes.Unauthenticated, status.Code(getUnlockedMemo(unlocked.AccessToken)))
+		_, err = unlock("open sesame")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// The failed attempts are throttled, even with the right passphrase.
+		for range 4 {
+			_, err = unlock("wrong")
+			require.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+		_, err = unlock("new passphrase")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// The access tokens of a link are only for that link.
+		other, err := createShareLink(nil)
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemoShareLink(userCtx, &v1pb.UpdateMemoShareLinkRequest{
+			ShareLink:  &v1pb.MemoShareLink{Name: other.Name, Passphrase: "other"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"passphrase"}},
+		})
+		require.NoError(t, err)
+		otherUnlocked, err := ts.Service.UnlockSharedMemo(ctx, &v1pb.UnlockSharedMemoRequest{Slug: other.Slug, Passphrase: "other"})
+		require.NoError(t, err)
+		require.Equal(t, codes.Unauthenticated, status.Code(getUnlockedMemo(otherUnlocked.AccessToken)))
+	})
+
+	t.Run("links of memos in the trash are not found", func(t *testing.T) {
+		shareLink, err := createShareLink(nil)
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		_, err = getSharedMemo(shareLink.Slug)
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = createShareLink(nil)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
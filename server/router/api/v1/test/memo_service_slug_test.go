@@ -0,0 +1,124 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoSlugs(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: visibility}})
+		require.NoError(t, err)
+		return memo
+	}
+	setSlug := func(ctx context.Context, memo *v1pb.Memo, slug string) (*v1pb.Memo, error) {
+		return ts.Service.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Slug: slug},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"slug"}},
+		})
+	}
+	getMemo := func(ctx context.Context, name string) (*v1pb.Memo, error) {
+		return ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: name})
+	}
+	memo := createMemo(userCtx, v1pb.Visibility_PUBLIC)
+
+	t.Run("memos are found by their slug and their uid", func(t *testing.T) {
+		updated, err := setSlug(userCtx, memo, "weekly-review")
+		require.NoError(t, err)
+		require.Equal(t, "weekly-review", updated.Slug)
+
+		for _, name := range []string{"memos/weekly-review", memo.Name} {
+			found, err := getMemo(ctx, name)
+			require.NoError(t, err)
+			require.Equal(t, memo.Name, found.Name)
+			require.Equal(t, "weekly-review", found.Slug)
+		}
+	})
+
+	t.Run("previous slugs keep resolving to the memo", func(t *testing.T) {
+		_, err := setSlug(userCtx, memo, "review")
+		require.NoError(t, err)
+		found, err := getMemo(ctx, "memos/weekly-review")
+		require.NoError(t, err)
+		require.Equal(t, memo.Name, found.Name)
+		require.Equal(t, "review", found.Slug)
+	})
+
+	t.Run("taken slugs return a suggestion", func(t *testing.T) {
+		another := createMemo(otherCtx, v1pb.Visibility_PUBLIC)
+		for _, slug := range []string{"review", "weekly-review"} {
+			_, err := setSlug(otherCtx, another, slug)
+			require.Equal(t, codes.AlreadyExists, status.Code(err), slug)
+			st := status.Convert(err)
+			require.Contains(t, st.Message(), `try "`+slug+`-2"`)
+			require.Len(t, st.Details(), 1)
+			require.Equal(t, slug+"-2", st.Details()[0].(*errdetails.ErrorInfo).Metadata["suggestion"])
+		}
+		_, err = setSlug(otherCtx, another, "review-2")
+		require.NoError(t, err)
+		_, err = setSlug(otherCtx, another, "review-3")
+		require.NoError(t, err)
+		// The slug of the memo and its previous slug are both taken.
+		_, err = setSlug(userCtx, memo, "review-2")
+		require.Contains(t, status.Convert(err).Message(), `try "review-4"`)
+		// The UIDs of memos are taken too.
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo"}, MemoId: "daily-notes"})
+		require.NoError(t, err)
+		_, err = setSlug(otherCtx, another, "daily-notes")
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("invalid and reserved slugs are rejected", func(t *testing.T) {
+		for _, slug := range []string{"Weekly", "weekly review", "-weekly", "weekly--review", "weekly_review", "api", "explore", "auth", "u"} {
+			_, err := setSlug(userCtx, memo, slug)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), slug)
+		}
+	})
+
+	t.Run("only the creator sets the slug", func(t *testing.T) {
+		_, err := setSlug(otherCtx, memo, "mine")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("slugs don't bypass the visibility", func(t *testing.T) {
+		private := createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		_, err := setSlug(userCtx, private, "secret")
+		require.NoError(t, err)
+		_, err = getMemo(otherCtx, "memos/secret")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		found, err := getMemo(userCtx, "memos/secret")
+		require.NoError(t, err)
+		require.Equal(t, private.Name, found.Name)
+	})
+
+	t.Run("removed slugs keep redirecting", func(t *testing.T) {
+		updated, err := setSlug(userCtx, memo, "")
+		require.NoError(t, err)
+		require.Empty(t, updated.Slug)
+		found, err := getMemo(ctx, "memos/review")
+		require.NoError(t, err)
+		require.Equal(t, memo.Name, found.Name)
+		_, err = getMemo(ctx, "memos/unknown")
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
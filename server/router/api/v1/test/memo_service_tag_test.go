@@ -0,0 +1,158 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestRenameMemoTag(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	getMemo := func(ctx context.Context, name string) *apiv1.Memo {
+		memo, err := ts.Service.GetMemo(ctx, &apiv1.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		return memo
+	}
+
+	work := createMemo(userCtx, "Meeting #work")
+	project := createMemo(userCtx, "Plan #work/project and #workout")
+	merged := createMemo(userCtx, "#work and #job")
+	workout := createMemo(userCtx, "Run #workout")
+	otherWork := createMemo(otherCtx, "Other #work")
+
+	t.Run("validate only returns the memos without changing them", func(t *testing.T) {
+		resp, err := ts.Service.RenameMemoTag(userCtx, &apiv1.RenameMemoTagRequest{OldTag: "work", NewTag: "job", ValidateOnly: true})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.MemoCount)
+		require.ElementsMatch(t, []string{work.Name, project.Name, merged.Name}, resp.Memos)
+		require.Equal(t, "Meeting #work", getMemo(userCtx, work.Name).Content)
+	})
+
+	t.Run("renames and merges the tag in the memos of the caller", func(t *testing.T) {
+		resp, err := ts.Service.RenameMemoTag(userCtx, &apiv1.RenameMemoTagRequest{OldTag: "work", NewTag: "job"})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.MemoCount)
+
+		memo := getMemo(userCtx, work.Name)
+		require.Equal(t, "Meeting #job", memo.Content)
+		require.Equal(t, []string{"job"}, memo.Tags)
+		memo = getMemo(userCtx, project.Name)
+		require.Equal(t, "Plan #job/project and #workout", memo.Content)
+		require.ElementsMatch(t, []string{"job/project", "workout"}, memo.Tags)
+		memo = getMemo(userCtx, merged.Name)
+		require.Equal(t, "#job and #job", memo.Content)
+		require.Equal(t, []string{"job"}, memo.Tags)
+		require.Equal(t, "Run #workout", getMemo(userCtx, workout.Name).Content)
+		require.Equal(t, "Other #work", getMemo(otherCtx, otherWork.Name).Content)
+	})
+
+	t.Run("tags must be valid and different", func(t *testing.T) {
+		_, err := ts.Service.RenameMemoTag(userCtx, &apiv1.RenameMemoTagRequest{OldTag: "job", NewTag: "new tag"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.RenameMemoTag(userCtx, &apiv1.RenameMemoTagRequest{OldTag: "job", NewTag: "Job"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListTagTree(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	for _, memo := range []struct {
+		content    string
+		visibility apiv1.Visibility
+	}{
+		{"#Project/Beta and #project/alpha", apiv1.Visibility_PRIVATE},
+		{"#project/alpha first", apiv1.Visibility_PRIVATE},
+		{"#project/beta/notes", apiv1.Visibility_PUBLIC},
+		{"#projects are not subtags", apiv1.Visibility_PRIVATE},
+		{"#project itself", apiv1.Visibility_PRIVATE},
+		{"#work and #workout are two tags", apiv1.Visibility_PUBLIC},
+		{"Twice #workout/running #workout/x", apiv1.Visibility_PRIVATE},
+	} {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: memo.content, Visibility: memo.visibility},
+		})
+		require.NoError(t, err)
+	}
+
+	// flatten returns the memo counts of the tags of the tree, with their depths.
+	var flatten func(nodes []*apiv1.TagTreeNode, depth int, counts map[string][2]int32)
+	flatten = func(nodes []*apiv1.TagTreeNode, depth int, counts map[string][2]int32) {
+		for _, node := range nodes {
+			counts[node.Tag] = [2]int32{int32(depth), node.MemoCount}
+			flatten(node.Children, depth+1, counts)
+		}
+	}
+
+	resp, err := ts.Service.ListTagTree(userCtx, &apiv1.ListTagTreeRequest{})
+	require.NoError(t, err)
+	tags := []string{}
+	for _, node := range resp.Tags {
+		tags = append(tags, node.Tag)
+	}
+	require.Equal(t, []string{"project", "projects", "work", "workout"}, tags)
+	counts := map[string][2]int32{}
+	flatten(resp.Tags, 0, counts)
+	require.Equal(t, map[string][2]int32{
+		"project":            {0, 4},
+		"project/alpha":      {1, 2},
+		"project/beta":       {1, 2},
+		"project/beta/notes": {2, 1},
+		"projects":           {0, 1},
+		"work":               {0, 1},
+		"workout":            {0, 2},
+		"workout/running":    {1, 1},
+		"workout/x":          {1, 1},
+	}, counts)
+	require.Equal(t, "beta", resp.Tags[0].Children[1].Name)
+
+	// Other users only count the visible memos.
+	resp, err = ts.Service.ListTagTree(otherCtx, &apiv1.ListTagTreeRequest{Creator: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	counts = map[string][2]int32{}
+	flatten(resp.Tags, 0, counts)
+	require.Equal(t, map[string][2]int32{
+		"project":            {0, 1},
+		"project/beta":       {1, 1},
+		"project/beta/notes": {2, 1},
+		"work":               {0, 1},
+		"workout":            {0, 1},
+	}, counts)
+
+	_, err = ts.Service.ListTagTree(ctx, &apiv1.ListTagTreeRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
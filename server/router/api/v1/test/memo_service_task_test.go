@@ -0,0 +1,178 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpdateMemoTask(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	parent := fmt.Sprintf("users/%d", user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &v1pb.UserWebhook{Url: "http://10.0.0.1/hook", DisplayName: "hook", Events: []string{"memos.memo.updated"}},
+	})
+	require.NoError(t, err)
+	listPayloads := func() []map[string]any {
+		webhookID := hook.Name[len(parent+"/webhooks/"):]
+		deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID})
+		require.NoError(t, err)
+		payloads := []map[string]any{}
+		for _, delivery := range deliveries {
+			payload := map[string]any{}
+			require.NoError(t, json.Unmarshal([]byte(delivery.Payload), &payload))
+			payloads = append(payloads, payload)
+		}
+		return payloads
+	}
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "# Groceries\n\n- [ ] Milk\n- [ ] Eggs #shop",
+		Visibility: v1pb.Visibility_PUBLIC,
+	}})
+	require.NoError(t, err)
+	require.NotEmpty(t, memo.Etag)
+
+	var response *v1pb.UpdateMemoTaskResponse
+	t.Run("tasks are checked by index", func(t *testing.T) {
+		response, err = ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{
+			Name:    memo.Name,
+			Task:    &v1pb.UpdateMemoTaskRequest_Index{Index: 1},
+			Checked: true,
+			Etag:    memo.Etag,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "# Groceries\n\n- [ ] Milk\n- [x] Eggs #shop", response.Memo.Content)
+		require.NotEqual(t, memo.Etag, response.Memo.Etag)
+		require.True(t, response.Memo.Property.HasIncompleteTasks)
+		require.Equal(t, []string{"shop"}, response.Memo.Tags)
+		require.Len(t, response.Tasks, 2)
+		require.Equal(t, "Eggs #shop", response.Tasks[1].Text)
+		require.True(t, response.Tasks[1].Checked)
+
+		// The webhook gets the task with the content.
+		payloads := listPayloads()
+		require.Len(t, payloads, 1)
+		require.Equal(t, "memos.memo.updated", payloads[0]["activityType"])
+		require.Equal(t, map[string]any{"index": float64(1), "text": "Eggs #shop", "checked": true}, payloads[0]["taskToggle"])
+		payloadMemo, ok := payloads[0]["memo"].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, response.Memo.Content, payloadMemo["content"])
+		require.NotContains(t, payloadMemo, "tags")
+	})
+
+	t.Run("stale toggles are rejected", func(t *testing.T) {
+		_, err := ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{
+			Name:    memo.Name,
+			Task:    &v1pb.UpdateMemoTaskRequest_Index{Index: 0},
+			Checked: true,
+			Etag:    memo.Etag,
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("edits saved while toggling aren't overwritten", func(t *testing.T) {
+		read, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: response.Memo.Name})
+		require.NoError(t, err)
+		edited := read.Content + "\n- [ ] Bread"
+		// The memo is edited between the toggle reading it and writing its content.
+		var memoID int32
+		driver := &editingDriver{Driver: ts.Store.GetDriver()}
+		driver.edit = func(ctx context.Context, id int32) {
+			memoID = id
+			require.NoError(t, driver.UpdateMemo(ctx, &store.UpdateMemo{ID: id, Content: &edited}))
+		}
+		ts.Service.Store = store.New(driver, ts.Profile)
+		_, err = ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{
+			Name:    memo.Name,
+			Task:    &v1pb.UpdateMemoTaskRequest_Index{Index: 0},
+			Checked: true,
+			Etag:    read.Etag,
+		})
+		ts.Service.Store = ts.Store
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		found, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, edited, found.Content)
+		require.Len(t, listPayloads(), 1)
+
+		// The edit is undone so that the next toggles start from the read content.
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memoID, Content: &read.Content}))
+	})
+
+	t.Run("tasks are checked by id", func(t *testing.T) {
+		checked, err := ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{
+			Name:    memo.Name,
+			Task:    &v1pb.UpdateMemoTaskRequest_TaskId{TaskId: response.Tasks[0].Id},
+			Checked: true,
+			Etag:    response.Memo.Etag,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "# Groceries\n\n- [x] Milk\n- [x] Eggs #shop", checked.Memo.Content)
+		require.False(t, checked.Memo.Property.HasIncompleteTasks)
+		require.Equal(t, response.Tasks[0].Id, checked.Tasks[0].Id)
+		require.Len(t, listPayloads(), 2)
+
+		// A task that's already checked is left as it is.
+		unchanged, err := ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{
+			Name:    memo.Name,
+			Task:    &v1pb.UpdateMemoTaskRequest_Index{Index: 0},
+			Checked: true,
+			Etag:    checked.Memo.Etag,
+		})
+		require.NoError(t, err)
+		require.Equal(t, checked.Memo.Content, unchanged.Memo.Content)
+		require.Len(t, listPayloads(), 2)
+	})
+
+	t.Run("invalid toggles are rejected", func(t *testing.T) {
+		current, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		etag := current.Etag
+		_, err = ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{Name: memo.Name, Task: &v1pb.UpdateMemoTaskRequest_Index{Index: 0}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.UpdateMemoTask(otherCtx, &v1pb.UpdateMemoTaskRequest{Name: memo.Name, Task: &v1pb.UpdateMemoTaskRequest_Index{Index: 0}, Etag: etag})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{Name: memo.Name, Etag: etag})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{Name: memo.Name, Task: &v1pb.UpdateMemoTaskRequest_Index{Index: 2}, Etag: etag})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.UpdateMemoTask(userCtx, &v1pb.UpdateMemoTaskRequest{Name: memo.Name, Task: &v1pb.UpdateMemoTaskRequest_TaskId{TaskId: "unknown"}, Etag: etag})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+// editingDriver edits a memo right before the content of a memo is updated from the content
+// read, like a concurrent edit would.
+type editingDriver struct {
+	store.Driver
+	edit func(ctx context.Context, id int32)
+}
+
+func (d *editingDriver) UpdateMemoContent(ctx context.Context, update *store.UpdateMemoContent) (bool, error) {
+	if d.edit != nil {
+		d.edit(ctx, update.ID)
+		d.edit = nil
+	}
+	return d.Driver.UpdateMemoContent(ctx, update)
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/trash"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTrash(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(content string) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	listMemoNames := func(ctx context.Context, showDeleted bool) []string {
+		resp, err := ts.Service.ListMemos(ctx, &apiv1.ListMemosRequest{ShowDeleted: showDeleted})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range resp.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+
+	t.Run("deleted memos move to the trash and can be restored", func(t *testing.T) {
+		memo := createMemo("restore me")
+		_, err := ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+
+		require.NotContains(t, listMemoNames(userCtx, false), memo.Name)
+		require.Contains(t, listMemoNames(userCtx, true), memo.Name)
+		require.NotContains(t, listMemoNames(otherCtx, true), memo.Name)
+
+		// Only the creator sees a memo in the trash.
+		trashed, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.NotNil(t, trashed.DeleteTime)
+		_, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = ts.Service.RestoreMemo(otherCtx, &apiv1.RestoreMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		restored, err := ts.Service.RestoreMemo(userCtx, &apiv1.RestoreMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Nil(t, restored.DeleteTime)
+		require.Contains(t, listMemoNames(userCtx, false), memo.Name)
+
+		_, err = ts.Service.RestoreMemo(userCtx, &apiv1.RestoreMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("purged memos are deleted with their reactions and comments", func(t *testing.T) {
+		memo := createMemo("purge me")
+		_, err := ts.Service.UpsertMemoReaction(otherCtx, &apiv1.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		comment, err := ts.Service.CreateMemoComment(userCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: "comment", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.PurgeMemo(userCtx, &apiv1.PurgeMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+
+		_, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: comment.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		reactions, err := ts.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memo.Name})
+		require.NoError(t, err)
+		require.Empty(t, reactions)
+	})
+
+	t.Run("the trash is purged after the retention", func(t *testing.T) {
+		_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+			Key: storepb.InstanceSettingKey_MEMO_RELATED,
+			Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: &storepb.InstanceMemoRelatedSetting{
+				TrashRetentionDays: 7,
+			}},
+		})
+		require.NoError(t, err)
+
+		expired := createMemo("expired")
+		recent := createMemo("recent")
+		for _, memo := range []*apiv1.Memo{expired, recent} {
+			_, err := ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+			require.NoError(t, err)
+		}
+		uid := strings.TrimPrefix(expired.Name, "memos/")
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, IncludeTrashed: true})
+		require.NoError(t, err)
+		deletedTsSec := time.Now().AddDate(0, 0, -8).Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, DeletedTs: &deletedTsSec}))
+
+		trash.NewRunner(ts.Store, ts.Service.DeleteMemoPermanently).RunOnce(ctx)
+
+		trashed := listMemoNames(userCtx, true)
+		require.NotContains(t, trashed, expired.Name)
+		require.Contains(t, trashed, recent.Name)
+	})
+}
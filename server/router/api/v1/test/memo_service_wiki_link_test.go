@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoWikiLinks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemo := func(ctx context.Context, content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: visibility}})
+		require.NoError(t, err)
+		return memo
+	}
+	updateMemo := func(ctx context.Context, name, content string) *v1pb.Memo {
+		memo, err := ts.Service.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: name, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	listReferences := func(name string) []string {
+		response, err := ts.Service.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{Name: name})
+		require.NoError(t, err)
+		names := []string{}
+		for _, relation := range response.Relations {
+			if relation.Type == v1pb.MemoRelation_REFERENCE && relation.Memo.Name == name {
+				names = append(names, relation.RelatedMemo.Name)
+			}
+		}
+		return names
+	}
+
+	plan := createMemo(userCtx, "# Project Plan\n\nThe plan.", v1pb.Visibility_PRIVATE)
+	goals := createMemo(userCtx, "Goals", v1pb.Visibility_PUBLIC)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: goals.Name, Slug: "2024-goals"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"slug"}},
+	})
+	require.NoError(t, err)
+	createMemo(otherCtx, "# Recipes", v1pb.Visibility_PUBLIC)
+
+	var memo *v1pb.Memo
+	t.Run("wiki links resolve by slug and title, with references", func(t *testing.T) {
+		memo = createMemo(userCtx, "See [[Project Plan]], [[2024 Goals|the goals]] and [[Recipes]].", v1pb.Visibility_PUBLIC)
+		require.Equal(t, []*v1pb.Memo_WikiLink{
+			{Target: "Project Plan", Memo: plan.Name},
+			{Target: "2024 Goals", Memo: goals.Name},
+			// The memos of other users aren't linked to.
+			{Target: "Recipes"},
+		}, memo.WikiLinks)
+		require.ElementsMatch(t, []string{plan.Name, goals.Name}, listReferences(memo.Name))
+	})
+
+	t.Run("other users only see the visible linked memos", func(t *testing.T) {
+		found, err := ts.Service.GetMemo(otherCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, []*v1pb.Memo_WikiLink{
+			{Target: "Project Plan"},
+			{Target: "2024 Goals", Memo: goals.Name},
+			{Target: "Recipes"},
+		}, found.WikiLinks)
+	})
+
+	t.Run("links keep their memo when its title changes", func(t *testing.T) {
+		updateMemo(userCtx, plan.Name, "# The Plan")
+		found, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, plan.Name, found.WikiLinks[0].Memo)
+	})
+
+	t.Run("removed links remove their references", func(t *testing.T) {
+		updated := updateMemo(userCtx, memo.Name, "See [[Project Plan]] and [[Project Plan|again]].")
+		require.Equal(t, []*v1pb.Memo_WikiLink{{Target: "Project Plan", Memo: plan.Name}}, updated.WikiLinks)
+		require.Equal(t, []string{plan.Name}, listReferences(memo.Name))
+	})
+
+	t.Run("missing links create memos", func(t *testing.T) {
+		updated := updateMemo(userCtx, memo.Name, "See [[Project Plan]] and [[Open Questions]].")
+		require.Equal(t, "", updated.WikiLinks[1].Memo)
+
+		_, err := ts.Service.CreateMemoFromWikiLink(otherCtx, &v1pb.CreateMemoFromWikiLinkRequest{Name: memo.Name, Target: "Open Questions"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.CreateMemoFromWikiLink(userCtx, &v1pb.CreateMemoFromWikiLinkRequest{Name: memo.Name, Target: "Other"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.CreateMemoFromWikiLink(userCtx, &v1pb.CreateMemoFromWikiLinkRequest{Name: memo.Name, Target: "Project Plan"})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		created, err := ts.Service.CreateMemoFromWikiLink(userCtx, &v1pb.CreateMemoFromWikiLinkRequest{Name: memo.Name, Target: "Open Questions"})
+		require.NoError(t, err)
+		require.Equal(t, "# Open Questions", created.Content)
+		require.Equal(t, v1pb.Visibility_PRIVATE, created.Visibility)
+		found, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, created.Name, found.WikiLinks[1].Memo)
+		require.ElementsMatch(t, []string{plan.Name, created.Name}, listReferences(memo.Name))
+	})
+
+	t.Run("links to deleted memos are missing", func(t *testing.T) {
+		_, err := ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: plan.Name, Force: true})
+		require.NoError(t, err)
+		found, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, "", found.WikiLinks[0].Memo)
+	})
+}
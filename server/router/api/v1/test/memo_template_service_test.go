@@ -0,0 +1,192 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	createMemoTemplate := func(memoTemplate *v1pb.MemoTemplate) *v1pb.MemoTemplate {
+		created, err := ts.Service.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+			Parent:       parent,
+			MemoTemplate: memoTemplate,
+		})
+		require.NoError(t, err)
+		return created
+	}
+	listMemoTemplateNames := func() []string {
+		resp, err := ts.Service.ListMemoTemplates(userCtx, &v1pb.ListMemoTemplatesRequest{Parent: parent})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memoTemplate := range resp.MemoTemplates {
+			names = append(names, memoTemplate.Name)
+		}
+		return names
+	}
+	deleteAll := func() {
+		for _, name := range listMemoTemplateNames() {
+			_, err := ts.Service.DeleteMemoTemplate(userCtx, &v1pb.DeleteMemoTemplateRequest{Name: name})
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("memo templates can be created, updated and deleted", func(t *testing.T) {
+		defer deleteAll()
+
+		created := createMemoTemplate(&v1pb.MemoTemplate{
+			Title:      "Daily",
+			Content:    "# {{date}}",
+			Tags:       []string{"#daily", " journal "},
+			Visibility: v1pb.Visibility_PROTECTED,
+		})
+		require.Equal(t, "Daily", created.Title)
+		require.Equal(t, []string{"daily", "journal"}, created.Tags)
+		require.Equal(t, v1pb.Visibility_PROTECTED, created.Visibility)
+
+		got, err := ts.Service.GetMemoTemplate(userCtx, &v1pb.GetMemoTemplateRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.Equal(t, created.Content, got.Content)
+
+		updated, err := ts.Service.UpdateMemoTemplate(userCtx, &v1pb.UpdateMemoTemplateRequest{
+			MemoTemplate: &v1pb.MemoTemplate{Name: created.Name, Title: "Journal", Visibility: v1pb.Visibility_VISIBILITY_UNSPECIFIED},
+			UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"title", "visibility"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Journal", updated.Title)
+		require.Equal(t, "# {{date}}", updated.Content)
+		require.Equal(t, v1pb.Visibility_VISIBILITY_UNSPECIFIED, updated.Visibility)
+
+		_, err = ts.Service.UpdateMemoTemplate(userCtx, &v1pb.UpdateMemoTemplateRequest{
+			MemoTemplate: &v1pb.MemoTemplate{Name: created.Name},
+			UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = ts.Service.DeleteMemoTemplate(userCtx, &v1pb.DeleteMemoTemplateRequest{Name: created.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemoTemplate(userCtx, &v1pb.GetMemoTemplateRequest{Name: created.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("memo templates are listed in the user-defined order", func(t *testing.T) {
+		defer deleteAll()
+
+		first := createMemoTemplate(&v1pb.MemoTemplate{Title: "First"})
+		second := createMemoTemplate(&v1pb.MemoTemplate{Title: "Second"})
+		third := createMemoTemplate(&v1pb.MemoTemplate{Title: "Third"})
+		require.Equal(t, []string{first.Name, second.Name, third.Name}, listMemoTemplateNames())
+
+		resp, err := ts.Service.ReorderMemoTemplates(userCtx, &v1pb.ReorderMemoTemplatesRequest{
+			Parent: parent,
+			Names:  []string{third.Name, first.Name, second.Name},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.MemoTemplates, 3)
+		require.Equal(t, []string{third.Name, first.Name, second.Name}, listMemoTemplateNames())
+
+		// The new order must be a permutation of all the memo templates.
+		for _, names := range [][]string{
+			{third.Name, first.Name},
+			{third.Name, first.Name, first.Name},
+			{third.Name, first.Name, parent + "/memoTemplates/unknown"},
+		} {
+			_, err := ts.Service.ReorderMemoTemplates(userCtx, &v1pb.ReorderMemoTemplatesRequest{Parent: parent, Names: names})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		require.Equal(t, []string{third.Name, first.Name, second.Name}, listMemoTemplateNames())
+	})
+
+	t.Run("the number of memo templates of a user is limited", func(t *testing.T) {
+		defer deleteAll()
+
+		for i := 0; i < 50; i++ {
+			createMemoTemplate(&v1pb.MemoTemplate{Title: fmt.Sprintf("Template %d", i)})
+		}
+		_, err := ts.Service.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+			Parent:       parent,
+			MemoTemplate: &v1pb.MemoTemplate{Title: "One too many"},
+		})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("memo templates are only available to their owner", func(t *testing.T) {
+		defer deleteAll()
+
+		created := createMemoTemplate(&v1pb.MemoTemplate{Title: "Mine"})
+		_, err := ts.Service.ListMemoTemplates(otherCtx, &v1pb.ListMemoTemplatesRequest{Parent: parent})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.GetMemoTemplate(otherCtx, &v1pb.GetMemoTemplateRequest{Name: created.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.DeleteMemoTemplate(otherCtx, &v1pb.DeleteMemoTemplateRequest{Name: created.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.CreateMemoFromTemplate(otherCtx, &v1pb.CreateMemoFromTemplateRequest{Name: created.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("memos are created from templates with their tags and visibility", func(t *testing.T) {
+		defer deleteAll()
+
+		_, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name: parent + "/settings/GENERAL",
+				Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+					Timezone:       "Asia/Tokyo",
+					MemoVisibility: "PUBLIC",
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone", "memoVisibility"}},
+		})
+		require.NoError(t, err)
+
+		withDefaults := createMemoTemplate(&v1pb.MemoTemplate{
+			Title:   "Standup",
+			Content: "Standup {{date}} #Work {{unknown}}",
+			Tags:    []string{"work", "standup"},
+		})
+		memo, err := ts.Service.CreateMemoFromTemplate(userCtx, &v1pb.CreateMemoFromTemplateRequest{Name: withDefaults.Name})
+		require.NoError(t, err)
+		require.Regexp(t, `^Standup \d{4}-\d{2}-\d{2} #Work \{\{unknown\}\}\n\n#standup$`, memo.Content)
+		require.ElementsMatch(t, []string{"Work", "standup"}, memo.Tags)
+		require.Equal(t, v1pb.Visibility_PUBLIC, memo.Visibility)
+
+		private := createMemoTemplate(&v1pb.MemoTemplate{Title: "Private", Visibility: v1pb.Visibility_PRIVATE})
+		memo, err = ts.Service.CreateMemoFromTemplate(userCtx, &v1pb.CreateMemoFromTemplateRequest{Name: private.Name})
+		require.NoError(t, err)
+		require.Empty(t, memo.Content)
+		require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+	})
+
+	t.Run("unknown time zones are rejected", func(t *testing.T) {
+		_, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name: parent + "/settings/GENERAL",
+				Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+					Timezone: "Mars/Olympus_Mons",
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
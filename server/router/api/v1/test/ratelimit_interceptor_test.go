@@ -0,0 +1,90 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	setRateLimits := func(setting *v1pb.InstanceSetting_RateLimitSetting) error {
+		_, err := ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name:  "instance/settings/RATE_LIMIT",
+				Value: &v1pb.InstanceSetting_RateLimitSetting_{RateLimitSetting: setting},
+			},
+		})
+		return err
+	}
+	require.NoError(t, setRateLimits(&v1pb.InstanceSetting_RateLimitSetting{
+		ReadRequestsPerMinute:  1,
+		ReadBurst:              2,
+		WriteRequestsPerMinute: 1,
+		WriteBurst:             1,
+		HostMultiplier:         3,
+	}))
+
+	interceptor := apiv1.NewRateLimitUnaryInterceptor(ts.Service.RateLimitService)
+	handler := func(context.Context, any) (any, error) {
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	const readMethod, writeMethod = "/memos.api.v1.MemoService/ListMemos", "/memos.api.v1.MemoService/CreateMemo"
+
+	t.Run("reads and writes are limited separately", func(t *testing.T) {
+		require.NoError(t, call(userCtx, readMethod))
+		require.NoError(t, call(userCtx, readMethod))
+		err := call(userCtx, readMethod)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.NoError(t, call(userCtx, writeMethod))
+		require.Equal(t, codes.ResourceExhausted, status.Code(call(userCtx, writeMethod)))
+	})
+
+	t.Run("the host has a higher ceiling", func(t *testing.T) {
+		for range 3 {
+			require.NoError(t, call(hostCtx, writeMethod))
+		}
+		require.Equal(t, codes.ResourceExhausted, status.Code(call(hostCtx, writeMethod)))
+	})
+
+	t.Run("anonymous requests are limited by IP", func(t *testing.T) {
+		first := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "203.0.113.1"))
+		second := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "203.0.113.2"))
+		require.NoError(t, call(first, writeMethod))
+		require.Equal(t, codes.ResourceExhausted, status.Code(call(first, writeMethod)))
+		require.NoError(t, call(second, writeMethod))
+	})
+
+	t.Run("the limits can be disabled", func(t *testing.T) {
+		require.NoError(t, setRateLimits(&v1pb.InstanceSetting_RateLimitSetting{Disabled: true}))
+		require.NoError(t, call(userCtx, writeMethod))
+	})
+
+	t.Run("negative limits are rejected", func(t *testing.T) {
+		err := setRateLimits(&v1pb.InstanceSetting_RateLimitSetting{WriteBurst: -1})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
@@ -817,3 +819,172 @@ func TestShortcutCRUDComplete(t *testing.T) {
 		require.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestShortcutOrderByAndCap(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	t.Run("order_by is validated and stored", func(t *testing.T) {
+		_, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Parent:   parent,
+			Shortcut: &v1pb.Shortcut{Title: "Bad", Filter: "pinned", OrderBy: "unknown desc"},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid order_by")
+
+		shortcut, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Parent:   parent,
+			Shortcut: &v1pb.Shortcut{Title: "Oldest", Filter: "pinned", OrderBy: "create_time asc"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "create_time asc", shortcut.OrderBy)
+
+		updated, err := ts.Service.UpdateShortcut(userCtx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Name: shortcut.Name, OrderBy: "update_time desc"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"order_by"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "update_time desc", updated.OrderBy)
+
+		_, err = ts.Service.UpdateShortcut(userCtx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Name: shortcut.Name, OrderBy: "title"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"order_by"}},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid order_by")
+	})
+
+	t.Run("shortcuts are capped per user", func(t *testing.T) {
+		resp, err := ts.Service.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{Parent: parent})
+		require.NoError(t, err)
+		for i := len(resp.Shortcuts); i < 50; i++ {
+			_, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+				Parent:   parent,
+				Shortcut: &v1pb.Shortcut{Title: fmt.Sprintf("Shortcut %d", i), Filter: "pinned"},
+			})
+			require.NoError(t, err)
+		}
+		_, err = ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Parent:   parent,
+			Shortcut: &v1pb.Shortcut{Title: "One too many", Filter: "pinned"},
+		})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+func TestReorderShortcuts(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	names := []string{}
+	for _, title := range []string{"First", "Second", "Third"} {
+		shortcut, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Parent:   parent,
+			Shortcut: &v1pb.Shortcut{Title: title, Filter: "pinned"},
+		})
+		require.NoError(t, err)
+		names = append(names, shortcut.Name)
+	}
+
+	resp, err := ts.Service.ReorderShortcuts(userCtx, &v1pb.ReorderShortcutsRequest{
+		Parent: parent,
+		Names:  []string{names[2], names[0], names[1]},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Shortcuts, 3)
+	require.Equal(t, "Third", resp.Shortcuts[0].Title)
+	require.Equal(t, "First", resp.Shortcuts[1].Title)
+
+	listed, err := ts.Service.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Equal(t, names[2], listed.Shortcuts[0].Name)
+	require.Equal(t, names[1], listed.Shortcuts[2].Name)
+
+	for _, invalid := range [][]string{
+		{names[0], names[1]},
+		{names[0], names[0], names[1]},
+		{names[0], names[1], parent + "/shortcuts/unknown"},
+	} {
+		_, err := ts.Service.ReorderShortcuts(userCtx, &v1pb.ReorderShortcutsRequest{Parent: parent, Names: invalid})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), invalid)
+	}
+
+	_, err = ts.Service.ReorderShortcuts(otherCtx, &v1pb.ReorderShortcutsRequest{Parent: parent, Names: names})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCountShortcutMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	work := []*v1pb.Memo{}
+	for _, content := range []string{"#work first", "#work second", "#home chores"} {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		if len(work) < 2 {
+			work = append(work, memo)
+		}
+	}
+	// The memos of other users aren't counted.
+	_, err = ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "#work elsewhere", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	workShortcut, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Parent:   parent,
+		Shortcut: &v1pb.Shortcut{Title: "Work", Filter: `tag in ["work"]`},
+	})
+	require.NoError(t, err)
+	homeShortcut, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Parent:   parent,
+		Shortcut: &v1pb.Shortcut{Title: "Home", Filter: `tag in ["home"]`},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.CountShortcutMemos(userCtx, &v1pb.CountShortcutMemosRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int32{workShortcut.Name: 2, homeShortcut.Name: 1}, resp.MemoCounts)
+
+	// Once the tag is gone, the shortcut still works and counts no memos.
+	for _, memo := range work {
+		_, err := ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+	}
+	resp, err = ts.Service.CountShortcutMemos(userCtx, &v1pb.CountShortcutMemosRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), resp.MemoCounts[workShortcut.Name])
+	require.Equal(t, int32(1), resp.MemoCounts[homeShortcut.Name])
+
+	_, err = ts.Service.CountShortcutMemos(otherCtx, &v1pb.CountShortcutMemosRequest{Parent: parent})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
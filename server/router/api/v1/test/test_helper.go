@@ -7,7 +7,9 @@ import (
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/plugin/markdown"
 	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/ratelimit"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/router/linkpreview"
 	"github.com/usememos/memos/store"
 	teststore "github.com/usememos/memos/store/test"
 )
@@ -40,12 +42,19 @@ func NewTestService(t *testing.T) *TestService {
 	secret := "test-secret"
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithMathExtension(),
+		markdown.WithMermaidExtension(),
+		markdown.WithFootnoteExtension(),
+		markdown.WithWikiLinkExtension(),
 	)
 	service := &apiv1.APIV1Service{
 		Secret:          secret,
 		Profile:         testProfile,
 		Store:           testStore,
 		MarkdownService: markdownService,
+
+		LinkPreviewService: linkpreview.NewService(testStore, secret, linkpreview.DefaultConfig()),
+		RateLimitService:   ratelimit.NewService(testStore, ratelimit.NewMemoryLimiter(ratelimit.DefaultIdleTTL)),
 	}
 
 	return &TestService{
@@ -0,0 +1,176 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/accesstoken"
+	"github.com/usememos/memos/store"
+)
+
+func TestScopedAccessTokens(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+
+	// createToken creates a token for the user authenticated by ctx.
+	createToken := func(ctx context.Context, scopes ...string) (*v1pb.UserAccessToken, error) {
+		return ts.Service.CreateUserAccessToken(ctx, &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", auth.GetUserID(ctx)),
+			AccessToken: &v1pb.UserAccessToken{Description: "test", Scopes: scopes},
+		})
+	}
+	// authorize authenticates token and authorizes it for procedure like the interceptors do.
+	authorize := func(token, procedure string) (context.Context, error) {
+		tokenUser, scopes, err := authenticator.AuthenticateByJWT(ctx, token)
+		require.NoError(t, err)
+		return authenticator.AuthorizeAndSetContext(ctx, procedure, tokenUser, "", token, scopes, apiv1.IsAdminOnlyMethod)
+	}
+
+	t.Run("read-only token cannot mutate", func(t *testing.T) {
+		token, err := createToken(ts.CreateUserContext(ctx, user.ID), auth.ScopeRead)
+		require.NoError(t, err)
+		require.Equal(t, []string{auth.ScopeRead}, token.Scopes)
+
+		_, err = authorize(token.AccessToken, "/memos.api.v1.MemoService/ListMemos")
+		require.NoError(t, err)
+		_, err = authorize(token.AccessToken, "/memos.api.v1.MemoService/CreateMemo")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `missing the "write" scope`)
+	})
+
+	t.Run("token without scopes has full access", func(t *testing.T) {
+		token, err := createToken(ts.CreateUserContext(ctx, host.ID))
+		require.NoError(t, err)
+		require.Empty(t, token.Scopes)
+
+		_, err = authorize(token.AccessToken, "/memos.api.v1.MemoService/CreateMemo")
+		require.NoError(t, err)
+		_, err = authorize(token.AccessToken, "/memos.api.v1.InstanceService/UpdateInstanceSetting")
+		require.NoError(t, err)
+	})
+
+	t.Run("admin methods need the admin scope", func(t *testing.T) {
+		token, err := createToken(ts.CreateUserContext(ctx, host.ID), auth.ScopeWrite)
+		require.NoError(t, err)
+
+		_, err = authorize(token.AccessToken, "/memos.api.v1.InstanceService/UpdateInstanceSetting")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `missing the "admin" scope`)
+
+		// Without the admin scope the host acts as a regular user in handlers too.
+		tokenCtx, err := authorize(token.AccessToken, "/memos.api.v1.UserService/GetUserSetting")
+		require.NoError(t, err)
+		currentUser, err := ts.Service.GetCurrentUser(tokenCtx)
+		require.NoError(t, err)
+		require.Equal(t, host.ID, currentUser.ID)
+		require.Equal(t, store.RoleUser, currentUser.Role)
+	})
+
+	t.Run("users cannot mint scopes above their role", func(t *testing.T) {
+		_, err := createToken(ts.CreateUserContext(ctx, user.ID), auth.ScopeAdmin)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "requires an admin user")
+
+		_, err = createToken(ts.CreateUserContext(ctx, user.ID), "delete")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown scope")
+
+		token, err := createToken(ts.CreateUserContext(ctx, host.ID), auth.ScopeRead, auth.ScopeAdmin)
+		require.NoError(t, err)
+		require.Equal(t, []string{auth.ScopeRead, auth.ScopeAdmin}, token.Scopes)
+	})
+
+	t.Run("scoped tokens cannot mint broader tokens", func(t *testing.T) {
+		writeToken, err := createToken(ts.CreateUserContext(ctx, host.ID), auth.ScopeWrite)
+		require.NoError(t, err)
+		tokenCtx, err := authorize(writeToken.AccessToken, "/memos.api.v1.UserService/CreateUserAccessToken")
+		require.NoError(t, err)
+
+		_, err = createToken(tokenCtx, auth.ScopeAdmin)
+		require.Error(t, err)
+
+		// Omitted scopes inherit the calling token's scopes instead of full access.
+		token, err := createToken(tokenCtx)
+		require.NoError(t, err)
+		require.Equal(t, []string{auth.ScopeWrite}, token.Scopes)
+	})
+}
+
+func TestAccessTokenExpiryAndLastUsed(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	storeToken := func(description string, expiresAt time.Time) string {
+		token, err := authenticator.GenerateAccessToken(ctx, user.Username, user.ID, nil, expiresAt)
+		require.NoError(t, err)
+		require.NoError(t, ts.Service.UpsertAccessTokenToStore(ctx, user, token, description, nil))
+		return token
+	}
+	active := storeToken("active", time.Time{})
+	expired := storeToken("expired", time.Now().Add(-time.Hour))
+	stale := storeToken("stale", time.Now().Add(-accesstoken.ExpiredRetention-time.Hour))
+
+	t.Run("expired tokens are rejected", func(t *testing.T) {
+		_, _, err := authenticator.AuthenticateByJWT(ctx, expired)
+		require.Error(t, err)
+	})
+
+	t.Run("last used time is updated at most hourly", func(t *testing.T) {
+		_, _, err := authenticator.AuthenticateByJWT(ctx, active)
+		require.NoError(t, err)
+		tokens, err := ts.Store.GetUserAccessTokens(ctx, user.ID)
+		require.NoError(t, err)
+		lastUsed := tokens[0].LastUsedTime
+		require.NotNil(t, lastUsed)
+
+		_, _, err = authenticator.AuthenticateByJWT(ctx, active)
+		require.NoError(t, err)
+		tokens, err = ts.Store.GetUserAccessTokens(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, lastUsed.AsTime(), tokens[0].LastUsedTime.AsTime())
+	})
+
+	t.Run("list includes last used time, truncated token and expired tokens", func(t *testing.T) {
+		resp, err := ts.Service.ListUserAccessTokens(ts.CreateUserContext(ctx, user.ID), &v1pb.ListUserAccessTokensRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.AccessTokens, 3)
+		for _, token := range resp.AccessTokens {
+			require.Equal(t, token.AccessToken[:8]+"..."+token.AccessToken[len(token.AccessToken)-8:], token.TokenPrefix)
+			require.Equal(t, token.AccessToken == active, token.LastUsedTime != nil)
+		}
+	})
+
+	t.Run("cleanup deletes tokens expired for more than 30 days", func(t *testing.T) {
+		accesstoken.NewRunner(ts.Store).RunOnce(ctx)
+		tokens, err := ts.Store.GetUserAccessTokens(ctx, user.ID)
+		require.NoError(t, err)
+		remaining := []string{}
+		for _, token := range tokens {
+			remaining = append(remaining, token.AccessToken)
+		}
+		require.ElementsMatch(t, []string{active, expired}, remaining)
+		require.NotContains(t, remaining, stale)
+	})
+}
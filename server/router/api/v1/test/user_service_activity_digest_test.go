@@ -0,0 +1,183 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/activitydigest"
+	"github.com/usememos/memos/store"
+)
+
+func TestActivityDigest(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	sender := &recordingEmailSender{messages: make(chan *email.Message, 10)}
+	ts.Service.EmailSender = sender
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: &storepb.InstanceEmailSetting{
+			SmtpHost:  "smtp.example.com",
+			FromEmail: "memos@example.com",
+		}},
+	})
+	require.NoError(t, err)
+	runner := activitydigest.NewRunner(ts.Store, ts.Service.DeliverActivityDigest)
+
+	jane, err := ts.Store.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser, Email: "jane@example.com"})
+	require.NoError(t, err)
+	janeCtx := ts.CreateUserContext(ctx, jane.ID)
+	john, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	johnCtx := ts.CreateUserContext(ctx, john.ID)
+
+	// The digests are scheduled an hour ago, so the last one summarizes the day before.
+	digestTime := time.Now().UTC().Add(-time.Hour).Format("15:04")
+	enableDigest := func(userCtx context.Context, userID int32, setting *v1pb.UserSetting_NotificationSetting, paths ...string) *v1pb.UserSetting_NotificationSetting {
+		updated, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/NOTIFICATION", userID),
+				Value: &v1pb.UserSetting_NotificationSetting_{NotificationSetting: setting},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		require.NoError(t, err)
+		return updated.GetNotificationSetting()
+	}
+	// forgetLastDigest makes the last scheduled digest of a user due again.
+	forgetLastDigest := func(userID int32) {
+		userSetting, err := ts.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_NOTIFICATION})
+		require.NoError(t, err)
+		userSetting.GetNotification().LastDigestTs = 0
+		_, err = ts.Store.UpsertUserSetting(ctx, userSetting)
+		require.NoError(t, err)
+	}
+	listDigests := func(userCtx context.Context, userID int32) []*v1pb.UserNotification {
+		notifications, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", userID)})
+		require.NoError(t, err)
+		digests := []*v1pb.UserNotification{}
+		for _, notification := range notifications.Notifications {
+			if notification.Type == v1pb.UserNotification_DIGEST {
+				digests = append(digests, notification)
+			}
+		}
+		return digests
+	}
+
+	t.Run("invalid preferences are rejected", func(t *testing.T) {
+		_, err := ts.Service.UpdateUserSetting(janeCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  fmt.Sprintf("users/%d/settings/NOTIFICATION", jane.ID),
+				Value: &v1pb.UserSetting_NotificationSetting_{NotificationSetting: &v1pb.UserSetting_NotificationSetting{DigestTime: "25:00"}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"digestTime"}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("the digest past when turning it on isn't delivered", func(t *testing.T) {
+		updated := enableDigest(janeCtx, jane.ID, &v1pb.UserSetting_NotificationSetting{
+			DigestFrequency: v1pb.UserSetting_NotificationSetting_DAILY,
+			DigestTime:      digestTime,
+			EmailDigest:     true,
+		}, "digestFrequency", "digestTime", "emailDigest")
+		require.Equal(t, v1pb.UserSetting_NotificationSetting_DAILY, updated.DigestFrequency)
+		require.Equal(t, digestTime, updated.DigestTime)
+
+		_, err := ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Yesterday's notes", Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+		_, err = ts.Store.GetDriver().GetDB().ExecContext(ctx, "UPDATE memo SET created_ts = created_ts - 7200")
+		require.NoError(t, err)
+		runner.RunOnce(ctx)
+		require.Empty(t, listDigests(janeCtx, jane.ID))
+		sender.requireNone(t)
+	})
+
+	t.Run("the digest summarizes the activity", func(t *testing.T) {
+		memos, err := ts.Service.ListMemos(janeCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		require.Len(t, memos.Memos, 1)
+		created := memos.Memos[0]
+		_, err = ts.Service.UpsertMemoReaction(johnCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     created.Name,
+			Reaction: &v1pb.Reaction{ContentId: created.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		_, err = ts.Store.GetDriver().GetDB().ExecContext(ctx, "UPDATE inbox SET created_ts = created_ts - 7200")
+		require.NoError(t, err)
+		reminder, err := ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+			Content:    "Call the dentist",
+			Visibility: v1pb.Visibility_PRIVATE,
+			Reminder:   &v1pb.MemoReminder{RemindTime: timestamppb.New(time.Now().Add(3 * time.Hour))},
+		}})
+		require.NoError(t, err)
+
+		forgetLastDigest(jane.ID)
+		runner.RunOnce(ctx)
+		digests := listDigests(janeCtx, jane.ID)
+		require.Len(t, digests, 1)
+		activity, err := ts.Service.GetActivity(janeCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", digests[0].GetActivityId())})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Activity_DIGEST, activity.Type)
+		payload := activity.Payload.GetDigest()
+		require.Equal(t, []string{created.Name}, payload.Memos)
+		require.Equal(t, int32(0), payload.CommentCount)
+		require.Equal(t, int32(1), payload.ReactionCount)
+		require.Equal(t, []string{reminder.Name}, payload.ReminderMemos)
+		require.Equal(t, 24*time.Hour, payload.EndTime.AsTime().Sub(payload.StartTime.AsTime()))
+
+		message := sender.next(t)
+		require.Equal(t, "jane@example.com", message.To)
+		require.Equal(t, "Your daily digest on Memos", message.Subject)
+		require.Contains(t, message.Body, "Yesterday's notes")
+		require.Contains(t, message.Body, "0 comment(s) and 1 reaction(s)")
+		require.Contains(t, message.Body, "Call the dentist")
+		require.Contains(t, message.HTMLBody, "http://localhost:8080/"+created.Name)
+
+		// The digest is delivered once, even after a restart.
+		runner.RunOnce(ctx)
+		activitydigest.NewRunner(ts.Store, ts.Service.DeliverActivityDigest).RunOnce(ctx)
+		require.Len(t, listDigests(janeCtx, jane.ID), 1)
+		sender.requireNone(t)
+
+		// The unsubscribe link of the email turns the digest emails off, not the digests.
+		matches := unsubscribeLinkRegexp.FindStringSubmatch(message.Body)
+		require.NotNil(t, matches)
+		token, err := url.QueryUnescape(matches[1])
+		require.NoError(t, err)
+		labels, err := ts.Service.UnsubscribeNotificationEmails(ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, []string{"activity digests"}, labels)
+		forgetLastDigest(jane.ID)
+		runner.RunOnce(ctx)
+		require.Len(t, listDigests(janeCtx, jane.ID), 2)
+		sender.requireNone(t)
+	})
+
+	t.Run("a period without activity gives no digest", func(t *testing.T) {
+		enableDigest(johnCtx, john.ID, &v1pb.UserSetting_NotificationSetting{
+			DigestFrequency: v1pb.UserSetting_NotificationSetting_WEEKLY,
+			DigestTime:      digestTime,
+			DigestWeekday:   int32(time.Now().UTC().Add(-time.Hour).Weekday()),
+		}, "digestFrequency", "digestTime", "digestWeekday")
+		forgetLastDigest(john.ID)
+		runner.RunOnce(ctx)
+		require.Empty(t, listDigests(johnCtx, john.ID))
+
+		// The skipped digest is recorded too.
+		userSetting, err := ts.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &john.ID, Key: storepb.UserSetting_NOTIFICATION})
+		require.NoError(t, err)
+		require.NotZero(t, userSetting.GetNotification().LastDigestTs)
+	})
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+)
+
+func TestUserFeedToken(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	name := fmt.Sprintf("users/%d/feedToken", user.ID)
+
+	feedToken, err := ts.Service.GetUserFeedToken(userCtx, &v1pb.GetUserFeedTokenRequest{Name: name})
+	require.NoError(t, err)
+	require.False(t, feedToken.Enabled)
+
+	// Only the user can generate their feed token, as it reads their private memos.
+	_, err = ts.Service.RotateUserFeedToken(hostCtx, &v1pb.RotateUserFeedTokenRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	rotated, err := ts.Service.RotateUserFeedToken(userCtx, &v1pb.RotateUserFeedTokenRequest{Name: name})
+	require.NoError(t, err)
+	require.True(t, rotated.Enabled)
+	require.NotNil(t, rotated.CreateTime)
+	require.NotEmpty(t, rotated.Token)
+
+	// The token is stored hashed, and isn't returned again.
+	setting, err := ts.Store.GetUserFeedToken(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotContains(t, setting.TokenHash, rotated.Token)
+	require.True(t, auth.VerifyFeedToken(setting, rotated.Token))
+	feedToken, err = ts.Service.GetUserFeedToken(hostCtx, &v1pb.GetUserFeedTokenRequest{Name: name})
+	require.NoError(t, err)
+	require.True(t, feedToken.Enabled)
+	require.Empty(t, feedToken.Token)
+
+	// Rotating the token invalidates the previous one.
+	next, err := ts.Service.RotateUserFeedToken(userCtx, &v1pb.RotateUserFeedTokenRequest{Name: name})
+	require.NoError(t, err)
+	require.NotEqual(t, rotated.Token, next.Token)
+	setting, err = ts.Store.GetUserFeedToken(ctx, user.ID)
+	require.NoError(t, err)
+	require.False(t, auth.VerifyFeedToken(setting, rotated.Token))
+	require.True(t, auth.VerifyFeedToken(setting, next.Token))
+
+	// The feed token setting isn't listed with the other settings.
+	settings, err := ts.Service.ListUserSettings(userCtx, &v1pb.ListUserSettingsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+	require.NoError(t, err)
+	for _, userSetting := range settings.Settings {
+		require.NotContains(t, userSetting.Name, "FEED_TOKEN")
+	}
+
+	// Admins can revoke the feed token of a user.
+	_, err = ts.Service.DeleteUserFeedToken(hostCtx, &v1pb.DeleteUserFeedTokenRequest{Name: name})
+	require.NoError(t, err)
+	feedToken, err = ts.Service.GetUserFeedToken(userCtx, &v1pb.GetUserFeedTokenRequest{Name: name})
+	require.NoError(t, err)
+	require.False(t, feedToken.Enabled)
+	setting, err = ts.Store.GetUserFeedToken(ctx, user.ID)
+	require.NoError(t, err)
+	require.False(t, auth.VerifyFeedToken(setting, next.Token))
+}
@@ -0,0 +1,262 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserMemoQuota(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+
+	setInstanceQuotas := func(maxMemos, maxMemosPerDay, maxPublicMemosPerDay int32) {
+		_, err := ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/MEMO_RELATED",
+				Value: &v1pb.InstanceSetting_MemoRelatedSetting_{MemoRelatedSetting: &v1pb.InstanceSetting_MemoRelatedSetting{
+					MaxMemosPerUser:                maxMemos,
+					MaxMemosPerDay:                 maxMemosPerDay,
+					NewAccountMaxPublicMemosPerDay: maxPublicMemosPerDay,
+				}},
+			},
+		})
+		require.NoError(t, err)
+	}
+	createMemo := func(ctx context.Context, visibility v1pb.Visibility) (*v1pb.Memo, error) {
+		return ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: visibility}})
+	}
+	// moveMemo moves the creation of a memo to createdSec in the store, as imports do.
+	moveMemo := func(memo *v1pb.Memo, createdSec int64) {
+		uid := strings.TrimPrefix(memo.Name, "memos/")
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+		require.NoError(t, err)
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdSec}))
+	}
+	setTimezone := func(userID int32, timezone string) {
+		_, err := ts.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: userID,
+			Key:    storepb.UserSetting_GENERAL,
+			Value:  &storepb.UserSetting_General{General: &storepb.GeneralUserSetting{Timezone: timezone}},
+		})
+		require.NoError(t, err)
+	}
+	memoQuotaName := func(userID int32) string {
+		return fmt.Sprintf("users/%d/settings/MEMO_QUOTA", userID)
+	}
+
+	t.Run("memos per user", func(t *testing.T) {
+		user, err := ts.CreateRegularUser(ctx, "total")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		setInstanceQuotas(2, 0, 0)
+		defer setInstanceQuotas(0, 0, 0)
+
+		first, err := createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.NoError(t, err)
+		_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.NoError(t, err)
+		_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "at most 2 memos")
+		_, err = ts.Service.DuplicateMemo(userCtx, &v1pb.DuplicateMemoRequest{Name: first.Name})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// The memos in the trash aren't counted.
+		_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: first.Name})
+		require.NoError(t, err)
+		_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.NoError(t, err)
+
+		// The host is exempt.
+		for range 3 {
+			_, err = createMemo(hostCtx, v1pb.Visibility_PRIVATE)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("memos per day across time zones", func(t *testing.T) {
+		for i, timezone := range []string{"Pacific/Kiritimati", "UTC", "Pacific/Honolulu"} {
+			user, err := ts.CreateRegularUser(ctx, fmt.Sprintf("daily%d", i))
+			require.NoError(t, err)
+			userCtx := ts.CreateUserContext(ctx, user.ID)
+			setTimezone(user.ID, timezone)
+			setInstanceQuotas(0, 2, 0)
+
+			location, err := time.LoadLocation(timezone)
+			require.NoError(t, err)
+			year, month, day := time.Now().In(location).Date()
+			dayStart := time.Date(year, month, day, 0, 0, 0, 0, location)
+
+			// The memos created yesterday in the time zone of the user aren't counted.
+			yesterdaySec := dayStart.AddDate(0, 0, -1).Unix()
+			require.NoError(t, ts.Store.AddMemoQuotaUsage(ctx, &store.MemoQuotaUsage{UserID: user.ID, DayStartTs: yesterdaySec, MemoCount: 2}))
+			_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+			require.NoError(t, err, timezone)
+			_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+			require.NoError(t, err, timezone)
+			_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+			require.Equal(t, codes.ResourceExhausted, status.Code(err), timezone)
+			require.Contains(t, status.Convert(err).Message(), "at most 2 memos per day")
+			usage, err := ts.Store.GetMemoQuotaUsage(ctx, user.ID, 0)
+			require.NoError(t, err)
+			require.Equal(t, dayStart.Unix(), usage.DayStartTs, timezone)
+
+			setting, err := ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: memoQuotaName(user.ID)})
+			require.NoError(t, err)
+			require.Equal(t, int32(2), setting.GetMemoQuotaSetting().MemoCount)
+			require.Equal(t, int32(2), setting.GetMemoQuotaSetting().TodayMemoCount, timezone)
+		}
+		setInstanceQuotas(0, 0, 0)
+	})
+
+	t.Run("public memos per day of new accounts", func(t *testing.T) {
+		user, err := ts.CreateRegularUser(ctx, "public")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		setInstanceQuotas(0, 0, 1)
+		defer setInstanceQuotas(0, 0, 0)
+
+		_, err = createMemo(userCtx, v1pb.Visibility_PUBLIC)
+		require.NoError(t, err)
+		_, err = createMemo(userCtx, v1pb.Visibility_PUBLIC)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "at most 1 public memos per day")
+
+		// Private memos aren't limited, but making them public is.
+		private, err := createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.NoError(t, err)
+		private.Visibility = v1pb.Visibility_PUBLIC
+		_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: private, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}}})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("backdating and publishing old memos don't free up the quotas per day", func(t *testing.T) {
+		user, err := ts.CreateRegularUser(ctx, "backdate")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		setInstanceQuotas(0, 1, 1)
+		defer setInstanceQuotas(0, 0, 0)
+
+		old, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "old-private", CreatorID: user.ID, Content: "old", Visibility: store.Private})
+		require.NoError(t, err)
+		lastYear := time.Now().AddDate(-1, 0, 0)
+		moveMemo(&v1pb.Memo{Name: "memos/" + old.UID}, lastYear.Unix())
+
+		memo, err := createMemo(userCtx, v1pb.Visibility_PUBLIC)
+		require.NoError(t, err)
+		memo.CreateTime = timestamppb.New(lastYear)
+		memo.DisplayTime = timestamppb.New(lastYear)
+		_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"create_time", "display_time"}}})
+		require.NoError(t, err)
+		_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "at most 1 memos per day")
+
+		// Making an old memo public counts against today's public memos.
+		_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: "memos/" + old.UID, Visibility: v1pb.Visibility_PUBLIC},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "at most 1 public memos per day")
+	})
+
+	t.Run("making memos public counts against the public memos per day", func(t *testing.T) {
+		user, err := ts.CreateRegularUser(ctx, "flip")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		setInstanceQuotas(0, 0, 1)
+		defer setInstanceQuotas(0, 0, 0)
+
+		first, err := createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.NoError(t, err)
+		second, err := createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.NoError(t, err)
+		for i, memo := range []*v1pb.Memo{first, second} {
+			memo.Visibility = v1pb.Visibility_PUBLIC
+			_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}}})
+			if i == 0 {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+			}
+		}
+
+		setting, err := ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: memoQuotaName(user.ID)})
+		require.NoError(t, err)
+		require.Equal(t, int32(2), setting.GetMemoQuotaSetting().TodayMemoCount)
+		require.Equal(t, int32(1), setting.GetMemoQuotaSetting().TodayPublicMemoCount)
+	})
+
+	t.Run("the host overrides the quotas of a user", func(t *testing.T) {
+		user, err := ts.CreateRegularUser(ctx, "override")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		setInstanceQuotas(1, 0, 0)
+		defer setInstanceQuotas(0, 0, 0)
+
+		update := func(ctx context.Context, setting *v1pb.UserSetting_MemoQuotaSetting, paths ...string) (*v1pb.UserSetting, error) {
+			return ts.Service.UpdateUserSetting(ctx, &v1pb.UpdateUserSettingRequest{
+				Setting: &v1pb.UserSetting{
+					Name:  memoQuotaName(user.ID),
+					Value: &v1pb.UserSetting_MemoQuotaSetting_{MemoQuotaSetting: setting},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+			})
+		}
+		unlimited, three := int32(0), int32(3)
+		_, err = update(userCtx, &v1pb.UserSetting_MemoQuotaSetting{MaxMemos: &unlimited}, "maxMemos")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		negative := int32(-1)
+		_, err = update(hostCtx, &v1pb.UserSetting_MemoQuotaSetting{MaxMemos: &negative}, "maxMemos")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		setting, err := update(hostCtx, &v1pb.UserSetting_MemoQuotaSetting{MaxMemos: &three}, "maxMemos")
+		require.NoError(t, err)
+		require.Equal(t, three, setting.GetMemoQuotaSetting().GetMaxMemos())
+		for range 3 {
+			_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+			require.NoError(t, err)
+		}
+		_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.Contains(t, status.Convert(err).Message(), "at most 3 memos")
+
+		// Clearing the override restores the limit of the instance.
+		setting, err = update(hostCtx, &v1pb.UserSetting_MemoQuotaSetting{}, "maxMemos")
+		require.NoError(t, err)
+		require.Nil(t, setting.GetMemoQuotaSetting().MaxMemos)
+		_, err = createMemo(userCtx, v1pb.Visibility_PRIVATE)
+		require.Contains(t, status.Convert(err).Message(), "at most 1 memos")
+	})
+
+	t.Run("negative instance quotas are rejected", func(t *testing.T) {
+		_, err := ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/MEMO_RELATED",
+				Value: &v1pb.InstanceSetting_MemoRelatedSetting_{MemoRelatedSetting: &v1pb.InstanceSetting_MemoRelatedSetting{
+					MaxMemosPerDay: -1,
+				}},
+			},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,206 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var unsubscribeLinkRegexp = regexp.MustCompile(`http://localhost:8080/email/unsubscribe\?token=(\S+)`)
+
+func TestNotificationEmails(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	sender := &recordingEmailSender{messages: make(chan *email.Message, 10)}
+	ts.Service.EmailSender = sender
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: &storepb.InstanceEmailSetting{
+			SmtpHost:  "smtp.example.com",
+			FromEmail: "memos@example.com",
+		}},
+	})
+	require.NoError(t, err)
+
+	jane, err := ts.Store.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser, Email: "jane@example.com"})
+	require.NoError(t, err)
+	janeCtx := ts.CreateUserContext(ctx, jane.ID)
+	john, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	johnCtx := ts.CreateUserContext(ctx, john.ID)
+
+	settingName := fmt.Sprintf("users/%d/settings/NOTIFICATION", jane.ID)
+	updatePreferences := func(setting *v1pb.UserSetting_NotificationSetting, paths ...string) *v1pb.UserSetting_NotificationSetting {
+		updated, err := ts.Service.UpdateUserSetting(janeCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  settingName,
+				Value: &v1pb.UserSetting_NotificationSetting_{NotificationSetting: setting},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		require.NoError(t, err)
+		return updated.GetNotificationSetting()
+	}
+	// backdate makes the notifications old enough to be sent.
+	backdate := func() {
+		_, err := ts.Store.GetDriver().GetDB().ExecContext(ctx, "UPDATE inbox SET created_ts = created_ts - 600")
+		require.NoError(t, err)
+	}
+	deliver := func() {
+		require.NoError(t, ts.Service.DeliverNotificationEmails(ctx, jane.ID, false))
+	}
+	memo, err := ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Release notes", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	react := func(reactionType string) {
+		_, err := ts.Service.UpsertMemoReaction(johnCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: reactionType},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("notification emails are off by default", func(t *testing.T) {
+		setting, err := ts.Service.GetUserSetting(janeCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+		require.NoError(t, err)
+		require.False(t, setting.GetNotificationSetting().EmailMemoReaction)
+		react("👍")
+		backdate()
+		deliver()
+		sender.requireNone(t)
+	})
+
+	t.Run("past notifications aren't sent when turning emails on", func(t *testing.T) {
+		updated := updatePreferences(&v1pb.UserSetting_NotificationSetting{EmailMemoReaction: true, EmailMemoMention: true}, "emailMemoReaction", "emailMemoMention")
+		require.True(t, updated.EmailMemoReaction)
+		require.True(t, updated.EmailMemoMention)
+		require.False(t, updated.EmailMemoComment)
+		deliver()
+		sender.requireNone(t)
+	})
+
+	t.Run("a burst of notifications is sent in one email", func(t *testing.T) {
+		react("🎉")
+		react("❤️")
+		_, err := ts.Service.CreateMemo(johnCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Thanks @jane and @nobody", Visibility: v1pb.Visibility_PROTECTED}})
+		require.NoError(t, err)
+		// The notifications wait for a quiet period.
+		deliver()
+		sender.requireNone(t)
+
+		backdate()
+		deliver()
+		message := sender.next(t)
+		require.Equal(t, "jane@example.com", message.To)
+		require.Equal(t, "3 new notifications on Memos", message.Subject)
+		require.Contains(t, message.Body, "john reacted 🎉 to your memo")
+		require.Contains(t, message.Body, "john mentioned you in a memo")
+		require.Contains(t, message.Body, "http://localhost:8080/"+memo.Name)
+		require.Contains(t, message.HTMLBody, "Release notes")
+		require.NotEmpty(t, message.UnsubscribeURL)
+
+		// The notifications are sent once.
+		deliver()
+		sender.requireNone(t)
+	})
+
+	t.Run("mentions are notified once", func(t *testing.T) {
+		mention, err := ts.Service.CreateMemo(johnCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Hi @jane", Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemo(johnCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: mention.Name, Content: "Hi again @jane"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		// Neither private memos nor self mentions notify.
+		_, err = ts.Service.CreateMemo(johnCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Secret @jane", Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Note to @jane", Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+
+		backdate()
+		deliver()
+		message := sender.next(t)
+		require.Equal(t, "john mentioned you in a memo", message.Subject)
+		require.Contains(t, message.Body, "Hi again @jane")
+	})
+
+	t.Run("unsubscribe links turn off their notifications", func(t *testing.T) {
+		react("👀")
+		backdate()
+		deliver()
+		message := sender.next(t)
+		matches := unsubscribeLinkRegexp.FindStringSubmatch(message.Body)
+		require.NotNil(t, matches)
+		token, err := url.QueryUnescape(matches[1])
+		require.NoError(t, err)
+
+		labels, err := ts.Service.UnsubscribeNotificationEmails(ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, []string{"reactions"}, labels)
+		setting, err := ts.Service.GetUserSetting(janeCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+		require.NoError(t, err)
+		require.False(t, setting.GetNotificationSetting().EmailMemoReaction)
+		require.True(t, setting.GetNotificationSetting().EmailMemoMention)
+
+		react("🚀")
+		backdate()
+		deliver()
+		sender.requireNone(t)
+
+		_, err = ts.Service.UnsubscribeNotificationEmails(ctx, "invalid")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSendTestEmail(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	sender := &recordingEmailSender{messages: make(chan *email.Message, 10)}
+	ts.Service.EmailSender = sender
+
+	host, err := ts.Store.CreateUser(ctx, &store.User{Username: "admin", Role: store.RoleHost, Email: "admin@example.com"})
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	_, err = ts.Service.SendTestEmail(hostCtx, &v1pb.SendTestEmailRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: &storepb.InstanceEmailSetting{
+			SmtpHost:  "smtp.example.com",
+			FromEmail: "memos@example.com",
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.SendTestEmail(ts.CreateUserContext(ctx, user.ID), &v1pb.SendTestEmailRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SendTestEmail(hostCtx, &v1pb.SendTestEmailRequest{Recipient: "not an email"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Service.SendTestEmail(hostCtx, &v1pb.SendTestEmailRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "admin@example.com", sender.next(t).To)
+	_, err = ts.Service.SendTestEmail(hostCtx, &v1pb.SendTestEmailRequest{Recipient: "ops@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "ops@example.com", sender.next(t).To)
+}
@@ -0,0 +1,213 @@
+package test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+// testPasskey simulates an authenticator holding one passkey.
+type testPasskey struct {
+	t            *testing.T
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	rpID         string
+}
+
+func newTestPasskey(t *testing.T, rpID string) *testPasskey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	credentialID := make([]byte, 16)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+	return &testPasskey{t: t, key: key, credentialID: credentialID, rpID: rpID}
+}
+
+func (p *testPasskey) clientData(ceremonyType string, challenge []byte, origin string) []byte {
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremonyType,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    origin,
+	})
+	require.NoError(p.t, err)
+	return data
+}
+
+func (p *testPasskey) authenticatorData(flags byte, signCount uint32, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(p.rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, signCount)
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(p.credentialID)))
+		data = append(data, p.credentialID...)
+	}
+	return data
+}
+
+func (p *testPasskey) register(options *v1pb.UserPasskeyOptions, parent, origin string) *v1pb.CreateUserPasskeyRequest {
+	publicKey, err := x509.MarshalPKIXPublicKey(&p.key.PublicKey)
+	require.NoError(p.t, err)
+	return &v1pb.CreateUserPasskeyRequest{
+		Parent:             parent,
+		Label:              "laptop",
+		CredentialId:       p.credentialID,
+		ClientDataJson:     p.clientData("webauthn.create", options.Challenge, origin),
+		AuthenticatorData:  p.authenticatorData(0x41, 0, true),
+		PublicKey:          publicKey,
+		PublicKeyAlgorithm: auth.PasskeyAlgorithmES256,
+		Transports:         []string{"internal"},
+	}
+}
+
+func (p *testPasskey) assert(challenge, userHandle []byte, origin string, signCount uint32) *v1pb.CreateSessionRequest {
+	clientDataJSON := p.clientData("webauthn.get", challenge, origin)
+	authData := p.authenticatorData(0x01, signCount, false)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(slices.Concat(authData, clientDataHash[:]))
+	signature, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	require.NoError(p.t, err)
+	return &v1pb.CreateSessionRequest{
+		Credentials: &v1pb.CreateSessionRequest_PasskeyCredentials_{
+			PasskeyCredentials: &v1pb.CreateSessionRequest_PasskeyCredentials{
+				CredentialId:      p.credentialID,
+				ClientDataJson:    clientDataJSON,
+				AuthenticatorData: authData,
+				Signature:         signature,
+				UserHandle:        userHandle,
+			},
+		},
+	}
+}
+
+func TestUserPasskeys(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+	origin := ts.Profile.InstanceURL
+	passkey := newTestPasskey(t, "localhost")
+
+	signIn := func(request *v1pb.CreateSessionRequest) (*v1pb.CreateSessionResponse, error) {
+		return ts.Service.CreateSession(apiv1.WithHeaderCarrier(ctx), request)
+	}
+	signInChallenge := func() []byte {
+		challenge, err := ts.Service.CreatePasskeyChallenge(ctx, &v1pb.CreatePasskeyChallengeRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "localhost", challenge.RpId)
+		return challenge.Challenge
+	}
+
+	t.Run("register", func(t *testing.T) {
+		options, err := ts.Service.CreateUserPasskeyOptions(userCtx, &v1pb.CreateUserPasskeyOptionsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Equal(t, "localhost", options.RpId)
+		require.Equal(t, auth.PasskeyUserHandle(user.ID), options.UserHandle)
+		require.Empty(t, options.ExcludeCredentialIds)
+
+		_, err = ts.Service.CreateUserPasskey(userCtx, passkey.register(options, parent, "https://evil.example.com"))
+		require.Error(t, err)
+
+		created, err := ts.Service.CreateUserPasskey(userCtx, passkey.register(options, parent, origin))
+		require.NoError(t, err)
+		require.Equal(t, "laptop", created.Label)
+
+		resp, err := ts.Service.ListUserPasskeys(userCtx, &v1pb.ListUserPasskeysRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.Passkeys, 1)
+		require.Equal(t, []string{"internal"}, resp.Passkeys[0].Transports)
+
+		options, err = ts.Service.CreateUserPasskeyOptions(userCtx, &v1pb.CreateUserPasskeyOptionsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{passkey.credentialID}, options.ExcludeCredentialIds)
+	})
+
+	t.Run("sign in", func(t *testing.T) {
+		resp, err := signIn(passkey.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 1))
+		require.NoError(t, err)
+		require.Equal(t, user.Username, resp.User.Username)
+
+		passkeys, err := ts.Store.GetUserPasskeys(ctx, user.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, passkeys[0].SignCount)
+		require.NotNil(t, passkeys[0].LastUsedTime)
+	})
+
+	t.Run("sign counter must increase", func(t *testing.T) {
+		_, err := signIn(passkey.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 1))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "sign counter")
+
+		_, err = signIn(passkey.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 2))
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid assertions are rejected", func(t *testing.T) {
+		_, err := signIn(passkey.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), "https://evil.example.com", 10))
+		require.Error(t, err)
+
+		// Registration challenges can't be used for sign-in.
+		options, err := ts.Service.CreateUserPasskeyOptions(userCtx, &v1pb.CreateUserPasskeyOptionsRequest{Parent: parent})
+		require.NoError(t, err)
+		_, err = signIn(passkey.assert(options.Challenge, auth.PasskeyUserHandle(user.ID), origin, 11))
+		require.Error(t, err)
+
+		// A different key can't sign for the registered credential.
+		other := newTestPasskey(t, "localhost")
+		other.credentialID = passkey.credentialID
+		_, err = signIn(other.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 12))
+		require.Error(t, err)
+	})
+
+	t.Run("challenges are single-use", func(t *testing.T) {
+		// Authenticators without a signature counter always report zero.
+		counterless := newTestPasskey(t, "localhost")
+		options, err := ts.Service.CreateUserPasskeyOptions(userCtx, &v1pb.CreateUserPasskeyOptionsRequest{Parent: parent})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateUserPasskey(userCtx, counterless.register(options, parent, origin))
+		require.NoError(t, err)
+		_, err = ts.Service.CreateUserPasskey(userCtx, counterless.register(options, parent, origin))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "already used")
+
+		assertion := counterless.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 0)
+		_, err = signIn(assertion)
+		require.NoError(t, err)
+		_, err = signIn(assertion)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "already used")
+
+		_, err = signIn(counterless.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 0))
+		require.NoError(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		name := fmt.Sprintf("%s/passkeys/%s", parent, base64.RawURLEncoding.EncodeToString(passkey.credentialID))
+		_, err := ts.Service.DeleteUserPasskey(userCtx, &v1pb.DeleteUserPasskeyRequest{Name: name})
+		require.NoError(t, err)
+
+		_, err = signIn(passkey.assert(signInChallenge(), auth.PasskeyUserHandle(user.ID), origin, 20))
+		require.Error(t, err)
+	})
+}
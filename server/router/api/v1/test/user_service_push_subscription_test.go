@@ -0,0 +1,226 @@
+package test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// recordingPushSender records the push messages it is asked to send, and answers that the
+// subscriptions of its gone endpoints are gone.
+type recordingPushSender struct {
+	messages chan *webpush.Message
+
+	mutex sync.Mutex
+	gone  map[string]bool
+}
+
+func (r *recordingPushSender) Send(_ context.Context, _ *webpush.VAPID, subscription *webpush.Subscription, message *webpush.Message) error {
+	r.mutex.Lock()
+	gone := r.gone[subscription.Endpoint]
+	r.mutex.Unlock()
+	if gone {
+		return webpush.ErrSubscriptionGone
+	}
+	r.messages <- message
+	return nil
+}
+
+func (r *recordingPushSender) setGone(endpoint string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.gone[endpoint] = true
+}
+
+func (r *recordingPushSender) next(t *testing.T) *webpush.Message {
+	select {
+	case message := <-r.messages:
+		return message
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no push message was sent")
+		return nil
+	}
+}
+
+func (r *recordingPushSender) requireNone(t *testing.T) {
+	select {
+	case message := <-r.messages:
+		require.FailNow(t, "unexpected push message", "%s", message.Title)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPushSubscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	sender := &recordingPushSender{messages: make(chan *webpush.Message, 10), gone: map[string]bool{}}
+	ts.Service.PushSender = sender
+
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	newKeys := func() ([]byte, []byte) {
+		key, err := ecdh.P256().GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		auth := make([]byte, 16)
+		_, err = rand.Read(auth)
+		require.NoError(t, err)
+		return key.PublicKey().Bytes(), auth
+	}
+	subscribe := func(ctx context.Context, endpoint, label string, expireTime *timestamppb.Timestamp) (*v1pb.UserPushSubscription, error) {
+		p256dh, auth := newKeys()
+		return ts.Service.CreateUserPushSubscription(ctx, &v1pb.CreateUserPushSubscriptionRequest{
+			Parent:     parent,
+			Label:      label,
+			Endpoint:   endpoint,
+			P256Dh:     p256dh,
+			Auth:       auth,
+			ExpireTime: expireTime,
+		})
+	}
+	listSubscriptions := func() []*v1pb.UserPushSubscription {
+		response, err := ts.Service.ListUserPushSubscriptions(userCtx, &v1pb.ListUserPushSubscriptionsRequest{Parent: parent})
+		require.NoError(t, err)
+		return response.PushSubscriptions
+	}
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Release notes", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	react := func(reactionType string) {
+		_, err := ts.Service.UpsertMemoReaction(otherCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: reactionType},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("the instance profile has the public key", func(t *testing.T) {
+		profile, err := ts.Service.GetInstanceProfile(ctx, &v1pb.GetInstanceProfileRequest{})
+		require.NoError(t, err)
+		require.NotEmpty(t, profile.WebPushPublicKey)
+		again, err := ts.Service.GetInstanceProfile(ctx, &v1pb.GetInstanceProfileRequest{})
+		require.NoError(t, err)
+		require.Equal(t, profile.WebPushPublicKey, again.WebPushPublicKey)
+	})
+
+	t.Run("devices are registered once per endpoint", func(t *testing.T) {
+		phone, err := subscribe(userCtx, "https://push.example.com/phone", "Phone", nil)
+		require.NoError(t, err)
+		require.Equal(t, "push.example.com", phone.PushService)
+		updated, err := subscribe(userCtx, "https://push.example.com/phone", "My phone", nil)
+		require.NoError(t, err)
+		require.Equal(t, phone.Name, updated.Name)
+		subscriptions := listSubscriptions()
+		require.Len(t, subscriptions, 1)
+		require.Equal(t, "My phone", subscriptions[0].Label)
+	})
+
+	t.Run("invalid subscriptions are rejected", func(t *testing.T) {
+		_, err := subscribe(userCtx, "http://push.example.com/insecure", "", nil)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.CreateUserPushSubscription(userCtx, &v1pb.CreateUserPushSubscriptionRequest{
+			Parent:   parent,
+			Endpoint: "https://push.example.com/invalid",
+			P256Dh:   []byte("invalid"),
+			Auth:     make([]byte, 16),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = subscribe(otherCtx, "https://push.example.com/other", "", nil)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("notifications are pushed to the devices", func(t *testing.T) {
+		react("👍")
+		message := sender.next(t)
+		require.Equal(t, "john reacted 👍 to your memo", message.Title)
+		require.Equal(t, "Release notes", message.Body)
+		require.Equal(t, "http://localhost:8080/"+memo.Name, message.URL)
+		require.Eventually(t, func() bool {
+			return listSubscriptions()[0].LastPushTime != nil
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("nothing is pushed during the quiet hours", func(t *testing.T) {
+		now := time.Now().UTC()
+		_, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name: parent + "/settings/NOTIFICATION",
+				Value: &v1pb.UserSetting_NotificationSetting_{NotificationSetting: &v1pb.UserSetting_NotificationSetting{
+					QuietHoursStart: now.Add(-time.Hour).Format("15:04"),
+					QuietHoursEnd:   now.Add(time.Hour).Format("15:04"),
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"quietHoursStart", "quietHoursEnd"}},
+		})
+		require.NoError(t, err)
+		react("🎉")
+		sender.requireNone(t)
+
+		_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  parent + "/settings/NOTIFICATION",
+				Value: &v1pb.UserSetting_NotificationSetting_{NotificationSetting: &v1pb.UserSetting_NotificationSetting{QuietHoursStart: "25:00"}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"quietHoursStart"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  parent + "/settings/NOTIFICATION",
+				Value: &v1pb.UserSetting_NotificationSetting_{NotificationSetting: &v1pb.UserSetting_NotificationSetting{}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"quietHoursStart", "quietHoursEnd"}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("expired and gone subscriptions are deleted", func(t *testing.T) {
+		_, err := subscribe(userCtx, "https://push.example.com/expired", "Old laptop", timestamppb.New(time.Now().Add(-time.Minute)))
+		require.NoError(t, err)
+		_, err = subscribe(userCtx, "https://push.example.com/gone", "Tablet", nil)
+		require.NoError(t, err)
+		sender.setGone("https://push.example.com/gone")
+		require.Len(t, listSubscriptions(), 3)
+
+		react("❤️")
+		sender.next(t)
+		sender.requireNone(t)
+		require.Eventually(t, func() bool {
+			return len(listSubscriptions()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, "My phone", listSubscriptions()[0].Label)
+	})
+
+	t.Run("devices are revoked", func(t *testing.T) {
+		name := listSubscriptions()[0].Name
+		_, err := ts.Service.DeleteUserPushSubscription(otherCtx, &v1pb.DeleteUserPushSubscriptionRequest{Name: name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.DeleteUserPushSubscription(userCtx, &v1pb.DeleteUserPushSubscriptionRequest{Name: name})
+		require.NoError(t, err)
+		require.Empty(t, listSubscriptions())
+		_, err = ts.Service.DeleteUserPushSubscription(userCtx, &v1pb.DeleteUserPushSubscriptionRequest{Name: name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		react("🚀")
+		sender.requireNone(t)
+	})
+}
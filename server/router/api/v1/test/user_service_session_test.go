@@ -0,0 +1,93 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+)
+
+func TestUserSessions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	for _, sessionID := range []string{"laptop", "phone", "tablet"} {
+		require.NoError(t, ts.Service.UpsertUserSession(ctx, user.ID, sessionID, &storepb.SessionsUserSetting_ClientInfo{
+			UserAgent: "Mozilla/5.0",
+			IpAddress: "192.0.2.1",
+		}))
+	}
+	userCtx := context.WithValue(ts.CreateUserContext(ctx, user.ID), auth.SessionIDContextKey, "laptop")
+	parent := fmt.Sprintf("users/%d", user.ID)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+
+	t.Run("ListUserSessions flags the current session", func(t *testing.T) {
+		resp, err := ts.Service.ListUserSessions(userCtx, &v1pb.ListUserSessionsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.Sessions, 3)
+		for _, session := range resp.Sessions {
+			require.Equal(t, session.SessionId == "laptop", session.Current)
+			require.Equal(t, "192.0.2.1", session.ClientInfo.IpAddress)
+		}
+	})
+
+	t.Run("ListUserSessions denies other users", func(t *testing.T) {
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		_, err = ts.Service.ListUserSessions(ts.CreateUserContext(ctx, other.ID), &v1pb.ListUserSessionsRequest{Parent: parent})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("RevokeUserSession invalidates the cookie immediately", func(t *testing.T) {
+		cookie := auth.BuildSessionCookieValue(user.ID, "phone")
+		_, err := authenticator.AuthenticateBySession(ctx, cookie)
+		require.NoError(t, err)
+
+		_, err = ts.Service.RevokeUserSession(userCtx, &v1pb.RevokeUserSessionRequest{Name: parent + "/sessions/phone"})
+		require.NoError(t, err)
+		_, err = authenticator.AuthenticateBySession(ctx, cookie)
+		require.Error(t, err)
+	})
+
+	t.Run("RevokeOtherUserSessions keeps the current session", func(t *testing.T) {
+		_, err := ts.Service.RevokeOtherUserSessions(userCtx, &v1pb.RevokeOtherUserSessionsRequest{Parent: parent})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListUserSessions(userCtx, &v1pb.ListUserSessionsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.Sessions, 1)
+		require.Equal(t, "laptop", resp.Sessions[0].SessionId)
+		_, err = authenticator.AuthenticateBySession(ctx, auth.BuildSessionCookieValue(user.ID, "tablet"))
+		require.Error(t, err)
+	})
+
+	t.Run("AuthorizeAndSetContext updates last accessed time lazily", func(t *testing.T) {
+		recent := timestamppb.New(time.Now().Add(-time.Minute))
+		require.NoError(t, ts.Store.UpdateUserSessionLastAccessed(ctx, user.ID, "laptop", recent))
+		_, err := authenticator.AuthorizeAndSetContext(ctx, "", user, "laptop", "", nil, nil)
+		require.NoError(t, err)
+		sessions, err := ts.Store.GetUserSessions(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, recent.AsTime(), sessions[0].LastAccessedTime.AsTime())
+
+		stale := timestamppb.New(time.Now().Add(-auth.SessionLastAccessedUpdateInterval - time.Minute))
+		require.NoError(t, ts.Store.UpdateUserSessionLastAccessed(ctx, user.ID, "laptop", stale))
+		_, err = authenticator.AuthorizeAndSetContext(ctx, "", user, "laptop", "", nil, nil)
+		require.NoError(t, err)
+		sessions, err = ts.Store.GetUserSessions(ctx, user.ID)
+		require.NoError(t, err)
+		require.True(t, sessions[0].LastAccessedTime.AsTime().After(stale.AsTime()))
+	})
+}
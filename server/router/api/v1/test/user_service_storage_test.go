@@ -0,0 +1,140 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestUserStorageQuota(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The files are stored in a temporary directory, with a default quota of 1 MiB.
+	_, err = ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_STORAGE,
+		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: &storepb.InstanceStorageSetting{
+			StorageType:        storepb.InstanceStorageSetting_LOCAL,
+			FilepathTemplate:   filepath.ToSlash(filepath.Join(t.TempDir(), "{uuid}_{filename}")),
+			DefaultUserQuotaMb: 1,
+		}},
+	})
+	require.NoError(t, err)
+
+	upload := func(content []byte) error {
+		_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "file.bin", Type: "application/octet-stream", Content: content},
+		})
+		return err
+	}
+	storageName := fmt.Sprintf("users/%d/settings/STORAGE", user.ID)
+	getStorage := func(ctx context.Context) (*v1pb.UserSetting_StorageSetting, error) {
+		setting, err := ts.Service.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: storageName})
+		return setting.GetStorageSetting(), err
+	}
+	setQuota := func(ctx context.Context, quotaMb *int64) error {
+		_, err := ts.Service.UpdateUserSetting(ctx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name:  storageName,
+				Value: &v1pb.UserSetting_StorageSetting_{StorageSetting: &v1pb.UserSetting_StorageSetting{QuotaMb: quotaMb}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"quotaMb"}},
+		})
+		return err
+	}
+
+	first := bytes.Repeat([]byte("a"), 600<<10)
+	require.NoError(t, upload(first))
+	storage, err := getStorage(userCtx)
+	require.NoError(t, err)
+	require.Equal(t, int64(600<<10), storage.UsedBytes)
+	require.Equal(t, int64(1<<20), storage.QuotaBytes)
+	require.Nil(t, storage.QuotaMb)
+
+	t.Run("uploads exceeding the quota are rejected", func(t *testing.T) {
+		err := upload(bytes.Repeat([]byte("b"), 600<<10))
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), fmt.Sprintf("%d of %d bytes used", 600<<10, 1<<20))
+	})
+
+	t.Run("identical uploads are counted once", func(t *testing.T) {
+		require.NoError(t, upload(first))
+		storage, err := getStorage(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, int64(600<<10), storage.UsedBytes)
+	})
+
+	t.Run("the host sets the quota of a user", func(t *testing.T) {
+		quotaMb := int64(2)
+		require.Equal(t, codes.PermissionDenied, status.Code(setQuota(userCtx, &quotaMb)))
+		require.NoError(t, setQuota(hostCtx, &quotaMb))
+		storage, err := getStorage(hostCtx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2<<20), storage.QuotaBytes)
+		require.Equal(t, int64(2), storage.GetQuotaMb())
+		require.NoError(t, upload(bytes.Repeat([]byte("b"), 600<<10)))
+
+		// A cleared quota falls back to the default quota.
+		require.NoError(t, setQuota(hostCtx, nil))
+		storage, err = getStorage(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, int64(1<<20), storage.QuotaBytes)
+		require.Equal(t, int64(1200<<10), storage.UsedBytes)
+
+		negative := int64(-1)
+		require.Equal(t, codes.InvalidArgument, status.Code(setQuota(hostCtx, &negative)))
+	})
+
+	t.Run("the storage is listed with the user settings", func(t *testing.T) {
+		response, err := ts.Service.ListUserSettings(userCtx, &v1pb.ListUserSettingsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		var storage *v1pb.UserSetting_StorageSetting
+		for _, setting := range response.Settings {
+			if setting.GetStorageSetting() != nil {
+				storage = setting.GetStorageSetting()
+			}
+		}
+		require.NotNil(t, storage)
+		require.Equal(t, int64(1200<<10), storage.UsedBytes)
+	})
+
+	t.Run("admins recalculate the storage usages", func(t *testing.T) {
+		_, err := ts.Service.RecalculateStorageUsage(userCtx, &v1pb.RecalculateStorageUsageRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		require.NoError(t, ts.Store.AddUserStorageUsage(ctx, user.ID, 1<<20))
+		response, err := ts.Service.RecalculateStorageUsage(hostCtx, &v1pb.RecalculateStorageUsageRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), response.UserCount)
+		require.Equal(t, int64(1200<<10), response.TotalBytes)
+		storage, err := getStorage(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, int64(1200<<10), storage.UsedBytes)
+	})
+
+	t.Run("other users can't see the storage of a user", func(t *testing.T) {
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		_, err = getStorage(ts.CreateUserContext(ctx, other.ID))
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
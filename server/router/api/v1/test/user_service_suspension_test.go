@@ -0,0 +1,167 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserSuspension(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "user",
+		Role:         store.RoleUser,
+		Email:        "user@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+	viewer, err := ts.CreateRegularUser(ctx, "viewer")
+	require.NoError(t, err)
+	viewerCtx := ts.CreateUserContext(ctx, viewer.ID)
+	authenticator := auth.NewAuthenticator(ts.Store, ts.Secret)
+
+	signIn := func() (map[string]string, error) {
+		signInCtx := apiv1.WithHeaderCarrier(ctx)
+		_, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "user", Password: "password"},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return responseCookies(signInCtx, t), nil
+	}
+	refresh := func(refreshToken string) error {
+		md := metadata.Pairs("cookie", auth.RefreshTokenCookieName+"="+refreshToken)
+		_, err := ts.Service.RefreshSession(apiv1.WithHeaderCarrier(metadata.NewIncomingContext(ctx, md)), &v1pb.RefreshSessionRequest{})
+		return err
+	}
+	listMemoNames := func(ctx context.Context) []string {
+		resp, err := ts.Service.ListMemos(ctx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range resp.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	listRelationCount := func(ctx context.Context, name string) int {
+		resp, err := ts.Service.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: name})
+		require.NoError(t, err)
+		return len(resp.Relations)
+	}
+	lastAuditLog := func(eventType v1pb.AuditLog_EventType) *v1pb.AuditLog {
+		resp, err := ts.Service.ListAuditLogs(hostCtx, &v1pb.ListAuditLogsRequest{EventType: eventType})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.AuditLogs)
+		return resp.AuditLogs[0]
+	}
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "public memo", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	// The viewer's memo references the memo of the user, who comments on it.
+	viewerMemo, err := ts.Service.CreateMemo(viewerCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "viewer memo", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoRelations(viewerCtx, &v1pb.SetMemoRelationsRequest{
+		Name: viewerMemo.Name,
+		Relations: []*v1pb.MemoRelation{
+			{RelatedMemo: &v1pb.MemoRelation_Memo{Name: memo.Name}, Type: v1pb.MemoRelation_REFERENCE},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    viewerMemo.Name,
+		Comment: &v1pb.Memo{Content: "comment", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, listRelationCount(viewerCtx, viewerMemo.Name))
+
+	t.Run("only the host suspends users", func(t *testing.T) {
+		_, err := ts.Service.SuspendUser(viewerCtx, &v1pb.SuspendUserRequest{Name: userName})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.SuspendUser(hostCtx, &v1pb.SuspendUserRequest{Name: fmt.Sprintf("users/%d", host.ID)})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("suspension revokes the credentials and hides the memos", func(t *testing.T) {
+		cookies, err := signIn()
+		require.NoError(t, err)
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, cookies[auth.AccessTokenCookieName])
+		require.NoError(t, err)
+		accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+			Parent:      userName,
+			AccessToken: &v1pb.UserAccessToken{Description: "test"},
+		})
+		require.NoError(t, err)
+
+		suspended, err := ts.Service.SuspendUser(hostCtx, &v1pb.SuspendUserRequest{Name: userName, Reason: "spam"})
+		require.NoError(t, err)
+		require.NotNil(t, suspended.SuspendTime)
+
+		_, _, err = authenticator.AuthenticateBySessionAccessToken(ctx, cookies[auth.AccessTokenCookieName])
+		require.Error(t, err)
+		require.Error(t, refresh(cookies[auth.RefreshTokenCookieName]))
+		_, _, err = authenticator.AuthenticateByJWT(ctx, accessToken.AccessToken)
+		require.Error(t, err)
+		_, err = signIn()
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "suspended")
+
+		_, err = ts.Service.GetMemo(viewerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		require.NotContains(t, listMemoNames(ctx), memo.Name)
+		require.NotContains(t, listMemoNames(viewerCtx), memo.Name)
+		require.Equal(t, 0, listRelationCount(viewerCtx, viewerMemo.Name))
+		require.Equal(t, 0, listRelationCount(ctx, viewerMemo.Name))
+		// The host still sees the memos, to moderate them.
+		_, err = ts.Service.GetMemo(hostCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, 2, listRelationCount(hostCtx, viewerMemo.Name))
+
+		auditLog := lastAuditLog(v1pb.AuditLog_USER_SUSPENDED)
+		require.Equal(t, fmt.Sprintf("users/%d", host.ID), auditLog.Actor)
+		require.Equal(t, "spam", auditLog.Payload.AsMap()["reason"])
+	})
+
+	t.Run("unsuspension restores the memos and the sign-in", func(t *testing.T) {
+		unsuspended, err := ts.Service.UnsuspendUser(hostCtx, &v1pb.UnsuspendUserRequest{Name: userName})
+		require.NoError(t, err)
+		require.Nil(t, unsuspended.SuspendTime)
+
+		_, err = ts.Service.GetMemo(viewerCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Contains(t, listMemoNames(ctx), memo.Name)
+		require.Equal(t, 2, listRelationCount(viewerCtx, viewerMemo.Name))
+		_, err = signIn()
+		require.NoError(t, err)
+
+		auditLog := lastAuditLog(v1pb.AuditLog_USER_UNSUSPENDED)
+		require.Equal(t, float64(user.ID), auditLog.Payload.AsMap()["user_id"])
+		require.NotContains(t, auditLog.Payload.AsMap(), "reason")
+	})
+}
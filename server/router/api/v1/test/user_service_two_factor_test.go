@@ -0,0 +1,177 @@
+package test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // TOTP uses HMAC-SHA1.
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// totpCode computes the authenticator app code of secret at t.
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(at.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1000000)
+}
+
+func TestUserTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "user",
+		Role:         store.RoleUser,
+		Email:        "user@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	name := fmt.Sprintf("users/%d/twoFactor", user.ID)
+
+	signIn := func(credentials *v1pb.CreateSessionRequest) (*v1pb.CreateSessionResponse, error) {
+		return ts.Service.CreateSession(apiv1.WithHeaderCarrier(ctx), credentials)
+	}
+	signInWithPassword := func() (*v1pb.CreateSessionResponse, error) {
+		return signIn(&v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "user", Password: "password"},
+			},
+		})
+	}
+	signInWithCode := func(challenge, code string) (*v1pb.CreateSessionResponse, error) {
+		return signIn(&v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_TwoFactorCredentials_{
+				TwoFactorCredentials: &v1pb.CreateSessionRequest_TwoFactorCredentials{Challenge: challenge, Code: code},
+			},
+		})
+	}
+
+	var secret string
+	var recoveryCodes []string
+
+	t.Run("enrollment is enabled after confirming a code", func(t *testing.T) {
+		enrollment, err := ts.Service.EnrollUserTwoFactor(userCtx, &v1pb.EnrollUserTwoFactorRequest{Name: name})
+		require.NoError(t, err)
+		secret = enrollment.Secret
+		uri, err := url.Parse(enrollment.OtpauthUri)
+		require.NoError(t, err)
+		require.Equal(t, "otpauth", uri.Scheme)
+		require.Equal(t, secret, uri.Query().Get("secret"))
+
+		// Not enabled until confirmed, so signing in doesn't need a code yet.
+		resp, err := signInWithPassword()
+		require.NoError(t, err)
+		require.NotNil(t, resp.User)
+
+		_, err = ts.Service.ConfirmUserTwoFactor(userCtx, &v1pb.ConfirmUserTwoFactorRequest{Name: name, Code: "000000"})
+		require.Error(t, err)
+		confirmation, err := ts.Service.ConfirmUserTwoFactor(userCtx, &v1pb.ConfirmUserTwoFactorRequest{Name: name, Code: totpCode(t, secret, time.Now())})
+		require.NoError(t, err)
+		require.Len(t, confirmation.RecoveryCodes, auth.RecoveryCodeCount)
+		recoveryCodes = confirmation.RecoveryCodes
+
+		status, err := ts.Service.GetUserTwoFactor(userCtx, &v1pb.GetUserTwoFactorRequest{Name: name})
+		require.NoError(t, err)
+		require.True(t, status.Enabled)
+		require.EqualValues(t, auth.RecoveryCodeCount, status.RecoveryCodesRemaining)
+
+		_, err = ts.Service.EnrollUserTwoFactor(userCtx, &v1pb.EnrollUserTwoFactorRequest{Name: name})
+		require.Error(t, err)
+	})
+
+	t.Run("sign in needs a code and rejects replays", func(t *testing.T) {
+		resp, err := signInWithPassword()
+		require.NoError(t, err)
+		require.Nil(t, resp.User)
+		require.NotEmpty(t, resp.TwoFactorChallenge)
+
+		// The confirmation code was already used, so use the next time step's code.
+		code := totpCode(t, secret, time.Now().Add(30*time.Second))
+		_, err = signInWithCode(resp.TwoFactorChallenge, "000000")
+		require.Error(t, err)
+		session, err := signInWithCode(resp.TwoFactorChallenge, code)
+		require.NoError(t, err)
+		require.Equal(t, user.Username, session.User.Username)
+
+		_, err = signInWithCode(resp.TwoFactorChallenge, code)
+		require.Error(t, err)
+
+		// The challenge is not an access token.
+		_, _, err = auth.NewAuthenticator(ts.Store, ts.Secret).AuthenticateByJWT(ctx, resp.TwoFactorChallenge)
+		require.Error(t, err)
+	})
+
+	t.Run("recovery codes work once", func(t *testing.T) {
+		resp, err := signInWithPassword()
+		require.NoError(t, err)
+		_, err = signInWithCode(resp.TwoFactorChallenge, recoveryCodes[0])
+		require.NoError(t, err)
+		_, err = signInWithCode(resp.TwoFactorChallenge, recoveryCodes[0])
+		require.Error(t, err)
+
+		status, err := ts.Service.GetUserTwoFactor(userCtx, &v1pb.GetUserTwoFactorRequest{Name: name})
+		require.NoError(t, err)
+		require.EqualValues(t, auth.RecoveryCodeCount-1, status.RecoveryCodesRemaining)
+	})
+
+	t.Run("access tokens are unaffected", func(t *testing.T) {
+		token, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+			Parent:      fmt.Sprintf("users/%d", user.ID),
+			AccessToken: &v1pb.UserAccessToken{Description: "test"},
+		})
+		require.NoError(t, err)
+		tokenUser, _, err := auth.NewAuthenticator(ts.Store, ts.Secret).AuthenticateByJWT(ctx, token.AccessToken)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, tokenUser.ID)
+	})
+
+	t.Run("settings don't expose the secret", func(t *testing.T) {
+		resp, err := ts.Service.ListUserSettings(userCtx, &v1pb.ListUserSettingsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		require.NotContains(t, fmt.Sprint(resp), secret)
+	})
+
+	t.Run("users need a code to disable it", func(t *testing.T) {
+		_, err := ts.Service.DeleteUserTwoFactor(userCtx, &v1pb.DeleteUserTwoFactorRequest{Name: name})
+		require.Error(t, err)
+	})
+
+	t.Run("admins can reset it", func(t *testing.T) {
+		_, err := ts.Service.DeleteUserTwoFactor(ts.CreateUserContext(ctx, host.ID), &v1pb.DeleteUserTwoFactorRequest{Name: name})
+		require.NoError(t, err)
+
+		resp, err := signInWithPassword()
+		require.NoError(t, err)
+		require.NotNil(t, resp.User)
+
+		// Regular users can't reset others.
+		_, err = ts.Service.DeleteUserTwoFactor(userCtx, &v1pb.DeleteUserTwoFactorRequest{Name: fmt.Sprintf("users/%d/twoFactor", host.ID)})
+		require.Error(t, err)
+	})
+}
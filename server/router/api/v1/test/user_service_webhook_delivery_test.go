@@ -0,0 +1,210 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryRetries(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	// Private addresses are rejected before connecting, so every attempt fails right away.
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: "http://10.0.0.1/hook", DisplayName: "hook"},
+	})
+	require.NoError(t, err)
+	webhookID := hook.Name[len(fmt.Sprintf("users/%d/webhooks/", user.ID)):]
+
+	payload := `{"activityType":"memos.memo.created"}`
+	payloadHash := sha256.Sum256([]byte(payload))
+	createDueDelivery := func(attempts int32) *store.WebhookDelivery {
+		nowSec := time.Now().Unix()
+		delivery, err := ts.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			UserID:        user.ID,
+			WebhookID:     webhookID,
+			ActivityType:  "memos.memo.created",
+			Payload:       payload,
+			PayloadHash:   hex.EncodeToString(payloadHash[:]),
+			Status:        store.WebhookDeliveryPending,
+			Attempts:      attempts,
+			NextAttemptTs: nowSec - 1,
+			CreatedTs:     nowSec,
+			UpdatedTs:     nowSec,
+		})
+		require.NoError(t, err)
+		return delivery
+	}
+	getDelivery := func(id int32) *store.WebhookDelivery {
+		delivery, err := ts.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &id})
+		require.NoError(t, err)
+		return delivery
+	}
+	getWebhook := func() *v1pb.UserWebhook {
+		response, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		require.Len(t, response.Webhooks, 1)
+		return response.Webhooks[0]
+	}
+
+	t.Run("failed attempts are retried with backoff", func(t *testing.T) {
+		delivery := createDueDelivery(0)
+		require.NoError(t, ts.Service.DeliverDueWebhook(ctx, delivery))
+		delivery = getDelivery(delivery.ID)
+		require.Equal(t, store.WebhookDeliveryPending, delivery.Status)
+		require.Equal(t, int32(1), delivery.Attempts)
+		require.NotEmpty(t, delivery.Error)
+		require.InDelta(t, time.Now().Add(2*time.Minute).Unix(), delivery.NextAttemptTs, 5)
+		require.Equal(t, int32(0), getWebhook().ConsecutiveFailures)
+
+		// A claimed delivery isn't attempted twice.
+		stale := *delivery
+		stale.NextAttemptTs--
+		require.NoError(t, ts.Service.DeliverDueWebhook(ctx, &stale))
+		require.Equal(t, int32(1), getDelivery(delivery.ID).Attempts)
+	})
+
+	t.Run("deliveries fail after 5 attempts", func(t *testing.T) {
+		delivery := createDueDelivery(4)
+		require.NoError(t, ts.Service.DeliverDueWebhook(ctx, delivery))
+		delivery = getDelivery(delivery.ID)
+		require.Equal(t, store.WebhookDeliveryFailed, delivery.Status)
+		require.Equal(t, int32(5), delivery.Attempts)
+		require.Equal(t, int32(1), getWebhook().ConsecutiveFailures)
+	})
+
+	t.Run("webhooks are disabled after 50 consecutive failures", func(t *testing.T) {
+		webhooks, err := ts.Store.GetUserWebhooks(ctx, user.ID)
+		require.NoError(t, err)
+		webhooks[0].ConsecutiveFailures = 49
+		require.NoError(t, ts.Store.UpdateUserWebhook(ctx, user.ID, webhooks[0]))
+
+		require.NoError(t, ts.Service.DeliverDueWebhook(ctx, createDueDelivery(4)))
+		webhook := getWebhook()
+		require.True(t, webhook.Disabled)
+		require.Equal(t, int32(50), webhook.ConsecutiveFailures)
+
+		notifications, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		require.Len(t, notifications.Notifications, 1)
+		require.Equal(t, v1pb.UserNotification_WEBHOOK_DISABLED, notifications.Notifications[0].Type)
+
+		// The deliveries of a disabled webhook fail without being sent.
+		delivery := createDueDelivery(0)
+		require.NoError(t, ts.Service.DeliverDueWebhook(ctx, delivery))
+		delivery = getDelivery(delivery.ID)
+		require.Equal(t, store.WebhookDeliveryFailed, delivery.Status)
+		require.Equal(t, int32(0), delivery.Attempts)
+	})
+
+	t.Run("deliveries are listed newest first", func(t *testing.T) {
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name, PageSize: 3})
+		require.NoError(t, err)
+		require.Len(t, response.Deliveries, 3)
+		require.NotEmpty(t, response.NextPageToken)
+		require.Equal(t, v1pb.UserWebhookDelivery_FAILED, response.Deliveries[0].State)
+		require.Equal(t, "webhook is disabled", response.Deliveries[0].Error)
+
+		response, err = ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name, PageToken: response.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, response.Deliveries, 1)
+		require.Equal(t, v1pb.UserWebhookDelivery_PENDING, response.Deliveries[0].State)
+		require.NotNil(t, response.Deliveries[0].NextAttemptTime)
+		require.Empty(t, response.NextPageToken)
+	})
+
+	t.Run("deliveries are replayed once the webhook is enabled", func(t *testing.T) {
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		name := response.Deliveries[len(response.Deliveries)-1].Name
+
+		_, err = ts.Service.RedeliverUserWebhookDelivery(userCtx, &v1pb.RedeliverUserWebhookDeliveryRequest{Name: name})
+		require.Error(t, err)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		webhook, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: hook.Name, Disabled: false},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"disabled"}},
+		})
+		require.NoError(t, err)
+		require.False(t, webhook.Disabled)
+		require.Equal(t, int32(0), webhook.ConsecutiveFailures)
+
+		redelivery, err := ts.Service.RedeliverUserWebhookDelivery(userCtx, &v1pb.RedeliverUserWebhookDeliveryRequest{Name: name})
+		require.NoError(t, err)
+		require.NotEqual(t, name, redelivery.Name)
+		require.Equal(t, hex.EncodeToString(payloadHash[:]), redelivery.PayloadHash)
+		require.Equal(t, "memos.memo.created", redelivery.ActivityType)
+	})
+
+	t.Run("only the owner can see the deliveries", func(t *testing.T) {
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+		_, err = ts.Service.ListUserWebhookDeliveries(otherCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: fmt.Sprintf("users/%d/webhooks/missing", user.ID)})
+		require.Error(t, err)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestTestUserWebhook(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &v1pb.UserWebhook{Url: "http://10.0.0.1/hook", DisplayName: "hook"},
+	})
+	require.NoError(t, err)
+
+	// Tests are validated like deliveries, so private addresses are rejected.
+	response, err := ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: hook.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), response.StatusCode)
+	require.NotEmpty(t, response.Error)
+
+	// Tests aren't recorded as deliveries.
+	deliveries, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Empty(t, deliveries.Deliveries)
+
+	for range 4 {
+		_, err = ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: hook.Name})
+		require.NoError(t, err)
+	}
+	_, err = ts.Service.TestUserWebhook(userCtx, &v1pb.TestUserWebhookRequest{Name: hook.Name})
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	_, err = ts.Service.TestUserWebhook(ts.CreateUserContext(ctx, other.ID), &v1pb.TestUserWebhookRequest{Name: hook.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
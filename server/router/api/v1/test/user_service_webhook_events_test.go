@@ -0,0 +1,154 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserWebhookEvents(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+	createWebhook := func(events []string) (*v1pb.UserWebhook, error) {
+		// Private addresses are rejected before connecting, so the deliveries aren't sent anywhere.
+		return ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: "http://10.0.0.1/hook", DisplayName: "hook", Events: events},
+		})
+	}
+	listActivityTypes := func(hook *v1pb.UserWebhook) []string {
+		webhookID := hook.Name[len(parent+"/webhooks/"):]
+		deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID})
+		require.NoError(t, err)
+		activityTypes := []string{}
+		for _, delivery := range deliveries {
+			activityTypes = append(activityTypes, delivery.ActivityType)
+		}
+		return activityTypes
+	}
+
+	t.Run("unknown events are rejected", func(t *testing.T) {
+		_, err := createWebhook([]string{"memos.memo.created", "memo.created"})
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, err.Error(), `unknown webhook event "memo.created"`)
+		require.Contains(t, err.Error(), "memos.memo.deleted")
+	})
+
+	t.Run("webhooks are only sent for their events", func(t *testing.T) {
+		all, err := createWebhook(nil)
+		require.NoError(t, err)
+		require.Empty(t, all.Events)
+		created, err := createWebhook([]string{"memos.memo.created", "memos.memo.created"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos.memo.created"}, created.Events)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "memo", Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "updated"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"memos.memo.updated", "memos.memo.created"}, listActivityTypes(all))
+		require.Equal(t, []string{"memos.memo.created"}, listActivityTypes(created))
+
+		// The events can be changed, and cleared to subscribe to all events.
+		updated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: created.Name, Events: []string{"memos.memo.updated", "memos.memo.created"}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"events"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos.memo.created", "memos.memo.updated"}, updated.Events)
+		_, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: created.Name, Events: []string{"memos.memo.archived"}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"events"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		updated, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: created.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"events"}},
+		})
+		require.NoError(t, err)
+		require.Empty(t, updated.Events)
+	})
+}
+
+func TestUserWebhookFormats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+	createWebhook := func(format v1pb.UserWebhook_Format) *v1pb.UserWebhook {
+		hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: "http://10.0.0.1/hook", DisplayName: "hook", Format: format},
+		})
+		require.NoError(t, err)
+		return hook
+	}
+	getPayload := func(hook *v1pb.UserWebhook) map[string]any {
+		webhookID := hook.Name[len(parent+"/webhooks/"):]
+		deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		payload := map[string]any{}
+		require.NoError(t, json.Unmarshal([]byte(deliveries[0].Payload), &payload))
+		return payload
+	}
+
+	raw := createWebhook(v1pb.UserWebhook_FORMAT_UNSPECIFIED)
+	require.Equal(t, v1pb.UserWebhook_RAW, raw.Format)
+	slack := createWebhook(v1pb.UserWebhook_SLACK)
+	require.Equal(t, v1pb.UserWebhook_SLACK, slack.Format)
+	discord := createWebhook(v1pb.UserWebhook_DISCORD)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "# Title\nSome content", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+
+	require.Equal(t, "memos.memo.created", getPayload(raw)["activityType"])
+	slackPayload := getPayload(slack)
+	require.Equal(t, "Memo created: Title", slackPayload["text"])
+	require.Contains(t, string(mustMarshal(t, slackPayload["blocks"])), "http://localhost:8080/"+memo.Name)
+	discordPayload := struct {
+		Embeds []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+		} `json:"embeds"`
+	}{}
+	require.NoError(t, json.Unmarshal(mustMarshal(t, getPayload(discord)), &discordPayload))
+	require.Len(t, discordPayload.Embeds, 1)
+	require.Equal(t, "Title", discordPayload.Embeds[0].Title)
+	require.Equal(t, "Some content", discordPayload.Embeds[0].Description)
+	require.Equal(t, "http://localhost:8080/"+memo.Name, discordPayload.Embeds[0].URL)
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return b
+}
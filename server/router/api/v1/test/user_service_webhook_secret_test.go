@@ -0,0 +1,81 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestUserWebhookSecret(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	t.Run("secrets are shown once and stored encrypted", func(t *testing.T) {
+		hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: "https://example.com/hook", DisplayName: "hook"},
+		})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(hook.Secret, "whsec_"))
+		require.True(t, hook.HasSecret)
+
+		webhooks, err := ts.Store.GetUserWebhooks(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, webhooks, 1)
+		require.NotEmpty(t, webhooks[0].EncryptedSecret)
+		require.NotContains(t, webhooks[0].EncryptedSecret, hook.Secret)
+
+		response, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Empty(t, response.Webhooks[0].Secret)
+		require.True(t, response.Webhooks[0].HasSecret)
+
+		rotated, err := ts.Service.RotateUserWebhookSecret(userCtx, &v1pb.RotateUserWebhookSecretRequest{Name: hook.Name})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(rotated.Secret, "whsec_"))
+		require.NotEqual(t, hook.Secret, rotated.Secret)
+	})
+
+	t.Run("webhooks without a secret can be given one", func(t *testing.T) {
+		require.NoError(t, ts.Store.AddUserWebhook(ctx, user.ID, &storepb.WebhooksUserSetting_Webhook{
+			Id:    "legacy",
+			Title: "legacy",
+			Url:   "https://example.com/legacy",
+		}))
+		response, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, response.Webhooks, 2)
+		require.False(t, response.Webhooks[1].HasSecret)
+
+		name := fmt.Sprintf("users/%d/webhooks/legacy", user.ID)
+		rotated, err := ts.Service.RotateUserWebhookSecret(userCtx, &v1pb.RotateUserWebhookSecretRequest{Name: name})
+		require.NoError(t, err)
+		require.NotEmpty(t, rotated.Secret)
+		require.True(t, rotated.HasSecret)
+		require.Equal(t, "https://example.com/legacy", rotated.Url)
+	})
+
+	t.Run("only the owner can rotate the secret", func(t *testing.T) {
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+		_, err = ts.Service.RotateUserWebhookSecret(otherCtx, &v1pb.RotateUserWebhookSecretRequest{Name: fmt.Sprintf("users/%d/webhooks/legacy", user.ID)})
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
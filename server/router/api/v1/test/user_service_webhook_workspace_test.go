@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestWorkspaceWebhooks(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	createWebhook := func(includePrivateContent bool) *v1pb.UserWebhook {
+		// Private addresses are rejected before connecting, so the deliveries aren't sent anywhere.
+		hook, err := ts.Service.CreateUserWebhook(hostCtx, &v1pb.CreateUserWebhookRequest{
+			Parent: "instance",
+			Webhook: &v1pb.UserWebhook{
+				Url:                   "http://10.0.0.1/hook",
+				DisplayName:           "hook",
+				Events:                []string{webhook.EventMemoCreated},
+				IncludePrivateContent: includePrivateContent,
+			},
+		})
+		require.NoError(t, err)
+		return hook
+	}
+	listPayloads := func(hook *v1pb.UserWebhook) []*webhook.WebhookRequestPayload {
+		ownerID := int32(0)
+		webhookID := hook.Name[len("instance/webhooks/"):]
+		deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &ownerID, WebhookID: &webhookID})
+		require.NoError(t, err)
+		payloads := []*webhook.WebhookRequestPayload{}
+		for _, delivery := range deliveries {
+			payload := &webhook.WebhookRequestPayload{}
+			require.NoError(t, json.Unmarshal([]byte(delivery.Payload), payload))
+			payloads = append(payloads, payload)
+		}
+		return payloads
+	}
+
+	t.Run("only the host manages workspace webhooks", func(t *testing.T) {
+		_, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: "instance"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  "instance",
+			Webhook: &v1pb.UserWebhook{Url: "http://10.0.0.1/hook"},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("workspace webhooks receive the events of every user", func(t *testing.T) {
+		redacted := createWebhook(false)
+		require.Equal(t, v1pb.UserWebhook_WORKSPACE, redacted.Scope)
+		require.False(t, redacted.IncludePrivateContent)
+		full := createWebhook(true)
+		require.True(t, full.IncludePrivateContent)
+
+		_, err = ts.Service.DeleteUserWebhook(userCtx, &v1pb.DeleteUserWebhookRequest{Name: full.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// The webhooks of the user are listed apart from the workspace webhooks.
+		userWebhooks, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: fmt.Sprintf("users/%d", user.ID)})
+		require.NoError(t, err)
+		require.Empty(t, userWebhooks.Webhooks)
+		workspaceWebhooks, err := ts.Service.ListUserWebhooks(hostCtx, &v1pb.ListUserWebhooksRequest{Parent: "instance"})
+		require.NoError(t, err)
+		require.Len(t, workspaceWebhooks.Webhooks, 2)
+
+		privateMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "private", Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		publicMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "public", Visibility: v1pb.Visibility_PUBLIC}})
+		require.NoError(t, err)
+
+		// The content of private memos is only sent to the webhooks that include private content.
+		payloads := listPayloads(redacted)
+		require.Len(t, payloads, 2)
+		require.Equal(t, publicMemo.Name, payloads[0].Memo.Name)
+		require.Equal(t, "public", payloads[0].Memo.Content)
+		require.Equal(t, privateMemo.Name, payloads[1].Memo.Name)
+		require.Equal(t, privateMemo.Creator, payloads[1].Creator)
+		require.Equal(t, webhook.EventMemoCreated, payloads[1].ActivityType)
+		require.Empty(t, payloads[1].Memo.Content)
+		payloads = listPayloads(full)
+		require.Len(t, payloads, 2)
+		require.Equal(t, "private", payloads[1].Memo.Content)
+
+		updated, err := ts.Service.UpdateUserWebhook(hostCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: full.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"include_private_content"}},
+		})
+		require.NoError(t, err)
+		require.False(t, updated.IncludePrivateContent)
+	})
+
+	t.Run("workspace webhook deliveries are listed under the webhook", func(t *testing.T) {
+		workspaceWebhooks, err := ts.Service.ListUserWebhooks(hostCtx, &v1pb.ListUserWebhooksRequest{Parent: "instance"})
+		require.NoError(t, err)
+		hook := workspaceWebhooks.Webhooks[0]
+		response, err := ts.Service.ListUserWebhookDeliveries(hostCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		require.Len(t, response.Deliveries, 2)
+		require.Regexp(t, `^instance/webhooks/[0-9a-f]+/deliveries/\d+$`, response.Deliveries[0].Name)
+
+		_, err = ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
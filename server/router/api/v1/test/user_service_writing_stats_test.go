@@ -0,0 +1,104 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetUserWritingStats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	now := time.Now()
+	createMemo := func(ctx context.Context, content string, createdAt time.Time, rowStatus store.RowStatus) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		memoUID := memo.Name[len("memos/"):]
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		createdTsSec := createdAt.Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdTsSec, RowStatus: &rowStatus}))
+		return memo
+	}
+	first := createMemo(userCtx, "hello world #work", now, store.Normal)
+	createMemo(userCtx, "你好 #work", now.Add(-24*time.Hour), store.Normal)
+	createMemo(userCtx, "one two", now.Add(-72*time.Hour), store.Normal)
+	createMemo(userCtx, "archived memo", now.Add(-48*time.Hour), store.Archived)
+	createMemo(otherCtx, "not counted", now, store.Normal)
+	_, err = ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    first.Name,
+		Comment: &v1pb.Memo{Content: "a comment isn't counted", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	userName := fmt.Sprintf("users/%d", user.ID)
+	t.Run("stats are computed from the memos", func(t *testing.T) {
+		stats, err := ts.Service.GetUserWritingStats(userCtx, &v1pb.GetUserWritingStatsRequest{Name: userName})
+		require.NoError(t, err)
+		require.Equal(t, userName, stats.Name)
+		require.Equal(t, int32(3), stats.TotalMemoCount)
+		// Each CJK character counts as a word.
+		require.Equal(t, int64(8), stats.TotalWordCount)
+		require.InDelta(t, 32.0/3, stats.AverageMemoLength, 0.001)
+		require.Equal(t, map[string]int32{"work": 2}, stats.TagMemoCounts)
+		require.Equal(t, int32(2), stats.CurrentStreakDays)
+		require.Equal(t, int32(2), stats.LongestStreakDays)
+
+		monthCounts := map[string]int32{}
+		for _, createdAt := range []time.Time{now, now.Add(-24 * time.Hour), now.Add(-72 * time.Hour)} {
+			monthCounts[createdAt.UTC().Format("2006-01")]++
+		}
+		got := map[string]int32{}
+		for _, monthCount := range stats.MonthCounts {
+			got[monthCount.Month] = monthCount.Count
+		}
+		require.Equal(t, monthCounts, got)
+	})
+
+	t.Run("archived memos are counted if requested", func(t *testing.T) {
+		stats, err := ts.Service.GetUserWritingStats(userCtx, &v1pb.GetUserWritingStatsRequest{Name: userName, IncludeArchived: true})
+		require.NoError(t, err)
+		require.Equal(t, int32(4), stats.TotalMemoCount)
+		require.Equal(t, int32(4), stats.CurrentStreakDays)
+		require.Equal(t, int32(4), stats.LongestStreakDays)
+	})
+
+	t.Run("stats are cached", func(t *testing.T) {
+		createMemo(userCtx, "after the stats", now, store.Normal)
+		stats, err := ts.Service.GetUserWritingStats(userCtx, &v1pb.GetUserWritingStatsRequest{Name: userName})
+		require.NoError(t, err)
+		require.Equal(t, int32(3), stats.TotalMemoCount)
+	})
+
+	t.Run("only the user and the host get the stats", func(t *testing.T) {
+		_, err := ts.Service.GetUserWritingStats(otherCtx, &v1pb.GetUserWritingStatsRequest{Name: userName})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.GetUserWritingStats(ctx, &v1pb.GetUserWritingStatsRequest{Name: userName})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		stats, err := ts.Service.GetUserWritingStats(ts.CreateUserContext(ctx, host.ID), &v1pb.GetUserWritingStatsRequest{Name: userName})
+		require.NoError(t, err)
+		require.Equal(t, int32(3), stats.TotalMemoCount)
+	})
+}
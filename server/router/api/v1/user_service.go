@@ -20,11 +20,13 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
@@ -258,6 +260,9 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			passwordHashStr := string(passwordHash)
 			update.PasswordHash = &passwordHashStr
 		case "state":
+			if request.User.State == v1pb.State_DRAFT {
+				return nil, status.Errorf(codes.InvalidArgument, "users can't be drafts")
+			}
 			rowStatus := convertStateToStore(request.User.State)
 			update.RowStatus = &rowStatus
 		default:
@@ -269,6 +274,13 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if update.Role != nil && *update.Role != user.Role {
+		auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventUserRoleChanged, currentUser.ID, map[string]any{
+			"user_id":  user.ID,
+			"old_role": user.Role.String(),
+			"new_role": update.Role.String(),
+		})
+	}
 
 	return convertUserFromStore(updatedUser), nil
 }
@@ -299,7 +311,43 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventUserDeleted, currentUser.ID, map[string]any{"user_id": user.ID, "username": user.Username})
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnlockUser clears the failed sign-in attempts of a user, so a locked account can sign in
+// again before the lock expires. Attempts counted against client IPs are not affected.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Admins only.
+func (s *APIV1Service) UnlockUser(ctx context.Context, request *v1pb.UnlockUserRequest) (*emptypb.Empty, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
 
+	if err := auth.NewAuthenticator(s.Store, s.Secret).ResetLoginThrottle(ctx, auth.AccountLoginIdentifier(user.Username)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock user: %v", err)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -311,6 +359,16 @@ func getDefaultUserGeneralSetting() *v1pb.UserSetting_GeneralSetting {
 	}
 }
 
+// getUserGeneralSettingLocation returns the time zone of a user, UTC if it isn't set or no longer known.
+func getUserGeneralSettingLocation(generalSetting *storepb.GeneralUserSetting) *time.Location {
+	if timezone := generalSetting.GetTimezone(); timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			return location
+		}
+	}
+	return time.UTC
+}
+
 func (s *APIV1Service) GetUserSetting(ctx context.Context, request *v1pb.GetUserSettingRequest) (*v1pb.UserSetting, error) {
 	// Parse resource name: users/{user}/settings/{setting}
 	userID, settingKey, err := ExtractUserIDAndSettingKeyFromName(request.Name)
@@ -326,17 +384,24 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, request *v1pb.GetUser
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Only allow user to get their own settings
-	if currentUser.ID != userID {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
-
 	// Convert setting key string to store enum
 	storeKey, err := convertSettingKeyToStore(settingKey)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
+	// Only allow user to get their own settings, the host may get the storage and memo quotas of any user
+	isQuota := storeKey == storepb.UserSetting_STORAGE_QUOTA || storeKey == storepb.UserSetting_MEMO_QUOTA
+	if currentUser.ID != userID && (!isQuota || currentUser.Role != store.RoleHost) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if storeKey == storepb.UserSetting_STORAGE_QUOTA {
+		return s.getStorageUserSetting(ctx, userID)
+	}
+	if storeKey == storepb.UserSetting_MEMO_QUOTA {
+		return s.getMemoQuotaUserSetting(ctx, userID)
+	}
+
 	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
 		UserID: &userID,
 		Key:    storeKey,
@@ -363,11 +428,6 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Only allow user to update their own settings
-	if currentUser.ID != userID {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
-
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is empty")
 	}
@@ -378,7 +438,24 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	// The storage and memo quotas are updated by the host, the other settings by their user
+	if storeKey == storepb.UserSetting_STORAGE_QUOTA {
+		return s.updateStorageUserSetting(ctx, currentUser, userID, request)
+	}
+	if storeKey == storepb.UserSetting_MEMO_QUOTA {
+		return s.updateMemoQuotaUserSetting(ctx, currentUser, userID, request)
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if storeKey == storepb.UserSetting_AUTO_ARCHIVE {
+		return s.updateAutoArchiveUserSetting(ctx, userID, request)
+	}
+	if storeKey == storepb.UserSetting_NOTIFICATION {
+		return s.updateNotificationUserSetting(ctx, userID, request)
+	}
+	// Only GENERAL, AUTO_ARCHIVE, NOTIFICATION and STORAGE settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
 	if storeKey != storepb.UserSetting_GENERAL {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
@@ -399,6 +476,11 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		MemoVisibility: generalSetting.GetMemoVisibility(),
 		Locale:         generalSetting.GetLocale(),
 		Theme:          generalSetting.GetTheme(),
+		Timezone:       generalSetting.GetTimezone(),
+	}
+	if generalSetting != nil && generalSetting.StripImageMetadata != nil {
+		stripImageMetadata := generalSetting.GetStripImageMetadata()
+		updatedGeneral.StripImageMetadata = &stripImageMetadata
 	}
 
 	// Apply updates for fields specified in the update mask
@@ -411,6 +493,13 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			updatedGeneral.Theme = incomingGeneral.Theme
 		case "locale":
 			updatedGeneral.Locale = incomingGeneral.Locale
+		case "timezone":
+			if _, err := time.LoadLocation(incomingGeneral.Timezone); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", incomingGeneral.Timezone)
+			}
+			updatedGeneral.Timezone = incomingGeneral.Timezone
+		case "stripImageMetadata":
+			updatedGeneral.StripImageMetadata = incomingGeneral.StripImageMetadata
 		default:
 			// Ignore unsupported fields
 		}
@@ -466,6 +555,20 @@ func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListU
 
 	settings := make([]*v1pb.UserSetting, 0, len(userSettings))
 	for _, storeSetting := range userSettings {
+		// The two-factor setting holds secrets; its status is exposed by GetUserTwoFactor.
+		// Passkeys are listed by ListUserPasskeys.
+		// The storage and memo quotas are listed with the storage usage and memo counts below.
+		// The announcement dismissal is applied to the instance profile.
+		// The feed token setting holds a token hash; its status is exposed by GetUserFeedToken.
+		// The inbound email setting holds a token; it's exposed by GetUserInboundEmail.
+		// The Slack and Matrix settings hold a link code hash; they're exposed by GetUserSlackLink and
+		// GetUserMatrixLink.
+		if storeSetting.Key == storepb.UserSetting_TWO_FACTOR || storeSetting.Key == storepb.UserSetting_PASSKEYS || storeSetting.Key == storepb.UserSetting_STORAGE_QUOTA ||
+			storeSetting.Key == storepb.UserSetting_FEED_TOKEN || storeSetting.Key == storepb.UserSetting_INBOUND_EMAIL || storeSetting.Key == storepb.UserSetting_SLACK ||
+			storeSetting.Key == storepb.UserSetting_MATRIX || storeSetting.Key == storepb.UserSetting_MEMO_QUOTA ||
+			storeSetting.Key == storepb.UserSetting_ANNOUNCEMENT_DISMISSAL {
+			continue
+		}
 		apiSetting := convertUserSettingFromStore(storeSetting, userID, storeSetting.Key)
 		if apiSetting != nil {
 			settings = append(settings, apiSetting)
@@ -489,6 +592,15 @@ func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListU
 		}
 		settings = append([]*v1pb.UserSetting{defaultGeneral}, settings...)
 	}
+	storageSetting, err := s.getStorageUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	memoQuotaSetting, err := s.getMemoQuotaUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	settings = append(settings, storageSetting, memoQuotaSetting)
 
 	response := &v1pb.ListUserSettingsResponse{
 		Settings:  settings,
@@ -509,7 +621,8 @@ func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListU
 // Security:
 // - Only the token owner can list their tokens
 // - Returns full token strings (so users can manage/revoke them)
-// - Invalid or expired tokens are filtered out
+// - Invalid tokens are filtered out; expired tokens are listed until the cleanup runner deletes them
+// - Includes the last used time and a truncated token for identification
 //
 // Authentication: Required (session cookie or access token)
 // Authorization: User can only list their own tokens.
@@ -535,30 +648,23 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
 	}
 
+	authenticator := auth.NewAuthenticator(s.Store, s.Secret)
 	accessTokens := []*v1pb.UserAccessToken{}
 	for _, userAccessToken := range userAccessTokens {
-		claims := &auth.ClaimsMessage{}
-		_, err := jwt.ParseWithClaims(userAccessToken.AccessToken, claims, func(t *jwt.Token) (any, error) {
-			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-				return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-			}
-			if kid, ok := t.Header["kid"].(string); ok {
-				if kid == "v1" {
-					return []byte(s.Secret), nil
-				}
-			}
-			return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-		})
+		claims, err := authenticator.ParseTokenClaims(ctx, userAccessToken.AccessToken)
 		if err != nil {
-			// If the access token is invalid or expired, just ignore it.
+			// If the access token is invalid, just ignore it.
 			continue
 		}
 
 		accessTokenResponse := &v1pb.UserAccessToken{
-			Name:        fmt.Sprintf("users/%d/accessTokens/%s", userID, userAccessToken.AccessToken),
-			AccessToken: userAccessToken.AccessToken,
-			Description: userAccessToken.Description,
-			IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
+			Name:         fmt.Sprintf("users/%d/accessTokens/%s", userID, userAccessToken.AccessToken),
+			AccessToken:  userAccessToken.AccessToken,
+			Description:  userAccessToken.Description,
+			IssuedAt:     timestamppb.New(claims.IssuedAt.Time),
+			Scopes:       userAccessToken.Scopes,
+			LastUsedTime: userAccessToken.LastUsedTime,
+			TokenPrefix:  truncateAccessToken(userAccessToken.AccessToken),
 		}
 		if claims.ExpiresAt != nil {
 			accessTokenResponse.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
@@ -588,6 +694,7 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 // - Contains user ID and username in claims
 // - Optional expiration time (can be never-expiring)
 // - User-provided description for identification
+// - Optional scopes limiting what the token can do (empty for full access)
 //
 // Security considerations:
 // - Full token is only shown ONCE (in this response)
@@ -595,7 +702,8 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 // - Token can be revoked by deleting it from settings
 //
 // Authentication: Required (session cookie or access token)
-// Authorization: User can only create tokens for themselves.
+// Authorization: User can only create tokens for themselves, with scopes their role and
+// the calling token allow.
 func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.CreateUserAccessTokenRequest) (*v1pb.UserAccessToken, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -612,42 +720,56 @@ func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	scopes := request.AccessToken.Scopes
+	// A scoped token can't mint a token with more access than its own.
+	if callerScopes := auth.GetScopes(ctx); len(callerScopes) > 0 {
+		if len(scopes) == 0 {
+			scopes = callerScopes
+		}
+		for _, scope := range scopes {
+			if !auth.HasScope(callerScopes, scope) {
+				return nil, status.Errorf(codes.PermissionDenied, "access token is missing the %q scope", scope)
+			}
+		}
+	}
+	// currentUser reflects the calling token's scopes, so the admin scope needs admin access.
+	if err := auth.ValidateScopes(scopes, currentUser.Role); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid scopes: %v", err)
+	}
+
 	expiresAt := time.Time{}
 	if request.AccessToken.ExpiresAt != nil {
 		expiresAt = request.AccessToken.ExpiresAt.AsTime()
 	}
 
-	accessToken, err := auth.GenerateAccessToken(currentUser.Username, currentUser.ID, expiresAt, []byte(s.Secret))
+	accessToken, err := auth.NewAuthenticator(s.Store, s.Secret).GenerateAccessToken(ctx, currentUser.Username, currentUser.ID, scopes, expiresAt)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
 	}
 
+	// The token was just signed, so its claims can be read without verifying it again.
 	claims := &auth.ClaimsMessage{}
-	_, err = jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-		}
-		if kid, ok := t.Header["kid"].(string); ok {
-			if kid == "v1" {
-				return []byte(s.Secret), nil
-			}
-		}
-		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-	})
-	if err != nil {
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, claims); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to parse access token: %v", err)
 	}
 
 	// Upsert the access token to user setting store.
-	if err := s.UpsertAccessTokenToStore(ctx, currentUser, accessToken, request.AccessToken.Description); err != nil {
+	if err := s.UpsertAccessTokenToStore(ctx, currentUser, accessToken, request.AccessToken.Description, scopes); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
 	}
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventAccessTokenCreated, currentUser.ID, map[string]any{
+		"token_prefix": truncateAccessToken(accessToken),
+		"description":  request.AccessToken.Description,
+		"scopes":       scopes,
+	})
 
 	userAccessToken := &v1pb.UserAccessToken{
 		Name:        fmt.Sprintf("users/%d/accessTokens/%s", userID, accessToken),
 		AccessToken: accessToken,
 		Description: request.AccessToken.Description,
 		IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
+		Scopes:      scopes,
+		TokenPrefix: truncateAccessToken(accessToken),
 	}
 	if claims.ExpiresAt != nil {
 		userAccessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
@@ -715,6 +837,7 @@ func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventAccessTokenRevoked, currentUser.ID, map[string]any{"token_prefix": truncateAccessToken(accessTokenToDelete)})
 
 	return &emptypb.Empty{}, nil
 }
@@ -726,6 +849,7 @@ func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.
 // - create_time: When the session was created
 // - last_accessed_time: Last API call time (for sliding expiration)
 // - client_info: Device details (browser, OS, IP address, device type)
+// - current: Whether the session is the one making this request
 //
 // Use cases:
 // - User reviews where they're logged in
@@ -756,6 +880,7 @@ func (s *APIV1Service) ListUserSessions(ctx context.Context, request *v1pb.ListU
 		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
 	}
 
+	currentSessionID := auth.GetSessionID(ctx)
 	sessions := []*v1pb.UserSession{}
 	for _, userSession := range userSessions {
 		sessionResponse := &v1pb.UserSession{
@@ -763,6 +888,7 @@ func (s *APIV1Service) ListUserSessions(ctx context.Context, request *v1pb.ListU
 			SessionId:        userSession.SessionId,
 			CreateTime:       userSession.CreateTime,
 			LastAccessedTime: userSession.LastAccessedTime,
+			Current:          currentSessionID != "" && userSession.SessionId == currentSessionID,
 		}
 
 		if userSession.ClientInfo != nil {
@@ -831,13 +957,48 @@ func (s *APIV1Service) RevokeUserSession(ctx context.Context, request *v1pb.Revo
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	if err := s.Store.RemoveUserSession(ctx, userID, sessionIDToRevoke); err != nil {
+	if err := auth.NewAuthenticator(s.Store, s.Secret).RevokeSession(ctx, userID, sessionIDToRevoke, "revoked"); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// RevokeOtherUserSessions terminates all sessions of a user except the current one.
+//
+// Use cases:
+// - User logs out from all devices except current one
+// - User secures the account after losing a device
+//
+// When authenticated by access token there is no current session, so all sessions are revoked.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only revoke their own sessions.
+func (s *APIV1Service) RevokeOtherUserSessions(ctx context.Context, request *v1pb.RevokeOtherUserSessionsRequest) (*emptypb.Empty, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if err := s.Store.RemoveOtherUserSessions(ctx, userID, auth.GetSessionID(ctx)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+	}
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventSessionRevoked, currentUser.ID, map[string]any{"user_id": userID, "reason": "revoked_others"})
+
+	return &emptypb.Empty{}, nil
+}
+
 // UpsertUserSession adds or updates a user session.
 func (s *APIV1Service) UpsertUserSession(ctx context.Context, userID int32, sessionID string, clientInfo *storepb.SessionsUserSetting_ClientInfo) error {
 	session := &storepb.SessionsUserSetting_Session{
@@ -850,7 +1011,16 @@ func (s *APIV1Service) UpsertUserSession(ctx context.Context, userID int32, sess
 	return s.Store.AddUserSession(ctx, userID, session)
 }
 
-func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string) error {
+// truncateAccessToken shortens an access token for display. Every JWT starts with the same
+// header, so the end of the token is kept too.
+func truncateAccessToken(accessToken string) string {
+	if len(accessToken) <= 16 {
+		return accessToken
+	}
+	return accessToken[:8] + "..." + accessToken[len(accessToken)-8:]
+}
+
+func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string, scopes []string) error {
 	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
 	if err != nil {
 		return errors.Wrap(err, "failed to get user access tokens")
@@ -858,6 +1028,7 @@ func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store
 	userAccessToken := storepb.AccessTokensUserSetting_AccessToken{
 		AccessToken: accessToken,
 		Description: description,
+		Scopes:      scopes,
 	}
 	userAccessTokens = append(userAccessTokens, &userAccessToken)
 
@@ -876,23 +1047,16 @@ func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store
 }
 
 func (s *APIV1Service) ListUserWebhooks(ctx context.Context, request *v1pb.ListUserWebhooksRequest) (*v1pb.ListUserWebhooksResponse, error) {
-	userID, err := ExtractUserIDFromName(request.Parent)
+	userID, err := parseUserWebhookParent(request.Parent)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 	}
 
-	currentUser, err := s.GetCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkUserWebhookPermission(ctx, userID); err != nil {
+		return nil, err
 	}
 
-	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	webhooks, err := s.listWebhooks(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
 	}
@@ -908,39 +1072,49 @@ func (s *APIV1Service) ListUserWebhooks(ctx context.Context, request *v1pb.ListU
 }
 
 func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.CreateUserWebhookRequest) (*v1pb.UserWebhook, error) {
-	userID, err := ExtractUserIDFromName(request.Parent)
+	userID, err := parseUserWebhookParent(request.Parent)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 	}
 
-	currentUser, err := s.GetCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkUserWebhookPermission(ctx, userID); err != nil {
+		return nil, err
 	}
 
 	if request.Webhook.Url == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
 	}
 
+	events, err := validateUserWebhookEvents(request.Webhook.Events)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	secret, encryptedSecret, err := s.generateUserWebhookSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
 	webhookID := generateUserWebhookID()
 	webhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+		Id:              webhookID,
+		Title:           request.Webhook.DisplayName,
+		Url:             strings.TrimSpace(request.Webhook.Url),
+		EncryptedSecret: encryptedSecret,
+		Events:          events,
+		Format:          convertUserWebhookFormatToStore(request.Webhook.Format),
+		// Only the workspace webhooks receive the events of memos of other users.
+		IncludePrivateContent: userID == 0 && request.Webhook.IncludePrivateContent,
 	}
 
-	err = s.Store.AddUserWebhook(ctx, userID, webhook)
+	err = s.addWebhook(ctx, userID, webhook)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(webhook, userID), nil
+	// The secret is only shown once, as it's stored encrypted.
+	userWebhook := convertUserWebhookFromUserSetting(webhook, userID)
+	userWebhook.Secret = secret
+	return userWebhook, nil
 }
 
 func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.UpdateUserWebhookRequest) (*v1pb.UserWebhook, error) {
@@ -953,19 +1127,12 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
 	}
 
-	currentUser, err := s.GetCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkUserWebhookPermission(ctx, userID); err != nil {
+		return nil, err
 	}
 
 	// Get existing webhooks
-	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	webhooks, err := s.listWebhooks(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
 	}
@@ -984,11 +1151,7 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 	}
 
 	// Update the webhook
-	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: targetWebhook.Title,
-		Url:   targetWebhook.Url,
-	}
+	updatedWebhook := proto.CloneOf(targetWebhook)
 
 	if request.UpdateMask != nil {
 		for _, path := range request.UpdateMask.Paths {
@@ -999,6 +1162,18 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 				}
 			case "display_name":
 				updatedWebhook.Title = request.Webhook.DisplayName
+			case "disabled":
+				setUserWebhookDisabled(updatedWebhook, request.Webhook.Disabled)
+			case "events":
+				events, err := validateUserWebhookEvents(request.Webhook.Events)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+				updatedWebhook.Events = events
+			case "format":
+				updatedWebhook.Format = convertUserWebhookFormatToStore(request.Webhook.Format)
+			case "include_private_content":
+				updatedWebhook.IncludePrivateContent = userID == 0 && request.Webhook.IncludePrivateContent
 			default:
 				// Ignore unsupported fields
 			}
@@ -1009,9 +1184,17 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 			updatedWebhook.Url = strings.TrimSpace(request.Webhook.Url)
 		}
 		updatedWebhook.Title = request.Webhook.DisplayName
+		setUserWebhookDisabled(updatedWebhook, request.Webhook.Disabled)
+		events, err := validateUserWebhookEvents(request.Webhook.Events)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		updatedWebhook.Events = events
+		updatedWebhook.Format = convertUserWebhookFormatToStore(request.Webhook.Format)
+		updatedWebhook.IncludePrivateContent = userID == 0 && request.Webhook.IncludePrivateContent
 	}
 
-	err = s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook)
+	err = s.updateWebhook(ctx, userID, updatedWebhook)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
 	}
@@ -1025,19 +1208,12 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
 	}
 
-	currentUser, err := s.GetCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkUserWebhookPermission(ctx, userID); err != nil {
+		return nil, err
 	}
 
 	// Get existing webhooks to verify the webhook exists
-	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	webhooks, err := s.listWebhooks(ctx, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
 	}
@@ -1055,10 +1231,13 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 		return nil, status.Errorf(codes.NotFound, "webhook not found")
 	}
 
-	err = s.Store.RemoveUserWebhook(ctx, userID, webhookID)
+	err = s.removeWebhook(ctx, userID, webhookID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if err := s.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{UserID: &userID, WebhookID: &webhookID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -1072,28 +1251,108 @@ func generateUserWebhookID() string {
 	return hex.EncodeToString(b)
 }
 
-// parseUserWebhookName parses a webhook name and returns the webhook ID and user ID.
-// Format: users/{user}/webhooks/{webhook}.
+// parseUserWebhookName parses a webhook name and returns the webhook ID and the owner ID, which
+// is 0 for the workspace webhooks.
+// Format: users/{user}/webhooks/{webhook} or instance/webhooks/{webhook}.
 func parseUserWebhookName(name string) (string, int32, error) {
 	parts := strings.Split(name, "/")
+	if len(parts) == 3 && parts[0] == workspaceWebhookParent && parts[1] == "webhooks" {
+		return parts[2], 0, nil
+	}
 	if len(parts) != 4 || parts[0] != "users" || parts[2] != "webhooks" {
 		return "", 0, errors.New("invalid webhook name format")
 	}
 
 	userID, err := strconv.ParseInt(parts[1], 10, 32)
-	if err != nil {
+	if err != nil || userID <= 0 {
 		return "", 0, errors.New("invalid user ID in webhook name")
 	}
 
 	return parts[3], int32(userID), nil
 }
 
-// convertUserWebhookFromUserSetting converts a storepb webhook to a v1pb UserWebhook.
+// validateUserWebhookEvents checks that the events of a webhook are known, and returns them
+// deduplicated in the order of webhook.Events.
+func validateUserWebhookEvents(events []string) ([]string, error) {
+	for _, event := range events {
+		if !slices.Contains(webhook.Events, event) {
+			return nil, errors.Errorf("unknown webhook event %q, valid events are: %s", event, strings.Join(webhook.Events, ", "))
+		}
+	}
+	validated := []string{}
+	for _, event := range webhook.Events {
+		if slices.Contains(events, event) {
+			validated = append(validated, event)
+		}
+	}
+	return validated, nil
+}
+
+func convertUserWebhookFormatToStore(format v1pb.UserWebhook_Format) storepb.WebhooksUserSetting_Webhook_Format {
+	switch format {
+	case v1pb.UserWebhook_SLACK:
+		return storepb.WebhooksUserSetting_Webhook_SLACK
+	case v1pb.UserWebhook_DISCORD:
+		return storepb.WebhooksUserSetting_Webhook_DISCORD
+	default:
+		return storepb.WebhooksUserSetting_Webhook_RAW
+	}
+}
+
+func convertUserWebhookFormatFromStore(format storepb.WebhooksUserSetting_Webhook_Format) v1pb.UserWebhook_Format {
+	switch format {
+	case storepb.WebhooksUserSetting_Webhook_SLACK:
+		return v1pb.UserWebhook_SLACK
+	case storepb.WebhooksUserSetting_Webhook_DISCORD:
+		return v1pb.UserWebhook_DISCORD
+	default:
+		return v1pb.UserWebhook_RAW
+	}
+}
+
+// convertWebhookFormatFromStore returns the format webhook requests are sent in.
+func convertWebhookFormatFromStore(format storepb.WebhooksUserSetting_Webhook_Format) webhook.Format {
+	switch format {
+	case storepb.WebhooksUserSetting_Webhook_SLACK:
+		return webhook.FormatSlack
+	case storepb.WebhooksUserSetting_Webhook_DISCORD:
+		return webhook.FormatDiscord
+	default:
+		return webhook.FormatRaw
+	}
+}
+
+// isUserWebhookSubscribed reports whether a webhook is sent for an event.
+func isUserWebhookSubscribed(hook *storepb.WebhooksUserSetting_Webhook, event string) bool {
+	return len(hook.Events) == 0 || slices.Contains(hook.Events, event)
+}
+
+// setUserWebhookDisabled disables or enables a webhook. Enabling a webhook resets the count of its
+// failed deliveries, so that it isn't disabled again by the next failure.
+func setUserWebhookDisabled(webhook *storepb.WebhooksUserSetting_Webhook, disabled bool) {
+	if webhook.Disabled && !disabled {
+		webhook.ConsecutiveFailures = 0
+	}
+	webhook.Disabled = disabled
+}
+
+// convertUserWebhookFromUserSetting converts a storepb webhook of an owner ID to a v1pb UserWebhook.
 func convertUserWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webhook, userID int32) *v1pb.UserWebhook {
+	scope := v1pb.UserWebhook_USER
+	if userID == 0 {
+		scope = v1pb.UserWebhook_WORKSPACE
+	}
 	return &v1pb.UserWebhook{
-		Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-		Url:         webhook.Url,
-		DisplayName: webhook.Title,
+		Name:                  fmt.Sprintf("%s/webhooks/%s", formatUserWebhookParent(userID), webhook.Id),
+		Url:                   webhook.Url,
+		DisplayName:           webhook.Title,
+		Disabled:              webhook.Disabled,
+		ConsecutiveFailures:   webhook.ConsecutiveFailures,
+		HasSecret:             webhook.EncryptedSecret != "",
+		Events:                webhook.Events,
+		Format:                convertUserWebhookFormatFromStore(webhook.Format),
+		Scope:                 scope,
+		IncludePrivateContent: webhook.IncludePrivateContent,
 		// Note: create_time and update_time are not available in the user setting webhook structure
 		// This is a limitation of storing webhooks in user settings vs the dedicated webhook table
 	}
@@ -1112,6 +1371,9 @@ func convertUserFromStore(user *store.User) *v1pb.User {
 		AvatarUrl:   user.AvatarURL,
 		Description: user.Description,
 	}
+	if user.SuspendedTs != 0 {
+		userpb.SuspendTime = timestamppb.New(time.Unix(user.SuspendedTs, 0))
+	}
 	// Use the avatar URL instead of raw base64 image data to reduce the response size.
 	if user.AvatarURL != "" {
 		// Check if avatar url is base64 format.
@@ -1193,6 +1455,14 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_ACCESS_TOKENS, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_AUTO_ARCHIVE)]:
+		return storepb.UserSetting_AUTO_ARCHIVE, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_STORAGE)]:
+		return storepb.UserSetting_STORAGE_QUOTA, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_MEMO_QUOTA)]:
+		return storepb.UserSetting_MEMO_QUOTA, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]:
+		return storepb.UserSetting_NOTIFICATION, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1209,8 +1479,18 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_ACCESS_TOKENS)]
 	case storepb.UserSetting_SHORTCUTS:
 		return "SHORTCUTS" // Not defined in API proto
+	case storepb.UserSetting_MEMO_TEMPLATES:
+		return "MEMO_TEMPLATES" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_AUTO_ARCHIVE:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_AUTO_ARCHIVE)]
+	case storepb.UserSetting_STORAGE_QUOTA:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_STORAGE)]
+	case storepb.UserSetting_MEMO_QUOTA:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_MEMO_QUOTA)]
+	case storepb.UserSetting_NOTIFICATION:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]
 	default:
 		return "unknown"
 	}
@@ -1244,6 +1524,16 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_AUTO_ARCHIVE:
+			setting.Value = &v1pb.UserSetting_AutoArchiveSetting_{
+				AutoArchiveSetting: &v1pb.UserSetting_AutoArchiveSetting{
+					ProtectedTags: []string{},
+				},
+			}
+		case storepb.UserSetting_NOTIFICATION:
+			setting.Value = &v1pb.UserSetting_NotificationSetting_{
+				NotificationSetting: &v1pb.UserSetting_NotificationSetting{},
+			}
 		default:
 			// Default to general setting
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1263,9 +1553,11 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		if general := storeSetting.GetGeneral(); general != nil {
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
 				GeneralSetting: &v1pb.UserSetting_GeneralSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
+					Locale:             general.Locale,
+					MemoVisibility:     general.MemoVisibility,
+					Theme:              general.Theme,
+					Timezone:           general.Timezone,
+					StripImageMetadata: general.StripImageMetadata,
 				},
 			}
 		} else {
@@ -1302,9 +1594,12 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		apiTokens := make([]*v1pb.UserAccessToken, 0, len(accessTokens.AccessTokens))
 		for _, token := range accessTokens.AccessTokens {
 			apiToken := &v1pb.UserAccessToken{
-				Name:        fmt.Sprintf("users/%d/accessTokens/%s", userID, token.AccessToken),
-				AccessToken: token.AccessToken,
-				Description: token.Description,
+				Name:         fmt.Sprintf("users/%d/accessTokens/%s", userID, token.AccessToken),
+				AccessToken:  token.AccessToken,
+				Description:  token.Description,
+				Scopes:       token.Scopes,
+				LastUsedTime: token.LastUsedTime,
+				TokenPrefix:  truncateAccessToken(token.AccessToken),
 			}
 			apiTokens = append(apiTokens, apiToken)
 		}
@@ -1317,18 +1612,25 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		webhooks := storeSetting.GetWebhooks()
 		apiWebhooks := make([]*v1pb.UserWebhook, 0, len(webhooks.Webhooks))
 		for _, webhook := range webhooks.Webhooks {
-			apiWebhook := &v1pb.UserWebhook{
-				Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-				Url:         webhook.Url,
-				DisplayName: webhook.Title,
-			}
-			apiWebhooks = append(apiWebhooks, apiWebhook)
+			apiWebhooks = append(apiWebhooks, convertUserWebhookFromUserSetting(webhook, userID))
 		}
 		setting.Value = &v1pb.UserSetting_WebhooksSetting_{
 			WebhooksSetting: &v1pb.UserSetting_WebhooksSetting{
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_AUTO_ARCHIVE:
+		autoArchive := storeSetting.GetAutoArchive()
+		setting.Value = &v1pb.UserSetting_AutoArchiveSetting_{
+			AutoArchiveSetting: &v1pb.UserSetting_AutoArchiveSetting{
+				Days:          autoArchive.GetDays(),
+				ProtectedTags: append([]string{}, autoArchive.GetProtectedTags()...),
+			},
+		}
+	case storepb.UserSetting_NOTIFICATION:
+		setting.Value = &v1pb.UserSetting_NotificationSetting_{
+			NotificationSetting: convertNotificationSettingFromStore(storeSetting.GetNotification()),
+		}
 	default:
 		// Default to general setting if unknown key
 		setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1351,9 +1653,11 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		if general := apiSetting.GetGeneralSetting(); general != nil {
 			storeSetting.Value = &storepb.UserSetting_General{
 				General: &storepb.GeneralUserSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
+					Locale:             general.Locale,
+					MemoVisibility:     general.MemoVisibility,
+					Theme:              general.Theme,
+					Timezone:           general.Timezone,
+					StripImageMetadata: general.StripImageMetadata,
 				},
 			}
 		} else {
@@ -1408,9 +1712,10 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 			storeWebhooks := make([]*storepb.WebhooksUserSetting_Webhook, 0, len(webhooks.Webhooks))
 			for _, webhook := range webhooks.Webhooks {
 				storeWebhook := &storepb.WebhooksUserSetting_Webhook{
-					Id:    extractWebhookIDFromName(webhook.Name),
-					Title: webhook.DisplayName,
-					Url:   webhook.Url,
+					Id:       extractWebhookIDFromName(webhook.Name),
+					Title:    webhook.DisplayName,
+					Url:      webhook.Url,
+					Disabled: webhook.Disabled,
 				}
 				storeWebhooks = append(storeWebhooks, storeWebhook)
 			}
@@ -1422,6 +1727,30 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_AUTO_ARCHIVE:
+		if autoArchive := apiSetting.GetAutoArchiveSetting(); autoArchive != nil {
+			storeSetting.Value = &storepb.UserSetting_AutoArchive{
+				AutoArchive: &storepb.AutoArchiveUserSetting{
+					Days:          autoArchive.Days,
+					ProtectedTags: autoArchive.ProtectedTags,
+				},
+			}
+		} else {
+			return nil, errors.Errorf("auto-archive setting is required")
+		}
+	case storepb.UserSetting_NOTIFICATION:
+		if notification := apiSetting.GetNotificationSetting(); notification != nil {
+			storeSetting.Value = &storepb.UserSetting_Notification{
+				Notification: &storepb.NotificationUserSetting{
+					EmailMemoComment:  notification.EmailMemoComment,
+					EmailMemoReaction: notification.EmailMemoReaction,
+					EmailMemoMention:  notification.EmailMemoMention,
+					EmailMemoReminder: notification.EmailMemoReminder,
+				},
+			}
+		} else {
+			return nil, errors.Errorf("notification setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -1551,11 +1880,13 @@ func (s *APIV1Service) ListUserNotifications(ctx context.Context, request *v1pb.
 	}
 
 	// Fetch inbox items from storage
-	// Filter at database level to only include MEMO_COMMENT notifications (ignore legacy VERSION_UPDATE entries)
-	memoCommentType := storepb.InboxMessage_MEMO_COMMENT
+	// Filter at database level to only include the known notification types (ignore legacy VERSION_UPDATE entries)
 	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
-		ReceiverID:  &userID,
-		MessageType: &memoCommentType,
+		ReceiverID: &userID,
+		MessageTypeList: []storepb.InboxMessage_Type{
+			storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_MEMO_REMINDER, storepb.InboxMessage_MEMO_AUTO_ARCHIVE, storepb.InboxMessage_WEBHOOK_DISABLED,
+			storepb.InboxMessage_BACKUP_FAILED, storepb.InboxMessage_MEMO_REACTION, storepb.InboxMessage_MEMO_MENTION, storepb.InboxMessage_DIGEST,
+		},
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
@@ -1706,6 +2037,20 @@ func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *st
 		switch inbox.Message.Type {
 		case storepb.InboxMessage_MEMO_COMMENT:
 			notification.Type = v1pb.UserNotification_MEMO_COMMENT
+		case storepb.InboxMessage_MEMO_REMINDER:
+			notification.Type = v1pb.UserNotification_MEMO_REMINDER
+		case storepb.InboxMessage_MEMO_AUTO_ARCHIVE:
+			notification.Type = v1pb.UserNotification_MEMO_AUTO_ARCHIVE
+		case storepb.InboxMessage_WEBHOOK_DISABLED:
+			notification.Type = v1pb.UserNotification_WEBHOOK_DISABLED
+		case storepb.InboxMessage_BACKUP_FAILED:
+			notification.Type = v1pb.UserNotification_BACKUP_FAILED
+		case storepb.InboxMessage_MEMO_REACTION:
+			notification.Type = v1pb.UserNotification_MEMO_REACTION
+		case storepb.InboxMessage_MEMO_MENTION:
+			notification.Type = v1pb.UserNotification_MEMO_MENTION
+		case storepb.InboxMessage_DIGEST:
+			notification.Type = v1pb.UserNotification_DIGEST
 		default:
 			notification.Type = v1pb.UserNotification_TYPE_UNSPECIFIED
 		}
@@ -0,0 +1,358 @@
+package v1
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"embed"
+	htmltemplate "html/template"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultDigestTime is the time of day the digests are delivered if the user didn't set one.
+	defaultDigestTime = "08:00"
+
+	// maxActivityDigestMemos is the maximum number of memos listed in each section of a digest.
+	maxActivityDigestMemos = 50
+
+	// activityDigestEmailLabel is the words of the unsubscribe link of the digest emails.
+	activityDigestEmailLabel = "activity digests"
+)
+
+//go:embed templates/activity_digest.txt templates/activity_digest.html
+var activityDigestTemplateFS embed.FS
+
+var (
+	activityDigestTextTemplate = texttemplate.Must(texttemplate.ParseFS(activityDigestTemplateFS, "templates/activity_digest.txt"))
+	activityDigestHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(activityDigestTemplateFS, "templates/activity_digest.html"))
+)
+
+// activityDigest is what the activity digest email templates are rendered from.
+type activityDigest struct {
+	Username       string
+	InstanceTitle  string
+	Frequency      string
+	Memos          []*notificationItem
+	CommentCount   int32
+	ReactionCount  int32
+	Reminders      []*notificationItem
+	Resurfaced     *notificationItem
+	InboxURL       string
+	SettingsURL    string
+	UnsubscribeURL string
+}
+
+// activityDigestPeriod returns the period summarized by the last digest scheduled at or before
+// now, which ends at the scheduled time: the day or the week before it. The scheduled times are
+// wall clock times in the location of now.
+func activityDigestPeriod(frequency storepb.NotificationUserSetting_DigestFrequency, minuteOfDay int, weekday time.Weekday, now time.Time) (time.Time, time.Time) {
+	end := time.Date(now.Year(), now.Month(), now.Day(), minuteOfDay/60, minuteOfDay%60, 0, 0, now.Location())
+	days := 1
+	if frequency == storepb.NotificationUserSetting_WEEKLY {
+		days = 7
+		end = end.AddDate(0, 0, -((int(end.Weekday()) - int(weekday) + 7) % 7))
+	}
+	if end.After(now) {
+		end = end.AddDate(0, 0, -days)
+	}
+	return end.AddDate(0, 0, -days), end
+}
+
+// DeliverActivityDigest delivers the digest of a user if one is scheduled since the last one: it
+// summarizes the memos the user created in the past period, the comments and reactions the memos
+// of the user received, the reminders due in the next period and a random older memo. The digest
+// goes to the inbox of the user, and to the email of the user if the user turned it on.
+//
+// The digest is recorded as delivered first, so that it is never delivered twice even if the
+// server stops in between. A digest missed while the server was down is delivered late, but only
+// the last one. A period without memos, comments, reactions or reminders gives no digest.
+func (s *APIV1Service) DeliverActivityDigest(ctx context.Context, userID int32) error {
+	setting, err := s.getNotificationUserSetting(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	if setting.DigestFrequency == storepb.NotificationUserSetting_DIGEST_FREQUENCY_UNSPECIFIED {
+		return nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+		return nil
+	}
+	minuteOfDay, err := parseTimeOfDay(cmp.Or(setting.DigestTime, defaultDigestTime))
+	if err != nil {
+		return err
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user general setting")
+	}
+	location := getUserGeneralSettingLocation(generalSetting.GetGeneral())
+	start, end := activityDigestPeriod(setting.DigestFrequency, minuteOfDay, time.Weekday(setting.DigestWeekday), time.Now().In(location))
+	if setting.LastDigestTs >= end.Unix() {
+		return nil
+	}
+
+	payload, err := s.buildActivityDigestPayload(ctx, userID, start, end, end.Add(end.Sub(start)))
+	if err != nil {
+		return err
+	}
+	if err := s.setLastActivityDigestTs(ctx, userID, end.Unix()); err != nil {
+		return err
+	}
+	if payload == nil {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: userID,
+		Type:      store.ActivityTypeDigest,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{Digest: payload},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	_, err = s.createNotificationInbox(ctx, &store.Inbox{
+		SenderID:   userID,
+		ReceiverID: userID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_DIGEST,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+
+	if !setting.EmailDigest || s.Profile.InstanceURL == "" || user.Email == "" {
+		return nil
+	}
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance email setting")
+	}
+	if !email.IsConfigured(emailSetting) {
+		return nil
+	}
+	message, err := s.buildActivityDigestEmail(ctx, user, setting.DigestFrequency, payload, location)
+	if err != nil {
+		return err
+	}
+	if err := s.EmailSender.Send(ctx, emailSetting, message); err != nil {
+		return errors.Wrap(err, "failed to send activity digest email")
+	}
+	return nil
+}
+
+// buildActivityDigestPayload summarizes the activity of a user from start to end, with the
+// reminders due before reminderDueBefore, or returns nil if there's nothing to summarize.
+func (s *APIV1Service) buildActivityDigestPayload(ctx context.Context, userID int32, start, end time.Time, reminderDueBefore time.Time) (*storepb.ActivityDigestPayload, error) {
+	payload := &storepb.ActivityDigestPayload{
+		StartTs:         start.Unix(),
+		EndTs:           end.Unix(),
+		MemoIds:         []int32{},
+		ReminderMemoIds: []int32{},
+	}
+	rowStatus := store.Normal
+	limit := maxActivityDigestMemos
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &rowStatus,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		CreatedTsRanges: []*store.MemoTsRange{{StartTs: payload.StartTs, EndTs: payload.EndTs}},
+		OrderByTimeAsc:  true,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	for _, memo := range memos {
+		payload.MemoIds = append(payload.MemoIds, memo.ID)
+	}
+
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:      &userID,
+		MessageTypeList: []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_MEMO_REACTION},
+		CreatedTsAfter:  &payload.StartTs,
+		CreatedTsBefore: &payload.EndTs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list inboxes")
+	}
+	for _, inbox := range inboxes {
+		if inbox.Message.GetType() == storepb.InboxMessage_MEMO_COMMENT {
+			payload.CommentCount++
+		} else {
+			payload.ReactionCount++
+		}
+	}
+
+	reminderDueBeforeSec := reminderDueBefore.Unix()
+	reminderMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:         &userID,
+		RowStatus:         &rowStatus,
+		ExcludeContent:    true,
+		ReminderDueBefore: &reminderDueBeforeSec,
+		Limit:             &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos with due reminders")
+	}
+	for _, memo := range reminderMemos {
+		payload.ReminderMemoIds = append(payload.ReminderMemoIds, memo.ID)
+	}
+
+	if len(payload.MemoIds) == 0 && payload.CommentCount == 0 && payload.ReactionCount == 0 && len(payload.ReminderMemoIds) == 0 {
+		return nil, nil
+	}
+
+	createdTsBeforeSec := end.Add(-randomMemoHighlightMinAge).Unix()
+	limit = 1
+	sample, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &rowStatus,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		CreatedTsBefore: &createdTsBeforeSec,
+		OrderByRandom:   true,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sample memos")
+	}
+	if len(sample) > 0 {
+		payload.ResurfacedMemoId = sample[0].ID
+	}
+	return payload, nil
+}
+
+// setLastActivityDigestTs records the scheduled time of the last digest of a user. Only that
+// time is written, so that the preferences changed meanwhile are kept.
+func (s *APIV1Service) setLastActivityDigestTs(ctx context.Context, userID int32, digestTs int64) error {
+	setting, err := s.getNotificationUserSetting(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	setting.LastDigestTs = digestTs
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value:  &storepb.UserSetting_Notification{Notification: setting},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to update notification setting")
+	}
+	return nil
+}
+
+// buildActivityDigestEmail renders the email of an activity digest.
+func (s *APIV1Service) buildActivityDigestEmail(ctx context.Context, user *store.User, frequency storepb.NotificationUserSetting_DigestFrequency, payload *storepb.ActivityDigestPayload, location *time.Location) (*email.Message, error) {
+	instanceURL := strings.TrimSuffix(s.Profile.InstanceURL, "/")
+	digest := &activityDigest{
+		Username:      user.Username,
+		InstanceTitle: s.getInstanceTitle(ctx),
+		Frequency:     "daily",
+		CommentCount:  payload.CommentCount,
+		ReactionCount: payload.ReactionCount,
+		InboxURL:      instanceURL + "/inbox",
+		SettingsURL:   instanceURL + "/setting",
+	}
+	if frequency == storepb.NotificationUserSetting_WEEKLY {
+		digest.Frequency = "weekly"
+	}
+
+	var err error
+	digest.Memos, err = s.buildActivityDigestItems(ctx, instanceURL, payload.MemoIds, func(memo *store.Memo) string {
+		return time.Unix(memo.CreatedTs, 0).In(location).Format("Mon Jan 2, 15:04")
+	})
+	if err != nil {
+		return nil, err
+	}
+	digest.Reminders, err = s.buildActivityDigestItems(ctx, instanceURL, payload.ReminderMemoIds, func(memo *store.Memo) string {
+		return "Due " + time.Unix(memo.ReminderTs, 0).In(location).Format("Mon Jan 2, 15:04")
+	})
+	if err != nil {
+		return nil, err
+	}
+	if payload.ResurfacedMemoId != 0 {
+		items, err := s.buildActivityDigestItems(ctx, instanceURL, []int32{payload.ResurfacedMemoId}, func(memo *store.Memo) string {
+			return "Created " + time.Unix(memo.CreatedTs, 0).In(location).Format("January 2, 2006")
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(items) > 0 {
+			digest.Resurfaced = items[0]
+		}
+	}
+
+	token, err := auth.NewAuthenticator(s.Store, s.Secret).GenerateUnsubscribeToken(ctx, user.ID, []string{storepb.InboxMessage_DIGEST.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate unsubscribe token")
+	}
+	digest.UnsubscribeURL = instanceURL + "/email/unsubscribe?token=" + url.QueryEscape(token)
+
+	var textBody, htmlBody bytes.Buffer
+	if err := activityDigestTextTemplate.Execute(&textBody, digest); err != nil {
+		return nil, errors.Wrap(err, "failed to render activity digest email")
+	}
+	if err := activityDigestHTMLTemplate.Execute(&htmlBody, digest); err != nil {
+		return nil, errors.Wrap(err, "failed to render activity digest email")
+	}
+	return &email.Message{
+		To:             user.Email,
+		Subject:        "Your " + digest.Frequency + " digest on " + digest.InstanceTitle,
+		Body:           textBody.String(),
+		HTMLBody:       htmlBody.String(),
+		UnsubscribeURL: digest.UnsubscribeURL,
+	}, nil
+}
+
+// buildActivityDigestItems describes the memos with the given IDs that still exist, in the order
+// of the IDs, titled by title.
+func (s *APIV1Service) buildActivityDigestItems(ctx context.Context, instanceURL string, memoIDs []int32, title func(memo *store.Memo) string) ([]*notificationItem, error) {
+	items := []*notificationItem{}
+	if len(memoIDs) == 0 {
+		return items, nil
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	memoMap := make(map[int32]*store.Memo, len(memos))
+	for _, memo := range memos {
+		memoMap[memo.ID] = memo
+	}
+	for _, memoID := range memoIDs {
+		memo, ok := memoMap[memoID]
+		if !ok {
+			continue
+		}
+		snippet, err := s.MarkdownService.GenerateSnippet([]byte(memo.Content), notificationSnippetLength)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate snippet")
+		}
+		items = append(items, &notificationItem{
+			Title:   title(memo),
+			Snippet: snippet,
+			URL:     instanceURL + "/memos/" + url.PathEscape(memo.UID),
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestActivityDigestPeriod(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// Friday, March 8, 2024. DST starts on Sunday, March 10.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 3, day, hour, minute, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name        string
+		frequency   storepb.NotificationUserSetting_DigestFrequency
+		weekday     time.Weekday
+		now         time.Time
+		start, end  time.Time
+		minuteOfDay int
+	}{
+		{name: "daily, after the time of day", frequency: storepb.NotificationUserSetting_DAILY, minuteOfDay: 8 * 60, now: at(8, 9, 0), start: at(7, 8, 0), end: at(8, 8, 0)},
+		{name: "daily, at the time of day", frequency: storepb.NotificationUserSetting_DAILY, minuteOfDay: 8 * 60, now: at(8, 8, 0), start: at(7, 8, 0), end: at(8, 8, 0)},
+		{name: "daily, before the time of day", frequency: storepb.NotificationUserSetting_DAILY, minuteOfDay: 8 * 60, now: at(8, 7, 59), start: at(6, 8, 0), end: at(7, 8, 0)},
+		{name: "daily, across a DST change", frequency: storepb.NotificationUserSetting_DAILY, minuteOfDay: 8 * 60, now: at(10, 9, 0), start: at(9, 8, 0), end: at(10, 8, 0)},
+		{name: "weekly, on the day", frequency: storepb.NotificationUserSetting_WEEKLY, weekday: time.Friday, minuteOfDay: 8 * 60, now: at(8, 9, 0), start: at(1, 8, 0), end: at(8, 8, 0)},
+		{name: "weekly, on the day before the time", frequency: storepb.NotificationUserSetting_WEEKLY, weekday: time.Friday, minuteOfDay: 8 * 60, now: at(8, 7, 0), start: time.Date(2024, 2, 23, 8, 0, 0, 0, newYork), end: at(1, 8, 0)},
+		{name: "weekly, after the day", frequency: storepb.NotificationUserSetting_WEEKLY, weekday: time.Monday, minuteOfDay: 18 * 60, now: at(8, 9, 0), start: time.Date(2024, 2, 26, 18, 0, 0, 0, newYork), end: at(4, 18, 0)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start, end := activityDigestPeriod(test.frequency, test.minuteOfDay, test.weekday, test.now)
+			require.Equal(t, test.start, start)
+			require.Equal(t, test.end, end)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+)
+
+// GetUserFeedToken returns whether a user has a feed token.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can get their own; admins can get any user's.
+func (s *APIV1Service) GetUserFeedToken(ctx context.Context, request *v1pb.GetUserFeedTokenRequest) (*v1pb.UserFeedToken, error) {
+	userID, err := s.checkUserFeedTokenPermission(ctx, request.Name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	feedToken, err := s.Store.GetUserFeedToken(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get feed token setting: %v", err)
+	}
+	return convertUserFeedTokenFromStore(userID, feedToken), nil
+}
+
+// RotateUserFeedToken generates a new feed token, replacing the current one, whose feed URLs
+// stop working.
+//
+// The token is stored hashed and only returned in the response.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only rotate their own, as the token reads their private memos.
+func (s *APIV1Service) RotateUserFeedToken(ctx context.Context, request *v1pb.RotateUserFeedTokenRequest) (*v1pb.UserFeedToken, error) {
+	userID, err := s.checkUserFeedTokenPermission(ctx, request.Name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	token, tokenHash, err := auth.GenerateFeedToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate feed token: %v", err)
+	}
+	feedToken := &storepb.FeedTokenUserSetting{
+		TokenHash:  tokenHash,
+		CreateTime: timestamppb.Now(),
+	}
+	if err := s.Store.UpsertUserFeedToken(ctx, userID, feedToken); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update feed token setting: %v", err)
+	}
+
+	userFeedToken := convertUserFeedTokenFromStore(userID, feedToken)
+	userFeedToken.Token = token
+	return userFeedToken, nil
+}
+
+// DeleteUserFeedToken revokes the feed token of a user. Feed URLs with the token are rejected.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can revoke their own; admins can revoke any user's.
+func (s *APIV1Service) DeleteUserFeedToken(ctx context.Context, request *v1pb.DeleteUserFeedTokenRequest) (*emptypb.Empty, error) {
+	userID, err := s.checkUserFeedTokenPermission(ctx, request.Name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.UpsertUserFeedToken(ctx, userID, &storepb.FeedTokenUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update feed token setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkUserFeedTokenPermission returns the user ID of a feed token resource name if the current
+// user owns it, or is an admin and allowSuperUser is set.
+func (s *APIV1Service) checkUserFeedTokenPermission(ctx context.Context, name string, allowSuperUser bool) (int32, error) {
+	userID, err := ExtractUserIDFromFeedTokenName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid feed token name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && (!allowSuperUser || !isSuperUser(currentUser)) {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func convertUserFeedTokenFromStore(userID int32, feedToken *storepb.FeedTokenUserSetting) *v1pb.UserFeedToken {
+	userFeedToken := &v1pb.UserFeedToken{
+		Name:    fmt.Sprintf("users/%d/feedToken", userID),
+		Enabled: feedToken.TokenHash != "",
+	}
+	if userFeedToken.Enabled {
+		userFeedToken.CreateTime = feedToken.CreateTime
+	}
+	return userFeedToken
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// inboundEmailTokenPrefix marks the tokens of inbound email addresses, so that they're
+// recognizable when leaked.
+const inboundEmailTokenPrefix = "memos-"
+
+// GetUserInboundEmail gets the inbound email address of a user.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only get their own, as the address creates memos for them.
+func (s *APIV1Service) GetUserInboundEmail(ctx context.Context, request *v1pb.GetUserInboundEmailRequest) (*v1pb.UserInboundEmail, error) {
+	userID, err := s.checkUserInboundEmailPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	inboundEmail, err := s.Store.GetUserInboundEmail(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get inbound email setting: %v", err)
+	}
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance email setting: %v", err)
+	}
+	return convertUserInboundEmailFromStore(userID, inboundEmail, emailSetting.InboundDomain), nil
+}
+
+// RotateUserInboundEmail generates a new inbound email address, replacing the current one, whose
+// emails are dropped.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only rotate their own.
+func (s *APIV1Service) RotateUserInboundEmail(ctx context.Context, request *v1pb.RotateUserInboundEmailRequest) (*v1pb.UserInboundEmail, error) {
+	userID, err := s.checkUserInboundEmailPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance email setting: %v", err)
+	}
+	if emailSetting.InboundDomain == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "inbound email is disabled")
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate inbound email token: %v", err)
+	}
+	inboundEmail := &storepb.InboundEmailUserSetting{
+		// Tokens are lowercase, as some mail servers lowercase the addresses.
+		Token:      inboundEmailTokenPrefix + hex.EncodeToString(raw),
+		CreateTime: timestamppb.Now(),
+	}
+	if err := s.Store.UpsertUserInboundEmail(ctx, userID, inboundEmail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update inbound email setting: %v", err)
+	}
+	return convertUserInboundEmailFromStore(userID, inboundEmail, emailSetting.InboundDomain), nil
+}
+
+// DeleteUserInboundEmail removes the inbound email address of a user. Its emails are dropped.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only delete their own.
+func (s *APIV1Service) DeleteUserInboundEmail(ctx context.Context, request *v1pb.DeleteUserInboundEmailRequest) (*emptypb.Empty, error) {
+	userID, err := s.checkUserInboundEmailPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.UpsertUserInboundEmail(ctx, userID, &storepb.InboundEmailUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update inbound email setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkUserInboundEmailPermission returns the user ID of an inbound email resource name if the
+// current user owns it.
+func (s *APIV1Service) checkUserInboundEmailPermission(ctx context.Context, name string) (int32, error) {
+	userID, err := ExtractUserIDFromInboundEmailName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid inbound email name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func convertUserInboundEmailFromStore(userID int32, inboundEmail *storepb.InboundEmailUserSetting, inboundDomain string) *v1pb.UserInboundEmail {
+	userInboundEmail := &v1pb.UserInboundEmail{
+		Name:      fmt.Sprintf("users/%d/inboundEmail", userID),
+		Available: inboundDomain != "",
+	}
+	if userInboundEmail.Available && inboundEmail.Token != "" {
+		userInboundEmail.Address = inboundEmail.Token + "@" + inboundDomain
+		userInboundEmail.CreateTime = inboundEmail.CreateTime
+	}
+	return userInboundEmail
+}
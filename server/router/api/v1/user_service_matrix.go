@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+)
+
+// GetUserMatrixLink gets the Matrix account linked to a user.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only get their own.
+func (s *APIV1Service) GetUserMatrixLink(ctx context.Context, request *v1pb.GetUserMatrixLinkRequest) (*v1pb.UserMatrixLink, error) {
+	userID, err := s.checkUserMatrixLinkPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix, err := s.Store.GetUserMatrix(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get Matrix setting: %v", err)
+	}
+	matrixSetting, err := s.Store.GetInstanceMatrixSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance Matrix setting: %v", err)
+	}
+	return convertUserMatrixLinkFromStore(userID, matrix, matrixSetting.HomeserverUrl != "" && matrixSetting.AccessToken != ""), nil
+}
+
+// GenerateUserMatrixLinkCode generates a one-time code linking a Matrix account to a user. The
+// code is sent to the bot with "!memos link <code>", and links the account it's sent from,
+// replacing the linked account, if any.
+//
+// The code is stored hashed and only returned in the response.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only generate their own, as the linked account creates their memos.
+func (s *APIV1Service) GenerateUserMatrixLinkCode(ctx context.Context, request *v1pb.GenerateUserMatrixLinkCodeRequest) (*v1pb.UserMatrixLink, error) {
+	userID, err := s.checkUserMatrixLinkPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	matrixSetting, err := s.Store.GetInstanceMatrixSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance Matrix setting: %v", err)
+	}
+	if matrixSetting.HomeserverUrl == "" || matrixSetting.AccessToken == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Matrix bot is disabled")
+	}
+
+	matrix, err := s.Store.GetUserMatrix(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get Matrix setting: %v", err)
+	}
+	code, codeHash, err := auth.GenerateLinkCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate Matrix link code: %v", err)
+	}
+	matrix.LinkCodeHash = codeHash
+	matrix.LinkCodeExpireTime = timestamppb.New(time.Now().Add(auth.LinkCodeDuration))
+	if err := s.Store.UpsertUserMatrix(ctx, userID, matrix); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update Matrix setting: %v", err)
+	}
+
+	userMatrixLink := convertUserMatrixLinkFromStore(userID, matrix, true)
+	userMatrixLink.LinkCode = code
+	return userMatrixLink, nil
+}
+
+// DeleteUserMatrixLink unlinks the Matrix account of a user, and revokes the pending link code
+// and the room subscriptions.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only delete their own.
+func (s *APIV1Service) DeleteUserMatrixLink(ctx context.Context, request *v1pb.DeleteUserMatrixLinkRequest) (*emptypb.Empty, error) {
+	userID, err := s.checkUserMatrixLinkPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.UpsertUserMatrix(ctx, userID, &storepb.MatrixUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update Matrix setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkUserMatrixLinkPermission returns the user ID of a Matrix link resource name if the current
+// user owns it.
+func (s *APIV1Service) checkUserMatrixLinkPermission(ctx context.Context, name string) (int32, error) {
+	userID, err := ExtractUserIDFromMatrixLinkName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid Matrix link name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func convertUserMatrixLinkFromStore(userID int32, matrix *storepb.MatrixUserSetting, available bool) *v1pb.UserMatrixLink {
+	userMatrixLink := &v1pb.UserMatrixLink{
+		Name:         fmt.Sprintf("users/%d/matrixLink", userID),
+		Available:    available,
+		MatrixUserId: matrix.MatrixUserId,
+		LinkTime:     matrix.LinkTime,
+	}
+	if matrix.LinkCodeHash != "" && matrix.LinkCodeExpireTime.AsTime().After(time.Now()) {
+		userMatrixLink.LinkCodeExpireTime = matrix.LinkCodeExpireTime
+	}
+	return userMatrixLink
+}
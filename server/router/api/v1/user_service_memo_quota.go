@@ -0,0 +1,238 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// checkMemoQuota rejects the creation of a memo with the visibility by a user that would exceed
+// one of the memo quotas of the user. The host is exempt.
+func (s *APIV1Service) checkMemoQuota(ctx context.Context, user *store.User, visibility store.Visibility) error {
+	return CheckMemoQuota(ctx, s.Store, user, visibility)
+}
+
+// checkPublicMemoQuota rejects making a memo of a user public when the user has already made as
+// many memos public today as the quota allows. The host is exempt.
+func (s *APIV1Service) checkPublicMemoQuota(ctx context.Context, user *store.User) error {
+	return checkUserMemoQuota(ctx, s.Store, user, store.Public, false)
+}
+
+// CheckMemoQuota rejects the creation of a memo with the visibility by a user that would exceed
+// one of the memo quotas of the user. The host is exempt.
+func CheckMemoQuota(ctx context.Context, stores *store.Store, user *store.User, visibility store.Visibility) error {
+	return checkUserMemoQuota(ctx, stores, user, visibility, true)
+}
+
+// RecordMemoQuotaUsage counts a memo created by a user with the visibility against the quotas
+// per day of the user.
+func RecordMemoQuotaUsage(ctx context.Context, stores *store.Store, userID int32, visibility store.Visibility) {
+	addMemoQuotaUsage(ctx, stores, userID, 1, visibility == store.Public)
+}
+
+// recordPublicMemoQuotaUsage counts a memo made public against the public memos per day of its
+// creator.
+func (s *APIV1Service) recordPublicMemoQuotaUsage(ctx context.Context, creatorID int32) {
+	addMemoQuotaUsage(ctx, s.Store, creatorID, 0, true)
+}
+
+func addMemoQuotaUsage(ctx context.Context, stores *store.Store, userID int32, memoCount int32, public bool) {
+	dayStartSec, err := getMemoQuotaDayStart(ctx, stores, userID, time.Now())
+	if err == nil {
+		usage := &store.MemoQuotaUsage{UserID: userID, DayStartTs: dayStartSec, MemoCount: memoCount}
+		if public {
+			usage.PublicMemoCount = 1
+		}
+		err = stores.AddMemoQuotaUsage(ctx, usage)
+	}
+	if err != nil {
+		slog.Warn("failed to update memo quota usage", slog.Int("user", int(userID)), slog.String("error", err.Error()))
+	}
+}
+
+// GetImportedMemoVisibility returns the visibility of a memo imported by a user. A user with a
+// quota of public memos per day, e.g. a new account, imports public memos as private, so that an
+// archive can't publish more memos than the quota allows. The host is exempt.
+func GetImportedMemoVisibility(ctx context.Context, stores *store.Store, user *store.User, visibility store.Visibility) (store.Visibility, error) {
+	if visibility != store.Public || user.Role == store.RoleHost {
+		return visibility, nil
+	}
+	quota, err := stores.GetUserMemoQuota(ctx, user, time.Now().Unix())
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get memo quota: %v", err)
+	}
+	if quota.MaxPublicMemosPerDay > 0 {
+		return store.Private, nil
+	}
+	return visibility, nil
+}
+
+// checkUserMemoQuota checks the quota of public memos per day for the visibility, and the
+// quotas of memos and memos per day when creating a memo. The memos per day are those counted
+// by the memo quota usage as they were created and made public, whatever their creation time
+// and visibility are now.
+func checkUserMemoQuota(ctx context.Context, stores *store.Store, user *store.User, visibility store.Visibility, creating bool) error {
+	if user.Role == store.RoleHost {
+		return nil
+	}
+	now := time.Now()
+	quota, err := stores.GetUserMemoQuota(ctx, user, now.Unix())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo quota: %v", err)
+	}
+	if !creating {
+		quota.MaxMemos, quota.MaxMemosPerDay = 0, 0
+	}
+	if visibility != store.Public {
+		quota.MaxPublicMemosPerDay = 0
+	}
+	if quota.MaxMemos == 0 && quota.MaxMemosPerDay == 0 && quota.MaxPublicMemosPerDay == 0 {
+		return nil
+	}
+
+	if quota.MaxMemos > 0 {
+		count, err := stores.CountMemos(ctx, &store.FindMemoCount{CreatorID: user.ID, ExcludeTrashed: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to count memos: %v", err)
+		}
+		if count >= int(quota.MaxMemos) {
+			return status.Errorf(codes.ResourceExhausted, "memo quota exceeded: a user can have at most %d memos", quota.MaxMemos)
+		}
+	}
+	if quota.MaxMemosPerDay == 0 && quota.MaxPublicMemosPerDay == 0 {
+		return nil
+	}
+	usage, err := getMemoQuotaUsage(ctx, stores, user.ID, now)
+	if err != nil {
+		return err
+	}
+	if quota.MaxMemosPerDay > 0 && usage.MemoCount >= quota.MaxMemosPerDay {
+		return status.Errorf(codes.ResourceExhausted, "memo quota exceeded: a user can create at most %d memos per day", quota.MaxMemosPerDay)
+	}
+	if quota.MaxPublicMemosPerDay > 0 && usage.PublicMemoCount >= quota.MaxPublicMemosPerDay {
+		return status.Errorf(codes.ResourceExhausted, "memo quota exceeded: this account can create at most %d public memos per day", quota.MaxPublicMemosPerDay)
+	}
+	return nil
+}
+
+// getMemoQuotaUsage returns the memos a user created and made public on the day of now.
+func getMemoQuotaUsage(ctx context.Context, stores *store.Store, userID int32, now time.Time) (*store.MemoQuotaUsage, error) {
+	dayStartSec, err := getMemoQuotaDayStart(ctx, stores, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := stores.GetMemoQuotaUsage(ctx, userID, dayStartSec)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo quota usage: %v", err)
+	}
+	return usage, nil
+}
+
+// getMemoQuotaDayStart returns the start of the day of now in the time zone of a user, which
+// the memos per day are counted from.
+func getMemoQuotaDayStart(ctx context.Context, stores *store.Store, userID int32, now time.Time) (int64, error) {
+	generalSetting, err := stores.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	return getDayStart(now, getUserGeneralSettingLocation(generalSetting.GetGeneral())), nil
+}
+
+// getDayStart returns the start of the day of t in the location.
+func getDayStart(t time.Time, location *time.Location) int64 {
+	year, month, day := t.In(location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, location).Unix()
+}
+
+// getMemoQuotaUserSetting returns the memo counts and quotas of a user.
+func (s *APIV1Service) getMemoQuotaUserSetting(ctx context.Context, userID int32) (*v1pb.UserSetting, error) {
+	memoCount, err := s.Store.CountMemos(ctx, &store.FindMemoCount{CreatorID: userID, ExcludeTrashed: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count memos: %v", err)
+	}
+	usage, err := getMemoQuotaUsage(ctx, s.Store, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_MEMO_QUOTA})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	memoQuotaSetting := &v1pb.UserSetting_MemoQuotaSetting{
+		MemoCount:            int32(memoCount),
+		TodayMemoCount:       usage.MemoCount,
+		TodayPublicMemoCount: usage.PublicMemoCount,
+	}
+	if quotaSetting := userSetting.GetMemoQuota(); quotaSetting != nil {
+		memoQuotaSetting.MaxMemos = quotaSetting.MaxMemos
+		memoQuotaSetting.MaxMemosPerDay = quotaSetting.MaxMemosPerDay
+		memoQuotaSetting.MaxPublicMemosPerDay = quotaSetting.MaxPublicMemosPerDay
+	}
+	return &v1pb.UserSetting{
+		Name:  fmt.Sprintf("users/%d/settings/%s", userID, convertSettingKeyFromStore(storepb.UserSetting_MEMO_QUOTA)),
+		Value: &v1pb.UserSetting_MemoQuotaSetting_{MemoQuotaSetting: memoQuotaSetting},
+	}, nil
+}
+
+// updateMemoQuotaUserSetting sets the memo quotas of a user, clearing those of the update mask
+// that aren't set. Only the host can update the memo quotas.
+func (s *APIV1Service) updateMemoQuotaUserSetting(ctx context.Context, currentUser *store.User, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	if currentUser.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host can update memo quotas")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_MEMO_QUOTA})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	setting := &storepb.MemoQuotaUserSetting{}
+	if quotaSetting := userSetting.GetMemoQuota(); quotaSetting != nil {
+		setting.MaxMemos = quotaSetting.MaxMemos
+		setting.MaxMemosPerDay = quotaSetting.MaxMemosPerDay
+		setting.MaxPublicMemosPerDay = quotaSetting.MaxPublicMemosPerDay
+	}
+
+	incoming := request.Setting.GetMemoQuotaSetting()
+	if incoming == nil {
+		incoming = &v1pb.UserSetting_MemoQuotaSetting{}
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "maxMemos":
+			setting.MaxMemos = incoming.MaxMemos
+		case "maxMemosPerDay":
+			setting.MaxMemosPerDay = incoming.MaxMemosPerDay
+		case "maxPublicMemosPerDay":
+			setting.MaxPublicMemosPerDay = incoming.MaxPublicMemosPerDay
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	if setting.GetMaxMemos() < 0 || setting.GetMaxMemosPerDay() < 0 || setting.GetMaxPublicMemosPerDay() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quota must not be negative")
+	}
+
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_MEMO_QUOTA,
+		Value:  &storepb.UserSetting_MemoQuota{MemoQuota: setting},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.getMemoQuotaUserSetting(ctx, userID)
+}
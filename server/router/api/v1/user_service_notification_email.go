@@ -0,0 +1,524 @@
+package v1
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// notificationEmailQuietPeriod is how long no notification must be received before the pending
+	// notifications of a user are sent, so that a burst of notifications gives a single email.
+	notificationEmailQuietPeriod = 2 * time.Minute
+
+	// notificationEmailMaxDelay is how long a notification waits at most for a quiet period, so
+	// that a steady stream of notifications is still sent.
+	notificationEmailMaxDelay = 15 * time.Minute
+
+	// maxNotificationDigestItems is the number of notifications listed in a digest. The others
+	// are only counted.
+	maxNotificationDigestItems = 50
+
+	// notificationSnippetLength is the length of the snippets of the memos in digests.
+	notificationSnippetLength = 140
+)
+
+//go:embed templates/notification_digest.txt templates/notification_digest.html
+var notificationEmailTemplateFS embed.FS
+
+var (
+	notificationDigestTextTemplate = texttemplate.Must(texttemplate.ParseFS(notificationEmailTemplateFS, "templates/notification_digest.txt"))
+	notificationDigestHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(notificationEmailTemplateFS, "templates/notification_digest.html"))
+)
+
+// notificationEmailTypes are the types of the notifications that can be sent by email, with the
+// words of their unsubscribe links.
+var notificationEmailTypes = []struct {
+	messageType storepb.InboxMessage_Type
+	label       string
+}{
+	{storepb.InboxMessage_MEMO_COMMENT, "comments"},
+	{storepb.InboxMessage_MEMO_REACTION, "reactions"},
+	{storepb.InboxMessage_MEMO_MENTION, "mentions"},
+	{storepb.InboxMessage_MEMO_REMINDER, "reminders"},
+}
+
+// notificationDigest is what the digest email templates are rendered from.
+type notificationDigest struct {
+	Username      string
+	InstanceTitle string
+	Items         []*notificationItem
+	// Count is the number of notifications, of which Items lists the first.
+	Count        int
+	MoreCount    int
+	InboxURL     string
+	SettingsURL  string
+	Unsubscribes []*notificationDigestUnsubscribe
+}
+
+// notificationItem describes a notification, in digest emails and push notifications.
+type notificationItem struct {
+	Title   string
+	Snippet string
+	URL     string
+}
+
+type notificationDigestUnsubscribe struct {
+	Label string
+	URL   string
+}
+
+// getNotificationEmailEnabled returns whether the notifications of a type are sent by email.
+func getNotificationEmailEnabled(setting *storepb.NotificationUserSetting, messageType storepb.InboxMessage_Type) bool {
+	switch messageType {
+	case storepb.InboxMessage_MEMO_COMMENT:
+		return setting.GetEmailMemoComment()
+	case storepb.InboxMessage_MEMO_REACTION:
+		return setting.GetEmailMemoReaction()
+	case storepb.InboxMessage_MEMO_MENTION:
+		return setting.GetEmailMemoMention()
+	case storepb.InboxMessage_MEMO_REMINDER:
+		return setting.GetEmailMemoReminder()
+	default:
+		return false
+	}
+}
+
+// setNotificationEmailEnabled turns the emails of the notifications of a type on or off.
+func setNotificationEmailEnabled(setting *storepb.NotificationUserSetting, messageType storepb.InboxMessage_Type, enabled bool) {
+	switch messageType {
+	case storepb.InboxMessage_MEMO_COMMENT:
+		setting.EmailMemoComment = enabled
+	case storepb.InboxMessage_MEMO_REACTION:
+		setting.EmailMemoReaction = enabled
+	case storepb.InboxMessage_MEMO_MENTION:
+		setting.EmailMemoMention = enabled
+	case storepb.InboxMessage_MEMO_REMINDER:
+		setting.EmailMemoReminder = enabled
+	default:
+		// The other notifications aren't sent by email.
+	}
+}
+
+// getNotificationEmailTypes returns the types of the notifications sent by email.
+func getNotificationEmailTypes(setting *storepb.NotificationUserSetting) []storepb.InboxMessage_Type {
+	messageTypes := []storepb.InboxMessage_Type{}
+	for _, notificationType := range notificationEmailTypes {
+		if getNotificationEmailEnabled(setting, notificationType.messageType) {
+			messageTypes = append(messageTypes, notificationType.messageType)
+		}
+	}
+	return messageTypes
+}
+
+func convertNotificationSettingFromStore(setting *storepb.NotificationUserSetting) *v1pb.UserSetting_NotificationSetting {
+	return &v1pb.UserSetting_NotificationSetting{
+		EmailMemoComment:  setting.GetEmailMemoComment(),
+		EmailMemoReaction: setting.GetEmailMemoReaction(),
+		EmailMemoMention:  setting.GetEmailMemoMention(),
+		EmailMemoReminder: setting.GetEmailMemoReminder(),
+		QuietHoursStart:   setting.GetQuietHoursStart(),
+		QuietHoursEnd:     setting.GetQuietHoursEnd(),
+		DigestFrequency:   v1pb.UserSetting_NotificationSetting_DigestFrequency(setting.GetDigestFrequency()),
+		DigestTime:        cmp.Or(setting.GetDigestTime(), defaultDigestTime),
+		DigestWeekday:     setting.GetDigestWeekday(),
+		EmailDigest:       setting.GetEmailDigest(),
+	}
+}
+
+func (s *APIV1Service) updateNotificationUserSetting(ctx context.Context, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	setting, err := s.getNotificationUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	previousTypes := getNotificationEmailTypes(setting)
+	previousDigestFrequency := setting.DigestFrequency
+
+	incoming := request.Setting.GetNotificationSetting()
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "emailMemoComment":
+			setting.EmailMemoComment = incoming.GetEmailMemoComment()
+		case "emailMemoReaction":
+			setting.EmailMemoReaction = incoming.GetEmailMemoReaction()
+		case "emailMemoMention":
+			setting.EmailMemoMention = incoming.GetEmailMemoMention()
+		case "emailMemoReminder":
+			setting.EmailMemoReminder = incoming.GetEmailMemoReminder()
+		case "quietHoursStart":
+			setting.QuietHoursStart = incoming.GetQuietHoursStart()
+		case "quietHoursEnd":
+			setting.QuietHoursEnd = incoming.GetQuietHoursEnd()
+		case "digestFrequency":
+			setting.DigestFrequency = storepb.NotificationUserSetting_DigestFrequency(incoming.GetDigestFrequency())
+		case "digestTime":
+			setting.DigestTime = incoming.GetDigestTime()
+		case "digestWeekday":
+			setting.DigestWeekday = incoming.GetDigestWeekday()
+		case "emailDigest":
+			setting.EmailDigest = incoming.GetEmailDigest()
+		default:
+			// Ignore unsupported fields
+		}
+	}
+	for _, timeOfDay := range []string{setting.QuietHoursStart, setting.QuietHoursEnd} {
+		if _, err := parseTimeOfDay(timeOfDay); timeOfDay != "" && err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quiet hours: %v", err)
+		}
+	}
+	if _, ok := storepb.NotificationUserSetting_DigestFrequency_name[int32(setting.DigestFrequency)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid digest frequency")
+	}
+	if _, err := parseTimeOfDay(setting.DigestTime); setting.DigestTime != "" && err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid digest time: %v", err)
+	}
+	if setting.DigestWeekday < 0 || setting.DigestWeekday > 6 {
+		return nil, status.Errorf(codes.InvalidArgument, "digest weekday must be between 0 and 6")
+	}
+	if previousDigestFrequency == storepb.NotificationUserSetting_DIGEST_FREQUENCY_UNSPECIFIED && setting.DigestFrequency != storepb.NotificationUserSetting_DIGEST_FREQUENCY_UNSPECIFIED {
+		// A digest turned on starts with the next scheduled one, rather than the one already past.
+		setting.LastDigestTs = time.Now().Unix()
+	}
+	for _, messageType := range getNotificationEmailTypes(setting) {
+		if slices.Contains(previousTypes, messageType) {
+			continue
+		}
+		// The emails of a type turned on start with the next notification, not the past ones.
+		inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &userID, Limit: &[]int{1}[0]})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
+		}
+		if len(inboxes) > 0 {
+			setting.EmailCursorInboxId = inboxes[0].ID
+		}
+		break
+	}
+
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value:  &storepb.UserSetting_Notification{Notification: setting},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// getNotificationUserSetting returns a copy of the notification setting of a user, which is
+// empty if the user never set it.
+func (s *APIV1Service) getNotificationUserSetting(ctx context.Context, userID int32) (*storepb.NotificationUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_NOTIFICATION})
+	if err != nil {
+		return nil, err
+	}
+	notification := userSetting.GetNotification()
+	return &storepb.NotificationUserSetting{
+		EmailMemoComment:   notification.GetEmailMemoComment(),
+		EmailMemoReaction:  notification.GetEmailMemoReaction(),
+		EmailMemoMention:   notification.GetEmailMemoMention(),
+		EmailMemoReminder:  notification.GetEmailMemoReminder(),
+		EmailCursorInboxId: notification.GetEmailCursorInboxId(),
+		QuietHoursStart:    notification.GetQuietHoursStart(),
+		QuietHoursEnd:      notification.GetQuietHoursEnd(),
+		DigestFrequency:    notification.GetDigestFrequency(),
+		DigestTime:         notification.GetDigestTime(),
+		DigestWeekday:      notification.GetDigestWeekday(),
+		EmailDigest:        notification.GetEmailDigest(),
+		LastDigestTs:       notification.GetLastDigestTs(),
+	}, nil
+}
+
+// DeliverNotificationEmails sends the unread notifications received by a user since the last
+// email, of the types the user turned on, in a digest email. The notifications are sent once none
+// was received for notificationEmailQuietPeriod, or once the oldest one waited for
+// notificationEmailMaxDelay, so that a burst of reactions gives one email rather than twenty.
+//
+// If sending fails, the notifications are kept for the next attempt, unless it's the last one and
+// they're skipped. Without email settings, an instance URL or an email of the user, the
+// notifications are skipped.
+func (s *APIV1Service) DeliverNotificationEmails(ctx context.Context, userID int32, lastAttempt bool) error {
+	setting, err := s.getNotificationUserSetting(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	messageTypes := getNotificationEmailTypes(setting)
+	if len(messageTypes) == 0 {
+		return nil
+	}
+	unread := store.UNREAD
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:      &userID,
+		Status:          &unread,
+		MessageTypeList: messageTypes,
+		IDAfter:         &setting.EmailCursorInboxId,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+	if len(inboxes) == 0 {
+		return nil
+	}
+	// The inboxes are listed newest first.
+	now := time.Now()
+	if now.Sub(time.Unix(inboxes[0].CreatedTs, 0)) < notificationEmailQuietPeriod && now.Sub(time.Unix(inboxes[len(inboxes)-1].CreatedTs, 0)) < notificationEmailMaxDelay {
+		return nil
+	}
+	cursor := setting.EmailCursorInboxId
+	for _, inbox := range inboxes {
+		cursor = max(cursor, inbox.ID)
+	}
+
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance email setting")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if !email.IsConfigured(emailSetting) || s.Profile.InstanceURL == "" || user == nil || user.Email == "" || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+		return s.setNotificationEmailCursor(ctx, userID, cursor)
+	}
+
+	message, err := s.buildNotificationDigestEmail(ctx, user, inboxes)
+	if err == nil && message != nil {
+		err = s.EmailSender.Send(ctx, emailSetting, message)
+	}
+	if err != nil && !lastAttempt {
+		return errors.Wrap(err, "failed to send notification email")
+	}
+	if cursorErr := s.setNotificationEmailCursor(ctx, userID, cursor); cursorErr != nil {
+		return cursorErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to send notification email, its notifications are skipped")
+	}
+	return nil
+}
+
+// setNotificationEmailCursor marks the notifications up to an inbox as sent by email. Only the
+// cursor is written, so that the preferences changed meanwhile are kept.
+func (s *APIV1Service) setNotificationEmailCursor(ctx context.Context, userID int32, cursor int32) error {
+	setting, err := s.getNotificationUserSetting(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	if setting.EmailCursorInboxId >= cursor {
+		return nil
+	}
+	setting.EmailCursorInboxId = cursor
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value:  &storepb.UserSetting_Notification{Notification: setting},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to update notification setting")
+	}
+	return nil
+}
+
+// buildNotificationDigestEmail renders the digest email of notifications, listed newest first,
+// or returns nil if none of them is about a memo that still exists.
+func (s *APIV1Service) buildNotificationDigestEmail(ctx context.Context, user *store.User, inboxes []*store.Inbox) (*email.Message, error) {
+	instanceURL := strings.TrimSuffix(s.Profile.InstanceURL, "/")
+	digest := &notificationDigest{
+		Username:      user.Username,
+		InstanceTitle: s.getInstanceTitle(ctx),
+		InboxURL:      instanceURL + "/inbox",
+		SettingsURL:   instanceURL + "/setting",
+	}
+	messageTypes := []storepb.InboxMessage_Type{}
+	// The digest lists the notifications oldest first, as they happened.
+	for i := len(inboxes) - 1; i >= 0; i-- {
+		item, err := s.buildNotificationItem(ctx, instanceURL, inboxes[i])
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			continue
+		}
+		digest.Count++
+		if len(digest.Items) < maxNotificationDigestItems {
+			digest.Items = append(digest.Items, item)
+		}
+		if !slices.Contains(messageTypes, inboxes[i].Message.GetType()) {
+			messageTypes = append(messageTypes, inboxes[i].Message.GetType())
+		}
+	}
+	if digest.Count == 0 {
+		return nil, nil
+	}
+	digest.MoreCount = digest.Count - len(digest.Items)
+
+	authenticator := auth.NewAuthenticator(s.Store, s.Secret)
+	messageTypeNames := []string{}
+	for _, notificationType := range notificationEmailTypes {
+		if !slices.Contains(messageTypes, notificationType.messageType) {
+			continue
+		}
+		token, err := authenticator.GenerateUnsubscribeToken(ctx, user.ID, []string{notificationType.messageType.String()})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate unsubscribe token")
+		}
+		digest.Unsubscribes = append(digest.Unsubscribes, &notificationDigestUnsubscribe{
+			Label: notificationType.label,
+			URL:   instanceURL + "/email/unsubscribe?token=" + url.QueryEscape(token),
+		})
+		messageTypeNames = append(messageTypeNames, notificationType.messageType.String())
+	}
+	// The List-Unsubscribe header of the email turns off all the types of its notifications.
+	token, err := authenticator.GenerateUnsubscribeToken(ctx, user.ID, messageTypeNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate unsubscribe token")
+	}
+
+	var textBody, htmlBody bytes.Buffer
+	if err := notificationDigestTextTemplate.Execute(&textBody, digest); err != nil {
+		return nil, errors.Wrap(err, "failed to render notification email")
+	}
+	if err := notificationDigestHTMLTemplate.Execute(&htmlBody, digest); err != nil {
+		return nil, errors.Wrap(err, "failed to render notification email")
+	}
+	subject := fmt.Sprintf("%d new notifications on %s", digest.Count, digest.InstanceTitle)
+	if digest.Count == 1 {
+		subject = digest.Items[0].Title
+	}
+	return &email.Message{
+		To:             user.Email,
+		Subject:        subject,
+		Body:           textBody.String(),
+		HTMLBody:       htmlBody.String(),
+		UnsubscribeURL: instanceURL + "/email/unsubscribe?token=" + url.QueryEscape(token),
+	}, nil
+}
+
+// buildNotificationItem describes a notification, or returns nil if its memo doesn't exist
+// anymore. The URL of the memo is relative if instanceURL is empty.
+func (s *APIV1Service) buildNotificationItem(ctx context.Context, instanceURL string, inbox *store.Inbox) (*notificationItem, error) {
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil || activity.Payload == nil {
+		return nil, nil
+	}
+	sender, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sender")
+	}
+	senderName := "Someone"
+	if sender != nil {
+		senderName = cmp.Or(sender.Nickname, sender.Username)
+	}
+
+	var title string
+	var memoID, linkedMemoID int32
+	switch inbox.Message.GetType() {
+	case storepb.InboxMessage_MEMO_COMMENT:
+		title = senderName + " commented on your memo"
+		memoID, linkedMemoID = activity.Payload.GetMemoComment().GetMemoId(), activity.Payload.GetMemoComment().GetRelatedMemoId()
+	case storepb.InboxMessage_MEMO_REACTION:
+		title = fmt.Sprintf("%s reacted %s to your memo", senderName, activity.Payload.GetMemoReaction().GetReactionType())
+		memoID = activity.Payload.GetMemoReaction().GetMemoId()
+	case storepb.InboxMessage_MEMO_MENTION:
+		title = senderName + " mentioned you in a memo"
+		memoID = activity.Payload.GetMemoMention().GetMemoId()
+	case storepb.InboxMessage_MEMO_REMINDER:
+		title = "Reminder for your memo"
+		memoID = activity.Payload.GetMemoReminder().GetMemoId()
+	default:
+		return nil, nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID, IncludeScheduled: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, nil
+	}
+	memoUID := memo.UID
+	if linkedMemoID != 0 {
+		// A comment links to the memo it's on.
+		linkedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &linkedMemoID, ExcludeContent: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if linkedMemo == nil {
+			return nil, nil
+		}
+		memoUID = linkedMemo.UID
+	}
+	snippet, err := s.MarkdownService.GenerateSnippet([]byte(memo.Content), notificationSnippetLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate snippet")
+	}
+	return &notificationItem{
+		Title:   title,
+		Snippet: snippet,
+		URL:     instanceURL + "/memos/" + url.PathEscape(memoUID),
+	}, nil
+}
+
+// UnsubscribeNotificationEmails turns off the emails of the notification types of the token of an
+// unsubscribe link, and returns the words of the types turned off.
+func (s *APIV1Service) UnsubscribeNotificationEmails(ctx context.Context, token string) ([]string, error) {
+	userID, messageTypeNames, err := auth.NewAuthenticator(s.Store, s.Secret).ParseUnsubscribeToken(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	setting, err := s.getNotificationUserSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification setting: %v", err)
+	}
+	labels := []string{}
+	for _, notificationType := range notificationEmailTypes {
+		if slices.Contains(messageTypeNames, notificationType.messageType.String()) {
+			setNotificationEmailEnabled(setting, notificationType.messageType, false)
+			labels = append(labels, notificationType.label)
+		}
+	}
+	if slices.Contains(messageTypeNames, storepb.InboxMessage_DIGEST.String()) {
+		setting.EmailDigest = false
+		labels = append(labels, activityDigestEmailLabel)
+	}
+	if len(labels) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid unsubscribe token")
+	}
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value:  &storepb.UserSetting_Notification{Notification: setting},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification setting: %v", err)
+	}
+	return labels, nil
+}
@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+)
+
+// ListUserPasskeys retrieves the passkeys registered by a user.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only list their own passkeys.
+func (s *APIV1Service) ListUserPasskeys(ctx context.Context, request *v1pb.ListUserPasskeysRequest) (*v1pb.ListUserPasskeysResponse, error) {
+	userID, err := s.checkPasskeyOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := s.Store.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list passkeys: %v", err)
+	}
+
+	response := &v1pb.ListUserPasskeysResponse{
+		Passkeys: make([]*v1pb.UserPasskey, 0, len(passkeys)),
+	}
+	for _, passkey := range passkeys {
+		response.Passkeys = append(response.Passkeys, convertUserPasskeyFromStore(userID, passkey))
+	}
+	return response, nil
+}
+
+// CreateUserPasskeyOptions starts a passkey registration.
+//
+// Returns the options for navigator.credentials.create(). The relying party is derived from
+// the instance URL, which must be set for passkeys to work.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only register passkeys for themselves.
+func (s *APIV1Service) CreateUserPasskeyOptions(ctx context.Context, request *v1pb.CreateUserPasskeyOptionsRequest) (*v1pb.UserPasskeyOptions, error) {
+	userID, err := s.checkPasskeyOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := auth.NewRelyingParty(s.Profile.InstanceURL)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are unavailable: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	challenge, err := auth.NewAuthenticator(s.Store, s.Secret).GeneratePasskeyChallenge(ctx, auth.PasskeyRegistrationAudienceName, currentUser.Username, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate passkey challenge: %v", err)
+	}
+	passkeys, err := s.Store.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list passkeys: %v", err)
+	}
+	excludeCredentialIDs := make([][]byte, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		credentialID, err := base64.RawURLEncoding.DecodeString(passkey.CredentialId)
+		if err != nil {
+			continue
+		}
+		excludeCredentialIDs = append(excludeCredentialIDs, credentialID)
+	}
+
+	displayName := currentUser.Nickname
+	if displayName == "" {
+		displayName = currentUser.Username
+	}
+	return &v1pb.UserPasskeyOptions{
+		Challenge:            challenge,
+		RpId:                 rp.ID,
+		RpName:               s.getInstanceTitle(ctx),
+		UserHandle:           auth.PasskeyUserHandle(userID),
+		Username:             currentUser.Username,
+		DisplayName:          displayName,
+		ExcludeCredentialIds: excludeCredentialIDs,
+	}, nil
+}
+
+// CreateUserPasskey registers a passkey created for a challenge from CreateUserPasskeyOptions.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only register passkeys for themselves.
+func (s *APIV1Service) CreateUserPasskey(ctx context.Context, request *v1pb.CreateUserPasskeyRequest) (*v1pb.UserPasskey, error) {
+	userID, err := s.checkPasskeyOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	label := strings.TrimSpace(request.Label)
+	if label == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "label is required")
+	}
+	rp, err := auth.NewRelyingParty(s.Profile.InstanceURL)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "passkeys are unavailable: %v", err)
+	}
+
+	passkey, err := auth.NewAuthenticator(s.Store, s.Secret).VerifyPasskeyRegistration(ctx, rp, userID, &auth.PasskeyRegistration{
+		CredentialID:      request.CredentialId,
+		ClientDataJSON:    request.ClientDataJson,
+		AuthenticatorData: request.AuthenticatorData,
+		PublicKey:         request.PublicKey,
+		Algorithm:         request.PublicKeyAlgorithm,
+		Transports:        request.Transports,
+		Label:             label,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey: %v", err)
+	}
+	if err := s.Store.AddUserPasskey(ctx, userID, passkey); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create passkey: %v", err)
+	}
+	return convertUserPasskeyFromStore(userID, passkey), nil
+}
+
+// DeleteUserPasskey removes a passkey, so it can no longer be used to sign in.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only delete their own passkeys.
+func (s *APIV1Service) DeleteUserPasskey(ctx context.Context, request *v1pb.DeleteUserPasskeyRequest) (*emptypb.Empty, error) {
+	// Format: users/{user}/passkeys/{passkey}
+	parts := strings.Split(request.Name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "passkeys" || parts[3] == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid passkey name format: %s", request.Name)
+	}
+	userID, err := s.checkPasskeyOwner(ctx, fmt.Sprintf("users/%s", parts[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.RemoveUserPasskey(ctx, userID, parts[3]); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete passkey: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkPasskeyOwner returns the user ID of the user name if it is the current user.
+func (s *APIV1Service) checkPasskeyOwner(ctx context.Context, name string) (int32, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func convertUserPasskeyFromStore(userID int32, passkey *storepb.PasskeysUserSetting_Passkey) *v1pb.UserPasskey {
+	return &v1pb.UserPasskey{
+		Name:         fmt.Sprintf("users/%d/passkeys/%s", userID, passkey.CredentialId),
+		Label:        passkey.Label,
+		Transports:   passkey.Transports,
+		CreateTime:   passkey.CreateTime,
+		LastUsedTime: passkey.LastUsedTime,
+	}
+}
@@ -0,0 +1,340 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxPushSubscriptions is the number of devices of a user that receive push notifications.
+	// Registering another device drops the oldest subscription.
+	maxPushSubscriptions = 20
+
+	// maxPushSubscriptionLabelLength is the maximum length of the label of a device.
+	maxPushSubscriptionLabelLength = 64
+
+	// maxPushEndpointLength is the maximum length of the URL of a push endpoint.
+	maxPushEndpointLength = 2048
+
+	// pushNotificationTimeout bounds pushing a notification to all the devices of a user.
+	pushNotificationTimeout = time.Minute
+)
+
+// ListUserPushSubscriptions retrieves the push subscriptions of the devices of a user.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only list their own push subscriptions.
+func (s *APIV1Service) ListUserPushSubscriptions(ctx context.Context, request *v1pb.ListUserPushSubscriptionsRequest) (*v1pb.ListUserPushSubscriptionsResponse, error) {
+	userID, err := s.checkUserPushSubscriptionPermission(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.Store.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list push subscriptions: %v", err)
+	}
+	response := &v1pb.ListUserPushSubscriptionsResponse{
+		PushSubscriptions: make([]*v1pb.UserPushSubscription, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		response.PushSubscriptions = append(response.PushSubscriptions, convertUserPushSubscriptionFromStore(userID, subscription))
+	}
+	return response, nil
+}
+
+// CreateUserPushSubscription registers the push subscription of a device, created in the browser
+// with the web push public key of the instance profile. Registering the endpoint of an existing
+// subscription again updates its keys.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only register push subscriptions for themselves.
+func (s *APIV1Service) CreateUserPushSubscription(ctx context.Context, request *v1pb.CreateUserPushSubscriptionRequest) (*v1pb.UserPushSubscription, error) {
+	userID, err := s.checkUserPushSubscriptionPermission(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if s.Profile.InstanceURL == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "push notifications are unavailable: the instance URL isn't set")
+	}
+	if len(request.Endpoint) > maxPushEndpointLength {
+		return nil, status.Errorf(codes.InvalidArgument, "endpoint is too long (max %d characters)", maxPushEndpointLength)
+	}
+	if err := webpush.ValidateSubscription(&webpush.Subscription{Endpoint: request.Endpoint, P256dh: request.P256Dh, Auth: request.Auth}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid push subscription: %v", err)
+	}
+	label := strings.TrimSpace(request.Label)
+	if len([]rune(label)) > maxPushSubscriptionLabelLength {
+		return nil, status.Errorf(codes.InvalidArgument, "label is too long (max %d characters)", maxPushSubscriptionLabelLength)
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate push subscription id: %v", err)
+	}
+	subscription, err := s.Store.SetUserPushSubscription(ctx, userID, &storepb.PushSubscriptionsUserSetting_PushSubscription{
+		Id:         hex.EncodeToString(id),
+		Endpoint:   request.Endpoint,
+		P256Dh:     request.P256Dh,
+		Auth:       request.Auth,
+		Label:      label,
+		CreateTime: timestamppb.Now(),
+		ExpireTime: request.ExpireTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create push subscription: %v", err)
+	}
+
+	subscriptions, err := s.Store.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list push subscriptions: %v", err)
+	}
+	if len(subscriptions) > maxPushSubscriptions {
+		// The subscriptions are kept in the order they were registered.
+		oldIDs := []string{}
+		for _, old := range subscriptions[:len(subscriptions)-maxPushSubscriptions] {
+			oldIDs = append(oldIDs, old.Id)
+		}
+		if err := s.Store.RemoveUserPushSubscriptions(ctx, userID, oldIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete push subscriptions: %v", err)
+		}
+	}
+	return convertUserPushSubscriptionFromStore(userID, subscription), nil
+}
+
+// DeleteUserPushSubscription revokes the push subscription of a device, which stops receiving
+// push notifications.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only delete their own push subscriptions.
+func (s *APIV1Service) DeleteUserPushSubscription(ctx context.Context, request *v1pb.DeleteUserPushSubscriptionRequest) (*emptypb.Empty, error) {
+	// Format: users/{user}/pushSubscriptions/{push_subscription}
+	parts := strings.Split(request.Name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "pushSubscriptions" || parts[3] == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid push subscription name format: %s", request.Name)
+	}
+	userID, err := s.checkUserPushSubscriptionPermission(ctx, fmt.Sprintf("users/%s", parts[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.Store.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list push subscriptions: %v", err)
+	}
+	found := false
+	for _, subscription := range subscriptions {
+		found = found || subscription.Id == parts[3]
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "push subscription not found")
+	}
+	if err := s.Store.RemoveUserPushSubscriptions(ctx, userID, []string{parts[3]}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete push subscription: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkUserPushSubscriptionPermission returns the user ID of the user name if it is the current user.
+func (s *APIV1Service) checkUserPushSubscriptionPermission(ctx context.Context, name string) (int32, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func convertUserPushSubscriptionFromStore(userID int32, subscription *storepb.PushSubscriptionsUserSetting_PushSubscription) *v1pb.UserPushSubscription {
+	pushService := ""
+	if u, err := url.Parse(subscription.Endpoint); err == nil {
+		pushService = u.Hostname()
+	}
+	return &v1pb.UserPushSubscription{
+		Name:         fmt.Sprintf("users/%d/pushSubscriptions/%s", userID, subscription.Id),
+		Label:        subscription.Label,
+		PushService:  pushService,
+		CreateTime:   subscription.CreateTime,
+		ExpireTime:   subscription.ExpireTime,
+		LastPushTime: subscription.LastPushTime,
+	}
+}
+
+// getWebPushVAPID returns the VAPID keys of the instance, generating them the first time.
+func (s *APIV1Service) getWebPushVAPID(ctx context.Context) (*webpush.VAPID, error) {
+	s.webPushMutex.Lock()
+	defer s.webPushMutex.Unlock()
+
+	setting, err := s.Store.GetInstanceWebPushSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if setting.VapidPublicKey == "" || setting.VapidPrivateKey == "" {
+		publicKey, privateKey, err := webpush.GenerateVAPIDKeys()
+		if err != nil {
+			return nil, err
+		}
+		setting = &storepb.InstanceWebPushSetting{VapidPublicKey: publicKey, VapidPrivateKey: privateKey}
+		_, err = s.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+			Key:   storepb.InstanceSettingKey_WEB_PUSH,
+			Value: &storepb.InstanceSetting_WebPushSetting{WebPushSetting: setting},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to save VAPID keys")
+		}
+	}
+	return &webpush.VAPID{
+		PublicKey:  setting.VapidPublicKey,
+		PrivateKey: setting.VapidPrivateKey,
+		Subject:    s.Profile.InstanceURL,
+	}, nil
+}
+
+// createNotificationInbox creates an inbox notification, and pushes it to the devices of its
+// receiver in the background.
+func (s *APIV1Service) createNotificationInbox(ctx context.Context, create *store.Inbox) (*store.Inbox, error) {
+	inbox, err := s.Store.CreateInbox(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := s.Store.GetUserPushSubscriptions(ctx, inbox.ReceiverID)
+	if err != nil {
+		slog.Warn("failed to list push subscriptions", slog.Int("user", int(inbox.ReceiverID)), slog.Any("err", err))
+		return inbox, nil
+	}
+	if len(subscriptions) == 0 {
+		return inbox, nil
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), pushNotificationTimeout)
+		defer cancel()
+		if err := s.pushInboxNotification(ctx, inbox, subscriptions); err != nil {
+			slog.Warn("failed to push notification", slog.Int("inbox", int(inbox.ID)), slog.Any("err", err))
+		}
+	}()
+	return inbox, nil
+}
+
+// pushInboxNotification pushes an inbox notification to the devices of its receiver, unless it's
+// the quiet hours of the receiver. The subscriptions that expired or that their push service
+// says are gone are deleted.
+func (s *APIV1Service) pushInboxNotification(ctx context.Context, inbox *store.Inbox, subscriptions []*storepb.PushSubscriptionsUserSetting_PushSubscription) error {
+	if s.Profile.InstanceURL == "" {
+		return nil
+	}
+	notificationSetting, err := s.getNotificationUserSetting(ctx, inbox.ReceiverID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get notification setting")
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &inbox.ReceiverID, Key: storepb.UserSetting_GENERAL})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user general setting")
+	}
+	now := time.Now()
+	if isInQuietHours(now.In(getUserGeneralSettingLocation(generalSetting.GetGeneral())), notificationSetting.QuietHoursStart, notificationSetting.QuietHoursEnd) {
+		return nil
+	}
+
+	item, err := s.buildNotificationItem(ctx, strings.TrimSuffix(s.Profile.InstanceURL, "/"), inbox)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return nil
+	}
+	vapid, err := s.getWebPushVAPID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get VAPID keys")
+	}
+	message := &webpush.Message{
+		Title: item.Title,
+		Body:  item.Snippet,
+		URL:   item.URL,
+		Tag:   fmt.Sprintf("inbox-%d", inbox.ID),
+	}
+
+	goneIDs := []string{}
+	for _, subscription := range subscriptions {
+		if subscription.ExpireTime != nil && subscription.ExpireTime.AsTime().Before(now) {
+			goneIDs = append(goneIDs, subscription.Id)
+			continue
+		}
+		err := s.PushSender.Send(ctx, vapid, &webpush.Subscription{
+			Endpoint: subscription.Endpoint,
+			P256dh:   subscription.P256Dh,
+			Auth:     subscription.Auth,
+		}, message)
+		if errors.Is(err, webpush.ErrSubscriptionGone) {
+			goneIDs = append(goneIDs, subscription.Id)
+			continue
+		}
+		if err != nil {
+			slog.Warn("failed to push notification to device", slog.Int("user", int(inbox.ReceiverID)), slog.String("subscription", subscription.Id), slog.Any("err", err))
+			continue
+		}
+		if err := s.Store.UpdateUserPushSubscriptionLastPushTime(ctx, inbox.ReceiverID, subscription.Id, timestamppb.New(now)); err != nil {
+			return errors.Wrap(err, "failed to update push subscription")
+		}
+	}
+	if len(goneIDs) > 0 {
+		if err := s.Store.RemoveUserPushSubscriptions(ctx, inbox.ReceiverID, goneIDs); err != nil {
+			return errors.Wrap(err, "failed to delete gone push subscriptions")
+		}
+	}
+	return nil
+}
+
+// parseTimeOfDay parses an "HH:MM" time of day into minutes after midnight.
+func parseTimeOfDay(timeOfDay string) (int, error) {
+	clock, err := time.Parse("15:04", timeOfDay)
+	if err != nil {
+		return 0, errors.Errorf("invalid time of day %q", timeOfDay)
+	}
+	return clock.Hour()*60 + clock.Minute(), nil
+}
+
+// isInQuietHours reports whether a time is in the quiet hours from start to end, "HH:MM" times
+// of day in the location of t. Quiet hours that end before they start span midnight, and are off
+// unless both are set.
+func isInQuietHours(t time.Time, start, end string) bool {
+	startMinute, err := parseTimeOfDay(start)
+	if err != nil {
+		return false
+	}
+	endMinute, err := parseTimeOfDay(end)
+	if err != nil {
+		return false
+	}
+	minute := t.Hour()*60 + t.Minute()
+	if startMinute <= endMinute {
+		return startMinute <= minute && minute < endMinute
+	}
+	return minute >= startMinute || minute < endMinute
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInQuietHours(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 3, 8, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		start, end string
+		time       time.Time
+		expected   bool
+	}{
+		{name: "within the same day", start: "12:00", end: "14:00", time: at(13, 0), expected: true},
+		{name: "the end is excluded", start: "12:00", end: "14:00", time: at(14, 0), expected: false},
+		{name: "the start is included", start: "12:00", end: "14:00", time: at(12, 0), expected: true},
+		{name: "spanning midnight, before midnight", start: "22:00", end: "07:00", time: at(23, 30), expected: true},
+		{name: "spanning midnight, after midnight", start: "22:00", end: "07:00", time: at(6, 59), expected: true},
+		{name: "spanning midnight, during the day", start: "22:00", end: "07:00", time: at(12, 0), expected: false},
+		{name: "off without an end", start: "22:00", end: "", time: at(23, 0), expected: false},
+		{name: "off when they start and end at the same time", start: "22:00", end: "22:00", time: at(22, 0), expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, isInQuietHours(test.time, test.start, test.end))
+		})
+	}
+
+	// The quiet hours are in the time zone of the time.
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	require.True(t, isInQuietHours(time.Date(2024, 3, 8, 14, 0, 0, 0, time.UTC).In(tokyo), "22:00", "07:00"))
+}
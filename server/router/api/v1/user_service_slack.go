@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+)
+
+// GetUserSlackLink gets the Slack account linked to a user.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only get their own.
+func (s *APIV1Service) GetUserSlackLink(ctx context.Context, request *v1pb.GetUserSlackLinkRequest) (*v1pb.UserSlackLink, error) {
+	userID, err := s.checkUserSlackLinkPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	slack, err := s.Store.GetUserSlack(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get Slack setting: %v", err)
+	}
+	slackSetting, err := s.Store.GetInstanceSlackSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance Slack setting: %v", err)
+	}
+	return convertUserSlackLinkFromStore(userID, slack, slackSetting.SigningSecret != ""), nil
+}
+
+// GenerateUserSlackLinkCode generates a one-time code linking a Slack account to a user. The
+// code is sent in a direct message to the Slack app, and links the account it's sent from,
+// replacing the linked account, if any.
+//
+// The code is stored hashed and only returned in the response.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only generate their own, as the linked account creates their memos.
+func (s *APIV1Service) GenerateUserSlackLinkCode(ctx context.Context, request *v1pb.GenerateUserSlackLinkCodeRequest) (*v1pb.UserSlackLink, error) {
+	userID, err := s.checkUserSlackLinkPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	slackSetting, err := s.Store.GetInstanceSlackSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance Slack setting: %v", err)
+	}
+	if slackSetting.SigningSecret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "Slack integration is disabled")
+	}
+
+	slack, err := s.Store.GetUserSlack(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get Slack setting: %v", err)
+	}
+	code, codeHash, err := auth.GenerateLinkCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate Slack link code: %v", err)
+	}
+	slack.LinkCodeHash = codeHash
+	slack.LinkCodeExpireTime = timestamppb.New(time.Now().Add(auth.LinkCodeDuration))
+	if err := s.Store.UpsertUserSlack(ctx, userID, slack); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update Slack setting: %v", err)
+	}
+
+	userSlackLink := convertUserSlackLinkFromStore(userID, slack, true)
+	userSlackLink.LinkCode = code
+	return userSlackLink, nil
+}
+
+// DeleteUserSlackLink unlinks the Slack account of a user, and revokes the pending link code.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only delete their own.
+func (s *APIV1Service) DeleteUserSlackLink(ctx context.Context, request *v1pb.DeleteUserSlackLinkRequest) (*emptypb.Empty, error) {
+	userID, err := s.checkUserSlackLinkPermission(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.UpsertUserSlack(ctx, userID, &storepb.SlackUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update Slack setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkUserSlackLinkPermission returns the user ID of a Slack link resource name if the current
+// user owns it.
+func (s *APIV1Service) checkUserSlackLinkPermission(ctx context.Context, name string) (int32, error) {
+	userID, err := ExtractUserIDFromSlackLinkName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid Slack link name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func convertUserSlackLinkFromStore(userID int32, slack *storepb.SlackUserSetting, available bool) *v1pb.UserSlackLink {
+	userSlackLink := &v1pb.UserSlackLink{
+		Name:        fmt.Sprintf("users/%d/slackLink", userID),
+		Available:   available,
+		SlackTeamId: slack.TeamId,
+		SlackUserId: slack.SlackUserId,
+		LinkTime:    slack.LinkTime,
+	}
+	if slack.LinkCodeHash != "" && slack.LinkCodeExpireTime.AsTime().After(time.Now()) {
+		userSlackLink.LinkCodeExpireTime = slack.LinkCodeExpireTime
+	}
+	return userSlackLink
+}
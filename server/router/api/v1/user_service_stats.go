@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -206,3 +207,84 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 
 	return userStats, nil
 }
+
+// secondsPerDay is the length of the days of store.MemoDayCount.
+const secondsPerDay = 24 * 60 * 60
+
+// GetUserWritingStats returns the writing stats of a user, aggregated by the store in the time
+// zone of the user. The days start at the current offset of the time zone, so the memos created
+// around a daylight saving time change may count on the next or previous day.
+//
+// Authentication: Required (the user, or the host for any user).
+func (s *APIV1Service) GetUserWritingStats(ctx context.Context, request *v1pb.GetUserWritingStatsRequest) (*v1pb.UserWritingStats, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && currentUser.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	now := time.Now().In(getUserGeneralSettingLocation(generalSetting.GetGeneral()))
+	_, offsetSec := now.Zone()
+	stats, err := s.Store.GetMemoStats(ctx, &store.FindMemoStats{
+		CreatorID:       user.ID,
+		IncludeArchived: request.IncludeArchived,
+		UTCOffsetSec:    int64(offsetSec),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo stats: %v", err)
+	}
+
+	writingStats := &v1pb.UserWritingStats{
+		Name:           fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		TotalMemoCount: stats.MemoCount,
+		TotalWordCount: stats.WordCount,
+		TagMemoCounts:  stats.TagCounts,
+		MonthCounts:    []*v1pb.UserWritingStats_MonthCount{},
+	}
+	if stats.MemoCount > 0 {
+		writingStats.AverageMemoLength = float64(stats.CharacterCount) / float64(stats.MemoCount)
+	}
+	var streakDays int32
+	for i, dayCount := range stats.DayCounts {
+		// The days are counted from the Unix epoch in the time zone, so they are dates in UTC.
+		month := time.Unix(dayCount.Day*secondsPerDay, 0).UTC().Format("2006-01")
+		if n := len(writingStats.MonthCounts); n > 0 && writingStats.MonthCounts[n-1].Month == month {
+			writingStats.MonthCounts[n-1].Count += dayCount.Count
+		} else {
+			writingStats.MonthCounts = append(writingStats.MonthCounts, &v1pb.UserWritingStats_MonthCount{Month: month, Count: dayCount.Count})
+		}
+		if i > 0 && dayCount.Day == stats.DayCounts[i-1].Day+1 {
+			streakDays++
+		} else {
+			streakDays = 1
+		}
+		writingStats.LongestStreakDays = max(writingStats.LongestStreakDays, streakDays)
+	}
+	today := (now.Unix() + int64(offsetSec)) / secondsPerDay
+	if n := len(stats.DayCounts); n > 0 && stats.DayCounts[n-1].Day >= today-1 {
+		writingStats.CurrentStreakDays = streakDays
+	}
+	return writingStats, nil
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// checkStorageQuota rejects an upload of sizeBytes that would exceed the storage quota of a user.
+func (s *APIV1Service) checkStorageQuota(ctx context.Context, userID int32, sizeBytes int64) error {
+	quotaBytes, err := s.Store.GetUserStorageQuota(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get storage quota: %v", err)
+	}
+	if quotaBytes <= 0 {
+		return nil
+	}
+	usedBytes, err := s.Store.GetUserStorageUsage(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get storage usage: %v", err)
+	}
+	if usedBytes+sizeBytes > quotaBytes {
+		return status.Errorf(codes.ResourceExhausted, "storage quota exceeded: %d of %d bytes used, the upload needs %d bytes", usedBytes, quotaBytes, sizeBytes)
+	}
+	return nil
+}
+
+// getStorageUserSetting returns the storage usage and quota of a user.
+func (s *APIV1Service) getStorageUserSetting(ctx context.Context, userID int32) (*v1pb.UserSetting, error) {
+	usedBytes, err := s.Store.GetUserStorageUsage(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get storage usage: %v", err)
+	}
+	quotaBytes, err := s.Store.GetUserStorageQuota(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get storage quota: %v", err)
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_STORAGE_QUOTA})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	storageSetting := &v1pb.UserSetting_StorageSetting{
+		UsedBytes:  usedBytes,
+		QuotaBytes: quotaBytes,
+	}
+	if userSetting.GetStorageQuota() != nil {
+		storageSetting.QuotaMb = userSetting.GetStorageQuota().QuotaMb
+	}
+	return &v1pb.UserSetting{
+		Name:  fmt.Sprintf("users/%d/settings/%s", userID, convertSettingKeyFromStore(storepb.UserSetting_STORAGE_QUOTA)),
+		Value: &v1pb.UserSetting_StorageSetting_{StorageSetting: storageSetting},
+	}, nil
+}
+
+// updateStorageUserSetting sets the storage quota of a user, clearing it when the quotaMb field
+// of the update mask isn't set. Only the host can update the storage quotas.
+func (s *APIV1Service) updateStorageUserSetting(ctx context.Context, currentUser *store.User, userID int32, request *v1pb.UpdateUserSettingRequest) (*v1pb.UserSetting, error) {
+	if currentUser.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host can update storage quotas")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &userID, Key: storepb.UserSetting_STORAGE_QUOTA})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	setting := &storepb.StorageQuotaUserSetting{}
+	if userSetting.GetStorageQuota() != nil {
+		setting.QuotaMb = userSetting.GetStorageQuota().QuotaMb
+	}
+
+	incoming := request.Setting.GetStorageSetting()
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "quotaMb":
+			if incoming.GetQuotaMb() < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "quota must not be negative")
+			}
+			setting.QuotaMb = incoming.QuotaMb
+		default:
+			// Ignore unsupported fields
+		}
+	}
+
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_STORAGE_QUOTA,
+		Value:  &storepb.UserSetting_StorageQuota{StorageQuota: setting},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return s.getStorageUserSetting(ctx, userID)
+}
@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// SuspendUser suspends a user without deleting anything. The sessions, refresh tokens and access
+// tokens of the user are revoked, and the memos of the user are hidden from the others until the
+// suspension is lifted.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) SuspendUser(ctx context.Context, request *v1pb.SuspendUserRequest) (*v1pb.User, error) {
+	currentUser, user, err := s.getUserToModerate(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == store.RoleHost {
+		return nil, status.Errorf(codes.FailedPrecondition, "the host can't be suspended")
+	}
+	if user.SuspendedTs != 0 {
+		return convertUserFromStore(user), nil
+	}
+
+	suspendedSec := time.Now().Unix()
+	user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, SuspendedTs: &suspendedSec})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to suspend user: %v", err)
+	}
+	// The authenticator rejects suspended users anyway, but the credentials must not outlive the
+	// suspension.
+	if err := s.Store.DeleteRefreshTokens(ctx, &store.DeleteRefreshToken{UserID: &user.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete refresh tokens: %v", err)
+	}
+	if err := s.Store.RemoveOtherUserSessions(ctx, user.ID, ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove user sessions: %v", err)
+	}
+	if err := s.Store.RemoveAllUserAccessTokens(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove user access tokens: %v", err)
+	}
+
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventUserSuspended, currentUser.ID, getSuspensionAuditPayload(user, request.Reason))
+	return convertUserFromStore(user), nil
+}
+
+// UnsuspendUser lifts the suspension of a user. The user has to sign in again.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: Host only.
+func (s *APIV1Service) UnsuspendUser(ctx context.Context, request *v1pb.UnsuspendUserRequest) (*v1pb.User, error) {
+	currentUser, user, err := s.getUserToModerate(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedTs == 0 {
+		return convertUserFromStore(user), nil
+	}
+
+	var notSuspendedSec int64
+	user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, SuspendedTs: &notSuspendedSec})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unsuspend user: %v", err)
+	}
+
+	auth.NewAuthenticator(s.Store, s.Secret).RecordAuditLog(ctx, store.AuditEventUserUnsuspended, currentUser.ID, getSuspensionAuditPayload(user, request.Reason))
+	return convertUserFromStore(user), nil
+}
+
+// getUserToModerate returns the current user, who must be the host, and the user of the name.
+func (s *APIV1Service) getUserToModerate(ctx context.Context, name string) (*store.User, *store.User, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkHost(ctx); err != nil {
+		return nil, nil, err
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	return currentUser, user, nil
+}
+
+func getSuspensionAuditPayload(user *store.User, reason string) map[string]any {
+	payload := map[string]any{"user_id": user.ID, "username": user.Username}
+	if reason != "" {
+		payload["reason"] = reason
+	}
+	return payload
+}
+
+// isMemoCreatorHidden returns whether the memos of a creator are hidden from a viewer, which is the
+// case when the creator is suspended and the viewer isn't the host.
+func (s *APIV1Service) isMemoCreatorHidden(ctx context.Context, creatorID int32, viewer *store.User) (bool, error) {
+	if viewer != nil && viewer.Role == store.RoleHost {
+		return false, nil
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &creatorID})
+	if err != nil {
+		return false, err
+	}
+	return creator != nil && creator.SuspendedTs != 0, nil
+}
+
+// excludeSuspendedCreators returns whether the memos of suspended users are excluded from the
+// memos listed to a viewer. Only the host sees them, to moderate them.
+func excludeSuspendedCreators(viewer *store.User) bool {
+	return viewer == nil || viewer.Role != store.RoleHost
+}
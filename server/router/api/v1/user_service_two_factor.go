@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+// GetUserTwoFactor returns the two-factor authentication status of a user.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can get their own status; admins can get any user's.
+func (s *APIV1Service) GetUserTwoFactor(ctx context.Context, request *v1pb.GetUserTwoFactorRequest) (*v1pb.UserTwoFactor, error) {
+	userID, err := ExtractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	return convertUserTwoFactorFromStore(userID, twoFactor), nil
+}
+
+// EnrollUserTwoFactor starts two-factor authentication enrollment.
+//
+// This endpoint:
+// 1. Generates a new TOTP secret, replacing any unconfirmed one
+// 2. Returns the secret and its otpauth:// URI for the authenticator app
+//
+// Two-factor authentication stays disabled until ConfirmUserTwoFactor verifies a code.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only enroll themselves.
+func (s *APIV1Service) EnrollUserTwoFactor(ctx context.Context, request *v1pb.EnrollUserTwoFactorRequest) (*v1pb.EnrollUserTwoFactorResponse, error) {
+	currentUser, err := s.getTwoFactorOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, currentUser.ID, &storepb.TwoFactorUserSetting{Secret: secret}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+
+	return &v1pb.EnrollUserTwoFactorResponse{
+		Secret:     secret,
+		OtpauthUri: auth.BuildTOTPURI(s.getInstanceTitle(ctx), currentUser.Username, secret),
+	}, nil
+}
+
+// ConfirmUserTwoFactor enables two-factor authentication after verifying a code for the
+// secret generated by EnrollUserTwoFactor.
+//
+// Returns one-time recovery codes, which are stored hashed and can't be shown again.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can only confirm their own enrollment.
+func (s *APIV1Service) ConfirmUserTwoFactor(ctx context.Context, request *v1pb.ConfirmUserTwoFactorRequest) (*v1pb.ConfirmUserTwoFactorResponse, error) {
+	currentUser, err := s.getTwoFactorOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	if twoFactor.Secret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication enrollment not started")
+	}
+
+	counter, ok := auth.ValidateTOTP(twoFactor.Secret, request.Code, time.Now(), 0)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+	}
+	recoveryCodes, recoveryCodeHashes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %v", err)
+	}
+	enabled := &storepb.TwoFactorUserSetting{
+		Secret:             twoFactor.Secret,
+		Enabled:            true,
+		LastCounter:        counter,
+		RecoveryCodeHashes: recoveryCodeHashes,
+		EnableTime:         timestamppb.Now(),
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, currentUser.ID, enabled); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+
+	return &v1pb.ConfirmUserTwoFactorResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DeleteUserTwoFactor disables two-factor authentication.
+//
+// Users disabling their own two-factor authentication must provide a current code or a
+// recovery code. Admins can reset another user's without a code, e.g. after the user lost
+// both their authenticator app and recovery codes.
+//
+// Authentication: Required (session cookie or access token)
+// Authorization: User can disable their own; admins can reset any user's except the host's.
+func (s *APIV1Service) DeleteUserTwoFactor(ctx context.Context, request *v1pb.DeleteUserTwoFactorRequest) (*emptypb.Empty, error) {
+	userID, err := ExtractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if currentUser.ID == userID {
+		twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+		}
+		// An unconfirmed enrollment can be cancelled without a code.
+		if twoFactor.Enabled {
+			if err := auth.NewAuthenticator(s.Store, s.Secret).VerifyTwoFactorCode(ctx, userID, request.Code); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+			}
+		}
+	} else {
+		if !isSuperUser(currentUser) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		if user.Role == store.RoleHost && currentUser.Role != store.RoleHost {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	if err := s.Store.UpsertUserTwoFactor(ctx, userID, &storepb.TwoFactorUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getTwoFactorOwner returns the current user if they own the two-factor resource name.
+func (s *APIV1Service) getTwoFactorOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := ExtractUserIDFromTwoFactorName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+func convertUserTwoFactorFromStore(userID int32, twoFactor *storepb.TwoFactorUserSetting) *v1pb.UserTwoFactor {
+	userTwoFactor := &v1pb.UserTwoFactor{
+		Name:    fmt.Sprintf("users/%d/twoFactor", userID),
+		Enabled: twoFactor.Enabled,
+	}
+	if twoFactor.Enabled {
+		userTwoFactor.EnableTime = twoFactor.EnableTime
+		userTwoFactor.RecoveryCodesRemaining = int32(len(twoFactor.RecoveryCodeHashes))
+	}
+	return userTwoFactor
+}
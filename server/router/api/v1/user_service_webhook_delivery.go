@@ -0,0 +1,452 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// webhookTestInterval and webhookTestBurst limit the webhook tests of a user to 10 per minute,
+	// so that tests can't be used to proxy requests.
+	webhookTestInterval = 6 * time.Second
+	webhookTestBurst    = 5
+	// webhookDeliveryMaxAttempts is the number of attempts of a delivery before it fails. With the
+	// backoff, the attempts of a delivery spread over about 30 minutes.
+	webhookDeliveryMaxAttempts = 5
+	// webhookDeliveryBackoff is the wait after the first failed attempt of a delivery, doubled
+	// after each following failed attempt.
+	webhookDeliveryBackoff = 2 * time.Minute
+	// webhookDeliveryLease is how long an attempt holds its delivery. It outlasts the timeout of the
+	// webhook requests, so a delivery is only due again if its attempt was lost to a restart.
+	webhookDeliveryLease = 2 * time.Minute
+	// webhookMaxConsecutiveFailures is the number of consecutive failed deliveries that disables
+	// a webhook.
+	webhookMaxConsecutiveFailures = 50
+
+	// defaultWebhookDeliveryPageSize is the page size of ListUserWebhookDeliveries if none is requested.
+	defaultWebhookDeliveryPageSize = 50
+	// maxWebhookDeliveryPageSize caps the page size of ListUserWebhookDeliveries.
+	maxWebhookDeliveryPageSize = 1000
+)
+
+// ListUserWebhookDeliveries lists the deliveries of a webhook, newest first.
+//
+// Authentication: Required.
+// Authorization: The owner of the webhook, or an admin. Only the host for workspace webhooks.
+func (s *APIV1Service) ListUserWebhookDeliveries(ctx context.Context, request *v1pb.ListUserWebhookDeliveriesRequest) (*v1pb.ListUserWebhookDeliveriesResponse, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+	if _, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryPageSize
+	}
+	limit = min(limit, maxWebhookDeliveryPageSize)
+	limitPlusOne := limit + 1
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		UserID:    &userID,
+		WebhookID: &webhookID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &v1pb.ListUserWebhookDeliveriesResponse{
+		Deliveries: []*v1pb.UserWebhookDelivery{},
+	}
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertUserWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
+// RedeliverUserWebhookDelivery replays a delivery of a webhook as a new delivery with the same
+// payload, retried like any other delivery.
+//
+// Authentication: Required.
+// Authorization: The owner of the webhook, or an admin. Only the host for workspace webhooks.
+func (s *APIV1Service) RedeliverUserWebhookDelivery(ctx context.Context, request *v1pb.RedeliverUserWebhookDeliveryRequest) (*v1pb.UserWebhookDelivery, error) {
+	deliveryID, webhookID, userID, err := parseUserWebhookDeliveryName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook delivery name: %v", err)
+	}
+	hook, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if hook.Disabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook is disabled")
+	}
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID:        &deliveryID,
+		UserID:    &userID,
+		WebhookID: &webhookID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+
+	redelivery, err := s.enqueueWebhookDelivery(ctx, userID, webhookID, delivery.ActivityType, []byte(delivery.Payload))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook: %v", err)
+	}
+	return convertUserWebhookDeliveryFromStore(redelivery), nil
+}
+
+func (s *APIV1Service) TestUserWebhook(ctx context.Context, request *v1pb.TestUserWebhookRequest) (*v1pb.TestUserWebhookResponse, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+	hook, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	limiter, _ := s.webhookTestLimiters.LoadOrStore(currentUser.ID, rate.NewLimiter(rate.Every(webhookTestInterval), webhookTestBurst))
+	if !limiter.(*rate.Limiter).Allow() {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many webhook tests, try again later")
+	}
+
+	secret, err := s.decryptWebhookSecret(hook.EncryptedSecret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decrypt webhook secret: %v", err)
+	}
+	// The workspace webhooks are tested with a memo of the current user.
+	creator := fmt.Sprintf("%s%d", UserNamePrefix, currentUser.ID)
+	now := timestamppb.Now()
+	body, err := s.buildWebhookBody(hook, &v1pb.Memo{
+		Name:        fmt.Sprintf("%s%s", MemoNamePrefix, "test"),
+		State:       v1pb.State_NORMAL,
+		Creator:     creator,
+		CreateTime:  now,
+		UpdateTime:  now,
+		DisplayTime: now,
+		Content:     "Test memo\nThis is a test memo sent to check the webhook. #test",
+		Visibility:  v1pb.Visibility_PRIVATE,
+		Tags:        []string{"test"},
+		Snippet:     "Test memo This is a test memo sent to check the webhook. #test",
+	}, webhook.EventTest, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build webhook payload: %v", err)
+	}
+
+	response, sendErr := webhook.Test(ctx, hook.Url, secret, convertWebhookFormatFromStore(hook.Format), body)
+	testResponse := &v1pb.TestUserWebhookResponse{}
+	if response != nil {
+		testResponse.StatusCode = int32(response.StatusCode)
+		testResponse.Headers = make(map[string]string, len(response.Header))
+		for key := range response.Header {
+			testResponse.Headers[key] = response.Header.Get(key)
+		}
+		testResponse.Body = string(response.Body)
+		testResponse.Latency = durationpb.New(response.Latency)
+	}
+	if sendErr != nil {
+		testResponse.Error = sendErr.Error()
+	}
+	return testResponse, nil
+}
+
+// getUserWebhookForCurrentUser returns a webhook of a user, if the current user can manage it.
+func (s *APIV1Service) getUserWebhookForCurrentUser(ctx context.Context, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	if err := s.checkUserWebhookPermission(ctx, userID); err != nil {
+		return nil, err
+	}
+	hook, err := s.getUserWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
+	}
+	if hook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	return hook, nil
+}
+
+// getUserWebhook returns a webhook of an owner ID, or nil if it doesn't exist.
+func (s *APIV1Service) getUserWebhook(ctx context.Context, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	webhooks, err := s.listWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range webhooks {
+		if hook.Id == webhookID {
+			return hook, nil
+		}
+	}
+	return nil, nil
+}
+
+// enqueueWebhookDelivery records the delivery of a JSON body to a webhook, and makes its first
+// attempt in the background. The delivery is created claimed by that attempt, so the webhook
+// delivery runner only picks it up if the attempt is lost.
+func (s *APIV1Service) enqueueWebhookDelivery(ctx context.Context, userID int32, webhookID, activityType string, body []byte) (*store.WebhookDelivery, error) {
+	hash := sha256.Sum256(body)
+	now := time.Now()
+	delivery, err := s.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		UserID:        userID,
+		WebhookID:     webhookID,
+		ActivityType:  activityType,
+		Payload:       string(body),
+		PayloadHash:   hex.EncodeToString(hash[:]),
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: now.Add(webhookDeliveryLease).Unix(),
+		CreatedTs:     now.Unix(),
+		UpdatedTs:     now.Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+
+	go func() {
+		if err := s.deliverWebhook(context.Background(), delivery); err != nil {
+			slog.Warn("failed to deliver webhook", "delivery", delivery.ID, "error", err)
+		}
+	}()
+	return delivery, nil
+}
+
+// DeliverDueWebhook claims a due webhook delivery and makes its next attempt. The delivery is
+// skipped if another attempt claimed it since it was found.
+func (s *APIV1Service) DeliverDueWebhook(ctx context.Context, delivery *store.WebhookDelivery) error {
+	claimed, err := s.Store.ClaimWebhookDelivery(ctx, &store.ClaimWebhookDelivery{
+		ID:            delivery.ID,
+		NextAttemptTs: delivery.NextAttemptTs,
+		LeaseTs:       time.Now().Add(webhookDeliveryLease).Unix(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to claim webhook delivery")
+	}
+	if !claimed {
+		return nil
+	}
+	return s.deliverWebhook(ctx, delivery)
+}
+
+// deliverWebhook makes an attempt of a pending webhook delivery claimed by the caller, and records
+// its outcome. A failed attempt is retried after an exponential backoff until the delivery runs
+// out of attempts. Deliveries to webhooks that have been deleted or disabled since fail without
+// being sent.
+func (s *APIV1Service) deliverWebhook(ctx context.Context, delivery *store.WebhookDelivery) error {
+	hook, err := s.getUserWebhook(ctx, delivery.UserID, delivery.WebhookID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user webhooks")
+	}
+	if hook == nil || hook.Disabled {
+		failed, message := store.WebhookDeliveryFailed, "webhook was deleted"
+		if hook != nil {
+			message = "webhook is disabled"
+		}
+		updatedTsSec := time.Now().Unix()
+		metrics.ObserveWebhookDelivery(metrics.WebhookDropped, 0)
+		return s.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+			ID:        delivery.ID,
+			Status:    &failed,
+			Error:     &message,
+			UpdatedTs: &updatedTsSec,
+		})
+	}
+
+	// Every attempt is signed when it's sent, so that retries and redeliveries carry a fresh timestamp.
+	startTime := time.Now()
+	statusCode := 0
+	secret, sendErr := s.decryptWebhookSecret(hook.EncryptedSecret)
+	if sendErr == nil {
+		statusCode, sendErr = webhook.Send(ctx, hook.Url, secret, convertWebhookFormatFromStore(hook.Format), []byte(delivery.Payload))
+	}
+	now := time.Now()
+	attempts := delivery.Attempts + 1
+	responseStatus := int32(statusCode)
+	latencyMs := now.Sub(startTime).Milliseconds()
+	updatedTsSec := now.Unix()
+	deliveryStatus, message := store.WebhookDeliverySucceeded, ""
+	update := &store.UpdateWebhookDelivery{
+		ID:             delivery.ID,
+		Status:         &deliveryStatus,
+		Attempts:       &attempts,
+		ResponseStatus: &responseStatus,
+		LatencyMs:      &latencyMs,
+		Error:          &message,
+		UpdatedTs:      &updatedTsSec,
+	}
+	if sendErr != nil {
+		deliveryStatus, message = store.WebhookDeliveryFailed, sendErr.Error()
+		if attempts < webhookDeliveryMaxAttempts {
+			deliveryStatus = store.WebhookDeliveryPending
+			nextAttemptTsSec := now.Add(webhookDeliveryBackoff << (attempts - 1)).Unix()
+			update.NextAttemptTs = &nextAttemptTsSec
+		}
+	}
+	metrics.ObserveWebhookDelivery(webhookDeliveryOutcome(deliveryStatus), now.Sub(startTime))
+	if err := s.Store.UpdateWebhookDelivery(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update webhook delivery")
+	}
+	if deliveryStatus == store.WebhookDeliveryPending {
+		return nil
+	}
+	return s.recordWebhookDeliveryResult(ctx, delivery.UserID, delivery.WebhookID, deliveryStatus == store.WebhookDeliverySucceeded)
+}
+
+// webhookDeliveryOutcome returns the metrics outcome of an attempt leaving its delivery with the
+// status.
+func webhookDeliveryOutcome(deliveryStatus store.WebhookDeliveryStatus) string {
+	switch deliveryStatus {
+	case store.WebhookDeliverySucceeded:
+		return metrics.WebhookSucceeded
+	case store.WebhookDeliveryPending:
+		return metrics.WebhookRetrying
+	default:
+		return metrics.WebhookFailed
+	}
+}
+
+// recordWebhookDeliveryResult counts the consecutive failed deliveries of a webhook, and disables
+// it once they reach webhookMaxConsecutiveFailures, notifying its owner, if it's a user webhook.
+func (s *APIV1Service) recordWebhookDeliveryResult(ctx context.Context, userID int32, webhookID string, succeeded bool) error {
+	// Deliveries finish concurrently, and the webhooks are stored in a single user setting.
+	s.webhookMutex.Lock()
+	defer s.webhookMutex.Unlock()
+
+	hook, err := s.getUserWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user webhooks")
+	}
+	if hook == nil || (succeeded && hook.ConsecutiveFailures == 0) {
+		return nil
+	}
+	if succeeded {
+		hook.ConsecutiveFailures = 0
+	} else {
+		hook.ConsecutiveFailures++
+	}
+	disable := !hook.Disabled && hook.ConsecutiveFailures >= webhookMaxConsecutiveFailures
+	if disable {
+		hook.Disabled = true
+	}
+	if err := s.updateWebhook(ctx, userID, hook); err != nil {
+		return errors.Wrap(err, "failed to update webhook")
+	}
+	if !disable {
+		return nil
+	}
+
+	slog.Warn("disabled webhook after consecutive failed deliveries", "user", userID, "webhook", webhookID, "failures", hook.ConsecutiveFailures)
+	// The workspace webhooks have no owner to notify.
+	if userID == 0 {
+		return nil
+	}
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: userID,
+		Type:      store.ActivityTypeWebhookDisabled,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			WebhookDisabled: &storepb.ActivityWebhookDisabledPayload{
+				WebhookId:           webhookID,
+				ConsecutiveFailures: hook.ConsecutiveFailures,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	_, err = s.Store.CreateInbox(ctx, &store.Inbox{
+		SenderID:   userID,
+		ReceiverID: userID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_WEBHOOK_DISABLED,
+			ActivityId: &activity.ID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
+// parseUserWebhookDeliveryName parses a webhook delivery name and returns the delivery ID, the
+// webhook ID and the user ID.
+// Format: {webhook}/deliveries/{delivery}, where {webhook} is the name of a user or workspace webhook.
+func parseUserWebhookDeliveryName(name string) (int32, string, int32, error) {
+	webhookName, deliveryID, ok := strings.Cut(name, "/deliveries/")
+	if !ok {
+		return 0, "", 0, errors.New("invalid webhook delivery name format")
+	}
+	webhookID, userID, err := parseUserWebhookName(webhookName)
+	if err != nil {
+		return 0, "", 0, err
+	}
+	id, err := strconv.ParseInt(deliveryID, 10, 32)
+	if err != nil {
+		return 0, "", 0, errors.New("invalid delivery ID in webhook delivery name")
+	}
+	return int32(id), webhookID, userID, nil
+}
+
+func convertUserWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *v1pb.UserWebhookDelivery {
+	message := &v1pb.UserWebhookDelivery{
+		Name:           fmt.Sprintf("%s/webhooks/%s/deliveries/%d", formatUserWebhookParent(delivery.UserID), delivery.WebhookID, delivery.ID),
+		ActivityType:   delivery.ActivityType,
+		PayloadHash:    delivery.PayloadHash,
+		State:          v1pb.UserWebhookDelivery_State(v1pb.UserWebhookDelivery_State_value[delivery.Status.String()]),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Latency:        durationpb.New(time.Duration(delivery.LatencyMs) * time.Millisecond),
+		Error:          delivery.Error,
+		CreateTime:     timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdateTime:     timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+	}
+	if delivery.Status == store.WebhookDeliveryPending {
+		message.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	}
+	return message
+}
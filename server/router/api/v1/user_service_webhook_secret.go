@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// webhookSecretPrefix marks webhook secrets, so that they're recognizable when leaked.
+const webhookSecretPrefix = "whsec_"
+
+func (s *APIV1Service) RotateUserWebhookSecret(ctx context.Context, request *v1pb.RotateUserWebhookSecretRequest) (*v1pb.UserWebhook, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+	if _, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	secret, encryptedSecret, err := s.generateUserWebhookSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
+	// Deliveries update the failure count of the webhook concurrently.
+	s.webhookMutex.Lock()
+	defer s.webhookMutex.Unlock()
+	hook, err := s.getUserWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
+	}
+	if hook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	updatedWebhook := proto.CloneOf(hook)
+	updatedWebhook.EncryptedSecret = encryptedSecret
+	if err := s.updateWebhook(ctx, userID, updatedWebhook); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+
+	userWebhook := convertUserWebhookFromUserSetting(updatedWebhook, userID)
+	userWebhook.Secret = secret
+	return userWebhook, nil
+}
+
+// generateUserWebhookSecret returns a new webhook secret, along with its encrypted form to store.
+func (s *APIV1Service) generateUserWebhookSecret() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := webhookSecretPrefix + hex.EncodeToString(b)
+	encryptedSecret, err := s.encryptWebhookSecret(secret)
+	if err != nil {
+		return "", "", err
+	}
+	return secret, encryptedSecret, nil
+}
+
+// encryptWebhookSecret encrypts a webhook secret with AES-GCM, keyed with the instance secret key.
+// Unlike passwords, webhook secrets can't be hashed, as deliveries are signed with them.
+func (s *APIV1Service) encryptWebhookSecret(secret string) (string, error) {
+	gcm, err := s.newWebhookSecretCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+// decryptWebhookSecret decrypts a webhook secret encrypted by encryptWebhookSecret. An empty
+// encrypted secret decrypts to an empty secret, and the deliveries of its webhook are unsigned.
+func (s *APIV1Service) decryptWebhookSecret(encryptedSecret string) (string, error) {
+	if encryptedSecret == "" {
+		return "", nil
+	}
+	data, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode webhook secret")
+	}
+	gcm, err := s.newWebhookSecretCipher()
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("invalid webhook secret")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	secret, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt webhook secret, the instance secret key may have changed")
+	}
+	return string(secret), nil
+}
+
+func (s *APIV1Service) newWebhookSecretCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte("webhook-secret:" + s.Secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
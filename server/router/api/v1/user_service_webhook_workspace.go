@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// workspaceWebhookParent is the parent of the workspace webhooks. The workspace webhooks are
+// stored in an instance setting, and their deliveries are recorded with the owner ID 0.
+const workspaceWebhookParent = "instance"
+
+// parseUserWebhookParent returns the owner ID of the webhooks of a parent: the ID of a user for
+// users/{user}, or 0 for the workspace webhooks.
+func parseUserWebhookParent(parent string) (int32, error) {
+	if parent == workspaceWebhookParent {
+		return 0, nil
+	}
+	userID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return 0, err
+	}
+	if userID <= 0 {
+		return 0, errors.Errorf("invalid user ID %d", userID)
+	}
+	return userID, nil
+}
+
+// formatUserWebhookParent returns the parent of the webhooks of an owner ID.
+func formatUserWebhookParent(ownerID int32) string {
+	if ownerID == 0 {
+		return workspaceWebhookParent
+	}
+	return fmt.Sprintf("%s%d", UserNamePrefix, ownerID)
+}
+
+// checkUserWebhookPermission checks that the current user can manage the webhooks of an owner.
+// Users manage their own webhooks, admins manage the webhooks of every user, and only the host
+// manages the workspace webhooks, which receive the events of every user.
+func (s *APIV1Service) checkUserWebhookPermission(ctx context.Context, ownerID int32) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if ownerID == 0 {
+		if currentUser.Role != store.RoleHost {
+			return status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		return nil
+	}
+	if currentUser.ID != ownerID && currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// listWebhooks returns the webhooks of an owner ID.
+func (s *APIV1Service) listWebhooks(ctx context.Context, ownerID int32) ([]*storepb.WebhooksUserSetting_Webhook, error) {
+	if ownerID == 0 {
+		return s.Store.GetInstanceWebhooks(ctx)
+	}
+	return s.Store.GetUserWebhooks(ctx, ownerID)
+}
+
+// addWebhook adds a webhook of an owner ID.
+func (s *APIV1Service) addWebhook(ctx context.Context, ownerID int32, hook *storepb.WebhooksUserSetting_Webhook) error {
+	if ownerID == 0 {
+		return s.Store.AddInstanceWebhook(ctx, hook)
+	}
+	return s.Store.AddUserWebhook(ctx, ownerID, hook)
+}
+
+// updateWebhook updates an existing webhook of an owner ID.
+func (s *APIV1Service) updateWebhook(ctx context.Context, ownerID int32, hook *storepb.WebhooksUserSetting_Webhook) error {
+	if ownerID == 0 {
+		return s.Store.UpdateInstanceWebhook(ctx, hook)
+	}
+	return s.Store.UpdateUserWebhook(ctx, ownerID, hook)
+}
+
+// removeWebhook removes a webhook of an owner ID.
+func (s *APIV1Service) removeWebhook(ctx context.Context, ownerID int32, webhookID string) error {
+	if ownerID == 0 {
+		return s.Store.RemoveInstanceWebhook(ctx, webhookID)
+	}
+	return s.Store.RemoveUserWebhook(ctx, ownerID, webhookID)
+}
+
+// redactWebhookMemo returns the memo sent to a workspace webhook. The workspace webhooks receive
+// the events of every user, so the content of private memos is left out unless the webhook
+// includes private content.
+func redactWebhookMemo(hook *storepb.WebhooksUserSetting_Webhook, memo *v1pb.Memo) *v1pb.Memo {
+	if memo.Visibility != v1pb.Visibility_PRIVATE || hook.IncludePrivateContent {
+		return memo
+	}
+	return &v1pb.Memo{
+		Name:       memo.Name,
+		State:      memo.State,
+		Creator:    memo.Creator,
+		Visibility: memo.Visibility,
+	}
+}
@@ -5,20 +5,24 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"sync"
 
 	"connectrpc.com/connect"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/email"
 	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/plugin/webpush"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/ratelimit"
+	"github.com/usememos/memos/server/router/linkpreview"
 	"github.com/usememos/memos/store"
 )
 
@@ -31,6 +35,8 @@ type APIV1Service struct {
 	v1pb.UnimplementedMemoServiceServer
 	v1pb.UnimplementedAttachmentServiceServer
 	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedMemoTemplateServiceServer
+	v1pb.UnimplementedMemoRecurrenceServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
 
@@ -38,25 +44,47 @@ type APIV1Service struct {
 	Profile         *profile.Profile
 	Store           *store.Store
 	MarkdownService markdown.Service
+	EmailSender     email.Sender
+	PushSender      webpush.Sender
+	// LinkPreviewService checks the external links of attachments under the link preview policy
+	// and rate limits.
+	LinkPreviewService *linkpreview.Service
+	// RateLimitService limits the API requests, shared with the gRPC server and the HTTP routes.
+	RateLimitService *ratelimit.Service
 
 	grpcServer *grpc.Server
-
-	// thumbnailSemaphore limits concurrent thumbnail generation to prevent memory exhaustion
-	thumbnailSemaphore *semaphore.Weighted
+	// webhookMutex serializes the updates of the webhooks of the users by their deliveries.
+	webhookMutex sync.Mutex
+	// webhookTestLimiters maps user IDs to the rate limiters of their webhook tests.
+	webhookTestLimiters sync.Map
+	// memoImports maps the IDs of memo imports to their progress.
+	memoImports sync.Map
+	// backupStatus is the status of the backups of the instance.
+	backupStatus backupStatus
+	// webPushMutex serializes the generation of the VAPID keys of the instance.
+	webPushMutex sync.Mutex
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server, rateLimitService *ratelimit.Service) *APIV1Service {
 	grpc.EnableTracing = true
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithMathExtension(),
+		markdown.WithMermaidExtension(),
+		markdown.WithFootnoteExtension(),
+		markdown.WithWikiLinkExtension(),
 	)
 	apiv1Service := &APIV1Service{
-		Secret:             secret,
-		Profile:            profile,
-		Store:              store,
-		MarkdownService:    markdownService,
-		grpcServer:         grpcServer,
-		thumbnailSemaphore: semaphore.NewWeighted(3), // Limit to 3 concurrent thumbnail generations
+		Secret:          secret,
+		Profile:         profile,
+		Store:           store,
+		MarkdownService: markdownService,
+		EmailSender:     email.NewSMTPSender(),
+		PushSender:      webpush.NewHTTPSender(),
+		grpcServer:      grpcServer,
+
+		LinkPreviewService: linkpreview.NewService(store, secret, linkpreview.DefaultConfig()),
+		RateLimitService:   rateLimitService,
 	}
 	grpc_health_v1.RegisterHealthServer(grpcServer, apiv1Service)
 	v1pb.RegisterInstanceServiceServer(grpcServer, apiv1Service)
@@ -65,6 +93,8 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterMemoServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterAttachmentServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterMemoTemplateServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterMemoRecurrenceServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
@@ -92,7 +122,7 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(runtime.WithOutgoingHeaderMatcher(retryAfterHeaderMatcher))
 	if err := v1pb.RegisterInstanceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -111,6 +141,12 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterShortcutServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterMemoTemplateServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterMemoRecurrenceServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterActivityServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -127,9 +163,12 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	// Connect handlers for browser clients (replaces grpc-web).
 	logStacktraces := s.Profile.IsDev()
 	connectInterceptors := connect.WithInterceptors(
+		NewMetricsInterceptor(),
 		NewLoggingInterceptor(logStacktraces),
 		NewRecoveryInterceptor(logStacktraces),
+		NewMetadataInterceptor(),
 		NewAuthInterceptor(s.Store, s.Secret),
+		NewRateLimitInterceptor(s.RateLimitService),
 	)
 	connectMux := http.NewServeMux()
 	connectHandler := NewConnectServiceHandler(s)
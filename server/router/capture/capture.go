@@ -0,0 +1,331 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/profile"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/proto/gen/api/v1/apiv1connect"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxCaptureSize is the maximum size of a capture request, attachments included. The
+	// attachments are also limited by the upload size limit of the instance, and the content by
+	// the content length limit.
+	maxCaptureSize = 64 << 20
+	// maxCaptureAttachments is the maximum number of attachments of a capture.
+	maxCaptureAttachments = 10
+)
+
+// Service creates memos from the capture requests of automation tools, e.g. iOS Shortcuts, IFTTT
+// or curl, which post the content of a memo without using the API. Requests are authenticated
+// with a personal access token with the write scope.
+type Service struct {
+	profile       *profile.Profile
+	store         *store.Store
+	api           *apiv1.APIV1Service
+	authenticator *auth.Authenticator
+}
+
+func NewService(profile *profile.Profile, store *store.Store, api *apiv1.APIV1Service) *Service {
+	return &Service{
+		profile:       profile,
+		store:         store,
+		api:           api,
+		authenticator: auth.NewAuthenticator(store, api.Secret),
+	}
+}
+
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.POST("/api/capture", s.capture)
+}
+
+// captureRequest is a capture request, posted as JSON, as a form, or as the content itself.
+type captureRequest struct {
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+	// Visibility is the visibility of the memo, e.g. "PUBLIC", or the default memo visibility of
+	// the user if it's empty.
+	Visibility  string               `json:"visibility"`
+	Attachments []*captureAttachment `json:"attachments"`
+}
+
+// captureAttachment is a file attached to a capture, e.g. base64 encoded in JSON.
+type captureAttachment struct {
+	Filename string `json:"filename"`
+	Type     string `json:"type"`
+	Content  []byte `json:"content"`
+}
+
+// captureResponse is the response of a capture, with the memo that was created.
+type captureResponse struct {
+	// ID is the ID of the memo, e.g. "abc123" for the memo "memos/abc123".
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// capture creates a memo from a capture request. Like the other memos, the memo dispatches the
+// memo created webhooks of the user.
+func (s *Service) capture(c echo.Context) error {
+	ctx, err := s.authenticate(c)
+	if err != nil {
+		return err
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxCaptureSize)
+	request, err := parseCaptureRequest(c.Request())
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "request too large")
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request").SetInternal(err)
+	}
+
+	memo, err := s.createMemo(ctx, request)
+	if err != nil {
+		code := status.Code(err)
+		if code == codes.Internal || code == codes.Unknown {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to create memo").SetInternal(err)
+		}
+		return echo.NewHTTPError(runtime.HTTPStatusFromCode(code), status.Convert(err).Message())
+	}
+	memoID := strings.TrimPrefix(memo.Name, apiv1.MemoNamePrefix)
+	return c.JSON(http.StatusCreated, &captureResponse{
+		ID:   memoID,
+		Name: memo.Name,
+		URL:  s.getBaseURL(c) + "/memos/" + memoID,
+	})
+}
+
+// authenticate authenticates the personal access token of the Authorization header, which needs
+// the write scope, and returns the context of its user.
+func (s *Service) authenticate(c echo.Context) (context.Context, error) {
+	ctx := c.Request().Context()
+	accessToken := auth.ExtractBearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+	if accessToken == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "access token required")
+	}
+	user, scopes, err := s.authenticator.AuthenticateByJWT(ctx, accessToken)
+	if err != nil || user == nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+	}
+	ctx, err = s.authenticator.AuthorizeAndSetContext(ctx, apiv1connect.MemoServiceCreateMemoProcedure, user, "", accessToken, scopes, nil)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	return ctx, nil
+}
+
+// parseCaptureRequest parses a capture request from its body: JSON, a form, a multipart form
+// with files, or the content itself, e.g. text/plain. The tags and the visibility of bodies
+// holding the content itself can be set with the query parameters.
+//
+// A form without a content field is the content itself too, as curl -d "note" posts a form.
+func parseCaptureRequest(r *http.Request) (*captureRequest, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(echo.HeaderContentType))
+	if err != nil {
+		mediaType = ""
+	}
+	query := r.URL.Query()
+	request := &captureRequest{
+		Tags:       splitTags(query["tags"]),
+		Visibility: query.Get("visibility"),
+	}
+
+	switch mediaType {
+	case echo.MIMEApplicationJSON:
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			return nil, errors.Wrap(err, "invalid JSON")
+		}
+		request.Tags = splitTags(request.Tags)
+	case echo.MIMEMultipartForm:
+		if err := r.ParseMultipartForm(maxCaptureSize); err != nil {
+			return nil, errors.Wrap(err, "invalid multipart form")
+		}
+		defer func() {
+			_ = r.MultipartForm.RemoveAll()
+		}()
+		parseCaptureForm(request, r.MultipartForm.Value)
+		for _, field := range slices.Sorted(maps.Keys(r.MultipartForm.File)) {
+			for _, header := range r.MultipartForm.File[field] {
+				attachment, err := readAttachment(header)
+				if err != nil {
+					return nil, err
+				}
+				request.Attachments = append(request.Attachments, attachment)
+			}
+		}
+	default:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if mediaType == echo.MIMEApplicationForm {
+			if values, err := url.ParseQuery(string(body)); err == nil && values.Has("content") {
+				parseCaptureForm(request, values)
+				break
+			}
+		}
+		request.Content = string(body)
+	}
+	request.Content = strings.TrimSpace(strings.ReplaceAll(request.Content, "\r\n", "\n"))
+	return request, nil
+}
+
+// parseCaptureForm parses the fields of a form, whose tags are repeated or comma separated.
+func parseCaptureForm(request *captureRequest, values url.Values) {
+	if content := values["content"]; len(content) > 0 {
+		request.Content = content[0]
+	}
+	if tags := splitTags(values["tags"]); len(tags) > 0 {
+		request.Tags = tags
+	}
+	if visibility := values["visibility"]; len(visibility) > 0 {
+		request.Visibility = visibility[0]
+	}
+}
+
+func readAttachment(header *multipart.FileHeader) (*captureAttachment, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+	return &captureAttachment{
+		Filename: header.Filename,
+		Type:     header.Header.Get(echo.HeaderContentType),
+		Content:  data,
+	}, nil
+}
+
+// splitTags splits comma separated tags, and trims their # prefix.
+func splitTags(values []string) []string {
+	tags := []string{}
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimPrefix(strings.TrimSpace(tag), "#"); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
+
+// createMemo creates the memo of a capture request as the current user of the context. The
+// attachments are deleted if the memo can't be created.
+func (s *Service) createMemo(ctx context.Context, request *captureRequest) (*v1pb.Memo, error) {
+	content, err := s.api.AppendMemoTags(request.Content, request.Tags)
+	if err != nil {
+		return nil, err
+	}
+	visibility, err := s.getVisibility(ctx, request.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Attachments) > maxCaptureAttachments {
+		return nil, status.Errorf(codes.InvalidArgument, "too many attachments (max %d)", maxCaptureAttachments)
+	}
+	if content == "" && len(request.Attachments) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+
+	attachments := []*v1pb.Attachment{}
+	deleteAttachments := func() {
+		for _, attachment := range attachments {
+			if _, err := s.api.DeleteAttachment(ctx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name}); err != nil {
+				slog.Warn("failed to delete capture attachment", "attachment", attachment.Name, "error", err)
+			}
+		}
+	}
+	for _, item := range request.Attachments {
+		contentType := item.Type
+		if contentType == "" {
+			contentType = http.DetectContentType(item.Content)
+		}
+		attachment, err := s.api.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{
+				Filename: item.Filename,
+				Type:     contentType,
+				Content:  item.Content,
+			},
+		})
+		if err != nil {
+			deleteAttachments()
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	memo, err := s.api.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:     content,
+			Visibility:  visibility,
+			Attachments: attachments,
+		},
+	})
+	if err != nil {
+		deleteAttachments()
+		return nil, err
+	}
+	return memo, nil
+}
+
+// getVisibility returns the visibility of a capture, case-insensitively, or the default memo
+// visibility of the current user if it's empty.
+func (s *Service) getVisibility(ctx context.Context, name string) (v1pb.Visibility, error) {
+	if name != "" {
+		visibility, ok := v1pb.Visibility_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok || visibility == int32(v1pb.Visibility_VISIBILITY_UNSPECIFIED) {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid visibility %q", name)
+		}
+		return v1pb.Visibility(visibility), nil
+	}
+
+	userID := auth.GetUserID(ctx)
+	generalSetting, err := s.store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get user general setting: %v", err)
+	}
+	if visibility, ok := v1pb.Visibility_value[generalSetting.GetGeneral().GetMemoVisibility()]; ok && visibility != int32(v1pb.Visibility_VISIBILITY_UNSPECIFIED) {
+		return v1pb.Visibility(visibility), nil
+	}
+	return v1pb.Visibility_PRIVATE, nil
+}
+
+// getBaseURL returns the URL the links to memos start with: the instance URL if it's set, or the
+// URL the capture is posted to.
+func (s *Service) getBaseURL(c echo.Context) string {
+	if s.profile.InstanceURL != "" {
+		return strings.TrimSuffix(s.profile.InstanceURL, "/")
+	}
+	return c.Scheme() + "://" + c.Request().Host
+}
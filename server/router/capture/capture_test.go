@@ -0,0 +1,168 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	apitest "github.com/usememos/memos/server/router/api/v1/test"
+	"github.com/usememos/memos/store"
+)
+
+type testService struct {
+	e     *echo.Echo
+	store *store.Store
+	user  *store.User
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	fixture := apitest.NewTestService(t)
+	t.Cleanup(fixture.Cleanup)
+	fixture.Profile.InstanceURL = "https://memos.example.com"
+	user, err := fixture.CreateRegularUser(context.Background(), "user")
+	require.NoError(t, err)
+
+	e := echo.New()
+	NewService(fixture.Profile, fixture.Store, fixture.Service).RegisterRoutes(e.Group(""))
+	return &testService{e: e, store: fixture.Store, user: user}
+}
+
+// createAccessToken creates a personal access token of the user with the scopes.
+func (ts *testService) createAccessToken(t *testing.T, scopes ...string) string {
+	t.Helper()
+	ctx := context.Background()
+	api := &apiv1.APIV1Service{Secret: "test-secret", Store: ts.store}
+	token, err := auth.NewAuthenticator(ts.store, "test-secret").GenerateAccessToken(ctx, ts.user.Username, ts.user.ID, scopes, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, api.UpsertAccessTokenToStore(ctx, ts.user, token, "capture", scopes))
+	return token
+}
+
+func (ts *testService) post(token, target, contentType string, body []byte) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	if contentType != "" {
+		request.Header.Set(echo.HeaderContentType, contentType)
+	}
+	if token != "" {
+		request.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	ts.e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// getMemo returns the memo of a successful capture response.
+func (ts *testService) getMemo(t *testing.T, recorder *httptest.ResponseRecorder) *store.Memo {
+	t.Helper()
+	require.Equal(t, http.StatusCreated, recorder.Code, recorder.Body.String())
+	response := &captureResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+	require.Equal(t, "memos/"+response.ID, response.Name)
+	require.Equal(t, "https://memos.example.com/memos/"+response.ID, response.URL)
+	memo, err := ts.store.GetMemo(context.Background(), &store.FindMemo{UID: &response.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+	require.Equal(t, ts.user.ID, memo.CreatorID)
+	return memo
+}
+
+func TestCapture(t *testing.T) {
+	ts := newTestService(t)
+	token := ts.createAccessToken(t)
+
+	// curl -d "note" posts a form, whose body is the content.
+	memo := ts.getMemo(t, ts.post(token, "/api/capture", echo.MIMEApplicationForm, []byte("Buy milk & eggs")))
+	require.Equal(t, "Buy milk & eggs", memo.Content)
+	require.Equal(t, store.Private, memo.Visibility)
+
+	memo = ts.getMemo(t, ts.post(token, "/api/capture?tags=inbox,%23todo&visibility=protected", echo.MIMETextPlain, []byte("Call Bob\r\n")))
+	require.Equal(t, "Call Bob\n\n#inbox #todo", memo.Content)
+	require.Equal(t, store.Protected, memo.Visibility)
+
+	form := url.Values{"content": {"Form memo #inbox"}, "tags": {"inbox", "later"}, "visibility": {"PUBLIC"}}
+	memo = ts.getMemo(t, ts.post(token, "/api/capture", echo.MIMEApplicationForm, []byte(form.Encode())))
+	require.Equal(t, "Form memo #inbox\n\n#later", memo.Content)
+	require.Equal(t, store.Public, memo.Visibility)
+
+	body, err := json.Marshal(map[string]any{
+		"content": "JSON memo",
+		"tags":    []string{"#json"},
+		"attachments": []map[string]any{
+			{"filename": "note.txt", "type": "text/plain", "content": []byte("attached")},
+		},
+	})
+	require.NoError(t, err)
+	memo = ts.getMemo(t, ts.post(token, "/api/capture", echo.MIMEApplicationJSON, body))
+	require.Equal(t, "JSON memo\n\n#json", memo.Content)
+	attachments, err := ts.store.ListAttachments(context.Background(), &store.FindAttachment{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, "note.txt", attachments[0].Filename)
+	require.Equal(t, int64(len("attached")), attachments[0].Size)
+
+	multipartBody := &bytes.Buffer{}
+	writer := multipart.NewWriter(multipartBody)
+	require.NoError(t, writer.WriteField("content", "Photo"))
+	part, err := writer.CreateFormFile("file", "photo.png")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("\x89PNG\r\n\x1a\nimage"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	memo = ts.getMemo(t, ts.post(token, "/api/capture", writer.FormDataContentType(), multipartBody.Bytes()))
+	require.Equal(t, "Photo", memo.Content)
+	attachments, err = ts.store.ListAttachments(context.Background(), &store.FindAttachment{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, "photo.png", attachments[0].Filename)
+}
+
+func TestCaptureDefaultVisibility(t *testing.T) {
+	ts := newTestService(t)
+	token := ts.createAccessToken(t)
+	_, err := ts.store.UpsertUserSetting(context.Background(), &storepb.UserSetting{
+		UserId: ts.user.ID,
+		Key:    storepb.UserSetting_GENERAL,
+		Value:  &storepb.UserSetting_General{General: &storepb.GeneralUserSetting{MemoVisibility: "PROTECTED"}},
+	})
+	require.NoError(t, err)
+
+	memo := ts.getMemo(t, ts.post(token, "/api/capture", echo.MIMETextPlain, []byte("Default")))
+	require.Equal(t, store.Protected, memo.Visibility)
+}
+
+func TestCaptureErrors(t *testing.T) {
+	ts := newTestService(t)
+	token := ts.createAccessToken(t)
+
+	require.Equal(t, http.StatusUnauthorized, ts.post("", "/api/capture", echo.MIMETextPlain, []byte("note")).Code)
+	require.Equal(t, http.StatusUnauthorized, ts.post("invalid", "/api/capture", echo.MIMETextPlain, []byte("note")).Code)
+	// Read-only tokens can't create memos.
+	readToken := ts.createAccessToken(t, auth.ScopeRead)
+	require.Equal(t, http.StatusForbidden, ts.post(readToken, "/api/capture", echo.MIMETextPlain, []byte("note")).Code)
+
+	require.Equal(t, http.StatusBadRequest, ts.post(token, "/api/capture", echo.MIMETextPlain, []byte("  ")).Code)
+	require.Equal(t, http.StatusBadRequest, ts.post(token, "/api/capture", echo.MIMEApplicationJSON, []byte("{")).Code)
+	require.Equal(t, http.StatusBadRequest, ts.post(token, "/api/capture?visibility=secret", echo.MIMETextPlain, []byte("note")).Code)
+	require.Equal(t, http.StatusBadRequest, ts.post(token, "/api/capture?tags=two%20words", echo.MIMETextPlain, []byte("note")).Code)
+
+	// The content length limit of the instance applies.
+	require.Equal(t, http.StatusBadRequest, ts.post(token, "/api/capture", echo.MIMETextPlain, []byte(strings.Repeat("a", store.DefaultContentLengthLimit+1))).Code)
+
+	memos, err := ts.store.ListMemos(context.Background(), &store.FindMemo{CreatorID: &ts.user.ID})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+}
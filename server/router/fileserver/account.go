@@ -0,0 +1,379 @@
+package fileserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// accountDumpVersion is the version of the format of account dumps. It's incremented when the
+// format changes in a way that older versions can't import.
+const accountDumpVersion = 1
+
+// accountDumpContentType is the content type of account dumps, which are NDJSON: one JSON
+// record per line.
+const accountDumpContentType = "application/x-ndjson"
+
+// The types of the records of account dumps. A dump starts with its header, followed by the
+// settings, memos, library attachments, relations and reactions of the account.
+const (
+	accountRecordHeader     = "header"
+	accountRecordSetting    = "setting"
+	accountRecordMemo       = "memo"
+	accountRecordAttachment = "attachment"
+	accountRecordRelation   = "relation"
+	accountRecordReaction   = "reaction"
+)
+
+// accountDumpSettingKeys are the user settings of account dumps. The sessions, access tokens,
+// passkeys, two-factor and feed token settings are credentials, and the storage quota is set by
+// the host, so they're never dumped.
+var accountDumpSettingKeys = []storepb.UserSetting_Key{
+	storepb.UserSetting_GENERAL,
+	storepb.UserSetting_SHORTCUTS,
+	storepb.UserSetting_WEBHOOKS,
+	storepb.UserSetting_MEMO_TEMPLATES,
+	storepb.UserSetting_AUTO_ARCHIVE,
+}
+
+// accountRecord is a line of an account dump, with the field of its type set.
+type accountRecord struct {
+	Type       string             `json:"type"`
+	Header     *accountHeader     `json:"header,omitempty"`
+	Setting    json.RawMessage    `json:"setting,omitempty"`
+	Memo       *accountMemo       `json:"memo,omitempty"`
+	Attachment *accountAttachment `json:"attachment,omitempty"`
+	Relation   *accountRelation   `json:"relation,omitempty"`
+	Reaction   *accountReaction   `json:"reaction,omitempty"`
+}
+
+// accountHeader is the first record of an account dump.
+type accountHeader struct {
+	// Version is the version of the format of the dump.
+	Version    int       `json:"version"`
+	ExportTime time.Time `json:"exportTime"`
+	Username   string    `json:"username"`
+	// IncludesContent is whether the attachments carry their content.
+	IncludesContent bool `json:"includesContent"`
+}
+
+// accountMemo is a memo of an account dump. Its ID is its UID on the exporting instance, which
+// stays stable across exports and identifies the memo across imports.
+type accountMemo struct {
+	ID              string               `json:"id"`
+	Content         string               `json:"content"`
+	Visibility      store.Visibility     `json:"visibility"`
+	State           store.RowStatus      `json:"state"`
+	Pinned          bool                 `json:"pinned"`
+	CreateTime      time.Time            `json:"createTime"`
+	UpdateTime      time.Time            `json:"updateTime"`
+	DeleteTime      *time.Time           `json:"deleteTime,omitempty"`
+	DisableComments bool                 `json:"disableComments,omitempty"`
+	Location        *accountLocation     `json:"location,omitempty"`
+	Attachments     []*accountAttachment `json:"attachments,omitempty"`
+}
+
+type accountLocation struct {
+	Placeholder  string  `json:"placeholder"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	ShowPublicly bool    `json:"showPublicly"`
+}
+
+// accountAttachment is an attachment of an account dump, identified by its UID on the exporting
+// instance. External attachments carry their link, the others carry their content if the dump
+// includes it.
+type accountAttachment struct {
+	ID           string    `json:"id"`
+	Filename     string    `json:"filename"`
+	Type         string    `json:"type"`
+	Size         int64     `json:"size"`
+	CreateTime   time.Time `json:"createTime"`
+	ExternalLink string    `json:"externalLink,omitempty"`
+	Content      []byte    `json:"content,omitempty"`
+}
+
+// accountRelation is a relation between two memos of an account dump, by their IDs.
+type accountRelation struct {
+	Memo        string                 `json:"memo"`
+	RelatedMemo string                 `json:"relatedMemo"`
+	Type        store.MemoRelationType `json:"type"`
+}
+
+// accountReaction is a reaction of the account to one of its memos, by its ID.
+type accountReaction struct {
+	Memo         string    `json:"memo"`
+	ReactionType string    `json:"reactionType"`
+	CreateTime   time.Time `json:"createTime"`
+}
+
+// exportAccount streams a dump of the account of the current user: its settings, its memos in
+// every state with their attachments, its library attachments, and the relations and reactions
+// between its memos. The content of the attachments is included with content=true.
+func (s *FileServerService) exportAccount(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.getCurrentUser(ctx, c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get current user").SetInternal(err)
+	}
+	if user == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized access")
+	}
+	includeContent, _ := strconv.ParseBool(c.QueryParam("content"))
+
+	filename := fmt.Sprintf("memos-account-%s-%s.ndjson", user.Username, time.Now().UTC().Format("20060102"))
+	c.Response().Header().Set(echo.HeaderContentType, accountDumpContentType)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+	c.Response().WriteHeader(http.StatusOK)
+
+	if err := s.writeAccountDump(ctx, c.Response(), user, includeContent); err != nil {
+		// The response has started, so the dump is left incomplete, and fails to import past the
+		// last complete record.
+		c.Logger().Errorf("failed to export account: %v", err)
+	}
+	return nil
+}
+
+// writeAccountDump writes the dump of the account of a user, a record per line.
+func (s *FileServerService) writeAccountDump(ctx context.Context, w io.Writer, user *store.User, includeContent bool) error {
+	encoder := json.NewEncoder(w)
+	header := &accountHeader{
+		Version:         accountDumpVersion,
+		ExportTime:      time.Now().UTC(),
+		Username:        user.Username,
+		IncludesContent: includeContent,
+	}
+	if err := encoder.Encode(&accountRecord{Type: accountRecordHeader, Header: header}); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+
+	settings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{UserID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list user settings")
+	}
+	for _, setting := range settings {
+		if !slices.Contains(accountDumpSettingKeys, setting.Key) {
+			continue
+		}
+		setting = proto.CloneOf(setting)
+		setting.UserId = 0
+		// The secrets of webhooks are encrypted with the secret key of the instance, so they're
+		// useless elsewhere.
+		for _, webhook := range setting.GetWebhooks().GetWebhooks() {
+			webhook.EncryptedSecret = ""
+			webhook.ConsecutiveFailures = 0
+		}
+		data, err := protojson.Marshal(setting)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal user setting")
+		}
+		if err := encoder.Encode(&accountRecord{Type: accountRecordSetting, Setting: data}); err != nil {
+			return errors.Wrap(err, "failed to write user setting")
+		}
+	}
+
+	memos, err := s.writeAccountMemos(ctx, encoder, user, includeContent)
+	if err != nil {
+		return err
+	}
+	memoUIDs := make(map[int32]string, len(memos))
+	for _, memo := range memos {
+		memoUIDs[memo.ID] = memo.UID
+	}
+
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{CreatorID: &user.ID, WithoutRelatedMemo: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	for _, attachment := range attachments {
+		if !attachment.Library {
+			continue
+		}
+		dumped, err := s.dumpAttachment(ctx, attachment, includeContent)
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(&accountRecord{Type: accountRecordAttachment, Attachment: dumped}); err != nil {
+			return errors.Wrap(err, "failed to write attachment")
+		}
+	}
+
+	// The relations and reactions come last, so that their memos are imported before them.
+	for _, memo := range memos {
+		relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo relations")
+		}
+		for _, relation := range relations {
+			// The relations to the memos of other users are dropped.
+			relatedMemoUID, ok := memoUIDs[relation.RelatedMemoID]
+			if !ok {
+				continue
+			}
+			dumped := &accountRelation{
+				Memo:        memo.UID,
+				RelatedMemo: relatedMemoUID,
+				Type:        relation.Type,
+			}
+			if err := encoder.Encode(&accountRecord{Type: accountRecordRelation, Relation: dumped}); err != nil {
+				return errors.Wrap(err, "failed to write memo relation")
+			}
+		}
+	}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{CreatorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		memoUID, ok := extractReactionMemoUID(reaction.ContentID)
+		if !ok || !slices.ContainsFunc(memos, func(memo *store.Memo) bool { return memo.UID == memoUID }) {
+			continue
+		}
+		dumped := &accountReaction{
+			Memo:         memoUID,
+			ReactionType: reaction.ReactionType,
+			CreateTime:   time.Unix(reaction.CreatedTs, 0).UTC(),
+		}
+		if err := encoder.Encode(&accountRecord{Type: accountRecordReaction, Reaction: dumped}); err != nil {
+			return errors.Wrap(err, "failed to write reaction")
+		}
+	}
+	return nil
+}
+
+// writeAccountMemos writes the memos of a user in every state, oldest first, in batches. It
+// returns the written memos, without their content.
+func (s *FileServerService) writeAccountMemos(ctx context.Context, encoder *json.Encoder, user *store.User, includeContent bool) ([]*store.Memo, error) {
+	limit := exportBatchSize
+	find := &store.FindMemo{
+		CreatorID:        &user.ID,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
+		IncludeExpired:   true,
+		OrderByTimeAsc:   true,
+		Limit:            &limit,
+	}
+	written := []*store.Memo{}
+	for {
+		memos, err := s.Store.ListMemos(ctx, find)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			return written, nil
+		}
+
+		memoIDs := make([]int32, 0, len(memos))
+		for _, memo := range memos {
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list attachments")
+		}
+		attachmentsByMemoID := map[int32][]*store.Attachment{}
+		// The attachments are listed newest first, and dumped in their order in the memo.
+		for _, attachment := range slices.Backward(attachments) {
+			if attachment.MemoID != nil {
+				attachmentsByMemoID[*attachment.MemoID] = append(attachmentsByMemoID[*attachment.MemoID], attachment)
+			}
+		}
+
+		for _, memo := range memos {
+			dumped := &accountMemo{
+				ID:              memo.UID,
+				Content:         memo.Content,
+				Visibility:      memo.Visibility,
+				State:           memo.RowStatus,
+				Pinned:          memo.Pinned,
+				CreateTime:      time.Unix(memo.CreatedTs, 0).UTC(),
+				UpdateTime:      time.Unix(memo.UpdatedTs, 0).UTC(),
+				DisableComments: memo.Payload.GetDisableComments(),
+			}
+			if memo.DeletedTs != 0 {
+				deleteTime := time.Unix(memo.DeletedTs, 0).UTC()
+				dumped.DeleteTime = &deleteTime
+			}
+			if location := memo.Payload.GetLocation(); location != nil {
+				dumped.Location = &accountLocation{
+					Placeholder:  location.Placeholder,
+					Latitude:     location.Latitude,
+					Longitude:    location.Longitude,
+					ShowPublicly: location.ShowPublicly,
+				}
+			}
+			for _, attachment := range attachmentsByMemoID[memo.ID] {
+				dumpedAttachment, err := s.dumpAttachment(ctx, attachment, includeContent)
+				if err != nil {
+					return nil, err
+				}
+				dumped.Attachments = append(dumped.Attachments, dumpedAttachment)
+			}
+			if err := encoder.Encode(&accountRecord{Type: accountRecordMemo, Memo: dumped}); err != nil {
+				return nil, errors.Wrap(err, "failed to write memo")
+			}
+			written = append(written, &store.Memo{ID: memo.ID, UID: memo.UID})
+		}
+		if len(memos) < exportBatchSize {
+			return written, nil
+		}
+		find.Cursor = store.NewMemoCursor(memos[len(memos)-1], find)
+	}
+}
+
+// dumpAttachment returns an attachment of an account dump, loading its content if requested.
+func (s *FileServerService) dumpAttachment(ctx context.Context, attachment *store.Attachment, includeContent bool) (*accountAttachment, error) {
+	dumped := &accountAttachment{
+		ID:         attachment.UID,
+		Filename:   attachment.Filename,
+		Type:       attachment.Type,
+		Size:       attachment.Size,
+		CreateTime: time.Unix(attachment.CreatedTs, 0).UTC(),
+	}
+	if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+		dumped.ExternalLink = attachment.Reference
+		return dumped, nil
+	}
+	if !includeContent {
+		return dumped, nil
+	}
+	if attachment.StorageType != storepb.AttachmentStorageType_LOCAL && attachment.StorageType != storepb.AttachmentStorageType_S3 {
+		withBlob, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get attachment")
+		}
+		if withBlob != nil {
+			attachment = withBlob
+		}
+	}
+	content, err := s.openAttachmentContent(attachment)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open attachment %s", attachment.UID)
+	}
+	defer content.Close()
+	if dumped.Content, err = io.ReadAll(content); err != nil {
+		return nil, errors.Wrapf(err, "failed to read attachment %s", attachment.UID)
+	}
+	return dumped, nil
+}
+
+// extractReactionMemoUID returns the UID of the memo of a reaction, from its content ID.
+func extractReactionMemoUID(contentID string) (string, bool) {
+	memoUID, ok := strings.CutPrefix(contentID, "memos/")
+	return memoUID, ok && memoUID != ""
+}
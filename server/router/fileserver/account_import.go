@@ -0,0 +1,532 @@
+package fileserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxAccountDumpSize is the maximum size in bytes of an imported account dump.
+	maxAccountDumpSize = 1 << 30
+	// maxAccountDumpRows is the maximum number of records and attachments of an imported account
+	// dump.
+	maxAccountDumpRows = 100_000
+)
+
+// accountImportResult is the response of an account import.
+type accountImportResult struct {
+	CreatedMemos       int `json:"createdMemos"`
+	SkippedMemos       int `json:"skippedMemos"`
+	CreatedAttachments int `json:"createdAttachments"`
+	SkippedAttachments int `json:"skippedAttachments"`
+	Relations          int `json:"relations"`
+	Reactions          int `json:"reactions"`
+	Settings           int `json:"settings"`
+	// Errors are the records that failed to import, which don't abort the import of the others.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// accountImporter imports the records of an account dump as the data of the importing user.
+type accountImporter struct {
+	s               *FileServerService
+	user            *store.User
+	markdownService markdown.Service
+	memoSetting     *storepb.InstanceMemoRelatedSetting
+	storageSetting  *storepb.InstanceStorageSetting
+	result          *accountImportResult
+	// memos maps the IDs of the memos of the dump to the memos they're imported as.
+	memos map[string]*store.Memo
+	// existingMemos maps the UIDs and the import hashes of the memos of the user to the memos.
+	existingMemos map[string]*store.Memo
+	rows          int
+}
+
+// importAccount imports an account dump as the data of the current user, who owns every
+// imported memo, attachment and reaction. The records are identified by the IDs of the dump, so
+// that an import run again, e.g. after a failure, skips the records already imported. The IDs
+// of the dump are kept when they're free, and replaced otherwise, with the links of the memos to
+// their attachments rewritten.
+func (s *FileServerService) importAccount(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.getCurrentUserWithScope(ctx, c, auth.ScopeWrite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get current user").SetInternal(err)
+	}
+	if user == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized access")
+	}
+	// Cross-site forms can't send JSON, so dumps can't be imported with the session cookie of an
+	// unsuspecting user.
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != accountDumpContentType && mediaType != echo.MIMEApplicationJSON {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, fmt.Sprintf("expected %s content", accountDumpContentType))
+	}
+
+	importer, err := s.newAccountImporter(ctx, user)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to prepare import").SetInternal(err)
+	}
+	decoder := json.NewDecoder(http.MaxBytesReader(c.Response(), c.Request().Body, maxAccountDumpSize))
+	for line := 1; ; line++ {
+		record := &accountRecord{}
+		if err := decoder.Decode(record); err != nil {
+			if errors.Is(err, io.EOF) && line > 1 {
+				break
+			}
+			var maxBytesError *http.MaxBytesError
+			if errors.As(err, &maxBytesError) {
+				return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("dump too large (max %d bytes)", maxAccountDumpSize))
+			}
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid record %d: %v", line, err))
+		}
+		if line == 1 {
+			if record.Type != accountRecordHeader || record.Header == nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "the dump must start with a header")
+			}
+			if record.Header.Version < 1 || record.Header.Version > accountDumpVersion {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unsupported dump version %d", record.Header.Version))
+			}
+			continue
+		}
+		importer.rows += 1 + len(record.GetAttachments())
+		if importer.rows > maxAccountDumpRows {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("dump too large (max %d rows)", maxAccountDumpRows))
+		}
+		if err := importer.importRecord(ctx, record); err != nil {
+			importer.result.Errors = append(importer.result.Errors, fmt.Sprintf("record %d: %v", line, err))
+		}
+	}
+	return c.JSON(http.StatusOK, importer.result)
+}
+
+// GetAttachments returns the attachments of a record, of its memo or the attachment itself.
+func (r *accountRecord) GetAttachments() []*accountAttachment {
+	if r.Memo != nil {
+		return r.Memo.Attachments
+	}
+	if r.Attachment != nil {
+		return []*accountAttachment{r.Attachment}
+	}
+	return nil
+}
+
+func (s *FileServerService) newAccountImporter(ctx context.Context, user *store.User) (*accountImporter, error) {
+	memoSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	storageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance storage setting")
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &user.ID,
+		ExcludeContent:   true,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
+		IncludeExpired:   true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	existingMemos := map[string]*store.Memo{}
+	for _, memo := range memos {
+		existingMemos[memo.UID] = memo
+		if importHash := memo.Payload.GetImportHash(); importHash != "" {
+			existingMemos[importHash] = memo
+		}
+	}
+	return &accountImporter{
+		s:               s,
+		user:            user,
+		markdownService: markdown.NewService(markdown.WithTagExtension(), markdown.WithMathExtension(), markdown.WithMermaidExtension(), markdown.WithFootnoteExtension(), markdown.WithWikiLinkExtension()),
+		memoSetting:     memoSetting,
+		storageSetting:  storageSetting,
+		result:          &accountImportResult{},
+		memos:           map[string]*store.Memo{},
+		existingMemos:   existingMemos,
+	}, nil
+}
+
+func (i *accountImporter) importRecord(ctx context.Context, record *accountRecord) error {
+	switch {
+	case record.Type == accountRecordSetting && record.Setting != nil:
+		return i.importSetting(ctx, record.Setting)
+	case record.Type == accountRecordMemo && record.Memo != nil:
+		return i.importMemo(ctx, record.Memo)
+	case record.Type == accountRecordAttachment && record.Attachment != nil:
+		created, err := i.importAttachment(ctx, record.Attachment, true)
+		if err == nil && created == nil {
+			i.result.SkippedAttachments++
+		}
+		return err
+	case record.Type == accountRecordRelation && record.Relation != nil:
+		return i.importRelation(ctx, record.Relation)
+	case record.Type == accountRecordReaction && record.Reaction != nil:
+		return i.importReaction(ctx, record.Reaction)
+	default:
+		return errors.Errorf("invalid %q record", record.Type)
+	}
+}
+
+// accountImportHash returns the import hash of the memos imported from an account dump, from
+// their ID in the dump.
+func accountImportHash(memoID string) string {
+	hash := sha256.Sum256([]byte("account:" + memoID))
+	return hex.EncodeToString(hash[:])
+}
+
+// importMemo imports a memo with its attachments, unless it was already imported. The memo
+// keeps its visibility if it's allowed on the instance and by the memo quotas of the user, and
+// becomes private otherwise. The memo counts against the memo quotas of the user.
+func (i *accountImporter) importMemo(ctx context.Context, dumped *accountMemo) error {
+	if dumped.ID == "" {
+		return errors.New("memo without an ID")
+	}
+	importHash := accountImportHash(dumped.ID)
+	if existing, ok := i.existingMemos[dumped.ID]; ok {
+		i.memos[dumped.ID] = existing
+		i.result.SkippedMemos++
+		return nil
+	}
+	if existing, ok := i.existingMemos[importHash]; ok {
+		i.memos[dumped.ID] = existing
+		i.result.SkippedMemos++
+		return nil
+	}
+	if len(dumped.Content) > int(i.memoSetting.ContentLengthLimit) {
+		return errors.Errorf("memo %q: content too long (max %d characters)", dumped.ID, i.memoSetting.ContentLengthLimit)
+	}
+
+	uid, err := i.freeMemoUID(ctx, dumped.ID)
+	if err != nil {
+		return err
+	}
+	create := &store.Memo{
+		UID:        uid,
+		CreatorID:  i.user.ID,
+		Visibility: store.Private,
+		RowStatus:  store.Normal,
+	}
+	if dumped.Visibility == store.Protected || (dumped.Visibility == store.Public && !i.memoSetting.DisallowPublicVisibility) {
+		create.Visibility = dumped.Visibility
+	}
+	if dumped.State == store.Archived || dumped.State == store.Draft {
+		create.RowStatus = dumped.State
+	}
+	create.Visibility, err = apiv1.GetImportedMemoVisibility(ctx, i.s.Store, i.user, create.Visibility)
+	if err != nil {
+		return err
+	}
+	// The memo is checked before its attachments are imported, so that a memo over the quota
+	// doesn't leave them behind.
+	if err := apiv1.CheckMemoQuota(ctx, i.s.Store, i.user, create.Visibility); err != nil {
+		return errors.Errorf("memo %q: %v", dumped.ID, status.Convert(err).Message())
+	}
+
+	// The attachments are imported first, so that the links to the attachments whose IDs are
+	// taken are rewritten.
+	content := dumped.Content
+	attachments := []*store.Attachment{}
+	for _, dumpedAttachment := range dumped.Attachments {
+		attachment, err := i.importAttachment(ctx, dumpedAttachment, false)
+		if err != nil {
+			i.result.Errors = append(i.result.Errors, fmt.Sprintf("memo %q: %v", dumped.ID, err))
+			continue
+		}
+		if attachment == nil {
+			i.result.SkippedAttachments++
+			continue
+		}
+		if attachment.UID != dumpedAttachment.ID {
+			content = strings.ReplaceAll(content, "/file/attachments/"+dumpedAttachment.ID+"/", "/file/attachments/"+attachment.UID+"/")
+		}
+		attachments = append(attachments, attachment)
+	}
+	create.Content = content
+	if err := memopayload.RebuildMemoPayload(create, i.markdownService); err != nil {
+		return errors.Wrap(err, "failed to rebuild memo payload")
+	}
+	create.Payload.DisableComments = dumped.DisableComments
+	create.Payload.ImportHash = importHash
+	if location := dumped.Location; location != nil && location.Latitude >= -90 && location.Latitude <= 90 && location.Longitude >= -180 && location.Longitude <= 180 {
+		create.Payload.Location = &storepb.MemoPayload_Location{
+			Placeholder:  location.Placeholder,
+			Latitude:     location.Latitude,
+			Longitude:    location.Longitude,
+			ShowPublicly: location.ShowPublicly,
+		}
+	}
+	memo, err := i.s.Store.CreateMemo(ctx, create)
+	if err != nil {
+		return errors.Wrap(err, "failed to create memo")
+	}
+	apiv1.RecordMemoQuotaUsage(ctx, i.s.Store, i.user.ID, memo.Visibility)
+
+	update := &store.UpdateMemo{ID: memo.ID}
+	if !dumped.CreateTime.IsZero() {
+		createdTsSec := dumped.CreateTime.Unix()
+		updatedTsSec := max(dumped.UpdateTime.Unix(), createdTsSec)
+		update.CreatedTs, update.UpdatedTs = &createdTsSec, &updatedTsSec
+	}
+	if dumped.DeleteTime != nil {
+		deletedTsSec := dumped.DeleteTime.Unix()
+		update.DeletedTs = &deletedTsSec
+	}
+	if err := i.s.Store.UpdateMemo(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	if dumped.Pinned && create.RowStatus == store.Normal {
+		if err := i.s.Store.PinMemo(ctx, &store.PinMemo{ID: memo.ID, CreatorID: memo.CreatorID, Pinned: true}); err != nil {
+			return errors.Wrap(err, "failed to pin memo")
+		}
+	}
+	for _, attachment := range attachments {
+		if err := i.s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{ID: attachment.ID, MemoID: &memo.ID}); err != nil {
+			return errors.Wrap(err, "failed to attach attachment")
+		}
+	}
+	i.memos[dumped.ID] = memo
+	i.existingMemos[dumped.ID] = memo
+	i.result.CreatedMemos++
+	return nil
+}
+
+// freeMemoUID returns the UID of a memo of the dump if no memo has it, or a new UID.
+func (i *accountImporter) freeMemoUID(ctx context.Context, uid string) (string, error) {
+	if len(uid) <= 32 {
+		existing, err := i.s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid, IncludeTrashed: true, IncludeScheduled: true, IncludeExpired: true})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get memo")
+		}
+		if existing == nil && base.UIDMatcher.MatchString(uid) {
+			return uid, nil
+		}
+	}
+	return shortuuid.New(), nil
+}
+
+// importAttachment imports an attachment of the dump, unless the user already has it, with its
+// content or external link. It returns nil if the attachment was skipped. The attachments of
+// memos are attached by the caller once their memo is created.
+func (i *accountImporter) importAttachment(ctx context.Context, dumped *accountAttachment, library bool) (*store.Attachment, error) {
+	if dumped.ID == "" {
+		return nil, errors.New("attachment without an ID")
+	}
+	existing, err := i.s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &dumped.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attachment")
+	}
+	if existing != nil && existing.CreatorID == i.user.ID {
+		return nil, nil
+	}
+	// Attachments without their content can't be imported.
+	if dumped.ExternalLink == "" && dumped.Content == nil {
+		return nil, nil
+	}
+	// Library attachments whose ID was taken are imported with a new ID, so they're matched by
+	// their content when imported again.
+	if library && dumped.Content != nil {
+		contentHash := store.HashAttachmentBlob(dumped.Content)
+		duplicates, err := i.s.Store.ListAttachments(ctx, &store.FindAttachment{CreatorID: &i.user.ID, ContentHash: &contentHash, Filename: &dumped.Filename})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list attachments")
+		}
+		if len(duplicates) > 0 {
+			return nil, nil
+		}
+	}
+
+	filename := path.Base(strings.ReplaceAll(dumped.Filename, "\\", "/"))
+	if filename == "." || filename == "/" || filename == ".." || strings.HasPrefix(filename, ".") {
+		filename = "file"
+	}
+	create := &store.Attachment{
+		UID:       dumped.ID,
+		CreatorID: i.user.ID,
+		Filename:  filename,
+		Type:      dumped.Type,
+		Library:   library,
+	}
+	if existing != nil || !base.UIDMatcher.MatchString(create.UID) {
+		create.UID = shortuuid.New()
+	}
+	if _, _, err := mime.ParseMediaType(create.Type); err != nil {
+		create.Type = "application/octet-stream"
+	}
+
+	if dumped.ExternalLink != "" {
+		link, err := url.Parse(dumped.ExternalLink)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+			return nil, errors.Errorf("attachment %q: invalid external link", dumped.ID)
+		}
+		create.StorageType = storepb.AttachmentStorageType_EXTERNAL
+		create.Reference = link.String()
+	} else {
+		if int64(len(dumped.Content)) > i.storageSetting.UploadSizeLimitMb*apiv1.MebiByte {
+			return nil, errors.Errorf("attachment %q: file size exceeds the limit", dumped.ID)
+		}
+		if err := i.checkStorageQuota(ctx, int64(len(dumped.Content))); err != nil {
+			return nil, errors.Wrapf(err, "attachment %q", dumped.ID)
+		}
+		create.Blob = dumped.Content
+		create.Size = int64(len(dumped.Content))
+		create.ContentHash = store.HashAttachmentBlob(dumped.Content)
+		if err := apiv1.SaveAttachmentBlob(ctx, i.s.Profile, i.s.Store, create); err != nil {
+			return nil, errors.Wrap(err, "failed to save attachment blob")
+		}
+	}
+	attachment, err := i.s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create attachment")
+	}
+	i.result.CreatedAttachments++
+	return attachment, nil
+}
+
+// checkStorageQuota returns an error if the storage quota of the user can't fit the size.
+func (i *accountImporter) checkStorageQuota(ctx context.Context, sizeBytes int64) error {
+	quotaBytes, err := i.s.Store.GetUserStorageQuota(ctx, i.user.ID)
+	if err != nil || quotaBytes <= 0 {
+		return err
+	}
+	usedBytes, err := i.s.Store.GetUserStorageUsage(ctx, i.user.ID)
+	if err != nil {
+		return err
+	}
+	if usedBytes+sizeBytes > quotaBytes {
+		return errors.Errorf("storage quota exceeded: %d of %d bytes used", usedBytes, quotaBytes)
+	}
+	return nil
+}
+
+// importRelation imports a relation between two memos of the dump.
+func (i *accountImporter) importRelation(ctx context.Context, relation *accountRelation) error {
+	memo, related := i.memos[relation.Memo], i.memos[relation.RelatedMemo]
+	if memo == nil || related == nil {
+		return errors.Errorf("relation between unknown memos %q and %q", relation.Memo, relation.RelatedMemo)
+	}
+	if relation.Type != store.MemoRelationReference && relation.Type != store.MemoRelationComment && relation.Type != store.MemoRelationMerge {
+		return errors.Errorf("invalid relation type %q", relation.Type)
+	}
+	if _, err := i.s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: memo.ID, RelatedMemoID: related.ID, Type: relation.Type}); err != nil {
+		return errors.Wrap(err, "failed to upsert memo relation")
+	}
+	i.result.Relations++
+	return nil
+}
+
+// importReaction imports a reaction of the user to a memo of the dump.
+func (i *accountImporter) importReaction(ctx context.Context, reaction *accountReaction) error {
+	memo := i.memos[reaction.Memo]
+	if memo == nil {
+		return errors.Errorf("reaction to unknown memo %q", reaction.Memo)
+	}
+	if reaction.ReactionType == "" || len(reaction.ReactionType) > 64 {
+		return errors.Errorf("invalid reaction type %q", reaction.ReactionType)
+	}
+	upsert := &store.Reaction{
+		CreatorID:    i.user.ID,
+		ContentID:    "memos/" + memo.UID,
+		ReactionType: reaction.ReactionType,
+	}
+	if _, err := i.s.Store.UpsertReaction(ctx, upsert); err != nil {
+		return errors.Wrap(err, "failed to upsert reaction")
+	}
+	i.result.Reactions++
+	return nil
+}
+
+// importSetting imports a user setting of the dump. The general and auto-archive settings
+// replace those of the user, and the shortcuts, memo templates and webhooks are added to them,
+// skipping those the user already has. Imported webhooks are disabled and unsigned until the
+// user enables them and rotates their secret.
+func (i *accountImporter) importSetting(ctx context.Context, data json.RawMessage) error {
+	setting := &storepb.UserSetting{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, setting); err != nil {
+		return errors.Wrap(err, "invalid user setting")
+	}
+	if !slices.Contains(accountDumpSettingKeys, setting.Key) {
+		return errors.Errorf("user setting %s can't be imported", setting.Key)
+	}
+	setting.UserId = i.user.ID
+	existing, err := i.s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &i.user.ID, Key: setting.Key})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user setting")
+	}
+
+	switch setting.Key {
+	case storepb.UserSetting_SHORTCUTS:
+		shortcuts := existing.GetShortcuts().GetShortcuts()
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return errors.Wrap(err, "failed to create filter engine")
+		}
+		for _, shortcut := range setting.GetShortcuts().GetShortcuts() {
+			if slices.ContainsFunc(shortcuts, func(s *storepb.ShortcutsUserSetting_Shortcut) bool { return s.Id == shortcut.Id }) {
+				continue
+			}
+			if _, err := engine.Compile(ctx, shortcut.Filter); err != nil {
+				i.result.Errors = append(i.result.Errors, fmt.Sprintf("shortcut %q: invalid filter", shortcut.Title))
+				continue
+			}
+			shortcuts = append(shortcuts, shortcut)
+		}
+		setting.Value = &storepb.UserSetting_Shortcuts{Shortcuts: &storepb.ShortcutsUserSetting{Shortcuts: shortcuts}}
+	case storepb.UserSetting_MEMO_TEMPLATES:
+		templates := existing.GetMemoTemplates().GetTemplates()
+		for _, template := range setting.GetMemoTemplates().GetTemplates() {
+			if !slices.ContainsFunc(templates, func(t *storepb.MemoTemplatesUserSetting_MemoTemplate) bool { return t.Id == template.Id }) {
+				templates = append(templates, template)
+			}
+		}
+		setting.Value = &storepb.UserSetting_MemoTemplates{MemoTemplates: &storepb.MemoTemplatesUserSetting{Templates: templates}}
+	case storepb.UserSetting_WEBHOOKS:
+		webhooks := existing.GetWebhooks().GetWebhooks()
+		for _, webhook := range setting.GetWebhooks().GetWebhooks() {
+			if slices.ContainsFunc(webhooks, func(w *storepb.WebhooksUserSetting_Webhook) bool { return w.Id == webhook.Id }) {
+				continue
+			}
+			if link, err := url.Parse(webhook.Url); err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+				i.result.Errors = append(i.result.Errors, fmt.Sprintf("webhook %q: invalid URL", webhook.Title))
+				continue
+			}
+			webhook.Disabled = true
+			webhook.EncryptedSecret = ""
+			webhook.ConsecutiveFailures = 0
+			webhooks = append(webhooks, webhook)
+		}
+		setting.Value = &storepb.UserSetting_Webhooks{Webhooks: &storepb.WebhooksUserSetting{Webhooks: webhooks}}
+	default:
+	}
+	if _, err := i.s.Store.UpsertUserSetting(ctx, setting); err != nil {
+		return errors.Wrap(err, "failed to upsert user setting")
+	}
+	i.result.Settings++
+	return nil
+}
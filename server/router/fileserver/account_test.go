@@ -0,0 +1,214 @@
+package fileserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestAccountExportImport(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	service := NewFileServerService(&profile.Profile{Driver: "sqlite", Data: t.TempDir()}, stores, "secret")
+	e := echo.New()
+	service.RegisterRoutes(e)
+	createUser := func(username string) (*store.User, string) {
+		user, err := stores.CreateUser(ctx, &store.User{Username: username, Role: store.RoleUser, Email: username + "@example.com"})
+		require.NoError(t, err)
+		token, err := service.authenticator.GenerateAccessToken(ctx, user.Username, user.ID, nil, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = stores.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: user.ID,
+			Key:    storepb.UserSetting_ACCESS_TOKENS,
+			Value: &storepb.UserSetting_AccessTokens{AccessTokens: &storepb.AccessTokensUserSetting{
+				AccessTokens: []*storepb.AccessTokensUserSetting_AccessToken{{AccessToken: token}},
+			}},
+		})
+		require.NoError(t, err)
+		return user, token
+	}
+	user, userToken := createUser("user")
+	other, otherToken := createUser("other")
+
+	createdTsSec := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Unix()
+	first, err := stores.CreateMemo(ctx, &store.Memo{UID: "first", CreatorID: user.ID, Content: "First ![photo](/file/attachments/photo/photo.png)", Visibility: store.Public})
+	require.NoError(t, err)
+	require.NoError(t, stores.UpdateMemo(ctx, &store.UpdateMemo{ID: first.ID, CreatedTs: &createdTsSec, UpdatedTs: &createdTsSec}))
+	require.NoError(t, stores.PinMemo(ctx, &store.PinMemo{ID: first.ID, CreatorID: user.ID, Pinned: true}))
+	_, err = stores.CreateAttachment(ctx, &store.Attachment{UID: "photo", CreatorID: user.ID, Filename: "photo.png", Type: "image/png", Blob: []byte("png"), Size: 3, MemoID: &first.ID})
+	require.NoError(t, err)
+	archived := store.Archived
+	commentContent := "Comment\n\n```mermaid\ngraph TD\n  A[\"Say <hi> & 'bye'\"] --> B\n\n  B -.->|\\n| C\n```\n"
+	comment, err := stores.CreateMemo(ctx, &store.Memo{UID: "comment", CreatorID: user.ID, Content: commentContent, Visibility: store.Private})
+	require.NoError(t, err)
+	require.NoError(t, stores.UpdateMemo(ctx, &store.UpdateMemo{ID: comment.ID, RowStatus: &archived}))
+	_, err = stores.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: comment.ID, RelatedMemoID: first.ID, Type: store.MemoRelationComment})
+	require.NoError(t, err)
+	_, err = stores.UpsertReaction(ctx, &store.Reaction{CreatorID: user.ID, ContentID: "memos/first", ReactionType: "👍"})
+	require.NoError(t, err)
+	_, err = stores.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_WEBHOOKS,
+		Value: &storepb.UserSetting_Webhooks{Webhooks: &storepb.WebhooksUserSetting{Webhooks: []*storepb.WebhooksUserSetting_Webhook{
+			{Id: "hook", Title: "Hook", Url: "https://example.com/hook", EncryptedSecret: "encrypted"},
+		}}},
+	})
+	require.NoError(t, err)
+
+	export := func(token string) (int, []byte) {
+		request := httptest.NewRequest(http.MethodGet, "/file/account/export?content=true", nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder.Code, recorder.Body.Bytes()
+	}
+	importDump := func(token, contentType string, dump []byte) (int, *accountImportResult) {
+		request := httptest.NewRequest(http.MethodPost, "/file/account/import", bytes.NewReader(dump))
+		request.Header.Set("Authorization", "Bearer "+token)
+		request.Header.Set(echo.HeaderContentType, contentType)
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusOK {
+			return recorder.Code, nil
+		}
+		result := &accountImportResult{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), result))
+		return recorder.Code, result
+	}
+
+	code, dump := export(userToken)
+	require.Equal(t, http.StatusOK, code)
+	require.NotContains(t, string(dump), "encrypted")
+
+	t.Run("dumps are imported as the data of the importing user", func(t *testing.T) {
+		code, result := importDump(otherToken, accountDumpContentType, dump)
+		require.Equal(t, http.StatusOK, code)
+		require.Empty(t, result.Errors)
+		require.Equal(t, 2, result.CreatedMemos)
+		require.Equal(t, 1, result.CreatedAttachments)
+		require.Equal(t, 1, result.Relations)
+		require.Equal(t, 1, result.Reactions)
+		require.Equal(t, 1, result.Settings)
+
+		memos, err := stores.ListMemos(ctx, &store.FindMemo{CreatorID: &other.ID, IncludeTrashed: true})
+		require.NoError(t, err)
+		require.Len(t, memos, 2)
+		var imported, importedComment *store.Memo
+		for _, memo := range memos {
+			if strings.HasPrefix(memo.Content, "First") {
+				imported = memo
+			} else {
+				importedComment = memo
+			}
+		}
+		// The UIDs of the dump are taken, so the memos and attachments get new ones.
+		require.NotEqual(t, "first", imported.UID)
+		require.Equal(t, createdTsSec, imported.CreatedTs)
+		require.Equal(t, store.Public, imported.Visibility)
+		require.True(t, imported.Pinned)
+		require.Equal(t, store.Archived, importedComment.RowStatus)
+		// Diagrams are imported as exported.
+		require.Equal(t, commentContent, importedComment.Content)
+
+		attachments, err := stores.ListAttachments(ctx, &store.FindAttachment{MemoID: &imported.ID})
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		require.NotEqual(t, "photo", attachments[0].UID)
+		require.Contains(t, imported.Content, "/file/attachments/"+attachments[0].UID+"/photo.png")
+
+		relations, err := stores.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &importedComment.ID})
+		require.NoError(t, err)
+		require.Len(t, relations, 1)
+		require.Equal(t, imported.ID, relations[0].RelatedMemoID)
+		reactions, err := stores.ListReactions(ctx, &store.FindReaction{CreatorID: &other.ID})
+		require.NoError(t, err)
+		require.Len(t, reactions, 1)
+		require.Equal(t, "memos/"+imported.UID, reactions[0].ContentID)
+
+		setting, err := stores.GetUserSetting(ctx, &store.FindUserSetting{UserID: &other.ID, Key: storepb.UserSetting_WEBHOOKS})
+		require.NoError(t, err)
+		webhooks := setting.GetWebhooks().GetWebhooks()
+		require.Len(t, webhooks, 1)
+		require.True(t, webhooks[0].Disabled)
+		require.Empty(t, webhooks[0].EncryptedSecret)
+	})
+
+	t.Run("records already imported are skipped", func(t *testing.T) {
+		code, result := importDump(otherToken, accountDumpContentType, dump)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, 0, result.CreatedMemos)
+		require.Equal(t, 2, result.SkippedMemos)
+		require.Equal(t, 0, result.CreatedAttachments)
+		memos, err := stores.ListMemos(ctx, &store.FindMemo{CreatorID: &other.ID, IncludeTrashed: true})
+		require.NoError(t, err)
+		require.Len(t, memos, 2)
+
+		// The memos of the exporting user are theirs already.
+		code, result = importDump(userToken, accountDumpContentType, dump)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, 0, result.CreatedMemos)
+	})
+
+	t.Run("imports count against the memo quotas", func(t *testing.T) {
+		memoSetting, err := stores.GetInstanceMemoRelatedSetting(ctx)
+		require.NoError(t, err)
+		setMemoSetting := func(setting *storepb.InstanceMemoRelatedSetting) {
+			_, err := stores.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+				Key:   storepb.InstanceSettingKey_MEMO_RELATED,
+				Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: setting},
+			})
+			require.NoError(t, err)
+		}
+		limitedSetting := proto.CloneOf(memoSetting)
+		limitedSetting.MaxMemosPerUser = 1
+		limitedSetting.NewAccountMaxPublicMemosPerDay = 1
+		setMemoSetting(limitedSetting)
+		defer setMemoSetting(memoSetting)
+
+		limited, limitedToken := createUser("limited")
+		code, result := importDump(limitedToken, accountDumpContentType, dump)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, 1, result.CreatedMemos)
+		require.Len(t, result.Errors, 2)
+		require.Contains(t, result.Errors[0], `memo "comment": memo quota exceeded`)
+		require.Contains(t, result.Errors[1], "relation between unknown memos")
+
+		// New accounts import public memos as private.
+		memos, err := stores.ListMemos(ctx, &store.FindMemo{CreatorID: &limited.ID, IncludeTrashed: true})
+		require.NoError(t, err)
+		require.Len(t, memos, 1)
+		require.True(t, strings.HasPrefix(memos[0].Content, "First"))
+		require.Equal(t, store.Private, memos[0].Visibility)
+	})
+
+	t.Run("invalid imports are rejected", func(t *testing.T) {
+		code, _ := export("")
+		require.Equal(t, http.StatusUnauthorized, code)
+		code, _ = importDump(otherToken, "text/plain", dump)
+		require.Equal(t, http.StatusUnsupportedMediaType, code)
+		code, _ = importDump(otherToken, accountDumpContentType, []byte(`{"type":"header","header":{"version":99}}`))
+		require.Equal(t, http.StatusBadRequest, code)
+		code, _ = importDump(otherToken, accountDumpContentType, []byte(`{"type":"memo","memo":{"id":"x"}}`))
+		require.Equal(t, http.StatusBadRequest, code)
+		code, _ = importDump(otherToken, accountDumpContentType, []byte("not json"))
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
@@ -0,0 +1,394 @@
+package fileserver
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/filter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// exportBatchSize is the number of memos loaded at once while an export is streamed.
+	exportBatchSize = 100
+	// exportSlugMaxLength is the maximum length of the slugs of the file names of exported memos.
+	exportSlugMaxLength = 50
+	// exportScopeInstance is the scope of the exports of the memos of every user, only for the host.
+	exportScopeInstance = "instance"
+)
+
+// exportAttachmentLinkRegex matches the links to attachments in the content of memos, relative or
+// absolute, capturing the attachment UID.
+var exportAttachmentLinkRegex = regexp.MustCompile(`(?:https?://[^\s()<>"]*)?/file/attachments/([a-zA-Z0-9-]+)/[^\s()<>"?#]*(?:\?[^\s()<>"#]*)?`)
+
+// memoExport is an export of memos, narrowed down from the query parameters of the request.
+type memoExport struct {
+	find *store.FindMemo
+	// instance is whether the memos of every user are exported, each user in a folder.
+	instance bool
+}
+
+// exportMemos streams a zip archive of memos: a markdown file per memo with a YAML front matter,
+// and an assets/ folder with their attachments, which the links of the memos are rewritten to.
+//
+// Users export their own memos, and the host can export the memos of every user with
+// scope=instance. The memos are narrowed down with the tag, filter, visibility, start and end
+// query parameters. The archive is written as the memos are loaded, so that large exports aren't
+// held in memory.
+func (s *FileServerService) exportMemos(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.getCurrentUser(ctx, c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get current user").SetInternal(err)
+	}
+	if user == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized access")
+	}
+	export, err := parseMemoExport(c, user)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("memos-%s-%s.zip", user.Username, time.Now().UTC().Format("20060102"))
+	if export.instance {
+		filename = fmt.Sprintf("memos-%s.zip", time.Now().UTC().Format("20060102"))
+	}
+	c.Response().Header().Set(echo.HeaderContentType, "application/zip")
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+	c.Response().WriteHeader(http.StatusOK)
+
+	archive := zip.NewWriter(c.Response())
+	if err := s.writeMemoExport(ctx, archive, export); err != nil {
+		// The response has started, so the archive is left incomplete, and fails to open.
+		c.Logger().Errorf("failed to export memos: %v", err)
+		return nil
+	}
+	if err := archive.Close(); err != nil {
+		c.Logger().Errorf("failed to export memos: %v", err)
+	}
+	return nil
+}
+
+// parseMemoExport parses the query parameters of an export of memos.
+func parseMemoExport(c echo.Context, user *store.User) (*memoExport, error) {
+	export := &memoExport{
+		find: &store.FindMemo{
+			IncludeScheduled: true,
+			OrderByTimeAsc:   true,
+		},
+	}
+	switch scope := c.QueryParam("scope"); scope {
+	case "":
+		export.find.CreatorID = &user.ID
+	case exportScopeInstance:
+		if user.Role != store.RoleHost {
+			return nil, echo.NewHTTPError(http.StatusForbidden, "only the host can export the memos of every user")
+		}
+		export.instance = true
+	default:
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid scope %q", scope))
+	}
+
+	if tag := strings.TrimPrefix(strings.TrimSpace(c.QueryParam("tag")), "#"); tag != "" {
+		export.find.Filters = append(export.find.Filters, fmt.Sprintf("tag in [%s]", strconv.Quote(tag)))
+	}
+	if expression := strings.TrimSpace(c.QueryParam("filter")); expression != "" {
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to create filter engine").SetInternal(err)
+		}
+		if _, err := engine.Compile(c.Request().Context(), expression); err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid filter: %v", err))
+		}
+		export.find.Filters = append(export.find.Filters, expression)
+		export.find.FilterViewerID = user.ID
+	}
+	if visibilities := strings.TrimSpace(c.QueryParam("visibility")); visibilities != "" {
+		for _, value := range strings.Split(visibilities, ",") {
+			visibility := store.Visibility(strings.ToUpper(strings.TrimSpace(value)))
+			if visibility != store.Public && visibility != store.Protected && visibility != store.Private {
+				return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid visibility %q", value))
+			}
+			export.find.VisibilityList = append(export.find.VisibilityList, visibility)
+		}
+	}
+
+	// The dates are days in UTC, and the end day is included.
+	dateRange := &store.MemoTsRange{StartTs: 0, EndTs: math.MaxInt64}
+	if start := c.QueryParam("start"); start != "" {
+		date, err := time.Parse(time.DateOnly, start)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid start date, expected YYYY-MM-DD")
+		}
+		dateRange.StartTs = date.Unix()
+	}
+	if end := c.QueryParam("end"); end != "" {
+		date, err := time.Parse(time.DateOnly, end)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid end date, expected YYYY-MM-DD")
+		}
+		dateRange.EndTs = date.AddDate(0, 0, 1).Unix()
+	}
+	if dateRange.StartTs != 0 || dateRange.EndTs != math.MaxInt64 {
+		if dateRange.StartTs >= dateRange.EndTs {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "the start date must not be after the end date")
+		}
+		export.find.CreatedTsRanges = []*store.MemoTsRange{dateRange}
+	}
+	return export, nil
+}
+
+// writeMemoExport writes the memos of an export to a zip archive, in batches.
+func (s *FileServerService) writeMemoExport(ctx context.Context, archive *zip.Writer, export *memoExport) error {
+	find := *export.find
+	limit := exportBatchSize
+	find.Limit = &limit
+	usernames := map[int32]string{}
+	usedFilenames := map[string]bool{}
+	for {
+		memos, err := s.Store.ListMemos(ctx, &find)
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			return nil
+		}
+
+		memoIDs := make([]int32, 0, len(memos))
+		for _, memo := range memos {
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+		if err != nil {
+			return errors.Wrap(err, "failed to list attachments")
+		}
+		attachmentsByMemoID := map[int32][]*store.Attachment{}
+		for _, attachment := range attachments {
+			if attachment.MemoID != nil {
+				attachmentsByMemoID[*attachment.MemoID] = append(attachmentsByMemoID[*attachment.MemoID], attachment)
+			}
+		}
+
+		for _, memo := range memos {
+			folder := ""
+			if export.instance {
+				username, err := s.getExportUsername(ctx, usernames, memo.CreatorID)
+				if err != nil {
+					return err
+				}
+				folder = username + "/"
+			}
+			if err := s.writeExportedMemo(ctx, archive, folder, usedFilenames, memo, attachmentsByMemoID[memo.ID]); err != nil {
+				return err
+			}
+		}
+		if len(memos) < exportBatchSize {
+			return nil
+		}
+		find.Cursor = store.NewMemoCursor(memos[len(memos)-1], &find)
+	}
+}
+
+// getExportUsername returns the username of the creator of memos, which the folders of the
+// memos of an instance export are named by.
+func (s *FileServerService) getExportUsername(ctx context.Context, usernames map[int32]string, userID int32) (string, error) {
+	if username, ok := usernames[userID]; ok {
+		return username, nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get user")
+	}
+	username := fmt.Sprintf("user-%d", userID)
+	if user != nil {
+		username = user.Username
+	}
+	usernames[userID] = username
+	return username, nil
+}
+
+// writeExportedMemo writes a memo and its attachments to a zip archive. The attachments are
+// written to the assets/ folder, and the links of the memo to them are rewritten to relative paths.
+func (s *FileServerService) writeExportedMemo(ctx context.Context, archive *zip.Writer, folder string, usedFilenames map[string]bool, memo *store.Memo, attachments []*store.Attachment) error {
+	// The memo files link to the assets from their folder.
+	assetsPrefix := strings.Repeat("../", strings.Count(folder, "/"))
+	assetPaths := map[string]string{}
+	for _, attachment := range attachments {
+		if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+			continue
+		}
+		assetPath := exportAssetPath(attachment)
+		if err := s.writeExportedAttachment(ctx, archive, assetPath, attachment); err != nil {
+			return err
+		}
+		assetPaths[attachment.UID] = assetsPrefix + assetPath
+	}
+
+	content := exportAttachmentLinkRegex.ReplaceAllStringFunc(memo.Content, func(link string) string {
+		matches := exportAttachmentLinkRegex.FindStringSubmatch(link)
+		if assetPath, ok := assetPaths[matches[1]]; ok {
+			return assetPath
+		}
+		return link
+	})
+	// The attachments that aren't linked in the content are listed after it.
+	var unlinked []string
+	for _, attachment := range attachments {
+		link, ok := assetPaths[attachment.UID]
+		if !ok {
+			link = attachment.Reference
+		}
+		if strings.Contains(content, link) {
+			continue
+		}
+		prefix := ""
+		if strings.HasPrefix(attachment.Type, "image/") {
+			prefix = "!"
+		}
+		unlinked = append(unlinked, fmt.Sprintf("- %s[%s](%s)", prefix, escapeMarkdownLinkText(attachment.Filename), link))
+	}
+	if len(unlinked) > 0 {
+		content = strings.TrimRight(content, "\n") + "\n\n" + strings.Join(unlinked, "\n") + "\n"
+	}
+
+	writer, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     folder + exportMemoFilename(memo, folder, usedFilenames),
+		Method:   zip.Deflate,
+		Modified: time.Unix(memo.UpdatedTs, 0),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create memo file")
+	}
+	if _, err := io.WriteString(writer, exportFrontMatter(memo)+content); err != nil {
+		return errors.Wrap(err, "failed to write memo file")
+	}
+	return nil
+}
+
+// writeExportedAttachment writes the content of an attachment to a zip archive. Local files are
+// copied from the disk, the other attachments are loaded in memory one at a time.
+func (s *FileServerService) writeExportedAttachment(ctx context.Context, archive *zip.Writer, assetPath string, attachment *store.Attachment) error {
+	if attachment.StorageType != storepb.AttachmentStorageType_LOCAL && attachment.StorageType != storepb.AttachmentStorageType_S3 {
+		withBlob, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get attachment")
+		}
+		if withBlob != nil {
+			attachment = withBlob
+		}
+	}
+	content, err := s.openAttachmentContent(attachment)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open attachment %s", attachment.UID)
+	}
+	defer content.Close()
+
+	writer, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     assetPath,
+		Method:   zip.Deflate,
+		Modified: time.Unix(attachment.UpdatedTs, 0),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create attachment file")
+	}
+	if _, err := io.Copy(writer, content); err != nil {
+		return errors.Wrapf(err, "failed to write attachment %s", attachment.UID)
+	}
+	return nil
+}
+
+// exportAssetPath returns the path of an attachment in an export, prefixed with its UID so that
+// attachments with the same filename don't collide.
+func exportAssetPath(attachment *store.Attachment) string {
+	filename := path.Base(strings.ReplaceAll(attachment.Filename, "\\", "/"))
+	if filename == "." || filename == "/" || filename == ".." {
+		filename = "file"
+	}
+	return "assets/" + attachment.UID + "-" + filename
+}
+
+// exportMemoFilename returns the name of the file of a memo in an export, from its creation date
+// and the slug of its first line, e.g. 2024-01-02-my-first-memo.md. Names already used in the
+// folder get a numbered suffix.
+func exportMemoFilename(memo *store.Memo, folder string, usedFilenames map[string]bool) string {
+	base := time.Unix(memo.CreatedTs, 0).UTC().Format(time.DateOnly)
+	if slug := slugify(memo.Content); slug != "" {
+		base += "-" + slug
+	} else {
+		base += "-" + memo.UID
+	}
+	filename := base + ".md"
+	for i := 2; usedFilenames[folder+filename]; i++ {
+		filename = fmt.Sprintf("%s-%d.md", base, i)
+	}
+	usedFilenames[folder+filename] = true
+	return filename
+}
+
+// slugify returns the slug of the first line of content, without its heading marks.
+func slugify(content string) string {
+	firstLine, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	var slug strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(strings.TrimLeft(firstLine, "# ")) {
+		if slug.Len() >= exportSlugMaxLength {
+			break
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			slug.WriteRune(r)
+			lastDash = false
+		} else if !lastDash {
+			slug.WriteRune('-')
+			lastDash = true
+		}
+	}
+	return strings.Trim(slug.String(), "-")
+}
+
+// exportFrontMatter returns the YAML front matter of an exported memo. Strings are written as
+// double-quoted scalars, which YAML reads like JSON strings.
+func exportFrontMatter(memo *store.Memo) string {
+	var buf strings.Builder
+	buf.WriteString("---\n")
+	fmt.Fprintf(&buf, "uid: %s\n", strconv.Quote(memo.UID))
+	fmt.Fprintf(&buf, "created: %s\n", time.Unix(memo.CreatedTs, 0).UTC().Format(time.RFC3339))
+	fmt.Fprintf(&buf, "updated: %s\n", time.Unix(memo.UpdatedTs, 0).UTC().Format(time.RFC3339))
+	fmt.Fprintf(&buf, "visibility: %s\n", memo.Visibility)
+	fmt.Fprintf(&buf, "pinned: %t\n", memo.Pinned)
+	if memo.RowStatus == store.Archived {
+		buf.WriteString("archived: true\n")
+	}
+	if memo.ParentUID != nil {
+		fmt.Fprintf(&buf, "parent: %s\n", strconv.Quote(*memo.ParentUID))
+	}
+	if memo.Payload != nil && len(memo.Payload.Tags) > 0 {
+		buf.WriteString("tags:\n")
+		for _, tag := range memo.Payload.Tags {
+			fmt.Fprintf(&buf, "  - %s\n", strconv.Quote(tag))
+		}
+	} else {
+		buf.WriteString("tags: []\n")
+	}
+	buf.WriteString("---\n\n")
+	return buf.String()
+}
+
+// escapeMarkdownLinkText escapes the brackets of the text of a markdown link.
+func escapeMarkdownLinkText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`).Replace(text)
+}
@@ -0,0 +1,156 @@
+package fileserver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestExportMemos(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	service := NewFileServerService(&profile.Profile{Driver: "sqlite", Data: t.TempDir()}, stores, "secret")
+	e := echo.New()
+	service.RegisterRoutes(e)
+	createUser := func(username string, role store.Role) (*store.User, string) {
+		user, err := stores.CreateUser(ctx, &store.User{Username: username, Role: role, Email: username + "@example.com"})
+		require.NoError(t, err)
+		token, err := service.authenticator.GenerateAccessToken(ctx, user.Username, user.ID, nil, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = stores.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: user.ID,
+			Key:    storepb.UserSetting_ACCESS_TOKENS,
+			Value: &storepb.UserSetting_AccessTokens{AccessTokens: &storepb.AccessTokensUserSetting{
+				AccessTokens: []*storepb.AccessTokensUserSetting_AccessToken{{AccessToken: token}},
+			}},
+		})
+		require.NoError(t, err)
+		return user, token
+	}
+	host, hostToken := createUser("host", store.RoleHost)
+	user, userToken := createUser("user", store.RoleUser)
+	createMemo := func(creator *store.User, uid, content string, visibility store.Visibility, createdTs int64, tags ...string) *store.Memo {
+		memo, err := stores.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  creator.ID,
+			Content:    content,
+			Visibility: visibility,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+		require.NoError(t, stores.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTs}))
+		return memo
+	}
+	day := func(date string) int64 {
+		parsed, err := time.Parse(time.DateOnly, date)
+		require.NoError(t, err)
+		return parsed.Add(12 * time.Hour).Unix()
+	}
+	first := createMemo(user, "first", "# My first memo!\nSee ![photo](/file/attachments/photo/photo.png) #travel", store.Private, day("2024-01-02"), "travel")
+	_, err := stores.CreateAttachment(ctx, &store.Attachment{UID: "photo", CreatorID: user.ID, Filename: "photo.png", Type: "image/png", Blob: []byte("png"), Size: 3, MemoID: &first.ID})
+	require.NoError(t, err)
+	_, err = stores.CreateAttachment(ctx, &store.Attachment{UID: "notes", CreatorID: user.ID, Filename: "notes [draft].pdf", Type: "application/pdf", Blob: []byte("pdf"), Size: 3, MemoID: &first.ID})
+	require.NoError(t, err)
+	createMemo(user, "second", "My first memo", store.Public, day("2024-01-02")+3600)
+	createMemo(user, "third", "Later", store.Public, day("2024-03-01"), "work")
+	createMemo(host, "hosts", "Host memo", store.Public, day("2024-02-01"))
+
+	export := func(token, query string) (int, map[string]string) {
+		request := httptest.NewRequest(http.MethodGet, "/file/memos/export"+query, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		if recorder.Code != http.StatusOK {
+			return recorder.Code, nil
+		}
+		require.Equal(t, "application/zip", recorder.Header().Get(echo.HeaderContentType))
+		reader, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+		require.NoError(t, err)
+		files := map[string]string{}
+		for _, file := range reader.File {
+			content, err := file.Open()
+			require.NoError(t, err)
+			data, err := io.ReadAll(content)
+			require.NoError(t, err)
+			files[file.Name] = string(data)
+		}
+		return recorder.Code, files
+	}
+	names := func(files map[string]string) []string {
+		names := []string{}
+		for name := range files {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		return names
+	}
+
+	t.Run("users export their own memos", func(t *testing.T) {
+		code, files := export(userToken, "")
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []string{
+			"2024-01-02-my-first-memo-2.md",
+			"2024-01-02-my-first-memo.md",
+			"2024-03-01-later.md",
+			"assets/notes-notes [draft].pdf",
+			"assets/photo-photo.png",
+		}, names(files))
+		require.Equal(t, "png", files["assets/photo-photo.png"])
+
+		memo := files["2024-01-02-my-first-memo.md"]
+		require.Contains(t, memo, "---\nuid: \"first\"\ncreated: 2024-01-02T12:00:00Z\n")
+		require.Contains(t, memo, "visibility: PRIVATE\npinned: false\ntags:\n  - \"travel\"\n---\n\n")
+		// Links to attachments are rewritten, and the other attachments are listed.
+		require.Contains(t, memo, "See ![photo](assets/photo-photo.png) #travel")
+		require.Contains(t, memo, `- [notes \[draft\].pdf](assets/notes-notes [draft].pdf)`)
+		require.NotContains(t, memo, "/file/attachments")
+	})
+
+	t.Run("exports are filtered", func(t *testing.T) {
+		_, files := export(userToken, "?tag=work")
+		require.Equal(t, []string{"2024-03-01-later.md"}, names(files))
+		_, files = export(userToken, "?visibility=private")
+		require.Len(t, files, 3)
+		_, files = export(userToken, "?start=2024-01-03&end=2024-03-01")
+		require.Equal(t, []string{"2024-03-01-later.md"}, names(files))
+		_, files = export(userToken, "?end=2024-01-02")
+		require.Len(t, files, 4)
+
+		code, _ := export(userToken, "?filter=unknown_field%20==%201")
+		require.Equal(t, http.StatusBadRequest, code)
+		code, _ = export(userToken, "?start=yesterday")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("only the host exports the instance", func(t *testing.T) {
+		code, _ := export("", "")
+		require.Equal(t, http.StatusUnauthorized, code)
+		code, _ = export(userToken, "?scope=instance")
+		require.Equal(t, http.StatusForbidden, code)
+
+		code, files := export(hostToken, "?scope=instance")
+		require.Equal(t, http.StatusOK, code)
+		require.Contains(t, files, "host/2024-02-01-host-memo.md")
+		require.Contains(t, files, "user/2024-03-01-later.md")
+		require.Contains(t, files["user/2024-01-02-my-first-memo.md"], "![photo](../assets/photo-photo.png)")
+		require.Contains(t, files, "assets/photo-photo.png")
+	})
+}
@@ -2,9 +2,9 @@ package fileserver
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/base64"
-	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -13,7 +13,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/disintegration/imaging"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/semaphore"
@@ -21,22 +20,18 @@ import (
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/thumbnail"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
-const (
-	// ThumbnailCacheFolder is the folder name where the thumbnail images are stored.
-	ThumbnailCacheFolder = ".thumbnail_cache"
-	// thumbnailMaxSize is the maximum size in pixels for the largest dimension of the thumbnail image.
-	thumbnailMaxSize = 600
-)
+// thumbnailCacheControl is the Cache-Control header of thumbnails, which never change.
+const thumbnailCacheControl = "public, max-age=31536000, immutable"
 
-var SupportedThumbnailMimeTypes = []string{
-	"image/png",
-	"image/jpeg",
-}
+// sharedAttachmentCacheControl is the Cache-Control header of the attachments served through share
+// links, which must not outlive the links in shared caches.
+const sharedAttachmentCacheControl = "private, no-cache"
 
 // FileServerService handles HTTP file serving with proper range request support.
 // This service bypasses gRPC-Gateway to use native HTTP serving via http.ServeContent(),
@@ -67,8 +62,18 @@ func (s *FileServerService) RegisterRoutes(echoServer *echo.Echo) {
 	// Serve attachment binary files
 	fileGroup.GET("/attachments/:uid/:filename", s.serveAttachmentFile)
 
+	// Serve the attachment files of memos shared by share links
+	fileGroup.GET("/shares/:slug/attachments/:uid/:filename", s.serveSharedAttachmentFile)
+
 	// Serve user avatar images
 	fileGroup.GET("/users/:identifier/avatar", s.serveUserAvatar)
+
+	// Export memos as a zip archive of markdown files
+	fileGroup.GET("/memos/export", s.exportMemos)
+
+	// Export and import the data of an account as NDJSON dumps
+	fileGroup.GET("/account/export", s.exportAccount)
+	fileGroup.POST("/account/import", s.importAccount)
 }
 
 // serveAttachmentFile serves attachment binary content using native HTTP.
@@ -76,7 +81,10 @@ func (s *FileServerService) RegisterRoutes(echoServer *echo.Echo) {
 func (s *FileServerService) serveAttachmentFile(c echo.Context) error {
 	ctx := c.Request().Context()
 	uid := c.Param("uid")
-	thumbnail := c.QueryParam("thumbnail") == "true"
+	thumbnailSize, err := parseThumbnailSize(c)
+	if err != nil {
+		return err
+	}
 
 	// Get attachment from database
 	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{
@@ -95,21 +103,75 @@ func (s *FileServerService) serveAttachmentFile(c echo.Context) error {
 		return err
 	}
 
-	// Get the binary content
-	blob, err := s.getAttachmentBlob(attachment)
+	return s.serveAttachmentContent(c, attachment, thumbnailSize, "")
+}
+
+// serveSharedAttachmentFile serves the files of the attachments of a memo shared by a share link,
+// whatever the visibility of the memo. Links that can't be used and attachments of other memos
+// are not found, so that the link doesn't reveal what exists.
+func (s *FileServerService) serveSharedAttachmentFile(c echo.Context) error {
+	ctx := c.Request().Context()
+	uid := c.Param("uid")
+	thumbnailSize, err := parseThumbnailSize(c)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment blob").SetInternal(err)
+		return err
+	}
+
+	share, _, err := s.Store.GetActiveMemoShare(ctx, c.Param("slug"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get share link").SetInternal(err)
+	}
+	if share == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "attachment not found")
+	}
+	// Links with a passphrase need the access token of UnlockSharedMemo, as a query parameter so
+	// that the files can be embedded
+	if share.PassphraseHash != "" && !s.authenticator.VerifyShareAccessToken(ctx, share, c.QueryParam("token")) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "passphrase required")
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{
+		UID:     &uid,
+		MemoID:  &share.MemoID,
+		GetBlob: true,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment").SetInternal(err)
 	}
+	if attachment == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "attachment not found")
+	}
+
+	return s.serveAttachmentContent(c, attachment, thumbnailSize, sharedAttachmentCacheControl)
+}
+
+// parseThumbnailSize returns the thumbnail size requested by the thumbnail query parameter,
+// or an empty size for the original file.
+func parseThumbnailSize(c echo.Context) (thumbnail.Size, error) {
+	name := c.QueryParam("thumbnail")
+	if name == "" {
+		return "", nil
+	}
+	size, ok := thumbnail.ParseSize(name)
+	if !ok {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "invalid thumbnail size")
+	}
+	return size, nil
+}
+
+// serveAttachmentContent serves the content of an attachment, or its thumbnail of the given size.
+// cacheControl overrides the Cache-Control header if not empty.
+func (s *FileServerService) serveAttachmentContent(c echo.Context, attachment *store.Attachment, thumbnailSize thumbnail.Size, cacheControl string) error {
+	ctx := c.Request().Context()
 
 	// Handle thumbnail requests for images
-	if thumbnail && s.isImageType(attachment.Type) {
-		thumbnailBlob, err := s.getOrGenerateThumbnail(ctx, attachment)
-		if err != nil {
-			// Log warning but fall back to original image
-			c.Logger().Warnf("failed to get thumbnail: %v", err)
-		} else {
-			blob = thumbnailBlob
+	if thumbnailSize != "" && thumbnail.IsSupported(attachment.Type) {
+		thumbnailBlob, err := s.getOrGenerateThumbnail(ctx, attachment, thumbnailSize)
+		if err == nil {
+			c.Response().Header().Set("Cache-Control", cmp.Or(cacheControl, thumbnailCacheControl))
+			return c.Blob(http.StatusOK, attachment.Type, thumbnailBlob)
 		}
+		// Log warning but fall back to original image
+		c.Logger().Warnf("failed to get thumbnail: %v", err)
 	}
 
 	// Determine content type
@@ -124,23 +186,38 @@ func (s *FileServerService) serveAttachmentFile(c echo.Context) error {
 		contentType = "application/octet-stream"
 	}
 
-	// Set common headers
-	c.Response().Header().Set("Content-Type", contentType)
-	c.Response().Header().Set("Cache-Control", "public, max-age=3600")
-
 	// For video/audio: Use http.ServeContent for automatic range request support
 	// This is critical for Safari which REQUIRES range request support
 	if strings.HasPrefix(contentType, "video/") || strings.HasPrefix(contentType, "audio/") {
+		// Local files are read from the disk as requested, so that seeking doesn't load the whole file
+		content, err := s.openAttachmentContent(attachment)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment blob").SetInternal(err)
+		}
+		defer content.Close()
+
+		c.Response().Header().Set("Content-Type", contentType)
+		c.Response().Header().Set("Cache-Control", cmp.Or(cacheControl, "public, max-age=3600"))
 		// ServeContent automatically handles:
 		// - Range request parsing
 		// - HTTP 206 Partial Content responses
 		// - Content-Range headers
 		// - Accept-Ranges: bytes header
 		modTime := time.Unix(attachment.UpdatedTs, 0)
-		http.ServeContent(c.Response(), c.Request(), attachment.Filename, modTime, bytes.NewReader(blob))
+		http.ServeContent(c.Response(), c.Request(), attachment.Filename, modTime, content)
 		return nil
 	}
 
+	// Get the binary content
+	blob, err := s.getAttachmentBlob(attachment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment blob").SetInternal(err)
+	}
+
+	// Set common headers
+	c.Response().Header().Set("Content-Type", contentType)
+	c.Response().Header().Set("Cache-Control", cmp.Or(cacheControl, "public, max-age=3600"))
+
 	// For other files: Simple blob response
 	return c.Blob(http.StatusOK, contentType, blob)
 }
@@ -215,7 +292,9 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c ech
 
 	// Check memo visibility
 	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-		ID: attachment.MemoID,
+		ID:               attachment.MemoID,
+		IncludeTrashed:   true,
+		IncludeScheduled: true,
 	})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find memo").SetInternal(err)
@@ -224,8 +303,24 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c ech
 		return echo.NewHTTPError(http.StatusNotFound, "memo not found")
 	}
 
-	// Public memos are accessible to everyone
-	if memo.Visibility == store.Public {
+	// The memos of suspended users are hidden from everyone but the host
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find memo creator").SetInternal(err)
+	}
+	if creator != nil && creator.SuspendedTs != 0 {
+		user, err := s.getCurrentUser(ctx, c)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get current user").SetInternal(err)
+		}
+		if user == nil || user.Role != store.RoleHost {
+			return echo.NewHTTPError(http.StatusNotFound, "memo not found")
+		}
+		return nil
+	}
+
+	// Public memos are accessible to everyone, unless they are in the trash, scheduled or drafts
+	if memo.Visibility == store.Public && memo.DeletedTs == 0 && memo.PublishTs == 0 && memo.RowStatus != store.Draft {
 		return nil
 	}
 
@@ -238,8 +333,8 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c ech
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized access")
 	}
 
-	// Private memos can only be accessed by the creator
-	if memo.Visibility == store.Private && user.ID != attachment.CreatorID {
+	// Private memos, memos in the trash, scheduled memos and drafts can only be accessed by the creator
+	if (memo.Visibility == store.Private || memo.DeletedTs != 0 || memo.PublishTs != 0 || memo.RowStatus == store.Draft) && user.ID != attachment.CreatorID {
 		return echo.NewHTTPError(http.StatusForbidden, "forbidden access")
 	}
 
@@ -250,6 +345,12 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c ech
 // It checks both session cookies and Bearer tokens for authentication.
 // Uses the shared Authenticator for consistent authentication logic.
 func (s *FileServerService) getCurrentUser(ctx context.Context, c echo.Context) (*store.User, error) {
+	return s.getCurrentUserWithScope(ctx, c, auth.ScopeRead)
+}
+
+// getCurrentUserWithScope retrieves the current authenticated user like getCurrentUser, with
+// Bearer tokens needing the scope, e.g. the write scope for requests that change data.
+func (s *FileServerService) getCurrentUserWithScope(ctx context.Context, c echo.Context, scope string) (*store.User, error) {
 	// Try session cookie authentication first
 	if cookie, err := c.Cookie(auth.SessionCookieName); err == nil && cookie.Value != "" {
 		user, err := s.authenticator.AuthenticateBySession(ctx, cookie.Value)
@@ -258,14 +359,22 @@ func (s *FileServerService) getCurrentUser(ctx context.Context, c echo.Context)
 		}
 	}
 
+	// Try session access token cookie authentication
+	if cookie, err := c.Cookie(auth.AccessTokenCookieName); err == nil && cookie.Value != "" {
+		user, _, err := s.authenticator.AuthenticateBySessionAccessToken(ctx, cookie.Value)
+		if err == nil && user != nil {
+			return user, nil
+		}
+	}
+
 	// Try JWT Bearer token authentication
 	authHeader := c.Request().Header.Get("Authorization")
 	if authHeader != "" {
 		parts := strings.Fields(authHeader)
 		if len(parts) == 2 && strings.EqualFold(parts[0], "bearer") {
-			user, err := s.authenticator.AuthenticateByJWT(ctx, parts[1])
-			if err == nil && user != nil {
-				return user, nil
+			user, scopes, err := s.authenticator.AuthenticateByJWT(ctx, parts[1])
+			if err == nil && user != nil && auth.HasScope(scopes, scope) {
+				return auth.ApplyScopes(user, scopes), nil
 			}
 		}
 	}
@@ -274,9 +383,37 @@ func (s *FileServerService) getCurrentUser(ctx context.Context, c echo.Context)
 	return nil, nil
 }
 
-// isImageType checks if the mime type is an image that supports thumbnails.
-func (*FileServerService) isImageType(mimeType string) bool {
-	return mimeType == "image/png" || mimeType == "image/jpeg"
+// blobContent is the content of an attachment kept in memory.
+type blobContent struct {
+	*bytes.Reader
+}
+
+func (blobContent) Close() error {
+	return nil
+}
+
+// openAttachmentContent opens the content of an attachment for reading and seeking: local files
+// are opened from the disk, the other attachments are loaded in memory.
+func (s *FileServerService) openAttachmentContent(attachment *store.Attachment) (io.ReadSeekCloser, error) {
+	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
+		attachmentPath := filepath.FromSlash(attachment.Reference)
+		if !filepath.IsAbs(attachmentPath) {
+			attachmentPath = filepath.Join(s.Profile.Data, attachmentPath)
+		}
+		file, err := os.Open(attachmentPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, errors.Wrap(err, "file not found")
+			}
+			return nil, errors.Wrap(err, "failed to open the file")
+		}
+		return file, nil
+	}
+	blob, err := s.getAttachmentBlob(attachment)
+	if err != nil {
+		return nil, err
+	}
+	return blobContent{bytes.NewReader(blob)}, nil
 }
 
 // getAttachmentBlob retrieves the binary content of an attachment from storage.
@@ -333,30 +470,16 @@ func (s *FileServerService) getAttachmentBlob(attachment *store.Attachment) ([]b
 	return attachment.Blob, nil
 }
 
-// getOrGenerateThumbnail returns the thumbnail image of the attachment.
-// Uses semaphore to limit concurrent thumbnail generation and prevent memory exhaustion.
-func (s *FileServerService) getOrGenerateThumbnail(ctx context.Context, attachment *store.Attachment) ([]byte, error) {
-	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
-	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
-		return nil, errors.Wrap(err, "failed to create thumbnail cache folder")
+// getOrGenerateThumbnail returns the thumbnail image of the attachment at a size. The thumbnails
+// of the attachments uploaded before thumbnails were generated on upload are generated on their
+// first request. Uses semaphore to limit concurrent thumbnail generation and prevent memory exhaustion.
+func (s *FileServerService) getOrGenerateThumbnail(ctx context.Context, attachment *store.Attachment, size thumbnail.Size) ([]byte, error) {
+	blob, err := s.Store.GetAttachmentThumbnail(ctx, attachment, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get thumbnail")
 	}
-	filePath := filepath.Join(thumbnailCacheFolder, fmt.Sprintf("%d%s", attachment.ID, filepath.Ext(attachment.Filename)))
-
-	// Check if thumbnail already exists
-	if _, err := os.Stat(filePath); err == nil {
-		// Thumbnail exists, read and return it
-		thumbnailFile, err := os.Open(filePath)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to open thumbnail file")
-		}
-		defer thumbnailFile.Close()
-		blob, err := io.ReadAll(thumbnailFile)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to read thumbnail file")
-		}
+	if blob != nil {
 		return blob, nil
-	} else if !os.IsNotExist(err) {
-		return nil, errors.Wrap(err, "failed to check thumbnail image stat")
 	}
 
 	// Thumbnail doesn't exist, acquire semaphore to limit concurrent generation
@@ -366,71 +489,26 @@ func (s *FileServerService) getOrGenerateThumbnail(ctx context.Context, attachme
 	defer s.thumbnailSemaphore.Release(1)
 
 	// Double-check if thumbnail was created while waiting for semaphore
-	if _, err := os.Stat(filePath); err == nil {
-		thumbnailFile, err := os.Open(filePath)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to open thumbnail file")
-		}
-		defer thumbnailFile.Close()
-		blob, err := io.ReadAll(thumbnailFile)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to read thumbnail file")
-		}
+	blob, err = s.Store.GetAttachmentThumbnail(ctx, attachment, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get thumbnail")
+	}
+	if blob != nil {
 		return blob, nil
 	}
 
-	// Generate the thumbnail
-	blob, err := s.getAttachmentBlob(attachment)
+	original, err := s.getAttachmentBlob(attachment)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get attachment blob")
 	}
-
-	// Decode image - this is memory intensive
-	img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to decode thumbnail image")
-	}
-
-	// The largest dimension is set to thumbnailMaxSize and the smaller dimension is scaled proportionally.
-	// Small images are not enlarged.
-	width := img.Bounds().Dx()
-	height := img.Bounds().Dy()
-	var thumbnailWidth, thumbnailHeight int
-
-	// Only resize if the image is larger than thumbnailMaxSize
-	if max(width, height) > thumbnailMaxSize {
-		if width >= height {
-			// Landscape or square - constrain width, maintain aspect ratio for height
-			thumbnailWidth = thumbnailMaxSize
-			thumbnailHeight = 0
-		} else {
-			// Portrait - constrain height, maintain aspect ratio for width
-			thumbnailWidth = 0
-			thumbnailHeight = thumbnailMaxSize
-		}
-	} else {
-		// Keep original dimensions for small images
-		thumbnailWidth = width
-		thumbnailHeight = height
-	}
-
-	// Resize the image to the calculated dimensions.
-	thumbnailImage := imaging.Resize(img, thumbnailWidth, thumbnailHeight, imaging.Lanczos)
-
-	// Save thumbnail to disk
-	if err := imaging.Save(thumbnailImage, filePath); err != nil {
-		return nil, errors.Wrap(err, "failed to save thumbnail file")
-	}
-
-	// Read the saved thumbnail and return it
-	thumbnailFile, err := os.Open(filePath)
+	thumbnails, err := thumbnail.Generate(original, attachment.Type)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to open thumbnail file")
+		return nil, errors.Wrap(err, "failed to generate thumbnails")
 	}
-	defer thumbnailFile.Close()
-	thumbnailBlob, err := io.ReadAll(thumbnailFile)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to read thumbnail file")
+	for thumbnailSize, thumbnailBlob := range thumbnails {
+		if err := s.Store.SaveAttachmentThumbnail(ctx, attachment, thumbnailSize, thumbnailBlob); err != nil {
+			return nil, errors.Wrap(err, "failed to save thumbnail")
+		}
 	}
-	return thumbnailBlob, nil
+	return thumbnails[size], nil
 }
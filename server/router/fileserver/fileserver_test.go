@@ -0,0 +1,80 @@
+package fileserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestServeSharedAttachmentFile(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	service := NewFileServerService(&profile.Profile{Driver: "sqlite", Data: t.TempDir()}, stores, "secret")
+	e := echo.New()
+	service.RegisterRoutes(e)
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	createMemo := func(uid string) *store.Memo {
+		memo, err := stores.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user.ID, Content: uid, Visibility: store.Private})
+		require.NoError(t, err)
+		_, err = stores.CreateAttachment(ctx, &store.Attachment{UID: uid + "-file", CreatorID: user.ID, Filename: "notes.txt", Type: "text/plain", Blob: []byte(uid), Size: int64(len(uid)), MemoID: &memo.ID})
+		require.NoError(t, err)
+		return memo
+	}
+	shared := createMemo("shared")
+	createMemo("other")
+	share, err := stores.CreateMemoShare(ctx, &store.MemoShare{UID: "slug", MemoID: shared.ID, CreatorID: user.ID, CreatedTs: time.Now().Unix(), MaxViews: 1})
+	require.NoError(t, err)
+	viewed, err := stores.ViewMemoShare(ctx, &store.ViewMemoShare{ID: share.ID})
+	require.NoError(t, err)
+	require.True(t, viewed)
+	_, err = stores.CreateMemoShare(ctx, &store.MemoShare{UID: "expired", MemoID: shared.ID, CreatorID: user.ID, ExpiresTs: time.Now().Add(-time.Minute).Unix()})
+	require.NoError(t, err)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	// The link gives access to the attachments of its private memo, even once it ran out of views.
+	recorder := get("/file/shares/slug/attachments/shared-file/notes.txt")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "shared", recorder.Body.String())
+	require.Equal(t, sharedAttachmentCacheControl, recorder.Header().Get("Cache-Control"))
+	// The normal endpoint still requires authentication.
+	require.Equal(t, http.StatusUnauthorized, get("/file/attachments/shared-file/notes.txt").Code)
+
+	// Attachments of other memos, expired links and unknown links are not found.
+	require.Equal(t, http.StatusNotFound, get("/file/shares/slug/attachments/other-file/notes.txt").Code)
+	require.Equal(t, http.StatusNotFound, get("/file/shares/expired/attachments/shared-file/notes.txt").Code)
+	require.Equal(t, http.StatusNotFound, get("/file/shares/unknown/attachments/shared-file/notes.txt").Code)
+
+	// Links with a passphrase need an access token.
+	protected, err := stores.CreateMemoShare(ctx, &store.MemoShare{UID: "protected", MemoID: shared.ID, CreatorID: user.ID, PassphraseHash: "hash"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, get("/file/shares/protected/attachments/shared-file/notes.txt").Code)
+	token, _, err := service.authenticator.GenerateShareAccessToken(ctx, protected)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, get("/file/shares/protected/attachments/shared-file/notes.txt?token="+token).Code)
+	// Changing the passphrase invalidates the access tokens.
+	passphraseHash := "new hash"
+	require.NoError(t, stores.UpdateMemoShare(ctx, &store.UpdateMemoShare{ID: protected.ID, PassphraseHash: &passphraseHash}))
+	require.Equal(t, http.StatusUnauthorized, get("/file/shares/protected/attachments/shared-file/notes.txt?token="+token).Code)
+
+	// Links of memos moved to the trash are not found.
+	deletedSec := time.Now().Unix()
+	require.NoError(t, stores.UpdateMemo(ctx, &store.UpdateMemo{ID: shared.ID, DeletedTs: &deletedSec}))
+	require.Equal(t, http.StatusNotFound, get("/file/shares/slug/attachments/shared-file/notes.txt").Code)
+}
@@ -1,10 +1,15 @@
 package frontend
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"embed"
+	"html"
 	"io/fs"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -29,7 +34,7 @@ func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendS
 	}
 }
 
-func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
+func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	skipper := func(c echo.Context) bool {
 		// Skip API routes.
 		if util.HasPrefixes(c.Path(), "/api", "/memos.api.v1") {
@@ -54,6 +59,40 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		HTML5:      true, // Enable fallback to index.html
 		Skipper:    skipper,
 	}))
+
+	// The pages of public memos link to their oEmbed, for embedders that discover it from the page.
+	e.GET("/memos/:uid", s.serveMemoPage)
+}
+
+// serveMemoPage serves the main app for a memo page, with the oEmbed discovery link of the memo if
+// it's public and anyone can see it. The page of a memo is at its UID or its slug.
+func (s *FrontendService) serveMemoPage(c echo.Context) error {
+	ctx := c.Request().Context()
+	index, err := fs.ReadFile(embeddedFiles, "dist/index.html")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "index.html not found").SetInternal(err)
+	}
+
+	uid := c.Param("uid")
+	memo, _, err := s.Store.GetPublicMemo(ctx, uid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memo").SetInternal(err)
+	}
+	if memo != nil {
+		// Previous slugs redirect to the current one while they are kept.
+		if uid != memo.UID && uid != memo.Slug {
+			return c.Redirect(http.StatusFound, "/memos/"+url.PathEscape(cmp.Or(memo.Slug, memo.UID)))
+		}
+		baseURL := c.Scheme() + "://" + c.Request().Host
+		if s.Profile.InstanceURL != "" {
+			baseURL = strings.TrimSuffix(s.Profile.InstanceURL, "/")
+		}
+		memoURL := baseURL + "/memos/" + url.PathEscape(memo.UID)
+		oEmbedURL := baseURL + "/api/oembed?" + url.Values{"format": {"json"}, "url": {memoURL}}.Encode()
+		link := `<link rel="alternate" type="application/json+oembed" href="` + html.EscapeString(oEmbedURL) + `" />`
+		index = bytes.Replace(index, []byte("</head>"), []byte(link+"</head>"), 1)
+	}
+	return c.HTMLBlob(http.StatusOK, index)
 }
 
 func getFileSystem(path string) http.FileSystem {
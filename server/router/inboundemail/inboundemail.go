@@ -0,0 +1,220 @@
+package inboundemail
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxInboundEmailSize is the maximum size of a posted email, attachments included. The
+	// attachments are also limited by the upload size limit of the instance.
+	maxInboundEmailSize = 64 << 20
+	// maxInboundEmailAttachments is the maximum number of attachments of an email.
+	maxInboundEmailAttachments = 20
+	// inboundEmailInterval and inboundEmailBurst limit the emails of a user to 10 at once, then
+	// 10 an hour.
+	inboundEmailInterval = 6 * time.Minute
+	inboundEmailBurst    = 10
+)
+
+// subjectTagRegexp matches the #tags of the subjects of emails.
+var subjectTagRegexp = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_/-]+)`)
+
+// Service turns the emails sent to the inbound email addresses of the users into memos. Each
+// user has a secret address, whose local part is a token, on the inbound domain of the instance.
+// The emails are posted by a mail provider, e.g. a Mailgun inbound route or an SES receipt rule.
+type Service struct {
+	store *store.Store
+	api   *apiv1.APIV1Service
+
+	// limiters maps user IDs to the rate limiters of their emails.
+	limiters sync.Map
+}
+
+func NewService(store *store.Store, api *apiv1.APIV1Service) *Service {
+	return &Service{
+		store: store,
+		api:   api,
+	}
+}
+
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.POST("/email/inbound", s.receiveEmail)
+}
+
+// receiveEmail creates a memo from a posted email. The emails that can't be delivered, e.g.
+// those sent to unknown addresses, are accepted and dropped, so that the mail provider doesn't
+// retry them and the sender can't tell the valid addresses from the others.
+func (s *Service) receiveEmail(c echo.Context) error {
+	ctx := c.Request().Context()
+	emailSetting, err := s.store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get instance email setting").SetInternal(err)
+	}
+	if emailSetting.InboundDomain == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "inbound email is disabled")
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxInboundEmailSize)
+	msg, err := parseMessage(c.Request())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid email").SetInternal(err)
+	}
+
+	user, err := s.findRecipient(ctx, msg.recipients, emailSetting.InboundDomain)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find recipient").SetInternal(err)
+	}
+	if user == nil {
+		slog.Info("dropped inbound email to an unknown address", "recipients", len(msg.recipients))
+		return c.NoContent(http.StatusOK)
+	}
+	limiter, _ := s.limiters.LoadOrStore(user.ID, rate.NewLimiter(rate.Every(inboundEmailInterval), inboundEmailBurst))
+	if !limiter.(*rate.Limiter).Allow() {
+		slog.Warn("dropped inbound email over the rate limit", "user", user.ID)
+		return c.NoContent(http.StatusOK)
+	}
+
+	if err := s.createMemo(context.WithValue(ctx, auth.UserIDContextKey, user.ID), msg); err != nil {
+		if status.Code(err) == codes.Internal {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to create memo").SetInternal(err)
+		}
+		slog.Warn("dropped inbound email", "user", user.ID, "error", err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// findRecipient returns the user whose inbound email address is one of the recipients of an
+// email, or nil if there's none. Subaddresses, e.g. name+token@domain, are matched too.
+func (s *Service) findRecipient(ctx context.Context, recipients []string, domain string) (*store.User, error) {
+	tokens := []string{}
+	for _, recipient := range recipients {
+		at := strings.LastIndex(recipient, "@")
+		if at < 0 || !strings.EqualFold(recipient[at+1:], domain) {
+			continue
+		}
+		local := strings.ToLower(recipient[:at])
+		tokens = append(tokens, local)
+		if strings.Contains(local, "+") {
+			tokens = append(tokens, strings.Split(local, "+")...)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	settings, err := s.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_INBOUND_EMAIL})
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range settings {
+		token := setting.GetInboundEmail().GetToken()
+		if token == "" {
+			continue
+		}
+		for _, candidate := range tokens {
+			if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) != 1 {
+				continue
+			}
+			user, err := s.store.GetUser(ctx, &store.FindUser{ID: &setting.UserId})
+			if err != nil {
+				return nil, err
+			}
+			// Archived and suspended users can't create memos.
+			if user == nil || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+				return nil, nil
+			}
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+// createMemo creates a private memo from an email, as the current user of the context. The
+// attachments that can't be uploaded, e.g. those over the upload size limit, are left out.
+func (s *Service) createMemo(ctx context.Context, msg *message) error {
+	attachments := []*v1pb.Attachment{}
+	for index, item := range msg.attachments {
+		if index >= maxInboundEmailAttachments {
+			slog.Warn("dropped inbound email attachments over the limit", "count", len(msg.attachments)-index)
+			break
+		}
+		contentType := item.contentType
+		if contentType == "" {
+			contentType = http.DetectContentType(item.data)
+		}
+		attachment, err := s.api.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{
+				Filename: item.filename,
+				Type:     contentType,
+				Content:  item.data,
+			},
+		})
+		if err != nil {
+			slog.Warn("failed to upload inbound email attachment", "filename", item.filename, "error", err)
+			continue
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	content := msg.content()
+	if content == "" && len(attachments) == 0 {
+		return status.Errorf(codes.InvalidArgument, "empty email")
+	}
+	memo := &v1pb.Memo{
+		Content:     content,
+		Visibility:  v1pb.Visibility_PRIVATE,
+		Attachments: attachments,
+	}
+	if _, err := s.api.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: memo}); err != nil {
+		for _, attachment := range attachments {
+			if _, err := s.api.DeleteAttachment(ctx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name}); err != nil {
+				slog.Warn("failed to delete inbound email attachment", "attachment", attachment.Name, "error", err)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// content returns the markdown content of the memo of an email: its subject as a heading,
+// without its #tags, followed by its body, preferably its text one, and the tags.
+func (m *message) content() string {
+	parts := []string{}
+	tags := []string{}
+	for _, match := range subjectTagRegexp.FindAllStringSubmatch(m.subject, -1) {
+		tags = append(tags, "#"+match[1])
+	}
+	subject := subjectTagRegexp.ReplaceAllString(m.subject, "")
+	if subject = strings.Join(strings.Fields(subject), " "); subject != "" {
+		parts = append(parts, "# "+subject)
+	}
+	body := strings.TrimSpace(strings.ReplaceAll(m.text, "\r\n", "\n"))
+	if body == "" && m.html != "" {
+		body = htmlToMarkdown(m.html)
+	}
+	if body != "" {
+		parts = append(parts, body)
+	}
+	if len(tags) > 0 {
+		parts = append(parts, strings.Join(tags, " "))
+	}
+	return strings.Join(parts, "\n\n")
+}
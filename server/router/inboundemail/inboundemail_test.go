@@ -0,0 +1,180 @@
+package inboundemail
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apitest "github.com/usememos/memos/server/router/api/v1/test"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	testInboundDomain = "in.example.com"
+	testInboundToken  = "memos-0123456789abcdef0123456789abcdef"
+)
+
+// newTestService returns an echo server with the inbound email routes, and a user with an
+// inbound email address.
+func newTestService(t *testing.T) (*echo.Echo, *store.Store, *store.User) {
+	t.Helper()
+	ctx := context.Background()
+	fixture := apitest.NewTestService(t)
+	t.Cleanup(fixture.Cleanup)
+	stores := fixture.Store
+
+	_, err := stores.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: &storepb.InstanceEmailSetting{InboundDomain: testInboundDomain}},
+	})
+	require.NoError(t, err)
+	user, err := fixture.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	inboundEmail := &storepb.InboundEmailUserSetting{Token: testInboundToken, CreateTime: timestamppb.Now()}
+	require.NoError(t, stores.UpsertUserInboundEmail(ctx, user.ID, inboundEmail))
+
+	e := echo.New()
+	NewService(stores, fixture.Service).RegisterRoutes(e.Group(""))
+	return e, stores, user
+}
+
+func postEmail(t *testing.T, e *echo.Echo, target, contentType string, body []byte) int {
+	t.Helper()
+	request := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, request)
+	return recorder.Code
+}
+
+func listMemos(t *testing.T, stores *store.Store, user *store.User) []*store.Memo {
+	t.Helper()
+	memos, err := stores.ListMemos(context.Background(), &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	return memos
+}
+
+func TestReceiveMailgunEmail(t *testing.T) {
+	e, stores, user := newTestService(t)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fields := map[string]string{
+		"recipient":        "Memos <" + testInboundToken + "@IN.example.com>",
+		"subject":          "Groceries #shopping #todo",
+		"body-plain":       "Milk\r\nEggs",
+		"body-html":        "<p>Milk</p>",
+		"attachment-count": "2",
+		"content-id-map":   `{"<logo>": "attachment-2"}`,
+	}
+	for key, value := range fields {
+		require.NoError(t, writer.WriteField(key, value))
+	}
+	file, err := writer.CreateFormFile("attachment-1", "list.txt")
+	require.NoError(t, err)
+	_, err = file.Write([]byte("milk, eggs"))
+	require.NoError(t, err)
+	file, err = writer.CreateFormFile("attachment-2", "logo.png")
+	require.NoError(t, err)
+	_, err = file.Write([]byte("logo"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.Equal(t, http.StatusOK, postEmail(t, e, "/email/inbound", writer.FormDataContentType(), body.Bytes()))
+
+	memos := listMemos(t, stores, user)
+	require.Len(t, memos, 1)
+	require.Equal(t, "# Groceries\n\nMilk\nEggs\n\n#shopping #todo", memos[0].Content)
+	require.Equal(t, store.Private, memos[0].Visibility)
+	attachments, err := stores.ListAttachments(context.Background(), &store.FindAttachment{MemoID: &memos[0].ID})
+	require.NoError(t, err)
+	// The inline logo isn't an attachment.
+	require.Len(t, attachments, 1)
+	require.Equal(t, "list.txt", attachments[0].Filename)
+}
+
+func TestReceiveMIMEEmail(t *testing.T) {
+	e, stores, user := newTestService(t)
+
+	raw := strings.Join([]string{
+		"From: Someone <someone@example.org>",
+		"To: " + testInboundToken + "@" + testInboundDomain,
+		"Subject: =?utf-8?q?Caf=C3=A9?=",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="outer"`,
+		"",
+		"--outer",
+		"Content-Type: text/html; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		`<html><head><style>p{}</style></head><body><h2>Menu</h2><p>Hello <b>there</b>, see <a href=3D"https://example.org">the site</a>.</p><ul><li>One</li><li>Two</li></ul><img src=3D"https://example.org/pixel.gif"><a href=3D"javascript:alert(1)">click</a></body></html>`,
+		"--outer",
+		"Content-Type: application/pdf",
+		`Content-Disposition: attachment; filename="menu.pdf"`,
+		"Content-Transfer-Encoding: base64",
+		"",
+		"JVBERi0xLjQ=",
+		"--outer--",
+		"",
+	}, "\r\n")
+	require.Equal(t, http.StatusOK, postEmail(t, e, "/email/inbound", "message/rfc822", []byte(raw)))
+
+	memos := listMemos(t, stores, user)
+	require.Len(t, memos, 1)
+	require.Equal(t, "# Café\n\n## Menu\n\nHello **there**, see [the site](https://example.org).\n\n- One\n- Two\n\nclick", memos[0].Content)
+	attachments, err := stores.ListAttachments(context.Background(), &store.FindAttachment{MemoID: &memos[0].ID, GetBlob: true})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, "menu.pdf", attachments[0].Filename)
+	require.Equal(t, "%PDF-1.4", string(attachments[0].Blob))
+}
+
+func TestReceiveEmailToUnknownAddress(t *testing.T) {
+	e, stores, user := newTestService(t)
+
+	for _, recipient := range []string{"memos-unknown@" + testInboundDomain, testInboundToken + "@other.example.com"} {
+		raw := "To: " + recipient + "\r\nSubject: Hello\r\n\r\nHello"
+		require.Equal(t, http.StatusOK, postEmail(t, e, "/email/inbound", "message/rfc822", []byte(raw)))
+	}
+	require.Empty(t, listMemos(t, stores, user))
+
+	// The envelope recipient, e.g. of a subaddress, is matched too.
+	raw := "To: someone@example.org\r\nSubject: Hello\r\n\r\nHello"
+	target := "/email/inbound?recipient=notes%2B" + testInboundToken + "%40" + testInboundDomain
+	require.Equal(t, http.StatusOK, postEmail(t, e, target, "message/rfc822", []byte(raw)))
+	require.Len(t, listMemos(t, stores, user), 1)
+}
+
+func TestReceiveEmailRateLimit(t *testing.T) {
+	e, stores, user := newTestService(t)
+
+	for i := 0; i < inboundEmailBurst+2; i++ {
+		raw := "To: " + testInboundToken + "@" + testInboundDomain + "\r\nSubject: Hello\r\n\r\nHello"
+		require.Equal(t, http.StatusOK, postEmail(t, e, "/email/inbound", "message/rfc822", []byte(raw)))
+	}
+	require.Len(t, listMemos(t, stores, user), inboundEmailBurst)
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	tests := []struct {
+		html string
+		want string
+	}{
+		{html: "<p>One<br>Two</p><p>Three</p>", want: "One\nTwo\n\nThree"},
+		{html: "<ol><li>First</li><li>Second<ul><li>Nested</li></ul></li></ol>", want: "1. First\n2. Second\n  - Nested"},
+		{html: "<blockquote><p>Quoted</p><p>Text</p></blockquote>", want: "> Quoted\n>\n> Text"},
+		{html: "<pre><code>a  &lt;b&gt;\n  c</code></pre>", want: "```\na  <b>\n  c\n```"},
+		{html: "<p>1 &lt; 2 <i>and</i> <code>x</code></p><script>alert(1)</script>", want: "1 &lt; 2 _and_ `x`"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, htmlToMarkdown(test.html), test.html)
+	}
+}
@@ -0,0 +1,219 @@
+package inboundemail
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	whitespaceRegexp = regexp.MustCompile(`\s+`)
+	blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToMarkdown converts the HTML body of an email to markdown. Only the text, the links and
+// the basic formatting are kept: the images, which are mostly remote or tracking pixels, the
+// scripts, the styles and the raw HTML are dropped.
+func htmlToMarkdown(source string) string {
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		return ""
+	}
+	c := &markdownConverter{}
+	c.convert(doc)
+	return c.String()
+}
+
+type markdownConverter struct {
+	out strings.Builder
+	// lists are the lists the converter is in, innermost last, with the number of their items
+	// so far. The number is negative for unordered lists.
+	lists []int
+	// pre is set in preformatted elements, whose whitespace is kept.
+	pre bool
+}
+
+// String returns the markdown, without trailing spaces and consecutive blank lines.
+func (c *markdownConverter) String() string {
+	lines := strings.Split(c.out.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(blankLinesRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+func (c *markdownConverter) convert(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.writeText(n.Data)
+		return
+	case html.ElementNode:
+	default:
+		c.convertChildren(n)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Title, atom.Img, atom.Svg, atom.Iframe, atom.Object, atom.Template:
+	case atom.Br:
+		c.out.WriteString("\n")
+	case atom.P, atom.Div, atom.Table, atom.Tr, atom.Section, atom.Article, atom.Header, atom.Footer:
+		c.block()
+		c.convertChildren(n)
+		c.block()
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.block()
+		c.out.WriteString(strings.Repeat("#", int(n.Data[1]-'0')) + " ")
+		c.convertChildren(n)
+		c.block()
+	case atom.Strong, atom.B:
+		c.wrap(n, "**")
+	case atom.Em, atom.I:
+		c.wrap(n, "_")
+	case atom.S, atom.Del, atom.Strike:
+		c.wrap(n, "~~")
+	case atom.Code:
+		if c.pre {
+			c.convertChildren(n)
+		} else {
+			c.wrap(n, "`")
+		}
+	case atom.Pre:
+		c.block()
+		c.out.WriteString("```\n")
+		c.pre = true
+		c.convertChildren(n)
+		c.pre = false
+		c.out.WriteString("\n```")
+		c.block()
+	case atom.A:
+		c.convertLink(n)
+	case atom.Ul, atom.Ol:
+		if len(c.lists) == 0 {
+			c.block()
+		}
+		count := -1
+		if n.DataAtom == atom.Ol {
+			count = 0
+		}
+		c.lists = append(c.lists, count)
+		c.convertChildren(n)
+		c.lists = c.lists[:len(c.lists)-1]
+		if len(c.lists) == 0 {
+			c.block()
+		}
+	case atom.Li:
+		c.convertListItem(n)
+	case atom.Blockquote:
+		sub := &markdownConverter{}
+		sub.convertChildren(n)
+		c.block()
+		for _, line := range strings.Split(sub.String(), "\n") {
+			c.out.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+		}
+		c.block()
+	case atom.Hr:
+		c.block()
+		c.out.WriteString("---")
+		c.block()
+	case atom.Td, atom.Th:
+		c.convertChildren(n)
+		c.out.WriteString(" ")
+	default:
+		c.convertChildren(n)
+	}
+}
+
+func (c *markdownConverter) convertChildren(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.convert(child)
+	}
+}
+
+// writeText writes the text of a node. Outside of preformatted elements, its whitespace is
+// collapsed, and its angle brackets are escaped so that it isn't rendered as HTML.
+func (c *markdownConverter) writeText(text string) {
+	if c.pre {
+		c.out.WriteString(text)
+		return
+	}
+	text = whitespaceRegexp.ReplaceAllString(text, " ")
+	if current := c.out.String(); current == "" || strings.HasSuffix(current, "\n") || strings.HasSuffix(current, " ") {
+		text = strings.TrimLeft(text, " ")
+	}
+	c.out.WriteString(strings.NewReplacer("<", "&lt;", ">", "&gt;").Replace(text))
+}
+
+// block starts a new paragraph, unless the output is empty or already ends with one.
+func (c *markdownConverter) block() {
+	current := c.out.String()
+	switch {
+	case current == "" || strings.HasSuffix(current, "\n\n"):
+	case strings.HasSuffix(current, "\n"):
+		c.out.WriteString("\n")
+	default:
+		c.out.WriteString("\n\n")
+	}
+}
+
+func (c *markdownConverter) wrap(n *html.Node, marker string) {
+	sub := &markdownConverter{pre: c.pre}
+	sub.convertChildren(n)
+	if text := sub.String(); text != "" {
+		c.writeSeparator()
+		c.out.WriteString(marker + text + marker)
+	}
+}
+
+// writeSeparator writes a space before inline markup following a word, whose leading
+// whitespace is dropped by the sub-converter.
+func (c *markdownConverter) writeSeparator() {
+	if current := c.out.String(); current != "" && !strings.HasSuffix(current, " ") && !strings.HasSuffix(current, "\n") && !strings.HasSuffix(current, "(") {
+		c.out.WriteString(" ")
+	}
+}
+
+// convertLink converts a link to a markdown link, if its URL is a web or email address. The
+// other links, e.g. javascript: URLs, are converted to their text.
+func (c *markdownConverter) convertLink(n *html.Node) {
+	href := ""
+	for _, attr := range n.Attr {
+		if attr.Key == "href" {
+			href = strings.TrimSpace(attr.Val)
+		}
+	}
+	u, err := url.Parse(href)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "mailto") {
+		c.convertChildren(n)
+		return
+	}
+	sub := &markdownConverter{}
+	sub.convertChildren(n)
+	text := strings.Join(strings.Fields(sub.String()), " ")
+	if text == "" {
+		text = href
+	}
+	c.writeSeparator()
+	c.out.WriteString("[" + strings.NewReplacer("[", `\[`, "]", `\]`).Replace(text) + "](" + strings.NewReplacer("(", "%28", ")", "%29", " ", "%20").Replace(href) + ")")
+}
+
+func (c *markdownConverter) convertListItem(n *html.Node) {
+	if !strings.HasSuffix(c.out.String(), "\n") && c.out.Len() > 0 {
+		c.out.WriteString("\n")
+	}
+	depth := max(len(c.lists), 1)
+	marker := "- "
+	if len(c.lists) > 0 && c.lists[depth-1] >= 0 {
+		c.lists[depth-1]++
+		marker = strconv.Itoa(c.lists[depth-1]) + ". "
+	}
+	c.out.WriteString(strings.Repeat("  ", depth-1) + marker)
+	c.convertChildren(n)
+	if !strings.HasSuffix(c.out.String(), "\n") {
+		c.out.WriteString("\n")
+	}
+}
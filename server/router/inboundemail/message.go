@@ -0,0 +1,276 @@
+package inboundemail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+const (
+	// maxMultipartMemory is the size of the posted forms kept in memory, the rest is stored in
+	// temporary files.
+	maxMultipartMemory = 32 << 20
+	// maxMIMEDepth is the maximum nesting of the multipart bodies of an email.
+	maxMIMEDepth = 10
+)
+
+// message is an inbound email.
+type message struct {
+	// recipients are the addresses the email was sent to, the envelope recipients first.
+	recipients []string
+	subject    string
+	text       string
+	html       string
+	// attachments are the files attached to the email. Inline files, e.g. the images of HTML
+	// emails, aren't attachments.
+	attachments []*attachment
+}
+
+type attachment struct {
+	filename    string
+	contentType string
+	data        []byte
+}
+
+// parseMessage parses a posted email. The emails are posted as the forms of Mailgun inbound
+// routes, either parsed or with the raw message in the body-mime field, or as raw messages with
+// the message/rfc822 content type, e.g. by an SES receipt rule. The envelope recipient of raw
+// messages is the recipient query parameter, if set.
+func parseMessage(r *http.Request) (*message, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid content type")
+	}
+	switch mediaType {
+	case "message/rfc822":
+		msg, err := parseMIMEMessage(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if recipient := r.URL.Query().Get("recipient"); recipient != "" {
+			msg.recipients = append(splitAddresses(recipient), msg.recipients...)
+		}
+		return msg, nil
+	case "multipart/form-data", "application/x-www-form-urlencoded":
+		return parseMailgunMessage(r)
+	default:
+		return nil, errors.Errorf("unsupported content type %q", mediaType)
+	}
+}
+
+// parseMailgunMessage parses the form of a Mailgun inbound route.
+func parseMailgunMessage(r *http.Request) (*message, error) {
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return nil, errors.Wrap(err, "invalid form")
+	}
+	if r.MultipartForm != nil {
+		defer r.MultipartForm.RemoveAll()
+	}
+	recipients := splitAddresses(r.FormValue("recipient"))
+	if bodyMIME := r.FormValue("body-mime"); bodyMIME != "" {
+		msg, err := parseMIMEMessage(strings.NewReader(bodyMIME))
+		if err != nil {
+			return nil, err
+		}
+		msg.recipients = append(recipients, msg.recipients...)
+		return msg, nil
+	}
+
+	msg := &message{
+		recipients: recipients,
+		subject:    r.FormValue("subject"),
+		text:       r.FormValue("body-plain"),
+		html:       r.FormValue("body-html"),
+	}
+	if r.MultipartForm == nil {
+		return msg, nil
+	}
+	// The content ID map maps the content IDs of the inline files to their fields.
+	inline := map[string]bool{}
+	contentIDMap := map[string]string{}
+	if value := r.FormValue("content-id-map"); value != "" {
+		if err := json.Unmarshal([]byte(value), &contentIDMap); err != nil {
+			return nil, errors.Wrap(err, "invalid content ID map")
+		}
+	}
+	for _, field := range contentIDMap {
+		inline[field] = true
+	}
+	count, _ := strconv.Atoi(r.FormValue("attachment-count"))
+	for i := 1; i <= count; i++ {
+		field := "attachment-" + strconv.Itoa(i)
+		files := r.MultipartForm.File[field]
+		if inline[field] || len(files) == 0 {
+			continue
+		}
+		data, err := readFormFile(files[0])
+		if err != nil {
+			return nil, err
+		}
+		msg.attachments = append(msg.attachments, &attachment{
+			filename:    files[0].Filename,
+			contentType: files[0].Header.Get("Content-Type"),
+			data:        data,
+		})
+	}
+	return msg, nil
+}
+
+func readFormFile(header *multipart.FileHeader) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open attachment")
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read attachment")
+	}
+	return data, nil
+}
+
+// parseMIMEMessage parses a raw email. The recipients are those of its headers, the address it
+// was delivered to first.
+func parseMIMEMessage(r io.Reader) (*message, error) {
+	mailMessage, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid message")
+	}
+	msg := &message{subject: decodeHeader(mailMessage.Header.Get("Subject"))}
+	for _, key := range []string{"X-Original-To", "Delivered-To", "To", "Cc"} {
+		addresses, err := mailMessage.Header.AddressList(key)
+		if err != nil {
+			continue
+		}
+		for _, address := range addresses {
+			msg.recipients = append(msg.recipients, address.Address)
+		}
+	}
+	if err := msg.readPart(textproto.MIMEHeader(mailMessage.Header), mailMessage.Body, 0); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// readPart reads a part of the body of an email: the first text and HTML bodies, and the
+// attachments.
+func (m *message) readPart(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxMIMEDepth {
+		return errors.New("message too deeply nested")
+	}
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			// Raw parts keep their transfer encoding, which is decoded below for all of them.
+			part, err := reader.NextRawPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return errors.Wrap(err, "invalid multipart body")
+			}
+			if err := m.readPart(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	default:
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read body")
+	}
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := decodeHeader(dispositionParams["filename"])
+	if filename == "" {
+		filename = decodeHeader(params["name"])
+	}
+	// Files without a disposition are attachments unless they're referenced by a content ID.
+	isAttachment := disposition == "attachment" || (disposition == "" && filename != "" && header.Get("Content-Id") == "")
+	switch {
+	case isAttachment:
+		if filename == "" {
+			filename = "attachment"
+		}
+		m.attachments = append(m.attachments, &attachment{filename: filename, contentType: mediaType, data: data})
+	case mediaType == "text/plain" && m.text == "":
+		m.text = decodeCharset(data, params["charset"])
+	case mediaType == "text/html" && m.html == "":
+		m.html = decodeCharset(data, params["charset"])
+	default:
+	}
+	return nil
+}
+
+// decodeHeader decodes the encoded words of a header, e.g. =?utf-8?q?caf=C3=A9?=.
+func decodeHeader(value string) string {
+	decoder := &mime.WordDecoder{CharsetReader: charsetReader}
+	decoded, err := decoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+	return decoded
+}
+
+// decodeCharset converts text in a charset to UTF-8. Unknown charsets are kept as is.
+func decodeCharset(data []byte, charset string) string {
+	reader, err := charsetReader(charset, bytes.NewReader(data))
+	if err != nil {
+		return string(data)
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return string(data)
+	}
+	return string(decoded)
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	charset = strings.ToLower(charset)
+	if charset == "" || charset == "utf-8" || charset == "us-ascii" {
+		return input, nil
+	}
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, err
+	}
+	return encoding.NewDecoder().Reader(input), nil
+}
+
+// splitAddresses splits a list of addresses separated by commas, with or without names.
+func splitAddresses(value string) []string {
+	addresses := []string{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if address, err := mail.ParseAddress(part); err == nil {
+			part = address.Address
+		}
+		addresses = append(addresses, part)
+	}
+	return addresses
+}
@@ -0,0 +1,110 @@
+package linkpreview
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// maxBatchSize is the maximum number of URLs accepted by a batch request.
+	maxBatchSize = 20
+	// batchWorkers bounds the number of concurrent fetches per batch request.
+	batchWorkers = 5
+)
+
+// batchPreviewRequest is the body of POST /api/link/preview/batch.
+type batchPreviewRequest struct {
+	URLs []string `json:"urls"`
+}
+
+// handleBatchPreview fetches metadata for several URLs at once.
+// Individual failures are reported per URL in an "error" field and never fail the whole batch.
+// URLs outside the domain allowlist are reported as failures without being fetched, and
+// every other uncached URL counts against the user's rate limit, capped at the burst size.
+func (s *Service) handleBatchPreview(c echo.Context) error {
+	user, err := s.authenticate(c.Request())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized").SetInternal(err)
+	}
+
+	request := &batchPreviewRequest{}
+	if err := c.Bind(request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body").SetInternal(err)
+	}
+
+	urls := dedupeURLs(request.URLs)
+	if len(urls) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "urls is required")
+	}
+	if len(urls) > maxBatchSize {
+		return echo.NewHTTPError(http.StatusBadRequest, "too many urls")
+	}
+
+	ctx := c.Request().Context()
+	setting, err := s.loadSetting(ctx)
+	if err != nil {
+		return err
+	}
+	results := make(map[string]map[string]any, len(urls))
+	allowed := make([]string, 0, len(urls))
+	uncached := 0
+	for _, rawURL := range urls {
+		if err := checkURLAllowed(setting, rawURL); err != nil {
+			results[rawURL] = map[string]any{"url": rawURL, "error": err.Error()}
+			continue
+		}
+		allowed = append(allowed, rawURL)
+		if !s.isCached(ctx, rawURL) {
+			uncached++
+		}
+	}
+	if err := s.checkRateLimit(c, user, setting, uncached); err != nil {
+		return err
+	}
+
+	fetchCtx := withPolicy(ctx, setting)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan string)
+	for range min(batchWorkers, len(allowed)) {
+		wg.Go(func() {
+			for rawURL := range jobs {
+				entry, cached := s.fetch(fetchCtx, rawURL)
+				var result map[string]any
+				if err := checkEntryAllowed(setting, entry); err != nil {
+					result = map[string]any{"url": rawURL, "error": err.Error()}
+				} else {
+					result = buildPreviewResponse(rawURL, entry, cached)
+				}
+				mu.Lock()
+				results[rawURL] = result
+				mu.Unlock()
+			}
+		})
+	}
+	for _, rawURL := range allowed {
+		jobs <- rawURL
+	}
+	close(jobs)
+	wg.Wait()
+
+	return c.JSON(http.StatusOK, results)
+}
+
+// dedupeURLs trims the URLs and removes blanks and duplicates, preserving order.
+func dedupeURLs(urls []string) []string {
+	seen := make(map[string]bool, len(urls))
+	deduped := make([]string, 0, len(urls))
+	for _, rawURL := range urls {
+		rawURL = strings.TrimSpace(rawURL)
+		if rawURL == "" || seen[rawURL] {
+			continue
+		}
+		seen[rawURL] = true
+		deduped = append(deduped, rawURL)
+	}
+	return deduped
+}
@@ -0,0 +1,93 @@
+package linkpreview
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+func TestDedupeURLs(t *testing.T) {
+	urls := dedupeURLs([]string{"https://a.com", " https://b.com ", "", "https://a.com", "https://b.com"})
+	require.Equal(t, []string{"https://a.com", "https://b.com"}, urls)
+}
+
+func TestHandleBatchPreview(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	httpgetter.DialForTest(t, testutil.ServeAt(t, func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := maxInFlight.Load()
+			if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+		if strings.HasPrefix(r.URL.Path, "/slow/") {
+			time.Sleep(50 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = fmt.Fprintf(w, "<html><head><title>%s</title></head></html>", r.URL.Path)
+	}))
+	// Every batch is sent by a new user, who has the whole rate limit burst left.
+	batch := func(urls []string) (int, map[string]map[string]any) {
+		e, _, _, token := newTestHandler(t)
+		body, err := json.Marshal(&batchPreviewRequest{URLs: urls})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "/api/link/preview/batch", strings.NewReader(string(body)))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		request.Header.Set("Authorization", "Bearer "+token)
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		results := map[string]map[string]any{}
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &results))
+		}
+		return recorder.Code, results
+	}
+
+	t.Run("a failing url doesn't fail the batch", func(t *testing.T) {
+		internalURL := "http://127.0.0.1/page"
+		code, results := batch([]string{upstreamURL + "/page", internalURL})
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, results, 2)
+		require.Equal(t, "/page", results[upstreamURL+"/page"]["title"])
+		require.NotContains(t, results[upstreamURL+"/page"], "error")
+		require.Equal(t, internalURL, results[internalURL]["url"])
+		require.NotEmpty(t, results[internalURL]["error"])
+	})
+
+	t.Run("too many urls", func(t *testing.T) {
+		urls := []string{}
+		for i := range maxBatchSize + 1 {
+			urls = append(urls, fmt.Sprintf("%s/page/%d", upstreamURL, i))
+		}
+		code, _ := batch(urls)
+		require.Equal(t, http.StatusBadRequest, code)
+		// Duplicates don't count against the limit.
+		code, _ = batch(append(urls[:maxBatchSize], urls[0]))
+		require.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("fetches are bounded by the worker pool", func(t *testing.T) {
+		maxInFlight.Store(0)
+		urls := []string{}
+		for i := range maxBatchSize {
+			urls = append(urls, fmt.Sprintf("%s/slow/%d", upstreamURL, i))
+		}
+		code, results := batch(urls)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, results, maxBatchSize)
+		require.Equal(t, int32(batchWorkers), maxInFlight.Load())
+	})
+}
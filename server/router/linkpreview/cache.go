@@ -0,0 +1,118 @@
+package linkpreview
+
+import (
+	"container/list"
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+// Entry is a cached link preview result.
+// Either Meta is set (successful fetch) or Err is set (negatively cached failure).
+type Entry struct {
+	Meta      *httpgetter.HTMLMeta
+	OEmbed    *httpgetter.OEmbed
+	Err       error
+	FetchedAt time.Time
+}
+
+// Cache stores link preview results keyed by normalized URL.
+// The in-memory implementation can be swapped for a persistent one later.
+type Cache interface {
+	// Get returns the entry for the key if it exists and has not expired.
+	Get(ctx context.Context, key string) (*Entry, bool)
+
+	// Set stores the entry for the key with the given TTL.
+	Set(ctx context.Context, key string, entry *Entry, ttl time.Duration)
+}
+
+// lruItem is a single element in the LRU list.
+type lruItem struct {
+	key        string
+	entry      *Entry
+	expiration time.Time
+}
+
+// memoryCache is a thread-safe in-memory LRU cache with per-entry TTL.
+type memoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	items      map[string]*list.Element
+	order      *list.List // front is most recently used
+}
+
+// NewMemoryCache creates an in-memory LRU cache holding at most maxEntries entries.
+// A non-positive maxEntries disables the size limit.
+func NewMemoryCache(maxEntries int) Cache {
+	return &memoryCache{
+		maxEntries: maxEntries,
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (*Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	itm, ok := elem.Value.(*lruItem)
+	if !ok || time.Now().After(itm.expiration) {
+		c.removeElement(elem)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return itm.entry, true
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, entry *Entry, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiration := time.Now().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		if itm, ok := elem.Value.(*lruItem); ok {
+			itm.entry = entry
+			itm.expiration = expiration
+			c.order.MoveToFront(elem)
+			return
+		}
+		c.removeElement(elem)
+	}
+
+	elem := c.order.PushFront(&lruItem{key: key, entry: entry, expiration: expiration})
+	c.items[key] = elem
+
+	// Evict the least recently used entries once we're over the limit.
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *memoryCache) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	if itm, ok := elem.Value.(*lruItem); ok {
+		delete(c.items, itm.key)
+	}
+}
+
+// normalizeCacheKey normalizes a URL for use as a cache key:
+// the scheme and host are lowercased and the fragment is stripped.
+func normalizeCacheKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String()
+}
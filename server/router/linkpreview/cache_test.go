@@ -0,0 +1,60 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+func TestMemoryCacheLRUEviction(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCache(2)
+
+	cache.Set(ctx, "a", &Entry{Meta: &httpgetter.HTMLMeta{Title: "a"}}, time.Hour)
+	cache.Set(ctx, "b", &Entry{Meta: &httpgetter.HTMLMeta{Title: "b"}}, time.Hour)
+
+	// Touch "a" so that "b" becomes the least recently used entry.
+	_, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+
+	cache.Set(ctx, "c", &Entry{Meta: &httpgetter.HTMLMeta{Title: "c"}}, time.Hour)
+
+	_, ok = cache.Get(ctx, "b")
+	require.False(t, ok)
+	entry, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+	require.Equal(t, "a", entry.Meta.Title)
+	_, ok = cache.Get(ctx, "c")
+	require.True(t, ok)
+}
+
+func TestMemoryCacheExpiration(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCache(10)
+
+	cache.Set(ctx, "a", &Entry{Err: errors.New("failed")}, 10*time.Millisecond)
+	_, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+
+	time.Sleep(20 * time.Millisecond)
+	_, ok = cache.Get(ctx, "a")
+	require.False(t, ok)
+}
+
+func TestNormalizeCacheKey(t *testing.T) {
+	tests := []struct {
+		rawURL string
+		want   string
+	}{
+		{rawURL: "https://Example.COM/Path?q=1#section", want: "https://example.com/Path?q=1"},
+		{rawURL: "HTTP://example.com/", want: "http://example.com/"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, normalizeCacheKey(test.rawURL))
+	}
+}
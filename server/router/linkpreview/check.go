@@ -0,0 +1,70 @@
+package linkpreview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/store"
+)
+
+// LinkCheck is the response to the check of a link.
+type LinkCheck struct {
+	// URL is the URL the response was served from after following redirects.
+	URL        string
+	StatusCode int
+	// Mediatype is taken from the Content-Type header, or sniffed from the body.
+	Mediatype string
+	// ContentLength is the declared body length, or -1 when unknown.
+	ContentLength int64
+}
+
+// RateLimitError is returned by CheckLink and GetLinkMetadata when the user exceeded the link preview rate limit.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", e.RetryAfter.Round(time.Second))
+}
+
+// CheckLink fetches rawURL on behalf of user to check that it responds, without reading its body.
+// The fetch is charged to the user's link preview rate limit, and is subject to the domain policy
+// and internal host allowlist of the link preview setting, even when previews are disabled.
+func (s *Service) CheckLink(ctx context.Context, user *store.User, rawURL string) (*LinkCheck, error) {
+	setting, err := s.store.GetInstanceLinkPreviewSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get link preview setting")
+	}
+	if err := checkURLAllowed(setting, rawURL); err != nil {
+		return nil, err
+	}
+	if ok, retryAfter := s.reserveFetches(user, setting, 1); !ok {
+		return nil, &RateLimitError{RetryAfter: retryAfter}
+	}
+
+	fetchCtx, cancel := context.WithTimeout(withPolicy(ctx, setting), s.config.FetchTimeout)
+	defer cancel()
+	response, err := httpgetter.GetRaw(fetchCtx, rawURL, httpgetter.RawOptions{
+		Timeout: s.config.FetchTimeout,
+		Retry:   httpgetter.DefaultRetryPolicy,
+	})
+	attempts := httpgetter.Attempts(err)
+	if response != nil {
+		attempts = response.Attempts
+	}
+	logRetries("link check", rawURL, attempts, err)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+	return &LinkCheck{
+		URL:           response.URL.String(),
+		StatusCode:    response.StatusCode,
+		Mediatype:     response.Mediatype,
+		ContentLength: response.ContentLength,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package linkpreview
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+const (
+	imageProxyPath = "/api/link/preview/image"
+	// defaultImageCacheControl is used when the upstream image has no caching headers.
+	defaultImageCacheControl = "private, max-age=3600"
+)
+
+// proxyImageURL rewrites a remote preview image URL to go through the image proxy,
+// so readers never contact the third-party host directly.
+func proxyImageURL(imageURL string) string {
+	if imageURL == "" {
+		return ""
+	}
+	return imageProxyPath + "?url=" + url.QueryEscape(imageURL)
+}
+
+// handleImage streams a remote preview image through the server.
+// The image is fetched with the same internal IP validation as previews, capped
+// in size, restricted to image/* content types and cached briefly in memory.
+// Cache misses count against the user's rate limit.
+func (s *Service) handleImage(c echo.Context) error {
+	user, err := s.authenticate(c.Request())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized").SetInternal(err)
+	}
+
+	rawURL := strings.TrimSpace(c.QueryParam("url"))
+	if rawURL == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "url is required")
+	}
+
+	ctx := c.Request().Context()
+	setting, err := s.loadSetting(ctx)
+	if err != nil {
+		return err
+	}
+	// Enforce the domain policy here too, otherwise the proxy could fetch any host.
+	if err := checkURLAllowed(setting, rawURL); err != nil {
+		return fetchHTTPError(err, "url is not allowed")
+	}
+
+	key := normalizeCacheKey(rawURL)
+	var image *httpgetter.Image
+	if value, ok := s.imageCache.Get(ctx, key); ok {
+		if cached, ok := value.(*httpgetter.Image); ok {
+			image = cached
+		}
+	}
+	if image == nil {
+		if err := s.checkRateLimit(c, user, setting, 1); err != nil {
+			return err
+		}
+		fetchCtx, cancel := context.WithTimeout(withPolicy(ctx, setting), s.config.FetchTimeout)
+		fetched, err := httpgetter.GetImage(fetchCtx, rawURL, s.config.ImageMaxSize)
+		cancel()
+		attempts := httpgetter.Attempts(err)
+		if fetched != nil {
+			attempts = fetched.Attempts
+		}
+		logRetries("image", rawURL, attempts, err)
+		if err != nil {
+			return fetchHTTPError(err, "failed to fetch image")
+		}
+		image = fetched
+		s.imageCache.Set(ctx, key, image)
+	}
+
+	header := c.Response().Header()
+	cacheControl := image.CacheControl
+	if cacheControl == "" {
+		cacheControl = defaultImageCacheControl
+	}
+	header.Set("Cache-Control", cacheControl)
+	if image.LastModified != "" {
+		header.Set("Last-Modified", image.LastModified)
+	}
+	if image.ETag != "" {
+		header.Set("ETag", image.ETag)
+		if c.Request().Header.Get("If-None-Match") == image.ETag {
+			return c.NoContent(http.StatusNotModified)
+		}
+	}
+	// Images are served from our origin, so prevent content sniffing and
+	// sandbox SVGs that might carry scripts.
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+	return c.Blob(http.StatusOK, image.Mediatype, image.Blob)
+}
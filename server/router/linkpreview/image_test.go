@@ -0,0 +1,100 @@
+package linkpreview
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/testutil"
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+func TestProxyImageURL(t *testing.T) {
+	require.Equal(t, "", proxyImageURL(""))
+	require.Equal(t, "/api/link/preview/image?url=https%3A%2F%2Fexample.com%2Fa.png%3Fw%3D1", proxyImageURL("https://example.com/a.png?w=1"))
+}
+
+func TestHandleImage(t *testing.T) {
+	maxSize := DefaultConfig().ImageMaxSize
+	httpgetter.DialForTest(t, testutil.ServeAt(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.png":
+			w.Header().Set("Content-Type", "image/png")
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Cache-Control", "public, max-age=60")
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			_, _ = w.Write([]byte("png"))
+		case "/plain.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("png"))
+		case "/max.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(bytes.Repeat([]byte("a"), int(maxSize)))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(bytes.Repeat([]byte("a"), int(maxSize)+1))
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	e, _, _, token := newTestHandler(t)
+	get := func(path string, header http.Header) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, proxyImageURL(upstreamURL+path), nil)
+		for name, values := range header {
+			request.Header[name] = values
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	t.Run("serves images with the upstream caching headers", func(t *testing.T) {
+		recorder := get("/image.png", nil)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "png", recorder.Body.String())
+		require.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+		require.Equal(t, `"v1"`, recorder.Header().Get("ETag"))
+		require.Equal(t, "public, max-age=60", recorder.Header().Get("Cache-Control"))
+		require.Equal(t, "Mon, 02 Jan 2006 15:04:05 GMT", recorder.Header().Get("Last-Modified"))
+		require.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+		require.Equal(t, "default-src 'none'; style-src 'unsafe-inline'; sandbox", recorder.Header().Get("Content-Security-Policy"))
+
+		recorder = get("/plain.png", nil)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, defaultImageCacheControl, recorder.Header().Get("Cache-Control"))
+		require.Empty(t, recorder.Header().Get("ETag"))
+		require.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+	})
+
+	t.Run("answers conditional requests with not modified", func(t *testing.T) {
+		recorder := get("/image.png", http.Header{"If-None-Match": {`"v1"`}})
+		require.Equal(t, http.StatusNotModified, recorder.Code)
+		require.Empty(t, recorder.Body.Bytes())
+		require.Equal(t, `"v1"`, recorder.Header().Get("ETag"))
+
+		recorder = get("/image.png", http.Header{"If-None-Match": {`"v0"`}})
+		require.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("caps the image size", func(t *testing.T) {
+		recorder := get("/max.png", nil)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, int(maxSize), recorder.Body.Len())
+
+		recorder = get("/large.png", nil)
+		require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	t.Run("rejects other content types", func(t *testing.T) {
+		recorder := get("/page.html", nil)
+		require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+		require.Empty(t, recorder.Header().Get("Content-Security-Policy"))
+	})
+}
@@ -1,42 +1,121 @@
 package linkpreview
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/plugin/httpgetter"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/cache"
 )
 
 // Service exposes a tiny HTTP endpoint for fetching link metadata (Open Graph).
 // It runs on the server to avoid browser CORS limits and blocks internal IPs
 // via the shared httpgetter validation.
 type Service struct {
+	store         *store.Store
 	authenticator *auth.Authenticator
+	config        Config
+	cache         Cache
+	imageCache    *cache.Cache
+	limiter       *rateLimiter
+}
+
+// Config contains options for configuring the link preview service.
+type Config struct {
+	// CacheTTL is how long a successful fetch is cached.
+	CacheTTL time.Duration
+
+	// NegativeCacheTTL is how long a failed fetch is cached, so dead links
+	// are not retried on every render.
+	NegativeCacheTTL time.Duration
+
+	// CacheMaxEntries is the maximum number of cached URLs before the least
+	// recently used entries are evicted.
+	CacheMaxEntries int
+
+	// ImageMaxSize is the maximum size in bytes of a proxied preview image.
+	ImageMaxSize int64
+
+	// ImageCacheTTL is how long proxied preview images are kept in memory.
+	ImageCacheTTL time.Duration
+
+	// ImageCacheMaxEntries is the maximum number of proxied images kept in memory.
+	ImageCacheMaxEntries int
+
+	// FetchTimeout bounds each outbound fetch.
+	FetchTimeout time.Duration
+
+	// RateLimitIdleTTL is how long an unused per-user rate limit bucket is kept.
+	RateLimitIdleTTL time.Duration
+
+	// SelfTestURL is the known URL fetched by the admin self-test endpoint.
+	SelfTestURL string
+}
+
+// DefaultConfig returns the default configuration for the link preview service.
+func DefaultConfig() Config {
+	return Config{
+		CacheTTL:         24 * time.Hour,
+		NegativeCacheTTL: 10 * time.Minute,
+		CacheMaxEntries:  1000,
+
+		ImageMaxSize:         5 << 20,
+		ImageCacheTTL:        10 * time.Minute,
+		ImageCacheMaxEntries: 50,
+
+		FetchTimeout: 10 * time.Second,
+
+		RateLimitIdleTTL: 10 * time.Minute,
+
+		SelfTestURL: "https://example.com/",
+	}
 }
 
 // NewService constructs a link preview service.
-func NewService(store *store.Store, secret string) *Service {
+func NewService(store *store.Store, secret string, config Config) *Service {
 	return &Service{
+		store:         store,
 		authenticator: auth.NewAuthenticator(store, secret),
+		config:        config,
+		cache:         NewMemoryCache(config.CacheMaxEntries),
+		imageCache: cache.New(cache.Config{
+			DefaultTTL:      config.ImageCacheTTL,
+			CleanupInterval: config.ImageCacheTTL,
+			MaxItems:        config.ImageCacheMaxEntries,
+		}),
+		limiter: newRateLimiter(config.RateLimitIdleTTL),
 	}
 }
 
 // RegisterRoutes registers HTTP routes on the provided group.
-// Path: GET /api/link/preview?url=<encoded>
+// Paths:
+//   - GET /api/link/preview?url=<encoded>
+//   - GET /api/link/preview/image?url=<encoded>
+//   - POST /api/link/preview/batch
+//   - GET /api/link/preview/selftest (admins only)
 func (s *Service) RegisterRoutes(group *echo.Group) {
 	group.GET("/api/link/preview", s.handlePreview)
+	group.GET("/api/link/preview/image", s.handleImage)
+	group.POST("/api/link/preview/batch", s.handleBatchPreview)
+	group.GET("/api/link/preview/selftest", s.handleSelfTest)
 }
 
 // handlePreview fetches Open Graph metadata for the requested URL.
 // Authentication: session cookie or Bearer token (same as other HTTP endpoints).
+// Outbound fetches are rate limited per user; cached previews are free.
 func (s *Service) handlePreview(c echo.Context) error {
 	// Require authentication (session cookie or JWT bearer)
-	if _, err := s.authenticate(c.Request()); err != nil {
+	user, err := s.authenticate(c.Request())
+	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized").SetInternal(err)
 	}
 
@@ -45,20 +124,177 @@ func (s *Service) handlePreview(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "url is required")
 	}
 
-	meta, err := httpgetter.GetHTMLMeta(rawURL)
+	ctx := c.Request().Context()
+	setting, err := s.loadSetting(ctx)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "failed to fetch metadata").SetInternal(err)
+		return err
 	}
+	if err := checkURLAllowed(setting, rawURL); err != nil {
+		return fetchHTTPError(err, "url is not allowed")
+	}
+	if !s.isCached(ctx, rawURL) {
+		if err := s.checkRateLimit(c, user, setting, 1); err != nil {
+			return err
+		}
+	}
+
+	entry, cached := s.fetch(withPolicy(ctx, setting), rawURL)
+	if err := checkEntryAllowed(setting, entry); err != nil {
+		return fetchHTTPError(err, "failed to fetch metadata")
+	}
+
+	return c.JSON(http.StatusOK, buildPreviewResponse(rawURL, entry, cached))
+}
+
+// checkEntryAllowed returns the entry's fetch error, or ErrDomainNotAllowed when a cached
+// preview landed on a host the current policy no longer allows.
+func checkEntryAllowed(setting *storepb.InstanceLinkPreviewSetting, entry *Entry) error {
+	if entry.Err != nil {
+		return entry.Err
+	}
+	if entry.Meta.FinalURL != "" {
+		return checkURLAllowed(setting, entry.Meta.FinalURL)
+	}
+	return nil
+}
 
-	return c.JSON(http.StatusOK, map[string]any{
+// buildPreviewResponse converts a successful cache entry into the preview JSON payload.
+func buildPreviewResponse(rawURL string, entry *Entry, cached bool) map[string]any {
+	response := map[string]any{
 		"url":         rawURL,
-		"title":       meta.Title,
-		"description": meta.Description,
-		"image":       meta.Image,
-	})
+		"title":       entry.Meta.Title,
+		"description": entry.Meta.Description,
+		"image":       proxyImageURL(entry.Meta.Image),
+		"favicon":     entry.Meta.Favicon,
+		"contentType": entry.Meta.ContentType,
+		"cached":      cached,
+		"fetchedAt":   entry.FetchedAt,
+	}
+	if entry.Meta.FinalURL != "" {
+		response["finalUrl"] = entry.Meta.FinalURL
+	} else {
+		response["finalUrl"] = rawURL
+	}
+	if entry.Meta.ContentType == "" {
+		// Previews built from an oEmbed provider always describe a web page.
+		response["contentType"] = "text/html"
+	}
+	if entry.Meta.ContentLength > 0 {
+		response["contentLength"] = entry.Meta.ContentLength
+	}
+	if entry.Meta.Filename != "" {
+		response["filename"] = entry.Meta.Filename
+	}
+	if oembed := entry.OEmbed; oembed != nil {
+		if oembed.HTML != "" {
+			response["html"] = oembed.HTML
+		}
+		if oembed.ProviderName != "" {
+			response["providerName"] = oembed.ProviderName
+		}
+		if oembed.Width > 0 {
+			response["width"] = oembed.Width
+		}
+		if oembed.Height > 0 {
+			response["height"] = oembed.Height
+		}
+	}
+	return response
+}
+
+// isCached reports whether a preview for the URL can be served without an outbound fetch.
+func (s *Service) isCached(ctx context.Context, rawURL string) bool {
+	_, ok := s.cache.Get(ctx, normalizeCacheKey(rawURL))
+	return ok
+}
+
+// fetch returns the preview entry for the URL, serving it from the cache when possible.
+// The returned bool reports whether the entry came from the cache.
+func (s *Service) fetch(ctx context.Context, rawURL string) (*Entry, bool) {
+	key := normalizeCacheKey(rawURL)
+	if entry, ok := s.cache.Get(ctx, key); ok {
+		return entry, true
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, s.config.FetchTimeout)
+	defer cancel()
+	entry := &Entry{FetchedAt: time.Now()}
+	meta, oembed, err := resolvePreview(fetchCtx, rawURL)
+	attempts := httpgetter.Attempts(err)
+	if meta != nil {
+		attempts = meta.Attempts
+	}
+	logRetries("preview", rawURL, attempts, err)
+	if err != nil {
+		entry.Err = err
+		// Don't remember failures caused by the client going away or by the domain policy,
+		// which may change at any time.
+		if ctx.Err() == nil && !errors.Is(err, ErrDomainNotAllowed) {
+			s.cache.Set(ctx, key, entry, s.config.NegativeCacheTTL)
+		}
+		return entry, false
+	}
+	entry.Meta = meta
+	entry.OEmbed = oembed
+	s.cache.Set(ctx, key, entry, s.config.CacheTTL)
+	return entry, false
+}
+
+// logRetries logs fetches that needed more than one attempt, to spot flaky upstreams.
+func logRetries(kind, rawURL string, attempts int, err error) {
+	if attempts <= 1 {
+		return
+	}
+	if err != nil {
+		slog.Warn("link preview fetch failed after retries", slog.String("kind", kind), slog.String("url", rawURL), slog.Int("attempts", attempts), slog.String("error", err.Error()))
+		return
+	}
+	slog.Info("link preview fetch succeeded after retries", slog.String("kind", kind), slog.String("url", rawURL), slog.Int("attempts", attempts))
 }
 
-// authenticate tries session cookie first, then bearer token.
+// resolvePreview fetches preview metadata for the URL.
+// Known oEmbed providers are queried first; otherwise the page is scraped for
+// Open Graph tags and an oEmbed discovery link.
+func resolvePreview(ctx context.Context, rawURL string) (*httpgetter.HTMLMeta, *httpgetter.OEmbed, error) {
+	if endpoint, ok := findOEmbedEndpoint(rawURL); ok {
+		if oembed, err := httpgetter.GetOEmbed(ctx, endpoint); err == nil {
+			meta := &httpgetter.HTMLMeta{}
+			mergeOEmbed(meta, oembed)
+			return meta, oembed, nil
+		}
+	}
+
+	meta, err := httpgetter.GetHTMLMeta(ctx, rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if meta.OEmbedURL != "" {
+		// oEmbed is optional; the Open Graph metadata is still usable if it fails.
+		if oembed, err := httpgetter.GetOEmbed(ctx, meta.OEmbedURL); err == nil {
+			mergeOEmbed(meta, oembed)
+			return meta, oembed, nil
+		}
+	}
+	return meta, nil, nil
+}
+
+// fetchHTTPError maps outbound fetch errors to meaningful client errors.
+func fetchHTTPError(err error, message string) *echo.HTTPError {
+	switch {
+	case errors.Is(err, ErrDomainNotAllowed):
+		return echo.NewHTTPError(http.StatusForbidden, "domain is not allowed").SetInternal(err)
+	case errors.Is(err, httpgetter.ErrTimeout):
+		return echo.NewHTTPError(http.StatusRequestTimeout, "timed out fetching url").SetInternal(err)
+	case errors.Is(err, httpgetter.ErrResponseTooLarge):
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "response is too large").SetInternal(err)
+	case errors.Is(err, httpgetter.ErrUnsupportedContentType):
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, "unsupported content type").SetInternal(err)
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, message).SetInternal(err)
+	}
+}
+
+// authenticate tries the session cookies first, then bearer token.
 func (s *Service) authenticate(r *http.Request) (*store.User, error) {
 	ctx := r.Context()
 
@@ -69,11 +305,18 @@ func (s *Service) authenticate(r *http.Request) (*store.User, error) {
 		}
 	}
 
+	// Session access token cookie
+	if cookie, err := r.Cookie(auth.AccessTokenCookieName); err == nil && cookie.Value != "" {
+		if user, _, err := s.authenticator.AuthenticateBySessionAccessToken(ctx, cookie.Value); err == nil && user != nil {
+			return user, nil
+		}
+	}
+
 	// Bearer token
 	accessToken := auth.ExtractBearerToken(r.Header.Get("Authorization"))
 	if accessToken != "" {
-		if user, err := s.authenticator.AuthenticateByJWT(ctx, accessToken); err == nil && user != nil {
-			return user, nil
+		if user, scopes, err := s.authenticator.AuthenticateByJWT(ctx, accessToken); err == nil && user != nil && auth.HasScope(scopes, auth.ScopeRead) {
+			return auth.ApplyScopes(user, scopes), nil
 		}
 	}
 
@@ -0,0 +1,111 @@
+package linkpreview
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/testutil"
+	"github.com/usememos/memos/plugin/httpgetter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+// upstreamURL is the public TEST-NET address the tests serve fetches at, through testutil.ServeAt.
+const upstreamURL = "http://203.0.113.1"
+
+// newTestHandler returns the echo instance serving the routes of a link preview service,
+// the service, and a user with an access token.
+func newTestHandler(t *testing.T) (*echo.Echo, *Service, *store.User, string) {
+	t.Helper()
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() { stores.Close() })
+
+	service := NewService(stores, "secret", DefaultConfig())
+	e := echo.New()
+	service.RegisterRoutes(e.Group(""))
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	token, err := service.authenticator.GenerateAccessToken(ctx, user.Username, user.ID, nil, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = stores.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{AccessTokens: &storepb.AccessTokensUserSetting{
+			AccessTokens: []*storepb.AccessTokensUserSetting_AccessToken{{AccessToken: token}},
+		}},
+	})
+	require.NoError(t, err)
+	return e, service, user, token
+}
+
+func TestHandlersAuthentication(t *testing.T) {
+	httpgetter.DialForTest(t, testutil.ServeAt(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/image.png" {
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("png"))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html><head><title>Page</title></head></html>"))
+	}))
+	ctx := context.Background()
+	e, service, user, token := newTestHandler(t)
+	require.NoError(t, service.store.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{
+		SessionId:        "session",
+		CreateTime:       timestamppb.Now(),
+		LastAccessedTime: timestamppb.Now(),
+	}))
+	tokenPair, err := service.authenticator.IssueTokenPair(ctx, user, "session")
+	require.NoError(t, err)
+
+	requests := map[string]func() *http.Request{
+		"preview": func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/api/link/preview?url="+upstreamURL+"/page", nil)
+		},
+		"batch": func() *http.Request {
+			request := httptest.NewRequest(http.MethodPost, "/api/link/preview/batch", strings.NewReader(`{"urls":["`+upstreamURL+`/page"]}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			return request
+		},
+		"image": func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, proxyImageURL(upstreamURL+"/image.png"), nil)
+		},
+	}
+	credentials := []struct {
+		name      string
+		authorize func(request *http.Request)
+		status    int
+	}{
+		{name: "no credentials", authorize: func(*http.Request) {}, status: http.StatusUnauthorized},
+		{name: "an invalid bearer token", authorize: func(request *http.Request) { request.Header.Set("Authorization", "Bearer invalid") }, status: http.StatusUnauthorized},
+		{name: "an access token as the session cookie", authorize: func(request *http.Request) {
+			request.AddCookie(&http.Cookie{Name: auth.AccessTokenCookieName, Value: token})
+		}, status: http.StatusUnauthorized},
+		{name: "a bearer token", authorize: func(request *http.Request) { request.Header.Set("Authorization", "Bearer "+token) }, status: http.StatusOK},
+		{name: "a session cookie", authorize: func(request *http.Request) {
+			request.AddCookie(&http.Cookie{Name: auth.AccessTokenCookieName, Value: tokenPair.AccessToken})
+		}, status: http.StatusOK},
+	}
+	for name, newRequest := range requests {
+		for _, credential := range credentials {
+			t.Run(name+" with "+credential.name, func(t *testing.T) {
+				request := newRequest()
+				credential.authorize(request)
+				recorder := httptest.NewRecorder()
+				e.ServeHTTP(recorder, request)
+				require.Equal(t, credential.status, recorder.Code)
+			})
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package linkpreview
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// ErrPreviewsDisabled is returned by GetLinkMetadata when link previews are disabled.
+var ErrPreviewsDisabled = errors.New("link previews are disabled on this instance")
+
+// LinkMetadata is the metadata of a link, taken from its Open Graph tags or oEmbed provider.
+type LinkMetadata struct {
+	// URL is the URL the metadata was served from after following redirects.
+	URL         string
+	Title       string
+	Description string
+	// Image is the URL of the preview image of the link, if any.
+	Image string
+}
+
+// GetLinkMetadata returns the metadata of rawURL on behalf of user, served from the preview cache
+// when possible. Outbound fetches are charged to the user's link preview rate limit, and are
+// subject to the domain policy and internal host allowlist of the link preview setting.
+func (s *Service) GetLinkMetadata(ctx context.Context, user *store.User, rawURL string) (*LinkMetadata, error) {
+	setting, err := s.store.GetInstanceLinkPreviewSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get link preview setting")
+	}
+	if setting.Mode == storepb.InstanceLinkPreviewSetting_DISABLED {
+		return nil, ErrPreviewsDisabled
+	}
+	if err := checkURLAllowed(setting, rawURL); err != nil {
+		return nil, err
+	}
+	if !s.isCached(ctx, rawURL) {
+		if ok, retryAfter := s.reserveFetches(user, setting, 1); !ok {
+			return nil, &RateLimitError{RetryAfter: retryAfter}
+		}
+	}
+
+	entry, _ := s.fetch(withPolicy(ctx, setting), rawURL)
+	if err := checkEntryAllowed(setting, entry); err != nil {
+		return nil, err
+	}
+	metadata := &LinkMetadata{
+		URL:         entry.Meta.FinalURL,
+		Title:       entry.Meta.Title,
+		Description: entry.Meta.Description,
+		Image:       entry.Meta.Image,
+	}
+	if metadata.URL == "" {
+		metadata.URL = rawURL
+	}
+	return metadata, nil
+}
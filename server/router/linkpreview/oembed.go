@@ -0,0 +1,61 @@
+package linkpreview
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+// oEmbedProvider describes a known oEmbed provider.
+type oEmbedProvider struct {
+	hosts    []string
+	endpoint string
+}
+
+// oEmbedProviders is the built-in provider table, used before falling back to discovery.
+var oEmbedProviders = []oEmbedProvider{
+	{
+		hosts:    []string{"youtube.com", "www.youtube.com", "m.youtube.com", "youtu.be"},
+		endpoint: "https://www.youtube.com/oembed",
+	},
+	{
+		hosts:    []string{"vimeo.com", "www.vimeo.com", "player.vimeo.com"},
+		endpoint: "https://vimeo.com/api/oembed.json",
+	},
+	{
+		hosts:    []string{"twitter.com", "www.twitter.com", "mobile.twitter.com", "x.com", "www.x.com"},
+		endpoint: "https://publish.twitter.com/oembed",
+	},
+}
+
+// findOEmbedEndpoint returns the oEmbed endpoint for rawURL from the built-in provider table.
+func findOEmbedEndpoint(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, provider := range oEmbedProviders {
+		for _, h := range provider.hosts {
+			if h != host {
+				continue
+			}
+			query := url.Values{}
+			query.Set("url", rawURL)
+			query.Set("format", "json")
+			return provider.endpoint + "?" + query.Encode(), true
+		}
+	}
+	return "", false
+}
+
+// mergeOEmbed fills missing preview fields from the oEmbed response.
+func mergeOEmbed(meta *httpgetter.HTMLMeta, oembed *httpgetter.OEmbed) {
+	if meta.Title == "" {
+		meta.Title = oembed.Title
+	}
+	if meta.Image == "" {
+		meta.Image = oembed.ThumbnailURL
+	}
+}
@@ -0,0 +1,20 @@
+package linkpreview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOEmbedEndpoint(t *testing.T) {
+	endpoint, ok := findOEmbedEndpoint("https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+	require.True(t, ok)
+	require.Equal(t, "https://www.youtube.com/oembed?format=json&url=https%3A%2F%2Fwww.youtube.com%2Fwatch%3Fv%3DdQw4w9WgXcQ", endpoint)
+
+	endpoint, ok = findOEmbedEndpoint("https://X.com/user/status/1")
+	require.True(t, ok)
+	require.Contains(t, endpoint, "https://publish.twitter.com/oembed?")
+
+	_, ok = findOEmbedEndpoint("https://example.com/page")
+	require.False(t, ok)
+}
@@ -0,0 +1,111 @@
+package linkpreview
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// ErrDomainNotAllowed is returned when a URL, or one of its redirects, is outside the allowlist.
+var ErrDomainNotAllowed = errors.New("domain is not allowed")
+
+// loadSetting returns the link preview setting, failing with 403 when previews are disabled.
+func (s *Service) loadSetting(ctx context.Context) (*storepb.InstanceLinkPreviewSetting, error) {
+	setting, err := s.store.GetInstanceLinkPreviewSetting(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get link preview setting").SetInternal(err)
+	}
+	if setting.Mode == storepb.InstanceLinkPreviewSetting_DISABLED {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "link previews are disabled on this instance")
+	}
+	return setting, nil
+}
+
+// withPolicy binds the setting's domain policy, internal host allowlist and request headers
+// to ctx, so that they are enforced for the requested URL and every redirect hop made by httpgetter.
+// The setting is read through the store's instance setting cache on every request.
+func withPolicy(ctx context.Context, setting *storepb.InstanceLinkPreviewSetting) context.Context {
+	ctx = withRequestHeaders(ctx, setting)
+	if len(setting.AllowedInternalHosts) > 0 {
+		allowlist, err := httpgetter.ParseInternalAllowlist(setting.AllowedInternalHosts)
+		if err != nil {
+			// Entries are validated when the setting is saved; fail closed if one slipped through.
+			slog.Warn("invalid link preview internal host allowlist", slog.String("error", err.Error()))
+		} else {
+			ctx = httpgetter.WithInternalAllowlist(ctx, allowlist)
+		}
+	}
+	if setting.Mode != storepb.InstanceLinkPreviewSetting_ALLOWLIST {
+		return ctx
+	}
+	return httpgetter.WithURLCheck(ctx, func(u *url.URL) error {
+		return checkDomain(setting, u.Hostname())
+	})
+}
+
+// withRequestHeaders binds the setting's User-Agent override and extra headers to ctx.
+func withRequestHeaders(ctx context.Context, setting *storepb.InstanceLinkPreviewSetting) context.Context {
+	if setting.UserAgent == "" && len(setting.RequestHeaders) == 0 {
+		return ctx
+	}
+	err := httpgetter.ValidateRequestHeaders(setting.RequestHeaders)
+	if err == nil {
+		err = httpgetter.ValidateUserAgent(setting.UserAgent)
+	}
+	if err != nil {
+		// Headers are validated when the setting is saved; never forward one that slipped through.
+		slog.Warn("invalid link preview request headers", slog.String("error", err.Error()))
+		return ctx
+	}
+	headers := http.Header{}
+	for name, value := range setting.RequestHeaders {
+		headers.Set(name, value)
+	}
+	if setting.UserAgent != "" {
+		headers.Set("User-Agent", setting.UserAgent)
+	}
+	return httpgetter.WithRequestHeaders(ctx, headers)
+}
+
+// checkURLAllowed checks the host of rawURL against the setting's domain policy.
+func checkURLAllowed(setting *storepb.InstanceLinkPreviewSetting, rawURL string) error {
+	if setting.Mode != storepb.InstanceLinkPreviewSetting_ALLOWLIST {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(ErrDomainNotAllowed, "invalid url")
+	}
+	return checkDomain(setting, u.Hostname())
+}
+
+func checkDomain(setting *storepb.InstanceLinkPreviewSetting, host string) error {
+	for _, pattern := range setting.AllowedDomains {
+		if matchDomain(pattern, host) {
+			return nil
+		}
+	}
+	return errors.Wrap(ErrDomainNotAllowed, host)
+}
+
+// matchDomain reports whether host matches the domain pattern.
+// A leading "*." matches any subdomain but not the domain itself; other patterns must match exactly.
+func matchDomain(pattern, host string) bool {
+	pattern = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(pattern)), ".")
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if pattern == "" || host == "" {
+		return false
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.HasSuffix(host, "."+suffix)
+	}
+	return host == pattern
+}
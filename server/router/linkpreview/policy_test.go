@@ -0,0 +1,52 @@
+package linkpreview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestMatchDomain(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "EXAMPLE.com.", true},
+		{"example.com", "www.example.com", false},
+		{"*.wikipedia.org", "en.wikipedia.org", true},
+		{"*.wikipedia.org", "en.m.wikipedia.org", true},
+		{"*.wikipedia.org", "wikipedia.org", false},
+		{"*.wikipedia.org", "evilwikipedia.org", false},
+		{"", "example.com", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, matchDomain(test.pattern, test.host), "%s %s", test.pattern, test.host)
+	}
+}
+
+func TestCheckURLAllowed(t *testing.T) {
+	open := &storepb.InstanceLinkPreviewSetting{Mode: storepb.InstanceLinkPreviewSetting_OPEN}
+	require.NoError(t, checkURLAllowed(open, "https://example.com/page"))
+
+	allowlist := &storepb.InstanceLinkPreviewSetting{
+		Mode:           storepb.InstanceLinkPreviewSetting_ALLOWLIST,
+		AllowedDomains: []string{"*.wikipedia.org", "github.com"},
+	}
+	require.NoError(t, checkURLAllowed(allowlist, "https://en.wikipedia.org/wiki/Go"))
+	require.NoError(t, checkURLAllowed(allowlist, "https://github.com/usememos/memos"))
+	require.ErrorIs(t, checkURLAllowed(allowlist, "https://example.com/"), ErrDomainNotAllowed)
+}
+
+func TestCheckEntryAllowedUsesFinalURL(t *testing.T) {
+	allowlist := &storepb.InstanceLinkPreviewSetting{
+		Mode:           storepb.InstanceLinkPreviewSetting_ALLOWLIST,
+		AllowedDomains: []string{"short.link"},
+	}
+	entry := &Entry{Meta: &httpgetter.HTMLMeta{FinalURL: "https://elsewhere.example/landing"}}
+	require.ErrorIs(t, checkEntryAllowed(allowlist, entry), ErrDomainNotAllowed)
+}
@@ -0,0 +1,108 @@
+package linkpreview
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// rateLimiter is an in-memory token bucket limiter keyed by user ID.
+// Buckets that have not been used for idleTTL are dropped during periodic cleanup.
+type rateLimiter struct {
+	mu          sync.Mutex
+	buckets     map[int32]*bucket
+	idleTTL     time.Duration
+	lastCleanup time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter(idleTTL time.Duration) *rateLimiter {
+	return &rateLimiter{
+		buckets:     make(map[int32]*bucket),
+		idleTTL:     idleTTL,
+		lastCleanup: time.Now(),
+	}
+}
+
+// reserve takes n tokens from the user's bucket, refilled at perMinute tokens per minute.
+// When the bucket doesn't hold enough tokens nothing is taken and the time to wait
+// before retrying is returned.
+func (l *rateLimiter) reserve(userID int32, perMinute, burst, n int, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastCleanup) >= l.idleTTL {
+		l.cleanup(now)
+	}
+
+	limit := rate.Limit(float64(perMinute) / 60)
+	b, ok := l.buckets[userID]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(limit, burst)}
+		l.buckets[userID] = b
+	} else if b.limiter.Limit() != limit || b.limiter.Burst() != burst {
+		// The instance setting changed since the bucket was created.
+		b.limiter.SetLimitAt(now, limit)
+		b.limiter.SetBurstAt(now, burst)
+	}
+	b.lastSeen = now
+
+	n = min(n, burst)
+	reservation := b.limiter.ReserveN(now, n)
+	if !reservation.OK() {
+		return false, time.Minute
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// cleanup drops the buckets that have been idle for longer than idleTTL.
+func (l *rateLimiter) cleanup(now time.Time) {
+	for userID, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.idleTTL {
+			delete(l.buckets, userID)
+		}
+	}
+	l.lastCleanup = now
+}
+
+// checkRateLimit charges n outbound fetches to the user.
+// It returns a 429 error with a Retry-After header once the user's limit is exceeded.
+// Admins are granted the higher admin limit from the instance setting.
+func (s *Service) checkRateLimit(c echo.Context, user *store.User, setting *storepb.InstanceLinkPreviewSetting, n int) error {
+	ok, retryAfter := s.reserveFetches(user, setting, n)
+	if ok {
+		return nil
+	}
+	c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded").SetInternal(errors.Errorf("user %d exceeded the link preview rate limit", user.ID))
+}
+
+// reserveFetches takes n outbound fetches from the user's bucket, returning the time to wait
+// before retrying when the user's limit is exceeded.
+func (s *Service) reserveFetches(user *store.User, setting *storepb.InstanceLinkPreviewSetting, n int) (bool, time.Duration) {
+	if n <= 0 {
+		return true, 0
+	}
+	perMinute := setting.RateLimitPerMinute
+	if user.Role == store.RoleHost || user.Role == store.RoleAdmin {
+		perMinute = setting.AdminRateLimitPerMinute
+	}
+	return s.limiter.reserve(user.ID, int(perMinute), int(setting.RateLimitBurst), n, time.Now())
+}
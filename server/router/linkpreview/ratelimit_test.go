@@ -0,0 +1,73 @@
+package linkpreview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterBurstAndRefill(t *testing.T) {
+	limiter := newRateLimiter(time.Minute)
+	now := time.Now()
+
+	for range 3 {
+		ok, _ := limiter.reserve(1, 60, 3, 1, now)
+		require.True(t, ok)
+	}
+	ok, retryAfter := limiter.reserve(1, 60, 3, 1, now)
+	require.False(t, ok)
+	require.Equal(t, time.Second, retryAfter)
+
+	// Other users have their own bucket.
+	ok, _ = limiter.reserve(2, 60, 3, 1, now)
+	require.True(t, ok)
+
+	// One token is refilled per second at 60 per minute.
+	ok, _ = limiter.reserve(1, 60, 3, 1, now.Add(time.Second))
+	require.True(t, ok)
+}
+
+func TestRateLimiterRejectedRequestIsNotCharged(t *testing.T) {
+	limiter := newRateLimiter(time.Minute)
+	now := time.Now()
+
+	ok, _ := limiter.reserve(1, 60, 2, 2, now)
+	require.True(t, ok)
+	ok, _ = limiter.reserve(1, 60, 2, 2, now.Add(time.Second))
+	require.False(t, ok)
+	// The rejected reservation must not have consumed the refilled token.
+	ok, _ = limiter.reserve(1, 60, 2, 1, now.Add(time.Second))
+	require.True(t, ok)
+}
+
+func TestRateLimiterChargeIsCappedAtBurst(t *testing.T) {
+	limiter := newRateLimiter(time.Minute)
+	ok, _ := limiter.reserve(1, 60, 3, 10, time.Now())
+	require.True(t, ok)
+}
+
+func TestRateLimiterCleanup(t *testing.T) {
+	limiter := newRateLimiter(time.Minute)
+	now := time.Now()
+
+	limiter.reserve(1, 60, 3, 1, now)
+	limiter.reserve(2, 60, 3, 1, now.Add(30*time.Second))
+	limiter.reserve(3, 60, 3, 1, now.Add(time.Minute+time.Second))
+	require.Len(t, limiter.buckets, 2)
+	require.NotContains(t, limiter.buckets, int32(1))
+}
+
+func TestRateLimiterAppliesUpdatedLimit(t *testing.T) {
+	limiter := newRateLimiter(time.Minute)
+	now := time.Now()
+
+	ok, _ := limiter.reserve(1, 60, 1, 1, now)
+	require.True(t, ok)
+	ok, _ = limiter.reserve(1, 60, 1, 1, now)
+	require.False(t, ok)
+
+	// A larger burst takes effect for the existing bucket.
+	limiter.reserve(1, 60, 5, 0, now)
+	require.Equal(t, 5, limiter.buckets[1].limiter.Burst())
+}
@@ -0,0 +1,69 @@
+package linkpreview
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/store"
+)
+
+// handleSelfTest fetches the configured self-test URL, bypassing the preview cache,
+// so that admins can check outbound connectivity and the proxy configuration.
+// Fetch failures are reported in the response body rather than as an error status.
+func (s *Service) handleSelfTest(c echo.Context) error {
+	user, err := s.authenticate(c.Request())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized").SetInternal(err)
+	}
+	if user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "permission denied")
+	}
+
+	ctx := c.Request().Context()
+	setting, err := s.store.GetInstanceLinkPreviewSetting(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get link preview setting").SetInternal(err)
+	}
+	if err := s.checkRateLimit(c, user, setting, 1); err != nil {
+		return err
+	}
+
+	response := map[string]any{
+		"url": s.config.SelfTestURL,
+	}
+	target, err := url.Parse(s.config.SelfTestURL)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "invalid self-test url").SetInternal(err)
+	}
+	proxyURL, err := httpgetter.ProxyURL(target)
+	if err != nil {
+		response["ok"] = false
+		response["error"] = err.Error()
+		return c.JSON(http.StatusOK, response)
+	}
+	if proxyURL != nil {
+		response["proxy"] = proxyURL.Redacted()
+	}
+
+	// The self-test URL isn't subject to the domain policy, but is fetched with the configured headers.
+	fetchCtx, cancel := context.WithTimeout(withRequestHeaders(ctx, setting), s.config.FetchTimeout)
+	defer cancel()
+	start := time.Now()
+	meta, err := httpgetter.GetHTMLMeta(fetchCtx, s.config.SelfTestURL)
+	response["durationMs"] = time.Since(start).Milliseconds()
+	if err != nil {
+		response["attempts"] = httpgetter.Attempts(err)
+		response["ok"] = false
+		response["error"] = err.Error()
+		return c.JSON(http.StatusOK, response)
+	}
+	response["ok"] = true
+	response["attempts"] = meta.Attempts
+	response["title"] = meta.Title
+	return c.JSON(http.StatusOK, response)
+}
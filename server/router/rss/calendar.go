@@ -0,0 +1,340 @@
+package rss
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxCalendarEventCount is the maximum number of reminders, and of scheduled memos, in a calendar.
+	maxCalendarEventCount = 1000
+	// calendarRefreshInterval is how often calendar clients are asked to refresh a calendar.
+	calendarRefreshInterval = 1 * time.Hour
+	// icsLineLength is the maximum length of an iCalendar line in octets, without the line break.
+	icsLineLength = 75
+	icsTimeFormat = "20060102T150405"
+)
+
+// GetUserCalendar serves the iCalendar feed of a user, authenticated with the feed token of the
+// user in the token query parameter. The reminders of the memos of the user are events with an
+// alarm, and the scheduled memos are events at their publish time.
+//
+// A repeating reminder is an event with a recurrence rule, in the time zone of the user so that
+// its occurrences keep their wall clock time across DST changes like the delivered reminders.
+// Completed reminders drop out of the calendar, and snoozed reminders move.
+func (s *RSSService) GetUserCalendar(c echo.Context) error {
+	ctx := c.Request().Context()
+	username := c.Param("username")
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &username,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil || user.SuspendedTs != 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "User not found")
+	}
+	feedToken, err := s.Store.GetUserFeedToken(ctx, user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find feed token").SetInternal(err)
+	}
+	if !auth.VerifyFeedToken(feedToken, c.QueryParam("token")) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid feed token")
+	}
+
+	normalStatus := store.Normal
+	limit := maxCalendarEventCount
+	reminderMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:        &user.ID,
+		RowStatus:        &normalStatus,
+		HasReminder:      true,
+		IncludeScheduled: true,
+		Limit:            &limit,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
+	}
+	scheduledMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &normalStatus,
+		Scheduled: true,
+		Limit:     &limit,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
+	}
+	generalSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user setting").SetInternal(err)
+	}
+	rssHeading, err := getRSSHeading(ctx, s.Store)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate calendar").SetInternal(err)
+	}
+
+	baseURL := s.getBaseURL(c)
+	calendar := &memoCalendar{
+		Name:     fmt.Sprintf("%s - %s", rssHeading.Title, getUserDisplayName(user)),
+		Location: getUserLocation(generalSetting.GetGeneral()),
+	}
+	for _, memo := range reminderMemos {
+		calendar.Events = append(calendar.Events, s.newCalendarEvent(memo, baseURL, "reminder", memo.ReminderTs, memo.ReminderRepeat))
+	}
+	for _, memo := range scheduledMemos {
+		calendar.Events = append(calendar.Events, s.newCalendarEvent(memo, baseURL, "publish", memo.PublishTs, store.MemoReminderRepeatNone))
+	}
+	content := calendar.render()
+
+	// The calendar is only cached by the client, as it holds private memos. The ETag doesn't
+	// change until a memo of the calendar does, so that refreshes are cheap.
+	etag := contentETag(content)
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("private, max-age=%d", int(calendarRefreshInterval.Seconds())))
+	c.Response().Header().Set("ETag", etag)
+	if c.Request().Header.Get("If-None-Match") == etag {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", []byte(content))
+}
+
+// newCalendarEvent returns the event of a memo at a time, the reminder or the publish time of the
+// memo. The UID of the event stays the same when its time changes, so that clients move it.
+func (s *RSSService) newCalendarEvent(memo *store.Memo, baseURL, kind string, ts int64, repeat store.MemoReminderRepeat) *calendarEvent {
+	host := baseURL
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return &calendarEvent{
+		UID:         fmt.Sprintf("%s-%s@%s", memo.UID, kind, host),
+		Summary:     s.generateItemTitle(memo.Content),
+		Description: memo.Content,
+		URL:         baseURL + "/memos/" + memo.UID,
+		Start:       time.Unix(ts, 0),
+		Updated:     time.Unix(memo.UpdatedTs, 0),
+		Repeat:      repeat,
+		Alarm:       kind == "reminder",
+	}
+}
+
+// memoCalendar is the iCalendar feed of the memos of a user.
+type memoCalendar struct {
+	Name string
+	// Location is the time zone of the user, which repeating events repeat in.
+	Location *time.Location
+	Events   []*calendarEvent
+}
+
+type calendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	// Updated is the time of the latest update of the memo, which the event is stamped with so that
+	// the calendar is the same until a memo changes.
+	Updated time.Time
+	Repeat  store.MemoReminderRepeat
+	// Alarm is set for the reminders, which alert at their start.
+	Alarm bool
+}
+
+// render renders the calendar as iCalendar, as specified by RFC 5545.
+func (c *memoCalendar) render() string {
+	w := &icsWriter{}
+	w.property("BEGIN", "VCALENDAR")
+	w.property("VERSION", "2.0")
+	w.property("PRODID", "-//usememos//Memos//EN")
+	w.property("CALSCALE", "GREGORIAN")
+	w.property("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", c.Name)
+	refreshInterval := fmt.Sprintf("PT%dH", int(calendarRefreshInterval.Hours()))
+	w.property("REFRESH-INTERVAL;VALUE=DURATION", refreshInterval)
+	w.property("X-PUBLISHED-TTL", refreshInterval)
+
+	// The time zone is only needed by repeating events, from the year of the first of them.
+	tzid := ""
+	firstYear := 0
+	if c.Location != time.UTC {
+		for _, event := range c.Events {
+			if event.Repeat == store.MemoReminderRepeatNone {
+				continue
+			}
+			if year := event.Start.In(c.Location).Year(); firstYear == 0 || year < firstYear {
+				firstYear = year
+			}
+		}
+	}
+	if firstYear != 0 {
+		tzid = c.Location.String()
+		writeTimezone(w, c.Location, firstYear)
+	}
+
+	for _, event := range c.Events {
+		w.property("BEGIN", "VEVENT")
+		w.property("UID", event.UID)
+		w.property("DTSTAMP", formatICSTimeUTC(event.Updated))
+		w.property("LAST-MODIFIED", formatICSTimeUTC(event.Updated))
+		if event.Repeat != store.MemoReminderRepeatNone && tzid != "" {
+			w.property("DTSTART;TZID="+tzid, event.Start.In(c.Location).Format(icsTimeFormat))
+		} else {
+			w.property("DTSTART", formatICSTimeUTC(event.Start))
+		}
+		switch event.Repeat {
+		case store.MemoReminderRepeatDaily:
+			w.property("RRULE", "FREQ=DAILY")
+		case store.MemoReminderRepeatWeekly:
+			w.property("RRULE", "FREQ=WEEKLY")
+		default:
+		}
+		w.text("SUMMARY", event.Summary)
+		w.text("DESCRIPTION", event.Description)
+		w.property("URL", event.URL)
+		if event.Alarm {
+			w.property("BEGIN", "VALARM")
+			w.property("ACTION", "DISPLAY")
+			w.property("TRIGGER", "PT0S")
+			w.text("DESCRIPTION", event.Summary)
+			w.property("END", "VALARM")
+		}
+		w.property("END", "VEVENT")
+	}
+	w.property("END", "VCALENDAR")
+	return w.String()
+}
+
+// writeTimezone writes the VTIMEZONE of a location, from the offset changes of a year. Yearly
+// changes are written as yearly rules, which hold for the following years.
+func writeTimezone(w *icsWriter, location *time.Location, year int) {
+	type transition struct {
+		at           time.Time
+		offsetFrom   int
+		offsetTo     int
+		name         string
+		daylightTime bool
+	}
+	var transitions []transition
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	for t := start; ; {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.Year() > year {
+			break
+		}
+		_, offsetFrom := end.Add(-time.Second).Zone()
+		name, offsetTo := end.Zone()
+		transitions = append(transitions, transition{at: end, offsetFrom: offsetFrom, offsetTo: offsetTo, name: name, daylightTime: end.IsDST()})
+		t = end
+	}
+
+	w.property("BEGIN", "VTIMEZONE")
+	w.property("TZID", location.String())
+	if len(transitions) == 0 {
+		name, offset := start.Zone()
+		w.property("BEGIN", "STANDARD")
+		w.property("DTSTART", "19700101T000000")
+		w.property("TZOFFSETFROM", formatICSOffset(offset))
+		w.property("TZOFFSETTO", formatICSOffset(offset))
+		w.text("TZNAME", name)
+		w.property("END", "STANDARD")
+	}
+	for _, tr := range transitions {
+		component := "STANDARD"
+		if tr.daylightTime {
+			component = "DAYLIGHT"
+		}
+		// The start of a change is the wall clock time before it.
+		local := tr.at.In(time.FixedZone("", tr.offsetFrom))
+		w.property("BEGIN", component)
+		w.property("DTSTART", local.Format(icsTimeFormat))
+		if len(transitions) == 2 {
+			w.property("RRULE", fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%s", local.Month(), formatICSWeekdayInMonth(local)))
+		}
+		w.property("TZOFFSETFROM", formatICSOffset(tr.offsetFrom))
+		w.property("TZOFFSETTO", formatICSOffset(tr.offsetTo))
+		w.text("TZNAME", tr.name)
+		w.property("END", component)
+	}
+	w.property("END", "VTIMEZONE")
+}
+
+// formatICSWeekdayInMonth returns the weekday of a date in its month, e.g. "2SU" for the second
+// Sunday, or "-1SU" for the last Sunday if it's in the last week of the month.
+func formatICSWeekdayInMonth(date time.Time) string {
+	weekday := strings.ToUpper(date.Weekday().String()[:2])
+	daysInMonth := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, -1).Day()
+	if date.Day() > daysInMonth-7 {
+		return "-1" + weekday
+	}
+	return fmt.Sprintf("%d%s", (date.Day()-1)/7+1, weekday)
+}
+
+func formatICSTimeUTC(t time.Time) string {
+	return t.UTC().Format(icsTimeFormat) + "Z"
+}
+
+func formatICSOffset(offsetSec int) string {
+	sign := "+"
+	if offsetSec < 0 {
+		sign = "-"
+		offsetSec = -offsetSec
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offsetSec/3600, offsetSec%3600/60)
+}
+
+// icsWriter writes iCalendar content lines, folded at 75 octets and ended with CRLF.
+type icsWriter struct {
+	strings.Builder
+}
+
+// property writes a property with a value of any type but text, which is written as is.
+func (w *icsWriter) property(name, value string) {
+	line := name + ":" + value
+	for len(line) > icsLineLength {
+		// Lines are folded between characters, never within a UTF-8 sequence.
+		cut := icsLineLength
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n")
+		// The space starting a continuation line counts towards its length.
+		line = " " + line[cut:]
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// text writes a property with a text value, escaped.
+func (w *icsWriter) text(name, value string) {
+	w.property(name, icsTextReplacer.Replace(value))
+}
+
+var icsTextReplacer = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func getUserDisplayName(user *store.User) string {
+	if user.Nickname != "" {
+		return user.Nickname
+	}
+	return user.Username
+}
+
+// getUserLocation returns the time zone of a user, or UTC if it isn't set.
+func getUserLocation(generalSetting *storepb.GeneralUserSetting) *time.Location {
+	if timezone := generalSetting.GetTimezone(); timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			return location
+		}
+	}
+	return time.UTC
+}
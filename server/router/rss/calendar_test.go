@@ -0,0 +1,236 @@
+package rss
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+// icsComponent is a component of a parsed iCalendar object, with its properties by name.
+type icsComponent struct {
+	Name       string
+	Properties map[string]icsProperty
+	Components []*icsComponent
+}
+
+type icsProperty struct {
+	Params string
+	Value  string
+}
+
+func (c *icsComponent) children(name string) []*icsComponent {
+	var children []*icsComponent
+	for _, child := range c.Components {
+		if child.Name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// parseICS parses an iCalendar object as specified by RFC 5545: its lines end with CRLF and are
+// at most 75 octets long, folded lines are unfolded, and the components must be nested properly.
+// Text values are unescaped.
+func parseICS(t *testing.T, content string) *icsComponent {
+	t.Helper()
+	require.True(t, strings.HasSuffix(content, "\r\n"))
+	rawLines := strings.Split(strings.TrimSuffix(content, "\r\n"), "\r\n")
+	var lines []string
+	for _, line := range rawLines {
+		require.NotContains(t, line, "\n")
+		require.LessOrEqual(t, len(line), 75, "line too long: %q", line)
+		if strings.HasPrefix(line, " ") {
+			require.NotEmpty(t, lines)
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	root := &icsComponent{}
+	stack := []*icsComponent{root}
+	for _, line := range lines {
+		nameAndParams, value, ok := strings.Cut(line, ":")
+		require.True(t, ok, "invalid line: %q", line)
+		name, params, _ := strings.Cut(nameAndParams, ";")
+		current := stack[len(stack)-1]
+		switch name {
+		case "BEGIN":
+			component := &icsComponent{Name: value, Properties: map[string]icsProperty{}}
+			current.Components = append(current.Components, component)
+			stack = append(stack, component)
+		case "END":
+			require.Equal(t, current.Name, value)
+			stack = stack[:len(stack)-1]
+		default:
+			require.NotNil(t, current.Properties, "property outside a component: %q", line)
+			value = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+			current.Properties[name] = icsProperty{Params: params, Value: value}
+		}
+	}
+	require.Len(t, stack, 1)
+	require.Len(t, root.Components, 1)
+	calendar := root.Components[0]
+	require.Equal(t, "VCALENDAR", calendar.Name)
+	require.Equal(t, "2.0", calendar.Properties["VERSION"].Value)
+	require.NotEmpty(t, calendar.Properties["PRODID"].Value)
+	for _, event := range calendar.children("VEVENT") {
+		for _, name := range []string{"UID", "DTSTAMP", "DTSTART"} {
+			require.Contains(t, event.Properties, name)
+		}
+	}
+	return calendar
+}
+
+func TestUserCalendar(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	_, err = stores.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_GENERAL,
+		Value:  &storepb.UserSetting_General{General: &storepb.GeneralUserSetting{Timezone: "Europe/Berlin"}},
+	})
+	require.NoError(t, err)
+	reminderSec := time.Date(2030, time.March, 20, 9, 0, 0, 0, time.UTC).Unix()
+	publishSec := time.Date(2030, time.April, 1, 12, 30, 0, 0, time.UTC).Unix()
+	longLine := strings.Repeat("Überlange Zeile, mit; Sonderzeichen ", 5)
+	for _, memo := range []*store.Memo{
+		{UID: "once", Content: "# Call the bank\nAbout the card", Visibility: store.Private, ReminderTs: reminderSec},
+		{UID: "daily", Content: longLine, Visibility: store.Private, ReminderTs: reminderSec, ReminderRepeat: store.MemoReminderRepeatDaily},
+		{UID: "weekly", Content: "Weekly review", Visibility: store.Protected, ReminderTs: reminderSec, ReminderRepeat: store.MemoReminderRepeatWeekly},
+		{UID: "scheduled", Content: "Announcement", Visibility: store.Public, PublishTs: publishSec},
+		{UID: "plain", Content: "No reminder", Visibility: store.Public},
+	} {
+		memo.CreatorID = user.ID
+		_, err := stores.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+	token, tokenHash, err := auth.GenerateFeedToken()
+	require.NoError(t, err)
+	require.NoError(t, stores.UpsertUserFeedToken(ctx, user.ID, &storepb.FeedTokenUserSetting{TokenHash: tokenHash}))
+
+	e := echo.New()
+	service := NewRSSService(&profile.Profile{Driver: "sqlite"}, stores, markdown.NewService(markdown.WithTagExtension()))
+	service.RegisterRoutes(e.Group(""))
+	get := func(target string, header http.Header) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+		for name, values := range header {
+			request.Header[name] = values
+		}
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+	getEvents := func() map[string]*icsComponent {
+		recorder := get("/u/user/calendar.ics?token="+token, nil)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		calendar := parseICS(t, recorder.Body.String())
+		events := map[string]*icsComponent{}
+		for _, event := range calendar.children("VEVENT") {
+			events[event.Properties["UID"].Value] = event
+		}
+		return events
+	}
+
+	// The calendar needs the feed token.
+	require.Equal(t, http.StatusUnauthorized, get("/u/user/calendar.ics", nil).Code)
+	require.Equal(t, http.StatusUnauthorized, get("/u/user/calendar.ics?token=invalid", nil).Code)
+	require.Equal(t, http.StatusNotFound, get("/u/unknown/calendar.ics?token="+token, nil).Code)
+
+	recorder := get("/u/user/calendar.ics?token="+token, nil)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "text/calendar; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+	require.Equal(t, "private, max-age=3600", recorder.Header().Get(echo.HeaderCacheControl))
+	calendar := parseICS(t, recorder.Body.String())
+	require.Equal(t, "PT1H", calendar.Properties["REFRESH-INTERVAL"].Value)
+
+	// The time zone of the repeating reminders switches to daylight time on the last Sunday of March.
+	timezones := calendar.children("VTIMEZONE")
+	require.Len(t, timezones, 1)
+	require.Equal(t, "Europe/Berlin", timezones[0].Properties["TZID"].Value)
+	daylight := timezones[0].children("DAYLIGHT")
+	require.Len(t, daylight, 1)
+	require.Equal(t, "20300331T020000", daylight[0].Properties["DTSTART"].Value)
+	require.Equal(t, "FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU", daylight[0].Properties["RRULE"].Value)
+	require.Equal(t, "+0100", daylight[0].Properties["TZOFFSETFROM"].Value)
+	require.Equal(t, "+0200", daylight[0].Properties["TZOFFSETTO"].Value)
+	require.Len(t, timezones[0].children("STANDARD"), 1)
+
+	events := getEvents()
+	require.Len(t, events, 4)
+	once := events["once-reminder@example.com"]
+	require.NotNil(t, once)
+	require.Equal(t, "Call the bank", once.Properties["SUMMARY"].Value)
+	require.Equal(t, "# Call the bank\nAbout the card", once.Properties["DESCRIPTION"].Value)
+	require.Equal(t, "http://example.com/memos/once", once.Properties["URL"].Value)
+	require.Equal(t, "20300320T090000Z", once.Properties["DTSTART"].Value)
+	require.NotContains(t, once.Properties, "RRULE")
+	require.Len(t, once.children("VALARM"), 1)
+
+	// Repeating reminders are expressed with a rule in the time zone of the user.
+	daily := events["daily-reminder@example.com"]
+	require.NotNil(t, daily)
+	require.Equal(t, "TZID=Europe/Berlin", daily.Properties["DTSTART"].Params)
+	require.Equal(t, "20300320T100000", daily.Properties["DTSTART"].Value)
+	require.Equal(t, "FREQ=DAILY", daily.Properties["RRULE"].Value)
+	require.True(t, strings.HasPrefix(longLine, strings.TrimSuffix(daily.Properties["SUMMARY"].Value, "...")))
+	require.Equal(t, longLine, daily.Properties["DESCRIPTION"].Value)
+	require.Equal(t, "FREQ=WEEKLY", events["weekly-reminder@example.com"].Properties["RRULE"].Value)
+
+	scheduled := events["scheduled-publish@example.com"]
+	require.NotNil(t, scheduled)
+	require.Equal(t, "20300401T123000Z", scheduled.Properties["DTSTART"].Value)
+	require.Empty(t, scheduled.children("VALARM"))
+
+	// Unchanged calendars aren't sent again.
+	etag := recorder.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	require.Equal(t, http.StatusNotModified, get("/u/user/calendar.ics?token="+token, http.Header{"If-None-Match": {etag}}).Code)
+
+	// Snoozed reminders move, and completed reminders drop out.
+	onceMemo, err := stores.GetMemo(ctx, &store.FindMemo{UID: &[]string{"once"}[0]})
+	require.NoError(t, err)
+	snoozedTs := reminderSec + 3600
+	require.NoError(t, stores.UpdateMemo(ctx, &store.UpdateMemo{ID: onceMemo.ID, ReminderTs: &snoozedTs}))
+	dailyMemo, err := stores.GetMemo(ctx, &store.FindMemo{UID: &[]string{"daily"}[0]})
+	require.NoError(t, err)
+	var completedTs int64
+	repeatNone := store.MemoReminderRepeatNone
+	require.NoError(t, stores.UpdateMemo(ctx, &store.UpdateMemo{ID: dailyMemo.ID, ReminderTs: &completedTs, ReminderRepeat: &repeatNone}))
+	require.NotEqual(t, http.StatusNotModified, get("/u/user/calendar.ics?token="+token, http.Header{"If-None-Match": {etag}}).Code)
+	events = getEvents()
+	require.Len(t, events, 3)
+	require.Equal(t, "20300320T100000Z", events["once-reminder@example.com"].Properties["DTSTART"].Value)
+	require.NotContains(t, events, "daily-reminder@example.com")
+}
+
+func TestFormatICSWeekdayInMonth(t *testing.T) {
+	for date, expected := range map[string]string{
+		"2030-03-10": "2SU",
+		"2030-11-03": "1SU",
+		"2030-03-31": "-1SU",
+		"2030-10-27": "-1SU",
+		"2030-03-25": "-1MO",
+	} {
+		parsed, err := time.Parse(time.DateOnly, date)
+		require.NoError(t, err)
+		require.Equal(t, expected, formatICSWeekdayInMonth(parsed), date)
+	}
+}
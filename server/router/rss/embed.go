@@ -0,0 +1,244 @@
+package rss
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxEmbedContentLength is the number of characters of text of a memo shown in embeds. Longer
+	// memos are truncated with a link to read the rest.
+	maxEmbedContentLength = 1000
+
+	// defaultEmbedWidth and defaultEmbedHeight are the size of embeds, unless embedders ask for
+	// smaller ones.
+	defaultEmbedWidth  = 550
+	defaultEmbedHeight = 300
+)
+
+// embedMemoPathRegex matches the paths of memo pages, the current one and the old one.
+var embedMemoPathRegex = regexp.MustCompile(`^/(?:memos|m)/([^/]+)/?$`)
+
+// embedResizeScript reports the height of the embed page to the embedding page, so that it can
+// size the iframe. Embedders listen for "memos:embed-resize" messages from the iframe.
+const embedResizeScript = `(function () {
+  var uid = document.body.dataset.memo;
+  function report() {
+    parent.postMessage({ type: "memos:embed-resize", memo: uid, height: document.documentElement.scrollHeight }, "*");
+  }
+  window.addEventListener("load", report);
+  if (window.ResizeObserver) {
+    new ResizeObserver(report).observe(document.body);
+  }
+})();`
+
+// embedContentSecurityPolicy only allows the resize script, inline styles and images, so that
+// nothing the memo renders can run in the pages that embed it.
+var embedContentSecurityPolicy = func() string {
+	hash := sha256.Sum256([]byte(embedResizeScript))
+	return "default-src 'none'; img-src * data:; style-src 'unsafe-inline'; script-src 'sha256-" +
+		base64.StdEncoding.EncodeToString(hash[:]) + "'; form-action 'none'; frame-ancestors *"
+}()
+
+// oEmbedResponse is a rich oEmbed response, see https://oembed.com.
+type oEmbedResponse struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	AuthorURL    string `json:"author_url"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	CacheAge     int    `json:"cache_age"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+// memoEmbed is what embeds of a memo are rendered from.
+type memoEmbed struct {
+	memo    *store.Memo
+	creator *store.User
+	baseURL string
+	// snippet is the sanitized HTML of the content, truncated to maxEmbedContentLength.
+	snippet string
+}
+
+func (e *memoEmbed) memoURL() string {
+	return e.baseURL + "/memos/" + url.PathEscape(e.memo.UID)
+}
+
+func (e *memoEmbed) authorName() string {
+	if e.creator.Nickname != "" {
+		return e.creator.Nickname
+	}
+	return e.creator.Username
+}
+
+func (e *memoEmbed) authorURL() string {
+	return e.baseURL + "/u/" + url.PathEscape(e.creator.Username)
+}
+
+// blockquote returns the snippet of the memo with its attribution, for embedders that show the HTML
+// of oEmbed responses in their pages.
+func (e *memoEmbed) blockquote() string {
+	return fmt.Sprintf(`<blockquote class="memos-embed" cite="%s">%s<footer>— <a href="%s">%s</a> · <a href="%s">%s</a></footer></blockquote>`,
+		html.EscapeString(e.memoURL()),
+		e.snippet,
+		html.EscapeString(e.authorURL()),
+		html.EscapeString(e.authorName()),
+		html.EscapeString(e.memoURL()),
+		time.Unix(e.memo.CreatedTs, 0).UTC().Format(time.DateOnly),
+	)
+}
+
+// GetOEmbed is the oEmbed endpoint of the public memos. Only the JSON format is supported.
+//
+// The HTML of the response is a blockquote with the sanitized snippet of the memo. Embedders that
+// prefer an iframe can embed the /embed/{memo} page instead, which reports its height.
+func (s *RSSService) GetOEmbed(c echo.Context) error {
+	ctx := c.Request().Context()
+	if format := c.QueryParam("format"); format != "" && format != "json" {
+		return echo.NewHTTPError(http.StatusNotImplemented, "only the json format is supported")
+	}
+	maxWidth, err := parseEmbedDimension(c.QueryParam("maxwidth"), defaultEmbedWidth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid maxwidth")
+	}
+	maxHeight, err := parseEmbedDimension(c.QueryParam("maxheight"), defaultEmbedHeight)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid maxheight")
+	}
+
+	baseURL := s.getBaseURL(c)
+	memoUID, ok := parseEmbedMemoURL(c.QueryParam("url"), baseURL)
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "memo not found")
+	}
+	embed, err := s.findPublicMemoEmbed(ctx, memoUID, baseURL)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memo").SetInternal(err)
+	}
+	if embed == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "memo not found")
+	}
+	rssHeading, err := getRSSHeading(ctx, s.Store)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get instance title").SetInternal(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(defaultCacheDuration.Seconds())))
+	return c.JSON(http.StatusOK, &oEmbedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        s.generateItemTitle(embed.memo.Content),
+		AuthorName:   embed.authorName(),
+		AuthorURL:    embed.authorURL(),
+		ProviderName: rssHeading.Title,
+		ProviderURL:  baseURL,
+		CacheAge:     int(defaultCacheDuration.Seconds()),
+		HTML:         embed.blockquote(),
+		Width:        maxWidth,
+		Height:       maxHeight,
+	})
+}
+
+// GetMemoEmbed serves a minimal page of a public memo to embed in an iframe. The page posts its
+// height to the embedding page in "memos:embed-resize" messages, and links open in a new tab.
+func (s *RSSService) GetMemoEmbed(c echo.Context) error {
+	ctx := c.Request().Context()
+	embed, err := s.findPublicMemoEmbed(ctx, c.Param("uid"), s.getBaseURL(c))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memo").SetInternal(err)
+	}
+	if embed == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "memo not found")
+	}
+
+	var page strings.Builder
+	page.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><base target="_blank">`)
+	page.WriteString("<title>" + html.EscapeString(s.generateItemTitle(embed.memo.Content)) + "</title>")
+	page.WriteString(`<style>body{margin:0;font:15px/1.5 system-ui,sans-serif;color:#27272a;background:#fff}` +
+		`blockquote{margin:0;padding:12px 16px;border:1px solid #e4e4e7;border-radius:8px;overflow-wrap:anywhere}` +
+		`img{max-width:100%;height:auto}pre{overflow-x:auto}footer{margin-top:8px;font-size:13px;color:#71717a}a{color:#2563eb}</style>`)
+	page.WriteString(`</head><body data-memo="` + html.EscapeString(embed.memo.UID) + `">`)
+	page.WriteString(embed.blockquote())
+	page.WriteString("<script>" + embedResizeScript + "</script></body></html>")
+
+	c.Response().Header().Set("Content-Security-Policy", embedContentSecurityPolicy)
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(defaultCacheDuration.Seconds())))
+	return c.HTML(http.StatusOK, page.String())
+}
+
+// findPublicMemoEmbed returns the embed of a memo, named by its UID or its slug, or nil unless it's
+// a public memo anyone can see: not in the trash, scheduled, a draft, or by a suspended user.
+func (s *RSSService) findPublicMemoEmbed(ctx context.Context, memoUID, baseURL string) (*memoEmbed, error) {
+	memo, creator, err := s.Store.GetPublicMemo(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	if memo == nil {
+		return nil, nil
+	}
+
+	embed := &memoEmbed{memo: memo, creator: creator, baseURL: baseURL}
+	renderedHTML, err := s.renderMemoHTML(ctx, memo, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	base, err := url.Parse(baseURL + "/")
+	if err != nil {
+		return nil, err
+	}
+	sanitizer := &feedHTMLSanitizer{
+		baseURL:     base,
+		maxLength:   maxEmbedContentLength,
+		readMoreURL: embed.memoURL(),
+	}
+	embed.snippet = sanitizer.sanitize(renderedHTML)
+	return embed, nil
+}
+
+// parseEmbedMemoURL returns the UID of the memo of a memo page URL of this instance.
+func parseEmbedMemoURL(rawURL, baseURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil || !strings.EqualFold(u.Host, base.Host) || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", false
+	}
+	matches := embedMemoPathRegex.FindStringSubmatch(strings.TrimPrefix(u.Path, strings.TrimSuffix(base.Path, "/")))
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// parseEmbedDimension parses the maxwidth or maxheight of an oEmbed request, capped at the default
+// dimension.
+func parseEmbedDimension(value string, defaultDimension int) (int, error) {
+	if value == "" {
+		return defaultDimension, nil
+	}
+	dimension, err := strconv.Atoi(value)
+	if err != nil || dimension <= 0 {
+		return 0, errors.Errorf("invalid dimension %q", value)
+	}
+	return min(dimension, defaultDimension), nil
+}
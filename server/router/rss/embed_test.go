@@ -0,0 +1,93 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestOEmbed(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Nickname: "Jo", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	for _, memo := range []*store.Memo{
+		{UID: "public", Content: "**Hello** <script>alert(1)</script>\n\n" + strings.Repeat("word ", 300), Visibility: store.Public},
+		{UID: "protected", Content: "Protected", Visibility: store.Protected},
+		{UID: "private", Content: "Private", Visibility: store.Private},
+	} {
+		memo.CreatorID = user.ID
+		_, err := stores.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+
+	e := echo.New()
+	service := NewRSSService(&profile.Profile{Driver: "sqlite"}, stores, markdown.NewService(markdown.WithTagExtension()))
+	service.RegisterRoutes(e.Group(""))
+	get := func(target string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+		return recorder
+	}
+	getOEmbed := func(memoURL, query string) *httptest.ResponseRecorder {
+		return get("/api/oembed?url=" + url.QueryEscape(memoURL) + query)
+	}
+
+	recorder := getOEmbed("http://example.com/memos/public", "&maxwidth=400")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	response := &oEmbedResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+	require.Equal(t, "rich", response.Type)
+	require.Equal(t, "Jo", response.AuthorName)
+	require.Equal(t, "http://example.com/u/user", response.AuthorURL)
+	require.Equal(t, "Memos", response.ProviderName)
+	require.Equal(t, 400, response.Width)
+	require.Equal(t, defaultEmbedHeight, response.Height)
+	require.Contains(t, response.HTML, "<strong>Hello</strong>")
+	require.NotContains(t, response.HTML, "<script")
+	// Long content is truncated with a link to the memo.
+	require.Less(t, len(response.HTML), 2*maxEmbedContentLength)
+	require.Contains(t, response.HTML, `<a href="http://example.com/memos/public">Read more</a>`)
+
+	// The old memo URLs and the URLs with the slug of the memo work too.
+	require.Equal(t, http.StatusOK, getOEmbed("http://example.com/m/public", "").Code)
+	publicUID := "public"
+	public, err := stores.GetMemo(ctx, &store.FindMemo{UID: &publicUID})
+	require.NoError(t, err)
+	require.NoError(t, stores.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: public.ID, Slug: "hello"}))
+	require.Equal(t, http.StatusOK, getOEmbed("http://example.com/memos/hello", "").Code)
+	// Memos that aren't public, unknown memos and URLs of other sites are not found.
+	for _, memoURL := range []string{"http://example.com/memos/protected", "http://example.com/memos/private", "http://example.com/memos/unknown", "http://other.example/memos/public", "http://example.com/explore"} {
+		require.Equal(t, http.StatusNotFound, getOEmbed(memoURL, "").Code, memoURL)
+	}
+	require.Equal(t, http.StatusNotImplemented, getOEmbed("http://example.com/memos/public", "&format=xml").Code)
+	require.Equal(t, http.StatusBadRequest, getOEmbed("http://example.com/memos/public", "&maxheight=-1").Code)
+
+	t.Run("embed page", func(t *testing.T) {
+		recorder := get("/embed/public")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		body := recorder.Body.String()
+		require.Contains(t, body, "<strong>Hello</strong>")
+		require.Contains(t, body, "memos:embed-resize")
+		require.Equal(t, 1, strings.Count(body, "<script>"))
+		require.Contains(t, recorder.Header().Get("Content-Security-Policy"), "script-src 'sha256-")
+		require.Empty(t, recorder.Header().Get("X-Frame-Options"))
+
+		require.Equal(t, http.StatusNotFound, get("/embed/protected").Code)
+		require.Equal(t, http.StatusNotFound, get("/embed/private").Code)
+	})
+}
@@ -0,0 +1,324 @@
+package rss
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/feeds"
+)
+
+// feedFormat is the format a feed is rendered in.
+type feedFormat string
+
+const (
+	feedFormatRSS  feedFormat = "rss"
+	feedFormatAtom feedFormat = "atom"
+	feedFormatJSON feedFormat = "json"
+)
+
+const (
+	rssContentNamespace = "http://purl.org/rss/1.0/modules/content/" //nolint:revive // The namespace is an identifier, not a URL.
+	atomNamespace       = "http://www.w3.org/2005/Atom"              //nolint:revive // The namespace is an identifier, not a URL.
+	jsonFeedVersion     = "https://jsonfeed.org/version/1.1"
+)
+
+// memoFeed is a feed of memos, rendered in every format from the same model so that the items of
+// the formats can't drift apart.
+type memoFeed struct {
+	Title       string
+	Description string
+	// HomePageURL is the URL of the page the feed follows.
+	HomePageURL string
+	// SelfURL is the URL of the feed itself, including its query.
+	SelfURL string
+	// Updated is the time of the latest update of the items of the feed.
+	Updated time.Time
+	Items   []*feedItem
+}
+
+// feedItem is a memo of a feed.
+type feedItem struct {
+	// ID is the permalink of the memo, which doesn't depend on the feed the memo is in.
+	ID          string
+	URL         string
+	Title       string
+	ContentHTML string
+	Published   time.Time
+	Updated     time.Time
+	Author      *feedAuthor
+	Tags        []string
+	Attachments []*feedAttachment
+}
+
+// enclosure returns the attachment of the RSS enclosure of the item: its first image or audio
+// attachment, or else its first attachment.
+func (i *feedItem) enclosure() *feedAttachment {
+	for _, attachment := range i.Attachments {
+		if strings.HasPrefix(attachment.MIMEType, "image/") || strings.HasPrefix(attachment.MIMEType, "audio/") {
+			return attachment
+		}
+	}
+	if len(i.Attachments) > 0 {
+		return i.Attachments[0]
+	}
+	return nil
+}
+
+type feedAuthor struct {
+	Name string
+	// URL is the URL of the profile of the author.
+	URL string
+}
+
+type feedAttachment struct {
+	URL      string
+	MIMEType string
+	Title    string
+	Size     int64
+}
+
+// contentType returns the media type of the format.
+func (f feedFormat) contentType() string {
+	switch f {
+	case feedFormatAtom:
+		return "application/atom+xml; charset=utf-8"
+	case feedFormatJSON:
+		return "application/feed+json; charset=utf-8"
+	default:
+		return "application/rss+xml; charset=utf-8"
+	}
+}
+
+// render renders a feed in the format.
+func (f feedFormat) render(feed *memoFeed) (string, error) {
+	switch f {
+	case feedFormatAtom:
+		return renderAtom(feed)
+	case feedFormatJSON:
+		return renderJSONFeed(feed)
+	default:
+		return renderRSS(feed)
+	}
+}
+
+// selfLinkedRSS is an RSS 2.0 feed with an atom:link to the URL of the feed, which tells feed
+// readers the filtered feeds apart.
+type selfLinkedRSS struct {
+	XMLName          xml.Name `xml:"rss"`
+	Version          string   `xml:"version,attr"`
+	ContentNamespace string   `xml:"xmlns:content,attr"`
+	AtomNamespace    string   `xml:"xmlns:atom,attr"`
+	Channel          *selfLinkedRSSChannel
+}
+
+type selfLinkedRSSChannel struct {
+	*feeds.RssFeed
+	AtomLink *rssAtomLink
+}
+
+type rssAtomLink struct {
+	XMLName xml.Name `xml:"atom:link"`
+	Href    string   `xml:"href,attr"`
+	Rel     string   `xml:"rel,attr"`
+	Type    string   `xml:"type,attr"`
+}
+
+// renderRSS renders a feed as RSS 2.0. RSS items have at most one enclosure, see feedItem.enclosure.
+func renderRSS(feed *memoFeed) (string, error) {
+	channel := &feeds.RssFeed{
+		Title:       feed.Title,
+		Link:        feed.HomePageURL,
+		Description: feed.Description,
+	}
+	if !feed.Updated.IsZero() {
+		channel.LastBuildDate = feed.Updated.Format(time.RFC1123Z)
+	}
+	for _, item := range feed.Items {
+		rssItem := &feeds.RssItem{
+			Title:       item.Title,
+			Link:        item.URL,
+			Description: item.ContentHTML,
+			Content:     &feeds.RssContent{Content: item.ContentHTML},
+			Guid:        &feeds.RssGuid{Id: item.ID},
+			PubDate:     item.Published.Format(time.RFC1123Z),
+		}
+		if item.Author != nil {
+			rssItem.Author = item.Author.Name
+		}
+		if attachment := item.enclosure(); attachment != nil {
+			rssItem.Enclosure = &feeds.RssEnclosure{Url: attachment.URL, Length: strconv.FormatInt(attachment.Size, 10), Type: attachment.MIMEType}
+		}
+		channel.Items = append(channel.Items, rssItem)
+	}
+	return marshalFeedXML(&selfLinkedRSS{
+		Version:          "2.0",
+		ContentNamespace: rssContentNamespace,
+		AtomNamespace:    atomNamespace,
+		Channel: &selfLinkedRSSChannel{
+			RssFeed:  channel,
+			AtomLink: &rssAtomLink{Href: feed.SelfURL, Rel: "self", Type: "application/rss+xml"},
+		},
+	})
+}
+
+type atomFeed struct {
+	XMLName  xml.Name `xml:"feed"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Title    string   `xml:"title"`
+	ID       string   `xml:"id"`
+	Updated  string   `xml:"updated"`
+	Subtitle string   `xml:"subtitle,omitempty"`
+	Links    []feeds.AtomLink
+	Entries  []*atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	XMLName    xml.Name `xml:"entry"`
+	Title      string   `xml:"title"`
+	ID         string   `xml:"id"`
+	Updated    string   `xml:"updated"`
+	Published  string   `xml:"published"`
+	Author     *atomAuthor
+	Categories []*atomCategory
+	Content    *feeds.AtomContent
+	Links      []feeds.AtomLink
+}
+
+type atomAuthor struct {
+	XMLName xml.Name `xml:"author"`
+	Name    string   `xml:"name"`
+	URI     string   `xml:"uri,omitempty"`
+}
+
+type atomCategory struct {
+	XMLName xml.Name `xml:"category"`
+	Term    string   `xml:"term,attr"`
+}
+
+// renderAtom renders a feed as Atom 1.0 (RFC 4287), with an enclosure link per attachment.
+func renderAtom(feed *memoFeed) (string, error) {
+	updated := feed.Updated
+	if updated.IsZero() {
+		// The updated time of a feed is required, even without entries.
+		updated = time.Now()
+	}
+	atom := &atomFeed{
+		Xmlns:    atomNamespace,
+		Title:    feed.Title,
+		ID:       feed.SelfURL,
+		Updated:  updated.UTC().Format(time.RFC3339),
+		Subtitle: feed.Description,
+		Links: []feeds.AtomLink{
+			{Href: feed.HomePageURL, Rel: "alternate", Type: "text/html"},
+			{Href: feed.SelfURL, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, item := range feed.Items {
+		entry := &atomEntry{
+			Title:     item.Title,
+			ID:        item.ID,
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Content:   &feeds.AtomContent{Content: item.ContentHTML, Type: "html"},
+			Links:     []feeds.AtomLink{{Href: item.URL, Rel: "alternate", Type: "text/html"}},
+		}
+		// Every entry needs an author, as the feed has none.
+		author := item.Author
+		if author == nil {
+			author = &feedAuthor{Name: feed.Title}
+		}
+		entry.Author = &atomAuthor{Name: author.Name, URI: author.URL}
+		for _, tag := range item.Tags {
+			entry.Categories = append(entry.Categories, &atomCategory{Term: tag})
+		}
+		for _, attachment := range item.Attachments {
+			entry.Links = append(entry.Links, feeds.AtomLink{Href: attachment.URL, Rel: "enclosure", Type: attachment.MIMEType, Length: strconv.FormatInt(attachment.Size, 10)})
+		}
+		atom.Entries = append(atom.Entries, entry)
+	}
+	return marshalFeedXML(atom)
+}
+
+type jsonFeed struct {
+	Version     string          `json:"version"`
+	Title       string          `json:"title"`
+	HomePageURL string          `json:"home_page_url,omitempty"`
+	FeedURL     string          `json:"feed_url,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Items       []*jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string                `json:"id"`
+	URL           string                `json:"url,omitempty"`
+	Title         string                `json:"title,omitempty"`
+	ContentHTML   string                `json:"content_html"`
+	DatePublished string                `json:"date_published,omitempty"`
+	DateModified  string                `json:"date_modified,omitempty"`
+	Authors       []*jsonFeedAuthor     `json:"authors,omitempty"`
+	Tags          []string              `json:"tags,omitempty"`
+	Attachments   []*jsonFeedAttachment `json:"attachments,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type jsonFeedAttachment struct {
+	URL         string `json:"url"`
+	MIMEType    string `json:"mime_type"`
+	Title       string `json:"title,omitempty"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// renderJSONFeed renders a feed as JSON Feed 1.1 (https://jsonfeed.org/version/1.1).
+func renderJSONFeed(feed *memoFeed) (string, error) {
+	jsonFeed := &jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       feed.Title,
+		HomePageURL: feed.HomePageURL,
+		FeedURL:     feed.SelfURL,
+		Description: feed.Description,
+		Items:       []*jsonFeedItem{},
+	}
+	for _, item := range feed.Items {
+		jsonItem := &jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.URL,
+			Title:         item.Title,
+			ContentHTML:   item.ContentHTML,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+			Tags:          item.Tags,
+		}
+		if item.Author != nil {
+			jsonItem.Authors = []*jsonFeedAuthor{{Name: item.Author.Name, URL: item.Author.URL}}
+		}
+		for _, attachment := range item.Attachments {
+			jsonItem.Attachments = append(jsonItem.Attachments, &jsonFeedAttachment{
+				URL:         attachment.URL,
+				MIMEType:    attachment.MIMEType,
+				Title:       attachment.Title,
+				SizeInBytes: attachment.Size,
+			})
+		}
+		jsonFeed.Items = append(jsonFeed.Items, jsonItem)
+	}
+	data, err := json.MarshalIndent(jsonFeed, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func marshalFeedXML(v any) (string, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(data), nil
+}
@@ -4,19 +4,23 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"html"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/gorilla/feeds"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/plugin/markdown"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -55,6 +59,13 @@ type RSSHeading struct {
 	Language    string
 }
 
+// feedQuery narrows a feed down to the memos with a tag, or matching a filter expression, from the
+// tag and filter query parameters.
+type feedQuery struct {
+	Tag    string
+	Filter string
+}
+
 func NewRSSService(profile *profile.Profile, store *store.Store, markdownService markdown.Service) *RSSService {
 	return &RSSService{
 		Profile:         profile,
@@ -66,60 +77,92 @@ func NewRSSService(profile *profile.Profile, store *store.Store, markdownService
 
 func (s *RSSService) RegisterRoutes(g *echo.Group) {
 	g.GET("/explore/rss.xml", s.GetExploreRSS)
+	g.GET("/explore/atom.xml", s.GetExploreAtom)
+	g.GET("/explore/feed.json", s.GetExploreJSONFeed)
 	g.GET("/u/:username/rss.xml", s.GetUserRSS)
+	g.GET("/u/:username/atom.xml", s.GetUserAtom)
+	g.GET("/u/:username/feed.json", s.GetUserJSONFeed)
+	g.GET("/u/:username/calendar.ics", s.GetUserCalendar)
+	g.GET("/api/oembed", s.GetOEmbed)
+	g.GET("/embed/:uid", s.GetMemoEmbed)
 }
 
 func (s *RSSService) GetExploreRSS(c echo.Context) error {
+	return s.serveExploreFeed(c, feedFormatRSS)
+}
+
+func (s *RSSService) GetExploreAtom(c echo.Context) error {
+	return s.serveExploreFeed(c, feedFormatAtom)
+}
+
+func (s *RSSService) GetExploreJSONFeed(c echo.Context) error {
+	return s.serveExploreFeed(c, feedFormatJSON)
+}
+
+func (s *RSSService) GetUserRSS(c echo.Context) error {
+	return s.serveUserFeed(c, feedFormatRSS)
+}
+
+func (s *RSSService) GetUserAtom(c echo.Context) error {
+	return s.serveUserFeed(c, feedFormatAtom)
+}
+
+func (s *RSSService) GetUserJSONFeed(c echo.Context) error {
+	return s.serveUserFeed(c, feedFormatJSON)
+}
+
+func (s *RSSService) serveExploreFeed(c echo.Context, format feedFormat) error {
 	ctx := c.Request().Context()
-	cacheKey := "explore"
+	query, err := parseFeedQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	cacheKey := string(format) + ":explore" + query.encode()
 
 	// Check cache first
 	if cached := s.getFromCache(cacheKey); cached != nil {
-		// Check ETag for conditional request
-		if c.Request().Header.Get("If-None-Match") == cached.etag {
-			return c.NoContent(http.StatusNotModified)
-		}
-		s.setRSSHeaders(c, cached.etag, cached.lastModified)
-		return c.String(http.StatusOK, cached.content)
+		return s.serveCachedFeed(c, format, cached)
 	}
 
 	normalStatus := store.Normal
 	limit := maxRSSItemCount
 	memoFind := store.FindMemo{
-		RowStatus:      &normalStatus,
-		VisibilityList: []store.Visibility{store.Public},
-		Limit:          &limit,
+		RowStatus:                &normalStatus,
+		ExcludeSuspendedCreators: true,
+		VisibilityList:           []store.Visibility{store.Public},
+		Filters:                  query.filters(),
+		Limit:                    &limit,
 	}
 	memoList, err := s.Store.ListMemos(ctx, &memoFind)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
 	}
 
-	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, nil)
+	baseURL := s.getBaseURL(c)
+	feed, err := s.buildFeed(ctx, memoList, baseURL, c.Request().URL.Path, query, nil)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate feed").SetInternal(err)
 	}
-
-	// Cache the result
-	etag := s.putInCache(cacheKey, rss, lastModified)
-	s.setRSSHeaders(c, etag, lastModified)
-	return c.String(http.StatusOK, rss)
+	return s.serveFeed(c, format, cacheKey, feed)
 }
 
-func (s *RSSService) GetUserRSS(c echo.Context) error {
+// serveUserFeed serves the feed of a user. With the feed token of the user in the token query
+// parameter, the feed includes the protected and private memos of the user.
+func (s *RSSService) serveUserFeed(c echo.Context, format feedFormat) error {
 	ctx := c.Request().Context()
 	username := c.Param("username")
-	cacheKey := "user:" + username
+	query, err := parseFeedQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	token := c.QueryParam("token")
+	cacheKey := string(format) + ":user:" + username + query.encode()
 
-	// Check cache first
-	if cached := s.getFromCache(cacheKey); cached != nil {
-		// Check ETag for conditional request
-		if c.Request().Header.Get("If-None-Match") == cached.etag {
-			return c.NoContent(http.StatusNotModified)
+	// Check cache first. Authenticated feeds are never cached.
+	if token == "" {
+		if cached := s.getFromCache(cacheKey); cached != nil {
+			return s.serveCachedFeed(c, format, cached)
 		}
-		s.setRSSHeaders(c, cached.etag, cached.lastModified)
-		return c.String(http.StatusOK, cached.content)
 	}
 
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
@@ -128,16 +171,30 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil {
+	// The memos of suspended users are hidden, as if the user didn't exist.
+	if user == nil || user.SuspendedTs != 0 {
 		return echo.NewHTTPError(http.StatusNotFound, "User not found")
 	}
 
+	visibilityList := []store.Visibility{store.Public}
+	if token != "" {
+		feedToken, err := s.Store.GetUserFeedToken(ctx, user.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find feed token").SetInternal(err)
+		}
+		if !auth.VerifyFeedToken(feedToken, token) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid feed token")
+		}
+		visibilityList = []store.Visibility{store.Public, store.Protected, store.Private}
+	}
+
 	normalStatus := store.Normal
 	limit := maxRSSItemCount
 	memoFind := store.FindMemo{
 		CreatorID:      &user.ID,
 		RowStatus:      &normalStatus,
-		VisibilityList: []store.Visibility{store.Public},
+		VisibilityList: visibilityList,
+		Filters:        query.filters(),
 		Limit:          &limit,
 	}
 	memoList, err := s.Store.ListMemos(ctx, &memoFind)
@@ -145,42 +202,75 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
 	}
 
-	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, lastModified, err := s.generateRSSFromMemoList(ctx, memoList, baseURL, user)
+	baseURL := s.getBaseURL(c)
+	// The self URL leaves the token out, so that the token isn't leaked along with the feed.
+	feed, err := s.buildFeed(ctx, memoList, baseURL, c.Request().URL.Path, query, user)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate feed").SetInternal(err)
+	}
+	feed.HomePageURL = baseURL + "/u/" + url.PathEscape(user.Username)
+	if token != "" {
+		return s.servePrivateFeed(c, format, feed)
+	}
+	return s.serveFeed(c, format, cacheKey, feed)
+}
+
+// serveFeed renders a feed in a format, and caches it.
+func (s *RSSService) serveFeed(c echo.Context, format feedFormat, cacheKey string, feed *memoFeed) error {
+	content, err := format.render(feed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render feed").SetInternal(err)
 	}
 
 	// Cache the result
-	etag := s.putInCache(cacheKey, rss, lastModified)
-	s.setRSSHeaders(c, etag, lastModified)
-	return c.String(http.StatusOK, rss)
+	etag := s.putInCache(cacheKey, content, feed.Updated)
+	s.setFeedHeaders(c, format, etag, feed.Updated)
+	return c.String(http.StatusOK, content)
+}
+
+// servePrivateFeed renders a feed authenticated with a feed token. It isn't cached, neither here
+// nor by shared caches, as it holds private memos.
+func (s *RSSService) servePrivateFeed(c echo.Context, format feedFormat, feed *memoFeed) error {
+	content, err := format.render(feed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render feed").SetInternal(err)
+	}
+	s.setFeedHeaders(c, format, contentETag(content), feed.Updated)
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+	return c.String(http.StatusOK, content)
 }
 
-func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string, user *store.User) (string, time.Time, error) {
+func (s *RSSService) serveCachedFeed(c echo.Context, format feedFormat, cached *cacheEntry) error {
+	// Check ETag for conditional request
+	if c.Request().Header.Get("If-None-Match") == cached.etag {
+		return c.NoContent(http.StatusNotModified)
+	}
+	s.setFeedHeaders(c, format, cached.etag, cached.lastModified)
+	return c.String(http.StatusOK, cached.content)
+}
+
+// buildFeed builds the feed of a list of memos, which every format is rendered from.
+func (s *RSSService) buildFeed(ctx context.Context, memoList []*store.Memo, baseURL, path string, query *feedQuery, user *store.User) (*memoFeed, error) {
 	rssHeading, err := getRSSHeading(ctx, s.Store)
 	if err != nil {
-		return "", time.Time{}, err
+		return nil, err
 	}
 
-	feed := &feeds.Feed{
-		Title:       rssHeading.Title,
-		Link:        &feeds.Link{Href: baseURL},
+	title := rssHeading.Title
+	if query.Tag != "" {
+		title = fmt.Sprintf("%s - #%s", title, query.Tag)
+	}
+	feed := &memoFeed{
+		Title:       title,
 		Description: rssHeading.Description,
-		Created:     time.Now(),
+		HomePageURL: baseURL,
+		SelfURL:     baseURL + path + query.encode(),
 	}
 
 	var itemCountLimit = min(len(memoList), maxRSSItemCount)
 	if itemCountLimit == 0 {
-		// Return empty feed if no memos
-		rss, err := feed.ToRss()
-		return rss, time.Time{}, err
-	}
-
-	// Track the most recent update time for Last-Modified header
-	var lastModified time.Time
-	if len(memoList) > 0 {
-		lastModified = time.Unix(memoList[0].UpdatedTs, 0)
+		// Return empty feed if no memos, e.g. for an unknown tag
+		return feed, nil
 	}
 
 	// Batch load all attachments for all memos to avoid N+1 query problem
@@ -193,7 +283,7 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 		MemoIDList: memoIDs,
 	})
 	if err != nil {
-		return "", lastModified, err
+		return nil, err
 	}
 
 	// Group attachments by memo ID for quick lookup
@@ -227,29 +317,34 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 	}
 
 	// Generate feed items
-	feed.Items = make([]*feeds.Item, itemCountLimit)
+	feed.Items = make([]*feedItem, itemCountLimit)
 	for i := 0; i < itemCountLimit; i++ {
 		memo := memoList[i]
 
-		// Generate item title from memo content
-		title := s.generateItemTitle(memo.Content)
+		// The ID doesn't depend on the query, so that feed readers don't show the memos of a
+		// feed again once it's filtered.
+		link := baseURL + "/memos/" + memo.UID
 
 		// Render content as HTML
-		htmlContent, err := s.getRSSItemDescription(memo.Content)
+		htmlContent, err := s.getRSSItemDescription(ctx, memo, baseURL, link)
 		if err != nil {
-			return "", lastModified, err
+			return nil, err
 		}
 
-		link := &feeds.Link{Href: baseURL + "/memos/" + memo.UID}
-
-		item := &feeds.Item{
-			Title:       title,
-			Link:        link,
-			Description: htmlContent, // Summary/excerpt
-			Content:     htmlContent, // Full content in content:encoded
-			Created:     time.Unix(memo.CreatedTs, 0),
+		item := &feedItem{
+			ID:          link,
+			URL:         link,
+			Title:       s.generateItemTitle(memo.Content),
+			ContentHTML: htmlContent,
+			Published:   time.Unix(memo.CreatedTs, 0),
 			Updated:     time.Unix(memo.UpdatedTs, 0),
-			Id:          link.Href,
+		}
+		// The feed is as recent as the latest update of its memos.
+		if item.Updated.After(feed.Updated) {
+			feed.Updated = item.Updated
+		}
+		if memo.Payload != nil {
+			item.Tags = memo.Payload.Tags
 		}
 
 		// Add author information
@@ -258,37 +353,80 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 			if authorName == "" {
 				authorName = creator.Username
 			}
-			item.Author = &feeds.Author{
-				Name:  authorName,
-				Email: creator.Email,
+			item.Author = &feedAuthor{
+				Name: authorName,
+				URL:  baseURL + "/u/" + url.PathEscape(creator.Username),
 			}
 		}
 
-		// Note: gorilla/feeds doesn't support categories in RSS items
-		// Tags could be added to the description or content if needed
-
-		// Add first attachment as enclosure
-		if attachments, ok := attachmentsByMemoID[memo.ID]; ok && len(attachments) > 0 {
-			attachment := attachments[0]
-			enclosure := feeds.Enclosure{}
+		for _, attachment := range attachmentsByMemoID[memo.ID] {
+			attachmentItem := &feedAttachment{
+				MIMEType: attachment.Type,
+				Title:    attachment.Filename,
+				Size:     attachment.Size,
+			}
 			if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL || attachment.StorageType == storepb.AttachmentStorageType_S3 {
-				enclosure.Url = attachment.Reference
+				attachmentItem.URL = attachment.Reference
 			} else {
-				enclosure.Url = fmt.Sprintf("%s/file/attachments/%s/%s", baseURL, attachment.UID, attachment.Filename)
+				attachmentItem.URL = fmt.Sprintf("%s/file/attachments/%s/%s", baseURL, attachment.UID, url.PathEscape(attachment.Filename))
+			}
+			item.Attachments = append(item.Attachments, attachmentItem)
+			// Images are shown inline, as not every feed reader shows enclosures.
+			if strings.HasPrefix(attachment.Type, "image/") {
+				item.ContentHTML += fmt.Sprintf(`<p><img src="%s" alt="%s"></p>`, html.EscapeString(attachmentItem.URL), html.EscapeString(attachment.Filename))
 			}
-			enclosure.Length = strconv.Itoa(int(attachment.Size))
-			enclosure.Type = attachment.Type
-			item.Enclosure = &enclosure
 		}
 
 		feed.Items[i] = item
 	}
+	return feed, nil
+}
 
-	rss, err := feed.ToRss()
-	if err != nil {
-		return "", lastModified, err
+// parseFeedQuery parses the query of a feed. The filter is validated like the filters of the API,
+// and the memos of feeds stay limited to the memos the feed can show whatever it matches.
+func parseFeedQuery(c echo.Context) (*feedQuery, error) {
+	query := &feedQuery{
+		Tag:    strings.TrimPrefix(strings.TrimSpace(c.QueryParam("tag")), "#"),
+		Filter: strings.TrimSpace(c.QueryParam("filter")),
+	}
+	if query.Filter != "" {
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := engine.Compile(c.Request().Context(), query.Filter); err != nil {
+			return nil, errors.Wrap(err, "invalid filter")
+		}
 	}
-	return rss, lastModified, nil
+	return query, nil
+}
+
+// filters returns the memo filters of the query.
+func (q *feedQuery) filters() []string {
+	var filters []string
+	if q.Tag != "" {
+		// Tags are matched case-insensitively, along with their subtags.
+		filters = append(filters, fmt.Sprintf("tag in [%s]", strconv.Quote(q.Tag)))
+	}
+	if q.Filter != "" {
+		filters = append(filters, q.Filter)
+	}
+	return filters
+}
+
+// encode returns the query string of the query, or an empty string if it doesn't filter the feed.
+func (q *feedQuery) encode() string {
+	values := url.Values{}
+	if q.Tag != "" {
+		values.Set("tag", q.Tag)
+	}
+	if q.Filter != "" {
+		values.Set("filter", q.Filter)
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
 }
 
 func (*RSSService) generateItemTitle(content string) string {
@@ -327,12 +465,68 @@ func (*RSSService) generateItemTitle(content string) string {
 	return title
 }
 
-func (s *RSSService) getRSSItemDescription(content string) (string, error) {
-	html, err := s.MarkdownService.RenderHTML([]byte(content))
+// getRSSItemDescription renders the content of a memo as sanitized HTML, with its links resolved
+// against the base URL. Content longer than the feed item length of the profile is truncated,
+// with a link to the memo to read the rest.
+func (s *RSSService) getRSSItemDescription(ctx context.Context, memo *store.Memo, baseURL, memoURL string) (string, error) {
+	renderedHTML, err := s.renderMemoHTML(ctx, memo, baseURL)
+	if err != nil {
+		return "", err
+	}
+	base, err := url.Parse(baseURL + "/")
+	if err != nil {
+		return "", err
+	}
+	sanitizer := &feedHTMLSanitizer{
+		baseURL:     base,
+		maxLength:   s.Profile.FeedItemLength,
+		readMoreURL: memoURL,
+	}
+	return sanitizer.sanitize(renderedHTML), nil
+}
+
+// renderMemoHTML renders the content of a memo as HTML, with its wiki links to the public memos
+// they're resolved to.
+func (s *RSSService) renderMemoHTML(ctx context.Context, memo *store.Memo, baseURL string) (string, error) {
+	memoIDs := []int32{}
+	for _, wikiLink := range memo.Payload.GetWikiLinks() {
+		if wikiLink.MemoId != 0 {
+			memoIDs = append(memoIDs, wikiLink.MemoId)
+		}
+	}
+	if len(memoIDs) == 0 {
+		return s.MarkdownService.RenderHTML([]byte(memo.Content))
+	}
+
+	linkedMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:         memoIDs,
+		ExcludeContent: true,
+		Filters:        []string{`visibility == "PUBLIC" && row_status != "DRAFT"`},
+	})
 	if err != nil {
 		return "", err
 	}
-	return html, nil
+	urls := map[int32]string{}
+	for _, linkedMemo := range linkedMemos {
+		urls[linkedMemo.ID] = baseURL + "/memos/" + linkedMemo.UID
+	}
+	return s.MarkdownService.RenderHTMLWithWikiLinks([]byte(memo.Content), func(target string) string {
+		for _, wikiLink := range memo.Payload.GetWikiLinks() {
+			if wikiLink.Target == target {
+				return urls[wikiLink.MemoId]
+			}
+		}
+		return ""
+	})
+}
+
+// getBaseURL returns the URL the links of feeds start with: the instance URL if it's set, or the
+// URL the feed is requested at.
+func (s *RSSService) getBaseURL(c echo.Context) string {
+	if s.Profile.InstanceURL != "" {
+		return strings.TrimSuffix(s.Profile.InstanceURL, "/")
+	}
+	return c.Scheme() + "://" + c.Request().Host
 }
 
 // getFromCache retrieves a cached feed entry if it exists and is not expired.
@@ -362,9 +556,7 @@ func (s *RSSService) putInCache(key, content string, lastModified time.Time) str
 	s.cacheMutex.Lock()
 	defer s.cacheMutex.Unlock()
 
-	// Generate ETag from content hash
-	hash := sha256.Sum256([]byte(content))
-	etag := fmt.Sprintf(`"%x"`, hash[:8])
+	etag := contentETag(content)
 
 	// Implement simple LRU: if cache is too large, remove oldest entries
 	if len(s.cache) >= maxCacheSize {
@@ -391,9 +583,15 @@ func (s *RSSService) putInCache(key, content string, lastModified time.Time) str
 	return etag
 }
 
-// setRSSHeaders sets appropriate HTTP headers for RSS responses.
-func (*RSSService) setRSSHeaders(c echo.Context, etag string, lastModified time.Time) {
-	c.Response().Header().Set(echo.HeaderContentType, "application/rss+xml; charset=utf-8")
+// contentETag returns the ETag of a feed, from the hash of its content.
+func contentETag(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return fmt.Sprintf(`"%x"`, hash[:8])
+}
+
+// setFeedHeaders sets appropriate HTTP headers for feed responses.
+func (*RSSService) setFeedHeaders(c echo.Context, format feedFormat, etag string, lastModified time.Time) {
+	c.Response().Header().Set(echo.HeaderContentType, format.contentType())
 	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(defaultCacheDuration.Seconds())))
 	c.Response().Header().Set("ETag", etag)
 	if !lastModified.IsZero() {
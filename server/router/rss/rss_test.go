@@ -0,0 +1,392 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+type testFeed struct {
+	Channel struct {
+		Title    string `xml:"title"`
+		AtomLink struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"http://www.w3.org/2005/Atom link"`
+		Items []struct {
+			Title string `xml:"title"`
+			GUID  string `xml:"guid"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+// getTestFeed gets an RSS feed, returning its status code and the feed, if it's found.
+func getTestFeed(t *testing.T, e *echo.Echo, target string) (int, *testFeed) {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	if recorder.Code != http.StatusOK {
+		return recorder.Code, nil
+	}
+	feed := &testFeed{}
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), feed))
+	return recorder.Code, feed
+}
+
+func TestFeedQuery(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	createMemo := func(uid, content string, visibility store.Visibility, tags ...string) {
+		_, err := stores.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: visibility,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+	}
+	createMemo("blog", "Post #blog", store.Public, "blog")
+	createMemo("subtag", "Post #Blog/tech", store.Public, "Blog/tech")
+	createMemo("other", "Other #misc", store.Public, "misc")
+	createMemo("private", "Private #blog", store.Private, "blog")
+
+	e := echo.New()
+	service := NewRSSService(&profile.Profile{Driver: "sqlite"}, stores, markdown.NewService(markdown.WithTagExtension()))
+	service.RegisterRoutes(e.Group(""))
+	getFeed := func(target string) (int, *testFeed) {
+		return getTestFeed(t, e, target)
+	}
+	guids := func(feed *testFeed) []string {
+		guids := []string{}
+		for _, item := range feed.Channel.Items {
+			guids = append(guids, item.GUID)
+		}
+		return guids
+	}
+
+	_, all := getFeed("/u/user/rss.xml")
+	require.Len(t, all.Channel.Items, 3)
+	require.Equal(t, "http://example.com/u/user/rss.xml", all.Channel.AtomLink.Href)
+
+	// Tags match their subtags, and private memos are left out of every feed.
+	for _, target := range []string{"/u/user/rss.xml?tag=blog", "/explore/rss.xml?tag=%23BLOG"} {
+		code, feed := getFeed(target)
+		require.Equal(t, http.StatusOK, code)
+		require.ElementsMatch(t, []string{"http://example.com/memos/blog", "http://example.com/memos/subtag"}, guids(feed))
+		require.Contains(t, feed.Channel.Title, "#")
+		require.Equal(t, "self", feed.Channel.AtomLink.Rel)
+		require.Contains(t, feed.Channel.AtomLink.Href, "?tag=")
+		// The items keep their GUIDs in filtered feeds.
+		require.Subset(t, guids(all), guids(feed))
+	}
+
+	_, feed := getFeed("/u/user/rss.xml?filter=" + url.QueryEscape(`content.contains("Other")`))
+	require.Equal(t, []string{"http://example.com/memos/other"}, guids(feed))
+	_, feed = getFeed("/explore/rss.xml?filter=" + url.QueryEscape(`visibility == "PRIVATE"`))
+	require.Empty(t, feed.Channel.Items)
+
+	// Unknown tags have an empty feed, and invalid filters are rejected.
+	code, feed := getFeed("/u/user/rss.xml?tag=unknown")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, feed.Channel.Items)
+	code, _ = getFeed("/u/user/rss.xml?filter=unknown_field%20==%201")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestFeedToken(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	for _, memo := range []*store.Memo{
+		{UID: "public", Content: "Public", Visibility: store.Public},
+		{UID: "protected", Content: "Protected", Visibility: store.Protected},
+		{UID: "private", Content: "Private", Visibility: store.Private},
+	} {
+		memo.CreatorID = user.ID
+		_, err := stores.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+	token, tokenHash, err := auth.GenerateFeedToken()
+	require.NoError(t, err)
+	require.NoError(t, stores.UpsertUserFeedToken(ctx, user.ID, &storepb.FeedTokenUserSetting{TokenHash: tokenHash}))
+
+	e := echo.New()
+	service := NewRSSService(&profile.Profile{Driver: "sqlite"}, stores, markdown.NewService(markdown.WithTagExtension()))
+	service.RegisterRoutes(e.Group(""))
+	get := func(target string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+		return recorder
+	}
+
+	// The public feed is cached first, and the authenticated feed doesn't get it from the cache.
+	recorder := get("/u/user/rss.xml")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Header().Get(echo.HeaderCacheControl), "public")
+	_, feed := getTestFeed(t, e, "/u/user/rss.xml")
+	require.Len(t, feed.Channel.Items, 1)
+
+	code, feed := getTestFeed(t, e, "/u/user/rss.xml?token="+token)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, feed.Channel.Items, 3)
+	// The token isn't leaked in the self link of the feed.
+	require.Equal(t, "http://example.com/u/user/rss.xml", feed.Channel.AtomLink.Href)
+	recorder = get("/u/user/feed.json?tag=unknown&token=" + token)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "private, no-store", recorder.Header().Get(echo.HeaderCacheControl))
+	require.NotContains(t, recorder.Body.String(), token)
+
+	// The public feed stays public once the authenticated feed was served.
+	_, feed = getTestFeed(t, e, "/u/user/rss.xml")
+	require.Len(t, feed.Channel.Items, 1)
+
+	// Invalid and revoked tokens are rejected.
+	require.Equal(t, http.StatusUnauthorized, get("/u/user/atom.xml?token=invalid").Code)
+	require.NoError(t, stores.UpsertUserFeedToken(ctx, user.ID, &storepb.FeedTokenUserSetting{}))
+	require.Equal(t, http.StatusUnauthorized, get("/u/user/rss.xml?token="+token).Code)
+}
+
+// TestFeedFormats checks the Atom and JSON Feed output against the elements RFC 4287 and JSON Feed
+// 1.1 require.
+func TestFeedFormats(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Nickname: "User", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	memo, err := stores.CreateMemo(ctx, &store.Memo{
+		UID:        "memo",
+		CreatorID:  user.ID,
+		Content:    "# Title\nSome **content** #tag",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"tag"}},
+	})
+	require.NoError(t, err)
+	for _, attachment := range []*store.Attachment{
+		{UID: "photo", Filename: "photo one.png", Type: "image/png"},
+		{UID: "notes", Filename: "notes.pdf", Type: "application/pdf"},
+	} {
+		attachment.CreatorID = user.ID
+		attachment.Blob = []byte("data")
+		attachment.Size = 4
+		attachment.MemoID = &memo.ID
+		_, err := stores.CreateAttachment(ctx, attachment)
+		require.NoError(t, err)
+	}
+
+	e := echo.New()
+	service := NewRSSService(&profile.Profile{Driver: "sqlite"}, stores, markdown.NewService(markdown.WithTagExtension()))
+	service.RegisterRoutes(e.Group(""))
+	get := func(target string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+		return recorder
+	}
+	requireTime := func(value string) {
+		_, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err, value)
+	}
+
+	t.Run("atom", func(t *testing.T) {
+		recorder := get("/u/user/atom.xml?tag=tag")
+		require.Equal(t, "application/atom+xml; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+		type atomLink struct {
+			Href   string `xml:"href,attr"`
+			Rel    string `xml:"rel,attr"`
+			Type   string `xml:"type,attr"`
+			Length string `xml:"length,attr"`
+		}
+		atom := struct {
+			XMLName xml.Name   `xml:"http://www.w3.org/2005/Atom feed"`
+			ID      string     `xml:"id"`
+			Title   string     `xml:"title"`
+			Updated string     `xml:"updated"`
+			Links   []atomLink `xml:"link"`
+			Entries []struct {
+				ID        string `xml:"id"`
+				Title     string `xml:"title"`
+				Updated   string `xml:"updated"`
+				Published string `xml:"published"`
+				Author    struct {
+					Name string `xml:"name"`
+					URI  string `xml:"uri"`
+				} `xml:"author"`
+				Categories []struct {
+					Term string `xml:"term,attr"`
+				} `xml:"category"`
+				Content struct {
+					Type  string `xml:"type,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"content"`
+				Links []atomLink `xml:"link"`
+			} `xml:"entry"`
+		}{}
+		require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &atom))
+		require.Equal(t, "http://example.com/u/user/atom.xml?tag=tag", atom.ID)
+		require.NotEmpty(t, atom.Title)
+		requireTime(atom.Updated)
+		require.Contains(t, atom.Links, atomLink{Href: "http://example.com/u/user/atom.xml?tag=tag", Rel: "self", Type: "application/atom+xml"})
+		require.Contains(t, atom.Links, atomLink{Href: "http://example.com/u/user", Rel: "alternate", Type: "text/html"})
+
+		require.Len(t, atom.Entries, 1)
+		entry := atom.Entries[0]
+		require.Equal(t, "http://example.com/memos/memo", entry.ID)
+		require.Equal(t, "Title", entry.Title)
+		requireTime(entry.Updated)
+		requireTime(entry.Published)
+		require.Equal(t, "User", entry.Author.Name)
+		require.Equal(t, "http://example.com/u/user", entry.Author.URI)
+		require.Len(t, entry.Categories, 1)
+		require.Equal(t, "tag", entry.Categories[0].Term)
+		require.Equal(t, "html", entry.Content.Type)
+		require.Contains(t, entry.Content.Value, "<strong>content</strong>")
+		require.Equal(t, []atomLink{
+			{Href: "http://example.com/memos/memo", Rel: "alternate", Type: "text/html"},
+			{Href: "http://example.com/file/attachments/photo/photo%20one.png", Rel: "enclosure", Type: "image/png", Length: "4"},
+			{Href: "http://example.com/file/attachments/notes/notes.pdf", Rel: "enclosure", Type: "application/pdf", Length: "4"},
+		}, entry.Links)
+	})
+
+	t.Run("json feed", func(t *testing.T) {
+		recorder := get("/explore/feed.json")
+		require.Equal(t, "application/feed+json; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+		jsonFeed := map[string]any{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &jsonFeed))
+		require.Equal(t, "https://jsonfeed.org/version/1.1", jsonFeed["version"])
+		require.IsType(t, "", jsonFeed["title"])
+		require.Equal(t, "http://example.com/explore/feed.json", jsonFeed["feed_url"])
+
+		feed := struct {
+			Items []struct {
+				ID            string `json:"id"`
+				URL           string `json:"url"`
+				ContentHTML   string `json:"content_html"`
+				DatePublished string `json:"date_published"`
+				DateModified  string `json:"date_modified"`
+				Authors       []struct {
+					Name string `json:"name"`
+					URL  string `json:"url"`
+				} `json:"authors"`
+				Tags        []string `json:"tags"`
+				Attachments []struct {
+					URL         string `json:"url"`
+					MIMEType    string `json:"mime_type"`
+					Title       string `json:"title"`
+					SizeInBytes int64  `json:"size_in_bytes"`
+				} `json:"attachments"`
+			} `json:"items"`
+		}{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &feed))
+		require.Len(t, feed.Items, 1)
+		item := feed.Items[0]
+		require.Equal(t, "http://example.com/memos/memo", item.ID)
+		require.Equal(t, item.ID, item.URL)
+		require.Contains(t, item.ContentHTML, "<strong>content</strong>")
+		requireTime(item.DatePublished)
+		requireTime(item.DateModified)
+		require.Len(t, item.Authors, 1)
+		require.Equal(t, "User", item.Authors[0].Name)
+		require.Equal(t, []string{"tag"}, item.Tags)
+		require.Len(t, item.Attachments, 2)
+		require.Equal(t, "image/png", item.Attachments[0].MIMEType)
+		require.Equal(t, "photo one.png", item.Attachments[0].Title)
+		require.Equal(t, int64(4), item.Attachments[0].SizeInBytes)
+
+		// Feeds without items still have the required items array.
+		recorder = get("/explore/feed.json?tag=unknown")
+		require.Contains(t, recorder.Body.String(), `"items": []`)
+	})
+
+	t.Run("the formats have the same items", func(t *testing.T) {
+		_, rss := getTestFeed(t, e, "/u/user/rss.xml")
+		require.Len(t, rss.Channel.Items, 1)
+		require.Equal(t, "http://example.com/memos/memo", rss.Channel.Items[0].GUID)
+	})
+}
+
+func TestFeedItemContent(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	memo, err := stores.CreateMemo(ctx, &store.Memo{
+		UID:        "memo",
+		CreatorID:  user.ID,
+		Content:    "See [the other memo](/memos/other).\n\n<script>alert(1)</script>\n\nLorem ipsum dolor sit amet, consectetur adipiscing elit.",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	for _, attachment := range []*store.Attachment{
+		{UID: "notes", Filename: "notes.pdf", Type: "application/pdf"},
+		{UID: "voice", Filename: "voice.ogg", Type: "audio/ogg"},
+		{UID: "photo", Filename: "photo.png", Type: "image/png"},
+	} {
+		attachment.CreatorID = user.ID
+		attachment.Blob = []byte("data")
+		attachment.Size = 4
+		attachment.MemoID = &memo.ID
+		_, err := stores.CreateAttachment(ctx, attachment)
+		require.NoError(t, err)
+	}
+
+	e := echo.New()
+	service := NewRSSService(&profile.Profile{Driver: "sqlite", InstanceURL: "https://memos.example.com/", FeedItemLength: 40}, stores, markdown.NewService(markdown.WithTagExtension()))
+	service.RegisterRoutes(e.Group(""))
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/u/user/rss.xml", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	feed := struct {
+		Items []struct {
+			Link        string `xml:"link"`
+			Description string `xml:"description"`
+			Enclosure   struct {
+				URL    string `xml:"url,attr"`
+				Type   string `xml:"type,attr"`
+				Length string `xml:"length,attr"`
+			} `xml:"enclosure"`
+		} `xml:"channel>item"`
+	}{}
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &feed))
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+
+	// Links use the instance URL, and relative links are resolved against it.
+	require.Equal(t, "https://memos.example.com/memos/memo", item.Link)
+	require.Contains(t, item.Description, `<a href="https://memos.example.com/memos/other">the other memo</a>`)
+	require.NotContains(t, item.Description, "script")
+	// Long content is truncated with a link to the memo.
+	require.NotContains(t, item.Description, "adipiscing")
+	require.Contains(t, item.Description, `<a href="https://memos.example.com/memos/memo">Read more</a>`)
+	// Images are shown inline, and the enclosure is the first image or audio attachment.
+	require.Contains(t, item.Description, `<img src="https://memos.example.com/file/attachments/photo/photo.png" alt="photo.png">`)
+	require.Equal(t, "https://memos.example.com/file/attachments/voice/voice.ogg", item.Enclosure.URL)
+	require.Equal(t, "audio/ogg", item.Enclosure.Type)
+	require.Equal(t, "4", item.Enclosure.Length)
+}
@@ -0,0 +1,250 @@
+package rss
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+
+	"github.com/usememos/memos/plugin/markdown"
+)
+
+// feedAllowedAttributes are the elements kept in the HTML of feed items, with their allowed
+// attributes. Other elements are dropped, and their text is kept.
+var feedAllowedAttributes = map[string][]string{
+	"a":          {"href", "title"},
+	"b":          nil,
+	"blockquote": nil,
+	"br":         nil,
+	"code":       {"class"},
+	"del":        nil,
+	"em":         nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title", "width", "height"},
+	"input":      {"type", "checked", "disabled"},
+	"li":         nil,
+	"ol":         {"start"},
+	"p":          nil,
+	"pre":        nil,
+	"s":          nil,
+	"span":       {"class"},
+	"strong":     nil,
+	"sub":        nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"style"},
+	"th":         {"style"},
+	"thead":      nil,
+	"tr":         nil,
+	"ul":         nil,
+}
+
+// feedDroppedElements are the elements dropped from the HTML of feed items along with their content.
+var feedDroppedElements = []string{"script", "style", "iframe", "object", "embed", "noscript", "template", "textarea", "select", "svg", "math"}
+
+// feedVoidElements are the elements without an end tag.
+var feedVoidElements = []string{"br", "hr", "img", "input"}
+
+// feedHTMLSanitizer sanitizes the rendered HTML of a memo for feed readers, which show it
+// outside of the web app.
+type feedHTMLSanitizer struct {
+	// baseURL is the URL relative links and images are resolved against.
+	baseURL *url.URL
+	// maxLength is the number of characters of text kept, 0 keeps all the text.
+	maxLength int
+	// readMoreURL is the URL of the "Read more" link appended to truncated content.
+	readMoreURL string
+}
+
+// sanitize returns the sanitized HTML. Elements and attributes that aren't allowed are dropped,
+// including scripts and event handlers, and links keep only http, https and mailto URLs.
+func (s *feedHTMLSanitizer) sanitize(content string) string {
+	var buf strings.Builder
+	var openElements []string
+	textLength := 0
+	truncated := false
+	skipElement, skipDepth := "", 0
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	for !truncated {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+		if skipElement != "" {
+			// Nested elements of the same name, e.g. svg, are skipped along.
+			if token.Data == skipElement {
+				switch tokenType {
+				case html.StartTagToken:
+					skipDepth++
+				case html.EndTagToken:
+					skipDepth--
+				default:
+				}
+				if skipDepth == 0 {
+					skipElement = ""
+				}
+			}
+			continue
+		}
+
+		switch tokenType {
+		case html.TextToken:
+			text := token.Data
+			if s.maxLength > 0 && textLength+utf8.RuneCountInString(text) > s.maxLength {
+				text = truncateText(text, s.maxLength-textLength) + "…"
+				truncated = true
+			}
+			textLength += utf8.RuneCountInString(text)
+			buf.WriteString(html.EscapeString(text))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if slices.Contains(feedDroppedElements, token.Data) {
+				if tokenType == html.StartTagToken {
+					skipElement, skipDepth = token.Data, 1
+				}
+				continue
+			}
+			if isDiagram(token) {
+				// Feed readers don't render diagrams, and their definitions aren't readable.
+				if tokenType == html.StartTagToken {
+					skipElement, skipDepth = token.Data, 1
+				}
+				textLength += utf8.RuneCountInString(markdown.DiagramPlaceholder)
+				buf.WriteString("<p>" + markdown.DiagramPlaceholder + "</p>")
+				continue
+			}
+			allowedAttributes, ok := feedAllowedAttributes[token.Data]
+			if !ok {
+				continue
+			}
+			start, ok := s.sanitizeStartTag(token, allowedAttributes)
+			if !ok {
+				continue
+			}
+			buf.WriteString(start)
+			if tokenType == html.StartTagToken && !slices.Contains(feedVoidElements, token.Data) {
+				openElements = append(openElements, token.Data)
+			}
+		case html.EndTagToken:
+			// End tags close the elements opened since their start tag, and are dropped without one.
+			index := slices.Index(openElements, token.Data)
+			if index < 0 {
+				continue
+			}
+			for len(openElements) > index {
+				buf.WriteString("</" + openElements[len(openElements)-1] + ">")
+				openElements = openElements[:len(openElements)-1]
+			}
+		default:
+			// Comments and doctypes are dropped.
+		}
+	}
+	for len(openElements) > 0 {
+		buf.WriteString("</" + openElements[len(openElements)-1] + ">")
+		openElements = openElements[:len(openElements)-1]
+	}
+	if truncated && s.readMoreURL != "" {
+		buf.WriteString(`<p><a href="` + html.EscapeString(s.readMoreURL) + `">Read more</a></p>`)
+	}
+	return buf.String()
+}
+
+// isDiagram reports whether an element is a mermaid diagram, as rendered by the markdown service.
+func isDiagram(token html.Token) bool {
+	for _, attribute := range token.Attr {
+		if attribute.Namespace == "" && attribute.Key == "class" && slices.Contains(strings.Fields(attribute.Val), "mermaid") {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeStartTag returns the start tag of an allowed element with its allowed attributes, or
+// false if the element is dropped.
+func (s *feedHTMLSanitizer) sanitizeStartTag(token html.Token, allowedAttributes []string) (string, bool) {
+	var buf strings.Builder
+	buf.WriteString("<" + token.Data)
+	isCheckbox := false
+	for _, attribute := range token.Attr {
+		if attribute.Namespace != "" || !slices.Contains(allowedAttributes, attribute.Key) {
+			continue
+		}
+		value := attribute.Val
+		switch attribute.Key {
+		case "href", "src":
+			resolved, ok := s.resolveURL(value, attribute.Key == "href")
+			if !ok {
+				continue
+			}
+			value = resolved
+		case "type":
+			// Only the checkboxes of task lists are kept.
+			if value != "checkbox" {
+				return "", false
+			}
+			isCheckbox = true
+		case "class":
+			// Classes are kept for the languages of code blocks and for tags.
+			if !strings.HasPrefix(value, "language-") && value != "tag" {
+				continue
+			}
+		case "style":
+			// Styles are kept for the alignment of table cells.
+			if !strings.HasPrefix(value, "text-align:") || strings.ContainsAny(value, ";()") {
+				continue
+			}
+		default:
+		}
+		buf.WriteString(" " + attribute.Key + `="` + html.EscapeString(value) + `"`)
+	}
+	if token.Data == "input" && !isCheckbox {
+		return "", false
+	}
+	buf.WriteString(">")
+	return buf.String(), true
+}
+
+// resolveURL resolves a URL against the base URL, so that relative links and attachments work in
+// feed readers. Only http and https URLs are kept, and mailto URLs for links.
+func (s *feedHTMLSanitizer) resolveURL(rawURL string, isLink bool) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || rawURL == "" {
+		return "", false
+	}
+	switch u.Scheme {
+	case "":
+		return s.baseURL.ResolveReference(u).String(), true
+	case "http", "https":
+		return u.String(), true
+	case "mailto":
+		return u.String(), isLink
+	default:
+		return "", false
+	}
+}
+
+// truncateText truncates text to at most maxLength characters, at the last space if there's one.
+func truncateText(text string, maxLength int) string {
+	if maxLength <= 0 {
+		return ""
+	}
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+	truncated := string(runes[:maxLength])
+	if index := strings.LastIndexAny(truncated, " \n\t"); index > 0 {
+		truncated = truncated[:index]
+	}
+	return strings.TrimRight(truncated, " \n\t")
+}
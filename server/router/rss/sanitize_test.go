@@ -0,0 +1,101 @@
+package rss
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/markdown"
+)
+
+func TestFeedHTMLSanitizer(t *testing.T) {
+	baseURL, err := url.Parse("https://memos.example.com/")
+	require.NoError(t, err)
+	sanitizer := &feedHTMLSanitizer{baseURL: baseURL}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "scripts are dropped with their content",
+			content: `<p>Hello<script>alert(1)</script> <style>p{}</style>world</p>`,
+			want:    `<p>Hello world</p>`,
+		},
+		{
+			name:    "event handlers and unknown attributes are dropped",
+			content: `<p onclick="alert(1)" id="p"><img src="x.png" onerror="alert(1)" alt="x"></p>`,
+			want:    `<p><img src="https://memos.example.com/x.png" alt="x"></p>`,
+		},
+		{
+			name:    "unsafe URLs are dropped",
+			content: `<a href="javascript:alert(1)">a</a><a href="mailto:user@example.com">b</a><img src="data:image/png;base64,AAAA">`,
+			want:    `<a>a</a><a href="mailto:user@example.com">b</a><img>`,
+		},
+		{
+			name:    "relative links are resolved against the base URL",
+			content: `<a href="/memos/abc">memo</a> <img src="/file/attachments/a/b.png">`,
+			want:    `<a href="https://memos.example.com/memos/abc">memo</a> <img src="https://memos.example.com/file/attachments/a/b.png">`,
+		},
+		{
+			name:    "unknown elements keep their text",
+			content: `<div><font color="red">text</font></div>`,
+			want:    `text`,
+		},
+		{
+			name:    "task list checkboxes are kept, other inputs are dropped",
+			content: `<li><input checked="" disabled="" type="checkbox"> done</li><input type="text" value="x">`,
+			want:    `<li><input checked="" disabled="" type="checkbox"> done</li>`,
+		},
+		{
+			name:    "unclosed elements are closed",
+			content: `<p><strong>bold</p>`,
+			want:    `<p><strong>bold</strong></p>`,
+		},
+		{
+			name:    "diagrams are replaced with a placeholder",
+			content: `<pre class="mermaid">graph TD\n  A[&quot;&lt;b&gt;&quot;] --&gt; B\n</pre><p>after</p>`,
+			want:    `<p>[diagram]</p><p>after</p>`,
+		},
+		{
+			name:    "text is escaped",
+			content: `<p>1 &lt; 2 &amp; &lt;b&gt;</p>`,
+			want:    `<p>1 &lt; 2 &amp; &lt;b&gt;</p>`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, sanitizer.sanitize(test.content))
+		})
+	}
+}
+
+func TestFeedHTMLSanitizerMarkdown(t *testing.T) {
+	baseURL, err := url.Parse("https://memos.example.com/")
+	require.NoError(t, err)
+	renderedHTML, err := markdown.NewService(markdown.WithTagExtension()).RenderHTML([]byte("# Title\n\n- [x] done\n- [ ] todo\n\n```go\nfmt.Println(\"<hi>\")\n```\n\n| a | b |\n|:-|-:|\n| 1 | 2 |\n\n#tag <b onclick=\"x\">raw</b>"))
+	require.NoError(t, err)
+
+	sanitized := (&feedHTMLSanitizer{baseURL: baseURL}).sanitize(renderedHTML)
+	require.Contains(t, sanitized, `<h1>Title</h1>`)
+	require.Contains(t, sanitized, `<li><input checked="" disabled="" type="checkbox"> done</li>`)
+	require.Contains(t, sanitized, `<pre><code class="language-go">fmt.Println(&#34;&lt;hi&gt;&#34;)`)
+	require.Contains(t, sanitized, `<th style="text-align:left">a</th>`)
+	require.Contains(t, sanitized, `<span class="tag">#tag</span>`)
+	require.NotContains(t, sanitized, "onclick")
+}
+
+func TestFeedHTMLSanitizerTruncation(t *testing.T) {
+	baseURL, err := url.Parse("https://memos.example.com/")
+	require.NoError(t, err)
+	sanitizer := &feedHTMLSanitizer{baseURL: baseURL, maxLength: 12, readMoreURL: "https://memos.example.com/memos/abc"}
+
+	require.Equal(t,
+		`<p>Short</p><ul><li>Lorem…</li></ul><p><a href="https://memos.example.com/memos/abc">Read more</a></p>`,
+		sanitizer.sanitize(`<p>Short</p><ul><li>Lorem ipsum dolor</li><li>sit amet</li></ul><p>More</p>`),
+	)
+	// Content within the length isn't truncated.
+	require.Equal(t, `<p>Short</p>`, sanitizer.sanitize(`<p>Short</p>`))
+}
@@ -0,0 +1,138 @@
+package slack
+
+import (
+	"context"
+	"html"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	slackapi "github.com/usememos/memos/plugin/slack"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+const (
+	// maxFiles is the maximum number of files saved with a message.
+	maxFiles = 10
+	// maxFileSize is the maximum size of a file saved with a message. The files are also limited
+	// by the upload size limit of the instance.
+	maxFileSize = 64 << 20
+)
+
+// slackEntityRegexp matches the entities of the text of Slack messages, e.g. <@U123|name>,
+// <#C123|general>, <!here> and <https://example.com|label>.
+var slackEntityRegexp = regexp.MustCompile(`<([^<>|]+)(?:\|([^<>]*))?>`)
+
+// message is a message saved with the "Save to memos" message action.
+type message struct {
+	TS    string  `json:"ts"`
+	Text  string  `json:"text"`
+	Files []*file `json:"files"`
+}
+
+// file is a file shared in a message.
+type file struct {
+	Name               string `json:"name"`
+	Mimetype           string `json:"mimetype"`
+	Size               int64  `json:"size"`
+	URLPrivate         string `json:"url_private"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+// convertText converts the text of a Slack message to markdown. Slack escapes &, < and >, and
+// wraps links and mentions in angle brackets, e.g. <https://example.com|label>.
+func convertText(text string) string {
+	text = slackEntityRegexp.ReplaceAllStringFunc(text, func(entity string) string {
+		match := slackEntityRegexp.FindStringSubmatch(entity)
+		target, label := match[1], match[2]
+		switch {
+		case strings.HasPrefix(target, "@"):
+			if label != "" {
+				return "@" + label
+			}
+			return target
+		case strings.HasPrefix(target, "#"):
+			if label != "" {
+				return "#" + label
+			}
+			return target
+		case strings.HasPrefix(target, "!"):
+			// Special mentions, e.g. <!here> or <!subteam^S123|@team>.
+			if label != "" {
+				return label
+			}
+			return "@" + strings.TrimPrefix(target, "!")
+		default:
+			if label != "" {
+				return "[" + label + "](" + target + ")"
+			}
+			return target
+		}
+	})
+	return strings.TrimSpace(html.UnescapeString(text))
+}
+
+// downloadFiles downloads the files of a message and uploads them as attachments. The files that
+// fail, e.g. those over the upload size limit, are left out.
+func (s *Service) downloadFiles(ctx context.Context, client *slackapi.Client, files []*file) []*v1pb.Attachment {
+	attachments := []*v1pb.Attachment{}
+	for index, item := range files {
+		if index >= maxFiles {
+			slog.Warn("dropped Slack files over the limit", "count", len(files)-index)
+			break
+		}
+		fileURL := item.URLPrivateDownload
+		if fileURL == "" {
+			fileURL = item.URLPrivate
+		}
+		data, err := client.DownloadFile(ctx, fileURL, maxFileSize)
+		if err != nil {
+			slog.Warn("failed to download Slack file", "filename", item.Name, "error", err)
+			continue
+		}
+		contentType := item.Mimetype
+		if contentType == "" {
+			contentType = http.DetectContentType(data)
+		}
+		attachment, err := s.api.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{
+				Filename: item.Name,
+				Type:     contentType,
+				Content:  data,
+			},
+		})
+		if err != nil {
+			slog.Warn("failed to upload Slack file", "filename", item.Name, "error", err)
+			continue
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments
+}
+
+// createMemo creates a private memo, tagged #slack, as the current user of the context. The
+// attachments are deleted if the memo can't be created.
+func (s *Service) createMemo(ctx context.Context, content string, attachments []*v1pb.Attachment) (*v1pb.Memo, error) {
+	if content == "" && len(attachments) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty message")
+	}
+	memo := &v1pb.Memo{
+		Content:     strings.TrimSpace(content + "\n\n#" + slackTag),
+		Visibility:  v1pb.Visibility_PRIVATE,
+		Attachments: attachments,
+	}
+	created, err := s.api.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: memo})
+	if err != nil {
+		for _, attachment := range attachments {
+			if _, err := s.api.DeleteAttachment(ctx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name}); err != nil {
+				slog.Warn("failed to delete Slack attachment", "attachment", attachment.Name, "error", err)
+			}
+		}
+		return nil, err
+	}
+	return created, nil
+}
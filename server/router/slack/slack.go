@@ -0,0 +1,364 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/profile"
+	slackapi "github.com/usememos/memos/plugin/slack"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxRequestSize is the maximum size of the requests from Slack.
+	maxRequestSize = 1 << 20
+	// slackTag is the tag of the memos created from Slack.
+	slackTag = "slack"
+	// saveMessageCallbackID is the callback ID of the "Save to memos" message action.
+	saveMessageCallbackID = "save_to_memos"
+)
+
+// Service handles the requests of the Slack app of the instance: the /memo slash command, the
+// "Save to memos" message action, and the direct messages linking Slack accounts to users.
+type Service struct {
+	profile *profile.Profile
+	store   *store.Store
+	api     *apiv1.APIV1Service
+
+	// apiURL is the URL of the Slack Web API, overridden in tests.
+	apiURL string
+}
+
+func NewService(profile *profile.Profile, store *store.Store, api *apiv1.APIV1Service) *Service {
+	return &Service{
+		profile: profile,
+		store:   store,
+		api:     api,
+		apiURL:  slackapi.APIURL,
+	}
+}
+
+// RegisterRoutes registers the request URLs of the Slack app: the slash command, interactivity
+// and event subscriptions URLs.
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.POST("/slack/commands", s.handleCommand)
+	g.POST("/slack/interactions", s.handleInteraction)
+	g.POST("/slack/events", s.handleEvent)
+}
+
+// readRequest reads the body of a request from Slack, once its signature is verified.
+func (s *Service) readRequest(c echo.Context) ([]byte, *storepb.InstanceSlackSetting, error) {
+	slackSetting, err := s.store.GetInstanceSlackSetting(c.Request().Context())
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get instance Slack setting").SetInternal(err)
+	}
+	if slackSetting.SigningSecret == "" {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, "Slack integration is disabled")
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, maxRequestSize))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "failed to read request").SetInternal(err)
+	}
+	if err := slackapi.Verify(slackSetting.SigningSecret, c.Request().Header, body, time.Now()); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid signature").SetInternal(err)
+	}
+	return body, slackSetting, nil
+}
+
+// handleCommand creates a memo from the text of a /memo slash command, and replies with an
+// ephemeral confirmation.
+func (s *Service) handleCommand(c echo.Context) error {
+	body, _, err := s.readRequest(c)
+	if err != nil {
+		return err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid command").SetInternal(err)
+	}
+	ctx := c.Request().Context()
+	text := strings.TrimSpace(form.Get("text"))
+	if text == "" || strings.EqualFold(text, "help") {
+		return c.JSON(http.StatusOK, slackapi.NewResponse(s.usage(form.Get("command")), true))
+	}
+
+	user, err := s.findLinkedUser(ctx, form.Get("team_id"), form.Get("user_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find linked user").SetInternal(err)
+	}
+	if user == nil {
+		return c.JSON(http.StatusOK, slackapi.NewResponse(s.linkInstructions(), true))
+	}
+	memo, err := s.createMemo(context.WithValue(ctx, auth.UserIDContextKey, user.ID), convertText(text), nil)
+	if err != nil {
+		slog.Warn("failed to create memo from Slack command", "user", user.ID, "error", err)
+		return c.JSON(http.StatusOK, slackapi.NewResponse("Failed to save the memo: "+err.Error(), true))
+	}
+	return c.JSON(http.StatusOK, slackapi.NewResponse(s.confirmation(memo.Name), true))
+}
+
+// interaction is the payload of a message action.
+type interaction struct {
+	Type        string `json:"type"`
+	CallbackID  string `json:"callback_id"`
+	ResponseURL string `json:"response_url"`
+	Team        struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Message *message `json:"message"`
+}
+
+// handleInteraction saves a message to memos with the "Save to memos" message action. Slack
+// expects an acknowledgment within 3 seconds, so the message is saved in the background, and the
+// confirmation sent to the response URL of the action.
+func (s *Service) handleInteraction(c echo.Context) error {
+	body, slackSetting, err := s.readRequest(c)
+	if err != nil {
+		return err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid interaction").SetInternal(err)
+	}
+	payload := &interaction{}
+	if err := json.Unmarshal([]byte(form.Get("payload")), payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid interaction payload").SetInternal(err)
+	}
+	// Other interactions, e.g. of other message actions, are acknowledged and ignored.
+	if payload.Type != "message_action" || payload.CallbackID != saveMessageCallbackID || payload.Message == nil {
+		return c.NoContent(http.StatusOK)
+	}
+
+	go s.saveMessage(context.WithoutCancel(c.Request().Context()), slackSetting, payload)
+	return c.NoContent(http.StatusOK)
+}
+
+// saveMessage creates a memo from a message with its permalink and files, and sends the result to
+// the response URL of the message action.
+func (s *Service) saveMessage(ctx context.Context, slackSetting *storepb.InstanceSlackSetting, payload *interaction) {
+	client, err := slackapi.NewClient(slackSetting.BotToken, s.apiURL)
+	if err != nil {
+		slog.Error("failed to create Slack client", "error", err)
+		return
+	}
+	respond := func(text string) {
+		if err := client.Respond(ctx, payload.ResponseURL, text, true); err != nil {
+			slog.Warn("failed to respond to Slack message action", "error", err)
+		}
+	}
+
+	user, err := s.findLinkedUser(ctx, payload.Team.ID, payload.User.ID)
+	if err != nil {
+		slog.Error("failed to find linked user", "error", err)
+		respond("Failed to save the message, please try again later.")
+		return
+	}
+	if user == nil {
+		respond(s.linkInstructions())
+		return
+	}
+	ctx = context.WithValue(ctx, auth.UserIDContextKey, user.ID)
+
+	// The permalink and the files need the bot token.
+	content := convertText(payload.Message.Text)
+	var attachments []*v1pb.Attachment
+	if slackSetting.BotToken != "" {
+		permalink, err := client.GetPermalink(ctx, payload.Channel.ID, payload.Message.TS)
+		if err != nil {
+			slog.Warn("failed to get Slack message permalink", "error", err)
+		} else if permalink != "" {
+			content = strings.TrimSpace(content + "\n\n[View in Slack](" + permalink + ")")
+		}
+		attachments = s.downloadFiles(ctx, client, payload.Message.Files)
+	}
+	memo, err := s.createMemo(ctx, content, attachments)
+	if err != nil {
+		slog.Warn("failed to create memo from Slack message", "user", user.ID, "error", err)
+		respond("Failed to save the message: " + err.Error())
+		return
+	}
+	respond(s.confirmation(memo.Name))
+}
+
+// event is the payload of the Events API.
+type event struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	TeamID    string `json:"team_id"`
+	Event     struct {
+		Type        string `json:"type"`
+		Subtype     string `json:"subtype"`
+		ChannelType string `json:"channel_type"`
+		Channel     string `json:"channel"`
+		User        string `json:"user"`
+		BotID       string `json:"bot_id"`
+		Text        string `json:"text"`
+	} `json:"event"`
+}
+
+// handleEvent links the Slack account of the direct messages to the app that contain a link
+// code, and answers the URL verification of the event subscriptions.
+func (s *Service) handleEvent(c echo.Context) error {
+	body, slackSetting, err := s.readRequest(c)
+	if err != nil {
+		return err
+	}
+	payload := &event{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid event").SetInternal(err)
+	}
+	if payload.Type == "url_verification" {
+		return c.JSON(http.StatusOK, map[string]string{"challenge": payload.Challenge})
+	}
+	// Events are retried when they aren't acknowledged in time, and were already handled.
+	if c.Request().Header.Get("X-Slack-Retry-Num") != "" {
+		return c.NoContent(http.StatusOK)
+	}
+	// Only the messages of users in direct messages to the app are handled, not those of bots,
+	// including the replies of the app, nor edits.
+	if payload.Type != "event_callback" || payload.Event.Type != "message" || payload.Event.ChannelType != "im" ||
+		payload.Event.Subtype != "" || payload.Event.BotID != "" || payload.Event.User == "" {
+		return c.NoContent(http.StatusOK)
+	}
+
+	ctx := c.Request().Context()
+	reply, err := s.linkAccount(ctx, payload.TeamID, payload.Event.User, payload.Event.Text)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to link Slack account").SetInternal(err)
+	}
+	if slackSetting.BotToken == "" {
+		slog.Warn("can't reply to Slack direct message without a bot token")
+		return c.NoContent(http.StatusOK)
+	}
+	client, err := slackapi.NewClient(slackSetting.BotToken, s.apiURL)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create Slack client").SetInternal(err)
+	}
+	if err := client.PostMessage(ctx, payload.Event.Channel, reply); err != nil {
+		slog.Warn("failed to reply to Slack direct message", "error", err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// linkAccount links a Slack account to the user whose pending link code is the text of a direct
+// message, and returns the reply to the message. A Slack account is linked to one user at most,
+// so it's unlinked from the others.
+func (s *Service) linkAccount(ctx context.Context, teamID, slackUserID, text string) (string, error) {
+	settings, err := s.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_SLACK})
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	var linked *storepb.UserSetting
+	for _, setting := range settings {
+		if auth.VerifyLinkCode(setting.GetSlack().GetLinkCodeHash(), setting.GetSlack().GetLinkCodeExpireTime(), strings.TrimSpace(text), now) {
+			linked = setting
+			break
+		}
+	}
+	if linked == nil {
+		if user, err := s.findLinkedUser(ctx, teamID, slackUserID); err == nil && user != nil {
+			return "Your Slack account is linked to memos. " + s.usage("/memo"), nil
+		}
+		return "That link code is invalid or expired. " + s.linkInstructions(), nil
+	}
+
+	for _, setting := range settings {
+		slack := setting.GetSlack()
+		if setting.UserId != linked.UserId && slack.GetTeamId() == teamID && slack.GetSlackUserId() == slackUserID {
+			slack.TeamId, slack.SlackUserId, slack.LinkTime = "", "", nil
+			if err := s.store.UpsertUserSlack(ctx, setting.UserId, slack); err != nil {
+				return "", errors.Wrap(err, "failed to unlink Slack account")
+			}
+		}
+	}
+	slack := &storepb.SlackUserSetting{
+		TeamId:      teamID,
+		SlackUserId: slackUserID,
+		LinkTime:    timestamppb.New(now),
+	}
+	if err := s.store.UpsertUserSlack(ctx, linked.UserId, slack); err != nil {
+		return "", errors.Wrap(err, "failed to link Slack account")
+	}
+	user, err := s.store.GetUser(ctx, &store.FindUser{ID: &linked.UserId})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get linked user")
+	}
+	if user == nil {
+		return "", errors.New("linked user not found")
+	}
+	return "Your Slack account is now linked to " + user.Username + " on memos. " + s.usage("/memo"), nil
+}
+
+// findLinkedUser returns the user a Slack account is linked to, or nil if there's none. Archived
+// and suspended users aren't returned, as they can't create memos.
+func (s *Service) findLinkedUser(ctx context.Context, teamID, slackUserID string) (*store.User, error) {
+	if teamID == "" || slackUserID == "" {
+		return nil, nil
+	}
+	settings, err := s.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_SLACK})
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range settings {
+		if setting.GetSlack().GetTeamId() != teamID || setting.GetSlack().GetSlackUserId() != slackUserID {
+			continue
+		}
+		user, err := s.store.GetUser(ctx, &store.FindUser{ID: &setting.UserId})
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+			return nil, nil
+		}
+		return user, nil
+	}
+	return nil, nil
+}
+
+func (*Service) usage(command string) string {
+	if command == "" {
+		command = "/memo"
+	}
+	return "Use `" + command + " <text>` to create a memo, or the \"Save to memos\" action of a message to save it with its files."
+}
+
+// linkInstructions returns the instructions for the Slack users who aren't linked to a user.
+func (s *Service) linkInstructions() string {
+	where := "memos"
+	if instanceURL := strings.TrimSuffix(s.profile.InstanceURL, "/"); instanceURL != "" {
+		where = "<" + instanceURL + "/setting|memos>"
+	}
+	return "Your Slack account isn't linked to memos yet. Generate a link code in the account settings of " + where +
+		", then send it to this app in a direct message."
+}
+
+// confirmation returns the reply to a saved memo, with a link to the memo if the instance URL is
+// set.
+func (s *Service) confirmation(memoName string) string {
+	instanceURL := strings.TrimSuffix(s.profile.InstanceURL, "/")
+	if instanceURL == "" {
+		return "Saved to memos."
+	}
+	return "Saved to memos: <" + instanceURL + "/" + memoName + "|view memo>"
+}
@@ -0,0 +1,287 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	slackapi "github.com/usememos/memos/plugin/slack"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	apitest "github.com/usememos/memos/server/router/api/v1/test"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	testSigningSecret = "signing-secret"
+	testBotToken      = "xoxb-test"
+)
+
+// fakeSlack is a fake Slack Web API, recording the messages and the responses it receives.
+type fakeSlack struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	messages  []string
+	responses []string
+}
+
+func newFakeSlack(t *testing.T) *fakeSlack {
+	t.Helper()
+	fake := &fakeSlack{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/chat.postMessage", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		fake.mu.Lock()
+		fake.messages = append(fake.messages, body["text"])
+		fake.mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+	mux.HandleFunc("/api/chat.getPermalink", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":true,"permalink":"https://team.slack.com/archives/` + r.URL.Query().Get("channel") + `/p1"}`))
+	})
+	mux.HandleFunc("/files/photo.png", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testBotToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("png"))
+	})
+	mux.HandleFunc("/response", func(_ http.ResponseWriter, r *http.Request) {
+		response := &slackapi.Response{}
+		_ = json.NewDecoder(r.Body).Decode(response)
+		fake.mu.Lock()
+		fake.responses = append(fake.responses, response.Text)
+		fake.mu.Unlock()
+	})
+	fake.Server = httptest.NewServer(mux)
+	t.Cleanup(fake.Close)
+	return fake
+}
+
+func (f *fakeSlack) lastMessage() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.messages) == 0 {
+		return ""
+	}
+	return f.messages[len(f.messages)-1]
+}
+
+func (f *fakeSlack) lastResponse() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.responses) == 0 {
+		return ""
+	}
+	return f.responses[len(f.responses)-1]
+}
+
+type testService struct {
+	e       *echo.Echo
+	store   *store.Store
+	api     *apiv1.APIV1Service
+	slack   *fakeSlack
+	user    *store.User
+	userCtx context.Context
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	ctx := context.Background()
+	fixture := apitest.NewTestService(t)
+	t.Cleanup(fixture.Cleanup)
+	fixture.Profile.InstanceURL = "https://memos.example.com"
+	stores := fixture.Store
+
+	_, err := stores.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_SLACK,
+		Value: &storepb.InstanceSetting_SlackSetting{SlackSetting: &storepb.InstanceSlackSetting{SigningSecret: testSigningSecret, BotToken: testBotToken}},
+	})
+	require.NoError(t, err)
+	user, err := fixture.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	fake := newFakeSlack(t)
+	service := NewService(fixture.Profile, stores, fixture.Service)
+	service.apiURL = fake.URL + "/api/"
+	e := echo.New()
+	service.RegisterRoutes(e.Group(""))
+	return &testService{
+		e:       e,
+		store:   stores,
+		api:     fixture.Service,
+		slack:   fake,
+		user:    user,
+		userCtx: context.WithValue(ctx, auth.UserIDContextKey, user.ID),
+	}
+}
+
+// post sends a signed request to the Slack app, returning the response.
+func (ts *testService) post(t *testing.T, target, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	request := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	nowSec := time.Now().Unix()
+	request.Header.Set(slackapi.TimestampHeader, strconv.FormatInt(nowSec, 10))
+	request.Header.Set(slackapi.SignatureHeader, slackapi.Sign(testSigningSecret, nowSec, body))
+	recorder := httptest.NewRecorder()
+	ts.e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (ts *testService) command(t *testing.T, text string) *slackapi.Response {
+	t.Helper()
+	form := url.Values{"command": {"/memo"}, "team_id": {"T1"}, "user_id": {"U1"}, "text": {text}}
+	recorder := ts.post(t, "/slack/commands", "application/x-www-form-urlencoded", []byte(form.Encode()))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	response := &slackapi.Response{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+	require.Equal(t, "ephemeral", response.ResponseType)
+	return response
+}
+
+// link links the Slack user U1 to the test user with a direct message to the app.
+func (ts *testService) link(t *testing.T) {
+	t.Helper()
+	slackLink, err := ts.api.GenerateUserSlackLinkCode(ts.userCtx, &v1pb.GenerateUserSlackLinkCodeRequest{Name: "users/" + strconv.Itoa(int(ts.user.ID)) + "/slackLink"})
+	require.NoError(t, err)
+	require.Len(t, slackLink.LinkCode, 8)
+	ts.directMessage(t, " "+strings.ToLower(slackLink.LinkCode)+" ")
+	require.Contains(t, ts.slack.lastMessage(), "now linked to user")
+}
+
+func (ts *testService) directMessage(t *testing.T, text string) {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{
+		"type":    "event_callback",
+		"team_id": "T1",
+		"event":   map[string]string{"type": "message", "channel_type": "im", "channel": "D1", "user": "U1", "text": text},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, ts.post(t, "/slack/events", "application/json", body).Code)
+}
+
+func (ts *testService) listMemos(t *testing.T) []*store.Memo {
+	t.Helper()
+	memos, err := ts.store.ListMemos(context.Background(), &store.FindMemo{CreatorID: &ts.user.ID})
+	require.NoError(t, err)
+	return memos
+}
+
+func TestCommand(t *testing.T) {
+	ts := newTestService(t)
+
+	// Unlinked Slack users get instructions.
+	response := ts.command(t, "Hello")
+	require.Contains(t, response.Text, "isn't linked to memos yet")
+	require.Empty(t, ts.listMemos(t))
+
+	ts.link(t)
+	response = ts.command(t, "Read <https://example.com|the docs> &amp; ask <@U2|bob> #work")
+	memos := ts.listMemos(t)
+	require.Len(t, memos, 1)
+	require.Equal(t, "Read [the docs](https://example.com) & ask @bob #work\n\n#slack", memos[0].Content)
+	require.Equal(t, store.Private, memos[0].Visibility)
+	require.Equal(t, "Saved to memos: <https://memos.example.com/memos/"+memos[0].UID+"|view memo>", response.Text)
+
+	require.Contains(t, ts.command(t, "").Text, "Use `/memo <text>`")
+}
+
+func TestSaveMessageAction(t *testing.T) {
+	ts := newTestService(t)
+	ts.link(t)
+
+	payload, err := json.Marshal(map[string]any{
+		"type":         "message_action",
+		"callback_id":  saveMessageCallbackID,
+		"response_url": ts.slack.URL + "/response",
+		"team":         map[string]string{"id": "T1"},
+		"user":         map[string]string{"id": "U1"},
+		"channel":      map[string]string{"id": "C1"},
+		"message": map[string]any{
+			"ts":   "1.2",
+			"text": "Release notes",
+			"files": []map[string]any{
+				{"name": "photo.png", "mimetype": "image/png", "url_private_download": ts.slack.URL + "/files/photo.png"},
+				// The bot token isn't sent to other hosts.
+				{"name": "other.png", "mimetype": "image/png", "url_private_download": "https://example.com/other.png"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	form := url.Values{"payload": {string(payload)}}
+	require.Equal(t, http.StatusOK, ts.post(t, "/slack/interactions", "application/x-www-form-urlencoded", []byte(form.Encode())).Code)
+
+	require.Eventually(t, func() bool { return strings.HasPrefix(ts.slack.lastResponse(), "Saved to memos") }, 5*time.Second, 10*time.Millisecond)
+	memos := ts.listMemos(t)
+	require.Len(t, memos, 1)
+	require.Equal(t, "Release notes\n\n[View in Slack](https://team.slack.com/archives/C1/p1)\n\n#slack", memos[0].Content)
+	attachments, err := ts.store.ListAttachments(context.Background(), &store.FindAttachment{MemoID: &memos[0].ID, GetBlob: true})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, "photo.png", attachments[0].Filename)
+	require.Equal(t, "png", string(attachments[0].Blob))
+}
+
+func TestLinkAccount(t *testing.T) {
+	ts := newTestService(t)
+
+	ts.directMessage(t, "WRONGCODE")
+	require.Contains(t, ts.slack.lastMessage(), "invalid or expired")
+
+	ts.link(t)
+	slack, err := ts.store.GetUserSlack(context.Background(), ts.user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "T1", slack.TeamId)
+	require.Equal(t, "U1", slack.SlackUserId)
+	// The code is single-use.
+	require.Empty(t, slack.LinkCodeHash)
+
+	// Linking the Slack account to another user unlinks it from the first one.
+	other, err := ts.store.CreateUser(context.Background(), &store.User{Username: "other", Role: store.RoleUser, Email: "other@example.com"})
+	require.NoError(t, err)
+	otherCtx := context.WithValue(context.Background(), auth.UserIDContextKey, other.ID)
+	slackLink, err := ts.api.GenerateUserSlackLinkCode(otherCtx, &v1pb.GenerateUserSlackLinkCodeRequest{Name: "users/" + strconv.Itoa(int(other.ID)) + "/slackLink"})
+	require.NoError(t, err)
+	ts.directMessage(t, slackLink.LinkCode)
+	require.Contains(t, ts.slack.lastMessage(), "now linked to other")
+	slack, err = ts.store.GetUserSlack(context.Background(), ts.user.ID)
+	require.NoError(t, err)
+	require.Empty(t, slack.SlackUserId)
+}
+
+func TestRejectsUnsignedRequests(t *testing.T) {
+	ts := newTestService(t)
+
+	body := []byte(url.Values{"team_id": {"T1"}, "user_id": {"U1"}, "text": {"Hello"}}.Encode())
+	request := httptest.NewRequest(http.MethodPost, "/slack/commands", bytes.NewReader(body))
+	request.Header.Set(slackapi.TimestampHeader, strconv.FormatInt(time.Now().Unix(), 10))
+	request.Header.Set(slackapi.SignatureHeader, slackapi.Sign("other-secret", time.Now().Unix(), body))
+	recorder := httptest.NewRecorder()
+	ts.e.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// The URL verification of the event subscriptions is signed too.
+	recorder = ts.post(t, "/slack/events", "application/json", []byte(`{"type":"url_verification","challenge":"abc"}`))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"challenge":"abc"}`, string(body))
+}
@@ -0,0 +1,77 @@
+package unsubscribe
+
+import (
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// pageContentSecurityPolicy only allows inline styles and the form posting to the page itself.
+const pageContentSecurityPolicy = "default-src 'none'; style-src 'unsafe-inline'; form-action 'self'; frame-ancestors 'none'"
+
+// Service serves the unsubscribe links of the notification emails. The links carry a signed token
+// naming the user and the notification types to turn off, so they work without signing in.
+type Service struct {
+	store *store.Store
+	api   *apiv1.APIV1Service
+}
+
+func NewService(store *store.Store, api *apiv1.APIV1Service) *Service {
+	return &Service{
+		store: store,
+		api:   api,
+	}
+}
+
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.GET("/email/unsubscribe", s.confirmUnsubscribe)
+	g.POST("/email/unsubscribe", s.unsubscribe)
+}
+
+// confirmUnsubscribe asks to confirm unsubscribing with a form rather than unsubscribing, as mail
+// scanners follow the links of emails.
+func (*Service) confirmUnsubscribe(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		return renderPage(c, http.StatusBadRequest, "Invalid link", "This unsubscribe link is invalid.", "")
+	}
+	form := `<form method="post" action="?token=` + html.EscapeString(token) + `"><button type="submit">Unsubscribe</button></form>`
+	return renderPage(c, http.StatusOK, "Unsubscribe", "Stop receiving these notification emails?", form)
+}
+
+// unsubscribe turns off the notification emails of the token. It's also the one-click unsubscribe
+// of the List-Unsubscribe-Post header, see RFC 8058.
+func (s *Service) unsubscribe(c echo.Context) error {
+	labels, err := s.api.UnsubscribeNotificationEmails(c.Request().Context(), c.FormValue("token"))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound:
+			return renderPage(c, http.StatusBadRequest, "Invalid link", "This unsubscribe link is invalid or expired. You can change your notification emails in your settings.", "")
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to unsubscribe").SetInternal(err)
+		}
+	}
+	return renderPage(c, http.StatusOK, "Unsubscribed", "You won't receive emails about "+strings.Join(labels, ", ")+" anymore. You can turn them back on in your settings.", "")
+}
+
+// renderPage serves a minimal page with a title, a message and an optional form.
+func renderPage(c echo.Context, code int, title, message, form string) error {
+	var page strings.Builder
+	page.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1">`)
+	page.WriteString("<title>" + html.EscapeString(title) + "</title>")
+	page.WriteString(`<style>body{max-width:480px;margin:64px auto;padding:0 16px;font:15px/1.5 system-ui,sans-serif;color:#27272a}` +
+		`button{padding:8px 16px;font:inherit;border:1px solid #d4d4d8;border-radius:6px;background:#fff;cursor:pointer}</style>`)
+	page.WriteString("</head><body><h1>" + html.EscapeString(title) + "</h1><p>" + html.EscapeString(message) + "</p>")
+	page.WriteString(form + "</body></html>")
+
+	c.Response().Header().Set("Content-Security-Policy", pageContentSecurityPolicy)
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.HTML(code, page.String())
+}
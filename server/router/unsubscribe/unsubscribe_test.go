@@ -0,0 +1,77 @@
+package unsubscribe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestUnsubscribe(t *testing.T) {
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() { stores.Close() })
+	api := &apiv1.APIV1Service{
+		Secret:          "test-secret",
+		Profile:         &profile.Profile{Mode: "dev", Version: "test-1.0.0", Driver: "sqlite"},
+		Store:           stores,
+		MarkdownService: markdown.NewService(),
+	}
+	e := echo.New()
+	NewService(stores, api).RegisterRoutes(e.Group(""))
+
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+	_, err = stores.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+		Value: &storepb.UserSetting_Notification{Notification: &storepb.NotificationUserSetting{
+			EmailMemoComment:  true,
+			EmailMemoReaction: true,
+		}},
+	})
+	require.NoError(t, err)
+	token, err := auth.NewAuthenticator(stores, "test-secret").GenerateUnsubscribeToken(ctx, user.ID, []string{storepb.InboxMessage_MEMO_REACTION.String()})
+	require.NoError(t, err)
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+	getSetting := func() *storepb.NotificationUserSetting {
+		setting, err := stores.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSetting_NOTIFICATION})
+		require.NoError(t, err)
+		return setting.GetNotification()
+	}
+
+	// Following the link only asks to confirm.
+	recorder := serve(http.MethodGet, "/email/unsubscribe?token="+url.QueryEscape(token), "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), `<form method="post"`)
+	require.True(t, getSetting().EmailMemoReaction)
+
+	// The one-click unsubscribe of mail clients posts to the link.
+	recorder = serve(http.MethodPost, "/email/unsubscribe?token="+url.QueryEscape(token), "List-Unsubscribe=One-Click")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "reactions")
+	require.False(t, getSetting().EmailMemoReaction)
+	require.True(t, getSetting().EmailMemoComment)
+
+	recorder = serve(http.MethodPost, "/email/unsubscribe", "token=invalid")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,99 @@
+package accesstoken
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+const (
+	// Schedule runner every 24 hours.
+	runnerInterval = time.Hour * 24
+	// ExpiredRetention is how long expired access tokens stay listed before they are deleted.
+	ExpiredRetention = time.Hour * 24 * 30
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("accesstoken")
+	defer run.End()
+
+	if err := r.DeleteExpiredAccessTokens(ctx, time.Now().Add(-ExpiredRetention)); err != nil {
+		run.Fail()
+	}
+	if err := r.DeleteExpiredRefreshTokens(ctx, time.Now()); err != nil {
+		run.Fail()
+	}
+}
+
+// DeleteExpiredRefreshTokens deletes session refresh tokens that expired before cutoff. The error
+// is logged before it's returned.
+func (r *Runner) DeleteExpiredRefreshTokens(ctx context.Context, cutoff time.Time) error {
+	cutoffSec := cutoff.Unix()
+	if err := r.Store.DeleteRefreshTokens(ctx, &store.DeleteRefreshToken{ExpiresBefore: &cutoffSec}); err != nil {
+		slog.Error("failed to delete expired refresh tokens", "error", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteExpiredAccessTokens deletes access tokens of all users that expired before cutoff. The
+// tokens that can't be deleted are logged and skipped, and the last error is returned.
+func (r *Runner) DeleteExpiredAccessTokens(ctx context.Context, cutoff time.Time) error {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_ACCESS_TOKENS,
+	})
+	if err != nil {
+		slog.Error("failed to list access tokens", "error", err)
+		return err
+	}
+
+	var lastErr error
+	deleted := 0
+	for _, userSetting := range userSettings {
+		for _, accessToken := range userSetting.GetAccessTokens().GetAccessTokens() {
+			expiresAt, err := auth.GetAccessTokenExpiration(accessToken.AccessToken)
+			if err != nil || expiresAt.IsZero() || !expiresAt.Before(cutoff) {
+				continue
+			}
+			if err := r.Store.RemoveUserAccessToken(ctx, userSetting.UserId, accessToken.AccessToken); err != nil {
+				slog.Error("failed to delete expired access token", "user", userSetting.UserId, "error", err)
+				lastErr = err
+				continue
+			}
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		slog.Info("deleted expired access tokens", "count", deleted)
+	}
+	return lastErr
+}
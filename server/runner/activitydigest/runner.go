@@ -0,0 +1,68 @@
+package activitydigest
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Deliver delivers the digest of a user if one is scheduled since the last one.
+	Deliver func(ctx context.Context, userID int32) error
+}
+
+func NewRunner(store *store.Store, deliver func(ctx context.Context, userID int32) error) *Runner {
+	return &Runner{
+		Store:   store,
+		Deliver: deliver,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce delivers the digests that are due of the users who turned them on. The last digest of
+// each user is recorded, so running it again doesn't deliver them twice.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("activitydigest")
+	defer run.End()
+
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_NOTIFICATION})
+	if err != nil {
+		slog.Error("failed to list notification settings", "error", err)
+		run.Fail()
+		return
+	}
+
+	for _, userSetting := range userSettings {
+		if userSetting.GetNotification().GetDigestFrequency() == storepb.NotificationUserSetting_DIGEST_FREQUENCY_UNSPECIFIED {
+			continue
+		}
+		if err := r.Deliver(ctx, userSetting.UserId); err != nil {
+			slog.Error("failed to deliver activity digest", "user", userSetting.UserId, "error", err)
+			run.Fail()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
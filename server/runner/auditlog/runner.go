@@ -0,0 +1,55 @@
+package auditlog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce deletes the audit log entries older than the retention of the instance general setting.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("auditlog")
+	defer run.End()
+
+	instanceGeneralSetting, err := r.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get instance general setting", "error", err)
+		run.Fail()
+		return
+	}
+	cutoffSec := time.Now().AddDate(0, 0, -int(instanceGeneralSetting.AuditLogRetentionDays)).Unix()
+	if err := r.Store.DeleteAuditLogs(ctx, &store.DeleteAuditLog{CreatedBefore: &cutoffSec}); err != nil {
+		slog.Error("failed to delete expired audit logs", "error", err)
+		run.Fail()
+	}
+}
@@ -0,0 +1,80 @@
+package backup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Backup backs up the instance to the destination of the backup setting.
+	Backup func(ctx context.Context)
+
+	// schedule is the schedule of the backup setting that nextRunTime was computed with.
+	schedule    string
+	nextRunTime time.Time
+}
+
+func NewRunner(store *store.Store, backup func(ctx context.Context)) *Runner {
+	return &Runner{
+		Store:  store,
+		Backup: backup,
+	}
+}
+
+// Check the backup schedule every minute, the finest granularity of cron expressions.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce runs a backup if one is due by the schedule of the backup setting. The first run
+// after the server starts, or after the schedule changes, only computes the next backup time, so
+// the backups missed while the server was down aren't caught up.
+func (r *Runner) RunOnce(ctx context.Context) {
+	backupSetting, err := r.Store.GetInstanceBackupSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get instance backup setting", "error", err)
+		return
+	}
+	if !backupSetting.Enabled {
+		r.schedule, r.nextRunTime = "", time.Time{}
+		return
+	}
+
+	now := time.Now().UTC()
+	if backupSetting.Schedule != r.schedule || r.nextRunTime.IsZero() {
+		schedule, err := cron.ParseStandard(backupSetting.Schedule)
+		if err != nil {
+			slog.Error("invalid backup schedule", "schedule", backupSetting.Schedule, "error", err)
+			return
+		}
+		r.schedule, r.nextRunTime = backupSetting.Schedule, schedule.Next(now)
+		return
+	}
+	if now.Before(r.nextRunTime) {
+		return
+	}
+
+	r.Backup(ctx)
+	schedule, err := cron.ParseStandard(r.schedule)
+	if err != nil {
+		return
+	}
+	r.nextRunTime = schedule.Next(time.Now().UTC())
+}
@@ -0,0 +1,116 @@
+package externallink
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/router/linkpreview"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Check checks the external link of an attachment on behalf of its creator, recording whether
+	// it's broken.
+	Check func(ctx context.Context, attachment *store.Attachment) (*store.Attachment, error)
+}
+
+func NewRunner(store *store.Store, check func(ctx context.Context, attachment *store.Attachment) (*store.Attachment, error)) *Runner {
+	return &Runner{
+		Store: store,
+		Check: check,
+	}
+}
+
+const (
+	// Schedule runner every day.
+	runnerInterval = 24 * time.Hour
+	// checkInterval is how long the result of the check of an external link is kept before the
+	// link is checked again.
+	checkInterval = 7 * 24 * time.Hour
+	// maxRateLimitWait is the longest wait for the rate limit of a creator before giving up on
+	// their links until the next run.
+	maxRateLimitWait = time.Minute
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce checks the external links of the attachments that haven't been checked for a week,
+// when the instance storage setting opted in. The checks are charged to the link preview rate
+// limits of the creators, waiting for them to refill.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("externallink")
+	defer run.End()
+
+	instanceStorageSetting, err := r.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get instance storage setting", "error", err)
+		run.Fail()
+		return
+	}
+	if !instanceStorageSetting.CheckExternalLinks {
+		return
+	}
+
+	storageType := storepb.AttachmentStorageType_EXTERNAL
+	attachments, err := r.Store.ListAttachments(ctx, &store.FindAttachment{StorageType: &storageType})
+	if err != nil {
+		slog.Error("failed to list external attachments", "error", err)
+		run.Fail()
+		return
+	}
+	checkedBefore := time.Now().Add(-checkInterval)
+	checked, broken := 0, 0
+	for _, attachment := range attachments {
+		if lastCheckTime := attachment.Payload.GetExternalLink().GetLastCheckTime(); lastCheckTime != nil && lastCheckTime.AsTime().After(checkedBefore) {
+			continue
+		}
+		checkedAttachment, err := r.check(ctx, attachment)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			slog.Warn("failed to check external attachment", "attachment", attachment.UID, "error", err)
+			continue
+		}
+		checked++
+		if checkedAttachment.Payload.GetExternalLink().GetBroken() {
+			broken++
+		}
+	}
+	if checked > 0 {
+		slog.Info("checked external attachments", "count", checked, "broken", broken)
+	}
+}
+
+// check checks an attachment, waiting once for the rate limit of its creator.
+func (r *Runner) check(ctx context.Context, attachment *store.Attachment) (*store.Attachment, error) {
+	checked, err := r.Check(ctx, attachment)
+	var rateLimitErr *linkpreview.RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter <= maxRateLimitWait {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(rateLimitErr.RetryAfter):
+		}
+		checked, err = r.Check(ctx, attachment)
+	}
+	return checked, err
+}
@@ -0,0 +1,51 @@
+package loginattempt
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce deletes the failed sign-in attempts that no longer count, i.e. unlocked attempts
+// whose last failure is older than the failure window.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("loginattempt")
+	defer run.End()
+
+	cutoffSec := time.Now().Add(-auth.LoginFailureWindow).Unix()
+	if err := r.Store.DeleteLoginAttempts(ctx, &store.DeleteLoginAttempt{LastFailedBefore: &cutoffSec}); err != nil {
+		slog.Error("failed to delete stale login attempts", "error", err)
+		run.Fail()
+	}
+}
@@ -0,0 +1,461 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	matrixapi "github.com/usememos/memos/plugin/matrix"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// commandPrefix starts the commands of the bot, e.g. "!memos link <code>".
+	commandPrefix = "!memos"
+	// memoPrefix starts the messages saved to memos outside direct messages.
+	memoPrefix = "!memo"
+	// matrixTag is the tag of the memos created from Matrix.
+	matrixTag = "matrix"
+	// maxFileSize is the maximum size of a file saved with a message. The files are also limited
+	// by the upload size limit of the instance.
+	maxFileSize = 64 << 20
+	// maxPostLength is the maximum length, in characters, of the memos posted to the rooms.
+	maxPostLength = 1000
+)
+
+// handleEvent handles a timeline event of a room: the messages from other accounts than the bot
+// that are commands, or to save to memos.
+func (r *Runner) handleEvent(ctx context.Context, client *matrixapi.Client, roomID string, event *matrixapi.Event) {
+	if event.Sender == r.userID {
+		return
+	}
+	switch event.Type {
+	case "m.room.member":
+		// The members changed, so the room may not be a direct message anymore.
+		delete(r.memberCounts, roomID)
+		return
+	case "m.room.encrypted":
+		if r.getRoom(ctx, roomID).Enabled && !r.encryptedRooms[roomID] {
+			r.encryptedRooms[roomID] = true
+			r.reply(ctx, client, roomID, "This room is encrypted, and the memos bot can only read unencrypted rooms.")
+		}
+		return
+	case "m.room.message":
+	default:
+		return
+	}
+	if !r.getRoom(ctx, roomID).Enabled {
+		return
+	}
+
+	content := &matrixapi.MessageContent{}
+	if err := json.Unmarshal(event.Content, content); err != nil {
+		slog.Warn("invalid Matrix message", "room", roomID, "event", event.EventID, "error", err)
+		return
+	}
+	// Edits, and notices, which are sent by bots, are ignored.
+	if (content.RelatesTo != nil && content.RelatesTo.RelType == "m.replace") || content.MsgType == matrixapi.MsgTypeNotice {
+		return
+	}
+	body := content.Body
+	if content.RelatesTo != nil && content.RelatesTo.InReplyTo != nil {
+		body = stripReplyFallback(body)
+	}
+
+	switch content.MsgType {
+	case matrixapi.MsgTypeText, matrixapi.MsgTypeEmote:
+		text := strings.TrimSpace(body)
+		if args, ok := cutPrefix(text, commandPrefix); ok {
+			if r.allowMessage(roomID, event.Sender) {
+				r.handleCommand(ctx, client, roomID, event.Sender, args)
+			}
+			return
+		}
+		if rest, ok := cutPrefix(text, memoPrefix); ok {
+			text = rest
+		} else if !r.isDirect(ctx, client, roomID) {
+			return
+		}
+		if r.allowMessage(roomID, event.Sender) {
+			r.saveMessage(ctx, client, roomID, event.Sender, text, nil)
+		}
+	case matrixapi.MsgTypeImage, matrixapi.MsgTypeFile, matrixapi.MsgTypeAudio, matrixapi.MsgTypeVideo:
+		// The body is the caption of the file if the file has its own filename, else the filename.
+		caption := ""
+		if content.Filename != "" && content.Filename != body {
+			caption = strings.TrimSpace(body)
+		}
+		if rest, ok := cutPrefix(caption, memoPrefix); ok {
+			caption = rest
+		} else if !r.isDirect(ctx, client, roomID) {
+			return
+		}
+		if r.allowMessage(roomID, event.Sender) {
+			r.saveMessage(ctx, client, roomID, event.Sender, caption, content)
+		}
+	default:
+	}
+}
+
+// allowMessage reports whether a message is within the rate limits, and logs the dropped ones.
+func (r *Runner) allowMessage(roomID, sender string) bool {
+	if r.allow(roomID, sender) {
+		return true
+	}
+	slog.Warn("dropped rate limited Matrix message", "room", roomID, "sender", sender)
+	return false
+}
+
+// handleCommand handles the "!memos" commands: link, subscribe, unsubscribe and help.
+func (r *Runner) handleCommand(ctx context.Context, client *matrixapi.Client, roomID, sender, args string) {
+	command, args, _ := strings.Cut(args, " ")
+	switch strings.ToLower(command) {
+	case "link":
+		reply, err := r.linkAccount(ctx, sender, args)
+		if err != nil {
+			slog.Error("failed to link Matrix account", "sender", sender, "error", err)
+			reply = "Failed to link your account, please try again later."
+		}
+		r.reply(ctx, client, roomID, reply)
+	case "subscribe", "unsubscribe":
+		reply, err := r.subscribe(ctx, roomID, sender, strings.EqualFold(command, "subscribe"))
+		if err != nil {
+			slog.Error("failed to update Matrix subscription", "sender", sender, "error", err)
+			reply = "Failed to update your subscription, please try again later."
+		}
+		r.reply(ctx, client, roomID, reply)
+	default:
+		r.reply(ctx, client, roomID, usage)
+	}
+}
+
+// saveMessage creates a memo from a message, with its file if any, as the linked user of the
+// sender, and replies with a confirmation.
+func (r *Runner) saveMessage(ctx context.Context, client *matrixapi.Client, roomID, sender, text string, file *matrixapi.MessageContent) {
+	user, err := r.findLinkedUser(ctx, sender)
+	if err != nil {
+		slog.Error("failed to find linked user", "sender", sender, "error", err)
+		return
+	}
+	if user == nil {
+		r.reply(ctx, client, roomID, r.linkInstructions())
+		return
+	}
+	ctx = context.WithValue(ctx, auth.UserIDContextKey, user.ID)
+
+	var attachments []*v1pb.Attachment
+	if file != nil {
+		attachment, err := r.uploadFile(ctx, client, file)
+		if err != nil {
+			slog.Warn("failed to save Matrix file", "sender", sender, "error", err)
+			r.reply(ctx, client, roomID, "Failed to save the file: "+err.Error())
+			return
+		}
+		attachments = append(attachments, attachment)
+	}
+	memo, err := r.createMemo(ctx, text, attachments)
+	if err != nil {
+		slog.Warn("failed to create memo from Matrix message", "user", user.ID, "error", err)
+		r.reply(ctx, client, roomID, "Failed to save the memo: "+err.Error())
+		return
+	}
+	r.reply(ctx, client, roomID, r.confirmation(memo.Name))
+}
+
+// uploadFile downloads the file of a message and uploads it as an attachment.
+func (r *Runner) uploadFile(ctx context.Context, client *matrixapi.Client, file *matrixapi.MessageContent) (*v1pb.Attachment, error) {
+	if file.URL == "" {
+		return nil, errors.New("encrypted files aren't supported")
+	}
+	data, err := client.DownloadMedia(ctx, file.URL, maxFileSize)
+	if err != nil {
+		return nil, err
+	}
+	filename := file.Filename
+	if filename == "" {
+		filename = file.Body
+	}
+	contentType := ""
+	if file.Info != nil {
+		contentType = file.Info.MimeType
+	}
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	return r.api.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: filename,
+			Type:     contentType,
+			Content:  data,
+		},
+	})
+}
+
+// createMemo creates a private memo, tagged #matrix, as the current user of the context. The
+// attachments are deleted if the memo can't be created.
+func (r *Runner) createMemo(ctx context.Context, content string, attachments []*v1pb.Attachment) (*v1pb.Memo, error) {
+	if content == "" && len(attachments) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty message")
+	}
+	memo := &v1pb.Memo{
+		Content:     strings.TrimSpace(content + "\n\n#" + matrixTag),
+		Visibility:  v1pb.Visibility_PRIVATE,
+		Attachments: attachments,
+	}
+	created, err := r.api.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: memo})
+	if err != nil {
+		for _, attachment := range attachments {
+			if _, err := r.api.DeleteAttachment(ctx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name}); err != nil {
+				slog.Warn("failed to delete Matrix attachment", "attachment", attachment.Name, "error", err)
+			}
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// linkAccount links a Matrix account to the user whose pending link code is code, and returns the
+// reply to the command. A Matrix account is linked to one user at most, so it's unlinked from the
+// others.
+func (r *Runner) linkAccount(ctx context.Context, matrixUserID, code string) (string, error) {
+	settings, err := r.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_MATRIX})
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	var linked *storepb.UserSetting
+	for _, setting := range settings {
+		if auth.VerifyLinkCode(setting.GetMatrix().GetLinkCodeHash(), setting.GetMatrix().GetLinkCodeExpireTime(), strings.TrimSpace(code), now) {
+			linked = setting
+			break
+		}
+	}
+	if linked == nil {
+		return "That link code is invalid or expired. " + r.linkInstructions(), nil
+	}
+
+	for _, setting := range settings {
+		matrix := setting.GetMatrix()
+		if setting.UserId != linked.UserId && matrix.GetMatrixUserId() == matrixUserID {
+			if err := r.store.UpsertUserMatrix(ctx, setting.UserId, &storepb.MatrixUserSetting{}); err != nil {
+				return "", errors.Wrap(err, "failed to unlink Matrix account")
+			}
+		}
+	}
+	matrix := &storepb.MatrixUserSetting{
+		MatrixUserId: matrixUserID,
+		LinkTime:     timestamppb.New(now),
+	}
+	// The subscriptions are kept when the same account is linked again.
+	if linked.GetMatrix().GetMatrixUserId() == matrixUserID {
+		matrix.SubscribedRoomIds = linked.GetMatrix().GetSubscribedRoomIds()
+	}
+	if err := r.store.UpsertUserMatrix(ctx, linked.UserId, matrix); err != nil {
+		return "", errors.Wrap(err, "failed to link Matrix account")
+	}
+	user, err := r.store.GetUser(ctx, &store.FindUser{ID: &linked.UserId})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get linked user")
+	}
+	if user == nil {
+		return "", errors.New("linked user not found")
+	}
+	return "Your Matrix account is now linked to " + user.Username + " on memos. " + usage, nil
+}
+
+// subscribe subscribes the linked user of the sender to a room, or unsubscribes them.
+func (r *Runner) subscribe(ctx context.Context, roomID, sender string, subscribed bool) (string, error) {
+	user, err := r.findLinkedUser(ctx, sender)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return r.linkInstructions(), nil
+	}
+	matrix, err := r.store.GetUserMatrix(ctx, user.ID)
+	if err != nil {
+		return "", err
+	}
+	matrix.SubscribedRoomIds = slices.DeleteFunc(matrix.SubscribedRoomIds, func(id string) bool { return id == roomID })
+	if subscribed {
+		matrix.SubscribedRoomIds = append(matrix.SubscribedRoomIds, roomID)
+	}
+	if err := r.store.UpsertUserMatrix(ctx, user.ID, matrix); err != nil {
+		return "", err
+	}
+
+	if !subscribed {
+		return "Your public memos won't be posted to this room anymore.", nil
+	}
+	if !r.getRoom(ctx, roomID).PostPublicMemos {
+		return "Your new public memos will be posted to this room once an admin allows posting memos in it.", nil
+	}
+	return "Your new public memos will be posted to this room.", nil
+}
+
+// findLinkedUser returns the user a Matrix account is linked to, or nil if there's none. Archived
+// and suspended users aren't returned, as they can't create memos.
+func (r *Runner) findLinkedUser(ctx context.Context, matrixUserID string) (*store.User, error) {
+	settings, err := r.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_MATRIX})
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range settings {
+		if setting.GetMatrix().GetMatrixUserId() != matrixUserID {
+			continue
+		}
+		user, err := r.store.GetUser(ctx, &store.FindUser{ID: &setting.UserId})
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || user.RowStatus == store.Archived || user.SuspendedTs != 0 {
+			return nil, nil
+		}
+		return user, nil
+	}
+	return nil, nil
+}
+
+// postPublicMemos posts the public memos created within [from, to) to the rooms their creators
+// subscribed to, if posting is allowed in the rooms.
+func (r *Runner) postPublicMemos(ctx context.Context, client *matrixapi.Client, from, to time.Time) error {
+	matrixSetting, err := r.store.GetInstanceMatrixSetting(ctx)
+	if err != nil {
+		return err
+	}
+	settings, err := r.store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_MATRIX})
+	if err != nil {
+		return err
+	}
+	subscriptions := map[int32][]string{}
+	for _, setting := range settings {
+		if setting.GetMatrix().GetMatrixUserId() == "" {
+			continue
+		}
+		for _, roomID := range setting.GetMatrix().GetSubscribedRoomIds() {
+			if room := findRoom(matrixSetting.Rooms, roomID); room != nil && room.Enabled && room.PostPublicMemos {
+				subscriptions[setting.UserId] = append(subscriptions[setting.UserId], roomID)
+			}
+		}
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	rowStatus := store.Normal
+	memos, err := r.store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &rowStatus,
+		VisibilityList:  []store.Visibility{store.Public},
+		ExcludeComments: true,
+		CreatedTsRanges: []*store.MemoTsRange{{StartTs: from.Unix(), EndTs: to.Unix()}},
+		OrderByTimeAsc:  true,
+	})
+	if err != nil {
+		return err
+	}
+	for _, memo := range memos {
+		roomIDs := subscriptions[memo.CreatorID]
+		if len(roomIDs) == 0 {
+			continue
+		}
+		creator, err := r.store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+		if err != nil {
+			return err
+		}
+		if creator == nil {
+			continue
+		}
+		text := r.formatMemo(creator, memo)
+		for _, roomID := range roomIDs {
+			r.reply(ctx, client, roomID, text)
+		}
+	}
+	return nil
+}
+
+// formatMemo returns the message posting a memo, shortened, with a link to the memo if the
+// instance URL is set.
+func (r *Runner) formatMemo(creator *store.User, memo *store.Memo) string {
+	name := creator.Nickname
+	if name == "" {
+		name = creator.Username
+	}
+	content := []rune(strings.TrimSpace(memo.Content))
+	if len(content) > maxPostLength {
+		content = append(content[:maxPostLength], '…')
+	}
+	text := name + " posted a memo:\n\n" + string(content)
+	if instanceURL := strings.TrimSuffix(r.profile.InstanceURL, "/"); instanceURL != "" {
+		text += "\n\n" + instanceURL + "/memos/" + memo.UID
+	}
+	return text
+}
+
+// reply sends a notice to a room. Notices aren't answered by bots, the memos bot included.
+func (*Runner) reply(ctx context.Context, client *matrixapi.Client, roomID, text string) {
+	if err := client.SendMessage(ctx, roomID, &matrixapi.MessageContent{MsgType: matrixapi.MsgTypeNotice, Body: text}); err != nil {
+		slog.Warn("failed to send Matrix message", "room", roomID, "error", err)
+	}
+}
+
+const usage = "Send a message starting with \"!memo\" to save it to memos, or any message or file in a direct message with this bot. " +
+	"Use \"!memos subscribe\" to post your new public memos to a room, and \"!memos unsubscribe\" to stop."
+
+// linkInstructions returns the instructions for the Matrix users who aren't linked to a user.
+func (r *Runner) linkInstructions() string {
+	where := "memos"
+	if instanceURL := strings.TrimSuffix(r.profile.InstanceURL, "/"); instanceURL != "" {
+		where = instanceURL + "/setting"
+	}
+	return "Your Matrix account isn't linked to memos yet. Generate a link code in the account settings of " + where +
+		", then send \"!memos link <code>\" to this bot."
+}
+
+// confirmation returns the reply to a saved memo, with a link to the memo if the instance URL is
+// set.
+func (r *Runner) confirmation(memoName string) string {
+	instanceURL := strings.TrimSuffix(r.profile.InstanceURL, "/")
+	if instanceURL == "" {
+		return "Saved to memos."
+	}
+	return "Saved to memos: " + instanceURL + "/" + memoName
+}
+
+// cutPrefix returns the text after a prefix, e.g. "!memo", if the text starts with the prefix as
+// a word.
+func cutPrefix(text, prefix string) (string, bool) {
+	rest, ok := strings.CutPrefix(text, prefix)
+	if !ok || (rest != "" && !strings.ContainsAny(rest[:1], " \t\n")) {
+		return "", false
+	}
+	return strings.TrimSpace(rest), true
+}
+
+// stripReplyFallback removes the quote of the replied message from the body of a reply, i.e. the
+// lines starting with "> " up to the first empty line.
+func stripReplyFallback(body string) string {
+	lines := strings.Split(body, "\n")
+	index := 0
+	for index < len(lines) && strings.HasPrefix(lines[index], ">") {
+		index++
+	}
+	if index == 0 {
+		return body
+	}
+	if index < len(lines) && lines[index] == "" {
+		index++
+	}
+	return strings.Join(lines[index:], "\n")
+}
@@ -0,0 +1,278 @@
+package matrix
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/profile"
+	matrixapi "github.com/usememos/memos/plugin/matrix"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// syncTimeout is how long a sync waits for new events.
+	syncTimeout = 30 * time.Second
+	// retryInterval is how long the runner waits after a failed sync, and between the checks of
+	// the settings while the bot is disabled.
+	retryInterval = time.Minute
+	// postInterval is how often the new public memos are posted to the rooms.
+	postInterval = time.Minute
+)
+
+// Rate limits of the messages handled by the bot, so that a busy room or a chatty user can't
+// flood the instance with memos. The messages over the limits are dropped.
+var (
+	roomRateLimit   = rate.Every(6 * time.Second)
+	roomBurst       = 10
+	senderRateLimit = rate.Every(12 * time.Second)
+	senderBurst     = 5
+)
+
+// errDisabled is returned by RunOnce while the bot has no homeserver URL or access token.
+var errDisabled = errors.New("Matrix bot is disabled")
+
+// Runner runs the Matrix bot of the instance: it syncs the bot account, joins the rooms it's
+// invited to, creates memos from the messages of the linked users, and posts the new public memos
+// of the users subscribed to a room.
+//
+// Encrypted rooms aren't supported, as the bot has no end-to-end encryption: it replies that it
+// can't read their messages instead.
+type Runner struct {
+	profile *profile.Profile
+	store   *store.Store
+	api     *apiv1.APIV1Service
+
+	// The client of the bot account and the settings it was created with.
+	client        *matrixapi.Client
+	homeserverURL string
+	accessToken   string
+	userID        string
+
+	// memberCounts caches the number of members of the rooms, to tell direct messages apart.
+	memberCounts map[string]int
+	// encryptedRooms are the rooms the bot already replied it can't read.
+	encryptedRooms map[string]bool
+	lastPostTime   time.Time
+
+	roomLimiters   sync.Map
+	senderLimiters sync.Map
+}
+
+func NewRunner(profile *profile.Profile, store *store.Store, api *apiv1.APIV1Service) *Runner {
+	return &Runner{
+		profile:        profile,
+		store:          store,
+		api:            api,
+		memberCounts:   map[string]int{},
+		encryptedRooms: map[string]bool{},
+	}
+}
+
+// Run syncs the bot continuously, as syncs wait for new events.
+func (r *Runner) Run(ctx context.Context) {
+	for {
+		err := r.RunOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, errDisabled) {
+			slog.Error("failed to sync Matrix bot", "error", err)
+		}
+		select {
+		case <-time.After(retryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce syncs the bot once, and handles the new events. The next batch token is stored after
+// the events are handled, so that the sync resumes where it stopped after a restart.
+//
+// The first sync of a bot account only joins the rooms of the pending invites, without handling
+// the history of the rooms.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	matrixSetting, err := r.store.GetInstanceMatrixSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance Matrix setting")
+	}
+	client, err := r.getClient(ctx, matrixSetting)
+	if err != nil {
+		return err
+	}
+	state, err := r.store.GetInstanceMatrixSyncState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get Matrix sync state")
+	}
+	if state.HomeserverUrl != r.homeserverURL || state.UserId != r.userID {
+		state = &storepb.InstanceMatrixSyncState{HomeserverUrl: r.homeserverURL, UserId: r.userID}
+	}
+
+	response, err := client.Sync(ctx, state.NextBatch, syncTimeout)
+	if err != nil {
+		return errors.Wrap(err, "failed to sync")
+	}
+	for _, roomID := range slices.Sorted(maps.Keys(response.Rooms.Invite)) {
+		r.joinRoom(ctx, client, roomID)
+	}
+	for _, roomID := range slices.Sorted(maps.Keys(response.Rooms.Leave)) {
+		r.leaveRoom(ctx, roomID)
+	}
+	if state.NextBatch != "" {
+		for _, roomID := range slices.Sorted(maps.Keys(response.Rooms.Join)) {
+			for _, event := range response.Rooms.Join[roomID].Timeline.Events {
+				r.handleEvent(ctx, client, roomID, event)
+			}
+		}
+	}
+	state.NextBatch = response.NextBatch
+
+	now := time.Now()
+	if state.PostTime == nil {
+		state.PostTime = timestamppb.New(now)
+	} else if now.Sub(r.lastPostTime) >= postInterval {
+		r.lastPostTime = now
+		if err := r.postPublicMemos(ctx, client, state.PostTime.AsTime(), now); err != nil {
+			slog.Error("failed to post public memos to Matrix", "error", err)
+		} else {
+			state.PostTime = timestamppb.New(now)
+		}
+	}
+
+	_, err = r.store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_MATRIX_SYNC,
+		Value: &storepb.InstanceSetting_MatrixSyncState{MatrixSyncState: state},
+	})
+	return errors.Wrap(err, "failed to store Matrix sync state")
+}
+
+// getClient returns the client of the bot account, created again when the settings change.
+func (r *Runner) getClient(ctx context.Context, matrixSetting *storepb.InstanceMatrixSetting) (*matrixapi.Client, error) {
+	if matrixSetting.HomeserverUrl == "" || matrixSetting.AccessToken == "" {
+		r.client = nil
+		return nil, errDisabled
+	}
+	if r.client != nil && matrixSetting.HomeserverUrl == r.homeserverURL && matrixSetting.AccessToken == r.accessToken {
+		return r.client, nil
+	}
+
+	client, err := matrixapi.NewClient(matrixSetting.HomeserverUrl, matrixSetting.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := client.Whoami(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the user of the access token")
+	}
+	r.client, r.homeserverURL, r.accessToken, r.userID = client, matrixSetting.HomeserverUrl, matrixSetting.AccessToken, userID
+	r.memberCounts, r.encryptedRooms = map[string]int{}, map[string]bool{}
+	return client, nil
+}
+
+// joinRoom joins the room of an invite, and adds it to the rooms of the settings, enabled.
+func (r *Runner) joinRoom(ctx context.Context, client *matrixapi.Client, roomID string) {
+	if err := client.JoinRoom(ctx, roomID); err != nil {
+		slog.Warn("failed to join Matrix room", "room", roomID, "error", err)
+		return
+	}
+	slog.Info("joined Matrix room", "room", roomID)
+	err := r.updateRooms(ctx, func(rooms []*storepb.InstanceMatrixSetting_Room) []*storepb.InstanceMatrixSetting_Room {
+		if findRoom(rooms, roomID) != nil {
+			return rooms
+		}
+		return append(rooms, &storepb.InstanceMatrixSetting_Room{RoomId: roomID, Enabled: true})
+	})
+	if err != nil {
+		slog.Error("failed to add Matrix room", "room", roomID, "error", err)
+	}
+}
+
+// leaveRoom removes a room the bot left, e.g. when it's kicked, from the rooms of the settings.
+func (r *Runner) leaveRoom(ctx context.Context, roomID string) {
+	delete(r.memberCounts, roomID)
+	err := r.updateRooms(ctx, func(rooms []*storepb.InstanceMatrixSetting_Room) []*storepb.InstanceMatrixSetting_Room {
+		return slices.DeleteFunc(rooms, func(room *storepb.InstanceMatrixSetting_Room) bool {
+			return room.RoomId == roomID
+		})
+	})
+	if err != nil {
+		slog.Error("failed to remove Matrix room", "room", roomID, "error", err)
+	}
+}
+
+// updateRooms updates the rooms of the settings, unless they're unchanged.
+func (r *Runner) updateRooms(ctx context.Context, update func([]*storepb.InstanceMatrixSetting_Room) []*storepb.InstanceMatrixSetting_Room) error {
+	matrixSetting, err := r.store.GetInstanceMatrixSetting(ctx)
+	if err != nil {
+		return err
+	}
+	count := len(matrixSetting.Rooms)
+	matrixSetting.Rooms = update(matrixSetting.Rooms)
+	if len(matrixSetting.Rooms) == count {
+		return nil
+	}
+	_, err = r.store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_MATRIX,
+		Value: &storepb.InstanceSetting_MatrixSetting{MatrixSetting: matrixSetting},
+	})
+	return err
+}
+
+// getRoom returns the settings of a room. The rooms missing from the settings, e.g. when the
+// settings were saved while the bot joined them, are enabled without posting.
+func (r *Runner) getRoom(ctx context.Context, roomID string) *storepb.InstanceMatrixSetting_Room {
+	matrixSetting, err := r.store.GetInstanceMatrixSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get instance Matrix setting", "error", err)
+		return &storepb.InstanceMatrixSetting_Room{RoomId: roomID}
+	}
+	if room := findRoom(matrixSetting.Rooms, roomID); room != nil {
+		return room
+	}
+	return &storepb.InstanceMatrixSetting_Room{RoomId: roomID, Enabled: true}
+}
+
+// isDirect reports whether a room is a direct message with the bot, where every message is
+// saved, not only those starting with "!memo".
+func (r *Runner) isDirect(ctx context.Context, client *matrixapi.Client, roomID string) bool {
+	count, ok := r.memberCounts[roomID]
+	if !ok {
+		var err error
+		count, err = client.JoinedMemberCount(ctx, roomID)
+		if err != nil {
+			slog.Warn("failed to get Matrix room members", "room", roomID, "error", err)
+			return false
+		}
+		r.memberCounts[roomID] = count
+	}
+	return count <= 2
+}
+
+// allow reports whether a message of a sender in a room is within the rate limits.
+func (r *Runner) allow(roomID, sender string) bool {
+	roomLimiter, _ := r.roomLimiters.LoadOrStore(roomID, rate.NewLimiter(roomRateLimit, roomBurst))
+	senderLimiter, _ := r.senderLimiters.LoadOrStore(sender, rate.NewLimiter(senderRateLimit, senderBurst))
+	return roomLimiter.(*rate.Limiter).Allow() && senderLimiter.(*rate.Limiter).Allow()
+}
+
+func findRoom(rooms []*storepb.InstanceMatrixSetting_Room, roomID string) *storepb.InstanceMatrixSetting_Room {
+	for _, room := range rooms {
+		if room.RoomId == roomID {
+			return room
+		}
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/markdown"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/router/linkpreview"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+const (
+	testAccessToken = "syt_test"
+	testBotUserID   = "@memos:example.com"
+	testDirectRoom  = "!direct:example.com"
+	testGroupRoom   = "!group:example.com"
+	testSender      = "@alice:example.com"
+)
+
+// fakeHomeserver is a fake Matrix homeserver, serving queued sync responses, and recording the
+// since tokens of the syncs and the messages it receives.
+type fakeHomeserver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	syncs    []map[string]any
+	since    []string
+	joined   []string
+	messages map[string][]string
+}
+
+func newFakeHomeserver(t *testing.T) *fakeHomeserver {
+	t.Helper()
+	fake := &fakeHomeserver{messages: map[string][]string{}}
+	authorized := func(handler http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errcode":"M_UNKNOWN_TOKEN","error":"Invalid access token"}`))
+				return
+			}
+			handler(w, r)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /_matrix/client/v3/account/whoami", authorized(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"user_id":"` + testBotUserID + `"}`))
+	}))
+	mux.HandleFunc("GET /_matrix/client/v3/sync", authorized(func(w http.ResponseWriter, r *http.Request) {
+		since := r.URL.Query().Get("since")
+		fake.mu.Lock()
+		fake.since = append(fake.since, since)
+		response := map[string]any{"next_batch": since}
+		if len(fake.syncs) > 0 {
+			response, fake.syncs = fake.syncs[0], fake.syncs[1:]
+		}
+		fake.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	mux.HandleFunc("POST /_matrix/client/v3/join/{room}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		fake.joined = append(fake.joined, r.PathValue("room"))
+		fake.mu.Unlock()
+		_, _ = w.Write([]byte(`{"room_id":"` + r.PathValue("room") + `"}`))
+	}))
+	mux.HandleFunc("GET /_matrix/client/v3/rooms/{room}/joined_members", authorized(func(w http.ResponseWriter, r *http.Request) {
+		members := `{"` + testBotUserID + `":{},"` + testSender + `":{}}`
+		if r.PathValue("room") == testGroupRoom {
+			members = `{"` + testBotUserID + `":{},"` + testSender + `":{},"@bob:example.com":{}}`
+		}
+		_, _ = w.Write([]byte(`{"joined":` + members + `}`))
+	}))
+	mux.HandleFunc("PUT /_matrix/client/v3/rooms/{room}/send/m.room.message/{txn}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		content := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&content)
+		fake.mu.Lock()
+		fake.messages[r.PathValue("room")] = append(fake.messages[r.PathValue("room")], content["body"])
+		fake.mu.Unlock()
+		_, _ = w.Write([]byte(`{"event_id":"$sent"}`))
+	}))
+	// Only the legacy media endpoint is supported, as on older homeservers.
+	mux.HandleFunc("GET /_matrix/media/v3/download/example.com/photo", authorized(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("png"))
+	}))
+	fake.Server = httptest.NewServer(mux)
+	t.Cleanup(fake.Close)
+	return fake
+}
+
+// queueSync queues a sync response with the invites and the timeline events of the rooms.
+func (f *fakeHomeserver) queueSync(nextBatch string, invites []string, events map[string][]map[string]any) {
+	rooms := map[string]any{}
+	if len(invites) > 0 {
+		invite := map[string]any{}
+		for _, roomID := range invites {
+			invite[roomID] = map[string]any{}
+		}
+		rooms["invite"] = invite
+	}
+	if len(events) > 0 {
+		join := map[string]any{}
+		for roomID, roomEvents := range events {
+			join[roomID] = map[string]any{"timeline": map[string]any{"events": roomEvents}}
+		}
+		rooms["join"] = join
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.syncs = append(f.syncs, map[string]any{"next_batch": nextBatch, "rooms": rooms})
+}
+
+func (f *fakeHomeserver) lastMessage(roomID string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	messages := f.messages[roomID]
+	if len(messages) == 0 {
+		return ""
+	}
+	return messages[len(messages)-1]
+}
+
+func (f *fakeHomeserver) lastSince() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.since[len(f.since)-1]
+}
+
+func textEvent(sender, body string) map[string]any {
+	return map[string]any{
+		"type":     "m.room.message",
+		"event_id": "$" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		"sender":   sender,
+		"content":  map[string]any{"msgtype": "m.text", "body": body},
+	}
+}
+
+type testRunner struct {
+	*Runner
+	fake    *fakeHomeserver
+	user    *store.User
+	userCtx context.Context
+}
+
+func newTestRunner(t *testing.T) *testRunner {
+	t.Helper()
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() { stores.Close() })
+
+	testProfile := &profile.Profile{Mode: "dev", Version: "test-1.0.0", Driver: "sqlite", InstanceURL: "https://memos.example.com"}
+	api := &apiv1.APIV1Service{
+		Secret:             "test-secret",
+		Profile:            testProfile,
+		Store:              stores,
+		MarkdownService:    markdown.NewService(markdown.WithTagExtension()),
+		LinkPreviewService: linkpreview.NewService(stores, "test-secret", linkpreview.DefaultConfig()),
+	}
+	fake := newFakeHomeserver(t)
+	_, err := stores.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_MATRIX,
+		Value: &storepb.InstanceSetting_MatrixSetting{MatrixSetting: &storepb.InstanceMatrixSetting{HomeserverUrl: fake.URL, AccessToken: testAccessToken}},
+	})
+	require.NoError(t, err)
+	user, err := stores.CreateUser(ctx, &store.User{Username: "user", Role: store.RoleUser, Email: "user@example.com"})
+	require.NoError(t, err)
+
+	return &testRunner{
+		Runner:  NewRunner(testProfile, stores, api),
+		fake:    fake,
+		user:    user,
+		userCtx: context.WithValue(ctx, auth.UserIDContextKey, user.ID),
+	}
+}
+
+// sync runs a sync of the bot with the timeline events of the rooms.
+func (tr *testRunner) sync(t *testing.T, nextBatch string, events map[string][]map[string]any) {
+	t.Helper()
+	tr.fake.queueSync(nextBatch, nil, events)
+	require.NoError(t, tr.RunOnce(context.Background()))
+}
+
+// link joins the test rooms, and links the sender to the test user.
+func (tr *testRunner) link(t *testing.T) {
+	t.Helper()
+	tr.fake.queueSync("b1", []string{testDirectRoom, testGroupRoom}, nil)
+	require.NoError(t, tr.RunOnce(context.Background()))
+
+	matrixLink, err := tr.api.GenerateUserMatrixLinkCode(tr.userCtx, &v1pb.GenerateUserMatrixLinkCodeRequest{Name: "users/" + strconv.Itoa(int(tr.user.ID)) + "/matrixLink"})
+	require.NoError(t, err)
+	tr.sync(t, "b2", map[string][]map[string]any{testDirectRoom: {textEvent(testSender, "!memos link "+strings.ToLower(matrixLink.LinkCode))}})
+	require.Contains(t, tr.fake.lastMessage(testDirectRoom), "now linked to user")
+}
+
+func (tr *testRunner) listMemos(t *testing.T) []*store.Memo {
+	t.Helper()
+	memos, err := tr.store.ListMemos(context.Background(), &store.FindMemo{CreatorID: &tr.user.ID, OrderByTimeAsc: true})
+	require.NoError(t, err)
+	return memos
+}
+
+func TestJoinAndLink(t *testing.T) {
+	tr := newTestRunner(t)
+	ctx := context.Background()
+
+	// The messages of the unlinked users get instructions.
+	tr.fake.queueSync("b1", []string{testDirectRoom}, nil)
+	require.NoError(t, tr.RunOnce(ctx))
+	tr.sync(t, "b2", map[string][]map[string]any{testDirectRoom: {textEvent(testSender, "Hello")}})
+	require.Contains(t, tr.fake.lastMessage(testDirectRoom), "isn't linked to memos yet")
+	require.Empty(t, tr.listMemos(t))
+	tr.sync(t, "b3", map[string][]map[string]any{testDirectRoom: {textEvent(testSender, "!memos link WRONGCODE")}})
+	require.Contains(t, tr.fake.lastMessage(testDirectRoom), "invalid or expired")
+
+	tr.link(t)
+	require.Equal(t, []string{testDirectRoom, testDirectRoom, testGroupRoom}, tr.fake.joined)
+	matrixSetting, err := tr.store.GetInstanceMatrixSetting(ctx)
+	require.NoError(t, err)
+	require.Len(t, matrixSetting.Rooms, 2)
+	require.True(t, matrixSetting.Rooms[0].Enabled)
+	matrix, err := tr.store.GetUserMatrix(ctx, tr.user.ID)
+	require.NoError(t, err)
+	require.Equal(t, testSender, matrix.MatrixUserId)
+	// The code is single-use.
+	require.Empty(t, matrix.LinkCodeHash)
+}
+
+func TestSaveMessages(t *testing.T) {
+	tr := newTestRunner(t)
+	tr.link(t)
+
+	image := map[string]any{
+		"type":     "m.room.message",
+		"event_id": "$image",
+		"sender":   testSender,
+		"content": map[string]any{
+			"msgtype": "m.image", "body": "Whiteboard", "filename": "photo.png",
+			"url": "mxc://example.com/photo", "info": map[string]any{"mimetype": "image/png"},
+		},
+	}
+	tr.sync(t, "b3", map[string][]map[string]any{
+		// Every message is saved in direct messages, and those starting with "!memo" in rooms.
+		testDirectRoom: {textEvent(testSender, "Hello #work"), image},
+		testGroupRoom:  {textEvent(testSender, "Chatter"), textEvent(testSender, "!memo From the room"), textEvent(testBotUserID, "!memo Bot")},
+	})
+	memos := tr.listMemos(t)
+	require.Len(t, memos, 3)
+	contents := []string{memos[0].Content, memos[1].Content, memos[2].Content}
+	require.ElementsMatch(t, []string{"Hello #work\n\n#matrix", "Whiteboard\n\n#matrix", "From the room\n\n#matrix"}, contents)
+	require.Equal(t, store.Private, memos[0].Visibility)
+	for _, memo := range memos {
+		if memo.Content == "From the room\n\n#matrix" {
+			require.Equal(t, "Saved to memos: https://memos.example.com/memos/"+memo.UID, tr.fake.lastMessage(testGroupRoom))
+		}
+		if memo.Content != "Whiteboard\n\n#matrix" {
+			continue
+		}
+		attachments, err := tr.store.ListAttachments(context.Background(), &store.FindAttachment{MemoID: &memo.ID, GetBlob: true})
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		require.Equal(t, "photo.png", attachments[0].Filename)
+		require.Equal(t, "png", string(attachments[0].Blob))
+	}
+
+	// The messages of the disabled rooms are ignored.
+	matrixSetting, err := tr.store.GetInstanceMatrixSetting(context.Background())
+	require.NoError(t, err)
+	findRoom(matrixSetting.Rooms, testGroupRoom).Enabled = false
+	_, err = tr.store.UpsertInstanceSetting(context.Background(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_MATRIX,
+		Value: &storepb.InstanceSetting_MatrixSetting{MatrixSetting: matrixSetting},
+	})
+	require.NoError(t, err)
+	tr.sync(t, "b4", map[string][]map[string]any{testGroupRoom: {textEvent(testSender, "!memo Ignored")}})
+	require.Len(t, tr.listMemos(t), 3)
+}
+
+func TestRateLimit(t *testing.T) {
+	tr := newTestRunner(t)
+	tr.link(t)
+
+	events := []map[string]any{}
+	for i := 0; i < 20; i++ {
+		events = append(events, textEvent(testSender, "Message "+strconv.Itoa(i)))
+	}
+	tr.sync(t, "b3", map[string][]map[string]any{testDirectRoom: events})
+	// The link command used one message of the sender burst.
+	require.Len(t, tr.listMemos(t), senderBurst-1)
+}
+
+func TestResumeSync(t *testing.T) {
+	tr := newTestRunner(t)
+	tr.link(t)
+	require.Equal(t, "b1", tr.fake.lastSince())
+
+	// A restarted bot resumes from the stored next batch token.
+	restarted := NewRunner(tr.profile, tr.store, tr.api)
+	require.NoError(t, restarted.RunOnce(context.Background()))
+	require.Equal(t, "b2", tr.fake.lastSince())
+
+	// The state is reset when the bot account changes.
+	_, err := tr.store.UpsertInstanceSetting(context.Background(), &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_MATRIX_SYNC,
+		Value: &storepb.InstanceSetting_MatrixSyncState{MatrixSyncState: &storepb.InstanceMatrixSyncState{
+			HomeserverUrl: tr.fake.URL, UserId: "@other:example.com", NextBatch: "b2",
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, NewRunner(tr.profile, tr.store, tr.api).RunOnce(context.Background()))
+	require.Equal(t, "", tr.fake.lastSince())
+}
+
+func TestPostPublicMemos(t *testing.T) {
+	tr := newTestRunner(t)
+	tr.link(t)
+	ctx := context.Background()
+
+	tr.sync(t, "b3", map[string][]map[string]any{testGroupRoom: {textEvent(testSender, "!memos subscribe")}})
+	require.Contains(t, tr.fake.lastMessage(testGroupRoom), "once an admin allows posting")
+	matrixSetting, err := tr.store.GetInstanceMatrixSetting(ctx)
+	require.NoError(t, err)
+	findRoom(matrixSetting.Rooms, testGroupRoom).PostPublicMemos = true
+	_, err = tr.store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_MATRIX,
+		Value: &storepb.InstanceSetting_MatrixSetting{MatrixSetting: matrixSetting},
+	})
+	require.NoError(t, err)
+
+	from := time.Now().Add(-time.Minute)
+	_, err = tr.api.CreateMemo(tr.userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Shipped the release", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	_, err = tr.api.CreateMemo(tr.userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Secret", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	require.NoError(t, tr.postPublicMemos(ctx, tr.client, from, time.Now().Add(time.Minute)))
+	require.True(t, strings.HasPrefix(tr.fake.lastMessage(testGroupRoom), "user posted a memo:\n\nShipped the release\n\nhttps://memos.example.com/memos/"))
+}
+
+func TestCutPrefix(t *testing.T) {
+	rest, ok := cutPrefix("!memo  Hello", memoPrefix)
+	require.True(t, ok)
+	require.Equal(t, "Hello", rest)
+	_, ok = cutPrefix("!memos link ABC", memoPrefix)
+	require.False(t, ok)
+	rest, ok = cutPrefix("!memos", commandPrefix)
+	require.True(t, ok)
+	require.Empty(t, rest)
+}
+
+func TestStripReplyFallback(t *testing.T) {
+	require.Equal(t, "!memo Good point", stripReplyFallback("> <@bob:example.com> The plan\n> is ready\n\n!memo Good point"))
+	require.Equal(t, "No quote", stripReplyFallback("No quote"))
+}
@@ -0,0 +1,73 @@
+package memoautoarchive
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Archive archives the memos of a user matching the auto-archive policy of the user, returning
+	// the number of memos archived.
+	Archive func(ctx context.Context, userID int32, setting *storepb.AutoArchiveUserSetting) (int, error)
+}
+
+func NewRunner(store *store.Store, archive func(ctx context.Context, userID int32, setting *storepb.AutoArchiveUserSetting) (int, error)) *Runner {
+	return &Runner{
+		Store:   store,
+		Archive: archive,
+	}
+}
+
+// Schedule runner every day.
+const runnerInterval = 24 * time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce applies the auto-archive policy of every user who enabled one.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("memoautoarchive")
+	defer run.End()
+
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_AUTO_ARCHIVE})
+	if err != nil {
+		slog.Error("failed to list auto-archive user settings", "error", err)
+		run.Fail()
+		return
+	}
+
+	archived := 0
+	for _, userSetting := range userSettings {
+		setting := userSetting.GetAutoArchive()
+		if setting.GetDays() == 0 {
+			continue
+		}
+		count, err := r.Archive(ctx, userSetting.UserId, setting)
+		if err != nil {
+			slog.Error("failed to auto-archive memos", "user", userSetting.UserId, "error", err)
+			run.Fail()
+			continue
+		}
+		archived += count
+	}
+	if archived > 0 {
+		slog.Info("auto-archived memos", "count", archived)
+	}
+}
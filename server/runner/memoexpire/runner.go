@@ -0,0 +1,68 @@
+package memoexpire
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Expire moves a memo past its expiry to the trash and dispatches its webhook.
+	Expire func(ctx context.Context, memo *store.Memo) error
+}
+
+func NewRunner(store *store.Store, expire func(ctx context.Context, memo *store.Memo) error) *Runner {
+	return &Runner{
+		Store:  store,
+		Expire: expire,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce moves the memos whose expiry has passed to the trash, including the ones that expired
+// while the server was down. Expired memos are already excluded from reads until then.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("memoexpire")
+	defer run.End()
+
+	nowSec := time.Now().Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{IncludeExpired: true, ExpireBefore: &nowSec, IncludeScheduled: true, ExcludeContent: true})
+	if err != nil {
+		slog.Error("failed to list expired memos", "error", err)
+		run.Fail()
+		return
+	}
+
+	expired := 0
+	for _, memo := range memos {
+		if err := r.Expire(ctx, memo); err != nil {
+			slog.Error("failed to expire memo", "memo", memo.UID, "error", err)
+			run.Fail()
+			continue
+		}
+		expired++
+	}
+	if expired > 0 {
+		slog.Info("expired memos", "count", expired)
+	}
+}
@@ -0,0 +1,68 @@
+package memopublish
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Publish publishes a scheduled memo and dispatches its memo created webhook.
+	Publish func(ctx context.Context, memo *store.Memo) error
+}
+
+func NewRunner(store *store.Store, publish func(ctx context.Context, memo *store.Memo) error) *Runner {
+	return &Runner{
+		Store:   store,
+		Publish: publish,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce publishes the scheduled memos whose publish time has passed, including the ones that
+// were due while the server was down.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("memopublish")
+	defer run.End()
+
+	nowSec := time.Now().Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{Scheduled: true, PublishBefore: &nowSec})
+	if err != nil {
+		slog.Error("failed to list due scheduled memos", "error", err)
+		run.Fail()
+		return
+	}
+
+	published := 0
+	for _, memo := range memos {
+		if err := r.Publish(ctx, memo); err != nil {
+			slog.Error("failed to publish scheduled memo", "memo", memo.UID, "error", err)
+			run.Fail()
+			continue
+		}
+		published++
+	}
+	if published > 0 {
+		slog.Info("published scheduled memos", "count", published)
+	}
+}
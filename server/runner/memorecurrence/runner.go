@@ -0,0 +1,70 @@
+package memorecurrence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// CreateMemo creates the memo of the due occurrence of a memo recurrence and moves it to its
+	// next occurrence.
+	CreateMemo func(ctx context.Context, memoRecurrence *store.MemoRecurrence) error
+}
+
+func NewRunner(store *store.Store, createMemo func(ctx context.Context, memoRecurrence *store.MemoRecurrence) error) *Runner {
+	return &Runner{
+		Store:      store,
+		CreateMemo: createMemo,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce creates the memos of the memo recurrences that are due. A recurrence that missed several
+// occurrences while the server was down creates a single catch-up memo. Recurrences are moved to
+// their next occurrence, so running it again doesn't create the memos twice.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("memorecurrence")
+	defer run.End()
+
+	nowSec := time.Now().Unix()
+	memoRecurrences, err := r.Store.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{DueBefore: &nowSec})
+	if err != nil {
+		slog.Error("failed to list due memo recurrences", "error", err)
+		run.Fail()
+		return
+	}
+
+	created := 0
+	for _, memoRecurrence := range memoRecurrences {
+		if err := r.CreateMemo(ctx, memoRecurrence); err != nil {
+			slog.Error("failed to create recurring memo", "recurrence", memoRecurrence.ID, "error", err)
+			run.Fail()
+			continue
+		}
+		created++
+	}
+	if created > 0 {
+		slog.Info("created recurring memos", "count", created)
+	}
+}
@@ -0,0 +1,69 @@
+package memoreminder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Deliver delivers the due reminder of a memo, notifying its creator and dispatching its webhook.
+	Deliver func(ctx context.Context, memo *store.Memo) error
+}
+
+func NewRunner(store *store.Store, deliver func(ctx context.Context, memo *store.Memo) error) *Runner {
+	return &Runner{
+		Store:   store,
+		Deliver: deliver,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce delivers the memo reminders that are due, including the ones that were due while the
+// server was down. Delivered reminders are marked as such, so running it again doesn't deliver
+// them twice.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("memoreminder")
+	defer run.End()
+
+	nowSec := time.Now().Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{ReminderDueBefore: &nowSec})
+	if err != nil {
+		slog.Error("failed to list memos with due reminders", "error", err)
+		run.Fail()
+		return
+	}
+
+	delivered := 0
+	for _, memo := range memos {
+		if err := r.Deliver(ctx, memo); err != nil {
+			slog.Error("failed to deliver memo reminder", "memo", memo.UID, "error", err)
+			run.Fail()
+			continue
+		}
+		delivered++
+	}
+	if delivered > 0 {
+		slog.Info("delivered memo reminders", "count", delivered)
+	}
+}
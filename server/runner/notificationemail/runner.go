@@ -0,0 +1,90 @@
+package notificationemail
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Deliver sends the pending notification emails of a user. On its last attempt, the
+	// notifications of an email that fails are skipped rather than kept for a retry.
+	Deliver func(ctx context.Context, userID int32, lastAttempt bool) error
+
+	mutex sync.Mutex
+	// attempts counts the failed attempts of the users whose emails fail to send.
+	attempts map[int32]int
+}
+
+func NewRunner(store *store.Store, deliver func(ctx context.Context, userID int32, lastAttempt bool) error) *Runner {
+	return &Runner{
+		Store:    store,
+		Deliver:  deliver,
+		attempts: map[int32]int{},
+	}
+}
+
+const (
+	// Schedule runner every minute.
+	runnerInterval = time.Minute
+	// maxAttempts is how many times an email is attempted before its notifications are skipped.
+	maxAttempts = 3
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce sends the pending notification emails of the users who turned them on.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("notificationemail")
+	defer run.End()
+
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_NOTIFICATION})
+	if err != nil {
+		slog.Error("failed to list notification settings", "error", err)
+		run.Fail()
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, userSetting := range userSettings {
+		setting := userSetting.GetNotification()
+		if !setting.GetEmailMemoComment() && !setting.GetEmailMemoReaction() && !setting.GetEmailMemoMention() && !setting.GetEmailMemoReminder() {
+			continue
+		}
+		userID := userSetting.UserId
+		lastAttempt := r.attempts[userID]+1 >= maxAttempts
+		if err := r.Deliver(ctx, userID, lastAttempt); err != nil {
+			slog.Error("failed to send notification email", "user", userID, "error", err)
+			run.Fail()
+			if lastAttempt {
+				delete(r.attempts, userID)
+			} else {
+				r.attempts[userID]++
+			}
+		} else {
+			delete(r.attempts, userID)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
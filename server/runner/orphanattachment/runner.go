@@ -0,0 +1,75 @@
+package orphanattachment
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+const (
+	// Schedule runner every hour.
+	runnerInterval = time.Hour
+	// pendingAttachmentMaxAge is how long the direct and chunked uploads are kept pending before
+	// they're purged as abandoned. Chunked uploads can be resumed for as long.
+	pendingAttachmentMaxAge = 48 * time.Hour
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce purges the attachments that have been without a memo longer than the orphaned
+// attachment grace period of the instance storage setting, and the abandoned direct uploads.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("orphanattachment")
+	defer run.End()
+
+	pendingCutoffSec := time.Now().Add(-pendingAttachmentMaxAge).Unix()
+	pendingCount, err := r.Store.PurgePendingAttachments(ctx, pendingCutoffSec)
+	if err != nil {
+		slog.Error("failed to purge pending attachments", "error", err)
+		run.Fail()
+	}
+	if pendingCount > 0 {
+		slog.Info("purged abandoned attachment uploads", "count", pendingCount)
+	}
+
+	instanceStorageSetting, err := r.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get instance storage setting", "error", err)
+		run.Fail()
+		return
+	}
+	cutoffSec := time.Now().AddDate(0, 0, -int(instanceStorageSetting.OrphanedAttachmentGraceDays)).Unix()
+	result, err := r.Store.PurgeOrphanedAttachments(ctx, cutoffSec)
+	if err != nil {
+		slog.Error("failed to purge orphaned attachments", "error", err)
+		run.Fail()
+	}
+	if result != nil && result.PurgedCount > 0 {
+		slog.Info("purged orphaned attachments", "count", result.PurgedCount, "reclaimedBytes", result.ReclaimedBytes)
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/internal/metrics"
 	"github.com/usememos/memos/plugin/storage/s3"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -40,15 +42,24 @@ func (r *Runner) Run(ctx context.Context) {
 }
 
 func (r *Runner) RunOnce(ctx context.Context) {
-	r.CheckAndPresign(ctx)
+	run := metrics.StartRunnerRun("s3presign")
+	defer run.End()
+
+	if err := r.CheckAndPresign(ctx); err != nil {
+		run.Fail()
+	}
 }
 
-func (r *Runner) CheckAndPresign(ctx context.Context) {
+// CheckAndPresign presigns the URLs of the S3 attachments whose URLs expire soon. The attachments
+// that can't be presigned are logged and skipped, and the last error is returned.
+func (r *Runner) CheckAndPresign(ctx context.Context) error {
 	instanceStorageSetting, err := r.Store.GetInstanceStorageSetting(ctx)
 	if err != nil {
-		return
+		return err
 	}
 
+	var lastErr error
+
 	s3StorageType := storepb.AttachmentStorageType_S3
 	// Limit attachments to a reasonable batch size
 	const batchSize = 100
@@ -64,7 +75,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 		})
 		if err != nil {
 			slog.Error("Failed to list attachments for presigning", "error", err)
-			return
+			return err
 		}
 
 		// Break if no more attachments
@@ -94,18 +105,21 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 			}
 			if s3Config == nil {
 				slog.Error("S3 config is not found")
+				lastErr = errors.New("S3 config is not found")
 				continue
 			}
 
 			s3Client, err := s3.NewClient(ctx, s3Config)
 			if err != nil {
 				slog.Error("Failed to create S3 client", "error", err)
+				lastErr = err
 				continue
 			}
 
 			presignURL, err := s3Client.PresignGetObject(ctx, s3ObjectPayload.Key)
 			if err != nil {
 				slog.Error("Failed to presign URL", "error", err, "attachmentID", attachment.ID)
+				lastErr = err
 				continue
 			}
 
@@ -121,6 +135,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 				},
 			}); err != nil {
 				slog.Error("Failed to update attachment", "error", err, "attachmentID", attachment.ID)
+				lastErr = err
 				continue
 			}
 			presignCount++
@@ -131,4 +146,5 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 		// Move to next batch
 		offset += len(attachments)
 	}
+	return lastErr
 }
@@ -0,0 +1,92 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("session")
+	defer run.End()
+
+	if err := r.DeleteExpiredSessions(ctx, time.Now()); err != nil {
+		run.Fail()
+	}
+}
+
+// DeleteExpiredSessions removes the sessions of all users that are expired at now under the
+// session policy, together with their refresh tokens. The sessions that can't be deleted are
+// logged and skipped, and the last error is returned.
+func (r *Runner) DeleteExpiredSessions(ctx context.Context, now time.Time) error {
+	policy, err := auth.GetSessionPolicy(ctx, r.Store)
+	if err != nil {
+		slog.Error("failed to get session policy", "error", err)
+		return err
+	}
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_SESSIONS,
+	})
+	if err != nil {
+		slog.Error("failed to list sessions", "error", err)
+		return err
+	}
+
+	var lastErr error
+	deleted := 0
+	for _, userSetting := range userSettings {
+		for _, session := range userSetting.GetSessions().GetSessions() {
+			if !policy.IsExpired(session, now) {
+				continue
+			}
+			sessionID := session.SessionId
+			if err := r.Store.DeleteRefreshTokens(ctx, &store.DeleteRefreshToken{SessionID: &sessionID}); err != nil {
+				slog.Error("failed to delete refresh tokens of expired session", "user", userSetting.UserId, "error", err)
+				lastErr = err
+				continue
+			}
+			if err := r.Store.RemoveUserSession(ctx, userSetting.UserId, sessionID); err != nil {
+				slog.Error("failed to delete expired session", "user", userSetting.UserId, "error", err)
+				lastErr = err
+				continue
+			}
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		slog.Info("deleted expired sessions", "count", deleted)
+	}
+	return lastErr
+}
@@ -0,0 +1,74 @@
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Purge permanently deletes a memo with its attachments, relations, reactions and comments.
+	Purge func(ctx context.Context, memo *store.Memo) error
+}
+
+func NewRunner(store *store.Store, purge func(ctx context.Context, memo *store.Memo) error) *Runner {
+	return &Runner{
+		Store: store,
+		Purge: purge,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce purges the memos that have been in the trash longer than the trash retention of the
+// instance memo related setting.
+func (r *Runner) RunOnce(ctx context.Context) {
+	run := metrics.StartRunnerRun("trash")
+	defer run.End()
+
+	instanceMemoRelatedSetting, err := r.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get instance memo related setting", "error", err)
+		run.Fail()
+		return
+	}
+	cutoffSec := time.Now().AddDate(0, 0, -int(instanceMemoRelatedSetting.TrashRetentionDays)).Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{Trashed: true, DeletedBefore: &cutoffSec, IncludeScheduled: true})
+	if err != nil {
+		slog.Error("failed to list expired trashed memos", "error", err)
+		run.Fail()
+		return
+	}
+
+	purged := 0
+	for _, memo := range memos {
+		if err := r.Purge(ctx, memo); err != nil {
+			slog.Error("failed to purge trashed memo", "memo", memo.UID, "error", err)
+			run.Fail()
+			continue
+		}
+		purged++
+	}
+	if purged > 0 {
+		slog.Info("purged trashed memos", "count", purged)
+	}
+}
@@ -0,0 +1,94 @@
+package webhookdelivery
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+	// Deliver claims a due webhook delivery and makes its next attempt.
+	Deliver func(ctx context.Context, delivery *store.WebhookDelivery) error
+}
+
+func NewRunner(store *store.Store, deliver func(ctx context.Context, delivery *store.WebhookDelivery) error) *Runner {
+	return &Runner{
+		Store:   store,
+		Deliver: deliver,
+	}
+}
+
+const (
+	// Schedule runner every minute.
+	runnerInterval = time.Minute
+	// pruneInterval is how often the deliveries past their retention are deleted.
+	pruneInterval = 24 * time.Hour
+	// retention is how long finished deliveries are kept in the delivery log of their webhook.
+	retention = 30 * 24 * time.Hour
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.deliver(ctx)
+		case <-pruneTicker.C:
+			r.prune(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce prunes the delivery log, and makes the attempts of the webhook deliveries that are due,
+// including the retries that were due and the attempts that were lost while the server was down.
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.prune(ctx)
+	r.deliver(ctx)
+}
+
+func (r *Runner) deliver(ctx context.Context) {
+	run := metrics.StartRunnerRun("webhookdelivery")
+	defer run.End()
+
+	nowSec := time.Now().Unix()
+	deliveries, err := r.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{DueBefore: &nowSec})
+	if err != nil {
+		slog.Error("failed to list due webhook deliveries", "error", err)
+		run.Fail()
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if err := r.Deliver(ctx, delivery); err != nil {
+			slog.Error("failed to deliver webhook", "delivery", delivery.ID, "error", err)
+			run.Fail()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+	if len(deliveries) > 0 {
+		slog.Info("attempted due webhook deliveries", "count", len(deliveries))
+	}
+}
+
+func (r *Runner) prune(ctx context.Context) {
+	run := metrics.StartRunnerRun("webhookdeliveryprune")
+	defer run.End()
+
+	createdBeforeSec := time.Now().Add(-retention).Unix()
+	if err := r.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{CreatedBefore: &createdBeforeSec}); err != nil {
+		slog.Error("failed to prune webhook deliveries", "error", err)
+		run.Fail()
+	}
+}
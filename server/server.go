@@ -20,13 +20,36 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/httpgetter"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/ratelimit"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/router/capture"
 	"github.com/usememos/memos/server/router/fileserver"
 	"github.com/usememos/memos/server/router/frontend"
-	"github.com/usememos/memos/server/router/linkpreview"
+	"github.com/usememos/memos/server/router/inboundemail"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/router/slack"
+	"github.com/usememos/memos/server/router/unsubscribe"
+	"github.com/usememos/memos/server/runner/accesstoken"
+	"github.com/usememos/memos/server/runner/activitydigest"
+	"github.com/usememos/memos/server/runner/auditlog"
+	"github.com/usememos/memos/server/runner/backup"
+	"github.com/usememos/memos/server/runner/externallink"
+	"github.com/usememos/memos/server/runner/loginattempt"
+	"github.com/usememos/memos/server/runner/matrix"
+	"github.com/usememos/memos/server/runner/memoautoarchive"
+	"github.com/usememos/memos/server/runner/memoexpire"
+	"github.com/usememos/memos/server/runner/memopublish"
+	"github.com/usememos/memos/server/runner/memorecurrence"
+	"github.com/usememos/memos/server/runner/memoreminder"
+	"github.com/usememos/memos/server/runner/notificationemail"
+	"github.com/usememos/memos/server/runner/orphanattachment"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/session"
+	"github.com/usememos/memos/server/runner/trash"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
 	"github.com/usememos/memos/store"
 )
 
@@ -37,6 +60,8 @@ type Server struct {
 
 	echoServer        *echo.Echo
 	grpcServer        *grpc.Server
+	metricsServer     *http.Server
+	apiV1Service      *apiv1.APIV1Service
 	runnerCancelFuncs []context.CancelFunc
 }
 
@@ -51,6 +76,7 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	echoServer.HideBanner = true
 	echoServer.HidePort = true
 	echoServer.Use(middleware.Recover())
+	echoServer.Use(newMetricsMiddleware())
 	s.echoServer = echoServer
 
 	instanceBasicSetting, err := s.getOrUpsertInstanceBasicSetting(ctx)
@@ -64,10 +90,30 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}
 	s.Secret = secret
 
-	// Register healthz endpoint.
+	// Route outbound fetches (link previews, webhooks) through the configured proxy
+	// and identify them with the instance.
+	if err := httpgetter.SetProxy(profile.OutboundProxy); err != nil {
+		return nil, errors.Wrap(err, "invalid outbound proxy")
+	}
+	userAgent := fmt.Sprintf("%s/%s", httpgetter.DefaultUserAgent, profile.Version)
+	if profile.InstanceURL != "" {
+		userAgent += fmt.Sprintf(" (+%s)", profile.InstanceURL)
+	}
+	httpgetter.SetUserAgent(userAgent)
+
+	// Register healthz endpoint for liveness, which doesn't touch the database.
 	echoServer.GET("/healthz", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Service ready.")
 	})
+	// Register readyz endpoint for readiness, which checks the database.
+	echoServer.GET("/readyz", func(c echo.Context) error {
+		if err := store.CheckReadiness(c.Request().Context()); err != nil {
+			return c.JSON(http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "reason": err.Error()})
+		}
+		return c.JSON(http.StatusOK, map[string]string{"status": "ready"})
+	})
+	// Register the Prometheus metrics endpoint, if it's enabled.
+	s.registerMetrics()
 
 	// Serve frontend static files.
 	frontend.NewFrontendService(profile, store).Serve(ctx, echoServer)
@@ -77,28 +123,46 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	// Log full stacktraces if we're in dev
 	logStacktraces := profile.IsDev()
 
+	// Share the API rate limits between the gRPC, Connect and HTTP requests.
+	rateLimitService := ratelimit.NewService(store, ratelimit.NewMemoryLimiter(ratelimit.DefaultIdleTTL))
+	echoServer.Use(newRateLimitMiddleware(rateLimitService, auth.NewAuthenticator(store, secret)))
+
 	grpcServer := grpc.NewServer(
 		// Override the maximum receiving message size to math.MaxInt32 for uploading large attachments.
 		grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.ChainUnaryInterceptor(
+			apiv1.MetricsUnaryInterceptor,
 			apiv1.NewLoggerInterceptor(logStacktraces).LoggerInterceptor,
 			newRecoveryInterceptor(logStacktraces),
 			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
+			apiv1.NewRateLimitUnaryInterceptor(rateLimitService),
 		))
 	s.grpcServer = grpcServer
 
-	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer, rateLimitService)
+	s.apiV1Service = apiV1Service
 
 	// Register HTTP file server routes BEFORE gRPC-Gateway to ensure proper range request handling for Safari.
 	// This uses native HTTP serving (http.ServeContent) instead of gRPC for video/audio files.
 	fileServerService := fileserver.NewFileServerService(s.Profile, s.Store, s.Secret)
 	fileServerService.RegisterRoutes(echoServer)
 
-	// Register link preview endpoint (server-side OG fetcher to avoid CORS).
-	linkpreview.NewService(s.Store, s.Secret).RegisterRoutes(rootGroup)
+	// Register link preview endpoint (server-side OG fetcher to avoid CORS), sharing its rate limits
+	// with the checks of the external attachments.
+	apiV1Service.LinkPreviewService.RegisterRoutes(rootGroup)
 
 	// Create and register RSS routes (needs markdown service from apiV1Service).
 	rss.NewRSSService(s.Profile, s.Store, apiV1Service.MarkdownService).RegisterRoutes(rootGroup)
+
+	// Register the webhook of the mail provider, which turns inbound emails into memos.
+	inboundemail.NewService(s.Store, apiV1Service).RegisterRoutes(rootGroup)
+	// Register the unsubscribe links of the notification emails.
+	unsubscribe.NewService(s.Store, apiV1Service).RegisterRoutes(rootGroup)
+
+	// Register the request URLs of the Slack app.
+	slack.NewService(s.Profile, s.Store, apiV1Service).RegisterRoutes(rootGroup)
+	// Register the capture endpoint, which creates memos from the requests of automation tools.
+	capture.NewService(s.Profile, s.Store, apiV1Service).RegisterRoutes(rootGroup)
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -160,6 +224,13 @@ func (s *Server) Start(ctx context.Context) error {
 			slog.Error("mux server listen error", "error", err)
 		}
 	}()
+	if s.metricsServer != nil {
+		go func() {
+			if err := s.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("failed to serve metrics", "error", err)
+			}
+		}()
+	}
 	s.StartBackgroundRunners(ctx)
 
 	return nil
@@ -183,6 +254,13 @@ func (s *Server) Shutdown(ctx context.Context) {
 		slog.Error("failed to shutdown server", slog.String("error", err.Error()))
 	}
 
+	// Shutdown metrics server.
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			slog.Error("failed to shutdown metrics server", slog.String("error", err.Error()))
+		}
+	}
+
 	// Shutdown gRPC server.
 	s.grpcServer.GracefulStop()
 
@@ -212,6 +290,211 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 		slog.Info("s3presign runner stopped")
 	}()
 
+	accessTokenContext, accessTokenCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, accessTokenCancel)
+
+	// Create and start expired access token cleanup runner
+	accessTokenRunner := accesstoken.NewRunner(s.Store)
+	accessTokenRunner.RunOnce(ctx)
+
+	go func() {
+		accessTokenRunner.Run(accessTokenContext)
+		slog.Info("accesstoken runner stopped")
+	}()
+
+	loginAttemptContext, loginAttemptCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, loginAttemptCancel)
+
+	// Create and start stale login attempt cleanup runner
+	loginAttemptRunner := loginattempt.NewRunner(s.Store)
+	loginAttemptRunner.RunOnce(ctx)
+
+	go func() {
+		loginAttemptRunner.Run(loginAttemptContext)
+		slog.Info("loginattempt runner stopped")
+	}()
+
+	auditLogContext, auditLogCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, auditLogCancel)
+
+	// Create and start expired audit log cleanup runner
+	auditLogRunner := auditlog.NewRunner(s.Store)
+	auditLogRunner.RunOnce(ctx)
+
+	go func() {
+		auditLogRunner.Run(auditLogContext)
+		slog.Info("auditlog runner stopped")
+	}()
+
+	sessionContext, sessionCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, sessionCancel)
+
+	// Create and start expired session cleanup runner
+	sessionRunner := session.NewRunner(s.Store)
+	sessionRunner.RunOnce(ctx)
+
+	go func() {
+		sessionRunner.Run(sessionContext)
+		slog.Info("session runner stopped")
+	}()
+
+	trashContext, trashCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, trashCancel)
+
+	// Create and start trash purge runner
+	trashRunner := trash.NewRunner(s.Store, s.apiV1Service.DeleteMemoPermanently)
+	trashRunner.RunOnce(ctx)
+
+	go func() {
+		trashRunner.Run(trashContext)
+		slog.Info("trash runner stopped")
+	}()
+
+	orphanAttachmentContext, orphanAttachmentCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, orphanAttachmentCancel)
+
+	// Create and start orphaned attachment purge runner
+	orphanAttachmentRunner := orphanattachment.NewRunner(s.Store)
+	orphanAttachmentRunner.RunOnce(ctx)
+
+	go func() {
+		orphanAttachmentRunner.Run(orphanAttachmentContext)
+		slog.Info("orphanattachment runner stopped")
+	}()
+
+	externalLinkContext, externalLinkCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, externalLinkCancel)
+
+	// Create and start external attachment link check runner, in the background as the checks
+	// wait for the rate limits
+	externalLinkRunner := externallink.NewRunner(s.Store, s.apiV1Service.CheckExternalAttachment)
+
+	go func() {
+		externalLinkRunner.RunOnce(externalLinkContext)
+		externalLinkRunner.Run(externalLinkContext)
+		slog.Info("externallink runner stopped")
+	}()
+
+	memoPublishContext, memoPublishCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, memoPublishCancel)
+
+	// Create and start scheduled memo publish runner, publishing the memos due while the server was down first
+	memoPublishRunner := memopublish.NewRunner(s.Store, s.apiV1Service.PublishScheduledMemo)
+	memoPublishRunner.RunOnce(ctx)
+
+	go func() {
+		memoPublishRunner.Run(memoPublishContext)
+		slog.Info("memopublish runner stopped")
+	}()
+
+	memoReminderContext, memoReminderCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, memoReminderCancel)
+
+	// Create and start memo reminder runner, delivering the reminders due while the server was down first
+	memoReminderRunner := memoreminder.NewRunner(s.Store, s.apiV1Service.DeliverMemoReminder)
+	memoReminderRunner.RunOnce(ctx)
+
+	go func() {
+		memoReminderRunner.Run(memoReminderContext)
+		slog.Info("memoreminder runner stopped")
+	}()
+
+	notificationEmailContext, notificationEmailCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, notificationEmailCancel)
+
+	// Create and start notification email runner, sending the emails in the background as SMTP can be slow
+	notificationEmailRunner := notificationemail.NewRunner(s.Store, s.apiV1Service.DeliverNotificationEmails)
+
+	go func() {
+		notificationEmailRunner.RunOnce(notificationEmailContext)
+		notificationEmailRunner.Run(notificationEmailContext)
+		slog.Info("notificationemail runner stopped")
+	}()
+
+	activityDigestContext, activityDigestCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, activityDigestCancel)
+
+	// Create and start activity digest runner, delivering the digests in the background as SMTP can be slow
+	activityDigestRunner := activitydigest.NewRunner(s.Store, s.apiV1Service.DeliverActivityDigest)
+
+	go func() {
+		activityDigestRunner.RunOnce(activityDigestContext)
+		activityDigestRunner.Run(activityDigestContext)
+		slog.Info("activitydigest runner stopped")
+	}()
+
+	memoRecurrenceContext, memoRecurrenceCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, memoRecurrenceCancel)
+
+	// Create and start memo recurrence runner, creating a single memo for the occurrences missed while the server was down first
+	memoRecurrenceRunner := memorecurrence.NewRunner(s.Store, s.apiV1Service.CreateRecurringMemo)
+	memoRecurrenceRunner.RunOnce(ctx)
+
+	go func() {
+		memoRecurrenceRunner.Run(memoRecurrenceContext)
+		slog.Info("memorecurrence runner stopped")
+	}()
+
+	memoExpireContext, memoExpireCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, memoExpireCancel)
+
+	// Create and start memo expire runner, moving the memos that expired while the server was down to the trash first
+	memoExpireRunner := memoexpire.NewRunner(s.Store, s.apiV1Service.ExpireMemo)
+	memoExpireRunner.RunOnce(ctx)
+
+	go func() {
+		memoExpireRunner.Run(memoExpireContext)
+		slog.Info("memoexpire runner stopped")
+	}()
+
+	memoAutoArchiveContext, memoAutoArchiveCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, memoAutoArchiveCancel)
+
+	// Create and start memo auto-archive runner
+	memoAutoArchiveRunner := memoautoarchive.NewRunner(s.Store, s.apiV1Service.AutoArchiveMemos)
+	memoAutoArchiveRunner.RunOnce(ctx)
+
+	go func() {
+		memoAutoArchiveRunner.Run(memoAutoArchiveContext)
+		slog.Info("memoautoarchive runner stopped")
+	}()
+
+	webhookDeliveryContext, webhookDeliveryCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, webhookDeliveryCancel)
+
+	// Create and start webhook delivery runner, picking up the deliveries left pending while the server
+	// was down in the background, as their attempts can take a while
+	webhookDeliveryRunner := webhookdelivery.NewRunner(s.Store, s.apiV1Service.DeliverDueWebhook)
+
+	go func() {
+		webhookDeliveryRunner.RunOnce(webhookDeliveryContext)
+		webhookDeliveryRunner.Run(webhookDeliveryContext)
+		slog.Info("webhookdelivery runner stopped")
+	}()
+
+	backupContext, backupCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, backupCancel)
+
+	// Create and start scheduled backup runner, computing the time of the next backup first
+	backupRunner := backup.NewRunner(s.Store, s.apiV1Service.RunScheduledBackup)
+	backupRunner.RunOnce(ctx)
+
+	go func() {
+		backupRunner.Run(backupContext)
+		slog.Info("backup runner stopped")
+	}()
+
+	matrixContext, matrixCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, matrixCancel)
+
+	// Create and start Matrix bot runner, resuming the sync from the stored next batch token
+	matrixRunner := matrix.NewRunner(s.Profile, s.Store, s.apiV1Service)
+
+	go func() {
+		matrixRunner.Run(matrixContext)
+		slog.Info("matrix runner stopped")
+	}()
+
 	// Log the number of goroutines running
 	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
 }
@@ -9,7 +9,14 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoComment     ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoReminder    ActivityType = "MEMO_REMINDER"
+	ActivityTypeMemoAutoArchive ActivityType = "MEMO_AUTO_ARCHIVE"
+	ActivityTypeWebhookDisabled ActivityType = "WEBHOOK_DISABLED"
+	ActivityTypeBackupFailed    ActivityType = "BACKUP_FAILED"
+	ActivityTypeMemoReaction    ActivityType = "MEMO_REACTION"
+	ActivityTypeMemoMention     ActivityType = "MEMO_MENTION"
+	ActivityTypeDigest          ActivityType = "DIGEST"
 )
 
 func (t ActivityType) String() string {
@@ -19,7 +26,8 @@ func (t ActivityType) String() string {
 type ActivityLevel string
 
 const (
-	ActivityLevelInfo ActivityLevel = "INFO"
+	ActivityLevelInfo  ActivityLevel = "INFO"
+	ActivityLevelError ActivityLevel = "ERROR"
 )
 
 func (l ActivityLevel) String() string {
@@ -32,6 +32,15 @@ type Attachment struct {
 	StorageType storepb.AttachmentStorageType
 	Reference   string
 	Payload     *storepb.AttachmentPayload
+	// Library is set on attachments uploaded to be kept without a memo, which are never
+	// collected as orphans.
+	Library bool
+	// ContentHash is the hex-encoded SHA-256 of the blob. The attachments of a user with the same
+	// hash share their local file or S3 object.
+	ContentHash string
+	// Pending is set on the placeholders of direct uploads to S3 and chunked uploads, until the
+	// upload is completed.
+	Pending bool
 
 	// The related memo ID.
 	MemoID *int32
@@ -47,12 +56,30 @@ type FindAttachment struct {
 	CreatorID      *int32
 	Filename       *string
 	FilenameSearch *string
+	// TypePrefix filters the attachments whose MIME type starts with the prefix.
+	TypePrefix *string
+	// SizeMin and SizeMax limit the size in bytes of the attachments, inclusive.
+	SizeMin        *int64
+	SizeMax        *int64
 	MemoID         *int32
 	MemoIDList     []int32
 	HasRelatedMemo bool
-	StorageType    *storepb.AttachmentStorageType
-	Limit          *int
-	Offset         *int
+	// WithoutRelatedMemo filters the attachments not attached to a memo.
+	WithoutRelatedMemo bool
+	StorageType        *storepb.AttachmentStorageType
+	Reference          *string
+	// S3ObjectKey filters the attachments stored in the S3 object with the key.
+	S3ObjectKey *string
+	ContentHash *string
+	// Pending lists the placeholders of direct uploads instead of the attachments.
+	Pending bool
+	// Orphaned filters the attachments without an existing memo that aren't library uploads.
+	Orphaned bool
+	// CreatedTsAfter and CreatedTsBefore limit the attachments to a time range, the end excluded.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+	Limit           *int
+	Offset          *int
 }
 
 type UpdateAttachment struct {
@@ -63,6 +90,16 @@ type UpdateAttachment struct {
 	MemoID    *int32
 	Reference *string
 	Payload   *storepb.AttachmentPayload
+	Library   *bool
+	// ContentHash is set on the attachments hashed by DeduplicateLocalAttachments.
+	ContentHash *string
+	// Type, Size and Pending are set when a direct upload is completed.
+	Type    *string
+	Size    *int64
+	Pending *bool
+	// StorageType and Blob are set when a chunked upload is completed.
+	StorageType *storepb.AttachmentStorageType
+	Blob        []byte
 }
 
 type DeleteAttachment struct {
@@ -70,11 +107,33 @@ type DeleteAttachment struct {
 	MemoID *int32
 }
 
+// DeleteOrphanedAttachment deletes an attachment only while it's orphaned and was created
+// before CreatedTsBefore.
+type DeleteOrphanedAttachment struct {
+	ID              int32
+	CreatedTsBefore int64
+}
+
+// PurgeOrphanedAttachmentsResult reports the attachments purged by PurgeOrphanedAttachments.
+type PurgeOrphanedAttachmentsResult struct {
+	PurgedCount int
+	// ReclaimedBytes is the total size of the purged attachments.
+	ReclaimedBytes int64
+}
+
+// orphanedAttachmentBatchSize is the number of orphaned attachments listed at a time.
+const orphanedAttachmentBatchSize = 100
+
 func (s *Store) CreateAttachment(ctx context.Context, create *Attachment) (*Attachment, error) {
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateAttachment(ctx, create)
+	attachment, err := s.driver.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	s.addAttachmentStorageUsage(ctx, attachment, 1)
+	return attachment, nil
 }
 
 func (s *Store) ListAttachments(ctx context.Context, find *FindAttachment) ([]*Attachment, error) {
@@ -92,6 +151,12 @@ func (s *Store) ListAttachments(ctx context.Context, find *FindAttachment) ([]*A
 	return s.driver.ListAttachments(ctx, find)
 }
 
+// CountAttachments returns the number of attachments matching the find, ignoring its limit and
+// offset.
+func (s *Store) CountAttachments(ctx context.Context, find *FindAttachment) (int, error) {
+	return s.driver.CountAttachments(ctx, find)
+}
+
 func (s *Store) GetAttachment(ctx context.Context, find *FindAttachment) (*Attachment, error) {
 	attachments, err := s.ListAttachments(ctx, find)
 	if err != nil {
@@ -121,50 +186,201 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		return errors.New("attachment not found")
 	}
 
-	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
-		if err := func() error {
-			p := filepath.FromSlash(attachment.Reference)
-			if !filepath.IsAbs(p) {
-				p = filepath.Join(s.profile.Data, p)
-			}
-			err := os.Remove(p)
-			if err != nil {
-				return errors.Wrap(err, "failed to delete local file")
-			}
-			return nil
-		}(); err != nil {
+	// The blob is kept while it's shared with other attachments.
+	if err := s.deleteAttachmentBlob(ctx, attachment); err != nil {
+		if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
 			return errors.Wrap(err, "failed to delete local file")
 		}
-	} else if attachment.StorageType == storepb.AttachmentStorageType_S3 {
-		if err := func() error {
-			s3ObjectPayload := attachment.Payload.GetS3Object()
-			if s3ObjectPayload == nil {
-				return errors.Errorf("No s3 object found")
-			}
-			instanceStorageSetting, err := s.GetInstanceStorageSetting(ctx)
+		slog.Warn("Failed to delete s3 object", slog.Any("err", err))
+	}
+
+	if err := s.driver.DeleteAttachment(ctx, delete); err != nil {
+		return err
+	}
+	s.addAttachmentStorageUsage(ctx, attachment, -1)
+	return nil
+}
+
+// PurgeOrphanedAttachments deletes the attachments without an existing memo created before
+// createdTsBefore, with their local files and S3 objects. Library uploads are kept.
+func (s *Store) PurgeOrphanedAttachments(ctx context.Context, createdTsBefore int64) (*PurgeOrphanedAttachmentsResult, error) {
+	result := &PurgeOrphanedAttachmentsResult{}
+	limit, offset := orphanedAttachmentBatchSize, 0
+	for {
+		attachments, err := s.ListAttachments(ctx, &FindAttachment{
+			Orphaned:        true,
+			CreatedTsBefore: &createdTsBefore,
+			Limit:           &limit,
+			Offset:          &offset,
+		})
+		if err != nil {
+			return result, errors.Wrap(err, "failed to list orphaned attachments")
+		}
+		for _, attachment := range attachments {
+			// The attachment may have been added to a memo since it was listed, so the delete
+			// checks it's still orphaned, and the blob is only deleted with the row.
+			deleted, err := s.driver.DeleteOrphanedAttachment(ctx, &DeleteOrphanedAttachment{
+				ID:              attachment.ID,
+				CreatedTsBefore: createdTsBefore,
+			})
 			if err != nil {
-				return errors.Wrap(err, "failed to get instance storage setting")
+				return result, errors.Wrap(err, "failed to delete orphaned attachment")
 			}
-			s3Config := s3ObjectPayload.S3Config
-			if s3Config == nil {
-				if instanceStorageSetting.S3Config == nil {
-					return errors.Errorf("S3 config is not found")
-				}
-				s3Config = instanceStorageSetting.S3Config
+			if !deleted {
+				offset++
+				continue
 			}
-
-			s3Client, err := s3.NewClient(ctx, s3Config)
-			if err != nil {
-				return errors.Wrap(err, "Failed to create s3 client")
+			if err := s.deleteAttachmentBlob(ctx, attachment); err != nil {
+				slog.Warn("failed to delete orphaned attachment blob", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
 			}
-			if err := s3Client.DeleteObject(ctx, s3ObjectPayload.Key); err != nil {
-				return errors.Wrap(err, "Failed to delete s3 object")
+			s.addAttachmentStorageUsage(ctx, attachment, -1)
+			result.PurgedCount++
+			result.ReclaimedBytes += attachment.Size
+		}
+		if len(attachments) < limit {
+			return result, nil
+		}
+	}
+}
+
+// PurgePendingAttachments deletes the placeholders of the direct and chunked uploads started
+// before createdTsBefore that were never completed, with the S3 objects or chunks uploaded for
+// them, if any.
+func (s *Store) PurgePendingAttachments(ctx context.Context, createdTsBefore int64) (int, error) {
+	purged := 0
+	limit := orphanedAttachmentBatchSize
+	for {
+		attachments, err := s.ListAttachments(ctx, &FindAttachment{
+			Pending:         true,
+			CreatedTsBefore: &createdTsBefore,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return purged, errors.Wrap(err, "failed to list pending attachments")
+		}
+		for _, attachment := range attachments {
+			if err := s.DeletePendingAttachment(ctx, attachment); err != nil {
+				return purged, err
 			}
-			return nil
-		}(); err != nil {
-			slog.Warn("Failed to delete s3 object", slog.Any("err", err))
+			purged++
+		}
+		if len(attachments) < limit {
+			return purged, nil
 		}
 	}
+}
 
-	return s.driver.DeleteAttachment(ctx, delete)
+// DeletePendingAttachment deletes the placeholder of a direct or chunked upload, with the S3
+// object or the chunks uploaded for it, if any.
+func (s *Store) DeletePendingAttachment(ctx context.Context, attachment *Attachment) error {
+	if attachment.Payload.GetChunkedUpload() != nil {
+		if err := s.DeleteAttachmentChunks(ctx, attachment); err != nil {
+			slog.Warn("failed to delete pending attachment chunks", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		}
+	} else if err := s.deleteS3AttachmentObject(ctx, attachment); err != nil {
+		slog.Warn("failed to delete pending attachment object", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+	}
+	if err := s.driver.DeleteAttachment(ctx, &DeleteAttachment{ID: attachment.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete pending attachment")
+	}
+	return nil
+}
+
+// deleteAttachmentBlob deletes the local file or S3 object of an attachment and its thumbnails,
+// unless they're shared with other attachments. The blobs of attachments stored in the database
+// are deleted with their rows.
+func (s *Store) deleteAttachmentBlob(ctx context.Context, attachment *Attachment) error {
+	shared, err := s.isAttachmentBlobShared(ctx, attachment, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to check attachment blob")
+	}
+	if shared {
+		return nil
+	}
+	s.deleteAttachmentThumbnails(ctx, attachment)
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		return s.deleteLocalAttachmentFile(attachment)
+	case storepb.AttachmentStorageType_S3:
+		return s.deleteS3AttachmentObject(ctx, attachment)
+	default:
+		return nil
+	}
+}
+
+// isAttachmentBlobShared reports whether another attachment, of creatorID if set, references the
+// local file or S3 object of an attachment, as the attachments of duplicated memos and identical
+// uploads do.
+func (s *Store) isAttachmentBlobShared(ctx context.Context, attachment *Attachment, creatorID *int32) (bool, error) {
+	find := &FindAttachment{StorageType: &attachment.StorageType, CreatorID: creatorID}
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		find.Reference = &attachment.Reference
+	case storepb.AttachmentStorageType_S3:
+		// The references of S3 attachments are presigned URLs, which are renewed separately.
+		s3ObjectPayload := attachment.Payload.GetS3Object()
+		if s3ObjectPayload == nil {
+			return false, nil
+		}
+		find.S3ObjectKey = &s3ObjectPayload.Key
+	default:
+		return false, nil
+	}
+	limit := 2
+	find.Limit = &limit
+	attachments, err := s.ListAttachments(ctx, find)
+	if err != nil {
+		return false, err
+	}
+	for _, other := range attachments {
+		if other.ID != attachment.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Store) deleteLocalAttachmentFile(attachment *Attachment) error {
+	err := os.Remove(s.getLocalAttachmentPath(attachment))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete local file")
+	}
+	return nil
+}
+
+// getLocalAttachmentPath returns the path of the local file of an attachment, whose reference is
+// relative to the data directory unless it's absolute.
+func (s *Store) getLocalAttachmentPath(attachment *Attachment) string {
+	p := filepath.FromSlash(attachment.Reference)
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(s.profile.Data, p)
+	}
+	return p
+}
+
+func (s *Store) deleteS3AttachmentObject(ctx context.Context, attachment *Attachment) error {
+	s3ObjectPayload := attachment.Payload.GetS3Object()
+	if s3ObjectPayload == nil {
+		return errors.Errorf("No s3 object found")
+	}
+	instanceStorageSetting, err := s.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance storage setting")
+	}
+	s3Config := s3ObjectPayload.S3Config
+	if s3Config == nil {
+		if instanceStorageSetting.S3Config == nil {
+			return errors.Errorf("S3 config is not found")
+		}
+		s3Config = instanceStorageSetting.S3Config
+	}
+
+	s3Client, err := s3.NewClient(ctx, s3Config)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create s3 client")
+	}
+	if err := s3Client.DeleteObject(ctx, s3ObjectPayload.Key); err != nil {
+		return errors.Wrap(err, "Failed to delete s3 object")
+	}
+	return nil
 }
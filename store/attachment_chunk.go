@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/storage/s3"
+)
+
+// AttachmentChunkFolder is the folder of the data directory where the chunks of the chunked
+// uploads to the local and database storages are kept until the upload is completed. The chunks
+// of the uploads to S3 are the parts of a multipart upload.
+const AttachmentChunkFolder = ".chunks"
+
+// SaveAttachmentChunk stores a chunk of the chunked upload of a pending attachment, replacing a
+// previous upload of the chunk.
+func (s *Store) SaveAttachmentChunk(attachment *Attachment, index int, blob []byte) error {
+	dir := s.getAttachmentChunkDir(attachment)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to create chunk folder")
+	}
+	// The chunk is written to a temporary file first, so that an interrupted upload of the chunk
+	// isn't taken for the chunk.
+	tmpFile, err := os.CreateTemp(dir, strconv.Itoa(index)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create chunk file")
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(blob); err != nil {
+		tmpFile.Close()
+		return errors.Wrap(err, "failed to write chunk file")
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrap(err, "failed to write chunk file")
+	}
+	if err := os.Rename(tmpFile.Name(), filepath.Join(dir, strconv.Itoa(index))); err != nil {
+		return errors.Wrap(err, "failed to save chunk file")
+	}
+	return nil
+}
+
+// ListAttachmentChunks returns the sizes of the stored chunks of a chunked upload by index.
+func (s *Store) ListAttachmentChunks(attachment *Attachment) (map[int]int64, error) {
+	entries, err := os.ReadDir(s.getAttachmentChunkDir(attachment))
+	if os.IsNotExist(err) {
+		return map[int]int64{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read chunk folder")
+	}
+	sizes := map[int]int64{}
+	for _, entry := range entries {
+		index, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			// A temporary file of a chunk being uploaded.
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to stat chunk file")
+		}
+		sizes[index] = info.Size()
+	}
+	return sizes, nil
+}
+
+// CopyAttachmentChunks writes the chunks 0 to count-1 of a chunked upload to w, in order.
+func (s *Store) CopyAttachmentChunks(attachment *Attachment, count int, w io.Writer) error {
+	dir := s.getAttachmentChunkDir(attachment)
+	for index := 0; index < count; index++ {
+		file, err := os.Open(filepath.Join(dir, strconv.Itoa(index)))
+		if err != nil {
+			return errors.Wrapf(err, "failed to open chunk %d", index)
+		}
+		_, err = io.Copy(w, file)
+		file.Close()
+		if err != nil {
+			return errors.Wrapf(err, "failed to copy chunk %d", index)
+		}
+	}
+	return nil
+}
+
+// DeleteAttachmentChunks deletes the chunks of the chunked upload of a pending attachment: its
+// chunk folder, or its S3 multipart upload.
+func (s *Store) DeleteAttachmentChunks(ctx context.Context, attachment *Attachment) error {
+	chunkedUpload := attachment.Payload.GetChunkedUpload()
+	if chunkedUpload == nil {
+		return nil
+	}
+	if chunkedUpload.S3UploadId == "" {
+		if err := os.RemoveAll(s.getAttachmentChunkDir(attachment)); err != nil {
+			return errors.Wrap(err, "failed to delete chunk folder")
+		}
+		return nil
+	}
+	s3ObjectPayload := attachment.Payload.GetS3Object()
+	if s3ObjectPayload == nil || s3ObjectPayload.S3Config == nil {
+		return errors.Errorf("No s3 object found")
+	}
+	s3Client, err := s3.NewClient(ctx, s3ObjectPayload.S3Config)
+	if err != nil {
+		return errors.Wrap(err, "failed to create s3 client")
+	}
+	if err := s3Client.AbortMultipartUpload(ctx, s3ObjectPayload.Key, chunkedUpload.S3UploadId); err != nil {
+		return errors.Wrap(err, "failed to abort multipart upload")
+	}
+	return nil
+}
+
+// getAttachmentChunkDir returns the folder of the chunks of the chunked upload of an attachment.
+func (s *Store) getAttachmentChunkDir(attachment *Attachment) string {
+	return filepath.Join(s.profile.Data, AttachmentChunkFolder, strconv.Itoa(int(attachment.ID)))
+}
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// DeduplicateAttachmentsResult reports the attachments deduplicated by DeduplicateLocalAttachments.
+type DeduplicateAttachmentsResult struct {
+	// HashedCount is the number of attachments hashed, which were uploaded before the hashes.
+	HashedCount int
+	// DeduplicatedCount is the number of attachments pointed to the file of an earlier attachment.
+	DeduplicatedCount int
+	// SavedBytes is the total size of the deleted duplicate files.
+	SavedBytes int64
+}
+
+// HashAttachmentBlob returns the content hash of an attachment blob, the hex-encoded SHA-256.
+func HashAttachmentBlob(blob []byte) string {
+	sum := sha256.Sum256(blob)
+	return hex.EncodeToString(sum[:])
+}
+
+// DeduplicateLocalAttachments hashes the local files of the attachments without a content hash,
+// then points the attachments of a user with the same content to the file of the earliest one,
+// deleting the duplicate files once no attachment references them. Files that can't be read are
+// skipped.
+func (s *Store) DeduplicateLocalAttachments(ctx context.Context) (*DeduplicateAttachmentsResult, error) {
+	storageType := storepb.AttachmentStorageType_LOCAL
+	attachments := []*Attachment{}
+	limit, offset := orphanedAttachmentBatchSize, 0
+	for {
+		list, err := s.ListAttachments(ctx, &FindAttachment{StorageType: &storageType, Limit: &limit, Offset: &offset})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list attachments")
+		}
+		attachments = append(attachments, list...)
+		if len(list) < limit {
+			break
+		}
+		offset += limit
+	}
+	slices.SortFunc(attachments, func(a, b *Attachment) int {
+		return int(a.ID - b.ID)
+	})
+
+	result := &DeduplicateAttachmentsResult{}
+	// The files shared by several attachments are hashed once.
+	referenceHashes := map[string]string{}
+	originals := map[string]*Attachment{}
+	for _, attachment := range attachments {
+		if attachment.ContentHash == "" {
+			contentHash, ok := referenceHashes[attachment.Reference]
+			if !ok {
+				blob, err := os.ReadFile(s.getLocalAttachmentPath(attachment))
+				if err != nil {
+					slog.Warn("failed to read attachment file", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+					continue
+				}
+				contentHash = HashAttachmentBlob(blob)
+				referenceHashes[attachment.Reference] = contentHash
+			}
+			if err := s.UpdateAttachment(ctx, &UpdateAttachment{ID: attachment.ID, ContentHash: &contentHash}); err != nil {
+				return result, errors.Wrap(err, "failed to update attachment")
+			}
+			attachment.ContentHash = contentHash
+			result.HashedCount++
+		}
+
+		key := fmt.Sprintf("%d/%s", attachment.CreatorID, attachment.ContentHash)
+		original, ok := originals[key]
+		if !ok {
+			originals[key] = attachment
+			continue
+		}
+		if attachment.Reference == original.Reference {
+			continue
+		}
+		if err := s.UpdateAttachment(ctx, &UpdateAttachment{ID: attachment.ID, Reference: &original.Reference}); err != nil {
+			return result, errors.Wrap(err, "failed to update attachment")
+		}
+		result.DeduplicatedCount++
+		// The attachment still has its previous reference, which other attachments may share.
+		// The file of the original is already counted in the storage usage of the user.
+		s.addAttachmentStorageUsage(ctx, attachment, -1)
+		shared, err := s.isAttachmentBlobShared(ctx, attachment, nil)
+		if err != nil {
+			return result, errors.Wrap(err, "failed to check attachment blob")
+		}
+		if !shared {
+			if err := s.deleteAttachmentBlob(ctx, attachment); err != nil {
+				slog.Warn("failed to delete duplicate attachment file", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+			} else {
+				result.SavedBytes += attachment.Size
+			}
+		}
+		attachment.Reference = original.Reference
+	}
+	return result, nil
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/thumbnail"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// ThumbnailCacheFolder is the folder of the data directory where the thumbnails of the
+// attachments stored in the database are stored.
+const ThumbnailCacheFolder = ".thumbnail_cache"
+
+// GetAttachmentThumbnail returns the stored thumbnail of an attachment, or nil if it hasn't been
+// generated yet. Thumbnails are stored next to the local file or S3 object of the attachment.
+func (s *Store) GetAttachmentThumbnail(ctx context.Context, attachment *Attachment, size thumbnail.Size) ([]byte, error) {
+	if attachment.StorageType == storepb.AttachmentStorageType_S3 {
+		s3Client, key, err := s.getS3AttachmentThumbnailObject(ctx, attachment, size)
+		if err != nil {
+			return nil, err
+		}
+		blob, err := s3Client.GetObject(ctx, key)
+		if s3.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get thumbnail object")
+		}
+		return blob, nil
+	}
+	blob, err := os.ReadFile(s.getLocalAttachmentThumbnailPath(attachment, size))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read thumbnail file")
+	}
+	return blob, nil
+}
+
+// SaveAttachmentThumbnail stores the thumbnail of an attachment, replacing a previous one.
+func (s *Store) SaveAttachmentThumbnail(ctx context.Context, attachment *Attachment, size thumbnail.Size, blob []byte) error {
+	if attachment.StorageType == storepb.AttachmentStorageType_S3 {
+		s3Client, key, err := s.getS3AttachmentThumbnailObject(ctx, attachment, size)
+		if err != nil {
+			return err
+		}
+		if _, err := s3Client.UploadObject(ctx, key, attachment.Type, bytes.NewReader(blob)); err != nil {
+			return errors.Wrap(err, "failed to upload thumbnail object")
+		}
+		return nil
+	}
+	filePath := s.getLocalAttachmentThumbnailPath(attachment, size)
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to create thumbnail folder")
+	}
+	// The thumbnail is written to a temporary file first, so that it's never read partially written.
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create thumbnail file")
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(blob); err != nil {
+		tmpFile.Close()
+		return errors.Wrap(err, "failed to write thumbnail file")
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrap(err, "failed to write thumbnail file")
+	}
+	if err := os.Rename(tmpFile.Name(), filePath); err != nil {
+		return errors.Wrap(err, "failed to save thumbnail file")
+	}
+	return nil
+}
+
+// deleteAttachmentThumbnails deletes the thumbnails of an attachment. Thumbnails are generated
+// again if missing, so failures are only logged.
+func (s *Store) deleteAttachmentThumbnails(ctx context.Context, attachment *Attachment) {
+	for _, size := range thumbnail.Sizes {
+		var err error
+		if attachment.StorageType == storepb.AttachmentStorageType_S3 {
+			var s3Client *s3.Client
+			var key string
+			s3Client, key, err = s.getS3AttachmentThumbnailObject(ctx, attachment, size)
+			if err == nil {
+				err = s3Client.DeleteObject(ctx, key)
+			}
+		} else if err = os.Remove(s.getLocalAttachmentThumbnailPath(attachment, size)); os.IsNotExist(err) {
+			err = nil
+		}
+		if err != nil {
+			slog.Warn("failed to delete attachment thumbnail", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// getLocalAttachmentThumbnailPath returns the path of the thumbnail of a local or database attachment.
+func (s *Store) getLocalAttachmentThumbnailPath(attachment *Attachment, size thumbnail.Size) string {
+	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
+		p := filepath.FromSlash(thumbnail.Key(attachment.Reference, size))
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(s.profile.Data, p)
+		}
+		return p
+	}
+	return filepath.Join(s.profile.Data, ThumbnailCacheFolder, fmt.Sprintf("%d_%s%s", attachment.ID, size, filepath.Ext(attachment.Filename)))
+}
+
+// getS3AttachmentThumbnailObject returns the client and the object key of the thumbnail of an S3 attachment.
+func (s *Store) getS3AttachmentThumbnailObject(ctx context.Context, attachment *Attachment, size thumbnail.Size) (*s3.Client, string, error) {
+	s3ObjectPayload := attachment.Payload.GetS3Object()
+	if s3ObjectPayload == nil || s3ObjectPayload.Key == "" {
+		return nil, "", errors.New("S3 object payload is missing")
+	}
+	s3Config := s3ObjectPayload.S3Config
+	if s3Config == nil {
+		instanceStorageSetting, err := s.GetInstanceStorageSetting(ctx)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to get instance storage setting")
+		}
+		if instanceStorageSetting.S3Config == nil {
+			return nil, "", errors.New("S3 config is not found")
+		}
+		s3Config = instanceStorageSetting.S3Config
+	}
+	s3Client, err := s3.NewClient(ctx, s3Config)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to create S3 client")
+	}
+	return s3Client, thumbnail.Key(s3ObjectPayload.Key, size), nil
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+)
+
+// AuditEventType is the type of an audited authentication or admin event.
+type AuditEventType string
+
+const (
+	AuditEventSignIn                 AuditEventType = "SIGN_IN"
+	AuditEventSignInFailed           AuditEventType = "SIGN_IN_FAILED"
+	AuditEventAccessTokenCreated     AuditEventType = "ACCESS_TOKEN_CREATED"
+	AuditEventAccessTokenRevoked     AuditEventType = "ACCESS_TOKEN_REVOKED"
+	AuditEventSessionRevoked         AuditEventType = "SESSION_REVOKED"
+	AuditEventUserRoleChanged        AuditEventType = "USER_ROLE_CHANGED"
+	AuditEventUserDeleted            AuditEventType = "USER_DELETED"
+	AuditEventInstanceSettingChanged AuditEventType = "INSTANCE_SETTING_CHANGED"
+	AuditEventPasswordReset          AuditEventType = "PASSWORD_RESET"
+	AuditEventSigningKeyRotated      AuditEventType = "SIGNING_KEY_ROTATED"
+	AuditEventSigningKeyExpired      AuditEventType = "SIGNING_KEY_EXPIRED"
+	AuditEventUserSuspended          AuditEventType = "USER_SUSPENDED"
+	AuditEventUserUnsuspended        AuditEventType = "USER_UNSUSPENDED"
+)
+
+func (t AuditEventType) String() string {
+	return string(t)
+}
+
+// AuditLog is a record of who did what, and from where.
+type AuditLog struct {
+	ID int32
+
+	// ActorID is the user who caused the event, or 0 if unauthenticated, e.g. a failed sign-in
+	// with an unknown username.
+	ActorID   int32
+	EventType AuditEventType
+	IPAddress string
+	UserAgent string
+	// Payload is a JSON object with event specific details.
+	Payload   string
+	CreatedTs int64
+}
+
+type FindAuditLog struct {
+	ID        *int32
+	ActorID   *int32
+	EventType *AuditEventType
+	// CreatedTsAfter and CreatedTsBefore limit the entries to a time range, inclusive.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteAuditLog struct {
+	// CreatedBefore deletes entries created before the timestamp.
+	CreatedBefore *int64
+}
+
+func (s *Store) CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error) {
+	return s.driver.CreateAuditLog(ctx, create)
+}
+
+func (s *Store) ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error) {
+	return s.driver.ListAuditLogs(ctx, find)
+}
+
+func (s *Store) DeleteAuditLogs(ctx context.Context, delete *DeleteAuditLog) error {
+	return s.driver.DeleteAuditLogs(ctx, delete)
+}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// BackupDatabase writes a consistent snapshot of the database. SQLite databases are copied with
+// VACUUM INTO. The other databases are dumped as NDJSON by a read-only transaction: a header
+// line with the driver and the time of the dump, followed by a line per row with its table and
+// its columns. The columns of binary data that isn't valid UTF-8 are dumped as {"base64": ...}
+// objects.
+func (s *Store) BackupDatabase(ctx context.Context, w io.Writer) error {
+	if s.profile.Driver == "sqlite" {
+		return s.backupSQLiteDatabase(ctx, w)
+	}
+	return s.dumpDatabase(ctx, w)
+}
+
+// DatabaseBackupFilename returns the file name of the snapshots written by BackupDatabase.
+func (s *Store) DatabaseBackupFilename() string {
+	if s.profile.Driver == "sqlite" {
+		return "memos.db"
+	}
+	return "database.ndjson"
+}
+
+func (s *Store) backupSQLiteDatabase(ctx context.Context, w io.Writer) error {
+	dir, err := os.MkdirTemp("", "memos-backup-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	// VACUUM INTO writes a consistent copy of the database while it's being written.
+	snapshotPath := filepath.Join(dir, "memos.db")
+	if _, err := s.driver.GetDB().ExecContext(ctx, "VACUUM INTO ?", snapshotPath); err != nil {
+		return errors.Wrap(err, "failed to copy database")
+	}
+	snapshot, err := os.Open(snapshotPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database copy")
+	}
+	defer snapshot.Close()
+	if _, err := io.Copy(w, snapshot); err != nil {
+		return errors.Wrap(err, "failed to write database copy")
+	}
+	return nil
+}
+
+// databaseDumpHeader is the first line of the NDJSON dumps of databases.
+type databaseDumpHeader struct {
+	Driver   string    `json:"driver"`
+	DumpTime time.Time `json:"dumpTime"`
+}
+
+// databaseDumpRow is a row of the NDJSON dumps of databases.
+type databaseDumpRow struct {
+	Table string         `json:"table"`
+	Row   map[string]any `json:"row"`
+}
+
+func (s *Store) dumpDatabase(ctx context.Context, w io.Writer) error {
+	tx, err := s.driver.GetDB().BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(&databaseDumpHeader{Driver: s.profile.Driver, DumpTime: time.Now().UTC()}); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+	tables, err := s.listDatabaseTables(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := s.dumpDatabaseTable(ctx, tx, encoder, table); err != nil {
+			return errors.Wrapf(err, "failed to dump table %s", table)
+		}
+	}
+	return nil
+}
+
+func (s *Store) listDatabaseTables(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	query := "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' ORDER BY table_name"
+	if s.profile.Driver == "postgres" {
+		query = "SELECT tablename FROM pg_tables WHERE schemaname = current_schema() ORDER BY tablename"
+	}
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tables")
+	}
+	defer rows.Close()
+	tables := []string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, errors.Wrap(err, "failed to scan table")
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func (s *Store) dumpDatabaseTable(ctx context.Context, tx *sql.Tx, encoder *json.Encoder, table string) error {
+	quotedTable := "`" + table + "`"
+	if s.profile.Driver == "postgres" {
+		quotedTable = `"` + table + `"`
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT * FROM "+quotedTable)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return err
+		}
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			value := values[i]
+			if data, ok := value.([]byte); ok {
+				if utf8.Valid(data) {
+					value = string(data)
+				} else {
+					value = map[string][]byte{"base64": data}
+				}
+			}
+			row[column] = value
+		}
+		if err := encoder.Encode(&databaseDumpRow{Table: table, Row: row}); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
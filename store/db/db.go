@@ -28,5 +28,17 @@ func NewDBDriver(profile *profile.Profile) (store.Driver, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create db driver")
 	}
+
+	// Apply the connection pool options, keeping the database/sql defaults for unset ones.
+	db := driver.GetDB()
+	if profile.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(profile.MaxOpenConns)
+	}
+	if profile.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(profile.MaxIdleConns)
+	}
+	if profile.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(profile.ConnMaxLifetime)
+	}
 	return driver, nil
 }
@@ -14,8 +14,8 @@ import (
 )
 
 func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*store.Attachment, error) {
-	fields := []string{"`uid`", "`filename`", "`blob`", "`type`", "`size`", "`creator_id`", "`memo_id`", "`storage_type`", "`reference`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`filename`", "`blob`", "`type`", "`size`", "`creator_id`", "`memo_id`", "`storage_type`", "`reference`", "`payload`", "`library`", "`content_hash`", "`pending`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
 	storageType := ""
 	if create.StorageType != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
 		storageType = create.StorageType.String()
@@ -28,7 +28,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 		}
 		payloadString = string(bytes)
 	}
-	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
+	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString, create.Library, create.ContentHash, create.Pending}
 
 	stmt := "INSERT INTO `resource` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -46,42 +46,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 }
 
 func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([]*store.Attachment, error) {
-	where, args := []string{"1 = 1"}, []any{}
-
-	if v := find.ID; v != nil {
-		where, args = append(where, "`resource`.`id` = ?"), append(args, *v)
-	}
-	if v := find.UID; v != nil {
-		where, args = append(where, "`resource`.`uid` = ?"), append(args, *v)
-	}
-	if v := find.CreatorID; v != nil {
-		where, args = append(where, "`resource`.`creator_id` = ?"), append(args, *v)
-	}
-	if v := find.Filename; v != nil {
-		where, args = append(where, "`resource`.`filename` = ?"), append(args, *v)
-	}
-	if v := find.FilenameSearch; v != nil {
-		where, args = append(where, "`resource`.`filename` LIKE ?"), append(args, "%"+*v+"%")
-	}
-	if v := find.MemoID; v != nil {
-		where, args = append(where, "`resource`.`memo_id` = ?"), append(args, *v)
-	}
-	if len(find.MemoIDList) > 0 {
-		placeholders := make([]string, 0, len(find.MemoIDList))
-		for range find.MemoIDList {
-			placeholders = append(placeholders, "?")
-		}
-		where = append(where, "`resource`.`memo_id` IN ("+strings.Join(placeholders, ",")+")")
-		for _, id := range find.MemoIDList {
-			args = append(args, id)
-		}
-	}
-	if find.HasRelatedMemo {
-		where = append(where, "`resource`.`memo_id` IS NOT NULL")
-	}
-	if find.StorageType != nil {
-		where, args = append(where, "`resource`.`storage_type` = ?"), append(args, find.StorageType.String())
-	}
+	where, args := buildAttachmentFindCondition(find)
 
 	fields := []string{
 		"`resource`.`id` AS `id`",
@@ -96,6 +61,9 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		"`resource`.`storage_type` AS `storage_type`",
 		"`resource`.`reference` AS `reference`",
 		"`resource`.`payload` AS `payload`",
+		"`resource`.`library` AS `library`",
+		"`resource`.`content_hash` AS `content_hash`",
+		"`resource`.`pending` AS `pending`",
 		"CASE WHEN `memo`.`uid` IS NOT NULL THEN `memo`.`uid` ELSE NULL END AS `memo_uid`",
 	}
 	if find.GetBlob {
@@ -138,6 +106,9 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 			&storageType,
 			&attachment.Reference,
 			&payloadBytes,
+			&attachment.Library,
+			&attachment.ContentHash,
+			&attachment.Pending,
 			&attachment.MemoUID,
 		}
 		if find.GetBlob {
@@ -166,6 +137,89 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	return list, nil
 }
 
+func (d *DB) CountAttachments(ctx context.Context, find *store.FindAttachment) (int, error) {
+	where, args := buildAttachmentFindCondition(find)
+	query := "SELECT COUNT(*) FROM `resource` LEFT JOIN `memo` ON `resource`.`memo_id` = `memo`.`id` WHERE " + strings.Join(where, " AND ")
+	var count int
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// buildAttachmentFindCondition returns the conditions of the attachments to find and their args.
+func buildAttachmentFindCondition(find *store.FindAttachment) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "`resource`.`id` = ?"), append(args, *v)
+	}
+	if v := find.UID; v != nil {
+		where, args = append(where, "`resource`.`uid` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`resource`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Filename; v != nil {
+		where, args = append(where, "`resource`.`filename` = ?"), append(args, *v)
+	}
+	if v := find.FilenameSearch; v != nil {
+		where, args = append(where, "`resource`.`filename` LIKE ?"), append(args, "%"+*v+"%")
+	}
+	if v := find.TypePrefix; v != nil {
+		where, args = append(where, "`resource`.`type` LIKE ?"), append(args, *v+"%")
+	}
+	if v := find.SizeMin; v != nil {
+		where, args = append(where, "`resource`.`size` >= ?"), append(args, *v)
+	}
+	if v := find.SizeMax; v != nil {
+		where, args = append(where, "`resource`.`size` <= ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`resource`.`memo_id` = ?"), append(args, *v)
+	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for range find.MemoIDList {
+			placeholders = append(placeholders, "?")
+		}
+		where = append(where, "`resource`.`memo_id` IN ("+strings.Join(placeholders, ",")+")")
+		for _, id := range find.MemoIDList {
+			args = append(args, id)
+		}
+	}
+	if find.HasRelatedMemo {
+		where = append(where, "`resource`.`memo_id` IS NOT NULL")
+	}
+	if find.WithoutRelatedMemo {
+		where = append(where, "`resource`.`memo_id` IS NULL")
+	}
+	if find.StorageType != nil {
+		where, args = append(where, "`resource`.`storage_type` = ?"), append(args, find.StorageType.String())
+	}
+	if v := find.Reference; v != nil {
+		where, args = append(where, "`resource`.`reference` = ?"), append(args, *v)
+	}
+	if v := find.ContentHash; v != nil {
+		where, args = append(where, "`resource`.`content_hash` = ?"), append(args, *v)
+	}
+	// The placeholders of direct uploads are only listed on request.
+	where, args = append(where, "`resource`.`pending` = ?"), append(args, find.Pending)
+	if v := find.S3ObjectKey; v != nil {
+		where, args = append(where, "JSON_UNQUOTE(JSON_EXTRACT(`resource`.`payload`, '$.s3Object.key')) = ?"), append(args, *v)
+	}
+	if find.Orphaned {
+		where = append(where, "`memo`.`id` IS NULL", "`resource`.`library` = FALSE")
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "`resource`.`created_ts` >= FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "`resource`.`created_ts` < FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	return where, args
+}
+
 func (d *DB) GetAttachment(ctx context.Context, find *store.FindAttachment) (*store.Attachment, error) {
 	list, err := d.ListAttachments(ctx, find)
 	if err != nil {
@@ -196,6 +250,31 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 	if v := update.Reference; v != nil {
 		set, args = append(set, "`reference` = ?"), append(args, *v)
 	}
+	if v := update.Library; v != nil {
+		set, args = append(set, "`library` = ?"), append(args, *v)
+	}
+	if v := update.ContentHash; v != nil {
+		set, args = append(set, "`content_hash` = ?"), append(args, *v)
+	}
+	if v := update.Type; v != nil {
+		set, args = append(set, "`type` = ?"), append(args, *v)
+	}
+	if v := update.Size; v != nil {
+		set, args = append(set, "`size` = ?"), append(args, *v)
+	}
+	if v := update.Pending; v != nil {
+		set, args = append(set, "`pending` = ?"), append(args, *v)
+	}
+	if v := update.StorageType; v != nil {
+		storageType := ""
+		if *v != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+			storageType = v.String()
+		}
+		set, args = append(set, "`storage_type` = ?"), append(args, storageType)
+	}
+	if v := update.Blob; v != nil {
+		set, args = append(set, "`blob` = ?"), append(args, v)
+	}
 	if v := update.Payload; v != nil {
 		bytes, err := protojson.Marshal(v)
 		if err != nil {
@@ -228,3 +307,16 @@ func (d *DB) DeleteAttachment(ctx context.Context, delete *store.DeleteAttachmen
 
 	return nil
 }
+
+func (d *DB) DeleteOrphanedAttachment(ctx context.Context, delete *store.DeleteOrphanedAttachment) (bool, error) {
+	stmt := "DELETE FROM `resource` WHERE `id` = ? AND `library` = FALSE AND `created_ts` < FROM_UNIXTIME(?) AND NOT EXISTS (SELECT 1 FROM `memo` WHERE `memo`.`id` = `resource`.`memo_id`)"
+	result, err := d.db.ExecContext(ctx, stmt, delete.ID, delete.CreatedTsBefore)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
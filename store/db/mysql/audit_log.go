@@ -0,0 +1,108 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAuditLog(ctx context.Context, create *store.AuditLog) (*store.AuditLog, error) {
+	stmt := `
+		INSERT INTO audit_log (
+			actor_id, event_type, ip_address, user_agent, payload, created_ts
+		)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	result, err := d.db.ExecContext(ctx, stmt, create.ActorID, create.EventType.String(), create.IPAddress, create.UserAgent, create.Payload, create.CreatedTs)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+
+	return create, nil
+}
+
+func (d *DB) ListAuditLogs(ctx context.Context, find *store.FindAuditLog) ([]*store.AuditLog, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = ?"), append(args, *find.ID)
+	}
+	if find.ActorID != nil {
+		where, args = append(where, "actor_id = ?"), append(args, *find.ActorID)
+	}
+	if find.EventType != nil {
+		where, args = append(where, "event_type = ?"), append(args, find.EventType.String())
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts <= ?"), append(args, *find.CreatedTsBefore)
+	}
+
+	query := `
+		SELECT
+			id,
+			actor_id,
+			event_type,
+			ip_address,
+			user_agent,
+			payload,
+			created_ts
+		FROM audit_log
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC`
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AuditLog{}
+	for rows.Next() {
+		auditLog := &store.AuditLog{}
+		err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.ActorID,
+			&auditLog.EventType,
+			&auditLog.IPAddress,
+			&auditLog.UserAgent,
+			&auditLog.Payload,
+			&auditLog.CreatedTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, auditLog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAuditLogs(ctx context.Context, delete *store.DeleteAuditLog) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatedBefore != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *delete.CreatedBefore)
+	}
+
+	stmt := "DELETE FROM audit_log WHERE " + strings.Join(where, " AND ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
@@ -51,6 +51,15 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 	if find.ID != nil {
 		where, args = append(where, "`id` = ?"), append(args, *find.ID)
 	}
+	if find.IDAfter != nil {
+		where, args = append(where, "`id` > ?"), append(args, *find.IDAfter)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "`created_ts` >= FROM_UNIXTIME(?)"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < FROM_UNIXTIME(?)"), append(args, *find.CreatedTsBefore)
+	}
 	if find.SenderID != nil {
 		where, args = append(where, "`sender_id` = ?"), append(args, *find.SenderID)
 	}
@@ -65,8 +74,16 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		// Note: The type field in JSON is stored as string representation of the enum name
 		where, args = append(where, "JSON_EXTRACT(`message`, '$.type') = ?"), append(args, find.MessageType.String())
 	}
+	if v := find.MessageTypeList; len(v) != 0 {
+		placeholder := []string{}
+		for _, messageType := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, messageType.String())
+		}
+		where = append(where, fmt.Sprintf("JSON_EXTRACT(`message`, '$.type') IN (%s)", strings.Join(placeholder, ",")))
+	}
 
-	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
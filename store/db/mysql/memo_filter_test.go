@@ -18,12 +18,12 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR (JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
+			want:   "((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?) OR (JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?))",
 			args:   []any{`"tag1"`, `%"tag1/%`, `"tag2"`, `%"tag2/%`},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT (((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR (JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?)))",
+			want:   "NOT (((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?) OR (JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?)))",
 			args:   []any{`"tag1"`, `%"tag1/%`, `"tag2"`, `%"tag2/%`},
 		},
 		{
@@ -43,7 +43,7 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR `memo`.`content` LIKE ?)",
+			want:   "((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), ?) OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?) OR `memo`.`content` LIKE ?)",
 			args:   []any{`"tag1"`, `%"tag1/%`, "%hello%"},
 		},
 		{
@@ -58,7 +58,7 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_task_list`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
 		{
@@ -78,17 +78,17 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `!has_task_list`,
-			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON))",
+			want:   "NOT (JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON))",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list && pinned`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON) AND `memo`.`pinned` IS TRUE)",
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON) AND `memo`.`pinned` IS TRUE)",
 			args:   []any{},
 		},
 		{
 			filter: `has_task_list && content.contains("todo")`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON) AND `memo`.`content` LIKE ?)",
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') <=> CAST('true' AS JSON) AND `memo`.`content` LIKE ?)",
 			args:   []any{"%todo%"},
 		},
 		{
@@ -98,22 +98,22 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `size(tags) == 0`,
-			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = ?",
+			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), JSON_ARRAY())) = ?",
 			args:   []any{int64(0)},
 		},
 		{
 			filter: `size(tags) > 0`,
-			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > ?",
+			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), JSON_ARRAY())) > ?",
 			args:   []any{int64(0)},
 		},
 		{
 			filter: `"work" in tags`,
-			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)",
+			want:   "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), ?)",
 			args:   []any{`"work"`},
 		},
 		{
 			filter: `size(tags) == 2`,
-			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = ?",
+			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), JSON_ARRAY())) = ?",
 			args:   []any{int64(2)},
 		},
 		{
@@ -133,19 +133,39 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `has_link`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasLink') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
 		{
 			filter: `has_code`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasCode') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
 		{
 			filter: `has_incomplete_tasks`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') = CAST('true' AS JSON)",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') <=> CAST('true' AS JSON)",
 			args:   []any{},
 		},
+		{
+			filter: `has_attachment`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment == false`,
+			want:   "NOT (EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`))",
+			args:   []any{},
+		},
+		{
+			filter: `!has_attachment && has_incomplete_tasks`,
+			want:   "(NOT (EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)) AND JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') <=> CAST('true' AS JSON))",
+			args:   []any{},
+		},
+		{
+			filter: `location_within_box(-10, 170, 10.5, -170)`,
+			want:   "((CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE) IS NOT NULL OR CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE) IS NOT NULL) AND (JSON_EXTRACT(`memo`.`payload`, '$.location.showPublicly') <=> CAST('true' AS JSON) OR `memo`.`creator_id` = ?) AND COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE), 0) >= ? AND COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE), 0) <= ? AND (COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE), 0) >= ? OR COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE), 0) <= ?))",
+			args:   []any{int32(0), -10.0, 10.5, 170.0, -170.0},
+		},
 	}
 
 	engine, err := filter.DefaultEngine()
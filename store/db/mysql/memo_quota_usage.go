@@ -0,0 +1,49 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) AddMemoQuotaUsage(ctx context.Context, add *store.MemoQuotaUsage) error {
+	// The assignments are applied in order, so the day is updated last.
+	stmt := "INSERT INTO `memo_quota_usage` (`user_id`, `day_start_ts`, `memo_count`, `public_memo_count`) VALUES (?, ?, ?, ?) " +
+		"ON DUPLICATE KEY UPDATE " +
+		"`memo_count` = IF(`day_start_ts` < VALUES(`day_start_ts`), VALUES(`memo_count`), `memo_count` + VALUES(`memo_count`)), " +
+		"`public_memo_count` = IF(`day_start_ts` < VALUES(`day_start_ts`), VALUES(`public_memo_count`), `public_memo_count` + VALUES(`public_memo_count`)), " +
+		"`day_start_ts` = GREATEST(`day_start_ts`, VALUES(`day_start_ts`))"
+	_, err := d.db.ExecContext(ctx, stmt, add.UserID, add.DayStartTs, add.MemoCount, add.PublicMemoCount)
+	return err
+}
+
+func (d *DB) ListMemoQuotaUsages(ctx context.Context, find *store.FindMemoQuotaUsage) ([]*store.MemoQuotaUsage, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	query := "SELECT `user_id`, `day_start_ts`, `memo_count`, `public_memo_count` FROM `memo_quota_usage` WHERE " + strings.Join(where, " AND ")
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoQuotaUsage{}
+	for rows.Next() {
+		usage := &store.MemoQuotaUsage{}
+		if err := rows.Scan(&usage.UserID, &usage.DayStartTs, &usage.MemoCount, &usage.PublicMemoCount); err != nil {
+			return nil, err
+		}
+		list = append(list, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+// memoSearchMatch matches memos against a full-text search query in boolean mode, and is also
+// the relevance of the match.
+const memoSearchMatch = "MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE)"
+
+// buildMemoSearchAgainst returns the boolean mode query of a full-text search query: every
+// term is required, as a phrase of its ngrams.
+func buildMemoSearchAgainst(query string) string {
+	phrases := []string{}
+	for _, term := range store.SplitMemoSearchQuery(query) {
+		phrases = append(phrases, buildMemoSearchPhrase(term))
+	}
+	return strings.Join(phrases, " ")
+}
+
+// buildMemoSearchPhrase returns the required phrase of a search term in boolean mode.
+func buildMemoSearchPhrase(term string) string {
+	return `+"` + strings.ReplaceAll(term, `"`, "") + `"`
+}
+
+// appendMemoSearchConditions appends the conditions of a full-text search query to where and
+// args: every term matches the content, or the filename of an attachment of the memo.
+func appendMemoSearchConditions(query string, where *[]string, args *[]any) {
+	for _, term := range store.SplitMemoSearchQuery(query) {
+		*where = append(*where, "("+memoSearchMatch+" OR "+
+			"EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND `resource`.`filename` LIKE ?))")
+		*args = append(*args, buildMemoSearchPhrase(term), store.MemoSearchFilenamePattern(term))
+	}
+}
+
+// isMemoSearchUnavailable reports whether err is caused by a missing memo search index, e.g.
+// on a database that hasn't been migrated.
+func isMemoSearchUnavailable(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "FULLTEXT index")
+}
@@ -0,0 +1,93 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateRefreshToken(ctx context.Context, create *store.RefreshToken) (*store.RefreshToken, error) {
+	stmt := "INSERT INTO `refresh_token` (`token_hash`, `user_id`, `session_id`, `created_ts`, `expires_ts`, `rotated_ts`) VALUES (?, ?, ?, ?, ?, ?)"
+	if _, err := d.db.ExecContext(ctx, stmt, create.TokenHash, create.UserID, create.SessionID, create.CreatedTs, create.ExpiresTs, create.RotatedTs); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListRefreshTokens(ctx context.Context, find *store.FindRefreshToken) ([]*store.RefreshToken, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.TokenHash != nil {
+		where, args = append(where, "`token_hash` = ?"), append(args, *find.TokenHash)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+	if find.SessionID != nil {
+		where, args = append(where, "`session_id` = ?"), append(args, *find.SessionID)
+	}
+
+	query := "SELECT `token_hash`, `user_id`, `session_id`, `created_ts`, `expires_ts`, `rotated_ts` FROM `refresh_token` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.RefreshToken{}
+	for rows.Next() {
+		refreshToken := &store.RefreshToken{}
+		err := rows.Scan(
+			&refreshToken.TokenHash,
+			&refreshToken.UserID,
+			&refreshToken.SessionID,
+			&refreshToken.CreatedTs,
+			&refreshToken.ExpiresTs,
+			&refreshToken.RotatedTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, refreshToken)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateRefreshToken(ctx context.Context, update *store.UpdateRefreshToken) error {
+	set, args := []string{}, []any{}
+	if v := update.RotatedTs; v != nil {
+		set, args = append(set, "`rotated_ts` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	args = append(args, update.TokenHash)
+	stmt := "UPDATE `refresh_token` SET " + strings.Join(set, ", ") + " WHERE `token_hash` = ?"
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func (d *DB) DeleteRefreshTokens(ctx context.Context, delete *store.DeleteRefreshToken) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *delete.UserID)
+	}
+	if delete.SessionID != nil {
+		where, args = append(where, "`session_id` = ?"), append(args, *delete.SessionID)
+	}
+	if delete.ExpiresBefore != nil {
+		where, args = append(where, "`expires_ts` < ?"), append(args, *delete.ExpiresBefore)
+	}
+
+	stmt := "DELETE FROM `refresh_token` WHERE " + strings.Join(where, " AND ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
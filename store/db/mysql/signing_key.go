@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateSigningKey(ctx context.Context, create *store.SigningKey) (*store.SigningKey, error) {
+	stmt := "INSERT INTO `signing_key` (`id`, `secret`, `created_ts`, `retired_ts`, `expires_ts`) VALUES (?, ?, ?, ?, ?)"
+	if _, err := d.db.ExecContext(ctx, stmt, create.ID, create.Secret, create.CreatedTs, create.RetiredTs, create.ExpiresTs); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListSigningKeys(ctx context.Context, find *store.FindSigningKey) ([]*store.SigningKey, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+
+	query := "SELECT `id`, `secret`, `created_ts`, `retired_ts`, `expires_ts` FROM `signing_key` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `retired_ts` ASC"
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SigningKey{}
+	for rows.Next() {
+		signingKey := &store.SigningKey{}
+		err := rows.Scan(
+			&signingKey.ID,
+			&signingKey.Secret,
+			&signingKey.CreatedTs,
+			&signingKey.RetiredTs,
+			&signingKey.ExpiresTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, signingKey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateSigningKey(ctx context.Context, update *store.UpdateSigningKey) error {
+	set, args := []string{}, []any{}
+	if v := update.RetiredTs; v != nil {
+		set, args = append(set, "`retired_ts` = ?"), append(args, *v)
+	}
+	if v := update.ExpiresTs; v != nil {
+		set, args = append(set, "`expires_ts` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	args = append(args, update.ID)
+	stmt := "UPDATE `signing_key` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
@@ -0,0 +1,171 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	stmt := `
+		INSERT INTO webhook_delivery (
+			user_id, webhook_id, activity_type, payload, payload_hash, status, attempts, next_attempt_ts, response_status, latency_ms, error, created_ts, updated_ts
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := d.db.ExecContext(ctx, stmt, create.UserID, create.WebhookID, create.ActivityType, create.Payload, create.PayloadHash, create.Status.String(), create.Attempts, create.NextAttemptTs, create.ResponseStatus, create.LatencyMs, create.Error, create.CreatedTs, create.UpdatedTs)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+
+	return create, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = ?"), append(args, *find.ID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = ?"), append(args, *find.UserID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "webhook_id = ?"), append(args, *find.WebhookID)
+	}
+	if find.DueBefore != nil {
+		where, args = append(where, "status = ?", "next_attempt_ts <= ?"), append(args, store.WebhookDeliveryPending.String(), *find.DueBefore)
+	}
+
+	query := `
+		SELECT
+			id,
+			user_id,
+			webhook_id,
+			activity_type,
+			payload,
+			payload_hash,
+			status,
+			attempts,
+			next_attempt_ts,
+			response_status,
+			latency_ms,
+			error,
+			created_ts,
+			updated_ts
+		FROM webhook_delivery
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC`
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.UserID,
+			&delivery.WebhookID,
+			&delivery.ActivityType,
+			&delivery.Payload,
+			&delivery.PayloadHash,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptTs,
+			&delivery.ResponseStatus,
+			&delivery.LatencyMs,
+			&delivery.Error,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) error {
+	set, args := []string{}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, "status = ?"), append(args, v.String())
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "attempts = ?"), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "next_attempt_ts = ?"), append(args, *v)
+	}
+	if v := update.ResponseStatus; v != nil {
+		set, args = append(set, "response_status = ?"), append(args, *v)
+	}
+	if v := update.LatencyMs; v != nil {
+		set, args = append(set, "latency_ms = ?"), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, "error = ?"), append(args, *v)
+	}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE webhook_delivery SET " + strings.Join(set, ", ") + " WHERE id = ?"
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func (d *DB) ClaimWebhookDelivery(ctx context.Context, claim *store.ClaimWebhookDelivery) (bool, error) {
+	stmt := "UPDATE webhook_delivery SET next_attempt_ts = ? WHERE id = ? AND status = ? AND next_attempt_ts = ?"
+	result, err := d.db.ExecContext(ctx, stmt, claim.LeaseTs, claim.ID, store.WebhookDeliveryPending.String(), claim.NextAttemptTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) DeleteWebhookDeliveries(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = ?"), append(args, *delete.UserID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "webhook_id = ?"), append(args, *delete.WebhookID)
+	}
+	if delete.CreatedBefore != nil {
+		where, args = append(where, "created_ts < ?", "status != ?"), append(args, *delete.CreatedBefore, store.WebhookDeliveryPending.String())
+	}
+
+	stmt := "DELETE FROM webhook_delivery WHERE " + strings.Join(where, " AND ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*store.Attachment, error) {
-	fields := []string{"uid", "filename", "blob", "type", "size", "creator_id", "memo_id", "storage_type", "reference", "payload"}
+	fields := []string{"uid", "filename", "blob", "type", "size", "creator_id", "memo_id", "storage_type", "reference", "payload", "library", "content_hash", "pending"}
 	storageType := ""
 	if create.StorageType != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
 		storageType = create.StorageType.String()
@@ -27,7 +27,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 		}
 		payloadString = string(bytes)
 	}
-	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
+	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString, create.Library, create.ContentHash, create.Pending}
 
 	stmt := "INSERT INTO resource (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
@@ -37,40 +37,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 }
 
 func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([]*store.Attachment, error) {
-	where, args := []string{"1 = 1"}, []any{}
-
-	if v := find.ID; v != nil {
-		where, args = append(where, "resource.id = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if v := find.UID; v != nil {
-		where, args = append(where, "resource.uid = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if v := find.CreatorID; v != nil {
-		where, args = append(where, "resource.creator_id = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if v := find.Filename; v != nil {
-		where, args = append(where, "resource.filename = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if v := find.FilenameSearch; v != nil {
-		where, args = append(where, "resource.filename LIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", *v))
-	}
-	if v := find.MemoID; v != nil {
-		where, args = append(where, "resource.memo_id = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if len(find.MemoIDList) > 0 {
-		holders := make([]string, 0, len(find.MemoIDList))
-		for _, id := range find.MemoIDList {
-			holders = append(holders, placeholder(len(args)+1))
-			args = append(args, id)
-		}
-		where = append(where, "resource.memo_id IN ("+strings.Join(holders, ", ")+")")
-	}
-	if find.HasRelatedMemo {
-		where = append(where, "resource.memo_id IS NOT NULL")
-	}
-	if v := find.StorageType; v != nil {
-		where, args = append(where, "resource.storage_type = "+placeholder(len(args)+1)), append(args, v.String())
-	}
+	where, args := buildAttachmentFindCondition(find)
 
 	fields := []string{
 		"resource.id AS id",
@@ -85,6 +52,9 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		"resource.storage_type AS storage_type",
 		"resource.reference AS reference",
 		"resource.payload AS payload",
+		"resource.library AS library",
+		"resource.content_hash AS content_hash",
+		"resource.pending AS pending",
 		"CASE WHEN memo.uid IS NOT NULL THEN memo.uid ELSE NULL END AS memo_uid",
 	}
 	if find.GetBlob {
@@ -131,6 +101,9 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 			&storageType,
 			&attachment.Reference,
 			&payloadBytes,
+			&attachment.Library,
+			&attachment.ContentHash,
+			&attachment.Pending,
 			&attachment.MemoUID,
 		}
 		if find.GetBlob {
@@ -159,6 +132,87 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	return list, nil
 }
 
+func (d *DB) CountAttachments(ctx context.Context, find *store.FindAttachment) (int, error) {
+	where, args := buildAttachmentFindCondition(find)
+	query := "SELECT COUNT(*) FROM resource LEFT JOIN memo ON resource.memo_id = memo.id WHERE " + strings.Join(where, " AND ")
+	var count int
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// buildAttachmentFindCondition returns the conditions of the attachments to find and their args.
+func buildAttachmentFindCondition(find *store.FindAttachment) ([]string, []any) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "resource.id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UID; v != nil {
+		where, args = append(where, "resource.uid = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "resource.creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Filename; v != nil {
+		where, args = append(where, "resource.filename = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.FilenameSearch; v != nil {
+		where, args = append(where, "resource.filename ILIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", *v))
+	}
+	if v := find.TypePrefix; v != nil {
+		where, args = append(where, "resource.type LIKE "+placeholder(len(args)+1)), append(args, *v+"%")
+	}
+	if v := find.SizeMin; v != nil {
+		where, args = append(where, "resource.size >= "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.SizeMax; v != nil {
+		where, args = append(where, "resource.size <= "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "resource.memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(find.MemoIDList) > 0 {
+		holders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, "resource.memo_id IN ("+strings.Join(holders, ", ")+")")
+	}
+	if find.HasRelatedMemo {
+		where = append(where, "resource.memo_id IS NOT NULL")
+	}
+	if find.WithoutRelatedMemo {
+		where = append(where, "resource.memo_id IS NULL")
+	}
+	if v := find.StorageType; v != nil {
+		where, args = append(where, "resource.storage_type = "+placeholder(len(args)+1)), append(args, v.String())
+	}
+	if v := find.Reference; v != nil {
+		where, args = append(where, "resource.reference = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ContentHash; v != nil {
+		where, args = append(where, "resource.content_hash = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	// The placeholders of direct uploads are only listed on request.
+	where, args = append(where, "resource.pending = "+placeholder(len(args)+1)), append(args, find.Pending)
+	if v := find.S3ObjectKey; v != nil {
+		where, args = append(where, "resource.payload::jsonb->'s3Object'->>'key' = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if find.Orphaned {
+		where = append(where, "memo.id IS NULL", "resource.library = FALSE")
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "resource.created_ts >= "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "resource.created_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	return where, args
+}
+
 func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachment) error {
 	set, args := []string{}, []any{}
 
@@ -177,6 +231,31 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 	if v := update.Reference; v != nil {
 		set, args = append(set, "reference = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.Library; v != nil {
+		set, args = append(set, "library = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ContentHash; v != nil {
+		set, args = append(set, "content_hash = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Type; v != nil {
+		set, args = append(set, "type = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Size; v != nil {
+		set, args = append(set, "size = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Pending; v != nil {
+		set, args = append(set, "pending = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.StorageType; v != nil {
+		storageType := ""
+		if *v != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+			storageType = v.String()
+		}
+		set, args = append(set, "storage_type = "+placeholder(len(args)+1)), append(args, storageType)
+	}
+	if v := update.Blob; v != nil {
+		set, args = append(set, "blob = "+placeholder(len(args)+1)), append(args, v)
+	}
 	if v := update.Payload; v != nil {
 		bytes, err := protojson.Marshal(v)
 		if err != nil {
@@ -208,3 +287,16 @@ func (d *DB) DeleteAttachment(ctx context.Context, delete *store.DeleteAttachmen
 	}
 	return nil
 }
+
+func (d *DB) DeleteOrphanedAttachment(ctx context.Context, delete *store.DeleteOrphanedAttachment) (bool, error) {
+	stmt := "DELETE FROM resource WHERE id = $1 AND library = FALSE AND created_ts < $2 AND NOT EXISTS (SELECT 1 FROM memo WHERE memo.id = resource.memo_id)"
+	result, err := d.db.ExecContext(ctx, stmt, delete.ID, delete.CreatedTsBefore)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
@@ -41,6 +41,15 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 	if find.ID != nil {
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
 	}
+	if find.IDAfter != nil {
+		where, args = append(where, "id > "+placeholder(len(args)+1)), append(args, *find.IDAfter)
+	}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= "+placeholder(len(args)+1)), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *find.CreatedTsBefore)
+	}
 	if find.SenderID != nil {
 		where, args = append(where, "sender_id = "+placeholder(len(args)+1)), append(args, *find.SenderID)
 	}
@@ -55,8 +64,16 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		// Note: The type field in JSON is stored as string representation of the enum name
 		where, args = append(where, "message->>'type' = "+placeholder(len(args)+1)), append(args, find.MessageType.String())
 	}
+	if v := find.MessageTypeList; len(v) != 0 {
+		holders := []string{}
+		for _, messageType := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, messageType.String())
+		}
+		where = append(where, "message->>'type' IN ("+strings.Join(holders, ", ")+")")
+	}
 
-	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
+	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
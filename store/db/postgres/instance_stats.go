@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) GetInstanceStats(ctx context.Context, find *store.FindInstanceStats) (*store.InstanceStats, error) {
+	stats := &store.InstanceStats{
+		MemoCounts:    []*store.InstanceMemoCount{},
+		DayCounts:     []*store.MemoDayCount{},
+		StorageUsages: []*store.InstanceStorageUsage{},
+	}
+	if err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM "user"`).Scan(&stats.UserCount); err != nil {
+		return nil, errors.Wrap(err, "failed to count users")
+	}
+	if err := d.db.QueryRowContext(ctx, "SELECT COUNT(DISTINCT user_id) FROM refresh_token WHERE created_ts >= "+placeholder(1), find.ActiveSinceTs).Scan(&stats.ActiveUserCount); err != nil {
+		return nil, errors.Wrap(err, "failed to count active users")
+	}
+	if err := d.db.QueryRowContext(ctx, "SELECT COALESCE(SUM(jsonb_array_length(value::jsonb->'webhooks')), 0) FROM user_setting WHERE key = 'WEBHOOKS'").Scan(&stats.UserWebhookCount); err != nil {
+		return nil, errors.Wrap(err, "failed to count webhooks")
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT visibility, row_status, deleted_ts != 0 AS trashed, COUNT(*) FROM memo GROUP BY visibility, row_status, trashed")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count memos")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		memoCount := &store.InstanceMemoCount{}
+		if err := rows.Scan(&memoCount.Visibility, &memoCount.RowStatus, &memoCount.Trashed, &memoCount.Count); err != nil {
+			return nil, err
+		}
+		stats.MemoCounts = append(stats.MemoCounts, memoCount)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	dayRows, err := d.db.QueryContext(ctx, "SELECT FLOOR(created_ts / 86400.0)::bigint AS day, COUNT(*) FROM memo WHERE created_ts >= "+placeholder(1)+" GROUP BY day ORDER BY day", find.CreatedSinceTs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count memos by day")
+	}
+	defer dayRows.Close()
+	for dayRows.Next() {
+		dayCount := &store.MemoDayCount{}
+		if err := dayRows.Scan(&dayCount.Day, &dayCount.Count); err != nil {
+			return nil, err
+		}
+		stats.DayCounts = append(stats.DayCounts, dayCount)
+	}
+	if err := dayRows.Err(); err != nil {
+		return nil, err
+	}
+
+	storageRows, err := d.db.QueryContext(ctx, "SELECT storage_type, COUNT(*), COALESCE(SUM(size), 0) FROM resource GROUP BY storage_type ORDER BY storage_type")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum attachment sizes")
+	}
+	defer storageRows.Close()
+	for storageRows.Next() {
+		usage := &store.InstanceStorageUsage{}
+		if err := storageRows.Scan(&usage.StorageType, &usage.AttachmentCount, &usage.SizeBytes); err != nil {
+			return nil, err
+		}
+		stats.StorageUsages = append(stats.StorageUsages, usage)
+	}
+	if err := storageRows.Err(); err != nil {
+		return nil, err
+	}
+
+	stats.DatabaseSizeBytes = -1
+	if err := d.db.QueryRowContext(ctx, "SELECT pg_database_size(current_database())").Scan(&stats.DatabaseSizeBytes); err != nil {
+		stats.DatabaseSizeBytes = -1
+	}
+	return stats, nil
+}
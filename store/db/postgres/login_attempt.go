@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertLoginAttempt(ctx context.Context, upsert *store.LoginAttempt) (*store.LoginAttempt, error) {
+	stmt := `
+		INSERT INTO login_attempt (
+			identifier, failure_count, first_failed_ts, last_failed_ts, locked_until_ts
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT(identifier) DO UPDATE
+		SET
+			failure_count = EXCLUDED.failure_count,
+			first_failed_ts = EXCLUDED.first_failed_ts,
+			last_failed_ts = EXCLUDED.last_failed_ts,
+			locked_until_ts = EXCLUDED.locked_until_ts
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.Identifier, upsert.FailureCount, upsert.FirstFailedTs, upsert.LastFailedTs, upsert.LockedUntilTs); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) ListLoginAttempts(ctx context.Context, find *store.FindLoginAttempt) ([]*store.LoginAttempt, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Identifier != nil {
+		where, args = append(where, "identifier = "+placeholder(len(args)+1)), append(args, *find.Identifier)
+	}
+
+	query := `
+		SELECT
+			identifier,
+			failure_count,
+			first_failed_ts,
+			last_failed_ts,
+			locked_until_ts
+		FROM login_attempt
+		WHERE ` + strings.Join(where, " AND ")
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.LoginAttempt{}
+	for rows.Next() {
+		loginAttempt := &store.LoginAttempt{}
+		err := rows.Scan(
+			&loginAttempt.Identifier,
+			&loginAttempt.FailureCount,
+			&loginAttempt.FirstFailedTs,
+			&loginAttempt.LastFailedTs,
+			&loginAttempt.LockedUntilTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, loginAttempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteLoginAttempts(ctx context.Context, delete *store.DeleteLoginAttempt) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.Identifier != nil {
+		where, args = append(where, "identifier = "+placeholder(len(args)+1)), append(args, *delete.Identifier)
+	}
+	if delete.LastFailedBefore != nil {
+		where, args = append(where, "last_failed_ts < "+placeholder(len(args)+1)), append(args, *delete.LastFailedBefore)
+		where, args = append(where, "locked_until_ts < "+placeholder(len(args)+1)), append(args, *delete.LastFailedBefore)
+	}
+
+	stmt := "DELETE FROM login_attempt WHERE " + strings.Join(where, " AND ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -13,8 +14,12 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// memoNotExpiredCondition finds only the memos that haven't expired yet. The current time is
+// the time of the database, so that an expired memo is excluded as soon as its expiry passes.
+const memoNotExpiredCondition = "(memo.expire_ts = 0 OR memo.expire_ts > EXTRACT(EPOCH FROM NOW()))"
+
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"uid", "creator_id", "content", "visibility", "payload"}
+	fields := []string{"uid", "creator_id", "content", "visibility", "payload", "publish_ts", "reminder_ts", "reminder_repeat", "expire_ts", "row_status"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -23,7 +28,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.PublishTs, create.ReminderTs, create.ReminderRepeat, create.ExpireTs, create.RowStatus}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -45,7 +50,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if err != nil {
 		return nil, err
 	}
-	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectPostgres, &where, &args); err != nil {
+	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.RenderOptions{Dialect: filter.DialectPostgres, ViewerID: find.FilterViewerID}, &where, &args); err != nil {
 		return nil, err
 	}
 	if v := find.ID; v != nil {
@@ -62,6 +67,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UID; v != nil {
 		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.Slug; v != nil {
+		where, args = append(where, "memo.id IN (SELECT memo_id FROM memo_slug WHERE slug = "+placeholder(len(args)+1)+" AND (expires_ts = 0 OR expires_ts > EXTRACT(EPOCH FROM NOW())))"), append(args, *v)
+	}
 	if len(find.UIDList) > 0 {
 		holders := make([]string, 0, len(find.UIDList))
 		for _, uid := range find.UIDList {
@@ -73,9 +81,53 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if find.ExcludeSuspendedCreators {
+		where = append(where, `memo.creator_id NOT IN (SELECT id FROM "user" WHERE suspended_ts > 0)`)
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if find.Trashed {
+		where = append(where, "memo.deleted_ts > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "memo.deleted_ts = 0")
+	}
+	if v := find.DeletedBefore; v != nil {
+		where, args = append(where, "memo.deleted_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if find.Scheduled {
+		where = append(where, "memo.publish_ts > 0")
+	} else if !find.IncludeScheduled {
+		where = append(where, "memo.publish_ts = 0")
+	}
+	if v := find.PublishBefore; v != nil {
+		where, args = append(where, "memo.publish_ts <= "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ReminderDueBefore; v != nil {
+		where, args = append(where, "memo.reminder_ts > 0 AND memo.reminder_ts <= "+placeholder(len(args)+1)+" AND memo.reminder_delivered_ts < memo.reminder_ts"), append(args, *v)
+	}
+	if find.HasReminder {
+		where = append(where, "memo.reminder_ts > 0")
+	}
+	if v := find.ExpireBefore; v != nil {
+		where, args = append(where, "memo.expire_ts > 0 AND memo.expire_ts <= "+placeholder(len(args)+1)), append(args, *v)
+	} else if !find.IncludeExpired {
+		where = append(where, memoNotExpiredCondition)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "memo.created_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UpdatedTsBefore; v != nil {
+		where, args = append(where, "memo.updated_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(find.CreatedTsRanges) > 0 {
+		conditions := make([]string, 0, len(find.CreatedTsRanges))
+		for _, r := range find.CreatedTsRanges {
+			conditions = append(conditions, fmt.Sprintf("(memo.created_ts >= %s AND memo.created_ts < %s)", placeholder(len(args)+1), placeholder(len(args)+2)))
+			args = append(args, r.StartTs, r.EndTs)
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		holders := []string{}
 		for _, visibility := range v {
@@ -87,14 +139,45 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if v := find.Cursor; v != nil {
+		// Find the memos after the cursor in the ordering: pinned, then the pin order, then the
+		// timestamp, then id DESC.
+		tsColumn, tsOp := "memo.created_ts", "<"
+		if find.OrderByUpdatedTs {
+			tsColumn = "memo.updated_ts"
+		}
+		if find.OrderByTimeAsc {
+			tsOp = ">"
+		}
+		condition := fmt.Sprintf("(%s %s %s OR (%s = %s AND memo.id < %s))", tsColumn, tsOp, placeholder(len(args)+1), tsColumn, placeholder(len(args)+2), placeholder(len(args)+3))
+		args = append(args, v.Ts, v.Ts, v.ID)
+		if find.OrderByPinned {
+			condition = fmt.Sprintf("(memo.pinned < %s OR (memo.pinned = %s AND (memo.pin_order > %s OR (memo.pin_order = %s AND %s))))",
+				placeholder(len(args)+1), placeholder(len(args)+2), placeholder(len(args)+3), placeholder(len(args)+4), condition)
+			args = append(args, v.Pinned, v.Pinned, v.PinOrder, v.PinOrder)
+		}
+		where = append(where, condition)
+	}
+	searchRank, searchMatchedAttachment := "", ""
+	if v := find.SearchQuery; v != nil {
+		searchRank, searchMatchedAttachment = appendMemoSearchConditions(*v, &where, &args)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
 	orderBy := []string{}
+	if searchMatchedAttachment != "" {
+		// The memos whose content matches the whole query first.
+		orderBy = append(orderBy, searchMatchedAttachment)
+	}
+	if searchRank != "" {
+		// Most relevant first.
+		orderBy = append(orderBy, searchRank+" DESC")
+	}
 	if find.OrderByPinned {
-		orderBy = append(orderBy, "pinned DESC")
+		orderBy = append(orderBy, "pinned DESC", "pin_order ASC")
 	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "updated_ts "+order)
@@ -103,6 +186,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	}
 	// Add id as final tie-breaker
 	orderBy = append(orderBy, "id DESC")
+	if find.OrderByRandom {
+		orderBy = []string{"RANDOM()"}
+	}
 	fields := []string{
 		`memo.id AS id`,
 		`memo.uid AS uid`,
@@ -112,12 +198,23 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.pinned AS pinned`,
+		`memo.pin_order AS pin_order`,
 		`memo.payload AS payload`,
+		`memo.deleted_ts AS deleted_ts`,
+		`memo.publish_ts AS publish_ts`,
+		`memo.reminder_ts AS reminder_ts`,
+		`memo.reminder_repeat AS reminder_repeat`,
+		`memo.reminder_delivered_ts AS reminder_delivered_ts`,
+		`memo.expire_ts AS expire_ts`,
 		`CASE WHEN parent_memo.uid IS NOT NULL THEN parent_memo.uid ELSE NULL END AS parent_uid`,
+		`COALESCE((SELECT slug FROM memo_slug WHERE memo_slug.memo_id = memo.id AND memo_slug.expires_ts = 0), '') AS slug`,
 	}
 	if !find.ExcludeContent {
 		fields = append(fields, `memo.content AS content`)
 	}
+	if searchMatchedAttachment != "" {
+		fields = append(fields, searchMatchedAttachment+" AS search_matched_attachment")
+	}
 
 	query := `SELECT ` + strings.Join(fields, ", ") + `
 		FROM memo
@@ -134,6 +231,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
+		if find.SearchQuery != nil && isMemoSearchUnavailable(err) {
+			return nil, errors.Wrap(store.ErrMemoSearchUnavailable, err.Error())
+		}
 		return nil, err
 	}
 	defer rows.Close()
@@ -151,12 +251,23 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
+			&memo.PinOrder,
 			&payloadBytes,
+			&memo.DeletedTs,
+			&memo.PublishTs,
+			&memo.ReminderTs,
+			&memo.ReminderRepeat,
+			&memo.ReminderDeliveredTs,
+			&memo.ExpireTs,
 			&memo.ParentUID,
+			&memo.Slug,
 		}
 		if !find.ExcludeContent {
 			dests = append(dests, &memo.Content)
 		}
+		if find.SearchQuery != nil {
+			dests = append(dests, &memo.SearchMatchedAttachment)
+		}
 		if err := rows.Scan(dests...); err != nil {
 			return nil, err
 		}
@@ -189,6 +300,107 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return updateMemo(ctx, d.db, update)
+}
+
+// UpdateMemos updates the memos in a single transaction.
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) MergeMemo(ctx context.Context, merge *store.MergeMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+	if err := updateMemo(ctx, tx, merge.Target); err != nil {
+		return err
+	}
+	targetID, sourceID := merge.Target.ID, merge.SourceID
+	for _, stmt := range []struct {
+		query string
+		args  []any
+	}{
+		{"UPDATE resource SET memo_id = $1 WHERE memo_id = $2", []any{targetID, sourceID}},
+		// The relations that the target already has are skipped, then deleted with the others.
+		{`UPDATE memo_relation SET memo_id = $1 WHERE memo_id = $2 AND related_memo_id != $1
+			AND NOT EXISTS (SELECT 1 FROM memo_relation AS existing WHERE existing.memo_id = $1 AND existing.related_memo_id = memo_relation.related_memo_id AND existing.type = memo_relation.type)`, []any{targetID, sourceID}},
+		{`UPDATE memo_relation SET related_memo_id = $1 WHERE related_memo_id = $2 AND memo_id != $1
+			AND NOT EXISTS (SELECT 1 FROM memo_relation AS existing WHERE existing.related_memo_id = $1 AND existing.memo_id = memo_relation.memo_id AND existing.type = memo_relation.type)`, []any{targetID, sourceID}},
+		{"DELETE FROM memo_relation WHERE memo_id = $1 OR related_memo_id = $1", []any{sourceID}},
+		{`UPDATE reaction SET content_id = $1 WHERE content_id = $2
+			AND NOT EXISTS (SELECT 1 FROM reaction AS existing WHERE existing.content_id = $1 AND existing.creator_id = reaction.creator_id AND existing.reaction_type = reaction.reaction_type)`, []any{merge.TargetName, merge.SourceName}},
+		{"DELETE FROM reaction WHERE content_id = $1", []any{merge.SourceName}},
+		{"UPDATE memo SET deleted_ts = $1 WHERE id = $2", []any{merge.DeletedTs, sourceID}},
+		{"INSERT INTO memo_relation (memo_id, related_memo_id, type) VALUES ($1, $2, $3)", []any{sourceID, targetID, store.MemoRelationMerge}},
+	} {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return errors.Wrap(err, "failed to merge memo")
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) PinMemo(ctx context.Context, pin *store.PinMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+	pinnedIDs, err := listPinnedMemoIDs(ctx, tx, pin.CreatorID)
+	if err != nil {
+		return err
+	}
+	if !pin.Pinned {
+		if _, err := tx.ExecContext(ctx, "UPDATE memo SET pinned = FALSE, pin_order = 0 WHERE id = $1", pin.ID); err != nil {
+			return errors.Wrap(err, "failed to unpin memo")
+		}
+	}
+	for i, id := range pin.Reorder(pinnedIDs) {
+		if _, err := tx.ExecContext(ctx, "UPDATE memo SET pinned = TRUE, pin_order = $1 WHERE id = $2", i+1, id); err != nil {
+			return errors.Wrap(err, "failed to update pin order")
+		}
+	}
+	return tx.Commit()
+}
+
+// listPinnedMemoIDs lists the IDs of the pinned memos of a user that are neither archived nor in
+// the trash, in their order, and locks them until the end of the transaction. Ties, such as memos
+// pinned before the pin order, are newest first.
+func listPinnedMemoIDs(ctx context.Context, tx *sql.Tx, creatorID int32) ([]int32, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM memo WHERE creator_id = $1 AND pinned = TRUE AND row_status = 'NORMAL' AND deleted_ts = 0 ORDER BY pin_order ASC, id DESC FOR UPDATE", creatorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pinned memos")
+	}
+	defer rows.Close()
+	ids := []int32{}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan pinned memo")
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func updateMemo(ctx context.Context, db execer, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "uid = "+placeholder(len(args)+1)), append(args, *v)
@@ -211,6 +423,24 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Pinned; v != nil {
 		set, args = append(set, "pinned = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "deleted_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.PublishTs; v != nil {
+		set, args = append(set, "publish_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ReminderTs; v != nil {
+		set, args = append(set, "reminder_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ReminderRepeat; v != nil {
+		set, args = append(set, "reminder_repeat = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ReminderDeliveredTs; v != nil {
+		set, args = append(set, "reminder_delivered_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ExpireTs; v != nil {
+		set, args = append(set, "expire_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
@@ -224,12 +454,68 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 
 	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := db.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
 	return nil
 }
 
+func (d *DB) UpdateMemoContent(ctx context.Context, update *store.UpdateMemoContent) (bool, error) {
+	payloadBytes, err := protojson.Marshal(update.Payload)
+	if err != nil {
+		return false, err
+	}
+	stmt := "UPDATE memo SET content = $1, payload = $2, updated_ts = $3 WHERE id = $4 AND content = $5"
+	result, err := d.db.ExecContext(ctx, stmt, update.Content, string(payloadBytes), update.UpdatedTs, update.ID, update.OldContent)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) PublishMemo(ctx context.Context, publish *store.PublishMemo) (bool, error) {
+	stmt := "UPDATE memo SET publish_ts = 0, created_ts = $1, updated_ts = $2 WHERE id = $3 AND publish_ts = $4"
+	result, err := d.db.ExecContext(ctx, stmt, publish.DisplayTs, publish.DisplayTs, publish.ID, publish.PublishTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) DeliverMemoReminder(ctx context.Context, deliver *store.DeliverMemoReminder) (bool, error) {
+	stmt := "UPDATE memo SET reminder_ts = $1, reminder_delivered_ts = $2 WHERE id = $3 AND reminder_ts = $4 AND reminder_delivered_ts < reminder_ts"
+	result, err := d.db.ExecContext(ctx, stmt, deliver.NextReminderTs, deliver.DeliveredTs, deliver.ID, deliver.ReminderTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) ExpireMemo(ctx context.Context, expire *store.ExpireMemo) (bool, error) {
+	stmt := "UPDATE memo SET expire_ts = 0, deleted_ts = $1 WHERE id = $2 AND expire_ts = $3 AND deleted_ts = 0"
+	result, err := d.db.ExecContext(ctx, stmt, expire.DeletedTs, expire.ID, expire.ExpireTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"id = " + placeholder(1)}, []any{delete.ID}
 	stmt := `DELETE FROM memo WHERE ` + strings.Join(where, " AND ")
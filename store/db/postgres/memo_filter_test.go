@@ -18,12 +18,12 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "((memo.payload->'tags' @> jsonb_build_array($1::json) OR (memo.payload->'tags')::text LIKE $2) OR (memo.payload->'tags' @> jsonb_build_array($3::json) OR (memo.payload->'tags')::text LIKE $4))",
+			want:   "((memo.payload->'normalizedTags' @> jsonb_build_array($1::json) OR (memo.payload->'normalizedTags')::text LIKE $2) OR (memo.payload->'normalizedTags' @> jsonb_build_array($3::json) OR (memo.payload->'normalizedTags')::text LIKE $4))",
 			args:   []any{`"tag1"`, `%"tag1/%`, `"tag2"`, `%"tag2/%`},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT (((memo.payload->'tags' @> jsonb_build_array($1::json) OR (memo.payload->'tags')::text LIKE $2) OR (memo.payload->'tags' @> jsonb_build_array($3::json) OR (memo.payload->'tags')::text LIKE $4)))",
+			want:   "NOT (((memo.payload->'normalizedTags' @> jsonb_build_array($1::json) OR (memo.payload->'normalizedTags')::text LIKE $2) OR (memo.payload->'normalizedTags' @> jsonb_build_array($3::json) OR (memo.payload->'normalizedTags')::text LIKE $4)))",
 			args:   []any{`"tag1"`, `%"tag1/%`, `"tag2"`, `%"tag2/%`},
 		},
 		{
@@ -43,7 +43,7 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "((memo.payload->'tags' @> jsonb_build_array($1::json) OR (memo.payload->'tags')::text LIKE $2) OR memo.content ILIKE $3)",
+			want:   "((memo.payload->'normalizedTags' @> jsonb_build_array($1::json) OR (memo.payload->'normalizedTags')::text LIKE $2) OR memo.content ILIKE $3)",
 			args:   []any{`"tag1"`, `%"tag1/%`, "%hello%"},
 		},
 		{
@@ -98,22 +98,22 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `size(tags) == 0`,
-			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) = $1",
+			want:   "jsonb_array_length(COALESCE(memo.payload->'normalizedTags', '[]'::jsonb)) = $1",
 			args:   []any{int64(0)},
 		},
 		{
 			filter: `size(tags) > 0`,
-			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) > $1",
+			want:   "jsonb_array_length(COALESCE(memo.payload->'normalizedTags', '[]'::jsonb)) > $1",
 			args:   []any{int64(0)},
 		},
 		{
 			filter: `"work" in tags`,
-			want:   "memo.payload->'tags' @> jsonb_build_array($1::json)",
+			want:   "memo.payload->'normalizedTags' @> jsonb_build_array($1::json)",
 			args:   []any{`"work"`},
 		},
 		{
 			filter: `size(tags) == 2`,
-			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) = $1",
+			want:   "jsonb_array_length(COALESCE(memo.payload->'normalizedTags', '[]'::jsonb)) = $1",
 			args:   []any{int64(2)},
 		},
 		{
@@ -146,6 +146,26 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS TRUE",
 			args:   []any{},
 		},
+		{
+			filter: `has_attachment`,
+			want:   "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment == false`,
+			want:   "NOT (EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id))",
+			args:   []any{},
+		},
+		{
+			filter: `!has_attachment && has_incomplete_tasks`,
+			want:   "(NOT (EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id)) AND (memo.payload->'property'->>'hasIncompleteTasks')::boolean IS TRUE)",
+			args:   []any{},
+		},
+		{
+			filter: `location_within_box(-10, 170, 10.5, -170)`,
+			want:   "(((memo.payload->'location'->>'latitude')::double precision IS NOT NULL OR (memo.payload->'location'->>'longitude')::double precision IS NOT NULL) AND ((memo.payload->'location'->>'showPublicly')::boolean IS TRUE OR memo.creator_id = $1) AND COALESCE((memo.payload->'location'->>'latitude')::double precision, 0) >= $2 AND COALESCE((memo.payload->'location'->>'latitude')::double precision, 0) <= $3 AND (COALESCE((memo.payload->'location'->>'longitude')::double precision, 0) >= $4 OR COALESCE((memo.payload->'location'->>'longitude')::double precision, 0) <= $5))",
+			args:   []any{int32(0), -10.0, 10.5, 170.0, -170.0},
+		},
 	}
 
 	engine, err := filter.DefaultEngine()
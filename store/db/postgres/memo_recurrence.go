@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRecurrence(ctx context.Context, create *store.MemoRecurrence) (*store.MemoRecurrence, error) {
+	payload := "{}"
+	if create.Payload != nil {
+		payloadBytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = string(payloadBytes)
+	}
+
+	stmt := `
+		INSERT INTO memo_recurrence (
+			creator_id, created_ts, updated_ts, paused, next_ts, payload
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, create.CreatorID, create.CreatedTs, create.UpdatedTs, create.Paused, create.NextTs, payload).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListMemoRecurrences(ctx context.Context, find *store.FindMemoRecurrence) ([]*store.MemoRecurrence, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+	if find.DueBefore != nil {
+		where, args = append(where, "paused = FALSE", "next_ts > 0", "next_ts <= "+placeholder(len(args)+1)), append(args, *find.DueBefore)
+	}
+
+	query := `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			paused,
+			next_ts,
+			last_occurrence_ts,
+			payload
+		FROM memo_recurrence
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id ASC`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRecurrence{}
+	for rows.Next() {
+		memoRecurrence := &store.MemoRecurrence{}
+		var payloadBytes []byte
+		err := rows.Scan(
+			&memoRecurrence.ID,
+			&memoRecurrence.CreatorID,
+			&memoRecurrence.CreatedTs,
+			&memoRecurrence.UpdatedTs,
+			&memoRecurrence.Paused,
+			&memoRecurrence.NextTs,
+			&memoRecurrence.LastOccurrenceTs,
+			&payloadBytes,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payload := &storepb.MemoRecurrencePayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		memoRecurrence.Payload = payload
+		list = append(list, memoRecurrence)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoRecurrence(ctx context.Context, update *store.UpdateMemoRecurrence) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Paused; v != nil {
+		set, args = append(set, "paused = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.NextTs; v != nil {
+		set, args = append(set, "next_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		payloadBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	stmt := "UPDATE memo_recurrence SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1)
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func (d *DB) AdvanceMemoRecurrence(ctx context.Context, advance *store.AdvanceMemoRecurrence) (bool, error) {
+	stmt := "UPDATE memo_recurrence SET next_ts = $1, last_occurrence_ts = $2 WHERE id = $3 AND next_ts = $4 AND paused = FALSE"
+	result, err := d.db.ExecContext(ctx, stmt, advance.NewNextTs, advance.LastOccurrenceTs, advance.ID, advance.NextTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) DeleteMemoRecurrence(ctx context.Context, delete *store.DeleteMemoRecurrence) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_recurrence WHERE id = $1", delete.ID)
+	return err
+}
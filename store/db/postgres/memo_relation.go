@@ -61,7 +61,7 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 			return nil, err
 		}
 		if stmt.SQL != "" {
-			where = append(where, fmt.Sprintf("memo_id IN (SELECT id FROM memo WHERE %s)", stmt.SQL))
+			where = append(where, fmt.Sprintf("memo_id IN (SELECT id FROM memo WHERE deleted_ts = 0 AND %s AND (%s))", memoNotExpiredCondition, stmt.SQL))
 			args = append(args, stmt.Args...)
 
 			stmtRelated, err := engine.CompileToStatement(ctx, *find.MemoFilter, filter.RenderOptions{
@@ -72,19 +72,31 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 				return nil, err
 			}
 			if stmtRelated.SQL != "" {
-				where = append(where, fmt.Sprintf("related_memo_id IN (SELECT id FROM memo WHERE %s)", stmtRelated.SQL))
+				where = append(where, fmt.Sprintf("related_memo_id IN (SELECT id FROM memo WHERE deleted_ts = 0 AND %s AND (%s))", memoNotExpiredCondition, stmtRelated.SQL))
 				args = append(args, stmtRelated.Args...)
 			}
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	if find.ExcludeSuspendedCreators {
+		where = append(where, `memo_id NOT IN (SELECT id FROM memo WHERE creator_id IN (SELECT id FROM "user" WHERE suspended_ts > 0))`)
+		where = append(where, `related_memo_id NOT IN (SELECT id FROM memo WHERE creator_id IN (SELECT id FROM "user" WHERE suspended_ts > 0))`)
+	}
+
+	query := `
 		SELECT
 			memo_id,
 			related_memo_id,
 			type
 		FROM memo_relation
-		WHERE `+strings.Join(where, " AND "), args...)
+		WHERE ` + strings.Join(where, " AND ")
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s ORDER BY memo_id DESC LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+// appendMemoSearchConditions appends the conditions of a full-text search query to where and
+// args: every term matches the content, or the filename of an attachment of the memo. It returns
+// the expression ranking the memos by relevance, or "" if nothing ranks them, and the expression
+// of whether the content of a memo doesn't match every term. The simple text search configuration
+// doesn't split CJK text, so CJK terms are matched as substrings instead.
+func appendMemoSearchConditions(query string, where *[]string, args *[]any) (string, string) {
+	words, contentConditions := []string{}, []string{}
+	for _, term := range store.SplitMemoSearchQuery(query) {
+		contentCondition := "memo.search_vector @@ plainto_tsquery('simple', " + placeholder(len(*args)+1) + ")"
+		contentArg := term
+		if strings.IndexFunc(term, store.IsCJK) >= 0 {
+			contentCondition, contentArg = "memo.content ILIKE "+placeholder(len(*args)+1), "%"+term+"%"
+		} else {
+			words = append(words, term)
+		}
+		*args = append(*args, contentArg, store.MemoSearchFilenamePattern(term))
+		contentConditions = append(contentConditions, contentCondition)
+		*where = append(*where, "("+contentCondition+" OR "+
+			"EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.filename ILIKE "+placeholder(len(*args))+"))")
+	}
+	matchedAttachment := "NOT (" + strings.Join(contentConditions, " AND ") + ")"
+	if len(words) == 0 {
+		return "", matchedAttachment
+	}
+
+	*args = append(*args, strings.Join(words, " "))
+	return "ts_rank(memo.search_vector, plainto_tsquery('simple', " + placeholder(len(*args)) + "))", matchedAttachment
+}
+
+// isMemoSearchUnavailable reports whether err is caused by a missing memo search index, e.g.
+// on a database that hasn't been migrated.
+func isMemoSearchUnavailable(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "search_vector")
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) GetMemoStats(ctx context.Context, find *store.FindMemoStats) (*store.MemoStats, error) {
+	where, args := []string{
+		"memo.creator_id = " + placeholder(1),
+		"memo.deleted_ts = 0",
+		"memo.publish_ts = 0",
+		memoNotExpiredCondition,
+		"NOT EXISTS (SELECT 1 FROM memo_relation WHERE memo_relation.memo_id = memo.id AND memo_relation.type = 'COMMENT')",
+	}, []any{find.CreatorID}
+	if find.IncludeArchived {
+		where, args = append(where, "memo.row_status IN ("+placeholder(len(args)+1)+", "+placeholder(len(args)+2)+")"), append(args, store.Normal, store.Archived)
+	} else {
+		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, store.Normal)
+	}
+	condition := strings.Join(where, " AND ")
+
+	stats := &store.MemoStats{
+		TagCounts: map[string]int32{},
+		DayCounts: []*store.MemoDayCount{},
+	}
+	query := "SELECT COUNT(*), COALESCE(SUM((memo.payload->'property'->>'wordCount')::bigint), 0), COALESCE(SUM(LENGTH(memo.content)), 0) FROM memo WHERE " + condition
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(&stats.MemoCount, &stats.WordCount, &stats.CharacterCount); err != nil {
+		return nil, errors.Wrap(err, "failed to count memos")
+	}
+
+	query = "SELECT tag, COUNT(*) FROM memo, jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE " + condition + " GROUP BY tag"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count tags")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tag string
+		var count int32
+		if err := rows.Scan(&tag, &count); err != nil {
+			return nil, err
+		}
+		stats.TagCounts[tag] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = "SELECT FLOOR((memo.created_ts + " + placeholder(len(args)+1) + ") / 86400.0)::bigint AS day, COUNT(*) FROM memo WHERE " + condition + " GROUP BY day ORDER BY day"
+	dayRows, err := d.db.QueryContext(ctx, query, append(args, find.UTCOffsetSec)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count memos by day")
+	}
+	defer dayRows.Close()
+	for dayRows.Next() {
+		dayCount := &store.MemoDayCount{}
+		if err := dayRows.Scan(&dayCount.Day, &dayCount.Count); err != nil {
+			return nil, err
+		}
+		stats.DayCounts = append(stats.DayCounts, dayCount)
+	}
+	if err := dayRows.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (d *DB) CountMemos(ctx context.Context, find *store.FindMemoCount) (int, error) {
+	where, args := []string{"creator_id = " + placeholder(1)}, []any{find.CreatorID}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts >= "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Visibility; v != nil {
+		where, args = append(where, "visibility = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if find.ExcludeTrashed {
+		where = append(where, "deleted_ts = 0")
+	}
+	var count int
+	if err := d.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM memo WHERE "+strings.Join(where, " AND "), args...).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "failed to count memos")
+	}
+	return count, nil
+}
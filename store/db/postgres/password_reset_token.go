@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreatePasswordResetToken(ctx context.Context, create *store.PasswordResetToken) (*store.PasswordResetToken, error) {
+	stmt := `
+		INSERT INTO password_reset_token (
+			token_hash, user_id, created_ts, expires_ts
+		)
+		VALUES ($1, $2, $3, $4)
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, create.TokenHash, create.UserID, create.CreatedTs, create.ExpiresTs); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListPasswordResetTokens(ctx context.Context, find *store.FindPasswordResetToken) ([]*store.PasswordResetToken, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.TokenHash != nil {
+		where, args = append(where, "token_hash = "+placeholder(len(args)+1)), append(args, *find.TokenHash)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+
+	query := `
+		SELECT
+			token_hash,
+			user_id,
+			created_ts,
+			expires_ts
+		FROM password_reset_token
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.PasswordResetToken{}
+	for rows.Next() {
+		passwordResetToken := &store.PasswordResetToken{}
+		err := rows.Scan(
+			&passwordResetToken.TokenHash,
+			&passwordResetToken.UserID,
+			&passwordResetToken.CreatedTs,
+			&passwordResetToken.ExpiresTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, passwordResetToken)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeletePasswordResetTokens(ctx context.Context, delete *store.DeletePasswordResetToken) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.TokenHash != nil {
+		where, args = append(where, "token_hash = "+placeholder(len(args)+1)), append(args, *delete.TokenHash)
+	}
+	if delete.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *delete.UserID)
+	}
+	if delete.ExpiresBefore != nil {
+		where, args = append(where, "expires_ts < "+placeholder(len(args)+1)), append(args, *delete.ExpiresBefore)
+	}
+
+	stmt := "DELETE FROM password_reset_token WHERE " + strings.Join(where, " AND ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
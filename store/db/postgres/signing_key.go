@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateSigningKey(ctx context.Context, create *store.SigningKey) (*store.SigningKey, error) {
+	stmt := `
+		INSERT INTO signing_key (
+			id, secret, created_ts, retired_ts, expires_ts
+		)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, create.ID, create.Secret, create.CreatedTs, create.RetiredTs, create.ExpiresTs); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListSigningKeys(ctx context.Context, find *store.FindSigningKey) ([]*store.SigningKey, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+
+	query := `
+		SELECT
+			id,
+			secret,
+			created_ts,
+			retired_ts,
+			expires_ts
+		FROM signing_key
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, retired_ts ASC`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SigningKey{}
+	for rows.Next() {
+		signingKey := &store.SigningKey{}
+		err := rows.Scan(
+			&signingKey.ID,
+			&signingKey.Secret,
+			&signingKey.CreatedTs,
+			&signingKey.RetiredTs,
+			&signingKey.ExpiresTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, signingKey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateSigningKey(ctx context.Context, update *store.UpdateSigningKey) error {
+	set, args := []string{}, []any{}
+	if v := update.RetiredTs; v != nil {
+		set, args = append(set, "retired_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ExpiresTs; v != nil {
+		set, args = append(set, "expires_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := "UPDATE signing_key SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1)
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
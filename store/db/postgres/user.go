@@ -13,13 +13,14 @@ import (
 func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
 	fields := []string{"username", "role", "email", "nickname", "password_hash", "avatar_url"}
 	args := []any{create.Username, create.Role, create.Email, create.Nickname, create.PasswordHash, create.AvatarURL}
-	stmt := "INSERT INTO \"user\" (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, description, created_ts, updated_ts, row_status"
+	stmt := "INSERT INTO \"user\" (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, description, created_ts, updated_ts, row_status, suspended_ts"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.Description,
 		&create.CreatedTs,
 		&create.UpdatedTs,
 		&create.RowStatus,
+		&create.SuspendedTs,
 	); err != nil {
 		return nil, err
 	}
@@ -56,12 +57,15 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Role; v != nil {
 		set, args = append(set, "role = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.SuspendedTs; v != nil {
+		set, args = append(set, "suspended_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	query := `
 		UPDATE "user"
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, created_ts, updated_ts, row_status
+		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, created_ts, updated_ts, row_status, suspended_ts
 	`
 	args = append(args, update.ID)
 	user := &store.User{}
@@ -77,6 +81,7 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.CreatedTs,
 		&user.UpdatedTs,
 		&user.RowStatus,
+		&user.SuspendedTs,
 	); err != nil {
 		return nil, err
 	}
@@ -120,7 +125,8 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			description,
 			created_ts,
 			updated_ts,
-			row_status
+			row_status,
+			suspended_ts
 		FROM "user"
 		WHERE ` + strings.Join(where, " AND ") + ` ORDER BY ` + strings.Join(orderBy, ", ")
 	if v := find.Limit; v != nil {
@@ -147,6 +153,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.CreatedTs,
 			&user.UpdatedTs,
 			&user.RowStatus,
+			&user.SuspendedTs,
 		); err != nil {
 			return nil, err
 		}
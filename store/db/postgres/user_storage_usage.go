@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertUserStorageUsage(ctx context.Context, upsert *store.UserStorageUsage) (*store.UserStorageUsage, error) {
+	stmt := `
+		INSERT INTO user_storage_usage (user_id, used_bytes)
+		VALUES ($1, $2)
+		ON CONFLICT(user_id) DO UPDATE
+		SET used_bytes = EXCLUDED.used_bytes
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.UsedBytes); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) AddUserStorageUsage(ctx context.Context, userID int32, deltaBytes int64) error {
+	// The usage never goes below zero, even when it drifted.
+	stmt := `
+		INSERT INTO user_storage_usage (user_id, used_bytes)
+		VALUES ($1, GREATEST($2::BIGINT, 0))
+		ON CONFLICT(user_id) DO UPDATE
+		SET used_bytes = GREATEST(user_storage_usage.used_bytes + $2::BIGINT, 0)
+	`
+	_, err := d.db.ExecContext(ctx, stmt, userID, deltaBytes)
+	return err
+}
+
+func (d *DB) ListUserStorageUsages(ctx context.Context, find *store.FindUserStorageUsage) ([]*store.UserStorageUsage, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *find.UserID)
+	}
+
+	query := `
+		SELECT
+			user_id,
+			used_bytes
+		FROM user_storage_usage
+		WHERE ` + strings.Join(where, " AND ")
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserStorageUsage{}
+	for rows.Next() {
+		usage := &store.UserStorageUsage{}
+		if err := rows.Scan(&usage.UserID, &usage.UsedBytes); err != nil {
+			return nil, err
+		}
+		list = append(list, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
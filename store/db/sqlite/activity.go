@@ -26,10 +26,11 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), payloadString}
 
 	stmt := "INSERT INTO activity (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	err := d.queryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
-	); err != nil {
+	)
+	if err != nil {
 		return nil, err
 	}
 
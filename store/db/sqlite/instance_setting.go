@@ -18,7 +18,7 @@ func (d *DB) UpsertInstanceSetting(ctx context.Context, upsert *store.InstanceSe
 			value = EXCLUDED.value,
 			description = EXCLUDED.description
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.Name, upsert.Value, upsert.Description); err != nil {
+	if _, err := d.execContext(ctx, stmt, upsert.Name, upsert.Value, upsert.Description); err != nil {
 		return nil, err
 	}
 
@@ -67,6 +67,6 @@ func (d *DB) ListInstanceSettings(ctx context.Context, find *store.FindInstanceS
 
 func (d *DB) DeleteInstanceSetting(ctx context.Context, delete *store.DeleteInstanceSetting) error {
 	stmt := "DELETE FROM system_setting WHERE name = ?"
-	_, err := d.db.ExecContext(ctx, stmt, delete.Name)
+	_, err := d.execContext(ctx, stmt, delete.Name)
 	return err
 }
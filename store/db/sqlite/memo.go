@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -13,9 +14,13 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// memoNotExpiredCondition finds only the memos that haven't expired yet. The current time is
+// the time of the database, so that an expired memo is excluded as soon as its expiry passes.
+const memoNotExpiredCondition = "(`memo`.`expire_ts` = 0 OR `memo`.`expire_ts` > CAST(strftime('%s', 'now') AS INTEGER))"
+
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`", "`publish_ts`", "`reminder_ts`", "`reminder_repeat`", "`expire_ts`", "`row_status`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -24,15 +29,22 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.PublishTs, create.ReminderTs, create.ReminderRepeat, create.ExpireTs, create.RowStatus}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	err := d.queryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
 		&create.RowStatus,
-	); err != nil {
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = withBusyRetry(ctx, func() error {
+		return upsertMemoSearchIndex(ctx, d.db, create.ID, create.Content)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -46,7 +58,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if err != nil {
 		return nil, err
 	}
-	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectSQLite, &where, &args); err != nil {
+	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.RenderOptions{Dialect: filter.DialectSQLite, ViewerID: find.FilterViewerID}, &where, &args); err != nil {
 		return nil, err
 	}
 	if v := find.ID; v != nil {
@@ -65,6 +77,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UID; v != nil {
 		where, args = append(where, "`memo`.`uid` = ?"), append(args, *v)
 	}
+	if v := find.Slug; v != nil {
+		where, args = append(where, "`memo`.`id` IN (SELECT `memo_id` FROM `memo_slug` WHERE `slug` = ? AND (`expires_ts` = 0 OR `expires_ts` > CAST(strftime('%s', 'now') AS INTEGER)))"), append(args, *v)
+	}
 	if len(find.UIDList) > 0 {
 		placeholders := make([]string, 0, len(find.UIDList))
 		for range find.UIDList {
@@ -78,9 +93,52 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 	}
+	if find.ExcludeSuspendedCreators {
+		where = append(where, "`memo`.`creator_id` NOT IN (SELECT `id` FROM `user` WHERE `suspended_ts` > 0)")
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
 	}
+	if find.Trashed {
+		where = append(where, "`memo`.`deleted_ts` > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "`memo`.`deleted_ts` = 0")
+	}
+	if v := find.DeletedBefore; v != nil {
+		where, args = append(where, "`memo`.`deleted_ts` < ?"), append(args, *v)
+	}
+	if find.Scheduled {
+		where = append(where, "`memo`.`publish_ts` > 0")
+	} else if !find.IncludeScheduled {
+		where = append(where, "`memo`.`publish_ts` = 0")
+	}
+	if v := find.PublishBefore; v != nil {
+		where, args = append(where, "`memo`.`publish_ts` <= ?"), append(args, *v)
+	}
+	if v := find.ReminderDueBefore; v != nil {
+		where, args = append(where, "`memo`.`reminder_ts` > 0 AND `memo`.`reminder_ts` <= ? AND `memo`.`reminder_delivered_ts` < `memo`.`reminder_ts`"), append(args, *v)
+	}
+	if find.HasReminder {
+		where = append(where, "`memo`.`reminder_ts` > 0")
+	}
+	if v := find.ExpireBefore; v != nil {
+		where, args = append(where, "`memo`.`expire_ts` > 0 AND `memo`.`expire_ts` <= ?"), append(args, *v)
+	} else if !find.IncludeExpired {
+		where = append(where, memoNotExpiredCondition)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`created_ts` < ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`updated_ts` < ?"), append(args, *v)
+	}
+	if len(find.CreatedTsRanges) > 0 {
+		conditions := make([]string, 0, len(find.CreatedTsRanges))
+		for _, r := range find.CreatedTsRanges {
+			conditions, args = append(conditions, "(`memo`.`created_ts` >= ? AND `memo`.`created_ts` < ?)"), append(args, r.StartTs, r.EndTs)
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
 		for _, visibility := range v {
@@ -92,14 +150,43 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "`parent_uid` IS NULL")
 	}
+	if v := find.Cursor; v != nil {
+		// Find the memos after the cursor in the ordering: pinned, then the pin order, then the
+		// timestamp, then id DESC.
+		tsColumn, tsOp := "`memo`.`created_ts`", "<"
+		if find.OrderByUpdatedTs {
+			tsColumn = "`memo`.`updated_ts`"
+		}
+		if find.OrderByTimeAsc {
+			tsOp = ">"
+		}
+		condition := fmt.Sprintf("(%s %s ? OR (%s = ? AND `memo`.`id` < ?))", tsColumn, tsOp, tsColumn)
+		if find.OrderByPinned {
+			condition = fmt.Sprintf("(`memo`.`pinned` < ? OR (`memo`.`pinned` = ? AND (`memo`.`pin_order` > ? OR (`memo`.`pin_order` = ? AND %s))))", condition)
+			args = append(args, v.Pinned, v.Pinned, v.PinOrder, v.PinOrder)
+		}
+		where, args = append(where, condition), append(args, v.Ts, v.Ts, v.ID)
+	}
+	searchJoin, searchArgs := "", []any{}
+	if v := find.SearchQuery; v != nil {
+		// The memos whose content matches the whole query are ranked, before those matching
+		// through the filenames of their attachments.
+		searchJoin = "LEFT JOIN (SELECT `rowid`, `rank` FROM `memo_search` WHERE `memo_search` MATCH ?) AS `search` ON `memo`.`id` = `search`.`rowid` "
+		searchArgs = append(searchArgs, buildMemoSearchMatch(*v))
+		appendMemoSearchConditions(*v, &where, &args)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
 	orderBy := []string{}
+	if find.SearchQuery != nil {
+		// Most relevant first: FTS5 ranks better matches lower.
+		orderBy = append(orderBy, "`search`.`rank` IS NULL", "`search`.`rank` ASC")
+	}
 	if find.OrderByPinned {
-		orderBy = append(orderBy, "`pinned` DESC")
+		orderBy = append(orderBy, "`pinned` DESC", "`pin_order` ASC")
 	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
@@ -108,6 +195,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	}
 	// Add id as final tie-breaker
 	orderBy = append(orderBy, "`id` DESC")
+	if find.OrderByRandom {
+		orderBy = []string{"RANDOM()"}
+	}
 	fields := []string{
 		"`memo`.`id` AS `id`",
 		"`memo`.`uid` AS `uid`",
@@ -117,14 +207,26 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
+		"`memo`.`pin_order` AS `pin_order`",
 		"`memo`.`payload` AS `payload`",
+		"`memo`.`deleted_ts` AS `deleted_ts`",
+		"`memo`.`publish_ts` AS `publish_ts`",
+		"`memo`.`reminder_ts` AS `reminder_ts`",
+		"`memo`.`reminder_repeat` AS `reminder_repeat`",
+		"`memo`.`reminder_delivered_ts` AS `reminder_delivered_ts`",
+		"`memo`.`expire_ts` AS `expire_ts`",
 		"CASE WHEN `parent_memo`.`uid` IS NOT NULL THEN `parent_memo`.`uid` ELSE NULL END AS `parent_uid`",
+		"COALESCE((SELECT `slug` FROM `memo_slug` WHERE `memo_slug`.`memo_id` = `memo`.`id` AND `memo_slug`.`expires_ts` = 0), '') AS `slug`",
 	}
 	if !find.ExcludeContent {
 		fields = append(fields, "`memo`.`content` AS `content`")
 	}
+	if find.SearchQuery != nil {
+		fields = append(fields, "`search`.`rowid` IS NULL AS `search_matched_attachment`")
+	}
 
-	query := "SELECT " + strings.Join(fields, ", ") + "FROM `memo` " +
+	query := "SELECT " + strings.Join(fields, ", ") + " FROM `memo` " +
+		searchJoin +
 		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = \"COMMENT\" " +
 		"LEFT JOIN `memo` AS `parent_memo` ON `memo_relation`.`related_memo_id` = `parent_memo`.`id` " +
 		"WHERE " + strings.Join(where, " AND ") + " " +
@@ -136,8 +238,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.db.QueryContext(ctx, query, append(searchArgs, args...)...)
 	if err != nil {
+		if find.SearchQuery != nil && isMemoSearchUnavailable(err) {
+			return nil, errors.Wrap(store.ErrMemoSearchUnavailable, err.Error())
+		}
 		return nil, err
 	}
 	defer rows.Close()
@@ -155,12 +260,23 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
+			&memo.PinOrder,
 			&payloadBytes,
+			&memo.DeletedTs,
+			&memo.PublishTs,
+			&memo.ReminderTs,
+			&memo.ReminderRepeat,
+			&memo.ReminderDeliveredTs,
+			&memo.ExpireTs,
 			&memo.ParentUID,
+			&memo.Slug,
 		}
 		if !find.ExcludeContent {
 			dests = append(dests, &memo.Content)
 		}
+		if find.SearchQuery != nil {
+			dests = append(dests, &memo.SearchMatchedAttachment)
+		}
 		if err := rows.Scan(dests...); err != nil {
 			return nil, err
 		}
@@ -180,6 +296,111 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return withBusyRetry(ctx, func() error {
+		return updateMemo(ctx, d.db, update)
+	})
+}
+
+// UpdateMemos updates the memos in a single transaction.
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	return withBusyRetry(ctx, func() error {
+		tx, err := d.db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to begin transaction")
+		}
+		defer tx.Rollback()
+		for _, update := range updates {
+			if err := updateMemo(ctx, tx, update); err != nil {
+				return err
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+func (d *DB) MergeMemo(ctx context.Context, merge *store.MergeMemo) error {
+	return withBusyRetry(ctx, func() error {
+		tx, err := d.db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to begin transaction")
+		}
+		defer tx.Rollback()
+		if err := updateMemo(ctx, tx, merge.Target); err != nil {
+			return err
+		}
+		targetID, sourceID := merge.Target.ID, merge.SourceID
+		for _, stmt := range []struct {
+			query string
+			args  []any
+		}{
+			{"UPDATE `resource` SET `memo_id` = ? WHERE `memo_id` = ?", []any{targetID, sourceID}},
+			// The relations that the target already has are ignored, then deleted with the others.
+			{"UPDATE OR IGNORE `memo_relation` SET `memo_id` = ? WHERE `memo_id` = ? AND `related_memo_id` != ?", []any{targetID, sourceID, targetID}},
+			{"UPDATE OR IGNORE `memo_relation` SET `related_memo_id` = ? WHERE `related_memo_id` = ? AND `memo_id` != ?", []any{targetID, sourceID, targetID}},
+			{"DELETE FROM `memo_relation` WHERE `memo_id` = ? OR `related_memo_id` = ?", []any{sourceID, sourceID}},
+			{"UPDATE OR IGNORE `reaction` SET `content_id` = ? WHERE `content_id` = ?", []any{merge.TargetName, merge.SourceName}},
+			{"DELETE FROM `reaction` WHERE `content_id` = ?", []any{merge.SourceName}},
+			{"UPDATE `memo` SET `deleted_ts` = ? WHERE `id` = ?", []any{merge.DeletedTs, sourceID}},
+			{"INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?)", []any{sourceID, targetID, store.MemoRelationMerge}},
+		} {
+			if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return errors.Wrap(err, "failed to merge memo")
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+func (d *DB) PinMemo(ctx context.Context, pin *store.PinMemo) error {
+	return withBusyRetry(ctx, func() error {
+		tx, err := d.db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to begin transaction")
+		}
+		defer tx.Rollback()
+		pinnedIDs, err := listPinnedMemoIDs(ctx, tx, pin.CreatorID)
+		if err != nil {
+			return err
+		}
+		if !pin.Pinned {
+			if _, err := tx.ExecContext(ctx, "UPDATE `memo` SET `pinned` = 0, `pin_order` = 0 WHERE `id` = ?", pin.ID); err != nil {
+				return errors.Wrap(err, "failed to unpin memo")
+			}
+		}
+		for i, id := range pin.Reorder(pinnedIDs) {
+			if _, err := tx.ExecContext(ctx, "UPDATE `memo` SET `pinned` = 1, `pin_order` = ? WHERE `id` = ?", i+1, id); err != nil {
+				return errors.Wrap(err, "failed to update pin order")
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+// listPinnedMemoIDs lists the IDs of the pinned memos of a user that are neither archived nor in
+// the trash, in their order. Ties, such as memos pinned before the pin order, are newest first.
+func listPinnedMemoIDs(ctx context.Context, tx *sql.Tx, creatorID int32) ([]int32, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT `id` FROM `memo` WHERE `creator_id` = ? AND `pinned` = 1 AND `row_status` = 'NORMAL' AND `deleted_ts` = 0 ORDER BY `pin_order` ASC, `id` DESC", creatorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pinned memos")
+	}
+	defer rows.Close()
+	ids := []int32{}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan pinned memo")
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func updateMemo(ctx context.Context, db execer, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -202,6 +423,24 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Pinned; v != nil {
 		set, args = append(set, "`pinned` = ?"), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "`deleted_ts` = ?"), append(args, *v)
+	}
+	if v := update.PublishTs; v != nil {
+		set, args = append(set, "`publish_ts` = ?"), append(args, *v)
+	}
+	if v := update.ReminderTs; v != nil {
+		set, args = append(set, "`reminder_ts` = ?"), append(args, *v)
+	}
+	if v := update.ReminderRepeat; v != nil {
+		set, args = append(set, "`reminder_repeat` = ?"), append(args, *v)
+	}
+	if v := update.ReminderDeliveredTs; v != nil {
+		set, args = append(set, "`reminder_delivered_ts` = ?"), append(args, *v)
+	}
+	if v := update.ExpireTs; v != nil {
+		set, args = append(set, "`expire_ts` = ?"), append(args, *v)
+	}
 	if v := update.Payload; v != nil {
 		payloadBytes, err := protojson.Marshal(v)
 		if err != nil {
@@ -215,21 +454,102 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := db.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
+	if v := update.Content; v != nil {
+		if err := upsertMemoSearchIndex(ctx, db, update.ID, *v); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (d *DB) UpdateMemoContent(ctx context.Context, update *store.UpdateMemoContent) (bool, error) {
+	payloadBytes, err := protojson.Marshal(update.Payload)
+	if err != nil {
+		return false, err
+	}
+	updated := false
+	err = withBusyRetry(ctx, func() error {
+		tx, err := d.db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to begin transaction")
+		}
+		defer tx.Rollback()
+		stmt := "UPDATE `memo` SET `content` = ?, `payload` = ?, `updated_ts` = ? WHERE `id` = ? AND `content` = ?"
+		result, err := tx.ExecContext(ctx, stmt, update.Content, string(payloadBytes), update.UpdatedTs, update.ID, update.OldContent)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if updated = rowsAffected > 0; !updated {
+			return nil
+		}
+		if err := upsertMemoSearchIndex(ctx, tx, update.ID, update.Content); err != nil {
+			return err
+		}
+		return tx.Commit()
+	})
+	if err != nil {
+		return false, err
+	}
+	return updated, nil
+}
+
+func (d *DB) PublishMemo(ctx context.Context, publish *store.PublishMemo) (bool, error) {
+	stmt := "UPDATE `memo` SET `publish_ts` = 0, `created_ts` = ?, `updated_ts` = ? WHERE `id` = ? AND `publish_ts` = ?"
+	result, err := d.execContext(ctx, stmt, publish.DisplayTs, publish.DisplayTs, publish.ID, publish.PublishTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) DeliverMemoReminder(ctx context.Context, deliver *store.DeliverMemoReminder) (bool, error) {
+	stmt := "UPDATE `memo` SET `reminder_ts` = ?, `reminder_delivered_ts` = ? WHERE `id` = ? AND `reminder_ts` = ? AND `reminder_delivered_ts` < `reminder_ts`"
+	result, err := d.execContext(ctx, stmt, deliver.NextReminderTs, deliver.DeliveredTs, deliver.ID, deliver.ReminderTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) ExpireMemo(ctx context.Context, expire *store.ExpireMemo) (bool, error) {
+	stmt := "UPDATE `memo` SET `expire_ts` = 0, `deleted_ts` = ? WHERE `id` = ? AND `expire_ts` = ? AND `deleted_ts` = 0"
+	result, err := d.execContext(ctx, stmt, expire.DeletedTs, expire.ID, expire.ExpireTs)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
 	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.execContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
-	return nil
+	return withBusyRetry(ctx, func() error {
+		return deleteMemoSearchIndex(ctx, d.db, delete.ID)
+	})
 }
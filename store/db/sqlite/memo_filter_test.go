@@ -18,12 +18,12 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR (JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?) OR (JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?))",
 			args:   []any{`%"tag1"%`, `%"tag1/%`, `%"tag2"%`, `%"tag2/%`},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT (((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR (JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?)))",
+			want:   "NOT (((JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?) OR (JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?)))",
 			args:   []any{`%"tag1"%`, `%"tag1/%`, `%"tag2"%`, `%"tag2/%`},
 		},
 		{
@@ -43,7 +43,7 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR `memo`.`content` LIKE ?)",
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?) OR `memo`.`content` LIKE ?)",
 			args:   []any{`%"tag1"%`, `%"tag1/%`, "%hello%"},
 		},
 		{
@@ -103,22 +103,22 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `size(tags) == 0`,
-			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = ?",
+			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), JSON_ARRAY())) = ?",
 			args:   []any{int64(0)},
 		},
 		{
 			filter: `size(tags) > 0`,
-			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > ?",
+			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), JSON_ARRAY())) > ?",
 			args:   []any{int64(0)},
 		},
 		{
 			filter: `"work" in tags`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?",
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags') LIKE ?",
 			args:   []any{`%"work"%`},
 		},
 		{
 			filter: `size(tags) == 2`,
-			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = ?",
+			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.normalizedTags'), JSON_ARRAY())) = ?",
 			args:   []any{int64(2)},
 		},
 		{
@@ -151,6 +151,26 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE",
 			args:   []any{},
 		},
+		{
+			filter: `has_attachment`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+			args:   []any{},
+		},
+		{
+			filter: `has_attachment == false`,
+			want:   "NOT (EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`))",
+			args:   []any{},
+		},
+		{
+			filter: `!has_attachment && has_incomplete_tasks`,
+			want:   "(NOT (EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)) AND JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE)",
+			args:   []any{},
+		},
+		{
+			filter: `location_within_box(-10, 170, 10.5, -170)`,
+			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') IS NOT NULL OR JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') IS NOT NULL) AND (JSON_EXTRACT(`memo`.`payload`, '$.location.showPublicly') IS TRUE OR `memo`.`creator_id` = ?) AND COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) >= ? AND COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) <= ? AND (COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) >= ? OR COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) <= ?))",
+			args:   []any{int32(0), -10.0, 10.5, 170.0, -170.0},
+		},
 	}
 
 	engine, err := filter.DefaultEngine()
@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) AddMemoQuotaUsage(ctx context.Context, add *store.MemoQuotaUsage) error {
+	stmt := `
+		INSERT INTO memo_quota_usage (user_id, day_start_ts, memo_count, public_memo_count)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE
+		SET memo_count = CASE WHEN memo_quota_usage.day_start_ts < EXCLUDED.day_start_ts THEN EXCLUDED.memo_count ELSE memo_quota_usage.memo_count + EXCLUDED.memo_count END,
+			public_memo_count = CASE WHEN memo_quota_usage.day_start_ts < EXCLUDED.day_start_ts THEN EXCLUDED.public_memo_count ELSE memo_quota_usage.public_memo_count + EXCLUDED.public_memo_count END,
+			day_start_ts = MAX(memo_quota_usage.day_start_ts, EXCLUDED.day_start_ts)
+	`
+	_, err := d.execContext(ctx, stmt, add.UserID, add.DayStartTs, add.MemoCount, add.PublicMemoCount)
+	return err
+}
+
+func (d *DB) ListMemoQuotaUsages(ctx context.Context, find *store.FindMemoQuotaUsage) ([]*store.MemoQuotaUsage, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.UserID != nil {
+		where, args = append(where, "user_id = ?"), append(args, *find.UserID)
+	}
+
+	query := "SELECT user_id, day_start_ts, memo_count, public_memo_count FROM memo_quota_usage WHERE " + strings.Join(where, " AND ")
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoQuotaUsage{}
+	for rows.Next() {
+		usage := &store.MemoQuotaUsage{}
+		if err := rows.Scan(&usage.UserID, &usage.DayStartTs, &usage.MemoCount, &usage.PublicMemoCount); err != nil {
+			return nil, err
+		}
+		list = append(list, usage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -20,7 +20,7 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		RETURNING memo_id, related_memo_id, type
 	`
 	memoRelation := &store.MemoRelation{}
-	if err := d.db.QueryRowContext(
+	err := d.queryRowContext(
 		ctx,
 		stmt,
 		create.MemoID,
@@ -30,7 +30,8 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		&memoRelation.MemoID,
 		&memoRelation.RelatedMemoID,
 		&memoRelation.Type,
-	); err != nil {
+	)
+	if err != nil {
 		return nil, err
 	}
 
@@ -58,19 +59,30 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 			return nil, err
 		}
 		if stmt.SQL != "" {
-			where = append(where, fmt.Sprintf("memo_id IN (SELECT id FROM memo WHERE %s)", stmt.SQL))
-			where = append(where, fmt.Sprintf("related_memo_id IN (SELECT id FROM memo WHERE %s)", stmt.SQL))
+			where = append(where, fmt.Sprintf("memo_id IN (SELECT id FROM memo WHERE deleted_ts = 0 AND %s AND (%s))", memoNotExpiredCondition, stmt.SQL))
+			where = append(where, fmt.Sprintf("related_memo_id IN (SELECT id FROM memo WHERE deleted_ts = 0 AND %s AND (%s))", memoNotExpiredCondition, stmt.SQL))
 			args = append(args, append(stmt.Args, stmt.Args...)...)
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	if find.ExcludeSuspendedCreators {
+		where = append(where, "`memo_id` NOT IN (SELECT `id` FROM `memo` WHERE `creator_id` IN (SELECT `id` FROM `user` WHERE `suspended_ts` > 0))")
+		where = append(where, "`related_memo_id` NOT IN (SELECT `id` FROM `memo` WHERE `creator_id` IN (SELECT `id` FROM `user` WHERE `suspended_ts` > 0))")
+	}
+	query := `
 		SELECT
 			memo_id,
 			related_memo_id,
 			type
 		FROM memo_relation
-		WHERE `+strings.Join(where, " AND "), args...)
+		WHERE ` + strings.Join(where, " AND ")
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s ORDER BY memo_id DESC LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +122,7 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	stmt := `
 		DELETE FROM memo_relation
 		WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.execContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+
+	"github.com/pkg/errors"
+	"modernc.org/sqlite"
+
+	"github.com/usememos/memos/store"
+)
+
+func init() {
+	// memo_search_tokenize is used by the migration that backfills the memo search index.
+	sqlite.MustRegisterDeterministicScalarFunction("memo_search_tokenize", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		content, ok := args[0].(string)
+		if !ok {
+			return "", nil
+		}
+		return tokenizeMemoSearchContent(content), nil
+	})
+}
+
+// tokenizeMemoSearchContent prepares content for the unicode61 tokenizer of the memo_search
+// FTS5 table: each run of CJK characters is replaced by its overlapping bigrams, e.g.
+// "全文检索" becomes "全文 文检 检索", and other text is kept as is.
+func tokenizeMemoSearchContent(content string) string {
+	var b strings.Builder
+	var run []rune
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		b.WriteByte(' ')
+		if len(run) == 1 {
+			b.WriteRune(run[0])
+		}
+		for i := 0; i+1 < len(run); i++ {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(run[i])
+			b.WriteRune(run[i+1])
+		}
+		b.WriteByte(' ')
+		run = run[:0]
+	}
+	for _, r := range content {
+		if store.IsCJK(r) {
+			run = append(run, r)
+			continue
+		}
+		flush()
+		b.WriteRune(r)
+	}
+	flush()
+	return b.String()
+}
+
+// buildMemoSearchMatch returns the FTS5 MATCH expression of a search query: every term must
+// match, as a phrase of its tokens.
+func buildMemoSearchMatch(query string) string {
+	phrases := []string{}
+	for _, term := range store.SplitMemoSearchQuery(query) {
+		phrases = append(phrases, buildMemoSearchPhrase(term))
+	}
+	return strings.Join(phrases, " ")
+}
+
+// buildMemoSearchPhrase returns the FTS5 phrase of a search term. A single CJK character matches
+// the bigrams it starts.
+func buildMemoSearchPhrase(term string) string {
+	tokens := strings.TrimSpace(tokenizeMemoSearchContent(term))
+	phrase := `"` + strings.ReplaceAll(tokens, `"`, `""`) + `"`
+	if runes := []rune(tokens); len(runes) == 1 && store.IsCJK(runes[0]) {
+		phrase += "*"
+	}
+	return phrase
+}
+
+// appendMemoSearchConditions appends the conditions of a full-text search query to where and
+// args: every term matches the content, or the filename of an attachment of the memo.
+func appendMemoSearchConditions(query string, where *[]string, args *[]any) {
+	for _, term := range store.SplitMemoSearchQuery(query) {
+		*where = append(*where, "(`memo`.`id` IN (SELECT `rowid` FROM `memo_search` WHERE `memo_search` MATCH ?) OR "+
+			"EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND `resource`.`filename` LIKE ? ESCAPE '\\'))")
+		*args = append(*args, buildMemoSearchPhrase(term), store.MemoSearchFilenamePattern(term))
+	}
+}
+
+// isMemoSearchUnavailable reports whether err is caused by a missing memo search index, e.g.
+// on a database that hasn't been migrated or a SQLite build without FTS5.
+func isMemoSearchUnavailable(err error) bool {
+	return err != nil && (strings.Contains(err.Error(), "no such table: memo_search") || strings.Contains(err.Error(), "no such module: fts5"))
+}
+
+// upsertMemoSearchIndex indexes the content of a memo for search.
+func upsertMemoSearchIndex(ctx context.Context, db execer, id int32, content string) error {
+	stmt := "INSERT OR REPLACE INTO `memo_search` (`rowid`, `content`) VALUES (?, ?)"
+	if _, err := db.ExecContext(ctx, stmt, id, tokenizeMemoSearchContent(content)); err != nil && !isMemoSearchUnavailable(err) {
+		return errors.Wrap(err, "failed to index memo for search")
+	}
+	return nil
+}
+
+// deleteMemoSearchIndex removes a memo from the search index.
+func deleteMemoSearchIndex(ctx context.Context, db execer, id int32) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM `memo_search` WHERE `rowid` = ?", id); err != nil && !isMemoSearchUnavailable(err) {
+		return errors.Wrap(err, "failed to remove memo from search index")
+	}
+	return nil
+}
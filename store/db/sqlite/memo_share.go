@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShare(ctx context.Context, create *store.MemoShare) (*store.MemoShare, error) {
+	stmt := `
+		INSERT INTO memo_share (
+			uid, memo_id, creator_id, created_ts, expires_ts, max_views, passphrase_hash
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`
+	if err := d.queryRowContext(ctx, stmt, create.UID, create.MemoID, create.CreatorID, create.CreatedTs, create.ExpiresTs, create.MaxViews, create.PassphraseHash).Scan(&create.ID); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*store.MemoShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = ?"), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "uid = ?"), append(args, *find.UID)
+	}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = ?"), append(args, *find.MemoID)
+	}
+
+	query := `
+		SELECT
+			id,
+			uid,
+			memo_id,
+			creator_id,
+			created_ts,
+			expires_ts,
+			max_views,
+			view_count,
+			passphrase_hash
+		FROM memo_share
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts DESC, id DESC`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShare{}
+	for rows.Next() {
+		memoShare := &store.MemoShare{}
+		err := rows.Scan(
+			&memoShare.ID,
+			&memoShare.UID,
+			&memoShare.MemoID,
+			&memoShare.CreatorID,
+			&memoShare.CreatedTs,
+			&memoShare.ExpiresTs,
+			&memoShare.MaxViews,
+			&memoShare.ViewCount,
+			&memoShare.PassphraseHash,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, memoShare)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) ViewMemoShare(ctx context.Context, view *store.ViewMemoShare) (bool, error) {
+	stmt := "UPDATE memo_share SET view_count = view_count + 1 WHERE id = ? AND (max_views = 0 OR view_count < max_views)"
+	result, err := d.execContext(ctx, stmt, view.ID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DB) UpdateMemoShare(ctx context.Context, update *store.UpdateMemoShare) error {
+	set, args := []string{}, []any{}
+	if v := update.PassphraseHash; v != nil {
+		set, args = append(set, "passphrase_hash = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE memo_share SET " + strings.Join(set, ", ") + " WHERE id = ?"
+	_, err := d.execContext(ctx, stmt, args...)
+	return err
+}
+
+func (d *DB) DeleteMemoShares(ctx context.Context, delete *store.DeleteMemoShare) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "id = ?"), append(args, *delete.ID)
+	}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = ?"), append(args, *delete.MemoID)
+	}
+
+	stmt := "DELETE FROM memo_share WHERE " + strings.Join(where, " AND ")
+	_, err := d.execContext(ctx, stmt, args...)
+	return err
+}
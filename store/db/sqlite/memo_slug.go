@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoSlug(ctx context.Context, set *store.SetMemoSlug) error {
+	return withBusyRetry(ctx, func() error {
+		tx, err := d.db.BeginTx(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "failed to begin transaction")
+		}
+		defer tx.Rollback()
+		// The previous slugs are released once they stop redirecting.
+		if _, err := tx.ExecContext(ctx, "DELETE FROM memo_slug WHERE expires_ts > 0 AND expires_ts <= CAST(strftime('%s', 'now') AS INTEGER)"); err != nil {
+			return errors.Wrap(err, "failed to release expired memo slugs")
+		}
+		if set.Slug != "" {
+			var memoID int32
+			var expiresTs int64
+			err := tx.QueryRowContext(ctx, "SELECT memo_id, expires_ts FROM memo_slug WHERE slug = ?", set.Slug).Scan(&memoID, &expiresTs)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return errors.Wrap(err, "failed to get memo slug")
+			}
+			if err == nil {
+				if memoID != set.MemoID {
+					return store.ErrMemoSlugTaken
+				}
+				if expiresTs == 0 {
+					// The slug is already the slug of the memo.
+					return tx.Commit()
+				}
+				if _, err := tx.ExecContext(ctx, "DELETE FROM memo_slug WHERE slug = ?", set.Slug); err != nil {
+					return errors.Wrap(err, "failed to delete previous memo slug")
+				}
+			}
+		}
+		if set.RedirectExpiresTs > 0 {
+			_, err = tx.ExecContext(ctx, "UPDATE memo_slug SET expires_ts = ? WHERE memo_id = ? AND expires_ts = 0", set.RedirectExpiresTs, set.MemoID)
+		} else {
+			_, err = tx.ExecContext(ctx, "DELETE FROM memo_slug WHERE memo_id = ? AND expires_ts = 0", set.MemoID)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to update current memo slug")
+		}
+		if set.Slug != "" {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO memo_slug (slug, memo_id) VALUES (?, ?)", set.Slug, set.MemoID); err != nil {
+				return errors.Wrap(err, "failed to create memo slug")
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+func (d *DB) DeleteMemoSlugs(ctx context.Context, delete *store.DeleteMemoSlug) error {
+	_, err := d.execContext(ctx, "DELETE FROM memo_slug WHERE memo_id = ?", delete.MemoID)
+	return err
+}
@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// migrationLockStaleAfter is the age after which a migration lock is considered left behind
+	// by a crashed process and taken over.
+	migrationLockStaleAfter = 10 * time.Minute
+	// migrationLockPollInterval is how often a waiting process checks the migration lock.
+	migrationLockPollInterval = 100 * time.Millisecond
+)
+
+// LockMigration acquires the migration lock, a row in the migration_lock table, so that
+// processes sharing the database file migrate one at a time.
+func (d *DB) LockMigration(ctx context.Context) (func(), error) {
+	if _, err := d.execContext(ctx, "CREATE TABLE IF NOT EXISTS `migration_lock` (`id` INTEGER PRIMARY KEY CHECK (`id` = 1), `acquired_ts` BIGINT NOT NULL)"); err != nil {
+		return nil, errors.Wrap(err, "failed to create migration lock table")
+	}
+
+	waiting := false
+	for {
+		nowSec := time.Now().Unix()
+		staleTsSec := time.Now().Add(-migrationLockStaleAfter).Unix()
+		if _, err := d.execContext(ctx, "DELETE FROM `migration_lock` WHERE `acquired_ts` < ?", staleTsSec); err != nil {
+			return nil, errors.Wrap(err, "failed to clear stale migration lock")
+		}
+		_, err := d.execContext(ctx, "INSERT INTO `migration_lock` (`id`, `acquired_ts`) VALUES (1, ?)", nowSec)
+		if err == nil {
+			break
+		}
+		if !strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return nil, errors.Wrap(err, "failed to acquire migration lock")
+		}
+		if !waiting {
+			slog.Info("waiting for another process to finish migrating the database")
+			waiting = true
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(migrationLockPollInterval):
+		}
+	}
+
+	return func() {
+		// Release the lock even if the migration context is canceled.
+		if _, err := d.execContext(context.Background(), "DELETE FROM `migration_lock` WHERE `id` = 1"); err != nil {
+			slog.Error("failed to release migration lock", slog.String("error", err.Error()))
+		}
+	}, nil
+}
@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreatePasskeyChallenge(ctx context.Context, create *store.PasskeyChallenge) (*store.PasskeyChallenge, error) {
+	stmt := "INSERT INTO passkey_challenge (challenge_hash, expires_ts) VALUES (?, ?)"
+	if _, err := d.execContext(ctx, stmt, create.ChallengeHash, create.ExpiresTs); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) DeletePasskeyChallenges(ctx context.Context, delete *store.DeletePasskeyChallenge) (bool, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ChallengeHash != nil {
+		where, args = append(where, "challenge_hash = ?"), append(args, *delete.ChallengeHash)
+	}
+	if delete.ExpiresBefore != nil {
+		where, args = append(where, "expires_ts < ?"), append(args, *delete.ExpiresBefore)
+	}
+
+	stmt := "DELETE FROM passkey_challenge WHERE " + strings.Join(where, " AND ")
+	result, err := d.execContext(ctx, stmt, args...)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	// busyRetryAttempts is the number of times a write is attempted while the database is busy.
+	busyRetryAttempts = 5
+	// busyRetryBackoff is the wait before the first retry, doubled after each attempt.
+	busyRetryBackoff = 50 * time.Millisecond
+)
+
+// isBusy reports whether err is SQLITE_BUSY or SQLITE_LOCKED, including their extended codes,
+// i.e. the write failed because another connection holds the lock.
+func isBusy(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	code := sqliteErr.Code() & 0xff
+	return code == sqlite3.SQLITE_BUSY || code == sqlite3.SQLITE_LOCKED
+}
+
+// withBusyRetry runs the write fn, retrying it with backoff while the database is busy, which
+// can happen despite the busy timeout, e.g. when a checkpoint holds the lock.
+func withBusyRetry(ctx context.Context, fn func() error) error {
+	backoff := busyRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !isBusy(err) || attempt == busyRetryAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// execContext executes a write statement, retrying it while the database is busy.
+func (d *DB) execContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var result sql.Result
+	err := withBusyRetry(ctx, func() error {
+		var err error
+		result, err = d.db.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+// busyRetryRow is the row of a write statement with a RETURNING clause, which is run when
+// scanned, retrying it while the database is busy.
+type busyRetryRow struct {
+	ctx   context.Context
+	db    *sql.DB
+	query string
+	args  []any
+}
+
+func (r *busyRetryRow) Scan(dest ...any) error {
+	return withBusyRetry(r.ctx, func() error {
+		return r.db.QueryRowContext(r.ctx, r.query, r.args...).Scan(dest...)
+	})
+}
+
+// queryRowContext prepares a write statement with a RETURNING clause, retrying it while the
+// database is busy.
+func (d *DB) queryRowContext(ctx context.Context, query string, args ...any) *busyRetryRow {
+	return &busyRetryRow{ctx: ctx, db: d.db, query: query, args: args}
+}
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertSchemaMigration(ctx context.Context, upsert *store.SchemaMigration) (*store.SchemaMigration, error) {
+	stmt := `
+		INSERT INTO schema_migration (
+			file, version, checksum, duration_ms, applied_ts
+		)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(file) DO UPDATE
+		SET
+			version = EXCLUDED.version,
+			checksum = EXCLUDED.checksum,
+			duration_ms = EXCLUDED.duration_ms,
+			applied_ts = EXCLUDED.applied_ts
+	`
+	if _, err := d.execContext(ctx, stmt, upsert.File, upsert.Version, upsert.Checksum, upsert.DurationMs, upsert.AppliedTs); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) ListSchemaMigrations(ctx context.Context, find *store.FindSchemaMigration) ([]*store.SchemaMigration, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.File != nil {
+		where, args = append(where, "file = ?"), append(args, *find.File)
+	}
+
+	query := `
+		SELECT
+			file,
+			version,
+			checksum,
+			duration_ms,
+			applied_ts
+		FROM schema_migration
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY applied_ts ASC, file ASC`
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SchemaMigration{}
+	for rows.Next() {
+		schemaMigration := &store.SchemaMigration{}
+		err := rows.Scan(
+			&schemaMigration.File,
+			&schemaMigration.Version,
+			&schemaMigration.Checksum,
+			&schemaMigration.DurationMs,
+			&schemaMigration.AppliedTs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, schemaMigration)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
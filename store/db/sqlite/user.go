@@ -14,14 +14,16 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 	fields := []string{"`username`", "`role`", "`email`", "`nickname`", "`password_hash`, `avatar_url`"}
 	placeholder := []string{"?", "?", "?", "?", "?", "?"}
 	args := []any{create.Username, create.Role, create.Email, create.Nickname, create.PasswordHash, create.AvatarURL}
-	stmt := "INSERT INTO user (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING id, description, created_ts, updated_ts, row_status"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	stmt := "INSERT INTO user (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING id, description, created_ts, updated_ts, row_status, suspended_ts"
+	err := d.queryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.Description,
 		&create.CreatedTs,
 		&create.UpdatedTs,
 		&create.RowStatus,
-	); err != nil {
+		&create.SuspendedTs,
+	)
+	if err != nil {
 		return nil, err
 	}
 
@@ -57,16 +59,19 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Role; v != nil {
 		set, args = append(set, "role = ?"), append(args, *v)
 	}
+	if v := update.SuspendedTs; v != nil {
+		set, args = append(set, "suspended_ts = ?"), append(args, *v)
+	}
 	args = append(args, update.ID)
 
 	query := `
 		UPDATE user
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ?
-		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, created_ts, updated_ts, row_status
+		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, created_ts, updated_ts, row_status, suspended_ts
 	`
 	user := &store.User{}
-	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+	err := d.queryRowContext(ctx, query, args...).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Role,
@@ -78,7 +83,9 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.CreatedTs,
 		&user.UpdatedTs,
 		&user.RowStatus,
-	); err != nil {
+		&user.SuspendedTs,
+	)
+	if err != nil {
 		return nil, err
 	}
 
@@ -121,7 +128,8 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			description,
 			created_ts,
 			updated_ts,
-			row_status
+			row_status,
+			suspended_ts
 		FROM user
 		WHERE ` + strings.Join(where, " AND ") + ` ORDER BY ` + strings.Join(orderBy, ", ")
 	if v := find.Limit; v != nil {
@@ -149,6 +157,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.CreatedTs,
 			&user.UpdatedTs,
 			&user.RowStatus,
+			&user.SuspendedTs,
 		); err != nil {
 			return nil, err
 		}
@@ -163,7 +172,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 }
 
 func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	result, err := d.db.ExecContext(ctx, `
+	result, err := d.execContext(ctx, `
 		DELETE FROM user WHERE id = ?
 	`, delete.ID)
 	if err != nil {
@@ -17,7 +17,7 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *store.UserSetting) (
 		ON CONFLICT(user_id, key) DO UPDATE 
 		SET value = EXCLUDED.value
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
+	if _, err := d.execContext(ctx, stmt, upsert.UserID, upsert.Key.String(), upsert.Value); err != nil {
 		return nil, err
 	}
 	return upsert, nil
@@ -20,20 +20,55 @@ type Driver interface {
 	// Attachment model related methods.
 	CreateAttachment(ctx context.Context, create *Attachment) (*Attachment, error)
 	ListAttachments(ctx context.Context, find *FindAttachment) ([]*Attachment, error)
+	CountAttachments(ctx context.Context, find *FindAttachment) (int, error)
 	UpdateAttachment(ctx context.Context, update *UpdateAttachment) error
 	DeleteAttachment(ctx context.Context, delete *DeleteAttachment) error
+	DeleteOrphanedAttachment(ctx context.Context, delete *DeleteOrphanedAttachment) (bool, error)
 
 	// Memo model related methods.
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
+	UpdateMemos(ctx context.Context, updates []*UpdateMemo) error
+	MergeMemo(ctx context.Context, merge *MergeMemo) error
+	PinMemo(ctx context.Context, pin *PinMemo) error
+	UpdateMemoContent(ctx context.Context, update *UpdateMemoContent) (bool, error)
+	PublishMemo(ctx context.Context, publish *PublishMemo) (bool, error)
+	DeliverMemoReminder(ctx context.Context, deliver *DeliverMemoReminder) (bool, error)
+	ExpireMemo(ctx context.Context, expire *ExpireMemo) (bool, error)
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
+	GetMemoStats(ctx context.Context, find *FindMemoStats) (*MemoStats, error)
+	CountMemos(ctx context.Context, find *FindMemoCount) (int, error)
+	GetInstanceStats(ctx context.Context, find *FindInstanceStats) (*InstanceStats, error)
 
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevisions(ctx context.Context, delete *DeleteMemoRevision) error
+
+	// MemoShare model related methods.
+	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	ViewMemoShare(ctx context.Context, view *ViewMemoShare) (bool, error)
+	UpdateMemoShare(ctx context.Context, update *UpdateMemoShare) error
+	DeleteMemoShares(ctx context.Context, delete *DeleteMemoShare) error
+
+	// MemoSlug model related methods.
+	SetMemoSlug(ctx context.Context, set *SetMemoSlug) error
+	DeleteMemoSlugs(ctx context.Context, delete *DeleteMemoSlug) error
+
+	// MemoRecurrence model related methods.
+	CreateMemoRecurrence(ctx context.Context, create *MemoRecurrence) (*MemoRecurrence, error)
+	ListMemoRecurrences(ctx context.Context, find *FindMemoRecurrence) ([]*MemoRecurrence, error)
+	UpdateMemoRecurrence(ctx context.Context, update *UpdateMemoRecurrence) error
+	AdvanceMemoRecurrence(ctx context.Context, advance *AdvanceMemoRecurrence) (bool, error)
+	DeleteMemoRecurrence(ctx context.Context, delete *DeleteMemoRecurrence) error
+
 	// InstanceSetting model related methods.
 	UpsertInstanceSetting(ctx context.Context, upsert *InstanceSetting) (*InstanceSetting, error)
 	ListInstanceSettings(ctx context.Context, find *FindInstanceSetting) ([]*InstanceSetting, error)
@@ -49,6 +84,15 @@ type Driver interface {
 	UpsertUserSetting(ctx context.Context, upsert *UserSetting) (*UserSetting, error)
 	ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*UserSetting, error)
 
+	// UserStorageUsage model related methods.
+	UpsertUserStorageUsage(ctx context.Context, upsert *UserStorageUsage) (*UserStorageUsage, error)
+	AddUserStorageUsage(ctx context.Context, userID int32, deltaBytes int64) error
+	ListUserStorageUsages(ctx context.Context, find *FindUserStorageUsage) ([]*UserStorageUsage, error)
+
+	// MemoQuotaUsage model related methods.
+	AddMemoQuotaUsage(ctx context.Context, add *MemoQuotaUsage) error
+	ListMemoQuotaUsages(ctx context.Context, find *FindMemoQuotaUsage) ([]*MemoQuotaUsage, error)
+
 	// IdentityProvider model related methods.
 	CreateIdentityProvider(ctx context.Context, create *IdentityProvider) (*IdentityProvider, error)
 	ListIdentityProviders(ctx context.Context, find *FindIdentityProvider) ([]*IdentityProvider, error)
@@ -61,9 +105,50 @@ type Driver interface {
 	UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error)
 	DeleteInbox(ctx context.Context, delete *DeleteInbox) error
 
+	// LoginAttempt model related methods.
+	UpsertLoginAttempt(ctx context.Context, upsert *LoginAttempt) (*LoginAttempt, error)
+	ListLoginAttempts(ctx context.Context, find *FindLoginAttempt) ([]*LoginAttempt, error)
+	DeleteLoginAttempts(ctx context.Context, delete *DeleteLoginAttempt) error
+
+	// RefreshToken model related methods.
+	CreateRefreshToken(ctx context.Context, create *RefreshToken) (*RefreshToken, error)
+	ListRefreshTokens(ctx context.Context, find *FindRefreshToken) ([]*RefreshToken, error)
+	UpdateRefreshToken(ctx context.Context, update *UpdateRefreshToken) error
+	DeleteRefreshTokens(ctx context.Context, delete *DeleteRefreshToken) error
+
+	// PasswordResetToken model related methods.
+	CreatePasswordResetToken(ctx context.Context, create *PasswordResetToken) (*PasswordResetToken, error)
+	ListPasswordResetTokens(ctx context.Context, find *FindPasswordResetToken) ([]*PasswordResetToken, error)
+	DeletePasswordResetTokens(ctx context.Context, delete *DeletePasswordResetToken) error
+
+	// PasskeyChallenge model related methods.
+	CreatePasskeyChallenge(ctx context.Context, create *PasskeyChallenge) (*PasskeyChallenge, error)
+	DeletePasskeyChallenges(ctx context.Context, delete *DeletePasskeyChallenge) (bool, error)
+
+	// SigningKey model related methods.
+	CreateSigningKey(ctx context.Context, create *SigningKey) (*SigningKey, error)
+	ListSigningKeys(ctx context.Context, find *FindSigningKey) ([]*SigningKey, error)
+	UpdateSigningKey(ctx context.Context, update *UpdateSigningKey) error
+
+	// AuditLog model related methods.
+	CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error)
+	ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error)
+	DeleteAuditLogs(ctx context.Context, delete *DeleteAuditLog) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error
+	ClaimWebhookDelivery(ctx context.Context, claim *ClaimWebhookDelivery) (bool, error)
+	DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error
+
 	// Reaction model related methods.
 	UpsertReaction(ctx context.Context, create *Reaction) (*Reaction, error)
 	ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error)
 	GetReaction(ctx context.Context, find *FindReaction) (*Reaction, error)
 	DeleteReaction(ctx context.Context, delete *DeleteReaction) error
+
+	// SchemaMigration model related methods.
+	UpsertSchemaMigration(ctx context.Context, upsert *SchemaMigration) (*SchemaMigration, error)
+	ListSchemaMigrations(ctx context.Context, find *FindSchemaMigration) ([]*SchemaMigration, error)
 }
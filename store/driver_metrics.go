@@ -0,0 +1,482 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/usememos/memos/internal/metrics"
+)
+
+// metricsDriver is a Driver recording the duration of its operations in the store metrics.
+type metricsDriver struct {
+	Driver
+}
+
+// unwrapDriver returns the driver wrapped by the metrics driver, e.g. to check for the optional
+// interfaces it implements.
+func unwrapDriver(driver Driver) Driver {
+	if wrapped, ok := driver.(*metricsDriver); ok {
+		return wrapped.Driver
+	}
+	return driver
+}
+
+func (d *metricsDriver) IsInitialized(ctx context.Context) (bool, error) {
+	defer metrics.ObserveStoreOperation("IsInitialized", time.Now())
+	return d.Driver.IsInitialized(ctx)
+}
+
+func (d *metricsDriver) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
+	defer metrics.ObserveStoreOperation("CreateActivity", time.Now())
+	return d.Driver.CreateActivity(ctx, create)
+}
+
+func (d *metricsDriver) ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error) {
+	defer metrics.ObserveStoreOperation("ListActivities", time.Now())
+	return d.Driver.ListActivities(ctx, find)
+}
+
+func (d *metricsDriver) CreateAttachment(ctx context.Context, create *Attachment) (*Attachment, error) {
+	defer metrics.ObserveStoreOperation("CreateAttachment", time.Now())
+	return d.Driver.CreateAttachment(ctx, create)
+}
+
+func (d *metricsDriver) ListAttachments(ctx context.Context, find *FindAttachment) ([]*Attachment, error) {
+	defer metrics.ObserveStoreOperation("ListAttachments", time.Now())
+	return d.Driver.ListAttachments(ctx, find)
+}
+
+func (d *metricsDriver) CountAttachments(ctx context.Context, find *FindAttachment) (int, error) {
+	defer metrics.ObserveStoreOperation("CountAttachments", time.Now())
+	return d.Driver.CountAttachments(ctx, find)
+}
+
+func (d *metricsDriver) UpdateAttachment(ctx context.Context, update *UpdateAttachment) error {
+	defer metrics.ObserveStoreOperation("UpdateAttachment", time.Now())
+	return d.Driver.UpdateAttachment(ctx, update)
+}
+
+func (d *metricsDriver) DeleteAttachment(ctx context.Context, delete *DeleteAttachment) error {
+	defer metrics.ObserveStoreOperation("DeleteAttachment", time.Now())
+	return d.Driver.DeleteAttachment(ctx, delete)
+}
+
+func (d *metricsDriver) DeleteOrphanedAttachment(ctx context.Context, delete *DeleteOrphanedAttachment) (bool, error) {
+	defer metrics.ObserveStoreOperation("DeleteOrphanedAttachment", time.Now())
+	return d.Driver.DeleteOrphanedAttachment(ctx, delete)
+}
+
+func (d *metricsDriver) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
+	defer metrics.ObserveStoreOperation("CreateMemo", time.Now())
+	return d.Driver.CreateMemo(ctx, create)
+}
+
+func (d *metricsDriver) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
+	defer metrics.ObserveStoreOperation("ListMemos", time.Now())
+	return d.Driver.ListMemos(ctx, find)
+}
+
+func (d *metricsDriver) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
+	defer metrics.ObserveStoreOperation("UpdateMemo", time.Now())
+	return d.Driver.UpdateMemo(ctx, update)
+}
+
+func (d *metricsDriver) UpdateMemos(ctx context.Context, updates []*UpdateMemo) error {
+	defer metrics.ObserveStoreOperation("UpdateMemos", time.Now())
+	return d.Driver.UpdateMemos(ctx, updates)
+}
+
+func (d *metricsDriver) MergeMemo(ctx context.Context, merge *MergeMemo) error {
+	defer metrics.ObserveStoreOperation("MergeMemo", time.Now())
+	return d.Driver.MergeMemo(ctx, merge)
+}
+
+func (d *metricsDriver) PinMemo(ctx context.Context, pin *PinMemo) error {
+	defer metrics.ObserveStoreOperation("PinMemo", time.Now())
+	return d.Driver.PinMemo(ctx, pin)
+}
+
+func (d *metricsDriver) UpdateMemoContent(ctx context.Context, update *UpdateMemoContent) (bool, error) {
+	defer metrics.ObserveStoreOperation("UpdateMemoContent", time.Now())
+	return d.Driver.UpdateMemoContent(ctx, update)
+}
+
+func (d *metricsDriver) PublishMemo(ctx context.Context, publish *PublishMemo) (bool, error) {
+	defer metrics.ObserveStoreOperation("PublishMemo", time.Now())
+	return d.Driver.PublishMemo(ctx, publish)
+}
+
+func (d *metricsDriver) DeliverMemoReminder(ctx context.Context, deliver *DeliverMemoReminder) (bool, error) {
+	defer metrics.ObserveStoreOperation("DeliverMemoReminder", time.Now())
+	return d.Driver.DeliverMemoReminder(ctx, deliver)
+}
+
+func (d *metricsDriver) ExpireMemo(ctx context.Context, expire *ExpireMemo) (bool, error) {
+	defer metrics.ObserveStoreOperation("ExpireMemo", time.Now())
+	return d.Driver.ExpireMemo(ctx, expire)
+}
+
+func (d *metricsDriver) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
+	defer metrics.ObserveStoreOperation("DeleteMemo", time.Now())
+	return d.Driver.DeleteMemo(ctx, delete)
+}
+
+func (d *metricsDriver) GetMemoStats(ctx context.Context, find *FindMemoStats) (*MemoStats, error) {
+	defer metrics.ObserveStoreOperation("GetMemoStats", time.Now())
+	return d.Driver.GetMemoStats(ctx, find)
+}
+
+func (d *metricsDriver) CountMemos(ctx context.Context, find *FindMemoCount) (int, error) {
+	defer metrics.ObserveStoreOperation("CountMemos", time.Now())
+	return d.Driver.CountMemos(ctx, find)
+}
+
+func (d *metricsDriver) GetInstanceStats(ctx context.Context, find *FindInstanceStats) (*InstanceStats, error) {
+	defer metrics.ObserveStoreOperation("GetInstanceStats", time.Now())
+	return d.Driver.GetInstanceStats(ctx, find)
+}
+
+func (d *metricsDriver) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
+	defer metrics.ObserveStoreOperation("UpsertMemoRelation", time.Now())
+	return d.Driver.UpsertMemoRelation(ctx, create)
+}
+
+func (d *metricsDriver) ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error) {
+	defer metrics.ObserveStoreOperation("ListMemoRelations", time.Now())
+	return d.Driver.ListMemoRelations(ctx, find)
+}
+
+func (d *metricsDriver) DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error {
+	defer metrics.ObserveStoreOperation("DeleteMemoRelation", time.Now())
+	return d.Driver.DeleteMemoRelation(ctx, delete)
+}
+
+func (d *metricsDriver) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	defer metrics.ObserveStoreOperation("CreateMemoRevision", time.Now())
+	return d.Driver.CreateMemoRevision(ctx, create)
+}
+
+func (d *metricsDriver) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	defer metrics.ObserveStoreOperation("ListMemoRevisions", time.Now())
+	return d.Driver.ListMemoRevisions(ctx, find)
+}
+
+func (d *metricsDriver) DeleteMemoRevisions(ctx context.Context, delete *DeleteMemoRevision) error {
+	defer metrics.ObserveStoreOperation("DeleteMemoRevisions", time.Now())
+	return d.Driver.DeleteMemoRevisions(ctx, delete)
+}
+
+func (d *metricsDriver) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	defer metrics.ObserveStoreOperation("CreateMemoShare", time.Now())
+	return d.Driver.CreateMemoShare(ctx, create)
+}
+
+func (d *metricsDriver) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	defer metrics.ObserveStoreOperation("ListMemoShares", time.Now())
+	return d.Driver.ListMemoShares(ctx, find)
+}
+
+func (d *metricsDriver) ViewMemoShare(ctx context.Context, view *ViewMemoShare) (bool, error) {
+	defer metrics.ObserveStoreOperation("ViewMemoShare", time.Now())
+	return d.Driver.ViewMemoShare(ctx, view)
+}
+
+func (d *metricsDriver) UpdateMemoShare(ctx context.Context, update *UpdateMemoShare) error {
+	defer metrics.ObserveStoreOperation("UpdateMemoShare", time.Now())
+	return d.Driver.UpdateMemoShare(ctx, update)
+}
+
+func (d *metricsDriver) DeleteMemoShares(ctx context.Context, delete *DeleteMemoShare) error {
+	defer metrics.ObserveStoreOperation("DeleteMemoShares", time.Now())
+	return d.Driver.DeleteMemoShares(ctx, delete)
+}
+
+func (d *metricsDriver) SetMemoSlug(ctx context.Context, set *SetMemoSlug) error {
+	defer metrics.ObserveStoreOperation("SetMemoSlug", time.Now())
+	return d.Driver.SetMemoSlug(ctx, set)
+}
+
+func (d *metricsDriver) DeleteMemoSlugs(ctx context.Context, delete *DeleteMemoSlug) error {
+	defer metrics.ObserveStoreOperation("DeleteMemoSlugs", time.Now())
+	return d.Driver.DeleteMemoSlugs(ctx, delete)
+}
+
+func (d *metricsDriver) CreateMemoRecurrence(ctx context.Context, create *MemoRecurrence) (*MemoRecurrence, error) {
+	defer metrics.ObserveStoreOperation("CreateMemoRecurrence", time.Now())
+	return d.Driver.CreateMemoRecurrence(ctx, create)
+}
+
+func (d *metricsDriver) ListMemoRecurrences(ctx context.Context, find *FindMemoRecurrence) ([]*MemoRecurrence, error) {
+	defer metrics.ObserveStoreOperation("ListMemoRecurrences", time.Now())
+	return d.Driver.ListMemoRecurrences(ctx, find)
+}
+
+func (d *metricsDriver) UpdateMemoRecurrence(ctx context.Context, update *UpdateMemoRecurrence) error {
+	defer metrics.ObserveStoreOperation("UpdateMemoRecurrence", time.Now())
+	return d.Driver.UpdateMemoRecurrence(ctx, update)
+}
+
+func (d *metricsDriver) AdvanceMemoRecurrence(ctx context.Context, advance *AdvanceMemoRecurrence) (bool, error) {
+	defer metrics.ObserveStoreOperation("AdvanceMemoRecurrence", time.Now())
+	return d.Driver.AdvanceMemoRecurrence(ctx, advance)
+}
+
+func (d *metricsDriver) DeleteMemoRecurrence(ctx context.Context, delete *DeleteMemoRecurrence) error {
+	defer metrics.ObserveStoreOperation("DeleteMemoRecurrence", time.Now())
+	return d.Driver.DeleteMemoRecurrence(ctx, delete)
+}
+
+func (d *metricsDriver) UpsertInstanceSetting(ctx context.Context, upsert *InstanceSetting) (*InstanceSetting, error) {
+	defer metrics.ObserveStoreOperation("UpsertInstanceSetting", time.Now())
+	return d.Driver.UpsertInstanceSetting(ctx, upsert)
+}
+
+func (d *metricsDriver) ListInstanceSettings(ctx context.Context, find *FindInstanceSetting) ([]*InstanceSetting, error) {
+	defer metrics.ObserveStoreOperation("ListInstanceSettings", time.Now())
+	return d.Driver.ListInstanceSettings(ctx, find)
+}
+
+func (d *metricsDriver) DeleteInstanceSetting(ctx context.Context, delete *DeleteInstanceSetting) error {
+	defer metrics.ObserveStoreOperation("DeleteInstanceSetting", time.Now())
+	return d.Driver.DeleteInstanceSetting(ctx, delete)
+}
+
+func (d *metricsDriver) CreateUser(ctx context.Context, create *User) (*User, error) {
+	defer metrics.ObserveStoreOperation("CreateUser", time.Now())
+	return d.Driver.CreateUser(ctx, create)
+}
+
+func (d *metricsDriver) UpdateUser(ctx context.Context, update *UpdateUser) (*User, error) {
+	defer metrics.ObserveStoreOperation("UpdateUser", time.Now())
+	return d.Driver.UpdateUser(ctx, update)
+}
+
+func (d *metricsDriver) ListUsers(ctx context.Context, find *FindUser) ([]*User, error) {
+	defer metrics.ObserveStoreOperation("ListUsers", time.Now())
+	return d.Driver.ListUsers(ctx, find)
+}
+
+func (d *metricsDriver) DeleteUser(ctx context.Context, delete *DeleteUser) error {
+	defer metrics.ObserveStoreOperation("DeleteUser", time.Now())
+	return d.Driver.DeleteUser(ctx, delete)
+}
+
+func (d *metricsDriver) UpsertUserSetting(ctx context.Context, upsert *UserSetting) (*UserSetting, error) {
+	defer metrics.ObserveStoreOperation("UpsertUserSetting", time.Now())
+	return d.Driver.UpsertUserSetting(ctx, upsert)
+}
+
+func (d *metricsDriver) ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*UserSetting, error) {
+	defer metrics.ObserveStoreOperation("ListUserSettings", time.Now())
+	return d.Driver.ListUserSettings(ctx, find)
+}
+
+func (d *metricsDriver) UpsertUserStorageUsage(ctx context.Context, upsert *UserStorageUsage) (*UserStorageUsage, error) {
+	defer metrics.ObserveStoreOperation("UpsertUserStorageUsage", time.Now())
+	return d.Driver.UpsertUserStorageUsage(ctx, upsert)
+}
+
+func (d *metricsDriver) AddUserStorageUsage(ctx context.Context, userID int32, deltaBytes int64) error {
+	defer metrics.ObserveStoreOperation("AddUserStorageUsage", time.Now())
+	return d.Driver.AddUserStorageUsage(ctx, userID, deltaBytes)
+}
+
+func (d *metricsDriver) ListUserStorageUsages(ctx context.Context, find *FindUserStorageUsage) ([]*UserStorageUsage, error) {
+	defer metrics.ObserveStoreOperation("ListUserStorageUsages", time.Now())
+	return d.Driver.ListUserStorageUsages(ctx, find)
+}
+
+func (d *metricsDriver) AddMemoQuotaUsage(ctx context.Context, add *MemoQuotaUsage) error {
+	defer metrics.ObserveStoreOperation("AddMemoQuotaUsage", time.Now())
+	return d.Driver.AddMemoQuotaUsage(ctx, add)
+}
+
+func (d *metricsDriver) ListMemoQuotaUsages(ctx context.Context, find *FindMemoQuotaUsage) ([]*MemoQuotaUsage, error) {
+	defer metrics.ObserveStoreOperation("ListMemoQuotaUsages", time.Now())
+	return d.Driver.ListMemoQuotaUsages(ctx, find)
+}
+
+func (d *metricsDriver) CreateIdentityProvider(ctx context.Context, create *IdentityProvider) (*IdentityProvider, error) {
+	defer metrics.ObserveStoreOperation("CreateIdentityProvider", time.Now())
+	return d.Driver.CreateIdentityProvider(ctx, create)
+}
+
+func (d *metricsDriver) ListIdentityProviders(ctx context.Context, find *FindIdentityProvider) ([]*IdentityProvider, error) {
+	defer metrics.ObserveStoreOperation("ListIdentityProviders", time.Now())
+	return d.Driver.ListIdentityProviders(ctx, find)
+}
+
+func (d *metricsDriver) UpdateIdentityProvider(ctx context.Context, update *UpdateIdentityProvider) (*IdentityProvider, error) {
+	defer metrics.ObserveStoreOperation("UpdateIdentityProvider", time.Now())
+	return d.Driver.UpdateIdentityProvider(ctx, update)
+}
+
+func (d *metricsDriver) DeleteIdentityProvider(ctx context.Context, delete *DeleteIdentityProvider) error {
+	defer metrics.ObserveStoreOperation("DeleteIdentityProvider", time.Now())
+	return d.Driver.DeleteIdentityProvider(ctx, delete)
+}
+
+func (d *metricsDriver) CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error) {
+	defer metrics.ObserveStoreOperation("CreateInbox", time.Now())
+	return d.Driver.CreateInbox(ctx, create)
+}
+
+func (d *metricsDriver) ListInboxes(ctx context.Context, find *FindInbox) ([]*Inbox, error) {
+	defer metrics.ObserveStoreOperation("ListInboxes", time.Now())
+	return d.Driver.ListInboxes(ctx, find)
+}
+
+func (d *metricsDriver) UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error) {
+	defer metrics.ObserveStoreOperation("UpdateInbox", time.Now())
+	return d.Driver.UpdateInbox(ctx, update)
+}
+
+func (d *metricsDriver) DeleteInbox(ctx context.Context, delete *DeleteInbox) error {
+	defer metrics.ObserveStoreOperation("DeleteInbox", time.Now())
+	return d.Driver.DeleteInbox(ctx, delete)
+}
+
+func (d *metricsDriver) UpsertLoginAttempt(ctx context.Context, upsert *LoginAttempt) (*LoginAttempt, error) {
+	defer metrics.ObserveStoreOperation("UpsertLoginAttempt", time.Now())
+	return d.Driver.UpsertLoginAttempt(ctx, upsert)
+}
+
+func (d *metricsDriver) ListLoginAttempts(ctx context.Context, find *FindLoginAttempt) ([]*LoginAttempt, error) {
+	defer metrics.ObserveStoreOperation("ListLoginAttempts", time.Now())
+	return d.Driver.ListLoginAttempts(ctx, find)
+}
+
+func (d *metricsDriver) DeleteLoginAttempts(ctx context.Context, delete *DeleteLoginAttempt) error {
+	defer metrics.ObserveStoreOperation("DeleteLoginAttempts", time.Now())
+	return d.Driver.DeleteLoginAttempts(ctx, delete)
+}
+
+func (d *metricsDriver) CreateRefreshToken(ctx context.Context, create *RefreshToken) (*RefreshToken, error) {
+	defer metrics.ObserveStoreOperation("CreateRefreshToken", time.Now())
+	return d.Driver.CreateRefreshToken(ctx, create)
+}
+
+func (d *metricsDriver) ListRefreshTokens(ctx context.Context, find *FindRefreshToken) ([]*RefreshToken, error) {
+	defer metrics.ObserveStoreOperation("ListRefreshTokens", time.Now())
+	return d.Driver.ListRefreshTokens(ctx, find)
+}
+
+func (d *metricsDriver) UpdateRefreshToken(ctx context.Context, update *UpdateRefreshToken) error {
+	defer metrics.ObserveStoreOperation("UpdateRefreshToken", time.Now())
+	return d.Driver.UpdateRefreshToken(ctx, update)
+}
+
+func (d *metricsDriver) DeleteRefreshTokens(ctx context.Context, delete *DeleteRefreshToken) error {
+	defer metrics.ObserveStoreOperation("DeleteRefreshTokens", time.Now())
+	return d.Driver.DeleteRefreshTokens(ctx, delete)
+}
+
+func (d *metricsDriver) CreatePasswordResetToken(ctx context.Context, create *PasswordResetToken) (*PasswordResetToken, error) {
+	defer metrics.ObserveStoreOperation("CreatePasswordResetToken", time.Now())
+	return d.Driver.CreatePasswordResetToken(ctx, create)
+}
+
+func (d *metricsDriver) ListPasswordResetTokens(ctx context.Context, find *FindPasswordResetToken) ([]*PasswordResetToken, error) {
+	defer metrics.ObserveStoreOperation("ListPasswordResetTokens", time.Now())
+	return d.Driver.ListPasswordResetTokens(ctx, find)
+}
+
+func (d *metricsDriver) DeletePasswordResetTokens(ctx context.Context, delete *DeletePasswordResetToken) error {
+	defer metrics.ObserveStoreOperation("DeletePasswordResetTokens", time.Now())
+	return d.Driver.DeletePasswordResetTokens(ctx, delete)
+}
+
+func (d *metricsDriver) CreatePasskeyChallenge(ctx context.Context, create *PasskeyChallenge) (*PasskeyChallenge, error) {
+	defer metrics.ObserveStoreOperation("CreatePasskeyChallenge", time.Now())
+	return d.Driver.CreatePasskeyChallenge(ctx, create)
+}
+
+func (d *metricsDriver) DeletePasskeyChallenges(ctx context.Context, delete *DeletePasskeyChallenge) (bool, error) {
+	defer metrics.ObserveStoreOperation("DeletePasskeyChallenges", time.Now())
+	return d.Driver.DeletePasskeyChallenges(ctx, delete)
+}
+
+func (d *metricsDriver) CreateSigningKey(ctx context.Context, create *SigningKey) (*SigningKey, error) {
+	defer metrics.ObserveStoreOperation("CreateSigningKey", time.Now())
+	return d.Driver.CreateSigningKey(ctx, create)
+}
+
+func (d *metricsDriver) ListSigningKeys(ctx context.Context, find *FindSigningKey) ([]*SigningKey, error) {
+	defer metrics.ObserveStoreOperation("ListSigningKeys", time.Now())
+	return d.Driver.ListSigningKeys(ctx, find)
+}
+
+func (d *metricsDriver) UpdateSigningKey(ctx context.Context, update *UpdateSigningKey) error {
+	defer metrics.ObserveStoreOperation("UpdateSigningKey", time.Now())
+	return d.Driver.UpdateSigningKey(ctx, update)
+}
+
+func (d *metricsDriver) CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error) {
+	defer metrics.ObserveStoreOperation("CreateAuditLog", time.Now())
+	return d.Driver.CreateAuditLog(ctx, create)
+}
+
+func (d *metricsDriver) ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error) {
+	defer metrics.ObserveStoreOperation("ListAuditLogs", time.Now())
+	return d.Driver.ListAuditLogs(ctx, find)
+}
+
+func (d *metricsDriver) DeleteAuditLogs(ctx context.Context, delete *DeleteAuditLog) error {
+	defer metrics.ObserveStoreOperation("DeleteAuditLogs", time.Now())
+	return d.Driver.DeleteAuditLogs(ctx, delete)
+}
+
+func (d *metricsDriver) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	defer metrics.ObserveStoreOperation("CreateWebhookDelivery", time.Now())
+	return d.Driver.CreateWebhookDelivery(ctx, create)
+}
+
+func (d *metricsDriver) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	defer metrics.ObserveStoreOperation("ListWebhookDeliveries", time.Now())
+	return d.Driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (d *metricsDriver) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error {
+	defer metrics.ObserveStoreOperation("UpdateWebhookDelivery", time.Now())
+	return d.Driver.UpdateWebhookDelivery(ctx, update)
+}
+
+func (d *metricsDriver) ClaimWebhookDelivery(ctx context.Context, claim *ClaimWebhookDelivery) (bool, error) {
+	defer metrics.ObserveStoreOperation("ClaimWebhookDelivery", time.Now())
+	return d.Driver.ClaimWebhookDelivery(ctx, claim)
+}
+
+func (d *metricsDriver) DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	defer metrics.ObserveStoreOperation("DeleteWebhookDeliveries", time.Now())
+	return d.Driver.DeleteWebhookDeliveries(ctx, delete)
+}
+
+func (d *metricsDriver) UpsertReaction(ctx context.Context, create *Reaction) (*Reaction, error) {
+	defer metrics.ObserveStoreOperation("UpsertReaction", time.Now())
+	return d.Driver.UpsertReaction(ctx, create)
+}
+
+func (d *metricsDriver) ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error) {
+	defer metrics.ObserveStoreOperation("ListReactions", time.Now())
+	return d.Driver.ListReactions(ctx, find)
+}
+
+func (d *metricsDriver) GetReaction(ctx context.Context, find *FindReaction) (*Reaction, error) {
+	defer metrics.ObserveStoreOperation("GetReaction", time.Now())
+	return d.Driver.GetReaction(ctx, find)
+}
+
+func (d *metricsDriver) DeleteReaction(ctx context.Context, delete *DeleteReaction) error {
+	defer metrics.ObserveStoreOperation("DeleteReaction", time.Now())
+	return d.Driver.DeleteReaction(ctx, delete)
+}
+
+func (d *metricsDriver) UpsertSchemaMigration(ctx context.Context, upsert *SchemaMigration) (*SchemaMigration, error) {
+	defer metrics.ObserveStoreOperation("UpsertSchemaMigration", time.Now())
+	return d.Driver.UpsertSchemaMigration(ctx, upsert)
+}
+
+func (d *metricsDriver) ListSchemaMigrations(ctx context.Context, find *FindSchemaMigration) ([]*SchemaMigration, error) {
+	defer metrics.ObserveStoreOperation("ListSchemaMigrations", time.Now())
+	return d.Driver.ListSchemaMigrations(ctx, find)
+}
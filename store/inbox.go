@@ -44,6 +44,14 @@ type FindInbox struct {
 	ReceiverID  *int32
 	Status      *InboxStatus
 	MessageType *storepb.InboxMessage_Type
+	// MessageTypeList finds only inbox items with one of the message types.
+	MessageTypeList []storepb.InboxMessage_Type
+	// IDAfter finds only inbox items with a greater ID, i.e. created after it.
+	IDAfter *int32
+	// CreatedTsAfter and CreatedTsBefore find only inbox items created in the time range, from
+	// CreatedTsAfter included to CreatedTsBefore excluded.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
 
 	// Pagination
 	Limit  *int
@@ -37,6 +37,26 @@ func (s *Store) UpsertInstanceSetting(ctx context.Context, upsert *storepb.Insta
 		valueBytes, err = protojson.Marshal(upsert.GetStorageSetting())
 	} else if upsert.Key == storepb.InstanceSettingKey_MEMO_RELATED {
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_LINK_PREVIEW {
+		valueBytes, err = protojson.Marshal(upsert.GetLinkPreviewSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_EMAIL {
+		valueBytes, err = protojson.Marshal(upsert.GetEmailSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_WEBHOOKS {
+		valueBytes, err = protojson.Marshal(upsert.GetWebhooksSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_BACKUP {
+		valueBytes, err = protojson.Marshal(upsert.GetBackupSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_SLACK {
+		valueBytes, err = protojson.Marshal(upsert.GetSlackSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_MATRIX {
+		valueBytes, err = protojson.Marshal(upsert.GetMatrixSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_MATRIX_SYNC {
+		valueBytes, err = protojson.Marshal(upsert.GetMatrixSyncState())
+	} else if upsert.Key == storepb.InstanceSettingKey_ANNOUNCEMENT {
+		valueBytes, err = protojson.Marshal(upsert.GetAnnouncementSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_RATE_LIMIT {
+		valueBytes, err = protojson.Marshal(upsert.GetRateLimitSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_WEB_PUSH {
+		valueBytes, err = protojson.Marshal(upsert.GetWebPushSetting())
 	} else {
 		return nil, errors.Errorf("unsupported instance setting key: %v", upsert.Key)
 	}
@@ -118,6 +138,12 @@ func (s *Store) GetInstanceBasicSetting(ctx context.Context) (*storepb.InstanceB
 	return instanceBasicSetting, nil
 }
 
+// DefaultAuditLogRetentionDays is the default number of days audit log entries are kept.
+const DefaultAuditLogRetentionDays = 90
+
+// DefaultSessionIdleTimeoutHours is the default number of hours a session stays valid without being used.
+const DefaultSessionIdleTimeoutHours = 14 * 24
+
 func (s *Store) GetInstanceGeneralSetting(ctx context.Context) (*storepb.InstanceGeneralSetting, error) {
 	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
 		Name: storepb.InstanceSettingKey_GENERAL.String(),
@@ -130,6 +156,15 @@ func (s *Store) GetInstanceGeneralSetting(ctx context.Context) (*storepb.Instanc
 	if instanceSetting != nil {
 		instanceGeneralSetting = instanceSetting.GetGeneralSetting()
 	}
+	if instanceGeneralSetting.AuditLogRetentionDays <= 0 {
+		instanceGeneralSetting.AuditLogRetentionDays = DefaultAuditLogRetentionDays
+	}
+	if instanceGeneralSetting.SessionIdleTimeoutHours <= 0 {
+		instanceGeneralSetting.SessionIdleTimeoutHours = DefaultSessionIdleTimeoutHours
+	}
+	if instanceGeneralSetting.SessionAbsoluteLifetimeHours < 0 {
+		instanceGeneralSetting.SessionAbsoluteLifetimeHours = 0
+	}
 	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_GENERAL.String(), &storepb.InstanceSetting{
 		Key:   storepb.InstanceSettingKey_GENERAL,
 		Value: &storepb.InstanceSetting_GeneralSetting{GeneralSetting: instanceGeneralSetting},
@@ -146,6 +181,19 @@ var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕"
 // DefaultNsfwTags is the default tags that mark content as NSFW for blurring.
 var DefaultNsfwTags = []string{"nsfw"}
 
+// DefaultTrashRetentionDays is the default number of days deleted memos stay in the trash.
+const DefaultTrashRetentionDays = 30
+
+// DefaultMemoRevisionLimit is the default number of revisions kept per memo.
+const DefaultMemoRevisionLimit = 50
+
+// DefaultBookmarkDuplicateWindowMinutes is the default number of minutes bookmarking a link again
+// returns the memo bookmarking it.
+const DefaultBookmarkDuplicateWindowMinutes = 10
+
+// DefaultNewAccountDays is the default number of days an account is new for.
+const DefaultNewAccountDays = 7
+
 func (s *Store) GetInstanceMemoRelatedSetting(ctx context.Context) (*storepb.InstanceMemoRelatedSetting, error) {
 	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
 		Name: storepb.InstanceSettingKey_MEMO_RELATED.String(),
@@ -167,6 +215,18 @@ func (s *Store) GetInstanceMemoRelatedSetting(ctx context.Context) (*storepb.Ins
 	if len(instanceMemoRelatedSetting.NsfwTags) == 0 {
 		instanceMemoRelatedSetting.NsfwTags = append(instanceMemoRelatedSetting.NsfwTags, DefaultNsfwTags...)
 	}
+	if instanceMemoRelatedSetting.TrashRetentionDays <= 0 {
+		instanceMemoRelatedSetting.TrashRetentionDays = DefaultTrashRetentionDays
+	}
+	if instanceMemoRelatedSetting.MemoRevisionLimit <= 0 {
+		instanceMemoRelatedSetting.MemoRevisionLimit = DefaultMemoRevisionLimit
+	}
+	if instanceMemoRelatedSetting.BookmarkDuplicateWindowMinutes <= 0 {
+		instanceMemoRelatedSetting.BookmarkDuplicateWindowMinutes = DefaultBookmarkDuplicateWindowMinutes
+	}
+	if instanceMemoRelatedSetting.NewAccountDays <= 0 {
+		instanceMemoRelatedSetting.NewAccountDays = DefaultNewAccountDays
+	}
 	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_MEMO_RELATED.String(), &storepb.InstanceSetting{
 		Key:   storepb.InstanceSettingKey_MEMO_RELATED,
 		Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: instanceMemoRelatedSetting},
@@ -180,6 +240,9 @@ const (
 	defaultInstanceFilepathTemplate  = "assets/{timestamp}_{filename}"
 )
 
+// DefaultOrphanedAttachmentGraceDays is the default number of days attachments without a memo are kept.
+const DefaultOrphanedAttachmentGraceDays = 7
+
 func (s *Store) GetInstanceStorageSetting(ctx context.Context) (*storepb.InstanceStorageSetting, error) {
 	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
 		Name: storepb.InstanceSettingKey_STORAGE.String(),
@@ -201,6 +264,9 @@ func (s *Store) GetInstanceStorageSetting(ctx context.Context) (*storepb.Instanc
 	if instanceStorageSetting.FilepathTemplate == "" {
 		instanceStorageSetting.FilepathTemplate = defaultInstanceFilepathTemplate
 	}
+	if instanceStorageSetting.OrphanedAttachmentGraceDays <= 0 {
+		instanceStorageSetting.OrphanedAttachmentGraceDays = DefaultOrphanedAttachmentGraceDays
+	}
 	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_STORAGE.String(), &storepb.InstanceSetting{
 		Key:   storepb.InstanceSettingKey_STORAGE,
 		Value: &storepb.InstanceSetting_StorageSetting{StorageSetting: instanceStorageSetting},
@@ -208,6 +274,332 @@ func (s *Store) GetInstanceStorageSetting(ctx context.Context) (*storepb.Instanc
 	return instanceStorageSetting, nil
 }
 
+const (
+	// DefaultLinkPreviewRateLimitPerMinute is the default number of link previews a user may fetch per minute.
+	DefaultLinkPreviewRateLimitPerMinute = 30
+	// DefaultLinkPreviewRateLimitBurst is the default burst size of the link preview rate limiter.
+	DefaultLinkPreviewRateLimitBurst = 10
+	// DefaultLinkPreviewAdminRateLimitPerMinute is the default per-minute limit for admin users.
+	DefaultLinkPreviewAdminRateLimitPerMinute = 120
+)
+
+func (s *Store) GetInstanceLinkPreviewSetting(ctx context.Context) (*storepb.InstanceLinkPreviewSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_LINK_PREVIEW.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance link preview setting")
+	}
+
+	instanceLinkPreviewSetting := &storepb.InstanceLinkPreviewSetting{}
+	if instanceSetting != nil {
+		instanceLinkPreviewSetting = instanceSetting.GetLinkPreviewSetting()
+	}
+	if instanceLinkPreviewSetting.RateLimitPerMinute <= 0 {
+		instanceLinkPreviewSetting.RateLimitPerMinute = DefaultLinkPreviewRateLimitPerMinute
+	}
+	if instanceLinkPreviewSetting.RateLimitBurst <= 0 {
+		instanceLinkPreviewSetting.RateLimitBurst = DefaultLinkPreviewRateLimitBurst
+	}
+	if instanceLinkPreviewSetting.AdminRateLimitPerMinute <= 0 {
+		instanceLinkPreviewSetting.AdminRateLimitPerMinute = DefaultLinkPreviewAdminRateLimitPerMinute
+	}
+	if instanceLinkPreviewSetting.Mode == storepb.InstanceLinkPreviewSetting_MODE_UNSPECIFIED {
+		instanceLinkPreviewSetting.Mode = storepb.InstanceLinkPreviewSetting_OPEN
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_LINK_PREVIEW.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_LINK_PREVIEW,
+		Value: &storepb.InstanceSetting_LinkPreviewSetting{LinkPreviewSetting: instanceLinkPreviewSetting},
+	})
+	return instanceLinkPreviewSetting, nil
+}
+
+// DefaultSMTPPort is the SMTP submission port used if none is configured.
+const DefaultSMTPPort = 587
+
+func (s *Store) GetInstanceEmailSetting(ctx context.Context) (*storepb.InstanceEmailSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_EMAIL.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance email setting")
+	}
+
+	instanceEmailSetting := &storepb.InstanceEmailSetting{}
+	if instanceSetting != nil {
+		instanceEmailSetting = instanceSetting.GetEmailSetting()
+	}
+	if instanceEmailSetting.SmtpPort <= 0 {
+		instanceEmailSetting.SmtpPort = DefaultSMTPPort
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_EMAIL.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: instanceEmailSetting},
+	})
+	return instanceEmailSetting, nil
+}
+
+const (
+	// DefaultBackupSchedule is the default schedule of the backups.
+	DefaultBackupSchedule = "@daily"
+	// DefaultBackupLocalPath is the default directory of the local backups, in the data directory.
+	DefaultBackupLocalPath = "backups"
+	// DefaultBackupS3Prefix is the default key prefix of the S3 backups.
+	DefaultBackupS3Prefix = "backups/"
+	// DefaultBackupRetentionCount is the default number of backups kept.
+	DefaultBackupRetentionCount = 7
+)
+
+func (s *Store) GetInstanceBackupSetting(ctx context.Context) (*storepb.InstanceBackupSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_BACKUP.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance backup setting")
+	}
+
+	instanceBackupSetting := &storepb.InstanceBackupSetting{}
+	if instanceSetting != nil {
+		instanceBackupSetting = instanceSetting.GetBackupSetting()
+	}
+	if instanceBackupSetting.Schedule == "" {
+		instanceBackupSetting.Schedule = DefaultBackupSchedule
+	}
+	if instanceBackupSetting.Destination == storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED {
+		instanceBackupSetting.Destination = storepb.InstanceBackupSetting_LOCAL
+	}
+	if instanceBackupSetting.LocalPath == "" {
+		instanceBackupSetting.LocalPath = DefaultBackupLocalPath
+	}
+	if instanceBackupSetting.S3Prefix == "" {
+		instanceBackupSetting.S3Prefix = DefaultBackupS3Prefix
+	}
+	if instanceBackupSetting.RetentionCount <= 0 {
+		instanceBackupSetting.RetentionCount = DefaultBackupRetentionCount
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_BACKUP.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_BACKUP,
+		Value: &storepb.InstanceSetting_BackupSetting{BackupSetting: instanceBackupSetting},
+	})
+	return instanceBackupSetting, nil
+}
+
+func (s *Store) GetInstanceSlackSetting(ctx context.Context) (*storepb.InstanceSlackSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_SLACK.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance slack setting")
+	}
+
+	instanceSlackSetting := &storepb.InstanceSlackSetting{}
+	if instanceSetting != nil {
+		instanceSlackSetting = instanceSetting.GetSlackSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_SLACK.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_SLACK,
+		Value: &storepb.InstanceSetting_SlackSetting{SlackSetting: instanceSlackSetting},
+	})
+	return instanceSlackSetting, nil
+}
+
+func (s *Store) GetInstanceMatrixSetting(ctx context.Context) (*storepb.InstanceMatrixSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_MATRIX.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance matrix setting")
+	}
+
+	instanceMatrixSetting := &storepb.InstanceMatrixSetting{}
+	if instanceSetting != nil {
+		instanceMatrixSetting = instanceSetting.GetMatrixSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_MATRIX.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_MATRIX,
+		Value: &storepb.InstanceSetting_MatrixSetting{MatrixSetting: instanceMatrixSetting},
+	})
+	return instanceMatrixSetting, nil
+}
+
+func (s *Store) GetInstanceAnnouncementSetting(ctx context.Context) (*storepb.InstanceAnnouncementSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_ANNOUNCEMENT.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance announcement setting")
+	}
+
+	instanceAnnouncementSetting := &storepb.InstanceAnnouncementSetting{}
+	if instanceSetting != nil {
+		instanceAnnouncementSetting = instanceSetting.GetAnnouncementSetting()
+	}
+	if instanceAnnouncementSetting.Severity == storepb.InstanceAnnouncementSetting_SEVERITY_UNSPECIFIED {
+		instanceAnnouncementSetting.Severity = storepb.InstanceAnnouncementSetting_INFO
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_ANNOUNCEMENT.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_ANNOUNCEMENT,
+		Value: &storepb.InstanceSetting_AnnouncementSetting{AnnouncementSetting: instanceAnnouncementSetting},
+	})
+	return instanceAnnouncementSetting, nil
+}
+
+const (
+	// DefaultReadRequestsPerMinute is the default number of API read requests a user may make per minute.
+	DefaultReadRequestsPerMinute = 1200
+	// DefaultReadBurst is the default burst size of the API read requests.
+	DefaultReadBurst = 300
+	// DefaultWriteRequestsPerMinute is the default number of API write requests a user may make per minute.
+	DefaultWriteRequestsPerMinute = 120
+	// DefaultWriteBurst is the default burst size of the API write requests.
+	DefaultWriteBurst = 30
+	// DefaultHostRateLimitMultiplier is the default multiplier of the API rate limits of the host.
+	DefaultHostRateLimitMultiplier = 10
+)
+
+func (s *Store) GetInstanceRateLimitSetting(ctx context.Context) (*storepb.InstanceRateLimitSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_RATE_LIMIT.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance rate limit setting")
+	}
+
+	instanceRateLimitSetting := &storepb.InstanceRateLimitSetting{}
+	if instanceSetting != nil {
+		instanceRateLimitSetting = instanceSetting.GetRateLimitSetting()
+	}
+	if instanceRateLimitSetting.ReadRequestsPerMinute <= 0 {
+		instanceRateLimitSetting.ReadRequestsPerMinute = DefaultReadRequestsPerMinute
+	}
+	if instanceRateLimitSetting.ReadBurst <= 0 {
+		instanceRateLimitSetting.ReadBurst = DefaultReadBurst
+	}
+	if instanceRateLimitSetting.WriteRequestsPerMinute <= 0 {
+		instanceRateLimitSetting.WriteRequestsPerMinute = DefaultWriteRequestsPerMinute
+	}
+	if instanceRateLimitSetting.WriteBurst <= 0 {
+		instanceRateLimitSetting.WriteBurst = DefaultWriteBurst
+	}
+	if instanceRateLimitSetting.HostMultiplier <= 0 {
+		instanceRateLimitSetting.HostMultiplier = DefaultHostRateLimitMultiplier
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_RATE_LIMIT.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_RATE_LIMIT,
+		Value: &storepb.InstanceSetting_RateLimitSetting{RateLimitSetting: instanceRateLimitSetting},
+	})
+	return instanceRateLimitSetting, nil
+}
+
+// GetInstanceMatrixSyncState returns the sync state of the Matrix bot, or an empty state if the
+// bot never synced.
+func (s *Store) GetInstanceMatrixSyncState(ctx context.Context) (*storepb.InstanceMatrixSyncState, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_MATRIX_SYNC.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance matrix sync state")
+	}
+	if instanceSetting == nil || instanceSetting.GetMatrixSyncState() == nil {
+		return &storepb.InstanceMatrixSyncState{}, nil
+	}
+	return instanceSetting.GetMatrixSyncState(), nil
+}
+
+// GetInstanceWebPushSetting returns the VAPID keys of Web Push, or empty keys if they were never
+// generated.
+func (s *Store) GetInstanceWebPushSetting(ctx context.Context) (*storepb.InstanceWebPushSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_WEB_PUSH.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance web push setting")
+	}
+	if instanceSetting == nil || instanceSetting.GetWebPushSetting() == nil {
+		return &storepb.InstanceWebPushSetting{}, nil
+	}
+	return instanceSetting.GetWebPushSetting(), nil
+}
+
+// GetInstanceWebhooks returns the workspace webhooks.
+func (s *Store) GetInstanceWebhooks(ctx context.Context) ([]*storepb.WebhooksUserSetting_Webhook, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_WEBHOOKS.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance webhooks setting")
+	}
+	if instanceSetting == nil {
+		return []*storepb.WebhooksUserSetting_Webhook{}, nil
+	}
+	return instanceSetting.GetWebhooksSetting().GetWebhooks(), nil
+}
+
+// AddInstanceWebhook adds a new workspace webhook, or replaces the webhook with the same ID.
+func (s *Store) AddInstanceWebhook(ctx context.Context, webhook *storepb.WebhooksUserSetting_Webhook) error {
+	webhooks, err := s.GetInstanceWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	updatedWebhooks := make([]*storepb.WebhooksUserSetting_Webhook, 0, len(webhooks)+1)
+	webhookExists := false
+	for _, existing := range webhooks {
+		if existing.Id == webhook.Id {
+			updatedWebhooks = append(updatedWebhooks, webhook)
+			webhookExists = true
+		} else {
+			updatedWebhooks = append(updatedWebhooks, existing)
+		}
+	}
+	if !webhookExists {
+		updatedWebhooks = append(updatedWebhooks, webhook)
+	}
+	return s.upsertInstanceWebhooks(ctx, updatedWebhooks)
+}
+
+// RemoveInstanceWebhook removes a workspace webhook.
+func (s *Store) RemoveInstanceWebhook(ctx context.Context, webhookID string) error {
+	webhooks, err := s.GetInstanceWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	newWebhooks := make([]*storepb.WebhooksUserSetting_Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhookID != webhook.Id {
+			newWebhooks = append(newWebhooks, webhook)
+		}
+	}
+	return s.upsertInstanceWebhooks(ctx, newWebhooks)
+}
+
+// UpdateInstanceWebhook updates an existing workspace webhook.
+func (s *Store) UpdateInstanceWebhook(ctx context.Context, webhook *storepb.WebhooksUserSetting_Webhook) error {
+	webhooks, err := s.GetInstanceWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, existing := range webhooks {
+		if existing.Id == webhook.Id {
+			webhooks[i] = webhook
+			break
+		}
+	}
+	return s.upsertInstanceWebhooks(ctx, webhooks)
+}
+
+func (s *Store) upsertInstanceWebhooks(ctx context.Context, webhooks []*storepb.WebhooksUserSetting_Webhook) error {
+	_, err := s.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_WEBHOOKS,
+		Value: &storepb.InstanceSetting_WebhooksSetting{
+			WebhooksSetting: &storepb.InstanceWebhooksSetting{Webhooks: webhooks},
+		},
+	})
+	return err
+}
+
 func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storepb.InstanceSetting, error) {
 	instanceSetting := &storepb.InstanceSetting{
 		Key: storepb.InstanceSettingKey(storepb.InstanceSettingKey_value[instanceSettingRaw.Name]),
@@ -237,6 +629,66 @@ func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storep
 			return nil, err
 		}
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: memoRelatedSetting}
+	case storepb.InstanceSettingKey_LINK_PREVIEW.String():
+		linkPreviewSetting := &storepb.InstanceLinkPreviewSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), linkPreviewSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_LinkPreviewSetting{LinkPreviewSetting: linkPreviewSetting}
+	case storepb.InstanceSettingKey_EMAIL.String():
+		emailSetting := &storepb.InstanceEmailSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), emailSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_EmailSetting{EmailSetting: emailSetting}
+	case storepb.InstanceSettingKey_WEBHOOKS.String():
+		webhooksSetting := &storepb.InstanceWebhooksSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), webhooksSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_WebhooksSetting{WebhooksSetting: webhooksSetting}
+	case storepb.InstanceSettingKey_BACKUP.String():
+		backupSetting := &storepb.InstanceBackupSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), backupSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_BackupSetting{BackupSetting: backupSetting}
+	case storepb.InstanceSettingKey_SLACK.String():
+		slackSetting := &storepb.InstanceSlackSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), slackSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_SlackSetting{SlackSetting: slackSetting}
+	case storepb.InstanceSettingKey_MATRIX.String():
+		matrixSetting := &storepb.InstanceMatrixSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), matrixSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_MatrixSetting{MatrixSetting: matrixSetting}
+	case storepb.InstanceSettingKey_MATRIX_SYNC.String():
+		matrixSyncState := &storepb.InstanceMatrixSyncState{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), matrixSyncState); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_MatrixSyncState{MatrixSyncState: matrixSyncState}
+	case storepb.InstanceSettingKey_ANNOUNCEMENT.String():
+		announcementSetting := &storepb.InstanceAnnouncementSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), announcementSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_AnnouncementSetting{AnnouncementSetting: announcementSetting}
+	case storepb.InstanceSettingKey_RATE_LIMIT.String():
+		rateLimitSetting := &storepb.InstanceRateLimitSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), rateLimitSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_RateLimitSetting{RateLimitSetting: rateLimitSetting}
+	case storepb.InstanceSettingKey_WEB_PUSH.String():
+		webPushSetting := &storepb.InstanceWebPushSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), webPushSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_WebPushSetting{WebPushSetting: webPushSetting}
 	default:
 		// Skip unsupported instance setting key.
 		return nil, nil
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// instanceStatsCacheTTL is how long the instance stats are cached, so they may lag behind the
+	// latest changes.
+	instanceStatsCacheTTL = 5 * time.Minute
+	// instanceStatsActiveWindow is how recently users must have used a browser session to be
+	// counted as active.
+	instanceStatsActiveWindow = 30 * 24 * time.Hour
+	// instanceStatsDays is the number of days of InstanceStats.DayCounts.
+	instanceStatsDays = 90
+
+	instanceStatsCacheKey = "instance"
+)
+
+// FindInstanceStats finds the stats of the instance.
+type FindInstanceStats struct {
+	// ActiveSinceTs is the time since which users must have been issued a refresh token to be
+	// counted as active.
+	ActiveSinceTs int64
+	// CreatedSinceTs is the time since which memos are counted by day.
+	CreatedSinceTs int64
+}
+
+// InstanceStats are the stats of the instance, aggregated by the database.
+type InstanceStats struct {
+	UserCount       int32
+	ActiveUserCount int32
+	// MemoCounts are the numbers of memos by visibility and state, comments included.
+	MemoCounts []*InstanceMemoCount
+	// DayCounts are the numbers of memos created by UTC day, ordered by day.
+	DayCounts []*MemoDayCount
+	// StorageUsages are the attachments by storage type.
+	StorageUsages []*InstanceStorageUsage
+	// UserWebhookCount is the number of webhooks of all users.
+	UserWebhookCount int32
+	// DatabaseSizeBytes is the size of the database, or -1 if the driver can't report it.
+	DatabaseSizeBytes int64
+	// ComputedTs is the time the stats were computed.
+	ComputedTs int64
+}
+
+// InstanceMemoCount is the number of memos with a visibility and state.
+type InstanceMemoCount struct {
+	Visibility Visibility
+	RowStatus  RowStatus
+	// Trashed is whether the memos are in the trash.
+	Trashed bool
+	Count   int32
+}
+
+// InstanceStorageUsage is the number and total size of the attachments of a storage type.
+type InstanceStorageUsage struct {
+	// StorageType is the storage type of the attachments, which is empty for the attachments
+	// stored in the database.
+	StorageType     string
+	AttachmentCount int32
+	SizeBytes       int64
+}
+
+// GetInstanceStats returns the stats of the instance, which are cached for instanceStatsCacheTTL.
+func (s *Store) GetInstanceStats(ctx context.Context) (*InstanceStats, error) {
+	if cached, ok := s.instanceStatsCache.Get(ctx, instanceStatsCacheKey); ok {
+		if stats, ok := cached.(*InstanceStats); ok {
+			return stats, nil
+		}
+	}
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	stats, err := s.driver.GetInstanceStats(ctx, &FindInstanceStats{
+		ActiveSinceTs:  now.Add(-instanceStatsActiveWindow).Unix(),
+		CreatedSinceTs: today.AddDate(0, 0, -(instanceStatsDays - 1)).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.ComputedTs = now.Unix()
+	s.instanceStatsCache.SetWithTTL(ctx, instanceStatsCacheKey, stats, instanceStatsCacheTTL)
+	return stats, nil
+}
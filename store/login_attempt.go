@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+)
+
+// LoginAttempt tracks the failed sign-in attempts of one identifier, e.g. a username or client IP.
+type LoginAttempt struct {
+	Identifier string
+	// FailureCount is the number of failures since FirstFailedTs.
+	FailureCount  int32
+	FirstFailedTs int64
+	LastFailedTs  int64
+	// LockedUntilTs is the time until which sign-in is refused, or 0 if not locked.
+	LockedUntilTs int64
+}
+
+type FindLoginAttempt struct {
+	Identifier *string
+}
+
+type DeleteLoginAttempt struct {
+	Identifier *string
+	// LastFailedBefore deletes unlocked attempts whose last failure is older than the timestamp.
+	LastFailedBefore *int64
+}
+
+func (s *Store) UpsertLoginAttempt(ctx context.Context, upsert *LoginAttempt) (*LoginAttempt, error) {
+	return s.driver.UpsertLoginAttempt(ctx, upsert)
+}
+
+func (s *Store) ListLoginAttempts(ctx context.Context, find *FindLoginAttempt) ([]*LoginAttempt, error) {
+	return s.driver.ListLoginAttempts(ctx, find)
+}
+
+func (s *Store) GetLoginAttempt(ctx context.Context, find *FindLoginAttempt) (*LoginAttempt, error) {
+	list, err := s.ListLoginAttempts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteLoginAttempts(ctx context.Context, delete *DeleteLoginAttempt) error {
+	return s.driver.DeleteLoginAttempts(ctx, delete)
+}
@@ -3,8 +3,15 @@ package store
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/internal/util"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -48,10 +55,32 @@ type Memo struct {
 	Content    string
 	Visibility Visibility
 	Pinned     bool
-	Payload    *storepb.MemoPayload
+	// PinOrder is the 1-based position of a pinned memo among the pinned memos of its creator,
+	// 0 if it isn't pinned.
+	PinOrder int32
+	Payload  *storepb.MemoPayload
+	// DeletedTs is when the memo was moved to the trash, 0 if it isn't in the trash.
+	DeletedTs int64
+	// PublishTs is when the memo is scheduled to be published, 0 if it isn't scheduled.
+	// Scheduled memos are only visible to their creator.
+	PublishTs int64
+	// ReminderTs is when the creator of the memo is reminded of it, 0 if it has no reminder.
+	ReminderTs     int64
+	ReminderRepeat MemoReminderRepeat
+	// ReminderDeliveredTs is when the reminder was last delivered. The reminder is due if
+	// ReminderTs has passed and it hasn't been delivered since.
+	ReminderDeliveredTs int64
+	// ExpireTs is when the memo expires, 0 if it doesn't. Memos past their expiry are excluded
+	// from reads, then moved to the trash.
+	ExpireTs int64
 
 	// Composed fields
 	ParentUID *string
+	// Slug is the short name of the memo in its URLs, empty if it has none.
+	Slug string
+	// SearchMatchedAttachment is set by searches when the content of the memo doesn't match every
+	// term of the search query, some matching only the filenames of its attachments.
+	SearchMatchedAttachment bool
 }
 
 type FindMemo struct {
@@ -60,25 +89,96 @@ type FindMemo struct {
 
 	IDList  []int32
 	UIDList []string
+	// Slug finds the memo with the slug, or with the slug as a previous slug that still redirects
+	// to it.
+	Slug *string
 
 	// Standard fields
 	RowStatus *RowStatus
 	CreatorID *int32
+	// ExcludeSuspendedCreators excludes the memos of the users suspended by the host.
+	ExcludeSuspendedCreators bool
 
 	// Domain specific fields
 	VisibilityList  []Visibility
 	ExcludeContent  bool
 	ExcludeComments bool
 	Filters         []string
+	// FilterViewerID is the user the Filters are evaluated for, or 0 if anonymous. The location
+	// conditions of Filters only match the locations shown to the viewer.
+	FilterViewerID int32
+
+	// IncludeTrashed also finds memos in the trash, which are excluded by default.
+	IncludeTrashed bool
+	// Trashed finds only memos in the trash.
+	Trashed bool
+	// DeletedBefore finds only memos moved to the trash before the timestamp, together with Trashed.
+	DeletedBefore *int64
+
+	// IncludeScheduled also finds scheduled memos, which are excluded by default.
+	IncludeScheduled bool
+	// Scheduled finds only scheduled memos.
+	Scheduled bool
+	// PublishBefore finds only memos scheduled to be published before the timestamp, together with Scheduled.
+	PublishBefore *int64
+	// ReminderDueBefore finds only memos with a reminder due before the timestamp and not delivered since.
+	ReminderDueBefore *int64
+	// HasReminder finds only memos with a reminder.
+	HasReminder bool
+	// IncludeExpired also finds memos past their expiry, which are excluded by default.
+	IncludeExpired bool
+	// ExpireBefore finds only memos expiring before the timestamp, together with IncludeExpired.
+	ExpireBefore *int64
+
+	// CreatedTsBefore finds only memos created before the timestamp.
+	CreatedTsBefore *int64
+	// UpdatedTsBefore finds only memos last updated before the timestamp.
+	UpdatedTsBefore *int64
+	// CreatedTsRanges finds only memos created within one of the ranges.
+	CreatedTsRanges []*MemoTsRange
+
+	// SearchQuery finds only memos matching the full-text search query, ordered by relevance.
+	// Every term matches the content of the memo, or the filename of one of its attachments.
+	SearchQuery *string
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor finds only memos after the cursor in the ordering, for keyset pagination.
+	// It isn't supported together with SearchQuery.
+	Cursor *MemoCursor
 
 	// Ordering
 	OrderByPinned    bool
 	OrderByUpdatedTs bool
 	OrderByTimeAsc   bool
+	// OrderByRandom orders the memos randomly instead, to sample them with Limit.
+	// It isn't supported together with Cursor.
+	OrderByRandom bool
+}
+
+// MemoTsRange is a range of timestamps, from StartTs included to EndTs excluded.
+type MemoTsRange struct {
+	StartTs int64
+	EndTs   int64
+}
+
+// MemoCursor is the position of a memo in the ordering of FindMemo: its pinned state and pin
+// order, the timestamp it is ordered by (created_ts, or updated_ts with OrderByUpdatedTs) and its ID.
+type MemoCursor struct {
+	Pinned   bool
+	PinOrder int32
+	Ts       int64
+	ID       int32
+}
+
+// NewMemoCursor returns the cursor of memo in the ordering of find.
+func NewMemoCursor(memo *Memo, find *FindMemo) *MemoCursor {
+	ts := memo.CreatedTs
+	if find.OrderByUpdatedTs {
+		ts = memo.UpdatedTs
+	}
+	return &MemoCursor{Pinned: memo.Pinned, PinOrder: memo.PinOrder, Ts: ts, ID: memo.ID}
 }
 
 type FindMemoPayload struct {
@@ -100,21 +200,188 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	DeletedTs  *int64
+	PublishTs  *int64
+
+	ReminderTs          *int64
+	ReminderRepeat      *MemoReminderRepeat
+	ReminderDeliveredTs *int64
+	ExpireTs            *int64
 }
 
 type DeleteMemo struct {
 	ID int32
 }
 
+// PinMemo pins, unpins or moves a memo among the pinned memos of its creator. The pinned memos
+// of a user are ordered by their PinOrder, from 1 at the top, without gaps.
+type PinMemo struct {
+	ID        int32
+	CreatorID int32
+	// Pinned is whether the memo is pinned after the change.
+	Pinned bool
+	// Position is the 1-based position to move the memo to, clamped to the pinned memos. If it is 0,
+	// the memo is moved by Offset positions, negative towards the top. A memo pinned without a
+	// position or an offset is placed at the top, and a pinned memo keeps its position.
+	Position int32
+	Offset   int32
+}
+
+// Reorder returns the IDs of the pinned memos in their order after the change, given the IDs of
+// the pinned memos in their current order.
+func (p *PinMemo) Reorder(pinnedIDs []int32) []int32 {
+	index := slices.Index(pinnedIDs, p.ID)
+	ids := slices.DeleteFunc(slices.Clone(pinnedIDs), func(id int32) bool { return id == p.ID })
+	if !p.Pinned {
+		return ids
+	}
+	position := int(p.Position) - 1
+	if p.Position == 0 {
+		position = max(index, 0) + int(p.Offset)
+	}
+	position = min(max(position, 0), len(ids))
+	return slices.Insert(ids, position, p.ID)
+}
+
+// UpdateMemoContent is an edit of the content of a memo made from the content it had when it was
+// read. The memo isn't updated if its content has changed since.
+type UpdateMemoContent struct {
+	ID int32
+	// OldContent is the content of the memo the edit was made from.
+	OldContent string
+	Content    string
+	Payload    *storepb.MemoPayload
+	UpdatedTs  int64
+}
+
+// PublishMemo is the publication of a scheduled memo.
+type PublishMemo struct {
+	ID int32
+	// PublishTs is the publish time the memo was scheduled at when it was found. The memo isn't
+	// published if it has been rescheduled or published since.
+	PublishTs int64
+	// DisplayTs is the timestamp the published memo is displayed at, set as its created_ts and updated_ts.
+	DisplayTs int64
+}
+
+// MemoReminderRepeat is how a memo reminder repeats once delivered.
+type MemoReminderRepeat string
+
+const (
+	// MemoReminderRepeatNone is a reminder delivered once.
+	MemoReminderRepeatNone MemoReminderRepeat = ""
+	// MemoReminderRepeatDaily is a reminder delivered every day.
+	MemoReminderRepeatDaily MemoReminderRepeat = "DAILY"
+	// MemoReminderRepeatWeekly is a reminder delivered every week.
+	MemoReminderRepeatWeekly MemoReminderRepeat = "WEEKLY"
+)
+
+func (r MemoReminderRepeat) String() string {
+	return string(r)
+}
+
+// DeliverMemoReminder is the delivery of a due memo reminder.
+type DeliverMemoReminder struct {
+	ID int32
+	// ReminderTs is the reminder time of the memo when it was found. The reminder isn't delivered
+	// if it has been rescheduled or delivered since.
+	ReminderTs int64
+	// NextReminderTs is the next reminder time of a repeating reminder, or ReminderTs.
+	NextReminderTs int64
+	DeliveredTs    int64
+}
+
+// ExpireMemo is the move of an expired memo to the trash.
+type ExpireMemo struct {
+	ID int32
+	// ExpireTs is the expiry of the memo when it was found. The memo isn't moved to the trash if
+	// its expiry has changed since.
+	ExpireTs int64
+	// DeletedTs is when the memo is moved to the trash.
+	DeletedTs int64
+}
+
+// ErrMemoSearchUnavailable is returned by drivers when a FindMemo.SearchQuery can't be run
+// because the full-text search index is missing.
+var ErrMemoSearchUnavailable = errors.New("memo search index unavailable")
+
+// SplitMemoSearchQuery splits a full-text search query into its terms, all of which must match.
+func SplitMemoSearchQuery(query string) []string {
+	return strings.Fields(query)
+}
+
+// MemoSearchFilenamePattern returns the LIKE pattern, escaped with a backslash, matching the
+// attachment filenames containing a search term.
+func MemoSearchFilenamePattern(term string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term) + "%"
+}
+
+// IsCJK reports whether r is a Chinese, Japanese or Korean character. Word tokenizers don't
+// split CJK text, which has no spaces between words, so it needs bigrams or substring search.
+func IsCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// CountMemoWords counts the words of a memo content as the web client does: the words are
+// separated by whitespace and each CJK character counts as a word.
+func CountMemoWords(content string) int32 {
+	count, inWord := int32(0), false
+	for _, r := range content {
+		switch {
+		case unicode.IsSpace(r):
+			inWord = false
+		case IsCJK(r):
+			count++
+			inWord = false
+		case !inWord:
+			count++
+			inWord = true
+		default:
+		}
+	}
+	return count
+}
+
+// normalizeMemoPayloadTags sets the normalized tags of a memo payload, which tag filters match
+// instead of the tags as written.
+func normalizeMemoPayloadTags(payload *storepb.MemoPayload) {
+	if payload == nil {
+		return
+	}
+	payload.NormalizedTags = nil
+	for _, tag := range payload.Tags {
+		normalized := util.NormalizeTag(tag)
+		if !slices.Contains(payload.NormalizedTags, normalized) {
+			payload.NormalizedTags = append(payload.NormalizedTags, normalized)
+		}
+	}
+}
+
 func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
+	if create.RowStatus == "" {
+		create.RowStatus = Normal
+	}
+	normalizeMemoPayloadTags(create.Payload)
 	return s.driver.CreateMemo(ctx, create)
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
-	return s.driver.ListMemos(ctx, find)
+	list, err := s.driver.ListMemos(ctx, find)
+	if errors.Is(err, ErrMemoSearchUnavailable) {
+		// Fall back to substring matching of the search terms in the content, without ranking.
+		slog.Warn("memo search index unavailable, falling back to substring matching")
+		fallback := *find
+		fallback.SearchQuery = nil
+		fallback.Filters = slices.Clone(find.Filters)
+		for _, term := range SplitMemoSearchQuery(*find.SearchQuery) {
+			fallback.Filters = append(fallback.Filters, fmt.Sprintf("content.contains(%s)", strconv.Quote(term)))
+		}
+		return s.driver.ListMemos(ctx, &fallback)
+	}
+	return list, err
 }
 
 func (s *Store) GetMemo(ctx context.Context, find *FindMemo) (*Memo, error) {
@@ -130,13 +397,104 @@ func (s *Store) GetMemo(ctx context.Context, find *FindMemo) (*Memo, error) {
 	return memo, nil
 }
 
+// GetPublicMemo returns a memo, named by its UID or its slug, and its creator, or nil unless it's a
+// public memo anyone can see: not in the trash, scheduled, a draft, or by a suspended user.
+func (s *Store) GetPublicMemo(ctx context.Context, name string) (*Memo, *User, error) {
+	find := &FindMemo{UID: &name, IncludeTrashed: true, IncludeScheduled: true}
+	memo, err := s.GetMemo(ctx, find)
+	if err == nil && memo == nil {
+		// The memo may be named by its slug instead.
+		find.UID, find.Slug = nil, &name
+		memo, err = s.GetMemo(ctx, find)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if memo == nil || memo.Visibility != Public || memo.DeletedTs != 0 || memo.PublishTs != 0 || memo.RowStatus == Draft {
+		return nil, nil, nil
+	}
+	creator, err := s.GetUser(ctx, &FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if creator == nil || creator.SuspendedTs != 0 {
+		return nil, nil, nil
+	}
+	return memo, creator, nil
+}
+
 func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
+	normalizeMemoPayloadTags(update.Payload)
 	return s.driver.UpdateMemo(ctx, update)
 }
 
+// UpdateMemos updates the memos in a single transaction, so either all or none are updated.
+func (s *Store) UpdateMemos(ctx context.Context, updates []*UpdateMemo) error {
+	for _, update := range updates {
+		if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+		normalizeMemoPayloadTags(update.Payload)
+	}
+	return s.driver.UpdateMemos(ctx, updates)
+}
+
+// MergeMemo merges a source memo into a target memo. The target is updated with the merged
+// content, the attachments, reactions and relations of the source are moved to the target, and
+// the source is moved to the trash with a merge relation to the target.
+type MergeMemo struct {
+	// Target is the update of the target memo.
+	Target *UpdateMemo
+	// TargetName and SourceName are the names of the memos that their reactions are on.
+	TargetName string
+	SourceID   int32
+	SourceName string
+	DeletedTs  int64
+}
+
+// MergeMemo merges the memos in a single transaction, so the attachments, reactions and relations
+// of the source are either all moved or not at all. The relations of other memos to the source are
+// moved to the target, and the relations between the two memos are dropped.
+func (s *Store) MergeMemo(ctx context.Context, merge *MergeMemo) error {
+	normalizeMemoPayloadTags(merge.Target.Payload)
+	return s.driver.MergeMemo(ctx, merge)
+}
+
+// PinMemo pins, unpins or moves a memo in a single transaction, which renumbers the pinned memos
+// of its creator that are neither archived nor in the trash, so their order has no gaps or ties.
+func (s *Store) PinMemo(ctx context.Context, pin *PinMemo) error {
+	return s.driver.PinMemo(ctx, pin)
+}
+
+// UpdateMemoContent updates the content of a memo, and reports whether it was updated, i.e.
+// whether its content was still update.OldContent.
+func (s *Store) UpdateMemoContent(ctx context.Context, update *UpdateMemoContent) (bool, error) {
+	normalizeMemoPayloadTags(update.Payload)
+	return s.driver.UpdateMemoContent(ctx, update)
+}
+
+// PublishMemo publishes a scheduled memo, and reports whether it was published, i.e. whether it
+// was still scheduled at publish.PublishTs.
+func (s *Store) PublishMemo(ctx context.Context, publish *PublishMemo) (bool, error) {
+	return s.driver.PublishMemo(ctx, publish)
+}
+
+// DeliverMemoReminder marks a due memo reminder as delivered and moves a repeating one to its next
+// reminder time, and reports whether it was still due at deliver.ReminderTs. Only the caller that
+// delivered it notifies the creator, so a reminder is never delivered twice.
+func (s *Store) DeliverMemoReminder(ctx context.Context, deliver *DeliverMemoReminder) (bool, error) {
+	return s.driver.DeliverMemoReminder(ctx, deliver)
+}
+
+// ExpireMemo moves an expired memo to the trash and clears its expiry, so that it can be restored,
+// and reports whether its expiry was still expire.ExpireTs.
+func (s *Store) ExpireMemo(ctx context.Context, expire *ExpireMemo) (bool, error) {
+	return s.driver.ExpireMemo(ctx, expire)
+}
+
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	return s.driver.DeleteMemo(ctx, delete)
 }
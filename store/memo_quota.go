@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// FindMemoCount finds the memos of a creator to count, which is served by the index of the
+// memos on their creator and creation time.
+type FindMemoCount struct {
+	CreatorID int32
+	// CreatedTsAfter counts only the memos created at or after it.
+	CreatedTsAfter *int64
+	Visibility     *Visibility
+	// ExcludeTrashed doesn't count the memos in the trash.
+	ExcludeTrashed bool
+}
+
+// UserMemoQuota is the memo quotas of a user, 0 meaning unlimited.
+type UserMemoQuota struct {
+	MaxMemos             int32
+	MaxMemosPerDay       int32
+	MaxPublicMemosPerDay int32
+}
+
+// MemoQuotaUsage is the number of memos a user created, and made public, on the day starting at
+// DayStartTs. The memos are counted as they're created and made public, so that changing the
+// creation time or the visibility of a memo afterwards doesn't free up the quotas per day.
+type MemoQuotaUsage struct {
+	UserID          int32
+	DayStartTs      int64
+	MemoCount       int32
+	PublicMemoCount int32
+}
+
+type FindMemoQuotaUsage struct {
+	UserID *int32
+}
+
+// CountMemos returns the number of memos matching the find.
+func (s *Store) CountMemos(ctx context.Context, find *FindMemoCount) (int, error) {
+	return s.driver.CountMemos(ctx, find)
+}
+
+// GetUserMemoQuota returns the memo quotas of a user at nowSec: the quotas set on the user by
+// the host, else the limits of the instance. The limit of public memos per day of the instance
+// only applies to new accounts.
+func (s *Store) GetUserMemoQuota(ctx context.Context, user *User, nowSec int64) (*UserMemoQuota, error) {
+	instanceMemoRelatedSetting, err := s.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	quota := &UserMemoQuota{
+		MaxMemos:       instanceMemoRelatedSetting.MaxMemosPerUser,
+		MaxMemosPerDay: instanceMemoRelatedSetting.MaxMemosPerDay,
+	}
+	if user.CreatedTs > nowSec-int64(instanceMemoRelatedSetting.NewAccountDays)*24*60*60 {
+		quota.MaxPublicMemosPerDay = instanceMemoRelatedSetting.NewAccountMaxPublicMemosPerDay
+	}
+
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{UserID: &user.ID, Key: storepb.UserSetting_MEMO_QUOTA})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user setting")
+	}
+	if quotaSetting := userSetting.GetMemoQuota(); quotaSetting != nil {
+		if quotaSetting.MaxMemos != nil {
+			quota.MaxMemos = quotaSetting.GetMaxMemos()
+		}
+		if quotaSetting.MaxMemosPerDay != nil {
+			quota.MaxMemosPerDay = quotaSetting.GetMaxMemosPerDay()
+		}
+		if quotaSetting.MaxPublicMemosPerDay != nil {
+			quota.MaxPublicMemosPerDay = quotaSetting.GetMaxPublicMemosPerDay()
+		}
+	}
+	return quota, nil
+}
+
+// GetMemoQuotaUsage returns the usage of the quotas per day of a user on the day starting at
+// dayStartSec. The usage of a later day still counts, e.g. after the user moved to a time zone
+// behind, so that changing time zones doesn't free up the quotas.
+func (s *Store) GetMemoQuotaUsage(ctx context.Context, userID int32, dayStartSec int64) (*MemoQuotaUsage, error) {
+	list, err := s.driver.ListMemoQuotaUsages(ctx, &FindMemoQuotaUsage{UserID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) > 0 && list[0].DayStartTs >= dayStartSec {
+		return list[0], nil
+	}
+	return &MemoQuotaUsage{UserID: userID, DayStartTs: dayStartSec}, nil
+}
+
+// AddMemoQuotaUsage adds the counts of add to the usage of the quotas per day of its user. The
+// usage of an earlier day is replaced.
+func (s *Store) AddMemoQuotaUsage(ctx context.Context, add *MemoQuotaUsage) error {
+	return s.driver.AddMemoQuotaUsage(ctx, add)
+}
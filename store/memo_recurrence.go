@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MemoRecurrence creates a memo of its creator at each occurrence of a recurrence rule.
+type MemoRecurrence struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+	Paused    bool
+	// NextTs is the time of the next occurrence, which creates a memo once it is due.
+	NextTs int64
+	// LastOccurrenceTs is the time of the occurrence that created the last memo, or 0.
+	LastOccurrenceTs int64
+	Payload          *storepb.MemoRecurrencePayload
+}
+
+type FindMemoRecurrence struct {
+	ID        *int32
+	CreatorID *int32
+	// DueBefore finds the recurrences that aren't paused and whose next occurrence is at or before
+	// the given time.
+	DueBefore *int64
+}
+
+type UpdateMemoRecurrence struct {
+	ID        int32
+	UpdatedTs *int64
+	Paused    *bool
+	NextTs    *int64
+	Payload   *storepb.MemoRecurrencePayload
+}
+
+// AdvanceMemoRecurrence is the move of a due memo recurrence to its next occurrence.
+type AdvanceMemoRecurrence struct {
+	ID int32
+	// NextTs is the next occurrence of the recurrence when it was found. The recurrence isn't
+	// advanced if it has been paused, rescheduled or advanced since.
+	NextTs int64
+	// NewNextTs is the occurrence following the current time.
+	NewNextTs int64
+	// LastOccurrenceTs is the occurrence that creates a memo.
+	LastOccurrenceTs int64
+}
+
+type DeleteMemoRecurrence struct {
+	ID int32
+}
+
+func (s *Store) CreateMemoRecurrence(ctx context.Context, create *MemoRecurrence) (*MemoRecurrence, error) {
+	return s.driver.CreateMemoRecurrence(ctx, create)
+}
+
+func (s *Store) ListMemoRecurrences(ctx context.Context, find *FindMemoRecurrence) ([]*MemoRecurrence, error) {
+	return s.driver.ListMemoRecurrences(ctx, find)
+}
+
+func (s *Store) GetMemoRecurrence(ctx context.Context, find *FindMemoRecurrence) (*MemoRecurrence, error) {
+	list, err := s.ListMemoRecurrences(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateMemoRecurrence(ctx context.Context, update *UpdateMemoRecurrence) error {
+	return s.driver.UpdateMemoRecurrence(ctx, update)
+}
+
+// AdvanceMemoRecurrence moves a due memo recurrence to its next occurrence, and reports whether it
+// was still due at advance.NextTs. Only the caller that advanced it creates the memo of the
+// occurrence, so an occurrence never creates two memos.
+func (s *Store) AdvanceMemoRecurrence(ctx context.Context, advance *AdvanceMemoRecurrence) (bool, error) {
+	return s.driver.AdvanceMemoRecurrence(ctx, advance)
+}
+
+func (s *Store) DeleteMemoRecurrence(ctx context.Context, delete *DeleteMemoRecurrence) error {
+	return s.driver.DeleteMemoRecurrence(ctx, delete)
+}
@@ -11,6 +11,8 @@ const (
 	MemoRelationReference MemoRelationType = "REFERENCE"
 	// MemoRelationComment is the type for a comment memo relation.
 	MemoRelationComment MemoRelationType = "COMMENT"
+	// MemoRelationMerge is the type for the relation from a memo merged into another to that memo.
+	MemoRelationMerge MemoRelationType = "MERGE"
 )
 
 type MemoRelation struct {
@@ -23,7 +25,14 @@ type FindMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
 	Type          *MemoRelationType
-	MemoFilter    *string
+	// MemoFilter finds relations between memos matching the filter. Memos in the trash never match.
+	MemoFilter *string
+	// ExcludeSuspendedCreators excludes the relations from or to the memos of the users suspended by the host.
+	ExcludeSuspendedCreators bool
+
+	// Pagination, ordered by memo ID from newest to oldest.
+	Limit  *int
+	Offset *int
 }
 
 type DeleteMemoRelation struct {
@@ -40,6 +49,15 @@ func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) (
 	return s.driver.ListMemoRelations(ctx, find)
 }
 
+// ListMemoRelationsByRelatedMemo lists the relations pointing to a memo, i.e. its backlinks,
+// further narrowed by the type, filter and pagination of find.
+func (s *Store) ListMemoRelationsByRelatedMemo(ctx context.Context, relatedMemoID int32, find *FindMemoRelation) ([]*MemoRelation, error) {
+	backlinkFind := *find
+	backlinkFind.MemoID = nil
+	backlinkFind.RelatedMemoID = &relatedMemoID
+	return s.driver.ListMemoRelations(ctx, &backlinkFind)
+}
+
 func (s *Store) DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error {
 	return s.driver.DeleteMemoRelation(ctx, delete)
 }
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MemoRevision is the content and the attachments of a memo after an edit.
+type MemoRevision struct {
+	ID     int32
+	MemoID int32
+	// CreatorID is the user who made the edit.
+	CreatorID int32
+	CreatedTs int64
+	Content   string
+	Payload   *storepb.MemoRevisionPayload
+}
+
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+
+	// Pagination, ordered from newest to oldest.
+	Limit  *int
+	Offset *int
+}
+
+type DeleteMemoRevision struct {
+	MemoID int32
+	// BeforeID deletes the revisions older than the revision, i.e. with a smaller ID.
+	BeforeID *int32
+}
+
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoRevisions(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevisions(ctx, delete)
+}
+
+// PruneMemoRevisions deletes the oldest revisions of a memo beyond the newest limit ones.
+func (s *Store) PruneMemoRevisions(ctx context.Context, memoID int32, limit int) error {
+	one, offset := 1, limit-1
+	oldestKept, err := s.GetMemoRevision(ctx, &FindMemoRevision{MemoID: &memoID, Limit: &one, Offset: &offset})
+	if err != nil {
+		return err
+	}
+	if oldestKept == nil {
+		return nil
+	}
+	return s.DeleteMemoRevisions(ctx, &DeleteMemoRevision{MemoID: memoID, BeforeID: &oldestKept.ID})
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MemoSearchQuery is a parsed memo search query: its free-text terms and the filters of its
+// operators.
+type MemoSearchQuery struct {
+	// Terms are the free-text terms, all of which must match, for FindMemo.SearchQuery.
+	Terms []string
+	// Filters are the CEL filters of the operators and of the negated terms, for FindMemo.Filters.
+	Filters []string
+	// Archived is whether the query finds archived memos, with is:archived, which are otherwise
+	// excluded from searches.
+	Archived bool
+}
+
+// memoSearchFlags are the CEL filters of the has: and is: operators of search queries.
+var memoSearchFlags = map[string]string{
+	"has:attachment":      "has_attachment",
+	"has:link":            "has_link",
+	"has:code":            "has_code",
+	"has:task":            "has_task_list",
+	"has:incomplete-task": "has_incomplete_tasks",
+	"is:pinned":           "pinned",
+	"is:archived":         `row_status == "ARCHIVED"`,
+}
+
+// ParseMemoSearchQuery parses a memo search query, whose words are free-text terms or operators:
+//   - has:attachment, has:link, has:code, has:task and has:incomplete-task;
+//   - is:pinned and is:archived;
+//   - before:YYYY-MM-DD and after:YYYY-MM-DD, the memos created before the date, or on the date
+//     or after it, in the location.
+//   - tag:name, the memos tagged with the tag or one of its subtags, e.g. tag:project matches
+//     #project/alpha but not #projects.
+//
+// A leading minus negates an operator or a term. Words with other prefixes, e.g. URLs, are terms.
+func ParseMemoSearchQuery(query string, location *time.Location) (*MemoSearchQuery, error) {
+	parsed := &MemoSearchQuery{}
+	for _, word := range strings.Fields(query) {
+		negated := len(word) > 1 && strings.HasPrefix(word, "-")
+		token := word
+		if negated {
+			token = word[1:]
+		}
+
+		filter, isOperator, err := parseMemoSearchOperator(token, location)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid search operator %q", word)
+		}
+		switch {
+		case !isOperator && !negated:
+			parsed.Terms = append(parsed.Terms, token)
+			continue
+		case !isOperator:
+			filter = fmt.Sprintf("content.contains(%s)", strconv.Quote(token))
+		case strings.EqualFold(token, "is:archived") && !negated:
+			parsed.Archived = true
+		default:
+		}
+		if negated {
+			filter = fmt.Sprintf("!(%s)", filter)
+		}
+		parsed.Filters = append(parsed.Filters, filter)
+	}
+	return parsed, nil
+}
+
+// parseMemoSearchOperator returns the CEL filter of a search operator, and whether the token is
+// an operator at all.
+func parseMemoSearchOperator(token string, location *time.Location) (string, bool, error) {
+	key, value, ok := strings.Cut(token, ":")
+	if !ok {
+		return "", false, nil
+	}
+	key = strings.ToLower(key)
+	switch key {
+	case "has", "is":
+		filter, ok := memoSearchFlags[key+":"+strings.ToLower(value)]
+		if !ok {
+			return "", true, errors.Errorf("unknown value %q", value)
+		}
+		return filter, true, nil
+	case "tag":
+		tag := strings.TrimSuffix(strings.Trim(value, `"`), "/")
+		if tag == "" {
+			return "", true, errors.New("missing tag")
+		}
+		return fmt.Sprintf("tag in [%s]", strconv.Quote(tag)), true, nil
+	case "before", "after":
+		date, err := time.ParseInLocation(time.DateOnly, value, location)
+		if err != nil {
+			return "", true, errors.Errorf("invalid date %q, expected YYYY-MM-DD", value)
+		}
+		if key == "before" {
+			return fmt.Sprintf("created_ts < %d", date.Unix()), true, nil
+		}
+		return fmt.Sprintf("created_ts >= %d", date.Unix()), true, nil
+	default:
+		return "", false, nil
+	}
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// MemoShare is a link that shares a memo read-only with anyone who has it, whatever the visibility
+// of the memo.
+type MemoShare struct {
+	ID int32
+	// UID is the random slug of the link.
+	UID       string
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+	// ExpiresTs is when the link stops working, or 0 if it doesn't expire.
+	ExpiresTs int64
+	// MaxViews is the number of views after which the link stops working, or 0 for no limit.
+	MaxViews  int32
+	ViewCount int32
+	// PassphraseHash is the bcrypt hash of the passphrase of the link, or empty if it has none.
+	PassphraseHash string
+}
+
+// IsExpired returns whether the link expired at nowSec.
+func (s *MemoShare) IsExpired(nowSec int64) bool {
+	return s.ExpiresTs != 0 && s.ExpiresTs <= nowSec
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	UID    *string
+	MemoID *int32
+}
+
+// ViewMemoShare counts a view of a link, if the link has views left.
+type ViewMemoShare struct {
+	ID int32
+}
+
+type UpdateMemoShare struct {
+	ID             int32
+	PassphraseHash *string
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	return s.driver.CreateMemoShare(ctx, create)
+}
+
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// GetActiveMemoShare returns the link of the given slug and its memo, or nil if the link doesn't
+// exist, expired, or its memo is in the trash, scheduled, a draft or by a suspended user.
+// The views left aren't checked, so that the attachments of the last view can still be loaded.
+func (s *Store) GetActiveMemoShare(ctx context.Context, uid string) (*MemoShare, *Memo, error) {
+	if uid == "" {
+		return nil, nil, nil
+	}
+	share, err := s.GetMemoShare(ctx, &FindMemoShare{UID: &uid})
+	if err != nil {
+		return nil, nil, err
+	}
+	if share == nil || share.IsExpired(time.Now().Unix()) {
+		return nil, nil, nil
+	}
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &share.MemoID, IncludeTrashed: true, IncludeScheduled: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	if memo == nil || memo.DeletedTs != 0 || memo.PublishTs != 0 || memo.RowStatus == Draft {
+		return nil, nil, nil
+	}
+	creator, err := s.GetUser(ctx, &FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if creator == nil || creator.SuspendedTs != 0 {
+		return nil, nil, nil
+	}
+	return share, memo, nil
+}
+
+// ViewMemoShare counts a view of a link. It returns false, without counting, if the link has no
+// views left, so that concurrent views don't go over the limit.
+func (s *Store) ViewMemoShare(ctx context.Context, view *ViewMemoShare) (bool, error) {
+	return s.driver.ViewMemoShare(ctx, view)
+}
+
+func (s *Store) UpdateMemoShare(ctx context.Context, update *UpdateMemoShare) error {
+	return s.driver.UpdateMemoShare(ctx, update)
+}
+
+func (s *Store) DeleteMemoShares(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShares(ctx, delete)
+}
@@ -0,0 +1,36 @@
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrMemoSlugTaken is returned when setting a slug that is the slug of another memo, or a
+// previous slug of another memo that still redirects to it.
+var ErrMemoSlugTaken = errors.New("memo slug taken")
+
+// SetMemoSlug changes the slug of a memo. The previous slug of the memo keeps resolving to it
+// until RedirectExpiresTs, so that the links to it don't break immediately.
+type SetMemoSlug struct {
+	MemoID int32
+	// Slug is the new slug of the memo, or empty to remove its slug.
+	Slug string
+	// RedirectExpiresTs is when the previous slug of the memo stops resolving to it, or 0 to
+	// release it now.
+	RedirectExpiresTs int64
+}
+
+type DeleteMemoSlug struct {
+	MemoID int32
+}
+
+// SetMemoSlug changes the slug of a memo, or returns ErrMemoSlugTaken. A previous slug of the
+// memo can be set again, and the expired previous slugs of all memos are released.
+func (s *Store) SetMemoSlug(ctx context.Context, set *SetMemoSlug) error {
+	return s.driver.SetMemoSlug(ctx, set)
+}
+
+// DeleteMemoSlugs deletes the slug and the previous slugs of a memo.
+func (s *Store) DeleteMemoSlugs(ctx context.Context, delete *DeleteMemoSlug) error {
+	return s.driver.DeleteMemoSlugs(ctx, delete)
+}
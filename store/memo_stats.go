@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// memoStatsCacheTTL is how long the memo stats of a user are cached, so they may lag behind the
+// latest edits.
+const memoStatsCacheTTL = 5 * time.Minute
+
+// FindMemoStats finds the writing stats of the memos of a user. Comments, scheduled memos and
+// memos in the trash aren't counted.
+type FindMemoStats struct {
+	CreatorID       int32
+	IncludeArchived bool
+	// UTCOffsetSec is the offset of the time zone that the days of MemoStats.DayCounts start at.
+	UTCOffsetSec int64
+}
+
+// MemoStats are the writing stats of memos, aggregated by the database.
+type MemoStats struct {
+	MemoCount int32
+	// WordCount is the sum of the word counts of the memo payloads.
+	WordCount int64
+	// CharacterCount is the sum of the numbers of characters of the memo contents.
+	CharacterCount int64
+	// TagCounts are the numbers of memos by tag.
+	TagCounts map[string]int32
+	// DayCounts are the numbers of memos created by day, ordered by day.
+	DayCounts []*MemoDayCount
+}
+
+// MemoDayCount is the number of memos created on a day.
+type MemoDayCount struct {
+	// Day is the number of days since the Unix epoch, in the time zone of FindMemoStats.
+	Day   int64
+	Count int32
+}
+
+// GetMemoStats returns the writing stats of the memos of a user, which are cached for
+// memoStatsCacheTTL.
+func (s *Store) GetMemoStats(ctx context.Context, find *FindMemoStats) (*MemoStats, error) {
+	key := fmt.Sprintf("%d-%t-%d", find.CreatorID, find.IncludeArchived, find.UTCOffsetSec)
+	if cached, ok := s.memoStatsCache.Get(ctx, key); ok {
+		if stats, ok := cached.(*MemoStats); ok {
+			return stats, nil
+		}
+	}
+	stats, err := s.driver.GetMemoStats(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	s.memoStatsCache.SetWithTTL(ctx, key, stats, memoStatsCacheTTL)
+	return stats, nil
+}
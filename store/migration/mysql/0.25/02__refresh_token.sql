@@ -0,0 +1,10 @@
+CREATE TABLE `refresh_token` (
+  `token_hash` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `session_id` VARCHAR(256) NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `rotated_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_refresh_token_session_id` ON `refresh_token` (`session_id`);
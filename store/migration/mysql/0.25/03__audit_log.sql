@@ -0,0 +1,11 @@
+CREATE TABLE `audit_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `actor_id` INT NOT NULL DEFAULT 0,
+  `event_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `ip_address` VARCHAR(256) NOT NULL DEFAULT '',
+  `user_agent` TEXT NOT NULL,
+  `payload` TEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_audit_log_created_ts` ON `audit_log` (`created_ts`);
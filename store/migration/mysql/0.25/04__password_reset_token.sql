@@ -0,0 +1,8 @@
+CREATE TABLE `password_reset_token` (
+  `token_hash` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_password_reset_token_user_id` ON `password_reset_token` (`user_id`);
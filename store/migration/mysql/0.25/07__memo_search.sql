@@ -0,0 +1,2 @@
+-- Full-text search index of memo content. The ngram parser also tokenizes CJK text.
+ALTER TABLE `memo` ADD FULLTEXT INDEX `idx_memo_content` (`content`) WITH PARSER ngram;
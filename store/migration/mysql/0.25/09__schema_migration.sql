@@ -0,0 +1,8 @@
+-- History of the applied migration files.
+CREATE TABLE `schema_migration` (
+  `file` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `version` VARCHAR(32) NOT NULL,
+  `checksum` VARCHAR(64) NOT NULL,
+  `duration_ms` BIGINT NOT NULL DEFAULT 0,
+  `applied_ts` BIGINT NOT NULL DEFAULT 0
+);
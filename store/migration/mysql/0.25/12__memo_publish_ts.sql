@@ -0,0 +1,3 @@
+-- Add publish_ts column. Memos with a non-zero publish_ts are scheduled, and only visible to their
+-- creator until they are published.
+ALTER TABLE `memo` ADD COLUMN `publish_ts` BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+-- Add memo_revision table. A revision is the content and the attachments of a memo after an edit.
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `content` TEXT NOT NULL,
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_memo_revision_memo_id` ON `memo_revision` (`memo_id`, `id`);
@@ -0,0 +1,15 @@
+-- Add memo_recurrence table. A recurrence creates a memo from a template or a source memo on a schedule.
+CREATE TABLE `memo_recurrence` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `updated_ts` BIGINT NOT NULL DEFAULT 0,
+  `paused` BOOLEAN NOT NULL DEFAULT FALSE,
+  `next_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_occurrence_ts` BIGINT NOT NULL DEFAULT 0,
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_memo_recurrence_creator_id` ON `memo_recurrence` (`creator_id`);
+
+CREATE INDEX `idx_memo_recurrence_next_ts` ON `memo_recurrence` (`next_ts`);
@@ -0,0 +1,2 @@
+-- Memos may have the DRAFT row status. The row_status column has no constraint on its values,
+-- so the schema is unchanged.
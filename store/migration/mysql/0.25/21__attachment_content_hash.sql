@@ -0,0 +1,4 @@
+-- The SHA-256 of the blob of an attachment, to share the blobs of identical uploads of a user.
+ALTER TABLE `resource` ADD COLUMN `content_hash` VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX `idx_resource_creator_id_content_hash` ON `resource` (`creator_id`, `content_hash`);
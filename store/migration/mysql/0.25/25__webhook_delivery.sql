@@ -0,0 +1,20 @@
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` MEDIUMTEXT NOT NULL,
+  `payload_hash` VARCHAR(64) NOT NULL DEFAULT '',
+  `status` VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `response_status` INT NOT NULL DEFAULT 0,
+  `latency_ms` BIGINT NOT NULL DEFAULT 0,
+  `error` TEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `updated_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_webhook_delivery_user_id_webhook_id` ON `webhook_delivery` (`user_id`, `webhook_id`, `created_ts`);
+
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
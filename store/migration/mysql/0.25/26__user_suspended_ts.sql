@@ -0,0 +1,2 @@
+-- Add suspended_ts column. Users with a non-zero suspended_ts are suspended by the host.
+ALTER TABLE `user` ADD COLUMN `suspended_ts` BIGINT NOT NULL DEFAULT 0;
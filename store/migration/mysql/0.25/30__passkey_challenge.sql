@@ -0,0 +1,4 @@
+CREATE TABLE `passkey_challenge` (
+  `challenge_hash` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
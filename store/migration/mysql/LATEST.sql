@@ -17,7 +17,8 @@ CREATE TABLE `user` (
   `nickname` VARCHAR(256) NOT NULL DEFAULT '',
   `password_hash` VARCHAR(256) NOT NULL,
   `avatar_url` LONGTEXT NOT NULL,
-  `description` VARCHAR(256) NOT NULL DEFAULT ''
+  `description` VARCHAR(256) NOT NULL DEFAULT '',
+  `suspended_ts` BIGINT NOT NULL DEFAULT 0
 );
 
 -- user_setting
@@ -39,7 +40,20 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `pin_order` INT NOT NULL DEFAULT 0,
+  `payload` JSON NOT NULL,
+  `deleted_ts` BIGINT NOT NULL DEFAULT 0,
+  `publish_ts` BIGINT NOT NULL DEFAULT 0,
+  `reminder_ts` BIGINT NOT NULL DEFAULT 0,
+  `reminder_repeat` VARCHAR(256) NOT NULL DEFAULT '',
+  `reminder_delivered_ts` BIGINT NOT NULL DEFAULT 0,
+  `expire_ts` BIGINT NOT NULL DEFAULT 0,
+  FULLTEXT INDEX `idx_memo_content` (`content`) WITH PARSER ngram,
+  INDEX `idx_memo_created_ts` (`created_ts`, `id`),
+  INDEX `idx_memo_creator_id_created_ts` (`creator_id`, `created_ts`),
+  INDEX `idx_memo_updated_ts` (`updated_ts`, `id`),
+  INDEX `idx_memo_reminder_ts` (`reminder_ts`),
+  INDEX `idx_memo_expire_ts` (`expire_ts`)
 );
 
 -- memo_organizer
@@ -72,7 +86,15 @@ CREATE TABLE `resource` (
   `memo_id` INT DEFAULT NULL,
   `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
   `reference` TEXT NOT NULL DEFAULT (''),
-  `payload` TEXT NOT NULL
+  `payload` TEXT NOT NULL,
+  `library` BOOLEAN NOT NULL DEFAULT FALSE,
+  `content_hash` VARCHAR(64) NOT NULL DEFAULT '',
+  `pending` BOOLEAN NOT NULL DEFAULT FALSE,
+  INDEX `idx_resource_creator_id_content_hash` (`creator_id`, `content_hash`),
+  INDEX `idx_resource_creator_id_type` (`creator_id`, `type`),
+  INDEX `idx_resource_creator_id_size` (`creator_id`, `size`),
+  INDEX `idx_resource_creator_id_created_ts` (`creator_id`, `created_ts`),
+  INDEX `idx_resource_memo_id` (`memo_id`)
 );
 
 -- activity
@@ -113,3 +135,159 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- login_attempt
+CREATE TABLE `login_attempt` (
+  `identifier` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `failure_count` INT NOT NULL DEFAULT 0,
+  `first_failed_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_failed_ts` BIGINT NOT NULL DEFAULT 0,
+  `locked_until_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+-- refresh_token
+CREATE TABLE `refresh_token` (
+  `token_hash` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `session_id` VARCHAR(256) NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `rotated_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_refresh_token_session_id` ON `refresh_token` (`session_id`);
+
+-- audit_log
+CREATE TABLE `audit_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `actor_id` INT NOT NULL DEFAULT 0,
+  `event_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `ip_address` VARCHAR(256) NOT NULL DEFAULT '',
+  `user_agent` TEXT NOT NULL,
+  `payload` TEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_audit_log_created_ts` ON `audit_log` (`created_ts`);
+
+-- password_reset_token
+CREATE TABLE `password_reset_token` (
+  `token_hash` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_password_reset_token_user_id` ON `password_reset_token` (`user_id`);
+
+-- signing_key
+CREATE TABLE `signing_key` (
+  `id` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `secret` TEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `retired_ts` BIGINT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+-- schema_migration
+CREATE TABLE `schema_migration` (
+  `file` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `version` VARCHAR(32) NOT NULL,
+  `checksum` VARCHAR(64) NOT NULL,
+  `duration_ms` BIGINT NOT NULL DEFAULT 0,
+  `applied_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `content` TEXT NOT NULL,
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_memo_revision_memo_id` ON `memo_revision` (`memo_id`, `id`);
+
+-- memo_recurrence
+CREATE TABLE `memo_recurrence` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `updated_ts` BIGINT NOT NULL DEFAULT 0,
+  `paused` BOOLEAN NOT NULL DEFAULT FALSE,
+  `next_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_occurrence_ts` BIGINT NOT NULL DEFAULT 0,
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_memo_recurrence_creator_id` ON `memo_recurrence` (`creator_id`);
+
+CREATE INDEX `idx_memo_recurrence_next_ts` ON `memo_recurrence` (`next_ts`);
+
+-- user_storage_usage
+CREATE TABLE `user_storage_usage` (
+  `user_id` INT NOT NULL PRIMARY KEY,
+  `used_bytes` BIGINT NOT NULL DEFAULT 0
+);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` MEDIUMTEXT NOT NULL,
+  `payload_hash` VARCHAR(64) NOT NULL DEFAULT '',
+  `status` VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `response_status` INT NOT NULL DEFAULT 0,
+  `latency_ms` BIGINT NOT NULL DEFAULT 0,
+  `error` TEXT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `updated_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_webhook_delivery_user_id_webhook_id` ON `webhook_delivery` (`user_id`, `webhook_id`, `created_ts`);
+
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` BIGINT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `max_views` INT NOT NULL DEFAULT 0,
+  `view_count` INT NOT NULL DEFAULT 0,
+  `passphrase_hash` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_memo_share_memo_id` ON `memo_share` (`memo_id`);
+
+-- memo_slug
+CREATE TABLE `memo_slug` (
+  `slug` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_memo_slug_memo_id` ON `memo_slug` (`memo_id`);
+
+-- passkey_challenge
+CREATE TABLE `passkey_challenge` (
+  `challenge_hash` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+-- memo_quota_usage
+CREATE TABLE `memo_quota_usage` (
+  `user_id` INT NOT NULL PRIMARY KEY,
+  `day_start_ts` BIGINT NOT NULL DEFAULT 0,
+  `memo_count` INT NOT NULL DEFAULT 0,
+  `public_memo_count` INT NOT NULL DEFAULT 0
+);
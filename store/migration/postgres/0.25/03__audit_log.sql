@@ -0,0 +1,11 @@
+CREATE TABLE audit_log (
+  id SERIAL PRIMARY KEY,
+  actor_id INTEGER NOT NULL DEFAULT 0,
+  event_type TEXT NOT NULL DEFAULT '',
+  ip_address TEXT NOT NULL DEFAULT '',
+  user_agent TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log (created_ts);
@@ -0,0 +1,7 @@
+CREATE TABLE signing_key (
+  id TEXT NOT NULL PRIMARY KEY,
+  secret TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT 0,
+  retired_ts BIGINT NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
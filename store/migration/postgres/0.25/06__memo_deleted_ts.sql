@@ -0,0 +1,2 @@
+-- Add deleted_ts column. Memos with a non-zero deleted_ts are in the trash.
+ALTER TABLE memo ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Full-text search index of memo content.
+ALTER TABLE memo ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED;
+
+CREATE INDEX idx_memo_search_vector ON memo USING GIN (search_vector);
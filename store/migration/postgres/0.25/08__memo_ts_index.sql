@@ -0,0 +1,4 @@
+-- Indexes for keyset pagination of memos by display time.
+CREATE INDEX idx_memo_created_ts ON memo (created_ts, id);
+
+CREATE INDEX idx_memo_updated_ts ON memo (updated_ts, id);
@@ -0,0 +1,8 @@
+-- History of the applied migration files.
+CREATE TABLE schema_migration (
+  file TEXT NOT NULL PRIMARY KEY,
+  version TEXT NOT NULL,
+  checksum TEXT NOT NULL,
+  duration_ms BIGINT NOT NULL DEFAULT 0,
+  applied_ts BIGINT NOT NULL DEFAULT 0
+);
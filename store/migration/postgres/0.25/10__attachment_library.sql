@@ -0,0 +1,2 @@
+-- Library uploads are kept without a memo and never collected as orphans.
+ALTER TABLE resource ADD COLUMN library BOOLEAN NOT NULL DEFAULT FALSE;
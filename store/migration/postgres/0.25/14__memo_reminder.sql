@@ -0,0 +1,7 @@
+-- Add reminder columns. A memo with a non-zero reminder_ts has a reminder, which is due when
+-- reminder_ts has passed and it hasn't been delivered since, i.e. reminder_delivered_ts < reminder_ts.
+ALTER TABLE memo ADD COLUMN reminder_ts BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE memo ADD COLUMN reminder_repeat TEXT NOT NULL DEFAULT '';
+ALTER TABLE memo ADD COLUMN reminder_delivered_ts BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_memo_reminder_ts ON memo (reminder_ts);
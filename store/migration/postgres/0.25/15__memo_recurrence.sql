@@ -0,0 +1,15 @@
+-- Add memo_recurrence table. A recurrence creates a memo from a template or a source memo on a schedule.
+CREATE TABLE memo_recurrence (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
+  next_ts BIGINT NOT NULL DEFAULT 0,
+  last_occurrence_ts BIGINT NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_recurrence_creator_id ON memo_recurrence (creator_id);
+
+CREATE INDEX idx_memo_recurrence_next_ts ON memo_recurrence (next_ts);
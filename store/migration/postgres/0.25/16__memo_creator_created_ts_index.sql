@@ -0,0 +1,2 @@
+-- Index for the memos of a user by creation time, such as the memos created on a day.
+CREATE INDEX idx_memo_creator_id_created_ts ON memo (creator_id, created_ts);
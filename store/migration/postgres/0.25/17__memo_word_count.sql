@@ -0,0 +1,2 @@
+-- The word counts of the memos, used by the writing stats, are stored in the memo payload and
+-- backfilled by backfillMemoWordCount in store/migrator_backfill.go.
@@ -0,0 +1,7 @@
+-- The position of a pinned memo among the pinned memos of its creator, from 1 at the top, and 0
+-- if it isn't pinned. The memos pinned before are ordered by their update time, newest first.
+ALTER TABLE memo ADD COLUMN pin_order INTEGER NOT NULL DEFAULT 0;
+
+UPDATE memo SET pin_order = ranked.pin_order
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY creator_id ORDER BY updated_ts DESC, id DESC) AS pin_order FROM memo WHERE pinned) AS ranked
+WHERE memo.id = ranked.id;
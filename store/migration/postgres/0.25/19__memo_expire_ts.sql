@@ -0,0 +1,5 @@
+-- Add expire_ts column. Memos with a non-zero expire_ts are excluded from reads once it has
+-- passed, then moved to the trash.
+ALTER TABLE memo ADD COLUMN expire_ts BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_memo_expire_ts ON memo (expire_ts);
@@ -0,0 +1,2 @@
+-- Pending attachments are the placeholders of direct uploads to S3, until the upload is completed.
+ALTER TABLE resource ADD COLUMN pending BOOLEAN NOT NULL DEFAULT FALSE;
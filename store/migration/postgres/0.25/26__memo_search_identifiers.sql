@@ -0,0 +1,8 @@
+-- Also index the parts of identifiers, e.g. "Println" of "fmt.Println", which the parser keeps whole.
+DROP INDEX IF EXISTS idx_memo_search_vector;
+
+ALTER TABLE memo DROP COLUMN search_vector;
+
+ALTER TABLE memo ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content) || to_tsvector('simple', regexp_replace(content, '[._]+', ' ', 'g'))) STORED;
+
+CREATE INDEX idx_memo_search_vector ON memo USING GIN (search_vector);
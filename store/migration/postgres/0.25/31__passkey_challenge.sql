@@ -0,0 +1,4 @@
+CREATE TABLE passkey_challenge (
+  challenge_hash TEXT NOT NULL PRIMARY KEY,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
@@ -17,7 +17,8 @@ CREATE TABLE "user" (
   nickname TEXT NOT NULL DEFAULT '',
   password_hash TEXT NOT NULL,
   avatar_url TEXT NOT NULL,
-  description TEXT NOT NULL DEFAULT ''
+  description TEXT NOT NULL DEFAULT '',
+  suspended_ts BIGINT NOT NULL DEFAULT 0
 );
 
 -- user_setting
@@ -39,9 +40,29 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   pinned BOOLEAN NOT NULL DEFAULT FALSE,
-  payload JSONB NOT NULL DEFAULT '{}'
+  pin_order INTEGER NOT NULL DEFAULT 0,
+  payload JSONB NOT NULL DEFAULT '{}',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  publish_ts BIGINT NOT NULL DEFAULT 0,
+  reminder_ts BIGINT NOT NULL DEFAULT 0,
+  reminder_repeat TEXT NOT NULL DEFAULT '',
+  reminder_delivered_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content) || to_tsvector('simple', regexp_replace(content, '[._]+', ' ', 'g'))) STORED
 );
 
+CREATE INDEX idx_memo_search_vector ON memo USING GIN (search_vector);
+
+CREATE INDEX idx_memo_created_ts ON memo (created_ts, id);
+
+CREATE INDEX idx_memo_creator_id_created_ts ON memo (creator_id, created_ts);
+
+CREATE INDEX idx_memo_updated_ts ON memo (updated_ts, id);
+
+CREATE INDEX idx_memo_reminder_ts ON memo (reminder_ts);
+
+CREATE INDEX idx_memo_expire_ts ON memo (expire_ts);
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -72,9 +93,22 @@ CREATE TABLE resource (
   memo_id INTEGER DEFAULT NULL,
   storage_type TEXT NOT NULL DEFAULT '',
   reference TEXT NOT NULL DEFAULT '',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  library BOOLEAN NOT NULL DEFAULT FALSE,
+  content_hash TEXT NOT NULL DEFAULT '',
+  pending BOOLEAN NOT NULL DEFAULT FALSE
 );
 
+CREATE INDEX idx_resource_creator_id_content_hash ON resource (creator_id, content_hash);
+
+CREATE INDEX idx_resource_creator_id_type ON resource (creator_id, type);
+
+CREATE INDEX idx_resource_creator_id_size ON resource (creator_id, size);
+
+CREATE INDEX idx_resource_creator_id_created_ts ON resource (creator_id, created_ts);
+
+CREATE INDEX idx_resource_memo_id ON resource (memo_id);
+
 -- activity
 CREATE TABLE activity (
   id SERIAL PRIMARY KEY,
@@ -113,3 +147,159 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- login_attempt
+CREATE TABLE login_attempt (
+  identifier TEXT NOT NULL PRIMARY KEY,
+  failure_count INTEGER NOT NULL DEFAULT 0,
+  first_failed_ts BIGINT NOT NULL DEFAULT 0,
+  last_failed_ts BIGINT NOT NULL DEFAULT 0,
+  locked_until_ts BIGINT NOT NULL DEFAULT 0
+);
+
+-- refresh_token
+CREATE TABLE refresh_token (
+  token_hash TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  session_id TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  rotated_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_refresh_token_session_id ON refresh_token (session_id);
+
+-- audit_log
+CREATE TABLE audit_log (
+  id SERIAL PRIMARY KEY,
+  actor_id INTEGER NOT NULL DEFAULT 0,
+  event_type TEXT NOT NULL DEFAULT '',
+  ip_address TEXT NOT NULL DEFAULT '',
+  user_agent TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log (created_ts);
+
+-- password_reset_token
+CREATE TABLE password_reset_token (
+  token_hash TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_password_reset_token_user_id ON password_reset_token (user_id);
+
+-- signing_key
+CREATE TABLE signing_key (
+  id TEXT NOT NULL PRIMARY KEY,
+  secret TEXT NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT 0,
+  retired_ts BIGINT NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+-- schema_migration
+CREATE TABLE schema_migration (
+  file TEXT NOT NULL PRIMARY KEY,
+  version TEXT NOT NULL,
+  checksum TEXT NOT NULL,
+  duration_ms BIGINT NOT NULL DEFAULT 0,
+  applied_ts BIGINT NOT NULL DEFAULT 0
+);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id, id);
+
+-- memo_recurrence
+CREATE TABLE memo_recurrence (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
+  next_ts BIGINT NOT NULL DEFAULT 0,
+  last_occurrence_ts BIGINT NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_recurrence_creator_id ON memo_recurrence (creator_id);
+
+CREATE INDEX idx_memo_recurrence_next_ts ON memo_recurrence (next_ts);
+
+-- user_storage_usage
+CREATE TABLE user_storage_usage (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  used_bytes BIGINT NOT NULL DEFAULT 0
+);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  activity_type TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  payload_hash TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT NOT NULL DEFAULT 0,
+  response_status INTEGER NOT NULL DEFAULT 0,
+  latency_ms BIGINT NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_webhook_delivery_user_id_webhook_id ON webhook_delivery (user_id, webhook_id, created_ts);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  uid TEXT NOT NULL UNIQUE,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL DEFAULT 0,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  passphrase_hash TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
+
+-- memo_slug
+CREATE TABLE memo_slug (
+  slug TEXT NOT NULL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_slug_memo_id ON memo_slug (memo_id);
+
+-- passkey_challenge
+CREATE TABLE passkey_challenge (
+  challenge_hash TEXT NOT NULL PRIMARY KEY,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+-- memo_quota_usage
+CREATE TABLE memo_quota_usage (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  day_start_ts BIGINT NOT NULL DEFAULT 0,
+  memo_count INTEGER NOT NULL DEFAULT 0,
+  public_memo_count INTEGER NOT NULL DEFAULT 0
+);
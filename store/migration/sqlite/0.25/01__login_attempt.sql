@@ -0,0 +1,7 @@
+CREATE TABLE login_attempt (
+  identifier TEXT NOT NULL PRIMARY KEY,
+  failure_count INTEGER NOT NULL DEFAULT 0,
+  first_failed_ts BIGINT NOT NULL DEFAULT 0,
+  last_failed_ts BIGINT NOT NULL DEFAULT 0,
+  locked_until_ts BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,4 @@
+-- Full-text search index of memo content, with CJK text split into bigrams.
+CREATE VIRTUAL TABLE memo_search USING fts5(content, tokenize = 'unicode61 remove_diacritics 2');
+
+INSERT INTO memo_search (rowid, content) SELECT id, memo_search_tokenize(content) FROM memo;
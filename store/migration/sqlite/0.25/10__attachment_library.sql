@@ -0,0 +1,2 @@
+-- Library uploads are kept without a memo and never collected as orphans.
+ALTER TABLE resource ADD COLUMN library INTEGER NOT NULL CHECK (library IN (0, 1)) DEFAULT 0;
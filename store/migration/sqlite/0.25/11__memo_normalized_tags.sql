@@ -0,0 +1,2 @@
+-- The normalized tags of the memos, used by tag filters, are stored in the memo payload and
+-- backfilled by backfillMemoNormalizedTags in store/migrator_backfill.go.
@@ -0,0 +1,15 @@
+-- Add memo_recurrence table. A recurrence creates a memo from a template or a source memo on a schedule.
+CREATE TABLE memo_recurrence (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  paused INTEGER NOT NULL CHECK (paused IN (0, 1)) DEFAULT 0,
+  next_ts BIGINT NOT NULL DEFAULT 0,
+  last_occurrence_ts BIGINT NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_recurrence_creator_id ON memo_recurrence (creator_id);
+
+CREATE INDEX idx_memo_recurrence_next_ts ON memo_recurrence (next_ts);
@@ -0,0 +1,81 @@
+-- Allow the DRAFT row status on memos. SQLite can't alter a CHECK constraint, so the memo table
+-- is rebuilt. The memo_search table is keyed by the memo id and is kept as is.
+DROP TABLE IF EXISTS _memo_old;
+
+ALTER TABLE
+  memo RENAME TO _memo_old;
+
+CREATE TABLE memo (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED', 'DRAFT')) DEFAULT 'NORMAL',
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
+  pin_order INTEGER NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  publish_ts BIGINT NOT NULL DEFAULT 0,
+  reminder_ts BIGINT NOT NULL DEFAULT 0,
+  reminder_repeat TEXT NOT NULL DEFAULT '',
+  reminder_delivered_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO
+  memo (
+    id,
+    uid,
+    creator_id,
+    created_ts,
+    updated_ts,
+    row_status,
+    content,
+    visibility,
+    pinned,
+    pin_order,
+    payload,
+    deleted_ts,
+    publish_ts,
+    reminder_ts,
+    reminder_repeat,
+    reminder_delivered_ts,
+    expire_ts
+  )
+SELECT
+  id,
+  uid,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  content,
+  visibility,
+  pinned,
+  pin_order,
+  payload,
+  deleted_ts,
+  publish_ts,
+  reminder_ts,
+  reminder_repeat,
+  reminder_delivered_ts,
+  expire_ts
+FROM
+  _memo_old;
+
+DROP TABLE IF EXISTS _memo_old;
+
+CREATE INDEX idx_memo_creator_id ON memo (creator_id);
+
+CREATE INDEX idx_memo_created_ts ON memo (created_ts, id);
+
+CREATE INDEX idx_memo_creator_id_created_ts ON memo (creator_id, created_ts);
+
+CREATE INDEX idx_memo_updated_ts ON memo (updated_ts, id);
+
+CREATE INDEX idx_memo_reminder_ts ON memo (reminder_ts);
+
+CREATE INDEX idx_memo_expire_ts ON memo (expire_ts);
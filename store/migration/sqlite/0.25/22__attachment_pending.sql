@@ -0,0 +1,2 @@
+-- Pending attachments are the placeholders of direct uploads to S3, until the upload is completed.
+ALTER TABLE resource ADD COLUMN pending INTEGER NOT NULL CHECK (pending IN (0, 1)) DEFAULT 0;
@@ -0,0 +1,5 @@
+-- The storage usages are backfilled by backfillUserStorageUsage in store/migrator_backfill.go.
+CREATE TABLE user_storage_usage (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  used_bytes BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,7 @@
+-- The attachments of a user are filtered by type, size and creation time. Filename substrings
+-- can't use an index.
+CREATE INDEX idx_resource_creator_id_type ON resource (creator_id, type);
+
+CREATE INDEX idx_resource_creator_id_size ON resource (creator_id, size);
+
+CREATE INDEX idx_resource_creator_id_created_ts ON resource (creator_id, created_ts);
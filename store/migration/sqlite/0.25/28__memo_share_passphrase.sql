@@ -0,0 +1,2 @@
+-- Add passphrase_hash column. Share links with a passphrase need it to be entered to view the memo.
+ALTER TABLE memo_share ADD COLUMN passphrase_hash TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,7 @@
+CREATE TABLE memo_slug (
+  slug TEXT NOT NULL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_slug_memo_id ON memo_slug (memo_id);
@@ -0,0 +1,6 @@
+CREATE TABLE memo_quota_usage (
+  user_id INTEGER NOT NULL PRIMARY KEY,
+  day_start_ts BIGINT NOT NULL DEFAULT 0,
+  memo_count INTEGER NOT NULL DEFAULT 0,
+  public_memo_count INTEGER NOT NULL DEFAULT 0
+);
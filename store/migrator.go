@@ -2,18 +2,23 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"embed"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
 	"log/slog"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/version"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -39,6 +44,11 @@ import (
 // - Naming: NN is zero-padded patch number, description is human-readable
 // - Ordering: Files sorted lexicographically and applied in order
 // - LATEST.sql: Full schema for new installations (faster than incremental migrations)
+// - Backfills: Go code completing a migration file, for data that can't be computed in SQL
+//
+// Migration History:
+// - Every applied file is recorded in schema_migration with its checksum and execution duration
+// - A recorded checksum that no longer matches the file reveals a modified migration
 
 //go:embed migration
 var migrationFS embed.FS
@@ -61,8 +71,25 @@ const (
 	// Mode constants for profile mode.
 	modeProd = "prod"
 	modeDemo = "demo"
+
+	// schemaMigrationSinceVersion is the schema version that added the schema_migration table.
+	schemaMigrationSinceVersion = "0.25.10"
 )
 
+// MigrationStatus describes the schema migrations of the database.
+type MigrationStatus struct {
+	// SchemaVersion is the schema version of the database, empty if it isn't initialized.
+	SchemaVersion string
+	// TargetSchemaVersion is the schema version of the running release.
+	TargetSchemaVersion string
+	// Applied is the history of the applied migration files, oldest first.
+	Applied []*SchemaMigration
+	// Modified lists the applied migration files changed since they were applied.
+	Modified []string
+	// Pending lists the migration files to apply, in order.
+	Pending []string
+}
+
 // getSchemaVersionOrDefault returns the schema version or default if empty.
 // This ensures safe version comparisons and handles old installations.
 func getSchemaVersionOrDefault(schemaVersion string) string {
@@ -102,10 +129,25 @@ func validateMigrationFileName(filename string) error {
 	return nil
 }
 
+// MigrationLocker is implemented by drivers that serialize migrations across processes, e.g.
+// replicas pointed at the same SQLite file.
+type MigrationLocker interface {
+	// LockMigration blocks until the migration lock is acquired, and returns a func releasing it.
+	LockMigration(ctx context.Context) (func(), error)
+}
+
 // Migrate migrates the database schema to the latest version.
 // It checks the current schema version and applies any necessary migrations.
 // It also seeds the database with initial data if in demo mode.
 func (s *Store) Migrate(ctx context.Context) error {
+	if locker, ok := unwrapDriver(s.driver).(MigrationLocker); ok {
+		unlock, err := locker.LockMigration(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to acquire migration lock")
+		}
+		defer unlock()
+	}
+
 	if err := s.preMigrate(ctx); err != nil {
 		return errors.Wrap(err, "failed to pre-migrate")
 	}
@@ -130,10 +172,14 @@ func (s *Store) Migrate(ctx context.Context) error {
 		}
 		// Apply migrations if needed (including when schema version is empty)
 		if isVersionEmpty(instanceBasicSetting.SchemaVersion) || version.IsVersionGreaterThan(currentSchemaVersion, instanceBasicSetting.SchemaVersion) {
+			if s.profile.Migrate == profile.MigrateManual {
+				return errors.Errorf("database schema version %s is behind %s, restart with --migrate=dry-run to list the pending migrations or --migrate=auto to apply them", getSchemaVersionOrDefault(instanceBasicSetting.SchemaVersion), currentSchemaVersion)
+			}
 			if err := s.applyMigrations(ctx, instanceBasicSetting.SchemaVersion, currentSchemaVersion); err != nil {
 				return errors.Wrap(err, "failed to apply migrations")
 			}
 		}
+		s.warnModifiedMigrations(ctx)
 	case modeDemo:
 		// In demo mode, we should seed the database.
 		if err := s.seed(ctx); err != nil {
@@ -142,17 +188,17 @@ func (s *Store) Migrate(ctx context.Context) error {
 	default:
 		// For other modes (like dev), no special migration handling needed
 	}
+	s.migrated.Store(true)
 	return nil
 }
 
 // applyMigrations applies all necessary migration files between current and target schema versions.
 // It runs all migrations in a single transaction for atomicity.
 func (s *Store) applyMigrations(ctx context.Context, currentSchemaVersion, targetSchemaVersion string) error {
-	filePaths, err := fs.Glob(migrationFS, fmt.Sprintf("%s*/*.sql", s.getMigrationBasePath()))
+	filePaths, err := s.listPendingMigrationFiles(currentSchemaVersion, targetSchemaVersion)
 	if err != nil {
-		return errors.Wrap(err, "failed to read migration files")
+		return err
 	}
-	sort.Strings(filePaths)
 
 	// Start a transaction to apply migrations atomically
 	tx, err := s.driver.GetDB().Begin()
@@ -172,51 +218,172 @@ func (s *Store) applyMigrations(ctx context.Context, currentSchemaVersion, targe
 		slog.String("currentSchemaVersion", schemaVersionForComparison),
 		slog.String("targetSchemaVersion", targetSchemaVersion))
 
-	migrationsApplied := 0
+	schemaMigrations := []*SchemaMigration{}
 	for _, filePath := range filePaths {
 		fileSchemaVersion, err := s.getSchemaVersionOfMigrateScript(filePath)
 		if err != nil {
 			return errors.Wrap(err, "failed to get schema version of migrate script")
 		}
 
-		if shouldApplyMigration(fileSchemaVersion, currentSchemaVersion, targetSchemaVersion) {
-			// Validate migration filename before applying
-			filename := filepath.Base(filePath)
-			if err := validateMigrationFileName(filename); err != nil {
-				slog.Warn("migration file has invalid name but will be applied", slog.String("file", filePath), slog.String("error", err.Error()))
-			}
+		// Validate migration filename before applying
+		filename := filepath.Base(filePath)
+		if err := validateMigrationFileName(filename); err != nil {
+			slog.Warn("migration file has invalid name but will be applied", slog.String("file", filePath), slog.String("error", err.Error()))
+		}
 
-			slog.Info("applying migration",
-				slog.String("file", filePath),
-				slog.String("version", fileSchemaVersion))
+		slog.Info("applying migration",
+			slog.String("file", filePath),
+			slog.String("version", fileSchemaVersion))
 
-			bytes, err := migrationFS.ReadFile(filePath)
-			if err != nil {
-				return errors.Wrapf(err, "failed to read migration file: %s", filePath)
-			}
+		bytes, err := migrationFS.ReadFile(filePath)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read migration file: %s", filePath)
+		}
 
-			stmt := string(bytes)
+		startTime := time.Now()
+		stmt := string(bytes)
+		if hasSQLStatements(stmt) {
 			if err := s.execute(ctx, tx, stmt); err != nil {
 				return errors.Wrapf(err, "failed to execute migration %s: %s", filePath, err)
 			}
-			migrationsApplied++
 		}
+		if backfill, ok := migrationBackfills[filename]; ok {
+			if err := backfill(ctx, tx, s.profile.Driver); err != nil {
+				return errors.Wrapf(err, "failed to backfill migration %s", filePath)
+			}
+		}
+		schemaMigrations = append(schemaMigrations, &SchemaMigration{
+			File:       strings.TrimPrefix(filePath, s.getMigrationBasePath()),
+			Version:    fileSchemaVersion,
+			Checksum:   migrationChecksum(bytes),
+			DurationMs: time.Since(startTime).Milliseconds(),
+		})
 	}
 
 	if err := tx.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit migration transaction")
 	}
 
-	slog.Info("migration completed", slog.Int("migrationsApplied", migrationsApplied))
+	slog.Info("migration completed", slog.Int("migrationsApplied", len(schemaMigrations)))
 
 	// Update schema version after successful migration
 	if err := s.updateCurrentSchemaVersion(ctx, targetSchemaVersion); err != nil {
 		return errors.Wrap(err, "failed to update current schema version")
 	}
 
+	// Record the history last, the schema_migration table may have been created by the migrations.
+	return s.recordSchemaMigrations(ctx, schemaMigrations)
+}
+
+// listPendingMigrationFiles returns the paths of the migration files between the current and
+// target schema versions, in the order they are applied.
+func (s *Store) listPendingMigrationFiles(currentSchemaVersion, targetSchemaVersion string) ([]string, error) {
+	filePaths, err := fs.Glob(migrationFS, fmt.Sprintf("%s*/*.sql", s.getMigrationBasePath()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read migration files")
+	}
+	slices.Sort(filePaths)
+
+	pending := []string{}
+	for _, filePath := range filePaths {
+		fileSchemaVersion, err := s.getSchemaVersionOfMigrateScript(filePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get schema version of migrate script")
+		}
+		if shouldApplyMigration(fileSchemaVersion, currentSchemaVersion, targetSchemaVersion) {
+			pending = append(pending, filePath)
+		}
+	}
+	return pending, nil
+}
+
+// recordSchemaMigrations records the applied migration files in the migration history.
+func (s *Store) recordSchemaMigrations(ctx context.Context, schemaMigrations []*SchemaMigration) error {
+	appliedTsSec := time.Now().Unix()
+	for _, schemaMigration := range schemaMigrations {
+		schemaMigration.AppliedTs = appliedTsSec
+		if _, err := s.UpsertSchemaMigration(ctx, schemaMigration); err != nil {
+			return errors.Wrapf(err, "failed to record migration %s", schemaMigration.File)
+		}
+	}
 	return nil
 }
 
+// GetMigrationStatus returns the schema version of the database, the history of the applied
+// migration files, and the migration files pending to reach the schema version of the release.
+func (s *Store) GetMigrationStatus(ctx context.Context) (*MigrationStatus, error) {
+	targetSchemaVersion, err := s.GetCurrentSchemaVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current schema version")
+	}
+	status := &MigrationStatus{
+		TargetSchemaVersion: targetSchemaVersion,
+		Applied:             []*SchemaMigration{},
+		Modified:            []string{},
+		Pending:             []string{},
+	}
+
+	initialized, err := s.driver.IsInitialized(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check if database is initialized")
+	}
+	if !initialized {
+		status.Pending = append(status.Pending, LatestSchemaFileName)
+		return status, nil
+	}
+
+	instanceBasicSetting, err := s.GetInstanceBasicSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance basic setting")
+	}
+	status.SchemaVersion = instanceBasicSetting.SchemaVersion
+	filePaths, err := s.listPendingMigrationFiles(status.SchemaVersion, targetSchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	for _, filePath := range filePaths {
+		status.Pending = append(status.Pending, strings.TrimPrefix(filePath, s.getMigrationBasePath()))
+	}
+
+	// Databases older than the schema_migration table have no history.
+	if isVersionEmpty(status.SchemaVersion) || !version.IsVersionGreaterOrEqualThan(status.SchemaVersion, schemaMigrationSinceVersion) {
+		return status, nil
+	}
+	status.Applied, err = s.ListSchemaMigrations(ctx, &FindSchemaMigration{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list schema migrations")
+	}
+	for _, schemaMigration := range status.Applied {
+		// LATEST.sql changes with every release, so it isn't checked.
+		if schemaMigration.File == LatestSchemaFileName {
+			continue
+		}
+		bytes, err := migrationFS.ReadFile(s.getMigrationBasePath() + schemaMigration.File)
+		if err != nil || migrationChecksum(bytes) != schemaMigration.Checksum {
+			status.Modified = append(status.Modified, schemaMigration.File)
+		}
+	}
+	return status, nil
+}
+
+// warnModifiedMigrations logs the applied migration files changed since they were applied.
+func (s *Store) warnModifiedMigrations(ctx context.Context) {
+	status, err := s.GetMigrationStatus(ctx)
+	if err != nil {
+		slog.Warn("failed to get migration status", slog.String("error", err.Error()))
+		return
+	}
+	for _, file := range status.Modified {
+		slog.Warn("migration file was modified after it was applied", slog.String("file", file))
+	}
+}
+
+// migrationChecksum returns the hex-encoded SHA-256 of a migration file.
+func migrationChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
 // preMigrate checks if the database is initialized and applies the latest schema if not.
 func (s *Store) preMigrate(ctx context.Context) error {
 	initialized, err := s.driver.IsInitialized(ctx)
@@ -237,6 +404,7 @@ func (s *Store) preMigrate(ctx context.Context) error {
 		}
 		defer tx.Rollback()
 		slog.Info("initializing new database with latest schema", slog.String("file", filePath))
+		startTime := time.Now()
 		if err := s.execute(ctx, tx, string(bytes)); err != nil {
 			return errors.Errorf("failed to execute SQL file %s, err %s", filePath, err)
 		}
@@ -253,6 +421,15 @@ func (s *Store) preMigrate(ctx context.Context) error {
 		if err := s.updateCurrentSchemaVersion(ctx, schemaVersion); err != nil {
 			return errors.Wrap(err, "failed to update current schema version")
 		}
+		latestSchemaMigration := &SchemaMigration{
+			File:       LatestSchemaFileName,
+			Version:    schemaVersion,
+			Checksum:   migrationChecksum(bytes),
+			DurationMs: time.Since(startTime).Milliseconds(),
+		}
+		if err := s.recordSchemaMigrations(ctx, []*SchemaMigration{latestSchemaMigration}); err != nil {
+			return err
+		}
 	}
 
 	if s.profile.Mode == modeProd {
@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// migrationBackfills are the backfills of data that can't be computed in SQL, by the name of
+// the migration file they complete. A backfill runs in the migration transaction, after the
+// statements of its file.
+var migrationBackfills = map[string]func(ctx context.Context, tx *sql.Tx, driver string) error{
+	"11__memo_normalized_tags.sql": backfillMemoNormalizedTags,
+	"17__memo_word_count.sql":      backfillMemoWordCount,
+	"23__user_storage_usage.sql":   backfillUserStorageUsage,
+}
+
+// hasSQLStatements reports whether a migration file has statements, not only comments, as
+// files completed by a backfill may have none.
+func hasSQLStatements(stmt string) bool {
+	for _, line := range strings.Split(stmt, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
+
+// backfillMemoNormalizedTags sets the normalized tags of the memos written before they were stored.
+func backfillMemoNormalizedTags(ctx context.Context, tx *sql.Tx, driver string) error {
+	payloads, err := listMemoPayloadsWithTags(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	stmt := "UPDATE memo SET payload = ? WHERE id = ?"
+	if driver == "postgres" {
+		stmt = "UPDATE memo SET payload = $1 WHERE id = $2"
+	}
+	for id, payload := range payloads {
+		normalizeMemoPayloadTags(payload)
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload of memo %d", id)
+		}
+		if _, err := tx.ExecContext(ctx, stmt, string(payloadBytes), id); err != nil {
+			return errors.Wrapf(err, "failed to update payload of memo %d", id)
+		}
+	}
+	return nil
+}
+
+// listMemoPayloadsWithTags returns the payloads of the memos with tags, by memo ID. They are read
+// before any update, as a transaction can't run statements while its rows are open on MySQL.
+func listMemoPayloadsWithTags(ctx context.Context, tx *sql.Tx) (map[int32]*storepb.MemoPayload, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id, payload FROM memo")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	defer rows.Close()
+
+	payloads := map[int32]*storepb.MemoPayload{}
+	for rows.Next() {
+		var id int32
+		var payloadBytes []byte
+		if err := rows.Scan(&id, &payloadBytes); err != nil {
+			return nil, errors.Wrap(err, "failed to scan memo")
+		}
+		payload := &storepb.MemoPayload{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload of memo %d", id)
+		}
+		if len(payload.Tags) > 0 {
+			payloads[id] = payload
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	return payloads, nil
+}
+
+// backfillMemoWordCount sets the word counts of the memos written before they were stored.
+func backfillMemoWordCount(ctx context.Context, tx *sql.Tx, driver string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, content, payload FROM memo")
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	defer rows.Close()
+
+	// The payloads are read before any update, as in listMemoPayloadsWithTags.
+	payloads := map[int32]*storepb.MemoPayload{}
+	for rows.Next() {
+		var id int32
+		var content string
+		var payloadBytes []byte
+		if err := rows.Scan(&id, &content, &payloadBytes); err != nil {
+			return errors.Wrap(err, "failed to scan memo")
+		}
+		payload := &storepb.MemoPayload{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payloadBytes, payload); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal payload of memo %d", id)
+		}
+		if payload.Property == nil {
+			payload.Property = &storepb.MemoPayload_Property{}
+		}
+		payload.Property.WordCount = CountMemoWords(content)
+		payloads[id] = payload
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	rows.Close()
+
+	stmt := "UPDATE memo SET payload = ? WHERE id = ?"
+	if driver == "postgres" {
+		stmt = "UPDATE memo SET payload = $1 WHERE id = $2"
+	}
+	for id, payload := range payloads {
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload of memo %d", id)
+		}
+		if _, err := tx.ExecContext(ctx, stmt, string(payloadBytes), id); err != nil {
+			return errors.Wrapf(err, "failed to update payload of memo %d", id)
+		}
+	}
+	return nil
+}
+
+// backfillUserStorageUsage sets the storage usages of the users from the attachments uploaded
+// before they were counted.
+func backfillUserStorageUsage(ctx context.Context, tx *sql.Tx, driver string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, creator_id, storage_type, reference, payload, size, pending FROM resource")
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	defer rows.Close()
+
+	// The attachments are read before any insert, as in listMemoPayloadsWithTags.
+	attachments := []*Attachment{}
+	for rows.Next() {
+		attachment := &Attachment{}
+		var storageType string
+		var payloadBytes []byte
+		if err := rows.Scan(&attachment.ID, &attachment.CreatorID, &storageType, &attachment.Reference, &payloadBytes, &attachment.Size, &attachment.Pending); err != nil {
+			return errors.Wrap(err, "failed to scan attachment")
+		}
+		attachment.StorageType = storepb.AttachmentStorageType(storepb.AttachmentStorageType_value[storageType])
+		attachment.Payload = &storepb.AttachmentPayload{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payloadBytes, attachment.Payload); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal payload of attachment %d", attachment.ID)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	rows.Close()
+
+	stmt := "INSERT INTO user_storage_usage (user_id, used_bytes) VALUES (?, ?)"
+	if driver == "postgres" {
+		stmt = "INSERT INTO user_storage_usage (user_id, used_bytes) VALUES ($1, $2)"
+	}
+	for userID, usedBytes := range sumUserStorageUsages(attachments) {
+		if _, err := tx.ExecContext(ctx, stmt, userID, usedBytes); err != nil {
+			return errors.Wrapf(err, "failed to insert storage usage of user %d", userID)
+		}
+	}
+	return nil
+}
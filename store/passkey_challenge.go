@@ -0,0 +1,28 @@
+package store
+
+import (
+	"context"
+)
+
+// PasskeyChallenge is the challenge of a pending passkey ceremony, consumed when the ceremony is
+// verified so an assertion can't be replayed.
+type PasskeyChallenge struct {
+	// ChallengeHash is the SHA-256 hash of the challenge.
+	ChallengeHash string
+	ExpiresTs     int64
+}
+
+type DeletePasskeyChallenge struct {
+	ChallengeHash *string
+	// ExpiresBefore deletes challenges that expired before the timestamp.
+	ExpiresBefore *int64
+}
+
+func (s *Store) CreatePasskeyChallenge(ctx context.Context, create *PasskeyChallenge) (*PasskeyChallenge, error) {
+	return s.driver.CreatePasskeyChallenge(ctx, create)
+}
+
+// DeletePasskeyChallenges deletes the matching challenges and reports whether any was deleted.
+func (s *Store) DeletePasskeyChallenges(ctx context.Context, delete *DeletePasskeyChallenge) (bool, error) {
+	return s.driver.DeletePasskeyChallenges(ctx, delete)
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+)
+
+// PasswordResetToken is a single-use token for resetting a user's password, sent by email.
+type PasswordResetToken struct {
+	// TokenHash is the SHA-256 hash of the token. The token itself is not stored.
+	TokenHash string
+	UserID    int32
+	CreatedTs int64
+	ExpiresTs int64
+}
+
+type FindPasswordResetToken struct {
+	TokenHash *string
+	UserID    *int32
+}
+
+type DeletePasswordResetToken struct {
+	TokenHash *string
+	UserID    *int32
+	// ExpiresBefore deletes tokens that expired before the timestamp.
+	ExpiresBefore *int64
+}
+
+func (s *Store) CreatePasswordResetToken(ctx context.Context, create *PasswordResetToken) (*PasswordResetToken, error) {
+	return s.driver.CreatePasswordResetToken(ctx, create)
+}
+
+func (s *Store) ListPasswordResetTokens(ctx context.Context, find *FindPasswordResetToken) ([]*PasswordResetToken, error) {
+	return s.driver.ListPasswordResetTokens(ctx, find)
+}
+
+func (s *Store) GetPasswordResetToken(ctx context.Context, find *FindPasswordResetToken) (*PasswordResetToken, error) {
+	list, err := s.ListPasswordResetTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeletePasswordResetTokens(ctx context.Context, delete *DeletePasswordResetToken) error {
+	return s.driver.DeletePasswordResetTokens(ctx, delete)
+}
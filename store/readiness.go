@@ -0,0 +1,37 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// readinessTimeout bounds the database checks of CheckReadiness.
+const readinessTimeout = time.Second
+
+// CheckReadiness reports whether the store can serve requests: the migrations have completed
+// and the database answers a ping and a trivial query within a second.
+func (s *Store) CheckReadiness(ctx context.Context) error {
+	if !s.migrated.Load() {
+		return errors.New("database migrations have not completed")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+	db := s.driver.GetDB()
+	if err := db.PingContext(ctx); err != nil {
+		return errors.Wrap(err, "database is unreachable")
+	}
+	var one int
+	if err := db.QueryRowContext(ctx, "SELECT 1").Scan(&one); err != nil {
+		return errors.Wrap(err, "database query failed")
+	}
+	return nil
+}
+
+// GetDBStats returns the connection pool statistics of the database.
+func (s *Store) GetDBStats() sql.DBStats {
+	return s.driver.GetDB().Stats()
+}
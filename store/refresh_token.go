@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+)
+
+// RefreshToken is a rotating refresh token of a browser session.
+//
+// All refresh tokens issued for a session form a family: rotating a token marks it as rotated
+// and issues its successor with the same SessionID.
+type RefreshToken struct {
+	// TokenHash is the SHA-256 hash of the token. The token itself is not stored.
+	TokenHash string
+	UserID    int32
+	SessionID string
+	CreatedTs int64
+	ExpiresTs int64
+	// RotatedTs is the time the token was exchanged for a new one, or 0 if it is current.
+	RotatedTs int64
+}
+
+type FindRefreshToken struct {
+	TokenHash *string
+	UserID    *int32
+	SessionID *string
+}
+
+type UpdateRefreshToken struct {
+	TokenHash string
+	RotatedTs *int64
+}
+
+type DeleteRefreshToken struct {
+	UserID    *int32
+	SessionID *string
+	// ExpiresBefore deletes tokens that expired before the timestamp.
+	ExpiresBefore *int64
+}
+
+func (s *Store) CreateRefreshToken(ctx context.Context, create *RefreshToken) (*RefreshToken, error) {
+	return s.driver.CreateRefreshToken(ctx, create)
+}
+
+func (s *Store) ListRefreshTokens(ctx context.Context, find *FindRefreshToken) ([]*RefreshToken, error) {
+	return s.driver.ListRefreshTokens(ctx, find)
+}
+
+func (s *Store) GetRefreshToken(ctx context.Context, find *FindRefreshToken) (*RefreshToken, error) {
+	list, err := s.ListRefreshTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateRefreshToken(ctx context.Context, update *UpdateRefreshToken) error {
+	return s.driver.UpdateRefreshToken(ctx, update)
+}
+
+func (s *Store) DeleteRefreshTokens(ctx context.Context, delete *DeleteRefreshToken) error {
+	return s.driver.DeleteRefreshTokens(ctx, delete)
+}
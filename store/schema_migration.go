@@ -0,0 +1,29 @@
+package store
+
+import (
+	"context"
+)
+
+// SchemaMigration records a migration file applied to the database.
+type SchemaMigration struct {
+	// File is the path of the migration file in the migration directory of the driver,
+	// e.g. "0.25/09__schema_migration.sql", or "LATEST.sql" for the initial schema.
+	File    string
+	Version string
+	// Checksum is the hex-encoded SHA-256 of the file content when it was applied.
+	Checksum   string
+	DurationMs int64
+	AppliedTs  int64
+}
+
+type FindSchemaMigration struct {
+	File *string
+}
+
+func (s *Store) UpsertSchemaMigration(ctx context.Context, upsert *SchemaMigration) (*SchemaMigration, error) {
+	return s.driver.UpsertSchemaMigration(ctx, upsert)
+}
+
+func (s *Store) ListSchemaMigrations(ctx context.Context, find *FindSchemaMigration) ([]*SchemaMigration, error) {
+	return s.driver.ListSchemaMigrations(ctx, find)
+}
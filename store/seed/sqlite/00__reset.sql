@@ -9,3 +9,4 @@ DELETE FROM activity;
 DELETE FROM idp;
 DELETE FROM inbox;
 DELETE FROM reaction;
+DELETE FROM memo_search;
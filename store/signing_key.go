@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+)
+
+// signingKeysCacheKey is the signingKeyCache key of the full key set.
+const signingKeysCacheKey = "signing_keys"
+
+// SigningKey is a key that signs and verifies the JWTs issued by the server.
+//
+// Exactly one key, the one with RetiredTs 0, signs new tokens. Retired keys still verify
+// tokens until they expire, so rotating the current key doesn't invalidate existing tokens.
+type SigningKey struct {
+	// ID is the key identifier in the kid header of tokens signed with the key.
+	ID string
+	// Secret is the HMAC secret. It is empty for the legacy key, which uses the instance secret.
+	Secret    string
+	CreatedTs int64
+	// RetiredTs is the time the key was replaced as signing key, or 0 if it is current.
+	RetiredTs int64
+	// ExpiresTs is the time tokens signed with the key stop being accepted, or 0 if they are
+	// accepted until the key is expired explicitly.
+	ExpiresTs int64
+}
+
+type FindSigningKey struct {
+	ID *string
+}
+
+type UpdateSigningKey struct {
+	ID        string
+	RetiredTs *int64
+	ExpiresTs *int64
+}
+
+func (s *Store) CreateSigningKey(ctx context.Context, create *SigningKey) (*SigningKey, error) {
+	signingKey, err := s.driver.CreateSigningKey(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	s.signingKeyCache.Delete(ctx, signingKeysCacheKey)
+	return signingKey, nil
+}
+
+// ListSigningKeys returns the signing keys, newest first.
+//
+// The full key set is read on every token verification, so it is cached.
+func (s *Store) ListSigningKeys(ctx context.Context, find *FindSigningKey) ([]*SigningKey, error) {
+	if find.ID == nil {
+		if cache, ok := s.signingKeyCache.Get(ctx, signingKeysCacheKey); ok {
+			if list, ok := cache.([]*SigningKey); ok {
+				return list, nil
+			}
+		}
+	}
+
+	list, err := s.driver.ListSigningKeys(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if find.ID == nil {
+		s.signingKeyCache.Set(ctx, signingKeysCacheKey, list)
+	}
+	return list, nil
+}
+
+func (s *Store) GetSigningKey(ctx context.Context, find *FindSigningKey) (*SigningKey, error) {
+	list, err := s.ListSigningKeys(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateSigningKey(ctx context.Context, update *UpdateSigningKey) error {
+	if err := s.driver.UpdateSigningKey(ctx, update); err != nil {
+		return err
+	}
+	s.signingKeyCache.Delete(ctx, signingKeysCacheKey)
+	return nil
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/usememos/memos/internal/profile"
@@ -19,6 +20,12 @@ type Store struct {
 	instanceSettingCache *cache.Cache // cache for instance settings
 	userCache            *cache.Cache // cache for users
 	userSettingCache     *cache.Cache // cache for user settings
+	signingKeyCache      *cache.Cache // cache for the signing key set
+	memoStatsCache       *cache.Cache // cache for the memo stats of users
+	instanceStatsCache   *cache.Cache // cache for the instance stats
+
+	// migrated is set once Migrate has completed.
+	migrated atomic.Bool
 }
 
 // New creates a new instance of Store.
@@ -32,12 +39,15 @@ func New(driver Driver, profile *profile.Profile) *Store {
 	}
 
 	store := &Store{
-		driver:               driver,
+		driver:               &metricsDriver{Driver: driver},
 		profile:              profile,
 		cacheConfig:          cacheConfig,
 		instanceSettingCache: cache.New(cacheConfig),
 		userCache:            cache.New(cacheConfig),
 		userSettingCache:     cache.New(cacheConfig),
+		signingKeyCache:      cache.New(cacheConfig),
+		memoStatsCache:       cache.New(cacheConfig),
+		instanceStatsCache:   cache.New(cacheConfig),
 	}
 
 	return store
@@ -52,6 +62,9 @@ func (s *Store) Close() error {
 	s.instanceSettingCache.Close()
 	s.userCache.Close()
 	s.userSettingCache.Close()
+	s.signingKeyCache.Close()
+	s.memoStatsCache.Close()
+	s.instanceStatsCache.Close()
 
 	return s.driver.Close()
 }
@@ -2,11 +2,15 @@ package test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/stretchr/testify/require"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -61,3 +65,195 @@ func TestAttachmentStore(t *testing.T) {
 	require.ErrorContains(t, err, "attachment not found")
 	ts.Close()
 }
+
+func TestPurgeOrphanedAttachments(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	createMemo := func() *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{UID: shortuuid.New(), CreatorID: user.ID, Content: "content", Visibility: store.Public})
+		require.NoError(t, err)
+		return memo
+	}
+	createAttachment := func(create *store.Attachment) *store.Attachment {
+		create.UID = shortuuid.New()
+		create.CreatorID = user.ID
+		create.Filename = "test.txt"
+		create.Type = "text/plain"
+		attachment, err := ts.CreateAttachment(ctx, create)
+		require.NoError(t, err)
+		return attachment
+	}
+
+	memo := createMemo()
+	deletedMemo := createMemo()
+	localFile := filepath.Join(t.TempDir(), "orphan.txt")
+	require.NoError(t, os.WriteFile(localFile, []byte("local"), 0644))
+
+	orphan := createAttachment(&store.Attachment{Blob: []byte("orphan"), Size: 6})
+	localOrphan := createAttachment(&store.Attachment{StorageType: storepb.AttachmentStorageType_LOCAL, Reference: localFile, Size: 5})
+	deletedMemoAttachment := createAttachment(&store.Attachment{Blob: []byte("deleted"), Size: 7, MemoID: &deletedMemo.ID})
+	library := createAttachment(&store.Attachment{Blob: []byte("library"), Size: 7, Library: true})
+	attached := createAttachment(&store.Attachment{Blob: []byte("attached"), Size: 8, MemoID: &memo.ID})
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: deletedMemo.ID}))
+
+	// Attachments within the grace period are kept.
+	result, err := ts.PurgeOrphanedAttachments(ctx, time.Now().Add(-time.Hour).Unix())
+	require.NoError(t, err)
+	require.Zero(t, result.PurgedCount)
+
+	result, err = ts.PurgeOrphanedAttachments(ctx, time.Now().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	require.Equal(t, 3, result.PurgedCount)
+	require.EqualValues(t, 6+5+7, result.ReclaimedBytes)
+	for _, attachment := range []*store.Attachment{orphan, localOrphan, deletedMemoAttachment} {
+		found, err := ts.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+		require.NoError(t, err)
+		require.Nil(t, found)
+	}
+	require.NoFileExists(t, localFile)
+	for _, attachment := range []*store.Attachment{library, attached} {
+		found, err := ts.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID})
+		require.NoError(t, err)
+		require.NotNil(t, found)
+	}
+}
+
+func TestDeleteSharedAttachmentBlob(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	localFile := filepath.Join(t.TempDir(), "shared.txt")
+	require.NoError(t, os.WriteFile(localFile, []byte("shared"), 0644))
+	createAttachment := func() *store.Attachment {
+		attachment, err := ts.CreateAttachment(ctx, &store.Attachment{
+			UID:         shortuuid.New(),
+			CreatorID:   user.ID,
+			Filename:    "shared.txt",
+			Type:        "text/plain",
+			Size:        6,
+			StorageType: storepb.AttachmentStorageType_LOCAL,
+			Reference:   localFile,
+		})
+		require.NoError(t, err)
+		return attachment
+	}
+	attachment, duplicated := createAttachment(), createAttachment()
+
+	// The file is kept until the last attachment referencing it is deleted.
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}))
+	require.FileExists(t, localFile)
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: duplicated.ID}))
+	require.NoFileExists(t, localFile)
+}
+
+func TestUserStorageUsage(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	usedBytes := func() int64 {
+		usedBytes, err := ts.GetUserStorageUsage(ctx, user.ID)
+		require.NoError(t, err)
+		return usedBytes
+	}
+
+	localFile := filepath.Join(t.TempDir(), "shared.txt")
+	require.NoError(t, os.WriteFile(localFile, []byte("shared"), 0644))
+	createAttachment := func(create *store.Attachment) *store.Attachment {
+		create.UID = shortuuid.New()
+		create.CreatorID = user.ID
+		create.Filename = "file.txt"
+		create.Type = "text/plain"
+		attachment, err := ts.CreateAttachment(ctx, create)
+		require.NoError(t, err)
+		return attachment
+	}
+	database := createAttachment(&store.Attachment{Blob: []byte("database"), Size: 8})
+	require.Equal(t, int64(8), usedBytes())
+	// The attachments sharing a file are counted once.
+	local := createAttachment(&store.Attachment{Size: 6, StorageType: storepb.AttachmentStorageType_LOCAL, Reference: localFile})
+	duplicated := createAttachment(&store.Attachment{Size: 6, StorageType: storepb.AttachmentStorageType_LOCAL, Reference: localFile})
+	require.Equal(t, int64(14), usedBytes())
+	// Pending uploads aren't counted.
+	createAttachment(&store.Attachment{Size: 100, StorageType: storepb.AttachmentStorageType_S3, Pending: true})
+	require.Equal(t, int64(14), usedBytes())
+	// Neither are the files of external attachments, which aren't stored.
+	createAttachment(&store.Attachment{Size: 1000, StorageType: storepb.AttachmentStorageType_EXTERNAL, Reference: "https://example.com/file.txt"})
+	require.Equal(t, int64(14), usedBytes())
+
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: local.ID}))
+	require.Equal(t, int64(14), usedBytes())
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: duplicated.ID}))
+	require.Equal(t, int64(8), usedBytes())
+
+	// A drift is fixed by the recalculation.
+	require.NoError(t, ts.AddUserStorageUsage(ctx, user.ID, 1000))
+	require.Equal(t, int64(1008), usedBytes())
+	usages, err := ts.RecalculateUserStorageUsages(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*store.UserStorageUsage{{UserID: user.ID, UsedBytes: 8}}, usages)
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: database.ID}))
+	require.Zero(t, usedBytes())
+	// The usage never goes below zero.
+	require.NoError(t, ts.AddUserStorageUsage(ctx, user.ID, -1000))
+	require.Zero(t, usedBytes())
+}
+
+func TestListAttachmentsFilters(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: shortuuid.New(), CreatorID: user.ID, Content: "content", Visibility: store.Public})
+	require.NoError(t, err)
+
+	createAttachment := func(filename, attachmentType string, size int64, memoID *int32) {
+		_, err := ts.CreateAttachment(ctx, &store.Attachment{UID: shortuuid.New(), CreatorID: user.ID, Filename: filename, Type: attachmentType, Size: size, MemoID: memoID})
+		require.NoError(t, err)
+	}
+	createAttachment("March report.pdf", "application/pdf", 2048, nil)
+	createAttachment("photo.png", "image/png", 4096, &memo.ID)
+	createAttachment("scan.jpg", "image/jpeg", 1024, nil)
+	createAttachment("notes.txt", "text/plain", 10, &memo.ID)
+
+	listFilenames := func(find *store.FindAttachment) []string {
+		attachments, err := ts.ListAttachments(ctx, find)
+		require.NoError(t, err)
+		count, err := ts.CountAttachments(ctx, find)
+		require.NoError(t, err)
+		require.Equal(t, len(attachments), count)
+		filenames := []string{}
+		for _, attachment := range attachments {
+			filenames = append(filenames, attachment.Filename)
+		}
+		return filenames
+	}
+	search, imagePrefix := "report", "image/"
+	require.ElementsMatch(t, []string{"March report.pdf"}, listFilenames(&store.FindAttachment{FilenameSearch: &search}))
+	require.ElementsMatch(t, []string{"photo.png", "scan.jpg"}, listFilenames(&store.FindAttachment{TypePrefix: &imagePrefix}))
+	sizeMin, sizeMax := int64(1024), int64(2048)
+	require.ElementsMatch(t, []string{"March report.pdf", "scan.jpg"}, listFilenames(&store.FindAttachment{SizeMin: &sizeMin, SizeMax: &sizeMax}))
+	require.ElementsMatch(t, []string{"photo.png", "notes.txt"}, listFilenames(&store.FindAttachment{HasRelatedMemo: true}))
+	require.ElementsMatch(t, []string{"March report.pdf", "scan.jpg"}, listFilenames(&store.FindAttachment{WithoutRelatedMemo: true}))
+	require.ElementsMatch(t, []string{"scan.jpg"}, listFilenames(&store.FindAttachment{TypePrefix: &imagePrefix, WithoutRelatedMemo: true}))
+
+	nowSec := time.Now().Unix()
+	before, after := nowSec-3600, nowSec+3600
+	require.Len(t, listFilenames(&store.FindAttachment{CreatedTsAfter: &before, CreatedTsBefore: &after}), 4)
+	require.Empty(t, listFilenames(&store.FindAttachment{CreatedTsAfter: &after}))
+
+	// The count ignores the page.
+	limit := 1
+	count, err := ts.CountAttachments(ctx, &store.FindAttachment{TypePrefix: &imagePrefix, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
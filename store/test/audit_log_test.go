@@ -0,0 +1,65 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAuditLogStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	for i, create := range []*store.AuditLog{
+		{ActorID: 1, EventType: store.AuditEventSignIn, IPAddress: "127.0.0.1", UserAgent: "test", Payload: `{"method":"password"}`},
+		{ActorID: 2, EventType: store.AuditEventSignInFailed, Payload: `{"username":"unknown"}`},
+		{ActorID: 1, EventType: store.AuditEventUserDeleted, Payload: `{"user":"users/2"}`},
+	} {
+		create.CreatedTs = int64(100 * (i + 1))
+		auditLog, err := ts.CreateAuditLog(ctx, create)
+		require.NoError(t, err)
+		require.NotZero(t, auditLog.ID)
+	}
+
+	auditLogs, err := ts.ListAuditLogs(ctx, &store.FindAuditLog{})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 3)
+	// Newest first.
+	require.Equal(t, store.AuditEventUserDeleted, auditLogs[0].EventType)
+	require.Equal(t, "127.0.0.1", auditLogs[2].IPAddress)
+	require.Equal(t, `{"method":"password"}`, auditLogs[2].Payload)
+
+	actorID := int32(1)
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{ActorID: &actorID})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 2)
+
+	eventType := store.AuditEventSignInFailed
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{EventType: &eventType})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 1)
+	require.Equal(t, int32(2), auditLogs[0].ActorID)
+
+	after, before := int64(200), int64(300)
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{CreatedTsAfter: &after, CreatedTsBefore: &before})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 2)
+
+	limit, offset := 1, 1
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 1)
+	require.Equal(t, store.AuditEventSignInFailed, auditLogs[0].EventType)
+
+	cutoff := int64(300)
+	require.NoError(t, ts.DeleteAuditLogs(ctx, &store.DeleteAuditLog{CreatedBefore: &cutoff}))
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{})
+	require.NoError(t, err)
+	require.Len(t, auditLogs, 1)
+	require.Equal(t, store.AuditEventUserDeleted, auditLogs[0].EventType)
+
+	ts.Close()
+}
@@ -29,3 +29,35 @@ func TestInstanceSettingV1Store(t *testing.T) {
 	require.Equal(t, instanceSetting, setting)
 	ts.Close()
 }
+
+func TestInstanceLinkPreviewSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	setting, err := ts.GetInstanceLinkPreviewSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(store.DefaultLinkPreviewRateLimitPerMinute), setting.RateLimitPerMinute)
+	require.Equal(t, int32(store.DefaultLinkPreviewRateLimitBurst), setting.RateLimitBurst)
+	require.Equal(t, int32(store.DefaultLinkPreviewAdminRateLimitPerMinute), setting.AdminRateLimitPerMinute)
+	require.Equal(t, storepb.InstanceLinkPreviewSetting_OPEN, setting.Mode)
+
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_LINK_PREVIEW,
+		Value: &storepb.InstanceSetting_LinkPreviewSetting{
+			LinkPreviewSetting: &storepb.InstanceLinkPreviewSetting{
+				RateLimitPerMinute:   5,
+				Mode:                 storepb.InstanceLinkPreviewSetting_ALLOWLIST,
+				AllowedDomains:       []string{"*.wikipedia.org"},
+				AllowedInternalHosts: []string{"192.168.1.0/24"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	setting, err = ts.GetInstanceLinkPreviewSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(5), setting.RateLimitPerMinute)
+	require.Equal(t, int32(store.DefaultLinkPreviewRateLimitBurst), setting.RateLimitBurst)
+	require.Equal(t, storepb.InstanceLinkPreviewSetting_ALLOWLIST, setting.Mode)
+	require.Equal(t, []string{"*.wikipedia.org"}, setting.AllowedDomains)
+	require.Equal(t, []string{"192.168.1.0/24"}, setting.AllowedInternalHosts)
+	ts.Close()
+}
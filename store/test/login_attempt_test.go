@@ -0,0 +1,66 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestLoginAttemptStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	identifier := "user:test"
+	_, err := ts.UpsertLoginAttempt(ctx, &store.LoginAttempt{
+		Identifier:    identifier,
+		FailureCount:  1,
+		FirstFailedTs: 100,
+		LastFailedTs:  100,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertLoginAttempt(ctx, &store.LoginAttempt{
+		Identifier:    identifier,
+		FailureCount:  2,
+		FirstFailedTs: 100,
+		LastFailedTs:  200,
+	})
+	require.NoError(t, err)
+
+	attempt, err := ts.GetLoginAttempt(ctx, &store.FindLoginAttempt{Identifier: &identifier})
+	require.NoError(t, err)
+	require.Equal(t, &store.LoginAttempt{
+		Identifier:    identifier,
+		FailureCount:  2,
+		FirstFailedTs: 100,
+		LastFailedTs:  200,
+	}, attempt)
+
+	// Locked attempts are kept until the lock expires.
+	lockedIdentifier := "ip:127.0.0.1"
+	_, err = ts.UpsertLoginAttempt(ctx, &store.LoginAttempt{
+		Identifier:    lockedIdentifier,
+		FailureCount:  15,
+		FirstFailedTs: 100,
+		LastFailedTs:  200,
+		LockedUntilTs: 2000,
+	})
+	require.NoError(t, err)
+	cutoff := int64(1000)
+	err = ts.DeleteLoginAttempts(ctx, &store.DeleteLoginAttempt{LastFailedBefore: &cutoff})
+	require.NoError(t, err)
+	attempts, err := ts.ListLoginAttempts(ctx, &store.FindLoginAttempt{})
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	require.Equal(t, lockedIdentifier, attempts[0].Identifier)
+
+	err = ts.DeleteLoginAttempts(ctx, &store.DeleteLoginAttempt{Identifier: &lockedIdentifier})
+	require.NoError(t, err)
+	attempt, err = ts.GetLoginAttempt(ctx, &store.FindLoginAttempt{Identifier: &lockedIdentifier})
+	require.NoError(t, err)
+	require.Nil(t, attempt)
+
+	ts.Close()
+}
@@ -0,0 +1,114 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoCursorPagination(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Memos sharing timestamps, so that the id breaks ties.
+	for i, createdTs := range []int64{1700000300, 1700000100, 1700000200, 1700000100, 1700000200, 1700000100} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("page-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		updatedTs := createdTs + int64(i)
+		pinned := i%3 == 0
+		require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTs, UpdatedTs: &updatedTs, Pinned: &pinned}))
+		if pinned {
+			// The pinned memos are ordered by their pin order, oldest pin at the top.
+			require.NoError(t, ts.PinMemo(ctx, &store.PinMemo{ID: memo.ID, CreatorID: user.ID, Pinned: true, Position: int32(i/3 + 1)}))
+		}
+	}
+
+	for _, find := range []store.FindMemo{
+		{},
+		{OrderByTimeAsc: true},
+		{OrderByPinned: true},
+		{OrderByPinned: true, OrderByTimeAsc: true},
+		{OrderByUpdatedTs: true},
+		{OrderByPinned: true, OrderByUpdatedTs: true, OrderByTimeAsc: true},
+	} {
+		t.Run(fmt.Sprintf("%+v", find), func(t *testing.T) {
+			all, err := ts.ListMemos(ctx, &find)
+			require.NoError(t, err)
+			require.Len(t, all, 6)
+
+			// Paging with cursors lists the same memos in the same order.
+			paged := []*store.Memo{}
+			limit := 4
+			page := find
+			page.Limit = &limit
+			for {
+				memos, err := ts.ListMemos(ctx, &page)
+				require.NoError(t, err)
+				if len(memos) < limit {
+					paged = append(paged, memos...)
+					break
+				}
+				memos = memos[:limit-1]
+				paged = append(paged, memos...)
+				page.Cursor = store.NewMemoCursor(memos[len(memos)-1], &find)
+			}
+			require.Equal(t, all, paged)
+		})
+	}
+
+	ts.Close()
+}
+
+// BenchmarkListMemosPagination compares fetching a page deep into a large instance by offset
+// and by cursor. Run with: go test ./store/test -run ^$ -bench ListMemosPagination.
+func BenchmarkListMemosPagination(b *testing.B) {
+	const memoCount = 100_000
+	const pageSize = 20
+
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, b)
+	defer ts.Close()
+	user, err := ts.CreateUser(ctx, &store.User{Username: "bench", Role: store.RoleHost, Email: "bench@usememos.com", Nickname: "bench"})
+	require.NoError(b, err)
+	for i := 0; i < memoCount; i++ {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("bench-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(b, err)
+	}
+
+	// The page at 90% of the memos, and the cursor of the memo before it.
+	offset := memoCount * 9 / 10
+	find := &store.FindMemo{ExcludeComments: true}
+	before, err := ts.ListMemos(ctx, &store.FindMemo{ExcludeComments: true, Limit: &[]int{1}[0], Offset: &[]int{offset - 1}[0]})
+	require.NoError(b, err)
+	cursor := store.NewMemoCursor(before[0], find)
+	limit := pageSize
+
+	b.Run("offset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := ts.ListMemos(ctx, &store.FindMemo{ExcludeComments: true, Limit: &limit, Offset: &offset})
+			require.NoError(b, err)
+		}
+	})
+	b.Run("cursor", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := ts.ListMemos(ctx, &store.FindMemo{ExcludeComments: true, Limit: &limit, Cursor: cursor})
+			require.NoError(b, err)
+		}
+	})
+}
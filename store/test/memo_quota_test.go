@@ -0,0 +1,51 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoQuotaUsageStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	const daySec = 24 * 60 * 60
+	todaySec := int64(100 * daySec)
+	getUsage := func(dayStartSec int64) *store.MemoQuotaUsage {
+		usage, err := ts.GetMemoQuotaUsage(ctx, user.ID, dayStartSec)
+		require.NoError(t, err)
+		return usage
+	}
+
+	usage := getUsage(todaySec)
+	require.Zero(t, usage.MemoCount)
+	require.Equal(t, todaySec, usage.DayStartTs)
+
+	require.NoError(t, ts.AddMemoQuotaUsage(ctx, &store.MemoQuotaUsage{UserID: user.ID, DayStartTs: todaySec, MemoCount: 1, PublicMemoCount: 1}))
+	require.NoError(t, ts.AddMemoQuotaUsage(ctx, &store.MemoQuotaUsage{UserID: user.ID, DayStartTs: todaySec, MemoCount: 1}))
+	require.NoError(t, ts.AddMemoQuotaUsage(ctx, &store.MemoQuotaUsage{UserID: user.ID, DayStartTs: todaySec, PublicMemoCount: 1}))
+	usage = getUsage(todaySec)
+	require.Equal(t, int32(2), usage.MemoCount)
+	require.Equal(t, int32(2), usage.PublicMemoCount)
+
+	// The usage of a later day still counts, e.g. after moving to a time zone behind, and an
+	// earlier day doesn't move the usage back.
+	require.Equal(t, int32(2), getUsage(todaySec-daySec/2).MemoCount)
+	require.NoError(t, ts.AddMemoQuotaUsage(ctx, &store.MemoQuotaUsage{UserID: user.ID, DayStartTs: todaySec - daySec/2, MemoCount: 1}))
+	usage = getUsage(todaySec)
+	require.Equal(t, int32(3), usage.MemoCount)
+	require.Equal(t, todaySec, usage.DayStartTs)
+
+	// The usage of the next day starts over.
+	require.Zero(t, getUsage(todaySec+daySec).MemoCount)
+	require.NoError(t, ts.AddMemoQuotaUsage(ctx, &store.MemoQuotaUsage{UserID: user.ID, DayStartTs: todaySec + daySec, MemoCount: 1}))
+	usage = getUsage(todaySec + daySec)
+	require.Equal(t, int32(1), usage.MemoCount)
+	require.Zero(t, usage.PublicMemoCount)
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRecurrenceStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memoRecurrence, err := ts.CreateMemoRecurrence(ctx, &store.MemoRecurrence{
+		CreatorID: user.ID,
+		CreatedTs: 100,
+		UpdatedTs: 100,
+		NextTs:    1000,
+		Payload: &storepb.MemoRecurrencePayload{
+			Rrule:     "FREQ=DAILY",
+			TimeOfDay: "08:00",
+			Timezone:  "UTC",
+			Source:    &storepb.MemoRecurrencePayload_MemoTemplateId{MemoTemplateId: "template"},
+			Tags:      []string{"daily"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, memoRecurrence.ID)
+
+	found, err := ts.GetMemoRecurrence(ctx, &store.FindMemoRecurrence{ID: &memoRecurrence.ID})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, found.CreatorID)
+	require.Equal(t, int64(1000), found.NextTs)
+	require.Equal(t, "template", found.Payload.GetMemoTemplateId())
+	require.Equal(t, []string{"daily"}, found.Payload.Tags)
+
+	// Only the recurrences that aren't paused are due.
+	beforeSec, afterSec := int64(999), int64(1000)
+	due, err := ts.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{DueBefore: &beforeSec})
+	require.NoError(t, err)
+	require.Empty(t, due)
+	due, err = ts.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{DueBefore: &afterSec})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	paused := true
+	require.NoError(t, ts.UpdateMemoRecurrence(ctx, &store.UpdateMemoRecurrence{ID: memoRecurrence.ID, Paused: &paused}))
+	due, err = ts.ListMemoRecurrences(ctx, &store.FindMemoRecurrence{DueBefore: &afterSec})
+	require.NoError(t, err)
+	require.Empty(t, due)
+	advanced, err := ts.AdvanceMemoRecurrence(ctx, &store.AdvanceMemoRecurrence{ID: memoRecurrence.ID, NextTs: 1000, NewNextTs: 2000, LastOccurrenceTs: 1000})
+	require.NoError(t, err)
+	require.False(t, advanced)
+
+	// A due recurrence is advanced once.
+	paused = false
+	require.NoError(t, ts.UpdateMemoRecurrence(ctx, &store.UpdateMemoRecurrence{ID: memoRecurrence.ID, Paused: &paused}))
+	advanced, err = ts.AdvanceMemoRecurrence(ctx, &store.AdvanceMemoRecurrence{ID: memoRecurrence.ID, NextTs: 1000, NewNextTs: 2000, LastOccurrenceTs: 1000})
+	require.NoError(t, err)
+	require.True(t, advanced)
+	advanced, err = ts.AdvanceMemoRecurrence(ctx, &store.AdvanceMemoRecurrence{ID: memoRecurrence.ID, NextTs: 1000, NewNextTs: 2000, LastOccurrenceTs: 1000})
+	require.NoError(t, err)
+	require.False(t, advanced)
+	found, err = ts.GetMemoRecurrence(ctx, &store.FindMemoRecurrence{ID: &memoRecurrence.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(2000), found.NextTs)
+	require.Equal(t, int64(1000), found.LastOccurrenceTs)
+
+	require.NoError(t, ts.DeleteMemoRecurrence(ctx, &store.DeleteMemoRecurrence{ID: memoRecurrence.ID}))
+	found, err = ts.GetMemoRecurrence(ctx, &store.FindMemoRecurrence{ID: &memoRecurrence.ID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "v0",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	for i, content := range []string{"v1", "v2", "v3", "v4"} {
+		revision, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+			MemoID:    memo.ID,
+			CreatorID: user.ID,
+			CreatedTs: int64(100 * (i + 1)),
+			Content:   content,
+			Payload: &storepb.MemoRevisionPayload{
+				Attachments: []*storepb.MemoRevisionPayload_Attachment{{Uid: "attachment", Filename: "a.txt", Type: "text/plain"}},
+			},
+		})
+		require.NoError(t, err)
+		require.NotZero(t, revision.ID)
+	}
+
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 4)
+	// Newest first.
+	require.Equal(t, "v4", revisions[0].Content)
+	require.Equal(t, int64(400), revisions[0].CreatedTs)
+	require.Equal(t, "a.txt", revisions[0].Payload.Attachments[0].Filename)
+
+	revision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &revisions[3].ID})
+	require.NoError(t, err)
+	require.Equal(t, "v1", revision.Content)
+
+	// Pruning keeps the newest revisions.
+	require.NoError(t, ts.PruneMemoRevisions(ctx, memo.ID, 2))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, "v4", revisions[0].Content)
+	require.Equal(t, "v3", revisions[1].Content)
+	require.NoError(t, ts.PruneMemoRevisions(ctx, memo.ID, 5))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+
+	require.NoError(t, ts.DeleteMemoRevisions(ctx, &store.DeleteMemoRevision{MemoID: memo.ID}))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+}
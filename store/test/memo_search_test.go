@@ -0,0 +1,266 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoSearchStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	contents := []string{
+		"Weekly meeting notes: discuss the release plan",
+		"Meeting room booking, meeting agenda and meeting minutes",
+		"今天学习了全文检索的实现",
+		"週末は東京で買い物をしました",
+		"Grocery list: milk, eggs",
+	}
+	memoIDs := []int32{}
+	for i, content := range contents {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("search-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	search := func(query string) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{SearchQuery: &query})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+
+	// Every term must match, and the memo with more matches ranks first.
+	require.Equal(t, []string{"search-memo-1", "search-memo-0"}, search("meeting"))
+	require.Equal(t, []string{"search-memo-0"}, search("meeting release"))
+	require.Empty(t, search("meeting milk"))
+
+	// CJK text is searchable by words within sentences.
+	require.Equal(t, []string{"search-memo-2"}, search("全文检索"))
+	require.Equal(t, []string{"search-memo-2"}, search("学习"))
+	require.Equal(t, []string{"search-memo-3"}, search("東京"))
+
+	// The index follows updates and deletes.
+	content := "Shopping list: bread and butter"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memoIDs[4], Content: &content}))
+	require.Empty(t, search("milk"))
+	require.Equal(t, []string{"search-memo-4"}, search("bread"))
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memoIDs[4]}))
+	require.Empty(t, search("bread"))
+
+	ts.Close()
+}
+
+func TestMemoSearchStoreAttachmentsAndCode(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	contents := []string{
+		"",
+		"Pay the invoice before Friday",
+		"Debugging today:\n\n```go\nfunc parse_memo_query() {\n\tfmt.Println(\"config.yaml\")\n}\n```",
+	}
+	memoIDs := []int32{}
+	for i, content := range contents {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("search-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{UID: "invoice", CreatorID: user.ID, Filename: "invoice-march.pdf", Type: "application/pdf", MemoID: &memoIDs[0]})
+	require.NoError(t, err)
+	search := func(query string) ([]string, []bool) {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{SearchQuery: &query})
+		require.NoError(t, err)
+		uids, matchedAttachment := []string{}, []bool{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+			matchedAttachment = append(matchedAttachment, memo.SearchMatchedAttachment)
+		}
+		return uids, matchedAttachment
+	}
+
+	// Filenames of attachments match, after the memos whose content matches.
+	uids, matchedAttachment := search("invoice")
+	require.Equal(t, []string{"search-memo-1", "search-memo-0"}, uids)
+	require.Equal(t, []bool{false, true}, matchedAttachment)
+	uids, _ = search("invoice march")
+	require.Equal(t, []string{"search-memo-0"}, uids)
+	uids, _ = search("invoice Friday")
+	require.Equal(t, []string{"search-memo-1"}, uids)
+	// The wildcards of LIKE patterns are matched literally.
+	uids, _ = search("e%m")
+	require.Empty(t, uids)
+
+	// Code blocks are searchable, by whole identifiers or their parts.
+	for _, query := range []string{"parse_memo_query", "fmt.Println", "Println", "config.yaml", "yaml"} {
+		uids, matchedAttachment := search(query)
+		require.Equal(t, []string{"search-memo-2"}, uids, query)
+		require.Equal(t, []bool{false}, matchedAttachment, query)
+	}
+
+	ts.Close()
+}
+
+func TestMemoSearchStoreFallback(t *testing.T) {
+	ctx := context.Background()
+	if getDriverFromEnv() != "sqlite" {
+		t.Skip("dropping the search index is only supported on SQLite")
+	}
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "search-memo",
+		CreatorID:  user.ID,
+		Content:    "Weekly meeting notes",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// Without the search index, memos are matched by substring.
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE memo_search")
+	require.NoError(t, err)
+	query := "meet notes"
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{SearchQuery: &query})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+
+	ts.Close()
+}
+
+func TestParseMemoSearchQuery(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	marchSec := time.Date(2024, time.March, 1, 0, 0, 0, 0, berlin).Unix()
+	aprilSec := time.Date(2024, time.April, 1, 0, 0, 0, 0, berlin).Unix()
+
+	query, err := store.ParseMemoSearchQuery("meeting HAS:Attachment after:2024-03-01 before:2024-04-01 -is:pinned -draft https://example.com", berlin)
+	require.NoError(t, err)
+	require.Equal(t, []string{"meeting", "https://example.com"}, query.Terms)
+	require.Equal(t, []string{
+		"has_attachment",
+		fmt.Sprintf("created_ts >= %d", marchSec),
+		fmt.Sprintf("created_ts < %d", aprilSec),
+		"!(pinned)",
+		`!(content.contains("draft"))`,
+	}, query.Filters)
+	require.False(t, query.Archived)
+
+	query, err = store.ParseMemoSearchQuery("is:archived has:link has:task has:incomplete-task has:code - 10:30", time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-", "10:30"}, query.Terms)
+	require.Equal(t, []string{`row_status == "ARCHIVED"`, "has_link", "has_task_list", "has_incomplete_tasks", "has_code"}, query.Filters)
+	require.True(t, query.Archived)
+
+	query, err = store.ParseMemoSearchQuery("-is:archived", time.UTC)
+	require.NoError(t, err)
+	require.Empty(t, query.Terms)
+	require.Equal(t, []string{`!(row_status == "ARCHIVED")`}, query.Filters)
+	require.False(t, query.Archived)
+
+	query, err = store.ParseMemoSearchQuery(`tag:project TAG:"work/" -tag:done`, time.UTC)
+	require.NoError(t, err)
+	require.Empty(t, query.Terms)
+	require.Equal(t, []string{`tag in ["project"]`, `tag in ["work"]`, `!(tag in ["done"])`}, query.Filters)
+
+	for input, token := range map[string]string{
+		"notes has:video":      `"has:video"`,
+		"tag:/":                `"tag:/"`,
+		"-is:":                 `"-is:"`,
+		"before:2024-13-01":    `"before:2024-13-01"`,
+		"after:yesterday memo": `"after:yesterday"`,
+	} {
+		_, err := store.ParseMemoSearchQuery(input, time.UTC)
+		require.ErrorContains(t, err, token, input)
+	}
+}
+
+func TestMemoSearchStoreOperators(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	createMemo := func(uid, content string, createdTs int64, property *storepb.MemoPayload_Property) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+			Payload:    &storepb.MemoPayload{Property: property},
+		})
+		require.NoError(t, err)
+		require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTs}))
+		return memo
+	}
+	marchSec := time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC).Unix()
+	maySec := time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC).Unix()
+	photo := createMemo("photo", "Trip photos from the lake", marchSec, &storepb.MemoPayload_Property{HasLink: true})
+	createMemo("link", "Trip planning https://example.com", marchSec, &storepb.MemoPayload_Property{HasLink: true})
+	createMemo("tasks", "- [ ] book the trip", maySec, &storepb.MemoPayload_Property{HasTaskList: true, HasIncompleteTasks: true})
+	createMemo("plain", "Trip notes", maySec, nil)
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       "photo-attachment",
+		CreatorID: user.ID,
+		Filename:  "lake.jpg",
+		Blob:      []byte("jpeg"),
+		Type:      "image/jpeg",
+		Size:      4,
+		MemoID:    &photo.ID,
+	})
+	require.NoError(t, err)
+
+	search := func(input string) []string {
+		query, err := store.ParseMemoSearchQuery(input, time.UTC)
+		require.NoError(t, err)
+		find := &store.FindMemo{Filters: query.Filters}
+		if len(query.Terms) > 0 {
+			terms := strings.Join(query.Terms, " ")
+			find.SearchQuery = &terms
+		}
+		memos, err := ts.ListMemos(ctx, find)
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		slices.Sort(uids)
+		return uids
+	}
+
+	require.Equal(t, []string{"photo"}, search("has:attachment"))
+	require.Equal(t, []string{"link", "plain", "tasks"}, search("-has:attachment"))
+	require.Equal(t, []string{"link", "photo"}, search("trip has:link after:2024-03-01 before:2024-04-01"))
+	require.Equal(t, []string{"link"}, search("trip has:link -has:attachment"))
+	require.Equal(t, []string{"plain", "tasks"}, search("-has:link"))
+	require.Equal(t, []string{"tasks"}, search("has:task has:incomplete-task after:2024-04-01"))
+	require.Equal(t, []string{"photo", "plain", "tasks"}, search("trip -planning"))
+	require.Empty(t, search("trip before:2024-03-01"))
+
+	ts.Close()
+}
@@ -0,0 +1,67 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "shared", CreatorID: user.ID, Content: "shared", Visibility: store.Private})
+	require.NoError(t, err)
+
+	limited, err := ts.CreateMemoShare(ctx, &store.MemoShare{UID: "limited", MemoID: memo.ID, CreatorID: user.ID, MaxViews: 2})
+	require.NoError(t, err)
+	unlimited, err := ts.CreateMemoShare(ctx, &store.MemoShare{UID: "unlimited", MemoID: memo.ID, CreatorID: user.ID})
+	require.NoError(t, err)
+
+	uid := "limited"
+	found, err := ts.GetMemoShare(ctx, &store.FindMemoShare{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, limited.ID, found.ID)
+	require.Equal(t, int32(2), found.MaxViews)
+	require.Empty(t, found.PassphraseHash)
+
+	passphraseHash := "hash"
+	require.NoError(t, ts.UpdateMemoShare(ctx, &store.UpdateMemoShare{ID: limited.ID, PassphraseHash: &passphraseHash}))
+	found, err = ts.GetMemoShare(ctx, &store.FindMemoShare{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, "hash", found.PassphraseHash)
+
+	// The views stop being counted once the link has none left.
+	for range 2 {
+		viewed, err := ts.ViewMemoShare(ctx, &store.ViewMemoShare{ID: limited.ID})
+		require.NoError(t, err)
+		require.True(t, viewed)
+	}
+	viewed, err := ts.ViewMemoShare(ctx, &store.ViewMemoShare{ID: limited.ID})
+	require.NoError(t, err)
+	require.False(t, viewed)
+	found, err = ts.GetMemoShare(ctx, &store.FindMemoShare{ID: &limited.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.ViewCount)
+	for range 3 {
+		viewed, err := ts.ViewMemoShare(ctx, &store.ViewMemoShare{ID: unlimited.ID})
+		require.NoError(t, err)
+		require.True(t, viewed)
+	}
+
+	require.NoError(t, ts.DeleteMemoShares(ctx, &store.DeleteMemoShare{ID: &limited.ID}))
+	shares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, unlimited.ID, shares[0].ID)
+
+	require.NoError(t, ts.DeleteMemoShares(ctx, &store.DeleteMemoShare{MemoID: &memo.ID}))
+	shares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, shares)
+}
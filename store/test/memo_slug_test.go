@@ -0,0 +1,93 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoSlugStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: user.ID, Content: "memo", Visibility: store.Public})
+	require.NoError(t, err)
+	other, err := ts.CreateMemo(ctx, &store.Memo{UID: "other", CreatorID: user.ID, Content: "other", Visibility: store.Public})
+	require.NoError(t, err)
+	findBySlug := func(slug string) *store.Memo {
+		found, err := ts.GetMemo(ctx, &store.FindMemo{Slug: &slug})
+		require.NoError(t, err)
+		return found
+	}
+	redirectExpiresSec := time.Now().Add(time.Hour).Unix()
+
+	require.NoError(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: memo.ID, Slug: "weekly-review", RedirectExpiresTs: redirectExpiresSec}))
+	found := findBySlug("weekly-review")
+	require.Equal(t, memo.ID, found.ID)
+	require.Equal(t, "weekly-review", found.Slug)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &other.ID})
+	require.NoError(t, err)
+	require.Empty(t, found.Slug)
+	require.Nil(t, findBySlug("unknown"))
+
+	// The previous slug keeps resolving to the memo, and no other memo can take it.
+	require.NoError(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: memo.ID, Slug: "review", RedirectExpiresTs: redirectExpiresSec}))
+	found = findBySlug("weekly-review")
+	require.Equal(t, memo.ID, found.ID)
+	require.Equal(t, "review", found.Slug)
+	require.ErrorIs(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: other.ID, Slug: "weekly-review"}), store.ErrMemoSlugTaken)
+	require.ErrorIs(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: other.ID, Slug: "review"}), store.ErrMemoSlugTaken)
+
+	// The memo can take its previous slug back.
+	require.NoError(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: memo.ID, Slug: "weekly-review", RedirectExpiresTs: redirectExpiresSec}))
+	require.Equal(t, "weekly-review", findBySlug("review").Slug)
+
+	// Previous slugs are released once they stop redirecting.
+	require.NoError(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: memo.ID, RedirectExpiresTs: time.Now().Add(-time.Minute).Unix()}))
+	require.Nil(t, findBySlug("weekly-review"))
+	require.NoError(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: other.ID, Slug: "weekly-review"}))
+	require.Equal(t, other.ID, findBySlug("weekly-review").ID)
+
+	require.NoError(t, ts.DeleteMemoSlugs(ctx, &store.DeleteMemoSlug{MemoID: other.ID}))
+	require.Nil(t, findBySlug("weekly-review"))
+	require.Equal(t, memo.ID, findBySlug("review").ID)
+}
+
+func TestGetPublicMemo(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: user.ID, Content: "memo", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "private", CreatorID: user.ID, Content: "private", Visibility: store.Private})
+	require.NoError(t, err)
+	require.NoError(t, ts.SetMemoSlug(ctx, &store.SetMemoSlug{MemoID: memo.ID, Slug: "weekly-review"}))
+
+	for _, name := range []string{"memo", "weekly-review"} {
+		found, creator, err := ts.GetPublicMemo(ctx, name)
+		require.NoError(t, err)
+		require.Equal(t, memo.ID, found.ID)
+		require.Equal(t, user.ID, creator.ID)
+	}
+	for _, name := range []string{"private", "unknown"} {
+		found, _, err := ts.GetPublicMemo(ctx, name)
+		require.NoError(t, err)
+		require.Nil(t, found)
+	}
+
+	// The memos of suspended users aren't public.
+	suspendedSec := time.Now().Unix()
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, SuspendedTs: &suspendedSec})
+	require.NoError(t, err)
+	found, _, err := ts.GetPublicMemo(ctx, "weekly-review")
+	require.NoError(t, err)
+	require.Nil(t, found)
+}
@@ -94,6 +94,116 @@ func TestMemoListByTags(t *testing.T) {
 	ts.Close()
 }
 
+// TestMemoListByNormalizedTags asserts that tag filters are case- and accent-insensitive, with
+// the same results on every driver.
+func TestMemoListByNormalizedTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	uids := map[string][]string{
+		"todo":    {"Todo"},
+		"lower":   {"todo", "work/Project"},
+		"cafe":    {"Café"},
+		"nfd":     {"cafe\u0301/Paris"},
+		"unicode": {"标签"},
+	}
+	for uid, tags := range uids {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "content",
+			Visibility: store.Public,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `tag in ["todo"]`, want: []string{"todo", "lower"}},
+		{filter: `tag in ["TODO"]`, want: []string{"todo", "lower"}},
+		{filter: `tag in ["cafe"]`, want: []string{"cafe", "nfd"}},
+		{filter: `tag in ["CAFÉ"]`, want: []string{"cafe", "nfd"}},
+		{filter: `tag in ["cafe\u0301/paris"]`, want: []string{"nfd"}},
+		{filter: `tag in ["Work"]`, want: []string{"lower"}},
+		{filter: `tag in ["work/project"]`, want: []string{"lower"}},
+		{filter: `tag in ["标签"]`, want: []string{"unicode"}},
+		{filter: `"Todo" in tags`, want: []string{"todo", "lower"}},
+		{filter: `"café" in tags`, want: []string{"cafe"}},
+		{filter: `tag in ["café", "todo"]`, want: []string{"todo", "lower", "cafe", "nfd"}},
+	}
+	for _, test := range tests {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{test.filter}})
+		require.NoError(t, err)
+		got := []string{}
+		for _, memo := range memos {
+			got = append(got, memo.UID)
+		}
+		require.ElementsMatch(t, test.want, got, test.filter)
+	}
+
+	// The tags are kept as written for display.
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{UID: &[]string{"cafe"}[0]})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Café"}, memo.Payload.Tags)
+	require.Equal(t, []string{"cafe"}, memo.Payload.NormalizedTags)
+}
+
+// TestMemoListByTagHierarchy asserts that tag filters match the subtags of a tag, but not the
+// tags it's a plain prefix of.
+func TestMemoListByTagHierarchy(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	uids := map[string][]string{
+		"project": {"project"},
+		"alpha":   {"Project/Alpha"},
+		"nested":  {"project/beta/notes"},
+		"plural":  {"projects"},
+		"work":    {"work"},
+		"workout": {"workout", "workouts/running"},
+	}
+	for uid, tags := range uids {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "content",
+			Visibility: store.Public,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `tag in ["project"]`, want: []string{"project", "alpha", "nested"}},
+		{filter: `tag in ["project/beta"]`, want: []string{"nested"}},
+		{filter: `tag in ["project/alpha"]`, want: []string{"alpha"}},
+		{filter: `tag in ["work"]`, want: []string{"work"}},
+		{filter: `tag in ["workout"]`, want: []string{"workout"}},
+		{filter: `"project" in tags`, want: []string{"project"}},
+	}
+	for _, test := range tests {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{test.filter}})
+		require.NoError(t, err)
+		got := []string{}
+		for _, memo := range memos {
+			got = append(got, memo.UID)
+		}
+		require.ElementsMatch(t, test.want, got, test.filter)
+	}
+}
+
 func TestDeleteMemoStore(t *testing.T) {
 	ctx := context.Background()
 	ts := NewTestingStore(ctx, t)
@@ -114,3 +224,314 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestMemoTrashStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.Zero(t, memo.DeletedTs)
+
+	deletedTs := int64(1700000000)
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+
+	// Memos in the trash are excluded by default.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, Trashed: true})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, deletedTs, memoList[0].DeletedTs)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID, IncludeTrashed: true})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+
+	before := deletedTs
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{Trashed: true, DeletedBefore: &before})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+	before = deletedTs + 1
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{Trashed: true, DeletedBefore: &before})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	ts.Close()
+}
+
+func TestMemoScheduleStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	publishTs := int64(1700000000)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+		PublishTs:  publishTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, publishTs, memo.PublishTs)
+
+	// Scheduled memos are excluded by default.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, Scheduled: true})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	before := publishTs - 1
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{Scheduled: true, PublishBefore: &before})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+
+	// Publishing is conditional on the expected publish time.
+	published, err := ts.PublishMemo(ctx, &store.PublishMemo{ID: memo.ID, PublishTs: publishTs + 1, DisplayTs: publishTs})
+	require.NoError(t, err)
+	require.False(t, published)
+	published, err = ts.PublishMemo(ctx, &store.PublishMemo{ID: memo.ID, PublishTs: publishTs, DisplayTs: publishTs})
+	require.NoError(t, err)
+	require.True(t, published)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+	require.Zero(t, memo.PublishTs)
+	require.Equal(t, publishTs, memo.CreatedTs)
+	ts.Close()
+}
+
+func TestMemoReminderStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	reminderTs := int64(1700000000)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:            "test-resource-name",
+		CreatorID:      user.ID,
+		Content:        "test_content",
+		Visibility:     store.Public,
+		ReminderTs:     reminderTs,
+		ReminderRepeat: store.MemoReminderRepeatDaily,
+	})
+	require.NoError(t, err)
+	require.Equal(t, reminderTs, memo.ReminderTs)
+	require.Equal(t, store.MemoReminderRepeatDaily, memo.ReminderRepeat)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "no-reminder", CreatorID: user.ID, Content: "test_content", Visibility: store.Public})
+	require.NoError(t, err)
+
+	before, after := reminderTs-1, reminderTs+60
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{ReminderDueBefore: &before})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{ReminderDueBefore: &after})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, memo.ID, memoList[0].ID)
+
+	// Delivering is conditional on the reminder time found, so a reminder is delivered once.
+	nextReminderTs := reminderTs + 86400
+	deliver := &store.DeliverMemoReminder{ID: memo.ID, ReminderTs: reminderTs, NextReminderTs: nextReminderTs, DeliveredTs: after}
+	delivered, err := ts.DeliverMemoReminder(ctx, deliver)
+	require.NoError(t, err)
+	require.True(t, delivered)
+	delivered, err = ts.DeliverMemoReminder(ctx, deliver)
+	require.NoError(t, err)
+	require.False(t, delivered)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{ReminderDueBefore: &after})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, nextReminderTs, memo.ReminderTs)
+	require.Equal(t, after, memo.ReminderDeliveredTs)
+
+	// A reminder delivered once stays, but isn't due anymore.
+	once := store.MemoReminderRepeatNone
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, ReminderRepeat: &once}))
+	dueSec := nextReminderTs + 60
+	delivered, err = ts.DeliverMemoReminder(ctx, &store.DeliverMemoReminder{ID: memo.ID, ReminderTs: nextReminderTs, NextReminderTs: nextReminderTs, DeliveredTs: dueSec})
+	require.NoError(t, err)
+	require.True(t, delivered)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{ReminderDueBefore: &dueSec})
+	require.NoError(t, err)
+	require.Empty(t, memoList)
+	ts.Close()
+}
+
+func TestUpdateMemosStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	first, err := ts.CreateMemo(ctx, &store.Memo{UID: "first", CreatorID: user.ID, Content: "first", Visibility: store.Public})
+	require.NoError(t, err)
+	second, err := ts.CreateMemo(ctx, &store.Memo{UID: "second", CreatorID: user.ID, Content: "second", Visibility: store.Public})
+	require.NoError(t, err)
+
+	firstContent, secondContent := "first_updated", "second_updated"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &firstContent},
+		{ID: second.ID, Content: &secondContent},
+	})
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, firstContent, memo.Content)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &second.ID})
+	require.NoError(t, err)
+	require.Equal(t, secondContent, memo.Content)
+
+	// A failing update rolls back the whole batch.
+	failedContent, duplicateUID := "failed", "first"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &failedContent},
+		{ID: second.ID, UID: &duplicateUID},
+	})
+	require.Error(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, firstContent, memo.Content)
+	ts.Close()
+}
+
+func TestUpdateMemoContentStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "tasks", CreatorID: user.ID, Content: "- [ ] one", Visibility: store.Public})
+	require.NoError(t, err)
+
+	updated, err := ts.UpdateMemoContent(ctx, &store.UpdateMemoContent{
+		ID:         memo.ID,
+		OldContent: "- [ ] one",
+		Content:    "- [x] one",
+		Payload:    &storepb.MemoPayload{},
+		UpdatedTs:  memo.UpdatedTs + 10,
+	})
+	require.NoError(t, err)
+	require.True(t, updated)
+	found, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "- [x] one", found.Content)
+	require.Equal(t, memo.UpdatedTs+10, found.UpdatedTs)
+
+	// The memo is edited after it's read, so the edit made from what was read isn't written.
+	read, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	edited := "- [x] one\n- [ ] two"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &edited}))
+	updated, err = ts.UpdateMemoContent(ctx, &store.UpdateMemoContent{
+		ID:         memo.ID,
+		OldContent: read.Content,
+		Content:    "- [ ] one",
+		Payload:    &storepb.MemoPayload{},
+		UpdatedTs:  memo.UpdatedTs + 20,
+	})
+	require.NoError(t, err)
+	require.False(t, updated)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, edited, found.Content)
+	ts.Close()
+}
+
+func TestPinMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{Username: "other", Role: store.RoleUser, Email: "other@test.com", Nickname: "other"})
+	require.NoError(t, err)
+	memos := map[string]*store.Memo{}
+	for _, uid := range []string{"a", "b", "c", "d"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user.ID, Content: uid, Visibility: store.Public})
+		require.NoError(t, err)
+		memos[uid] = memo
+	}
+	otherMemo, err := ts.CreateMemo(ctx, &store.Memo{UID: "other", CreatorID: other.ID, Content: "other", Visibility: store.Public})
+	require.NoError(t, err)
+	pin := func(uid string, pinned bool, position, offset int32) {
+		memo := memos[uid]
+		require.NoError(t, ts.PinMemo(ctx, &store.PinMemo{ID: memo.ID, CreatorID: memo.CreatorID, Pinned: pinned, Position: position, Offset: offset}))
+	}
+	// listPinned lists the UIDs of the pinned memos in their order, and checks their pin orders.
+	listPinned := func() []string {
+		list, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, OrderByPinned: true})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range list {
+			if !memo.Pinned {
+				require.Zero(t, memo.PinOrder)
+				continue
+			}
+			uids = append(uids, memo.UID)
+			require.Equal(t, int32(len(uids)), memo.PinOrder)
+		}
+		return uids
+	}
+
+	// Pinned memos are placed at the top.
+	pin("a", true, 0, 0)
+	pin("b", true, 0, 0)
+	pin("c", true, 0, 0)
+	require.NoError(t, ts.PinMemo(ctx, &store.PinMemo{ID: otherMemo.ID, CreatorID: other.ID, Pinned: true}))
+	require.Equal(t, []string{"c", "b", "a"}, listPinned())
+
+	// Editing a pinned memo keeps its position.
+	updatedTsSec := int64(1900000000)
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memos["a"].ID, UpdatedTs: &updatedTsSec}))
+	require.Equal(t, []string{"c", "b", "a"}, listPinned())
+	list, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, OrderByPinned: true, OrderByUpdatedTs: true})
+	require.NoError(t, err)
+	require.Equal(t, "c", list[0].UID)
+
+	// Moves are clamped to the pinned memos.
+	pin("a", true, 0, -1)
+	require.Equal(t, []string{"c", "a", "b"}, listPinned())
+	pin("c", true, 0, 5)
+	require.Equal(t, []string{"a", "b", "c"}, listPinned())
+	pin("c", true, 1, 0)
+	require.Equal(t, []string{"c", "a", "b"}, listPinned())
+	pin("c", true, 10, 0)
+	require.Equal(t, []string{"a", "b", "c"}, listPinned())
+	pin("b", true, 0, 0)
+	require.Equal(t, []string{"a", "b", "c"}, listPinned())
+
+	// Unpinning renumbers the pinned memos, so later moves have no gaps to skip.
+	pin("a", false, 0, 0)
+	require.Equal(t, []string{"b", "c"}, listPinned())
+	pin("c", true, 0, -1)
+	require.Equal(t, []string{"c", "b"}, listPinned())
+	pin("d", true, 2, 0)
+	require.Equal(t, []string{"c", "d", "b"}, listPinned())
+
+	// Archived memos are left out of the renumbering.
+	archived := store.Archived
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memos["d"].ID, RowStatus: &archived}))
+	pin("b", true, 1, 0)
+	normal := store.Normal
+	list, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, RowStatus: &normal, OrderByPinned: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c"}, []string{list[0].UID, list[1].UID})
+	require.Equal(t, []int32{1, 2}, []int32{list[0].PinOrder, list[1].PinOrder})
+
+	// The pin order of the other user is unchanged.
+	otherMemo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &otherMemo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), otherMemo.PinOrder)
+	ts.Close()
+}
@@ -2,9 +2,14 @@ package test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
 )
 
 func TestGetCurrentSchemaVersion(t *testing.T) {
@@ -13,5 +18,342 @@ func TestGetCurrentSchemaVersion(t *testing.T) {
 
 	currentSchemaVersion, err := ts.GetCurrentSchemaVersion()
 	require.NoError(t, err)
-	require.Equal(t, "0.25.1", currentSchemaVersion)
+	require.Equal(t, "0.25.32", currentSchemaVersion)
+}
+
+func TestGetMigrationStatus(t *testing.T) {
+	ctx := context.Background()
+	profile := getTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	ts := store.New(dbDriver, profile)
+	defer ts.Close()
+
+	// A database that isn't initialized is pending the latest schema.
+	status, err := ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Empty(t, status.SchemaVersion)
+	require.Equal(t, []string{store.LatestSchemaFileName}, status.Pending)
+
+	require.NoError(t, ts.Migrate(ctx))
+	status, err = ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, status.TargetSchemaVersion, status.SchemaVersion)
+	require.Empty(t, status.Pending)
+	require.Len(t, status.Applied, 1)
+	require.Equal(t, store.LatestSchemaFileName, status.Applied[0].File)
+	require.NotEmpty(t, status.Applied[0].Checksum)
+
+	// Go back to the schema version before the schema_migration table.
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DROP TABLE schema_migration")
+	require.NoError(t, err)
+	_, err = dbDriver.GetDB().ExecContext(ctx, "ALTER TABLE resource DROP COLUMN library")
+	require.NoError(t, err)
+	_, err = dbDriver.GetDB().ExecContext(ctx, "ALTER TABLE memo DROP COLUMN publish_ts")
+	require.NoError(t, err)
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DROP TABLE memo_revision")
+	require.NoError(t, err)
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DROP TABLE memo_recurrence")
+	require.NoError(t, err)
+	dropMemoReminderColumns(ctx, t, ts)
+	dropMemoIndex(ctx, t, ts, "idx_memo_creator_id_created_ts")
+	dropMemoExpireColumn(ctx, t, ts)
+	dropMemoPinOrderColumn(ctx, t, ts)
+	dropAttachmentColumns(ctx, t, ts)
+	setSchemaVersion(ctx, t, ts, "0.25.9")
+	status, err = ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.25.9", status.SchemaVersion)
+	require.Equal(t, []string{"0.25/09__schema_migration.sql", "0.25/10__attachment_library.sql", "0.25/11__memo_normalized_tags.sql", "0.25/12__memo_publish_ts.sql", "0.25/13__memo_revision.sql", "0.25/14__memo_reminder.sql", "0.25/15__memo_recurrence.sql", "0.25/16__memo_creator_created_ts_index.sql", "0.25/17__memo_word_count.sql", "0.25/18__memo_pin_order.sql", "0.25/19__memo_expire_ts.sql", "0.25/20__memo_draft_state.sql", "0.25/21__attachment_content_hash.sql", "0.25/22__attachment_pending.sql", "0.25/23__user_storage_usage.sql", "0.25/24__attachment_filter_indexes.sql", "0.25/25__webhook_delivery.sql", "0.25/26__user_suspended_ts.sql", "0.25/27__memo_share.sql", "0.25/28__memo_share_passphrase.sql", "0.25/29__memo_slug.sql", "0.25/30__passkey_challenge.sql", "0.25/31__memo_quota_usage.sql"}, status.Pending)
+	require.Empty(t, status.Applied)
+
+	// The manual mode refuses to apply the pending migration.
+	profile.Migrate = "manual"
+	require.ErrorContains(t, ts.Migrate(ctx), "--migrate=auto")
+
+	profile.Migrate = "auto"
+	require.NoError(t, ts.Migrate(ctx))
+	status, err = ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, status.TargetSchemaVersion, status.SchemaVersion)
+	require.Empty(t, status.Pending)
+	require.Len(t, status.Applied, 23)
+	require.Equal(t, "0.25/09__schema_migration.sql", status.Applied[0].File)
+	require.Equal(t, "0.25.10", status.Applied[0].Version)
+	require.Empty(t, status.Modified)
+
+	// A recorded checksum that doesn't match the file reveals a modified migration.
+	modified := *status.Applied[0]
+	modified.Checksum = "0000"
+	_, err = ts.UpsertSchemaMigration(ctx, &modified)
+	require.NoError(t, err)
+	status, err = ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0.25/09__schema_migration.sql"}, status.Modified)
+}
+
+func TestMigrateBackfillsMemoNormalizedTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "backfill",
+		CreatorID:  user.ID,
+		Content:    "#Café",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"Café"}},
+	})
+	require.NoError(t, err)
+
+	// Go back to a payload written before the normalized tags.
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, fmt.Sprintf(`UPDATE memo SET payload = '{"tags":["Café"]}' WHERE id = %d`, memo.ID))
+	require.NoError(t, err)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filters: []string{`tag in ["cafe"]`}})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "ALTER TABLE memo DROP COLUMN publish_ts")
+	require.NoError(t, err)
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE memo_revision")
+	require.NoError(t, err)
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE memo_recurrence")
+	require.NoError(t, err)
+	dropMemoReminderColumns(ctx, t, ts)
+	dropMemoIndex(ctx, t, ts, "idx_memo_creator_id_created_ts")
+	dropMemoExpireColumn(ctx, t, ts)
+	dropMemoPinOrderColumn(ctx, t, ts)
+	dropAttachmentColumns(ctx, t, ts)
+
+	setSchemaVersion(ctx, t, ts, "0.25.11")
+	require.NoError(t, ts.Migrate(ctx))
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filters: []string{`tag in ["cafe"]`}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, []string{"Café"}, memos[0].Payload.Tags)
+	require.Equal(t, []string{"cafe"}, memos[0].Payload.NormalizedTags)
+}
+
+func TestMigrateBackfillsMemoWordCount(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "backfill",
+		CreatorID:  user.ID,
+		Content:    "hello world 你好",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"Café"}},
+	})
+	require.NoError(t, err)
+
+	dropMemoExpireColumn(ctx, t, ts)
+	dropMemoPinOrderColumn(ctx, t, ts)
+	dropAttachmentColumns(ctx, t, ts)
+	setSchemaVersion(ctx, t, ts, "0.25.17")
+	require.NoError(t, ts.Migrate(ctx))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), memo.Payload.Property.WordCount)
+	require.Equal(t, []string{"Café"}, memo.Payload.Tags)
+}
+
+func TestMigrateBackfillsMemoPinOrder(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	pinned := true
+	memoIDs := []int32{}
+	for i, uid := range []string{"older", "newer", "unpinned"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user.ID, Content: uid, Visibility: store.Public})
+		require.NoError(t, err)
+		updatedTsSec := int64(1700000000 + i)
+		update := &store.UpdateMemo{ID: memo.ID, UpdatedTs: &updatedTsSec}
+		if uid != "unpinned" {
+			update.Pinned = &pinned
+		}
+		require.NoError(t, ts.UpdateMemo(ctx, update))
+		memoIDs = append(memoIDs, memo.ID)
+	}
+
+	dropMemoExpireColumn(ctx, t, ts)
+	dropMemoPinOrderColumn(ctx, t, ts)
+	dropAttachmentColumns(ctx, t, ts)
+	setSchemaVersion(ctx, t, ts, "0.25.18")
+	require.NoError(t, ts.Migrate(ctx))
+	// The pinned memos are ordered by their update time, newest first.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{IDList: memoIDs})
+	require.NoError(t, err)
+	pinOrders := map[string]int32{}
+	for _, memo := range memos {
+		pinOrders[memo.UID] = memo.PinOrder
+	}
+	require.Equal(t, map[string]int32{"newer": 1, "older": 2, "unpinned": 0}, pinOrders)
+}
+
+func TestMigrateBackfillsUserStorageUsage(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for _, attachment := range []*store.Attachment{
+		{UID: "database", Size: 100},
+		{UID: "local", Size: 10, StorageType: storepb.AttachmentStorageType_LOCAL, Reference: "assets/a.txt"},
+		// The attachments sharing a file are counted once.
+		{UID: "duplicate", Size: 10, StorageType: storepb.AttachmentStorageType_LOCAL, Reference: "assets/a.txt"},
+		{UID: "pending", Size: 1000, StorageType: storepb.AttachmentStorageType_S3, Pending: true},
+	} {
+		attachment.CreatorID = user.ID
+		attachment.Filename = attachment.UID
+		_, err := ts.CreateAttachment(ctx, attachment)
+		require.NoError(t, err)
+	}
+
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE user_storage_usage")
+	require.NoError(t, err)
+	dropAttachmentFilterIndexes(ctx, t, ts)
+	dropWebhookDeliveryTable(ctx, t, ts)
+	dropUserSuspendedColumn(ctx, t, ts)
+	dropMemoShareTable(ctx, t, ts)
+	dropMemoSlugTable(ctx, t, ts)
+	dropPasskeyChallengeTable(ctx, t, ts)
+	dropMemoQuotaUsageTable(ctx, t, ts)
+	setSchemaVersion(ctx, t, ts, "0.25.23")
+	require.NoError(t, ts.Migrate(ctx))
+	usedBytes, err := ts.GetUserStorageUsage(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), usedBytes)
+}
+
+func setSchemaVersion(ctx context.Context, t *testing.T, ts *store.Store, schemaVersion string) {
+	instanceBasicSetting, err := ts.GetInstanceBasicSetting(ctx)
+	require.NoError(t, err)
+	instanceBasicSetting.SchemaVersion = schemaVersion
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_BASIC,
+		Value: &storepb.InstanceSetting_BasicSetting{BasicSetting: instanceBasicSetting},
+	})
+	require.NoError(t, err)
+}
+
+// dropMemoReminderColumns goes back to the memo table before the reminder columns.
+func dropMemoReminderColumns(ctx context.Context, t *testing.T, ts *store.Store) {
+	dropMemoIndex(ctx, t, ts, "idx_memo_reminder_ts")
+	for _, stmt := range []string{
+		"ALTER TABLE memo DROP COLUMN reminder_ts",
+		"ALTER TABLE memo DROP COLUMN reminder_repeat",
+		"ALTER TABLE memo DROP COLUMN reminder_delivered_ts",
+	} {
+		_, err := ts.GetDriver().GetDB().ExecContext(ctx, stmt)
+		require.NoError(t, err)
+	}
+}
+
+// dropMemoPinOrderColumn goes back to the memo table before the pin order.
+func dropMemoPinOrderColumn(ctx context.Context, t *testing.T, ts *store.Store) {
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "ALTER TABLE memo DROP COLUMN pin_order")
+	require.NoError(t, err)
+}
+
+// dropMemoExpireColumn goes back to the memo table before the expiry.
+func dropMemoExpireColumn(ctx context.Context, t *testing.T, ts *store.Store) {
+	dropMemoIndex(ctx, t, ts, "idx_memo_expire_ts")
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "ALTER TABLE memo DROP COLUMN expire_ts")
+	require.NoError(t, err)
+}
+
+// dropAttachmentColumns goes back to the resource table before the content hash and the pending
+// uploads, and drops the storage usages.
+func dropAttachmentColumns(ctx context.Context, t *testing.T, ts *store.Store) {
+	stmt := "DROP INDEX idx_resource_creator_id_content_hash"
+	if getDriverFromEnv() == "mysql" {
+		stmt += " ON resource"
+	}
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, stmt)
+	require.NoError(t, err)
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "ALTER TABLE resource DROP COLUMN content_hash")
+	require.NoError(t, err)
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "ALTER TABLE resource DROP COLUMN pending")
+	require.NoError(t, err)
+	_, err = ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE user_storage_usage")
+	require.NoError(t, err)
+	dropAttachmentFilterIndexes(ctx, t, ts)
+	dropWebhookDeliveryTable(ctx, t, ts)
+	dropUserSuspendedColumn(ctx, t, ts)
+	dropMemoShareTable(ctx, t, ts)
+	dropMemoSlugTable(ctx, t, ts)
+	dropPasskeyChallengeTable(ctx, t, ts)
+	dropMemoQuotaUsageTable(ctx, t, ts)
+}
+
+// dropAttachmentFilterIndexes drops the indexes of the attachment filters.
+func dropAttachmentFilterIndexes(ctx context.Context, t *testing.T, ts *store.Store) {
+	names := []string{"idx_resource_creator_id_type", "idx_resource_creator_id_size", "idx_resource_creator_id_created_ts"}
+	if getDriverFromEnv() != "sqlite" {
+		names = append(names, "idx_resource_memo_id")
+	}
+	for _, name := range names {
+		stmt := "DROP INDEX " + name
+		if getDriverFromEnv() == "mysql" {
+			stmt += " ON resource"
+		}
+		_, err := ts.GetDriver().GetDB().ExecContext(ctx, stmt)
+		require.NoError(t, err)
+	}
+}
+
+// dropWebhookDeliveryTable drops the webhook deliveries.
+func dropWebhookDeliveryTable(ctx context.Context, t *testing.T, ts *store.Store) {
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE webhook_delivery")
+	require.NoError(t, err)
+}
+
+// dropUserSuspendedColumn drops the suspension of the users.
+func dropUserSuspendedColumn(ctx context.Context, t *testing.T, ts *store.Store) {
+	stmt := "ALTER TABLE user DROP COLUMN suspended_ts"
+	if getDriverFromEnv() == "postgres" {
+		stmt = `ALTER TABLE "user" DROP COLUMN suspended_ts`
+	}
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, stmt)
+	require.NoError(t, err)
+}
+
+// dropMemoShareTable drops the share links of the memos.
+func dropMemoShareTable(ctx context.Context, t *testing.T, ts *store.Store) {
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE memo_share")
+	require.NoError(t, err)
+}
+
+// dropMemoSlugTable drops the slugs of the memos.
+func dropMemoSlugTable(ctx context.Context, t *testing.T, ts *store.Store) {
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE memo_slug")
+	require.NoError(t, err)
+}
+
+// dropPasskeyChallengeTable drops the pending passkey challenges.
+func dropPasskeyChallengeTable(ctx context.Context, t *testing.T, ts *store.Store) {
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE passkey_challenge")
+	require.NoError(t, err)
+}
+
+// dropMemoQuotaUsageTable drops the usages of the memo quotas per day.
+func dropMemoQuotaUsageTable(ctx context.Context, t *testing.T, ts *store.Store) {
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, "DROP TABLE memo_quota_usage")
+	require.NoError(t, err)
+}
+
+// dropMemoIndex drops an index of the memo table.
+func dropMemoIndex(ctx context.Context, t *testing.T, ts *store.Store, name string) {
+	stmt := "DROP INDEX " + name
+	if getDriverFromEnv() == "mysql" {
+		stmt += " ON memo"
+	}
+	_, err := ts.GetDriver().GetDB().ExecContext(ctx, stmt)
+	require.NoError(t, err)
 }
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+func TestCheckReadiness(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	require.NoError(t, ts.CheckReadiness(ctx))
+
+	// The store isn't ready until the migrations have completed.
+	profile := getTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	unmigrated := store.New(dbDriver, profile)
+	require.ErrorContains(t, unmigrated.CheckReadiness(ctx), "migrations have not completed")
+	require.NoError(t, unmigrated.Close())
+
+	// The store isn't ready once the database is unreachable.
+	require.NoError(t, ts.Close())
+	require.ErrorContains(t, ts.CheckReadiness(ctx), "database is unreachable")
+}
+
+func TestDBConnectionPool(t *testing.T) {
+	profile := getTestingProfile(t)
+	profile.MaxOpenConns = 3
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	defer ts.Close()
+	require.Equal(t, 3, ts.GetDBStats().MaxOpenConnections)
+}
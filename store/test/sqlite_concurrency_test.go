@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+func TestSQLiteConcurrentWrites(t *testing.T) {
+	if getDriverFromEnv() != "sqlite" {
+		t.Skip("only for the sqlite driver")
+	}
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	const workers, writes = 8, 25
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*writes*2)
+	for w := range workers {
+		wg.Go(func() {
+			for i := range writes {
+				memo, err := ts.CreateMemo(ctx, &store.Memo{
+					UID:        fmt.Sprintf("memo-%d-%d", w, i),
+					CreatorID:  user.ID,
+					Content:    "content",
+					Visibility: store.Public,
+				})
+				if err != nil {
+					errs <- err
+					continue
+				}
+				content := fmt.Sprintf("updated content %d", i)
+				if err := ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}); err != nil {
+					errs <- err
+				}
+			}
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, workers*writes)
+}
+
+func TestSQLiteConcurrentMigrations(t *testing.T) {
+	if getDriverFromEnv() != "sqlite" {
+		t.Skip("only for the sqlite driver")
+	}
+	ctx := context.Background()
+	profile := getTestingProfile(t)
+
+	// Replicas pointed at the same file migrate one at a time.
+	const replicas = 4
+	var wg sync.WaitGroup
+	errs := make(chan error, replicas)
+	for range replicas {
+		wg.Go(func() {
+			dbDriver, err := db.NewDBDriver(profile)
+			if err != nil {
+				errs <- err
+				return
+			}
+			replica := store.New(dbDriver, profile)
+			defer replica.Close()
+			errs <- replica.Migrate(ctx)
+		})
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
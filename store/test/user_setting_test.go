@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -26,3 +27,78 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserSessions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for _, sessionID := range []string{"a", "b", "c"} {
+		err := ts.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{
+			SessionId:        sessionID,
+			CreateTime:       timestamppb.Now(),
+			LastAccessedTime: timestamppb.Now(),
+			ClientInfo:       &storepb.SessionsUserSetting_ClientInfo{UserAgent: "test", IpAddress: "127.0.0.1"},
+		})
+		require.NoError(t, err)
+	}
+
+	err = ts.RemoveUserSession(ctx, user.ID, "a")
+	require.NoError(t, err)
+	sessions, err := ts.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	// Updating a revoked session must not bring it back.
+	err = ts.UpdateUserSessionLastAccessed(ctx, user.ID, "a", timestamppb.Now())
+	require.NoError(t, err)
+	sessions, err = ts.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	err = ts.RemoveOtherUserSessions(ctx, user.ID, "c")
+	require.NoError(t, err)
+	sessions, err = ts.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, "c", sessions[0].SessionId)
+	require.Equal(t, "test", sessions[0].ClientInfo.UserAgent)
+	ts.Close()
+}
+
+func TestUserMemoQuota(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_MEMO_RELATED,
+		Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: &storepb.InstanceMemoRelatedSetting{
+			MaxMemosPerUser:                100,
+			NewAccountMaxPublicMemosPerDay: 5,
+			NewAccountDays:                 7,
+		}},
+	})
+	require.NoError(t, err)
+
+	// The public memos per day are only limited while the account is new.
+	quota, err := ts.GetUserMemoQuota(ctx, user, user.CreatedTs+6*24*60*60)
+	require.NoError(t, err)
+	require.Equal(t, &store.UserMemoQuota{MaxMemos: 100, MaxPublicMemosPerDay: 5}, quota)
+	quota, err = ts.GetUserMemoQuota(ctx, user, user.CreatedTs+8*24*60*60)
+	require.NoError(t, err)
+	require.Equal(t, &store.UserMemoQuota{MaxMemos: 100}, quota)
+
+	// The quotas set on the user apply whatever the age of the account.
+	unlimited, two := int32(0), int32(2)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_MEMO_QUOTA,
+		Value:  &storepb.UserSetting_MemoQuota{MemoQuota: &storepb.MemoQuotaUserSetting{MaxMemos: &unlimited, MaxPublicMemosPerDay: &two}},
+	})
+	require.NoError(t, err)
+	quota, err = ts.GetUserMemoQuota(ctx, user, user.CreatedTs+8*24*60*60)
+	require.NoError(t, err)
+	require.Equal(t, &store.UserMemoQuota{MaxPublicMemosPerDay: 2}, quota)
+}
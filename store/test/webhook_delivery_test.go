@@ -0,0 +1,78 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	nowSec := time.Now().Unix()
+	webhookID := "hook"
+	create := func(status store.WebhookDeliveryStatus, createdTsSec, nextAttemptTsSec int64) *store.WebhookDelivery {
+		delivery, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			UserID:        user.ID,
+			WebhookID:     webhookID,
+			ActivityType:  "memos.memo.created",
+			Payload:       "{}",
+			Status:        status,
+			NextAttemptTs: nextAttemptTsSec,
+			CreatedTs:     createdTsSec,
+			UpdatedTs:     createdTsSec,
+		})
+		require.NoError(t, err)
+		return delivery
+	}
+	oldSucceeded := create(store.WebhookDeliverySucceeded, nowSec-3600, 0)
+	oldPending := create(store.WebhookDeliveryPending, nowSec-3600, nowSec-60)
+	notDue := create(store.WebhookDeliveryPending, nowSec, nowSec+60)
+
+	due, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{DueBefore: &nowSec})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, oldPending.ID, due[0].ID)
+
+	// Only the first claim of a due delivery succeeds.
+	claim := &store.ClaimWebhookDelivery{ID: oldPending.ID, NextAttemptTs: oldPending.NextAttemptTs, LeaseTs: nowSec + 120}
+	claimed, err := ts.ClaimWebhookDelivery(ctx, claim)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = ts.ClaimWebhookDelivery(ctx, claim)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	succeeded, responseStatus, message := store.WebhookDeliverySucceeded, int32(200), ""
+	require.NoError(t, ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{ID: notDue.ID, Status: &succeeded, ResponseStatus: &responseStatus, Error: &message}))
+	delivery, err := ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &notDue.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliverySucceeded, delivery.Status)
+	require.Equal(t, int32(200), delivery.ResponseStatus)
+
+	// Pruning keeps the pending deliveries.
+	createdBeforeSec := nowSec - 60
+	require.NoError(t, ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{CreatedBefore: &createdBeforeSec}))
+	limit := 10
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID, Limit: &limit})
+	require.NoError(t, err)
+	ids := []int32{}
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.ID)
+	}
+	require.Equal(t, []int32{notDue.ID, oldPending.ID}, ids)
+	require.NotContains(t, ids, oldSucceeded.ID)
+
+	require.NoError(t, ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{UserID: &user.ID, WebhookID: &webhookID}))
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
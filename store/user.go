@@ -57,6 +57,8 @@ type User struct {
 	PasswordHash string
 	AvatarURL    string
 	Description  string
+	// SuspendedTs is the time the user was suspended by the host, or 0 if the user isn't suspended.
+	SuspendedTs int64
 }
 
 type UpdateUser struct {
@@ -72,6 +74,7 @@ type UpdateUser struct {
 	AvatarURL    *string
 	PasswordHash *string
 	Description  *string
+	SuspendedTs  *int64
 }
 
 type FindUser struct {
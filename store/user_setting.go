@@ -2,9 +2,11 @@ package store
 
 import (
 	"context"
+	"slices"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -133,6 +135,51 @@ func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token s
 	return err
 }
 
+// RemoveAllUserAccessTokens removes all the access tokens of the user.
+func (s *Store) RemoveAllUserAccessTokens(ctx context.Context, userID int32) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.AccessTokensUserSetting{},
+		},
+	})
+	return err
+}
+
+// UpdateUserAccessTokenLastUsed updates the last used time of an access token.
+func (s *Store) UpdateUserAccessTokenLastUsed(ctx context.Context, userID int32, token string, lastUsedTime *timestamppb.Timestamp) error {
+	accessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, accessToken := range accessTokens {
+		if accessToken.AccessToken == token {
+			accessToken.LastUsedTime = lastUsedTime
+			found = true
+			break
+		}
+	}
+	// Nothing to update, e.g. the token was deleted concurrently.
+	if !found {
+		return nil
+	}
+
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.AccessTokensUserSetting{
+				AccessTokens: accessTokens,
+			},
+		},
+	})
+
+	return err
+}
+
 // GetUserSessions returns the sessions of the user.
 func (s *Store) GetUserSessions(ctx context.Context, userID int32) ([]*storepb.SessionsUserSetting_Session, error) {
 	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
@@ -177,6 +224,33 @@ func (s *Store) RemoveUserSession(ctx context.Context, userID int32, sessionID s
 	return err
 }
 
+// RemoveOtherUserSessions removes all sessions of the user except the session with keepSessionID.
+func (s *Store) RemoveOtherUserSessions(ctx context.Context, userID int32, keepSessionID string) error {
+	oldSessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newSessions := make([]*storepb.SessionsUserSetting_Session, 0, 1)
+	for _, session := range oldSessions {
+		if keepSessionID == session.SessionId {
+			newSessions = append(newSessions, session)
+		}
+	}
+
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SESSIONS,
+		Value: &storepb.UserSetting_Sessions{
+			Sessions: &storepb.SessionsUserSetting{
+				Sessions: newSessions,
+			},
+		},
+	})
+
+	return err
+}
+
 // AddUserSession adds a new session for the user.
 func (s *Store) AddUserSession(ctx context.Context, userID int32, session *storepb.SessionsUserSetting_Session) error {
 	existingSessions, err := s.GetUserSessions(ctx, userID)
@@ -221,12 +295,18 @@ func (s *Store) UpdateUserSessionLastAccessed(ctx context.Context, userID int32,
 		return err
 	}
 
+	found := false
 	for _, session := range sessions {
 		if session.SessionId == sessionID {
 			session.LastAccessedTime = lastAccessedTime
+			found = true
 			break
 		}
 	}
+	// Nothing to update, e.g. the session was revoked concurrently.
+	if !found {
+		return nil
+	}
 
 	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
 		UserId: userID,
@@ -241,6 +321,347 @@ func (s *Store) UpdateUserSessionLastAccessed(ctx context.Context, userID int32,
 	return err
 }
 
+// GetUserTwoFactor returns the two-factor authentication setting of the user, or an empty
+// setting if the user never enrolled.
+func (s *Store) GetUserTwoFactor(ctx context.Context, userID int32) (*storepb.TwoFactorUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetTwoFactor() == nil {
+		return &storepb.TwoFactorUserSetting{}, nil
+	}
+	return userSetting.GetTwoFactor(), nil
+}
+
+// UpsertUserTwoFactor replaces the two-factor authentication setting of the user.
+// Pass an empty setting to disable two-factor authentication.
+func (s *Store) UpsertUserTwoFactor(ctx context.Context, userID int32, twoFactor *storepb.TwoFactorUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+		Value: &storepb.UserSetting_TwoFactor{
+			TwoFactor: twoFactor,
+		},
+	})
+	return err
+}
+
+// GetUserFeedToken returns the feed token setting of the user, or an empty setting if the user
+// has no feed token.
+func (s *Store) GetUserFeedToken(ctx context.Context, userID int32) (*storepb.FeedTokenUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_FEED_TOKEN,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetFeedToken() == nil {
+		return &storepb.FeedTokenUserSetting{}, nil
+	}
+	return userSetting.GetFeedToken(), nil
+}
+
+// UpsertUserFeedToken replaces the feed token setting of the user.
+// Pass an empty setting to revoke the feed token.
+func (s *Store) UpsertUserFeedToken(ctx context.Context, userID int32, feedToken *storepb.FeedTokenUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_FEED_TOKEN,
+		Value: &storepb.UserSetting_FeedToken{
+			FeedToken: feedToken,
+		},
+	})
+	return err
+}
+
+// GetUserInboundEmail returns the inbound email setting of the user, or an empty setting if the
+// user has no inbound email address.
+func (s *Store) GetUserInboundEmail(ctx context.Context, userID int32) (*storepb.InboundEmailUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_INBOUND_EMAIL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetInboundEmail() == nil {
+		return &storepb.InboundEmailUserSetting{}, nil
+	}
+	return userSetting.GetInboundEmail(), nil
+}
+
+// UpsertUserInboundEmail replaces the inbound email setting of the user.
+// Pass an empty setting to remove the inbound email address.
+func (s *Store) UpsertUserInboundEmail(ctx context.Context, userID int32, inboundEmail *storepb.InboundEmailUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_INBOUND_EMAIL,
+		Value: &storepb.UserSetting_InboundEmail{
+			InboundEmail: inboundEmail,
+		},
+	})
+	return err
+}
+
+// GetUserSlack returns the Slack setting of the user, or an empty setting if the user has no
+// linked Slack account nor pending link code.
+func (s *Store) GetUserSlack(ctx context.Context, userID int32) (*storepb.SlackUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SLACK,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetSlack() == nil {
+		return &storepb.SlackUserSetting{}, nil
+	}
+	return userSetting.GetSlack(), nil
+}
+
+// UpsertUserSlack replaces the Slack setting of the user.
+// Pass an empty setting to unlink the Slack account.
+func (s *Store) UpsertUserSlack(ctx context.Context, userID int32, slack *storepb.SlackUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SLACK,
+		Value: &storepb.UserSetting_Slack{
+			Slack: slack,
+		},
+	})
+	return err
+}
+
+// GetUserMatrix returns the Matrix setting of the user, or an empty setting if the user has no
+// linked Matrix account nor pending link code.
+func (s *Store) GetUserMatrix(ctx context.Context, userID int32) (*storepb.MatrixUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_MATRIX,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetMatrix() == nil {
+		return &storepb.MatrixUserSetting{}, nil
+	}
+	return userSetting.GetMatrix(), nil
+}
+
+// UpsertUserMatrix replaces the Matrix setting of the user.
+// Pass an empty setting to unlink the Matrix account.
+func (s *Store) UpsertUserMatrix(ctx context.Context, userID int32, matrix *storepb.MatrixUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_MATRIX,
+		Value: &storepb.UserSetting_Matrix{
+			Matrix: matrix,
+		},
+	})
+	return err
+}
+
+// GetUserPasskeys returns the passkeys of the user.
+func (s *Store) GetUserPasskeys(ctx context.Context, userID int32) ([]*storepb.PasskeysUserSetting_Passkey, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_PASSKEYS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.PasskeysUserSetting_Passkey{}, nil
+	}
+
+	passkeysUserSetting := userSetting.GetPasskeys()
+	return passkeysUserSetting.Passkeys, nil
+}
+
+// AddUserPasskey adds a new passkey for the user.
+func (s *Store) AddUserPasskey(ctx context.Context, userID int32, passkey *storepb.PasskeysUserSetting_Passkey) error {
+	existingPasskeys, err := s.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range existingPasskeys {
+		if existing.CredentialId == passkey.CredentialId {
+			return errors.Errorf("passkey %s already exists", passkey.CredentialId)
+		}
+	}
+
+	return s.upsertUserPasskeys(ctx, userID, append(slices.Clone(existingPasskeys), passkey))
+}
+
+// RemoveUserPasskey removes the passkey of the user.
+func (s *Store) RemoveUserPasskey(ctx context.Context, userID int32, credentialID string) error {
+	oldPasskeys, err := s.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newPasskeys := make([]*storepb.PasskeysUserSetting_Passkey, 0, len(oldPasskeys))
+	for _, passkey := range oldPasskeys {
+		if credentialID != passkey.CredentialId {
+			newPasskeys = append(newPasskeys, passkey)
+		}
+	}
+
+	return s.upsertUserPasskeys(ctx, userID, newPasskeys)
+}
+
+// UpdateUserPasskeySignCount records a sign-in with the passkey of the user.
+func (s *Store) UpdateUserPasskeySignCount(ctx context.Context, userID int32, credentialID string, signCount uint32, lastUsedTime *timestamppb.Timestamp) error {
+	oldPasskeys, err := s.GetUserPasskeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	newPasskeys := make([]*storepb.PasskeysUserSetting_Passkey, 0, len(oldPasskeys))
+	for _, passkey := range oldPasskeys {
+		if passkey.CredentialId == credentialID {
+			// Copy the passkey, which is shared through the store cache.
+			updated, ok := proto.Clone(passkey).(*storepb.PasskeysUserSetting_Passkey)
+			if !ok {
+				return errors.New("failed to copy passkey")
+			}
+			updated.SignCount = signCount
+			updated.LastUsedTime = lastUsedTime
+			passkey = updated
+			found = true
+		}
+		newPasskeys = append(newPasskeys, passkey)
+	}
+	// Nothing to update, e.g. the passkey was removed concurrently.
+	if !found {
+		return nil
+	}
+
+	return s.upsertUserPasskeys(ctx, userID, newPasskeys)
+}
+
+func (s *Store) upsertUserPasskeys(ctx context.Context, userID int32, passkeys []*storepb.PasskeysUserSetting_Passkey) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_PASSKEYS,
+		Value: &storepb.UserSetting_Passkeys{
+			Passkeys: &storepb.PasskeysUserSetting{
+				Passkeys: passkeys,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserPushSubscriptions returns the push subscriptions of the user.
+func (s *Store) GetUserPushSubscriptions(ctx context.Context, userID int32) ([]*storepb.PushSubscriptionsUserSetting_PushSubscription, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_PUSH_SUBSCRIPTIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.PushSubscriptionsUserSetting_PushSubscription{}, nil
+	}
+	return userSetting.GetPushSubscriptions().Subscriptions, nil
+}
+
+// SetUserPushSubscription adds a push subscription for the user, or replaces the subscription
+// with the same endpoint, keeping its ID. It returns the subscription as stored.
+func (s *Store) SetUserPushSubscription(ctx context.Context, userID int32, subscription *storepb.PushSubscriptionsUserSetting_PushSubscription) (*storepb.PushSubscriptionsUserSetting_PushSubscription, error) {
+	oldSubscriptions, err := s.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	newSubscriptions := make([]*storepb.PushSubscriptionsUserSetting_PushSubscription, 0, len(oldSubscriptions)+1)
+	for _, existing := range oldSubscriptions {
+		if existing.Endpoint == subscription.Endpoint {
+			subscription.Id = existing.Id
+			existing = subscription
+			found = true
+		}
+		newSubscriptions = append(newSubscriptions, existing)
+	}
+	if !found {
+		newSubscriptions = append(newSubscriptions, subscription)
+	}
+	if err := s.upsertUserPushSubscriptions(ctx, userID, newSubscriptions); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// RemoveUserPushSubscriptions removes push subscriptions of the user.
+func (s *Store) RemoveUserPushSubscriptions(ctx context.Context, userID int32, ids []string) error {
+	oldSubscriptions, err := s.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newSubscriptions := make([]*storepb.PushSubscriptionsUserSetting_PushSubscription, 0, len(oldSubscriptions))
+	for _, subscription := range oldSubscriptions {
+		if !slices.Contains(ids, subscription.Id) {
+			newSubscriptions = append(newSubscriptions, subscription)
+		}
+	}
+	if len(newSubscriptions) == len(oldSubscriptions) {
+		return nil
+	}
+	return s.upsertUserPushSubscriptions(ctx, userID, newSubscriptions)
+}
+
+// UpdateUserPushSubscriptionLastPushTime records a notification pushed to the subscription of the user.
+func (s *Store) UpdateUserPushSubscriptionLastPushTime(ctx context.Context, userID int32, id string, lastPushTime *timestamppb.Timestamp) error {
+	oldSubscriptions, err := s.GetUserPushSubscriptions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	newSubscriptions := make([]*storepb.PushSubscriptionsUserSetting_PushSubscription, 0, len(oldSubscriptions))
+	for _, subscription := range oldSubscriptions {
+		if subscription.Id == id {
+			// Copy the subscription, which is shared through the store cache.
+			updated, ok := proto.Clone(subscription).(*storepb.PushSubscriptionsUserSetting_PushSubscription)
+			if !ok {
+				return errors.New("failed to copy push subscription")
+			}
+			updated.LastPushTime = lastPushTime
+			subscription = updated
+			found = true
+		}
+		newSubscriptions = append(newSubscriptions, subscription)
+	}
+	// Nothing to update, e.g. the subscription was removed concurrently.
+	if !found {
+		return nil
+	}
+	return s.upsertUserPushSubscriptions(ctx, userID, newSubscriptions)
+}
+
+func (s *Store) upsertUserPushSubscriptions(ctx context.Context, userID int32, subscriptions []*storepb.PushSubscriptionsUserSetting_PushSubscription) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_PushSubscriptions{
+			PushSubscriptions: &storepb.PushSubscriptionsUserSetting{
+				Subscriptions: subscriptions,
+			},
+		},
+	})
+	return err
+}
+
 // GetUserWebhooks returns the webhooks of the user.
 func (s *Store) GetUserWebhooks(ctx context.Context, userID int32) ([]*storepb.WebhooksUserSetting_Webhook, error) {
 	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
@@ -374,6 +795,48 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Shortcuts{Shortcuts: shortcutsUserSetting}
+	case storepb.UserSetting_MEMO_TEMPLATES:
+		memoTemplatesUserSetting := &storepb.MemoTemplatesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), memoTemplatesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_MemoTemplates{MemoTemplates: memoTemplatesUserSetting}
+	case storepb.UserSetting_AUTO_ARCHIVE:
+		autoArchiveUserSetting := &storepb.AutoArchiveUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), autoArchiveUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_AutoArchive{AutoArchive: autoArchiveUserSetting}
+	case storepb.UserSetting_STORAGE_QUOTA:
+		storageQuotaUserSetting := &storepb.StorageQuotaUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), storageQuotaUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_StorageQuota{StorageQuota: storageQuotaUserSetting}
+	case storepb.UserSetting_MEMO_QUOTA:
+		memoQuotaUserSetting := &storepb.MemoQuotaUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), memoQuotaUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_MemoQuota{MemoQuota: memoQuotaUserSetting}
+	case storepb.UserSetting_ANNOUNCEMENT_DISMISSAL:
+		announcementDismissalUserSetting := &storepb.AnnouncementDismissalUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), announcementDismissalUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_AnnouncementDismissal{AnnouncementDismissal: announcementDismissalUserSetting}
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := &storepb.NotificationUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Notification{Notification: notificationUserSetting}
+	case storepb.UserSetting_PUSH_SUBSCRIPTIONS:
+		pushSubscriptionsUserSetting := &storepb.PushSubscriptionsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), pushSubscriptionsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_PushSubscriptions{PushSubscriptions: pushSubscriptionsUserSetting}
 	case storepb.UserSetting_GENERAL:
 		generalUserSetting := &storepb.GeneralUserSetting{}
 		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), generalUserSetting); err != nil {
@@ -386,6 +849,42 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := &storepb.TwoFactorUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), twoFactorUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_TwoFactor{TwoFactor: twoFactorUserSetting}
+	case storepb.UserSetting_PASSKEYS:
+		passkeysUserSetting := &storepb.PasskeysUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), passkeysUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Passkeys{Passkeys: passkeysUserSetting}
+	case storepb.UserSetting_FEED_TOKEN:
+		feedTokenUserSetting := &storepb.FeedTokenUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), feedTokenUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_FeedToken{FeedToken: feedTokenUserSetting}
+	case storepb.UserSetting_INBOUND_EMAIL:
+		inboundEmailUserSetting := &storepb.InboundEmailUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), inboundEmailUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_InboundEmail{InboundEmail: inboundEmailUserSetting}
+	case storepb.UserSetting_SLACK:
+		slackUserSetting := &storepb.SlackUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), slackUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Slack{Slack: slackUserSetting}
+	case storepb.UserSetting_MATRIX:
+		matrixUserSetting := &storepb.MatrixUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), matrixUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Matrix{Matrix: matrixUserSetting}
 	default:
 		return nil, nil
 	}
@@ -420,6 +919,51 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_MEMO_TEMPLATES:
+		memoTemplatesUserSetting := userSetting.GetMemoTemplates()
+		value, err := protojson.Marshal(memoTemplatesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_AUTO_ARCHIVE:
+		autoArchiveUserSetting := userSetting.GetAutoArchive()
+		value, err := protojson.Marshal(autoArchiveUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_STORAGE_QUOTA:
+		storageQuotaUserSetting := userSetting.GetStorageQuota()
+		value, err := protojson.Marshal(storageQuotaUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_MEMO_QUOTA:
+		value, err := protojson.Marshal(userSetting.GetMemoQuota())
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_ANNOUNCEMENT_DISMISSAL:
+		value, err := protojson.Marshal(userSetting.GetAnnouncementDismissal())
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_NOTIFICATION:
+		value, err := protojson.Marshal(userSetting.GetNotification())
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_PUSH_SUBSCRIPTIONS:
+		value, err := protojson.Marshal(userSetting.GetPushSubscriptions())
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	case storepb.UserSetting_GENERAL:
 		generalUserSetting := userSetting.GetGeneral()
 		value, err := protojson.Marshal(generalUserSetting)
@@ -434,6 +978,48 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := userSetting.GetTwoFactor()
+		value, err := protojson.Marshal(twoFactorUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_PASSKEYS:
+		passkeysUserSetting := userSetting.GetPasskeys()
+		value, err := protojson.Marshal(passkeysUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_FEED_TOKEN:
+		feedTokenUserSetting := userSetting.GetFeedToken()
+		value, err := protojson.Marshal(feedTokenUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_INBOUND_EMAIL:
+		inboundEmailUserSetting := userSetting.GetInboundEmail()
+		value, err := protojson.Marshal(inboundEmailUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_SLACK:
+		slackUserSetting := userSetting.GetSlack()
+		value, err := protojson.Marshal(slackUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_MATRIX:
+		matrixUserSetting := userSetting.GetMatrix()
+		value, err := protojson.Marshal(matrixUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// mebiByte is the unit of the storage quotas.
+const mebiByte = 1024 * 1024
+
+// UserStorageUsage is the number of bytes of the attachment blobs of a user. A blob shared by
+// several attachments of the user is counted once.
+type UserStorageUsage struct {
+	UserID    int32
+	UsedBytes int64
+}
+
+type FindUserStorageUsage struct {
+	UserID *int32
+}
+
+// GetUserStorageUsage returns the number of bytes used by the attachments of a user.
+func (s *Store) GetUserStorageUsage(ctx context.Context, userID int32) (int64, error) {
+	list, err := s.driver.ListUserStorageUsages(ctx, &FindUserStorageUsage{UserID: &userID})
+	if err != nil {
+		return 0, err
+	}
+	if len(list) == 0 {
+		return 0, nil
+	}
+	return list[0].UsedBytes, nil
+}
+
+// AddUserStorageUsage adds deltaBytes, which may be negative, to the storage usage of a user.
+func (s *Store) AddUserStorageUsage(ctx context.Context, userID int32, deltaBytes int64) error {
+	return s.driver.AddUserStorageUsage(ctx, userID, deltaBytes)
+}
+
+// GetUserStorageQuota returns the storage quota of a user in bytes, 0 meaning unlimited: the
+// quota set on the user by the host, else the default quota of the instance.
+func (s *Store) GetUserStorageQuota(ctx context.Context, userID int32) (int64, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{UserID: &userID, Key: storepb.UserSetting_STORAGE_QUOTA})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get user setting")
+	}
+	if quotaSetting := userSetting.GetStorageQuota(); quotaSetting != nil && quotaSetting.QuotaMb != nil {
+		return quotaSetting.GetQuotaMb() * mebiByte, nil
+	}
+	instanceStorageSetting, err := s.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get instance storage setting")
+	}
+	return instanceStorageSetting.DefaultUserQuotaMb * mebiByte, nil
+}
+
+// RecalculateUserStorageUsages recomputes the storage usages of all users from their
+// attachments, fixing the drift of the usages updated on upload and delete.
+func (s *Store) RecalculateUserStorageUsages(ctx context.Context) ([]*UserStorageUsage, error) {
+	attachments := []*Attachment{}
+	limit, offset := orphanedAttachmentBatchSize, 0
+	for {
+		list, err := s.ListAttachments(ctx, &FindAttachment{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list attachments")
+		}
+		attachments = append(attachments, list...)
+		if len(list) < limit {
+			break
+		}
+		offset += limit
+	}
+
+	usedBytes := sumUserStorageUsages(attachments)
+	existing, err := s.driver.ListUserStorageUsages(ctx, &FindUserStorageUsage{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list storage usages")
+	}
+	// The users without attachments anymore are reset.
+	for _, usage := range existing {
+		if _, ok := usedBytes[usage.UserID]; !ok {
+			usedBytes[usage.UserID] = 0
+		}
+	}
+
+	usages := make([]*UserStorageUsage, 0, len(usedBytes))
+	for userID, bytes := range usedBytes {
+		usage, err := s.driver.UpsertUserStorageUsage(ctx, &UserStorageUsage{UserID: userID, UsedBytes: bytes})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to upsert storage usage")
+		}
+		usages = append(usages, usage)
+	}
+	slices.SortFunc(usages, func(a, b *UserStorageUsage) int {
+		return int(a.UserID - b.UserID)
+	})
+	return usages, nil
+}
+
+// addAttachmentStorageUsage adds the size of an attachment, times sign, to the storage usage of
+// its creator, unless the blob is shared with another attachment of the creator. A failure is
+// only logged, as the attachment is already created or deleted.
+func (s *Store) addAttachmentStorageUsage(ctx context.Context, attachment *Attachment, sign int64) {
+	if attachment.Pending || attachment.Size == 0 || attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+		return
+	}
+	shared, err := s.isAttachmentBlobShared(ctx, attachment, &attachment.CreatorID)
+	if err != nil {
+		slog.Warn("failed to check attachment blob", slog.String("attachment", attachment.UID), slog.String("error", err.Error()))
+		return
+	}
+	if shared {
+		return
+	}
+	if err := s.driver.AddUserStorageUsage(ctx, attachment.CreatorID, sign*attachment.Size); err != nil {
+		slog.Warn("failed to update storage usage", slog.Int("user", int(attachment.CreatorID)), slog.String("error", err.Error()))
+	}
+}
+
+// sumUserStorageUsages sums the sizes of the attachment blobs by creator, counting the blobs
+// shared by several attachments of a user once.
+func sumUserStorageUsages(attachments []*Attachment) map[int32]int64 {
+	usedBytes := map[int32]int64{}
+	counted := map[string]bool{}
+	for _, attachment := range attachments {
+		// The files of external attachments aren't stored.
+		if attachment.Pending || attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+			continue
+		}
+		key := fmt.Sprintf("%d/%s", attachment.CreatorID, getAttachmentBlobKey(attachment))
+		if counted[key] {
+			continue
+		}
+		counted[key] = true
+		usedBytes[attachment.CreatorID] += attachment.Size
+	}
+	return usedBytes
+}
+
+// getAttachmentBlobKey returns the key of the blob of an attachment: its local file or S3 object,
+// which may be shared, else the attachment itself.
+func getAttachmentBlobKey(attachment *Attachment) string {
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		return "local/" + attachment.Reference
+	case storepb.AttachmentStorageType_S3:
+		if s3Object := attachment.Payload.GetS3Object(); s3Object != nil {
+			return "s3/" + s3Object.Key
+		}
+	default:
+	}
+	return fmt.Sprintf("attachment/%d", attachment.ID)
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus is the state of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is a delivery waiting for its next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is a delivery the webhook accepted.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is a delivery that failed all its attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is the delivery of an event to a webhook of a user, with the outcome of its
+// last attempt.
+type WebhookDelivery struct {
+	ID int32
+
+	UserID    int32
+	WebhookID string
+	// ActivityType is the type of the event, e.g. memos.memo.created.
+	ActivityType string
+	// Payload is the JSON body posted to the webhook, kept to retry and replay the delivery.
+	Payload string
+	// PayloadHash is the hex encoded SHA-256 hash of the payload.
+	PayloadHash string
+	Status      WebhookDeliveryStatus
+	Attempts    int32
+	// NextAttemptTs is the time of the next attempt of a pending delivery. An attempt in progress
+	// pushes it back, so that the delivery is picked up again if the attempt never finishes.
+	NextAttemptTs int64
+	// ResponseStatus is the HTTP status of the response to the last attempt, or 0 without response.
+	ResponseStatus int32
+	LatencyMs      int64
+	// Error is the error of the last attempt, or empty if it succeeded.
+	Error     string
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+type FindWebhookDelivery struct {
+	ID        *int32
+	UserID    *int32
+	WebhookID *string
+	// DueBefore finds the pending deliveries whose next attempt is at or before the given time.
+	DueBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateWebhookDelivery struct {
+	ID             int32
+	Status         *WebhookDeliveryStatus
+	Attempts       *int32
+	NextAttemptTs  *int64
+	ResponseStatus *int32
+	LatencyMs      *int64
+	Error          *string
+	UpdatedTs      *int64
+}
+
+// ClaimWebhookDelivery is the claim of the next attempt of a pending webhook delivery.
+type ClaimWebhookDelivery struct {
+	ID int32
+	// NextAttemptTs is the next attempt of the delivery when it was found. The delivery isn't
+	// claimed if it has been claimed or finished since.
+	NextAttemptTs int64
+	// LeaseTs is the time after which the attempt is considered lost, and the delivery is due again.
+	LeaseTs int64
+}
+
+type DeleteWebhookDelivery struct {
+	UserID    *int32
+	WebhookID *string
+	// CreatedBefore deletes the deliveries created before the timestamp that aren't pending.
+	CreatedBefore *int64
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries lists webhook deliveries, newest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
+
+// ClaimWebhookDelivery claims the next attempt of a pending webhook delivery, and reports whether
+// it was still due at claim.NextAttemptTs. Only the caller that claimed it attempts the delivery,
+// so an attempt is never made twice.
+func (s *Store) ClaimWebhookDelivery(ctx context.Context, claim *ClaimWebhookDelivery) (bool, error) {
+	return s.driver.ClaimWebhookDelivery(ctx, claim)
+}
+
+func (s *Store) DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	return s.driver.DeleteWebhookDeliveries(ctx, delete)
+}
@@ -0,0 +1,56 @@
+import { XIcon } from "lucide-react";
+import { observer } from "mobx-react-lite";
+import { toast } from "react-hot-toast";
+import ReactMarkdown from "react-markdown";
+import rehypeSanitize from "rehype-sanitize";
+import remarkGfm from "remark-gfm";
+import { instanceServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { cn } from "@/lib/utils";
+import { instanceStore } from "@/store";
+import { InstanceSetting_AnnouncementSetting_Severity } from "@/types/proto/api/v1/instance_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const severityClassNames: Record<InstanceSetting_AnnouncementSetting_Severity, string> = {
+  [InstanceSetting_AnnouncementSetting_Severity.SEVERITY_UNSPECIFIED]: "bg-muted text-foreground border-border",
+  [InstanceSetting_AnnouncementSetting_Severity.INFO]: "bg-muted text-foreground border-border",
+  [InstanceSetting_AnnouncementSetting_Severity.WARNING]: "bg-yellow-50 text-yellow-900 border-yellow-200 dark:bg-yellow-950 dark:text-yellow-100 dark:border-yellow-900",
+  [InstanceSetting_AnnouncementSetting_Severity.CRITICAL]: "bg-red-50 text-red-900 border-red-200 dark:bg-red-950 dark:text-red-100 dark:border-red-900",
+};
+
+// AnnouncementBanner shows the announcement of the instance profile, until the user dismisses it.
+const AnnouncementBanner = observer(() => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const announcement = instanceStore.state.profile.announcement;
+  if (!announcement) {
+    return null;
+  }
+
+  const dismiss = async () => {
+    try {
+      await instanceServiceClient.dismissInstanceAnnouncement({ id: announcement.id });
+    } catch (error: any) {
+      toast.error(error.message);
+      return;
+    }
+    instanceStore.state.setPartial({ profile: { ...instanceStore.state.profile, announcement: undefined } });
+  };
+
+  return (
+    <div className={cn("w-full flex flex-row justify-between items-start gap-2 px-4 py-2 border-b text-sm", severityClassNames[announcement.severity])}>
+      <div className="min-w-0 break-words [&_a]:underline [&_p]:m-0">
+        <ReactMarkdown remarkPlugins={[remarkGfm]} rehypePlugins={[rehypeSanitize]}>
+          {announcement.content}
+        </ReactMarkdown>
+      </div>
+      {announcement.dismissible && currentUser && (
+        <button className="shrink-0 opacity-70 hover:opacity-100" title={t("common.close")} onClick={dismiss}>
+          <XIcon className="w-4 h-auto" />
+        </button>
+      )}
+    </div>
+  );
+});
+
+export default AnnouncementBanner;
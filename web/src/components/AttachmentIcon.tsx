@@ -53,7 +53,7 @@ const AttachmentIcon = (props: Props) => {
             onError={(e) => {
               // Fallback to original image if thumbnail fails
               const target = e.target as HTMLImageElement;
-              if (target.src.includes("?thumbnail=true")) {
+              if (target.src.includes("?thumbnail=medium")) {
                 console.warn("Thumbnail failed, falling back to original image:", attachmentUrl);
                 target.src = attachmentUrl;
               }
@@ -21,6 +21,7 @@ interface Props {
 interface State {
   description: string;
   expiration: number;
+  scope: string;
 }
 
 function CreateAccessTokenDialog({ open, onOpenChange, onSuccess }: Props) {
@@ -29,6 +30,7 @@ function CreateAccessTokenDialog({ open, onOpenChange, onSuccess }: Props) {
   const [state, setState] = useState({
     description: "",
     expiration: 3600 * 8,
+    scope: "full",
   });
   const requestState = useLoading(false);
 
@@ -47,6 +49,22 @@ function CreateAccessTokenDialog({ open, onOpenChange, onSuccess }: Props) {
     },
   ];
 
+  // Full access is sent as no scopes.
+  const scopeOptions = [
+    {
+      label: t("setting.access-token-section.create-dialog.scope-full"),
+      value: "full",
+    },
+    {
+      label: t("setting.access-token-section.create-dialog.scope-write"),
+      value: "write",
+    },
+    {
+      label: t("setting.access-token-section.create-dialog.scope-read"),
+      value: "read",
+    },
+  ];
+
   const setPartialState = (partialState: Partial<State>) => {
     setState({
       ...state,
@@ -66,6 +84,12 @@ function CreateAccessTokenDialog({ open, onOpenChange, onSuccess }: Props) {
     });
   };
 
+  const handleScopeInputChange = (value: string) => {
+    setPartialState({
+      scope: value,
+    });
+  };
+
   const handleSaveBtnClick = async () => {
     if (!state.description) {
       toast.error(t("message.description-is-required"));
@@ -79,6 +103,7 @@ function CreateAccessTokenDialog({ open, onOpenChange, onSuccess }: Props) {
         accessToken: {
           description: state.description,
           expiresAt: state.expiration ? timestampFromDate(new Date(Date.now() + state.expiration * 1000)) : undefined,
+          scopes: state.scope === "full" ? [] : [state.scope],
         },
       });
 
@@ -124,6 +149,17 @@ function CreateAccessTokenDialog({ open, onOpenChange, onSuccess }: Props) {
               ))}
             </RadioGroup>
           </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.access-token-section.create-dialog.scope")}</Label>
+            <RadioGroup value={state.scope} onValueChange={handleScopeInputChange} className="flex flex-row gap-4">
+              {scopeOptions.map((option) => (
+                <div key={option.value} className="flex items-center space-x-2">
+                  <RadioGroupItem value={option.value} id={`scope-${option.value}`} />
+                  <Label htmlFor={`scope-${option.value}`}>{option.label}</Label>
+                </div>
+              ))}
+            </RadioGroup>
+          </div>
         </div>
         <DialogFooter>
           <Button variant="ghost" disabled={requestState.isLoading} onClick={() => onOpenChange(false)}>
@@ -1,5 +1,6 @@
 import { create } from "@bufbuild/protobuf";
 import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
+import { PlusIcon, TrashIcon } from "lucide-react";
 import { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { Button } from "@/components/ui/button";
@@ -7,6 +8,7 @@ import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from "
 import { Input } from "@/components/ui/input";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { Separator } from "@/components/ui/separator";
+import { Switch } from "@/components/ui/switch";
 import { identityProviderServiceClient } from "@/grpcweb";
 import { absolutifyLink } from "@/helpers/utils";
 import {
@@ -18,7 +20,10 @@ import {
   IdentityProviderSchema,
   OAuth2Config,
   OAuth2ConfigSchema,
+  RoleMapping,
+  RoleMappingSchema,
 } from "@/types/proto/api/v1/idp_service_pb";
+import { User_Role } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 
 const templateList: IdentityProvider[] = [
@@ -303,6 +308,14 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
     });
   };
 
+  const setRoleMapping = (index: number, state: Partial<RoleMapping>) => {
+    setPartialOAuth2Config({
+      roleMappings: oauth2Config.roleMappings.map((roleMapping, i) =>
+        i === index ? create(RoleMappingSchema, { ...roleMapping, ...state }) : roleMapping,
+      ),
+    });
+  };
+
   return (
     <Dialog open={open} onOpenChange={onOpenChange}>
       <DialogContent className="max-w-2xl max-h-[80vh] overflow-y-auto">
@@ -476,6 +489,59 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
                   setPartialOAuth2Config({ fieldMapping: { ...oauth2Config.fieldMapping, avatarUrl: e.target.value } as FieldMapping })
                 }
               />
+              <Separator className="my-2" />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.role-claim")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.role-claim-placeholder")}
+                value={oauth2Config.roleClaim}
+                onChange={(e) => setPartialOAuth2Config({ roleClaim: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.role-mappings")}</p>
+              <p className="mb-2 text-xs text-muted-foreground">{t("setting.sso-section.role-mappings-description")}</p>
+              {oauth2Config.roleMappings.map((roleMapping, index) => (
+                <div key={index} className="mb-2 w-full flex flex-row items-center gap-2">
+                  <Input
+                    className="grow"
+                    placeholder={t("setting.sso-section.claim-value")}
+                    value={roleMapping.claimValue}
+                    onChange={(e) => setRoleMapping(index, { claimValue: e.target.value })}
+                  />
+                  <Select value={String(roleMapping.role)} onValueChange={(value) => setRoleMapping(index, { role: Number(value) as User_Role })}>
+                    <SelectTrigger className="w-32">
+                      <SelectValue />
+                    </SelectTrigger>
+                    <SelectContent>
+                      <SelectItem value={String(User_Role.HOST)}>{t("setting.member-section.host")}</SelectItem>
+                      <SelectItem value={String(User_Role.ADMIN)}>{t("setting.member-section.admin")}</SelectItem>
+                      <SelectItem value={String(User_Role.USER)}>{t("setting.member-section.user")}</SelectItem>
+                    </SelectContent>
+                  </Select>
+                  <Button
+                    variant="ghost"
+                    size="icon"
+                    onClick={() => setPartialOAuth2Config({ roleMappings: oauth2Config.roleMappings.filter((_, i) => i !== index) })}
+                  >
+                    <TrashIcon className="w-4 h-auto" />
+                  </Button>
+                </div>
+              ))}
+              <Button
+                variant="outline"
+                className="mb-2"
+                onClick={() =>
+                  setPartialOAuth2Config({
+                    roleMappings: [...oauth2Config.roleMappings, create(RoleMappingSchema, { claimValue: "", role: User_Role.USER })],
+                  })
+                }
+              >
+                <PlusIcon className="w-4 h-auto" />
+                {t("setting.sso-section.add-role-mapping")}
+              </Button>
+              <div className="w-full flex flex-row justify-between items-center gap-2">
+                <p className="text-sm font-medium">{t("setting.sso-section.sync-user-info")}</p>
+                <Switch checked={oauth2Config.syncUserInfo} onCheckedChange={(checked) => setPartialOAuth2Config({ syncUserInfo: checked })} />
+              </div>
             </>
           )}
         </div>
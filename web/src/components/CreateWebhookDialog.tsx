@@ -1,17 +1,23 @@
 import { create } from "@bufbuild/protobuf";
 import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
+import copy from "copy-to-clipboard";
 import React, { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { Button } from "@/components/ui/button";
+import { Checkbox } from "@/components/ui/checkbox";
 import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from "@/components/ui/dialog";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { userServiceClient } from "@/grpcweb";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import useLoading from "@/hooks/useLoading";
+import { UserWebhook_Format } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 
 interface Props {
+  // The parent of the webhook, the current user by default.
+  parent?: string;
   open: boolean;
   onOpenChange: (open: boolean) => void;
   webhookName?: string;
@@ -21,25 +27,44 @@ interface Props {
 interface State {
   displayName: string;
   url: string;
+  events: string[];
+  format: UserWebhook_Format;
+  includePrivateContent: boolean;
 }
 
-function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Props) {
+// The events webhooks can subscribe to. No selected events subscribes to all of them.
+const WEBHOOK_EVENTS = [
+  "memos.memo.created",
+  "memos.memo.updated",
+  "memos.memo.trashed",
+  "memos.memo.restored",
+  "memos.memo.reminder",
+  "memos.memo.expired",
+  "memos.memo.deleted",
+];
+
+function CreateWebhookDialog({ parent, open, onOpenChange, webhookName, onSuccess }: Props) {
   const t = useTranslate();
   const currentUser = useCurrentUser();
+  const webhookParent = parent ?? currentUser?.name;
+  const isWorkspace = webhookParent === "instance";
   const [state, setState] = useState<State>({
     displayName: "",
     url: "",
+    events: [],
+    format: UserWebhook_Format.RAW,
+    includePrivateContent: false,
   });
   const requestState = useLoading(false);
   const isCreating = webhookName === undefined;
 
   useEffect(() => {
-    if (webhookName && currentUser) {
+    if (webhookName && webhookParent) {
       // For editing, we need to get the webhook data
       // Since we're using user webhooks now, we need to list all webhooks and find the one we want
       userServiceClient
         .listUserWebhooks({
-          parent: currentUser.name,
+          parent: webhookParent,
         })
         .then((response) => {
           const webhook = response.webhooks.find((w) => w.name === webhookName);
@@ -47,11 +72,14 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
             setState({
               displayName: webhook.displayName,
               url: webhook.url,
+              events: webhook.events,
+              format: webhook.format,
+              includePrivateContent: webhook.includePrivateContent,
             });
           }
         });
     }
-  }, [webhookName, currentUser]);
+  }, [webhookName, webhookParent]);
 
   const setPartialState = (partialState: Partial<State>) => {
     setState({
@@ -72,13 +100,19 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
     });
   };
 
+  const handleEventCheckedChange = (event: string, checked: boolean) => {
+    setPartialState({
+      events: checked ? [...state.events, event] : state.events.filter((item) => item !== event),
+    });
+  };
+
   const handleSaveBtnClick = async () => {
     if (!state.displayName || !state.url) {
       toast.error(t("message.fill-all-required-fields"));
       return;
     }
 
-    if (!currentUser) {
+    if (!webhookParent) {
       toast.error("User not authenticated");
       return;
     }
@@ -86,21 +120,30 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
     try {
       requestState.setLoading();
       if (isCreating) {
-        await userServiceClient.createUserWebhook({
-          parent: currentUser.name,
+        const webhook = await userServiceClient.createUserWebhook({
+          parent: webhookParent,
           webhook: {
             displayName: state.displayName,
             url: state.url,
+            events: state.events,
+            format: state.format,
+            includePrivateContent: state.includePrivateContent,
           },
         });
+        // The signing secret is only returned once.
+        copy(webhook.secret);
+        toast.success(t("setting.webhook-section.secret-copied-to-clipboard"));
       } else {
         await userServiceClient.updateUserWebhook({
           webhook: {
             name: webhookName,
             displayName: state.displayName,
             url: state.url,
+            events: state.events,
+            format: state.format,
+            includePrivateContent: state.includePrivateContent,
           },
-          updateMask: create(FieldMaskSchema, { paths: ["display_name", "url"] }),
+          updateMask: create(FieldMaskSchema, { paths: ["display_name", "url", "events", "format", "include_private_content"] }),
         });
       }
 
@@ -149,6 +192,51 @@ function CreateWebhookDialog({ open, onOpenChange, webhookName, onSuccess }: Pro
               onChange={handleUrlInputChange}
             />
           </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook-section.create-dialog.format")}</Label>
+            <Select
+              value={state.format.toString()}
+              onValueChange={(value) => setPartialState({ format: parseInt(value) as UserWebhook_Format })}
+            >
+              <SelectTrigger className="w-full">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                <SelectItem value={UserWebhook_Format.RAW.toString()}>{t("setting.webhook-section.create-dialog.format-raw")}</SelectItem>
+                <SelectItem value={UserWebhook_Format.SLACK.toString()}>Slack</SelectItem>
+                <SelectItem value={UserWebhook_Format.DISCORD.toString()}>Discord</SelectItem>
+              </SelectContent>
+            </Select>
+          </div>
+          <div className="grid gap-2">
+            <Label>{t("setting.webhook-section.create-dialog.events")}</Label>
+            <div className="grid grid-cols-2 gap-2">
+              {WEBHOOK_EVENTS.map((event) => (
+                <label key={event} className="flex items-center gap-2 text-sm">
+                  <Checkbox
+                    checked={state.events.includes(event)}
+                    onCheckedChange={(checked) => handleEventCheckedChange(event, checked === true)}
+                  />
+                  {event}
+                </label>
+              ))}
+            </div>
+            <p className="text-xs text-muted-foreground">{t("setting.webhook-section.create-dialog.events-description")}</p>
+          </div>
+          {isWorkspace && (
+            <div className="grid gap-2">
+              <label className="flex items-center gap-2 text-sm">
+                <Checkbox
+                  checked={state.includePrivateContent}
+                  onCheckedChange={(checked) => setPartialState({ includePrivateContent: checked === true })}
+                />
+                {t("setting.webhook-section.create-dialog.include-private-content")}
+              </label>
+              <p className="text-xs text-muted-foreground">
+                {t("setting.webhook-section.create-dialog.include-private-content-description")}
+              </p>
+            </div>
+          )}
         </div>
         <DialogFooter>
           <Button variant="ghost" disabled={requestState.isLoading} onClick={() => onOpenChange(false)}>
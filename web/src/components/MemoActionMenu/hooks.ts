@@ -90,7 +90,7 @@ export const useMemoActionHandlers = ({ memo, onEdit, setDeleteDialogOpen, setRe
 
   const confirmDeleteMemo = useCallback(async () => {
     await memoStore.deleteMemo(memo.name);
-    toast.success(t("message.deleted-successfully"));
+    toast.success(t("message.moved-to-trash"));
     if (isInMemoDetailPage) {
       navigateTo("/");
     }
@@ -1,5 +1,5 @@
 import { Attachment } from "@/types/proto/api/v1/attachment_service_pb";
-import { getAttachmentUrl, isMidiFile } from "@/utils/attachment";
+import { formatAudioDuration, getAttachmentUrl, isMidiFile } from "@/utils/attachment";
 import AttachmentIcon from "./AttachmentIcon";
 
 interface Props {
@@ -20,7 +20,13 @@ const MemoAttachment: React.FC<Props> = (props: Props) => {
       className={`w-auto flex flex-row justify-start items-center text-muted-foreground hover:text-foreground hover:bg-accent rounded px-2 py-1 transition-colors ${className}`}
     >
       {attachment.type.startsWith("audio") && !isMidiFile(attachment.type) ? (
-        <audio src={attachmentUrl} controls></audio>
+        <div className="flex flex-col justify-start items-start gap-0.5">
+          <audio src={attachmentUrl} controls preload="metadata"></audio>
+          <span className="text-xs max-w-[256px] truncate">
+            {attachment.filename}
+            {attachment.duration && ` · ${formatAudioDuration(attachment.duration)}`}
+          </span>
+        </div>
       ) : (
         <>
           <AttachmentIcon className="w-4! h-4! mr-1" attachment={attachment} />
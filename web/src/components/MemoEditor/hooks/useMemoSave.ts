@@ -6,7 +6,7 @@ import { toast } from "react-hot-toast";
 import type { LocalFile } from "@/components/memo-metadata";
 import { memoServiceClient } from "@/grpcweb";
 import { attachmentStore, memoStore } from "@/store";
-import { Attachment, AttachmentSchema } from "@/types/proto/api/v1/attachment_service_pb";
+import type { Attachment } from "@/types/proto/api/v1/attachment_service_pb";
 import type { Location, Memo, MemoRelation, Visibility } from "@/types/proto/api/v1/memo_service_pb";
 import { MemoSchema } from "@/types/proto/api/v1/memo_service_pb";
 import type { Translations } from "@/utils/i18n";
@@ -39,15 +39,7 @@ async function uploadLocalFiles(localFiles: LocalFile[], onUploadingChange: (upl
   try {
     const attachments: Attachment[] = [];
     for (const { file } of localFiles) {
-      const buffer = new Uint8Array(await file.arrayBuffer());
-      const attachment = await attachmentStore.createAttachment(
-        create(AttachmentSchema, {
-          filename: file.name,
-          size: BigInt(file.size),
-          type: file.type,
-          content: buffer,
-        }),
-      );
+      const attachment = await attachmentStore.uploadAttachment(file);
       attachments.push(attachment);
     }
     return attachments;
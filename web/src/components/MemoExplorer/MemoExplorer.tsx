@@ -84,7 +84,13 @@ const MemoExplorer = observer((props: Props) => {
       <div className="mt-1 px-1 w-full">
         {features.statistics && <StatisticsView context={features.statisticsContext} statisticsData={statisticsData} />}
         {features.shortcuts && currentUser && <ShortcutsSection />}
-        {features.tags && <TagsSection readonly={context === "explore"} tagCount={tagCount} />}
+        {features.tags && (
+          <TagsSection
+            readonly={context === "explore"}
+            tagCount={tagCount}
+            tagTreeCreator={context === "home" ? currentUser?.name : undefined}
+          />
+        )}
       </div>
     </aside>
   );
@@ -29,11 +29,25 @@ const ShortcutsSection = observer(() => {
   const [isCreateShortcutDialogOpen, setIsCreateShortcutDialogOpen] = useState(false);
   const [deleteTarget, setDeleteTarget] = useState<Shortcut | undefined>();
   const [editingShortcut, setEditingShortcut] = useState<Shortcut | undefined>();
+  const [memoCounts, setMemoCounts] = useState<Record<string, number>>({});
 
   useAsyncEffect(async () => {
     await userStore.fetchUserSettings();
   }, []);
 
+  useAsyncEffect(async () => {
+    if (!userStore.state.currentUser || shortcuts.length === 0) {
+      setMemoCounts({});
+      return;
+    }
+    try {
+      const { memoCounts } = await shortcutServiceClient.countShortcutMemos({ parent: userStore.state.currentUser });
+      setMemoCounts(memoCounts);
+    } catch (error) {
+      console.error("Failed to count shortcut memos:", error);
+    }
+  }, [shortcuts]);
+
   const handleDeleteShortcut = async (shortcut: Shortcut) => {
     setDeleteTarget(shortcut);
   };
@@ -95,6 +109,9 @@ const ShortcutsSection = observer(() => {
                 {emoji && <span className="text-base mr-1">{emoji}</span>}
                 {title.trim()}
               </span>
+              {memoCounts[shortcut.name] !== undefined && (
+                <span className="ml-auto shrink-0 text-xs opacity-60">{memoCounts[shortcut.name]}</span>
+              )}
               <DropdownMenu>
                 <DropdownMenuTrigger asChild>
                   <MoreVerticalIcon className="w-4 h-auto shrink-0 text-muted-foreground cursor-pointer hover:text-foreground" />
@@ -1,9 +1,13 @@
 import { HashIcon, MoreVerticalIcon, TagsIcon } from "lucide-react";
 import { observer } from "mobx-react-lite";
+import { useState } from "react";
 import useLocalStorage from "react-use/lib/useLocalStorage";
 import { Switch } from "@/components/ui/switch";
+import { memoServiceClient } from "@/grpcweb";
+import useAsyncEffect from "@/hooks/useAsyncEffect";
 import { cn } from "@/lib/utils";
 import memoFilterStore, { MemoFilter } from "@/store/memoFilter";
+import { TagTreeNode } from "@/types/proto/api/v1/memo_service_pb";
 import { useTranslate } from "@/utils/i18n";
 import TagTree from "../TagTree";
 import { Popover, PopoverContent, PopoverTrigger } from "../ui/popover";
@@ -11,6 +15,9 @@ import { Popover, PopoverContent, PopoverTrigger } from "../ui/popover";
 interface Props {
   readonly?: boolean;
   tagCount: Record<string, number>;
+  // tagTreeCreator is the user whose tags are listed as a tree by the server in tree mode,
+  // counting the memos of subtags. Otherwise the tree is built from tagCount.
+  tagTreeCreator?: string;
 }
 
 const TagsSection = observer((props: Props) => {
@@ -21,6 +28,21 @@ const TagsSection = observer((props: Props) => {
   const tags = Object.entries(props.tagCount)
     .sort((a, b) => a[0].localeCompare(b[0]))
     .sort((a, b) => b[1] - a[1]);
+  const [tagNodes, setTagNodes] = useState<TagTreeNode[] | undefined>();
+
+  useAsyncEffect(async () => {
+    if (!treeMode || !props.tagTreeCreator) {
+      setTagNodes(undefined);
+      return;
+    }
+    try {
+      const { tags } = await memoServiceClient.listTagTree({ creator: props.tagTreeCreator });
+      setTagNodes(tags);
+    } catch (error) {
+      console.error("Failed to list tag tree:", error);
+      setTagNodes(undefined);
+    }
+  }, [treeMode, props.tagTreeCreator, props.tagCount]);
 
   const handleTagClick = (tag: string) => {
     const isActive = memoFilterStore.getFiltersByFactor("tagSearch").some((filter: MemoFilter) => filter.value === tag);
@@ -60,7 +82,7 @@ const TagsSection = observer((props: Props) => {
       </div>
       {tags.length > 0 ? (
         treeMode ? (
-          <TagTree tagAmounts={tags} expandSubTags={!!treeAutoExpand} />
+          <TagTree tagAmounts={tags} tagNodes={tagNodes} expandSubTags={!!treeAutoExpand} />
         ) : (
           <div className="w-full flex flex-row justify-start items-center relative flex-wrap gap-x-2 gap-y-1.5">
             {tags.map(([tag, amount]) => {
@@ -9,9 +9,15 @@ interface LinkPreviewCardProps {
 
 interface LinkPreviewData {
   url: string;
+  finalUrl: string;
   title: string;
   description: string;
   image: string;
+  contentType: string;
+  contentLength?: number;
+  filename?: string;
+  cached: boolean;
+  fetchedAt: string;
 }
 
 const FIRST_LINK_REGEX = /((https?:\/\/)?[^\s<>"'()]+\.[^\s<>"'()]+)/i;
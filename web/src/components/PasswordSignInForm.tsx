@@ -1,8 +1,9 @@
-import { ConnectError } from "@connectrpc/connect";
-import { LoaderIcon } from "lucide-react";
+import { Code, ConnectError } from "@connectrpc/connect";
+import { KeyRoundIcon, LoaderIcon } from "lucide-react";
 import { observer } from "mobx-react-lite";
 import { useState } from "react";
 import { toast } from "react-hot-toast";
+import { Link } from "react-router-dom";
 import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { authServiceClient } from "@/grpcweb";
@@ -11,6 +12,7 @@ import useNavigateTo from "@/hooks/useNavigateTo";
 import { instanceStore } from "@/store";
 import { initialUserStore } from "@/store/user";
 import { useTranslate } from "@/utils/i18n";
+import { getPasskeyAssertion, isPasskeySupported } from "@/utils/passkey";
 
 const PasswordSignInForm = observer(() => {
   const t = useTranslate();
@@ -18,6 +20,18 @@ const PasswordSignInForm = observer(() => {
   const actionBtnLoadingState = useLoading(false);
   const [username, setUsername] = useState(instanceStore.state.profile.mode === "demo" ? "demo" : "");
   const [password, setPassword] = useState(instanceStore.state.profile.mode === "demo" ? "secret" : "");
+  // Set when the user has two-factor authentication enabled and must enter a code to finish signing in.
+  const [twoFactorChallenge, setTwoFactorChallenge] = useState("");
+  const [twoFactorCode, setTwoFactorCode] = useState("");
+
+  const getSignInErrorMessage = (error: ConnectError) => {
+    // Throttled sign-ins return the same error whether or not the username exists.
+    if (error.code === Code.ResourceExhausted) {
+      const minutes = error.rawMessage.match(/try again in (\d+) minute/)?.[1] ?? "1";
+      return t("auth.sign-in-throttled", { minutes });
+    }
+    return error.message || "Failed to sign in.";
+  };
 
   const handleUsernameInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
     const text = e.target.value as string;
@@ -35,7 +49,7 @@ const PasswordSignInForm = observer(() => {
   };
 
   const handleSignInButtonClick = async () => {
-    if (username === "" || password === "") {
+    if (username === "" || password === "" || (twoFactorChallenge !== "" && twoFactorCode === "")) {
       return;
     }
 
@@ -45,17 +59,51 @@ const PasswordSignInForm = observer(() => {
 
     try {
       actionBtnLoadingState.setLoading();
+      const response = await authServiceClient.createSession({
+        credentials: twoFactorChallenge
+          ? {
+              case: "twoFactorCredentials",
+              value: { challenge: twoFactorChallenge, code: twoFactorCode },
+            }
+          : {
+              case: "passwordCredentials",
+              value: { username, password },
+            },
+      });
+      if (response.twoFactorChallenge) {
+        setTwoFactorChallenge(response.twoFactorChallenge);
+        actionBtnLoadingState.setFinish();
+        return;
+      }
+      await initialUserStore();
+      navigateTo("/");
+    } catch (error: any) {
+      console.error(error);
+      toast.error(getSignInErrorMessage(error as ConnectError));
+    }
+    actionBtnLoadingState.setFinish();
+  };
+
+  const handlePasskeySignIn = async () => {
+    if (actionBtnLoadingState.isLoading) {
+      return;
+    }
+
+    try {
+      actionBtnLoadingState.setLoading();
+      const challenge = await authServiceClient.createPasskeyChallenge({});
+      const assertion = await getPasskeyAssertion(challenge);
       await authServiceClient.createSession({
         credentials: {
-          case: "passwordCredentials",
-          value: { username, password },
+          case: "passkeyCredentials",
+          value: assertion,
         },
       });
       await initialUserStore();
       navigateTo("/");
     } catch (error: any) {
       console.error(error);
-      toast.error((error as ConnectError).message || "Failed to sign in.");
+      toast.error(getSignInErrorMessage(error as ConnectError));
     }
     actionBtnLoadingState.setFinish();
   };
@@ -92,7 +140,29 @@ const PasswordSignInForm = observer(() => {
             onChange={handlePasswordInputChanged}
             required
           />
+          <Link to="/auth/forgot-password" className="self-end mt-1 text-sm text-primary hover:underline" viewTransition>
+            {t("auth.forgot-password")}
+          </Link>
         </div>
+        {twoFactorChallenge && (
+          <div className="w-full flex flex-col justify-start items-start">
+            <span className="leading-8 text-muted-foreground">{t("auth.two-factor-code")}</span>
+            <Input
+              className="w-full bg-background h-10"
+              type="text"
+              readOnly={actionBtnLoadingState.isLoading}
+              placeholder="123456"
+              value={twoFactorCode}
+              autoComplete="one-time-code"
+              autoCapitalize="off"
+              spellCheck={false}
+              autoFocus
+              onChange={(e) => setTwoFactorCode(e.target.value)}
+              required
+            />
+            <span className="text-sm text-muted-foreground mt-1">{t("auth.two-factor-tip")}</span>
+          </div>
+        )}
       </div>
       <div className="flex flex-row justify-end items-center w-full mt-6">
         <Button type="submit" className="w-full h-10" disabled={actionBtnLoadingState.isLoading} onClick={handleSignInButtonClick}>
@@ -100,6 +170,18 @@ const PasswordSignInForm = observer(() => {
           {actionBtnLoadingState.isLoading && <LoaderIcon className="w-5 h-auto ml-2 animate-spin opacity-60" />}
         </Button>
       </div>
+      {isPasskeySupported() && instanceStore.state.profile.instanceUrl && !twoFactorChallenge && (
+        <Button
+          type="button"
+          variant="outline"
+          className="w-full h-10 mt-2"
+          disabled={actionBtnLoadingState.isLoading}
+          onClick={handlePasskeySignIn}
+        >
+          <KeyRoundIcon className="w-4 h-auto mr-2 opacity-60" />
+          {t("auth.sign-in-with-passkey")}
+        </Button>
+      )}
     </form>
   );
 });
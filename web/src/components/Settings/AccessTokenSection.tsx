@@ -64,10 +64,6 @@ const AccessTokenSection = () => {
     toast.success(t("setting.access-token-section.access-token-deleted", { description }));
   };
 
-  const getFormatedAccessToken = (accessToken: string) => {
-    return `${accessToken.slice(0, 4)}****${accessToken.slice(-4)}`;
-  };
-
   return (
     <div className="w-full flex flex-col gap-2">
       <div className="flex flex-col sm:flex-row sm:items-start sm:justify-between gap-2">
@@ -88,7 +84,7 @@ const AccessTokenSection = () => {
             header: t("setting.access-token-section.token"),
             render: (_, token: UserAccessToken) => (
               <div className="flex items-center gap-1">
-                <span className="font-mono text-foreground">{getFormatedAccessToken(token.accessToken)}</span>
+                <span className="font-mono text-foreground">{token.tokenPrefix}</span>
                 <Button variant="ghost" size="sm" onClick={() => copyAccessToken(token.accessToken)}>
                   <ClipboardIcon className="w-4 h-auto text-muted-foreground" />
                 </Button>
@@ -112,6 +108,13 @@ const AccessTokenSection = () => {
               (token.expiresAt ? timestampDate(token.expiresAt) : undefined)?.toLocaleString() ??
               t("setting.access-token-section.create-dialog.duration-never"),
           },
+          {
+            key: "lastUsedTime",
+            header: t("setting.access-token-section.last-used"),
+            render: (_, token: UserAccessToken) =>
+              (token.lastUsedTime ? timestampDate(token.lastUsedTime) : undefined)?.toLocaleString() ??
+              t("setting.access-token-section.never-used"),
+          },
           {
             key: "actions",
             header: "",
@@ -0,0 +1,116 @@
+import { toJson } from "@bufbuild/protobuf";
+import { StructSchema, timestampDate } from "@bufbuild/protobuf/wkt";
+import { useEffect, useState } from "react";
+import { Button } from "@/components/ui/button";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { instanceServiceClient } from "@/grpcweb";
+import { userStore } from "@/store";
+import { AuditLog, AuditLog_EventType } from "@/types/proto/api/v1/instance_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import SettingSection from "./SettingSection";
+import SettingTable from "./SettingTable";
+
+const EVENT_TYPES = Object.values(AuditLog_EventType).filter(
+  (value): value is AuditLog_EventType => typeof value === "number" && value !== AuditLog_EventType.EVENT_TYPE_UNSPECIFIED,
+);
+
+const AuditLogSection = () => {
+  const t = useTranslate();
+  const [auditLogs, setAuditLogs] = useState<AuditLog[]>([]);
+  const [nextPageToken, setNextPageToken] = useState("");
+  const [eventType, setEventType] = useState<AuditLog_EventType>(AuditLog_EventType.EVENT_TYPE_UNSPECIFIED);
+  const [usernames, setUsernames] = useState<Record<string, string>>({});
+
+  useEffect(() => {
+    userStore.fetchUsers().then((users) => {
+      setUsernames(Object.fromEntries(users.map((user) => [user.name, user.username])));
+    });
+  }, []);
+
+  useEffect(() => {
+    fetchAuditLogs("");
+  }, [eventType]);
+
+  const fetchAuditLogs = async (pageToken: string) => {
+    const response = await instanceServiceClient.listAuditLogs({ eventType, pageToken });
+    setAuditLogs((prev) => (pageToken ? [...prev, ...response.auditLogs] : response.auditLogs));
+    setNextPageToken(response.nextPageToken);
+  };
+
+  const formatActor = (actor: string) => {
+    if (!actor) {
+      return t("setting.audit-log-section.unauthenticated");
+    }
+    return usernames[actor] ?? actor;
+  };
+
+  return (
+    <SettingSection
+      title={t("setting.audit-log")}
+      description={t("setting.audit-log-section.description")}
+      actions={
+        <Select value={eventType.toString()} onValueChange={(value) => setEventType(Number(value) as AuditLog_EventType)}>
+          <SelectTrigger className="min-w-fit">
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value={AuditLog_EventType.EVENT_TYPE_UNSPECIFIED.toString()}>{t("setting.audit-log-section.all-events")}</SelectItem>
+            {EVENT_TYPES.map((type) => (
+              <SelectItem key={type} value={type.toString()}>
+                {AuditLog_EventType[type]}
+              </SelectItem>
+            ))}
+          </SelectContent>
+        </Select>
+      }
+    >
+      <SettingTable
+        columns={[
+          {
+            key: "createTime",
+            header: t("setting.audit-log-section.time"),
+            render: (_, auditLog: AuditLog) => (auditLog.createTime ? timestampDate(auditLog.createTime).toLocaleString() : ""),
+          },
+          {
+            key: "actor",
+            header: t("setting.audit-log-section.actor"),
+            render: (_, auditLog: AuditLog) => formatActor(auditLog.actor),
+          },
+          {
+            key: "eventType",
+            header: t("setting.audit-log-section.event"),
+            render: (_, auditLog: AuditLog) => <span className="font-mono text-xs">{AuditLog_EventType[auditLog.eventType]}</span>,
+          },
+          {
+            key: "ipAddress",
+            header: t("setting.audit-log-section.ip-address"),
+            render: (_, auditLog: AuditLog) => (
+              <span className="font-mono text-xs" title={auditLog.userAgent}>
+                {auditLog.ipAddress}
+              </span>
+            ),
+          },
+          {
+            key: "payload",
+            header: t("setting.audit-log-section.details"),
+            render: (_, auditLog: AuditLog) => (
+              <span className="font-mono text-xs break-all">{auditLog.payload ? JSON.stringify(toJson(StructSchema, auditLog.payload)) : ""}</span>
+            ),
+          },
+        ]}
+        data={auditLogs}
+        emptyMessage={t("setting.audit-log-section.no-entries")}
+        getRowKey={(auditLog: AuditLog) => auditLog.name}
+      />
+      {nextPageToken && (
+        <div className="w-full flex justify-center">
+          <Button variant="outline" onClick={() => fetchAuditLogs(nextPageToken)}>
+            {t("setting.audit-log-section.load-more")}
+          </Button>
+        </div>
+      )}
+    </SettingSection>
+  );
+};
+
+export default AuditLogSection;
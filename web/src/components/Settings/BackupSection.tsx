@@ -0,0 +1,220 @@
+import { create } from "@bufbuild/protobuf";
+import { Timestamp, timestampDate } from "@bufbuild/protobuf/wkt";
+import { isEqual } from "lodash-es";
+import { observer } from "mobx-react-lite";
+import { useEffect, useMemo, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { RadioGroup, RadioGroupItem } from "@/components/ui/radio-group";
+import { Switch } from "@/components/ui/switch";
+import { instanceServiceClient } from "@/grpcweb";
+import { instanceStore } from "@/store";
+import { buildInstanceSettingName } from "@/store/common";
+import {
+  InstanceBackupStatus,
+  InstanceSetting_BackupSetting,
+  InstanceSetting_BackupSetting_Destination,
+  InstanceSetting_BackupSettingSchema,
+  InstanceSetting_Key,
+  InstanceSettingSchema,
+} from "@/types/proto/api/v1/instance_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import SettingGroup from "./SettingGroup";
+import SettingRow from "./SettingRow";
+import SettingSection from "./SettingSection";
+
+// Helper to extract backup setting value from InstanceSetting oneof
+function getBackupSetting(setting: any): InstanceSetting_BackupSetting | undefined {
+  if (setting?.value?.case === "backupSetting") {
+    return setting.value.value;
+  }
+  return undefined;
+}
+
+const formatBytes = (bytes: bigint): string => {
+  const units = ["B", "KB", "MB", "GB", "TB"];
+  let value = Number(bytes);
+  let unit = 0;
+  while (value >= 1024 && unit < units.length - 1) {
+    value /= 1024;
+    unit++;
+  }
+  return `${unit === 0 ? value : value.toFixed(1)} ${units[unit]}`;
+};
+
+const BackupSection = observer(() => {
+  const t = useTranslate();
+  const originalSetting = create(
+    InstanceSetting_BackupSettingSchema,
+    getBackupSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.BACKUP)) || {},
+  );
+  const [backupSetting, setBackupSetting] = useState<InstanceSetting_BackupSetting>(originalSetting);
+  const [backupStatus, setBackupStatus] = useState<InstanceBackupStatus | undefined>(undefined);
+
+  useEffect(() => {
+    instanceStore.fetchInstanceSetting(InstanceSetting_Key.BACKUP);
+    fetchBackupStatus();
+  }, []);
+
+  useEffect(() => {
+    setBackupSetting(
+      create(InstanceSetting_BackupSettingSchema, getBackupSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.BACKUP)) || {}),
+    );
+  }, [instanceStore.getInstanceSettingByKey(InstanceSetting_Key.BACKUP)]);
+
+  // Poll the status while a backup is running.
+  useEffect(() => {
+    if (!backupStatus?.running) {
+      return;
+    }
+    const timer = setTimeout(fetchBackupStatus, 2000);
+    return () => clearTimeout(timer);
+  }, [backupStatus]);
+
+  const allowSave = useMemo(() => {
+    if (backupSetting.retentionCount < 0) {
+      return false;
+    }
+    return !isEqual(originalSetting, backupSetting);
+  }, [backupSetting, instanceStore.state]);
+
+  const fetchBackupStatus = async () => {
+    setBackupStatus(await instanceServiceClient.getInstanceBackupStatus({}));
+  };
+
+  const updatePartialSetting = (partial: Partial<InstanceSetting_BackupSetting>) => {
+    setBackupSetting(create(InstanceSetting_BackupSettingSchema, { ...backupSetting, ...partial }));
+  };
+
+  const handleRetentionCountChanged = (event: React.ChangeEvent<HTMLInputElement>) => {
+    let num = parseInt(event.target.value);
+    if (Number.isNaN(num)) {
+      num = 0;
+    }
+    updatePartialSetting({ retentionCount: num });
+  };
+
+  const saveBackupSetting = async () => {
+    try {
+      await instanceStore.upsertInstanceSetting(
+        create(InstanceSettingSchema, {
+          name: buildInstanceSettingName(InstanceSetting_Key.BACKUP),
+          value: {
+            case: "backupSetting",
+            value: backupSetting,
+          },
+        }),
+      );
+    } catch (error: any) {
+      toast.error(error.message);
+      return;
+    }
+    await fetchBackupStatus();
+    toast.success(t("message.update-succeed"));
+  };
+
+  const runBackup = async () => {
+    try {
+      await instanceServiceClient.runInstanceBackup({});
+    } catch (error: any) {
+      toast.error(error.message);
+      return;
+    }
+    await fetchBackupStatus();
+  };
+
+  const formatTime = (time?: Timestamp) => (time ? timestampDate(time).toLocaleString() : "-");
+
+  return (
+    <SettingSection>
+      <SettingGroup title={t("setting.backup-section.schedule")} description={t("setting.backup-section.description")}>
+        <SettingRow label={t("setting.backup-section.enabled")}>
+          <Switch checked={backupSetting.enabled} onCheckedChange={(checked) => updatePartialSetting({ enabled: checked })} />
+        </SettingRow>
+        <SettingRow label={t("setting.backup-section.schedule")} tooltip={t("setting.backup-section.schedule-hint")}>
+          <Input
+            className="w-48 font-mono"
+            value={backupSetting.schedule}
+            placeholder="@daily"
+            onChange={(e) => updatePartialSetting({ schedule: e.target.value })}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.backup-section.retention-count")} tooltip={t("setting.backup-section.retention-count-hint")}>
+          <Input className="w-24 font-mono" value={String(backupSetting.retentionCount)} onChange={handleRetentionCountChanged} />
+        </SettingRow>
+      </SettingGroup>
+      <SettingGroup title={t("setting.backup-section.destination")} showSeparator>
+        <div className="w-full">
+          <RadioGroup
+            value={String(backupSetting.destination)}
+            onValueChange={(value) => updatePartialSetting({ destination: Number(value) as InstanceSetting_BackupSetting_Destination })}
+            className="flex flex-row gap-4"
+          >
+            <div className="flex items-center space-x-2">
+              <RadioGroupItem value={String(InstanceSetting_BackupSetting_Destination.LOCAL)} id="backup-local" />
+              <Label htmlFor="backup-local">{t("setting.backup-section.local-directory")}</Label>
+            </div>
+            <div className="flex items-center space-x-2">
+              <RadioGroupItem value={String(InstanceSetting_BackupSetting_Destination.S3)} id="backup-s3" />
+              <Label htmlFor="backup-s3">S3</Label>
+            </div>
+          </RadioGroup>
+        </div>
+        {backupSetting.destination === InstanceSetting_BackupSetting_Destination.S3 ? (
+          <SettingRow label={t("setting.backup-section.s3-prefix")} tooltip={t("setting.backup-section.s3-prefix-hint")}>
+            <Input
+              className="w-64"
+              value={backupSetting.s3Prefix}
+              placeholder="backups/"
+              onChange={(e) => updatePartialSetting({ s3Prefix: e.target.value })}
+            />
+          </SettingRow>
+        ) : (
+          <SettingRow label={t("setting.backup-section.local-path")} tooltip={t("setting.backup-section.local-path-hint")}>
+            <Input
+              className="w-64"
+              value={backupSetting.localPath}
+              placeholder="backups"
+              onChange={(e) => updatePartialSetting({ localPath: e.target.value })}
+            />
+          </SettingRow>
+        )}
+      </SettingGroup>
+      <div className="w-full flex justify-end">
+        <Button disabled={!allowSave} onClick={saveBackupSetting}>
+          {t("common.save")}
+        </Button>
+      </div>
+      <SettingGroup title={t("setting.backup-section.status")} showSeparator>
+        <SettingRow label={t("setting.backup-section.last-run")}>
+          <span className="text-sm">{backupStatus?.running ? t("setting.backup-section.running") : formatTime(backupStatus?.lastFinishTime)}</span>
+        </SettingRow>
+        {backupStatus?.lastError ? (
+          <SettingRow label={t("setting.backup-section.last-error")}>
+            <span className="text-sm text-destructive break-all">{backupStatus.lastError}</span>
+          </SettingRow>
+        ) : (
+          backupStatus?.lastBackup && (
+            <SettingRow label={t("setting.backup-section.last-backup")}>
+              <span className="text-sm font-mono">
+                {backupStatus.lastBackup} ({formatBytes(backupStatus.lastBackupSizeBytes)})
+              </span>
+            </SettingRow>
+          )
+        )}
+        <SettingRow label={t("setting.backup-section.next-run")}>
+          <span className="text-sm">{formatTime(backupStatus?.nextRunTime)}</span>
+        </SettingRow>
+      </SettingGroup>
+      <div className="w-full flex justify-end">
+        <Button variant="outline" disabled={backupStatus?.running} onClick={runBackup}>
+          {t("setting.backup-section.run-now")}
+        </Button>
+      </div>
+    </SettingSection>
+  );
+});
+
+export default BackupSection;
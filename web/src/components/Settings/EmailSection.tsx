@@ -0,0 +1,143 @@
+import { create } from "@bufbuild/protobuf";
+import { isEqual } from "lodash-es";
+import { observer } from "mobx-react-lite";
+import { useEffect, useMemo, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Switch } from "@/components/ui/switch";
+import { instanceStore } from "@/store";
+import { buildInstanceSettingName } from "@/store/common";
+import {
+  InstanceSetting_EmailSetting,
+  InstanceSetting_EmailSettingSchema,
+  InstanceSetting_Key,
+  InstanceSettingSchema,
+} from "@/types/proto/api/v1/instance_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import SettingGroup from "./SettingGroup";
+import SettingRow from "./SettingRow";
+import SettingSection from "./SettingSection";
+
+// Helper to extract email setting value from InstanceSetting oneof
+function getEmailSetting(setting: any): InstanceSetting_EmailSetting | undefined {
+  if (setting?.value?.case === "emailSetting") {
+    return setting.value.value;
+  }
+  return undefined;
+}
+
+const EmailSection = observer(() => {
+  const t = useTranslate();
+  const originalSetting = create(
+    InstanceSetting_EmailSettingSchema,
+    getEmailSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.EMAIL)) || {},
+  );
+  const [emailSetting, setEmailSetting] = useState<InstanceSetting_EmailSetting>(originalSetting);
+
+  useEffect(() => {
+    setEmailSetting(
+      create(InstanceSetting_EmailSettingSchema, getEmailSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.EMAIL)) || {}),
+    );
+  }, [instanceStore.getInstanceSettingByKey(InstanceSetting_Key.EMAIL)]);
+
+  const allowSave = useMemo(() => {
+    if (emailSetting.smtpPort < 0 || emailSetting.smtpPort > 65535) {
+      return false;
+    }
+    return !isEqual(originalSetting, emailSetting);
+  }, [emailSetting, instanceStore.state]);
+
+  const updatePartialSetting = (partial: Partial<InstanceSetting_EmailSetting>) => {
+    setEmailSetting(create(InstanceSetting_EmailSettingSchema, { ...emailSetting, ...partial }));
+  };
+
+  const handleSmtpPortChanged = (event: React.ChangeEvent<HTMLInputElement>) => {
+    let num = parseInt(event.target.value);
+    if (Number.isNaN(num)) {
+      num = 0;
+    }
+    updatePartialSetting({ smtpPort: num });
+  };
+
+  const saveEmailSetting = async () => {
+    await instanceStore.upsertInstanceSetting(
+      create(InstanceSettingSchema, {
+        name: buildInstanceSettingName(InstanceSetting_Key.EMAIL),
+        value: {
+          case: "emailSetting",
+          value: emailSetting,
+        },
+      }),
+    );
+    toast.success(t("message.update-succeed"));
+  };
+
+  return (
+    <SettingSection>
+      <SettingGroup title={t("setting.email-section.smtp-server")} description={t("setting.email-section.description")}>
+        <SettingRow label={t("setting.email-section.smtp-host")}>
+          <Input
+            className="w-64"
+            value={emailSetting.smtpHost}
+            placeholder="smtp.example.com"
+            onChange={(e) => updatePartialSetting({ smtpHost: e.target.value })}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.email-section.smtp-port")}>
+          <Input
+            className="w-24 font-mono"
+            value={emailSetting.smtpPort ? String(emailSetting.smtpPort) : ""}
+            placeholder="587"
+            onChange={handleSmtpPortChanged}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.email-section.smtp-username")}>
+          <Input className="w-64" value={emailSetting.smtpUsername} onChange={(e) => updatePartialSetting({ smtpUsername: e.target.value })} />
+        </SettingRow>
+        <SettingRow label={t("setting.email-section.smtp-password")}>
+          <Input
+            className="w-64"
+            type="password"
+            value={emailSetting.smtpPassword}
+            onChange={(e) => updatePartialSetting({ smtpPassword: e.target.value })}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.email-section.use-tls")} tooltip={t("setting.email-section.use-tls-hint")}>
+          <Switch checked={emailSetting.useTls} onCheckedChange={(checked) => updatePartialSetting({ useTls: checked })} />
+        </SettingRow>
+      </SettingGroup>
+      <SettingGroup title={t("setting.email-section.sender")} showSeparator>
+        <SettingRow label={t("setting.email-section.from-email")}>
+          <Input
+            className="w-64"
+            type="email"
+            value={emailSetting.fromEmail}
+            placeholder="memos@example.com"
+            onChange={(e) => updatePartialSetting({ fromEmail: e.target.value })}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.email-section.from-name")}>
+          <Input className="w-64" value={emailSetting.fromName} placeholder="Memos" onChange={(e) => updatePartialSetting({ fromName: e.target.value })} />
+        </SettingRow>
+      </SettingGroup>
+      <SettingGroup title={t("setting.email-section.inbound")} description={t("setting.email-section.inbound-description")} showSeparator>
+        <SettingRow label={t("setting.email-section.inbound-domain")} tooltip={t("setting.email-section.inbound-domain-hint")}>
+          <Input
+            className="w-64"
+            value={emailSetting.inboundDomain}
+            placeholder="in.example.com"
+            onChange={(e) => updatePartialSetting({ inboundDomain: e.target.value })}
+          />
+        </SettingRow>
+      </SettingGroup>
+      <div className="w-full flex justify-end">
+        <Button disabled={!allowSave} onClick={saveEmailSetting}>
+          {t("common.save")}
+        </Button>
+      </div>
+    </SettingSection>
+  );
+});
+
+export default EmailSection;
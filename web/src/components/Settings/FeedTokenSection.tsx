@@ -0,0 +1,109 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { ConnectError } from "@connectrpc/connect";
+import copy from "copy-to-clipboard";
+import { CalendarIcon, RefreshCwIcon, RssIcon, TrashIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import { Button } from "@/components/ui/button";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { UserFeedToken } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const FeedTokenSection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [feedToken, setFeedToken] = useState<UserFeedToken | undefined>(undefined);
+  // The token is only returned when it's rotated, so it's kept to copy the calendar URL as well.
+  const [token, setToken] = useState("");
+  const [revokeDialogOpen, setRevokeDialogOpen] = useState(false);
+  const name = `${currentUser.name}/feedToken`;
+  const buildPrivateURL = (path: string, privateToken: string) =>
+    `${window.location.origin}/u/${encodeURIComponent(currentUser.username)}/${path}?token=${privateToken}`;
+
+  useEffect(() => {
+    userServiceClient.getUserFeedToken({ name }).then(setFeedToken);
+  }, [name]);
+
+  const handleRotateToken = async () => {
+    try {
+      const rotated = await userServiceClient.rotateUserFeedToken({ name });
+      setFeedToken(rotated);
+      setToken(rotated.token);
+      // The token is only returned once, so the feed URL is copied right away.
+      copy(buildPrivateURL("rss.xml", rotated.token));
+      toast.success(t("setting.feed-token-section.feed-url-copied-to-clipboard"));
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to generate feed token.");
+    }
+  };
+
+  const confirmRevokeToken = async () => {
+    await userServiceClient.deleteUserFeedToken({ name });
+    setFeedToken(await userServiceClient.getUserFeedToken({ name }));
+    setToken("");
+    setRevokeDialogOpen(false);
+    toast.success(t("setting.feed-token-section.token-revoked"));
+  };
+
+  return (
+    <div className="w-full flex flex-col gap-2">
+      <div className="flex flex-col gap-1">
+        <h4 className="text-sm font-medium text-muted-foreground">{t("setting.feed-token-section.title")}</h4>
+        <p className="text-xs text-muted-foreground">{t("setting.feed-token-section.description")}</p>
+      </div>
+
+      <div className="flex flex-row items-center gap-2">
+        <RssIcon className="w-4 h-4 text-muted-foreground" />
+        <span className="text-sm text-foreground">
+          {feedToken?.enabled && feedToken.createTime
+            ? t("setting.feed-token-section.created-at", { time: timestampDate(feedToken.createTime).toLocaleString() })
+            : t("setting.feed-token-section.no-token")}
+        </span>
+        <div className="ml-auto flex flex-row items-center gap-2">
+          <Button variant="outline" size="sm" onClick={handleRotateToken}>
+            <RefreshCwIcon className="w-4 h-4 mr-1.5" />
+            {feedToken?.enabled ? t("setting.feed-token-section.rotate-token") : t("setting.feed-token-section.generate-token")}
+          </Button>
+          {feedToken?.enabled && (
+            <Button variant="ghost" size="sm" onClick={() => setRevokeDialogOpen(true)}>
+              <TrashIcon className="w-4 h-auto text-destructive" />
+            </Button>
+          )}
+        </div>
+      </div>
+      {token && (
+        <div className="flex flex-row items-center gap-2">
+          <CalendarIcon className="w-4 h-4 text-muted-foreground" />
+          <span className="text-sm text-muted-foreground">{t("setting.feed-token-section.calendar-description")}</span>
+          <Button
+            className="ml-auto"
+            variant="outline"
+            size="sm"
+            onClick={() => {
+              copy(buildPrivateURL("calendar.ics", token));
+              toast.success(t("setting.feed-token-section.calendar-url-copied-to-clipboard"));
+            }}
+          >
+            {t("setting.feed-token-section.copy-calendar-url")}
+          </Button>
+        </div>
+      )}
+
+      <ConfirmDialog
+        open={revokeDialogOpen}
+        onOpenChange={setRevokeDialogOpen}
+        title={t("setting.feed-token-section.token-revocation")}
+        description={t("setting.feed-token-section.token-revocation-description")}
+        confirmLabel={t("setting.feed-token-section.revoke-token")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmRevokeToken}
+        confirmVariant="destructive"
+      />
+    </div>
+  );
+};
+
+export default FeedTokenSection;
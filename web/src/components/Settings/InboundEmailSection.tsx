@@ -0,0 +1,99 @@
+import { ConnectError } from "@connectrpc/connect";
+import copy from "copy-to-clipboard";
+import { CopyIcon, MailIcon, RefreshCwIcon, TrashIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import { Button } from "@/components/ui/button";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { UserInboundEmail } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const InboundEmailSection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [inboundEmail, setInboundEmail] = useState<UserInboundEmail | undefined>(undefined);
+  const [removeDialogOpen, setRemoveDialogOpen] = useState(false);
+  const name = `${currentUser.name}/inboundEmail`;
+
+  useEffect(() => {
+    userServiceClient.getUserInboundEmail({ name }).then(setInboundEmail);
+  }, [name]);
+
+  const handleCopyAddress = () => {
+    if (!inboundEmail?.address) {
+      return;
+    }
+    copy(inboundEmail.address);
+    toast.success(t("setting.inbound-email-section.address-copied-to-clipboard"));
+  };
+
+  const handleRotateAddress = async () => {
+    try {
+      const rotated = await userServiceClient.rotateUserInboundEmail({ name });
+      setInboundEmail(rotated);
+      copy(rotated.address);
+      toast.success(t("setting.inbound-email-section.address-copied-to-clipboard"));
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to generate inbound address.");
+    }
+  };
+
+  const confirmRemoveAddress = async () => {
+    await userServiceClient.deleteUserInboundEmail({ name });
+    setInboundEmail(await userServiceClient.getUserInboundEmail({ name }));
+    setRemoveDialogOpen(false);
+    toast.success(t("setting.inbound-email-section.address-removed"));
+  };
+
+  return (
+    <div className="w-full flex flex-col gap-2">
+      <div className="flex flex-col gap-1">
+        <h4 className="text-sm font-medium text-muted-foreground">{t("setting.inbound-email-section.title")}</h4>
+        <p className="text-xs text-muted-foreground">{t("setting.inbound-email-section.description")}</p>
+      </div>
+
+      {inboundEmail && !inboundEmail.available ? (
+        <p className="text-sm text-muted-foreground">{t("setting.inbound-email-section.disabled")}</p>
+      ) : (
+        <div className="flex flex-row items-center gap-2">
+          <MailIcon className="w-4 h-4 text-muted-foreground" />
+          {inboundEmail?.address ? (
+            <button className="text-sm font-mono text-foreground truncate cursor-pointer inline-flex items-center gap-1" onClick={handleCopyAddress}>
+              {inboundEmail.address}
+              <CopyIcon className="w-3 h-3 text-muted-foreground shrink-0" />
+            </button>
+          ) : (
+            <span className="text-sm text-foreground">{t("setting.inbound-email-section.no-address")}</span>
+          )}
+          <div className="ml-auto flex flex-row items-center gap-2">
+            <Button variant="outline" size="sm" onClick={handleRotateAddress}>
+              <RefreshCwIcon className="w-4 h-4 mr-1.5" />
+              {inboundEmail?.address ? t("setting.inbound-email-section.regenerate-address") : t("setting.inbound-email-section.generate-address")}
+            </Button>
+            {inboundEmail?.address && (
+              <Button variant="ghost" size="sm" onClick={() => setRemoveDialogOpen(true)}>
+                <TrashIcon className="w-4 h-auto text-destructive" />
+              </Button>
+            )}
+          </div>
+        </div>
+      )}
+
+      <ConfirmDialog
+        open={removeDialogOpen}
+        onOpenChange={setRemoveDialogOpen}
+        title={t("setting.inbound-email-section.address-removal")}
+        description={t("setting.inbound-email-section.address-removal-description")}
+        confirmLabel={t("setting.inbound-email-section.remove-address")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmRemoveAddress}
+        confirmVariant="destructive"
+      />
+    </div>
+  );
+};
+
+export default InboundEmailSection;
@@ -4,6 +4,7 @@ import { observer } from "mobx-react-lite";
 import { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
 import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { Switch } from "@/components/ui/switch";
 import { Textarea } from "@/components/ui/textarea";
@@ -23,6 +24,7 @@ import UpdateCustomizedProfileDialog from "../UpdateCustomizedProfileDialog";
 import SettingGroup from "./SettingGroup";
 import SettingRow from "./SettingRow";
 import SettingSection from "./SettingSection";
+import WebhookSection from "./WebhookSection";
 
 // Helper to extract general setting value from InstanceSetting oneof
 function getGeneralSetting(setting: any): InstanceSetting_GeneralSetting | undefined {
@@ -170,6 +172,39 @@ const InstanceSection = observer(() => {
             </SelectContent>
           </Select>
         </SettingRow>
+
+        <SettingRow label={t("setting.instance-section.audit-log-retention-days")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={1}
+            value={instanceGeneralSetting.auditLogRetentionDays}
+            onChange={(event) => updatePartialSetting({ auditLogRetentionDays: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.instance-section.session-idle-timeout-hours")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={1}
+            value={instanceGeneralSetting.sessionIdleTimeoutHours}
+            onChange={(event) => updatePartialSetting({ sessionIdleTimeoutHours: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow
+          label={t("setting.instance-section.session-absolute-lifetime-hours")}
+          tooltip={t("setting.instance-section.session-absolute-lifetime-hours-hint")}
+        >
+          <Input
+            className="w-24"
+            type="number"
+            min={0}
+            value={instanceGeneralSetting.sessionAbsoluteLifetimeHours}
+            onChange={(event) => updatePartialSetting({ sessionAbsoluteLifetimeHours: Number(event.target.value) })}
+          />
+        </SettingRow>
       </SettingGroup>
 
       <div className="w-full flex justify-end">
@@ -178,6 +213,10 @@ const InstanceSection = observer(() => {
         </Button>
       </div>
 
+      <SettingGroup showSeparator>
+        <WebhookSection parent="instance" />
+      </SettingGroup>
+
       <UpdateCustomizedProfileDialog
         open={customizeDialog.isOpen}
         onOpenChange={customizeDialog.setOpen}
@@ -0,0 +1,99 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { ConnectError } from "@connectrpc/connect";
+import copy from "copy-to-clipboard";
+import { KeyRoundIcon, LinkIcon, TrashIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import { Button } from "@/components/ui/button";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { UserMatrixLink } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const MatrixLinkSection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [matrixLink, setMatrixLink] = useState<UserMatrixLink | undefined>(undefined);
+  const [unlinkDialogOpen, setUnlinkDialogOpen] = useState(false);
+  const name = `${currentUser.name}/matrixLink`;
+  const linked = Boolean(matrixLink?.matrixUserId);
+
+  useEffect(() => {
+    userServiceClient.getUserMatrixLink({ name }).then(setMatrixLink);
+  }, [name]);
+
+  const handleGenerateCode = async () => {
+    try {
+      const generated = await userServiceClient.generateUserMatrixLinkCode({ name });
+      setMatrixLink(generated);
+      copy(`!memos link ${generated.linkCode}`);
+      toast.success(t("setting.matrix-link-section.code-copied-to-clipboard"));
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to generate link code.");
+    }
+  };
+
+  const confirmUnlink = async () => {
+    await userServiceClient.deleteUserMatrixLink({ name });
+    setMatrixLink(await userServiceClient.getUserMatrixLink({ name }));
+    setUnlinkDialogOpen(false);
+    toast.success(t("setting.matrix-link-section.unlinked"));
+  };
+
+  if (matrixLink && !matrixLink.available) {
+    return null;
+  }
+
+  return (
+    <div className="w-full flex flex-col gap-2">
+      <div className="flex flex-col gap-1">
+        <h4 className="text-sm font-medium text-muted-foreground">{t("setting.matrix-link-section.title")}</h4>
+        <p className="text-xs text-muted-foreground">{t("setting.matrix-link-section.description")}</p>
+      </div>
+
+      <div className="flex flex-row items-center gap-2">
+        <LinkIcon className="w-4 h-4 text-muted-foreground" />
+        <span className="text-sm text-foreground">
+          {linked && matrixLink?.linkTime
+            ? t("setting.matrix-link-section.linked-at", { time: timestampDate(matrixLink.linkTime).toLocaleString() })
+            : t("setting.matrix-link-section.not-linked")}
+        </span>
+        <div className="ml-auto flex flex-row items-center gap-2">
+          <Button variant="outline" size="sm" onClick={handleGenerateCode}>
+            <KeyRoundIcon className="w-4 h-4 mr-1.5" />
+            {linked ? t("setting.matrix-link-section.relink") : t("setting.matrix-link-section.generate-code")}
+          </Button>
+          {linked && (
+            <Button variant="ghost" size="sm" onClick={() => setUnlinkDialogOpen(true)}>
+              <TrashIcon className="w-4 h-auto text-destructive" />
+            </Button>
+          )}
+        </div>
+      </div>
+
+      {matrixLink?.linkCode && matrixLink.linkCodeExpireTime && (
+        <div className="flex flex-col gap-1 rounded-lg border border-border px-3 py-2">
+          <span className="text-lg font-mono text-foreground">!memos link {matrixLink.linkCode}</span>
+          <span className="text-xs text-muted-foreground">
+            {t("setting.matrix-link-section.code-hint", { time: timestampDate(matrixLink.linkCodeExpireTime).toLocaleTimeString() })}
+          </span>
+        </div>
+      )}
+
+      <ConfirmDialog
+        open={unlinkDialogOpen}
+        onOpenChange={setUnlinkDialogOpen}
+        title={t("setting.matrix-link-section.unlink-confirm")}
+        description={t("setting.matrix-link-section.unlink-confirm-description")}
+        confirmLabel={t("setting.matrix-link-section.unlink")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmUnlink}
+        confirmVariant="destructive"
+      />
+    </div>
+  );
+};
+
+export default MatrixLinkSection;
@@ -0,0 +1,131 @@
+import { create } from "@bufbuild/protobuf";
+import { isEqual } from "lodash-es";
+import { TrashIcon } from "lucide-react";
+import { observer } from "mobx-react-lite";
+import { useEffect, useMemo, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Switch } from "@/components/ui/switch";
+import { instanceStore } from "@/store";
+import { buildInstanceSettingName } from "@/store/common";
+import {
+  InstanceSetting_Key,
+  InstanceSetting_MatrixSetting,
+  InstanceSetting_MatrixSetting_Room,
+  InstanceSetting_MatrixSettingSchema,
+  InstanceSettingSchema,
+} from "@/types/proto/api/v1/instance_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import SettingGroup from "./SettingGroup";
+import SettingRow from "./SettingRow";
+import SettingSection from "./SettingSection";
+
+// Helper to extract Matrix setting value from InstanceSetting oneof
+function getMatrixSetting(setting: any): InstanceSetting_MatrixSetting | undefined {
+  if (setting?.value?.case === "matrixSetting") {
+    return setting.value.value;
+  }
+  return undefined;
+}
+
+const MatrixSection = observer(() => {
+  const t = useTranslate();
+  const originalSetting = create(
+    InstanceSetting_MatrixSettingSchema,
+    getMatrixSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.MATRIX)) || {},
+  );
+  const [matrixSetting, setMatrixSetting] = useState<InstanceSetting_MatrixSetting>(originalSetting);
+
+  useEffect(() => {
+    instanceStore.fetchInstanceSetting(InstanceSetting_Key.MATRIX);
+  }, []);
+
+  useEffect(() => {
+    setMatrixSetting(
+      create(InstanceSetting_MatrixSettingSchema, getMatrixSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.MATRIX)) || {}),
+    );
+  }, [instanceStore.getInstanceSettingByKey(InstanceSetting_Key.MATRIX)]);
+
+  const allowSave = useMemo(() => !isEqual(originalSetting, matrixSetting), [matrixSetting, instanceStore.state]);
+
+  const updatePartialSetting = (partial: Partial<InstanceSetting_MatrixSetting>) => {
+    setMatrixSetting(create(InstanceSetting_MatrixSettingSchema, { ...matrixSetting, ...partial }));
+  };
+
+  const updateRoom = (roomId: string, partial: Partial<InstanceSetting_MatrixSetting_Room>) => {
+    updatePartialSetting({ rooms: matrixSetting.rooms.map((room) => (room.roomId === roomId ? { ...room, ...partial } : room)) });
+  };
+
+  const removeRoom = (roomId: string) => {
+    updatePartialSetting({ rooms: matrixSetting.rooms.filter((room) => room.roomId !== roomId) });
+  };
+
+  const saveMatrixSetting = async () => {
+    await instanceStore.upsertInstanceSetting(
+      create(InstanceSettingSchema, {
+        name: buildInstanceSettingName(InstanceSetting_Key.MATRIX),
+        value: {
+          case: "matrixSetting",
+          value: matrixSetting,
+        },
+      }),
+    );
+    toast.success(t("message.update-succeed"));
+  };
+
+  return (
+    <SettingSection>
+      <SettingGroup title={t("setting.matrix-section.bot")} description={t("setting.matrix-section.description")}>
+        <SettingRow label={t("setting.matrix-section.homeserver-url")}>
+          <Input
+            className="w-64"
+            value={matrixSetting.homeserverUrl}
+            placeholder="https://matrix.org"
+            onChange={(e) => updatePartialSetting({ homeserverUrl: e.target.value })}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.matrix-section.access-token")} tooltip={t("setting.matrix-section.access-token-hint")}>
+          <Input
+            className="w-64"
+            type="password"
+            autoComplete="off"
+            value={matrixSetting.accessToken}
+            onChange={(e) => updatePartialSetting({ accessToken: e.target.value })}
+          />
+        </SettingRow>
+      </SettingGroup>
+      <SettingGroup title={t("setting.matrix-section.rooms")} description={t("setting.matrix-section.rooms-description")} showSeparator>
+        {matrixSetting.rooms.length === 0 ? (
+          <p className="text-sm text-muted-foreground">{t("setting.matrix-section.no-rooms")}</p>
+        ) : (
+          matrixSetting.rooms.map((room) => (
+            <div key={room.roomId} className="w-full flex flex-row items-center gap-4">
+              <span className="text-sm font-mono text-foreground truncate">{room.roomId}</span>
+              <div className="ml-auto flex flex-row items-center gap-4 shrink-0">
+                <label className="flex flex-row items-center gap-2 text-sm text-muted-foreground">
+                  {t("setting.matrix-section.room-enabled")}
+                  <Switch checked={room.enabled} onCheckedChange={(checked) => updateRoom(room.roomId, { enabled: checked })} />
+                </label>
+                <label className="flex flex-row items-center gap-2 text-sm text-muted-foreground">
+                  {t("setting.matrix-section.post-public-memos")}
+                  <Switch checked={room.postPublicMemos} onCheckedChange={(checked) => updateRoom(room.roomId, { postPublicMemos: checked })} />
+                </label>
+                <Button variant="ghost" size="sm" onClick={() => removeRoom(room.roomId)}>
+                  <TrashIcon className="w-4 h-auto text-destructive" />
+                </Button>
+              </div>
+            </div>
+          ))
+        )}
+      </SettingGroup>
+      <div className="w-full flex justify-end">
+        <Button disabled={!allowSave} onClick={saveMatrixSetting}>
+          {t("common.save")}
+        </Button>
+      </div>
+    </SettingSection>
+  );
+});
+
+export default MatrixSection;
@@ -29,6 +29,7 @@ const MemberSection = observer(() => {
   const sortedUsers = sortBy(users, "id");
   const [archiveTarget, setArchiveTarget] = useState<User | undefined>(undefined);
   const [deleteTarget, setDeleteTarget] = useState<User | undefined>(undefined);
+  const [suspendTarget, setSuspendTarget] = useState<User | undefined>(undefined);
 
   useEffect(() => {
     fetchUsers();
@@ -91,6 +92,28 @@ const MemberSection = observer(() => {
     await fetchUsers();
   };
 
+  const handleUnlockUserClick = async (user: User) => {
+    const { username } = user;
+    await userServiceClient.unlockUser({ name: user.name });
+    toast.success(t("setting.member-section.unlock-success", { username }));
+  };
+
+  const confirmSuspendUser = async () => {
+    if (!suspendTarget) return;
+    const { username, name } = suspendTarget;
+    await userServiceClient.suspendUser({ name });
+    setSuspendTarget(undefined);
+    toast.success(t("setting.member-section.suspend-success", { username }));
+    await fetchUsers();
+  };
+
+  const handleUnsuspendUserClick = async (user: User) => {
+    const { username } = user;
+    await userServiceClient.unsuspendUser({ name: user.name });
+    toast.success(t("setting.member-section.unsuspend-success", { username }));
+    await fetchUsers();
+  };
+
   const handleDeleteUserClick = async (user: User) => {
     setDeleteTarget(user);
   };
@@ -123,6 +146,7 @@ const MemberSection = observer(() => {
               <span className="text-foreground">
                 {user.username}
                 {user.state === State.ARCHIVED && <span className="ml-2 italic text-muted-foreground">(Archived)</span>}
+                {user.suspendTime && <span className="ml-2 italic text-muted-foreground">({t("setting.member-section.suspended")})</span>}
               </span>
             ),
           },
@@ -157,6 +181,16 @@ const MemberSection = observer(() => {
                   </DropdownMenuTrigger>
                   <DropdownMenuContent align="end" sideOffset={2}>
                     <DropdownMenuItem onClick={() => handleEditUser(user)}>{t("common.update")}</DropdownMenuItem>
+                    <DropdownMenuItem onClick={() => handleUnlockUserClick(user)}>{t("setting.member-section.unlock-member")}</DropdownMenuItem>
+                    {currentUser?.role === User_Role.HOST &&
+                      user.role !== User_Role.HOST &&
+                      (user.suspendTime ? (
+                        <DropdownMenuItem onClick={() => handleUnsuspendUserClick(user)}>
+                          {t("setting.member-section.unsuspend-member")}
+                        </DropdownMenuItem>
+                      ) : (
+                        <DropdownMenuItem onClick={() => setSuspendTarget(user)}>{t("setting.member-section.suspend-member")}</DropdownMenuItem>
+                      ))}
                     {user.state === State.NORMAL ? (
                       <DropdownMenuItem onClick={() => handleArchiveUserClick(user)}>
                         {t("setting.member-section.archive-member")}
@@ -196,6 +230,17 @@ const MemberSection = observer(() => {
         confirmVariant="default"
       />
 
+      <ConfirmDialog
+        open={!!suspendTarget}
+        onOpenChange={(open) => !open && setSuspendTarget(undefined)}
+        title={suspendTarget ? t("setting.member-section.suspend-warning", { username: suspendTarget.username }) : ""}
+        description={suspendTarget ? t("setting.member-section.suspend-warning-description") : ""}
+        confirmLabel={t("common.confirm")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmSuspendUser}
+        confirmVariant="destructive"
+      />
+
       <ConfirmDialog
         open={!!deleteTarget}
         onOpenChange={(open) => !open && setDeleteTarget(undefined)}
@@ -110,6 +110,66 @@ const MemoRelatedSettings = observer(() => {
             onBlur={(event) => updatePartialSetting({ contentLengthLimit: Number(event.target.value) })}
           />
         </SettingRow>
+
+        <SettingRow label={t("setting.memo-related-settings.trash-retention-days")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={1}
+            defaultValue={memoRelatedSetting.trashRetentionDays}
+            onBlur={(event) => updatePartialSetting({ trashRetentionDays: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.memo-related-settings.bookmark-duplicate-window-minutes")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={1}
+            defaultValue={memoRelatedSetting.bookmarkDuplicateWindowMinutes}
+            onBlur={(event) => updatePartialSetting({ bookmarkDuplicateWindowMinutes: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.memo-related-settings.max-memos-per-user")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={0}
+            defaultValue={memoRelatedSetting.maxMemosPerUser}
+            onBlur={(event) => updatePartialSetting({ maxMemosPerUser: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.memo-related-settings.max-memos-per-day")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={0}
+            defaultValue={memoRelatedSetting.maxMemosPerDay}
+            onBlur={(event) => updatePartialSetting({ maxMemosPerDay: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.memo-related-settings.new-account-max-public-memos-per-day")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={0}
+            defaultValue={memoRelatedSetting.newAccountMaxPublicMemosPerDay}
+            onBlur={(event) => updatePartialSetting({ newAccountMaxPublicMemosPerDay: Number(event.target.value) })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.memo-related-settings.new-account-days")}>
+          <Input
+            className="w-24"
+            type="number"
+            min={1}
+            defaultValue={memoRelatedSetting.newAccountDays}
+            onBlur={(event) => updatePartialSetting({ newAccountDays: Number(event.target.value) })}
+          />
+        </SettingRow>
       </SettingGroup>
 
       <SettingGroup title={t("setting.memo-related-settings.reactions")} showSeparator>
@@ -1,15 +1,27 @@
+import { ConnectError } from "@connectrpc/connect";
 import { MoreVerticalIcon, PenLineIcon } from "lucide-react";
+import { useRef } from "react";
+import { toast } from "react-hot-toast";
 import { Button } from "@/components/ui/button";
+import { memoServiceClient } from "@/grpcweb";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import { useDialog } from "@/hooks/useDialog";
+import { ImportMemosRequest_Format, MemoImport, MemoImport_State } from "@/types/proto/api/v1/memo_service_pb";
+import { User_Role } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 import ChangeMemberPasswordDialog from "../ChangeMemberPasswordDialog";
 import UpdateAccountDialog from "../UpdateAccountDialog";
 import UserAvatar from "../UserAvatar";
 import { DropdownMenu, DropdownMenuContent, DropdownMenuItem, DropdownMenuTrigger } from "../ui/dropdown-menu";
 import AccessTokenSection from "./AccessTokenSection";
+import FeedTokenSection from "./FeedTokenSection";
+import InboundEmailSection from "./InboundEmailSection";
+import MatrixLinkSection from "./MatrixLinkSection";
+import PasskeySection from "./PasskeySection";
 import SettingGroup from "./SettingGroup";
 import SettingSection from "./SettingSection";
+import SlackLinkSection from "./SlackLinkSection";
+import StorageUsageSection from "./StorageUsageSection";
 import UserSessionsSection from "./UserSessionsSection";
 
 const MyAccountSection = () => {
@@ -17,6 +29,9 @@ const MyAccountSection = () => {
   const user = useCurrentUser();
   const accountDialog = useDialog();
   const passwordDialog = useDialog();
+  const importInputRef = useRef<HTMLInputElement>(null);
+  const importFormatRef = useRef<ImportMemosRequest_Format>(ImportMemosRequest_Format.MARKDOWN);
+  const accountImportInputRef = useRef<HTMLInputElement>(null);
 
   const handleEditAccount = () => {
     accountDialog.open();
@@ -26,6 +41,82 @@ const MyAccountSection = () => {
     passwordDialog.open();
   };
 
+  const handleExportMemos = (scope?: string) => {
+    // The export is streamed as a zip file by the file server.
+    window.open(scope ? `/file/memos/export?scope=${scope}` : "/file/memos/export", "_blank");
+  };
+
+  const handleImportMemos = (format: ImportMemosRequest_Format) => {
+    importFormatRef.current = format;
+    importInputRef.current?.click();
+  };
+
+  const handleImportFileChange = async (event: React.ChangeEvent<HTMLInputElement>) => {
+    const file = event.target.files?.[0];
+    event.target.value = "";
+    if (!file) {
+      return;
+    }
+    const toastId = toast.loading(t("setting.account-section.importing-memos"));
+    try {
+      let memoImport: MemoImport = await memoServiceClient.importMemos({
+        content: new Uint8Array(await file.arrayBuffer()),
+        format: importFormatRef.current,
+      });
+      // The import runs in the background, so its progress is polled until it finishes.
+      while (memoImport.state === MemoImport_State.RUNNING) {
+        await new Promise((resolve) => setTimeout(resolve, 1000));
+        memoImport = await memoServiceClient.getMemoImport({ name: memoImport.name });
+      }
+      toast.success(
+        t("setting.account-section.import-memos-finished", {
+          created: memoImport.createdMemos,
+          skipped: memoImport.skippedFiles,
+          failed: memoImport.errors.length,
+        }),
+        { id: toastId },
+      );
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to import memos.", { id: toastId });
+    }
+  };
+
+  const handleExportAccount = () => {
+    window.open("/file/account/export?content=true", "_blank");
+  };
+
+  const handleAccountImportFileChange = async (event: React.ChangeEvent<HTMLInputElement>) => {
+    const file = event.target.files?.[0];
+    event.target.value = "";
+    if (!file) {
+      return;
+    }
+    const toastId = toast.loading(t("setting.account-section.importing-account"));
+    try {
+      const response = await fetch("/file/account/import", {
+        method: "POST",
+        headers: { "Content-Type": "application/x-ndjson" },
+        body: file,
+      });
+      const result = await response.json();
+      if (!response.ok) {
+        throw new Error(result.message);
+      }
+      toast.success(
+        t("setting.account-section.import-memos-finished", {
+          created: result.createdMemos,
+          skipped: result.skippedMemos,
+          failed: result.errors?.length ?? 0,
+        }),
+        { id: toastId },
+      );
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.message || "Failed to import account.", { id: toastId });
+    }
+  };
+
   return (
     <SettingSection>
       <SettingGroup title={t("setting.account-section.title")}>
@@ -51,20 +142,65 @@ const MyAccountSection = () => {
               </DropdownMenuTrigger>
               <DropdownMenuContent align="end">
                 <DropdownMenuItem onClick={handleChangePassword}>{t("setting.account-section.change-password")}</DropdownMenuItem>
+                <DropdownMenuItem onClick={() => handleImportMemos(ImportMemosRequest_Format.MARKDOWN)}>
+                  {t("setting.account-section.import-memos")}
+                </DropdownMenuItem>
+                <DropdownMenuItem onClick={() => handleImportMemos(ImportMemosRequest_Format.GOOGLE_KEEP)}>
+                  {t("setting.account-section.import-google-keep")}
+                </DropdownMenuItem>
+                <DropdownMenuItem onClick={() => handleImportMemos(ImportMemosRequest_Format.DAY_ONE)}>
+                  {t("setting.account-section.import-day-one")}
+                </DropdownMenuItem>
+                <DropdownMenuItem onClick={() => handleExportMemos()}>{t("setting.account-section.export-memos")}</DropdownMenuItem>
+                {user.role === User_Role.HOST && (
+                  <DropdownMenuItem onClick={() => handleExportMemos("instance")}>
+                    {t("setting.account-section.export-instance-memos")}
+                  </DropdownMenuItem>
+                )}
+                <DropdownMenuItem onClick={() => accountImportInputRef.current?.click()}>
+                  {t("setting.account-section.import-account")}
+                </DropdownMenuItem>
+                <DropdownMenuItem onClick={handleExportAccount}>{t("setting.account-section.export-account")}</DropdownMenuItem>
               </DropdownMenuContent>
             </DropdownMenu>
+            <input className="hidden" ref={importInputRef} type="file" accept=".zip" onChange={handleImportFileChange} />
+            <input className="hidden" ref={accountImportInputRef} type="file" accept=".ndjson" onChange={handleAccountImportFileChange} />
           </div>
         </div>
       </SettingGroup>
 
+      <SettingGroup showSeparator>
+        <StorageUsageSection />
+      </SettingGroup>
+
       <SettingGroup showSeparator>
         <UserSessionsSection />
       </SettingGroup>
 
+      <SettingGroup showSeparator>
+        <PasskeySection />
+      </SettingGroup>
+
       <SettingGroup showSeparator>
         <AccessTokenSection />
       </SettingGroup>
 
+      <SettingGroup showSeparator>
+        <FeedTokenSection />
+      </SettingGroup>
+
+      <SettingGroup showSeparator>
+        <InboundEmailSection />
+      </SettingGroup>
+
+      <SettingGroup showSeparator>
+        <SlackLinkSection />
+      </SettingGroup>
+
+      <SettingGroup showSeparator>
+        <MatrixLinkSection />
+      </SettingGroup>
+
       {/* Update Account Dialog */}
       <UpdateAccountDialog open={accountDialog.isOpen} onOpenChange={accountDialog.setOpen} />
 
@@ -0,0 +1,133 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { ConnectError } from "@connectrpc/connect";
+import { KeyRoundIcon, PlusIcon, TrashIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { UserPasskey } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import { createPasskey, isPasskeySupported } from "@/utils/passkey";
+import SettingTable from "./SettingTable";
+
+const PasskeySection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [passkeys, setPasskeys] = useState<UserPasskey[]>([]);
+  const [label, setLabel] = useState("");
+  const [deleteTarget, setDeleteTarget] = useState<UserPasskey | undefined>(undefined);
+
+  const listPasskeys = async () => {
+    const { passkeys } = await userServiceClient.listUserPasskeys({ parent: currentUser.name });
+    setPasskeys(passkeys);
+  };
+
+  useEffect(() => {
+    listPasskeys();
+  }, []);
+
+  const handleAddPasskey = async () => {
+    if (label.trim() === "") {
+      toast.error(t("setting.passkey-section.label-required"));
+      return;
+    }
+    try {
+      const options = await userServiceClient.createUserPasskeyOptions({ parent: currentUser.name });
+      const credential = await createPasskey(options);
+      await userServiceClient.createUserPasskey({ parent: currentUser.name, label: label.trim(), ...credential });
+      setLabel("");
+      await listPasskeys();
+      toast.success(t("setting.passkey-section.passkey-added"));
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to add passkey.");
+    }
+  };
+
+  const confirmDeletePasskey = async () => {
+    if (!deleteTarget) return;
+    await userServiceClient.deleteUserPasskey({ name: deleteTarget.name });
+    setPasskeys(passkeys.filter((passkey) => passkey.name !== deleteTarget.name));
+    setDeleteTarget(undefined);
+  };
+
+  return (
+    <div className="w-full flex flex-col gap-2">
+      <div className="flex flex-col gap-1">
+        <h4 className="text-sm font-medium text-muted-foreground">{t("setting.passkey-section.title")}</h4>
+        <p className="text-xs text-muted-foreground">{t("setting.passkey-section.description")}</p>
+      </div>
+
+      {isPasskeySupported() && (
+        <div className="flex flex-row items-center gap-2">
+          <Input
+            className="max-w-64"
+            placeholder={t("setting.passkey-section.label-placeholder")}
+            value={label}
+            onChange={(e) => setLabel(e.target.value)}
+          />
+          <Button variant="outline" onClick={handleAddPasskey}>
+            <PlusIcon className="w-4 h-4 mr-1.5" />
+            {t("setting.passkey-section.add-passkey")}
+          </Button>
+        </div>
+      )}
+
+      <SettingTable
+        columns={[
+          {
+            key: "label",
+            header: t("setting.passkey-section.label"),
+            render: (_, passkey: UserPasskey) => (
+              <div className="flex items-center space-x-2">
+                <KeyRoundIcon className="w-4 h-4 text-muted-foreground" />
+                <span className="font-medium text-foreground">{passkey.label}</span>
+              </div>
+            ),
+          },
+          {
+            key: "createTime",
+            header: t("setting.passkey-section.created-at"),
+            render: (_, passkey: UserPasskey) => (passkey.createTime ? timestampDate(passkey.createTime) : undefined)?.toLocaleString(),
+          },
+          {
+            key: "lastUsedTime",
+            header: t("setting.passkey-section.last-used"),
+            render: (_, passkey: UserPasskey) =>
+              (passkey.lastUsedTime ? timestampDate(passkey.lastUsedTime) : undefined)?.toLocaleString() ??
+              t("setting.passkey-section.never-used"),
+          },
+          {
+            key: "actions",
+            header: "",
+            className: "text-right",
+            render: (_, passkey: UserPasskey) => (
+              <Button variant="ghost" size="sm" onClick={() => setDeleteTarget(passkey)}>
+                <TrashIcon className="w-4 h-auto text-destructive" />
+              </Button>
+            ),
+          },
+        ]}
+        data={passkeys}
+        emptyMessage={t("setting.passkey-section.no-passkeys")}
+        getRowKey={(passkey) => passkey.name}
+      />
+
+      <ConfirmDialog
+        open={!!deleteTarget}
+        onOpenChange={(open) => !open && setDeleteTarget(undefined)}
+        title={deleteTarget ? t("setting.passkey-section.passkey-deletion", { label: deleteTarget.label }) : ""}
+        description={t("setting.passkey-section.passkey-deletion-description")}
+        confirmLabel={t("common.delete")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmDeletePasskey}
+        confirmVariant="destructive"
+      />
+    </div>
+  );
+};
+
+export default PasskeySection;
@@ -0,0 +1,99 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { ConnectError } from "@connectrpc/connect";
+import copy from "copy-to-clipboard";
+import { KeyRoundIcon, LinkIcon, TrashIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import { Button } from "@/components/ui/button";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { UserSlackLink } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const SlackLinkSection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [slackLink, setSlackLink] = useState<UserSlackLink | undefined>(undefined);
+  const [unlinkDialogOpen, setUnlinkDialogOpen] = useState(false);
+  const name = `${currentUser.name}/slackLink`;
+  const linked = Boolean(slackLink?.slackUserId);
+
+  useEffect(() => {
+    userServiceClient.getUserSlackLink({ name }).then(setSlackLink);
+  }, [name]);
+
+  const handleGenerateCode = async () => {
+    try {
+      const generated = await userServiceClient.generateUserSlackLinkCode({ name });
+      setSlackLink(generated);
+      copy(generated.linkCode);
+      toast.success(t("setting.slack-link-section.code-copied-to-clipboard"));
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to generate link code.");
+    }
+  };
+
+  const confirmUnlink = async () => {
+    await userServiceClient.deleteUserSlackLink({ name });
+    setSlackLink(await userServiceClient.getUserSlackLink({ name }));
+    setUnlinkDialogOpen(false);
+    toast.success(t("setting.slack-link-section.unlinked"));
+  };
+
+  if (slackLink && !slackLink.available) {
+    return null;
+  }
+
+  return (
+    <div className="w-full flex flex-col gap-2">
+      <div className="flex flex-col gap-1">
+        <h4 className="text-sm font-medium text-muted-foreground">{t("setting.slack-link-section.title")}</h4>
+        <p className="text-xs text-muted-foreground">{t("setting.slack-link-section.description")}</p>
+      </div>
+
+      <div className="flex flex-row items-center gap-2">
+        <LinkIcon className="w-4 h-4 text-muted-foreground" />
+        <span className="text-sm text-foreground">
+          {linked && slackLink?.linkTime
+            ? t("setting.slack-link-section.linked-at", { time: timestampDate(slackLink.linkTime).toLocaleString() })
+            : t("setting.slack-link-section.not-linked")}
+        </span>
+        <div className="ml-auto flex flex-row items-center gap-2">
+          <Button variant="outline" size="sm" onClick={handleGenerateCode}>
+            <KeyRoundIcon className="w-4 h-4 mr-1.5" />
+            {linked ? t("setting.slack-link-section.relink") : t("setting.slack-link-section.generate-code")}
+          </Button>
+          {linked && (
+            <Button variant="ghost" size="sm" onClick={() => setUnlinkDialogOpen(true)}>
+              <TrashIcon className="w-4 h-auto text-destructive" />
+            </Button>
+          )}
+        </div>
+      </div>
+
+      {slackLink?.linkCode && slackLink.linkCodeExpireTime && (
+        <div className="flex flex-col gap-1 rounded-lg border border-border px-3 py-2">
+          <span className="text-lg font-mono tracking-widest text-foreground">{slackLink.linkCode}</span>
+          <span className="text-xs text-muted-foreground">
+            {t("setting.slack-link-section.code-hint", { time: timestampDate(slackLink.linkCodeExpireTime).toLocaleTimeString() })}
+          </span>
+        </div>
+      )}
+
+      <ConfirmDialog
+        open={unlinkDialogOpen}
+        onOpenChange={setUnlinkDialogOpen}
+        title={t("setting.slack-link-section.unlink-confirm")}
+        description={t("setting.slack-link-section.unlink-confirm-description")}
+        confirmLabel={t("setting.slack-link-section.unlink")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmUnlink}
+        confirmVariant="destructive"
+      />
+    </div>
+  );
+};
+
+export default SlackLinkSection;
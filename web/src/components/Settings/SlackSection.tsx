@@ -0,0 +1,121 @@
+import { create } from "@bufbuild/protobuf";
+import copy from "copy-to-clipboard";
+import { isEqual } from "lodash-es";
+import { observer } from "mobx-react-lite";
+import { useEffect, useMemo, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { instanceStore } from "@/store";
+import { buildInstanceSettingName } from "@/store/common";
+import {
+  InstanceSetting_Key,
+  InstanceSetting_SlackSetting,
+  InstanceSetting_SlackSettingSchema,
+  InstanceSettingSchema,
+} from "@/types/proto/api/v1/instance_service_pb";
+import { useTranslate } from "@/utils/i18n";
+import SettingGroup from "./SettingGroup";
+import SettingRow from "./SettingRow";
+import SettingSection from "./SettingSection";
+
+// Helper to extract Slack setting value from InstanceSetting oneof
+function getSlackSetting(setting: any): InstanceSetting_SlackSetting | undefined {
+  if (setting?.value?.case === "slackSetting") {
+    return setting.value.value;
+  }
+  return undefined;
+}
+
+// The request URLs to set in the Slack app, relative to the instance URL.
+const REQUEST_URLS = [
+  { key: "slash-command-url", path: "/slack/commands" },
+  { key: "interactivity-url", path: "/slack/interactions" },
+  { key: "event-subscriptions-url", path: "/slack/events" },
+] as const;
+
+const SlackSection = observer(() => {
+  const t = useTranslate();
+  const originalSetting = create(
+    InstanceSetting_SlackSettingSchema,
+    getSlackSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.SLACK)) || {},
+  );
+  const [slackSetting, setSlackSetting] = useState<InstanceSetting_SlackSetting>(originalSetting);
+  const baseURL = instanceStore.state.profile.instanceUrl || window.location.origin;
+
+  useEffect(() => {
+    instanceStore.fetchInstanceSetting(InstanceSetting_Key.SLACK);
+  }, []);
+
+  useEffect(() => {
+    setSlackSetting(
+      create(InstanceSetting_SlackSettingSchema, getSlackSetting(instanceStore.getInstanceSettingByKey(InstanceSetting_Key.SLACK)) || {}),
+    );
+  }, [instanceStore.getInstanceSettingByKey(InstanceSetting_Key.SLACK)]);
+
+  const allowSave = useMemo(() => !isEqual(originalSetting, slackSetting), [slackSetting, instanceStore.state]);
+
+  const updatePartialSetting = (partial: Partial<InstanceSetting_SlackSetting>) => {
+    setSlackSetting(create(InstanceSetting_SlackSettingSchema, { ...slackSetting, ...partial }));
+  };
+
+  const saveSlackSetting = async () => {
+    await instanceStore.upsertInstanceSetting(
+      create(InstanceSettingSchema, {
+        name: buildInstanceSettingName(InstanceSetting_Key.SLACK),
+        value: {
+          case: "slackSetting",
+          value: slackSetting,
+        },
+      }),
+    );
+    toast.success(t("message.update-succeed"));
+  };
+
+  const handleCopyURL = (url: string) => {
+    copy(url);
+    toast.success(t("message.copied"));
+  };
+
+  return (
+    <SettingSection>
+      <SettingGroup title={t("setting.slack-section.app")} description={t("setting.slack-section.description")}>
+        <SettingRow label={t("setting.slack-section.signing-secret")} tooltip={t("setting.slack-section.signing-secret-hint")}>
+          <Input
+            className="w-64"
+            type="password"
+            autoComplete="off"
+            value={slackSetting.signingSecret}
+            onChange={(e) => updatePartialSetting({ signingSecret: e.target.value })}
+          />
+        </SettingRow>
+        <SettingRow label={t("setting.slack-section.bot-token")} tooltip={t("setting.slack-section.bot-token-hint")}>
+          <Input
+            className="w-64"
+            type="password"
+            autoComplete="off"
+            value={slackSetting.botToken}
+            placeholder="xoxb-..."
+            onChange={(e) => updatePartialSetting({ botToken: e.target.value })}
+          />
+        </SettingRow>
+      </SettingGroup>
+      <SettingGroup title={t("setting.slack-section.request-urls")} description={t("setting.slack-section.request-urls-description")} showSeparator>
+        {REQUEST_URLS.map(({ key, path }) => (
+          <SettingRow key={key} label={t(`setting.slack-section.${key}`)}>
+            <button className="text-sm font-mono text-foreground truncate cursor-pointer" onClick={() => handleCopyURL(baseURL + path)}>
+              {baseURL + path}
+            </button>
+          </SettingRow>
+        ))}
+      </SettingGroup>
+      <div className="w-full flex justify-end">
+        <Button disabled={!allowSave} onClick={saveSlackSetting}>
+          {t("common.save")}
+        </Button>
+      </div>
+    </SettingSection>
+  );
+});
+
+export default SlackSection;
@@ -13,6 +13,8 @@ import { buildInstanceSettingName } from "@/store/common";
 import {
   InstanceSetting_Key,
   InstanceSetting_StorageSetting,
+  InstanceSetting_StorageSetting_ImageCompressionConfig,
+  InstanceSetting_StorageSetting_ImageCompressionConfigSchema,
   InstanceSetting_StorageSetting_S3Config,
   InstanceSetting_StorageSetting_S3ConfigSchema,
   InstanceSetting_StorageSetting_StorageType,
@@ -51,7 +53,7 @@ const StorageSection = observer(() => {
   }, [instanceStore.getInstanceSettingByKey(InstanceSetting_Key.STORAGE)]);
 
   const allowSaveStorageSetting = useMemo(() => {
-    if (instanceStorageSetting.uploadSizeLimitMb <= 0) {
+    if (instanceStorageSetting.uploadSizeLimitMb <= 0 || instanceStorageSetting.orphanedAttachmentGraceDays <= 0) {
       return false;
     }
 
@@ -89,6 +91,62 @@ const StorageSection = observer(() => {
     setInstanceStorageSetting(update);
   };
 
+  const handleOrphanedAttachmentGraceDaysChanged = async (event: React.FocusEvent<HTMLInputElement>) => {
+    let num = parseInt(event.target.value);
+    if (Number.isNaN(num)) {
+      num = 0;
+    }
+    const update = create(InstanceSetting_StorageSettingSchema, {
+      ...instanceStorageSetting,
+      orphanedAttachmentGraceDays: num,
+    });
+    setInstanceStorageSetting(update);
+  };
+
+  const handleDefaultUserQuotaChanged = async (event: React.FocusEvent<HTMLInputElement>) => {
+    let num = parseInt(event.target.value);
+    if (Number.isNaN(num) || num < 0) {
+      num = 0;
+    }
+    const update = create(InstanceSetting_StorageSettingSchema, {
+      ...instanceStorageSetting,
+      defaultUserQuotaMb: BigInt(num),
+    });
+    setInstanceStorageSetting(update);
+  };
+
+  const handleStripImageMetadataChanged = (checked: boolean) => {
+    const update = create(InstanceSetting_StorageSettingSchema, {
+      ...instanceStorageSetting,
+      stripImageMetadata: checked,
+    });
+    setInstanceStorageSetting(update);
+  };
+
+  const handleCheckExternalLinksChanged = (checked: boolean) => {
+    const update = create(InstanceSetting_StorageSettingSchema, {
+      ...instanceStorageSetting,
+      checkExternalLinks: checked,
+    });
+    setInstanceStorageSetting(update);
+  };
+
+  const handlePartialImageCompressionChanged = (imageCompression: Partial<InstanceSetting_StorageSetting_ImageCompressionConfig>) => {
+    const update = create(InstanceSetting_StorageSettingSchema, {
+      ...instanceStorageSetting,
+      imageCompression: create(InstanceSetting_StorageSetting_ImageCompressionConfigSchema, {
+        ...instanceStorageSetting.imageCompression,
+        ...imageCompression,
+      }),
+    });
+    setInstanceStorageSetting(update);
+  };
+
+  const parseNonNegativeInt = (value: string): number => {
+    const num = parseInt(value);
+    return Number.isNaN(num) || num < 0 ? 0 : num;
+  };
+
   const handleFilepathTemplateChanged = async (event: React.FocusEvent<HTMLInputElement>) => {
     const update = create(InstanceSetting_StorageSettingSchema, {
       ...instanceStorageSetting,
@@ -112,6 +170,11 @@ const StorageSection = observer(() => {
       storageType: instanceStorageSetting.storageType,
       filepathTemplate: instanceStorageSetting.filepathTemplate,
       uploadSizeLimitMb: instanceStorageSetting.uploadSizeLimitMb,
+      orphanedAttachmentGraceDays: instanceStorageSetting.orphanedAttachmentGraceDays,
+      stripImageMetadata: instanceStorageSetting.stripImageMetadata,
+      defaultUserQuotaMb: instanceStorageSetting.defaultUserQuotaMb,
+      imageCompression: instanceStorageSetting.imageCompression,
+      checkExternalLinks: instanceStorageSetting.checkExternalLinks,
       s3Config: create(InstanceSetting_StorageSetting_S3ConfigSchema, s3ConfigInit),
     });
     setInstanceStorageSetting(update);
@@ -198,6 +261,89 @@ const StorageSection = observer(() => {
           />
         </SettingRow>
 
+        <SettingRow
+          label={t("setting.storage-section.orphaned-attachment-grace-days")}
+          tooltip={t("setting.storage-section.orphaned-attachment-grace-days-hint")}
+        >
+          <Input
+            className="w-24 font-mono"
+            value={String(instanceStorageSetting.orphanedAttachmentGraceDays)}
+            onChange={handleOrphanedAttachmentGraceDaysChanged}
+          />
+        </SettingRow>
+
+        <SettingRow
+          label={t("setting.storage-section.default-user-quota")}
+          tooltip={t("setting.storage-section.default-user-quota-hint")}
+        >
+          <Input
+            className="w-24 font-mono"
+            value={String(instanceStorageSetting.defaultUserQuotaMb)}
+            onChange={handleDefaultUserQuotaChanged}
+          />
+        </SettingRow>
+
+        <SettingRow
+          label={t("setting.storage-section.strip-image-metadata")}
+          tooltip={t("setting.storage-section.strip-image-metadata-hint")}
+        >
+          <Switch checked={instanceStorageSetting.stripImageMetadata} onCheckedChange={handleStripImageMetadataChanged} />
+        </SettingRow>
+
+        <SettingRow
+          label={t("setting.storage-section.check-external-links")}
+          tooltip={t("setting.storage-section.check-external-links-hint")}
+        >
+          <Switch checked={instanceStorageSetting.checkExternalLinks} onCheckedChange={handleCheckExternalLinksChanged} />
+        </SettingRow>
+
+        <SettingRow
+          label={t("setting.storage-section.compress-images")}
+          tooltip={t("setting.storage-section.compress-images-hint")}
+        >
+          <Switch
+            checked={instanceStorageSetting.imageCompression?.enabled ?? false}
+            onCheckedChange={(checked) => handlePartialImageCompressionChanged({ enabled: checked })}
+          />
+        </SettingRow>
+
+        {instanceStorageSetting.imageCompression?.enabled && (
+          <>
+            <SettingRow
+              label={t("setting.storage-section.compression-quality")}
+              tooltip={t("setting.storage-section.compression-quality-hint")}
+            >
+              <Input
+                className="w-24 font-mono"
+                value={String(instanceStorageSetting.imageCompression.quality)}
+                onChange={(event) => handlePartialImageCompressionChanged({ quality: Math.min(parseNonNegativeInt(event.target.value), 100) })}
+              />
+            </SettingRow>
+
+            <SettingRow
+              label={t("setting.storage-section.compression-max-dimension")}
+              tooltip={t("setting.storage-section.compression-max-dimension-hint")}
+            >
+              <Input
+                className="w-24 font-mono"
+                value={String(instanceStorageSetting.imageCompression.maxDimension)}
+                onChange={(event) => handlePartialImageCompressionChanged({ maxDimension: parseNonNegativeInt(event.target.value) })}
+              />
+            </SettingRow>
+
+            <SettingRow
+              label={t("setting.storage-section.compression-threshold")}
+              tooltip={t("setting.storage-section.compression-threshold-hint")}
+            >
+              <Input
+                className="w-24 font-mono"
+                value={String(instanceStorageSetting.imageCompression.thresholdKb)}
+                onChange={(event) => handlePartialImageCompressionChanged({ thresholdKb: BigInt(parseNonNegativeInt(event.target.value)) })}
+              />
+            </SettingRow>
+          </>
+        )}
+
         {instanceStorageSetting.storageType !== InstanceSetting_StorageSetting_StorageType.DATABASE && (
           <SettingRow label={t("setting.storage-section.filepath-template")}>
             <Input
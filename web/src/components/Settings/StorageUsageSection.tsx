@@ -0,0 +1,59 @@
+import { useEffect, useState } from "react";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { buildUserSettingName } from "@/store/common";
+import { UserSetting_Key, UserSetting_StorageSetting } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const formatBytes = (bytes: bigint): string => {
+  const units = ["B", "KB", "MB", "GB", "TB"];
+  let value = Number(bytes);
+  let unit = 0;
+  while (value >= 1024 && unit < units.length - 1) {
+    value /= 1024;
+    unit++;
+  }
+  return `${unit === 0 ? value : value.toFixed(1)} ${units[unit]}`;
+};
+
+const StorageUsageSection = () => {
+  const t = useTranslate();
+  const currentUser = useCurrentUser();
+  const [storageSetting, setStorageSetting] = useState<UserSetting_StorageSetting | undefined>(undefined);
+
+  useEffect(() => {
+    userServiceClient.getUserSetting({ name: buildUserSettingName(currentUser.name, UserSetting_Key.STORAGE) }).then((setting) => {
+      setStorageSetting(setting.value.case === "storageSetting" ? setting.value.value : undefined);
+    });
+  }, [currentUser.name]);
+
+  if (!storageSetting) {
+    return null;
+  }
+
+  const hasQuota = storageSetting.quotaBytes > 0n;
+  const percent = hasQuota ? Math.min(100, (Number(storageSetting.usedBytes) / Number(storageSetting.quotaBytes)) * 100) : 0;
+
+  return (
+    <div className="w-full flex flex-col gap-2">
+      <div className="flex flex-row justify-between items-center">
+        <h4 className="text-sm font-medium">{t("setting.account-section.storage-usage")}</h4>
+        <span className="text-sm text-muted-foreground">
+          {hasQuota
+            ? t("setting.account-section.storage-usage-of", {
+                used: formatBytes(storageSetting.usedBytes),
+                quota: formatBytes(storageSetting.quotaBytes),
+              })
+            : formatBytes(storageSetting.usedBytes)}
+        </span>
+      </div>
+      {hasQuota && (
+        <div className="w-full h-2 rounded-full bg-muted overflow-hidden">
+          <div className={`h-full ${percent >= 90 ? "bg-destructive" : "bg-primary"}`} style={{ width: `${percent}%` }} />
+        </div>
+      )}
+    </div>
+  );
+};
+
+export default StorageUsageSection;
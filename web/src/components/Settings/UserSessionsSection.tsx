@@ -69,12 +69,7 @@ const UserSessionsSection = () => {
     return parts.length > 0 ? parts.join(" • ") : "Unknown Device";
   };
 
-  const isCurrentSession = (session: UserSession) => {
-    // A simple heuristic: the most recently accessed session is likely the current one
-    if (userSessions.length === 0) return false;
-    const mostRecent = userSessions[0];
-    return session.sessionId === mostRecent.sessionId;
-  };
+  const isCurrentSession = (session: UserSession) => session.current;
 
   return (
     <div className="w-full flex flex-col gap-2">
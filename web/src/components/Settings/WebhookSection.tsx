@@ -1,27 +1,42 @@
-import { ExternalLinkIcon, PlusIcon, TrashIcon } from "lucide-react";
+import { create } from "@bufbuild/protobuf";
+import { FieldMaskSchema } from "@bufbuild/protobuf/wkt";
+import copy from "copy-to-clipboard";
+import { ExternalLinkIcon, HistoryIcon, KeyRoundIcon, PlusIcon, SendIcon, TrashIcon } from "lucide-react";
 import { useEffect, useState } from "react";
 import toast from "react-hot-toast";
 import { Link } from "react-router-dom";
 import ConfirmDialog from "@/components/ConfirmDialog";
 import { Button } from "@/components/ui/button";
+import { Switch } from "@/components/ui/switch";
 import { userServiceClient } from "@/grpcweb";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import { UserWebhook } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 import CreateWebhookDialog from "../CreateWebhookDialog";
+import WebhookDeliveriesDialog from "../WebhookDeliveriesDialog";
 import SettingTable from "./SettingTable";
 
-const WebhookSection = () => {
+interface Props {
+  // The parent of the webhooks, the current user by default. "instance" lists the workspace webhooks,
+  // which receive the events of every user and are only managed by the host.
+  parent?: string;
+}
+
+const WebhookSection = ({ parent }: Props) => {
   const t = useTranslate();
   const currentUser = useCurrentUser();
+  const webhookParent = parent ?? currentUser?.name;
+  const isWorkspace = webhookParent === "instance";
   const [webhooks, setWebhooks] = useState<UserWebhook[]>([]);
   const [isCreateWebhookDialogOpen, setIsCreateWebhookDialogOpen] = useState(false);
   const [deleteTarget, setDeleteTarget] = useState<UserWebhook | undefined>(undefined);
+  const [deliveriesTarget, setDeliveriesTarget] = useState<UserWebhook | undefined>(undefined);
+  const [rotateSecretTarget, setRotateSecretTarget] = useState<UserWebhook | undefined>(undefined);
 
   const listWebhooks = async () => {
-    if (!currentUser) return [];
+    if (!webhookParent) return [];
     const { webhooks } = await userServiceClient.listUserWebhooks({
-      parent: currentUser.name,
+      parent: webhookParent,
     });
     return webhooks;
   };
@@ -30,7 +45,7 @@ const WebhookSection = () => {
     listWebhooks().then((webhooks) => {
       setWebhooks(webhooks);
     });
-  }, [currentUser]);
+  }, [webhookParent]);
 
   const handleCreateWebhookDialogConfirm = async () => {
     const webhooks = await listWebhooks();
@@ -40,6 +55,37 @@ const WebhookSection = () => {
     toast.success(t("setting.webhook-section.create-dialog.create-webhook-success", { name }));
   };
 
+  const handleToggleWebhook = async (webhook: UserWebhook, enabled: boolean) => {
+    const updated = await userServiceClient.updateUserWebhook({
+      webhook: { name: webhook.name, disabled: !enabled },
+      updateMask: create(FieldMaskSchema, { paths: ["disabled"] }),
+    });
+    setWebhooks(webhooks.map((item) => (item.name === updated.name ? updated : item)));
+  };
+
+  const handleTestWebhook = async (webhook: UserWebhook) => {
+    try {
+      const response = await userServiceClient.testUserWebhook({ name: webhook.name });
+      if (response.error) {
+        toast.error(t("setting.webhook-section.test-failed", { error: response.error }));
+      } else {
+        toast.success(t("setting.webhook-section.test-succeeded", { status: response.statusCode }));
+      }
+    } catch (error: unknown) {
+      console.error(error);
+      toast.error((error as { message?: string }).message || "Failed to test webhook");
+    }
+  };
+
+  const confirmRotateSecret = async () => {
+    if (!rotateSecretTarget) return;
+    const updated = await userServiceClient.rotateUserWebhookSecret({ name: rotateSecretTarget.name });
+    setWebhooks(webhooks.map((item) => (item.name === updated.name ? updated : item)));
+    setRotateSecretTarget(undefined);
+    copy(updated.secret);
+    toast.success(t("setting.webhook-section.secret-copied-to-clipboard"));
+  };
+
   const handleDeleteWebhook = async (webhook: UserWebhook) => {
     setDeleteTarget(webhook);
   };
@@ -55,7 +101,12 @@ const WebhookSection = () => {
   return (
     <div className="w-full flex flex-col gap-2">
       <div className="flex flex-col sm:flex-row sm:items-start sm:justify-between gap-2">
-        <h4 className="text-sm font-medium text-muted-foreground">{t("setting.webhook-section.title")}</h4>
+        <div className="flex flex-col gap-1">
+          <h4 className="text-sm font-medium text-muted-foreground">
+            {isWorkspace ? t("setting.webhook-section.workspace-title") : t("setting.webhook-section.title")}
+          </h4>
+          {isWorkspace && <p className="text-xs text-muted-foreground">{t("setting.webhook-section.workspace-description")}</p>}
+        </div>
         <Button onClick={() => setIsCreateWebhookDialogOpen(true)} size="sm">
           <PlusIcon className="w-4 h-4 mr-1.5" />
           {t("common.create")}
@@ -78,14 +129,32 @@ const WebhookSection = () => {
               </span>
             ),
           },
+          {
+            key: "disabled",
+            header: t("setting.webhook-section.enabled"),
+            render: (_, webhook: UserWebhook) => (
+              <Switch checked={!webhook.disabled} onCheckedChange={(checked) => handleToggleWebhook(webhook, checked)} />
+            ),
+          },
           {
             key: "actions",
             header: "",
             className: "text-right",
             render: (_, webhook: UserWebhook) => (
-              <Button variant="ghost" size="sm" onClick={() => handleDeleteWebhook(webhook)}>
-                <TrashIcon className="text-destructive w-4 h-auto" />
-              </Button>
+              <>
+                <Button variant="ghost" size="sm" title={t("setting.webhook-section.test")} onClick={() => handleTestWebhook(webhook)}>
+                  <SendIcon className="w-4 h-auto" />
+                </Button>
+                <Button variant="ghost" size="sm" title={t("setting.webhook-section.deliveries")} onClick={() => setDeliveriesTarget(webhook)}>
+                  <HistoryIcon className="w-4 h-auto" />
+                </Button>
+                <Button variant="ghost" size="sm" title={t("setting.webhook-section.rotate-secret")} onClick={() => setRotateSecretTarget(webhook)}>
+                  <KeyRoundIcon className="w-4 h-auto" />
+                </Button>
+                <Button variant="ghost" size="sm" onClick={() => handleDeleteWebhook(webhook)}>
+                  <TrashIcon className="text-destructive w-4 h-auto" />
+                </Button>
+              </>
             ),
           },
         ]}
@@ -106,10 +175,29 @@ const WebhookSection = () => {
       </div>
 
       <CreateWebhookDialog
+        parent={webhookParent}
         open={isCreateWebhookDialogOpen}
         onOpenChange={setIsCreateWebhookDialogOpen}
         onSuccess={handleCreateWebhookDialogConfirm}
       />
+      <WebhookDeliveriesDialog
+        open={!!deliveriesTarget}
+        onOpenChange={(open) => !open && setDeliveriesTarget(undefined)}
+        webhook={deliveriesTarget}
+      />
+      <ConfirmDialog
+        open={!!rotateSecretTarget}
+        onOpenChange={(open) => !open && setRotateSecretTarget(undefined)}
+        title={t("setting.webhook-section.rotate-secret-dialog.title", { name: rotateSecretTarget?.displayName || "" })}
+        description={
+          rotateSecretTarget?.hasSecret
+            ? t("setting.webhook-section.rotate-secret-dialog.description")
+            : t("setting.webhook-section.rotate-secret-dialog.unsigned-description")
+        }
+        confirmLabel={t("setting.webhook-section.rotate-secret")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={confirmRotateSecret}
+      />
       <ConfirmDialog
         open={!!deleteTarget}
         onOpenChange={(open) => !open && setDeleteTarget(undefined)}
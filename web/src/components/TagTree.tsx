@@ -3,6 +3,7 @@ import { observer } from "mobx-react-lite";
 import { useEffect, useState } from "react";
 import useToggle from "react-use/lib/useToggle";
 import memoFilterStore, { MemoFilter } from "@/store/memoFilter";
+import { TagTreeNode } from "@/types/proto/api/v1/memo_service_pb";
 
 interface Tag {
   key: string;
@@ -13,13 +14,26 @@ interface Tag {
 
 interface Props {
   tagAmounts: [tag: string, amount: number][];
+  // tagNodes is the tag tree listed by the server, whose amounts include the memos of subtags.
+  tagNodes?: TagTreeNode[];
   expandSubTags: boolean;
 }
 
-const TagTree = ({ tagAmounts: rawTagAmounts, expandSubTags }: Props) => {
+const convertTagTreeNode = (node: TagTreeNode): Tag => ({
+  key: node.name,
+  text: node.tag,
+  amount: node.memoCount,
+  subTags: node.children.map(convertTagTreeNode),
+});
+
+const TagTree = ({ tagAmounts: rawTagAmounts, tagNodes, expandSubTags }: Props) => {
   const [tags, setTags] = useState<Tag[]>([]);
 
   useEffect(() => {
+    if (tagNodes) {
+      setTags(tagNodes.map(convertTagTreeNode));
+      return;
+    }
     const sortedTagAmounts = Array.from(rawTagAmounts).sort();
     const root: Tag = {
       key: "",
@@ -70,7 +84,7 @@ const TagTree = ({ tagAmounts: rawTagAmounts, expandSubTags }: Props) => {
     }
 
     setTags(root.subTags as Tag[]);
-  }, [rawTagAmounts]);
+  }, [rawTagAmounts, tagNodes]);
 
   return (
     <div className="flex flex-col justify-start items-start relative w-full h-auto flex-nowrap gap-2 mt-1">
@@ -1,4 +1,4 @@
-import { ArchiveIcon, CheckIcon, GlobeIcon, LogOutIcon, PaletteIcon, SettingsIcon, SquareUserIcon, User2Icon } from "lucide-react";
+import { ArchiveIcon, CheckIcon, GlobeIcon, LogOutIcon, PaletteIcon, SettingsIcon, SquareUserIcon, Trash2Icon, User2Icon } from "lucide-react";
 import { observer } from "mobx-react-lite";
 import { authServiceClient } from "@/grpcweb";
 import useCurrentUser from "@/hooks/useCurrentUser";
@@ -92,6 +92,10 @@ const UserMenu = observer((props: Props) => {
           <ArchiveIcon className="size-4 text-muted-foreground" />
           {t("common.archived")}
         </DropdownMenuItem>
+        <DropdownMenuItem onClick={() => navigateTo(Routes.TRASH)}>
+          <Trash2Icon className="size-4 text-muted-foreground" />
+          {t("common.trash")}
+        </DropdownMenuItem>
         <DropdownMenuSub>
           <DropdownMenuSubTrigger>
             <GlobeIcon className="size-4 text-muted-foreground" />
@@ -0,0 +1,97 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { RotateCcwIcon } from "lucide-react";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { Button } from "@/components/ui/button";
+import { Dialog, DialogContent, DialogHeader, DialogTitle } from "@/components/ui/dialog";
+import { userServiceClient } from "@/grpcweb";
+import { UserWebhook, UserWebhookDelivery, UserWebhookDelivery_State } from "@/types/proto/api/v1/user_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+interface Props {
+  open: boolean;
+  onOpenChange: (open: boolean) => void;
+  webhook?: UserWebhook;
+}
+
+function WebhookDeliveriesDialog({ open, onOpenChange, webhook }: Props) {
+  const t = useTranslate();
+  const [deliveries, setDeliveries] = useState<UserWebhookDelivery[]>([]);
+
+  const listDeliveries = async () => {
+    if (!webhook) return;
+    const { deliveries } = await userServiceClient.listUserWebhookDeliveries({ parent: webhook.name });
+    setDeliveries(deliveries);
+  };
+
+  useEffect(() => {
+    if (open) {
+      listDeliveries();
+    }
+  }, [open, webhook]);
+
+  const handleRedeliver = async (delivery: UserWebhookDelivery) => {
+    try {
+      await userServiceClient.redeliverUserWebhookDelivery({ name: delivery.name });
+      await listDeliveries();
+    } catch (error: unknown) {
+      console.error(error);
+      toast.error((error as { message?: string }).message || "Failed to redeliver");
+    }
+  };
+
+  const stateLabel = (state: UserWebhookDelivery_State) => {
+    switch (state) {
+      case UserWebhookDelivery_State.SUCCEEDED:
+        return t("setting.webhook-section.deliveries-dialog.succeeded");
+      case UserWebhookDelivery_State.FAILED:
+        return t("setting.webhook-section.deliveries-dialog.failed");
+      default:
+        return t("setting.webhook-section.deliveries-dialog.pending");
+    }
+  };
+
+  return (
+    <Dialog open={open} onOpenChange={onOpenChange}>
+      <DialogContent className="max-w-2xl">
+        <DialogHeader>
+          <DialogTitle>{t("setting.webhook-section.deliveries-dialog.title", { name: webhook?.displayName || "" })}</DialogTitle>
+        </DialogHeader>
+        {deliveries.length === 0 ? (
+          <p className="text-sm text-muted-foreground">{t("setting.webhook-section.deliveries-dialog.no-deliveries-found")}</p>
+        ) : (
+          <div className="max-h-[60vh] overflow-y-auto flex flex-col divide-y divide-border">
+            {deliveries.map((delivery) => (
+              <div key={delivery.name} className="py-2 flex flex-row items-center justify-between gap-2 text-sm">
+                <div className="flex flex-col min-w-0">
+                  <span className="text-foreground">
+                    {delivery.activityType} · {stateLabel(delivery.state)}
+                    {delivery.responseStatus > 0 && ` · ${delivery.responseStatus}`}
+                  </span>
+                  <span className="text-xs text-muted-foreground truncate" title={delivery.error}>
+                    {delivery.createTime && timestampDate(delivery.createTime).toLocaleString()}
+                    {` · ${t("setting.webhook-section.deliveries-dialog.attempts", { count: delivery.attempts })}`}
+                    {delivery.error && ` · ${delivery.error}`}
+                  </span>
+                </div>
+                {delivery.state !== UserWebhookDelivery_State.PENDING && (
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    disabled={webhook?.disabled}
+                    title={t("setting.webhook-section.deliveries-dialog.redeliver")}
+                    onClick={() => handleRedeliver(delivery)}
+                  >
+                    <RotateCcwIcon className="w-4 h-auto" />
+                  </Button>
+                )}
+              </div>
+            ))}
+          </div>
+        )}
+      </DialogContent>
+    </Dialog>
+  );
+}
+
+export default WebhookDeliveriesDialog;
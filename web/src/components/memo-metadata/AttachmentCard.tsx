@@ -64,7 +64,7 @@ const AttachmentCard = ({ item, mode, onRemove, onClick, className, showThumbnai
           onError={(e) => {
             const target = e.target as HTMLImageElement;
             // Fallback to source URL if thumbnail fails
-            if (target.src.includes("?thumbnail=true")) {
+            if (target.src.includes("?thumbnail=medium")) {
               target.src = sourceUrl;
             }
           }}
@@ -1,4 +1,4 @@
-import { createClient } from "@connectrpc/connect";
+import { Code, ConnectError, createClient, Interceptor } from "@connectrpc/connect";
 import { createConnectTransport } from "@connectrpc/connect-web";
 import { ActivityService } from "./types/proto/api/v1/activity_service_pb";
 import { AttachmentService } from "./types/proto/api/v1/attachment_service_pb";
@@ -9,10 +9,41 @@ import { MemoService } from "./types/proto/api/v1/memo_service_pb";
 import { ShortcutService } from "./types/proto/api/v1/shortcut_service_pb";
 import { UserService } from "./types/proto/api/v1/user_service_pb";
 
+// Shared by concurrent requests, so the refresh token is only rotated once.
+let refreshingSession: Promise<unknown> | undefined;
+
+// Session access tokens expire after 15 minutes. When a request is rejected as
+// unauthenticated, refresh the session with the refresh token cookie and retry once.
+const refreshSessionInterceptor: Interceptor = (next) => async (req) => {
+  try {
+    return await next(req);
+  } catch (error) {
+    const skipped = req.method.name === "RefreshSession" || req.method.name === "CreateSession";
+    if (skipped || !(error instanceof ConnectError) || error.code !== Code.Unauthenticated) {
+      throw error;
+    }
+    if (!refreshingSession) {
+      refreshingSession = authServiceClient.refreshSession({}).finally(() => {
+        refreshingSession = undefined;
+      });
+    }
+    try {
+      await refreshingSession;
+    } catch (refreshError) {
+      // Aborted means another tab rotated the token first and its cookies are already in place.
+      if (!(refreshError instanceof ConnectError) || refreshError.code !== Code.Aborted) {
+        throw error;
+      }
+    }
+    return await next(req);
+  }
+};
+
 const transport = createConnectTransport({
   baseUrl: window.location.origin,
   // Include cookies in requests for session auth
   fetch: (input, init) => fetch(input, { ...init, credentials: "include" }),
+  interceptors: [refreshSessionInterceptor],
 });
 
 export const instanceServiceClient = createClient(InstanceService, transport);
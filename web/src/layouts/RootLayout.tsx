@@ -2,6 +2,7 @@ import { observer } from "mobx-react-lite";
 import { Suspense, useEffect, useMemo, useState } from "react";
 import { Outlet, useLocation, useSearchParams } from "react-router-dom";
 import usePrevious from "react-use/lib/usePrevious";
+import AnnouncementBanner from "@/components/AnnouncementBanner";
 import Navigation from "@/components/Navigation";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import useResponsiveWidth from "@/hooks/useResponsiveWidth";
@@ -59,6 +60,7 @@ const RootLayout = observer(() => {
         </div>
       )}
       <main className="w-full h-auto grow shrink flex flex-col justify-start items-center">
+        <AnnouncementBanner />
         <Suspense fallback={<Loading />}>
           <Outlet />
         </Suspense>
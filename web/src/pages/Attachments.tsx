@@ -1,6 +1,6 @@
 import { timestampDate } from "@bufbuild/protobuf/wkt";
 import dayjs from "dayjs";
-import { ExternalLinkIcon, PaperclipIcon, SearchIcon, Trash } from "lucide-react";
+import { ExternalLinkIcon, PaperclipIcon, SearchIcon, Trash, TriangleAlertIcon } from "lucide-react";
 import { observer } from "mobx-react-lite";
 import { useCallback, useEffect, useMemo, useState } from "react";
 import { toast } from "react-hot-toast";
@@ -42,31 +42,40 @@ const groupAttachmentsByDate = (attachments: Attachment[]): Map<string, Attachme
   return grouped;
 };
 
-const filterAttachments = (attachments: Attachment[], searchQuery: string): Attachment[] => {
-  if (!searchQuery.trim()) return attachments;
-  const query = searchQuery.toLowerCase();
-  return attachments.filter((attachment) => attachment.filename.toLowerCase().includes(query));
+// buildAttachmentFilter builds the filter of the listed attachments, searching their filenames on the server.
+const buildAttachmentFilter = (searchQuery: string): string => {
+  const query = searchQuery.trim();
+  return query ? `filename.contains(${JSON.stringify(query)})` : "";
 };
 
 interface AttachmentItemProps {
   attachment: Attachment;
 }
 
-const AttachmentItem = ({ attachment }: AttachmentItemProps) => (
-  <div className="w-24 sm:w-32 h-auto flex flex-col justify-start items-start">
-    <div className="w-24 h-24 flex justify-center items-center sm:w-32 sm:h-32 border border-border overflow-clip rounded-xl cursor-pointer hover:shadow hover:opacity-80">
-      <AttachmentIcon attachment={attachment} strokeWidth={0.5} />
-    </div>
-    <div className="w-full max-w-full flex flex-row justify-between items-center mt-1 px-1">
-      <p className="text-xs shrink text-muted-foreground truncate">{attachment.filename}</p>
-      {attachment.memo && (
-        <Link to={`/${attachment.memo}`} className="text-primary hover:opacity-80 transition-opacity shrink-0 ml-1" aria-label="View memo">
-          <ExternalLinkIcon className="w-3 h-3" />
-        </Link>
-      )}
+const AttachmentItem = ({ attachment }: AttachmentItemProps) => {
+  const t = useTranslate();
+
+  return (
+    <div className="w-24 sm:w-32 h-auto flex flex-col justify-start items-start">
+      <div className="w-24 h-24 flex justify-center items-center sm:w-32 sm:h-32 border border-border overflow-clip rounded-xl cursor-pointer hover:shadow hover:opacity-80">
+        <AttachmentIcon attachment={attachment} strokeWidth={0.5} />
+      </div>
+      <div className="w-full max-w-full flex flex-row justify-between items-center mt-1 px-1">
+        {attachment.broken && (
+          <span className="text-destructive shrink-0 mr-1" title={t("resource.broken-link")}>
+            <TriangleAlertIcon className="w-3 h-3" />
+          </span>
+        )}
+        <p className="text-xs shrink text-muted-foreground truncate">{attachment.filename}</p>
+        {attachment.memo && (
+          <Link to={`/${attachment.memo}`} className="text-primary hover:opacity-80 transition-opacity shrink-0 ml-1" aria-label="View memo">
+            <ExternalLinkIcon className="w-3 h-3" />
+          </Link>
+        )}
+      </div>
     </div>
-  </div>
-);
+  );
+};
 
 const Attachments = observer(() => {
   const t = useTranslate();
@@ -80,20 +89,21 @@ const Attachments = observer(() => {
   const [isLoadingMore, setIsLoadingMore] = useState(false);
 
   // Memoized computed values
-  const filteredAttachments = useMemo(() => filterAttachments(attachments, searchQuery), [attachments, searchQuery]);
+  const filter = useMemo(() => buildAttachmentFilter(searchQuery), [searchQuery]);
 
-  const usedAttachments = useMemo(() => filteredAttachments.filter((attachment) => attachment.memo), [filteredAttachments]);
+  const usedAttachments = useMemo(() => attachments.filter((attachment) => attachment.memo), [attachments]);
 
-  const unusedAttachments = useMemo(() => filteredAttachments.filter((attachment) => !attachment.memo), [filteredAttachments]);
+  const unusedAttachments = useMemo(() => attachments.filter((attachment) => !attachment.memo), [attachments]);
 
   const groupedAttachments = useMemo(() => groupAttachmentsByDate(usedAttachments), [usedAttachments]);
 
-  // Fetch initial attachments
+  // Fetch initial attachments, and fetch them again when the search changes
   useEffect(() => {
     const fetchInitialAttachments = async () => {
       try {
         const { attachments: fetchedAttachments, nextPageToken } = await attachmentServiceClient.listAttachments({
           pageSize: PAGE_SIZE,
+          filter,
         });
         setAttachments(fetchedAttachments);
         setNextPageToken(nextPageToken ?? "");
@@ -105,9 +115,10 @@ const Attachments = observer(() => {
       }
     };
 
-    fetchInitialAttachments();
+    const timer = setTimeout(fetchInitialAttachments, filter ? 300 : 0);
+    return () => clearTimeout(timer);
     // eslint-disable-next-line react-hooks/exhaustive-deps
-  }, []);
+  }, [filter]);
 
   // Load more attachments with pagination
   const handleLoadMore = useCallback(async () => {
@@ -118,6 +129,7 @@ const Attachments = observer(() => {
       const { attachments: fetchedAttachments, nextPageToken: newPageToken } = await attachmentServiceClient.listAttachments({
         pageSize: PAGE_SIZE,
         pageToken: nextPageToken,
+        filter,
       });
       setAttachments((prev) => [...prev, ...fetchedAttachments]);
       setNextPageToken(newPageToken ?? "");
@@ -127,7 +139,7 @@ const Attachments = observer(() => {
     } finally {
       setIsLoadingMore(false);
     }
-  }, [nextPageToken, isLoadingMore]);
+  }, [nextPageToken, isLoadingMore, filter]);
 
   // Refetch all attachments from the beginning
   const handleRefetch = useCallback(async () => {
@@ -135,6 +147,7 @@ const Attachments = observer(() => {
       loadingState.setLoading();
       const { attachments: fetchedAttachments, nextPageToken } = await attachmentServiceClient.listAttachments({
         pageSize: PAGE_SIZE,
+        filter,
       });
       setAttachments(fetchedAttachments);
       setNextPageToken(nextPageToken ?? "");
@@ -144,7 +157,7 @@ const Attachments = observer(() => {
       loadingState.setError();
       toast.error("Failed to refresh attachments. Please try again.");
     }
-  }, [loadingState]);
+  }, [loadingState, filter]);
 
   // Delete all unused attachments
   const handleDeleteUnusedAttachments = useCallback(async () => {
@@ -188,7 +201,7 @@ const Attachments = observer(() => {
               </div>
             ) : (
               <>
-                {filteredAttachments.length === 0 ? (
+                {attachments.length === 0 ? (
                   <div className="w-full mt-8 mb-8 flex flex-col justify-center items-center italic">
                     <Empty />
                     <p className="mt-4 text-muted-foreground">{t("message.no-data")}</p>
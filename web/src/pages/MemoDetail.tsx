@@ -27,7 +27,9 @@ const MemoDetail = observer(() => {
   const currentUser = useCurrentUser();
   const uid = params.uid;
   const memoName = `${memoNamePrefix}${uid}`;
-  const memo = memoStore.getMemoByName(memoName);
+  // The uid of the route may be the slug of the memo, which is resolved to its name once fetched.
+  const [resolvedMemoName, setResolvedMemoName] = useState(memoName);
+  const memo = memoStore.getMemoByName(resolvedMemoName);
   const [parentMemo, setParentMemo] = useState<Memo | undefined>(undefined);
   const [showCommentEditor, setShowCommentEditor] = useState(false);
   const commentRelations =
@@ -38,10 +40,13 @@ const MemoDetail = observer(() => {
   // Prepare memo.
   useEffect(() => {
     if (memoName) {
-      memoStore.getOrFetchMemoByName(memoName).catch((error: ConnectError) => {
-        toast.error(error.message);
-        navigateTo("/403");
-      });
+      memoStore
+        .getOrFetchMemoByName(memoName)
+        .then((memo: Memo) => setResolvedMemoName(memo.name))
+        .catch((error: ConnectError) => {
+          toast.error(error.message);
+          navigateTo("/403");
+        });
     } else {
       navigateTo("/404");
     }
@@ -0,0 +1,107 @@
+import { ConnectError } from "@connectrpc/connect";
+import { LoaderIcon } from "lucide-react";
+import { observer } from "mobx-react-lite";
+import { useState } from "react";
+import { toast } from "react-hot-toast";
+import { Link, useSearchParams } from "react-router-dom";
+import AuthFooter from "@/components/AuthFooter";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { authServiceClient } from "@/grpcweb";
+import useLoading from "@/hooks/useLoading";
+import useNavigateTo from "@/hooks/useNavigateTo";
+import { instanceStore } from "@/store";
+import { useTranslate } from "@/utils/i18n";
+
+const ResetPassword = observer(() => {
+  const t = useTranslate();
+  const navigateTo = useNavigateTo();
+  const [searchParams] = useSearchParams();
+  const actionBtnLoadingState = useLoading(false);
+  const [newPassword, setNewPassword] = useState("");
+  const [newPasswordAgain, setNewPasswordAgain] = useState("");
+  const token = searchParams.get("token") || "";
+  const instanceGeneralSetting = instanceStore.state.generalSetting;
+
+  const handleFormSubmit = async (e: React.FormEvent<HTMLFormElement>) => {
+    e.preventDefault();
+    if (newPassword === "" || actionBtnLoadingState.isLoading) {
+      return;
+    }
+    if (newPassword !== newPasswordAgain) {
+      toast.error(t("message.new-password-not-match"));
+      return;
+    }
+
+    try {
+      actionBtnLoadingState.setLoading();
+      await authServiceClient.resetPassword({ token, newPassword });
+      toast.success(t("auth.password-reset-success"));
+      navigateTo("/auth");
+    } catch (error: any) {
+      console.error(error);
+      toast.error((error as ConnectError).message || "Failed to reset password");
+    }
+    actionBtnLoadingState.setFinish();
+  };
+
+  return (
+    <div className="py-4 sm:py-8 w-80 max-w-full min-h-svh mx-auto flex flex-col justify-start items-center">
+      <div className="w-full py-4 grow flex flex-col justify-center items-center">
+        <div className="w-full flex flex-row justify-center items-center mb-6">
+          <img className="h-14 w-auto rounded-full shadow" src={instanceGeneralSetting.customProfile?.logoUrl || "/logo.webp"} alt="" />
+          <p className="ml-2 text-5xl text-foreground opacity-80">{instanceGeneralSetting.customProfile?.title || "Memos"}</p>
+        </div>
+        <p className="w-full text-2xl mt-2 text-muted-foreground">{t("auth.reset-your-password")}</p>
+        {!token ? (
+          <p className="w-full mt-4 text-muted-foreground">{t("auth.password-reset-invalid-link")}</p>
+        ) : (
+          <form className="w-full mt-2" onSubmit={handleFormSubmit}>
+            <div className="flex flex-col justify-start items-start w-full gap-4">
+              <div className="w-full flex flex-col justify-start items-start">
+                <span className="leading-8 text-muted-foreground">{t("auth.new-password")}</span>
+                <Input
+                  className="w-full bg-background h-10"
+                  type="password"
+                  readOnly={actionBtnLoadingState.isLoading}
+                  placeholder={t("auth.new-password")}
+                  value={newPassword}
+                  autoComplete="new-password"
+                  onChange={(e) => setNewPassword(e.target.value)}
+                  required
+                />
+              </div>
+              <div className="w-full flex flex-col justify-start items-start">
+                <span className="leading-8 text-muted-foreground">{t("auth.repeat-new-password")}</span>
+                <Input
+                  className="w-full bg-background h-10"
+                  type="password"
+                  readOnly={actionBtnLoadingState.isLoading}
+                  placeholder={t("auth.repeat-new-password")}
+                  value={newPasswordAgain}
+                  autoComplete="new-password"
+                  onChange={(e) => setNewPasswordAgain(e.target.value)}
+                  required
+                />
+              </div>
+            </div>
+            <div className="flex flex-row justify-end items-center w-full mt-6">
+              <Button type="submit" className="w-full h-10" disabled={actionBtnLoadingState.isLoading}>
+                {t("auth.reset-password")}
+                {actionBtnLoadingState.isLoading && <LoaderIcon className="w-5 h-auto ml-2 animate-spin opacity-60" />}
+              </Button>
+            </div>
+          </form>
+        )}
+        <p className="w-full mt-4 text-sm">
+          <Link to="/auth" className="cursor-pointer text-primary hover:underline" viewTransition>
+            {t("auth.back-to-sign-in")}
+          </Link>
+        </p>
+      </div>
+      <AuthFooter />
+    </div>
+  );
+});
+
+export default ResetPassword;
@@ -1,14 +1,33 @@
-import { CogIcon, DatabaseIcon, KeyIcon, LibraryIcon, LucideIcon, Settings2Icon, UserIcon, UsersIcon } from "lucide-react";
+import {
+  ArchiveIcon,
+  CogIcon,
+  DatabaseIcon,
+  KeyIcon,
+  LibraryIcon,
+  LucideIcon,
+  MailIcon,
+  MessagesSquareIcon,
+  ScrollTextIcon,
+  Settings2Icon,
+  SlackIcon,
+  UserIcon,
+  UsersIcon,
+} from "lucide-react";
 import { observer } from "mobx-react-lite";
 import { useCallback, useEffect, useMemo, useState } from "react";
 import { useLocation } from "react-router-dom";
 import MobileHeader from "@/components/MobileHeader";
+import AuditLogSection from "@/components/Settings/AuditLogSection";
+import BackupSection from "@/components/Settings/BackupSection";
+import EmailSection from "@/components/Settings/EmailSection";
 import InstanceSection from "@/components/Settings/InstanceSection";
+import MatrixSection from "@/components/Settings/MatrixSection";
 import MemberSection from "@/components/Settings/MemberSection";
 import MemoRelatedSettings from "@/components/Settings/MemoRelatedSettings";
 import MyAccountSection from "@/components/Settings/MyAccountSection";
 import PreferencesSection from "@/components/Settings/PreferencesSection";
 import SectionMenuItem from "@/components/Settings/SectionMenuItem";
+import SlackSection from "@/components/Settings/SlackSection";
 import SSOSection from "@/components/Settings/SSOSection";
 import StorageSection from "@/components/Settings/StorageSection";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
@@ -19,14 +38,26 @@ import { InstanceSetting_Key } from "@/types/proto/api/v1/instance_service_pb";
 import { User_Role } from "@/types/proto/api/v1/user_service_pb";
 import { useTranslate } from "@/utils/i18n";
 
-type SettingSection = "my-account" | "preference" | "member" | "system" | "memo-related" | "storage" | "sso";
+type SettingSection =
+  | "my-account"
+  | "preference"
+  | "member"
+  | "system"
+  | "memo-related"
+  | "storage"
+  | "backup"
+  | "email"
+  | "slack"
+  | "matrix"
+  | "sso"
+  | "audit-log";
 
 interface State {
   selectedSection: SettingSection;
 }
 
 const BASIC_SECTIONS: SettingSection[] = ["my-account", "preference"];
-const ADMIN_SECTIONS: SettingSection[] = ["member", "system", "memo-related", "storage", "sso"];
+const ADMIN_SECTIONS: SettingSection[] = ["member", "system", "memo-related", "storage", "backup", "email", "slack", "matrix", "sso", "audit-log"];
 const SECTION_ICON_MAP: Record<SettingSection, LucideIcon> = {
   "my-account": UserIcon,
   preference: CogIcon,
@@ -34,7 +65,12 @@ const SECTION_ICON_MAP: Record<SettingSection, LucideIcon> = {
   system: Settings2Icon,
   "memo-related": LibraryIcon,
   storage: DatabaseIcon,
+  backup: ArchiveIcon,
+  email: MailIcon,
+  slack: SlackIcon,
+  matrix: MessagesSquareIcon,
   sso: KeyIcon,
+  "audit-log": ScrollTextIcon,
 };
 
 const Setting = observer(() => {
@@ -73,7 +109,7 @@ const Setting = observer(() => {
 
     // Initial fetch for instance settings.
     (async () => {
-      [InstanceSetting_Key.MEMO_RELATED, InstanceSetting_Key.STORAGE].forEach(async (key) => {
+      [InstanceSetting_Key.MEMO_RELATED, InstanceSetting_Key.STORAGE, InstanceSetting_Key.EMAIL].forEach(async (key) => {
         await instanceStore.fetchInstanceSetting(key);
       });
     })();
@@ -148,8 +184,18 @@ const Setting = observer(() => {
               <MemoRelatedSettings />
             ) : state.selectedSection === "storage" ? (
               <StorageSection />
+            ) : state.selectedSection === "backup" ? (
+              <BackupSection />
+            ) : state.selectedSection === "email" ? (
+              <EmailSection />
+            ) : state.selectedSection === "slack" ? (
+              <SlackSection />
+            ) : state.selectedSection === "matrix" ? (
+              <MatrixSection />
             ) : state.selectedSection === "sso" ? (
               <SSOSection />
+            ) : state.selectedSection === "audit-log" ? (
+              <AuditLogSection />
             ) : null}
           </div>
         </div>
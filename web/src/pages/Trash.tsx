@@ -0,0 +1,179 @@
+import { timestampDate } from "@bufbuild/protobuf/wkt";
+import { ArchiveRestoreIcon, Trash2Icon } from "lucide-react";
+import { observer } from "mobx-react-lite";
+import { useCallback, useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import ConfirmDialog from "@/components/ConfirmDialog";
+import Empty from "@/components/Empty";
+import MobileHeader from "@/components/MobileHeader";
+import { Button } from "@/components/ui/button";
+import { memoServiceClient } from "@/grpcweb";
+import { DEFAULT_LIST_MEMOS_PAGE_SIZE } from "@/helpers/consts";
+import useLoading from "@/hooks/useLoading";
+import useResponsiveWidth from "@/hooks/useResponsiveWidth";
+import i18n from "@/i18n";
+import { instanceStore, userStore } from "@/store";
+import type { Memo } from "@/types/proto/api/v1/memo_service_pb";
+import { useTranslate } from "@/utils/i18n";
+
+const Trash = observer(() => {
+  const t = useTranslate();
+  const { md } = useResponsiveWidth();
+  const loadingState = useLoading();
+  const trashRetentionDays = instanceStore.state.memoRelatedSetting.trashRetentionDays;
+
+  const [memos, setMemos] = useState<Memo[]>([]);
+  const [nextPageToken, setNextPageToken] = useState("");
+  const [isLoadingMore, setIsLoadingMore] = useState(false);
+  const [purgeTarget, setPurgeTarget] = useState<Memo | undefined>(undefined);
+
+  // Fetch the memos in the trash
+  useEffect(() => {
+    const fetchTrashedMemos = async () => {
+      try {
+        const { memos: fetchedMemos, nextPageToken } = await memoServiceClient.listMemos({
+          showDeleted: true,
+          pageSize: DEFAULT_LIST_MEMOS_PAGE_SIZE,
+        });
+        setMemos(fetchedMemos);
+        setNextPageToken(nextPageToken ?? "");
+      } catch (error) {
+        console.error("Failed to fetch trashed memos:", error);
+        toast.error("Failed to load the trash. Please try again.");
+      } finally {
+        loadingState.setFinish();
+      }
+    };
+
+    fetchTrashedMemos();
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, []);
+
+  const handleLoadMore = useCallback(async () => {
+    if (!nextPageToken || isLoadingMore) return;
+
+    setIsLoadingMore(true);
+    try {
+      const { memos: fetchedMemos, nextPageToken: newPageToken } = await memoServiceClient.listMemos({
+        showDeleted: true,
+        pageSize: DEFAULT_LIST_MEMOS_PAGE_SIZE,
+        pageToken: nextPageToken,
+      });
+      setMemos((prev) => [...prev, ...fetchedMemos]);
+      setNextPageToken(newPageToken ?? "");
+    } catch (error) {
+      console.error("Failed to load more trashed memos:", error);
+      toast.error("Failed to load the trash. Please try again.");
+    } finally {
+      setIsLoadingMore(false);
+    }
+  }, [nextPageToken, isLoadingMore]);
+
+  const removeFromList = (name: string) => {
+    setMemos((prev) => prev.filter((memo) => memo.name !== name));
+  };
+
+  const handleRestore = async (memo: Memo) => {
+    try {
+      await memoServiceClient.restoreMemo({ name: memo.name });
+      removeFromList(memo.name);
+      toast.success(t("message.restored-successfully"));
+      // Refresh user stats to update tag counts
+      userStore.fetchUserStats().catch(console.error);
+    } catch (error) {
+      console.error("Failed to restore memo:", error);
+      toast.error("Failed to restore memo. Please try again.");
+    }
+  };
+
+  const handlePurge = async () => {
+    if (!purgeTarget) return;
+
+    try {
+      await memoServiceClient.purgeMemo({ name: purgeTarget.name });
+      removeFromList(purgeTarget.name);
+      toast.success(t("message.deleted-successfully"));
+    } catch (error) {
+      console.error("Failed to delete memo:", error);
+      toast.error("Failed to delete memo. Please try again.");
+    } finally {
+      setPurgeTarget(undefined);
+    }
+  };
+
+  return (
+    <section className="@container w-full max-w-5xl min-h-full flex flex-col justify-start items-center sm:pt-3 md:pt-6 pb-8">
+      {!md && <MobileHeader />}
+      <div className="w-full px-4 sm:px-6">
+        <div className="w-full border border-border flex flex-col justify-start items-start px-4 py-3 rounded-xl bg-background text-foreground">
+          <div className="relative w-full flex flex-row justify-between items-center">
+            <p className="py-1 flex flex-row justify-start items-center select-none opacity-80">
+              <Trash2Icon className="w-6 h-auto mr-1 opacity-80" />
+              <span className="text-lg">{t("common.trash")}</span>
+            </p>
+          </div>
+          {trashRetentionDays > 0 && (
+            <p className="text-sm text-muted-foreground">{t("memo.trash-retention-hint", { days: trashRetentionDays })}</p>
+          )}
+          <div className="w-full flex flex-col justify-start items-start mt-4 mb-6">
+            {loadingState.isLoading ? (
+              <div className="w-full h-32 flex flex-col justify-center items-center">
+                <p className="w-full text-center text-base my-6 mt-8">{t("resource.fetching-data")}</p>
+              </div>
+            ) : memos.length === 0 ? (
+              <div className="w-full mt-8 mb-8 flex flex-col justify-center items-center italic">
+                <Empty />
+                <p className="mt-4 text-muted-foreground">{t("memo.no-trashed-memos")}</p>
+              </div>
+            ) : (
+              <>
+                <div className="w-full flex flex-col justify-start items-start divide-y divide-border">
+                  {memos.map((memo) => (
+                    <div key={memo.name} className="w-full py-3 flex flex-row justify-between items-center gap-2">
+                      <div className="flex flex-col justify-start items-start min-w-0">
+                        <p className="w-full truncate">{memo.snippet || memo.content}</p>
+                        {memo.deleteTime && (
+                          <span className="text-xs text-muted-foreground">{timestampDate(memo.deleteTime).toLocaleString(i18n.language)}</span>
+                        )}
+                      </div>
+                      <div className="shrink-0 flex flex-row justify-end items-center gap-2">
+                        <Button variant="outline" size="sm" onClick={() => handleRestore(memo)}>
+                          <ArchiveRestoreIcon />
+                          {t("common.restore")}
+                        </Button>
+                        <Button variant="destructive" size="sm" onClick={() => setPurgeTarget(memo)}>
+                          <Trash2Icon />
+                          {t("memo.delete-permanently")}
+                        </Button>
+                      </div>
+                    </div>
+                  ))}
+                </div>
+                {nextPageToken && (
+                  <div className="w-full flex flex-row justify-center items-center mt-4">
+                    <Button variant="outline" size="sm" onClick={handleLoadMore} disabled={isLoadingMore}>
+                      {isLoadingMore ? t("resource.fetching-data") : t("memo.load-more")}
+                    </Button>
+                  </div>
+                )}
+              </>
+            )}
+          </div>
+        </div>
+      </div>
+
+      <ConfirmDialog
+        open={purgeTarget !== undefined}
+        onOpenChange={(open) => !open && setPurgeTarget(undefined)}
+        title={t("memo.delete-permanently-confirm")}
+        description={t("memo.delete-permanently-confirm-description")}
+        confirmLabel={t("common.delete")}
+        cancelLabel={t("common.cancel")}
+        onConfirm={handlePurge}
+        confirmVariant="destructive"
+      />
+    </section>
+  );
+});
+
+export default Trash;
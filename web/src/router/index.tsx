@@ -10,14 +10,17 @@ const AdminSignIn = lazy(() => import("@/pages/AdminSignIn"));
 const Archived = lazy(() => import("@/pages/Archived"));
 const AuthCallback = lazy(() => import("@/pages/AuthCallback"));
 const Explore = lazy(() => import("@/pages/Explore"));
+const ForgotPassword = lazy(() => import("@/pages/ForgotPassword"));
 const Inboxes = lazy(() => import("@/pages/Inboxes"));
 const MemoDetail = lazy(() => import("@/pages/MemoDetail"));
 const NotFound = lazy(() => import("@/pages/NotFound"));
 const PermissionDenied = lazy(() => import("@/pages/PermissionDenied"));
 const Attachments = lazy(() => import("@/pages/Attachments"));
+const ResetPassword = lazy(() => import("@/pages/ResetPassword"));
 const Setting = lazy(() => import("@/pages/Setting"));
 const SignIn = lazy(() => import("@/pages/SignIn"));
 const SignUp = lazy(() => import("@/pages/SignUp"));
+const Trash = lazy(() => import("@/pages/Trash"));
 const UserProfile = lazy(() => import("@/pages/UserProfile"));
 const MemoDetailRedirect = lazy(() => import("./MemoDetailRedirect"));
 
@@ -26,6 +29,7 @@ export enum Routes {
   ATTACHMENTS = "/attachments",
   INBOX = "/inbox",
   ARCHIVED = "/archived",
+  TRASH = "/trash",
   SETTING = "/setting",
   EXPLORE = "/explore",
   AUTH = "/auth",
@@ -63,6 +67,22 @@ const router = createBrowserRouter([
               </Suspense>
             ),
           },
+          {
+            path: "forgot-password",
+            element: (
+              <Suspense fallback={<Loading />}>
+                <ForgotPassword />
+              </Suspense>
+            ),
+          },
+          {
+            path: "reset-password",
+            element: (
+              <Suspense fallback={<Loading />}>
+                <ResetPassword />
+              </Suspense>
+            ),
+          },
           {
             path: "callback",
             element: (
@@ -118,6 +138,14 @@ const router = createBrowserRouter([
               </Suspense>
             ),
           },
+          {
+            path: Routes.TRASH,
+            element: (
+              <Suspense fallback={<Loading />}>
+                <Trash />
+              </Suspense>
+            ),
+          },
           {
             path: Routes.INBOX,
             element: (
@@ -1,10 +1,21 @@
 // Attachment Store - manages file attachment state including uploads and metadata
+import { create } from "@bufbuild/protobuf";
 import { computed, makeObservable, observable } from "mobx";
 import { attachmentServiceClient } from "@/grpcweb";
-import { Attachment, CreateAttachmentRequest, UpdateAttachmentRequest } from "@/types/proto/api/v1/attachment_service_pb";
+import { Attachment, AttachmentSchema, CreateAttachmentRequest, UpdateAttachmentRequest } from "@/types/proto/api/v1/attachment_service_pb";
 import { createServerStore, StandardState } from "./base-store";
 import { createRequestKey } from "./store-utils";
 
+// Files larger than a chunk are uploaded in chunks when they can't be uploaded to S3 directly,
+// so that a failed chunk is retried rather than the whole file.
+const CHUNKED_UPLOAD_THRESHOLD = 8 * 1024 * 1024;
+const CHUNK_UPLOAD_ATTEMPTS = 3;
+
+const sha256Hex = async (data: BufferSource): Promise<string> => {
+  const digest = await crypto.subtle.digest("SHA-256", data);
+  return Array.from(new Uint8Array(digest), (byte) => byte.toString(16).padStart(2, "0")).join("");
+};
+
 class AttachmentState extends StandardState {
   // Map of attachments indexed by resource name (e.g., "attachments/123")
   attachmentMapByName: Record<string, Attachment> = {};
@@ -89,6 +100,95 @@ const attachmentStore = (() => {
     );
   };
 
+  const uploadAttachmentInChunks = async (file: File): Promise<Attachment> => {
+    return executeRequest(
+      "", // No deduplication for uploads
+      async () => {
+        const upload = await attachmentServiceClient.createChunkedUpload({
+          attachment: create(AttachmentSchema, { filename: file.name, type: file.type }),
+          size: BigInt(file.size),
+          contentHash: await sha256Hex(await file.arrayBuffer()),
+        });
+        const chunkSize = Number(upload.chunkSize);
+        for (const index of upload.missingChunks) {
+          const content = new Uint8Array(await file.slice(index * chunkSize, (index + 1) * chunkSize).arrayBuffer());
+          const checksum = await sha256Hex(content);
+          for (let attempt = 1; ; attempt++) {
+            try {
+              await attachmentServiceClient.uploadAttachmentChunk({ name: upload.name, index, content, checksum });
+              break;
+            } catch (error) {
+              if (attempt >= CHUNK_UPLOAD_ATTEMPTS) {
+                throw error;
+              }
+            }
+          }
+        }
+        const result = await attachmentServiceClient.completeChunkedUpload({ name: upload.name });
+
+        // Add to cache
+        state.setPartial({
+          attachmentMapByName: {
+            ...state.attachmentMapByName,
+            [result.name]: result,
+          },
+        });
+
+        return result;
+      },
+      "UPLOAD_ATTACHMENT_FAILED",
+    );
+  };
+
+  // Uploads a file directly to the S3 storage when the server gives an upload URL,
+  // otherwise the content is sent in chunks for large files, or with createAttachment.
+  const uploadAttachment = async (file: File): Promise<Attachment> => {
+    const { attachment, uploadUrl } = await attachmentServiceClient.createAttachmentUpload({
+      attachment: create(AttachmentSchema, { filename: file.name, type: file.type }),
+      size: BigInt(file.size),
+    });
+    if (!attachment || !uploadUrl) {
+      // The metadata of images may be stripped on upload, which chunked uploads don't support.
+      if (file.size > CHUNKED_UPLOAD_THRESHOLD && !file.type.startsWith("image/")) {
+        return uploadAttachmentInChunks(file);
+      }
+      return createAttachment(
+        create(AttachmentSchema, {
+          filename: file.name,
+          size: BigInt(file.size),
+          type: file.type,
+          content: new Uint8Array(await file.arrayBuffer()),
+        }),
+      );
+    }
+
+    return executeRequest(
+      "", // No deduplication for uploads
+      async () => {
+        const response = await fetch(uploadUrl, {
+          method: "PUT",
+          headers: file.type ? { "Content-Type": file.type } : undefined,
+          body: file,
+        });
+        if (!response.ok) {
+          throw new Error(`Failed to upload ${file.name}: ${response.status}`);
+        }
+        const result = await attachmentServiceClient.completeAttachmentUpload({ name: attachment.name });
+
+        // Add to cache
+        state.setPartial({
+          attachmentMapByName: {
+            ...state.attachmentMapByName,
+            [result.name]: result,
+          },
+        });
+
+        return result;
+      },
+      "UPLOAD_ATTACHMENT_FAILED",
+    );
+  };
+
   const updateAttachment = async (request: UpdateAttachmentRequest): Promise<Attachment> => {
     return executeRequest(
       "", // No deduplication for updates
@@ -134,6 +234,7 @@ const attachmentStore = (() => {
     getAttachmentByName,
     getOrFetchAttachmentByName,
     createAttachment,
+    uploadAttachment,
     updateAttachment,
     deleteAttachment,
     clearCache,
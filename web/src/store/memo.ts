@@ -91,7 +91,8 @@ const memoStore = (() => {
 
     if (!options?.skipStore) {
       const memoMap = { ...state.memoMapByName };
-      memoMap[name] = memo;
+      // Memos fetched by their slug are kept by their name.
+      memoMap[memo.name] = memo;
       state.setPartial({
         stateId: uniqueId(),
         memoMapByName: memoMap,
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/activity_service.proto.
  */
 export const file_api_v1_activity_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIsEDCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyIuCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQASI9CgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARIICgRXQVJOEAISCQoFRVJST1IQAzpN6kFKChVtZW1vcy5hcGkudjEvQWN0aXZpdHkSFWFjdGl2aXRpZXMve2FjdGl2aXR5fRoEbmFtZSoKYWN0aXZpdGllczIIYWN0aXZpdHkiXgoPQWN0aXZpdHlQYXlsb2FkEkAKDG1lbW9fY29tbWVudBgBIAEoCzIoLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9Db21tZW50UGF5bG9hZEgAQgkKB3BheWxvYWQiQAoaQWN0aXZpdHlNZW1vQ29tbWVudFBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxyZWxhdGVkX21lbW8YAiABKAkiPgoVTGlzdEFjdGl2aXRpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJIl0KFkxpc3RBY3Rpdml0aWVzUmVzcG9uc2USKgoKYWN0aXZpdGllcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiQQoSR2V0QWN0aXZpdHlSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5Mv8BCg9BY3Rpdml0eVNlcnZpY2USdwoOTGlzdEFjdGl2aXRpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVzcG9uc2UiGoLT5JMCFBISL2FwaS92MS9hY3Rpdml0aWVzEnMKC0dldEFjdGl2aXR5EiAubWVtb3MuYXBpLnYxLkdldEFjdGl2aXR5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BY3Rpdml0eSIq2kEEbmFtZYLT5JMCHRIbL2FwaS92MS97bmFtZT1hY3Rpdml0aWVzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRBY3Rpdml0eVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
+  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIsYECghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyKyAQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEAoMTUVNT19DT01NRU5UEAESEQoNTUVNT19SRU1JTkRFUhACEhUKEU1FTU9fQVVUT19BUkNISVZFEAMSFAoQV0VCSE9PS19ESVNBQkxFRBAEEhEKDUJBQ0tVUF9GQUlMRUQQBRIRCg1NRU1PX1JFQUNUSU9OEAYSEAoMTUVNT19NRU5USU9OEAcSCgoGRElHRVNUEAgiPQoFTGV2ZWwSFQoRTEVWRUxfVU5TUEVDSUZJRUQQABIICgRJTkZPEAESCAoEV0FSThACEgkKBUVSUk9SEAM6TepBSgoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5EhVhY3Rpdml0aWVzL3thY3Rpdml0eX0aBG5hbWUqCmFjdGl2aXRpZXMyCGFjdGl2aXR5IrgECg9BY3Rpdml0eVBheWxvYWQSQAoMbWVtb19jb21tZW50GAEgASgLMigubWVtb3MuYXBpLnYxLkFjdGl2aXR5TWVtb0NvbW1lbnRQYXlsb2FkSAASQgoNbWVtb19yZW1pbmRlchgCIAEoCzIpLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9SZW1pbmRlclBheWxvYWRIABJJChFtZW1vX2F1dG9fYXJjaGl2ZRgDIAEoCzIsLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9BdXRvQXJjaGl2ZVBheWxvYWRIABJIChB3ZWJob29rX2Rpc2FibGVkGAQgASgLMiwubWVtb3MuYXBpLnYxLkFjdGl2aXR5V2ViaG9va0Rpc2FibGVkUGF5bG9hZEgAEkIKDWJhY2t1cF9mYWlsZWQYBSABKAsyKS5tZW1vcy5hcGkudjEuQWN0aXZpdHlCYWNrdXBGYWlsZWRQYXlsb2FkSAASQgoNbWVtb19yZWFjdGlvbhgGIAEoCzIpLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9SZWFjdGlvblBheWxvYWRIABJACgxtZW1vX21lbnRpb24YByABKAsyKC5tZW1vcy5hcGkudjEuQWN0aXZpdHlNZW1vTWVudGlvblBheWxvYWRIABI1CgZkaWdlc3QYCCABKAsyIy5tZW1vcy5hcGkudjEuQWN0aXZpdHlEaWdlc3RQYXlsb2FkSABCCQoHcGF5bG9hZCJAChpBY3Rpdml0eU1lbW9Db21tZW50UGF5bG9hZBIMCgRtZW1vGAEgASgJEhQKDHJlbGF0ZWRfbWVtbxgCIAEoCSJcChtBY3Rpdml0eU1lbW9SZW1pbmRlclBheWxvYWQSDAoEbWVtbxgBIAEoCRIvCgtyZW1pbmRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQgoeQWN0aXZpdHlNZW1vQXV0b0FyY2hpdmVQYXlsb2FkEhIKCm1lbW9fY291bnQYASABKAUSDAoEZGF5cxgCIAEoBSJPCh5BY3Rpdml0eVdlYmhvb2tEaXNhYmxlZFBheWxvYWQSDwoHd2ViaG9vaxgBIAEoCRIcChRjb25zZWN1dGl2ZV9mYWlsdXJlcxgCIAEoBSIsChtBY3Rpdml0eUJhY2t1cEZhaWxlZFBheWxvYWQSDQoFZXJyb3IYASABKAkiQgobQWN0aXZpdHlNZW1vUmVhY3Rpb25QYXlsb2FkEgwKBG1lbW8YASABKAkSFQoNcmVhY3Rpb25fdHlwZRgCIAEoCSIqChpBY3Rpdml0eU1lbW9NZW50aW9uUGF5bG9hZBIMCgRtZW1vGAEgASgJIuQBChVBY3Rpdml0eURpZ2VzdFBheWxvYWQSLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBW1lbW9zGAMgAygJEhUKDWNvbW1lbnRfY291bnQYBCABKAUSFgoOcmVhY3Rpb25fY291bnQYBSABKAUSFgoOcmVtaW5kZXJfbWVtb3MYBiADKAkSFwoPcmVzdXJmYWNlZF9tZW1vGAcgASgJIj4KFUxpc3RBY3Rpdml0aWVzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCSJdChZMaXN0QWN0aXZpdGllc1Jlc3BvbnNlEioKCmFjdGl2aXRpZXMYASADKAsyFi5tZW1vcy5hcGkudjEuQWN0aXZpdHkSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIkEKEkdldEFjdGl2aXR5UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFW1lbW9zLmFwaS52MS9BY3Rpdml0eTL/AQoPQWN0aXZpdHlTZXJ2aWNlEncKDkxpc3RBY3Rpdml0aWVzEiMubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVxdWVzdBokLm1lbW9zLmFwaS52MS5MaXN0QWN0aXZpdGllc1Jlc3BvbnNlIhqC0+STAhQSEi9hcGkvdjEvYWN0aXZpdGllcxJzCgtHZXRBY3Rpdml0eRIgLm1lbW9zLmFwaS52MS5HZXRBY3Rpdml0eVJlcXVlc3QaFi5tZW1vcy5hcGkudjEuQWN0aXZpdHkiKtpBBG5hbWWC0+STAh0SGy9hcGkvdjEve25hbWU9YWN0aXZpdGllcy8qfUKsAQoQY29tLm1lbW9zLmFwaS52MUIUQWN0aXZpdHlTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Activity
@@ -93,6 +93,55 @@ export enum Activity_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * Memo reminder activity.
+   *
+   * @generated from enum value: MEMO_REMINDER = 2;
+   */
+  MEMO_REMINDER = 2,
+
+  /**
+   * Memo auto-archive activity.
+   *
+   * @generated from enum value: MEMO_AUTO_ARCHIVE = 3;
+   */
+  MEMO_AUTO_ARCHIVE = 3,
+
+  /**
+   * Webhook disabled activity.
+   *
+   * @generated from enum value: WEBHOOK_DISABLED = 4;
+   */
+  WEBHOOK_DISABLED = 4,
+
+  /**
+   * Backup failed activity.
+   *
+   * @generated from enum value: BACKUP_FAILED = 5;
+   */
+  BACKUP_FAILED = 5,
+
+  /**
+   * Memo reaction activity.
+   *
+   * @generated from enum value: MEMO_REACTION = 6;
+   */
+  MEMO_REACTION = 6,
+
+  /**
+   * Memo mention activity.
+   *
+   * @generated from enum value: MEMO_MENTION = 7;
+   */
+  MEMO_MENTION = 7,
+
+  /**
+   * Activity digest.
+   *
+   * @generated from enum value: DIGEST = 8;
+   */
+  DIGEST = 8,
 }
 
 /**
@@ -157,6 +206,62 @@ export type ActivityPayload = Message<"memos.api.v1.ActivityPayload"> & {
      */
     value: ActivityMemoCommentPayload;
     case: "memoComment";
+  } | {
+    /**
+     * Memo reminder activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoReminderPayload memo_reminder = 2;
+     */
+    value: ActivityMemoReminderPayload;
+    case: "memoReminder";
+  } | {
+    /**
+     * Memo auto-archive activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoAutoArchivePayload memo_auto_archive = 3;
+     */
+    value: ActivityMemoAutoArchivePayload;
+    case: "memoAutoArchive";
+  } | {
+    /**
+     * Webhook disabled activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityWebhookDisabledPayload webhook_disabled = 4;
+     */
+    value: ActivityWebhookDisabledPayload;
+    case: "webhookDisabled";
+  } | {
+    /**
+     * Backup failed activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityBackupFailedPayload backup_failed = 5;
+     */
+    value: ActivityBackupFailedPayload;
+    case: "backupFailed";
+  } | {
+    /**
+     * Memo reaction activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoReactionPayload memo_reaction = 6;
+     */
+    value: ActivityMemoReactionPayload;
+    case: "memoReaction";
+  } | {
+    /**
+     * Memo mention activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoMentionPayload memo_mention = 7;
+     */
+    value: ActivityMemoMentionPayload;
+    case: "memoMention";
+  } | {
+    /**
+     * Activity digest payload.
+     *
+     * @generated from field: memos.api.v1.ActivityDigestPayload digest = 8;
+     */
+    value: ActivityDigestPayload;
+    case: "digest";
   } | { case: undefined; value?: undefined };
 };
 
@@ -197,6 +302,236 @@ export type ActivityMemoCommentPayload = Message<"memos.api.v1.ActivityMemoComme
 export const ActivityMemoCommentPayloadSchema: GenMessage<ActivityMemoCommentPayload> = /*@__PURE__*/
   messageDesc(file_api_v1_activity_service, 2);
 
+/**
+ * ActivityMemoReminderPayload represents the payload of a memo reminder activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoReminderPayload
+ */
+export type ActivityMemoReminderPayload = Message<"memos.api.v1.ActivityMemoReminderPayload"> & {
+  /**
+   * The name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The reminder time that was due.
+   *
+   * @generated from field: google.protobuf.Timestamp remind_time = 2;
+   */
+  remindTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoReminderPayload.
+ * Use `create(ActivityMemoReminderPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoReminderPayloadSchema: GenMessage<ActivityMemoReminderPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 3);
+
+/**
+ * ActivityMemoAutoArchivePayload represents the payload of a memo auto-archive activity, which
+ * summarizes the memos archived by a run of the auto-archive policy of a user.
+ *
+ * @generated from message memos.api.v1.ActivityMemoAutoArchivePayload
+ */
+export type ActivityMemoAutoArchivePayload = Message<"memos.api.v1.ActivityMemoAutoArchivePayload"> & {
+  /**
+   * The number of memos archived.
+   *
+   * @generated from field: int32 memo_count = 1;
+   */
+  memoCount: number;
+
+  /**
+   * The days without edits after which the memos were archived.
+   *
+   * @generated from field: int32 days = 2;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoAutoArchivePayload.
+ * Use `create(ActivityMemoAutoArchivePayloadSchema)` to create a new message.
+ */
+export const ActivityMemoAutoArchivePayloadSchema: GenMessage<ActivityMemoAutoArchivePayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 4);
+
+/**
+ * ActivityWebhookDisabledPayload represents the payload of a webhook disabled activity, which
+ * records a webhook disabled after too many consecutive failed deliveries.
+ *
+ * @generated from message memos.api.v1.ActivityWebhookDisabledPayload
+ */
+export type ActivityWebhookDisabledPayload = Message<"memos.api.v1.ActivityWebhookDisabledPayload"> & {
+  /**
+   * The resource name of the webhook.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string webhook = 1;
+   */
+  webhook: string;
+
+  /**
+   * The number of consecutive failed deliveries that disabled the webhook.
+   *
+   * @generated from field: int32 consecutive_failures = 2;
+   */
+  consecutiveFailures: number;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityWebhookDisabledPayload.
+ * Use `create(ActivityWebhookDisabledPayloadSchema)` to create a new message.
+ */
+export const ActivityWebhookDisabledPayloadSchema: GenMessage<ActivityWebhookDisabledPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 5);
+
+/**
+ * ActivityBackupFailedPayload represents the payload of a backup failed activity, which records
+ * a scheduled or manual backup of the instance that failed.
+ *
+ * @generated from message memos.api.v1.ActivityBackupFailedPayload
+ */
+export type ActivityBackupFailedPayload = Message<"memos.api.v1.ActivityBackupFailedPayload"> & {
+  /**
+   * The error of the failed backup.
+   *
+   * @generated from field: string error = 1;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityBackupFailedPayload.
+ * Use `create(ActivityBackupFailedPayloadSchema)` to create a new message.
+ */
+export const ActivityBackupFailedPayloadSchema: GenMessage<ActivityBackupFailedPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 6);
+
+/**
+ * ActivityMemoReactionPayload represents the payload of a memo reaction activity, which records
+ * a reaction to a memo of another user.
+ *
+ * @generated from message memos.api.v1.ActivityMemoReactionPayload
+ */
+export type ActivityMemoReactionPayload = Message<"memos.api.v1.ActivityMemoReactionPayload"> & {
+  /**
+   * The name of the memo reacted to.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The reaction type, e.g. an emoji.
+   *
+   * @generated from field: string reaction_type = 2;
+   */
+  reactionType: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoReactionPayload.
+ * Use `create(ActivityMemoReactionPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoReactionPayloadSchema: GenMessage<ActivityMemoReactionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 7);
+
+/**
+ * ActivityMemoMentionPayload represents the payload of a memo mention activity, which records a
+ * memo mentioning another user with @username.
+ *
+ * @generated from message memos.api.v1.ActivityMemoMentionPayload
+ */
+export type ActivityMemoMentionPayload = Message<"memos.api.v1.ActivityMemoMentionPayload"> & {
+  /**
+   * The name of the memo mentioning the user.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoMentionPayload.
+ * Use `create(ActivityMemoMentionPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoMentionPayloadSchema: GenMessage<ActivityMemoMentionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 8);
+
+/**
+ * ActivityDigestPayload represents the payload of an activity digest, which summarizes the
+ * activity of a user over a day or a week.
+ *
+ * @generated from message memos.api.v1.ActivityDigestPayload
+ */
+export type ActivityDigestPayload = Message<"memos.api.v1.ActivityDigestPayload"> & {
+  /**
+   * The start of the period summarized by the digest.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * The end of the period summarized by the digest.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * The names of the memos the user created in the period.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string memos = 3;
+   */
+  memos: string[];
+
+  /**
+   * The number of comments on the memos of the user in the period.
+   *
+   * @generated from field: int32 comment_count = 4;
+   */
+  commentCount: number;
+
+  /**
+   * The number of reactions to the memos of the user in the period.
+   *
+   * @generated from field: int32 reaction_count = 5;
+   */
+  reactionCount: number;
+
+  /**
+   * The names of the memos of the user with a reminder due in the next period.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string reminder_memos = 6;
+   */
+  reminderMemos: string[];
+
+  /**
+   * The name of a random older memo of the user, brought back by the digest.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string resurfaced_memo = 7;
+   */
+  resurfacedMemo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityDigestPayload.
+ * Use `create(ActivityDigestPayloadSchema)` to create a new message.
+ */
+export const ActivityDigestPayloadSchema: GenMessage<ActivityDigestPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 9);
+
 /**
  * @generated from message memos.api.v1.ListActivitiesRequest
  */
@@ -225,7 +560,7 @@ export type ListActivitiesRequest = Message<"memos.api.v1.ListActivitiesRequest"
  * Use `create(ListActivitiesRequestSchema)` to create a new message.
  */
 export const ListActivitiesRequestSchema: GenMessage<ListActivitiesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 3);
+  messageDesc(file_api_v1_activity_service, 10);
 
 /**
  * @generated from message memos.api.v1.ListActivitiesResponse
@@ -253,7 +588,7 @@ export type ListActivitiesResponse = Message<"memos.api.v1.ListActivitiesRespons
  * Use `create(ListActivitiesResponseSchema)` to create a new message.
  */
 export const ListActivitiesResponseSchema: GenMessage<ListActivitiesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 4);
+  messageDesc(file_api_v1_activity_service, 11);
 
 /**
  * @generated from message memos.api.v1.GetActivityRequest
@@ -273,7 +608,7 @@ export type GetActivityRequest = Message<"memos.api.v1.GetActivityRequest"> & {
  * Use `create(GetActivityRequestSchema)` to create a new message.
  */
 export const GetActivityRequestSchema: GenMessage<GetActivityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 5);
+  messageDesc(file_api_v1_activity_service, 12);
 
 /**
  * @generated from service memos.api.v1.ActivityService
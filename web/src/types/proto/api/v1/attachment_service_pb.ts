@@ -8,15 +8,15 @@ import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/attachment_service.proto.
  */
 export const file_api_v1_attachment_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch9hcGkvdjEvYXR0YWNobWVudF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEitgIKCkF0dGFjaG1lbnQSEQoEbmFtZRgBIAEoCUID4EEIEjQKC2NyZWF0ZV90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEhUKCGZpbGVuYW1lGAMgASgJQgPgQQISFAoHY29udGVudBgEIAEoDEID4EEEEhoKDWV4dGVybmFsX2xpbmsYBSABKAlCA+BBARIRCgR0eXBlGAYgASgJQgPgQQISEQoEc2l6ZRgHIAEoA0ID4EEDEhYKBG1lbW8YCCABKAlCA+BBAUgAiAEBOk/qQUwKF21lbW9zLmFwaS52MS9BdHRhY2htZW50EhhhdHRhY2htZW50cy97YXR0YWNobWVudH0qC2F0dGFjaG1lbnRzMgphdHRhY2htZW50QgcKBV9tZW1vImgKF0NyZWF0ZUF0dGFjaG1lbnRSZXF1ZXN0EjEKCmF0dGFjaG1lbnQYASABKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EECEhoKDWF0dGFjaG1lbnRfaWQYAiABKAlCA+BBASJ1ChZMaXN0QXR0YWNobWVudHNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARITCgZmaWx0ZXIYAyABKAlCA+BBARIVCghvcmRlcl9ieRgEIAEoCUID4EEBInUKF0xpc3RBdHRhY2htZW50c1Jlc3BvbnNlEi0KC2F0dGFjaG1lbnRzGAEgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUiRQoUR2V0QXR0YWNobWVudFJlcXVlc3QSLQoEbmFtZRgBIAEoCUIf4EEC+kEZChdtZW1vcy5hcGkudjEvQXR0YWNobWVudCKCAQoXVXBkYXRlQXR0YWNobWVudFJlcXVlc3QSMQoKYXR0YWNobWVudBgBIAEoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiSAoXRGVsZXRlQXR0YWNobWVudFJlcXVlc3QSLQoEbmFtZRgBIAEoCUIf4EEC+kEZChdtZW1vcy5hcGkudjEvQXR0YWNobWVudDLEBQoRQXR0YWNobWVudFNlcnZpY2USiQEKEENyZWF0ZUF0dGFjaG1lbnQSJS5tZW1vcy5hcGkudjEuQ3JlYXRlQXR0YWNobWVudFJlcXVlc3QaGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudCI02kEKYXR0YWNobWVudILT5JMCIToKYXR0YWNobWVudCITL2FwaS92MS9hdHRhY2htZW50cxJ7Cg9MaXN0QXR0YWNobWVudHMSJC5tZW1vcy5hcGkudjEuTGlzdEF0dGFjaG1lbnRzUmVxdWVzdBolLm1lbW9zLmFwaS52MS5MaXN0QXR0YWNobWVudHNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL2F0dGFjaG1lbnRzEnoKDUdldEF0dGFjaG1lbnQSIi5tZW1vcy5hcGkudjEuR2V0QXR0YWNobWVudFJlcXVlc3QaGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudCIr2kEEbmFtZYLT5JMCHhIcL2FwaS92MS97bmFtZT1hdHRhY2htZW50cy8qfRKpAQoQVXBkYXRlQXR0YWNobWVudBIlLm1lbW9zLmFwaS52MS5VcGRhdGVBdHRhY2htZW50UmVxdWVzdBoYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50IlTaQRZhdHRhY2htZW50LHVwZGF0ZV9tYXNrgtPkkwI1OgphdHRhY2htZW50MicvYXBpL3YxL3thdHRhY2htZW50Lm5hbWU9YXR0YWNobWVudHMvKn0SfgoQRGVsZXRlQXR0YWNobWVudBIlLm1lbW9zLmFwaS52MS5EZWxldGVBdHRhY2htZW50UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIr2kEEbmFtZYLT5JMCHiocL2FwaS92MS97bmFtZT1hdHRhY2htZW50cy8qfUKuAQoQY29tLm1lbW9zLmFwaS52MUIWQXR0YWNobWVudFNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("Ch9hcGkvdjEvYXR0YWNobWVudF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi6QMKCkF0dGFjaG1lbnQSEQoEbmFtZRgBIAEoCUID4EEIEjQKC2NyZWF0ZV90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEhUKCGZpbGVuYW1lGAMgASgJQgPgQQISFAoHY29udGVudBgEIAEoDEID4EEEEhoKDWV4dGVybmFsX2xpbmsYBSABKAlCA+BBARIRCgR0eXBlGAYgASgJQgPgQQISEQoEc2l6ZRgHIAEoA0ID4EEDEhYKBG1lbW8YCCABKAlCA+BBAUgAiAEBEhQKB2xpYnJhcnkYCSABKAhCA+BBARIaCg1vcmlnaW5hbF9zaXplGAogASgDQgPgQQMSMAoIZHVyYXRpb24YCyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBAxITCgZicm9rZW4YDCABKAhCA+BBAxI4Cg9saW5rX2NoZWNrX3RpbWUYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6T+pBTAoXbWVtb3MuYXBpLnYxL0F0dGFjaG1lbnQSGGF0dGFjaG1lbnRzL3thdHRhY2htZW50fSoLYXR0YWNobWVudHMyCmF0dGFjaG1lbnRCBwoFX21lbW8iaAoXQ3JlYXRlQXR0YWNobWVudFJlcXVlc3QSMQoKYXR0YWNobWVudBgBIAEoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQISGgoNYXR0YWNobWVudF9pZBgCIAEoCUID4EEBIp4BCh1DcmVhdGVBdHRhY2htZW50VXBsb2FkUmVxdWVzdBIxCgphdHRhY2htZW50GAEgASgLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnRCA+BBAhIRCgRzaXplGAIgASgDQgPgQQISGgoNYXR0YWNobWVudF9pZBgDIAEoCUID4EEBEhsKDmV4cGlyZV9zZWNvbmRzGAQgASgFQgPgQQEikwEKHkNyZWF0ZUF0dGFjaG1lbnRVcGxvYWRSZXNwb25zZRIsCgphdHRhY2htZW50GAEgASgLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnQSEgoKdXBsb2FkX3VybBgCIAEoCRIvCgtleHBpcmVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUAofQ29tcGxldGVBdHRhY2htZW50VXBsb2FkUmVxdWVzdBItCgRuYW1lGAEgASgJQh/gQQL6QRkKF21lbW9zLmFwaS52MS9BdHRhY2htZW50IpkBChpDcmVhdGVDaHVua2VkVXBsb2FkUmVxdWVzdBIxCgphdHRhY2htZW50GAEgASgLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnRCA+BBAhIRCgRzaXplGAIgASgDQgPgQQISGQoMY29udGVudF9oYXNoGAMgASgJQgPgQQISGgoNYXR0YWNobWVudF9pZBgEIAEoCUID4EEBIp0BCg1DaHVua2VkVXBsb2FkEgwKBG5hbWUYASABKAkSDAoEc2l6ZRgCIAEoAxISCgpjaHVua19zaXplGAMgASgDEhMKC2NodW5rX2NvdW50GAQgASgFEhYKDm1pc3NpbmdfY2h1bmtzGAUgAygFEi8KC2V4cGlyZV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKOAQocVXBsb2FkQXR0YWNobWVudENodW5rUmVxdWVzdBItCgRuYW1lGAEgASgJQh/gQQL6QRkKF21lbW9zLmFwaS52MS9BdHRhY2htZW50EhIKBWluZGV4GAIgASgFQgPgQQISFAoHY29udGVudBgDIAEoDEID4EECEhUKCGNoZWNrc3VtGAQgASgJQgPgQQIiSAoXR2V0Q2h1bmtlZFVwbG9hZFJlcXVlc3QSLQoEbmFtZRgBIAEoCUIf4EEC+kEZChdtZW1vcy5hcGkudjEvQXR0YWNobWVudCJNChxDb21wbGV0ZUNodW5rZWRVcGxvYWRSZXF1ZXN0Ei0KBG5hbWUYASABKAlCH+BBAvpBGQoXbWVtb3MuYXBpLnYxL0F0dGFjaG1lbnQijQEKFkxpc3RBdHRhY2htZW50c1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEhMKBmZpbHRlchgDIAEoCUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQESFgoJYWxsX3VzZXJzGAUgASgIQgPgQQEidQoXTGlzdEF0dGFjaG1lbnRzUmVzcG9uc2USLQoLYXR0YWNobWVudHMYASADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJRCiBSZWZyZXNoRXh0ZXJuYWxBdHRhY2htZW50UmVxdWVzdBItCgRuYW1lGAEgASgJQh/gQQL6QRkKF21lbW9zLmFwaS52MS9BdHRhY2htZW50IkUKFEdldEF0dGFjaG1lbnRSZXF1ZXN0Ei0KBG5hbWUYASABKAlCH+BBAvpBGQoXbWVtb3MuYXBpLnYxL0F0dGFjaG1lbnQiggEKF1VwZGF0ZUF0dGFjaG1lbnRSZXF1ZXN0EjEKCmF0dGFjaG1lbnQYASABKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIkgKF0RlbGV0ZUF0dGFjaG1lbnRSZXF1ZXN0Ei0KBG5hbWUYASABKAlCH+BBAvpBGQoXbWVtb3MuYXBpLnYxL0F0dGFjaG1lbnQiIQofUHVyZ2VPcnBoYW5lZEF0dGFjaG1lbnRzUmVxdWVzdCJRCiBQdXJnZU9ycGhhbmVkQXR0YWNobWVudHNSZXNwb25zZRIUCgxwdXJnZWRfY291bnQYASABKAUSFwoPcmVjbGFpbWVkX2J5dGVzGAIgASgDIh8KHURlZHVwbGljYXRlQXR0YWNobWVudHNSZXF1ZXN0ImcKHkRlZHVwbGljYXRlQXR0YWNobWVudHNSZXNwb25zZRIUCgxoYXNoZWRfY291bnQYASABKAUSGgoSZGVkdXBsaWNhdGVkX2NvdW50GAIgASgFEhMKC3NhdmVkX2J5dGVzGAMgASgDIiAKHlJlY2FsY3VsYXRlU3RvcmFnZVVzYWdlUmVxdWVzdCJKCh9SZWNhbGN1bGF0ZVN0b3JhZ2VVc2FnZVJlc3BvbnNlEhIKCnVzZXJfY291bnQYASABKAUSEwoLdG90YWxfYnl0ZXMYAiABKAMykRIKEUF0dGFjaG1lbnRTZXJ2aWNlEokBChBDcmVhdGVBdHRhY2htZW50EiUubWVtb3MuYXBpLnYxLkNyZWF0ZUF0dGFjaG1lbnRSZXF1ZXN0GhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnQiNNpBCmF0dGFjaG1lbnSC0+STAiE6CmF0dGFjaG1lbnQiEy9hcGkvdjEvYXR0YWNobWVudHMSoAEKFkNyZWF0ZUF0dGFjaG1lbnRVcGxvYWQSKy5tZW1vcy5hcGkudjEuQ3JlYXRlQXR0YWNobWVudFVwbG9hZFJlcXVlc3QaLC5tZW1vcy5hcGkudjEuQ3JlYXRlQXR0YWNobWVudFVwbG9hZFJlc3BvbnNlIiuC0+STAiU6ASoiIC9hcGkvdjEvYXR0YWNobWVudHM6Y3JlYXRlVXBsb2FkEqIBChhDb21wbGV0ZUF0dGFjaG1lbnRVcGxvYWQSLS5tZW1vcy5hcGkudjEuQ29tcGxldGVBdHRhY2htZW50VXBsb2FkUmVxdWVzdBoYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50Ij3aQQRuYW1lgtPkkwIwOgEqIisvYXBpL3YxL3tuYW1lPWF0dGFjaG1lbnRzLyp9OmNvbXBsZXRlVXBsb2FkEpABChNDcmVhdGVDaHVua2VkVXBsb2FkEigubWVtb3MuYXBpLnYxLkNyZWF0ZUNodW5rZWRVcGxvYWRSZXF1ZXN0GhsubWVtb3MuYXBpLnYxLkNodW5rZWRVcGxvYWQiMoLT5JMCLDoBKiInL2FwaS92MS9hdHRhY2htZW50czpjcmVhdGVDaHVua2VkVXBsb2FkEpABChVVcGxvYWRBdHRhY2htZW50Q2h1bmsSKi5tZW1vcy5hcGkudjEuVXBsb2FkQXR0YWNobWVudENodW5rUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIzgtPkkwItOgEqIigvYXBpL3YxL3tuYW1lPWF0dGFjaG1lbnRzLyp9OnVwbG9hZENodW5rEpEBChBHZXRDaHVua2VkVXBsb2FkEiUubWVtb3MuYXBpLnYxLkdldENodW5rZWRVcGxvYWRSZXF1ZXN0GhsubWVtb3MuYXBpLnYxLkNodW5rZWRVcGxvYWQiOdpBBG5hbWWC0+STAiwSKi9hcGkvdjEve25hbWU9YXR0YWNobWVudHMvKn06Y2h1bmtlZFVwbG9hZBKjAQoVQ29tcGxldGVDaHVua2VkVXBsb2FkEioubWVtb3MuYXBpLnYxLkNvbXBsZXRlQ2h1bmtlZFVwbG9hZFJlcXVlc3QaGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudCJE2kEEbmFtZYLT5JMCNzoBKiIyL2FwaS92MS97bmFtZT1hdHRhY2htZW50cy8qfTpjb21wbGV0ZUNodW5rZWRVcGxvYWQSewoPTGlzdEF0dGFjaG1lbnRzEiQubWVtb3MuYXBpLnYxLkxpc3RBdHRhY2htZW50c1JlcXVlc3QaJS5tZW1vcy5hcGkudjEuTGlzdEF0dGFjaG1lbnRzUmVzcG9uc2UiG4LT5JMCFRITL2FwaS92MS9hdHRhY2htZW50cxJ6Cg1HZXRBdHRhY2htZW50EiIubWVtb3MuYXBpLnYxLkdldEF0dGFjaG1lbnRSZXF1ZXN0GhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnQiK9pBBG5hbWWC0+STAh4SHC9hcGkvdjEve25hbWU9YXR0YWNobWVudHMvKn0SqQEKEFVwZGF0ZUF0dGFjaG1lbnQSJS5tZW1vcy5hcGkudjEuVXBkYXRlQXR0YWNobWVudFJlcXVlc3QaGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudCJU2kEWYXR0YWNobWVudCx1cGRhdGVfbWFza4LT5JMCNToKYXR0YWNobWVudDInL2FwaS92MS97YXR0YWNobWVudC5uYW1lPWF0dGFjaG1lbnRzLyp9En4KEERlbGV0ZUF0dGFjaG1lbnQSJS5tZW1vcy5hcGkudjEuRGVsZXRlQXR0YWNobWVudFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiK9pBBG5hbWWC0+STAh4qHC9hcGkvdjEve25hbWU9YXR0YWNobWVudHMvKn0SpwEKGFB1cmdlT3JwaGFuZWRBdHRhY2htZW50cxItLm1lbW9zLmFwaS52MS5QdXJnZU9ycGhhbmVkQXR0YWNobWVudHNSZXF1ZXN0Gi4ubWVtb3MuYXBpLnYxLlB1cmdlT3JwaGFuZWRBdHRhY2htZW50c1Jlc3BvbnNlIiyC0+STAiY6ASoiIS9hcGkvdjEvYXR0YWNobWVudHM6cHVyZ2VPcnBoYW5lZBKfAQoWRGVkdXBsaWNhdGVBdHRhY2htZW50cxIrLm1lbW9zLmFwaS52MS5EZWR1cGxpY2F0ZUF0dGFjaG1lbnRzUmVxdWVzdBosLm1lbW9zLmFwaS52MS5EZWR1cGxpY2F0ZUF0dGFjaG1lbnRzUmVzcG9uc2UiKoLT5JMCJDoBKiIfL2FwaS92MS9hdHRhY2htZW50czpkZWR1cGxpY2F0ZRKlAQoZUmVmcmVzaEV4dGVybmFsQXR0YWNobWVudBIuLm1lbW9zLmFwaS52MS5SZWZyZXNoRXh0ZXJuYWxBdHRhY2htZW50UmVxdWVzdBoYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50Ij7aQQRuYW1lgtPkkwIxOgEqIiwvYXBpL3YxL3tuYW1lPWF0dGFjaG1lbnRzLyp9OnJlZnJlc2hFeHRlcm5hbBKuAQoXUmVjYWxjdWxhdGVTdG9yYWdlVXNhZ2USLC5tZW1vcy5hcGkudjEuUmVjYWxjdWxhdGVTdG9yYWdlVXNhZ2VSZXF1ZXN0Gi0ubWVtb3MuYXBpLnYxLlJlY2FsY3VsYXRlU3RvcmFnZVVzYWdlUmVzcG9uc2UiNoLT5JMCMDoBKiIrL2FwaS92MS9hdHRhY2htZW50czpyZWNhbGN1bGF0ZVN0b3JhZ2VVc2FnZUKuAQoQY29tLm1lbW9zLmFwaS52MUIWQXR0YWNobWVudFNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Attachment
@@ -52,7 +52,9 @@ export type Attachment = Message<"memos.api.v1.Attachment"> & {
   content: Uint8Array;
 
   /**
-   * Optional. The external link of the attachment.
+   * Optional. The external link of the attachment, created instead of uploading the content.
+   * The link must respond when the attachment is created, and its type and size are taken from
+   * the response when it declares them. Links to internal addresses are rejected.
    *
    * @generated from field: string external_link = 5;
    */
@@ -79,6 +81,45 @@ export type Attachment = Message<"memos.api.v1.Attachment"> & {
    * @generated from field: optional string memo = 8;
    */
   memo?: string;
+
+  /**
+   * Optional. Whether the attachment is a library upload, kept without a memo. Attachments
+   * without a memo that aren't library uploads are purged after a grace period.
+   *
+   * @generated from field: bool library = 9;
+   */
+  library: boolean;
+
+  /**
+   * Output only. The size in bytes of the uploaded image before it was compressed, or 0 if the
+   * attachment is stored as uploaded.
+   *
+   * @generated from field: int64 original_size = 10;
+   */
+  originalSize: bigint;
+
+  /**
+   * Output only. The duration of an audio attachment, unset if it couldn't be read from the
+   * audio container or the audio was uploaded directly to S3.
+   *
+   * @generated from field: google.protobuf.Duration duration = 11;
+   */
+  duration?: Duration;
+
+  /**
+   * Output only. Whether the external link of the attachment answered 404 or 410 when it was
+   * last checked.
+   *
+   * @generated from field: bool broken = 12;
+   */
+  broken: boolean;
+
+  /**
+   * Output only. The last time the external link of the attachment was checked.
+   *
+   * @generated from field: google.protobuf.Timestamp link_check_time = 13;
+   */
+  linkCheckTime?: Timestamp;
 };
 
 /**
@@ -115,6 +156,283 @@ export type CreateAttachmentRequest = Message<"memos.api.v1.CreateAttachmentRequ
 export const CreateAttachmentRequestSchema: GenMessage<CreateAttachmentRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_attachment_service, 1);
 
+/**
+ * @generated from message memos.api.v1.CreateAttachmentUploadRequest
+ */
+export type CreateAttachmentUploadRequest = Message<"memos.api.v1.CreateAttachmentUploadRequest"> & {
+  /**
+   * Required. The attachment to upload, without its content.
+   *
+   * @generated from field: memos.api.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  /**
+   * Required. The size of the content in bytes, which the upload must match.
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * Optional. The attachment ID to use for this attachment.
+   * If empty, a unique ID will be generated.
+   *
+   * @generated from field: string attachment_id = 3;
+   */
+  attachmentId: string;
+
+  /**
+   * Optional. How long the upload URL is valid in seconds, at most an hour.
+   * If unspecified, the upload URL is valid for 15 minutes.
+   *
+   * @generated from field: int32 expire_seconds = 4;
+   */
+  expireSeconds: number;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateAttachmentUploadRequest.
+ * Use `create(CreateAttachmentUploadRequestSchema)` to create a new message.
+ */
+export const CreateAttachmentUploadRequestSchema: GenMessage<CreateAttachmentUploadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 2);
+
+/**
+ * @generated from message memos.api.v1.CreateAttachmentUploadResponse
+ */
+export type CreateAttachmentUploadResponse = Message<"memos.api.v1.CreateAttachmentUploadResponse"> & {
+  /**
+   * The pending attachment, which is completed by CompleteAttachmentUpload. Pending attachments
+   * that aren't completed within two days are deleted.
+   * Unset if the content must be uploaded with CreateAttachment.
+   *
+   * @generated from field: memos.api.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  /**
+   * The presigned URL to upload the content to, with a PUT request sending the type and the
+   * size of the attachment as the Content-Type and Content-Length headers.
+   *
+   * @generated from field: string upload_url = 2;
+   */
+  uploadUrl: string;
+
+  /**
+   * The expiration time of the upload URL.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 3;
+   */
+  expireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateAttachmentUploadResponse.
+ * Use `create(CreateAttachmentUploadResponseSchema)` to create a new message.
+ */
+export const CreateAttachmentUploadResponseSchema: GenMessage<CreateAttachmentUploadResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CompleteAttachmentUploadRequest
+ */
+export type CompleteAttachmentUploadRequest = Message<"memos.api.v1.CompleteAttachmentUploadRequest"> & {
+  /**
+   * Required. The name of the pending attachment.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CompleteAttachmentUploadRequest.
+ * Use `create(CompleteAttachmentUploadRequestSchema)` to create a new message.
+ */
+export const CompleteAttachmentUploadRequestSchema: GenMessage<CompleteAttachmentUploadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 4);
+
+/**
+ * @generated from message memos.api.v1.CreateChunkedUploadRequest
+ */
+export type CreateChunkedUploadRequest = Message<"memos.api.v1.CreateChunkedUploadRequest"> & {
+  /**
+   * Required. The attachment to upload, without its content.
+   *
+   * @generated from field: memos.api.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+
+  /**
+   * Required. The size of the content in bytes.
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * Required. The hex-encoded SHA-256 of the content, which the assembled chunks must match.
+   *
+   * @generated from field: string content_hash = 3;
+   */
+  contentHash: string;
+
+  /**
+   * Optional. The attachment ID to use for this attachment.
+   * If empty, a unique ID will be generated.
+   *
+   * @generated from field: string attachment_id = 4;
+   */
+  attachmentId: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateChunkedUploadRequest.
+ * Use `create(CreateChunkedUploadRequestSchema)` to create a new message.
+ */
+export const CreateChunkedUploadRequestSchema: GenMessage<CreateChunkedUploadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 5);
+
+/**
+ * ChunkedUpload is the status of a chunked upload.
+ *
+ * @generated from message memos.api.v1.ChunkedUpload
+ */
+export type ChunkedUpload = Message<"memos.api.v1.ChunkedUpload"> & {
+  /**
+   * The name of the pending attachment.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The size of the content in bytes.
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * The size of the chunks in bytes. Only the last chunk may be smaller.
+   *
+   * @generated from field: int64 chunk_size = 3;
+   */
+  chunkSize: bigint;
+
+  /**
+   * The number of chunks, indexed from 0.
+   *
+   * @generated from field: int32 chunk_count = 4;
+   */
+  chunkCount: number;
+
+  /**
+   * The indexes of the chunks left to upload.
+   *
+   * @generated from field: repeated int32 missing_chunks = 5;
+   */
+  missingChunks: number[];
+
+  /**
+   * The time after which the upload can't be resumed, and is deleted.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 6;
+   */
+  expireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.ChunkedUpload.
+ * Use `create(ChunkedUploadSchema)` to create a new message.
+ */
+export const ChunkedUploadSchema: GenMessage<ChunkedUpload> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 6);
+
+/**
+ * @generated from message memos.api.v1.UploadAttachmentChunkRequest
+ */
+export type UploadAttachmentChunkRequest = Message<"memos.api.v1.UploadAttachmentChunkRequest"> & {
+  /**
+   * Required. The name of the pending attachment.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The index of the chunk, from 0.
+   *
+   * @generated from field: int32 index = 2;
+   */
+  index: number;
+
+  /**
+   * Required. The content of the chunk.
+   *
+   * @generated from field: bytes content = 3;
+   */
+  content: Uint8Array;
+
+  /**
+   * Required. The hex-encoded SHA-256 of the content of the chunk.
+   *
+   * @generated from field: string checksum = 4;
+   */
+  checksum: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UploadAttachmentChunkRequest.
+ * Use `create(UploadAttachmentChunkRequestSchema)` to create a new message.
+ */
+export const UploadAttachmentChunkRequestSchema: GenMessage<UploadAttachmentChunkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 7);
+
+/**
+ * @generated from message memos.api.v1.GetChunkedUploadRequest
+ */
+export type GetChunkedUploadRequest = Message<"memos.api.v1.GetChunkedUploadRequest"> & {
+  /**
+   * Required. The name of the pending attachment.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetChunkedUploadRequest.
+ * Use `create(GetChunkedUploadRequestSchema)` to create a new message.
+ */
+export const GetChunkedUploadRequestSchema: GenMessage<GetChunkedUploadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 8);
+
+/**
+ * @generated from message memos.api.v1.CompleteChunkedUploadRequest
+ */
+export type CompleteChunkedUploadRequest = Message<"memos.api.v1.CompleteChunkedUploadRequest"> & {
+  /**
+   * Required. The name of the pending attachment.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CompleteChunkedUploadRequest.
+ * Use `create(CompleteChunkedUploadRequestSchema)` to create a new message.
+ */
+export const CompleteChunkedUploadRequestSchema: GenMessage<CompleteChunkedUploadRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 9);
+
 /**
  * @generated from message memos.api.v1.ListAttachmentsRequest
  */
@@ -138,10 +456,12 @@ export type ListAttachmentsRequest = Message<"memos.api.v1.ListAttachmentsReques
   pageToken: string;
 
   /**
-   * Optional. Filter to apply to the list results.
-   * Example: "type=image/png" or "filename:*.jpg"
-   * Supported operators: =, !=, <, <=, >, >=, :
-   * Supported fields: filename, type, size, create_time, memo
+   * Optional. A CEL expression to filter the attachments by, as a conjunction with `&&` of:
+   * - `filename.contains("report")` for a filename substring;
+   * - `mime_type.startsWith("image/")` for a MIME type prefix;
+   * - `size` compared with `<`, `<=`, `>`, `>=` or `==` to a number of bytes;
+   * - `create_time` compared to a timestamp, as `create_time >= timestamp("2025-03-01T00:00:00Z")`;
+   * - `has_memo` or `!has_memo` for whether the attachment is attached to a memo.
    *
    * @generated from field: string filter = 3;
    */
@@ -154,6 +474,14 @@ export type ListAttachmentsRequest = Message<"memos.api.v1.ListAttachmentsReques
    * @generated from field: string order_by = 4;
    */
   orderBy: string;
+
+  /**
+   * Optional. Whether to list the attachments of all users instead of the caller's. Only the host
+   * can list the attachments of all users.
+   *
+   * @generated from field: bool all_users = 5;
+   */
+  allUsers: boolean;
 };
 
 /**
@@ -161,7 +489,7 @@ export type ListAttachmentsRequest = Message<"memos.api.v1.ListAttachmentsReques
  * Use `create(ListAttachmentsRequestSchema)` to create a new message.
  */
 export const ListAttachmentsRequestSchema: GenMessage<ListAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_attachment_service, 2);
+  messageDesc(file_api_v1_attachment_service, 10);
 
 /**
  * @generated from message memos.api.v1.ListAttachmentsResponse
@@ -183,7 +511,7 @@ export type ListAttachmentsResponse = Message<"memos.api.v1.ListAttachmentsRespo
   nextPageToken: string;
 
   /**
-   * The total count of attachments (may be approximate).
+   * The total count of the attachments matching the filter.
    *
    * @generated from field: int32 total_size = 3;
    */
@@ -195,7 +523,27 @@ export type ListAttachmentsResponse = Message<"memos.api.v1.ListAttachmentsRespo
  * Use `create(ListAttachmentsResponseSchema)` to create a new message.
  */
 export const ListAttachmentsResponseSchema: GenMessage<ListAttachmentsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_attachment_service, 3);
+  messageDesc(file_api_v1_attachment_service, 11);
+
+/**
+ * @generated from message memos.api.v1.RefreshExternalAttachmentRequest
+ */
+export type RefreshExternalAttachmentRequest = Message<"memos.api.v1.RefreshExternalAttachmentRequest"> & {
+  /**
+   * Required. The name of the external attachment.
+   * Format: attachments/{attachment}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RefreshExternalAttachmentRequest.
+ * Use `create(RefreshExternalAttachmentRequestSchema)` to create a new message.
+ */
+export const RefreshExternalAttachmentRequestSchema: GenMessage<RefreshExternalAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 12);
 
 /**
  * @generated from message memos.api.v1.GetAttachmentRequest
@@ -215,7 +563,7 @@ export type GetAttachmentRequest = Message<"memos.api.v1.GetAttachmentRequest">
  * Use `create(GetAttachmentRequestSchema)` to create a new message.
  */
 export const GetAttachmentRequestSchema: GenMessage<GetAttachmentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_attachment_service, 4);
+  messageDesc(file_api_v1_attachment_service, 13);
 
 /**
  * @generated from message memos.api.v1.UpdateAttachmentRequest
@@ -241,7 +589,7 @@ export type UpdateAttachmentRequest = Message<"memos.api.v1.UpdateAttachmentRequ
  * Use `create(UpdateAttachmentRequestSchema)` to create a new message.
  */
 export const UpdateAttachmentRequestSchema: GenMessage<UpdateAttachmentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_attachment_service, 5);
+  messageDesc(file_api_v1_attachment_service, 14);
 
 /**
  * @generated from message memos.api.v1.DeleteAttachmentRequest
@@ -261,7 +609,131 @@ export type DeleteAttachmentRequest = Message<"memos.api.v1.DeleteAttachmentRequ
  * Use `create(DeleteAttachmentRequestSchema)` to create a new message.
  */
 export const DeleteAttachmentRequestSchema: GenMessage<DeleteAttachmentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_attachment_service, 6);
+  messageDesc(file_api_v1_attachment_service, 15);
+
+/**
+ * @generated from message memos.api.v1.PurgeOrphanedAttachmentsRequest
+ */
+export type PurgeOrphanedAttachmentsRequest = Message<"memos.api.v1.PurgeOrphanedAttachmentsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.PurgeOrphanedAttachmentsRequest.
+ * Use `create(PurgeOrphanedAttachmentsRequestSchema)` to create a new message.
+ */
+export const PurgeOrphanedAttachmentsRequestSchema: GenMessage<PurgeOrphanedAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 16);
+
+/**
+ * @generated from message memos.api.v1.PurgeOrphanedAttachmentsResponse
+ */
+export type PurgeOrphanedAttachmentsResponse = Message<"memos.api.v1.PurgeOrphanedAttachmentsResponse"> & {
+  /**
+   * The number of purged attachments.
+   *
+   * @generated from field: int32 purged_count = 1;
+   */
+  purgedCount: number;
+
+  /**
+   * The total size of the purged attachments in bytes.
+   *
+   * @generated from field: int64 reclaimed_bytes = 2;
+   */
+  reclaimedBytes: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.PurgeOrphanedAttachmentsResponse.
+ * Use `create(PurgeOrphanedAttachmentsResponseSchema)` to create a new message.
+ */
+export const PurgeOrphanedAttachmentsResponseSchema: GenMessage<PurgeOrphanedAttachmentsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 17);
+
+/**
+ * @generated from message memos.api.v1.DeduplicateAttachmentsRequest
+ */
+export type DeduplicateAttachmentsRequest = Message<"memos.api.v1.DeduplicateAttachmentsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.DeduplicateAttachmentsRequest.
+ * Use `create(DeduplicateAttachmentsRequestSchema)` to create a new message.
+ */
+export const DeduplicateAttachmentsRequestSchema: GenMessage<DeduplicateAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 18);
+
+/**
+ * @generated from message memos.api.v1.DeduplicateAttachmentsResponse
+ */
+export type DeduplicateAttachmentsResponse = Message<"memos.api.v1.DeduplicateAttachmentsResponse"> & {
+  /**
+   * The number of attachments hashed.
+   *
+   * @generated from field: int32 hashed_count = 1;
+   */
+  hashedCount: number;
+
+  /**
+   * The number of attachments now sharing the file of another attachment.
+   *
+   * @generated from field: int32 deduplicated_count = 2;
+   */
+  deduplicatedCount: number;
+
+  /**
+   * The total size of the deleted duplicate files in bytes.
+   *
+   * @generated from field: int64 saved_bytes = 3;
+   */
+  savedBytes: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.DeduplicateAttachmentsResponse.
+ * Use `create(DeduplicateAttachmentsResponseSchema)` to create a new message.
+ */
+export const DeduplicateAttachmentsResponseSchema: GenMessage<DeduplicateAttachmentsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 19);
+
+/**
+ * @generated from message memos.api.v1.RecalculateStorageUsageRequest
+ */
+export type RecalculateStorageUsageRequest = Message<"memos.api.v1.RecalculateStorageUsageRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.RecalculateStorageUsageRequest.
+ * Use `create(RecalculateStorageUsageRequestSchema)` to create a new message.
+ */
+export const RecalculateStorageUsageRequestSchema: GenMessage<RecalculateStorageUsageRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 20);
+
+/**
+ * @generated from message memos.api.v1.RecalculateStorageUsageResponse
+ */
+export type RecalculateStorageUsageResponse = Message<"memos.api.v1.RecalculateStorageUsageResponse"> & {
+  /**
+   * The number of users whose storage usage was recalculated.
+   *
+   * @generated from field: int32 user_count = 1;
+   */
+  userCount: number;
+
+  /**
+   * The total storage usage of the users in bytes.
+   *
+   * @generated from field: int64 total_bytes = 2;
+   */
+  totalBytes: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.RecalculateStorageUsageResponse.
+ * Use `create(RecalculateStorageUsageResponseSchema)` to create a new message.
+ */
+export const RecalculateStorageUsageResponseSchema: GenMessage<RecalculateStorageUsageResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_attachment_service, 21);
 
 /**
  * @generated from service memos.api.v1.AttachmentService
@@ -277,6 +749,74 @@ export const AttachmentService: GenService<{
     input: typeof CreateAttachmentRequestSchema;
     output: typeof AttachmentSchema;
   },
+  /**
+   * CreateAttachmentUpload starts a direct upload of an attachment to the S3 storage, returning
+   * a pending attachment and a presigned upload URL. When the content must go through the
+   * server, as with the local storage, no upload URL is returned and the content is uploaded
+   * with CreateAttachment instead.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.CreateAttachmentUpload
+   */
+  createAttachmentUpload: {
+    methodKind: "unary";
+    input: typeof CreateAttachmentUploadRequestSchema;
+    output: typeof CreateAttachmentUploadResponseSchema;
+  },
+  /**
+   * CompleteAttachmentUpload completes a direct upload once the content is uploaded to the
+   * upload URL, checking the uploaded object.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.CompleteAttachmentUpload
+   */
+  completeAttachmentUpload: {
+    methodKind: "unary";
+    input: typeof CompleteAttachmentUploadRequestSchema;
+    output: typeof AttachmentSchema;
+  },
+  /**
+   * CreateChunkedUpload starts a resumable upload of a large attachment in chunks, returning the
+   * chunk size. The pending attachment is completed by CompleteChunkedUpload once all the chunks
+   * are uploaded.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.CreateChunkedUpload
+   */
+  createChunkedUpload: {
+    methodKind: "unary";
+    input: typeof CreateChunkedUploadRequestSchema;
+    output: typeof ChunkedUploadSchema;
+  },
+  /**
+   * UploadAttachmentChunk uploads a chunk of a chunked upload, replacing a previous upload of
+   * the chunk.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.UploadAttachmentChunk
+   */
+  uploadAttachmentChunk: {
+    methodKind: "unary";
+    input: typeof UploadAttachmentChunkRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetChunkedUpload returns the status of a chunked upload, with the chunks left to upload.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.GetChunkedUpload
+   */
+  getChunkedUpload: {
+    methodKind: "unary";
+    input: typeof GetChunkedUploadRequestSchema;
+    output: typeof ChunkedUploadSchema;
+  },
+  /**
+   * CompleteChunkedUpload assembles the chunks of a chunked upload into the attachment, checking
+   * the content hash.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.CompleteChunkedUpload
+   */
+  completeChunkedUpload: {
+    methodKind: "unary";
+    input: typeof CompleteChunkedUploadRequestSchema;
+    output: typeof AttachmentSchema;
+  },
   /**
    * ListAttachments lists all attachments.
    *
@@ -317,6 +857,53 @@ export const AttachmentService: GenService<{
     input: typeof DeleteAttachmentRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * PurgeOrphanedAttachments deletes the attachments without a memo older than the grace
+   * period of the storage setting, except library uploads.
+   * Only admins can purge orphaned attachments.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.PurgeOrphanedAttachments
+   */
+  purgeOrphanedAttachments: {
+    methodKind: "unary";
+    input: typeof PurgeOrphanedAttachmentsRequestSchema;
+    output: typeof PurgeOrphanedAttachmentsResponseSchema;
+  },
+  /**
+   * DeduplicateAttachments hashes the local files of the attachments uploaded before the content
+   * hashes, and makes the attachments of a user with the same content share a single file.
+   * Only admins can deduplicate attachments.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.DeduplicateAttachments
+   */
+  deduplicateAttachments: {
+    methodKind: "unary";
+    input: typeof DeduplicateAttachmentsRequestSchema;
+    output: typeof DeduplicateAttachmentsResponseSchema;
+  },
+  /**
+   * RefreshExternalAttachment checks again that the external link of an attachment responds,
+   * marking the attachment as broken when its file is gone.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.RefreshExternalAttachment
+   */
+  refreshExternalAttachment: {
+    methodKind: "unary";
+    input: typeof RefreshExternalAttachmentRequestSchema;
+    output: typeof AttachmentSchema;
+  },
+  /**
+   * RecalculateStorageUsage recomputes the storage usages of all users from their attachments,
+   * fixing a drift of the usages counted on upload and delete.
+   * Only admins can recalculate the storage usages.
+   *
+   * @generated from rpc memos.api.v1.AttachmentService.RecalculateStorageUsage
+   */
+  recalculateStorageUsage: {
+    methodKind: "unary";
+    input: typeof RecalculateStorageUsageRequestSchema;
+    output: typeof RecalculateStorageUsageResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_attachment_service, 0);
 
@@ -8,15 +8,15 @@ import type { User } from "./user_service_pb";
 import { file_api_v1_user_service } from "./user_service_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
-import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/auth_service.proto.
  */
 export const file_api_v1_auth_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvYXV0aF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiGgoYR2V0Q3VycmVudFNlc3Npb25SZXF1ZXN0InMKGUdldEN1cnJlbnRTZXNzaW9uUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyEjQKEGxhc3RfYWNjZXNzZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoEDChRDcmVhdGVTZXNzaW9uUmVxdWVzdBJWChRwYXNzd29yZF9jcmVkZW50aWFscxgBIAEoCzI2Lm1lbW9zLmFwaS52MS5DcmVhdGVTZXNzaW9uUmVxdWVzdC5QYXNzd29yZENyZWRlbnRpYWxzSAASTAoPc3NvX2NyZWRlbnRpYWxzGAIgASgLMjEubWVtb3MuYXBpLnYxLkNyZWF0ZVNlc3Npb25SZXF1ZXN0LlNTT0NyZWRlbnRpYWxzSAAaQwoTUGFzc3dvcmRDcmVkZW50aWFscxIVCgh1c2VybmFtZRgBIAEoCUID4EECEhUKCHBhc3N3b3JkGAIgASgJQgPgQQIabwoOU1NPQ3JlZGVudGlhbHMSEwoGaWRwX2lkGAEgASgFQgPgQQISEQoEY29kZRgCIAEoCUID4EECEhkKDHJlZGlyZWN0X3VyaRgDIAEoCUID4EECEhoKDWNvZGVfdmVyaWZpZXIYBCABKAlCA+BBAUINCgtjcmVkZW50aWFscyJvChVDcmVhdGVTZXNzaW9uUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyEjQKEGxhc3RfYWNjZXNzZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhYKFERlbGV0ZVNlc3Npb25SZXF1ZXN0MosDCgtBdXRoU2VydmljZRKLAQoRR2V0Q3VycmVudFNlc3Npb24SJi5tZW1vcy5hcGkudjEuR2V0Q3VycmVudFNlc3Npb25SZXF1ZXN0GicubWVtb3MuYXBpLnYxLkdldEN1cnJlbnRTZXNzaW9uUmVzcG9uc2UiJYLT5JMCHxIdL2FwaS92MS9hdXRoL3Nlc3Npb25zL2N1cnJlbnQSegoNQ3JlYXRlU2Vzc2lvbhIiLm1lbW9zLmFwaS52MS5DcmVhdGVTZXNzaW9uUmVxdWVzdBojLm1lbW9zLmFwaS52MS5DcmVhdGVTZXNzaW9uUmVzcG9uc2UiIILT5JMCGjoBKiIVL2FwaS92MS9hdXRoL3Nlc3Npb25zEnIKDURlbGV0ZVNlc3Npb24SIi5tZW1vcy5hcGkudjEuRGVsZXRlU2Vzc2lvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJYLT5JMCHyodL2FwaS92MS9hdXRoL3Nlc3Npb25zL2N1cnJlbnRCqAEKEGNvbS5tZW1vcy5hcGkudjFCEEF1dGhTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_user_service, file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvYXV0aF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiGgoYR2V0Q3VycmVudFNlc3Npb25SZXF1ZXN0It4BChlHZXRDdXJyZW50U2Vzc2lvblJlc3BvbnNlEiAKBHVzZXIYASABKAsyEi5tZW1vcy5hcGkudjEuVXNlchI0ChBsYXN0X2FjY2Vzc2VkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgxpZGxlX3RpbWVvdXQYAyABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SOAoUYWJzb2x1dGVfZXhwaXJlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpoGChRDcmVhdGVTZXNzaW9uUmVxdWVzdBJWChRwYXNzd29yZF9jcmVkZW50aWFscxgBIAEoCzI2Lm1lbW9zLmFwaS52MS5DcmVhdGVTZXNzaW9uUmVxdWVzdC5QYXNzd29yZENyZWRlbnRpYWxzSAASTAoPc3NvX2NyZWRlbnRpYWxzGAIgASgLMjEubWVtb3MuYXBpLnYxLkNyZWF0ZVNlc3Npb25SZXF1ZXN0LlNTT0NyZWRlbnRpYWxzSAASWQoWdHdvX2ZhY3Rvcl9jcmVkZW50aWFscxgDIAEoCzI3Lm1lbW9zLmFwaS52MS5DcmVhdGVTZXNzaW9uUmVxdWVzdC5Ud29GYWN0b3JDcmVkZW50aWFsc0gAElQKE3Bhc3NrZXlfY3JlZGVudGlhbHMYBCABKAsyNS5tZW1vcy5hcGkudjEuQ3JlYXRlU2Vzc2lvblJlcXVlc3QuUGFzc2tleUNyZWRlbnRpYWxzSAAaQwoTUGFzc3dvcmRDcmVkZW50aWFscxIVCgh1c2VybmFtZRgBIAEoCUID4EECEhUKCHBhc3N3b3JkGAIgASgJQgPgQQIabwoOU1NPQ3JlZGVudGlhbHMSEwoGaWRwX2lkGAEgASgFQgPgQQISEQoEY29kZRgCIAEoCUID4EECEhkKDHJlZGlyZWN0X3VyaRgDIAEoCUID4EECEhoKDWNvZGVfdmVyaWZpZXIYBCABKAlCA+BBARpBChRUd29GYWN0b3JDcmVkZW50aWFscxIWCgljaGFsbGVuZ2UYASABKAlCA+BBAhIRCgRjb2RlGAIgASgJQgPgQQIaogEKElBhc3NrZXlDcmVkZW50aWFscxIaCg1jcmVkZW50aWFsX2lkGAEgASgMQgPgQQISHQoQY2xpZW50X2RhdGFfanNvbhgCIAEoDEID4EECEh8KEmF1dGhlbnRpY2F0b3JfZGF0YRgDIAEoDEID4EECEhYKCXNpZ25hdHVyZRgEIAEoDEID4EECEhgKC3VzZXJfaGFuZGxlGAUgASgMQgPgQQJCDQoLY3JlZGVudGlhbHMi+AEKFUNyZWF0ZVNlc3Npb25SZXNwb25zZRIgCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXISNAoQbGFzdF9hY2Nlc3NlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoUdHdvX2ZhY3Rvcl9jaGFsbGVuZ2UYAyABKAkSLwoMaWRsZV90aW1lb3V0GAQgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEjgKFGFic29sdXRlX2V4cGlyZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIfCh1DcmVhdGVQYXNza2V5Q2hhbGxlbmdlUmVxdWVzdCI0ChBQYXNza2V5Q2hhbGxlbmdlEhEKCWNoYWxsZW5nZRgBIAEoDBINCgVycF9pZBgCIAEoCSIXChVSZWZyZXNoU2Vzc2lvblJlcXVlc3QieAoWUmVmcmVzaFNlc3Npb25SZXNwb25zZRIgCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXISPAoYYWNjZXNzX3Rva2VuX2V4cGlyZV90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIWChREZWxldGVTZXNzaW9uUmVxdWVzdCI2ChtSZXF1ZXN0UGFzc3dvcmRSZXNldFJlcXVlc3QSFwoKaWRlbnRpZmllchgBIAEoCUID4EECIkUKFFJlc2V0UGFzc3dvcmRSZXF1ZXN0EhIKBXRva2VuGAEgASgJQgPgQQISGQoMbmV3X3Bhc3N3b3JkGAIgASgJQgPgQQIyrQcKC0F1dGhTZXJ2aWNlEosBChFHZXRDdXJyZW50U2Vzc2lvbhImLm1lbW9zLmFwaS52MS5HZXRDdXJyZW50U2Vzc2lvblJlcXVlc3QaJy5tZW1vcy5hcGkudjEuR2V0Q3VycmVudFNlc3Npb25SZXNwb25zZSIlgtPkkwIfEh0vYXBpL3YxL2F1dGgvc2Vzc2lvbnMvY3VycmVudBJ6Cg1DcmVhdGVTZXNzaW9uEiIubWVtb3MuYXBpLnYxLkNyZWF0ZVNlc3Npb25SZXF1ZXN0GiMubWVtb3MuYXBpLnYxLkNyZWF0ZVNlc3Npb25SZXNwb25zZSIggtPkkwIaOgEqIhUvYXBpL3YxL2F1dGgvc2Vzc2lvbnMSkAEKFkNyZWF0ZVBhc3NrZXlDaGFsbGVuZ2USKy5tZW1vcy5hcGkudjEuQ3JlYXRlUGFzc2tleUNoYWxsZW5nZVJlcXVlc3QaHi5tZW1vcy5hcGkudjEuUGFzc2tleUNoYWxsZW5nZSIpgtPkkwIjOgEqIh4vYXBpL3YxL2F1dGgvcGFzc2tleUNoYWxsZW5nZXMSjQEKDlJlZnJlc2hTZXNzaW9uEiMubWVtb3MuYXBpLnYxLlJlZnJlc2hTZXNzaW9uUmVxdWVzdBokLm1lbW9zLmFwaS52MS5SZWZyZXNoU2Vzc2lvblJlc3BvbnNlIjCC0+STAio6ASoiJS9hcGkvdjEvYXV0aC9zZXNzaW9ucy9jdXJyZW50OnJlZnJlc2gScgoNRGVsZXRlU2Vzc2lvbhIiLm1lbW9zLmFwaS52MS5EZWxldGVTZXNzaW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIlgtPkkwIfKh0vYXBpL3YxL2F1dGgvc2Vzc2lvbnMvY3VycmVudBKBAQoUUmVxdWVzdFBhc3N3b3JkUmVzZXQSKS5tZW1vcy5hcGkudjEuUmVxdWVzdFBhc3N3b3JkUmVzZXRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiaC0+STAiA6ASoiGy9hcGkvdjEvYXV0aC9wYXNzd29yZFJlc2V0cxJ5Cg1SZXNldFBhc3N3b3JkEiIubWVtb3MuYXBpLnYxLlJlc2V0UGFzc3dvcmRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiyC0+STAiY6ASoiIS9hcGkvdjEvYXV0aC9wYXNzd29yZFJlc2V0czpyZXNldEKoAQoQY29tLm1lbW9zLmFwaS52MUIQQXV0aFNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_user_service, file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.GetCurrentSessionRequest
@@ -42,11 +42,26 @@ export type GetCurrentSessionResponse = Message<"memos.api.v1.GetCurrentSessionR
 
   /**
    * Last time the session was accessed.
-   * Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+   * Used for sliding expiration calculation (last_accessed_time + idle_timeout).
    *
    * @generated from field: google.protobuf.Timestamp last_accessed_at = 2;
    */
   lastAccessedAt?: Timestamp;
+
+  /**
+   * How long the session stays valid without being used.
+   *
+   * @generated from field: google.protobuf.Duration idle_timeout = 3;
+   */
+  idleTimeout?: Duration;
+
+  /**
+   * When the session expires however often it is used.
+   * Unset if the instance doesn't cap the session lifetime.
+   *
+   * @generated from field: google.protobuf.Timestamp absolute_expire_time = 4;
+   */
+  absoluteExpireTime?: Timestamp;
 };
 
 /**
@@ -61,7 +76,7 @@ export const GetCurrentSessionResponseSchema: GenMessage<GetCurrentSessionRespon
  */
 export type CreateSessionRequest = Message<"memos.api.v1.CreateSessionRequest"> & {
   /**
-   * Provide one authentication method (username/password or SSO).
+   * Provide one authentication method (username/password, SSO, two-factor or passkey).
    * Required field to specify the authentication method.
    *
    * @generated from oneof memos.api.v1.CreateSessionRequest.credentials
@@ -82,6 +97,22 @@ export type CreateSessionRequest = Message<"memos.api.v1.CreateSessionRequest">
      */
     value: CreateSessionRequest_SSOCredentials;
     case: "ssoCredentials";
+  } | {
+    /**
+     * Two-factor code completing a password or SSO sign-in.
+     *
+     * @generated from field: memos.api.v1.CreateSessionRequest.TwoFactorCredentials two_factor_credentials = 3;
+     */
+    value: CreateSessionRequest_TwoFactorCredentials;
+    case: "twoFactorCredentials";
+  } | {
+    /**
+     * Passkey assertion for a challenge from CreatePasskeyChallenge.
+     *
+     * @generated from field: memos.api.v1.CreateSessionRequest.PasskeyCredentials passkey_credentials = 4;
+     */
+    value: CreateSessionRequest_PasskeyCredentials;
+    case: "passkeyCredentials";
   } | { case: undefined; value?: undefined };
 };
 
@@ -168,6 +199,84 @@ export type CreateSessionRequest_SSOCredentials = Message<"memos.api.v1.CreateSe
 export const CreateSessionRequest_SSOCredentialsSchema: GenMessage<CreateSessionRequest_SSOCredentials> = /*@__PURE__*/
   messageDesc(file_api_v1_auth_service, 2, 1);
 
+/**
+ * Nested message for the second step of two-factor authentication.
+ *
+ * @generated from message memos.api.v1.CreateSessionRequest.TwoFactorCredentials
+ */
+export type CreateSessionRequest_TwoFactorCredentials = Message<"memos.api.v1.CreateSessionRequest.TwoFactorCredentials"> & {
+  /**
+   * The challenge returned by the first step in CreateSessionResponse.
+   *
+   * @generated from field: string challenge = 1;
+   */
+  challenge: string;
+
+  /**
+   * A 6-digit code from the authenticator app, or a recovery code.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateSessionRequest.TwoFactorCredentials.
+ * Use `create(CreateSessionRequest_TwoFactorCredentialsSchema)` to create a new message.
+ */
+export const CreateSessionRequest_TwoFactorCredentialsSchema: GenMessage<CreateSessionRequest_TwoFactorCredentials> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 2, 2);
+
+/**
+ * Nested message for a WebAuthn passkey assertion.
+ * Fields hold the raw bytes of the PublicKeyCredential returned by navigator.credentials.get().
+ *
+ * @generated from message memos.api.v1.CreateSessionRequest.PasskeyCredentials
+ */
+export type CreateSessionRequest_PasskeyCredentials = Message<"memos.api.v1.CreateSessionRequest.PasskeyCredentials"> & {
+  /**
+   * The credential ID (PublicKeyCredential.rawId).
+   *
+   * @generated from field: bytes credential_id = 1;
+   */
+  credentialId: Uint8Array;
+
+  /**
+   * The client data JSON (AuthenticatorAssertionResponse.clientDataJSON).
+   *
+   * @generated from field: bytes client_data_json = 2;
+   */
+  clientDataJson: Uint8Array;
+
+  /**
+   * The authenticator data (AuthenticatorAssertionResponse.authenticatorData).
+   *
+   * @generated from field: bytes authenticator_data = 3;
+   */
+  authenticatorData: Uint8Array;
+
+  /**
+   * The assertion signature (AuthenticatorAssertionResponse.signature).
+   *
+   * @generated from field: bytes signature = 4;
+   */
+  signature: Uint8Array;
+
+  /**
+   * The user handle (AuthenticatorAssertionResponse.userHandle).
+   *
+   * @generated from field: bytes user_handle = 5;
+   */
+  userHandle: Uint8Array;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateSessionRequest.PasskeyCredentials.
+ * Use `create(CreateSessionRequest_PasskeyCredentialsSchema)` to create a new message.
+ */
+export const CreateSessionRequest_PasskeyCredentialsSchema: GenMessage<CreateSessionRequest_PasskeyCredentials> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 2, 3);
+
 /**
  * @generated from message memos.api.v1.CreateSessionResponse
  */
@@ -181,11 +290,34 @@ export type CreateSessionResponse = Message<"memos.api.v1.CreateSessionResponse"
 
   /**
    * Last time the session was accessed.
-   * Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+   * Used for sliding expiration calculation (last_accessed_time + idle_timeout).
    *
    * @generated from field: google.protobuf.Timestamp last_accessed_at = 2;
    */
   lastAccessedAt?: Timestamp;
+
+  /**
+   * Set instead of user when the account has two-factor authentication enabled.
+   * No session is created until the challenge is passed back with a code in two_factor_credentials.
+   *
+   * @generated from field: string two_factor_challenge = 3;
+   */
+  twoFactorChallenge: string;
+
+  /**
+   * How long the session stays valid without being used.
+   *
+   * @generated from field: google.protobuf.Duration idle_timeout = 4;
+   */
+  idleTimeout?: Duration;
+
+  /**
+   * When the session expires however often it is used.
+   * Unset if the instance doesn't cap the session lifetime.
+   *
+   * @generated from field: google.protobuf.Timestamp absolute_expire_time = 5;
+   */
+  absoluteExpireTime?: Timestamp;
 };
 
 /**
@@ -195,6 +327,87 @@ export type CreateSessionResponse = Message<"memos.api.v1.CreateSessionResponse"
 export const CreateSessionResponseSchema: GenMessage<CreateSessionResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_auth_service, 3);
 
+/**
+ * @generated from message memos.api.v1.CreatePasskeyChallengeRequest
+ */
+export type CreatePasskeyChallengeRequest = Message<"memos.api.v1.CreatePasskeyChallengeRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.CreatePasskeyChallengeRequest.
+ * Use `create(CreatePasskeyChallengeRequestSchema)` to create a new message.
+ */
+export const CreatePasskeyChallengeRequestSchema: GenMessage<CreatePasskeyChallengeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 4);
+
+/**
+ * PasskeyChallenge holds the WebAuthn request options for a passkey sign-in.
+ * Sign-in uses discoverable credentials, so no credential IDs are listed.
+ *
+ * @generated from message memos.api.v1.PasskeyChallenge
+ */
+export type PasskeyChallenge = Message<"memos.api.v1.PasskeyChallenge"> & {
+  /**
+   * The challenge to sign. It expires after 5 minutes.
+   *
+   * @generated from field: bytes challenge = 1;
+   */
+  challenge: Uint8Array;
+
+  /**
+   * The relying party ID, derived from the instance URL.
+   *
+   * @generated from field: string rp_id = 2;
+   */
+  rpId: string;
+};
+
+/**
+ * Describes the message memos.api.v1.PasskeyChallenge.
+ * Use `create(PasskeyChallengeSchema)` to create a new message.
+ */
+export const PasskeyChallengeSchema: GenMessage<PasskeyChallenge> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 5);
+
+/**
+ * @generated from message memos.api.v1.RefreshSessionRequest
+ */
+export type RefreshSessionRequest = Message<"memos.api.v1.RefreshSessionRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.RefreshSessionRequest.
+ * Use `create(RefreshSessionRequestSchema)` to create a new message.
+ */
+export const RefreshSessionRequestSchema: GenMessage<RefreshSessionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 6);
+
+/**
+ * @generated from message memos.api.v1.RefreshSessionResponse
+ */
+export type RefreshSessionResponse = Message<"memos.api.v1.RefreshSessionResponse"> & {
+  /**
+   * The authenticated user information.
+   *
+   * @generated from field: memos.api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * When the new session access token expires and the session should be refreshed again.
+   *
+   * @generated from field: google.protobuf.Timestamp access_token_expire_time = 2;
+   */
+  accessTokenExpireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.RefreshSessionResponse.
+ * Use `create(RefreshSessionResponseSchema)` to create a new message.
+ */
+export const RefreshSessionResponseSchema: GenMessage<RefreshSessionResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 7);
+
 /**
  * @generated from message memos.api.v1.DeleteSessionRequest
  */
@@ -206,7 +419,52 @@ export type DeleteSessionRequest = Message<"memos.api.v1.DeleteSessionRequest">
  * Use `create(DeleteSessionRequestSchema)` to create a new message.
  */
 export const DeleteSessionRequestSchema: GenMessage<DeleteSessionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_auth_service, 4);
+  messageDesc(file_api_v1_auth_service, 8);
+
+/**
+ * @generated from message memos.api.v1.RequestPasswordResetRequest
+ */
+export type RequestPasswordResetRequest = Message<"memos.api.v1.RequestPasswordResetRequest"> & {
+  /**
+   * The username or email address of the account.
+   *
+   * @generated from field: string identifier = 1;
+   */
+  identifier: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RequestPasswordResetRequest.
+ * Use `create(RequestPasswordResetRequestSchema)` to create a new message.
+ */
+export const RequestPasswordResetRequestSchema: GenMessage<RequestPasswordResetRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 9);
+
+/**
+ * @generated from message memos.api.v1.ResetPasswordRequest
+ */
+export type ResetPasswordRequest = Message<"memos.api.v1.ResetPasswordRequest"> & {
+  /**
+   * The token from the password reset email.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * The new password.
+   *
+   * @generated from field: string new_password = 2;
+   */
+  newPassword: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ResetPasswordRequest.
+ * Use `create(ResetPasswordRequestSchema)` to create a new message.
+ */
+export const ResetPasswordRequestSchema: GenMessage<ResetPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 10);
 
 /**
  * @generated from service memos.api.v1.AuthService
@@ -234,6 +492,29 @@ export const AuthService: GenService<{
     input: typeof CreateSessionRequestSchema;
     output: typeof CreateSessionResponseSchema;
   },
+  /**
+   * CreatePasskeyChallenge starts a passkey sign-in.
+   * Returns the options for navigator.credentials.get(); the assertion is sent back in CreateSession.
+   *
+   * @generated from rpc memos.api.v1.AuthService.CreatePasskeyChallenge
+   */
+  createPasskeyChallenge: {
+    methodKind: "unary";
+    input: typeof CreatePasskeyChallengeRequestSchema;
+    output: typeof PasskeyChallengeSchema;
+  },
+  /**
+   * RefreshSession renews the session access token of a browser session.
+   * The refresh token is read from the user_refresh_token cookie and rotated: both cookies are
+   * replaced with a new pair, and reusing the old refresh token revokes the session.
+   *
+   * @generated from rpc memos.api.v1.AuthService.RefreshSession
+   */
+  refreshSession: {
+    methodKind: "unary";
+    input: typeof RefreshSessionRequestSchema;
+    output: typeof RefreshSessionResponseSchema;
+  },
   /**
    * DeleteSession terminates the current user session.
    * This is an idempotent operation that invalidates the user's authentication.
@@ -245,6 +526,29 @@ export const AuthService: GenService<{
     input: typeof DeleteSessionRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * RequestPasswordReset emails a single-use password reset link to a user.
+   * The response is the same whether or not the account exists.
+   * Fails with FAILED_PRECONDITION if email is not configured.
+   *
+   * @generated from rpc memos.api.v1.AuthService.RequestPasswordReset
+   */
+  requestPasswordReset: {
+    methodKind: "unary";
+    input: typeof RequestPasswordResetRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ResetPassword sets a new password with a token from RequestPasswordReset.
+   * All sessions of the user are signed out.
+   *
+   * @generated from rpc memos.api.v1.AuthService.ResetPassword
+   */
+  resetPassword: {
+    methodKind: "unary";
+    input: typeof ResetPasswordRequestSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_auth_service, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/common.proto.
  */
 export const file_api_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("ChNhcGkvdjEvY29tbW9uLnByb3RvEgxtZW1vcy5hcGkudjEiKgoJUGFnZVRva2VuEg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBSo4CgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEgoKBk5PUk1BTBABEgwKCEFSQ0hJVkVEEAIqOQoJRGlyZWN0aW9uEhkKFURJUkVDVElPTl9VTlNQRUNJRklFRBAAEgcKA0FTQxABEggKBERFU0MQAkKjAQoQY29tLm1lbW9zLmFwaS52MUILQ29tbW9uUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw");
+  fileDesc("ChNhcGkvdjEvY29tbW9uLnByb3RvEgxtZW1vcy5hcGkudjEiuwEKCVBhZ2VUb2tlbhINCgVsaW1pdBgBIAEoBRIOCgZvZmZzZXQYAiABKAUSNwoLbWVtb19jdXJzb3IYAyABKAsyIi5tZW1vcy5hcGkudjEuUGFnZVRva2VuLk1lbW9DdXJzb3IaVgoKTWVtb0N1cnNvchIOCgZwaW5uZWQYASABKAgSCgoCdHMYAiABKAMSCgoCaWQYAyABKAUSDQoFb3JkZXIYBCABKAkSEQoJcGluX29yZGVyGAUgASgFKkMKBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASCgoGTk9STUFMEAESDAoIQVJDSElWRUQQAhIJCgVEUkFGVBADKjkKCURpcmVjdGlvbhIZChVESVJFQ1RJT05fVU5TUEVDSUZJRUQQABIHCgNBU0MQARIICgRERVNDEAJCowEKEGNvbS5tZW1vcy5hcGkudjFCC0NvbW1vblByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM");
 
 /**
  * Used internally for obfuscating the page token.
@@ -27,6 +27,14 @@ export type PageToken = Message<"memos.api.v1.PageToken"> & {
    * @generated from field: int32 offset = 2;
    */
   offset: number;
+
+  /**
+   * The position of the last memo of the previous page, for keyset pagination of memos.
+   * Takes precedence over offset when set.
+   *
+   * @generated from field: memos.api.v1.PageToken.MemoCursor memo_cursor = 3;
+   */
+  memoCursor?: PageToken_MemoCursor;
 };
 
 /**
@@ -36,6 +44,49 @@ export type PageToken = Message<"memos.api.v1.PageToken"> & {
 export const PageTokenSchema: GenMessage<PageToken> = /*@__PURE__*/
   messageDesc(file_api_v1_common, 0);
 
+/**
+ * @generated from message memos.api.v1.PageToken.MemoCursor
+ */
+export type PageToken_MemoCursor = Message<"memos.api.v1.PageToken.MemoCursor"> & {
+  /**
+   * @generated from field: bool pinned = 1;
+   */
+  pinned: boolean;
+
+  /**
+   * The timestamp the memos are ordered by.
+   *
+   * @generated from field: int64 ts = 2;
+   */
+  ts: bigint;
+
+  /**
+   * @generated from field: int32 id = 3;
+   */
+  id: number;
+
+  /**
+   * The ordering of the memos, tokens are only valid for the same ordering.
+   *
+   * @generated from field: string order = 4;
+   */
+  order: string;
+
+  /**
+   * The pin order, for the memos ordered by pinned.
+   *
+   * @generated from field: int32 pin_order = 5;
+   */
+  pinOrder: number;
+};
+
+/**
+ * Describes the message memos.api.v1.PageToken.MemoCursor.
+ * Use `create(PageToken_MemoCursorSchema)` to create a new message.
+ */
+export const PageToken_MemoCursorSchema: GenMessage<PageToken_MemoCursor> = /*@__PURE__*/
+  messageDesc(file_api_v1_common, 0, 0);
+
 /**
  * @generated from enum memos.api.v1.State
  */
@@ -54,6 +105,13 @@ export enum State {
    * @generated from enum value: ARCHIVED = 2;
    */
   ARCHIVED = 2,
+
+  /**
+   * Only memos may be drafts. A draft is only visible to its creator.
+   *
+   * @generated from enum value: DRAFT = 3;
+   */
+  DRAFT = 3,
 }
 
 /**
@@ -4,6 +4,8 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { User_Role } from "./user_service_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
@@ -16,7 +18,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/idp_service.proto.
  */
 export const file_api_v1_idp_service: GenFile = /*@__PURE__*/
-  fileDesc("ChhhcGkvdjEvaWRwX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSLfAgoQSWRlbnRpdHlQcm92aWRlchIRCgRuYW1lGAEgASgJQgPgQQgSNgoEdHlwZRgCIAEoDjIjLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEh4KEWlkZW50aWZpZXJfZmlsdGVyGAQgASgJQgPgQQESOQoGY29uZmlnGAUgASgLMiQubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWdCA+BBAiIoCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIKCgZPQVVUSDIQATpn6kFkCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlchIYaWRlbnRpdHktcHJvdmlkZXJzL3tpZHB9GgRuYW1lKhFpZGVudGl0eVByb3ZpZGVyczIQaWRlbnRpdHlQcm92aWRlciJXChZJZGVudGl0eVByb3ZpZGVyQ29uZmlnEjMKDW9hdXRoMl9jb25maWcYASABKAsyGi5tZW1vcy5hcGkudjEuT0F1dGgyQ29uZmlnSABCCAoGY29uZmlnIlsKDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJIrcBCgxPQXV0aDJDb25maWcSEQoJY2xpZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkSEAoIYXV0aF91cmwYAyABKAkSEQoJdG9rZW5fdXJsGAQgASgJEhUKDXVzZXJfaW5mb191cmwYBSABKAkSDgoGc2NvcGVzGAYgAygJEjEKDWZpZWxkX21hcHBpbmcYByABKAsyGi5tZW1vcy5hcGkudjEuRmllbGRNYXBwaW5nIh4KHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QiWwodTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2USOgoSaWRlbnRpdHlfcHJvdmlkZXJzGAEgAygLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIiUQoaR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSMwoEbmFtZRgBIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlciKCAQodQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPgoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlckID4EECEiEKFGlkZW50aXR5X3Byb3ZpZGVyX2lkGAIgASgJQgPgQQEilQEKHVVwZGF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Ej4KEWlkZW50aXR5X3Byb3ZpZGVyGAEgASgLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyMucGChdJZGVudGl0eVByb3ZpZGVyU2VydmljZRKUAQoVTGlzdElkZW50aXR5UHJvdmlkZXJzEioubWVtb3MuYXBpLnYxLkxpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2UiIoLT5JMCHBIaL2FwaS92MS9pZGVudGl0eS1wcm92aWRlcnMSkwEKE0dldElkZW50aXR5UHJvdmlkZXISKC5tZW1vcy5hcGkudjEuR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciIy2kEEbmFtZYLT5JMCJRIjL2FwaS92MS97bmFtZT1pZGVudGl0eS1wcm92aWRlcnMvKn0SsAEKFkNyZWF0ZUlkZW50aXR5UHJvdmlkZXISKy5tZW1vcy5hcGkudjEuQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciJJ2kERaWRlbnRpdHlfcHJvdmlkZXKC0+STAi86EWlkZW50aXR5X3Byb3ZpZGVyIhovYXBpL3YxL2lkZW50aXR5LXByb3ZpZGVycxLXAQoWVXBkYXRlSWRlbnRpdHlQcm92aWRlchIrLm1lbW9zLmFwaS52MS5VcGRhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyInDaQR1pZGVudGl0eV9wcm92aWRlcix1cGRhdGVfbWFza4LT5JMCSjoRaWRlbnRpdHlfcHJvdmlkZXIyNS9hcGkvdjEve2lkZW50aXR5X3Byb3ZpZGVyLm5hbWU9aWRlbnRpdHktcHJvdmlkZXJzLyp9EpEBChZEZWxldGVJZGVudGl0eVByb3ZpZGVyEisubWVtb3MuYXBpLnYxLkRlbGV0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPWlkZW50aXR5LXByb3ZpZGVycy8qfUKnAQoQY29tLm1lbW9zLmFwaS52MUIPSWRwU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
+  fileDesc("ChhhcGkvdjEvaWRwX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSLfAgoQSWRlbnRpdHlQcm92aWRlchIRCgRuYW1lGAEgASgJQgPgQQgSNgoEdHlwZRgCIAEoDjIjLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEh4KEWlkZW50aWZpZXJfZmlsdGVyGAQgASgJQgPgQQESOQoGY29uZmlnGAUgASgLMiQubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWdCA+BBAiIoCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIKCgZPQVVUSDIQATpn6kFkCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlchIYaWRlbnRpdHktcHJvdmlkZXJzL3tpZHB9GgRuYW1lKhFpZGVudGl0eVByb3ZpZGVyczIQaWRlbnRpdHlQcm92aWRlciJXChZJZGVudGl0eVByb3ZpZGVyQ29uZmlnEjMKDW9hdXRoMl9jb25maWcYASABKAsyGi5tZW1vcy5hcGkudjEuT0F1dGgyQ29uZmlnSABCCAoGY29uZmlnIlsKDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJIpUCCgxPQXV0aDJDb25maWcSEQoJY2xpZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkSEAoIYXV0aF91cmwYAyABKAkSEQoJdG9rZW5fdXJsGAQgASgJEhUKDXVzZXJfaW5mb191cmwYBSABKAkSDgoGc2NvcGVzGAYgAygJEjEKDWZpZWxkX21hcHBpbmcYByABKAsyGi5tZW1vcy5hcGkudjEuRmllbGRNYXBwaW5nEhIKCnJvbGVfY2xhaW0YCCABKAkSMAoNcm9sZV9tYXBwaW5ncxgJIAMoCzIZLm1lbW9zLmFwaS52MS5Sb2xlTWFwcGluZxIWCg5zeW5jX3VzZXJfaW5mbxgKIAEoCCJJCgtSb2xlTWFwcGluZxITCgtjbGFpbV92YWx1ZRgBIAEoCRIlCgRyb2xlGAIgASgOMhcubWVtb3MuYXBpLnYxLlVzZXIuUm9sZSIeChxMaXN0SWRlbnRpdHlQcm92aWRlcnNSZXF1ZXN0IlsKHUxpc3RJZGVudGl0eVByb3ZpZGVyc1Jlc3BvbnNlEjoKEmlkZW50aXR5X3Byb3ZpZGVycxgBIAMoCzIeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyIlEKGkdldElkZW50aXR5UHJvdmlkZXJSZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL0lkZW50aXR5UHJvdmlkZXIiggEKHUNyZWF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Ej4KEWlkZW50aXR5X3Byb3ZpZGVyGAEgASgLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJCA+BBAhIhChRpZGVudGl0eV9wcm92aWRlcl9pZBgCIAEoCUID4EEBIpUBCh1VcGRhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBI+ChFpZGVudGl0eV9wcm92aWRlchgBIAEoCzIeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiVAodRGVsZXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSMwoEbmFtZRgBIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlcjLnBgoXSWRlbnRpdHlQcm92aWRlclNlcnZpY2USlAEKFUxpc3RJZGVudGl0eVByb3ZpZGVycxIqLm1lbW9zLmFwaS52MS5MaXN0SWRlbnRpdHlQcm92aWRlcnNSZXF1ZXN0GisubWVtb3MuYXBpLnYxLkxpc3RJZGVudGl0eVByb3ZpZGVyc1Jlc3BvbnNlIiKC0+STAhwSGi9hcGkvdjEvaWRlbnRpdHktcHJvdmlkZXJzEpMBChNHZXRJZGVudGl0eVByb3ZpZGVyEigubWVtb3MuYXBpLnYxLkdldElkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Gh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIiMtpBBG5hbWWC0+STAiUSIy9hcGkvdjEve25hbWU9aWRlbnRpdHktcHJvdmlkZXJzLyp9ErABChZDcmVhdGVJZGVudGl0eVByb3ZpZGVyEisubWVtb3MuYXBpLnYxLkNyZWF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Gh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIiSdpBEWlkZW50aXR5X3Byb3ZpZGVygtPkkwIvOhFpZGVudGl0eV9wcm92aWRlciIaL2FwaS92MS9pZGVudGl0eS1wcm92aWRlcnMS1wEKFlVwZGF0ZUlkZW50aXR5UHJvdmlkZXISKy5tZW1vcy5hcGkudjEuVXBkYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciJw2kEdaWRlbnRpdHlfcHJvdmlkZXIsdXBkYXRlX21hc2uC0+STAko6EWlkZW50aXR5X3Byb3ZpZGVyMjUvYXBpL3YxL3tpZGVudGl0eV9wcm92aWRlci5uYW1lPWlkZW50aXR5LXByb3ZpZGVycy8qfRKRAQoWRGVsZXRlSWRlbnRpdHlQcm92aWRlchIrLm1lbW9zLmFwaS52MS5EZWxldGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIy2kEEbmFtZYLT5JMCJSojL2FwaS92MS97bmFtZT1pZGVudGl0eS1wcm92aWRlcnMvKn1CpwEKEGNvbS5tZW1vcy5hcGkudjFCD0lkcFNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
 
 /**
  * @generated from message memos.api.v1.IdentityProvider
@@ -182,6 +184,29 @@ export type OAuth2Config = Message<"memos.api.v1.OAuth2Config"> & {
    * @generated from field: memos.api.v1.FieldMapping field_mapping = 7;
    */
   fieldMapping?: FieldMapping;
+
+  /**
+   * The claim holding the user's groups, e.g. "groups".
+   * Nested claims are addressed with dots, e.g. "realm_access.roles".
+   *
+   * @generated from field: string role_claim = 8;
+   */
+  roleClaim: string;
+
+  /**
+   * Maps values of the role claim to roles, applied on every sign-in.
+   * Users matching several values get the most privileged role, users matching none become USER.
+   *
+   * @generated from field: repeated memos.api.v1.RoleMapping role_mappings = 9;
+   */
+  roleMappings: RoleMapping[];
+
+  /**
+   * Whether to update the display name and avatar from the identity provider on every sign-in.
+   *
+   * @generated from field: bool sync_user_info = 10;
+   */
+  syncUserInfo: boolean;
 };
 
 /**
@@ -191,6 +216,32 @@ export type OAuth2Config = Message<"memos.api.v1.OAuth2Config"> & {
 export const OAuth2ConfigSchema: GenMessage<OAuth2Config> = /*@__PURE__*/
   messageDesc(file_api_v1_idp_service, 3);
 
+/**
+ * @generated from message memos.api.v1.RoleMapping
+ */
+export type RoleMapping = Message<"memos.api.v1.RoleMapping"> & {
+  /**
+   * The value of the role claim, e.g. a group name.
+   *
+   * @generated from field: string claim_value = 1;
+   */
+  claimValue: string;
+
+  /**
+   * The role granted to users with the claim value.
+   *
+   * @generated from field: memos.api.v1.User.Role role = 2;
+   */
+  role: User_Role;
+};
+
+/**
+ * Describes the message memos.api.v1.RoleMapping.
+ * Use `create(RoleMappingSchema)` to create a new message.
+ */
+export const RoleMappingSchema: GenMessage<RoleMapping> = /*@__PURE__*/
+  messageDesc(file_api_v1_idp_service, 4);
+
 /**
  * @generated from message memos.api.v1.ListIdentityProvidersRequest
  */
@@ -202,7 +253,7 @@ export type ListIdentityProvidersRequest = Message<"memos.api.v1.ListIdentityPro
  * Use `create(ListIdentityProvidersRequestSchema)` to create a new message.
  */
 export const ListIdentityProvidersRequestSchema: GenMessage<ListIdentityProvidersRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 4);
+  messageDesc(file_api_v1_idp_service, 5);
 
 /**
  * @generated from message memos.api.v1.ListIdentityProvidersResponse
@@ -221,7 +272,7 @@ export type ListIdentityProvidersResponse = Message<"memos.api.v1.ListIdentityPr
  * Use `create(ListIdentityProvidersResponseSchema)` to create a new message.
  */
 export const ListIdentityProvidersResponseSchema: GenMessage<ListIdentityProvidersResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 5);
+  messageDesc(file_api_v1_idp_service, 6);
 
 /**
  * @generated from message memos.api.v1.GetIdentityProviderRequest
@@ -241,7 +292,7 @@ export type GetIdentityProviderRequest = Message<"memos.api.v1.GetIdentityProvid
  * Use `create(GetIdentityProviderRequestSchema)` to create a new message.
  */
 export const GetIdentityProviderRequestSchema: GenMessage<GetIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 6);
+  messageDesc(file_api_v1_idp_service, 7);
 
 /**
  * @generated from message memos.api.v1.CreateIdentityProviderRequest
@@ -268,7 +319,7 @@ export type CreateIdentityProviderRequest = Message<"memos.api.v1.CreateIdentity
  * Use `create(CreateIdentityProviderRequestSchema)` to create a new message.
  */
 export const CreateIdentityProviderRequestSchema: GenMessage<CreateIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 7);
+  messageDesc(file_api_v1_idp_service, 8);
 
 /**
  * @generated from message memos.api.v1.UpdateIdentityProviderRequest
@@ -295,7 +346,7 @@ export type UpdateIdentityProviderRequest = Message<"memos.api.v1.UpdateIdentity
  * Use `create(UpdateIdentityProviderRequestSchema)` to create a new message.
  */
 export const UpdateIdentityProviderRequestSchema: GenMessage<UpdateIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 8);
+  messageDesc(file_api_v1_idp_service, 9);
 
 /**
  * @generated from message memos.api.v1.DeleteIdentityProviderRequest
@@ -315,7 +366,7 @@ export type DeleteIdentityProviderRequest = Message<"memos.api.v1.DeleteIdentity
  * Use `create(DeleteIdentityProviderRequestSchema)` to create a new message.
  */
 export const DeleteIdentityProviderRequestSchema: GenMessage<DeleteIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 9);
+  messageDesc(file_api_v1_idp_service, 10);
 
 /**
  * @generated from service memos.api.v1.IdentityProviderService
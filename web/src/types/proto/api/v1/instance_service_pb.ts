@@ -4,19 +4,23 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { State } from "./common_pb";
+import { file_api_v1_common } from "./common_pb";
+import type { Visibility } from "./memo_service_pb";
+import { file_api_v1_memo_service } from "./memo_service_pb";
 import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { FieldMask } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, FieldMask, Struct, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/instance_service.proto.
  */
 export const file_api_v1_instance_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIlUKD0luc3RhbmNlUHJvZmlsZRINCgVvd25lchgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEgwKBG1vZGUYAyABKAkSFAoMaW5zdGFuY2VfdXJsGAYgASgJIhsKGUdldEluc3RhbmNlUHJvZmlsZVJlcXVlc3Qi6AsKD0luc3RhbmNlU2V0dGluZxIRCgRuYW1lGAEgASgJQgPgQQgSRwoPZ2VuZXJhbF9zZXR0aW5nGAIgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZ0gAEkcKD3N0b3JhZ2Vfc2V0dGluZxgDIAEoCzIsLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmdIABJQChRtZW1vX3JlbGF0ZWRfc2V0dGluZxgEIAEoCzIwLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuTWVtb1JlbGF0ZWRTZXR0aW5nSAAahwMKDkdlbmVyYWxTZXR0aW5nEiIKGmRpc2FsbG93X3VzZXJfcmVnaXN0cmF0aW9uGAIgASgIEh4KFmRpc2FsbG93X3Bhc3N3b3JkX2F1dGgYAyABKAgSGQoRYWRkaXRpb25hbF9zY3JpcHQYBCABKAkSGAoQYWRkaXRpb25hbF9zdHlsZRgFIAEoCRJSCg5jdXN0b21fcHJvZmlsZRgGIAEoCzI6Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuR2VuZXJhbFNldHRpbmcuQ3VzdG9tUHJvZmlsZRIdChV3ZWVrX3N0YXJ0X2RheV9vZmZzZXQYByABKAUSIAoYZGlzYWxsb3dfY2hhbmdlX3VzZXJuYW1lGAggASgIEiAKGGRpc2FsbG93X2NoYW5nZV9uaWNrbmFtZRgJIAEoCBpFCg1DdXN0b21Qcm9maWxlEg0KBXRpdGxlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhAKCGxvZ29fdXJsGAMgASgJGroDCg5TdG9yYWdlU2V0dGluZxJOCgxzdG9yYWdlX3R5cGUYASABKA4yOC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlN0b3JhZ2VTZXR0aW5nLlN0b3JhZ2VUeXBlEhkKEWZpbGVwYXRoX3RlbXBsYXRlGAIgASgJEhwKFHVwbG9hZF9zaXplX2xpbWl0X21iGAMgASgDEkgKCXMzX2NvbmZpZxgEIAEoCzI1Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuUzNDb25maWcahgEKCFMzQ29uZmlnEhUKDWFjY2Vzc19rZXlfaWQYASABKAkSGQoRYWNjZXNzX2tleV9zZWNyZXQYAiABKAkSEAoIZW5kcG9pbnQYAyABKAkSDgoGcmVnaW9uGAQgASgJEg4KBmJ1Y2tldBgFIAEoCRIWCg51c2VfcGF0aF9zdHlsZRgGIAEoCCJMCgtTdG9yYWdlVHlwZRIcChhTVE9SQUdFX1RZUEVfVU5TUEVDSUZJRUQQABIMCghEQVRBQkFTRRABEgkKBUxPQ0FMEAISBgoCUzMQAxriAQoSTWVtb1JlbGF0ZWRTZXR0aW5nEiIKGmRpc2FsbG93X3B1YmxpY192aXNpYmlsaXR5GAEgASgIEiAKGGRpc3BsYXlfd2l0aF91cGRhdGVfdGltZRgCIAEoCBIcChRjb250ZW50X2xlbmd0aF9saW1pdBgDIAEoBRIgChhlbmFibGVfZG91YmxlX2NsaWNrX2VkaXQYBCABKAgSEQoJcmVhY3Rpb25zGAcgAygJEiAKGGVuYWJsZV9ibHVyX25zZndfY29udGVudBgJIAEoCBIRCgluc2Z3X3RhZ3MYCiADKAkiRgoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARILCgdTVE9SQUdFEAISEAoMTUVNT19SRUxBVEVEEAM6YepBXgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZxIbaW5zdGFuY2Uvc2V0dGluZ3Mve3NldHRpbmd9KhBpbnN0YW5jZVNldHRpbmdzMg9pbnN0YW5jZVNldHRpbmdCBwoFdmFsdWUiTwoZR2V0SW5zdGFuY2VTZXR0aW5nUmVxdWVzdBIyCgRuYW1lGAEgASgJQiTgQQL6QR4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmciiQEKHFVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMwoHc2V0dGluZxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBATLbAwoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn1CrAEKEGNvbS5tZW1vcy5hcGkudjFCFEluc3RhbmNlU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask]);
+  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIrsBCg9JbnN0YW5jZVByb2ZpbGUSDQoFb3duZXIYASABKAkSDwoHdmVyc2lvbhgCIAEoCRIMCgRtb2RlGAMgASgJEhQKDGluc3RhbmNlX3VybBgGIAEoCRJHCgxhbm5vdW5jZW1lbnQYByABKAsyMS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkFubm91bmNlbWVudFNldHRpbmcSGwoTd2ViX3B1c2hfcHVibGljX2tleRgIIAEoCSIbChlHZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0IvgjCg9JbnN0YW5jZVNldHRpbmcSEQoEbmFtZRgBIAEoCUID4EEIEkcKD2dlbmVyYWxfc2V0dGluZxgCIAEoCzIsLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJHCg9zdG9yYWdlX3NldHRpbmcYAyABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlN0b3JhZ2VTZXR0aW5nSAASUAoUbWVtb19yZWxhdGVkX3NldHRpbmcYBCABKAsyMC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLk1lbW9SZWxhdGVkU2V0dGluZ0gAElAKFGxpbmtfcHJldmlld19zZXR0aW5nGAUgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5MaW5rUHJldmlld1NldHRpbmdIABJDCg1lbWFpbF9zZXR0aW5nGAYgASgLMioubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5FbWFpbFNldHRpbmdIABJFCg5iYWNrdXBfc2V0dGluZxgHIAEoCzIrLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuQmFja3VwU2V0dGluZ0gAEkMKDXNsYWNrX3NldHRpbmcYCCABKAsyKi5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlNsYWNrU2V0dGluZ0gAEkUKDm1hdHJpeF9zZXR0aW5nGAkgASgLMisubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NYXRyaXhTZXR0aW5nSAASUQoUYW5ub3VuY2VtZW50X3NldHRpbmcYCiABKAsyMS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkFubm91bmNlbWVudFNldHRpbmdIABJMChJyYXRlX2xpbWl0X3NldHRpbmcYCyABKAsyLi5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlJhdGVMaW1pdFNldHRpbmdIABr2AwoOR2VuZXJhbFNldHRpbmcSIgoaZGlzYWxsb3dfdXNlcl9yZWdpc3RyYXRpb24YAiABKAgSHgoWZGlzYWxsb3dfcGFzc3dvcmRfYXV0aBgDIAEoCBIZChFhZGRpdGlvbmFsX3NjcmlwdBgEIAEoCRIYChBhZGRpdGlvbmFsX3N0eWxlGAUgASgJElIKDmN1c3RvbV9wcm9maWxlGAYgASgLMjoubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZy5DdXN0b21Qcm9maWxlEh0KFXdlZWtfc3RhcnRfZGF5X29mZnNldBgHIAEoBRIgChhkaXNhbGxvd19jaGFuZ2VfdXNlcm5hbWUYCCABKAgSIAoYZGlzYWxsb3dfY2hhbmdlX25pY2tuYW1lGAkgASgIEiAKGGF1ZGl0X2xvZ19yZXRlbnRpb25fZGF5cxgKIAEoBRIiChpzZXNzaW9uX2lkbGVfdGltZW91dF9ob3VycxgLIAEoBRInCh9zZXNzaW9uX2Fic29sdXRlX2xpZmV0aW1lX2hvdXJzGAwgASgFGkUKDUN1c3RvbVByb2ZpbGUSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSEAoIbG9nb191cmwYAyABKAkahgYKDlN0b3JhZ2VTZXR0aW5nEk4KDHN0b3JhZ2VfdHlwZRgBIAEoDjI4Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuU3RvcmFnZVR5cGUSGQoRZmlsZXBhdGhfdGVtcGxhdGUYAiABKAkSHAoUdXBsb2FkX3NpemVfbGltaXRfbWIYAyABKAMSSAoJczNfY29uZmlnGAQgASgLMjUubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TM0NvbmZpZxImCh5vcnBoYW5lZF9hdHRhY2htZW50X2dyYWNlX2RheXMYBSABKAUSHAoUc3RyaXBfaW1hZ2VfbWV0YWRhdGEYBiABKAgSHQoVZGVmYXVsdF91c2VyX3F1b3RhX21iGAcgASgDEl4KEWltYWdlX2NvbXByZXNzaW9uGAggASgLMkMubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5JbWFnZUNvbXByZXNzaW9uQ29uZmlnEhwKFGNoZWNrX2V4dGVybmFsX2xpbmtzGAkgASgIGoYBCghTM0NvbmZpZxIVCg1hY2Nlc3Nfa2V5X2lkGAEgASgJEhkKEWFjY2Vzc19rZXlfc2VjcmV0GAIgASgJEhAKCGVuZHBvaW50GAMgASgJEg4KBnJlZ2lvbhgEIAEoCRIOCgZidWNrZXQYBSABKAkSFgoOdXNlX3BhdGhfc3R5bGUYBiABKAgaZwoWSW1hZ2VDb21wcmVzc2lvbkNvbmZpZxIPCgdlbmFibGVkGAEgASgIEg8KB3F1YWxpdHkYAiABKAUSFQoNbWF4X2RpbWVuc2lvbhgDIAEoBRIUCgx0aHJlc2hvbGRfa2IYBCABKAMiTAoLU3RvcmFnZVR5cGUSHAoYU1RPUkFHRV9UWVBFX1VOU1BFQ0lGSUVEEAASDAoIREFUQUJBU0UQARIJCgVMT0NBTBACEgYKAlMzEAMaxwMKEk1lbW9SZWxhdGVkU2V0dGluZxIiChpkaXNhbGxvd19wdWJsaWNfdmlzaWJpbGl0eRgBIAEoCBIgChhkaXNwbGF5X3dpdGhfdXBkYXRlX3RpbWUYAiABKAgSHAoUY29udGVudF9sZW5ndGhfbGltaXQYAyABKAUSIAoYZW5hYmxlX2RvdWJsZV9jbGlja19lZGl0GAQgASgIEhEKCXJlYWN0aW9ucxgHIAMoCRIgChhlbmFibGVfYmx1cl9uc2Z3X2NvbnRlbnQYCSABKAgSEQoJbnNmd190YWdzGAogAygJEhwKFHRyYXNoX3JldGVudGlvbl9kYXlzGAsgASgFEhsKE21lbW9fcmV2aXNpb25fbGltaXQYDCABKAUSKQohYm9va21hcmtfZHVwbGljYXRlX3dpbmRvd19taW51dGVzGA0gASgFEhoKEm1heF9tZW1vc19wZXJfdXNlchgOIAEoBRIZChFtYXhfbWVtb3NfcGVyX2RheRgPIAEoBRIsCiRuZXdfYWNjb3VudF9tYXhfcHVibGljX21lbW9zX3Blcl9kYXkYECABKAUSGAoQbmV3X2FjY291bnRfZGF5cxgRIAEoBRrfAwoSTGlua1ByZXZpZXdTZXR0aW5nEh0KFXJhdGVfbGltaXRfcGVyX21pbnV0ZRgBIAEoBRIYChByYXRlX2xpbWl0X2J1cnN0GAIgASgFEiMKG2FkbWluX3JhdGVfbGltaXRfcGVyX21pbnV0ZRgDIAEoBRJDCgRtb2RlGAQgASgOMjUubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5MaW5rUHJldmlld1NldHRpbmcuTW9kZRIXCg9hbGxvd2VkX2RvbWFpbnMYBSADKAkSHgoWYWxsb3dlZF9pbnRlcm5hbF9ob3N0cxgGIAMoCRISCgp1c2VyX2FnZW50GAcgASgJEl0KD3JlcXVlc3RfaGVhZGVycxgIIAMoCzJELm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuTGlua1ByZXZpZXdTZXR0aW5nLlJlcXVlc3RIZWFkZXJzRW50cnkaNQoTUmVxdWVzdEhlYWRlcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkMKBE1vZGUSFAoQTU9ERV9VTlNQRUNJRklFRBAAEggKBE9QRU4QARINCglBTExPV0xJU1QQAhIMCghESVNBQkxFRBADGr4CCgxFbWFpbFNldHRpbmcSEQoJc210cF9ob3N0GAEgASgJEhEKCXNtdHBfcG9ydBgCIAEoBRIVCg1zbXRwX3VzZXJuYW1lGAMgASgJEhUKDXNtdHBfcGFzc3dvcmQYBCABKAkSDwoHdXNlX3RscxgFIAEoCBISCgpmcm9tX2VtYWlsGAYgASgJEhEKCWZyb21fbmFtZRgHIAEoCRIWCg5pbmJvdW5kX2RvbWFpbhgIIAEoCRJECgh0bHNfbW9kZRgJIAEoDjIyLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuRW1haWxTZXR0aW5nLlRsc01vZGUiRAoHVGxzTW9kZRIYChRUTFNfTU9ERV9VTlNQRUNJRklFRBAAEgwKCFNUQVJUVExTEAESBwoDVExTEAISCAoETk9ORRADGv8BCg1CYWNrdXBTZXR0aW5nEg8KB2VuYWJsZWQYASABKAgSEAoIc2NoZWR1bGUYAiABKAkSTAoLZGVzdGluYXRpb24YAyABKA4yNy5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkJhY2t1cFNldHRpbmcuRGVzdGluYXRpb24SEgoKbG9jYWxfcGF0aBgEIAEoCRIRCglzM19wcmVmaXgYBSABKAkSFwoPcmV0ZW50aW9uX2NvdW50GAYgASgFIj0KC0Rlc3RpbmF0aW9uEhsKF0RFU1RJTkFUSU9OX1VOU1BFQ0lGSUVEEAASCQoFTE9DQUwQARIGCgJTMxACGjkKDFNsYWNrU2V0dGluZxIWCg5zaWduaW5nX3NlY3JldBgBIAEoCRIRCglib3RfdG9rZW4YAiABKAkawwEKDU1hdHJpeFNldHRpbmcSFgoOaG9tZXNlcnZlcl91cmwYASABKAkSFAoMYWNjZXNzX3Rva2VuGAIgASgJEj8KBXJvb21zGAMgAygLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NYXRyaXhTZXR0aW5nLlJvb20aQwoEUm9vbRIPCgdyb29tX2lkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgSGQoRcG9zdF9wdWJsaWNfbWVtb3MYAyABKAgazQIKE0Fubm91bmNlbWVudFNldHRpbmcSDwoCaWQYASABKAlCA+BBAxIPCgdjb250ZW50GAIgASgJEkwKCHNldmVyaXR5GAMgASgOMjoubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5Bbm5vdW5jZW1lbnRTZXR0aW5nLlNldmVyaXR5EjMKCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESMQoIZW5kX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESEwoLZGlzbWlzc2libGUYBiABKAgiSQoIU2V2ZXJpdHkSGAoUU0VWRVJJVFlfVU5TUEVDSUZJRUQQABIICgRJTkZPEAESCwoHV0FSTklORxACEgwKCENSSVRJQ0FMEAMaqwEKEFJhdGVMaW1pdFNldHRpbmcSEAoIZGlzYWJsZWQYASABKAgSIAoYcmVhZF9yZXF1ZXN0c19wZXJfbWludXRlGAIgASgFEhIKCnJlYWRfYnVyc3QYAyABKAUSIQoZd3JpdGVfcmVxdWVzdHNfcGVyX21pbnV0ZRgEIAEoBRITCgt3cml0ZV9idXJzdBgFIAEoBRIXCg9ob3N0X211bHRpcGxpZXIYBiABKAUihgEKA0tleRITCg9LRVlfVU5TUEVDSUZJRUQQABILCgdHRU5FUkFMEAESCwoHU1RPUkFHRRACEhAKDE1FTU9fUkVMQVRFRBADEhAKDExJTktfUFJFVklFVxAEEgkKBUVNQUlMEAUSCgoGQkFDS1VQEAYSCQoFU0xBQ0sQBxIKCgZNQVRSSVgQCDph6kFeChxtZW1vcy5hcGkudjEvSW5zdGFuY2VTZXR0aW5nEhtpbnN0YW5jZS9zZXR0aW5ncy97c2V0dGluZ30qEGluc3RhbmNlU2V0dGluZ3MyD2luc3RhbmNlU2V0dGluZ0IHCgV2YWx1ZSJPChlHZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0EjIKBG5hbWUYASABKAlCJOBBAvpBHgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZyI1CiJEaXNtaXNzSW5zdGFuY2VBbm5vdW5jZW1lbnRSZXF1ZXN0Eg8KAmlkGAEgASgJQgPgQQIiiQEKHFVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMwoHc2V0dGluZxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBASKfBQoIQXVkaXRMb2cSFAoEbmFtZRgBIAEoCUIG4EED4EEIEhIKBWFjdG9yGAIgASgJQgPgQQMSOQoKZXZlbnRfdHlwZRgDIAEoDjIgLm1lbW9zLmFwaS52MS5BdWRpdExvZy5FdmVudFR5cGVCA+BBAxIXCgppcF9hZGRyZXNzGAQgASgJQgPgQQMSFwoKdXNlcl9hZ2VudBgFIAEoCUID4EEDEi0KB3BheWxvYWQYBiABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0QgPgQQMSNAoLY3JlYXRlX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMiyAIKCUV2ZW50VHlwZRIaChZFVkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASCwoHU0lHTl9JThABEhIKDlNJR05fSU5fRkFJTEVEEAISGAoUQUNDRVNTX1RPS0VOX0NSRUFURUQQAxIYChRBQ0NFU1NfVE9LRU5fUkVWT0tFRBAEEhMKD1NFU1NJT05fUkVWT0tFRBAFEhUKEVVTRVJfUk9MRV9DSEFOR0VEEAYSEAoMVVNFUl9ERUxFVEVEEAcSHAoYSU5TVEFOQ0VfU0VUVElOR19DSEFOR0VEEAgSEgoOUEFTU1dPUkRfUkVTRVQQCRIXChNTSUdOSU5HX0tFWV9ST1RBVEVEEAoSFwoTU0lHTklOR19LRVlfRVhQSVJFRBALEhIKDlVTRVJfU1VTUEVOREVEEAwSFAoQVVNFUl9VTlNVU1BFTkRFRBANOkzqQUkKFW1lbW9zLmFwaS52MS9BdWRpdExvZxIVYXVkaXRMb2dzL3thdWRpdF9sb2d9GgRuYW1lKglhdWRpdExvZ3MyCGF1ZGl0TG9nIv4BChRMaXN0QXVkaXRMb2dzUmVxdWVzdBIWCglwYWdlX3NpemUYASABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAIgASgJQgPgQQESEgoFYWN0b3IYAyABKAlCA+BBARI5CgpldmVudF90eXBlGAQgASgOMiAubWVtb3MuYXBpLnYxLkF1ZGl0TG9nLkV2ZW50VHlwZUID4EEBEjMKCnN0YXJ0X3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESMQoIZW5kX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQEiXAoVTGlzdEF1ZGl0TG9nc1Jlc3BvbnNlEioKCmF1ZGl0X2xvZ3MYASADKAsyFi5tZW1vcy5hcGkudjEuQXVkaXRMb2cSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIogDChNJbnN0YW5jZURpYWdub3N0aWNzEg4KBmRyaXZlchgBIAEoCRIWCg5zY2hlbWFfdmVyc2lvbhgCIAEoCRJHCg5kYXRhYmFzZV9zdGF0cxgDIAEoCzIvLm1lbW9zLmFwaS52MS5JbnN0YW5jZURpYWdub3N0aWNzLkRhdGFiYXNlU3RhdHMa/wEKDURhdGFiYXNlU3RhdHMSHAoUbWF4X29wZW5fY29ubmVjdGlvbnMYASABKAUSGAoQb3Blbl9jb25uZWN0aW9ucxgCIAEoBRIOCgZpbl91c2UYAyABKAUSDAoEaWRsZRgEIAEoBRISCgp3YWl0X2NvdW50GAUgASgDEjAKDXdhaXRfZHVyYXRpb24YBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SFwoPbWF4X2lkbGVfY2xvc2VkGAcgASgDEhwKFG1heF9pZGxlX3RpbWVfY2xvc2VkGAggASgDEhsKE21heF9saWZldGltZV9jbG9zZWQYCSABKAMiHwodR2V0SW5zdGFuY2VEaWFnbm9zdGljc1JlcXVlc3Qi6QUKDUluc3RhbmNlU3RhdHMSDwoHdmVyc2lvbhgBIAEoCRIpCgZ1cHRpbWUYAiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SMAoMY29tcHV0ZV90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgp1c2VyX2NvdW50GAQgASgFEhkKEWFjdGl2ZV91c2VyX2NvdW50GAUgASgFEjoKC21lbW9fY291bnRzGAYgAygLMiUubWVtb3MuYXBpLnYxLkluc3RhbmNlU3RhdHMuTWVtb0NvdW50EkUKEWRhaWx5X21lbW9fY291bnRzGAcgAygLMioubWVtb3MuYXBpLnYxLkluc3RhbmNlU3RhdHMuRGFpbHlNZW1vQ291bnQSQAoOc3RvcmFnZV91c2FnZXMYCCADKAsyKC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTdGF0cy5TdG9yYWdlVXNhZ2USGgoSdXNlcl93ZWJob29rX2NvdW50GAkgASgFEh4KFmluc3RhbmNlX3dlYmhvb2tfY291bnQYCiABKAUSIAoTZGF0YWJhc2Vfc2l6ZV9ieXRlcxgLIAEoA0gAiAEBGn0KCU1lbW9Db3VudBIsCgp2aXNpYmlsaXR5GAEgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHkSIgoFc3RhdGUYAiABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGUSDwoHdHJhc2hlZBgDIAEoCBINCgVjb3VudBgEIAEoBRotCg5EYWlseU1lbW9Db3VudBIMCgRkYXRlGAEgASgJEg0KBWNvdW50GAIgASgFGlIKDFN0b3JhZ2VVc2FnZRIUCgxzdG9yYWdlX3R5cGUYASABKAkSGAoQYXR0YWNobWVudF9jb3VudBgCIAEoBRISCgpzaXplX2J5dGVzGAMgASgDQhYKFF9kYXRhYmFzZV9zaXplX2J5dGVzIhkKF0dldEluc3RhbmNlU3RhdHNSZXF1ZXN0IqADChdJbnN0YW5jZU1pZ3JhdGlvblN0YXR1cxIWCg5zY2hlbWFfdmVyc2lvbhgBIAEoCRIdChV0YXJnZXRfc2NoZW1hX3ZlcnNpb24YAiABKAkSUgoSYXBwbGllZF9taWdyYXRpb25zGAMgAygLMjYubWVtb3MuYXBpLnYxLkluc3RhbmNlTWlncmF0aW9uU3RhdHVzLkFwcGxpZWRNaWdyYXRpb24SFQoNcGVuZGluZ19jb3VudBgEIAEoBRIaChJwZW5kaW5nX21pZ3JhdGlvbnMYBSADKAkSEgoKdXBfdG9fZGF0ZRgGIAEoCBqyAQoQQXBwbGllZE1pZ3JhdGlvbhIMCgRmaWxlGAEgASgJEg8KB3ZlcnNpb24YAiABKAkSEAoIY2hlY2tzdW0YAyABKAkSKwoIZHVyYXRpb24YBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLgoKYXBwbHlfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIbW9kaWZpZWQYBiABKAgiIwohR2V0SW5zdGFuY2VNaWdyYXRpb25TdGF0dXNSZXF1ZXN0IowCChRJbnN0YW5jZUJhY2t1cFN0YXR1cxIPCgdydW5uaW5nGAEgASgIEjEKDWxhc3RfcnVuX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKEGxhc3RfZmluaXNoX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmxhc3RfZXJyb3IYBCABKAkSEwoLbGFzdF9iYWNrdXAYBSABKAkSHgoWbGFzdF9iYWNrdXBfc2l6ZV9ieXRlcxgGIAEoAxIxCg1uZXh0X3J1bl90aW1lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIgCh5HZXRJbnN0YW5jZUJhY2t1cFN0YXR1c1JlcXVlc3QiGgoYUnVuSW5zdGFuY2VCYWNrdXBSZXF1ZXN0Ii4KFFNlbmRUZXN0RW1haWxSZXF1ZXN0EhYKCXJlY2lwaWVudBgBIAEoCUID4EEBIpcDCgpTaWduaW5nS2V5EhQKBG5hbWUYASABKAlCBuBBA+BBCBIyCgVzdGF0ZRgCIAEoDjIeLm1lbW9zLmFwaS52MS5TaWduaW5nS2V5LlN0YXRlQgPgQQMSNAoLY3JlYXRlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLcmV0aXJlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLZXhwaXJlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMiRQoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJRUQQABILCgdDVVJSRU5UEAESCwoHUkVUSVJFRBACEgsKB0VYUElSRUQQAzpW6kFTChdtZW1vcy5hcGkudjEvU2lnbmluZ0tleRIZc2lnbmluZ0tleXMve3NpZ25pbmdfa2V5fRoEbmFtZSoLc2lnbmluZ0tleXMyCnNpZ25pbmdLZXkiGAoWTGlzdFNpZ25pbmdLZXlzUmVxdWVzdCJJChdMaXN0U2lnbmluZ0tleXNSZXNwb25zZRIuCgxzaWduaW5nX2tleXMYASADKAsyGC5tZW1vcy5hcGkudjEuU2lnbmluZ0tleSJPChdSb3RhdGVTaWduaW5nS2V5UmVxdWVzdBI0CgxncmFjZV9wZXJpb2QYASABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBASJIChdFeHBpcmVTaWduaW5nS2V5UmVxdWVzdBItCgRuYW1lGAEgASgJQh/gQQL6QRkKF21lbW9zLmFwaS52MS9TaWduaW5nS2V5MqUPCg9JbnN0YW5jZVNlcnZpY2USfgoSR2V0SW5zdGFuY2VQcm9maWxlEicubWVtb3MuYXBpLnYxLkdldEluc3RhbmNlUHJvZmlsZVJlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VQcm9maWxlIiCC0+STAhoSGC9hcGkvdjEvaW5zdGFuY2UvcHJvZmlsZRKPAQoSR2V0SW5zdGFuY2VTZXR0aW5nEicubWVtb3MuYXBpLnYxLkdldEluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPWluc3RhbmNlL3NldHRpbmdzLyp9ErUBChVVcGRhdGVJbnN0YW5jZVNldHRpbmcSKi5tZW1vcy5hcGkudjEuVXBkYXRlSW5zdGFuY2VTZXR0aW5nUmVxdWVzdBodLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmciUdpBE3NldHRpbmcsdXBkYXRlX21hc2uC0+STAjU6B3NldHRpbmcyKi9hcGkvdjEve3NldHRpbmcubmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRKZAQobRGlzbWlzc0luc3RhbmNlQW5ub3VuY2VtZW50EjAubWVtb3MuYXBpLnYxLkRpc21pc3NJbnN0YW5jZUFubm91bmNlbWVudFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMILT5JMCKjoBKiIlL2FwaS92MS9pbnN0YW5jZS9hbm5vdW5jZW1lbnQ6ZGlzbWlzcxJzCg1MaXN0QXVkaXRMb2dzEiIubWVtb3MuYXBpLnYxLkxpc3RBdWRpdExvZ3NSZXF1ZXN0GiMubWVtb3MuYXBpLnYxLkxpc3RBdWRpdExvZ3NSZXNwb25zZSIZgtPkkwITEhEvYXBpL3YxL2F1ZGl0TG9ncxKOAQoWR2V0SW5zdGFuY2VEaWFnbm9zdGljcxIrLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZURpYWdub3N0aWNzUmVxdWVzdBohLm1lbW9zLmFwaS52MS5JbnN0YW5jZURpYWdub3N0aWNzIiSC0+STAh4SHC9hcGkvdjEvaW5zdGFuY2UvZGlhZ25vc3RpY3MSdgoQR2V0SW5zdGFuY2VTdGF0cxIlLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVN0YXRzUmVxdWVzdBobLm1lbW9zLmFwaS52MS5JbnN0YW5jZVN0YXRzIh6C0+STAhgSFi9hcGkvdjEvaW5zdGFuY2Uvc3RhdHMSmQEKGkdldEluc3RhbmNlTWlncmF0aW9uU3RhdHVzEi8ubWVtb3MuYXBpLnYxLkdldEluc3RhbmNlTWlncmF0aW9uU3RhdHVzUmVxdWVzdBolLm1lbW9zLmFwaS52MS5JbnN0YW5jZU1pZ3JhdGlvblN0YXR1cyIjgtPkkwIdEhsvYXBpL3YxL2luc3RhbmNlL21pZ3JhdGlvbnMSjAEKF0dldEluc3RhbmNlQmFja3VwU3RhdHVzEiwubWVtb3MuYXBpLnYxLkdldEluc3RhbmNlQmFja3VwU3RhdHVzUmVxdWVzdBoiLm1lbW9zLmFwaS52MS5JbnN0YW5jZUJhY2t1cFN0YXR1cyIfgtPkkwIZEhcvYXBpL3YxL2luc3RhbmNlL2JhY2t1cBKHAQoRUnVuSW5zdGFuY2VCYWNrdXASJi5tZW1vcy5hcGkudjEuUnVuSW5zdGFuY2VCYWNrdXBSZXF1ZXN0GiIubWVtb3MuYXBpLnYxLkluc3RhbmNlQmFja3VwU3RhdHVzIiaC0+STAiA6ASoiGy9hcGkvdjEvaW5zdGFuY2UvYmFja3VwOnJ1bhJzCg1TZW5kVGVzdEVtYWlsEiIubWVtb3MuYXBpLnYxLlNlbmRUZXN0RW1haWxSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiaC0+STAiA6ASoiGy9hcGkvdjEvaW5zdGFuY2UvZW1haWw6dGVzdBJ7Cg9MaXN0U2lnbmluZ0tleXMSJC5tZW1vcy5hcGkudjEuTGlzdFNpZ25pbmdLZXlzUmVxdWVzdBolLm1lbW9zLmFwaS52MS5MaXN0U2lnbmluZ0tleXNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL3NpZ25pbmdLZXlzEnoKEFJvdGF0ZVNpZ25pbmdLZXkSJS5tZW1vcy5hcGkudjEuUm90YXRlU2lnbmluZ0tleVJlcXVlc3QaGC5tZW1vcy5hcGkudjEuU2lnbmluZ0tleSIlgtPkkwIfOgEqIhovYXBpL3YxL3NpZ25pbmdLZXlzOnJvdGF0ZRKKAQoQRXhwaXJlU2lnbmluZ0tleRIlLm1lbW9zLmFwaS52MS5FeHBpcmVTaWduaW5nS2V5UmVxdWVzdBoYLm1lbW9zLmFwaS52MS5TaWduaW5nS2V5IjXaQQRuYW1lgtPkkwIoOgEqIiMvYXBpL3YxL3tuYW1lPXNpZ25pbmdLZXlzLyp9OmV4cGlyZUKsAQoQY29tLm1lbW9zLmFwaS52MUIUSW5zdGFuY2VTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_common, file_api_v1_memo_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_struct, file_google_protobuf_timestamp]);
 
 /**
  * Instance profile message containing basic instance information.
@@ -52,6 +56,22 @@ export type InstanceProfile = Message<"memos.api.v1.InstanceProfile"> & {
    * @generated from field: string instance_url = 6;
    */
   instanceUrl: string;
+
+  /**
+   * The announcement shown to the users, if one is active and the current user didn't
+   * dismiss it.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.AnnouncementSetting announcement = 7;
+   */
+  announcement?: InstanceSetting_AnnouncementSetting;
+
+  /**
+   * The VAPID public key of Web Push, the applicationServerKey of the push subscriptions. Empty
+   * when push notifications are unavailable, as the instance URL isn't set.
+   *
+   * @generated from field: string web_push_public_key = 8;
+   */
+  webPushPublicKey: string;
 };
 
 /**
@@ -111,6 +131,48 @@ export type InstanceSetting = Message<"memos.api.v1.InstanceSetting"> & {
      */
     value: InstanceSetting_MemoRelatedSetting;
     case: "memoRelatedSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.LinkPreviewSetting link_preview_setting = 5;
+     */
+    value: InstanceSetting_LinkPreviewSetting;
+    case: "linkPreviewSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.EmailSetting email_setting = 6;
+     */
+    value: InstanceSetting_EmailSetting;
+    case: "emailSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.BackupSetting backup_setting = 7;
+     */
+    value: InstanceSetting_BackupSetting;
+    case: "backupSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.SlackSetting slack_setting = 8;
+     */
+    value: InstanceSetting_SlackSetting;
+    case: "slackSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.MatrixSetting matrix_setting = 9;
+     */
+    value: InstanceSetting_MatrixSetting;
+    case: "matrixSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.AnnouncementSetting announcement_setting = 10;
+     */
+    value: InstanceSetting_AnnouncementSetting;
+    case: "announcementSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.RateLimitSetting rate_limit_setting = 11;
+     */
+    value: InstanceSetting_RateLimitSetting;
+    case: "rateLimitSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -184,6 +246,30 @@ export type InstanceSetting_GeneralSetting = Message<"memos.api.v1.InstanceSetti
    * @generated from field: bool disallow_change_nickname = 9;
    */
   disallowChangeNickname: boolean;
+
+  /**
+   * audit_log_retention_days is how many days audit log entries are kept.
+   * Default is 90 days.
+   *
+   * @generated from field: int32 audit_log_retention_days = 10;
+   */
+  auditLogRetentionDays: number;
+
+  /**
+   * session_idle_timeout_hours is how long a session stays valid without being used.
+   * Default is 336 hours (14 days).
+   *
+   * @generated from field: int32 session_idle_timeout_hours = 11;
+   */
+  sessionIdleTimeoutHours: number;
+
+  /**
+   * session_absolute_lifetime_hours caps the lifetime of a session from sign-in,
+   * however often it is used. 0 means no cap.
+   *
+   * @generated from field: int32 session_absolute_lifetime_hours = 12;
+   */
+  sessionAbsoluteLifetimeHours: number;
 };
 
 /**
@@ -256,6 +342,45 @@ export type InstanceSetting_StorageSetting = Message<"memos.api.v1.InstanceSetti
    * @generated from field: memos.api.v1.InstanceSetting.StorageSetting.S3Config s3_config = 4;
    */
   s3Config?: InstanceSetting_StorageSetting_S3Config;
+
+  /**
+   * orphaned_attachment_grace_days is how many days attachments without a memo are kept
+   * before they are purged. Library uploads are never purged.
+   *
+   * @generated from field: int32 orphaned_attachment_grace_days = 5;
+   */
+  orphanedAttachmentGraceDays: number;
+
+  /**
+   * strip_image_metadata strips the EXIF and XMP metadata of uploaded images, keeping
+   * their orientation. Users may override it in their general settings.
+   *
+   * @generated from field: bool strip_image_metadata = 6;
+   */
+  stripImageMetadata: boolean;
+
+  /**
+   * default_user_quota_mb is the storage quota of each user in MiB, 0 means unlimited.
+   * The host may set another quota on a user.
+   *
+   * @generated from field: int64 default_user_quota_mb = 7;
+   */
+  defaultUserQuotaMb: bigint;
+
+  /**
+   * image_compression re-encodes the large JPEG and PNG uploads to save storage.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfig image_compression = 8;
+   */
+  imageCompression?: InstanceSetting_StorageSetting_ImageCompressionConfig;
+
+  /**
+   * check_external_links re-checks the external links of the attachments every week, marking
+   * the links whose files are gone as broken.
+   *
+   * @generated from field: bool check_external_links = 9;
+   */
+  checkExternalLinks: boolean;
 };
 
 /**
@@ -310,6 +435,49 @@ export type InstanceSetting_StorageSetting_S3Config = Message<"memos.api.v1.Inst
 export const InstanceSetting_StorageSetting_S3ConfigSchema: GenMessage<InstanceSetting_StorageSetting_S3Config> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 2, 1, 0);
 
+/**
+ * Image compression configuration for the uploads.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfig
+ */
+export type InstanceSetting_StorageSetting_ImageCompressionConfig = Message<"memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfig"> & {
+  /**
+   * enabled re-encodes the JPEG and PNG uploads larger than the threshold to WebP, or to a
+   * lower quality JPEG when smaller.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * quality is the encoding quality from 1 to 100, 0 meaning the default quality of 80.
+   *
+   * @generated from field: int32 quality = 2;
+   */
+  quality: number;
+
+  /**
+   * max_dimension caps the long edge of the compressed images in pixels, 0 meaning uncapped.
+   *
+   * @generated from field: int32 max_dimension = 3;
+   */
+  maxDimension: number;
+
+  /**
+   * threshold_kb is the size in KiB up to which images are kept as uploaded.
+   *
+   * @generated from field: int64 threshold_kb = 4;
+   */
+  thresholdKb: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.StorageSetting.ImageCompressionConfig.
+ * Use `create(InstanceSetting_StorageSetting_ImageCompressionConfigSchema)` to create a new message.
+ */
+export const InstanceSetting_StorageSetting_ImageCompressionConfigSchema: GenMessage<InstanceSetting_StorageSetting_ImageCompressionConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 1, 1);
+
 /**
  * Storage type enumeration for different storage backends.
  *
@@ -403,6 +571,63 @@ export type InstanceSetting_MemoRelatedSetting = Message<"memos.api.v1.InstanceS
    * @generated from field: repeated string nsfw_tags = 10;
    */
   nsfwTags: string[];
+
+  /**
+   * trash_retention_days is how many days deleted memos stay in the trash before they are purged.
+   * Default is 30 days.
+   *
+   * @generated from field: int32 trash_retention_days = 11;
+   */
+  trashRetentionDays: number;
+
+  /**
+   * memo_revision_limit is how many revisions are kept per memo, the oldest being pruned first.
+   * Default is 50 revisions.
+   *
+   * @generated from field: int32 memo_revision_limit = 12;
+   */
+  memoRevisionLimit: number;
+
+  /**
+   * bookmark_duplicate_window_minutes is how long a bookmark memo is returned again instead of
+   * creating another memo when the same link is bookmarked.
+   * Default is 10 minutes.
+   *
+   * @generated from field: int32 bookmark_duplicate_window_minutes = 13;
+   */
+  bookmarkDuplicateWindowMinutes: number;
+
+  /**
+   * max_memos_per_user is the number of memos, not counting the trash, a user may have.
+   * 0 means unlimited. The host is exempt and may override the limit per user.
+   *
+   * @generated from field: int32 max_memos_per_user = 14;
+   */
+  maxMemosPerUser: number;
+
+  /**
+   * max_memos_per_day is the number of memos a user may create per day, in the time zone
+   * of the user. 0 means unlimited. The host is exempt and may override the limit per user.
+   *
+   * @generated from field: int32 max_memos_per_day = 15;
+   */
+  maxMemosPerDay: number;
+
+  /**
+   * new_account_max_public_memos_per_day is the number of memos an account younger than
+   * new_account_days may create as public or make public per day. 0 means unlimited.
+   *
+   * @generated from field: int32 new_account_max_public_memos_per_day = 16;
+   */
+  newAccountMaxPublicMemosPerDay: number;
+
+  /**
+   * new_account_days is how many days after its creation an account is new.
+   * Default is 7 days.
+   *
+   * @generated from field: int32 new_account_days = 17;
+   */
+  newAccountDays: number;
 };
 
 /**
@@ -413,128 +638,1882 @@ export const InstanceSetting_MemoRelatedSettingSchema: GenMessage<InstanceSettin
   messageDesc(file_api_v1_instance_service, 2, 2);
 
 /**
- * Enumeration of instance setting keys.
+ * Link preview settings controlling outbound metadata fetches.
  *
- * @generated from enum memos.api.v1.InstanceSetting.Key
+ * @generated from message memos.api.v1.InstanceSetting.LinkPreviewSetting
  */
-export enum InstanceSetting_Key {
+export type InstanceSetting_LinkPreviewSetting = Message<"memos.api.v1.InstanceSetting.LinkPreviewSetting"> & {
   /**
-   * @generated from enum value: KEY_UNSPECIFIED = 0;
+   * rate_limit_per_minute is the number of link preview requests a user may make per minute.
+   *
+   * @generated from field: int32 rate_limit_per_minute = 1;
    */
-  KEY_UNSPECIFIED = 0,
+  rateLimitPerMinute: number;
 
   /**
-   * GENERAL is the key for general settings.
+   * rate_limit_burst is the number of requests a user may make in a burst.
    *
-   * @generated from enum value: GENERAL = 1;
+   * @generated from field: int32 rate_limit_burst = 2;
    */
-  GENERAL = 1,
+  rateLimitBurst: number;
 
   /**
-   * STORAGE is the key for storage settings.
+   * admin_rate_limit_per_minute is the per-minute limit applied to admin users.
    *
-   * @generated from enum value: STORAGE = 2;
+   * @generated from field: int32 admin_rate_limit_per_minute = 3;
    */
-  STORAGE = 2,
+  adminRateLimitPerMinute: number;
 
   /**
-   * MEMO_RELATED is the key for memo related settings.
+   * mode controls which URLs may be previewed.
    *
-   * @generated from enum value: MEMO_RELATED = 3;
+   * @generated from field: memos.api.v1.InstanceSetting.LinkPreviewSetting.Mode mode = 4;
    */
-  MEMO_RELATED = 3,
+  mode: InstanceSetting_LinkPreviewSetting_Mode;
+
+  /**
+   * allowed_domains is the list of domain patterns allowed in ALLOWLIST mode.
+   * A leading "*." matches any subdomain, e.g. "*.wikipedia.org".
+   *
+   * @generated from field: repeated string allowed_domains = 5;
+   */
+  allowedDomains: string[];
+
+  /**
+   * allowed_internal_hosts lists private hosts that may be fetched despite the internal IP protection.
+   * Entries are hostnames (a leading "*." matches any subdomain), IP addresses or CIDR ranges, e.g. "192.168.1.0/24".
+   * Loopback and link-local (cloud metadata) addresses are never allowed.
+   *
+   * @generated from field: repeated string allowed_internal_hosts = 6;
+   */
+  allowedInternalHosts: string[];
+
+  /**
+   * user_agent overrides the User-Agent sent with outbound fetches.
+   * Defaults to "memos-linkpreview/<version> (+<instance url>)".
+   *
+   * @generated from field: string user_agent = 7;
+   */
+  userAgent: string;
+
+  /**
+   * request_headers are extra headers sent with outbound fetches, e.g. Accept-Language.
+   * Credentials such as Authorization and Cookie are not allowed.
+   *
+   * @generated from field: map<string, string> request_headers = 8;
+   */
+  requestHeaders: { [key: string]: string };
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.LinkPreviewSetting.
+ * Use `create(InstanceSetting_LinkPreviewSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_LinkPreviewSettingSchema: GenMessage<InstanceSetting_LinkPreviewSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 3);
+
+/**
+ * Mode controls which URLs may be previewed.
+ *
+ * @generated from enum memos.api.v1.InstanceSetting.LinkPreviewSetting.Mode
+ */
+export enum InstanceSetting_LinkPreviewSetting_Mode {
+  /**
+   * @generated from enum value: MODE_UNSPECIFIED = 0;
+   */
+  MODE_UNSPECIFIED = 0,
+
+  /**
+   * OPEN allows previews of any public URL.
+   *
+   * @generated from enum value: OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * ALLOWLIST only allows previews of URLs whose host matches allowed_domains.
+   *
+   * @generated from enum value: ALLOWLIST = 2;
+   */
+  ALLOWLIST = 2,
+
+  /**
+   * DISABLED turns off link previews entirely.
+   *
+   * @generated from enum value: DISABLED = 3;
+   */
+  DISABLED = 3,
 }
 
 /**
- * Describes the enum memos.api.v1.InstanceSetting.Key.
+ * Describes the enum memos.api.v1.InstanceSetting.LinkPreviewSetting.Mode.
  */
-export const InstanceSetting_KeySchema: GenEnum<InstanceSetting_Key> = /*@__PURE__*/
-  enumDesc(file_api_v1_instance_service, 2, 0);
+export const InstanceSetting_LinkPreviewSetting_ModeSchema: GenEnum<InstanceSetting_LinkPreviewSetting_Mode> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 3, 0);
 
 /**
- * Request message for GetInstanceSetting method.
+ * Email settings for sending notifications and password reset links over SMTP.
  *
- * @generated from message memos.api.v1.GetInstanceSettingRequest
+ * @generated from message memos.api.v1.InstanceSetting.EmailSetting
  */
-export type GetInstanceSettingRequest = Message<"memos.api.v1.GetInstanceSettingRequest"> & {
+export type InstanceSetting_EmailSetting = Message<"memos.api.v1.InstanceSetting.EmailSetting"> & {
   /**
-   * The resource name of the instance setting.
-   * Format: instance/settings/{setting}
+   * smtp_host is the host of the SMTP server. Email is disabled if empty.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string smtp_host = 1;
    */
-  name: string;
+  smtpHost: string;
+
+  /**
+   * smtp_port is the port of the SMTP server. Defaults to 587.
+   *
+   * @generated from field: int32 smtp_port = 2;
+   */
+  smtpPort: number;
+
+  /**
+   * smtp_username is the username for SMTP authentication, if required.
+   *
+   * @generated from field: string smtp_username = 3;
+   */
+  smtpUsername: string;
+
+  /**
+   * smtp_password is the password for SMTP authentication.
+   *
+   * @generated from field: string smtp_password = 4;
+   */
+  smtpPassword: string;
+
+  /**
+   * use_tls connects with implicit TLS, usually on port 465.
+   * Otherwise STARTTLS is used if the server supports it.
+   *
+   * @generated from field: bool use_tls = 5;
+   */
+  useTls: boolean;
+
+  /**
+   * from_email is the sender address of emails.
+   *
+   * @generated from field: string from_email = 6;
+   */
+  fromEmail: string;
+
+  /**
+   * from_name is the sender name of emails.
+   *
+   * @generated from field: string from_name = 7;
+   */
+  fromName: string;
+
+  /**
+   * inbound_domain is the domain of the inbound email addresses of the users, whose emails
+   * become memos, e.g. "memos.example.com". The emails are received by an inbound email
+   * service, e.g. Mailgun, which posts them to /email/inbound. Inbound email is disabled if
+   * empty.
+   *
+   * @generated from field: string inbound_domain = 8;
+   */
+  inboundDomain: string;
+
+  /**
+   * tls_mode is how the connection to the SMTP server is secured.
+   * If unspecified, use_tls is used.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.EmailSetting.TlsMode tls_mode = 9;
+   */
+  tlsMode: InstanceSetting_EmailSetting_TlsMode;
 };
 
 /**
- * Describes the message memos.api.v1.GetInstanceSettingRequest.
- * Use `create(GetInstanceSettingRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.InstanceSetting.EmailSetting.
+ * Use `create(InstanceSetting_EmailSettingSchema)` to create a new message.
  */
-export const GetInstanceSettingRequestSchema: GenMessage<GetInstanceSettingRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_instance_service, 3);
+export const InstanceSetting_EmailSettingSchema: GenMessage<InstanceSetting_EmailSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 4);
 
 /**
- * Request message for UpdateInstanceSetting method.
+ * How the connection to the SMTP server is secured.
  *
- * @generated from message memos.api.v1.UpdateInstanceSettingRequest
+ * @generated from enum memos.api.v1.InstanceSetting.EmailSetting.TlsMode
  */
-export type UpdateInstanceSettingRequest = Message<"memos.api.v1.UpdateInstanceSettingRequest"> & {
+export enum InstanceSetting_EmailSetting_TlsMode {
   /**
-   * The instance setting resource which replaces the resource on the server.
+   * @generated from enum value: TLS_MODE_UNSPECIFIED = 0;
+   */
+  TLS_MODE_UNSPECIFIED = 0,
+
+  /**
+   * STARTTLS upgrades the connection with STARTTLS, usually on port 587, and fails if the
+   * server doesn't support it.
    *
-   * @generated from field: memos.api.v1.InstanceSetting setting = 1;
+   * @generated from enum value: STARTTLS = 1;
    */
-  setting?: InstanceSetting;
+  STARTTLS = 1,
 
   /**
-   * The list of fields to update.
+   * TLS connects with implicit TLS, usually on port 465.
    *
-   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   * @generated from enum value: TLS = 2;
    */
-  updateMask?: FieldMask;
-};
+  TLS = 2,
+
+  /**
+   * NONE never uses TLS, for relays on a trusted network. Credentials are only sent to
+   * localhost.
+   *
+   * @generated from enum value: NONE = 3;
+   */
+  NONE = 3,
+}
 
 /**
- * Describes the message memos.api.v1.UpdateInstanceSettingRequest.
- * Use `create(UpdateInstanceSettingRequestSchema)` to create a new message.
+ * Describes the enum memos.api.v1.InstanceSetting.EmailSetting.TlsMode.
  */
-export const UpdateInstanceSettingRequestSchema: GenMessage<UpdateInstanceSettingRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_instance_service, 4);
+export const InstanceSetting_EmailSetting_TlsModeSchema: GenEnum<InstanceSetting_EmailSetting_TlsMode> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 4, 0);
 
 /**
- * @generated from service memos.api.v1.InstanceService
+ * Backup settings for the scheduled backups of the database and the local attachments.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.BackupSetting
  */
-export const InstanceService: GenService<{
+export type InstanceSetting_BackupSetting = Message<"memos.api.v1.InstanceSetting.BackupSetting"> & {
   /**
-   * Gets the instance profile.
+   * enabled turns on the scheduled backups.
    *
-   * @generated from rpc memos.api.v1.InstanceService.GetInstanceProfile
+   * @generated from field: bool enabled = 1;
    */
-  getInstanceProfile: {
-    methodKind: "unary";
-    input: typeof GetInstanceProfileRequestSchema;
-    output: typeof InstanceProfileSchema;
-  },
+  enabled: boolean;
+
   /**
-   * Gets an instance setting.
+   * schedule is when backups run, as a standard cron expression in UTC such as "0 3 * * *",
+   * or a descriptor such as "@daily" or "@every 12h". Defaults to "@daily".
    *
-   * @generated from rpc memos.api.v1.InstanceService.GetInstanceSetting
+   * @generated from field: string schedule = 2;
    */
-  getInstanceSetting: {
-    methodKind: "unary";
-    input: typeof GetInstanceSettingRequestSchema;
-    output: typeof InstanceSettingSchema;
-  },
+  schedule: string;
+
   /**
-   * Updates an instance setting.
+   * destination is where backups are written. Defaults to LOCAL.
    *
-   * @generated from rpc memos.api.v1.InstanceService.UpdateInstanceSetting
+   * @generated from field: memos.api.v1.InstanceSetting.BackupSetting.Destination destination = 3;
    */
-  updateInstanceSetting: {
-    methodKind: "unary";
+  destination: InstanceSetting_BackupSetting_Destination;
+
+  /**
+   * local_path is the directory of the LOCAL backups, relative to the data directory unless
+   * absolute. Defaults to "backups".
+   *
+   * @generated from field: string local_path = 4;
+   */
+  localPath: string;
+
+  /**
+   * s3_prefix is the key prefix of the S3 backups. Defaults to "backups/".
+   *
+   * @generated from field: string s3_prefix = 5;
+   */
+  s3Prefix: string;
+
+  /**
+   * retention_count is how many backups are kept, the oldest being pruned first.
+   * Defaults to 7.
+   *
+   * @generated from field: int32 retention_count = 6;
+   */
+  retentionCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.BackupSetting.
+ * Use `create(InstanceSetting_BackupSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_BackupSettingSchema: GenMessage<InstanceSetting_BackupSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 5);
+
+/**
+ * Destination of the backups.
+ *
+ * @generated from enum memos.api.v1.InstanceSetting.BackupSetting.Destination
+ */
+export enum InstanceSetting_BackupSetting_Destination {
+  /**
+   * @generated from enum value: DESTINATION_UNSPECIFIED = 0;
+   */
+  DESTINATION_UNSPECIFIED = 0,
+
+  /**
+   * LOCAL writes the backups to a directory of the server.
+   *
+   * @generated from enum value: LOCAL = 1;
+   */
+  LOCAL = 1,
+
+  /**
+   * S3 uploads the backups to the bucket of the S3 storage setting.
+   *
+   * @generated from enum value: S3 = 2;
+   */
+  S3 = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.InstanceSetting.BackupSetting.Destination.
+ */
+export const InstanceSetting_BackupSetting_DestinationSchema: GenEnum<InstanceSetting_BackupSetting_Destination> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 5, 0);
+
+/**
+ * Slack app settings for the /memo slash command and the "Save to memos" message action.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.SlackSetting
+ */
+export type InstanceSetting_SlackSetting = Message<"memos.api.v1.InstanceSetting.SlackSetting"> & {
+  /**
+   * signing_secret verifies the requests of the Slack app. The integration is disabled while
+   * it's empty.
+   *
+   * @generated from field: string signing_secret = 1;
+   */
+  signingSecret: string;
+
+  /**
+   * bot_token is the bot token of the Slack app, used to reply to messages, get the permalinks
+   * of messages and download their files.
+   *
+   * @generated from field: string bot_token = 2;
+   */
+  botToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.SlackSetting.
+ * Use `create(InstanceSetting_SlackSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_SlackSettingSchema: GenMessage<InstanceSetting_SlackSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 6);
+
+/**
+ * Matrix bot settings for creating memos from Matrix messages, and posting public memos.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.MatrixSetting
+ */
+export type InstanceSetting_MatrixSetting = Message<"memos.api.v1.InstanceSetting.MatrixSetting"> & {
+  /**
+   * homeserver_url is the URL of the homeserver of the bot account. The bot is disabled while
+   * it or the access token is empty.
+   *
+   * @generated from field: string homeserver_url = 1;
+   */
+  homeserverUrl: string;
+
+  /**
+   * access_token is the access token of the bot account.
+   *
+   * @generated from field: string access_token = 2;
+   */
+  accessToken: string;
+
+  /**
+   * rooms are the rooms the bot joined, added when the bot is invited.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceSetting.MatrixSetting.Room rooms = 3;
+   */
+  rooms: InstanceSetting_MatrixSetting_Room[];
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.MatrixSetting.
+ * Use `create(InstanceSetting_MatrixSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_MatrixSettingSchema: GenMessage<InstanceSetting_MatrixSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 7);
+
+/**
+ * @generated from message memos.api.v1.InstanceSetting.MatrixSetting.Room
+ */
+export type InstanceSetting_MatrixSetting_Room = Message<"memos.api.v1.InstanceSetting.MatrixSetting.Room"> & {
+  /**
+   * room_id is the ID of the room, e.g. "!abc:example.com".
+   *
+   * @generated from field: string room_id = 1;
+   */
+  roomId: string;
+
+  /**
+   * enabled turns the messages of the room into memos.
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * post_public_memos posts the new public memos of the users subscribed to the room.
+   *
+   * @generated from field: bool post_public_memos = 3;
+   */
+  postPublicMemos: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.MatrixSetting.Room.
+ * Use `create(InstanceSetting_MatrixSetting_RoomSchema)` to create a new message.
+ */
+export const InstanceSetting_MatrixSetting_RoomSchema: GenMessage<InstanceSetting_MatrixSetting_Room> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 7, 0);
+
+/**
+ * Announcement banner shown to all users, e.g. for a planned maintenance.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.AnnouncementSetting
+ */
+export type InstanceSetting_AnnouncementSetting = Message<"memos.api.v1.InstanceSetting.AnnouncementSetting"> & {
+  /**
+   * id identifies the content of the announcement, which the dismissals are for.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * content is the markdown text of the announcement. No announcement is shown while it's
+   * empty.
+   *
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * severity is how the announcement is highlighted. Defaults to INFO.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.AnnouncementSetting.Severity severity = 3;
+   */
+  severity: InstanceSetting_AnnouncementSetting_Severity;
+
+  /**
+   * start_time is when the announcement starts being shown. If not set, it's shown right away.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * end_time is when the announcement stops being shown. If not set, it's shown until it's
+   * removed.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * dismissible lets the users hide the announcement.
+   *
+   * @generated from field: bool dismissible = 6;
+   */
+  dismissible: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.AnnouncementSetting.
+ * Use `create(InstanceSetting_AnnouncementSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_AnnouncementSettingSchema: GenMessage<InstanceSetting_AnnouncementSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 8);
+
+/**
+ * @generated from enum memos.api.v1.InstanceSetting.AnnouncementSetting.Severity
+ */
+export enum InstanceSetting_AnnouncementSetting_Severity {
+  /**
+   * @generated from enum value: SEVERITY_UNSPECIFIED = 0;
+   */
+  SEVERITY_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INFO = 1;
+   */
+  INFO = 1,
+
+  /**
+   * @generated from enum value: WARNING = 2;
+   */
+  WARNING = 2,
+
+  /**
+   * @generated from enum value: CRITICAL = 3;
+   */
+  CRITICAL = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.InstanceSetting.AnnouncementSetting.Severity.
+ */
+export const InstanceSetting_AnnouncementSetting_SeveritySchema: GenEnum<InstanceSetting_AnnouncementSetting_Severity> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 8, 0);
+
+/**
+ * API rate limits, applied to each user or, for anonymous requests, to each IP. Reads and
+ * writes are limited separately.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.RateLimitSetting
+ */
+export type InstanceSetting_RateLimitSetting = Message<"memos.api.v1.InstanceSetting.RateLimitSetting"> & {
+  /**
+   * disabled turns the API rate limits off.
+   *
+   * @generated from field: bool disabled = 1;
+   */
+  disabled: boolean;
+
+  /**
+   * read_requests_per_minute is the number of read requests that may be made per minute.
+   * Defaults to 1200.
+   *
+   * @generated from field: int32 read_requests_per_minute = 2;
+   */
+  readRequestsPerMinute: number;
+
+  /**
+   * read_burst is the number of read requests that may be made in a burst. Defaults to 300.
+   *
+   * @generated from field: int32 read_burst = 3;
+   */
+  readBurst: number;
+
+  /**
+   * write_requests_per_minute is the number of write requests that may be made per minute.
+   * Defaults to 120.
+   *
+   * @generated from field: int32 write_requests_per_minute = 4;
+   */
+  writeRequestsPerMinute: number;
+
+  /**
+   * write_burst is the number of write requests that may be made in a burst. Defaults to 30.
+   *
+   * @generated from field: int32 write_burst = 5;
+   */
+  writeBurst: number;
+
+  /**
+   * host_multiplier multiplies the limits of the host. Defaults to 10.
+   *
+   * @generated from field: int32 host_multiplier = 6;
+   */
+  hostMultiplier: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.RateLimitSetting.
+ * Use `create(InstanceSetting_RateLimitSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_RateLimitSettingSchema: GenMessage<InstanceSetting_RateLimitSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 9);
+
+/**
+ * Enumeration of instance setting keys.
+ *
+ * @generated from enum memos.api.v1.InstanceSetting.Key
+ */
+export enum InstanceSetting_Key {
+  /**
+   * @generated from enum value: KEY_UNSPECIFIED = 0;
+   */
+  KEY_UNSPECIFIED = 0,
+
+  /**
+   * GENERAL is the key for general settings.
+   *
+   * @generated from enum value: GENERAL = 1;
+   */
+  GENERAL = 1,
+
+  /**
+   * STORAGE is the key for storage settings.
+   *
+   * @generated from enum value: STORAGE = 2;
+   */
+  STORAGE = 2,
+
+  /**
+   * MEMO_RELATED is the key for memo related settings.
+   *
+   * @generated from enum value: MEMO_RELATED = 3;
+   */
+  MEMO_RELATED = 3,
+
+  /**
+   * LINK_PREVIEW is the key for link preview settings.
+   *
+   * @generated from enum value: LINK_PREVIEW = 4;
+   */
+  LINK_PREVIEW = 4,
+
+  /**
+   * EMAIL is the key for email settings.
+   *
+   * @generated from enum value: EMAIL = 5;
+   */
+  EMAIL = 5,
+
+  /**
+   * BACKUP is the key for scheduled backup settings.
+   *
+   * @generated from enum value: BACKUP = 6;
+   */
+  BACKUP = 6,
+
+  /**
+   * SLACK is the key for Slack app settings.
+   *
+   * @generated from enum value: SLACK = 7;
+   */
+  SLACK = 7,
+
+  /**
+   * MATRIX is the key for Matrix bot settings.
+   *
+   * @generated from enum value: MATRIX = 8;
+   */
+  MATRIX = 8,
+}
+
+/**
+ * Describes the enum memos.api.v1.InstanceSetting.Key.
+ */
+export const InstanceSetting_KeySchema: GenEnum<InstanceSetting_Key> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 0);
+
+/**
+ * Request message for GetInstanceSetting method.
+ *
+ * @generated from message memos.api.v1.GetInstanceSettingRequest
+ */
+export type GetInstanceSettingRequest = Message<"memos.api.v1.GetInstanceSettingRequest"> & {
+  /**
+   * The resource name of the instance setting.
+   * Format: instance/settings/{setting}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetInstanceSettingRequest.
+ * Use `create(GetInstanceSettingRequestSchema)` to create a new message.
+ */
+export const GetInstanceSettingRequestSchema: GenMessage<GetInstanceSettingRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 3);
+
+/**
+ * Request message for DismissInstanceAnnouncement method.
+ *
+ * @generated from message memos.api.v1.DismissInstanceAnnouncementRequest
+ */
+export type DismissInstanceAnnouncementRequest = Message<"memos.api.v1.DismissInstanceAnnouncementRequest"> & {
+  /**
+   * The id of the announcement to dismiss.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DismissInstanceAnnouncementRequest.
+ * Use `create(DismissInstanceAnnouncementRequestSchema)` to create a new message.
+ */
+export const DismissInstanceAnnouncementRequestSchema: GenMessage<DismissInstanceAnnouncementRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 4);
+
+/**
+ * Request message for UpdateInstanceSetting method.
+ *
+ * @generated from message memos.api.v1.UpdateInstanceSettingRequest
+ */
+export type UpdateInstanceSettingRequest = Message<"memos.api.v1.UpdateInstanceSettingRequest"> & {
+  /**
+   * The instance setting resource which replaces the resource on the server.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting setting = 1;
+   */
+  setting?: InstanceSetting;
+
+  /**
+   * The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateInstanceSettingRequest.
+ * Use `create(UpdateInstanceSettingRequestSchema)` to create a new message.
+ */
+export const UpdateInstanceSettingRequestSchema: GenMessage<UpdateInstanceSettingRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 5);
+
+/**
+ * An audited authentication or admin event.
+ *
+ * @generated from message memos.api.v1.AuditLog
+ */
+export type AuditLog = Message<"memos.api.v1.AuditLog"> & {
+  /**
+   * The resource name of the audit log entry.
+   * Format: auditLogs/{id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The user who caused the event, empty for unauthenticated requests.
+   * Format: users/{user}
+   *
+   * @generated from field: string actor = 2;
+   */
+  actor: string;
+
+  /**
+   * The type of the event.
+   *
+   * @generated from field: memos.api.v1.AuditLog.EventType event_type = 3;
+   */
+  eventType: AuditLog_EventType;
+
+  /**
+   * The IP address of the client.
+   *
+   * @generated from field: string ip_address = 4;
+   */
+  ipAddress: string;
+
+  /**
+   * The user agent of the client.
+   *
+   * @generated from field: string user_agent = 5;
+   */
+  userAgent: string;
+
+  /**
+   * Event specific details.
+   *
+   * @generated from field: google.protobuf.Struct payload = 6;
+   */
+  payload?: Struct;
+
+  /**
+   * The time of the event.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 7;
+   */
+  createTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.AuditLog.
+ * Use `create(AuditLogSchema)` to create a new message.
+ */
+export const AuditLogSchema: GenMessage<AuditLog> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 6);
+
+/**
+ * Audited event types.
+ *
+ * @generated from enum memos.api.v1.AuditLog.EventType
+ */
+export enum AuditLog_EventType {
+  /**
+   * @generated from enum value: EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  EVENT_TYPE_UNSPECIFIED = 0,
+
+  /**
+   * A user signed in.
+   *
+   * @generated from enum value: SIGN_IN = 1;
+   */
+  SIGN_IN = 1,
+
+  /**
+   * A sign-in attempt failed.
+   *
+   * @generated from enum value: SIGN_IN_FAILED = 2;
+   */
+  SIGN_IN_FAILED = 2,
+
+  /**
+   * A user created an access token.
+   *
+   * @generated from enum value: ACCESS_TOKEN_CREATED = 3;
+   */
+  ACCESS_TOKEN_CREATED = 3,
+
+  /**
+   * A user revoked an access token.
+   *
+   * @generated from enum value: ACCESS_TOKEN_REVOKED = 4;
+   */
+  ACCESS_TOKEN_REVOKED = 4,
+
+  /**
+   * A session was signed out or revoked.
+   *
+   * @generated from enum value: SESSION_REVOKED = 5;
+   */
+  SESSION_REVOKED = 5,
+
+  /**
+   * A user's role was changed.
+   *
+   * @generated from enum value: USER_ROLE_CHANGED = 6;
+   */
+  USER_ROLE_CHANGED = 6,
+
+  /**
+   * A user was deleted.
+   *
+   * @generated from enum value: USER_DELETED = 7;
+   */
+  USER_DELETED = 7,
+
+  /**
+   * An instance setting was changed.
+   *
+   * @generated from enum value: INSTANCE_SETTING_CHANGED = 8;
+   */
+  INSTANCE_SETTING_CHANGED = 8,
+
+  /**
+   * A user reset their password with an emailed token.
+   *
+   * @generated from enum value: PASSWORD_RESET = 9;
+   */
+  PASSWORD_RESET = 9,
+
+  /**
+   * The signing key of JWTs was rotated.
+   *
+   * @generated from enum value: SIGNING_KEY_ROTATED = 10;
+   */
+  SIGNING_KEY_ROTATED = 10,
+
+  /**
+   * A retired signing key was expired.
+   *
+   * @generated from enum value: SIGNING_KEY_EXPIRED = 11;
+   */
+  SIGNING_KEY_EXPIRED = 11,
+
+  /**
+   * A user was suspended by the host.
+   *
+   * @generated from enum value: USER_SUSPENDED = 12;
+   */
+  USER_SUSPENDED = 12,
+
+  /**
+   * The suspension of a user was lifted.
+   *
+   * @generated from enum value: USER_UNSUSPENDED = 13;
+   */
+  USER_UNSUSPENDED = 13,
+}
+
+/**
+ * Describes the enum memos.api.v1.AuditLog.EventType.
+ */
+export const AuditLog_EventTypeSchema: GenEnum<AuditLog_EventType> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 6, 0);
+
+/**
+ * Request message for ListAuditLogs method.
+ *
+ * @generated from message memos.api.v1.ListAuditLogsRequest
+ */
+export type ListAuditLogsRequest = Message<"memos.api.v1.ListAuditLogsRequest"> & {
+  /**
+   * The maximum number of entries to return.
+   * If unspecified, at most 50 entries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * A page token, received from a previous `ListAuditLogs` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * Only return entries caused by this user.
+   * Format: users/{user}
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor: string;
+
+  /**
+   * Only return entries of this event type.
+   *
+   * @generated from field: memos.api.v1.AuditLog.EventType event_type = 4;
+   */
+  eventType: AuditLog_EventType;
+
+  /**
+   * Only return entries created at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Only return entries created at or before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAuditLogsRequest.
+ * Use `create(ListAuditLogsRequestSchema)` to create a new message.
+ */
+export const ListAuditLogsRequestSchema: GenMessage<ListAuditLogsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 7);
+
+/**
+ * Response message for ListAuditLogs method.
+ *
+ * @generated from message memos.api.v1.ListAuditLogsResponse
+ */
+export type ListAuditLogsResponse = Message<"memos.api.v1.ListAuditLogsResponse"> & {
+  /**
+   * The audit log entries, newest first.
+   *
+   * @generated from field: repeated memos.api.v1.AuditLog audit_logs = 1;
+   */
+  auditLogs: AuditLog[];
+
+  /**
+   * A token to retrieve the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAuditLogsResponse.
+ * Use `create(ListAuditLogsResponseSchema)` to create a new message.
+ */
+export const ListAuditLogsResponseSchema: GenMessage<ListAuditLogsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 8);
+
+/**
+ * Diagnostics of the instance.
+ *
+ * @generated from message memos.api.v1.InstanceDiagnostics
+ */
+export type InstanceDiagnostics = Message<"memos.api.v1.InstanceDiagnostics"> & {
+  /**
+   * The database driver, e.g. "sqlite", "mysql" or "postgres".
+   *
+   * @generated from field: string driver = 1;
+   */
+  driver: string;
+
+  /**
+   * The schema version of the database.
+   *
+   * @generated from field: string schema_version = 2;
+   */
+  schemaVersion: string;
+
+  /**
+   * The statistics of the database connection pool.
+   *
+   * @generated from field: memos.api.v1.InstanceDiagnostics.DatabaseStats database_stats = 3;
+   */
+  databaseStats?: InstanceDiagnostics_DatabaseStats;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceDiagnostics.
+ * Use `create(InstanceDiagnosticsSchema)` to create a new message.
+ */
+export const InstanceDiagnosticsSchema: GenMessage<InstanceDiagnostics> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 9);
+
+/**
+ * Statistics of the database connection pool.
+ *
+ * @generated from message memos.api.v1.InstanceDiagnostics.DatabaseStats
+ */
+export type InstanceDiagnostics_DatabaseStats = Message<"memos.api.v1.InstanceDiagnostics.DatabaseStats"> & {
+  /**
+   * The maximum number of open connections, 0 means unlimited.
+   *
+   * @generated from field: int32 max_open_connections = 1;
+   */
+  maxOpenConnections: number;
+
+  /**
+   * The number of established connections, both in use and idle.
+   *
+   * @generated from field: int32 open_connections = 2;
+   */
+  openConnections: number;
+
+  /**
+   * The number of connections in use.
+   *
+   * @generated from field: int32 in_use = 3;
+   */
+  inUse: number;
+
+  /**
+   * The number of idle connections.
+   *
+   * @generated from field: int32 idle = 4;
+   */
+  idle: number;
+
+  /**
+   * The total number of connections waited for.
+   *
+   * @generated from field: int64 wait_count = 5;
+   */
+  waitCount: bigint;
+
+  /**
+   * The total time blocked waiting for a new connection.
+   *
+   * @generated from field: google.protobuf.Duration wait_duration = 6;
+   */
+  waitDuration?: Duration;
+
+  /**
+   * The total number of connections closed due to the maximum idle connections.
+   *
+   * @generated from field: int64 max_idle_closed = 7;
+   */
+  maxIdleClosed: bigint;
+
+  /**
+   * The total number of connections closed due to the maximum idle time.
+   *
+   * @generated from field: int64 max_idle_time_closed = 8;
+   */
+  maxIdleTimeClosed: bigint;
+
+  /**
+   * The total number of connections closed due to the maximum connection lifetime.
+   *
+   * @generated from field: int64 max_lifetime_closed = 9;
+   */
+  maxLifetimeClosed: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceDiagnostics.DatabaseStats.
+ * Use `create(InstanceDiagnostics_DatabaseStatsSchema)` to create a new message.
+ */
+export const InstanceDiagnostics_DatabaseStatsSchema: GenMessage<InstanceDiagnostics_DatabaseStats> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 9, 0);
+
+/**
+ * Request for instance diagnostics.
+ *
+ * @generated from message memos.api.v1.GetInstanceDiagnosticsRequest
+ */
+export type GetInstanceDiagnosticsRequest = Message<"memos.api.v1.GetInstanceDiagnosticsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.GetInstanceDiagnosticsRequest.
+ * Use `create(GetInstanceDiagnosticsRequestSchema)` to create a new message.
+ */
+export const GetInstanceDiagnosticsRequestSchema: GenMessage<GetInstanceDiagnosticsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 10);
+
+/**
+ * Statistics of the instance.
+ *
+ * @generated from message memos.api.v1.InstanceStats
+ */
+export type InstanceStats = Message<"memos.api.v1.InstanceStats"> & {
+  /**
+   * The running version of the server.
+   *
+   * @generated from field: string version = 1;
+   */
+  version: string;
+
+  /**
+   * How long the server has been running.
+   *
+   * @generated from field: google.protobuf.Duration uptime = 2;
+   */
+  uptime?: Duration;
+
+  /**
+   * The time the statistics were computed, as they're cached for a few minutes.
+   *
+   * @generated from field: google.protobuf.Timestamp compute_time = 3;
+   */
+  computeTime?: Timestamp;
+
+  /**
+   * The number of users.
+   *
+   * @generated from field: int32 user_count = 4;
+   */
+  userCount: number;
+
+  /**
+   * The number of users who signed in or used a browser session within the last 30 days.
+   * Users who only use access tokens aren't counted.
+   *
+   * @generated from field: int32 active_user_count = 5;
+   */
+  activeUserCount: number;
+
+  /**
+   * The numbers of memos by visibility and state, comments included.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceStats.MemoCount memo_counts = 6;
+   */
+  memoCounts: InstanceStats_MemoCount[];
+
+  /**
+   * The numbers of memos created by day over the last 90 days, in UTC, ordered by date.
+   * Days without memos are omitted.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceStats.DailyMemoCount daily_memo_counts = 7;
+   */
+  dailyMemoCounts: InstanceStats_DailyMemoCount[];
+
+  /**
+   * The attachments by storage backend.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceStats.StorageUsage storage_usages = 8;
+   */
+  storageUsages: InstanceStats_StorageUsage[];
+
+  /**
+   * The number of webhooks of the users.
+   *
+   * @generated from field: int32 user_webhook_count = 9;
+   */
+  userWebhookCount: number;
+
+  /**
+   * The number of webhooks of the instance.
+   *
+   * @generated from field: int32 instance_webhook_count = 10;
+   */
+  instanceWebhookCount: number;
+
+  /**
+   * The size of the database, if the driver can report it.
+   *
+   * @generated from field: optional int64 database_size_bytes = 11;
+   */
+  databaseSizeBytes?: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceStats.
+ * Use `create(InstanceStatsSchema)` to create a new message.
+ */
+export const InstanceStatsSchema: GenMessage<InstanceStats> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 11);
+
+/**
+ * The number of memos with a visibility and state.
+ *
+ * @generated from message memos.api.v1.InstanceStats.MemoCount
+ */
+export type InstanceStats_MemoCount = Message<"memos.api.v1.InstanceStats.MemoCount"> & {
+  /**
+   * @generated from field: memos.api.v1.Visibility visibility = 1;
+   */
+  visibility: Visibility;
+
+  /**
+   * @generated from field: memos.api.v1.State state = 2;
+   */
+  state: State;
+
+  /**
+   * Whether the memos are in the trash.
+   *
+   * @generated from field: bool trashed = 3;
+   */
+  trashed: boolean;
+
+  /**
+   * @generated from field: int32 count = 4;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceStats.MemoCount.
+ * Use `create(InstanceStats_MemoCountSchema)` to create a new message.
+ */
+export const InstanceStats_MemoCountSchema: GenMessage<InstanceStats_MemoCount> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 11, 0);
+
+/**
+ * The number of memos created on a day.
+ *
+ * @generated from message memos.api.v1.InstanceStats.DailyMemoCount
+ */
+export type InstanceStats_DailyMemoCount = Message<"memos.api.v1.InstanceStats.DailyMemoCount"> & {
+  /**
+   * The date, in YYYY-MM-DD format.
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceStats.DailyMemoCount.
+ * Use `create(InstanceStats_DailyMemoCountSchema)` to create a new message.
+ */
+export const InstanceStats_DailyMemoCountSchema: GenMessage<InstanceStats_DailyMemoCount> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 11, 1);
+
+/**
+ * The attachments of a storage backend.
+ *
+ * @generated from message memos.api.v1.InstanceStats.StorageUsage
+ */
+export type InstanceStats_StorageUsage = Message<"memos.api.v1.InstanceStats.StorageUsage"> & {
+  /**
+   * The storage backend, "DATABASE", "LOCAL", "S3" or "EXTERNAL".
+   *
+   * @generated from field: string storage_type = 1;
+   */
+  storageType: string;
+
+  /**
+   * @generated from field: int32 attachment_count = 2;
+   */
+  attachmentCount: number;
+
+  /**
+   * The total size of the attachments. External attachments only count the size they were
+   * created with, if any.
+   *
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes: bigint;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceStats.StorageUsage.
+ * Use `create(InstanceStats_StorageUsageSchema)` to create a new message.
+ */
+export const InstanceStats_StorageUsageSchema: GenMessage<InstanceStats_StorageUsage> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 11, 2);
+
+/**
+ * Request for instance statistics.
+ *
+ * @generated from message memos.api.v1.GetInstanceStatsRequest
+ */
+export type GetInstanceStatsRequest = Message<"memos.api.v1.GetInstanceStatsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.GetInstanceStatsRequest.
+ * Use `create(GetInstanceStatsRequestSchema)` to create a new message.
+ */
+export const GetInstanceStatsRequestSchema: GenMessage<GetInstanceStatsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 12);
+
+/**
+ * The schema migration status of the instance database.
+ *
+ * @generated from message memos.api.v1.InstanceMigrationStatus
+ */
+export type InstanceMigrationStatus = Message<"memos.api.v1.InstanceMigrationStatus"> & {
+  /**
+   * The schema version of the database, empty if it isn't initialized.
+   *
+   * @generated from field: string schema_version = 1;
+   */
+  schemaVersion: string;
+
+  /**
+   * The schema version of the running release.
+   *
+   * @generated from field: string target_schema_version = 2;
+   */
+  targetSchemaVersion: string;
+
+  /**
+   * The applied migrations, oldest first.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceMigrationStatus.AppliedMigration applied_migrations = 3;
+   */
+  appliedMigrations: InstanceMigrationStatus_AppliedMigration[];
+
+  /**
+   * The number of migrations pending to reach the target schema version.
+   *
+   * @generated from field: int32 pending_count = 4;
+   */
+  pendingCount: number;
+
+  /**
+   * The pending migration files, in the order they are applied.
+   *
+   * @generated from field: repeated string pending_migrations = 5;
+   */
+  pendingMigrations: string[];
+
+  /**
+   * Whether the database is up to date, i.e. no migration is pending.
+   *
+   * @generated from field: bool up_to_date = 6;
+   */
+  upToDate: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceMigrationStatus.
+ * Use `create(InstanceMigrationStatusSchema)` to create a new message.
+ */
+export const InstanceMigrationStatusSchema: GenMessage<InstanceMigrationStatus> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 13);
+
+/**
+ * A migration file applied to the database.
+ *
+ * @generated from message memos.api.v1.InstanceMigrationStatus.AppliedMigration
+ */
+export type InstanceMigrationStatus_AppliedMigration = Message<"memos.api.v1.InstanceMigrationStatus.AppliedMigration"> & {
+  /**
+   * The path of the migration file, e.g. "0.25/09__schema_migration.sql", or "LATEST.sql"
+   * for the initial schema.
+   *
+   * @generated from field: string file = 1;
+   */
+  file: string;
+
+  /**
+   * The schema version of the migration.
+   *
+   * @generated from field: string version = 2;
+   */
+  version: string;
+
+  /**
+   * The hex-encoded SHA-256 of the file when it was applied.
+   *
+   * @generated from field: string checksum = 3;
+   */
+  checksum: string;
+
+  /**
+   * The execution time of the migration.
+   *
+   * @generated from field: google.protobuf.Duration duration = 4;
+   */
+  duration?: Duration;
+
+  /**
+   * The time the migration was applied.
+   *
+   * @generated from field: google.protobuf.Timestamp apply_time = 5;
+   */
+  applyTime?: Timestamp;
+
+  /**
+   * Whether the file was modified after it was applied.
+   *
+   * @generated from field: bool modified = 6;
+   */
+  modified: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceMigrationStatus.AppliedMigration.
+ * Use `create(InstanceMigrationStatus_AppliedMigrationSchema)` to create a new message.
+ */
+export const InstanceMigrationStatus_AppliedMigrationSchema: GenMessage<InstanceMigrationStatus_AppliedMigration> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 13, 0);
+
+/**
+ * Request for the instance migration status.
+ *
+ * @generated from message memos.api.v1.GetInstanceMigrationStatusRequest
+ */
+export type GetInstanceMigrationStatusRequest = Message<"memos.api.v1.GetInstanceMigrationStatusRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.GetInstanceMigrationStatusRequest.
+ * Use `create(GetInstanceMigrationStatusRequestSchema)` to create a new message.
+ */
+export const GetInstanceMigrationStatusRequestSchema: GenMessage<GetInstanceMigrationStatusRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 14);
+
+/**
+ * The status of the backups of the instance. The status of the last run is kept in memory, so
+ * it's reset when the server restarts.
+ *
+ * @generated from message memos.api.v1.InstanceBackupStatus
+ */
+export type InstanceBackupStatus = Message<"memos.api.v1.InstanceBackupStatus"> & {
+  /**
+   * Whether a backup is running.
+   *
+   * @generated from field: bool running = 1;
+   */
+  running: boolean;
+
+  /**
+   * The time the last backup started, unset if no backup ran since the server started.
+   *
+   * @generated from field: google.protobuf.Timestamp last_run_time = 2;
+   */
+  lastRunTime?: Timestamp;
+
+  /**
+   * The time the last backup finished.
+   *
+   * @generated from field: google.protobuf.Timestamp last_finish_time = 3;
+   */
+  lastFinishTime?: Timestamp;
+
+  /**
+   * The error of the last backup, empty if it succeeded.
+   *
+   * @generated from field: string last_error = 4;
+   */
+  lastError: string;
+
+  /**
+   * The file name of the archive of the last successful backup.
+   *
+   * @generated from field: string last_backup = 5;
+   */
+  lastBackup: string;
+
+  /**
+   * The size of the archive of the last successful backup.
+   *
+   * @generated from field: int64 last_backup_size_bytes = 6;
+   */
+  lastBackupSizeBytes: bigint;
+
+  /**
+   * The time of the next scheduled backup, unset if the scheduled backups are disabled.
+   *
+   * @generated from field: google.protobuf.Timestamp next_run_time = 7;
+   */
+  nextRunTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceBackupStatus.
+ * Use `create(InstanceBackupStatusSchema)` to create a new message.
+ */
+export const InstanceBackupStatusSchema: GenMessage<InstanceBackupStatus> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 15);
+
+/**
+ * @generated from message memos.api.v1.GetInstanceBackupStatusRequest
+ */
+export type GetInstanceBackupStatusRequest = Message<"memos.api.v1.GetInstanceBackupStatusRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.GetInstanceBackupStatusRequest.
+ * Use `create(GetInstanceBackupStatusRequestSchema)` to create a new message.
+ */
+export const GetInstanceBackupStatusRequestSchema: GenMessage<GetInstanceBackupStatusRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 16);
+
+/**
+ * @generated from message memos.api.v1.RunInstanceBackupRequest
+ */
+export type RunInstanceBackupRequest = Message<"memos.api.v1.RunInstanceBackupRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.RunInstanceBackupRequest.
+ * Use `create(RunInstanceBackupRequestSchema)` to create a new message.
+ */
+export const RunInstanceBackupRequestSchema: GenMessage<RunInstanceBackupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 17);
+
+/**
+ * @generated from message memos.api.v1.SendTestEmailRequest
+ */
+export type SendTestEmailRequest = Message<"memos.api.v1.SendTestEmailRequest"> & {
+  /**
+   * The address to send the test email to. Defaults to the email of the host.
+   *
+   * @generated from field: string recipient = 1;
+   */
+  recipient: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SendTestEmailRequest.
+ * Use `create(SendTestEmailRequestSchema)` to create a new message.
+ */
+export const SendTestEmailRequestSchema: GenMessage<SendTestEmailRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 18);
+
+/**
+ * A key that signs and verifies JWTs. The secret of the key is never returned.
+ *
+ * @generated from message memos.api.v1.SigningKey
+ */
+export type SigningKey = Message<"memos.api.v1.SigningKey"> & {
+  /**
+   * The resource name of the signing key. The last segment is the kid header of tokens
+   * signed with the key.
+   * Format: signingKeys/{key_id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The state of the key.
+   *
+   * @generated from field: memos.api.v1.SigningKey.State state = 2;
+   */
+  state: SigningKey_State;
+
+  /**
+   * The time the key was created, unset for the legacy key derived from the server secret.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The time the key was replaced as signing key, unset for the current key.
+   *
+   * @generated from field: google.protobuf.Timestamp retire_time = 4;
+   */
+  retireTime?: Timestamp;
+
+  /**
+   * The time tokens signed with the key stop being accepted, unset if they are accepted
+   * until the key is expired explicitly.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 5;
+   */
+  expireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.SigningKey.
+ * Use `create(SigningKeySchema)` to create a new message.
+ */
+export const SigningKeySchema: GenMessage<SigningKey> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 19);
+
+/**
+ * @generated from enum memos.api.v1.SigningKey.State
+ */
+export enum SigningKey_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The key signs new tokens.
+   *
+   * @generated from enum value: CURRENT = 1;
+   */
+  CURRENT = 1,
+
+  /**
+   * The key no longer signs tokens, but still verifies the tokens it signed.
+   *
+   * @generated from enum value: RETIRED = 2;
+   */
+  RETIRED = 2,
+
+  /**
+   * Tokens signed with the key are rejected.
+   *
+   * @generated from enum value: EXPIRED = 3;
+   */
+  EXPIRED = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.SigningKey.State.
+ */
+export const SigningKey_StateSchema: GenEnum<SigningKey_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 19, 0);
+
+/**
+ * Request message for ListSigningKeys method.
+ *
+ * @generated from message memos.api.v1.ListSigningKeysRequest
+ */
+export type ListSigningKeysRequest = Message<"memos.api.v1.ListSigningKeysRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListSigningKeysRequest.
+ * Use `create(ListSigningKeysRequestSchema)` to create a new message.
+ */
+export const ListSigningKeysRequestSchema: GenMessage<ListSigningKeysRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 20);
+
+/**
+ * Response message for ListSigningKeys method.
+ *
+ * @generated from message memos.api.v1.ListSigningKeysResponse
+ */
+export type ListSigningKeysResponse = Message<"memos.api.v1.ListSigningKeysResponse"> & {
+  /**
+   * The signing keys, newest first.
+   *
+   * @generated from field: repeated memos.api.v1.SigningKey signing_keys = 1;
+   */
+  signingKeys: SigningKey[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListSigningKeysResponse.
+ * Use `create(ListSigningKeysResponseSchema)` to create a new message.
+ */
+export const ListSigningKeysResponseSchema: GenMessage<ListSigningKeysResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 21);
+
+/**
+ * Request message for RotateSigningKey method.
+ *
+ * @generated from message memos.api.v1.RotateSigningKeyRequest
+ */
+export type RotateSigningKeyRequest = Message<"memos.api.v1.RotateSigningKeyRequest"> & {
+  /**
+   * How long tokens signed with the previous key stay valid.
+   * If unset, they stay valid until the key is expired with ExpireSigningKey.
+   *
+   * @generated from field: google.protobuf.Duration grace_period = 1;
+   */
+  gracePeriod?: Duration;
+};
+
+/**
+ * Describes the message memos.api.v1.RotateSigningKeyRequest.
+ * Use `create(RotateSigningKeyRequestSchema)` to create a new message.
+ */
+export const RotateSigningKeyRequestSchema: GenMessage<RotateSigningKeyRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 22);
+
+/**
+ * Request message for ExpireSigningKey method.
+ *
+ * @generated from message memos.api.v1.ExpireSigningKeyRequest
+ */
+export type ExpireSigningKeyRequest = Message<"memos.api.v1.ExpireSigningKeyRequest"> & {
+  /**
+   * The resource name of the signing key to expire.
+   * Format: signingKeys/{key_id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ExpireSigningKeyRequest.
+ * Use `create(ExpireSigningKeyRequestSchema)` to create a new message.
+ */
+export const ExpireSigningKeyRequestSchema: GenMessage<ExpireSigningKeyRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 23);
+
+/**
+ * @generated from service memos.api.v1.InstanceService
+ */
+export const InstanceService: GenService<{
+  /**
+   * Gets the instance profile.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetInstanceProfile
+   */
+  getInstanceProfile: {
+    methodKind: "unary";
+    input: typeof GetInstanceProfileRequestSchema;
+    output: typeof InstanceProfileSchema;
+  },
+  /**
+   * Gets an instance setting.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetInstanceSetting
+   */
+  getInstanceSetting: {
+    methodKind: "unary";
+    input: typeof GetInstanceSettingRequestSchema;
+    output: typeof InstanceSettingSchema;
+  },
+  /**
+   * Updates an instance setting.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.UpdateInstanceSetting
+   */
+  updateInstanceSetting: {
+    methodKind: "unary";
     input: typeof UpdateInstanceSettingRequestSchema;
     output: typeof InstanceSettingSchema;
   },
+  /**
+   * Dismisses the announcement of the instance for the current user, until its content changes.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.DismissInstanceAnnouncement
+   */
+  dismissInstanceAnnouncement: {
+    methodKind: "unary";
+    input: typeof DismissInstanceAnnouncementRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Lists the audit log of authentication and admin events, newest first.
+   * Only the host can list audit logs.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.ListAuditLogs
+   */
+  listAuditLogs: {
+    methodKind: "unary";
+    input: typeof ListAuditLogsRequestSchema;
+    output: typeof ListAuditLogsResponseSchema;
+  },
+  /**
+   * Gets the diagnostics of the instance, such as the database connection pool statistics.
+   * Only admins can get the diagnostics.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetInstanceDiagnostics
+   */
+  getInstanceDiagnostics: {
+    methodKind: "unary";
+    input: typeof GetInstanceDiagnosticsRequestSchema;
+    output: typeof InstanceDiagnosticsSchema;
+  },
+  /**
+   * Gets the statistics of the instance: its users, memos, attachments and webhooks, along with
+   * the version and uptime of the server. The statistics are cached for a few minutes.
+   * Only the host can get the statistics.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetInstanceStats
+   */
+  getInstanceStats: {
+    methodKind: "unary";
+    input: typeof GetInstanceStatsRequestSchema;
+    output: typeof InstanceStatsSchema;
+  },
+  /**
+   * Gets the schema migration status of the database: the schema version, the history of the
+   * applied migrations and the pending migrations.
+   * Only admins can get the migration status.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetInstanceMigrationStatus
+   */
+  getInstanceMigrationStatus: {
+    methodKind: "unary";
+    input: typeof GetInstanceMigrationStatusRequestSchema;
+    output: typeof InstanceMigrationStatusSchema;
+  },
+  /**
+   * Gets the status of the scheduled backups: the last run, the next run and whether a backup is
+   * running. Only the host can get the backup status.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.GetInstanceBackupStatus
+   */
+  getInstanceBackupStatus: {
+    methodKind: "unary";
+    input: typeof GetInstanceBackupStatusRequestSchema;
+    output: typeof InstanceBackupStatusSchema;
+  },
+  /**
+   * Starts a backup now, with the backup setting of the instance. The backup runs in the
+   * background, and its result is reported by GetInstanceBackupStatus.
+   * Only the host can run backups.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.RunInstanceBackup
+   */
+  runInstanceBackup: {
+    methodKind: "unary";
+    input: typeof RunInstanceBackupRequestSchema;
+    output: typeof InstanceBackupStatusSchema;
+  },
+  /**
+   * Sends a test email with the email setting of the instance, to check the SMTP settings.
+   * Only the host can send test emails.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.SendTestEmail
+   */
+  sendTestEmail: {
+    methodKind: "unary";
+    input: typeof SendTestEmailRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Lists the keys that sign and verify JWTs, newest first.
+   * Only the host can list signing keys.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.ListSigningKeys
+   */
+  listSigningKeys: {
+    methodKind: "unary";
+    input: typeof ListSigningKeysRequestSchema;
+    output: typeof ListSigningKeysResponseSchema;
+  },
+  /**
+   * Rotates the signing key. New tokens are signed with a new key, while tokens signed with
+   * the previous key stay valid for the grace period.
+   * Only the host can rotate the signing key.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.RotateSigningKey
+   */
+  rotateSigningKey: {
+    methodKind: "unary";
+    input: typeof RotateSigningKeyRequestSchema;
+    output: typeof SigningKeySchema;
+  },
+  /**
+   * Expires a retired signing key, so tokens signed with it are rejected immediately.
+   * Only the host can expire signing keys.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.ExpireSigningKey
+   */
+  expireSigningKey: {
+    methodKind: "unary";
+    input: typeof ExpireSigningKeyRequestSchema;
+    output: typeof SigningKeySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_instance_service, 0);
 
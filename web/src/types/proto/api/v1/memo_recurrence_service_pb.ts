@@ -0,0 +1,379 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/memo_recurrence_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/memo_recurrence_service.proto.
+ */
+export const file_api_v1_memo_recurrence_service: GenFile = /*@__PURE__*/
+  fileDesc("CiRhcGkvdjEvbWVtb19yZWN1cnJlbmNlX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSKhBAoOTWVtb1JlY3VycmVuY2USEQoEbmFtZRgBIAEoCUID4EEIEhIKBXJydWxlGAIgASgJQgPgQQISGAoLdGltZV9vZl9kYXkYAyABKAlCA+BBAhIVCgh0aW1lem9uZRgEIAEoCUID4EEBEhcKDW1lbW9fdGVtcGxhdGUYBSABKAlIABIOCgRtZW1vGAYgASgJSAASEQoEdGFncxgHIAMoCUID4EEBEhMKBnBhdXNlZBgIIAEoCEID4EEDEj4KFW5leHRfb2NjdXJyZW5jZV90aW1lcxgJIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI9ChRsYXN0X29jY3VycmVuY2VfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0CgtjcmVhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAzpx6kFuChttZW1vcy5hcGkudjEvTWVtb1JlY3VycmVuY2USLnVzZXJzL3t1c2VyfS9tZW1vUmVjdXJyZW5jZXMve21lbW9fcmVjdXJyZW5jZX0qD21lbW9SZWN1cnJlbmNlczIObWVtb1JlY3VycmVuY2VCCAoGc291cmNlIlEKGkxpc3RNZW1vUmVjdXJyZW5jZXNSZXF1ZXN0EjMKBnBhcmVudBgBIAEoCUIj4EEC+kEdEhttZW1vcy5hcGkudjEvTWVtb1JlY3VycmVuY2UiVQobTGlzdE1lbW9SZWN1cnJlbmNlc1Jlc3BvbnNlEjYKEG1lbW9fcmVjdXJyZW5jZXMYASADKAsyHC5tZW1vcy5hcGkudjEuTWVtb1JlY3VycmVuY2UiTQoYR2V0TWVtb1JlY3VycmVuY2VSZXF1ZXN0EjEKBG5hbWUYASABKAlCI+BBAvpBHQobbWVtb3MuYXBpLnYxL01lbW9SZWN1cnJlbmNlIo4BChtDcmVhdGVNZW1vUmVjdXJyZW5jZVJlcXVlc3QSMwoGcGFyZW50GAEgASgJQiPgQQL6QR0SG21lbW9zLmFwaS52MS9NZW1vUmVjdXJyZW5jZRI6Cg9tZW1vX3JlY3VycmVuY2UYAiABKAsyHC5tZW1vcy5hcGkudjEuTWVtb1JlY3VycmVuY2VCA+BBAiKPAQobVXBkYXRlTWVtb1JlY3VycmVuY2VSZXF1ZXN0EjoKD21lbW9fcmVjdXJyZW5jZRgBIAEoCzIcLm1lbW9zLmFwaS52MS5NZW1vUmVjdXJyZW5jZUID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlAKG0RlbGV0ZU1lbW9SZWN1cnJlbmNlUmVxdWVzdBIxCgRuYW1lGAEgASgJQiPgQQL6QR0KG21lbW9zLmFwaS52MS9NZW1vUmVjdXJyZW5jZSJPChpQYXVzZU1lbW9SZWN1cnJlbmNlUmVxdWVzdBIxCgRuYW1lGAEgASgJQiPgQQL6QR0KG21lbW9zLmFwaS52MS9NZW1vUmVjdXJyZW5jZSJQChtSZXN1bWVNZW1vUmVjdXJyZW5jZVJlcXVlc3QSMQoEbmFtZRgBIAEoCUIj4EEC+kEdChttZW1vcy5hcGkudjEvTWVtb1JlY3VycmVuY2UywQkKFU1lbW9SZWN1cnJlbmNlU2VydmljZRKlAQoTTGlzdE1lbW9SZWN1cnJlbmNlcxIoLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlY3VycmVuY2VzUmVxdWVzdBopLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlY3VycmVuY2VzUmVzcG9uc2UiOdpBBnBhcmVudILT5JMCKhIoL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L21lbW9SZWN1cnJlbmNlcxKSAQoRR2V0TWVtb1JlY3VycmVuY2USJi5tZW1vcy5hcGkudjEuR2V0TWVtb1JlY3VycmVuY2VSZXF1ZXN0GhwubWVtb3MuYXBpLnYxLk1lbW9SZWN1cnJlbmNlIjfaQQRuYW1lgtPkkwIqEigvYXBpL3YxL3tuYW1lPXVzZXJzLyovbWVtb1JlY3VycmVuY2VzLyp9ErsBChRDcmVhdGVNZW1vUmVjdXJyZW5jZRIpLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vUmVjdXJyZW5jZVJlcXVlc3QaHC5tZW1vcy5hcGkudjEuTWVtb1JlY3VycmVuY2UiWtpBFnBhcmVudCxtZW1vX3JlY3VycmVuY2WC0+STAjs6D21lbW9fcmVjdXJyZW5jZSIoL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L21lbW9SZWN1cnJlbmNlcxLQAQoUVXBkYXRlTWVtb1JlY3VycmVuY2USKS5tZW1vcy5hcGkudjEuVXBkYXRlTWVtb1JlY3VycmVuY2VSZXF1ZXN0GhwubWVtb3MuYXBpLnYxLk1lbW9SZWN1cnJlbmNlIm/aQRttZW1vX3JlY3VycmVuY2UsdXBkYXRlX21hc2uC0+STAks6D21lbW9fcmVjdXJyZW5jZTI4L2FwaS92MS97bWVtb19yZWN1cnJlbmNlLm5hbWU9dXNlcnMvKi9tZW1vUmVjdXJyZW5jZXMvKn0SkgEKFERlbGV0ZU1lbW9SZWN1cnJlbmNlEikubWVtb3MuYXBpLnYxLkRlbGV0ZU1lbW9SZWN1cnJlbmNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI32kEEbmFtZYLT5JMCKiooL2FwaS92MS97bmFtZT11c2Vycy8qL21lbW9SZWN1cnJlbmNlcy8qfRKfAQoTUGF1c2VNZW1vUmVjdXJyZW5jZRIoLm1lbW9zLmFwaS52MS5QYXVzZU1lbW9SZWN1cnJlbmNlUmVxdWVzdBocLm1lbW9zLmFwaS52MS5NZW1vUmVjdXJyZW5jZSJA2kEEbmFtZYLT5JMCMzoBKiIuL2FwaS92MS97bmFtZT11c2Vycy8qL21lbW9SZWN1cnJlbmNlcy8qfTpwYXVzZRKiAQoUUmVzdW1lTWVtb1JlY3VycmVuY2USKS5tZW1vcy5hcGkudjEuUmVzdW1lTWVtb1JlY3VycmVuY2VSZXF1ZXN0GhwubWVtb3MuYXBpLnYxLk1lbW9SZWN1cnJlbmNlIkHaQQRuYW1lgtPkkwI0OgEqIi8vYXBpL3YxL3tuYW1lPXVzZXJzLyovbWVtb1JlY3VycmVuY2VzLyp9OnJlc3VtZUKyAQoQY29tLm1lbW9zLmFwaS52MUIaTWVtb1JlY3VycmVuY2VTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.MemoRecurrence
+ */
+export type MemoRecurrence = Message<"memos.api.v1.MemoRecurrence"> & {
+  /**
+   * The resource name of the memo recurrence.
+   * Format: users/{user}/memoRecurrences/{memo_recurrence}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The recurrence rule, a subset of an RFC 5545 RRULE: FREQ (DAILY or WEEKLY), INTERVAL and
+   * BYDAY (MO to SU), e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH". A weekly rule without BYDAY
+   * occurs on the weekday of the creation of the recurrence.
+   *
+   * @generated from field: string rrule = 2;
+   */
+  rrule: string;
+
+  /**
+   * The local time of the occurrences, formatted as HH:MM, e.g. "08:00".
+   *
+   * @generated from field: string time_of_day = 3;
+   */
+  timeOfDay: string;
+
+  /**
+   * The IANA time zone of the occurrences, e.g. "Europe/Paris". If empty, the time zone of the
+   * user is used.
+   *
+   * @generated from field: string timezone = 4;
+   */
+  timezone: string;
+
+  /**
+   * The source of the memos created at each occurrence.
+   *
+   * @generated from oneof memos.api.v1.MemoRecurrence.source
+   */
+  source: {
+    /**
+     * A memo template of the user, whose placeholders are expanded at the occurrence.
+     * Format: users/{user}/memoTemplates/{memo_template}
+     *
+     * @generated from field: string memo_template = 5;
+     */
+    value: string;
+    case: "memoTemplate";
+  } | {
+    /**
+     * A memo of the user, whose content and visibility are copied.
+     * Format: memos/{memo}
+     *
+     * @generated from field: string memo = 6;
+     */
+    value: string;
+    case: "memo";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * The tags, without the # prefix, added to the created memos if their content doesn't have them.
+   *
+   * @generated from field: repeated string tags = 7;
+   */
+  tags: string[];
+
+  /**
+   * Whether the recurrence is paused.
+   *
+   * @generated from field: bool paused = 8;
+   */
+  paused: boolean;
+
+  /**
+   * The next three occurrences of the recurrence. Empty if the recurrence is paused.
+   *
+   * @generated from field: repeated google.protobuf.Timestamp next_occurrence_times = 9;
+   */
+  nextOccurrenceTimes: Timestamp[];
+
+  /**
+   * The occurrence that created the last memo, if any.
+   *
+   * @generated from field: google.protobuf.Timestamp last_occurrence_time = 10;
+   */
+  lastOccurrenceTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 11;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp update_time = 12;
+   */
+  updateTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoRecurrence.
+ * Use `create(MemoRecurrenceSchema)` to create a new message.
+ */
+export const MemoRecurrenceSchema: GenMessage<MemoRecurrence> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRecurrencesRequest
+ */
+export type ListMemoRecurrencesRequest = Message<"memos.api.v1.ListMemoRecurrencesRequest"> & {
+  /**
+   * Required. The parent resource where memo recurrences are listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRecurrencesRequest.
+ * Use `create(ListMemoRecurrencesRequestSchema)` to create a new message.
+ */
+export const ListMemoRecurrencesRequestSchema: GenMessage<ListMemoRecurrencesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRecurrencesResponse
+ */
+export type ListMemoRecurrencesResponse = Message<"memos.api.v1.ListMemoRecurrencesResponse"> & {
+  /**
+   * The list of memo recurrences, from the oldest to the newest.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRecurrence memo_recurrences = 1;
+   */
+  memoRecurrences: MemoRecurrence[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRecurrencesResponse.
+ * Use `create(ListMemoRecurrencesResponseSchema)` to create a new message.
+ */
+export const ListMemoRecurrencesResponseSchema: GenMessage<ListMemoRecurrencesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 2);
+
+/**
+ * @generated from message memos.api.v1.GetMemoRecurrenceRequest
+ */
+export type GetMemoRecurrenceRequest = Message<"memos.api.v1.GetMemoRecurrenceRequest"> & {
+  /**
+   * Required. The resource name of the memo recurrence to retrieve.
+   * Format: users/{user}/memoRecurrences/{memo_recurrence}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoRecurrenceRequest.
+ * Use `create(GetMemoRecurrenceRequestSchema)` to create a new message.
+ */
+export const GetMemoRecurrenceRequestSchema: GenMessage<GetMemoRecurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoRecurrenceRequest
+ */
+export type CreateMemoRecurrenceRequest = Message<"memos.api.v1.CreateMemoRecurrenceRequest"> & {
+  /**
+   * Required. The parent resource where this memo recurrence will be created.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The memo recurrence to create.
+   *
+   * @generated from field: memos.api.v1.MemoRecurrence memo_recurrence = 2;
+   */
+  memoRecurrence?: MemoRecurrence;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoRecurrenceRequest.
+ * Use `create(CreateMemoRecurrenceRequestSchema)` to create a new message.
+ */
+export const CreateMemoRecurrenceRequestSchema: GenMessage<CreateMemoRecurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 4);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoRecurrenceRequest
+ */
+export type UpdateMemoRecurrenceRequest = Message<"memos.api.v1.UpdateMemoRecurrenceRequest"> & {
+  /**
+   * Required. The memo recurrence resource which replaces the resource on the server.
+   *
+   * @generated from field: memos.api.v1.MemoRecurrence memo_recurrence = 1;
+   */
+  memoRecurrence?: MemoRecurrence;
+
+  /**
+   * Required. The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoRecurrenceRequest.
+ * Use `create(UpdateMemoRecurrenceRequestSchema)` to create a new message.
+ */
+export const UpdateMemoRecurrenceRequestSchema: GenMessage<UpdateMemoRecurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 5);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoRecurrenceRequest
+ */
+export type DeleteMemoRecurrenceRequest = Message<"memos.api.v1.DeleteMemoRecurrenceRequest"> & {
+  /**
+   * Required. The resource name of the memo recurrence to delete.
+   * Format: users/{user}/memoRecurrences/{memo_recurrence}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoRecurrenceRequest.
+ * Use `create(DeleteMemoRecurrenceRequestSchema)` to create a new message.
+ */
+export const DeleteMemoRecurrenceRequestSchema: GenMessage<DeleteMemoRecurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 6);
+
+/**
+ * @generated from message memos.api.v1.PauseMemoRecurrenceRequest
+ */
+export type PauseMemoRecurrenceRequest = Message<"memos.api.v1.PauseMemoRecurrenceRequest"> & {
+  /**
+   * Required. The resource name of the memo recurrence to pause.
+   * Format: users/{user}/memoRecurrences/{memo_recurrence}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.PauseMemoRecurrenceRequest.
+ * Use `create(PauseMemoRecurrenceRequestSchema)` to create a new message.
+ */
+export const PauseMemoRecurrenceRequestSchema: GenMessage<PauseMemoRecurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 7);
+
+/**
+ * @generated from message memos.api.v1.ResumeMemoRecurrenceRequest
+ */
+export type ResumeMemoRecurrenceRequest = Message<"memos.api.v1.ResumeMemoRecurrenceRequest"> & {
+  /**
+   * Required. The resource name of the memo recurrence to resume.
+   * Format: users/{user}/memoRecurrences/{memo_recurrence}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ResumeMemoRecurrenceRequest.
+ * Use `create(ResumeMemoRecurrenceRequestSchema)` to create a new message.
+ */
+export const ResumeMemoRecurrenceRequestSchema: GenMessage<ResumeMemoRecurrenceRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_recurrence_service, 8);
+
+/**
+ * @generated from service memos.api.v1.MemoRecurrenceService
+ */
+export const MemoRecurrenceService: GenService<{
+  /**
+   * ListMemoRecurrences returns the memo recurrences of a user.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.ListMemoRecurrences
+   */
+  listMemoRecurrences: {
+    methodKind: "unary";
+    input: typeof ListMemoRecurrencesRequestSchema;
+    output: typeof ListMemoRecurrencesResponseSchema;
+  },
+  /**
+   * GetMemoRecurrence gets a memo recurrence by name.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.GetMemoRecurrence
+   */
+  getMemoRecurrence: {
+    methodKind: "unary";
+    input: typeof GetMemoRecurrenceRequestSchema;
+    output: typeof MemoRecurrenceSchema;
+  },
+  /**
+   * CreateMemoRecurrence creates a memo recurrence for a user. Its first occurrence is the first
+   * one after the creation.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.CreateMemoRecurrence
+   */
+  createMemoRecurrence: {
+    methodKind: "unary";
+    input: typeof CreateMemoRecurrenceRequestSchema;
+    output: typeof MemoRecurrenceSchema;
+  },
+  /**
+   * UpdateMemoRecurrence updates a memo recurrence of a user. Changing its schedule moves its next
+   * occurrence to the first one of the new schedule after the update.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.UpdateMemoRecurrence
+   */
+  updateMemoRecurrence: {
+    methodKind: "unary";
+    input: typeof UpdateMemoRecurrenceRequestSchema;
+    output: typeof MemoRecurrenceSchema;
+  },
+  /**
+   * DeleteMemoRecurrence deletes a memo recurrence of a user. The memos it created are kept.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.DeleteMemoRecurrence
+   */
+  deleteMemoRecurrence: {
+    methodKind: "unary";
+    input: typeof DeleteMemoRecurrenceRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * PauseMemoRecurrence stops a memo recurrence from creating memos until it is resumed.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.PauseMemoRecurrence
+   */
+  pauseMemoRecurrence: {
+    methodKind: "unary";
+    input: typeof PauseMemoRecurrenceRequestSchema;
+    output: typeof MemoRecurrenceSchema;
+  },
+  /**
+   * ResumeMemoRecurrence resumes a paused memo recurrence from its first occurrence after now. The
+   * occurrences while it was paused are skipped.
+   *
+   * @generated from rpc memos.api.v1.MemoRecurrenceService.ResumeMemoRecurrence
+   */
+  resumeMemoRecurrence: {
+    methodKind: "unary";
+    input: typeof ResumeMemoRecurrenceRequestSchema;
+    output: typeof MemoRecurrenceSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_memo_recurrence_service, 0);
+
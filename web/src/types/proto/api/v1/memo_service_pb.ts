@@ -20,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/memo_service.proto.
  */
 export const file_api_v1_memo_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEimgIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOkvqQUgKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIUcmVhY3Rpb25zL3tyZWFjdGlvbn0aBG5hbWUqCXJlYWN0aW9uczIIcmVhY3Rpb24i/gYKBE1lbW8SEQoEbmFtZRgBIAEoCUID4EEIEicKBXN0YXRlGAIgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQISKgoHY3JlYXRvchgDIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI1CgxkaXNwbGF5X3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESFAoHY29udGVudBgHIAEoCUID4EECEjEKCnZpc2liaWxpdHkYCSABKA4yGC5tZW1vcy5hcGkudjEuVmlzaWJpbGl0eUID4EECEhEKBHRhZ3MYCiADKAlCA+BBAxITCgZwaW5uZWQYCyABKAhCA+BBARIyCgthdHRhY2htZW50cxgMIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQESMgoJcmVsYXRpb25zGA0gAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EEBEi4KCXJlYWN0aW9ucxgOIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EEDEjIKCHByb3BlcnR5GA8gASgLMhsubWVtb3MuYXBpLnYxLk1lbW8uUHJvcGVydHlCA+BBAxIuCgZwYXJlbnQYECABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL01lbW9IAIgBARIUCgdzbmlwcGV0GBEgASgJQgPgQQMSMgoIbG9jYXRpb24YEiABKAsyFi5tZW1vcy5hcGkudjEuTG9jYXRpb25CA+BBAUgBiAEBGmMKCFByb3BlcnR5EhAKCGhhc19saW5rGAEgASgIEhUKDWhhc190YXNrX2xpc3QYAiABKAgSEAoIaGFzX2NvZGUYAyABKAgSHAoUaGFzX2luY29tcGxldGVfdGFza3MYBCABKAg6N+pBNAoRbWVtb3MuYXBpLnYxL01lbW8SDG1lbW9zL3ttZW1vfRoEbmFtZSoFbWVtb3MyBG1lbW9CCQoHX3BhcmVudEILCglfbG9jYXRpb24iUwoITG9jYXRpb24SGAoLcGxhY2Vob2xkZXIYASABKAlCA+BBARIVCghsYXRpdHVkZRgCIAEoAUID4EEBEhYKCWxvbmdpdHVkZRgDIAEoAUID4EEBIlAKEUNyZWF0ZU1lbW9SZXF1ZXN0EiUKBG1lbW8YASABKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EECEhQKB21lbW9faWQYAiABKAlCA+BBASKzAQoQTGlzdE1lbW9zUmVxdWVzdBIWCglwYWdlX3NpemUYASABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAIgASgJQgPgQQESJwoFc3RhdGUYAyABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBARIVCghvcmRlcl9ieRgEIAEoCUID4EEBEhMKBmZpbHRlchgFIAEoCUID4EEBEhkKDHNob3dfZGVsZXRlZBgGIAEoCEID4EEBIk8KEUxpc3RNZW1vc1Jlc3BvbnNlEiEKBW1lbW9zGAEgAygLMhIubWVtb3MuYXBpLnYxLk1lbW8SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIjkKDkdldE1lbW9SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8icAoRVXBkYXRlTWVtb1JlcXVlc3QSJQoEbWVtbxgBIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiUAoRRGVsZXRlTWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxISCgVmb3JjZRgCIAEoCEID4EEBIngKGVNldE1lbW9BdHRhY2htZW50c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIyCgthdHRhY2htZW50cxgCIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQIidgoaTGlzdE1lbW9BdHRhY2htZW50c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEiZQobTGlzdE1lbW9BdHRhY2htZW50c1Jlc3BvbnNlEi0KC2F0dGFjaG1lbnRzGAEgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIrMCCgxNZW1vUmVsYXRpb24SMgoEbWVtbxgBIAEoCzIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uTWVtb0ID4EECEjoKDHJlbGF0ZWRfbWVtbxgCIAEoCzIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uTWVtb0ID4EECEjIKBHR5cGUYAyABKA4yHy5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uLlR5cGVCA+BBAhpFCgRNZW1vEicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFAoHc25pcHBldBgCIAEoCUID4EEDIjgKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEg0KCVJFRkVSRU5DRRABEgsKB0NPTU1FTlQQAiJ2ChdTZXRNZW1vUmVsYXRpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjIKCXJlbGF0aW9ucxgCIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb25CA+BBAiJ0ChhMaXN0TWVtb1JlbGF0aW9uc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEiYwoZTGlzdE1lbW9SZWxhdGlvbnNSZXNwb25zZRItCglyZWxhdGlvbnMYASADKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKGAQoYQ3JlYXRlTWVtb0NvbW1lbnRSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SKAoHY29tbWVudBgCIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISFwoKY29tbWVudF9pZBgDIAEoCUID4EEBIooBChdMaXN0TWVtb0NvbW1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARIVCghvcmRlcl9ieRgEIAEoCUID4EEBImoKGExpc3RNZW1vQ29tbWVudHNSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFInQKGExpc3RNZW1vUmVhY3Rpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJzChlMaXN0TWVtb1JlYWN0aW9uc1Jlc3BvbnNlEikKCXJlYWN0aW9ucxgBIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJzChlVcHNlcnRNZW1vUmVhY3Rpb25SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SLQoIcmVhY3Rpb24YAiABKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb25CA+BBAiJIChlEZWxldGVNZW1vUmVhY3Rpb25SZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL1JlYWN0aW9uKlAKClZpc2liaWxpdHkSGgoWVklTSUJJTElUWV9VTlNQRUNJRklFRBAAEgsKB1BSSVZBVEUQARINCglQUk9URUNURUQQAhIKCgZQVUJMSUMQAzLLDgoLTWVtb1NlcnZpY2USZQoKQ3JlYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiLaQQRtZW1vgtPkkwIVOgRtZW1vIg0vYXBpL3YxL21lbW9zEmYKCUxpc3RNZW1vcxIeLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1Jlc3BvbnNlIhjaQQCC0+STAg8SDS9hcGkvdjEvbWVtb3MSYgoHR2V0TWVtbxIcLm1lbW9zLmFwaS52MS5HZXRNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPW1lbW9zLyp9En8KClVwZGF0ZU1lbW8SHy5tZW1vcy5hcGkudjEuVXBkYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI82kEQbWVtbyx1cGRhdGVfbWFza4LT5JMCIzoEbWVtbzIbL2FwaS92MS97bWVtby5uYW1lPW1lbW9zLyp9EmwKCkRlbGV0ZU1lbW8SHy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJdpBBG5hbWWC0+STAhgqFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SiwEKElNldE1lbW9BdHRhY2htZW50cxInLm1lbW9zLmFwaS52MS5TZXRNZW1vQXR0YWNobWVudHNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjTaQQRuYW1lgtPkkwInOgEqMiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEp0BChNMaXN0TWVtb0F0dGFjaG1lbnRzEigubWVtb3MuYXBpLnYxLkxpc3RNZW1vQXR0YWNobWVudHNSZXF1ZXN0GikubWVtb3MuYXBpLnYxLkxpc3RNZW1vQXR0YWNobWVudHNSZXNwb25zZSIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1tZW1vcy8qfS9hdHRhY2htZW50cxKFAQoQU2V0TWVtb1JlbGF0aW9ucxIlLm1lbW9zLmFwaS52MS5TZXRNZW1vUmVsYXRpb25zUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIy2kEEbmFtZYLT5JMCJToBKjIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWxhdGlvbnMSlQEKEUxpc3RNZW1vUmVsYXRpb25zEiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKQAQoRQ3JlYXRlTWVtb0NvbW1lbnQSJi5tZW1vcy5hcGkudjEuQ3JlYXRlTWVtb0NvbW1lbnRSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iP9pBDG5hbWUsY29tbWVudILT5JMCKjoHY29tbWVudCIfL2FwaS92MS97bmFtZT1tZW1vcy8qfS9jb21tZW50cxKRAQoQTGlzdE1lbW9Db21tZW50cxIlLm1lbW9zLmFwaS52MS5MaXN0TWVtb0NvbW1lbnRzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0TWVtb0NvbW1lbnRzUmVzcG9uc2UiLtpBBG5hbWWC0+STAiESHy9hcGkvdjEve25hbWU9bWVtb3MvKn0vY29tbWVudHMSlQEKEUxpc3RNZW1vUmVhY3Rpb25zEiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVhY3Rpb25zUmVxdWVzdBonLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlYWN0aW9uc1Jlc3BvbnNlIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlYWN0aW9ucxKJAQoSVXBzZXJ0TWVtb1JlYWN0aW9uEicubWVtb3MuYXBpLnYxLlVwc2VydE1lbW9SZWFjdGlvblJlcXVlc3QaFi5tZW1vcy5hcGkudjEuUmVhY3Rpb24iMtpBBG5hbWWC0+STAiU6ASoiIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVhY3Rpb25zEoABChJEZWxldGVNZW1vUmVhY3Rpb24SJy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlYWN0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIp2kEEbmFtZYLT5JMCHCoaL2FwaS92MS97bmFtZT1yZWFjdGlvbnMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEE1lbW9TZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEimgIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOkvqQUgKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIUcmVhY3Rpb25zL3tyZWFjdGlvbn0aBG5hbWUqCXJlYWN0aW9uczIIcmVhY3Rpb24i4QoKBE1lbW8SEQoEbmFtZRgBIAEoCUID4EEIEicKBXN0YXRlGAIgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQISKgoHY3JlYXRvchgDIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI1CgxkaXNwbGF5X3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESFAoHY29udGVudBgHIAEoCUID4EECEjEKCnZpc2liaWxpdHkYCSABKA4yGC5tZW1vcy5hcGkudjEuVmlzaWJpbGl0eUID4EECEhEKBHRhZ3MYCiADKAlCA+BBAxITCgZwaW5uZWQYCyABKAhCA+BBARIyCgthdHRhY2htZW50cxgMIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQESMgoJcmVsYXRpb25zGA0gAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EEBEi4KCXJlYWN0aW9ucxgOIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EEDEjIKCHByb3BlcnR5GA8gASgLMhsubWVtb3MuYXBpLnYxLk1lbW8uUHJvcGVydHlCA+BBAxIuCgZwYXJlbnQYECABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL01lbW9IAIgBARIUCgdzbmlwcGV0GBEgASgJQgPgQQMSMgoIbG9jYXRpb24YEiABKAsyFi5tZW1vcy5hcGkudjEuTG9jYXRpb25CA+BBAUgBiAEBEjQKC2RlbGV0ZV90aW1lGBMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEhsKDnNlYXJjaF9zbmlwcGV0GBQgASgJQgPgQQMSNQoMcHVibGlzaF90aW1lGBUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEjEKCHJlbWluZGVyGBYgASgLMhoubWVtb3MuYXBpLnYxLk1lbW9SZW1pbmRlckID4EEBEh0KEGRpc2FibGVfY29tbWVudHMYFyABKAhCA+BBARI0CgtleHBpcmVfdGltZRgYIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBARIxCghib29rbWFyaxgZIAEoCzIaLm1lbW9zLmFwaS52MS5NZW1vQm9va21hcmtCA+BBAxIRCgRzbHVnGBogASgJQgPgQQESNAoKd2lraV9saW5rcxgbIAMoCzIbLm1lbW9zLmFwaS52MS5NZW1vLldpa2lMaW5rQgPgQQMSEQoEZXRhZxgcIAEoCUID4EEDGmMKCFByb3BlcnR5EhAKCGhhc19saW5rGAEgASgIEhUKDWhhc190YXNrX2xpc3QYAiABKAgSEAoIaGFzX2NvZGUYAyABKAgSHAoUaGFzX2luY29tcGxldGVfdGFza3MYBCABKAgaQAoIV2lraUxpbmsSDgoGdGFyZ2V0GAEgASgJEiQKBG1lbW8YAiABKAlCFvpBEwoRbWVtb3MuYXBpLnYxL01lbW86N+pBNAoRbWVtb3MuYXBpLnYxL01lbW8SDG1lbW9zL3ttZW1vfRoEbmFtZSoFbWVtb3MyBG1lbW9CCQoHX3BhcmVudEILCglfbG9jYXRpb24i7AEKDE1lbW9SZW1pbmRlchI0CgtyZW1pbmRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAhI2CgZyZXBlYXQYAiABKA4yIS5tZW1vcy5hcGkudjEuTWVtb1JlbWluZGVyLlJlcGVhdEID4EEBEjUKDGRlbGl2ZXJfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyI3CgZSZXBlYXQSFgoSUkVQRUFUX1VOU1BFQ0lGSUVEEAASCQoFREFJTFkQARIKCgZXRUVLTFkQAiJOCgxNZW1vQm9va21hcmsSCwoDdXJsGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg0KBWltYWdlGAQgASgJIm8KCExvY2F0aW9uEhgKC3BsYWNlaG9sZGVyGAEgASgJQgPgQQESFQoIbGF0aXR1ZGUYAiABKAFCA+BBARIWCglsb25naXR1ZGUYAyABKAFCA+BBARIaCg1zaG93X3B1YmxpY2x5GAQgASgIQgPgQQEiUAoRQ3JlYXRlTWVtb1JlcXVlc3QSJQoEbWVtbxgBIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISFAoHbWVtb19pZBgCIAEoCUID4EEBIokBChlDcmVhdGVCb29rbWFya01lbW9SZXF1ZXN0EhAKA3VybBgBIAEoCUID4EECEhQKB2NvbW1lbnQYAiABKAlCA+BBARIRCgR0YWdzGAMgAygJQgPgQQESMQoKdmlzaWJpbGl0eRgEIAEoDjIYLm1lbW9zLmFwaS52MS5WaXNpYmlsaXR5QgPgQQEiYgoaQ3JlYXRlQm9va21hcmtNZW1vUmVzcG9uc2USIAoEbWVtbxgBIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vEg8KB3dhcm5pbmcYAiABKAkSEQoJZHVwbGljYXRlGAMgASgIIuUBChBMaXN0TWVtb3NSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARInCgVzdGF0ZRgDIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EEBEhUKCG9yZGVyX2J5GAQgASgJQgPgQQESEwoGZmlsdGVyGAUgASgJQgPgQQESGQoMc2hvd19kZWxldGVkGAYgASgIQgPgQQESEwoGc2VhcmNoGAcgASgJQgPgQQESGwoOc2hvd19zY2hlZHVsZWQYCCABKAhCA+BBASJxChFMaXN0TWVtb3NSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRIgChhhdHRhY2htZW50X21hdGNoZWRfbWVtb3MYAyADKAkiWwoYR2V0TWVtb0hpZ2hsaWdodHNSZXF1ZXN0EhIKBW1vbnRoGAEgASgFQgPgQQESEAoDZGF5GAIgASgFQgPgQQESGQoMcmFuZG9tX2NvdW50GAMgASgFQgPgQQEidAoZR2V0TWVtb0hpZ2hsaWdodHNSZXNwb25zZRItChFvbl90aGlzX2RheV9tZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEigKDHJhbmRvbV9tZW1vcxgCIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vIhYKFEdldE1lbW9Db3VudHNSZXF1ZXN0IloKFUdldE1lbW9Db3VudHNSZXNwb25zZRIUCgxub3JtYWxfY291bnQYASABKAUSFgoOYXJjaGl2ZWRfY291bnQYAiABKAUSEwoLZHJhZnRfY291bnQYAyABKAUidgoeUHJldmlld0F1dG9BcmNoaXZlTWVtb3NSZXF1ZXN0EhYKBGRheXMYASABKAVCA+BBAUgAiAEBEhsKDnByb3RlY3RlZF90YWdzGAIgAygJQgPgQQESFgoJcGFnZV9zaXplGAMgASgFQgPgQQFCBwoFX2RheXMiWAofUHJldmlld0F1dG9BcmNoaXZlTWVtb3NSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhIKCnRvdGFsX3NpemUYAiABKAUiOQoOR2V0TWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbyJwChFVcGRhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJQChFEZWxldGVNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhIKBWZvcmNlGAIgASgIQgPgQQEiPQoSUmVzdG9yZU1lbW9SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8idAoURHVwbGljYXRlTWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCgljb3B5X3RhZ3MYAiABKAhCA+BBARIbCg5jb3B5X3JlbGF0aW9ucxgDIAEoCEID4EEBIpIBChFNZXJnZU1lbW9zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEikKBnNvdXJjZRgCIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIbCglzZXBhcmF0b3IYAyABKAlCA+BBAUgAiAEBQgwKCl9zZXBhcmF0b3IiXQodQ3JlYXRlTWVtb0Zyb21XaWtpTGlua1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxITCgZ0YXJnZXQYAiABKAlCA+BBAiKQAQoVVXBkYXRlTWVtb1Rhc2tSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SDwoFaW5kZXgYAiABKAVIABIRCgd0YXNrX2lkGAMgASgJSAASDwoHY2hlY2tlZBgEIAEoCBIRCgRldGFnGAUgASgJQgPgQQJCBgoEdGFzayJhChZVcGRhdGVNZW1vVGFza1Jlc3BvbnNlEiAKBG1lbW8YASABKAsyEi5tZW1vcy5hcGkudjEuTWVtbxIlCgV0YXNrcxgCIAMoCzIWLm1lbW9zLmFwaS52MS5NZW1vVGFzayJECghNZW1vVGFzaxIKCgJpZBgBIAEoCRINCgVpbmRleBgCIAEoBRIMCgR0ZXh0GAMgASgJEg8KB2NoZWNrZWQYBCABKAgiiwEKFU1vdmVQaW5uZWRNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhEKB21vdmVfdXAYAiABKAhIABITCgltb3ZlX2Rvd24YAyABKAhIABISCghwb3NpdGlvbhgEIAEoBUgAQg0KC2Rlc3RpbmF0aW9uIjsKEFB1cmdlTWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbyKvAgoMTWVtb1JldmlzaW9uEhEKBG5hbWUYASABKAlCA+BBCBITCgZlZGl0b3IYAiABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIUCgdjb250ZW50GAQgASgJQgPgQQMSMgoLYXR0YWNobWVudHMYBSADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EEDEhEKBGRpZmYYBiABKAlCA+BBAzpk6kFhChltZW1vcy5hcGkudjEvTWVtb1JldmlzaW9uEiFtZW1vcy97bWVtb30vcmV2aXNpb25zL3tyZXZpc2lvbn0aBG5hbWUqDW1lbW9SZXZpc2lvbnMyDG1lbW9SZXZpc2lvbiJ2ChhMaXN0TWVtb1JldmlzaW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJjChlMaXN0TWVtb1JldmlzaW9uc1Jlc3BvbnNlEi0KCXJldmlzaW9ucxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmV2aXNpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIkkKFkdldE1lbW9SZXZpc2lvblJlcXVlc3QSLwoEbmFtZRgBIAEoCUIh4EEC+kEbChltZW1vcy5hcGkudjEvTWVtb1JldmlzaW9uIk0KGlJlc3RvcmVNZW1vUmV2aXNpb25SZXF1ZXN0Ei8KBG5hbWUYASABKAlCIeBBAvpBGwoZbWVtb3MuYXBpLnYxL01lbW9SZXZpc2lvbiL0AgoNTWVtb1NoYXJlTGluaxIRCgRuYW1lGAEgASgJQgPgQQgSEQoEc2x1ZxgCIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC2V4cGlyZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEhYKCW1heF92aWV3cxgFIAEoBUID4EEBEhcKCnZpZXdfY291bnQYBiABKAVCA+BBAxIXCgpwYXNzcGhyYXNlGAcgASgJQgPgQQQSGwoOaGFzX3Bhc3NwaHJhc2UYCCABKAhCA+BBAzpq6kFnChptZW1vcy5hcGkudjEvTWVtb1NoYXJlTGluaxIkbWVtb3Mve21lbW99L3NoYXJlTGlua3Mve3NoYXJlX2xpbmt9GgRuYW1lKg5tZW1vU2hhcmVMaW5rczINbWVtb1NoYXJlTGluayJ9ChpDcmVhdGVNZW1vU2hhcmVMaW5rUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SNAoKc2hhcmVfbGluaxgCIAEoCzIbLm1lbW9zLmFwaS52MS5NZW1vU2hhcmVMaW5rQgPgQQEiRgoZTGlzdE1lbW9TaGFyZUxpbmtzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8iTgoaTGlzdE1lbW9TaGFyZUxpbmtzUmVzcG9uc2USMAoLc2hhcmVfbGlua3MYASADKAsyGy5tZW1vcy5hcGkudjEuTWVtb1NoYXJlTGluayKIAQoaVXBkYXRlTWVtb1NoYXJlTGlua1JlcXVlc3QSNAoKc2hhcmVfbGluaxgBIAEoCzIbLm1lbW9zLmFwaS52MS5NZW1vU2hhcmVMaW5rQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiTgoaRGVsZXRlTWVtb1NoYXJlTGlua1JlcXVlc3QSMAoEbmFtZRgBIAEoCUIi4EEC+kEcChptZW1vcy5hcGkudjEvTWVtb1NoYXJlTGluayJEChRHZXRTaGFyZWRNZW1vUmVxdWVzdBIRCgRzbHVnGAEgASgJQgPgQQISGQoMYWNjZXNzX3Rva2VuGAIgASgJQgPgQQEiRQoXVW5sb2NrU2hhcmVkTWVtb1JlcXVlc3QSEQoEc2x1ZxgBIAEoCUID4EECEhcKCnBhc3NwaHJhc2UYAiABKAlCA+BBAiJhChhVbmxvY2tTaGFyZWRNZW1vUmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAEgASgJEi8KC2V4cGlyZV90aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJ6ChlTbm9vemVNZW1vUmVtaW5kZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SNAoLcmVtaW5kX3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQIiRgobQ29tcGxldGVNZW1vUmVtaW5kZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8iQAoSTGlzdFRhZ1RyZWVSZXF1ZXN0EioKB2NyZWF0b3IYASABKAlCGeBBAfpBEwoRbWVtb3MuYXBpLnYxL1VzZXIiPgoTTGlzdFRhZ1RyZWVSZXNwb25zZRInCgR0YWdzGAEgAygLMhkubWVtb3MuYXBpLnYxLlRhZ1RyZWVOb2RlImkKC1RhZ1RyZWVOb2RlEgsKA3RhZxgBIAEoCRIMCgRuYW1lGAIgASgJEhIKCm1lbW9fY291bnQYAyABKAUSKwoIY2hpbGRyZW4YBCADKAsyGS5tZW1vcy5hcGkudjEuVGFnVHJlZU5vZGUiXgoUUmVuYW1lTWVtb1RhZ1JlcXVlc3QSFAoHb2xkX3RhZxgBIAEoCUID4EECEhQKB25ld190YWcYAiABKAlCA+BBAhIaCg12YWxpZGF0ZV9vbmx5GAMgASgIQgPgQQEiOgoVUmVuYW1lTWVtb1RhZ1Jlc3BvbnNlEg0KBW1lbW9zGAEgAygJEhIKCm1lbW9fY291bnQYAiABKAUi8AEKF0JhdGNoVXBkYXRlTWVtb3NSZXF1ZXN0EigKBW5hbWVzGAEgAygJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjIKDnNldF92aXNpYmlsaXR5GAIgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlIABIoCglzZXRfc3RhdGUYAyABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVIABIRCgdhZGRfdGFnGAQgASgJSAASFAoKcmVtb3ZlX3RhZxgFIAEoCUgAEhcKDW1vdmVfdG9fdHJhc2gYBiABKAhIAEILCglvcGVyYXRpb24itAEKGEJhdGNoVXBkYXRlTWVtb3NSZXNwb25zZRIXCg9zdWNjZWVkZWRfY291bnQYASABKAUSFAoMZmFpbGVkX2NvdW50GAIgASgFEkAKCGZhaWx1cmVzGAMgAygLMi4ubWVtb3MuYXBpLnYxLkJhdGNoVXBkYXRlTWVtb3NSZXNwb25zZS5GYWlsdXJlGicKB0ZhaWx1cmUSDAoEbmFtZRgBIAEoCRIOCgZyZWFzb24YAiABKAki6QEKEkltcG9ydE1lbW9zUmVxdWVzdBIUCgdjb250ZW50GAEgASgMQgPgQQISMQoKdmlzaWJpbGl0eRgCIAEoDjIYLm1lbW9zLmFwaS52MS5WaXNpYmlsaXR5QgPgQQESPAoGZm9ybWF0GAMgASgOMicubWVtb3MuYXBpLnYxLkltcG9ydE1lbW9zUmVxdWVzdC5Gb3JtYXRCA+BBASJMCgZGb3JtYXQSFgoSRk9STUFUX1VOU1BFQ0lGSUVEEAASDAoITUFSS0RPV04QARIPCgtHT09HTEVfS0VFUBACEgsKB0RBWV9PTkUQAyJFChRHZXRNZW1vSW1wb3J0UmVxdWVzdBItCgRuYW1lGAEgASgJQh/gQQL6QRkKF21lbW9zLmFwaS52MS9NZW1vSW1wb3J0IrcECgpNZW1vSW1wb3J0EhEKBG5hbWUYASABKAlCA+BBCBIyCgVzdGF0ZRgCIAEoDjIeLm1lbW9zLmFwaS52MS5NZW1vSW1wb3J0LlN0YXRlQgPgQQMSGAoLdG90YWxfZmlsZXMYAyABKAVCA+BBAxIcCg9wcm9jZXNzZWRfZmlsZXMYBCABKAVCA+BBAxIaCg1jcmVhdGVkX21lbW9zGAUgASgFQgPgQQMSGgoNc2tpcHBlZF9maWxlcxgGIAEoBUID4EEDEjcKBmVycm9ycxgHIAMoCzIiLm1lbW9zLmFwaS52MS5NZW1vSW1wb3J0LkZpbGVFcnJvckID4EEDEjQKC2NyZWF0ZV90aW1lGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC2ZpbmlzaF90aW1lGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDGi0KCUZpbGVFcnJvchIQCghmaWxlbmFtZRgBIAEoCRIOCgZyZWFzb24YAiABKAkiRgoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJRUQQABILCgdSVU5OSU5HEAESDQoJU1VDQ0VFREVEEAISCgoGRkFJTEVEEAM6VupBUwoXbWVtb3MuYXBpLnYxL01lbW9JbXBvcnQSGW1lbW9JbXBvcnRzL3ttZW1vX2ltcG9ydH0aBG5hbWUqC21lbW9JbXBvcnRzMgptZW1vSW1wb3J0IngKGVNldE1lbW9BdHRhY2htZW50c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIyCgthdHRhY2htZW50cxgCIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQIidgoaTGlzdE1lbW9BdHRhY2htZW50c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEiZQobTGlzdE1lbW9BdHRhY2htZW50c1Jlc3BvbnNlEi0KC2F0dGFjaG1lbnRzGAEgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIr4CCgxNZW1vUmVsYXRpb24SMgoEbWVtbxgBIAEoCzIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uTWVtb0ID4EECEjoKDHJlbGF0ZWRfbWVtbxgCIAEoCzIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uTWVtb0ID4EECEjIKBHR5cGUYAyABKA4yHy5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uLlR5cGVCA+BBAhpFCgRNZW1vEicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFAoHc25pcHBldBgCIAEoCUID4EEDIkMKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEg0KCVJFRkVSRU5DRRABEgsKB0NPTU1FTlQQAhIJCgVNRVJHRRADInYKF1NldE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoJcmVsYXRpb25zGAIgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EECInQKGExpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJjChlMaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlEi0KCXJlbGF0aW9ucxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInQKGExpc3RNZW1vQmFja2xpbmtzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJkChlMaXN0TWVtb0JhY2tsaW5rc1Jlc3BvbnNlEi4KBW1lbW9zGAEgAygLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKGAQoYQ3JlYXRlTWVtb0NvbW1lbnRSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SKAoHY29tbWVudBgCIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISFwoKY29tbWVudF9pZBgDIAEoCUID4EEBIooBChdMaXN0TWVtb0NvbW1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARIVCghvcmRlcl9ieRgEIAEoCUID4EEBImoKGExpc3RNZW1vQ29tbWVudHNSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFInQKGExpc3RNZW1vUmVhY3Rpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJzChlMaXN0TWVtb1JlYWN0aW9uc1Jlc3BvbnNlEikKCXJlYWN0aW9ucxgBIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJzChlVcHNlcnRNZW1vUmVhY3Rpb25SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SLQoIcmVhY3Rpb24YAiABKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb25CA+BBAiJIChlEZWxldGVNZW1vUmVhY3Rpb25SZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL1JlYWN0aW9uKlAKClZpc2liaWxpdHkSGgoWVklTSUJJTElUWV9VTlNQRUNJRklFRBAAEgsKB1BSSVZBVEUQARINCglQUk9URUNURUQQAhIKCgZQVUJMSUMQAzLNLQoLTWVtb1NlcnZpY2USZQoKQ3JlYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiLaQQRtZW1vgtPkkwIVOgRtZW1vIg0vYXBpL3YxL21lbW9zEmYKCUxpc3RNZW1vcxIeLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1Jlc3BvbnNlIhjaQQCC0+STAg8SDS9hcGkvdjEvbWVtb3MSiQEKEUdldE1lbW9IaWdobGlnaHRzEiYubWVtb3MuYXBpLnYxLkdldE1lbW9IaWdobGlnaHRzUmVxdWVzdBonLm1lbW9zLmFwaS52MS5HZXRNZW1vSGlnaGxpZ2h0c1Jlc3BvbnNlIiPaQQCC0+STAhoSGC9hcGkvdjEvbWVtb3M6aGlnaGxpZ2h0cxJ5Cg1HZXRNZW1vQ291bnRzEiIubWVtb3MuYXBpLnYxLkdldE1lbW9Db3VudHNSZXF1ZXN0GiMubWVtb3MuYXBpLnYxLkdldE1lbW9Db3VudHNSZXNwb25zZSIf2kEAgtPkkwIWEhQvYXBpL3YxL21lbW9zOmNvdW50cxKjAQoXUHJldmlld0F1dG9BcmNoaXZlTWVtb3MSLC5tZW1vcy5hcGkudjEuUHJldmlld0F1dG9BcmNoaXZlTWVtb3NSZXF1ZXN0Gi0ubWVtb3MuYXBpLnYxLlByZXZpZXdBdXRvQXJjaGl2ZU1lbW9zUmVzcG9uc2UiK9pBAILT5JMCIhIgL2FwaS92MS9tZW1vczpwcmV2aWV3QXV0b0FyY2hpdmUSYgoHR2V0TWVtbxIcLm1lbW9zLmFwaS52MS5HZXRNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPW1lbW9zLyp9En8KClVwZGF0ZU1lbW8SHy5tZW1vcy5hcGkudjEuVXBkYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI82kEQbWVtbyx1cGRhdGVfbWFza4LT5JMCIzoEbWVtbzIbL2FwaS92MS97bWVtby5uYW1lPW1lbW9zLyp9EmwKCkRlbGV0ZU1lbW8SHy5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiJdpBBG5hbWWC0+STAhgqFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SdQoLUmVzdG9yZU1lbW8SIC5tZW1vcy5hcGkudjEuUmVzdG9yZU1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iMNpBBG5hbWWC0+STAiM6ASoiHi9hcGkvdjEve25hbWU9bWVtb3MvKn06cmVzdG9yZRJzCglQdXJnZU1lbW8SHi5tZW1vcy5hcGkudjEuUHVyZ2VNZW1vUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIu2kEEbmFtZYLT5JMCIToBKiIcL2FwaS92MS97bmFtZT1tZW1vcy8qfTpwdXJnZRKZAQoRTGlzdE1lbW9SZXZpc2lvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZXZpc2lvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmV2aXNpb25zUmVzcG9uc2UiM9pBBnBhcmVudILT5JMCJBIiL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L3JldmlzaW9ucxKGAQoPR2V0TWVtb1JldmlzaW9uEiQubWVtb3MuYXBpLnYxLkdldE1lbW9SZXZpc2lvblJlcXVlc3QaGi5tZW1vcy5hcGkudjEuTWVtb1JldmlzaW9uIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPW1lbW9zLyovcmV2aXNpb25zLyp9EpEBChNSZXN0b3JlTWVtb1JldmlzaW9uEigubWVtb3MuYXBpLnYxLlJlc3RvcmVNZW1vUmV2aXNpb25SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iPNpBBG5hbWWC0+STAi86ASoiKi9hcGkvdjEve25hbWU9bWVtb3MvKi9yZXZpc2lvbnMvKn06cmVzdG9yZRKpAQoTQ3JlYXRlTWVtb1NoYXJlTGluaxIoLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vU2hhcmVMaW5rUmVxdWVzdBobLm1lbW9zLmFwaS52MS5NZW1vU2hhcmVMaW5rIkvaQRFwYXJlbnQsc2hhcmVfbGlua4LT5JMCMToKc2hhcmVfbGluayIjL2FwaS92MS97cGFyZW50PW1lbW9zLyp9L3NoYXJlTGlua3MSnQEKEkxpc3RNZW1vU2hhcmVMaW5rcxInLm1lbW9zLmFwaS52MS5MaXN0TWVtb1NoYXJlTGlua3NSZXF1ZXN0GigubWVtb3MuYXBpLnYxLkxpc3RNZW1vU2hhcmVMaW5rc1Jlc3BvbnNlIjTaQQZwYXJlbnSC0+STAiUSIy9hcGkvdjEve3BhcmVudD1tZW1vcy8qfS9zaGFyZUxpbmtzErkBChNVcGRhdGVNZW1vU2hhcmVMaW5rEigubWVtb3MuYXBpLnYxLlVwZGF0ZU1lbW9TaGFyZUxpbmtSZXF1ZXN0GhsubWVtb3MuYXBpLnYxLk1lbW9TaGFyZUxpbmsiW9pBFnNoYXJlX2xpbmssdXBkYXRlX21hc2uC0+STAjw6CnNoYXJlX2xpbmsyLi9hcGkvdjEve3NoYXJlX2xpbmsubmFtZT1tZW1vcy8qL3NoYXJlTGlua3MvKn0SiwEKE0RlbGV0ZU1lbW9TaGFyZUxpbmsSKC5tZW1vcy5hcGkudjEuRGVsZXRlTWVtb1NoYXJlTGlua1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMtpBBG5hbWWC0+STAiUqIy9hcGkvdjEve25hbWU9bWVtb3MvKi9zaGFyZUxpbmtzLyp9Em0KDUdldFNoYXJlZE1lbW8SIi5tZW1vcy5hcGkudjEuR2V0U2hhcmVkTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIk2kEEc2x1Z4LT5JMCFxIVL2FwaS92MS9zaGFyZXMve3NsdWd9EpwBChBVbmxvY2tTaGFyZWRNZW1vEiUubWVtb3MuYXBpLnYxLlVubG9ja1NoYXJlZE1lbW9SZXF1ZXN0GiYubWVtb3MuYXBpLnYxLlVubG9ja1NoYXJlZE1lbW9SZXNwb25zZSI52kEPc2x1ZyxwYXNzcGhyYXNlgtPkkwIhOgEqIhwvYXBpL3YxL3NoYXJlcy97c2x1Z306dW5sb2NrEnsKDUR1cGxpY2F0ZU1lbW8SIi5tZW1vcy5hcGkudjEuRHVwbGljYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIy2kEEbmFtZYLT5JMCJToBKiIgL2FwaS92MS97bmFtZT1tZW1vcy8qfTpkdXBsaWNhdGUSeAoKTWVyZ2VNZW1vcxIfLm1lbW9zLmFwaS52MS5NZXJnZU1lbW9zUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjXaQQtuYW1lLHNvdXJjZYLT5JMCIToBKiIcL2FwaS92MS97bmFtZT1tZW1vcy8qfTptZXJnZRKdAQoWQ3JlYXRlTWVtb0Zyb21XaWtpTGluaxIrLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vRnJvbVdpa2lMaW5rUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIkLaQQtuYW1lLHRhcmdldILT5JMCLjoBKiIpL2FwaS92MS97bmFtZT1tZW1vcy8qfTpjcmVhdGVGcm9tV2lraUxpbmsSmAEKDlVwZGF0ZU1lbW9UYXNrEiMubWVtb3MuYXBpLnYxLlVwZGF0ZU1lbW9UYXNrUmVxdWVzdBokLm1lbW9zLmFwaS52MS5VcGRhdGVNZW1vVGFza1Jlc3BvbnNlIjvaQQxuYW1lLGNoZWNrZWSC0+STAiY6ASoiIS9hcGkvdjEve25hbWU9bWVtb3MvKn06dXBkYXRlVGFzaxJ7Cg5Nb3ZlUGlubmVkTWVtbxIjLm1lbW9zLmFwaS52MS5Nb3ZlUGlubmVkTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIw2kEEbmFtZYLT5JMCIzoBKiIeL2FwaS92MS97bmFtZT1tZW1vcy8qfTptb3ZlUGluEpYBChJTbm9vemVNZW1vUmVtaW5kZXISJy5tZW1vcy5hcGkudjEuU25vb3plTWVtb1JlbWluZGVyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIkPaQRBuYW1lLHJlbWluZF90aW1lgtPkkwIqOgEqIiUvYXBpL3YxL3tuYW1lPW1lbW9zLyp9OnNub296ZVJlbWluZGVyEpABChRDb21wbGV0ZU1lbW9SZW1pbmRlchIpLm1lbW9zLmFwaS52MS5Db21wbGV0ZU1lbW9SZW1pbmRlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI52kEEbmFtZYLT5JMCLDoBKiInL2FwaS92MS97bmFtZT1tZW1vcy8qfTpjb21wbGV0ZVJlbWluZGVyEpYBChJDcmVhdGVCb29rbWFya01lbW8SJy5tZW1vcy5hcGkudjEuQ3JlYXRlQm9va21hcmtNZW1vUmVxdWVzdBooLm1lbW9zLmFwaS52MS5DcmVhdGVCb29rbWFya01lbW9SZXNwb25zZSIt2kEDdXJsgtPkkwIhOgEqIhwvYXBpL3YxL21lbW9zOmNyZWF0ZUJvb2ttYXJrEnEKC0xpc3RUYWdUcmVlEiAubWVtb3MuYXBpLnYxLkxpc3RUYWdUcmVlUmVxdWVzdBohLm1lbW9zLmFwaS52MS5MaXN0VGFnVHJlZVJlc3BvbnNlIh2C0+STAhcSFS9hcGkvdjEvbWVtb3M6dGFnVHJlZRKQAQoNUmVuYW1lTWVtb1RhZxIiLm1lbW9zLmFwaS52MS5SZW5hbWVNZW1vVGFnUmVxdWVzdBojLm1lbW9zLmFwaS52MS5SZW5hbWVNZW1vVGFnUmVzcG9uc2UiNtpBD29sZF90YWcsbmV3X3RhZ4LT5JMCHjoBKiIZL2FwaS92MS9tZW1vcy90YWdzOnJlbmFtZRKPAQoQQmF0Y2hVcGRhdGVNZW1vcxIlLm1lbW9zLmFwaS52MS5CYXRjaFVwZGF0ZU1lbW9zUmVxdWVzdBomLm1lbW9zLmFwaS52MS5CYXRjaFVwZGF0ZU1lbW9zUmVzcG9uc2UiLNpBBW5hbWVzgtPkkwIeOgEqIhkvYXBpL3YxL21lbW9zOmJhdGNoVXBkYXRlEmoKC0ltcG9ydE1lbW9zEiAubWVtb3MuYXBpLnYxLkltcG9ydE1lbW9zUmVxdWVzdBoYLm1lbW9zLmFwaS52MS5NZW1vSW1wb3J0Ih+C0+STAhk6ASoiFC9hcGkvdjEvbWVtb3M6aW1wb3J0EnoKDUdldE1lbW9JbXBvcnQSIi5tZW1vcy5hcGkudjEuR2V0TWVtb0ltcG9ydFJlcXVlc3QaGC5tZW1vcy5hcGkudjEuTWVtb0ltcG9ydCIr2kEEbmFtZYLT5JMCHhIcL2FwaS92MS97bmFtZT1tZW1vSW1wb3J0cy8qfRKLAQoSU2V0TWVtb0F0dGFjaG1lbnRzEicubWVtb3MuYXBpLnYxLlNldE1lbW9BdHRhY2htZW50c1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNNpBBG5hbWWC0+STAic6ASoyIi9hcGkvdjEve25hbWU9bWVtb3MvKn0vYXR0YWNobWVudHMSnQEKE0xpc3RNZW1vQXR0YWNobWVudHMSKC5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1JlcXVlc3QaKS5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1Jlc3BvbnNlIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEoUBChBTZXRNZW1vUmVsYXRpb25zEiUubWVtb3MuYXBpLnYxLlNldE1lbW9SZWxhdGlvbnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlOgEqMiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKVAQoRTGlzdE1lbW9SZWxhdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWxhdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVsYXRpb25zEpUBChFMaXN0TWVtb0JhY2tsaW5rcxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb0JhY2tsaW5rc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9CYWNrbGlua3NSZXNwb25zZSIv2kEEbmFtZYLT5JMCIhIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9iYWNrbGlua3MSkAEKEUNyZWF0ZU1lbW9Db21tZW50EiYubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9Db21tZW50UmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIj/aQQxuYW1lLGNvbW1lbnSC0+STAio6B2NvbW1lbnQiHy9hcGkvdjEve25hbWU9bWVtb3MvKn0vY29tbWVudHMSkQEKEExpc3RNZW1vQ29tbWVudHMSJS5tZW1vcy5hcGkudjEuTGlzdE1lbW9Db21tZW50c1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9Db21tZW50c1Jlc3BvbnNlIi7aQQRuYW1lgtPkkwIhEh8vYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2NvbW1lbnRzEpUBChFMaXN0TWVtb1JlYWN0aW9ucxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JlYWN0aW9uc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWFjdGlvbnNSZXNwb25zZSIv2kEEbmFtZYLT5JMCIhIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWFjdGlvbnMSiQEKElVwc2VydE1lbW9SZWFjdGlvbhInLm1lbW9zLmFwaS52MS5VcHNlcnRNZW1vUmVhY3Rpb25SZXF1ZXN0GhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uIjLaQQRuYW1lgtPkkwIlOgEqIiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlYWN0aW9ucxKAAQoSRGVsZXRlTWVtb1JlYWN0aW9uEicubWVtb3MuYXBpLnYxLkRlbGV0ZU1lbW9SZWFjdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiKdpBBG5hbWWC0+STAhwqGi9hcGkvdjEve25hbWU9cmVhY3Rpb25zLyp9QqgBChBjb20ubWVtb3MuYXBpLnYxQhBNZW1vU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Reaction
@@ -143,7 +143,7 @@ export type Memo = Message<"memos.api.v1.Memo"> & {
   tags: string[];
 
   /**
-   * Whether the memo is pinned.
+   * Whether the memo is pinned. A memo pinned by an update is placed at the top of the pinned memos.
    *
    * @generated from field: bool pinned = 11;
    */
@@ -198,6 +198,91 @@ export type Memo = Message<"memos.api.v1.Memo"> & {
    * @generated from field: optional memos.api.v1.Location location = 18;
    */
   location?: Location;
+
+  /**
+   * Output only. When the memo was moved to the trash. Unset if it isn't in the trash.
+   *
+   * @generated from field: google.protobuf.Timestamp delete_time = 19;
+   */
+  deleteTime?: Timestamp;
+
+  /**
+   * Output only. Only set in search results: the content around the search matches, HTML-escaped,
+   * with each match wrapped in <mark> tags.
+   *
+   * @generated from field: string search_snippet = 20;
+   */
+  searchSnippet: string;
+
+  /**
+   * Optional. When the memo is scheduled to be published. Until then, the memo is only visible to
+   * its creator, whatever its visibility. Once published, its display time is set to the publish
+   * time and this field is unset. Clear it in an update to publish the memo now.
+   *
+   * @generated from field: google.protobuf.Timestamp publish_time = 21;
+   */
+  publishTime?: Timestamp;
+
+  /**
+   * Optional. The reminder of the memo. Its creator gets an inbox notification and their webhooks
+   * get a memos.memo.reminder event when it is due. Clear it in an update to remove the reminder.
+   *
+   * @generated from field: memos.api.v1.MemoReminder reminder = 22;
+   */
+  reminder?: MemoReminder;
+
+  /**
+   * Optional. Whether new comments on the memo are rejected. Comments created before are still
+   * listed. Only the creator of the memo can change it.
+   *
+   * @generated from field: bool disable_comments = 23;
+   */
+  disableComments: boolean;
+
+  /**
+   * Optional. When the memo expires. Once it has passed, the memo is excluded from all reads, then
+   * moved to the trash with its expiry cleared, and webhooks get a memos.memo.expired event. It
+   * must be in the future, and for a comment not later than the expiry of its memo, which a
+   * comment without an expiry inherits. Clear it in an update to remove the expiry.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 24;
+   */
+  expireTime?: Timestamp;
+
+  /**
+   * Output only. The link the memo bookmarks, if it was created by CreateBookmarkMemo.
+   *
+   * @generated from field: memos.api.v1.MemoBookmark bookmark = 25;
+   */
+  bookmark?: MemoBookmark;
+
+  /**
+   * Optional. The short name of the memo in its URLs, such as /memos/weekly-review. It is unique
+   * per instance and made of lowercase letters, digits and hyphens. It can be used instead of the
+   * UID in the name of GetMemo, and after a change the previous slug keeps resolving to the memo
+   * for a grace period. Only the creator of the memo can set it, in UpdateMemo. Clear it in an
+   * update to remove the slug.
+   *
+   * @generated from field: string slug = 26;
+   */
+  slug: string;
+
+  /**
+   * Output only. The [[wiki links]] of the content. A link resolves, when the memo is saved, to
+   * the memo of the creator with the target as slug, or else as title. The memo of a link is
+   * empty if it didn't resolve or the linked memo isn't visible.
+   *
+   * @generated from field: repeated memos.api.v1.Memo.WikiLink wiki_links = 27;
+   */
+  wikiLinks: Memo_WikiLink[];
+
+  /**
+   * Output only. A checksum of the content, which changes whenever the content does. Requests that
+   * edit part of the content take it to fail if the memo was edited since the client read it.
+   *
+   * @generated from field: string etag = 28;
+   */
+  etag: string;
 };
 
 /**
@@ -241,30 +326,171 @@ export type Memo_Property = Message<"memos.api.v1.Memo.Property"> & {
 export const Memo_PropertySchema: GenMessage<Memo_Property> = /*@__PURE__*/
   messageDesc(file_api_v1_memo_service, 1, 0);
 
+/**
+ * @generated from message memos.api.v1.Memo.WikiLink
+ */
+export type Memo_WikiLink = Message<"memos.api.v1.Memo.WikiLink"> & {
+  /**
+   * The target of the link, between the brackets and before the "|" of a label.
+   *
+   * @generated from field: string target = 1;
+   */
+  target: string;
+
+  /**
+   * The resource name of the linked memo, empty if the link is missing.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 2;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.Memo.WikiLink.
+ * Use `create(Memo_WikiLinkSchema)` to create a new message.
+ */
+export const Memo_WikiLinkSchema: GenMessage<Memo_WikiLink> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 1, 1);
+
+/**
+ * @generated from message memos.api.v1.MemoReminder
+ */
+export type MemoReminder = Message<"memos.api.v1.MemoReminder"> & {
+  /**
+   * Required. When the reminder is due. A repeating reminder moves to its next occurrence in the
+   * time zone of the memo creator once delivered.
+   *
+   * @generated from field: google.protobuf.Timestamp remind_time = 1;
+   */
+  remindTime?: Timestamp;
+
+  /**
+   * How the reminder repeats once delivered.
+   *
+   * @generated from field: memos.api.v1.MemoReminder.Repeat repeat = 2;
+   */
+  repeat: MemoReminder_Repeat;
+
+  /**
+   * Output only. When the reminder was last delivered. Unset if it hasn't been delivered.
+   *
+   * @generated from field: google.protobuf.Timestamp deliver_time = 3;
+   */
+  deliverTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoReminder.
+ * Use `create(MemoReminderSchema)` to create a new message.
+ */
+export const MemoReminderSchema: GenMessage<MemoReminder> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 2);
+
+/**
+ * @generated from enum memos.api.v1.MemoReminder.Repeat
+ */
+export enum MemoReminder_Repeat {
+  /**
+   * The reminder is delivered once.
+   *
+   * @generated from enum value: REPEAT_UNSPECIFIED = 0;
+   */
+  REPEAT_UNSPECIFIED = 0,
+
+  /**
+   * The reminder is delivered every day.
+   *
+   * @generated from enum value: DAILY = 1;
+   */
+  DAILY = 1,
+
+  /**
+   * The reminder is delivered every week.
+   *
+   * @generated from enum value: WEEKLY = 2;
+   */
+  WEEKLY = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.MemoReminder.Repeat.
+ */
+export const MemoReminder_RepeatSchema: GenEnum<MemoReminder_Repeat> = /*@__PURE__*/
+  enumDesc(file_api_v1_memo_service, 2, 0);
+
+/**
+ * @generated from message memos.api.v1.MemoBookmark
+ */
+export type MemoBookmark = Message<"memos.api.v1.MemoBookmark"> & {
+  /**
+   * The bookmarked link.
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * The title of the link, empty if its metadata couldn't be fetched.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The description of the link.
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * The URL of the image of the link, e.g. its Open Graph image.
+   *
+   * @generated from field: string image = 4;
+   */
+  image: string;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoBookmark.
+ * Use `create(MemoBookmarkSchema)` to create a new message.
+ */
+export const MemoBookmarkSchema: GenMessage<MemoBookmark> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 3);
+
 /**
  * @generated from message memos.api.v1.Location
  */
 export type Location = Message<"memos.api.v1.Location"> & {
   /**
-   * A placeholder text for the location.
+   * A placeholder text for the location, at most 256 characters.
    *
    * @generated from field: string placeholder = 1;
    */
   placeholder: string;
 
   /**
-   * The latitude of the location.
+   * The latitude of the location, from -90 to 90.
    *
    * @generated from field: double latitude = 2;
    */
   latitude: number;
 
   /**
-   * The longitude of the location.
+   * The longitude of the location, from -180 to 180.
    *
    * @generated from field: double longitude = 3;
    */
   longitude: number;
+
+  /**
+   * Optional. Whether the location is shown to other users who can view the memo, and matched by
+   * their location filters. The location is only shown to the creator otherwise.
+   *
+   * @generated from field: bool show_publicly = 4;
+   */
+  showPublicly: boolean;
 };
 
 /**
@@ -272,7 +498,7 @@ export type Location = Message<"memos.api.v1.Location"> & {
  * Use `create(LocationSchema)` to create a new message.
  */
 export const LocationSchema: GenMessage<Location> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 2);
+  messageDesc(file_api_v1_memo_service, 4);
 
 /**
  * @generated from message memos.api.v1.CreateMemoRequest
@@ -299,7 +525,82 @@ export type CreateMemoRequest = Message<"memos.api.v1.CreateMemoRequest"> & {
  * Use `create(CreateMemoRequestSchema)` to create a new message.
  */
 export const CreateMemoRequestSchema: GenMessage<CreateMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 3);
+  messageDesc(file_api_v1_memo_service, 5);
+
+/**
+ * @generated from message memos.api.v1.CreateBookmarkMemoRequest
+ */
+export type CreateBookmarkMemoRequest = Message<"memos.api.v1.CreateBookmarkMemoRequest"> & {
+  /**
+   * Required. The link to bookmark, an http or https URL.
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * Optional. A comment added to the memo below the link.
+   *
+   * @generated from field: string comment = 2;
+   */
+  comment: string;
+
+  /**
+   * Optional. The tags, without the # prefix, added to the memo.
+   *
+   * @generated from field: repeated string tags = 3;
+   */
+  tags: string[];
+
+  /**
+   * Optional. The visibility of the memo.
+   * Defaults to the default memo visibility of the user.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 4;
+   */
+  visibility: Visibility;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateBookmarkMemoRequest.
+ * Use `create(CreateBookmarkMemoRequestSchema)` to create a new message.
+ */
+export const CreateBookmarkMemoRequestSchema: GenMessage<CreateBookmarkMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 6);
+
+/**
+ * @generated from message memos.api.v1.CreateBookmarkMemoResponse
+ */
+export type CreateBookmarkMemoResponse = Message<"memos.api.v1.CreateBookmarkMemoResponse"> & {
+  /**
+   * The memo bookmarking the link.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 1;
+   */
+  memo?: Memo;
+
+  /**
+   * Why the metadata of the link couldn't be fetched, empty if it was.
+   *
+   * @generated from field: string warning = 2;
+   */
+  warning: string;
+
+  /**
+   * Whether the memo was created before, as the link was bookmarked within the duplicate window
+   * of the instance.
+   *
+   * @generated from field: bool duplicate = 3;
+   */
+  duplicate: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateBookmarkMemoResponse.
+ * Use `create(CreateBookmarkMemoResponseSchema)` to create a new message.
+ */
+export const CreateBookmarkMemoResponseSchema: GenMessage<CreateBookmarkMemoResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 7);
 
 /**
  * @generated from message memos.api.v1.ListMemosRequest
@@ -318,6 +619,7 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
   /**
    * Optional. A page token, received from a previous `ListMemos` call.
    * Provide this to retrieve the subsequent page.
+   * The order_by must match the call that provided the page token.
    *
    * @generated from field: string page_token = 2;
    */
@@ -325,7 +627,8 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
 
   /**
    * Optional. The state of the memos to list.
-   * Default to `NORMAL`. Set to `ARCHIVED` to list archived memos.
+   * Default to `NORMAL`. Set to `ARCHIVED` to list archived memos, or to `DRAFT` to list the
+   * drafts of the current user.
    *
    * @generated from field: memos.api.v1.State state = 3;
    */
@@ -346,17 +649,44 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
    * Optional. Filter to apply to the list results.
    * Filter is a CEL expression to filter memos.
    * Refer to `Shortcut.filter`.
+   * Memo locations are matched by `location_within_box(south, west, north, east)` and
+   * `location_within_radius(latitude, longitude, radius_km)`.
    *
    * @generated from field: string filter = 5;
    */
   filter: string;
 
   /**
-   * Optional. If true, show deleted memos in the response.
+   * Optional. If true, list the memos in the trash instead.
+   * Only the current user's memos are listed, in any state.
    *
    * @generated from field: bool show_deleted = 6;
    */
   showDeleted: boolean;
+
+  /**
+   * Optional. A full-text search query. Only the memos matching all of its words are listed,
+   * most relevant first, with their search_snippet set. Words match the content of a memo,
+   * code blocks included, or the filenames of its attachments.
+   * Words may also be operators: has:attachment, has:link, has:code, has:task,
+   * has:incomplete-task, is:pinned, is:archived, and before:YYYY-MM-DD and after:YYYY-MM-DD
+   * for the memos created before the date, or on or after it, in the time zone of the user,
+   * and tag:name for the memos tagged with the tag or one of its subtags, e.g. tag:project
+   * matches #project/alpha but not #projects.
+   * A leading minus negates an operator or a word.
+   * Example: "meeting notes has:attachment after:2024-03-01 -is:pinned"
+   *
+   * @generated from field: string search = 7;
+   */
+  search: string;
+
+  /**
+   * Optional. If true, list the memos scheduled to be published instead.
+   * Only the current user's memos are listed.
+   *
+   * @generated from field: bool show_scheduled = 8;
+   */
+  showScheduled: boolean;
 };
 
 /**
@@ -364,7 +694,7 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
  * Use `create(ListMemosRequestSchema)` to create a new message.
  */
 export const ListMemosRequestSchema: GenMessage<ListMemosRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 4);
+  messageDesc(file_api_v1_memo_service, 8);
 
 /**
  * @generated from message memos.api.v1.ListMemosResponse
@@ -384,6 +714,15 @@ export type ListMemosResponse = Message<"memos.api.v1.ListMemosResponse"> & {
    * @generated from field: string next_page_token = 2;
    */
   nextPageToken: string;
+
+  /**
+   * The names of the memos of the page matching the search through the filenames of their
+   * attachments, whose content doesn't match every search term.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string attachment_matched_memos = 3;
+   */
+  attachmentMatchedMemos: string[];
 };
 
 /**
@@ -391,81 +730,1543 @@ export type ListMemosResponse = Message<"memos.api.v1.ListMemosResponse"> & {
  * Use `create(ListMemosResponseSchema)` to create a new message.
  */
 export const ListMemosResponseSchema: GenMessage<ListMemosResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 5);
+  messageDesc(file_api_v1_memo_service, 9);
 
 /**
- * @generated from message memos.api.v1.GetMemoRequest
+ * @generated from message memos.api.v1.GetMemoHighlightsRequest
  */
-export type GetMemoRequest = Message<"memos.api.v1.GetMemoRequest"> & {
+export type GetMemoHighlightsRequest = Message<"memos.api.v1.GetMemoHighlightsRequest"> & {
   /**
-   * Required. The resource name of the memo.
-   * Format: memos/{memo}
+   * Optional. The month of the day to get the memos of, from 1 to 12, together with day.
+   * Defaults to today in the time zone of the user. The previous years are the years before the
+   * next occurrence of the day, so that clients can prefetch the highlights of tomorrow.
+   *
+   * @generated from field: int32 month = 1;
+   */
+  month: number;
+
+  /**
+   * Optional. The day of the month to get the memos of, from 1 to 31, together with month.
+   *
+   * @generated from field: int32 day = 2;
+   */
+  day: number;
+
+  /**
+   * Optional. The number of random memos older than 90 days, at most 20. Defaults to none.
+   *
+   * @generated from field: int32 random_count = 3;
+   */
+  randomCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoHighlightsRequest.
+ * Use `create(GetMemoHighlightsRequestSchema)` to create a new message.
+ */
+export const GetMemoHighlightsRequestSchema: GenMessage<GetMemoHighlightsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 10);
+
+/**
+ * @generated from message memos.api.v1.GetMemoHighlightsResponse
+ */
+export type GetMemoHighlightsResponse = Message<"memos.api.v1.GetMemoHighlightsResponse"> & {
+  /**
+   * The memos created on the day in previous years, newest first.
+   * Archived memos, memos in the trash and comments are excluded.
+   *
+   * @generated from field: repeated memos.api.v1.Memo on_this_day_memos = 1;
+   */
+  onThisDayMemos: Memo[];
+
+  /**
+   * The random memos older than 90 days, with the same exclusions.
+   *
+   * @generated from field: repeated memos.api.v1.Memo random_memos = 2;
+   */
+  randomMemos: Memo[];
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoHighlightsResponse.
+ * Use `create(GetMemoHighlightsResponseSchema)` to create a new message.
+ */
+export const GetMemoHighlightsResponseSchema: GenMessage<GetMemoHighlightsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 11);
+
+/**
+ * @generated from message memos.api.v1.GetMemoCountsRequest
+ */
+export type GetMemoCountsRequest = Message<"memos.api.v1.GetMemoCountsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoCountsRequest.
+ * Use `create(GetMemoCountsRequestSchema)` to create a new message.
+ */
+export const GetMemoCountsRequestSchema: GenMessage<GetMemoCountsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 12);
+
+/**
+ * @generated from message memos.api.v1.GetMemoCountsResponse
+ */
+export type GetMemoCountsResponse = Message<"memos.api.v1.GetMemoCountsResponse"> & {
+  /**
+   * The number of memos that are published and not archived.
+   *
+   * @generated from field: int32 normal_count = 1;
+   */
+  normalCount: number;
+
+  /**
+   * The number of archived memos.
+   *
+   * @generated from field: int32 archived_count = 2;
+   */
+  archivedCount: number;
+
+  /**
+   * The number of drafts.
+   *
+   * @generated from field: int32 draft_count = 3;
+   */
+  draftCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoCountsResponse.
+ * Use `create(GetMemoCountsResponseSchema)` to create a new message.
+ */
+export const GetMemoCountsResponseSchema: GenMessage<GetMemoCountsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 13);
+
+/**
+ * @generated from message memos.api.v1.PreviewAutoArchiveMemosRequest
+ */
+export type PreviewAutoArchiveMemosRequest = Message<"memos.api.v1.PreviewAutoArchiveMemosRequest"> & {
+  /**
+   * Optional. The number of days without edits of the previewed policy. If not set, the saved
+   * auto-archive setting of the user is previewed.
+   *
+   * @generated from field: optional int32 days = 1;
+   */
+  days?: number;
+
+  /**
+   * Optional. The protected tags of the previewed policy, only used if days is set.
+   *
+   * @generated from field: repeated string protected_tags = 2;
+   */
+  protectedTags: string[];
+
+  /**
+   * Optional. The maximum number of memos to return, least recently edited first.
+   * Defaults to 50, at most 1000.
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message memos.api.v1.PreviewAutoArchiveMemosRequest.
+ * Use `create(PreviewAutoArchiveMemosRequestSchema)` to create a new message.
+ */
+export const PreviewAutoArchiveMemosRequestSchema: GenMessage<PreviewAutoArchiveMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 14);
+
+/**
+ * @generated from message memos.api.v1.PreviewAutoArchiveMemosResponse
+ */
+export type PreviewAutoArchiveMemosResponse = Message<"memos.api.v1.PreviewAutoArchiveMemosResponse"> & {
+  /**
+   * The memos that would be archived, least recently edited first.
+   *
+   * @generated from field: repeated memos.api.v1.Memo memos = 1;
+   */
+  memos: Memo[];
+
+  /**
+   * The number of memos that would be archived, including the ones not returned.
+   *
+   * @generated from field: int32 total_size = 2;
+   */
+  totalSize: number;
+};
+
+/**
+ * Describes the message memos.api.v1.PreviewAutoArchiveMemosResponse.
+ * Use `create(PreviewAutoArchiveMemosResponseSchema)` to create a new message.
+ */
+export const PreviewAutoArchiveMemosResponseSchema: GenMessage<PreviewAutoArchiveMemosResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 15);
+
+/**
+ * @generated from message memos.api.v1.GetMemoRequest
+ */
+export type GetMemoRequest = Message<"memos.api.v1.GetMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo. The memo can also be named by its slug, or by a
+   * previous slug within its grace period, and the name of the memo returned is its canonical name.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoRequest.
+ * Use `create(GetMemoRequestSchema)` to create a new message.
+ */
+export const GetMemoRequestSchema: GenMessage<GetMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 16);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoRequest
+ */
+export type UpdateMemoRequest = Message<"memos.api.v1.UpdateMemoRequest"> & {
+  /**
+   * Required. The memo to update.
+   * The `name` field is required.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 1;
+   */
+  memo?: Memo;
+
+  /**
+   * Required. The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoRequest.
+ * Use `create(UpdateMemoRequestSchema)` to create a new message.
+ */
+export const UpdateMemoRequestSchema: GenMessage<UpdateMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 17);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoRequest
+ */
+export type DeleteMemoRequest = Message<"memos.api.v1.DeleteMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo to delete.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. If set to true, the memo will be deleted even if it has associated data.
+   *
+   * @generated from field: bool force = 2;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoRequest.
+ * Use `create(DeleteMemoRequestSchema)` to create a new message.
+ */
+export const DeleteMemoRequestSchema: GenMessage<DeleteMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 18);
+
+/**
+ * @generated from message memos.api.v1.RestoreMemoRequest
+ */
+export type RestoreMemoRequest = Message<"memos.api.v1.RestoreMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo to restore.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RestoreMemoRequest.
+ * Use `create(RestoreMemoRequestSchema)` to create a new message.
+ */
+export const RestoreMemoRequestSchema: GenMessage<RestoreMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 19);
+
+/**
+ * @generated from message memos.api.v1.DuplicateMemoRequest
+ */
+export type DuplicateMemoRequest = Message<"memos.api.v1.DuplicateMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo to duplicate.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. Whether to keep the tags in the content. The tags are removed otherwise.
+   *
+   * @generated from field: bool copy_tags = 2;
+   */
+  copyTags: boolean;
+
+  /**
+   * Optional. Whether to copy the references of the memo to other memos.
+   * The memo links in the content are always kept as references.
+   *
+   * @generated from field: bool copy_relations = 3;
+   */
+  copyRelations: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.DuplicateMemoRequest.
+ * Use `create(DuplicateMemoRequestSchema)` to create a new message.
+ */
+export const DuplicateMemoRequestSchema: GenMessage<DuplicateMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 20);
+
+/**
+ * @generated from message memos.api.v1.MergeMemosRequest
+ */
+export type MergeMemosRequest = Message<"memos.api.v1.MergeMemosRequest"> & {
+  /**
+   * Required. The resource name of the target memo, which the source memo is merged into.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The resource name of the source memo, which is moved to the trash.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * Optional. The separator between the target content and the source content.
+   * Defaults to a blank line.
+   *
+   * @generated from field: optional string separator = 3;
+   */
+  separator?: string;
+};
+
+/**
+ * Describes the message memos.api.v1.MergeMemosRequest.
+ * Use `create(MergeMemosRequestSchema)` to create a new message.
+ */
+export const MergeMemosRequestSchema: GenMessage<MergeMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 21);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoFromWikiLinkRequest
+ */
+export type CreateMemoFromWikiLinkRequest = Message<"memos.api.v1.CreateMemoFromWikiLinkRequest"> & {
+  /**
+   * Required. The resource name of the memo with the wiki link.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The target of the missing wiki link, which is the title of the created memo.
+   *
+   * @generated from field: string target = 2;
+   */
+  target: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoFromWikiLinkRequest.
+ * Use `create(CreateMemoFromWikiLinkRequestSchema)` to create a new message.
+ */
+export const CreateMemoFromWikiLinkRequestSchema: GenMessage<CreateMemoFromWikiLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 22);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoTaskRequest
+ */
+export type UpdateMemoTaskRequest = Message<"memos.api.v1.UpdateMemoTaskRequest"> & {
+  /**
+   * Required. The resource name of the memo with the task.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The task to check or uncheck.
+   *
+   * @generated from oneof memos.api.v1.UpdateMemoTaskRequest.task
+   */
+  task: {
+    /**
+     * The position of the task among the tasks of the memo, from 0.
+     *
+     * @generated from field: int32 index = 2;
+     */
+    value: number;
+    case: "index";
+  } | {
+    /**
+     * The id of the task, which doesn't change when other tasks are added or removed.
+     *
+     * @generated from field: string task_id = 3;
+     */
+    value: string;
+    case: "taskId";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * Whether the task is checked.
+   *
+   * @generated from field: bool checked = 4;
+   */
+  checked: boolean;
+
+  /**
+   * Required. The etag of the memo the task was read from. If the content changed since, the
+   * request fails with FAILED_PRECONDITION.
+   *
+   * @generated from field: string etag = 5;
+   */
+  etag: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoTaskRequest.
+ * Use `create(UpdateMemoTaskRequestSchema)` to create a new message.
+ */
+export const UpdateMemoTaskRequestSchema: GenMessage<UpdateMemoTaskRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 23);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoTaskResponse
+ */
+export type UpdateMemoTaskResponse = Message<"memos.api.v1.UpdateMemoTaskResponse"> & {
+  /**
+   * The updated memo.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 1;
+   */
+  memo?: Memo;
+
+  /**
+   * The tasks of the updated memo, in the order of the content.
+   *
+   * @generated from field: repeated memos.api.v1.MemoTask tasks = 2;
+   */
+  tasks: MemoTask[];
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoTaskResponse.
+ * Use `create(UpdateMemoTaskResponseSchema)` to create a new message.
+ */
+export const UpdateMemoTaskResponseSchema: GenMessage<UpdateMemoTaskResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 24);
+
+/**
+ * MemoTask is a task list item of the content of a memo, e.g. "- [ ] Buy milk".
+ *
+ * @generated from message memos.api.v1.MemoTask
+ */
+export type MemoTask = Message<"memos.api.v1.MemoTask"> & {
+  /**
+   * The id of the task, computed from its text and the number of tasks with the same text before
+   * it, so that it doesn't change when the task is checked or when other tasks change.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The position of the task among the tasks of the memo, from 0.
+   *
+   * @generated from field: int32 index = 2;
+   */
+  index: number;
+
+  /**
+   * The markdown of the task after its checkbox, on a single line.
+   *
+   * @generated from field: string text = 3;
+   */
+  text: string;
+
+  /**
+   * Whether the task is checked.
+   *
+   * @generated from field: bool checked = 4;
+   */
+  checked: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoTask.
+ * Use `create(MemoTaskSchema)` to create a new message.
+ */
+export const MemoTaskSchema: GenMessage<MemoTask> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 25);
+
+/**
+ * @generated from message memos.api.v1.MovePinnedMemoRequest
+ */
+export type MovePinnedMemoRequest = Message<"memos.api.v1.MovePinnedMemoRequest"> & {
+  /**
+   * Required. The resource name of the pinned memo to move.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. Where the memo is moved to.
+   *
+   * @generated from oneof memos.api.v1.MovePinnedMemoRequest.destination
+   */
+  destination: {
+    /**
+     * Moves the memo up by one position, towards the top.
+     *
+     * @generated from field: bool move_up = 2;
+     */
+    value: boolean;
+    case: "moveUp";
+  } | {
+    /**
+     * Moves the memo down by one position.
+     *
+     * @generated from field: bool move_down = 3;
+     */
+    value: boolean;
+    case: "moveDown";
+  } | {
+    /**
+     * Moves the memo to a 1-based position, from 1 at the top. Positions after the last pinned
+     * memo move it to the bottom.
+     *
+     * @generated from field: int32 position = 4;
+     */
+    value: number;
+    case: "position";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message memos.api.v1.MovePinnedMemoRequest.
+ * Use `create(MovePinnedMemoRequestSchema)` to create a new message.
+ */
+export const MovePinnedMemoRequestSchema: GenMessage<MovePinnedMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 26);
+
+/**
+ * @generated from message memos.api.v1.PurgeMemoRequest
+ */
+export type PurgeMemoRequest = Message<"memos.api.v1.PurgeMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo to purge.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.PurgeMemoRequest.
+ * Use `create(PurgeMemoRequestSchema)` to create a new message.
+ */
+export const PurgeMemoRequestSchema: GenMessage<PurgeMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 27);
+
+/**
+ * @generated from message memos.api.v1.MemoRevision
+ */
+export type MemoRevision = Message<"memos.api.v1.MemoRevision"> & {
+  /**
+   * The resource name of the revision.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The name of the user who made the revision.
+   * Format: users/{user}
+   *
+   * @generated from field: string editor = 2;
+   */
+  editor: string;
+
+  /**
+   * When the revision was made.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The content of the memo at the revision. Only set in GetMemoRevision.
+   *
+   * @generated from field: string content = 4;
+   */
+  content: string;
+
+  /**
+   * The attachments of the memo at the revision, with their name, filename and type only.
+   * They may since have been deleted.
+   *
+   * @generated from field: repeated memos.api.v1.Attachment attachments = 5;
+   */
+  attachments: Attachment[];
+
+  /**
+   * The unified diff of the content against the previous revision, without file headers.
+   * Only set in ListMemoRevisions.
+   *
+   * @generated from field: string diff = 6;
+   */
+  diff: string;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoRevision.
+ * Use `create(MemoRevisionSchema)` to create a new message.
+ */
+export const MemoRevisionSchema: GenMessage<MemoRevision> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 28);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRevisionsRequest
+ */
+export type ListMemoRevisionsRequest = Message<"memos.api.v1.ListMemoRevisionsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of revisions to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token for pagination.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRevisionsRequest.
+ * Use `create(ListMemoRevisionsRequestSchema)` to create a new message.
+ */
+export const ListMemoRevisionsRequestSchema: GenMessage<ListMemoRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 29);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRevisionsResponse
+ */
+export type ListMemoRevisionsResponse = Message<"memos.api.v1.ListMemoRevisionsResponse"> & {
+  /**
+   * The revisions of the memo, newest first.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRevision revisions = 1;
+   */
+  revisions: MemoRevision[];
+
+  /**
+   * A token for the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRevisionsResponse.
+ * Use `create(ListMemoRevisionsResponseSchema)` to create a new message.
+ */
+export const ListMemoRevisionsResponseSchema: GenMessage<ListMemoRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 30);
+
+/**
+ * @generated from message memos.api.v1.GetMemoRevisionRequest
+ */
+export type GetMemoRevisionRequest = Message<"memos.api.v1.GetMemoRevisionRequest"> & {
+  /**
+   * Required. The resource name of the revision.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoRevisionRequest.
+ * Use `create(GetMemoRevisionRequestSchema)` to create a new message.
+ */
+export const GetMemoRevisionRequestSchema: GenMessage<GetMemoRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 31);
+
+/**
+ * @generated from message memos.api.v1.RestoreMemoRevisionRequest
+ */
+export type RestoreMemoRevisionRequest = Message<"memos.api.v1.RestoreMemoRevisionRequest"> & {
+  /**
+   * Required. The resource name of the revision to restore.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RestoreMemoRevisionRequest.
+ * Use `create(RestoreMemoRevisionRequestSchema)` to create a new message.
+ */
+export const RestoreMemoRevisionRequestSchema: GenMessage<RestoreMemoRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 32);
+
+/**
+ * @generated from message memos.api.v1.MemoShareLink
+ */
+export type MemoShareLink = Message<"memos.api.v1.MemoShareLink"> & {
+  /**
+   * The resource name of the share link.
+   * Format: memos/{memo}/shareLinks/{share_link}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The random slug of the link, which GetSharedMemo gets the memo with.
+   *
+   * @generated from field: string slug = 2;
+   */
+  slug: string;
+
+  /**
+   * When the link was created.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * Optional. When the link stops working. If not set, the link doesn't expire.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 4;
+   */
+  expireTime?: Timestamp;
+
+  /**
+   * Optional. The number of views after which the link stops working. 0 for no limit.
+   *
+   * @generated from field: int32 max_views = 5;
+   */
+  maxViews: number;
+
+  /**
+   * The number of times the memo was viewed through the link.
+   *
+   * @generated from field: int32 view_count = 6;
+   */
+  viewCount: number;
+
+  /**
+   * Optional. The passphrase to enter to view the memo. Empty for no passphrase.
+   *
+   * @generated from field: string passphrase = 7;
+   */
+  passphrase: string;
+
+  /**
+   * Whether the link has a passphrase.
+   *
+   * @generated from field: bool has_passphrase = 8;
+   */
+  hasPassphrase: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoShareLink.
+ * Use `create(MemoShareLinkSchema)` to create a new message.
+ */
+export const MemoShareLinkSchema: GenMessage<MemoShareLink> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 33);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoShareLinkRequest
+ */
+export type CreateMemoShareLinkRequest = Message<"memos.api.v1.CreateMemoShareLinkRequest"> & {
+  /**
+   * Required. The resource name of the memo to share.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The expiry and the view limit of the link.
+   *
+   * @generated from field: memos.api.v1.MemoShareLink share_link = 2;
+   */
+  shareLink?: MemoShareLink;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoShareLinkRequest.
+ * Use `create(CreateMemoShareLinkRequestSchema)` to create a new message.
+ */
+export const CreateMemoShareLinkRequestSchema: GenMessage<CreateMemoShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 34);
+
+/**
+ * @generated from message memos.api.v1.ListMemoShareLinksRequest
+ */
+export type ListMemoShareLinksRequest = Message<"memos.api.v1.ListMemoShareLinksRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoShareLinksRequest.
+ * Use `create(ListMemoShareLinksRequestSchema)` to create a new message.
+ */
+export const ListMemoShareLinksRequestSchema: GenMessage<ListMemoShareLinksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 35);
+
+/**
+ * @generated from message memos.api.v1.ListMemoShareLinksResponse
+ */
+export type ListMemoShareLinksResponse = Message<"memos.api.v1.ListMemoShareLinksResponse"> & {
+  /**
+   * The share links of the memo, including those that expired or ran out of views.
+   *
+   * @generated from field: repeated memos.api.v1.MemoShareLink share_links = 1;
+   */
+  shareLinks: MemoShareLink[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoShareLinksResponse.
+ * Use `create(ListMemoShareLinksResponseSchema)` to create a new message.
+ */
+export const ListMemoShareLinksResponseSchema: GenMessage<ListMemoShareLinksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 36);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoShareLinkRequest
+ */
+export type UpdateMemoShareLinkRequest = Message<"memos.api.v1.UpdateMemoShareLinkRequest"> & {
+  /**
+   * Required. The share link to update.
+   * The `name` field is required.
+   *
+   * @generated from field: memos.api.v1.MemoShareLink share_link = 1;
+   */
+  shareLink?: MemoShareLink;
+
+  /**
+   * Required. The list of fields to update. Only `passphrase` is supported.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoShareLinkRequest.
+ * Use `create(UpdateMemoShareLinkRequestSchema)` to create a new message.
+ */
+export const UpdateMemoShareLinkRequestSchema: GenMessage<UpdateMemoShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 37);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoShareLinkRequest
+ */
+export type DeleteMemoShareLinkRequest = Message<"memos.api.v1.DeleteMemoShareLinkRequest"> & {
+  /**
+   * Required. The resource name of the share link to revoke.
+   * Format: memos/{memo}/shareLinks/{share_link}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoShareLinkRequest.
+ * Use `create(DeleteMemoShareLinkRequestSchema)` to create a new message.
+ */
+export const DeleteMemoShareLinkRequestSchema: GenMessage<DeleteMemoShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 38);
+
+/**
+ * @generated from message memos.api.v1.GetSharedMemoRequest
+ */
+export type GetSharedMemoRequest = Message<"memos.api.v1.GetSharedMemoRequest"> & {
+  /**
+   * Required. The slug of the share link.
+   *
+   * @generated from field: string slug = 1;
+   */
+  slug: string;
+
+  /**
+   * Optional. The access token from UnlockSharedMemo, for links with a passphrase.
+   *
+   * @generated from field: string access_token = 2;
+   */
+  accessToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetSharedMemoRequest.
+ * Use `create(GetSharedMemoRequestSchema)` to create a new message.
+ */
+export const GetSharedMemoRequestSchema: GenMessage<GetSharedMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 39);
+
+/**
+ * @generated from message memos.api.v1.UnlockSharedMemoRequest
+ */
+export type UnlockSharedMemoRequest = Message<"memos.api.v1.UnlockSharedMemoRequest"> & {
+  /**
+   * Required. The slug of the share link.
+   *
+   * @generated from field: string slug = 1;
+   */
+  slug: string;
+
+  /**
+   * Required. The passphrase of the share link.
+   *
+   * @generated from field: string passphrase = 2;
+   */
+  passphrase: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UnlockSharedMemoRequest.
+ * Use `create(UnlockSharedMemoRequestSchema)` to create a new message.
+ */
+export const UnlockSharedMemoRequestSchema: GenMessage<UnlockSharedMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 40);
+
+/**
+ * @generated from message memos.api.v1.UnlockSharedMemoResponse
+ */
+export type UnlockSharedMemoResponse = Message<"memos.api.v1.UnlockSharedMemoResponse"> & {
+  /**
+   * The token to pass to GetSharedMemo, and as the `token` query parameter of the attachment
+   * files of the shared memo.
+   *
+   * @generated from field: string access_token = 1;
+   */
+  accessToken: string;
+
+  /**
+   * When the access token expires.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 2;
+   */
+  expireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UnlockSharedMemoResponse.
+ * Use `create(UnlockSharedMemoResponseSchema)` to create a new message.
+ */
+export const UnlockSharedMemoResponseSchema: GenMessage<UnlockSharedMemoResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 41);
+
+/**
+ * @generated from message memos.api.v1.SnoozeMemoReminderRequest
+ */
+export type SnoozeMemoReminderRequest = Message<"memos.api.v1.SnoozeMemoReminderRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The new reminder time, in the future. A repeating reminder repeats from it.
+   *
+   * @generated from field: google.protobuf.Timestamp remind_time = 2;
+   */
+  remindTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.SnoozeMemoReminderRequest.
+ * Use `create(SnoozeMemoReminderRequestSchema)` to create a new message.
+ */
+export const SnoozeMemoReminderRequestSchema: GenMessage<SnoozeMemoReminderRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 42);
+
+/**
+ * @generated from message memos.api.v1.CompleteMemoReminderRequest
+ */
+export type CompleteMemoReminderRequest = Message<"memos.api.v1.CompleteMemoReminderRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CompleteMemoReminderRequest.
+ * Use `create(CompleteMemoReminderRequestSchema)` to create a new message.
+ */
+export const CompleteMemoReminderRequestSchema: GenMessage<CompleteMemoReminderRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 43);
+
+/**
+ * @generated from message memos.api.v1.ListTagTreeRequest
+ */
+export type ListTagTreeRequest = Message<"memos.api.v1.ListTagTreeRequest"> & {
+  /**
+   * Optional. The creator of the memos, e.g. "users/1". Defaults to the current user.
+   * Only the memos of the creator visible to the current user are counted.
+   *
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListTagTreeRequest.
+ * Use `create(ListTagTreeRequestSchema)` to create a new message.
+ */
+export const ListTagTreeRequestSchema: GenMessage<ListTagTreeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 44);
+
+/**
+ * @generated from message memos.api.v1.ListTagTreeResponse
+ */
+export type ListTagTreeResponse = Message<"memos.api.v1.ListTagTreeResponse"> & {
+  /**
+   * The top-level tags, sorted by tag.
+   *
+   * @generated from field: repeated memos.api.v1.TagTreeNode tags = 1;
+   */
+  tags: TagTreeNode[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListTagTreeResponse.
+ * Use `create(ListTagTreeResponseSchema)` to create a new message.
+ */
+export const ListTagTreeResponseSchema: GenMessage<ListTagTreeResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 45);
+
+/**
+ * TagTreeNode is a tag of a tag tree, e.g. "project/alpha", with its subtags.
+ *
+ * @generated from message memos.api.v1.TagTreeNode
+ */
+export type TagTreeNode = Message<"memos.api.v1.TagTreeNode"> & {
+  /**
+   * The tag, without the # prefix, e.g. "project/alpha".
+   * Tags differing only by case or accents are the same tag, written as in the first memo found.
+   *
+   * @generated from field: string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * The last segment of the tag, e.g. "alpha".
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The number of memos tagged with the tag or one of its subtags, as listed by the filter
+   * `tag in ["project/alpha"]`. Archived memos and comments aren't counted.
+   *
+   * @generated from field: int32 memo_count = 3;
+   */
+  memoCount: number;
+
+  /**
+   * The subtags one level below the tag, sorted by tag.
+   *
+   * @generated from field: repeated memos.api.v1.TagTreeNode children = 4;
+   */
+  children: TagTreeNode[];
+};
+
+/**
+ * Describes the message memos.api.v1.TagTreeNode.
+ * Use `create(TagTreeNodeSchema)` to create a new message.
+ */
+export const TagTreeNodeSchema: GenMessage<TagTreeNode> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 46);
+
+/**
+ * @generated from message memos.api.v1.RenameMemoTagRequest
+ */
+export type RenameMemoTagRequest = Message<"memos.api.v1.RenameMemoTagRequest"> & {
+  /**
+   * Required. The tag to rename, without the # prefix. Its subtags are renamed too,
+   * e.g. renaming "work" to "job" renames "work/project" to "job/project".
+   *
+   * @generated from field: string old_tag = 1;
+   */
+  oldTag: string;
+
+  /**
+   * Required. The new name of the tag, without the # prefix.
+   *
+   * @generated from field: string new_tag = 2;
+   */
+  newTag: string;
+
+  /**
+   * Optional. If set, only returns the memos that would be changed, without changing them.
+   *
+   * @generated from field: bool validate_only = 3;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.RenameMemoTagRequest.
+ * Use `create(RenameMemoTagRequestSchema)` to create a new message.
+ */
+export const RenameMemoTagRequestSchema: GenMessage<RenameMemoTagRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 47);
+
+/**
+ * @generated from message memos.api.v1.RenameMemoTagResponse
+ */
+export type RenameMemoTagResponse = Message<"memos.api.v1.RenameMemoTagResponse"> & {
+  /**
+   * The resource names of the changed memos, or the memos that would be changed
+   * if validate_only is set.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string memos = 1;
+   */
+  memos: string[];
+
+  /**
+   * The number of changed memos.
+   *
+   * @generated from field: int32 memo_count = 2;
+   */
+  memoCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.RenameMemoTagResponse.
+ * Use `create(RenameMemoTagResponseSchema)` to create a new message.
+ */
+export const RenameMemoTagResponseSchema: GenMessage<RenameMemoTagResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 48);
+
+/**
+ * @generated from message memos.api.v1.BatchUpdateMemosRequest
+ */
+export type BatchUpdateMemosRequest = Message<"memos.api.v1.BatchUpdateMemosRequest"> & {
+  /**
+   * Required. The resource names of the memos to update, at most 100.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string names = 1;
+   */
+  names: string[];
+
+  /**
+   * Required. The operation applied to each memo.
+   *
+   * @generated from oneof memos.api.v1.BatchUpdateMemosRequest.operation
+   */
+  operation: {
+    /**
+     * Sets the visibility of the memos.
+     *
+     * @generated from field: memos.api.v1.Visibility set_visibility = 2;
+     */
+    value: Visibility;
+    case: "setVisibility";
+  } | {
+    /**
+     * Archives the memos with ARCHIVED, or unarchives them with NORMAL.
+     *
+     * @generated from field: memos.api.v1.State set_state = 3;
+     */
+    value: State;
+    case: "setState";
+  } | {
+    /**
+     * Adds a tag, without the # prefix, at the end of the memos that don't have it.
+     *
+     * @generated from field: string add_tag = 4;
+     */
+    value: string;
+    case: "addTag";
+  } | {
+    /**
+     * Removes a tag, without the # prefix, from the memos. Its subtags are kept.
+     *
+     * @generated from field: string remove_tag = 5;
+     */
+    value: string;
+    case: "removeTag";
+  } | {
+    /**
+     * Moves the memos to the trash, which webhooks receive as trashed events.
+     *
+     * @generated from field: bool move_to_trash = 6;
+     */
+    value: boolean;
+    case: "moveToTrash";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message memos.api.v1.BatchUpdateMemosRequest.
+ * Use `create(BatchUpdateMemosRequestSchema)` to create a new message.
+ */
+export const BatchUpdateMemosRequestSchema: GenMessage<BatchUpdateMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 49);
+
+/**
+ * @generated from message memos.api.v1.BatchUpdateMemosResponse
+ */
+export type BatchUpdateMemosResponse = Message<"memos.api.v1.BatchUpdateMemosResponse"> & {
+  /**
+   * The number of memos the operation was applied to, including the memos it didn't change.
+   *
+   * @generated from field: int32 succeeded_count = 1;
+   */
+  succeededCount: number;
+
+  /**
+   * The number of memos the operation wasn't applied to.
+   *
+   * @generated from field: int32 failed_count = 2;
+   */
+  failedCount: number;
+
+  /**
+   * The memos the operation wasn't applied to, in the order of the request.
+   *
+   * @generated from field: repeated memos.api.v1.BatchUpdateMemosResponse.Failure failures = 3;
+   */
+  failures: BatchUpdateMemosResponse_Failure[];
+};
+
+/**
+ * Describes the message memos.api.v1.BatchUpdateMemosResponse.
+ * Use `create(BatchUpdateMemosResponseSchema)` to create a new message.
+ */
+export const BatchUpdateMemosResponseSchema: GenMessage<BatchUpdateMemosResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 50);
+
+/**
+ * @generated from message memos.api.v1.BatchUpdateMemosResponse.Failure
+ */
+export type BatchUpdateMemosResponse_Failure = Message<"memos.api.v1.BatchUpdateMemosResponse.Failure"> & {
+  /**
+   * The resource name of the memo, as in the request.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The reason of the failure, e.g. "permission denied" or "memo not found".
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message memos.api.v1.BatchUpdateMemosResponse.Failure.
+ * Use `create(BatchUpdateMemosResponse_FailureSchema)` to create a new message.
+ */
+export const BatchUpdateMemosResponse_FailureSchema: GenMessage<BatchUpdateMemosResponse_Failure> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 50, 0);
+
+/**
+ * @generated from message memos.api.v1.ImportMemosRequest
+ */
+export type ImportMemosRequest = Message<"memos.api.v1.ImportMemosRequest"> & {
+  /**
+   * Required. The zip archive of markdown files. The front matter of the files may set the
+   * created, updated, tags, visibility and pinned fields of their memos, as in exported memos.
+   *
+   * @generated from field: bytes content = 1;
+   */
+  content: Uint8Array;
+
+  /**
+   * Optional. The visibility of the memos without one in their front matter.
+   * Defaults to PRIVATE.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 2;
+   */
+  visibility: Visibility;
+
+  /**
+   * Optional. The format of the archive.
+   * Defaults to MARKDOWN.
+   *
+   * @generated from field: memos.api.v1.ImportMemosRequest.Format format = 3;
+   */
+  format: ImportMemosRequest_Format;
+};
+
+/**
+ * Describes the message memos.api.v1.ImportMemosRequest.
+ * Use `create(ImportMemosRequestSchema)` to create a new message.
+ */
+export const ImportMemosRequestSchema: GenMessage<ImportMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 51);
+
+/**
+ * @generated from enum memos.api.v1.ImportMemosRequest.Format
+ */
+export enum ImportMemosRequest_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * A zip archive of markdown files.
+   *
+   * @generated from enum value: MARKDOWN = 1;
+   */
+  MARKDOWN = 1,
+
+  /**
+   * A Google Keep Takeout archive, with a JSON file per note and the files attached to the
+   * notes. The titles of the notes are prepended to their content as headings, their labels
+   * are appended as tags, and their checklists are converted to task lists.
+   *
+   * @generated from enum value: GOOGLE_KEEP = 2;
+   */
+  GOOGLE_KEEP = 2,
+
+  /**
+   * A Day One JSON export, with a JSON file per journal and the photos, videos, audio
+   * recordings and PDFs of the entries named after their MD5 hashes. The entries keep their
+   * creation date, tags and location, and their embedded media become attachments.
+   *
+   * @generated from enum value: DAY_ONE = 3;
+   */
+  DAY_ONE = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.ImportMemosRequest.Format.
+ */
+export const ImportMemosRequest_FormatSchema: GenEnum<ImportMemosRequest_Format> = /*@__PURE__*/
+  enumDesc(file_api_v1_memo_service, 51, 0);
+
+/**
+ * @generated from message memos.api.v1.GetMemoImportRequest
+ */
+export type GetMemoImportRequest = Message<"memos.api.v1.GetMemoImportRequest"> & {
+  /**
+   * Required. The resource name of the import.
+   * Format: memoImports/{memo_import}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoImportRequest.
+ * Use `create(GetMemoImportRequestSchema)` to create a new message.
+ */
+export const GetMemoImportRequestSchema: GenMessage<GetMemoImportRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 52);
+
+/**
+ * @generated from message memos.api.v1.MemoImport
+ */
+export type MemoImport = Message<"memos.api.v1.MemoImport"> & {
+  /**
+   * The resource name of the import.
+   * Format: memoImports/{memo_import}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The state of the import.
+   *
+   * @generated from field: memos.api.v1.MemoImport.State state = 2;
+   */
+  state: MemoImport_State;
+
+  /**
+   * The number of markdown files, or Google Keep notes, in the archive. For Day One exports,
+   * the number of journals until they are read, and then the number of their entries.
+   *
+   * @generated from field: int32 total_files = 3;
+   */
+  totalFiles: number;
+
+  /**
+   * The number of files imported, skipped or failed so far.
+   *
+   * @generated from field: int32 processed_files = 4;
+   */
+  processedFiles: number;
+
+  /**
+   * The number of memos created.
+   *
+   * @generated from field: int32 created_memos = 5;
+   */
+  createdMemos: number;
+
+  /**
+   * The number of files skipped because their content was already imported.
+   *
+   * @generated from field: int32 skipped_files = 6;
+   */
+  skippedFiles: number;
+
+  /**
+   * The files that failed to import. They don't abort the import of the other files. The
+   * entries of Day One journals are reported as {journal file}#{entry uuid}.
+   *
+   * @generated from field: repeated memos.api.v1.MemoImport.FileError errors = 7;
+   */
+  errors: MemoImport_FileError[];
+
+  /**
+   * When the import was started.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 8;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * When the import finished, if it did.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: google.protobuf.Timestamp finish_time = 9;
    */
-  name: string;
+  finishTime?: Timestamp;
 };
 
 /**
- * Describes the message memos.api.v1.GetMemoRequest.
- * Use `create(GetMemoRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.MemoImport.
+ * Use `create(MemoImportSchema)` to create a new message.
  */
-export const GetMemoRequestSchema: GenMessage<GetMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 6);
+export const MemoImportSchema: GenMessage<MemoImport> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 53);
 
 /**
- * @generated from message memos.api.v1.UpdateMemoRequest
+ * @generated from message memos.api.v1.MemoImport.FileError
  */
-export type UpdateMemoRequest = Message<"memos.api.v1.UpdateMemoRequest"> & {
+export type MemoImport_FileError = Message<"memos.api.v1.MemoImport.FileError"> & {
   /**
-   * Required. The memo to update.
-   * The `name` field is required.
+   * The path of the file in the archive.
    *
-   * @generated from field: memos.api.v1.Memo memo = 1;
+   * @generated from field: string filename = 1;
    */
-  memo?: Memo;
+  filename: string;
 
   /**
-   * Required. The list of fields to update.
+   * The reason of the failure, e.g. "content too long".
    *
-   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   * @generated from field: string reason = 2;
    */
-  updateMask?: FieldMask;
+  reason: string;
 };
 
 /**
- * Describes the message memos.api.v1.UpdateMemoRequest.
- * Use `create(UpdateMemoRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.MemoImport.FileError.
+ * Use `create(MemoImport_FileErrorSchema)` to create a new message.
  */
-export const UpdateMemoRequestSchema: GenMessage<UpdateMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 7);
+export const MemoImport_FileErrorSchema: GenMessage<MemoImport_FileError> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 53, 0);
 
 /**
- * @generated from message memos.api.v1.DeleteMemoRequest
+ * @generated from enum memos.api.v1.MemoImport.State
  */
-export type DeleteMemoRequest = Message<"memos.api.v1.DeleteMemoRequest"> & {
+export enum MemoImport_State {
   /**
-   * Required. The resource name of the memo to delete.
-   * Format: memos/{memo}
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The import is running.
    *
-   * @generated from field: string name = 1;
+   * @generated from enum value: RUNNING = 1;
    */
-  name: string;
+  RUNNING = 1,
 
   /**
-   * Optional. If set to true, the memo will be deleted even if it has associated data.
+   * The import finished, possibly with some files failing.
    *
-   * @generated from field: bool force = 2;
+   * @generated from enum value: SUCCEEDED = 2;
    */
-  force: boolean;
-};
+  SUCCEEDED = 2,
+
+  /**
+   * The import was aborted.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
 
 /**
- * Describes the message memos.api.v1.DeleteMemoRequest.
- * Use `create(DeleteMemoRequestSchema)` to create a new message.
+ * Describes the enum memos.api.v1.MemoImport.State.
  */
-export const DeleteMemoRequestSchema: GenMessage<DeleteMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 8);
+export const MemoImport_StateSchema: GenEnum<MemoImport_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_memo_service, 53, 0);
 
 /**
  * @generated from message memos.api.v1.SetMemoAttachmentsRequest
@@ -492,7 +2293,7 @@ export type SetMemoAttachmentsRequest = Message<"memos.api.v1.SetMemoAttachments
  * Use `create(SetMemoAttachmentsRequestSchema)` to create a new message.
  */
 export const SetMemoAttachmentsRequestSchema: GenMessage<SetMemoAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 9);
+  messageDesc(file_api_v1_memo_service, 54);
 
 /**
  * @generated from message memos.api.v1.ListMemoAttachmentsRequest
@@ -526,7 +2327,7 @@ export type ListMemoAttachmentsRequest = Message<"memos.api.v1.ListMemoAttachmen
  * Use `create(ListMemoAttachmentsRequestSchema)` to create a new message.
  */
 export const ListMemoAttachmentsRequestSchema: GenMessage<ListMemoAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 10);
+  messageDesc(file_api_v1_memo_service, 55);
 
 /**
  * @generated from message memos.api.v1.ListMemoAttachmentsResponse
@@ -552,7 +2353,7 @@ export type ListMemoAttachmentsResponse = Message<"memos.api.v1.ListMemoAttachme
  * Use `create(ListMemoAttachmentsResponseSchema)` to create a new message.
  */
 export const ListMemoAttachmentsResponseSchema: GenMessage<ListMemoAttachmentsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 11);
+  messageDesc(file_api_v1_memo_service, 56);
 
 /**
  * @generated from message memos.api.v1.MemoRelation
@@ -583,7 +2384,7 @@ export type MemoRelation = Message<"memos.api.v1.MemoRelation"> & {
  * Use `create(MemoRelationSchema)` to create a new message.
  */
 export const MemoRelationSchema: GenMessage<MemoRelation> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 12);
+  messageDesc(file_api_v1_memo_service, 57);
 
 /**
  * Memo reference in relations.
@@ -612,7 +2413,7 @@ export type MemoRelation_Memo = Message<"memos.api.v1.MemoRelation.Memo"> & {
  * Use `create(MemoRelation_MemoSchema)` to create a new message.
  */
 export const MemoRelation_MemoSchema: GenMessage<MemoRelation_Memo> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 12, 0);
+  messageDesc(file_api_v1_memo_service, 57, 0);
 
 /**
  * The type of the relation.
@@ -634,13 +2435,20 @@ export enum MemoRelation_Type {
    * @generated from enum value: COMMENT = 2;
    */
   COMMENT = 2,
+
+  /**
+   * The memo was merged into the related memo.
+   *
+   * @generated from enum value: MERGE = 3;
+   */
+  MERGE = 3,
 }
 
 /**
  * Describes the enum memos.api.v1.MemoRelation.Type.
  */
 export const MemoRelation_TypeSchema: GenEnum<MemoRelation_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_memo_service, 12, 0);
+  enumDesc(file_api_v1_memo_service, 57, 0);
 
 /**
  * @generated from message memos.api.v1.SetMemoRelationsRequest
@@ -667,7 +2475,7 @@ export type SetMemoRelationsRequest = Message<"memos.api.v1.SetMemoRelationsRequ
  * Use `create(SetMemoRelationsRequestSchema)` to create a new message.
  */
 export const SetMemoRelationsRequestSchema: GenMessage<SetMemoRelationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 13);
+  messageDesc(file_api_v1_memo_service, 58);
 
 /**
  * @generated from message memos.api.v1.ListMemoRelationsRequest
@@ -701,7 +2509,7 @@ export type ListMemoRelationsRequest = Message<"memos.api.v1.ListMemoRelationsRe
  * Use `create(ListMemoRelationsRequestSchema)` to create a new message.
  */
 export const ListMemoRelationsRequestSchema: GenMessage<ListMemoRelationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 14);
+  messageDesc(file_api_v1_memo_service, 59);
 
 /**
  * @generated from message memos.api.v1.ListMemoRelationsResponse
@@ -727,7 +2535,68 @@ export type ListMemoRelationsResponse = Message<"memos.api.v1.ListMemoRelationsR
  * Use `create(ListMemoRelationsResponseSchema)` to create a new message.
  */
 export const ListMemoRelationsResponseSchema: GenMessage<ListMemoRelationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 15);
+  messageDesc(file_api_v1_memo_service, 60);
+
+/**
+ * @generated from message memos.api.v1.ListMemoBacklinksRequest
+ */
+export type ListMemoBacklinksRequest = Message<"memos.api.v1.ListMemoBacklinksRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The maximum number of memos to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token for pagination.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoBacklinksRequest.
+ * Use `create(ListMemoBacklinksRequestSchema)` to create a new message.
+ */
+export const ListMemoBacklinksRequestSchema: GenMessage<ListMemoBacklinksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 61);
+
+/**
+ * @generated from message memos.api.v1.ListMemoBacklinksResponse
+ */
+export type ListMemoBacklinksResponse = Message<"memos.api.v1.ListMemoBacklinksResponse"> & {
+  /**
+   * The memos referencing the memo that are visible to the caller, newest first,
+   * with their snippets.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRelation.Memo memos = 1;
+   */
+  memos: MemoRelation_Memo[];
+
+  /**
+   * A token for the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoBacklinksResponse.
+ * Use `create(ListMemoBacklinksResponseSchema)` to create a new message.
+ */
+export const ListMemoBacklinksResponseSchema: GenMessage<ListMemoBacklinksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 62);
 
 /**
  * @generated from message memos.api.v1.CreateMemoCommentRequest
@@ -761,7 +2630,7 @@ export type CreateMemoCommentRequest = Message<"memos.api.v1.CreateMemoCommentRe
  * Use `create(CreateMemoCommentRequestSchema)` to create a new message.
  */
 export const CreateMemoCommentRequestSchema: GenMessage<CreateMemoCommentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 16);
+  messageDesc(file_api_v1_memo_service, 63);
 
 /**
  * @generated from message memos.api.v1.ListMemoCommentsRequest
@@ -802,7 +2671,7 @@ export type ListMemoCommentsRequest = Message<"memos.api.v1.ListMemoCommentsRequ
  * Use `create(ListMemoCommentsRequestSchema)` to create a new message.
  */
 export const ListMemoCommentsRequestSchema: GenMessage<ListMemoCommentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 17);
+  messageDesc(file_api_v1_memo_service, 64);
 
 /**
  * @generated from message memos.api.v1.ListMemoCommentsResponse
@@ -835,7 +2704,7 @@ export type ListMemoCommentsResponse = Message<"memos.api.v1.ListMemoCommentsRes
  * Use `create(ListMemoCommentsResponseSchema)` to create a new message.
  */
 export const ListMemoCommentsResponseSchema: GenMessage<ListMemoCommentsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 18);
+  messageDesc(file_api_v1_memo_service, 65);
 
 /**
  * @generated from message memos.api.v1.ListMemoReactionsRequest
@@ -869,7 +2738,7 @@ export type ListMemoReactionsRequest = Message<"memos.api.v1.ListMemoReactionsRe
  * Use `create(ListMemoReactionsRequestSchema)` to create a new message.
  */
 export const ListMemoReactionsRequestSchema: GenMessage<ListMemoReactionsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 19);
+  messageDesc(file_api_v1_memo_service, 66);
 
 /**
  * @generated from message memos.api.v1.ListMemoReactionsResponse
@@ -902,7 +2771,7 @@ export type ListMemoReactionsResponse = Message<"memos.api.v1.ListMemoReactionsR
  * Use `create(ListMemoReactionsResponseSchema)` to create a new message.
  */
 export const ListMemoReactionsResponseSchema: GenMessage<ListMemoReactionsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 20);
+  messageDesc(file_api_v1_memo_service, 67);
 
 /**
  * @generated from message memos.api.v1.UpsertMemoReactionRequest
@@ -929,7 +2798,7 @@ export type UpsertMemoReactionRequest = Message<"memos.api.v1.UpsertMemoReaction
  * Use `create(UpsertMemoReactionRequestSchema)` to create a new message.
  */
 export const UpsertMemoReactionRequestSchema: GenMessage<UpsertMemoReactionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 21);
+  messageDesc(file_api_v1_memo_service, 68);
 
 /**
  * @generated from message memos.api.v1.DeleteMemoReactionRequest
@@ -949,7 +2818,7 @@ export type DeleteMemoReactionRequest = Message<"memos.api.v1.DeleteMemoReaction
  * Use `create(DeleteMemoReactionRequestSchema)` to create a new message.
  */
 export const DeleteMemoReactionRequestSchema: GenMessage<DeleteMemoReactionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 22);
+  messageDesc(file_api_v1_memo_service, 69);
 
 /**
  * @generated from enum memos.api.v1.Visibility
@@ -1006,6 +2875,38 @@ export const MemoService: GenService<{
     input: typeof ListMemosRequestSchema;
     output: typeof ListMemosResponseSchema;
   },
+  /**
+   * GetMemoHighlights gets memos of the current user to rediscover: the memos created on the same
+   * day in previous years, and a random sample of memos older than 90 days.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoHighlights
+   */
+  getMemoHighlights: {
+    methodKind: "unary";
+    input: typeof GetMemoHighlightsRequestSchema;
+    output: typeof GetMemoHighlightsResponseSchema;
+  },
+  /**
+   * GetMemoCounts gets the number of memos of the current user in each state.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoCounts
+   */
+  getMemoCounts: {
+    methodKind: "unary";
+    input: typeof GetMemoCountsRequestSchema;
+    output: typeof GetMemoCountsResponseSchema;
+  },
+  /**
+   * PreviewAutoArchiveMemos lists the memos of the current user that the auto-archive policy
+   * would archive, with the saved policy or the one of the request.
+   *
+   * @generated from rpc memos.api.v1.MemoService.PreviewAutoArchiveMemos
+   */
+  previewAutoArchiveMemos: {
+    methodKind: "unary";
+    input: typeof PreviewAutoArchiveMemosRequestSchema;
+    output: typeof PreviewAutoArchiveMemosResponseSchema;
+  },
   /**
    * GetMemo gets a memo.
    *
@@ -1027,7 +2928,8 @@ export const MemoService: GenService<{
     output: typeof MemoSchema;
   },
   /**
-   * DeleteMemo deletes a memo.
+   * DeleteMemo moves a memo to the trash.
+   * Memos in the trash are purged after the trash retention of the instance.
    *
    * @generated from rpc memos.api.v1.MemoService.DeleteMemo
    */
@@ -1036,6 +2938,270 @@ export const MemoService: GenService<{
     input: typeof DeleteMemoRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * RestoreMemo restores a memo from the trash.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RestoreMemo
+   */
+  restoreMemo: {
+    methodKind: "unary";
+    input: typeof RestoreMemoRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * PurgeMemo permanently deletes a memo with its attachments, relations, reactions, revisions
+   * and comments.
+   *
+   * @generated from rpc memos.api.v1.MemoService.PurgeMemo
+   */
+  purgeMemo: {
+    methodKind: "unary";
+    input: typeof PurgeMemoRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListMemoRevisions lists the revisions of a memo, newest first, with their diffs.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoRevisions
+   */
+  listMemoRevisions: {
+    methodKind: "unary";
+    input: typeof ListMemoRevisionsRequestSchema;
+    output: typeof ListMemoRevisionsResponseSchema;
+  },
+  /**
+   * GetMemoRevision gets a revision of a memo with its content.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoRevision
+   */
+  getMemoRevision: {
+    methodKind: "unary";
+    input: typeof GetMemoRevisionRequestSchema;
+    output: typeof MemoRevisionSchema;
+  },
+  /**
+   * RestoreMemoRevision restores the content of a memo to a revision, which creates a new revision.
+   * The attachments of the revision that still exist are attached to the memo again.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RestoreMemoRevision
+   */
+  restoreMemoRevision: {
+    methodKind: "unary";
+    input: typeof RestoreMemoRevisionRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * CreateMemoShareLink creates a link that shares a memo read-only with anyone who has it,
+   * whatever the visibility of the memo, until it expires, runs out of views or is revoked.
+   *
+   * @generated from rpc memos.api.v1.MemoService.CreateMemoShareLink
+   */
+  createMemoShareLink: {
+    methodKind: "unary";
+    input: typeof CreateMemoShareLinkRequestSchema;
+    output: typeof MemoShareLinkSchema;
+  },
+  /**
+   * ListMemoShareLinks lists the share links of a memo, newest first.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoShareLinks
+   */
+  listMemoShareLinks: {
+    methodKind: "unary";
+    input: typeof ListMemoShareLinksRequestSchema;
+    output: typeof ListMemoShareLinksResponseSchema;
+  },
+  /**
+   * UpdateMemoShareLink updates a share link. Only the passphrase can be changed, which signs out
+   * everyone who entered the previous one.
+   *
+   * @generated from rpc memos.api.v1.MemoService.UpdateMemoShareLink
+   */
+  updateMemoShareLink: {
+    methodKind: "unary";
+    input: typeof UpdateMemoShareLinkRequestSchema;
+    output: typeof MemoShareLinkSchema;
+  },
+  /**
+   * DeleteMemoShareLink revokes a share link.
+   *
+   * @generated from rpc memos.api.v1.MemoService.DeleteMemoShareLink
+   */
+  deleteMemoShareLink: {
+    methodKind: "unary";
+    input: typeof DeleteMemoShareLinkRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetSharedMemo gets the memo of a share link, which counts a view of the link. The links that
+   * expired, ran out of views or were revoked are not found. The links with a passphrase are
+   * unauthenticated without an access token from UnlockSharedMemo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetSharedMemo
+   */
+  getSharedMemo: {
+    methodKind: "unary";
+    input: typeof GetSharedMemoRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * UnlockSharedMemo checks the passphrase of a share link and returns a short-lived access token
+   * to the memo of the link. Failed attempts are throttled per link.
+   *
+   * @generated from rpc memos.api.v1.MemoService.UnlockSharedMemo
+   */
+  unlockSharedMemo: {
+    methodKind: "unary";
+    input: typeof UnlockSharedMemoRequestSchema;
+    output: typeof UnlockSharedMemoResponseSchema;
+  },
+  /**
+   * DuplicateMemo creates a private copy of a memo for the current user, with the same content
+   * and attachments. The copied attachments share the files of the memo's attachments.
+   * Duplicating a memo of another user adds a reference to the memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.DuplicateMemo
+   */
+  duplicateMemo: {
+    methodKind: "unary";
+    input: typeof DuplicateMemoRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * MergeMemos merges a source memo into a target memo of the current user. The source content is
+   * appended to the target, its attachments, reactions and relations move to the target, and the
+   * source is moved to the trash with a merge relation to the target.
+   *
+   * @generated from rpc memos.api.v1.MemoService.MergeMemos
+   */
+  mergeMemos: {
+    methodKind: "unary";
+    input: typeof MergeMemosRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * CreateMemoFromWikiLink creates the memo a missing [[wiki link]] of a memo of the current user
+   * links to, a private memo titled with the target, and resolves the link to it.
+   *
+   * @generated from rpc memos.api.v1.MemoService.CreateMemoFromWikiLink
+   */
+  createMemoFromWikiLink: {
+    methodKind: "unary";
+    input: typeof CreateMemoFromWikiLinkRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * UpdateMemoTask checks or unchecks a task list item of a memo, without rewriting the rest of
+   * its content.
+   *
+   * @generated from rpc memos.api.v1.MemoService.UpdateMemoTask
+   */
+  updateMemoTask: {
+    methodKind: "unary";
+    input: typeof UpdateMemoTaskRequestSchema;
+    output: typeof UpdateMemoTaskResponseSchema;
+  },
+  /**
+   * MovePinnedMemo moves a pinned memo up, down or to a position among the pinned memos of its
+   * creator, which are listed in that order before the other memos when ordered by pinned.
+   *
+   * @generated from rpc memos.api.v1.MemoService.MovePinnedMemo
+   */
+  movePinnedMemo: {
+    methodKind: "unary";
+    input: typeof MovePinnedMemoRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * SnoozeMemoReminder moves the reminder of a memo to a later time.
+   *
+   * @generated from rpc memos.api.v1.MemoService.SnoozeMemoReminder
+   */
+  snoozeMemoReminder: {
+    methodKind: "unary";
+    input: typeof SnoozeMemoReminderRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * CompleteMemoReminder clears the reminder of a memo, including its next occurrences.
+   *
+   * @generated from rpc memos.api.v1.MemoService.CompleteMemoReminder
+   */
+  completeMemoReminder: {
+    methodKind: "unary";
+    input: typeof CompleteMemoReminderRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * CreateBookmarkMemo creates a memo bookmarking a link, with the title, description and image
+   * of the link fetched like link previews. The memo is still created, with the link alone, if
+   * the metadata can't be fetched. Bookmarking a link again shortly after returns the memo that
+   * was created instead of creating another one.
+   *
+   * @generated from rpc memos.api.v1.MemoService.CreateBookmarkMemo
+   */
+  createBookmarkMemo: {
+    methodKind: "unary";
+    input: typeof CreateBookmarkMemoRequestSchema;
+    output: typeof CreateBookmarkMemoResponseSchema;
+  },
+  /**
+   * ListTagTree lists the tags of the memos of a user as a tree, e.g. "project" with the
+   * subtags "project/alpha" and "project/beta", with the number of memos of each tag.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListTagTree
+   */
+  listTagTree: {
+    methodKind: "unary";
+    input: typeof ListTagTreeRequestSchema;
+    output: typeof ListTagTreeResponseSchema;
+  },
+  /**
+   * RenameMemoTag renames a tag in all memos of the current user.
+   * Renaming to an existing tag merges the two tags.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RenameMemoTag
+   */
+  renameMemoTag: {
+    methodKind: "unary";
+    input: typeof RenameMemoTagRequestSchema;
+    output: typeof RenameMemoTagResponseSchema;
+  },
+  /**
+   * BatchUpdateMemos applies one operation to up to 100 memos in a single transaction. Each memo
+   * is checked as with the single-memo endpoints, and the memos that fail the checks are reported
+   * without failing the others. Each changed memo dispatches its own webhook event, as if it was
+   * changed by the single-memo endpoint.
+   *
+   * @generated from rpc memos.api.v1.MemoService.BatchUpdateMemos
+   */
+  batchUpdateMemos: {
+    methodKind: "unary";
+    input: typeof BatchUpdateMemosRequestSchema;
+    output: typeof BatchUpdateMemosResponseSchema;
+  },
+  /**
+   * ImportMemos starts importing the memos of a zip archive of markdown files, e.g. an Obsidian
+   * vault, of a Google Keep Takeout archive, or of a Day One export, as memos of the current
+   * user. The import runs in the background, and its progress is returned by GetMemoImport.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ImportMemos
+   */
+  importMemos: {
+    methodKind: "unary";
+    input: typeof ImportMemosRequestSchema;
+    output: typeof MemoImportSchema;
+  },
+  /**
+   * GetMemoImport gets the progress of an import of the current user.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoImport
+   */
+  getMemoImport: {
+    methodKind: "unary";
+    input: typeof GetMemoImportRequestSchema;
+    output: typeof MemoImportSchema;
+  },
   /**
    * SetMemoAttachments sets attachments for a memo.
    *
@@ -1076,6 +3242,16 @@ export const MemoService: GenService<{
     input: typeof ListMemoRelationsRequestSchema;
     output: typeof ListMemoRelationsResponseSchema;
   },
+  /**
+   * ListMemoBacklinks lists the memos referencing a memo.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoBacklinks
+   */
+  listMemoBacklinks: {
+    methodKind: "unary";
+    input: typeof ListMemoBacklinksRequestSchema;
+    output: typeof ListMemoBacklinksResponseSchema;
+  },
   /**
    * CreateMemoComment creates a comment for a memo.
    *
@@ -0,0 +1,331 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file api/v1/memo_template_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { MemoSchema, Visibility } from "./memo_service_pb";
+import { file_api_v1_memo_service } from "./memo_service_pb";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { EmptySchema, FieldMask } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/memo_template_service.proto.
+ */
+export const file_api_v1_memo_template_service: GenFile = /*@__PURE__*/
+  fileDesc("CiJhcGkvdjEvbWVtb190ZW1wbGF0ZV9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi+gEKDE1lbW9UZW1wbGF0ZRIRCgRuYW1lGAEgASgJQgPgQQgSEgoFdGl0bGUYAiABKAlCA+BBAhIUCgdjb250ZW50GAMgASgJQgPgQQESEQoEdGFncxgEIAMoCUID4EEBEjEKCnZpc2liaWxpdHkYBSABKA4yGC5tZW1vcy5hcGkudjEuVmlzaWJpbGl0eUID4EEBOmfqQWQKGW1lbW9zLmFwaS52MS9NZW1vVGVtcGxhdGUSKnVzZXJzL3t1c2VyfS9tZW1vVGVtcGxhdGVzL3ttZW1vX3RlbXBsYXRlfSoNbWVtb1RlbXBsYXRlczIMbWVtb1RlbXBsYXRlIk0KGExpc3RNZW1vVGVtcGxhdGVzUmVxdWVzdBIxCgZwYXJlbnQYASABKAlCIeBBAvpBGxIZbWVtb3MuYXBpLnYxL01lbW9UZW1wbGF0ZSJPChlMaXN0TWVtb1RlbXBsYXRlc1Jlc3BvbnNlEjIKDm1lbW9fdGVtcGxhdGVzGAEgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9UZW1wbGF0ZSJJChZHZXRNZW1vVGVtcGxhdGVSZXF1ZXN0Ei8KBG5hbWUYASABKAlCIeBBAvpBGwoZbWVtb3MuYXBpLnYxL01lbW9UZW1wbGF0ZSKGAQoZQ3JlYXRlTWVtb1RlbXBsYXRlUmVxdWVzdBIxCgZwYXJlbnQYASABKAlCIeBBAvpBGxIZbWVtb3MuYXBpLnYxL01lbW9UZW1wbGF0ZRI2Cg1tZW1vX3RlbXBsYXRlGAIgASgLMhoubWVtb3MuYXBpLnYxLk1lbW9UZW1wbGF0ZUID4EECIokBChlVcGRhdGVNZW1vVGVtcGxhdGVSZXF1ZXN0EjYKDW1lbW9fdGVtcGxhdGUYASABKAsyGi5tZW1vcy5hcGkudjEuTWVtb1RlbXBsYXRlQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiTAoZRGVsZXRlTWVtb1RlbXBsYXRlUmVxdWVzdBIvCgRuYW1lGAEgASgJQiHgQQL6QRsKGW1lbW9zLmFwaS52MS9NZW1vVGVtcGxhdGUiZAobUmVvcmRlck1lbW9UZW1wbGF0ZXNSZXF1ZXN0EjEKBnBhcmVudBgBIAEoCUIh4EEC+kEbEhltZW1vcy5hcGkudjEvTWVtb1RlbXBsYXRlEhIKBW5hbWVzGAIgAygJQgPgQQIiUAodQ3JlYXRlTWVtb0Zyb21UZW1wbGF0ZVJlcXVlc3QSLwoEbmFtZRgBIAEoCUIh4EEC+kEbChltZW1vcy5hcGkudjEvTWVtb1RlbXBsYXRlMqAJChNNZW1vVGVtcGxhdGVTZXJ2aWNlEp0BChFMaXN0TWVtb1RlbXBsYXRlcxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1RlbXBsYXRlc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9UZW1wbGF0ZXNSZXNwb25zZSI32kEGcGFyZW50gtPkkwIoEiYvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vbWVtb1RlbXBsYXRlcxKKAQoPR2V0TWVtb1RlbXBsYXRlEiQubWVtb3MuYXBpLnYxLkdldE1lbW9UZW1wbGF0ZVJlcXVlc3QaGi5tZW1vcy5hcGkudjEuTWVtb1RlbXBsYXRlIjXaQQRuYW1lgtPkkwIoEiYvYXBpL3YxL3tuYW1lPXVzZXJzLyovbWVtb1RlbXBsYXRlcy8qfRKvAQoSQ3JlYXRlTWVtb1RlbXBsYXRlEicubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9UZW1wbGF0ZVJlcXVlc3QaGi5tZW1vcy5hcGkudjEuTWVtb1RlbXBsYXRlIlTaQRRwYXJlbnQsbWVtb190ZW1wbGF0ZYLT5JMCNzoNbWVtb190ZW1wbGF0ZSImL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L21lbW9UZW1wbGF0ZXMSwgEKElVwZGF0ZU1lbW9UZW1wbGF0ZRInLm1lbW9zLmFwaS52MS5VcGRhdGVNZW1vVGVtcGxhdGVSZXF1ZXN0GhoubWVtb3MuYXBpLnYxLk1lbW9UZW1wbGF0ZSJn2kEZbWVtb190ZW1wbGF0ZSx1cGRhdGVfbWFza4LT5JMCRToNbWVtb190ZW1wbGF0ZTI0L2FwaS92MS97bWVtb190ZW1wbGF0ZS5uYW1lPXVzZXJzLyovbWVtb1RlbXBsYXRlcy8qfRKMAQoSRGVsZXRlTWVtb1RlbXBsYXRlEicubWVtb3MuYXBpLnYxLkRlbGV0ZU1lbW9UZW1wbGF0ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNdpBBG5hbWWC0+STAigqJi9hcGkvdjEve25hbWU9dXNlcnMvKi9tZW1vVGVtcGxhdGVzLyp9ErQBChRSZW9yZGVyTWVtb1RlbXBsYXRlcxIpLm1lbW9zLmFwaS52MS5SZW9yZGVyTWVtb1RlbXBsYXRlc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9UZW1wbGF0ZXNSZXNwb25zZSJI2kEMcGFyZW50LG5hbWVzgtPkkwIzOgEqIi4vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vbWVtb1RlbXBsYXRlczpyZW9yZGVyEp4BChZDcmVhdGVNZW1vRnJvbVRlbXBsYXRlEisubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9Gcm9tVGVtcGxhdGVSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iQ9pBBG5hbWWC0+STAjY6ASoiMS9hcGkvdjEve25hbWU9dXNlcnMvKi9tZW1vVGVtcGxhdGVzLyp9OmNyZWF0ZU1lbW9CsAEKEGNvbS5tZW1vcy5hcGkudjFCGE1lbW9UZW1wbGF0ZVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_memo_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
+
+/**
+ * @generated from message memos.api.v1.MemoTemplate
+ */
+export type MemoTemplate = Message<"memos.api.v1.MemoTemplate"> & {
+  /**
+   * The resource name of the memo template.
+   * Format: users/{user}/memoTemplates/{memo_template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The title of the memo template.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The content of the memos created from the template. The placeholders {{date}}
+   * (2006-01-02), {{time}} (15:04) and {{weekday}} (Monday) are expanded in the time zone
+   * of the user. Other placeholders are kept as is.
+   *
+   * @generated from field: string content = 3;
+   */
+  content: string;
+
+  /**
+   * The tags, without the # prefix, added to the memos created from the template if their
+   * content doesn't have them.
+   *
+   * @generated from field: repeated string tags = 4;
+   */
+  tags: string[];
+
+  /**
+   * The visibility of the memos created from the template. If unspecified, the default
+   * memo visibility of the user is used.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 5;
+   */
+  visibility: Visibility;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoTemplate.
+ * Use `create(MemoTemplateSchema)` to create a new message.
+ */
+export const MemoTemplateSchema: GenMessage<MemoTemplate> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListMemoTemplatesRequest
+ */
+export type ListMemoTemplatesRequest = Message<"memos.api.v1.ListMemoTemplatesRequest"> & {
+  /**
+   * Required. The parent resource where memo templates are listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoTemplatesRequest.
+ * Use `create(ListMemoTemplatesRequestSchema)` to create a new message.
+ */
+export const ListMemoTemplatesRequestSchema: GenMessage<ListMemoTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListMemoTemplatesResponse
+ */
+export type ListMemoTemplatesResponse = Message<"memos.api.v1.ListMemoTemplatesResponse"> & {
+  /**
+   * The list of memo templates, in the order of the user.
+   *
+   * @generated from field: repeated memos.api.v1.MemoTemplate memo_templates = 1;
+   */
+  memoTemplates: MemoTemplate[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoTemplatesResponse.
+ * Use `create(ListMemoTemplatesResponseSchema)` to create a new message.
+ */
+export const ListMemoTemplatesResponseSchema: GenMessage<ListMemoTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 2);
+
+/**
+ * @generated from message memos.api.v1.GetMemoTemplateRequest
+ */
+export type GetMemoTemplateRequest = Message<"memos.api.v1.GetMemoTemplateRequest"> & {
+  /**
+   * Required. The resource name of the memo template to retrieve.
+   * Format: users/{user}/memoTemplates/{memo_template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoTemplateRequest.
+ * Use `create(GetMemoTemplateRequestSchema)` to create a new message.
+ */
+export const GetMemoTemplateRequestSchema: GenMessage<GetMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoTemplateRequest
+ */
+export type CreateMemoTemplateRequest = Message<"memos.api.v1.CreateMemoTemplateRequest"> & {
+  /**
+   * Required. The parent resource where this memo template will be created.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The memo template to create.
+   *
+   * @generated from field: memos.api.v1.MemoTemplate memo_template = 2;
+   */
+  memoTemplate?: MemoTemplate;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoTemplateRequest.
+ * Use `create(CreateMemoTemplateRequestSchema)` to create a new message.
+ */
+export const CreateMemoTemplateRequestSchema: GenMessage<CreateMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 4);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoTemplateRequest
+ */
+export type UpdateMemoTemplateRequest = Message<"memos.api.v1.UpdateMemoTemplateRequest"> & {
+  /**
+   * Required. The memo template resource which replaces the resource on the server.
+   *
+   * @generated from field: memos.api.v1.MemoTemplate memo_template = 1;
+   */
+  memoTemplate?: MemoTemplate;
+
+  /**
+   * Required. The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoTemplateRequest.
+ * Use `create(UpdateMemoTemplateRequestSchema)` to create a new message.
+ */
+export const UpdateMemoTemplateRequestSchema: GenMessage<UpdateMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 5);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoTemplateRequest
+ */
+export type DeleteMemoTemplateRequest = Message<"memos.api.v1.DeleteMemoTemplateRequest"> & {
+  /**
+   * Required. The resource name of the memo template to delete.
+   * Format: users/{user}/memoTemplates/{memo_template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoTemplateRequest.
+ * Use `create(DeleteMemoTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteMemoTemplateRequestSchema: GenMessage<DeleteMemoTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 6);
+
+/**
+ * @generated from message memos.api.v1.ReorderMemoTemplatesRequest
+ */
+export type ReorderMemoTemplatesRequest = Message<"memos.api.v1.ReorderMemoTemplatesRequest"> & {
+  /**
+   * Required. The user of the memo templates.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The resource names of all the memo templates of the user, in their new order.
+   *
+   * @generated from field: repeated string names = 2;
+   */
+  names: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.ReorderMemoTemplatesRequest.
+ * Use `create(ReorderMemoTemplatesRequestSchema)` to create a new message.
+ */
+export const ReorderMemoTemplatesRequestSchema: GenMessage<ReorderMemoTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 7);
+
+/**
+ * @generated from message memos.api.v1.CreateMemoFromTemplateRequest
+ */
+export type CreateMemoFromTemplateRequest = Message<"memos.api.v1.CreateMemoFromTemplateRequest"> & {
+  /**
+   * Required. The resource name of the memo template.
+   * Format: users/{user}/memoTemplates/{memo_template}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateMemoFromTemplateRequest.
+ * Use `create(CreateMemoFromTemplateRequestSchema)` to create a new message.
+ */
+export const CreateMemoFromTemplateRequestSchema: GenMessage<CreateMemoFromTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_template_service, 8);
+
+/**
+ * @generated from service memos.api.v1.MemoTemplateService
+ */
+export const MemoTemplateService: GenService<{
+  /**
+   * ListMemoTemplates returns the memo templates of a user, in the order of the user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.ListMemoTemplates
+   */
+  listMemoTemplates: {
+    methodKind: "unary";
+    input: typeof ListMemoTemplatesRequestSchema;
+    output: typeof ListMemoTemplatesResponseSchema;
+  },
+  /**
+   * GetMemoTemplate gets a memo template by name.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.GetMemoTemplate
+   */
+  getMemoTemplate: {
+    methodKind: "unary";
+    input: typeof GetMemoTemplateRequestSchema;
+    output: typeof MemoTemplateSchema;
+  },
+  /**
+   * CreateMemoTemplate creates a memo template for a user, after its other templates.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.CreateMemoTemplate
+   */
+  createMemoTemplate: {
+    methodKind: "unary";
+    input: typeof CreateMemoTemplateRequestSchema;
+    output: typeof MemoTemplateSchema;
+  },
+  /**
+   * UpdateMemoTemplate updates a memo template of a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.UpdateMemoTemplate
+   */
+  updateMemoTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateMemoTemplateRequestSchema;
+    output: typeof MemoTemplateSchema;
+  },
+  /**
+   * DeleteMemoTemplate deletes a memo template of a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.DeleteMemoTemplate
+   */
+  deleteMemoTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteMemoTemplateRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ReorderMemoTemplates sets the order of the memo templates of a user.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.ReorderMemoTemplates
+   */
+  reorderMemoTemplates: {
+    methodKind: "unary";
+    input: typeof ReorderMemoTemplatesRequestSchema;
+    output: typeof ListMemoTemplatesResponseSchema;
+  },
+  /**
+   * CreateMemoFromTemplate creates a memo from a template, with its placeholders expanded in the
+   * time zone of the user and its default tags.
+   *
+   * @generated from rpc memos.api.v1.MemoTemplateService.CreateMemoFromTemplate
+   */
+  createMemoFromTemplate: {
+    methodKind: "unary";
+    input: typeof CreateMemoFromTemplateRequestSchema;
+    output: typeof MemoSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_memo_template_service, 0);
+
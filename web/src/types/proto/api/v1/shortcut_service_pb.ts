@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/shortcut_service.proto.
  */
 export const file_api_v1_shortcut_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvc2hvcnRjdXRfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIpoBCghTaG9ydGN1dBIRCgRuYW1lGAEgASgJQgPgQQgSEgoFdGl0bGUYAiABKAlCA+BBAhITCgZmaWx0ZXIYAyABKAlCA+BBATpS6kFPChVtZW1vcy5hcGkudjEvU2hvcnRjdXQSIXVzZXJzL3t1c2VyfS9zaG9ydGN1dHMve3Nob3J0Y3V0fSoJc2hvcnRjdXRzMghzaG9ydGN1dCJFChRMaXN0U2hvcnRjdXRzUmVxdWVzdBItCgZwYXJlbnQYASABKAlCHeBBAvpBFxIVbWVtb3MuYXBpLnYxL1Nob3J0Y3V0IkIKFUxpc3RTaG9ydGN1dHNSZXNwb25zZRIpCglzaG9ydGN1dHMYASADKAsyFi5tZW1vcy5hcGkudjEuU2hvcnRjdXQiQQoSR2V0U2hvcnRjdXRSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL1Nob3J0Y3V0IpEBChVDcmVhdGVTaG9ydGN1dFJlcXVlc3QSLQoGcGFyZW50GAEgASgJQh3gQQL6QRcSFW1lbW9zLmFwaS52MS9TaG9ydGN1dBItCghzaG9ydGN1dBgCIAEoCzIWLm1lbW9zLmFwaS52MS5TaG9ydGN1dEID4EECEhoKDXZhbGlkYXRlX29ubHkYAyABKAhCA+BBASJ8ChVVcGRhdGVTaG9ydGN1dFJlcXVlc3QSLQoIc2hvcnRjdXQYASABKAsyFi5tZW1vcy5hcGkudjEuU2hvcnRjdXRCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBASJEChVEZWxldGVTaG9ydGN1dFJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvU2hvcnRjdXQy3gUKD1Nob3J0Y3V0U2VydmljZRKNAQoNTGlzdFNob3J0Y3V0cxIiLm1lbW9zLmFwaS52MS5MaXN0U2hvcnRjdXRzUmVxdWVzdBojLm1lbW9zLmFwaS52MS5MaXN0U2hvcnRjdXRzUmVzcG9uc2UiM9pBBnBhcmVudILT5JMCJBIiL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nob3J0Y3V0cxJ6CgtHZXRTaG9ydGN1dBIgLm1lbW9zLmFwaS52MS5HZXRTaG9ydGN1dFJlcXVlc3QaFi5tZW1vcy5hcGkudjEuU2hvcnRjdXQiMdpBBG5hbWWC0+STAiQSIi9hcGkvdjEve25hbWU9dXNlcnMvKi9zaG9ydGN1dHMvKn0SlQEKDkNyZWF0ZVNob3J0Y3V0EiMubWVtb3MuYXBpLnYxLkNyZWF0ZVNob3J0Y3V0UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5TaG9ydGN1dCJG2kEPcGFyZW50LHNob3J0Y3V0gtPkkwIuOghzaG9ydGN1dCIiL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nob3J0Y3V0cxKjAQoOVXBkYXRlU2hvcnRjdXQSIy5tZW1vcy5hcGkudjEuVXBkYXRlU2hvcnRjdXRSZXF1ZXN0GhYubWVtb3MuYXBpLnYxLlNob3J0Y3V0IlTaQRRzaG9ydGN1dCx1cGRhdGVfbWFza4LT5JMCNzoIc2hvcnRjdXQyKy9hcGkvdjEve3Nob3J0Y3V0Lm5hbWU9dXNlcnMvKi9zaG9ydGN1dHMvKn0SgAEKDkRlbGV0ZVNob3J0Y3V0EiMubWVtb3MuYXBpLnYxLkRlbGV0ZVNob3J0Y3V0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIx2kEEbmFtZYLT5JMCJCoiL2FwaS92MS97bmFtZT11c2Vycy8qL3Nob3J0Y3V0cy8qfUKsAQoQY29tLm1lbW9zLmFwaS52MUIUU2hvcnRjdXRTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
+  fileDesc("Ch1hcGkvdjEvc2hvcnRjdXRfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIrEBCghTaG9ydGN1dBIRCgRuYW1lGAEgASgJQgPgQQgSEgoFdGl0bGUYAiABKAlCA+BBAhITCgZmaWx0ZXIYAyABKAlCA+BBARIVCghvcmRlcl9ieRgEIAEoCUID4EEBOlLqQU8KFW1lbW9zLmFwaS52MS9TaG9ydGN1dBIhdXNlcnMve3VzZXJ9L3Nob3J0Y3V0cy97c2hvcnRjdXR9KglzaG9ydGN1dHMyCHNob3J0Y3V0IkUKFExpc3RTaG9ydGN1dHNSZXF1ZXN0Ei0KBnBhcmVudBgBIAEoCUId4EEC+kEXEhVtZW1vcy5hcGkudjEvU2hvcnRjdXQiQgoVTGlzdFNob3J0Y3V0c1Jlc3BvbnNlEikKCXNob3J0Y3V0cxgBIAMoCzIWLm1lbW9zLmFwaS52MS5TaG9ydGN1dCJBChJHZXRTaG9ydGN1dFJlcXVlc3QSKwoEbmFtZRgBIAEoCUId4EEC+kEXChVtZW1vcy5hcGkudjEvU2hvcnRjdXQikQEKFUNyZWF0ZVNob3J0Y3V0UmVxdWVzdBItCgZwYXJlbnQYASABKAlCHeBBAvpBFxIVbWVtb3MuYXBpLnYxL1Nob3J0Y3V0Ei0KCHNob3J0Y3V0GAIgASgLMhYubWVtb3MuYXBpLnYxLlNob3J0Y3V0QgPgQQISGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBInwKFVVwZGF0ZVNob3J0Y3V0UmVxdWVzdBItCghzaG9ydGN1dBgBIAEoCzIWLm1lbW9zLmFwaS52MS5TaG9ydGN1dEID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIkQKFURlbGV0ZVNob3J0Y3V0UmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFW1lbW9zLmFwaS52MS9TaG9ydGN1dCJcChdSZW9yZGVyU2hvcnRjdXRzUmVxdWVzdBItCgZwYXJlbnQYASABKAlCHeBBAvpBFxIVbWVtb3MuYXBpLnYxL1Nob3J0Y3V0EhIKBW5hbWVzGAIgAygJQgPgQQIiSgoZQ291bnRTaG9ydGN1dE1lbW9zUmVxdWVzdBItCgZwYXJlbnQYASABKAlCHeBBAvpBFxIVbWVtb3MuYXBpLnYxL1Nob3J0Y3V0Ip4BChpDb3VudFNob3J0Y3V0TWVtb3NSZXNwb25zZRJNCgttZW1vX2NvdW50cxgBIAMoCzI4Lm1lbW9zLmFwaS52MS5Db3VudFNob3J0Y3V0TWVtb3NSZXNwb25zZS5NZW1vQ291bnRzRW50cnkaMQoPTWVtb0NvdW50c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEyrwgKD1Nob3J0Y3V0U2VydmljZRKNAQoNTGlzdFNob3J0Y3V0cxIiLm1lbW9zLmFwaS52MS5MaXN0U2hvcnRjdXRzUmVxdWVzdBojLm1lbW9zLmFwaS52MS5MaXN0U2hvcnRjdXRzUmVzcG9uc2UiM9pBBnBhcmVudILT5JMCJBIiL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nob3J0Y3V0cxJ6CgtHZXRTaG9ydGN1dBIgLm1lbW9zLmFwaS52MS5HZXRTaG9ydGN1dFJlcXVlc3QaFi5tZW1vcy5hcGkudjEuU2hvcnRjdXQiMdpBBG5hbWWC0+STAiQSIi9hcGkvdjEve25hbWU9dXNlcnMvKi9zaG9ydGN1dHMvKn0SlQEKDkNyZWF0ZVNob3J0Y3V0EiMubWVtb3MuYXBpLnYxLkNyZWF0ZVNob3J0Y3V0UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5TaG9ydGN1dCJG2kEPcGFyZW50LHNob3J0Y3V0gtPkkwIuOghzaG9ydGN1dCIiL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nob3J0Y3V0cxKjAQoOVXBkYXRlU2hvcnRjdXQSIy5tZW1vcy5hcGkudjEuVXBkYXRlU2hvcnRjdXRSZXF1ZXN0GhYubWVtb3MuYXBpLnYxLlNob3J0Y3V0IlTaQRRzaG9ydGN1dCx1cGRhdGVfbWFza4LT5JMCNzoIc2hvcnRjdXQyKy9hcGkvdjEve3Nob3J0Y3V0Lm5hbWU9dXNlcnMvKi9zaG9ydGN1dHMvKn0SgAEKDkRlbGV0ZVNob3J0Y3V0EiMubWVtb3MuYXBpLnYxLkRlbGV0ZVNob3J0Y3V0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIx2kEEbmFtZYLT5JMCJCoiL2FwaS92MS97bmFtZT11c2Vycy8qL3Nob3J0Y3V0cy8qfRKkAQoQUmVvcmRlclNob3J0Y3V0cxIlLm1lbW9zLmFwaS52MS5SZW9yZGVyU2hvcnRjdXRzUmVxdWVzdBojLm1lbW9zLmFwaS52MS5MaXN0U2hvcnRjdXRzUmVzcG9uc2UiRNpBDHBhcmVudCxuYW1lc4LT5JMCLzoBKiIqL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nob3J0Y3V0czpyZW9yZGVyEqcBChJDb3VudFNob3J0Y3V0TWVtb3MSJy5tZW1vcy5hcGkudjEuQ291bnRTaG9ydGN1dE1lbW9zUmVxdWVzdBooLm1lbW9zLmFwaS52MS5Db3VudFNob3J0Y3V0TWVtb3NSZXNwb25zZSI+2kEGcGFyZW50gtPkkwIvEi0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vc2hvcnRjdXRzOmNvdW50TWVtb3NCrAEKEGNvbS5tZW1vcy5hcGkudjFCFFNob3J0Y3V0U2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
 
 /**
  * @generated from message memos.api.v1.Shortcut
@@ -43,6 +43,14 @@ export type Shortcut = Message<"memos.api.v1.Shortcut"> & {
    * @generated from field: string filter = 3;
    */
   filter: string;
+
+  /**
+   * The order of the memos of the shortcut, in the syntax of ListMemosRequest.order_by.
+   * Empty for the default order of memo lists.
+   *
+   * @generated from field: string order_by = 4;
+   */
+  orderBy: string;
 };
 
 /**
@@ -191,6 +199,73 @@ export type DeleteShortcutRequest = Message<"memos.api.v1.DeleteShortcutRequest"
 export const DeleteShortcutRequestSchema: GenMessage<DeleteShortcutRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_shortcut_service, 6);
 
+/**
+ * @generated from message memos.api.v1.ReorderShortcutsRequest
+ */
+export type ReorderShortcutsRequest = Message<"memos.api.v1.ReorderShortcutsRequest"> & {
+  /**
+   * Required. The user of the shortcuts.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The resource names of all the shortcuts of the user, in their new order.
+   *
+   * @generated from field: repeated string names = 2;
+   */
+  names: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.ReorderShortcutsRequest.
+ * Use `create(ReorderShortcutsRequestSchema)` to create a new message.
+ */
+export const ReorderShortcutsRequestSchema: GenMessage<ReorderShortcutsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_shortcut_service, 7);
+
+/**
+ * @generated from message memos.api.v1.CountShortcutMemosRequest
+ */
+export type CountShortcutMemosRequest = Message<"memos.api.v1.CountShortcutMemosRequest"> & {
+  /**
+   * Required. The user of the shortcuts.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CountShortcutMemosRequest.
+ * Use `create(CountShortcutMemosRequestSchema)` to create a new message.
+ */
+export const CountShortcutMemosRequestSchema: GenMessage<CountShortcutMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_shortcut_service, 8);
+
+/**
+ * @generated from message memos.api.v1.CountShortcutMemosResponse
+ */
+export type CountShortcutMemosResponse = Message<"memos.api.v1.CountShortcutMemosResponse"> & {
+  /**
+   * The numbers of memos of the shortcuts, by the resource names of the shortcuts.
+   * The memos are those of the user listed on the home page. Counts are capped at 1000.
+   *
+   * @generated from field: map<string, int32> memo_counts = 1;
+   */
+  memoCounts: { [key: string]: number };
+};
+
+/**
+ * Describes the message memos.api.v1.CountShortcutMemosResponse.
+ * Use `create(CountShortcutMemosResponseSchema)` to create a new message.
+ */
+export const CountShortcutMemosResponseSchema: GenMessage<CountShortcutMemosResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_shortcut_service, 9);
+
 /**
  * @generated from service memos.api.v1.ShortcutService
  */
@@ -245,6 +320,26 @@ export const ShortcutService: GenService<{
     input: typeof DeleteShortcutRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ReorderShortcuts sets the order of the shortcuts of a user.
+   *
+   * @generated from rpc memos.api.v1.ShortcutService.ReorderShortcuts
+   */
+  reorderShortcuts: {
+    methodKind: "unary";
+    input: typeof ReorderShortcutsRequestSchema;
+    output: typeof ListShortcutsResponseSchema;
+  },
+  /**
+   * CountShortcutMemos counts the memos of each shortcut of a user, without listing them.
+   *
+   * @generated from rpc memos.api.v1.ShortcutService.CountShortcutMemos
+   */
+  countShortcutMemos: {
+    methodKind: "unary";
+    input: typeof CountShortcutMemosRequestSchema;
+    output: typeof CountShortcutMemosResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_shortcut_service, 0);
 
@@ -10,15 +10,15 @@ import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/user_service.proto.
  */
 export const file_api_v1_user_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi4AMKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyI7CgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIICgRIT1NUEAESCQoFQURNSU4QAhIICgRVU0VSEAM6N+pBNAoRbWVtb3MuYXBpLnYxL1VzZXISDHVzZXJzL3t1c2VyfRoEbmFtZSoFdXNlcnMyBHVzZXIicwoQTGlzdFVzZXJzUmVxdWVzdBIWCglwYWdlX3NpemUYASABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAIgASgJQgPgQQESEwoGZmlsdGVyGAMgASgJQgPgQQESGQoMc2hvd19kZWxldGVkGAQgASgIQgPgQQEiYwoRTGlzdFVzZXJzUmVzcG9uc2USIQoFdXNlcnMYASADKAsyEi5tZW1vcy5hcGkudjEuVXNlchIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJtCg5HZXRVc2VyUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEjIKCXJlYWRfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBASKIAQoRQ3JlYXRlVXNlclJlcXVlc3QSKAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgbgQQLgQQQSFAoHdXNlcl9pZBgCIAEoCUID4EEBEhoKDXZhbGlkYXRlX29ubHkYAyABKAhCA+BBARIXCgpyZXF1ZXN0X2lkGAQgASgJQgPgQQEijAEKEVVwZGF0ZVVzZXJSZXF1ZXN0EiUKBHVzZXIYASABKAsyEi5tZW1vcy5hcGkudjEuVXNlckID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECEhoKDWFsbG93X21pc3NpbmcYAyABKAhCA+BBASJQChFEZWxldGVVc2VyUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhIKBWZvcmNlGAIgASgIQgPgQQEi2AMKCVVzZXJTdGF0cxIRCgRuYW1lGAEgASgJQgPgQQgSOwoXbWVtb19kaXNwbGF5X3RpbWVzdGFtcHMYAiADKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEj4KD21lbW9fdHlwZV9zdGF0cxgDIAEoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuTWVtb1R5cGVTdGF0cxI4Cgl0YWdfY291bnQYBCADKAsyJS5tZW1vcy5hcGkudjEuVXNlclN0YXRzLlRhZ0NvdW50RW50cnkSFAoMcGlubmVkX21lbW9zGAUgAygJEhgKEHRvdGFsX21lbW9fY291bnQYBiABKAUaLwoNVGFnQ291bnRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGl8KDU1lbW9UeXBlU3RhdHMSEgoKbGlua19jb3VudBgBIAEoBRISCgpjb2RlX2NvdW50GAIgASgFEhIKCnRvZG9fY291bnQYAyABKAUSEgoKdW5kb19jb3VudBgEIAEoBTo/6kE8ChZtZW1vcy5hcGkudjEvVXNlclN0YXRzEgx1c2Vycy97dXNlcn0qCXVzZXJTdGF0czIJdXNlclN0YXRzIj4KE0dldFVzZXJTdGF0c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciIZChdMaXN0QWxsVXNlclN0YXRzUmVxdWVzdCJCChhMaXN0QWxsVXNlclN0YXRzUmVzcG9uc2USJgoFc3RhdHMYASADKAsyFy5tZW1vcy5hcGkudjEuVXNlclN0YXRzIqUGCgtVc2VyU2V0dGluZxIRCgRuYW1lGAEgASgJQgPgQQgSQwoPZ2VuZXJhbF9zZXR0aW5nGAIgASgLMigubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRQoQc2Vzc2lvbnNfc2V0dGluZxgDIAEoCzIpLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5TZXNzaW9uc1NldHRpbmdIABJOChVhY2Nlc3NfdG9rZW5zX3NldHRpbmcYBCABKAsyLS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuQWNjZXNzVG9rZW5zU2V0dGluZ0gAEkUKEHdlYmhvb2tzX3NldHRpbmcYBSABKAsyKS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuV2ViaG9va3NTZXR0aW5nSAAaVwoOR2VuZXJhbFNldHRpbmcSEwoGbG9jYWxlGAEgASgJQgPgQQESHAoPbWVtb192aXNpYmlsaXR5GAMgASgJQgPgQQESEgoFdGhlbWUYBCABKAlCA+BBARo+Cg9TZXNzaW9uc1NldHRpbmcSKwoIc2Vzc2lvbnMYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlclNlc3Npb24aSwoTQWNjZXNzVG9rZW5zU2V0dGluZxI0Cg1hY2Nlc3NfdG9rZW5zGAEgAygLMh0ubWVtb3MuYXBpLnYxLlVzZXJBY2Nlc3NUb2tlbho+Cg9XZWJob29rc1NldHRpbmcSKwoId2ViaG9va3MYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siVgoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARIMCghTRVNTSU9OUxACEhEKDUFDQ0VTU19UT0tFTlMQAxIMCghXRUJIT09LUxAEOlnqQVYKGG1lbW9zLmFwaS52MS9Vc2VyU2V0dGluZxIfdXNlcnMve3VzZXJ9L3NldHRpbmdzL3tzZXR0aW5nfSoMdXNlclNldHRpbmdzMgt1c2VyU2V0dGluZ0IHCgV2YWx1ZSJHChVHZXRVc2VyU2V0dGluZ1JlcXVlc3QSLgoEbmFtZRgBIAEoCUIg4EEC+kEaChhtZW1vcy5hcGkudjEvVXNlclNldHRpbmcigQEKGFVwZGF0ZVVzZXJTZXR0aW5nUmVxdWVzdBIvCgdzZXR0aW5nGAEgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIidQoXTGlzdFVzZXJTZXR0aW5nc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJ0ChhMaXN0VXNlclNldHRpbmdzUmVzcG9uc2USKwoIc2V0dGluZ3MYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUisgIKD1VzZXJBY2Nlc3NUb2tlbhIRCgRuYW1lGAEgASgJQgPgQQgSGQoMYWNjZXNzX3Rva2VuGAIgASgJQgPgQQMSGAoLZGVzY3JpcHRpb24YAyABKAlCA+BBARIyCglpc3N1ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBATpu6kFrChxtZW1vcy5hcGkudjEvVXNlckFjY2Vzc1Rva2VuEih1c2Vycy97dXNlcn0vYWNjZXNzVG9rZW5zL3thY2Nlc3NfdG9rZW59KhB1c2VyQWNjZXNzVG9rZW5zMg91c2VyQWNjZXNzVG9rZW4ieQobTGlzdFVzZXJBY2Nlc3NUb2tlbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEigQEKHExpc3RVc2VyQWNjZXNzVG9rZW5zUmVzcG9uc2USNAoNYWNjZXNzX3Rva2VucxgBIAMoCzIdLm1lbW9zLmFwaS52MS5Vc2VyQWNjZXNzVG9rZW4SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUioQEKHENyZWF0ZVVzZXJBY2Nlc3NUb2tlblJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEjgKDGFjY2Vzc190b2tlbhgCIAEoCzIdLm1lbW9zLmFwaS52MS5Vc2VyQWNjZXNzVG9rZW5CA+BBAhIcCg9hY2Nlc3NfdG9rZW5faWQYAyABKAlCA+BBASJSChxEZWxldGVVc2VyQWNjZXNzVG9rZW5SZXF1ZXN0EjIKBG5hbWUYASABKAlCJOBBAvpBHgocbWVtb3MuYXBpLnYxL1VzZXJBY2Nlc3NUb2tlbiKpAwoLVXNlclNlc3Npb24SEQoEbmFtZRgBIAEoCUID4EEIEhcKCnNlc3Npb25faWQYAiABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI7ChJsYXN0X2FjY2Vzc2VkX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSPgoLY2xpZW50X2luZm8YBSABKAsyJC5tZW1vcy5hcGkudjEuVXNlclNlc3Npb24uQ2xpZW50SW5mb0ID4EEDGnUKCkNsaWVudEluZm8SEgoKdXNlcl9hZ2VudBgBIAEoCRISCgppcF9hZGRyZXNzGAIgASgJEhgKC2RldmljZV90eXBlGAMgASgJQgPgQQESDwoCb3MYBCABKAlCA+BBARIUCgdicm93c2VyGAUgASgJQgPgQQE6ROpBQQoYbWVtb3MuYXBpLnYxL1VzZXJTZXNzaW9uEh91c2Vycy97dXNlcn0vc2Vzc2lvbnMve3Nlc3Npb259GgRuYW1lIkQKF0xpc3RVc2VyU2Vzc2lvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciJHChhMaXN0VXNlclNlc3Npb25zUmVzcG9uc2USKwoIc2Vzc2lvbnMYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlclNlc3Npb24iLQoYUmV2b2tlVXNlclNlc3Npb25SZXF1ZXN0EhEKBG5hbWUYASABKAlCA+BBAiKqAQoLVXNlcldlYmhvb2sSDAoEbmFtZRgBIAEoCRILCgN1cmwYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIi4KF0xpc3RVc2VyV2ViaG9va3NSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECIkcKGExpc3RVc2VyV2ViaG9va3NSZXNwb25zZRIrCgh3ZWJob29rcxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJgChhDcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISLwoHd2ViaG9vaxgCIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0ID4EECInwKGFVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBIvCgd3ZWJob29rGAEgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rQgPgQQISLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIi0KGERlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBIRCgRuYW1lGAEgASgJQgPgQQIiigQKEFVzZXJOb3RpZmljYXRpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEikKBnNlbmRlchgCIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI6CgZzdGF0dXMYAyABKA4yJS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5TdGF0dXNCA+BBARI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI2CgR0eXBlGAUgASgOMiMubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uVHlwZUID4EEDEh0KC2FjdGl2aXR5X2lkGAYgASgFQgPgQQFIAIgBASI6CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCgoGVU5SRUFEEAESDAoIQVJDSElWRUQQAiIuCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQATpw6kFtCh1tZW1vcy5hcGkudjEvVXNlck5vdGlmaWNhdGlvbhIpdXNlcnMve3VzZXJ9L25vdGlmaWNhdGlvbnMve25vdGlmaWNhdGlvbn0aBG5hbWUqDW5vdGlmaWNhdGlvbnMyDG5vdGlmaWNhdGlvbkIOCgxfYWN0aXZpdHlfaWQijwEKHExpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARITCgZmaWx0ZXIYBCABKAlCA+BBASJvCh1MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZRI1Cg1ub3RpZmljYXRpb25zGAEgAygLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIpABCh1VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBI5Cgxub3RpZmljYXRpb24YASABKAsyHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbkID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlQKHURlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24y/hgKC1VzZXJTZXJ2aWNlEmMKCUxpc3RVc2VycxIeLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlIhWC0+STAg8SDS9hcGkvdjEvdXNlcnMSYgoHR2V0VXNlchIcLm1lbW9zLmFwaS52MS5HZXRVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIiXaQQRuYW1lgtPkkwIYEhYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9EmUKCkNyZWF0ZVVzZXISHy5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIi2kEEdXNlcoLT5JMCFToEdXNlciINL2FwaS92MS91c2VycxJ/CgpVcGRhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiPNpBEHVzZXIsdXBkYXRlX21hc2uC0+STAiM6BHVzZXIyGy9hcGkvdjEve3VzZXIubmFtZT11c2Vycy8qfRJsCgpEZWxldGVVc2VyEh8ubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IiXaQQRuYW1lgtPkkwIYKhYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9En4KEExpc3RBbGxVc2VyU3RhdHMSJS5tZW1vcy5hcGkudjEuTGlzdEFsbFVzZXJTdGF0c1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdEFsbFVzZXJTdGF0c1Jlc3BvbnNlIhuC0+STAhUSEy9hcGkvdjEvdXNlcnM6c3RhdHMSegoMR2V0VXNlclN0YXRzEiEubWVtb3MuYXBpLnYxLkdldFVzZXJTdGF0c1JlcXVlc3QaFy5tZW1vcy5hcGkudjEuVXNlclN0YXRzIi7aQQRuYW1lgtPkkwIhEh8vYXBpL3YxL3tuYW1lPXVzZXJzLyp9OmdldFN0YXRzEoIBCg5HZXRVc2VyU2V0dGluZxIjLm1lbW9zLmFwaS52MS5HZXRVc2VyU2V0dGluZ1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmciMNpBBG5hbWWC0+STAiMSIS9hcGkvdjEve25hbWU9dXNlcnMvKi9zZXR0aW5ncy8qfRKoAQoRVXBkYXRlVXNlclNldHRpbmcSJi5tZW1vcy5hcGkudjEuVXBkYXRlVXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIlDaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI0OgdzZXR0aW5nMikvYXBpL3YxL3tzZXR0aW5nLm5hbWU9dXNlcnMvKi9zZXR0aW5ncy8qfRKVAQoQTGlzdFVzZXJTZXR0aW5ncxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlclNldHRpbmdzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlclNldHRpbmdzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3NldHRpbmdzEqUBChRMaXN0VXNlckFjY2Vzc1Rva2VucxIpLm1lbW9zLmFwaS52MS5MaXN0VXNlckFjY2Vzc1Rva2Vuc1JlcXVlc3QaKi5tZW1vcy5hcGkudjEuTGlzdFVzZXJBY2Nlc3NUb2tlbnNSZXNwb25zZSI22kEGcGFyZW50gtPkkwInEiUvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vYWNjZXNzVG9rZW5zErUBChVDcmVhdGVVc2VyQWNjZXNzVG9rZW4SKi5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlckFjY2Vzc1Rva2VuUmVxdWVzdBodLm1lbW9zLmFwaS52MS5Vc2VyQWNjZXNzVG9rZW4iUdpBE3BhcmVudCxhY2Nlc3NfdG9rZW6C0+STAjU6DGFjY2Vzc190b2tlbiIlL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L2FjY2Vzc1Rva2VucxKRAQoVRGVsZXRlVXNlckFjY2Vzc1Rva2VuEioubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJBY2Nlc3NUb2tlblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNNpBBG5hbWWC0+STAicqJS9hcGkvdjEve25hbWU9dXNlcnMvKi9hY2Nlc3NUb2tlbnMvKn0SlQEKEExpc3RVc2VyU2Vzc2lvbnMSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXNzaW9uc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXNzaW9uc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9zZXNzaW9ucxKFAQoRUmV2b2tlVXNlclNlc3Npb24SJi5tZW1vcy5hcGkudjEuUmV2b2tlVXNlclNlc3Npb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjDaQQRuYW1lgtPkkwIjKiEvYXBpL3YxL3tuYW1lPXVzZXJzLyovc2Vzc2lvbnMvKn0SlQEKEExpc3RVc2VyV2ViaG9va3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS93ZWJob29rcxKbAQoRQ3JlYXRlVXNlcldlYmhvb2sSJi5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlcldlYmhvb2tSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rIkPaQQ5wYXJlbnQsd2ViaG9va4LT5JMCLDoHd2ViaG9vayIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEqgBChFVcGRhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siUNpBE3dlYmhvb2ssdXBkYXRlX21hc2uC0+STAjQ6B3dlYmhvb2syKS9hcGkvdjEve3dlYmhvb2submFtZT11c2Vycy8qL3dlYmhvb2tzLyp9EoUBChFEZWxldGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyV2ViaG9va1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMNpBBG5hbWWC0+STAiMqIS9hcGkvdjEve25hbWU9dXNlcnMvKi93ZWJob29rcy8qfRKpAQoVTGlzdFVzZXJOb3RpZmljYXRpb25zEioubWVtb3MuYXBpLnYxLkxpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdFVzZXJOb3RpZmljYXRpb25zUmVzcG9uc2UiN9pBBnBhcmVudILT5JMCKBImL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L25vdGlmaWNhdGlvbnMSywEKFlVwZGF0ZVVzZXJOb3RpZmljYXRpb24SKy5tZW1vcy5hcGkudjEuVXBkYXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QaHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbiJk2kEYbm90aWZpY2F0aW9uLHVwZGF0ZV9tYXNrgtPkkwJDOgxub3RpZmljYXRpb24yMy9hcGkvdjEve25vdGlmaWNhdGlvbi5uYW1lPXVzZXJzLyovbm90aWZpY2F0aW9ucy8qfRKUAQoWRGVsZXRlVXNlck5vdGlmaWNhdGlvbhIrLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI12kEEbmFtZYLT5JMCKComL2FwaS92MS97bmFtZT11c2Vycy8qL25vdGlmaWNhdGlvbnMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEFVzZXJTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEilwQKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI1CgxzdXNwZW5kX3RpbWUYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMiOwoEUm9sZRIUChBST0xFX1VOU1BFQ0lGSUVEEAASCAoESE9TVBABEgkKBUFETUlOEAISCAoEVVNFUhADOjfqQTQKEW1lbW9zLmFwaS52MS9Vc2VyEgx1c2Vycy97dXNlcn0aBG5hbWUqBXVzZXJzMgR1c2VyInMKEExpc3RVc2Vyc1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEhMKBmZpbHRlchgDIAEoCUID4EEBEhkKDHNob3dfZGVsZXRlZBgEIAEoCEID4EEBImMKEUxpc3RVc2Vyc1Jlc3BvbnNlEiEKBXVzZXJzGAEgAygLMhIubWVtb3MuYXBpLnYxLlVzZXISFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUibQoOR2V0VXNlclJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIyCglyZWFkX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQEiiAEKEUNyZWF0ZVVzZXJSZXF1ZXN0EigKBHVzZXIYASABKAsyEi5tZW1vcy5hcGkudjEuVXNlckIG4EEC4EEEEhQKB3VzZXJfaWQYAiABKAlCA+BBARIaCg12YWxpZGF0ZV9vbmx5GAMgASgIQgPgQQESFwoKcmVxdWVzdF9pZBgEIAEoCUID4EEBIowBChFVcGRhdGVVc2VyUmVxdWVzdBIlCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXJCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIaCg1hbGxvd19taXNzaW5nGAMgASgIQgPgQQEiUAoRRGVsZXRlVXNlclJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchISCgVmb3JjZRgCIAEoCEID4EEBItgDCglVc2VyU3RhdHMSEQoEbmFtZRgBIAEoCUID4EEIEjsKF21lbW9fZGlzcGxheV90aW1lc3RhbXBzGAIgAygLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI+Cg9tZW1vX3R5cGVfc3RhdHMYAyABKAsyJS5tZW1vcy5hcGkudjEuVXNlclN0YXRzLk1lbW9UeXBlU3RhdHMSOAoJdGFnX2NvdW50GAQgAygLMiUubWVtb3MuYXBpLnYxLlVzZXJTdGF0cy5UYWdDb3VudEVudHJ5EhQKDHBpbm5lZF9tZW1vcxgFIAMoCRIYChB0b3RhbF9tZW1vX2NvdW50GAYgASgFGi8KDVRhZ0NvdW50RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ARpfCg1NZW1vVHlwZVN0YXRzEhIKCmxpbmtfY291bnQYASABKAUSEgoKY29kZV9jb3VudBgCIAEoBRISCgp0b2RvX2NvdW50GAMgASgFEhIKCnVuZG9fY291bnQYBCABKAU6P+pBPAoWbWVtb3MuYXBpLnYxL1VzZXJTdGF0cxIMdXNlcnMve3VzZXJ9Kgl1c2VyU3RhdHMyCXVzZXJTdGF0cyI+ChNHZXRVc2VyU3RhdHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXIinwMKEFVzZXJXcml0aW5nU3RhdHMSEQoEbmFtZRgBIAEoCUID4EEIEhgKEHRvdGFsX21lbW9fY291bnQYAiABKAUSGAoQdG90YWxfd29yZF9jb3VudBgDIAEoAxIbChNhdmVyYWdlX21lbW9fbGVuZ3RoGAQgASgBEkoKD3RhZ19tZW1vX2NvdW50cxgFIAMoCzIxLm1lbW9zLmFwaS52MS5Vc2VyV3JpdGluZ1N0YXRzLlRhZ01lbW9Db3VudHNFbnRyeRIbChNjdXJyZW50X3N0cmVha19kYXlzGAYgASgFEhsKE2xvbmdlc3Rfc3RyZWFrX2RheXMYByABKAUSPwoMbW9udGhfY291bnRzGAggAygLMikubWVtb3MuYXBpLnYxLlVzZXJXcml0aW5nU3RhdHMuTW9udGhDb3VudBo0ChJUYWdNZW1vQ291bnRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ARoqCgpNb250aENvdW50Eg0KBW1vbnRoGAEgASgJEg0KBWNvdW50GAIgASgFImQKGkdldFVzZXJXcml0aW5nU3RhdHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISHQoQaW5jbHVkZV9hcmNoaXZlZBgCIAEoCEID4EEBIhkKF0xpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0IkIKGExpc3RBbGxVc2VyU3RhdHNSZXNwb25zZRImCgVzdGF0cxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMimhEKC1VzZXJTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJDCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJFChBzZXNzaW9uc19zZXR0aW5nGAMgASgLMikubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLlNlc3Npb25zU2V0dGluZ0gAEk4KFWFjY2Vzc190b2tlbnNfc2V0dGluZxgEIAEoCzItLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5BY2Nlc3NUb2tlbnNTZXR0aW5nSAASRQoQd2ViaG9va3Nfc2V0dGluZxgFIAEoCzIpLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5XZWJob29rc1NldHRpbmdIABJMChRhdXRvX2FyY2hpdmVfc2V0dGluZxgGIAEoCzIsLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5BdXRvQXJjaGl2ZVNldHRpbmdIABJDCg9zdG9yYWdlX3NldHRpbmcYByABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuU3RvcmFnZVNldHRpbmdIABJIChJtZW1vX3F1b3RhX3NldHRpbmcYCCABKAsyKi5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuTWVtb1F1b3RhU2V0dGluZ0gAEk0KFG5vdGlmaWNhdGlvbl9zZXR0aW5nGAkgASgLMi0ubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLk5vdGlmaWNhdGlvblNldHRpbmdIABqvAQoOR2VuZXJhbFNldHRpbmcSEwoGbG9jYWxlGAEgASgJQgPgQQESHAoPbWVtb192aXNpYmlsaXR5GAMgASgJQgPgQQESEgoFdGhlbWUYBCABKAlCA+BBARIVCgh0aW1lem9uZRgFIAEoCUID4EEBEiYKFHN0cmlwX2ltYWdlX21ldGFkYXRhGAYgASgIQgPgQQFIAIgBAUIXChVfc3RyaXBfaW1hZ2VfbWV0YWRhdGEaPgoPU2Vzc2lvbnNTZXR0aW5nEisKCHNlc3Npb25zGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXNzaW9uGksKE0FjY2Vzc1Rva2Vuc1NldHRpbmcSNAoNYWNjZXNzX3Rva2VucxgBIAMoCzIdLm1lbW9zLmFwaS52MS5Vc2VyQWNjZXNzVG9rZW4aPgoPV2ViaG9va3NTZXR0aW5nEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rGkQKEkF1dG9BcmNoaXZlU2V0dGluZxIRCgRkYXlzGAEgASgFQgPgQQESGwoOcHJvdGVjdGVkX3RhZ3MYAiADKAlCA+BBARrVAwoTTm90aWZpY2F0aW9uU2V0dGluZxIfChJlbWFpbF9tZW1vX2NvbW1lbnQYASABKAhCA+BBARIgChNlbWFpbF9tZW1vX3JlYWN0aW9uGAIgASgIQgPgQQESHwoSZW1haWxfbWVtb19tZW50aW9uGAMgASgIQgPgQQESIAoTZW1haWxfbWVtb19yZW1pbmRlchgEIAEoCEID4EEBEh4KEXF1aWV0X2hvdXJzX3N0YXJ0GAUgASgJQgPgQQESHAoPcXVpZXRfaG91cnNfZW5kGAYgASgJQgPgQQESXAoQZGlnZXN0X2ZyZXF1ZW5jeRgHIAEoDjI9Lm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5Ob3RpZmljYXRpb25TZXR0aW5nLkRpZ2VzdEZyZXF1ZW5jeUID4EEBEhgKC2RpZ2VzdF90aW1lGAggASgJQgPgQQESGwoOZGlnZXN0X3dlZWtkYXkYCSABKAVCA+BBARIZCgxlbWFpbF9kaWdlc3QYCiABKAhCA+BBASJKCg9EaWdlc3RGcmVxdWVuY3kSIAocRElHRVNUX0ZSRVFVRU5DWV9VTlNQRUNJRklFRBAAEgkKBURBSUxZEAESCgoGV0VFS0xZEAIabAoOU3RvcmFnZVNldHRpbmcSFwoKdXNlZF9ieXRlcxgBIAEoA0ID4EEDEhgKC3F1b3RhX2J5dGVzGAIgASgDQgPgQQMSGgoIcXVvdGFfbWIYAyABKANCA+BBAUgAiAEBQgsKCV9xdW90YV9tYhqfAgoQTWVtb1F1b3RhU2V0dGluZxIXCgptZW1vX2NvdW50GAEgASgFQgPgQQMSHQoQdG9kYXlfbWVtb19jb3VudBgCIAEoBUID4EEDEiQKF3RvZGF5X3B1YmxpY19tZW1vX2NvdW50GAMgASgFQgPgQQMSGwoJbWF4X21lbW9zGAQgASgFQgPgQQFIAIgBARIjChFtYXhfbWVtb3NfcGVyX2RheRgFIAEoBUID4EEBSAGIAQESKgoYbWF4X3B1YmxpY19tZW1vc19wZXJfZGF5GAYgASgFQgPgQQFIAogBAUIMCgpfbWF4X21lbW9zQhQKEl9tYXhfbWVtb3NfcGVyX2RheUIbChlfbWF4X3B1YmxpY19tZW1vc19wZXJfZGF5IpcBCgNLZXkSEwoPS0VZX1VOU1BFQ0lGSUVEEAASCwoHR0VORVJBTBABEgwKCFNFU1NJT05TEAISEQoNQUNDRVNTX1RPS0VOUxADEgwKCFdFQkhPT0tTEAQSEAoMQVVUT19BUkNISVZFEAUSCwoHU1RPUkFHRRAGEg4KCk1FTU9fUVVPVEEQBxIQCgxOT1RJRklDQVRJT04QCDpZ6kFWChhtZW1vcy5hcGkudjEvVXNlclNldHRpbmcSH3VzZXJzL3t1c2VyfS9zZXR0aW5ncy97c2V0dGluZ30qDHVzZXJTZXR0aW5nczILdXNlclNldHRpbmdCBwoFdmFsdWUiRwoVR2V0VXNlclNldHRpbmdSZXF1ZXN0Ei4KBG5hbWUYASABKAlCIOBBAvpBGgoYbWVtb3MuYXBpLnYxL1VzZXJTZXR0aW5nIoEBChhVcGRhdGVVc2VyU2V0dGluZ1JlcXVlc3QSLwoHc2V0dGluZxgBIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZ0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECInUKF0xpc3RVc2VyU2V0dGluZ3NSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEidAoYTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlEisKCHNldHRpbmdzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIpsDCg9Vc2VyQWNjZXNzVG9rZW4SEQoEbmFtZRgBIAEoCUID4EEIEhkKDGFjY2Vzc190b2tlbhgCIAEoCUID4EEDEhgKC2Rlc2NyaXB0aW9uGAMgASgJQgPgQQESMgoJaXNzdWVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjMKCmV4cGlyZXNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESEwoGc2NvcGVzGAYgAygJQgPgQQESNwoObGFzdF91c2VkX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSGQoMdG9rZW5fcHJlZml4GAggASgJQgPgQQM6bupBawocbWVtb3MuYXBpLnYxL1VzZXJBY2Nlc3NUb2tlbhIodXNlcnMve3VzZXJ9L2FjY2Vzc1Rva2Vucy97YWNjZXNzX3Rva2VufSoQdXNlckFjY2Vzc1Rva2VuczIPdXNlckFjY2Vzc1Rva2VuInkKG0xpc3RVc2VyQWNjZXNzVG9rZW5zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBIoEBChxMaXN0VXNlckFjY2Vzc1Rva2Vuc1Jlc3BvbnNlEjQKDWFjY2Vzc190b2tlbnMYASADKAsyHS5tZW1vcy5hcGkudjEuVXNlckFjY2Vzc1Rva2VuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIqEBChxDcmVhdGVVc2VyQWNjZXNzVG9rZW5SZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchI4CgxhY2Nlc3NfdG9rZW4YAiABKAsyHS5tZW1vcy5hcGkudjEuVXNlckFjY2Vzc1Rva2VuQgPgQQISHAoPYWNjZXNzX3Rva2VuX2lkGAMgASgJQgPgQQEiUgocRGVsZXRlVXNlckFjY2Vzc1Rva2VuUmVxdWVzdBIyCgRuYW1lGAEgASgJQiTgQQL6QR4KHG1lbW9zLmFwaS52MS9Vc2VyQWNjZXNzVG9rZW4ivwMKC1VzZXJTZXNzaW9uEhEKBG5hbWUYASABKAlCA+BBCBIXCgpzZXNzaW9uX2lkGAIgASgJQgPgQQMSNAoLY3JlYXRlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSOwoSbGFzdF9hY2Nlc3NlZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEj4KC2NsaWVudF9pbmZvGAUgASgLMiQubWVtb3MuYXBpLnYxLlVzZXJTZXNzaW9uLkNsaWVudEluZm9CA+BBAxIUCgdjdXJyZW50GAYgASgIQgPgQQMadQoKQ2xpZW50SW5mbxISCgp1c2VyX2FnZW50GAEgASgJEhIKCmlwX2FkZHJlc3MYAiABKAkSGAoLZGV2aWNlX3R5cGUYAyABKAlCA+BBARIPCgJvcxgEIAEoCUID4EEBEhQKB2Jyb3dzZXIYBSABKAlCA+BBATpE6kFBChhtZW1vcy5hcGkudjEvVXNlclNlc3Npb24SH3VzZXJzL3t1c2VyfS9zZXNzaW9ucy97c2Vzc2lvbn0aBG5hbWUiRAoXTGlzdFVzZXJTZXNzaW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIkcKGExpc3RVc2VyU2Vzc2lvbnNSZXNwb25zZRIrCghzZXNzaW9ucxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2Vzc2lvbiItChhSZXZva2VVc2VyU2Vzc2lvblJlcXVlc3QSEQoEbmFtZRgBIAEoCUID4EECIksKHlJldm9rZU90aGVyVXNlclNlc3Npb25zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXIi3QEKDVVzZXJUd29GYWN0b3ISEQoEbmFtZRgBIAEoCUID4EEIEhQKB2VuYWJsZWQYAiABKAhCA+BBAxI0CgtlbmFibGVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIlChhyZWNvdmVyeV9jb2Rlc19yZW1haW5pbmcYBCABKAVCA+BBAzpG6kFDChptZW1vcy5hcGkudjEvVXNlclR3b0ZhY3RvchIWdXNlcnMve3VzZXJ9L3R3b0ZhY3RvcjINdXNlclR3b0ZhY3RvciJLChdHZXRVc2VyVHdvRmFjdG9yUmVxdWVzdBIwCgRuYW1lGAEgASgJQiLgQQL6QRwKGm1lbW9zLmFwaS52MS9Vc2VyVHdvRmFjdG9yIk4KGkVucm9sbFVzZXJUd29GYWN0b3JSZXF1ZXN0EjAKBG5hbWUYASABKAlCIuBBAvpBHAoabWVtb3MuYXBpLnYxL1VzZXJUd29GYWN0b3IiQgobRW5yb2xsVXNlclR3b0ZhY3RvclJlc3BvbnNlEg4KBnNlY3JldBgBIAEoCRITCgtvdHBhdXRoX3VyaRgCIAEoCSJiChtDb25maXJtVXNlclR3b0ZhY3RvclJlcXVlc3QSMAoEbmFtZRgBIAEoCUIi4EEC+kEcChptZW1vcy5hcGkudjEvVXNlclR3b0ZhY3RvchIRCgRjb2RlGAIgASgJQgPgQQIiNgocQ29uZmlybVVzZXJUd29GYWN0b3JSZXNwb25zZRIWCg5yZWNvdmVyeV9jb2RlcxgBIAMoCSJhChpEZWxldGVVc2VyVHdvRmFjdG9yUmVxdWVzdBIwCgRuYW1lGAEgASgJQiLgQQL6QRwKGm1lbW9zLmFwaS52MS9Vc2VyVHdvRmFjdG9yEhEKBGNvZGUYAiABKAlCA+BBASKYAgoLVXNlclBhc3NrZXkSEQoEbmFtZRgBIAEoCUID4EEIEg0KBWxhYmVsGAIgASgJEhcKCnRyYW5zcG9ydHMYAyADKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI3Cg5sYXN0X3VzZWRfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAzpf6kFcChhtZW1vcy5hcGkudjEvVXNlclBhc3NrZXkSH3VzZXJzL3t1c2VyfS9wYXNza2V5cy97cGFzc2tleX0aBG5hbWUqDHVzZXJQYXNza2V5czILdXNlclBhc3NrZXkiRAoXTGlzdFVzZXJQYXNza2V5c1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIkcKGExpc3RVc2VyUGFzc2tleXNSZXNwb25zZRIrCghwYXNza2V5cxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyUGFzc2tleSJMCh9DcmVhdGVVc2VyUGFzc2tleU9wdGlvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciKkAQoSVXNlclBhc3NrZXlPcHRpb25zEhEKCWNoYWxsZW5nZRgBIAEoDBINCgVycF9pZBgCIAEoCRIPCgdycF9uYW1lGAMgASgJEhMKC3VzZXJfaGFuZGxlGAQgASgMEhAKCHVzZXJuYW1lGAUgASgJEhQKDGRpc3BsYXlfbmFtZRgGIAEoCRIeChZleGNsdWRlX2NyZWRlbnRpYWxfaWRzGAcgAygMIooCChhDcmVhdGVVc2VyUGFzc2tleVJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhIKBWxhYmVsGAIgASgJQgPgQQISGgoNY3JlZGVudGlhbF9pZBgDIAEoDEID4EECEh0KEGNsaWVudF9kYXRhX2pzb24YBCABKAxCA+BBAhIfChJhdXRoZW50aWNhdG9yX2RhdGEYBSABKAxCA+BBAhIXCgpwdWJsaWNfa2V5GAYgASgMQgPgQQISIQoUcHVibGljX2tleV9hbGdvcml0aG0YByABKANCA+BBAhIXCgp0cmFuc3BvcnRzGAggAygJQgPgQQEiSgoYRGVsZXRlVXNlclBhc3NrZXlSZXF1ZXN0Ei4KBG5hbWUYASABKAlCIOBBAvpBGgoYbWVtb3MuYXBpLnYxL1VzZXJQYXNza2V5IokDChRVc2VyUHVzaFN1YnNjcmlwdGlvbhIRCgRuYW1lGAEgASgJQgPgQQgSDQoFbGFiZWwYAiABKAkSGQoMcHVzaF9zZXJ2aWNlGAMgASgJQgPgQQMSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLZXhwaXJlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNwoObGFzdF9wdXNoX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6jgHqQYoBCiFtZW1vcy5hcGkudjEvVXNlclB1c2hTdWJzY3JpcHRpb24SMnVzZXJzL3t1c2VyfS9wdXNoU3Vic2NyaXB0aW9ucy97cHVzaF9zdWJzY3JpcHRpb259GgRuYW1lKhV1c2VyUHVzaFN1YnNjcmlwdGlvbnMyFHVzZXJQdXNoU3Vic2NyaXB0aW9uIk0KIExpc3RVc2VyUHVzaFN1YnNjcmlwdGlvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciJjCiFMaXN0VXNlclB1c2hTdWJzY3JpcHRpb25zUmVzcG9uc2USPgoScHVzaF9zdWJzY3JpcHRpb25zGAEgAygLMiIubWVtb3MuYXBpLnYxLlVzZXJQdXNoU3Vic2NyaXB0aW9uItcBCiFDcmVhdGVVc2VyUHVzaFN1YnNjcmlwdGlvblJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhIKBWxhYmVsGAIgASgJQgPgQQESFQoIZW5kcG9pbnQYAyABKAlCA+BBAhITCgZwMjU2ZGgYBCABKAxCA+BBAhIRCgRhdXRoGAUgASgMQgPgQQISNAoLZXhwaXJlX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQEiXAohRGVsZXRlVXNlclB1c2hTdWJzY3JpcHRpb25SZXF1ZXN0EjcKBG5hbWUYASABKAlCKeBBAvpBIwohbWVtb3MuYXBpLnYxL1VzZXJQdXNoU3Vic2NyaXB0aW9uIsoBCg1Vc2VyRmVlZFRva2VuEhEKBG5hbWUYASABKAlCA+BBCBIUCgdlbmFibGVkGAIgASgIQgPgQQMSNAoLY3JlYXRlX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEgoFdG9rZW4YBCABKAlCA+BBAzpG6kFDChptZW1vcy5hcGkudjEvVXNlckZlZWRUb2tlbhIWdXNlcnMve3VzZXJ9L2ZlZWRUb2tlbjINdXNlckZlZWRUb2tlbiJLChdHZXRVc2VyRmVlZFRva2VuUmVxdWVzdBIwCgRuYW1lGAEgASgJQiLgQQL6QRwKGm1lbW9zLmFwaS52MS9Vc2VyRmVlZFRva2VuIk4KGlJvdGF0ZVVzZXJGZWVkVG9rZW5SZXF1ZXN0EjAKBG5hbWUYASABKAlCIuBBAvpBHAoabWVtb3MuYXBpLnYxL1VzZXJGZWVkVG9rZW4iTgoaRGVsZXRlVXNlckZlZWRUb2tlblJlcXVlc3QSMAoEbmFtZRgBIAEoCUIi4EEC+kEcChptZW1vcy5hcGkudjEvVXNlckZlZWRUb2tlbiLaAQoQVXNlckluYm91bmRFbWFpbBIRCgRuYW1lGAEgASgJQgPgQQgSFgoJYXZhaWxhYmxlGAIgASgIQgPgQQMSFAoHYWRkcmVzcxgDIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOk/qQUwKHW1lbW9zLmFwaS52MS9Vc2VySW5ib3VuZEVtYWlsEhl1c2Vycy97dXNlcn0vaW5ib3VuZEVtYWlsMhB1c2VySW5ib3VuZEVtYWlsIlEKGkdldFVzZXJJbmJvdW5kRW1haWxSZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL1VzZXJJbmJvdW5kRW1haWwiVAodUm90YXRlVXNlckluYm91bmRFbWFpbFJlcXVlc3QSMwoEbmFtZRgBIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvVXNlckluYm91bmRFbWFpbCJUCh1EZWxldGVVc2VySW5ib3VuZEVtYWlsUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9Vc2VySW5ib3VuZEVtYWlsIsYCCg1Vc2VyU2xhY2tMaW5rEhEKBG5hbWUYASABKAlCA+BBCBIWCglhdmFpbGFibGUYAiABKAhCA+BBAxIaCg1zbGFja190ZWFtX2lkGAMgASgJQgPgQQMSGgoNc2xhY2tfdXNlcl9pZBgEIAEoCUID4EEDEjIKCWxpbmtfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIWCglsaW5rX2NvZGUYBiABKAlCA+BBAxI+ChVsaW5rX2NvZGVfZXhwaXJlX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6RupBQwoabWVtb3MuYXBpLnYxL1VzZXJTbGFja0xpbmsSFnVzZXJzL3t1c2VyfS9zbGFja0xpbmsyDXVzZXJTbGFja0xpbmsiSwoXR2V0VXNlclNsYWNrTGlua1JlcXVlc3QSMAoEbmFtZRgBIAEoCUIi4EEC+kEcChptZW1vcy5hcGkudjEvVXNlclNsYWNrTGluayJUCiBHZW5lcmF0ZVVzZXJTbGFja0xpbmtDb2RlUmVxdWVzdBIwCgRuYW1lGAEgASgJQiLgQQL6QRwKGm1lbW9zLmFwaS52MS9Vc2VyU2xhY2tMaW5rIk4KGkRlbGV0ZVVzZXJTbGFja0xpbmtSZXF1ZXN0EjAKBG5hbWUYASABKAlCIuBBAvpBHAoabWVtb3MuYXBpLnYxL1VzZXJTbGFja0xpbmsirwIKDlVzZXJNYXRyaXhMaW5rEhEKBG5hbWUYASABKAlCA+BBCBIWCglhdmFpbGFibGUYAiABKAhCA+BBAxIbCg5tYXRyaXhfdXNlcl9pZBgDIAEoCUID4EEDEjIKCWxpbmtfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIWCglsaW5rX2NvZGUYBSABKAlCA+BBAxI+ChVsaW5rX2NvZGVfZXhwaXJlX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6SepBRgobbWVtb3MuYXBpLnYxL1VzZXJNYXRyaXhMaW5rEhd1c2Vycy97dXNlcn0vbWF0cml4TGluazIOdXNlck1hdHJpeExpbmsiTQoYR2V0VXNlck1hdHJpeExpbmtSZXF1ZXN0EjEKBG5hbWUYASABKAlCI+BBAvpBHQobbWVtb3MuYXBpLnYxL1VzZXJNYXRyaXhMaW5rIlYKIUdlbmVyYXRlVXNlck1hdHJpeExpbmtDb2RlUmVxdWVzdBIxCgRuYW1lGAEgASgJQiPgQQL6QR0KG21lbW9zLmFwaS52MS9Vc2VyTWF0cml4TGluayJQChtEZWxldGVVc2VyTWF0cml4TGlua1JlcXVlc3QSMQoEbmFtZRgBIAEoCUIj4EEC+kEdChttZW1vcy5hcGkudjEvVXNlck1hdHJpeExpbmsiPAoRVW5sb2NrVXNlclJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciJSChJTdXNwZW5kVXNlclJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchITCgZyZWFzb24YAiABKAlCA+BBASJUChRVbnN1c3BlbmRVc2VyUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhMKBnJlYXNvbhgCIAEoCUID4EEBIrUECgtVc2VyV2ViaG9vaxIMCgRuYW1lGAEgASgJEgsKA3VybBgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSFQoIZGlzYWJsZWQYBiABKAhCA+BBARIhChRjb25zZWN1dGl2ZV9mYWlsdXJlcxgHIAEoBUID4EEDEhMKBnNlY3JldBgIIAEoCUID4EEDEhcKCmhhc19zZWNyZXQYCSABKAhCA+BBAxITCgZldmVudHMYCiADKAlCA+BBARI1CgZmb3JtYXQYCyABKA4yIC5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2suRm9ybWF0QgPgQQESMwoFc2NvcGUYDCABKA4yHy5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2suU2NvcGVCA+BBAxIkChdpbmNsdWRlX3ByaXZhdGVfY29udGVudBgNIAEoCEID4EEBIkEKBkZvcm1hdBIWChJGT1JNQVRfVU5TUEVDSUZJRUQQABIHCgNSQVcQARIJCgVTTEFDSxACEgsKB0RJU0NPUkQQAyI3CgVTY29wZRIVChFTQ09QRV9VTlNQRUNJRklFRBAAEggKBFVTRVIQARINCglXT1JLU1BBQ0UQAiKbBQoTVXNlcldlYmhvb2tEZWxpdmVyeRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSGgoNYWN0aXZpdHlfdHlwZRgCIAEoCUID4EEDEhkKDHBheWxvYWRfaGFzaBgDIAEoCUID4EEDEjsKBXN0YXRlGAQgASgOMicubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rRGVsaXZlcnkuU3RhdGVCA+BBAxIVCghhdHRlbXB0cxgFIAEoBUID4EEDEhwKD3Jlc3BvbnNlX3N0YXR1cxgGIAEoBUID4EEDEi8KB2xhdGVuY3kYByABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CA+BBAxISCgVlcnJvchgIIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjoKEW5leHRfYXR0ZW1wdF90aW1lGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIkYKBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEg0KCVNVQ0NFRURFRBACEgoKBkZBSUxFRBADOo8B6kGLAQogbWVtb3MuYXBpLnYxL1VzZXJXZWJob29rRGVsaXZlcnkSNXVzZXJzL3t1c2VyfS93ZWJob29rcy97d2ViaG9va30vZGVsaXZlcmllcy97ZGVsaXZlcnl9GgRuYW1lKhV1c2VyV2ViaG9va0RlbGl2ZXJpZXMyE3VzZXJXZWJob29rRGVsaXZlcnkiLgoXTGlzdFVzZXJXZWJob29rc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQIiRwoYTGlzdFVzZXJXZWJob29rc1Jlc3BvbnNlEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rImAKGENyZWF0ZVVzZXJXZWJob29rUmVxdWVzdBITCgZwYXJlbnQYASABKAlCA+BBAhIvCgd3ZWJob29rGAIgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rQgPgQQIifAoYVXBkYXRlVXNlcldlYmhvb2tSZXF1ZXN0Ei8KB3dlYmhvb2sYASABKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siLQoYRGVsZXRlVXNlcldlYmhvb2tSZXF1ZXN0EhEKBG5hbWUYASABKAlCA+BBAiIzCh5Sb3RhdGVVc2VyV2ViaG9va1NlY3JldFJlcXVlc3QSEQoEbmFtZRgBIAEoCUID4EECIisKFlRlc3RVc2VyV2ViaG9va1JlcXVlc3QSEQoEbmFtZRgBIAEoCUID4EECIuwBChdUZXN0VXNlcldlYmhvb2tSZXNwb25zZRITCgtzdGF0dXNfY29kZRgBIAEoBRJDCgdoZWFkZXJzGAIgAygLMjIubWVtb3MuYXBpLnYxLlRlc3RVc2VyV2ViaG9va1Jlc3BvbnNlLkhlYWRlcnNFbnRyeRIMCgRib2R5GAMgASgJEioKB2xhdGVuY3kYBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SDQoFZXJyb3IYBSABKAkaLgoMSGVhZGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiaAogTGlzdFVzZXJXZWJob29rRGVsaXZlcmllc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBInMKIUxpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXNSZXNwb25zZRI1CgpkZWxpdmVyaWVzGAEgAygLMiEubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rRGVsaXZlcnkSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIl0KI1JlZGVsaXZlclVzZXJXZWJob29rRGVsaXZlcnlSZXF1ZXN0EjYKBG5hbWUYASABKAlCKOBBAvpBIgogbWVtb3MuYXBpLnYxL1VzZXJXZWJob29rRGVsaXZlcnkijwUKEFVzZXJOb3RpZmljYXRpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEikKBnNlbmRlchgCIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI6CgZzdGF0dXMYAyABKA4yJS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5TdGF0dXNCA+BBARI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI2CgR0eXBlGAUgASgOMiMubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uVHlwZUID4EEDEh0KC2FjdGl2aXR5X2lkGAYgASgFQgPgQQFIAIgBASI6CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASCgoGVU5SRUFEEAESDAoIQVJDSElWRUQQAiKyAQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEAoMTUVNT19DT01NRU5UEAESEQoNTUVNT19SRU1JTkRFUhACEhUKEU1FTU9fQVVUT19BUkNISVZFEAMSFAoQV0VCSE9PS19ESVNBQkxFRBAEEhEKDUJBQ0tVUF9GQUlMRUQQBRIRCg1NRU1PX1JFQUNUSU9OEAYSEAoMTUVNT19NRU5USU9OEAcSCgoGRElHRVNUEAg6cOpBbQodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24SKXVzZXJzL3t1c2VyfS9ub3RpZmljYXRpb25zL3tub3RpZmljYXRpb259GgRuYW1lKg1ub3RpZmljYXRpb25zMgxub3RpZmljYXRpb25CDgoMX2FjdGl2aXR5X2lkIo8BChxMaXN0VXNlck5vdGlmaWNhdGlvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESEwoGZmlsdGVyGAQgASgJQgPgQQEibwodTGlzdFVzZXJOb3RpZmljYXRpb25zUmVzcG9uc2USNQoNbm90aWZpY2F0aW9ucxgBIAMoCzIeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKQAQodVXBkYXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QSOQoMbm90aWZpY2F0aW9uGAEgASgLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb25CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9Vc2VyTm90aWZpY2F0aW9uMtNCCgtVc2VyU2VydmljZRJjCglMaXN0VXNlcnMSHi5tZW1vcy5hcGkudjEuTGlzdFVzZXJzUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXNwb25zZSIVgtPkkwIPEg0vYXBpL3YxL3VzZXJzEmIKB0dldFVzZXISHC5tZW1vcy5hcGkudjEuR2V0VXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIl2kEEbmFtZYLT5JMCGBIWL2FwaS92MS97bmFtZT11c2Vycy8qfRJlCgpDcmVhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiItpBBHVzZXKC0+STAhU6BHVzZXIiDS9hcGkvdjEvdXNlcnMSfwoKVXBkYXRlVXNlchIfLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIjzaQRB1c2VyLHVwZGF0ZV9tYXNrgtPkkwIjOgR1c2VyMhsvYXBpL3YxL3t1c2VyLm5hbWU9dXNlcnMvKn0SbAoKRGVsZXRlVXNlchIfLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT11c2Vycy8qfRJ+ChBMaXN0QWxsVXNlclN0YXRzEiUubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL3VzZXJzOnN0YXRzEnoKDEdldFVzZXJTdGF0cxIhLm1lbW9zLmFwaS52MS5HZXRVc2VyU3RhdHNSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLlVzZXJTdGF0cyIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT11c2Vycy8qfTpnZXRTdGF0cxKWAQoTR2V0VXNlcldyaXRpbmdTdGF0cxIoLm1lbW9zLmFwaS52MS5HZXRVc2VyV3JpdGluZ1N0YXRzUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5Vc2VyV3JpdGluZ1N0YXRzIjXaQQRuYW1lgtPkkwIoEiYvYXBpL3YxL3tuYW1lPXVzZXJzLyp9OmdldFdyaXRpbmdTdGF0cxKCAQoOR2V0VXNlclNldHRpbmcSIy5tZW1vcy5hcGkudjEuR2V0VXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIjDaQQRuYW1lgtPkkwIjEiEvYXBpL3YxL3tuYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SqAEKEVVwZGF0ZVVzZXJTZXR0aW5nEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJTZXR0aW5nUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZyJQ2kETc2V0dGluZyx1cGRhdGVfbWFza4LT5JMCNDoHc2V0dGluZzIpL2FwaS92MS97c2V0dGluZy5uYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SlQEKEExpc3RVc2VyU2V0dGluZ3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9zZXR0aW5ncxKlAQoUTGlzdFVzZXJBY2Nlc3NUb2tlbnMSKS5tZW1vcy5hcGkudjEuTGlzdFVzZXJBY2Nlc3NUb2tlbnNSZXF1ZXN0GioubWVtb3MuYXBpLnYxLkxpc3RVc2VyQWNjZXNzVG9rZW5zUmVzcG9uc2UiNtpBBnBhcmVudILT5JMCJxIlL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L2FjY2Vzc1Rva2VucxK1AQoVQ3JlYXRlVXNlckFjY2Vzc1Rva2VuEioubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJBY2Nlc3NUb2tlblJlcXVlc3QaHS5tZW1vcy5hcGkudjEuVXNlckFjY2Vzc1Rva2VuIlHaQRNwYXJlbnQsYWNjZXNzX3Rva2VugtPkkwI1OgxhY2Nlc3NfdG9rZW4iJS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9hY2Nlc3NUb2tlbnMSkQEKFURlbGV0ZVVzZXJBY2Nlc3NUb2tlbhIqLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyQWNjZXNzVG9rZW5SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjTaQQRuYW1lgtPkkwInKiUvYXBpL3YxL3tuYW1lPXVzZXJzLyovYWNjZXNzVG9rZW5zLyp9EpUBChBMaXN0VXNlclNlc3Npb25zEiUubWVtb3MuYXBpLnYxLkxpc3RVc2VyU2Vzc2lvbnNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RVc2VyU2Vzc2lvbnNSZXNwb25zZSIy2kEGcGFyZW50gtPkkwIjEiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vc2Vzc2lvbnMShQEKEVJldm9rZVVzZXJTZXNzaW9uEiYubWVtb3MuYXBpLnYxLlJldm9rZVVzZXJTZXNzaW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3Nlc3Npb25zLyp9EpMBChdSZXZva2VPdGhlclVzZXJTZXNzaW9ucxIsLm1lbW9zLmFwaS52MS5SZXZva2VPdGhlclVzZXJTZXNzaW9uc1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMtpBBnBhcmVudILT5JMCIyohL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Nlc3Npb25zEocBChBHZXRVc2VyVHdvRmFjdG9yEiUubWVtb3MuYXBpLnYxLkdldFVzZXJUd29GYWN0b3JSZXF1ZXN0GhsubWVtb3MuYXBpLnYxLlVzZXJUd29GYWN0b3IiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9dXNlcnMvKi90d29GYWN0b3J9EqUBChNFbnJvbGxVc2VyVHdvRmFjdG9yEigubWVtb3MuYXBpLnYxLkVucm9sbFVzZXJUd29GYWN0b3JSZXF1ZXN0GikubWVtb3MuYXBpLnYxLkVucm9sbFVzZXJUd29GYWN0b3JSZXNwb25zZSI52kEEbmFtZYLT5JMCLDoBKiInL2FwaS92MS97bmFtZT11c2Vycy8qL3R3b0ZhY3Rvcn0vZW5yb2xsEq4BChRDb25maXJtVXNlclR3b0ZhY3RvchIpLm1lbW9zLmFwaS52MS5Db25maXJtVXNlclR3b0ZhY3RvclJlcXVlc3QaKi5tZW1vcy5hcGkudjEuQ29uZmlybVVzZXJUd29GYWN0b3JSZXNwb25zZSI/2kEJbmFtZSxjb2RlgtPkkwItOgEqIigvYXBpL3YxL3tuYW1lPXVzZXJzLyovdHdvRmFjdG9yfS9jb25maXJtEogBChNEZWxldGVVc2VyVHdvRmFjdG9yEigubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJUd29GYWN0b3JSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ii/aQQRuYW1lgtPkkwIiKiAvYXBpL3YxL3tuYW1lPXVzZXJzLyovdHdvRmFjdG9yfRKVAQoQTGlzdFVzZXJQYXNza2V5cxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlclBhc3NrZXlzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlclBhc3NrZXlzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Bhc3NrZXlzEqoBChhDcmVhdGVVc2VyUGFzc2tleU9wdGlvbnMSLS5tZW1vcy5hcGkudjEuQ3JlYXRlVXNlclBhc3NrZXlPcHRpb25zUmVxdWVzdBogLm1lbW9zLmFwaS52MS5Vc2VyUGFzc2tleU9wdGlvbnMiPdpBBnBhcmVudILT5JMCLjoBKiIpL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3Bhc3NrZXlzOm9wdGlvbnMSjQEKEUNyZWF0ZVVzZXJQYXNza2V5EiYubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJQYXNza2V5UmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyUGFzc2tleSI12kEGcGFyZW50gtPkkwImOgEqIiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGFzc2tleXMShQEKEURlbGV0ZVVzZXJQYXNza2V5EiYubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJQYXNza2V5UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3Bhc3NrZXlzLyp9ErkBChlMaXN0VXNlclB1c2hTdWJzY3JpcHRpb25zEi4ubWVtb3MuYXBpLnYxLkxpc3RVc2VyUHVzaFN1YnNjcmlwdGlvbnNSZXF1ZXN0Gi8ubWVtb3MuYXBpLnYxLkxpc3RVc2VyUHVzaFN1YnNjcmlwdGlvbnNSZXNwb25zZSI72kEGcGFyZW50gtPkkwIsEiovYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcHVzaFN1YnNjcmlwdGlvbnMSsQEKGkNyZWF0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uEi8ubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uUmVxdWVzdBoiLm1lbW9zLmFwaS52MS5Vc2VyUHVzaFN1YnNjcmlwdGlvbiI+2kEGcGFyZW50gtPkkwIvOgEqIiovYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcHVzaFN1YnNjcmlwdGlvbnMSoAEKGkRlbGV0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uEi8ubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJQdXNoU3Vic2NyaXB0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI52kEEbmFtZYLT5JMCLCoqL2FwaS92MS97bmFtZT11c2Vycy8qL3B1c2hTdWJzY3JpcHRpb25zLyp9EocBChBHZXRVc2VyRmVlZFRva2VuEiUubWVtb3MuYXBpLnYxLkdldFVzZXJGZWVkVG9rZW5SZXF1ZXN0GhsubWVtb3MuYXBpLnYxLlVzZXJGZWVkVG9rZW4iL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9dXNlcnMvKi9mZWVkVG9rZW59EpcBChNSb3RhdGVVc2VyRmVlZFRva2VuEigubWVtb3MuYXBpLnYxLlJvdGF0ZVVzZXJGZWVkVG9rZW5SZXF1ZXN0GhsubWVtb3MuYXBpLnYxLlVzZXJGZWVkVG9rZW4iOdpBBG5hbWWC0+STAiw6ASoiJy9hcGkvdjEve25hbWU9dXNlcnMvKi9mZWVkVG9rZW59OnJvdGF0ZRKIAQoTRGVsZXRlVXNlckZlZWRUb2tlbhIoLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyRmVlZFRva2VuUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIv2kEEbmFtZYLT5JMCIiogL2FwaS92MS97bmFtZT11c2Vycy8qL2ZlZWRUb2tlbn0SkwEKE0dldFVzZXJJbmJvdW5kRW1haWwSKC5tZW1vcy5hcGkudjEuR2V0VXNlckluYm91bmRFbWFpbFJlcXVlc3QaHi5tZW1vcy5hcGkudjEuVXNlckluYm91bmRFbWFpbCIy2kEEbmFtZYLT5JMCJRIjL2FwaS92MS97bmFtZT11c2Vycy8qL2luYm91bmRFbWFpbH0SowEKFlJvdGF0ZVVzZXJJbmJvdW5kRW1haWwSKy5tZW1vcy5hcGkudjEuUm90YXRlVXNlckluYm91bmRFbWFpbFJlcXVlc3QaHi5tZW1vcy5hcGkudjEuVXNlckluYm91bmRFbWFpbCI82kEEbmFtZYLT5JMCLzoBKiIqL2FwaS92MS97bmFtZT11c2Vycy8qL2luYm91bmRFbWFpbH06cm90YXRlEpEBChZEZWxldGVVc2VySW5ib3VuZEVtYWlsEisubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJJbmJvdW5kRW1haWxSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPXVzZXJzLyovaW5ib3VuZEVtYWlsfRKHAQoQR2V0VXNlclNsYWNrTGluaxIlLm1lbW9zLmFwaS52MS5HZXRVc2VyU2xhY2tMaW5rUmVxdWVzdBobLm1lbW9zLmFwaS52MS5Vc2VyU2xhY2tMaW5rIi/aQQRuYW1lgtPkkwIiEiAvYXBpL3YxL3tuYW1lPXVzZXJzLyovc2xhY2tMaW5rfRKpAQoZR2VuZXJhdGVVc2VyU2xhY2tMaW5rQ29kZRIuLm1lbW9zLmFwaS52MS5HZW5lcmF0ZVVzZXJTbGFja0xpbmtDb2RlUmVxdWVzdBobLm1lbW9zLmFwaS52MS5Vc2VyU2xhY2tMaW5rIj/aQQRuYW1lgtPkkwIyOgEqIi0vYXBpL3YxL3tuYW1lPXVzZXJzLyovc2xhY2tMaW5rfTpnZW5lcmF0ZUNvZGUSiAEKE0RlbGV0ZVVzZXJTbGFja0xpbmsSKC5tZW1vcy5hcGkudjEuRGVsZXRlVXNlclNsYWNrTGlua1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiL9pBBG5hbWWC0+STAiIqIC9hcGkvdjEve25hbWU9dXNlcnMvKi9zbGFja0xpbmt9EosBChFHZXRVc2VyTWF0cml4TGluaxImLm1lbW9zLmFwaS52MS5HZXRVc2VyTWF0cml4TGlua1JlcXVlc3QaHC5tZW1vcy5hcGkudjEuVXNlck1hdHJpeExpbmsiMNpBBG5hbWWC0+STAiMSIS9hcGkvdjEve25hbWU9dXNlcnMvKi9tYXRyaXhMaW5rfRKtAQoaR2VuZXJhdGVVc2VyTWF0cml4TGlua0NvZGUSLy5tZW1vcy5hcGkudjEuR2VuZXJhdGVVc2VyTWF0cml4TGlua0NvZGVSZXF1ZXN0GhwubWVtb3MuYXBpLnYxLlVzZXJNYXRyaXhMaW5rIkDaQQRuYW1lgtPkkwIzOgEqIi4vYXBpL3YxL3tuYW1lPXVzZXJzLyovbWF0cml4TGlua306Z2VuZXJhdGVDb2RlEosBChREZWxldGVVc2VyTWF0cml4TGluaxIpLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyTWF0cml4TGlua1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiMNpBBG5hbWWC0+STAiMqIS9hcGkvdjEve25hbWU9dXNlcnMvKi9tYXRyaXhMaW5rfRJ2CgpVbmxvY2tVc2VyEh8ubWVtb3MuYXBpLnYxLlVubG9ja1VzZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ii/aQQRuYW1lgtPkkwIiOgEqIh0vYXBpL3YxL3tuYW1lPXVzZXJzLyp9OnVubG9jaxJ1CgtTdXNwZW5kVXNlchIgLm1lbW9zLmFwaS52MS5TdXNwZW5kVXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIw2kEEbmFtZYLT5JMCIzoBKiIeL2FwaS92MS97bmFtZT11c2Vycy8qfTpzdXNwZW5kEnsKDVVuc3VzcGVuZFVzZXISIi5tZW1vcy5hcGkudjEuVW5zdXNwZW5kVXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIy2kEEbmFtZYLT5JMCJToBKiIgL2FwaS92MS97bmFtZT11c2Vycy8qfTp1bnN1c3BlbmQSuwEKEExpc3RVc2VyV2ViaG9va3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rc1Jlc3BvbnNlIljaQQZwYXJlbnSC0+STAklaJBIiL2FwaS92MS97cGFyZW50PWluc3RhbmNlfS93ZWJob29rcxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEsoBChFDcmVhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2sictpBDnBhcmVudCx3ZWJob29rgtPkkwJbOgd3ZWJob29rWi06B3dlYmhvb2siIi9hcGkvdjEve3BhcmVudD1pbnN0YW5jZX0vd2ViaG9va3MiIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS93ZWJob29rcxLgAQoRVXBkYXRlVXNlcldlYmhvb2sSJi5tZW1vcy5hcGkudjEuVXBkYXRlVXNlcldlYmhvb2tSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rIocB2kETd2ViaG9vayx1cGRhdGVfbWFza4LT5JMCazoHd2ViaG9va1o1Ogd3ZWJob29rMiovYXBpL3YxL3t3ZWJob29rLm5hbWU9aW5zdGFuY2Uvd2ViaG9va3MvKn0yKS9hcGkvdjEve3dlYmhvb2submFtZT11c2Vycy8qL3dlYmhvb2tzLyp9EqsBChFEZWxldGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyV2ViaG9va1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiVtpBBG5hbWWC0+STAklaJCoiL2FwaS92MS97bmFtZT1pbnN0YW5jZS93ZWJob29rcy8qfSohL2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyp9EtoBChdSb3RhdGVVc2VyV2ViaG9va1NlY3JldBIsLm1lbW9zLmFwaS52MS5Sb3RhdGVVc2VyV2ViaG9va1NlY3JldFJlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2sidtpBBG5hbWWC0+STAmk6ASpaNDoBKiIvL2FwaS92MS97bmFtZT1pbnN0YW5jZS93ZWJob29rcy8qfTpyb3RhdGVTZWNyZXQiLi9hcGkvdjEve25hbWU9dXNlcnMvKi93ZWJob29rcy8qfTpyb3RhdGVTZWNyZXQSxgEKD1Rlc3RVc2VyV2ViaG9vaxIkLm1lbW9zLmFwaS52MS5UZXN0VXNlcldlYmhvb2tSZXF1ZXN0GiUubWVtb3MuYXBpLnYxLlRlc3RVc2VyV2ViaG9va1Jlc3BvbnNlImbaQQRuYW1lgtPkkwJZOgEqWiw6ASoiJy9hcGkvdjEve25hbWU9aW5zdGFuY2Uvd2ViaG9va3MvKn06dGVzdCImL2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyp9OnRlc3QS8AEKGUxpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXMSLi5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rRGVsaXZlcmllc1JlcXVlc3QaLy5tZW1vcy5hcGkudjEuTGlzdFVzZXJXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlInLaQQZwYXJlbnSC0+STAmNaMRIvL2FwaS92MS97cGFyZW50PWluc3RhbmNlL3dlYmhvb2tzLyp9L2RlbGl2ZXJpZXMSLi9hcGkvdjEve3BhcmVudD11c2Vycy8qL3dlYmhvb2tzLyp9L2RlbGl2ZXJpZXMSgQIKHFJlZGVsaXZlclVzZXJXZWJob29rRGVsaXZlcnkSMS5tZW1vcy5hcGkudjEuUmVkZWxpdmVyVXNlcldlYmhvb2tEZWxpdmVyeVJlcXVlc3QaIS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tEZWxpdmVyeSKKAdpBBG5hbWWC0+STAn06ASpaPjoBKiI5L2FwaS92MS97bmFtZT1pbnN0YW5jZS93ZWJob29rcy8qL2RlbGl2ZXJpZXMvKn06cmVkZWxpdmVyIjgvYXBpL3YxL3tuYW1lPXVzZXJzLyovd2ViaG9va3MvKi9kZWxpdmVyaWVzLyp9OnJlZGVsaXZlchKpAQoVTGlzdFVzZXJOb3RpZmljYXRpb25zEioubWVtb3MuYXBpLnYxLkxpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdFVzZXJOb3RpZmljYXRpb25zUmVzcG9uc2UiN9pBBnBhcmVudILT5JMCKBImL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L25vdGlmaWNhdGlvbnMSywEKFlVwZGF0ZVVzZXJOb3RpZmljYXRpb24SKy5tZW1vcy5hcGkudjEuVXBkYXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QaHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbiJk2kEYbm90aWZpY2F0aW9uLHVwZGF0ZV9tYXNrgtPkkwJDOgxub3RpZmljYXRpb24yMy9hcGkvdjEve25vdGlmaWNhdGlvbi5uYW1lPXVzZXJzLyovbm90aWZpY2F0aW9ucy8qfRKUAQoWRGVsZXRlVXNlck5vdGlmaWNhdGlvbhIrLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSI12kEEbmFtZYLT5JMCKComL2FwaS92MS97bmFtZT11c2Vycy8qL25vdGlmaWNhdGlvbnMvKn1CqAEKEGNvbS5tZW1vcy5hcGkudjFCEFVzZXJTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.User
@@ -101,6 +101,13 @@ export type User = Message<"memos.api.v1.User"> & {
    * @generated from field: google.protobuf.Timestamp update_time = 11;
    */
   updateTime?: Timestamp;
+
+  /**
+   * Output only. The time the user was suspended by the host, unset if the user isn't suspended.
+   *
+   * @generated from field: google.protobuf.Timestamp suspend_time = 12;
+   */
+  suspendTime?: Timestamp;
 };
 
 /**
@@ -477,6 +484,131 @@ export type GetUserStatsRequest = Message<"memos.api.v1.GetUserStatsRequest"> &
 export const GetUserStatsRequestSchema: GenMessage<GetUserStatsRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 8);
 
+/**
+ * The writing statistics of a user, computed from the memos that aren't comments, scheduled or in
+ * the trash. Days and months are in the time zone of the user. The statistics are cached for a few
+ * minutes, so they may not include the latest edits.
+ *
+ * @generated from message memos.api.v1.UserWritingStats
+ */
+export type UserWritingStats = Message<"memos.api.v1.UserWritingStats"> & {
+  /**
+   * The resource name of the user whose stats these are.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The number of memos.
+   *
+   * @generated from field: int32 total_memo_count = 2;
+   */
+  totalMemoCount: number;
+
+  /**
+   * The number of words of the memos. The words are separated by whitespace, and each CJK
+   * character counts as a word.
+   *
+   * @generated from field: int64 total_word_count = 3;
+   */
+  totalWordCount: bigint;
+
+  /**
+   * The average number of characters of the memos.
+   *
+   * @generated from field: double average_memo_length = 4;
+   */
+  averageMemoLength: number;
+
+  /**
+   * The number of memos by tag.
+   *
+   * @generated from field: map<string, int32> tag_memo_counts = 5;
+   */
+  tagMemoCounts: { [key: string]: number };
+
+  /**
+   * The number of consecutive days with memos, up to today or yesterday.
+   *
+   * @generated from field: int32 current_streak_days = 6;
+   */
+  currentStreakDays: number;
+
+  /**
+   * The largest number of consecutive days with memos.
+   *
+   * @generated from field: int32 longest_streak_days = 7;
+   */
+  longestStreakDays: number;
+
+  /**
+   * The number of memos by month, ordered by month.
+   *
+   * @generated from field: repeated memos.api.v1.UserWritingStats.MonthCount month_counts = 8;
+   */
+  monthCounts: UserWritingStats_MonthCount[];
+};
+
+/**
+ * Describes the message memos.api.v1.UserWritingStats.
+ * Use `create(UserWritingStatsSchema)` to create a new message.
+ */
+export const UserWritingStatsSchema: GenMessage<UserWritingStats> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 9);
+
+/**
+ * @generated from message memos.api.v1.UserWritingStats.MonthCount
+ */
+export type UserWritingStats_MonthCount = Message<"memos.api.v1.UserWritingStats.MonthCount"> & {
+  /**
+   * The month, in the format YYYY-MM.
+   *
+   * @generated from field: string month = 1;
+   */
+  month: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message memos.api.v1.UserWritingStats.MonthCount.
+ * Use `create(UserWritingStats_MonthCountSchema)` to create a new message.
+ */
+export const UserWritingStats_MonthCountSchema: GenMessage<UserWritingStats_MonthCount> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 9, 0);
+
+/**
+ * @generated from message memos.api.v1.GetUserWritingStatsRequest
+ */
+export type GetUserWritingStatsRequest = Message<"memos.api.v1.GetUserWritingStatsRequest"> & {
+  /**
+   * Required. The resource name of the user.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. Whether the archived memos are counted.
+   *
+   * @generated from field: bool include_archived = 2;
+   */
+  includeArchived: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserWritingStatsRequest.
+ * Use `create(GetUserWritingStatsRequestSchema)` to create a new message.
+ */
+export const GetUserWritingStatsRequestSchema: GenMessage<GetUserWritingStatsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 10);
+
 /**
  * This endpoint doesn't take any parameters.
  *
@@ -490,7 +622,7 @@ export type ListAllUserStatsRequest = Message<"memos.api.v1.ListAllUserStatsRequ
  * Use `create(ListAllUserStatsRequestSchema)` to create a new message.
  */
 export const ListAllUserStatsRequestSchema: GenMessage<ListAllUserStatsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 9);
+  messageDesc(file_api_v1_user_service, 11);
 
 /**
  * @generated from message memos.api.v1.ListAllUserStatsResponse
@@ -509,7 +641,7 @@ export type ListAllUserStatsResponse = Message<"memos.api.v1.ListAllUserStatsRes
  * Use `create(ListAllUserStatsResponseSchema)` to create a new message.
  */
 export const ListAllUserStatsResponseSchema: GenMessage<ListAllUserStatsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 10);
+  messageDesc(file_api_v1_user_service, 12);
 
 /**
  * User settings message
@@ -553,6 +685,30 @@ export type UserSetting = Message<"memos.api.v1.UserSetting"> & {
      */
     value: UserSetting_WebhooksSetting;
     case: "webhooksSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.AutoArchiveSetting auto_archive_setting = 6;
+     */
+    value: UserSetting_AutoArchiveSetting;
+    case: "autoArchiveSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.StorageSetting storage_setting = 7;
+     */
+    value: UserSetting_StorageSetting;
+    case: "storageSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.MemoQuotaSetting memo_quota_setting = 8;
+     */
+    value: UserSetting_MemoQuotaSetting;
+    case: "memoQuotaSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.NotificationSetting notification_setting = 9;
+     */
+    value: UserSetting_NotificationSetting;
+    case: "notificationSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -561,7 +717,7 @@ export type UserSetting = Message<"memos.api.v1.UserSetting"> & {
  * Use `create(UserSettingSchema)` to create a new message.
  */
 export const UserSettingSchema: GenMessage<UserSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11);
+  messageDesc(file_api_v1_user_service, 13);
 
 /**
  * General user settings configuration.
@@ -591,6 +747,22 @@ export type UserSetting_GeneralSetting = Message<"memos.api.v1.UserSetting.Gener
    * @generated from field: string theme = 4;
    */
   theme: string;
+
+  /**
+   * The IANA time zone of the user, e.g. "Europe/Paris", used to expand the placeholders
+   * of memo templates. If not set, UTC is used.
+   *
+   * @generated from field: string timezone = 5;
+   */
+  timezone: string;
+
+  /**
+   * Whether the EXIF and XMP metadata of the images uploaded by the user is stripped.
+   * If not set, the instance storage setting is used.
+   *
+   * @generated from field: optional bool strip_image_metadata = 6;
+   */
+  stripImageMetadata?: boolean;
 };
 
 /**
@@ -598,7 +770,7 @@ export type UserSetting_GeneralSetting = Message<"memos.api.v1.UserSetting.Gener
  * Use `create(UserSetting_GeneralSettingSchema)` to create a new message.
  */
 export const UserSetting_GeneralSettingSchema: GenMessage<UserSetting_GeneralSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11, 0);
+  messageDesc(file_api_v1_user_service, 13, 0);
 
 /**
  * User authentication sessions configuration.
@@ -619,7 +791,7 @@ export type UserSetting_SessionsSetting = Message<"memos.api.v1.UserSetting.Sess
  * Use `create(UserSetting_SessionsSettingSchema)` to create a new message.
  */
 export const UserSetting_SessionsSettingSchema: GenMessage<UserSetting_SessionsSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11, 1);
+  messageDesc(file_api_v1_user_service, 13, 1);
 
 /**
  * User access tokens configuration.
@@ -640,7 +812,7 @@ export type UserSetting_AccessTokensSetting = Message<"memos.api.v1.UserSetting.
  * Use `create(UserSetting_AccessTokensSettingSchema)` to create a new message.
  */
 export const UserSetting_AccessTokensSettingSchema: GenMessage<UserSetting_AccessTokensSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11, 2);
+  messageDesc(file_api_v1_user_service, 13, 2);
 
 /**
  * User webhooks configuration.
@@ -661,233 +833,540 @@ export type UserSetting_WebhooksSetting = Message<"memos.api.v1.UserSetting.Webh
  * Use `create(UserSetting_WebhooksSettingSchema)` to create a new message.
  */
 export const UserSetting_WebhooksSettingSchema: GenMessage<UserSetting_WebhooksSetting> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11, 3);
+  messageDesc(file_api_v1_user_service, 13, 3);
 
 /**
- * Enumeration of user setting keys.
+ * Auto-archive policy configuration. A daily job archives the memos of the user not edited
+ * in the given number of days, except pinned memos, memos with a reminder and memos with a
+ * protected tag.
  *
- * @generated from enum memos.api.v1.UserSetting.Key
+ * @generated from message memos.api.v1.UserSetting.AutoArchiveSetting
  */
-export enum UserSetting_Key {
+export type UserSetting_AutoArchiveSetting = Message<"memos.api.v1.UserSetting.AutoArchiveSetting"> & {
   /**
-   * @generated from enum value: KEY_UNSPECIFIED = 0;
+   * The number of days without edits after which memos are archived.
+   * 0 disables the policy, which is the default.
+   *
+   * @generated from field: int32 days = 1;
    */
-  KEY_UNSPECIFIED = 0,
+  days: number;
 
   /**
-   * GENERAL is the key for general user settings.
+   * The tags, without the # prefix, of the memos that are never archived. Their subtags
+   * are protected too.
    *
-   * @generated from enum value: GENERAL = 1;
+   * @generated from field: repeated string protected_tags = 2;
    */
-  GENERAL = 1,
+  protectedTags: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.UserSetting.AutoArchiveSetting.
+ * Use `create(UserSetting_AutoArchiveSettingSchema)` to create a new message.
+ */
+export const UserSetting_AutoArchiveSettingSchema: GenMessage<UserSetting_AutoArchiveSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 13, 4);
 
+/**
+ * Notification preferences of the user. The notifications of the enabled types are also sent to
+ * the email of the user, when email is configured, batched in digests of the notifications
+ * received within a few minutes. Unread notifications only are sent.
+ *
+ * @generated from message memos.api.v1.UserSetting.NotificationSetting
+ */
+export type UserSetting_NotificationSetting = Message<"memos.api.v1.UserSetting.NotificationSetting"> & {
   /**
-   * SESSIONS is the key for user authentication sessions.
+   * Whether the comments on the memos of the user are sent by email.
    *
-   * @generated from enum value: SESSIONS = 2;
+   * @generated from field: bool email_memo_comment = 1;
    */
-  SESSIONS = 2,
+  emailMemoComment: boolean;
 
   /**
-   * ACCESS_TOKENS is the key for access tokens.
+   * Whether the reactions to the memos of the user are sent by email.
    *
-   * @generated from enum value: ACCESS_TOKENS = 3;
+   * @generated from field: bool email_memo_reaction = 2;
    */
-  ACCESS_TOKENS = 3,
+  emailMemoReaction: boolean;
 
   /**
-   * WEBHOOKS is the key for user webhooks.
+   * Whether the memos mentioning the user are sent by email.
    *
-   * @generated from enum value: WEBHOOKS = 4;
+   * @generated from field: bool email_memo_mention = 3;
    */
-  WEBHOOKS = 4,
-}
+  emailMemoMention: boolean;
 
-/**
- * Describes the enum memos.api.v1.UserSetting.Key.
- */
-export const UserSetting_KeySchema: GenEnum<UserSetting_Key> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 11, 0);
+  /**
+   * Whether the due reminders of the memos of the user are sent by email.
+   *
+   * @generated from field: bool email_memo_reminder = 4;
+   */
+  emailMemoReminder: boolean;
 
-/**
- * @generated from message memos.api.v1.GetUserSettingRequest
- */
-export type GetUserSettingRequest = Message<"memos.api.v1.GetUserSettingRequest"> & {
   /**
-   * Required. The resource name of the user setting.
-   * Format: users/{user}/settings/{setting}
+   * The time of day the quiet hours of the push notifications start, as "HH:MM" in the time
+   * zone of the user. No notification is pushed during the quiet hours, which are off unless
+   * both their start and end are set.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string quiet_hours_start = 5;
    */
-  name: string;
-};
+  quietHoursStart: string;
 
-/**
- * Describes the message memos.api.v1.GetUserSettingRequest.
- * Use `create(GetUserSettingRequestSchema)` to create a new message.
- */
-export const GetUserSettingRequestSchema: GenMessage<GetUserSettingRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 12);
+  /**
+   * The time of day the quiet hours of the push notifications end, as "HH:MM".
+   *
+   * @generated from field: string quiet_hours_end = 6;
+   */
+  quietHoursEnd: string;
 
-/**
- * @generated from message memos.api.v1.UpdateUserSettingRequest
- */
-export type UpdateUserSettingRequest = Message<"memos.api.v1.UpdateUserSettingRequest"> & {
   /**
-   * Required. The user setting to update.
+   * How often a digest summarizing the activity of the user is delivered to the inbox: the
+   * memos created by the user, the comments and reactions received and the reminders due. No
+   * digest is delivered for a period without any.
    *
-   * @generated from field: memos.api.v1.UserSetting setting = 1;
+   * @generated from field: memos.api.v1.UserSetting.NotificationSetting.DigestFrequency digest_frequency = 7;
    */
-  setting?: UserSetting;
+  digestFrequency: UserSetting_NotificationSetting_DigestFrequency;
 
   /**
-   * Required. The list of fields to update.
+   * The time of day the digest is delivered, as "HH:MM" in the time zone of the user.
+   * Defaults to "08:00".
    *
-   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   * @generated from field: string digest_time = 8;
    */
-  updateMask?: FieldMask;
+  digestTime: string;
+
+  /**
+   * The day of the week a weekly digest is delivered, from 0 for Sunday to 6 for Saturday.
+   *
+   * @generated from field: int32 digest_weekday = 9;
+   */
+  digestWeekday: number;
+
+  /**
+   * Whether the digest is also sent to the email of the user, when email is configured.
+   *
+   * @generated from field: bool email_digest = 10;
+   */
+  emailDigest: boolean;
 };
 
 /**
- * Describes the message memos.api.v1.UpdateUserSettingRequest.
- * Use `create(UpdateUserSettingRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.UserSetting.NotificationSetting.
+ * Use `create(UserSetting_NotificationSettingSchema)` to create a new message.
  */
-export const UpdateUserSettingRequestSchema: GenMessage<UpdateUserSettingRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 13);
+export const UserSetting_NotificationSettingSchema: GenMessage<UserSetting_NotificationSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 13, 5);
 
 /**
- * Request message for ListUserSettings method.
- *
- * @generated from message memos.api.v1.ListUserSettingsRequest
+ * @generated from enum memos.api.v1.UserSetting.NotificationSetting.DigestFrequency
  */
-export type ListUserSettingsRequest = Message<"memos.api.v1.ListUserSettingsRequest"> & {
+export enum UserSetting_NotificationSetting_DigestFrequency {
   /**
-   * Required. The parent resource whose settings will be listed.
-   * Format: users/{user}
+   * No digest is delivered.
    *
-   * @generated from field: string parent = 1;
+   * @generated from enum value: DIGEST_FREQUENCY_UNSPECIFIED = 0;
    */
-  parent: string;
+  DIGEST_FREQUENCY_UNSPECIFIED = 0,
 
   /**
-   * Optional. The maximum number of settings to return.
-   * The service may return fewer than this value.
-   * If unspecified, at most 50 settings will be returned.
-   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   * A digest of the previous day is delivered every day.
    *
-   * @generated from field: int32 page_size = 2;
+   * @generated from enum value: DAILY = 1;
    */
-  pageSize: number;
+  DAILY = 1,
 
   /**
-   * Optional. A page token, received from a previous `ListUserSettings` call.
-   * Provide this to retrieve the subsequent page.
+   * A digest of the previous week is delivered every week.
    *
-   * @generated from field: string page_token = 3;
+   * @generated from enum value: WEEKLY = 2;
    */
-  pageToken: string;
-};
+  WEEKLY = 2,
+}
 
 /**
- * Describes the message memos.api.v1.ListUserSettingsRequest.
- * Use `create(ListUserSettingsRequestSchema)` to create a new message.
+ * Describes the enum memos.api.v1.UserSetting.NotificationSetting.DigestFrequency.
  */
-export const ListUserSettingsRequestSchema: GenMessage<ListUserSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 14);
+export const UserSetting_NotificationSetting_DigestFrequencySchema: GenEnum<UserSetting_NotificationSetting_DigestFrequency> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 13, 5, 0);
 
 /**
- * Response message for ListUserSettings method.
+ * Storage usage and quota of the user. Uploads that would exceed the quota are rejected.
  *
- * @generated from message memos.api.v1.ListUserSettingsResponse
+ * @generated from message memos.api.v1.UserSetting.StorageSetting
  */
-export type ListUserSettingsResponse = Message<"memos.api.v1.ListUserSettingsResponse"> & {
+export type UserSetting_StorageSetting = Message<"memos.api.v1.UserSetting.StorageSetting"> & {
   /**
-   * The list of user settings.
+   * The number of bytes used by the attachments of the user. A file shared by several
+   * attachments of the user is counted once.
    *
-   * @generated from field: repeated memos.api.v1.UserSetting settings = 1;
+   * @generated from field: int64 used_bytes = 1;
    */
-  settings: UserSetting[];
+  usedBytes: bigint;
 
   /**
-   * A token that can be sent as `page_token` to retrieve the next page.
-   * If this field is omitted, there are no subsequent pages.
+   * The storage quota of the user in bytes, 0 means unlimited.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: int64 quota_bytes = 2;
    */
-  nextPageToken: string;
+  quotaBytes: bigint;
 
   /**
-   * The total count of settings (may be approximate).
+   * The storage quota set on the user in MiB, 0 means unlimited. If not set, the default
+   * quota of the instance storage setting is used. Only the host can update it.
    *
-   * @generated from field: int32 total_size = 3;
+   * @generated from field: optional int64 quota_mb = 3;
    */
-  totalSize: number;
+  quotaMb?: bigint;
 };
 
 /**
- * Describes the message memos.api.v1.ListUserSettingsResponse.
- * Use `create(ListUserSettingsResponseSchema)` to create a new message.
+ * Describes the message memos.api.v1.UserSetting.StorageSetting.
+ * Use `create(UserSetting_StorageSettingSchema)` to create a new message.
  */
-export const ListUserSettingsResponseSchema: GenMessage<ListUserSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 15);
+export const UserSetting_StorageSettingSchema: GenMessage<UserSetting_StorageSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 13, 6);
 
 /**
- * User access token message
+ * Memo counts and quotas of the user. Memo creations that would exceed a quota are
+ * rejected. The days are counted in the time zone of the user.
  *
- * @generated from message memos.api.v1.UserAccessToken
+ * @generated from message memos.api.v1.UserSetting.MemoQuotaSetting
  */
-export type UserAccessToken = Message<"memos.api.v1.UserAccessToken"> & {
+export type UserSetting_MemoQuotaSetting = Message<"memos.api.v1.UserSetting.MemoQuotaSetting"> & {
   /**
-   * The resource name of the access token.
-   * Format: users/{user}/accessTokens/{access_token}
+   * The number of memos of the user, not counting the trash.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: int32 memo_count = 1;
    */
-  name: string;
+  memoCount: number;
 
   /**
-   * Output only. The access token value.
+   * The number of memos created by the user today, whatever their creation time was set to
+   * afterwards.
    *
-   * @generated from field: string access_token = 2;
+   * @generated from field: int32 today_memo_count = 2;
    */
-  accessToken: string;
+  todayMemoCount: number;
 
   /**
-   * The description of the access token.
+   * The number of memos the user created as public or made public today.
    *
-   * @generated from field: string description = 3;
+   * @generated from field: int32 today_public_memo_count = 3;
    */
-  description: string;
+  todayPublicMemoCount: number;
 
   /**
-   * Output only. The issued timestamp.
+   * The number of memos the user may have, 0 means unlimited. If not set, the limit of the
+   * instance memo related setting is used. Only the host can update it.
    *
-   * @generated from field: google.protobuf.Timestamp issued_at = 4;
+   * @generated from field: optional int32 max_memos = 4;
    */
-  issuedAt?: Timestamp;
+  maxMemos?: number;
 
   /**
-   * Optional. The expiration timestamp.
+   * The number of memos the user may create per day, 0 means unlimited. If not set, the
+   * limit of the instance memo related setting is used. Only the host can update it.
    *
-   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   * @generated from field: optional int32 max_memos_per_day = 5;
    */
-  expiresAt?: Timestamp;
+  maxMemosPerDay?: number;
+
+  /**
+   * The number of memos the user may create as public or make public per day, 0 means
+   * unlimited. Public memos imported by a user with this limit become private. If set, it
+   * applies whatever the age of the account, else the new account limit of the instance
+   * memo related setting applies. Only the host can update it.
+   *
+   * @generated from field: optional int32 max_public_memos_per_day = 6;
+   */
+  maxPublicMemosPerDay?: number;
 };
 
 /**
- * Describes the message memos.api.v1.UserAccessToken.
- * Use `create(UserAccessTokenSchema)` to create a new message.
+ * Describes the message memos.api.v1.UserSetting.MemoQuotaSetting.
+ * Use `create(UserSetting_MemoQuotaSettingSchema)` to create a new message.
  */
-export const UserAccessTokenSchema: GenMessage<UserAccessToken> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 16);
+export const UserSetting_MemoQuotaSettingSchema: GenMessage<UserSetting_MemoQuotaSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 13, 7);
 
 /**
- * @generated from message memos.api.v1.ListUserAccessTokensRequest
+ * Enumeration of user setting keys.
+ *
+ * @generated from enum memos.api.v1.UserSetting.Key
  */
-export type ListUserAccessTokensRequest = Message<"memos.api.v1.ListUserAccessTokensRequest"> & {
+export enum UserSetting_Key {
   /**
-   * Required. The parent resource whose access tokens will be listed.
-   * Format: users/{user}
+   * @generated from enum value: KEY_UNSPECIFIED = 0;
+   */
+  KEY_UNSPECIFIED = 0,
+
+  /**
+   * GENERAL is the key for general user settings.
+   *
+   * @generated from enum value: GENERAL = 1;
+   */
+  GENERAL = 1,
+
+  /**
+   * SESSIONS is the key for user authentication sessions.
+   *
+   * @generated from enum value: SESSIONS = 2;
+   */
+  SESSIONS = 2,
+
+  /**
+   * ACCESS_TOKENS is the key for access tokens.
+   *
+   * @generated from enum value: ACCESS_TOKENS = 3;
+   */
+  ACCESS_TOKENS = 3,
+
+  /**
+   * WEBHOOKS is the key for user webhooks.
+   *
+   * @generated from enum value: WEBHOOKS = 4;
+   */
+  WEBHOOKS = 4,
+
+  /**
+   * AUTO_ARCHIVE is the key for the auto-archive policy of the user.
+   *
+   * @generated from enum value: AUTO_ARCHIVE = 5;
+   */
+  AUTO_ARCHIVE = 5,
+
+  /**
+   * STORAGE is the key for the storage usage and quota of the user.
+   *
+   * @generated from enum value: STORAGE = 6;
+   */
+  STORAGE = 6,
+
+  /**
+   * MEMO_QUOTA is the key for the memo counts and quotas of the user.
+   *
+   * @generated from enum value: MEMO_QUOTA = 7;
+   */
+  MEMO_QUOTA = 7,
+
+  /**
+   * NOTIFICATION is the key for the notification preferences of the user.
+   *
+   * @generated from enum value: NOTIFICATION = 8;
+   */
+  NOTIFICATION = 8,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserSetting.Key.
+ */
+export const UserSetting_KeySchema: GenEnum<UserSetting_Key> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 13, 0);
+
+/**
+ * @generated from message memos.api.v1.GetUserSettingRequest
+ */
+export type GetUserSettingRequest = Message<"memos.api.v1.GetUserSettingRequest"> & {
+  /**
+   * Required. The resource name of the user setting.
+   * Format: users/{user}/settings/{setting}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserSettingRequest.
+ * Use `create(GetUserSettingRequestSchema)` to create a new message.
+ */
+export const GetUserSettingRequestSchema: GenMessage<GetUserSettingRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 14);
+
+/**
+ * @generated from message memos.api.v1.UpdateUserSettingRequest
+ */
+export type UpdateUserSettingRequest = Message<"memos.api.v1.UpdateUserSettingRequest"> & {
+  /**
+   * Required. The user setting to update.
+   *
+   * @generated from field: memos.api.v1.UserSetting setting = 1;
+   */
+  setting?: UserSetting;
+
+  /**
+   * Required. The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateUserSettingRequest.
+ * Use `create(UpdateUserSettingRequestSchema)` to create a new message.
+ */
+export const UpdateUserSettingRequestSchema: GenMessage<UpdateUserSettingRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 15);
+
+/**
+ * Request message for ListUserSettings method.
+ *
+ * @generated from message memos.api.v1.ListUserSettingsRequest
+ */
+export type ListUserSettingsRequest = Message<"memos.api.v1.ListUserSettingsRequest"> & {
+  /**
+   * Required. The parent resource whose settings will be listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of settings to return.
+   * The service may return fewer than this value.
+   * If unspecified, at most 50 settings will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListUserSettings` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserSettingsRequest.
+ * Use `create(ListUserSettingsRequestSchema)` to create a new message.
+ */
+export const ListUserSettingsRequestSchema: GenMessage<ListUserSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 16);
+
+/**
+ * Response message for ListUserSettings method.
+ *
+ * @generated from message memos.api.v1.ListUserSettingsResponse
+ */
+export type ListUserSettingsResponse = Message<"memos.api.v1.ListUserSettingsResponse"> & {
+  /**
+   * The list of user settings.
+   *
+   * @generated from field: repeated memos.api.v1.UserSetting settings = 1;
+   */
+  settings: UserSetting[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * The total count of settings (may be approximate).
+   *
+   * @generated from field: int32 total_size = 3;
+   */
+  totalSize: number;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserSettingsResponse.
+ * Use `create(ListUserSettingsResponseSchema)` to create a new message.
+ */
+export const ListUserSettingsResponseSchema: GenMessage<ListUserSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 17);
+
+/**
+ * User access token message
+ *
+ * @generated from message memos.api.v1.UserAccessToken
+ */
+export type UserAccessToken = Message<"memos.api.v1.UserAccessToken"> & {
+  /**
+   * The resource name of the access token.
+   * Format: users/{user}/accessTokens/{access_token}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Output only. The access token value.
+   *
+   * @generated from field: string access_token = 2;
+   */
+  accessToken: string;
+
+  /**
+   * The description of the access token.
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * Output only. The issued timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp issued_at = 4;
+   */
+  issuedAt?: Timestamp;
+
+  /**
+   * Optional. The expiration timestamp.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * Optional. The scopes granted to the access token: "read", "write" and "admin".
+   * Empty means full access.
+   *
+   * @generated from field: repeated string scopes = 6;
+   */
+  scopes: string[];
+
+  /**
+   * Output only. The timestamp when the access token was last used.
+   * Updated at most once per hour; unset if never used.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_time = 7;
+   */
+  lastUsedTime?: Timestamp;
+
+  /**
+   * Output only. A truncated form of the access token, e.g. "eyJhbGci...x1YzQ2Nw",
+   * for matching entries to tokens in use.
+   *
+   * @generated from field: string token_prefix = 8;
+   */
+  tokenPrefix: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserAccessToken.
+ * Use `create(UserAccessTokenSchema)` to create a new message.
+ */
+export const UserAccessTokenSchema: GenMessage<UserAccessToken> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 18);
+
+/**
+ * @generated from message memos.api.v1.ListUserAccessTokensRequest
+ */
+export type ListUserAccessTokensRequest = Message<"memos.api.v1.ListUserAccessTokensRequest"> & {
+  /**
+   * Required. The parent resource whose access tokens will be listed.
+   * Format: users/{user}
    *
    * @generated from field: string parent = 1;
    */
@@ -913,7 +1392,7 @@ export type ListUserAccessTokensRequest = Message<"memos.api.v1.ListUserAccessTo
  * Use `create(ListUserAccessTokensRequestSchema)` to create a new message.
  */
 export const ListUserAccessTokensRequestSchema: GenMessage<ListUserAccessTokensRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 17);
+  messageDesc(file_api_v1_user_service, 19);
 
 /**
  * @generated from message memos.api.v1.ListUserAccessTokensResponse
@@ -946,7 +1425,7 @@ export type ListUserAccessTokensResponse = Message<"memos.api.v1.ListUserAccessT
  * Use `create(ListUserAccessTokensResponseSchema)` to create a new message.
  */
 export const ListUserAccessTokensResponseSchema: GenMessage<ListUserAccessTokensResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 18);
+  messageDesc(file_api_v1_user_service, 20);
 
 /**
  * @generated from message memos.api.v1.CreateUserAccessTokenRequest
@@ -980,7 +1459,7 @@ export type CreateUserAccessTokenRequest = Message<"memos.api.v1.CreateUserAcces
  * Use `create(CreateUserAccessTokenRequestSchema)` to create a new message.
  */
 export const CreateUserAccessTokenRequestSchema: GenMessage<CreateUserAccessTokenRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 19);
+  messageDesc(file_api_v1_user_service, 21);
 
 /**
  * @generated from message memos.api.v1.DeleteUserAccessTokenRequest
@@ -1000,7 +1479,7 @@ export type DeleteUserAccessTokenRequest = Message<"memos.api.v1.DeleteUserAcces
  * Use `create(DeleteUserAccessTokenRequestSchema)` to create a new message.
  */
 export const DeleteUserAccessTokenRequestSchema: GenMessage<DeleteUserAccessTokenRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 20);
+  messageDesc(file_api_v1_user_service, 22);
 
 /**
  * @generated from message memos.api.v1.UserSession
@@ -1030,7 +1509,7 @@ export type UserSession = Message<"memos.api.v1.UserSession"> & {
 
   /**
    * The timestamp when the session was last accessed.
-   * Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+   * Used for sliding expiration calculation (last_accessed_time + the session idle timeout).
    *
    * @generated from field: google.protobuf.Timestamp last_accessed_time = 4;
    */
@@ -1042,14 +1521,21 @@ export type UserSession = Message<"memos.api.v1.UserSession"> & {
    * @generated from field: memos.api.v1.UserSession.ClientInfo client_info = 5;
    */
   clientInfo?: UserSession_ClientInfo;
-};
 
-/**
- * Describes the message memos.api.v1.UserSession.
+  /**
+   * Whether this is the session making the request.
+   *
+   * @generated from field: bool current = 6;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSession.
  * Use `create(UserSessionSchema)` to create a new message.
  */
 export const UserSessionSchema: GenMessage<UserSession> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 21);
+  messageDesc(file_api_v1_user_service, 23);
 
 /**
  * @generated from message memos.api.v1.UserSession.ClientInfo
@@ -1096,7 +1582,7 @@ export type UserSession_ClientInfo = Message<"memos.api.v1.UserSession.ClientInf
  * Use `create(UserSession_ClientInfoSchema)` to create a new message.
  */
 export const UserSession_ClientInfoSchema: GenMessage<UserSession_ClientInfo> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 21, 0);
+  messageDesc(file_api_v1_user_service, 23, 0);
 
 /**
  * @generated from message memos.api.v1.ListUserSessionsRequest
@@ -1116,7 +1602,7 @@ export type ListUserSessionsRequest = Message<"memos.api.v1.ListUserSessionsRequ
  * Use `create(ListUserSessionsRequestSchema)` to create a new message.
  */
 export const ListUserSessionsRequestSchema: GenMessage<ListUserSessionsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 22);
+  messageDesc(file_api_v1_user_service, 24);
 
 /**
  * @generated from message memos.api.v1.ListUserSessionsResponse
@@ -1135,7 +1621,7 @@ export type ListUserSessionsResponse = Message<"memos.api.v1.ListUserSessionsRes
  * Use `create(ListUserSessionsResponseSchema)` to create a new message.
  */
 export const ListUserSessionsResponseSchema: GenMessage<ListUserSessionsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 23);
+  messageDesc(file_api_v1_user_service, 25);
 
 /**
  * @generated from message memos.api.v1.RevokeUserSessionRequest
@@ -1155,64 +1641,268 @@ export type RevokeUserSessionRequest = Message<"memos.api.v1.RevokeUserSessionRe
  * Use `create(RevokeUserSessionRequestSchema)` to create a new message.
  */
 export const RevokeUserSessionRequestSchema: GenMessage<RevokeUserSessionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 24);
+  messageDesc(file_api_v1_user_service, 26);
 
 /**
- * UserWebhook represents a webhook owned by a user.
+ * @generated from message memos.api.v1.RevokeOtherUserSessionsRequest
+ */
+export type RevokeOtherUserSessionsRequest = Message<"memos.api.v1.RevokeOtherUserSessionsRequest"> & {
+  /**
+   * Required. The resource name of the parent.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RevokeOtherUserSessionsRequest.
+ * Use `create(RevokeOtherUserSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeOtherUserSessionsRequestSchema: GenMessage<RevokeOtherUserSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 27);
+
+/**
+ * UserTwoFactor is the TOTP two-factor authentication status of a user.
  *
- * @generated from message memos.api.v1.UserWebhook
+ * @generated from message memos.api.v1.UserTwoFactor
  */
-export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
+export type UserTwoFactor = Message<"memos.api.v1.UserTwoFactor"> & {
   /**
-   * The name of the webhook.
-   * Format: users/{user}/webhooks/{webhook}
+   * The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
    *
    * @generated from field: string name = 1;
    */
   name: string;
 
   /**
-   * The URL to send the webhook to.
+   * Whether two-factor authentication is enabled.
    *
-   * @generated from field: string url = 2;
+   * @generated from field: bool enabled = 2;
    */
-  url: string;
+  enabled: boolean;
 
   /**
-   * Optional. Human-readable name for the webhook.
+   * The timestamp when two-factor authentication was enabled.
    *
-   * @generated from field: string display_name = 3;
+   * @generated from field: google.protobuf.Timestamp enable_time = 3;
    */
-  displayName: string;
+  enableTime?: Timestamp;
 
   /**
-   * The creation time of the webhook.
+   * The number of unused recovery codes.
+   *
+   * @generated from field: int32 recovery_codes_remaining = 4;
+   */
+  recoveryCodesRemaining: number;
+};
+
+/**
+ * Describes the message memos.api.v1.UserTwoFactor.
+ * Use `create(UserTwoFactorSchema)` to create a new message.
+ */
+export const UserTwoFactorSchema: GenMessage<UserTwoFactor> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 28);
+
+/**
+ * @generated from message memos.api.v1.GetUserTwoFactorRequest
+ */
+export type GetUserTwoFactorRequest = Message<"memos.api.v1.GetUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserTwoFactorRequest.
+ * Use `create(GetUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const GetUserTwoFactorRequestSchema: GenMessage<GetUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 29);
+
+/**
+ * @generated from message memos.api.v1.EnrollUserTwoFactorRequest
+ */
+export type EnrollUserTwoFactorRequest = Message<"memos.api.v1.EnrollUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.EnrollUserTwoFactorRequest.
+ * Use `create(EnrollUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const EnrollUserTwoFactorRequestSchema: GenMessage<EnrollUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 30);
+
+/**
+ * @generated from message memos.api.v1.EnrollUserTwoFactorResponse
+ */
+export type EnrollUserTwoFactorResponse = Message<"memos.api.v1.EnrollUserTwoFactorResponse"> & {
+  /**
+   * The base32-encoded TOTP secret, for entering into an authenticator app manually.
+   *
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * The otpauth:// URI of the secret, usually shown as a QR code.
+   *
+   * @generated from field: string otpauth_uri = 2;
+   */
+  otpauthUri: string;
+};
+
+/**
+ * Describes the message memos.api.v1.EnrollUserTwoFactorResponse.
+ * Use `create(EnrollUserTwoFactorResponseSchema)` to create a new message.
+ */
+export const EnrollUserTwoFactorResponseSchema: GenMessage<EnrollUserTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 31);
+
+/**
+ * @generated from message memos.api.v1.ConfirmUserTwoFactorRequest
+ */
+export type ConfirmUserTwoFactorRequest = Message<"memos.api.v1.ConfirmUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. A 6-digit code from the authenticator app.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ConfirmUserTwoFactorRequest.
+ * Use `create(ConfirmUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const ConfirmUserTwoFactorRequestSchema: GenMessage<ConfirmUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 32);
+
+/**
+ * @generated from message memos.api.v1.ConfirmUserTwoFactorResponse
+ */
+export type ConfirmUserTwoFactorResponse = Message<"memos.api.v1.ConfirmUserTwoFactorResponse"> & {
+  /**
+   * One-time recovery codes for signing in without the authenticator app.
+   * They are only shown once.
+   *
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.ConfirmUserTwoFactorResponse.
+ * Use `create(ConfirmUserTwoFactorResponseSchema)` to create a new message.
+ */
+export const ConfirmUserTwoFactorResponseSchema: GenMessage<ConfirmUserTwoFactorResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 33);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserTwoFactorRequest
+ */
+export type DeleteUserTwoFactorRequest = Message<"memos.api.v1.DeleteUserTwoFactorRequest"> & {
+  /**
+   * Required. The resource name of the two-factor authentication.
+   * Format: users/{user}/twoFactor
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * A 6-digit code or a recovery code.
+   * Required when disabling your own two-factor authentication; admins resetting another user's leave it empty.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserTwoFactorRequest.
+ * Use `create(DeleteUserTwoFactorRequestSchema)` to create a new message.
+ */
+export const DeleteUserTwoFactorRequestSchema: GenMessage<DeleteUserTwoFactorRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 34);
+
+/**
+ * UserPasskey is a WebAuthn passkey registered by a user.
+ *
+ * @generated from message memos.api.v1.UserPasskey
+ */
+export type UserPasskey = Message<"memos.api.v1.UserPasskey"> & {
+  /**
+   * The resource name of the passkey.
+   * Format: users/{user}/passkeys/{passkey}, where passkey is the base64url-encoded credential ID.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The user-supplied label, e.g. "MacBook".
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Transport hints reported by the authenticator, e.g. "internal" or "usb".
+   *
+   * @generated from field: repeated string transports = 3;
+   */
+  transports: string[];
+
+  /**
+   * The timestamp when the passkey was registered.
    *
    * @generated from field: google.protobuf.Timestamp create_time = 4;
    */
   createTime?: Timestamp;
 
   /**
-   * The last update time of the webhook.
+   * The timestamp when the passkey was last used to sign in.
    *
-   * @generated from field: google.protobuf.Timestamp update_time = 5;
+   * @generated from field: google.protobuf.Timestamp last_used_time = 5;
    */
-  updateTime?: Timestamp;
+  lastUsedTime?: Timestamp;
 };
 
 /**
- * Describes the message memos.api.v1.UserWebhook.
- * Use `create(UserWebhookSchema)` to create a new message.
+ * Describes the message memos.api.v1.UserPasskey.
+ * Use `create(UserPasskeySchema)` to create a new message.
  */
-export const UserWebhookSchema: GenMessage<UserWebhook> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 25);
+export const UserPasskeySchema: GenMessage<UserPasskey> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 35);
 
 /**
- * @generated from message memos.api.v1.ListUserWebhooksRequest
+ * @generated from message memos.api.v1.ListUserPasskeysRequest
  */
-export type ListUserWebhooksRequest = Message<"memos.api.v1.ListUserWebhooksRequest"> & {
+export type ListUserPasskeysRequest = Message<"memos.api.v1.ListUserPasskeysRequest"> & {
   /**
-   * The parent user resource.
+   * Required. The parent resource whose passkeys will be listed.
    * Format: users/{user}
    *
    * @generated from field: string parent = 1;
@@ -1221,91 +1911,1486 @@ export type ListUserWebhooksRequest = Message<"memos.api.v1.ListUserWebhooksRequ
 };
 
 /**
- * Describes the message memos.api.v1.ListUserWebhooksRequest.
- * Use `create(ListUserWebhooksRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.ListUserPasskeysRequest.
+ * Use `create(ListUserPasskeysRequestSchema)` to create a new message.
  */
-export const ListUserWebhooksRequestSchema: GenMessage<ListUserWebhooksRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 26);
+export const ListUserPasskeysRequestSchema: GenMessage<ListUserPasskeysRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 36);
 
 /**
- * @generated from message memos.api.v1.ListUserWebhooksResponse
+ * @generated from message memos.api.v1.ListUserPasskeysResponse
  */
-export type ListUserWebhooksResponse = Message<"memos.api.v1.ListUserWebhooksResponse"> & {
+export type ListUserPasskeysResponse = Message<"memos.api.v1.ListUserPasskeysResponse"> & {
+  /**
+   * The list of passkeys.
+   *
+   * @generated from field: repeated memos.api.v1.UserPasskey passkeys = 1;
+   */
+  passkeys: UserPasskey[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserPasskeysResponse.
+ * Use `create(ListUserPasskeysResponseSchema)` to create a new message.
+ */
+export const ListUserPasskeysResponseSchema: GenMessage<ListUserPasskeysResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 37);
+
+/**
+ * @generated from message memos.api.v1.CreateUserPasskeyOptionsRequest
+ */
+export type CreateUserPasskeyOptionsRequest = Message<"memos.api.v1.CreateUserPasskeyOptionsRequest"> & {
+  /**
+   * Required. The parent resource who will register the passkey.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateUserPasskeyOptionsRequest.
+ * Use `create(CreateUserPasskeyOptionsRequestSchema)` to create a new message.
+ */
+export const CreateUserPasskeyOptionsRequestSchema: GenMessage<CreateUserPasskeyOptionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 38);
+
+/**
+ * UserPasskeyOptions holds the WebAuthn creation options for a passkey registration.
+ *
+ * @generated from message memos.api.v1.UserPasskeyOptions
+ */
+export type UserPasskeyOptions = Message<"memos.api.v1.UserPasskeyOptions"> & {
+  /**
+   * The challenge to sign. It expires after 5 minutes.
+   *
+   * @generated from field: bytes challenge = 1;
+   */
+  challenge: Uint8Array;
+
+  /**
+   * The relying party ID, derived from the instance URL.
+   *
+   * @generated from field: string rp_id = 2;
+   */
+  rpId: string;
+
+  /**
+   * The relying party display name.
+   *
+   * @generated from field: string rp_name = 3;
+   */
+  rpName: string;
+
+  /**
+   * The user handle stored in the passkey.
+   *
+   * @generated from field: bytes user_handle = 4;
+   */
+  userHandle: Uint8Array;
+
+  /**
+   * The username.
+   *
+   * @generated from field: string username = 5;
+   */
+  username: string;
+
+  /**
+   * The user's display name.
+   *
+   * @generated from field: string display_name = 6;
+   */
+  displayName: string;
+
+  /**
+   * IDs of the user's existing passkeys, so an authenticator isn't registered twice.
+   *
+   * @generated from field: repeated bytes exclude_credential_ids = 7;
+   */
+  excludeCredentialIds: Uint8Array[];
+};
+
+/**
+ * Describes the message memos.api.v1.UserPasskeyOptions.
+ * Use `create(UserPasskeyOptionsSchema)` to create a new message.
+ */
+export const UserPasskeyOptionsSchema: GenMessage<UserPasskeyOptions> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 39);
+
+/**
+ * @generated from message memos.api.v1.CreateUserPasskeyRequest
+ */
+export type CreateUserPasskeyRequest = Message<"memos.api.v1.CreateUserPasskeyRequest"> & {
+  /**
+   * Required. The parent resource who will register the passkey.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The user-supplied label of the passkey.
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Required. The credential ID (PublicKeyCredential.rawId).
+   *
+   * @generated from field: bytes credential_id = 3;
+   */
+  credentialId: Uint8Array;
+
+  /**
+   * Required. The client data JSON (AuthenticatorAttestationResponse.clientDataJSON).
+   *
+   * @generated from field: bytes client_data_json = 4;
+   */
+  clientDataJson: Uint8Array;
+
+  /**
+   * Required. The authenticator data (AuthenticatorAttestationResponse.getAuthenticatorData()).
+   *
+   * @generated from field: bytes authenticator_data = 5;
+   */
+  authenticatorData: Uint8Array;
+
+  /**
+   * Required. The DER-encoded public key (AuthenticatorAttestationResponse.getPublicKey()).
+   *
+   * @generated from field: bytes public_key = 6;
+   */
+  publicKey: Uint8Array;
+
+  /**
+   * Required. The COSE algorithm of the public key (AuthenticatorAttestationResponse.getPublicKeyAlgorithm()).
+   *
+   * @generated from field: int64 public_key_algorithm = 7;
+   */
+  publicKeyAlgorithm: bigint;
+
+  /**
+   * Optional. The transports (AuthenticatorAttestationResponse.getTransports()).
+   *
+   * @generated from field: repeated string transports = 8;
+   */
+  transports: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.CreateUserPasskeyRequest.
+ * Use `create(CreateUserPasskeyRequestSchema)` to create a new message.
+ */
+export const CreateUserPasskeyRequestSchema: GenMessage<CreateUserPasskeyRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 40);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserPasskeyRequest
+ */
+export type DeleteUserPasskeyRequest = Message<"memos.api.v1.DeleteUserPasskeyRequest"> & {
+  /**
+   * Required. The resource name of the passkey to delete.
+   * Format: users/{user}/passkeys/{passkey}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserPasskeyRequest.
+ * Use `create(DeleteUserPasskeyRequestSchema)` to create a new message.
+ */
+export const DeleteUserPasskeyRequestSchema: GenMessage<DeleteUserPasskeyRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 41);
+
+/**
+ * UserPushSubscription is the Web Push subscription of a device of a user.
+ *
+ * @generated from message memos.api.v1.UserPushSubscription
+ */
+export type UserPushSubscription = Message<"memos.api.v1.UserPushSubscription"> & {
+  /**
+   * The resource name of the push subscription.
+   * Format: users/{user}/pushSubscriptions/{push_subscription}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The user-supplied label of the device, e.g. "Phone".
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * The host of the push service of the subscription, e.g. "fcm.googleapis.com".
+   *
+   * @generated from field: string push_service = 3;
+   */
+  pushService: string;
+
+  /**
+   * The timestamp when the subscription was registered.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The timestamp when the subscription expires, if its push service set one.
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 5;
+   */
+  expireTime?: Timestamp;
+
+  /**
+   * The timestamp when a notification was last pushed to the device.
+   *
+   * @generated from field: google.protobuf.Timestamp last_push_time = 6;
+   */
+  lastPushTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserPushSubscription.
+ * Use `create(UserPushSubscriptionSchema)` to create a new message.
+ */
+export const UserPushSubscriptionSchema: GenMessage<UserPushSubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 42);
+
+/**
+ * @generated from message memos.api.v1.ListUserPushSubscriptionsRequest
+ */
+export type ListUserPushSubscriptionsRequest = Message<"memos.api.v1.ListUserPushSubscriptionsRequest"> & {
+  /**
+   * Required. The parent resource whose push subscriptions will be listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserPushSubscriptionsRequest.
+ * Use `create(ListUserPushSubscriptionsRequestSchema)` to create a new message.
+ */
+export const ListUserPushSubscriptionsRequestSchema: GenMessage<ListUserPushSubscriptionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 43);
+
+/**
+ * @generated from message memos.api.v1.ListUserPushSubscriptionsResponse
+ */
+export type ListUserPushSubscriptionsResponse = Message<"memos.api.v1.ListUserPushSubscriptionsResponse"> & {
+  /**
+   * The list of push subscriptions.
+   *
+   * @generated from field: repeated memos.api.v1.UserPushSubscription push_subscriptions = 1;
+   */
+  pushSubscriptions: UserPushSubscription[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserPushSubscriptionsResponse.
+ * Use `create(ListUserPushSubscriptionsResponseSchema)` to create a new message.
+ */
+export const ListUserPushSubscriptionsResponseSchema: GenMessage<ListUserPushSubscriptionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 44);
+
+/**
+ * @generated from message memos.api.v1.CreateUserPushSubscriptionRequest
+ */
+export type CreateUserPushSubscriptionRequest = Message<"memos.api.v1.CreateUserPushSubscriptionRequest"> & {
+  /**
+   * Required. The parent resource who will receive the notifications.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The user-supplied label of the device.
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * Required. The push endpoint (PushSubscription.endpoint).
+   *
+   * @generated from field: string endpoint = 3;
+   */
+  endpoint: string;
+
+  /**
+   * Required. The P-256 public key of the subscription (PushSubscription.getKey("p256dh")).
+   *
+   * @generated from field: bytes p256dh = 4;
+   */
+  p256dh: Uint8Array;
+
+  /**
+   * Required. The authentication secret of the subscription (PushSubscription.getKey("auth")).
+   *
+   * @generated from field: bytes auth = 5;
+   */
+  auth: Uint8Array;
+
+  /**
+   * Optional. When the subscription expires (PushSubscription.expirationTime).
+   *
+   * @generated from field: google.protobuf.Timestamp expire_time = 6;
+   */
+  expireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateUserPushSubscriptionRequest.
+ * Use `create(CreateUserPushSubscriptionRequestSchema)` to create a new message.
+ */
+export const CreateUserPushSubscriptionRequestSchema: GenMessage<CreateUserPushSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 45);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserPushSubscriptionRequest
+ */
+export type DeleteUserPushSubscriptionRequest = Message<"memos.api.v1.DeleteUserPushSubscriptionRequest"> & {
+  /**
+   * Required. The resource name of the push subscription to delete.
+   * Format: users/{user}/pushSubscriptions/{push_subscription}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserPushSubscriptionRequest.
+ * Use `create(DeleteUserPushSubscriptionRequestSchema)` to create a new message.
+ */
+export const DeleteUserPushSubscriptionRequestSchema: GenMessage<DeleteUserPushSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 46);
+
+/**
+ * The feed token of a user. Appended to the feed URLs of the user as the token query
+ * parameter, it makes the feeds include the protected and private memos of the user.
+ * Feed tokens are independent of access tokens, and only grant access to the feeds.
+ *
+ * @generated from message memos.api.v1.UserFeedToken
+ */
+export type UserFeedToken = Message<"memos.api.v1.UserFeedToken"> & {
+  /**
+   * The resource name of the feed token.
+   * Format: users/{user}/feedToken
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether the user has a feed token.
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * The timestamp when the feed token was generated.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The feed token. Only returned by RotateUserFeedToken, as it's stored hashed.
+   *
+   * @generated from field: string token = 4;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserFeedToken.
+ * Use `create(UserFeedTokenSchema)` to create a new message.
+ */
+export const UserFeedTokenSchema: GenMessage<UserFeedToken> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 47);
+
+/**
+ * @generated from message memos.api.v1.GetUserFeedTokenRequest
+ */
+export type GetUserFeedTokenRequest = Message<"memos.api.v1.GetUserFeedTokenRequest"> & {
+  /**
+   * Required. The resource name of the feed token.
+   * Format: users/{user}/feedToken
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserFeedTokenRequest.
+ * Use `create(GetUserFeedTokenRequestSchema)` to create a new message.
+ */
+export const GetUserFeedTokenRequestSchema: GenMessage<GetUserFeedTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 48);
+
+/**
+ * @generated from message memos.api.v1.RotateUserFeedTokenRequest
+ */
+export type RotateUserFeedTokenRequest = Message<"memos.api.v1.RotateUserFeedTokenRequest"> & {
+  /**
+   * Required. The resource name of the feed token.
+   * Format: users/{user}/feedToken
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RotateUserFeedTokenRequest.
+ * Use `create(RotateUserFeedTokenRequestSchema)` to create a new message.
+ */
+export const RotateUserFeedTokenRequestSchema: GenMessage<RotateUserFeedTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 49);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserFeedTokenRequest
+ */
+export type DeleteUserFeedTokenRequest = Message<"memos.api.v1.DeleteUserFeedTokenRequest"> & {
+  /**
+   * Required. The resource name of the feed token.
+   * Format: users/{user}/feedToken
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserFeedTokenRequest.
+ * Use `create(DeleteUserFeedTokenRequestSchema)` to create a new message.
+ */
+export const DeleteUserFeedTokenRequestSchema: GenMessage<DeleteUserFeedTokenRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 50);
+
+/**
+ * The inbound email address of a user. The emails sent to the address become private memos of
+ * the user: the tags of the subject are extracted, the body is converted to markdown, and the
+ * attachments are uploaded.
+ *
+ * @generated from message memos.api.v1.UserInboundEmail
+ */
+export type UserInboundEmail = Message<"memos.api.v1.UserInboundEmail"> & {
+  /**
+   * The resource name of the inbound email address.
+   * Format: users/{user}/inboundEmail
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether inbound email is enabled on the instance, i.e. an inbound domain is set.
+   *
+   * @generated from field: bool available = 2;
+   */
+  available: boolean;
+
+  /**
+   * The inbound email address, empty if the user has none.
+   *
+   * @generated from field: string address = 3;
+   */
+  address: string;
+
+  /**
+   * The timestamp when the address was generated.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserInboundEmail.
+ * Use `create(UserInboundEmailSchema)` to create a new message.
+ */
+export const UserInboundEmailSchema: GenMessage<UserInboundEmail> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 51);
+
+/**
+ * @generated from message memos.api.v1.GetUserInboundEmailRequest
+ */
+export type GetUserInboundEmailRequest = Message<"memos.api.v1.GetUserInboundEmailRequest"> & {
+  /**
+   * Required. The resource name of the inbound email address.
+   * Format: users/{user}/inboundEmail
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserInboundEmailRequest.
+ * Use `create(GetUserInboundEmailRequestSchema)` to create a new message.
+ */
+export const GetUserInboundEmailRequestSchema: GenMessage<GetUserInboundEmailRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 52);
+
+/**
+ * @generated from message memos.api.v1.RotateUserInboundEmailRequest
+ */
+export type RotateUserInboundEmailRequest = Message<"memos.api.v1.RotateUserInboundEmailRequest"> & {
+  /**
+   * Required. The resource name of the inbound email address.
+   * Format: users/{user}/inboundEmail
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RotateUserInboundEmailRequest.
+ * Use `create(RotateUserInboundEmailRequestSchema)` to create a new message.
+ */
+export const RotateUserInboundEmailRequestSchema: GenMessage<RotateUserInboundEmailRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 53);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserInboundEmailRequest
+ */
+export type DeleteUserInboundEmailRequest = Message<"memos.api.v1.DeleteUserInboundEmailRequest"> & {
+  /**
+   * Required. The resource name of the inbound email address.
+   * Format: users/{user}/inboundEmail
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserInboundEmailRequest.
+ * Use `create(DeleteUserInboundEmailRequestSchema)` to create a new message.
+ */
+export const DeleteUserInboundEmailRequestSchema: GenMessage<DeleteUserInboundEmailRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 54);
+
+/**
+ * The Slack account linked to a user. The linked account creates memos of the user with the
+ * /memo slash command and the "Save to memos" message action.
+ *
+ * @generated from message memos.api.v1.UserSlackLink
+ */
+export type UserSlackLink = Message<"memos.api.v1.UserSlackLink"> & {
+  /**
+   * The resource name of the Slack link.
+   * Format: users/{user}/slackLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether the Slack integration is enabled on the instance, i.e. a signing secret is set.
+   *
+   * @generated from field: bool available = 2;
+   */
+  available: boolean;
+
+  /**
+   * The ID of the Slack workspace of the linked account, empty if the user has none.
+   *
+   * @generated from field: string slack_team_id = 3;
+   */
+  slackTeamId: string;
+
+  /**
+   * The ID of the linked Slack user.
+   *
+   * @generated from field: string slack_user_id = 4;
+   */
+  slackUserId: string;
+
+  /**
+   * The timestamp when the Slack account was linked.
+   *
+   * @generated from field: google.protobuf.Timestamp link_time = 5;
+   */
+  linkTime?: Timestamp;
+
+  /**
+   * The one-time link code, only returned by GenerateUserSlackLinkCode.
+   *
+   * @generated from field: string link_code = 6;
+   */
+  linkCode: string;
+
+  /**
+   * The timestamp when the pending link code expires, unset if there's none.
+   *
+   * @generated from field: google.protobuf.Timestamp link_code_expire_time = 7;
+   */
+  linkCodeExpireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSlackLink.
+ * Use `create(UserSlackLinkSchema)` to create a new message.
+ */
+export const UserSlackLinkSchema: GenMessage<UserSlackLink> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 55);
+
+/**
+ * @generated from message memos.api.v1.GetUserSlackLinkRequest
+ */
+export type GetUserSlackLinkRequest = Message<"memos.api.v1.GetUserSlackLinkRequest"> & {
+  /**
+   * Required. The resource name of the Slack link.
+   * Format: users/{user}/slackLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserSlackLinkRequest.
+ * Use `create(GetUserSlackLinkRequestSchema)` to create a new message.
+ */
+export const GetUserSlackLinkRequestSchema: GenMessage<GetUserSlackLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 56);
+
+/**
+ * @generated from message memos.api.v1.GenerateUserSlackLinkCodeRequest
+ */
+export type GenerateUserSlackLinkCodeRequest = Message<"memos.api.v1.GenerateUserSlackLinkCodeRequest"> & {
+  /**
+   * Required. The resource name of the Slack link.
+   * Format: users/{user}/slackLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GenerateUserSlackLinkCodeRequest.
+ * Use `create(GenerateUserSlackLinkCodeRequestSchema)` to create a new message.
+ */
+export const GenerateUserSlackLinkCodeRequestSchema: GenMessage<GenerateUserSlackLinkCodeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 57);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserSlackLinkRequest
+ */
+export type DeleteUserSlackLinkRequest = Message<"memos.api.v1.DeleteUserSlackLinkRequest"> & {
+  /**
+   * Required. The resource name of the Slack link.
+   * Format: users/{user}/slackLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserSlackLinkRequest.
+ * Use `create(DeleteUserSlackLinkRequestSchema)` to create a new message.
+ */
+export const DeleteUserSlackLinkRequestSchema: GenMessage<DeleteUserSlackLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 58);
+
+/**
+ * The Matrix account linked to a user. The linked account creates memos of the user with the
+ * messages to the Matrix bot.
+ *
+ * @generated from message memos.api.v1.UserMatrixLink
+ */
+export type UserMatrixLink = Message<"memos.api.v1.UserMatrixLink"> & {
+  /**
+   * The resource name of the Matrix link.
+   * Format: users/{user}/matrixLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether the Matrix bot is enabled on the instance, i.e. a homeserver and access token are set.
+   *
+   * @generated from field: bool available = 2;
+   */
+  available: boolean;
+
+  /**
+   * The ID of the linked Matrix user, e.g. "@alice:example.com", empty if the user has none.
+   *
+   * @generated from field: string matrix_user_id = 3;
+   */
+  matrixUserId: string;
+
+  /**
+   * The timestamp when the Matrix account was linked.
+   *
+   * @generated from field: google.protobuf.Timestamp link_time = 4;
+   */
+  linkTime?: Timestamp;
+
+  /**
+   * The one-time link code, only returned by GenerateUserMatrixLinkCode.
+   *
+   * @generated from field: string link_code = 5;
+   */
+  linkCode: string;
+
+  /**
+   * The timestamp when the pending link code expires, unset if there's none.
+   *
+   * @generated from field: google.protobuf.Timestamp link_code_expire_time = 6;
+   */
+  linkCodeExpireTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserMatrixLink.
+ * Use `create(UserMatrixLinkSchema)` to create a new message.
+ */
+export const UserMatrixLinkSchema: GenMessage<UserMatrixLink> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 59);
+
+/**
+ * @generated from message memos.api.v1.GetUserMatrixLinkRequest
+ */
+export type GetUserMatrixLinkRequest = Message<"memos.api.v1.GetUserMatrixLinkRequest"> & {
+  /**
+   * Required. The resource name of the Matrix link.
+   * Format: users/{user}/matrixLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetUserMatrixLinkRequest.
+ * Use `create(GetUserMatrixLinkRequestSchema)` to create a new message.
+ */
+export const GetUserMatrixLinkRequestSchema: GenMessage<GetUserMatrixLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 60);
+
+/**
+ * @generated from message memos.api.v1.GenerateUserMatrixLinkCodeRequest
+ */
+export type GenerateUserMatrixLinkCodeRequest = Message<"memos.api.v1.GenerateUserMatrixLinkCodeRequest"> & {
+  /**
+   * Required. The resource name of the Matrix link.
+   * Format: users/{user}/matrixLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GenerateUserMatrixLinkCodeRequest.
+ * Use `create(GenerateUserMatrixLinkCodeRequestSchema)` to create a new message.
+ */
+export const GenerateUserMatrixLinkCodeRequestSchema: GenMessage<GenerateUserMatrixLinkCodeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 61);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserMatrixLinkRequest
+ */
+export type DeleteUserMatrixLinkRequest = Message<"memos.api.v1.DeleteUserMatrixLinkRequest"> & {
+  /**
+   * Required. The resource name of the Matrix link.
+   * Format: users/{user}/matrixLink
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserMatrixLinkRequest.
+ * Use `create(DeleteUserMatrixLinkRequestSchema)` to create a new message.
+ */
+export const DeleteUserMatrixLinkRequestSchema: GenMessage<DeleteUserMatrixLinkRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 62);
+
+/**
+ * @generated from message memos.api.v1.UnlockUserRequest
+ */
+export type UnlockUserRequest = Message<"memos.api.v1.UnlockUserRequest"> & {
+  /**
+   * Required. The resource name of the user to unlock.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UnlockUserRequest.
+ * Use `create(UnlockUserRequestSchema)` to create a new message.
+ */
+export const UnlockUserRequestSchema: GenMessage<UnlockUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 63);
+
+/**
+ * @generated from message memos.api.v1.SuspendUserRequest
+ */
+export type SuspendUserRequest = Message<"memos.api.v1.SuspendUserRequest"> & {
+  /**
+   * Required. The resource name of the user to suspend.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The reason of the suspension, recorded in the audit log.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SuspendUserRequest.
+ * Use `create(SuspendUserRequestSchema)` to create a new message.
+ */
+export const SuspendUserRequestSchema: GenMessage<SuspendUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 64);
+
+/**
+ * @generated from message memos.api.v1.UnsuspendUserRequest
+ */
+export type UnsuspendUserRequest = Message<"memos.api.v1.UnsuspendUserRequest"> & {
+  /**
+   * Required. The resource name of the user to unsuspend.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The reason of lifting the suspension, recorded in the audit log.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UnsuspendUserRequest.
+ * Use `create(UnsuspendUserRequestSchema)` to create a new message.
+ */
+export const UnsuspendUserRequestSchema: GenMessage<UnsuspendUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 65);
+
+/**
+ * UserWebhook represents a webhook owned by a user.
+ *
+ * @generated from message memos.api.v1.UserWebhook
+ */
+export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
+  /**
+   * The name of the webhook.
+   * Format: users/{user}/webhooks/{webhook}, or instance/webhooks/{webhook} for workspace webhooks.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The URL to send the webhook to.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * Optional. Human-readable name for the webhook.
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * The creation time of the webhook.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 4;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The last update time of the webhook.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 5;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * Whether deliveries to the webhook are stopped. A webhook is disabled automatically after
+   * 50 consecutive failed deliveries, and enabling it again resets the count.
+   *
+   * @generated from field: bool disabled = 6;
+   */
+  disabled: boolean;
+
+  /**
+   * The number of failed deliveries since the last successful one.
+   *
+   * @generated from field: int32 consecutive_failures = 7;
+   */
+  consecutiveFailures: number;
+
+  /**
+   * The secret deliveries are signed with. It's only returned when the webhook is created and when
+   * its secret is rotated.
+   *
+   * Each delivery carries an X-Memos-Signature header of the form "t=<timestamp>,v1=<signature>",
+   * where the timestamp is the Unix time of the attempt and the signature is the hex-encoded
+   * HMAC-SHA256, keyed with the secret, of the timestamp, a ".", and the request body. Receivers
+   * should compute the signature and compare it in constant time, and reject timestamps that are
+   * too old to prevent replays. Every attempt is signed with a fresh timestamp.
+   *
+   * @generated from field: string secret = 8;
+   */
+  secret: string;
+
+  /**
+   * Whether the deliveries of the webhook are signed.
+   *
+   * @generated from field: bool has_secret = 9;
+   */
+  hasSecret: boolean;
+
+  /**
+   * The events the webhook is sent for. An empty list subscribes to all events.
+   * Valid events are memos.memo.created, memos.memo.updated, memos.memo.trashed,
+   * memos.memo.restored, memos.memo.reminder, memos.memo.expired and memos.memo.deleted.
+   * The event of a delivery is sent in the activityType field of its payload.
+   *
+   * @generated from field: repeated string events = 10;
+   */
+  events: string[];
+
+  /**
+   * The format of the payloads sent to the webhook.
+   *
+   * @generated from field: memos.api.v1.UserWebhook.Format format = 11;
+   */
+  format: UserWebhook_Format;
+
+  /**
+   * Whether the webhook belongs to a user or to the workspace.
+   *
+   * @generated from field: memos.api.v1.UserWebhook.Scope scope = 12;
+   */
+  scope: UserWebhook_Scope;
+
+  /**
+   * Whether the events of private memos carry their content. Only used by workspace webhooks,
+   * which otherwise send only the memo name, its creator and the event of private memos.
+   *
+   * @generated from field: bool include_private_content = 13;
+   */
+  includePrivateContent: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.UserWebhook.
+ * Use `create(UserWebhookSchema)` to create a new message.
+ */
+export const UserWebhookSchema: GenMessage<UserWebhook> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 66);
+
+/**
+ * @generated from enum memos.api.v1.UserWebhook.Format
+ */
+export enum UserWebhook_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * The memos event payload, with the event in its activityType field. It's the default.
+   *
+   * @generated from enum value: RAW = 1;
+   */
+  RAW = 1,
+
+  /**
+   * A Slack incoming webhook message, for Slack webhook URLs.
+   *
+   * @generated from enum value: SLACK = 2;
+   */
+  SLACK = 2,
+
+  /**
+   * A Discord webhook message with an embed, for Discord webhook URLs.
+   *
+   * @generated from enum value: DISCORD = 3;
+   */
+  DISCORD = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhook.Format.
+ */
+export const UserWebhook_FormatSchema: GenEnum<UserWebhook_Format> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 66, 0);
+
+/**
+ * @generated from enum memos.api.v1.UserWebhook.Scope
+ */
+export enum UserWebhook_Scope {
+  /**
+   * @generated from enum value: SCOPE_UNSPECIFIED = 0;
+   */
+  SCOPE_UNSPECIFIED = 0,
+
+  /**
+   * The webhook of a user, sent for the events of the memos of the user.
+   *
+   * @generated from enum value: USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * A workspace webhook, sent for the events of the memos of all users.
+   *
+   * @generated from enum value: WORKSPACE = 2;
+   */
+  WORKSPACE = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhook.Scope.
+ */
+export const UserWebhook_ScopeSchema: GenEnum<UserWebhook_Scope> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 66, 1);
+
+/**
+ * UserWebhookDelivery is the delivery of an event to a webhook. Failed attempts are retried with
+ * exponential backoff, up to 5 attempts over about 30 minutes.
+ *
+ * @generated from message memos.api.v1.UserWebhookDelivery
+ */
+export type UserWebhookDelivery = Message<"memos.api.v1.UserWebhookDelivery"> & {
+  /**
+   * The resource name of the delivery.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The type of the delivered event, e.g. memos.memo.created.
+   *
+   * @generated from field: string activity_type = 2;
+   */
+  activityType: string;
+
+  /**
+   * The hex encoded SHA-256 hash of the delivered JSON body.
+   *
+   * @generated from field: string payload_hash = 3;
+   */
+  payloadHash: string;
+
+  /**
+   * The state of the delivery.
+   *
+   * @generated from field: memos.api.v1.UserWebhookDelivery.State state = 4;
+   */
+  state: UserWebhookDelivery_State;
+
+  /**
+   * The number of attempts made so far.
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * The HTTP status of the response to the last attempt, 0 if there was no response.
+   *
+   * @generated from field: int32 response_status = 6;
+   */
+  responseStatus: number;
+
+  /**
+   * The time the last attempt took.
+   *
+   * @generated from field: google.protobuf.Duration latency = 7;
+   */
+  latency?: Duration;
+
+  /**
+   * The error of the last attempt, empty if it succeeded.
+   *
+   * @generated from field: string error = 8;
+   */
+  error: string;
+
+  /**
+   * The creation time of the delivery.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 9;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The time of the last attempt.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 10;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * The time of the next attempt of a pending delivery.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_time = 11;
+   */
+  nextAttemptTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserWebhookDelivery.
+ * Use `create(UserWebhookDeliverySchema)` to create a new message.
+ */
+export const UserWebhookDeliverySchema: GenMessage<UserWebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 67);
+
+/**
+ * @generated from enum memos.api.v1.UserWebhookDelivery.State
+ */
+export enum UserWebhookDelivery_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The delivery is waiting for its next attempt.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The webhook accepted the delivery.
+   *
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * All the attempts of the delivery failed.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhookDelivery.State.
+ */
+export const UserWebhookDelivery_StateSchema: GenEnum<UserWebhookDelivery_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 67, 0);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhooksRequest
+ */
+export type ListUserWebhooksRequest = Message<"memos.api.v1.ListUserWebhooksRequest"> & {
+  /**
+   * The parent user resource.
+   * Format: users/{user}, or instance for the workspace webhooks.
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhooksRequest.
+ * Use `create(ListUserWebhooksRequestSchema)` to create a new message.
+ */
+export const ListUserWebhooksRequestSchema: GenMessage<ListUserWebhooksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 68);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhooksResponse
+ */
+export type ListUserWebhooksResponse = Message<"memos.api.v1.ListUserWebhooksResponse"> & {
+  /**
+   * The list of webhooks.
+   *
+   * @generated from field: repeated memos.api.v1.UserWebhook webhooks = 1;
+   */
+  webhooks: UserWebhook[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhooksResponse.
+ * Use `create(ListUserWebhooksResponseSchema)` to create a new message.
+ */
+export const ListUserWebhooksResponseSchema: GenMessage<ListUserWebhooksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 69);
+
+/**
+ * @generated from message memos.api.v1.CreateUserWebhookRequest
+ */
+export type CreateUserWebhookRequest = Message<"memos.api.v1.CreateUserWebhookRequest"> & {
+  /**
+   * The parent user resource.
+   * Format: users/{user}, or instance for a workspace webhook.
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The webhook to create.
+   *
+   * @generated from field: memos.api.v1.UserWebhook webhook = 2;
+   */
+  webhook?: UserWebhook;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateUserWebhookRequest.
+ * Use `create(CreateUserWebhookRequestSchema)` to create a new message.
+ */
+export const CreateUserWebhookRequestSchema: GenMessage<CreateUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 70);
+
+/**
+ * @generated from message memos.api.v1.UpdateUserWebhookRequest
+ */
+export type UpdateUserWebhookRequest = Message<"memos.api.v1.UpdateUserWebhookRequest"> & {
+  /**
+   * The webhook to update.
+   *
+   * @generated from field: memos.api.v1.UserWebhook webhook = 1;
+   */
+  webhook?: UserWebhook;
+
+  /**
+   * The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateUserWebhookRequest.
+ * Use `create(UpdateUserWebhookRequestSchema)` to create a new message.
+ */
+export const UpdateUserWebhookRequestSchema: GenMessage<UpdateUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 71);
+
+/**
+ * @generated from message memos.api.v1.DeleteUserWebhookRequest
+ */
+export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRequest"> & {
+  /**
+   * The name of the webhook to delete.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteUserWebhookRequest.
+ * Use `create(DeleteUserWebhookRequestSchema)` to create a new message.
+ */
+export const DeleteUserWebhookRequestSchema: GenMessage<DeleteUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 72);
+
+/**
+ * @generated from message memos.api.v1.RotateUserWebhookSecretRequest
+ */
+export type RotateUserWebhookSecretRequest = Message<"memos.api.v1.RotateUserWebhookSecretRequest"> & {
+  /**
+   * The name of the webhook to rotate the secret of.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RotateUserWebhookSecretRequest.
+ * Use `create(RotateUserWebhookSecretRequestSchema)` to create a new message.
+ */
+export const RotateUserWebhookSecretRequestSchema: GenMessage<RotateUserWebhookSecretRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 73);
+
+/**
+ * @generated from message memos.api.v1.TestUserWebhookRequest
+ */
+export type TestUserWebhookRequest = Message<"memos.api.v1.TestUserWebhookRequest"> & {
+  /**
+   * The name of the webhook to test.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.TestUserWebhookRequest.
+ * Use `create(TestUserWebhookRequestSchema)` to create a new message.
+ */
+export const TestUserWebhookRequestSchema: GenMessage<TestUserWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 74);
+
+/**
+ * @generated from message memos.api.v1.TestUserWebhookResponse
+ */
+export type TestUserWebhookResponse = Message<"memos.api.v1.TestUserWebhookResponse"> & {
+  /**
+   * The HTTP status of the response, or 0 if the endpoint couldn't be reached.
+   *
+   * @generated from field: int32 status_code = 1;
+   */
+  statusCode: number;
+
+  /**
+   * The headers of the response.
+   *
+   * @generated from field: map<string, string> headers = 2;
+   */
+  headers: { [key: string]: string };
+
+  /**
+   * The first 1 KB of the response body.
+   *
+   * @generated from field: string body = 3;
+   */
+  body: string;
+
+  /**
+   * The round-trip time of the request.
+   *
+   * @generated from field: google.protobuf.Duration latency = 4;
+   */
+  latency?: Duration;
+
   /**
-   * The list of webhooks.
+   * Why deliveries to the webhook would fail, e.g. an unreachable endpoint or an unexpected
+   * response. Empty if the test succeeded.
    *
-   * @generated from field: repeated memos.api.v1.UserWebhook webhooks = 1;
+   * @generated from field: string error = 5;
    */
-  webhooks: UserWebhook[];
+  error: string;
 };
 
 /**
- * Describes the message memos.api.v1.ListUserWebhooksResponse.
- * Use `create(ListUserWebhooksResponseSchema)` to create a new message.
+ * Describes the message memos.api.v1.TestUserWebhookResponse.
+ * Use `create(TestUserWebhookResponseSchema)` to create a new message.
  */
-export const ListUserWebhooksResponseSchema: GenMessage<ListUserWebhooksResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 27);
+export const TestUserWebhookResponseSchema: GenMessage<TestUserWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 75);
 
 /**
- * @generated from message memos.api.v1.CreateUserWebhookRequest
+ * @generated from message memos.api.v1.ListUserWebhookDeliveriesRequest
  */
-export type CreateUserWebhookRequest = Message<"memos.api.v1.CreateUserWebhookRequest"> & {
+export type ListUserWebhookDeliveriesRequest = Message<"memos.api.v1.ListUserWebhookDeliveriesRequest"> & {
   /**
-   * The parent user resource.
-   * Format: users/{user}
+   * The parent webhook.
+   * Format: users/{user}/webhooks/{webhook}
    *
    * @generated from field: string parent = 1;
    */
   parent: string;
 
   /**
-   * The webhook to create.
+   * The maximum number of deliveries to return.
+   * If unspecified, at most 50 deliveries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
    *
-   * @generated from field: memos.api.v1.UserWebhook webhook = 2;
+   * @generated from field: int32 page_size = 2;
    */
-  webhook?: UserWebhook;
+  pageSize: number;
+
+  /**
+   * A page token, received from a previous `ListUserWebhookDeliveries` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
 };
 
 /**
- * Describes the message memos.api.v1.CreateUserWebhookRequest.
- * Use `create(CreateUserWebhookRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.ListUserWebhookDeliveriesRequest.
+ * Use `create(ListUserWebhookDeliveriesRequestSchema)` to create a new message.
  */
-export const CreateUserWebhookRequestSchema: GenMessage<CreateUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 28);
+export const ListUserWebhookDeliveriesRequestSchema: GenMessage<ListUserWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 76);
 
 /**
- * @generated from message memos.api.v1.UpdateUserWebhookRequest
+ * @generated from message memos.api.v1.ListUserWebhookDeliveriesResponse
  */
-export type UpdateUserWebhookRequest = Message<"memos.api.v1.UpdateUserWebhookRequest"> & {
+export type ListUserWebhookDeliveriesResponse = Message<"memos.api.v1.ListUserWebhookDeliveriesResponse"> & {
   /**
-   * The webhook to update.
+   * The deliveries, newest first.
    *
-   * @generated from field: memos.api.v1.UserWebhook webhook = 1;
+   * @generated from field: repeated memos.api.v1.UserWebhookDelivery deliveries = 1;
    */
-  webhook?: UserWebhook;
+  deliveries: UserWebhookDelivery[];
 
   /**
-   * The list of fields to update.
+   * A token to retrieve the next page of results.
    *
-   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   * @generated from field: string next_page_token = 2;
    */
-  updateMask?: FieldMask;
+  nextPageToken: string;
 };
 
 /**
- * Describes the message memos.api.v1.UpdateUserWebhookRequest.
- * Use `create(UpdateUserWebhookRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.ListUserWebhookDeliveriesResponse.
+ * Use `create(ListUserWebhookDeliveriesResponseSchema)` to create a new message.
  */
-export const UpdateUserWebhookRequestSchema: GenMessage<UpdateUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 29);
+export const ListUserWebhookDeliveriesResponseSchema: GenMessage<ListUserWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 77);
 
 /**
- * @generated from message memos.api.v1.DeleteUserWebhookRequest
+ * @generated from message memos.api.v1.RedeliverUserWebhookDeliveryRequest
  */
-export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRequest"> & {
+export type RedeliverUserWebhookDeliveryRequest = Message<"memos.api.v1.RedeliverUserWebhookDeliveryRequest"> & {
   /**
-   * The name of the webhook to delete.
-   * Format: users/{user}/webhooks/{webhook}
+   * The name of the delivery to replay.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
    *
    * @generated from field: string name = 1;
    */
@@ -1313,11 +3398,11 @@ export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRe
 };
 
 /**
- * Describes the message memos.api.v1.DeleteUserWebhookRequest.
- * Use `create(DeleteUserWebhookRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.RedeliverUserWebhookDeliveryRequest.
+ * Use `create(RedeliverUserWebhookDeliveryRequestSchema)` to create a new message.
  */
-export const DeleteUserWebhookRequestSchema: GenMessage<DeleteUserWebhookRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 30);
+export const RedeliverUserWebhookDeliveryRequestSchema: GenMessage<RedeliverUserWebhookDeliveryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 78);
 
 /**
  * @generated from message memos.api.v1.UserNotification
@@ -1373,7 +3458,7 @@ export type UserNotification = Message<"memos.api.v1.UserNotification"> & {
  * Use `create(UserNotificationSchema)` to create a new message.
  */
 export const UserNotificationSchema: GenMessage<UserNotification> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 31);
+  messageDesc(file_api_v1_user_service, 79);
 
 /**
  * @generated from enum memos.api.v1.UserNotification.Status
@@ -1399,7 +3484,7 @@ export enum UserNotification_Status {
  * Describes the enum memos.api.v1.UserNotification.Status.
  */
 export const UserNotification_StatusSchema: GenEnum<UserNotification_Status> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 31, 0);
+  enumDesc(file_api_v1_user_service, 79, 0);
 
 /**
  * @generated from enum memos.api.v1.UserNotification.Type
@@ -1414,13 +3499,60 @@ export enum UserNotification_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * @generated from enum value: MEMO_REMINDER = 2;
+   */
+  MEMO_REMINDER = 2,
+
+  /**
+   * Summary of the memos archived by the auto-archive policy.
+   *
+   * @generated from enum value: MEMO_AUTO_ARCHIVE = 3;
+   */
+  MEMO_AUTO_ARCHIVE = 3,
+
+  /**
+   * A webhook was disabled after too many consecutive failed deliveries.
+   *
+   * @generated from enum value: WEBHOOK_DISABLED = 4;
+   */
+  WEBHOOK_DISABLED = 4,
+
+  /**
+   * A backup of the instance failed.
+   *
+   * @generated from enum value: BACKUP_FAILED = 5;
+   */
+  BACKUP_FAILED = 5,
+
+  /**
+   * A reaction to a memo of the user.
+   *
+   * @generated from enum value: MEMO_REACTION = 6;
+   */
+  MEMO_REACTION = 6,
+
+  /**
+   * A memo mentioning the user with @username.
+   *
+   * @generated from enum value: MEMO_MENTION = 7;
+   */
+  MEMO_MENTION = 7,
+
+  /**
+   * A digest summarizing the activity of the user.
+   *
+   * @generated from enum value: DIGEST = 8;
+   */
+  DIGEST = 8,
 }
 
 /**
  * Describes the enum memos.api.v1.UserNotification.Type.
  */
 export const UserNotification_TypeSchema: GenEnum<UserNotification_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 31, 1);
+  enumDesc(file_api_v1_user_service, 79, 1);
 
 /**
  * @generated from message memos.api.v1.ListUserNotificationsRequest
@@ -1455,7 +3587,7 @@ export type ListUserNotificationsRequest = Message<"memos.api.v1.ListUserNotific
  * Use `create(ListUserNotificationsRequestSchema)` to create a new message.
  */
 export const ListUserNotificationsRequestSchema: GenMessage<ListUserNotificationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 32);
+  messageDesc(file_api_v1_user_service, 80);
 
 /**
  * @generated from message memos.api.v1.ListUserNotificationsResponse
@@ -1477,7 +3609,7 @@ export type ListUserNotificationsResponse = Message<"memos.api.v1.ListUserNotifi
  * Use `create(ListUserNotificationsResponseSchema)` to create a new message.
  */
 export const ListUserNotificationsResponseSchema: GenMessage<ListUserNotificationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 33);
+  messageDesc(file_api_v1_user_service, 81);
 
 /**
  * @generated from message memos.api.v1.UpdateUserNotificationRequest
@@ -1499,7 +3631,7 @@ export type UpdateUserNotificationRequest = Message<"memos.api.v1.UpdateUserNoti
  * Use `create(UpdateUserNotificationRequestSchema)` to create a new message.
  */
 export const UpdateUserNotificationRequestSchema: GenMessage<UpdateUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 34);
+  messageDesc(file_api_v1_user_service, 82);
 
 /**
  * @generated from message memos.api.v1.DeleteUserNotificationRequest
@@ -1518,7 +3650,7 @@ export type DeleteUserNotificationRequest = Message<"memos.api.v1.DeleteUserNoti
  * Use `create(DeleteUserNotificationRequestSchema)` to create a new message.
  */
 export const DeleteUserNotificationRequestSchema: GenMessage<DeleteUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 35);
+  messageDesc(file_api_v1_user_service, 83);
 
 /**
  * @generated from service memos.api.v1.UserService
@@ -1597,6 +3729,17 @@ export const UserService: GenService<{
     input: typeof GetUserStatsRequestSchema;
     output: typeof UserStatsSchema;
   },
+  /**
+   * GetUserWritingStats returns the writing statistics of a user.
+   * Users can only get their own, except for the host.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserWritingStats
+   */
+  getUserWritingStats: {
+    methodKind: "unary";
+    input: typeof GetUserWritingStatsRequestSchema;
+    output: typeof UserWritingStatsSchema;
+  },
   /**
    * GetUserSetting returns the user setting.
    *
@@ -1678,7 +3821,288 @@ export const UserService: GenService<{
     output: typeof EmptySchema;
   },
   /**
-   * ListUserWebhooks returns a list of webhooks for a user.
+   * RevokeOtherUserSessions revokes all sessions of a user except the current one.
+   *
+   * @generated from rpc memos.api.v1.UserService.RevokeOtherUserSessions
+   */
+  revokeOtherUserSessions: {
+    methodKind: "unary";
+    input: typeof RevokeOtherUserSessionsRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetUserTwoFactor returns the two-factor authentication status of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserTwoFactor
+   */
+  getUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof GetUserTwoFactorRequestSchema;
+    output: typeof UserTwoFactorSchema;
+  },
+  /**
+   * EnrollUserTwoFactor generates a new TOTP secret for a user.
+   * Two-factor authentication is enabled once ConfirmUserTwoFactor verifies a code.
+   *
+   * @generated from rpc memos.api.v1.UserService.EnrollUserTwoFactor
+   */
+  enrollUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof EnrollUserTwoFactorRequestSchema;
+    output: typeof EnrollUserTwoFactorResponseSchema;
+  },
+  /**
+   * ConfirmUserTwoFactor verifies a code for a pending enrollment and enables two-factor authentication.
+   *
+   * @generated from rpc memos.api.v1.UserService.ConfirmUserTwoFactor
+   */
+  confirmUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof ConfirmUserTwoFactorRequestSchema;
+    output: typeof ConfirmUserTwoFactorResponseSchema;
+  },
+  /**
+   * DeleteUserTwoFactor disables two-factor authentication for a user.
+   * Admins can use it to reset two-factor authentication for a locked-out user.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserTwoFactor
+   */
+  deleteUserTwoFactor: {
+    methodKind: "unary";
+    input: typeof DeleteUserTwoFactorRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListUserPasskeys returns the passkeys registered by a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserPasskeys
+   */
+  listUserPasskeys: {
+    methodKind: "unary";
+    input: typeof ListUserPasskeysRequestSchema;
+    output: typeof ListUserPasskeysResponseSchema;
+  },
+  /**
+   * CreateUserPasskeyOptions starts a passkey registration.
+   * Returns the options for navigator.credentials.create(); the credential is sent back in CreateUserPasskey.
+   *
+   * @generated from rpc memos.api.v1.UserService.CreateUserPasskeyOptions
+   */
+  createUserPasskeyOptions: {
+    methodKind: "unary";
+    input: typeof CreateUserPasskeyOptionsRequestSchema;
+    output: typeof UserPasskeyOptionsSchema;
+  },
+  /**
+   * CreateUserPasskey registers a passkey for a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.CreateUserPasskey
+   */
+  createUserPasskey: {
+    methodKind: "unary";
+    input: typeof CreateUserPasskeyRequestSchema;
+    output: typeof UserPasskeySchema;
+  },
+  /**
+   * DeleteUserPasskey removes a passkey of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserPasskey
+   */
+  deleteUserPasskey: {
+    methodKind: "unary";
+    input: typeof DeleteUserPasskeyRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ListUserPushSubscriptions returns the Web Push subscriptions of the devices of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserPushSubscriptions
+   */
+  listUserPushSubscriptions: {
+    methodKind: "unary";
+    input: typeof ListUserPushSubscriptionsRequestSchema;
+    output: typeof ListUserPushSubscriptionsResponseSchema;
+  },
+  /**
+   * CreateUserPushSubscription registers the Web Push subscription of a device of a user.
+   * Registering the endpoint of an existing subscription again updates it.
+   *
+   * @generated from rpc memos.api.v1.UserService.CreateUserPushSubscription
+   */
+  createUserPushSubscription: {
+    methodKind: "unary";
+    input: typeof CreateUserPushSubscriptionRequestSchema;
+    output: typeof UserPushSubscriptionSchema;
+  },
+  /**
+   * DeleteUserPushSubscription revokes the Web Push subscription of a device of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserPushSubscription
+   */
+  deleteUserPushSubscription: {
+    methodKind: "unary";
+    input: typeof DeleteUserPushSubscriptionRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetUserFeedToken returns whether a user has a feed token.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserFeedToken
+   */
+  getUserFeedToken: {
+    methodKind: "unary";
+    input: typeof GetUserFeedTokenRequestSchema;
+    output: typeof UserFeedTokenSchema;
+  },
+  /**
+   * RotateUserFeedToken generates a new feed token for a user, replacing the current one.
+   * The token is only returned in the response.
+   *
+   * @generated from rpc memos.api.v1.UserService.RotateUserFeedToken
+   */
+  rotateUserFeedToken: {
+    methodKind: "unary";
+    input: typeof RotateUserFeedTokenRequestSchema;
+    output: typeof UserFeedTokenSchema;
+  },
+  /**
+   * DeleteUserFeedToken revokes the feed token of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserFeedToken
+   */
+  deleteUserFeedToken: {
+    methodKind: "unary";
+    input: typeof DeleteUserFeedTokenRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetUserInboundEmail gets the inbound email address of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserInboundEmail
+   */
+  getUserInboundEmail: {
+    methodKind: "unary";
+    input: typeof GetUserInboundEmailRequestSchema;
+    output: typeof UserInboundEmailSchema;
+  },
+  /**
+   * RotateUserInboundEmail generates a new inbound email address for a user, replacing the
+   * current one, whose emails are dropped.
+   *
+   * @generated from rpc memos.api.v1.UserService.RotateUserInboundEmail
+   */
+  rotateUserInboundEmail: {
+    methodKind: "unary";
+    input: typeof RotateUserInboundEmailRequestSchema;
+    output: typeof UserInboundEmailSchema;
+  },
+  /**
+   * DeleteUserInboundEmail removes the inbound email address of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserInboundEmail
+   */
+  deleteUserInboundEmail: {
+    methodKind: "unary";
+    input: typeof DeleteUserInboundEmailRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetUserSlackLink gets the Slack account linked to a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserSlackLink
+   */
+  getUserSlackLink: {
+    methodKind: "unary";
+    input: typeof GetUserSlackLinkRequestSchema;
+    output: typeof UserSlackLinkSchema;
+  },
+  /**
+   * GenerateUserSlackLinkCode generates a one-time code linking the Slack account it's sent
+   * from, in a direct message to the Slack app, to a user. It replaces the pending code, if any.
+   *
+   * @generated from rpc memos.api.v1.UserService.GenerateUserSlackLinkCode
+   */
+  generateUserSlackLinkCode: {
+    methodKind: "unary";
+    input: typeof GenerateUserSlackLinkCodeRequestSchema;
+    output: typeof UserSlackLinkSchema;
+  },
+  /**
+   * DeleteUserSlackLink unlinks the Slack account of a user, and revokes the pending code.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserSlackLink
+   */
+  deleteUserSlackLink: {
+    methodKind: "unary";
+    input: typeof DeleteUserSlackLinkRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * GetUserMatrixLink gets the Matrix account linked to a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.GetUserMatrixLink
+   */
+  getUserMatrixLink: {
+    methodKind: "unary";
+    input: typeof GetUserMatrixLinkRequestSchema;
+    output: typeof UserMatrixLinkSchema;
+  },
+  /**
+   * GenerateUserMatrixLinkCode generates a one-time code linking the Matrix account it's sent
+   * from, with "!memos link <code>" in a room of the bot, to a user. It replaces the pending code,
+   * if any.
+   *
+   * @generated from rpc memos.api.v1.UserService.GenerateUserMatrixLinkCode
+   */
+  generateUserMatrixLinkCode: {
+    methodKind: "unary";
+    input: typeof GenerateUserMatrixLinkCodeRequestSchema;
+    output: typeof UserMatrixLinkSchema;
+  },
+  /**
+   * DeleteUserMatrixLink unlinks the Matrix account of a user, and revokes the pending code.
+   *
+   * @generated from rpc memos.api.v1.UserService.DeleteUserMatrixLink
+   */
+  deleteUserMatrixLink: {
+    methodKind: "unary";
+    input: typeof DeleteUserMatrixLinkRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * UnlockUser clears the failed sign-in attempts of a user, lifting a sign-in lock.
+   *
+   * @generated from rpc memos.api.v1.UserService.UnlockUser
+   */
+  unlockUser: {
+    methodKind: "unary";
+    input: typeof UnlockUserRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * SuspendUser suspends a user: the user can't sign in and the memos of the user are hidden from
+   * the others, but nothing is deleted. Only the host can suspend users.
+   *
+   * @generated from rpc memos.api.v1.UserService.SuspendUser
+   */
+  suspendUser: {
+    methodKind: "unary";
+    input: typeof SuspendUserRequestSchema;
+    output: typeof UserSchema;
+  },
+  /**
+   * UnsuspendUser lifts the suspension of a user. Only the host can unsuspend users.
+   *
+   * @generated from rpc memos.api.v1.UserService.UnsuspendUser
+   */
+  unsuspendUser: {
+    methodKind: "unary";
+    input: typeof UnsuspendUserRequestSchema;
+    output: typeof UserSchema;
+  },
+  /**
+   * ListUserWebhooks returns a list of webhooks for a user, or the workspace webhooks for the
+   * "instance" parent. Only hosts can manage the workspace webhooks.
    *
    * @generated from rpc memos.api.v1.UserService.ListUserWebhooks
    */
@@ -1717,6 +4141,50 @@ export const UserService: GenService<{
     input: typeof DeleteUserWebhookRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * RotateUserWebhookSecret generates a new signing secret for a webhook, replacing the current one.
+   * Webhooks without a secret start signing their deliveries.
+   *
+   * @generated from rpc memos.api.v1.UserService.RotateUserWebhookSecret
+   */
+  rotateUserWebhookSecret: {
+    methodKind: "unary";
+    input: typeof RotateUserWebhookSecretRequestSchema;
+    output: typeof UserWebhookSchema;
+  },
+  /**
+   * TestUserWebhook sends a test request to a webhook and returns the response of its endpoint.
+   * The request is validated and signed like deliveries, and carries the "test" event with a
+   * sample memo. Tests are rate-limited per user.
+   *
+   * @generated from rpc memos.api.v1.UserService.TestUserWebhook
+   */
+  testUserWebhook: {
+    methodKind: "unary";
+    input: typeof TestUserWebhookRequestSchema;
+    output: typeof TestUserWebhookResponseSchema;
+  },
+  /**
+   * ListUserWebhookDeliveries lists the deliveries of a webhook, newest first.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserWebhookDeliveries
+   */
+  listUserWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListUserWebhookDeliveriesRequestSchema;
+    output: typeof ListUserWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * RedeliverUserWebhookDelivery replays a delivery of a webhook, sending its payload again as a
+   * new delivery.
+   *
+   * @generated from rpc memos.api.v1.UserService.RedeliverUserWebhookDelivery
+   */
+  redeliverUserWebhookDelivery: {
+    methodKind: "unary";
+    input: typeof RedeliverUserWebhookDeliveryRequestSchema;
+    output: typeof UserWebhookDeliverySchema;
+  },
   /**
    * ListUserNotifications lists notifications for a user.
    *
@@ -1,3 +1,4 @@
+import type { Duration } from "@bufbuild/protobuf/wkt";
 import { Attachment } from "@/types/proto/api/v1/attachment_service_pb";
 
 export const getAttachmentUrl = (attachment: Attachment) => {
@@ -9,7 +10,7 @@ export const getAttachmentUrl = (attachment: Attachment) => {
 };
 
 export const getAttachmentThumbnailUrl = (attachment: Attachment) => {
-  return `${window.location.origin}/file/${attachment.name}/${attachment.filename}?thumbnail=true`;
+  return `${window.location.origin}/file/${attachment.name}/${attachment.filename}?thumbnail=medium`;
 };
 
 export const getAttachmentType = (attachment: Attachment) => {
@@ -44,6 +45,18 @@ export const isImage = (t: string) => {
   return t.startsWith("image/") && !isPSD(t);
 };
 
+// formatAudioDuration formats the duration of an audio attachment as m:ss, or h:mm:ss for long recordings.
+export const formatAudioDuration = (duration: Duration): string => {
+  const totalSeconds = Math.round(Number(duration.seconds) + duration.nanos / 1e9);
+  const hours = Math.floor(totalSeconds / 3600);
+  const minutes = Math.floor((totalSeconds % 3600) / 60);
+  const seconds = String(totalSeconds % 60).padStart(2, "0");
+  if (hours > 0) {
+    return `${hours}:${String(minutes).padStart(2, "0")}:${seconds}`;
+  }
+  return `${minutes}:${seconds}`;
+};
+
 // isMidiFile returns true if the given mime type is a MIDI file.
 export const isMidiFile = (mimeType: string): boolean => {
   return mimeType === "audio/midi" || mimeType === "audio/mid" || mimeType === "audio/x-midi" || mimeType === "application/x-midi";
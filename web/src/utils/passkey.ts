@@ -0,0 +1,62 @@
+import { PasskeyChallenge } from "@/types/proto/api/v1/auth_service_pb";
+import { UserPasskeyOptions } from "@/types/proto/api/v1/user_service_pb";
+
+// COSE algorithms supported by the server: ES256, EdDSA, RS256.
+const SUPPORTED_ALGORITHMS = [-7, -8, -257];
+
+export const isPasskeySupported = () => typeof window !== "undefined" && !!window.PublicKeyCredential;
+
+const toArrayBuffer = (bytes: Uint8Array): ArrayBuffer => bytes.slice().buffer as ArrayBuffer;
+
+// Create a passkey for options from CreateUserPasskeyOptions.
+export const createPasskey = async (options: UserPasskeyOptions) => {
+  const credential = (await navigator.credentials.create({
+    publicKey: {
+      challenge: toArrayBuffer(options.challenge),
+      rp: { id: options.rpId, name: options.rpName },
+      user: { id: toArrayBuffer(options.userHandle), name: options.username, displayName: options.displayName },
+      pubKeyCredParams: SUPPORTED_ALGORITHMS.map((alg) => ({ type: "public-key" as const, alg })),
+      excludeCredentials: options.excludeCredentialIds.map((id) => ({ type: "public-key" as const, id: toArrayBuffer(id) })),
+      authenticatorSelection: { residentKey: "required", userVerification: "preferred" },
+      attestation: "none",
+    },
+  })) as PublicKeyCredential | null;
+  if (!credential) {
+    throw new Error("Passkey creation was cancelled.");
+  }
+  const response = credential.response as AuthenticatorAttestationResponse;
+  const publicKey = response.getPublicKey();
+  if (!publicKey) {
+    throw new Error("The authenticator uses an unsupported algorithm.");
+  }
+  return {
+    credentialId: new Uint8Array(credential.rawId),
+    clientDataJson: new Uint8Array(response.clientDataJSON),
+    authenticatorData: new Uint8Array(response.getAuthenticatorData()),
+    publicKey: new Uint8Array(publicKey),
+    publicKeyAlgorithm: BigInt(response.getPublicKeyAlgorithm()),
+    transports: response.getTransports(),
+  };
+};
+
+// Sign a challenge from CreatePasskeyChallenge with a discoverable passkey.
+export const getPasskeyAssertion = async (challenge: PasskeyChallenge) => {
+  const credential = (await navigator.credentials.get({
+    publicKey: {
+      challenge: toArrayBuffer(challenge.challenge),
+      rpId: challenge.rpId,
+      userVerification: "preferred",
+    },
+  })) as PublicKeyCredential | null;
+  if (!credential) {
+    throw new Error("Passkey sign-in was cancelled.");
+  }
+  const response = credential.response as AuthenticatorAssertionResponse;
+  return {
+    credentialId: new Uint8Array(credential.rawId),
+    clientDataJson: new Uint8Array(response.clientDataJSON),
+    authenticatorData: new Uint8Array(response.authenticatorData),
+    signature: new Uint8Array(response.signature),
+    userHandle: new Uint8Array(response.userHandle ?? new ArrayBuffer(0)),
+  };
+};